@@ -61,9 +61,18 @@ type ForeignKey struct {
 // Generation context
 type genContext struct {
 	tables    []TableDefinition
+	valueSets []StandardValueSet
 	timestamp string
 }
 
+// StandardValueSet matches entries of internal/bootstrap/standard_value_sets.json
+type StandardValueSet struct {
+	Name        string   `json:"name"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Options     []string `json:"options"`
+}
+
 var commonFieldNames = []string{
 	// Primary/Core fields
 	"__sys_gen_id",
@@ -199,8 +208,14 @@ func main() {
 
 	fmt.Printf("📊 Total Definitions: %d\n", len(tables))
 
+	valueSets, err := readStandardValueSets(filepath.Join(filepath.Dir(jsonPath), "standard_value_sets.json"))
+	if err != nil {
+		log.Fatalf("❌ Failed to read standard value sets: %v", err)
+	}
+
 	ctx := &genContext{
 		tables:    tables,
+		valueSets: valueSets,
 		timestamp: time.Now().Format(time.RFC3339),
 	}
 
@@ -229,9 +244,41 @@ func main() {
 		log.Fatalf("❌ Failed to generate MCP types: %v", err)
 	}
 
+	if err := generateProto(ctx, projectRoot); err != nil {
+		log.Fatalf("❌ Failed to generate protobuf definitions: %v", err)
+	}
+
+	if err := generateValueSets(ctx, projectRoot); err != nil {
+		log.Fatalf("❌ Failed to generate value sets: %v", err)
+	}
+
+	if err := generateErrorCodes(ctx, projectRoot); err != nil {
+		log.Fatalf("❌ Failed to generate error codes: %v", err)
+	}
+
 	fmt.Println("\n🎉 Code generation complete!")
 }
 
+// readStandardValueSets loads the standard global value sets seeded at startup
+func readStandardValueSets(path string) ([]StandardValueSet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var sets []StandardValueSet
+	if err := json.Unmarshal(content, &sets); err != nil {
+		return nil, err
+	}
+	validName := regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	for _, vs := range sets {
+		if !validName.MatchString(vs.Name) {
+			return nil, fmt.Errorf("value set name %q must be PascalCase", vs.Name)
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Name < sets[j].Name })
+	return sets, nil
+}
+
 func findSystemTablesJSON() string {
 	paths := []string{
 		"backend/internal/bootstrap/system_tables.json",
@@ -1037,6 +1084,68 @@ func generateMCPTypes(ctx *genContext, projectRoot string) error {
 	return nil
 }
 
+// generateProto writes one protobuf message per system table for the gRPC API.
+// Field numbers follow column order, so columns must only ever be appended to a
+// table for the wire format to stay compatible.
+func generateProto(ctx *genContext, projectRoot string) error {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by cmd/codegen. DO NOT EDIT.\n")
+	sb.WriteString("// Source: backend/internal/bootstrap/system_tables.json\n")
+	sb.WriteString("// Generated at: " + ctx.timestamp + "\n\n")
+	sb.WriteString("syntax = \"proto3\";\n\n")
+	sb.WriteString("package nexuscrm.v1;\n\n")
+	sb.WriteString("import \"google/protobuf/struct.proto\";\n")
+	sb.WriteString("import \"google/protobuf/timestamp.proto\";\n\n")
+	sb.WriteString("option go_package = \"github.com/nexuscrm/backend/pkg/api/nexuscrmv1;nexuscrmv1\";\n")
+
+	sortedTables := make([]TableDefinition, len(ctx.tables))
+	copy(sortedTables, ctx.tables)
+	sort.Slice(sortedTables, func(i, j int) bool {
+		return sortedTables[i].TableName < sortedTables[j].TableName
+	})
+
+	for _, t := range sortedTables {
+		sb.WriteString(fmt.Sprintf("\n// %s represents the %s table (generated).\n", tableNameToStructName(t.TableName), t.TableName))
+		if t.Description != "" {
+			sb.WriteString(fmt.Sprintf("// %s\n", t.Description))
+		}
+		sb.WriteString(fmt.Sprintf("message %s {\n", tableNameToStructName(t.TableName)))
+
+		used := make(map[string]bool, len(t.Columns))
+		for _, col := range t.Columns {
+			used[col.Name] = true
+		}
+		for i, col := range t.Columns {
+			// Password hashes never leave the server; the field number stays reserved
+			if col.LogicalType == "Password" {
+				sb.WriteString(fmt.Sprintf("  reserved %d;\n", i+1))
+				continue
+			}
+
+			fieldName := strings.TrimPrefix(col.Name, "__sys_gen_")
+			if fieldName != col.Name && used[fieldName] {
+				fieldName = "sys_" + fieldName
+			}
+			protoType, scalar := sqlTypeToProtoType(col.Type)
+			label := ""
+			if col.Nullable && scalar && !col.PrimaryKey {
+				label = "optional "
+			}
+			sb.WriteString(fmt.Sprintf("  %s%s %s = %d [json_name = \"%s\"];\n", label, protoType, fieldName, i+1, col.Name))
+		}
+
+		sb.WriteString("}\n")
+	}
+
+	outPath := filepath.Join(projectRoot, "backend", "proto", "nexuscrm", "v1", "system_tables.proto")
+	if err := os.WriteFile(outPath, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	fmt.Printf("✅ Generated: %s (%d bytes)\n", outPath, sb.Len())
+	return nil
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
@@ -1179,6 +1288,40 @@ func sqlTypeToGoType(sqlType string, nullable bool, logicalType string) string {
 	return goType
 }
 
+// sqlTypeToProtoType converts SQL types to protobuf types. The second result reports
+// whether the type is a scalar, which needs an explicit optional label to carry NULL.
+func sqlTypeToProtoType(sqlType string) (string, bool) {
+	sqlType = strings.ToUpper(sqlType)
+	if strings.HasPrefix(sqlType, "ENUM") {
+		return "string", true
+	}
+
+	baseType := strings.TrimSpace(regexp.MustCompile(`\([^)]*\)`).ReplaceAllString(sqlType, ""))
+	switch baseType {
+	case "INT", "INTEGER", "SMALLINT", "MEDIUMINT":
+		return "int32", true
+	case "BIGINT":
+		return "int64", true
+	case "TINYINT":
+		if strings.Contains(sqlType, "(1)") {
+			return "bool", true
+		}
+		return "int32", true
+	case "BOOLEAN", "BOOL":
+		return "bool", true
+	case "DECIMAL", "NUMERIC", "FLOAT", "DOUBLE":
+		return "double", true
+	case "DATETIME", "TIMESTAMP":
+		return "google.protobuf.Timestamp", false
+	case "JSON":
+		return "google.protobuf.Value", false
+	case "BLOB", "LONGBLOB", "MEDIUMBLOB":
+		return "bytes", true
+	default:
+		return "string", true // VARCHAR, TEXT, DATE, TIME, ...
+	}
+}
+
 // sqlTypeToTSType converts SQL types to TypeScript types
 func sqlTypeToTSType(sqlType string, logicalType string) string {
 	sqlType = strings.ToUpper(sqlType)
@@ -1212,3 +1355,160 @@ func sqlTypeToTSType(sqlType string, logicalType string) string {
 		return "unknown"
 	}
 }
+
+// ============================================================================
+// Standard Value Sets Generation
+// ============================================================================
+
+func generateValueSets(ctx *genContext, projectRoot string) error {
+	var gb strings.Builder
+	gb.WriteString("// Code generated by cmd/codegen. DO NOT EDIT.\n")
+	gb.WriteString("// Source: internal/bootstrap/standard_value_sets.json\n")
+	gb.WriteString("// Generated at: " + ctx.timestamp + "\n\n")
+	gb.WriteString("package constants\n\n")
+	gb.WriteString("// Standard Global Value Set Names\n")
+	gb.WriteString("const (\n")
+	for _, vs := range ctx.valueSets {
+		gb.WriteString(fmt.Sprintf("\tValueSet%s = \"%s\"\n", vs.Name, vs.Name))
+	}
+	gb.WriteString(")\n\n")
+	gb.WriteString("// StandardValueSetNames lists the standard global value sets\n")
+	gb.WriteString("var StandardValueSetNames = []string{\n")
+	for _, vs := range ctx.valueSets {
+		gb.WriteString(fmt.Sprintf("\tValueSet%s,\n", vs.Name))
+	}
+	gb.WriteString("}\n")
+
+	goPath := filepath.Join(projectRoot, "shared", "pkg", "constants", "z_generated_value_sets.go")
+	if err := os.WriteFile(goPath, []byte(gb.String()), 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	fmt.Printf("✅ Generated: %s (%d bytes)\n", goPath, gb.Len())
+
+	var tb strings.Builder
+	tb.WriteString("// Code generated by cmd/codegen. DO NOT EDIT.\n")
+	tb.WriteString("// Source: backend/internal/bootstrap/standard_value_sets.json\n")
+	tb.WriteString("// Generated at: " + ctx.timestamp + "\n\n")
+	tb.WriteString("// ==================== Standard Value Sets ====================\n\n")
+	tb.WriteString("export const STANDARD_VALUE_SETS = {\n")
+	for _, vs := range ctx.valueSets {
+		quoted := make([]string, len(vs.Options))
+		for i, o := range vs.Options {
+			quoted[i] = "'" + strings.ReplaceAll(o, "'", "\\'") + "'"
+		}
+		tb.WriteString(fmt.Sprintf("    %s: [%s],\n", vs.Name, strings.Join(quoted, ", ")))
+	}
+	tb.WriteString("} as const;\n\n")
+	tb.WriteString("export type StandardValueSetName = keyof typeof STANDARD_VALUE_SETS;\n\n")
+	for _, vs := range ctx.valueSets {
+		tb.WriteString(fmt.Sprintf("export type %sValue = typeof STANDARD_VALUE_SETS['%s'][number];\n", vs.Name, vs.Name))
+	}
+
+	tsPath := filepath.Join(projectRoot, "frontend", "src", "generated-value-sets.ts")
+	if err := os.WriteFile(tsPath, []byte(tb.String()), 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	fmt.Printf("✅ Generated: %s (%d bytes)\n", tsPath, tb.Len())
+	return nil
+}
+
+// ============================================================================
+// Error Code Registry Generation
+// ============================================================================
+
+// ErrorCodeDef matches shared/constants/errorCodes.json entries
+type ErrorCodeDef struct {
+	Status  int    `json:"status"`
+	I18nKey string `json:"i18nKey"`
+	Message string `json:"message"`
+}
+
+func generateErrorCodes(ctx *genContext, projectRoot string) error {
+	registryPath := filepath.Join(projectRoot, "shared", "constants", "errorCodes.json")
+	data, err := os.ReadFile(registryPath)
+	if err != nil {
+		return fmt.Errorf("read errorCodes.json: %w", err)
+	}
+	var registry map[string]ErrorCodeDef
+	if err := json.Unmarshal(data, &registry); err != nil {
+		return fmt.Errorf("parse errorCodes.json: %w", err)
+	}
+	codes := make([]string, 0, len(registry))
+	for code := range registry {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var gb strings.Builder
+	gb.WriteString("// Code generated by cmd/codegen. DO NOT EDIT.\n")
+	gb.WriteString("// Source: shared/constants/errorCodes.json\n")
+	gb.WriteString("// Generated at: " + ctx.timestamp + "\n\n")
+	gb.WriteString("package constants\n\n")
+	gb.WriteString("// API Error Codes\n")
+	gb.WriteString("const (\n")
+	for _, code := range codes {
+		gb.WriteString(fmt.Sprintf("\tErrorCode%s = \"%s\" // %s\n", snakeToPascal(strings.ToLower(code)), code, registry[code].Message))
+	}
+	gb.WriteString(")\n\n")
+	gb.WriteString("// ErrorCodeStatus maps each error code to its HTTP status\n")
+	gb.WriteString("var ErrorCodeStatus = map[string]int{\n")
+	for _, code := range codes {
+		gb.WriteString(fmt.Sprintf("\tErrorCode%s: %d,\n", snakeToPascal(strings.ToLower(code)), registry[code].Status))
+	}
+	gb.WriteString("}\n\n")
+	gb.WriteString("// ErrorCodeI18nKeys maps each error code to the translation key of its message\n")
+	gb.WriteString("var ErrorCodeI18nKeys = map[string]string{\n")
+	for _, code := range codes {
+		gb.WriteString(fmt.Sprintf("\tErrorCode%s: \"%s\",\n", snakeToPascal(strings.ToLower(code)), registry[code].I18nKey))
+	}
+	gb.WriteString("}\n")
+
+	goPath := filepath.Join(projectRoot, "shared", "pkg", "constants", "z_generated_error_codes.go")
+	if err := os.WriteFile(goPath, []byte(gb.String()), 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	fmt.Printf("✅ Generated: %s (%d bytes)\n", goPath, gb.Len())
+
+	var tb strings.Builder
+	tb.WriteString("// Code generated by cmd/codegen. DO NOT EDIT.\n")
+	tb.WriteString("// Source: shared/constants/errorCodes.json\n")
+	tb.WriteString("// Generated at: " + ctx.timestamp + "\n\n")
+	tb.WriteString("// ==================== API Error Codes ====================\n\n")
+	tb.WriteString("export const ERROR_CODES = {\n")
+	for _, code := range codes {
+		tb.WriteString(fmt.Sprintf("    %s: '%s',\n", code, code))
+	}
+	tb.WriteString("} as const;\n\n")
+	tb.WriteString("export type ErrorCode = keyof typeof ERROR_CODES;\n\n")
+	tb.WriteString("export interface ErrorCodeDefinition {\n")
+	tb.WriteString("    status: number;\n")
+	tb.WriteString("    i18nKey: string;\n")
+	tb.WriteString("    message: string;\n")
+	tb.WriteString("}\n\n")
+	tb.WriteString("export const ERROR_CODE_REGISTRY: Record<ErrorCode, ErrorCodeDefinition> = {\n")
+	for _, code := range codes {
+		def := registry[code]
+		tb.WriteString(fmt.Sprintf("    %s: { status: %d, i18nKey: '%s', message: '%s' },\n",
+			code, def.Status, def.I18nKey, strings.ReplaceAll(def.Message, "'", "\\'")))
+	}
+	tb.WriteString("};\n\n")
+	tb.WriteString("/** Error body returned by every API handler */\n")
+	tb.WriteString("export interface ApiErrorBody {\n")
+	tb.WriteString("    code: ErrorCode;\n")
+	tb.WriteString("    message: string;\n")
+	tb.WriteString("    field?: string;\n")
+	tb.WriteString("    i18n_key?: string;\n")
+	tb.WriteString("    details?: unknown;\n")
+	tb.WriteString("    errors?: ApiErrorBody[];\n")
+	tb.WriteString("}\n\n")
+	tb.WriteString("export function isErrorCode(code: unknown): code is ErrorCode {\n")
+	tb.WriteString("    return typeof code === 'string' && code in ERROR_CODES;\n")
+	tb.WriteString("}\n")
+
+	tsPath := filepath.Join(projectRoot, "frontend", "src", "generated-error-codes.ts")
+	if err := os.WriteFile(tsPath, []byte(tb.String()), 0644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	fmt.Printf("✅ Generated: %s (%d bytes)\n", tsPath, tb.Len())
+	return nil
+}
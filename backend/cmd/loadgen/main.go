@@ -0,0 +1,195 @@
+// Command loadgen replays a weighted mix of API calls (record queries, creates and updates,
+// dashboard runs and MCP tool calls) against a running NexusCRM server and reports latency
+// percentiles per operation, for performance regression testing.
+//
+//	LOADGEN_EMAIL=admin@test.com LOADGEN_PASSWORD='Admin123!' \
+//	    go run ./cmd/loadgen -url http://localhost:3001 -duration 1m -concurrency 16 \
+//	    -mix query=60,update=20,mcp=20 -json run.json -baseline main.json
+//
+// With -baseline, the run fails when an operation's p95 latency is more than -max-regression
+// slower than in the baseline report (written by an earlier run with -json). Calls rejected by
+// a rate limit, such as the per-user MCP tool call limit, are reported as throttled rather
+// than as errors; use -rate or a lower mcp weight to stay under it.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+func main() {
+	baseURL := flag.String("url", "http://localhost:3001", "server URL")
+	email := flag.String("email", os.Getenv("LOADGEN_EMAIL"), "login email (default $LOADGEN_EMAIL)")
+	duration := flag.Duration("duration", 30*time.Second, "how long to run")
+	concurrency := flag.Int("concurrency", 8, "concurrent workers")
+	rate := flag.Float64("rate", 0, "operations per second across workers (0 = as fast as possible)")
+	mixFlag := flag.String("mix", defaultMix, "operations and their weights")
+	var cfg workloadConfig
+	flag.StringVar(&cfg.Object, "object", "opportunity", "object queried, created and updated")
+	flag.StringVar(&cfg.Filter, "filter", "", "filter expression of the queries")
+	flag.IntVar(&cfg.PageSize, "limit", 50, "rows per query and MCP tool call")
+	flag.StringVar(&cfg.UpdateField, "update-field", "amount", "numeric field set by creates and updates")
+	flag.StringVar(&cfg.Dashboard, "dashboard", "", "dashboard ID to run (default the first one the user sees)")
+	flag.StringVar(&cfg.MCPTool, "mcp-tool", "query_object", "MCP tool to call with object_name and limit")
+	cleanup := flag.Bool("cleanup", true, "delete the records created by the run")
+	seed := flag.Int64("seed", 0, "seed of the operation choice (default random)")
+	jsonOut := flag.String("json", "", "write the report as JSON to this file")
+	baselinePath := flag.String("baseline", "", "JSON report of an earlier run to compare with")
+	maxRegression := flag.Float64("max-regression", 0.2, "p95 slowdown over the baseline that fails the run (0.2 = 20%)")
+	maxErrorRate := flag.Float64("max-error-rate", 0.01, "share of failed operations that fails the run")
+	flag.Parse()
+
+	// The password is read from the environment only, keeping it out of the process list
+	password := os.Getenv("LOADGEN_PASSWORD")
+	if *email == "" || password == "" {
+		log.Fatal("🛑 Set LOADGEN_EMAIL (or -email) and LOADGEN_PASSWORD to the login to run as")
+	}
+	if *concurrency < 1 {
+		log.Fatal("🛑 -concurrency must be at least 1")
+	}
+	mix, err := parseMix(*mixFlag)
+	if err != nil {
+		log.Fatalf("🛑 Invalid -mix: %v", err)
+	}
+	var baseline *report
+	if *baselinePath != "" {
+		if baseline, err = loadReport(*baselinePath); err != nil {
+			log.Fatalf("🛑 Failed to read the baseline: %v", err)
+		}
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = *concurrency
+	c := &client{baseURL: strings.TrimRight(*baseURL, "/"), http: &http.Client{Timeout: 60 * time.Second, Transport: transport}}
+	var login struct {
+		Token string `json:"token"`
+	}
+	if _, err := c.call(ctx, http.MethodPost, "/api/auth/login", map[string]string{"email": *email, "password": password}, &login, nil); err != nil {
+		log.Fatalf("❌ Login failed: %v", err)
+	}
+	c.token = login.Token
+
+	cfg.RunTag = fmt.Sprintf("loadgen-%d", time.Now().Unix())
+	w := &workload{c: c, cfg: cfg}
+	mix, notes, err := w.prepare(ctx, mix)
+	for _, note := range notes {
+		log.Printf("⚠️  %s", note)
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	log.Printf("🚀 Running %s for %s with %d workers", formatMix(mix), *duration, *concurrency)
+	rec := newRecorder()
+	started := time.Now()
+	runWorkers(ctx, w, mix, rec, *duration, *concurrency, *rate, *seed)
+	rep := rec.summarize(time.Since(started))
+	rep.Target = c.baseURL
+	rep.Mix = formatMix(mix)
+	rep.Concurrency = *concurrency
+	rep.StartedAt = started.UTC()
+
+	if *cleanup {
+		// Cleanup runs even after an interrupt, bounded so a stuck server cannot hang the exit
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		deleted, failed := w.cleanup(cleanupCtx)
+		cancel()
+		if deleted+failed > 0 {
+			log.Printf("🧹 Deleted %d records created by the run (%d failed)", deleted, failed)
+		}
+	}
+
+	rep.print(os.Stdout)
+	if *jsonOut != "" {
+		if err := rep.save(*jsonOut); err != nil {
+			log.Fatalf("❌ Failed to write the report: %v", err)
+		}
+	}
+
+	failed := false
+	if rate := rep.Total.errorRate(); rate > *maxErrorRate {
+		log.Printf("❌ %.1f%% of operations failed (limit %.1f%%)", rate*100, *maxErrorRate*100)
+		failed = true
+	}
+	if baseline != nil {
+		regressions := rep.regressions(baseline, *maxRegression)
+		for _, r := range regressions {
+			log.Printf("❌ Regression: %s", r)
+		}
+		if len(regressions) > 0 {
+			failed = true
+		} else {
+			log.Printf("✅ No p95 regression over %.0f%% against %s", *maxRegression*100, *baselinePath)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// runWorkers runs operations of the mix on concurrency workers until duration elapses or ctx
+// ends. A positive rate paces the operations across all workers.
+func runWorkers(ctx context.Context, w *workload, mix []mixEntry, rec *recorder, duration time.Duration, concurrency int, rate float64, seed int64) {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var ticks <-chan time.Time
+	if rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(rng *rand.Rand) {
+			defer wg.Done()
+			for {
+				if ticks != nil {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticks:
+					}
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				op := pickOp(mix, rng)
+				start := time.Now()
+				err := w.run(ctx, op, rng)
+				// Calls cut short by the end of the run are not measured
+				if err != nil && ctx.Err() != nil {
+					return
+				}
+				rec.record(op, time.Since(start), err)
+			}
+		}(rand.New(rand.NewSource(seed + int64(i))))
+	}
+	wg.Wait()
+}
+
+func formatMix(mix []mixEntry) string {
+	parts := make([]string, len(mix))
+	for i, e := range mix {
+		parts[i] = fmt.Sprintf("%s=%d", e.op, e.weight)
+	}
+	return strings.Join(parts, ",")
+}
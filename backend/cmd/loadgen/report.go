@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// maxErrorSamples bounds the error messages kept per operation
+const maxErrorSamples = 5
+
+// recorder collects the latency and outcome of every operation
+type recorder struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+	throttled map[string]int
+	samples   map[string][]string
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		latencies: map[string][]time.Duration{},
+		errors:    map[string]int{},
+		throttled: map[string]int{},
+		samples:   map[string][]string{},
+	}
+}
+
+// record counts an operation. Throttled calls are counted but their latency is not measured.
+func (r *recorder) record(op string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var throttled *throttledError
+	if errors.As(err, &throttled) {
+		r.throttled[op]++
+		return
+	}
+	r.latencies[op] = append(r.latencies[op], latency)
+	if err != nil {
+		r.errors[op]++
+		if len(r.samples[op]) < maxErrorSamples {
+			r.samples[op] = append(r.samples[op], err.Error())
+		}
+	}
+}
+
+// opStats summarizes one operation. Latencies are in milliseconds.
+type opStats struct {
+	Count        int      `json:"count"`
+	Errors       int      `json:"errors"`
+	Throttled    int      `json:"throttled"`
+	RPS          float64  `json:"rps"`
+	Mean         float64  `json:"mean_ms"`
+	P50          float64  `json:"p50_ms"`
+	P90          float64  `json:"p90_ms"`
+	P95          float64  `json:"p95_ms"`
+	P99          float64  `json:"p99_ms"`
+	Max          float64  `json:"max_ms"`
+	ErrorSamples []string `json:"error_samples,omitempty"`
+}
+
+// report is the result of a run, also written and read as JSON to compare runs
+type report struct {
+	Target      string              `json:"target"`
+	Mix         string              `json:"mix"`
+	Concurrency int                 `json:"concurrency"`
+	StartedAt   time.Time           `json:"started_at"`
+	Duration    float64             `json:"duration_seconds"`
+	Total       opStats             `json:"total"`
+	Operations  map[string]*opStats `json:"operations"`
+}
+
+// summarize computes the statistics of the recorded operations over elapsed
+func (r *recorder) summarize(elapsed time.Duration) *report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rep := &report{Duration: elapsed.Seconds(), Operations: map[string]*opStats{}}
+	var all []time.Duration
+	failed, throttled := 0, 0
+	for op, latencies := range r.latencies {
+		stats := computeStats(latencies, elapsed)
+		stats.Errors = r.errors[op]
+		stats.ErrorSamples = r.samples[op]
+		rep.Operations[op] = &stats
+		failed += stats.Errors
+		all = append(all, latencies...)
+	}
+	for op, n := range r.throttled {
+		if rep.Operations[op] == nil {
+			rep.Operations[op] = &opStats{}
+		}
+		rep.Operations[op].Throttled = n
+		throttled += n
+	}
+	rep.Total = computeStats(all, elapsed)
+	rep.Total.Errors = failed
+	rep.Total.Throttled = throttled
+	return rep
+}
+
+func computeStats(latencies []time.Duration, elapsed time.Duration) opStats {
+	stats := opStats{Count: len(latencies)}
+	if len(latencies) == 0 {
+		return stats
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+	stats.Mean = millis(sum / time.Duration(len(sorted)))
+	stats.P50 = millis(percentile(sorted, 50))
+	stats.P90 = millis(percentile(sorted, 90))
+	stats.P95 = millis(percentile(sorted, 95))
+	stats.P99 = millis(percentile(sorted, 99))
+	stats.Max = millis(sorted[len(sorted)-1])
+	if elapsed > 0 {
+		stats.RPS = float64(len(sorted)) / elapsed.Seconds()
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func millis(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Millisecond)*100) / 100
+}
+
+// errorRate is the share of operations that failed
+func (s opStats) errorRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Count)
+}
+
+// print writes the report as a table
+func (rep *report) print(w io.Writer) {
+	fmt.Fprintf(w, "\n%s  mix %s  concurrency %d  %.1fs\n\n", rep.Target, rep.Mix, rep.Concurrency, rep.Duration)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "operation\tcount\terrors\tthrottled\trps\tmean\tp50\tp90\tp95\tp99\tmax\t")
+	row := func(name string, s opStats) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t\n",
+			name, s.Count, s.Errors, s.Throttled, s.RPS, s.Mean, s.P50, s.P90, s.P95, s.P99, s.Max)
+	}
+	for _, op := range rep.sortedOps() {
+		row(op, *rep.Operations[op])
+	}
+	row("total", rep.Total)
+	tw.Flush()
+	fmt.Fprintln(w, "\nlatencies in ms")
+
+	for _, op := range rep.sortedOps() {
+		for _, sample := range rep.Operations[op].ErrorSamples {
+			fmt.Fprintf(w, "  %s error: %s\n", op, sample)
+		}
+	}
+}
+
+func (rep *report) sortedOps() []string {
+	ops := make([]string, 0, len(rep.Operations))
+	for op := range rep.Operations {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+func (rep *report) save(path string) error {
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func loadReport(path string) (*report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rep report
+	if err := json.Unmarshal(data, &rep); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &rep, nil
+}
+
+// regressions compares the p95 latency of each operation with a baseline run and describes
+// those slower by more than maxRatio (0.2 is 20%). Operations missing from either run are skipped.
+func (rep *report) regressions(baseline *report, maxRatio float64) []string {
+	var out []string
+	for _, op := range rep.sortedOps() {
+		base, ok := baseline.Operations[op]
+		if !ok || base.P95 <= 0 {
+			continue
+		}
+		cur := rep.Operations[op]
+		if cur.P95 > base.P95*(1+maxRatio) {
+			out = append(out, fmt.Sprintf("%s p95 %.1fms, baseline %.1fms (+%.0f%%)", op, cur.P95, base.P95, (cur.P95/base.P95-1)*100))
+		}
+	}
+	return out
+}
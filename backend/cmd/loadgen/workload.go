@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// Operations a workload mix can name
+const (
+	opQuery     = "query"
+	opCreate    = "create"
+	opUpdate    = "update"
+	opDashboard = "dashboard"
+	opMCP       = "mcp"
+)
+
+// defaultMix exercises reads most, like interactive use
+const defaultMix = "query=50,update=15,create=10,dashboard=10,mcp=15"
+
+// mixEntry is an operation and its share of the requests
+type mixEntry struct {
+	op     string
+	weight int
+}
+
+// parseMix reads "op=weight,..." into a mix
+func parseMix(raw string) ([]mixEntry, error) {
+	known := map[string]bool{opQuery: true, opCreate: true, opUpdate: true, opDashboard: true, opMCP: true}
+	var mix []mixEntry
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		op, weightText, ok := strings.Cut(part, "=")
+		op = strings.TrimSpace(op)
+		if !known[op] {
+			return nil, fmt.Errorf("unknown operation %q (use query, create, update, dashboard or mcp)", op)
+		}
+		weight := 1
+		if ok {
+			w, err := strconv.Atoi(strings.TrimSpace(weightText))
+			if err != nil || w < 0 {
+				return nil, fmt.Errorf("invalid weight for %s: %q", op, weightText)
+			}
+			weight = w
+		}
+		if weight > 0 {
+			mix = append(mix, mixEntry{op: op, weight: weight})
+		}
+	}
+	if len(mix) == 0 {
+		return nil, fmt.Errorf("the mix names no operation")
+	}
+	return mix, nil
+}
+
+// pickOp returns an operation of the mix, chosen by weight
+func pickOp(mix []mixEntry, rng *rand.Rand) string {
+	total := 0
+	for _, e := range mix {
+		total += e.weight
+	}
+	n := rng.Intn(total)
+	for _, e := range mix {
+		if n < e.weight {
+			return e.op
+		}
+		n -= e.weight
+	}
+	return mix[len(mix)-1].op
+}
+
+// workloadConfig describes what the operations touch
+type workloadConfig struct {
+	Object      string // Object queried, created and updated
+	Filter      string // Filter of the queries
+	PageSize    int
+	UpdateField string // Numeric field updates set
+	Dashboard   string // Dashboard run; empty picks the first one the user sees
+	MCPTool     string
+	RunTag      string // Names the records this run creates
+}
+
+// workload runs the operations against the target. Records read by queries and created by
+// the run feed the update pool.
+type workload struct {
+	c         *client
+	cfg       workloadConfig
+	dashboard string
+	mcpMu     sync.Mutex
+	mcpID     int
+	mcpSess   string
+
+	idsMu   sync.Mutex
+	ids     []string // Records updates pick from
+	known   map[string]bool
+	created []string // Records the run created, deleted by cleanup
+	seq     int
+}
+
+// maxUpdatePool bounds the record IDs kept for updates
+const maxUpdatePool = 10000
+
+// prepare checks the target and resolves what the mix needs: records to update, a dashboard
+// to run and an MCP session. Operations that cannot run are dropped from the mix, with a reason.
+func (w *workload) prepare(ctx context.Context, mix []mixEntry) ([]mixEntry, []string, error) {
+	var notes []string
+	if err := w.query(ctx); err != nil {
+		return nil, nil, fmt.Errorf("query %s: %w", w.cfg.Object, err)
+	}
+
+	kept := make([]mixEntry, 0, len(mix))
+	for _, e := range mix {
+		switch e.op {
+		case opUpdate:
+			if len(w.ids) == 0 && !hasOp(mix, opCreate) {
+				notes = append(notes, fmt.Sprintf("update skipped: %s has no records and the mix creates none", w.cfg.Object))
+				continue
+			}
+		case opDashboard:
+			if err := w.resolveDashboard(ctx); err != nil {
+				notes = append(notes, "dashboard skipped: "+err.Error())
+				continue
+			}
+		case opMCP:
+			if err := w.initializeMCP(ctx); err != nil {
+				notes = append(notes, "mcp skipped: "+err.Error())
+				continue
+			}
+		}
+		kept = append(kept, e)
+	}
+	if len(kept) == 0 {
+		return nil, notes, fmt.Errorf("no operation of the mix can run")
+	}
+	return kept, notes, nil
+}
+
+func hasOp(mix []mixEntry, op string) bool {
+	for _, e := range mix {
+		if e.op == op {
+			return true
+		}
+	}
+	return false
+}
+
+// run performs one operation
+func (w *workload) run(ctx context.Context, op string, rng *rand.Rand) error {
+	switch op {
+	case opQuery:
+		return w.query(ctx)
+	case opCreate:
+		return w.create(ctx, rng)
+	case opUpdate:
+		return w.update(ctx, rng)
+	case opDashboard:
+		_, err := w.c.call(ctx, http.MethodPost, "/api/metadata/dashboards/"+w.dashboard+"/run", nil, nil, nil)
+		return err
+	case opMCP:
+		return w.callTool(ctx)
+	}
+	return fmt.Errorf("unknown operation %s", op)
+}
+
+func (w *workload) query(ctx context.Context) error {
+	var resp struct {
+		Data []models.SObject `json:"data"`
+	}
+	req := models.QueryRequest{
+		ObjectAPIName: w.cfg.Object,
+		FilterExpr:    w.cfg.Filter,
+		SortField:     constants.FieldLastModifiedDate,
+		SortDirection: constants.SortDESC,
+		Limit:         w.cfg.PageSize,
+	}
+	if _, err := w.c.call(ctx, http.MethodPost, "/api/data/query", req, &resp, nil); err != nil {
+		return err
+	}
+
+	w.idsMu.Lock()
+	defer w.idsMu.Unlock()
+	for _, row := range resp.Data {
+		w.remember(row.GetString(constants.FieldID))
+	}
+	return nil
+}
+
+func (w *workload) create(ctx context.Context, rng *rand.Rand) error {
+	w.idsMu.Lock()
+	w.seq++
+	name := fmt.Sprintf("%s-%d", w.cfg.RunTag, w.seq)
+	w.idsMu.Unlock()
+
+	record := models.SObject{constants.FieldName: name}
+	if w.cfg.UpdateField != "" {
+		record[w.cfg.UpdateField] = rng.Intn(500000)
+	}
+	var resp struct {
+		Data models.SObject `json:"data"`
+	}
+	if _, err := w.c.call(ctx, http.MethodPost, "/api/data/"+w.cfg.Object, record, &resp, nil); err != nil {
+		return err
+	}
+
+	id := resp.Data.GetString(constants.FieldID)
+	w.idsMu.Lock()
+	defer w.idsMu.Unlock()
+	w.created = append(w.created, id)
+	w.remember(id)
+	return nil
+}
+
+// remember adds a record to the update pool; the caller holds idsMu
+func (w *workload) remember(id string) {
+	if id == "" || w.known[id] || len(w.ids) >= maxUpdatePool {
+		return
+	}
+	if w.known == nil {
+		w.known = map[string]bool{}
+	}
+	w.known[id] = true
+	w.ids = append(w.ids, id)
+}
+
+func (w *workload) update(ctx context.Context, rng *rand.Rand) error {
+	w.idsMu.Lock()
+	if len(w.ids) == 0 {
+		w.idsMu.Unlock()
+		return fmt.Errorf("no %s record to update yet", w.cfg.Object)
+	}
+	id := w.ids[rng.Intn(len(w.ids))]
+	w.idsMu.Unlock()
+
+	fields := models.SObject{w.cfg.UpdateField: rng.Intn(500000)}
+	_, err := w.c.call(ctx, http.MethodPatch, "/api/data/"+w.cfg.Object+"/"+id, fields, nil, nil)
+	return err
+}
+
+// resolveDashboard picks the first dashboard the user sees unless one was given
+func (w *workload) resolveDashboard(ctx context.Context) error {
+	if w.cfg.Dashboard != "" {
+		w.dashboard = w.cfg.Dashboard
+		return nil
+	}
+	var resp struct {
+		Data []models.DashboardConfig `json:"data"`
+	}
+	if _, err := w.c.call(ctx, http.MethodGet, "/api/metadata/dashboards", nil, &resp, nil); err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		if d.ID != "" {
+			ids = append(ids, d.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("no dashboard to run (create one or pass -dashboard)")
+	}
+	sort.Strings(ids)
+	w.dashboard = ids[0]
+	return nil
+}
+
+// initializeMCP opens the MCP session tool calls run in
+func (w *workload) initializeMCP(ctx context.Context) error {
+	headers, err := w.rpc(ctx, "initialize", map[string]interface{}{
+		"protocolVersion": mcp.ProtocolVersion,
+		"clientInfo":      mcp.Implementation{Name: "loadgen", Version: "1.0.0"},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	w.mcpSess = headers.Get(mcp.HeaderSessionID)
+	return nil
+}
+
+func (w *workload) callTool(ctx context.Context) error {
+	var result mcp.CallToolResult
+	_, err := w.rpc(ctx, "tools/call", mcp.CallToolParams{
+		Name:      w.cfg.MCPTool,
+		Arguments: map[string]interface{}{"object_name": w.cfg.Object, "limit": w.cfg.PageSize},
+	}, &result)
+	if err != nil {
+		return err
+	}
+	if result.IsError {
+		msg := "tool error"
+		if len(result.Content) > 0 {
+			msg = truncate(result.Content[0].Text, 200)
+		}
+		if strings.HasPrefix(msg, "Rate limit exceeded") {
+			return &throttledError{msg: w.cfg.MCPTool + ": " + msg}
+		}
+		return fmt.Errorf("%s: %s", w.cfg.MCPTool, msg)
+	}
+	return nil
+}
+
+// rpc sends a JSON-RPC request to /mcp and decodes its result into out
+func (w *workload) rpc(ctx context.Context, method string, params, out interface{}) (http.Header, error) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	w.mcpMu.Lock()
+	w.mcpID++
+	id := w.mcpID
+	w.mcpMu.Unlock()
+
+	headers := map[string]string{}
+	if w.mcpSess != "" {
+		headers[mcp.HeaderSessionID] = w.mcpSess
+	}
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *mcp.Error      `json:"error"`
+	}
+	respHeaders, err := w.c.call(ctx, http.MethodPost, "/mcp", mcp.Request{JSONRPC: mcp.JSONRPCVersion, Method: method, Params: raw, ID: id}, &resp, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	if out != nil && len(resp.Result) > 0 {
+		if err := json.Unmarshal(resp.Result, out); err != nil {
+			return nil, err
+		}
+	}
+	return respHeaders, nil
+}
+
+// cleanup deletes the records the run created
+func (w *workload) cleanup(ctx context.Context) (deleted, failed int) {
+	w.idsMu.Lock()
+	created := append([]string(nil), w.created...)
+	w.idsMu.Unlock()
+	for _, id := range created {
+		if _, err := w.c.call(ctx, http.MethodDelete, "/api/data/"+w.cfg.Object+"/"+id, nil, nil, nil); err != nil {
+			failed++
+		} else {
+			deleted++
+		}
+	}
+	return deleted, failed
+}
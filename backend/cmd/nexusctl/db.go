@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// defaultWipeEnvs are the GO_ENV values destructive commands run in unless NEXUSCTL_WIPE_ENVS
+// lists others
+const defaultWipeEnvs = "development,dev,test,local"
+
+// checkWipeAllowed refuses to destroy data outside the environments NEXUSCTL_WIPE_ENVS allows
+func checkWipeAllowed(env string) error {
+	allowed := os.Getenv("NEXUSCTL_WIPE_ENVS")
+	if allowed == "" {
+		allowed = defaultWipeEnvs
+	}
+	for _, a := range strings.Split(allowed, ",") {
+		if strings.ToLower(strings.TrimSpace(a)) == env {
+			return nil
+		}
+	}
+	return fmt.Errorf("refusing to wipe the database: GO_ENV=%s is not in NEXUSCTL_WIPE_ENVS (%s)", env, allowed)
+}
+
+// databaseName names the connected database, which --confirm must repeat
+func databaseName(ctx context.Context, db *database.TiDBConnection) (string, error) {
+	var stmt string
+	switch query.ActiveDialect().Name() {
+	case query.DialectSQLite:
+		stmt = "SELECT file FROM pragma_database_list WHERE name = 'main'"
+	case query.DialectPostgres:
+		stmt = "SELECT current_database()"
+	default:
+		stmt = "SELECT DATABASE()"
+	}
+	var name string
+	if err := db.QueryRowContext(ctx, stmt).Scan(&name); err != nil {
+		return "", fmt.Errorf("failed to read the database name: %w", err)
+	}
+	if query.ActiveDialect().Name() == query.DialectSQLite {
+		name = filepath.Base(name)
+	}
+	return name, nil
+}
+
+// wipe drops every table once the environment allows it and confirm names the database
+func wipe(ctx context.Context, confirm string) error {
+	conn, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkWipeAllowed(conn.cfg.Env); err != nil {
+		return err
+	}
+	name, err := databaseName(ctx, conn.db)
+	if err != nil {
+		return err
+	}
+	if confirm != name {
+		return fmt.Errorf("pass --confirm %s to wipe the %s database %q", name, query.ActiveDialect().Name(), name)
+	}
+
+	log.Printf("🧹 Wiping %s database %q", query.ActiveDialect().Name(), name)
+	dropped, err := persistence.NewSchemaRepository(conn.db.DB()).DropAllTables(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("✅ Dropped %d tables", len(dropped))
+	return nil
+}
+
+func runDBWipe(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("db wipe", flag.ExitOnError)
+	confirm := fs.String("confirm", "", "name of the database to wipe, as a safeguard")
+	_ = fs.Parse(args)
+	return wipe(ctx, *confirm)
+}
+
+func runDBReset(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("db reset", flag.ExitOnError)
+	confirm := fs.String("confirm", "", "name of the database to reset, as a safeguard")
+	seed := fs.Bool("seed", false, "load the demo dataset after the reset")
+	owner := fs.String("owner", os.Getenv("SETUP_ADMIN_EMAIL"), "user owning the demo data (default $SETUP_ADMIN_EMAIL)")
+	_ = fs.Parse(args)
+	if *seed && *owner == "" {
+		return fmt.Errorf("--seed needs the user owning the demo data: pass --owner or set SETUP_ADMIN_EMAIL (SETUP_ADMIN_* also creates that administrator during the reset)")
+	}
+
+	if err := wipe(ctx, *confirm); err != nil {
+		return err
+	}
+	svcMgr, err := startServices(ctx, true)
+	if err != nil {
+		return err
+	}
+	log.Println("✅ System tables and data recreated")
+	if !*seed {
+		return nil
+	}
+
+	user, err := lookupUser(ctx, svcMgr, *owner)
+	if err != nil {
+		return err
+	}
+	if !constants.IsSuperUser(user.ProfileID) {
+		return fmt.Errorf("the demo data owner %s must be a system administrator", *owner)
+	}
+	if err := svcMgr.SampleData.LoadSampleData(ctx, user); err != nil {
+		return fmt.Errorf("failed to load demo data: %w", err)
+	}
+	log.Printf("🌱 Loaded demo data owned by %s", *owner)
+	return nil
+}
@@ -0,0 +1,119 @@
+// Command nexusctl is the operator CLI of a NexusCRM deployment. It connects to the database
+// with the server's own configuration (the environment and .env files: DB_DIALECT, TIDB_*,
+// POSTGRES_DSN, SQLITE_PATH, secret references and JWT keys) and runs maintenance tasks:
+//
+//	nexusctl db wipe --confirm <database>      drop every table
+//	nexusctl db reset --confirm <database> [--seed] [--owner <email>]
+//	                                           wipe, bootstrap and optionally load demo data
+//	nexusctl auth issue-token <user id|email>  start a session and print its token
+//	nexusctl metadata export [-o file]         write custom objects, rules, flows and dashboards
+//	nexusctl metadata import <file|->          apply a bundle written by export
+//	nexusctl user create --email <email> --name <name> [--profile <id>]
+//
+// Destructive commands only run where GO_ENV is listed in NEXUSCTL_WIPE_ENVS.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/bootstrap"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/internal/infrastructure/secretstore"
+)
+
+// command is a nexusctl subcommand, run with the arguments that follow its name
+type command struct {
+	name    string // "group verb"
+	summary string
+	run     func(ctx context.Context, args []string) error
+}
+
+var commands = []command{
+	{"db wipe", "drop every table of the database", runDBWipe},
+	{"db reset", "wipe the database, recreate the system tables and optionally load demo data", runDBReset},
+	{"auth issue-token", "start a session for a user and print its token", runIssueToken},
+	{"metadata export", "write the custom metadata of the org as a JSON bundle", runMetadataExport},
+	{"metadata import", "apply a metadata bundle", runMetadataImport},
+	{"user create", "create a user", runUserCreate},
+}
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 3 {
+		usage()
+		os.Exit(2)
+	}
+	name := os.Args[1] + " " + os.Args[2]
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		err := cmd.run(ctx, os.Args[3:])
+		stop()
+		if err != nil {
+			log.Fatalf("❌ %s: %v", name, err)
+		}
+		return
+	}
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	var b strings.Builder
+	b.WriteString("Usage: nexusctl <command> [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(&b, "  %-18s %s\n", cmd.name, cmd.summary)
+	}
+	b.WriteString("\nRun nexusctl <command> -h for the flags of a command.\n")
+	fmt.Fprint(os.Stderr, b.String())
+}
+
+// connection is the database nexusctl works on and the configuration it was opened with
+type connection struct {
+	db       *database.TiDBConnection
+	cfg      *config.Config
+	resolver *secretstore.Resolver
+}
+
+// connect loads the server's configuration, .env files included, and opens its database
+func connect(ctx context.Context) (*connection, error) {
+	cfg, resolver, err := bootstrap.LoadConfig(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.GetInstance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return &connection{db: db, cfg: cfg, resolver: resolver}, nil
+}
+
+// startServices connects and brings the schema and system data up to date, as the server
+// does at startup, and returns the services; force reruns every step. Background workers
+// are not started.
+func startServices(ctx context.Context, force bool) (*services.ServiceManager, error) {
+	conn, err := connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts := bootstrap.StartupOptionsFrom(conn.cfg)
+	opts.Force = opts.Force || force
+	opts.Configure = func(svcMgr *services.ServiceManager) {
+		svcMgr.System.SetSecrets(conn.resolver)
+	}
+	svcMgr, _, err := bootstrap.Startup(ctx, conn.db, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bootstrap: %w", err)
+	}
+	return svcMgr, nil
+}
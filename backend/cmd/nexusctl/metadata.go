@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// cliAdmin is the session metadata imports run as
+var cliAdmin = &models.UserSession{
+	ID:            "system-nexusctl",
+	Name:          "nexusctl",
+	ProfileID:     constants.ProfileSystemAdmin,
+	IsSystemAdmin: true,
+}
+
+func runMetadataExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("metadata export", flag.ExitOnError)
+	out := fs.String("o", "", "file to write (default stdout)")
+	_ = fs.Parse(args)
+
+	svcMgr, err := startServices(ctx, false)
+	if err != nil {
+		return err
+	}
+	bundle, err := svcMgr.Metadata.ExportMetadataBundle(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return err
+	}
+	log.Printf("✅ Exported %d objects, %d validation rules, %d flows and %d dashboards to %s",
+		len(bundle.Objects), len(bundle.ValidationRules), len(bundle.Flows), len(bundle.Dashboards), *out)
+	return nil
+}
+
+func runMetadataImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("metadata import", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: nexusctl metadata import <file|->")
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	var data []byte
+	var err error
+	if fs.Arg(0) == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+	var bundle models.MetadataBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("invalid metadata bundle: %w", err)
+	}
+
+	svcMgr, err := startServices(ctx, false)
+	if err != nil {
+		return err
+	}
+	result, err := svcMgr.Metadata.ImportMetadataBundle(ctx, &bundle, cliAdmin)
+	if err != nil {
+		return err
+	}
+	for _, line := range []struct {
+		kind  string
+		count models.MetadataImportCount
+	}{
+		{"objects", result.Objects},
+		{"fields", result.Fields},
+		{"validation rules", result.ValidationRules},
+		{"flows", result.Flows},
+		{"dashboards", result.Dashboards},
+	} {
+		log.Printf("   %-17s %d created, %d updated", line.kind, line.count.Created, line.count.Updated)
+	}
+	for _, e := range result.Errors {
+		log.Printf("⚠️  %s", e)
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%d components failed to import", len(result.Errors))
+	}
+	log.Println("✅ Metadata imported")
+	return nil
+}
@@ -0,0 +1,128 @@
+// Command seed_demo fills a running NexusCRM server with linked demo data (accounts,
+// contacts, opportunities across the sales stages and tasks) through the admin API, for
+// demos and load testing. Missing demo objects are created by the server first.
+//
+//	SEED_DEMO_EMAIL=admin@test.com SEED_DEMO_PASSWORD='Admin123!' \
+//	    go run ./cmd/seed_demo -url http://localhost:3001 -accounts 1000 -seed 42
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+func main() {
+	baseURL := flag.String("url", "http://localhost:3001", "server URL")
+	email := flag.String("email", os.Getenv("SEED_DEMO_EMAIL"), "system administrator email (default $SEED_DEMO_EMAIL)")
+	var req models.SampleDataRequest
+	flag.IntVar(&req.Accounts, "accounts", 0, "accounts to create (default 20)")
+	flag.IntVar(&req.ContactsPerAccount, "contacts", 0, "contacts per account (default 3)")
+	flag.IntVar(&req.OpportunitiesPerAccount, "opportunities", 0, "opportunities per account (default 2)")
+	flag.IntVar(&req.TasksPerRecord, "tasks", 0, "tasks per account and per opportunity (default 2)")
+	flag.Int64Var(&req.Seed, "seed", 0, "seed; the same seed generates the same values (default random)")
+	flag.IntVar(&req.BatchSize, "batch", 0, "records inserted per batch (default 200)")
+	wait := flag.Bool("wait", true, "wait for the job to finish")
+	flag.Parse()
+
+	// The password is read from the environment only, keeping it out of the process list
+	password := os.Getenv("SEED_DEMO_PASSWORD")
+	if *email == "" || password == "" {
+		log.Fatal("🛑 Set SEED_DEMO_EMAIL (or -email) and SEED_DEMO_PASSWORD to a system administrator's login")
+	}
+
+	c := &client{baseURL: strings.TrimRight(*baseURL, "/"), http: &http.Client{Timeout: 60 * time.Second}}
+	var login struct {
+		Token string `json:"token"`
+	}
+	if err := c.call(http.MethodPost, "/api/auth/login", map[string]string{"email": *email, "password": password}, &login); err != nil {
+		log.Fatalf("❌ Login failed: %v", err)
+	}
+	c.token = login.Token
+
+	var queued struct {
+		Data models.SystemAsyncJob `json:"data"`
+	}
+	if err := c.call(http.MethodPost, "/api/admin/sample-data", req, &queued); err != nil {
+		log.Fatalf("❌ Failed to queue sample data: %v", err)
+	}
+	job := queued.Data
+	log.Printf("🌱 Queued sample data job %s: %d records", job.ID, job.TotalCount)
+	if !*wait {
+		return
+	}
+
+	started := time.Now()
+	for job.Status == string(constants.AsyncJobStatusQueued) || job.Status == string(constants.AsyncJobStatusRunning) {
+		time.Sleep(2 * time.Second)
+		var polled struct {
+			Data models.SystemAsyncJob `json:"data"`
+		}
+		if err := c.call(http.MethodGet, "/api/metadata/async-jobs/"+job.ID, nil, &polled); err != nil {
+			log.Fatalf("❌ Failed to poll job %s: %v", job.ID, err)
+		}
+		job = polled.Data
+		log.Printf("   %d/%d records, %d failed", job.ProcessedCount, job.TotalCount, job.FailedCount)
+	}
+
+	elapsed := time.Since(started).Round(time.Second)
+	if job.Status != string(constants.AsyncJobStatusCompleted) {
+		msg := "unknown error"
+		if job.ErrorMessage != nil {
+			msg = *job.ErrorMessage
+		}
+		log.Fatalf("❌ Sample data job %s %s after %s: %s", job.ID, job.Status, elapsed, msg)
+	}
+	log.Printf("✅ Created %d records in %s (%d failed)", job.ProcessedCount, elapsed, job.FailedCount)
+}
+
+// client calls the REST API with a session token
+type client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// call sends body as JSON and decodes the response into out
+func (c *client) call(method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	httpReq, err := http.NewRequest(method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/nexuscrm/backend/internal/config"
+)
+
+// newHTTPServer builds the REST server with connection timeouts that keep slow or idle
+// clients from holding connections open. The read and write timeouts are off by default:
+// the body limit middleware bounds bodies, and they would cut off streaming responses
+// (agent chat, MCP notifications).
+func newHTTPServer(addr string, handler http.Handler, cfg config.HTTP) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
@@ -13,22 +16,40 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
 	"github.com/nexuscrm/backend/internal/bootstrap"
+	"github.com/nexuscrm/backend/internal/domain/events"
 	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/internal/interfaces/grpcapi"
+	"github.com/nexuscrm/backend/internal/interfaces/mcpapi"
 	"github.com/nexuscrm/backend/internal/interfaces/middleware"
 	"github.com/nexuscrm/backend/internal/interfaces/rest"
+	"github.com/nexuscrm/backend/pkg/extension"
 	"github.com/nexuscrm/mcp/pkg/client"
 	"github.com/nexuscrm/mcp/pkg/contextstore"
 	"github.com/nexuscrm/mcp/pkg/mcp"
-	mcp_models "github.com/nexuscrm/mcp/pkg/models"
 	mcp_server "github.com/nexuscrm/mcp/pkg/server"
 	"github.com/nexuscrm/shared/pkg/constants"
+	"google.golang.org/grpc"
 )
 
 func main() {
-	// Get port from environment
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "3001" // Default to 3001 (standard NexusCRM port)
+	// Read flags, the environment and .env files, resolve credentials held as secret
+	// references (vault:..., aws:..., env:...) and validate the result before anything starts
+	cfg, secretResolver, err := bootstrap.LoadConfig(context.Background(), os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	port := cfg.Server.Port
+	log.Printf("⚙️  Environment: %s, database: %s", cfg.Env, cfg.Database.DialectName())
+	log.Printf("🔐 Secret stores: %v", secretResolver.Stores())
+
+	// Extensions built as Go plugins join those compiled in; they are installed before the
+	// services start so their save hooks see every write
+	if cfg.Server.ExtensionsDir != "" {
+		names, err := extension.Default().LoadPlugins(cfg.Server.ExtensionsDir)
+		if err != nil {
+			log.Fatalf("Failed to load extensions: %v", err)
+		}
+		log.Printf("🧩 Loaded %d extension plugin(s) from %s: %v", len(names), cfg.Server.ExtensionsDir, names)
 	}
 
 	// Initialize database connection
@@ -38,60 +59,23 @@ func main() {
 	}
 	log.Println("✅ Database connection established")
 
-	// Initialize core schema - SchemaManager auto-registers all tables in _System_Table during creation
-	if err := bootstrap.InitializeSchema(db); err != nil {
-		log.Fatalf("Failed to initialize schema: %v", err)
-	}
-
-	// Initialize service manager
-	svcMgr := services.NewServiceManager(db)
-	log.Println("🔧 Service manager initialized")
-
-	// Refresh metadata cache - Load all metadata before running bootstrap scripts
-	if err := svcMgr.RefreshMetadataCache(); err != nil {
-		log.Printf("⚠️  Warning: Failed to refresh metadata cache: %v", err)
-	} else {
-		log.Println("📦 Metadata cache loaded")
-	}
-
-	// Initialize system data (profiles, admin user, etc.)
-	if err := bootstrap.InitializeSystemData(svcMgr.System); err != nil {
-		log.Fatalf("Failed to initialize system data: %v", err)
-	}
-
-	// Initialize standard actions - Ensures Edit/Delete actions exist for core objects
-	if err := bootstrap.InitializeStandardActions(svcMgr.Metadata); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize standard actions: %v", err)
-	}
-
-	// Initialize default permissions for all profiles
-	if err := bootstrap.InitializePermissions(svcMgr.Permissions, svcMgr.Metadata); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize permissions: %v", err)
-	}
-
-	// Initialize standard themes
-	if err := bootstrap.InitializeThemes(svcMgr); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize themes: %v", err)
-	}
-
-	// Initialize UI components
-	if err := bootstrap.InitializeUIComponents(svcMgr); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize UI components: %v", err)
-	}
-
-	// Initialize setup pages
-	if err := bootstrap.InitializeSetupPages(svcMgr); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize setup pages: %v", err)
+	// Bring the schema and seeded system data up to date. Independent steps run in parallel,
+	// steps whose input is unchanged since their last run are skipped (BOOTSTRAP_FORCE=true
+	// reruns them), and an interrupt during startup stops the steps not yet started.
+	startupCtx, stopStartup := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	startupOpts := bootstrap.StartupOptionsFrom(cfg)
+	startupOpts.Configure = func(svcMgr *services.ServiceManager) {
+		svcMgr.System.SetSecrets(secretResolver)
 	}
-
-	// Initialize flows
-	if err := bootstrap.InitializeFlows(svcMgr.Metadata); err != nil {
-		log.Printf("⚠️  Warning: Failed to initialize flows: %v", err)
+	svcMgr, _, err := bootstrap.Startup(startupCtx, db, startupOpts)
+	stopStartup()
+	if err != nil {
+		log.Fatalf("Failed to bootstrap: %v", err)
 	}
 
 	// Run startup assertions to detect design violations
 	// By default, violations are fatal (strict mode). Set SKIP_ASSERTIONS=true to skip.
-	if os.Getenv("SKIP_ASSERTIONS") != "true" {
+	if !cfg.Server.SkipAssertions {
 		if _, err := bootstrap.RunAssertions(db, true); err != nil {
 			log.Fatalf("❌ Startup assertions failed: %v", err)
 		}
@@ -99,11 +83,26 @@ func main() {
 		log.Println("⚠️  Skipping startup assertions (SKIP_ASSERTIONS=true)")
 	}
 
+	// Keep checking for drift after startup; system tables are compared with their definitions
+	svcMgr.SchemaDrift.SetSystemTables(bootstrap.GetSystemTableDefinitions())
+
+	// Browser security settings: allowed origins and cookie sessions
+	sessionCookies := middleware.SessionCookiesFrom(cfg.HTTP)
+	corsConfig := middleware.CorsConfigFrom(cfg.HTTP)
+	if len(corsConfig.AllowedOrigins) == 0 {
+		log.Println("🔒 CORS: no allowed origins configured, only same-origin browser requests are accepted")
+	}
+	securityHeaders := middleware.SecurityHeadersConfigFrom(cfg.HTTP)
+	bodyLimits := middleware.BodyLimitsFrom(cfg.HTTP)
+
 	// Create Gin router
 	router := gin.Default()
 
-	// CORS middleware - Allow credentials from any origin
-	router.Use(middleware.Cors())
+	// CORS middleware - Only configured origins may send credentials
+	router.Use(middleware.Cors(corsConfig))
+	router.Use(middleware.SecurityHeaders(securityHeaders))
+	router.Use(middleware.LimitBody(bodyLimits))
+	router.Use(middleware.Locale())
 
 	// Health check
 	router.GET("/health", func(c *gin.Context) {
@@ -133,6 +132,7 @@ func main() {
 	// Initialize handlers
 	formulaHandler := rest.NewFormulaHandler()
 	authHandler := rest.NewAuthHandler(svcMgr)
+	authHandler.SetSessionCookies(sessionCookies)
 	userHandler := rest.NewUserHandler(svcMgr) // UserHandler init
 	metadataHandler := rest.NewMetadataHandler(svcMgr)
 	uiHandler := rest.NewUIHandler(svcMgr) // Add UIHandler initialization
@@ -146,60 +146,122 @@ func main() {
 	feedHandler := rest.NewFeedHandler(svcMgr)
 	notificationHandler := rest.NewNotificationHandler(svcMgr)
 	roleHandler := rest.NewRoleHandler(svcMgr)
+	savedSearchHandler := rest.NewSavedSearchHandler(svcMgr)
+	dataQualityHandler := rest.NewDataQualityHandler(svcMgr)
+	reportHandler := rest.NewReportHandler(svcMgr)
+	chartHandler := rest.NewChartHandler(svcMgr)
+	recordTypeHandler := rest.NewRecordTypeHandler(svcMgr)
+	picklistValueHandler := rest.NewPicklistValueHandler(svcMgr)
+	globalValueSetHandler := rest.NewGlobalValueSetHandler(svcMgr)
+	autoNumberHandler := rest.NewAutoNumberHandler(svcMgr)
+	customMetadataHandler := rest.NewCustomMetadataHandler(svcMgr)
+	customSettingHandler := rest.NewCustomSettingHandler(svcMgr)
+	namedCredentialHandler := rest.NewNamedCredentialHandler(svcMgr)
+	externalObjectHandler := rest.NewExternalObjectHandler(svcMgr)
+	slaHandler := rest.NewSLAHandler(svcMgr)
+	stageHistoryHandler := rest.NewStageHistoryHandler(svcMgr)
+	timelineHandler := rest.NewTimelineHandler(svcMgr)
+	forecastHandler := rest.NewForecastHandler(svcMgr)
+	campaignHandler := rest.NewCampaignHandler(svcMgr)
+	orderHandler := rest.NewOrderHandler(svcMgr)
+	entitlementHandler := rest.NewEntitlementHandler(svcMgr)
+	knowledgeHandler := rest.NewKnowledgeHandler(svcMgr)
+	surveyHandler := rest.NewSurveyHandler(svcMgr)
+	setupHandler := rest.NewSetupHandler(svcMgr)
+	escalationHandler := rest.NewEscalationHandler(svcMgr)
+	scriptHandler := rest.NewScriptHandler(svcMgr)
+	customEndpointHandler := rest.NewCustomEndpointHandler(svcMgr)
+	batchJobHandler := rest.NewBatchJobHandler(svcMgr)
+	stateMachineHandler := rest.NewStateMachineHandler(svcMgr)
+	archiveHandler := rest.NewArchiveHandler(svcMgr)
+	syncHandler := rest.NewSyncHandler(svcMgr, cfg.HTTP.FrontendURL)
+	telephonyHandler := rest.NewTelephonyHandler(svcMgr)
+	integrationHookHandler := rest.NewIntegrationHookHandler(svcMgr)
+	inboundHookHandler := rest.NewInboundHookHandler(svcMgr)
+	documentHandler := rest.NewDocumentHandler(svcMgr)
+	portalHandler := rest.NewPortalHandler(svcMgr)
+	translationHandler := rest.NewTranslationHandler(svcMgr)
+	changeDataCaptureHandler := rest.NewChangeDataCaptureHandler(svcMgr)
+	setupAuditHandler := rest.NewSetupAuditHandler(svcMgr)
+	extensionHandler := rest.NewExtensionHandler(svcMgr)
+	graphQLHandler := rest.NewGraphQLHandler(svcMgr)
+	odataHandler := rest.NewODataHandler(svcMgr)
 	// Initialize Agent Handler (MCP-based)
-	// Function to extract and map backend user to MCP user
-	agentUserExtractor := func(c *gin.Context) *mcp_models.UserSession {
-		user := rest.GetUserFromContext(c)
-		if user == nil {
-			return nil
-		}
-		// Map backend user to MCP user
-		return &mcp_models.UserSession{
-			ID:            user.ID,
-			Name:          user.Name,
-			Email:         user.Email,
-			ProfileID:     user.ProfileID,
-			IsSystemAdmin: user.IsSuperUser(),
-		}
-	}
+	// The MCP user session is the backend session, so in-process tool calls carry the
+	// caller's full identity, including their role
+	agentUserExtractor := rest.GetUserFromContext
 	// Initialize MCP Handler
-	// Decoupled: Create standalone ToolBus using NexusClient
-	apiBaseURL := "http://localhost:3001"
-	if url := os.Getenv("API_BASE_URL"); url != "" {
-		apiBaseURL = url
+	// NexusClient reaches the REST API; the in-process client below falls back to it
+	apiBaseURL := "http://localhost:" + port
+	if cfg.Server.APIBaseURL != "" {
+		apiBaseURL = cfg.Server.APIBaseURL
 	}
 	mcpClient := client.NewNexusClient(apiBaseURL)
 
-	// SHARED Context Store with Persistence
-	// Use data/context_store.json for persistence (relative to CWD: backend)
-	persistencePath := "data/context_store.json"
-	sharedContextStore := contextstore.NewContextStore(persistencePath)
+	// SHARED Context Store, persisted per user in _System_AI_Context_Item so every
+	// instance sees the same agent context
+	sharedContextStore := contextstore.NewPersistentContextStore(mcpapi.NewContextPersister(svcMgr.AIHistory))
+
+	// Embedded in the backend, the ToolBus calls services directly rather than looping back
+	// over HTTP; MCP_TOOLBUS_MODE=http keeps every call on the REST API
+	var toolBusAPI client.API = mcpapi.NewDirectClient(svcMgr, mcpClient)
+	if cfg.HTTP.MCPToolbusMode == "http" {
+		toolBusAPI = mcpClient
+	}
 
-	toolBus := mcp_server.NewToolBusService(mcpClient, sharedContextStore)
-	mcpHandler := mcp_server.NewHandler(toolBus)
+	toolBus := mcp_server.NewToolBusService(toolBusAPI, sharedContextStore)
+	// Audit every tool call from MCP clients and the agent in _System_Log
+	toolBus.SetAuditSink(mcp_server.ToolAuditFunc(func(ctx context.Context, call mcp_server.ToolCallAudit) {
+		level := constants.LogLevelInfo
+		if call.Status != mcp_server.ToolCallSucceeded {
+			level = constants.LogLevelWarning
+		}
+		details, _ := json.Marshal(call)
+		detailsStr := string(details)
+		message := fmt.Sprintf("%s called %s: %s", call.UserID, call.Tool, call.Status)
+		_ = svcMgr.System.LogEvent(context.WithoutCancel(ctx), level, "mcp", message, &detailsStr)
+	}))
+	// MCP tools of extensions are served alongside the built-in ones
+	for _, tool := range svcMgr.Extensions.Registry().Tools() {
+		if err := toolBus.RegisterTool(tool.Tool, mcp_server.ToolHandler(tool.Handler)); err != nil {
+			log.Fatalf("Extension '%s': %v", tool.Extension, err)
+		}
+	}
+	mcpResources := mcp_server.NewResourceService(toolBusAPI)
+	mcpHandler := mcp_server.NewHandler(toolBus, mcpResources)
+
+	// Push record changes to MCP clients subscribed to the record's resource
+	for _, eventType := range []events.EventType{events.RecordCreated, events.RecordUpdated, events.RecordDeleted} {
+		svcMgr.EventBus.Subscribe(eventType, func(ctx context.Context, payload interface{}) error {
+			if p, ok := payload.(services.RecordEventPayload); ok {
+				mcpResources.RecordChanged(p.ObjectAPIName, p.Record.GetString(constants.FieldID))
+			}
+			return nil
+		})
+	}
 
 	// Inject shared store into Agent Handler too
-	agentHandler := mcp_server.NewAgentHandler(agentUserExtractor, sharedContextStore)
+	agentHandler := mcp_server.NewAgentHandler(agentUserExtractor, toolBus, sharedContextStore)
 
 	// Initialize middleware
-	requireAuth := middleware.RequireAuth(svcMgr.Auth)
+	requireAuth := middleware.RequireAuth(svcMgr.Auth, sessionCookies)
+	requirePortalAuth := middleware.RequirePortalAuth(svcMgr.Auth, sessionCookies)
 	requireSystemAdmin := middleware.RequireSystemAdmin()
 
 	// MCP Endpoint (Model Context Protocol)
-	// Supports JSON-RPC 2.0 over HTTP
+	// Supports JSON-RPC 2.0 over HTTP (POST) and a notification stream per session (GET)
 	// 1. Require Auth (Validates Bearer token)
 	// 2. Propagate User Context (Gin -> Stdlib Context) -> WrapH(mcpHandler)
-	router.POST("/mcp", requireAuth, func(c *gin.Context) {
+	serveMCP := func(c *gin.Context) {
 		// Extract user from Gin context (set by RequireAuth)
-		if user, exists := c.Get(constants.ContextKeyUser); exists {
+		if user := agentUserExtractor(c); user != nil {
 			ctx := c.Request.Context()
-			// Inject into standard context
-			ctx = context.WithValue(ctx, constants.ContextKeyUser, user)
+			// Inject into standard context as the MCP user session, which the
+			// ToolBus checks before running admin-only tools
+			ctx = context.WithValue(ctx, mcp.ContextKeyUser, user)
 
-			// Inject Auth Token (needed for ContextStore)
-			authHeader := c.GetHeader(constants.HeaderAuthorization)
-			if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
-				token := authHeader[7:]
+			// Inject Auth Token (needed for ContextStore), from the header or session cookie
+			if token := c.GetString(constants.ContextKeyToken); token != "" {
 				ctx = context.WithValue(ctx, mcp.ContextKeyAuthToken, token)
 			}
 
@@ -207,11 +269,48 @@ func main() {
 		}
 		// Forward to standard HTTP handler
 		mcpHandler.ServeHTTP(c.Writer, c.Request)
-	})
+	}
+	router.POST("/mcp", requireAuth, serveMCP)
+	router.GET("/mcp", requireAuth, serveMCP)
+
+	// Public signing keys, for services that verify NexusCRM tokens
+	router.GET("/.well-known/jwks.json", authHandler.GetJWKS)
+
+	// Inbound webhooks; deliveries authenticate with the hook's secret
+	router.POST("/hooks/:slug", inboundHookHandler.Receive)
+
+	// SCIM 2.0 provisioning for identity providers, enabled by SCIM_TOKEN
+	if scimToken := cfg.HTTP.SCIMToken; scimToken != "" {
+		scimHandler := rest.NewSCIMHandler(svcMgr, scimToken)
+		scim := router.Group(services.SCIMBasePath, scimHandler.Authenticate)
+		{
+			scim.GET("/ServiceProviderConfig", scimHandler.GetServiceProviderConfig)
+			scim.GET("/Users", scimHandler.ListUsers)
+			scim.POST("/Users", scimHandler.CreateUser)
+			scim.GET("/Users/:id", scimHandler.GetUser)
+			scim.PUT("/Users/:id", scimHandler.ReplaceUser)
+			scim.PATCH("/Users/:id", scimHandler.PatchUser)
+			scim.DELETE("/Users/:id", scimHandler.DeleteUser)
+			scim.GET("/Groups", scimHandler.ListGroups)
+			scim.POST("/Groups", scimHandler.CreateGroup)
+			scim.GET("/Groups/:id", scimHandler.GetGroup)
+			scim.PUT("/Groups/:id", scimHandler.ReplaceGroup)
+			scim.PATCH("/Groups/:id", scimHandler.PatchGroup)
+			scim.DELETE("/Groups/:id", scimHandler.DeleteGroup)
+		}
+		log.Println("🪪 SCIM provisioning enabled at " + services.SCIMBasePath)
+	}
 
 	// API routes
 	api := router.Group("/api")
 	{
+		// Public first-run setup: open only until the first administrator exists
+		firstRunSetup := api.Group("/setup")
+		{
+			firstRunSetup.GET("", setupHandler.GetState)
+			firstRunSetup.POST("", setupHandler.Complete)
+		}
+
 		// Public Auth routes (no authentication required)
 		auth := api.Group("/auth")
 		{
@@ -225,12 +324,17 @@ func main() {
 			auth.POST("/register", requireAuth, requireSystemAdmin, userHandler.Register)
 			auth.PUT("/users/:id", requireAuth, requireSystemAdmin, userHandler.UpdateUser)
 			auth.DELETE("/users/:id", requireAuth, requireSystemAdmin, userHandler.DeleteUser)
+			auth.GET("/users/:id/ownership", requireAuth, requireSystemAdmin, userHandler.GetUserOwnership)
+			auth.POST("/users/:id/deactivate", requireAuth, requireSystemAdmin, userHandler.DeactivateUser)
 			auth.GET("/users", requireAuth, userHandler.GetUsers)
 			auth.GET("/profiles", requireAuth, userHandler.GetProfiles)
 			auth.GET("/profiles/:id/permissions", requireAuth, userHandler.GetProfilePermissions)
 			auth.PUT("/profiles/:id/permissions", requireAuth, requireSystemAdmin, userHandler.UpdateProfilePermissions)
 			auth.GET("/profiles/:id/permissions/fields", requireAuth, userHandler.GetProfileFieldPermissions)
 			auth.PUT("/profiles/:id/permissions/fields", requireAuth, requireSystemAdmin, userHandler.UpdateProfileFieldPermissions)
+			auth.GET("/profiles/:id/query-limits", requireAuth, requireSystemAdmin, userHandler.GetProfileQueryLimits)
+			auth.PUT("/profiles/:id/query-limits", requireAuth, requireSystemAdmin, userHandler.UpdateProfileQueryLimits)
+			auth.DELETE("/profiles/:id/query-limits", requireAuth, requireSystemAdmin, userHandler.DeleteProfileQueryLimits)
 
 			// Permission Set permissions
 			auth.POST("/permission-sets", requireAuth, requireSystemAdmin, userHandler.CreatePermissionSet)
@@ -254,6 +358,18 @@ func main() {
 			auth.DELETE("/roles/:id", requireAuth, requireSystemAdmin, roleHandler.DeleteRole)
 		}
 
+		// GraphQL API, schema generated per caller from object metadata
+		api.POST("/graphql", requireAuth, graphQLHandler.Execute)
+
+		// Read-only OData v4 feed for Excel and Power BI, which sign in with Basic auth
+		odata := api.Group("/odata")
+		odata.Use(middleware.AllowBasicToken("NexusCRM"), requireAuth)
+		{
+			odata.GET("", odataHandler.ServiceDocument)
+			odata.GET("/", odataHandler.ServiceDocument)
+			odata.GET("/:resource", odataHandler.Resource)
+		}
+
 		// Protected Formula routes
 		formula := api.Group("/formula")
 		formula.Use(requireAuth)
@@ -272,6 +388,49 @@ func main() {
 		{
 			admin.GET("/tables", adminHandler.GetTableRegistry)
 			admin.POST("/validate-schema", adminHandler.ValidateSchema)
+			admin.GET("/schema-drift", adminHandler.GetSchemaDrift)
+			admin.POST("/schema-drift/repair", adminHandler.RepairSchemaDrift)
+			admin.GET("/index-advisor", adminHandler.GetIndexAdvisor)
+			admin.GET("/read-replica", adminHandler.GetReadReplica)
+			admin.GET("/search/status", adminHandler.GetSearchStatus)
+			admin.POST("/search/reindex", adminHandler.ReindexSearch)
+			admin.POST("/mass-transfer", adminHandler.MassTransfer)
+			admin.POST("/mass-update", adminHandler.MassUpdate)
+			admin.POST("/sample-data", adminHandler.GenerateSampleData)
+			admin.GET("/config", adminHandler.GetConfigs)
+			admin.PUT("/config/:key", adminHandler.SaveConfig)
+			admin.DELETE("/config/:key", adminHandler.DeleteConfig)
+
+			// Change data capture stream and consumer offsets
+			admin.GET("/cdc/events", changeDataCaptureHandler.GetChangeEvents)
+			admin.GET("/cdc/consumers", changeDataCaptureHandler.GetConsumers)
+			admin.GET("/cdc/consumers/:consumer/events", changeDataCaptureHandler.GetConsumerChangeEvents)
+			admin.PUT("/cdc/consumers/:consumer/offset", changeDataCaptureHandler.CommitOffset)
+			admin.DELETE("/cdc/consumers/:consumer", changeDataCaptureHandler.DeleteConsumer)
+
+			// Setup audit trail of metadata changes
+			admin.GET("/setup-audit", setupAuditHandler.GetSetupAudit)
+
+			// Installed extensions and what they registered
+			admin.GET("/extensions", extensionHandler.ListExtensions)
+		}
+
+		// Routes of extensions, under /api/ext/<extension>
+		if n := extensionHandler.MountRoutes(api, requireAuth, requireSystemAdmin); n > 0 {
+			log.Printf("🧩 Serving %d extension route(s) under /api%s", n, rest.ExtensionsBasePath)
+		}
+
+		// Customer portal (portal users only; internal sessions are rejected)
+		portal := api.Group("/portal")
+		{
+			portal.POST("/auth/login", authHandler.PortalLogin)
+			portal.POST("/auth/logout", requirePortalAuth, authHandler.PortalLogout)
+			portal.POST("/auth/change-password", requirePortalAuth, authHandler.ChangePassword)
+			portal.GET("/me", requirePortalAuth, portalHandler.GetMe)
+			portal.GET("/objects", requirePortalAuth, portalHandler.GetObjects)
+			portal.GET("/data/:objectApiName", requirePortalAuth, portalHandler.GetRecords)
+			portal.GET("/data/:objectApiName/:id", requirePortalAuth, portalHandler.GetRecord)
+			portal.POST("/data/:objectApiName", requirePortalAuth, portalHandler.CreateRecord)
 		}
 
 		// Protected Metadata routes
@@ -291,12 +450,180 @@ func main() {
 			metadata.GET("/objects", metadataHandler.GetSchemas)
 			metadata.POST("/objects", requireSystemAdmin, metadataHandler.CreateSchema)
 			metadata.GET("/objects/:apiName", metadataHandler.GetSchema)
+			metadata.GET("/objects/:apiName/describe-full", metadataHandler.DescribeFull)
 			metadata.PATCH("/objects/:apiName", requireSystemAdmin, metadataHandler.UpdateSchema)
 			metadata.DELETE("/objects/:apiName", requireSystemAdmin, metadataHandler.DeleteSchema)
 			metadata.POST("/objects/:apiName/fields", requireSystemAdmin, metadataHandler.CreateField)
 			metadata.PATCH("/objects/:apiName/fields/:fieldApiName", requireSystemAdmin, metadataHandler.UpdateField)
 			metadata.DELETE("/objects/:apiName/fields/:fieldApiName", requireSystemAdmin, metadataHandler.DeleteField)
+
+			// Where-used analysis: /objects/<object>/dependencies or /fields/<object>.<field>/dependencies
+			metadata.GET("/:type/:apiName/dependencies", requireSystemAdmin, metadataHandler.GetDependencies)
+
+			// Deleted objects and fields, restorable until their grace period ends
+			metadata.GET("/deleted", requireSystemAdmin, metadataHandler.GetDeletedMetadata)
+			metadata.POST("/deleted/:id/restore", requireSystemAdmin, metadataHandler.RestoreDeletedMetadata)
+			metadata.DELETE("/deleted/:id", requireSystemAdmin, metadataHandler.PurgeDeletedMetadata)
+
+			// Picklist Values
+			metadata.GET("/objects/:apiName/fields/:fieldApiName/picklist-values", picklistValueHandler.GetPicklistValues)
+			metadata.POST("/objects/:apiName/fields/:fieldApiName/picklist-values", requireSystemAdmin, picklistValueHandler.AddPicklistValue)
+			metadata.POST("/objects/:apiName/fields/:fieldApiName/picklist-values/activate", requireSystemAdmin, picklistValueHandler.ActivatePicklistValue)
+			metadata.POST("/objects/:apiName/fields/:fieldApiName/picklist-values/deactivate", requireSystemAdmin, picklistValueHandler.DeactivatePicklistValue)
+			metadata.PUT("/objects/:apiName/fields/:fieldApiName/picklist-values/order", requireSystemAdmin, picklistValueHandler.ReorderPicklistValues)
+			metadata.POST("/objects/:apiName/fields/:fieldApiName/picklist-values/replace", requireSystemAdmin, picklistValueHandler.ReplacePicklistValue)
+			metadata.GET("/async-jobs/:id", requireSystemAdmin, picklistValueHandler.GetAsyncJob)
+			metadata.POST("/async-jobs/:id/abort", requireSystemAdmin, batchJobHandler.AbortAsyncJob)
+			metadata.PUT("/objects/:apiName/fields/:fieldApiName/value-set", requireSystemAdmin, globalValueSetHandler.SetFieldValueSet)
+
+			// Auto Numbers
+			metadata.GET("/objects/:apiName/fields/:fieldApiName/auto-number", autoNumberHandler.GetAutoNumber)
+			metadata.PATCH("/objects/:apiName/fields/:fieldApiName/auto-number", requireSystemAdmin, autoNumberHandler.UpdateAutoNumber)
+			metadata.POST("/objects/:apiName/fields/:fieldApiName/auto-number/backfill", requireSystemAdmin, autoNumberHandler.BackfillAutoNumber)
+
+			// Global Value Sets
+			metadata.GET("/global-value-sets", globalValueSetHandler.GetGlobalValueSets)
+			metadata.GET("/global-value-sets/:name", globalValueSetHandler.GetGlobalValueSet)
+			metadata.POST("/global-value-sets", requireSystemAdmin, globalValueSetHandler.CreateGlobalValueSet)
+			metadata.PATCH("/global-value-sets/:name", requireSystemAdmin, globalValueSetHandler.UpdateGlobalValueSet)
+			metadata.DELETE("/global-value-sets/:name", requireSystemAdmin, globalValueSetHandler.DeleteGlobalValueSet)
+
+			// Custom Metadata Types
+			metadata.GET("/custom-metadata-types", customMetadataHandler.GetTypes)
+			metadata.GET("/custom-metadata-types/:typeName", customMetadataHandler.GetType)
+			metadata.POST("/custom-metadata-types", requireSystemAdmin, customMetadataHandler.CreateType)
+			metadata.PATCH("/custom-metadata-types/:typeName", requireSystemAdmin, customMetadataHandler.UpdateType)
+			metadata.DELETE("/custom-metadata-types/:typeName", requireSystemAdmin, customMetadataHandler.DeleteType)
+			metadata.GET("/custom-metadata-types/:typeName/records", customMetadataHandler.GetRecords)
+			metadata.GET("/custom-metadata-types/:typeName/records/:developerName", customMetadataHandler.GetRecord)
+			metadata.POST("/custom-metadata-types/:typeName/records", requireSystemAdmin, customMetadataHandler.CreateRecord)
+			metadata.PATCH("/custom-metadata-types/:typeName/records/:developerName", requireSystemAdmin, customMetadataHandler.UpdateRecord)
+			metadata.DELETE("/custom-metadata-types/:typeName/records/:developerName", requireSystemAdmin, customMetadataHandler.DeleteRecord)
+
+			// Custom Settings (org default → profile → user)
+			metadata.GET("/custom-settings", customSettingHandler.GetSettings)
+			metadata.GET("/custom-settings/resolved", customSettingHandler.GetResolved)
+			metadata.GET("/custom-settings/:name", customSettingHandler.GetSetting)
+			metadata.POST("/custom-settings", requireSystemAdmin, customSettingHandler.CreateSetting)
+			metadata.PATCH("/custom-settings/:name", requireSystemAdmin, customSettingHandler.UpdateSetting)
+			metadata.DELETE("/custom-settings/:name", requireSystemAdmin, customSettingHandler.DeleteSetting)
+			metadata.GET("/custom-settings/:name/values", requireSystemAdmin, customSettingHandler.GetOverrides)
+			metadata.PUT("/custom-settings/:name/values", requireSystemAdmin, customSettingHandler.SetOverride)
+			metadata.DELETE("/custom-settings/:name/values/:scope/:scopeId", requireSystemAdmin, customSettingHandler.DeleteOverride)
+
+			// Named Credentials & Callouts (admin only: endpoints and auth details)
+			metadata.GET("/named-credentials", requireSystemAdmin, namedCredentialHandler.GetNamedCredentials)
+			metadata.GET("/named-credentials/:name", requireSystemAdmin, namedCredentialHandler.GetNamedCredential)
+			metadata.POST("/named-credentials", requireSystemAdmin, namedCredentialHandler.CreateNamedCredential)
+			metadata.PATCH("/named-credentials/:name", requireSystemAdmin, namedCredentialHandler.UpdateNamedCredential)
+			metadata.DELETE("/named-credentials/:name", requireSystemAdmin, namedCredentialHandler.DeleteNamedCredential)
+			metadata.POST("/named-credentials/:name/callout", requireSystemAdmin, namedCredentialHandler.TestCallout)
+			metadata.GET("/inbound-hooks", requireSystemAdmin, inboundHookHandler.GetInboundHooks)
+			metadata.GET("/inbound-hooks/:slug", requireSystemAdmin, inboundHookHandler.GetInboundHook)
+			metadata.POST("/inbound-hooks", requireSystemAdmin, inboundHookHandler.CreateInboundHook)
+			metadata.PATCH("/inbound-hooks/:slug", requireSystemAdmin, inboundHookHandler.UpdateInboundHook)
+			metadata.DELETE("/inbound-hooks/:slug", requireSystemAdmin, inboundHookHandler.DeleteInboundHook)
+
+			// Document templates (PDF generation)
+			metadata.GET("/document-templates", documentHandler.GetTemplates)
+			metadata.GET("/document-templates/:id", documentHandler.GetTemplate)
+			metadata.POST("/document-templates", requireSystemAdmin, documentHandler.CreateTemplate)
+			metadata.PATCH("/document-templates/:id", requireSystemAdmin, documentHandler.UpdateTemplate)
+			metadata.DELETE("/document-templates/:id", requireSystemAdmin, documentHandler.DeleteTemplate)
+
+			// External Objects (read-only objects backed by REST, OData or SQL sources)
+			metadata.GET("/external-objects", requireSystemAdmin, externalObjectHandler.GetExternalObjects)
+			metadata.GET("/external-objects/:apiName", requireSystemAdmin, externalObjectHandler.GetExternalObject)
+			metadata.POST("/external-objects", requireSystemAdmin, externalObjectHandler.CreateExternalObject)
+			metadata.PATCH("/external-objects/:apiName/source", requireSystemAdmin, externalObjectHandler.UpdateExternalDataSource)
+			metadata.DELETE("/external-objects/:apiName", requireSystemAdmin, externalObjectHandler.DeleteExternalObject)
+
+			// Business Hours, Holidays & SLA Policies
+			metadata.GET("/business-hours", slaHandler.GetBusinessHours)
+			metadata.GET("/business-hours/:name", slaHandler.GetBusinessHoursByName)
+			metadata.POST("/business-hours", requireSystemAdmin, slaHandler.CreateBusinessHours)
+			metadata.PATCH("/business-hours/:name", requireSystemAdmin, slaHandler.UpdateBusinessHours)
+			metadata.DELETE("/business-hours/:name", requireSystemAdmin, slaHandler.DeleteBusinessHours)
+			metadata.POST("/business-hours/:name/holidays", requireSystemAdmin, slaHandler.AddHoliday)
+			metadata.DELETE("/business-hours/:name/holidays/:holidayId", requireSystemAdmin, slaHandler.DeleteHoliday)
+			metadata.GET("/sla-policies", requireSystemAdmin, slaHandler.GetPolicies)
+			metadata.GET("/sla-policies/:name", requireSystemAdmin, slaHandler.GetPolicy)
+			metadata.POST("/sla-policies", requireSystemAdmin, slaHandler.CreatePolicy)
+			metadata.PUT("/sla-policies/:name", requireSystemAdmin, slaHandler.UpdatePolicy)
+			metadata.DELETE("/sla-policies/:name", requireSystemAdmin, slaHandler.DeletePolicy)
+
+			// Translation Workbench
+			metadata.GET("/translations", requireSystemAdmin, translationHandler.GetLocales)
+			metadata.GET("/translations/:locale", requireSystemAdmin, translationHandler.GetWorkbench)
+			metadata.PUT("/translations/:locale", requireSystemAdmin, translationHandler.SaveTranslations)
+			metadata.DELETE("/translations/:locale", requireSystemAdmin, translationHandler.DeleteLocale)
+			metadata.GET("/translations/:locale/export", requireSystemAdmin, translationHandler.ExportTranslations)
+			metadata.POST("/translations/:locale/import", requireSystemAdmin, translationHandler.ImportTranslations)
+
+			// Portal Objects
+			metadata.GET("/portal-objects", requireSystemAdmin, portalHandler.GetPortalObjects)
+			metadata.PUT("/portal-objects/:objectApiName", requireSystemAdmin, portalHandler.SavePortalObject)
+			metadata.DELETE("/portal-objects/:objectApiName", requireSystemAdmin, portalHandler.DeletePortalObject)
+
+			// Escalation Rules
+			metadata.GET("/escalation-rules", requireSystemAdmin, escalationHandler.GetRules)
+			metadata.GET("/escalation-rules/:name", requireSystemAdmin, escalationHandler.GetRule)
+			metadata.POST("/escalation-rules", requireSystemAdmin, escalationHandler.CreateRule)
+			metadata.PUT("/escalation-rules/:name", requireSystemAdmin, escalationHandler.UpdateRule)
+			metadata.DELETE("/escalation-rules/:name", requireSystemAdmin, escalationHandler.DeleteRule)
+
+			// Scripts
+			metadata.GET("/scripts", requireSystemAdmin, scriptHandler.GetScripts)
+			metadata.GET("/scripts/:name", requireSystemAdmin, scriptHandler.GetScript)
+			metadata.POST("/scripts", requireSystemAdmin, scriptHandler.CreateScript)
+			metadata.PUT("/scripts/:name", requireSystemAdmin, scriptHandler.UpdateScript)
+			metadata.DELETE("/scripts/:name", requireSystemAdmin, scriptHandler.DeleteScript)
+			metadata.POST("/scripts/:name/run", requireSystemAdmin, scriptHandler.RunScript)
+			metadata.GET("/scripts/:name/logs", requireSystemAdmin, scriptHandler.GetScriptLogs)
+
+			// Custom REST Endpoints
+			metadata.GET("/custom-endpoints", requireSystemAdmin, customEndpointHandler.GetCustomEndpoints)
+			metadata.GET("/custom-endpoints/:name", requireSystemAdmin, customEndpointHandler.GetCustomEndpoint)
+			metadata.POST("/custom-endpoints", requireSystemAdmin, customEndpointHandler.CreateCustomEndpoint)
+			metadata.PUT("/custom-endpoints/:name", requireSystemAdmin, customEndpointHandler.UpdateCustomEndpoint)
+			metadata.DELETE("/custom-endpoints/:name", requireSystemAdmin, customEndpointHandler.DeleteCustomEndpoint)
+
+			// Batch Jobs
+			metadata.GET("/batch-jobs", requireSystemAdmin, batchJobHandler.GetBatchJobs)
+			metadata.GET("/batch-jobs/:name", requireSystemAdmin, batchJobHandler.GetBatchJob)
+			metadata.POST("/batch-jobs", requireSystemAdmin, batchJobHandler.CreateBatchJob)
+			metadata.PUT("/batch-jobs/:name", requireSystemAdmin, batchJobHandler.UpdateBatchJob)
+			metadata.DELETE("/batch-jobs/:name", requireSystemAdmin, batchJobHandler.DeleteBatchJob)
+			metadata.POST("/batch-jobs/:name/run", requireSystemAdmin, batchJobHandler.RunBatchJob)
+			metadata.POST("/batch-jobs/:name/abort", requireSystemAdmin, batchJobHandler.AbortBatchJob)
+
+			// Archival Policies
+			metadata.GET("/archive-policies", requireSystemAdmin, archiveHandler.GetPolicies)
+			metadata.GET("/archive-policies/:objectApiName", requireSystemAdmin, archiveHandler.GetPolicy)
+			metadata.PUT("/archive-policies/:objectApiName", requireSystemAdmin, archiveHandler.SavePolicy)
+			metadata.DELETE("/archive-policies/:objectApiName", requireSystemAdmin, archiveHandler.DeletePolicy)
+			metadata.POST("/archive-policies/:objectApiName/run", requireSystemAdmin, archiveHandler.RunPolicy)
+
+			// Record Types
+			metadata.GET("/objects/:apiName/record-types", recordTypeHandler.GetRecordTypes)
+			metadata.GET("/objects/:apiName/record-types/available", recordTypeHandler.GetAvailableRecordTypes)
+			metadata.POST("/objects/:apiName/record-types", requireSystemAdmin, recordTypeHandler.CreateRecordType)
+			metadata.PATCH("/record-types/:id", requireSystemAdmin, recordTypeHandler.UpdateRecordType)
+			metadata.DELETE("/record-types/:id", requireSystemAdmin, recordTypeHandler.DeleteRecordType)
+			metadata.GET("/record-types/assignments", requireSystemAdmin, recordTypeHandler.GetProfileRecordTypes)
+			metadata.POST("/record-types/assign", requireSystemAdmin, recordTypeHandler.AssignRecordTypeToProfile)
+			metadata.DELETE("/record-types/:id/assignments/:profileId", requireSystemAdmin, recordTypeHandler.RemoveRecordTypeFromProfile)
+
+			// State Machines (picklist transitions; any user may read an object's to offer valid moves)
+			metadata.GET("/objects/:apiName/state-machines", stateMachineHandler.GetObjectStateMachines)
+			metadata.GET("/state-machines", requireSystemAdmin, stateMachineHandler.GetStateMachines)
+			metadata.GET("/state-machines/:name", requireSystemAdmin, stateMachineHandler.GetStateMachine)
+			metadata.POST("/state-machines", requireSystemAdmin, stateMachineHandler.CreateStateMachine)
+			metadata.PUT("/state-machines/:name", requireSystemAdmin, stateMachineHandler.UpdateStateMachine)
+			metadata.DELETE("/state-machines/:name", requireSystemAdmin, stateMachineHandler.DeleteStateMachine)
+
 			metadata.GET("/layouts/:objectName", uiHandler.GetLayout)
+			metadata.GET("/layouts/:objectName/resolved", uiHandler.GetResolvedLayout)
 			metadata.POST("/layouts", uiHandler.SaveLayout)
 			metadata.DELETE("/layouts/:id", uiHandler.DeleteLayout)
 			metadata.POST("/layouts/assign", uiHandler.AssignLayoutToProfile)
@@ -313,12 +640,26 @@ func main() {
 			metadata.GET("/dashboards/:id", uiHandler.GetDashboard)
 			metadata.PATCH("/dashboards/:id", uiHandler.UpdateDashboard)
 			metadata.DELETE("/dashboards/:id", uiHandler.DeleteDashboard)
+			metadata.POST("/dashboards/:id/run", uiHandler.RunDashboard)
+			metadata.GET("/dashboards/:id/widgets/:wid/image", chartHandler.GetWidgetImage)
+
+			// Reports
+			metadata.GET("/reports", reportHandler.GetReports)
+			metadata.POST("/reports", reportHandler.CreateReport)
+			metadata.POST("/reports/run", reportHandler.PreviewReport)
+			metadata.GET("/reports/:id", reportHandler.GetReport)
+			metadata.PATCH("/reports/:id", reportHandler.UpdateReport)
+			metadata.DELETE("/reports/:id", reportHandler.DeleteReport)
+			metadata.POST("/reports/:id/run", reportHandler.RunReport)
+			metadata.GET("/reports/:id/export", reportHandler.ExportReport)
+			metadata.GET("/reports/:id/chart", chartHandler.GetReportChart)
 
 			// List Views
 			metadata.GET("/listviews", uiHandler.GetListViews)
 			metadata.POST("/listviews", uiHandler.CreateListView)
 			metadata.PATCH("/listviews/:id", uiHandler.UpdateListView)
 			metadata.DELETE("/listviews/:id", uiHandler.DeleteListView)
+			metadata.GET("/listviews/:id/footer", uiHandler.GetListViewFooter)
 
 			// Validation Rules
 			metadata.GET("/validation-rules", metadataHandler.GetValidationRules)
@@ -351,25 +692,179 @@ func main() {
 			flows.POST("/:flowId/execute", flowHandler.ExecuteFlow)
 		}
 
+		// Custom REST endpoints defined in metadata, called with the caller's access
+		custom := api.Group("/custom")
+		custom.Use(requireAuth)
+		{
+			for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+				custom.Handle(method, "/:name", customEndpointHandler.Call)
+			}
+		}
+
 		// Protected Data routes
 		data := api.Group("/data")
 		data.Use(requireAuth)
 		{
 			data.POST("/query", dataHandler.Query)
+			data.POST("/nlq", dataHandler.NaturalLanguageQuery)
 			data.POST("/analytics", dataHandler.RunAnalytics)
 			data.POST("/search", dataHandler.Search)
+			data.POST("/semantic-search", dataHandler.SemanticSearch)
+			data.GET("/recent", dataHandler.GetRecentItems)
+			data.GET("/saved-searches", savedSearchHandler.GetSavedSearches)
+			data.POST("/saved-searches", savedSearchHandler.CreateSavedSearch)
+			data.PATCH("/saved-searches/:id", savedSearchHandler.UpdateSavedSearch)
+			data.DELETE("/saved-searches/:id", savedSearchHandler.DeleteSavedSearch)
+			data.POST("/saved-searches/:id/run", savedSearchHandler.RunSavedSearch)
 			data.GET("/recyclebin/items", dataHandler.GetRecycleBinItems)
 			data.POST("/recyclebin/restore/:id", dataHandler.RestoreFromRecycleBin)
 			data.DELETE("/recyclebin/:id", dataHandler.PurgeFromRecycleBin)
 			// Single object search - MUST be before /:objectApiName/:id to avoid conflict
 			data.GET("/search/:objectApiName", dataHandler.SearchSingleObject)
 			data.POST("/:objectApiName/calculate", dataHandler.Calculate)
+			data.POST("/:objectApiName/kanban", dataHandler.Kanban)
+			data.POST("/:objectApiName/kanban/move", dataHandler.MoveKanbanCard)
+			data.POST("/:objectApiName/calendar", dataHandler.Calendar)
+			data.GET("/:objectApiName/lookup", dataHandler.Lookup)
+			data.GET("/:objectApiName/stage-analytics", stageHistoryHandler.GetAnalytics)
 			data.GET("/:objectApiName/:id", dataHandler.GetRecord)
+			data.GET("/:objectApiName/:id/sla", slaHandler.GetRecordTimers)
+			data.GET("/:objectApiName/:id/stage-history", stageHistoryHandler.GetRecordHistory)
+			data.GET("/:objectApiName/:id/timeline", timelineHandler.GetTimeline)
+			data.POST("/:objectApiName/:id/documents/:templateId", documentHandler.Generate)
 			data.POST("/:objectApiName", dataHandler.CreateRecord)
 			data.POST("/:objectApiName/bulk", dataHandler.BulkCreateRecords)
+			data.PATCH("/:objectApiName/bulk", dataHandler.BulkUpdateRecords)
+			data.DELETE("/:objectApiName/bulk", dataHandler.BulkDeleteRecords)
 			data.PATCH("/:objectApiName/:id", dataHandler.UpdateRecord)
+			data.PATCH("/:objectApiName/:id/fields/:fieldApiName", dataHandler.UpdateField)
 			data.DELETE("/:objectApiName/:id", dataHandler.DeleteRecord)
 		}
+		// Protected Data Quality routes (rules and scoring runs are System Admin Only)
+		dataQuality := api.Group("/data-quality")
+		dataQuality.Use(requireAuth)
+		{
+			dataQuality.GET("/dashboard", dataQualityHandler.GetDashboard)
+			dataQuality.GET("/:objectApiName/records", dataQualityHandler.GetRecords)
+			dataQuality.GET("/:objectApiName/rule", requireSystemAdmin, dataQualityHandler.GetRule)
+			dataQuality.PUT("/:objectApiName/rule", requireSystemAdmin, dataQualityHandler.SaveRule)
+			dataQuality.POST("/:objectApiName/score", requireSystemAdmin, dataQualityHandler.Score)
+		}
+
+		// Protected Forecast routes: users see their role's branch; settings and quotas are admin-only
+		forecast := api.Group("/analytics/forecast")
+		forecast.Use(requireAuth)
+		{
+			forecast.GET("", forecastHandler.GetForecast)
+			forecast.GET("/settings/:objectApiName", requireSystemAdmin, forecastHandler.GetSetting)
+			forecast.PUT("/settings/:objectApiName", requireSystemAdmin, forecastHandler.SaveSetting)
+			forecast.GET("/quotas", requireSystemAdmin, forecastHandler.GetQuotas)
+			forecast.PUT("/quotas", requireSystemAdmin, forecastHandler.SaveQuotas)
+			forecast.POST("/adjustments", forecastHandler.SaveAdjustment)
+			forecast.DELETE("/adjustments/:id", forecastHandler.DeleteAdjustment)
+		}
+
+		// Protected Campaign routes: campaigns are visible to all users; owners and admins edit them
+		campaigns := api.Group("/campaigns")
+		campaigns.Use(requireAuth)
+		{
+			campaigns.GET("", campaignHandler.ListCampaigns)
+			campaigns.POST("", campaignHandler.CreateCampaign)
+			campaigns.PATCH("/members/:memberId", campaignHandler.UpdateMember)
+			campaigns.DELETE("/members/:memberId", campaignHandler.RemoveMember)
+			campaigns.GET("/:id", campaignHandler.GetCampaign)
+			campaigns.PUT("/:id", campaignHandler.UpdateCampaign)
+			campaigns.DELETE("/:id", campaignHandler.DeleteCampaign)
+			campaigns.POST("/:id/recalculate", campaignHandler.RecalculateCampaign)
+			campaigns.GET("/:id/members", campaignHandler.ListMembers)
+			campaigns.POST("/:id/members", campaignHandler.AddMembers)
+		}
+
+		// Protected Order routes: owners and admins see and change orders
+		orders := api.Group("/orders")
+		orders.Use(requireAuth)
+		{
+			orders.GET("", orderHandler.ListOrders)
+			orders.POST("", orderHandler.CreateOrder)
+			orders.GET("/:id", orderHandler.GetOrder)
+			orders.PUT("/:id", orderHandler.UpdateOrder)
+			orders.DELETE("/:id", orderHandler.DeleteOrder)
+			orders.POST("/:id/activate", orderHandler.ActivateOrder)
+			orders.POST("/:id/fulfill", orderHandler.FulfillOrder)
+			orders.POST("/:id/cancel", orderHandler.CancelOrder)
+		}
+
+		// Protected Support plan routes: contracts and entitlements are visible to all users; owners and admins edit them
+		contracts := api.Group("/contracts")
+		contracts.Use(requireAuth)
+		{
+			contracts.GET("", entitlementHandler.ListContracts)
+			contracts.POST("", entitlementHandler.CreateContract)
+			contracts.GET("/:id", entitlementHandler.GetContract)
+			contracts.PUT("/:id", entitlementHandler.UpdateContract)
+			contracts.DELETE("/:id", entitlementHandler.DeleteContract)
+		}
+		entitlements := api.Group("/entitlements")
+		entitlements.Use(requireAuth)
+		{
+			entitlements.GET("", entitlementHandler.ListEntitlements)
+			entitlements.POST("", entitlementHandler.CreateEntitlement)
+			entitlements.GET("/:id", entitlementHandler.GetEntitlement)
+			entitlements.PUT("/:id", entitlementHandler.UpdateEntitlement)
+			entitlements.DELETE("/:id", entitlementHandler.DeleteEntitlement)
+			entitlements.GET("/:id/usage", entitlementHandler.ListUsage)
+		}
+
+		// Protected Knowledge routes: published articles are readable by all users; authors edit their own drafts and admins manage categories
+		knowledge := api.Group("/knowledge")
+		knowledge.Use(requireAuth)
+		{
+			knowledge.GET("/categories", knowledgeHandler.ListCategories)
+			knowledge.POST("/categories", knowledgeHandler.CreateCategory)
+			knowledge.PUT("/categories/:id", knowledgeHandler.UpdateCategory)
+			knowledge.DELETE("/categories/:id", knowledgeHandler.DeleteCategory)
+			knowledge.GET("/articles", knowledgeHandler.ListArticles)
+			knowledge.POST("/articles", knowledgeHandler.CreateArticle)
+			knowledge.GET("/articles/:id", knowledgeHandler.GetArticle)
+			knowledge.PUT("/articles/:id", knowledgeHandler.UpdateArticle)
+			knowledge.DELETE("/articles/:id", knowledgeHandler.DeleteArticle)
+			knowledge.POST("/articles/:id/publish", knowledgeHandler.PublishArticle)
+			knowledge.POST("/articles/:id/archive", knowledgeHandler.ArchiveArticle)
+			knowledge.POST("/articles/:id/restore", knowledgeHandler.RestoreArticle)
+			knowledge.GET("/articles/:id/versions", knowledgeHandler.ListVersions)
+			knowledge.POST("/articles/:id/versions/:version/revert", knowledgeHandler.RevertArticle)
+			knowledge.GET("/published", knowledgeHandler.ListPublished)
+			knowledge.GET("/published/:urlName", knowledgeHandler.GetPublished)
+			knowledge.GET("/search", knowledgeHandler.Search)
+			knowledge.GET("/suggestions", knowledgeHandler.Suggestions)
+			knowledge.GET("/links", knowledgeHandler.ListLinks)
+			knowledge.POST("/links", knowledgeHandler.CreateLink)
+			knowledge.DELETE("/links/:id", knowledgeHandler.DeleteLink)
+		}
+
+		// Protected Survey routes: owners manage surveys, invitations and responses; analytics are readable by all users
+		surveys := api.Group("/surveys")
+		surveys.Use(requireAuth)
+		{
+			surveys.GET("", surveyHandler.ListSurveys)
+			surveys.POST("", surveyHandler.CreateSurvey)
+			surveys.GET("/responses", surveyHandler.ListRecordResponses)
+			surveys.GET("/:id", surveyHandler.GetSurvey)
+			surveys.PUT("/:id", surveyHandler.UpdateSurvey)
+			surveys.DELETE("/:id", surveyHandler.DeleteSurvey)
+			surveys.GET("/:id/invitations", surveyHandler.ListInvitations)
+			surveys.POST("/:id/invitations", surveyHandler.CreateInvitation)
+			surveys.GET("/:id/responses", surveyHandler.ListResponses)
+			surveys.GET("/:id/analytics", surveyHandler.Analytics)
+		}
+
+		// Public Survey routes: the link token is the only credential
+		publicSurveys := api.Group("/public/surveys")
+		{
+			publicSurveys.GET("/:token", surveyHandler.GetPublicSurvey)
+			publicSurveys.POST("/:token", surveyHandler.SubmitResponse)
+		}
+
 		// Protected Analytics routes (System Admin Only)
 		analytics := api.Group("/analytics")
 		analytics.Use(requireAuth, requireSystemAdmin)
@@ -391,6 +886,8 @@ func main() {
 			approvals.POST("/submit", approvalHandler.Submit)
 			approvals.POST("/:workItemId/approve", approvalHandler.Approve)
 			approvals.POST("/:workItemId/reject", approvalHandler.Reject)
+			approvals.POST("/:workItemId/recall", approvalHandler.Recall)
+			approvals.POST("/:workItemId/reassign", approvalHandler.Reassign)
 			approvals.GET("/pending", approvalHandler.GetPending)
 			approvals.GET("/check/:objectApiName", approvalHandler.CheckProcess)
 			approvals.GET("/history/:objectApiName/:recordId", approvalHandler.GetHistory)
@@ -405,6 +902,42 @@ func main() {
 			feed.GET("/:recordId", feedHandler.GetComments)
 		}
 
+		// Mail and calendar sync. The OAuth callback is reached by the provider's redirect and
+		// authenticated by its encrypted state.
+		sync := api.Group("/sync")
+		{
+			sync.GET("/oauth/callback", syncHandler.Callback)
+			sync.GET("/providers", requireAuth, syncHandler.GetProviders)
+			sync.GET("/connectors", requireAuth, syncHandler.GetConnectors)
+			sync.POST("/providers/:provider/authorize", requireAuth, syncHandler.Authorize)
+			sync.PATCH("/connectors/:id", requireAuth, syncHandler.UpdateConnector)
+			sync.DELETE("/connectors/:id", requireAuth, syncHandler.DeleteConnector)
+			sync.POST("/connectors/:id/run", requireAuth, syncHandler.RunConnector)
+			sync.GET("/activities/:objectApiName/:recordId", requireAuth, syncHandler.GetActivities)
+		}
+
+		// Telephony. Webhooks are signed by the provider.
+		telephony := api.Group("/telephony")
+		{
+			telephony.POST("/webhooks/:provider", telephonyHandler.Webhook)
+			telephony.GET("/providers", requireAuth, telephonyHandler.GetProviders)
+			telephony.POST("/dial", requireAuth, telephonyHandler.Dial)
+			telephony.POST("/calls", requireAuth, telephonyHandler.LogCall)
+		}
+
+		// Integration platforms (Zapier, Make): polling triggers, REST hooks and actions
+		hooks := api.Group("/integrations/hooks")
+		{
+			hooks.GET("/me", requireAuth, integrationHookHandler.Me)
+			hooks.GET("/triggers/:objectApiName/:event", requireAuth, integrationHookHandler.Poll)
+			hooks.GET("/subscriptions", requireAuth, integrationHookHandler.GetSubscriptions)
+			hooks.POST("/subscriptions", requireAuth, integrationHookHandler.Subscribe)
+			hooks.DELETE("/subscriptions/:id", requireAuth, integrationHookHandler.Unsubscribe)
+			hooks.GET("/actions/:objectApiName", requireAuth, integrationHookHandler.FindRecords)
+			hooks.POST("/actions/:objectApiName", requireAuth, integrationHookHandler.CreateRecord)
+			hooks.PATCH("/actions/:objectApiName/:id", requireAuth, integrationHookHandler.UpdateRecord)
+		}
+
 		// Protected Notification routes
 		notifications := api.Group("/notifications")
 		notifications.Use(requireAuth)
@@ -448,6 +981,18 @@ func main() {
 	svcMgr.StartScheduler()
 	log.Println("⏰ Scheduler service started (60s polling)")
 
+	// Rebuild full-text search index (no-op when SEARCH_ENGINE is unset)
+	svcMgr.StartSearchIndexer()
+
+	// Embed new and changed records for semantic search (no-op when EMBEDDING_PROVIDER is unset)
+	svcMgr.StartSemanticIndexer()
+
+	// Purge expired AI agent history (no-op when AI_HISTORY_RETENTION_DAYS is unset)
+	svcMgr.StartAIHistoryRetention()
+
+	// Apply runtime settings changed in _System_Config (no-op when CONFIG_RELOAD_INTERVAL is 0)
+	svcMgr.StartConfigReloader(cfg.ReloadInterval)
+
 	// Start server
 	log.Println("\n═══════════════════════════════════════════════════════════════════════════")
 	log.Println("🚀 NexusCRM Golang Backend Started Successfully")
@@ -458,14 +1003,29 @@ func main() {
 	log.Printf("📊 Metadata API:   http://localhost:%s/api/metadata", port)
 	log.Printf("🤖 MCP Endpoint:   http://localhost:%s/mcp", port)
 	log.Printf("💾 Data API:       http://localhost:%s/api/data", port)
+	log.Printf("🕸️  GraphQL API:    http://localhost:%s/api/graphql", port)
+	log.Printf("📈 OData feed:     http://localhost:%s/api/odata", port)
 	log.Printf("💚 Health check:   http://localhost:%s/health\n", port)
 
-	// Create HTTP Server
-	srv := &http.Server{
-		Addr:    "0.0.0.0:" + port,
-		Handler: router,
+	// Start the gRPC API alongside REST when a port is configured
+	var grpcSrv *grpc.Server
+	if grpcPort := cfg.Server.GRPCPort; grpcPort != "" {
+		lis, err := net.Listen("tcp", "0.0.0.0:"+grpcPort)
+		if err != nil {
+			log.Fatalf("Failed to listen on gRPC port %s: %v", grpcPort, err)
+		}
+		grpcSrv = grpcapi.NewServer(svcMgr)
+		go func() {
+			if err := grpcSrv.Serve(lis); err != nil {
+				log.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
+		log.Printf("🛰️  gRPC API:       localhost:%s", grpcPort)
 	}
 
+	// Create HTTP Server
+	srv := newHTTPServer("0.0.0.0:"+port, router, cfg.HTTP)
+
 	// Start server in a goroutine
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -482,11 +1042,18 @@ func main() {
 	<-quit
 	log.Println("Shutting down server...")
 
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
+		log.Println("🛑 gRPC server stopped")
+	}
+
 	// Stop background workers
 	svcMgr.StopOutboxWorker()
 	log.Println("🛑 Outbox worker stopped")
 	svcMgr.StopScheduler()
 	log.Println("🛑 Scheduler stopped")
+	svcMgr.StopAIHistoryRetention()
+	svcMgr.StopConfigReloader()
 
 	// The context is used to inform the server it has 5 seconds to finish
 	// the request it is currently handling
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,26 +19,37 @@ import (
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
+// mergeFieldPattern matches a {!formula} merge field inside a template string
+var mergeFieldPattern = regexp.MustCompile(`\{![^}]*\}`)
+
 // ActionService handles execution of metadata-driven actions
 type ActionService struct {
 	metadata    *MetadataService
 	persistence *PersistenceService
 	permissions *PermissionService
 	txManager   *persistence.TransactionManager
+	callouts    *CalloutService
+	scripts     *ScriptService // nil until SetScripts; RunScript actions fail without it
 	formula     *formula.Engine
 }
 
 // NewActionService creates a new ActionService
-func NewActionService(metadata *MetadataService, persistence *PersistenceService, permissions *PermissionService, txManager *persistence.TransactionManager) *ActionService {
+func NewActionService(metadata *MetadataService, persistence *PersistenceService, permissions *PermissionService, txManager *persistence.TransactionManager, callouts *CalloutService) *ActionService {
 	return &ActionService{
 		metadata:    metadata,
 		persistence: persistence,
 		permissions: permissions,
 		txManager:   txManager,
+		callouts:    callouts,
 		formula:     formula.NewEngine(),
 	}
 }
 
+// SetScripts enables RunScript actions
+func (as *ActionService) SetScripts(scripts *ScriptService) {
+	as.scripts = scripts
+}
+
 // ActionContext holds the state of an action execution, including results from previous steps
 type ActionContext struct {
 	Record  models.SObject
@@ -75,8 +87,12 @@ func (as *ActionService) executeActionFromMetadata(ctx context.Context, action *
 		return as.executeSendEmail(ctx, action, actionCtx)
 	case constants.ActionTypeCallWebhook:
 		return as.executeCallWebhook(ctx, action, actionCtx)
+	case constants.ActionTypeCallout:
+		return as.executeCallout(ctx, action, actionCtx)
 	case constants.ActionTypeComposite:
 		return as.executeComposite(ctx, action, actionCtx)
+	case constants.ActionTypeRunScript:
+		return as.executeRunScript(ctx, action, actionCtx)
 	default:
 		return fmt.Errorf("unsupported action type: %s", action.Type)
 	}
@@ -165,7 +181,9 @@ func (as *ActionService) executeUpdateRecord(ctx context.Context, action *models
 	return as.persistence.Update(ctx, targetObjectName, recordID, updates, actionCtx.User)
 }
 
-// executeComposite executes a sequence of actions within a transaction
+// executeComposite executes a sequence of actions within a transaction. With all_or_none
+// (the default) a failing step rolls back every step; set to false, a failing step is
+// rolled back to its savepoint and the remaining steps still run.
 func (as *ActionService) executeComposite(ctx context.Context, action *models.ActionMetadata, actionCtx *ActionContext) error {
 	// composite action config should have a "steps" array
 	stepsInterface, ok := action.Config[constants.ConfigKeySteps]
@@ -178,17 +196,22 @@ func (as *ActionService) executeComposite(ctx context.Context, action *models.Ac
 		return fmt.Errorf("steps must be an array")
 	}
 
+	allOrNone := true
+	if v, ok := action.Config[constants.ConfigKeyAllOrNone].(bool); ok {
+		allOrNone = v
+	}
+
 	// EXECUTE WITHIN TRANSACTION
 	return as.txManager.WithTransaction(func(tx *sql.Tx) error {
 		// Inject transaction into context
 		txCtx := as.txManager.InjectTx(ctx, tx)
 
 		// Execute steps using the transactional context
-		return as.executeSteps(txCtx, stepsList, actionCtx, action.ObjectAPIName)
+		return as.executeSteps(txCtx, stepsList, actionCtx, action.ObjectAPIName, allOrNone)
 	})
 }
 
-func (as *ActionService) executeSteps(ctx context.Context, steps []interface{}, actionCtx *ActionContext, sourceObjectName string) error {
+func (as *ActionService) executeSteps(ctx context.Context, steps []interface{}, actionCtx *ActionContext, sourceObjectName string, allOrNone bool) error {
 	for _, stepInterface := range steps {
 		stepConfig, ok := stepInterface.(map[string]interface{})
 		if !ok {
@@ -210,8 +233,16 @@ func (as *ActionService) executeSteps(ctx context.Context, steps []interface{},
 		}
 
 		// Recursively execute
-		if err := as.executeActionFromMetadata(ctx, stepAction, actionCtx); err != nil {
-			return fmt.Errorf("step %s failed: %w", stepID, err)
+		if allOrNone {
+			if err := as.executeActionFromMetadata(ctx, stepAction, actionCtx); err != nil {
+				return fmt.Errorf("step %s failed: %w", stepID, err)
+			}
+			continue
+		}
+		if err := as.txManager.WithSavepoint(ctx, func(spCtx context.Context) error {
+			return as.executeActionFromMetadata(spCtx, stepAction, actionCtx)
+		}); err != nil {
+			log.Printf("⚠️ Composite step %s failed and was rolled back: %v", stepID, err)
 		}
 	}
 	return nil
@@ -323,6 +354,167 @@ func (as *ActionService) executeCallWebhook(ctx context.Context, action *models.
 	return nil
 }
 
+// executeCallout calls an external service through a named credential. The request path,
+// headers and body are templates evaluated against the record; the response is stored in the
+// step results and, with response_mapping, copied into record fields.
+func (as *ActionService) executeCallout(ctx context.Context, action *models.ActionMetadata, actionCtx *ActionContext) error {
+	credential, err := GetConfigStringRequired(action.Config, constants.ConfigNamedCredential)
+	if err != nil {
+		return err
+	}
+
+	req := CalloutRequest{
+		NamedCredential: credential,
+		Method:          GetConfigString(action.Config, constants.ConfigMethod),
+	}
+	if path, ok := action.Config[constants.ConfigPath]; ok {
+		rendered, err := as.renderTemplate(ctx, path, actionCtx, action.ObjectAPIName)
+		if err != nil {
+			return fmt.Errorf("failed to render callout path: %w", err)
+		}
+		req.Path = fmt.Sprintf("%v", rendered)
+	}
+	if headers, ok := GetConfigMap(action.Config, constants.ConfigHeaders); ok {
+		req.Headers = make(map[string]string, len(headers))
+		for name, value := range headers {
+			rendered, err := as.renderTemplate(ctx, value, actionCtx, action.ObjectAPIName)
+			if err != nil {
+				return fmt.Errorf("failed to render header %s: %w", name, err)
+			}
+			req.Headers[name] = fmt.Sprintf("%v", rendered)
+		}
+	}
+	if body, ok := action.Config[constants.ConfigBody]; ok {
+		if req.Body, err = as.renderTemplate(ctx, body, actionCtx, action.ObjectAPIName); err != nil {
+			return fmt.Errorf("failed to render callout body: %w", err)
+		}
+	}
+
+	resp, err := as.callouts.Callout(ctx, req)
+	if err != nil {
+		return err
+	}
+	actionCtx.Results[action.ID] = map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"body":        resp.Body,
+	}
+
+	mapping, ok := GetConfigMap(action.Config, constants.ConfigResponseMapping)
+	if !ok {
+		return nil
+	}
+	updates := MapCalloutResponse(resp.Body, mapping)
+	if len(updates) == 0 {
+		return nil
+	}
+	if actionCtx.Record == nil {
+		return fmt.Errorf("callout response_mapping requires a record to update")
+	}
+	for field, value := range updates {
+		actionCtx.Record[field] = value
+	}
+
+	if writeBack, ok := action.Config[constants.ConfigWriteBack].(bool); ok && !writeBack {
+		return nil
+	}
+	recordID := actionCtx.Record.GetString(constants.FieldID)
+	if recordID == "" || action.ObjectAPIName == "" {
+		return fmt.Errorf("callout response_mapping requires a saved record to update")
+	}
+	return as.persistence.Update(ctx, action.ObjectAPIName, recordID, updates, actionCtx.User)
+}
+
+// executeRunScript runs a script on the record with params rendered from templates. The
+// script's global result is stored in the step results and the fields it changed are saved
+// to the record (or, with write_back false, changed in-memory only).
+func (as *ActionService) executeRunScript(ctx context.Context, action *models.ActionMetadata, actionCtx *ActionContext) error {
+	if as.scripts == nil {
+		return fmt.Errorf("scripts are not available")
+	}
+	name, err := GetConfigStringRequired(action.Config, constants.ConfigScript)
+	if err != nil {
+		return err
+	}
+	var params map[string]interface{}
+	if templates, ok := GetConfigMap(action.Config, constants.ConfigParams); ok {
+		rendered, err := as.renderTemplate(ctx, templates, actionCtx, action.ObjectAPIName)
+		if err != nil {
+			return fmt.Errorf("failed to render script params: %w", err)
+		}
+		params, _ = rendered.(map[string]interface{})
+	}
+
+	result, err := as.scripts.RunAction(ctx, name, action.ObjectAPIName, actionCtx.Record, params, actionCtx.User)
+	if err != nil {
+		return err
+	}
+	actionCtx.Results[action.ID] = map[string]interface{}{"result": result.Value}
+
+	changes := result.Changes
+	delete(changes, constants.FieldID)
+	if len(changes) == 0 || actionCtx.Record == nil {
+		return nil
+	}
+	for field, value := range changes {
+		actionCtx.Record[field] = value
+	}
+	if writeBack, ok := action.Config[constants.ConfigWriteBack].(bool); ok && !writeBack {
+		return nil
+	}
+	recordID := actionCtx.Record.GetString(constants.FieldID)
+	if recordID == "" || action.ObjectAPIName == "" {
+		return fmt.Errorf("script %s changed a record that is not saved", name)
+	}
+	return as.persistence.Update(ctx, action.ObjectAPIName, recordID, changes, actionCtx.User)
+}
+
+// renderTemplate evaluates a request template: a value that is a single {!formula} keeps
+// the formula's type, {!formula} merge fields inside longer strings are substituted as text,
+// and maps and arrays are rendered recursively.
+func (as *ActionService) renderTemplate(ctx context.Context, value interface{}, actionCtx *ActionContext, sourceObjectName string) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			r, err := as.renderTemplate(ctx, item, actionCtx, sourceObjectName)
+			if err != nil {
+				return nil, err
+			}
+			rendered[k] = r
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, len(v))
+		for i, item := range v {
+			r, err := as.renderTemplate(ctx, item, actionCtx, sourceObjectName)
+			if err != nil {
+				return nil, err
+			}
+			rendered[i] = r
+		}
+		return rendered, nil
+	case string:
+		if matches := mergeFieldPattern.FindAllStringIndex(v, -1); len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(v) {
+			return as.evaluateRef(ctx, v, actionCtx, sourceObjectName)
+		}
+		var renderErr error
+		rendered := mergeFieldPattern.ReplaceAllStringFunc(v, func(field string) string {
+			result, err := as.evaluateRef(ctx, field, actionCtx, sourceObjectName)
+			if err != nil {
+				renderErr = err
+				return ""
+			}
+			if result == nil {
+				return ""
+			}
+			return fmt.Sprintf("%v", result)
+		})
+		return rendered, renderErr
+	default:
+		return value, nil
+	}
+}
+
 // getConfigValue extracts a value from action config and evaluates it if it's a formula
 func (as *ActionService) getConfigValue(ctx context.Context, config map[string]interface{}, key string, actionCtx *ActionContext, sourceObjectName string) (interface{}, error) {
 	value, exists := config[key]
@@ -0,0 +1,645 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/mailsync"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/secrets"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultSyncInterval applies when SYNC_INTERVAL is unset or invalid
+	defaultSyncInterval = 15 * time.Minute
+	// defaultSyncMatchFields applies when SYNC_MATCH_FIELDS is unset
+	defaultSyncMatchFields = "contact.email"
+	// syncInitialLookback is how far back the first sync of a connector reaches
+	syncInitialLookback = 7 * 24 * time.Hour
+	// syncCursorOverlap is re-read on the next run, catching items the provider indexed late
+	syncCursorOverlap = 5 * time.Minute
+	// syncMaxItems caps the emails and the events fetched per connector and run; a larger
+	// backlog is worked off over several runs
+	syncMaxItems = 500
+	// syncMatchChunk is the number of addresses matched per query
+	syncMatchChunk = 50
+	// syncStateTTL is how long an authorization link stays valid
+	syncStateTTL = 10 * time.Minute
+	// syncTokenRefreshMargin refreshes access tokens this close to expiry
+	syncTokenRefreshMargin = 2 * time.Minute
+	// activityListLimit caps the activities returned for a record
+	activityListLimit = 200
+)
+
+// SyncMatchField is an email field of an object; records whose field holds a participant
+// address of a synced item get an activity
+type SyncMatchField struct {
+	Object string
+	Field  string
+}
+
+// syncState is the OAuth state parameter: encrypted, so the callback can trust it
+type syncState struct {
+	UserID   string                 `json:"user_id"`
+	Provider constants.SyncProvider `json:"provider"`
+	Expires  time.Time              `json:"exp"`
+}
+
+// recordRef is a record an address matched
+type recordRef struct {
+	Object string
+	ID     string
+}
+
+var syncSystemContext = &models.UserSession{
+	ID:            "system-sync",
+	Name:          "Activity Sync",
+	ProfileID:     constants.ProfileSystemAdmin,
+	IsSystemAdmin: true,
+}
+
+// ActivitySyncService connects users' mailboxes and calendars and captures their emails
+// and events as activities on the records whose email fields match a participant.
+// Connectors are synced on the scheduler tick.
+type ActivitySyncService struct {
+	repo        *persistence.SyncRepository
+	providers   *mailsync.Registry
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	matchFields []SyncMatchField
+	interval    time.Duration       // 0 disables the scheduled runs
+	stats       *RecordStatsService // nil leaves last activity dates alone
+
+	mu      sync.Mutex // Serializes runs
+	lastRun time.Time
+}
+
+// NewActivitySyncService creates a new ActivitySyncService
+func NewActivitySyncService(repo *persistence.SyncRepository, providers *mailsync.Registry, metadata *MetadataService, query *QueryService, permissions *PermissionService, matchFields []SyncMatchField, interval time.Duration) *ActivitySyncService {
+	return &ActivitySyncService{
+		repo:        repo,
+		providers:   providers,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		matchFields: matchFields,
+		interval:    interval,
+	}
+}
+
+// syncMatchFields parses SYNC_MATCH_FIELDS, a comma-separated list of object.field email
+// fields (default "contact.email")
+func syncMatchFields(raw string) []SyncMatchField {
+	if raw == "" {
+		raw = defaultSyncMatchFields
+	}
+	return parseSyncMatchFields(raw)
+}
+
+func parseSyncMatchFields(raw string) []SyncMatchField {
+	fields := make([]SyncMatchField, 0)
+	for _, entry := range strings.Split(raw, ",") {
+		object, field, ok := strings.Cut(strings.TrimSpace(entry), ".")
+		if !ok || object == "" || field == "" {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				log.Printf("⚠️  Invalid SYNC_MATCH_FIELDS entry %q, expected object.field", entry)
+			}
+			continue
+		}
+		fields = append(fields, SyncMatchField{Object: strings.ToLower(object), Field: strings.ToLower(field)})
+	}
+	return fields
+}
+
+// SetRecordStats moves the last activity date of the records imported activities are logged on
+func (s *ActivitySyncService) SetRecordStats(stats *RecordStatsService) {
+	s.stats = stats
+}
+
+// Providers lists the providers users can connect
+func (s *ActivitySyncService) Providers() []constants.SyncProvider {
+	return s.providers.Names()
+}
+
+// AuthorizeURL returns the provider URL that starts connecting the user's account
+func (s *ActivitySyncService) AuthorizeURL(ctx context.Context, provider constants.SyncProvider, currentUser *models.UserSession) (string, error) {
+	p, err := s.providers.Get(provider)
+	if err != nil {
+		return "", errors.NewValidationError("provider", err.Error())
+	}
+	data, err := json.Marshal(syncState{UserID: currentUser.ID, Provider: provider, Expires: time.Now().Add(syncStateTTL)})
+	if err != nil {
+		return "", err
+	}
+	state, err := secrets.Encrypt(string(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to create authorization state: %w", err)
+	}
+	return p.AuthCodeURL(state), nil
+}
+
+// CompleteAuthorization redeems the code of the OAuth callback and stores the connector.
+// Reconnecting an account replaces its tokens and keeps its sync cursors.
+func (s *ActivitySyncService) CompleteAuthorization(ctx context.Context, state, code string) (*models.SyncConnector, error) {
+	var st syncState
+	plain, err := secrets.Decrypt(state)
+	if err != nil || json.Unmarshal([]byte(plain), &st) != nil || time.Now().After(st.Expires) {
+		return nil, errors.NewValidationError("state", "Authorization state is invalid or expired")
+	}
+	if code == "" {
+		return nil, errors.NewValidationError("code", "Authorization code is required")
+	}
+	p, err := s.providers.Get(st.Provider)
+	if err != nil {
+		return nil, errors.NewValidationError("provider", err.Error())
+	}
+
+	tok, err := p.Exchange(ctx, code)
+	if err != nil {
+		return nil, errors.NewValidationError("code", err.Error())
+	}
+	email, err := p.AccountEmail(ctx, tok.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s account: %w", st.Provider, err)
+	}
+
+	c, err := s.repo.FindConnectorByProvider(ctx, st.UserID, st.Provider)
+	if err != nil {
+		return nil, err
+	}
+	isNew := c == nil
+	if isNew {
+		c = &models.SystemSyncConnector{
+			ID:           utils.GenerateID(),
+			UserID:       st.UserID,
+			Provider:     string(st.Provider),
+			SyncEmail:    true,
+			SyncCalendar: true,
+		}
+	} else if tok.RefreshToken == "" && c.RefreshToken != nil {
+		// Providers may only issue a refresh token on the first consent
+		refresh, err := secrets.Decrypt(*c.RefreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt refresh token: %w", err)
+		}
+		tok.RefreshToken = refresh
+	}
+	c.AccountEmail = strings.ToLower(email)
+	c.IsActive = true
+	c.LastError = nil
+	if err := setConnectorToken(c, tok); err != nil {
+		return nil, err
+	}
+
+	if isNew {
+		err = s.repo.InsertConnector(ctx, c)
+	} else {
+		err = s.repo.UpdateConnector(ctx, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return syncConnectorView(c), nil
+}
+
+// ListConnectors returns the current user's connectors
+func (s *ActivitySyncService) ListConnectors(ctx context.Context, currentUser *models.UserSession) ([]*models.SyncConnector, error) {
+	connectors, err := s.repo.ListConnectors(ctx, currentUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]*models.SyncConnector, len(connectors))
+	for i, c := range connectors {
+		views[i] = syncConnectorView(c)
+	}
+	return views, nil
+}
+
+// UpdateConnector changes what one of the current user's connectors syncs
+func (s *ActivitySyncService) UpdateConnector(ctx context.Context, id string, update models.SyncConnectorUpdate, currentUser *models.UserSession) (*models.SyncConnector, error) {
+	c, err := s.ownedConnector(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if update.SyncEmail != nil {
+		c.SyncEmail = *update.SyncEmail
+	}
+	if update.SyncCalendar != nil {
+		c.SyncCalendar = *update.SyncCalendar
+	}
+	if update.IsActive != nil {
+		c.IsActive = *update.IsActive
+	}
+	if err := s.repo.UpdateConnector(ctx, c); err != nil {
+		return nil, err
+	}
+	return syncConnectorView(c), nil
+}
+
+// DeleteConnector disconnects one of the current user's accounts. Captured activities stay.
+func (s *ActivitySyncService) DeleteConnector(ctx context.Context, id string, currentUser *models.UserSession) error {
+	c, err := s.ownedConnector(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteConnector(ctx, c.ID)
+}
+
+// SyncConnector syncs one of the current user's connectors now
+func (s *ActivitySyncService) SyncConnector(ctx context.Context, id string, currentUser *models.UserSession) (*models.SyncResult, error) {
+	c, err := s.ownedConnector(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsActive {
+		return nil, errors.NewValidationError("is_active", "Connector is inactive")
+	}
+	return s.syncConnector(ctx, c, time.Now().UTC())
+}
+
+// Run syncs every active connector once the interval has passed (scheduler monitor)
+func (s *ActivitySyncService) Run(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.interval <= 0 || (!s.lastRun.IsZero() && now.Sub(s.lastRun) < s.interval) {
+		return
+	}
+	s.lastRun = now
+
+	connectors, err := s.repo.ListConnectors(ctx, "")
+	if err != nil {
+		log.Printf("⚠️ [Sync] Failed to load connectors: %v", err)
+		return
+	}
+	for _, c := range connectors {
+		if _, err := s.syncConnector(ctx, c, now.UTC()); err != nil {
+			log.Printf("⚠️ [Sync] %s connector %s: %v", c.Provider, c.ID, err)
+		}
+	}
+}
+
+// GetActivities returns the activities captured for a record the user can read
+func (s *ActivitySyncService) GetActivities(ctx context.Context, objectAPIName, recordID string, currentUser *models.UserSession) ([]*models.SystemActivity, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	return s.repo.ListActivities(ctx, schema.APIName, recordID, activityListLimit)
+}
+
+// syncConnector imports the emails and events received or changed since the connector's
+// cursors and records the outcome on the connector
+func (s *ActivitySyncService) syncConnector(ctx context.Context, c *models.SystemSyncConnector, runStart time.Time) (*models.SyncResult, error) {
+	result := &models.SyncResult{}
+	runErr := s.syncItems(ctx, c, runStart, result)
+
+	c.LastSyncDate = &runStart
+	c.LastError = nil
+	if runErr != nil {
+		msg := runErr.Error()
+		c.LastError = &msg
+	}
+	if err := s.repo.SaveSyncState(ctx, c); err != nil {
+		return nil, err
+	}
+	if runErr != nil {
+		return nil, runErr
+	}
+	return result, nil
+}
+
+func (s *ActivitySyncService) syncItems(ctx context.Context, c *models.SystemSyncConnector, runStart time.Time, result *models.SyncResult) error {
+	p, err := s.providers.Get(constants.SyncProvider(c.Provider))
+	if err != nil {
+		return err
+	}
+	accessToken, err := s.accessToken(ctx, p, c)
+	if err != nil {
+		return err
+	}
+
+	if c.SyncEmail {
+		since := syncSince(c.EmailSyncedUntil, runStart)
+		messages, err := p.ListMessages(ctx, accessToken, since, syncMaxItems)
+		if err != nil {
+			return fmt.Errorf("failed to list emails: %w", err)
+		}
+		activities := make([]*models.SystemActivity, 0, len(messages))
+		var last time.Time
+		for _, m := range messages {
+			activities = append(activities, messageActivity(c, m))
+			last = m.Date
+		}
+		created, err := s.capture(ctx, c, activities)
+		if err != nil {
+			return err
+		}
+		result.Emails = len(messages)
+		result.Activities += created
+		until := nextSyncCursor(since, runStart, len(messages), last)
+		c.EmailSyncedUntil = &until
+	}
+
+	if c.SyncCalendar {
+		since := syncSince(c.CalendarSyncedUntil, runStart)
+		events, err := p.ListEvents(ctx, accessToken, since, syncMaxItems)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+		activities := make([]*models.SystemActivity, 0, len(events))
+		var last time.Time
+		for _, e := range events {
+			last = e.Modified
+			if !e.Cancelled {
+				activities = append(activities, eventActivity(c, e))
+			}
+		}
+		created, err := s.capture(ctx, c, activities)
+		if err != nil {
+			return err
+		}
+		result.Events = len(events)
+		result.Activities += created
+		until := nextSyncCursor(since, runStart, len(events), last)
+		c.CalendarSyncedUntil = &until
+	}
+	return nil
+}
+
+// accessToken returns the connector's access token, refreshing it when it is about to expire
+func (s *ActivitySyncService) accessToken(ctx context.Context, p mailsync.Provider, c *models.SystemSyncConnector) (string, error) {
+	if c.TokenExpiresAt == nil || time.Until(*c.TokenExpiresAt) > syncTokenRefreshMargin || c.RefreshToken == nil {
+		token, err := secrets.Decrypt(c.AccessToken)
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt access token: %w", err)
+		}
+		return token, nil
+	}
+
+	refresh, err := secrets.Decrypt(*c.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt refresh token: %w", err)
+	}
+	tok, err := p.Refresh(ctx, refresh)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	if tok.RefreshToken == "" {
+		tok.RefreshToken = refresh
+	}
+	if err := setConnectorToken(c, tok); err != nil {
+		return "", err
+	}
+	if err := s.repo.UpdateConnector(ctx, c); err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+// capture links each item to the records its participants match and stores the activities
+// not captured before, returning how many were created
+func (s *ActivitySyncService) capture(ctx context.Context, c *models.SystemSyncConnector, items []*models.SystemActivity) (int, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+	addressSet := make(map[string]bool)
+	externalIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		for _, addr := range activityAddresses(item) {
+			if addr != c.AccountEmail {
+				addressSet[addr] = true
+			}
+		}
+		externalIDs = append(externalIDs, item.ExternalID)
+	}
+	addresses := make([]string, 0, len(addressSet))
+	for addr := range addressSet {
+		addresses = append(addresses, addr)
+	}
+	matches, err := s.matchRecords(ctx, addresses)
+	if err != nil {
+		return 0, err
+	}
+	if len(matches) == 0 {
+		return 0, nil
+	}
+
+	existing, err := s.repo.ExistingActivities(ctx, c.ID, externalIDs)
+	if err != nil {
+		return 0, err
+	}
+	activities := make([]*models.SystemActivity, 0)
+	for _, item := range items {
+		for _, ref := range itemMatches(item, c.AccountEmail, matches) {
+			key := persistence.ActivityKey(item.ExternalID, ref.ID)
+			if existing[key] {
+				continue
+			}
+			existing[key] = true
+			activity := *item
+			activity.ID = utils.GenerateID()
+			activity.ObjectAPIName = ref.Object
+			activity.RecordID = ref.ID
+			activities = append(activities, &activity)
+		}
+	}
+	if err := s.repo.InsertActivities(ctx, activities); err != nil {
+		return 0, err
+	}
+	s.stats.RecordActivities(ctx, activities)
+	return len(activities), nil
+}
+
+// matchRecords finds the records whose match fields hold one of the addresses. Records are
+// read as the system, so every matching record is linked whoever owns the mailbox; access
+// to the activities follows access to the record.
+func (s *ActivitySyncService) matchRecords(ctx context.Context, addresses []string) (map[string][]recordRef, error) {
+	matches := make(map[string][]recordRef)
+	for _, mf := range s.matchFields {
+		schema := s.metadata.GetSchema(ctx, mf.Object)
+		if schema == nil || FindField(schema, mf.Field) == nil {
+			log.Printf("⚠️ [Sync] Match field %s.%s does not exist", mf.Object, mf.Field)
+			continue
+		}
+		for start := 0; start < len(addresses); start += syncMatchChunk {
+			end := min(start+syncMatchChunk, len(addresses))
+			terms := make([]string, 0, end-start)
+			for _, addr := range addresses[start:end] {
+				terms = append(terms, fieldEquals(mf.Field, addr))
+			}
+			rows, err := s.query.Query(ctx, models.QueryRequest{
+				ObjectAPIName:   schema.APIName,
+				FilterExpr:      strings.Join(terms, " || "),
+				Limit:           syncMaxItems,
+				SkipLookupNames: true,
+			}, syncSystemContext)
+			if err != nil {
+				return nil, fmt.Errorf("failed to match %s.%s: %w", mf.Object, mf.Field, err)
+			}
+			for _, row := range rows {
+				addr := strings.ToLower(row.GetString(mf.Field))
+				matches[addr] = append(matches[addr], recordRef{Object: schema.APIName, ID: row.GetString(constants.FieldID)})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// ownedConnector loads a connector of the current user; others' connectors are not found
+func (s *ActivitySyncService) ownedConnector(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemSyncConnector, error) {
+	c, err := s.repo.FindConnector(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.UserID != currentUser.ID {
+		return nil, errors.NewNotFoundError("SyncConnector", id)
+	}
+	return c, nil
+}
+
+// setConnectorToken stores a token set on a connector, encrypted
+func setConnectorToken(c *models.SystemSyncConnector, tok *mailsync.Token) error {
+	access, err := secrets.Encrypt(tok.AccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt access token: %w", err)
+	}
+	c.AccessToken = access
+	c.RefreshToken = nil
+	if tok.RefreshToken != "" {
+		refresh, err := secrets.Encrypt(tok.RefreshToken)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt refresh token: %w", err)
+		}
+		c.RefreshToken = &refresh
+	}
+	c.TokenExpiresAt = nil
+	if !tok.ExpiresAt.IsZero() {
+		expires := tok.ExpiresAt.UTC()
+		c.TokenExpiresAt = &expires
+	}
+	return nil
+}
+
+// syncSince is where a listing starts: the cursor, or the initial lookback on the first run
+func syncSince(cursor *time.Time, runStart time.Time) time.Time {
+	if cursor == nil {
+		return runStart.Add(-syncInitialLookback)
+	}
+	return *cursor
+}
+
+// nextSyncCursor is where the next listing starts. A listing cut off at syncMaxItems resumes
+// from its last item; otherwise the next run starts shortly before this one did.
+func nextSyncCursor(since, runStart time.Time, fetched int, last time.Time) time.Time {
+	if fetched >= syncMaxItems && last.After(since) {
+		return last
+	}
+	next := runStart.Add(-syncCursorOverlap)
+	if next.Before(since) {
+		return since
+	}
+	return next
+}
+
+func messageActivity(c *models.SystemSyncConnector, m mailsync.Message) *models.SystemActivity {
+	to := strings.Join(m.To, ", ")
+	return &models.SystemActivity{
+		ActivityType: constants.ActivityTypeEmail,
+		Subject:      optionalString(m.Subject),
+		Description:  optionalString(m.Snippet),
+		FromAddress:  optionalString(m.From),
+		ToAddresses:  optionalString(to),
+		ActivityDate: m.Date,
+		ConnectorID:  c.ID,
+		ExternalID:   m.ID,
+		OwnerID:      c.UserID,
+	}
+}
+
+func eventActivity(c *models.SystemSyncConnector, e mailsync.Event) *models.SystemActivity {
+	end := e.End
+	return &models.SystemActivity{
+		ActivityType: constants.ActivityTypeEvent,
+		Subject:      optionalString(e.Subject),
+		Description:  optionalString(e.Description),
+		FromAddress:  optionalString(e.Organizer),
+		ToAddresses:  optionalString(strings.Join(e.Attendees, ", ")),
+		ActivityDate: e.Start,
+		EndDate:      &end,
+		ConnectorID:  c.ID,
+		ExternalID:   e.ID,
+		OwnerID:      c.UserID,
+	}
+}
+
+// activityAddresses lists the participants of an activity
+func activityAddresses(a *models.SystemActivity) []string {
+	var addresses []string
+	if a.FromAddress != nil {
+		addresses = append(addresses, *a.FromAddress)
+	}
+	if a.ToAddresses != nil {
+		for _, addr := range strings.Split(*a.ToAddresses, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+	return addresses
+}
+
+// itemMatches returns the records matched by an activity's participants other than the
+// mailbox owner, each once
+func itemMatches(a *models.SystemActivity, accountEmail string, matches map[string][]recordRef) []recordRef {
+	seen := make(map[recordRef]bool)
+	refs := make([]recordRef, 0)
+	for _, addr := range activityAddresses(a) {
+		if addr == accountEmail {
+			continue
+		}
+		for _, ref := range matches[addr] {
+			if !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// syncConnectorView is a connector without its tokens
+func syncConnectorView(c *models.SystemSyncConnector) *models.SyncConnector {
+	return &models.SyncConnector{
+		ID:                  c.ID,
+		UserID:              c.UserID,
+		Provider:            constants.SyncProvider(c.Provider),
+		AccountEmail:        c.AccountEmail,
+		SyncEmail:           c.SyncEmail,
+		SyncCalendar:        c.SyncCalendar,
+		IsActive:            c.IsActive,
+		EmailSyncedUntil:    c.EmailSyncedUntil,
+		CalendarSyncedUntil: c.CalendarSyncedUntil,
+		LastSyncDate:        c.LastSyncDate,
+		LastError:           c.LastError,
+		CreatedDate:         c.CreatedDate,
+		LastModifiedDate:    c.LastModifiedDate,
+	}
+}
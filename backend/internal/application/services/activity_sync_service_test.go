@@ -0,0 +1,46 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSyncMatchFields(t *testing.T) {
+	assert.Equal(t, []SyncMatchField{
+		{Object: "contact", Field: "email"},
+		{Object: "lead", Field: "work_email"},
+	}, parseSyncMatchFields(" Contact.Email, lead.work_email ,invalid,"))
+}
+
+func TestNextSyncCursor(t *testing.T) {
+	runStart := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	since := runStart.Add(-time.Hour)
+
+	assert.Equal(t, runStart.Add(-syncCursorOverlap), nextSyncCursor(since, runStart, 3, since.Add(time.Minute)))
+
+	last := since.Add(10 * time.Minute)
+	assert.Equal(t, last, nextSyncCursor(since, runStart, syncMaxItems, last), "a truncated listing resumes from its last item")
+
+	recent := runStart.Add(-time.Minute)
+	assert.Equal(t, recent, nextSyncCursor(recent, runStart, 0, time.Time{}), "the cursor never moves back")
+
+	assert.Equal(t, runStart.Add(-syncInitialLookback), syncSince(nil, runStart))
+}
+
+func TestItemMatches(t *testing.T) {
+	from, to := "jane@example.com", "me@example.com, bob@example.com, jane@example.com"
+	activity := &models.SystemActivity{FromAddress: &from, ToAddresses: &to}
+	matches := map[string][]recordRef{
+		"jane@example.com": {{Object: "contact", ID: "c1"}},
+		"bob@example.com":  {{Object: "contact", ID: "c2"}, {Object: "lead", ID: "l1"}},
+		"me@example.com":   {{Object: "contact", ID: "c3"}},
+	}
+	assert.Equal(t, []recordRef{
+		{Object: "contact", ID: "c1"},
+		{Object: "contact", ID: "c2"},
+		{Object: "lead", ID: "l1"},
+	}, itemMatches(activity, "me@example.com", matches), "the mailbox owner is not matched and records are linked once")
+}
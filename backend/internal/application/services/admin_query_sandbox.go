@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// adminQueryMaxRows caps the rows of an admin query; the governor may lower it further
+	adminQueryMaxRows = 1000
+	// adminQueryTimeout caps the execution time of an admin query; the governor may lower it further
+	adminQueryTimeout = 30 * time.Second
+	// adminQueryAuditNameLength is the length of _System_SetupAudit.component_name
+	adminQueryAuditNameLength = 512
+)
+
+// AdminQueryResult holds the rows of an admin query
+type AdminQueryResult struct {
+	Records   []models.SObject `json:"data"`
+	Truncated bool             `json:"truncated"` // More rows matched than the row limit returns
+}
+
+// adminQueryAudit is the audit record of an admin query
+type adminQueryAudit struct {
+	SQL         string        `json:"sql"`
+	Params      []interface{} `json:"params,omitempty"`
+	ExecutedSQL string        `json:"executed_sql,omitempty"` // After validation, row-level security and the row limit
+	MaxRows     int           `json:"max_rows"`
+	TimeoutMs   int64         `json:"timeout_ms"`
+	RowCount    int           `json:"row_count"`
+	Truncated   bool          `json:"truncated,omitempty"`
+	DurationMs  int64         `json:"duration_ms"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// ExecuteAdminQuery runs administrator SQL in the analytics sandbox. The SQL must be a
+// single SELECT; it is checked against object and field permissions, rewritten for
+// row-level security and capped with an injected LIMIT, then run in a read-only
+// transaction under a time limit. Every query is recorded in the setup audit trail,
+// whether it runs or not.
+func (qs *QueryService) ExecuteAdminQuery(ctx context.Context, sql string, params []interface{}, user *models.UserSession) (result *AdminQueryResult, err error) {
+	limits := qs.adminQueryLimits(ctx, user)
+	maxRows := limits.MaxRows
+	timeout := time.Duration(limits.MaxExecutionMs) * time.Millisecond
+	audit := adminQueryAudit{SQL: sql, Params: params, MaxRows: maxRows, TimeoutMs: timeout.Milliseconds()}
+	started := time.Now()
+	defer func() {
+		audit.DurationMs = time.Since(started).Milliseconds()
+		if err != nil {
+			audit.Error = err.Error()
+		} else {
+			audit.RowCount = len(result.Records)
+			audit.Truncated = result.Truncated
+		}
+		qs.setupAudit.Record(ctx, constants.SetupActionQuery, constants.SetupComponentAdminQuery, adminQueryAuditName(sql), "", nil, audit)
+	}()
+
+	// One row past the limit tells whether the result was cut short
+	safeSQL, safeParams, err := qs.validator.ValidateAndRewriteLimited(ctx, sql, params, user, maxRows+1)
+	if err != nil {
+		return nil, errors.NewValidationError("sql", fmt.Sprintf("security validation failed: %v", err))
+	}
+	audit.ExecutedSQL = safeSQL
+
+	release := func() {}
+	if qs.governor != nil {
+		if release, err = qs.governor.Acquire(user, limits); err != nil {
+			return nil, err
+		}
+	}
+	defer release()
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	records, err := qs.repo.ExecuteReadOnlySQL(runCtx, safeSQL, safeParams)
+	if err != nil {
+		if runCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			return nil, queryTimeoutError(limits)
+		}
+		return nil, err
+	}
+
+	result = &AdminQueryResult{Records: records}
+	if len(records) > maxRows {
+		result.Records = records[:maxRows]
+		result.Truncated = true
+	}
+	return result, nil
+}
+
+// adminQueryLimits returns the limits of an admin query: the sandbox caps on rows and
+// execution time, lowered by the user's governor limits where those are stricter
+func (qs *QueryService) adminQueryLimits(ctx context.Context, user *models.UserSession) models.QueryLimits {
+	limits := models.QueryLimits{MaxRows: adminQueryMaxRows, MaxExecutionMs: int(adminQueryTimeout.Milliseconds())}
+	if qs.governor == nil {
+		return limits
+	}
+	governed := qs.governor.Limits(ctx, user)
+	if governed.MaxRows > 0 && governed.MaxRows < limits.MaxRows {
+		limits.MaxRows = governed.MaxRows
+	}
+	if governed.MaxExecutionMs > 0 && governed.MaxExecutionMs < limits.MaxExecutionMs {
+		limits.MaxExecutionMs = governed.MaxExecutionMs
+	}
+	limits.MaxConcurrentQueries = governed.MaxConcurrentQueries
+	return limits
+}
+
+// adminQueryAuditName shortens SQL to fit the component name of its audit entry
+func adminQueryAuditName(sql string) string {
+	runes := []rune(sql)
+	if len(runes) <= adminQueryAuditNameLength {
+		return sql
+	}
+	return string(runes[:adminQueryAuditNameLength-1]) + "…"
+}
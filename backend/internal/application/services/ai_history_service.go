@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// aiHistoryPurgeInterval is how often expired agent history is purged
+const aiHistoryPurgeInterval = time.Hour
+
+// AIHistoryService stores the AI agent's per-user context files in _System_AI_Context_Item
+// and enforces the retention policy for agent conversations and context. Everything lives
+// in the database, so every backend instance sees the same history.
+type AIHistoryService struct {
+	repo      *persistence.SystemRepository
+	retention time.Duration // 0 keeps history forever
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewAIHistoryService creates an AIHistoryService purging history older than retention
+func NewAIHistoryService(repo *persistence.SystemRepository, retention time.Duration) *AIHistoryService {
+	return &AIHistoryService{
+		repo:      repo,
+		retention: retention,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// ContextItems returns the files pinned to the user's agent context
+func (s *AIHistoryService) ContextItems(ctx context.Context, userID string) ([]*models.SystemAIContextItem, error) {
+	return s.repo.GetAIContextItems(ctx, userID)
+}
+
+// PinContextItem adds a file to the user's agent context or refreshes its content
+func (s *AIHistoryService) PinContextItem(ctx context.Context, userID, path, content string, tokenSize int) error {
+	return s.repo.UpsertAIContextItem(ctx, &models.SystemAIContextItem{
+		ID:        GenerateID(),
+		UserID:    userID,
+		Path:      path,
+		Content:   content,
+		TokenSize: tokenSize,
+	})
+}
+
+// UnpinContextItem removes a file from the user's agent context
+func (s *AIHistoryService) UnpinContextItem(ctx context.Context, userID, path string) error {
+	return s.repo.DeleteAIContextItem(ctx, userID, path)
+}
+
+// ClearContext removes every file from the user's agent context
+func (s *AIHistoryService) ClearContext(ctx context.Context, userID string) error {
+	return s.repo.ClearAIContextItems(ctx, userID)
+}
+
+// PurgeExpired deletes conversations and context items untouched for longer than the
+// retention period
+func (s *AIHistoryService) PurgeExpired(ctx context.Context) (int64, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.PurgeAIHistory(ctx, time.Now().Add(-s.retention))
+}
+
+// StartRetentionWorker purges expired history now and then every interval.
+// It does nothing when history is kept forever.
+func (s *AIHistoryService) StartRetentionWorker(interval time.Duration) {
+	if s.retention <= 0 {
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.Printf("🧹 AI history retention worker started (keeping %s)", s.retention)
+		for {
+			if purged, err := s.PurgeExpired(context.Background()); err != nil {
+				log.Printf("⚠️ AI history purge failed: %v", err)
+			} else if purged > 0 {
+				log.Printf("🧹 Purged %d expired AI history rows", purged)
+			}
+
+			select {
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// StopRetentionWorker stops the retention worker gracefully
+func (s *AIHistoryService) StopRetentionWorker() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
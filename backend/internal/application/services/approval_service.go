@@ -3,11 +3,11 @@ package services
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
@@ -19,6 +19,7 @@ type ApprovalService struct {
 	permissions     *PermissionService
 	flowExecutor    *FlowExecutor
 	flowInstanceSvc *FlowInstanceService
+	notifications   *NotificationService
 }
 
 // NewApprovalService creates a new ApprovalService
@@ -28,6 +29,7 @@ func NewApprovalService(
 	perm *PermissionService,
 	fe *FlowExecutor,
 	fis *FlowInstanceService,
+	notifications *NotificationService,
 ) *ApprovalService {
 	return &ApprovalService{
 		persistence:     p,
@@ -35,6 +37,7 @@ func NewApprovalService(
 		permissions:     perm,
 		flowExecutor:    fe,
 		flowInstanceSvc: fis,
+		notifications:   notifications,
 	}
 }
 
@@ -55,12 +58,12 @@ func (s *ApprovalService) Submit(ctx context.Context, req SubmitRequest, user *m
 	// Find active approval process for this object
 	process, err := s.findActiveProcess(ctx, req.ObjectAPIName, user)
 	if err != nil || process == nil {
-		return nil, errors.New("no active approval process found for this object")
+		return nil, errors.NewValidationError(constants.FieldObjectAPIName, "no active approval process found for this object")
 	}
 
 	// Security check: verify user has read access to the record
 	if !s.permissions.CheckObjectPermissionWithUser(ctx, req.ObjectAPIName, constants.PermRead, user) {
-		return nil, errors.New("you don't have permission to submit this record for approval")
+		return nil, errors.NewPermissionError("submit for approval", req.ObjectAPIName)
 	}
 
 	// Check if already pending
@@ -69,7 +72,7 @@ func (s *ApprovalService) Submit(ctx context.Context, req SubmitRequest, user *m
 		return nil, fmt.Errorf("failed to check for pending approvals: %w", err)
 	}
 	if hasPending {
-		return nil, errors.New("record already has a pending approval")
+		return nil, errors.NewValidationError(constants.FieldRecordID, "record already has a pending approval")
 	}
 
 	// Determine approver (Business Logic)
@@ -95,7 +98,7 @@ func (s *ApprovalService) Submit(ctx context.Context, req SubmitRequest, user *m
 	// Run as System to bypass creating permission on system table
 	// We track the actual submitter in SubmittedByID field
 	systemUser := s.getSystemUser()
-	return s.persistence.Insert(ctx, constants.TableApprovalWorkItem, workItem, systemUser)
+	return s.persistence.Insert(WithSystemTableWrite(ctx), constants.TableApprovalWorkItem, workItem, systemUser)
 }
 
 // Approve approves a pending work item
@@ -108,6 +111,102 @@ func (s *ApprovalService) Reject(ctx context.Context, workItemID, comments strin
 	return s.processAction(ctx, workItemID, constants.ApprovalStatusRejected, comments, user)
 }
 
+// Recall withdraws a pending work item on behalf of its submitter and notifies its approver.
+// A flow waiting on the approval fails, as neither of its branches applies.
+func (s *ApprovalService) Recall(ctx context.Context, workItemID, comments string, user *models.UserSession) error {
+	return s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		item, err := s.getPendingWorkItem(txCtx, workItemID, user)
+		if err != nil {
+			return err
+		}
+		if !user.IsSystemAdmin && item.GetString(constants.FieldSysApprovalWorkItem_SubmittedByID) != user.ID {
+			return errors.NewPermissionError("recall", "Approval Work Item")
+		}
+
+		systemUser := s.getSystemUser()
+		if err := s.closeWorkItem(txCtx, workItemID, constants.ApprovalStatusRecalled, comments, user); err != nil {
+			return err
+		}
+
+		if instanceID := item.GetString(constants.FieldSysApprovalWorkItem_FlowInstanceID); instanceID != "" {
+			if err := s.flowInstanceSvc.FailInstance(txCtx, instanceID, "approval recalled", systemUser); err != nil {
+				log.Printf("⚠️ Failed to stop flow after approval recall: %v", err)
+			}
+		}
+
+		if approverID := item.GetString(constants.FieldSysApprovalWorkItem_ApproverID); approverID != "" && approverID != user.ID {
+			return s.notify(txCtx, approverID, item, "Approval request recalled",
+				fmt.Sprintf("%s recalled their approval request.", user.Name))
+		}
+		return nil
+	})
+}
+
+// Reassign routes a pending work item to another approver. The item is closed as reassigned
+// and a new pending item takes over for the new approver, so the record's approval history
+// shows the hand-over with its comments. The current approver or an administrator may
+// reassign.
+func (s *ApprovalService) Reassign(ctx context.Context, workItemID, approverID, comments string, user *models.UserSession) (models.SObject, error) {
+	var reassigned models.SObject
+	err := s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		item, err := s.getPendingWorkItem(txCtx, workItemID, user)
+		if err != nil {
+			return err
+		}
+		if !user.IsSystemAdmin && !s.isAuthorizedApprover(item, user) {
+			return errors.NewPermissionError("reassign", "Approval Work Item")
+		}
+		if approverID == item.GetString(constants.FieldSysApprovalWorkItem_ApproverID) {
+			return errors.NewValidationError(constants.FieldSysApprovalWorkItem_ApproverID, "the work item is already assigned to this approver")
+		}
+
+		systemUser := s.getSystemUser()
+		approvers, err := s.query.QueryWithFilter(txCtx, constants.TableUser, combineFilters(
+			fieldEquals(constants.FieldID, approverID),
+			constants.FieldSysUser_IsActive+" == true",
+		), systemUser, "", "", 1)
+		if err != nil {
+			return err
+		}
+		if len(approvers) == 0 {
+			return errors.NewValidationError(constants.FieldSysApprovalWorkItem_ApproverID, "approver must be an active user")
+		}
+
+		if err := s.closeWorkItem(txCtx, workItemID, constants.ApprovalStatusReassigned, comments, user); err != nil {
+			return err
+		}
+		reassigned, err = s.persistence.Insert(WithSystemTableWrite(txCtx), constants.TableApprovalWorkItem, models.SObject{
+			constants.FieldSysApprovalWorkItem_ProcessID:      item[constants.FieldSysApprovalWorkItem_ProcessID],
+			constants.FieldSysApprovalWorkItem_FlowInstanceID: item[constants.FieldSysApprovalWorkItem_FlowInstanceID],
+			constants.FieldSysApprovalWorkItem_FlowStepID:     item[constants.FieldSysApprovalWorkItem_FlowStepID],
+			constants.FieldSysApprovalWorkItem_ObjectAPIName:  item[constants.FieldSysApprovalWorkItem_ObjectAPIName],
+			constants.FieldSysApprovalWorkItem_RecordID:       item[constants.FieldSysApprovalWorkItem_RecordID],
+			constants.FieldSysApprovalWorkItem_Status:         constants.ApprovalStatusPending,
+			constants.FieldSysApprovalWorkItem_SubmittedByID:  item[constants.FieldSysApprovalWorkItem_SubmittedByID],
+			constants.FieldSysApprovalWorkItem_SubmittedDate:  item[constants.FieldSysApprovalWorkItem_SubmittedDate],
+			constants.FieldSysApprovalWorkItem_ApproverID:     approverID,
+			constants.FieldSysApprovalWorkItem_Comments:       comments,
+		}, systemUser)
+		if err != nil {
+			return fmt.Errorf("failed to reassign approval: %w", err)
+		}
+
+		if err := s.notify(txCtx, approverID, item, "Approval request assigned to you",
+			fmt.Sprintf("%s reassigned an approval request to you.", user.Name)); err != nil {
+			return err
+		}
+		if submitterID := item.GetString(constants.FieldSysApprovalWorkItem_SubmittedByID); submitterID != "" && submitterID != user.ID {
+			return s.notify(txCtx, submitterID, item, "Approval request reassigned",
+				fmt.Sprintf("%s reassigned your approval request to another approver.", user.Name))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reassigned, nil
+}
+
 // GetPending returns pending approvals for the current user
 func (s *ApprovalService) GetPending(ctx context.Context, user *models.UserSession) ([]models.SObject, error) {
 	filterExpr := fmt.Sprintf("%s == '%s' && %s == '%s'", constants.FieldSysApprovalWorkItem_ApproverID, user.ID, constants.FieldSysApprovalWorkItem_Status, constants.ApprovalStatusPending)
@@ -154,13 +253,9 @@ func (s *ApprovalService) processAction(ctx context.Context, workItemID, newStat
 	// Execute in transaction to ensure atomicity of update and flow resumption
 	return s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
 		// Fetch and validate work item
-		item, err := s.getWorkItem(txCtx, workItemID, user)
+		item, err := s.getPendingWorkItem(txCtx, workItemID, user)
 		if err != nil {
-			return errors.New("approval work item not found")
-		}
-
-		if item[constants.FieldSysApprovalWorkItem_Status] != constants.ApprovalStatusPending {
-			return errors.New("work item is not pending")
+			return err
 		}
 
 		// Verify user is authorized to act on this item
@@ -169,21 +264,12 @@ func (s *ApprovalService) processAction(ctx context.Context, workItemID, newStat
 			if newStatus == constants.ApprovalStatusRejected {
 				action = "reject"
 			}
-			return fmt.Errorf("you are not authorized to %s this item", action)
+			return errors.NewPermissionError(action, "Approval Work Item")
 		}
 
 		// Update work item
-		updates := models.SObject{
-			constants.FieldSysApprovalWorkItem_Status:       newStatus,
-			constants.FieldSysApprovalWorkItem_ApprovedByID: user.ID,
-			constants.FieldSysApprovalWorkItem_ApprovedDate: time.Now().UTC(),
-			constants.FieldSysApprovalWorkItem_Comments:     comments,
-		}
-
-		// Run as System to bypass permission check on system table
-		systemUser := s.getSystemUser()
-		if err := s.persistence.Update(txCtx, constants.TableApprovalWorkItem, workItemID, updates, systemUser); err != nil {
-			return fmt.Errorf("failed to update approval: %w", err)
+		if err := s.closeWorkItem(txCtx, workItemID, newStatus, comments, user); err != nil {
+			return err
 		}
 
 		// Resume flow if needed - passed txCtx will ensure it participates in transaction
@@ -193,6 +279,50 @@ func (s *ApprovalService) processAction(ctx context.Context, workItemID, newStat
 	})
 }
 
+// getPendingWorkItem returns a work item that is still pending
+func (s *ApprovalService) getPendingWorkItem(ctx context.Context, workItemID string, user *models.UserSession) (models.SObject, error) {
+	item, err := s.getWorkItem(ctx, workItemID, user)
+	if err != nil || item == nil {
+		return nil, errors.NewNotFoundError("Approval Work Item", workItemID)
+	}
+	if item[constants.FieldSysApprovalWorkItem_Status] != constants.ApprovalStatusPending {
+		return nil, errors.NewValidationError(constants.FieldStatus, "work item is not pending")
+	}
+	return item, nil
+}
+
+// closeWorkItem records how and by whom a pending work item was closed. It runs as System to
+// bypass the permission check on the system table.
+func (s *ApprovalService) closeWorkItem(ctx context.Context, workItemID, status, comments string, user *models.UserSession) error {
+	updates := models.SObject{
+		constants.FieldSysApprovalWorkItem_Status:       status,
+		constants.FieldSysApprovalWorkItem_ApprovedByID: user.ID,
+		constants.FieldSysApprovalWorkItem_ApprovedDate: time.Now().UTC(),
+		constants.FieldSysApprovalWorkItem_Comments:     comments,
+	}
+	if err := s.persistence.Update(WithSystemTableWrite(ctx), constants.TableApprovalWorkItem, workItemID, updates, s.getSystemUser()); err != nil {
+		return fmt.Errorf("failed to update approval: %w", err)
+	}
+	return nil
+}
+
+// notify sends a notification about a work item that links to its record
+func (s *ApprovalService) notify(ctx context.Context, recipientID string, item models.SObject, title, body string) error {
+	notification := models.SystemNotification{
+		ID:               GenerateID(),
+		RecipientID:      recipientID,
+		Title:            title,
+		Body:             body,
+		Link:             fmt.Sprintf("/object/%s/%s", item.GetString(constants.FieldSysApprovalWorkItem_ObjectAPIName), item.GetString(constants.FieldSysApprovalWorkItem_RecordID)),
+		NotificationType: "approval",
+		CreatedDate:      time.Now(),
+	}
+	if err := s.notifications.CreateNotification(ctx, notification, s.getSystemUser()); err != nil {
+		return fmt.Errorf("notify %s: %w", recipientID, err)
+	}
+	return nil
+}
+
 func (s *ApprovalService) findActiveProcess(ctx context.Context, objectAPIName string, user *models.UserSession) (models.SObject, error) {
 	filterExpr := fmt.Sprintf("%s == '%s' && %s == true", constants.FieldSysApprovalProcess_ObjectAPIName, objectAPIName, constants.FieldSysApprovalProcess_IsActive)
 	processes, err := s.query.QueryWithFilter(
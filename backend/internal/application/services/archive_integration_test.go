@@ -0,0 +1,76 @@
+package services_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivePolicy_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	code := testharness.Field("code", constants.FieldTypeText)
+	code.Required = true
+	code.IsUnique = true
+	obj := h.CreateObject(t, "archived", code)
+
+	// age backdates a record past the retention of the policy
+	age := func(record models.SObject) {
+		_, err := h.DB.DB().ExecContext(ctx,
+			fmt.Sprintf("UPDATE `%s` SET `%s` = ? WHERE `%s` = ?", obj.APIName, constants.FieldCreatedDate, constants.FieldID),
+			time.Now().UTC().AddDate(0, 0, -90), record[constants.FieldID])
+		require.NoError(t, err)
+	}
+	query := func(includeArchived bool) []models.SObject {
+		records, err := h.Services.QuerySvc.Query(ctx, models.QueryRequest{ObjectAPIName: obj.APIName, IncludeArchived: includeArchived}, h.Admin)
+		require.NoError(t, err)
+		return records
+	}
+
+	old := h.CreateRecord(t, obj.APIName, models.SObject{"name": "Old", "code": "A-1"})
+	age(old)
+	h.CreateRecord(t, obj.APIName, models.SObject{"name": "Recent", "code": "A-2"})
+
+	_, err := h.Services.Archive.SavePolicy(ctx, obj.APIName, &models.SystemArchivePolicy{RetainDays: 30, IsActive: true})
+	require.NoError(t, err)
+	policy, err := h.Services.Archive.RunPolicy(ctx, obj.APIName)
+	require.NoError(t, err)
+	assert.Equal(t, 1, policy.LastArchivedCount)
+	assert.Nil(t, policy.LastError)
+
+	assert.Len(t, query(false), 1)
+	assert.Len(t, query(true), 2)
+
+	t.Run("the archive gains the fields added since it was created", func(t *testing.T) {
+		require.NoError(t, h.Services.Metadata.CreateField(ctx, obj.APIName, &models.FieldMetadata{
+			APIName: "region", Label: "Region", Type: constants.FieldTypeText,
+		}))
+
+		// The archive keeps no unique index, so a reused code can be archived again
+		reused := h.CreateRecord(t, obj.APIName, models.SObject{"name": "Reused", "code": "A-1", "region": "EMEA"})
+		age(reused)
+		policy, err := h.Services.Archive.RunPolicy(ctx, obj.APIName)
+		require.NoError(t, err)
+		assert.Nil(t, policy.LastError)
+		assert.Equal(t, 1, policy.LastArchivedCount)
+
+		var region string
+		err = h.DB.DB().QueryRowContext(ctx,
+			fmt.Sprintf("SELECT `region` FROM `%s` WHERE `%s` = ?", persistence.ArchiveTableName(obj.APIName), constants.FieldID),
+			reused[constants.FieldID]).Scan(&region)
+		require.NoError(t, err)
+		assert.Equal(t, "EMEA", region)
+		assert.Len(t, query(true), 3)
+	})
+}
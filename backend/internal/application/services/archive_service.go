@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	domainSchema "github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultArchiveInterval applies when ARCHIVE_INTERVAL is unset or invalid
+	defaultArchiveInterval = time.Hour
+	// archiveBatchSize is the number of rows moved per transaction
+	archiveBatchSize = 500
+	// archiveMaxBatches caps the batches of one policy per run, so a large backlog is
+	// worked off over several runs instead of holding the scheduler
+	archiveMaxBatches = 20
+)
+
+// ArchiveService keeps the tables of high-volume objects small by moving rows older than
+// a policy's retention into the object's {table}__archive table. Archived rows stay
+// readable through queries that set include_archived.
+type ArchiveService struct {
+	repo     *persistence.ArchiveRepository
+	metadata *MetadataService
+	interval time.Duration // 0 disables the scheduled runs
+
+	mu      sync.Mutex // Serializes runs
+	lastRun time.Time
+}
+
+// NewArchiveService creates a new ArchiveService
+func NewArchiveService(repo *persistence.ArchiveRepository, metadata *MetadataService, interval time.Duration) *ArchiveService {
+	return &ArchiveService{
+		repo:     repo,
+		metadata: metadata,
+		interval: interval,
+	}
+}
+
+// GetPolicies returns every archival policy
+func (s *ArchiveService) GetPolicies(ctx context.Context) ([]*models.SystemArchivePolicy, error) {
+	return s.repo.ListPolicies(ctx)
+}
+
+// GetPolicy returns the archival policy of an object
+func (s *ArchiveService) GetPolicy(ctx context.Context, objectAPIName string) (*models.SystemArchivePolicy, error) {
+	objectAPIName = strings.ToLower(objectAPIName)
+	policy, err := s.repo.GetPolicy(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, errors.NewNotFoundError("Archive policy", objectAPIName)
+	}
+	return policy, nil
+}
+
+// SavePolicy creates or replaces the archival policy of an object. The date field
+// defaults to the created date.
+func (s *ArchiveService) SavePolicy(ctx context.Context, objectAPIName string, input *models.SystemArchivePolicy) (*models.SystemArchivePolicy, error) {
+	objectAPIName = strings.ToLower(objectAPIName)
+	if input.DateField == "" {
+		input.DateField = constants.FieldCreatedDate
+	}
+	if err := s.validatePolicy(ctx, objectAPIName, input); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetPolicy(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		policy := &models.SystemArchivePolicy{
+			ID:            GenerateID(),
+			ObjectAPIName: objectAPIName,
+			DateField:     input.DateField,
+			RetainDays:    input.RetainDays,
+			IsActive:      input.IsActive,
+		}
+		if err := s.repo.InsertPolicy(ctx, policy); err != nil {
+			return nil, err
+		}
+	} else {
+		existing.DateField = input.DateField
+		existing.RetainDays = input.RetainDays
+		existing.IsActive = input.IsActive
+		if err := s.repo.UpdatePolicy(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetPolicy(ctx, objectAPIName)
+}
+
+// DeletePolicy stops archiving an object. Rows already archived stay in the archive table.
+func (s *ArchiveService) DeletePolicy(ctx context.Context, objectAPIName string) error {
+	policy, err := s.GetPolicy(ctx, objectAPIName)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeletePolicy(ctx, policy.ObjectAPIName)
+}
+
+// RunPolicy archives an object now, whether or not its policy is active, and returns the
+// policy with the outcome recorded
+func (s *ArchiveService) RunPolicy(ctx context.Context, objectAPIName string) (*models.SystemArchivePolicy, error) {
+	policy, err := s.GetPolicy(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.archiveLocked(ctx, policy, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return s.GetPolicy(ctx, policy.ObjectAPIName)
+}
+
+// Run archives every active policy once the configured interval has passed since the last
+// run. It runs on the scheduler tick.
+func (s *ArchiveService) Run(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.interval <= 0 || (!s.lastRun.IsZero() && now.Sub(s.lastRun) < s.interval) {
+		return
+	}
+	s.lastRun = now
+
+	policies, err := s.repo.ListPolicies(ctx)
+	if err != nil {
+		log.Printf("⚠️ [Archive] Failed to load policies: %v", err)
+		return
+	}
+	for _, policy := range policies {
+		if !policy.IsActive {
+			continue
+		}
+		if err := s.archiveLocked(ctx, policy, now.UTC()); err != nil {
+			log.Printf("⚠️ [Archive] %s: %v", policy.ObjectAPIName, err)
+		}
+	}
+}
+
+// archiveLocked moves the rows of one policy's object that are past retention, then
+// records the outcome on the policy
+func (s *ArchiveService) archiveLocked(ctx context.Context, policy *models.SystemArchivePolicy, now time.Time) error {
+	archived, runErr := s.archiveRows(ctx, policy, now)
+
+	var lastError *string
+	if runErr != nil {
+		msg := runErr.Error()
+		lastError = &msg
+	}
+	if err := s.repo.RecordRun(ctx, policy.ID, now, archived, lastError); err != nil {
+		log.Printf("⚠️ [Archive] Failed to record run of %s: %v", policy.ObjectAPIName, err)
+	}
+	if archived > 0 {
+		log.Printf("🗄️ [Archive] Moved %d %s record(s) older than %d day(s) to %s",
+			archived, policy.ObjectAPIName, policy.RetainDays, persistence.ArchiveTableName(policy.ObjectAPIName))
+	}
+	return runErr
+}
+
+func (s *ArchiveService) archiveRows(ctx context.Context, policy *models.SystemArchivePolicy, now time.Time) (int, error) {
+	if err := s.validatePolicy(ctx, policy.ObjectAPIName, policy); err != nil {
+		return 0, err
+	}
+	def, err := s.tableDefinition(ctx, policy.ObjectAPIName)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.repo.EnsureArchiveTable(ctx, def); err != nil {
+		return 0, err
+	}
+
+	cutoff := archiveCutoff(now, policy.RetainDays)
+	archived := 0
+	for i := 0; i < archiveMaxBatches; i++ {
+		moved, err := s.repo.ArchiveBatch(ctx, policy.ObjectAPIName, policy.DateField, cutoff, archiveBatchSize)
+		archived += moved
+		if err != nil {
+			return archived, err
+		}
+		if moved < archiveBatchSize {
+			break
+		}
+	}
+	return archived, nil
+}
+
+// tableDefinition returns the columns of an object's table as its metadata describes them.
+// Formula columns take the type of their return type.
+func (s *ArchiveService) tableDefinition(ctx context.Context, objectAPIName string) (domainSchema.TableDefinition, error) {
+	obj := s.metadata.GetSchema(ctx, objectAPIName)
+	if obj == nil {
+		return domainSchema.TableDefinition{}, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	// PrepareTableDefinition fills in the object it is given; keep the cached one intact
+	copied := *obj
+	copied.Fields = append([]models.FieldMetadata(nil), obj.Fields...)
+	def, _, err := s.metadata.PrepareTableDefinition(&copied)
+	if err != nil {
+		return domainSchema.TableDefinition{}, err
+	}
+	for i := range def.Columns {
+		if field := FindField(&copied, def.Columns[i].Name); field != nil && field.Type == constants.FieldTypeFormula && field.ReturnType != nil {
+			def.Columns[i].Type = s.metadata.schemaMgr.MapFieldTypeToSQL(string(*field.ReturnType))
+		}
+	}
+	return def, nil
+}
+
+// archiveCutoff is the instant before which rows are past a retention of retainDays
+func archiveCutoff(now time.Time, retainDays int) time.Time {
+	return now.AddDate(0, 0, -retainDays)
+}
+
+// validatePolicy checks that an object can be archived by a policy
+func (s *ArchiveService) validatePolicy(ctx context.Context, objectAPIName string, policy *models.SystemArchivePolicy) error {
+	obj := s.metadata.GetSchema(ctx, objectAPIName)
+	if obj == nil {
+		return errors.NewNotFoundError("Object", objectAPIName)
+	}
+	return validateArchivePolicy(obj, policy)
+}
+
+// validateArchivePolicy checks a policy against the object it archives
+func validateArchivePolicy(obj *models.ObjectMetadata, policy *models.SystemArchivePolicy) error {
+	if obj.IsExternal {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_ObjectAPIName, "external objects cannot be archived")
+	}
+	if constants.IsSystemTable(obj.APIName) {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_ObjectAPIName, "system objects cannot be archived")
+	}
+	if len(persistence.ArchiveTableName(obj.APIName)) > maxIdentifierLength {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_ObjectAPIName,
+			fmt.Sprintf("object name is too long for an archive table (at most %d characters)", maxIdentifierLength-len(persistence.ArchiveSuffix)))
+	}
+	if policy.RetainDays < 1 {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_RetainDays, "must keep records for at least one day")
+	}
+	field := FindField(obj, policy.DateField)
+	if field == nil {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_DateField, fmt.Sprintf("field '%s' does not exist on %s", policy.DateField, obj.APIName))
+	}
+	if field.Type != constants.FieldTypeDate && field.Type != constants.FieldTypeDateTime {
+		return errors.NewValidationError(constants.FieldSysArchivePolicy_DateField, "must be a Date or DateTime field")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateArchivePolicy(t *testing.T) {
+	ticket := &models.ObjectMetadata{APIName: "ticket", Fields: []models.FieldMetadata{
+		{APIName: constants.FieldCreatedDate, Type: constants.FieldTypeDateTime},
+		{APIName: "closed_on", Type: constants.FieldTypeDate},
+		{APIName: "subject", Type: constants.FieldTypeText},
+	}}
+
+	tests := []struct {
+		name   string
+		obj    *models.ObjectMetadata
+		policy models.SystemArchivePolicy
+		field  string // Field of the expected validation error; "" when valid
+	}{
+		{"created date", ticket, models.SystemArchivePolicy{DateField: constants.FieldCreatedDate, RetainDays: 365}, ""},
+		{"date field", ticket, models.SystemArchivePolicy{DateField: "closed_on", RetainDays: 30}, ""},
+		{"no retention", ticket, models.SystemArchivePolicy{DateField: "closed_on"}, constants.FieldSysArchivePolicy_RetainDays},
+		{"missing field", ticket, models.SystemArchivePolicy{DateField: "resolved_on", RetainDays: 30}, constants.FieldSysArchivePolicy_DateField},
+		{"text field", ticket, models.SystemArchivePolicy{DateField: "subject", RetainDays: 30}, constants.FieldSysArchivePolicy_DateField},
+		{"external object", &models.ObjectMetadata{APIName: "erp_order", IsExternal: true}, models.SystemArchivePolicy{RetainDays: 30}, constants.FieldSysArchivePolicy_ObjectAPIName},
+		{"system object", &models.ObjectMetadata{APIName: constants.TableUser}, models.SystemArchivePolicy{RetainDays: 30}, constants.FieldSysArchivePolicy_ObjectAPIName},
+		{"name too long", &models.ObjectMetadata{APIName: strings.Repeat("x", 60)}, models.SystemArchivePolicy{RetainDays: 30}, constants.FieldSysArchivePolicy_ObjectAPIName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateArchivePolicy(tt.obj, &tt.policy)
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			validationErr, ok := err.(*errors.ValidationError)
+			if assert.True(t, ok, "expected a validation error, got %v", err) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestArchiveCutoff(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 2, 9, 12, 0, 0, 0, time.UTC), archiveCutoff(now, 30))
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// AsyncJobService runs long operations in the background and records their progress in
+// _System_AsyncJob so clients can poll for the outcome, or abort the job
+type AsyncJobService struct {
+	repo    *persistence.AsyncJobRepository
+	mu      sync.Mutex
+	running map[string]context.CancelFunc // Cancels the jobs running in this process, by ID
+}
+
+// NewAsyncJobService creates a new AsyncJobService
+func NewAsyncJobService(repo *persistence.AsyncJobRepository) *AsyncJobService {
+	return &AsyncJobService{repo: repo, running: make(map[string]context.CancelFunc)}
+}
+
+// AsyncJobFunc performs the work of a job. It may update the job's counters and call
+// report to persist progress while running. Its context is cancelled when the job is
+// aborted; work should stop soon after.
+type AsyncJobFunc func(ctx context.Context, job *models.SystemAsyncJob, report func()) error
+
+// Enqueue stores a queued job and runs it in the background
+func (s *AsyncJobService) Enqueue(ctx context.Context, jobType, objectAPIName string, params interface{}, total int, currentUser *models.UserSession, run AsyncJobFunc) (*models.SystemAsyncJob, error) {
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &models.SystemAsyncJob{
+		ID:         GenerateID(),
+		JobType:    jobType,
+		Status:     string(constants.AsyncJobStatusQueued),
+		Parameters: paramsJSON,
+		TotalCount: total,
+	}
+	if objectAPIName != "" {
+		job.ObjectAPIName = &objectAPIName
+	}
+	if currentUser != nil {
+		job.CreatedByID = &currentUser.ID
+	}
+	if err := s.repo.Insert(ctx, job); err != nil {
+		return nil, err
+	}
+
+	queued := *job
+	go s.run(&queued, run)
+	return job, nil
+}
+
+// GetJob returns a job by ID
+func (s *AsyncJobService) GetJob(ctx context.Context, id string) (*models.SystemAsyncJob, error) {
+	job, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, errors.NewNotFoundError("Async job", id)
+	}
+	return job, nil
+}
+
+// Abort stops a queued or running job. A job running in this process is cancelled at once;
+// one running elsewhere stops when it next reports progress.
+func (s *AsyncJobService) Abort(ctx context.Context, id string) (*models.SystemAsyncJob, error) {
+	job, err := s.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	aborted, err := s.repo.Abort(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !aborted {
+		return nil, errors.NewValidationError(constants.FieldSysAsyncJob_Status, "the job has already "+job.Status)
+	}
+
+	s.mu.Lock()
+	if cancel, ok := s.running[id]; ok {
+		cancel()
+	}
+	s.mu.Unlock()
+	log.Printf("🛑 Async job %s (%s) aborted", job.ID, job.JobType)
+	return s.GetJob(ctx, id)
+}
+
+// asyncJobActive reports whether a job is queued or running
+func asyncJobActive(job *models.SystemAsyncJob) bool {
+	return job.Status == string(constants.AsyncJobStatusQueued) || job.Status == string(constants.AsyncJobStatusRunning)
+}
+
+// run executes a job with a background context, as it outlives the request that queued it
+func (s *AsyncJobService) run(job *models.SystemAsyncJob, fn AsyncJobFunc) {
+	jobCtx, cancel := context.WithCancel(context.Background())
+	s.mu.Lock()
+	s.running[job.ID] = cancel
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, job.ID)
+		s.mu.Unlock()
+		cancel()
+	}()
+
+	// Progress is saved even once the job is cancelled
+	ctx := context.Background()
+	report := func() {
+		saved, err := s.repo.UpdateProgress(ctx, job)
+		if err != nil {
+			log.Printf("⚠️ Async job %s: failed to record progress: %v", job.ID, err)
+		} else if !saved {
+			// Aborted by another process
+			cancel()
+		}
+	}
+
+	started := time.Now()
+	job.Status = string(constants.AsyncJobStatusRunning)
+	job.StartedDate = &started
+	report()
+
+	err := fn(jobCtx, job, report)
+
+	completed := time.Now()
+	job.CompletedDate = &completed
+	job.Status = string(constants.AsyncJobStatusCompleted)
+	switch {
+	case jobCtx.Err() != nil:
+		job.Status = string(constants.AsyncJobStatusAborted)
+		log.Printf("🛑 Async job %s (%s) stopped after %d records", job.ID, job.JobType, job.ProcessedCount+job.FailedCount)
+	case err != nil:
+		msg := err.Error()
+		job.Status = string(constants.AsyncJobStatusFailed)
+		job.ErrorMessage = &msg
+		log.Printf("❌ Async job %s (%s) failed: %v", job.ID, job.JobType, err)
+	}
+	report()
+}
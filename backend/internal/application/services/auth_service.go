@@ -10,6 +10,7 @@ import (
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -45,8 +46,17 @@ type LoginResult struct {
 	ExpiresAt time.Time
 }
 
-// Login authenticates a user and creates a session
+// Login authenticates an internal user and creates a session
 func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent string) (*LoginResult, error) {
+	return s.login(ctx, email, password, ip, userAgent, false)
+}
+
+// PortalLogin authenticates a portal user and creates a portal session
+func (s *AuthService) PortalLogin(ctx context.Context, email, password, ip, userAgent string) (*LoginResult, error) {
+	return s.login(ctx, email, password, ip, userAgent, true)
+}
+
+func (s *AuthService) login(ctx context.Context, email, password, ip, userAgent string, portal bool) (*LoginResult, error) {
 	// 1. Find user by email
 	user, err := s.userRepo.FindUserByEmailWithPassword(ctx, email)
 	if err != nil {
@@ -56,18 +66,15 @@ func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent
 		log.Printf("⚠️ Login failed for %s: user not found", email)
 		return nil, errors.NewUnauthorizedError("Invalid email or password")
 	}
-
-	// Construct Display Name
-	displayName := user.Username
-	fullNameParts := []string{}
-	if user.FirstName != "" {
-		fullNameParts = append(fullNameParts, user.FirstName)
-	}
-	if user.LastName != "" {
-		fullNameParts = append(fullNameParts, user.LastName)
+	// Deactivated users (e.g. deprovisioned through SCIM) cannot sign in
+	if !user.IsActive {
+		log.Printf("⚠️ Login failed for %s: user is inactive", email)
+		return nil, errors.NewUnauthorizedError("Invalid email or password")
 	}
-	if len(fullNameParts) > 0 {
-		displayName = strings.Join(fullNameParts, " ")
+	// Portal and internal users each sign in only through their own login
+	if (user.UserType == constants.UserTypePortal) != portal {
+		log.Printf("⚠️ Login failed for %s: wrong login for user type %q", email, user.UserType)
+		return nil, errors.NewUnauthorizedError("Invalid email or password")
 	}
 
 	// 2. Verify password
@@ -81,7 +88,56 @@ func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent
 		return nil, errors.NewUnauthorizedError("Invalid email or password")
 	}
 
-	// 3. Create user session object
+	return s.startSession(ctx, user, ip, userAgent)
+}
+
+// IssueToken starts a session for the internal user with an ID or email without checking a
+// password. It is for operator tooling on the server host (nexusctl auth issue-token), never
+// for API requests.
+func (s *AuthService) IssueToken(ctx context.Context, userIDOrEmail, userAgent string) (*LoginResult, error) {
+	email := userIDOrEmail
+	if !strings.Contains(userIDOrEmail, "@") {
+		user, err := s.userRepo.GetUserByID(ctx, userIDOrEmail)
+		if err != nil {
+			return nil, fmt.Errorf("database error: %w", err)
+		}
+		if user == nil {
+			return nil, errors.NewNotFoundError("User", userIDOrEmail)
+		}
+		email = user.Email
+	}
+	user, err := s.userRepo.FindUserByEmailWithPassword(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if user == nil {
+		return nil, errors.NewNotFoundError("User", userIDOrEmail)
+	}
+	if !user.IsActive {
+		return nil, errors.NewValidationError(constants.FieldSysUser_IsActive, "User is inactive")
+	}
+	if user.UserType == constants.UserTypePortal {
+		return nil, errors.NewValidationError(constants.FieldSysUser_UserType, "Portal users sign in through the portal")
+	}
+	return s.startSession(ctx, user, "", userAgent)
+}
+
+// startSession issues a token for an authenticated user and records its session
+func (s *AuthService) startSession(ctx context.Context, user *persistence.UserWithPassword, ip, userAgent string) (*LoginResult, error) {
+	// 1. Construct Display Name
+	displayName := user.Username
+	fullNameParts := []string{}
+	if user.FirstName != "" {
+		fullNameParts = append(fullNameParts, user.FirstName)
+	}
+	if user.LastName != "" {
+		fullNameParts = append(fullNameParts, user.LastName)
+	}
+	if len(fullNameParts) > 0 {
+		displayName = strings.Join(fullNameParts, " ")
+	}
+
+	// 2. Create user session object
 	// RoleID is populated by FindUserByEmailWithPassword (Repository Layer)
 
 	userSession := auth.UserSession{
@@ -90,20 +146,22 @@ func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent
 		Email:     user.Email,
 		ProfileId: user.ProfileID,
 		RoleId:    user.RoleID,
+		UserType:  user.UserType,
+		Locale:    derefString(user.Locale),
 	}
 
-	// 4. Generate JWT token
+	// 3. Generate JWT token
 	token, err := auth.GenerateToken(userSession)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	// 5. Decode token to get expiry
+	// 4. Decode token to get expiry
 	claims, _ := auth.DecodeToken(token)
 	expiresAt := time.Unix(claims.ExpiresAt.Unix(), 0)
 	createdAt := time.Now()
 
-	// 6. Store session in database using SessionRepository
+	// 5. Store session in database using SessionRepository
 	sessionStruct := &models.SystemSession{
 		ID:           claims.RegisteredClaims.ID,
 		UserID:       user.ID,
@@ -126,8 +184,32 @@ func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent
 	}, nil
 }
 
-// ValidateSession checks if a session token is valid and active in the database
+// ValidateSession checks if an internal user's session token is valid and active in the database.
+// Portal sessions are rejected; they are only accepted by ValidatePortalSession.
 func (s *AuthService) ValidateSession(ctx context.Context, tokenString string) (*auth.Claims, error) {
+	claims, err := s.validateSession(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.User.IsPortal() {
+		return nil, errors.NewUnauthorizedError("Portal sessions can only access the portal API")
+	}
+	return claims, nil
+}
+
+// ValidatePortalSession checks if a portal user's session token is valid and active in the database
+func (s *AuthService) ValidatePortalSession(ctx context.Context, tokenString string) (*auth.Claims, error) {
+	claims, err := s.validateSession(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.User.IsPortal() {
+		return nil, errors.NewUnauthorizedError("Not a portal session")
+	}
+	return claims, nil
+}
+
+func (s *AuthService) validateSession(ctx context.Context, tokenString string) (*auth.Claims, error) {
 	// 1. Verify JWT signature and claims
 	claims, err := auth.ValidateToken(tokenString)
 	if err != nil {
@@ -212,6 +294,18 @@ func (s *AuthService) ChangePassword(ctx context.Context, userID, currentPasswor
 	return err
 }
 
+// GetUserByEmail retrieves a user session object by email
+func (s *AuthService) GetUserByEmail(ctx context.Context, email string) (*models.UserSession, error) {
+	user, err := s.userRepo.FindUserByEmailWithPassword(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.NewNotFoundError("User", email)
+	}
+	return s.GetUserByID(ctx, user.ID)
+}
+
 // GetUserByID retrieves a user session object by ID
 func (s *AuthService) GetUserByID(ctx context.Context, userID string) (*models.UserSession, error) {
 	// Reuse Repo logic
@@ -247,6 +341,8 @@ func (s *AuthService) GetUserByID(ctx context.Context, userID string) (*models.U
 		Email:     &user.Email,
 		ProfileID: user.ProfileID,
 		RoleID:    roleID,
+		UserType:  user.UserType,
+		Locale:    derefString(user.Locale),
 	}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/i18n"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
@@ -22,6 +23,10 @@ type CreateUserRequest struct {
 	Password  string
 	ProfileID string
 	RoleID    string
+	UserType  string // Defaults to constants.UserTypeStandard
+	ContactID string // Portal users: the contact record they represent
+	AccountID string // Portal users: the account record they belong to
+	Locale    string // Preferred locale for translated metadata; empty follows the browser
 }
 
 // CreateUser creates a new user account
@@ -36,7 +41,28 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		return nil, err
 	}
 
-	// 3. Check for Existing User
+	// 3. Validate User Type (portal users default to the portal profile)
+	userType := req.UserType
+	if userType == "" {
+		userType = constants.UserTypeStandard
+	}
+	profileID := req.ProfileID
+	if profileID == "" {
+		profileID = constants.ProfileStandardUser
+		if userType == constants.UserTypePortal {
+			profileID = constants.ProfilePortalUser
+		}
+	}
+	if err := validateUserType(userType, profileID, req.ContactID, req.AccountID); err != nil {
+		return nil, err
+	}
+
+	locale, err := normalizeUserLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Check for Existing User
 	exists, err := s.userRepo.CheckUserExistsByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, fmt.Errorf("database error: %w", err)
@@ -45,19 +71,15 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		return nil, errors.NewConflictError(constants.TableUser, constants.FieldEmail, req.Email)
 	}
 
-	// 4. Hash Password
+	// 5. Hash Password
 	hashedPassword, err := auth.HashPassword(req.Password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	// 5. Prepare Data
+	// 6. Prepare Data
 	userID := GenerateID()
 	now := time.Now()
-	profileID := req.ProfileID
-	if profileID == "" {
-		profileID = constants.ProfileStandardUser
-	}
 
 	// Split Name
 	firstName, lastName := splitName(req.Name)
@@ -67,7 +89,7 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		roleID = &req.RoleID
 	}
 
-	// 6. Insert User using PersistenceService
+	// 7. Insert User using PersistenceService
 	userStruct := models.SystemUser{
 		ID:          userID,
 		Username:    req.Email,
@@ -77,9 +99,15 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		LastName:    lastName,
 		ProfileID:   profileID,
 		RoleID:      roleID,
+		UserType:    userType,
+		Locale:      optionalString(locale),
 		CreatedDate: now,
 		IsActive:    true,
 	}
+	if userType == constants.UserTypePortal {
+		userStruct.ContactID = optionalString(req.ContactID)
+		userStruct.AccountID = optionalString(req.AccountID)
+	}
 
 	// System Context
 	systemContext := &models.UserSession{
@@ -94,7 +122,7 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 	if req.RoleID != "" {
 		userData["role_id"] = req.RoleID
 	}
-	if _, err := s.persistence.Insert(ctx, constants.TableUser, userData, systemContext); err != nil {
+	if _, err := s.persistence.Insert(WithSystemTableWrite(ctx), constants.TableUser, userData, systemContext); err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
@@ -104,6 +132,8 @@ func (s *AuthService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		Email:     &req.Email,
 		ProfileID: profileID,
 		RoleID:    roleID,
+		UserType:  userType,
+		Locale:    locale,
 	}, nil
 }
 
@@ -115,6 +145,10 @@ type UpdateUserRequest struct {
 	ProfileID string
 	RoleID    string
 	IsActive  *bool
+	UserType  string
+	ContactID *string // Empty string clears the link
+	AccountID *string // Empty string clears the link
+	Locale    *string // Takes effect at the next sign-in; empty string clears it
 }
 
 // UpdateUser updates an existing user's information
@@ -178,6 +212,52 @@ func (s *AuthService) UpdateUser(ctx context.Context, userID string, req UpdateU
 		updates[constants.FieldIsActive] = *req.IsActive
 	}
 
+	if req.Locale != nil {
+		locale, err := normalizeUserLocale(*req.Locale)
+		if err != nil {
+			return err
+		}
+		updates[constants.FieldSysUser_Locale] = optionalString(locale)
+	}
+
+	// Sessions carry the user type, so changing it signs the user out
+	revokeSessions := false
+	if req.UserType != "" || req.ContactID != nil || req.AccountID != nil || req.ProfileID != "" {
+		current, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+		userType, profileID := current.UserType, current.ProfileID
+		contactID, accountID := derefString(current.ContactID), derefString(current.AccountID)
+		if req.UserType != "" {
+			userType = req.UserType
+		}
+		if req.ProfileID != "" {
+			profileID = req.ProfileID
+		}
+		if req.ContactID != nil {
+			contactID = *req.ContactID
+		}
+		if req.AccountID != nil {
+			accountID = *req.AccountID
+		}
+		if err := validateUserType(userType, profileID, contactID, accountID); err != nil {
+			return err
+		}
+		if userType != constants.UserTypePortal {
+			contactID, accountID = "", ""
+		}
+		updates[constants.FieldSysUser_UserType] = userType
+		updates[constants.FieldSysUser_ContactID] = optionalString(contactID)
+		updates[constants.FieldSysUser_AccountID] = optionalString(accountID)
+		revokeSessions = userType != current.UserType
+	}
+
+	// Deactivating a user ends their sessions
+	if req.IsActive != nil && !*req.IsActive {
+		revokeSessions = true
+	}
+
 	if len(updates) == 0 {
 		return nil // No changes
 	}
@@ -185,11 +265,47 @@ func (s *AuthService) UpdateUser(ctx context.Context, userID string, req UpdateU
 	if err := s.userRepo.UpdateUser(ctx, userID, updates); err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
+	if revokeSessions {
+		if err := s.sessionRepo.RevokeUserSessions(ctx, userID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
 
 	log.Printf("📝 User updated: %s", userID)
 	return nil
 }
 
+// validateUserType checks a user's type against its profile and portal links. Portal users
+// must be linked to a contact or account and cannot hold the administrator profile.
+func validateUserType(userType, profileID, contactID, accountID string) error {
+	switch userType {
+	case constants.UserTypeStandard:
+		return nil
+	case constants.UserTypePortal:
+		if constants.IsSuperUser(profileID) {
+			return errors.NewValidationError(constants.FieldProfileID, "Portal users cannot be System Administrators")
+		}
+		if contactID == "" && accountID == "" {
+			return errors.NewValidationError(constants.FieldSysUser_ContactID, "Portal users must be linked to a contact or an account")
+		}
+		return nil
+	default:
+		return errors.NewValidationError(constants.FieldSysUser_UserType, fmt.Sprintf("must be %s or %s", constants.UserTypeStandard, constants.UserTypePortal))
+	}
+}
+
+// normalizeUserLocale canonicalizes a user's preferred locale; empty means none
+func normalizeUserLocale(locale string) (string, error) {
+	if locale == "" {
+		return "", nil
+	}
+	normalized := i18n.Normalize(locale)
+	if normalized == "" {
+		return "", errors.NewValidationError(constants.FieldSysUser_Locale, fmt.Sprintf("invalid locale %q", locale))
+	}
+	return normalized, nil
+}
+
 // DeleteUser removes a user from the system
 func (s *AuthService) DeleteUser(ctx context.Context, userID string) error {
 	// Check Existence
@@ -224,15 +340,16 @@ func (s *AuthService) GetUsers(ctx context.Context) ([]map[string]interface{}, e
 		}
 
 		userMap := map[string]interface{}{
-			constants.FieldID:            u.ID,
-			constants.FieldUsername:      u.Username,
-			constants.FieldName:          fullName,
-			constants.FieldEmail:         u.Email,
-			constants.FieldProfileID:     u.ProfileID,
-			constants.FieldRoleID:        u.RoleID,
-			constants.FieldIsActive:      u.IsActive,
-			constants.FieldLastLoginDate: u.LastLoginDate,
-			constants.FieldCreatedDate:   u.CreatedDate,
+			constants.FieldID:               u.ID,
+			constants.FieldUsername:         u.Username,
+			constants.FieldName:             fullName,
+			constants.FieldEmail:            u.Email,
+			constants.FieldProfileID:        u.ProfileID,
+			constants.FieldRoleID:           u.RoleID,
+			constants.FieldIsActive:         u.IsActive,
+			constants.FieldSysUser_UserType: u.UserType,
+			constants.FieldLastLoginDate:    u.LastLoginDate,
+			constants.FieldCreatedDate:      u.CreatedDate,
 		}
 		result = append(result, userMap)
 	}
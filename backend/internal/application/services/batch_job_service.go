@@ -0,0 +1,445 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultBatchChunkSize   = 200
+	maxBatchChunkSize       = 2000
+	defaultBatchConcurrency = 1
+	maxBatchConcurrency     = 10
+)
+
+// BatchJobService runs batch jobs: bulk processing of every record matching a scope query,
+// read in chunks in ID order and handed to a script or a built-in action, several chunks at a
+// time. Runs are async jobs, so their progress is polled and they can be aborted like any
+// other; scheduled batch jobs are started by the scheduler.
+type BatchJobService struct {
+	repo        *persistence.BatchJobRepository
+	metadata    *MetadataService
+	query       *QueryService
+	persistence *PersistenceService
+	permissions *PermissionService
+	scripts     *ScriptService
+	jobs        *AsyncJobService
+}
+
+// NewBatchJobService creates a new BatchJobService
+func NewBatchJobService(
+	repo *persistence.BatchJobRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	persistence *PersistenceService,
+	permissions *PermissionService,
+	scripts *ScriptService,
+	jobs *AsyncJobService,
+) *BatchJobService {
+	return &BatchJobService{
+		repo:        repo,
+		metadata:    metadata,
+		query:       query,
+		persistence: persistence,
+		permissions: permissions,
+		scripts:     scripts,
+		jobs:        jobs,
+	}
+}
+
+// ==================== Configuration ====================
+
+// GetBatchJobs returns all batch jobs
+func (s *BatchJobService) GetBatchJobs(ctx context.Context) ([]*models.BatchJob, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetBatchJob returns a batch job with the progress of its latest run
+func (s *BatchJobService) GetBatchJob(ctx context.Context, name string) (*models.BatchJob, error) {
+	b, err := s.findBatchJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if b.LastJobID != "" {
+		if b.LastJob, err = s.jobs.GetJob(ctx, b.LastJobID); err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// CreateBatchJob validates and stores a batch job, owned by the creating user
+func (s *BatchJobService) CreateBatchJob(ctx context.Context, b *models.BatchJob, currentUser *models.UserSession) error {
+	b.Name = strings.TrimSpace(b.Name)
+	if !developerNamePattern.MatchString(b.Name) {
+		return errors.NewValidationError(constants.FieldSysBatchJob_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	b.IsActive = true
+	if err := s.validateBatchJob(ctx, b); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByName(ctx, b.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("BatchJob", constants.FieldSysBatchJob_Name, b.Name)
+	}
+	b.ID = GenerateID()
+	if currentUser != nil {
+		b.OwnerID = currentUser.ID
+	}
+	return s.repo.Insert(ctx, b)
+}
+
+// UpdateBatchJob replaces the settings of a batch job and reschedules it
+func (s *BatchJobService) UpdateBatchJob(ctx context.Context, name string, b *models.BatchJob) (*models.BatchJob, error) {
+	existing, err := s.findBatchJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	b.ID = existing.ID
+	b.Name = existing.Name
+	b.OwnerID = existing.OwnerID
+	b.LastRunAt = existing.LastRunAt
+	b.LastJobID = existing.LastJobID
+	b.CreatedDate = existing.CreatedDate
+	if err := s.validateBatchJob(ctx, b); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// DeleteBatchJob deletes a batch job; a run in progress continues
+func (s *BatchJobService) DeleteBatchJob(ctx context.Context, name string) error {
+	b, err := s.findBatchJob(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, b.ID)
+}
+
+func (s *BatchJobService) findBatchJob(ctx context.Context, name string) (*models.BatchJob, error) {
+	b, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, errors.NewNotFoundError("Batch job", name)
+	}
+	return b, nil
+}
+
+// validateBatchJob checks the scope, processor, sizes and schedule of a batch job and sets
+// its next scheduled run
+func (s *BatchJobService) validateBatchJob(ctx context.Context, b *models.BatchJob) error {
+	schema, err := s.metadata.GetSchemaOrError(ctx, b.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+	if constants.IsSystemTable(schema.APIName) {
+		return errors.NewValidationError(constants.FieldSysBatchJob_ObjectAPIName, "batch jobs cannot process system tables")
+	}
+	if schema.IsExternal {
+		return errors.NewValidationError(constants.FieldSysBatchJob_ObjectAPIName, fmt.Sprintf("%s is an external object", schema.APIName))
+	}
+	b.ObjectAPIName = schema.APIName
+	if b.ScopeFilter != "" {
+		if _, _, err := formula.ToSQL(b.ScopeFilter); err != nil {
+			return errors.NewValidationError(constants.FieldSysBatchJob_ScopeFilter, fmt.Sprintf("invalid filter: %v", err))
+		}
+	}
+
+	switch b.Processor {
+	case constants.BatchProcessorScript:
+		b.FieldValues = nil
+		if strings.TrimSpace(b.ScriptName) == "" {
+			return errors.NewValidationError(constants.FieldSysBatchJob_ScriptName, "is required for the script processor")
+		}
+		sc, err := s.scripts.GetScript(ctx, b.ScriptName)
+		if err != nil {
+			return err
+		}
+		b.ScriptName = sc.Name
+	case constants.BatchProcessorMassUpdate:
+		b.ScriptName = ""
+		if len(b.FieldValues) == 0 {
+			return errors.NewValidationError(constants.FieldSysBatchJob_FieldValues, "at least one field value is required")
+		}
+		for field := range b.FieldValues {
+			if FindField(schema, field) == nil {
+				return errors.NewValidationError(constants.FieldSysBatchJob_FieldValues, fmt.Sprintf("unknown field '%s' on %s", field, schema.APIName))
+			}
+		}
+	case constants.BatchProcessorDelete:
+		b.ScriptName, b.FieldValues = "", nil
+	default:
+		return errors.NewValidationError(constants.FieldSysBatchJob_Processor,
+			fmt.Sprintf("unsupported processor '%s'; expected script, mass_update or delete", b.Processor))
+	}
+
+	if b.ChunkSize < 0 || b.ChunkSize > maxBatchChunkSize {
+		return errors.NewValidationError(constants.FieldSysBatchJob_ChunkSize, fmt.Sprintf("must be between 0 (the default of %d) and %d", defaultBatchChunkSize, maxBatchChunkSize))
+	}
+	if b.Concurrency < 0 || b.Concurrency > maxBatchConcurrency {
+		return errors.NewValidationError(constants.FieldSysBatchJob_Concurrency, fmt.Sprintf("must be between 0 (the default of %d) and %d", defaultBatchConcurrency, maxBatchConcurrency))
+	}
+
+	b.Schedule = strings.TrimSpace(b.Schedule)
+	b.NextRunAt = nil
+	if b.Schedule == "" {
+		b.ScheduleTimezone = ""
+		return nil
+	}
+	next, err := nextBatchRun(b, time.Now())
+	if err != nil {
+		return err
+	}
+	b.NextRunAt = &next
+	return nil
+}
+
+// nextBatchRun returns the first scheduled run of a batch job after after
+func nextBatchRun(b *models.BatchJob, after time.Time) (time.Time, error) {
+	loc := time.UTC
+	if b.ScheduleTimezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(b.ScheduleTimezone); err != nil {
+			return time.Time{}, errors.NewValidationError(constants.FieldSysBatchJob_ScheduleTimezone, fmt.Sprintf("unknown time zone '%s'", b.ScheduleTimezone))
+		}
+	}
+	next, err := nextCronRun(b.Schedule, loc, after)
+	if err != nil {
+		return time.Time{}, errors.NewValidationError(constants.FieldSysBatchJob_Schedule, err.Error())
+	}
+	// Stored to the second, so the next run can be claimed by comparing it
+	return next.Truncate(time.Second), nil
+}
+
+// ==================== Runs ====================
+
+// Run starts a run of a batch job as user and returns its async job. A batch job runs once at
+// a time; scheduled runs are skipped while one is in progress.
+func (s *BatchJobService) Run(ctx context.Context, name string, user *models.UserSession) (*models.SystemAsyncJob, error) {
+	b, err := s.findBatchJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.start(ctx, b, user)
+}
+
+// Abort aborts the run in progress of a batch job
+func (s *BatchJobService) Abort(ctx context.Context, name string) (*models.SystemAsyncJob, error) {
+	b, err := s.findBatchJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if b.LastJobID == "" {
+		return nil, errors.NewValidationError(constants.FieldSysBatchJob_LastJobID, "the batch job has never run")
+	}
+	return s.jobs.Abort(ctx, b.LastJobID)
+}
+
+func (s *BatchJobService) start(ctx context.Context, b *models.BatchJob, user *models.UserSession) (*models.SystemAsyncJob, error) {
+	if b.LastJobID != "" {
+		last, err := s.jobs.GetJob(ctx, b.LastJobID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if last != nil && asyncJobActive(last) {
+			return nil, errors.NewValidationError(constants.FieldSysBatchJob_LastJobID, fmt.Sprintf("batch job %s is already running as job %s", b.Name, last.ID))
+		}
+	}
+
+	// Field values are checked against the running user's access before the run starts
+	var update models.SObject
+	if b.Processor == constants.BatchProcessorMassUpdate {
+		update = make(models.SObject, len(b.FieldValues))
+		for field, value := range b.FieldValues {
+			fieldUpdate, err := s.persistence.FieldUpdate(ctx, b.ObjectAPIName, field, value, user)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range fieldUpdate {
+				update[k] = v
+			}
+		}
+	}
+
+	total, err := s.query.RunAnalytics(ctx, models.AnalyticsQuery{
+		ObjectAPIName: b.ObjectAPIName,
+		Operation:     persistence.OpCount,
+		FilterExpr:    b.ScopeFilter,
+	}, user)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{"batch_job": b.Name, "processor": b.Processor}
+	job, err := s.jobs.Enqueue(ctx, constants.AsyncJobTypeBatch, b.ObjectAPIName, params, int(toInt64(total)), user,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			return s.execute(ctx, b, update, user, job, report)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SaveRun(ctx, b.ID, job.ID, time.Now()); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// execute reads the scope chunk by chunk in ID order, so records the processor changes or
+// deletes are neither skipped nor read twice, and processes up to the batch job's concurrency
+// of chunks at a time. A chunk that fails is counted and the run goes on; the run stops when
+// the scope cannot be read or the job is aborted.
+func (s *BatchJobService) execute(ctx context.Context, b *models.BatchJob, update models.SObject, user *models.UserSession, job *models.SystemAsyncJob, report func()) error {
+	chunkSize := b.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultBatchChunkSize
+	}
+	concurrency := b.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	var scopeErr error
+	lastID := ""
+	for ctx.Err() == nil {
+		filter := b.ScopeFilter
+		if lastID != "" {
+			filter = combineFilters(fmt.Sprintf("%s > %s", constants.FieldID, strconv.Quote(lastID)), b.ScopeFilter)
+		}
+		chunk, err := s.query.Query(ctx, models.QueryRequest{
+			ObjectAPIName:   b.ObjectAPIName,
+			FilterExpr:      filter,
+			SortField:       constants.FieldID,
+			SortDirection:   constants.SortASC,
+			Limit:           chunkSize,
+			SkipLookupNames: true,
+		}, user)
+		if err != nil {
+			if ctx.Err() == nil {
+				scopeErr = err
+			}
+			break
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		lastID = chunk[len(chunk)-1].GetString(constants.FieldID)
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(chunk []models.SObject) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			processed, failed := s.processChunk(ctx, b, update, chunk, user)
+
+			mu.Lock()
+			defer mu.Unlock()
+			job.ProcessedCount += processed
+			job.FailedCount += failed
+			report()
+		}(chunk)
+
+		if len(chunk) < chunkSize {
+			break
+		}
+	}
+	wg.Wait()
+
+	log.Printf("📦 Batch job %s: processed %d %s records, %d failed", b.Name, job.ProcessedCount, b.ObjectAPIName, job.FailedCount)
+	return scopeErr
+}
+
+// processChunk hands a chunk to the batch job's processor and counts its records processed
+// and failed. A failing script fails its whole chunk; the built-in processors count each
+// record.
+func (s *BatchJobService) processChunk(ctx context.Context, b *models.BatchJob, update models.SObject, chunk []models.SObject, user *models.UserSession) (processed, failed int) {
+	if b.Processor == constants.BatchProcessorScript {
+		if err := s.scripts.RunBatch(ctx, b.ScriptName, b.Name, b.ObjectAPIName, chunk, user); err != nil {
+			log.Printf("⚠️ Batch job %s: chunk of %d records failed: %v", b.Name, len(chunk), err)
+			return 0, len(chunk)
+		}
+		return len(chunk), 0
+	}
+
+	for _, record := range chunk {
+		if ctx.Err() != nil {
+			break
+		}
+		id := record.GetString(constants.FieldID)
+		var err error
+		if b.Processor == constants.BatchProcessorDelete {
+			err = s.persistence.Delete(ctx, b.ObjectAPIName, id, user)
+		} else {
+			fields := make(models.SObject, len(update))
+			for k, v := range update {
+				fields[k] = v
+			}
+			err = s.persistence.Update(ctx, b.ObjectAPIName, id, fields, user)
+		}
+		if err != nil {
+			failed++
+			log.Printf("⚠️ Batch job %s: %s/%s not processed: %v", b.Name, b.ObjectAPIName, id, err)
+		} else {
+			processed++
+		}
+	}
+	return processed, failed
+}
+
+// RunScheduled starts the due runs of scheduled batch jobs as their owners; the scheduler
+// calls it on every tick. Each run is claimed first, so only one process starts it.
+func (s *BatchJobService) RunScheduled(ctx context.Context, now time.Time) {
+	due, err := s.repo.FindDue(ctx, now)
+	if err != nil {
+		log.Printf("⚠️ [BatchJobs] Failed to load due batch jobs: %v", err)
+		return
+	}
+	for _, b := range due {
+		var next *time.Time
+		if t, err := nextBatchRun(b, now); err == nil {
+			next = &t
+		} else {
+			log.Printf("⚠️ [BatchJobs] %s: %v", b.Name, err)
+		}
+		claimed, err := s.repo.ClaimScheduledRun(ctx, b.ID, *b.NextRunAt, next)
+		if err != nil || !claimed {
+			continue
+		}
+
+		owner, err := s.permissions.SessionForUser(ctx, b.OwnerID)
+		if err == nil {
+			_, err = s.start(ctx, b, owner)
+		}
+		if err != nil {
+			log.Printf("⚠️ [BatchJobs] Scheduled run of %s not started: %v", b.Name, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextBatchRun(t *testing.T) {
+	after := time.Date(2026, 3, 2, 10, 30, 15, 500, time.UTC)
+
+	next, err := nextBatchRun(&models.BatchJob{Schedule: "0 2 * * *"}, after)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 3, 2, 0, 0, 0, time.UTC), next)
+
+	// 02:00 in Berlin is 01:00 UTC before daylight saving time starts
+	next, err = nextBatchRun(&models.BatchJob{Schedule: "0 2 * * *", ScheduleTimezone: "Europe/Berlin"}, after)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 3, 1, 0, 0, 0, time.UTC), next)
+
+	_, err = nextBatchRun(&models.BatchJob{Schedule: "every night"}, after)
+	assert.Error(t, err)
+	_, err = nextBatchRun(&models.BatchJob{Schedule: "0 2 * * *", ScheduleTimezone: "Mars/Olympus"}, after)
+	assert.Error(t, err)
+}
+
+func TestAsyncJobActive(t *testing.T) {
+	for status, active := range map[string]bool{
+		"queued": true, "running": true, "completed": false, "failed": false, "aborted": false,
+	} {
+		assert.Equal(t, active, asyncJobActive(&models.SystemAsyncJob{Status: status}), status)
+	}
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// maxCalendarDays bounds day-by-day walks so a calendar without working days cannot loop forever
+const maxCalendarDays = 3660
+
+// businessDay is the working window of a weekday in minutes after midnight
+type businessDay struct {
+	open       bool
+	start, end int
+}
+
+// businessCalendar measures business time against weekly business hours and holidays.
+// A nil calendar is open around the clock.
+type businessCalendar struct {
+	loc       *time.Location
+	days      [7]businessDay  // Indexed by time.Weekday
+	holidays  map[string]bool // YYYY-MM-DD
+	recurring map[string]bool // MM-DD
+}
+
+// newBusinessCalendar parses business hours into a calendar
+func newBusinessCalendar(bh *models.BusinessHours) (*businessCalendar, error) {
+	loc := time.UTC
+	if bh.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(bh.Timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q", bh.Timezone)
+		}
+	}
+
+	cal := &businessCalendar{loc: loc, holidays: make(map[string]bool), recurring: make(map[string]bool)}
+	for name, window := range bh.Schedule {
+		weekday, ok := parseWeekday(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", name)
+		}
+		start, err := parseClock(window.Start)
+		if err != nil {
+			return nil, fmt.Errorf("%s start: %w", name, err)
+		}
+		end, err := parseClock(window.End)
+		if err != nil {
+			return nil, fmt.Errorf("%s end: %w", name, err)
+		}
+		if end <= start {
+			return nil, fmt.Errorf("%s ends before it starts", name)
+		}
+		cal.days[weekday] = businessDay{open: true, start: start, end: end}
+	}
+
+	for _, h := range bh.Holidays {
+		date, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holiday %q: date must be YYYY-MM-DD", h.Name)
+		}
+		if h.IsRecurring {
+			cal.recurring[date.Format("01-02")] = true
+		} else {
+			cal.holidays[h.Date] = true
+		}
+	}
+	return cal, nil
+}
+
+// window returns the working interval of the day containing t, if the day is a working day
+func (c *businessCalendar) window(t time.Time) (time.Time, time.Time, bool) {
+	day := c.days[t.Weekday()]
+	if !day.open || c.holidays[t.Format("2006-01-02")] || c.recurring[t.Format("01-02")] {
+		return time.Time{}, time.Time{}, false
+	}
+	y, m, d := t.Date()
+	start := time.Date(y, m, d, day.start/60, day.start%60, 0, 0, c.loc)
+	end := time.Date(y, m, d, day.end/60, day.end%60, 0, 0, c.loc)
+	return start, end, true
+}
+
+// Duration returns the business time between from and to
+func (c *businessCalendar) Duration(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	if c == nil {
+		return to.Sub(from)
+	}
+
+	var total time.Duration
+	day := from.In(c.loc)
+	for i := 0; i < maxCalendarDays; i++ {
+		start, end, ok := c.window(day)
+		if ok {
+			if from.After(start) {
+				start = from
+			}
+			if to.Before(end) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		y, m, d := day.Date()
+		day = time.Date(y, m, d+1, 0, 0, 0, 0, c.loc)
+		if !day.Before(to) {
+			break
+		}
+	}
+	return total
+}
+
+// Add returns the time at which d of business time has passed since from. It returns the
+// zero time when the calendar has no working time within maxCalendarDays.
+func (c *businessCalendar) Add(from time.Time, d time.Duration) time.Time {
+	if c == nil {
+		return from.Add(d)
+	}
+
+	day := from.In(c.loc)
+	for i := 0; i < maxCalendarDays; i++ {
+		start, end, ok := c.window(day)
+		if ok {
+			if from.After(start) {
+				start = from
+			}
+			if available := end.Sub(start); available > 0 {
+				if d <= available {
+					return start.Add(d).UTC()
+				}
+				d -= available
+			}
+		}
+		y, m, dd := day.Date()
+		day = time.Date(y, m, dd+1, 0, 0, 0, 0, c.loc)
+	}
+	return time.Time{}
+}
+
+// parseWeekday resolves a lowercase English weekday name
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock converts "HH:MM" (up to "24:00") to minutes after midnight
+func parseClock(s string) (int, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	h, herr := strconv.Atoi(hh)
+	m, merr := strconv.Atoi(mm)
+	if !ok || herr != nil || merr != nil || h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("time must be HH:MM, got %q", s)
+	}
+	return h*60 + m, nil
+}
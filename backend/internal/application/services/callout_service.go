@@ -0,0 +1,442 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/secrets"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultCalloutTimeoutSeconds = 30
+	maxCalloutTimeoutSeconds     = 300
+	maxCalloutRetries            = 5
+	maxCalloutResponseBytes      = 5 << 20 // Larger responses are truncated
+)
+
+// CalloutService calls external REST APIs through named credentials, so flows and actions
+// can integrate with other systems without holding URLs or secrets in their config.
+type CalloutService struct {
+	repo         *persistence.NamedCredentialRepository
+	client       *http.Client
+	retryBackoff time.Duration // Delay before the first retry; doubles with each attempt
+}
+
+// NewCalloutService creates a new CalloutService
+func NewCalloutService(repo *persistence.NamedCredentialRepository) *CalloutService {
+	return &CalloutService{
+		repo:         repo,
+		client:       &http.Client{},
+		retryBackoff: 500 * time.Millisecond,
+	}
+}
+
+// CalloutRequest describes a request to an external service
+type CalloutRequest struct {
+	NamedCredential string            `json:"named_credential"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"` // Appended to the credential's base URL
+	Headers         map[string]string `json:"headers,omitempty"`
+	Body            interface{}       `json:"body,omitempty"` // Sent as JSON unless it is a string
+}
+
+// CalloutResponse is the result of a callout. Body holds the decoded JSON response, or the
+// raw text when the response is not JSON.
+type CalloutResponse struct {
+	StatusCode int         `json:"status_code"`
+	Body       interface{} `json:"body"`
+	Attempts   int         `json:"attempts"`
+}
+
+// ==================== Named Credentials ====================
+
+// GetNamedCredentials returns all named credentials without their secrets
+func (s *CalloutService) GetNamedCredentials(ctx context.Context) ([]*models.NamedCredential, error) {
+	creds, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range creds {
+		c.Secret = ""
+	}
+	return creds, nil
+}
+
+// GetNamedCredential returns a named credential without its secret
+func (s *CalloutService) GetNamedCredential(ctx context.Context, name string) (*models.NamedCredential, error) {
+	cred, err := s.findNamedCredential(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	cred.Secret = ""
+	return cred, nil
+}
+
+// CreateNamedCredential validates a named credential and stores it with its secret encrypted
+func (s *CalloutService) CreateNamedCredential(ctx context.Context, cred *models.NamedCredential) error {
+	cred.Name = strings.TrimSpace(cred.Name)
+	if !developerNamePattern.MatchString(cred.Name) {
+		return errors.NewValidationError(constants.FieldSysNamedCredential_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(cred.Label) == "" {
+		cred.Label = cred.Name
+	}
+	if cred.AuthType == "" {
+		cred.AuthType = constants.NamedCredentialAuthNone
+	}
+	if cred.TimeoutSeconds == 0 {
+		cred.TimeoutSeconds = defaultCalloutTimeoutSeconds
+	}
+	if err := validateNamedCredential(cred); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByName(ctx, cred.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("NamedCredential", constants.FieldSysNamedCredential_Name, cred.Name)
+	}
+
+	plaintext := cred.Secret
+	if cred.Secret, err = secrets.Encrypt(plaintext); err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	if cred.ID == "" {
+		cred.ID = GenerateID()
+	}
+	if err := s.repo.Insert(ctx, cred); err != nil {
+		return err
+	}
+	cred.HasSecret = plaintext != ""
+	cred.Secret = ""
+	return nil
+}
+
+// NamedCredentialUpdate holds the changeable settings of a named credential; nil leaves a
+// setting unchanged. The name cannot change because flows and actions reference it.
+type NamedCredentialUpdate struct {
+	Label          *string                            `json:"label"`
+	BaseURL        *string                            `json:"base_url"`
+	AuthType       *constants.NamedCredentialAuthType `json:"auth_type"`
+	Username       *string                            `json:"username"`
+	AuthHeader     *string                            `json:"auth_header"`
+	Secret         *string                            `json:"secret"`
+	TimeoutSeconds *int                               `json:"timeout_seconds"`
+	MaxRetries     *int                               `json:"max_retries"`
+}
+
+// UpdateNamedCredential updates a named credential, re-encrypting the secret when one is given
+func (s *CalloutService) UpdateNamedCredential(ctx context.Context, name string, updates NamedCredentialUpdate) (*models.NamedCredential, error) {
+	cred, err := s.findNamedCredential(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Label != nil && strings.TrimSpace(*updates.Label) != "" {
+		cred.Label = *updates.Label
+	}
+	if updates.BaseURL != nil {
+		cred.BaseURL = *updates.BaseURL
+	}
+	if updates.AuthType != nil {
+		cred.AuthType = *updates.AuthType
+	}
+	if updates.Username != nil {
+		cred.Username = updates.Username
+	}
+	if updates.AuthHeader != nil {
+		cred.AuthHeader = updates.AuthHeader
+	}
+	if updates.TimeoutSeconds != nil {
+		cred.TimeoutSeconds = *updates.TimeoutSeconds
+	}
+	if updates.MaxRetries != nil {
+		cred.MaxRetries = *updates.MaxRetries
+	}
+	if err := validateNamedCredential(cred); err != nil {
+		return nil, err
+	}
+
+	if updates.Secret != nil {
+		if cred.Secret, err = secrets.Encrypt(*updates.Secret); err != nil {
+			return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+		}
+	}
+	if cred.AuthType == constants.NamedCredentialAuthNone {
+		cred.Secret = ""
+	}
+	if err := s.repo.Update(ctx, cred); err != nil {
+		return nil, err
+	}
+	cred.HasSecret = cred.Secret != ""
+	cred.Secret = ""
+	return cred, nil
+}
+
+// DeleteNamedCredential deletes a named credential
+func (s *CalloutService) DeleteNamedCredential(ctx context.Context, name string) error {
+	cred, err := s.findNamedCredential(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, cred.ID)
+}
+
+func (s *CalloutService) findNamedCredential(ctx context.Context, name string) (*models.NamedCredential, error) {
+	cred, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil {
+		return nil, errors.NewNotFoundError("Named credential", name)
+	}
+	return cred, nil
+}
+
+// validateNamedCredential checks the endpoint, authentication and limits of a named credential
+func validateNamedCredential(cred *models.NamedCredential) error {
+	u, err := url.Parse(cred.BaseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.NewValidationError(constants.FieldSysNamedCredential_BaseURL, "must be an absolute http or https URL")
+	}
+
+	switch cred.AuthType {
+	case constants.NamedCredentialAuthNone, constants.NamedCredentialAuthBearer:
+	case constants.NamedCredentialAuthBasic:
+		if cred.Username == nil || strings.TrimSpace(*cred.Username) == "" {
+			return errors.NewValidationError(constants.FieldSysNamedCredential_Username, "is required for Basic authentication")
+		}
+	case constants.NamedCredentialAuthAPIKey:
+		if cred.AuthHeader == nil || strings.TrimSpace(*cred.AuthHeader) == "" {
+			return errors.NewValidationError(constants.FieldSysNamedCredential_AuthHeader, "is required for ApiKey authentication")
+		}
+	default:
+		return errors.NewValidationError(constants.FieldSysNamedCredential_AuthType,
+			fmt.Sprintf("unsupported auth type '%s'; expected None, Basic, Bearer or ApiKey", cred.AuthType))
+	}
+
+	if cred.TimeoutSeconds < 1 || cred.TimeoutSeconds > maxCalloutTimeoutSeconds {
+		return errors.NewValidationError(constants.FieldSysNamedCredential_TimeoutSeconds,
+			fmt.Sprintf("must be between 1 and %d", maxCalloutTimeoutSeconds))
+	}
+	if cred.MaxRetries < 0 || cred.MaxRetries > maxCalloutRetries {
+		return errors.NewValidationError(constants.FieldSysNamedCredential_MaxRetries,
+			fmt.Sprintf("must be between 0 and %d", maxCalloutRetries))
+	}
+	return nil
+}
+
+// ==================== Callouts ====================
+
+// Callout sends a request through a named credential. Network errors, 429 and 5xx responses
+// are retried with exponential backoff up to the credential's retry limit; any other error
+// status fails immediately.
+func (s *CalloutService) Callout(ctx context.Context, req CalloutRequest) (*CalloutResponse, error) {
+	cred, err := s.findNamedCredential(ctx, req.NamedCredential)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Decrypt(cred.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("named credential %s: %w", cred.Name, err)
+	}
+
+	method := strings.ToUpper(req.Method)
+	if method == "" {
+		method = http.MethodGet
+		if req.Body != nil {
+			method = http.MethodPost
+		}
+	}
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, errors.NewValidationError(constants.ConfigMethod, fmt.Sprintf("invalid HTTP method: %s", method))
+	}
+
+	var body []byte
+	switch b := req.Body.(type) {
+	case nil:
+	case string:
+		body = []byte(b)
+	default:
+		if body, err = json.Marshal(b); err != nil {
+			return nil, fmt.Errorf("failed to serialize callout body: %w", err)
+		}
+	}
+
+	endpoint := joinCalloutURL(cred.BaseURL, req.Path)
+	timeout := time.Duration(cred.TimeoutSeconds) * time.Second
+
+	var lastErr error
+	for attempt := 0; attempt <= cred.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(s.retryBackoff << (attempt - 1)):
+			}
+		}
+
+		resp, retry, err := s.send(ctx, cred, secret, method, endpoint, req.Headers, body, timeout)
+		if err == nil {
+			resp.Attempts = attempt + 1
+			log.Printf("🔌 CALLOUT %s %s %s → %d", cred.Name, method, req.Path, resp.StatusCode)
+			return resp, nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+		log.Printf("⚠️ CALLOUT %s %s %s attempt %d failed: %v", cred.Name, method, req.Path, attempt+1, err)
+	}
+	return nil, fmt.Errorf("callout to %s failed: %w", cred.Name, lastErr)
+}
+
+// GetJSON sends a GET callout and returns the decoded response body. It lets external
+// object adapters read through named credentials.
+func (s *CalloutService) GetJSON(ctx context.Context, namedCredential, path string) (interface{}, error) {
+	resp, err := s.Callout(ctx, CalloutRequest{NamedCredential: namedCredential, Method: http.MethodGet, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	if _, isText := resp.Body.(string); isText {
+		return nil, fmt.Errorf("callout to %s returned a non-JSON body", namedCredential)
+	}
+	return resp.Body, nil
+}
+
+// send performs one attempt of a callout and reports whether a failure is worth retrying
+func (s *CalloutService) send(ctx context.Context, cred *models.NamedCredential, secret, method, endpoint string, headers map[string]string, body []byte, timeout time.Duration) (*CalloutResponse, bool, error) {
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	httpReq, err := http.NewRequestWithContext(attemptCtx, method, endpoint, bodyReader)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	for name, value := range headers {
+		httpReq.Header.Set(name, value)
+	}
+	applyCalloutAuth(httpReq, cred, secret)
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxCalloutResponseBytes))
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retry, fmt.Errorf("status %d: %s", resp.StatusCode, truncate(string(raw), 500))
+	}
+
+	result := &CalloutResponse{StatusCode: resp.StatusCode}
+	if len(raw) > 0 {
+		var decoded interface{}
+		if json.Unmarshal(raw, &decoded) == nil {
+			result.Body = decoded
+		} else {
+			result.Body = string(raw)
+		}
+	}
+	return result, false, nil
+}
+
+// applyCalloutAuth adds the credential's authentication to a request
+func applyCalloutAuth(req *http.Request, cred *models.NamedCredential, secret string) {
+	switch cred.AuthType {
+	case constants.NamedCredentialAuthBasic:
+		req.SetBasicAuth(*cred.Username, secret)
+	case constants.NamedCredentialAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+secret)
+	case constants.NamedCredentialAuthAPIKey:
+		req.Header.Set(*cred.AuthHeader, secret)
+	}
+}
+
+// joinCalloutURL appends a path (which may carry a query string) to a base URL
+func joinCalloutURL(baseURL, path string) string {
+	if path == "" {
+		return baseURL
+	}
+	return strings.TrimRight(baseURL, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+// MapCalloutResponse extracts record field values from a response body. Mapping is
+// field → dotted path into the body (e.g. "data.items.0.id"); fields whose path is missing
+// are left out so they do not overwrite existing values.
+func MapCalloutResponse(body interface{}, mapping map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{}, len(mapping))
+	for field, p := range mapping {
+		path, ok := p.(string)
+		if !ok {
+			continue
+		}
+		if v, found := lookupJSONPath(body, path); found {
+			values[field] = v
+		}
+	}
+	return values
+}
+
+// lookupJSONPath resolves a dotted path of object keys and array indexes
+func lookupJSONPath(body interface{}, path string) (interface{}, bool) {
+	current := body
+	if path == "" || path == "." {
+		return current, true
+	}
+	for _, part := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			v, ok := node[part]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case []interface{}:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			current = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/secrets"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCalloutService returns a CalloutService whose repository serves one Bearer credential
+func newTestCalloutService(t *testing.T, baseURL string, maxRetries int) *CalloutService {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	secret, err := secrets.Encrypt("token-123")
+	require.NoError(t, err)
+	now := time.Now()
+	mock.ExpectQuery("FROM `?" + constants.TableNamedCredential).WillReturnRows(
+		sqlmock.NewRows(namedCredentialTestColumns).
+			AddRow("nc1", "Billing", "Billing", baseURL, "Bearer", nil, nil, secret, 5, maxRetries, now, now))
+
+	svc := NewCalloutService(persistence.NewNamedCredentialRepository(db))
+	svc.retryBackoff = time.Millisecond
+	return svc
+}
+
+var namedCredentialTestColumns = []string{"id", "name", "label", "base_url", "auth_type", "username", "auth_header", "secret", "timeout_seconds", "max_retries", "created", "modified"}
+
+func TestCallout_RetriesAndAuth(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token-123", r.Header.Get("Authorization"))
+		assert.Equal(t, "/v1/invoices/42", r.URL.Path)
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "ACME", body["customer"])
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"status": "paid", "lines": [{"id": "L1"}]}}`))
+	}))
+	defer server.Close()
+
+	svc := newTestCalloutService(t, server.URL+"/v1/", 2)
+	resp, err := svc.Callout(context.Background(), CalloutRequest{
+		NamedCredential: "Billing",
+		Path:            "/invoices/42",
+		Body:            map[string]interface{}{"customer": "ACME"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 2, resp.Attempts, "503 is retried")
+
+	mapped := MapCalloutResponse(resp.Body, map[string]interface{}{
+		"status__c":  "data.status",
+		"line_id__c": "data.lines.0.id",
+		"missing__c": "data.nope",
+	})
+	assert.Equal(t, map[string]interface{}{"status__c": "paid", "line_id__c": "L1"}, mapped)
+}
+
+func TestCallout_ClientErrorNotRetried(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": "bad customer"}`))
+	}))
+	defer server.Close()
+
+	svc := newTestCalloutService(t, server.URL, 3)
+	_, err := svc.Callout(context.Background(), CalloutRequest{NamedCredential: "Billing", Method: "POST"})
+	assert.ErrorContains(t, err, "bad customer")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestValidateNamedCredential(t *testing.T) {
+	user := "svc"
+	valid := func() *models.NamedCredential {
+		return &models.NamedCredential{Name: "Billing", BaseURL: "https://api.example.com", AuthType: constants.NamedCredentialAuthNone, TimeoutSeconds: 30}
+	}
+	assert.NoError(t, validateNamedCredential(valid()))
+
+	c := valid()
+	c.BaseURL = "api.example.com"
+	assert.True(t, errors.IsValidation(validateNamedCredential(c)), "relative URL")
+
+	c = valid()
+	c.AuthType = constants.NamedCredentialAuthBasic
+	assert.True(t, errors.IsValidation(validateNamedCredential(c)), "basic needs username")
+	c.Username = &user
+	assert.NoError(t, validateNamedCredential(c))
+
+	c = valid()
+	c.AuthType = constants.NamedCredentialAuthAPIKey
+	assert.True(t, errors.IsValidation(validateNamedCredential(c)), "api key needs header")
+
+	c = valid()
+	c.AuthType = "OAuth1"
+	assert.True(t, errors.IsValidation(validateNamedCredential(c)), "unsupported auth type")
+
+	c = valid()
+	c.MaxRetries = 10
+	assert.True(t, errors.IsValidation(validateNamedCredential(c)), "too many retries")
+}
+
+func TestJoinCalloutURL(t *testing.T) {
+	assert.Equal(t, "https://x.io/v1/a?b=1", joinCalloutURL("https://x.io/v1/", "/a?b=1"))
+	assert.Equal(t, "https://x.io/v1/a", joinCalloutURL("https://x.io/v1", "a"))
+	assert.Equal(t, "https://x.io/v1", joinCalloutURL("https://x.io/v1", ""))
+}
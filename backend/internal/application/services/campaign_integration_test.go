@@ -0,0 +1,142 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaign_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	prospect := h.CreateObject(t, "prospect", testharness.Field("city", constants.FieldTypeText))
+	prospectLookup := testharness.Field("prospect_id", constants.FieldTypeLookup)
+	prospectLookup.ReferenceTo = []string{prospect.APIName}
+	deal := h.CreateObject(t, "deal", testharness.Field("amount", constants.FieldTypeCurrency),
+		testharness.Field("close_date", constants.FieldTypeDate), prospectLookup)
+	require.NoError(t, h.Services.Metadata.CreateField(ctx, deal.APIName, &models.FieldMetadata{
+		APIName: "stage", Label: "Stage", Type: constants.FieldTypePicklist, Options: []string{"Open", "Won", "Lost"},
+	}))
+	_, err := h.Services.Forecasts.SaveSetting(ctx, deal.APIName, models.ForecastSetting{
+		AmountField: "amount", CloseDateField: "close_date", StageField: "stage",
+		CategoryMapping: map[string]string{"Won": constants.ForecastCategoryClosed, "Lost": constants.ForecastCategoryOmitted},
+	})
+	require.NoError(t, err)
+
+	profile := constants.ProfileStandardUser
+	require.NoError(t, h.Services.Permissions.UpdateObjectPermission(models.SystemObjectPerms{
+		ProfileID: &profile, ObjectAPIName: prospect.APIName, AllowRead: true,
+	}))
+
+	svc := services.NewCampaignService(persistence.NewCampaignRepository(h.DB.DB()), h.Services.Metadata, h.Services.QuerySvc,
+		h.Services.Reports, h.Services.Forecasts, h.Services.Permissions, deal.APIName, 0)
+	marketer := h.CreateUser(t)
+	other := h.CreateUser(t)
+
+	cost := 100.0
+	campaign := &models.SystemCampaign{Name: " Autumn webinar ", ActualCost: &cost, IsActive: true}
+	require.NoError(t, svc.Create(ctx, campaign, marketer))
+	t.Cleanup(func() { _ = svc.Delete(ctx, campaign.ID, h.Admin) })
+	assert.Equal(t, "Autumn webinar", campaign.Name)
+	assert.Equal(t, constants.CampaignStatusPlanned, campaign.Status)
+
+	owned := func(name, city string) string {
+		record := h.CreateRecord(t, prospect.APIName, models.SObject{"name": name, "city": city, constants.FieldOwnerID: marketer.ID})
+		return record[constants.FieldID].(string)
+	}
+	annID, bobID := owned("Ann", "Paris"), owned("Bob", "Paris")
+	owned("Cid", "Paris")
+	danID := owned("Dan", "Oslo")
+
+	_, err = svc.AddMembers(ctx, campaign.ID, models.AddCampaignMembersInput{ObjectAPIName: prospect.APIName, RecordIDs: []string{annID}}, other)
+	require.Error(t, err, "only the owner edits a campaign")
+	_, err = svc.AddMembers(ctx, campaign.ID, models.AddCampaignMembersInput{
+		ObjectAPIName: prospect.APIName, RecordIDs: []string{annID}, ListViewID: "view",
+	}, marketer)
+	require.Error(t, err, "members come from one source")
+
+	result, err := svc.AddMembers(ctx, campaign.ID, models.AddCampaignMembersInput{
+		ObjectAPIName: prospect.APIName, RecordIDs: []string{annID, danID, annID},
+	}, marketer)
+	require.NoError(t, err)
+	assert.Equal(t, models.AddCampaignMembersResult{Added: 2}, *result)
+
+	view := &models.ListView{ObjectAPIName: prospect.APIName, Label: "Paris", FilterExpr: `city == "Paris"`}
+	require.NoError(t, h.Services.Metadata.CreateListView(ctx, view, marketer))
+	result, err = svc.AddMembers(ctx, campaign.ID, models.AddCampaignMembersInput{ListViewID: view.ID, Status: "responded"}, marketer)
+	require.NoError(t, err)
+	assert.Equal(t, models.AddCampaignMembersResult{Added: 2, Skipped: 1}, *result, "Ann is already a member")
+
+	members, err := svc.ListMembers(ctx, campaign.ID, 0, 0, other)
+	require.NoError(t, err)
+	require.Len(t, members, 4)
+	var danMember *models.SystemCampaignMember
+	for _, m := range members {
+		if m.RecordID == danID {
+			danMember = m
+		}
+		if m.RecordID == bobID {
+			assert.True(t, m.HasResponded)
+			assert.NotNil(t, m.FirstRespondedDate)
+		}
+	}
+	require.NotNil(t, danMember)
+	assert.Equal(t, constants.CampaignMemberStatusSent, danMember.Status)
+
+	_, err = svc.UpdateMemberStatus(ctx, danMember.ID, "Attended", marketer)
+	require.Error(t, err, "statuses must belong to the campaign")
+	updated, err := svc.UpdateMemberStatus(ctx, danMember.ID, constants.CampaignMemberStatusResponded, marketer)
+	require.NoError(t, err)
+	assert.True(t, updated.HasResponded)
+	require.NotNil(t, updated.FirstRespondedDate)
+
+	h.CreateRecord(t, deal.APIName, models.SObject{"name": "Won", "amount": 500, "stage": "Won", "prospect_id": annID})
+	h.CreateRecord(t, deal.APIName, models.SObject{"name": "Open", "amount": 300, "stage": "Open", "prospect_id": danID})
+	h.CreateRecord(t, deal.APIName, models.SObject{"name": "No prospect", "amount": 900, "stage": "Won"})
+
+	detail, err := svc.Recalculate(ctx, campaign.ID, marketer)
+	require.NoError(t, err)
+	c := detail.Campaign
+	assert.Equal(t, 4, c.NumberOfMembers)
+	assert.Equal(t, 3, c.NumberOfResponses)
+	assert.Equal(t, 2, c.NumberOfOpportunities)
+	assert.Equal(t, 1, c.NumberOfWonOpportunities)
+	assert.Equal(t, 800.0, c.AmountAllOpportunities)
+	assert.Equal(t, 500.0, c.AmountWonOpportunities)
+	assert.NotNil(t, c.RollupsDate)
+	assert.Equal(t, map[string]int{constants.CampaignMemberStatusSent: 1, constants.CampaignMemberStatusResponded: 3}, detail.StatusCounts)
+	require.NotNil(t, detail.ROI)
+	assert.InDelta(t, 4.0, *detail.ROI, 1e-9)
+	require.NotNil(t, detail.ResponseRate)
+	assert.InDelta(t, 0.75, *detail.ResponseRate, 1e-9)
+
+	edit := *c
+	edit.MemberStatuses = []byte(`[{"status": "Sent", "is_default": true}]`)
+	require.Error(t, svc.Update(ctx, campaign.ID, &edit, marketer), "statuses held by members stay")
+	edit.MemberStatuses = []byte(`[{"status": "Sent", "is_default": true}, {"status": "Responded", "responded": true}, {"status": "Attended", "responded": true}]`)
+	edit.Status = constants.CampaignStatusCompleted
+	require.NoError(t, svc.Update(ctx, campaign.ID, &edit, marketer))
+	assert.Equal(t, 4, edit.NumberOfMembers, "rollups are kept")
+
+	require.NoError(t, svc.RemoveMember(ctx, danMember.ID, marketer))
+	detail, err = svc.Get(ctx, campaign.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, detail.Campaign.NumberOfMembers)
+	assert.Len(t, detail.Statuses, 3)
+	assert.Equal(t, constants.CampaignStatusCompleted, detail.Campaign.Status)
+
+	require.Error(t, svc.Delete(ctx, campaign.ID, other))
+	require.NoError(t, svc.Delete(ctx, campaign.ID, marketer))
+	_, err = svc.Get(ctx, campaign.ID)
+	require.Error(t, err)
+}
@@ -0,0 +1,723 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultCampaignInfluenceObject is the object whose records campaigns influence
+	defaultCampaignInfluenceObject = "opportunity"
+	// defaultCampaignRollupInterval applies when CAMPAIGN_ROLLUP_INTERVAL is unset or invalid
+	defaultCampaignRollupInterval = time.Hour
+	// maxCampaignMembersAdded caps the records one bulk add from a list view or report may match
+	maxCampaignMembersAdded = 50000
+	// campaignMemberBatchSize is the number of records checked and inserted at a time
+	campaignMemberBatchSize = 500
+	// defaultCampaignMemberPageSize and maxCampaignMemberPageSize bound member listings
+	defaultCampaignMemberPageSize = 200
+	maxCampaignMemberPageSize     = 2000
+)
+
+// CampaignService manages marketing campaigns and their members. Members are records
+// (typically leads and contacts) added by ID or in bulk from a list view or report, each
+// with one of the campaign's member statuses. Records of the influence object that look up
+// a member and were created after the member was added count as influenced by the
+// campaign; their number and amounts are rolled up onto it.
+type CampaignService struct {
+	repo            *persistence.CampaignRepository
+	metadata        *MetadataService
+	query           *QueryService
+	reports         *ReportService
+	forecasts       *ForecastService
+	permissions     *PermissionService
+	influenceObject string
+	interval        time.Duration
+
+	mu      sync.Mutex
+	lastRun time.Time
+}
+
+// NewCampaignService creates a new CampaignService
+func NewCampaignService(
+	repo *persistence.CampaignRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	reports *ReportService,
+	forecasts *ForecastService,
+	permissions *PermissionService,
+	influenceObject string,
+	interval time.Duration,
+) *CampaignService {
+	return &CampaignService{
+		repo:            repo,
+		metadata:        metadata,
+		query:           query,
+		reports:         reports,
+		forecasts:       forecasts,
+		permissions:     permissions,
+		influenceObject: influenceObject,
+		interval:        interval,
+	}
+}
+
+// campaignInfluenceObject returns the object of CAMPAIGN_INFLUENCE_OBJECT (default opportunity)
+func campaignInfluenceObject(raw string) string {
+	if object := strings.TrimSpace(raw); object != "" {
+		return strings.ToLower(object)
+	}
+	return defaultCampaignInfluenceObject
+}
+
+// List returns every campaign; campaigns are visible to all users
+func (s *CampaignService) List(ctx context.Context) ([]*models.SystemCampaign, error) {
+	return s.repo.List(ctx)
+}
+
+// Get returns a campaign with its member statuses, member counts per status and ratios
+func (s *CampaignService) Get(ctx context.Context, id string) (*models.CampaignDetail, error) {
+	campaign, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := campaignMemberStatuses(campaign)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.repo.StatusCounts(ctx, campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+	detail := &models.CampaignDetail{Campaign: campaign, Statuses: statuses, StatusCounts: counts}
+	if campaign.NumberOfMembers > 0 {
+		rate := float64(campaign.NumberOfResponses) / float64(campaign.NumberOfMembers)
+		detail.ResponseRate = &rate
+	}
+	if campaign.ActualCost != nil && *campaign.ActualCost > 0 {
+		roi := (campaign.AmountWonOpportunities - *campaign.ActualCost) / *campaign.ActualCost
+		detail.ROI = &roi
+	}
+	return detail, nil
+}
+
+func (s *CampaignService) getCampaign(ctx context.Context, id string) (*models.SystemCampaign, error) {
+	campaign, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if campaign == nil {
+		return nil, errors.NewNotFoundError(constants.TableCampaign, id)
+	}
+	return campaign, nil
+}
+
+// Create saves a new campaign owned by the current user. campaign is updated in place with
+// the stored values.
+func (s *CampaignService) Create(ctx context.Context, campaign *models.SystemCampaign, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	campaign.ID = GenerateID()
+	campaign.OwnerID = currentUser.ID
+	campaign.NumberOfMembers, campaign.NumberOfResponses = 0, 0
+	campaign.NumberOfOpportunities, campaign.NumberOfWonOpportunities = 0, 0
+	campaign.AmountAllOpportunities, campaign.AmountWonOpportunities = 0, 0
+	campaign.RollupsDate = nil
+	if err := normalizeCampaign(campaign); err != nil {
+		return err
+	}
+	return s.repo.Insert(ctx, campaign)
+}
+
+// Update replaces the editable fields of a campaign with those of updates; the owner stays
+// unless updates names a new one. Member statuses still held by members cannot be removed.
+// updates is replaced with the stored values.
+func (s *CampaignService) Update(ctx context.Context, id string, updates *models.SystemCampaign, currentUser *models.UserSession) error {
+	existing, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditCampaign(existing, currentUser); err != nil {
+		return err
+	}
+
+	updated := *existing
+	updated.Name = updates.Name
+	updated.Description = updates.Description
+	updated.Type = updates.Type
+	updated.Status = updates.Status
+	updated.StartDate = updates.StartDate
+	updated.EndDate = updates.EndDate
+	updated.BudgetedCost = updates.BudgetedCost
+	updated.ActualCost = updates.ActualCost
+	updated.ExpectedRevenue = updates.ExpectedRevenue
+	updated.MemberStatuses = updates.MemberStatuses
+	updated.IsActive = updates.IsActive
+	if updates.OwnerID != "" {
+		updated.OwnerID = updates.OwnerID
+	}
+	if err := normalizeCampaign(&updated); err != nil {
+		return err
+	}
+
+	statuses, err := campaignMemberStatuses(&updated)
+	if err != nil {
+		return err
+	}
+	counts, err := s.repo.StatusCounts(ctx, id)
+	if err != nil {
+		return err
+	}
+	for status, count := range counts {
+		if count > 0 && findCampaignMemberStatus(statuses, status) == nil {
+			return errors.NewValidationError(constants.FieldSysCampaign_MemberStatuses,
+				fmt.Sprintf("status %q is held by %d members", status, count))
+		}
+	}
+
+	if err := s.repo.Update(ctx, &updated); err != nil {
+		return err
+	}
+	*updates = updated
+	return nil
+}
+
+// Delete removes a campaign and its members
+func (s *CampaignService) Delete(ctx context.Context, id string, currentUser *models.UserSession) error {
+	campaign, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditCampaign(campaign, currentUser); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, campaign.ID)
+}
+
+// ListMembers returns a page of a campaign's members, leaving out those of objects the
+// current user cannot read
+func (s *CampaignService) ListMembers(ctx context.Context, id string, limit, offset int, currentUser *models.UserSession) ([]*models.SystemCampaignMember, error) {
+	campaign, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultCampaignMemberPageSize
+	}
+	if limit > maxCampaignMemberPageSize {
+		limit = maxCampaignMemberPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	members, err := s.repo.ListMembers(ctx, campaign.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make(map[string]bool)
+	visible := make([]*models.SystemCampaignMember, 0, len(members))
+	for _, m := range members {
+		canRead, checked := readable[m.ObjectAPIName]
+		if !checked {
+			canRead = s.permissions.CheckObjectPermissionWithUser(ctx, m.ObjectAPIName, constants.PermRead, currentUser)
+			readable[m.ObjectAPIName] = canRead
+		}
+		if canRead {
+			visible = append(visible, m)
+		}
+	}
+	return visible, nil
+}
+
+// AddMembers adds records of one object to a campaign: the given record IDs, or every record
+// matching a list view or report. Records must be readable by the current user; records
+// already members are skipped.
+func (s *CampaignService) AddMembers(ctx context.Context, id string, input models.AddCampaignMembersInput, currentUser *models.UserSession) (*models.AddCampaignMembersResult, error) {
+	campaign, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEditCampaign(campaign, currentUser); err != nil {
+		return nil, err
+	}
+	statuses, err := campaignMemberStatuses(campaign)
+	if err != nil {
+		return nil, err
+	}
+	status := defaultCampaignMemberStatus(statuses)
+	if input.Status != "" {
+		if status = findCampaignMemberStatus(statuses, input.Status); status == nil {
+			return nil, errors.NewValidationError("status", fmt.Sprintf("%q is not a member status of the campaign", input.Status))
+		}
+	}
+
+	sources := 0
+	for _, set := range []bool{len(input.RecordIDs) > 0, input.ListViewID != "", input.ReportID != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, errors.NewValidationError("record_ids", "exactly one of record_ids, list_view_id or report_id is required")
+	}
+
+	var object string
+	var recordIDs []string
+	switch {
+	case len(input.RecordIDs) > 0:
+		object, recordIDs, err = s.readableRecordIDs(ctx, input.ObjectAPIName, input.RecordIDs, currentUser)
+	case input.ListViewID != "":
+		var view *models.ListView
+		if view, err = s.metadata.GetListView(ctx, input.ListViewID, currentUser); err == nil {
+			object, recordIDs, err = s.matchingRecordIDs(ctx, view.ObjectAPIName, view.FilterExpr, currentUser)
+		}
+	default:
+		var report *models.Report
+		if report, err = s.reports.Get(ctx, input.ReportID, currentUser); err == nil {
+			object, recordIDs, err = s.matchingRecordIDs(ctx, report.ObjectAPIName, report.FilterExpr, currentUser)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.AddCampaignMembersResult{}
+	now := time.Now()
+	for start := 0; start < len(recordIDs); start += campaignMemberBatchSize {
+		batch := recordIDs[start:min(start+campaignMemberBatchSize, len(recordIDs))]
+		existing, err := s.repo.ExistingMembers(ctx, campaign.ID, object, batch)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]*models.SystemCampaignMember, 0, len(batch))
+		for _, recordID := range batch {
+			if existing[recordID] {
+				result.Skipped++
+				continue
+			}
+			existing[recordID] = true
+			member := &models.SystemCampaignMember{
+				ID:               GenerateID(),
+				CampaignID:       campaign.ID,
+				ObjectAPIName:    object,
+				RecordID:         recordID,
+				Status:           status.Status,
+				HasResponded:     status.Responded,
+				AddedByID:        currentUser.ID,
+				CreatedDate:      now,
+				LastModifiedDate: now,
+			}
+			if status.Responded {
+				member.FirstRespondedDate = &now
+			}
+			members = append(members, member)
+		}
+		if len(members) == 0 {
+			continue
+		}
+		if err := s.repo.InsertMembers(ctx, members); err != nil {
+			return nil, err
+		}
+		result.Added += len(members)
+	}
+
+	if err := s.repo.RefreshMemberCounts(ctx, campaign.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// readableRecordIDs checks that the given records exist and are readable by the user
+func (s *CampaignService) readableRecordIDs(ctx context.Context, objectAPIName string, ids []string, currentUser *models.UserSession) (string, []string, error) {
+	schema, err := s.memberSchema(ctx, objectAPIName)
+	if err != nil {
+		return "", nil, err
+	}
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found := make(map[string]bool, len(unique))
+	for start := 0; start < len(unique); start += campaignMemberBatchSize {
+		batch := unique[start:min(start+campaignMemberBatchSize, len(unique))]
+		records, err := s.query.QueryByIDs(ctx, schema.APIName, batch, currentUser)
+		if err != nil {
+			return "", nil, errors.NewPermissionError(constants.PermRead, schema.APIName)
+		}
+		for _, record := range records {
+			if s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, currentUser) {
+				found[record.GetString(constants.FieldID)] = true
+			}
+		}
+	}
+	for _, id := range unique {
+		if !found[id] {
+			return "", nil, errors.NewNotFoundError(schema.APIName, id)
+		}
+	}
+	return schema.APIName, unique, nil
+}
+
+// matchingRecordIDs returns the IDs of the records matching a filter, queried as the user
+func (s *CampaignService) matchingRecordIDs(ctx context.Context, objectAPIName, filter string, currentUser *models.UserSession) (string, []string, error) {
+	schema, err := s.memberSchema(ctx, objectAPIName)
+	if err != nil {
+		return "", nil, err
+	}
+	ids := make([]string, 0)
+	lastID := ""
+	for {
+		batchFilter := filter
+		if lastID != "" {
+			batchFilter = combineFilters(fmt.Sprintf("%s > %s", constants.FieldID, strconv.Quote(lastID)), filter)
+		}
+		rows, err := s.query.Query(ctx, models.QueryRequest{
+			ObjectAPIName:   schema.APIName,
+			FilterExpr:      batchFilter,
+			SortField:       constants.FieldID,
+			SortDirection:   constants.SortASC,
+			Limit:           campaignMemberBatchSize,
+			SkipLookupNames: true,
+		}, currentUser)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, row := range rows {
+			lastID = row.GetString(constants.FieldID)
+			ids = append(ids, lastID)
+		}
+		if len(ids) > maxCampaignMembersAdded {
+			return "", nil, errors.NewLimitExceededError("campaign_members_added", maxCampaignMembersAdded,
+				fmt.Sprintf("more than %d records match; narrow the filter", maxCampaignMembersAdded))
+		}
+		if len(rows) < campaignMemberBatchSize {
+			return schema.APIName, ids, nil
+		}
+	}
+}
+
+// memberSchema returns the schema of an object whose records can be campaign members
+func (s *CampaignService) memberSchema(ctx context.Context, objectAPIName string) (*models.ObjectMetadata, error) {
+	if objectAPIName == "" {
+		return nil, errors.NewValidationError("object_api_name", "object_api_name is required")
+	}
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	if constants.IsSystemTable(schema.APIName) {
+		return nil, errors.NewValidationError("object_api_name", "system objects cannot be campaign members")
+	}
+	return schema, nil
+}
+
+// UpdateMemberStatus sets a member's status. The first move to a responded status stamps
+// the first responded date, which later status changes keep.
+func (s *CampaignService) UpdateMemberStatus(ctx context.Context, memberID, status string, currentUser *models.UserSession) (*models.SystemCampaignMember, error) {
+	member, campaign, err := s.getMember(ctx, memberID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := campaignMemberStatuses(campaign)
+	if err != nil {
+		return nil, err
+	}
+	next := findCampaignMemberStatus(statuses, status)
+	if next == nil {
+		return nil, errors.NewValidationError("status", fmt.Sprintf("%q is not a member status of the campaign", status))
+	}
+	member.Status = next.Status
+	member.HasResponded = next.Responded
+	if next.Responded && member.FirstRespondedDate == nil {
+		now := time.Now()
+		member.FirstRespondedDate = &now
+	}
+	if err := s.repo.UpdateMemberStatus(ctx, member); err != nil {
+		return nil, err
+	}
+	if err := s.repo.RefreshMemberCounts(ctx, campaign.ID); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember removes a member from its campaign
+func (s *CampaignService) RemoveMember(ctx context.Context, memberID string, currentUser *models.UserSession) error {
+	member, campaign, err := s.getMember(ctx, memberID, currentUser)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteMember(ctx, member.ID); err != nil {
+		return err
+	}
+	return s.repo.RefreshMemberCounts(ctx, campaign.ID)
+}
+
+// getMember returns a member and its campaign, checking the user may edit the campaign
+func (s *CampaignService) getMember(ctx context.Context, memberID string, currentUser *models.UserSession) (*models.SystemCampaignMember, *models.SystemCampaign, error) {
+	member, err := s.repo.GetMember(ctx, memberID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if member == nil {
+		return nil, nil, errors.NewNotFoundError(constants.TableCampaignMember, memberID)
+	}
+	campaign, err := s.getCampaign(ctx, member.CampaignID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkCanEditCampaign(campaign, currentUser); err != nil {
+		return nil, nil, err
+	}
+	return member, campaign, nil
+}
+
+// Recalculate refreshes the member counts and influence rollups of a campaign now
+func (s *CampaignService) Recalculate(ctx context.Context, id string, currentUser *models.UserSession) (*models.CampaignDetail, error) {
+	campaign, err := s.getCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEditCampaign(campaign, currentUser); err != nil {
+		return nil, err
+	}
+	if err := s.recalculate(ctx, campaign.ID, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, campaign.ID)
+}
+
+// Run recalculates the rollups of every active campaign once the configured interval has
+// passed since the last run. It runs on the scheduler tick.
+func (s *CampaignService) Run(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.interval <= 0 || (!s.lastRun.IsZero() && now.Sub(s.lastRun) < s.interval) {
+		return
+	}
+	s.lastRun = now
+
+	campaigns, err := s.repo.ListActive(ctx)
+	if err != nil {
+		log.Printf("⚠️ [Campaigns] Failed to load active campaigns: %v", err)
+		return
+	}
+	for _, campaign := range campaigns {
+		if err := s.recalculate(ctx, campaign.ID, now); err != nil {
+			log.Printf("⚠️ [Campaigns] %s: %v", campaign.ID, err)
+		}
+	}
+}
+
+// recalculate refreshes a campaign's member counts and influence rollups
+func (s *CampaignService) recalculate(ctx context.Context, campaignID string, now time.Time) error {
+	if err := s.repo.RefreshMemberCounts(ctx, campaignID); err != nil {
+		return err
+	}
+	rollups, err := s.influence(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	return s.repo.SetRollups(ctx, campaignID, rollups, now)
+}
+
+// influence totals the records of the influence object that look up a campaign member. The
+// amount and won stages come from the object's forecast setting; without one only the
+// records are counted.
+func (s *CampaignService) influence(ctx context.Context, campaignID string) (persistence.CampaignRollups, error) {
+	var rollups persistence.CampaignRollups
+	schema := s.metadata.GetSchema(ctx, s.influenceObject)
+	if schema == nil {
+		return rollups, nil
+	}
+	var amountField, stageField string
+	won := make(map[string]bool)
+	setting, err := s.forecasts.GetSetting(ctx, schema.APIName)
+	switch {
+	case err == nil:
+		amountField, stageField = setting.AmountField, setting.StageField
+		for stage, category := range setting.CategoryMapping {
+			if category == constants.ForecastCategoryClosed {
+				won[stage] = true
+			}
+		}
+	case !errors.IsNotFound(err):
+		return rollups, err
+	}
+
+	objects, err := s.repo.MemberObjects(ctx, campaignID)
+	if err != nil {
+		return rollups, err
+	}
+	influenced := make(map[string]persistence.CampaignInfluence)
+	for _, field := range schema.Fields {
+		if field.Type != constants.FieldTypeLookup {
+			continue
+		}
+		for _, object := range objects {
+			if !campaignLookupReferences(field, object) {
+				continue
+			}
+			records, err := s.repo.InfluencedRecords(ctx, campaignID, object, schema.APIName, field.APIName, amountField, stageField)
+			if err != nil {
+				return rollups, err
+			}
+			for _, record := range records {
+				influenced[record.RecordID] = record
+			}
+		}
+	}
+	return campaignRollups(influenced, won), nil
+}
+
+// campaignLookupReferences reports whether a lookup field can reference records of object
+func campaignLookupReferences(field models.FieldMetadata, object string) bool {
+	for _, ref := range field.ReferenceTo {
+		if strings.EqualFold(ref, object) {
+			return true
+		}
+	}
+	return false
+}
+
+// campaignRollups counts and sums the influenced records; won stages also count as won
+func campaignRollups(influenced map[string]persistence.CampaignInfluence, won map[string]bool) persistence.CampaignRollups {
+	var rollups persistence.CampaignRollups
+	for _, record := range influenced {
+		rollups.Opportunities++
+		rollups.AmountAll += record.Amount
+		if won[record.Stage] {
+			rollups.WonOpportunities++
+			rollups.AmountWon += record.Amount
+		}
+	}
+	return rollups
+}
+
+// checkCanEditCampaign allows administrators and the campaign owner
+func checkCanEditCampaign(campaign *models.SystemCampaign, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if currentUser.IsSystemAdmin || constants.IsSuperUser(currentUser.ProfileID) || campaign.OwnerID == currentUser.ID {
+		return nil
+	}
+	return errors.NewPermissionError("edit", "campaign "+campaign.ID)
+}
+
+// normalizeCampaign validates a campaign, defaulting its status and member statuses
+func normalizeCampaign(c *models.SystemCampaign) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return errors.NewValidationError(constants.FieldSysCampaign_Name, "name is required")
+	}
+	if c.Status == "" {
+		c.Status = constants.CampaignStatusPlanned
+	}
+	validStatus := false
+	for _, status := range constants.CampaignStatuses {
+		validStatus = validStatus || status == c.Status
+	}
+	if !validStatus {
+		return errors.NewValidationError(constants.FieldSysCampaign_Status,
+			fmt.Sprintf("status must be one of %s", strings.Join(constants.CampaignStatuses, ", ")))
+	}
+	if c.StartDate != nil && c.EndDate != nil && c.EndDate.Before(*c.StartDate) {
+		return errors.NewValidationError(constants.FieldSysCampaign_EndDate, "end date must not be before start date")
+	}
+	for field, amount := range map[string]*float64{
+		constants.FieldSysCampaign_BudgetedCost:    c.BudgetedCost,
+		constants.FieldSysCampaign_ActualCost:      c.ActualCost,
+		constants.FieldSysCampaign_ExpectedRevenue: c.ExpectedRevenue,
+	} {
+		if amount != nil && *amount < 0 {
+			return errors.NewValidationError(field, "must not be negative")
+		}
+	}
+
+	statuses, err := campaignMemberStatuses(c)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(statuses))
+	defaults := 0
+	for i := range statuses {
+		statuses[i].Status = strings.TrimSpace(statuses[i].Status)
+		key := strings.ToLower(statuses[i].Status)
+		if key == "" || seen[key] {
+			return errors.NewValidationError(constants.FieldSysCampaign_MemberStatuses, "member statuses must be unique and not empty")
+		}
+		seen[key] = true
+		if statuses[i].IsDefault {
+			defaults++
+		}
+	}
+	if defaults > 1 {
+		return errors.NewValidationError(constants.FieldSysCampaign_MemberStatuses, "only one member status can be the default")
+	}
+	if defaults == 0 {
+		statuses[0].IsDefault = true
+	}
+	raw, err := json.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+	c.MemberStatuses = raw
+	return nil
+}
+
+// campaignMemberStatuses parses the member statuses of a campaign; campaigns without their
+// own have Sent (the default) and Responded
+func campaignMemberStatuses(c *models.SystemCampaign) ([]models.CampaignMemberStatus, error) {
+	var statuses []models.CampaignMemberStatus
+	if len(c.MemberStatuses) > 0 && string(c.MemberStatuses) != "null" {
+		if err := json.Unmarshal(c.MemberStatuses, &statuses); err != nil {
+			return nil, errors.NewValidationError(constants.FieldSysCampaign_MemberStatuses, "member statuses must be a list of {status, responded}")
+		}
+	}
+	if len(statuses) == 0 {
+		statuses = []models.CampaignMemberStatus{
+			{Status: constants.CampaignMemberStatusSent, IsDefault: true},
+			{Status: constants.CampaignMemberStatusResponded, Responded: true},
+		}
+	}
+	return statuses, nil
+}
+
+// findCampaignMemberStatus returns the member status named status (case-insensitive), or nil
+func findCampaignMemberStatus(statuses []models.CampaignMemberStatus, status string) *models.CampaignMemberStatus {
+	for i := range statuses {
+		if strings.EqualFold(statuses[i].Status, strings.TrimSpace(status)) {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// defaultCampaignMemberStatus returns the status given to members added without one
+func defaultCampaignMemberStatus(statuses []models.CampaignMemberStatus) *models.CampaignMemberStatus {
+	for i := range statuses {
+		if statuses[i].IsDefault {
+			return &statuses[i]
+		}
+	}
+	return &statuses[0]
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCampaign(t *testing.T) {
+	c := &models.SystemCampaign{Name: "  Launch "}
+	require.NoError(t, normalizeCampaign(c))
+	assert.Equal(t, "Launch", c.Name)
+	assert.Equal(t, constants.CampaignStatusPlanned, c.Status)
+	statuses, err := campaignMemberStatuses(c)
+	require.NoError(t, err)
+	assert.Equal(t, []models.CampaignMemberStatus{
+		{Status: constants.CampaignMemberStatusSent, IsDefault: true},
+		{Status: constants.CampaignMemberStatusResponded, Responded: true},
+	}, statuses)
+
+	c = &models.SystemCampaign{Name: "Launch", MemberStatuses: json.RawMessage(`[{"status": " Invited "}, {"status": "Attended", "responded": true}]`)}
+	require.NoError(t, normalizeCampaign(c))
+	statuses, err = campaignMemberStatuses(c)
+	require.NoError(t, err)
+	assert.Equal(t, "Invited", defaultCampaignMemberStatus(statuses).Status, "the first status is the default")
+	assert.True(t, findCampaignMemberStatus(statuses, "attended").Responded)
+	assert.Nil(t, findCampaignMemberStatus(statuses, "Sent"))
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, -1)
+	negative := -1.0
+	for name, invalid := range map[string]*models.SystemCampaign{
+		"name":               {Name: " "},
+		"status":             {Name: "x", Status: "Running"},
+		"dates":              {Name: "x", StartDate: &start, EndDate: &end},
+		"cost":               {Name: "x", ActualCost: &negative},
+		"duplicate status":   {Name: "x", MemberStatuses: json.RawMessage(`[{"status": "Sent"}, {"status": "sent"}]`)},
+		"empty status":       {Name: "x", MemberStatuses: json.RawMessage(`[{"status": ""}]`)},
+		"two defaults":       {Name: "x", MemberStatuses: json.RawMessage(`[{"status": "A", "is_default": true}, {"status": "B", "is_default": true}]`)},
+		"malformed statuses": {Name: "x", MemberStatuses: json.RawMessage(`{"status": "A"}`)},
+	} {
+		assert.Error(t, normalizeCampaign(invalid), name)
+	}
+}
+
+func TestCampaignRollups(t *testing.T) {
+	rollups := campaignRollups(map[string]persistence.CampaignInfluence{
+		"a": {RecordID: "a", Amount: 500, Stage: "Won"},
+		"b": {RecordID: "b", Amount: 300, Stage: "Open"},
+		"c": {RecordID: "c", Amount: 200, Stage: "Closed Won"},
+	}, map[string]bool{"Won": true, "Closed Won": true})
+	assert.Equal(t, persistence.CampaignRollups{Opportunities: 3, WonOpportunities: 2, AmountAll: 1000, AmountWon: 700}, rollups)
+
+	assert.True(t, campaignLookupReferences(models.FieldMetadata{ReferenceTo: []string{"Lead", "contact"}}, "lead"))
+	assert.False(t, campaignLookupReferences(models.FieldMetadata{ReferenceTo: []string{"account"}}, "lead"))
+}
+
+func TestCampaignInfluenceObject(t *testing.T) {
+	assert.Equal(t, "opportunity", campaignInfluenceObject(""))
+	assert.Equal(t, "deal", campaignInfluenceObject(" Deal "))
+}
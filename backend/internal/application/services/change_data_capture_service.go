@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultChangeEventPageSize = 500
+	maxChangeEventPageSize     = 5000
+
+	// changeEventSettleDelay holds back freshly written events. Positions are allocated when
+	// an event is written, not when its transaction commits, so a reader right at the head
+	// of the stream could otherwise move past a position whose transaction is still open.
+	changeEventSettleDelay = 2 * time.Second
+)
+
+// changeTransactionKey carries the ID shared by every change captured in one transaction
+type changeTransactionKey struct{}
+
+// RecordChange is one record change made by a persistence operation
+type RecordChange struct {
+	Operation     constants.ChangeOperation
+	ObjectAPIName string
+	Before        models.SObject // nil for creates
+	After         models.SObject // nil for deletes
+	ChangedFields []string       // Written fields of updates; derived from After for creates
+}
+
+// ChangeDataCaptureService records every change to business records on a durable,
+// ordered stream that downstream consumers (e.g. data warehouse loaders) read and replay
+// by position. Unlike the outbox, which drives in-process handlers and is drained once
+// delivered, the stream is append-only and each consumer tracks its own offset.
+//
+// Changes are appended inside the transaction that makes them, so the stream holds
+// exactly the committed changes. System tables are not captured, and Password and
+// EncryptedString values never leave the record.
+type ChangeDataCaptureService struct {
+	repo     *persistence.ChangeEventRepository
+	metadata *MetadataService
+}
+
+// NewChangeDataCaptureService creates a new ChangeDataCaptureService
+func NewChangeDataCaptureService(repo *persistence.ChangeEventRepository, metadata *MetadataService) *ChangeDataCaptureService {
+	return &ChangeDataCaptureService{repo: repo, metadata: metadata}
+}
+
+// WithChangeTransaction marks ctx as the context of a new transaction, so that every
+// change captured under it shares one transaction ID
+func WithChangeTransaction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, changeTransactionKey{}, GenerateID())
+}
+
+// CaptureTx appends record changes to the stream within tx
+func (s *ChangeDataCaptureService) CaptureTx(ctx context.Context, tx *sql.Tx, currentUser *models.UserSession, changes ...RecordChange) error {
+	txID, ok := ctx.Value(changeTransactionKey{}).(string)
+	if !ok {
+		txID = GenerateID()
+	}
+	var userID *string
+	if currentUser != nil {
+		userID = &currentUser.ID
+	}
+	now := time.Now().UTC()
+
+	events := make([]models.ChangeEvent, 0, len(changes))
+	for _, c := range changes {
+		if constants.IsSystemTable(c.ObjectAPIName) {
+			continue
+		}
+		schema := s.metadata.GetSchema(ctx, c.ObjectAPIName)
+		if schema == nil || schema.IsExternal {
+			continue
+		}
+
+		before := changeImage(schema, c.Before)
+		after := changeImage(schema, c.After)
+		recordID := ""
+		if after != nil {
+			recordID, _ = after[constants.FieldID].(string)
+		} else if before != nil {
+			recordID, _ = before[constants.FieldID].(string)
+		}
+
+		changed := c.ChangedFields
+		if changed == nil && c.Operation == constants.ChangeOperationCreate {
+			for field, v := range after {
+				if v != nil {
+					changed = append(changed, field)
+				}
+			}
+		}
+		changed = capturedFields(schema, changed)
+
+		events = append(events, models.ChangeEvent{
+			Header: models.ChangeEventHeader{
+				Operation:       c.Operation,
+				ObjectAPIName:   schema.APIName,
+				RecordID:        recordID,
+				TransactionID:   txID,
+				CommitTimestamp: now,
+				ChangedFields:   changed,
+				UserID:          userID,
+			},
+			Before: before,
+			After:  after,
+		})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return s.repo.Append(ctx, tx, events)
+}
+
+// Read returns the events after a stream position, oldest first, optionally restricted
+// to some objects. Pass 0 to replay the stream from its start.
+func (s *ChangeDataCaptureService) Read(ctx context.Context, from int64, objects []string, limit int) (*models.ChangeEventPage, error) {
+	if from < 0 {
+		return nil, errors.NewValidationError("from", "Position cannot be negative")
+	}
+	switch {
+	case limit <= 0:
+		limit = defaultChangeEventPageSize
+	case limit > maxChangeEventPageSize:
+		limit = maxChangeEventPageSize
+	}
+
+	resolved := make([]string, 0, len(objects))
+	for _, o := range objects {
+		schema := s.metadata.GetSchema(ctx, o)
+		if schema == nil {
+			return nil, errors.NewNotFoundError("Object", o)
+		}
+		resolved = append(resolved, schema.APIName)
+	}
+
+	// Read one extra event to tell whether another page follows
+	events, err := s.repo.ReadFrom(ctx, from, resolved, time.Now().Add(-changeEventSettleDelay), limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &models.ChangeEventPage{Events: events, NextPosition: from}
+	if len(events) > limit {
+		page.Events = events[:limit]
+		page.HasMore = true
+	}
+	if n := len(page.Events); n > 0 {
+		page.NextPosition = page.Events[n-1].Position
+	}
+	return page, nil
+}
+
+// ReadForConsumer returns the events after the consumer's committed offset. A consumer
+// that never committed starts at the beginning of the stream.
+func (s *ChangeDataCaptureService) ReadForConsumer(ctx context.Context, consumer string, objects []string, limit int) (*models.ChangeEventPage, error) {
+	if err := validateConsumerName(consumer); err != nil {
+		return nil, err
+	}
+	offset, err := s.repo.FindOffset(ctx, consumer)
+	if err != nil {
+		return nil, err
+	}
+	var from int64
+	if offset != nil {
+		from = offset.Position
+	}
+	return s.Read(ctx, from, objects, limit)
+}
+
+// GetOffsets returns the committed offsets of all consumers
+func (s *ChangeDataCaptureService) GetOffsets(ctx context.Context) ([]models.ChangeEventOffset, error) {
+	return s.repo.GetOffsets(ctx)
+}
+
+// CommitOffset records the last position a consumer has processed. Committing a lower
+// position than before rewinds the consumer, which then replays from there.
+func (s *ChangeDataCaptureService) CommitOffset(ctx context.Context, consumer string, position int64) (*models.ChangeEventOffset, error) {
+	if err := validateConsumerName(consumer); err != nil {
+		return nil, err
+	}
+	if position < 0 {
+		return nil, errors.NewValidationError("position", "Position cannot be negative")
+	}
+	if err := s.repo.SaveOffset(ctx, consumer, position); err != nil {
+		return nil, err
+	}
+	return s.repo.FindOffset(ctx, consumer)
+}
+
+// DeleteOffset forgets a consumer, which then replays the stream from its start
+func (s *ChangeDataCaptureService) DeleteOffset(ctx context.Context, consumer string) error {
+	deleted, err := s.repo.DeleteOffset(ctx, consumer)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.NewNotFoundError("Change event consumer", consumer)
+	}
+	return nil
+}
+
+func validateConsumerName(consumer string) error {
+	if strings.TrimSpace(consumer) == "" {
+		return errors.NewValidationError("consumer", "Consumer name is required")
+	}
+	if len(consumer) > 255 {
+		return errors.NewValidationError("consumer", "Consumer name cannot exceed 255 characters")
+	}
+	return nil
+}
+
+// changeImage copies a record without its secret fields
+func changeImage(schema *models.ObjectMetadata, record models.SObject) models.SObject {
+	if record == nil {
+		return nil
+	}
+	image := make(models.SObject, len(record))
+	for field, v := range record {
+		if !isSecretField(schema, field) {
+			image[field] = v
+		}
+	}
+	return image
+}
+
+// capturedFields sorts field names, dropping secret fields
+func capturedFields(schema *models.ObjectMetadata, fields []string) []string {
+	result := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !isSecretField(schema, f) {
+			result = append(result, f)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isSecretField(schema *models.ObjectMetadata, fieldAPIName string) bool {
+	for _, f := range schema.Fields {
+		if strings.EqualFold(f.APIName, fieldAPIName) {
+			return f.Type == constants.FieldTypePassword || f.Type == constants.FieldTypeEncryptedString
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectArg matches any statement argument and keeps its value
+type collectArg struct {
+	values *[]driver.Value
+}
+
+func (a collectArg) Match(v driver.Value) bool {
+	*a.values = append(*a.values, v)
+	return true
+}
+
+// newTestChangeDataCaptureService returns a service that knows a contact object with a password field
+func newTestChangeDataCaptureService(t *testing.T) (*ChangeDataCaptureService, *sql.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	contact := &models.ObjectMetadata{
+		APIName: "contact",
+		Fields: []models.FieldMetadata{
+			{APIName: constants.FieldID, IsSystem: true},
+			{APIName: "name", Type: constants.FieldTypeText},
+			{APIName: "email", Type: constants.FieldTypeEmail},
+			{APIName: "portal_password", Type: constants.FieldTypePassword},
+		},
+	}
+	metadata := &MetadataService{
+		schemas:   []*models.ObjectMetadata{contact},
+		schemaMap: map[string]*models.ObjectMetadata{"contact": contact},
+	}
+	return NewChangeDataCaptureService(persistence.NewChangeEventRepository(db), metadata), db, mock
+}
+
+func TestChangeDataCapture_CaptureTx(t *testing.T) {
+	svc, db, mock := newTestChangeDataCaptureService(t)
+
+	var created, updated []driver.Value
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `?" + constants.TableChangeEvent).
+		WithArgs(repeatArg(collectArg{&created}, 10)...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `?" + constants.TableChangeEvent).
+		WithArgs(repeatArg(collectArg{&updated}, 10)...).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	err = svc.CaptureTx(WithChangeTransaction(context.Background()), tx, &models.UserSession{ID: "u9"},
+		RecordChange{
+			Operation:     constants.ChangeOperationCreate,
+			ObjectAPIName: "Contact",
+			After:         models.SObject{constants.FieldID: "c1", "name": "Ada", "email": nil, "portal_password": "hash1"},
+		},
+		RecordChange{
+			Operation:     constants.ChangeOperationUpdate,
+			ObjectAPIName: "contact",
+			Before:        models.SObject{constants.FieldID: "c2", "name": "Bob", "portal_password": "hash2"},
+			After:         models.SObject{constants.FieldID: "c2", "name": "Rob", "portal_password": "hash3"},
+			ChangedFields: []string{"portal_password", "name"},
+		},
+		RecordChange{
+			Operation:     constants.ChangeOperationCreate,
+			ObjectAPIName: constants.TableUser,
+			After:         models.SObject{constants.FieldID: "u1"},
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet(), "the system table change must not be captured")
+
+	// Both changes carry the transaction ID, the user and the operation, and no secret
+	for _, args := range [][]driver.Value{created, updated} {
+		assert.Contains(t, args, "u9")
+		for _, v := range args {
+			if s, ok := v.(string); ok {
+				assert.NotContains(t, s, "hash")
+			}
+		}
+	}
+	assert.Contains(t, created, "c")
+	assert.Contains(t, created, `["__sys_gen_id","name"]`)
+	assert.Contains(t, updated, "u")
+	assert.Contains(t, updated, `["name"]`)
+
+	// Each change has its own event ID but both share the transaction ID
+	sharedIDs := 0
+	for _, v := range created {
+		if s, ok := v.(string); ok && len(s) == 36 {
+			for _, w := range updated {
+				if w == s {
+					sharedIDs++
+				}
+			}
+		}
+	}
+	assert.Equal(t, 1, sharedIDs)
+}
+
+func TestChangeDataCapture_ReadPagesFromPosition(t *testing.T) {
+	svc, _, mock := newTestChangeDataCaptureService(t)
+
+	now := time.Now()
+	columns := []string{"id", "position", "object_api_name", "record_id", "operation", "transaction_id", "commit_timestamp", "changed_fields", "before_data", "after_data", "user_id"}
+	mock.ExpectQuery("FROM `?"+constants.TableChangeEvent).
+		WithArgs(int64(41), sqlmock.AnyArg(), "contact").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("e1", 42, "contact", "c1", "c", "t1", now, `["name"]`, nil, `{"__sys_gen_id": "c1", "name": "Ada"}`, "u9").
+			AddRow("e2", 43, "contact", "c1", "u", "t2", now, `["name"]`, `{"name": "Ada"}`, `{"name": "Ida"}`, nil).
+			AddRow("e3", 44, "contact", "c1", "d", "t3", now, nil, `{"name": "Ida"}`, nil, nil))
+
+	page, err := svc.Read(context.Background(), 41, []string{"Contact"}, 2)
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, int64(43), page.NextPosition)
+
+	first := page.Events[0]
+	assert.Equal(t, constants.ChangeOperationCreate, first.Header.Operation)
+	assert.Nil(t, first.Before)
+	assert.Equal(t, "Ada", first.After["name"])
+	require.NotNil(t, first.Header.UserID)
+
+	record := first.Debezium("nexuscrm")
+	assert.Equal(t, "nexuscrm.contact", record.Topic)
+	assert.Equal(t, "c1", record.Key[constants.FieldID])
+	assert.Equal(t, "c", record.Value.Op)
+	assert.Equal(t, int64(42), record.Value.Source.Pos)
+}
+
+func TestChangeDataCapture_ReadRejectsUnknownObjectAndBadPosition(t *testing.T) {
+	svc, _, _ := newTestChangeDataCaptureService(t)
+
+	_, err := svc.Read(context.Background(), 0, []string{"nope"}, 10)
+	assert.Error(t, err)
+
+	_, err = svc.Read(context.Background(), -1, nil, 10)
+	assert.Error(t, err)
+
+	_, err = svc.CommitOffset(context.Background(), " ", 10)
+	assert.Error(t, err)
+}
+
+func repeatArg(arg sqlmock.Argument, n int) []driver.Value {
+	args := make([]driver.Value, n)
+	for i := range args {
+		args[i] = arg
+	}
+	return args
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/charts"
+	pkgErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ChartImageOptions controls a rendered chart image
+type ChartImageOptions struct {
+	Format charts.Format
+	Kind   charts.Kind // Reports only; widgets are drawn as their own chart type
+	Width  int
+	Height int
+}
+
+// ChartService renders dashboard widgets and reports as PNG/SVG images for contexts
+// without a browser, such as email digests and exports. Data is queried with the
+// permissions of the requesting user exactly as for the interactive views.
+type ChartService struct {
+	dashboards *DashboardRunner
+	reports    *ReportService
+}
+
+// NewChartService creates a new ChartService
+func NewChartService(dashboards *DashboardRunner, reports *ReportService) *ChartService {
+	return &ChartService{
+		dashboards: dashboards,
+		reports:    reports,
+	}
+}
+
+// WidgetImage renders a group_by widget of a dashboard
+func (cs *ChartService) WidgetImage(ctx context.Context, dashboardID, widgetID string, opts ChartImageOptions, currentUser *models.UserSession) ([]byte, error) {
+	widget, data, err := cs.dashboards.RunWidget(ctx, dashboardID, widgetID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	records, ok := data.([]models.SObject)
+	if !ok {
+		return nil, pkgErrors.NewValidationError("widget", fmt.Sprintf("widget '%s' has no chartable data", widgetID))
+	}
+	points := make([]charts.Point, 0, len(records))
+	for _, rec := range records {
+		points = append(points, charts.Point{Label: chartLabel(rec["name"]), Value: chartValue(rec["value"])})
+	}
+
+	return renderChart(charts.Chart{
+		Kind:   widgetChartKind(widget.Type),
+		Title:  widget.Title,
+		Points: points,
+		Width:  opts.Width,
+		Height: opts.Height,
+	}, opts.Format)
+}
+
+// ReportImage renders the top-level groups of a summary report, or the row totals of a matrix
+// report, charting the report's first aggregate (or the record count when it has none)
+func (cs *ChartService) ReportImage(ctx context.Context, reportID string, opts ChartImageOptions, currentUser *models.UserSession) ([]byte, error) {
+	result, err := cs.reports.Run(ctx, reportID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	value := func(count int64, values map[string]interface{}) float64 {
+		if len(result.Aggregates) > 0 {
+			return chartValue(values[result.Aggregates[0]])
+		}
+		return float64(count)
+	}
+
+	var points []charts.Point
+	switch result.Format {
+	case constants.ReportFormatSummary:
+		for _, g := range result.Groups {
+			points = append(points, charts.Point{Label: chartLabel(g.Value), Value: value(g.Count, g.Aggregates)})
+		}
+	case constants.ReportFormatMatrix:
+		for _, row := range result.Matrix.RowTotals {
+			points = append(points, charts.Point{Label: chartLabel(row.Value), Value: value(row.Count, row.Values)})
+		}
+	default:
+		return nil, pkgErrors.NewValidationError("format", "only summary and matrix reports can be charted")
+	}
+
+	return renderChart(charts.Chart{
+		Kind:   opts.Kind,
+		Title:  result.Name,
+		Points: points,
+		Width:  opts.Width,
+		Height: opts.Height,
+	}, opts.Format)
+}
+
+func renderChart(c charts.Chart, format charts.Format) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := charts.Render(&buf, c, format); err != nil {
+		if errors.Is(err, charts.ErrNoData) {
+			return nil, pkgErrors.NewValidationError("data", err.Error())
+		}
+		return nil, fmt.Errorf("failed to render chart: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// widgetChartKind maps a dashboard widget type to the closest chart kind
+func widgetChartKind(widgetType string) charts.Kind {
+	switch widgetType {
+	case "chart-line":
+		return charts.KindLine
+	case "chart-pie":
+		return charts.KindPie
+	default:
+		return charts.KindBar
+	}
+}
+
+// chartLabel formats a group value as a category label
+func chartLabel(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "(none)"
+	case time.Time:
+		return val.Format(calendarDateLayout)
+	case string:
+		if val == "" {
+			return "(none)"
+		}
+		return val
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// chartValue converts a scanned aggregate to float64
+func chartValue(v interface{}) float64 {
+	if f, ok := reportNumber(v); ok {
+		return f
+	}
+	if s, ok := v.(string); ok {
+		var f float64
+		_, _ = fmt.Sscan(s, &f)
+		return f
+	}
+	return 0
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
 )
 
 // Scannable is an interface for something that can scan into a destination (sql.Row or sql.Rows)
@@ -83,6 +85,14 @@ func StringPtr(s string) *string {
 	return &s
 }
 
+// optionalString returns a pointer to a string, or nil for an empty string
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 // ContainsString checks if a slice contains a string (case-sensitive)
 func ContainsString(slice []string, item string) bool {
 	for _, s := range slice {
@@ -107,6 +117,22 @@ func ContainsStringIgnoreCase(slice []string, item string) bool {
 	return false
 }
 
+// GetNameFieldAPIName returns the API name of the object's name field,
+// falling back to a field literally called "name". Returns "" if neither exists.
+func GetNameFieldAPIName(schema *models.ObjectMetadata) string {
+	for _, f := range schema.Fields {
+		if f.IsNameField {
+			return f.APIName
+		}
+	}
+	for _, f := range schema.Fields {
+		if strings.EqualFold(f.APIName, constants.FieldName) {
+			return f.APIName
+		}
+	}
+	return ""
+}
+
 // SliceToNullJSON converts a []string to sql.NullString as JSON array
 func SliceToNullJSON(slice []string) sql.NullString {
 	if len(slice) == 0 {
@@ -0,0 +1,555 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// customEndpointDefaultRows is how many records a query endpoint returns without a row limit
+	customEndpointDefaultRows = 200
+	// customEndpointMaxRows caps the row limit of query endpoints
+	customEndpointMaxRows = 2000
+)
+
+var (
+	// customEndpointNamePattern is the form of the name in /api/custom/:name
+	customEndpointNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+	// customEndpointParamPattern is the form of input parameter names
+	customEndpointParamPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// customEndpointInputRef matches the $input.<param> references of an endpoint's templates
+	customEndpointInputRef = regexp.MustCompile(`\$input\.([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// customEndpointMethods are the HTTP methods a custom endpoint may answer
+var customEndpointMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// CustomEndpointService manages custom REST endpoints: admin-defined APIs at /api/custom/:name
+// that validate their input against a schema and run a script or a query or DML template
+// with the caller's access, so lightweight domain APIs need no server changes.
+type CustomEndpointService struct {
+	repo        *persistence.CustomEndpointRepository
+	metadata    *MetadataService
+	query       *QueryService
+	persistence *PersistenceService
+	scripts     *ScriptService
+}
+
+// NewCustomEndpointService creates a new CustomEndpointService
+func NewCustomEndpointService(repo *persistence.CustomEndpointRepository, metadata *MetadataService, query *QueryService, persistence *PersistenceService, scripts *ScriptService) *CustomEndpointService {
+	return &CustomEndpointService{
+		repo:        repo,
+		metadata:    metadata,
+		query:       query,
+		persistence: persistence,
+		scripts:     scripts,
+	}
+}
+
+// ==================== Configuration ====================
+
+// GetCustomEndpoints returns all custom endpoints
+func (s *CustomEndpointService) GetCustomEndpoints(ctx context.Context) ([]*models.CustomEndpoint, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetCustomEndpoint returns a custom endpoint by name
+func (s *CustomEndpointService) GetCustomEndpoint(ctx context.Context, name string) (*models.CustomEndpoint, error) {
+	e, err := s.repo.FindByName(ctx, strings.ToLower(name))
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, errors.NewNotFoundError("Custom endpoint", name)
+	}
+	return e, nil
+}
+
+// CreateCustomEndpoint validates and stores a custom endpoint
+func (s *CustomEndpointService) CreateCustomEndpoint(ctx context.Context, e *models.CustomEndpoint, currentUser *models.UserSession) error {
+	e.Name = strings.ToLower(strings.TrimSpace(e.Name))
+	if !customEndpointNamePattern.MatchString(e.Name) {
+		return errors.NewValidationError(constants.FieldSysCustomEndpoint_Name, "must be 1-63 lowercase letters, digits, hyphens or underscores, starting with a letter or digit")
+	}
+	e.IsActive = true
+	if err := s.validateCustomEndpoint(ctx, e); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByName(ctx, e.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("CustomEndpoint", constants.FieldSysCustomEndpoint_Name, e.Name)
+	}
+	if e.ID == "" {
+		e.ID = GenerateID()
+	}
+	if currentUser != nil {
+		e.OwnerID = currentUser.ID
+	}
+	return s.repo.Insert(ctx, e)
+}
+
+// UpdateCustomEndpoint replaces the settings of a custom endpoint. The name cannot change
+// because callers use it.
+func (s *CustomEndpointService) UpdateCustomEndpoint(ctx context.Context, name string, e *models.CustomEndpoint) (*models.CustomEndpoint, error) {
+	existing, err := s.GetCustomEndpoint(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	e.ID = existing.ID
+	e.Name = existing.Name
+	e.OwnerID = existing.OwnerID
+	e.CreatedDate = existing.CreatedDate
+	if err := s.validateCustomEndpoint(ctx, e); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// DeleteCustomEndpoint deletes a custom endpoint
+func (s *CustomEndpointService) DeleteCustomEndpoint(ctx context.Context, name string) error {
+	e, err := s.GetCustomEndpoint(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, e.ID)
+}
+
+// validateCustomEndpoint normalizes an endpoint and checks its input schema and the script or
+// template of its action. Settings the action does not use are cleared.
+func (s *CustomEndpointService) validateCustomEndpoint(ctx context.Context, e *models.CustomEndpoint) error {
+	e.HTTPMethod = strings.ToUpper(strings.TrimSpace(e.HTTPMethod))
+	if e.HTTPMethod == "" {
+		e.HTTPMethod = http.MethodPost
+	}
+	if !ContainsString(customEndpointMethods, e.HTTPMethod) {
+		return errors.NewValidationError(constants.FieldSysCustomEndpoint_HttpMethod, "must be one of "+strings.Join(customEndpointMethods, ", "))
+	}
+	if err := validateCustomEndpointSchema(e.InputSchema); err != nil {
+		return err
+	}
+
+	if e.Action == constants.CustomEndpointScript {
+		e.ObjectAPIName, e.FilterExpr, e.SortField, e.SortDirection, e.RowLimit, e.RecordID, e.FieldValues = "", "", "", "", 0, "", nil
+		if strings.TrimSpace(e.ScriptName) == "" {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_ScriptName, "is required for script endpoints")
+		}
+		sc, err := s.scripts.GetScript(ctx, e.ScriptName)
+		if err != nil {
+			return err
+		}
+		e.ScriptName = sc.Name
+		return nil
+	}
+
+	e.ScriptName = ""
+	e.ObjectAPIName = strings.ToLower(strings.TrimSpace(e.ObjectAPIName))
+	if constants.IsSystemTable(e.ObjectAPIName) {
+		return errors.NewValidationError(constants.FieldSysCustomEndpoint_ObjectAPIName, "system objects cannot be used by custom endpoints")
+	}
+	schema, err := s.metadata.GetSchemaOrError(ctx, e.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+
+	switch e.Action {
+	case constants.CustomEndpointQuery:
+		e.RecordID, e.FieldValues = "", nil
+		if e.FilterExpr != "" {
+			if _, _, err := formula.ToSQL(bindFilterRefs(e.FilterExpr, customEndpointInputRef, nil)); err != nil {
+				return errors.NewValidationError(constants.FieldSysCustomEndpoint_FilterExpr, fmt.Sprintf("invalid filter: %v", err))
+			}
+		}
+		if e.SortField != "" && FindField(schema, e.SortField) == nil {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_SortField, fmt.Sprintf("unknown field '%s' on %s", e.SortField, e.ObjectAPIName))
+		}
+		e.SortDirection = strings.ToUpper(e.SortDirection)
+		if e.SortDirection != "" && e.SortDirection != constants.SortASC && e.SortDirection != constants.SortDESC {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_SortDirection, "must be ASC or DESC")
+		}
+		if e.RowLimit < 0 || e.RowLimit > customEndpointMaxRows {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_RowLimit, fmt.Sprintf("must be between 0 (the default of %d) and %d", customEndpointDefaultRows, customEndpointMaxRows))
+		}
+	case constants.CustomEndpointInsert, constants.CustomEndpointUpdate, constants.CustomEndpointDelete:
+		e.FilterExpr, e.SortField, e.SortDirection, e.RowLimit = "", "", "", 0
+		if e.Action == constants.CustomEndpointInsert {
+			e.RecordID = ""
+		} else if strings.TrimSpace(e.RecordID) == "" {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_RecordID, fmt.Sprintf("is required for %s endpoints", e.Action))
+		}
+		if e.Action == constants.CustomEndpointDelete {
+			e.FieldValues = nil
+			break
+		}
+		if len(e.FieldValues) == 0 {
+			return errors.NewValidationError(constants.FieldSysCustomEndpoint_FieldValues, fmt.Sprintf("must set at least one field for %s endpoints", e.Action))
+		}
+		for field := range e.FieldValues {
+			if FindField(schema, field) == nil {
+				return errors.NewValidationError(constants.FieldSysCustomEndpoint_FieldValues, fmt.Sprintf("unknown field '%s' on %s", field, e.ObjectAPIName))
+			}
+		}
+	default:
+		return errors.NewValidationError(constants.FieldSysCustomEndpoint_Action,
+			fmt.Sprintf("unsupported action '%s'; expected script, query, insert, update or delete", e.Action))
+	}
+
+	// Every template reference must name a declared parameter
+	templates := []string{e.FilterExpr, e.RecordID}
+	for _, v := range e.FieldValues {
+		if text, ok := v.(string); ok {
+			templates = append(templates, text)
+		}
+	}
+	for _, template := range templates {
+		for _, match := range customEndpointInputRef.FindAllStringSubmatch(template, -1) {
+			if _, ok := e.InputSchema[match[1]]; !ok {
+				return errors.NewValidationError(constants.FieldSysCustomEndpoint_InputSchema, fmt.Sprintf("'%s' references undeclared parameter '%s'", template, match[1]))
+			}
+		}
+	}
+	return nil
+}
+
+// validateCustomEndpointSchema checks the parameter names and constraints of an input schema;
+// defaults and allowed values must themselves be valid input
+func validateCustomEndpointSchema(schema map[string]models.CustomEndpointParam) error {
+	for name, param := range schema {
+		field := constants.FieldSysCustomEndpoint_InputSchema + "." + name
+		if !customEndpointParamPattern.MatchString(name) {
+			return errors.NewValidationError(field, "parameter names must be letters, digits and underscores, not starting with a digit")
+		}
+		switch param.Type {
+		case constants.CustomEndpointParamString, constants.CustomEndpointParamNumber, constants.CustomEndpointParamInteger,
+			constants.CustomEndpointParamBoolean, constants.CustomEndpointParamObject, constants.CustomEndpointParamArray:
+		default:
+			return errors.NewValidationError(field, fmt.Sprintf("unsupported type '%s'; expected string, number, integer, boolean, object or array", param.Type))
+		}
+		if param.Pattern != "" {
+			if _, err := regexp.Compile(param.Pattern); err != nil {
+				return errors.NewValidationError(field, fmt.Sprintf("invalid pattern: %v", err))
+			}
+		}
+		if param.MaxLength < 0 {
+			return errors.NewValidationError(field, "max_length cannot be negative")
+		}
+		if param.Min != nil && param.Max != nil && *param.Min > *param.Max {
+			return errors.NewValidationError(field, "min cannot be greater than max")
+		}
+		for _, allowed := range param.Enum {
+			if _, err := checkCustomEndpointValue(param, allowed); err != nil {
+				return errors.NewValidationError(field, fmt.Sprintf("allowed value %v: %s", allowed, err))
+			}
+		}
+		if param.Default != nil {
+			if _, err := checkCustomEndpointParam(param, param.Default); err != nil {
+				return errors.NewValidationError(field, fmt.Sprintf("default: %s", err))
+			}
+		}
+	}
+	return nil
+}
+
+// ==================== Calls ====================
+
+// CustomEndpointCall is a request to /api/custom/:name
+type CustomEndpointCall struct {
+	Method string
+	Query  url.Values // Input of GET and DELETE endpoints
+	Body   []byte     // Input of other endpoints, a JSON object
+}
+
+// Call validates the input of a call to a custom endpoint and runs the endpoint as user. It
+// returns the script's result, the matching records, the created record, or the ID of the
+// updated or deleted record.
+func (s *CustomEndpointService) Call(ctx context.Context, name string, call CustomEndpointCall, user *models.UserSession) (interface{}, error) {
+	e, err := s.repo.FindByName(ctx, strings.ToLower(name))
+	if err != nil {
+		return nil, err
+	}
+	// Unknown, inactive and other-method endpoints look the same to callers
+	if e == nil || !e.IsActive || e.HTTPMethod != call.Method {
+		return nil, errors.NewNotFoundError("Custom endpoint", call.Method+" "+name)
+	}
+	if !user.IsSystemAdmin && len(e.ProfileIDs) > 0 && !ContainsString(e.ProfileIDs, user.ProfileID) {
+		return nil, errors.NewForbiddenError("your profile may not call this endpoint")
+	}
+
+	var raw map[string]interface{}
+	if call.Method == http.MethodGet || call.Method == http.MethodDelete {
+		raw, err = customEndpointQueryInput(e.InputSchema, call.Query)
+	} else {
+		raw, err = customEndpointBodyInput(call.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+	input, err := validateCustomEndpointInput(e.InputSchema, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Action {
+	case constants.CustomEndpointScript:
+		return s.scripts.RunEndpoint(ctx, e.ScriptName, input, user)
+	case constants.CustomEndpointQuery:
+		limit := e.RowLimit
+		if limit == 0 {
+			limit = customEndpointDefaultRows
+		}
+		return s.query.Query(ctx, models.QueryRequest{
+			ObjectAPIName: e.ObjectAPIName,
+			FilterExpr:    bindFilterRefs(e.FilterExpr, customEndpointInputRef, input),
+			SortField:     e.SortField,
+			SortDirection: e.SortDirection,
+			Limit:         limit,
+		}, user)
+	case constants.CustomEndpointInsert:
+		return s.persistence.Insert(ctx, e.ObjectAPIName, bindCustomEndpointValues(e.FieldValues, input), user)
+	}
+
+	var id string
+	if v := bindCustomEndpointValue(e.RecordID, input); v != nil {
+		id = fmt.Sprint(v)
+	}
+	if id == "" {
+		return nil, errors.NewValidationError(constants.FieldSysCustomEndpoint_RecordID, "the input does not name a record")
+	}
+	if e.Action == constants.CustomEndpointUpdate {
+		err = s.persistence.Update(ctx, e.ObjectAPIName, id, bindCustomEndpointValues(e.FieldValues, input), user)
+	} else {
+		err = s.persistence.Delete(ctx, e.ObjectAPIName, id, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return models.SObject{constants.FieldID: id}, nil
+}
+
+// customEndpointQueryInput reads the input of a GET or DELETE call from the query string,
+// parsing each value as its parameter's type
+func customEndpointQueryInput(schema map[string]models.CustomEndpointParam, query url.Values) (map[string]interface{}, error) {
+	input := make(map[string]interface{}, len(query))
+	for name := range query {
+		text := query.Get(name)
+		param, ok := schema[name]
+		if !ok {
+			// Reported as unknown by validateCustomEndpointInput
+			input[name] = text
+			continue
+		}
+		switch param.Type {
+		case constants.CustomEndpointParamNumber, constants.CustomEndpointParamInteger:
+			n, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, errors.NewValidationError(name, "must be a number")
+			}
+			input[name] = n
+		case constants.CustomEndpointParamBoolean:
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return nil, errors.NewValidationError(name, "must be true or false")
+			}
+			input[name] = b
+		case constants.CustomEndpointParamObject, constants.CustomEndpointParamArray:
+			var v interface{}
+			if err := json.Unmarshal([]byte(text), &v); err != nil {
+				return nil, errors.NewValidationError(name, "must be JSON")
+			}
+			input[name] = v
+		default:
+			input[name] = text
+		}
+	}
+	return input, nil
+}
+
+// customEndpointBodyInput reads the input of a call from its JSON body; an empty body is no input
+func customEndpointBodyInput(body []byte) (map[string]interface{}, error) {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(body, &input); err != nil || input == nil {
+		return nil, errors.NewValidationError("body", "must be a JSON object")
+	}
+	return input, nil
+}
+
+// validateCustomEndpointInput checks input against an endpoint's schema and returns it with
+// defaults applied and integers as int64. Parameters not in the schema are rejected.
+func validateCustomEndpointInput(schema map[string]models.CustomEndpointParam, input map[string]interface{}) (map[string]interface{}, error) {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := schema[name]; !ok {
+			return nil, errors.NewValidationError(name, "is not a parameter of this endpoint")
+		}
+	}
+
+	names = names[:0]
+	for name := range schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	valid := make(map[string]interface{}, len(schema))
+	for _, name := range names {
+		param := schema[name]
+		value := input[name]
+		if value == nil {
+			if param.Default == nil {
+				if param.Required {
+					return nil, errors.NewValidationError(name, "is required")
+				}
+				continue
+			}
+			value = param.Default
+		}
+		checked, err := checkCustomEndpointParam(param, value)
+		if err != nil {
+			return nil, errors.NewValidationError(name, err.Error())
+		}
+		valid[name] = checked
+	}
+	return valid, nil
+}
+
+// checkCustomEndpointParam checks a value against a parameter's type, constraints and
+// allowed values
+func checkCustomEndpointParam(param models.CustomEndpointParam, value interface{}) (interface{}, error) {
+	checked, err := checkCustomEndpointValue(param, value)
+	if err != nil || len(param.Enum) == 0 {
+		return checked, err
+	}
+	for _, allowed := range param.Enum {
+		if a, err := checkCustomEndpointValue(param, allowed); err == nil && reflect.DeepEqual(a, checked) {
+			return checked, nil
+		}
+	}
+	allowed := make([]string, len(param.Enum))
+	for i, v := range param.Enum {
+		allowed[i] = fmt.Sprint(v)
+	}
+	return nil, fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+}
+
+// checkCustomEndpointValue checks a value against a parameter's type and constraints
+func checkCustomEndpointValue(param models.CustomEndpointParam, value interface{}) (interface{}, error) {
+	switch param.Type {
+	case constants.CustomEndpointParamString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a string")
+		}
+		if param.MaxLength > 0 && utf8.RuneCountInString(s) > param.MaxLength {
+			return nil, fmt.Errorf("must be at most %d characters", param.MaxLength)
+		}
+		if param.Pattern != "" {
+			if matched, err := regexp.MatchString(`^(?:`+param.Pattern+`)$`, s); err != nil || !matched {
+				return nil, fmt.Errorf("must match %s", param.Pattern)
+			}
+		}
+		return s, nil
+	case constants.CustomEndpointParamNumber, constants.CustomEndpointParamInteger:
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case int:
+			n = float64(v)
+		case int64:
+			n = float64(v)
+		default:
+			return nil, fmt.Errorf("must be a number")
+		}
+		if param.Min != nil && n < *param.Min {
+			return nil, fmt.Errorf("must be at least %v", *param.Min)
+		}
+		if param.Max != nil && n > *param.Max {
+			return nil, fmt.Errorf("must be at most %v", *param.Max)
+		}
+		if param.Type == constants.CustomEndpointParamNumber {
+			return n, nil
+		}
+		if n != math.Trunc(n) || math.Abs(n) > 1<<53 {
+			return nil, fmt.Errorf("must be an integer")
+		}
+		return int64(n), nil
+	case constants.CustomEndpointParamBoolean:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+		return nil, fmt.Errorf("must be true or false")
+	case constants.CustomEndpointParamObject:
+		if m, ok := value.(map[string]interface{}); ok {
+			return m, nil
+		}
+		return nil, fmt.Errorf("must be an object")
+	case constants.CustomEndpointParamArray:
+		if a, ok := value.([]interface{}); ok {
+			return a, nil
+		}
+		return nil, fmt.Errorf("must be an array")
+	}
+	return nil, fmt.Errorf("has unsupported type '%s'", param.Type)
+}
+
+// bindCustomEndpointValues resolves the $input.<param> references of an endpoint's field values
+func bindCustomEndpointValues(values map[string]interface{}, input map[string]interface{}) models.SObject {
+	record := make(models.SObject, len(values))
+	for field, v := range values {
+		record[field] = bindCustomEndpointValue(v, input)
+	}
+	return record
+}
+
+// bindCustomEndpointValue resolves the $input.<param> references of a template value. A value
+// that is a single reference takes the parameter's value as is; references inside longer
+// text are replaced by the value's text. Other values are returned unchanged.
+func bindCustomEndpointValue(v interface{}, input map[string]interface{}) interface{} {
+	text, ok := v.(string)
+	if !ok {
+		return v
+	}
+	if m := customEndpointInputRef.FindStringSubmatch(text); m != nil && m[0] == text {
+		return input[m[1]]
+	}
+	return customEndpointInputRef.ReplaceAllStringFunc(text, func(ref string) string {
+		switch value := input[customEndpointInputRef.FindStringSubmatch(ref)[1]].(type) {
+		case nil:
+			return ""
+		case string:
+			return value
+		case map[string]interface{}, []interface{}:
+			data, _ := json.Marshal(value)
+			return string(data)
+		default:
+			return fmt.Sprint(value)
+		}
+	})
+}
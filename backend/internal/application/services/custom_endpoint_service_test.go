@@ -0,0 +1,68 @@
+package services
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomEndpointInput(t *testing.T) {
+	minAmount := 0.0
+	schema := map[string]models.CustomEndpointParam{
+		"email":  {Type: constants.CustomEndpointParamString, Required: true, Pattern: `[^@]+@[^@]+`, MaxLength: 50},
+		"amount": {Type: constants.CustomEndpointParamNumber, Min: &minAmount},
+		"count":  {Type: constants.CustomEndpointParamInteger, Default: 10.0},
+		"stage":  {Type: constants.CustomEndpointParamString, Enum: []interface{}{"Open", "Won"}},
+	}
+	require.NoError(t, validateCustomEndpointSchema(schema))
+
+	input, err := validateCustomEndpointInput(schema, map[string]interface{}{"email": "a@b.co", "stage": "Won"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"email": "a@b.co", "stage": "Won", "count": int64(10)}, input)
+
+	for _, bad := range []map[string]interface{}{
+		{},
+		{"email": "not-an-email"},
+		{"email": "a@b.co", "amount": -1.0},
+		{"email": "a@b.co", "count": 1.5},
+		{"email": "a@b.co", "stage": "Lost"},
+		{"email": "a@b.co", "extra": true},
+	} {
+		_, err := validateCustomEndpointInput(schema, bad)
+		assert.Error(t, err, "%v", bad)
+	}
+
+	assert.Error(t, validateCustomEndpointSchema(map[string]models.CustomEndpointParam{"1st": {Type: constants.CustomEndpointParamString}}))
+	assert.Error(t, validateCustomEndpointSchema(map[string]models.CustomEndpointParam{"x": {Type: "date"}}))
+	assert.Error(t, validateCustomEndpointSchema(map[string]models.CustomEndpointParam{"x": {Type: constants.CustomEndpointParamBoolean, Default: "yes"}}))
+}
+
+func TestCustomEndpointQueryInput(t *testing.T) {
+	schema := map[string]models.CustomEndpointParam{
+		"limit":  {Type: constants.CustomEndpointParamInteger},
+		"active": {Type: constants.CustomEndpointParamBoolean},
+		"name":   {Type: constants.CustomEndpointParamString},
+	}
+	input, err := customEndpointQueryInput(schema, url.Values{"limit": {"5"}, "active": {"true"}, "name": {"Acme"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"limit": 5.0, "active": true, "name": "Acme"}, input)
+
+	_, err = customEndpointQueryInput(schema, url.Values{"limit": {"five"}})
+	assert.Error(t, err)
+}
+
+func TestBindCustomEndpointTemplates(t *testing.T) {
+	input := map[string]interface{}{"name": `Acme "Co"`, "min": int64(100), "tags": []interface{}{"a"}}
+
+	assert.Equal(t, `name == "Acme \"Co\"" && amount >= 100 && owner == nil`,
+		bindFilterRefs(`name == $input.name && amount >= $input.min && owner == $input.owner`, customEndpointInputRef, input))
+
+	assert.Equal(t, int64(100), bindCustomEndpointValue("$input.min", input))
+	assert.Equal(t, `Order for Acme "Co" (min 100, ["a"])`, bindCustomEndpointValue(`Order for $input.name (min $input.min, $input.tags)`, input))
+	assert.Equal(t, 42.0, bindCustomEndpointValue(42.0, input))
+	assert.Nil(t, bindCustomEndpointValue("$input.missing", input))
+}
@@ -0,0 +1,352 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// CustomSettingService manages hierarchical custom settings. A setting resolves to the
+// user's override, else the user's profile override, else the org default.
+type CustomSettingService struct {
+	repo  *persistence.CustomSettingRepository
+	mu    sync.Mutex   // Serializes writes and cache reloads
+	cache atomic.Value // *customSettingCache, read lock-free by formulas
+}
+
+// customSettingCache holds every setting value by hierarchy level
+type customSettingCache struct {
+	defaults map[string]interface{}            // setting name → org default
+	profiles map[string]map[string]interface{} // profile ID → setting name → value
+	users    map[string]map[string]interface{} // user ID → setting name → value
+}
+
+// NewCustomSettingService creates a new CustomSettingService
+func NewCustomSettingService(repo *persistence.CustomSettingRepository) *CustomSettingService {
+	return &CustomSettingService{repo: repo}
+}
+
+// RefreshCache reloads all settings and overrides from the database
+func (s *CustomSettingService) RefreshCache(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reloadLocked(ctx)
+}
+
+func (s *CustomSettingService) reloadLocked(ctx context.Context) error {
+	settings, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	values, err := s.repo.GetValues(ctx, "")
+	if err != nil {
+		return err
+	}
+	s.cache.Store(buildCustomSettingCache(settings, values))
+	return nil
+}
+
+// GetSettings returns all custom settings
+func (s *CustomSettingService) GetSettings(ctx context.Context) ([]*models.CustomSetting, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetSetting returns a custom setting by name
+func (s *CustomSettingService) GetSetting(ctx context.Context, name string) (*models.CustomSetting, error) {
+	setting, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, errors.NewNotFoundError("Custom setting", name)
+	}
+	return setting, nil
+}
+
+// CreateSetting creates a custom setting with its org default
+func (s *CustomSettingService) CreateSetting(ctx context.Context, setting *models.CustomSetting) error {
+	setting.Name = strings.TrimSpace(setting.Name)
+	if !developerNamePattern.MatchString(setting.Name) {
+		return errors.NewValidationError(constants.FieldSysCustomSetting_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(setting.Label) == "" {
+		setting.Label = setting.Name
+	}
+	if setting.Type == "" {
+		setting.Type = constants.FieldTypeText
+	}
+	if !containsFieldType(customMetadataFieldTypes, setting.Type) {
+		return errors.NewValidationError(constants.FieldSysCustomSetting_Type, fmt.Sprintf("unsupported type '%s'", setting.Type))
+	}
+	defaultValue, err := convertCustomSettingValue(setting.Type, setting.DefaultValue)
+	if err != nil {
+		return errors.NewValidationError(constants.FieldSysCustomSetting_DefaultValue, err.Error())
+	}
+	setting.DefaultValue = defaultValue
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.repo.FindByName(ctx, setting.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("CustomSetting", constants.FieldSysCustomSetting_Name, setting.Name)
+	}
+	if setting.ID == "" {
+		setting.ID = GenerateID()
+	}
+	if err := s.repo.Insert(ctx, setting); err != nil {
+		return err
+	}
+	return s.reloadLocked(ctx)
+}
+
+// UpdateSetting updates the label, description and org default of a custom setting. The type
+// cannot change because overrides were validated against it; a nil default leaves it unchanged.
+func (s *CustomSettingService) UpdateSetting(ctx context.Context, name string, updates *models.CustomSetting) (*models.CustomSetting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setting, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, errors.NewNotFoundError("Custom setting", name)
+	}
+	if updates.Type != "" && updates.Type != setting.Type {
+		return nil, errors.NewValidationError(constants.FieldSysCustomSetting_Type, "the type of a custom setting cannot be changed")
+	}
+
+	if strings.TrimSpace(updates.Label) != "" {
+		setting.Label = updates.Label
+	}
+	if updates.Description != nil {
+		setting.Description = updates.Description
+	}
+	if updates.DefaultValue != nil {
+		v, err := convertCustomSettingValue(setting.Type, updates.DefaultValue)
+		if err != nil {
+			return nil, errors.NewValidationError(constants.FieldSysCustomSetting_DefaultValue, err.Error())
+		}
+		setting.DefaultValue = v
+	}
+
+	if err := s.repo.Update(ctx, setting); err != nil {
+		return nil, err
+	}
+	return setting, s.reloadLocked(ctx)
+}
+
+// DeleteSetting deletes a custom setting and all of its overrides
+func (s *CustomSettingService) DeleteSetting(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setting, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if setting == nil {
+		return errors.NewNotFoundError("Custom setting", name)
+	}
+	if err := s.repo.Delete(ctx, setting); err != nil {
+		return err
+	}
+	return s.reloadLocked(ctx)
+}
+
+// GetOverrides returns the profile and user overrides of a custom setting
+func (s *CustomSettingService) GetOverrides(ctx context.Context, name string) ([]*models.CustomSettingValue, error) {
+	setting, err := s.GetSetting(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetValues(ctx, setting.Name)
+}
+
+// SetOverride sets the value of a custom setting for a profile or user
+func (s *CustomSettingService) SetOverride(ctx context.Context, name string, scope constants.CustomSettingScope, scopeID string, value interface{}) (*models.CustomSettingValue, error) {
+	if scope != constants.CustomSettingScopeProfile && scope != constants.CustomSettingScopeUser {
+		return nil, errors.NewValidationError(constants.FieldSysCustomSettingValue_ScopeType, "must be 'profile' or 'user'")
+	}
+	if strings.TrimSpace(scopeID) == "" {
+		return nil, errors.NewValidationError(constants.FieldSysCustomSettingValue_ScopeID, "is required")
+	}
+	if value == nil {
+		return nil, errors.NewValidationError(constants.FieldSysCustomSettingValue_Value, "is required; delete the override to fall back to the next level")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setting, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, errors.NewNotFoundError("Custom setting", name)
+	}
+	converted, err := convertCustomSettingValue(setting.Type, value)
+	if err != nil {
+		return nil, errors.NewValidationError(constants.FieldSysCustomSettingValue_Value, err.Error())
+	}
+
+	override := &models.CustomSettingValue{
+		ID:          GenerateID(),
+		SettingName: setting.Name,
+		ScopeType:   scope,
+		ScopeID:     scopeID,
+		Value:       converted,
+	}
+	if err := s.repo.UpsertValue(ctx, override); err != nil {
+		return nil, err
+	}
+	return override, s.reloadLocked(ctx)
+}
+
+// DeleteOverride removes the value of a custom setting for a profile or user
+func (s *CustomSettingService) DeleteOverride(ctx context.Context, name string, scope constants.CustomSettingScope, scopeID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setting, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if setting == nil {
+		return errors.NewNotFoundError("Custom setting", name)
+	}
+	deleted, err := s.repo.DeleteValue(ctx, setting.Name, scope, scopeID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.NewNotFoundError("Custom setting override", fmt.Sprintf("%s/%s/%s", setting.Name, scope, scopeID))
+	}
+	return s.reloadLocked(ctx)
+}
+
+// Resolve returns every custom setting resolved for a user and profile. Either ID may be empty.
+func (s *CustomSettingService) Resolve(userID, profileID string) map[string]interface{} {
+	cache, _ := s.cache.Load().(*customSettingCache)
+	if cache == nil {
+		return map[string]interface{}{}
+	}
+	return cache.resolve(userID, profileID)
+}
+
+// ResolveForUser returns every custom setting resolved for a session user
+func (s *CustomSettingService) ResolveForUser(user *models.UserSession) map[string]interface{} {
+	if user == nil {
+		return s.Resolve("", "")
+	}
+	return s.Resolve(user.ID, user.ProfileID)
+}
+
+// ResolveForFormula resolves custom settings for the user map of a formula context
+func (s *CustomSettingService) ResolveForFormula(user map[string]interface{}) map[string]interface{} {
+	userID, _ := user[constants.FieldID].(string)
+	profileID, _ := user[constants.FieldProfileID].(string)
+	return s.Resolve(userID, profileID)
+}
+
+// ImportLegacyConfig copies non-secret _System_Config entries into org-default Text settings,
+// skipping server settings (see config.Settings) and keys that already exist as settings or
+// are not valid setting names
+func (s *CustomSettingService) ImportLegacyConfig(ctx context.Context, configs []*models.SystemConfig) (int, error) {
+	imported := 0
+	for _, c := range configs {
+		if c.IsSecret {
+			log.Printf("   ⏭️  Skipping secret config %s (not exposed as a custom setting)", c.KeyName)
+			continue
+		}
+		if config.IsSetting(c.KeyName) {
+			continue
+		}
+		if !developerNamePattern.MatchString(c.KeyName) {
+			log.Printf("   ⏭️  Skipping config %s (not a valid setting name)", c.KeyName)
+			continue
+		}
+		existing, err := s.repo.FindByName(ctx, c.KeyName)
+		if err != nil {
+			return imported, err
+		}
+		if existing != nil {
+			continue
+		}
+
+		setting := &models.CustomSetting{Name: c.KeyName, Type: constants.FieldTypeText, DefaultValue: c.Value}
+		if c.Description != "" {
+			description := c.Description
+			setting.Description = &description
+		}
+		if err := s.CreateSetting(ctx, setting); err != nil {
+			return imported, fmt.Errorf("failed to import config %s: %w", c.KeyName, err)
+		}
+		imported++
+	}
+	return imported, nil
+}
+
+// buildCustomSettingCache arranges org defaults and overrides by hierarchy level
+func buildCustomSettingCache(settings []*models.CustomSetting, values []*models.CustomSettingValue) *customSettingCache {
+	cache := &customSettingCache{
+		defaults: make(map[string]interface{}, len(settings)),
+		profiles: make(map[string]map[string]interface{}),
+		users:    make(map[string]map[string]interface{}),
+	}
+	names := make(map[string]string, len(settings)) // lowercase → canonical name
+	for _, setting := range settings {
+		cache.defaults[setting.Name] = setting.DefaultValue
+		names[strings.ToLower(setting.Name)] = setting.Name
+	}
+	for _, v := range values {
+		name, ok := names[strings.ToLower(v.SettingName)]
+		if !ok {
+			continue
+		}
+		level := cache.users
+		if v.ScopeType == constants.CustomSettingScopeProfile {
+			level = cache.profiles
+		}
+		if level[v.ScopeID] == nil {
+			level[v.ScopeID] = make(map[string]interface{})
+		}
+		level[v.ScopeID][name] = v.Value
+	}
+	return cache
+}
+
+func (c *customSettingCache) resolve(userID, profileID string) map[string]interface{} {
+	resolved := make(map[string]interface{}, len(c.defaults))
+	for name, v := range c.defaults {
+		resolved[name] = v
+	}
+	for name, v := range c.profiles[profileID] {
+		resolved[name] = v
+	}
+	for name, v := range c.users[userID] {
+		resolved[name] = v
+	}
+	return resolved
+}
+
+// convertCustomSettingValue converts a value to the setting type; nil stays nil
+func convertCustomSettingValue(settingType models.FieldType, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return convertCustomMetadataValue(settingType, v)
+}
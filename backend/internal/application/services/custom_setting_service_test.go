@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomSettingResolution(t *testing.T) {
+	settings := []*models.CustomSetting{
+		{Name: "DiscountLimit", Type: constants.FieldTypePercent, DefaultValue: 10.0},
+		{Name: "Region", Type: constants.FieldTypeText, DefaultValue: "Global"},
+	}
+	values := []*models.CustomSettingValue{
+		{SettingName: "discountlimit", ScopeType: constants.CustomSettingScopeProfile, ScopeID: "sales", Value: 20.0},
+		{SettingName: "DiscountLimit", ScopeType: constants.CustomSettingScopeUser, ScopeID: "u1", Value: 35.0},
+		{SettingName: "Deleted", ScopeType: constants.CustomSettingScopeUser, ScopeID: "u1", Value: 1.0},
+	}
+	svc := &CustomSettingService{}
+	svc.cache.Store(buildCustomSettingCache(settings, values))
+
+	assert.Equal(t, map[string]interface{}{"DiscountLimit": 10.0, "Region": "Global"}, svc.Resolve("", ""), "org default")
+	assert.Equal(t, 20.0, svc.Resolve("u2", "sales")["DiscountLimit"], "profile overrides org default")
+	assert.Equal(t, 35.0, svc.Resolve("u1", "sales")["DiscountLimit"], "user overrides profile")
+	assert.Equal(t, "Global", svc.Resolve("u1", "sales")["Region"], "unset levels fall through")
+	assert.NotContains(t, svc.Resolve("u1", ""), "Deleted", "overrides of unknown settings are ignored")
+
+	user := map[string]interface{}{constants.FieldID: "u2", constants.FieldProfileID: "sales"}
+	assert.Equal(t, 20.0, svc.ResolveForFormula(user)["DiscountLimit"])
+	assert.Equal(t, 10.0, svc.ResolveForUser(nil)["DiscountLimit"])
+}
+
+func TestCustomSettingResolve_EmptyCache(t *testing.T) {
+	svc := &CustomSettingService{}
+	assert.Empty(t, svc.Resolve("u1", "p1"))
+}
+
+func TestCustomSettingValidation(t *testing.T) {
+	svc := &CustomSettingService{}
+	ctx := context.Background()
+
+	err := svc.CreateSetting(ctx, &models.CustomSetting{Name: "1bad"})
+	assert.True(t, errors.IsValidation(err), "bad name")
+	err = svc.CreateSetting(ctx, &models.CustomSetting{Name: "Owner", Type: constants.FieldTypeLookup})
+	assert.True(t, errors.IsValidation(err), "unsupported type")
+	err = svc.CreateSetting(ctx, &models.CustomSetting{Name: "Limit", Type: constants.FieldTypeNumber, DefaultValue: "lots"})
+	assert.True(t, errors.IsValidation(err), "default must match type")
+
+	_, err = svc.SetOverride(ctx, "Limit", "team", "t1", 1.0)
+	assert.True(t, errors.IsValidation(err), "unknown scope")
+	_, err = svc.SetOverride(ctx, "Limit", constants.CustomSettingScopeUser, "", 1.0)
+	assert.True(t, errors.IsValidation(err), "scope ID required")
+	_, err = svc.SetOverride(ctx, "Limit", constants.CustomSettingScopeUser, "u1", nil)
+	assert.True(t, errors.IsValidation(err), "value required")
+}
@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	pkgErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// maxConcurrentWidgetQueries bounds the widget queries of one run executing at once
+	maxConcurrentWidgetQueries = 8
+	// widgetScopeMine restricts a widget to records owned by the running user (config.scope)
+	widgetScopeMine = "mine"
+)
+
+// DashboardRunner executes the analytics queries of all dashboard widgets server-side.
+// Widgets run as the viewer (or the dashboard's running user), so object and field
+// permissions apply exactly as for a direct analytics call. Results are cached per viewer,
+// dashboard version and filter values for the configured TTL.
+type DashboardRunner struct {
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+
+	mu    sync.Mutex
+	ttl   time.Duration
+	cache map[string]dashboardCacheEntry
+}
+
+type dashboardCacheEntry struct {
+	result    models.DashboardRunResult
+	expiresAt time.Time
+}
+
+// NewDashboardRunner creates a new DashboardRunner. A ttl of zero disables caching.
+func NewDashboardRunner(metadata *MetadataService, query *QueryService, permissions *PermissionService, ttl time.Duration) *DashboardRunner {
+	return &DashboardRunner{
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		ttl:         ttl,
+		cache:       make(map[string]dashboardCacheEntry),
+	}
+}
+
+// SetCacheTTL changes how long results are cached; zero disables caching. Cached results
+// are dropped, since they expire on the previous TTL.
+func (dr *DashboardRunner) SetCacheTTL(ttl time.Duration) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if ttl != dr.ttl {
+		dr.ttl = ttl
+		dr.cache = make(map[string]dashboardCacheEntry)
+	}
+}
+
+// Run executes every query-backed widget of a dashboard in parallel and returns all results.
+// Widgets without a query (text, image, ...) are not included. A failing widget reports its
+// error in its own result and never fails the whole run.
+//
+// Widgets run with the viewer's permissions, or with the running user's permissions when the
+// dashboard is set to run as a specified user; the viewer must still be able to read each
+// widget's object. Dashboard filters are pushed into every widget whose object has the filter field.
+func (dr *DashboardRunner) Run(ctx context.Context, dashboardID string, req models.DashboardRunRequest, currentUser *models.UserSession) (*models.DashboardRunResult, error) {
+	dashboard := dr.metadata.GetDashboard(ctx, dashboardID)
+	if dashboard == nil {
+		return nil, pkgErrors.NewNotFoundError("Dashboard", dashboardID)
+	}
+
+	runningUser, err := dr.runningUser(ctx, dashboard, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := resolveDashboardFilters(dashboard.Filters, req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	key := dashboardCacheKey(dashboard, currentUser, runningUser, filters)
+	if !req.Refresh {
+		if cached, ok := dr.cached(key); ok {
+			return cached, nil
+		}
+	}
+
+	widgets := make([]models.WidgetConfig, 0, len(dashboard.Widgets))
+	for _, widget := range dashboard.Widgets {
+		if widget.Query.ObjectAPIName != "" {
+			widgets = append(widgets, widget)
+		}
+	}
+
+	result := &models.DashboardRunResult{
+		DashboardID: dashboard.ID,
+		Widgets:     make([]models.WidgetResult, len(widgets)),
+		RunAt:       time.Now(),
+	}
+	if runningUser != nil {
+		result.RunningUserID = runningUser.ID
+	}
+
+	run := &dashboardRun{viewer: currentUser, runningUser: runningUser, filters: filters}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentWidgetQueries)
+	for i, widget := range widgets {
+		wg.Add(1)
+		go func(i int, widget models.WidgetConfig) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			result.Widgets[i] = dr.runWidget(ctx, widget, run)
+		}(i, widget)
+	}
+	wg.Wait()
+
+	dr.store(key, result)
+	return result, nil
+}
+
+// RunWidget executes a single query-backed widget of a dashboard with the dashboard's default
+// filter values, returning the widget and its data. Unlike Run, errors are returned directly
+// and results are not cached.
+func (dr *DashboardRunner) RunWidget(ctx context.Context, dashboardID, widgetID string, currentUser *models.UserSession) (*models.WidgetConfig, interface{}, error) {
+	dashboard := dr.metadata.GetDashboard(ctx, dashboardID)
+	if dashboard == nil {
+		return nil, nil, pkgErrors.NewNotFoundError("Dashboard", dashboardID)
+	}
+
+	var widget *models.WidgetConfig
+	for i := range dashboard.Widgets {
+		if dashboard.Widgets[i].ID == widgetID {
+			widget = &dashboard.Widgets[i]
+			break
+		}
+	}
+	if widget == nil {
+		return nil, nil, pkgErrors.NewNotFoundError("Widget", widgetID)
+	}
+	if widget.Query.ObjectAPIName == "" {
+		return nil, nil, pkgErrors.NewValidationError("widget", fmt.Sprintf("widget '%s' has no query", widgetID))
+	}
+
+	runningUser, err := dr.runningUser(ctx, dashboard, currentUser)
+	if err != nil {
+		return nil, nil, err
+	}
+	filters, err := resolveDashboardFilters(dashboard.Filters, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := &dashboardRun{viewer: currentUser, runningUser: runningUser, filters: filters}
+	q, err := dr.widgetQuery(ctx, *widget, run)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := dr.query.RunAnalytics(ctx, q, runningUser)
+	if err != nil {
+		return nil, nil, err
+	}
+	return widget, data, nil
+}
+
+// dashboardRun carries the per-run state shared by all widgets
+type dashboardRun struct {
+	viewer      *models.UserSession
+	runningUser *models.UserSession
+	filters     []activeDashboardFilter
+}
+
+// activeDashboardFilter is a dashboard filter with its selected value
+type activeDashboardFilter struct {
+	filter models.DashboardFilter
+	value  models.DashboardFilterValue
+}
+
+// runningUser returns the user whose permissions the dashboard's widgets run with
+func (dr *DashboardRunner) runningUser(ctx context.Context, dashboard *models.DashboardConfig, viewer *models.UserSession) (*models.UserSession, error) {
+	if dashboard.RunAs != constants.DashboardRunAsUser || dashboard.RunAsUserID == nil || *dashboard.RunAsUserID == "" {
+		return viewer, nil
+	}
+	if viewer != nil && viewer.ID == *dashboard.RunAsUserID {
+		return viewer, nil
+	}
+	return dr.permissions.SessionForUser(ctx, *dashboard.RunAsUserID)
+}
+
+// runWidget executes a single widget query, converting errors and panics into the widget result
+func (dr *DashboardRunner) runWidget(ctx context.Context, widget models.WidgetConfig, run *dashboardRun) (res models.WidgetResult) {
+	start := time.Now()
+	res.WidgetID = widget.ID
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("⚠️ [Dashboard] Widget %s panicked: %v", widget.ID, r)
+			res.Data = nil
+			res.Error = "widget query failed"
+		}
+		res.DurationMs = time.Since(start).Milliseconds()
+	}()
+
+	q, err := dr.widgetQuery(ctx, widget, run)
+	if err == nil {
+		res.Data, err = dr.query.RunAnalytics(ctx, q, run.runningUser)
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// widgetQuery validates a widget's analytics query against the viewer's and running user's
+// permissions, and applies the widget scope and dashboard filters
+func (dr *DashboardRunner) widgetQuery(ctx context.Context, widget models.WidgetConfig, run *dashboardRun) (models.AnalyticsQuery, error) {
+	q := widget.Query
+
+	switch q.Operation {
+	case persistence.OpCount:
+	case persistence.OpGroupBy:
+		if q.GroupBy == nil || *q.GroupBy == "" {
+			return q, pkgErrors.NewValidationError("group_by", "is required for group_by widgets")
+		}
+	case persistence.OpSum, persistence.OpAvg:
+		if q.Field == nil || *q.Field == "" {
+			return q, pkgErrors.NewValidationError("field", fmt.Sprintf("is required for %s widgets", q.Operation))
+		}
+	default:
+		return q, pkgErrors.NewValidationError("operation", fmt.Sprintf("unsupported operation '%s'", q.Operation))
+	}
+
+	if run.viewer != run.runningUser && !dr.permissions.CheckObjectPermissionWithUser(ctx, q.ObjectAPIName, constants.PermRead, run.viewer) {
+		return q, pkgErrors.NewPermissionError(constants.PermRead, q.ObjectAPIName)
+	}
+	for _, field := range []*string{q.Field, q.GroupBy} {
+		if field != nil && *field != "" && !dr.permissions.CheckFieldVisibilityWithUser(ctx, q.ObjectAPIName, *field, run.runningUser) {
+			return q, pkgErrors.NewPermissionError(constants.PermRead, q.ObjectAPIName+"."+*field)
+		}
+	}
+
+	if scope, _ := widget.Config["scope"].(string); scope == widgetScopeMine && run.runningUser != nil {
+		q.FilterExpr = combineFilters(q.FilterExpr, fmt.Sprintf("%s == '%s'", constants.FieldOwnerID, run.runningUser.ID))
+	}
+
+	if len(run.filters) > 0 {
+		schema := dr.metadata.GetSchema(ctx, q.ObjectAPIName)
+		if schema == nil {
+			return q, pkgErrors.NewNotFoundError("Object", q.ObjectAPIName)
+		}
+		for _, active := range run.filters {
+			field := FindField(schema, active.filter.Field)
+			if field == nil {
+				continue // Filter does not apply to this widget's object
+			}
+			if !dr.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, run.runningUser) {
+				return q, pkgErrors.NewPermissionError(constants.PermRead, schema.APIName+"."+field.APIName)
+			}
+			expr, err := dashboardFilterExpr(field, active.filter, active.value)
+			if err != nil {
+				return q, err
+			}
+			q.FilterExpr = combineFilters(q.FilterExpr, expr)
+		}
+	}
+	return q, nil
+}
+
+// resolveDashboardFilters pairs each dashboard filter with its requested or default value,
+// dropping filters without a value
+func resolveDashboardFilters(filters []models.DashboardFilter, values map[string]models.DashboardFilterValue) ([]activeDashboardFilter, error) {
+	known := make(map[string]bool, len(filters))
+	active := make([]activeDashboardFilter, 0, len(filters))
+	for _, filter := range filters {
+		known[filter.ID] = true
+		value, ok := values[filter.ID]
+		if !ok {
+			if filter.Default == nil {
+				continue
+			}
+			value = *filter.Default
+		}
+
+		switch filter.Type {
+		case constants.DashboardFilterDateRange:
+			if value.Start == "" && value.End == "" {
+				continue
+			}
+			for _, d := range []string{value.Start, value.End} {
+				if _, err := time.Parse(calendarDateLayout, d); d != "" && err != nil {
+					return nil, pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: dates must be in YYYY-MM-DD format", filter.ID))
+				}
+			}
+		case constants.DashboardFilterPicklist:
+			if len(value.Values) == 0 {
+				continue
+			}
+			for _, v := range value.Values {
+				if len(filter.Options) > 0 && !ContainsString(filter.Options, v) {
+					return nil, pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: '%s' is not an available option", filter.ID, v))
+				}
+			}
+		}
+		active = append(active, activeDashboardFilter{filter: filter, value: value})
+	}
+
+	for id := range values {
+		if !known[id] {
+			return nil, pkgErrors.NewValidationError("filters", fmt.Sprintf("unknown filter '%s'", id))
+		}
+	}
+	return active, nil
+}
+
+// dashboardFilterExpr builds the formula filter applying a dashboard filter to a field.
+// Values are checked against the field definition before being embedded as literals.
+func dashboardFilterExpr(field *models.FieldMetadata, filter models.DashboardFilter, value models.DashboardFilterValue) (string, error) {
+	switch filter.Type {
+	case constants.DashboardFilterDateRange:
+		if field.Type != constants.FieldTypeDate && field.Type != constants.FieldTypeDateTime {
+			return "", pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: '%s' is not a date field", filter.ID, field.APIName))
+		}
+		parts := make([]string, 0, 2)
+		if value.Start != "" {
+			parts = append(parts, fmt.Sprintf("%s >= '%s'", field.APIName, value.Start))
+		}
+		if value.End != "" {
+			end, _ := time.Parse(calendarDateLayout, value.End)
+			parts = append(parts, fmt.Sprintf("%s < '%s'", field.APIName, end.AddDate(0, 0, 1).Format(calendarDateLayout)))
+		}
+		return strings.Join(parts, " && "), nil
+
+	case constants.DashboardFilterPicklist:
+		if field.Type != constants.FieldTypePicklist {
+			return "", pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: '%s' is not a picklist field", filter.ID, field.APIName))
+		}
+		parts := make([]string, 0, len(value.Values))
+		for _, v := range value.Values {
+			if !ContainsString(field.Options, v) || strings.ContainsAny(v, `'\`) {
+				return "", pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: '%s' is not a valid %s value", filter.ID, v, field.APIName))
+			}
+			parts = append(parts, fmt.Sprintf("%s == '%s'", field.APIName, v))
+		}
+		return "(" + strings.Join(parts, " || ") + ")", nil
+	}
+	return "", pkgErrors.NewValidationError("filters", fmt.Sprintf("%s: unsupported filter type '%s'", filter.ID, filter.Type))
+}
+
+func (dr *DashboardRunner) cached(key string) (*models.DashboardRunResult, bool) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if dr.ttl <= 0 {
+		return nil, false
+	}
+
+	entry, ok := dr.cache[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	result := entry.result
+	result.Cached = true
+	return &result, true
+}
+
+func (dr *DashboardRunner) store(key string, result *models.DashboardRunResult) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if dr.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+	for k, entry := range dr.cache {
+		if now.After(entry.expiresAt) {
+			delete(dr.cache, k)
+		}
+	}
+	dr.cache[key] = dashboardCacheEntry{result: *result, expiresAt: now.Add(dr.ttl)}
+}
+
+// dashboardCacheKey identifies a run by viewer, running user, dashboard definition and
+// filter values, so editing a dashboard never serves results computed for its previous widgets
+func dashboardCacheKey(dashboard *models.DashboardConfig, viewer, runningUser *models.UserSession, filters []activeDashboardFilter) string {
+	userID := func(u *models.UserSession) string {
+		if u == nil {
+			return ""
+		}
+		return u.ID
+	}
+	filterValues := make(map[string]models.DashboardFilterValue, len(filters))
+	for _, active := range filters {
+		filterValues[active.filter.ID] = active.value
+	}
+	definition, _ := json.Marshal(struct {
+		Widgets []models.WidgetConfig                  `json:"widgets"`
+		Filters []models.DashboardFilter               `json:"filters"`
+		Values  map[string]models.DashboardFilterValue `json:"values"`
+	}{dashboard.Widgets, dashboard.Filters, filterValues})
+	sum := sha256.Sum256(definition)
+	return dashboard.ID + "|" + userID(viewer) + "|" + userID(runningUser) + "|" + hex.EncodeToString(sum[:8])
+}
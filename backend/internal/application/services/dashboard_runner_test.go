@@ -0,0 +1,127 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardRunnerCache(t *testing.T) {
+	dr := NewDashboardRunner(nil, nil, nil, time.Minute)
+	result := &models.DashboardRunResult{DashboardID: "d1", RunAt: time.Now()}
+
+	_, ok := dr.cached("k")
+	assert.False(t, ok)
+
+	dr.store("k", result)
+	cached, ok := dr.cached("k")
+	assert.True(t, ok)
+	assert.True(t, cached.Cached)
+	assert.False(t, result.Cached, "stored result must not be mutated")
+
+	dr.cache["k"] = dashboardCacheEntry{result: *result, expiresAt: time.Now().Add(-time.Second)}
+	_, ok = dr.cached("k")
+	assert.False(t, ok)
+
+	disabled := NewDashboardRunner(nil, nil, nil, 0)
+	disabled.store("k", result)
+	_, ok = disabled.cached("k")
+	assert.False(t, ok)
+}
+
+func TestDashboardCacheKey(t *testing.T) {
+	dashboard := &models.DashboardConfig{ID: "d1", Widgets: []models.WidgetConfig{{ID: "w1", Query: models.AnalyticsQuery{ObjectAPIName: "account", Operation: "count"}}}}
+	alice := &models.UserSession{ID: "alice"}
+	bob := &models.UserSession{ID: "bob"}
+
+	key := dashboardCacheKey(dashboard, alice, alice, nil)
+	assert.Equal(t, key, dashboardCacheKey(dashboard, alice, alice, nil))
+	assert.NotEqual(t, key, dashboardCacheKey(dashboard, bob, bob, nil))
+	assert.NotEqual(t, key, dashboardCacheKey(dashboard, alice, bob, nil))
+
+	filtered := []activeDashboardFilter{{
+		filter: models.DashboardFilter{ID: "f1", Type: constants.DashboardFilterPicklist, Field: "status"},
+		value:  models.DashboardFilterValue{Values: []string{"Open"}},
+	}}
+	assert.NotEqual(t, key, dashboardCacheKey(dashboard, alice, alice, filtered))
+
+	dashboard.Widgets[0].Query.FilterExpr = "status == 'Open'"
+	assert.NotEqual(t, key, dashboardCacheKey(dashboard, alice, alice, nil))
+}
+
+func TestResolveDashboardFilters(t *testing.T) {
+	filters := []models.DashboardFilter{
+		{ID: "period", Type: constants.DashboardFilterDateRange, Field: "close_date", Default: &models.DashboardFilterValue{Start: "2024-01-01"}},
+		{ID: "stage", Type: constants.DashboardFilterPicklist, Field: "stage", Options: []string{"Open", "Won"}},
+	}
+
+	active, err := resolveDashboardFilters(filters, nil)
+	assert.NoError(t, err)
+	assert.Len(t, active, 1)
+	assert.Equal(t, "2024-01-01", active[0].value.Start)
+
+	active, err = resolveDashboardFilters(filters, map[string]models.DashboardFilterValue{
+		"period": {},
+		"stage":  {Values: []string{"Won"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, active, 1)
+	assert.Equal(t, "stage", active[0].filter.ID)
+
+	_, err = resolveDashboardFilters(filters, map[string]models.DashboardFilterValue{"stage": {Values: []string{"Lost"}}})
+	assert.Error(t, err)
+	_, err = resolveDashboardFilters(filters, map[string]models.DashboardFilterValue{"period": {Start: "01/02/2024"}})
+	assert.Error(t, err)
+	_, err = resolveDashboardFilters(filters, map[string]models.DashboardFilterValue{"other": {}})
+	assert.Error(t, err)
+}
+
+func TestDashboardFilterExpr(t *testing.T) {
+	dateField := &models.FieldMetadata{APIName: "close_date", Type: constants.FieldTypeDate}
+	period := models.DashboardFilter{ID: "period", Type: constants.DashboardFilterDateRange}
+	expr, err := dashboardFilterExpr(dateField, period, models.DashboardFilterValue{Start: "2024-01-01", End: "2024-01-31"})
+	assert.NoError(t, err)
+	assert.Equal(t, "close_date >= '2024-01-01' && close_date < '2024-02-01'", expr)
+
+	picklist := &models.FieldMetadata{APIName: "stage", Type: constants.FieldTypePicklist, Options: []string{"Open", "Won", "It's done"}}
+	stage := models.DashboardFilter{ID: "stage", Type: constants.DashboardFilterPicklist}
+	expr, err = dashboardFilterExpr(picklist, stage, models.DashboardFilterValue{Values: []string{"Open", "Won"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "(stage == 'Open' || stage == 'Won')", expr)
+
+	_, err = dashboardFilterExpr(picklist, stage, models.DashboardFilterValue{Values: []string{"Lost"}})
+	assert.Error(t, err)
+	_, err = dashboardFilterExpr(picklist, stage, models.DashboardFilterValue{Values: []string{"It's done"}})
+	assert.Error(t, err)
+	_, err = dashboardFilterExpr(picklist, period, models.DashboardFilterValue{Start: "2024-01-01"})
+	assert.Error(t, err)
+}
+
+func TestValidateDashboardSettings(t *testing.T) {
+	owner := &models.UserSession{ID: "u1", ProfileID: "standard_user"}
+	admin := &models.UserSession{ID: "u2", ProfileID: constants.ProfileSystemAdmin}
+	other := "u3"
+
+	dashboard := &models.DashboardConfig{Filters: []models.DashboardFilter{{Type: constants.DashboardFilterPicklist, Field: "stage"}}}
+	assert.NoError(t, validateDashboardSettings(dashboard))
+	assert.NotEmpty(t, dashboard.Filters[0].ID)
+	assert.Equal(t, constants.DashboardRunAsViewer, dashboard.RunAs)
+	assert.NoError(t, checkDashboardRunningUser(dashboard, owner))
+
+	runAs := &models.DashboardConfig{RunAs: constants.DashboardRunAsUser, RunAsUserID: &other}
+	assert.NoError(t, validateDashboardSettings(runAs))
+	assert.Error(t, checkDashboardRunningUser(runAs, owner))
+	assert.NoError(t, checkDashboardRunningUser(runAs, admin))
+
+	self := &models.DashboardConfig{RunAs: constants.DashboardRunAsUser, RunAsUserID: &owner.ID}
+	assert.NoError(t, checkDashboardRunningUser(self, owner))
+
+	missing := &models.DashboardConfig{RunAs: constants.DashboardRunAsUser}
+	assert.Error(t, validateDashboardSettings(missing))
+
+	badType := &models.DashboardConfig{Filters: []models.DashboardFilter{{Type: "slider", Field: "amount"}}}
+	assert.Error(t, validateDashboardSettings(badType))
+}
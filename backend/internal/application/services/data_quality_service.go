@@ -0,0 +1,554 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultDuplicateThreshold = 85
+	// dataQualityScanBatchSize is the page size used when loading records to score
+	dataQualityScanBatchSize = 500
+	// dataQualityWriteBatchSize is the number of scores written per statement
+	dataQualityWriteBatchSize = 200
+	// maxDuplicateBlockSize bounds the pairwise fuzzy comparisons among records sharing a prefix
+	maxDuplicateBlockSize = 200
+	// minPhoneDigits ignores phone values too short to identify anyone
+	minPhoneDigits = 7
+
+	defaultDataQualityListLimit = 50
+	maxDataQualityListLimit     = 500
+)
+
+// DataQualityService scores records for completeness and probable duplication. Scores are
+// computed per object by a background job and stored in _System_Data_Quality_Score, so
+// cleanup can be targeted from the dashboard without exporting data.
+type DataQualityService struct {
+	repo        *persistence.DataQualityRepository
+	records     *persistence.QueryRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+	jobs        *AsyncJobService
+	semantic    *SemanticSearchService
+}
+
+// NewDataQualityService creates a new DataQualityService
+func NewDataQualityService(
+	repo *persistence.DataQualityRepository,
+	records *persistence.QueryRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	query *QueryService,
+	jobs *AsyncJobService,
+	semantic *SemanticSearchService,
+) *DataQualityService {
+	return &DataQualityService{
+		repo:        repo,
+		records:     records,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+		jobs:        jobs,
+		semantic:    semantic,
+	}
+}
+
+// GetRule returns the scoring rule of an object. Objects without a stored rule use the defaults.
+func (s *DataQualityService) GetRule(ctx context.Context, objectAPIName string) (*models.DataQualityRule, error) {
+	schema, err := s.scorableSchema(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := s.repo.GetRule(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	rule := &models.DataQualityRule{ObjectAPIName: schema.APIName, DuplicateThreshold: defaultDuplicateThreshold}
+	if stored != nil {
+		_ = json.Unmarshal(stored.CompletenessFields, &rule.CompletenessFields)
+		_ = json.Unmarshal(stored.MatchFields, &rule.MatchFields)
+		rule.DuplicateThreshold = stored.DuplicateThreshold
+		rule.UseEmbeddings = stored.UseEmbeddings
+	}
+	return rule, nil
+}
+
+// SaveRule validates and stores the scoring rule of an object
+func (s *DataQualityService) SaveRule(ctx context.Context, objectAPIName string, rule models.DataQualityRule) (*models.DataQualityRule, error) {
+	schema, err := s.scorableSchema(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+
+	completeness, err := resolveRuleFields(schema, "completeness_fields", rule.CompletenessFields)
+	if err != nil {
+		return nil, err
+	}
+	match, err := resolveRuleFields(schema, "match_fields", rule.MatchFields)
+	if err != nil {
+		return nil, err
+	}
+	if rule.DuplicateThreshold == 0 {
+		rule.DuplicateThreshold = defaultDuplicateThreshold
+	}
+	if rule.DuplicateThreshold < 1 || rule.DuplicateThreshold > 100 {
+		return nil, errors.NewValidationError("duplicate_threshold", "must be between 1 and 100")
+	}
+	if rule.UseEmbeddings && !s.semantic.Enabled() {
+		return nil, errors.NewValidationError("use_embeddings", "Semantic search is not configured (set EMBEDDING_PROVIDER)")
+	}
+
+	completenessJSON, _ := json.Marshal(completeness)
+	matchJSON, _ := json.Marshal(match)
+	if err := s.repo.UpsertRule(ctx, &models.SystemDataQualityRule{
+		ID:                 GenerateID(),
+		ObjectAPIName:      schema.APIName,
+		CompletenessFields: completenessJSON,
+		MatchFields:        matchJSON,
+		DuplicateThreshold: rule.DuplicateThreshold,
+		UseEmbeddings:      rule.UseEmbeddings,
+	}); err != nil {
+		return nil, err
+	}
+	return s.GetRule(ctx, schema.APIName)
+}
+
+// Score queues a job that rescores every record of an object
+func (s *DataQualityService) Score(ctx context.Context, objectAPIName string, currentUser *models.UserSession) (*models.SystemAsyncJob, error) {
+	rule, err := s.GetRule(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	schema := s.metadata.GetSchema(ctx, rule.ObjectAPIName)
+
+	return s.jobs.Enqueue(ctx, constants.AsyncJobTypeDataQuality, schema.APIName, rule, 0, currentUser,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			return s.scoreObject(ctx, schema, rule, job, report)
+		})
+}
+
+// scoredRecord is a record's completeness, kept in memory until duplicates are known
+type scoredRecord struct {
+	id           string
+	completeness int
+	missing      []string
+}
+
+// scoreObject loads every record of an object, scores it and replaces the object's stored scores
+func (s *DataQualityService) scoreObject(ctx context.Context, schema *models.ObjectMetadata, rule *models.DataQualityRule, job *models.SystemAsyncJob, report func()) error {
+	started := time.Now().Truncate(time.Second) // DATETIME precision
+	completenessFields := rule.CompletenessFields
+	if len(completenessFields) == 0 {
+		completenessFields = defaultCompletenessFields(schema)
+	}
+	matchFields := rule.MatchFields
+	if len(matchFields) == 0 {
+		matchFields = defaultMatchFields(schema)
+	}
+	fuzzy := make(map[string]bool)
+	for _, name := range matchFields {
+		if f := FindField(schema, name); f != nil && f.Type != constants.FieldTypeEmail && f.Type != constants.FieldTypePhone {
+			fuzzy[name] = true
+		}
+	}
+
+	var scored []scoredRecord
+	var candidates []duplicateCandidate
+	afterID := ""
+	for {
+		rows, err := s.records.ScanAfter(ctx, schema.APIName, unionFields(completenessFields, matchFields), afterID, dataQualityScanBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s: %w", schema.APIName, err)
+		}
+		for _, row := range rows {
+			id := row.GetString(constants.FieldID)
+			completeness, missing := scoreCompleteness(row, completenessFields)
+			scored = append(scored, scoredRecord{id: id, completeness: completeness, missing: missing})
+			candidates = append(candidates, duplicateCandidate{id: id, values: matchValues(schema, row, matchFields)})
+		}
+		if len(rows) < dataQualityScanBatchSize {
+			break
+		}
+		afterID = rows[len(rows)-1].GetString(constants.FieldID)
+	}
+	job.TotalCount = len(scored)
+	report()
+
+	duplicates := findDuplicates(candidates, fuzzy, rule.DuplicateThreshold)
+	if rule.UseEmbeddings && s.semantic.Enabled() {
+		for _, record := range scored {
+			hits, err := s.semantic.SimilarRecords(ctx, schema.APIName, record.id, 1)
+			if err != nil {
+				return fmt.Errorf("failed to compare embeddings: %w", err)
+			}
+			if len(hits) > 0 {
+				duplicates.add(record.id, hits[0].RecordID, similarityScore(hits[0].Score), rule.DuplicateThreshold)
+			}
+		}
+	}
+
+	batch := make([]*models.SystemDataQualityScore, 0, dataQualityWriteBatchSize)
+	flush := func() error {
+		if err := s.repo.UpsertScores(ctx, batch); err != nil {
+			return err
+		}
+		job.ProcessedCount += len(batch)
+		report()
+		batch = batch[:0]
+		return nil
+	}
+	for _, record := range scored {
+		missing, _ := json.Marshal(record.missing)
+		score := &models.SystemDataQualityScore{
+			ID:                GenerateID(),
+			ObjectAPIName:     schema.APIName,
+			RecordID:          record.id,
+			CompletenessScore: record.completeness,
+			QualityScore:      record.completeness,
+			MissingFields:     missing,
+			ScoredDate:        started,
+		}
+		if match, ok := duplicates[record.id]; ok {
+			score.DuplicateScore = match.score
+			score.DuplicateOf = &match.of
+			score.QualityScore = record.completeness / 2 // A probable duplicate needs cleanup however complete it is
+		}
+		batch = append(batch, score)
+		if len(batch) == dataQualityWriteBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteScoresBefore(ctx, schema.APIName, started); err != nil {
+		return fmt.Errorf("failed to remove stale scores: %w", err)
+	}
+	log.Printf("🧹 Data quality scored %d %s records (%d probable duplicates)", len(scored), schema.APIName, len(duplicates))
+	return nil
+}
+
+// Dashboard summarizes the stored scores of every scored object the user can read
+func (s *DataQualityService) Dashboard(ctx context.Context, currentUser *models.UserSession) ([]models.DataQualitySummary, error) {
+	stats, err := s.repo.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]models.DataQualitySummary, 0, len(stats))
+	for _, st := range stats {
+		schema := s.metadata.GetSchema(ctx, st.ObjectAPIName)
+		if schema == nil || !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+			continue
+		}
+		missing, err := s.repo.MissingFieldCounts(ctx, schema.APIName)
+		if err != nil {
+			return nil, err
+		}
+		for field := range missing {
+			if !s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field, currentUser) {
+				delete(missing, field)
+			}
+		}
+		summaries = append(summaries, models.DataQualitySummary{
+			ObjectAPIName:       schema.APIName,
+			ObjectLabel:         schema.Label,
+			ScoredRecords:       st.ScoredRecords,
+			AverageCompleteness: math.Round(st.AverageCompleteness*10) / 10,
+			AverageQuality:      math.Round(st.AverageQuality*10) / 10,
+			ProbableDuplicates:  st.ProbableDuplicates,
+			QualityBands:        map[string]int{"poor": st.Poor, "fair": st.Fair, "good": st.Good},
+			MissingFields:       missing,
+			LastScoredDate:      st.LastScoredDate,
+		})
+	}
+	return summaries, nil
+}
+
+// Records lists an object's scored records, lowest quality first, skipping records the user cannot read
+func (s *DataQualityService) Records(ctx context.Context, objectAPIName string, maxQuality int, duplicatesOnly bool, limit int, currentUser *models.UserSession) ([]models.DataQualityRecord, error) {
+	schema := s.metadata.GetSchema(ctx, strings.ToLower(objectAPIName))
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	if !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+		return nil, errors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	if limit <= 0 {
+		limit = defaultDataQualityListLimit
+	}
+	limit = min(limit, maxDataQualityListLimit)
+
+	scores, err := s.repo.ListScores(ctx, schema.APIName, maxQuality, duplicatesOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(scores))
+	for i, score := range scores {
+		ids[i] = score.RecordID
+	}
+	rows, err := s.query.QueryByIDs(ctx, schema.APIName, ids, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]models.SObject, len(rows))
+	for _, row := range rows {
+		byID[row.GetString(constants.FieldID)] = row
+	}
+
+	nameField := GetNameFieldAPIName(schema)
+	results := make([]models.DataQualityRecord, 0, len(scores))
+	for _, score := range scores {
+		record, ok := byID[score.RecordID]
+		if !ok || !s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, currentUser) {
+			continue
+		}
+		result := models.DataQualityRecord{
+			RecordID:          score.RecordID,
+			Name:              record.GetString(nameField),
+			CompletenessScore: score.CompletenessScore,
+			DuplicateScore:    score.DuplicateScore,
+			QualityScore:      score.QualityScore,
+			MissingFields:     []string{},
+			ScoredDate:        score.ScoredDate,
+		}
+		if score.DuplicateOf != nil {
+			result.DuplicateOf = *score.DuplicateOf
+		}
+		_ = json.Unmarshal(score.MissingFields, &result.MissingFields)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// scorableSchema returns the schema of a data object that can be scored
+func (s *DataQualityService) scorableSchema(ctx context.Context, objectAPIName string) (*models.ObjectMetadata, error) {
+	schema := s.metadata.GetSchema(ctx, strings.ToLower(objectAPIName))
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	if constants.IsSystemTable(schema.APIName) {
+		return nil, errors.NewValidationError("object_api_name", "system objects are not scored")
+	}
+	return schema, nil
+}
+
+// resolveRuleFields checks that every field of a rule exists and returns their API names
+func resolveRuleFields(schema *models.ObjectMetadata, param string, names []string) ([]string, error) {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		field := FindField(schema, name)
+		if field == nil {
+			return nil, errors.NewValidationError(param, fmt.Sprintf("field %s does not exist on %s", name, schema.APIName))
+		}
+		if !ContainsString(resolved, field.APIName) {
+			resolved = append(resolved, field.APIName)
+		}
+	}
+	return resolved, nil
+}
+
+// defaultCompletenessFields scores the required business fields and the name field
+func defaultCompletenessFields(schema *models.ObjectMetadata) []string {
+	fields := make([]string, 0)
+	for _, f := range schema.Fields {
+		if !f.IsSystem && (f.Required || f.IsNameField) {
+			fields = append(fields, f.APIName)
+		}
+	}
+	return fields
+}
+
+// defaultMatchFields compares the name, email and phone fields
+func defaultMatchFields(schema *models.ObjectMetadata) []string {
+	fields := make([]string, 0)
+	for _, f := range schema.Fields {
+		if !f.IsSystem && (f.IsNameField || f.Type == constants.FieldTypeEmail || f.Type == constants.FieldTypePhone) {
+			fields = append(fields, f.APIName)
+		}
+	}
+	return fields
+}
+
+func unionFields(a, b []string) []string {
+	fields := append([]string{}, a...)
+	for _, f := range b {
+		if !ContainsString(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// scoreCompleteness returns the percentage of fields holding a value and the fields missing one
+func scoreCompleteness(row models.SObject, fields []string) (int, []string) {
+	if len(fields) == 0 {
+		return 100, []string{}
+	}
+	missing := make([]string, 0)
+	for _, f := range fields {
+		if val, ok := row[f]; !ok || val == nil || strings.TrimSpace(fmt.Sprint(val)) == "" {
+			missing = append(missing, f)
+		}
+	}
+	return (len(fields) - len(missing)) * 100 / len(fields), missing
+}
+
+// duplicateCandidate is a record's normalized match values by field
+type duplicateCandidate struct {
+	id     string
+	values map[string]string
+}
+
+// duplicateMatch is the closest other record found for a record
+type duplicateMatch struct {
+	of    string
+	score int
+}
+
+// duplicateMatches maps record IDs to their closest probable duplicate
+type duplicateMatches map[string]duplicateMatch
+
+// add records that a and b are score% similar, if that reaches the threshold and beats their current matches
+func (m duplicateMatches) add(a, b string, score, threshold int) {
+	if a == b || score < threshold {
+		return
+	}
+	if score > m[a].score {
+		m[a] = duplicateMatch{of: b, score: score}
+	}
+	if score > m[b].score {
+		m[b] = duplicateMatch{of: a, score: score}
+	}
+}
+
+// matchValues normalizes a record's match fields for comparison; empty values are omitted
+func matchValues(schema *models.ObjectMetadata, row models.SObject, fields []string) map[string]string {
+	values := make(map[string]string, len(fields))
+	for _, name := range fields {
+		val, ok := row[name]
+		if !ok || val == nil {
+			continue
+		}
+		var fieldType constants.SchemaFieldType
+		if f := FindField(schema, name); f != nil {
+			fieldType = f.Type
+		}
+		if normalized := normalizeMatchValue(fieldType, fmt.Sprint(val)); normalized != "" {
+			values[name] = normalized
+		}
+	}
+	return values
+}
+
+// normalizeMatchValue reduces a value to the form compared for duplicates: emails are
+// lowercased, phones keep their digits and text keeps lowercased words
+func normalizeMatchValue(fieldType constants.SchemaFieldType, value string) string {
+	switch fieldType {
+	case constants.FieldTypeEmail:
+		return strings.ToLower(strings.TrimSpace(value))
+	case constants.FieldTypePhone:
+		digits := strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, value)
+		if len(digits) < minPhoneDigits {
+			return ""
+		}
+		return digits
+	default:
+		words := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		return strings.Join(words, " ")
+	}
+}
+
+// findDuplicates pairs records sharing a match value exactly, and records whose fuzzy
+// (text) match values are similar enough; similar values are only compared within blocks
+// sharing their first two characters
+func findDuplicates(candidates []duplicateCandidate, fuzzy map[string]bool, threshold int) duplicateMatches {
+	matches := make(duplicateMatches)
+	first := make(map[string]string) // field + value -> first record holding it
+	blocks := make(map[string][]int) // field + prefix -> candidates
+	for i, c := range candidates {
+		for field, value := range c.values {
+			key := field + "\x00" + value
+			if id, ok := first[key]; ok {
+				matches.add(id, c.id, 100, threshold)
+			} else {
+				first[key] = c.id
+			}
+			if fuzzy[field] {
+				block := field + "\x00" + string([]rune(value)[:min(2, len([]rune(value)))])
+				if len(blocks[block]) < maxDuplicateBlockSize {
+					blocks[block] = append(blocks[block], i)
+				}
+			}
+		}
+	}
+
+	for key, members := range blocks {
+		field := key[:strings.IndexByte(key, 0)]
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				a, b := candidates[members[x]], candidates[members[y]]
+				if a.values[field] == b.values[field] {
+					continue // Already matched exactly
+				}
+				matches.add(a.id, b.id, similarityScore(diceSimilarity(a.values[field], b.values[field])), threshold)
+			}
+		}
+	}
+	return matches
+}
+
+// diceSimilarity compares two strings by their shared character bigrams (0 to 1)
+func diceSimilarity(a, b string) float64 {
+	bigrams := func(s string) map[string]int {
+		runes := []rune(s)
+		grams := make(map[string]int)
+		for i := 0; i+1 < len(runes); i++ {
+			grams[string(runes[i:i+2])]++
+		}
+		return grams
+	}
+	ga, gb := bigrams(a), bigrams(b)
+	total := 0
+	for _, n := range ga {
+		total += n
+	}
+	for _, n := range gb {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+	shared := 0
+	for gram, n := range ga {
+		shared += min(n, gb[gram])
+	}
+	return float64(2*shared) / float64(total)
+}
+
+// similarityScore converts a 0-1 similarity to a 0-100 score
+func similarityScore(similarity float64) int {
+	return int(math.Round(max(0, min(1, similarity)) * 100))
+}
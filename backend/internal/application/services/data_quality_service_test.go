@@ -0,0 +1,52 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreCompleteness(t *testing.T) {
+	score, missing := scoreCompleteness(models.SObject{"name": "Acme", "email": " ", "phone": nil}, []string{"name", "email", "phone", "industry"})
+	assert.Equal(t, 25, score)
+	assert.Equal(t, []string{"email", "phone", "industry"}, missing)
+
+	score, missing = scoreCompleteness(models.SObject{}, nil)
+	assert.Equal(t, 100, score)
+	assert.Empty(t, missing)
+}
+
+func TestNormalizeMatchValue(t *testing.T) {
+	assert.Equal(t, "jane@acme.com", normalizeMatchValue(constants.FieldTypeEmail, " Jane@Acme.com "))
+	assert.Equal(t, "15551234567", normalizeMatchValue(constants.FieldTypePhone, "+1 (555) 123-4567"))
+	assert.Empty(t, normalizeMatchValue(constants.FieldTypePhone, "ext 12"))
+	assert.Equal(t, "acme corp inc", normalizeMatchValue(constants.FieldTypeText, "ACME Corp., Inc."))
+}
+
+func TestFindDuplicates(t *testing.T) {
+	candidates := []duplicateCandidate{
+		{id: "a1", values: map[string]string{"name": "acme corporation", "email": "info@acme.com"}},
+		{id: "a2", values: map[string]string{"name": "globex", "email": "info@acme.com"}},
+		{id: "a3", values: map[string]string{"name": "acme corporaton"}},
+		{id: "a4", values: map[string]string{"name": "initech"}},
+	}
+	matches := findDuplicates(candidates, map[string]bool{"name": true}, 85)
+
+	// Exact email match wins over the fuzzy name match for a1
+	assert.Equal(t, duplicateMatch{of: "a2", score: 100}, matches["a1"])
+	assert.Equal(t, duplicateMatch{of: "a1", score: 100}, matches["a2"])
+	assert.Equal(t, "a1", matches["a3"].of)
+	assert.GreaterOrEqual(t, matches["a3"].score, 85)
+	assert.NotContains(t, matches, "a4")
+
+	// Below the threshold nothing is flagged
+	assert.Empty(t, findDuplicates(candidates[2:], map[string]bool{"name": true}, 100))
+}
+
+func TestDiceSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, diceSimilarity("acme", "acme"), 1e-9)
+	assert.InDelta(t, 0.0, diceSimilarity("acme", "zzzz"), 1e-9)
+	assert.Equal(t, 0.0, diceSimilarity("a", "b"))
+}
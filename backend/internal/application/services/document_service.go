@@ -0,0 +1,362 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/documents"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// mimeTypePDF is the content type of generated documents
+const mimeTypePDF = "application/pdf"
+
+// DocumentService manages document templates and generates printable PDFs (quotes,
+// invoices) from a record: merge fields are filled with the record's values, barcodes and
+// QR codes are drawn from them, and the PDF is attached to the record as a file.
+// HTML templates use the built-in renderer unless a document converter is configured,
+// which DOCX templates require.
+type DocumentService struct {
+	repo        *persistence.DocumentTemplateRepository
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	files       *FileService
+	converter   *documents.Converter
+}
+
+// NewDocumentService creates a new DocumentService; converter may be nil
+func NewDocumentService(repo *persistence.DocumentTemplateRepository, metadata *MetadataService, query *QueryService, permissions *PermissionService, files *FileService, converter *documents.Converter) *DocumentService {
+	return &DocumentService{
+		repo:        repo,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		files:       files,
+		converter:   converter,
+	}
+}
+
+// ==================== Templates ====================
+
+// GetTemplates returns the document templates, optionally only those of one object
+func (s *DocumentService) GetTemplates(ctx context.Context, objectAPIName string) ([]*models.SystemDocumentTemplate, error) {
+	return s.repo.List(ctx, strings.ToLower(objectAPIName))
+}
+
+// GetTemplate returns a document template
+func (s *DocumentService) GetTemplate(ctx context.Context, id string) (*models.SystemDocumentTemplate, error) {
+	t, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.NewNotFoundError("Document template", id)
+	}
+	return t, nil
+}
+
+// CreateTemplate validates and stores a document template
+func (s *DocumentService) CreateTemplate(ctx context.Context, t *models.SystemDocumentTemplate) error {
+	t.Name = strings.TrimSpace(t.Name)
+	if !developerNamePattern.MatchString(t.Name) {
+		return errors.NewValidationError(constants.FieldSysDocumentTemplate_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(t.Label) == "" {
+		t.Label = t.Name
+	}
+	if t.TemplateType == "" {
+		t.TemplateType = string(constants.DocumentTemplateHTML)
+	}
+	if t.PageSize == "" {
+		t.PageSize = documents.PageSizeA4
+	}
+	t.ObjectAPIName = strings.ToLower(t.ObjectAPIName)
+	t.IsActive = true
+	if err := s.validateTemplate(ctx, t); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByName(ctx, t.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("DocumentTemplate", constants.FieldSysDocumentTemplate_Name, t.Name)
+	}
+	if t.ID == "" {
+		t.ID = GenerateID()
+	}
+	return s.repo.Insert(ctx, t)
+}
+
+// DocumentTemplateUpdate holds the changeable settings of a document template; nil leaves
+// a setting unchanged
+type DocumentTemplateUpdate struct {
+	Label         *string `json:"label"`
+	ObjectAPIName *string `json:"object_api_name"`
+	TemplateType  *string `json:"template_type"`
+	Content       *string `json:"content"`
+	FileName      *string `json:"file_name"`
+	PageSize      *string `json:"page_size"`
+	IsActive      *bool   `json:"is_active"`
+}
+
+// UpdateTemplate updates a document template. An empty file name clears it.
+func (s *DocumentService) UpdateTemplate(ctx context.Context, id string, updates DocumentTemplateUpdate) (*models.SystemDocumentTemplate, error) {
+	t, err := s.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Label != nil && strings.TrimSpace(*updates.Label) != "" {
+		t.Label = *updates.Label
+	}
+	if updates.ObjectAPIName != nil {
+		t.ObjectAPIName = strings.ToLower(*updates.ObjectAPIName)
+	}
+	if updates.TemplateType != nil {
+		t.TemplateType = *updates.TemplateType
+	}
+	if updates.Content != nil {
+		t.Content = *updates.Content
+	}
+	if updates.FileName != nil {
+		t.FileName = optionalString(*updates.FileName)
+	}
+	if updates.PageSize != nil {
+		t.PageSize = *updates.PageSize
+	}
+	if updates.IsActive != nil {
+		t.IsActive = *updates.IsActive
+	}
+	if err := s.validateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DeleteTemplate deletes a document template
+func (s *DocumentService) DeleteTemplate(ctx context.Context, id string) error {
+	if _, err := s.GetTemplate(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// validateTemplate checks the object, format, page size and that every merge field exists
+func (s *DocumentService) validateTemplate(ctx context.Context, t *models.SystemDocumentTemplate) error {
+	schema, err := s.metadata.GetSchemaOrError(ctx, t.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+	if t.PageSize != documents.PageSizeA4 && t.PageSize != documents.PageSizeLetter {
+		return errors.NewValidationError(constants.FieldSysDocumentTemplate_PageSize,
+			fmt.Sprintf("unsupported page size '%s'; expected %s or %s", t.PageSize, documents.PageSizeA4, documents.PageSizeLetter))
+	}
+	if strings.TrimSpace(t.Content) == "" {
+		return errors.NewValidationError(constants.FieldSysDocumentTemplate_Content, "is required")
+	}
+
+	text := t.Content
+	switch constants.DocumentTemplateType(t.TemplateType) {
+	case constants.DocumentTemplateHTML:
+	case constants.DocumentTemplateDOCX:
+		docx, err := base64.StdEncoding.DecodeString(t.Content)
+		if err != nil {
+			return errors.NewValidationError(constants.FieldSysDocumentTemplate_Content, "must be a base64-encoded DOCX file")
+		}
+		// Merging every field with its own token collects the fields Word split across runs
+		var names strings.Builder
+		if _, err := documents.MergeDOCX(docx, func(f documents.MergeField) string {
+			names.WriteString(mergeToken(f))
+			return ""
+		}); err != nil {
+			return errors.NewValidationError(constants.FieldSysDocumentTemplate_Content, err.Error())
+		}
+		text = names.String()
+	default:
+		return errors.NewValidationError(constants.FieldSysDocumentTemplate_TemplateType,
+			fmt.Sprintf("unsupported template type '%s'; expected %s or %s", t.TemplateType, constants.DocumentTemplateHTML, constants.DocumentTemplateDOCX))
+	}
+
+	if t.FileName != nil {
+		text += *t.FileName
+	}
+	for _, f := range documents.MergeFields(text) {
+		if f.Code != "" && f.Code != documents.CodeBarcode && f.Code != documents.CodeQR {
+			return errors.NewValidationError(constants.FieldSysDocumentTemplate_Content,
+				fmt.Sprintf("unknown merge field '%s'; use %s: or %s: to draw a field as a code", mergeToken(f), documents.CodeBarcode, documents.CodeQR))
+		}
+		if FindField(schema, f.Field) == nil && FindField(schema, strings.TrimSuffix(f.Field, constants.LookupNameSuffix)) == nil {
+			return errors.NewValidationError(constants.FieldSysDocumentTemplate_Content,
+				fmt.Sprintf("unknown field '%s' on %s", f.Field, t.ObjectAPIName))
+		}
+	}
+	return nil
+}
+
+func mergeToken(f documents.MergeField) string {
+	if f.Code != "" {
+		return "{{" + f.Code + ":" + f.Field + "}}"
+	}
+	return "{{" + f.Field + "}}"
+}
+
+// ==================== Generation ====================
+
+// Generate renders a template for a record the user can read and attaches the PDF to the
+// record. It returns the created _System_File record.
+func (s *DocumentService) Generate(ctx context.Context, objectAPIName, recordID, templateID string, currentUser *models.UserSession) (models.SObject, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t.ObjectAPIName != schema.APIName {
+		return nil, errors.NewValidationError("template_id", fmt.Sprintf("template '%s' is for %s, not %s", t.Name, t.ObjectAPIName, schema.APIName))
+	}
+	if !t.IsActive {
+		return nil, errors.NewValidationError("template_id", fmt.Sprintf("template '%s' is inactive", t.Name))
+	}
+
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	record := records[0]
+
+	pdf, err := s.render(ctx, t, schema, record)
+	if err != nil {
+		return nil, err
+	}
+	return s.files.Attach(ctx, recordID, documentFileName(t, schema, record), mimeTypePDF, bytes.NewReader(pdf), currentUser)
+}
+
+// render merges a record into a template and converts the result to PDF
+func (s *DocumentService) render(ctx context.Context, t *models.SystemDocumentTemplate, schema *models.ObjectMetadata, record models.SObject) ([]byte, error) {
+	switch constants.DocumentTemplateType(t.TemplateType) {
+	case constants.DocumentTemplateDOCX:
+		if s.converter == nil {
+			return nil, errors.NewValidationError(constants.FieldSysDocumentTemplate_TemplateType, "DOCX templates require a document converter (DOCUMENT_CONVERTER_URL)")
+		}
+		template, err := base64.StdEncoding.DecodeString(t.Content)
+		if err != nil {
+			return nil, errors.NewValidationError(constants.FieldSysDocumentTemplate_Content, "must be a base64-encoded DOCX file")
+		}
+		docx, err := documents.MergeDOCX(template, func(f documents.MergeField) string {
+			return documentValue(schema, record, f.Field)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s.converter.DOCXToPDF(ctx, docx)
+	default:
+		merged, err := mergeDocumentHTML(t.Content, schema, record)
+		if err != nil {
+			return nil, err
+		}
+		if s.converter != nil {
+			return s.converter.HTMLToPDF(ctx, []byte(merged), t.PageSize)
+		}
+		return documents.RenderHTML(merged, t.PageSize)
+	}
+}
+
+// mergeDocumentHTML fills an HTML template: values are escaped and codes become images
+func mergeDocumentHTML(template string, schema *models.ObjectMetadata, record models.SObject) (string, error) {
+	var codeErr error
+	merged := documents.Merge(template, func(f documents.MergeField) string {
+		value := documentValue(schema, record, f.Field)
+		if f.Code == "" {
+			return strings.ReplaceAll(html.EscapeString(value), "\n", "<br>")
+		}
+		if value == "" {
+			return ""
+		}
+		png, err := documents.CodePNG(f.Code, value)
+		if err != nil {
+			if codeErr == nil {
+				codeErr = errors.NewValidationError(f.Field, err.Error())
+			}
+			return ""
+		}
+		return documents.CodeImageTag(f.Code, png)
+	})
+	return merged, codeErr
+}
+
+// documentValue formats a record's field for print
+func documentValue(schema *models.ObjectMetadata, record models.SObject, field string) string {
+	value, ok := record[field]
+	if !ok || value == nil {
+		return ""
+	}
+	var fieldType constants.SchemaFieldType
+	if f := FindField(schema, field); f != nil {
+		fieldType = f.Type
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		if fieldType == constants.FieldTypeDate {
+			return v.Format("2006-01-02")
+		}
+		return v.Format("2006-01-02 15:04")
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case float64:
+		if fieldType == constants.FieldTypeCurrency {
+			return strconv.FormatFloat(v, 'f', 2, 64)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// documentFileName names a generated PDF from the template's file name pattern, or its label
+func documentFileName(t *models.SystemDocumentTemplate, schema *models.ObjectMetadata, record models.SObject) string {
+	name := t.Label
+	if t.FileName != nil {
+		name = documents.Merge(*t.FileName, func(f documents.MergeField) string {
+			return documentValue(schema, record, f.Field)
+		})
+	}
+	name = strings.Map(func(r rune) rune {
+		if r < 32 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = t.Name
+	}
+	if !strings.HasSuffix(strings.ToLower(name), ".pdf") {
+		name += ".pdf"
+	}
+	return name
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var documentTestSchema = &models.ObjectMetadata{
+	APIName: "quote",
+	Fields: []models.FieldMetadata{
+		{APIName: "quote_number", Type: constants.FieldTypeText},
+		{APIName: "total", Type: constants.FieldTypeCurrency},
+		{APIName: "quantity", Type: constants.FieldTypeNumber},
+		{APIName: "valid_until", Type: constants.FieldTypeDate},
+		{APIName: "approved", Type: constants.FieldTypeBoolean},
+		{APIName: "notes", Type: constants.FieldTypeTextArea},
+	},
+}
+
+func TestDocumentValue(t *testing.T) {
+	record := models.SObject{
+		"quote_number": "Q-1",
+		"total":        float64(1250),
+		"quantity":     float64(3),
+		"valid_until":  time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+		"approved":     true,
+		"notes":        nil,
+	}
+
+	assert.Equal(t, "Q-1", documentValue(documentTestSchema, record, "quote_number"))
+	assert.Equal(t, "1250.00", documentValue(documentTestSchema, record, "total"))
+	assert.Equal(t, "3", documentValue(documentTestSchema, record, "quantity"))
+	assert.Equal(t, "2026-11-30", documentValue(documentTestSchema, record, "valid_until"))
+	assert.Equal(t, "Yes", documentValue(documentTestSchema, record, "approved"))
+	assert.Equal(t, "", documentValue(documentTestSchema, record, "notes"))
+	assert.Equal(t, "", documentValue(documentTestSchema, record, "missing"))
+}
+
+func TestMergeDocumentHTML(t *testing.T) {
+	record := models.SObject{"quote_number": "Q-1", "notes": "<script>x</script>\nLine 2"}
+
+	merged, err := mergeDocumentHTML(`<p>{{notes}}</p>{{barcode:quote_number}}{{qrcode:notes_missing}}`, documentTestSchema, record)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(merged, `<p>&lt;script&gt;x&lt;/script&gt;<br>Line 2</p><img class="nexus-barcode" src="data:image/png;base64,`), merged)
+	assert.True(t, strings.HasSuffix(merged, `">`), "empty values draw no code")
+
+	_, err = mergeDocumentHTML(`{{barcode:notes}}`, documentTestSchema, models.SObject{"notes": "café"})
+	assert.Error(t, err, "Code 128 cannot encode every character")
+}
+
+func TestDocumentFileName(t *testing.T) {
+	pattern := "Quote {{quote_number}}/draft"
+	template := &models.SystemDocumentTemplate{Name: "Quote", Label: "Quote PDF", FileName: &pattern}
+
+	assert.Equal(t, "Quote Q-1_draft.pdf", documentFileName(template, documentTestSchema, models.SObject{"quote_number": "Q-1"}))
+
+	template.FileName = nil
+	assert.Equal(t, "Quote PDF.pdf", documentFileName(template, documentTestSchema, models.SObject{}))
+}
@@ -0,0 +1,111 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitlement_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	account := h.CreateObject(t, "account", testharness.Field("name", constants.FieldTypeText))
+	accountLookup := testharness.Field("account_id", constants.FieldTypeLookup)
+	accountLookup.ReferenceTo = []string{account.APIName}
+	supportCase := h.CreateObject(t, "case", testharness.Field("subject", constants.FieldTypeText), accountLookup,
+		testharness.Field("entitlement_id", constants.FieldTypeText))
+
+	newService := func(required bool) *services.EntitlementService {
+		return services.NewEntitlementService(persistence.NewEntitlementRepository(h.DB.DB()), h.Services.TxManager,
+			h.Services.Metadata, h.Services.QuerySvc, h.Services.Permissions, services.EntitlementConfig{
+				AccountObject: account.APIName, CaseObject: supportCase.APIName, CaseAccountField: "account_id",
+				CaseField: "entitlement_id", Required: required,
+			})
+	}
+	svc := newService(false)
+	svc.RegisterHandlers(h.Services.EventBus)
+
+	acmeID := h.CreateRecord(t, account.APIName, models.SObject{"name": "Acme"})[constants.FieldID].(string)
+	otherID := h.CreateRecord(t, account.APIName, models.SObject{"name": "Other"})[constants.FieldID].(string)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	contract := &models.SystemContract{Name: "Support MSA", AccountID: acmeID, StartDate: today.AddDate(0, -1, 0)}
+	require.NoError(t, svc.CreateContract(ctx, contract, h.Admin))
+	t.Cleanup(func() { _ = svc.DeleteContract(ctx, contract.ID, h.Admin) })
+	assert.Equal(t, constants.ContractStatusDraft, contract.Status)
+
+	units := 2.0
+	gold := &models.SystemEntitlement{Name: "Gold", AccountID: acmeID, ContractID: &contract.ID, StartDate: today, TotalUnits: &units, IsActive: true}
+	require.NoError(t, svc.CreateEntitlement(ctx, gold, h.Admin))
+	t.Cleanup(func() { _ = svc.DeleteEntitlement(ctx, gold.ID, h.Admin) })
+	require.NotNil(t, gold.RemainingUnits)
+	assert.Equal(t, 2.0, *gold.RemainingUnits)
+	require.Error(t, svc.CreateEntitlement(ctx, &models.SystemEntitlement{Name: "x", AccountID: otherID, ContractID: &contract.ID, StartDate: today}, h.Admin),
+		"the contract belongs to another account")
+	require.Error(t, svc.DeleteContract(ctx, contract.ID, h.Admin), "the contract has an entitlement")
+
+	openCase := func(data models.SObject) (models.SObject, error) {
+		record, err := h.Services.Persistence.Insert(ctx, supportCase.APIName, data, h.Admin)
+		if err == nil {
+			id := record[constants.FieldID].(string)
+			t.Cleanup(func() { _ = h.Services.Persistence.Delete(ctx, supportCase.APIName, id, h.Admin) })
+		}
+		return record, err
+	}
+	remaining := func() float64 {
+		e, err := svc.GetEntitlement(ctx, gold.ID)
+		require.NoError(t, err)
+		return *e.RemainingUnits
+	}
+
+	_, err := openCase(models.SObject{"subject": "Printer", "entitlement_id": gold.ID})
+	require.Error(t, err, "the contract is not activated")
+	assert.Equal(t, 2.0, remaining())
+
+	contract.Status = constants.ContractStatusActivated
+	require.NoError(t, svc.UpdateContract(ctx, contract.ID, contract, h.Admin))
+
+	_, err = openCase(models.SObject{"subject": "Printer", "account_id": otherID, "entitlement_id": gold.ID})
+	require.Error(t, err, "the entitlement belongs to another account")
+
+	record, err := openCase(models.SObject{"subject": "Printer", "entitlement_id": gold.ID})
+	require.NoError(t, err)
+	assert.Equal(t, acmeID, record["account_id"], "the account comes from the entitlement")
+	assert.Equal(t, 1.0, remaining())
+
+	_, err = openCase(models.SObject{"subject": "No plan"})
+	require.NoError(t, err, "entitlements are optional")
+	assert.Equal(t, 1.0, remaining())
+
+	required := newService(true)
+	required.RegisterHandlers(h.Services.EventBus)
+	_, err = openCase(models.SObject{"subject": "Scanner", "account_id": otherID})
+	require.Error(t, err, "the other account has no entitlement")
+	record, err = openCase(models.SObject{"subject": "Scanner", "account_id": acmeID})
+	require.NoError(t, err)
+	assert.Equal(t, gold.ID, record["entitlement_id"])
+	assert.Equal(t, 0.0, remaining())
+
+	_, err = openCase(models.SObject{"subject": "Fax", "entitlement_id": gold.ID})
+	require.Error(t, err, "no units remain")
+
+	usage, err := svc.ListUsage(ctx, gold.ID)
+	require.NoError(t, err)
+	assert.Len(t, usage, 2)
+
+	more := 5.0
+	gold.TotalUnits = &more
+	require.NoError(t, svc.UpdateEntitlement(ctx, gold.ID, gold, h.Admin))
+	assert.Equal(t, 3.0, *gold.RemainingUnits, "two units were consumed")
+}
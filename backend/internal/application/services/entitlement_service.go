@@ -0,0 +1,531 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultEntitlementAccountObject is the object contracts and entitlements belong to
+	defaultEntitlementAccountObject = "account"
+	// defaultEntitlementCaseObject is the object whose new records consume entitlements
+	defaultEntitlementCaseObject = "case"
+	// defaultEntitlementCaseAccountField is the case field looking up the account
+	defaultEntitlementCaseAccountField = "account_id"
+	// defaultEntitlementCaseField is the case field naming the entitlement it consumes
+	defaultEntitlementCaseField = "entitlement_id"
+	// entitlementUnitsPerCase is what one case consumes from an entitlement with units
+	entitlementUnitsPerCase = 1.0
+)
+
+// EntitlementConfig names the account and case objects entitlements apply to
+type EntitlementConfig struct {
+	AccountObject    string
+	CaseObject       string
+	CaseAccountField string
+	CaseField        string
+	Required         bool // Cases must consume an entitlement; one of the account's is chosen when none is given
+}
+
+// EntitlementConfigFrom applies the entitlement settings over the default objects and fields
+func EntitlementConfigFrom(settings config.Entitlements) EntitlementConfig {
+	cfg := EntitlementConfig{
+		AccountObject:    defaultEntitlementAccountObject,
+		CaseObject:       defaultEntitlementCaseObject,
+		CaseAccountField: defaultEntitlementCaseAccountField,
+		CaseField:        defaultEntitlementCaseField,
+		Required:         settings.Required,
+	}
+	for _, setting := range []struct {
+		value  string
+		target *string
+	}{
+		{settings.AccountObject, &cfg.AccountObject},
+		{settings.CaseObject, &cfg.CaseObject},
+		{settings.CaseAccountField, &cfg.CaseAccountField},
+		{settings.CaseField, &cfg.CaseField},
+	} {
+		if value := strings.TrimSpace(setting.value); value != "" {
+			*setting.target = strings.ToLower(value)
+		}
+	}
+	return cfg
+}
+
+// EntitlementService manages the support contracts of accounts and the entitlements they
+// grant. Creating a case that names an entitlement verifies it is active, in effect today,
+// under an activated contract in term and has units left, then consumes a unit; the check,
+// the consumption and the case insert commit in one transaction.
+type EntitlementService struct {
+	repo        *persistence.EntitlementRepository
+	txManager   *persistence.TransactionManager
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	config      EntitlementConfig
+}
+
+// NewEntitlementService creates a new EntitlementService
+func NewEntitlementService(
+	repo *persistence.EntitlementRepository,
+	txManager *persistence.TransactionManager,
+	metadata *MetadataService,
+	query *QueryService,
+	permissions *PermissionService,
+	config EntitlementConfig,
+) *EntitlementService {
+	return &EntitlementService{
+		repo:        repo,
+		txManager:   txManager,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		config:      config,
+	}
+}
+
+// RegisterHandlers verifies and consumes entitlements as cases are created
+func (s *EntitlementService) RegisterHandlers(eventBus *EventBus) {
+	eventBus.Subscribe(events.RecordBeforeCreate, func(ctx context.Context, payload interface{}) error {
+		recordPayload, ok := payload.(RecordEventPayload)
+		if !ok || recordPayload.ObjectAPIName != s.config.CaseObject {
+			return nil
+		}
+		return s.consumeForCase(ctx, recordPayload.Record, recordPayload.CurrentUser)
+	})
+}
+
+// ListContracts returns the contracts of an account, or all of them when accountID is empty
+func (s *EntitlementService) ListContracts(ctx context.Context, accountID string) ([]*models.SystemContract, error) {
+	return s.repo.ListContracts(ctx, accountID)
+}
+
+// GetContract returns a contract by ID
+func (s *EntitlementService) GetContract(ctx context.Context, id string) (*models.SystemContract, error) {
+	contract, err := s.repo.GetContract(ctx, nil, id)
+	if err != nil {
+		return nil, err
+	}
+	if contract == nil {
+		return nil, errors.NewNotFoundError(constants.TableContract, id)
+	}
+	return contract, nil
+}
+
+// CreateContract saves a new contract owned by the current user. contract is updated in
+// place with the stored values.
+func (s *EntitlementService) CreateContract(ctx context.Context, contract *models.SystemContract, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	contract.ID = GenerateID()
+	contract.OwnerID = currentUser.ID
+	if err := normalizeContract(contract); err != nil {
+		return err
+	}
+	if err := s.checkAccount(ctx, contract.AccountID, currentUser); err != nil {
+		return err
+	}
+	return s.repo.InsertContract(ctx, contract)
+}
+
+// UpdateContract replaces the editable fields of a contract; the owner stays unless updates
+// names a new one. updates is replaced with the stored values.
+func (s *EntitlementService) UpdateContract(ctx context.Context, id string, updates *models.SystemContract, currentUser *models.UserSession) error {
+	existing, err := s.GetContract(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditEntitlement(existing.OwnerID, currentUser); err != nil {
+		return err
+	}
+	updated := *existing
+	updated.Name = updates.Name
+	updated.AccountID = updates.AccountID
+	updated.Status = updates.Status
+	updated.StartDate = updates.StartDate
+	updated.EndDate = updates.EndDate
+	updated.Description = updates.Description
+	if updates.OwnerID != "" {
+		updated.OwnerID = updates.OwnerID
+	}
+	if err := normalizeContract(&updated); err != nil {
+		return err
+	}
+	if updated.AccountID != existing.AccountID {
+		count, err := s.repo.CountContractEntitlements(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.NewValidationError(constants.FieldSysContract_AccountID, "the account of a contract with entitlements cannot change")
+		}
+		if err := s.checkAccount(ctx, updated.AccountID, currentUser); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.UpdateContract(ctx, &updated); err != nil {
+		return err
+	}
+	*updates = updated
+	return nil
+}
+
+// DeleteContract removes a contract without entitlements
+func (s *EntitlementService) DeleteContract(ctx context.Context, id string, currentUser *models.UserSession) error {
+	contract, err := s.GetContract(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditEntitlement(contract.OwnerID, currentUser); err != nil {
+		return err
+	}
+	count, err := s.repo.CountContractEntitlements(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.NewValidationError(constants.FieldSysEntitlement_ContractID, fmt.Sprintf("contract has %d entitlements", count))
+	}
+	return s.repo.DeleteContract(ctx, id)
+}
+
+// ListEntitlements returns the entitlements of an account, or all of them when accountID is empty
+func (s *EntitlementService) ListEntitlements(ctx context.Context, accountID string) ([]*models.SystemEntitlement, error) {
+	return s.repo.ListEntitlements(ctx, accountID)
+}
+
+// GetEntitlement returns an entitlement by ID
+func (s *EntitlementService) GetEntitlement(ctx context.Context, id string) (*models.SystemEntitlement, error) {
+	entitlement, err := s.repo.GetEntitlement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entitlement == nil {
+		return nil, errors.NewNotFoundError(constants.TableEntitlement, id)
+	}
+	return entitlement, nil
+}
+
+// ListUsage returns the cases that consumed an entitlement, most recent first
+func (s *EntitlementService) ListUsage(ctx context.Context, id string) ([]*models.SystemEntitlementUsage, error) {
+	if _, err := s.GetEntitlement(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.ListUsage(ctx, id)
+}
+
+// CreateEntitlement saves a new entitlement owned by the current user, with all of its
+// units remaining. entitlement is updated in place with the stored values.
+func (s *EntitlementService) CreateEntitlement(ctx context.Context, entitlement *models.SystemEntitlement, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	entitlement.ID = GenerateID()
+	entitlement.OwnerID = currentUser.ID
+	entitlement.RemainingUnits = entitlement.TotalUnits
+	if err := normalizeEntitlement(entitlement); err != nil {
+		return err
+	}
+	if err := s.checkContract(ctx, entitlement); err != nil {
+		return err
+	}
+	if err := s.checkAccount(ctx, entitlement.AccountID, currentUser); err != nil {
+		return err
+	}
+	return s.repo.InsertEntitlement(ctx, entitlement)
+}
+
+// UpdateEntitlement replaces the editable fields of an entitlement. Changing its total
+// units changes its remaining units by as much; units already consumed stay consumed.
+// updates is replaced with the stored values.
+func (s *EntitlementService) UpdateEntitlement(ctx context.Context, id string, updates *models.SystemEntitlement, currentUser *models.UserSession) error {
+	var updated models.SystemEntitlement
+	err := s.txManager.WithRetry(func(tx *sql.Tx) error {
+		existing, err := s.repo.GetEntitlementLock(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return errors.NewNotFoundError(constants.TableEntitlement, id)
+		}
+		if err := checkCanEditEntitlement(existing.OwnerID, currentUser); err != nil {
+			return err
+		}
+		if updates.AccountID != existing.AccountID {
+			return errors.NewValidationError(constants.FieldSysEntitlement_AccountID, "the account of an entitlement cannot change")
+		}
+		updated = *existing
+		updated.Name = updates.Name
+		updated.ContractID = updates.ContractID
+		updated.Type = updates.Type
+		updated.StartDate = updates.StartDate
+		updated.EndDate = updates.EndDate
+		updated.TotalUnits = updates.TotalUnits
+		updated.RemainingUnits = adjustRemainingUnits(existing.TotalUnits, existing.RemainingUnits, updates.TotalUnits)
+		updated.IsActive = updates.IsActive
+		if updates.OwnerID != "" {
+			updated.OwnerID = updates.OwnerID
+		}
+		if err := normalizeEntitlement(&updated); err != nil {
+			return err
+		}
+		if err := s.checkContract(ctx, &updated); err != nil {
+			return err
+		}
+		return s.repo.UpdateEntitlement(ctx, tx, &updated)
+	}, 3)
+	if err != nil {
+		return err
+	}
+	*updates = updated
+	return nil
+}
+
+// DeleteEntitlement removes an entitlement and its usage
+func (s *EntitlementService) DeleteEntitlement(ctx context.Context, id string, currentUser *models.UserSession) error {
+	entitlement, err := s.GetEntitlement(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditEntitlement(entitlement.OwnerID, currentUser); err != nil {
+		return err
+	}
+	return s.repo.DeleteEntitlement(ctx, id)
+}
+
+// checkContract verifies an entitlement's contract exists and is for the same account
+func (s *EntitlementService) checkContract(ctx context.Context, entitlement *models.SystemEntitlement) error {
+	if entitlement.ContractID == nil {
+		return nil
+	}
+	contract, err := s.repo.GetContract(ctx, nil, *entitlement.ContractID)
+	if err != nil {
+		return err
+	}
+	if contract == nil {
+		return errors.NewNotFoundError(constants.TableContract, *entitlement.ContractID)
+	}
+	if contract.AccountID != entitlement.AccountID {
+		return errors.NewValidationError(constants.FieldSysEntitlement_ContractID, "contract belongs to another account")
+	}
+	return nil
+}
+
+// checkAccount verifies the user can read an account
+func (s *EntitlementService) checkAccount(ctx context.Context, accountID string, currentUser *models.UserSession) error {
+	schema := s.metadata.GetSchema(ctx, s.config.AccountObject)
+	if schema == nil {
+		return errors.NewNotFoundError("Object Metadata", s.config.AccountObject)
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{accountID}, currentUser)
+	if err != nil {
+		return errors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return errors.NewNotFoundError(schema.APIName, accountID)
+	}
+	return nil
+}
+
+// consumeForCase verifies the entitlement a new case names, or picks one of its account's
+// when entitlements are required, and consumes a unit from it. It runs in the case insert's
+// transaction, so a failed insert gives the unit back.
+func (s *EntitlementService) consumeForCase(ctx context.Context, record models.SObject, currentUser *models.UserSession) error {
+	entitlementID := record.GetString(s.config.CaseField)
+	if entitlementID == "" && !s.config.Required {
+		return nil
+	}
+	tx := s.txManager.ExtractTx(ctx)
+	if tx == nil {
+		return fmt.Errorf("transaction required to consume an entitlement")
+	}
+	today := time.Now().Format(time.DateOnly)
+	accountID := record.GetString(s.config.CaseAccountField)
+
+	var entitlement *models.SystemEntitlement
+	if entitlementID != "" {
+		var err error
+		entitlement, err = s.repo.GetEntitlementLock(ctx, tx, entitlementID)
+		if err != nil {
+			return err
+		}
+		if entitlement == nil {
+			return errors.NewValidationError(s.config.CaseField, fmt.Sprintf("entitlement %s not found", entitlementID))
+		}
+		if accountID != "" && entitlement.AccountID != accountID {
+			return errors.NewValidationError(s.config.CaseField, "entitlement belongs to another account")
+		}
+		if err := s.verifyInTx(ctx, tx, entitlement, today); err != nil {
+			return err
+		}
+	} else {
+		if accountID == "" {
+			return errors.NewValidationError(s.config.CaseField, "an entitlement is required")
+		}
+		ids, err := s.repo.ActiveEntitlementIDs(ctx, tx, accountID, today)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			candidate, err := s.repo.GetEntitlementLock(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+			if candidate != nil && s.verifyInTx(ctx, tx, candidate, today) == nil {
+				entitlement = candidate
+				break
+			}
+		}
+		if entitlement == nil {
+			return errors.NewValidationError(s.config.CaseField, "the account has no entitlement in effect with units remaining")
+		}
+		record[s.config.CaseField] = entitlement.ID
+	}
+	if accountID == "" && s.metadata.GetField(s.config.CaseObject, s.config.CaseAccountField) != nil {
+		record[s.config.CaseAccountField] = entitlement.AccountID
+	}
+
+	consumedBy := ""
+	if currentUser != nil {
+		consumedBy = currentUser.ID
+	}
+	return s.repo.Consume(ctx, tx, entitlement, &models.SystemEntitlementUsage{
+		ID:            GenerateID(),
+		EntitlementID: entitlement.ID,
+		ObjectAPIName: s.config.CaseObject,
+		RecordID:      record.GetString(constants.FieldID),
+		Units:         entitlementUnitsPerCase,
+		ConsumedByID:  consumedBy,
+	})
+}
+
+// verifyInTx checks an entitlement and its contract allow a case today
+func (s *EntitlementService) verifyInTx(ctx context.Context, tx *sql.Tx, entitlement *models.SystemEntitlement, today string) error {
+	var contract *models.SystemContract
+	if entitlement.ContractID != nil {
+		var err error
+		contract, err = s.repo.GetContract(ctx, tx, *entitlement.ContractID)
+		if err != nil {
+			return err
+		}
+	}
+	return verifyEntitlement(entitlement, contract, today, entitlementUnitsPerCase, s.config.CaseField)
+}
+
+// verifyEntitlement checks an entitlement is active, in effect on today (YYYY-MM-DD) and has
+// units left, and that its contract, if any, is activated and in term
+func verifyEntitlement(e *models.SystemEntitlement, contract *models.SystemContract, today string, units float64, field string) error {
+	if !e.IsActive {
+		return errors.NewValidationError(field, fmt.Sprintf("entitlement %s is not active", e.Name))
+	}
+	if !inDateRange(e.StartDate, e.EndDate, today) {
+		return errors.NewValidationError(field, fmt.Sprintf("entitlement %s is not in effect on %s", e.Name, today))
+	}
+	if e.RemainingUnits != nil && *e.RemainingUnits < units {
+		return errors.NewValidationError(field, fmt.Sprintf("entitlement %s has no units remaining", e.Name))
+	}
+	if contract != nil {
+		if contract.Status != constants.ContractStatusActivated {
+			return errors.NewValidationError(field, fmt.Sprintf("contract %s of entitlement %s is not activated", contract.Name, e.Name))
+		}
+		if !inDateRange(contract.StartDate, contract.EndDate, today) {
+			return errors.NewValidationError(field, fmt.Sprintf("contract %s of entitlement %s is not in term on %s", contract.Name, e.Name, today))
+		}
+	}
+	return nil
+}
+
+// inDateRange reports whether a day (YYYY-MM-DD) falls between start and an optional end, inclusive
+func inDateRange(start time.Time, end *time.Time, day string) bool {
+	if day < start.Format(time.DateOnly) {
+		return false
+	}
+	return end == nil || day <= end.Format(time.DateOnly)
+}
+
+// adjustRemainingUnits moves remaining units by the change in total units; unlimited
+// entitlements (no total) have no remaining units
+func adjustRemainingUnits(oldTotal, oldRemaining, newTotal *float64) *float64 {
+	if newTotal == nil {
+		return nil
+	}
+	if oldTotal == nil || oldRemaining == nil {
+		remaining := *newTotal
+		return &remaining
+	}
+	remaining := *oldRemaining + *newTotal - *oldTotal
+	return &remaining
+}
+
+// normalizeContract validates a contract, defaulting its status
+func normalizeContract(c *models.SystemContract) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return errors.NewValidationError(constants.FieldSysContract_Name, "name is required")
+	}
+	if strings.TrimSpace(c.AccountID) == "" {
+		return errors.NewValidationError(constants.FieldSysContract_AccountID, "account is required")
+	}
+	if c.Status == "" {
+		c.Status = constants.ContractStatusDraft
+	}
+	valid := false
+	for _, status := range constants.ContractStatuses {
+		if strings.EqualFold(c.Status, status) {
+			c.Status, valid = status, true
+		}
+	}
+	if !valid {
+		return errors.NewValidationError(constants.FieldSysContract_Status,
+			fmt.Sprintf("status must be one of %s", strings.Join(constants.ContractStatuses, ", ")))
+	}
+	if c.StartDate.IsZero() {
+		return errors.NewValidationError(constants.FieldSysContract_StartDate, "start date is required")
+	}
+	if c.EndDate != nil && c.EndDate.Before(c.StartDate) {
+		return errors.NewValidationError(constants.FieldSysContract_EndDate, "end date is before start date")
+	}
+	return nil
+}
+
+// normalizeEntitlement validates an entitlement
+func normalizeEntitlement(e *models.SystemEntitlement) error {
+	e.Name = strings.TrimSpace(e.Name)
+	if e.Name == "" {
+		return errors.NewValidationError(constants.FieldSysEntitlement_Name, "name is required")
+	}
+	if strings.TrimSpace(e.AccountID) == "" {
+		return errors.NewValidationError(constants.FieldSysEntitlement_AccountID, "account is required")
+	}
+	if e.StartDate.IsZero() {
+		return errors.NewValidationError(constants.FieldSysEntitlement_StartDate, "start date is required")
+	}
+	if e.EndDate != nil && e.EndDate.Before(e.StartDate) {
+		return errors.NewValidationError(constants.FieldSysEntitlement_EndDate, "end date is before start date")
+	}
+	if e.TotalUnits != nil && *e.TotalUnits < 0 {
+		return errors.NewValidationError(constants.FieldSysEntitlement_TotalUnits, "total units cannot be negative")
+	}
+	return nil
+}
+
+func checkCanEditEntitlement(ownerID string, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if currentUser.IsSystemAdmin || constants.IsSuperUser(currentUser.ProfileID) || ownerID == currentUser.ID {
+		return nil
+	}
+	return errors.NewPermissionError("edit", "support plan")
+}
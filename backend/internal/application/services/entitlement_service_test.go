@@ -0,0 +1,85 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEntitlement(t *testing.T) {
+	day := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		return d
+	}
+	end := day("2026-12-31")
+	units := 1.0
+	entitlement := &models.SystemEntitlement{Name: "Gold", StartDate: day("2026-01-01"), EndDate: &end, RemainingUnits: &units, IsActive: true}
+	contract := &models.SystemContract{Name: "MSA", Status: constants.ContractStatusActivated, StartDate: day("2026-01-01")}
+
+	assert.NoError(t, verifyEntitlement(entitlement, contract, "2026-10-18", 1, "entitlement_id"))
+	assert.NoError(t, verifyEntitlement(entitlement, nil, "2026-12-31", 1, "entitlement_id"), "the end date is included")
+	assert.Error(t, verifyEntitlement(entitlement, nil, "2027-01-01", 1, "entitlement_id"), "expired")
+	assert.Error(t, verifyEntitlement(entitlement, nil, "2025-12-31", 1, "entitlement_id"), "not yet in effect")
+	assert.Error(t, verifyEntitlement(entitlement, nil, "2026-10-18", 2, "entitlement_id"), "not enough units")
+
+	entitlement.RemainingUnits = nil
+	assert.NoError(t, verifyEntitlement(entitlement, nil, "2026-10-18", 2, "entitlement_id"), "no total means unlimited")
+
+	contract.Status = constants.ContractStatusDraft
+	assert.Error(t, verifyEntitlement(entitlement, contract, "2026-10-18", 1, "entitlement_id"))
+	contract.Status = constants.ContractStatusActivated
+	contractEnd := day("2026-06-30")
+	contract.EndDate = &contractEnd
+	assert.Error(t, verifyEntitlement(entitlement, contract, "2026-10-18", 1, "entitlement_id"), "the contract is out of term")
+
+	entitlement.IsActive = false
+	assert.Error(t, verifyEntitlement(entitlement, nil, "2026-10-18", 1, "entitlement_id"))
+}
+
+func TestAdjustRemainingUnits(t *testing.T) {
+	ptr := func(f float64) *float64 { return &f }
+	assert.Nil(t, adjustRemainingUnits(ptr(10), ptr(4), nil))
+	assert.Equal(t, 10.0, *adjustRemainingUnits(nil, nil, ptr(10)))
+	assert.Equal(t, 9.0, *adjustRemainingUnits(ptr(10), ptr(4), ptr(15)), "consumed units stay consumed")
+	assert.Equal(t, -1.0, *adjustRemainingUnits(ptr(10), ptr(4), ptr(5)))
+}
+
+func TestNormalizeContract(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &models.SystemContract{Name: " MSA ", AccountID: "a1", StartDate: start}
+	require.NoError(t, normalizeContract(c))
+	assert.Equal(t, "MSA", c.Name)
+	assert.Equal(t, constants.ContractStatusDraft, c.Status)
+
+	c.Status = "activated"
+	require.NoError(t, normalizeContract(c))
+	assert.Equal(t, constants.ContractStatusActivated, c.Status)
+
+	before := start.AddDate(0, 0, -1)
+	for name, invalid := range map[string]*models.SystemContract{
+		"name":    {AccountID: "a1", StartDate: start},
+		"account": {Name: "x", StartDate: start},
+		"status":  {Name: "x", AccountID: "a1", StartDate: start, Status: "Expired"},
+		"start":   {Name: "x", AccountID: "a1"},
+		"end":     {Name: "x", AccountID: "a1", StartDate: start, EndDate: &before},
+	} {
+		assert.Error(t, normalizeContract(invalid), name)
+	}
+}
+
+func TestEntitlementConfigFrom(t *testing.T) {
+	assert.Equal(t, EntitlementConfig{AccountObject: "account", CaseObject: "case", CaseAccountField: "account_id", CaseField: "entitlement_id"},
+		EntitlementConfigFrom(config.Entitlements{}))
+
+	cfg := EntitlementConfigFrom(config.Entitlements{CaseObject: " Ticket ", CaseField: "Plan_ID", Required: true})
+	assert.Equal(t, "ticket", cfg.CaseObject)
+	assert.Equal(t, "plan_id", cfg.CaseField)
+	assert.Equal(t, "account_id", cfg.CaseAccountField)
+	assert.True(t, cfg.Required)
+}
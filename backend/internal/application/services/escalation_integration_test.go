@@ -0,0 +1,34 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalationClaimLevel_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	repo := persistence.NewEscalationRepository(h.DB.DB())
+	now := time.Now().UTC()
+
+	_, claimed, err := repo.ClaimLevel(ctx, "overdue_cases", "case", "rec-1", 1, now)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	_, claimed, err = repo.ClaimLevel(ctx, "overdue_cases", "case", "rec-1", 1, now)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a level is claimed once")
+
+	_, claimed, err = repo.ClaimLevel(ctx, "overdue_cases", "case", "rec-1", 2, now)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// escalationBatchSize caps the records escalated per rule level and scheduler tick
+const escalationBatchSize = 100
+
+// EscalationService monitors records that keep matching an escalation rule's criteria,
+// e.g. open cases, and takes each level's actions once the record has matched for the
+// level's business time. The scheduler calls Run on every tick.
+type EscalationService struct {
+	repo          *persistence.EscalationRepository
+	metadata      *MetadataService
+	query         *QueryService
+	persistence   *PersistenceService
+	notifications *NotificationService
+	flows         *FlowExecutor
+	sla           *SLAService
+}
+
+// NewEscalationService creates a new EscalationService
+func NewEscalationService(
+	repo *persistence.EscalationRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	persistence *PersistenceService,
+	notifications *NotificationService,
+	flows *FlowExecutor,
+	sla *SLAService,
+) *EscalationService {
+	return &EscalationService{
+		repo:          repo,
+		metadata:      metadata,
+		query:         query,
+		persistence:   persistence,
+		notifications: notifications,
+		flows:         flows,
+		sla:           sla,
+	}
+}
+
+// ==================== Rules ====================
+
+// GetRules returns all escalation rules
+func (s *EscalationService) GetRules(ctx context.Context) ([]*models.EscalationRule, error) {
+	return s.repo.GetRules(ctx)
+}
+
+// GetRule returns an escalation rule by name
+func (s *EscalationService) GetRule(ctx context.Context, name string) (*models.EscalationRule, error) {
+	rule, err := s.repo.FindRule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, errors.NewNotFoundError("EscalationRule", name)
+	}
+	return rule, nil
+}
+
+// CreateRule validates and stores an escalation rule
+func (s *EscalationService) CreateRule(ctx context.Context, rule *models.EscalationRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if !developerNamePattern.MatchString(rule.Name) {
+		return errors.NewValidationError(constants.FieldSysEscalationRule_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(rule.Label) == "" {
+		rule.Label = rule.Name
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindRule(ctx, rule.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("EscalationRule", constants.FieldSysEscalationRule_Name, rule.Name)
+	}
+
+	rule.ID = GenerateID()
+	return s.repo.InsertRule(ctx, rule)
+}
+
+// UpdateRule replaces the settings of an escalation rule; its name cannot change because the
+// escalation log references it. Levels already taken are not repeated.
+func (s *EscalationService) UpdateRule(ctx context.Context, name string, rule *models.EscalationRule) (*models.EscalationRule, error) {
+	existing, err := s.GetRule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = existing.ID
+	rule.Name = existing.Name
+	rule.CreatedDate = existing.CreatedDate
+	if strings.TrimSpace(rule.Label) == "" {
+		rule.Label = existing.Label
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// DeleteRule deletes an escalation rule and its log
+func (s *EscalationService) DeleteRule(ctx context.Context, name string) error {
+	rule, err := s.GetRule(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteRule(ctx, rule)
+}
+
+// validateRule checks an escalation rule against the object's schema, business hours and flows
+func (s *EscalationService) validateRule(ctx context.Context, rule *models.EscalationRule) error {
+	schema := s.metadata.GetSchema(ctx, rule.ObjectAPIName)
+	if schema == nil {
+		return errors.NewNotFoundError("Object", rule.ObjectAPIName)
+	}
+	rule.ObjectAPIName = schema.APIName
+
+	if strings.TrimSpace(rule.StartField) == "" {
+		rule.StartField = constants.FieldCreatedDate
+	}
+	start := FindField(schema, rule.StartField)
+	if start == nil || start.Type != constants.FieldTypeDateTime {
+		return errors.NewValidationError(constants.FieldSysEscalationRule_StartField, fmt.Sprintf("%q is not a date/time field of %s", rule.StartField, schema.APIName))
+	}
+	rule.StartField = start.APIName
+
+	if rule.Criteria != "" {
+		if _, _, err := formula.ToSQL(rule.Criteria); err != nil {
+			return errors.NewValidationError(constants.FieldSysEscalationRule_Criteria, err.Error())
+		}
+	}
+	if rule.BusinessHours != "" {
+		bh, err := s.sla.GetBusinessHoursByName(ctx, rule.BusinessHours)
+		if err != nil {
+			return err
+		}
+		rule.BusinessHours = bh.Name
+	}
+
+	if err := normalizeEscalationActions(rule.Actions); err != nil {
+		return err
+	}
+	hasOwner := FindField(schema, constants.FieldOwnerID) != nil
+	for _, a := range rule.Actions {
+		if (a.ReassignTo != "" || a.NotifyOwner) && !hasOwner {
+			return errors.NewValidationError(constants.FieldSysEscalationRule_Actions, fmt.Sprintf("%s has no owner to reassign or notify", schema.APIName))
+		}
+		if a.FlowID != "" && s.metadata.GetFlow(ctx, a.FlowID) == nil {
+			return errors.NewNotFoundError("Flow", a.FlowID)
+		}
+	}
+	return nil
+}
+
+// normalizeEscalationActions sorts actions into escalation levels and checks each level
+// waits longer than the previous one and does something
+func normalizeEscalationActions(actions []models.EscalationAction) error {
+	if len(actions) == 0 {
+		return errors.NewValidationError(constants.FieldSysEscalationRule_Actions, "At least one action is required")
+	}
+	sort.SliceStable(actions, func(i, j int) bool { return actions[i].AfterMinutes < actions[j].AfterMinutes })
+	for i, a := range actions {
+		if a.AfterMinutes <= 0 {
+			return errors.NewValidationError(constants.FieldSysEscalationRule_Actions, "after_minutes must be positive")
+		}
+		if i > 0 && a.AfterMinutes == actions[i-1].AfterMinutes {
+			return errors.NewValidationError(constants.FieldSysEscalationRule_Actions, fmt.Sprintf("two actions run after %d minutes", a.AfterMinutes))
+		}
+		if a.ReassignTo == "" && !a.NotifyOwner && len(a.NotifyUserIDs) == 0 && a.FlowID == "" {
+			return errors.NewValidationError(constants.FieldSysEscalationRule_Actions, fmt.Sprintf("the action after %d minutes does nothing", a.AfterMinutes))
+		}
+	}
+	return nil
+}
+
+// ==================== Monitor ====================
+
+// Run escalates the records of every active rule that reached a new level by now. Each
+// level is taken once per record; the log claim keeps concurrent servers from repeating it.
+func (s *EscalationService) Run(ctx context.Context, now time.Time) {
+	rules, err := s.repo.FindActiveRules(ctx)
+	if err != nil {
+		log.Printf("⚠️ [Escalation] Failed to load rules: %v", err)
+		return
+	}
+	for _, rule := range rules {
+		if err := s.runRule(ctx, rule, now); err != nil {
+			log.Printf("⚠️ [Escalation] Rule %s failed: %v", rule.Name, err)
+		}
+	}
+}
+
+func (s *EscalationService) runRule(ctx context.Context, rule *models.EscalationRule, now time.Time) error {
+	schema := s.metadata.GetSchema(ctx, rule.ObjectAPIName)
+	if schema == nil {
+		return fmt.Errorf("object %s not found", rule.ObjectAPIName)
+	}
+	cal, err := s.sla.calendarByName(ctx, rule.BusinessHours)
+	if err != nil {
+		return err
+	}
+	var fields []string
+	if FindField(schema, constants.FieldOwnerID) != nil {
+		fields = append(fields, constants.FieldOwnerID)
+	}
+
+	for i, action := range rule.Actions {
+		level := i + 1
+		threshold := time.Duration(action.AfterMinutes) * time.Minute
+		// Business time never exceeds wall time, so the wall-clock cutoff only narrows the scan
+		records, err := s.repo.FindCandidates(ctx, schema, rule, level, fields, now.Add(-threshold), escalationBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			start, ok := record[rule.StartField].(time.Time)
+			if !ok {
+				continue
+			}
+			// Records are oldest first, so the rest are younger still
+			if cal.Duration(start, now) < threshold {
+				break
+			}
+			s.escalate(ctx, schema, rule, level, record, now)
+		}
+	}
+	return nil
+}
+
+// escalate claims a level for a record and takes its action, logging any failure
+func (s *EscalationService) escalate(ctx context.Context, schema *models.ObjectMetadata, rule *models.EscalationRule, level int, record models.SObject, now time.Time) {
+	recordID := record.GetString(constants.FieldID)
+	logID, claimed, err := s.repo.ClaimLevel(ctx, rule.Name, schema.APIName, recordID, level, now)
+	if err != nil {
+		log.Printf("⚠️ [Escalation] Failed to log level %d of %s for %s/%s: %v", level, rule.Name, schema.APIName, recordID, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+	log.Printf("🚨 [Escalation] %s/%s reached level %d of %s", schema.APIName, recordID, level, rule.Name)
+
+	if err := s.takeAction(ctx, schema, rule, level, record); err != nil {
+		log.Printf("⚠️ [Escalation] Level %d of %s failed for %s/%s: %v", level, rule.Name, schema.APIName, recordID, err)
+		if err := s.repo.SetLogError(ctx, logID, err.Error()); err != nil {
+			log.Printf("⚠️ [Escalation] Failed to record error: %v", err)
+		}
+	}
+}
+
+// takeAction reassigns the record, notifies its owner and listed users, then runs the level's flow
+func (s *EscalationService) takeAction(ctx context.Context, schema *models.ObjectMetadata, rule *models.EscalationRule, level int, record models.SObject) error {
+	action := rule.Actions[level-1]
+	recordID := record.GetString(constants.FieldID)
+	systemUser := &models.UserSession{
+		ID:            "system",
+		Name:          "Escalation Rules",
+		ProfileID:     constants.ProfileSystemAdmin,
+		IsSystemAdmin: true,
+	}
+
+	owner := record.GetString(constants.FieldOwnerID)
+	if action.ReassignTo != "" && action.ReassignTo != owner {
+		if err := s.persistence.Update(ctx, schema.APIName, recordID, models.SObject{constants.FieldOwnerID: action.ReassignTo}, systemUser); err != nil {
+			return fmt.Errorf("reassign: %w", err)
+		}
+		owner = action.ReassignTo
+	}
+
+	var recipients []string
+	if action.NotifyOwner && owner != "" {
+		recipients = append(recipients, owner)
+	}
+	for _, id := range action.NotifyUserIDs {
+		if !ContainsStringIgnoreCase(recipients, id) {
+			recipients = append(recipients, id)
+		}
+	}
+	for _, recipient := range recipients {
+		notification := models.SystemNotification{
+			ID:               GenerateID(),
+			RecipientID:      recipient,
+			Title:            fmt.Sprintf("Escalated: %s", rule.Label),
+			Body:             fmt.Sprintf("A %s record reached escalation level %d.", schema.Label, level),
+			Link:             fmt.Sprintf("/object/%s/%s", schema.APIName, recordID),
+			NotificationType: "escalation",
+			CreatedDate:      time.Now(),
+		}
+		if err := s.notifications.CreateNotification(ctx, notification, systemUser); err != nil {
+			return fmt.Errorf("notify %s: %w", recipient, err)
+		}
+	}
+
+	if action.FlowID != "" {
+		rows, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, systemUser)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := s.flows.ExecuteFlowForRecord(ctx, action.FlowID, schema.APIName, rows[0], systemUser); err != nil {
+			return fmt.Errorf("flow %s: %w", action.FlowID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEscalationActions(t *testing.T) {
+	actions := []models.EscalationAction{
+		{AfterMinutes: 240, ReassignTo: "manager"},
+		{AfterMinutes: 60, NotifyOwner: true},
+	}
+	require.NoError(t, normalizeEscalationActions(actions))
+	assert.Equal(t, 60, actions[0].AfterMinutes)
+	assert.Equal(t, "manager", actions[1].ReassignTo)
+
+	assert.Error(t, normalizeEscalationActions(nil))
+	assert.Error(t, normalizeEscalationActions([]models.EscalationAction{{AfterMinutes: 0, NotifyOwner: true}}))
+	assert.Error(t, normalizeEscalationActions([]models.EscalationAction{{AfterMinutes: 30}}))
+	assert.Error(t, normalizeEscalationActions([]models.EscalationAction{
+		{AfterMinutes: 30, NotifyOwner: true},
+		{AfterMinutes: 30, FlowID: "f1"},
+	}))
+}
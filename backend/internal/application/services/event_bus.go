@@ -21,6 +21,8 @@ type RecordEventPayload struct {
 	Record        models.SObject      `json:"record"`
 	OldRecord     *models.SObject     `json:"old_record,omitempty"`
 	CurrentUser   *models.UserSession `json:"current_user,omitempty"`
+	Triggers      []TriggerFrame      `json:"triggers,omitempty"`       // Automation that caused the event
+	SuppressFlows bool                `json:"suppress_flows,omitempty"` // The save was made with flows suppressed
 }
 
 // PlatformEvent represents a platform event
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/pkg/extension"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ExtensionService runs the save hooks of installed extensions (see package extension).
+// Their routes and MCP tools are mounted by the server.
+type ExtensionService struct {
+	registry *extension.Registry
+}
+
+// NewExtensionService creates an ExtensionService over the extensions in registry
+func NewExtensionService(registry *extension.Registry) *ExtensionService {
+	return &ExtensionService{registry: registry}
+}
+
+// Registry returns the installed extensions
+func (s *ExtensionService) Registry() *extension.Registry {
+	return s.registry
+}
+
+// List describes the installed extensions
+func (s *ExtensionService) List() []extension.Info {
+	return s.registry.Extensions()
+}
+
+// RegisterHandlers runs before save hooks on the synchronous before-save events, inside the
+// save transaction, and after save hooks on the after-commit record events. Hooks are
+// looked up on every event, so extensions installed later are hooked as well.
+func (s *ExtensionService) RegisterHandlers(eventBus *EventBus) {
+	before := func(operation string) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			return s.registry.RunBeforeSave(ctx, saveEvent(operation, recordPayload), constants.IsSystemTable(recordPayload.ObjectAPIName))
+		}
+	}
+	after := func(operation string) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			// Extension failures must not fail the outbox event (flows share the same dispatch)
+			for _, err := range s.registry.RunAfterSave(ctx, saveEvent(operation, recordPayload), constants.IsSystemTable(recordPayload.ObjectAPIName)) {
+				log.Printf("⚠️ [Extensions] After save hook failed for %s/%s: %v", recordPayload.ObjectAPIName, recordPayload.Record.GetString(constants.FieldID), err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordBeforeCreate, before(extension.OperationCreate))
+	eventBus.Subscribe(events.RecordBeforeUpdate, before(extension.OperationUpdate))
+	eventBus.Subscribe(events.RecordBeforeDelete, before(extension.OperationDelete))
+	eventBus.Subscribe(events.RecordCreated, after(extension.OperationCreate))
+	eventBus.Subscribe(events.RecordUpdated, after(extension.OperationUpdate))
+	eventBus.Subscribe(events.RecordDeleted, after(extension.OperationDelete))
+}
+
+// saveEvent is what save hooks see of a record event. The record is shared, so changes
+// made by before save hooks are saved.
+func saveEvent(operation string, payload RecordEventPayload) *extension.SaveEvent {
+	var oldRecord models.SObject
+	if payload.OldRecord != nil {
+		oldRecord = *payload.OldRecord
+	}
+	return &extension.SaveEvent{
+		Operation:     operation,
+		ObjectAPIName: payload.ObjectAPIName,
+		Record:        payload.Record,
+		OldRecord:     oldRecord,
+		User:          payload.CurrentUser,
+	}
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/pkg/extension"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type hookExtension func(r *extension.Registrar) error
+
+func (hookExtension) Name() string                            { return "hooks" }
+func (e hookExtension) Register(r *extension.Registrar) error { return e(r) }
+
+func TestExtensionService_SaveHooks(t *testing.T) {
+	registry := extension.NewRegistry()
+	eventBus := NewEventBus()
+	NewExtensionService(registry).RegisterHandlers(eventBus)
+
+	var after []*extension.SaveEvent
+	require.NoError(t, registry.Install(hookExtension(func(r *extension.Registrar) error {
+		r.BeforeSave("lead", func(ctx context.Context, e *extension.SaveEvent) error {
+			if e.Operation == extension.OperationDelete {
+				return errors.New("leads are never deleted")
+			}
+			e.Record["rating"] = "Hot"
+			return nil
+		})
+		r.AfterSave("lead", func(ctx context.Context, e *extension.SaveEvent) error {
+			after = append(after, e)
+			return errors.New("only logged")
+		})
+		return nil
+	}), extension.SourceBuiltin), "hooks installed after the subscription still run")
+
+	record := models.SObject{"id": "lead-1"}
+	old := models.SObject{"id": "lead-1", "rating": "Cold"}
+	user := &models.UserSession{ID: "u1"}
+	require.NoError(t, eventBus.Publish(context.Background(), events.RecordBeforeUpdate, RecordEventPayload{ObjectAPIName: "lead", Record: record, OldRecord: &old, CurrentUser: user}))
+	assert.Equal(t, "Hot", record["rating"])
+
+	err := eventBus.Publish(context.Background(), events.RecordBeforeDelete, RecordEventPayload{ObjectAPIName: "lead", Record: record})
+	assert.ErrorContains(t, err, "leads are never deleted")
+
+	require.NoError(t, eventBus.Publish(context.Background(), events.RecordUpdated, RecordEventPayload{ObjectAPIName: "lead", Record: record, OldRecord: &old, CurrentUser: user}))
+	require.Len(t, after, 1)
+	assert.Equal(t, extension.OperationUpdate, after[0].Operation)
+	assert.Equal(t, "Cold", after[0].OldRecord["rating"])
+	assert.Equal(t, user, after[0].User)
+}
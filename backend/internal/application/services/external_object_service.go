@@ -0,0 +1,563 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/external"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/backend/pkg/secrets"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultExternalIDField = "id"
+	defaultExternalMaxRows = 1000
+	maxExternalMaxRows     = 10000
+	defaultQueryLimit      = 20 // Matches QueryRepository.Find
+)
+
+// ExternalObjectService manages external objects: read-only objects whose records stay in
+// another system and are fetched through an adapter when queried, so remote data such as ERP
+// invoices can be listed and looked up alongside native records without syncing it.
+type ExternalObjectService struct {
+	repo     *persistence.ExternalObjectRepository
+	metadata *MetadataService
+	callouts *CalloutService
+	adapters *external.Registry
+	formula  *formula.Engine
+}
+
+// NewExternalObjectService creates a new ExternalObjectService
+func NewExternalObjectService(repo *persistence.ExternalObjectRepository, metadata *MetadataService, callouts *CalloutService) *ExternalObjectService {
+	return &ExternalObjectService{
+		repo:     repo,
+		metadata: metadata,
+		callouts: callouts,
+		adapters: external.NewRegistry(callouts),
+		formula:  formula.NewEngine(),
+	}
+}
+
+// RegisterAdapter adds or replaces the adapter of an adapter type
+func (s *ExternalObjectService) RegisterAdapter(adapterType constants.ExternalAdapterType, adapter external.Adapter) {
+	s.adapters.Register(adapterType, adapter)
+}
+
+// ==================== Definitions ====================
+
+// GetExternalObjects returns every external object with its connection settings (DSNs stripped)
+func (s *ExternalObjectService) GetExternalObjects(ctx context.Context) ([]*models.ExternalObject, error) {
+	sources, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]*models.ExternalObject, 0, len(sources))
+	for _, src := range sources {
+		schema := s.metadata.GetSchema(ctx, src.ObjectAPIName)
+		if schema == nil {
+			continue
+		}
+		src.DSN = ""
+		objects = append(objects, &models.ExternalObject{Object: *schema, Source: *src})
+	}
+	return objects, nil
+}
+
+// GetExternalObject returns an external object with its connection settings (DSN stripped)
+func (s *ExternalObjectService) GetExternalObject(ctx context.Context, apiName string) (*models.ExternalObject, error) {
+	schema := s.metadata.GetSchema(ctx, apiName)
+	if schema == nil || !schema.IsExternal {
+		return nil, errors.NewNotFoundError("External object", apiName)
+	}
+	src, err := s.findSource(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	src.DSN = ""
+	return &models.ExternalObject{Object: *schema, Source: *src}, nil
+}
+
+// CreateExternalObject registers an external object's metadata and stores its connection
+// settings, with the DSN encrypted
+func (s *ExternalObjectService) CreateExternalObject(ctx context.Context, def *models.ExternalObject) error {
+	src := &def.Source
+	src.ObjectAPIName = def.Object.APIName
+	if src.IDField == "" {
+		src.IDField = defaultExternalIDField
+	}
+	if src.MaxRows == 0 {
+		src.MaxRows = defaultExternalMaxRows
+	}
+	if src.Adapter == constants.ExternalAdapterSQL && src.DSN == "" {
+		return errors.NewValidationError(constants.FieldSysExternalObject_Dsn, "is required for SQL sources")
+	}
+	if err := s.validateSource(ctx, src, def.Object.Fields); err != nil {
+		return err
+	}
+
+	plaintext := src.DSN
+	encrypted, err := secrets.Encrypt(plaintext)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt DSN: %w", err)
+	}
+
+	if err := s.metadata.CreateExternalSchema(ctx, &def.Object); err != nil {
+		return err
+	}
+
+	src.DSN = encrypted
+	if src.ID == "" {
+		src.ID = GenerateID()
+	}
+	if err := s.repo.Insert(ctx, src); err != nil {
+		// COMPENSATION: an external object without settings cannot be queried
+		if dropErr := s.metadata.DropSchema(ctx, def.Object.APIName); dropErr != nil {
+			log.Printf("⚠️ Failed to remove metadata of external object %s: %v", def.Object.APIName, dropErr)
+		}
+		return err
+	}
+	src.HasDSN = plaintext != ""
+	src.DSN = ""
+	return nil
+}
+
+// ExternalDataSourceUpdate holds the changeable connection settings of an external object;
+// nil leaves a setting unchanged
+type ExternalDataSourceUpdate struct {
+	Adapter         *constants.ExternalAdapterType `json:"adapter"`
+	NamedCredential *string                        `json:"named_credential"`
+	Resource        *string                        `json:"resource"`
+	RecordsPath     *string                        `json:"records_path"`
+	IDField         *string                        `json:"id_field"`
+	FieldMap        map[string]string              `json:"field_map"`
+	DSN             *string                        `json:"dsn"`
+	MaxRows         *int                           `json:"max_rows"`
+}
+
+// UpdateExternalDataSource updates the connection settings of an external object
+func (s *ExternalObjectService) UpdateExternalDataSource(ctx context.Context, apiName string, updates ExternalDataSourceUpdate) (*models.ExternalDataSource, error) {
+	schema := s.metadata.GetSchema(ctx, apiName)
+	if schema == nil || !schema.IsExternal {
+		return nil, errors.NewNotFoundError("External object", apiName)
+	}
+	src, err := s.repo.FindByObject(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		return nil, errors.NewNotFoundError("External object", apiName)
+	}
+
+	if updates.Adapter != nil {
+		src.Adapter = *updates.Adapter
+	}
+	if updates.NamedCredential != nil {
+		src.NamedCredential = updates.NamedCredential
+	}
+	if updates.Resource != nil {
+		src.Resource = *updates.Resource
+	}
+	if updates.RecordsPath != nil {
+		src.RecordsPath = updates.RecordsPath
+	}
+	if updates.IDField != nil {
+		src.IDField = *updates.IDField
+	}
+	if updates.FieldMap != nil {
+		src.FieldMap = updates.FieldMap
+	}
+	if updates.MaxRows != nil {
+		src.MaxRows = *updates.MaxRows
+	}
+	if updates.DSN != nil {
+		if src.DSN, err = secrets.Encrypt(*updates.DSN); err != nil {
+			return nil, fmt.Errorf("failed to encrypt DSN: %w", err)
+		}
+	}
+	if src.Adapter == constants.ExternalAdapterSQL && src.DSN == "" {
+		return nil, errors.NewValidationError(constants.FieldSysExternalObject_Dsn, "is required for SQL sources")
+	}
+	if err := s.validateSource(ctx, src, schema.Fields); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, src); err != nil {
+		return nil, err
+	}
+	src.HasDSN = src.DSN != ""
+	src.DSN = ""
+	return src, nil
+}
+
+// DeleteExternalObject deletes an external object's metadata and connection settings.
+// The records in the external system are not touched.
+func (s *ExternalObjectService) DeleteExternalObject(ctx context.Context, apiName string) error {
+	schema := s.metadata.GetSchema(ctx, apiName)
+	if schema == nil || !schema.IsExternal {
+		return errors.NewNotFoundError("External object", apiName)
+	}
+	return s.metadata.DeleteSchema(ctx, schema.APIName)
+}
+
+// validateSource checks connection settings against the adapter type and the object's fields
+func (s *ExternalObjectService) validateSource(ctx context.Context, src *models.ExternalDataSource, fields []models.FieldMetadata) error {
+	src.Resource = strings.TrimSpace(src.Resource)
+	if src.Resource == "" {
+		return errors.NewValidationError(constants.FieldSysExternalObject_Resource, "is required")
+	}
+	if strings.TrimSpace(src.IDField) == "" {
+		return errors.NewValidationError(constants.FieldSysExternalObject_IDField, "is required")
+	}
+	if src.MaxRows < 1 || src.MaxRows > maxExternalMaxRows {
+		return errors.NewValidationError(constants.FieldSysExternalObject_MaxRows, fmt.Sprintf("must be between 1 and %d", maxExternalMaxRows))
+	}
+
+	switch src.Adapter {
+	case constants.ExternalAdapterREST, constants.ExternalAdapterOData:
+		if src.NamedCredential == nil || *src.NamedCredential == "" {
+			return errors.NewValidationError(constants.FieldSysExternalObject_NamedCredential, fmt.Sprintf("is required for %s sources", src.Adapter))
+		}
+		if _, err := s.callouts.GetNamedCredential(ctx, *src.NamedCredential); err != nil {
+			return err
+		}
+		src.DSN = ""
+	case constants.ExternalAdapterSQL:
+		if !external.IsValidSQLIdentifier(src.Resource) {
+			return errors.NewValidationError(constants.FieldSysExternalObject_Resource, "must be a table name")
+		}
+		if !external.IsValidSQLIdentifier(src.IDField) {
+			return errors.NewValidationError(constants.FieldSysExternalObject_IDField, "must be a column name")
+		}
+		src.NamedCredential = nil
+	default:
+		return errors.NewValidationError(constants.FieldSysExternalObject_Adapter, fmt.Sprintf("unsupported adapter '%s'", src.Adapter))
+	}
+
+	for local, remote := range src.FieldMap {
+		if local == constants.FieldID {
+			return errors.NewValidationError(constants.FieldSysExternalObject_FieldMap, "map the record ID with id_field")
+		}
+		if !hasField(fields, local) {
+			return errors.NewValidationError(constants.FieldSysExternalObject_FieldMap, fmt.Sprintf("unknown field '%s'", local))
+		}
+		if strings.TrimSpace(remote) == "" {
+			return errors.NewValidationError(constants.FieldSysExternalObject_FieldMap, fmt.Sprintf("no remote field for '%s'", local))
+		}
+		if src.Adapter == constants.ExternalAdapterSQL && !external.IsValidSQLIdentifier(remote) {
+			return errors.NewValidationError(constants.FieldSysExternalObject_FieldMap, fmt.Sprintf("'%s' is not a column name", remote))
+		}
+	}
+	return nil
+}
+
+func hasField(fields []models.FieldMetadata, apiName string) bool {
+	for _, f := range fields {
+		if strings.EqualFold(f.APIName, apiName) {
+			return true
+		}
+	}
+	return false
+}
+
+// findSource loads the connection settings of an external object, DSN still encrypted
+func (s *ExternalObjectService) findSource(ctx context.Context, apiName string) (*models.ExternalDataSource, error) {
+	src, err := s.repo.FindByObject(ctx, apiName)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		return nil, errors.NewNotFoundError("External object", apiName)
+	}
+	return src, nil
+}
+
+// ==================== Queries ====================
+
+// Query fetches the records of an external object. Criteria, sort and limit are pushed to the
+// adapter where possible and always re-applied here, together with the formula filter, so the
+// result matches what QueryRepository.Find would return for a native object.
+func (s *ExternalObjectService) Query(ctx context.Context, schema *models.ObjectMetadata, req models.QueryRequest, visibleFields []string) ([]models.SObject, error) {
+	src, err := s.findSource(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	if src.DSN, err = secrets.Decrypt(src.DSN); err != nil {
+		return nil, fmt.Errorf("external object %s: %w", schema.APIName, err)
+	}
+	adapter, err := s.adapters.Get(src.Adapter)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := newExternalFieldMapping(schema, src)
+	for _, c := range req.Criteria {
+		if c.Field != constants.FieldID && !hasField(schema.Fields, c.Field) {
+			return nil, fmt.Errorf("invalid field name in criteria: %s", c.Field)
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Only push the limit down when the source will apply every condition and the sort
+	pushdown := external.Query{Limit: limit + offset}
+	for _, f := range visibleFields {
+		if remote, ok := fields.remote(f); ok {
+			pushdown.Fields = append(pushdown.Fields, remote)
+		}
+	}
+	for _, c := range req.Criteria {
+		remote, ok := fields.remote(c.Field)
+		if !ok {
+			pushdown.Limit = 0
+			continue
+		}
+		pushdown.Criteria = append(pushdown.Criteria, external.Criterion{Field: remote, Op: c.Op, Val: c.Val})
+	}
+	sortDesc := strings.EqualFold(req.SortDirection, constants.SortDESC)
+	if req.SortField != "" {
+		if remote, ok := fields.remote(req.SortField); ok {
+			pushdown.SortField = remote
+			pushdown.SortDesc = sortDesc
+		} else {
+			pushdown.Limit = 0
+		}
+	}
+	if req.FilterExpr != "" {
+		pushdown.Limit = 0
+	}
+
+	rows, err := adapter.Fetch(ctx, src, pushdown)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query external object %s: %w", schema.APIName, err)
+	}
+
+	results := make([]models.SObject, 0, len(rows))
+	for _, row := range rows {
+		record := fields.toRecord(row, visibleFields)
+		match, err := s.matches(record, req)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			results = append(results, record)
+		}
+	}
+
+	if req.SortField != "" {
+		sort.SliceStable(results, func(i, j int) bool {
+			cmp, _ := compareExternalValues(results[i][req.SortField], results[j][req.SortField])
+			if sortDesc {
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+	}
+
+	if offset >= len(results) {
+		return []models.SObject{}, nil
+	}
+	results = results[offset:]
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// FindByIDs fetches external records by ID
+func (s *ExternalObjectService) FindByIDs(ctx context.Context, schema *models.ObjectMetadata, visibleFields []string, ids []string) ([]models.SObject, error) {
+	if len(ids) == 0 {
+		return []models.SObject{}, nil
+	}
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return s.Query(ctx, schema, models.QueryRequest{
+		ObjectAPIName: schema.APIName,
+		Criteria:      []models.QueryCriterion{{Field: constants.FieldID, Op: "IN", Val: values}},
+		Limit:         len(ids),
+	}, visibleFields)
+}
+
+// matches applies the criteria and formula filter of a query to a record
+func (s *ExternalObjectService) matches(record models.SObject, req models.QueryRequest) (bool, error) {
+	for _, c := range req.Criteria {
+		ok, err := matchExternalCriterion(record[c.Field], c.Op, c.Val)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	if req.FilterExpr == "" {
+		return true, nil
+	}
+	result, err := s.formula.Evaluate(req.FilterExpr, &formula.Context{Record: record})
+	if err != nil {
+		return false, fmt.Errorf("invalid filter expression: %w", err)
+	}
+	match, _ := result.(bool)
+	return match, nil
+}
+
+// externalFieldMapping translates between local field API names and remote field names
+type externalFieldMapping struct {
+	toRemote map[string]string // local → remote, for fields that exist remotely
+}
+
+// newExternalFieldMapping maps the record ID to the source's ID field and every stored field to
+// its mapped or own name. System fields exist remotely only when mapped; formula fields never do.
+func newExternalFieldMapping(schema *models.ObjectMetadata, src *models.ExternalDataSource) *externalFieldMapping {
+	m := &externalFieldMapping{toRemote: map[string]string{constants.FieldID: src.IDField}}
+	for _, f := range schema.Fields {
+		if f.APIName == constants.FieldID || f.Type == constants.FieldTypeFormula {
+			continue
+		}
+		if remote, ok := src.FieldMap[f.APIName]; ok {
+			m.toRemote[f.APIName] = remote
+		} else if !f.IsSystem || f.IsNameField {
+			m.toRemote[f.APIName] = f.APIName
+		}
+	}
+	return m
+}
+
+func (m *externalFieldMapping) remote(local string) (string, bool) {
+	remote, ok := m.toRemote[local]
+	return remote, ok
+}
+
+// toRecord builds a record with every visible field, leaving those the source lacks empty.
+// IDs are always strings, as for native records.
+func (m *externalFieldMapping) toRecord(row map[string]interface{}, visibleFields []string) models.SObject {
+	record := make(models.SObject, len(visibleFields)+1)
+	for _, f := range visibleFields {
+		record[f] = nil
+		if remote, ok := m.toRemote[f]; ok {
+			record[f] = row[remote]
+		}
+	}
+	if id := row[m.toRemote[constants.FieldID]]; id != nil {
+		record[constants.FieldID] = fmt.Sprint(id)
+	}
+	return record
+}
+
+// matchExternalCriterion evaluates a query criterion against a value the way MySQL would with
+// its default case-insensitive collation
+func matchExternalCriterion(value interface{}, op string, expected interface{}) (bool, error) {
+	switch strings.ToUpper(op) {
+	case "IN":
+		values, ok := expected.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("IN criteria need a list")
+		}
+		for _, v := range values {
+			if cmp, ok := compareExternalValues(value, v); ok && cmp == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "LIKE":
+		if value == nil {
+			return false, nil
+		}
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("LIKE criteria need a string pattern")
+		}
+		return likePattern(pattern).MatchString(fmt.Sprint(value)), nil
+	}
+
+	cmp, ok := compareExternalValues(value, expected)
+	if !ok {
+		return false, nil
+	}
+	switch op {
+	case "=":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case "<":
+		return cmp < 0, nil
+	case ">":
+		return cmp > 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	}
+	return false, fmt.Errorf("invalid operator in criteria: %s", op)
+}
+
+// compareExternalValues compares two values numerically when both are numbers, else as
+// case-insensitive text. NULL compares to nothing, like in SQL.
+func compareExternalValues(a, b interface{}) (int, bool) {
+	if a == nil || b == nil {
+		return 0, false
+	}
+	if x, ok := toExternalNumber(a); ok {
+		if y, ok := toExternalNumber(b); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return strings.Compare(strings.ToLower(fmt.Sprint(a)), strings.ToLower(fmt.Sprint(b))), true
+}
+
+func toExternalNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// likePattern converts a SQL LIKE pattern into a case-insensitive regular expression
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
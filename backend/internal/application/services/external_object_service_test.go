@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nexuscrm/backend/internal/infrastructure/external"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdapter serves fixed rows and records the query it was asked for
+type fakeAdapter struct {
+	rows  []map[string]interface{}
+	query external.Query
+}
+
+func (f *fakeAdapter) Fetch(_ context.Context, _ *models.ExternalDataSource, q external.Query) ([]map[string]interface{}, error) {
+	f.query = q
+	return f.rows, nil
+}
+
+var externalObjectTestColumns = []string{"id", "object_api_name", "adapter", "named_credential", "resource", "records_path", "id_field", "field_map", "dsn", "max_rows", "created", "modified"}
+
+// newTestExternalObjectService returns a service whose repository serves one REST source for erp_invoice
+func newTestExternalObjectService(t *testing.T, adapter external.Adapter) *ExternalObjectService {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	now := time.Now()
+	mock.ExpectQuery("FROM `?" + constants.TableExternalObject).WillReturnRows(
+		sqlmock.NewRows(externalObjectTestColumns).
+			AddRow("eo1", "erp_invoice", "REST", "Erp", "/invoices", nil, "InvoiceNo", `{"name": "Number", "amount": "Total"}`, nil, 1000, now, now))
+
+	svc := NewExternalObjectService(persistence.NewExternalObjectRepository(db), nil, nil)
+	svc.RegisterAdapter(constants.ExternalAdapterREST, adapter)
+	return svc
+}
+
+func externalInvoiceSchema() *models.ObjectMetadata {
+	return &models.ObjectMetadata{
+		APIName:    "erp_invoice",
+		IsExternal: true,
+		Fields: []models.FieldMetadata{
+			{APIName: constants.FieldID, IsSystem: true},
+			{APIName: constants.FieldOwnerID, IsSystem: true},
+			{APIName: "name", Type: constants.FieldTypeText, IsNameField: true},
+			{APIName: "amount", Type: constants.FieldTypeCurrency},
+			{APIName: "status", Type: constants.FieldTypeText},
+		},
+	}
+}
+
+func TestExternalObjectQuery_MapsFiltersSortsAndPages(t *testing.T) {
+	adapter := &fakeAdapter{rows: []map[string]interface{}{
+		{"InvoiceNo": 1, "Number": "INV-1", "Total": 50.0, "status": "Open"},
+		{"InvoiceNo": 2, "Number": "INV-2", "Total": 300.0, "status": "open"},
+		{"InvoiceNo": 3, "Number": "INV-3", "Total": 120.0, "status": "Paid"},
+		{"InvoiceNo": 4, "Number": "INV-4", "Total": 200.0, "status": "Open"},
+	}}
+	svc := newTestExternalObjectService(t, adapter)
+
+	visible := []string{constants.FieldID, constants.FieldOwnerID, "name", "amount", "status"}
+	results, err := svc.Query(context.Background(), externalInvoiceSchema(), models.QueryRequest{
+		ObjectAPIName: "erp_invoice",
+		Criteria:      []models.QueryCriterion{{Field: "status", Op: "=", Val: "OPEN"}},
+		SortField:     "amount",
+		SortDirection: constants.SortDESC,
+		Limit:         2,
+	}, visible)
+	require.NoError(t, err)
+
+	// Pushed down in remote names, without the owner field the source does not have
+	assert.ElementsMatch(t, []string{"InvoiceNo", "Number", "Total", "status"}, adapter.query.Fields)
+	assert.Equal(t, []external.Criterion{{Field: "status", Op: "=", Val: "OPEN"}}, adapter.query.Criteria)
+	assert.Equal(t, "Total", adapter.query.SortField)
+	assert.Equal(t, 2, adapter.query.Limit)
+
+	// Re-filtered case-insensitively, sorted and limited locally
+	require.Len(t, results, 2)
+	assert.Equal(t, "2", results[0][constants.FieldID])
+	assert.Equal(t, "INV-2", results[0]["name"])
+	assert.Equal(t, "4", results[1][constants.FieldID])
+	assert.Nil(t, results[1][constants.FieldOwnerID])
+}
+
+func TestExternalObjectQuery_FilterExprFetchesWithoutLimit(t *testing.T) {
+	adapter := &fakeAdapter{rows: []map[string]interface{}{
+		{"InvoiceNo": "A", "Number": "INV-A", "Total": 50.0, "status": "Open"},
+		{"InvoiceNo": "B", "Number": "INV-B", "Total": 500.0, "status": "Open"},
+	}}
+	svc := newTestExternalObjectService(t, adapter)
+
+	results, err := svc.Query(context.Background(), externalInvoiceSchema(), models.QueryRequest{
+		ObjectAPIName: "erp_invoice",
+		FilterExpr:    "amount > 100",
+		Limit:         1,
+	}, []string{constants.FieldID, "name", "amount", "status"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, adapter.query.Limit, "the source cannot apply the formula, so the limit is not pushed down")
+	require.Len(t, results, 1)
+	assert.Equal(t, "B", results[0][constants.FieldID])
+}
+
+func TestMatchExternalCriterion(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		op       string
+		expected interface{}
+		match    bool
+	}{
+		{"100", ">", 99.5, true},
+		{int64(3), "IN", []interface{}{"1", "3"}, true},
+		{"Acme Corp", "LIKE", "acme%", true},
+		{"Acme Corp", "LIKE", "%inc", false},
+		{nil, "=", "x", false},
+		{"a", "!=", "A", false},
+	}
+	for _, c := range cases {
+		match, err := matchExternalCriterion(c.value, c.op, c.expected)
+		require.NoError(t, err)
+		assert.Equal(t, c.match, match, "%v %s %v", c.value, c.op, c.expected)
+	}
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// DefaultExpressionPrefix marks a field default value as a formula evaluated when a record
+// is created (e.g. "=TODAY() + 7" or "=$User.id"), like formula values in flow field
+// mappings. Defaults without it are static values.
+const DefaultExpressionPrefix = "="
+
+// UserFormulaVar is the variable through which default expressions read the running user:
+// $User.id, $User.name, $User.email, $User.profile_id and $User.role_id
+const UserFormulaVar = "$User"
+
+// defaultExpression returns the formula of a field's default value, if it is one
+func defaultExpression(field *models.FieldMetadata) (string, bool) {
+	if field.DefaultValue == nil {
+		return "", false
+	}
+	expr, ok := strings.CutPrefix(strings.TrimSpace(*field.DefaultValue), DefaultExpressionPrefix)
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSpace(expr), true
+}
+
+// userFormulaVar is what $User exposes of the running user
+func userFormulaVar(user *models.UserSession) map[string]interface{} {
+	if user == nil {
+		user = &models.UserSession{}
+	}
+	var email, roleID string
+	if user.Email != nil {
+		email = *user.Email
+	}
+	if user.RoleID != nil {
+		roleID = *user.RoleID
+	}
+	return map[string]interface{}{
+		"id":         user.ID,
+		"name":       user.Name,
+		"email":      email,
+		"profile_id": user.ProfileID,
+		"role_id":    roleID,
+	}
+}
+
+// defaultExpressionContext is the formula context of a default expression: the record
+// being created (fields supplied so far) and the running user
+func defaultExpressionContext(record models.SObject, user *models.UserSession) *formula.Context {
+	ctx := &formula.Context{
+		Record: record,
+		Fields: map[string]interface{}{UserFormulaVar: userFormulaVar(user)},
+	}
+	if user != nil {
+		ctx.User = user.ToMap()
+	}
+	return ctx
+}
+
+// applyDefaultExpressions evaluates the default expressions of fields absent from a new
+// record. Static defaults are applied by generateSystemFields.
+func (ps *PersistenceService) applyDefaultExpressions(schema *models.ObjectMetadata, data models.SObject, user *models.UserSession) error {
+	var formulaCtx *formula.Context
+	for i := range schema.Fields {
+		field := &schema.Fields[i]
+		if _, exists := data[field.APIName]; exists {
+			continue
+		}
+		expr, ok := defaultExpression(field)
+		if !ok {
+			continue
+		}
+		if formulaCtx == nil {
+			formulaCtx = defaultExpressionContext(data, user)
+		}
+		val, err := ps.formula.Evaluate(expr, formulaCtx)
+		if err != nil {
+			return errors.NewValidationError(field.APIName, fmt.Sprintf("Default value of %s could not be evaluated: %v", field.APIName, err))
+		}
+		data[field.APIName] = val
+	}
+	return nil
+}
+
+// validateDefaultExpression checks that a field's default expression compiles against the
+// object's fields and the running user
+func (ms *MetadataService) validateDefaultExpression(obj *models.ObjectMetadata, field *models.FieldMetadata) error {
+	expr, ok := defaultExpression(field)
+	if !ok {
+		return nil
+	}
+	if expr == "" {
+		return errors.NewValidationError(constants.FieldSysField_DefaultValue, "Default value expression is empty")
+	}
+	if field.Type == constants.FieldTypeFormula || field.Type == constants.FieldTypeAutoNumber {
+		return errors.NewValidationError(constants.FieldSysField_DefaultValue, fmt.Sprintf("%s fields cannot have a default value expression", field.Type))
+	}
+
+	env := formulaSampleEnv(obj)
+	env[UserFormulaVar] = userFormulaVar(nil)
+	if err := ms.schemaMgr.ValidateFormula(expr, env); err != nil {
+		return errors.NewValidationError(constants.FieldSysField_DefaultValue, fmt.Sprintf("Invalid default value expression: %v", err))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaultExpressions(t *testing.T) {
+	ps := &PersistenceService{formula: formula.NewEngine()}
+	ptr := func(s string) *string { return &s }
+	schema := &models.ObjectMetadata{
+		APIName: "task",
+		Fields: []models.FieldMetadata{
+			{APIName: "subject", Type: constants.FieldTypeText},
+			{APIName: "status", Type: constants.FieldTypePicklist, DefaultValue: ptr("Open")},
+			{APIName: "due_date", Type: constants.FieldTypeDate, DefaultValue: ptr("= TODAY() + 7")},
+			{APIName: "reviewer_id", Type: constants.FieldTypeLookup, DefaultValue: ptr("=$User.id")},
+			{APIName: "summary", Type: constants.FieldTypeText, DefaultValue: ptr(`=UPPER(subject)`)},
+		},
+	}
+	user := &models.UserSession{ID: "user-1", ProfileID: constants.ProfileStandardUser}
+
+	data := models.SObject{"subject": "call back"}
+	require.NoError(t, ps.applyDefaultExpressions(schema, data, user))
+	assert.Equal(t, models.SObject{
+		"subject":     "call back",
+		"due_date":    time.Now().AddDate(0, 0, 7).Format(time.DateOnly),
+		"reviewer_id": "user-1",
+		"summary":     "CALL BACK",
+	}, data, "static defaults are left to generateSystemFields")
+
+	data = models.SObject{"subject": "x", "due_date": "2024-01-01", "reviewer_id": nil, "summary": "kept"}
+	require.NoError(t, ps.applyDefaultExpressions(schema, data, user))
+	assert.Equal(t, "2024-01-01", data["due_date"], "supplied values win")
+	assert.Nil(t, data["reviewer_id"])
+
+	schema.Fields = append(schema.Fields, models.FieldMetadata{APIName: "broken", DefaultValue: ptr("=UPPER(42)")})
+	assert.Error(t, ps.applyDefaultExpressions(schema, models.SObject{"subject": "x"}, user))
+}
+
+func TestDefaultExpression(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	_, ok := defaultExpression(&models.FieldMetadata{})
+	assert.False(t, ok)
+	_, ok = defaultExpression(&models.FieldMetadata{DefaultValue: ptr("CURRENT_TIMESTAMP")})
+	assert.False(t, ok)
+
+	expr, ok := defaultExpression(&models.FieldMetadata{DefaultValue: ptr(" = TODAY() ")})
+	assert.True(t, ok)
+	assert.Equal(t, "TODAY()", expr)
+}
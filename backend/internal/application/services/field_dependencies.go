@@ -0,0 +1,81 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Dependent Picklists ====================
+
+// applyFieldDependencies merges _System_FieldDependency rows into the controlling field and
+// dependency matrix of each dependent field. Rows override values stored on the field itself;
+// a row naming a different controlling field replaces the field's matrix.
+func applyFieldDependencies(schemaMap map[string]*models.ObjectMetadata, deps []*models.FieldDependency) {
+	for _, dep := range deps {
+		schema := schemaMap[strings.ToLower(dep.ObjectAPIName)]
+		if schema == nil {
+			continue
+		}
+		var field *models.FieldMetadata
+		for i := range schema.Fields {
+			if strings.EqualFold(schema.Fields[i].APIName, dep.DependentField) {
+				field = &schema.Fields[i]
+				break
+			}
+		}
+		if field == nil || FindField(schema, dep.ControllingField) == nil {
+			log.Printf("⚠️ Field dependency %s references unknown fields on %s", dep.ID, dep.ObjectAPIName)
+			continue
+		}
+
+		if field.ControllingField == nil || !strings.EqualFold(*field.ControllingField, dep.ControllingField) {
+			controlling := dep.ControllingField
+			field.ControllingField = &controlling
+			field.PicklistDependency = nil
+		}
+		if field.PicklistDependency == nil {
+			field.PicklistDependency = make(map[string][]string)
+		}
+		field.PicklistDependency[dep.ControllingValue] = dep.DependentValues
+	}
+}
+
+// checkFieldDependency reports whether value is allowed for a dependent picklist field
+// when its controlling field holds controllingValue. Blank values are always allowed.
+func checkFieldDependency(field *models.FieldMetadata, controllingValue, value string) error {
+	if value == "" || field.ControllingField == nil || len(field.PicklistDependency) == 0 {
+		return nil
+	}
+	if !ContainsString(field.PicklistDependency[controllingValue], value) {
+		return errors.NewValidationError(field.APIName,
+			fmt.Sprintf("'%s' is not allowed when %s is '%s'", value, *field.ControllingField, controllingValue))
+	}
+	return nil
+}
+
+// validateFieldDependencies checks every dependent picklist value of a record against its
+// controlling value. When changed is non-nil (updates), only dependencies where either the
+// dependent or the controlling field changed are checked; record must then be the merged record.
+func validateFieldDependencies(schema *models.ObjectMetadata, record, changed models.SObject) error {
+	for i := range schema.Fields {
+		field := &schema.Fields[i]
+		if field.ControllingField == nil || len(field.PicklistDependency) == 0 {
+			continue
+		}
+		if changed != nil {
+			_, dependentChanged := changed[field.APIName]
+			_, controllingChanged := changed[*field.ControllingField]
+			if !dependentChanged && !controllingChanged {
+				continue
+			}
+		}
+		if err := checkFieldDependency(field, record.GetString(*field.ControllingField), record.GetString(field.APIName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func dependencySchema() *models.ObjectMetadata {
+	return &models.ObjectMetadata{APIName: "Case", Fields: []models.FieldMetadata{
+		{APIName: "category", Type: constants.FieldTypePicklist, Options: []string{"Hardware", "Software"}},
+		{APIName: "sub_category", Type: constants.FieldTypePicklist, Options: []string{"Laptop", "Printer", "Login", "Crash"}},
+		{APIName: "subject", Type: constants.FieldTypeText},
+	}}
+}
+
+func TestApplyFieldDependencies(t *testing.T) {
+	schema := dependencySchema()
+	schemaMap := map[string]*models.ObjectMetadata{"case": schema}
+
+	applyFieldDependencies(schemaMap, []*models.FieldDependency{
+		{ObjectAPIName: "Case", ControllingField: "category", DependentField: "sub_category", ControllingValue: "Hardware", DependentValues: []string{"Laptop", "Printer"}},
+		{ObjectAPIName: "Case", ControllingField: "category", DependentField: "sub_category", ControllingValue: "Software", DependentValues: []string{"Login", "Crash"}},
+		{ObjectAPIName: "Case", ControllingField: "missing", DependentField: "sub_category", ControllingValue: "X"},
+		{ObjectAPIName: "Unknown", ControllingField: "a", DependentField: "b"},
+	})
+
+	field := FindField(schema, "sub_category")
+	if assert.NotNil(t, field.ControllingField) {
+		assert.Equal(t, "category", *field.ControllingField)
+	}
+	assert.Equal(t, map[string][]string{
+		"Hardware": {"Laptop", "Printer"},
+		"Software": {"Login", "Crash"},
+	}, field.PicklistDependency)
+	assert.Nil(t, FindField(schema, "category").ControllingField)
+}
+
+func TestValidateFieldDependencies(t *testing.T) {
+	schema := dependencySchema()
+	controlling := "category"
+	schema.Fields[1].ControllingField = &controlling
+	schema.Fields[1].PicklistDependency = map[string][]string{
+		"Hardware": {"Laptop", "Printer"},
+		"Software": {"Login", "Crash"},
+	}
+
+	assert.NoError(t, validateFieldDependencies(schema, models.SObject{"category": "Hardware", "sub_category": "Printer"}, nil))
+	assert.NoError(t, validateFieldDependencies(schema, models.SObject{"category": "Hardware"}, nil), "blank dependent value")
+
+	err := validateFieldDependencies(schema, models.SObject{"category": "Software", "sub_category": "Printer"}, nil)
+	assert.True(t, errors.IsValidation(err))
+	assert.Error(t, validateFieldDependencies(schema, models.SObject{"sub_category": "Login"}, nil), "no controlling value")
+
+	// Updates only check dependencies touched by the change
+	stale := models.SObject{"category": "Software", "sub_category": "Printer", "subject": "New"}
+	assert.NoError(t, validateFieldDependencies(schema, stale, models.SObject{"subject": "New"}))
+	assert.Error(t, validateFieldDependencies(schema, stale, models.SObject{"category": "Software"}), "controlling change invalidates the dependent value")
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// UploadDir is where files are stored; it is served at /uploads
+const UploadDir = "uploads"
+
+// FileService stores uploaded and generated files and records attachments as _System_File rows
+type FileService struct {
+	persistence *PersistenceService
+	dir         string
+}
+
+// NewFileService creates a new FileService storing files in UploadDir
+func NewFileService(persistence *PersistenceService) *FileService {
+	return &FileService{persistence: persistence, dir: UploadDir}
+}
+
+// Save writes content under a generated name keeping the extension of name, and returns
+// the stored file's path and size
+func (s *FileService) Save(name string, content io.Reader) (string, int64, error) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return "", 0, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%d-%s%s", time.Now().UnixNano(), "file", filepath.Ext(name)))
+	out, err := os.Create(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to save file: %w", err)
+	}
+	size, err := io.Copy(out, content)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", 0, fmt.Errorf("failed to save file: %w", err)
+	}
+	return path, size, nil
+}
+
+// Attach saves content and records it as a file attached to parentID
+func (s *FileService) Attach(ctx context.Context, parentID, name, mimeType string, content io.Reader, currentUser *models.UserSession) (models.SObject, error) {
+	path, size, err := s.Save(name, content)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.persistence.Insert(ctx, constants.TableFile, models.SObject{
+		constants.FieldSysFile_ParentID:    parentID,
+		constants.FieldSysFile_Name:        name,
+		constants.FieldSysFile_MimeType:    mimeType,
+		constants.FieldSysFile_SizeBytes:   size,
+		constants.FieldSysFile_StoragePath: path,
+	}, currentUser)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	return file, nil
+}
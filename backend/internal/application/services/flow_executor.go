@@ -10,6 +10,7 @@ import (
 
 	"github.com/nexuscrm/backend/internal/domain/events"
 	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/backend/pkg/formula"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
@@ -24,6 +25,7 @@ type FlowExecutor struct {
 	formula             ports.FormulaEvaluator
 	flowInstanceManager ports.FlowInstanceManager
 	approvalPersistence ports.ApprovalPersistence
+	savepoints          ports.SavepointRunner // nil runs flows without savepoints
 }
 
 // NewFlowExecutor creates a new FlowExecutor with interface dependencies.
@@ -65,6 +67,12 @@ func NewFlowExecutorWithFormula(
 	}
 }
 
+// SetSavepoints runs each flow inside a savepoint of the save that triggered it, so a
+// failing flow's writes are rolled back while the save and the other flows go on
+func (fe *FlowExecutor) SetSavepoints(savepoints ports.SavepointRunner) {
+	fe.savepoints = savepoints
+}
+
 // RegisterFlowHandlers subscribes to EventBus events and executes matching Flows
 func (fe *FlowExecutor) RegisterFlowHandlers() {
 	// Dynamically subscribe to all events supported by metadata
@@ -115,6 +123,11 @@ func (fe *FlowExecutor) mapEventToTrigger(eventType string) string {
 
 // executeMatchingFlows finds and executes Flows that match the trigger
 func (fe *FlowExecutor) executeMatchingFlows(ctx context.Context, triggerType string, payload RecordEventPayload) error {
+	if payload.SuppressFlows {
+		return nil
+	}
+	// After-save events arrive from the outbox with the chain that caused them
+	ctx = WithTriggerChain(ctx, payload.Triggers)
 	flows := fe.metadata.GetFlows(ctx)
 	log.Printf("🔍 FlowExecutor: checking %d flows for trigger '%s' on object '%s'", len(flows), triggerType, payload.ObjectAPIName)
 
@@ -151,11 +164,22 @@ func (fe *FlowExecutor) executeMatchingFlows(ctx context.Context, triggerType st
 			}
 		}
 
+		flowCtx, err := fe.enterFlow(ctx, flow, payload)
+		if err != nil {
+			return err
+		}
+
 		// Execute the flow action
 		log.Printf("🔄 Flow %s: executing %s action on %s", flow.Name, flow.ActionType, payload.ObjectAPIName)
 
-		if err := fe.executeFlowAction(ctx, flow, payload); err != nil {
+		if err := fe.runIsolated(flowCtx, func(ctx context.Context) error {
+			return fe.executeFlowAction(ctx, flow, payload)
+		}); err != nil {
 			log.Printf("❌ Flow %s: execution failed: %v", flow.Name, err)
+			// Runaway automation fails the save that started it
+			if errors.IsTriggerRecursion(err) {
+				return err
+			}
 			// Continue with other flows even if one fails
 			continue
 		}
@@ -166,6 +190,28 @@ func (fe *FlowExecutor) executeMatchingFlows(ctx context.Context, triggerType st
 	return nil
 }
 
+// runIsolated runs fn inside a savepoint when savepoints are configured
+func (fe *FlowExecutor) runIsolated(ctx context.Context, fn func(ctx context.Context) error) error {
+	if fe.savepoints == nil {
+		return fn(ctx)
+	}
+	return fe.savepoints.WithSavepoint(ctx, fn)
+}
+
+// enterFlow adds a flow running on the payload's record to the trigger chain of ctx
+func (fe *FlowExecutor) enterFlow(ctx context.Context, flow *models.Flow, payload RecordEventPayload) (context.Context, error) {
+	flowCtx, err := EnterTrigger(ctx, TriggerFrame{
+		Kind:          TriggerKindFlow,
+		Name:          flow.Name,
+		ObjectAPIName: payload.ObjectAPIName,
+		RecordID:      payload.Record.GetString(constants.FieldID),
+	})
+	if err != nil {
+		log.Printf("🛑 Flow %s: %v", flow.Name, err)
+	}
+	return flowCtx, err
+}
+
 // createFormulaContext helper to create context for formula evaluation
 func (fe *FlowExecutor) createFormulaContext(payload RecordEventPayload) *formula.Context {
 	ctx := &formula.Context{
@@ -189,6 +235,28 @@ func (fe *FlowExecutor) executeFlowAction(ctx context.Context, flow *models.Flow
 	return fe.executeActionLogic(ctx, flow.ActionType, flow.ActionConfig, flow.ID, flow.TriggerType, payload)
 }
 
+// ExecuteFlowForRecord runs an active flow on a record outside of its trigger, as the
+// given user (e.g. an SLA escalation). The flow's trigger condition is not evaluated.
+func (fe *FlowExecutor) ExecuteFlowForRecord(ctx context.Context, flowID, objectAPIName string, record models.SObject, user *models.UserSession) error {
+	flow := fe.metadata.GetFlow(ctx, flowID)
+	if flow == nil {
+		return fmt.Errorf("flow %s not found", flowID)
+	}
+	if flow.Status != constants.FlowStatusActive {
+		return fmt.Errorf("flow %s is not active", flow.Name)
+	}
+	payload := RecordEventPayload{
+		ObjectAPIName: objectAPIName,
+		Record:        record,
+		CurrentUser:   user,
+	}
+	flowCtx, err := fe.enterFlow(ctx, flow, payload)
+	if err != nil {
+		return err
+	}
+	return fe.executeFlowAction(flowCtx, flow, payload)
+}
+
 // executeActionLogic executes a generic action based on type and config
 func (fe *FlowExecutor) executeActionLogic(ctx context.Context, actionType string, config map[string]interface{}, flowID, triggerType string, payload RecordEventPayload) error {
 	isBeforeTrigger := strings.EqualFold(triggerType, constants.TriggerBeforeCreate) ||
@@ -275,6 +343,46 @@ func (fe *FlowExecutor) executeActionLogic(ctx context.Context, actionType strin
 		return fe.actionSvc.ExecuteActionDirect(ctx, action, payload.Record, payload.CurrentUser)
 	}
 
+	if strings.EqualFold(actionType, constants.ActionTypeCallout) {
+		// Call an external service; response mappings land on the current record. A BEFORE
+		// trigger saves the record right after, so mapped fields are applied in-memory only.
+		calloutConfig := config
+		if isBeforeTrigger {
+			calloutConfig = make(map[string]interface{}, len(config)+1)
+			for k, v := range config {
+				calloutConfig[k] = v
+			}
+			calloutConfig[constants.ConfigWriteBack] = false
+		}
+		action := &models.ActionMetadata{
+			ID:            flowID,
+			ObjectAPIName: payload.ObjectAPIName,
+			Type:          constants.ActionTypeCallout,
+			Config:        calloutConfig,
+		}
+		return fe.actionSvc.ExecuteActionDirect(ctx, action, payload.Record, payload.CurrentUser)
+	}
+
+	if strings.EqualFold(actionType, constants.ActionTypeRunScript) {
+		// Run a script on the current record. As with callouts, a BEFORE trigger saves the
+		// record right after, so the fields the script changes are applied in-memory only.
+		scriptConfig := config
+		if isBeforeTrigger {
+			scriptConfig = make(map[string]interface{}, len(config)+1)
+			for k, v := range config {
+				scriptConfig[k] = v
+			}
+			scriptConfig[constants.ConfigWriteBack] = false
+		}
+		action := &models.ActionMetadata{
+			ID:            flowID,
+			ObjectAPIName: payload.ObjectAPIName,
+			Type:          constants.ActionTypeRunScript,
+			Config:        scriptConfig,
+		}
+		return fe.actionSvc.ExecuteActionDirect(ctx, action, payload.Record, payload.CurrentUser)
+	}
+
 	if strings.EqualFold(actionType, constants.ActionTypeSubmitForApproval) {
 		return fe.executeApprovalLogic(ctx, config, flowID, payload)
 	}
@@ -380,7 +488,7 @@ func (fe *FlowExecutor) executeApprovalStep(ctx context.Context, instance *model
 	}
 
 	// Insert work item
-	if _, err := fe.approvalPersistence.Insert(ctx, constants.TableApprovalWorkItem, workItem, payload.CurrentUser); err != nil {
+	if _, err := fe.approvalPersistence.Insert(WithSystemTableWrite(ctx), constants.TableApprovalWorkItem, workItem, payload.CurrentUser); err != nil {
 		return fmt.Errorf("failed to create approval work item: %w", err)
 	}
 
@@ -433,7 +541,7 @@ func (fe *FlowExecutor) executeApprovalLogic(ctx context.Context, config map[str
 	}
 
 	// Insert work item
-	created, err := fe.approvalPersistence.Insert(ctx, constants.TableApprovalWorkItem, workItem, payload.CurrentUser)
+	created, err := fe.approvalPersistence.Insert(WithSystemTableWrite(ctx), constants.TableApprovalWorkItem, workItem, payload.CurrentUser)
 	if err != nil {
 		return fmt.Errorf("failed to create approval work item: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/nexuscrm/backend/internal/domain/events"
 	"github.com/nexuscrm/backend/internal/domain/ports"
 	"github.com/nexuscrm/backend/pkg/auth"
+	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,7 @@ func (m *MockMetadataService) GetSupportedEvents() []string {
 		string(events.RecordBeforeCreate),
 		string(events.RecordBeforeUpdate),
 		string(events.RecordAfterCreate), // Added support
+		string(events.RecordAfterUpdate),
 	}
 }
 
@@ -242,3 +244,47 @@ func TestFlowExecutor_HighPriorityAlert(t *testing.T) {
 		mockActionSvc.AssertNumberOfCalls(t, "ExecuteActionDirect", 0)
 	})
 }
+
+func TestFlowExecutor_UpdateLoop(t *testing.T) {
+	mockMetadata := &MockMetadataService{
+		flows: []*models.Flow{
+			{
+				ID:            "flow-bump",
+				Name:          "Bump Score",
+				Status:        constants.FlowStatusActive,
+				TriggerObject: "Lead",
+				TriggerType:   constants.TriggerAfterUpdate,
+				ActionType:    constants.ActionTypeUpdateRecord,
+				ActionConfig: map[string]interface{}{
+					constants.ConfigFieldMappings: map[string]interface{}{"score": "=score + 1"},
+				},
+			},
+		},
+	}
+
+	// The update the flow makes is saved, and its afterUpdate event carries the chain
+	var chain []TriggerFrame
+	mockActionSvc := new(MockActionService)
+	mockActionSvc.On("ExecuteActionDirect", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			chain = TriggerChain(args.Get(0).(context.Context))
+		}).Return(nil)
+
+	eventBus := NewMockEventBus()
+	executor := NewFlowExecutor(mockMetadata, mockActionSvc, eventBus, nil, nil)
+	executor.RegisterFlowHandlers()
+
+	var err error
+	for i := 0; i < MaxTriggerDepth && err == nil; i++ {
+		err = eventBus.Publish(context.Background(), events.RecordAfterUpdate, RecordEventPayload{
+			ObjectAPIName: "Lead",
+			Record:        models.SObject{constants.FieldID: "lead-1", "score": i},
+			Triggers:      chain,
+		})
+	}
+
+	assert.True(t, errors.IsTriggerRecursion(err), "expected a trigger recursion error, got %v", err)
+	assert.Contains(t, err.Error(), "flow Bump Score keeps re-triggering itself on Lead/lead-1")
+	// The flow ran for the user's save and once more for its own update
+	mockActionSvc.AssertNumberOfCalls(t, "ExecuteActionDirect", 2)
+}
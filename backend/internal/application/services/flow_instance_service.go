@@ -73,7 +73,7 @@ func (s *FlowInstanceService) CreateInstance(ctx context.Context, flow *models.F
 		data[constants.FieldCreatedByID] = *instance.CreatedByID
 	}
 
-	created, err := s.persistence.Insert(ctx, constants.TableFlowInstance, data, user)
+	created, err := s.persistence.Insert(WithSystemTableWrite(ctx), constants.TableFlowInstance, data, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create flow instance: %w", err)
 	}
@@ -105,7 +105,7 @@ func (s *FlowInstanceService) PauseInstance(ctx context.Context, instanceID, cur
 		constants.FieldSysFlowInstance_PausedDate:    now,
 	}
 
-	if err := s.persistence.Update(ctx, constants.TableFlowInstance, instanceID, updates, user); err != nil {
+	if err := s.persistence.Update(WithSystemTableWrite(ctx), constants.TableFlowInstance, instanceID, updates, user); err != nil {
 		return fmt.Errorf("failed to pause flow instance: %w", err)
 	}
 
@@ -134,7 +134,7 @@ func (s *FlowInstanceService) ResumeInstance(ctx context.Context, instanceID, ne
 		constants.FieldSysFlowInstance_PausedDate:    nil, // Clear paused date
 	}
 
-	if err := s.persistence.Update(ctx, constants.TableFlowInstance, instanceID, updates, user); err != nil {
+	if err := s.persistence.Update(WithSystemTableWrite(ctx), constants.TableFlowInstance, instanceID, updates, user); err != nil {
 		return fmt.Errorf("failed to resume flow instance: %w", err)
 	}
 
@@ -163,7 +163,7 @@ func (s *FlowInstanceService) CompleteInstance(ctx context.Context, instanceID s
 		constants.FieldSysFlowInstance_CompletedDate: now,
 	}
 
-	if err := s.persistence.Update(ctx, constants.TableFlowInstance, instanceID, updates, user); err != nil {
+	if err := s.persistence.Update(WithSystemTableWrite(ctx), constants.TableFlowInstance, instanceID, updates, user); err != nil {
 		return fmt.Errorf("failed to complete flow instance: %w", err)
 	}
 
@@ -191,7 +191,7 @@ func (s *FlowInstanceService) FailInstance(ctx context.Context, instanceID, reas
 		constants.FieldSysFlowInstance_ContextData: fmt.Sprintf(`{"error": "%s"}`, reason),
 	}
 
-	if err := s.persistence.Update(ctx, constants.TableFlowInstance, instanceID, updates, user); err != nil {
+	if err := s.persistence.Update(WithSystemTableWrite(ctx), constants.TableFlowInstance, instanceID, updates, user); err != nil {
 		return fmt.Errorf("failed to mark flow instance as failed: %w", err)
 	}
 
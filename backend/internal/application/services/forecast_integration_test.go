@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecast_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	deal := h.CreateObject(t, "deal",
+		testharness.Field("amount", constants.FieldTypeCurrency),
+		testharness.Field("close_date", constants.FieldTypeDate),
+	)
+	require.NoError(t, h.Services.Metadata.CreateField(ctx, deal.APIName, &models.FieldMetadata{
+		APIName: "stage", Label: "Stage", Type: constants.FieldTypePicklist,
+		Options: []string{"Prospect", "Commit", "Won", "Lost"},
+	}))
+
+	profile := constants.ProfileStandardUser
+	require.NoError(t, h.Services.Permissions.UpdateObjectPermission(models.SystemObjectPerms{
+		ProfileID: &profile, ObjectAPIName: deal.APIName, AllowRead: true,
+	}))
+
+	svc := h.Services.Forecasts
+	_, err := svc.GetForecast(ctx, deal.APIName, "2026-Q4", h.Admin)
+	require.Error(t, err, "forecasting needs a setting")
+	_, err = svc.SaveSetting(ctx, deal.APIName, models.ForecastSetting{
+		AmountField: "amount", CloseDateField: "close_date", StageField: "stage",
+		CategoryMapping: map[string]string{"Unknown": constants.ForecastCategoryCommit},
+	})
+	require.Error(t, err, "mapped stages must be picklist values")
+	setting, err := svc.SaveSetting(ctx, deal.APIName, models.ForecastSetting{
+		AmountField: "amount", CloseDateField: "close_date", StageField: "stage",
+		CategoryMapping: map[string]string{
+			"Commit": constants.ForecastCategoryCommit,
+			"Won":    constants.ForecastCategoryClosed,
+			"Lost":   constants.ForecastCategoryOmitted,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, constants.ForecastCategoryClosed, setting.CategoryMapping["Won"])
+
+	managerRole, err := h.Services.Permissions.CreateRole(ctx, testharness.UniqueName("manager"), "", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = h.Services.Permissions.DeleteRole(ctx, managerRole.ID) })
+	repRole, err := h.Services.Permissions.CreateRole(ctx, testharness.UniqueName("rep"), "", &managerRole.ID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = h.Services.Permissions.DeleteRole(ctx, repRole.ID) })
+
+	manager := h.CreateUser(t, testharness.WithRole(managerRole.ID))
+	rep := h.CreateUser(t, testharness.WithRole(repRole.ID))
+
+	for _, r := range []models.SObject{
+		{"name": "A", "amount": 100, "close_date": "2026-10-15", "stage": "Prospect"},
+		{"name": "B", "amount": 200, "close_date": "2026-11-01", "stage": "Commit"},
+		{"name": "C", "amount": 300, "close_date": "2026-12-31", "stage": "Won"},
+		{"name": "D", "amount": 400, "close_date": "2027-01-01", "stage": "Won"}, // Next quarter
+		{"name": "E", "amount": 500, "close_date": "2026-10-20", "stage": "Lost"},
+	} {
+		r[constants.FieldOwnerID] = rep.ID
+		h.CreateRecord(t, deal.APIName, r)
+	}
+
+	quota := 600.0
+	_, err = svc.SaveQuotas(ctx, models.ForecastQuotaInput{
+		ObjectAPIName: deal.APIName, Period: "2026-Q4",
+		Quotas: []models.ForecastQuotaItem{{UserID: rep.ID, Amount: &quota}},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.SaveAdjustment(ctx, models.ForecastAdjustmentInput{
+		ObjectAPIName: deal.APIName, UserID: manager.ID, Period: "2026-Q4", Category: constants.ForecastCategoryCommit, Amount: 1,
+	}, rep)
+	require.Error(t, err, "reps cannot adjust their manager's forecast")
+	adjustment, err := svc.SaveAdjustment(ctx, models.ForecastAdjustmentInput{
+		ObjectAPIName: deal.APIName, UserID: rep.ID, Period: "2026-Q4", Category: constants.ForecastCategoryCommit, Amount: 150,
+	}, manager)
+	require.NoError(t, err)
+	assert.Equal(t, manager.ID, adjustment.AdjustedByID)
+
+	rollup, err := svc.GetForecast(ctx, deal.APIName, "2026-Q4", manager)
+	require.NoError(t, err)
+	require.Len(t, rollup.Roles, 1, "managers see their own role")
+	node := rollup.Roles[0]
+	require.Len(t, node.Children, 1)
+	require.Len(t, node.Children[0].Users, 1)
+	repForecast := node.Children[0].Users[0]
+	assert.Equal(t, 100.0, repForecast.Amounts[constants.ForecastCategoryPipeline])
+	assert.Equal(t, 200.0, repForecast.Amounts[constants.ForecastCategoryCommit])
+	assert.Equal(t, 150.0, repForecast.Adjusted[constants.ForecastCategoryCommit])
+	assert.Equal(t, 300.0, repForecast.Amounts[constants.ForecastCategoryClosed])
+	assert.Equal(t, 500.0, repForecast.Amounts[constants.ForecastCategoryOmitted])
+	assert.Equal(t, 600.0, node.Quota)
+	require.NotNil(t, node.Attainment)
+	assert.InDelta(t, 0.5, *node.Attainment, 1e-9)
+
+	rollup, err = svc.GetForecast(ctx, deal.APIName, "2026-Q4", rep)
+	require.NoError(t, err)
+	require.Len(t, rollup.Roles, 1)
+	assert.Empty(t, rollup.Roles[0].Children, "reps only see their own forecast")
+
+	require.NoError(t, svc.DeleteAdjustment(ctx, adjustment.ID, manager))
+	rollup, err = svc.GetForecast(ctx, deal.APIName, "2026-Q4", rep)
+	require.NoError(t, err)
+	assert.Equal(t, 200.0, rollup.Roles[0].Adjusted[constants.ForecastCategoryCommit])
+}
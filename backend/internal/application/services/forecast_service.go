@@ -0,0 +1,536 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ForecastService rolls the amounts of an object (typically opportunity) up the role
+// hierarchy per forecast category. Each stage maps to a category; users have quotas per
+// period, and managers can override the amount a subordinate forecasts in a category.
+type ForecastService struct {
+	repo        *persistence.ForecastRepository
+	users       *persistence.UserRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+}
+
+// NewForecastService creates a new ForecastService
+func NewForecastService(
+	repo *persistence.ForecastRepository,
+	users *persistence.UserRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+) *ForecastService {
+	return &ForecastService{
+		repo:        repo,
+		users:       users,
+		metadata:    metadata,
+		permissions: permissions,
+	}
+}
+
+// forecastUserFigures holds what a user contributes to a period's forecast
+type forecastUserFigures struct {
+	amounts     map[string]float64
+	quota       *float64
+	adjustments []*models.SystemForecastAdjustment
+}
+
+// GetSetting returns the forecast setting of an object
+func (s *ForecastService) GetSetting(ctx context.Context, objectAPIName string) (*models.ForecastSetting, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	stored, err := s.repo.GetSetting(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, errors.NewNotFoundError("ForecastSetting", schema.APIName)
+	}
+	setting := &models.ForecastSetting{
+		ObjectAPIName:   stored.ObjectAPIName,
+		AmountField:     stored.AmountField,
+		CloseDateField:  stored.CloseDateField,
+		StageField:      stored.StageField,
+		CategoryMapping: map[string]string{},
+	}
+	if len(stored.CategoryMapping) > 0 {
+		if err := json.Unmarshal(stored.CategoryMapping, &setting.CategoryMapping); err != nil {
+			return nil, fmt.Errorf("invalid forecast setting of %s: %w", schema.APIName, err)
+		}
+	}
+	return setting, nil
+}
+
+// SaveSetting validates and stores the forecast setting of an object
+func (s *ForecastService) SaveSetting(ctx context.Context, objectAPIName string, setting models.ForecastSetting) (*models.ForecastSetting, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	amount := FindField(schema, setting.AmountField)
+	if amount == nil || (amount.Type != constants.FieldTypeCurrency && amount.Type != constants.FieldTypeNumber) {
+		return nil, errors.NewValidationError("amount_field", fmt.Sprintf("%s has no currency or number field '%s'", schema.APIName, setting.AmountField))
+	}
+	closeDate := FindField(schema, setting.CloseDateField)
+	if closeDate == nil || (closeDate.Type != constants.FieldTypeDate && closeDate.Type != constants.FieldTypeDateTime) {
+		return nil, errors.NewValidationError("close_date_field", fmt.Sprintf("%s has no date field '%s'", schema.APIName, setting.CloseDateField))
+	}
+	stage := FindField(schema, setting.StageField)
+	if stage == nil || stage.Type != constants.FieldTypePicklist {
+		return nil, errors.NewValidationError("stage_field", fmt.Sprintf("%s has no picklist field '%s'", schema.APIName, setting.StageField))
+	}
+
+	values := make(map[string]bool)
+	for _, v := range append(append([]string{}, stage.Options...), stage.InactiveOptions...) {
+		values[v] = true
+	}
+	mapping := make(map[string]string, len(setting.CategoryMapping))
+	for value, category := range setting.CategoryMapping {
+		if !values[value] {
+			return nil, errors.NewValidationError("category_mapping", fmt.Sprintf("'%s' is not a value of %s.%s", value, schema.APIName, stage.APIName))
+		}
+		if !isForecastCategory(category) {
+			return nil, errors.NewValidationError("category_mapping", fmt.Sprintf("unknown forecast category '%s'; use %s", category, strings.Join(constants.ForecastCategories, ", ")))
+		}
+		mapping[value] = category
+	}
+
+	mappingJSON, _ := json.Marshal(mapping)
+	if err := s.repo.UpsertSetting(ctx, &models.SystemForecastSetting{
+		ID:              GenerateID(),
+		ObjectAPIName:   schema.APIName,
+		AmountField:     amount.APIName,
+		CloseDateField:  closeDate.APIName,
+		StageField:      stage.APIName,
+		CategoryMapping: mappingJSON,
+	}); err != nil {
+		return nil, err
+	}
+	return s.GetSetting(ctx, schema.APIName)
+}
+
+// GetQuotas returns the quotas of a period
+func (s *ForecastService) GetQuotas(ctx context.Context, objectAPIName, period string) ([]*models.SystemForecastQuota, error) {
+	setting, err := s.GetSetting(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := ParseForecastPeriod(period); err != nil {
+		return nil, err
+	}
+	return s.repo.FindQuotas(ctx, setting.ObjectAPIName, period)
+}
+
+// SaveQuotas sets the quotas of users for a period
+func (s *ForecastService) SaveQuotas(ctx context.Context, input models.ForecastQuotaInput) ([]*models.SystemForecastQuota, error) {
+	setting, err := s.GetSetting(ctx, input.ObjectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := ParseForecastPeriod(input.Period); err != nil {
+		return nil, err
+	}
+	amounts := make(map[string]*float64, len(input.Quotas))
+	for _, q := range input.Quotas {
+		if q.UserID == "" {
+			return nil, errors.NewValidationError("user_id", "Each quota needs a user")
+		}
+		if q.Amount != nil && *q.Amount < 0 {
+			return nil, errors.NewValidationError("amount", "Quotas cannot be negative")
+		}
+		amounts[q.UserID] = q.Amount
+	}
+	if err := s.repo.SaveQuotas(ctx, setting.ObjectAPIName, input.Period, amounts); err != nil {
+		return nil, err
+	}
+	return s.repo.FindQuotas(ctx, setting.ObjectAPIName, input.Period)
+}
+
+// SaveAdjustment overrides the amount a user forecasts in a category for a period. Users
+// adjust their own forecast, managers those of users below them in the role hierarchy.
+func (s *ForecastService) SaveAdjustment(ctx context.Context, input models.ForecastAdjustmentInput, currentUser *models.UserSession) (*models.SystemForecastAdjustment, error) {
+	setting, err := s.GetSetting(ctx, input.ObjectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := ParseForecastPeriod(input.Period); err != nil {
+		return nil, err
+	}
+	if !isForecastCategory(input.Category) || input.Category == constants.ForecastCategoryOmitted {
+		return nil, errors.NewValidationError("category", fmt.Sprintf("cannot adjust forecast category '%s'", input.Category))
+	}
+	if err := s.checkCanAdjust(ctx, input.UserID, currentUser); err != nil {
+		return nil, err
+	}
+
+	adjustment := &models.SystemForecastAdjustment{
+		ObjectAPIName: setting.ObjectAPIName,
+		UserID:        input.UserID,
+		Period:        input.Period,
+		Category:      input.Category,
+		Amount:        input.Amount,
+		Note:          input.Note,
+		AdjustedByID:  currentUser.ID,
+	}
+	if err := s.repo.UpsertAdjustment(ctx, adjustment); err != nil {
+		return nil, err
+	}
+	adjustments, err := s.repo.FindAdjustments(ctx, setting.ObjectAPIName, input.Period)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range adjustments {
+		if a.UserID == input.UserID && a.Category == input.Category {
+			return a, nil
+		}
+	}
+	return adjustment, nil
+}
+
+// DeleteAdjustment removes an adjustment, restoring the computed amount
+func (s *ForecastService) DeleteAdjustment(ctx context.Context, id string, currentUser *models.UserSession) error {
+	adjustment, err := s.repo.GetAdjustment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if adjustment == nil {
+		return errors.NewNotFoundError("ForecastAdjustment", id)
+	}
+	if err := s.checkCanAdjust(ctx, adjustment.UserID, currentUser); err != nil {
+		return err
+	}
+	return s.repo.DeleteAdjustment(ctx, id)
+}
+
+// checkCanAdjust allows administrators, the user themselves and the managers above them
+func (s *ForecastService) checkCanAdjust(ctx context.Context, userID string, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	target, err := s.users.GetUserByID(ctx, userID)
+	if err != nil || target == nil {
+		return errors.NewNotFoundError("User", userID)
+	}
+	if isForecastAdmin(currentUser) || target.ID == currentUser.ID {
+		return nil
+	}
+	roleID, err := s.users.GetUserRoleID(ctx, target.ID)
+	if err != nil {
+		return err
+	}
+	if s.permissions.isUserAboveInHierarchy(currentUser.RoleID, roleID) {
+		return nil
+	}
+	return errors.NewPermissionError("adjust", "forecast of "+userID)
+}
+
+// GetForecast computes the forecast of a period. Administrators see every role; other users
+// see their own forecast and the roles below theirs. Amounts cover all records of the object
+// owned by the users shown, like report summaries; records owned by queues are not forecast.
+func (s *ForecastService) GetForecast(ctx context.Context, objectAPIName, period string, currentUser *models.UserSession) (*models.ForecastRollup, error) {
+	if currentUser == nil {
+		return nil, errors.NewUnauthorizedError("User session not found")
+	}
+	setting, err := s.GetSetting(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	schema := s.metadata.GetSchema(ctx, setting.ObjectAPIName)
+	if err := s.permissions.CheckPermissionOrErrorWithUser(ctx, schema.APIName, constants.PermRead, currentUser); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{setting.AmountField, setting.StageField} {
+		if !s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field, currentUser) {
+			return nil, errors.NewPermissionError("read", schema.APIName+"."+field)
+		}
+	}
+	start, end, err := ParseForecastPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := s.repo.SumByOwner(ctx, schema.APIName, setting.StageField, setting.AmountField, setting.CloseDateField, start, end)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := s.repo.FindQuotas(ctx, schema.APIName, period)
+	if err != nil {
+		return nil, err
+	}
+	adjustments, err := s.repo.FindAdjustments(ctx, schema.APIName, period)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.permissions.GetAllRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.users.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	figures := forecastFigures(setting, sums, quotas, adjustments)
+	roots, byRole := forecastTree(roles, users, figures)
+	if !isForecastAdmin(currentUser) {
+		roots = scopeForecastTree(roots, byRole, currentUser)
+	}
+	for _, root := range roots {
+		totalForecastNode(root)
+	}
+
+	return &models.ForecastRollup{
+		ObjectAPIName: schema.APIName,
+		Period:        period,
+		PeriodStart:   start.Format(time.DateOnly),
+		PeriodEnd:     end.Format(time.DateOnly),
+		Categories:    constants.ForecastCategories,
+		Roles:         roots,
+	}, nil
+}
+
+// ParseForecastPeriod returns the [start, end) dates of a forecast period: a year ("2026"),
+// a quarter ("2026-Q4") or a month ("2026-10")
+func ParseForecastPeriod(period string) (time.Time, time.Time, error) {
+	invalid := errors.NewValidationError("period", fmt.Sprintf("invalid forecast period '%s'; use YYYY, YYYY-Qn or YYYY-MM", period))
+	year, rest, _ := strings.Cut(period, "-")
+	y, err := strconv.Atoi(year)
+	if err != nil || len(year) != 4 {
+		return time.Time{}, time.Time{}, invalid
+	}
+	switch {
+	case rest == "" && !strings.Contains(period, "-"):
+		start := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0), nil
+	case len(rest) == 2 && rest[0] == 'Q':
+		q, err := strconv.Atoi(rest[1:])
+		if err != nil || q < 1 || q > 4 {
+			return time.Time{}, time.Time{}, invalid
+		}
+		start := time.Date(y, time.Month(3*(q-1)+1), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 3, 0), nil
+	case len(rest) == 2:
+		m, err := strconv.Atoi(rest)
+		if err != nil || m < 1 || m > 12 {
+			return time.Time{}, time.Time{}, invalid
+		}
+		start := time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0), nil
+	}
+	return time.Time{}, time.Time{}, invalid
+}
+
+func isForecastCategory(category string) bool {
+	for _, c := range constants.ForecastCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func isForecastAdmin(user *models.UserSession) bool {
+	return user.IsSystemAdmin || constants.IsSuperUser(user.ProfileID)
+}
+
+// newForecastAmounts returns zeroed amounts for every category
+func newForecastAmounts() map[string]float64 {
+	amounts := make(map[string]float64, len(constants.ForecastCategories))
+	for _, c := range constants.ForecastCategories {
+		amounts[c] = 0
+	}
+	return amounts
+}
+
+// forecastFigures groups the sums, quotas and adjustments of a period by user; stages
+// without a mapping count as Pipeline
+func forecastFigures(setting *models.ForecastSetting, sums []persistence.ForecastSum, quotas []*models.SystemForecastQuota, adjustments []*models.SystemForecastAdjustment) map[string]*forecastUserFigures {
+	figures := make(map[string]*forecastUserFigures)
+	get := func(userID string) *forecastUserFigures {
+		f, ok := figures[userID]
+		if !ok {
+			f = &forecastUserFigures{amounts: newForecastAmounts()}
+			figures[userID] = f
+		}
+		return f
+	}
+	for _, sum := range sums {
+		category, ok := setting.CategoryMapping[sum.Stage]
+		if !ok {
+			category = constants.ForecastCategoryPipeline
+		}
+		get(sum.OwnerID).amounts[category] += sum.Amount
+	}
+	for _, q := range quotas {
+		amount := q.Amount
+		get(q.UserID).quota = &amount
+	}
+	for _, a := range adjustments {
+		f := get(a.UserID)
+		f.adjustments = append(f.adjustments, a)
+	}
+	return figures
+}
+
+// forecastTree builds one node per role with its users and subordinate roles. Users without
+// a role are gathered in a trailing node without role ID. Inactive and portal users are
+// left out unless they have figures in the period.
+func forecastTree(roles []*models.SystemRole, users []*models.SystemUser, figures map[string]*forecastUserFigures) ([]*models.ForecastNode, map[string]*models.ForecastNode) {
+	byRole := make(map[string]*models.ForecastNode, len(roles))
+	for _, role := range roles {
+		id := role.ID
+		byRole[id] = &models.ForecastNode{RoleID: &id, RoleName: role.Name, Users: []models.ForecastUser{}, Children: []*models.ForecastNode{}}
+	}
+	roots := make([]*models.ForecastNode, 0)
+	for _, role := range roles {
+		node := byRole[role.ID]
+		if role.ParentRoleID != nil {
+			if parent, ok := byRole[*role.ParentRoleID]; ok && parent != node {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	noRole := &models.ForecastNode{Users: []models.ForecastUser{}, Children: []*models.ForecastNode{}}
+	for _, u := range users {
+		f := figures[u.ID]
+		if f == nil && (!u.IsActive || u.UserType == constants.UserTypePortal) {
+			continue
+		}
+		node := noRole
+		if u.RoleID != nil {
+			if n, ok := byRole[*u.RoleID]; ok {
+				node = n
+			}
+		}
+		node.Users = append(node.Users, forecastUser(u, f))
+	}
+	if len(noRole.Users) > 0 {
+		roots = append(roots, noRole)
+	}
+
+	sortForecastNodes(roots)
+	return roots, byRole
+}
+
+// forecastUser computes the amounts of a user, applying their adjustments
+func forecastUser(u *models.SystemUser, f *forecastUserFigures) models.ForecastUser {
+	name := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	if name == "" {
+		name = u.Username
+	}
+	fu := models.ForecastUser{UserID: u.ID, Name: name, Amounts: newForecastAmounts(), Adjusted: newForecastAmounts()}
+	if f == nil {
+		return fu
+	}
+	for c, v := range f.amounts {
+		fu.Amounts[c] = v
+		fu.Adjusted[c] = v
+	}
+	for _, a := range f.adjustments {
+		fu.Adjusted[a.Category] = a.Amount
+	}
+	fu.Adjustments = f.adjustments
+	fu.Quota = f.quota
+	fu.Attainment = forecastAttainment(fu.Adjusted, f.quota)
+	return fu
+}
+
+// scopeForecastTree keeps the role of a user with only them in it, and the roles below it
+func scopeForecastTree(roots []*models.ForecastNode, byRole map[string]*models.ForecastNode, user *models.UserSession) []*models.ForecastNode {
+	var node *models.ForecastNode
+	if user.RoleID != nil {
+		node = byRole[*user.RoleID]
+	}
+	if node == nil {
+		for _, root := range roots {
+			if root.RoleID == nil {
+				node = root
+			}
+		}
+	}
+	if node == nil {
+		return []*models.ForecastNode{}
+	}
+	own := make([]models.ForecastUser, 0, 1)
+	for _, u := range node.Users {
+		if u.UserID == user.ID {
+			own = append(own, u)
+		}
+	}
+	node.Users = own
+	if node.RoleID == nil && len(own) == 0 {
+		return []*models.ForecastNode{}
+	}
+	return []*models.ForecastNode{node}
+}
+
+// totalForecastNode sums the quotas and amounts of a node's users and subordinate roles
+func totalForecastNode(node *models.ForecastNode) {
+	node.Quota = 0
+	node.Amounts = newForecastAmounts()
+	node.Adjusted = newForecastAmounts()
+	for _, u := range node.Users {
+		if u.Quota != nil {
+			node.Quota += *u.Quota
+		}
+		for c, v := range u.Amounts {
+			node.Amounts[c] += v
+		}
+		for c, v := range u.Adjusted {
+			node.Adjusted[c] += v
+		}
+	}
+	for _, child := range node.Children {
+		totalForecastNode(child)
+		node.Quota += child.Quota
+		for c, v := range child.Amounts {
+			node.Amounts[c] += v
+		}
+		for c, v := range child.Adjusted {
+			node.Adjusted[c] += v
+		}
+	}
+	node.Attainment = nil
+	if node.Quota > 0 {
+		node.Attainment = forecastAttainment(node.Adjusted, &node.Quota)
+	}
+}
+
+func forecastAttainment(adjusted map[string]float64, quota *float64) *float64 {
+	if quota == nil || *quota <= 0 {
+		return nil
+	}
+	attainment := adjusted[constants.ForecastCategoryClosed] / *quota
+	return &attainment
+}
+
+// sortForecastNodes orders roles and users by name; the node without role stays last
+func sortForecastNodes(nodes []*models.ForecastNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if (nodes[i].RoleID == nil) != (nodes[j].RoleID == nil) {
+			return nodes[j].RoleID == nil
+		}
+		return nodes[i].RoleName < nodes[j].RoleName
+	})
+	for _, n := range nodes {
+		sort.SliceStable(n.Users, func(i, j int) bool { return n.Users[i].Name < n.Users[j].Name })
+		sortForecastNodes(n.Children)
+	}
+}
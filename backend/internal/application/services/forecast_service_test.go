@@ -0,0 +1,122 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForecastPeriod(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		return d
+	}
+	cases := map[string][2]string{
+		"2026":    {"2026-01-01", "2027-01-01"},
+		"2026-Q1": {"2026-01-01", "2026-04-01"},
+		"2026-Q4": {"2026-10-01", "2027-01-01"},
+		"2026-02": {"2026-02-01", "2026-03-01"},
+		"2026-12": {"2026-12-01", "2027-01-01"},
+	}
+	for period, want := range cases {
+		start, end, err := ParseForecastPeriod(period)
+		require.NoError(t, err, period)
+		assert.Equal(t, date(want[0]), start, period)
+		assert.Equal(t, date(want[1]), end, period)
+	}
+
+	for _, period := range []string{"", "26", "2026-", "2026-Q5", "2026-Q0", "2026-13", "2026-1", "Q4-2026", "2026-10-01"} {
+		_, _, err := ParseForecastPeriod(period)
+		assert.Error(t, err, period)
+	}
+}
+
+func TestForecastRollup(t *testing.T) {
+	str := func(s string) *string { return &s }
+	roles := []*models.SystemRole{
+		{ID: "vp", Name: "VP Sales"},
+		{ID: "east", Name: "East", ParentRoleID: str("vp")},
+		{ID: "west", Name: "West", ParentRoleID: str("vp")},
+	}
+	users := []*models.SystemUser{
+		{ID: "boss", FirstName: "Vera", LastName: "Boss", RoleID: str("vp"), IsActive: true},
+		{ID: "ann", FirstName: "Ann", RoleID: str("east"), IsActive: true},
+		{ID: "bob", FirstName: "Bob", RoleID: str("east"), IsActive: true},
+		{ID: "cid", FirstName: "Cid", RoleID: str("west"), IsActive: true},
+		{ID: "gone", FirstName: "Gone", RoleID: str("west")},
+		{ID: "solo", Username: "solo", IsActive: true},
+	}
+	setting := &models.ForecastSetting{CategoryMapping: map[string]string{
+		"Proposal": constants.ForecastCategoryBestCase,
+		"Contract": constants.ForecastCategoryCommit,
+		"Won":      constants.ForecastCategoryClosed,
+		"Lost":     constants.ForecastCategoryOmitted,
+	}}
+	sums := []persistence.ForecastSum{
+		{OwnerID: "ann", Stage: "New", Amount: 100},
+		{OwnerID: "ann", Stage: "Won", Amount: 300},
+		{OwnerID: "bob", Stage: "Contract", Amount: 200},
+		{OwnerID: "bob", Stage: "Lost", Amount: 50},
+		{OwnerID: "cid", Stage: "Proposal", Amount: 400},
+		{OwnerID: "queue-1", Stage: "Won", Amount: 999}, // Not a user
+	}
+	quotas := []*models.SystemForecastQuota{{UserID: "ann", Amount: 600}, {UserID: "cid", Amount: 400}}
+	adjustments := []*models.SystemForecastAdjustment{{UserID: "bob", Category: constants.ForecastCategoryCommit, Amount: 150}}
+
+	build := func() ([]*models.ForecastNode, map[string]*models.ForecastNode) {
+		return forecastTree(roles, users, forecastFigures(setting, sums, quotas, adjustments))
+	}
+
+	roots, _ := build()
+	for _, root := range roots {
+		totalForecastNode(root)
+	}
+	require.Len(t, roots, 2, "VP role and users without role")
+	vp := roots[0]
+	assert.Equal(t, "VP Sales", vp.RoleName)
+	require.Len(t, vp.Children, 2)
+	east, west := vp.Children[0], vp.Children[1]
+	assert.Equal(t, "East", east.RoleName)
+	assert.Len(t, west.Users, 1, "inactive users without figures are left out")
+	assert.Nil(t, roots[1].RoleID)
+	assert.Equal(t, "solo", roots[1].Users[0].Name)
+
+	ann := east.Users[0]
+	assert.Equal(t, "Ann", ann.Name)
+	assert.Equal(t, 100.0, ann.Amounts[constants.ForecastCategoryPipeline], "unmapped stages are pipeline")
+	require.NotNil(t, ann.Attainment)
+	assert.InDelta(t, 0.5, *ann.Attainment, 1e-9)
+
+	bob := east.Users[1]
+	assert.Equal(t, 200.0, bob.Amounts[constants.ForecastCategoryCommit])
+	assert.Equal(t, 150.0, bob.Adjusted[constants.ForecastCategoryCommit])
+	assert.Equal(t, 50.0, bob.Adjusted[constants.ForecastCategoryOmitted])
+
+	assert.Equal(t, 200.0, east.Amounts[constants.ForecastCategoryCommit])
+	assert.Equal(t, 150.0, east.Adjusted[constants.ForecastCategoryCommit])
+	assert.Equal(t, 1000.0, vp.Quota)
+	assert.Equal(t, 300.0, vp.Adjusted[constants.ForecastCategoryClosed], "amounts of records owned by queues are not forecast")
+	assert.Equal(t, 400.0, vp.Adjusted[constants.ForecastCategoryBestCase])
+	require.NotNil(t, vp.Attainment)
+	assert.InDelta(t, 0.3, *vp.Attainment, 1e-9)
+
+	// A manager sees their own forecast and the roles below; peers in their role are hidden
+	roots, byRole := build()
+	scoped := scopeForecastTree(roots, byRole, &models.UserSession{ID: "ann", RoleID: str("east")})
+	require.Len(t, scoped, 1)
+	totalForecastNode(scoped[0])
+	require.Len(t, scoped[0].Users, 1)
+	assert.Equal(t, "ann", scoped[0].Users[0].UserID)
+	assert.Equal(t, 0.0, scoped[0].Adjusted[constants.ForecastCategoryCommit])
+
+	roots, byRole = build()
+	scoped = scopeForecastTree(roots, byRole, &models.UserSession{ID: "solo"})
+	require.Len(t, scoped, 1)
+	assert.Len(t, scoped[0].Users, 1)
+}
@@ -16,7 +16,7 @@ import (
 
 func TestFormulaFields_Integration(t *testing.T) {
 	// Setup Dependencies
-	dbConn, err := database.GetInstance()
+	dbConn, err := database.GetTestInstance()
 	if err != nil {
 		t.Fatalf("Failed to get DB instance: %v", err)
 	}
@@ -50,7 +50,7 @@ func TestFormulaFields_Integration(t *testing.T) {
 	ps := services.NewPersistenceService(recordRepo, rollupSvc, metadataService, permService, eventBus, validationSvc, txManager, outboxSvc)
 
 	queryRepo := persistence.NewQueryRepository(db)
-	qs := services.NewQueryService(queryRepo, metadataService, permService)
+	qs := services.NewQueryService(queryRepo, metadataService, permService, nil)
 
 	// Context
 	ctx := context.Background()
@@ -288,7 +288,7 @@ func TestFormulaFields_Integration(t *testing.T) {
 // TestFormulaValidation tests formula syntax validation on field creation
 func TestFormulaValidation(t *testing.T) {
 	// Setup Dependencies
-	dbConn, err := database.GetInstance()
+	dbConn, err := database.GetTestInstance()
 	if err != nil {
 		t.Fatalf("Failed to get DB instance: %v", err)
 	}
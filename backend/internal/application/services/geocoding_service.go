@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GeocodeMapping places a Geolocation field from address fields of the same record
+type GeocodeMapping struct {
+	Object  string
+	Field   string
+	Address []string // Joined with ", " in order, skipping blank values
+}
+
+// parseGeocodeFields parses GEOCODE_FIELDS, a comma-separated list of
+// object.location_field:address_field+address_field entries
+// (e.g. "account.location:billing_street+billing_city+billing_country")
+func parseGeocodeFields(raw string) []GeocodeMapping {
+	mappings := make([]GeocodeMapping, 0)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		target, sources, ok := strings.Cut(entry, ":")
+		object, field, targetOK := strings.Cut(strings.TrimSpace(target), ".")
+		address := make([]string, 0)
+		for _, source := range strings.Split(sources, "+") {
+			if source = strings.TrimSpace(source); source != "" {
+				address = append(address, strings.ToLower(source))
+			}
+		}
+		if !ok || !targetOK || object == "" || field == "" || len(address) == 0 {
+			log.Printf("⚠️  Invalid GEOCODE_FIELDS entry %q, expected object.field:address_field+address_field", entry)
+			continue
+		}
+		mappings = append(mappings, GeocodeMapping{Object: strings.ToLower(object), Field: strings.ToLower(field), Address: address})
+	}
+	return mappings
+}
+
+// GeocodingService fills Geolocation fields from address fields when a record is created
+// or its address changes. Locations set by hand are kept until the address changes.
+type GeocodingService struct {
+	geocoder    ports.Geocoder
+	mappings    []GeocodeMapping
+	metadata    *MetadataService
+	persistence *PersistenceService
+}
+
+// NewGeocodingService creates a new GeocodingService. geocoder may be nil (geocoding disabled).
+func NewGeocodingService(geocoder ports.Geocoder, mappings []GeocodeMapping, metadata *MetadataService, persistence *PersistenceService) *GeocodingService {
+	return &GeocodingService{
+		geocoder:    geocoder,
+		mappings:    mappings,
+		metadata:    metadata,
+		persistence: persistence,
+	}
+}
+
+// Enabled reports whether a provider and at least one mapping are configured
+func (s *GeocodingService) Enabled() bool {
+	return s.geocoder != nil && len(s.mappings) > 0
+}
+
+// RegisterHandlers subscribes to after-commit record events to geocode changed addresses
+func (s *GeocodingService) RegisterHandlers(eventBus *EventBus) {
+	if !s.Enabled() {
+		return
+	}
+
+	handler := func(ctx context.Context, payload interface{}) error {
+		recordPayload, ok := payload.(RecordEventPayload)
+		if !ok {
+			return nil
+		}
+		var oldRecord models.SObject
+		if recordPayload.OldRecord != nil {
+			oldRecord = *recordPayload.OldRecord
+		}
+		for _, mapping := range s.mappings {
+			if !strings.EqualFold(mapping.Object, recordPayload.ObjectAPIName) {
+				continue
+			}
+			// Geocoding failures must not fail the outbox event (flows share the same dispatch)
+			if err := s.GeocodeRecord(ctx, mapping, recordPayload.Record, oldRecord); err != nil {
+				log.Printf("⚠️ [Geocoding] Failed to geocode %s/%s: %v", recordPayload.ObjectAPIName, recordPayload.Record.GetString(constants.FieldID), err)
+			}
+		}
+		return nil
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler)
+	eventBus.Subscribe(events.RecordUpdated, handler)
+
+	log.Printf("🧭 Geocoding (%s) subscribed to record events for %d field(s)", s.geocoder.Name(), len(s.mappings))
+}
+
+// GeocodeRecord places a record's location from its address. Nothing is written when the
+// address is blank, unchanged since oldRecord (nil for a new record) while a location is
+// set, or cannot be placed.
+func (s *GeocodingService) GeocodeRecord(ctx context.Context, mapping GeocodeMapping, record, oldRecord models.SObject) error {
+	recordID := record.GetString(constants.FieldID)
+	address := geocodeAddress(record, mapping.Address)
+	if recordID == "" || address == "" {
+		return nil
+	}
+	if record[mapping.Field] != nil && (oldRecord == nil || geocodeAddress(oldRecord, mapping.Address) == address) {
+		return nil
+	}
+
+	schema := s.metadata.GetSchema(ctx, mapping.Object)
+	if schema == nil {
+		return fmt.Errorf("object %s not found", mapping.Object)
+	}
+	if field := FindField(schema, mapping.Field); field == nil || field.Type != constants.FieldTypeGeolocation {
+		return fmt.Errorf("%s.%s is not a Geolocation field", mapping.Object, mapping.Field)
+	}
+
+	point, err := s.geocoder.Geocode(ctx, address)
+	if err != nil {
+		return err
+	}
+	if point == nil {
+		log.Printf("🧭 [Geocoding] No match for the address of %s/%s", schema.APIName, recordID)
+		return nil
+	}
+	return s.persistence.Update(ctx, schema.APIName, recordID, models.SObject{mapping.Field: *point}, geocodingUser())
+}
+
+// geocodeAddress joins the non-blank address fields of a record
+func geocodeAddress(record models.SObject, fields []string) string {
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if val, ok := record[field]; ok && val != nil {
+			if part := strings.TrimSpace(fmt.Sprintf("%v", val)); part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// geocodingUser is the session locations are written as
+func geocodingUser() *models.UserSession {
+	return &models.UserSession{
+		ID:            "system",
+		Name:          "Geocoding",
+		ProfileID:     constants.ProfileSystemAdmin,
+		IsSystemAdmin: true,
+	}
+}
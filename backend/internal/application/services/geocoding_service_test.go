@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGeocodeFields(t *testing.T) {
+	mappings := parseGeocodeFields(" Account.Location:billing_street + billing_city+billing_country, lead.geo:city,bad, contact.location:")
+
+	assert.Equal(t, []GeocodeMapping{
+		{Object: "account", Field: "location", Address: []string{"billing_street", "billing_city", "billing_country"}},
+		{Object: "lead", Field: "geo", Address: []string{"city"}},
+	}, mappings)
+	assert.Empty(t, parseGeocodeFields(""))
+}
+
+func TestGeocodeAddress(t *testing.T) {
+	record := models.SObject{"street": " 10 Downing St ", "city": "London", "zip": nil, "country": ""}
+	assert.Equal(t, "10 Downing St, London", geocodeAddress(record, []string{"street", "zip", "city", "country", "missing"}))
+	assert.Equal(t, "", geocodeAddress(record, []string{"zip", "country"}))
+}
+
+func TestApplyGeolocations(t *testing.T) {
+	schema := &models.ObjectMetadata{
+		APIName: "account",
+		Fields: []models.FieldMetadata{
+			{APIName: "name", Type: constants.FieldTypeText},
+			{APIName: "location", Type: constants.FieldTypeGeolocation},
+			{APIName: "location__latitude", Type: constants.FieldTypeNumber},
+			{APIName: "location__longitude", Type: constants.FieldTypeNumber},
+		},
+	}
+
+	data := models.SObject{"location": map[string]interface{}{"latitude": "51.5", "longitude": -0.12}}
+	require.NoError(t, applyGeolocations(schema, data))
+	assert.Equal(t, models.SObject{
+		"location":            models.GeoPoint{Latitude: 51.5, Longitude: -0.12},
+		"location__latitude":  51.5,
+		"location__longitude": -0.12,
+	}, data)
+
+	data = models.SObject{"location": nil}
+	require.NoError(t, applyGeolocations(schema, data))
+	assert.Equal(t, models.SObject{"location": nil, "location__latitude": nil, "location__longitude": nil}, data)
+
+	data = models.SObject{"name": "Acme", "location__latitude": 10.0}
+	require.NoError(t, applyGeolocations(schema, data))
+	assert.Equal(t, models.SObject{"name": "Acme"}, data, "coordinates follow their field")
+
+	assert.Error(t, applyGeolocations(schema, models.SObject{"location": `{"latitude":91,"longitude":0}`}))
+	assert.Error(t, applyGeolocations(schema, models.SObject{"location": map[string]interface{}{"latitude": 10.0}}))
+	assert.Error(t, applyGeolocations(schema, models.SObject{"location": "Paris"}))
+}
@@ -0,0 +1,46 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistanceFilter_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	obj := h.CreateObject(t, "venue", testharness.Field("title", constants.FieldTypeText), testharness.Field("location", constants.FieldTypeGeolocation))
+
+	h.CreateRecord(t, obj.APIName, models.SObject{"title": "London", "location": map[string]interface{}{"latitude": 51.5074, "longitude": -0.1278}})
+	h.CreateRecord(t, obj.APIName, models.SObject{"title": "Paris", "location": map[string]interface{}{"latitude": 48.8566, "longitude": 2.3522}})
+	h.CreateRecord(t, obj.APIName, models.SObject{"title": "New York", "location": map[string]interface{}{"latitude": 40.7128, "longitude": -74.006}})
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"DISTANCE(location, 48.8566, 2.3522) < 400", []string{"London", "Paris"}},
+		{"DISTANCE(location, 48.8566, 2.3522) < 1", []string{"Paris"}},
+		// Antipodal points push the haversine term to 1, where the clamp keeps ASIN defined
+		{"DISTANCE(location, -48.8566, -177.6478) > 20000", []string{"Paris"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			records, err := h.Services.QuerySvc.QueryWithFilter(ctx, obj.APIName, tt.filter, h.Admin, "title", constants.SortASC, 10)
+			require.NoError(t, err)
+			names := make([]string, 0, len(records))
+			for _, r := range records {
+				names = append(names, r["title"].(string))
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
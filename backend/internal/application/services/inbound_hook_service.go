@@ -0,0 +1,481 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/secrets"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// inboundHookMaxItems caps the records one delivery may save
+const inboundHookMaxItems = 200
+
+// inboundHookSlugPattern is the form of the slug in /hooks/:slug
+var inboundHookSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// InboundHookService manages inbound webhooks: admin-defined endpoints that map the JSON
+// external systems push onto record fields and create or upsert records, so data can flow
+// in without custom code. Deliveries authenticate with the hook's secret, either as an
+// HMAC-SHA256 signature of the body or sent as is.
+type InboundHookService struct {
+	repo        *persistence.InboundHookRepository
+	userRepo    *persistence.UserRepository
+	metadata    *MetadataService
+	query       *QueryService
+	persistence *PersistenceService
+	permissions *PermissionService
+}
+
+// NewInboundHookService creates a new InboundHookService
+func NewInboundHookService(repo *persistence.InboundHookRepository, userRepo *persistence.UserRepository, metadata *MetadataService, query *QueryService, persistence *PersistenceService, permissions *PermissionService) *InboundHookService {
+	return &InboundHookService{
+		repo:        repo,
+		userRepo:    userRepo,
+		metadata:    metadata,
+		query:       query,
+		persistence: persistence,
+		permissions: permissions,
+	}
+}
+
+// ==================== Configuration ====================
+
+// GetInboundHooks returns all inbound webhooks without their secrets
+func (s *InboundHookService) GetInboundHooks(ctx context.Context) ([]*models.InboundHook, error) {
+	hooks, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hooks {
+		h.Secret = ""
+	}
+	return hooks, nil
+}
+
+// GetInboundHook returns an inbound webhook without its secret
+func (s *InboundHookService) GetInboundHook(ctx context.Context, slug string) (*models.InboundHook, error) {
+	h, err := s.findInboundHook(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	h.Secret = ""
+	return h, nil
+}
+
+// CreateInboundHook validates an inbound webhook and stores it with its secret encrypted.
+// Without a secret one is generated and returned this once. Records are saved as the
+// creating user unless another run-as user is given.
+func (s *InboundHookService) CreateInboundHook(ctx context.Context, h *models.InboundHook, currentUser *models.UserSession) error {
+	h.Slug = strings.ToLower(strings.TrimSpace(h.Slug))
+	if !inboundHookSlugPattern.MatchString(h.Slug) {
+		return errors.NewValidationError(constants.FieldSysInboundHook_Slug, "must be 1-63 lowercase letters, digits or hyphens, starting with a letter or digit")
+	}
+	if strings.TrimSpace(h.Label) == "" {
+		h.Label = h.Slug
+	}
+	if h.Operation == "" {
+		h.Operation = constants.InboundHookCreate
+	}
+	if h.RunAsUserID == "" {
+		h.RunAsUserID = currentUser.ID
+	}
+	h.ObjectAPIName = strings.ToLower(h.ObjectAPIName)
+	h.IsActive = true
+	if err := s.validateInboundHook(ctx, h); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindBySlug(ctx, h.Slug)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("InboundHook", constants.FieldSysInboundHook_Slug, h.Slug)
+	}
+
+	plaintext := h.Secret
+	generated := plaintext == ""
+	if generated {
+		if plaintext, err = randomInboundHookSecret(); err != nil {
+			return err
+		}
+	}
+	if h.Secret, err = secrets.Encrypt(plaintext); err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	if h.ID == "" {
+		h.ID = GenerateID()
+	}
+	if err := s.repo.Insert(ctx, h); err != nil {
+		return err
+	}
+	h.HasSecret = true
+	h.Secret = ""
+	if generated {
+		h.Secret = plaintext
+	}
+	return nil
+}
+
+// InboundHookUpdate holds the changeable settings of an inbound webhook; nil leaves a
+// setting unchanged. The slug cannot change because external systems post to it.
+type InboundHookUpdate struct {
+	Label         *string                         `json:"label"`
+	ObjectAPIName *string                         `json:"object_api_name"`
+	Operation     *constants.InboundHookOperation `json:"operation"`
+	MatchField    *string                         `json:"match_field"`
+	RecordsPath   *string                         `json:"records_path"`
+	FieldMapping  map[string]string               `json:"field_mapping"`
+	Secret        *string                         `json:"secret"`
+	RunAsUserID   *string                         `json:"run_as_user_id"`
+	IsActive      *bool                           `json:"is_active"`
+}
+
+// UpdateInboundHook updates an inbound webhook, re-encrypting the secret when one is given.
+// An empty match field or records path clears it.
+func (s *InboundHookService) UpdateInboundHook(ctx context.Context, slug string, updates InboundHookUpdate) (*models.InboundHook, error) {
+	h, err := s.findInboundHook(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Label != nil && strings.TrimSpace(*updates.Label) != "" {
+		h.Label = *updates.Label
+	}
+	if updates.ObjectAPIName != nil {
+		h.ObjectAPIName = strings.ToLower(*updates.ObjectAPIName)
+	}
+	if updates.Operation != nil {
+		h.Operation = *updates.Operation
+	}
+	if updates.MatchField != nil {
+		h.MatchField = optionalString(*updates.MatchField)
+	}
+	if updates.RecordsPath != nil {
+		h.RecordsPath = optionalString(*updates.RecordsPath)
+	}
+	if updates.FieldMapping != nil {
+		h.FieldMapping = updates.FieldMapping
+	}
+	if updates.RunAsUserID != nil {
+		h.RunAsUserID = *updates.RunAsUserID
+	}
+	if updates.IsActive != nil {
+		h.IsActive = *updates.IsActive
+	}
+	if err := s.validateInboundHook(ctx, h); err != nil {
+		return nil, err
+	}
+
+	if updates.Secret != nil {
+		if strings.TrimSpace(*updates.Secret) == "" {
+			return nil, errors.NewValidationError(constants.FieldSysInboundHook_Secret, "cannot be empty")
+		}
+		if h.Secret, err = secrets.Encrypt(*updates.Secret); err != nil {
+			return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+		}
+	}
+	if err := s.repo.Update(ctx, h); err != nil {
+		return nil, err
+	}
+	h.Secret = ""
+	return h, nil
+}
+
+// DeleteInboundHook deletes an inbound webhook
+func (s *InboundHookService) DeleteInboundHook(ctx context.Context, slug string) error {
+	h, err := s.findInboundHook(ctx, slug)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, h.ID)
+}
+
+func (s *InboundHookService) findInboundHook(ctx context.Context, slug string) (*models.InboundHook, error) {
+	h, err := s.repo.FindBySlug(ctx, strings.ToLower(slug))
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, errors.NewNotFoundError("Inbound hook", slug)
+	}
+	return h, nil
+}
+
+// validateInboundHook checks the target object, operation, mapping and run-as user
+func (s *InboundHookService) validateInboundHook(ctx context.Context, h *models.InboundHook) error {
+	if constants.IsSystemTable(h.ObjectAPIName) {
+		return errors.NewValidationError(constants.FieldSysInboundHook_ObjectAPIName, "system objects cannot be written by inbound hooks")
+	}
+	schema, err := s.metadata.GetSchemaOrError(ctx, h.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+
+	if len(h.FieldMapping) == 0 {
+		return errors.NewValidationError(constants.FieldSysInboundHook_FieldMapping, "must map at least one field")
+	}
+	for field, path := range h.FieldMapping {
+		if FindField(schema, field) == nil {
+			return errors.NewValidationError(constants.FieldSysInboundHook_FieldMapping, fmt.Sprintf("unknown field '%s' on %s", field, h.ObjectAPIName))
+		}
+		if strings.TrimSpace(path) == "" {
+			return errors.NewValidationError(constants.FieldSysInboundHook_FieldMapping, fmt.Sprintf("field '%s' has an empty path", field))
+		}
+	}
+
+	switch h.Operation {
+	case constants.InboundHookCreate:
+		h.MatchField = nil
+	case constants.InboundHookUpsert:
+		if h.MatchField == nil {
+			return errors.NewValidationError(constants.FieldSysInboundHook_MatchField, "is required for upsert")
+		}
+		if _, ok := h.FieldMapping[*h.MatchField]; !ok {
+			return errors.NewValidationError(constants.FieldSysInboundHook_MatchField, "must be a mapped field")
+		}
+	default:
+		return errors.NewValidationError(constants.FieldSysInboundHook_Operation,
+			fmt.Sprintf("unsupported operation '%s'; expected create or upsert", h.Operation))
+	}
+
+	// A deactivated run-as user must not block switching the hook off
+	if !h.IsActive {
+		return nil
+	}
+	active, err := s.runAsUserActive(ctx, h)
+	if err != nil {
+		return err
+	}
+	if !active {
+		return errors.NewValidationError(constants.FieldSysInboundHook_RunAsUserID, "must be an active user")
+	}
+	return nil
+}
+
+func (s *InboundHookService) runAsUserActive(ctx context.Context, h *models.InboundHook) (bool, error) {
+	user, err := s.userRepo.GetUserByID(ctx, h.RunAsUserID)
+	if err != nil {
+		return false, err
+	}
+	return user != nil && user.IsActive, nil
+}
+
+// randomInboundHookSecret generates a secret for a hook created without one
+func randomInboundHookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// ==================== Deliveries ====================
+
+// InboundDelivery is a request posted to /hooks/:slug
+type InboundDelivery struct {
+	Body      []byte
+	Signature string // X-Hook-Signature: hex HMAC-SHA256 of the body, optionally prefixed "sha256="
+	Secret    string // X-Hook-Secret: the secret itself, for systems that cannot sign
+}
+
+// Receive authenticates a delivery and saves its items as the hook's run-as user. Items are
+// saved in order; the first failure stops the delivery.
+func (s *InboundHookService) Receive(ctx context.Context, slug string, delivery InboundDelivery) (*models.InboundHookResult, error) {
+	h, err := s.repo.FindBySlug(ctx, strings.ToLower(slug))
+	if err != nil {
+		return nil, err
+	}
+	// Unknown and inactive hooks look the same to callers
+	if h == nil || !h.IsActive {
+		return nil, errors.NewNotFoundError("Inbound hook", slug)
+	}
+	secret, err := secrets.Decrypt(h.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+	if !validInboundDelivery(secret, delivery) {
+		return nil, errors.NewUnauthorizedError("invalid hook signature or secret")
+	}
+
+	result, err := s.receive(ctx, h, delivery.Body)
+	var lastError *string
+	if err != nil {
+		msg := err.Error()
+		lastError = &msg
+	}
+	if saveErr := s.repo.SaveReceiveState(ctx, h.ID, time.Now(), lastError); saveErr != nil {
+		return nil, saveErr
+	}
+	return result, err
+}
+
+func (s *InboundHookService) receive(ctx context.Context, h *models.InboundHook, body []byte) (*models.InboundHookResult, error) {
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, errors.NewValidationError("body", "must be JSON")
+	}
+	items, err := inboundItems(payload, h.RecordsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.runAsUserActive(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, errors.NewForbiddenError("the hook's run-as user is inactive")
+	}
+	session, err := s.permissions.SessionForUser(ctx, h.RunAsUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.InboundHookResult{RecordIDs: make([]string, 0, len(items))}
+	for i, item := range items {
+		values := mapInboundItem(item, h.FieldMapping)
+		if len(values) == 0 {
+			return result, errors.NewValidationError(fmt.Sprintf("items[%d]", i), "none of the mapped paths are present")
+		}
+		id, created, err := s.save(ctx, h, values, session)
+		if err != nil {
+			return result, fmt.Errorf("item %d: %w", i, err)
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+		result.RecordIDs = append(result.RecordIDs, id)
+	}
+	return result, nil
+}
+
+// save creates a record from the mapped values, or updates the one matching on upsert
+func (s *InboundHookService) save(ctx context.Context, h *models.InboundHook, values models.SObject, session *models.UserSession) (string, bool, error) {
+	if h.Operation == constants.InboundHookUpsert {
+		if match, ok := values[*h.MatchField]; ok && match != nil {
+			id, err := s.findMatch(ctx, h, match, session)
+			if err != nil {
+				return "", false, err
+			}
+			if id != "" {
+				return id, false, s.persistence.Update(ctx, h.ObjectAPIName, id, values, session)
+			}
+		}
+	}
+
+	record, err := s.persistence.Insert(ctx, h.ObjectAPIName, values, session)
+	if err != nil {
+		return "", false, err
+	}
+	return record.GetString(constants.FieldID), true, nil
+}
+
+// findMatch returns the ID of the newest record whose match field equals the value, if any
+func (s *InboundHookService) findMatch(ctx context.Context, h *models.InboundHook, value interface{}, session *models.UserSession) (string, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, h.ObjectAPIName)
+	if err != nil {
+		return "", err
+	}
+	field := FindField(schema, *h.MatchField)
+	if field == nil {
+		return "", errors.NewValidationError(constants.FieldSysInboundHook_MatchField, fmt.Sprintf("unknown field '%s' on %s", *h.MatchField, h.ObjectAPIName))
+	}
+	filter, err := hookFindFilter(field, inboundMatchValue(value))
+	if err != nil {
+		return "", err
+	}
+	records, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName:   h.ObjectAPIName,
+		FilterExpr:      filter,
+		SortField:       constants.FieldCreatedDate,
+		SortDirection:   constants.SortDESC,
+		Limit:           1,
+		SkipLookupNames: true,
+	}, session)
+	if err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return "", nil
+	}
+	return records[0].GetString(constants.FieldID), nil
+}
+
+// validInboundDelivery checks the signature when one is sent, otherwise the plain secret
+func validInboundDelivery(secret string, delivery InboundDelivery) bool {
+	if delivery.Signature != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(delivery.Body)
+		expected := hex.EncodeToString(mac.Sum(nil))
+		signature := strings.ToLower(strings.TrimPrefix(delivery.Signature, "sha256="))
+		return hmac.Equal([]byte(expected), []byte(signature))
+	}
+	return delivery.Secret != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(delivery.Secret)) == 1
+}
+
+// inboundItems returns the items of a payload: the array at the records path, or the
+// payload itself (an object, or an array of objects)
+func inboundItems(payload interface{}, recordsPath *string) ([]interface{}, error) {
+	if recordsPath != nil {
+		v, ok := lookupJSONPath(payload, *recordsPath)
+		if !ok {
+			return nil, errors.NewValidationError("body", fmt.Sprintf("records path '%s' not found", *recordsPath))
+		}
+		payload = v
+	}
+
+	var items []interface{}
+	switch v := payload.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		items = []interface{}{v}
+	default:
+		return nil, errors.NewValidationError("body", "must be a JSON object or an array of objects")
+	}
+	if len(items) > inboundHookMaxItems {
+		return nil, errors.NewValidationError("body", fmt.Sprintf("at most %d items per delivery", inboundHookMaxItems))
+	}
+	return items, nil
+}
+
+// mapInboundItem extracts the mapped field values of an item; missing paths are left out
+func mapInboundItem(item interface{}, mapping map[string]string) models.SObject {
+	values := make(models.SObject, len(mapping))
+	for field, path := range mapping {
+		if v, found := lookupJSONPath(item, path); found {
+			values[field] = v
+		}
+	}
+	return values
+}
+
+// inboundMatchValue formats a JSON value for an equality filter
+func inboundMatchValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidInboundDelivery(t *testing.T) {
+	body := []byte(`{"email":"a@example.com"}`)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, validInboundDelivery("s3cret", InboundDelivery{Body: body, Signature: signature}))
+	assert.True(t, validInboundDelivery("s3cret", InboundDelivery{Body: body, Signature: "sha256=" + signature}))
+	assert.False(t, validInboundDelivery("s3cret", InboundDelivery{Body: []byte(`{}`), Signature: signature}), "signature of another body")
+	assert.False(t, validInboundDelivery("s3cret", InboundDelivery{Body: body, Signature: "bogus", Secret: "s3cret"}), "a bad signature is not rescued by the secret")
+	assert.True(t, validInboundDelivery("s3cret", InboundDelivery{Body: body, Secret: "s3cret"}))
+	assert.False(t, validInboundDelivery("s3cret", InboundDelivery{Body: body, Secret: "wrong"}))
+	assert.False(t, validInboundDelivery("s3cret", InboundDelivery{Body: body}))
+}
+
+func TestInboundItemsAndMapping(t *testing.T) {
+	var payload interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"event": "order.created",
+		"data": {"orders": [
+			{"id": 1001, "customer": {"email": "a@example.com"}, "total": 25.5},
+			{"id": 1002, "customer": {}}
+		]}
+	}`), &payload))
+
+	path := "data.orders"
+	items, err := inboundItems(payload, &path)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	mapping := map[string]string{"order_number": "id", "email": "customer.email", "amount": "total"}
+	assert.Equal(t, models.SObject{"order_number": float64(1001), "email": "a@example.com", "amount": 25.5}, mapInboundItem(items[0], mapping))
+	assert.Equal(t, models.SObject{"order_number": float64(1002)}, mapInboundItem(items[1], mapping), "missing paths are left out")
+
+	items, err = inboundItems(payload, nil)
+	require.NoError(t, err)
+	assert.Len(t, items, 1, "without a records path the payload is one item")
+
+	missing := "data.refunds"
+	_, err = inboundItems(payload, &missing)
+	assert.Error(t, err)
+	_, err = inboundItems("text", nil)
+	assert.Error(t, err)
+}
+
+func TestInboundMatchValue(t *testing.T) {
+	assert.Equal(t, "1000000", inboundMatchValue(float64(1000000)))
+	assert.Equal(t, "a@example.com", inboundMatchValue("a@example.com"))
+	assert.Equal(t, "true", inboundMatchValue(true))
+}
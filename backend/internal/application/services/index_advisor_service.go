@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// slowQueryCaptureSize bounds the slow queries kept; the oldest are overwritten first
+const slowQueryCaptureSize = 500
+
+// IndexAdvisorService captures slow record queries and suggests indexes for the custom
+// fields they filter and sort on
+type IndexAdvisorService struct {
+	metadata  *MetadataService
+	threshold atomic.Int64 // A time.Duration; 0 disables the capture
+
+	mu      sync.Mutex
+	queries []slowQuery // Ring buffer of at most slowQueryCaptureSize entries
+	next    int
+}
+
+// slowQuery is one captured query with the fields its filter and sort use
+type slowQuery struct {
+	object   string
+	fields   []string
+	duration time.Duration
+	at       time.Time
+}
+
+// NewIndexAdvisorService creates a new IndexAdvisorService
+func NewIndexAdvisorService(metadata *MetadataService, threshold time.Duration) *IndexAdvisorService {
+	s := &IndexAdvisorService{metadata: metadata}
+	s.SetThreshold(threshold)
+	return s
+}
+
+// SetThreshold changes the duration from which queries are captured; 0 disables the capture
+func (s *IndexAdvisorService) SetThreshold(threshold time.Duration) {
+	s.threshold.Store(int64(threshold))
+}
+
+// Capture records a query that took at least the threshold
+func (s *IndexAdvisorService) Capture(req models.QueryRequest, elapsed time.Duration) {
+	threshold := time.Duration(s.threshold.Load())
+	if threshold <= 0 || elapsed < threshold {
+		return
+	}
+	fields := queryFilterFields(req)
+	if len(fields) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	q := slowQuery{object: req.ObjectAPIName, fields: fields, duration: elapsed, at: time.Now().UTC()}
+	if len(s.queries) < slowQueryCaptureSize {
+		s.queries = append(s.queries, q)
+		return
+	}
+	s.queries[s.next] = q
+	s.next = (s.next + 1) % slowQueryCaptureSize
+}
+
+// Report suggests indexes for the fields used by the captured slow queries
+func (s *IndexAdvisorService) Report(ctx context.Context) *models.IndexAdvisorReport {
+	s.mu.Lock()
+	queries := make([]slowQuery, len(s.queries))
+	copy(queries, s.queries)
+	s.mu.Unlock()
+
+	return &models.IndexAdvisorReport{
+		ThresholdMs:     time.Duration(s.threshold.Load()).Milliseconds(),
+		CapturedQueries: len(queries),
+		Suggestions: suggestIndexes(queries, func(name string) *models.ObjectMetadata {
+			return s.metadata.GetSchema(ctx, name)
+		}),
+	}
+}
+
+// queryFilterFields lists the distinct fields a query filters or sorts on. Fields of
+// related records (account_id.name) live in another table and are left out.
+func queryFilterFields(req models.QueryRequest) []string {
+	seen := make(map[string]bool)
+	var fields []string
+	add := func(name string) {
+		key := strings.ToLower(name)
+		if name == "" || strings.Contains(name, ".") || seen[key] {
+			return
+		}
+		seen[key] = true
+		fields = append(fields, name)
+	}
+
+	for _, c := range req.Criteria {
+		add(c.Field)
+	}
+	if req.FilterExpr != "" {
+		if refs, err := formula.References(req.FilterExpr); err == nil {
+			for _, ref := range refs {
+				add(ref)
+			}
+		}
+	}
+	add(req.SortField)
+	return fields
+}
+
+// suggestIndexes ranks the unindexed custom fields used by slow queries, most frequent first
+func suggestIndexes(queries []slowQuery, schemaFor func(string) *models.ObjectMetadata) []models.IndexSuggestion {
+	type usage struct {
+		suggestion models.IndexSuggestion
+		total      time.Duration
+	}
+	usages := make(map[string]*usage)
+	schemas := make(map[string]*models.ObjectMetadata)
+
+	for _, q := range queries {
+		obj, ok := schemas[q.object]
+		if !ok {
+			obj = schemaFor(q.object)
+			schemas[q.object] = obj
+		}
+		if obj == nil {
+			continue
+		}
+		for _, name := range q.fields {
+			field := FindField(obj, name)
+			if field == nil || field.IsSystem || field.IsIndexed || validateFieldIndex(obj, field) != nil {
+				continue
+			}
+			key := obj.APIName + "." + field.APIName
+			u, ok := usages[key]
+			if !ok {
+				u = &usage{suggestion: models.IndexSuggestion{
+					ObjectAPIName: obj.APIName,
+					FieldAPIName:  field.APIName,
+					FieldLabel:    field.Label,
+				}}
+				usages[key] = u
+			}
+			u.suggestion.SlowQueries++
+			u.total += q.duration
+			if ms := q.duration.Milliseconds(); ms > u.suggestion.MaxDurationMs {
+				u.suggestion.MaxDurationMs = ms
+			}
+			if q.at.After(u.suggestion.LastSeen) {
+				u.suggestion.LastSeen = q.at
+			}
+		}
+	}
+
+	suggestions := make([]models.IndexSuggestion, 0, len(usages))
+	totals := make(map[string]time.Duration, len(usages))
+	for key, u := range usages {
+		u.suggestion.AvgDurationMs = (u.total / time.Duration(u.suggestion.SlowQueries)).Milliseconds()
+		suggestions = append(suggestions, u.suggestion)
+		totals[key] = u.total
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.SlowQueries != b.SlowQueries {
+			return a.SlowQueries > b.SlowQueries
+		}
+		ta, tb := totals[a.ObjectAPIName+"."+a.FieldAPIName], totals[b.ObjectAPIName+"."+b.FieldAPIName]
+		if ta != tb {
+			return ta > tb
+		}
+		return a.ObjectAPIName+"."+a.FieldAPIName < b.ObjectAPIName+"."+b.FieldAPIName
+	})
+	return suggestions
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryFilterFields(t *testing.T) {
+	fields := queryFilterFields(models.QueryRequest{
+		ObjectAPIName: "deal",
+		Criteria:      []models.QueryCriterion{{Field: "stage", Op: "=", Val: "Won"}},
+		FilterExpr:    "amount > 100 && account_id.name == 'Acme' && Stage == 'Won'",
+		SortField:     "close_date",
+	})
+	assert.Equal(t, []string{"stage", "amount", "close_date"}, fields)
+}
+
+func TestSuggestIndexes(t *testing.T) {
+	deal := &models.ObjectMetadata{APIName: "deal", Fields: []models.FieldMetadata{
+		{APIName: "stage", Label: "Stage", Type: constants.FieldTypePicklist},
+		{APIName: "amount", Label: "Amount", Type: constants.FieldTypeCurrency},
+		{APIName: "region", Type: constants.FieldTypeText, IsIndexed: true},
+		{APIName: "code", Type: constants.FieldTypeText, IsUnique: true},
+		{APIName: "notes", Type: constants.FieldTypeLongTextArea},
+		{APIName: "account_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"account"}},
+		{APIName: "owner_id", Type: constants.FieldTypeLookup, IsSystem: true},
+	}}
+	schemas := map[string]*models.ObjectMetadata{"deal": deal}
+	now := time.Now().UTC()
+
+	queries := []slowQuery{
+		{object: "deal", fields: []string{"stage", "region", "code", "notes", "account_id", "owner_id"}, duration: 800 * time.Millisecond, at: now.Add(-time.Minute)},
+		{object: "deal", fields: []string{"stage", "amount"}, duration: 1200 * time.Millisecond, at: now},
+		{object: "erp_order", fields: []string{"total"}, duration: time.Second, at: now},
+	}
+	suggestions := suggestIndexes(queries, func(name string) *models.ObjectMetadata { return schemas[name] })
+
+	require.Len(t, suggestions, 2)
+	stage := suggestions[0]
+	assert.Equal(t, "stage", stage.FieldAPIName)
+	assert.Equal(t, "Stage", stage.FieldLabel)
+	assert.Equal(t, 2, stage.SlowQueries)
+	assert.Equal(t, int64(1000), stage.AvgDurationMs)
+	assert.Equal(t, int64(1200), stage.MaxDurationMs)
+	assert.Equal(t, now, stage.LastSeen)
+	assert.Equal(t, "amount", suggestions[1].FieldAPIName)
+}
+
+func TestIndexAdvisorCapture(t *testing.T) {
+	advisor := NewIndexAdvisorService(nil, 100*time.Millisecond)
+	req := models.QueryRequest{ObjectAPIName: "deal", SortField: "stage"}
+
+	advisor.Capture(req, 50*time.Millisecond)
+	assert.Empty(t, advisor.queries)
+
+	for i := 0; i < slowQueryCaptureSize+3; i++ {
+		advisor.Capture(req, 150*time.Millisecond)
+	}
+	assert.Len(t, advisor.queries, slowQueryCaptureSize)
+	assert.Equal(t, 3, advisor.next)
+}
@@ -1,10 +1,17 @@
 package services_test
 
 import (
+	"context"
 	"log"
 	"os"
+	"testing"
 
 	"github.com/joho/godotenv"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/bootstrap"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/pkg/query"
 )
 
 func init() {
@@ -30,3 +37,25 @@ func init() {
 
 	log.Println("⚠️  No .env file found for tests - database tests may fail")
 }
+
+// TestMain bootstraps the embedded database when the tests run on SQLite, which starts out
+// empty: with no database configured, database.GetTestInstance opens an in-memory one
+func TestMain(m *testing.M) {
+	if db, err := database.GetTestInstance(); err == nil && query.ActiveDialect().Name() == query.DialectSQLite {
+		if err := bootstrapSQLite(db); err != nil {
+			log.Fatalf("failed to bootstrap the SQLite test database: %v", err)
+		}
+	}
+	os.Exit(m.Run())
+}
+
+func bootstrapSQLite(db *database.TiDBConnection) error {
+	if err := bootstrap.InitializeSchema(context.Background(), db); err != nil {
+		return err
+	}
+	svcMgr := services.NewServiceManager(db, config.ServicesFromEnv())
+	if err := svcMgr.RefreshMetadataCache(); err != nil {
+		return err
+	}
+	return bootstrap.InitializeSystemData(context.Background(), svcMgr.System)
+}
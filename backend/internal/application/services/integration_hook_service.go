@@ -0,0 +1,406 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// hookPollDefaultLimit and hookPollMaxLimit bound the records a polling trigger returns
+	hookPollDefaultLimit = 50
+	hookPollMaxLimit     = 100
+	// hookFindDefaultLimit bounds the records a find action returns
+	hookFindDefaultLimit = 10
+	// hookDeliveryTimeout bounds one REST hook delivery
+	hookDeliveryTimeout = 10 * time.Second
+	// hookMaxFailures deactivates a subscription after this many consecutive failed deliveries
+	hookMaxFailures = 10
+	// hookFieldKey and hookFieldRecordID are added to every item: "id" is the deduplication
+	// key integration platforms expect, "record_id" the record's own ID
+	hookFieldKey      = "id"
+	hookFieldRecordID = "record_id"
+)
+
+// IntegrationHookService is the contract of no-code integration platforms such as Zapier
+// and Make: polling triggers returning newest records first with deduplication keys, REST
+// hook subscriptions receiving record events, and simplified create/update/find actions.
+// Everything runs with the access of the calling, or subscribing, user.
+type IntegrationHookService struct {
+	repo        *persistence.HookSubscriptionRepository
+	userRepo    *persistence.UserRepository
+	metadata    *MetadataService
+	query       *QueryService
+	persistence *PersistenceService
+	permissions *PermissionService
+	client      *http.Client
+}
+
+// NewIntegrationHookService creates a new IntegrationHookService
+func NewIntegrationHookService(repo *persistence.HookSubscriptionRepository, userRepo *persistence.UserRepository, metadata *MetadataService, query *QueryService, persistence *PersistenceService, permissions *PermissionService) *IntegrationHookService {
+	return &IntegrationHookService{
+		repo:        repo,
+		userRepo:    userRepo,
+		metadata:    metadata,
+		query:       query,
+		persistence: persistence,
+		permissions: permissions,
+		client:      &http.Client{Timeout: hookDeliveryTimeout},
+	}
+}
+
+// ==================== Polling Triggers ====================
+
+// Poll returns the newest created, or most recently updated, records of an object, newest
+// first. Platforms remember the "id" keys they have seen: for created records it is the
+// record ID, for updates the record ID and modification time, so every update triggers once.
+func (s *IntegrationHookService) Poll(ctx context.Context, objectAPIName string, event constants.HookEvent, limit int, currentUser *models.UserSession) ([]models.SObject, error) {
+	sortField := constants.FieldCreatedDate
+	switch event {
+	case constants.HookEventCreated:
+	case constants.HookEventUpdated:
+		sortField = constants.FieldLastModifiedDate
+	default:
+		return nil, errors.NewValidationError("event", "polling supports the created and updated events")
+	}
+	if limit <= 0 {
+		limit = hookPollDefaultLimit
+	} else if limit > hookPollMaxLimit {
+		limit = hookPollMaxLimit
+	}
+
+	if _, err := s.metadata.GetSchemaOrError(ctx, objectAPIName); err != nil {
+		return nil, err
+	}
+	records, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName: objectAPIName,
+		SortField:     sortField,
+		SortDirection: constants.SortDESC,
+		Limit:         limit,
+	}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.SObject, 0, len(records))
+	for _, record := range records {
+		items = append(items, hookItem(record, event))
+	}
+	return items, nil
+}
+
+// hookItem copies a record and adds its deduplication key and record ID
+func hookItem(record models.SObject, event constants.HookEvent) models.SObject {
+	item := make(models.SObject, len(record)+2)
+	for k, v := range record {
+		item[k] = v
+	}
+	recordID := record.GetString(constants.FieldID)
+	item[hookFieldRecordID] = recordID
+	item[hookFieldKey] = recordID
+	switch event {
+	case constants.HookEventUpdated:
+		item[hookFieldKey] = recordID + "@" + hookTimestamp(record[constants.FieldLastModifiedDate])
+	case constants.HookEventDeleted:
+		item[hookFieldKey] = recordID + "@deleted"
+	}
+	return item
+}
+
+func hookTimestamp(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return strconv.FormatInt(v.UnixMilli(), 10)
+	case *time.Time:
+		if v != nil {
+			return strconv.FormatInt(v.UnixMilli(), 10)
+		}
+	case nil:
+	default:
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// ==================== REST Hooks ====================
+
+// Subscribe registers a REST hook for an event of an object the user can read
+func (s *IntegrationHookService) Subscribe(ctx context.Context, req models.HookSubscribeRequest, currentUser *models.UserSession) (*models.SystemHookSubscription, error) {
+	switch req.Event {
+	case constants.HookEventCreated, constants.HookEventUpdated, constants.HookEventDeleted:
+	default:
+		return nil, errors.NewValidationError(constants.FieldSysHookSubscription_Event,
+			fmt.Sprintf("unsupported event '%s'; expected created, updated or deleted", req.Event))
+	}
+	u, err := url.Parse(req.TargetURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.NewValidationError(constants.FieldSysHookSubscription_TargetURL, "must be an absolute http or https URL")
+	}
+	if constants.IsSystemTable(req.ObjectAPIName) {
+		return nil, errors.NewValidationError(constants.FieldSysHookSubscription_ObjectAPIName, "system objects do not publish record events")
+	}
+	schema, err := s.metadata.GetSchemaOrError(ctx, req.ObjectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.permissions.CheckPermissionOrErrorWithUser(ctx, schema.APIName, constants.PermRead, currentUser); err != nil {
+		return nil, err
+	}
+
+	sub := &models.SystemHookSubscription{
+		ID:            utils.GenerateID(),
+		UserID:        currentUser.ID,
+		ObjectAPIName: schema.APIName,
+		Event:         string(req.Event),
+		TargetURL:     req.TargetURL,
+		IsActive:      true,
+	}
+	if err := s.repo.Insert(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// ListSubscriptions lists the current user's subscriptions
+func (s *IntegrationHookService) ListSubscriptions(ctx context.Context, currentUser *models.UserSession) ([]*models.SystemHookSubscription, error) {
+	return s.repo.ListByUser(ctx, currentUser.ID)
+}
+
+// Unsubscribe deletes a subscription of the current user; administrators may delete any
+func (s *IntegrationHookService) Unsubscribe(ctx context.Context, id string, currentUser *models.UserSession) error {
+	sub, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil || (sub.UserID != currentUser.ID && !currentUser.IsSystemAdmin) {
+		return errors.NewNotFoundError("Hook subscription", id)
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// RegisterHandlers delivers record events to the subscribed REST hooks
+func (s *IntegrationHookService) RegisterHandlers(eventBus *EventBus) {
+	handler := func(event constants.HookEvent) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok || constants.IsSystemTable(recordPayload.ObjectAPIName) {
+				return nil
+			}
+			if recordPayload.Record.GetString(constants.FieldID) == "" {
+				return nil
+			}
+
+			// Delivery failures must not fail the outbox event (flows share the same dispatch)
+			subs, err := s.repo.ListActive(ctx, recordPayload.ObjectAPIName, event)
+			if err != nil {
+				log.Printf("⚠️ [Hooks] Failed to load subscriptions of %s: %v", recordPayload.ObjectAPIName, err)
+				return nil
+			}
+			if len(subs) > 0 {
+				// Slow endpoints must not hold up the outbox
+				go s.deliverAll(context.WithoutCancel(ctx), subs, recordPayload.ObjectAPIName, event, recordPayload.Record)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(constants.HookEventCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(constants.HookEventUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(constants.HookEventDeleted))
+}
+
+func (s *IntegrationHookService) deliverAll(ctx context.Context, subs []*models.SystemHookSubscription, objectAPIName string, event constants.HookEvent, record models.SObject) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return
+	}
+	for _, sub := range subs {
+		if err := s.deliver(ctx, sub, schema, event, record); err != nil {
+			log.Printf("⚠️ [Hooks] Failed to deliver %s %s/%s to subscription %s: %v",
+				event, objectAPIName, record.GetString(constants.FieldID), sub.ID, err)
+		}
+	}
+}
+
+// deliver posts a record event to a subscription, as seen by the subscribing user. Records
+// the user cannot read are skipped; a 410 Gone response unsubscribes the hook.
+func (s *IntegrationHookService) deliver(ctx context.Context, sub *models.SystemHookSubscription, schema *models.ObjectMetadata, event constants.HookEvent, record models.SObject) error {
+	user, err := s.userRepo.GetUserByID(ctx, sub.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+	session, err := s.permissions.SessionForUser(ctx, sub.UserID)
+	if err != nil {
+		return err
+	}
+	if !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, session) ||
+		!s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, session) {
+		return nil
+	}
+
+	visible := s.permissions.GetEffectiveSchema(ctx, schema, session)
+	body, err := json.Marshal(hookItem(visibleFields(visible, record), event))
+	if err != nil {
+		return err
+	}
+
+	status, err := s.post(ctx, sub.TargetURL, body)
+	if status == http.StatusGone {
+		return s.repo.Delete(ctx, sub.ID)
+	}
+	now := time.Now()
+	if err == nil {
+		sub.FailureCount = 0
+		sub.LastError = nil
+		sub.LastDeliveryDate = &now
+	} else {
+		sub.FailureCount++
+		msg := err.Error()
+		sub.LastError = &msg
+		if sub.FailureCount >= hookMaxFailures {
+			sub.IsActive = false
+		}
+	}
+	if saveErr := s.repo.SaveDeliveryState(ctx, sub); saveErr != nil {
+		return saveErr
+	}
+	return err
+}
+
+// post sends a delivery and returns the response status; non-2xx statuses are errors
+func (s *IntegrationHookService) post(ctx context.Context, targetURL string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, hookDeliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("target responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// visibleFields keeps the fields of a record present in the (effective) schema
+func visibleFields(schema *models.ObjectMetadata, record models.SObject) models.SObject {
+	filtered := make(models.SObject, len(schema.Fields))
+	for _, f := range schema.Fields {
+		if v, ok := record[f.APIName]; ok {
+			filtered[f.APIName] = v
+		}
+	}
+	if v, ok := record[constants.FieldID]; ok {
+		filtered[constants.FieldID] = v
+	}
+	return filtered
+}
+
+// ==================== Actions ====================
+
+// CreateRecord creates a record and returns it as a trigger item
+func (s *IntegrationHookService) CreateRecord(ctx context.Context, objectAPIName string, data models.SObject, currentUser *models.UserSession) (models.SObject, error) {
+	record, err := s.persistence.Insert(ctx, objectAPIName, data, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	return hookItem(record, constants.HookEventCreated), nil
+}
+
+// UpdateRecord updates a record and returns it as saved
+func (s *IntegrationHookService) UpdateRecord(ctx context.Context, objectAPIName, id string, data models.SObject, currentUser *models.UserSession) (models.SObject, error) {
+	if err := s.persistence.Update(ctx, objectAPIName, id, data, currentUser); err != nil {
+		return nil, err
+	}
+	records, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName: objectAPIName,
+		FilterExpr:    fieldEquals(constants.FieldID, id),
+		Limit:         1,
+	}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.NewNotFoundError(objectAPIName, id)
+	}
+	return hookItem(records[0], constants.HookEventUpdated), nil
+}
+
+// FindRecords returns the records of an object whose field equals a value, newest first.
+// Platforms use it for "find" searches and to look up a record before updating it.
+func (s *IntegrationHookService) FindRecords(ctx context.Context, objectAPIName, field, value string, limit int, currentUser *models.UserSession) ([]models.SObject, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	f := FindField(schema, field)
+	if f == nil {
+		return nil, errors.NewValidationError("field", fmt.Sprintf("unknown field '%s' on %s", field, objectAPIName))
+	}
+	filter, err := hookFindFilter(f, value)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > hookPollMaxLimit {
+		limit = hookFindDefaultLimit
+	}
+
+	records, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName: objectAPIName,
+		FilterExpr:    filter,
+		SortField:     constants.FieldCreatedDate,
+		SortDirection: constants.SortDESC,
+		Limit:         limit,
+	}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]models.SObject, 0, len(records))
+	for _, record := range records {
+		items = append(items, hookItem(record, constants.HookEventCreated))
+	}
+	return items, nil
+}
+
+// hookFindFilter builds the equality filter of a find action, with the value typed as the field
+func hookFindFilter(f *models.FieldMetadata, value string) (string, error) {
+	switch f.Type {
+	case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", errors.NewValidationError("value", fmt.Sprintf("'%s' is not a number", value))
+		}
+		return fmt.Sprintf("%s == %s", f.APIName, strconv.FormatFloat(n, 'f', -1, 64)), nil
+	case constants.FieldTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errors.NewValidationError("value", fmt.Sprintf("'%s' is not a boolean", value))
+		}
+		return fmt.Sprintf("%s == %t", f.APIName, b), nil
+	}
+	return fieldEquals(f.APIName, value), nil
+}
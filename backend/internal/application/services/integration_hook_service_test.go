@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHookItem(t *testing.T) {
+	modified := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	record := models.SObject{
+		constants.FieldID:               "r1",
+		constants.FieldLastModifiedDate: modified,
+		"name":                          "Acme",
+	}
+
+	created := hookItem(record, constants.HookEventCreated)
+	assert.Equal(t, "r1", created["id"])
+	assert.Equal(t, "r1", created["record_id"])
+	assert.Equal(t, "Acme", created["name"])
+	assert.NotContains(t, record, "id", "the record itself is left as it is")
+
+	updated := hookItem(record, constants.HookEventUpdated)
+	assert.Equal(t, "r1@1777636800000", updated["id"], "each update gets its own deduplication key")
+	assert.Equal(t, "r1@deleted", hookItem(record, constants.HookEventDeleted)["id"])
+}
+
+func TestHookFindFilter(t *testing.T) {
+	filter, err := hookFindFilter(&models.FieldMetadata{APIName: "email", Type: constants.FieldTypeEmail}, `a"b@example.com`)
+	require.NoError(t, err)
+	assert.Equal(t, `email == "a\"b@example.com"`, filter)
+
+	filter, err = hookFindFilter(&models.FieldMetadata{APIName: "amount", Type: constants.FieldTypeCurrency}, "12.50")
+	require.NoError(t, err)
+	assert.Equal(t, "amount == 12.5", filter)
+
+	filter, err = hookFindFilter(&models.FieldMetadata{APIName: "active", Type: constants.FieldTypeBoolean}, "true")
+	require.NoError(t, err)
+	assert.Equal(t, "active == true", filter)
+
+	_, err = hookFindFilter(&models.FieldMetadata{APIName: "amount", Type: constants.FieldTypeNumber}, "1 OR 1")
+	assert.Error(t, err)
+}
+
+func TestVisibleFields(t *testing.T) {
+	schema := &models.ObjectMetadata{Fields: []models.FieldMetadata{{APIName: "name"}}}
+	record := models.SObject{constants.FieldID: "r1", "name": "Acme", "salary": 100}
+	assert.Equal(t, models.SObject{constants.FieldID: "r1", "name": "Acme"}, visibleFields(schema, record))
+}
+
+func TestHookPost(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	s := &IntegrationHookService{client: srv.Client()}
+	code, err := s.post(context.Background(), srv.URL, []byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	status = http.StatusGone
+	code, err = s.post(context.Background(), srv.URL, []byte(`{}`))
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusGone, code)
+}
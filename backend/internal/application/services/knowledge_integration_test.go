@@ -0,0 +1,156 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/infrastructure/search"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnowledge_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	repo := persistence.NewKnowledgeRepository(h.DB.DB())
+	newService := func(index ports.SearchIndex) *services.KnowledgeService {
+		searchSvc := services.NewSearchIndexService(index, persistence.NewQueryRepository(h.DB.DB()), h.Services.Metadata, h.Services.Permissions, h.Services.QuerySvc)
+		return services.NewKnowledgeService(repo, h.Services.TxManager, searchSvc, h.Services.Metadata, h.Services.QuerySvc, h.Services.Permissions)
+	}
+	index := search.NewEmbeddedIndex()
+	svc := newService(index)
+
+	// Categories nest, and filters include subcategories
+	hardware := &models.SystemKnowledgeCategory{Name: "Hardware"}
+	require.NoError(t, svc.CreateCategory(ctx, hardware, h.Admin))
+	printers := &models.SystemKnowledgeCategory{Name: "Printers", ParentID: &hardware.ID}
+	require.NoError(t, svc.CreateCategory(ctx, printers, h.Admin))
+	hardware.ParentID = &printers.ID
+	require.Error(t, svc.UpdateCategory(ctx, hardware.ID, hardware, h.Admin), "a category cannot move under its child")
+	author := h.CreateUser(t)
+	require.Error(t, svc.CreateCategory(ctx, &models.SystemKnowledgeCategory{Name: "x"}, author), "only admins manage categories")
+
+	// Drafts are private to their author and get a URL name from the title
+	body := "Open the tray and remove the jammed paper, then restart the printer."
+	article := &models.SystemKnowledgeArticle{Title: "Clear a paper jam", Body: &body, CategoryID: &printers.ID}
+	require.NoError(t, svc.CreateArticle(ctx, article, author))
+	t.Cleanup(func() {
+		_, _ = svc.ArchiveArticle(ctx, article.ID, h.Admin)
+		_ = svc.DeleteArticle(ctx, article.ID, h.Admin)
+	})
+	assert.Equal(t, "clear-a-paper-jam", article.URLName)
+	assert.Equal(t, constants.KnowledgeArticleStatusDraft, article.Status)
+	assert.Equal(t, 1, article.VersionNumber)
+
+	twin := &models.SystemKnowledgeArticle{Title: "Clear a paper jam!"}
+	require.NoError(t, svc.CreateArticle(ctx, twin, author))
+	t.Cleanup(func() { _ = svc.DeleteArticle(ctx, twin.ID, h.Admin) })
+	assert.Equal(t, "clear-a-paper-jam-"+twin.ID[:8], twin.URLName, "the generated URL name is made unique")
+
+	_, err := svc.GetArticle(ctx, article.ID, h.CreateUser(t))
+	require.Error(t, err, "other users cannot see a draft")
+	_, err = svc.GetPublished(ctx, article.URLName)
+	require.Error(t, err, "drafts are not published")
+
+	// Publishing snapshots version 1 and indexes it
+	published, err := svc.PublishArticle(ctx, article.ID, author)
+	require.NoError(t, err)
+	assert.Equal(t, constants.KnowledgeArticleStatusPublished, published.Status)
+	require.NotNil(t, published.PublishedVersionNumber)
+	assert.Equal(t, 1, *published.PublishedVersionNumber)
+	_, err = svc.PublishArticle(ctx, article.ID, author)
+	require.Error(t, err, "the article is already published")
+
+	results, err := svc.SearchPublished(ctx, "paper jam", "", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, article.ID, results[0].Version.ArticleID)
+	assert.Greater(t, results[0].Score, 0.0)
+	results, err = svc.SearchPublished(ctx, "paper jam", hardware.ID, 0)
+	require.NoError(t, err)
+	assert.Len(t, results, 1, "the parent category includes its subcategories")
+
+	// Editing starts draft version 2 while version 1 stays live
+	article.Title = "Clear a paper jam in the tray"
+	require.NoError(t, svc.UpdateArticle(ctx, article.ID, article, author))
+	assert.Equal(t, constants.KnowledgeArticleStatusDraft, article.Status)
+	assert.Equal(t, 2, article.VersionNumber)
+	live, err := svc.GetPublished(ctx, "clear-a-paper-jam")
+	require.NoError(t, err)
+	assert.Equal(t, "Clear a paper jam", live.Version.Title)
+
+	_, err = svc.PublishArticle(ctx, article.ID, author)
+	require.NoError(t, err)
+	live, err = svc.GetPublished(ctx, article.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, live.Version.VersionNumber)
+	versions, err := svc.ListVersions(ctx, article.ID, author)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].VersionNumber)
+
+	reverted, err := svc.RevertArticle(ctx, article.ID, 1, author)
+	require.NoError(t, err)
+	assert.Equal(t, "Clear a paper jam", reverted.Title)
+	assert.Equal(t, 3, reverted.VersionNumber)
+
+	// Cases get suggestions and can attach published articles
+	supportCase := h.CreateObject(t, "case", testharness.Field("subject", constants.FieldTypeText))
+	caseID := h.CreateRecord(t, supportCase.APIName, models.SObject{"subject": "Printer says paper jam"})[constants.FieldID].(string)
+	suggestions, err := svc.Suggestions(ctx, supportCase.APIName, caseID, h.Admin)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, article.ID, suggestions[0].Version.ArticleID)
+
+	require.Error(t, svc.LinkArticle(ctx, &models.SystemKnowledgeArticleLink{ArticleID: twin.ID, ObjectAPIName: supportCase.APIName, RecordID: caseID}, h.Admin),
+		"drafts cannot be attached")
+	link := &models.SystemKnowledgeArticleLink{ArticleID: article.ID, ObjectAPIName: supportCase.APIName, RecordID: caseID}
+	require.NoError(t, svc.LinkArticle(ctx, link, h.Admin))
+	assert.Equal(t, 2, link.VersionNumber)
+	require.Error(t, svc.LinkArticle(ctx, &models.SystemKnowledgeArticleLink{ArticleID: article.ID, ObjectAPIName: supportCase.APIName, RecordID: caseID}, h.Admin),
+		"the article is already attached")
+	suggestions, err = svc.Suggestions(ctx, supportCase.APIName, caseID, h.Admin)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions, "attached articles are not suggested")
+	links, err := svc.ListLinks(ctx, supportCase.APIName, caseID, h.Admin)
+	require.NoError(t, err)
+	assert.Len(t, links, 1)
+
+	// Without a search engine, search falls back to LIKE over the published versions
+	fallback, err := newService(nil).SearchPublished(ctx, "jammed tray", "", 0)
+	require.NoError(t, err)
+	require.Len(t, fallback, 1)
+	assert.Equal(t, article.ID, fallback[0].Version.ArticleID)
+
+	// Archiving takes the article off the knowledge base
+	require.Error(t, svc.DeleteArticle(ctx, article.ID, author), "a live article cannot be deleted")
+	require.Error(t, svc.DeleteCategory(ctx, printers.ID, h.Admin), "the category has articles")
+	_, err = svc.ArchiveArticle(ctx, article.ID, author)
+	require.NoError(t, err)
+	_, err = svc.GetPublished(ctx, article.ID)
+	require.Error(t, err)
+	results, err = svc.SearchPublished(ctx, "paper jam", "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Zero(t, index.Size())
+
+	restored, err := svc.RestoreArticle(ctx, article.ID, author)
+	require.NoError(t, err)
+	assert.Equal(t, constants.KnowledgeArticleStatusDraft, restored.Status)
+	assert.Nil(t, restored.ArchivedDate)
+
+	require.NoError(t, svc.UnlinkArticle(ctx, link.ID, h.Admin))
+	require.NoError(t, svc.DeleteArticle(ctx, article.ID, author))
+	require.NoError(t, svc.DeleteArticle(ctx, twin.ID, author))
+	require.NoError(t, svc.DeleteCategory(ctx, printers.ID, h.Admin))
+	require.NoError(t, svc.DeleteCategory(ctx, hardware.ID, h.Admin))
+}
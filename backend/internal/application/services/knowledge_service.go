@@ -0,0 +1,868 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// knowledgeSearchLimit is the default number of articles a knowledge search returns
+	knowledgeSearchLimit = 20
+	// knowledgeSuggestionLimit is the number of articles suggested for a record
+	knowledgeSuggestionLimit = 5
+	// knowledgeSuggestionTextLimit bounds the record text used as the suggestion query
+	knowledgeSuggestionTextLimit = 1000
+	// knowledgeFallbackTerms bounds the words matched with LIKE when no search engine is configured
+	knowledgeFallbackTerms = 8
+	// knowledgeURLNameLimit leaves room in url_name for a uniqueness suffix
+	knowledgeURLNameLimit = 200
+)
+
+// KnowledgeService manages the knowledge base: a category taxonomy and articles that move
+// through Draft, Published and Archived. Authors edit a working copy; publishing snapshots
+// it as a numbered version, and readers, search and record links only ever see the
+// published version, which stays live while a newer draft is being edited.
+type KnowledgeService struct {
+	repo        *persistence.KnowledgeRepository
+	txManager   *persistence.TransactionManager
+	search      *SearchIndexService
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+}
+
+// NewKnowledgeService creates a new KnowledgeService
+func NewKnowledgeService(
+	repo *persistence.KnowledgeRepository,
+	txManager *persistence.TransactionManager,
+	search *SearchIndexService,
+	metadata *MetadataService,
+	query *QueryService,
+	permissions *PermissionService,
+) *KnowledgeService {
+	return &KnowledgeService{
+		repo:        repo,
+		txManager:   txManager,
+		search:      search,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+	}
+}
+
+// ==================== Categories ====================
+
+// ListCategories returns every category in sort order; parents link the tree
+func (s *KnowledgeService) ListCategories(ctx context.Context) ([]*models.SystemKnowledgeCategory, error) {
+	return s.repo.ListCategories(ctx)
+}
+
+// CreateCategory saves a new category. category is updated in place with the stored values.
+func (s *KnowledgeService) CreateCategory(ctx context.Context, category *models.SystemKnowledgeCategory, currentUser *models.UserSession) error {
+	if err := checkKnowledgeAdmin(currentUser); err != nil {
+		return err
+	}
+	category.ID = GenerateID()
+	if err := normalizeKnowledgeCategory(category); err != nil {
+		return err
+	}
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkCategoryParent(categories, category); err != nil {
+		return err
+	}
+	return s.repo.InsertCategory(ctx, category)
+}
+
+// UpdateCategory replaces the fields of a category. Moving it under one of its own
+// descendants is rejected. updates is replaced with the stored values.
+func (s *KnowledgeService) UpdateCategory(ctx context.Context, id string, updates *models.SystemKnowledgeCategory, currentUser *models.UserSession) error {
+	if err := checkKnowledgeAdmin(currentUser); err != nil {
+		return err
+	}
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	existing := findKnowledgeCategory(categories, id)
+	if existing == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeCategory, id)
+	}
+	updated := *existing
+	updated.Name = updates.Name
+	updated.ParentID = updates.ParentID
+	updated.Description = updates.Description
+	updated.SortOrder = updates.SortOrder
+	if err := normalizeKnowledgeCategory(&updated); err != nil {
+		return err
+	}
+	if err := checkCategoryParent(categories, &updated); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateCategory(ctx, &updated); err != nil {
+		return err
+	}
+	*updates = updated
+	return nil
+}
+
+// DeleteCategory removes a category without subcategories or articles
+func (s *KnowledgeService) DeleteCategory(ctx context.Context, id string, currentUser *models.UserSession) error {
+	if err := checkKnowledgeAdmin(currentUser); err != nil {
+		return err
+	}
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	if findKnowledgeCategory(categories, id) == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeCategory, id)
+	}
+	if len(knowledgeCategoryTree(categories, id)) > 1 {
+		return errors.NewValidationError(constants.FieldSysKnowledgeCategory_ParentID, "category has subcategories")
+	}
+	count, err := s.repo.CountCategoryArticles(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.NewValidationError(constants.FieldSysKnowledgeArticle_CategoryID, fmt.Sprintf("category has %d articles", count))
+	}
+	return s.repo.DeleteCategory(ctx, id)
+}
+
+// categoryFilter returns a category and its descendants, or nil when categoryID is empty
+func (s *KnowledgeService) categoryFilter(ctx context.Context, categoryID string) ([]string, error) {
+	if categoryID == "" {
+		return nil, nil
+	}
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if findKnowledgeCategory(categories, categoryID) == nil {
+		return nil, errors.NewNotFoundError(constants.TableKnowledgeCategory, categoryID)
+	}
+	return knowledgeCategoryTree(categories, categoryID), nil
+}
+
+// ==================== Authoring ====================
+
+// ListArticles returns the working copies of articles, optionally by status and category
+// (including subcategories). Administrators see every article, other users their own.
+func (s *KnowledgeService) ListArticles(ctx context.Context, status, categoryID string, currentUser *models.UserSession) ([]*models.SystemKnowledgeArticle, error) {
+	if currentUser == nil {
+		return nil, errors.NewUnauthorizedError("User session not found")
+	}
+	categoryIDs, err := s.categoryFilter(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	filter := persistence.ArticleFilter{Status: status, CategoryIDs: categoryIDs}
+	if !currentUser.IsSystemAdmin && !constants.IsSuperUser(currentUser.ProfileID) {
+		filter.OwnerID = currentUser.ID
+	}
+	return s.repo.ListArticles(ctx, filter)
+}
+
+// GetArticle returns the working copy of an article to its author
+func (s *KnowledgeService) GetArticle(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemKnowledgeArticle, error) {
+	article, err := s.repo.GetArticle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if article == nil {
+		return nil, errors.NewNotFoundError(constants.TableKnowledgeArticle, id)
+	}
+	if err := checkCanEditArticle(article.OwnerID, currentUser); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+// ListVersions returns the published versions of an article, newest first
+func (s *KnowledgeService) ListVersions(ctx context.Context, id string, currentUser *models.UserSession) ([]*models.SystemKnowledgeArticleVersion, error) {
+	if _, err := s.GetArticle(ctx, id, currentUser); err != nil {
+		return nil, err
+	}
+	return s.repo.ListVersions(ctx, id)
+}
+
+// CreateArticle saves a new draft at version 1 owned by the current user. The URL name
+// defaults to one derived from the title. article is updated in place with the stored values.
+func (s *KnowledgeService) CreateArticle(ctx context.Context, article *models.SystemKnowledgeArticle, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	article.ID = GenerateID()
+	article.OwnerID = currentUser.ID
+	article.Status = constants.KnowledgeArticleStatusDraft
+	article.VersionNumber = 1
+	article.PublishedVersionNumber = nil
+	article.PublishedDate = nil
+	article.ArchivedDate = nil
+	if err := normalizeKnowledgeArticle(article); err != nil {
+		return err
+	}
+	if err := s.checkCategory(ctx, article.CategoryID); err != nil {
+		return err
+	}
+
+	explicit := article.URLName != ""
+	if !explicit {
+		article.URLName = knowledgeURLName(article.Title)
+	}
+	taken, err := s.urlNameTaken(ctx, article.URLName, article.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		if explicit {
+			return errors.NewConflictError(constants.TableKnowledgeArticle, constants.FieldSysKnowledgeArticle_URLName, article.URLName)
+		}
+		article.URLName += "-" + article.ID[:8]
+	}
+	return s.repo.InsertArticle(ctx, article)
+}
+
+// UpdateArticle replaces the title, summary, body, category and URL name of an article's
+// working copy; the owner stays unless updates names a new one. Editing a published article
+// starts a new draft version while the published one stays live. updates is replaced with
+// the stored values.
+func (s *KnowledgeService) UpdateArticle(ctx context.Context, id string, updates *models.SystemKnowledgeArticle, currentUser *models.UserSession) error {
+	urlName := ""
+	if updates.URLName != "" {
+		urlName = knowledgeURLName(updates.URLName)
+		taken, err := s.urlNameTaken(ctx, urlName, id)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return errors.NewConflictError(constants.TableKnowledgeArticle, constants.FieldSysKnowledgeArticle_URLName, urlName)
+		}
+	}
+	if err := s.checkCategory(ctx, updates.CategoryID); err != nil {
+		return err
+	}
+
+	article, err := s.changeArticle(ctx, id, currentUser, func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+		if a.Status == constants.KnowledgeArticleStatusArchived {
+			return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, "restore an archived article before editing it")
+		}
+		a.Title = updates.Title
+		a.Summary = updates.Summary
+		a.Body = updates.Body
+		a.CategoryID = updates.CategoryID
+		if updates.URLName != "" {
+			a.URLName = urlName
+		}
+		if updates.OwnerID != "" {
+			a.OwnerID = updates.OwnerID
+		}
+		if err := normalizeKnowledgeArticle(a); err != nil {
+			return err
+		}
+		return s.reopenDraft(ctx, tx, a)
+	})
+	if err != nil {
+		return err
+	}
+	*updates = *article
+	return nil
+}
+
+// PublishArticle snapshots a draft as its next version and makes that version live
+func (s *KnowledgeService) PublishArticle(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemKnowledgeArticle, error) {
+	var published *models.SystemKnowledgeArticleVersion
+	article, err := s.changeArticle(ctx, id, currentUser, func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+		if a.Status != constants.KnowledgeArticleStatusDraft {
+			return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, fmt.Sprintf("only drafts can be published; article is %s", a.Status))
+		}
+		if err := s.reopenDraft(ctx, tx, a); err != nil {
+			return err
+		}
+		now := time.Now()
+		published = &models.SystemKnowledgeArticleVersion{
+			ID:            GenerateID(),
+			ArticleID:     a.ID,
+			VersionNumber: a.VersionNumber,
+			Title:         a.Title,
+			Summary:       a.Summary,
+			Body:          a.Body,
+			CategoryID:    a.CategoryID,
+			PublishedByID: currentUser.ID,
+			PublishedDate: now,
+		}
+		if err := s.repo.InsertVersion(ctx, tx, published); err != nil {
+			return err
+		}
+		versionNumber := a.VersionNumber
+		a.Status = constants.KnowledgeArticleStatusPublished
+		a.PublishedVersionNumber = &versionNumber
+		a.PublishedDate = &now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.indexArticle(ctx, published)
+	return article, nil
+}
+
+// ArchiveArticle takes an article off the knowledge base; readers and search stop seeing it
+func (s *KnowledgeService) ArchiveArticle(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemKnowledgeArticle, error) {
+	article, err := s.changeArticle(ctx, id, currentUser, func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+		if a.Status == constants.KnowledgeArticleStatusArchived {
+			return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, "article is already archived")
+		}
+		now := time.Now()
+		a.Status = constants.KnowledgeArticleStatusArchived
+		a.ArchivedDate = &now
+		a.PublishedVersionNumber = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.search.RemoveDocument(ctx, constants.TableKnowledgeArticle, id); err != nil {
+		log.Printf("⚠️ [Knowledge] Failed to remove article %s from the search index: %v", id, err)
+	}
+	return article, nil
+}
+
+// RestoreArticle returns an archived article to draft so it can be edited and republished
+func (s *KnowledgeService) RestoreArticle(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemKnowledgeArticle, error) {
+	return s.changeArticle(ctx, id, currentUser, func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+		if a.Status != constants.KnowledgeArticleStatusArchived {
+			return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, "only archived articles can be restored")
+		}
+		a.ArchivedDate = nil
+		return s.reopenDraft(ctx, tx, a)
+	})
+}
+
+// RevertArticle replaces the working copy with the content of a published version
+func (s *KnowledgeService) RevertArticle(ctx context.Context, id string, versionNumber int, currentUser *models.UserSession) (*models.SystemKnowledgeArticle, error) {
+	return s.changeArticle(ctx, id, currentUser, func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+		if a.Status == constants.KnowledgeArticleStatusArchived {
+			return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, "restore an archived article before reverting it")
+		}
+		version, err := s.repo.GetVersion(ctx, tx, id, versionNumber)
+		if err != nil {
+			return err
+		}
+		if version == nil {
+			return errors.NewNotFoundError(constants.TableKnowledgeArticleVersion, fmt.Sprintf("%s v%d", id, versionNumber))
+		}
+		a.Title = version.Title
+		a.Summary = version.Summary
+		a.Body = version.Body
+		a.CategoryID = version.CategoryID
+		return s.reopenDraft(ctx, tx, a)
+	})
+}
+
+// DeleteArticle removes an article that is not live, with its versions and links
+func (s *KnowledgeService) DeleteArticle(ctx context.Context, id string, currentUser *models.UserSession) error {
+	article, err := s.GetArticle(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+	if article.PublishedVersionNumber != nil {
+		return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Status, "archive a published article before deleting it")
+	}
+	return s.repo.DeleteArticle(ctx, id)
+}
+
+// changeArticle locks an article, lets its author change it and stores the result
+func (s *KnowledgeService) changeArticle(ctx context.Context, id string, currentUser *models.UserSession, change func(tx *sql.Tx, a *models.SystemKnowledgeArticle) error) (*models.SystemKnowledgeArticle, error) {
+	var article *models.SystemKnowledgeArticle
+	err := s.txManager.WithRetry(func(tx *sql.Tx) error {
+		a, err := s.repo.GetArticleLock(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if a == nil {
+			return errors.NewNotFoundError(constants.TableKnowledgeArticle, id)
+		}
+		if err := checkCanEditArticle(a.OwnerID, currentUser); err != nil {
+			return err
+		}
+		if err := change(tx, a); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateArticle(ctx, tx, a); err != nil {
+			return err
+		}
+		article = a
+		return nil
+	}, 3)
+	if err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+// reopenDraft makes the working copy a draft, moving it to a new version number once its
+// current one has been published
+func (s *KnowledgeService) reopenDraft(ctx context.Context, tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+	latest, err := s.repo.LatestVersionNumber(ctx, tx, a.ID)
+	if err != nil {
+		return err
+	}
+	a.VersionNumber = nextKnowledgeVersion(a.VersionNumber, latest)
+	a.Status = constants.KnowledgeArticleStatusDraft
+	return nil
+}
+
+// urlNameTaken reports whether another article uses a URL name
+func (s *KnowledgeService) urlNameTaken(ctx context.Context, urlName, articleID string) (bool, error) {
+	existing, err := s.repo.FindArticleByURLName(ctx, urlName)
+	if err != nil {
+		return false, err
+	}
+	return existing != nil && existing.ID != articleID, nil
+}
+
+// checkCategory verifies an optional category exists
+func (s *KnowledgeService) checkCategory(ctx context.Context, categoryID *string) error {
+	if categoryID == nil || strings.TrimSpace(*categoryID) == "" {
+		return nil
+	}
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	if findKnowledgeCategory(categories, *categoryID) == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeCategory, *categoryID)
+	}
+	return nil
+}
+
+// ==================== Reading and search ====================
+
+// ListPublished returns the published articles, optionally in a category and its subcategories
+func (s *KnowledgeService) ListPublished(ctx context.Context, categoryID string) ([]*models.PublishedArticle, error) {
+	categoryIDs, err := s.categoryFilter(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListPublished(ctx, persistence.PublishedFilter{CategoryIDs: categoryIDs})
+}
+
+// GetPublished returns the published version of an article by URL name or ID
+func (s *KnowledgeService) GetPublished(ctx context.Context, urlNameOrID string) (*models.PublishedArticle, error) {
+	articles, err := s.repo.ListPublished(ctx, persistence.PublishedFilter{URLName: urlNameOrID})
+	if err != nil {
+		return nil, err
+	}
+	if len(articles) == 0 {
+		articles, err = s.repo.ListPublished(ctx, persistence.PublishedFilter{ArticleIDs: []string{urlNameOrID}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(articles) == 0 {
+		return nil, errors.NewNotFoundError(constants.TableKnowledgeArticle, urlNameOrID)
+	}
+	return articles[0], nil
+}
+
+// SearchPublished returns published articles matching a term, best first, optionally in a
+// category and its subcategories. It uses the search engine when one is configured and
+// matches words with LIKE otherwise.
+func (s *KnowledgeService) SearchPublished(ctx context.Context, term, categoryID string, limit int) ([]*models.PublishedArticle, error) {
+	if limit <= 0 {
+		limit = knowledgeSearchLimit
+	}
+	categoryIDs, err := s.categoryFilter(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(term) == "" {
+		return []*models.PublishedArticle{}, nil
+	}
+	if !s.search.Enabled() {
+		terms := knowledgeTerms(term)
+		if len(terms) == 0 {
+			return []*models.PublishedArticle{}, nil
+		}
+		return s.repo.ListPublished(ctx, persistence.PublishedFilter{CategoryIDs: categoryIDs, Terms: terms, Limit: limit})
+	}
+
+	hits, err := s.search.SearchDocuments(ctx, ports.SearchQuery{
+		Term:           term,
+		ObjectAPINames: []string{constants.TableKnowledgeArticle},
+		Limit:          searchCandidateLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("knowledge search failed: %w", err)
+	}
+	if len(hits) == 0 {
+		return []*models.PublishedArticle{}, nil
+	}
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.RecordID
+	}
+	articles, err := s.repo.ListPublished(ctx, persistence.PublishedFilter{ArticleIDs: ids, CategoryIDs: categoryIDs})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*models.PublishedArticle, len(articles))
+	for _, article := range articles {
+		byID[article.Version.ArticleID] = article
+	}
+
+	results := make([]*models.PublishedArticle, 0, limit)
+	for _, hit := range hits {
+		article, ok := byID[hit.RecordID]
+		if !ok {
+			continue // Stale index entry, or outside the category
+		}
+		article.Score = hit.Score
+		article.Highlights = hit.Highlights
+		results = append(results, article)
+		if len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// Reindex adds every published article to the search index and returns how many it indexed
+func (s *KnowledgeService) Reindex(ctx context.Context) (int, error) {
+	if !s.search.Enabled() {
+		return 0, nil
+	}
+	articles, err := s.repo.ListPublished(ctx, persistence.PublishedFilter{})
+	if err != nil {
+		return 0, err
+	}
+	for _, article := range articles {
+		if err := s.search.IndexDocument(ctx, knowledgeSearchDocument(article.Version)); err != nil {
+			return 0, fmt.Errorf("failed to index knowledge article %s: %w", article.Version.ArticleID, err)
+		}
+	}
+	return len(articles), nil
+}
+
+// indexArticle replaces an article's index entry with a newly published version
+func (s *KnowledgeService) indexArticle(ctx context.Context, version *models.SystemKnowledgeArticleVersion) {
+	// Index failures must not fail the publish; a rebuild catches the article up
+	if err := s.search.IndexDocument(ctx, knowledgeSearchDocument(version)); err != nil {
+		log.Printf("⚠️ [Knowledge] Failed to index article %s: %v", version.ArticleID, err)
+	}
+}
+
+// ==================== Record links and suggestions ====================
+
+// Suggestions returns published articles relevant to a record, such as a case, by searching
+// with the text of its searchable fields. Articles already attached are left out.
+func (s *KnowledgeService) Suggestions(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) ([]*models.PublishedArticle, error) {
+	schema, record, err := s.checkRecord(ctx, objectName, recordID, constants.PermRead, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	text := knowledgeRecordText(record, searchableFields(schema))
+	if text == "" {
+		return []*models.PublishedArticle{}, nil
+	}
+	links, err := s.repo.ListLinks(ctx, schema.APIName, recordID)
+	if err != nil {
+		return nil, err
+	}
+	linked := make(map[string]bool, len(links))
+	for _, link := range links {
+		linked[link.ArticleID] = true
+	}
+
+	candidates, err := s.SearchPublished(ctx, text, "", knowledgeSuggestionLimit+len(links))
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]*models.PublishedArticle, 0, knowledgeSuggestionLimit)
+	for _, article := range candidates {
+		if linked[article.Version.ArticleID] {
+			continue
+		}
+		suggestions = append(suggestions, article)
+		if len(suggestions) >= knowledgeSuggestionLimit {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// ListLinks returns the articles attached to a record the user can read
+func (s *KnowledgeService) ListLinks(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) ([]*models.SystemKnowledgeArticleLink, error) {
+	schema, _, err := s.checkRecord(ctx, objectName, recordID, constants.PermRead, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListLinks(ctx, schema.APIName, recordID)
+}
+
+// LinkArticle attaches the published version of an article to a record the user can edit.
+// link is updated in place with the stored values.
+func (s *KnowledgeService) LinkArticle(ctx context.Context, link *models.SystemKnowledgeArticleLink, currentUser *models.UserSession) error {
+	if strings.TrimSpace(link.ArticleID) == "" {
+		return errors.NewValidationError(constants.FieldSysKnowledgeArticleLink_ArticleID, "article is required")
+	}
+	schema, _, err := s.checkRecord(ctx, link.ObjectAPIName, link.RecordID, constants.PermEdit, currentUser)
+	if err != nil {
+		return err
+	}
+	article, err := s.repo.GetArticle(ctx, link.ArticleID)
+	if err != nil {
+		return err
+	}
+	if article == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeArticle, link.ArticleID)
+	}
+	if article.PublishedVersionNumber == nil {
+		return errors.NewValidationError(constants.FieldSysKnowledgeArticleLink_ArticleID, "only published articles can be attached")
+	}
+	existing, err := s.repo.ListLinks(ctx, schema.APIName, link.RecordID)
+	if err != nil {
+		return err
+	}
+	for _, l := range existing {
+		if l.ArticleID == link.ArticleID {
+			return errors.NewConflictError(constants.TableKnowledgeArticleLink, constants.FieldSysKnowledgeArticleLink_ArticleID, link.ArticleID)
+		}
+	}
+
+	link.ID = GenerateID()
+	link.ObjectAPIName = schema.APIName
+	link.VersionNumber = *article.PublishedVersionNumber
+	link.LinkedByID = currentUser.ID
+	return s.repo.InsertLink(ctx, link)
+}
+
+// UnlinkArticle detaches an article from a record the user can edit
+func (s *KnowledgeService) UnlinkArticle(ctx context.Context, id string, currentUser *models.UserSession) error {
+	link, err := s.repo.GetLink(ctx, id)
+	if err != nil {
+		return err
+	}
+	if link == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeArticleLink, id)
+	}
+	if _, _, err := s.checkRecord(ctx, link.ObjectAPIName, link.RecordID, constants.PermEdit, currentUser); err != nil {
+		return err
+	}
+	return s.repo.DeleteLink(ctx, id)
+}
+
+// checkRecord loads a record with field-level security and verifies the user has an access
+// level on it
+func (s *KnowledgeService) checkRecord(ctx context.Context, objectName, recordID, access string, currentUser *models.UserSession) (*models.ObjectMetadata, models.SObject, error) {
+	if currentUser == nil {
+		return nil, nil, errors.NewUnauthorizedError("User session not found")
+	}
+	if strings.TrimSpace(objectName) == "" || strings.TrimSpace(recordID) == "" {
+		return nil, nil, errors.NewValidationError(constants.FieldSysKnowledgeArticleLink_RecordID, "object and record are required")
+	}
+	schema := s.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return nil, nil, errors.NewNotFoundError("Object Metadata", objectName)
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, nil, errors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	if access != constants.PermRead && !s.permissions.CheckRecordAccess(ctx, schema, records[0], access, currentUser) {
+		return nil, nil, errors.NewPermissionError(access, schema.APIName)
+	}
+	return schema, records[0], nil
+}
+
+// ==================== Helpers ====================
+
+// knowledgeSearchDocument projects a published version onto the search index
+func knowledgeSearchDocument(v *models.SystemKnowledgeArticleVersion) ports.SearchDocument {
+	doc := ports.SearchDocument{
+		ObjectAPIName: constants.TableKnowledgeArticle,
+		RecordID:      v.ArticleID,
+		Fields:        map[string]string{constants.FieldSysKnowledgeArticleVersion_Title: v.Title},
+	}
+	if v.Summary != nil && *v.Summary != "" {
+		doc.Fields[constants.FieldSysKnowledgeArticleVersion_Summary] = *v.Summary
+	}
+	if v.Body != nil && *v.Body != "" {
+		doc.Fields[constants.FieldSysKnowledgeArticleVersion_Body] = *v.Body
+	}
+	return doc
+}
+
+// knowledgeRecordText joins the text of a record's fields into a search query
+func knowledgeRecordText(record models.SObject, fields []string) string {
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if value := strings.TrimSpace(record.GetString(field)); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	text := strings.Join(parts, " ")
+	if runes := []rune(text); len(runes) > knowledgeSuggestionTextLimit {
+		text = string(runes[:knowledgeSuggestionTextLimit])
+	}
+	return text
+}
+
+// knowledgeTerms picks the distinct words of a query worth matching with LIKE: the first
+// few of at least three characters
+func knowledgeTerms(term string) []string {
+	seen := make(map[string]bool)
+	terms := make([]string, 0, knowledgeFallbackTerms)
+	for _, word := range strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) < 3 || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+		if len(terms) >= knowledgeFallbackTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// knowledgeURLName turns text into a lowercase, hyphenated URL name
+func knowledgeURLName(text string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	name := strings.TrimSuffix(b.String(), "-")
+	if len(name) > knowledgeURLNameLimit {
+		name = strings.TrimSuffix(name[:knowledgeURLNameLimit], "-")
+	}
+	if name == "" {
+		name = "article"
+	}
+	return name
+}
+
+// nextKnowledgeVersion returns the version number of a draft: its own until that version
+// has been published, then the one after the latest published
+func nextKnowledgeVersion(current, latestPublished int) int {
+	if latestPublished >= current {
+		return latestPublished + 1
+	}
+	return current
+}
+
+// knowledgeCategoryTree returns a category and all of its descendants
+func knowledgeCategoryTree(categories []*models.SystemKnowledgeCategory, rootID string) []string {
+	children := make(map[string][]string)
+	for _, c := range categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+	ids := []string{rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids
+}
+
+func findKnowledgeCategory(categories []*models.SystemKnowledgeCategory, id string) *models.SystemKnowledgeCategory {
+	for _, c := range categories {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// checkCategoryParent verifies a category's parent exists and is not the category or one
+// of its descendants
+func checkCategoryParent(categories []*models.SystemKnowledgeCategory, category *models.SystemKnowledgeCategory) error {
+	if category.ParentID == nil {
+		return nil
+	}
+	if findKnowledgeCategory(categories, *category.ParentID) == nil {
+		return errors.NewNotFoundError(constants.TableKnowledgeCategory, *category.ParentID)
+	}
+	for _, id := range knowledgeCategoryTree(categories, category.ID) {
+		if id == *category.ParentID {
+			return errors.NewValidationError(constants.FieldSysKnowledgeCategory_ParentID, "a category cannot be nested under itself or its subcategories")
+		}
+	}
+	return nil
+}
+
+// normalizeKnowledgeCategory validates a category
+func normalizeKnowledgeCategory(c *models.SystemKnowledgeCategory) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return errors.NewValidationError(constants.FieldSysKnowledgeCategory_Name, "name is required")
+	}
+	if c.ParentID != nil && strings.TrimSpace(*c.ParentID) == "" {
+		c.ParentID = nil
+	}
+	return nil
+}
+
+// normalizeKnowledgeArticle validates the working copy of an article
+func normalizeKnowledgeArticle(a *models.SystemKnowledgeArticle) error {
+	a.Title = strings.TrimSpace(a.Title)
+	if a.Title == "" {
+		return errors.NewValidationError(constants.FieldSysKnowledgeArticle_Title, "title is required")
+	}
+	if a.URLName != "" {
+		a.URLName = knowledgeURLName(a.URLName)
+	}
+	if a.CategoryID != nil && strings.TrimSpace(*a.CategoryID) == "" {
+		a.CategoryID = nil
+	}
+	return nil
+}
+
+func checkKnowledgeAdmin(currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if currentUser.IsSystemAdmin || constants.IsSuperUser(currentUser.ProfileID) {
+		return nil
+	}
+	return errors.NewPermissionError("manage", "knowledge categories")
+}
+
+func checkCanEditArticle(ownerID string, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if currentUser.IsSystemAdmin || constants.IsSuperUser(currentUser.ProfileID) || ownerID == currentUser.ID {
+		return nil
+	}
+	return errors.NewPermissionError("edit", "knowledge article")
+}
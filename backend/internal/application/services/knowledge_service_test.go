@@ -0,0 +1,74 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnowledgeURLName(t *testing.T) {
+	assert.Equal(t, "reset-your-password", knowledgeURLName("  Reset your password! "))
+	assert.Equal(t, "vpn-2-0-setup", knowledgeURLName("VPN 2.0 -- setup"))
+	assert.Equal(t, "article", knowledgeURLName("¿?"))
+
+	long := ""
+	for i := 0; i < 60; i++ {
+		long += "word "
+	}
+	name := knowledgeURLName(long)
+	assert.LessOrEqual(t, len(name), knowledgeURLNameLimit)
+	assert.NotEqual(t, '-', rune(name[len(name)-1]))
+}
+
+func TestNextKnowledgeVersion(t *testing.T) {
+	assert.Equal(t, 1, nextKnowledgeVersion(1, 0), "a draft that was never published keeps its version")
+	assert.Equal(t, 2, nextKnowledgeVersion(1, 1), "editing a published version starts the next one")
+	assert.Equal(t, 3, nextKnowledgeVersion(3, 2), "a pending draft keeps its version")
+	assert.Equal(t, 5, nextKnowledgeVersion(2, 4))
+}
+
+func TestKnowledgeCategoryTree(t *testing.T) {
+	parent := func(id string) *string { return &id }
+	categories := []*models.SystemKnowledgeCategory{
+		{ID: "root", Name: "Root"},
+		{ID: "billing", Name: "Billing", ParentID: parent("root")},
+		{ID: "invoices", Name: "Invoices", ParentID: parent("billing")},
+		{ID: "other", Name: "Other"},
+	}
+	assert.ElementsMatch(t, []string{"root", "billing", "invoices"}, knowledgeCategoryTree(categories, "root"))
+	assert.Equal(t, []string{"other"}, knowledgeCategoryTree(categories, "other"))
+
+	move := &models.SystemKnowledgeCategory{ID: "root", Name: "Root", ParentID: parent("invoices")}
+	assert.Error(t, checkCategoryParent(categories, move), "a category cannot move under its descendant")
+	move.ParentID = parent("root")
+	assert.Error(t, checkCategoryParent(categories, move), "a category cannot be its own parent")
+	move.ParentID = parent("missing")
+	assert.Error(t, checkCategoryParent(categories, move))
+	move.ParentID = parent("other")
+	assert.NoError(t, checkCategoryParent(categories, move))
+}
+
+func TestKnowledgeTerms(t *testing.T) {
+	assert.Equal(t, []string{"printer", "jams", "paper"}, knowledgeTerms("Printer jams on A4 paper; printer!"))
+	assert.Empty(t, knowledgeTerms("a of"))
+	assert.Len(t, knowledgeTerms("one two three four five six seven eight nine ten eleven"), knowledgeFallbackTerms)
+}
+
+func TestKnowledgeSearchDocument(t *testing.T) {
+	summary := ""
+	body := "Hold the power button"
+	doc := knowledgeSearchDocument(&models.SystemKnowledgeArticleVersion{ArticleID: "a1", Title: "Reboot", Summary: &summary, Body: &body})
+	assert.Equal(t, constants.TableKnowledgeArticle, doc.ObjectAPIName)
+	assert.Equal(t, "a1", doc.RecordID)
+	assert.Equal(t, map[string]string{"title": "Reboot", "body": body}, doc.Fields)
+}
+
+func TestCheckCanEditArticle(t *testing.T) {
+	assert.Error(t, checkCanEditArticle("u1", nil))
+	assert.NoError(t, checkCanEditArticle("u1", &models.UserSession{ID: "u1"}))
+	assert.Error(t, checkCanEditArticle("u1", &models.UserSession{ID: "u2"}))
+	assert.NoError(t, checkCanEditArticle("u1", &models.UserSession{ID: "u2", IsSystemAdmin: true}))
+	assert.Error(t, checkKnowledgeAdmin(&models.UserSession{ID: "u1"}))
+}
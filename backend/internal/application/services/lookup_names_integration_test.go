@@ -0,0 +1,35 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryHydratesLookupNames_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	account := h.CreateObject(t, "account", testharness.Field("name", constants.FieldTypeText))
+	lookup := testharness.Field("account_id", constants.FieldTypeLookup)
+	lookup.ReferenceTo = []string{account.APIName}
+	contact := h.CreateObject(t, "contact", testharness.Field("name", constants.FieldTypeText), lookup)
+
+	acme := h.CreateRecord(t, account.APIName, models.SObject{"name": "Acme"})
+	h.CreateRecord(t, contact.APIName, models.SObject{"name": "Jane", "account_id": acme.GetString(constants.FieldID)})
+	h.CreateRecord(t, contact.APIName, models.SObject{"name": "Solo"})
+
+	rows, err := h.Services.QuerySvc.QueryWithFilter(ctx, contact.APIName, "", h.Admin, "name", constants.SortASC, 10)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "Jane", rows[0]["name"])
+	assert.Equal(t, "Acme", rows[0]["account_id"+constants.LookupNameSuffix])
+	assert.NotContains(t, rows[1], "account_id"+constants.LookupNameSuffix, "an empty lookup has no name")
+}
@@ -0,0 +1,112 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMassUpdate_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	mass := h.Services.MassOperations
+
+	obj := h.CreateObject(t, "mass", testharness.Field("name", constants.FieldTypeText), testharness.Field("amount", constants.FieldTypeNumber))
+	for i := 0; i < 5; i++ {
+		h.CreateRecord(t, obj.APIName, models.SObject{"name": fmt.Sprintf("r%d", i), "amount": 1})
+	}
+
+	t.Run("Updates every matching record in batches", func(t *testing.T) {
+		job, err := mass.Update(ctx, models.MassUpdateRequest{
+			ObjectAPIName:        obj.APIName,
+			Values:               models.SObject{"amount": 2},
+			MassOperationOptions: models.MassOperationOptions{BatchSize: 2},
+		}, h.Admin)
+		require.NoError(t, err)
+
+		job = waitForAsyncJob(t, h, ctx, job.ID)
+		assert.Equal(t, string(constants.AsyncJobStatusCompleted), job.Status)
+		assert.Equal(t, 5, job.ProcessedCount)
+		assert.Equal(t, 0, job.FailedCount)
+
+		rows, err := h.Services.QuerySvc.QueryWithFilter(ctx, obj.APIName, "amount == 2", h.Admin, "name", constants.SortASC, 10)
+		require.NoError(t, err)
+		assert.Len(t, rows, 5)
+	})
+
+	t.Run("Counts records that fail and updates the rest", func(t *testing.T) {
+		locked := h.CreateRecord(t, obj.APIName, models.SObject{"name": "locked", "amount": 1})
+		rule := &models.ValidationRule{
+			ObjectAPIName: obj.APIName,
+			Name:          "locked_amount",
+			Active:        true,
+			Condition:     "name == 'locked' && amount > 1",
+			ErrorMessage:  "Locked records keep their amount",
+		}
+		require.NoError(t, h.Services.Metadata.CreateValidationRule(ctx, rule))
+		t.Cleanup(func() { _ = h.Services.Metadata.DeleteValidationRule(ctx, rule.ID) })
+
+		job, err := mass.Update(ctx, models.MassUpdateRequest{
+			ObjectAPIName:        obj.APIName,
+			Values:               models.SObject{"amount": 3},
+			MassOperationOptions: models.MassOperationOptions{BatchSize: 4},
+		}, h.Admin)
+		require.NoError(t, err)
+
+		job = waitForAsyncJob(t, h, ctx, job.ID)
+		assert.Equal(t, string(constants.AsyncJobStatusCompleted), job.Status)
+		assert.Equal(t, 5, job.ProcessedCount)
+		assert.Equal(t, 1, job.FailedCount)
+
+		rows, err := h.Services.QuerySvc.QueryWithFilter(ctx, obj.APIName, "name == 'locked'", h.Admin, "name", constants.SortASC, 1)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, locked.GetString(constants.FieldID), rows[0].GetString(constants.FieldID))
+		assert.EqualValues(t, 1, rows[0]["amount"])
+	})
+
+	t.Run("Suppressing flows requires bypass_flows", func(t *testing.T) {
+		user := h.CreateUser(t)
+		req := models.MassUpdateRequest{
+			ObjectAPIName:        obj.APIName,
+			Values:               models.SObject{"amount": 4},
+			MassOperationOptions: models.MassOperationOptions{SuppressFlows: true},
+		}
+
+		_, err := mass.Update(ctx, req, user)
+		require.Error(t, err)
+		assert.True(t, errors.IsPermission(err), "got %v", err)
+
+		job, err := mass.Update(ctx, req, h.Admin)
+		require.NoError(t, err)
+		waitForAsyncJob(t, h, ctx, job.ID)
+	})
+}
+
+// waitForAsyncJob polls a job until it is no longer queued or running
+func waitForAsyncJob(t *testing.T, h *testharness.Harness, ctx context.Context, id string) *models.SystemAsyncJob {
+	t.Helper()
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		job, err := h.Services.AsyncJobs.GetJob(ctx, id)
+		require.NoError(t, err)
+		if job.Status != string(constants.AsyncJobStatusQueued) && job.Status != string(constants.AsyncJobStatusRunning) {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("async job %s still %s", id, job.Status)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
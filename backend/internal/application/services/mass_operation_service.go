@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultMassOperationBatchSize = 200
+	maxMassOperationBatchSize     = 1000
+)
+
+// MassOperationService transfers record ownership and updates field values across every
+// record of an object matching a filter. The work runs as an async job in batches of records,
+// each record saved through PersistenceService so validation, rollups and auditing apply.
+type MassOperationService struct {
+	persistence *PersistenceService
+	query       *QueryService
+	metadata    *MetadataService
+	permissions *PermissionService
+	jobs        *AsyncJobService
+}
+
+// NewMassOperationService creates a new MassOperationService
+func NewMassOperationService(
+	persistence *PersistenceService,
+	query *QueryService,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	jobs *AsyncJobService,
+) *MassOperationService {
+	return &MassOperationService{
+		persistence: persistence,
+		query:       query,
+		metadata:    metadata,
+		permissions: permissions,
+		jobs:        jobs,
+	}
+}
+
+// Transfer queues a job changing the owner of the matching records to a user or queue.
+// Sharing is evaluated when records are read, so ownership, role hierarchy and sharing rules
+// follow the new owner at once; the manual shares the previous owner granted are revoked
+// unless KeepManualShares is set.
+func (s *MassOperationService) Transfer(ctx context.Context, req models.MassTransferRequest, currentUser *models.UserSession) (*models.SystemAsyncJob, error) {
+	schema, err := s.massSchema(ctx, req.ObjectAPIName, req.FilterExpr, req.MassOperationOptions, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if FindField(schema, constants.FieldOwnerID) == nil {
+		return nil, errors.NewValidationError(constants.FieldObjectAPIName, fmt.Sprintf("%s records have no owner", schema.APIName))
+	}
+	if err := s.checkOwner(ctx, req.ToOwnerID, currentUser); err != nil {
+		return nil, err
+	}
+
+	filter := req.FilterExpr
+	if req.FromOwnerID != "" {
+		filter = combineFilters(fmt.Sprintf("%s == %s", constants.FieldOwnerID, strconv.Quote(req.FromOwnerID)), filter)
+	}
+	update := models.SObject{constants.FieldOwnerID: req.ToOwnerID}
+
+	return s.jobs.Enqueue(ctx, constants.AsyncJobTypeMassTransfer, schema.APIName, req, 0, currentUser,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			ctx = WithOwnerTransfer(ctx)
+			return s.run(ctx, schema, filter, update, req.MassOperationOptions, currentUser, job, report, func(ids []string) error {
+				if req.KeepManualShares {
+					return nil
+				}
+				_, err := s.permissions.RevokeManualShares(ctx, schema.APIName, ids)
+				return err
+			})
+		})
+}
+
+// Update queues a job setting the given field values on the matching records. Every field
+// must be one the user may edit.
+func (s *MassOperationService) Update(ctx context.Context, req models.MassUpdateRequest, currentUser *models.UserSession) (*models.SystemAsyncJob, error) {
+	schema, err := s.massSchema(ctx, req.ObjectAPIName, req.FilterExpr, req.MassOperationOptions, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Values) == 0 {
+		return nil, errors.NewValidationError("values", "at least one field value is required")
+	}
+	update := make(models.SObject, len(req.Values))
+	for field, value := range req.Values {
+		fieldUpdate, err := s.persistence.FieldUpdate(ctx, schema.APIName, field, value, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fieldUpdate {
+			update[k] = v
+		}
+	}
+
+	return s.jobs.Enqueue(ctx, constants.AsyncJobTypeMassUpdate, schema.APIName, req, 0, currentUser,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			return s.run(ctx, schema, req.FilterExpr, update, req.MassOperationOptions, currentUser, job, report, nil)
+		})
+}
+
+// massSchema resolves the object of a mass operation and checks its filter and options
+func (s *MassOperationService) massSchema(ctx context.Context, objectAPIName, filterExpr string, options models.MassOperationOptions, currentUser *models.UserSession) (*models.ObjectMetadata, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	// Saves check this too; checking when queueing spares a job failing record by record
+	if err := CheckSystemTableWrite(schema.APIName, constants.PermEdit, currentUser); err != nil {
+		return nil, err
+	}
+	if schema.IsExternal {
+		return nil, errors.NewValidationError(constants.FieldObjectAPIName, fmt.Sprintf("%s is an external object and is read-only", schema.APIName))
+	}
+	if filterExpr != "" {
+		if _, _, err := formula.ToSQL(filterExpr); err != nil {
+			return nil, errors.NewValidationError("filter_expr", fmt.Sprintf("invalid filter: %v", err))
+		}
+	}
+	if options.BatchSize < 0 || options.BatchSize > maxMassOperationBatchSize {
+		return nil, errors.NewValidationError("batch_size", fmt.Sprintf("must be between 1 and %d", maxMassOperationBatchSize))
+	}
+	// Checked when queueing so the caller learns of a missing permission at once
+	if _, err := s.persistence.WithWriteOptions(ctx, WriteOptions{SkipFlows: options.SuppressFlows}, currentUser); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// checkOwner verifies that a new owner is an existing user or queue
+func (s *MassOperationService) checkOwner(ctx context.Context, ownerID string, currentUser *models.UserSession) error {
+	for _, table := range []string{constants.TableUser, constants.TableGroup} {
+		rows, err := s.query.QueryByIDs(ctx, table, []string{ownerID}, currentUser)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			return nil
+		}
+	}
+	return errors.NewNotFoundError("Owner", ownerID)
+}
+
+// run applies update to the records matching filter batch by batch, in ID order so records
+// that stop matching once updated are not skipped. Records that fail are counted and left
+// as they are. afterBatch receives the IDs of each batch's updated records.
+func (s *MassOperationService) run(
+	ctx context.Context,
+	schema *models.ObjectMetadata,
+	filter string,
+	update models.SObject,
+	options models.MassOperationOptions,
+	currentUser *models.UserSession,
+	job *models.SystemAsyncJob,
+	report func(),
+	afterBatch func(ids []string) error,
+) error {
+	batchSize := options.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultMassOperationBatchSize
+	}
+	ctx, err := s.persistence.WithWriteOptions(ctx, WriteOptions{SkipFlows: options.SuppressFlows}, currentUser)
+	if err != nil {
+		return err
+	}
+
+	lastID := ""
+	for {
+		batchFilter := filter
+		if lastID != "" {
+			batchFilter = combineFilters(fmt.Sprintf("%s > %s", constants.FieldID, strconv.Quote(lastID)), filter)
+		}
+		rows, err := s.query.Query(ctx, models.QueryRequest{
+			ObjectAPIName:   schema.APIName,
+			FilterExpr:      batchFilter,
+			SortField:       constants.FieldID,
+			SortDirection:   constants.SortASC,
+			Limit:           batchSize,
+			SkipLookupNames: true,
+		}, currentUser)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		updated := make([]string, 0, len(rows))
+		for _, row := range rows {
+			id := row.GetString(constants.FieldID)
+			fields := make(models.SObject, len(update))
+			for k, v := range update {
+				fields[k] = v
+			}
+			if err := s.persistence.Update(ctx, schema.APIName, id, fields, currentUser); err != nil {
+				job.FailedCount++
+				log.Printf("⚠️ Async job %s: %s/%s not updated: %v", job.ID, schema.APIName, id, err)
+			} else {
+				job.ProcessedCount++
+				updated = append(updated, id)
+			}
+			lastID = id
+		}
+		if afterBatch != nil {
+			if err := afterBatch(updated); err != nil {
+				return err
+			}
+		}
+		job.TotalCount = job.ProcessedCount + job.FailedCount
+		report()
+
+		if len(rows) < batchSize {
+			break
+		}
+	}
+
+	log.Printf("🔁 %s: updated %d %s records, %d failed", job.JobType, job.ProcessedCount, schema.APIName, job.FailedCount)
+	return nil
+}
@@ -23,7 +23,7 @@ func TestMasterDetail_CascadeDelete(t *testing.T) {
 	}
 
 	// 1. Setup
-	conn, err := database.GetInstance()
+	conn, err := database.GetTestInstance()
 	if err != nil {
 		t.Logf("Skipping integration test: failed to connect to DB: %v", err)
 		t.SkipNow()
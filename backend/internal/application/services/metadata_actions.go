@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -31,7 +33,7 @@ func (ms *MetadataService) CreateAction(ctx context.Context, action *models.Acti
 	// Check for duplicate ID
 	existing, _ := ms.repo.GetAction(ctx, action.ID)
 	if existing != nil {
-		return fmt.Errorf("action with ID '%s' already exists", action.ID)
+		return errors.NewConflictError("Action", constants.FieldID, action.ID)
 	}
 
 	// Check for duplicate (object_api_name, name) - this is the unique constraint
@@ -40,7 +42,7 @@ func (ms *MetadataService) CreateAction(ctx context.Context, action *models.Acti
 		return fmt.Errorf("failed to check for existing action: %w", err)
 	}
 	if exists {
-		return fmt.Errorf("action '%s' already exists for object '%s'", action.Name, action.ObjectAPIName)
+		return errors.NewConflictError(fmt.Sprintf("Action on %s", action.ObjectAPIName), constants.FieldName, action.Name)
 	}
 
 	// Insert into database via Repo
@@ -7,6 +7,8 @@ import (
 	"log"
 	"time"
 
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -59,7 +61,7 @@ func (ms *MetadataService) CreateApp(ctx context.Context, app *models.AppConfig)
 		return fmt.Errorf("failed to check app existence: %w", err)
 	}
 	if existing != nil {
-		return fmt.Errorf("app with ID '%s' already exists", app.ID)
+		return errors.NewConflictError("App", constants.FieldID, app.ID)
 	}
 
 	// Insert into DB via Repo
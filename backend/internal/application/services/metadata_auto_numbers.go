@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Auto Numbers ====================
+
+// defaultAutoNumberFormat is used when an auto-number field has no display format
+const defaultAutoNumberFormat = "{0}"
+
+var autoNumberPlaceholder = regexp.MustCompile(`\{0+\}`)
+
+// AutoNumberSettings holds the changeable settings of an auto-number field; nil leaves a
+// setting unchanged
+type AutoNumberSettings struct {
+	DisplayFormat *string `json:"display_format"`
+	GapFree       *bool   `json:"gap_free"`
+}
+
+// GetAutoNumber returns the sequence of an auto-number field
+func (ms *MetadataService) GetAutoNumber(ctx context.Context, objectAPIName, fieldAPIName string) (*models.AutoNumber, error) {
+	schema, field, err := ms.getAutoNumberField(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	an, err := ms.repo.GetAutoNumber(ctx, schema.APIName, field.APIName)
+	if err != nil {
+		return nil, err
+	}
+	if an == nil {
+		return nil, errors.NewNotFoundError("Auto number", schema.APIName+"."+field.APIName)
+	}
+	return an, nil
+}
+
+// UpdateAutoNumberSettings changes the display format or gap-free mode of an auto-number
+// field. The sequence continues where it was; existing records keep their numbers.
+func (ms *MetadataService) UpdateAutoNumberSettings(ctx context.Context, objectAPIName, fieldAPIName string, settings AutoNumberSettings) (*models.AutoNumber, error) {
+	an, err := ms.GetAutoNumber(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if settings.DisplayFormat != nil {
+		if err := validateAutoNumberFormat(*settings.DisplayFormat); err != nil {
+			return nil, err
+		}
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if settings.DisplayFormat != nil && *settings.DisplayFormat != an.DisplayFormat {
+		an.DisplayFormat = *settings.DisplayFormat
+		if err := ms.repo.SetFieldDefaultValue(ctx, GenerateFieldID(an.ObjectAPIName, an.FieldAPIName), &an.DisplayFormat); err != nil {
+			return nil, err
+		}
+	}
+	if settings.GapFree != nil {
+		an.GapFree = *settings.GapFree
+	}
+	if err := ms.repo.UpdateAutoNumberSettings(ctx, an.ID, an.DisplayFormat, an.GapFree); err != nil {
+		return nil, err
+	}
+	ms.invalidateCacheLocked()
+	return an, nil
+}
+
+// BackfillAutoNumber renumbers every existing record of an auto-number field, continuing its
+// sequence, and returns the number of records updated
+func (ms *MetadataService) BackfillAutoNumber(ctx context.Context, objectAPIName, fieldAPIName string) (int, error) {
+	an, err := ms.GetAutoNumber(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return 0, err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	return ms.backfillAutoNumberLocked(ctx, an)
+}
+
+func (ms *MetadataService) backfillAutoNumberLocked(ctx context.Context, an *models.AutoNumber) (int, error) {
+	n, err := ms.repo.BackfillAutoNumber(ctx, an, func(value int) string {
+		return formatAutoNumber(an.DisplayFormat, value)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to backfill %s.%s: %w", an.ObjectAPIName, an.FieldAPIName, err)
+	}
+	log.Printf("🔢 Backfilled %d %s records with %s numbers", n, an.ObjectAPIName, an.FieldAPIName)
+	ms.invalidateCacheLocked()
+	return n, nil
+}
+
+// syncAutoNumberLocked keeps the sequence of a field in step with its type after an update:
+// converting to AutoNumber registers a sequence and numbers existing records, converting away
+// drops it, and a changed display format is applied without resetting the sequence.
+func (ms *MetadataService) syncAutoNumberLocked(ctx context.Context, objectAPIName string, field *models.FieldMetadata, previousType constants.SchemaFieldType) error {
+	if field.Type != constants.FieldTypeAutoNumber {
+		if previousType == constants.FieldTypeAutoNumber {
+			return ms.repo.DeleteAutoNumber(ctx, objectAPIName, field.APIName)
+		}
+		return nil
+	}
+
+	format := autoNumberFormat(field)
+	an, err := ms.repo.GetAutoNumber(ctx, objectAPIName, field.APIName)
+	if err != nil {
+		return err
+	}
+	if an == nil {
+		if err := ms.repo.UpsertAutoNumber(ctx, GenerateAutoNumberID(objectAPIName, field.APIName), objectAPIName, field.APIName, format, 1, 0); err != nil {
+			return fmt.Errorf("failed to register auto-number metadata: %w", err)
+		}
+		if an, err = ms.repo.GetAutoNumber(ctx, objectAPIName, field.APIName); err != nil || an == nil {
+			return fmt.Errorf("failed to load auto-number metadata: %v", err)
+		}
+	} else if an.DisplayFormat != format {
+		an.DisplayFormat = format
+		if err := ms.repo.UpdateAutoNumberSettings(ctx, an.ID, format, an.GapFree); err != nil {
+			return err
+		}
+	}
+
+	if previousType != constants.FieldTypeAutoNumber {
+		if _, err := ms.backfillAutoNumberLocked(ctx, an); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ms *MetadataService) getAutoNumberField(ctx context.Context, objectAPIName, fieldAPIName string) (*models.ObjectMetadata, *models.FieldMetadata, error) {
+	schema, err := ms.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, nil, err
+	}
+	field := FindField(schema, fieldAPIName)
+	if field == nil {
+		return nil, nil, errors.NewNotFoundError("Field", fieldAPIName)
+	}
+	if field.Type != constants.FieldTypeAutoNumber {
+		return nil, nil, errors.NewValidationError(field.APIName, "not an auto-number field")
+	}
+	return schema, field, nil
+}
+
+// autoNumberFormat returns the display format of an auto-number field, kept in its default value
+func autoNumberFormat(field *models.FieldMetadata) string {
+	if field.DefaultValue != nil && *field.DefaultValue != "" {
+		return *field.DefaultValue
+	}
+	return defaultAutoNumberFormat
+}
+
+// validateAutoNumberFormat checks that a display format has exactly one number placeholder
+func validateAutoNumberFormat(format string) error {
+	if len(autoNumberPlaceholder.FindAllString(format, -1)) != 1 || strings.Count(format, "{") != 1 {
+		return errors.NewValidationError(constants.FieldSysAutoNumber_DisplayFormat,
+			fmt.Sprintf("'%s' must contain exactly one number placeholder such as {0000}", format))
+	}
+	return nil
+}
+
+// formatAutoNumber applies a display format (e.g., "INV-{0000}") to a numeric value
+func formatAutoNumber(format string, value int) string {
+	start := strings.Index(format, "{")
+	end := strings.Index(format, "}")
+	if start == -1 || end == -1 || end <= start {
+		// Fallback: just append
+		return fmt.Sprintf("%s%d", format, value)
+	}
+
+	placeholder := format[start+1 : end]
+	padding := len(placeholder)
+
+	formattedValue := fmt.Sprintf("%0*d", padding, value)
+	return format[:start] + formattedValue + format[end+1:]
+}
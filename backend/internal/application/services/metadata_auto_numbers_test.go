@@ -0,0 +1,29 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatAutoNumber(t *testing.T) {
+	assert.Equal(t, "INV-0042", formatAutoNumber("INV-{0000}", 42))
+	assert.Equal(t, "7", formatAutoNumber("{0}", 7))
+	assert.Equal(t, "CASE-12345-X", formatAutoNumber("CASE-{000}-X", 12345), "padding never truncates")
+	assert.Equal(t, "N-5", formatAutoNumber("N-", 5), "missing placeholder appends")
+}
+
+func TestValidateAutoNumberFormat(t *testing.T) {
+	assert.NoError(t, validateAutoNumberFormat("{0}"))
+	assert.NoError(t, validateAutoNumberFormat("INV-{00000}"))
+	assert.True(t, errors.IsValidation(validateAutoNumberFormat("INV-")))
+	assert.True(t, errors.IsValidation(validateAutoNumberFormat("{00}-{00}")))
+	assert.True(t, errors.IsValidation(validateAutoNumberFormat("{YYYY}-{0}")))
+}
+
+func TestAutoNumberValue(t *testing.T) {
+	assert.Equal(t, 12, autoNumberValue(int64(12)))
+	assert.Equal(t, 3, autoNumberValue(float64(3)))
+	assert.Equal(t, 0, autoNumberValue(nil))
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Metadata Bundles ====================
+
+// ExportMetadataBundle copies the org's customizations into a bundle: the custom objects with
+// their non-system fields, their validation rules, the flows and the dashboards. Record data,
+// users and permissions are not included.
+func (ms *MetadataService) ExportMetadataBundle(ctx context.Context) (*models.MetadataBundle, error) {
+	if err := ms.ensureCacheInitialized(); err != nil {
+		return nil, err
+	}
+	bundle := &models.MetadataBundle{
+		Version:    models.MetadataBundleVersion,
+		ExportedAt: time.Now().UTC(),
+		Objects:    []models.ObjectMetadata{},
+	}
+
+	for _, schema := range ms.GetSchemas(ctx) {
+		// is_custom is unreliable for objects created through the API, so system tables are
+		// recognized by name
+		if schema.IsExternal || constants.IsSystemTable(schema.APIName) {
+			continue
+		}
+		obj := *schema
+		obj.ID = ""
+		obj.LastModifiedDate = time.Time{}
+		obj.Fields = make([]models.FieldMetadata, 0, len(schema.Fields))
+		for _, field := range schema.Fields {
+			if field.IsSystem || constants.IsSystemField(field.APIName) {
+				continue
+			}
+			field.ID = ""
+			field.OptionLabels = nil
+			obj.Fields = append(obj.Fields, field)
+		}
+		bundle.Objects = append(bundle.Objects, obj)
+
+		for _, rule := range ms.GetValidationRules(ctx, obj.APIName) {
+			bundle.ValidationRules = append(bundle.ValidationRules, *rule)
+		}
+	}
+	sort.Slice(bundle.Objects, func(i, j int) bool { return bundle.Objects[i].APIName < bundle.Objects[j].APIName })
+
+	for _, flow := range ms.GetFlows(ctx) {
+		f := *flow
+		// Run state belongs to the org the flow ran in
+		f.LastRunAt, f.NextRunAt, f.IsRunning = nil, nil, false
+		bundle.Flows = append(bundle.Flows, f)
+	}
+	for _, dashboard := range ms.GetDashboards(ctx, nil) {
+		bundle.Dashboards = append(bundle.Dashboards, *dashboard)
+	}
+	return bundle, nil
+}
+
+// ImportMetadataBundle applies a bundle written by ExportMetadataBundle. Missing objects and
+// fields are created; existing ones are left as they are. Validation rules, flows and
+// dashboards are matched by ID, updating those that exist and creating the rest. Fields
+// that may reference other objects of the bundle (lookups, formulas, roll-ups) are added
+// once every object exists. A component that fails is reported in the result and the
+// import goes on.
+func (ms *MetadataService) ImportMetadataBundle(ctx context.Context, bundle *models.MetadataBundle, user *models.UserSession) (*models.MetadataImportResult, error) {
+	if bundle.Version < 1 || bundle.Version > models.MetadataBundleVersion {
+		return nil, fmt.Errorf("unsupported metadata bundle version %d (this release reads up to %d)", bundle.Version, models.MetadataBundleVersion)
+	}
+	result := &models.MetadataImportResult{}
+	fail := func(component, name string, err error) {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s %s: %v", component, name, err))
+	}
+
+	// Objects first, lookup targets before the objects referencing them, so lookups are
+	// created with their table where the dialect cannot add foreign keys later. Fields that
+	// reference an object that does not exist yet wait until every object does.
+	type deferredField struct {
+		object string
+		field  models.FieldMetadata
+	}
+	var deferred []deferredField
+	for _, obj := range orderByLookups(bundle.Objects) {
+		existing := ms.GetSchema(ctx, obj.APIName)
+		var own []models.FieldMetadata
+		for _, field := range obj.Fields {
+			if existing != nil && hasField(existing.Fields, field.APIName) {
+				continue
+			}
+			if ms.needsOtherObjects(ctx, obj.APIName, field) {
+				deferred = append(deferred, deferredField{obj.APIName, field})
+				continue
+			}
+			own = append(own, field)
+		}
+
+		if existing == nil {
+			schema := obj
+			schema.Fields = own
+			if err := ms.CreateSchema(ctx, &schema); err != nil {
+				fail(constants.SetupComponentObject, obj.APIName, err)
+				continue
+			}
+			result.Objects.Created++
+			result.Fields.Created += len(own)
+			continue
+		}
+		for i := range own {
+			if err := ms.CreateField(ctx, obj.APIName, &own[i]); err != nil {
+				fail(constants.SetupComponentField, obj.APIName+"."+own[i].APIName, err)
+				continue
+			}
+			result.Fields.Created++
+		}
+	}
+	for _, d := range deferred {
+		field := d.field
+		if ms.GetSchema(ctx, d.object) == nil {
+			continue // The object failed above
+		}
+		if err := ms.CreateField(ctx, d.object, &field); err != nil {
+			fail(constants.SetupComponentField, d.object+"."+field.APIName, err)
+			continue
+		}
+		result.Fields.Created++
+	}
+
+	for i := range bundle.ValidationRules {
+		rule := bundle.ValidationRules[i]
+		existing, err := ms.repo.GetValidationRule(ctx, rule.ID)
+		switch {
+		case err != nil:
+			fail(constants.SetupComponentValidationRule, rule.Name, err)
+		case existing != nil:
+			if err := ms.UpdateValidationRule(ctx, rule.ID, &rule); err != nil {
+				fail(constants.SetupComponentValidationRule, rule.Name, err)
+			} else {
+				result.ValidationRules.Updated++
+			}
+		default:
+			if err := ms.CreateValidationRule(ctx, &rule); err != nil {
+				fail(constants.SetupComponentValidationRule, rule.Name, err)
+			} else {
+				result.ValidationRules.Created++
+			}
+		}
+	}
+
+	for i := range bundle.Flows {
+		flow := bundle.Flows[i]
+		if ms.GetFlow(ctx, flow.ID) != nil {
+			if err := ms.UpdateFlow(ctx, flow.ID, &flow); err != nil {
+				fail(constants.SetupComponentFlow, flow.Name, err)
+			} else {
+				result.Flows.Updated++
+			}
+			continue
+		}
+		if err := ms.CreateFlow(ctx, &flow); err != nil {
+			fail(constants.SetupComponentFlow, flow.Name, err)
+		} else {
+			result.Flows.Created++
+		}
+	}
+
+	for i := range bundle.Dashboards {
+		dashboard := bundle.Dashboards[i]
+		if dashboard.ID != "" && ms.GetDashboard(ctx, dashboard.ID) != nil {
+			if err := ms.UpdateDashboard(ctx, dashboard.ID, &dashboard, user); err != nil {
+				fail("Dashboard", dashboard.Label, err)
+			} else {
+				result.Dashboards.Updated++
+			}
+			continue
+		}
+		if err := ms.CreateDashboard(ctx, &dashboard, user); err != nil {
+			fail("Dashboard", dashboard.Label, err)
+		} else {
+			result.Dashboards.Created++
+		}
+	}
+	return result, nil
+}
+
+// needsOtherObjects reports whether a field of object can only be created once other objects
+// exist: formulas and roll-ups may read any object, lookups need their targets
+func (ms *MetadataService) needsOtherObjects(ctx context.Context, object string, field models.FieldMetadata) bool {
+	if field.Type == constants.FieldTypeFormula || field.Type == constants.FieldTypeRollupSummary || field.RollupConfig != nil {
+		return true
+	}
+	for _, target := range field.ReferenceTo {
+		if target != object && ms.GetSchema(ctx, target) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// orderByLookups sorts objects so that the targets of their lookups come first, keeping the
+// bundle order otherwise. Objects in a lookup cycle keep their order; needsOtherObjects defers
+// the lookups closing the cycle.
+func orderByLookups(objects []models.ObjectMetadata) []models.ObjectMetadata {
+	inBundle := make(map[string]bool, len(objects))
+	for _, obj := range objects {
+		inBundle[obj.APIName] = true
+	}
+	placed := make(map[string]bool, len(objects))
+	ordered := make([]models.ObjectMetadata, 0, len(objects))
+	remaining := objects
+	for len(remaining) > 0 {
+		var next []models.ObjectMetadata
+		for _, obj := range remaining {
+			if lookupsPlaced(obj, inBundle, placed) {
+				ordered = append(ordered, obj)
+				placed[obj.APIName] = true
+			} else {
+				next = append(next, obj)
+			}
+		}
+		if len(next) == len(remaining) {
+			// A cycle: place the first object and let its lookups wait
+			ordered = append(ordered, next[0])
+			placed[next[0].APIName] = true
+			next = next[1:]
+		}
+		remaining = next
+	}
+	return ordered
+}
+
+// lookupsPlaced reports whether every bundle object that obj looks up is already placed
+func lookupsPlaced(obj models.ObjectMetadata, inBundle, placed map[string]bool) bool {
+	for _, field := range obj.Fields {
+		for _, target := range field.ReferenceTo {
+			if target != obj.APIName && inBundle[target] && !placed[target] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderByLookups(t *testing.T) {
+	object := func(name string, targets ...string) models.ObjectMetadata {
+		obj := models.ObjectMetadata{APIName: name}
+		for _, target := range targets {
+			obj.Fields = append(obj.Fields, models.FieldMetadata{APIName: target + "_id", ReferenceTo: []string{target}})
+		}
+		return obj
+	}
+	names := func(objects []models.ObjectMetadata) []string {
+		var out []string
+		for _, obj := range objects {
+			out = append(out, obj.APIName)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"account", "contact", "opportunity"}, names(orderByLookups([]models.ObjectMetadata{
+		object("opportunity", "account", "contact"),
+		object("contact", "account"),
+		object("account"),
+	})))
+	assert.Equal(t, []string{"task", "note"}, names(orderByLookups([]models.ObjectMetadata{
+		object("task", "user", "task"),
+		object("note", "task"),
+	})), "self lookups and objects outside the bundle do not hold an object back")
+	assert.Equal(t, []string{"a", "b", "c"}, names(orderByLookups([]models.ObjectMetadata{
+		object("a", "b"),
+		object("b", "a"),
+		object("c", "a"),
+	})), "a cycle keeps the bundle order")
+}
@@ -0,0 +1,56 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChildRelationships_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	account := h.CreateObject(t, "account", testharness.Field("name", constants.FieldTypeText))
+	lookup := func(name string) models.FieldMetadata {
+		f := testharness.Field(name, constants.FieldTypeLookup)
+		f.ReferenceTo = []string{account.APIName}
+		return f
+	}
+	// Two lookups to the same parent still make one child
+	contact := h.CreateObject(t, "contact", testharness.Field("name", constants.FieldTypeText), lookup("account_id"), lookup("billing_account_id"))
+	h.CreateObject(t, "note", testharness.Field("name", constants.FieldTypeText))
+
+	children := h.Services.Metadata.GetChildRelationships(ctx, account.APIName)
+	require.Len(t, children, 1)
+	assert.Equal(t, contact.APIName, children[0].APIName)
+	assert.Empty(t, h.Services.Metadata.GetChildRelationships(ctx, contact.APIName))
+
+	// A lookup to an object whose name matches the parent's as a LIKE pattern is not a child
+	decoyName := strings.Replace(account.APIName, "_", "x", 1)
+	require.NoError(t, h.Services.Metadata.CreateSchema(ctx, &models.ObjectMetadata{
+		APIName: decoyName, Label: decoyName, PluralLabel: decoyName + "s", SharingModel: constants.SharingModelPublicReadWrite,
+		Fields: []models.FieldMetadata{testharness.Field("name", constants.FieldTypeText)},
+	}))
+	t.Cleanup(func() { _ = h.Services.Metadata.DeleteSchema(ctx, decoyName) })
+	decoyLookup := testharness.Field("decoy_id", constants.FieldTypeLookup)
+	decoyLookup.ReferenceTo = []string{decoyName}
+	h.CreateObject(t, "decoy_child", testharness.Field("name", constants.FieldTypeText), decoyLookup)
+
+	// Layouts without related lists discover one per lookup field
+	layout := h.Services.Metadata.GetLayout(ctx, account.APIName, nil, "")
+	require.NotNil(t, layout)
+	var lookups []string
+	for _, rl := range layout.RelatedLists {
+		assert.Equal(t, contact.APIName, rl.ObjectAPIName)
+		lookups = append(lookups, rl.LookupField)
+	}
+	assert.ElementsMatch(t, []string{"account_id", "billing_account_id"}, lookups)
+}
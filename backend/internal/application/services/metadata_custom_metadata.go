@@ -0,0 +1,427 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Custom Metadata Types ====================
+
+var developerNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// customMetadataFieldTypes are the field types a custom metadata type may use
+var customMetadataFieldTypes = []models.FieldType{
+	constants.FieldTypeText,
+	constants.FieldTypeNumber,
+	constants.FieldTypeCurrency,
+	constants.FieldTypePercent,
+	constants.FieldTypeBoolean,
+	constants.FieldTypeDate,
+}
+
+// GetCustomMetadataTypes returns all custom metadata types
+func (ms *MetadataService) GetCustomMetadataTypes(ctx context.Context) ([]*models.CustomMetadataType, error) {
+	return ms.repo.GetCustomMetadataTypes(ctx)
+}
+
+// GetCustomMetadataType returns a custom metadata type by API name
+func (ms *MetadataService) GetCustomMetadataType(ctx context.Context, apiName string) (*models.CustomMetadataType, error) {
+	t, err := ms.repo.GetCustomMetadataType(ctx, apiName)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.NewNotFoundError("Custom metadata type", apiName)
+	}
+	return t, nil
+}
+
+// CreateCustomMetadataType creates a custom metadata type
+func (ms *MetadataService) CreateCustomMetadataType(ctx context.Context, t *models.CustomMetadataType) error {
+	t.APIName = strings.TrimSpace(t.APIName)
+	if !developerNamePattern.MatchString(t.APIName) {
+		return errors.NewValidationError(constants.FieldSysCustomMetadataType_APIName, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(t.Label) == "" {
+		t.Label = t.APIName
+	}
+	if err := validateCustomMetadataFields(t.Fields); err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	existing, err := ms.repo.GetCustomMetadataType(ctx, t.APIName)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("CustomMetadataType", constants.FieldSysCustomMetadataType_APIName, t.APIName)
+	}
+	if t.ID == "" {
+		t.ID = GenerateID()
+	}
+	if err := ms.repo.CreateCustomMetadataType(ctx, t); err != nil {
+		return err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return nil
+}
+
+// UpdateCustomMetadataType updates the label, description and fields of a custom metadata type.
+// Existing records must still be valid under the new fields.
+func (ms *MetadataService) UpdateCustomMetadataType(ctx context.Context, apiName string, updates *models.CustomMetadataType) (*models.CustomMetadataType, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	t, err := ms.repo.GetCustomMetadataType(ctx, apiName)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.NewNotFoundError("Custom metadata type", apiName)
+	}
+
+	if strings.TrimSpace(updates.Label) != "" {
+		t.Label = updates.Label
+	}
+	if updates.Description != nil {
+		t.Description = updates.Description
+	}
+	if updates.Fields != nil {
+		if err := validateCustomMetadataFields(updates.Fields); err != nil {
+			return nil, err
+		}
+		t.Fields = updates.Fields
+
+		records, err := ms.repo.GetCustomMetadataRecords(ctx, t.APIName)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			if _, err := normalizeCustomMetadataValues(t, rec.Values); err != nil {
+				return nil, errors.NewValidationError(constants.FieldSysCustomMetadataType_Fields,
+					fmt.Sprintf("record '%s' no longer fits: %v", rec.DeveloperName, err))
+			}
+		}
+	}
+
+	if err := ms.repo.UpdateCustomMetadataType(ctx, t); err != nil {
+		return nil, err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return t, nil
+}
+
+// DeleteCustomMetadataType deletes a custom metadata type and its records
+func (ms *MetadataService) DeleteCustomMetadataType(ctx context.Context, apiName string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	t, err := ms.repo.GetCustomMetadataType(ctx, apiName)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return errors.NewNotFoundError("Custom metadata type", apiName)
+	}
+	if err := ms.repo.DeleteCustomMetadataType(ctx, t); err != nil {
+		return err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return nil
+}
+
+// ==================== Custom Metadata Records ====================
+
+// GetCustomMetadataRecords returns the records of a custom metadata type
+func (ms *MetadataService) GetCustomMetadataRecords(ctx context.Context, typeAPIName string) ([]*models.CustomMetadataRecord, error) {
+	t, err := ms.GetCustomMetadataType(ctx, typeAPIName)
+	if err != nil {
+		return nil, err
+	}
+	return ms.repo.GetCustomMetadataRecords(ctx, t.APIName)
+}
+
+// GetCustomMetadataRecord returns a custom metadata record by developer name
+func (ms *MetadataService) GetCustomMetadataRecord(ctx context.Context, typeAPIName, developerName string) (*models.CustomMetadataRecord, error) {
+	rec, err := ms.repo.GetCustomMetadataRecord(ctx, typeAPIName, developerName)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, errors.NewNotFoundError("Custom metadata record", typeAPIName+"."+developerName)
+	}
+	return rec, nil
+}
+
+// CreateCustomMetadataRecord creates a record of a custom metadata type
+func (ms *MetadataService) CreateCustomMetadataRecord(ctx context.Context, typeAPIName string, rec *models.CustomMetadataRecord) error {
+	rec.DeveloperName = strings.TrimSpace(rec.DeveloperName)
+	if !developerNamePattern.MatchString(rec.DeveloperName) {
+		return errors.NewValidationError(constants.FieldSysCustomMetadataRecord_DeveloperName, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(rec.Label) == "" {
+		rec.Label = rec.DeveloperName
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	t, err := ms.repo.GetCustomMetadataType(ctx, typeAPIName)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return errors.NewNotFoundError("Custom metadata type", typeAPIName)
+	}
+	values, err := normalizeCustomMetadataValues(t, rec.Values)
+	if err != nil {
+		return err
+	}
+	existing, err := ms.repo.GetCustomMetadataRecord(ctx, t.APIName, rec.DeveloperName)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("CustomMetadataRecord", constants.FieldSysCustomMetadataRecord_DeveloperName, rec.DeveloperName)
+	}
+
+	if rec.ID == "" {
+		rec.ID = GenerateID()
+	}
+	rec.TypeAPIName = t.APIName
+	rec.Values = values
+	if err := ms.repo.CreateCustomMetadataRecord(ctx, rec); err != nil {
+		return err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return nil
+}
+
+// UpdateCustomMetadataRecord updates the label and values of a custom metadata record. Values
+// are merged into the existing ones; a null value clears a field.
+func (ms *MetadataService) UpdateCustomMetadataRecord(ctx context.Context, typeAPIName, developerName string, updates *models.CustomMetadataRecord) (*models.CustomMetadataRecord, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	t, err := ms.repo.GetCustomMetadataType(ctx, typeAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.NewNotFoundError("Custom metadata type", typeAPIName)
+	}
+	rec, err := ms.repo.GetCustomMetadataRecord(ctx, t.APIName, developerName)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, errors.NewNotFoundError("Custom metadata record", t.APIName+"."+developerName)
+	}
+
+	if strings.TrimSpace(updates.Label) != "" {
+		rec.Label = updates.Label
+	}
+	merged := make(map[string]interface{}, len(rec.Values)+len(updates.Values))
+	for k, v := range rec.Values {
+		merged[k] = v
+	}
+	for k, v := range updates.Values {
+		merged[k] = v
+	}
+	values, err := normalizeCustomMetadataValues(t, merged)
+	if err != nil {
+		return nil, err
+	}
+	rec.Values = values
+
+	if err := ms.repo.UpdateCustomMetadataRecord(ctx, rec); err != nil {
+		return nil, err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return rec, nil
+}
+
+// DeleteCustomMetadataRecord deletes a custom metadata record
+func (ms *MetadataService) DeleteCustomMetadataRecord(ctx context.Context, typeAPIName, developerName string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	rec, err := ms.repo.GetCustomMetadataRecord(ctx, typeAPIName, developerName)
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return errors.NewNotFoundError("Custom metadata record", typeAPIName+"."+developerName)
+	}
+	if err := ms.repo.DeleteCustomMetadataRecord(ctx, rec.ID); err != nil {
+		return err
+	}
+	ms.reloadCustomMetadataLocked(ctx)
+	return nil
+}
+
+// ==================== Custom Metadata Cache ====================
+
+// CustomMetadataEnv returns the cached custom metadata records as
+// {type: {developer_name: {field: value}}}. It never touches the database or the metadata
+// lock, so formulas can read it from anywhere.
+func (ms *MetadataService) CustomMetadataEnv() map[string]interface{} {
+	env, _ := ms.customMetadata.Load().(map[string]interface{})
+	if env == nil {
+		return map[string]interface{}{}
+	}
+	return env
+}
+
+// reloadCustomMetadataLocked rebuilds the custom metadata cache (Caller must hold lock). Custom
+// metadata does not affect schemas, so the rest of the cache is left alone.
+func (ms *MetadataService) reloadCustomMetadataLocked(ctx context.Context) {
+	types, err := ms.repo.GetCustomMetadataTypes(ctx)
+	if err != nil {
+		log.Printf("⚠️ Failed to load custom metadata types: %v", err)
+		return
+	}
+	records, err := ms.repo.GetCustomMetadataRecords(ctx, "")
+	if err != nil {
+		log.Printf("⚠️ Failed to load custom metadata records: %v", err)
+		return
+	}
+	ms.customMetadata.Store(buildCustomMetadataEnv(types, records))
+}
+
+// buildCustomMetadataEnv arranges custom metadata records by type and developer name. Each
+// record exposes the current fields of its type plus its label and developer name.
+func buildCustomMetadataEnv(types []*models.CustomMetadataType, records []*models.CustomMetadataRecord) map[string]interface{} {
+	byType := make(map[string]*models.CustomMetadataType, len(types))
+	env := make(map[string]interface{}, len(types))
+	for _, t := range types {
+		byType[strings.ToLower(t.APIName)] = t
+		env[t.APIName] = map[string]interface{}{}
+	}
+	for _, rec := range records {
+		t := byType[strings.ToLower(rec.TypeAPIName)]
+		if t == nil {
+			continue
+		}
+		values := map[string]interface{}{
+			constants.FieldSysCustomMetadataRecord_Label:         rec.Label,
+			constants.FieldSysCustomMetadataRecord_DeveloperName: rec.DeveloperName,
+		}
+		for _, f := range t.Fields {
+			values[f.APIName] = rec.Values[f.APIName]
+		}
+		env[t.APIName].(map[string]interface{})[rec.DeveloperName] = values
+	}
+	return env
+}
+
+// validateCustomMetadataFields checks the field definitions of a custom metadata type
+func validateCustomMetadataFields(fields []models.CustomMetadataField) error {
+	seen := make(map[string]bool, len(fields))
+	for i := range fields {
+		f := &fields[i]
+		if !developerNamePattern.MatchString(f.APIName) {
+			return errors.NewValidationError(constants.FieldSysCustomMetadataType_Fields, fmt.Sprintf("invalid field name '%s'", f.APIName))
+		}
+		key := strings.ToLower(f.APIName)
+		if seen[key] || key == constants.FieldSysCustomMetadataRecord_Label || key == constants.FieldSysCustomMetadataRecord_DeveloperName {
+			return errors.NewValidationError(constants.FieldSysCustomMetadataType_Fields, fmt.Sprintf("field name '%s' is duplicated or reserved", f.APIName))
+		}
+		seen[key] = true
+		if !containsFieldType(customMetadataFieldTypes, f.Type) {
+			return errors.NewValidationError(constants.FieldSysCustomMetadataType_Fields, fmt.Sprintf("field '%s' has unsupported type '%s'", f.APIName, f.Type))
+		}
+		if strings.TrimSpace(f.Label) == "" {
+			f.Label = f.APIName
+		}
+	}
+	return nil
+}
+
+// normalizeCustomMetadataValues checks record values against the fields of their type and
+// converts them to the field types. Unknown fields and missing required values are rejected.
+func normalizeCustomMetadataValues(t *models.CustomMetadataType, values map[string]interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]models.CustomMetadataField, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.APIName] = f
+	}
+	for k := range values {
+		if _, ok := fields[k]; !ok {
+			return nil, errors.NewValidationError(k, fmt.Sprintf("'%s' is not a field of %s", k, t.APIName))
+		}
+	}
+
+	normalized := make(map[string]interface{}, len(t.Fields))
+	for _, f := range t.Fields {
+		v, ok := values[f.APIName]
+		if !ok || v == nil || v == "" {
+			if f.Required {
+				return nil, errors.NewValidationError(f.APIName, "is required")
+			}
+			continue
+		}
+		converted, err := convertCustomMetadataValue(f.Type, v)
+		if err != nil {
+			return nil, errors.NewValidationError(f.APIName, err.Error())
+		}
+		normalized[f.APIName] = converted
+	}
+	return normalized, nil
+}
+
+func convertCustomMetadataValue(fieldType models.FieldType, v interface{}) (interface{}, error) {
+	switch fieldType {
+	case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent:
+		switch n := v.(type) {
+		case float64:
+			return n, nil
+		case int:
+			return float64(n), nil
+		case int64:
+			return float64(n), nil
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(n), 64); err == nil {
+				return f, nil
+			}
+		}
+		return nil, fmt.Errorf("'%v' is not a number", v)
+	case constants.FieldTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		return nil, fmt.Errorf("'%v' is not a boolean", v)
+	case constants.FieldTypeDate:
+		if s, ok := v.(string); ok {
+			if _, err := time.Parse("2006-01-02", s); err == nil {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("'%v' is not a date (YYYY-MM-DD)", v)
+	default:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("'%v' is not text", v)
+	}
+}
+
+func containsFieldType(types []models.FieldType, t models.FieldType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func taxRateType() *models.CustomMetadataType {
+	return &models.CustomMetadataType{
+		APIName: "TaxRate",
+		Fields: []models.CustomMetadataField{
+			{APIName: "rate", Type: constants.FieldTypePercent, Required: true},
+			{APIName: "region", Type: constants.FieldTypeText},
+			{APIName: "effective", Type: constants.FieldTypeDate},
+			{APIName: "exempt", Type: constants.FieldTypeBoolean},
+		},
+	}
+}
+
+func TestValidateCustomMetadataFields(t *testing.T) {
+	fields := []models.CustomMetadataField{{APIName: "rate", Type: constants.FieldTypeNumber}}
+	assert.NoError(t, validateCustomMetadataFields(fields))
+	assert.Equal(t, "rate", fields[0].Label, "label defaults to API name")
+
+	assert.True(t, errors.IsValidation(validateCustomMetadataFields([]models.CustomMetadataField{
+		{APIName: "rate", Type: constants.FieldTypeNumber}, {APIName: "Rate", Type: constants.FieldTypeText},
+	})), "duplicate")
+	assert.True(t, errors.IsValidation(validateCustomMetadataFields([]models.CustomMetadataField{{APIName: "label", Type: constants.FieldTypeText}})), "reserved")
+	assert.True(t, errors.IsValidation(validateCustomMetadataFields([]models.CustomMetadataField{{APIName: "owner", Type: constants.FieldTypeLookup}})), "unsupported type")
+	assert.True(t, errors.IsValidation(validateCustomMetadataFields([]models.CustomMetadataField{{APIName: "1st", Type: constants.FieldTypeText}})), "bad name")
+}
+
+func TestNormalizeCustomMetadataValues(t *testing.T) {
+	typ := taxRateType()
+
+	values, err := normalizeCustomMetadataValues(typ, map[string]interface{}{"rate": "7.25", "region": "West", "effective": "2026-01-01", "exempt": false})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"rate": 7.25, "region": "West", "effective": "2026-01-01", "exempt": false}, values)
+
+	_, err = normalizeCustomMetadataValues(typ, map[string]interface{}{"region": "West"})
+	assert.True(t, errors.IsValidation(err), "required rate missing")
+	_, err = normalizeCustomMetadataValues(typ, map[string]interface{}{"rate": 1.0, "unknown": 1})
+	assert.True(t, errors.IsValidation(err), "unknown field")
+	_, err = normalizeCustomMetadataValues(typ, map[string]interface{}{"rate": "high"})
+	assert.True(t, errors.IsValidation(err), "not a number")
+	_, err = normalizeCustomMetadataValues(typ, map[string]interface{}{"rate": 1.0, "effective": "01/02/2026"})
+	assert.True(t, errors.IsValidation(err), "bad date")
+}
+
+func TestBuildCustomMetadataEnv(t *testing.T) {
+	typ := taxRateType()
+	records := []*models.CustomMetadataRecord{
+		{TypeAPIName: "taxrate", DeveloperName: "CA", Label: "California", Values: map[string]interface{}{"rate": 7.25, "stale": 1}},
+		{TypeAPIName: "Orphan", DeveloperName: "X", Values: map[string]interface{}{}},
+	}
+
+	env := buildCustomMetadataEnv([]*models.CustomMetadataType{typ, {APIName: "Threshold"}}, records)
+
+	ca := env["TaxRate"].(map[string]interface{})["CA"].(map[string]interface{})
+	assert.Equal(t, 7.25, ca["rate"])
+	assert.Equal(t, "California", ca["label"])
+	assert.Nil(t, ca["region"], "unset fields are present but nil")
+	assert.NotContains(t, ca, "stale", "values of removed fields are dropped")
+	assert.Empty(t, env["Threshold"])
+	assert.NotContains(t, env, "Orphan")
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -18,6 +20,62 @@ func normalizeWidgets(widgets []models.WidgetConfig) []models.WidgetConfig {
 	return widgets
 }
 
+// validateDashboardSettings checks dashboard filters and running user settings
+func validateDashboardSettings(dashboard *models.DashboardConfig) error {
+	ids := make(map[string]bool, len(dashboard.Filters))
+	for i := range dashboard.Filters {
+		filter := &dashboard.Filters[i]
+		if filter.ID == "" {
+			filter.ID = GenerateID()
+		}
+		if ids[filter.ID] {
+			return errors.NewValidationError("filters", fmt.Sprintf("duplicate filter id '%s'", filter.ID))
+		}
+		ids[filter.ID] = true
+		if filter.Field == "" {
+			return errors.NewValidationError("filters", "field is required")
+		}
+		switch filter.Type {
+		case constants.DashboardFilterDateRange, constants.DashboardFilterPicklist:
+		default:
+			return errors.NewValidationError("filters", fmt.Sprintf("type must be %s or %s", constants.DashboardFilterDateRange, constants.DashboardFilterPicklist))
+		}
+	}
+
+	switch dashboard.RunAs {
+	case "", constants.DashboardRunAsViewer:
+		dashboard.RunAs = constants.DashboardRunAsViewer
+		dashboard.RunAsUserID = nil
+	case constants.DashboardRunAsUser:
+		if dashboard.RunAsUserID == nil || *dashboard.RunAsUserID == "" {
+			return errors.NewValidationError("run_as_user_id", "is required when run_as is user")
+		}
+	default:
+		return errors.NewValidationError("run_as", fmt.Sprintf("must be %s or %s", constants.DashboardRunAsViewer, constants.DashboardRunAsUser))
+	}
+	return nil
+}
+
+// checkDashboardRunningUser ensures the user may set the dashboard's running user.
+// Only administrators may make a dashboard run as someone else, since every viewer
+// then sees that user's data.
+func checkDashboardRunningUser(dashboard *models.DashboardConfig, user *models.UserSession) error {
+	if dashboard.RunAs != constants.DashboardRunAsUser {
+		return nil
+	}
+	if user != nil && (user.IsSystemAdmin || constants.IsSuperUser(user.ProfileID) || *dashboard.RunAsUserID == user.ID) {
+		return nil
+	}
+	return errors.NewPermissionError("set running user of", "Dashboard")
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // ==================== Dashboard Methods ====================
 
 // GetDashboards returns all dashboards
@@ -46,13 +104,19 @@ func (ms *MetadataService) GetDashboard(ctx context.Context, id string) *models.
 }
 
 // CreateDashboard creates a new dashboard
-func (ms *MetadataService) CreateDashboard(ctx context.Context, dashboard *models.DashboardConfig) error {
+func (ms *MetadataService) CreateDashboard(ctx context.Context, dashboard *models.DashboardConfig, user *models.UserSession) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
 	if dashboard.Label == "" {
 		return fmt.Errorf("dashboard label is required")
 	}
+	if err := validateDashboardSettings(dashboard); err != nil {
+		return err
+	}
+	if err := checkDashboardRunningUser(dashboard, user); err != nil {
+		return err
+	}
 
 	if dashboard.ID == "" {
 		dashboard.ID = GenerateID()
@@ -60,7 +124,7 @@ func (ms *MetadataService) CreateDashboard(ctx context.Context, dashboard *model
 
 	existing, _ := ms.repo.GetDashboard(ctx, dashboard.ID)
 	if existing != nil {
-		return fmt.Errorf("dashboard with ID '%s' already exists", dashboard.ID)
+		return errors.NewConflictError("Dashboard", constants.FieldID, dashboard.ID)
 	}
 
 	// Normalize widgets: ensure IDs
@@ -80,7 +144,7 @@ func (ms *MetadataService) CreateDashboard(ctx context.Context, dashboard *model
 }
 
 // UpdateDashboard updates an existing dashboard
-func (ms *MetadataService) UpdateDashboard(ctx context.Context, id string, updates *models.DashboardConfig) error {
+func (ms *MetadataService) UpdateDashboard(ctx context.Context, id string, updates *models.DashboardConfig, user *models.UserSession) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
@@ -102,9 +166,27 @@ func (ms *MetadataService) UpdateDashboard(ctx context.Context, id string, updat
 	if updates.Description != nil {
 		existing.Description = updates.Description
 	}
+	if updates.Filters != nil {
+		existing.Filters = updates.Filters
+	}
+	runningUserChanged := false
+	if updates.RunAs != "" {
+		runningUserChanged = updates.RunAs != existing.RunAs || derefString(updates.RunAsUserID) != derefString(existing.RunAsUserID)
+		existing.RunAs = updates.RunAs
+		existing.RunAsUserID = updates.RunAsUserID
+	}
 
 	existing.ID = id
 	existing.Widgets = normalizeWidgets(existing.Widgets)
+	if err := validateDashboardSettings(existing); err != nil {
+		return err
+	}
+	// Dashboards already running as another user stay editable; only changing the running user is restricted
+	if runningUserChanged {
+		if err := checkDashboardRunningUser(existing, user); err != nil {
+			return err
+		}
+	}
 
 	// Update DB via Repo
 	if err := ms.repo.UpdateDashboard(ctx, id, existing); err != nil {
@@ -0,0 +1,481 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	domainSchema "github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// MetadataRestoreWindow is how long an erased object or field can be restored before its
+// data is dropped
+const MetadataRestoreWindow = 15 * 24 * time.Hour
+
+// maxIdentifierLength is MySQL's limit on table and column names
+const maxIdentifierLength = 64
+
+// retiredMarker tags the names of tables and columns kept for erased components
+const retiredMarker = "__del_"
+
+// deletedMetadataSnapshot is the metadata kept for an erased object or field
+type deletedMetadataSnapshot struct {
+	Object      *models.ObjectMetadata `json:"object,omitempty"`       // Erased object, with its fields
+	RecordTypes []*models.RecordType   `json:"record_types,omitempty"` // Record types of an erased object
+	Fields      []models.FieldMetadata `json:"fields,omitempty"`       // Erased field, then its polymorphic type column
+	Columns     map[string]string      `json:"columns,omitempty"`      // Original column -> retired column
+	Archive     string                 `json:"archive,omitempty"`      // Retired archive table of an erased object
+}
+
+// SetDeletedMetadata enables two-phase deletion: objects and fields are erased into the
+// repository and dropped once MetadataRestoreWindow has passed. Without it they are dropped
+// immediately.
+func (ms *MetadataService) SetDeletedMetadata(repo *persistence.DeletedMetadataRepository) {
+	ms.deleted = repo
+}
+
+// GetDeletedMetadata lists the erased objects and fields that can still be restored
+func (ms *MetadataService) GetDeletedMetadata(ctx context.Context) ([]*models.SystemDeletedMetadata, error) {
+	if ms.deleted == nil {
+		return []*models.SystemDeletedMetadata{}, nil
+	}
+	return ms.deleted.List(ctx)
+}
+
+// RestoreDeletedMetadata brings an erased object or field back with its data, under its
+// original name
+func (ms *MetadataService) RestoreDeletedMetadata(ctx context.Context, id string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	entry, snap, err := ms.getDeletedLocked(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if entry.ComponentType == constants.SetupComponentObject {
+		err = ms.restoreObjectLocked(ctx, entry, snap)
+	} else {
+		err = ms.restoreFieldLocked(ctx, entry, snap)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := ms.deleted.Delete(ctx, entry.ID); err != nil {
+		log.Printf("⚠️ Restored %s but failed to clear its deletion record: %v", deletedComponentName(entry), err)
+	}
+	var restored interface{} = snap.Object
+	if entry.ComponentType == constants.SetupComponentField {
+		restored = snap.Fields[0]
+	}
+	ms.setupAudit.Record(ctx, constants.SetupActionRestore, entry.ComponentType, deletedComponentName(entry), entry.ObjectAPIName, nil, restored)
+	ms.invalidateCacheLocked()
+	return nil
+}
+
+// PurgeDeletedMetadata drops an erased object or field and its data without waiting for
+// the grace period to end
+func (ms *MetadataService) PurgeDeletedMetadata(ctx context.Context, id string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	entry, snap, err := ms.getDeletedLocked(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ms.purgeLocked(ctx, entry, snap)
+}
+
+// PurgeExpiredMetadata drops the erased objects and fields whose grace period ended by now.
+// It runs on the scheduler tick.
+func (ms *MetadataService) PurgeExpiredMetadata(ctx context.Context, now time.Time) {
+	if ms.deleted == nil {
+		return
+	}
+	expired, err := ms.deleted.ListExpired(ctx, now)
+	if err != nil {
+		log.Printf("⚠️ Failed to load expired deleted metadata: %v", err)
+		return
+	}
+	for _, entry := range expired {
+		if err := ms.purgeExpired(ctx, entry); err != nil {
+			log.Printf("⚠️ Failed to purge %s: %v", deletedComponentName(entry), err)
+		}
+	}
+}
+
+func (ms *MetadataService) purgeExpired(ctx context.Context, entry *models.SystemDeletedMetadata) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	snap, err := decodeDeletedSnapshot(entry)
+	if err != nil {
+		return err
+	}
+	return ms.purgeLocked(ctx, entry, snap)
+}
+
+func (ms *MetadataService) getDeletedLocked(ctx context.Context, id string) (*models.SystemDeletedMetadata, *deletedMetadataSnapshot, error) {
+	if ms.deleted == nil {
+		return nil, nil, errors.NewNotFoundError("Deleted metadata", id)
+	}
+	entry, err := ms.deleted.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry == nil {
+		return nil, nil, errors.NewNotFoundError("Deleted metadata", id)
+	}
+	snap, err := decodeDeletedSnapshot(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry, snap, nil
+}
+
+// eraseSchemaLocked hides an object and keeps its table under a retired name
+func (ms *MetadataService) eraseSchemaLocked(ctx context.Context, obj *models.ObjectMetadata) error {
+	entry := ms.newDeletedEntry(ctx, constants.SetupComponentObject, obj.APIName)
+	snap := &deletedMetadataSnapshot{Object: obj}
+	if !obj.IsExternal {
+		storage := retiredName(obj.APIName, entry.ID)
+		entry.StorageName = &storage
+
+		archived, err := ms.schemaMgr.TableExists(persistence.ArchiveTableName(obj.APIName))
+		if err != nil {
+			return err
+		}
+		if archived {
+			snap.Archive = retiredName(persistence.ArchiveTableName(obj.APIName), entry.ID)
+		}
+	}
+	recordTypes, err := ms.repo.GetRecordTypes(ctx, obj.APIName)
+	if err != nil {
+		return fmt.Errorf("failed to load record types: %w", err)
+	}
+	snap.RecordTypes = recordTypes
+
+	if err := ms.insertDeletedEntry(ctx, entry, snap); err != nil {
+		return err
+	}
+	if entry.StorageName != nil {
+		if err := ms.schemaMgr.RenameTable(obj.APIName, *entry.StorageName); err != nil {
+			ms.discardDeletedEntry(ctx, entry)
+			return err
+		}
+	}
+	rollback := func(err error) error {
+		if snap.Archive != "" {
+			if renameErr := ms.schemaMgr.RenameTable(snap.Archive, persistence.ArchiveTableName(obj.APIName)); renameErr != nil {
+				log.Printf("⚠️ Failed to rename table %s back to %s: %v", snap.Archive, persistence.ArchiveTableName(obj.APIName), renameErr)
+				return err
+			}
+		}
+		if entry.StorageName != nil {
+			if renameErr := ms.schemaMgr.RenameTable(*entry.StorageName, obj.APIName); renameErr != nil {
+				log.Printf("⚠️ Failed to rename table %s back to %s: %v", *entry.StorageName, obj.APIName, renameErr)
+				return err
+			}
+		}
+		ms.discardDeletedEntry(ctx, entry)
+		return err
+	}
+	if snap.Archive != "" {
+		if err := ms.schemaMgr.RenameTable(persistence.ArchiveTableName(obj.APIName), snap.Archive); err != nil {
+			snap.Archive = ""
+			return rollback(err)
+		}
+	}
+	if err := ms.schemaMgr.UnregisterObject(obj.APIName); err != nil {
+		return rollback(err)
+	}
+	return nil
+}
+
+// eraseFieldLocked hides a field and keeps its column (and the type column of a polymorphic
+// lookup) under a retired name
+func (ms *MetadataService) eraseFieldLocked(ctx context.Context, obj *models.ObjectMetadata, field *models.FieldMetadata) error {
+	entry := ms.newDeletedEntry(ctx, constants.SetupComponentField, obj.APIName)
+	entry.FieldAPIName = &field.APIName
+
+	snap := &deletedMetadataSnapshot{Fields: []models.FieldMetadata{*field}}
+	if field.IsPolymorphic {
+		if typeField := FindField(obj, GetPolymorphicTypeColumnName(field.APIName)); typeField != nil {
+			snap.Fields = append(snap.Fields, *typeField)
+		}
+	}
+	if field.Type == constants.FieldTypeGeolocation {
+		latCol, lngCol := GetGeolocationColumnNames(field.APIName)
+		for _, col := range []string{latCol, lngCol} {
+			if coordField := FindField(obj, col); coordField != nil {
+				snap.Fields = append(snap.Fields, *coordField)
+			}
+		}
+	}
+	if !obj.IsExternal {
+		snap.Columns = make(map[string]string, len(snap.Fields))
+		for _, f := range snap.Fields {
+			snap.Columns[f.APIName] = retiredName(f.APIName, entry.ID)
+		}
+		storage := snap.Columns[field.APIName]
+		entry.StorageName = &storage
+	}
+
+	if err := ms.insertDeletedEntry(ctx, entry, snap); err != nil {
+		return err
+	}
+	renamed := make([]string, 0, len(snap.Columns))
+	rollback := func() {
+		for _, column := range renamed {
+			if err := ms.schemaMgr.RenameColumn(obj.APIName, snap.Columns[column], column); err != nil {
+				log.Printf("⚠️ Failed to rename column %s.%s back to %s: %v", obj.APIName, snap.Columns[column], column, err)
+				return
+			}
+		}
+		ms.discardDeletedEntry(ctx, entry)
+	}
+	for _, f := range snap.Fields {
+		if retired, ok := snap.Columns[f.APIName]; ok {
+			if err := ms.schemaMgr.RenameColumn(obj.APIName, f.APIName, retired); err != nil {
+				rollback()
+				return err
+			}
+			renamed = append(renamed, f.APIName)
+		}
+	}
+	for _, f := range snap.Fields {
+		if err := ms.schemaMgr.UnregisterField(f.ID); err != nil {
+			rollback()
+			return err
+		}
+	}
+	return nil
+}
+
+func (ms *MetadataService) restoreObjectLocked(ctx context.Context, entry *models.SystemDeletedMetadata, snap *deletedMetadataSnapshot) error {
+	obj := snap.Object
+	if obj == nil {
+		return fmt.Errorf("deleted metadata %s has no object snapshot", entry.ID)
+	}
+	if existing, err := ms.repo.GetSchemaByAPIName(ctx, obj.APIName); err == nil && existing != nil {
+		return errors.NewConflictError("Object Metadata", "api_name", obj.APIName)
+	}
+
+	if entry.StorageName != nil {
+		if err := ms.schemaMgr.RenameTable(*entry.StorageName, obj.APIName); err != nil {
+			return err
+		}
+	}
+	if snap.Archive != "" {
+		if err := ms.schemaMgr.RenameTable(snap.Archive, persistence.ArchiveTableName(obj.APIName)); err != nil {
+			if entry.StorageName != nil {
+				if renameErr := ms.schemaMgr.RenameTable(obj.APIName, *entry.StorageName); renameErr != nil {
+					log.Printf("⚠️ Failed to rename table %s back to %s: %v", obj.APIName, *entry.StorageName, renameErr)
+				}
+			}
+			return err
+		}
+	}
+	register := func() error {
+		if !obj.IsExternal {
+			description := obj.Label
+			if obj.Description != nil && *obj.Description != "" {
+				description = *obj.Description
+			}
+			def := domainSchema.TableDefinition{
+				TableName:   obj.APIName,
+				TableType:   string(constants.TableTypeCustomObject),
+				Category:    "standard",
+				Description: description,
+			}
+			if err := ms.schemaMgr.RegisterTable(def); err != nil {
+				return fmt.Errorf("failed to register table %s: %w", obj.APIName, err)
+			}
+		}
+		if err := ms.schemaMgr.InsertObjectMetadata(obj, nil); err != nil {
+			return fmt.Errorf("failed to register object %s: %w", obj.APIName, err)
+		}
+		for i := range obj.Fields {
+			f := &obj.Fields[i]
+			if err := ms.schemaMgr.SaveFieldMetadataWithIDs(f, obj.ID, f.ID, nil); err != nil {
+				return fmt.Errorf("failed to register field %s.%s: %w", obj.APIName, f.APIName, err)
+			}
+			// The index itself was renamed along with the table
+			if f.IsIndexed {
+				if err := ms.schemaMgr.SetFieldIndexed(obj.ID, f.APIName, true); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := register(); err != nil {
+		// COMPENSATION: put the object back in the recycle state it was restored from
+		if unregErr := ms.schemaMgr.UnregisterObject(obj.APIName); unregErr != nil {
+			log.Printf("⚠️ Failed to unregister partially restored object %s: %v", obj.APIName, unregErr)
+		}
+		if entry.StorageName != nil {
+			if renameErr := ms.schemaMgr.RenameTable(obj.APIName, *entry.StorageName); renameErr != nil {
+				log.Printf("⚠️ Failed to rename table %s back to %s: %v", obj.APIName, *entry.StorageName, renameErr)
+			}
+		}
+		if snap.Archive != "" {
+			if renameErr := ms.schemaMgr.RenameTable(persistence.ArchiveTableName(obj.APIName), snap.Archive); renameErr != nil {
+				log.Printf("⚠️ Failed to rename table %s back to %s: %v", persistence.ArchiveTableName(obj.APIName), snap.Archive, renameErr)
+			}
+		}
+		return err
+	}
+
+	for _, rt := range snap.RecordTypes {
+		if err := ms.repo.CreateRecordType(ctx, rt); err != nil {
+			log.Printf("⚠️ Failed to restore record type %s of %s: %v", rt.Name, obj.APIName, err)
+		}
+	}
+	return nil
+}
+
+func (ms *MetadataService) restoreFieldLocked(ctx context.Context, entry *models.SystemDeletedMetadata, snap *deletedMetadataSnapshot) error {
+	if len(snap.Fields) == 0 {
+		return fmt.Errorf("deleted metadata %s has no field snapshot", entry.ID)
+	}
+	obj, err := ms.repo.GetSchemaByAPIName(ctx, entry.ObjectAPIName)
+	if err != nil || obj == nil {
+		return errors.NewNotFoundError("Object", entry.ObjectAPIName)
+	}
+	for _, f := range snap.Fields {
+		if FindField(obj, f.APIName) != nil {
+			return errors.NewConflictError("Field", "api_name", entry.ObjectAPIName+"."+f.APIName)
+		}
+	}
+
+	for _, f := range snap.Fields {
+		if retired, ok := snap.Columns[f.APIName]; ok {
+			if err := ms.schemaMgr.RenameColumn(obj.APIName, retired, f.APIName); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range snap.Fields {
+		f := &snap.Fields[i]
+		if err := ms.schemaMgr.SaveFieldMetadataWithIDs(f, obj.ID, f.ID, nil); err != nil {
+			return fmt.Errorf("failed to register field %s.%s: %w", obj.APIName, f.APIName, err)
+		}
+		// The index followed the column through both renames
+		if f.IsIndexed {
+			if err := ms.schemaMgr.SetFieldIndexed(obj.ID, f.APIName, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// purgeLocked drops an erased component's data. Settings kept for a restore (permissions,
+// auto-number sequences, external object settings) go too, unless a component of the same
+// name has been created since.
+func (ms *MetadataService) purgeLocked(ctx context.Context, entry *models.SystemDeletedMetadata, snap *deletedMetadataSnapshot) error {
+	current, err := ms.repo.GetSchemaByAPIName(ctx, entry.ObjectAPIName)
+	if err != nil {
+		current = nil
+	}
+
+	if entry.ComponentType == constants.SetupComponentObject {
+		if entry.StorageName != nil {
+			if err := ms.schemaMgr.DropTable(*entry.StorageName); err != nil {
+				return err
+			}
+		}
+		if snap.Archive != "" {
+			if err := ms.schemaMgr.DropTable(snap.Archive); err != nil {
+				return err
+			}
+		}
+		if current == nil {
+			ms.schemaMgr.DeleteObjectSettings(entry.ObjectAPIName)
+		}
+	} else {
+		for _, retired := range snap.Columns {
+			if err := ms.schemaMgr.DropColumn(entry.ObjectAPIName, retired); err != nil {
+				return err
+			}
+		}
+		if entry.FieldAPIName != nil && (current == nil || FindField(current, *entry.FieldAPIName) == nil) {
+			ms.schemaMgr.DeleteFieldSettings(entry.ObjectAPIName, *entry.FieldAPIName)
+		}
+	}
+
+	if err := ms.deleted.Delete(ctx, entry.ID); err != nil {
+		return err
+	}
+	ms.setupAudit.Record(ctx, constants.SetupActionPurge, entry.ComponentType, deletedComponentName(entry), entry.ObjectAPIName, entry.Metadata, nil)
+	return nil
+}
+
+func (ms *MetadataService) newDeletedEntry(ctx context.Context, componentType, objectAPIName string) *models.SystemDeletedMetadata {
+	now := time.Now().UTC()
+	entry := &models.SystemDeletedMetadata{
+		ID:            GenerateID(),
+		ComponentType: componentType,
+		ObjectAPIName: objectAPIName,
+		DeletedDate:   now,
+		PurgeAfter:    now.Add(MetadataRestoreWindow),
+	}
+	if actor, ok := ctx.Value(setupActorKey{}).(setupActor); ok {
+		entry.DeletedByID = optionalString(actor.ID)
+	}
+	return entry
+}
+
+func (ms *MetadataService) insertDeletedEntry(ctx context.Context, entry *models.SystemDeletedMetadata, snap *deletedMetadataSnapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("failed to serialize deleted metadata: %w", err)
+	}
+	entry.Metadata = data
+	return ms.deleted.Insert(ctx, entry)
+}
+
+func (ms *MetadataService) discardDeletedEntry(ctx context.Context, entry *models.SystemDeletedMetadata) {
+	if err := ms.deleted.Delete(ctx, entry.ID); err != nil {
+		log.Printf("⚠️ Failed to discard deletion record of %s: %v", deletedComponentName(entry), err)
+	}
+}
+
+func decodeDeletedSnapshot(entry *models.SystemDeletedMetadata) (*deletedMetadataSnapshot, error) {
+	var snap deletedMetadataSnapshot
+	if err := json.Unmarshal(entry.Metadata, &snap); err != nil {
+		return nil, fmt.Errorf("failed to parse deleted metadata %s: %w", entry.ID, err)
+	}
+	return &snap, nil
+}
+
+// deletedComponentName names an erased component the way the setup audit trail does
+func deletedComponentName(entry *models.SystemDeletedMetadata) string {
+	if entry.FieldAPIName != nil {
+		return entry.ObjectAPIName + "." + *entry.FieldAPIName
+	}
+	return entry.ObjectAPIName
+}
+
+// retiredName is the table or column name that keeps an erased component's data: the
+// original name tagged with the deletion, cut to fit the identifier length limit
+func retiredName(name, deletionID string) string {
+	tag := strings.ReplaceAll(deletionID, "-", "")
+	if len(tag) > 8 {
+		tag = tag[:8]
+	}
+	suffix := retiredMarker + strings.ToLower(tag)
+	if len(name)+len(suffix) > maxIdentifierLength {
+		name = name[:maxIdentifierLength-len(suffix)]
+	}
+	return name + suffix
+}
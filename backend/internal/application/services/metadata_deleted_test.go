@@ -0,0 +1,57 @@
+package services
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetiredName(t *testing.T) {
+	id := "3F2504E0-4F89-11D3-9A0C-0305E82C3301"
+
+	assert.Equal(t, "region__del_3f2504e0", retiredName("region", id))
+
+	long := strings.Repeat("a", 70)
+	retired := retiredName(long, id)
+	assert.Len(t, retired, maxIdentifierLength)
+	assert.True(t, strings.HasSuffix(retired, "__del_3f2504e0"))
+
+	// Two deletions of the same field never collide
+	assert.NotEqual(t, retiredName("region", id), retiredName("region", "9b2c1d00-0000-0000-0000-000000000000"))
+}
+
+func TestDeletedSnapshotRoundTrip(t *testing.T) {
+	ms := &MetadataService{}
+	entry := ms.newDeletedEntry(WithSetupActor(t.Context(), "u1", "Admin"), constants.SetupComponentField, "deal")
+	assert.Equal(t, MetadataRestoreWindow, entry.PurgeAfter.Sub(entry.DeletedDate))
+	require.NotNil(t, entry.DeletedByID)
+	assert.Equal(t, "u1", *entry.DeletedByID)
+
+	fieldName := "related_to"
+	entry.FieldAPIName = &fieldName
+	snap := &deletedMetadataSnapshot{
+		Fields: []models.FieldMetadata{
+			{ID: "f1", APIName: "related_to", Type: constants.FieldTypeLookup, IsPolymorphic: true},
+			{ID: "f2", APIName: "related_to_type", Type: constants.FieldTypeText},
+		},
+		Columns: map[string]string{
+			"related_to":      retiredName("related_to", entry.ID),
+			"related_to_type": retiredName("related_to_type", entry.ID),
+		},
+	}
+	data, err := json.Marshal(snap)
+	require.NoError(t, err)
+	entry.Metadata = data
+
+	decoded, err := decodeDeletedSnapshot(entry)
+	require.NoError(t, err)
+	assert.Equal(t, snap.Columns, decoded.Columns)
+	require.Len(t, decoded.Fields, 2)
+	assert.Equal(t, "f1", decoded.Fields[0].ID)
+	assert.Equal(t, "deal.related_to", deletedComponentName(entry))
+}
@@ -0,0 +1,400 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Dependency (Where-Used) Analysis ====================
+
+// GetDependencies reports what references an object, or one of its fields when
+// fieldAPIName is set
+func (ms *MetadataService) GetDependencies(ctx context.Context, objectAPIName, fieldAPIName string) (*models.DependencyReport, error) {
+	return ms.analyzeDependencies(ctx, objectAPIName, fieldAPIName)
+}
+
+// checkDeletable returns a DependencyError when blocking dependencies reference the
+// object or field. Reads go to the repository, so it is safe while ms.mu is held.
+func (ms *MetadataService) checkDeletable(ctx context.Context, objectAPIName, fieldAPIName string) error {
+	report, err := ms.analyzeDependencies(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return err
+	}
+	if report.CanDelete {
+		return nil
+	}
+	resource := report.ObjectAPIName
+	if report.FieldAPIName != "" {
+		resource += "." + report.FieldAPIName
+	}
+	var dependents []string
+	for _, d := range report.Dependencies {
+		if d.Blocking {
+			dependents = append(dependents, fmt.Sprintf("%s %s", d.ComponentType, d.ComponentName))
+		}
+	}
+	return errors.NewDependencyError(resource, dependents)
+}
+
+func (ms *MetadataService) analyzeDependencies(ctx context.Context, objectAPIName, fieldAPIName string) (*models.DependencyReport, error) {
+	schemas, err := ms.repo.GetAllSchemas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schemas: %w", err)
+	}
+	a := newDependencyAnalyzer(schemas)
+	obj := a.schemas[strings.ToLower(objectAPIName)]
+	if obj == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	a.object = obj.APIName
+	report := &models.DependencyReport{Type: constants.DependencyTargetObject, ObjectAPIName: obj.APIName}
+	if fieldAPIName != "" {
+		field := FindField(obj, fieldAPIName)
+		if field == nil {
+			return nil, errors.NewNotFoundError("Field", obj.APIName+"."+fieldAPIName)
+		}
+		a.field = field.APIName
+		report.Type = constants.DependencyTargetField
+		report.FieldAPIName = field.APIName
+	}
+
+	a.checkFields(schemas)
+
+	flows, err := ms.repo.GetAllFlows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load flows: %w", err)
+	}
+	a.checkFlows(flows)
+
+	// Validation rules, layouts and list views of a deleted object go with it
+	if a.field != "" {
+		rules, err := ms.repo.GetValidationRules(ctx, obj.APIName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load validation rules: %w", err)
+		}
+		a.checkValidationRules(rules)
+
+		views, err := ms.repo.GetListViews(ctx, obj.APIName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load list views: %w", err)
+		}
+		a.checkListViews(views)
+	}
+
+	// Related lists of the object appear on the layouts of the objects it looks up
+	layoutObjects := lookupTargets(obj)
+	if a.field != "" {
+		layoutObjects = append([]string{obj.APIName}, layoutObjects...)
+	}
+	for _, name := range layoutObjects {
+		layouts, err := ms.repo.GetLayouts(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load layouts of %s: %w", name, err)
+		}
+		a.checkLayouts(layouts)
+	}
+
+	dashboards, err := ms.repo.GetAllDashboards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dashboards: %w", err)
+	}
+	a.checkDashboards(dashboards)
+
+	report.Dependencies = a.dependencies
+	report.CanDelete = true
+	for _, d := range a.dependencies {
+		if d.Blocking {
+			report.CanDelete = false
+			break
+		}
+	}
+	return report, nil
+}
+
+// lookupTargets returns the distinct objects referenced by an object's lookup fields
+func lookupTargets(obj *models.ObjectMetadata) []string {
+	seen := map[string]bool{strings.ToLower(obj.APIName): true}
+	var targets []string
+	for _, f := range obj.Fields {
+		for _, ref := range f.ReferenceTo {
+			if key := strings.ToLower(ref); !seen[key] {
+				seen[key] = true
+				targets = append(targets, ref)
+			}
+		}
+	}
+	return targets
+}
+
+// dependencyAnalyzer collects the components referencing an object, or a field of it
+// when field is set
+type dependencyAnalyzer struct {
+	schemas      map[string]*models.ObjectMetadata // key: lowercase API name
+	object       string
+	field        string
+	dependencies []models.MetadataDependency
+}
+
+func newDependencyAnalyzer(schemas []*models.ObjectMetadata) *dependencyAnalyzer {
+	a := &dependencyAnalyzer{schemas: make(map[string]*models.ObjectMetadata, len(schemas))}
+	for _, s := range schemas {
+		a.schemas[strings.ToLower(s.APIName)] = s
+	}
+	return a
+}
+
+// add records a dependency when the component uses the target at all
+func (a *dependencyAnalyzer) add(componentType, id, name, objectAPIName string, blocking bool, usages []string) {
+	if len(usages) == 0 {
+		return
+	}
+	a.dependencies = append(a.dependencies, models.MetadataDependency{
+		ComponentType: componentType,
+		ComponentID:   id,
+		ComponentName: name,
+		ObjectAPIName: objectAPIName,
+		Usage:         strings.Join(usages, ", "),
+		Blocking:      blocking,
+	})
+}
+
+func (a *dependencyAnalyzer) isObject(name string) bool {
+	return strings.EqualFold(name, a.object)
+}
+
+func (a *dependencyAnalyzer) isField(objectAPIName, fieldAPIName string) bool {
+	return a.field != "" && a.isObject(objectAPIName) && strings.EqualFold(fieldAPIName, a.field)
+}
+
+// expressionUses reports whether a formula evaluated against baseObject reads the target
+// field, directly or across lookups (e.g. account_id.industry)
+func (a *dependencyAnalyzer) expressionUses(baseObject, expr string) bool {
+	if a.field == "" || strings.TrimSpace(expr) == "" {
+		return false
+	}
+	refs, err := formula.References(expr)
+	if err != nil {
+		return false
+	}
+	for _, ref := range refs {
+		segments := strings.Split(ref, ".")
+		if len(segments) > 1 && (segments[0] == "record" || segments[0] == "prior") {
+			segments = segments[1:]
+		}
+		schema := a.schemas[strings.ToLower(baseObject)]
+		for _, segment := range segments {
+			if schema == nil {
+				break
+			}
+			f := FindField(schema, segment)
+			if f == nil {
+				break
+			}
+			if a.isField(schema.APIName, f.APIName) {
+				return true
+			}
+			if len(f.ReferenceTo) == 0 {
+				break
+			}
+			schema = a.schemas[strings.ToLower(f.ReferenceTo[0])]
+		}
+	}
+	return false
+}
+
+// checkFields finds lookups, formulas, rollups and dependent picklists using the target
+func (a *dependencyAnalyzer) checkFields(schemas []*models.ObjectMetadata) {
+	for _, s := range schemas {
+		for i := range s.Fields {
+			f := &s.Fields[i]
+			if a.isField(s.APIName, f.APIName) || (a.field == "" && a.isObject(s.APIName)) {
+				continue
+			}
+			name := s.APIName + "." + f.APIName
+
+			if a.field == "" {
+				for _, ref := range f.ReferenceTo {
+					if a.isObject(ref) {
+						a.add(constants.DependencyLookupField, f.ID, name, s.APIName, true, []string{"lookup to " + a.object})
+						break
+					}
+				}
+			}
+			if f.Formula != nil && a.expressionUses(s.APIName, *f.Formula) {
+				a.add(constants.DependencyFormulaField, f.ID, name, s.APIName, true, []string{"formula"})
+			}
+			if r := f.RollupConfig; r != nil && a.isObject(r.SummaryObject) {
+				var usages []string
+				switch {
+				case a.field == "":
+					usages = append(usages, "rollup summary of "+a.object)
+				case strings.EqualFold(r.SummaryField, a.field):
+					usages = append(usages, "summarized field")
+				case strings.EqualFold(r.RelationshipField, a.field):
+					usages = append(usages, "relationship field")
+				case r.Filter != nil && a.expressionUses(r.SummaryObject, *r.Filter):
+					usages = append(usages, "rollup filter")
+				}
+				a.add(constants.DependencyRollupField, f.ID, name, s.APIName, true, usages)
+			}
+			if f.ControllingField != nil && a.isField(s.APIName, *f.ControllingField) {
+				a.add(constants.DependencyDependentField, f.ID, name, s.APIName, true, []string{"controlling field"})
+			}
+		}
+	}
+}
+
+// checkFlows finds flows triggered by, or writing to, the target
+func (a *dependencyAnalyzer) checkFlows(flows []*models.Flow) {
+	for _, flow := range flows {
+		var usages []string
+		if a.field == "" {
+			if a.isObject(flow.TriggerObject) {
+				usages = append(usages, "trigger object")
+			}
+			if target, _ := flow.ActionConfig[constants.ConfigTargetObject].(string); a.isObject(target) {
+				usages = append(usages, "action target object")
+			}
+		} else {
+			if a.expressionUses(flow.TriggerObject, flow.TriggerCondition) {
+				usages = append(usages, "trigger condition")
+			}
+			if a.actionUses(flow.TriggerObject, flow.ActionConfig) {
+				usages = append(usages, "action field mapping")
+			}
+			for _, step := range flow.Steps {
+				if (step.EntryCondition != nil && a.expressionUses(flow.TriggerObject, *step.EntryCondition)) ||
+					a.actionUses(flow.TriggerObject, step.ActionConfig) {
+					usages = append(usages, "step "+step.StepName)
+				}
+			}
+		}
+		a.add(constants.DependencyFlow, flow.ID, flow.Name, flow.TriggerObject, true, usages)
+	}
+}
+
+// actionUses reports whether an action's field mappings write the target field
+func (a *dependencyAnalyzer) actionUses(triggerObject string, config map[string]interface{}) bool {
+	target, _ := config[constants.ConfigTargetObject].(string)
+	if target == "" {
+		target = triggerObject
+	}
+	mappings, _ := config[constants.ConfigFieldMappings].(map[string]interface{})
+	for field := range mappings {
+		if a.isField(target, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkValidationRules finds validation rules whose condition reads the target field
+func (a *dependencyAnalyzer) checkValidationRules(rules []*models.ValidationRule) {
+	for _, rule := range rules {
+		if a.expressionUses(rule.ObjectAPIName, rule.Condition) {
+			a.add(constants.DependencyValidationRule, rule.ID, rule.Name, rule.ObjectAPIName, true, []string{"error condition"})
+		}
+	}
+}
+
+// checkLayouts finds layout sections and related lists showing the target
+func (a *dependencyAnalyzer) checkLayouts(layouts []*models.PageLayout) {
+	for _, layout := range layouts {
+		var usages []string
+		if a.isObject(layout.ObjectAPIName) {
+			if containsFold(layout.CompactLayout, a.field) {
+				usages = append(usages, "compact layout")
+			}
+			for _, section := range layout.Sections {
+				if containsFold(section.Fields, a.field) ||
+					(section.VisibilityCondition != nil && a.expressionUses(layout.ObjectAPIName, *section.VisibilityCondition)) {
+					usages = append(usages, "section "+section.Label)
+				}
+			}
+		}
+		for _, rl := range layout.RelatedLists {
+			if !a.isObject(rl.ObjectAPIName) {
+				continue
+			}
+			if a.field == "" || strings.EqualFold(rl.LookupField, a.field) || containsFold(rl.Fields, a.field) {
+				usages = append(usages, "related list "+rl.Label)
+			}
+		}
+		a.add(constants.DependencyLayout, layout.ID, layout.LayoutName, layout.ObjectAPIName, false, usages)
+	}
+}
+
+// checkListViews finds list views showing, filtering, sorting or summarizing the target field
+func (a *dependencyAnalyzer) checkListViews(views []*models.ListView) {
+	for _, view := range views {
+		var usages []string
+		columns := make([]string, 0, len(view.Columns))
+		for _, col := range view.Columns {
+			columns = append(columns, col.Field)
+		}
+		if containsFold(view.Fields, a.field) || containsFold(columns, a.field) {
+			usages = append(usages, "column")
+		}
+		if a.expressionUses(view.ObjectAPIName, view.FilterExpr) {
+			usages = append(usages, "filter")
+		}
+		if strings.EqualFold(view.SortField, a.field) {
+			usages = append(usages, "sort")
+		}
+		for _, agg := range view.Aggregates {
+			if strings.EqualFold(agg.Field, a.field) {
+				usages = append(usages, "aggregate")
+				break
+			}
+		}
+		a.add(constants.DependencyListView, view.ID, view.Label, view.ObjectAPIName, false, usages)
+	}
+}
+
+// checkDashboards finds dashboard widgets and filters over the target
+func (a *dependencyAnalyzer) checkDashboards(dashboards []*models.DashboardConfig) {
+	for _, d := range dashboards {
+		var usages []string
+		onObject := false
+		for _, w := range d.Widgets {
+			q := w.Query
+			if !a.isObject(q.ObjectAPIName) {
+				continue
+			}
+			onObject = true
+			if a.field == "" ||
+				(q.Field != nil && strings.EqualFold(*q.Field, a.field)) ||
+				(q.GroupBy != nil && strings.EqualFold(*q.GroupBy, a.field)) ||
+				a.expressionUses(q.ObjectAPIName, q.FilterExpr) {
+				usages = append(usages, "widget "+w.Title)
+			}
+		}
+		if onObject && a.field != "" {
+			for _, f := range d.Filters {
+				if strings.EqualFold(f.Field, a.field) {
+					usages = append(usages, "filter "+f.Label)
+				}
+			}
+		}
+		a.add(constants.DependencyDashboard, d.ID, d.Label, "", false, usages)
+	}
+}
+
+// containsFold reports whether a list contains a non-empty name, ignoring case
+func containsFold(list []string, name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, item := range list {
+		if strings.EqualFold(item, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func dependencyTestSchemas() []*models.ObjectMetadata {
+	margin := "amount - cost"
+	region := "account_id.region"
+	return []*models.ObjectMetadata{
+		{APIName: "account", Fields: []models.FieldMetadata{
+			{ID: "f1", APIName: "region", Type: constants.FieldTypePicklist},
+			{ID: "f2", APIName: "total_amount", Type: constants.FieldTypeRollupSummary, RollupConfig: &models.RollupConfig{
+				SummaryObject: "deal", SummaryField: "amount", RelationshipField: "account_id", CalcType: "SUM",
+			}},
+		}},
+		{APIName: "deal", Fields: []models.FieldMetadata{
+			{ID: "f3", APIName: "amount", Type: constants.FieldTypeCurrency},
+			{ID: "f4", APIName: "cost", Type: constants.FieldTypeCurrency},
+			{ID: "f5", APIName: "margin", Type: constants.FieldTypeFormula, Formula: &margin},
+			{ID: "f6", APIName: "account_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"account"}},
+			{ID: "f7", APIName: "account_region", Type: constants.FieldTypeFormula, Formula: &region},
+		}},
+	}
+}
+
+func dependencyTypes(deps []models.MetadataDependency) map[string]string {
+	types := make(map[string]string, len(deps))
+	for _, d := range deps {
+		types[d.ComponentName] = d.ComponentType
+	}
+	return types
+}
+
+func TestDependencyAnalyzer_Field(t *testing.T) {
+	schemas := dependencyTestSchemas()
+	a := newDependencyAnalyzer(schemas)
+	a.object, a.field = "deal", "amount"
+
+	a.checkFields(schemas)
+	a.checkValidationRules([]*models.ValidationRule{
+		{ID: "v1", ObjectAPIName: "deal", Name: "Positive", Condition: "amount < 0"},
+		{ID: "v2", ObjectAPIName: "deal", Name: "Cost", Condition: "cost < 0"},
+	})
+	a.checkLayouts([]*models.PageLayout{
+		{ID: "l1", ObjectAPIName: "deal", LayoutName: "Deal", Sections: []models.PageSection{{Label: "Details", Fields: []string{"amount"}}}},
+		{ID: "l2", ObjectAPIName: "account", LayoutName: "Account", RelatedLists: []models.RelatedListConfig{
+			{Label: "Deals", ObjectAPIName: "deal", LookupField: "account_id", Fields: []string{"Amount"}},
+		}},
+	})
+	a.checkListViews([]*models.ListView{{ID: "lv1", ObjectAPIName: "deal", Label: "Big", FilterExpr: "amount > 1000"}})
+
+	assert.Equal(t, map[string]string{
+		"deal.margin":          constants.DependencyFormulaField,
+		"account.total_amount": constants.DependencyRollupField,
+		"Positive":             constants.DependencyValidationRule,
+		"Deal":                 constants.DependencyLayout,
+		"Account":              constants.DependencyLayout,
+		"Big":                  constants.DependencyListView,
+	}, dependencyTypes(a.dependencies))
+	for _, d := range a.dependencies {
+		blocking := d.ComponentType != constants.DependencyLayout && d.ComponentType != constants.DependencyListView
+		assert.Equal(t, blocking, d.Blocking, d.ComponentName)
+	}
+}
+
+func TestDependencyAnalyzer_CrossObjectFormula(t *testing.T) {
+	schemas := dependencyTestSchemas()
+	a := newDependencyAnalyzer(schemas)
+	a.object, a.field = "account", "region"
+
+	a.checkFields(schemas)
+	a.checkFlows([]*models.Flow{
+		{ID: "fl1", Name: "Route", TriggerObject: "deal", TriggerCondition: `account_id.region == "EMEA"`},
+		{ID: "fl2", Name: "Stamp", TriggerObject: "deal", ActionConfig: map[string]interface{}{
+			constants.ConfigTargetObject:  "account",
+			constants.ConfigFieldMappings: map[string]interface{}{"region": "APAC"},
+		}},
+	})
+
+	assert.Equal(t, map[string]string{
+		"deal.account_region": constants.DependencyFormulaField,
+		"Route":               constants.DependencyFlow,
+		"Stamp":               constants.DependencyFlow,
+	}, dependencyTypes(a.dependencies))
+}
+
+func TestDependencyAnalyzer_Object(t *testing.T) {
+	schemas := dependencyTestSchemas()
+	a := newDependencyAnalyzer(schemas)
+	a.object = "account"
+
+	a.checkFields(schemas)
+	a.checkDashboards([]*models.DashboardConfig{
+		{ID: "d1", Label: "Sales", Widgets: []models.WidgetConfig{{Title: "Accounts", Query: models.AnalyticsQuery{ObjectAPIName: "account"}}}},
+		{ID: "d2", Label: "Pipeline", Widgets: []models.WidgetConfig{{Title: "Deals", Query: models.AnalyticsQuery{ObjectAPIName: "deal"}}}},
+	})
+
+	assert.Equal(t, map[string]string{
+		"deal.account_id": constants.DependencyLookupField,
+		"Sales":           constants.DependencyDashboard,
+	}, dependencyTypes(a.dependencies))
+}
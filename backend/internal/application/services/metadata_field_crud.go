@@ -55,6 +55,25 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 		field.IsPolymorphic = true
 	}
 
+	// Picklists bound to a global value set take their options from it
+	var valueSet *models.GlobalValueSet
+	if field.ValueSet != nil && *field.ValueSet != "" {
+		if field.Type != constants.FieldTypePicklist && field.Type != constants.FieldTypeMultiPicklist {
+			return errors.NewValidationError(constants.FieldSysField_ValueSet, "only picklist fields can use a global value set")
+		}
+		vs, err := ms.repo.GetGlobalValueSet(ctx, *field.ValueSet)
+		if err != nil {
+			return err
+		}
+		if vs == nil {
+			return errors.NewNotFoundError("Global value set", *field.ValueSet)
+		}
+		valueSet = vs
+		field.ValueSet = &vs.Name
+		field.Options = vs.Options
+		field.InactiveOptions = vs.InactiveOptions
+	}
+
 	// Validate Picklist fields require options
 	if field.Type == constants.FieldTypePicklist && len(field.Options) == 0 {
 		return errors.NewValidationError("options", "Picklist fields require at least one option")
@@ -64,6 +83,22 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 	if field.Type == constants.FieldTypeLookup && len(field.ReferenceTo) == 0 {
 		return errors.NewValidationError("reference_to", "Lookup fields require a referenced object")
 	}
+	if field.LookupFilter != nil && strings.TrimSpace(*field.LookupFilter) == "" {
+		field.LookupFilter = nil
+	}
+	if err := validateLookupFilter(field); err != nil {
+		return err
+	}
+	if err := validateTrackHistory(field); err != nil {
+		return err
+	}
+
+	// Validate AutoNumber display format (kept in default_value)
+	if field.Type == constants.FieldTypeAutoNumber {
+		if err := validateAutoNumberFormat(autoNumberFormat(field)); err != nil {
+			return err
+		}
+	}
 
 	// Get the object to ensure it exists
 	obj, err := ms.repo.GetSchemaByAPIName(ctx, objectAPIName)
@@ -71,6 +106,17 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 		return fmt.Errorf("object '%s' not found", objectAPIName)
 	}
 
+	if obj.IsExternal {
+		if err := validateExternalField(field); err != nil {
+			return err
+		}
+	}
+	if field.IsIndexed {
+		if err := validateFieldIndex(obj, field); err != nil {
+			return err
+		}
+	}
+
 	// Validate Max Master-Detail Usage (Limit 2)
 	if field.IsMasterDetail {
 		if err := ms.ValidateMaxMasterDetailFields(obj, ""); err != nil {
@@ -81,7 +127,7 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 	// Check if field already exists
 	for _, f := range obj.Fields {
 		if strings.EqualFold(f.APIName, field.APIName) {
-			return fmt.Errorf("field '%s' already exists on object '%s'", field.APIName, objectAPIName)
+			return errors.NewConflictError(fmt.Sprintf("Field on %s", objectAPIName), constants.FieldAPIName, field.APIName)
 		}
 	}
 
@@ -94,22 +140,16 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 			return errors.NewValidationError("return_type", "Formula fields require a valid return_type")
 		}
 		// Validate formula syntax by attempting to compile it
-		sampleEnv := make(map[string]interface{})
-		for _, f := range obj.Fields {
-			switch f.Type {
-			case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent:
-				sampleEnv[f.APIName] = 0.0
-			case constants.FieldTypeBoolean:
-				sampleEnv[f.APIName] = false
-			default:
-				sampleEnv[f.APIName] = ""
-			}
-		}
-		if err := ms.schemaMgr.ValidateFormula(*field.Formula, sampleEnv); err != nil {
+		if err := ms.schemaMgr.ValidateFormula(*field.Formula, formulaSampleEnv(obj)); err != nil {
 			return errors.NewValidationError("formula", fmt.Sprintf("Invalid formula syntax: %v", err))
 		}
 	}
 
+	// Default value expressions are evaluated at create time, so they must compile now
+	if err := ms.validateDefaultExpression(obj, field); err != nil {
+		return err
+	}
+
 	// Map to ColumnDefinition
 	var relationshipName string
 	if field.RelationshipName != nil {
@@ -125,7 +165,7 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 		IsMasterDetail:   field.IsMasterDetail,
 		RelationshipName: relationshipName,
 	}
-	if field.DefaultValue != nil {
+	if _, isExpr := defaultExpression(field); field.DefaultValue != nil && !isExpr {
 		colDef.Default = "'" + *field.DefaultValue + "'"
 	}
 	colDef.ReferenceTo = field.ReferenceTo
@@ -148,11 +188,26 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 		colDef.Options = field.Options
 	}
 
-	// Delegate to SchemaManager
-	if err := ms.schemaMgr.AddColumn(objectAPIName, colDef); err != nil {
+	// Delegate to SchemaManager; external objects have no table to alter
+	if obj.IsExternal {
+		if err := ms.schemaMgr.RegisterColumn(objectAPIName, colDef); err != nil {
+			return err
+		}
+	} else if err := ms.schemaMgr.AddColumn(objectAPIName, colDef); err != nil {
 		return fmt.Errorf("failed to add column to schema: %w", err)
 	}
 
+	// Back filterable fields with a secondary index
+	if field.IsIndexed {
+		if err := ms.syncFieldIndexLocked(obj, field, false); err != nil {
+			log.Printf("🔥 Failed to index field %s. Rolling back column...", field.APIName)
+			if dropErr := ms.schemaMgr.DropColumn(objectAPIName, field.APIName); dropErr != nil {
+				log.Printf("⚠️ Rollback failed for column %s: %v", field.APIName, dropErr)
+			}
+			return fmt.Errorf("failed to index field: %w", err)
+		}
+	}
+
 	// For Polymorphic Lookups, create a secondary column for Object Type
 	if field.IsPolymorphic {
 		typeColName := GetPolymorphicTypeColumnName(field.APIName)
@@ -171,6 +226,39 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 		}
 	}
 
+	// For Geolocation fields, create the latitude/longitude columns
+	if field.Type == constants.FieldTypeGeolocation {
+		added := []string{field.APIName}
+		for _, colDef := range geolocationColumns(field.APIName) {
+			if err := ms.schemaMgr.AddColumn(objectAPIName, colDef); err != nil {
+				log.Printf("🔥 Failed to add geolocation column %s. Rolling back...", colDef.Name)
+				for _, col := range added {
+					if dropErr := ms.schemaMgr.DropColumn(objectAPIName, col); dropErr != nil {
+						log.Printf("⚠️ Rollback failed for column %s: %v", col, dropErr)
+					}
+				}
+				return fmt.Errorf("failed to add coordinate column for geolocation field: %w", err)
+			}
+			added = append(added, colDef.Name)
+		}
+	}
+
+	if valueSet != nil {
+		if err := ms.repo.SetFieldValueSet(ctx, GenerateFieldID(obj.APIName, field.APIName), &valueSet.Name); err != nil {
+			return fmt.Errorf("failed to bind value set: %w", err)
+		}
+	}
+	if field.LookupFilter != nil {
+		if err := ms.repo.SetFieldLookupFilter(ctx, GenerateFieldID(obj.APIName, field.APIName), field.LookupFilter); err != nil {
+			return fmt.Errorf("failed to save lookup filter: %w", err)
+		}
+	}
+	if field.TrackHistory {
+		if err := ms.repo.SetFieldTrackHistory(ctx, obj.ID, field.APIName, true); err != nil {
+			return fmt.Errorf("failed to save history tracking: %w", err)
+		}
+	}
+
 	// Add to default layout
 	if err := ms.addFieldToLayout(ctx, objectAPIName, field.APIName); err != nil {
 		log.Printf("Warning: Failed to add field %s to layout: %v", field.APIName, err)
@@ -178,18 +266,14 @@ func (ms *MetadataService) CreateField(ctx context.Context, objectAPIName string
 
 	// For AutoNumber fields, register in _System_AutoNumber
 	if field.Type == constants.FieldTypeAutoNumber {
-		format := "{0}"
-		if field.DefaultValue != nil && *field.DefaultValue != "" {
-			format = *field.DefaultValue
-		}
-
 		anID := GenerateAutoNumberID(objectAPIName, field.APIName)
 		// Default starting_number to 1 (current_number = 0)
-		if err := ms.repo.UpsertAutoNumber(ctx, anID, objectAPIName, field.APIName, format, 1, 0); err != nil {
+		if err := ms.repo.UpsertAutoNumber(ctx, anID, objectAPIName, field.APIName, autoNumberFormat(field), 1, 0); err != nil {
 			log.Printf("⚠️ Failed to register auto-number metadata: %v", err)
 		}
 	}
 
+	ms.setupAudit.Record(ctx, constants.SetupActionCreate, constants.SetupComponentField, obj.APIName+"."+field.APIName, obj.APIName, nil, field)
 	ms.invalidateCacheLocked()
 	return nil
 }
@@ -217,11 +301,14 @@ func (ms *MetadataService) UpdateField(ctx context.Context, objectAPIName, field
 		return fmt.Errorf("field '%s' not found on object '%s'", fieldAPIName, objectAPIName)
 	}
 
+	before := setupSnapshot(existingField)
+
 	// Don't allow editing system fields (except help text and default value which is safe)
 	if existingField.IsSystem {
 		if (updates.Type != "" && updates.Type != existingField.Type) ||
 			(updates.Required != existingField.Required) ||
-			(updates.IsUnique != existingField.IsUnique) {
+			(updates.IsUnique != existingField.IsUnique) ||
+			(updates.IsIndexed != existingField.IsIndexed) {
 			return fmt.Errorf("cannot modify structural properties of system field '%s'", fieldAPIName)
 		}
 	}
@@ -232,14 +319,21 @@ func (ms *MetadataService) UpdateField(ctx context.Context, objectAPIName, field
 	}
 	existingField.Required = updates.Required
 	existingField.IsUnique = updates.IsUnique
+	wasIndexed := existingField.IsIndexed
+	existingField.IsIndexed = updates.IsIndexed
+	trackHistoryChanged := updates.TrackHistory != existingField.TrackHistory
+	existingField.TrackHistory = updates.TrackHistory
 
 	if updates.HelpText != nil {
 		existingField.HelpText = updates.HelpText
 	}
 	if updates.DefaultValue != nil {
 		existingField.DefaultValue = updates.DefaultValue
+		if err := ms.validateDefaultExpression(obj, existingField); err != nil {
+			return err
+		}
 	}
-	if updates.Options != nil {
+	if updates.Options != nil && existingField.ValueSet == nil {
 		existingField.Options = updates.Options
 	}
 	if updates.MinLength != nil {
@@ -257,9 +351,55 @@ func (ms *MetadataService) UpdateField(ctx context.Context, objectAPIName, field
 	if updates.ReturnType != nil {
 		existingField.ReturnType = updates.ReturnType
 	}
+	// An empty lookup filter removes the filter
+	lookupFilterChanged := updates.LookupFilter != nil
+	if lookupFilterChanged {
+		existingField.LookupFilter = updates.LookupFilter
+		if strings.TrimSpace(*updates.LookupFilter) == "" {
+			existingField.LookupFilter = nil
+		}
+	}
+
+	// AutoNumber display format (kept in default_value) must be valid when set or converted to
+	toAutoNumber := updates.Type == constants.FieldTypeAutoNumber && existingField.Type != constants.FieldTypeAutoNumber
+	staysAutoNumber := (updates.Type == "" || updates.Type == constants.FieldTypeAutoNumber) && existingField.Type == constants.FieldTypeAutoNumber
+	if toAutoNumber || (staysAutoNumber && updates.DefaultValue != nil) {
+		if err := validateAutoNumberFormat(autoNumberFormat(existingField)); err != nil {
+			return err
+		}
+	}
+	previousType := existingField.Type
+
+	// The index must still fit the column once a type change is applied
+	if existingField.IsIndexed {
+		indexed := *existingField
+		if updates.Type != "" {
+			indexed.Type = updates.Type
+		}
+		if err := validateFieldIndex(obj, &indexed); err != nil {
+			return err
+		}
+	}
+
+	// A lookup filter must still apply once a type change is applied
+	filtered := *existingField
+	if updates.Type != "" {
+		filtered.Type = updates.Type
+	}
+	if err := validateLookupFilter(&filtered); err != nil {
+		return err
+	}
+	if err := validateTrackHistory(&filtered); err != nil {
+		return err
+	}
 
 	// Handle Type Changes (for non-system fields only)
 	if updates.Type != "" && updates.Type != existingField.Type {
+		// Coordinates live in companion columns that a column type change cannot create or drop
+		if updates.Type == constants.FieldTypeGeolocation || existingField.Type == constants.FieldTypeGeolocation {
+			return errors.NewValidationError(constants.FieldType, "cannot change the type of a field to or from Geolocation")
+		}
+
 		log.Printf("🔧 Field type change detected: %s.%s from %s to %s", objectAPIName, fieldAPIName, existingField.Type, updates.Type)
 
 		// Build column definition for ALTER TABLE
@@ -270,7 +410,11 @@ func (ms *MetadataService) UpdateField(ctx context.Context, objectAPIName, field
 			Nullable:    !existingField.Required,
 		}
 
-		if err := ms.schemaMgr.ModifyColumn(objectAPIName, fieldAPIName, colDef); err != nil {
+		if obj.IsExternal {
+			if err := validateExternalField(&models.FieldMetadata{Type: updates.Type}); err != nil {
+				return err
+			}
+		} else if err := ms.schemaMgr.ModifyColumn(objectAPIName, fieldAPIName, colDef); err != nil {
 			return fmt.Errorf("failed to modify field type: %w", err)
 		}
 
@@ -285,11 +429,33 @@ func (ms *MetadataService) UpdateField(ctx context.Context, objectAPIName, field
 		return fmt.Errorf("failed to update field metadata: %w", err)
 	}
 
+	if err := ms.syncFieldIndexLocked(obj, existingField, wasIndexed); err != nil {
+		return fmt.Errorf("failed to update field index: %w", err)
+	}
+
+	if lookupFilterChanged {
+		if err := ms.repo.SetFieldLookupFilter(ctx, fieldID, existingField.LookupFilter); err != nil {
+			return fmt.Errorf("failed to save lookup filter: %w", err)
+		}
+	}
+	if trackHistoryChanged {
+		if err := ms.repo.SetFieldTrackHistory(ctx, obj.ID, existingField.APIName, existingField.TrackHistory); err != nil {
+			return fmt.Errorf("failed to save history tracking: %w", err)
+		}
+	}
+
+	// Register, renumber or drop the auto-number sequence
+	if err := ms.syncAutoNumberLocked(ctx, obj.APIName, existingField, previousType); err != nil {
+		return err
+	}
+
+	ms.setupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentField, obj.APIName+"."+fieldAPIName, obj.APIName, before, existingField)
 	ms.invalidateCacheLocked()
 	return nil
 }
 
-// DeleteField deletes a field from an object
+// DeleteField erases a field from an object: it disappears from metadata while its column
+// is kept for MetadataRestoreWindow, after which it is dropped
 func (ms *MetadataService) DeleteField(ctx context.Context, objectAPIName, fieldAPIName string) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
@@ -317,11 +483,49 @@ func (ms *MetadataService) DeleteField(ctx context.Context, objectAPIName, field
 		return fmt.Errorf("cannot delete system or name field '%s'", fieldAPIName)
 	}
 
-	// Delegate to SchemaManager
-	if err := ms.schemaMgr.DropColumn(objectAPIName, fieldAPIName); err != nil {
+	// Coordinate columns are deleted with their Geolocation field
+	if base := geolocationFieldOf(obj, fieldAPIName); base != nil {
+		return fmt.Errorf("field '%s' holds the coordinates of '%s' and is deleted with it", fieldAPIName, base.APIName)
+	}
+
+	// Formulas, rollups, validation rules and flows would break without the field
+	if err := ms.checkDeletable(ctx, obj.APIName, existingField.APIName); err != nil {
+		return err
+	}
+
+	// Erase the field, keeping its data for a restore, or delegate to SchemaManager
+	if ms.deleted != nil {
+		if err := ms.eraseFieldLocked(ctx, obj, existingField); err != nil {
+			return fmt.Errorf("failed to delete field: %w", err)
+		}
+	} else if obj.IsExternal {
+		if err := ms.schemaMgr.UnregisterColumn(objectAPIName, fieldAPIName); err != nil {
+			return err
+		}
+	} else if err := ms.schemaMgr.DropColumn(objectAPIName, fieldAPIName); err != nil {
 		return fmt.Errorf("failed to drop column: %w", err)
+	} else if existingField.Type == constants.FieldTypeGeolocation {
+		latCol, lngCol := GetGeolocationColumnNames(fieldAPIName)
+		for _, col := range []string{latCol, lngCol} {
+			if err := ms.schemaMgr.DropColumn(objectAPIName, col); err != nil {
+				return fmt.Errorf("failed to drop column: %w", err)
+			}
+		}
+	}
+
+	// The sequence of an erased field is kept until it is purged
+	if ms.deleted == nil && existingField.Type == constants.FieldTypeAutoNumber {
+		if err := ms.repo.DeleteAutoNumber(ctx, obj.APIName, existingField.APIName); err != nil {
+			log.Printf("⚠️ Failed to remove auto-number metadata: %v", err)
+		}
+	}
+
+	// Incremental metadata readers see the object change although no field row is left
+	if err := ms.repo.TouchObject(ctx, obj.ID); err != nil {
+		log.Printf("⚠️ Failed to mark object %s modified: %v", obj.APIName, err)
 	}
 
+	ms.setupAudit.Record(ctx, constants.SetupActionDelete, constants.SetupComponentField, obj.APIName+"."+fieldAPIName, obj.APIName, existingField, nil)
 	ms.invalidateCacheLocked()
 	return nil
 }
@@ -358,3 +562,20 @@ func (ms *MetadataService) BatchSyncSystemFields(ctx context.Context, objectAPIN
 	ms.invalidateCacheLocked()
 	return nil
 }
+
+// formulaSampleEnv types an object's fields with zero values so formulas referencing them
+// can be compiled before any record exists
+func formulaSampleEnv(obj *models.ObjectMetadata) map[string]interface{} {
+	env := make(map[string]interface{}, len(obj.Fields))
+	for _, f := range obj.Fields {
+		switch f.Type {
+		case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent:
+			env[f.APIName] = 0.0
+		case constants.FieldTypeBoolean:
+			env[f.APIName] = false
+		default:
+			env[f.APIName] = ""
+		}
+	}
+	return env
+}
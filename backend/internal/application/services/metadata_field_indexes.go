@@ -0,0 +1,66 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// isIndexableFieldType reports whether fields of a type are stored in a column a plain
+// secondary index can cover. TEXT, JSON and generated columns are left out.
+func isIndexableFieldType(t models.FieldType) bool {
+	switch t {
+	case constants.FieldTypeText, constants.FieldTypeEmail, constants.FieldTypePhone,
+		constants.FieldTypePicklist, constants.FieldTypeAutoNumber,
+		constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent,
+		constants.FieldTypeBoolean, constants.FieldTypeDate, constants.FieldTypeDateTime,
+		constants.FieldTypeLookup:
+		return true
+	}
+	return false
+}
+
+// validateFieldIndex checks that a field can be backed by its own secondary index
+func validateFieldIndex(obj *models.ObjectMetadata, field *models.FieldMetadata) error {
+	if obj.IsExternal {
+		return errors.NewValidationError("is_indexed", "fields of external objects cannot be indexed")
+	}
+	if field.IsUnique {
+		return errors.NewValidationError("is_indexed", "unique fields are already indexed")
+	}
+	if field.Type == constants.FieldTypeLookup && !field.IsPolymorphic && len(field.ReferenceTo) <= 1 {
+		return errors.NewValidationError("is_indexed", "lookup fields are already indexed by their foreign key")
+	}
+	if !isIndexableFieldType(field.Type) {
+		return errors.NewValidationError("is_indexed", fmt.Sprintf("%s fields cannot be indexed", field.Type))
+	}
+	return nil
+}
+
+// syncFieldIndexLocked creates or drops a field's index after its is_indexed flag changed
+func (ms *MetadataService) syncFieldIndexLocked(obj *models.ObjectMetadata, field *models.FieldMetadata, wasIndexed bool) error {
+	if field.IsIndexed == wasIndexed || obj.IsExternal {
+		return nil
+	}
+	if field.IsIndexed {
+		if err := ms.schemaMgr.AddFieldIndex(obj.APIName, field.APIName); err != nil {
+			return err
+		}
+	} else if err := ms.schemaMgr.DropFieldIndex(obj.APIName, field.APIName); err != nil {
+		return err
+	}
+	return ms.schemaMgr.SetFieldIndexed(obj.ID, field.APIName, field.IsIndexed)
+}
+
+// indexNameFieldLocked backs the name field of a new object with a secondary index, so
+// type-ahead lookups matching a name prefix do not scan the table
+func (ms *MetadataService) indexNameFieldLocked(obj *models.ObjectMetadata) error {
+	field := FindField(obj, GetNameFieldAPIName(obj))
+	if field == nil || field.IsIndexed || validateFieldIndex(obj, field) != nil {
+		return nil
+	}
+	field.IsIndexed = true
+	return ms.syncFieldIndexLocked(obj, field, false)
+}
@@ -62,6 +62,8 @@ func (ms *MetadataService) CreateFlow(ctx context.Context, flow *models.Flow) er
 		}
 	}
 
+	ms.setupAudit.Record(ctx, constants.SetupActionCreate, constants.SetupComponentFlow, flow.ID, flow.TriggerObject, nil, flow)
+
 	// Invalidate cache to include new flow
 	ms.invalidateCacheLocked()
 	return nil
@@ -77,6 +79,7 @@ func (ms *MetadataService) UpdateFlow(ctx context.Context, flowID string, update
 	if err != nil || existingFlow == nil {
 		return fmt.Errorf("flow with ID '%s' not found", flowID)
 	}
+	before := setupSnapshot(existingFlow)
 
 	// Apply updates
 	if updates.Name != "" {
@@ -125,8 +128,9 @@ func (ms *MetadataService) UpdateFlow(ctx context.Context, flowID string, update
 		return fmt.Errorf("failed to update flow: %w", err)
 	}
 
-	// Update steps (Delete and Re-create)
-	if existingFlow.FlowType == constants.FlowTypeMultistep || updates.FlowType == constants.FlowTypeMultistep || len(updates.Steps) > 0 {
+	// Update steps (Delete and Re-create). Steps left out of the update are kept;
+	// an explicit empty list clears them.
+	if updates.Steps != nil {
 		if err := ms.repo.DeleteFlowSteps(ctx, flowID); err != nil {
 			return err
 		}
@@ -135,16 +139,13 @@ func (ms *MetadataService) UpdateFlow(ctx context.Context, flowID string, update
 				return err
 			}
 		}
-	} else if len(updates.Steps) > 0 {
-		// If steps provided in update, save them
-		if err := ms.repo.DeleteFlowSteps(ctx, flowID); err != nil {
-			return err
-		}
-		if err := ms.repo.SaveFlowSteps(ctx, flowID, updates.Steps); err != nil {
-			return err
-		}
 	}
 
+	if updates.Steps != nil {
+		existingFlow.Steps = updates.Steps
+	}
+	ms.setupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentFlow, flowID, existingFlow.TriggerObject, before, existingFlow)
+
 	// Invalidate cache to reflect updated flow
 	ms.invalidateCacheLocked()
 	return nil
@@ -165,6 +166,7 @@ func (ms *MetadataService) DeleteFlow(ctx context.Context, flowID string) error
 	if err := ms.repo.DeleteFlow(ctx, flowID); err != nil {
 		return err
 	}
+	ms.setupAudit.Record(ctx, constants.SetupActionDelete, constants.SetupComponentFlow, flowID, existing.TriggerObject, existing, nil)
 
 	// Invalidate cache
 	ms.invalidateCacheLocked()
@@ -12,15 +12,32 @@ import (
 
 // ==================== Layout Methods ====================
 
-// GetLayout returns the layout for an object
-func (ms *MetadataService) GetLayout(ctx context.Context, apiName string, profileID *string) *models.PageLayout {
+// GetLayout returns the layout for an object. With a record type, the layout assigned to
+// the profile for that record type takes precedence over the profile's object layout.
+func (ms *MetadataService) GetLayout(ctx context.Context, apiName string, profileID *string, recordTypeID string) *models.PageLayout {
 	ms.mu.RLock()
 	defer ms.mu.RUnlock()
 
 	var layout *models.PageLayout
 
-	// 1. If profileID provided, try to find assigned layout
-	if profileID != nil {
+	// 1. If profileID and record type provided, try the record type's layout for the profile
+	if profileID != nil && recordTypeID != "" {
+		assignments, err := ms.repo.GetProfileRecordTypes(ctx, *profileID, apiName)
+		if err != nil {
+			log.Printf("⚠️ Failed to load record type assignments for %s: %v", apiName, err)
+		}
+		for _, a := range assignments {
+			if a.RecordTypeID == recordTypeID && a.LayoutID != nil {
+				if l, err := ms.repo.GetLayout(ctx, *a.LayoutID); err == nil && l != nil {
+					layout = l
+				}
+				break
+			}
+		}
+	}
+
+	// 2. If profileID provided, try to find assigned layout
+	if layout == nil && profileID != nil {
 		layoutID, err := ms.repo.GetLayoutIDForProfile(ctx, *profileID, apiName)
 		if err == nil && layoutID != "" {
 			l, err := ms.repo.GetLayout(ctx, layoutID)
@@ -30,7 +47,7 @@ func (ms *MetadataService) GetLayout(ctx context.Context, apiName string, profil
 		}
 	}
 
-	// 2. Fallback: get all layouts for object and pick first
+	// 3. Fallback: get all layouts for object and pick first
 	if layout == nil {
 		layouts, err := ms.repo.GetLayouts(ctx, apiName)
 		if err == nil && len(layouts) > 0 {
@@ -38,7 +55,7 @@ func (ms *MetadataService) GetLayout(ctx context.Context, apiName string, profil
 		}
 	}
 
-	// 3. Last resort: generate default layout from schema
+	// 4. Last resort: generate default layout from schema
 	if layout == nil {
 		obj, err := ms.repo.GetSchemaByAPIName(ctx, apiName)
 		if err != nil || obj == nil {
@@ -76,7 +93,7 @@ func (ms *MetadataService) GetLayout(ctx context.Context, apiName string, profil
 		layout = newLayout
 	}
 
-	// 4. Augment with Related Lists (Auto-Discovery) if missing
+	// 5. Augment with Related Lists (Auto-Discovery) if missing
 	if layout != nil && len(layout.RelatedLists) == 0 {
 		ms.augmentLayoutWithRelatedLists(ctx, layout)
 	}
@@ -130,7 +147,19 @@ func (ms *MetadataService) SaveLayout(ctx context.Context, layout *models.PageLa
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	return ms.repo.SaveLayout(ctx, layout)
+	before, err := ms.repo.GetLayout(ctx, layout.ID)
+	if err != nil {
+		return err
+	}
+	if err := ms.repo.SaveLayout(ctx, layout); err != nil {
+		return err
+	}
+	action := constants.SetupActionUpdate
+	if before == nil {
+		action = constants.SetupActionCreate
+	}
+	ms.setupAudit.Record(ctx, action, constants.SetupComponentLayout, layout.ID, layout.ObjectAPIName, before, layout)
+	return nil
 }
 
 // DeleteLayout soft-deletes a layout
@@ -138,7 +167,17 @@ func (ms *MetadataService) DeleteLayout(ctx context.Context, layoutID string) er
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	return ms.repo.DeleteLayout(ctx, layoutID)
+	before, err := ms.repo.GetLayout(ctx, layoutID)
+	if err != nil {
+		return err
+	}
+	if err := ms.repo.DeleteLayout(ctx, layoutID); err != nil {
+		return err
+	}
+	if before != nil {
+		ms.setupAudit.Record(ctx, constants.SetupActionDelete, constants.SetupComponentLayout, layoutID, before.ObjectAPIName, before, nil)
+	}
+	return nil
 }
 
 // AssignLayoutToProfile assigns a layout to a profile
@@ -146,7 +185,20 @@ func (ms *MetadataService) AssignLayoutToProfile(ctx context.Context, profileID,
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	return ms.repo.AssignLayoutToProfile(ctx, profileID, objectAPIName, layoutID)
+	previous, err := ms.repo.GetLayoutIDForProfile(ctx, profileID, objectAPIName)
+	if err != nil {
+		return err
+	}
+	if err := ms.repo.AssignLayoutToProfile(ctx, profileID, objectAPIName, layoutID); err != nil {
+		return err
+	}
+	var before interface{}
+	if previous != "" {
+		before = map[string]string{constants.FieldSysProfileLayout_LayoutID: previous}
+	}
+	ms.setupAudit.Record(ctx, constants.SetupActionAssign, constants.SetupComponentLayoutAssignment, profileID+"."+objectAPIName, objectAPIName,
+		before, map[string]string{constants.FieldSysProfileLayout_LayoutID: layoutID})
+	return nil
 }
 
 // addFieldToLayout adds a new field to the first section of the object's default layout
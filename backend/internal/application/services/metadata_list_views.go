@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GetListViews returns the list views of an object that the user may see
+func (ms *MetadataService) GetListViews(ctx context.Context, objectAPIName string, user *models.UserSession) []*models.ListView {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	views, err := ms.repo.GetListViews(ctx, objectAPIName)
+	if err != nil {
+		log.Printf("Failed to get list views: %v", err)
+		return []*models.ListView{}
+	}
+
+	visible := make([]*models.ListView, 0, len(views))
+	for _, view := range views {
+		if CanViewListView(view, user) {
+			visible = append(visible, view)
+		}
+	}
+	return visible
+}
+
+// GetListView returns a list view by ID, or a NotFoundError if it does not exist or is hidden from the user
+func (ms *MetadataService) GetListView(ctx context.Context, id string, user *models.UserSession) (*models.ListView, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	view, err := ms.repo.GetListView(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list view: %w", err)
+	}
+	if view == nil || !CanViewListView(view, user) {
+		return nil, errors.NewNotFoundError("ListView", id)
+	}
+	return view, nil
+}
+
+// CreateListView creates a new list view owned by the user
+func (ms *MetadataService) CreateListView(ctx context.Context, view *models.ListView, user *models.UserSession) error {
+	if user != nil {
+		view.OwnerID = user.ID
+	}
+	if view.Visibility == "" {
+		view.Visibility = constants.ListViewVisibilityShared
+	}
+	if err := ms.validateListView(ctx, view); err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if view.ID == "" {
+		view.ID = GenerateID()
+	}
+
+	if err := ms.repo.CreateListView(ctx, view); err != nil {
+		return fmt.Errorf("failed to create list view: %w", err)
+	}
+	return nil
+}
+
+// UpdateListView merges updates into an existing list view.
+// Omitted (empty) values are left unchanged; updates is replaced with the stored view.
+func (ms *MetadataService) UpdateListView(ctx context.Context, id string, updates *models.ListView, user *models.UserSession) error {
+	existing, err := ms.GetListView(ctx, id, user)
+	if err != nil {
+		return err
+	}
+	if !CanEditListView(existing, user) {
+		return errors.NewPermissionError("edit", "ListView/"+id)
+	}
+
+	merged := *existing
+	if updates.Label != "" {
+		merged.Label = updates.Label
+	}
+	if updates.FilterExpr != "" {
+		merged.FilterExpr = updates.FilterExpr
+	}
+	if updates.Fields != nil {
+		merged.Fields = updates.Fields
+	}
+	if updates.Visibility != "" {
+		merged.Visibility = updates.Visibility
+	}
+	if updates.ProfileIDs != nil {
+		merged.ProfileIDs = updates.ProfileIDs
+	}
+	if updates.Columns != nil {
+		merged.Columns = updates.Columns
+	}
+	if updates.SortField != "" {
+		merged.SortField = updates.SortField
+	}
+	if updates.SortDirection != "" {
+		merged.SortDirection = updates.SortDirection
+	}
+	if updates.Aggregates != nil {
+		merged.Aggregates = updates.Aggregates
+	}
+	if err := ms.validateListView(ctx, &merged); err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if err := ms.repo.UpdateListView(ctx, id, &merged); err != nil {
+		return fmt.Errorf("failed to update list view: %w", err)
+	}
+	*updates = merged
+	return nil
+}
+
+// DeleteListView deletes a list view
+func (ms *MetadataService) DeleteListView(ctx context.Context, id string, user *models.UserSession) error {
+	existing, err := ms.GetListView(ctx, id, user)
+	if err != nil {
+		return err
+	}
+	if !CanEditListView(existing, user) {
+		return errors.NewPermissionError("delete", "ListView/"+id)
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if err := ms.repo.DeleteListView(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NewNotFoundError("ListView", id)
+		}
+		return fmt.Errorf("failed to delete list view: %w", err)
+	}
+	return nil
+}
+
+// CanViewListView reports whether a list view is visible to the user.
+// Views without an owner predate sharing and stay visible to everyone.
+func CanViewListView(view *models.ListView, user *models.UserSession) bool {
+	if user == nil {
+		return view.Visibility != constants.ListViewVisibilityPrivate && view.Visibility != constants.ListViewVisibilityProfile
+	}
+	if isListViewAdmin(user) || view.OwnerID == "" || view.OwnerID == user.ID {
+		return true
+	}
+	switch view.Visibility {
+	case constants.ListViewVisibilityPrivate:
+		return false
+	case constants.ListViewVisibilityProfile:
+		return ContainsString(view.ProfileIDs, user.ProfileID)
+	default:
+		return true
+	}
+}
+
+// CanEditListView reports whether the user may modify or delete a list view.
+// Only the owner and administrators may change an owned view.
+func CanEditListView(view *models.ListView, user *models.UserSession) bool {
+	if user == nil {
+		return false
+	}
+	return isListViewAdmin(user) || view.OwnerID == "" || view.OwnerID == user.ID
+}
+
+func isListViewAdmin(user *models.UserSession) bool {
+	return user.IsSystemAdmin || constants.IsSuperUser(user.ProfileID)
+}
+
+// validateListView checks visibility, column, sort and aggregate settings against the object schema
+func (ms *MetadataService) validateListView(ctx context.Context, view *models.ListView) error {
+	switch view.Visibility {
+	case constants.ListViewVisibilityPrivate, constants.ListViewVisibilityShared:
+	case constants.ListViewVisibilityProfile:
+		if len(view.ProfileIDs) == 0 {
+			return errors.NewValidationError("profile_ids", "at least one profile is required for profile visibility")
+		}
+	default:
+		return errors.NewValidationError("visibility", fmt.Sprintf("must be one of %s, %s, %s",
+			constants.ListViewVisibilityPrivate, constants.ListViewVisibilityShared, constants.ListViewVisibilityProfile))
+	}
+
+	schema := ms.GetSchema(ctx, view.ObjectAPIName)
+	if schema == nil {
+		return errors.NewNotFoundError("Object", view.ObjectAPIName)
+	}
+
+	for _, col := range view.Columns {
+		if FindField(schema, col.Field) == nil {
+			return errors.NewValidationError("columns", fmt.Sprintf("unknown field '%s'", col.Field))
+		}
+		if col.Width < 0 {
+			return errors.NewValidationError("columns", fmt.Sprintf("width of '%s' must not be negative", col.Field))
+		}
+		switch col.Pinned {
+		case constants.ColumnPinNone, constants.ColumnPinLeft, constants.ColumnPinRight:
+		default:
+			return errors.NewValidationError("columns", fmt.Sprintf("pinned must be '%s' or '%s'", constants.ColumnPinLeft, constants.ColumnPinRight))
+		}
+	}
+
+	if view.SortField != "" && FindField(schema, view.SortField) == nil {
+		return errors.NewValidationError("sort_field", fmt.Sprintf("unknown field '%s'", view.SortField))
+	}
+	if view.SortDirection != "" {
+		view.SortDirection = strings.ToUpper(view.SortDirection)
+		if view.SortDirection != constants.SortASC && view.SortDirection != constants.SortDESC {
+			return errors.NewValidationError("sort_direction", "must be ASC or DESC")
+		}
+	}
+
+	for i, agg := range view.Aggregates {
+		fn := strings.ToUpper(agg.Function)
+		view.Aggregates[i].Function = fn
+		if err := validateListViewAggregate(schema, fn, agg.Field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateListViewAggregate checks that an aggregate function can be computed in SQL over the field
+func validateListViewAggregate(schema *models.ObjectMetadata, fn, fieldName string) error {
+	if fn == persistence.RollupTypeCount && fieldName == "" {
+		return nil
+	}
+	field := FindField(schema, fieldName)
+	if field == nil {
+		return errors.NewValidationError("aggregates", fmt.Sprintf("unknown field '%s'", fieldName))
+	}
+	if field.Type == constants.FieldTypeFormula {
+		return errors.NewValidationError("aggregates", fmt.Sprintf("formula field '%s' cannot be aggregated", fieldName))
+	}
+
+	numeric := field.Type == constants.FieldTypeNumber || field.Type == constants.FieldTypeCurrency ||
+		field.Type == constants.FieldTypePercent || field.Type == constants.FieldTypeRollupSummary
+	temporal := field.Type == constants.FieldTypeDate || field.Type == constants.FieldTypeDateTime
+
+	switch fn {
+	case persistence.RollupTypeCount:
+		return nil
+	case persistence.RollupTypeSum, persistence.RollupTypeAvg:
+		if !numeric {
+			return errors.NewValidationError("aggregates", fmt.Sprintf("%s requires a numeric field, '%s' is %s", fn, fieldName, field.Type))
+		}
+	case persistence.RollupTypeMin, persistence.RollupTypeMax:
+		if !numeric && !temporal {
+			return errors.NewValidationError("aggregates", fmt.Sprintf("%s requires a numeric or date field, '%s' is %s", fn, fieldName, field.Type))
+		}
+	default:
+		return errors.NewValidationError("aggregates", fmt.Sprintf("unsupported function '%s'", fn))
+	}
+	return nil
+}
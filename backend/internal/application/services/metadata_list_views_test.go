@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanViewListView(t *testing.T) {
+	owner := &models.UserSession{ID: "u1", ProfileID: "standard_user"}
+	other := &models.UserSession{ID: "u2", ProfileID: "standard_user"}
+	sales := &models.UserSession{ID: "u3", ProfileID: "sales"}
+	admin := &models.UserSession{ID: "u4", ProfileID: constants.ProfileSystemAdmin}
+
+	private := &models.ListView{OwnerID: "u1", Visibility: constants.ListViewVisibilityPrivate}
+	assert.True(t, CanViewListView(private, owner))
+	assert.False(t, CanViewListView(private, other))
+	assert.True(t, CanViewListView(private, admin))
+
+	profile := &models.ListView{OwnerID: "u1", Visibility: constants.ListViewVisibilityProfile, ProfileIDs: []string{"sales"}}
+	assert.True(t, CanViewListView(profile, sales))
+	assert.False(t, CanViewListView(profile, other))
+
+	shared := &models.ListView{OwnerID: "u1", Visibility: constants.ListViewVisibilityShared}
+	assert.True(t, CanViewListView(shared, other))
+	assert.False(t, CanEditListView(shared, other))
+	assert.True(t, CanEditListView(shared, owner))
+
+	legacy := &models.ListView{Visibility: constants.ListViewVisibilityShared}
+	assert.True(t, CanEditListView(legacy, other))
+}
+
+func TestValidateListViewAggregate(t *testing.T) {
+	schema := &models.ObjectMetadata{
+		APIName: "opportunity",
+		Fields: []models.FieldMetadata{
+			{APIName: "amount", Type: constants.FieldTypeCurrency},
+			{APIName: "close_date", Type: constants.FieldTypeDate},
+			{APIName: "stage", Type: constants.FieldTypePicklist},
+			{APIName: "score", Type: constants.FieldTypeFormula},
+		},
+	}
+
+	assert.NoError(t, validateListViewAggregate(schema, "COUNT", ""))
+	assert.NoError(t, validateListViewAggregate(schema, "SUM", "amount"))
+	assert.NoError(t, validateListViewAggregate(schema, "MAX", "close_date"))
+	assert.NoError(t, validateListViewAggregate(schema, "COUNT", "stage"))
+
+	assert.Error(t, validateListViewAggregate(schema, "SUM", "stage"))
+	assert.Error(t, validateListViewAggregate(schema, "AVG", "close_date"))
+	assert.Error(t, validateListViewAggregate(schema, "SUM", "score"))
+	assert.Error(t, validateListViewAggregate(schema, "SUM", "missing"))
+	assert.Error(t, validateListViewAggregate(schema, "MEDIAN", "amount"))
+}
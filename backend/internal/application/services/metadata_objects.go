@@ -167,54 +167,6 @@ func (ms *MetadataService) GetValidationRules(ctx context.Context, objectAPIName
 	return rules
 }
 
-func (ms *MetadataService) GetListViews(ctx context.Context, objectAPIName string) []*models.ListView {
-	ms.mu.RLock()
-	defer ms.mu.RUnlock()
-	views, err := ms.repo.GetListViews(ctx, objectAPIName)
-	if err != nil {
-		log.Printf("Failed to get list views: %v", err)
-		return []*models.ListView{}
-	}
-	return views
-}
-
-// CreateListView creates a new list view
-func (ms *MetadataService) CreateListView(ctx context.Context, view *models.ListView) error {
-	ms.mu.Lock()
-	defer ms.mu.Unlock()
-
-	if view.ID == "" {
-		view.ID = GenerateID()
-	}
-
-	if err := ms.repo.CreateListView(ctx, view); err != nil {
-		return fmt.Errorf("failed to create list view: %w", err)
-	}
-	return nil
-}
-
-// UpdateListView updates an existing list view
-func (ms *MetadataService) UpdateListView(ctx context.Context, id string, updates *models.ListView) error {
-	ms.mu.Lock()
-	defer ms.mu.Unlock()
-
-	if err := ms.repo.UpdateListView(ctx, id, updates); err != nil {
-		return fmt.Errorf("failed to update list view: %w", err)
-	}
-	return nil
-}
-
-// DeleteListView deletes a list view
-func (ms *MetadataService) DeleteListView(ctx context.Context, id string) error {
-	ms.mu.Lock()
-	defer ms.mu.Unlock()
-
-	if err := ms.repo.DeleteListView(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete list view: %w", err)
-	}
-	return nil
-}
-
 func (ms *MetadataService) GetSharingRules(ctx context.Context, objectAPIName string) []*models.SystemSharingRule {
 	ms.mu.RLock()
 	defer ms.mu.RUnlock()
@@ -261,16 +213,6 @@ func (ms *MetadataService) GetActionByID(ctx context.Context, actionID string) *
 	return action
 }
 
-func (ms *MetadataService) GetRecordTypes(ctx context.Context, objectAPIName string) []*models.RecordType {
-	ms.mu.RLock()
-	defer ms.mu.RUnlock()
-	rts, err := ms.repo.GetRecordTypes(ctx, objectAPIName)
-	if err != nil {
-		return []*models.RecordType{}
-	}
-	return rts
-}
-
 func (ms *MetadataService) GetAutoNumbers(ctx context.Context, objectAPIName string) []*models.AutoNumber {
 	if err := ms.ensureCacheInitialized(); err != nil {
 		log.Printf("⚠️ Failed to initialize cache in GetAutoNumbers: %v", err)
@@ -309,15 +251,25 @@ func (ms *MetadataService) GetFieldDependencies(ctx context.Context, objectAPINa
 	return fds
 }
 
+// GetChildRelationships returns the objects with a lookup field to the parent object, each
+// once, read from the schema cache
 func (ms *MetadataService) GetChildRelationships(ctx context.Context, parentObjectAPIName string) []*models.ObjectMetadata {
+	if err := ms.ensureCacheInitialized(); err != nil {
+		log.Printf("⚠️ Failed to initialize cache in GetChildRelationships: %v", err)
+		return []*models.ObjectMetadata{}
+	}
+
 	ms.mu.RLock()
 	defer ms.mu.RUnlock()
 
-	children, err := ms.repo.GetChildRelationships(ctx, parentObjectAPIName)
-	if err != nil {
-		log.Printf("⚠️ Failed to query child relationships for %s: %v", parentObjectAPIName, err)
-		return []*models.ObjectMetadata{}
+	children := make([]*models.ObjectMetadata, 0)
+	for _, schema := range ms.schemas {
+		for _, field := range schema.Fields {
+			if strings.EqualFold(string(field.Type), string(constants.FieldTypeLookup)) && ContainsStringIgnoreCase(field.ReferenceTo, parentObjectAPIName) {
+				children = append(children, schema)
+				break
+			}
+		}
 	}
-
 	return children
 }
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Picklist Value Management ====================
+
+// GetPicklistValues returns the active values of a picklist field in display order,
+// followed by its inactive values
+func (ms *MetadataService) GetPicklistValues(ctx context.Context, objectAPIName, fieldAPIName string) ([]models.PicklistValue, error) {
+	_, field, err := ms.getPicklistField(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	return picklistValues(field), nil
+}
+
+// AddPicklistValue adds an active value to a picklist field. A nil position appends it.
+func (ms *MetadataService) AddPicklistValue(ctx context.Context, objectAPIName, fieldAPIName, value string, position *int) ([]models.PicklistValue, error) {
+	return ms.changePicklistValues(ctx, objectAPIName, fieldAPIName, func(field *models.FieldMetadata) error {
+		options, err := addPicklistValue(field.Options, field.InactiveOptions, field.APIName, value, position)
+		if err != nil {
+			return err
+		}
+		field.Options = options
+		return nil
+	})
+}
+
+// SetPicklistValueActive activates or deactivates a picklist value. Deactivated values stay on
+// existing records but can no longer be set; the field's default value cannot be deactivated.
+func (ms *MetadataService) SetPicklistValueActive(ctx context.Context, objectAPIName, fieldAPIName, value string, active bool) ([]models.PicklistValue, error) {
+	return ms.changePicklistValues(ctx, objectAPIName, fieldAPIName, func(field *models.FieldMetadata) error {
+		if active {
+			if !ContainsString(field.InactiveOptions, value) {
+				return errors.NewNotFoundError("Inactive picklist value", value)
+			}
+			field.InactiveOptions = removeString(field.InactiveOptions, value)
+			field.Options = append(field.Options, value)
+			return nil
+		}
+
+		if !ContainsString(field.Options, value) {
+			return errors.NewNotFoundError("Picklist value", value)
+		}
+		if field.DefaultValue != nil && *field.DefaultValue == value {
+			return errors.NewValidationError(field.APIName, fmt.Sprintf("'%s' is the default value and cannot be deactivated", value))
+		}
+		if len(field.Options) == 1 {
+			return errors.NewValidationError(field.APIName, "a picklist needs at least one active value")
+		}
+		field.Options = removeString(field.Options, value)
+		field.InactiveOptions = append(field.InactiveOptions, value)
+		return nil
+	})
+}
+
+// ReorderPicklistValues sets the display order of the active values of a picklist field
+func (ms *MetadataService) ReorderPicklistValues(ctx context.Context, objectAPIName, fieldAPIName string, values []string) ([]models.PicklistValue, error) {
+	return ms.changePicklistValues(ctx, objectAPIName, fieldAPIName, func(field *models.FieldMetadata) error {
+		if !samePicklistValues(field.Options, values) {
+			return errors.NewValidationError("values", "must list every active value exactly once")
+		}
+		field.Options = append([]string(nil), values...)
+		return nil
+	})
+}
+
+// changePicklistValues applies a change to the options of a picklist field and stores them
+func (ms *MetadataService) changePicklistValues(ctx context.Context, objectAPIName, fieldAPIName string, change func(field *models.FieldMetadata) error) ([]models.PicklistValue, error) {
+	schema, field, err := ms.getPicklistField(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if field.ValueSet != nil {
+		return nil, errors.NewValidationError(field.APIName, fmt.Sprintf("values come from global value set '%s'; edit the value set instead", *field.ValueSet))
+	}
+
+	field.Options = append([]string(nil), field.Options...)
+	field.InactiveOptions = append([]string(nil), field.InactiveOptions...)
+	if err := change(field); err != nil {
+		return nil, err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if err := ms.repo.UpdatePicklistValues(ctx, GenerateFieldID(schema.APIName, field.APIName), field.Options, field.InactiveOptions); err != nil {
+		return nil, fmt.Errorf("failed to update picklist values: %w", err)
+	}
+	ms.invalidateCacheLocked()
+	return picklistValues(field), nil
+}
+
+// getPicklistField returns a copy of a picklist field and its object
+func (ms *MetadataService) getPicklistField(ctx context.Context, objectAPIName, fieldAPIName string) (*models.ObjectMetadata, *models.FieldMetadata, error) {
+	schema, err := ms.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, nil, err
+	}
+	field := FindField(schema, fieldAPIName)
+	if field == nil {
+		return nil, nil, errors.NewNotFoundError("Field", fieldAPIName)
+	}
+	if field.Type != constants.FieldTypePicklist && field.Type != constants.FieldTypeMultiPicklist {
+		return nil, nil, errors.NewValidationError(fieldAPIName, fmt.Sprintf("'%s' is not a picklist field on %s", fieldAPIName, schema.APIName))
+	}
+	return schema, field, nil
+}
+
+// validateActivePicklistValues rejects inactive values being written to picklist fields.
+// Records that already hold an inactive value keep it until the field is changed.
+func validateActivePicklistValues(schema *models.ObjectMetadata, values models.SObject) error {
+	for i := range schema.Fields {
+		field := &schema.Fields[i]
+		if field.Type != constants.FieldTypePicklist || len(field.InactiveOptions) == 0 {
+			continue
+		}
+		value := values.GetString(field.APIName)
+		if value != "" && ContainsString(field.InactiveOptions, value) && !ContainsString(field.Options, value) {
+			return errors.NewValidationError(field.APIName, fmt.Sprintf("'%s' is an inactive value", value))
+		}
+	}
+	return nil
+}
+
+// picklistValues lists the active options of a field followed by its inactive ones
+func picklistValues(field *models.FieldMetadata) []models.PicklistValue {
+	values := make([]models.PicklistValue, 0, len(field.Options)+len(field.InactiveOptions))
+	for _, v := range field.Options {
+		values = append(values, models.PicklistValue{Value: v, Active: true})
+	}
+	for _, v := range field.InactiveOptions {
+		if !ContainsString(field.Options, v) {
+			values = append(values, models.PicklistValue{Value: v, Active: false})
+		}
+	}
+	return values
+}
+
+// addPicklistValue inserts a new value into options at position (appended when nil)
+func addPicklistValue(options, inactive []string, fieldAPIName, value string, position *int) ([]string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, errors.NewValidationError("value", "is required")
+	}
+	if ContainsString(options, value) {
+		return nil, errors.NewConflictError(fieldAPIName, "value", value)
+	}
+	if ContainsString(inactive, value) {
+		return nil, errors.NewValidationError("value", fmt.Sprintf("'%s' is inactive; activate it instead", value))
+	}
+
+	at := len(options)
+	if position != nil {
+		if *position < 0 || *position > len(options) {
+			return nil, errors.NewValidationError("position", fmt.Sprintf("must be between 0 and %d", len(options)))
+		}
+		at = *position
+	}
+	result := make([]string, 0, len(options)+1)
+	result = append(result, options[:at]...)
+	result = append(result, value)
+	return append(result, options[at:]...), nil
+}
+
+// samePicklistValues reports whether ordered is a permutation of options
+func samePicklistValues(options, ordered []string) bool {
+	if len(options) != len(ordered) {
+		return false
+	}
+	seen := make(map[string]bool, len(ordered))
+	for _, v := range ordered {
+		if seen[v] || !ContainsString(options, v) {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+// removeString returns values without v
+func removeString(values []string, v string) []string {
+	result := make([]string, 0, len(values))
+	for _, s := range values {
+		if s != v {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddPicklistValue(t *testing.T) {
+	options := []string{"New", "Working", "Closed"}
+	pos := 1
+
+	result, err := addPicklistValue(options, nil, "status", " Escalated ", &pos)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"New", "Escalated", "Working", "Closed"}, result)
+	assert.Equal(t, []string{"New", "Working", "Closed"}, options, "input is not modified")
+
+	result, err = addPicklistValue(options, nil, "status", "Reopened", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Reopened", result[3])
+
+	_, err = addPicklistValue(options, nil, "status", "New", nil)
+	assert.Error(t, err, "duplicate")
+	_, err = addPicklistValue(options, []string{"Pending"}, "status", "Pending", nil)
+	assert.True(t, errors.IsValidation(err), "inactive values are reactivated, not re-added")
+	bad := 4
+	_, err = addPicklistValue(options, nil, "status", "X", &bad)
+	assert.True(t, errors.IsValidation(err))
+}
+
+func TestSamePicklistValues(t *testing.T) {
+	options := []string{"A", "B", "C"}
+	assert.True(t, samePicklistValues(options, []string{"C", "A", "B"}))
+	assert.False(t, samePicklistValues(options, []string{"A", "B"}))
+	assert.False(t, samePicklistValues(options, []string{"A", "A", "B"}))
+	assert.False(t, samePicklistValues(options, []string{"A", "B", "D"}))
+}
+
+func TestPicklistValuesAndInactiveEnforcement(t *testing.T) {
+	field := models.FieldMetadata{
+		APIName:         "status",
+		Type:            constants.FieldTypePicklist,
+		Options:         []string{"New", "Closed"},
+		InactiveOptions: []string{"Pending"},
+	}
+	assert.Equal(t, []models.PicklistValue{
+		{Value: "New", Active: true},
+		{Value: "Closed", Active: true},
+		{Value: "Pending", Active: false},
+	}, picklistValues(&field))
+
+	schema := &models.ObjectMetadata{APIName: "ticket", Fields: []models.FieldMetadata{field}}
+	assert.NoError(t, validateActivePicklistValues(schema, models.SObject{"status": "New"}))
+	assert.NoError(t, validateActivePicklistValues(schema, models.SObject{"subject": "Printer"}), "untouched field")
+	assert.True(t, errors.IsValidation(validateActivePicklistValues(schema, models.SObject{"status": "Pending"})))
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Record Type Methods ====================
+
+// GetRecordTypes returns all record types of an object, active or not
+func (ms *MetadataService) GetRecordTypes(ctx context.Context, objectAPIName string) []*models.RecordType {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	rts, err := ms.repo.GetRecordTypes(ctx, objectAPIName)
+	if err != nil {
+		log.Printf("⚠️ Failed to load record types for %s: %v", objectAPIName, err)
+		return []*models.RecordType{}
+	}
+	return rts
+}
+
+// GetRecordType returns a record type by ID
+func (ms *MetadataService) GetRecordType(ctx context.Context, id string) (*models.RecordType, error) {
+	rt, err := ms.repo.GetRecordType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rt == nil {
+		return nil, errors.NewNotFoundError("RecordType", id)
+	}
+	return rt, nil
+}
+
+// CreateRecordType creates a record type. The first record type of an object adds the
+// record_type_id lookup field to it.
+func (ms *MetadataService) CreateRecordType(ctx context.Context, rt *models.RecordType) error {
+	schema, err := ms.GetSchemaOrError(ctx, rt.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+	rt.ObjectAPIName = schema.APIName
+	if err := validateRecordType(rt, schema); err != nil {
+		return err
+	}
+
+	if FindField(schema, constants.FieldRecordTypeID) == nil {
+		setNull := constants.DeleteRuleSetNull
+		if err := ms.CreateField(ctx, schema.APIName, &models.FieldMetadata{
+			APIName:     constants.FieldRecordTypeID,
+			Label:       "Record Type",
+			Type:        constants.FieldTypeLookup,
+			ReferenceTo: []string{constants.TableRecordType},
+			DeleteRule:  &setNull,
+		}); err != nil {
+			return fmt.Errorf("failed to add record type field to %s: %w", schema.APIName, err)
+		}
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if rt.ID == "" {
+		rt.ID = GenerateID()
+	}
+	if err := ms.repo.CreateRecordType(ctx, rt); err != nil {
+		return err
+	}
+	if rt.IsDefault {
+		return ms.repo.ClearDefaultRecordType(ctx, rt.ObjectAPIName, rt.ID)
+	}
+	return nil
+}
+
+// UpdateRecordType updates the name, description, status, default flag and picklist values of a record type
+func (ms *MetadataService) UpdateRecordType(ctx context.Context, id string, updates *models.RecordType) (*models.RecordType, error) {
+	existing, err := ms.GetRecordType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := ms.GetSchemaOrError(ctx, existing.ObjectAPIName)
+	if err != nil {
+		return nil, err
+	}
+
+	updates.ID = existing.ID
+	updates.ObjectAPIName = existing.ObjectAPIName
+	updates.CreatedDate = existing.CreatedDate
+	if err := validateRecordType(updates, schema); err != nil {
+		return nil, err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if err := ms.repo.UpdateRecordType(ctx, updates); err != nil {
+		return nil, err
+	}
+	if updates.IsDefault {
+		if err := ms.repo.ClearDefaultRecordType(ctx, updates.ObjectAPIName, updates.ID); err != nil {
+			return nil, err
+		}
+	}
+	return updates, nil
+}
+
+// DeleteRecordType deletes a record type that no record uses. Record types in use
+// should be deactivated instead.
+func (ms *MetadataService) DeleteRecordType(ctx context.Context, id string) error {
+	rt, err := ms.GetRecordType(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	inUse, err := ms.repo.RecordTypeInUse(ctx, rt.ObjectAPIName, id)
+	if err != nil {
+		return fmt.Errorf("failed to check record type usage: %w", err)
+	}
+	if inUse {
+		return errors.NewConflictError("RecordType", constants.FieldRecordTypeID, rt.Name)
+	}
+	return ms.repo.DeleteRecordType(ctx, id)
+}
+
+// GetProfileRecordTypes returns a profile's record type assignments for an object
+func (ms *MetadataService) GetProfileRecordTypes(ctx context.Context, profileID, objectAPIName string) ([]*models.ProfileRecordType, error) {
+	return ms.repo.GetProfileRecordTypes(ctx, profileID, objectAPIName)
+}
+
+// AssignRecordTypeToProfile makes a record type available to a profile, optionally as its
+// default for the object and with a page layout for records of the type
+func (ms *MetadataService) AssignRecordTypeToProfile(ctx context.Context, assignment *models.ProfileRecordType) error {
+	rt, err := ms.GetRecordType(ctx, assignment.RecordTypeID)
+	if err != nil {
+		return err
+	}
+	assignment.ObjectAPIName = rt.ObjectAPIName
+
+	if assignment.LayoutID != nil && *assignment.LayoutID != "" {
+		layout, err := ms.repo.GetLayout(ctx, *assignment.LayoutID)
+		if err != nil {
+			return err
+		}
+		if layout == nil {
+			return errors.NewNotFoundError("Layout", *assignment.LayoutID)
+		}
+		if !strings.EqualFold(layout.ObjectAPIName, rt.ObjectAPIName) {
+			return errors.NewValidationError("layout_id", fmt.Sprintf("layout '%s' does not belong to %s", layout.ID, rt.ObjectAPIName))
+		}
+	} else {
+		assignment.LayoutID = nil
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.repo.AssignRecordTypeToProfile(ctx, assignment)
+}
+
+// RemoveRecordTypeFromProfile removes a record type assignment from a profile
+func (ms *MetadataService) RemoveRecordTypeFromProfile(ctx context.Context, profileID, recordTypeID string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.repo.RemoveRecordTypeFromProfile(ctx, profileID, recordTypeID)
+}
+
+// GetAvailableRecordTypes returns the active record types of an object the user may assign
+// to records, and the user's default among them (nil if there is none)
+func (ms *MetadataService) GetAvailableRecordTypes(ctx context.Context, objectAPIName string, user *models.UserSession) ([]*models.RecordType, *models.RecordType, error) {
+	all, err := ms.repo.GetRecordTypes(ctx, objectAPIName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(all) == 0 {
+		return nil, nil, nil
+	}
+
+	var assignments []*models.ProfileRecordType
+	if user != nil {
+		if assignments, err = ms.repo.GetProfileRecordTypes(ctx, user.ProfileID, objectAPIName); err != nil {
+			return nil, nil, err
+		}
+	}
+	available, def := selectRecordTypes(all, assignments, user == nil || isListViewAdmin(user))
+	return available, def, nil
+}
+
+// ResolveRecordType returns the record type a record of the object is saved with: the given
+// ID when it is available to the user, otherwise the user's default. Returns nil when the
+// object has no record types.
+func (ms *MetadataService) ResolveRecordType(ctx context.Context, objectAPIName, recordTypeID string, user *models.UserSession) (*models.RecordType, error) {
+	available, def, err := ms.GetAvailableRecordTypes(ctx, objectAPIName, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load record types: %w", err)
+	}
+	return pickRecordType(available, def, recordTypeID, objectAPIName)
+}
+
+// pickRecordType returns the requested record type if available, or the default when none is requested
+func pickRecordType(available []*models.RecordType, def *models.RecordType, recordTypeID, objectAPIName string) (*models.RecordType, error) {
+	if recordTypeID == "" {
+		if def == nil && len(available) > 0 {
+			return nil, errors.NewValidationError(constants.FieldRecordTypeID, "a record type is required")
+		}
+		return def, nil
+	}
+	for _, rt := range available {
+		if rt.ID == recordTypeID {
+			return rt, nil
+		}
+	}
+	return nil, errors.NewValidationError(constants.FieldRecordTypeID, fmt.Sprintf("record type '%s' is not available for %s", recordTypeID, objectAPIName))
+}
+
+// selectRecordTypes narrows active record types to those assigned to a profile and picks the
+// default: the profile's default, else the object-wide default, else the only available type.
+// Profiles without assignments for the object, and unrestricted users, may use every active type.
+func selectRecordTypes(all []*models.RecordType, assignments []*models.ProfileRecordType, unrestricted bool) ([]*models.RecordType, *models.RecordType) {
+	assigned := make(map[string]bool, len(assignments))
+	profileDefault := ""
+	for _, a := range assignments {
+		assigned[a.RecordTypeID] = true
+		if a.IsDefault {
+			profileDefault = a.RecordTypeID
+		}
+	}
+
+	available := make([]*models.RecordType, 0, len(all))
+	for _, rt := range all {
+		if rt.IsActive && (unrestricted || len(assigned) == 0 || assigned[rt.ID]) {
+			available = append(available, rt)
+		}
+	}
+
+	var def *models.RecordType
+	for _, rt := range available {
+		if rt.ID == profileDefault {
+			return available, rt
+		}
+		if rt.IsDefault && def == nil {
+			def = rt
+		}
+	}
+	if def == nil && len(available) == 1 {
+		def = available[0]
+	}
+	return available, def
+}
+
+// validateRecordType checks the name and that picklist values are options of picklist fields of the object
+func validateRecordType(rt *models.RecordType, schema *models.ObjectMetadata) error {
+	rt.Name = strings.TrimSpace(rt.Name)
+	if rt.Name == "" {
+		return errors.NewValidationError(constants.FieldSysRecordType_Name, "Record type name is required")
+	}
+	for fieldName, values := range rt.PicklistValues {
+		field := FindField(schema, fieldName)
+		if field == nil || field.Type != constants.FieldTypePicklist {
+			return errors.NewValidationError(constants.FieldSysRecordType_PicklistValues, fmt.Sprintf("'%s' is not a picklist field on %s", fieldName, schema.APIName))
+		}
+		for _, v := range values {
+			if !ContainsString(field.Options, v) {
+				return errors.NewValidationError(constants.FieldSysRecordType_PicklistValues, fmt.Sprintf("'%s' is not an option of %s", v, fieldName))
+			}
+		}
+	}
+	return nil
+}
+
+// validateRecordTypePicklists rejects picklist values of the record that the record type does not allow
+func validateRecordTypePicklists(rt *models.RecordType, record models.SObject) error {
+	for fieldName, allowed := range rt.PicklistValues {
+		value, ok := record[fieldName].(string)
+		if !ok || value == "" {
+			continue
+		}
+		if !ContainsString(allowed, value) {
+			return errors.NewValidationError(fieldName, fmt.Sprintf("'%s' is not allowed for record type %s", value, rt.Name))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRecordTypes(t *testing.T) {
+	retail := &models.RecordType{ID: "rt_retail", Name: "Retail", IsActive: true, IsDefault: true}
+	partner := &models.RecordType{ID: "rt_partner", Name: "Partner", IsActive: true}
+	legacy := &models.RecordType{ID: "rt_legacy", Name: "Legacy", IsActive: false}
+	all := []*models.RecordType{legacy, partner, retail}
+
+	available, def := selectRecordTypes(all, nil, false)
+	assert.Equal(t, []*models.RecordType{partner, retail}, available, "no assignments: every active type")
+	assert.Equal(t, retail, def, "object-wide default")
+
+	assignments := []*models.ProfileRecordType{{RecordTypeID: "rt_partner", IsDefault: true}, {RecordTypeID: "rt_legacy"}}
+	available, def = selectRecordTypes(all, assignments, false)
+	assert.Equal(t, []*models.RecordType{partner}, available, "inactive assigned types are unavailable")
+	assert.Equal(t, partner, def, "profile default wins")
+
+	available, def = selectRecordTypes(all, assignments, true)
+	assert.Len(t, available, 2, "unrestricted users see every active type")
+	assert.Equal(t, partner, def)
+
+	available, def = selectRecordTypes(all, []*models.ProfileRecordType{{RecordTypeID: "rt_partner"}}, false)
+	assert.Len(t, available, 1)
+	assert.Equal(t, partner, def, "only available type is the default")
+}
+
+func TestPickRecordType(t *testing.T) {
+	retail := &models.RecordType{ID: "rt_retail", IsActive: true}
+	partner := &models.RecordType{ID: "rt_partner", IsActive: true}
+	available := []*models.RecordType{retail, partner}
+
+	rt, err := pickRecordType(nil, nil, "", "account")
+	assert.NoError(t, err)
+	assert.Nil(t, rt, "objects without record types")
+
+	rt, err = pickRecordType(available, partner, "", "account")
+	assert.NoError(t, err)
+	assert.Equal(t, partner, rt)
+
+	rt, err = pickRecordType(available, partner, "rt_retail", "account")
+	assert.NoError(t, err)
+	assert.Equal(t, retail, rt)
+
+	_, err = pickRecordType(available, nil, "", "account")
+	assert.True(t, errors.IsValidation(err), "required without a default")
+
+	_, err = pickRecordType(available, partner, "rt_other", "account")
+	assert.True(t, errors.IsValidation(err))
+}
+
+func TestValidateRecordType(t *testing.T) {
+	schema := &models.ObjectMetadata{APIName: "account", Fields: []models.FieldMetadata{
+		{APIName: "industry", Type: constants.FieldTypePicklist, Options: []string{"Retail", "Banking", "Energy"}},
+		{APIName: "name", Type: constants.FieldTypeText},
+	}}
+
+	assert.NoError(t, validateRecordType(&models.RecordType{Name: "Retail", PicklistValues: map[string][]string{"industry": {"Retail"}}}, schema))
+	assert.Error(t, validateRecordType(&models.RecordType{Name: "  "}, schema))
+	assert.Error(t, validateRecordType(&models.RecordType{Name: "X", PicklistValues: map[string][]string{"name": {"a"}}}, schema))
+	assert.Error(t, validateRecordType(&models.RecordType{Name: "X", PicklistValues: map[string][]string{"industry": {"Mining"}}}, schema))
+
+	rt := &models.RecordType{Name: "Retail", PicklistValues: map[string][]string{"industry": {"Retail", "Banking"}}}
+	assert.NoError(t, validateRecordTypePicklists(rt, models.SObject{"industry": "Banking"}))
+	assert.NoError(t, validateRecordTypePicklists(rt, models.SObject{"industry": ""}))
+	assert.NoError(t, validateRecordTypePicklists(rt, models.SObject{"name": "Acme"}))
+	assert.Error(t, validateRecordTypePicklists(rt, models.SObject{"industry": "Energy"}))
+}
@@ -53,6 +53,9 @@ func (ms *MetadataService) CreateSchema(ctx context.Context, schema *models.Obje
 	if err := ms.schemaMgr.CreateTableWithStrictMetadata(ctx, def, schema); err != nil {
 		return fmt.Errorf("failed to create schema via SchemaManager: %w", err)
 	}
+	if err := ms.indexNameFieldLocked(schema); err != nil {
+		log.Printf("⚠️ Failed to index the name field of %s: %v", schema.APIName, err)
+	}
 
 	// ==================== AUTO-GENERATE DEFAULT LAYOUT ====================
 	defaultLayout := ms.GenerateDefaultLayout(schema)
@@ -65,10 +68,77 @@ func (ms *MetadataService) CreateSchema(ctx context.Context, schema *models.Obje
 		log.Printf("✅ Auto-created default layout for %s", schema.APIName)
 	}
 
+	ms.setupAudit.Record(ctx, constants.SetupActionCreate, constants.SetupComponentObject, schema.APIName, schema.APIName, nil, schema)
+	ms.invalidateCacheLocked()
+	return nil
+}
+
+// CreateExternalSchema registers the metadata of an external object. Fields are described
+// like those of a custom object, but no table is created: records are read from the external
+// source at query time.
+func (ms *MetadataService) CreateExternalSchema(ctx context.Context, schema *models.ObjectMetadata) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	originalPlural := schema.PluralLabel
+	originalLabel := schema.Label
+
+	if ms.validationSvc != nil {
+		if err := ms.validationSvc.ValidateObjectMetadata(schema); err != nil {
+			return err
+		}
+	}
+	for _, f := range schema.Fields {
+		if err := validateExternalField(&f); err != nil {
+			return err
+		}
+	}
+
+	schema.IsCustom = true
+	schema.IsExternal = true
+	def, _, err := ms.PrepareTableDefinition(schema)
+	if err != nil {
+		return err
+	}
+
+	existing, err := ms.repo.GetSchemaByAPIName(ctx, schema.APIName)
+	if err == nil && existing != nil {
+		return errors.NewConflictError("Object Metadata", "api_name", schema.APIName)
+	}
+
+	if originalLabel != "" {
+		schema.Label = originalLabel
+	}
+	if originalPlural != "" {
+		schema.PluralLabel = originalPlural
+	}
+
+	if err := ms.schemaMgr.RegisterExternalObject(ctx, def, schema); err != nil {
+		return fmt.Errorf("failed to register external object: %w", err)
+	}
+
+	defaultLayout := ms.GenerateDefaultLayout(schema)
+	if err := ms.repo.UpsertLayout(ctx, &defaultLayout); err != nil {
+		log.Printf("⚠️ Failed to auto-create default layout for %s: %v", schema.APIName, err)
+	}
+
 	ms.invalidateCacheLocked()
 	return nil
 }
 
+// validateExternalField rejects field types that need storage or a physical table
+func validateExternalField(field *models.FieldMetadata) error {
+	switch {
+	case field.IsMasterDetail:
+		return errors.NewValidationError(constants.FieldType, "external objects cannot have Master-Detail fields")
+	case field.Type == constants.FieldTypeLookup && len(field.ReferenceTo) > 1:
+		return errors.NewValidationError(constants.FieldType, "external objects cannot have polymorphic lookups")
+	case field.Type == constants.FieldTypeAutoNumber, field.Type == constants.FieldTypeRollupSummary, field.Type == constants.FieldTypeGeolocation:
+		return errors.NewValidationError(constants.FieldType, fmt.Sprintf("external objects cannot have %s fields", field.Type))
+	}
+	return nil
+}
+
 // CreateSchemaOptimized creates a new object schema using batch metadata registration
 // This is faster than CreateSchema for objects with many fields
 func (ms *MetadataService) CreateSchemaOptimized(ctx context.Context, schema *models.ObjectMetadata) error {
@@ -99,6 +169,9 @@ func (ms *MetadataService) CreateSchemaOptimized(ctx context.Context, schema *mo
 	if err := ms.schemaMgr.CreateTableWithStrictMetadata(ctx, def, schema); err != nil {
 		return fmt.Errorf("failed to create schema: %w", err)
 	}
+	if err := ms.indexNameFieldLocked(schema); err != nil {
+		log.Printf("⚠️ Failed to index the name field of %s: %v", schema.APIName, err)
+	}
 
 	// Auto-generate default layout (same as CreateSchema)
 	defaultLayout := ms.GenerateDefaultLayout(schema)
@@ -122,6 +195,7 @@ func (ms *MetadataService) UpdateSchema(ctx context.Context, apiName string, upd
 	if err != nil || obj == nil {
 		return fmt.Errorf("object with API name '%s' not found", apiName)
 	}
+	before := setupSnapshot(obj)
 
 	// Prepare updates by modifying the existing object in-memory
 	if updates.Label != "" {
@@ -157,11 +231,13 @@ func (ms *MetadataService) UpdateSchema(ctx context.Context, apiName string, upd
 		return fmt.Errorf("failed to update object: %w", err)
 	}
 
+	ms.setupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentObject, obj.APIName, obj.APIName, before, obj)
 	ms.invalidateCacheLocked()
 	return nil
 }
 
-// DeleteSchema deletes a custom object schema and drops its table
+// DeleteSchema erases a custom object: it disappears from metadata while its table is kept
+// for MetadataRestoreWindow, after which it is dropped. See RestoreDeletedMetadata.
 func (ms *MetadataService) DeleteSchema(ctx context.Context, apiName string) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
@@ -172,12 +248,34 @@ func (ms *MetadataService) DeleteSchema(ctx context.Context, apiName string) err
 		return fmt.Errorf("object with API name '%s' not found", apiName)
 	}
 
+	// Lookups, rollups and flows on other objects would break without the object
+	if err := ms.checkDeletable(ctx, obj.APIName, ""); err != nil {
+		return err
+	}
+
+	if ms.deleted != nil {
+		if err := ms.eraseSchemaLocked(ctx, obj); err != nil {
+			return fmt.Errorf("failed to delete object: %w", err)
+		}
+	} else if err := ms.schemaMgr.DropTable(apiName); err != nil {
+		return fmt.Errorf("failed to drop table and schema: %w", err)
+	}
+
+	ms.setupAudit.Record(ctx, constants.SetupActionDelete, constants.SetupComponentObject, obj.APIName, obj.APIName, obj, nil)
+	ms.invalidateCacheLocked()
+	return nil
+}
+
+// DropSchema permanently drops an object and its table without a grace period. It is meant
+// for rolling back objects that were never usable.
+func (ms *MetadataService) DropSchema(ctx context.Context, apiName string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
 	// Physical table drop and Unregister
 	if err := ms.schemaMgr.DropTable(apiName); err != nil {
 		return fmt.Errorf("failed to drop table and schema: %w", err)
 	}
-
-	// Delete from _System_Object and _System_Field is handled by DropTable internally
 	ms.invalidateCacheLocked()
 	return nil
 }
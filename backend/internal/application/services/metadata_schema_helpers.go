@@ -2,9 +2,10 @@ package services
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
 
 	domainSchema "github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
@@ -15,9 +16,8 @@ import (
 // It returns the Physical TableDefinition and a slice of FieldWithContext for batch processing
 func (ms *MetadataService) PrepareTableDefinition(schema *models.ObjectMetadata) (domainSchema.TableDefinition, []FieldWithContext, error) {
 	// Validate API Name
-	validName := regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
-	if !validName.MatchString(schema.APIName) {
-		return domainSchema.TableDefinition{}, nil, fmt.Errorf("invalid API name '%s': must start with letter or underscore and contain only alphanumeric characters", schema.APIName)
+	if !query.IsValidIdentifier(schema.APIName) {
+		return domainSchema.TableDefinition{}, nil, fmt.Errorf("invalid API name '%s': must start with letter or underscore, contain only alphanumeric characters and be at most %d characters", schema.APIName, query.MaxIdentifierLength)
 	}
 
 	// Determine defaults
@@ -64,7 +64,7 @@ func (ms *MetadataService) PrepareTableDefinition(schema *models.ObjectMetadata)
 			colDef.Type = "VARCHAR(36)"
 		}
 
-		if field.DefaultValue != nil {
+		if _, isExpr := defaultExpression(&field); field.DefaultValue != nil && !isExpr {
 			colDef.Default = "'" + *field.DefaultValue + "'"
 			if field.Type == constants.FieldTypeBoolean {
 				if *field.DefaultValue == "false" || *field.DefaultValue == "0" {
@@ -88,6 +88,11 @@ func (ms *MetadataService) PrepareTableDefinition(schema *models.ObjectMetadata)
 			def.Columns = append(def.Columns, typeColDef)
 		}
 
+		// If Geolocation, add the numeric latitude/longitude columns distance filters use
+		if field.Type == constants.FieldTypeGeolocation {
+			def.Columns = append(def.Columns, geolocationColumns(field.APIName)...)
+		}
+
 		// Field Metadata Context
 		f := field // copy
 		batchFields = append(batchFields, FieldWithContext{
@@ -100,6 +105,34 @@ func (ms *MetadataService) PrepareTableDefinition(schema *models.ObjectMetadata)
 	return def, batchFields, nil
 }
 
+// geolocationColumns returns the companion columns holding a Geolocation field's latitude and longitude
+func geolocationColumns(fieldAPIName string) []domainSchema.ColumnDefinition {
+	latCol, lngCol := GetGeolocationColumnNames(fieldAPIName)
+	columns := make([]domainSchema.ColumnDefinition, 0, 2)
+	for _, name := range []string{latCol, lngCol} {
+		columns = append(columns, domainSchema.ColumnDefinition{
+			Name:        name,
+			Type:        string(constants.FieldTypeNumber),
+			LogicalType: string(constants.FieldTypeNumber),
+			Nullable:    true, // Null while the location is unknown
+		})
+	}
+	return columns
+}
+
+// geolocationFieldOf returns the Geolocation field whose coordinates a companion column holds
+func geolocationFieldOf(obj *models.ObjectMetadata, columnName string) *models.FieldMetadata {
+	for _, suffix := range []string{constants.GeolocationLatitudeSuffix, constants.GeolocationLongitudeSuffix} {
+		if !strings.HasSuffix(columnName, suffix) {
+			continue
+		}
+		if field := FindField(obj, strings.TrimSuffix(columnName, suffix)); field != nil && field.Type == constants.FieldTypeGeolocation {
+			return field
+		}
+	}
+	return nil
+}
+
 // GenerateDefaultLayout creates a default page layout for a schema
 func (ms *MetadataService) GenerateDefaultLayout(schema *models.ObjectMetadata) models.PageLayout {
 	layoutID := GenerateID()
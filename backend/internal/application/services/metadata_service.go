@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
 	"github.com/nexuscrm/backend/pkg/errors"
@@ -31,8 +32,13 @@ type MetadataService struct {
 	validationRulesMap map[string][]*models.ValidationRule // key: objectAPIName (lowercase)
 	autoNumbersMap     map[string][]*models.AutoNumber     // key: objectAPIName (lowercase)
 
+	// Cache - Custom metadata records, read lock-free by formulas (map[string]interface{})
+	customMetadata atomic.Value
+
 	// Dependencies
 	validationSvc *ValidationService
+	setupAudit    *SetupAuditService
+	deleted       *persistence.DeletedMetadataRepository
 }
 
 // NewMetadataService creates a new MetadataService
@@ -48,6 +54,11 @@ func (ms *MetadataService) SetValidationService(vs *ValidationService) {
 	ms.validationSvc = vs
 }
 
+// SetSetupAudit sets the setup audit trail that records metadata changes
+func (ms *MetadataService) SetSetupAudit(a *SetupAuditService) {
+	ms.setupAudit = a
+}
+
 // RefreshCache reloads all metadata from the database
 func (ms *MetadataService) RefreshCache() error {
 	ms.mu.Lock()
@@ -77,6 +88,23 @@ func (ms *MetadataService) refreshCacheLocked() error {
 		fieldMap[key] = schema.Fields
 	}
 
+	// Fields bound to a global value set take their options from it
+	valueSets, err := ms.repo.GetGlobalValueSets(ctx)
+	if err != nil {
+		log.Printf("⚠️ Failed to load global value sets: %v", err)
+	} else {
+		applyGlobalValueSets(schemas, valueSets)
+	}
+
+	// Merge _System_FieldDependency rows into the dependent fields so describe responses and
+	// record validation see the full dependency matrix
+	deps, err := ms.repo.GetFieldDependencies(ctx, "")
+	if err != nil {
+		log.Printf("⚠️ Failed to load field dependencies: %v", err)
+	} else {
+		applyFieldDependencies(schemaMap, deps)
+	}
+
 	// 3. Load all flows
 	// Critical: If this fails, returning empty flows would silently disable all automation.
 	// We must fail the refresh instead.
@@ -122,6 +150,9 @@ func (ms *MetadataService) refreshCacheLocked() error {
 		}
 	}
 
+	// Custom metadata records for formulas (kept separately, survives invalidation)
+	ms.reloadCustomMetadataLocked(ctx)
+
 	// 5. ATOMIC SWAP
 	// Only update state after all critical data is loaded successfully
 	ms.schemas = schemas
@@ -19,7 +19,7 @@ func TestMetadataService_Themes_Integration(t *testing.T) {
 	}
 
 	// 1. Setup Connection
-	conn, err := database.GetInstance()
+	conn, err := database.GetTestInstance()
 	if err != nil {
 		t.SkipNow()
 	}
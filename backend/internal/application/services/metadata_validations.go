@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -38,6 +39,7 @@ func (ms *MetadataService) CreateValidationRule(ctx context.Context, rule *model
 	if err := ms.repo.CreateValidationRule(ctx, rule); err != nil {
 		return fmt.Errorf("failed to insert validation rule: %w", err)
 	}
+	ms.setupAudit.Record(ctx, constants.SetupActionCreate, constants.SetupComponentValidationRule, rule.ID, rule.ObjectAPIName, nil, rule)
 
 	// Invalidate cache
 	ms.invalidateCacheLocked()
@@ -57,6 +59,7 @@ func (ms *MetadataService) UpdateValidationRule(ctx context.Context, id string,
 	if existingRule == nil {
 		return fmt.Errorf("validation rule with ID '%s' not found", id)
 	}
+	before := setupSnapshot(existingRule)
 
 	// Merge updates
 	if updates.Name != "" {
@@ -76,6 +79,7 @@ func (ms *MetadataService) UpdateValidationRule(ctx context.Context, id string,
 	if err := ms.repo.UpdateValidationRule(ctx, id, existingRule); err != nil {
 		return fmt.Errorf("failed to update validation rule: %w", err)
 	}
+	ms.setupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentValidationRule, id, existingRule.ObjectAPIName, before, existingRule)
 
 	// Invalidate cache
 	ms.invalidateCacheLocked()
@@ -87,9 +91,16 @@ func (ms *MetadataService) DeleteValidationRule(ctx context.Context, id string)
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
+	existingRule, err := ms.repo.GetValidationRule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get validation rule: %w", err)
+	}
 	if err := ms.repo.DeleteValidationRule(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete validation rule: %w", err)
 	}
+	if existingRule != nil {
+		ms.setupAudit.Record(ctx, constants.SetupActionDelete, constants.SetupComponentValidationRule, id, existingRule.ObjectAPIName, existingRule, nil)
+	}
 
 	// Invalidate cache
 	ms.invalidateCacheLocked()
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Global Value Sets ====================
+
+var valueSetNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// GetGlobalValueSets returns all global value sets
+func (ms *MetadataService) GetGlobalValueSets(ctx context.Context) ([]*models.GlobalValueSet, error) {
+	return ms.repo.GetGlobalValueSets(ctx)
+}
+
+// GetGlobalValueSet returns a global value set by name
+func (ms *MetadataService) GetGlobalValueSet(ctx context.Context, name string) (*models.GlobalValueSet, error) {
+	vs, err := ms.repo.GetGlobalValueSet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if vs == nil {
+		return nil, errors.NewNotFoundError("Global value set", name)
+	}
+	return vs, nil
+}
+
+// GetValueSetFields returns the fields ("object.field") that use a global value set
+func (ms *MetadataService) GetValueSetFields(ctx context.Context, name string) ([]string, error) {
+	return ms.repo.GetValueSetFields(ctx, name)
+}
+
+// CreateGlobalValueSet creates a global value set
+func (ms *MetadataService) CreateGlobalValueSet(ctx context.Context, vs *models.GlobalValueSet) error {
+	vs.Name = strings.TrimSpace(vs.Name)
+	if !valueSetNamePattern.MatchString(vs.Name) {
+		return errors.NewValidationError(constants.FieldName, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(vs.Label) == "" {
+		vs.Label = vs.Name
+	}
+	if err := validateValueSetOptions(vs.Options, vs.InactiveOptions); err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	existing, err := ms.repo.GetGlobalValueSet(ctx, vs.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("GlobalValueSet", constants.FieldName, vs.Name)
+	}
+	if vs.ID == "" {
+		vs.ID = GenerateID()
+	}
+	return ms.repo.CreateGlobalValueSet(ctx, vs)
+}
+
+// UpdateGlobalValueSet updates the label, description and values of a global value set; the
+// change applies to every field using it. Values dropped from both lists become inactive so
+// records holding them stay valid.
+func (ms *MetadataService) UpdateGlobalValueSet(ctx context.Context, name string, updates *models.GlobalValueSet) (*models.GlobalValueSet, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	vs, err := ms.repo.GetGlobalValueSet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if vs == nil {
+		return nil, errors.NewNotFoundError("Global value set", name)
+	}
+
+	if strings.TrimSpace(updates.Label) != "" {
+		vs.Label = updates.Label
+	}
+	if updates.Description != nil {
+		vs.Description = updates.Description
+	}
+	if updates.Options != nil {
+		inactive := append([]string(nil), updates.InactiveOptions...)
+		for _, v := range append(append([]string(nil), vs.Options...), vs.InactiveOptions...) {
+			if !ContainsString(updates.Options, v) && !ContainsString(inactive, v) {
+				inactive = append(inactive, v)
+			}
+		}
+		if err := validateValueSetOptions(updates.Options, inactive); err != nil {
+			return nil, err
+		}
+		vs.Options = updates.Options
+		vs.InactiveOptions = inactive
+	}
+
+	if err := ms.repo.UpdateGlobalValueSet(ctx, vs); err != nil {
+		return nil, err
+	}
+	ms.invalidateCacheLocked()
+	return vs, nil
+}
+
+// DeleteGlobalValueSet deletes a custom global value set that no field uses
+func (ms *MetadataService) DeleteGlobalValueSet(ctx context.Context, name string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	vs, err := ms.repo.GetGlobalValueSet(ctx, name)
+	if err != nil {
+		return err
+	}
+	if vs == nil {
+		return errors.NewNotFoundError("Global value set", name)
+	}
+	if vs.IsStandard {
+		return errors.NewValidationError(constants.FieldName, fmt.Sprintf("standard value set '%s' cannot be deleted", vs.Name))
+	}
+	fields, err := ms.repo.GetValueSetFields(ctx, vs.Name)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		return errors.NewConflictError("GlobalValueSet", "fields", strings.Join(fields, ", "))
+	}
+	return ms.repo.DeleteGlobalValueSet(ctx, vs.ID)
+}
+
+// SetFieldValueSet binds a picklist field to a global value set, or unbinds it when name is
+// nil. Binding fails if the field has values the set does not know; unbinding copies the
+// set's values to the field so it keeps its options.
+func (ms *MetadataService) SetFieldValueSet(ctx context.Context, objectAPIName, fieldAPIName string, name *string) (*models.FieldMetadata, error) {
+	schema, field, err := ms.getPicklistField(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	fieldID := GenerateFieldID(schema.APIName, field.APIName)
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if name == nil || *name == "" {
+		if field.ValueSet == nil {
+			return field, nil
+		}
+		if err := ms.repo.UpdatePicklistValues(ctx, fieldID, field.Options, field.InactiveOptions); err != nil {
+			return nil, err
+		}
+		if err := ms.repo.SetFieldValueSet(ctx, fieldID, nil); err != nil {
+			return nil, err
+		}
+		field.ValueSet = nil
+		ms.invalidateCacheLocked()
+		return field, nil
+	}
+
+	vs, err := ms.repo.GetGlobalValueSet(ctx, *name)
+	if err != nil {
+		return nil, err
+	}
+	if vs == nil {
+		return nil, errors.NewNotFoundError("Global value set", *name)
+	}
+	if field.ValueSet == nil {
+		if missing := missingFromValueSet(field, vs); len(missing) > 0 {
+			return nil, errors.NewValidationError(constants.FieldSysField_ValueSet,
+				fmt.Sprintf("values not in '%s': %s; replace or remove them first", vs.Name, strings.Join(missing, ", ")))
+		}
+	}
+
+	if err := ms.repo.UpdatePicklistValues(ctx, fieldID, vs.Options, vs.InactiveOptions); err != nil {
+		return nil, err
+	}
+	if err := ms.repo.SetFieldValueSet(ctx, fieldID, &vs.Name); err != nil {
+		return nil, err
+	}
+	ms.invalidateCacheLocked()
+
+	field.ValueSet = &vs.Name
+	field.Options = vs.Options
+	field.InactiveOptions = vs.InactiveOptions
+	return field, nil
+}
+
+// applyGlobalValueSets replaces the options of fields bound to a global value set with the
+// set's values
+func applyGlobalValueSets(schemas []*models.ObjectMetadata, sets []*models.GlobalValueSet) {
+	byName := make(map[string]*models.GlobalValueSet, len(sets))
+	for _, vs := range sets {
+		byName[strings.ToLower(vs.Name)] = vs
+	}
+	for _, schema := range schemas {
+		for i := range schema.Fields {
+			field := &schema.Fields[i]
+			if field.ValueSet == nil {
+				continue
+			}
+			vs := byName[strings.ToLower(*field.ValueSet)]
+			if vs == nil {
+				log.Printf("⚠️ Field %s.%s references unknown value set %s", schema.APIName, field.APIName, *field.ValueSet)
+				continue
+			}
+			field.Options = vs.Options
+			field.InactiveOptions = vs.InactiveOptions
+		}
+	}
+}
+
+// validateValueSetOptions checks that a value set has active values and no blank, duplicate or
+// both active and inactive values
+func validateValueSetOptions(options, inactive []string) error {
+	if len(options) == 0 {
+		return errors.NewValidationError(constants.FieldSysGlobalValueSet_Options, "a value set needs at least one active value")
+	}
+	seen := make(map[string]bool, len(options)+len(inactive))
+	for _, v := range append(append([]string(nil), options...), inactive...) {
+		if strings.TrimSpace(v) == "" {
+			return errors.NewValidationError(constants.FieldSysGlobalValueSet_Options, "values cannot be blank")
+		}
+		if seen[v] {
+			return errors.NewValidationError(constants.FieldSysGlobalValueSet_Options, fmt.Sprintf("'%s' is listed more than once", v))
+		}
+		seen[v] = true
+	}
+	return nil
+}
+
+// missingFromValueSet lists the values of a field that a value set has neither as active nor
+// inactive values
+func missingFromValueSet(field *models.FieldMetadata, vs *models.GlobalValueSet) []string {
+	var missing []string
+	for _, v := range append(append([]string(nil), field.Options...), field.InactiveOptions...) {
+		if !ContainsString(vs.Options, v) && !ContainsString(vs.InactiveOptions, v) {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
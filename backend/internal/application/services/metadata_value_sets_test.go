@@ -0,0 +1,47 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyGlobalValueSets(t *testing.T) {
+	industry := "Industry"
+	unknown := "Missing"
+	schemas := []*models.ObjectMetadata{
+		{APIName: "account", Fields: []models.FieldMetadata{
+			{APIName: "industry", Options: []string{"Stale"}, ValueSet: &industry},
+			{APIName: "rating", Options: []string{"Hot", "Cold"}},
+		}},
+		{APIName: "lead", Fields: []models.FieldMetadata{
+			{APIName: "industry", ValueSet: &unknown, Options: []string{"Kept"}},
+		}},
+	}
+	sets := []*models.GlobalValueSet{
+		{Name: "industry", Options: []string{"Banking", "Retail"}, InactiveOptions: []string{"Telex"}},
+	}
+
+	applyGlobalValueSets(schemas, sets)
+
+	assert.Equal(t, []string{"Banking", "Retail"}, schemas[0].Fields[0].Options, "matched case-insensitively")
+	assert.Equal(t, []string{"Telex"}, schemas[0].Fields[0].InactiveOptions)
+	assert.Equal(t, []string{"Hot", "Cold"}, schemas[0].Fields[1].Options, "unbound field untouched")
+	assert.Equal(t, []string{"Kept"}, schemas[1].Fields[0].Options, "unknown set leaves options alone")
+}
+
+func TestValidateValueSetOptions(t *testing.T) {
+	assert.NoError(t, validateValueSetOptions([]string{"A", "B"}, []string{"C"}))
+	assert.True(t, errors.IsValidation(validateValueSetOptions(nil, []string{"C"})))
+	assert.True(t, errors.IsValidation(validateValueSetOptions([]string{"A", " "}, nil)))
+	assert.True(t, errors.IsValidation(validateValueSetOptions([]string{"A", "B"}, []string{"A"})))
+}
+
+func TestMissingFromValueSet(t *testing.T) {
+	vs := &models.GlobalValueSet{Options: []string{"Hot", "Warm"}, InactiveOptions: []string{"Cold"}}
+	field := &models.FieldMetadata{Options: []string{"Hot", "Frozen"}, InactiveOptions: []string{"Cold", "Tepid"}}
+	assert.Equal(t, []string{"Frozen", "Tepid"}, missingFromValueSet(field, vs))
+	assert.Empty(t, missingFromValueSet(&models.FieldMetadata{Options: []string{"Warm"}}, vs))
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultNLQLimit = 20
+	maxNLQLimit     = 200
+	// maxNLQAttempts bounds how often a rejected translation is sent back for correction
+	maxNLQAttempts = 2
+)
+
+// NLQService answers natural-language questions about CRM data. A translator proposes a
+// query over the objects the user can read; the proposal is validated against the user's
+// effective schema, corrected once if invalid, and run with the user's permissions.
+type NLQService struct {
+	translator  ports.QueryTranslator
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+}
+
+// NewNLQService creates a new NLQService. A nil translator disables natural-language queries.
+func NewNLQService(translator ports.QueryTranslator, metadata *MetadataService, permissions *PermissionService, query *QueryService) *NLQService {
+	return &NLQService{
+		translator:  translator,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+	}
+}
+
+// Ask translates req.Question into a query and runs it as currentUser
+func (s *NLQService) Ask(ctx context.Context, req models.NLQRequest, currentUser *models.UserSession) (*models.NLQResult, error) {
+	if s.translator == nil {
+		return nil, errors.NewValidationError("question", "Natural language queries are not configured (set LLM_BASE_URL)")
+	}
+	question := strings.TrimSpace(req.Question)
+	if question == "" {
+		return nil, errors.NewValidationError("question", "Question is required")
+	}
+
+	objects, err := s.readableObjects(ctx, req.ObjectAPIName, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	var feedback string
+	for attempt := 1; ; attempt++ {
+		translation, err := s.translator.Translate(ctx, question, objects, feedback)
+		if err != nil {
+			return nil, errors.NewInternalError("failed to translate question", err)
+		}
+
+		query, err := validateTranslation(translation, objects, req.Limit)
+		if err != nil {
+			if attempt >= maxNLQAttempts {
+				return nil, errors.NewValidationError("question", "Could not translate the question into a valid query: "+err.Error())
+			}
+			feedback = err.Error()
+			continue
+		}
+
+		records, err := s.query.Query(ctx, query, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		return &models.NLQResult{Query: query, Explanation: translation.Explanation, Records: records}, nil
+	}
+}
+
+// readableObjects returns the effective schemas of the data objects the user can read,
+// or just objectName when the question is restricted to it
+func (s *NLQService) readableObjects(ctx context.Context, objectName string, currentUser *models.UserSession) ([]*models.ObjectMetadata, error) {
+	if objectName != "" {
+		objectName = strings.ToLower(objectName)
+		schema := s.metadata.GetSchema(ctx, objectName)
+		if schema == nil {
+			return nil, errors.NewNotFoundError("Object", objectName)
+		}
+		if !s.permissions.CheckObjectPermissionWithUser(ctx, objectName, constants.PermRead, currentUser) {
+			return nil, errors.NewPermissionError(constants.PermRead, objectName)
+		}
+		return []*models.ObjectMetadata{s.permissions.GetEffectiveSchema(ctx, schema, currentUser)}, nil
+	}
+
+	var objects []*models.ObjectMetadata
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if schema.IsSystem || !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+			continue
+		}
+		objects = append(objects, s.permissions.GetEffectiveSchema(ctx, schema, currentUser))
+	}
+	if len(objects) == 0 {
+		return nil, errors.NewPermissionError(constants.PermRead, "any object")
+	}
+	return objects, nil
+}
+
+// validateTranslation checks a proposed query against the schemas offered to the translator
+// and turns it into a QueryRequest. Errors are phrased as feedback for the translator.
+func validateTranslation(t *ports.QueryTranslation, objects []*models.ObjectMetadata, limit int) (models.QueryRequest, error) {
+	objectName := strings.ToLower(strings.TrimSpace(t.ObjectAPIName))
+	var schema *models.ObjectMetadata
+	for _, obj := range objects {
+		if obj.APIName == objectName {
+			schema = obj
+		}
+	}
+	if schema == nil {
+		return models.QueryRequest{}, fmt.Errorf("object %q is not one of the listed objects", t.ObjectAPIName)
+	}
+
+	filter := strings.TrimSpace(t.FilterExpr)
+	if filter != "" {
+		_, _, err := formula.ToSQLWithResolver(filter, func(ref string) (string, error) {
+			if strings.Contains(ref, ".") {
+				return "", fmt.Errorf("relationship path %s is not supported; filter on %s fields only", ref, objectName)
+			}
+			field := FindField(schema, ref)
+			if field == nil {
+				return "", fmt.Errorf("field %s does not exist on %s", ref, objectName)
+			}
+			return "`" + field.APIName + "`", nil
+		})
+		if err != nil {
+			return models.QueryRequest{}, fmt.Errorf("invalid filter_expr %q: %w", filter, err)
+		}
+	}
+
+	query := models.QueryRequest{ObjectAPIName: objectName, FilterExpr: filter}
+	if t.SortField != "" {
+		field := FindField(schema, t.SortField)
+		if field == nil {
+			return models.QueryRequest{}, fmt.Errorf("sort_field %s does not exist on %s", t.SortField, objectName)
+		}
+		query.SortField = field.APIName
+		query.SortDirection = constants.SortASC
+		if strings.EqualFold(t.SortDirection, constants.SortDESC) {
+			query.SortDirection = constants.SortDESC
+		}
+	}
+
+	// An explicit limit from the caller wins over the translator's
+	if limit <= 0 {
+		limit = t.Limit
+	}
+	if limit <= 0 {
+		limit = defaultNLQLimit
+	}
+	query.Limit = min(limit, maxNLQLimit)
+	return query, nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTranslation(t *testing.T) {
+	objects := []*models.ObjectMetadata{{
+		APIName: "opportunity",
+		Fields: []models.FieldMetadata{
+			{APIName: "name", Type: constants.FieldTypeText},
+			{APIName: "amount", Type: constants.FieldTypeCurrency},
+			{APIName: "stage", Type: constants.FieldTypePicklist},
+		},
+	}}
+
+	t.Run("builds a query", func(t *testing.T) {
+		query, err := validateTranslation(&ports.QueryTranslation{
+			ObjectAPIName: "Opportunity",
+			FilterExpr:    "stage == 'Closed Won' && amount > 10000",
+			SortField:     "amount",
+			SortDirection: "desc",
+			Limit:         5,
+		}, objects, 0)
+		require.NoError(t, err)
+		assert.Equal(t, models.QueryRequest{
+			ObjectAPIName: "opportunity",
+			FilterExpr:    "stage == 'Closed Won' && amount > 10000",
+			SortField:     "amount",
+			SortDirection: constants.SortDESC,
+			Limit:         5,
+		}, query)
+	})
+
+	t.Run("caller limit wins and is capped", func(t *testing.T) {
+		query, err := validateTranslation(&ports.QueryTranslation{ObjectAPIName: "opportunity", Limit: 5}, objects, 1000)
+		require.NoError(t, err)
+		assert.Equal(t, maxNLQLimit, query.Limit)
+
+		query, err = validateTranslation(&ports.QueryTranslation{ObjectAPIName: "opportunity"}, objects, 0)
+		require.NoError(t, err)
+		assert.Equal(t, defaultNLQLimit, query.Limit)
+	})
+
+	t.Run("rejects what the user cannot see", func(t *testing.T) {
+		for name, translation := range map[string]*ports.QueryTranslation{
+			"unknown object":    {ObjectAPIName: "account"},
+			"unknown field":     {ObjectAPIName: "opportunity", FilterExpr: "probability > 50"},
+			"relationship path": {ObjectAPIName: "opportunity", FilterExpr: "account_id.name == 'Acme'"},
+			"syntax error":      {ObjectAPIName: "opportunity", FilterExpr: "amount >"},
+			"unknown sort":      {ObjectAPIName: "opportunity", SortField: "close_date"},
+		} {
+			_, err := validateTranslation(translation, objects, 0)
+			assert.Error(t, err, name)
+		}
+	})
+}
@@ -61,7 +61,7 @@ func (s *NotificationService) CreateNotification(ctx context.Context, notificati
 	// Ensure is_read is false default
 	data[constants.FieldSysNotification_IsRead] = false
 
-	_, err := s.persistence.Insert(ctx, constants.TableNotification, data, user)
+	_, err := s.persistence.Insert(WithSystemTableWrite(ctx), constants.TableNotification, data, user)
 	return err
 }
 
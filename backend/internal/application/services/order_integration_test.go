@@ -0,0 +1,105 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	product := h.CreateObject(t, "product", testharness.Field("name", constants.FieldTypeText),
+		testharness.Field("quantity_on_hand", constants.FieldTypeNumber),
+		testharness.Field("unit_price", constants.FieldTypeCurrency))
+	profile := constants.ProfileStandardUser
+	require.NoError(t, h.Services.Permissions.UpdateObjectPermission(models.SystemObjectPerms{
+		ProfileID: &profile, ObjectAPIName: product.APIName, AllowRead: true,
+	}))
+
+	newService := func(policy string) *services.OrderService {
+		return services.NewOrderService(persistence.NewOrderRepository(h.DB.DB()), persistence.NewRecordRepository(h.DB.DB()),
+			h.Services.Persistence, h.Services.Outbox, h.Services.Metadata, h.Services.QuerySvc, h.Services.Permissions,
+			services.InventoryConfig{ProductObject: product.APIName, QuantityField: "quantity_on_hand", PriceField: "unit_price", NegativeStock: policy})
+	}
+	strict, backorder := newService(constants.NegativeStockReject), newService(constants.NegativeStockBackorder)
+
+	seller := h.CreateUser(t)
+	other := h.CreateUser(t)
+	stocked := func(name string, quantity, price float64) string {
+		record := h.CreateRecord(t, product.APIName, models.SObject{"name": name, "quantity_on_hand": quantity, "unit_price": price, constants.FieldOwnerID: seller.ID})
+		return record[constants.FieldID].(string)
+	}
+	widgetID, gadgetID := stocked("Widget", 10, 5), stocked("Gadget", 1, 20)
+	stock := func(id string) float64 {
+		var quantity float64
+		require.NoError(t, h.DB.DB().QueryRowContext(ctx, "SELECT quantity_on_hand FROM "+product.APIName+" WHERE "+constants.FieldID+" = ?", id).Scan(&quantity))
+		return quantity
+	}
+
+	detail := &models.OrderDetail{
+		Order: &models.SystemOrder{Name: "Restock"},
+		Items: []*models.SystemOrderItem{{ProductID: widgetID, Quantity: 3}, {ProductID: gadgetID, Quantity: 2, UnitPrice: 15}},
+	}
+	require.NoError(t, strict.Create(ctx, detail, seller))
+	orderID := detail.Order.ID
+	t.Cleanup(func() { _ = strict.Delete(ctx, orderID, h.Admin) })
+	assert.Equal(t, constants.OrderStatusDraft, detail.Order.Status)
+	assert.Equal(t, "Widget", *detail.Items[0].ProductName)
+	assert.Equal(t, 5.0, detail.Items[0].UnitPrice, "the product's price applies")
+	assert.Equal(t, 45.0, detail.Order.TotalAmount)
+
+	_, err := strict.Get(ctx, orderID, other)
+	require.Error(t, err, "orders are visible to their owner")
+	_, err = strict.Fulfill(ctx, orderID, seller)
+	require.Error(t, err, "a draft order cannot be fulfilled")
+
+	_, err = strict.Activate(ctx, orderID, seller)
+	require.Error(t, err, "two gadgets are ordered but one is in stock")
+	assert.Equal(t, 10.0, stock(widgetID), "the failed activation rolls back")
+	current, err := strict.Get(ctx, orderID, seller)
+	require.NoError(t, err)
+	assert.Equal(t, constants.OrderStatusDraft, current.Order.Status)
+
+	activated, err := backorder.Activate(ctx, orderID, seller)
+	require.NoError(t, err)
+	assert.Equal(t, constants.OrderStatusActivated, activated.Order.Status)
+	assert.NotNil(t, activated.Order.ActivatedDate)
+	assert.Equal(t, 7.0, stock(widgetID))
+	assert.Equal(t, 0.0, stock(gadgetID))
+	current, err = strict.Get(ctx, orderID, seller)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, current.Items[0].BackorderedQuantity)
+	assert.Equal(t, 1.0, current.Items[1].BackorderedQuantity)
+
+	var events int
+	require.NoError(t, h.DB.DB().QueryRowContext(ctx, "SELECT COUNT(*) FROM "+constants.TableOutboxEvent+" WHERE "+
+		constants.FieldSysOutboxEvent_EventType+" = ?", "order.activated").Scan(&events))
+	assert.Equal(t, 1, events, "activation publishes an event for fulfillment")
+
+	_, err = backorder.Activate(ctx, orderID, seller)
+	require.Error(t, err, "an order is activated once")
+	require.Error(t, strict.Update(ctx, orderID, &models.OrderDetail{Order: &models.SystemOrder{Name: "x"}}, seller),
+		"activated orders are not edited")
+
+	_, err = strict.Cancel(ctx, orderID, seller)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, stock(widgetID))
+	assert.Equal(t, 1.0, stock(gadgetID), "only the stock taken is restored")
+
+	orders, err := strict.List(ctx, seller)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, constants.OrderStatusCancelled, orders[0].Status)
+	require.NoError(t, strict.Delete(ctx, orderID, seller))
+}
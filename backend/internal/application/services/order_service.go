@@ -0,0 +1,587 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultInventoryProductObject is the object whose records order items reference
+	defaultInventoryProductObject = "product"
+	// defaultInventoryQuantityField holds the stock of a product
+	defaultInventoryQuantityField = "quantity_on_hand"
+	// defaultInventoryPriceField gives the unit price of items ordered without one
+	defaultInventoryPriceField = "unit_price"
+)
+
+// inventorySystemContext applies stock changes, which users cannot make on their own
+var inventorySystemContext = &models.UserSession{
+	ID:            "system-inventory",
+	Name:          "Inventory",
+	ProfileID:     constants.ProfileSystemAdmin,
+	IsSystemAdmin: true,
+}
+
+// InventoryConfig names the product object and fields orders draw stock from, and what
+// happens when an order needs more than is in stock
+type InventoryConfig struct {
+	ProductObject string
+	QuantityField string
+	PriceField    string
+	NegativeStock string // One of the constants.NegativeStock* policies
+}
+
+// InventoryConfigFrom applies the inventory settings over the default product object and
+// fields; an unknown negative stock policy is logged and rejects
+func InventoryConfigFrom(settings config.Inventory) InventoryConfig {
+	cfg := InventoryConfig{
+		ProductObject: defaultInventoryProductObject,
+		QuantityField: defaultInventoryQuantityField,
+		PriceField:    defaultInventoryPriceField,
+		NegativeStock: constants.NegativeStockReject,
+	}
+	if object := strings.TrimSpace(settings.ProductObject); object != "" {
+		cfg.ProductObject = strings.ToLower(object)
+	}
+	if field := strings.TrimSpace(settings.QuantityField); field != "" {
+		cfg.QuantityField = strings.ToLower(field)
+	}
+	if field := strings.TrimSpace(settings.PriceField); field != "" {
+		cfg.PriceField = strings.ToLower(field)
+	}
+	if raw := settings.NegativeStock; raw != "" {
+		switch policy := strings.ToLower(strings.TrimSpace(raw)); policy {
+		case constants.NegativeStockReject, constants.NegativeStockAllow, constants.NegativeStockBackorder:
+			cfg.NegativeStock = policy
+		default:
+			log.Printf("⚠️  Invalid INVENTORY_NEGATIVE_STOCK %q, using %s", raw, constants.NegativeStockReject)
+		}
+	}
+	return cfg
+}
+
+// OrderService manages orders of products. Activating an order decrements the stock of its
+// products and cancelling an activated order restores it; each happens in one transaction
+// with the order's status change and an order event for fulfillment integrations, delivered
+// through the outbox.
+type OrderService struct {
+	repo        *persistence.OrderRepository
+	records     *persistence.RecordRepository
+	persistence *PersistenceService
+	outbox      *OutboxService
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	config      InventoryConfig
+}
+
+// NewOrderService creates a new OrderService
+func NewOrderService(
+	repo *persistence.OrderRepository,
+	records *persistence.RecordRepository,
+	persistence *PersistenceService,
+	outbox *OutboxService,
+	metadata *MetadataService,
+	query *QueryService,
+	permissions *PermissionService,
+	config InventoryConfig,
+) *OrderService {
+	return &OrderService{
+		repo:        repo,
+		records:     records,
+		persistence: persistence,
+		outbox:      outbox,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		config:      config,
+	}
+}
+
+// List returns the orders visible to the user: all of them for admins, otherwise their own
+func (s *OrderService) List(ctx context.Context, currentUser *models.UserSession) ([]*models.SystemOrder, error) {
+	if currentUser == nil {
+		return nil, errors.NewUnauthorizedError("User session not found")
+	}
+	if isOrderAdmin(currentUser) {
+		return s.repo.List(ctx, "")
+	}
+	return s.repo.List(ctx, currentUser.ID)
+}
+
+// Get returns an order with its items
+func (s *OrderService) Get(ctx context.Context, id string, currentUser *models.UserSession) (*models.OrderDetail, error) {
+	order, err := s.getOrder(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListItems(ctx, nil, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OrderDetail{Order: order, Items: items}, nil
+}
+
+func (s *OrderService) getOrder(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemOrder, error) {
+	order, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, errors.NewNotFoundError(constants.TableOrder, id)
+	}
+	if err := checkOrderAccess(order, currentUser); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// Create saves a new draft order owned by the current user. detail is updated in place with
+// the stored values.
+func (s *OrderService) Create(ctx context.Context, detail *models.OrderDetail, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if detail.Order == nil {
+		return errors.NewValidationError("order", "order is required")
+	}
+	order := detail.Order
+	order.ID = GenerateID()
+	order.OwnerID = currentUser.ID
+	order.Status = constants.OrderStatusDraft
+	order.ActivatedDate, order.FulfilledDate, order.CancelledDate = nil, nil, nil
+	if err := s.prepare(ctx, detail, currentUser); err != nil {
+		return err
+	}
+	return s.repo.Insert(ctx, order, detail.Items)
+}
+
+// Update replaces the editable fields and the items of a draft order. detail is updated in
+// place with the stored values.
+func (s *OrderService) Update(ctx context.Context, id string, detail *models.OrderDetail, currentUser *models.UserSession) error {
+	existing, err := s.getOrder(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+	if existing.Status != constants.OrderStatusDraft {
+		return errors.NewValidationError(constants.FieldSysOrder_Status, "only draft orders can be edited")
+	}
+	if detail.Order == nil {
+		return errors.NewValidationError("order", "order is required")
+	}
+	updated := *existing
+	updated.Name = detail.Order.Name
+	updated.CustomerObjectAPIName = detail.Order.CustomerObjectAPIName
+	updated.CustomerID = detail.Order.CustomerID
+	updated.Description = detail.Order.Description
+	detail.Order = &updated
+	if err := s.prepare(ctx, detail, currentUser); err != nil {
+		return err
+	}
+	return s.repo.Update(ctx, &updated, detail.Items)
+}
+
+// Delete removes a draft or cancelled order
+func (s *OrderService) Delete(ctx context.Context, id string, currentUser *models.UserSession) error {
+	order, err := s.getOrder(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+	if order.Status != constants.OrderStatusDraft && order.Status != constants.OrderStatusCancelled {
+		return errors.NewValidationError(constants.FieldSysOrder_Status, "only draft or cancelled orders can be deleted")
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// prepare validates an order and its items, checks the user can read its customer and
+// products, and fills in product names, missing unit prices and the totals
+func (s *OrderService) prepare(ctx context.Context, detail *models.OrderDetail, currentUser *models.UserSession) error {
+	if err := normalizeOrder(detail); err != nil {
+		return err
+	}
+	order := detail.Order
+	if order.CustomerObjectAPIName != nil {
+		if _, err := s.readRecords(ctx, *order.CustomerObjectAPIName, []string{*order.CustomerID}, currentUser); err != nil {
+			return err
+		}
+	}
+
+	productIDs := orderProductIDs(detail.Items)
+	products, err := s.readRecords(ctx, s.config.ProductObject, productIDs, currentUser)
+	if err != nil {
+		return err
+	}
+	hasPrice := s.metadata.GetField(s.config.ProductObject, s.config.PriceField) != nil
+	order.TotalAmount = 0
+	for i, item := range detail.Items {
+		product := products[item.ProductID]
+		if name := product.GetString(constants.FieldName); name != "" {
+			item.ProductName = &name
+		}
+		if item.UnitPrice == 0 && hasPrice {
+			if price, ok := inventoryQuantity(product[s.config.PriceField]); ok {
+				item.UnitPrice = price
+			}
+		}
+		item.ID = GenerateID()
+		item.OrderID = order.ID
+		item.BackorderedQuantity = 0
+		item.SortOrder = i
+		item.TotalPrice = roundQuantity(item.Quantity * item.UnitPrice)
+		order.TotalAmount = roundQuantity(order.TotalAmount + item.TotalPrice)
+	}
+	return nil
+}
+
+// readRecords returns the records of an object by ID, failing unless the user can read all of them
+func (s *OrderService) readRecords(ctx context.Context, objectAPIName string, ids []string, currentUser *models.UserSession) (map[string]models.SObject, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object Metadata", objectAPIName)
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, ids, currentUser)
+	if err != nil {
+		return nil, errors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	found := make(map[string]models.SObject, len(records))
+	for _, record := range records {
+		if s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, currentUser) {
+			found[record.GetString(constants.FieldID)] = record
+		}
+	}
+	for _, id := range ids {
+		if found[id] == nil {
+			return nil, errors.NewNotFoundError(schema.APIName, id)
+		}
+	}
+	return found, nil
+}
+
+// Activate moves a draft order to Activated, decrementing the stock of its products
+// according to the negative stock policy
+func (s *OrderService) Activate(ctx context.Context, id string, currentUser *models.UserSession) (*models.OrderDetail, error) {
+	return s.transition(ctx, id, currentUser, func(txCtx context.Context, tx *sql.Tx, detail *models.OrderDetail) (events.EventType, error) {
+		if detail.Order.Status != constants.OrderStatusDraft {
+			return "", errors.NewValidationError(constants.FieldSysOrder_Status, "only draft orders can be activated")
+		}
+		if len(detail.Items) == 0 {
+			return "", errors.NewValidationError("items", "order has no items")
+		}
+		if err := s.decrementStock(txCtx, tx, detail.Items); err != nil {
+			return "", err
+		}
+		now := time.Now()
+		detail.Order.Status = constants.OrderStatusActivated
+		detail.Order.ActivatedDate = &now
+		return events.OrderActivated, nil
+	})
+}
+
+// Fulfill moves an activated order to Fulfilled
+func (s *OrderService) Fulfill(ctx context.Context, id string, currentUser *models.UserSession) (*models.OrderDetail, error) {
+	return s.transition(ctx, id, currentUser, func(txCtx context.Context, tx *sql.Tx, detail *models.OrderDetail) (events.EventType, error) {
+		if detail.Order.Status != constants.OrderStatusActivated {
+			return "", errors.NewValidationError(constants.FieldSysOrder_Status, "only activated orders can be fulfilled")
+		}
+		now := time.Now()
+		detail.Order.Status = constants.OrderStatusFulfilled
+		detail.Order.FulfilledDate = &now
+		return events.OrderFulfilled, nil
+	})
+}
+
+// Cancel moves a draft or activated order to Cancelled. Cancelling an activated order
+// restores the stock it took; backordered quantities were never taken.
+func (s *OrderService) Cancel(ctx context.Context, id string, currentUser *models.UserSession) (*models.OrderDetail, error) {
+	return s.transition(ctx, id, currentUser, func(txCtx context.Context, tx *sql.Tx, detail *models.OrderDetail) (events.EventType, error) {
+		var eventType events.EventType
+		switch detail.Order.Status {
+		case constants.OrderStatusDraft:
+		case constants.OrderStatusActivated:
+			if err := s.restoreStock(txCtx, tx, detail.Items); err != nil {
+				return "", err
+			}
+			eventType = events.OrderCancelled
+		default:
+			return "", errors.NewValidationError(constants.FieldSysOrder_Status, "only draft or activated orders can be cancelled")
+		}
+		now := time.Now()
+		detail.Order.Status = constants.OrderStatusCancelled
+		detail.Order.CancelledDate = &now
+		return eventType, nil
+	})
+}
+
+// transition locks an order, applies a status change and stores it, and enqueues the
+// change's event, all in one transaction. apply returns an empty event type when the
+// change publishes none.
+func (s *OrderService) transition(
+	ctx context.Context,
+	id string,
+	currentUser *models.UserSession,
+	apply func(txCtx context.Context, tx *sql.Tx, detail *models.OrderDetail) (events.EventType, error),
+) (*models.OrderDetail, error) {
+	var detail *models.OrderDetail
+	err := s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		order, err := s.repo.GetLock(txCtx, tx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return errors.NewNotFoundError(constants.TableOrder, id)
+		}
+		if err := checkOrderAccess(order, currentUser); err != nil {
+			return err
+		}
+		items, err := s.repo.ListItems(txCtx, tx, id)
+		if err != nil {
+			return err
+		}
+		detail = &models.OrderDetail{Order: order, Items: items}
+
+		eventType, err := apply(txCtx, tx, detail)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.SetStatus(txCtx, tx, order); err != nil {
+			return err
+		}
+		if eventType == "" {
+			return nil
+		}
+		return s.outbox.EnqueueEventTx(txCtx, tx, eventType, RecordEventPayload{
+			ObjectAPIName: constants.TableOrder,
+			Record:        detail.ToSObject(),
+			CurrentUser:   currentUser,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// decrementStock takes the ordered quantities from the stock of each product, locking the
+// products in ID order so concurrent activations cannot deadlock
+func (s *OrderService) decrementStock(ctx context.Context, tx *sql.Tx, items []*models.SystemOrderItem) error {
+	for _, productID := range orderProductIDs(items) {
+		product, stock, err := s.lockStock(ctx, tx, productID)
+		if err != nil {
+			return err
+		}
+		var productItems []*models.SystemOrderItem
+		var quantities []float64
+		for _, item := range items {
+			if item.ProductID == productID {
+				productItems = append(productItems, item)
+				quantities = append(quantities, item.Quantity)
+			}
+		}
+		newStock, backordered, ok := allocateStock(stock, quantities, s.config.NegativeStock)
+		if !ok {
+			name := product.GetString(constants.FieldName)
+			if name == "" {
+				name = productID
+			}
+			return errors.NewValidationError(s.config.QuantityField,
+				fmt.Sprintf("insufficient stock for %s: %s available, %s ordered", name,
+					formatQuantity(stock), formatQuantity(sumQuantities(quantities))))
+		}
+		for i, item := range productItems {
+			if backordered[i] == 0 {
+				continue
+			}
+			item.BackorderedQuantity = backordered[i]
+			if err := s.repo.SetBackordered(ctx, tx, item); err != nil {
+				return err
+			}
+		}
+		if err := s.setStock(ctx, productID, stock, newStock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreStock returns the quantities an activated order took to the stock of its products
+func (s *OrderService) restoreStock(ctx context.Context, tx *sql.Tx, items []*models.SystemOrderItem) error {
+	for _, productID := range orderProductIDs(items) {
+		taken := 0.0
+		for _, item := range items {
+			if item.ProductID == productID {
+				taken += item.Quantity - item.BackorderedQuantity
+			}
+		}
+		if taken == 0 {
+			continue
+		}
+		_, stock, err := s.lockStock(ctx, tx, productID)
+		if err != nil {
+			return err
+		}
+		if err := s.setStock(ctx, productID, stock, roundQuantity(stock+taken)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockStock locks a product for the rest of the transaction and returns it with its stock
+func (s *OrderService) lockStock(ctx context.Context, tx *sql.Tx, productID string) (models.SObject, float64, error) {
+	product, err := s.records.GetLock(ctx, tx, s.config.ProductObject, productID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to lock %s %s: %w", s.config.ProductObject, productID, err)
+	}
+	if product == nil {
+		return nil, 0, errors.NewNotFoundError(s.config.ProductObject, productID)
+	}
+	stock, _ := inventoryQuantity(product[s.config.QuantityField])
+	return product, stock, nil
+}
+
+// setStock saves a product's new stock through the persistence pipeline, so the change is
+// audited and publishes a record update like any other
+func (s *OrderService) setStock(ctx context.Context, productID string, oldStock, newStock float64) error {
+	if newStock == oldStock {
+		return nil
+	}
+	return s.persistence.Update(ctx, s.config.ProductObject, productID,
+		models.SObject{s.config.QuantityField: newStock}, inventorySystemContext)
+}
+
+// allocateStock takes quantities from stock in order under a negative stock policy. It
+// returns the remaining stock and the part of each quantity that is backordered, or false
+// when the reject policy refuses a shortfall.
+func allocateStock(stock float64, quantities []float64, policy string) (float64, []float64, bool) {
+	backordered := make([]float64, len(quantities))
+	total := sumQuantities(quantities)
+	switch policy {
+	case constants.NegativeStockAllow:
+		return roundQuantity(stock - total), backordered, true
+	case constants.NegativeStockBackorder:
+		available := math.Max(stock, 0)
+		taken := 0.0
+		for i, quantity := range quantities {
+			take := math.Min(quantity, available)
+			available -= take
+			taken += take
+			backordered[i] = roundQuantity(quantity - take)
+		}
+		return roundQuantity(stock - taken), backordered, true
+	default:
+		if total > stock {
+			return stock, backordered, false
+		}
+		return roundQuantity(stock - total), backordered, true
+	}
+}
+
+// normalizeOrder validates an order and its items
+func normalizeOrder(detail *models.OrderDetail) error {
+	order := detail.Order
+	order.Name = strings.TrimSpace(order.Name)
+	if order.Name == "" {
+		return errors.NewValidationError(constants.FieldSysOrder_Name, "name is required")
+	}
+	if (order.CustomerObjectAPIName == nil) != (order.CustomerID == nil) {
+		return errors.NewValidationError(constants.FieldSysOrder_CustomerID, "customer object and customer ID must be given together")
+	}
+	if order.CustomerObjectAPIName != nil {
+		object := strings.ToLower(strings.TrimSpace(*order.CustomerObjectAPIName))
+		order.CustomerObjectAPIName = &object
+	}
+	for i, item := range detail.Items {
+		if item == nil || strings.TrimSpace(item.ProductID) == "" {
+			return errors.NewValidationError(constants.FieldSysOrderItem_ProductID, fmt.Sprintf("item %d has no product", i+1))
+		}
+		item.ProductID = strings.TrimSpace(item.ProductID)
+		if item.Quantity <= 0 {
+			return errors.NewValidationError(constants.FieldSysOrderItem_Quantity, fmt.Sprintf("item %d must have a positive quantity", i+1))
+		}
+		if item.UnitPrice < 0 {
+			return errors.NewValidationError(constants.FieldSysOrderItem_UnitPrice, fmt.Sprintf("item %d has a negative unit price", i+1))
+		}
+		item.Quantity = roundQuantity(item.Quantity)
+	}
+	return nil
+}
+
+// orderProductIDs returns the distinct products of order items in ID order
+func orderProductIDs(items []*models.SystemOrderItem) []string {
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+	for _, item := range items {
+		if !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			ids = append(ids, item.ProductID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func checkOrderAccess(order *models.SystemOrder, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if isOrderAdmin(currentUser) || order.OwnerID == currentUser.ID {
+		return nil
+	}
+	return errors.NewNotFoundError(constants.TableOrder, order.ID)
+}
+
+func isOrderAdmin(user *models.UserSession) bool {
+	return user.IsSystemAdmin || constants.IsSuperUser(user.ProfileID)
+}
+
+// inventoryQuantity converts a stored numeric value; DECIMAL columns scan as strings
+func inventoryQuantity(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case []byte:
+		f, err := strconv.ParseFloat(string(n), 64)
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// roundQuantity rounds to the two decimals quantities and amounts are stored with
+func roundQuantity(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+func sumQuantities(quantities []float64) float64 {
+	total := 0.0
+	for _, quantity := range quantities {
+		total += quantity
+	}
+	return roundQuantity(total)
+}
+
+func formatQuantity(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateStock(t *testing.T) {
+	stock, backordered, ok := allocateStock(5, []float64{2, 2}, constants.NegativeStockReject)
+	require.True(t, ok)
+	assert.Equal(t, 1.0, stock)
+	assert.Equal(t, []float64{0, 0}, backordered)
+
+	_, _, ok = allocateStock(3, []float64{2, 2}, constants.NegativeStockReject)
+	assert.False(t, ok)
+
+	stock, backordered, ok = allocateStock(3, []float64{2, 2}, constants.NegativeStockAllow)
+	require.True(t, ok)
+	assert.Equal(t, -1.0, stock)
+	assert.Equal(t, []float64{0, 0}, backordered)
+
+	stock, backordered, ok = allocateStock(3, []float64{2, 2.5}, constants.NegativeStockBackorder)
+	require.True(t, ok)
+	assert.Equal(t, 0.0, stock)
+	assert.Equal(t, []float64{0, 1.5}, backordered, "later items are backordered first")
+
+	stock, backordered, ok = allocateStock(-2, []float64{1}, constants.NegativeStockBackorder)
+	require.True(t, ok)
+	assert.Equal(t, -2.0, stock, "negative stock is left alone")
+	assert.Equal(t, []float64{1}, backordered)
+}
+
+func TestNormalizeOrder(t *testing.T) {
+	account := " Account "
+	detail := &models.OrderDetail{
+		Order: &models.SystemOrder{Name: " Q4 restock ", CustomerObjectAPIName: &account, CustomerID: new(string)},
+		Items: []*models.SystemOrderItem{{ProductID: " p1 ", Quantity: 1.004}},
+	}
+	require.NoError(t, normalizeOrder(detail))
+	assert.Equal(t, "Q4 restock", detail.Order.Name)
+	assert.Equal(t, "account", *detail.Order.CustomerObjectAPIName)
+	assert.Equal(t, "p1", detail.Items[0].ProductID)
+	assert.Equal(t, 1.0, detail.Items[0].Quantity)
+
+	for name, invalid := range map[string]*models.OrderDetail{
+		"name":     {Order: &models.SystemOrder{Name: " "}},
+		"customer": {Order: &models.SystemOrder{Name: "x", CustomerObjectAPIName: &account}},
+		"product":  {Order: &models.SystemOrder{Name: "x"}, Items: []*models.SystemOrderItem{{Quantity: 1}}},
+		"quantity": {Order: &models.SystemOrder{Name: "x"}, Items: []*models.SystemOrderItem{{ProductID: "p", Quantity: 0}}},
+		"price":    {Order: &models.SystemOrder{Name: "x"}, Items: []*models.SystemOrderItem{{ProductID: "p", Quantity: 1, UnitPrice: -1}}},
+	} {
+		assert.Error(t, normalizeOrder(invalid), name)
+	}
+}
+
+func TestOrderProductIDs(t *testing.T) {
+	items := []*models.SystemOrderItem{{ProductID: "b"}, {ProductID: "a"}, {ProductID: "b"}}
+	assert.Equal(t, []string{"a", "b"}, orderProductIDs(items))
+}
+
+func TestInventoryQuantity(t *testing.T) {
+	for _, v := range []interface{}{int64(4), 4, 4.0, float32(4), "4.00", []byte("4")} {
+		f, ok := inventoryQuantity(v)
+		assert.True(t, ok)
+		assert.Equal(t, 4.0, f)
+	}
+	_, ok := inventoryQuantity(nil)
+	assert.False(t, ok)
+}
+
+func TestInventoryConfigFrom(t *testing.T) {
+	cfg := InventoryConfigFrom(config.Inventory{})
+	assert.Equal(t, InventoryConfig{ProductObject: "product", QuantityField: "quantity_on_hand", PriceField: "unit_price", NegativeStock: constants.NegativeStockReject}, cfg)
+
+	cfg = InventoryConfigFrom(config.Inventory{ProductObject: " Widget ", NegativeStock: "Backorder"})
+	assert.Equal(t, "widget", cfg.ProductObject)
+	assert.Equal(t, constants.NegativeStockBackorder, cfg.NegativeStock)
+
+	cfg = InventoryConfigFrom(config.Inventory{NegativeStock: "sometimes"})
+	assert.Equal(t, constants.NegativeStockReject, cfg.NegativeStock)
+}
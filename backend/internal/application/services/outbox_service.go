@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
 )
 
 // OutboxEvent status constants
@@ -23,12 +25,16 @@ const (
 
 // OutboxService handles transactional event storage and async publishing.
 // It implements the Outbox Pattern for guaranteed event delivery.
-// OutboxService handles transactional event storage and async publishing.
-// It implements the Outbox Pattern for guaranteed event delivery.
+//
+// Without a transport, the worker hands events straight to the in-process EventBus. With
+// one (Kafka or NATS), the worker publishes to the broker and every node consumes the
+// broker as a member of one consumer group, so each event is handled once per deployment.
 type OutboxService struct {
 	repo      *persistence.OutboxRepository
 	eventBus  *EventBus
 	txManager *persistence.TransactionManager
+	transport ports.EventBusPort // nil for the in-process bus
+	group     string             // Consumer group of the transport
 
 	// Worker control
 	stopCh   chan struct{}
@@ -46,6 +52,12 @@ func NewOutboxService(repo *persistence.OutboxRepository, eventBus *EventBus, tx
 	}
 }
 
+// SetTransport routes outbox events through an external event bus, consumed as the given group
+func (os *OutboxService) SetTransport(transport ports.EventBusPort, group string) {
+	os.transport = transport
+	os.group = group
+}
+
 // EnqueueEvent stores an event in the outbox table within the current transaction.
 // This ensures the event is persisted atomically with the business operation.
 func (os *OutboxService) EnqueueEvent(ctx context.Context, eventType events.EventType, payload RecordEventPayload) error {
@@ -88,6 +100,11 @@ func (os *OutboxService) enqueueDirect(ctx context.Context, eventType events.Eve
 // StartWorker starts the background worker that processes pending outbox events.
 // The worker polls with the specified interval.
 func (os *OutboxService) StartWorker(interval time.Duration) {
+	if os.transport != nil {
+		os.wg.Add(1)
+		go os.consumeTransport()
+	}
+
 	os.wg.Add(1)
 	go func() {
 		defer os.wg.Done()
@@ -117,6 +134,11 @@ func (os *OutboxService) StopWorker() {
 		close(os.stopCh)
 	})
 	os.wg.Wait()
+	if os.transport != nil {
+		if err := os.transport.Close(); err != nil {
+			log.Printf("⚠️ Failed to close event bus: %v", err)
+		}
+	}
 	log.Printf("📤 Outbox worker stopped")
 }
 
@@ -166,8 +188,8 @@ func (os *OutboxService) processEventAtomic(ctx context.Context, id, eventType,
 			return nil // Commit the failure status
 		}
 
-		// Publish via EventBus
-		if err := os.eventBus.Publish(ctx, events.EventType(eventType), payload); err != nil {
+		// Publish via the transport, or the EventBus when running in-process
+		if err := os.dispatch(ctx, id, events.EventType(eventType), payloadJSON, payload); err != nil {
 			// Increment retry count
 			newRetryCount := retryCount + 1
 			if newRetryCount >= MaxRetryAttempts {
@@ -195,6 +217,58 @@ func (os *OutboxService) processEventAtomic(ctx context.Context, id, eventType,
 	})
 }
 
+// dispatch delivers a claimed event. A transport must acknowledge the event before it is
+// marked processed, which gives at-least-once delivery to the broker.
+func (os *OutboxService) dispatch(ctx context.Context, id string, eventType events.EventType, payloadJSON string, payload RecordEventPayload) error {
+	if os.transport == nil {
+		return os.eventBus.Publish(ctx, eventType, payload)
+	}
+	recordID, _ := payload.Record[constants.FieldID].(string)
+	return os.transport.Publish(ctx, ports.EventMessage{
+		ID:      id,
+		Type:    eventType,
+		Key:     payload.ObjectAPIName + "/" + recordID,
+		Payload: []byte(payloadJSON),
+	})
+}
+
+// consumeTransport hands events from the transport to the in-process EventBus handlers
+// until the worker stops, reconnecting after broker errors
+func (os *OutboxService) consumeTransport() {
+	defer os.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-os.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.Printf("📥 Event bus consumer started (group %s)", os.group)
+	for {
+		err := os.transport.Consume(ctx, os.group, func(ctx context.Context, msg ports.EventMessage) error {
+			var payload RecordEventPayload
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				log.Printf("❌ [Outbox] Dropping event %s with invalid payload: %v", msg.ID, err)
+				return nil // Redelivery cannot fix it
+			}
+			return os.eventBus.Publish(ctx, msg.Type, payload)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("⚠️ [Outbox] Event bus consumer stopped, retrying in 5s: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
 // CleanupProcessed removes old processed events from the outbox.
 // This should be called periodically (e.g., daily) to prevent table bloat.
 func (os *OutboxService) CleanupProcessed(ctx context.Context, olderThan time.Duration) (int64, error) {
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransport records published messages and delivers its inbox to the consumer
+type fakeTransport struct {
+	published []ports.EventMessage
+	inbox     []ports.EventMessage
+	results   chan error
+	group     string
+	closed    bool
+}
+
+func (f *fakeTransport) Publish(_ context.Context, msg ports.EventMessage) error {
+	f.published = append(f.published, msg)
+	return nil
+}
+
+func (f *fakeTransport) Consume(ctx context.Context, group string, handle ports.EventMessageHandler) error {
+	f.group = group
+	for _, msg := range f.inbox {
+		f.results <- handle(ctx, msg)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeTransport) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestOutboxDispatch_PublishesToTransportKeyedByRecord(t *testing.T) {
+	transport := &fakeTransport{}
+	svc := NewOutboxService(nil, NewEventBus(), nil)
+	svc.SetTransport(transport, "crm")
+
+	payload := RecordEventPayload{ObjectAPIName: "account", Record: models.SObject{constants.FieldID: "a1"}}
+	payloadJSON, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.dispatch(context.Background(), "evt-1", events.RecordUpdated, string(payloadJSON), payload))
+	require.Len(t, transport.published, 1)
+	msg := transport.published[0]
+	assert.Equal(t, "evt-1", msg.ID)
+	assert.Equal(t, events.RecordUpdated, msg.Type)
+	assert.Equal(t, "account/a1", msg.Key)
+	assert.JSONEq(t, string(payloadJSON), string(msg.Payload))
+}
+
+func TestOutboxConsumer_HandsTransportEventsToEventBus(t *testing.T) {
+	bus := NewEventBus()
+	received := make(chan RecordEventPayload, 1)
+	bus.Subscribe(events.RecordCreated, func(_ context.Context, payload interface{}) error {
+		received <- payload.(RecordEventPayload)
+		return nil
+	})
+
+	transport := &fakeTransport{
+		inbox: []ports.EventMessage{
+			{ID: "bad", Type: events.RecordCreated, Payload: []byte("{")},
+			{ID: "evt-2", Type: events.RecordCreated, Payload: []byte(`{"object_api_name": "lead", "record": {"__sys_gen_id": "l1"}}`)},
+		},
+		results: make(chan error, 2),
+	}
+	svc := NewOutboxService(nil, bus, nil)
+	svc.SetTransport(transport, "crm")
+
+	svc.wg.Add(1)
+	go svc.consumeTransport()
+
+	// An undecodable payload is acknowledged rather than redelivered forever
+	assert.NoError(t, <-transport.results)
+	assert.NoError(t, <-transport.results)
+	select {
+	case payload := <-received:
+		assert.Equal(t, "lead", payload.ObjectAPIName)
+		assert.Equal(t, "l1", payload.Record[constants.FieldID])
+	case <-time.After(time.Second):
+		t.Fatal("event was not handed to the event bus")
+	}
+
+	svc.StopWorker()
+	assert.Equal(t, "crm", transport.group)
+	assert.True(t, transport.closed)
+}
@@ -105,6 +105,11 @@ func (ps *PermissionService) CheckRecordAccess(ctx context.Context, schema *mode
 	return false
 }
 
+// RevokeManualShares removes the manual shares of the given records, as when they change owner
+func (ps *PermissionService) RevokeManualShares(ctx context.Context, objectAPIName string, recordIDs []string) (int, error) {
+	return ps.repo.RevokeManualShares(ctx, objectAPIName, recordIDs)
+}
+
 // checkManualShareAccess checks if user has access via manual record share
 func (ps *PermissionService) checkManualShareAccess(ctx context.Context, objectAPIName, recordID string, user *models.UserSession, operation string) bool {
 	// Check direct user share and group share via repository
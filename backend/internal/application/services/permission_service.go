@@ -116,6 +116,25 @@ func (ps *PermissionService) CheckPermissionOrErrorWithUser(ctx context.Context,
 	return nil
 }
 
+// CheckSystemPermissionWithUser checks if a user holds a system permission (constants.PermBypass*)
+// through their profile or a permission set
+func (ps *PermissionService) CheckSystemPermissionWithUser(ctx context.Context, permission string, user *models.UserSession) bool {
+	if user == nil {
+		return false
+	}
+
+	// SuperUser bypass
+	if user.IsSystemAdmin || constants.IsSuperUser(user.ProfileID) {
+		return true
+	}
+
+	granted, err := ps.repo.HasSystemPermission(ctx, user, permission)
+	if err != nil {
+		return false
+	}
+	return granted
+}
+
 // Record-level access functions are in permission_record_access.go:
 // - CheckRecordAccess, checkManualShareAccess
 // - checkTeamMemberAccess, accessLevelAllowsOperation
@@ -177,6 +196,37 @@ func (ps *PermissionService) CheckFieldVisibilityWithUser(ctx context.Context, o
 	return ps.CheckObjectPermissionWithUser(ctx, objectAPIName, constants.PermRead, user)
 }
 
+// SessionForUser builds the session used to evaluate permissions on behalf of another user,
+// e.g. for a dashboard that runs as a specified user
+func (ps *PermissionService) SessionForUser(ctx context.Context, userID string) (*models.UserSession, error) {
+	user, err := ps.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	if user == nil {
+		return nil, errors.NewNotFoundError("User", userID)
+	}
+
+	roleID, err := ps.userRepo.GetUserRoleID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role: %w", err)
+	}
+
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if name == "" {
+		name = user.Username
+	}
+	return &models.UserSession{
+		ID:            user.ID,
+		Name:          name,
+		Email:         &user.Email,
+		ProfileID:     user.ProfileID,
+		RoleID:        roleID,
+		IsSystemAdmin: user.UserType != constants.UserTypePortal && constants.IsSuperUser(user.ProfileID),
+		UserType:      user.UserType,
+	}, nil
+}
+
 // RefreshPermissions reloads permissions from the database
 // This specifically refreshes the Role Hierarchy cache.
 // Object/Field permissions are not cached (checked per-request), so they don't need refreshing.
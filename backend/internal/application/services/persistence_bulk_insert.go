@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/shared/pkg/constants"
@@ -22,11 +21,7 @@ type BulkInsertOptions struct {
 }
 
 // BulkInsertResult contains the result of a bulk insert operation
-type BulkInsertResult struct {
-	SuccessCount int      `json:"success_count"`
-	FailedCount  int      `json:"failed_count"`
-	Errors       []string `json:"errors,omitempty"`
-}
+type BulkInsertResult = models.BulkResult
 
 // BulkInsert creates multiple records in a single transaction
 func (ps *PersistenceService) BulkInsert(
@@ -65,13 +60,51 @@ func (ps *PersistenceService) BulkInsert(
 	}
 
 	// 2. Get validation rules (once, cached)
-	validationRules := ps.metadata.GetValidationRules(ctx, objectName)
+	validationRules := ps.validationRules(ctx, objectName)
+
+	// Record types available to the user (loaded once for the batch)
+	hasRecordTypes := FindField(schema, constants.FieldRecordTypeID) != nil
+	var availableRecordTypes []*models.RecordType
+	var defaultRecordType *models.RecordType
+	if hasRecordTypes {
+		availableRecordTypes, defaultRecordType, err = ps.metadata.GetAvailableRecordTypes(ctx, objectName, currentUser)
+		if err != nil {
+			return result, fmt.Errorf("failed to load record types: %w", err)
+		}
+	}
 
 	// 3. Pre-flight validation and preparation
 	preparedRecords := make([]models.SObject, 0, len(records))
 	for i, record := range records {
 		// Apply defaults (and Generate System Fields logic - respecting input Audit fields)
-		prepared := ps.applyDefaults(ctx, record, schema, currentUser)
+		prepared, err := ps.applyDefaults(ctx, record, schema, currentUser)
+		if err != nil {
+			result.FailedCount++
+			result.Errors = append(result.Errors, fmt.Sprintf("record %d: %v", i, err))
+			continue
+		}
+
+		// Resolve record type and enforce its picklist values
+		if hasRecordTypes {
+			requested, _ := prepared[constants.FieldRecordTypeID].(string)
+			rt, err := pickRecordType(availableRecordTypes, defaultRecordType, requested, objectName)
+			if err == nil && rt != nil {
+				prepared[constants.FieldRecordTypeID] = rt.ID
+				err = validateRecordTypePicklists(rt, prepared)
+			}
+			if err != nil {
+				result.FailedCount++
+				result.Errors = append(result.Errors, fmt.Sprintf("record %d: %v", i, err))
+				continue
+			}
+		}
+
+		// Validate locations and derive their coordinate columns
+		if err := applyGeolocations(schema, prepared); err != nil {
+			result.FailedCount++
+			result.Errors = append(result.Errors, fmt.Sprintf("record %d: %v", i, err))
+			continue
+		}
 
 		// Validate polymorphic lookups if not skipped
 		if !options.SkipValidation {
@@ -85,6 +118,20 @@ func (ps *PersistenceService) BulkInsert(
 				prepared[GetPolymorphicTypeColumnName(fieldName)] = objType
 			}
 
+			// Enforce active and dependent picklist values and state machines
+			err = validateActivePicklistValues(schema, prepared)
+			if err == nil {
+				err = validateFieldDependencies(schema, prepared, nil)
+			}
+			if err == nil {
+				err = ps.checkStateMachines(ctx, schema, nil, prepared, currentUser)
+			}
+			if err != nil {
+				result.FailedCount++
+				result.Errors = append(result.Errors, fmt.Sprintf("record %d: %v", i, err))
+				continue
+			}
+
 			// Validate static rules
 			if err := ps.validator.ValidateRecord(prepared, schema, validationRules, nil); err != nil {
 				result.FailedCount++
@@ -178,6 +225,20 @@ func (ps *PersistenceService) BulkInsert(
 		// NOTE: Rollups are skipped for bulk insert for performance.
 		// If rollups are needed, use single Insert or run a separate rollup recalculation job.
 
+		if ps.cdc != nil {
+			changes := make([]RecordChange, len(preparedRecords))
+			for i, record := range preparedRecords {
+				changes[i] = RecordChange{
+					Operation:     constants.ChangeOperationCreate,
+					ObjectAPIName: objectName,
+					After:         record,
+				}
+			}
+			if err := ps.cdc.CaptureTx(txCtx, tx, currentUser, changes...); err != nil {
+				return fmt.Errorf("failed to capture record changes: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -203,45 +264,15 @@ func (ps *PersistenceService) generateAutoNumbersBatch(ctx context.Context, tx *
 	}
 
 	for _, an := range autoNumbers {
-		// 1. Lock the auto-number record
-		autoNumberRecord, err := ps.repo.GetLock(ctx, tx, constants.TableAutoNumber, an.ID)
+		// Reserve a block of numbers with a single update
+		last, err := ps.reserveAutoNumbers(ctx, tx, an, len(records))
 		if err != nil {
-			return fmt.Errorf("failed to lock auto-number %s: %w", an.ID, err)
-		}
-
-		var currentValue int
-		if autoNumberRecord != nil {
-			if val, ok := autoNumberRecord[constants.FieldSysAutoNumber_CurrentNumber]; ok && val != nil {
-				// Handle potential float64 from JSON/DB driver
-				switch v := val.(type) {
-				case int64:
-					currentValue = int(v)
-				case float64:
-					currentValue = int(v)
-				case int:
-					currentValue = v
-				}
-			}
-		}
-
-		count := len(records)
-		newValue := currentValue + count
-
-		// 2. Update DB with new max value (single update)
-		anUpdate := models.SObject{
-			constants.FieldSysAutoNumber_CurrentNumber: newValue,
-			constants.FieldLastModifiedDate:            time.Now().UTC(),
-		}
-
-		if err := ps.repo.Update(ctx, tx, constants.TableAutoNumber, an.ID, anUpdate); err != nil {
-			return fmt.Errorf("failed to update auto-number %s: %w", an.ID, err)
+			return err
 		}
 
-		// 3. Assign sequential values to records in memory
-		for i := 0; i < count; i++ {
-			num := currentValue + i + 1
-			formatted := ps.formatAutoNumber(an.DisplayFormat, num)
-			records[i][an.FieldAPIName] = formatted
+		// Assign sequential values to records in memory
+		for i, record := range records {
+			record[an.FieldAPIName] = formatAutoNumber(an.DisplayFormat, last+i+1)
 		}
 	}
 	return nil
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// BulkWriteOptions configures bulk update and delete
+type BulkWriteOptions struct {
+	// AllOrNone fails the whole request, keeping no changes, when any record fails.
+	// Otherwise failing records are reported and the others are kept.
+	AllOrNone bool
+}
+
+// BulkUpdate applies the updates in one transaction, each inside its own savepoint, so a
+// record that fails validation or access checks rolls back alone unless AllOrNone is set.
+// Every update must carry the record ID.
+func (ps *PersistenceService) BulkUpdate(
+	ctx context.Context,
+	objectName string,
+	updates []models.SObject,
+	currentUser *models.UserSession,
+	options BulkWriteOptions,
+) (models.BulkResult, error) {
+	// Fail the whole request up front when the object itself cannot be edited
+	if _, err := ps.prepareOperation(ctx, objectName, constants.PermEdit, currentUser); err != nil {
+		return models.BulkResult{}, err
+	}
+
+	result, err := ps.bulkWrite(ctx, len(updates), options, func(ctx context.Context, i int) (string, error) {
+		id, _ := updates[i][constants.FieldID].(string)
+		if id == "" {
+			return fmt.Sprintf("record %d", i), fmt.Errorf("%s is required", constants.FieldID)
+		}
+
+		fields := make(models.SObject, len(updates[i]))
+		for k, v := range updates[i] {
+			if k != constants.FieldID {
+				fields[k] = v
+			}
+		}
+		return "record " + id, ps.Update(ctx, objectName, id, fields, currentUser)
+	})
+	if err != nil {
+		return result, err
+	}
+
+	log.Printf("✨ Bulk updated %d/%d records in %s (User: %s)", result.SuccessCount, len(updates), objectName, getUserID(currentUser))
+	return result, nil
+}
+
+// BulkDelete deletes the records in one transaction, each inside its own savepoint,
+// reporting the ones that could not be deleted unless AllOrNone is set
+func (ps *PersistenceService) BulkDelete(
+	ctx context.Context,
+	objectName string,
+	ids []string,
+	currentUser *models.UserSession,
+	options BulkWriteOptions,
+) (models.BulkResult, error) {
+	if _, err := ps.prepareOperation(ctx, objectName, constants.PermDelete, currentUser); err != nil {
+		return models.BulkResult{}, err
+	}
+
+	result, err := ps.bulkWrite(ctx, len(ids), options, func(ctx context.Context, i int) (string, error) {
+		return "record " + ids[i], ps.Delete(ctx, objectName, ids[i], currentUser)
+	})
+	if err != nil {
+		return result, err
+	}
+
+	log.Printf("🗑️ Bulk deleted %d/%d records in %s (User: %s)", result.SuccessCount, len(ids), objectName, getUserID(currentUser))
+	return result, nil
+}
+
+// bulkWrite runs write for each of n records in one transaction, rolling a failing record
+// back to its savepoint. write returns a label naming the record in errors.
+func (ps *PersistenceService) bulkWrite(
+	ctx context.Context,
+	n int,
+	options BulkWriteOptions,
+	write func(ctx context.Context, i int) (string, error),
+) (models.BulkResult, error) {
+	var result models.BulkResult
+	err := ps.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		result = models.BulkResult{} // A deadlock retry starts over
+		for i := 0; i < n; i++ {
+			var label string
+			err := ps.txManager.WithSavepoint(txCtx, func(spCtx context.Context) error {
+				var err error
+				label, err = write(spCtx, i)
+				return err
+			})
+			if err != nil {
+				if options.AllOrNone {
+					return fmt.Errorf("%s: %w", label, err)
+				}
+				result.FailedCount++
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", label, err))
+				continue
+			}
+			result.SuccessCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return models.BulkResult{}, err
+	}
+	return result, nil
+}
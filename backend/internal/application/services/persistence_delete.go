@@ -127,11 +127,23 @@ func (ps *PersistenceService) Delete(
 				ObjectAPIName: objectName,
 				Record:        record,
 				CurrentUser:   currentUser,
+				Triggers:      TriggerChain(txCtx),
+				SuppressFlows: FlowsSuppressed(txCtx),
 			}); err != nil {
 				return fmt.Errorf("failed to enqueue record deleted event: %w", err)
 			}
 		}
 
+		if ps.cdc != nil {
+			if err := ps.cdc.CaptureTx(txCtx, tx, currentUser, RecordChange{
+				Operation:     constants.ChangeOperationDelete,
+				ObjectAPIName: objectName,
+				Before:        record,
+			}); err != nil {
+				return fmt.Errorf("failed to capture record change: %w", err)
+			}
+		}
+
 		return nil
 	})
 
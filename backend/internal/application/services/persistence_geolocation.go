@@ -0,0 +1,41 @@
+package services
+
+import (
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// applyGeolocations validates the Geolocation values being written and derives their
+// latitude/longitude companion columns. The companion columns only ever follow their
+// field: values written to them directly are dropped.
+func applyGeolocations(schema *models.ObjectMetadata, data models.SObject) error {
+	for _, field := range schema.Fields {
+		if field.Type != constants.FieldTypeGeolocation {
+			continue
+		}
+		latCol, lngCol := GetGeolocationColumnNames(field.APIName)
+		delete(data, latCol)
+		delete(data, lngCol)
+
+		val, ok := data[field.APIName]
+		if !ok {
+			continue
+		}
+		point, err := persistence.ParseGeolocation(val)
+		if err != nil {
+			return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, err.Error())
+		}
+		if point == nil {
+			data[field.APIName] = nil
+			data[latCol] = nil
+			data[lngCol] = nil
+			continue
+		}
+		data[field.APIName] = *point
+		data[latCol] = point.Latitude
+		data[lngCol] = point.Longitude
+	}
+	return nil
+}
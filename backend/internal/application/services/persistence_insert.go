@@ -29,7 +29,26 @@ func (ps *PersistenceService) Insert(
 	}
 
 	// Apply defaults
-	data = ps.applyDefaults(ctx, data, schema, currentUser)
+	data, err = ps.applyDefaults(ctx, data, schema, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve record type and enforce its picklist values
+	if err := ps.applyRecordType(ctx, schema, data, currentUser); err != nil {
+		return nil, err
+	}
+
+	// Enforce active and dependent picklist values
+	if err := validateActivePicklistValues(schema, data); err != nil {
+		return nil, err
+	}
+	if err := validateFieldDependencies(schema, data, nil); err != nil {
+		return nil, err
+	}
+	if err := ps.checkStateMachines(ctx, schema, nil, data, currentUser); err != nil {
+		return nil, err
+	}
 
 	// Validate Polymorphic Lookups (Database Check) & Resolve Types
 	resolvedTypes, err := ps.validatePolymorphicLookups(ctx, data, schema)
@@ -42,8 +61,13 @@ func (ps *PersistenceService) Insert(
 		data[GetPolymorphicTypeColumnName(fieldName)] = objType
 	}
 
+	// Validate locations and derive their coordinate columns
+	if err := applyGeolocations(schema, data); err != nil {
+		return nil, err
+	}
+
 	// Validate Static Rules
-	validationRules := ps.metadata.GetValidationRules(ctx, objectName)
+	validationRules := ps.validationRules(ctx, objectName)
 	if err := ps.validator.ValidateRecord(data, schema, validationRules, nil); err != nil {
 		return nil, err
 	}
@@ -115,11 +139,23 @@ func (ps *PersistenceService) Insert(
 				ObjectAPIName: objectName,
 				Record:        data,
 				CurrentUser:   currentUser,
+				Triggers:      TriggerChain(txCtx),
+				SuppressFlows: FlowsSuppressed(txCtx),
 			}); err != nil {
 				return fmt.Errorf("failed to enqueue record created event: %w", err)
 			}
 		}
 
+		if ps.cdc != nil {
+			if err := ps.cdc.CaptureTx(txCtx, tx, currentUser, RecordChange{
+				Operation:     constants.ChangeOperationCreate,
+				ObjectAPIName: objectName,
+				After:         data,
+			}); err != nil {
+				return fmt.Errorf("failed to capture record change: %w", err)
+			}
+		}
+
 		return nil
 	})
 
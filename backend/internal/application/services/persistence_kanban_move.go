@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// MoveKanbanCard moves a record to another kanban column by updating its picklist value.
+// The move is rejected when the target is not a valid option, the field is not editable, the
+// card was moved by someone else since the board was loaded (FromValue no longer matches), or
+// a dependent picklist does not allow the target for the record's controlling value.
+// Stage transition rules are enforced by the object's validation rules as part of the update.
+func (ps *PersistenceService) MoveKanbanCard(
+	ctx context.Context,
+	objectName string,
+	req models.KanbanMoveRequest,
+	currentUser *models.UserSession,
+) (models.SObject, error) {
+	schema, err := ps.prepareOperation(ctx, objectName, constants.PermEdit, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	field := FindField(schema, req.GroupBy)
+	if field == nil || field.Type != constants.FieldTypePicklist {
+		return nil, errors.NewValidationError("group_by", fmt.Sprintf("'%s' is not a picklist field on %s", req.GroupBy, schema.APIName))
+	}
+	if req.ToValue == "" {
+		if field.Required {
+			return nil, errors.NewValidationError(field.APIName, "is required")
+		}
+	} else if !ContainsString(field.Options, req.ToValue) {
+		return nil, errors.NewValidationError(field.APIName, fmt.Sprintf("'%s' is not a valid option", req.ToValue))
+	}
+	if !ps.permissions.CheckFieldEditabilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+		return nil, errors.NewPermissionError(constants.PermEdit, schema.APIName+"."+field.APIName)
+	}
+
+	current, err := ps.repo.FindOne(ctx, nil, ps.getTableName(schema.APIName), req.RecordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load record: %w", err)
+	}
+	if current == nil || !ps.permissions.CheckRecordAccess(ctx, schema, current, constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, req.RecordID)
+	}
+
+	currentValue := current.GetString(field.APIName)
+	if req.FromValue != nil && *req.FromValue != currentValue {
+		return nil, errors.NewConflictError(schema.APIName, field.APIName, currentValue)
+	}
+
+	if field.ControllingField != nil {
+		if err := checkFieldDependency(field, current.GetString(*field.ControllingField), req.ToValue); err != nil {
+			return nil, err
+		}
+	}
+
+	var value interface{} = req.ToValue
+	if req.ToValue == "" {
+		value = nil
+	}
+	if err := ps.Update(ctx, schema.APIName, req.RecordID, models.SObject{field.APIName: value}, currentUser); err != nil {
+		return nil, err
+	}
+
+	return models.SObject{
+		constants.FieldID: req.RecordID,
+		field.APIName:     value,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// applyRecordType sets the record type of a new record, defaulting it from the user's
+// profile, and checks the record's picklist values against it
+func (ps *PersistenceService) applyRecordType(ctx context.Context, schema *models.ObjectMetadata, data models.SObject, currentUser *models.UserSession) error {
+	if FindField(schema, constants.FieldRecordTypeID) == nil {
+		return nil
+	}
+
+	requested, _ := data[constants.FieldRecordTypeID].(string)
+	rt, err := ps.metadata.ResolveRecordType(ctx, schema.APIName, requested, currentUser)
+	if err != nil || rt == nil {
+		return err
+	}
+	data[constants.FieldRecordTypeID] = rt.ID
+	return validateRecordTypePicklists(rt, data)
+}
+
+// checkRecordTypeUpdate validates a change of record type against the user's profile and
+// the updated picklist values against the record's (new) record type. When the record type
+// changes, every restricted picklist value of the merged record must be allowed by it.
+func (ps *PersistenceService) checkRecordTypeUpdate(ctx context.Context, schema *models.ObjectMetadata, oldRecord, updates models.SObject, currentUser *models.UserSession) error {
+	if FindField(schema, constants.FieldRecordTypeID) == nil {
+		return nil
+	}
+
+	if newVal, changed := updates[constants.FieldRecordTypeID]; changed {
+		requested, _ := newVal.(string)
+		rt, err := ps.metadata.ResolveRecordType(ctx, schema.APIName, requested, currentUser)
+		if err != nil || rt == nil {
+			return err
+		}
+		updates[constants.FieldRecordTypeID] = rt.ID
+		return validateRecordTypePicklists(rt, ps.mergeRecords(oldRecord, updates))
+	}
+
+	currentID, _ := oldRecord[constants.FieldRecordTypeID].(string)
+	if currentID == "" {
+		return nil
+	}
+	rt, err := ps.metadata.GetRecordType(ctx, currentID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil // Record type deleted since; nothing to enforce
+		}
+		return err
+	}
+	return validateRecordTypePicklists(rt, updates)
+}
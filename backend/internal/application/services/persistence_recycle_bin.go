@@ -66,6 +66,23 @@ func (ps *PersistenceService) Restore(
 			return fmt.Errorf("failed to remove from recycle bin: %w", err)
 		}
 
+		// 3. The record comes back to consumers of the change stream, which saw it deleted
+		if ps.cdc != nil {
+			restored, err := ps.repo.FindOne(ctx, tx, objectName, recordId)
+			if err != nil {
+				return fmt.Errorf("failed to load restored record: %w", err)
+			}
+			if restored != nil {
+				if err := ps.cdc.CaptureTx(ctx, tx, currentUser, RecordChange{
+					Operation:     constants.ChangeOperationCreate,
+					ObjectAPIName: objectName,
+					After:         restored,
+				}); err != nil {
+					return fmt.Errorf("failed to capture record change: %w", err)
+				}
+			}
+		}
+
 		return nil
 	}, 3)
 
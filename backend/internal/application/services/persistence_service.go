@@ -18,15 +18,18 @@ import (
 
 // PersistenceService manages strict CRUD operations and data integrity
 type PersistenceService struct {
-	repo        *persistence.RecordRepository
-	metadata    *MetadataService
-	permissions *PermissionService
-	eventBus    *EventBus
-	formula     *formula.Engine
-	validator   *ValidationService
-	txManager   *persistence.TransactionManager
-	rollup      *RollupService
-	outbox      *OutboxService
+	repo         *persistence.RecordRepository
+	metadata     *MetadataService
+	permissions  *PermissionService
+	eventBus     *EventBus
+	formula      *formula.Engine
+	validator    *ValidationService
+	txManager    *persistence.TransactionManager
+	rollup       *RollupService
+	outbox       *OutboxService
+	cdc          *ChangeDataCaptureService // nil unless change data capture is enabled
+	translations *TranslationService       // nil leaves validation messages untranslated
+	states       *StateMachineService      // nil leaves picklist fields without state machines
 }
 
 // NewPersistenceService creates a new PersistenceService
@@ -53,6 +56,44 @@ func NewPersistenceService(
 	}
 }
 
+// SetChangeDataCapture enables change data capture for every record write
+func (ps *PersistenceService) SetChangeDataCapture(cdc *ChangeDataCaptureService) {
+	ps.cdc = cdc
+}
+
+// SetTranslations translates validation error messages into the caller's locale
+func (ps *PersistenceService) SetTranslations(translations *TranslationService) {
+	ps.translations = translations
+}
+
+// SetStateMachines enforces the state machines of picklist fields on every save
+func (ps *PersistenceService) SetStateMachines(states *StateMachineService) {
+	ps.states = states
+}
+
+// checkStateMachines checks a save against the state machines of its object (oldRecord is
+// nil for new records). Like validation rules, they are skipped when the request skips
+// validation.
+func (ps *PersistenceService) checkStateMachines(ctx context.Context, schema *models.ObjectMetadata, oldRecord, record models.SObject, currentUser *models.UserSession) error {
+	if ps.states == nil || validationSkipped(ctx) {
+		return nil
+	}
+	return ps.states.CheckSave(ctx, schema, oldRecord, record, currentUser)
+}
+
+// validationRules returns an object's validation rules with messages in the caller's locale,
+// or none when the request skips validation (see WithWriteOptions)
+func (ps *PersistenceService) validationRules(ctx context.Context, objectName string) []*models.ValidationRule {
+	if validationSkipped(ctx) {
+		return nil
+	}
+	rules := ps.metadata.GetValidationRules(ctx, objectName)
+	if ps.translations == nil {
+		return rules
+	}
+	return ps.translations.LocalizeValidationRules(ctx, rules)
+}
+
 // ==================== CRUD Operations ====================
 
 // publishRecordEvent publishes a record event with consistent payload
@@ -62,6 +103,8 @@ func (ps *PersistenceService) publishRecordEvent(ctx context.Context, eventType
 		Record:        record,
 		OldRecord:     oldRecord,
 		CurrentUser:   currentUser,
+		Triggers:      TriggerChain(ctx),
+		SuppressFlows: FlowsSuppressed(ctx),
 	}
 
 	if err := ps.eventBus.Publish(ctx, eventType, payload); err != nil {
@@ -70,12 +113,26 @@ func (ps *PersistenceService) publishRecordEvent(ctx context.Context, eventType
 	return nil
 }
 
-// prepareOperation checks permissions and retrieves schema
+// prepareOperation checks permissions and retrieves schema. System tables are written only as
+// their write policy allows unless ctx is a WithSystemTableWrite one, and external objects are
+// read-only, so every write on them is rejected here.
 func (ps *PersistenceService) prepareOperation(ctx context.Context, objectName string, operation string, user *models.UserSession) (*models.ObjectMetadata, error) {
+	if !systemTableWriteAllowed(ctx) {
+		if err := CheckSystemTableWrite(objectName, operation, user); err != nil {
+			return nil, err
+		}
+	}
 	if err := ps.permissions.CheckPermissionOrErrorWithUser(ctx, objectName, operation, user); err != nil {
 		return nil, err
 	}
-	return ps.metadata.GetSchemaOrError(ctx, objectName)
+	schema, err := ps.metadata.GetSchemaOrError(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if schema.IsExternal {
+		return nil, errors.NewValidationError(constants.FieldObjectAPIName, fmt.Sprintf("%s is an external object and is read-only", schema.APIName))
+	}
+	return schema, nil
 }
 
 // validatePolymorphicLookups verifies that referenced IDs in polymorphic fields exist in at least one of the allowed objects
@@ -160,7 +217,7 @@ func (ps *PersistenceService) RunInTransaction(
 	// Otherwise start new transaction with retry
 	return ps.txManager.WithRetry(func(tx *sql.Tx) error {
 		// Inject transaction into context for downstream usage
-		txCtx := ps.txManager.InjectTx(ctx, tx)
+		txCtx := WithChangeTransaction(ps.txManager.InjectTx(ctx, tx))
 		return fn(tx, txCtx)
 	}, 3)
 }
@@ -186,70 +243,9 @@ func (ps *PersistenceService) Update(
 
 	// Execute Transactional Work
 	err = ps.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
-		// Load current record with FOR UPDATE lock within transaction via Repository
-		oldRecord, err = ps.repo.GetLock(txCtx, tx, objectName, id)
-		if err != nil {
-			return fmt.Errorf("failed to lock record: %w", err)
-		}
-		if oldRecord == nil {
-			return errors.NewNotFoundError(objectName, id)
-		}
-
-		// Validate Polymorphic Lookups & Resolve Types
-		resolvedTypes, err := ps.validatePolymorphicLookups(txCtx, updates, schema)
-		if err != nil {
-			return err
-		}
-
-		// Inject resolved types into updates for persistence
-		for fieldName, objType := range resolvedTypes {
-			updates[GetPolymorphicTypeColumnName(fieldName)] = objType
-		}
-
-		// Check record-level access
-		if !ps.permissions.CheckRecordAccess(ctx, schema, oldRecord, constants.PermEdit, currentUser) {
-			return errors.NewPermissionError("update", objectName+"/"+id)
-		}
-
-		// Filter editable fields
-		effectiveUpdates := make(models.SObject)
-		hasChanges := false
-
-		// Normalize updates (keys to match schema, values to match type)
-		normalizedUpdates := NormalizeSObject(schema, updates)
-		updates = normalizedUpdates
-
-		for key, newVal := range updates {
-			if isFieldSystemReadOnly(ps.metadata, objectName, key) {
-				continue
-			}
-
-			if !ps.permissions.CheckFieldEditabilityWithUser(ctx, objectName, key, currentUser) {
-				continue
-			}
-
-			oldVal := oldRecord[key]
-			if !ps.areValuesEqual(oldVal, newVal) {
-				effectiveUpdates[key] = newVal
-				hasChanges = true
-			}
-		}
-
-		if !hasChanges {
-			return nil // No changes
-		}
-
-		// Merge for validation
-		recordToValidate := ps.mergeRecords(oldRecord, effectiveUpdates)
-
-		// Validate
-		validationRules := ps.metadata.GetValidationRules(txCtx, objectName)
-		if err := ps.validator.ValidateRecord(recordToValidate, schema, validationRules, &oldRecord); err != nil {
-			return err
-		}
-
-		// Check uniqueness
-		if err := ps.checkUniqueness(txCtx, objectName, effectiveUpdates, schema, id); err != nil {
+		var effectiveUpdates, recordToValidate models.SObject
+		oldRecord, effectiveUpdates, recordToValidate, err = ps.stageUpdate(txCtx, tx, schema, id, updates, currentUser)
+		if err != nil || effectiveUpdates == nil {
 			return err
 		}
 
@@ -335,11 +331,29 @@ func (ps *PersistenceService) Update(
 				Record:        finalRecord,
 				OldRecord:     &oldRecord,
 				CurrentUser:   currentUser,
+				Triggers:      TriggerChain(txCtx),
+				SuppressFlows: FlowsSuppressed(txCtx),
 			}); err != nil {
 				return fmt.Errorf("failed to enqueue record updated event: %w", err)
 			}
 		}
 
+		if ps.cdc != nil {
+			changed := make([]string, 0, len(effectiveUpdates))
+			for field := range effectiveUpdates {
+				changed = append(changed, field)
+			}
+			if err := ps.cdc.CaptureTx(txCtx, tx, currentUser, RecordChange{
+				Operation:     constants.ChangeOperationUpdate,
+				ObjectAPIName: objectName,
+				Before:        oldRecord,
+				After:         finalRecord,
+				ChangedFields: changed,
+			}); err != nil {
+				return fmt.Errorf("failed to capture record change: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -353,6 +367,126 @@ func (ps *PersistenceService) Update(
 	return nil
 }
 
+type ownerTransferKey struct{}
+
+// WithOwnerTransfer returns ctx in which updates may change the owner of records. Otherwise
+// the owner is a system field that updates leave alone.
+func WithOwnerTransfer(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ownerTransferKey{}, true)
+}
+
+func ownerTransferAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(ownerTransferKey{}).(bool)
+	return allowed
+}
+
+// stageUpdate locks a record and checks an update the way Update saves it: record access,
+// field editability, record type, picklist values, state machines, validation rules and
+// uniqueness. It returns the locked record, the fields that change and the merged record.
+// Updates without changes return nil changes.
+func (ps *PersistenceService) stageUpdate(
+	txCtx context.Context,
+	tx *sql.Tx,
+	schema *models.ObjectMetadata,
+	id string,
+	updates models.SObject,
+	currentUser *models.UserSession,
+) (models.SObject, models.SObject, models.SObject, error) {
+	// Load current record with FOR UPDATE lock within transaction via Repository
+	objectName := schema.APIName
+	oldRecord, err := ps.repo.GetLock(txCtx, tx, objectName, id)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to lock record: %w", err)
+	}
+	if oldRecord == nil {
+		return nil, nil, nil, errors.NewNotFoundError(objectName, id)
+	}
+
+	// Validate Polymorphic Lookups & Resolve Types
+	resolvedTypes, err := ps.validatePolymorphicLookups(txCtx, updates, schema)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Inject resolved types into updates for persistence
+	for fieldName, objType := range resolvedTypes {
+		updates[GetPolymorphicTypeColumnName(fieldName)] = objType
+	}
+
+	// Check record-level access
+	if !ps.permissions.CheckRecordAccess(txCtx, schema, oldRecord, constants.PermEdit, currentUser) {
+		return nil, nil, nil, errors.NewPermissionError("update", objectName+"/"+id)
+	}
+
+	// Filter editable fields
+	effectiveUpdates := make(models.SObject)
+	hasChanges := false
+
+	// Normalize updates (keys to match schema, values to match type)
+	normalizedUpdates := NormalizeSObject(schema, updates)
+	updates = normalizedUpdates
+
+	for key, newVal := range updates {
+		if isFieldSystemReadOnly(ps.metadata, objectName, key) && (key != constants.FieldOwnerID || !ownerTransferAllowed(txCtx)) {
+			continue
+		}
+
+		if !ps.permissions.CheckFieldEditabilityWithUser(txCtx, objectName, key, currentUser) {
+			continue
+		}
+
+		oldVal := oldRecord[key]
+		if !ps.areValuesEqual(oldVal, newVal) {
+			effectiveUpdates[key] = newVal
+			hasChanges = true
+		}
+	}
+
+	// Validate locations and derive their coordinate columns
+	if hasChanges {
+		if err := applyGeolocations(schema, effectiveUpdates); err != nil {
+			return nil, nil, nil, err
+		}
+		hasChanges = len(effectiveUpdates) > 0
+	}
+
+	if !hasChanges {
+		return oldRecord, nil, oldRecord, nil // No changes
+	}
+
+	// Enforce record type availability and its picklist values
+	if err := ps.checkRecordTypeUpdate(txCtx, schema, oldRecord, effectiveUpdates, currentUser); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Merge for validation
+	recordToValidate := ps.mergeRecords(oldRecord, effectiveUpdates)
+
+	// Enforce active and dependent picklist values
+	if err := validateActivePicklistValues(schema, effectiveUpdates); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := validateFieldDependencies(schema, recordToValidate, effectiveUpdates); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := ps.checkStateMachines(txCtx, schema, oldRecord, recordToValidate, currentUser); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Validate
+	validationRules := ps.validationRules(txCtx, objectName)
+	if err := ps.validator.ValidateRecord(recordToValidate, schema, validationRules, &oldRecord); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Check uniqueness
+	if err := ps.checkUniqueness(txCtx, objectName, effectiveUpdates, schema, id); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return oldRecord, effectiveUpdates, recordToValidate, nil
+}
+
 // valToString converts any value to string for audit logging
 func (ps *PersistenceService) valToString(val interface{}) string {
 	if val == nil {
@@ -391,64 +525,65 @@ func (ps *PersistenceService) generateAutoNumbers(ctx context.Context, tx *sql.T
 	}
 
 	for _, an := range autoNumbers {
-		// 1. Lock and increment current value in DB via Repo
-		// Can't use GetLock for standard SObject because this is _System_AutoNumber
-		// But RecordRepository is generic, so we can use it!
-		// GetLock returns SObject.
+		last, err := ps.reserveAutoNumbers(ctx, tx, an, 1)
+		if err != nil {
+			return err
+		}
+		data[an.FieldAPIName] = formatAutoNumber(an.DisplayFormat, last+1)
+	}
+	return nil
+}
 
+// reserveAutoNumbers takes count numbers from an auto-number sequence and returns the number
+// before the first one reserved. Gap-free sequences are locked and advanced in the record
+// transaction, so a rollback gives the numbers back but concurrent inserts wait for the commit.
+// Other sequences advance in a short transaction of their own; rolled-back inserts leave gaps.
+func (ps *PersistenceService) reserveAutoNumbers(ctx context.Context, tx *sql.Tx, an *models.AutoNumber, count int) (int, error) {
+	reserve := func(tx *sql.Tx) (int, error) {
 		autoNumberRecord, err := ps.repo.GetLock(ctx, tx, constants.TableAutoNumber, an.ID)
 		if err != nil {
-			return fmt.Errorf("failed to lock auto-number %s: %w", an.ID, err)
+			return 0, fmt.Errorf("failed to lock auto-number %s: %w", an.ID, err)
 		}
 
-		var currentValue int
+		currentValue := an.StartingNumber - 1
 		if autoNumberRecord != nil {
-			if val, ok := autoNumberRecord["current_number"]; ok && val != nil {
-				// Handle potential float64 from JSON/DB driver
-				switch v := val.(type) {
-				case int64:
-					currentValue = int(v)
-				case float64:
-					currentValue = int(v)
-				case int:
-					currentValue = v
-				}
+			if v := autoNumberValue(autoNumberRecord[constants.FieldSysAutoNumber_CurrentNumber]); v > currentValue {
+				currentValue = v
 			}
 		}
 
-		newValue := currentValue + 1
-
-		// 2. Update DB via Repo
 		anUpdate := models.SObject{
-			constants.FieldSysAutoNumber_CurrentNumber: newValue,
+			constants.FieldSysAutoNumber_CurrentNumber: currentValue + count,
 			constants.FieldLastModifiedDate:            time.Now().UTC(),
 		}
-
 		if err := ps.repo.Update(ctx, tx, constants.TableAutoNumber, an.ID, anUpdate); err != nil {
-			return fmt.Errorf("failed to update auto-number %s: %w", an.ID, err)
+			return 0, fmt.Errorf("failed to update auto-number %s: %w", an.ID, err)
 		}
+		return currentValue, nil
+	}
 
-		// 3. Format value
-		formatted := ps.formatAutoNumber(an.DisplayFormat, newValue)
-
-		// 4. Set value in data
-		data[an.FieldAPIName] = formatted
+	if an.GapFree || ps.txManager == nil {
+		return reserve(tx)
 	}
-	return nil
+	var last int
+	err := ps.txManager.WithTransaction(func(own *sql.Tx) error {
+		var err error
+		last, err = reserve(own)
+		return err
+	})
+	return last, err
 }
 
-// formatAutoNumber applies a display format (e.g., "INV-{0000}") to a numeric value
-func (ps *PersistenceService) formatAutoNumber(format string, value int) string {
-	start := strings.Index(format, "{")
-	end := strings.Index(format, "}")
-	if start == -1 || end == -1 || end <= start {
-		// Fallback: just append
-		return fmt.Sprintf("%s%d", format, value)
+// autoNumberValue converts a current_number column value to int
+func autoNumberValue(val interface{}) int {
+	// Handle potential float64 from JSON/DB driver
+	switch v := val.(type) {
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case int:
+		return v
 	}
-
-	placeholder := format[start+1 : end]
-	padding := len(placeholder)
-
-	formattedValue := fmt.Sprintf("%0*d", padding, value)
-	return format[:start] + formattedValue + format[end+1:]
+	return 0
 }
@@ -19,7 +19,7 @@ func TestPersistenceService_Integration(t *testing.T) {
 	db := conn.DB()
 
 	// 0. Bootstrap Schema (tables + system data)
-	err := bootstrap.InitializeSchema(conn)
+	err := bootstrap.InitializeSchema(context.Background(), conn)
 	require.NoError(t, err, "Schema bootstrap failed")
 
 	// 1. Setup Dependencies
@@ -120,6 +120,90 @@ func TestPersistenceService_Integration(t *testing.T) {
 		assert.True(t, foundInBin, "Record should be in recycle bin")
 	})
 
+	t.Run("Bulk_Update_Savepoints", func(t *testing.T) {
+		ctx := context.Background()
+		tableName := constants.TableGroup
+		uniqueSuffix := services.GenerateID()
+
+		created, err := svc.Insert(ctx, tableName, models.SObject{
+			"name":  "Bulk Savepoint Queue " + uniqueSuffix,
+			"label": "Bulk Label " + uniqueSuffix,
+			"type":  "Queue",
+			"email": "bulkq_" + uniqueSuffix + "@example.com",
+		}, adminUser)
+		require.NoError(t, err)
+		id := created[constants.FieldID].(string)
+		missing := services.GenerateID()
+
+		// A failing record rolls back to its savepoint; the other update is kept
+		result, err := svc.BulkUpdate(ctx, tableName, []models.SObject{
+			{constants.FieldID: id, "label": "Bulk Partial " + uniqueSuffix},
+			{constants.FieldID: missing, "label": "Nope"},
+		}, adminUser, services.BulkWriteOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.SuccessCount)
+		assert.Equal(t, 1, result.FailedCount)
+		rec, err := recordRepo.FindOne(ctx, nil, tableName, id)
+		require.NoError(t, err)
+		assert.Equal(t, "Bulk Partial "+uniqueSuffix, rec["label"])
+
+		// All or none: the failure rolls back the update that succeeded
+		_, err = svc.BulkUpdate(ctx, tableName, []models.SObject{
+			{constants.FieldID: id, "label": "Bulk All " + uniqueSuffix},
+			{constants.FieldID: missing, "label": "Nope"},
+		}, adminUser, services.BulkWriteOptions{AllOrNone: true})
+		assert.Error(t, err)
+		rec, err = recordRepo.FindOne(ctx, nil, tableName, id)
+		require.NoError(t, err)
+		assert.Equal(t, "Bulk Partial "+uniqueSuffix, rec["label"])
+	})
+
+	t.Run("Preview_Update", func(t *testing.T) {
+		ctx := context.Background()
+		tableName := constants.TableGroup
+		uniqueSuffix := services.GenerateID()
+
+		created, err := svc.Insert(ctx, tableName, models.SObject{
+			"name":  "Preview Queue " + uniqueSuffix,
+			"label": "Preview Label " + uniqueSuffix,
+			"type":  "Queue",
+			"email": "previewq_" + uniqueSuffix + "@example.com",
+		}, adminUser)
+		require.NoError(t, err)
+		id := created[constants.FieldID].(string)
+
+		updates, err := svc.FieldUpdate(ctx, tableName, "label", "Previewed "+uniqueSuffix, adminUser)
+		require.NoError(t, err)
+		preview, err := svc.PreviewUpdate(ctx, tableName, id, updates, adminUser)
+		require.NoError(t, err)
+		require.Len(t, preview.Changes, 1)
+		assert.Equal(t, "label", preview.Changes[0].Field)
+		assert.Equal(t, "Preview Label "+uniqueSuffix, preview.Changes[0].OldValue)
+		assert.Equal(t, "Previewed "+uniqueSuffix, preview.Record["label"])
+
+		// Nothing is saved
+		rec, err := recordRepo.FindOne(ctx, nil, tableName, id)
+		require.NoError(t, err)
+		assert.Equal(t, "Preview Label "+uniqueSuffix, rec["label"])
+
+		_, err = svc.FieldUpdate(ctx, tableName, "no_such_field", "x", adminUser)
+		assert.Error(t, err)
+	})
+
+	t.Run("Write_Options", func(t *testing.T) {
+		ctx := context.Background()
+		options := services.WriteOptions{SkipFlows: true, SkipValidation: true}
+
+		// Bypassing automation needs the bypass permissions
+		standardUser := services.GetTestUser("test-standard", constants.ProfileStandardUser)
+		_, err := svc.WithWriteOptions(ctx, options, standardUser)
+		assert.Error(t, err)
+
+		writeCtx, err := svc.WithWriteOptions(ctx, options, adminUser)
+		require.NoError(t, err)
+		assert.True(t, services.FlowsSuppressed(writeCtx))
+	})
+
 	t.Run("Create_Validation_Error", func(t *testing.T) {
 		ctx := context.Background()
 		uniqueSuffix := services.GenerateID()
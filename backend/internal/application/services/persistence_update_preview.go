@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sort"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// PreviewUpdate runs the checks of Update without saving anything: record access, field
+// editability, validation rules, uniqueness, then recalculates the formula fields of the
+// merged record. It returns the record as it would be saved and what would change.
+// Before-update flows are not run, since their actions may reach outside the database.
+func (ps *PersistenceService) PreviewUpdate(
+	ctx context.Context,
+	objectName string,
+	id string,
+	updates models.SObject,
+	currentUser *models.UserSession,
+) (*models.UpdatePreview, error) {
+	schema, err := ps.prepareOperation(ctx, objectName, constants.PermEdit, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldRecord, changed, merged models.SObject
+	err = ps.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		var err error
+		oldRecord, changed, merged, err = ps.stageUpdate(txCtx, tx, schema, id, updates, currentUser)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	visible := ps.readableFields(ctx, schema, currentUser)
+	before := FromStorageRecord(schema, ps.mergeRecords(oldRecord, nil), visible)
+	after := FromStorageRecord(schema, ps.mergeRecords(merged, nil), visible)
+	ps.recalculateFormulas(schema, before, currentUser)
+	ps.recalculateFormulas(schema, after, currentUser)
+
+	preview := &models.UpdatePreview{Record: make(models.SObject, len(visible)), Changes: []models.FieldChange{}}
+	for _, field := range visible {
+		preview.Record[field] = after[field]
+		_, updated := changed[field]
+		if updated || !ps.areValuesEqual(before[field], after[field]) {
+			preview.Changes = append(preview.Changes, models.FieldChange{Field: field, OldValue: before[field], NewValue: after[field]})
+		}
+	}
+	sort.Slice(preview.Changes, func(i, j int) bool { return preview.Changes[i].Field < preview.Changes[j].Field })
+	return preview, nil
+}
+
+// FieldUpdate builds the update of a single field for inline editing. Unlike record updates,
+// which skip fields the user may not edit, it rejects them.
+func (ps *PersistenceService) FieldUpdate(ctx context.Context, objectName, fieldName string, value interface{}, currentUser *models.UserSession) (models.SObject, error) {
+	schema, err := ps.metadata.GetSchemaOrError(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	field := FindField(schema, fieldName)
+	if field == nil {
+		return nil, errors.NewNotFoundError("Field", objectName+"."+fieldName)
+	}
+	if isFieldSystemReadOnly(ps.metadata, schema.APIName, field.APIName) || field.Type == constants.FieldTypeFormula ||
+		!ps.permissions.CheckFieldEditabilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+		return nil, errors.NewPermissionError("edit", schema.APIName+"."+field.APIName)
+	}
+	return models.SObject{field.APIName: value}, nil
+}
+
+// readableFields lists the fields of an object the user may read, as QueryService returns them
+func (ps *PersistenceService) readableFields(ctx context.Context, schema *models.ObjectMetadata, currentUser *models.UserSession) []string {
+	fields := []string{}
+	for _, field := range schema.Fields {
+		if field.IsSystem || field.IsNameField || ps.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+			fields = append(fields, field.APIName)
+		}
+	}
+	return fields
+}
+
+// recalculateFormulas evaluates the formula fields of a record in place
+func (ps *PersistenceService) recalculateFormulas(schema *models.ObjectMetadata, record models.SObject, currentUser *models.UserSession) {
+	formulaCtx := &formula.Context{Record: record}
+	if currentUser != nil {
+		formulaCtx.User = map[string]interface{}{
+			constants.FieldID:    currentUser.ID,
+			constants.FieldName:  currentUser.Name,
+			constants.FieldEmail: currentUser.Email,
+		}
+	}
+	for _, field := range schema.Fields {
+		if field.Type != constants.FieldTypeFormula || field.Formula == nil {
+			continue
+		}
+		result, err := ps.formula.Evaluate(*field.Formula, formulaCtx)
+		if err != nil {
+			log.Printf("⚠️ Formula evaluation error on field '%s': %v", field.APIName, err)
+			record[field.APIName] = nil
+			continue
+		}
+		record[field.APIName] = coerceFormulaResult(result, field.ReturnType)
+	}
+}
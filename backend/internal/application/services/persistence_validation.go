@@ -147,8 +147,9 @@ func (s *PersistenceService) generateSystemFields(
 			}
 		}
 
-		// Defaults for non-system fields on insert (or system fields not handled above)
-		if isInsert && !exists && field.DefaultValue != nil {
+		// Defaults for non-system fields on insert (or system fields not handled above).
+		// Default expressions are evaluated by applyDefaults.
+		if _, isExpr := defaultExpression(&field); isInsert && !exists && field.DefaultValue != nil && !isExpr {
 			val := *field.DefaultValue
 			if strings.EqualFold(val, "CURRENT_TIMESTAMP") {
 				result[fieldName] = NowTimestamp()
@@ -161,10 +162,13 @@ func (s *PersistenceService) generateSystemFields(
 	return result
 }
 
-// applyDefaults applies default values to fields that are missing
-func (s *PersistenceService) applyDefaults(ctx context.Context, data models.SObject, schema *models.ObjectMetadata, currentUser *models.UserSession) models.SObject {
-	// Wrapper around generateSystemFields for clarity
-	return s.generateSystemFields(ctx, schema.APIName, data, currentUser, true)
+// applyDefaults applies static defaults and default value expressions to fields that are missing
+func (s *PersistenceService) applyDefaults(ctx context.Context, data models.SObject, schema *models.ObjectMetadata, currentUser *models.UserSession) (models.SObject, error) {
+	result := s.generateSystemFields(ctx, schema.APIName, data, currentUser, true)
+	if err := s.applyDefaultExpressions(schema, result, currentUser); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // mergeRecords merges updates into base record
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// WriteOptions switch off automation and checks for the saves of a single API request, so
+// data migrations can load records as they are without disabling automation for everyone.
+// Each option requires its own system permission.
+type WriteOptions struct {
+	SkipFlows      bool // Run no record-triggered flows (constants.PermBypassFlows)
+	SkipValidation bool // Evaluate no validation rules (constants.PermBypassValidation)
+}
+
+type skipValidationKey struct{}
+
+// WithWriteOptions returns ctx in which record saves apply options, after checking that the
+// user holds the permission each option requires
+func (ps *PersistenceService) WithWriteOptions(ctx context.Context, options WriteOptions, currentUser *models.UserSession) (context.Context, error) {
+	if options.SkipFlows {
+		if !ps.permissions.CheckSystemPermissionWithUser(ctx, constants.PermBypassFlows, currentUser) {
+			return ctx, errors.NewPermissionError("bypass", "flows")
+		}
+		ctx = WithFlowsSuppressed(ctx)
+	}
+	if options.SkipValidation {
+		if !ps.permissions.CheckSystemPermissionWithUser(ctx, constants.PermBypassValidation, currentUser) {
+			return ctx, errors.NewPermissionError("bypass", "validation rules")
+		}
+		ctx = context.WithValue(ctx, skipValidationKey{}, true)
+	}
+	return ctx, nil
+}
+
+// validationSkipped reports whether record saves in ctx skip validation rules
+func validationSkipped(ctx context.Context) bool {
+	skipped, _ := ctx.Value(skipValidationKey{}).(bool)
+	return skipped
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// picklistReplaceBatchSize is the number of records rewritten per statement by a replace job
+const picklistReplaceBatchSize = 500
+
+// PicklistValueService replaces picklist values across existing records
+type PicklistValueService struct {
+	metadata *MetadataService
+	records  *persistence.RecordRepository
+	jobs     *AsyncJobService
+}
+
+// NewPicklistValueService creates a new PicklistValueService
+func NewPicklistValueService(metadata *MetadataService, records *persistence.RecordRepository, jobs *AsyncJobService) *PicklistValueService {
+	return &PicklistValueService{metadata: metadata, records: records, jobs: jobs}
+}
+
+// PicklistReplaceRequest describes a bulk replacement of a picklist value
+type PicklistReplaceRequest struct {
+	From       string `json:"from" binding:"required"`
+	To         string `json:"to" binding:"required"`
+	Deactivate bool   `json:"deactivate"` // Deactivate the old value once records are migrated
+}
+
+// ReplaceValue queues a job that rewrites every record (including deleted ones) holding
+// req.From to req.To. The old value may be an active, inactive or orphaned value; the new
+// value must be active. Records are rewritten directly, without running triggers or flows.
+func (s *PicklistValueService) ReplaceValue(ctx context.Context, objectAPIName, fieldAPIName string, req PicklistReplaceRequest, currentUser *models.UserSession) (*models.SystemAsyncJob, error) {
+	schema, field, err := s.metadata.getPicklistField(ctx, objectAPIName, fieldAPIName)
+	if err != nil {
+		return nil, err
+	}
+	if field.Type != constants.FieldTypePicklist {
+		return nil, errors.NewValidationError(field.APIName, "values can only be replaced on single-select picklists")
+	}
+	if req.From == req.To {
+		return nil, errors.NewValidationError("to", "must differ from the value being replaced")
+	}
+	if req.Deactivate && field.ValueSet != nil {
+		return nil, errors.NewValidationError("deactivate", fmt.Sprintf("values come from global value set '%s'; deactivate the value there", *field.ValueSet))
+	}
+	if !ContainsString(field.Options, req.To) {
+		return nil, errors.NewValidationError("to", fmt.Sprintf("'%s' is not an active value of %s", req.To, field.APIName))
+	}
+
+	total, err := s.records.CountByField(ctx, schema.APIName, field.APIName, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count records: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"field":      field.APIName,
+		"from":       req.From,
+		"to":         req.To,
+		"deactivate": req.Deactivate,
+	}
+	return s.jobs.Enqueue(ctx, constants.AsyncJobTypePicklistReplace, schema.APIName, params, total, currentUser,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			for {
+				n, err := s.records.ReplaceFieldValue(ctx, schema.APIName, field.APIName, req.From, req.To, picklistReplaceBatchSize)
+				if err != nil {
+					return err
+				}
+				if n == 0 {
+					break
+				}
+				job.ProcessedCount += n
+				if job.ProcessedCount > job.TotalCount {
+					job.TotalCount = job.ProcessedCount // Records written since the job was queued
+				}
+				report()
+			}
+
+			log.Printf("🔁 Replaced '%s' with '%s' on %d %s records", req.From, req.To, job.ProcessedCount, schema.APIName)
+			if req.Deactivate && ContainsString(field.Options, req.From) {
+				if _, err := s.metadata.SetPicklistValueActive(ctx, schema.APIName, field.APIName, req.From, false); err != nil {
+					return fmt.Errorf("records updated but deactivating '%s' failed: %w", req.From, err)
+				}
+			}
+			return nil
+		})
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// portalMaxPageSize caps the records a portal list request returns
+const portalMaxPageSize = 200
+
+// PortalService serves external portal users. They only reach the objects an administrator
+// exposes, and within those only the records related to their own contact or account;
+// profile object and field permissions still apply on top.
+type PortalService struct {
+	repo        *persistence.PortalRepository
+	users       *persistence.UserRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+	persistence *PersistenceService
+}
+
+// NewPortalService creates a new PortalService
+func NewPortalService(
+	repo *persistence.PortalRepository,
+	users *persistence.UserRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	query *QueryService,
+	persistence *PersistenceService,
+) *PortalService {
+	return &PortalService{
+		repo:        repo,
+		users:       users,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+		persistence: persistence,
+	}
+}
+
+// PortalIdentity is the contact and account a portal user represents
+type PortalIdentity struct {
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	ContactID string `json:"contact_id,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// ==================== Configuration ====================
+
+// GetObjects returns the portal settings of every exposed object
+func (s *PortalService) GetObjects(ctx context.Context) ([]*models.PortalObject, error) {
+	return s.repo.GetObjects(ctx)
+}
+
+// SaveObject exposes an object to portal users or replaces its portal settings
+func (s *PortalService) SaveObject(ctx context.Context, objectAPIName string, o *models.PortalObject) (*models.PortalObject, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	if constants.IsSystemTable(schema.APIName) {
+		return nil, errors.NewValidationError(constants.FieldSysPortalObject_ObjectAPIName, "System objects cannot be exposed in the portal")
+	}
+	o.ObjectAPIName = schema.APIName
+
+	if o.ContactField == "" && o.AccountField == "" {
+		return nil, errors.NewValidationError(constants.FieldSysPortalObject_ContactField, "A contact or account field is required to relate records to portal users")
+	}
+	for column, field := range map[string]*string{
+		constants.FieldSysPortalObject_ContactField: &o.ContactField,
+		constants.FieldSysPortalObject_AccountField: &o.AccountField,
+	} {
+		if *field == "" {
+			continue
+		}
+		f := FindField(schema, *field)
+		if f == nil {
+			return nil, errors.NewValidationError(column, fmt.Sprintf("field %q not found on %s", *field, schema.APIName))
+		}
+		if f.Type != constants.FieldTypeLookup && f.Type != constants.FieldTypeMasterDetail && f.Type != constants.FieldTypeText {
+			return nil, errors.NewValidationError(column, fmt.Sprintf("%s must be a lookup or text field", f.APIName))
+		}
+		*field = f.APIName
+	}
+
+	existing, err := s.repo.FindObject(ctx, schema.APIName)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		o.ID = GenerateID()
+		err = s.repo.InsertObject(ctx, o)
+	} else {
+		o.ID = existing.ID
+		o.CreatedDate = existing.CreatedDate
+		err = s.repo.UpdateObject(ctx, o)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// DeleteObject removes an object from the portal
+func (s *PortalService) DeleteObject(ctx context.Context, objectAPIName string) error {
+	o, err := s.repo.FindObject(ctx, objectAPIName)
+	if err != nil {
+		return err
+	}
+	if o == nil {
+		return errors.NewNotFoundError("PortalObject", objectAPIName)
+	}
+	return s.repo.DeleteObject(ctx, o.ID)
+}
+
+// ==================== Portal Users ====================
+
+// GetIdentity returns the contact and account a portal user represents
+func (s *PortalService) GetIdentity(ctx context.Context, user *models.UserSession) (*PortalIdentity, error) {
+	if user == nil || user.UserType != constants.UserTypePortal {
+		return nil, errors.NewPermissionError("access", "portal")
+	}
+	u, err := s.users.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	if u == nil || u.UserType != constants.UserTypePortal {
+		return nil, errors.NewPermissionError("access", "portal")
+	}
+	return &PortalIdentity{
+		UserID:    u.ID,
+		Name:      user.Name,
+		Email:     u.Email,
+		ContactID: derefString(u.ContactID),
+		AccountID: derefString(u.AccountID),
+	}, nil
+}
+
+// GetAccessibleObjects returns the exposed objects the portal user's profile can read
+func (s *PortalService) GetAccessibleObjects(ctx context.Context, user *models.UserSession) ([]*models.PortalObject, error) {
+	if _, err := s.GetIdentity(ctx, user); err != nil {
+		return nil, err
+	}
+	objects, err := s.repo.GetObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessible := make([]*models.PortalObject, 0, len(objects))
+	for _, o := range objects {
+		if s.permissions.CheckObjectPermissionWithUser(ctx, o.ObjectAPIName, constants.PermRead, user) {
+			accessible = append(accessible, o)
+		}
+	}
+	return accessible, nil
+}
+
+// ListRecords returns the portal user's records of an exposed object, newest first
+func (s *PortalService) ListRecords(ctx context.Context, objectAPIName string, limit, offset int, user *models.UserSession) ([]models.SObject, error) {
+	o, identity, err := s.resolve(ctx, objectAPIName, user)
+	if err != nil {
+		return nil, err
+	}
+	filter := portalScopeFilter(o, identity)
+	if filter == "" {
+		return []models.SObject{}, nil
+	}
+	if limit <= 0 || limit > portalMaxPageSize {
+		limit = portalMaxPageSize
+	}
+	return s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName: o.ObjectAPIName,
+		FilterExpr:    filter,
+		SortField:     constants.FieldCreatedDate,
+		SortDirection: constants.SortDESC,
+		Limit:         limit,
+		Offset:        offset,
+	}, user)
+}
+
+// GetRecord returns one of the portal user's records
+func (s *PortalService) GetRecord(ctx context.Context, objectAPIName, id string, user *models.UserSession) (models.SObject, error) {
+	o, identity, err := s.resolve(ctx, objectAPIName, user)
+	if err != nil {
+		return nil, err
+	}
+	filter := portalScopeFilter(o, identity)
+	if filter == "" {
+		return nil, errors.NewNotFoundError(o.ObjectAPIName, id)
+	}
+	rows, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName: o.ObjectAPIName,
+		FilterExpr:    fmt.Sprintf("(%s) && %s == %s", filter, constants.FieldID, strconv.Quote(id)),
+		Limit:         1,
+	}, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.NewNotFoundError(o.ObjectAPIName, id)
+	}
+	return rows[0], nil
+}
+
+// CreateRecord creates a record of an exposed object that allows portal creation. The
+// record is always related to the portal user's own contact and account.
+func (s *PortalService) CreateRecord(ctx context.Context, objectAPIName string, data models.SObject, user *models.UserSession) (models.SObject, error) {
+	o, identity, err := s.resolve(ctx, objectAPIName, user)
+	if err != nil {
+		return nil, err
+	}
+	if !o.AllowCreate {
+		return nil, errors.NewPermissionError(constants.PermCreate, o.ObjectAPIName)
+	}
+	if portalScopeFilter(o, identity) == "" {
+		return nil, errors.NewValidationError(constants.FieldSysUser_ContactID, "Your portal user is not linked to a contact or account")
+	}
+
+	record := make(models.SObject, len(data))
+	for k, v := range data {
+		// Owner and audit fields are set by the platform
+		if strings.HasPrefix(k, "__sys_gen_") {
+			continue
+		}
+		record[k] = v
+	}
+	if o.ContactField != "" {
+		record[o.ContactField] = optionalString(identity.ContactID)
+	}
+	if o.AccountField != "" {
+		record[o.AccountField] = optionalString(identity.AccountID)
+	}
+	return s.persistence.Insert(ctx, o.ObjectAPIName, record, user)
+}
+
+// resolve loads a portal object and the user's identity, rejecting objects not exposed in the portal
+func (s *PortalService) resolve(ctx context.Context, objectAPIName string, user *models.UserSession) (*models.PortalObject, *PortalIdentity, error) {
+	identity, err := s.GetIdentity(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, err := s.repo.FindObject(ctx, objectAPIName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if o == nil {
+		return nil, nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	return o, identity, nil
+}
+
+// portalScopeFilter builds the formula filter matching records related to a portal user's
+// contact or account; empty when the user is linked to neither field of the object
+func portalScopeFilter(o *models.PortalObject, identity *PortalIdentity) string {
+	var terms []string
+	if o.ContactField != "" && identity.ContactID != "" {
+		terms = append(terms, fmt.Sprintf("%s == %s", o.ContactField, strconv.Quote(identity.ContactID)))
+	}
+	if o.AccountField != "" && identity.AccountID != "" {
+		terms = append(terms, fmt.Sprintf("%s == %s", o.AccountField, strconv.Quote(identity.AccountID)))
+	}
+	return strings.Join(terms, " || ")
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortalScopeFilter(t *testing.T) {
+	o := &models.PortalObject{ObjectAPIName: "support_case", ContactField: "contact_id", AccountField: "account_id"}
+
+	assert.Equal(t, `contact_id == "c1" || account_id == "a1"`, portalScopeFilter(o, &PortalIdentity{ContactID: "c1", AccountID: "a1"}))
+	assert.Equal(t, `account_id == "a1"`, portalScopeFilter(o, &PortalIdentity{AccountID: "a1"}))
+	assert.Equal(t, `contact_id == "x\" || 1 == 1"`, portalScopeFilter(o, &PortalIdentity{ContactID: `x" || 1 == 1`}))
+
+	// A contact-only object is invisible to users linked only to an account
+	assert.Empty(t, portalScopeFilter(&models.PortalObject{ContactField: "contact_id"}, &PortalIdentity{AccountID: "a1"}))
+}
+
+func TestValidateUserType(t *testing.T) {
+	assert.NoError(t, validateUserType(constants.UserTypeStandard, constants.ProfileSystemAdmin, "", ""))
+	assert.NoError(t, validateUserType(constants.UserTypePortal, constants.ProfilePortalUser, "c1", ""))
+	assert.Error(t, validateUserType(constants.UserTypePortal, constants.ProfilePortalUser, "", ""))
+	assert.Error(t, validateUserType(constants.UserTypePortal, constants.ProfileSystemAdmin, "c1", ""))
+	assert.Error(t, validateUserType("Partner", constants.ProfileStandardUser, "", ""))
+}
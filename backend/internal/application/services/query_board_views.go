@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	pkgErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultKanbanColumnLimit = 25
+	maxKanbanColumnLimit     = 200
+	defaultCalendarLimit     = 500
+	maxCalendarLimit         = 2000
+	maxCalendarRangeDays     = 366
+	calendarDateLayout       = "2006-01-02"
+)
+
+// Kanban returns an object's records grouped by a picklist field, one column per picklist value
+// (plus any stored values no longer in the picklist, and a "" column for records without a value).
+// Each column carries its total count and the requested aggregates, computed in a single grouped query.
+func (qs *QueryService) Kanban(ctx context.Context, objectName string, req models.KanbanRequest, currentUser *models.UserSession) (*models.KanbanResult, error) {
+	schema, err := qs.readableSchema(ctx, objectName, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	field := FindField(schema, req.GroupBy)
+	if field == nil || field.Type != constants.FieldTypePicklist {
+		return nil, pkgErrors.NewValidationError("group_by", fmt.Sprintf("'%s' is not a picklist field on %s", req.GroupBy, schema.APIName))
+	}
+	if !qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+		return nil, pkgErrors.NewPermissionError(constants.PermRead, schema.APIName+"."+field.APIName)
+	}
+
+	aggregates := make([]models.ListViewAggregate, 0, len(req.Aggregates))
+	for _, agg := range req.Aggregates {
+		agg.Function = strings.ToUpper(agg.Function)
+		if err := validateListViewAggregate(schema, agg.Function, agg.Field); err != nil {
+			return nil, err
+		}
+		// The row count is always returned as TotalCount
+		if agg.Field == "" {
+			continue
+		}
+		if !qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, agg.Field, currentUser) {
+			continue
+		}
+		aggregates = append(aggregates, agg)
+	}
+
+	stats, err := qs.repo.RunGroupedAggregates(ctx, schema, req.FilterExpr, field.APIName, aggregates)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.PerColumnLimit
+	if limit <= 0 {
+		limit = defaultKanbanColumnLimit
+	}
+	limit = min(limit, maxKanbanColumnLimit)
+
+	sortField, sortDirection := req.SortField, req.SortDirection
+	if sortField == "" {
+		sortField, sortDirection = constants.FieldLastModifiedDate, constants.SortDESC
+	}
+
+	countAlias := persistence.AggregateAlias(persistence.RollupTypeCount, "")
+	result := &models.KanbanResult{
+		ObjectAPIName: schema.APIName,
+		GroupBy:       field.APIName,
+		Columns:       make([]models.KanbanColumn, 0, len(field.Options)+1),
+	}
+	for _, value := range kanbanColumnValues(field.Options, stats) {
+		column := models.KanbanColumn{Value: value, Records: []models.SObject{}}
+		if groupStats, ok := stats[value]; ok {
+			column.TotalCount = toInt64(groupStats[countAlias])
+			delete(groupStats, countAlias)
+			if len(groupStats) > 0 {
+				column.Aggregates = groupStats
+			}
+		}
+
+		if column.TotalCount > 0 {
+			query := models.QueryRequest{
+				ObjectAPIName: schema.APIName,
+				FilterExpr:    req.FilterExpr,
+				SortField:     sortField,
+				SortDirection: sortDirection,
+				Limit:         limit,
+			}
+			if value == "" {
+				query.FilterExpr = combineFilters(req.FilterExpr, field.APIName+" == nil")
+			} else {
+				query.Criteria = []models.QueryCriterion{{Field: field.APIName, Op: "=", Val: value}}
+			}
+			records, err := qs.Query(ctx, query, currentUser)
+			if err != nil {
+				return nil, err
+			}
+			column.Records = records
+		}
+
+		result.Columns = append(result.Columns, column)
+	}
+
+	return result, nil
+}
+
+// Calendar returns the records whose date field (or date range, when EndDateField is set)
+// overlaps [Start, End], grouped by day.
+func (qs *QueryService) Calendar(ctx context.Context, objectName string, req models.CalendarRequest, currentUser *models.UserSession) (*models.CalendarResult, error) {
+	schema, err := qs.readableSchema(ctx, objectName, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.ParseInLocation(calendarDateLayout, req.Start, time.Local)
+	if err != nil {
+		return nil, pkgErrors.NewValidationError("start", "must be a date in YYYY-MM-DD format")
+	}
+	end, err := time.ParseInLocation(calendarDateLayout, req.End, time.Local)
+	if err != nil {
+		return nil, pkgErrors.NewValidationError("end", "must be a date in YYYY-MM-DD format")
+	}
+	if end.Before(start) {
+		return nil, pkgErrors.NewValidationError("end", "must not be before start")
+	}
+	if end.Sub(start) > maxCalendarRangeDays*24*time.Hour {
+		return nil, pkgErrors.NewValidationError("end", fmt.Sprintf("range must not exceed %d days", maxCalendarRangeDays))
+	}
+
+	dateField, err := qs.calendarField(ctx, schema, "date_field", req.DateField, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	endField := ""
+	if req.EndDateField != "" {
+		if endField, err = qs.calendarField(ctx, schema, "end_date_field", req.EndDateField, currentUser); err != nil {
+			return nil, err
+		}
+	}
+
+	// Dates are validated above, so embedding them as literals is safe
+	rangeStart := start.Format(calendarDateLayout)
+	rangeEnd := end.AddDate(0, 0, 1).Format(calendarDateLayout) // exclusive
+	var rangeFilter string
+	if endField == "" {
+		rangeFilter = fmt.Sprintf("%s >= '%s' && %s < '%s'", dateField, rangeStart, dateField, rangeEnd)
+	} else {
+		rangeFilter = fmt.Sprintf("%s < '%s' && (%s >= '%s' || (%s == nil && %s >= '%s'))",
+			dateField, rangeEnd, endField, rangeStart, endField, dateField, rangeStart)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCalendarLimit
+	}
+	limit = min(limit, maxCalendarLimit)
+
+	records, err := qs.Query(ctx, models.QueryRequest{
+		ObjectAPIName: schema.APIName,
+		FilterExpr:    combineFilters(req.FilterExpr, rangeFilter),
+		SortField:     dateField,
+		SortDirection: constants.SortASC,
+		Limit:         limit + 1,
+	}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.CalendarResult{
+		ObjectAPIName: schema.APIName,
+		DateField:     dateField,
+		Start:         req.Start,
+		End:           req.End,
+		Days:          []models.CalendarDay{},
+	}
+	if len(records) > limit {
+		records = records[:limit]
+		result.Truncated = true
+	}
+	result.Days = groupRecordsByDay(records, dateField, endField, start, end)
+	return result, nil
+}
+
+// readableSchema resolves an object the user can read
+func (qs *QueryService) readableSchema(ctx context.Context, objectName string, currentUser *models.UserSession) (*models.ObjectMetadata, error) {
+	if !qs.permissions.CheckObjectPermissionWithUser(ctx, objectName, constants.PermRead, currentUser) {
+		return nil, pkgErrors.NewPermissionError(constants.PermRead, objectName)
+	}
+	schema := qs.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return nil, pkgErrors.NewNotFoundError("Object", objectName)
+	}
+	return schema, nil
+}
+
+// calendarField validates a visible Date/DateTime field and returns its API name
+func (qs *QueryService) calendarField(ctx context.Context, schema *models.ObjectMetadata, param, name string, currentUser *models.UserSession) (string, error) {
+	field := FindField(schema, name)
+	if field == nil || (field.Type != constants.FieldTypeDate && field.Type != constants.FieldTypeDateTime) {
+		return "", pkgErrors.NewValidationError(param, fmt.Sprintf("'%s' is not a date field on %s", name, schema.APIName))
+	}
+	if !qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+		return "", pkgErrors.NewPermissionError(constants.PermRead, schema.APIName+"."+field.APIName)
+	}
+	return field.APIName, nil
+}
+
+// kanbanColumnValues orders columns as picklist options, then stored values no longer in the
+// picklist (sorted), then "" when records without a value exist
+func kanbanColumnValues(options []string, stats map[string]map[string]interface{}) []string {
+	values := append([]string{}, options...)
+	extra := make([]string, 0)
+	for value := range stats {
+		if value != "" && !ContainsString(options, value) {
+			extra = append(extra, value)
+		}
+	}
+	sort.Strings(extra)
+	values = append(values, extra...)
+	if _, ok := stats[""]; ok {
+		values = append(values, "")
+	}
+	return values
+}
+
+// groupRecordsByDay buckets records into each day of [start, end] they fall on
+func groupRecordsByDay(records []models.SObject, dateField, endField string, start, end time.Time) []models.CalendarDay {
+	byDay := make(map[string][]models.SObject)
+	for _, record := range records {
+		from, ok := toCalendarDate(record[dateField])
+		if !ok {
+			continue
+		}
+		to := from
+		if endField != "" {
+			if d, ok := toCalendarDate(record[endField]); ok && d.After(from) {
+				to = d
+			}
+		}
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			key := day.Format(calendarDateLayout)
+			byDay[key] = append(byDay[key], record)
+		}
+	}
+
+	keys := make([]string, 0, len(byDay))
+	for key := range byDay {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	days := make([]models.CalendarDay, 0, len(keys))
+	for _, key := range keys {
+		days = append(days, models.CalendarDay{Date: key, Records: byDay[key]})
+	}
+	return days
+}
+
+// toCalendarDate truncates a stored Date/DateTime value to local midnight
+func toCalendarDate(val interface{}) (time.Time, bool) {
+	var t time.Time
+	switch v := val.(type) {
+	case time.Time:
+		t = v.In(time.Local)
+	case string:
+		parsed := false
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", calendarDateLayout} {
+			if p, err := time.ParseInLocation(layout, v, time.Local); err == nil {
+				t, parsed = p.In(time.Local), true
+				break
+			}
+		}
+		if !parsed {
+			return time.Time{}, false
+		}
+	default:
+		return time.Time{}, false
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local), true
+}
+
+// combineFilters ANDs two formula filter expressions, either of which may be empty
+func combineFilters(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	default:
+		return "(" + a + ") && (" + b + ")"
+	}
+}
+
+// toInt64 converts a scanned numeric value to int64
+func toInt64(val interface{}) int64 {
+	switch v := val.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	case string:
+		var n int64
+		_, _ = fmt.Sscan(v, &n)
+		return n
+	default:
+		return 0
+	}
+}
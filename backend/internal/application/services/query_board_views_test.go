@@ -0,0 +1,53 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKanbanColumnValues(t *testing.T) {
+	stats := map[string]map[string]interface{}{
+		"Closed":   {},
+		"Retired":  {},
+		"Archived": {},
+		"":         {},
+	}
+	values := kanbanColumnValues([]string{"Open", "Closed"}, stats)
+	assert.Equal(t, []string{"Open", "Closed", "Archived", "Retired", ""}, values)
+
+	assert.Equal(t, []string{"Open"}, kanbanColumnValues([]string{"Open"}, nil))
+}
+
+func TestGroupRecordsByDay(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.ParseInLocation(calendarDateLayout, s, time.Local)
+		return d
+	}
+	start, end := day("2024-03-01"), day("2024-03-03")
+
+	records := []models.SObject{
+		{"id": "a", "start_date": "2024-03-02"},
+		{"id": "b", "start_date": day("2024-02-28"), "end_date": "2024-03-01"},
+		{"id": "c", "start_date": "2024-03-03", "end_date": "2024-03-10"},
+		{"id": "d", "start_date": nil},
+	}
+
+	days := groupRecordsByDay(records, "start_date", "end_date", start, end)
+	assert.Len(t, days, 3)
+	assert.Equal(t, "2024-03-01", days[0].Date)
+	assert.Len(t, days[0].Records, 1)
+	assert.Equal(t, "b", days[0].Records[0]["id"])
+	assert.Equal(t, "2024-03-02", days[1].Date)
+	assert.Equal(t, "a", days[1].Records[0]["id"])
+	assert.Equal(t, "2024-03-03", days[2].Date)
+	assert.Equal(t, "c", days[2].Records[0]["id"])
+}
+
+func TestCombineFilters(t *testing.T) {
+	assert.Equal(t, "b == 1", combineFilters("", "b == 1"))
+	assert.Equal(t, "a == 1", combineFilters("a == 1", ""))
+	assert.Equal(t, "(a == 1) && (b == 1)", combineFilters("a == 1", "b == 1"))
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// QueryGovernor enforces row, execution time and concurrency limits on record queries, so
+// a single oversized request or a looping agent cannot stall the database. The platform
+// defaults can be overridden per profile.
+type QueryGovernor struct {
+	repo *persistence.QueryGovernorRepository
+
+	mu        sync.Mutex
+	defaults  models.QueryLimits
+	overrides map[string]*models.SystemQueryGovernor // By profile ID; nil until loaded
+	running   map[string]int                         // Queries in flight by user ID
+}
+
+// NewQueryGovernor creates a new QueryGovernor
+func NewQueryGovernor(repo *persistence.QueryGovernorRepository, defaults models.QueryLimits) *QueryGovernor {
+	return &QueryGovernor{
+		repo:     repo,
+		defaults: defaults,
+		running:  make(map[string]int),
+	}
+}
+
+// QueryLimitsFromConfig returns the default governor limits of the runtime settings
+// QUERY_MAX_ROWS, QUERY_TIMEOUT and QUERY_MAX_CONCURRENT. "0" lifts a limit.
+func QueryLimitsFromConfig(rt config.Runtime) models.QueryLimits {
+	return models.QueryLimits{
+		MaxRows:              rt.QueryMaxRows,
+		MaxExecutionMs:       int(rt.QueryTimeout.Milliseconds()),
+		MaxConcurrentQueries: rt.QueryMaxConcurrent,
+	}
+}
+
+// SetDefaults replaces the platform default limits, which profile overrides build on
+func (g *QueryGovernor) SetDefaults(limits models.QueryLimits) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.defaults = limits
+}
+
+// defaultLimits returns the platform default limits
+func (g *QueryGovernor) defaultLimits() models.QueryLimits {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.defaults
+}
+
+// GetProfileLimits returns the defaults, override and effective limits of a profile
+func (g *QueryGovernor) GetProfileLimits(ctx context.Context, profileID string) (*models.ProfileQueryLimits, error) {
+	if err := g.requireProfile(ctx, profileID); err != nil {
+		return nil, err
+	}
+	override := g.override(ctx, profileID)
+	defaults := g.defaultLimits()
+	return &models.ProfileQueryLimits{
+		ProfileID: profileID,
+		Defaults:  defaults,
+		Override:  override,
+		Effective: effectiveQueryLimits(defaults, override),
+	}, nil
+}
+
+// SaveProfileLimits overrides the limits of a profile. Limits left out keep the default;
+// 0 lifts a limit for the profile.
+func (g *QueryGovernor) SaveProfileLimits(ctx context.Context, profileID string, input *models.SystemQueryGovernor) (*models.ProfileQueryLimits, error) {
+	if err := g.requireProfile(ctx, profileID); err != nil {
+		return nil, err
+	}
+	for field, value := range map[string]*int{
+		constants.FieldSysQueryGovernor_MaxRows:              input.MaxRows,
+		constants.FieldSysQueryGovernor_MaxExecutionMs:       input.MaxExecutionMs,
+		constants.FieldSysQueryGovernor_MaxConcurrentQueries: input.MaxConcurrentQueries,
+	} {
+		if value != nil && *value < 0 {
+			return nil, errors.NewValidationError(field, "must be 0 (unlimited) or greater")
+		}
+	}
+
+	override := &models.SystemQueryGovernor{
+		ID:                   GenerateID(),
+		ProfileID:            profileID,
+		MaxRows:              input.MaxRows,
+		MaxExecutionMs:       input.MaxExecutionMs,
+		MaxConcurrentQueries: input.MaxConcurrentQueries,
+	}
+	if err := g.repo.Save(ctx, override); err != nil {
+		return nil, err
+	}
+	g.invalidate()
+	return g.GetProfileLimits(ctx, profileID)
+}
+
+// DeleteProfileLimits returns a profile to the default limits
+func (g *QueryGovernor) DeleteProfileLimits(ctx context.Context, profileID string) error {
+	if err := g.repo.Delete(ctx, profileID); err != nil {
+		return err
+	}
+	g.invalidate()
+	return nil
+}
+
+// Limits returns the limits in effect for a user
+func (g *QueryGovernor) Limits(ctx context.Context, user *models.UserSession) models.QueryLimits {
+	if user == nil {
+		return g.defaultLimits()
+	}
+	return effectiveQueryLimits(g.defaultLimits(), g.override(ctx, user.ProfileID))
+}
+
+// Acquire admits a query of a user under its concurrency limit. The returned release must
+// be called once the query has finished.
+func (g *QueryGovernor) Acquire(user *models.UserSession, limits models.QueryLimits) (func(), error) {
+	if user == nil {
+		return func() {}, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if limits.MaxConcurrentQueries > 0 && g.running[user.ID] >= limits.MaxConcurrentQueries {
+		return nil, errors.NewLimitExceededError(constants.FieldSysQueryGovernor_MaxConcurrentQueries, int64(limits.MaxConcurrentQueries),
+			fmt.Sprintf("too many concurrent queries: your profile allows %d at a time; wait for running queries to finish", limits.MaxConcurrentQueries))
+	}
+	g.running[user.ID]++
+
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.running[user.ID] <= 1 {
+			delete(g.running, user.ID)
+		} else {
+			g.running[user.ID]--
+		}
+	}, nil
+}
+
+// checkQueryRows rejects a query that requests more rows than the limit allows
+func checkQueryRows(req models.QueryRequest, limits models.QueryLimits) error {
+	if limits.MaxRows > 0 && req.Limit > limits.MaxRows {
+		return errors.NewLimitExceededError(constants.FieldSysQueryGovernor_MaxRows, int64(limits.MaxRows),
+			fmt.Sprintf("query requests %d rows but your profile allows at most %d per query; page through the results with offset or narrow the filter", req.Limit, limits.MaxRows))
+	}
+	return nil
+}
+
+// queryTimeoutError reports a query cancelled by the execution time limit
+func queryTimeoutError(limits models.QueryLimits) error {
+	return errors.NewLimitExceededError(constants.FieldSysQueryGovernor_MaxExecutionMs, int64(limits.MaxExecutionMs),
+		fmt.Sprintf("query was cancelled after %d ms, the execution limit of your profile; add filters on indexed fields or request fewer rows", limits.MaxExecutionMs))
+}
+
+// effectiveQueryLimits applies a profile override to the defaults
+func effectiveQueryLimits(defaults models.QueryLimits, override *models.SystemQueryGovernor) models.QueryLimits {
+	limits := defaults
+	if override == nil {
+		return limits
+	}
+	if override.MaxRows != nil {
+		limits.MaxRows = *override.MaxRows
+	}
+	if override.MaxExecutionMs != nil {
+		limits.MaxExecutionMs = *override.MaxExecutionMs
+	}
+	if override.MaxConcurrentQueries != nil {
+		limits.MaxConcurrentQueries = *override.MaxConcurrentQueries
+	}
+	return limits
+}
+
+// override returns the override of a profile, loading the overrides on first use. If they
+// cannot be loaded the defaults apply, so queries keep working.
+func (g *QueryGovernor) override(ctx context.Context, profileID string) *models.SystemQueryGovernor {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.overrides == nil {
+		list, err := g.repo.List(ctx)
+		if err != nil {
+			log.Printf("⚠️ [QueryGovernor] Failed to load profile limits: %v", err)
+			return nil
+		}
+		g.overrides = make(map[string]*models.SystemQueryGovernor, len(list))
+		for _, o := range list {
+			g.overrides[o.ProfileID] = o
+		}
+	}
+	return g.overrides[profileID]
+}
+
+func (g *QueryGovernor) invalidate() {
+	g.mu.Lock()
+	g.overrides = nil
+	g.mu.Unlock()
+}
+
+func (g *QueryGovernor) requireProfile(ctx context.Context, profileID string) error {
+	exists, err := g.repo.ProfileExists(ctx, profileID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.NewNotFoundError("Profile", profileID)
+	}
+	return nil
+}
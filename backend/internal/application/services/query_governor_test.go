@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveQueryLimits(t *testing.T) {
+	defaults := models.QueryLimits{MaxRows: 10000, MaxExecutionMs: 30000, MaxConcurrentQueries: 10}
+	assert.Equal(t, defaults, effectiveQueryLimits(defaults, nil))
+
+	rows, unlimited := 500, 0
+	limits := effectiveQueryLimits(defaults, &models.SystemQueryGovernor{MaxRows: &rows, MaxConcurrentQueries: &unlimited})
+	assert.Equal(t, models.QueryLimits{MaxRows: 500, MaxExecutionMs: 30000, MaxConcurrentQueries: 0}, limits)
+}
+
+func TestCheckQueryRows(t *testing.T) {
+	limits := models.QueryLimits{MaxRows: 100}
+	assert.NoError(t, checkQueryRows(models.QueryRequest{Limit: 100}, limits))
+	assert.NoError(t, checkQueryRows(models.QueryRequest{}, limits))
+	assert.NoError(t, checkQueryRows(models.QueryRequest{Limit: 1000000}, models.QueryLimits{}))
+
+	err := checkQueryRows(models.QueryRequest{Limit: 101}, limits)
+	assert.True(t, errors.IsLimitExceeded(err))
+	assert.Equal(t, 429, errors.GetHTTPStatus(err))
+}
+
+func TestQueryGovernorAcquire(t *testing.T) {
+	governor := NewQueryGovernor(nil, models.QueryLimits{})
+	user := &models.UserSession{ID: "u1", ProfileID: "p1"}
+	limits := models.QueryLimits{MaxConcurrentQueries: 2}
+
+	first, err := governor.Acquire(user, limits)
+	require.NoError(t, err)
+	second, err := governor.Acquire(user, limits)
+	require.NoError(t, err)
+
+	_, err = governor.Acquire(user, limits)
+	assert.True(t, errors.IsLimitExceeded(err))
+
+	// Other users have their own allowance
+	other, err := governor.Acquire(&models.UserSession{ID: "u2"}, limits)
+	require.NoError(t, err)
+	other()
+
+	first()
+	third, err := governor.Acquire(user, limits)
+	require.NoError(t, err)
+	second()
+	third()
+	assert.Empty(t, governor.running)
+}
@@ -21,9 +21,8 @@ func (qs *QueryService) hydrateVirtualFields(
 	visibleFields []string,
 	currentUser *models.UserSession,
 ) []models.SObject {
-	// Find formula fields and lookup fields
+	// Find formula fields
 	formulaFields := make([]models.FieldMetadata, 0)
-	lookupFields := make([]models.FieldMetadata, 0)
 
 	for _, field := range schema.Fields {
 		if !ContainsString(visibleFields, field.APIName) {
@@ -31,14 +30,9 @@ func (qs *QueryService) hydrateVirtualFields(
 		}
 
 		isFormula := strings.EqualFold(string(field.Type), string(constants.FieldTypeFormula))
-		isLookup := strings.EqualFold(string(field.Type), string(constants.FieldTypeLookup))
-
 		if isFormula && field.Formula != nil {
 			formulaFields = append(formulaFields, field)
 		}
-		if isLookup && field.ReferenceTo != nil && len(field.ReferenceTo) > 0 {
-			lookupFields = append(lookupFields, field)
-		}
 	}
 
 	// Hydrate each row
@@ -75,104 +69,114 @@ func (qs *QueryService) hydrateVirtualFields(
 		rows[i] = record
 	}
 
-	// Hydrate lookup field display names (batch query for efficiency)
-	if len(lookupFields) > 0 {
-		rows = qs.hydrateLookupNames(ctx, rows, lookupFields)
-	}
-
+	redactSecretConfigRows(schema, rows)
 	return rows
 }
 
-// hydrateLookupNames resolves lookup field UUIDs to display names
-// It batches queries per referenced object for efficiency
-func (qs *QueryService) hydrateLookupNames(ctx context.Context, rows []models.SObject, lookupFields []models.FieldMetadata) []models.SObject {
-	if len(rows) == 0 || len(lookupFields) == 0 {
+// hydrateLookupNames adds the display name of each referenced record to the rows as
+// {lookup}__name, so callers do not have to load every referenced record. Names are
+// loaded with one query per referenced object, and only for objects the user may read.
+func (qs *QueryService) hydrateLookupNames(
+	ctx context.Context,
+	rows []models.SObject,
+	schema *models.ObjectMetadata,
+	visibleFields []string,
+	currentUser *models.UserSession,
+) []models.SObject {
+	if len(rows) == 0 {
 		return rows
 	}
 
-	// For each lookup field, collect all unique IDs and their reference object
-	// Map: referenceObject -> Set of IDs
-	refObjectIDs := make(map[string]map[string]bool)
-
-	for _, field := range lookupFields {
-		refObject := field.ReferenceTo[0] // Primary reference object (for polymorphic, we use first)
-		if _, exists := refObjectIDs[refObject]; !exists {
-			refObjectIDs[refObject] = make(map[string]bool)
+	lookupFields := make([]models.FieldMetadata, 0)
+	for _, field := range schema.Fields {
+		if field.Type == constants.FieldTypeLookup && len(field.ReferenceTo) > 0 && ContainsString(visibleFields, field.APIName) {
+			lookupFields = append(lookupFields, field)
 		}
+	}
+	if len(lookupFields) == 0 {
+		return rows
+	}
 
-		for _, row := range rows {
-			if val, ok := row[field.APIName]; ok && val != nil {
-				if id, ok := val.(string); ok && id != "" {
-					refObjectIDs[refObject][id] = true
-				}
+	// Map: referenced object -> set of IDs
+	refObjectIDs := make(map[string]map[string]bool)
+	for _, row := range rows {
+		for _, field := range lookupFields {
+			id, _ := row[field.APIName].(string)
+			refObject := lookupTargetObject(field, row)
+			if id == "" || refObject == "" {
+				continue
 			}
+			if refObjectIDs[refObject] == nil {
+				refObjectIDs[refObject] = make(map[string]bool)
+			}
+			refObjectIDs[refObject][id] = true
 		}
 	}
 
-	// For each reference object, fetch the names of all IDs
-	// Map: ID -> Name
-	idToName := make(map[string]string)
-
+	// Map: referenced object -> ID -> name
+	names := make(map[string]map[string]string)
 	for refObject, idSet := range refObjectIDs {
-		if len(idSet) == 0 {
-			continue
-		}
-
-		// Get the schema for the referenced object to find the name field
 		refSchema := qs.metadata.GetSchema(ctx, refObject)
-		if refSchema == nil {
+		if refSchema == nil || !qs.permissions.CheckObjectPermissionWithUser(ctx, refSchema.APIName, constants.PermRead, currentUser) {
 			continue
 		}
-
-		// Find the name field
-		nameField := constants.FieldName
-		for _, f := range refSchema.Fields {
-			if f.IsNameField {
-				nameField = f.APIName
-				break
-			}
+		nameField := GetNameFieldAPIName(refSchema)
+		if nameField == "" {
+			continue
 		}
 
-		// Collect IDs into slice
 		ids := make([]string, 0, len(idSet))
 		for id := range idSet {
 			ids = append(ids, id)
 		}
 
-		// Delegate to Repository
-		results, err := qs.repo.GetLookupNames(ctx, refObject, ids, nameField)
+		// Delegate to Repository, or to the external source of an external object
+		var results []models.SObject
+		var err error
+		if refSchema.IsExternal {
+			results, err = qs.findExternalByIDs(ctx, refSchema, []string{constants.FieldID, nameField}, ids)
+		} else {
+			results, err = qs.repo.GetLookupNames(ctx, refSchema.APIName, ids, nameField)
+		}
 		if err != nil {
 			log.Printf("⚠️ Failed to hydrate lookup names for %s: %v", refObject, err)
 			continue
 		}
 
-		// Build ID -> Name map
+		names[refObject] = make(map[string]string, len(results))
 		for _, rec := range results {
-			if id, ok := rec[constants.FieldID].(string); ok {
-				if name, ok := rec[nameField]; ok && name != nil {
-					idToName[id] = fmt.Sprintf("%v", name)
-				}
+			if id, ok := rec[constants.FieldID].(string); ok && rec[nameField] != nil {
+				names[refObject][id] = fmt.Sprintf("%v", rec[nameField])
 			}
 		}
 	}
 
-	// Populate _Name fields on each row
-	for i, row := range rows {
+	for _, row := range rows {
 		for _, field := range lookupFields {
-			if val, ok := row[field.APIName]; ok && val != nil {
-				if id, ok := val.(string); ok {
-					if name, found := idToName[id]; found {
-						row[field.APIName+"_Name"] = name
-					}
-				}
+			id, _ := row[field.APIName].(string)
+			if name, found := names[lookupTargetObject(field, row)][id]; found {
+				row[field.APIName+constants.LookupNameSuffix] = name
 			}
 		}
-		rows[i] = row
 	}
-
 	return rows
 }
 
+// lookupTargetObject returns the object a row's lookup value points at. Polymorphic lookups
+// name it in their type column; "" means the target is unknown.
+func lookupTargetObject(field models.FieldMetadata, row models.SObject) string {
+	if !field.IsPolymorphic {
+		return field.ReferenceTo[0]
+	}
+	objectType, _ := row[GetPolymorphicTypeColumnName(field.APIName)].(string)
+	for _, ref := range field.ReferenceTo {
+		if strings.EqualFold(ref, objectType) {
+			return ref
+		}
+	}
+	return ""
+}
+
 // Calculate evaluates formula fields for a given record
 func (qs *QueryService) Calculate(
 	ctx context.Context,
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pkgErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultLookupLimit = 10
+	maxLookupLimit     = 50
+)
+
+// lookupSourceRef matches the $source.<field> references of a lookup filter
+var lookupSourceRef = regexp.MustCompile(`\$source\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// Lookup returns the records of an object a lookup field may reference, for type-ahead
+// pickers. The term matches the start of the name field so the name-field index serves the
+// search. When req.Field names the lookup, its filter is applied with the edited record's
+// values taken from req.DependentValues. Each record carries its name and the compact layout
+// fields the user may read.
+func (qs *QueryService) Lookup(ctx context.Context, objectName string, req models.LookupRequest, currentUser *models.UserSession) (*models.LookupResult, error) {
+	schema := qs.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return nil, pkgErrors.NewNotFoundError("Object", objectName)
+	}
+	if !qs.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+		return nil, pkgErrors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	nameField := GetNameFieldAPIName(schema)
+	if nameField == "" {
+		return nil, pkgErrors.NewValidationError("objectApiName", fmt.Sprintf("%s has no name field to search", schema.APIName))
+	}
+
+	filter := ""
+	if req.Field != "" {
+		field, err := qs.lookupField(ctx, req.Field, schema.APIName)
+		if err != nil {
+			return nil, err
+		}
+		if field.LookupFilter != nil {
+			filter = bindLookupFilter(*field.LookupFilter, req.DependentValues)
+		}
+	}
+	if term := strings.TrimSpace(req.Term); term != "" {
+		filter = combineFilters(fmt.Sprintf("STARTS_WITH(%s, %s)", nameField, strconv.Quote(term)), filter)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLookupLimit
+	}
+	if limit > maxLookupLimit {
+		limit = maxLookupLimit
+	}
+	rows, err := qs.Query(ctx, models.QueryRequest{
+		ObjectAPIName: schema.APIName,
+		FilterExpr:    filter,
+		SortField:     nameField,
+		SortDirection: "ASC",
+		Limit:         limit,
+
+		SkipLookupNames: true,
+	}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.LookupResult{
+		NameField: nameField,
+		Fields:    qs.lookupDisplayFields(ctx, schema, nameField, currentUser),
+		Records:   make([]models.LookupRecord, 0, len(rows)),
+	}
+	for _, row := range rows {
+		if !qs.permissions.CheckRecordAccess(ctx, schema, row, constants.PermRead, currentUser) {
+			continue
+		}
+		record := models.LookupRecord{
+			ID:     row.GetString(constants.FieldID),
+			Name:   row.GetString(nameField),
+			Fields: make(map[string]interface{}, len(result.Fields)),
+		}
+		for _, f := range result.Fields {
+			record.Fields[f] = row[f]
+		}
+		result.Records = append(result.Records, record)
+	}
+	return result, nil
+}
+
+// lookupField resolves an "object.field" reference to a lookup field pointing at target
+func (qs *QueryService) lookupField(ctx context.Context, ref, target string) (*models.FieldMetadata, error) {
+	objectName, fieldName, ok := strings.Cut(ref, ".")
+	if !ok {
+		return nil, pkgErrors.NewValidationError("field", "must be written as object.field")
+	}
+	source := qs.metadata.GetSchema(ctx, objectName)
+	if source == nil {
+		return nil, pkgErrors.NewNotFoundError("Object", objectName)
+	}
+	field := FindField(source, fieldName)
+	if field == nil {
+		return nil, pkgErrors.NewNotFoundError("Field", ref)
+	}
+	if field.Type != constants.FieldTypeLookup || !ContainsStringIgnoreCase(field.ReferenceTo, target) {
+		return nil, pkgErrors.NewValidationError("field", fmt.Sprintf("'%s' is not a lookup to %s", ref, target))
+	}
+	return field, nil
+}
+
+// lookupDisplayFields returns the compact layout fields of the object the user may read,
+// without the ID and name which every lookup record carries anyway
+func (qs *QueryService) lookupDisplayFields(ctx context.Context, schema *models.ObjectMetadata, nameField string, currentUser *models.UserSession) []string {
+	fields := []string{}
+	var profileID *string
+	if currentUser != nil {
+		profileID = &currentUser.ProfileID
+	}
+	layout := qs.metadata.GetLayout(ctx, schema.APIName, profileID, "")
+	if layout == nil {
+		return fields
+	}
+	for _, name := range layout.CompactLayout {
+		if name == constants.FieldID || name == nameField || FindField(schema, name) == nil {
+			continue
+		}
+		if qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, name, currentUser) {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// bindLookupFilter replaces the $source.<field> references of a lookup filter with literals
+// of the edited record's values. Fields without a value compare as nil.
+func bindLookupFilter(expr string, source map[string]interface{}) string {
+	return bindFilterRefs(expr, lookupSourceRef, source)
+}
+
+// bindFilterRefs replaces the references ref matches in a filter expression with literals of
+// the values named by their first submatch. Missing values compare as nil.
+func bindFilterRefs(expr string, ref *regexp.Regexp, values map[string]interface{}) string {
+	return ref.ReplaceAllStringFunc(expr, func(match string) string {
+		name := ref.FindStringSubmatch(match)[1]
+		switch v := values[name].(type) {
+		case nil:
+			return "nil"
+		case string:
+			return strconv.Quote(v)
+		case bool:
+			return strconv.FormatBool(v)
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case int:
+			return strconv.Itoa(v)
+		case int64:
+			return strconv.FormatInt(v, 10)
+		default:
+			return strconv.Quote(fmt.Sprint(v))
+		}
+	})
+}
+
+// validateLookupFilter checks that a lookup filter is a valid filter expression. Filters are
+// only allowed on lookup fields.
+func validateLookupFilter(field *models.FieldMetadata) error {
+	if field.LookupFilter == nil {
+		return nil
+	}
+	if field.Type != constants.FieldTypeLookup {
+		return pkgErrors.NewValidationError(constants.FieldSysField_LookupFilter, "only lookup fields can have a lookup filter")
+	}
+	if _, _, err := formula.ToSQL(bindLookupFilter(*field.LookupFilter, nil)); err != nil {
+		return pkgErrors.NewValidationError(constants.FieldSysField_LookupFilter, fmt.Sprintf("invalid filter: %v", err))
+	}
+	return nil
+}
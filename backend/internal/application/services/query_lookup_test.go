@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindLookupFilter(t *testing.T) {
+	filter := `account_id == $source.account_id && amount > $source.min_amount && is_active == $source.active`
+
+	bound := bindLookupFilter(filter, map[string]interface{}{
+		"account_id": `acme "west"`,
+		"min_amount": 1500.5,
+		"active":     true,
+	})
+	assert.Equal(t, `account_id == "acme \"west\"" && amount > 1500.5 && is_active == true`, bound)
+
+	// Values the edited record does not have compare as nil
+	assert.Equal(t, `account_id == nil && amount > nil && is_active == nil`, bindLookupFilter(filter, nil))
+}
+
+func TestValidateLookupFilter(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	valid := &models.FieldMetadata{APIName: "contact_id", Type: constants.FieldTypeLookup, LookupFilter: str("account_id == $source.account_id")}
+	assert.NoError(t, validateLookupFilter(valid))
+
+	invalid := &models.FieldMetadata{APIName: "contact_id", Type: constants.FieldTypeLookup, LookupFilter: str("account_id ==")}
+	assert.True(t, errors.IsValidation(validateLookupFilter(invalid)))
+
+	text := &models.FieldMetadata{APIName: "name", Type: constants.FieldTypeText, LookupFilter: str("status == 'Open'")}
+	assert.True(t, errors.IsValidation(validateLookupFilter(text)))
+}
+
+func TestLookupTargetObject(t *testing.T) {
+	account := models.FieldMetadata{APIName: "account_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"account"}}
+	assert.Equal(t, "account", lookupTargetObject(account, models.SObject{"account_id": "a1"}))
+
+	what := models.FieldMetadata{APIName: "what_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"account", "opportunity"}, IsPolymorphic: true}
+	assert.Equal(t, "opportunity", lookupTargetObject(what, models.SObject{"what_id": "o1", "what_id_type": "Opportunity"}))
+	// Without a known type the name cannot be resolved
+	assert.Equal(t, "", lookupTargetObject(what, models.SObject{"what_id": "o1"}))
+	assert.Equal(t, "", lookupTargetObject(what, models.SObject{"what_id": "o1", "what_id_type": "lead"}))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"strings"
+	"time"
 
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
 	pkgErrors "github.com/nexuscrm/backend/pkg/errors"
@@ -15,11 +16,15 @@ import (
 
 // QueryService handles all query operations with formula hydration
 type QueryService struct {
-	repo        *persistence.QueryRepository
-	metadata    *MetadataService
-	permissions *PermissionService
-	validator   *SecurityValidator
-	formula     *formula.Engine
+	repo            *persistence.QueryRepository
+	metadata        *MetadataService
+	permissions     *PermissionService
+	externalObjects *ExternalObjectService // Reads external objects; nil disables them
+	validator       *SecurityValidator
+	formula         *formula.Engine
+	indexAdvisor    *IndexAdvisorService // Captures slow queries; nil disables the capture
+	governor        *QueryGovernor       // Enforces row, time and concurrency limits; nil disables them
+	setupAudit      *SetupAuditService   // Records admin SQL run through the sandbox; nil disables it
 }
 
 // NewQueryService creates a new QueryService
@@ -27,16 +32,33 @@ func NewQueryService(
 	repo *persistence.QueryRepository,
 	metadata *MetadataService,
 	permissions *PermissionService,
+	externalObjects *ExternalObjectService,
 ) *QueryService {
 	return &QueryService{
-		repo:        repo,
-		metadata:    metadata,
-		permissions: permissions,
-		validator:   NewSecurityValidator(permissions, metadata),
-		formula:     formula.NewEngine(),
+		repo:            repo,
+		metadata:        metadata,
+		permissions:     permissions,
+		externalObjects: externalObjects,
+		validator:       NewSecurityValidator(permissions, metadata),
+		formula:         formula.NewEngine(),
 	}
 }
 
+// SetIndexAdvisor sets the service that captures slow record queries
+func (qs *QueryService) SetIndexAdvisor(advisor *IndexAdvisorService) {
+	qs.indexAdvisor = advisor
+}
+
+// SetGovernor sets the governor that limits the rows, execution time and concurrency of queries
+func (qs *QueryService) SetGovernor(governor *QueryGovernor) {
+	qs.governor = governor
+}
+
+// SetSetupAudit sets the audit trail that records the admin SQL run through the sandbox
+func (qs *QueryService) SetSetupAudit(audit *SetupAuditService) {
+	qs.setupAudit = audit
+}
+
 // Query executes a query based on a QueryRequest
 func (qs *QueryService) Query(
 	ctx context.Context,
@@ -53,32 +75,123 @@ func (qs *QueryService) Query(
 		return nil, pkgErrors.NewNotFoundError("Object", req.ObjectAPIName)
 	}
 
-	// Build visible field list
-	visibleFields := qs.metadata.GetSystemFields(ctx, req.ObjectAPIName)
+	visibleFields := qs.visibleFields(ctx, schema, currentUser)
 
-	// Add custom fields that are visible
-	for _, field := range schema.Fields {
-		isSystem := field.IsSystem || field.IsNameField
-		if !isSystem && qs.permissions.CheckFieldVisibilityWithUser(ctx, req.ObjectAPIName, field.APIName, currentUser) {
-			visibleFields = append(visibleFields, field.APIName)
-			if field.IsPolymorphic {
-				visibleFields = append(visibleFields, GetPolymorphicTypeColumnName(field.APIName))
-			}
+	// Governor limits of the user's profile
+	findCtx := ctx
+	release := func() {}
+	var limits models.QueryLimits
+	if qs.governor != nil {
+		limits = qs.governor.Limits(ctx, currentUser)
+		if err := checkQueryRows(req, limits); err != nil {
+			return nil, err
+		}
+		var err error
+		if release, err = qs.governor.Acquire(currentUser, limits); err != nil {
+			return nil, err
+		}
+		if limits.MaxExecutionMs > 0 {
+			var cancel context.CancelFunc
+			findCtx, cancel = context.WithTimeout(ctx, time.Duration(limits.MaxExecutionMs)*time.Millisecond)
+			defer cancel()
 		}
 	}
 
-	// Delegate to Repository
-	results, err := qs.repo.Find(ctx, schema, req, visibleFields)
+	// Delegate to Repository, or to the external source of an external object
+	var results []models.SObject
+	var err error
+	if schema.IsExternal {
+		results, err = qs.findExternal(findCtx, schema, req, visibleFields)
+	} else {
+		started := time.Now()
+		results, err = qs.repo.Find(findCtx, schema, req, visibleFields)
+		if err == nil && qs.indexAdvisor != nil {
+			qs.indexAdvisor.Capture(req, time.Since(started))
+		}
+	}
+	release() // Hydration may query again, so the slot is freed first
 	if err != nil {
+		if findCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			return nil, queryTimeoutError(limits)
+		}
 		return nil, err
 	}
 
-	// Hydrate virtual fields (formulas, booleans)
+	// Hydrate virtual fields (formulas, booleans) and lookup display names
 	results = qs.hydrateVirtualFields(ctx, results, schema, visibleFields, currentUser)
+	if !req.SkipLookupNames {
+		results = qs.hydrateLookupNames(ctx, results, schema, visibleFields, currentUser)
+	}
 
 	return results, nil
 }
 
+// QueryByIDs loads the given records with field-level security and virtual field hydration applied.
+// Record-level access is NOT checked; callers must filter with PermissionService.CheckRecordAccess.
+func (qs *QueryService) QueryByIDs(
+	ctx context.Context,
+	objectName string,
+	ids []string,
+	currentUser *models.UserSession,
+) ([]models.SObject, error) {
+	if !qs.permissions.CheckObjectPermissionWithUser(ctx, objectName, constants.PermRead, currentUser) {
+		return nil, fmt.Errorf("insufficient permissions to read %s", objectName)
+	}
+
+	schema := qs.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return nil, pkgErrors.NewNotFoundError("Object", objectName)
+	}
+
+	visibleFields := qs.visibleFields(ctx, schema, currentUser)
+	var results []models.SObject
+	var err error
+	if schema.IsExternal {
+		results, err = qs.findExternalByIDs(ctx, schema, visibleFields, ids)
+	} else {
+		results, err = qs.repo.FindByIDs(ctx, schema.APIName, visibleFields, ids)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results = qs.hydrateVirtualFields(ctx, results, schema, visibleFields, currentUser)
+	return qs.hydrateLookupNames(ctx, results, schema, visibleFields, currentUser), nil
+}
+
+// findExternal queries the records of an external object from its source
+func (qs *QueryService) findExternal(ctx context.Context, schema *models.ObjectMetadata, req models.QueryRequest, visibleFields []string) ([]models.SObject, error) {
+	if qs.externalObjects == nil {
+		return nil, fmt.Errorf("external objects are not available")
+	}
+	return qs.externalObjects.Query(ctx, schema, req, visibleFields)
+}
+
+// findExternalByIDs loads records of an external object by ID from its source
+func (qs *QueryService) findExternalByIDs(ctx context.Context, schema *models.ObjectMetadata, visibleFields []string, ids []string) ([]models.SObject, error) {
+	if qs.externalObjects == nil {
+		return nil, fmt.Errorf("external objects are not available")
+	}
+	return qs.externalObjects.FindByIDs(ctx, schema, visibleFields, ids)
+}
+
+// visibleFields builds the list of columns the user may read on an object
+func (qs *QueryService) visibleFields(ctx context.Context, schema *models.ObjectMetadata, currentUser *models.UserSession) []string {
+	visibleFields := qs.metadata.GetSystemFields(ctx, schema.APIName)
+
+	// Add custom fields that are visible
+	for _, field := range schema.Fields {
+		isSystem := field.IsSystem || field.IsNameField
+		if !isSystem && qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+			visibleFields = append(visibleFields, field.APIName)
+			if field.IsPolymorphic {
+				visibleFields = append(visibleFields, GetPolymorphicTypeColumnName(field.APIName))
+			}
+		}
+	}
+	return visibleFields
+}
+
 // QueryWithFilter executes a query with a formula expression filter
 func (qs *QueryService) QueryWithFilter(
 	ctx context.Context,
@@ -109,6 +222,9 @@ func (qs *QueryService) SearchSingleObject(ctx context.Context, objectName strin
 	if schema == nil {
 		return nil, pkgErrors.NewNotFoundError("Object", objectName)
 	}
+	if !schema.Searchable {
+		return []models.SObject{}, nil
+	}
 
 	// Find searchable fields
 	searchFields := make([]string, 0)
@@ -231,8 +347,8 @@ func (qs *QueryService) RunAnalytics(ctx context.Context, analyticsQuery models.
 		return nil, pkgErrors.NewNotFoundError("Object", objectName)
 	}
 
-	// Delegate to Repository
-	val, err := qs.repo.RunAnalytics(ctx, objectName, analyticsQuery)
+	// Delegate to Repository; analytics tolerate replica lag
+	val, err := qs.repo.RunAnalytics(persistence.AllowStaleReads(ctx), objectName, analyticsQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -245,15 +361,29 @@ func (qs *QueryService) RunAnalytics(ctx context.Context, analyticsQuery models.
 	return val, nil
 }
 
-// ExecuteRawSQL executes a raw SQL query with parameters (Validated and Secured)
-func (qs *QueryService) ExecuteRawSQL(ctx context.Context, sql string, params []interface{}, user *models.UserSession) ([]models.SObject, error) {
-	// Validate and Rewrite SQL (RLS/FLS)
-	// This ensures users can only see what they are allowed to see.
-	safeSQL, safeParams, err := qs.validator.ValidateAndRewrite(ctx, sql, params, user)
-	if err != nil {
-		return nil, fmt.Errorf("security validation failed: %w", err)
+// ComputeListViewFooter evaluates a list view's footer aggregates over the records matching its filter.
+// Aggregates over fields the user cannot see are omitted.
+func (qs *QueryService) ComputeListViewFooter(ctx context.Context, view *models.ListView, currentUser *models.UserSession) (*models.ListViewFooter, error) {
+	if !qs.permissions.CheckObjectPermissionWithUser(ctx, view.ObjectAPIName, constants.PermRead, currentUser) {
+		return nil, pkgErrors.NewPermissionError(constants.PermRead, view.ObjectAPIName)
 	}
 
-	// Delegate to Repository
-	return qs.repo.ExecuteRawSQL(ctx, safeSQL, safeParams)
+	schema := qs.metadata.GetSchema(ctx, view.ObjectAPIName)
+	if schema == nil {
+		return nil, pkgErrors.NewNotFoundError("Object", view.ObjectAPIName)
+	}
+
+	aggregates := make([]models.ListViewAggregate, 0, len(view.Aggregates))
+	for _, agg := range view.Aggregates {
+		if agg.Field != "" && !qs.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, agg.Field, currentUser) {
+			continue
+		}
+		aggregates = append(aggregates, agg)
+	}
+
+	values, err := qs.repo.RunAggregates(ctx, schema, view.FilterExpr, aggregates)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ListViewFooter{ListViewID: view.ID, Values: values}, nil
 }
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// maxRecentItemsPerUser caps the recent items history kept for each user
+	maxRecentItemsPerUser = 100
+	// defaultRecentItemsPerObject is the default list size per object in GetRecent
+	defaultRecentItemsPerObject = 10
+)
+
+// RecentItemsService maintains _System_Recent as users view records and serves
+// per-object most-recently-viewed lists.
+type RecentItemsService struct {
+	repo        *persistence.SystemRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+}
+
+// NewRecentItemsService creates a new RecentItemsService
+func NewRecentItemsService(repo *persistence.SystemRepository, metadata *MetadataService, permissions *PermissionService) *RecentItemsService {
+	return &RecentItemsService{
+		repo:        repo,
+		metadata:    metadata,
+		permissions: permissions,
+	}
+}
+
+// TrackViews records that the user viewed the given records.
+// Tracking is best-effort: failures are logged, never returned.
+func (s *RecentItemsService) TrackViews(ctx context.Context, objectName string, records []models.SObject, currentUser *models.UserSession) {
+	if currentUser == nil || currentUser.ID == "" || len(records) == 0 || constants.IsSystemTable(objectName) {
+		return
+	}
+	schema := s.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return
+	}
+
+	// Only the newest views survive pruning, so never write more than the cap
+	if len(records) > maxRecentItemsPerUser {
+		records = records[:maxRecentItemsPerUser]
+	}
+
+	nameField := GetNameFieldAPIName(schema)
+	for _, record := range records {
+		recordID := record.GetString(constants.FieldID)
+		if recordID == "" {
+			continue
+		}
+		recordName := record.GetString(nameField)
+		if recordName == "" {
+			recordName = recordID
+		}
+
+		recent := &models.SystemRecent{
+			ID:            GenerateID(),
+			UserID:        currentUser.ID,
+			ObjectAPIName: schema.APIName,
+			RecordID:      recordID,
+			RecordName:    recordName,
+		}
+		if err := s.repo.UpsertRecentItem(ctx, recent); err != nil {
+			log.Printf("⚠️ [Recent] Failed to track %s/%s: %v", schema.APIName, recordID, err)
+			return
+		}
+	}
+
+	if err := s.repo.PruneRecentItems(ctx, currentUser.ID, maxRecentItemsPerUser); err != nil {
+		log.Printf("⚠️ [Recent] Failed to prune recent items for %s: %v", currentUser.ID, err)
+	}
+}
+
+// TrackViewsAsync runs TrackViews in the background so reads are not slowed by tracking writes
+func (s *RecentItemsService) TrackViewsAsync(objectName string, records []models.SObject, currentUser *models.UserSession) {
+	if currentUser == nil || len(records) == 0 {
+		return
+	}
+	go s.TrackViews(context.Background(), objectName, records, currentUser)
+}
+
+// GetRecent returns the user's most recently viewed records grouped by object,
+// most recently used object first. objectName optionally restricts the result to one object.
+// Objects the user can no longer read are omitted.
+func (s *RecentItemsService) GetRecent(ctx context.Context, objectName string, perObject int, currentUser *models.UserSession) ([]models.RecentItemGroup, error) {
+	if currentUser == nil {
+		return []models.RecentItemGroup{}, nil
+	}
+	if perObject <= 0 {
+		perObject = defaultRecentItemsPerObject
+	}
+
+	var items []*models.SystemRecent
+	var err error
+	if objectName != "" {
+		items, err = s.repo.GetRecentItemsByObject(ctx, currentUser.ID, strings.ToLower(objectName), perObject)
+	} else {
+		items, err = s.repo.GetRecentItems(ctx, currentUser.ID, maxRecentItemsPerUser)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0)
+	grouped := make(map[string]*models.RecentItemGroup)
+	denied := make(map[string]bool)
+	for _, item := range items {
+		key := strings.ToLower(item.ObjectAPIName)
+		if denied[key] {
+			continue
+		}
+
+		group, ok := grouped[key]
+		if !ok {
+			schema := s.metadata.GetSchema(ctx, key)
+			if schema == nil || !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+				denied[key] = true
+				continue
+			}
+			group = &models.RecentItemGroup{
+				ObjectLabel:   schema.PluralLabel,
+				ObjectAPIName: schema.APIName,
+				Icon:          schema.Icon,
+				Items:         make([]*models.SystemRecent, 0),
+			}
+			grouped[key] = group
+			order = append(order, key)
+		}
+
+		if len(group.Items) < perObject {
+			group.Items = append(group.Items, item)
+		}
+	}
+
+	groups := make([]models.RecentItemGroup, 0, len(order))
+	for _, key := range order {
+		groups = append(groups, *grouped[key])
+	}
+	return groups, nil
+}
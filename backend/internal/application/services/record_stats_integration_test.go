@@ -0,0 +1,49 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordStats_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	deal := h.CreateObject(t, "deal", testharness.Field("stage", constants.FieldTypeText))
+	dealLookup := testharness.Field("deal_id", constants.FieldTypeLookup)
+	dealLookup.ReferenceTo = []string{deal.APIName}
+	todo := h.CreateObject(t, "todo", testharness.Field("status", constants.FieldTypeText), dealLookup)
+
+	svc := services.NewRecordStatsService(persistence.NewRecordStatsRepository(h.DB.DB()), h.Services.Metadata,
+		[]services.RecordStatsObject{{Object: deal.APIName, StageField: "stage"}},
+		services.RecordStatsTasks{Object: todo.APIName, StatusField: "status", Closed: []string{"Done"}})
+	require.NoError(t, svc.EnsureFields(ctx))
+	require.NoError(t, svc.EnsureFields(ctx), "existing fields are left alone")
+
+	record := h.CreateRecord(t, deal.APIName, models.SObject{"name": "Big deal", "stage": "New"})
+	dealID := record[constants.FieldID]
+	require.NoError(t, svc.HandleRecordEvent(ctx, events.RecordCreated, services.RecordEventPayload{ObjectAPIName: deal.APIName, Record: record}))
+
+	task := h.CreateRecord(t, todo.APIName, models.SObject{"name": "Call", "status": "Open", "deal_id": dealID})
+	require.NoError(t, svc.HandleRecordEvent(ctx, events.RecordCreated, services.RecordEventPayload{ObjectAPIName: todo.APIName, Record: task}))
+	h.CreateRecord(t, todo.APIName, models.SObject{"name": "Mail", "status": "Done", "deal_id": dealID})
+
+	rows, err := h.Services.QuerySvc.QueryWithFilter(ctx, deal.APIName, "", h.Admin, "", "", 1)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.EqualValues(t, 1, rows[0][constants.FieldOpenTaskCount], "closed tasks are not counted")
+	assert.EqualValues(t, 0, rows[0][constants.FieldDaysInCurrentStage])
+	assert.NotNil(t, rows[0][constants.FieldStageEnteredDate])
+	assert.NotNil(t, rows[0][constants.FieldLastActivityDate])
+}
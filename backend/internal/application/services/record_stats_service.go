@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// defaultRecordStatsTasks applies when RECORD_STATS_TASKS is unset
+const defaultRecordStatsTasks = "task.status:Completed"
+
+// RecordStatsObject is an object whose records carry statistics fields. StageField, when
+// set, is the picklist whose time in the current value is tracked.
+type RecordStatsObject struct {
+	Object     string
+	StageField string
+}
+
+// RecordStatsTasks describes the object whose open records are counted on the records
+// they look up to. A task is open while its status is not one of Closed.
+type RecordStatsTasks struct {
+	Object      string
+	StatusField string
+	Closed      []string
+}
+
+// parseRecordStatsObjects parses RECORD_STATS_OBJECTS, a comma-separated list of objects
+// with an optional stage field (e.g. "account,opportunity:stage,lead:status")
+func parseRecordStatsObjects(raw string) []RecordStatsObject {
+	objects := make([]RecordStatsObject, 0)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		object, stage, _ := strings.Cut(entry, ":")
+		object = strings.ToLower(strings.TrimSpace(object))
+		if object == "" {
+			log.Printf("⚠️  Invalid RECORD_STATS_OBJECTS entry %q, expected object or object:stage_field", entry)
+			continue
+		}
+		objects = append(objects, RecordStatsObject{Object: object, StageField: strings.ToLower(strings.TrimSpace(stage))})
+	}
+	return objects
+}
+
+// recordStatsTasks parses RECORD_STATS_TASKS as object.status_field:closed_value+closed_value
+// (default "task.status:Completed")
+func recordStatsTasks(raw string) RecordStatsTasks {
+	if raw == "" {
+		raw = defaultRecordStatsTasks
+	}
+	return parseRecordStatsTasks(raw)
+}
+
+func parseRecordStatsTasks(raw string) RecordStatsTasks {
+	target, values, _ := strings.Cut(strings.TrimSpace(raw), ":")
+	object, status, ok := strings.Cut(strings.TrimSpace(target), ".")
+	object, status = strings.ToLower(strings.TrimSpace(object)), strings.ToLower(strings.TrimSpace(status))
+	if !ok || object == "" || status == "" {
+		log.Printf("⚠️  Invalid RECORD_STATS_TASKS %q, expected object.status_field:closed_value+closed_value", raw)
+		return RecordStatsTasks{}
+	}
+	tasks := RecordStatsTasks{Object: object, StatusField: status, Closed: make([]string, 0)}
+	for _, value := range strings.Split(values, "+") {
+		if value = strings.TrimSpace(value); value != "" {
+			tasks.Closed = append(tasks.Closed, value)
+		}
+	}
+	return tasks
+}
+
+// RecordStatsService maintains read-only statistics fields on the configured objects:
+// last_activity_date (latest task change or captured email, event or call),
+// open_task_count, and for objects with a stage field stage_entered_date and
+// days_in_current_stage. They are plain columns, so list views, reports and formulas
+// filter on them without custom flows.
+type RecordStatsService struct {
+	repo     *persistence.RecordStatsRepository
+	metadata *MetadataService
+	objects  []RecordStatsObject
+	tasks    RecordStatsTasks
+
+	mu          sync.Mutex // Serializes day count refreshes
+	lastRefresh string     // Date of the last day count refresh
+}
+
+// NewRecordStatsService creates a new RecordStatsService
+func NewRecordStatsService(repo *persistence.RecordStatsRepository, metadata *MetadataService, objects []RecordStatsObject, tasks RecordStatsTasks) *RecordStatsService {
+	return &RecordStatsService{
+		repo:     repo,
+		metadata: metadata,
+		objects:  objects,
+		tasks:    tasks,
+	}
+}
+
+// Enabled reports whether any object keeps statistics
+func (s *RecordStatsService) Enabled() bool {
+	return len(s.objects) > 0
+}
+
+// object returns the statistics configuration of an object, or nil
+func (s *RecordStatsService) object(objectAPIName string) *RecordStatsObject {
+	for i := range s.objects {
+		if strings.EqualFold(s.objects[i].Object, objectAPIName) {
+			return &s.objects[i]
+		}
+	}
+	return nil
+}
+
+// statsFields are the fields an object keeps statistics in
+func statsFields(cfg RecordStatsObject) []models.FieldMetadata {
+	fields := []models.FieldMetadata{
+		{APIName: constants.FieldLastActivityDate, Label: "Last Activity Date", Type: constants.FieldTypeDateTime},
+		{APIName: constants.FieldOpenTaskCount, Label: "Open Tasks", Type: constants.FieldTypeNumber},
+	}
+	if cfg.StageField != "" {
+		fields = append(fields,
+			models.FieldMetadata{APIName: constants.FieldStageEnteredDate, Label: "Stage Entered Date", Type: constants.FieldTypeDateTime},
+			models.FieldMetadata{APIName: constants.FieldDaysInCurrentStage, Label: "Days in Current Stage", Type: constants.FieldTypeNumber},
+		)
+	}
+	return fields
+}
+
+// EnsureFields adds the missing statistics fields to the configured objects as read-only
+// system fields. Objects that do not exist (yet) are skipped.
+func (s *RecordStatsService) EnsureFields(ctx context.Context) error {
+	for _, cfg := range s.objects {
+		schema := s.metadata.GetSchema(ctx, cfg.Object)
+		if schema == nil {
+			log.Printf("⚠️  RECORD_STATS_OBJECTS names unknown object %s", cfg.Object)
+			continue
+		}
+		if cfg.StageField != "" && FindField(schema, cfg.StageField) == nil {
+			return fmt.Errorf("stage field %s.%s not found", cfg.Object, cfg.StageField)
+		}
+		for _, field := range statsFields(cfg) {
+			if FindField(schema, field.APIName) != nil {
+				continue
+			}
+			field.IsSystem = true
+			if err := s.metadata.CreateField(ctx, schema.APIName, &field); err != nil {
+				return fmt.Errorf("failed to add %s to %s: %w", field.APIName, schema.APIName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// RegisterHandlers subscribes to after-commit record events to keep statistics current
+func (s *RecordStatsService) RegisterHandlers(eventBus *EventBus) {
+	if !s.Enabled() {
+		return
+	}
+
+	handler := func(eventType events.EventType) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			// Statistics failures must not fail the outbox event (flows share the same dispatch)
+			if err := s.HandleRecordEvent(ctx, eventType, recordPayload); err != nil {
+				log.Printf("⚠️ [RecordStats] Failed to update statistics for %s/%s: %v", recordPayload.ObjectAPIName, recordPayload.Record.GetString(constants.FieldID), err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(events.RecordCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(events.RecordUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(events.RecordDeleted))
+
+	log.Printf("📈 Record statistics subscribed to record events for %d object(s)", len(s.objects))
+}
+
+// HandleRecordEvent restarts the stage clock of a record whose stage changed, and
+// recounts the open tasks of the records a task looks up to (before and after the change)
+func (s *RecordStatsService) HandleRecordEvent(ctx context.Context, eventType events.EventType, payload RecordEventPayload) error {
+	var oldRecord models.SObject
+	if payload.OldRecord != nil {
+		oldRecord = *payload.OldRecord
+	}
+
+	if cfg := s.object(payload.ObjectAPIName); cfg != nil && cfg.StageField != "" && eventType != events.RecordDeleted {
+		if oldRecord == nil || fmt.Sprint(oldRecord[cfg.StageField]) != fmt.Sprint(payload.Record[cfg.StageField]) {
+			if err := s.repo.SetStats(ctx, cfg.Object, payload.Record.GetString(constants.FieldID), map[string]interface{}{
+				constants.FieldStageEnteredDate:   NowTimestamp(),
+				constants.FieldDaysInCurrentStage: 0,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.tasks.Object == "" || !strings.EqualFold(payload.ObjectAPIName, s.tasks.Object) {
+		return nil
+	}
+	parents := s.taskParents(ctx, payload.Record, oldRecord)
+	for _, parent := range parents {
+		count, err := s.repo.CountOpenTasks(ctx, s.tasks.Object, parent.lookupField, parent.ID, s.tasks.StatusField, s.tasks.Closed)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.SetStats(ctx, parent.Object, parent.ID, map[string]interface{}{constants.FieldOpenTaskCount: count}); err != nil {
+			return err
+		}
+		if eventType != events.RecordDeleted {
+			if err := s.repo.TouchLastActivity(ctx, parent.Object, parent.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// taskParent is a statistics record a task looks up to
+type taskParent struct {
+	recordRef
+	lookupField string
+}
+
+// taskParents returns the statistics records the new and old versions of a task look up to
+func (s *RecordStatsService) taskParents(ctx context.Context, record, oldRecord models.SObject) []taskParent {
+	schema := s.metadata.GetSchema(ctx, s.tasks.Object)
+	if schema == nil {
+		return nil
+	}
+	parents := make([]taskParent, 0)
+	seen := make(map[string]bool)
+	for _, field := range schema.Fields {
+		if field.Type != constants.FieldTypeLookup || len(field.ReferenceTo) == 0 {
+			continue
+		}
+		for _, version := range []models.SObject{record, oldRecord} {
+			id := version.GetString(field.APIName)
+			if id == "" {
+				continue
+			}
+			target := field.ReferenceTo[0]
+			if field.IsPolymorphic {
+				target = version.GetString(GetPolymorphicTypeColumnName(field.APIName))
+			}
+			cfg := s.object(target)
+			if cfg == nil || seen[field.APIName+"/"+id] {
+				continue
+			}
+			seen[field.APIName+"/"+id] = true
+			parents = append(parents, taskParent{recordRef: recordRef{Object: cfg.Object, ID: id}, lookupField: field.APIName})
+		}
+	}
+	return parents
+}
+
+// RecordActivities moves the last activity date of the records captured activities
+// (emails, events, calls) are linked to
+func (s *RecordStatsService) RecordActivities(ctx context.Context, activities []*models.SystemActivity) {
+	if s == nil {
+		return
+	}
+	for _, a := range activities {
+		if s.object(a.ObjectAPIName) == nil {
+			continue
+		}
+		if err := s.repo.TouchLastActivity(ctx, strings.ToLower(a.ObjectAPIName), a.RecordID, a.ActivityDate); err != nil {
+			log.Printf("⚠️ [RecordStats] %v", err)
+		}
+	}
+}
+
+// Run refreshes days_in_current_stage once a day; it is called on the scheduler tick
+func (s *RecordStatsService) Run(ctx context.Context, now time.Time) {
+	today := now.Format(time.DateOnly)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastRefresh == today {
+		return
+	}
+	for _, cfg := range s.objects {
+		if cfg.StageField == "" {
+			continue
+		}
+		if err := s.refreshDaysInStage(ctx, cfg.Object, now); err != nil {
+			log.Printf("⚠️ [RecordStats] Failed to refresh days in stage of %s: %v", cfg.Object, err)
+			return
+		}
+	}
+	s.lastRefresh = today
+}
+
+// refreshDaysInStage recomputes the day counts of an object's records that changed since
+// they were last stored
+func (s *RecordStatsService) refreshDaysInStage(ctx context.Context, object string, now time.Time) error {
+	ages, err := s.repo.StageAges(ctx, object)
+	if err != nil {
+		return err
+	}
+	stale := make(map[int][]string)
+	for _, age := range ages {
+		days := daysBetween(age.EnteredDate, now)
+		if !age.HasDaysValue || age.DaysInStage != days {
+			stale[days] = append(stale[days], age.RecordID)
+		}
+	}
+	for days, ids := range stale {
+		if err := s.repo.SetDaysInStage(ctx, object, days, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// daysBetween counts the calendar days from one time to a later one
+func daysBetween(from, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	if days := int(toDay.Sub(fromDay).Hours() / 24); days > 0 {
+		return days
+	}
+	return 0
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecordStatsObjects(t *testing.T) {
+	assert.Equal(t, []RecordStatsObject{
+		{Object: "account"},
+		{Object: "opportunity", StageField: "stage"},
+	}, parseRecordStatsObjects(" Account, ,opportunity:Stage,:status"))
+	assert.Empty(t, parseRecordStatsObjects(""))
+}
+
+func TestParseRecordStatsTasks(t *testing.T) {
+	assert.Equal(t, RecordStatsTasks{Object: "task", StatusField: "status", Closed: []string{"Completed", "Cancelled"}},
+		parseRecordStatsTasks("Task.Status:Completed+ Cancelled"))
+	assert.Equal(t, RecordStatsTasks{Object: "task", StatusField: "status", Closed: []string{}},
+		parseRecordStatsTasks("task.status"))
+	assert.Equal(t, RecordStatsTasks{}, parseRecordStatsTasks("task:Completed"))
+}
+
+func TestDaysBetween(t *testing.T) {
+	entered := time.Date(2024, 2, 28, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, 0, daysBetween(entered, entered.Add(10*time.Minute)))
+	assert.Equal(t, 2, daysBetween(entered, time.Date(2024, 3, 1, 0, 5, 0, 0, time.UTC)))
+	assert.Equal(t, 0, daysBetween(entered, entered.AddDate(0, 0, -3)), "clock skew never yields negative counts")
+}
@@ -0,0 +1,792 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultReportRowLimit = 2000
+	maxReportRowLimit     = 10000
+	maxReportGroups       = 1000
+	maxReportGroupings    = 3
+	maxReportColumns      = 50
+)
+
+// ReportEngine executes report definitions. Field references may cross one lookup
+// relationship ("account_id.industry"), which is resolved to a LEFT JOIN on the parent object.
+// Object and field-level security of the running user is applied to every referenced field.
+type ReportEngine struct {
+	repo        *persistence.ReportRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+}
+
+// NewReportEngine creates a new ReportEngine
+func NewReportEngine(repo *persistence.ReportRepository, metadata *MetadataService, permissions *PermissionService) *ReportEngine {
+	return &ReportEngine{
+		repo:        repo,
+		metadata:    metadata,
+		permissions: permissions,
+	}
+}
+
+// reportField is a resolved field reference of a report
+type reportField struct {
+	ref   persistence.ReportField
+	meta  *models.FieldMetadata
+	label string
+}
+
+// compiledReport is a report resolved against metadata and the running user's permissions
+type compiledReport struct {
+	report     *models.Report
+	plan       persistence.ReportPlan
+	columns    []reportField
+	groupings  []reportField
+	sort       *reportField
+	aggregates []models.ListViewAggregate
+	rowLimit   int
+}
+
+// reportResolver resolves field paths of one report, registering the joins they need
+type reportResolver struct {
+	ctx    context.Context
+	engine *ReportEngine
+	user   *models.UserSession
+	schema *models.ObjectMetadata
+	joins  []persistence.ReportJoin
+	fields map[string]reportField
+}
+
+// Run executes a report as the given user
+func (e *ReportEngine) Run(ctx context.Context, report *models.Report, currentUser *models.UserSession) (*models.ReportResult, error) {
+	compiled, err := e.compile(ctx, report, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	// Access was checked on the primary; the report queries themselves may read a replica
+	ctx = persistence.AllowStaleReads(ctx)
+
+	result := &models.ReportResult{
+		ReportID: report.ID,
+		Name:     report.Name,
+		Format:   report.Format,
+		Columns:  make([]models.ReportResultColumn, 0, len(compiled.columns)),
+		RunAt:    time.Now(),
+	}
+	for _, col := range compiled.columns {
+		result.Columns = append(result.Columns, resultColumn(col))
+	}
+	for _, g := range compiled.groupings {
+		result.Groupings = append(result.Groupings, resultColumn(g))
+	}
+	for _, agg := range compiled.aggregates {
+		result.Aggregates = append(result.Aggregates, persistence.AggregateAlias(agg.Function, agg.Field))
+	}
+
+	totals, err := e.repo.RunReportAggregates(ctx, compiled.plan, nil, compiled.aggregates, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) > 0 {
+		result.TotalCount, result.GrandTotals = splitReportAggregates(totals[0], compiled.aggregates)
+	}
+
+	switch report.Format {
+	case constants.ReportFormatMatrix:
+		result.Matrix, result.Truncated, err = e.runMatrix(ctx, compiled)
+	case constants.ReportFormatSummary:
+		result.Groups, result.Truncated, err = e.runSummary(ctx, compiled)
+	default:
+		result.Rows, result.Truncated, err = e.runRows(ctx, compiled, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Validate checks that a report resolves against the object schema and the user's permissions
+func (e *ReportEngine) Validate(ctx context.Context, report *models.Report, currentUser *models.UserSession) error {
+	_, err := e.compile(ctx, report, currentUser)
+	return err
+}
+
+// runRows fetches up to the row limit of detail rows, including the extra fields (grouping keys)
+func (e *ReportEngine) runRows(ctx context.Context, compiled *compiledReport, extra []reportField) ([]models.SObject, bool, error) {
+	fields := []persistence.ReportField{{Key: constants.FieldID, Table: compiled.plan.Table, Column: constants.FieldID}}
+	seen := map[string]bool{constants.FieldID: true}
+	for _, f := range append(append([]reportField{}, compiled.columns...), extra...) {
+		if !seen[f.ref.Key] {
+			seen[f.ref.Key] = true
+			fields = append(fields, f.ref)
+		}
+	}
+
+	var sortRef *persistence.ReportField
+	if compiled.sort != nil {
+		sortRef = &compiled.sort.ref
+	}
+	rows, err := e.repo.RunReportRows(ctx, compiled.plan, fields, sortRef, compiled.report.SortDirection, compiled.rowLimit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rows) > compiled.rowLimit {
+		return rows[:compiled.rowLimit], true, nil
+	}
+	return rows, false, nil
+}
+
+// runSummary computes subtotals for every grouping level and attaches detail rows to the leaf groups
+func (e *ReportEngine) runSummary(ctx context.Context, compiled *compiledReport) ([]models.ReportGroup, bool, error) {
+	truncated := false
+	levels := make([][]models.SObject, len(compiled.groupings))
+	for i := range compiled.groupings {
+		groupBy := make([]persistence.ReportField, 0, i+1)
+		for _, g := range compiled.groupings[:i+1] {
+			groupBy = append(groupBy, g.ref)
+		}
+		records, err := e.repo.RunReportAggregates(ctx, compiled.plan, groupBy, compiled.aggregates, maxReportGroups+1)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(records) > maxReportGroups {
+			records = records[:maxReportGroups]
+			truncated = true
+		}
+		levels[i] = records
+	}
+
+	// A summary without columns is subtotals only
+	var rows []models.SObject
+	rowsTruncated := false
+	if len(compiled.report.Columns) > 0 {
+		var err error
+		if rows, rowsTruncated, err = e.runRows(ctx, compiled, compiled.groupings); err != nil {
+			return nil, false, err
+		}
+	}
+
+	keys := make([]string, 0, len(compiled.groupings))
+	for _, g := range compiled.groupings {
+		keys = append(keys, g.ref.Key)
+	}
+	return buildReportGroups(keys, levels, rows, compiled.aggregates), truncated || rowsTruncated, nil
+}
+
+// runMatrix computes cell, row, and column aggregates of a matrix report
+func (e *ReportEngine) runMatrix(ctx context.Context, compiled *compiledReport) (*models.ReportMatrix, bool, error) {
+	rowField, colField := compiled.groupings[0], compiled.groupings[1]
+	truncated := false
+	run := func(groupBy ...persistence.ReportField) ([]models.SObject, error) {
+		records, err := e.repo.RunReportAggregates(ctx, compiled.plan, groupBy, compiled.aggregates, maxReportGroups+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) > maxReportGroups {
+			truncated = true
+			records = records[:maxReportGroups]
+		}
+		return records, nil
+	}
+
+	cells, err := run(rowField.ref, colField.ref)
+	if err != nil {
+		return nil, false, err
+	}
+	rowTotals, err := run(rowField.ref)
+	if err != nil {
+		return nil, false, err
+	}
+	colTotals, err := run(colField.ref)
+	if err != nil {
+		return nil, false, err
+	}
+
+	matrix := &models.ReportMatrix{
+		RowGrouping:    rowField.ref.Key,
+		ColumnGrouping: colField.ref.Key,
+		Cells:          make([]models.ReportMatrixCell, 0, len(cells)),
+		RowTotals:      matrixTotals(rowTotals, rowField.ref.Key, compiled.aggregates),
+		ColumnTotals:   matrixTotals(colTotals, colField.ref.Key, compiled.aggregates),
+	}
+	for _, rec := range cells {
+		count, values := splitReportAggregates(rec, compiled.aggregates)
+		matrix.Cells = append(matrix.Cells, models.ReportMatrixCell{
+			Row:    rec[rowField.ref.Key],
+			Column: rec[colField.ref.Key],
+			Count:  count,
+			Values: values,
+		})
+	}
+	sort.SliceStable(matrix.Cells, func(i, j int) bool {
+		a, b := matrix.Cells[i], matrix.Cells[j]
+		if reportValueKey(a.Row) != reportValueKey(b.Row) {
+			return lessReportValue(a.Row, b.Row)
+		}
+		return lessReportValue(a.Column, b.Column)
+	})
+	return matrix, truncated, nil
+}
+
+// compile validates a report definition and resolves it into a query plan
+func (e *ReportEngine) compile(ctx context.Context, report *models.Report, currentUser *models.UserSession) (*compiledReport, error) {
+	if err := normalizeReport(report); err != nil {
+		return nil, err
+	}
+	if !e.permissions.CheckObjectPermissionWithUser(ctx, report.ObjectAPIName, constants.PermRead, currentUser) {
+		return nil, errors.NewPermissionError(constants.PermRead, report.ObjectAPIName)
+	}
+	schema := e.metadata.GetSchema(ctx, report.ObjectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", report.ObjectAPIName)
+	}
+
+	r := &reportResolver{
+		ctx:    ctx,
+		engine: e,
+		user:   currentUser,
+		schema: schema,
+		fields: make(map[string]reportField),
+	}
+	compiled := &compiledReport{report: report, rowLimit: report.RowLimit}
+	if compiled.rowLimit <= 0 {
+		compiled.rowLimit = defaultReportRowLimit
+	}
+
+	// Columns the user cannot see are left out; everything else must be visible
+	for i, col := range report.Columns {
+		f, visible, err := r.resolve(col.Field)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			continue
+		}
+		if report.Columns[i].Label != "" {
+			f.label = report.Columns[i].Label
+		}
+		compiled.columns = append(compiled.columns, f)
+	}
+
+	for _, grouping := range append(append([]string{}, report.Groupings...), report.ColumnGroupings...) {
+		path, bucket, bucketed := strings.Cut(grouping, ":")
+		f, err := r.resolveVisible(path)
+		if err != nil {
+			return nil, err
+		}
+		if f.meta.Type == constants.FieldTypeLongTextArea || f.meta.Type == constants.FieldTypeJSON {
+			return nil, errors.NewValidationError("groupings", fmt.Sprintf("cannot group by %s field '%s'", f.meta.Type, path))
+		}
+		if bucketed {
+			if f, err = bucketReportField(f, grouping, constants.ReportDateBucket(bucket)); err != nil {
+				return nil, err
+			}
+		}
+		compiled.groupings = append(compiled.groupings, f)
+	}
+
+	if report.SortField != "" {
+		f, err := r.resolveVisible(report.SortField)
+		if err != nil {
+			return nil, err
+		}
+		compiled.sort = &f
+	}
+
+	for _, agg := range report.Aggregates {
+		if err := validateListViewAggregate(schema, agg.Function, agg.Field); err != nil {
+			return nil, err
+		}
+		// The row count is always computed
+		if agg.Field == "" {
+			continue
+		}
+		if !e.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, agg.Field, currentUser) {
+			continue
+		}
+		compiled.aggregates = append(compiled.aggregates, models.ListViewAggregate{Field: FindField(schema, agg.Field).APIName, Function: agg.Function})
+	}
+
+	var where string
+	var params []interface{}
+	if report.FilterExpr != "" {
+		var err error
+		where, params, err = formula.ToSQLWithResolver(report.FilterExpr, func(path string) (string, error) {
+			f, err := r.resolveVisible(path)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("`%s`.`%s`", f.ref.Table, f.ref.Column), nil
+		})
+		if err != nil {
+			if _, ok := err.(errors.AppError); ok {
+				return nil, err
+			}
+			return nil, errors.NewValidationError("filter_expr", err.Error())
+		}
+	}
+
+	compiled.plan = persistence.ReportPlan{
+		Table:          schema.APIName,
+		ExcludeDeleted: FindField(schema, constants.FieldIsDeleted) != nil,
+		Joins:          r.joins,
+		Where:          where,
+		Params:         params,
+	}
+	return compiled, nil
+}
+
+// resolveVisible resolves a field path that the user must be able to see
+func (r *reportResolver) resolveVisible(path string) (reportField, error) {
+	f, visible, err := r.resolve(path)
+	if err != nil {
+		return reportField{}, err
+	}
+	if !visible {
+		return reportField{}, errors.NewPermissionError(constants.PermRead, r.schema.APIName+"."+path)
+	}
+	return f, nil
+}
+
+// resolve maps "field" or "lookup_field.parent_field" to a column, reporting whether the user can see it.
+// Joins are only registered for visible fields.
+func (r *reportResolver) resolve(path string) (reportField, bool, error) {
+	if f, ok := r.fields[path]; ok {
+		return f, true, nil
+	}
+
+	parts := strings.Split(path, ".")
+	switch len(parts) {
+	case 1:
+		field, err := reportableField(r.schema, path)
+		if err != nil {
+			return reportField{}, false, err
+		}
+		if !r.engine.permissions.CheckFieldVisibilityWithUser(r.ctx, r.schema.APIName, field.APIName, r.user) {
+			return reportField{}, false, nil
+		}
+		f := reportField{
+			ref:   persistence.ReportField{Key: path, Table: r.schema.APIName, Column: field.APIName},
+			meta:  field,
+			label: field.Label,
+		}
+		r.fields[path] = f
+		return f, true, nil
+	case 2:
+	default:
+		return reportField{}, false, errors.NewValidationError("field", fmt.Sprintf("'%s' crosses more than one relationship", path))
+	}
+
+	lookup := FindField(r.schema, parts[0])
+	if lookup == nil || (lookup.Type != constants.FieldTypeLookup && lookup.Type != constants.FieldTypeMasterDetail) || len(lookup.ReferenceTo) != 1 {
+		return reportField{}, false, errors.NewValidationError("field", fmt.Sprintf("'%s' is not a single-object lookup on %s", parts[0], r.schema.APIName))
+	}
+	parent := r.engine.metadata.GetSchema(r.ctx, lookup.ReferenceTo[0])
+	if parent == nil {
+		return reportField{}, false, errors.NewNotFoundError("Object", lookup.ReferenceTo[0])
+	}
+	field, err := reportableField(parent, parts[1])
+	if err != nil {
+		return reportField{}, false, err
+	}
+	if !r.engine.permissions.CheckFieldVisibilityWithUser(r.ctx, r.schema.APIName, lookup.APIName, r.user) ||
+		!r.engine.permissions.CheckObjectPermissionWithUser(r.ctx, parent.APIName, constants.PermRead, r.user) ||
+		!r.engine.permissions.CheckFieldVisibilityWithUser(r.ctx, parent.APIName, field.APIName, r.user) {
+		return reportField{}, false, nil
+	}
+
+	alias := "rel_" + lookup.APIName
+	registered := false
+	for _, join := range r.joins {
+		if join.Alias == alias {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		r.joins = append(r.joins, persistence.ReportJoin{
+			Alias:          alias,
+			Table:          parent.APIName,
+			LookupField:    lookup.APIName,
+			ExcludeDeleted: FindField(parent, constants.FieldIsDeleted) != nil,
+		})
+	}
+
+	f := reportField{
+		ref:   persistence.ReportField{Key: path, Table: alias, Column: field.APIName},
+		meta:  field,
+		label: lookup.Label + ": " + field.Label,
+	}
+	r.fields[path] = f
+	return f, true, nil
+}
+
+// bucketReportField groups a Date or DateTime field by period, keyed by the full grouping
+// ("close_date:month") so the bucketed and the plain field can be told apart
+func bucketReportField(f reportField, grouping string, bucket constants.ReportDateBucket) (reportField, error) {
+	if f.meta.Type != constants.FieldTypeDate && f.meta.Type != constants.FieldTypeDateTime {
+		return reportField{}, errors.NewValidationError("groupings", fmt.Sprintf("'%s' is not a date field and cannot be bucketed", f.ref.Key))
+	}
+	switch bucket {
+	case constants.ReportDateBucketDay, constants.ReportDateBucketWeek, constants.ReportDateBucketMonth,
+		constants.ReportDateBucketQuarter, constants.ReportDateBucketYear:
+	default:
+		return reportField{}, errors.NewValidationError("groupings", fmt.Sprintf("unknown date bucket '%s'; use day, week, month, quarter or year", bucket))
+	}
+	f.ref.Key = grouping
+	f.ref.Bucket = bucket
+	f.label = fmt.Sprintf("%s (%s)", f.label, strings.ToUpper(string(bucket[:1]))+string(bucket[1:]))
+	return f, nil
+}
+
+// reportableField finds a stored (non-formula) field of an object
+func reportableField(schema *models.ObjectMetadata, name string) (*models.FieldMetadata, error) {
+	field := FindField(schema, name)
+	if field == nil {
+		return nil, errors.NewValidationError("field", fmt.Sprintf("unknown field '%s' on %s", name, schema.APIName))
+	}
+	if field.Type == constants.FieldTypeFormula {
+		return nil, errors.NewValidationError("field", fmt.Sprintf("formula field '%s' cannot be used in reports", name))
+	}
+	return field, nil
+}
+
+// normalizeReport checks the shape of a report definition independent of object metadata
+func normalizeReport(report *models.Report) error {
+	report.ObjectAPIName = strings.TrimSpace(report.ObjectAPIName)
+	if report.ObjectAPIName == "" {
+		return errors.NewValidationError(constants.FieldSysReport_ObjectAPIName, "is required")
+	}
+	if report.Format == "" {
+		report.Format = constants.ReportFormatTabular
+	}
+	if len(report.Columns) > maxReportColumns {
+		return errors.NewValidationError(constants.FieldSysReport_Columns, fmt.Sprintf("must not exceed %d columns", maxReportColumns))
+	}
+
+	switch report.Format {
+	case constants.ReportFormatTabular:
+		if len(report.Groupings) > 0 || len(report.ColumnGroupings) > 0 {
+			return errors.NewValidationError(constants.FieldSysReport_Groupings, "tabular reports cannot be grouped")
+		}
+	case constants.ReportFormatSummary:
+		if len(report.Groupings) == 0 || len(report.Groupings) > maxReportGroupings {
+			return errors.NewValidationError(constants.FieldSysReport_Groupings, fmt.Sprintf("summary reports need 1 to %d groupings", maxReportGroupings))
+		}
+		if len(report.ColumnGroupings) > 0 {
+			return errors.NewValidationError(constants.FieldSysReport_ColumnGroupings, "only matrix reports have column groupings")
+		}
+	case constants.ReportFormatMatrix:
+		if len(report.Groupings) != 1 || len(report.ColumnGroupings) != 1 {
+			return errors.NewValidationError(constants.FieldSysReport_Groupings, "matrix reports need exactly one row and one column grouping")
+		}
+	default:
+		return errors.NewValidationError(constants.FieldSysReport_Format, fmt.Sprintf("unsupported format '%s'", report.Format))
+	}
+	groupings := append(append([]string{}, report.Groupings...), report.ColumnGroupings...)
+	for i, g := range groupings {
+		if ContainsString(groupings[:i], g) {
+			return errors.NewValidationError(constants.FieldSysReport_Groupings, fmt.Sprintf("'%s' is grouped more than once", g))
+		}
+	}
+	if report.Format == constants.ReportFormatTabular && len(report.Columns) == 0 {
+		return errors.NewValidationError(constants.FieldSysReport_Columns, "at least one column is required")
+	}
+
+	for i := range report.Aggregates {
+		report.Aggregates[i].Function = strings.ToUpper(report.Aggregates[i].Function)
+	}
+
+	switch strings.ToUpper(report.SortDirection) {
+	case "":
+	case constants.SortASC, constants.SortDESC:
+		report.SortDirection = strings.ToUpper(report.SortDirection)
+	default:
+		return errors.NewValidationError(constants.FieldSysReport_SortDirection, "must be ASC or DESC")
+	}
+	if report.RowLimit < 0 || report.RowLimit > maxReportRowLimit {
+		return errors.NewValidationError(constants.FieldSysReport_RowLimit, fmt.Sprintf("must be between 0 and %d", maxReportRowLimit))
+	}
+	return nil
+}
+
+func resultColumn(f reportField) models.ReportResultColumn {
+	return models.ReportResultColumn{Key: f.ref.Key, Label: f.label, Type: string(f.meta.Type)}
+}
+
+// splitReportAggregates extracts the row count and the aggregate values of an aggregate query record
+func splitReportAggregates(rec models.SObject, aggregates []models.ListViewAggregate) (int64, map[string]interface{}) {
+	count := toInt64(rec[persistence.AggregateAlias(persistence.RollupTypeCount, "")])
+	if len(aggregates) == 0 {
+		return count, nil
+	}
+	values := make(map[string]interface{}, len(aggregates))
+	for _, agg := range aggregates {
+		alias := persistence.AggregateAlias(agg.Function, agg.Field)
+		values[alias] = rec[alias]
+	}
+	return count, values
+}
+
+// matrixTotals converts grouped aggregate records into sorted matrix totals
+func matrixTotals(records []models.SObject, key string, aggregates []models.ListViewAggregate) []models.ReportMatrixTotal {
+	totals := make([]models.ReportMatrixTotal, 0, len(records))
+	for _, rec := range records {
+		count, values := splitReportAggregates(rec, aggregates)
+		totals = append(totals, models.ReportMatrixTotal{Value: rec[key], Count: count, Values: values})
+	}
+	sort.SliceStable(totals, func(i, j int) bool { return lessReportValue(totals[i].Value, totals[j].Value) })
+	return totals
+}
+
+// buildReportGroups assembles the group tree of a summary report. levels[i] holds the aggregate
+// records grouped by keys[:i+1]; rows are attached to the leaf group matching all their keys.
+func buildReportGroups(keys []string, levels [][]models.SObject, rows []models.SObject, aggregates []models.ListViewAggregate) []models.ReportGroup {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	// Index each level's records and the detail rows by their parent group path
+	byParent := make([]map[string][]models.SObject, len(keys))
+	for i, records := range levels {
+		byParent[i] = make(map[string][]models.SObject)
+		for _, rec := range records {
+			parent := reportGroupPath(rec, keys[:i])
+			byParent[i][parent] = append(byParent[i][parent], rec)
+		}
+	}
+	rowsByGroup := make(map[string][]models.SObject)
+	for _, row := range rows {
+		path := reportGroupPath(row, keys)
+		rowsByGroup[path] = append(rowsByGroup[path], row)
+	}
+
+	var build func(level int, parent string) []models.ReportGroup
+	build = func(level int, parent string) []models.ReportGroup {
+		records := byParent[level][parent]
+		groups := make([]models.ReportGroup, 0, len(records))
+		for _, rec := range records {
+			value := rec[keys[level]]
+			count, values := splitReportAggregates(rec, aggregates)
+			group := models.ReportGroup{Field: keys[level], Value: value, Count: count, Aggregates: values}
+			path := parent + reportPathSeparator + reportValueKey(value)
+			if level+1 < len(keys) {
+				group.Groups = build(level+1, path)
+			} else {
+				group.Rows = rowsByGroup[path]
+			}
+			groups = append(groups, group)
+		}
+		sort.SliceStable(groups, func(i, j int) bool { return lessReportValue(groups[i].Value, groups[j].Value) })
+		return groups
+	}
+	return build(0, "")
+}
+
+const reportPathSeparator = "\x1f"
+
+// reportGroupPath identifies the group of a record by its values for keys
+func reportGroupPath(rec models.SObject, keys []string) string {
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(reportPathSeparator)
+		b.WriteString(reportValueKey(rec[key]))
+	}
+	return b.String()
+}
+
+// reportValueKey renders a group value as a comparable key, keeping NULL distinct from ""
+func reportValueKey(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "\x00"
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// lessReportValue orders group values: NULL first, then numerically, chronologically or lexically
+func lessReportValue(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Before(bt)
+		}
+	}
+	if af, ok := reportNumber(a); ok {
+		if bf, ok := reportNumber(b); ok {
+			return af < bf
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func reportNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// ExportReportCSV writes a report result as CSV. Summary reports are flattened to one line per
+// detail row (or leaf group, without columns) prefixed with its group values; matrix reports are written as a cross table of
+// the first aggregate (or the row count when there is none).
+func ExportReportCSV(w io.Writer, result *models.ReportResult) error {
+	cw := csv.NewWriter(w)
+
+	switch result.Format {
+	case constants.ReportFormatMatrix:
+		if err := writeMatrixCSV(cw, result); err != nil {
+			return err
+		}
+	default:
+		header := make([]string, 0, len(result.Groupings)+len(result.Columns))
+		for _, col := range append(append([]models.ReportResultColumn{}, result.Groupings...), result.Columns...) {
+			header = append(header, col.Label)
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+
+		var writeRows func(prefix []string, rows []models.SObject) error
+		writeRows = func(prefix []string, rows []models.SObject) error {
+			for _, row := range rows {
+				line := append([]string{}, prefix...)
+				for _, col := range result.Columns {
+					line = append(line, csvValue(row[col.Key]))
+				}
+				if err := cw.Write(line); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		var writeGroups func(prefix []string, groups []models.ReportGroup) error
+		writeGroups = func(prefix []string, groups []models.ReportGroup) error {
+			for _, g := range groups {
+				next := append(append([]string{}, prefix...), csvValue(g.Value))
+				// Without columns there are no detail rows; each leaf group is a line
+				if len(result.Columns) == 0 && len(g.Groups) == 0 {
+					if err := cw.Write(next); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := writeGroups(next, g.Groups); err != nil {
+					return err
+				}
+				if err := writeRows(next, g.Rows); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if result.Format == constants.ReportFormatSummary {
+			if err := writeGroups(nil, result.Groups); err != nil {
+				return err
+			}
+		} else if err := writeRows(nil, result.Rows); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeMatrixCSV(cw *csv.Writer, result *models.ReportResult) error {
+	matrix := result.Matrix
+	if matrix == nil {
+		return nil
+	}
+	value := func(count int64, values map[string]interface{}) string {
+		if len(result.Aggregates) > 0 {
+			return csvValue(values[result.Aggregates[0]])
+		}
+		return csvValue(count)
+	}
+
+	rowLabel := matrix.RowGrouping
+	if len(result.Groupings) > 0 {
+		rowLabel = result.Groupings[0].Label
+	}
+	header := []string{rowLabel}
+	for _, col := range matrix.ColumnTotals {
+		header = append(header, csvValue(col.Value))
+	}
+	header = append(header, "Total")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	cells := make(map[string]models.ReportMatrixCell, len(matrix.Cells))
+	for _, cell := range matrix.Cells {
+		cells[reportValueKey(cell.Row)+reportPathSeparator+reportValueKey(cell.Column)] = cell
+	}
+	for _, row := range matrix.RowTotals {
+		line := []string{csvValue(row.Value)}
+		for _, col := range matrix.ColumnTotals {
+			cell, ok := cells[reportValueKey(row.Value)+reportPathSeparator+reportValueKey(col.Value)]
+			if !ok {
+				line = append(line, "")
+				continue
+			}
+			line = append(line, value(cell.Count, cell.Values))
+		}
+		line = append(line, value(row.Count, row.Values))
+		if err := cw.Write(line); err != nil {
+			return err
+		}
+	}
+
+	footer := []string{"Total"}
+	for _, col := range matrix.ColumnTotals {
+		footer = append(footer, value(col.Count, col.Values))
+	}
+	footer = append(footer, value(result.TotalCount, result.GrandTotals))
+	return cw.Write(footer)
+}
+
+// csvValue formats a cell value, neutralising spreadsheet formula injection
+func csvValue(v interface{}) string {
+	var s string
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		s = val.Format(time.RFC3339)
+	default:
+		s = fmt.Sprint(val)
+	}
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		if _, isNumber := reportNumber(v); !isNumber {
+			s = "'" + s
+		}
+	}
+	return s
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildReportGroups(t *testing.T) {
+	aggregates := []models.ListViewAggregate{{Function: "SUM", Field: "amount"}}
+	levels := [][]models.SObject{
+		{
+			{"stage": "Won", "count": int64(2), "sum_amount": 300.0},
+			{"stage": nil, "count": int64(1), "sum_amount": 50.0},
+		},
+		{
+			{"stage": "Won", "region": "EU", "count": int64(1), "sum_amount": 100.0},
+			{"stage": "Won", "region": "APAC", "count": int64(1), "sum_amount": 200.0},
+			{"stage": nil, "region": "EU", "count": int64(1), "sum_amount": 50.0},
+		},
+	}
+	rows := []models.SObject{
+		{"id": "a", "stage": "Won", "region": "EU"},
+		{"id": "b", "stage": "Won", "region": "APAC"},
+		{"id": "c", "stage": nil, "region": "EU"},
+	}
+
+	groups := buildReportGroups([]string{"stage", "region"}, levels, rows, aggregates)
+	assert.Len(t, groups, 2)
+
+	assert.Nil(t, groups[0].Value, "NULL groups sort first")
+	assert.Equal(t, int64(1), groups[0].Count)
+	assert.Len(t, groups[0].Groups, 1)
+	assert.Equal(t, "c", groups[0].Groups[0].Rows[0]["id"])
+
+	won := groups[1]
+	assert.Equal(t, "Won", won.Value)
+	assert.Equal(t, int64(2), won.Count)
+	assert.Equal(t, 300.0, won.Aggregates["sum_amount"])
+	assert.Equal(t, []interface{}{"APAC", "EU"}, []interface{}{won.Groups[0].Value, won.Groups[1].Value})
+	assert.Equal(t, "b", won.Groups[0].Rows[0]["id"])
+	assert.Equal(t, "a", won.Groups[1].Rows[0]["id"])
+	assert.Empty(t, won.Rows, "rows belong to leaf groups only")
+}
+
+func TestNormalizeReport(t *testing.T) {
+	cols := []models.ReportColumn{{Field: "name"}}
+
+	tabular := &models.Report{ObjectAPIName: "account", Columns: cols, SortDirection: "desc"}
+	assert.NoError(t, normalizeReport(tabular))
+	assert.Equal(t, constants.ReportFormatTabular, tabular.Format)
+	assert.Equal(t, constants.SortDESC, tabular.SortDirection)
+
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account"}), "columns required")
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Columns: cols, Groupings: []string{"type"}}))
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Columns: cols, Format: constants.ReportFormatSummary}))
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Columns: cols, Format: constants.ReportFormatSummary,
+		Groupings: []string{"a", "b", "c", "d"}}))
+	assert.NoError(t, normalizeReport(&models.Report{ObjectAPIName: "account", Format: constants.ReportFormatMatrix,
+		Groupings: []string{"type"}, ColumnGroupings: []string{"industry"}}))
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Format: constants.ReportFormatMatrix,
+		Groupings: []string{"type"}, ColumnGroupings: []string{"type"}}))
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Columns: cols, Format: "chart"}))
+	assert.Error(t, normalizeReport(&models.Report{ObjectAPIName: "account", Columns: cols, RowLimit: maxReportRowLimit + 1}))
+	assert.NoError(t, normalizeReport(&models.Report{ObjectAPIName: "account", Format: constants.ReportFormatSummary,
+		Groupings: []string{"created_date:month", "created_date:year"}}), "summaries without columns are subtotals only")
+}
+
+func TestBucketReportField(t *testing.T) {
+	closeDate := reportField{
+		ref:   persistence.ReportField{Key: "close_date", Table: "opportunity", Column: "close_date"},
+		meta:  &models.FieldMetadata{APIName: "close_date", Type: constants.FieldTypeDate},
+		label: "Close Date",
+	}
+
+	f, err := bucketReportField(closeDate, "close_date:quarter", constants.ReportDateBucketQuarter)
+	assert.NoError(t, err)
+	assert.Equal(t, "close_date:quarter", f.ref.Key)
+	assert.Equal(t, constants.ReportDateBucketQuarter, f.ref.Bucket)
+	assert.Equal(t, "Close Date (Quarter)", f.label)
+	assert.Equal(t, "close_date", closeDate.ref.Key, "the resolved field is not modified")
+
+	_, err = bucketReportField(closeDate, "close_date:decade", "decade")
+	assert.Error(t, err)
+
+	name := reportField{meta: &models.FieldMetadata{APIName: "name", Type: constants.FieldTypeText}}
+	_, err = bucketReportField(name, "name:month", constants.ReportDateBucketMonth)
+	assert.Error(t, err)
+}
+
+func TestExportReportCSV(t *testing.T) {
+	summary := &models.ReportResult{
+		Format:    constants.ReportFormatSummary,
+		Groupings: []models.ReportResultColumn{{Key: "stage", Label: "Stage"}},
+		Columns:   []models.ReportResultColumn{{Key: "name", Label: "Name"}, {Key: "amount", Label: "Amount"}},
+		Groups: []models.ReportGroup{{
+			Field: "stage", Value: "Won",
+			Rows: []models.SObject{{"name": "=HYPERLINK()", "amount": -5.5}},
+		}},
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, ExportReportCSV(&buf, summary))
+	assert.Equal(t, "Stage,Name,Amount\nWon,'=HYPERLINK(),-5.5\n", buf.String())
+
+	summary.Columns = nil
+	buf.Reset()
+	assert.NoError(t, ExportReportCSV(&buf, summary))
+	assert.Equal(t, "Stage\nWon\n", buf.String())
+
+	matrix := &models.ReportResult{
+		Format:      constants.ReportFormatMatrix,
+		Groupings:   []models.ReportResultColumn{{Key: "stage", Label: "Stage"}, {Key: "region", Label: "Region"}},
+		TotalCount:  3,
+		GrandTotals: map[string]interface{}{},
+		Matrix: &models.ReportMatrix{
+			RowGrouping: "stage", ColumnGrouping: "region",
+			Cells: []models.ReportMatrixCell{
+				{Row: "Won", Column: "EU", Count: 2},
+				{Row: "Lost", Column: "APAC", Count: 1},
+			},
+			RowTotals:    []models.ReportMatrixTotal{{Value: "Lost", Count: 1}, {Value: "Won", Count: 2}},
+			ColumnTotals: []models.ReportMatrixTotal{{Value: "APAC", Count: 1}, {Value: "EU", Count: 2}},
+		},
+	}
+	buf.Reset()
+	assert.NoError(t, ExportReportCSV(&buf, matrix))
+	assert.Equal(t, "Stage,APAC,EU,Total\nLost,1,,1\nWon,,2,2\nTotal,1,2,3\n", buf.String())
+}
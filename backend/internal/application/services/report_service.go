@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ReportService manages report definitions. Reports are visible to every user who can read
+// the report's object; only the owner and administrators may change or delete one.
+// Running a report always applies the permissions of the user running it.
+type ReportService struct {
+	repo        *persistence.ReportRepository
+	engine      *ReportEngine
+	permissions *PermissionService
+}
+
+// NewReportService creates a new ReportService
+func NewReportService(repo *persistence.ReportRepository, engine *ReportEngine, permissions *PermissionService) *ReportService {
+	return &ReportService{
+		repo:        repo,
+		engine:      engine,
+		permissions: permissions,
+	}
+}
+
+// List returns the reports on objects the user can read, optionally for one object only
+func (s *ReportService) List(ctx context.Context, objectAPIName string, currentUser *models.UserSession) ([]*models.Report, error) {
+	reports, err := s.repo.List(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*models.Report, 0, len(reports))
+	for _, report := range reports {
+		if s.permissions.CheckObjectPermissionWithUser(ctx, report.ObjectAPIName, constants.PermRead, currentUser) {
+			visible = append(visible, report)
+		}
+	}
+	return visible, nil
+}
+
+// Get returns a report the user can see
+func (s *ReportService) Get(ctx context.Context, id string, currentUser *models.UserSession) (*models.Report, error) {
+	report, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil || !s.permissions.CheckObjectPermissionWithUser(ctx, report.ObjectAPIName, constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(constants.TableReport, id)
+	}
+	return report, nil
+}
+
+// Create validates and stores a new report owned by the current user.
+// report is updated in place with the stored values.
+func (s *ReportService) Create(ctx context.Context, report *models.Report, currentUser *models.UserSession) error {
+	report.ID = GenerateID()
+	report.OwnerID = currentUser.ID
+	if err := s.validate(ctx, report, currentUser); err != nil {
+		return err
+	}
+	return s.repo.Insert(ctx, report)
+}
+
+// Update applies the non-empty fields of updates to a report. updates is replaced with the stored values.
+func (s *ReportService) Update(ctx context.Context, id string, updates *models.Report, currentUser *models.UserSession) error {
+	existing, err := s.editable(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+
+	if updates.Name != "" {
+		existing.Name = updates.Name
+	}
+	if updates.Description != nil {
+		existing.Description = updates.Description
+	}
+	if updates.ObjectAPIName != "" {
+		existing.ObjectAPIName = updates.ObjectAPIName
+	}
+	if updates.Format != "" {
+		existing.Format = updates.Format
+	}
+	if updates.Columns != nil {
+		existing.Columns = updates.Columns
+	}
+	if updates.FilterExpr != "" {
+		existing.FilterExpr = updates.FilterExpr
+	}
+	if updates.Groupings != nil {
+		existing.Groupings = updates.Groupings
+	}
+	if updates.ColumnGroupings != nil {
+		existing.ColumnGroupings = updates.ColumnGroupings
+	}
+	if updates.Aggregates != nil {
+		existing.Aggregates = updates.Aggregates
+	}
+	if updates.SortField != "" {
+		existing.SortField = updates.SortField
+		existing.SortDirection = updates.SortDirection
+	}
+	if updates.RowLimit != 0 {
+		existing.RowLimit = updates.RowLimit
+	}
+	if err := s.validate(ctx, existing, currentUser); err != nil {
+		return err
+	}
+
+	found, err := s.repo.Update(ctx, existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFoundError(constants.TableReport, id)
+	}
+
+	*updates = *existing
+	return nil
+}
+
+// Delete removes a report
+func (s *ReportService) Delete(ctx context.Context, id string, currentUser *models.UserSession) error {
+	if _, err := s.editable(ctx, id, currentUser); err != nil {
+		return err
+	}
+	found, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFoundError(constants.TableReport, id)
+	}
+	return nil
+}
+
+// Run executes a saved report as the current user
+func (s *ReportService) Run(ctx context.Context, id string, currentUser *models.UserSession) (*models.ReportResult, error) {
+	report, err := s.Get(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	return s.engine.Run(ctx, report, currentUser)
+}
+
+// Preview executes an unsaved report definition, e.g. while it is being built
+func (s *ReportService) Preview(ctx context.Context, report *models.Report, currentUser *models.UserSession) (*models.ReportResult, error) {
+	report.ID = ""
+	return s.engine.Run(ctx, report, currentUser)
+}
+
+// Export runs a saved report and writes it as CSV, returning the report for naming the download
+func (s *ReportService) Export(ctx context.Context, id string, w io.Writer, currentUser *models.UserSession) (*models.Report, error) {
+	report, err := s.Get(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.engine.Run(ctx, report, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	return report, ExportReportCSV(w, result)
+}
+
+// editable loads a report the current user may change
+func (s *ReportService) editable(ctx context.Context, id string, currentUser *models.UserSession) (*models.Report, error) {
+	report, err := s.Get(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if !isListViewAdmin(currentUser) && report.OwnerID != "" && report.OwnerID != currentUser.ID {
+		return nil, errors.NewPermissionError(constants.PermEdit, constants.TableReport)
+	}
+	return report, nil
+}
+
+// validate checks a report definition before it is stored
+func (s *ReportService) validate(ctx context.Context, report *models.Report, currentUser *models.UserSession) error {
+	report.Name = strings.TrimSpace(report.Name)
+	if report.Name == "" {
+		return errors.NewValidationError(constants.FieldSysReport_Name, "is required")
+	}
+	return s.engine.Validate(ctx, report, currentUser)
+}
@@ -16,7 +16,7 @@ import (
 // TestRoleHierarchy_ManagerVisibility tests that managers can see records owned by subordinates
 func TestRoleHierarchy_ManagerVisibility(t *testing.T) {
 	// Setup Dependencies
-	dbConn, err := database.GetInstance()
+	dbConn, err := database.GetTestInstance()
 	require.NoError(t, err)
 
 	db := dbConn.DB()
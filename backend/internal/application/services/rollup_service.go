@@ -38,7 +38,17 @@ func (rs *RollupService) ProcessRollups(ctx context.Context, tx *sql.Tx, childOb
 	}
 
 	for _, item := range affected {
-		newVal, err := rs.CalculateRollup(ctx, item, tx)
+		rollupCtx, err := EnterTrigger(ctx, TriggerFrame{
+			Kind:          TriggerKindRollup,
+			Name:          item.RollupField.APIName,
+			ObjectAPIName: item.ParentObjName,
+			RecordID:      item.ParentID,
+		})
+		if err != nil {
+			return err
+		}
+
+		newVal, err := rs.CalculateRollup(rollupCtx, item, tx)
 		if err != nil {
 			return fmt.Errorf("failed to calculate rollup %s.%s: %w", item.ParentObjName, item.RollupField.APIName, err)
 		}
@@ -46,7 +56,7 @@ func (rs *RollupService) ProcessRollups(ctx context.Context, tx *sql.Tx, childOb
 		// Direct Update of Parent via Repository
 		log.Printf("🔄 Updating Rollup %s.%s on %s = %v", item.ParentObjName, item.RollupField.APIName, item.ParentID, newVal)
 
-		if err := rs.repo.UpdateParentRollup(ctx, tx, item.ParentObjName, item.ParentID, item.RollupField.APIName, newVal); err != nil {
+		if err := rs.repo.UpdateParentRollup(rollupCtx, tx, item.ParentObjName, item.ParentID, item.RollupField.APIName, newVal); err != nil {
 			return fmt.Errorf("failed to update parent rollup %s: %w", item.ParentID, err)
 		}
 	}
@@ -18,7 +18,7 @@ import (
 
 func TestRollupSummary_Sum(t *testing.T) {
 	// Setup Dependencies
-	conn, err := database.GetInstance()
+	conn, err := database.GetTestInstance()
 	if err != nil {
 		t.Skip("Skipping integration test: failed to connect to DB: " + err.Error())
 	}
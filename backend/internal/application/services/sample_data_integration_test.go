@@ -0,0 +1,90 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleData_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	svc := h.Services.SampleData
+
+	// An account object that already exists keeps its own fields and picklist values
+	require.NoError(t, h.Services.Metadata.CreateSchema(ctx, &models.ObjectMetadata{
+		APIName: "account", Label: "Account", PluralLabel: "Accounts",
+		SharingModel: constants.SharingModelPublicReadWrite,
+		Fields: []models.FieldMetadata{
+			{APIName: "name", Label: "Name", Type: constants.FieldTypeText},
+			{APIName: "industry", Label: "Industry", Type: constants.FieldTypePicklist, Options: []string{"Aerospace", "Mining"}},
+		},
+	}))
+
+	_, err := svc.Generate(ctx, models.SampleDataRequest{Accounts: -1}, h.Admin)
+	assert.True(t, errors.IsValidation(err))
+
+	job, err := svc.Generate(ctx, models.SampleDataRequest{
+		Accounts: 5, ContactsPerAccount: 2, OpportunitiesPerAccount: 3, TasksPerRecord: 1, Seed: 42, BatchSize: 2,
+	}, h.Admin)
+	require.NoError(t, err)
+	assert.Equal(t, constants.AsyncJobTypeSampleData, job.JobType)
+	assert.Equal(t, 5*(1+2+3+1*(1+3)), job.TotalCount)
+
+	require.Eventually(t, func() bool {
+		job, err = h.Services.AsyncJobs.GetJob(ctx, job.ID)
+		require.NoError(t, err)
+		return job.Status == string(constants.AsyncJobStatusCompleted) || job.Status == string(constants.AsyncJobStatusFailed)
+	}, 30*time.Second, 50*time.Millisecond)
+	require.Equal(t, string(constants.AsyncJobStatusCompleted), job.Status, "job error: %v", job.ErrorMessage)
+	assert.Equal(t, job.TotalCount, job.ProcessedCount)
+	assert.Zero(t, job.FailedCount)
+
+	query := func(object string) []models.SObject {
+		rows, err := h.Services.QuerySvc.Query(ctx, models.QueryRequest{ObjectAPIName: object, Limit: 1000}, h.Admin)
+		require.NoError(t, err)
+		return rows
+	}
+	accounts := query("account")
+	require.Len(t, accounts, 5)
+	accountIDs := map[string]bool{}
+	for _, a := range accounts {
+		accountIDs[a.GetString(constants.FieldID)] = true
+		assert.NotEmpty(t, a.GetString("name"))
+		assert.Contains(t, []string{"Aerospace", "Mining"}, a.GetString("industry"))
+	}
+
+	contacts := query("contact")
+	assert.Len(t, contacts, 10)
+	for _, c := range contacts {
+		assert.True(t, accountIDs[c.GetString("account_id")], "contact linked to a generated account")
+		assert.Contains(t, c.GetString("email"), ".example.com")
+	}
+
+	opportunities := query("opportunity")
+	assert.Len(t, opportunities, 15)
+	for _, o := range opportunities {
+		assert.True(t, accountIDs[o.GetString("account_id")])
+		assert.Contains(t, o.GetString("name"), " - ")
+		assert.NotEmpty(t, o.GetString("stage"))
+	}
+
+	tasks := query("task")
+	assert.Len(t, tasks, 20)
+	for _, task := range tasks {
+		assert.NotEmpty(t, task.GetString("what_id"))
+	}
+
+	// First-run setup loads the default dataset into the objects that now exist
+	require.NoError(t, svc.LoadSampleData(ctx, h.Admin))
+	assert.Len(t, query("account"), 5+20)
+}
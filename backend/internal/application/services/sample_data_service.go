@@ -0,0 +1,509 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// Demo objects; they match the defaults of forecasts, campaigns, surveys, entitlements
+	// and the record timeline, so those features work on the demo data as they are
+	sampleAccountObject     = "account"
+	sampleContactObject     = "contact"
+	sampleOpportunityObject = "opportunity"
+	sampleTaskObject        = "task"
+
+	defaultSampleAccounts                = 20
+	defaultSampleContactsPerAccount      = 3
+	defaultSampleOpportunitiesPerAccount = 2
+	defaultSampleTasksPerRecord          = 2
+	defaultSampleDataBatchSize           = 200
+
+	maxSampleAccounts        = 100000
+	maxSampleRecordsPerGroup = 50 // Bound of each per-account and per-record count
+	maxSampleDataBatchSize   = 1000
+
+	sampleDateFormat = "2006-01-02"
+)
+
+// SampleDataService generates realistic linked demo data: accounts with contacts,
+// opportunities spread across the sales stages, and tasks on accounts and opportunities.
+// Objects that do not exist are created through the metadata service first; an existing
+// object is filled only through the fields it has. Records are saved with bulk inserts, so
+// validation rules apply but flows and rollups do not run.
+type SampleDataService struct {
+	metadata    *MetadataService
+	persistence *PersistenceService
+	jobs        *AsyncJobService
+}
+
+// NewSampleDataService creates a new SampleDataService
+func NewSampleDataService(metadata *MetadataService, persistence *PersistenceService, jobs *AsyncJobService) *SampleDataService {
+	return &SampleDataService{
+		metadata:    metadata,
+		persistence: persistence,
+		jobs:        jobs,
+	}
+}
+
+// Generate queues a job generating a demo dataset. Its progress is polled like any async job;
+// the job parameters record the seed used.
+func (s *SampleDataService) Generate(ctx context.Context, req models.SampleDataRequest, currentUser *models.UserSession) (*models.SystemAsyncJob, error) {
+	req, err := normalizeSampleDataRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.jobs.Enqueue(ctx, constants.AsyncJobTypeSampleData, "", req, sampleDataTotal(req), currentUser,
+		func(ctx context.Context, job *models.SystemAsyncJob, report func()) error {
+			return s.load(ctx, req, currentUser, job, report)
+		})
+}
+
+// LoadSampleData generates the default dataset right away; first-run setup calls it
+func (s *SampleDataService) LoadSampleData(ctx context.Context, admin *models.UserSession) error {
+	req, err := normalizeSampleDataRequest(models.SampleDataRequest{})
+	if err != nil {
+		return err
+	}
+	job := &models.SystemAsyncJob{JobType: constants.AsyncJobTypeSampleData, TotalCount: sampleDataTotal(req)}
+	if err := s.load(ctx, req, admin, job, func() {}); err != nil {
+		return err
+	}
+	if job.ProcessedCount == 0 {
+		return fmt.Errorf("no sample records could be saved (%d failed)", job.FailedCount)
+	}
+	return nil
+}
+
+// normalizeSampleDataRequest applies the defaults and checks the bounds of a request
+func normalizeSampleDataRequest(req models.SampleDataRequest) (models.SampleDataRequest, error) {
+	counts := []struct {
+		field    string
+		value    *int
+		fallback int
+		max      int
+	}{
+		{"accounts", &req.Accounts, defaultSampleAccounts, maxSampleAccounts},
+		{"contacts_per_account", &req.ContactsPerAccount, defaultSampleContactsPerAccount, maxSampleRecordsPerGroup},
+		{"opportunities_per_account", &req.OpportunitiesPerAccount, defaultSampleOpportunitiesPerAccount, maxSampleRecordsPerGroup},
+		{"tasks_per_record", &req.TasksPerRecord, defaultSampleTasksPerRecord, maxSampleRecordsPerGroup},
+		{"batch_size", &req.BatchSize, defaultSampleDataBatchSize, maxSampleDataBatchSize},
+	}
+	for _, c := range counts {
+		if *c.value == 0 {
+			*c.value = c.fallback
+		}
+		if *c.value < 0 || *c.value > c.max {
+			return req, errors.NewValidationError(c.field, fmt.Sprintf("must be between 1 and %d", c.max))
+		}
+	}
+	if req.Seed == 0 {
+		req.Seed = time.Now().UnixNano()
+	}
+	return req, nil
+}
+
+// sampleDataTotal is the number of records a request generates
+func sampleDataTotal(req models.SampleDataRequest) int {
+	perAccount := 1 + req.ContactsPerAccount + req.OpportunitiesPerAccount
+	perAccount += req.TasksPerRecord * (1 + req.OpportunitiesPerAccount)
+	return req.Accounts * perAccount
+}
+
+// load creates the demo objects that are missing, then inserts the records one batch of
+// accounts at a time, each batch followed by the records linked to its accounts
+func (s *SampleDataService) load(ctx context.Context, req models.SampleDataRequest, currentUser *models.UserSession, job *models.SystemAsyncJob, report func()) error {
+	schemas, err := s.ensureObjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	gen := newSampleDataGenerator(req.Seed, time.Now())
+	insert := func(objectName string, records []models.SObject) error {
+		schema := schemas[objectName]
+		for _, record := range records {
+			gen.fit(schema, record)
+		}
+		result, err := s.persistence.BulkInsert(ctx, schema.APIName, records, currentUser, BulkInsertOptions{BatchSize: req.BatchSize})
+		job.ProcessedCount += result.SuccessCount
+		job.FailedCount += result.FailedCount
+		for i, e := range result.Errors {
+			if i == 3 {
+				log.Printf("⚠️ Async job %s: %d more %s records failed", job.ID, len(result.Errors)-i, schema.APIName)
+				break
+			}
+			log.Printf("⚠️ Async job %s: %s %s", job.ID, schema.APIName, e)
+		}
+		// Records failing validation are counted; a failed insert stops the job
+		if err != nil && result.FailedCount < len(records) {
+			return err
+		}
+		return nil
+	}
+
+	for done := 0; done < req.Accounts; done += req.BatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		size := min(req.BatchSize, req.Accounts-done)
+
+		accounts := make([]models.SObject, 0, size)
+		var contacts, opportunities, tasks []models.SObject
+		for i := 0; i < size; i++ {
+			account := gen.account()
+			accounts = append(accounts, account)
+			for j := 0; j < req.ContactsPerAccount; j++ {
+				contacts = append(contacts, gen.contact(account))
+			}
+			for j := 0; j < req.TasksPerRecord; j++ {
+				tasks = append(tasks, gen.task(account))
+			}
+			for j := 0; j < req.OpportunitiesPerAccount; j++ {
+				opportunity := gen.opportunity(account)
+				opportunities = append(opportunities, opportunity)
+				for k := 0; k < req.TasksPerRecord; k++ {
+					tasks = append(tasks, gen.task(opportunity))
+				}
+			}
+		}
+
+		// Parents first, so lookups point at saved records
+		for _, batch := range []struct {
+			object  string
+			records []models.SObject
+		}{
+			{sampleAccountObject, accounts},
+			{sampleContactObject, contacts},
+			{sampleOpportunityObject, opportunities},
+			{sampleTaskObject, tasks},
+		} {
+			if len(batch.records) == 0 {
+				continue
+			}
+			if err := insert(batch.object, batch.records); err != nil {
+				return err
+			}
+		}
+		report()
+	}
+
+	log.Printf("🌱 Sample data: created %d records, %d failed", job.ProcessedCount, job.FailedCount)
+	return nil
+}
+
+// ensureObjects creates the demo objects that do not exist yet and returns the schema of
+// each, keyed by demo object name
+func (s *SampleDataService) ensureObjects(ctx context.Context) (map[string]*models.ObjectMetadata, error) {
+	schemas := make(map[string]*models.ObjectMetadata)
+	for _, def := range sampleObjectDefinitions() {
+		schema := s.metadata.GetSchema(ctx, def.APIName)
+		if schema == nil {
+			// A concurrent job may create the object first
+			if err := s.metadata.CreateSchema(ctx, def); err != nil && !errors.IsConflict(err) {
+				return nil, fmt.Errorf("failed to create sample object %s: %w", def.APIName, err)
+			}
+			log.Printf("🌱 Sample data: created object %s", def.APIName)
+			schema = s.metadata.GetSchema(ctx, def.APIName)
+			if schema == nil {
+				return nil, fmt.Errorf("sample object %s missing after create", def.APIName)
+			}
+		}
+		if schema.IsExternal {
+			return nil, errors.NewValidationError(constants.FieldObjectAPIName, fmt.Sprintf("%s is an external object and is read-only", schema.APIName))
+		}
+		schemas[def.APIName] = schema
+	}
+	return schemas, nil
+}
+
+// sampleObjectDefinitions returns the demo objects, each after the objects it looks up
+func sampleObjectDefinitions() []*models.ObjectMetadata {
+	field := func(apiName, label string, fieldType constants.SchemaFieldType) models.FieldMetadata {
+		return models.FieldMetadata{APIName: apiName, Label: label, Type: fieldType}
+	}
+	picklist := func(apiName, label string, options []string) models.FieldMetadata {
+		f := field(apiName, label, constants.FieldTypePicklist)
+		f.Options = options
+		return f
+	}
+	lookup := func(apiName, label string, referenceTo ...string) models.FieldMetadata {
+		f := field(apiName, label, constants.FieldTypeLookup)
+		f.ReferenceTo = referenceTo
+		f.IsPolymorphic = len(referenceTo) > 1
+		return f
+	}
+	object := func(apiName, label, pluralLabel string, fields ...models.FieldMetadata) *models.ObjectMetadata {
+		name := field(constants.FieldName, "Name", constants.FieldTypeText)
+		name.Required = true
+		return &models.ObjectMetadata{
+			APIName:      apiName,
+			Label:        label,
+			PluralLabel:  pluralLabel,
+			SharingModel: constants.SharingModelPublicReadWrite,
+			Fields:       append([]models.FieldMetadata{name}, fields...),
+		}
+	}
+
+	stages := make([]string, len(sampleStages))
+	for i, stage := range sampleStages {
+		stages[i] = stage.name
+	}
+	return []*models.ObjectMetadata{
+		object(sampleAccountObject, "Account", "Accounts",
+			picklist("industry", "Industry", sampleIndustries),
+			picklist("type", "Type", sampleAccountTypes),
+			field("phone", "Phone", constants.FieldTypePhone),
+			field("website", "Website", constants.FieldTypeURL),
+			field("annual_revenue", "Annual Revenue", constants.FieldTypeCurrency),
+			field("employees", "Employees", constants.FieldTypeNumber),
+			field("billing_city", "Billing City", constants.FieldTypeText),
+			field("billing_country", "Billing Country", constants.FieldTypeText),
+		),
+		object(sampleContactObject, "Contact", "Contacts",
+			field("first_name", "First Name", constants.FieldTypeText),
+			field("last_name", "Last Name", constants.FieldTypeText),
+			field("email", "Email", constants.FieldTypeEmail),
+			field("phone", "Phone", constants.FieldTypePhone),
+			field("title", "Title", constants.FieldTypeText),
+			lookup("account_id", "Account", sampleAccountObject),
+		),
+		object(sampleOpportunityObject, "Opportunity", "Opportunities",
+			lookup("account_id", "Account", sampleAccountObject),
+			picklist("stage", "Stage", stages),
+			field("amount", "Amount", constants.FieldTypeCurrency),
+			field("close_date", "Close Date", constants.FieldTypeDate),
+			field("probability", "Probability", constants.FieldTypePercent),
+		),
+		object(sampleTaskObject, "Task", "Tasks",
+			picklist("status", "Status", sampleTaskStatuses),
+			picklist("priority", "Priority", sampleTaskPriorities),
+			field("due_date", "Due Date", constants.FieldTypeDate),
+			field("description", "Description", constants.FieldTypeTextArea),
+			lookup("what_id", "Related To", sampleAccountObject, sampleOpportunityObject),
+		),
+	}
+}
+
+// sampleStage is an opportunity stage with its win probability and how often it is picked
+type sampleStage struct {
+	name        string
+	probability int
+	weight      int
+	closed      bool
+}
+
+var (
+	sampleStages = []sampleStage{
+		{name: "Prospecting", probability: 10, weight: 20},
+		{name: "Qualification", probability: 20, weight: 18},
+		{name: "Needs Analysis", probability: 40, weight: 14},
+		{name: "Proposal", probability: 60, weight: 12},
+		{name: "Negotiation", probability: 80, weight: 10},
+		{name: "Closed Won", probability: 100, weight: 16, closed: true},
+		{name: "Closed Lost", probability: 0, weight: 10, closed: true},
+	}
+	sampleIndustries     = []string{"Technology", "Manufacturing", "Healthcare", "Financial Services", "Retail", "Education", "Energy", "Media"}
+	sampleAccountTypes   = []string{"Prospect", "Customer", "Partner"}
+	sampleTaskStatuses   = []string{"Not Started", "In Progress", "Completed"}
+	sampleTaskPriorities = []string{"High", "Normal", "Low"}
+
+	sampleCompanyNames = []string{
+		"Acme", "Globex", "Initech", "Umbrella", "Northwind", "Contoso", "Fabrikam", "Tailspin", "Hooli", "Vandelay",
+		"Wonka", "Cyberdyne", "Tyrell", "Aperture", "Soylent", "Oscorp", "Stark", "Wayne", "Blue Sun", "Pied Piper",
+	}
+	sampleCompanySuffixes = []string{"Industries", "Systems", "Labs", "Holdings", "Group", "Technologies", "Partners", "Logistics", "Foods", "Health"}
+	sampleFirstNames      = []string{
+		"Ada", "Alan", "Grace", "Linus", "Margaret", "Dennis", "Barbara", "Ken", "Frances", "John",
+		"Radia", "Tim", "Katherine", "Guido", "Hedy", "Edsger", "Sophie", "Donald", "Mary", "Niklaus",
+	}
+	sampleLastNames = []string{
+		"Lovelace", "Turing", "Hopper", "Torvalds", "Hamilton", "Ritchie", "Liskov", "Thompson", "Allen", "Backus",
+		"Perlman", "Berners-Lee", "Johnson", "van Rossum", "Lamarr", "Dijkstra", "Wilson", "Knuth", "Keller", "Wirth",
+	}
+	sampleTitles = []string{
+		"Chief Executive Officer", "Chief Technology Officer", "VP of Sales", "VP of Operations", "Head of Procurement",
+		"IT Director", "Finance Manager", "Operations Manager", "Product Manager", "Office Manager",
+	}
+	sampleLocations = [][2]string{
+		{"San Francisco", "United States"}, {"New York", "United States"}, {"Chicago", "United States"},
+		{"Toronto", "Canada"}, {"London", "United Kingdom"}, {"Berlin", "Germany"}, {"Paris", "France"},
+		{"Amsterdam", "Netherlands"}, {"Singapore", "Singapore"}, {"Tokyo", "Japan"}, {"Sydney", "Australia"},
+		{"São Paulo", "Brazil"},
+	}
+	sampleDeals = []string{"New Business", "Annual Renewal", "Platform Expansion", "Pilot", "Support Upgrade", "Additional Seats", "Services Engagement"}
+	sampleTasks = []string{
+		"Call to discuss requirements", "Send proposal", "Follow up on pricing", "Schedule product demo",
+		"Quarterly business review", "Send contract for signature", "Introduce implementation team", "Check in after onboarding",
+	}
+)
+
+// sampleDataGenerator produces the values of demo records; the same seed and date give the
+// same values
+type sampleDataGenerator struct {
+	rng   *rand.Rand
+	today time.Time
+	names map[string]int // Uses of each account name, to keep names unique
+}
+
+func newSampleDataGenerator(seed int64, now time.Time) *sampleDataGenerator {
+	return &sampleDataGenerator{
+		rng:   rand.New(rand.NewSource(seed)),
+		today: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		names: make(map[string]int),
+	}
+}
+
+func (g *sampleDataGenerator) pick(values []string) string {
+	return values[g.rng.Intn(len(values))]
+}
+
+// between returns a random integer in [lo, hi]
+func (g *sampleDataGenerator) between(lo, hi int) int {
+	return lo + g.rng.Intn(hi-lo+1)
+}
+
+func (g *sampleDataGenerator) date(offsetDays int) string {
+	return g.today.AddDate(0, 0, offsetDays).Format(sampleDateFormat)
+}
+
+func (g *sampleDataGenerator) phone() string {
+	return fmt.Sprintf("+1-555-%03d-%04d", g.rng.Intn(1000), g.rng.Intn(10000))
+}
+
+func (g *sampleDataGenerator) account() models.SObject {
+	name := g.pick(sampleCompanyNames) + " " + g.pick(sampleCompanySuffixes)
+	g.names[name]++
+	if n := g.names[name]; n > 1 {
+		name = fmt.Sprintf("%s %d", name, n)
+	}
+	employees := g.between(10, 20000)
+	location := sampleLocations[g.rng.Intn(len(sampleLocations))]
+
+	return models.SObject{
+		constants.FieldID:   GenerateID(),
+		constants.FieldName: name,
+		"industry":          g.pick(sampleIndustries),
+		"type":              g.pick(sampleAccountTypes),
+		"phone":             g.phone(),
+		"website":           "https://www." + sampleDomain(name),
+		"annual_revenue":    float64(employees * g.between(50, 250) * 1000),
+		"employees":         employees,
+		"billing_city":      location[0],
+		"billing_country":   location[1],
+	}
+}
+
+func (g *sampleDataGenerator) contact(account models.SObject) models.SObject {
+	first, last := g.pick(sampleFirstNames), g.pick(sampleLastNames)
+	local := strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(first + "." + last))
+
+	return models.SObject{
+		constants.FieldID:   GenerateID(),
+		constants.FieldName: first + " " + last,
+		"first_name":        first,
+		"last_name":         last,
+		"email":             local + "@" + sampleDomain(account.GetString(constants.FieldName)),
+		"phone":             g.phone(),
+		"title":             g.pick(sampleTitles),
+		"account_id":        account.GetString(constants.FieldID),
+	}
+}
+
+func (g *sampleDataGenerator) opportunity(account models.SObject) models.SObject {
+	total := 0
+	for _, s := range sampleStages {
+		total += s.weight
+	}
+	n := g.rng.Intn(total)
+	stage := sampleStages[len(sampleStages)-1]
+	for _, s := range sampleStages {
+		if n < s.weight {
+			stage = s
+			break
+		}
+		n -= s.weight
+	}
+	// Closed deals closed in the last half year; open ones are expected within the next
+	closeDate := g.date(g.between(7, 180))
+	if stage.closed {
+		closeDate = g.date(-g.between(1, 180))
+	}
+
+	return models.SObject{
+		constants.FieldID:   GenerateID(),
+		constants.FieldName: account.GetString(constants.FieldName) + " - " + g.pick(sampleDeals),
+		"account_id":        account.GetString(constants.FieldID),
+		"stage":             stage.name,
+		"amount":            float64(g.between(10, 500) * 500),
+		"close_date":        closeDate,
+		"probability":       stage.probability,
+	}
+}
+
+func (g *sampleDataGenerator) task(about models.SObject) models.SObject {
+	offset := g.between(-30, 30)
+	status := sampleTaskStatuses[0]
+	switch {
+	case offset < 0 && g.rng.Intn(4) > 0:
+		status = sampleTaskStatuses[2]
+	case g.rng.Intn(3) == 0:
+		status = sampleTaskStatuses[1]
+	}
+
+	return models.SObject{
+		constants.FieldID:   GenerateID(),
+		constants.FieldName: g.pick(sampleTasks),
+		"status":            status,
+		"priority":          sampleTaskPriorities[min(g.rng.Intn(4), 2)], // Mostly normal or high
+		"due_date":          g.date(offset),
+		"description":       fmt.Sprintf("Regarding %s", about.GetString(constants.FieldName)),
+		"what_id":           about.GetString(constants.FieldID), // Its object is resolved on insert
+	}
+}
+
+// fit adapts a generated record to an object that existed before: values of fields the
+// object lacks are dropped and picklist values it does not offer are replaced by its own
+func (g *sampleDataGenerator) fit(schema *models.ObjectMetadata, record models.SObject) {
+	for key, value := range record {
+		if key == constants.FieldID {
+			continue
+		}
+		field := FindField(schema, key)
+		if field == nil {
+			delete(record, key)
+			continue
+		}
+		if field.Type != constants.FieldTypePicklist || len(field.Options) == 0 {
+			continue
+		}
+		offered := false
+		for _, option := range field.Options {
+			if option == value {
+				offered = true
+				break
+			}
+		}
+		if !offered {
+			record[key] = g.pick(field.Options)
+		}
+	}
+}
+
+// sampleDomain returns the reserved example domain used for a company's website and emails
+func sampleDomain(company string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(company) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String() + ".example.com"
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSampleDataRequest(t *testing.T) {
+	req, err := normalizeSampleDataRequest(models.SampleDataRequest{OpportunitiesPerAccount: 4})
+	require.NoError(t, err)
+	assert.Equal(t, defaultSampleAccounts, req.Accounts)
+	assert.Equal(t, 4, req.OpportunitiesPerAccount)
+	assert.NotZero(t, req.Seed, "a seed is picked so the job parameters can reproduce the dataset")
+	assert.Equal(t, 20*(1+3+4+2*(1+4)), sampleDataTotal(req))
+
+	_, err = normalizeSampleDataRequest(models.SampleDataRequest{Accounts: maxSampleAccounts + 1})
+	assert.True(t, errors.IsValidation(err))
+	_, err = normalizeSampleDataRequest(models.SampleDataRequest{TasksPerRecord: -1})
+	assert.True(t, errors.IsValidation(err))
+}
+
+func TestSampleDataGenerator(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	values := func(seed int64) []string {
+		g := newSampleDataGenerator(seed, now)
+		var out []string
+		for i := 0; i < 30; i++ {
+			account := g.account()
+			opportunity := g.opportunity(account)
+			out = append(out, account.GetString(constants.FieldName), opportunity.GetString("stage"), opportunity.GetString("close_date"))
+		}
+		return out
+	}
+	assert.Equal(t, values(7), values(7), "the same seed generates the same values")
+	assert.NotEqual(t, values(7), values(8))
+
+	g := newSampleDataGenerator(1, now)
+	names := map[string]bool{}
+	for i := 0; i < 500; i++ {
+		account := g.account()
+		name := account.GetString(constants.FieldName)
+		assert.False(t, names[name], "account names are unique: %s", name)
+		names[name] = true
+
+		contact := g.contact(account)
+		assert.Equal(t, account.GetString(constants.FieldID), contact.GetString("account_id"))
+		assert.Regexp(t, `^[a-z.]+@[a-z0-9]+\.example\.com$`, contact.GetString("email"))
+
+		opportunity := g.opportunity(account)
+		closeDate := opportunity.GetString("close_date")
+		switch opportunity.GetString("stage") {
+		case "Closed Won", "Closed Lost":
+			assert.Less(t, closeDate, "2026-10-18", "closed deals closed in the past")
+		default:
+			assert.Greater(t, closeDate, "2026-10-18")
+		}
+	}
+
+	record := models.SObject{"industry": "Technology", "unknown": "x"}
+	g.fit(&models.ObjectMetadata{Fields: []models.FieldMetadata{
+		{APIName: "industry", Type: constants.FieldTypePicklist, Options: []string{"Mining"}},
+	}}, record)
+	assert.Equal(t, models.SObject{"industry": "Mining"}, record)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SavedSearchService manages per-user saved global searches.
+// Searches are private to their owner; running one re-executes it with the owner's permissions.
+type SavedSearchService struct {
+	repo   *persistence.SavedSearchRepository
+	search *SearchIndexService
+}
+
+// NewSavedSearchService creates a new SavedSearchService
+func NewSavedSearchService(repo *persistence.SavedSearchRepository, search *SearchIndexService) *SavedSearchService {
+	return &SavedSearchService{
+		repo:   repo,
+		search: search,
+	}
+}
+
+// List returns the current user's saved searches
+func (s *SavedSearchService) List(ctx context.Context, currentUser *models.UserSession) ([]*models.SystemSavedSearch, error) {
+	return s.repo.ListByUser(ctx, currentUser.ID)
+}
+
+// Get returns one of the current user's saved searches
+func (s *SavedSearchService) Get(ctx context.Context, id string, currentUser *models.UserSession) (*models.SystemSavedSearch, error) {
+	search, err := s.repo.FindByID(ctx, id, currentUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil {
+		return nil, errors.NewNotFoundError(constants.TableSavedSearch, id)
+	}
+	return search, nil
+}
+
+// Create saves a new search for the current user. search is updated in place with the stored values.
+func (s *SavedSearchService) Create(ctx context.Context, search *models.SystemSavedSearch, currentUser *models.UserSession) error {
+	search.ID = GenerateID()
+	search.UserID = currentUser.ID
+	search.LastRunDate = nil
+	if err := normalizeSavedSearch(search); err != nil {
+		return err
+	}
+	return s.repo.Insert(ctx, search)
+}
+
+// Update applies non-empty fields of updates to one of the current user's saved searches.
+// updates is replaced with the stored values.
+func (s *SavedSearchService) Update(ctx context.Context, id string, updates *models.SystemSavedSearch, currentUser *models.UserSession) error {
+	existing, err := s.Get(ctx, id, currentUser)
+	if err != nil {
+		return err
+	}
+
+	if updates.Name != "" {
+		existing.Name = updates.Name
+	}
+	if updates.Term != "" {
+		existing.Term = updates.Term
+	}
+	if updates.ObjectScope != nil {
+		existing.ObjectScope = updates.ObjectScope
+	}
+	if err := normalizeSavedSearch(existing); err != nil {
+		return err
+	}
+
+	found, err := s.repo.Update(ctx, existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFoundError(constants.TableSavedSearch, id)
+	}
+
+	*updates = *existing
+	return nil
+}
+
+// Delete removes one of the current user's saved searches
+func (s *SavedSearchService) Delete(ctx context.Context, id string, currentUser *models.UserSession) error {
+	found, err := s.repo.Delete(ctx, id, currentUser.ID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFoundError(constants.TableSavedSearch, id)
+	}
+	return nil
+}
+
+// Run executes a saved search as the current user and stamps its last run date
+func (s *SavedSearchService) Run(ctx context.Context, id string, currentUser *models.UserSession) ([]models.SearchResult, error) {
+	saved, err := s.Get(ctx, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.search.GlobalSearch(ctx, saved.Term, savedSearchScope(saved), currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.MarkRun(ctx, saved.ID, time.Now()); err != nil {
+		log.Printf("⚠️ [SavedSearch] Failed to update last run date for %s: %v", saved.ID, err)
+	}
+	return results, nil
+}
+
+// normalizeSavedSearch trims and validates a saved search before it is stored
+func normalizeSavedSearch(search *models.SystemSavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	search.Term = strings.TrimSpace(search.Term)
+	if search.Name == "" {
+		return errors.NewValidationError(constants.FieldSysSavedSearch_Name, "is required")
+	}
+	if search.Term == "" {
+		return errors.NewValidationError(constants.FieldSysSavedSearch_Term, "is required")
+	}
+
+	if len(search.ObjectScope) == 0 || string(search.ObjectScope) == "null" {
+		search.ObjectScope = nil
+		return nil
+	}
+
+	var objects []string
+	if err := json.Unmarshal(search.ObjectScope, &objects); err != nil {
+		return errors.NewValidationError(constants.FieldSysSavedSearch_ObjectScope, "must be an array of object API names")
+	}
+	normalized := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		obj = strings.ToLower(strings.TrimSpace(obj))
+		if obj != "" && !ContainsString(normalized, obj) {
+			normalized = append(normalized, obj)
+		}
+	}
+	if len(normalized) == 0 {
+		search.ObjectScope = nil
+		return nil
+	}
+	scope, _ := json.Marshal(normalized)
+	search.ObjectScope = scope
+	return nil
+}
+
+// savedSearchScope decodes the stored object scope (nil means all objects)
+func savedSearchScope(search *models.SystemSavedSearch) []string {
+	if len(search.ObjectScope) == 0 {
+		return nil
+	}
+	var objects []string
+	if err := json.Unmarshal(search.ObjectScope, &objects); err != nil {
+		return nil
+	}
+	return objects
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSavedSearch(t *testing.T) {
+	t.Run("requires name and term", func(t *testing.T) {
+		assert.Error(t, normalizeSavedSearch(&models.SystemSavedSearch{Term: "acme"}))
+		assert.Error(t, normalizeSavedSearch(&models.SystemSavedSearch{Name: "Acme", Term: "   "}))
+	})
+
+	t.Run("normalizes object scope", func(t *testing.T) {
+		search := &models.SystemSavedSearch{
+			Name:        " Acme accounts ",
+			Term:        "acme",
+			ObjectScope: json.RawMessage(`["Account", " account", "Contact", ""]`),
+		}
+		require.NoError(t, normalizeSavedSearch(search))
+		assert.Equal(t, "Acme accounts", search.Name)
+		assert.Equal(t, []string{"account", "contact"}, savedSearchScope(search))
+	})
+
+	t.Run("empty scope means all objects", func(t *testing.T) {
+		search := &models.SystemSavedSearch{Name: "All", Term: "acme", ObjectScope: json.RawMessage(`[]`)}
+		require.NoError(t, normalizeSavedSearch(search))
+		assert.Nil(t, search.ObjectScope)
+		assert.Nil(t, savedSearchScope(search))
+	})
+
+	t.Run("rejects non-array scope", func(t *testing.T) {
+		search := &models.SystemSavedSearch{Name: "Bad", Term: "acme", ObjectScope: json.RawMessage(`"account"`)}
+		assert.Error(t, normalizeSavedSearch(search))
+	})
+}
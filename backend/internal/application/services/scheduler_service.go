@@ -19,6 +19,7 @@ type SchedulerService struct {
 	repo         *persistence.SchedulerRepository
 	metadata     *MetadataService
 	flowExecutor *FlowExecutor
+	monitors     []func(ctx context.Context, now time.Time) // Checks run on each tick (SLA timers, escalation rules)
 	stopChan     chan struct{}
 	wg           sync.WaitGroup
 	mu           sync.Mutex
@@ -36,6 +37,12 @@ func NewSchedulerService(repo *persistence.SchedulerRepository, metadata *Metada
 	}
 }
 
+// AddMonitor registers a check the scheduler runs on every tick, e.g. flagging violated SLA milestones.
+// Monitors must be registered before Start.
+func (s *SchedulerService) AddMonitor(monitor func(ctx context.Context, now time.Time)) {
+	s.monitors = append(s.monitors, monitor)
+}
+
 // Start begins the scheduler background loop
 func (s *SchedulerService) Start() {
 	s.mu.Lock()
@@ -81,11 +88,18 @@ func (s *SchedulerService) Stop() {
 	close(s.stopChan)
 }
 
-// runPendingJobs finds and executes all due scheduled flows
+// runPendingJobs finds and executes all due scheduled flows and runs the registered monitors
 func (s *SchedulerService) runPendingJobs() {
 	flows := s.metadata.GetScheduledFlows(context.Background())
 
 	now := time.Now().UTC()
+	for _, monitor := range s.monitors {
+		s.wg.Add(1)
+		go func(monitor func(ctx context.Context, now time.Time)) {
+			defer s.wg.Done()
+			monitor(context.Background(), now)
+		}(monitor)
+	}
 	for _, flow := range flows {
 		// Skip if not active
 		if flow.Status != constants.FlowStatusActive {
@@ -237,18 +251,18 @@ func (s *SchedulerService) calculateNextRun(cronExpr string, timezone *string) (
 		}
 	}
 
-	// Parse cron expression
+	return nextCronRun(cronExpr, loc, time.Now())
+}
+
+// nextCronRun returns the first time after after that a five-field cron expression, read in
+// loc, matches
+func nextCronRun(cronExpr string, loc *time.Location, after time.Time) (time.Time, error) {
 	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	schedule, err := parser.Parse(cronExpr)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("invalid cron expression: %w", err)
 	}
-
-	// Calculate next run time
-	now := time.Now().In(loc)
-	nextRun := schedule.Next(now)
-
-	return nextRun.UTC(), nil
+	return schedule.Next(after.In(loc)).UTC(), nil
 }
 
 // logFlowExecution logs a flow execution event (stub - can be expanded)
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	domainSchema "github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const defaultSchemaDriftInterval = time.Hour
+
+// SchemaDriftService periodically compares the physical schema with _System_Object,
+// _System_Field and the system table definitions, and can add missing columns and indexes
+type SchemaDriftService struct {
+	schemaMgr    *SchemaManager
+	metadata     *MetadataService
+	systemTables []domainSchema.TableDefinition
+	interval     time.Duration // 0 disables the periodic check
+	autoRepair   bool
+
+	mu      sync.Mutex
+	lastRun time.Time
+	latest  *models.SchemaDriftReport
+}
+
+// schemaDriftFinding is an issue with what auto-repair needs to fix it
+type schemaDriftFinding struct {
+	issue  models.SchemaDriftIssue
+	column *domainSchema.ColumnDefinition
+	index  *domainSchema.IndexDefinition
+}
+
+// NewSchemaDriftService creates a new SchemaDriftService
+func NewSchemaDriftService(schemaMgr *SchemaManager, metadata *MetadataService, interval time.Duration, autoRepair bool) *SchemaDriftService {
+	return &SchemaDriftService{
+		schemaMgr:  schemaMgr,
+		metadata:   metadata,
+		interval:   interval,
+		autoRepair: autoRepair,
+	}
+}
+
+// SetSystemTables sets the system table definitions the database is compared against
+func (s *SchemaDriftService) SetSystemTables(defs []domainSchema.TableDefinition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.systemTables = defs
+}
+
+// Latest returns the report of the last check, or nil if none has run yet
+func (s *SchemaDriftService) Latest() *models.SchemaDriftReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest
+}
+
+// Detect compares the database with its metadata now
+func (s *SchemaDriftService) Detect(ctx context.Context) (*models.SchemaDriftReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	findings, err := s.detectLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportLocked(findings, nil, nil), nil
+}
+
+// Repair adds the missing columns and indexes found by a fresh check, then checks again.
+// Missing tables and unexpected columns are reported but never changed.
+func (s *SchemaDriftService) Repair(ctx context.Context) (*models.SchemaDriftReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.repairLocked(ctx)
+}
+
+// Run checks for drift once the configured interval has passed since the last check,
+// repairing it when auto-repair is on. It runs on the scheduler tick.
+func (s *SchemaDriftService) Run(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.interval <= 0 || (!s.lastRun.IsZero() && now.Sub(s.lastRun) < s.interval) {
+		return
+	}
+	s.lastRun = now
+
+	var report *models.SchemaDriftReport
+	var err error
+	if s.autoRepair {
+		report, err = s.repairLocked(ctx)
+	} else {
+		var findings []schemaDriftFinding
+		if findings, err = s.detectLocked(ctx); err == nil {
+			report = s.reportLocked(findings, nil, nil)
+		}
+	}
+	if err != nil {
+		log.Printf("⚠️ [SchemaDrift] Check failed: %v", err)
+		return
+	}
+	if len(report.Repaired) > 0 {
+		log.Printf("🔧 [SchemaDrift] Repaired %d issue(s)", len(report.Repaired))
+	}
+	for _, issue := range report.Issues {
+		log.Printf("⚠️ [SchemaDrift] [%s] %s: %s", issue.Severity, issue.Kind, issue.Description)
+	}
+}
+
+func (s *SchemaDriftService) repairLocked(ctx context.Context) (*models.SchemaDriftReport, error) {
+	findings, err := s.detectLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var repaired []models.SchemaDriftIssue
+	var repairErrors []string
+	for _, f := range findings {
+		if !f.issue.Repairable {
+			continue
+		}
+		var err error
+		if f.column != nil {
+			err = s.schemaMgr.AddMissingColumn(f.issue.Table, *f.column)
+		} else if f.index != nil {
+			err = s.schemaMgr.AddIndex(f.issue.Table, *f.index)
+		}
+		if err != nil {
+			repairErrors = append(repairErrors, err.Error())
+			continue
+		}
+		repaired = append(repaired, f.issue)
+	}
+
+	if len(repaired) > 0 {
+		if findings, err = s.detectLocked(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.reportLocked(findings, repaired, repairErrors), nil
+}
+
+func (s *SchemaDriftService) detectLocked(ctx context.Context) ([]schemaDriftFinding, error) {
+	physical, err := s.schemaMgr.GetPhysicalSchema()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database schema: %w", err)
+	}
+	return detectSchemaDrift(s.systemTables, s.metadata.GetSchemas(ctx), physical, s.schemaMgr.MapFieldTypeToSQL), nil
+}
+
+func (s *SchemaDriftService) reportLocked(findings []schemaDriftFinding, repaired []models.SchemaDriftIssue, repairErrors []string) *models.SchemaDriftReport {
+	report := &models.SchemaDriftReport{
+		CheckedAt:    time.Now().UTC(),
+		Healthy:      true,
+		Issues:       make([]models.SchemaDriftIssue, 0, len(findings)),
+		Repaired:     repaired,
+		RepairErrors: repairErrors,
+	}
+	for _, f := range findings {
+		report.Issues = append(report.Issues, f.issue)
+		if f.issue.Severity == constants.SeverityError {
+			report.Healthy = false
+		}
+	}
+	s.latest = report
+	return report
+}
+
+// detectSchemaDrift compares the physical schema with the system table definitions and the
+// object metadata. System tables are checked against their definitions only; external
+// objects have no table and are skipped.
+func detectSchemaDrift(systemTables []domainSchema.TableDefinition, schemas []*models.ObjectMetadata, physical *persistence.PhysicalSchema, mapType func(string) string) []schemaDriftFinding {
+	var findings []schemaDriftFinding
+	systemNames := make(map[string]bool, len(systemTables))
+
+	for i := range systemTables {
+		def := &systemTables[i]
+		systemNames[strings.ToLower(def.TableName)] = true
+		if !physical.HasTable(def.TableName) {
+			findings = append(findings, missingTableFinding(def.TableName))
+			continue
+		}
+
+		expected := make(map[string]bool, len(def.Columns))
+		var indexes []domainSchema.IndexDefinition
+		for j := range def.Columns {
+			col := def.Columns[j]
+			expected[strings.ToLower(col.Name)] = true
+			if !physical.HasColumn(def.TableName, col.Name) {
+				findings = append(findings, missingColumnFinding(def.TableName, col, !col.PrimaryKey))
+			}
+			if col.Unique && !col.PrimaryKey {
+				indexes = append(indexes, domainSchema.IndexDefinition{Name: col.Name, Columns: []string{col.Name}, Unique: true})
+			}
+		}
+		indexes = append(indexes, def.Indices...)
+		for _, idx := range indexes {
+			name := persistence.IndexName(def.TableName, idx)
+			if idx.Unique && len(idx.Columns) == 1 && physical.IsUnique(def.TableName, idx.Columns[0]) {
+				continue
+			}
+			if !physical.HasIndex(def.TableName, name) {
+				findings = append(findings, missingIndexFinding(def.TableName, idx, name))
+			}
+		}
+		findings = append(findings, unexpectedColumnFindings(def.TableName, expected, physical)...)
+	}
+
+	for _, obj := range schemas {
+		if obj == nil || obj.IsExternal || systemNames[strings.ToLower(obj.APIName)] {
+			continue
+		}
+		if !physical.HasTable(obj.APIName) {
+			findings = append(findings, missingTableFinding(obj.APIName))
+			continue
+		}
+
+		expected := make(map[string]bool, len(obj.Fields))
+		var indexes []schemaDriftFinding
+		for _, f := range obj.Fields {
+			expected[strings.ToLower(f.APIName)] = true
+			if !physical.HasColumn(obj.APIName, f.APIName) {
+				col := domainSchema.ColumnDefinition{
+					Name:        f.APIName,
+					Type:        mapType(string(f.Type)),
+					LogicalType: string(f.Type),
+				}
+				// Formula columns are generated from an expression that may no longer compile
+				findings = append(findings, missingColumnFinding(obj.APIName, col, f.Type != constants.FieldTypeFormula))
+			}
+			if f.IsUnique && !physical.IsUnique(obj.APIName, f.APIName) {
+				idx := domainSchema.IndexDefinition{Columns: []string{f.APIName}, Unique: true}
+				indexes = append(indexes, missingIndexFinding(obj.APIName, idx, persistence.IndexName(obj.APIName, idx)))
+			}
+			if name := persistence.FieldIndexName(obj.APIName, f.APIName); f.IsIndexed && !physical.HasIndex(obj.APIName, name) {
+				idx := domainSchema.IndexDefinition{Name: name, Columns: []string{f.APIName}}
+				indexes = append(indexes, missingIndexFinding(obj.APIName, idx, name))
+			}
+		}
+		findings = append(findings, indexes...)
+		findings = append(findings, unexpectedColumnFindings(obj.APIName, expected, physical)...)
+	}
+	return findings
+}
+
+func missingTableFinding(table string) schemaDriftFinding {
+	return schemaDriftFinding{issue: models.SchemaDriftIssue{
+		Kind:        constants.DriftMissingTable,
+		Severity:    constants.SeverityError,
+		Table:       table,
+		Description: fmt.Sprintf("Table '%s' is described by metadata but does not exist", table),
+	}}
+}
+
+func missingColumnFinding(table string, col domainSchema.ColumnDefinition, repairable bool) schemaDriftFinding {
+	f := schemaDriftFinding{issue: models.SchemaDriftIssue{
+		Kind:        constants.DriftMissingColumn,
+		Severity:    constants.SeverityError,
+		Table:       table,
+		Column:      col.Name,
+		Description: fmt.Sprintf("Table '%s' has no column for '%s'", table, col.Name),
+		Repairable:  repairable,
+	}}
+	if repairable {
+		f.column = &col
+	}
+	return f
+}
+
+func missingIndexFinding(table string, idx domainSchema.IndexDefinition, name string) schemaDriftFinding {
+	kind := "index"
+	if idx.Unique {
+		kind = "unique index"
+	}
+	return schemaDriftFinding{
+		issue: models.SchemaDriftIssue{
+			Kind:        constants.DriftMissingIndex,
+			Severity:    constants.SeverityWarning,
+			Table:       table,
+			Column:      strings.Join(idx.Columns, ", "),
+			Index:       name,
+			Description: fmt.Sprintf("Table '%s' is missing %s '%s' on (%s)", table, kind, name, strings.Join(idx.Columns, ", ")),
+			Repairable:  true,
+		},
+		index: &idx,
+	}
+}
+
+// unexpectedColumnFindings reports physical columns nothing describes. Columns kept for
+// erased fields are expected until they are purged.
+func unexpectedColumnFindings(table string, expected map[string]bool, physical *persistence.PhysicalSchema) []schemaDriftFinding {
+	var columns []string
+	for column := range physical.Columns[strings.ToLower(table)] {
+		if !expected[column] && !strings.Contains(column, retiredMarker) {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	findings := make([]schemaDriftFinding, 0, len(columns))
+	for _, column := range columns {
+		findings = append(findings, schemaDriftFinding{issue: models.SchemaDriftIssue{
+			Kind:        constants.DriftUnexpectedColumn,
+			Severity:    constants.SeverityWarning,
+			Table:       table,
+			Column:      column,
+			Description: fmt.Sprintf("Column '%s.%s' is not described by metadata", table, column),
+		}})
+	}
+	return findings
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"testing"
+
+	domainSchema "github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func driftIssues(findings []schemaDriftFinding) map[string]models.SchemaDriftIssue {
+	issues := make(map[string]models.SchemaDriftIssue)
+	for _, f := range findings {
+		issues[f.issue.Kind+":"+f.issue.Table+"."+f.issue.Column] = f.issue
+	}
+	return issues
+}
+
+func TestDetectSchemaDrift(t *testing.T) {
+	systemTables := []domainSchema.TableDefinition{
+		{
+			TableName: "_System_Widget",
+			Columns: []domainSchema.ColumnDefinition{
+				{Name: "__sys_gen_id", Type: "VARCHAR(36)", PrimaryKey: true},
+				{Name: "name", Type: "VARCHAR(255)", Unique: true},
+				{Name: "color", Type: "VARCHAR(20)"},
+			},
+			Indices: []domainSchema.IndexDefinition{{Name: "idx_widget_color", Columns: []string{"color"}}},
+		},
+		{TableName: "_System_Gadget", Columns: []domainSchema.ColumnDefinition{{Name: "__sys_gen_id", Type: "VARCHAR(36)", PrimaryKey: true}}},
+	}
+	margin := "amount - cost"
+	schemas := []*models.ObjectMetadata{
+		// System objects are checked against their definitions, not their field metadata
+		{APIName: "_System_Widget", Fields: []models.FieldMetadata{{APIName: "legacy"}}},
+		{APIName: "deal", Fields: []models.FieldMetadata{
+			{APIName: "__sys_gen_id", Type: constants.FieldTypeText},
+			{APIName: "code", Type: constants.FieldTypeText, IsUnique: true},
+			{APIName: "amount", Type: constants.FieldTypeCurrency},
+			{APIName: "stage", Type: constants.FieldTypePicklist, IsIndexed: true},
+			{APIName: "margin", Type: constants.FieldTypeFormula, Formula: &margin},
+		}},
+		{APIName: "ticket", Fields: []models.FieldMetadata{{APIName: "__sys_gen_id"}}},
+		{APIName: "erp_order", IsExternal: true, Fields: []models.FieldMetadata{{APIName: "total"}}},
+	}
+	physical := &persistence.PhysicalSchema{
+		Columns: map[string]map[string]bool{
+			"_system_widget": {"__sys_gen_id": true, "name": true, "color": true, "obsolete": true},
+			"deal":           {"__sys_gen_id": true, "code": true, "stage": true, "notes": true, "region__del_3f2504e0": true},
+		},
+		Indexes: map[string]map[string]bool{
+			"_system_widget": {"primary": true, "name": true},
+		},
+		UniqueColumns: map[string]map[string]bool{
+			"_system_widget": {"__sys_gen_id": true, "name": true},
+			"deal":           {"__sys_gen_id": true},
+		},
+	}
+
+	findings := detectSchemaDrift(systemTables, schemas, physical, func(string) string { return "VARCHAR(255)" })
+	issues := driftIssues(findings)
+
+	assert.Contains(t, issues, constants.DriftMissingTable+":_System_Gadget.")
+	assert.Contains(t, issues, constants.DriftMissingTable+":ticket.")
+	assert.NotContains(t, issues, constants.DriftMissingTable+":erp_order.")
+
+	idx, ok := issues[constants.DriftMissingIndex+":_System_Widget.color"]
+	require.True(t, ok)
+	assert.Equal(t, "idx_widget_color", idx.Index)
+	assert.True(t, idx.Repairable)
+	assert.Contains(t, issues, constants.DriftUnexpectedColumn+":_System_Widget.obsolete")
+	assert.NotContains(t, issues, constants.DriftMissingColumn+":_System_Widget.legacy")
+
+	amount := issues[constants.DriftMissingColumn+":deal.amount"]
+	assert.True(t, amount.Repairable)
+	assert.Equal(t, constants.SeverityError, amount.Severity)
+	assert.False(t, issues[constants.DriftMissingColumn+":deal.margin"].Repairable)
+	assert.Contains(t, issues, constants.DriftMissingIndex+":deal.code")
+	assert.Equal(t, "idx_deal_stage", issues[constants.DriftMissingIndex+":deal.stage"].Index)
+	assert.Contains(t, issues, constants.DriftUnexpectedColumn+":deal.notes")
+	// Columns of erased fields are kept on purpose
+	assert.NotContains(t, issues, constants.DriftUnexpectedColumn+":deal.region__del_3f2504e0")
+	assert.Len(t, issues, 9)
+
+	for _, f := range findings {
+		if f.issue.Repairable {
+			assert.True(t, f.column != nil || f.index != nil, "repairable issue %s has no repair", f.issue.Description)
+		}
+	}
+}
@@ -37,6 +37,11 @@ func (sm *SchemaManager) CreateTableWithStrictMetadata(ctx context.Context, def
 // - buildForeignKeyDDL
 // - buildIndexDDL
 
+// RegisterExternalObject registers an external object's metadata without creating a table
+func (sm *SchemaManager) RegisterExternalObject(ctx context.Context, def schema.TableDefinition, objectMeta *models.ObjectMetadata) error {
+	return sm.repo.RegisterExternalObject(ctx, def, objectMeta)
+}
+
 // BatchCreatePhysicalTables performs parallel DDL creation and then batch registers in _System_Table
 func (sm *SchemaManager) BatchCreatePhysicalTables(ctx context.Context, defs []schema.TableDefinition) error {
 	return sm.repo.BatchCreatePhysicalTables(ctx, defs)
@@ -91,6 +96,16 @@ func (sm *SchemaManager) AddColumn(tableName string, col schema.ColumnDefinition
 	return sm.repo.AddColumn(tableName, col)
 }
 
+// RegisterColumn registers a field without DDL (external objects)
+func (sm *SchemaManager) RegisterColumn(tableName string, col schema.ColumnDefinition) error {
+	return sm.repo.RegisterColumn(tableName, col)
+}
+
+// UnregisterColumn removes a field's metadata without DDL (external objects)
+func (sm *SchemaManager) UnregisterColumn(tableName, colName string) error {
+	return sm.repo.UnregisterColumn(tableName, colName)
+}
+
 // EnsureColumn checks if a column exists and adds it if missing
 func (sm *SchemaManager) EnsureColumn(tableName string, col schema.ColumnDefinition) error {
 	return sm.repo.EnsureColumn(tableName, col)
@@ -101,6 +116,76 @@ func (sm *SchemaManager) DropColumn(tableName string, colName string) error {
 	return sm.repo.DropColumn(tableName, colName)
 }
 
+// RenameColumn renames a column, keeping its data, and leaves it nullable
+func (sm *SchemaManager) RenameColumn(tableName, from, to string) error {
+	return sm.repo.RenameColumn(tableName, from, to)
+}
+
+// TableExists reports whether a physical table exists
+func (sm *SchemaManager) TableExists(tableName string) (bool, error) {
+	return sm.repo.TableExists(tableName)
+}
+
+// RenameTable renames a physical table, keeping its data
+func (sm *SchemaManager) RenameTable(from, to string) error {
+	return sm.repo.RenameTable(from, to)
+}
+
+// RegisterTable adds a table to the _System_Table registry
+func (sm *SchemaManager) RegisterTable(def schema.TableDefinition) error {
+	return sm.repo.RegisterTable(def)
+}
+
+// UnregisterField removes a field's metadata by ID without DDL
+func (sm *SchemaManager) UnregisterField(fieldID string) error {
+	return sm.repo.UnregisterField(fieldID)
+}
+
+// UnregisterObject removes an object's registry, object and field metadata without DDL
+func (sm *SchemaManager) UnregisterObject(apiName string) error {
+	return sm.repo.UnregisterObject(apiName)
+}
+
+// DeleteObjectSettings removes an object's auto-numbers, external settings, permissions and archive
+func (sm *SchemaManager) DeleteObjectSettings(apiName string) {
+	sm.repo.DeleteObjectSettings(apiName)
+}
+
+// DeleteFieldSettings removes a field's auto-number sequence and permissions
+func (sm *SchemaManager) DeleteFieldSettings(objectAPIName, fieldAPIName string) {
+	sm.repo.DeleteFieldSettings(objectAPIName, fieldAPIName)
+}
+
+// GetPhysicalSchema reads the columns and indexes of every table in the database
+func (sm *SchemaManager) GetPhysicalSchema() (*persistence.PhysicalSchema, error) {
+	return sm.repo.GetPhysicalSchema()
+}
+
+// AddMissingColumn adds a nullable column that metadata already describes, without registering it
+func (sm *SchemaManager) AddMissingColumn(tableName string, col schema.ColumnDefinition) error {
+	return sm.repo.AddMissingColumn(tableName, col)
+}
+
+// AddIndex creates an index on an existing table
+func (sm *SchemaManager) AddIndex(tableName string, idx schema.IndexDefinition) error {
+	return sm.repo.AddIndex(tableName, idx)
+}
+
+// AddFieldIndex creates the secondary index of an indexed field, online where possible
+func (sm *SchemaManager) AddFieldIndex(tableName, fieldAPIName string) error {
+	return sm.repo.AddFieldIndex(tableName, fieldAPIName)
+}
+
+// DropFieldIndex removes the secondary index of a field, online where possible
+func (sm *SchemaManager) DropFieldIndex(tableName, fieldAPIName string) error {
+	return sm.repo.DropFieldIndex(tableName, fieldAPIName)
+}
+
+// SetFieldIndexed records whether a field is backed by a secondary index
+func (sm *SchemaManager) SetFieldIndexed(objectID, fieldAPIName string, indexed bool) error {
+	return sm.repo.SetFieldIndexed(objectID, fieldAPIName, indexed)
+}
+
 // ModifyColumn modifies a column's type (for schema auto-correction during import)
 func (sm *SchemaManager) ModifyColumn(tableName, colName string, col schema.ColumnDefinition) error {
 	return sm.repo.ModifyColumn(tableName, colName, col)
@@ -23,7 +23,7 @@ func TestSchemaManager_Integration_ACID(t *testing.T) {
 
 	// 1. Setup Connection
 	// We use the singleton getter which uses env vars (TIDB_HOST etc.)
-	conn, err := database.GetInstance()
+	conn, err := database.GetTestInstance()
 	if err != nil {
 		t.Skip("Skipping integration test: failed to connect to DB: " + err.Error())
 	}
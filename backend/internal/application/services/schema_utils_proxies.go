@@ -34,6 +34,11 @@ func GetPolymorphicTypeColumnName(fieldAPIName string) string {
 	return persistence.GetPolymorphicTypeColumnName(fieldAPIName)
 }
 
+// GetGeolocationColumnNames returns the latitude and longitude companion columns of a Geolocation field
+func GetGeolocationColumnNames(fieldAPIName string) (string, string) {
+	return persistence.GetGeolocationColumnNames(fieldAPIName)
+}
+
 // GenerateObjectID generates a standardized ID for an object based on its API Name
 func GenerateObjectID(apiName string) string {
 	return persistence.GenerateObjectID(apiName)
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SCIM error types (RFC 7644 §3.12) carried by validation errors as their field
+const (
+	SCIMInvalidFilter = "invalidFilter"
+	SCIMInvalidPath   = "invalidPath"
+	SCIMInvalidValue  = "invalidValue"
+	SCIMUniqueness    = "uniqueness"
+)
+
+// scimFilter is a parsed "<attribute> eq <value>" filter, the form identity providers use to
+// look up a resource before creating it
+type scimFilter struct {
+	Attribute string // Lowercase
+	Value     string
+}
+
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*([a-z0-9_.:$\[\]" ]+?)\s+eq\s+("(?:[^"\\]|\\.)*"|true|false)\s*$`)
+
+// parseSCIMFilter parses a filter; an empty filter matches everything
+func parseSCIMFilter(filter string) (*scimFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	match := scimFilterPattern.FindStringSubmatch(filter)
+	if match == nil {
+		return nil, errors.NewValidationError(SCIMInvalidFilter, fmt.Sprintf("unsupported filter %q; only <attribute> eq \"<value>\" is supported", filter))
+	}
+	value := match[2]
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, errors.NewValidationError(SCIMInvalidFilter, fmt.Sprintf("invalid filter value %s", value))
+		}
+		value = unquoted
+	}
+	attribute := strings.ToLower(strings.TrimSpace(match[1]))
+	// emails[type eq "work"].value and emails.value filter on the email address
+	if strings.HasPrefix(attribute, "emails") {
+		attribute = "emails.value"
+	}
+	return &scimFilter{Attribute: attribute, Value: value}, nil
+}
+
+// scimUserAttributes maps lowercase SCIM attribute names to their JSON names
+var scimUserAttributes = map[string]string{
+	"username":    "userName",
+	"externalid":  "externalId",
+	"name":        "name",
+	"formatted":   "formatted",
+	"givenname":   "givenName",
+	"familyname":  "familyName",
+	"displayname": "displayName",
+	"emails":      "emails",
+	"value":       "value",
+	"type":        "type",
+	"primary":     "primary",
+	"active":      "active",
+	"locale":      "locale",
+	"password":    "password",
+	"profileid":   "profileId",
+	"roleid":      "roleId",
+}
+
+// applySCIMUserPatch applies PATCH operations to a user. Operations without a path carry an
+// object of attributes, as Azure AD and Okta send them.
+func applySCIMUserPatch(user *models.SCIMUser, operations []models.SCIMPatchOperation) error {
+	raw, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+
+	for _, op := range operations {
+		operation := strings.ToLower(op.Op)
+		switch operation {
+		case "add", "replace":
+			if op.Path == "" {
+				values, ok := op.Value.(map[string]interface{})
+				if !ok {
+					return errors.NewValidationError(SCIMInvalidValue, "an operation without a path needs an object value")
+				}
+				for attr, value := range values {
+					if err := setSCIMUserAttribute(doc, attr, value); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := setSCIMUserAttribute(doc, op.Path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return errors.NewValidationError(SCIMInvalidPath, "remove needs a path")
+			}
+			if err := setSCIMUserAttribute(doc, op.Path, nil); err != nil {
+				return err
+			}
+		default:
+			return errors.NewValidationError(SCIMInvalidValue, fmt.Sprintf("unsupported operation %q", op.Op))
+		}
+	}
+
+	raw, err = json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	patched := models.SCIMUser{}
+	if err := json.Unmarshal(raw, &patched); err != nil {
+		return errors.NewValidationError(SCIMInvalidValue, err.Error())
+	}
+	*user = patched
+	return nil
+}
+
+// setSCIMUserAttribute sets (or with a nil value removes) the attribute at path
+func setSCIMUserAttribute(doc map[string]interface{}, path string, value interface{}) error {
+	// Extension attributes: "urn:...:User:profileId", or the extension URN with an object
+	lowerPath := strings.ToLower(path)
+	lowerExt := strings.ToLower(models.SCIMSchemaUserExtension)
+	if lowerPath == lowerExt {
+		values, ok := value.(map[string]interface{})
+		if !ok && value != nil {
+			return errors.NewValidationError(SCIMInvalidValue, "the extension needs an object value")
+		}
+		for attr, v := range values {
+			if err := setSCIMUserAttribute(doc, models.SCIMSchemaUserExtension+":"+attr, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if strings.HasPrefix(lowerPath, lowerExt+":") {
+		attr, ok := scimUserAttributes[strings.ToLower(path[len(lowerExt)+1:])]
+		if !ok {
+			return errors.NewValidationError(SCIMInvalidPath, fmt.Sprintf("unknown attribute %q", path))
+		}
+		ext, _ := doc[models.SCIMSchemaUserExtension].(map[string]interface{})
+		if ext == nil {
+			ext = make(map[string]interface{})
+			doc[models.SCIMSchemaUserExtension] = ext
+		}
+		return setOrDelete(ext, attr, value)
+	}
+
+	// A single email address is stored, so every emails path sets the primary email
+	if strings.HasPrefix(lowerPath, "emails") {
+		switch v := value.(type) {
+		case nil:
+			delete(doc, "emails")
+		case string:
+			doc["emails"] = []interface{}{map[string]interface{}{"value": v, "type": "work", "primary": true}}
+		default:
+			doc["emails"] = v
+		}
+		return nil
+	}
+
+	parts := strings.Split(path, ".")
+	target := doc
+	for i, part := range parts {
+		attr, ok := scimUserAttributes[strings.ToLower(part)]
+		if !ok {
+			return errors.NewValidationError(SCIMInvalidPath, fmt.Sprintf("unknown attribute %q", path))
+		}
+		if i == len(parts)-1 {
+			if attr == "active" {
+				active, err := scimBool(value)
+				if err != nil {
+					return err
+				}
+				value = active
+			}
+			return setOrDelete(target, attr, value)
+		}
+		next, _ := target[attr].(map[string]interface{})
+		if next == nil {
+			next = make(map[string]interface{})
+			target[attr] = next
+		}
+		target = next
+	}
+	return nil
+}
+
+func setOrDelete(doc map[string]interface{}, key string, value interface{}) error {
+	if value == nil {
+		delete(doc, key)
+	} else {
+		doc[key] = value
+	}
+	return nil
+}
+
+// scimBool reads a boolean that some identity providers send as the string "True"/"False"
+func scimBool(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil, bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err != nil {
+			return nil, errors.NewValidationError(SCIMInvalidValue, fmt.Sprintf("active must be a boolean, got %q", v))
+		}
+		return b, nil
+	default:
+		return nil, errors.NewValidationError(SCIMInvalidValue, "active must be a boolean")
+	}
+}
+
+var scimMemberPathPattern = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+
+// scimMemberIDs reads the user IDs of a members value: a list of {"value": id} objects
+func scimMemberIDs(value interface{}) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		member, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.NewValidationError(SCIMInvalidValue, "members must be objects with a value")
+		}
+		id, _ := member["value"].(string)
+		if id == "" {
+			return nil, errors.NewValidationError(SCIMInvalidValue, "members must be objects with a value")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
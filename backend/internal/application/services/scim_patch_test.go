@@ -0,0 +1,95 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	f, err := parseSCIMFilter(`userName eq "jane@example.com"`)
+	require.NoError(t, err)
+	assert.Equal(t, &scimFilter{Attribute: "username", Value: "jane@example.com"}, f)
+
+	f, err = parseSCIMFilter(`emails[type eq "work"].value eq "jane@example.com"`)
+	require.NoError(t, err)
+	assert.Equal(t, "emails.value", f.Attribute)
+
+	f, err = parseSCIMFilter("")
+	require.NoError(t, err)
+	assert.Nil(t, f, "an empty filter matches everything")
+
+	_, err = parseSCIMFilter(`userName sw "jane"`)
+	var validation *errors.ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, SCIMInvalidFilter, validation.Field)
+}
+
+func TestApplySCIMUserPatch(t *testing.T) {
+	active := true
+	user := models.SCIMUser{UserName: "jane@example.com", Active: &active, Name: &models.SCIMName{GivenName: "Jane"}}
+
+	var ops []models.SCIMPatchOperation
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"op": "Replace", "path": "active", "value": "False"},
+		{"op": "replace", "value": {"name.familyName": "Doe", "displayName": "Jane Doe"}},
+		{"op": "add", "path": "emails[type eq \"work\"].value", "value": "jane.doe@example.com"},
+		{"op": "replace", "path": "urn:nexuscrm:params:scim:schemas:extension:2.0:User:profileId", "value": "sales"}
+	]`), &ops))
+
+	require.NoError(t, applySCIMUserPatch(&user, ops))
+	require.NotNil(t, user.Active)
+	assert.False(t, *user.Active, "Azure AD sends booleans as strings")
+	assert.Equal(t, "Jane", user.Name.GivenName)
+	assert.Equal(t, "Doe", user.Name.FamilyName)
+	assert.Equal(t, "Jane Doe", user.DisplayName)
+	assert.Equal(t, []models.SCIMEmail{{Value: "jane.doe@example.com", Type: "work", Primary: true}}, user.Emails)
+	require.NotNil(t, user.NexusCRM)
+	assert.Equal(t, "sales", user.NexusCRM.ProfileID)
+
+	err := applySCIMUserPatch(&user, []models.SCIMPatchOperation{{Op: "replace", Path: "nickName", Value: "JD"}})
+	var validation *errors.ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, SCIMInvalidPath, validation.Field)
+}
+
+func TestSCIMMemberIDs(t *testing.T) {
+	ids, err := scimMemberIDs([]interface{}{
+		map[string]interface{}{"value": "u1"},
+		map[string]interface{}{"value": "u2", "display": "Jane"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2"}, ids)
+
+	_, err = scimMemberIDs([]interface{}{"u1"})
+	assert.Error(t, err)
+
+	match := scimMemberPathPattern.FindStringSubmatch(`members[value eq "u3"]`)
+	require.Len(t, match, 2)
+	assert.Equal(t, "u3", match[1])
+}
+
+func TestSCIMPage(t *testing.T) {
+	start, end := scimPage(models.SCIMListQuery{StartIndex: 1, Count: 10}, 25)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 10, end)
+
+	start, end = scimPage(models.SCIMListQuery{StartIndex: 21, Count: 10}, 25)
+	assert.Equal(t, 20, start)
+	assert.Equal(t, 25, end)
+
+	start, end = scimPage(models.SCIMListQuery{StartIndex: 40}, 25)
+	assert.Equal(t, 25, start)
+	assert.Equal(t, 25, end)
+}
+
+func TestUniqueGroupName(t *testing.T) {
+	existing := []*models.SystemGroup{{Name: "sales_team"}, {Name: "sales_team_2"}}
+	assert.Equal(t, "sales_team_3", uniqueGroupName("Sales Team", existing))
+	assert.Equal(t, "engineering", uniqueGroupName("Engineering!", existing))
+	assert.Equal(t, "group", uniqueGroupName("---", existing))
+}
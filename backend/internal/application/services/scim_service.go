@@ -0,0 +1,642 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/auth"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SCIMBasePath is where the SCIM 2.0 API is served
+const SCIMBasePath = "/scim/v2"
+
+// scimMaxPageSize caps the count of a SCIM list request
+const scimMaxPageSize = 200
+
+// SCIMService maps SCIM 2.0 Users and Groups (RFC 7643) onto _System_User and _System_Group,
+// so identity providers such as Okta and Azure AD can provision and deprovision users.
+// Portal users are not exposed. Users and groups are filtered in memory: directories are
+// small and identity providers mostly look resources up one at a time.
+type SCIMService struct {
+	auth        *AuthService
+	users       *persistence.UserRepository
+	groups      *persistence.GroupRepository
+	persistence *PersistenceService
+}
+
+// NewSCIMService creates a new SCIMService
+func NewSCIMService(authSvc *AuthService, users *persistence.UserRepository, groups *persistence.GroupRepository, persistenceSvc *PersistenceService) *SCIMService {
+	return &SCIMService{auth: authSvc, users: users, groups: groups, persistence: persistenceSvc}
+}
+
+var scimSystemContext = &models.UserSession{
+	ID:        "system-scim",
+	Name:      "SCIM Provisioning",
+	ProfileID: constants.ProfileSystemAdmin,
+}
+
+// ==================== Users ====================
+
+// ListUsers returns a page of the users matching the query
+func (s *SCIMService) ListUsers(ctx context.Context, q models.SCIMListQuery) (*models.SCIMListResponse, error) {
+	filter, err := parseSCIMFilter(q.Filter)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.loadUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupsByUser, err := s.groupsByUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]models.SCIMUser, 0)
+	for _, u := range users {
+		resource := toSCIMUser(u, groupsByUser[u.ID])
+		if filter == nil || scimUserMatches(resource, filter) {
+			matched = append(matched, resource)
+		}
+	}
+	start, end := scimPage(q, len(matched))
+	return scimListResponse(matched[start:end], len(matched), start), nil
+}
+
+// GetUser returns one user
+func (s *SCIMService) GetUser(ctx context.Context, userID string) (*models.SCIMUser, error) {
+	users, err := s.loadUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u.ID == userID {
+			groupsByUser, err := s.groupsByUser(ctx)
+			if err != nil {
+				return nil, err
+			}
+			resource := toSCIMUser(u, groupsByUser[u.ID])
+			return &resource, nil
+		}
+	}
+	return nil, errors.NewNotFoundError("User", userID)
+}
+
+// CreateUser provisions a user. Users provisioned without a password get a random one and
+// sign in through the identity provider.
+func (s *SCIMService) CreateUser(ctx context.Context, user models.SCIMUser) (*models.SCIMUser, error) {
+	email := scimEmail(user)
+	if email == "" {
+		return nil, errors.NewValidationError(SCIMInvalidValue, "userName or a primary email is required")
+	}
+	password := user.Password
+	if password == "" {
+		var err error
+		if password, err = randomSCIMPassword(); err != nil {
+			return nil, err
+		}
+	}
+	req := CreateUserRequest{
+		Name:     scimFullName(user),
+		Email:    email,
+		Password: password,
+		Locale:   user.Locale,
+	}
+	if user.NexusCRM != nil {
+		req.ProfileID = user.NexusCRM.ProfileID
+		req.RoleID = user.NexusCRM.RoleID
+	}
+
+	created, err := s.auth.CreateUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if user.Active != nil && !*user.Active {
+		if err := s.auth.UpdateUser(ctx, created.ID, UpdateUserRequest{IsActive: user.Active}); err != nil {
+			return nil, err
+		}
+	}
+	log.Printf("👤 SCIM provisioned user %s (%s)", created.ID, email)
+	return s.GetUser(ctx, created.ID)
+}
+
+// ReplaceUser replaces the attributes of a user (PUT). Attributes left out are unchanged.
+func (s *SCIMService) ReplaceUser(ctx context.Context, userID string, user models.SCIMUser) (*models.SCIMUser, error) {
+	current, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	req := UpdateUserRequest{
+		Password: user.Password,
+		IsActive: user.Active,
+	}
+	if email := scimEmail(user); email != "" && !strings.EqualFold(email, current.UserName) {
+		req.Email = email
+	}
+	if name := scimFullName(user); name != "" && name != current.DisplayName {
+		req.Name = name
+	}
+	if user.Locale != "" {
+		req.Locale = &user.Locale
+	}
+	if user.NexusCRM != nil {
+		if current.NexusCRM == nil || user.NexusCRM.ProfileID != current.NexusCRM.ProfileID {
+			req.ProfileID = user.NexusCRM.ProfileID
+		}
+		req.RoleID = user.NexusCRM.RoleID
+	}
+
+	if err := s.auth.UpdateUser(ctx, userID, req); err != nil {
+		return nil, err
+	}
+	if user.Active != nil && !*user.Active && (current.Active == nil || *current.Active) {
+		log.Printf("👤 SCIM deactivated user %s", userID)
+	}
+	return s.GetUser(ctx, userID)
+}
+
+// PatchUser applies PATCH operations to a user; deprovisioning sends active=false
+func (s *SCIMService) PatchUser(ctx context.Context, userID string, patch models.SCIMPatchRequest) (*models.SCIMUser, error) {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applySCIMUserPatch(user, patch.Operations); err != nil {
+		return nil, err
+	}
+	return s.ReplaceUser(ctx, userID, *user)
+}
+
+// DeleteUser deletes a user
+func (s *SCIMService) DeleteUser(ctx context.Context, userID string) error {
+	if _, err := s.GetUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.auth.sessionRepo.RevokeUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return s.auth.DeleteUser(ctx, userID)
+}
+
+// loadUsers returns the internal (non-portal) users
+func (s *SCIMService) loadUsers(ctx context.Context) ([]*models.SystemUser, error) {
+	all, err := s.users.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	users := make([]*models.SystemUser, 0, len(all))
+	for _, u := range all {
+		if u.UserType != constants.UserTypePortal {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// groupsByUser maps each user to the groups they belong to
+func (s *SCIMService) groupsByUser(ctx context.Context) (map[string][]models.SCIMMember, error) {
+	groups, err := s.groups.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.groups.GetMemberIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[string][]models.SCIMMember)
+	for _, g := range groups {
+		for _, userID := range members[g.ID] {
+			byUser[userID] = append(byUser[userID], models.SCIMMember{Value: g.ID, Display: g.Label, Ref: SCIMBasePath + "/Groups/" + g.ID})
+		}
+	}
+	return byUser, nil
+}
+
+func toSCIMUser(u *models.SystemUser, groups []models.SCIMMember) models.SCIMUser {
+	active := u.IsActive
+	familyName := u.LastName
+	if familyName == u.FirstName {
+		familyName = "" // splitName repeats a single name as the last name
+	}
+	displayName := strings.TrimSpace(u.FirstName + " " + familyName)
+	resource := models.SCIMUser{
+		Schemas:     []string{models.SCIMSchemaUser, models.SCIMSchemaUserExtension},
+		ID:          u.ID,
+		UserName:    u.Email,
+		Name:        &models.SCIMName{Formatted: displayName, GivenName: u.FirstName, FamilyName: familyName},
+		DisplayName: displayName,
+		Emails:      []models.SCIMEmail{{Value: u.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Groups:      groups,
+		NexusCRM:    &models.SCIMUserExtension{ProfileID: u.ProfileID, RoleID: derefString(u.RoleID)},
+		Meta:        scimMeta("User", u.ID, u.CreatedDate, time.Time{}),
+	}
+	return resource
+}
+
+func scimUserMatches(u models.SCIMUser, f *scimFilter) bool {
+	switch f.Attribute {
+	case "id":
+		return u.ID == f.Value
+	case "username", "emails.value":
+		return strings.EqualFold(u.UserName, f.Value)
+	case "displayname":
+		return strings.EqualFold(u.DisplayName, f.Value)
+	case "active":
+		return u.Active != nil && fmt.Sprint(*u.Active) == strings.ToLower(f.Value)
+	default:
+		return false // externalId and other attributes are not stored
+	}
+}
+
+// scimEmail returns the primary email of a user, or its userName when that is an email
+func scimEmail(u models.SCIMUser) string {
+	for _, e := range u.Emails {
+		if e.Primary && e.Value != "" {
+			return strings.TrimSpace(e.Value)
+		}
+	}
+	if auth.IsValidEmail(u.UserName) {
+		return strings.TrimSpace(u.UserName)
+	}
+	if len(u.Emails) > 0 {
+		return strings.TrimSpace(u.Emails[0].Value)
+	}
+	return ""
+}
+
+func scimFullName(u models.SCIMUser) string {
+	if u.Name != nil {
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+		if u.Name.Formatted != "" {
+			return strings.TrimSpace(u.Name.Formatted)
+		}
+	}
+	return strings.TrimSpace(u.DisplayName)
+}
+
+// randomSCIMPassword generates a password meeting the strength rules that nobody knows
+func randomSCIMPassword() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf) + "aA1!", nil
+}
+
+// ==================== Groups ====================
+
+// ListGroups returns a page of the groups matching the query
+func (s *SCIMService) ListGroups(ctx context.Context, q models.SCIMListQuery) (*models.SCIMListResponse, error) {
+	filter, err := parseSCIMFilter(q.Filter)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.groups.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, names, err := s.groupMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]models.SCIMGroup, 0)
+	for _, g := range groups {
+		resource := toSCIMGroup(g, members[g.ID], names)
+		if filter == nil || scimGroupMatches(resource, filter) {
+			matched = append(matched, resource)
+		}
+	}
+	start, end := scimPage(q, len(matched))
+	return scimListResponse(matched[start:end], len(matched), start), nil
+}
+
+// GetGroup returns one group with its members
+func (s *SCIMService) GetGroup(ctx context.Context, groupID string) (*models.SCIMGroup, error) {
+	group, err := s.groups.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, errors.NewNotFoundError("Group", groupID)
+	}
+	members, names, err := s.groupMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resource := toSCIMGroup(group, members[group.ID], names)
+	return &resource, nil
+}
+
+// CreateGroup creates a public group with its members
+func (s *SCIMService) CreateGroup(ctx context.Context, group models.SCIMGroup) (*models.SCIMGroup, error) {
+	label := strings.TrimSpace(group.DisplayName)
+	if label == "" {
+		return nil, errors.NewValidationError(SCIMInvalidValue, "displayName is required")
+	}
+	existing, err := s.groups.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range existing {
+		if strings.EqualFold(g.Label, label) {
+			return nil, errors.NewValidationError(SCIMUniqueness, fmt.Sprintf("a group named %q already exists", label))
+		}
+	}
+	memberIDs, err := s.validMemberIDs(ctx, group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.persistence.Insert(WithSystemTableWrite(ctx), constants.TableGroup, models.SObject{
+		constants.FieldSysGroup_Name:  uniqueGroupName(label, existing),
+		constants.FieldSysGroup_Label: label,
+		constants.FieldSysGroup_Type:  "Regular",
+	}, scimSystemContext)
+	if err != nil {
+		return nil, err
+	}
+	groupID, _ := created[constants.FieldID].(string)
+	for _, userID := range memberIDs {
+		if err := s.groups.AddMember(ctx, groupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	log.Printf("👥 SCIM created group %s (%s)", groupID, label)
+	return s.GetGroup(ctx, groupID)
+}
+
+// ReplaceGroup replaces the name and members of a group (PUT)
+func (s *SCIMService) ReplaceGroup(ctx context.Context, groupID string, group models.SCIMGroup) (*models.SCIMGroup, error) {
+	if _, err := s.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	memberIDs, err := s.validMemberIDs(ctx, group.Members)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.renameGroup(ctx, groupID, group.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := s.groups.RemoveAllMembers(ctx, groupID); err != nil {
+		return nil, err
+	}
+	for _, userID := range memberIDs {
+		if err := s.groups.AddMember(ctx, groupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetGroup(ctx, groupID)
+}
+
+// PatchGroup renames a group or adds, removes and replaces its members
+func (s *SCIMService) PatchGroup(ctx context.Context, groupID string, patch models.SCIMPatchRequest) (*models.SCIMGroup, error) {
+	if _, err := s.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	for _, op := range patch.Operations {
+		if err := s.applyGroupOperation(ctx, groupID, op); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetGroup(ctx, groupID)
+}
+
+func (s *SCIMService) applyGroupOperation(ctx context.Context, groupID string, op models.SCIMPatchOperation) error {
+	operation := strings.ToLower(op.Op)
+	path := strings.TrimSpace(op.Path)
+
+	// Operations without a path carry {"displayName": ..., "members": [...]}
+	if path == "" {
+		values, ok := op.Value.(map[string]interface{})
+		if !ok || operation == "remove" {
+			return errors.NewValidationError(SCIMInvalidValue, "an operation without a path needs an object value")
+		}
+		for attr, value := range values {
+			if err := s.applyGroupOperation(ctx, groupID, models.SCIMPatchOperation{Op: op.Op, Path: attr, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if match := scimMemberPathPattern.FindStringSubmatch(path); match != nil {
+		if operation != "remove" {
+			return errors.NewValidationError(SCIMInvalidPath, fmt.Sprintf("%s is only supported with remove", path))
+		}
+		return s.groups.RemoveMember(ctx, groupID, match[1])
+	}
+
+	switch strings.ToLower(path) {
+	case "displayname":
+		name, ok := op.Value.(string)
+		if !ok || operation == "remove" {
+			return errors.NewValidationError(SCIMInvalidValue, "displayName must be a string")
+		}
+		return s.renameGroup(ctx, groupID, name)
+	case "members":
+		ids, err := scimMemberIDs(op.Value)
+		if err != nil {
+			return err
+		}
+		switch operation {
+		case "add", "replace":
+			members := make([]models.SCIMMember, 0, len(ids))
+			for _, id := range ids {
+				members = append(members, models.SCIMMember{Value: id})
+			}
+			if ids, err = s.validMemberIDs(ctx, members); err != nil {
+				return err
+			}
+			if operation == "replace" {
+				if err := s.groups.RemoveAllMembers(ctx, groupID); err != nil {
+					return err
+				}
+			}
+			for _, id := range ids {
+				if err := s.groups.AddMember(ctx, groupID, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		case "remove":
+			if op.Value == nil {
+				return s.groups.RemoveAllMembers(ctx, groupID)
+			}
+			for _, id := range ids {
+				if err := s.groups.RemoveMember(ctx, groupID, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case "externalid":
+		return nil // Not stored
+	default:
+		return errors.NewValidationError(SCIMInvalidPath, fmt.Sprintf("unsupported path %q", path))
+	}
+	return errors.NewValidationError(SCIMInvalidValue, fmt.Sprintf("unsupported operation %q", op.Op))
+}
+
+// DeleteGroup deletes a group and its memberships
+func (s *SCIMService) DeleteGroup(ctx context.Context, groupID string) error {
+	if _, err := s.GetGroup(ctx, groupID); err != nil {
+		return err
+	}
+	if err := s.groups.RemoveAllMembers(ctx, groupID); err != nil {
+		return err
+	}
+	return s.persistence.Delete(WithSystemTableWrite(ctx), constants.TableGroup, groupID, scimSystemContext)
+}
+
+func (s *SCIMService) renameGroup(ctx context.Context, groupID, displayName string) error {
+	label := strings.TrimSpace(displayName)
+	if label == "" {
+		return nil
+	}
+	return s.persistence.Update(WithSystemTableWrite(ctx), constants.TableGroup, groupID, models.SObject{constants.FieldSysGroup_Label: label}, scimSystemContext)
+}
+
+// validMemberIDs checks that every member is an internal user
+func (s *SCIMService) validMemberIDs(ctx context.Context, members []models.SCIMMember) ([]string, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	users, err := s.loadUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(users))
+	for _, u := range users {
+		known[u.ID] = true
+	}
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		if !known[m.Value] {
+			return nil, errors.NewValidationError(SCIMInvalidValue, fmt.Sprintf("member %q is not a user", m.Value))
+		}
+		ids = append(ids, m.Value)
+	}
+	return ids, nil
+}
+
+// groupMembers returns the member IDs of every group and the display names of users
+func (s *SCIMService) groupMembers(ctx context.Context) (map[string][]string, map[string]string, error) {
+	members, err := s.groups.GetMemberIDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := s.loadUsers(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make(map[string]string, len(users))
+	for _, u := range users {
+		names[u.ID] = u.Email
+	}
+	return members, names, nil
+}
+
+func toSCIMGroup(g *models.SystemGroup, memberIDs []string, names map[string]string) models.SCIMGroup {
+	members := make([]models.SCIMMember, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		name, ok := names[id]
+		if !ok {
+			continue // Portal users and users deleted without their memberships
+		}
+		members = append(members, models.SCIMMember{Value: id, Display: name, Ref: SCIMBasePath + "/Users/" + id})
+	}
+	return models.SCIMGroup{
+		Schemas:     []string{models.SCIMSchemaGroup},
+		ID:          g.ID,
+		DisplayName: g.Label,
+		Members:     members,
+		Meta:        scimMeta("Group", g.ID, g.CreatedDate, g.LastModifiedDate),
+	}
+}
+
+func scimGroupMatches(g models.SCIMGroup, f *scimFilter) bool {
+	switch f.Attribute {
+	case "id":
+		return g.ID == f.Value
+	case "displayname":
+		return strings.EqualFold(g.DisplayName, f.Value)
+	default:
+		return false
+	}
+}
+
+var groupNameInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// uniqueGroupName derives the API name of a group from its label
+func uniqueGroupName(label string, existing []*models.SystemGroup) string {
+	base := strings.Trim(groupNameInvalidChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if base == "" {
+		base = "group"
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, g := range existing {
+		taken[g.Name] = true
+	}
+	name := base
+	for i := 2; taken[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	return name
+}
+
+// ==================== Shared ====================
+
+func scimMeta(resourceType, id string, created, modified time.Time) *models.SCIMMeta {
+	meta := &models.SCIMMeta{ResourceType: resourceType, Location: SCIMBasePath + "/" + resourceType + "s/" + id}
+	if !created.IsZero() {
+		meta.Created = created.UTC().Format(time.RFC3339)
+	}
+	if !modified.IsZero() {
+		meta.LastModified = modified.UTC().Format(time.RFC3339)
+	}
+	return meta
+}
+
+// scimPage returns the slice bounds of the requested page; startIndex is 1-based
+func scimPage(q models.SCIMListQuery, total int) (int, int) {
+	start := q.StartIndex - 1
+	if start < 0 {
+		start = 0
+	}
+	if start > total {
+		start = total
+	}
+	count := q.Count
+	if count <= 0 || count > scimMaxPageSize {
+		count = scimMaxPageSize
+	}
+	end := start + count
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func scimListResponse[T any](page []T, total, start int) *models.SCIMListResponse {
+	return &models.SCIMListResponse{
+		Schemas:      []string{models.SCIMSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   start + 1,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
@@ -0,0 +1,55 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptWrites_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	obj := h.CreateObject(t, "scripted")
+	standardUser := h.CreateUser(t)
+
+	newScript := func(source string) string {
+		sc := &models.Script{Name: testharness.UniqueName("script"), Source: source, IsActive: true}
+		require.NoError(t, h.Services.Scripts.CreateScript(ctx, sc, h.Admin))
+		t.Cleanup(func() { _ = h.Services.Scripts.DeleteScript(ctx, sc.Name) })
+		return sc.Name
+	}
+	run := func(ctx context.Context, name string, user *models.UserSession) *models.ScriptLog {
+		result, err := h.Services.Scripts.Run(ctx, name, &models.ScriptRunRequest{Rollback: true}, user)
+		require.NoError(t, err)
+		return result.Log
+	}
+
+	t.Run("the system table write policy applies even in a system write context", func(t *testing.T) {
+		name := newScript(fmt.Sprintf("insert(%q, {'message': 'forged'})", constants.TableLog))
+		entry := run(services.WithSystemTableWrite(ctx), name, h.Admin)
+		assert.Equal(t, constants.ScriptStatusFailed, entry.Status)
+		assert.Contains(t, entry.ErrorMessage, "managed by its own endpoints")
+	})
+
+	t.Run("inherited bypasses need the script user's permissions", func(t *testing.T) {
+		name := newScript(fmt.Sprintf("insert(%q, {'name': 'From script'})", obj.APIName))
+
+		entry := run(services.WithFlowsSuppressed(ctx), name, standardUser)
+		assert.Equal(t, constants.ScriptStatusFailed, entry.Status)
+		assert.Contains(t, entry.ErrorMessage, "flows")
+
+		entry = run(services.WithFlowsSuppressed(ctx), name, h.Admin)
+		assert.Equal(t, constants.ScriptStatusSuccess, entry.Status, entry.ErrorMessage)
+	})
+}
@@ -0,0 +1,666 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/script"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// scriptCacheTTL is how long the trigger scripts are cached; changes made on this server
+	// apply at once, changes made on others within the TTL
+	scriptCacheTTL = 30 * time.Second
+
+	// scriptLogPageSize caps the runs returned by GetLogs
+	scriptLogPageSize = 200
+
+	// scriptLogPurgeInterval is how often runs past the retention are purged
+	scriptLogPurgeInterval = time.Hour
+)
+
+// Quota ceilings administrators may raise a script's limits to
+const (
+	maxScriptSteps     = 100_000_000
+	maxScriptTimeoutMs = 120_000
+	maxScriptMemoryMB  = 1024
+	maxScriptDMLRows   = 10_000
+	maxScriptQueryRows = 50_000
+	maxScriptCallouts  = 100
+)
+
+// errScriptRollback undoes the writes of a trial run
+var errScriptRollback = stderrors.New("script run rolled back")
+
+// ScriptService manages Starlark scripts (see package script) and runs them: as record
+// triggers on the saves of their trigger object, as the RunScript flow action and by hand.
+// Scripts run as the user whose save or flow ran them, and every run is logged.
+type ScriptService struct {
+	repo         *persistence.ScriptRepository
+	metadata     *MetadataService
+	query        *QueryService
+	persistence  *PersistenceService
+	callouts     *CalloutService
+	txManager    *persistence.TransactionManager
+	logRetention time.Duration
+
+	mu        sync.Mutex
+	triggers  []*models.Script
+	loadedAt  time.Time
+	lastPurge time.Time
+}
+
+// NewScriptService creates a ScriptService purging run logs older than logRetention (0 keeps them)
+func NewScriptService(
+	repo *persistence.ScriptRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	persistence *PersistenceService,
+	callouts *CalloutService,
+	txManager *persistence.TransactionManager,
+	logRetention time.Duration,
+) *ScriptService {
+	return &ScriptService{
+		repo:         repo,
+		metadata:     metadata,
+		query:        query,
+		persistence:  persistence,
+		callouts:     callouts,
+		txManager:    txManager,
+		logRetention: logRetention,
+	}
+}
+
+// ==================== Scripts ====================
+
+// GetScripts returns all scripts
+func (s *ScriptService) GetScripts(ctx context.Context) ([]*models.Script, error) {
+	return s.repo.GetScripts(ctx)
+}
+
+// GetScript returns a script by name
+func (s *ScriptService) GetScript(ctx context.Context, name string) (*models.Script, error) {
+	sc, err := s.repo.FindScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		return nil, errors.NewNotFoundError("Script", name)
+	}
+	return sc, nil
+}
+
+// CreateScript validates and stores a script
+func (s *ScriptService) CreateScript(ctx context.Context, sc *models.Script, user *models.UserSession) error {
+	sc.Name = strings.TrimSpace(sc.Name)
+	if !developerNamePattern.MatchString(sc.Name) {
+		return errors.NewValidationError(constants.FieldSysScript_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if err := s.validateScript(ctx, sc); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindScript(ctx, sc.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("Script", constants.FieldSysScript_Name, sc.Name)
+	}
+
+	sc.ID = GenerateID()
+	if user != nil {
+		sc.OwnerID = user.ID
+	}
+	if err := s.repo.InsertScript(ctx, sc); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// UpdateScript replaces a script; its name cannot change because flows reference it
+func (s *ScriptService) UpdateScript(ctx context.Context, name string, sc *models.Script) (*models.Script, error) {
+	existing, err := s.GetScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	sc.ID = existing.ID
+	sc.Name = existing.Name
+	sc.OwnerID = existing.OwnerID
+	sc.CreatedDate = existing.CreatedDate
+	if err := s.validateScript(ctx, sc); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateScript(ctx, sc); err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return sc, nil
+}
+
+// DeleteScript deletes a script and its run log
+func (s *ScriptService) DeleteScript(ctx context.Context, name string) error {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteScript(ctx, sc); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// GetLogs returns the latest runs of a script, newest first
+func (s *ScriptService) GetLogs(ctx context.Context, name string) ([]*models.ScriptLog, error) {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetLogs(ctx, sc.ID, scriptLogPageSize)
+}
+
+// validateScript compiles the source and checks the trigger and quotas
+func (s *ScriptService) validateScript(ctx context.Context, sc *models.Script) error {
+	if strings.TrimSpace(sc.Source) == "" {
+		return errors.NewValidationError(constants.FieldSysScript_Source, "is required")
+	}
+	if err := script.Check(sc.Name, sc.Source); err != nil {
+		return errors.NewValidationError(constants.FieldSysScript_Source, err.Error())
+	}
+
+	sc.TriggerObject = strings.TrimSpace(sc.TriggerObject)
+	if sc.TriggerObject == "" {
+		if len(sc.TriggerEvents) > 0 {
+			return errors.NewValidationError(constants.FieldSysScript_TriggerEvents, "require a trigger object")
+		}
+	} else {
+		schema := s.metadata.GetSchema(ctx, sc.TriggerObject)
+		if schema == nil {
+			return errors.NewNotFoundError("Object", sc.TriggerObject)
+		}
+		if constants.IsSystemTable(schema.APIName) {
+			return errors.NewValidationError(constants.FieldSysScript_TriggerObject, "scripts cannot trigger on system tables")
+		}
+		sc.TriggerObject = schema.APIName
+		if len(sc.TriggerEvents) == 0 {
+			return errors.NewValidationError(constants.FieldSysScript_TriggerEvents, "name at least one event")
+		}
+		triggerEvents := make([]string, 0, len(sc.TriggerEvents))
+		for _, event := range sc.TriggerEvents {
+			canonical := canonicalTriggerEvent(event)
+			if canonical == "" {
+				return errors.NewValidationError(constants.FieldSysScript_TriggerEvents, fmt.Sprintf("unknown event '%s'", event))
+			}
+			triggerEvents = append(triggerEvents, canonical)
+		}
+		sc.TriggerEvents = triggerEvents
+	}
+
+	quotas := []struct {
+		field string
+		value int64
+		max   int64
+	}{
+		{constants.FieldSysScript_MaxSteps, sc.MaxSteps, maxScriptSteps},
+		{constants.FieldSysScript_TimeoutMs, int64(sc.TimeoutMs), maxScriptTimeoutMs},
+		{constants.FieldSysScript_MaxMemoryMb, int64(sc.MaxMemoryMB), maxScriptMemoryMB},
+		{constants.FieldSysScript_MaxDmlRows, int64(sc.MaxDMLRows), maxScriptDMLRows},
+		{constants.FieldSysScript_MaxQueryRows, int64(sc.MaxQueryRows), maxScriptQueryRows},
+		{constants.FieldSysScript_MaxCallouts, int64(sc.MaxCallouts), maxScriptCallouts},
+	}
+	for _, q := range quotas {
+		if q.value < 0 || q.value > q.max {
+			return errors.NewValidationError(q.field, fmt.Sprintf("must be between 0 (the default) and %d", q.max))
+		}
+	}
+	return nil
+}
+
+// canonicalTriggerEvent returns the trigger event named event in any case, or ""
+func canonicalTriggerEvent(event string) string {
+	for _, t := range []string{
+		constants.TriggerBeforeCreate, constants.TriggerAfterCreate,
+		constants.TriggerBeforeUpdate, constants.TriggerAfterUpdate,
+		constants.TriggerBeforeDelete, constants.TriggerAfterDelete,
+	} {
+		if strings.EqualFold(strings.TrimSpace(event), t) {
+			return t
+		}
+	}
+	return ""
+}
+
+// scriptLimits are the quotas of a script; unset ones take the defaults of package script
+func scriptLimits(sc *models.Script) script.Limits {
+	return script.Limits{
+		MaxSteps:     uint64(sc.MaxSteps),
+		Timeout:      time.Duration(sc.TimeoutMs) * time.Millisecond,
+		MaxMemory:    uint64(sc.MaxMemoryMB) << 20,
+		MaxDMLRows:   sc.MaxDMLRows,
+		MaxQueryRows: sc.MaxQueryRows,
+		MaxCallouts:  sc.MaxCallouts,
+	}
+}
+
+// ==================== Triggers ====================
+
+// RegisterHandlers runs trigger scripts on record events: before-save scripts inside the
+// save transaction, where a failing script rejects the save and field changes are saved with
+// the record, and after-save scripts once the save has committed, where field changes are
+// saved as an update and failures are only logged.
+func (s *ScriptService) RegisterHandlers(eventBus *EventBus) {
+	handler := func(trigger string) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			return s.runTriggers(ctx, trigger, recordPayload)
+		}
+	}
+	eventBus.Subscribe(events.RecordBeforeCreate, handler(constants.TriggerBeforeCreate))
+	eventBus.Subscribe(events.RecordBeforeUpdate, handler(constants.TriggerBeforeUpdate))
+	eventBus.Subscribe(events.RecordBeforeDelete, handler(constants.TriggerBeforeDelete))
+	eventBus.Subscribe(events.RecordCreated, handler(constants.TriggerAfterCreate))
+	eventBus.Subscribe(events.RecordUpdated, handler(constants.TriggerAfterUpdate))
+	eventBus.Subscribe(events.RecordDeleted, handler(constants.TriggerAfterDelete))
+}
+
+func (s *ScriptService) runTriggers(ctx context.Context, trigger string, payload RecordEventPayload) error {
+	if constants.IsSystemTable(payload.ObjectAPIName) {
+		return nil
+	}
+	before := strings.HasPrefix(trigger, "before")
+	// After-save events arrive from the outbox with the chain that caused them
+	ctx = WithTriggerChain(ctx, payload.Triggers)
+	recordID := payload.Record.GetString(constants.FieldID)
+	var oldRecord models.SObject
+	if payload.OldRecord != nil {
+		oldRecord = *payload.OldRecord
+	}
+
+	for _, sc := range s.triggerScripts(ctx) {
+		if !scriptTriggersOn(sc, payload.ObjectAPIName, trigger) {
+			continue
+		}
+		scriptCtx, err := EnterTrigger(ctx, TriggerFrame{
+			Kind:          TriggerKindScript,
+			Name:          sc.Name,
+			ObjectAPIName: payload.ObjectAPIName,
+			RecordID:      recordID,
+		})
+		if err != nil {
+			log.Printf("🛑 Script %s: %v", sc.Name, err)
+			if before {
+				return err
+			}
+			continue
+		}
+
+		result, err := s.run(scriptCtx, sc, trigger, payload.ObjectAPIName, recordID, script.Input{
+			Event:     trigger,
+			Record:    payload.Record,
+			OldRecord: oldRecord,
+		}, payload.CurrentUser)
+		if err != nil {
+			if before {
+				return scriptUserError(sc, err)
+			}
+			log.Printf("⚠️ [Scripts] %s failed on %s/%s: %v", sc.Name, payload.ObjectAPIName, recordID, err)
+			continue
+		}
+		if len(result.Changes) == 0 || trigger == constants.TriggerBeforeDelete || trigger == constants.TriggerAfterDelete {
+			continue
+		}
+		delete(result.Changes, constants.FieldID)
+		if before {
+			// The record is shared with the save, so the changes are saved with it
+			for field, value := range result.Changes {
+				payload.Record[field] = value
+			}
+			continue
+		}
+		if err := s.persistence.Update(scriptCtx, payload.ObjectAPIName, recordID, result.Changes, payload.CurrentUser); err != nil {
+			log.Printf("⚠️ [Scripts] %s could not save its changes to %s/%s: %v", sc.Name, payload.ObjectAPIName, recordID, err)
+		}
+	}
+	return nil
+}
+
+// scriptUserError is the error a failed script is reported with, rejecting the save for
+// before-save scripts: the message the script failed with, or what went wrong
+func scriptUserError(sc *models.Script, err error) error {
+	if errors.IsTriggerRecursion(err) {
+		return err
+	}
+	var scriptErr *script.Error
+	if stderrors.As(err, &scriptErr) {
+		return errors.NewValidationError("", scriptErr.Message)
+	}
+	return errors.NewValidationError("", fmt.Sprintf("script %s failed: %v", sc.Name, err))
+}
+
+// scriptTriggersOn reports whether sc runs on trigger events of objectName
+func scriptTriggersOn(sc *models.Script, objectName, trigger string) bool {
+	if !sc.IsActive || !strings.EqualFold(sc.TriggerObject, objectName) {
+		return false
+	}
+	for _, event := range sc.TriggerEvents {
+		if strings.EqualFold(event, trigger) {
+			return true
+		}
+	}
+	return false
+}
+
+// triggerScripts returns the active trigger scripts, cached for scriptCacheTTL
+func (s *ScriptService) triggerScripts(ctx context.Context) []*models.Script {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < scriptCacheTTL {
+		return s.triggers
+	}
+	scripts, err := s.repo.FindTriggerScripts(ctx)
+	if err != nil {
+		log.Printf("⚠️ [Scripts] Failed to load trigger scripts: %v", err)
+		return s.triggers
+	}
+	s.triggers = scripts
+	s.loadedAt = time.Now()
+	return scripts
+}
+
+func (s *ScriptService) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = time.Time{}
+}
+
+// ==================== Running ====================
+
+// RunAction runs a script as a flow or metadata action on record, which may be nil. The
+// result carries the changes the script made to the record; saving them is up to the caller.
+func (s *ScriptService) RunAction(ctx context.Context, name, objectName string, record models.SObject, params map[string]interface{}, user *models.UserSession) (*script.Result, error) {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !sc.IsActive {
+		return nil, fmt.Errorf("script %s is not active", sc.Name)
+	}
+	recordID := record.GetString(constants.FieldID)
+	scriptCtx, err := EnterTrigger(ctx, TriggerFrame{Kind: TriggerKindScript, Name: sc.Name, ObjectAPIName: objectName, RecordID: recordID})
+	if err != nil {
+		return nil, err
+	}
+	return s.run(scriptCtx, sc, constants.ScriptInvocationFlow, objectName, recordID, script.Input{
+		Event:  constants.ScriptInvocationFlow,
+		Record: record,
+		Params: params,
+	}, user)
+}
+
+// RunEndpoint runs a script for a custom REST endpoint with the endpoint's input as params
+// and returns the script's result
+func (s *ScriptService) RunEndpoint(ctx context.Context, name string, params map[string]interface{}, user *models.UserSession) (interface{}, error) {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !sc.IsActive {
+		return nil, fmt.Errorf("script %s is not active", sc.Name)
+	}
+	result, err := s.run(ctx, sc, constants.ScriptInvocationEndpoint, "", "", script.Input{
+		Event:  constants.ScriptInvocationEndpoint,
+		Params: params,
+	}, user)
+	if err != nil {
+		return nil, scriptUserError(sc, err)
+	}
+	return result.Value, nil
+}
+
+// RunBatch runs a script on a chunk of a batch job, passing the records as params["records"]
+// and the job's name as params["batch_job"]
+func (s *ScriptService) RunBatch(ctx context.Context, name, batchJob, objectName string, records []models.SObject, user *models.UserSession) error {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !sc.IsActive {
+		return fmt.Errorf("script %s is not active", sc.Name)
+	}
+	list := make([]interface{}, len(records))
+	for i, record := range records {
+		list[i] = record
+	}
+	_, err = s.run(ctx, sc, constants.ScriptInvocationBatch, objectName, "", script.Input{
+		Event:  constants.ScriptInvocationBatch,
+		Params: map[string]interface{}{"records": list, "batch_job": batchJob},
+	}, user)
+	return err
+}
+
+// Run runs a script by hand as user, on a record when the request names one. Changes the
+// script makes to that record are returned, not saved.
+func (s *ScriptService) Run(ctx context.Context, name string, req *models.ScriptRunRequest, user *models.UserSession) (*models.ScriptRunResult, error) {
+	sc, err := s.GetScript(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	input := script.Input{Event: constants.ScriptInvocationManual, Params: req.Params}
+	if req.RecordID != "" {
+		if req.ObjectAPIName == "" {
+			return nil, errors.NewValidationError("object_api_name", "is required with record_id")
+		}
+		record, err := (&scriptHost{svc: s, user: user}).Get(ctx, req.ObjectAPIName, req.RecordID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, errors.NewNotFoundError(req.ObjectAPIName, req.RecordID)
+		}
+		input.Record = record
+	}
+
+	var result *script.Result
+	var entry *models.ScriptLog
+	runWith := func(ctx context.Context) {
+		result, entry, _ = s.runLogged(ctx, sc, constants.ScriptInvocationManual, req.ObjectAPIName, req.RecordID, input, user)
+	}
+	if req.Rollback {
+		err = s.txManager.WithTransaction(func(tx *sql.Tx) error {
+			runWith(s.txManager.InjectTx(ctx, tx))
+			return errScriptRollback
+		})
+		if err != nil && !stderrors.Is(err, errScriptRollback) {
+			return nil, err
+		}
+	} else {
+		runWith(ctx)
+	}
+
+	// A failed run is reported through its log entry
+	return &models.ScriptRunResult{Log: entry, Changes: result.Changes, Result: result.Value}, nil
+}
+
+// run executes sc as user and logs the run
+func (s *ScriptService) run(ctx context.Context, sc *models.Script, invocation, objectName, recordID string, input script.Input, user *models.UserSession) (*script.Result, error) {
+	result, _, err := s.runLogged(ctx, sc, invocation, objectName, recordID, input, user)
+	return result, err
+}
+
+// runLogged executes sc as user and returns the log entry of the run as well
+func (s *ScriptService) runLogged(ctx context.Context, sc *models.Script, invocation, objectName, recordID string, input script.Input, user *models.UserSession) (*script.Result, *models.ScriptLog, error) {
+	if user != nil {
+		input.User = user.ToMap()
+	}
+	result, err := script.Run(ctx, sc.Name, sc.Source, input, &scriptHost{svc: s, user: user}, scriptLimits(sc))
+
+	entry := scriptLogEntry(sc, invocation, objectName, recordID, result, err, user)
+	// The save that ran the script may roll back; the log must survive it
+	if logErr := s.repo.InsertLog(context.WithoutCancel(ctx), entry); logErr != nil {
+		log.Printf("⚠️ [Scripts] Failed to log run of %s: %v", sc.Name, logErr)
+	}
+	return result, entry, err
+}
+
+// scriptLogEntry describes a run for the log
+func scriptLogEntry(sc *models.Script, invocation, objectName, recordID string, result *script.Result, err error, user *models.UserSession) *models.ScriptLog {
+	entry := &models.ScriptLog{
+		ID:            GenerateID(),
+		ScriptID:      sc.ID,
+		ScriptName:    sc.Name,
+		Invocation:    invocation,
+		ObjectAPIName: objectName,
+		RecordID:      recordID,
+		Status:        constants.ScriptStatusSuccess,
+		DurationMs:    int(result.Duration.Milliseconds()),
+		Steps:         int64(result.Steps),
+		DMLRows:       result.DMLRows,
+		QueryRows:     result.QueryRows,
+		Callouts:      result.Callouts,
+		Output:        result.Output,
+		CreatedDate:   time.Now().UTC(),
+	}
+	if user != nil {
+		entry.UserID = user.ID
+	}
+	if err != nil {
+		entry.Status = constants.ScriptStatusFailed
+		var limitErr *script.LimitError
+		if stderrors.As(err, &limitErr) {
+			entry.Status = constants.ScriptStatusLimitExceeded
+		}
+		entry.ErrorMessage = err.Error()
+		var scriptErr *script.Error
+		if stderrors.As(err, &scriptErr) && scriptErr.Backtrace != "" {
+			entry.ErrorMessage = scriptErr.Backtrace
+		}
+	}
+	return entry
+}
+
+// PurgeLogs deletes runs past the log retention; the scheduler calls it on every tick
+func (s *ScriptService) PurgeLogs(ctx context.Context, now time.Time) {
+	if s.logRetention <= 0 {
+		return
+	}
+	s.mu.Lock()
+	if now.Sub(s.lastPurge) < scriptLogPurgeInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastPurge = now
+	s.mu.Unlock()
+
+	purged, err := s.repo.PurgeLogs(ctx, now.Add(-s.logRetention))
+	if err != nil {
+		log.Printf("⚠️ [Scripts] Failed to purge script runs: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("🧹 [Scripts] Purged %d script runs", purged)
+	}
+}
+
+// scriptHost is what scripts reach: queries, DML and callouts, with the permissions of user
+type scriptHost struct {
+	svc  *ScriptService
+	user *models.UserSession
+}
+
+func (h *scriptHost) Query(ctx context.Context, objectName, where, orderBy string, limit int) ([]models.SObject, error) {
+	req := models.QueryRequest{
+		ObjectAPIName:   objectName,
+		FilterExpr:      where,
+		Limit:           limit,
+		SkipLookupNames: true,
+	}
+	if sort := strings.Fields(orderBy); len(sort) > 0 {
+		req.SortField = sort[0]
+		if len(sort) > 1 {
+			req.SortDirection = strings.ToUpper(sort[1])
+		}
+	}
+	return h.svc.query.Query(ctx, req, h.user)
+}
+
+func (h *scriptHost) Get(ctx context.Context, objectName, id string) (models.SObject, error) {
+	records, err := h.svc.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName:   objectName,
+		Criteria:        []models.QueryCriterion{{Field: constants.FieldID, Op: "=", Val: id}},
+		Limit:           1,
+		SkipLookupNames: true,
+	}, h.user)
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	return records[0], nil
+}
+
+// writeContext gives the writes of a script the checks of a REST write. The system table
+// write policy always applies, and the flow and validation bypasses of the save or request
+// that ran the script carry over only while the user holds their permissions.
+func (h *scriptHost) writeContext(ctx context.Context) (context.Context, error) {
+	options := WriteOptions{SkipFlows: FlowsSuppressed(ctx), SkipValidation: validationSkipped(ctx)}
+	ctx = context.WithValue(ctx, systemTableWriteKey{}, false)
+	ctx = context.WithValue(ctx, suppressFlowsKey{}, false)
+	ctx = context.WithValue(ctx, skipValidationKey{}, false)
+	return h.svc.persistence.WithWriteOptions(ctx, options, h.user)
+}
+
+func (h *scriptHost) Insert(ctx context.Context, objectName string, fields models.SObject) (string, error) {
+	ctx, err := h.writeContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	record, err := h.svc.persistence.Insert(ctx, objectName, fields, h.user)
+	if err != nil {
+		return "", err
+	}
+	return record.GetString(constants.FieldID), nil
+}
+
+func (h *scriptHost) Update(ctx context.Context, objectName, id string, fields models.SObject) error {
+	ctx, err := h.writeContext(ctx)
+	if err != nil {
+		return err
+	}
+	return h.svc.persistence.Update(ctx, objectName, id, fields, h.user)
+}
+
+func (h *scriptHost) Delete(ctx context.Context, objectName, id string) error {
+	ctx, err := h.writeContext(ctx)
+	if err != nil {
+		return err
+	}
+	return h.svc.persistence.Delete(ctx, objectName, id, h.user)
+}
+
+func (h *scriptHost) Callout(ctx context.Context, credential, method, path string, headers map[string]string, body interface{}) (*script.Response, error) {
+	resp, err := h.svc.callouts.Callout(ctx, CalloutRequest{
+		NamedCredential: credential,
+		Method:          method,
+		Path:            path,
+		Headers:         headers,
+		Body:            body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &script.Response{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/script"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptTriggersOn(t *testing.T) {
+	assert.Equal(t, constants.TriggerBeforeUpdate, canonicalTriggerEvent(" BEFOREUPDATE "))
+	assert.Equal(t, "", canonicalTriggerEvent("onSave"))
+
+	sc := &models.Script{IsActive: true, TriggerObject: "Opportunity", TriggerEvents: []string{constants.TriggerBeforeUpdate}}
+	assert.True(t, scriptTriggersOn(sc, "opportunity", constants.TriggerBeforeUpdate))
+	assert.False(t, scriptTriggersOn(sc, "opportunity", constants.TriggerAfterUpdate))
+	assert.False(t, scriptTriggersOn(sc, "account", constants.TriggerBeforeUpdate))
+	sc.IsActive = false
+	assert.False(t, scriptTriggersOn(sc, "opportunity", constants.TriggerBeforeUpdate))
+}
+
+func TestScriptLogEntry(t *testing.T) {
+	sc := &models.Script{ID: "s1", Name: "guard", TimeoutMs: 250, MaxMemoryMB: 16}
+	assert.Equal(t, 250*time.Millisecond, scriptLimits(sc).Timeout)
+	assert.Equal(t, uint64(16<<20), scriptLimits(sc).MaxMemory)
+
+	result := &script.Result{Steps: 10, DMLRows: 1}
+	entry := scriptLogEntry(sc, constants.ScriptInvocationManual, "", "", result, nil, &models.UserSession{ID: "u1"})
+	assert.Equal(t, constants.ScriptStatusSuccess, entry.Status)
+	assert.Equal(t, int64(10), entry.Steps)
+	assert.Equal(t, "u1", entry.UserID)
+
+	entry = scriptLogEntry(sc, constants.ScriptInvocationFlow, "", "", result, &script.LimitError{Limit: script.LimitSteps, Max: "10"}, nil)
+	assert.Equal(t, constants.ScriptStatusLimitExceeded, entry.Status)
+
+	err := &script.Error{Message: "Discount too high", Backtrace: "Traceback: guard.star:1:5"}
+	entry = scriptLogEntry(sc, constants.TriggerBeforeUpdate, "opportunity", "o1", result, err, nil)
+	assert.Equal(t, constants.ScriptStatusFailed, entry.Status)
+	assert.Equal(t, err.Backtrace, entry.ErrorMessage)
+
+	assert.Contains(t, scriptUserError(sc, err).Error(), "Discount too high")
+	assert.Contains(t, scriptUserError(sc, stderrors.New("boom")).Error(), "script guard failed")
+}
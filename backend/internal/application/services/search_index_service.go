@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// searchCandidateLimit bounds the hits fetched from the index before security filtering
+	searchCandidateLimit = 200
+	// searchMatchesPerObject mirrors the LIKE-based GlobalSearch cap
+	searchMatchesPerObject = 5
+	// searchRebuildBatchSize is the page size used when re-indexing an object
+	searchRebuildBatchSize = 500
+)
+
+// SearchIndexService keeps a full-text SearchIndex in sync with record events and
+// serves relevance-ranked global search. When no index is configured it falls back
+// to the LIKE-based QueryService.GlobalSearch.
+type SearchIndexService struct {
+	index       ports.SearchIndex
+	repo        *persistence.QueryRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+}
+
+// NewSearchIndexService creates a new SearchIndexService. index may be nil (indexing disabled).
+func NewSearchIndexService(
+	index ports.SearchIndex,
+	repo *persistence.QueryRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	query *QueryService,
+) *SearchIndexService {
+	return &SearchIndexService{
+		index:       index,
+		repo:        repo,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+	}
+}
+
+// Enabled reports whether a search engine is configured
+func (s *SearchIndexService) Enabled() bool {
+	return s.index != nil
+}
+
+// EngineName returns the configured engine name, or "none"
+func (s *SearchIndexService) EngineName() string {
+	if s.index == nil {
+		return "none"
+	}
+	return s.index.Name()
+}
+
+// RegisterHandlers subscribes to after-commit record events to keep the index current
+func (s *SearchIndexService) RegisterHandlers(eventBus *EventBus) {
+	if !s.Enabled() {
+		return
+	}
+
+	handler := func(eventType events.EventType) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			recordID := recordPayload.Record.GetString(constants.FieldID)
+			if recordID == "" {
+				return nil
+			}
+
+			// Index failures must not fail the outbox event (flows share the same dispatch)
+			var err error
+			if eventType == events.RecordDeleted {
+				err = s.index.Remove(ctx, recordPayload.ObjectAPIName, recordID)
+			} else {
+				err = s.ReindexRecord(ctx, recordPayload.ObjectAPIName, recordID)
+			}
+			if err != nil {
+				log.Printf("⚠️ [Search] Failed to sync %s/%s: %v", recordPayload.ObjectAPIName, recordID, err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(events.RecordCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(events.RecordUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(events.RecordDeleted))
+
+	log.Printf("🔎 Search index (%s) subscribed to record events", s.index.Name())
+}
+
+// ReindexRecord reloads a record from storage and updates or removes its index entry.
+// Reloading (rather than trusting the event payload) covers partial payloads such as restores.
+func (s *SearchIndexService) ReindexRecord(ctx context.Context, objectName, recordID string) error {
+	schema := s.metadata.GetSchema(ctx, objectName)
+	if schema == nil || !isIndexable(schema) {
+		return nil
+	}
+
+	fields := searchableFields(schema)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	rows, err := s.repo.FindByIDs(ctx, schema.APIName, fields, []string{recordID})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return s.index.Remove(ctx, schema.APIName, recordID)
+	}
+	return s.index.Index(ctx, buildSearchDocument(schema.APIName, rows[0], fields))
+}
+
+// Rebuild re-indexes every searchable object from storage and returns the number of documents indexed
+func (s *SearchIndexService) Rebuild(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, fmt.Errorf("search index is not enabled")
+	}
+
+	total := 0
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if !isIndexable(schema) {
+			continue
+		}
+		fields := searchableFields(schema)
+		if len(fields) == 0 {
+			continue
+		}
+
+		afterID := ""
+		for {
+			rows, err := s.repo.ScanAfter(ctx, schema.APIName, fields, afterID, searchRebuildBatchSize)
+			if err != nil {
+				return total, fmt.Errorf("failed to scan %s: %w", schema.APIName, err)
+			}
+			for _, row := range rows {
+				if err := s.index.Index(ctx, buildSearchDocument(schema.APIName, row, fields)); err != nil {
+					return total, fmt.Errorf("failed to index %s: %w", schema.APIName, err)
+				}
+				total++
+			}
+			if len(rows) < searchRebuildBatchSize {
+				break
+			}
+			afterID = rows[len(rows)-1].GetString(constants.FieldID)
+		}
+	}
+
+	log.Printf("🔎 Search index (%s) rebuilt: %d documents", s.index.Name(), total)
+	return total, nil
+}
+
+// IndexDocument adds or replaces a document that is not a record, such as a published
+// knowledge article. It does nothing when no engine is configured.
+func (s *SearchIndexService) IndexDocument(ctx context.Context, doc ports.SearchDocument) error {
+	if !s.Enabled() {
+		return nil
+	}
+	return s.index.Index(ctx, doc)
+}
+
+// RemoveDocument removes a document added with IndexDocument
+func (s *SearchIndexService) RemoveDocument(ctx context.Context, objectName, id string) error {
+	if !s.Enabled() {
+		return nil
+	}
+	return s.index.Remove(ctx, objectName, id)
+}
+
+// SearchDocuments runs a raw index query without record security; callers scope it to
+// their own documents and apply their own access rules. It returns no hits when no engine
+// is configured.
+func (s *SearchIndexService) SearchDocuments(ctx context.Context, q ports.SearchQuery) ([]ports.SearchHit, error) {
+	if !s.Enabled() {
+		return []ports.SearchHit{}, nil
+	}
+	return s.index.Search(ctx, q)
+}
+
+// GlobalSearch performs a relevance-ranked search across searchable objects the user can read.
+// objectNames optionally restricts the search scope.
+func (s *SearchIndexService) GlobalSearch(ctx context.Context, term string, objectNames []string, currentUser *models.UserSession) ([]models.SearchResult, error) {
+	if !s.Enabled() {
+		return s.query.GlobalSearch(ctx, term, currentUser)
+	}
+
+	// Resolve readable, searchable objects
+	scope := make([]string, 0)
+	schemas := make(map[string]*models.ObjectMetadata)
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if !isIndexable(schema) {
+			continue
+		}
+		if len(objectNames) > 0 && !ContainsStringIgnoreCase(objectNames, schema.APIName) {
+			continue
+		}
+		if !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+			continue
+		}
+		key := strings.ToLower(schema.APIName)
+		scope = append(scope, key)
+		schemas[key] = schema
+	}
+	if len(scope) == 0 {
+		return []models.SearchResult{}, nil
+	}
+
+	hits, err := s.index.Search(ctx, ports.SearchQuery{Term: term, ObjectAPINames: scope, Limit: searchCandidateLimit})
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	// Group hits per object, preserving rank order
+	order := make([]string, 0)
+	grouped := make(map[string][]ports.SearchHit)
+	for _, hit := range hits {
+		key := strings.ToLower(hit.ObjectAPIName)
+		if _, ok := schemas[key]; !ok {
+			continue
+		}
+		if _, seen := grouped[key]; !seen {
+			order = append(order, key)
+		}
+		grouped[key] = append(grouped[key], hit)
+	}
+
+	results := make([]models.SearchResult, 0, len(order))
+	for _, key := range order {
+		result, err := s.resolveHits(ctx, schemas[key], grouped[key], currentUser)
+		if err != nil {
+			log.Printf("⚠️ [Search] Failed to resolve hits for %s: %v", key, err)
+			continue
+		}
+		if len(result.Matches) > 0 {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// resolveHits loads hit records with FLS applied, drops records the user cannot access
+// and strips highlights on fields the user cannot see.
+func (s *SearchIndexService) resolveHits(ctx context.Context, schema *models.ObjectMetadata, hits []ports.SearchHit, currentUser *models.UserSession) (models.SearchResult, error) {
+	result := models.SearchResult{
+		ObjectAPIName: schema.APIName,
+		ObjectLabel:   schema.PluralLabel,
+		Icon:          schema.Icon,
+		Matches:       []models.SObject{},
+		Scores:        make(map[string]float64),
+		Highlights:    make(map[string]map[string]string),
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.RecordID
+	}
+
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, ids, currentUser)
+	if err != nil {
+		return result, err
+	}
+	byID := make(map[string]models.SObject, len(records))
+	for _, record := range records {
+		byID[record.GetString(constants.FieldID)] = record
+	}
+
+	nameField := GetNameFieldAPIName(schema)
+	for _, hit := range hits {
+		record, ok := byID[hit.RecordID]
+		if !ok {
+			continue // Stale index entry or deleted record
+		}
+		if !s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, currentUser) {
+			continue
+		}
+
+		if nameField != "" && nameField != constants.FieldName {
+			if val, ok := record[nameField]; ok {
+				record[constants.FieldName] = val
+			}
+		}
+
+		result.Matches = append(result.Matches, record)
+		result.Scores[hit.RecordID] = hit.Score
+
+		visible := make(map[string]string)
+		for field, snippet := range hit.Highlights {
+			if s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field, currentUser) {
+				visible[field] = snippet
+			}
+		}
+		if len(visible) > 0 {
+			result.Highlights[hit.RecordID] = visible
+		}
+
+		if len(result.Matches) >= searchMatchesPerObject {
+			break
+		}
+	}
+
+	// Keep matches in score order (index order is already ranked, but be explicit)
+	sort.SliceStable(result.Matches, func(i, j int) bool {
+		return result.Scores[result.Matches[i].GetString(constants.FieldID)] > result.Scores[result.Matches[j].GetString(constants.FieldID)]
+	})
+
+	return result, nil
+}
+
+// isIndexable reports whether an object participates in full-text search
+func isIndexable(schema *models.ObjectMetadata) bool {
+	return schema.Searchable && !constants.IsSystemTable(schema.APIName)
+}
+
+// searchableFields returns the text-like physical fields indexed for an object
+func searchableFields(schema *models.ObjectMetadata) []string {
+	fields := make([]string, 0)
+	for _, field := range schema.Fields {
+		switch field.Type {
+		case constants.FieldTypeText, constants.FieldTypeTextArea, constants.FieldTypeLongTextArea,
+			constants.FieldTypeEmail, constants.FieldTypePhone, constants.FieldTypePicklist,
+			constants.FieldTypeAutoNumber:
+			fields = append(fields, field.APIName)
+		default:
+			if field.IsNameField {
+				fields = append(fields, field.APIName)
+			}
+		}
+	}
+	return fields
+}
+
+// buildSearchDocument projects a stored row onto its searchable fields
+func buildSearchDocument(objectName string, row models.SObject, fields []string) ports.SearchDocument {
+	doc := ports.SearchDocument{
+		ObjectAPIName: objectName,
+		RecordID:      row.GetString(constants.FieldID),
+		Fields:        make(map[string]string),
+	}
+	for _, field := range fields {
+		val, ok := row[field]
+		if !ok || val == nil {
+			continue
+		}
+		var text string
+		switch v := val.(type) {
+		case string:
+			text = v
+		case []byte:
+			text = string(v)
+		default:
+			text = fmt.Sprintf("%v", v)
+		}
+		if text != "" {
+			doc.Fields[field] = text
+		}
+	}
+	return doc
+}
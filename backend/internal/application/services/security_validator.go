@@ -45,6 +45,12 @@ func NewSecurityValidator(permissions PermissionChecker, metadata MetadataProvid
 
 // ValidateAndRewrite parses the SQL, validates permissions, and rewrites it for RLS
 func (v *SecurityValidator) ValidateAndRewrite(ctx context.Context, sql string, params []interface{}, user *models.UserSession) (string, []interface{}, error) {
+	return v.ValidateAndRewriteLimited(ctx, sql, params, user, 0)
+}
+
+// ValidateAndRewriteLimited is ValidateAndRewrite that also caps the rows the statement may
+// return at maxRows, injecting a LIMIT or lowering a larger one. 0 leaves the LIMIT alone.
+func (v *SecurityValidator) ValidateAndRewriteLimited(ctx context.Context, sql string, params []interface{}, user *models.UserSession, maxRows int) (string, []interface{}, error) {
 	// 1. Parse SQL
 	stmtNodes, _, err := v.parser.Parse(sql, "", "")
 	if err != nil {
@@ -62,6 +68,11 @@ func (v *SecurityValidator) ValidateAndRewrite(ctx context.Context, sql string,
 	if !ok {
 		return "", nil, fmt.Errorf("only SELECT statements are allowed in analytics")
 	}
+	if maxRows > 0 {
+		if err := capRows(selectStmt, maxRows); err != nil {
+			return "", nil, err
+		}
+	}
 
 	// 3. Visitor for Validation
 	// Extract primary table name to handle implicit column references
@@ -111,6 +122,37 @@ func (v *SecurityValidator) ValidateAndRewrite(ctx context.Context, sql string,
 	return sb.String(), params, nil
 }
 
+// capRows limits a SELECT to at most maxRows rows
+func capRows(stmt *ast.SelectStmt, maxRows int) error {
+	limit := &test_driver.ValueExpr{}
+	limit.SetInt64(int64(maxRows))
+
+	if stmt.Limit == nil {
+		stmt.Limit = &ast.Limit{Count: limit}
+		return nil
+	}
+	count, ok := stmt.Limit.Count.(*test_driver.ValueExpr)
+	if !ok {
+		return fmt.Errorf("LIMIT must be a number")
+	}
+	var requested uint64
+	switch count.Kind() {
+	case test_driver.KindInt64:
+		if count.GetInt64() < 0 {
+			return fmt.Errorf("LIMIT must be a number")
+		}
+		requested = uint64(count.GetInt64())
+	case test_driver.KindUint64:
+		requested = count.GetUint64()
+	default:
+		return fmt.Errorf("LIMIT must be a number")
+	}
+	if requested > uint64(maxRows) {
+		stmt.Limit.Count = limit
+	}
+	return nil
+}
+
 // applyRLS injects "AND owner_id = 'userID'" into the WHERE clause
 func (v *SecurityValidator) applyRLS(ctx context.Context, stmt *ast.SelectStmt, user *models.UserSession) error {
 	// Strategy: If the FROM clause targets a table that needs RLS, add filter.
@@ -198,6 +240,18 @@ func (v *SecurityVisitor) Enter(in ast.Node) (ast.Node, bool) {
 		return in, true
 	}
 
+	// Only plain reads: no locking reads or SELECT ... INTO, in subqueries either
+	if sel, ok := in.(*ast.SelectStmt); ok {
+		if sel.LockInfo != nil && sel.LockInfo.LockType != ast.SelectLockNone {
+			v.err = fmt.Errorf("locking reads are not allowed in analytics")
+			return in, true
+		}
+		if sel.SelectIntoOpt != nil {
+			v.err = fmt.Errorf("SELECT ... INTO is not allowed in analytics")
+			return in, true
+		}
+	}
+
 	// Validate Table Permissions
 	if t, ok := in.(*ast.TableName); ok {
 		objName := t.Name.O
@@ -198,3 +198,47 @@ func TestSecurityValidator_ValidateAndRewrite(t *testing.T) {
 		assert.NotContains(t, rewritten, "owner_id")
 	})
 }
+
+func TestSecurityValidator_ValidateAndRewriteLimited(t *testing.T) {
+	adminUser := &models.UserSession{
+		ID:        "admin-123",
+		ProfileID: constants.ProfileSystemAdmin,
+	}
+
+	newValidator := func() *services.SecurityValidator {
+		mockPerms := new(MockPermissionChecker)
+		mockPerms.On("CheckObjectPermissionWithUser", mock.Anything, mock.Anything, constants.PermRead, adminUser).Return(true)
+		mockPerms.On("CheckFieldVisibilityWithUser", mock.Anything, mock.Anything, mock.Anything, adminUser).Return(true)
+		return services.NewSecurityValidator(mockPerms, new(MockMetadataProvider))
+	}
+
+	limits := []struct {
+		name, sql, want string
+	}{
+		{"injects a LIMIT", "SELECT name FROM Account", "LIMIT 101"},
+		{"lowers a larger LIMIT", "SELECT name FROM Account LIMIT 5000", "LIMIT 101"},
+		{"keeps a smaller LIMIT", "SELECT name FROM Account LIMIT 10", "LIMIT 10"},
+		{"keeps the offset", "SELECT name FROM Account LIMIT 20, 5000", "LIMIT 20,101"},
+	}
+	for _, tc := range limits {
+		t.Run(tc.name, func(t *testing.T) {
+			rewritten, _, err := newValidator().ValidateAndRewriteLimited(context.Background(), tc.sql, nil, adminUser, 101)
+			assert.NoError(t, err)
+			assert.Contains(t, rewritten, tc.want)
+		})
+	}
+
+	rejected := map[string]string{
+		"placeholder LIMIT":   "SELECT name FROM Account LIMIT ?",
+		"FOR UPDATE":          "SELECT name FROM Account FOR UPDATE",
+		"locking subquery":    "SELECT name FROM Account WHERE id IN (SELECT id FROM Account FOR SHARE)",
+		"SELECT INTO":         "SELECT name FROM Account INTO OUTFILE '/tmp/accounts'",
+		"multiple statements": "SELECT name FROM Account; DELETE FROM Account",
+	}
+	for name, sql := range rejected {
+		t.Run("rejects "+name, func(t *testing.T) {
+			_, _, err := newValidator().ValidateAndRewriteLimited(context.Background(), sql, []interface{}{10}, adminUser, 101)
+			assert.Error(t, err)
+		})
+	}
+}
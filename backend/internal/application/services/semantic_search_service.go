@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultSemanticLimit = 10
+	maxSemanticLimit     = 50
+	// semanticCandidateFactor over-fetches nearest neighbours to survive security filtering
+	semanticCandidateFactor = 4
+	// semanticRebuildBatchSize is the page size (and embedding batch size) used when re-embedding an object
+	semanticRebuildBatchSize = 100
+	// maxEmbeddingTextLength keeps record text within typical embedding model input limits
+	maxEmbeddingTextLength = 8000
+)
+
+// SemanticSearchService keeps record embeddings in sync with record events and ranks
+// records by similarity to a search term. It embeds the same fields as full-text search.
+type SemanticSearchService struct {
+	embedder    ports.Embedder
+	store       ports.VectorStore
+	repo        *persistence.QueryRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+}
+
+// NewSemanticSearchService creates a new SemanticSearchService. embedder and store may be nil (semantic search disabled).
+func NewSemanticSearchService(
+	embedder ports.Embedder,
+	store ports.VectorStore,
+	repo *persistence.QueryRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	query *QueryService,
+) *SemanticSearchService {
+	return &SemanticSearchService{
+		embedder:    embedder,
+		store:       store,
+		repo:        repo,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+	}
+}
+
+// Enabled reports whether an embedding provider and vector store are configured
+func (s *SemanticSearchService) Enabled() bool {
+	return s.embedder != nil && s.store != nil
+}
+
+// RegisterHandlers subscribes to after-commit record events to keep embeddings current
+func (s *SemanticSearchService) RegisterHandlers(eventBus *EventBus) {
+	if !s.Enabled() {
+		return
+	}
+
+	handler := func(eventType events.EventType) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			recordID := recordPayload.Record.GetString(constants.FieldID)
+			if recordID == "" {
+				return nil
+			}
+
+			// Embedding failures must not fail the outbox event (flows share the same dispatch)
+			var err error
+			if eventType == events.RecordDeleted {
+				err = s.store.Remove(ctx, recordPayload.ObjectAPIName, recordID)
+			} else {
+				err = s.EmbedRecord(ctx, recordPayload.ObjectAPIName, recordID)
+			}
+			if err != nil {
+				log.Printf("⚠️ [Semantic] Failed to sync %s/%s: %v", recordPayload.ObjectAPIName, recordID, err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(events.RecordCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(events.RecordUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(events.RecordDeleted))
+
+	log.Printf("🧭 Semantic search (%s, %s) subscribed to record events", s.embedder.Name(), s.store.Name())
+}
+
+// EmbedRecord reloads a record and refreshes its embedding. Records whose text is unchanged
+// since they were last embedded are skipped, so edits to other fields cost no embedding call.
+func (s *SemanticSearchService) EmbedRecord(ctx context.Context, objectName, recordID string) error {
+	schema := s.metadata.GetSchema(ctx, objectName)
+	if schema == nil || !isIndexable(schema) {
+		return nil
+	}
+	fields := searchableFields(schema)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	rows, err := s.repo.FindByIDs(ctx, schema.APIName, fields, []string{recordID})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return s.store.Remove(ctx, schema.APIName, recordID)
+	}
+	_, err = s.embedRows(ctx, schema, rows, fields)
+	return err
+}
+
+// Rebuild embeds every searchable record whose text changed and returns the number of records embedded
+func (s *SemanticSearchService) Rebuild(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, fmt.Errorf("semantic search is not enabled")
+	}
+
+	total := 0
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if !isIndexable(schema) {
+			continue
+		}
+		fields := searchableFields(schema)
+		if len(fields) == 0 {
+			continue
+		}
+
+		afterID := ""
+		for {
+			rows, err := s.repo.ScanAfter(ctx, schema.APIName, fields, afterID, semanticRebuildBatchSize)
+			if err != nil {
+				return total, fmt.Errorf("failed to scan %s: %w", schema.APIName, err)
+			}
+			embedded, err := s.embedRows(ctx, schema, rows, fields)
+			total += embedded
+			if err != nil {
+				return total, fmt.Errorf("failed to embed %s: %w", schema.APIName, err)
+			}
+			if len(rows) < semanticRebuildBatchSize {
+				break
+			}
+			afterID = rows[len(rows)-1].GetString(constants.FieldID)
+		}
+	}
+
+	log.Printf("🧭 Semantic search (%s, %s) rebuilt: %d records embedded", s.embedder.Name(), s.store.Name(), total)
+	return total, nil
+}
+
+// embedRows embeds the rows whose text changed in one call and stores the vectors
+func (s *SemanticSearchService) embedRows(ctx context.Context, schema *models.ObjectMetadata, rows []models.SObject, fields []string) (int, error) {
+	var docs []ports.VectorDocument
+	var texts []string
+	for _, row := range rows {
+		recordID := row.GetString(constants.FieldID)
+		text := embeddingText(schema, row, fields)
+		if text == "" {
+			if err := s.store.Remove(ctx, schema.APIName, recordID); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		hash := contentHash(s.embedder.Name(), text)
+		stored, err := s.store.ContentHash(ctx, schema.APIName, recordID)
+		if err != nil {
+			return 0, err
+		}
+		if stored == hash {
+			continue
+		}
+		docs = append(docs, ports.VectorDocument{ObjectAPIName: schema.APIName, RecordID: recordID, ContentHash: hash, Model: s.embedder.Name()})
+		texts = append(texts, text)
+	}
+	if len(texts) == 0 {
+		return 0, nil
+	}
+
+	vectors, err := s.embedder.Embed(ctx, texts)
+	if err != nil {
+		return 0, err
+	}
+	for i := range docs {
+		docs[i].Vector = vectors[i]
+		if err := s.store.Upsert(ctx, docs[i]); err != nil {
+			return i, err
+		}
+	}
+	return len(docs), nil
+}
+
+// Search ranks records the user can read by similarity to req.Term
+func (s *SemanticSearchService) Search(ctx context.Context, req models.SemanticSearchRequest, currentUser *models.UserSession) ([]models.SemanticSearchHit, error) {
+	if !s.Enabled() {
+		return nil, errors.NewValidationError("term", "Semantic search is not configured (set EMBEDDING_PROVIDER)")
+	}
+	term := strings.TrimSpace(req.Term)
+	if term == "" {
+		return nil, errors.NewValidationError("term", "Search term is required")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSemanticLimit
+	}
+	limit = min(limit, maxSemanticLimit)
+
+	// Resolve readable, searchable objects
+	scope := make([]string, 0)
+	schemas := make(map[string]*models.ObjectMetadata)
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if !isIndexable(schema) {
+			continue
+		}
+		if len(req.ObjectAPINames) > 0 && !ContainsStringIgnoreCase(req.ObjectAPINames, schema.APIName) {
+			continue
+		}
+		if !s.permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, currentUser) {
+			continue
+		}
+		key := strings.ToLower(schema.APIName)
+		scope = append(scope, key)
+		schemas[key] = schema
+	}
+	if len(scope) == 0 {
+		return []models.SemanticSearchHit{}, nil
+	}
+
+	vectors, err := s.embedder.Embed(ctx, []string{term})
+	if err != nil {
+		return nil, errors.NewInternalError("failed to embed search term", err)
+	}
+	hits, err := s.store.Search(ctx, ports.VectorQuery{Vector: vectors[0], ObjectAPINames: scope, Limit: limit * semanticCandidateFactor})
+	if err != nil {
+		return nil, fmt.Errorf("semantic search failed: %w", err)
+	}
+
+	// Load each object's candidates once, then emit hits in similarity order
+	ids := make(map[string][]string)
+	for _, hit := range hits {
+		key := strings.ToLower(hit.ObjectAPIName)
+		if _, ok := schemas[key]; ok {
+			ids[key] = append(ids[key], hit.RecordID)
+		}
+	}
+	records := make(map[string]map[string]models.SObject, len(ids))
+	for key, recordIDs := range ids {
+		rows, err := s.query.QueryByIDs(ctx, schemas[key].APIName, recordIDs, currentUser)
+		if err != nil {
+			log.Printf("⚠️ [Semantic] Failed to resolve hits for %s: %v", key, err)
+			continue
+		}
+		records[key] = make(map[string]models.SObject, len(rows))
+		for _, row := range rows {
+			records[key][row.GetString(constants.FieldID)] = row
+		}
+	}
+
+	results := make([]models.SemanticSearchHit, 0, limit)
+	for _, hit := range hits {
+		key := strings.ToLower(hit.ObjectAPIName)
+		record, ok := records[key][hit.RecordID]
+		if !ok {
+			continue // Stale embedding, deleted or hidden record
+		}
+		schema := schemas[key]
+		if !s.permissions.CheckRecordAccess(ctx, schema, record, constants.PermRead, currentUser) {
+			continue
+		}
+		if nameField := GetNameFieldAPIName(schema); nameField != "" && nameField != constants.FieldName {
+			if val, ok := record[nameField]; ok {
+				record[constants.FieldName] = val
+			}
+		}
+		results = append(results, models.SemanticSearchHit{
+			ObjectAPIName: schema.APIName,
+			ObjectLabel:   schema.Label,
+			Record:        record,
+			Score:         hit.Score,
+		})
+		if len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// SimilarRecords returns the records of the same object whose embeddings are closest to a
+// record's own, excluding the record. It returns nil when the record has no embedding.
+// Results are not permission-filtered; callers must not expose them to users directly.
+func (s *SemanticSearchService) SimilarRecords(ctx context.Context, objectName, recordID string, limit int) ([]ports.VectorHit, error) {
+	if !s.Enabled() {
+		return nil, nil
+	}
+	vector, err := s.store.Vector(ctx, objectName, recordID)
+	if err != nil || vector == nil {
+		return nil, err
+	}
+	hits, err := s.store.Search(ctx, ports.VectorQuery{Vector: vector, ObjectAPINames: []string{objectName}, Limit: limit + 1})
+	if err != nil {
+		return nil, err
+	}
+	similar := make([]ports.VectorHit, 0, limit)
+	for _, hit := range hits {
+		if hit.RecordID != recordID && len(similar) < limit {
+			similar = append(similar, hit)
+		}
+	}
+	return similar, nil
+}
+
+// embeddingText renders a record's searchable fields as "Label: value" lines under the object label
+func embeddingText(schema *models.ObjectMetadata, row models.SObject, fields []string) string {
+	doc := buildSearchDocument(schema.APIName, row, fields)
+	if len(doc.Fields) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(schema.Label)
+	for _, field := range fields {
+		text, ok := doc.Fields[field]
+		if !ok {
+			continue
+		}
+		label := field
+		if f := FindField(schema, field); f != nil && f.Label != "" {
+			label = f.Label
+		}
+		fmt.Fprintf(&sb, "\n%s: %s", label, text)
+	}
+	text := sb.String()
+	if len(text) > maxEmbeddingTextLength {
+		text = strings.ToValidUTF8(text[:maxEmbeddingTextLength], "")
+	}
+	return text
+}
+
+// contentHash identifies the text a vector was computed from; the model is included so
+// switching models re-embeds every record
+func contentHash(model, text string) string {
+	sum := sha256.Sum256([]byte(model + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,11 +2,23 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/nexuscrm/backend/internal/config"
 	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/internal/infrastructure/documents"
+	"github.com/nexuscrm/backend/internal/infrastructure/eventbus"
+	"github.com/nexuscrm/backend/internal/infrastructure/geocoding"
+	"github.com/nexuscrm/backend/internal/infrastructure/mailsync"
+	"github.com/nexuscrm/backend/internal/infrastructure/nlq"
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/infrastructure/search"
+	"github.com/nexuscrm/backend/internal/infrastructure/telephony"
+	"github.com/nexuscrm/backend/internal/infrastructure/vector"
+	"github.com/nexuscrm/backend/pkg/extension"
 	"github.com/nexuscrm/backend/pkg/formula"
 	"github.com/nexuscrm/shared/pkg/models"
 )
@@ -23,6 +35,7 @@ type ServiceManager struct {
 	UIMetadata      *UIMetadataService
 	Permissions     *PermissionService
 	Auth            *AuthService
+	Setup           *SetupService
 	QuerySvc        *QueryService
 	Persistence     *PersistenceService
 	ActionSvc       *ActionService
@@ -34,7 +47,59 @@ type ServiceManager struct {
 	Notification    *NotificationService
 	Validation      *ValidationService
 	Outbox          *OutboxService
+	ChangeCapture   *ChangeDataCaptureService
 	Scheduler       *SchedulerService
+	SLA             *SLAService
+	Escalations     *EscalationService
+	Scripts         *ScriptService
+	StateMachines   *StateMachineService
+	CustomEndpoints *CustomEndpointService
+	BatchJobs       *BatchJobService
+	Portal          *PortalService
+	Translations    *TranslationService
+	SetupAudit      *SetupAuditService
+	SchemaDrift     *SchemaDriftService
+	IndexAdvisor    *IndexAdvisorService
+	Archive         *ArchiveService
+	QueryGovernor   *QueryGovernor
+	ReadRouter      *persistence.ReadRouter
+	Search          *SearchIndexService
+	SavedSearch     *SavedSearchService
+	NLQ             *NLQService
+	Semantic        *SemanticSearchService
+	DataQuality     *DataQualityService
+	Recent          *RecentItemsService
+	AIHistory       *AIHistoryService
+	Dashboards      *DashboardRunner
+	Reports         *ReportService
+	Charts          *ChartService
+	AsyncJobs       *AsyncJobService
+	Picklists       *PicklistValueService
+	MassOperations  *MassOperationService
+	SampleData      *SampleDataService
+	Settings        *CustomSettingService
+	Callouts        *CalloutService
+	External        *ExternalObjectService
+	SCIM            *SCIMService
+	Deactivation    *UserDeactivationService
+	ActivitySync    *ActivitySyncService
+	Telephony       *TelephonyService
+	RecordStats     *RecordStatsService
+	StageHistory    *StageHistoryService
+	Forecasts       *ForecastService
+	Campaigns       *CampaignService
+	Orders          *OrderService
+	Entitlements    *EntitlementService
+	Knowledge       *KnowledgeService
+	Surveys         *SurveyService
+	Timeline        *TimelineService
+	Hooks           *IntegrationHookService
+	InboundHooks    *InboundHookService
+	Files           *FileService
+	Documents       *DocumentService
+	Geocoding       *GeocodingService
+	Extensions      *ExtensionService
+	Config          *config.Reloader
 
 	// Repositories
 	UserRepo   *persistence.UserRepository
@@ -42,10 +107,11 @@ type ServiceManager struct {
 }
 
 // NewServiceManager creates a new service manager with all dependencies wired
-func NewServiceManager(db *database.TiDBConnection) *ServiceManager {
+func NewServiceManager(db *database.TiDBConnection, settings config.Services) *ServiceManager {
 	sm := &ServiceManager{
 		db: db,
 	}
+	runtime := config.RuntimeFromEnv()
 
 	// 1. Infrastructure & Event Bus
 	sm.TxManager = persistence.NewTransactionManager(db)
@@ -65,20 +131,97 @@ func NewServiceManager(db *database.TiDBConnection) *ServiceManager {
 	outboxRepo := persistence.NewOutboxRepository(db.DB())
 	queryRepo := persistence.NewQueryRepository(db.DB())
 	schedulerRepo := persistence.NewSchedulerRepository(db.DB())
+	savedSearchRepo := persistence.NewSavedSearchRepository(db.DB())
+	reportRepo := persistence.NewReportRepository(db.DB())
+	asyncJobRepo := persistence.NewAsyncJobRepository(db.DB())
+	customSettingRepo := persistence.NewCustomSettingRepository(db.DB())
+	namedCredentialRepo := persistence.NewNamedCredentialRepository(db.DB())
+	externalObjectRepo := persistence.NewExternalObjectRepository(db.DB())
+	changeEventRepo := persistence.NewChangeEventRepository(db.DB())
+	dataQualityRepo := persistence.NewDataQualityRepository(db.DB())
+	slaRepo := persistence.NewSLARepository(db.DB())
+	escalationRepo := persistence.NewEscalationRepository(db.DB())
+	portalRepo := persistence.NewPortalRepository(db.DB())
+	translationRepo := persistence.NewTranslationRepository(db.DB())
+	setupAuditRepo := persistence.NewSetupAuditRepository(db.DB())
+	deletedMetadataRepo := persistence.NewDeletedMetadataRepository(db.DB())
+	archiveRepo := persistence.NewArchiveRepository(db.DB())
+	queryGovernorRepo := persistence.NewQueryGovernorRepository(db.DB())
+	syncRepo := persistence.NewSyncRepository(db.DB())
+	hookSubscriptionRepo := persistence.NewHookSubscriptionRepository(db.DB())
+	inboundHookRepo := persistence.NewInboundHookRepository(db.DB())
+
+	// Read replica for analytics, reports and dashboards (TIDB_REPLICA_DSN)
+	var replicaDB *sql.DB
+	if replica, err := database.GetReplica(); err != nil {
+		log.Printf("⚠️  Read replica unavailable, reading from the primary: %v", err)
+	} else if replica != nil {
+		replicaDB = replica.DB()
+		log.Println("✅ Read replica connection established")
+	}
+	sm.ReadRouter = persistence.NewReadRouter(db.DB(), replicaDB, settings.ReplicaMaxStaleness)
+	queryRepo.SetReadRouter(sm.ReadRouter)
+	reportRepo.SetReadRouter(sm.ReadRouter)
 
 	// 3. Core Domain Managers (Foundation)
 	sm.Schema = NewSchemaManager(schemaRepo)
 	sm.Metadata = NewMetadataService(metadataRepo, sm.Schema)
+	sm.SetupAudit = NewSetupAuditService(setupAuditRepo)
+	sm.Metadata.SetSetupAudit(sm.SetupAudit)
+	sm.Metadata.SetDeletedMetadata(deletedMetadataRepo)            // Deleted objects and fields stay restorable for MetadataRestoreWindow
+	formula.SetCustomMetadataSource(sm.Metadata.CustomMetadataEnv) // Formulas and flows read cmdt.<Type>.<Record>.<field>
+	sm.Settings = NewCustomSettingService(customSettingRepo)
+	formula.SetCustomSettingsSource(sm.Settings.ResolveForFormula) // Formulas read $Setting.<Name> for the running user
 	sm.Permissions = NewPermissionService(permissionRepo, sm.Metadata, sm.UserRepo)
+	sm.Translations = NewTranslationService(translationRepo, sm.Metadata)
+
+	sm.Callouts = NewCalloutService(namedCredentialRepo)
+	sm.External = NewExternalObjectService(externalObjectRepo, sm.Metadata, sm.Callouts)
 
 	// 4. Higher-Level Orchestration Services
-	sm.UIMetadata = NewUIMetadataService(sm.Metadata, sm.Permissions)
-	sm.QuerySvc = NewQueryService(queryRepo, sm.Metadata, sm.Permissions)
+	sm.QuerySvc = NewQueryService(queryRepo, sm.Metadata, sm.Permissions, sm.External)
+	sm.QueryGovernor = NewQueryGovernor(queryGovernorRepo, QueryLimitsFromConfig(runtime))
+	sm.QuerySvc.SetGovernor(sm.QueryGovernor) // Per-profile row, time and concurrency limits
+	sm.QuerySvc.SetSetupAudit(sm.SetupAudit)  // Admin SQL run through the sandbox is audited
+	sm.UIMetadata = NewUIMetadataService(sm.Metadata, sm.Permissions, sm.QuerySvc)
+	sm.Dashboards = NewDashboardRunner(sm.Metadata, sm.QuerySvc, sm.Permissions, runtime.DashboardCacheTTL)
+	sm.Reports = NewReportService(reportRepo, NewReportEngine(reportRepo, sm.Metadata, sm.Permissions), sm.Permissions)
+	sm.Charts = NewChartService(sm.Dashboards, sm.Reports)
+
+	// Full-text search (optional; falls back to LIKE search when SEARCH_ENGINE is unset)
+	searchIndex, err := search.New(settings.Search)
+	if err != nil {
+		log.Printf("⚠️  Search engine disabled: %v", err)
+		searchIndex = nil
+	}
+	sm.Search = NewSearchIndexService(searchIndex, queryRepo, sm.Metadata, sm.Permissions, sm.QuerySvc)
+	sm.Search.RegisterHandlers(sm.EventBus)
+	sm.SavedSearch = NewSavedSearchService(savedSearchRepo, sm.Search)
+	// Natural-language queries (optional; disabled when LLM_BASE_URL is unset)
+	sm.NLQ = NewNLQService(nlq.New(settings.LLM), sm.Metadata, sm.Permissions, sm.QuerySvc)
+	// Semantic search (optional; disabled when EMBEDDING_PROVIDER is unset)
+	embedder, vectorStore, err := vector.New(settings.Embeddings, db.DB())
+	if err != nil {
+		log.Printf("⚠️  Semantic search disabled: %v", err)
+		embedder, vectorStore = nil, nil
+	}
+	sm.Semantic = NewSemanticSearchService(embedder, vectorStore, queryRepo, sm.Metadata, sm.Permissions, sm.QuerySvc)
+	sm.Semantic.RegisterHandlers(sm.EventBus)
+	sm.Recent = NewRecentItemsService(sm.SystemRepo, sm.Metadata, sm.Permissions)
+	sm.AIHistory = NewAIHistoryService(sm.SystemRepo, settings.AIHistoryRetention)
 
 	// 5. Persistence Ecosystem
 	rollupSvc := NewRollupService(rollupRepo, sm.Metadata, sm.TxManager)
 	sm.Outbox = NewOutboxService(outboxRepo, sm.EventBus, sm.TxManager)
 
+	// External event bus (optional; events stay in-process when EVENT_BUS is unset)
+	transport, err := eventbus.New(settings.EventBus)
+	if err != nil {
+		log.Printf("⚠️  External event bus disabled: %v", err)
+	} else if transport != nil {
+		sm.Outbox.SetTransport(transport, eventbus.Group(settings.EventBus))
+	}
+
 	sm.Persistence = NewPersistenceService(
 		recordRepo,
 		rollupSvc,
@@ -90,28 +233,167 @@ func NewServiceManager(db *database.TiDBConnection) *ServiceManager {
 		sm.Outbox,
 	)
 
+	// Change data capture (optional; the stream stays readable when capture is off)
+	sm.ChangeCapture = NewChangeDataCaptureService(changeEventRepo, sm.Metadata)
+	if settings.ChangeDataCapture {
+		sm.Persistence.SetChangeDataCapture(sm.ChangeCapture)
+	}
+	sm.Persistence.SetTranslations(sm.Translations)
+
 	// 6. Business Logic Services
-	sm.ActionSvc = NewActionService(sm.Metadata, sm.Persistence, sm.Permissions, sm.TxManager)
+	sm.AsyncJobs = NewAsyncJobService(asyncJobRepo)
+	sm.DataQuality = NewDataQualityService(dataQualityRepo, queryRepo, sm.Metadata, sm.Permissions, sm.QuerySvc, sm.AsyncJobs, sm.Semantic)
+	sm.Picklists = NewPicklistValueService(sm.Metadata, recordRepo, sm.AsyncJobs)
+	sm.MassOperations = NewMassOperationService(sm.Persistence, sm.QuerySvc, sm.Metadata, sm.Permissions, sm.AsyncJobs)
+	sm.SampleData = NewSampleDataService(sm.Metadata, sm.Persistence, sm.AsyncJobs)
+	sm.ActionSvc = NewActionService(sm.Metadata, sm.Persistence, sm.Permissions, sm.TxManager, sm.Callouts)
+
+	// State machines on picklist fields: transitions checked on save, state actions run after it
+	sm.StateMachines = NewStateMachineService(persistence.NewStateMachineRepository(db.DB()), sm.Metadata, sm.ActionSvc)
+	sm.StateMachines.RegisterHandlers(sm.EventBus)
+	sm.Persistence.SetStateMachines(sm.StateMachines)
+
+	// Scripts: sandboxed Starlark record triggers and RunScript actions, run under per-script quotas
+	sm.Scripts = NewScriptService(persistence.NewScriptRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Callouts, sm.TxManager, settings.ScriptLogRetention)
+	sm.Scripts.RegisterHandlers(sm.EventBus)
+	sm.ActionSvc.SetScripts(sm.Scripts)
+	// Custom REST endpoints (/api/custom/:name) bound to scripts or query and DML templates
+	sm.CustomEndpoints = NewCustomEndpointService(persistence.NewCustomEndpointRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Scripts)
 
 	// Flow Stack (Order matters: Instance -> Executor)
 	sm.FlowInstanceSvc = NewFlowInstanceService(sm.Persistence, sm.QuerySvc, sm.Metadata)
 	sm.FlowExecutor = NewFlowExecutor(sm.Metadata, sm.ActionSvc, sm.EventBus, sm.FlowInstanceSvc, sm.Persistence)
+	sm.FlowExecutor.SetSavepoints(sm.TxManager)
 	// Register flow handlers
 	sm.FlowExecutor.RegisterFlowHandlers()
 
 	sm.System = NewSystemManager(sm.Persistence, sm.SystemRepo)
+	sm.Config = config.NewReloader(sm.System, runtime, sm.ApplyRuntimeConfig)
 	sm.Feed = NewFeedService(sm.Persistence, sm.QuerySvc)
 	sm.Notification = NewNotificationService(sm.Persistence, sm.QuerySvc)
 
 	// Approval Service
-	sm.Approval = NewApprovalService(sm.Persistence, sm.QuerySvc, sm.Permissions, sm.FlowExecutor, sm.FlowInstanceSvc)
+	sm.Approval = NewApprovalService(sm.Persistence, sm.QuerySvc, sm.Permissions, sm.FlowExecutor, sm.FlowInstanceSvc, sm.Notification)
 
 	// Scheduler Service
 	sm.Scheduler = NewSchedulerService(schedulerRepo, sm.Metadata, sm.FlowExecutor)
 
+	// SLA timers (escalations run on the scheduler tick)
+	sm.SLA = NewSLAService(slaRepo, queryRepo, sm.Metadata, sm.Permissions, sm.QuerySvc, sm.FlowExecutor)
+	sm.SLA.RegisterHandlers(sm.EventBus)
+	sm.Scheduler.AddMonitor(sm.SLA.CheckDueTimers)
+
+	// Escalation rules (monitored on the scheduler tick)
+	sm.Escalations = NewEscalationService(escalationRepo, sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Notification, sm.FlowExecutor, sm.SLA)
+	sm.Scheduler.AddMonitor(sm.Escalations.Run)
+
+	// Batch jobs: chunked bulk processing of a scope query, started on demand or on a schedule
+	sm.BatchJobs = NewBatchJobService(persistence.NewBatchJobRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Permissions, sm.Scripts, sm.AsyncJobs)
+	sm.Scheduler.AddMonitor(sm.BatchJobs.RunScheduled)
+
+	// Script runs past SCRIPT_LOG_RETENTION_DAYS are purged
+	sm.Scheduler.AddMonitor(sm.Scripts.PurgeLogs)
+
+	// Erased objects and fields are dropped once their grace period ends
+	sm.Scheduler.AddMonitor(sm.Metadata.PurgeExpiredMetadata)
+
+	// Schema drift between the database and metadata (checked on the scheduler tick)
+	sm.SchemaDrift = NewSchemaDriftService(sm.Schema, sm.Metadata, settings.SchemaDriftInterval, settings.SchemaDriftAutoRepair)
+	sm.Scheduler.AddMonitor(sm.SchemaDrift.Run)
+
+	// Slow query capture feeding the index advisor
+	sm.IndexAdvisor = NewIndexAdvisorService(sm.Metadata, runtime.SlowQueryThreshold)
+	sm.QuerySvc.SetIndexAdvisor(sm.IndexAdvisor)
+
+	// Read replica health and lag (checked on the scheduler tick)
+	sm.Scheduler.AddMonitor(sm.ReadRouter.Check)
+
+	// Archival: rows past an object's retention move to its archive table
+	sm.Archive = NewArchiveService(archiveRepo, sm.Metadata, settings.ArchiveInterval)
+	sm.Scheduler.AddMonitor(sm.Archive.Run)
+
+	// Record statistics: last activity date, open task count and time in stage
+	sm.RecordStats = NewRecordStatsService(persistence.NewRecordStatsRepository(db.DB()), sm.Metadata, parseRecordStatsObjects(settings.RecordStatsObjects), recordStatsTasks(settings.RecordStatsTasks))
+	sm.RecordStats.RegisterHandlers(sm.EventBus)
+	sm.Scheduler.AddMonitor(sm.RecordStats.Run)
+
+	// Stage history of picklist fields with track_history (funnel and velocity analytics)
+	sm.StageHistory = NewStageHistoryService(persistence.NewStageHistoryRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Permissions)
+	sm.StageHistory.RegisterHandlers(sm.EventBus)
+
+	// Forecasts rolled up the role hierarchy, with quotas and manager adjustments
+	sm.Forecasts = NewForecastService(persistence.NewForecastRepository(db.DB()), sm.UserRepo, sm.Metadata, sm.Permissions)
+
+	// Campaigns: members with response statuses and influenced revenue rolled up on the scheduler tick
+	sm.Campaigns = NewCampaignService(persistence.NewCampaignRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Reports, sm.Forecasts, sm.Permissions, campaignInfluenceObject(settings.CampaignInfluenceObject), settings.CampaignRollupInterval)
+	sm.Scheduler.AddMonitor(sm.Campaigns.Run)
+
+	// Orders: activation and cancellation move product stock in the same transaction as the order
+	sm.Orders = NewOrderService(persistence.NewOrderRepository(db.DB()), recordRepo, sm.Persistence, sm.Outbox, sm.Metadata, sm.QuerySvc, sm.Permissions, InventoryConfigFrom(settings.Inventory))
+
+	// Entitlements: new cases verify and consume the entitlement they name inside their insert transaction
+	sm.Entitlements = NewEntitlementService(persistence.NewEntitlementRepository(db.DB()), sm.TxManager, sm.Metadata, sm.QuerySvc, sm.Permissions, EntitlementConfigFrom(settings.Entitlements))
+	sm.Entitlements.RegisterHandlers(sm.EventBus)
+
+	// Knowledge: articles are versioned on publish; only published versions are searchable and attachable
+	sm.Knowledge = NewKnowledgeService(persistence.NewKnowledgeRepository(db.DB()), sm.TxManager, sm.Search, sm.Metadata, sm.QuerySvc, sm.Permissions)
+
+	// Surveys: invitation links are single use; responses are recorded through public token endpoints
+	sm.Surveys = NewSurveyService(persistence.NewSurveyRepository(db.DB()), sm.Persistence, sm.Outbox, sm.Metadata, sm.QuerySvc, sm.Permissions, SurveyConfigFrom(settings.Survey))
+
+	// Timeline: one paged stream of what happened to a record, read from the tables each feature keeps
+	taskObject, taskField := timelineTaskLink(settings.TimelineTaskLink)
+	sm.Timeline = NewTimelineService(persistence.NewTimelineRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Permissions, taskObject, taskField)
+
+	// Mail and calendar sync: connected mailboxes are imported as activities on the scheduler tick
+	sm.ActivitySync = NewActivitySyncService(syncRepo, mailsync.NewConfiguredRegistry(settings.MailSync), sm.Metadata, sm.QuerySvc, sm.Permissions, syncMatchFields(settings.SyncMatchFields), settings.SyncInterval)
+	sm.ActivitySync.SetRecordStats(sm.RecordStats)
+	sm.Scheduler.AddMonitor(sm.ActivitySync.Run)
+
+	// Telephony: click-to-dial, call logging and provider call events
+	sm.Telephony = NewTelephonyService(syncRepo, telephony.NewConfiguredRegistry(settings.Telephony), sm.UserRepo, sm.Metadata, sm.QuerySvc, sm.Permissions, telephonyMatchFields(settings.TelephonyMatchFields))
+	sm.Telephony.SetRecordStats(sm.RecordStats)
+
+	// Integration platforms (Zapier, Make): polling triggers, REST hooks and simple actions
+	sm.Hooks = NewIntegrationHookService(hookSubscriptionRepo, sm.UserRepo, sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Permissions)
+	sm.Hooks.RegisterHandlers(sm.EventBus)
+
+	// Inbound webhooks: external systems push JSON that is mapped onto records
+	sm.InboundHooks = NewInboundHookService(inboundHookRepo, sm.UserRepo, sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Permissions)
+
+	// Files and printable documents (quotes, invoices) generated from templates
+	sm.Files = NewFileService(sm.Persistence)
+	sm.Documents = NewDocumentService(persistence.NewDocumentTemplateRepository(db.DB()), sm.Metadata, sm.QuerySvc, sm.Permissions, sm.Files, documents.NewConverterAt(settings.DocumentConverterURL))
+
+	// Geocoding of address fields onto Geolocation fields (optional; disabled when GEOCODING_PROVIDER is unset)
+	geocoder, err := geocoding.New(settings.Geocoding)
+	if err != nil {
+		log.Printf("⚠️  Geocoding disabled: %v", err)
+		geocoder = nil
+	}
+	sm.Geocoding = NewGeocodingService(geocoder, parseGeocodeFields(settings.GeocodeFields), sm.Metadata, sm.Persistence)
+	sm.Geocoding.RegisterHandlers(sm.EventBus)
+
+	// Extensions: save hooks of extensions compiled in or loaded as Go plugins
+	sm.Extensions = NewExtensionService(extension.Default())
+	sm.Extensions.RegisterHandlers(sm.EventBus)
+
+	// Customer portal
+	sm.Portal = NewPortalService(portalRepo, sm.UserRepo, sm.Metadata, sm.Permissions, sm.QuerySvc, sm.Persistence)
+
 	// 7. Auth Service (Instantiated last to satisfy dependencies)
 	sm.Auth = NewAuthService(sm.Persistence, sm.UserRepo, sessionRepo, permissionRepo)
 
+	// First-run setup: names the organization and creates the first administrator
+	sm.Setup = NewSetupService(persistence.NewOrganizationRepository(db.DB()), sm.UserRepo, sm.Persistence, sm.Auth, sm.Translations, SetupConfigFrom(settings.Setup))
+	sm.Setup.SetSampleDataLoader(sm.SampleData)
+
+	// SCIM provisioning from identity providers
+	sm.SCIM = NewSCIMService(sm.Auth, sm.UserRepo, persistence.NewGroupRepository(db.DB()), sm.Persistence)
+
+	// Ownership hand-over when users leave
+	sm.Deactivation = NewUserDeactivationService(sm.Auth, sm.UserRepo, sm.Metadata, sm.QuerySvc, sm.Persistence, sm.Permissions)
+
 	return sm
 }
 
@@ -121,10 +403,18 @@ func (sm *ServiceManager) RefreshMetadataCache() error {
 		return fmt.Errorf("failed to refresh metadata cache: %w", err)
 	}
 
+	if err := sm.Settings.RefreshCache(context.Background()); err != nil {
+		return fmt.Errorf("failed to refresh custom settings: %w", err)
+	}
+
 	if err := sm.UIMetadata.RefreshCache(); err != nil {
 		return fmt.Errorf("failed to refresh UI metadata cache: %w", err)
 	}
 
+	if err := sm.Translations.RefreshCache(context.Background()); err != nil {
+		return fmt.Errorf("failed to refresh translations: %w", err)
+	}
+
 	if err := sm.Permissions.RefreshPermissions(); err != nil {
 		return fmt.Errorf("failed to refresh permissions: %w", err)
 	}
@@ -176,6 +466,74 @@ func (sm *ServiceManager) StartScheduler() {
 	}
 }
 
+// StartAIHistoryRetention starts purging AI agent history past its retention period.
+// Call this during server startup.
+func (sm *ServiceManager) StartAIHistoryRetention() {
+	if sm.AIHistory != nil {
+		sm.AIHistory.StartRetentionWorker(aiHistoryPurgeInterval)
+	}
+}
+
+// StopAIHistoryRetention stops the AI history retention worker.
+// Call this during server shutdown.
+func (sm *ServiceManager) StopAIHistoryRetention() {
+	if sm.AIHistory != nil {
+		sm.AIHistory.StopRetentionWorker()
+	}
+}
+
+// StartSearchIndexer rebuilds the search index in the background.
+// Call this during server startup, after the metadata cache is loaded.
+func (sm *ServiceManager) StartSearchIndexer() {
+	if sm.Search == nil || !sm.Search.Enabled() {
+		return
+	}
+	go func() {
+		if _, err := sm.Search.Rebuild(context.Background()); err != nil {
+			log.Printf("⚠️  Search index rebuild failed: %v", err)
+		}
+		if _, err := sm.Knowledge.Reindex(context.Background()); err != nil {
+			log.Printf("⚠️  Knowledge index rebuild failed: %v", err)
+		}
+	}()
+}
+
+// StartSemanticIndexer embeds records whose text changed since they were last embedded, in the background.
+// Call this during server startup, after the metadata cache is loaded.
+func (sm *ServiceManager) StartSemanticIndexer() {
+	if sm.Semantic == nil || !sm.Semantic.Enabled() {
+		return
+	}
+	go func() {
+		if _, err := sm.Semantic.Rebuild(context.Background()); err != nil {
+			log.Printf("⚠️  Semantic index rebuild failed: %v", err)
+		}
+	}()
+}
+
+// ApplyRuntimeConfig hands changed runtime settings to the services that use them
+func (sm *ServiceManager) ApplyRuntimeConfig(rt config.Runtime) {
+	sm.QueryGovernor.SetDefaults(QueryLimitsFromConfig(rt))
+	sm.Dashboards.SetCacheTTL(rt.DashboardCacheTTL)
+	sm.IndexAdvisor.SetThreshold(rt.SlowQueryThreshold)
+}
+
+// StartConfigReloader reloads the runtime settings from _System_Config every interval.
+// Call this during server startup.
+func (sm *ServiceManager) StartConfigReloader(interval time.Duration) {
+	if sm.Config != nil {
+		sm.Config.Start(interval)
+	}
+}
+
+// StopConfigReloader stops reloading the runtime settings.
+// Call this during server shutdown.
+func (sm *ServiceManager) StopConfigReloader() {
+	if sm.Config != nil {
+		sm.Config.Stop()
+	}
+}
+
 // StopScheduler stops the scheduled job executor gracefully.
 // Call this during server shutdown.
 func (sm *ServiceManager) StopScheduler() {
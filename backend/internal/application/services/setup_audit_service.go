@@ -0,0 +1,108 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	setupAuditDefaultLimit = 50
+	setupAuditMaxLimit     = 500
+)
+
+// setupActorKey carries the user making setup changes through the request context
+type setupActorKey struct{}
+
+// setupActor identifies the user making setup changes
+type setupActor struct {
+	ID   string
+	Name string
+}
+
+// SetupAuditService records metadata changes, with their before/after state and the
+// administrator who made them, in the setup audit trail
+type SetupAuditService struct {
+	repo *persistence.SetupAuditRepository
+}
+
+// NewSetupAuditService creates a new SetupAuditService
+func NewSetupAuditService(repo *persistence.SetupAuditRepository) *SetupAuditService {
+	return &SetupAuditService{repo: repo}
+}
+
+// WithSetupActor marks ctx as the context of changes made by a user, so that metadata
+// services can attribute them without taking the user as a parameter
+func WithSetupActor(ctx context.Context, userID, name string) context.Context {
+	if userID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, setupActorKey{}, setupActor{ID: userID, Name: name})
+}
+
+// Record writes an entry for a setup change. A nil before or after snapshot means the
+// component did not exist on that side of the change; updates that change nothing are
+// skipped. Failures are logged rather than returned so auditing never blocks a change.
+func (s *SetupAuditService) Record(ctx context.Context, action, componentType, componentName, objectAPIName string, before, after interface{}) {
+	if s == nil {
+		return
+	}
+	beforeData := setupSnapshot(before)
+	afterData := setupSnapshot(after)
+	if beforeData != nil && afterData != nil && bytes.Equal(beforeData, afterData) {
+		return
+	}
+
+	entry := &models.SystemSetupAudit{
+		ID:            GenerateID(),
+		Action:        action,
+		ComponentType: componentType,
+		ComponentName: componentName,
+		ObjectAPIName: optionalString(objectAPIName),
+		BeforeData:    beforeData,
+		AfterData:     afterData,
+		ChangedAt:     time.Now().UTC(),
+	}
+	if actor, ok := ctx.Value(setupActorKey{}).(setupActor); ok {
+		entry.ActorID = optionalString(actor.ID)
+		entry.ActorName = optionalString(actor.Name)
+	}
+	// The change is already committed, so record it even if the request was cancelled
+	if err := s.repo.Insert(context.WithoutCancel(ctx), entry); err != nil {
+		log.Printf("⚠️ Failed to record setup change %s %s %s: %v", action, componentType, componentName, err)
+	}
+}
+
+// Query returns setup audit entries matching a filter, newest first
+func (s *SetupAuditService) Query(ctx context.Context, filter persistence.SetupAuditFilter) ([]*models.SystemSetupAudit, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = setupAuditDefaultLimit
+	}
+	if filter.Limit > setupAuditMaxLimit {
+		filter.Limit = setupAuditMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.repo.Find(ctx, filter)
+}
+
+// setupSnapshot serializes the state of a component; nil when the component is absent
+func setupSnapshot(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	if raw, ok := v.(json.RawMessage); ok {
+		return raw
+	}
+	data, err := json.Marshal(v)
+	if err != nil || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupSnapshot(t *testing.T) {
+	var missing *models.Flow
+	assert.Nil(t, setupSnapshot(nil))
+	assert.Nil(t, setupSnapshot(missing))
+	assert.JSONEq(t, `{"layout_id":"l1"}`, string(setupSnapshot(map[string]string{"layout_id": "l1"})))
+	assert.Equal(t, json.RawMessage(`{"a":1}`), setupSnapshot(json.RawMessage(`{"a":1}`)))
+}
+
+func TestSetupAuditRecordSkipsNoOpUpdates(t *testing.T) {
+	// Without a repository, reaching the insert would panic
+	s := &SetupAuditService{}
+	rule := &models.ValidationRule{ID: "r1", Name: "Amount_Positive", Condition: "amount < 0"}
+	assert.NotPanics(t, func() {
+		s.Record(context.Background(), constants.SetupActionUpdate, constants.SetupComponentValidationRule, rule.ID, "", setupSnapshot(rule), rule)
+	})
+
+	var disabled *SetupAuditService
+	assert.NotPanics(t, func() {
+		disabled.Record(context.Background(), constants.SetupActionDelete, constants.SetupComponentFlow, "f1", "", rule, nil)
+	})
+}
@@ -0,0 +1,193 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSampleData struct {
+	admins []string
+}
+
+func (r *recordingSampleData) LoadSampleData(ctx context.Context, admin *models.UserSession) error {
+	r.admins = append(r.admins, admin.ID)
+	return nil
+}
+
+func TestSetup_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+
+	newSetup := func(h *testharness.Harness, config services.SetupConfig) *services.SetupService {
+		return services.NewSetupService(persistence.NewOrganizationRepository(h.DB.DB()), h.Services.UserRepo,
+			h.Services.Persistence, h.Services.Auth, h.Services.Translations, config)
+	}
+	validRequest := func() models.SetupRequest {
+		return models.SetupRequest{
+			OrgName:    "Acme Corp",
+			Admin:      models.SetupAdmin{Name: "Grace Hopper", Email: "grace@acme.test", Password: "Setup-Passw0rd!"},
+			Locale:     "fr_fr",
+			SampleData: true,
+			SetupToken: "s3cret",
+		}
+	}
+
+	t.Run("creates the first administrator once", func(t *testing.T) {
+		h := testharness.New(t)
+		ctx := h.Context(t)
+		setup := newSetup(h, services.SetupConfig{Token: "s3cret"})
+		loader := &recordingSampleData{}
+		setup.SetSampleDataLoader(loader)
+		require.NoError(t, setup.Initialize(ctx))
+
+		state, err := setup.State(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, constants.SetupStatusPending, state.Status)
+		assert.True(t, state.Required)
+		assert.True(t, state.TokenRequired)
+
+		wrongToken := validRequest()
+		wrongToken.SetupToken = "guess"
+		_, err = setup.Complete(ctx, wrongToken)
+		var forbidden *errors.ForbiddenError
+		assert.ErrorAs(t, err, &forbidden)
+
+		noOrg := validRequest()
+		noOrg.OrgName = "  "
+		_, err = setup.Complete(ctx, noOrg)
+		assert.True(t, errors.IsValidation(err))
+
+		// A rejected password leaves setup open for another try
+		weak := validRequest()
+		weak.Admin.Password = "short"
+		_, err = setup.Complete(ctx, weak)
+		require.Error(t, err)
+		state, err = setup.State(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, constants.SetupStatusPending, state.Status)
+
+		result, err := setup.Complete(ctx, validRequest())
+		require.NoError(t, err)
+		assert.Equal(t, constants.ProfileSystemAdmin, result.Admin.ProfileID)
+		assert.Equal(t, "fr-FR", result.Admin.Locale)
+		assert.True(t, result.SampleDataLoaded)
+		assert.Equal(t, []string{result.Admin.ID}, loader.admins)
+		require.NotNil(t, result.Organization.Name)
+		assert.Equal(t, "Acme Corp", *result.Organization.Name)
+
+		state, err = setup.State(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &models.SetupState{
+			Status:        constants.SetupStatusCompleted,
+			TokenRequired: true,
+			OrgName:       "Acme Corp",
+			DefaultLocale: "fr-FR",
+		}, state)
+
+		login, err := h.Services.Auth.Login(ctx, "grace@acme.test", "Setup-Passw0rd!", "127.0.0.1", "test")
+		require.NoError(t, err)
+		assert.Equal(t, result.Admin.ID, login.User.ID)
+
+		again := validRequest()
+		again.Admin.Email = "mallory@acme.test"
+		_, err = setup.Complete(ctx, again)
+		assert.ErrorAs(t, err, &forbidden)
+	})
+
+	t.Run("only one concurrent request wins", func(t *testing.T) {
+		h := testharness.New(t)
+		ctx := h.Context(t)
+		setup := newSetup(h, services.SetupConfig{})
+		require.NoError(t, setup.Initialize(ctx))
+
+		var wg sync.WaitGroup
+		errs := make([]error, 4)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				req := validRequest()
+				req.Admin.Email = testharness.UniqueName("admin") + "@acme.test"
+				_, errs[i] = setup.Complete(ctx, req)
+			}(i)
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			}
+		}
+		assert.Equal(t, 1, succeeded)
+	})
+
+	t.Run("users close a claimed setup and concurrent starts create one organization", func(t *testing.T) {
+		h := testharness.New(t)
+		ctx := h.Context(t)
+		repo := persistence.NewOrganizationRepository(h.DB.DB())
+
+		var wg sync.WaitGroup
+		errs := make([]error, 4)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repo.Ensure(ctx, constants.SetupStatusPending)
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		// A claim left behind by a setup request that died, then a user added another way
+		claimed, err := repo.ClaimSetup(ctx, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		require.True(t, claimed)
+		h.CreateUser(t)
+
+		state, err := newSetup(h, services.SetupConfig{}).State(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, constants.SetupStatusCompleted, state.Status)
+	})
+
+	t.Run("existing users close setup and unattended setup runs at startup", func(t *testing.T) {
+		h := testharness.New(t)
+		ctx := h.Context(t)
+		h.CreateUser(t)
+		setup := newSetup(h, services.SetupConfig{Unattended: &models.SetupRequest{
+			OrgName: "Ignored",
+			Admin:   models.SetupAdmin{Name: "CI Admin", Email: "ci@acme.test", Password: "Setup-Passw0rd!"},
+		}})
+		require.NoError(t, setup.Initialize(ctx))
+		state, err := setup.State(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, constants.SetupStatusCompleted, state.Status)
+		exists, err := h.Services.UserRepo.CheckUserExistsByEmail(ctx, "ci@acme.test")
+		require.NoError(t, err)
+		assert.False(t, exists, "setup was already closed by the existing user")
+
+		fresh := testharness.New(t)
+		setup = newSetup(fresh, services.SetupConfig{Unattended: &models.SetupRequest{
+			OrgName: "CI",
+			Admin:   models.SetupAdmin{Name: "CI Admin", Email: "ci@acme.test", Password: "Setup-Passw0rd!"},
+		}})
+		require.NoError(t, setup.Initialize(ctx))
+		exists, err = fresh.Services.UserRepo.CheckUserExistsByEmail(ctx, "ci@acme.test")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
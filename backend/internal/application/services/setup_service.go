@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// setupClaimTTL is how long a setup request may hold the claim before another may take it
+	setupClaimTTL = 5 * time.Minute
+	// defaultSetupAdminName names the administrator of an unattended setup
+	defaultSetupAdminName = "System Administrator"
+	// orgNameLimit bounds the organization name, in characters
+	orgNameLimit = 255
+)
+
+// SampleDataLoader fills a new organization with demonstration records
+type SampleDataLoader interface {
+	LoadSampleData(ctx context.Context, admin *models.UserSession) error
+}
+
+// SetupConfig controls who may run first-run setup
+type SetupConfig struct {
+	// Token, when set, must accompany the setup form so only whoever deployed the server can
+	// claim a fresh installation
+	Token string
+	// Unattended completes setup at startup (dev and CI); nil waits for the setup wizard
+	Unattended *models.SetupRequest
+}
+
+// SetupConfigFrom returns the setup token and, when the settings name the admin email and
+// password, the unattended setup
+func SetupConfigFrom(settings config.Setup) SetupConfig {
+	cfg := SetupConfig{Token: strings.TrimSpace(settings.Token)}
+
+	email := strings.TrimSpace(settings.AdminEmail)
+	if email == "" || settings.AdminPassword == "" {
+		return cfg
+	}
+	req := &models.SetupRequest{
+		OrgName: strings.TrimSpace(settings.OrgName),
+		Admin: models.SetupAdmin{
+			Name:     strings.TrimSpace(settings.AdminName),
+			Email:    email,
+			Password: settings.AdminPassword,
+		},
+		Locale:     strings.TrimSpace(settings.Locale),
+		SampleData: settings.SampleData,
+	}
+	if req.OrgName == "" {
+		req.OrgName = "NexusCRM"
+	}
+	if req.Admin.Name == "" {
+		req.Admin.Name = defaultSetupAdminName
+	}
+	cfg.Unattended = req
+	return cfg
+}
+
+// SetupService runs first-run setup. While no user exists, setup is pending and one request
+// may name the organization, choose its default locale and create the first system
+// administrator; after that setup is closed for good. The setup state lives on the single
+// _System_Organization row: pending -> in_progress (claimed by one request) -> completed.
+type SetupService struct {
+	repo         *persistence.OrganizationRepository
+	users        *persistence.UserRepository
+	persistence  *PersistenceService
+	auth         *AuthService
+	translations *TranslationService
+	config       SetupConfig
+	sampleData   SampleDataLoader
+}
+
+// NewSetupService creates a new SetupService
+func NewSetupService(repo *persistence.OrganizationRepository, users *persistence.UserRepository, persistenceSvc *PersistenceService, auth *AuthService, translations *TranslationService, config SetupConfig) *SetupService {
+	return &SetupService{
+		repo:         repo,
+		users:        users,
+		persistence:  persistenceSvc,
+		auth:         auth,
+		translations: translations,
+		config:       config,
+	}
+}
+
+// SetSampleDataLoader sets what loads demonstration records when setup asks for them
+func (s *SetupService) SetSampleDataLoader(loader SampleDataLoader) {
+	s.sampleData = loader
+}
+
+// Initialize runs at startup: it creates the organization row, closes setup for databases
+// that already have users, applies the org default locale and completes an unattended setup
+func (s *SetupService) Initialize(ctx context.Context) error {
+	if err := s.repo.Ensure(ctx, constants.SetupStatusPending); err != nil {
+		return err
+	}
+	org, err := s.reconcile(ctx)
+	if err != nil {
+		return err
+	}
+
+	if org.SetupStatus == constants.SetupStatusCompleted {
+		if org.DefaultLocale != nil {
+			s.translations.SetDefaultLocale(*org.DefaultLocale)
+		}
+		return nil
+	}
+	if s.config.Unattended == nil {
+		log.Println("   🧭 First-run setup is pending: open the app or POST /api/setup to create the administrator")
+		return nil
+	}
+
+	req := *s.config.Unattended
+	req.SetupToken = s.config.Token
+	if _, err := s.Complete(ctx, req); err != nil {
+		return fmt.Errorf("unattended setup failed: %w", err)
+	}
+	log.Printf("   ✅ Unattended setup created administrator %s", req.Admin.Email)
+	return nil
+}
+
+// State reports whether setup is still open
+func (s *SetupService) State(ctx context.Context) (*models.SetupState, error) {
+	org, err := s.reconcile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state := &models.SetupState{
+		Status:        org.SetupStatus,
+		Required:      org.SetupStatus != constants.SetupStatusCompleted,
+		TokenRequired: s.config.Token != "",
+	}
+	if org.Name != nil {
+		state.OrgName = *org.Name
+	}
+	if org.DefaultLocale != nil {
+		state.DefaultLocale = *org.DefaultLocale
+	}
+	return state, nil
+}
+
+// Complete runs setup: it creates the first system administrator and stores the organization
+// settings. Only one request can succeed; the rest are refused once setup has begun.
+func (s *SetupService) Complete(ctx context.Context, req models.SetupRequest) (*models.SetupResult, error) {
+	if s.config.Token != "" && subtle.ConstantTimeCompare([]byte(req.SetupToken), []byte(s.config.Token)) != 1 {
+		return nil, errors.NewForbiddenError("invalid setup token")
+	}
+	orgName := strings.TrimSpace(req.OrgName)
+	if orgName == "" {
+		return nil, errors.NewValidationError("org_name", "Organization name is required")
+	}
+	if len([]rune(orgName)) > orgNameLimit {
+		return nil, errors.NewValidationError("org_name", fmt.Sprintf("must be at most %d characters", orgNameLimit))
+	}
+	if strings.TrimSpace(req.Admin.Name) == "" {
+		return nil, errors.NewValidationError(constants.FieldName, "Administrator name is required")
+	}
+	locale, err := normalizeUserLocale(strings.TrimSpace(req.Locale))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Ensure(ctx, constants.SetupStatusPending); err != nil {
+		return nil, err
+	}
+	org, err := s.reconcile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if org.SetupStatus == constants.SetupStatusCompleted {
+		return nil, errors.NewForbiddenError("setup has already been completed")
+	}
+	claimed, err := s.repo.ClaimSetup(ctx, time.Now().Add(-setupClaimTTL))
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errors.NewForbiddenError("setup is already in progress")
+	}
+
+	// The administrator and the closed setup are committed together, so a failure cannot leave
+	// a user behind with setup still open, nor setup closed without its administrator
+	var admin *models.UserSession
+	err = s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		created, err := s.auth.CreateUser(txCtx, CreateUserRequest{
+			Name:      strings.TrimSpace(req.Admin.Name),
+			Email:     strings.TrimSpace(req.Admin.Email),
+			Password:  req.Admin.Password,
+			ProfileID: constants.ProfileSystemAdmin,
+			Locale:    locale,
+		})
+		if err != nil {
+			return err
+		}
+		created.IsSystemAdmin = true
+
+		org = &models.SystemOrganization{
+			Name:               &orgName,
+			DefaultLocale:      optionalString(locale),
+			SampleData:         req.SampleData,
+			SetupCompletedByID: &created.ID,
+		}
+		if err := s.repo.CompleteSetup(txCtx, tx, org); err != nil {
+			return err
+		}
+		admin = created
+		return nil
+	})
+	if err != nil {
+		// Reopen setup so the form can be corrected and sent again
+		if releaseErr := s.repo.ReleaseSetup(context.WithoutCancel(ctx)); releaseErr != nil {
+			log.Printf("⚠️  Failed to reopen setup: %v", releaseErr)
+		}
+		return nil, err
+	}
+	s.translations.SetDefaultLocale(locale)
+
+	result := &models.SetupResult{Organization: org, Admin: admin}
+	if req.SampleData {
+		if s.sampleData == nil {
+			log.Println("⚠️  Setup asked for sample data, but no sample data loader is configured")
+		} else if err := s.sampleData.LoadSampleData(ctx, admin); err != nil {
+			// Setup itself succeeded; the administrator can load sample data later
+			log.Printf("⚠️  Failed to load sample data: %v", err)
+		} else {
+			result.SampleDataLoaded = true
+		}
+	}
+	return result, nil
+}
+
+// reconcile returns the organization, closing setup when users already exist (databases
+// created before setup existed, or users added through SCIM or the data APIs), whether setup
+// is pending or claimed: a setup request commits its administrator together with closing
+// setup, so users seen while setup is open never come from it
+func (s *SetupService) reconcile(ctx context.Context) (*models.SystemOrganization, error) {
+	org, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		org = &models.SystemOrganization{SetupStatus: constants.SetupStatusPending}
+	}
+	if org.SetupStatus == constants.SetupStatusCompleted {
+		return org, nil
+	}
+	hasUsers, err := s.users.HasUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for users: %w", err)
+	}
+	if hasUsers {
+		if err := s.repo.MarkSetupCompleted(ctx); err != nil {
+			return nil, err
+		}
+		org.SetupStatus = constants.SetupStatusCompleted
+	}
+	return org, nil
+}
@@ -15,7 +15,7 @@ import (
 // TestSharingRules tests that sharing rules grant access to matching records
 func TestSharingRules_Integration(t *testing.T) {
 	// Setup Dependencies
-	dbConn, err := database.GetInstance()
+	dbConn, err := database.GetTestInstance()
 	if err != nil {
 		t.Fatalf("Failed to get DB instance: %v", err)
 	}
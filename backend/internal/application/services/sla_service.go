@@ -0,0 +1,720 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// slaDueTimerBatchSize caps the violated timers escalated per scheduler tick
+const slaDueTimerBatchSize = 100
+
+// SLAService measures how long records spend against SLA milestones in business time.
+// Record events start, pause and complete each record's milestone timers; the scheduler
+// calls CheckDueTimers to flag violated milestones and run their escalation flows.
+type SLAService struct {
+	repo        *persistence.SLARepository
+	records     *persistence.QueryRepository
+	metadata    *MetadataService
+	permissions *PermissionService
+	query       *QueryService
+	flows       *FlowExecutor
+}
+
+// NewSLAService creates a new SLAService
+func NewSLAService(
+	repo *persistence.SLARepository,
+	records *persistence.QueryRepository,
+	metadata *MetadataService,
+	permissions *PermissionService,
+	query *QueryService,
+	flows *FlowExecutor,
+) *SLAService {
+	return &SLAService{
+		repo:        repo,
+		records:     records,
+		metadata:    metadata,
+		permissions: permissions,
+		query:       query,
+		flows:       flows,
+	}
+}
+
+// ==================== Business Hours ====================
+
+// GetBusinessHours returns all business hours with their holidays
+func (s *SLAService) GetBusinessHours(ctx context.Context) ([]*models.BusinessHours, error) {
+	return s.repo.GetAllBusinessHours(ctx)
+}
+
+// GetBusinessHoursByName returns business hours with their holidays
+func (s *SLAService) GetBusinessHoursByName(ctx context.Context, name string) (*models.BusinessHours, error) {
+	bh, err := s.repo.FindBusinessHours(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if bh == nil {
+		return nil, errors.NewNotFoundError("BusinessHours", name)
+	}
+	return bh, nil
+}
+
+// CreateBusinessHours validates and stores business hours with their holidays
+func (s *SLAService) CreateBusinessHours(ctx context.Context, bh *models.BusinessHours) error {
+	bh.Name = strings.TrimSpace(bh.Name)
+	if !developerNamePattern.MatchString(bh.Name) {
+		return errors.NewValidationError(constants.FieldSysBusinessHours_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(bh.Label) == "" {
+		bh.Label = bh.Name
+	}
+	if bh.Timezone == "" {
+		bh.Timezone = constants.ScheduleDefaultTimezone
+	}
+	if err := validateBusinessHours(bh); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindBusinessHours(ctx, bh.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("BusinessHours", constants.FieldSysBusinessHours_Name, bh.Name)
+	}
+
+	bh.ID = GenerateID()
+	if err := s.repo.InsertBusinessHours(ctx, bh); err != nil {
+		return err
+	}
+	for i := range bh.Holidays {
+		h := &bh.Holidays[i]
+		h.ID = GenerateID()
+		h.BusinessHoursID = bh.ID
+		if err := s.repo.InsertHoliday(ctx, h); err != nil {
+			return err
+		}
+	}
+	if bh.IsDefault {
+		return s.repo.ClearDefaultBusinessHours(ctx, bh.ID)
+	}
+	return nil
+}
+
+// BusinessHoursUpdate holds the changeable settings of business hours; nil leaves a setting
+// unchanged. The name cannot change because SLA policies reference it.
+type BusinessHoursUpdate struct {
+	Label     *string                               `json:"label"`
+	Timezone  *string                               `json:"timezone"`
+	IsDefault *bool                                 `json:"is_default"`
+	Schedule  map[string]models.BusinessHoursWindow `json:"schedule"`
+}
+
+// UpdateBusinessHours applies updates to business hours. Running timers keep their due
+// dates until their record next changes.
+func (s *SLAService) UpdateBusinessHours(ctx context.Context, name string, updates BusinessHoursUpdate) (*models.BusinessHours, error) {
+	bh, err := s.GetBusinessHoursByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if updates.Label != nil {
+		bh.Label = *updates.Label
+	}
+	if updates.Timezone != nil {
+		bh.Timezone = *updates.Timezone
+	}
+	if updates.IsDefault != nil {
+		bh.IsDefault = *updates.IsDefault
+	}
+	if updates.Schedule != nil {
+		bh.Schedule = updates.Schedule
+	}
+	if err := validateBusinessHours(bh); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateBusinessHours(ctx, bh); err != nil {
+		return nil, err
+	}
+	if bh.IsDefault {
+		if err := s.repo.ClearDefaultBusinessHours(ctx, bh.ID); err != nil {
+			return nil, err
+		}
+	}
+	return bh, nil
+}
+
+// DeleteBusinessHours deletes business hours that no SLA policy uses
+func (s *SLAService) DeleteBusinessHours(ctx context.Context, name string) error {
+	bh, err := s.GetBusinessHoursByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	policies, err := s.repo.GetPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if strings.EqualFold(p.BusinessHours, bh.Name) {
+			return errors.NewValidationError(constants.FieldSysBusinessHours_Name, fmt.Sprintf("business hours are used by SLA policy %s", p.Name))
+		}
+	}
+	return s.repo.DeleteBusinessHours(ctx, bh.ID)
+}
+
+// AddHoliday adds a non-working day to business hours
+func (s *SLAService) AddHoliday(ctx context.Context, name string, h *models.Holiday) error {
+	bh, err := s.GetBusinessHoursByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.NewValidationError(constants.FieldSysHoliday_Name, "Holiday name is required")
+	}
+	if _, err := time.Parse("2006-01-02", h.Date); err != nil {
+		return errors.NewValidationError(constants.FieldSysHoliday_HolidayDate, "Date must be YYYY-MM-DD")
+	}
+	h.ID = GenerateID()
+	h.BusinessHoursID = bh.ID
+	return s.repo.InsertHoliday(ctx, h)
+}
+
+// DeleteHoliday removes a holiday from business hours
+func (s *SLAService) DeleteHoliday(ctx context.Context, name, holidayID string) error {
+	bh, err := s.GetBusinessHoursByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.repo.DeleteHoliday(ctx, bh.ID, holidayID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.NewNotFoundError("Holiday", holidayID)
+	}
+	return nil
+}
+
+// validateBusinessHours checks that business hours parse into a calendar with working time
+func validateBusinessHours(bh *models.BusinessHours) error {
+	if len(bh.Schedule) == 0 {
+		return errors.NewValidationError(constants.FieldSysBusinessHours_Schedule, "At least one working day is required")
+	}
+	if _, err := newBusinessCalendar(bh); err != nil {
+		return errors.NewValidationError(constants.FieldSysBusinessHours_Schedule, err.Error())
+	}
+	return nil
+}
+
+// ==================== SLA Policies ====================
+
+// GetPolicies returns all SLA policies
+func (s *SLAService) GetPolicies(ctx context.Context) ([]*models.SLAPolicy, error) {
+	return s.repo.GetPolicies(ctx)
+}
+
+// GetPolicy returns an SLA policy by name
+func (s *SLAService) GetPolicy(ctx context.Context, name string) (*models.SLAPolicy, error) {
+	p, err := s.repo.FindPolicy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, errors.NewNotFoundError("SLAPolicy", name)
+	}
+	return p, nil
+}
+
+// CreatePolicy validates and stores an SLA policy. Timers start as the object's records next change.
+func (s *SLAService) CreatePolicy(ctx context.Context, p *models.SLAPolicy) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if !developerNamePattern.MatchString(p.Name) {
+		return errors.NewValidationError(constants.FieldSysSLAPolicy_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	if strings.TrimSpace(p.Label) == "" {
+		p.Label = p.Name
+	}
+	if err := s.validatePolicy(ctx, p); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindPolicy(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("SLAPolicy", constants.FieldSysSLAPolicy_Name, p.Name)
+	}
+
+	p.ID = GenerateID()
+	return s.repo.InsertPolicy(ctx, p)
+}
+
+// UpdatePolicy replaces the settings of an SLA policy; its name cannot change because timers reference it
+func (s *SLAService) UpdatePolicy(ctx context.Context, name string, p *models.SLAPolicy) (*models.SLAPolicy, error) {
+	existing, err := s.GetPolicy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	p.ID = existing.ID
+	p.Name = existing.Name
+	p.CreatedDate = existing.CreatedDate
+	if strings.TrimSpace(p.Label) == "" {
+		p.Label = existing.Label
+	}
+	if err := s.validatePolicy(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdatePolicy(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeletePolicy deletes an SLA policy and its timers
+func (s *SLAService) DeletePolicy(ctx context.Context, name string) error {
+	p, err := s.GetPolicy(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeletePolicy(ctx, p)
+}
+
+// validatePolicy checks an SLA policy against the object's schema, business hours and flows
+func (s *SLAService) validatePolicy(ctx context.Context, p *models.SLAPolicy) error {
+	schema := s.metadata.GetSchema(ctx, p.ObjectAPIName)
+	if schema == nil {
+		return errors.NewNotFoundError("Object", p.ObjectAPIName)
+	}
+	p.ObjectAPIName = schema.APIName
+
+	if FindField(schema, p.StatusField) == nil {
+		return errors.NewValidationError(constants.FieldSysSLAPolicy_StatusField, fmt.Sprintf("field %q not found on %s", p.StatusField, schema.APIName))
+	}
+	if p.PriorityField != "" && FindField(schema, p.PriorityField) == nil {
+		return errors.NewValidationError(constants.FieldSysSLAPolicy_PriorityField, fmt.Sprintf("field %q not found on %s", p.PriorityField, schema.APIName))
+	}
+	if p.BusinessHours != "" {
+		bh, err := s.repo.FindBusinessHours(ctx, p.BusinessHours)
+		if err != nil {
+			return err
+		}
+		if bh == nil {
+			return errors.NewNotFoundError("BusinessHours", p.BusinessHours)
+		}
+		p.BusinessHours = bh.Name
+	}
+	if p.PausedStatuses == nil {
+		p.PausedStatuses = []string{}
+	}
+	if p.ClosedStatuses == nil {
+		p.ClosedStatuses = []string{}
+	}
+
+	if len(p.Milestones) == 0 {
+		return errors.NewValidationError(constants.FieldSysSLAPolicy_Milestones, "At least one milestone is required")
+	}
+	seen := make(map[string]bool)
+	for _, m := range p.Milestones {
+		if strings.TrimSpace(m.Name) == "" {
+			return errors.NewValidationError(constants.FieldSysSLAPolicy_Milestones, "Milestone name is required")
+		}
+		key := strings.ToLower(m.Name) + "\x00" + strings.ToLower(m.Priority)
+		if seen[key] {
+			return errors.NewValidationError(constants.FieldSysSLAPolicy_Milestones, fmt.Sprintf("milestone %q is defined twice for priority %q", m.Name, m.Priority))
+		}
+		seen[key] = true
+		if m.TargetMinutes <= 0 {
+			return errors.NewValidationError(constants.FieldSysSLAPolicy_Milestones, fmt.Sprintf("milestone %q needs a positive target_minutes", m.Name))
+		}
+		if m.Priority != "" && p.PriorityField == "" {
+			return errors.NewValidationError(constants.FieldSysSLAPolicy_PriorityField, "Priority field is required for milestones with a priority")
+		}
+		if m.CompletionField != "" && FindField(schema, m.CompletionField) == nil {
+			return errors.NewValidationError(constants.FieldSysSLAPolicy_Milestones, fmt.Sprintf("completion field %q not found on %s", m.CompletionField, schema.APIName))
+		}
+		if m.EscalationFlowID != "" && s.metadata.GetFlow(ctx, m.EscalationFlowID) == nil {
+			return errors.NewNotFoundError("Flow", m.EscalationFlowID)
+		}
+	}
+	return nil
+}
+
+// calendar resolves the business calendar of a policy; nil means 24x7
+func (s *SLAService) calendar(ctx context.Context, p *models.SLAPolicy) (*businessCalendar, error) {
+	return s.calendarByName(ctx, p.BusinessHours)
+}
+
+// calendarByName resolves named business hours, or the default ones when name is empty; nil means 24x7
+func (s *SLAService) calendarByName(ctx context.Context, name string) (*businessCalendar, error) {
+	var bh *models.BusinessHours
+	var err error
+	if name != "" {
+		bh, err = s.repo.FindBusinessHours(ctx, name)
+	} else {
+		bh, err = s.repo.FindDefaultBusinessHours(ctx)
+	}
+	if err != nil || bh == nil {
+		return nil, err
+	}
+	return newBusinessCalendar(bh)
+}
+
+// ==================== Timers ====================
+
+// GetRecordTimers returns the SLA timers of a record the user can read. Elapsed time of
+// running timers includes the business time accrued up to now.
+func (s *SLAService) GetRecordTimers(ctx context.Context, objectAPIName, recordID string, currentUser *models.UserSession) ([]*models.SLATimer, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	rows, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, rows[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+
+	timers, err := s.repo.FindTimers(ctx, schema.APIName, recordID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	calendars := make(map[string]*businessCalendar)
+	for _, t := range timers {
+		if t.Status != constants.SLATimerStatusRunning || t.RunningSince == nil {
+			continue
+		}
+		cal, ok := calendars[t.PolicyName]
+		if !ok {
+			if p, err := s.repo.FindPolicy(ctx, t.PolicyName); err == nil && p != nil {
+				cal, _ = s.calendar(ctx, p)
+			}
+			calendars[t.PolicyName] = cal
+		}
+		t.ElapsedSeconds += int64(cal.Duration(*t.RunningSince, now).Seconds())
+	}
+	return timers, nil
+}
+
+// RegisterHandlers subscribes to after-commit record events to keep SLA timers current
+func (s *SLAService) RegisterHandlers(eventBus *EventBus) {
+	handler := func(eventType events.EventType) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok || constants.IsSystemTable(recordPayload.ObjectAPIName) {
+				return nil
+			}
+			recordID := recordPayload.Record.GetString(constants.FieldID)
+			if recordID == "" {
+				return nil
+			}
+
+			// SLA failures must not fail the outbox event (flows share the same dispatch)
+			var err error
+			if eventType == events.RecordDeleted {
+				err = s.repo.DeleteRecordTimers(ctx, recordPayload.ObjectAPIName, recordID)
+			} else {
+				err = s.EvaluateRecord(ctx, recordPayload.ObjectAPIName, recordID)
+			}
+			if err != nil {
+				log.Printf("⚠️ [SLA] Failed to update timers of %s/%s: %v", recordPayload.ObjectAPIName, recordID, err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(events.RecordCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(events.RecordUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(events.RecordDeleted))
+}
+
+// EvaluateRecord reloads a record and starts, pauses, resumes or completes its milestone
+// timers under every active SLA policy of its object
+func (s *SLAService) EvaluateRecord(ctx context.Context, objectAPIName, recordID string) error {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil
+	}
+	policies, err := s.repo.FindActivePolicies(ctx, schema.APIName)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+	timers, err := s.repo.FindTimers(ctx, schema.APIName, recordID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, p := range policies {
+		fields := policyFields(p)
+		rows, err := s.records.FindByIDs(ctx, schema.APIName, fields, []string{recordID})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		cal, err := s.calendar(ctx, p)
+		if err != nil {
+			return fmt.Errorf("policy %s: %w", p.Name, err)
+		}
+
+		var own []*models.SLATimer
+		for _, t := range timers {
+			if t.PolicyName == p.Name {
+				own = append(own, t)
+			}
+		}
+		save, remove := advanceTimers(p, cal, rows[0], recordID, own, now)
+		for _, t := range save {
+			if t.ID == "" {
+				t.ID = GenerateID()
+			}
+			if err := s.repo.SaveTimer(ctx, t); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.DeleteTimers(ctx, remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckDueTimers flags running timers past their due date as violated and runs their
+// milestones' escalation flows. The scheduler calls it on every tick.
+func (s *SLAService) CheckDueTimers(ctx context.Context, now time.Time) {
+	due, err := s.repo.FindDueTimers(ctx, now, slaDueTimerBatchSize)
+	if err != nil {
+		log.Printf("⚠️ [SLA] Failed to query due timers: %v", err)
+		return
+	}
+
+	policies := make(map[string]*models.SLAPolicy)
+	for _, t := range due {
+		claimed, err := s.repo.MarkViolated(ctx, t.ID, now)
+		if err != nil {
+			log.Printf("⚠️ [SLA] Failed to flag timer %s: %v", t.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		log.Printf("🚨 [SLA] %s/%s missed milestone %q", t.ObjectAPIName, t.RecordID, t.Milestone)
+
+		p, ok := policies[t.PolicyName]
+		if !ok {
+			if p, err = s.repo.FindPolicy(ctx, t.PolicyName); err != nil {
+				log.Printf("⚠️ [SLA] Failed to load policy %s: %v", t.PolicyName, err)
+			}
+			policies[t.PolicyName] = p
+		}
+		if p == nil {
+			continue
+		}
+		if err := s.escalate(ctx, p, t); err != nil {
+			log.Printf("⚠️ [SLA] Escalation of %s/%s milestone %q failed: %v", t.ObjectAPIName, t.RecordID, t.Milestone, err)
+		}
+	}
+}
+
+// escalate runs the escalation flows of a violated timer's milestone on its record
+func (s *SLAService) escalate(ctx context.Context, p *models.SLAPolicy, t *models.SLATimer) error {
+	var flowIDs []string
+	for _, m := range p.Milestones {
+		if strings.EqualFold(m.Name, t.Milestone) && m.EscalationFlowID != "" && !ContainsStringIgnoreCase(flowIDs, m.EscalationFlowID) {
+			flowIDs = append(flowIDs, m.EscalationFlowID)
+		}
+	}
+	if len(flowIDs) == 0 {
+		return nil
+	}
+
+	systemUser := &models.UserSession{
+		ID:            "system",
+		Name:          "SLA Escalation",
+		ProfileID:     constants.ProfileSystemAdmin,
+		IsSystemAdmin: true,
+	}
+	rows, err := s.query.QueryByIDs(ctx, t.ObjectAPIName, []string{t.RecordID}, systemUser)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	for _, id := range flowIDs {
+		if err := s.flows.ExecuteFlowForRecord(ctx, id, t.ObjectAPIName, rows[0], systemUser); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// policyFields lists the record fields an SLA policy reads
+func policyFields(p *models.SLAPolicy) []string {
+	fields := []string{p.StatusField}
+	if p.PriorityField != "" {
+		fields = append(fields, p.PriorityField)
+	}
+	for _, m := range p.Milestones {
+		if m.CompletionField != "" && !ContainsStringIgnoreCase(fields, m.CompletionField) {
+			fields = append(fields, m.CompletionField)
+		}
+	}
+	return fields
+}
+
+// applicableMilestones returns the milestones that apply to a priority, one per milestone
+// name. A milestone defined for the priority overrides one defined for every priority.
+func applicableMilestones(milestones []models.SLAMilestone, priority string) []models.SLAMilestone {
+	result := make([]models.SLAMilestone, 0, len(milestones))
+	index := make(map[string]int)
+	for _, m := range milestones {
+		if m.Priority != "" && !strings.EqualFold(m.Priority, priority) {
+			continue
+		}
+		key := strings.ToLower(m.Name)
+		if i, ok := index[key]; ok {
+			if m.Priority != "" {
+				result[i] = m
+			}
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, m)
+	}
+	return result
+}
+
+// advanceTimers applies a record's current status and priority to its timers under one
+// policy. It returns the timers to save and the IDs of timers whose milestone no longer
+// applies (e.g. after a priority change).
+func advanceTimers(p *models.SLAPolicy, cal *businessCalendar, record models.SObject, recordID string, timers []*models.SLATimer, now time.Time) ([]*models.SLATimer, []string) {
+	status := slaFieldValue(record, p.StatusField)
+	closed := ContainsStringIgnoreCase(p.ClosedStatuses, status)
+	paused := ContainsStringIgnoreCase(p.PausedStatuses, status)
+	priority := ""
+	if p.PriorityField != "" {
+		priority = slaFieldValue(record, p.PriorityField)
+	}
+
+	existing := make(map[string]*models.SLATimer, len(timers))
+	for _, t := range timers {
+		existing[strings.ToLower(t.Milestone)] = t
+	}
+
+	var save []*models.SLATimer
+	for _, m := range applicableMilestones(p.Milestones, priority) {
+		key := strings.ToLower(m.Name)
+		t := existing[key]
+		delete(existing, key)
+
+		changed := false
+		if t == nil {
+			if closed {
+				continue
+			}
+			t = &models.SLATimer{
+				PolicyName:    p.Name,
+				ObjectAPIName: p.ObjectAPIName,
+				RecordID:      recordID,
+				Milestone:     m.Name,
+				TargetMinutes: m.TargetMinutes,
+				Status:        constants.SLATimerStatusPaused,
+				StartedDate:   now,
+			}
+			if !paused {
+				startTimer(t, cal, now)
+			}
+			changed = true
+		}
+		if t.Status == constants.SLATimerStatusCompleted {
+			continue
+		}
+		if t.TargetMinutes != m.TargetMinutes {
+			t.TargetMinutes = m.TargetMinutes
+			if t.RunningSince != nil {
+				t.DueDate = timerDueDate(t, cal, *t.RunningSince)
+			}
+			changed = true
+		}
+
+		met := closed || (m.CompletionField != "" && strings.TrimSpace(slaFieldValue(record, m.CompletionField)) != "")
+		switch {
+		case met:
+			stopTimer(t, cal, now)
+			t.Status = constants.SLATimerStatusCompleted
+			t.CompletedDate = &now
+			if t.ElapsedSeconds > int64(t.TargetMinutes)*60 {
+				t.Violated = true
+			}
+			changed = true
+		case paused && t.Status == constants.SLATimerStatusRunning:
+			stopTimer(t, cal, now)
+			t.Status = constants.SLATimerStatusPaused
+			changed = true
+		case !paused && t.Status == constants.SLATimerStatusPaused:
+			startTimer(t, cal, now)
+			changed = true
+		}
+		if changed {
+			save = append(save, t)
+		}
+	}
+
+	var remove []string
+	for _, t := range existing {
+		if t.Status != constants.SLATimerStatusCompleted {
+			remove = append(remove, t.ID)
+		}
+	}
+	return save, remove
+}
+
+// slaFieldValue renders a record field as text; missing and null values are empty
+func slaFieldValue(record models.SObject, field string) string {
+	v := record[field]
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// startTimer resumes business time accrual from now
+func startTimer(t *models.SLATimer, cal *businessCalendar, now time.Time) {
+	t.Status = constants.SLATimerStatusRunning
+	t.RunningSince = &now
+	t.DueDate = timerDueDate(t, cal, now)
+}
+
+// stopTimer banks the business time accrued since the timer last started
+func stopTimer(t *models.SLATimer, cal *businessCalendar, now time.Time) {
+	if t.RunningSince != nil {
+		t.ElapsedSeconds += int64(cal.Duration(*t.RunningSince, now).Seconds())
+	}
+	t.RunningSince = nil
+	t.DueDate = nil
+}
+
+// timerDueDate returns when a timer running since from reaches its target, or nil when
+// the calendar has no working time left to reach it
+func timerDueDate(t *models.SLATimer, cal *businessCalendar, from time.Time) *time.Time {
+	remaining := time.Duration(t.TargetMinutes)*time.Minute - time.Duration(t.ElapsedSeconds)*time.Second
+	if remaining < 0 {
+		remaining = 0
+	}
+	due := cal.Add(from, remaining)
+	if due.IsZero() {
+		return nil
+	}
+	return &due
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func weekdayCalendar(t *testing.T, holidays ...models.Holiday) *businessCalendar {
+	t.Helper()
+	window := models.BusinessHoursWindow{Start: "09:00", End: "17:00"}
+	cal, err := newBusinessCalendar(&models.BusinessHours{
+		Timezone: "UTC",
+		Schedule: map[string]models.BusinessHoursWindow{
+			"monday": window, "tuesday": window, "wednesday": window, "thursday": window, "friday": window,
+		},
+		Holidays: holidays,
+	})
+	require.NoError(t, err)
+	return cal
+}
+
+func TestBusinessCalendar_Duration(t *testing.T) {
+	cal := weekdayCalendar(t, models.Holiday{Name: "New Year", Date: "2026-01-01"})
+
+	// Wednesday 16:00 -> Friday 10:00 skips Thursday's holiday
+	from := time.Date(2025, 12, 31, 16, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, 2*time.Hour, cal.Duration(from, to))
+	assert.Zero(t, cal.Duration(to, from))
+
+	var allDay *businessCalendar
+	assert.Equal(t, to.Sub(from), allDay.Duration(from, to))
+}
+
+func TestBusinessCalendar_Add(t *testing.T) {
+	cal := weekdayCalendar(t, models.Holiday{Name: "Christmas", Date: "2020-12-25", IsRecurring: true})
+
+	// Friday 16:30 + 1h rolls over the weekend
+	assert.Equal(t, time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC), cal.Add(time.Date(2026, 1, 2, 16, 30, 0, 0, time.UTC), time.Hour))
+	// Starting on a Saturday waits for Monday's opening
+	assert.Equal(t, time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC), cal.Add(time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC), 30*time.Minute))
+	// Recurring holiday on Friday 2026-12-25
+	assert.Equal(t, time.Date(2026, 12, 28, 10, 0, 0, 0, time.UTC), cal.Add(time.Date(2026, 12, 24, 17, 0, 0, 0, time.UTC), time.Hour))
+}
+
+func TestNewBusinessCalendar_Invalid(t *testing.T) {
+	_, err := newBusinessCalendar(&models.BusinessHours{Schedule: map[string]models.BusinessHoursWindow{"funday": {Start: "09:00", End: "17:00"}}})
+	assert.Error(t, err)
+	_, err = newBusinessCalendar(&models.BusinessHours{Schedule: map[string]models.BusinessHoursWindow{"monday": {Start: "17:00", End: "09:00"}}})
+	assert.Error(t, err)
+	_, err = newBusinessCalendar(&models.BusinessHours{Timezone: "Mars/Olympus", Schedule: map[string]models.BusinessHoursWindow{"monday": {Start: "09:00", End: "24:00"}}})
+	assert.Error(t, err)
+}
+
+func TestApplicableMilestones(t *testing.T) {
+	milestones := []models.SLAMilestone{
+		{Name: "Resolution", TargetMinutes: 480},
+		{Name: "Resolution", Priority: "High", TargetMinutes: 240},
+		{Name: "First Response", TargetMinutes: 60},
+	}
+	got := applicableMilestones(milestones, "high")
+	require.Len(t, got, 2)
+	assert.Equal(t, 240, got[0].TargetMinutes)
+	assert.Equal(t, 480, applicableMilestones(milestones, "Low")[0].TargetMinutes)
+}
+
+func TestAdvanceTimers(t *testing.T) {
+	policy := &models.SLAPolicy{
+		Name:           "Support",
+		ObjectAPIName:  "support_case",
+		StatusField:    "status",
+		PriorityField:  "priority",
+		PausedStatuses: []string{"Waiting on Customer"},
+		ClosedStatuses: []string{"Closed"},
+		Milestones: []models.SLAMilestone{
+			{Name: "First Response", TargetMinutes: 60, CompletionField: "first_response_date"},
+			{Name: "Resolution", Priority: "High", TargetMinutes: 240},
+		},
+	}
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	byMilestone := func(timers []*models.SLATimer) map[string]*models.SLATimer {
+		m := make(map[string]*models.SLATimer)
+		for _, tm := range timers {
+			m[tm.Milestone] = tm
+		}
+		return m
+	}
+
+	// A new High case starts both milestones
+	save, remove := advanceTimers(policy, nil, models.SObject{"status": "New", "priority": "High"}, "c1", nil, start)
+	assert.Empty(t, remove)
+	timers := byMilestone(save)
+	require.Len(t, timers, 2)
+	assert.Equal(t, constants.SLATimerStatusRunning, timers["First Response"].Status)
+	assert.Equal(t, start.Add(time.Hour), *timers["First Response"].DueDate)
+	timers["Resolution"].ID = "r1"
+
+	// Waiting on the customer pauses the clock
+	save, _ = advanceTimers(policy, nil, models.SObject{"status": "Waiting on Customer", "priority": "High"}, "c1", save, start.Add(30*time.Minute))
+	require.Len(t, save, 2)
+	fr := byMilestone(save)["First Response"]
+	assert.Equal(t, constants.SLATimerStatusPaused, fr.Status)
+	assert.Equal(t, int64(1800), fr.ElapsedSeconds)
+	assert.Nil(t, fr.DueDate)
+
+	// Resuming later only has the remaining 30 minutes
+	resume := start.Add(2 * time.Hour)
+	save, _ = advanceTimers(policy, nil, models.SObject{"status": "Working", "priority": "High"}, "c1", save, resume)
+	fr = byMilestone(save)["First Response"]
+	assert.Equal(t, constants.SLATimerStatusRunning, fr.Status)
+	assert.Equal(t, resume.Add(30*time.Minute), *fr.DueDate)
+
+	// Responding completes the first milestone within its target
+	all := save
+	save, _ = advanceTimers(policy, nil, models.SObject{"status": "Working", "priority": "High", "first_response_date": "2026-01-05"}, "c1", all, resume.Add(10*time.Minute))
+	require.Len(t, save, 1)
+	assert.Equal(t, constants.SLATimerStatusCompleted, save[0].Status)
+	assert.False(t, save[0].Violated)
+	assert.Equal(t, int64(2400), save[0].ElapsedSeconds)
+
+	// Lowering the priority drops the milestone that only applies to High
+	save, remove = advanceTimers(policy, nil, models.SObject{"status": "Working", "priority": "Low", "first_response_date": "2026-01-05"}, "c1", all, resume.Add(20*time.Minute))
+	assert.Empty(t, save)
+	assert.Equal(t, []string{"r1"}, remove)
+
+	// Closing completes the open milestone, late
+	save, _ = advanceTimers(policy, nil, models.SObject{"status": "Closed", "priority": "High"}, "c1", all, resume.Add(5*time.Hour))
+	res := byMilestone(save)["Resolution"]
+	require.NotNil(t, res)
+	assert.Equal(t, constants.SLATimerStatusCompleted, res.Status)
+	assert.True(t, res.Violated)
+
+	// Records created closed get no timers
+	save, _ = advanceTimers(policy, nil, models.SObject{"status": "Closed", "priority": "High"}, "c2", nil, start)
+	assert.Empty(t, save)
+}
@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageHistory_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	deal := h.CreateObject(t, "deal")
+	require.NoError(t, h.Services.Metadata.CreateField(ctx, deal.APIName, &models.FieldMetadata{
+		APIName: "stage", Label: "Stage", Type: constants.FieldTypePicklist,
+		Options: []string{"New", "Proposal", "Won"}, TrackHistory: true,
+	}))
+	require.Error(t, h.Services.Metadata.CreateField(ctx, deal.APIName, &models.FieldMetadata{
+		APIName: "notes", Label: "Notes", Type: constants.FieldTypeText, TrackHistory: true,
+	}), "only picklists track history")
+	field := services.FindField(h.Services.Metadata.GetSchema(ctx, deal.APIName), "stage")
+	require.NotNil(t, field)
+	assert.True(t, field.TrackHistory, "track_history is persisted")
+
+	svc := h.Services.StageHistory
+	record := h.CreateRecord(t, deal.APIName, models.SObject{"name": "Big deal", "stage": "New"})
+	recordID := record.GetString(constants.FieldID)
+	require.NoError(t, svc.HandleRecordEvent(ctx, events.RecordCreated, services.RecordEventPayload{
+		ObjectAPIName: deal.APIName, Record: record, CurrentUser: h.Admin,
+	}))
+	updated := models.SObject{constants.FieldID: recordID, "stage": "Proposal"}
+	require.NoError(t, svc.HandleRecordEvent(ctx, events.RecordUpdated, services.RecordEventPayload{
+		ObjectAPIName: deal.APIName, Record: updated, OldRecord: &record, CurrentUser: h.Admin,
+	}))
+
+	history, err := svc.GetRecordHistory(ctx, deal.APIName, recordID, h.Admin)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "New", history[0].Value)
+	assert.NotNil(t, history[0].ExitedDate)
+	assert.NotNil(t, history[0].DurationSeconds)
+	assert.Equal(t, "Proposal", history[1].Value)
+	require.NotNil(t, history[1].PreviousValue)
+	assert.Equal(t, "New", *history[1].PreviousValue)
+	assert.Nil(t, history[1].ExitedDate)
+
+	from := time.Now().Add(-time.Hour)
+	analytics, err := svc.GetAnalytics(ctx, deal.APIName, "stage", &from, nil, h.Admin)
+	require.NoError(t, err)
+	require.Len(t, analytics.Stages, 3)
+	assert.Equal(t, 1, analytics.Stages[0].Reached)
+	assert.Equal(t, 1, analytics.Stages[0].Exited)
+	assert.Equal(t, 1, analytics.Stages[1].Current)
+	assert.Equal(t, []models.StageTransition{{From: "New", To: "Proposal", Count: 1}}, analytics.Transitions)
+
+	_, err = svc.GetAnalytics(ctx, deal.APIName, "name", nil, nil, h.Admin)
+	assert.Error(t, err, "untracked fields have no analytics")
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// StageHistoryService records how long records stay in each value of picklist fields with
+// track_history set (stage, status), and reports funnel and velocity figures from it
+type StageHistoryService struct {
+	repo        *persistence.StageHistoryRepository
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+}
+
+// NewStageHistoryService creates a new StageHistoryService
+func NewStageHistoryService(repo *persistence.StageHistoryRepository, metadata *MetadataService, query *QueryService, permissions *PermissionService) *StageHistoryService {
+	return &StageHistoryService{
+		repo:        repo,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+	}
+}
+
+// stageHistoryFields returns the picklist fields of an object whose transitions are recorded
+func stageHistoryFields(schema *models.ObjectMetadata) []*models.FieldMetadata {
+	fields := make([]*models.FieldMetadata, 0)
+	for i := range schema.Fields {
+		if schema.Fields[i].TrackHistory && schema.Fields[i].Type == constants.FieldTypePicklist {
+			fields = append(fields, &schema.Fields[i])
+		}
+	}
+	return fields
+}
+
+// validateTrackHistory checks that history tracking is only requested on picklist fields
+func validateTrackHistory(field *models.FieldMetadata) error {
+	if field.TrackHistory && field.Type != constants.FieldTypePicklist {
+		return errors.NewValidationError(constants.FieldSysField_TrackHistory, "only picklist fields can track stage history")
+	}
+	return nil
+}
+
+// RegisterHandlers subscribes to after-commit record events to record stage transitions
+func (s *StageHistoryService) RegisterHandlers(eventBus *EventBus) {
+	handler := func(eventType events.EventType) EventHandler {
+		return func(ctx context.Context, payload interface{}) error {
+			recordPayload, ok := payload.(RecordEventPayload)
+			if !ok {
+				return nil
+			}
+			// History failures must not fail the outbox event (flows share the same dispatch)
+			if err := s.HandleRecordEvent(ctx, eventType, recordPayload); err != nil {
+				log.Printf("⚠️ [StageHistory] Failed to record history of %s/%s: %v", recordPayload.ObjectAPIName, recordPayload.Record.GetString(constants.FieldID), err)
+			}
+			return nil
+		}
+	}
+
+	eventBus.Subscribe(events.RecordCreated, handler(events.RecordCreated))
+	eventBus.Subscribe(events.RecordUpdated, handler(events.RecordUpdated))
+	eventBus.Subscribe(events.RecordDeleted, handler(events.RecordDeleted))
+}
+
+// HandleRecordEvent opens a stay for each tracked field that got a new value, closing the
+// previous one. Deleting a record closes its open stays.
+func (s *StageHistoryService) HandleRecordEvent(ctx context.Context, eventType events.EventType, payload RecordEventPayload) error {
+	schema := s.metadata.GetSchema(ctx, payload.ObjectAPIName)
+	if schema == nil {
+		return nil
+	}
+	fields := stageHistoryFields(schema)
+	if len(fields) == 0 {
+		return nil
+	}
+	recordID := payload.Record.GetString(constants.FieldID)
+	now := time.Now().UTC()
+
+	if eventType == events.RecordDeleted {
+		return s.repo.CloseOpen(ctx, schema.APIName, recordID, now)
+	}
+
+	var changedBy *string
+	if payload.CurrentUser != nil && payload.CurrentUser.ID != "" {
+		changedBy = &payload.CurrentUser.ID
+	}
+	for _, field := range fields {
+		value := payload.Record.GetString(field.APIName)
+		var previous string
+		if payload.OldRecord != nil {
+			previous = payload.OldRecord.GetString(field.APIName)
+		}
+		if value == previous {
+			continue
+		}
+		entry := &models.StageHistoryEntry{
+			ObjectAPIName: schema.APIName,
+			RecordID:      recordID,
+			FieldAPIName:  field.APIName,
+			Value:         value,
+			EnteredDate:   now,
+			ChangedByID:   changedBy,
+		}
+		if previous != "" {
+			entry.PreviousValue = &previous
+		}
+		if err := s.repo.RecordTransition(ctx, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRecordHistory returns the stays of a record in the values of its tracked fields that
+// the user can see, oldest first
+func (s *StageHistoryService) GetRecordHistory(ctx context.Context, objectAPIName, recordID string, currentUser *models.UserSession) ([]*models.StageHistoryEntry, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	rows, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, rows[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+
+	entries, err := s.repo.FindByRecord(ctx, schema.APIName, recordID)
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[string]bool)
+	filtered := make([]*models.StageHistoryEntry, 0, len(entries))
+	for _, e := range entries {
+		canSee, ok := visible[e.FieldAPIName]
+		if !ok {
+			canSee = s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, e.FieldAPIName, currentUser)
+			visible[e.FieldAPIName] = canSee
+		}
+		if canSee {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, nil
+}
+
+// GetAnalytics reports the funnel and velocity of a tracked picklist field over the stays
+// entered within [from, to) (nil bounds are open). Like report summaries, the figures
+// cover all records of the object; the user needs read access to the object and field.
+func (s *StageHistoryService) GetAnalytics(ctx context.Context, objectAPIName, fieldAPIName string, from, to *time.Time, currentUser *models.UserSession) (*models.StageAnalytics, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	if err := s.permissions.CheckPermissionOrErrorWithUser(ctx, schema.APIName, constants.PermRead, currentUser); err != nil {
+		return nil, err
+	}
+	field := FindField(schema, fieldAPIName)
+	if field == nil || !s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, field.APIName, currentUser) {
+		return nil, errors.NewNotFoundError("Field", fieldAPIName)
+	}
+	if !field.TrackHistory || field.Type != constants.FieldTypePicklist {
+		return nil, errors.NewValidationError("field", fmt.Sprintf("%s.%s is not a picklist field with history tracking", schema.APIName, field.APIName))
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, errors.NewValidationError("from", "from must be before to")
+	}
+
+	aggregates, err := s.repo.Aggregates(ctx, schema.APIName, field.APIName, from, to)
+	if err != nil {
+		return nil, err
+	}
+	visits, err := s.repo.Visits(ctx, schema.APIName, field.APIName, from, to)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := s.repo.Transitions(ctx, schema.APIName, field.APIName, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.StageAnalytics{
+		ObjectAPIName: schema.APIName,
+		FieldAPIName:  field.APIName,
+		From:          from,
+		To:            to,
+		Stages:        stageMetrics(stageOrder(field, aggregates), aggregates, visits),
+		Transitions:   transitions,
+	}, nil
+}
+
+// stageOrder lists the values of a picklist in funnel order: its options, then retired
+// options, then values only found in the history
+func stageOrder(field *models.FieldMetadata, aggregates []persistence.StageAggregate) []string {
+	order := make([]string, 0, len(field.Options)+len(field.InactiveOptions))
+	seen := make(map[string]bool)
+	for _, values := range [][]string{field.Options, field.InactiveOptions} {
+		for _, v := range values {
+			if !seen[v] {
+				seen[v] = true
+				order = append(order, v)
+			}
+		}
+	}
+	extra := make([]string, 0)
+	for _, a := range aggregates {
+		if !seen[a.Value] {
+			seen[a.Value] = true
+			extra = append(extra, a.Value)
+		}
+	}
+	sort.Strings(extra)
+	return append(order, extra...)
+}
+
+// stageMetrics combines the per-value aggregates with the funnel: a record reached every
+// value up to the furthest one it stayed in
+func stageMetrics(order []string, aggregates []persistence.StageAggregate, visits []persistence.StageVisit) []models.StageMetrics {
+	index := make(map[string]int, len(order))
+	for i, v := range order {
+		index[v] = i
+	}
+	furthest := make(map[string]int)
+	for _, v := range visits {
+		i, ok := index[v.Value]
+		if !ok {
+			continue
+		}
+		if prev, seen := furthest[v.RecordID]; !seen || i > prev {
+			furthest[v.RecordID] = i
+		}
+	}
+	reached := make([]int, len(order))
+	for _, i := range furthest {
+		for j := 0; j <= i; j++ {
+			reached[j]++
+		}
+	}
+
+	byValue := make(map[string]persistence.StageAggregate, len(aggregates))
+	for _, a := range aggregates {
+		byValue[a.Value] = a
+	}
+	metrics := make([]models.StageMetrics, len(order))
+	for i, v := range order {
+		a := byValue[v]
+		metrics[i] = models.StageMetrics{
+			Value:              v,
+			Entries:            a.Entries,
+			Records:            a.Records,
+			Reached:            reached[i],
+			Current:            a.Current,
+			Exited:             a.Exited,
+			AvgDurationSeconds: a.AvgDurationSeconds,
+			MaxDurationSeconds: a.MaxDurationSeconds,
+		}
+		if i+1 < len(order) && reached[i] > 0 {
+			rate := float64(reached[i+1]) / float64(reached[i])
+			metrics[i].ConversionRate = &rate
+		}
+	}
+	return metrics
+}
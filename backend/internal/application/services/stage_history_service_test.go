@@ -0,0 +1,52 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageMetrics(t *testing.T) {
+	field := &models.FieldMetadata{
+		APIName:         "stage",
+		Type:            constants.FieldTypePicklist,
+		Options:         []string{"Prospect", "Proposal", "Won"},
+		InactiveOptions: []string{"Qualify"},
+	}
+	aggregates := []persistence.StageAggregate{
+		{Value: "Prospect", Entries: 3, Records: 3, Exited: 2, AvgDurationSeconds: 60, MaxDurationSeconds: 90},
+		{Value: "Proposal", Entries: 1, Records: 1, Current: 1},
+		{Value: "Legacy", Entries: 1, Records: 1, Current: 1},
+	}
+	visits := []persistence.StageVisit{
+		{RecordID: "a", Value: "Prospect"}, {RecordID: "a", Value: "Proposal"},
+		{RecordID: "b", Value: "Prospect"},
+		{RecordID: "c", Value: "Won"}, // Skipped straight to Won
+	}
+
+	order := stageOrder(field, aggregates)
+	assert.Equal(t, []string{"Prospect", "Proposal", "Won", "Qualify", "Legacy"}, order)
+
+	metrics := stageMetrics(order, aggregates, visits)
+	require.Len(t, metrics, 5)
+	assert.Equal(t, 3, metrics[0].Reached)
+	assert.Equal(t, 2, metrics[1].Reached)
+	assert.Equal(t, 1, metrics[2].Reached)
+	assert.Equal(t, 0, metrics[3].Reached)
+	assert.Equal(t, 2, metrics[0].Exited)
+	assert.Equal(t, float64(60), metrics[0].AvgDurationSeconds)
+	require.NotNil(t, metrics[0].ConversionRate)
+	assert.InDelta(t, 2.0/3.0, *metrics[0].ConversionRate, 1e-9)
+	assert.Nil(t, metrics[3].ConversionRate, "no record reached the value")
+	assert.Nil(t, metrics[4].ConversionRate, "the last value has no next one")
+}
+
+func TestValidateTrackHistory(t *testing.T) {
+	assert.NoError(t, validateTrackHistory(&models.FieldMetadata{Type: constants.FieldTypePicklist, TrackHistory: true}))
+	assert.NoError(t, validateTrackHistory(&models.FieldMetadata{Type: constants.FieldTypeText}))
+	assert.Error(t, validateTrackHistory(&models.FieldMetadata{Type: constants.FieldTypeText, TrackHistory: true}))
+}
@@ -0,0 +1,422 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// stateMachineCacheTTL is how long the active state machines are cached; changes made on
+	// this server apply at once, changes made on others within the TTL
+	stateMachineCacheTTL = 30 * time.Second
+
+	// anyState as the from state of a transition allows the move from every state
+	anyState = "*"
+)
+
+// StateMachineService manages state machines on picklist fields. PersistenceService checks
+// every save against them (see CheckSave); the entry and exit actions of states run after
+// the save commits, as the user who saved.
+type StateMachineService struct {
+	repo     *persistence.StateMachineRepository
+	metadata *MetadataService
+	actions  *ActionService
+
+	mu       sync.Mutex
+	active   []*models.StateMachine
+	loadedAt time.Time
+}
+
+// NewStateMachineService creates a new StateMachineService
+func NewStateMachineService(repo *persistence.StateMachineRepository, metadata *MetadataService, actions *ActionService) *StateMachineService {
+	return &StateMachineService{
+		repo:     repo,
+		metadata: metadata,
+		actions:  actions,
+	}
+}
+
+// ==================== Configuration ====================
+
+// GetStateMachines returns all state machines
+func (s *StateMachineService) GetStateMachines(ctx context.Context) ([]*models.StateMachine, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// GetObjectStateMachines returns the active state machines of an object
+func (s *StateMachineService) GetObjectStateMachines(ctx context.Context, objectAPIName string) ([]*models.StateMachine, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	return s.objectStateMachines(ctx, schema.APIName), nil
+}
+
+// GetStateMachine returns a state machine by name
+func (s *StateMachineService) GetStateMachine(ctx context.Context, name string) (*models.StateMachine, error) {
+	m, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errors.NewNotFoundError("State machine", name)
+	}
+	return m, nil
+}
+
+// CreateStateMachine validates and stores a state machine; a field has at most one
+func (s *StateMachineService) CreateStateMachine(ctx context.Context, m *models.StateMachine) error {
+	m.Name = strings.TrimSpace(m.Name)
+	if !developerNamePattern.MatchString(m.Name) {
+		return errors.NewValidationError(constants.FieldSysStateMachine_Name, "must start with a letter and contain only letters, digits and underscores")
+	}
+	m.IsActive = true
+	if err := s.validateStateMachine(ctx, m); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByName(ctx, m.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errors.NewConflictError("StateMachine", constants.FieldSysStateMachine_Name, m.Name)
+	}
+	if err := s.checkFieldFree(ctx, m); err != nil {
+		return err
+	}
+	m.ID = GenerateID()
+	if err := s.repo.Insert(ctx, m); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// UpdateStateMachine replaces the definition of a state machine
+func (s *StateMachineService) UpdateStateMachine(ctx context.Context, name string, m *models.StateMachine) (*models.StateMachine, error) {
+	existing, err := s.GetStateMachine(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	m.ID = existing.ID
+	m.Name = existing.Name
+	m.CreatedDate = existing.CreatedDate
+	if err := s.validateStateMachine(ctx, m); err != nil {
+		return nil, err
+	}
+	if err := s.checkFieldFree(ctx, m); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, m); err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return m, nil
+}
+
+// DeleteStateMachine deletes a state machine, leaving its field unrestricted
+func (s *StateMachineService) DeleteStateMachine(ctx context.Context, name string) error {
+	m, err := s.GetStateMachine(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, m.ID); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// checkFieldFree rejects a second state machine on the same field
+func (s *StateMachineService) checkFieldFree(ctx context.Context, m *models.StateMachine) error {
+	other, err := s.repo.FindByField(ctx, m.ObjectAPIName, m.FieldAPIName)
+	if err != nil {
+		return err
+	}
+	if other != nil && other.ID != m.ID {
+		return errors.NewValidationError(constants.FieldSysStateMachine_FieldAPIName,
+			fmt.Sprintf("%s.%s already has state machine %s", m.ObjectAPIName, m.FieldAPIName, other.Name))
+	}
+	return nil
+}
+
+// validateStateMachine checks a state machine against its object and field and checks that
+// the actions of its states exist
+func (s *StateMachineService) validateStateMachine(ctx context.Context, m *models.StateMachine) error {
+	schema, err := s.metadata.GetSchemaOrError(ctx, m.ObjectAPIName)
+	if err != nil {
+		return err
+	}
+	if constants.IsSystemTable(schema.APIName) {
+		return errors.NewValidationError(constants.FieldSysStateMachine_ObjectAPIName, "state machines cannot govern system tables")
+	}
+	m.ObjectAPIName = schema.APIName
+	if err := validateStateMachineDefinition(m, schema); err != nil {
+		return err
+	}
+
+	for _, state := range m.States {
+		for _, actionID := range append(append([]string{}, state.EntryActions...), state.ExitActions...) {
+			if s.metadata.GetActionByID(ctx, actionID) == nil {
+				return errors.NewValidationError(constants.FieldSysStateMachine_States,
+					fmt.Sprintf("state '%s' references unknown action '%s'", state.Value, actionID))
+			}
+		}
+	}
+	return nil
+}
+
+// validateStateMachineDefinition checks that a state machine governs a picklist field of
+// schema, that its states are values of the field, and that its transitions and initial
+// states refer to its states
+func validateStateMachineDefinition(m *models.StateMachine, schema *models.ObjectMetadata) error {
+	field := FindField(schema, m.FieldAPIName)
+	if field == nil {
+		return errors.NewValidationError(constants.FieldSysStateMachine_FieldAPIName, fmt.Sprintf("unknown field '%s' on %s", m.FieldAPIName, schema.APIName))
+	}
+	if field.Type != constants.FieldTypePicklist {
+		return errors.NewValidationError(constants.FieldSysStateMachine_FieldAPIName, fmt.Sprintf("%s is not a picklist field", field.APIName))
+	}
+	m.FieldAPIName = field.APIName
+
+	if len(m.States) == 0 {
+		return errors.NewValidationError(constants.FieldSysStateMachine_States, "at least one state is required")
+	}
+	states := make(map[string]bool, len(m.States))
+	for _, state := range m.States {
+		if !ContainsString(field.Options, state.Value) && !ContainsString(field.InactiveOptions, state.Value) {
+			return errors.NewValidationError(constants.FieldSysStateMachine_States, fmt.Sprintf("'%s' is not a value of %s", state.Value, field.APIName))
+		}
+		if states[state.Value] {
+			return errors.NewValidationError(constants.FieldSysStateMachine_States, fmt.Sprintf("state '%s' is listed twice", state.Value))
+		}
+		states[state.Value] = true
+	}
+
+	for _, initial := range m.InitialStates {
+		if !states[initial] {
+			return errors.NewValidationError(constants.FieldSysStateMachine_InitialStates, fmt.Sprintf("'%s' is not a state", initial))
+		}
+	}
+
+	seen := make(map[[2]string]bool, len(m.Transitions))
+	for _, t := range m.Transitions {
+		if t.From != anyState && !states[t.From] {
+			return errors.NewValidationError(constants.FieldSysStateMachine_Transitions, fmt.Sprintf("transition from unknown state '%s'", t.From))
+		}
+		if !states[t.To] {
+			return errors.NewValidationError(constants.FieldSysStateMachine_Transitions, fmt.Sprintf("transition to unknown state '%s'", t.To))
+		}
+		if t.From == t.To {
+			return errors.NewValidationError(constants.FieldSysStateMachine_Transitions, fmt.Sprintf("transition from '%s' to itself", t.From))
+		}
+		key := [2]string{t.From, t.To}
+		if seen[key] {
+			return errors.NewValidationError(constants.FieldSysStateMachine_Transitions, fmt.Sprintf("transition from '%s' to '%s' is listed twice", t.From, t.To))
+		}
+		seen[key] = true
+		for _, required := range t.RequiredFields {
+			if FindField(schema, required) == nil {
+				return errors.NewValidationError(constants.FieldSysStateMachine_Transitions, fmt.Sprintf("unknown required field '%s' on %s", required, schema.APIName))
+			}
+		}
+	}
+	return nil
+}
+
+// ==================== Enforcement ====================
+
+// CheckSave checks a save against the state machines of its object. oldRecord is nil for
+// new records, which must start in an initial state; updates must move along a transition
+// the user's profile may make, with the transition's required fields filled in.
+func (s *StateMachineService) CheckSave(ctx context.Context, schema *models.ObjectMetadata, oldRecord, record models.SObject, user *models.UserSession) error {
+	for _, m := range s.objectStateMachines(ctx, schema.APIName) {
+		if err := checkStateTransition(m, oldRecord, record, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStateTransition checks the move of record from the state of oldRecord (nil for a new
+// record) to its own state. Records without a state, such as ones saved before the state
+// machine existed, start over as new records.
+func checkStateTransition(m *models.StateMachine, oldRecord, record models.SObject, user *models.UserSession) error {
+	to := record.GetString(m.FieldAPIName)
+	from := ""
+	if oldRecord != nil {
+		from = oldRecord.GetString(m.FieldAPIName)
+	}
+	if to == from {
+		return nil
+	}
+	if to == "" {
+		return errors.NewValidationError(m.FieldAPIName, fmt.Sprintf("cannot be cleared once set (state machine %s)", m.Name))
+	}
+	if findState(m, to) == nil {
+		return errors.NewValidationError(m.FieldAPIName, fmt.Sprintf("'%s' is not a state of state machine %s", to, m.Name))
+	}
+	if from == "" {
+		if len(m.InitialStates) > 0 && !ContainsString(m.InitialStates, to) {
+			return errors.NewValidationError(m.FieldAPIName, fmt.Sprintf("records cannot start in '%s'; expected %s", to, strings.Join(m.InitialStates, ", ")))
+		}
+		return nil
+	}
+
+	t := findTransition(m, from, to)
+	if t == nil {
+		return errors.NewValidationError(m.FieldAPIName, fmt.Sprintf("cannot move from '%s' to '%s'", from, to))
+	}
+	if user != nil && !user.IsSystemAdmin && len(t.ProfileIDs) > 0 && !ContainsString(t.ProfileIDs, user.ProfileID) {
+		return errors.NewForbiddenError(fmt.Sprintf("your profile may not move %s from '%s' to '%s'", m.FieldAPIName, from, to))
+	}
+	for _, required := range t.RequiredFields {
+		if val, ok := record[required]; !ok || val == nil || val == "" {
+			return errors.NewFieldValidationError(constants.ErrorCodeRequiredFieldMissing, required, fmt.Sprintf("is required to move to '%s'", to))
+		}
+	}
+	return nil
+}
+
+func findState(m *models.StateMachine, value string) *models.StateMachineState {
+	for i := range m.States {
+		if m.States[i].Value == value {
+			return &m.States[i]
+		}
+	}
+	return nil
+}
+
+// findTransition returns the transition from one state to another, preferring one from
+// that state over one from any state
+func findTransition(m *models.StateMachine, from, to string) *models.StateMachineTransition {
+	var wildcard *models.StateMachineTransition
+	for i := range m.Transitions {
+		t := &m.Transitions[i]
+		if t.To != to {
+			continue
+		}
+		if t.From == from {
+			return t
+		}
+		if t.From == anyState {
+			wildcard = t
+		}
+	}
+	return wildcard
+}
+
+// objectStateMachines returns the active state machines of an object
+func (s *StateMachineService) objectStateMachines(ctx context.Context, objectAPIName string) []*models.StateMachine {
+	if constants.IsSystemTable(objectAPIName) {
+		return nil
+	}
+	var machines []*models.StateMachine
+	for _, m := range s.activeStateMachines(ctx) {
+		if strings.EqualFold(m.ObjectAPIName, objectAPIName) {
+			machines = append(machines, m)
+		}
+	}
+	return machines
+}
+
+// activeStateMachines returns the active state machines, cached for stateMachineCacheTTL
+func (s *StateMachineService) activeStateMachines(ctx context.Context) []*models.StateMachine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < stateMachineCacheTTL {
+		return s.active
+	}
+	machines, err := s.repo.FindActive(ctx)
+	if err != nil {
+		log.Printf("⚠️ [StateMachines] Failed to load state machines: %v", err)
+		return s.active
+	}
+	s.active = machines
+	s.loadedAt = time.Now()
+	return machines
+}
+
+func (s *StateMachineService) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = time.Time{}
+}
+
+// ==================== Actions ====================
+
+// RegisterHandlers runs the exit actions of the state a record left and the entry actions
+// of the state it entered once the save commits
+func (s *StateMachineService) RegisterHandlers(eventBus *EventBus) {
+	handler := func(ctx context.Context, payload interface{}) error {
+		recordPayload, ok := payload.(RecordEventPayload)
+		if !ok {
+			return nil
+		}
+		s.runStateActions(ctx, recordPayload)
+		return nil
+	}
+	eventBus.Subscribe(events.RecordCreated, handler)
+	eventBus.Subscribe(events.RecordUpdated, handler)
+}
+
+// runStateActions runs the actions of the state changes of a saved record. The save has
+// committed, so failing actions are logged and the remaining ones still run.
+func (s *StateMachineService) runStateActions(ctx context.Context, payload RecordEventPayload) {
+	machines := s.objectStateMachines(ctx, payload.ObjectAPIName)
+	if len(machines) == 0 {
+		return
+	}
+	// After-save events arrive from the outbox with the chain that caused them
+	ctx = WithTriggerChain(ctx, payload.Triggers)
+	recordID := payload.Record.GetString(constants.FieldID)
+
+	for _, m := range machines {
+		to := payload.Record.GetString(m.FieldAPIName)
+		from := ""
+		if payload.OldRecord != nil {
+			from = payload.OldRecord.GetString(m.FieldAPIName)
+		}
+		if from == to {
+			continue
+		}
+
+		var actionIDs []string
+		if state := findState(m, from); state != nil {
+			actionIDs = append(actionIDs, state.ExitActions...)
+		}
+		if state := findState(m, to); state != nil {
+			actionIDs = append(actionIDs, state.EntryActions...)
+		}
+		if len(actionIDs) == 0 {
+			continue
+		}
+
+		actionCtx, err := EnterTrigger(ctx, TriggerFrame{
+			Kind:          TriggerKindStateMachine,
+			Name:          m.Name,
+			ObjectAPIName: payload.ObjectAPIName,
+			RecordID:      recordID,
+		})
+		if err != nil {
+			log.Printf("⚠️ [StateMachines] %s: %v", m.Name, err)
+			continue
+		}
+		for _, actionID := range actionIDs {
+			if err := s.actions.ExecuteAction(actionCtx, actionID, payload.Record, payload.CurrentUser); err != nil {
+				log.Printf("⚠️ [StateMachines] %s: action %s on %s/%s failed: %v", m.Name, actionID, payload.ObjectAPIName, recordID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stateMachineSchema() *models.ObjectMetadata {
+	return &models.ObjectMetadata{APIName: "Contract", Fields: []models.FieldMetadata{
+		{APIName: "status", Type: constants.FieldTypePicklist, Options: []string{"Draft", "Review", "Signed", "Cancelled"}},
+		{APIName: "signed_date", Type: constants.FieldTypeDate},
+		{APIName: "subject", Type: constants.FieldTypeText},
+	}}
+}
+
+func contractStateMachine() *models.StateMachine {
+	return &models.StateMachine{
+		Name:          "ContractLifecycle",
+		ObjectAPIName: "Contract",
+		FieldAPIName:  "Status",
+		InitialStates: []string{"Draft"},
+		States:        []models.StateMachineState{{Value: "Draft"}, {Value: "Review"}, {Value: "Signed"}, {Value: "Cancelled"}},
+		Transitions: []models.StateMachineTransition{
+			{From: "Draft", To: "Review"},
+			{From: "Review", To: "Draft"},
+			{From: "Review", To: "Signed", RequiredFields: []string{"signed_date"}, ProfileIDs: []string{"legal"}},
+			{From: "*", To: "Cancelled"},
+		},
+	}
+}
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	m := contractStateMachine()
+	require.NoError(t, validateStateMachineDefinition(m, stateMachineSchema()))
+	assert.Equal(t, "status", m.FieldAPIName)
+
+	for name, mutate := range map[string]func(m *models.StateMachine){
+		"not a picklist":     func(m *models.StateMachine) { m.FieldAPIName = "subject" },
+		"unknown value":      func(m *models.StateMachine) { m.States = append(m.States, models.StateMachineState{Value: "Expired"}) },
+		"duplicate state":    func(m *models.StateMachine) { m.States = append(m.States, models.StateMachineState{Value: "Draft"}) },
+		"no states":          func(m *models.StateMachine) { m.States = nil },
+		"unknown initial":    func(m *models.StateMachine) { m.InitialStates = []string{"Expired"} },
+		"unknown from":       func(m *models.StateMachine) { m.Transitions[0].From = "Expired" },
+		"self transition":    func(m *models.StateMachine) { m.Transitions[0].To = "Draft" },
+		"duplicate":          func(m *models.StateMachine) { m.Transitions = append(m.Transitions, m.Transitions[0]) },
+		"unknown required":   func(m *models.StateMachine) { m.Transitions[2].RequiredFields = []string{"missing"} },
+		"wildcard as target": func(m *models.StateMachine) { m.Transitions[3].To = "*" },
+	} {
+		m := contractStateMachine()
+		mutate(m)
+		assert.Error(t, validateStateMachineDefinition(m, stateMachineSchema()), name)
+	}
+}
+
+func TestCheckStateTransition(t *testing.T) {
+	m := contractStateMachine()
+	require.NoError(t, validateStateMachineDefinition(m, stateMachineSchema()))
+	legal := &models.UserSession{ID: "u1", ProfileID: "legal"}
+	sales := &models.UserSession{ID: "u2", ProfileID: "sales"}
+	record := func(fields ...interface{}) models.SObject {
+		r := models.SObject{}
+		for i := 0; i < len(fields); i += 2 {
+			r[fields[i].(string)] = fields[i+1]
+		}
+		return r
+	}
+
+	// New records start in an initial state or without one
+	assert.NoError(t, checkStateTransition(m, nil, record("status", "Draft"), sales))
+	assert.NoError(t, checkStateTransition(m, nil, record("subject", "x"), sales))
+	assert.Error(t, checkStateTransition(m, nil, record("status", "Signed"), sales))
+
+	assert.NoError(t, checkStateTransition(m, record("status", "Draft"), record("status", "Review"), sales))
+	assert.NoError(t, checkStateTransition(m, record("status", "Signed"), record("status", "Cancelled"), sales), "from any state")
+	assert.NoError(t, checkStateTransition(m, record("status", "Draft"), record("status", "Draft", "subject", "y"), sales), "no move")
+	assert.Error(t, checkStateTransition(m, record("status", "Draft"), record("status", "Signed"), legal), "no transition")
+	assert.Error(t, checkStateTransition(m, record("status", "Draft"), record("status", ""), legal), "cleared")
+
+	// Guarded transition: profile and required fields
+	err := checkStateTransition(m, record("status", "Review"), record("status", "Signed", "signed_date", "2026-01-02"), sales)
+	assert.IsType(t, &errors.ForbiddenError{}, err)
+	err = checkStateTransition(m, record("status", "Review"), record("status", "Signed"), legal)
+	var validationErr *errors.ValidationError
+	if assert.ErrorAs(t, err, &validationErr) {
+		assert.Equal(t, "signed_date", validationErr.Field)
+	}
+	assert.NoError(t, checkStateTransition(m, record("status", "Review"), record("status", "Signed", "signed_date", "2026-01-02"), legal))
+	assert.NoError(t, checkStateTransition(m, record("status", "Review"), record("status", "Signed", "signed_date", "2026-01-02"), &models.UserSession{IsSystemAdmin: true}))
+}
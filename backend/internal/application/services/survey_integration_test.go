@@ -0,0 +1,129 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSurveys_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+
+	contact := h.CreateObject(t, "contact", testharness.Field("name", constants.FieldTypeText))
+	supportCase := h.CreateObject(t, "case", testharness.Field("subject", constants.FieldTypeText))
+	contactID := h.CreateRecord(t, contact.APIName, models.SObject{"name": "Ada"})[constants.FieldID].(string)
+	caseID := h.CreateRecord(t, supportCase.APIName, models.SObject{"subject": "Printer jam"})[constants.FieldID].(string)
+
+	svc := services.NewSurveyService(persistence.NewSurveyRepository(h.DB.DB()), h.Services.Persistence, h.Services.Outbox,
+		h.Services.Metadata, h.Services.QuerySvc, h.Services.Permissions, services.SurveyConfig{
+			LinkBaseURL:   "https://crm.example.com/survey",
+			InvitationTTL: time.Hour,
+			ContactObject: contact.APIName,
+		})
+
+	survey := &models.SystemSurvey{
+		Name:      "Case satisfaction",
+		Question:  "How satisfied are you with the resolution?",
+		Questions: []byte(`[{"key":"reason","label":"Main reason","type":"choice","options":["Speed","Quality"],"required":true}]`),
+	}
+	require.NoError(t, svc.CreateSurvey(ctx, survey, h.Admin))
+	assert.Equal(t, constants.SurveyScoreTypeCSAT, survey.ScoreType)
+	assert.True(t, survey.IsActive)
+	other := h.CreateUser(t)
+	require.Error(t, svc.DeleteSurvey(ctx, survey.ID, other), "only the owner or an admin manages a survey")
+
+	// Invitations hand out a token once and store only its hash
+	link, err := svc.CreateInvitation(ctx, survey.ID, models.CreateSurveyInvitationInput{
+		ObjectAPIName: supportCase.APIName, RecordID: caseID, ContactID: contactID,
+	}, h.Admin)
+	require.NoError(t, err)
+	assert.Equal(t, "https://crm.example.com/survey/"+link.Token, link.URL)
+	assert.Empty(t, link.Invitation.TokenHash)
+	require.NotNil(t, link.Invitation.ExpiresDate)
+	_, err = svc.CreateInvitation(ctx, survey.ID, models.CreateSurveyInvitationInput{ObjectAPIName: supportCase.APIName, RecordID: "missing"}, h.Admin)
+	require.Error(t, err, "the record must exist")
+
+	// The public link shows the survey and takes one valid response
+	public, err := svc.GetPublicSurvey(ctx, link.Token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, public.ScoreMin)
+	assert.Equal(t, 5, public.ScoreMax)
+	require.Len(t, public.Questions, 1)
+	_, err = svc.GetPublicSurvey(ctx, "unknown-token")
+	require.Error(t, err)
+
+	score := func(v int) *int { return &v }
+	_, err = svc.SubmitResponse(ctx, link.Token, models.SurveySubmission{Score: score(6), Answers: map[string]interface{}{"reason": "Speed"}})
+	require.Error(t, err, "CSAT scores are 1-5")
+	_, err = svc.SubmitResponse(ctx, link.Token, models.SurveySubmission{Score: score(5)})
+	require.Error(t, err, "the reason is required")
+
+	comment := "Quick fix"
+	response, err := svc.SubmitResponse(ctx, link.Token, models.SurveySubmission{Score: score(5), Answers: map[string]interface{}{"reason": "Speed"}, Comment: &comment})
+	require.NoError(t, err)
+	require.NotNil(t, response.RecordID)
+	assert.Equal(t, caseID, *response.RecordID)
+	require.NotNil(t, response.ContactID)
+	assert.Equal(t, contactID, *response.ContactID)
+
+	_, err = svc.SubmitResponse(ctx, link.Token, models.SurveySubmission{Score: score(1), Answers: map[string]interface{}{"reason": "Speed"}})
+	require.Error(t, err, "a link takes one response")
+	_, err = svc.GetPublicSurvey(ctx, link.Token)
+	require.Error(t, err)
+
+	second, err := svc.CreateInvitation(ctx, survey.ID, models.CreateSurveyInvitationInput{ContactID: contactID}, h.Admin)
+	require.NoError(t, err)
+	_, err = svc.SubmitResponse(ctx, second.Token, models.SurveySubmission{Score: score(2), Answers: map[string]interface{}{"reason": "Quality"}})
+	require.NoError(t, err)
+
+	// Responses are listed by survey, by record and by contact
+	responses, err := svc.ListResponses(ctx, survey.ID, 0, 0, h.Admin)
+	require.NoError(t, err)
+	assert.Len(t, responses, 2)
+	byCase, err := svc.ListRecordResponses(ctx, supportCase.APIName, caseID, h.Admin)
+	require.NoError(t, err)
+	assert.Len(t, byCase, 1)
+	byContact, err := svc.ListRecordResponses(ctx, contact.APIName, contactID, h.Admin)
+	require.NoError(t, err)
+	assert.Len(t, byContact, 2)
+
+	// Analytics roll the scores up overall and by period
+	analytics, err := svc.Analytics(ctx, survey.ID, services.SurveyAnalyticsQuery{Interval: "week"}, other)
+	require.NoError(t, err)
+	assert.Equal(t, 2, analytics.Responses)
+	assert.Equal(t, 3.5, analytics.AverageScore)
+	require.NotNil(t, analytics.CSAT)
+	assert.Equal(t, 50.0, *analytics.CSAT)
+	require.Len(t, analytics.Distribution, 5)
+	assert.Equal(t, 1, analytics.Distribution[4].Count)
+	require.Len(t, analytics.Series, 1)
+	assert.Equal(t, 2, analytics.Series[0].Responses)
+
+	caseOnly, err := svc.Analytics(ctx, survey.ID, services.SurveyAnalyticsQuery{ObjectAPIName: strings.ToUpper(supportCase.APIName)}, other)
+	require.NoError(t, err)
+	assert.Equal(t, 1, caseOnly.Responses)
+	_, err = svc.Analytics(ctx, survey.ID, services.SurveyAnalyticsQuery{Interval: "year"}, other)
+	require.Error(t, err)
+
+	// Surveys with responses are deactivated rather than deleted, and their score type is fixed
+	require.Error(t, svc.DeleteSurvey(ctx, survey.ID, h.Admin))
+	survey.ScoreType = constants.SurveyScoreTypeNPS
+	require.Error(t, svc.UpdateSurvey(ctx, survey.ID, survey, h.Admin))
+	survey.ScoreType = constants.SurveyScoreTypeCSAT
+	survey.IsActive = false
+	require.NoError(t, svc.UpdateSurvey(ctx, survey.ID, survey, h.Admin))
+	_, err = svc.CreateInvitation(ctx, survey.ID, models.CreateSurveyInvitationInput{ContactID: contactID}, h.Admin)
+	require.Error(t, err, "inactive surveys take no invitations")
+}
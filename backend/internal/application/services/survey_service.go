@@ -0,0 +1,750 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultSurveyInvitationTTL is how long survey links stay valid unless configured
+	defaultSurveyInvitationTTL = 30 * 24 * time.Hour
+	// defaultSurveyContactObject is the object contact_id of an invitation refers to
+	defaultSurveyContactObject = "contact"
+	// surveyTextLimit bounds comments and text answers, in characters
+	surveyTextLimit = 4000
+	// surveyListLimit is the default and maximum page size of responses and invitations
+	surveyListLimit = 200
+	// csatSatisfiedScore is the lowest CSAT score counted as satisfied
+	csatSatisfiedScore = 4
+	// npsPromoterScore and npsPassiveScore are the lowest NPS scores of promoters and passives
+	npsPromoterScore = 9
+	npsPassiveScore  = 7
+)
+
+// Survey analytics intervals
+const (
+	SurveyIntervalDay   = "day"
+	SurveyIntervalWeek  = "week"
+	SurveyIntervalMonth = "month"
+)
+
+// surveyQuestionKeyPattern is the form of a follow-up question key
+var surveyQuestionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// surveyRespondentContext publishes survey events; respondents answer through a link
+// without a session
+var surveyRespondentContext = &models.UserSession{
+	ID:            "system-survey",
+	Name:          "Survey Respondent",
+	ProfileID:     constants.ProfileSystemAdmin,
+	IsSystemAdmin: true,
+}
+
+// SurveyConfig controls the links survey invitations are sent as
+type SurveyConfig struct {
+	LinkBaseURL   string        // A link is LinkBaseURL + "/" + token
+	InvitationTTL time.Duration // Zero keeps links valid until answered
+	ContactObject string
+}
+
+// SurveyConfigFrom returns the survey settings, with the contact object defaulting to contact
+func SurveyConfigFrom(settings config.Survey) SurveyConfig {
+	cfg := SurveyConfig{
+		LinkBaseURL:   settings.LinkBaseURL,
+		InvitationTTL: settings.InvitationTTL,
+		ContactObject: defaultSurveyContactObject,
+	}
+	if object := strings.TrimSpace(settings.ContactObject); object != "" {
+		cfg.ContactObject = strings.ToLower(object)
+	}
+	return cfg
+}
+
+// SurveyService manages CSAT and NPS surveys. Invitations hand out single-use links about a
+// record such as a case and/or a contact; only a hash of each link's token is stored. The
+// public endpoints behind a link show the survey and record the scored response, linked to
+// the invitation's record and contact, and analytics aggregate scores for dashboards.
+type SurveyService struct {
+	repo        *persistence.SurveyRepository
+	persistence *PersistenceService
+	outbox      *OutboxService
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	config      SurveyConfig
+}
+
+// NewSurveyService creates a new SurveyService
+func NewSurveyService(
+	repo *persistence.SurveyRepository,
+	persistence *PersistenceService,
+	outbox *OutboxService,
+	metadata *MetadataService,
+	query *QueryService,
+	permissions *PermissionService,
+	config SurveyConfig,
+) *SurveyService {
+	return &SurveyService{
+		repo:        repo,
+		persistence: persistence,
+		outbox:      outbox,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		config:      config,
+	}
+}
+
+// ==================== Surveys ====================
+
+// ListSurveys returns surveys by name, only active ones when activeOnly is set
+func (s *SurveyService) ListSurveys(ctx context.Context, activeOnly bool) ([]*models.SystemSurvey, error) {
+	return s.repo.ListSurveys(ctx, activeOnly)
+}
+
+// GetSurvey returns a survey by ID
+func (s *SurveyService) GetSurvey(ctx context.Context, id string) (*models.SystemSurvey, error) {
+	survey, err := s.repo.GetSurvey(ctx, nil, id)
+	if err != nil {
+		return nil, err
+	}
+	if survey == nil {
+		return nil, errors.NewNotFoundError(constants.TableSurvey, id)
+	}
+	return survey, nil
+}
+
+// CreateSurvey saves a new active survey owned by the current user. survey is updated in
+// place with the stored values.
+func (s *SurveyService) CreateSurvey(ctx context.Context, survey *models.SystemSurvey, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	survey.ID = GenerateID()
+	survey.OwnerID = currentUser.ID
+	survey.IsActive = true
+	if err := normalizeSurvey(survey); err != nil {
+		return err
+	}
+	return s.repo.InsertSurvey(ctx, survey)
+}
+
+// UpdateSurvey replaces the fields of a survey; the owner stays unless updates names a new
+// one. Once a survey has responses its score type is fixed. updates is replaced with the
+// stored values.
+func (s *SurveyService) UpdateSurvey(ctx context.Context, id string, updates *models.SystemSurvey, currentUser *models.UserSession) error {
+	existing, err := s.GetSurvey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditSurvey(existing.OwnerID, currentUser); err != nil {
+		return err
+	}
+	updated := *existing
+	updated.Name = updates.Name
+	updated.Description = updates.Description
+	updated.ScoreType = updates.ScoreType
+	updated.Question = updates.Question
+	updated.Questions = updates.Questions
+	updated.IsActive = updates.IsActive
+	if updates.OwnerID != "" {
+		updated.OwnerID = updates.OwnerID
+	}
+	if err := normalizeSurvey(&updated); err != nil {
+		return err
+	}
+	if updated.ScoreType != existing.ScoreType {
+		count, err := s.repo.CountResponses(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.NewValidationError(constants.FieldSysSurvey_ScoreType, "the score type of a survey with responses cannot change")
+		}
+	}
+	if err := s.repo.UpdateSurvey(ctx, &updated); err != nil {
+		return err
+	}
+	*updates = updated
+	return nil
+}
+
+// DeleteSurvey removes a survey without responses, with its invitations. Surveys with
+// responses are deactivated instead so their analytics remain.
+func (s *SurveyService) DeleteSurvey(ctx context.Context, id string, currentUser *models.UserSession) error {
+	survey, err := s.GetSurvey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkCanEditSurvey(survey.OwnerID, currentUser); err != nil {
+		return err
+	}
+	count, err := s.repo.CountResponses(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.NewValidationError(constants.FieldSysSurvey_IsActive, fmt.Sprintf("survey has %d responses; deactivate it instead", count))
+	}
+	return s.repo.DeleteSurvey(ctx, id)
+}
+
+// ==================== Invitations ====================
+
+// CreateInvitation creates a single-use link to an active survey about a record and/or a
+// contact the user can read. The token is returned only here.
+func (s *SurveyService) CreateInvitation(ctx context.Context, surveyID string, input models.CreateSurveyInvitationInput, currentUser *models.UserSession) (*models.SurveyInvitationLink, error) {
+	if currentUser == nil {
+		return nil, errors.NewUnauthorizedError("User session not found")
+	}
+	survey, err := s.GetSurvey(ctx, surveyID)
+	if err != nil {
+		return nil, err
+	}
+	if !survey.IsActive {
+		return nil, errors.NewValidationError(constants.FieldSysSurvey_IsActive, "survey is not active")
+	}
+
+	invitation := &models.SystemSurveyInvitation{
+		ID:          GenerateID(),
+		SurveyID:    survey.ID,
+		CreatedByID: currentUser.ID,
+	}
+	if input.ObjectAPIName != "" || input.RecordID != "" {
+		schema, err := s.checkReadable(ctx, input.ObjectAPIName, input.RecordID, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		invitation.ObjectAPIName = &schema.APIName
+		invitation.RecordID = &input.RecordID
+	}
+	if input.ContactID != "" {
+		if _, err := s.checkReadable(ctx, s.config.ContactObject, input.ContactID, currentUser); err != nil {
+			return nil, err
+		}
+		invitation.ContactID = &input.ContactID
+	}
+	if s.config.InvitationTTL > 0 {
+		expires := time.Now().Add(s.config.InvitationTTL)
+		invitation.ExpiresDate = &expires
+	}
+
+	token, err := randomSurveyToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation.TokenHash = hashSurveyToken(token)
+	if err := s.repo.InsertInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+	invitation.TokenHash = ""
+	return &models.SurveyInvitationLink{
+		Invitation: invitation,
+		Token:      token,
+		URL:        s.config.LinkBaseURL + "/" + token,
+	}, nil
+}
+
+// ListInvitations returns the most recent invitations of a survey to its owner
+func (s *SurveyService) ListInvitations(ctx context.Context, surveyID string, currentUser *models.UserSession) ([]*models.SystemSurveyInvitation, error) {
+	survey, err := s.GetSurvey(ctx, surveyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEditSurvey(survey.OwnerID, currentUser); err != nil {
+		return nil, err
+	}
+	invitations, err := s.repo.ListInvitations(ctx, surveyID, surveyListLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range invitations {
+		invitation.TokenHash = ""
+	}
+	return invitations, nil
+}
+
+// ==================== Public responses ====================
+
+// GetPublicSurvey returns what the link with a token shows: the survey, while the link is
+// unanswered, unexpired and the survey active
+func (s *SurveyService) GetPublicSurvey(ctx context.Context, token string) (*models.PublicSurvey, error) {
+	invitation, err := s.repo.GetInvitationByToken(ctx, hashSurveyToken(token))
+	if err != nil {
+		return nil, err
+	}
+	survey, err := s.openSurvey(ctx, nil, invitation, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	questions, err := parseSurveyQuestions(survey.Questions)
+	if err != nil {
+		return nil, err
+	}
+	min, max := surveyScoreRange(survey.ScoreType)
+	return &models.PublicSurvey{
+		Name:        survey.Name,
+		Description: survey.Description,
+		ScoreType:   survey.ScoreType,
+		Question:    survey.Question,
+		ScoreMin:    min,
+		ScoreMax:    max,
+		Questions:   questions,
+		ExpiresDate: invitation.ExpiresDate,
+	}, nil
+}
+
+// SubmitResponse records the answer posted to the link with a token. A link takes one
+// response; the response is linked to the invitation's record and contact.
+func (s *SurveyService) SubmitResponse(ctx context.Context, token string, submission models.SurveySubmission) (*models.SystemSurveyResponse, error) {
+	var response *models.SystemSurveyResponse
+	err := s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		invitation, err := s.repo.GetInvitationByTokenLock(txCtx, tx, hashSurveyToken(token))
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		survey, err := s.openSurvey(txCtx, tx, invitation, now)
+		if err != nil {
+			return err
+		}
+
+		if submission.Score == nil {
+			return errors.NewValidationError(constants.FieldSysSurveyResponse_Score, "score is required")
+		}
+		if min, max := surveyScoreRange(survey.ScoreType); *submission.Score < min || *submission.Score > max {
+			return errors.NewValidationError(constants.FieldSysSurveyResponse_Score, fmt.Sprintf("score must be between %d and %d", min, max))
+		}
+		questions, err := parseSurveyQuestions(survey.Questions)
+		if err != nil {
+			return err
+		}
+		answers, err := validateSurveyAnswers(questions, submission.Answers)
+		if err != nil {
+			return err
+		}
+		comment := submission.Comment
+		if comment != nil {
+			trimmed := strings.TrimSpace(*comment)
+			if len([]rune(trimmed)) > surveyTextLimit {
+				return errors.NewValidationError(constants.FieldSysSurveyResponse_Comment, fmt.Sprintf("comment is longer than %d characters", surveyTextLimit))
+			}
+			comment = &trimmed
+			if trimmed == "" {
+				comment = nil
+			}
+		}
+
+		response = &models.SystemSurveyResponse{
+			ID:            GenerateID(),
+			SurveyID:      survey.ID,
+			InvitationID:  invitation.ID,
+			ObjectAPIName: invitation.ObjectAPIName,
+			RecordID:      invitation.RecordID,
+			ContactID:     invitation.ContactID,
+			Score:         *submission.Score,
+			Answers:       answers,
+			Comment:       comment,
+			SubmittedDate: now,
+		}
+		if err := s.repo.Respond(txCtx, tx, invitation, response); err != nil {
+			return err
+		}
+		return s.outbox.EnqueueEventTx(txCtx, tx, events.SurveyResponded, RecordEventPayload{
+			ObjectAPIName: constants.TableSurveyResponse,
+			Record:        response.ToSObject(),
+			CurrentUser:   surveyRespondentContext,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// openSurvey returns the survey of an invitation that can still be answered
+func (s *SurveyService) openSurvey(ctx context.Context, tx *sql.Tx, invitation *models.SystemSurveyInvitation, now time.Time) (*models.SystemSurvey, error) {
+	// Unknown tokens are not echoed back
+	if invitation == nil {
+		return nil, errors.NewNotFoundError(constants.TableSurvey, "link")
+	}
+	if invitation.RespondedDate != nil {
+		return nil, errors.NewConflictError(constants.TableSurveyInvitation, constants.FieldSysSurveyInvitation_RespondedDate, "survey already answered")
+	}
+	if invitation.ExpiresDate != nil && now.After(*invitation.ExpiresDate) {
+		return nil, errors.NewValidationError(constants.FieldSysSurveyInvitation_ExpiresDate, "survey link has expired")
+	}
+	survey, err := s.repo.GetSurvey(ctx, tx, invitation.SurveyID)
+	if err != nil {
+		return nil, err
+	}
+	if survey == nil || !survey.IsActive {
+		return nil, errors.NewNotFoundError(constants.TableSurvey, "link")
+	}
+	return survey, nil
+}
+
+// ==================== Responses and analytics ====================
+
+// ListResponses returns responses to a survey to its owner, most recent first
+func (s *SurveyService) ListResponses(ctx context.Context, surveyID string, limit, offset int, currentUser *models.UserSession) ([]*models.SystemSurveyResponse, error) {
+	survey, err := s.GetSurvey(ctx, surveyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEditSurvey(survey.OwnerID, currentUser); err != nil {
+		return nil, err
+	}
+	return s.repo.ListResponses(ctx, persistence.ResponseFilter{SurveyID: surveyID}, surveyPageSize(limit), max(offset, 0))
+}
+
+// ListRecordResponses returns the responses about a record the user can read, such as a
+// case or a contact, most recent first
+func (s *SurveyService) ListRecordResponses(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) ([]*models.SystemSurveyResponse, error) {
+	schema, err := s.checkReadable(ctx, objectName, recordID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	filter := persistence.ResponseFilter{ObjectAPIName: schema.APIName, RecordID: recordID}
+	if strings.EqualFold(schema.APIName, s.config.ContactObject) {
+		filter = persistence.ResponseFilter{ContactID: recordID}
+	}
+	return s.repo.ListResponses(ctx, filter, surveyListLimit, 0)
+}
+
+// SurveyAnalyticsQuery selects the responses a survey's analytics cover
+type SurveyAnalyticsQuery struct {
+	From          *time.Time // Submitted at or after
+	To            *time.Time // Submitted before
+	Interval      string     // day (default), week or month
+	ObjectAPIName string     // Only responses about records of this object
+}
+
+// Analytics aggregates the scores of a survey's responses overall, by score and by period.
+// Aggregates carry no respondent details, so any user can read them.
+func (s *SurveyService) Analytics(ctx context.Context, surveyID string, q SurveyAnalyticsQuery, currentUser *models.UserSession) (*models.SurveyAnalytics, error) {
+	if currentUser == nil {
+		return nil, errors.NewUnauthorizedError("User session not found")
+	}
+	survey, err := s.GetSurvey(ctx, surveyID)
+	if err != nil {
+		return nil, err
+	}
+	interval := strings.ToLower(q.Interval)
+	if interval == "" {
+		interval = SurveyIntervalDay
+	}
+	if interval != SurveyIntervalDay && interval != SurveyIntervalWeek && interval != SurveyIntervalMonth {
+		return nil, errors.NewValidationError("interval", "interval must be day, week or month")
+	}
+	filter := persistence.ResponseFilter{SurveyID: surveyID, From: q.From, To: q.To}
+	if q.ObjectAPIName != "" {
+		schema := s.metadata.GetSchema(ctx, q.ObjectAPIName)
+		if schema == nil {
+			return nil, errors.NewNotFoundError("Object Metadata", q.ObjectAPIName)
+		}
+		filter.ObjectAPIName = schema.APIName
+	}
+	counts, err := s.repo.ScoreCounts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return buildSurveyAnalytics(survey, interval, counts)
+}
+
+// checkReadable verifies the user can read a record
+func (s *SurveyService) checkReadable(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) (*models.ObjectMetadata, error) {
+	if strings.TrimSpace(objectName) == "" || strings.TrimSpace(recordID) == "" {
+		return nil, errors.NewValidationError(constants.FieldSysSurveyInvitation_RecordID, "object and record are required")
+	}
+	schema := s.metadata.GetSchema(ctx, objectName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object Metadata", objectName)
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, errors.NewPermissionError(constants.PermRead, schema.APIName)
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	return schema, nil
+}
+
+// ==================== Helpers ====================
+
+// buildSurveyAnalytics summarizes daily score counts overall, by score and by period
+func buildSurveyAnalytics(survey *models.SystemSurvey, interval string, counts []persistence.DailyScoreCount) (*models.SurveyAnalytics, error) {
+	overall := make(map[int]int)
+	periods := make(map[string]map[int]int)
+	order := make([]string, 0)
+	for _, c := range counts {
+		day, err := time.Parse(time.DateOnly, c.Day)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response day %q: %w", c.Day, err)
+		}
+		period := surveyPeriodStart(day, interval)
+		if periods[period] == nil {
+			periods[period] = make(map[int]int)
+			order = append(order, period)
+		}
+		periods[period][c.Score] += c.Count
+		overall[c.Score] += c.Count
+	}
+	sort.Strings(order)
+
+	analytics := &models.SurveyAnalytics{
+		SurveyID:           survey.ID,
+		ScoreType:          survey.ScoreType,
+		Interval:           interval,
+		Distribution:       make([]models.SurveyScoreCount, 0),
+		Series:             make([]models.SurveyAnalyticsPeriod, 0, len(order)),
+		SurveyScoreSummary: summarizeSurveyScores(survey.ScoreType, overall),
+	}
+	min, max := surveyScoreRange(survey.ScoreType)
+	for score := min; score <= max; score++ {
+		analytics.Distribution = append(analytics.Distribution, models.SurveyScoreCount{Score: score, Count: overall[score]})
+	}
+	for _, period := range order {
+		analytics.Series = append(analytics.Series, models.SurveyAnalyticsPeriod{
+			Period:             period,
+			SurveyScoreSummary: summarizeSurveyScores(survey.ScoreType, periods[period]),
+		})
+	}
+	return analytics, nil
+}
+
+// summarizeSurveyScores computes the average and the CSAT or NPS of response counts by score
+func summarizeSurveyScores(scoreType string, counts map[int]int) models.SurveyScoreSummary {
+	var summary models.SurveyScoreSummary
+	total, satisfied := 0, 0
+	for score, count := range counts {
+		summary.Responses += count
+		total += score * count
+		switch {
+		case scoreType == constants.SurveyScoreTypeNPS && score >= npsPromoterScore:
+			summary.Promoters += count
+		case scoreType == constants.SurveyScoreTypeNPS && score >= npsPassiveScore:
+			summary.Passives += count
+		case scoreType == constants.SurveyScoreTypeNPS:
+			summary.Detractors += count
+		case score >= csatSatisfiedScore:
+			satisfied += count
+		}
+	}
+	if summary.Responses == 0 {
+		return summary
+	}
+	responses := float64(summary.Responses)
+	summary.AverageScore = roundPercent(float64(total) / responses)
+	if scoreType == constants.SurveyScoreTypeNPS {
+		nps := roundPercent(float64(summary.Promoters-summary.Detractors) * 100 / responses)
+		summary.NPS = &nps
+	} else {
+		csat := roundPercent(float64(satisfied) * 100 / responses)
+		summary.CSAT = &csat
+	}
+	return summary
+}
+
+// roundPercent rounds to two decimals
+func roundPercent(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// surveyPeriodStart returns the first day (YYYY-MM-DD) of the day, week (from Monday) or
+// month a day falls in
+func surveyPeriodStart(day time.Time, interval string) string {
+	switch interval {
+	case SurveyIntervalWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset).Format(time.DateOnly)
+	case SurveyIntervalMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location()).Format(time.DateOnly)
+	default:
+		return day.Format(time.DateOnly)
+	}
+}
+
+// surveyScoreRange returns the scores a survey type accepts
+func surveyScoreRange(scoreType string) (int, int) {
+	if scoreType == constants.SurveyScoreTypeNPS {
+		return 0, 10
+	}
+	return 1, 5
+}
+
+func surveyPageSize(limit int) int {
+	if limit <= 0 || limit > surveyListLimit {
+		return surveyListLimit
+	}
+	return limit
+}
+
+// normalizeSurvey validates a survey, canonicalizing its score type and questions
+func normalizeSurvey(s *models.SystemSurvey) error {
+	s.Name = strings.TrimSpace(s.Name)
+	if s.Name == "" {
+		return errors.NewValidationError(constants.FieldSysSurvey_Name, "name is required")
+	}
+	s.Question = strings.TrimSpace(s.Question)
+	if s.Question == "" {
+		return errors.NewValidationError(constants.FieldSysSurvey_Question, "question is required")
+	}
+	switch strings.ToUpper(s.ScoreType) {
+	case "", constants.SurveyScoreTypeCSAT:
+		s.ScoreType = constants.SurveyScoreTypeCSAT
+	case constants.SurveyScoreTypeNPS:
+		s.ScoreType = constants.SurveyScoreTypeNPS
+	default:
+		return errors.NewValidationError(constants.FieldSysSurvey_ScoreType, "score type must be CSAT or NPS")
+	}
+
+	questions, err := parseSurveyQuestions(s.Questions)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for i := range questions {
+		q := &questions[i]
+		q.Key = strings.TrimSpace(q.Key)
+		q.Label = strings.TrimSpace(q.Label)
+		q.Type = strings.ToLower(strings.TrimSpace(q.Type))
+		if !surveyQuestionKeyPattern.MatchString(q.Key) {
+			return errors.NewValidationError(constants.FieldSysSurvey_Questions, fmt.Sprintf("question key %q must be lowercase letters, digits or underscores, starting with a letter", q.Key))
+		}
+		if seen[q.Key] {
+			return errors.NewValidationError(constants.FieldSysSurvey_Questions, fmt.Sprintf("question key %q is used twice", q.Key))
+		}
+		seen[q.Key] = true
+		if q.Label == "" {
+			return errors.NewValidationError(constants.FieldSysSurvey_Questions, fmt.Sprintf("question %s needs a label", q.Key))
+		}
+		switch q.Type {
+		case constants.SurveyQuestionTypeText, constants.SurveyQuestionTypeRating:
+			q.Options = nil
+		case constants.SurveyQuestionTypeChoice:
+			if len(q.Options) == 0 {
+				return errors.NewValidationError(constants.FieldSysSurvey_Questions, fmt.Sprintf("choice question %s needs options", q.Key))
+			}
+		default:
+			return errors.NewValidationError(constants.FieldSysSurvey_Questions, fmt.Sprintf("question %s has unknown type %q", q.Key, q.Type))
+		}
+	}
+	if len(questions) == 0 {
+		s.Questions = nil
+		return nil
+	}
+	raw, err := json.Marshal(questions)
+	if err != nil {
+		return err
+	}
+	s.Questions = raw
+	return nil
+}
+
+// parseSurveyQuestions decodes a survey's follow-up questions
+func parseSurveyQuestions(raw json.RawMessage) ([]models.SurveyQuestion, error) {
+	questions := make([]models.SurveyQuestion, 0)
+	if len(raw) == 0 || string(raw) == "null" {
+		return questions, nil
+	}
+	if err := json.Unmarshal(raw, &questions); err != nil {
+		return nil, errors.NewValidationError(constants.FieldSysSurvey_Questions, "questions must be a list of questions")
+	}
+	return questions, nil
+}
+
+// validateSurveyAnswers checks answers against a survey's questions and encodes them
+func validateSurveyAnswers(questions []models.SurveyQuestion, answers map[string]interface{}) (json.RawMessage, error) {
+	byKey := make(map[string]models.SurveyQuestion, len(questions))
+	for _, q := range questions {
+		byKey[q.Key] = q
+	}
+	for key := range answers {
+		if _, ok := byKey[key]; !ok {
+			return nil, errors.NewValidationError(constants.FieldSysSurveyResponse_Answers, fmt.Sprintf("unknown question %q", key))
+		}
+	}
+
+	clean := make(map[string]interface{})
+	for _, q := range questions {
+		value, ok := answers[q.Key]
+		if text, isText := value.(string); isText && strings.TrimSpace(text) == "" {
+			ok = false
+		}
+		if !ok || value == nil {
+			if q.Required {
+				return nil, errors.NewValidationError(constants.FieldSysSurveyResponse_Answers, fmt.Sprintf("%s is required", q.Label))
+			}
+			continue
+		}
+		switch q.Type {
+		case constants.SurveyQuestionTypeText:
+			text, isText := value.(string)
+			if !isText || len([]rune(text)) > surveyTextLimit {
+				return nil, errors.NewValidationError(constants.FieldSysSurveyResponse_Answers, fmt.Sprintf("%s must be text of at most %d characters", q.Label, surveyTextLimit))
+			}
+			clean[q.Key] = strings.TrimSpace(text)
+		case constants.SurveyQuestionTypeChoice:
+			choice, isText := value.(string)
+			if !isText || !ContainsString(q.Options, choice) {
+				return nil, errors.NewValidationError(constants.FieldSysSurveyResponse_Answers, fmt.Sprintf("%s must be one of %s", q.Label, strings.Join(q.Options, ", ")))
+			}
+			clean[q.Key] = choice
+		case constants.SurveyQuestionTypeRating:
+			rating, isNumber := value.(float64)
+			if !isNumber || rating != math.Trunc(rating) || rating < 1 || rating > 5 {
+				return nil, errors.NewValidationError(constants.FieldSysSurveyResponse_Answers, fmt.Sprintf("%s must be a rating from 1 to 5", q.Label))
+			}
+			clean[q.Key] = int(rating)
+		}
+	}
+	if len(clean) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(clean)
+}
+
+// randomSurveyToken generates the secret part of a survey link
+func randomSurveyToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate survey token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashSurveyToken is how a survey token is stored and looked up
+func hashSurveyToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func checkCanEditSurvey(ownerID string, currentUser *models.UserSession) error {
+	if currentUser == nil {
+		return errors.NewUnauthorizedError("User session not found")
+	}
+	if currentUser.IsSystemAdmin || constants.IsSuperUser(currentUser.ProfileID) || ownerID == currentUser.ID {
+		return nil
+	}
+	return errors.NewPermissionError("edit", "survey")
+}
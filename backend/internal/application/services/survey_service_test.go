@@ -0,0 +1,105 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeSurveyScores(t *testing.T) {
+	csat := summarizeSurveyScores(constants.SurveyScoreTypeCSAT, map[int]int{5: 2, 4: 1, 2: 1})
+	assert.Equal(t, 4, csat.Responses)
+	assert.Equal(t, 4.0, csat.AverageScore)
+	require.NotNil(t, csat.CSAT)
+	assert.Equal(t, 75.0, *csat.CSAT)
+	assert.Nil(t, csat.NPS)
+
+	nps := summarizeSurveyScores(constants.SurveyScoreTypeNPS, map[int]int{10: 3, 9: 2, 8: 2, 7: 1, 6: 1, 0: 1})
+	assert.Equal(t, 10, nps.Responses)
+	assert.Equal(t, 5, nps.Promoters)
+	assert.Equal(t, 3, nps.Passives)
+	assert.Equal(t, 2, nps.Detractors)
+	require.NotNil(t, nps.NPS)
+	assert.Equal(t, 30.0, *nps.NPS)
+
+	empty := summarizeSurveyScores(constants.SurveyScoreTypeCSAT, nil)
+	assert.Zero(t, empty.Responses)
+	assert.Nil(t, empty.CSAT, "no score without responses")
+}
+
+func TestSurveyPeriodStart(t *testing.T) {
+	day := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC) // A Thursday
+	assert.Equal(t, "2026-10-15", surveyPeriodStart(day, SurveyIntervalDay))
+	assert.Equal(t, "2026-10-12", surveyPeriodStart(day, SurveyIntervalWeek))
+	assert.Equal(t, "2026-10-01", surveyPeriodStart(day, SurveyIntervalMonth))
+	sunday := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2026-10-12", surveyPeriodStart(sunday, SurveyIntervalWeek), "weeks start on Monday")
+}
+
+func TestNormalizeSurvey(t *testing.T) {
+	survey := &models.SystemSurvey{
+		Name:      " Support ",
+		Question:  "How did we do?",
+		ScoreType: "nps",
+		Questions: json.RawMessage(`[{"key":"reason","label":"Why?","type":"Choice","options":["Speed","Quality"]}]`),
+	}
+	require.NoError(t, normalizeSurvey(survey))
+	assert.Equal(t, "Support", survey.Name)
+	assert.Equal(t, constants.SurveyScoreTypeNPS, survey.ScoreType)
+	assert.JSONEq(t, `[{"key":"reason","label":"Why?","type":"choice","options":["Speed","Quality"]}]`, string(survey.Questions))
+
+	invalid := map[string]string{
+		"duplicate key":        `[{"key":"a","label":"A","type":"text"},{"key":"a","label":"B","type":"text"}]`,
+		"bad key":              `[{"key":"A b","label":"A","type":"text"}]`,
+		"choice needs options": `[{"key":"a","label":"A","type":"choice"}]`,
+		"unknown type":         `[{"key":"a","label":"A","type":"date"}]`,
+		"not a list":           `{"key":"a"}`,
+	}
+	for name, questions := range invalid {
+		s := &models.SystemSurvey{Name: "S", Question: "Q", Questions: json.RawMessage(questions)}
+		assert.Error(t, normalizeSurvey(s), name)
+	}
+	assert.Error(t, normalizeSurvey(&models.SystemSurvey{Name: "S", Question: "Q", ScoreType: "CES"}))
+}
+
+func TestValidateSurveyAnswers(t *testing.T) {
+	questions := []models.SurveyQuestion{
+		{Key: "reason", Label: "Reason", Type: constants.SurveyQuestionTypeChoice, Options: []string{"Speed", "Quality"}, Required: true},
+		{Key: "agent", Label: "Agent", Type: constants.SurveyQuestionTypeRating},
+		{Key: "notes", Label: "Notes", Type: constants.SurveyQuestionTypeText},
+	}
+
+	raw, err := validateSurveyAnswers(questions, map[string]interface{}{"reason": "Speed", "agent": 4.0, "notes": " fast "})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"reason":"Speed","agent":4,"notes":"fast"}`, string(raw))
+
+	raw, err = validateSurveyAnswers(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, raw)
+
+	for name, answers := range map[string]map[string]interface{}{
+		"missing required":  {"agent": 3.0},
+		"unknown option":    {"reason": "Price"},
+		"rating too high":   {"reason": "Speed", "agent": 6.0},
+		"fractional rating": {"reason": "Speed", "agent": 2.5},
+		"unknown question":  {"reason": "Speed", "other": "x"},
+		"text not a string": {"reason": "Speed", "notes": 3.0},
+	} {
+		_, err := validateSurveyAnswers(questions, answers)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSurveyTokenHash(t *testing.T) {
+	token, err := randomSurveyToken()
+	require.NoError(t, err)
+	assert.Len(t, token, 43)
+	assert.Len(t, hashSurveyToken(token), 64)
+	assert.Equal(t, hashSurveyToken(token), hashSurveyToken(token))
+	assert.NotEqual(t, token, hashSurveyToken(token))
+}
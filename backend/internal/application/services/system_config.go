@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appconfig "github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/secretstore"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// redactedSecret replaces secret values stored in plaintext before they leave the server
+const redactedSecret = "********"
+
+// GetConfig returns the value of a _System_Config entry. Secret entries hold a reference
+// ("vault:secret/data/nexuscrm#smtp_password") that is resolved here on every read, so the
+// secret itself is never stored in the database.
+func (sm *SystemManager) GetConfig(ctx context.Context, keyName string) (string, error) {
+	config, err := sm.repo.GetConfig(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	if config == nil {
+		return "", errors.NewNotFoundError("Config", keyName)
+	}
+	if !config.IsSecret {
+		return config.Value, nil
+	}
+	if !secretstore.IsReference(config.Value) {
+		return "", fmt.Errorf("secret config %s holds a plaintext value; store a secret reference instead", keyName)
+	}
+	return sm.secrets.Resolve(ctx, config.Value)
+}
+
+// ListConfigs returns every _System_Config entry with secret values redacted: references
+// are shown as they are, plaintext secrets are masked
+func (sm *SystemManager) ListConfigs(ctx context.Context) ([]*models.SystemConfig, error) {
+	configs, err := sm.repo.GetAllConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.IsSecret {
+			config.Value = RedactSecretConfig(config.Value)
+		}
+	}
+	return configs, nil
+}
+
+// SaveConfig creates or replaces a _System_Config entry. The value of a secret entry must be
+// a reference to a configured secret store that resolves. Entries named after a runtime
+// setting (QUERY_MAX_ROWS, ...) must hold a valid value; they apply at the next reload.
+func (sm *SystemManager) SaveConfig(ctx context.Context, config *models.SystemConfig) error {
+	config.KeyName = strings.TrimSpace(config.KeyName)
+	if config.KeyName == "" {
+		return errors.NewValidationError(constants.FieldKeyName, "Key name is required")
+	}
+	if appconfig.IsSetting(config.KeyName) {
+		if config.IsSecret {
+			return errors.NewValidationError(constants.FieldIsSecret, "Runtime settings are not secret")
+		}
+		if err := appconfig.CheckEntry(config.KeyName, config.Value); err != nil {
+			return errors.NewValidationError(constants.FieldValue, err.Error())
+		}
+	}
+	if config.IsSecret {
+		config.Value = strings.TrimSpace(config.Value)
+		if !secretstore.IsReference(config.Value) {
+			return errors.NewValidationError(constants.FieldValue,
+				"Secret values must be a reference such as vault:<path>#<key>, aws:<secret id>#<key> or env:<VARIABLE>")
+		}
+		if _, err := sm.secrets.Resolve(ctx, config.Value); err != nil {
+			return errors.NewValidationError(constants.FieldValue, err.Error())
+		}
+	}
+	return sm.repo.UpsertConfig(ctx, config)
+}
+
+// DeleteConfig removes a _System_Config entry
+func (sm *SystemManager) DeleteConfig(ctx context.Context, keyName string) error {
+	found, err := sm.repo.DeleteConfig(ctx, keyName)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFoundError("Config", keyName)
+	}
+	return nil
+}
+
+// RedactSecretConfig returns what the API may show of a secret config value: the reference
+// itself, or a mask for legacy plaintext secrets
+func RedactSecretConfig(value string) string {
+	if value == "" || secretstore.IsReference(value) {
+		return value
+	}
+	return redactedSecret
+}
+
+// redactSecretConfigRows masks the plaintext secrets of _System_Config rows read through the
+// data APIs. A row queried without is_secret is treated as secret.
+func redactSecretConfigRows(schema *models.ObjectMetadata, rows []models.SObject) {
+	if !strings.EqualFold(schema.APIName, constants.TableConfig) {
+		return
+	}
+	for _, row := range rows {
+		value, ok := row[constants.FieldValue].(string)
+		if !ok {
+			continue
+		}
+		switch isSecret := row[constants.FieldIsSecret].(type) {
+		case bool:
+			if !isSecret {
+				continue
+			}
+		case int64:
+			if isSecret == 0 {
+				continue
+			}
+		}
+		row[constants.FieldValue] = RedactSecretConfig(value)
+	}
+}
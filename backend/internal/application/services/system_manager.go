@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/infrastructure/secretstore"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 	"golang.org/x/crypto/bcrypt"
@@ -17,6 +18,7 @@ import (
 type SystemManager struct {
 	persistence *PersistenceService
 	repo        *persistence.SystemRepository
+	secrets     *secretstore.Resolver
 }
 
 // NewSystemManager creates a new SystemManager
@@ -24,9 +26,16 @@ func NewSystemManager(persistence *PersistenceService, repo *persistence.SystemR
 	return &SystemManager{
 		persistence: persistence,
 		repo:        repo,
+		secrets:     secretstore.NewResolver(0, secretstore.NewEnvStore()),
 	}
 }
 
+// SetSecrets sets the resolver for secret references in _System_Config, replacing the
+// default that only reads environment variables
+func (sm *SystemManager) SetSecrets(resolver *secretstore.Resolver) {
+	sm.secrets = resolver
+}
+
 // LogEvent logs a system event
 func (sm *SystemManager) LogEvent(ctx context.Context, level, source, message string, details *string) error {
 	// System Context not strictly needed if permission checks skipped for _System_Log?
@@ -55,7 +64,7 @@ func (sm *SystemManager) LogEvent(ctx context.Context, level, source, message st
 
 	// Just use "Log" constant if exists, else "_System_Log"
 	// I'll use "_System_Log" directly to be safe as previously viewed in system_tables.json
-	if _, err := sm.persistence.Insert(ctx, constants.TableLog, logEntry.ToSObject(), systemContext); err != nil {
+	if _, err := sm.persistence.Insert(WithSystemTableWrite(ctx), constants.TableLog, logEntry.ToSObject(), systemContext); err != nil {
 		// Log error to std out because logging failed?
 		log.Printf("Failed to log event: %v\n", err)
 		return err
@@ -68,35 +77,17 @@ func (sm *SystemManager) GetLogs(ctx context.Context, limit int) ([]*models.Syst
 	return sm.repo.GetLogs(ctx, limit)
 }
 
-// TrackRecent tracks a recently viewed record
+// TrackRecent tracks a recently viewed record.
+// Repeat views refresh the existing entry rather than appending history.
 func (sm *SystemManager) TrackRecent(ctx context.Context, userID, objectName, recordID, recordName string) error {
-	systemContext := &models.UserSession{
-		ID:        "system-tracker",
-		Name:      "System Tracker",
-		ProfileID: constants.ProfileSystemAdmin,
-	}
-
-	// Logic: Upsert based on UserID + RecordID?
-	// Check if exists
-	// _System_Recent should have unique constraint on user_id + record_id?
-	// Index exists: user_id.
-	// If duplicates allowed, history grows.
-	// Original code: `INSERT INTO ...`. No ON DUPLICATE.
-	// So it just Appends.
-	// I will just Insert.
-
-	recent := models.SystemRecent{
+	recent := &models.SystemRecent{
+		ID:            GenerateID(),
 		UserID:        userID,
 		ObjectAPIName: objectName,
 		RecordID:      recordID,
 		RecordName:    recordName,
-		Timestamp:     time.Now(),
 	}
-
-	if _, err := sm.persistence.Insert(ctx, constants.TableRecent, recent.ToSObject(), systemContext); err != nil {
-		return err
-	}
-	return nil
+	return sm.repo.UpsertRecentItem(ctx, recent)
 }
 
 // GetRecentItems retrieves recently viewed items for a user
@@ -107,51 +98,11 @@ func (sm *SystemManager) GetRecentItems(ctx context.Context, currentUser *models
 	return sm.repo.GetRecentItems(ctx, currentUser.ID, limit)
 }
 
-// GetConfig retrieves a system configuration value
-func (sm *SystemManager) GetConfig(ctx context.Context, key string) (*string, error) {
-	return sm.repo.GetConfig(ctx, key)
-}
-
-// GetAllConfigs retrieves all system configurations
-func (sm *SystemManager) GetAllConfigs(ctx context.Context) ([]*models.SystemConfig, error) {
+// GetLegacyConfigs retrieves the flat _System_Config entries superseded by custom settings
+func (sm *SystemManager) GetLegacyConfigs(ctx context.Context) ([]*models.SystemConfig, error) {
 	return sm.repo.GetAllConfigs(ctx)
 }
 
-// SetConfig sets a system configuration value
-func (sm *SystemManager) SetConfig(ctx context.Context, key string, value string, isSecret bool, description string) error {
-	systemContext := &models.UserSession{
-		ID:        "system-config-manager",
-		Name:      "System Config Manager",
-		ProfileID: constants.ProfileSystemAdmin,
-	}
-
-	config := models.SystemConfig{
-		KeyName:     key,
-		Value:       value,
-		IsSecret:    isSecret,
-		Description: description,
-	}
-
-	// Check existence
-	exists, err := sm.repo.CheckConfigExists(ctx, key)
-	if err != nil {
-		return fmt.Errorf("failed to check config existence: %w", err)
-	}
-
-	if exists {
-		// Update
-		if err := sm.persistence.Update(ctx, constants.TableConfig, key, config.ToSObject(), systemContext); err != nil {
-			return fmt.Errorf("failed to update config %s: %w", key, err)
-		}
-	} else {
-		// Insert
-		if _, err := sm.persistence.Insert(ctx, constants.TableConfig, config.ToSObject(), systemContext); err != nil {
-			return fmt.Errorf("failed to insert config %s: %w", key, err)
-		}
-	}
-	return nil
-}
-
 // UpsertProfile creates or updates a system profile
 func (sm *SystemManager) UpsertProfile(ctx context.Context, id, name, description string, isSystem bool) error {
 	systemContext := &models.UserSession{
@@ -177,12 +128,12 @@ func (sm *SystemManager) UpsertProfile(ctx context.Context, id, name, descriptio
 	if existingID != "" {
 		// Update
 		profile.ID = existingID
-		if err := sm.persistence.Update(ctx, constants.TableProfile, existingID, profile.ToSObject(), systemContext); err != nil {
+		if err := sm.persistence.Update(WithSystemTableWrite(ctx), constants.TableProfile, existingID, profile.ToSObject(), systemContext); err != nil {
 			return fmt.Errorf("failed to update profile %s: %w", name, err)
 		}
 	} else {
 		// Insert
-		if _, err := sm.persistence.Insert(ctx, constants.TableProfile, profile.ToSObject(), systemContext); err != nil {
+		if _, err := sm.persistence.Insert(WithSystemTableWrite(ctx), constants.TableProfile, profile.ToSObject(), systemContext); err != nil {
 			return fmt.Errorf("failed to insert profile %s: %w", name, err)
 		}
 	}
@@ -235,12 +186,12 @@ func (sm *SystemManager) UpsertUser(ctx context.Context, id, name, email, passwo
 	if existingID != "" {
 		// Update
 		user.ID = existingID // Force ID to match existing
-		if err := sm.persistence.Update(ctx, constants.TableUser, existingID, user.ToSObject(), systemContext); err != nil {
+		if err := sm.persistence.Update(WithSystemTableWrite(ctx), constants.TableUser, existingID, user.ToSObject(), systemContext); err != nil {
 			return fmt.Errorf("failed to update user %s: %w", email, err)
 		}
 	} else {
 		// Insert
-		if _, err := sm.persistence.Insert(ctx, constants.TableUser, user.ToSObject(), systemContext); err != nil {
+		if _, err := sm.persistence.Insert(WithSystemTableWrite(ctx), constants.TableUser, user.ToSObject(), systemContext); err != nil {
 			return fmt.Errorf("failed to insert user %s: %w", email, err)
 		}
 	}
@@ -251,40 +202,3 @@ func (sm *SystemManager) UpsertUser(ctx context.Context, id, name, email, passwo
 func (sm *SystemManager) BatchUpsertProfiles(ctx context.Context, profiles []models.SystemProfile) error {
 	return sm.repo.BatchUpsertProfiles(ctx, profiles)
 }
-
-// BatchUpsertUsers inserts multiple users using direct SQL for performance during bootstrap
-// Uses a dynamic cache for password hashing to avoid redundant BCrypt calls for identical passwords
-func (sm *SystemManager) BatchUpsertUsers(ctx context.Context, users []models.SystemUser) error {
-	if len(users) == 0 {
-		return nil
-	}
-
-	// Pre-calculated bcrypt hash for "Admin123!" (cost 10)
-	// This saves ~100ms per user during bootstrap
-	hashCache := make(map[string]string)
-
-	preparedUsers := make([]models.SystemUser, len(users))
-
-	for i, u := range users {
-		hashedPwd, ok := hashCache[u.Password]
-		if !ok {
-			h, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
-			if err != nil {
-				return fmt.Errorf("failed to hash password: %w", err)
-			}
-			hashedPwd = string(h)
-			hashCache[u.Password] = hashedPwd
-		}
-
-		// Calculate Names
-		// Caller (InitializeSystemData) is responsible for splitting Name into FirstName/LastName
-		// stored in u.FirstName and u.LastName
-
-		// Create a copy with hashed password
-		preparedUser := u
-		preparedUser.Password = hashedPwd
-		preparedUsers[i] = preparedUser
-	}
-
-	return sm.repo.BatchUpsertUsers(ctx, preparedUsers)
-}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SystemTableWritePolicy declares who may write a system table through PersistenceService, and
+// so through the generic data APIs, scripts, actions, flows and batch jobs. Object, field and
+// record permissions still apply on top.
+type SystemTableWritePolicy struct {
+	Profiles   []string // Profiles that may write the table; empty allows every profile
+	Operations []string // Operations allowed (constants.PermCreate, PermEdit, PermDelete); empty allows all
+}
+
+var adminWritePolicy = SystemTableWritePolicy{Profiles: []string{constants.ProfileSystemAdmin}}
+
+// systemTableWritePolicies lists the system tables the generic data APIs may write. Every other
+// system table (sessions, object and field permissions, metadata with its own endpoints) is
+// written only by the services that own it, in a WithSystemTableWrite context.
+var systemTableWritePolicies = map[string]SystemTableWritePolicy{
+	// Records users write from record pages and the agent
+	constants.TableRecordShare:    {},
+	constants.TableTeamMember:     {},
+	constants.TableComment:        {},
+	constants.TableFile:           {},
+	constants.TableAIConversation: {},
+	constants.TableNotification:   {Operations: []string{constants.PermEdit}}, // Marking as read
+
+	// Setup pages
+	constants.TableUser:                    adminWritePolicy,
+	constants.TableProfile:                 {Profiles: adminWritePolicy.Profiles, Operations: []string{constants.PermEdit, constants.PermDelete}},
+	constants.TablePermissionSet:           adminWritePolicy,
+	constants.TablePermissionSetAssignment: adminWritePolicy,
+	constants.TableGroup:                   adminWritePolicy,
+	constants.TableGroupMember:             adminWritePolicy,
+	constants.TableSharingRule:             adminWritePolicy,
+	constants.TableApprovalProcess:         adminWritePolicy,
+	constants.TableObject:                  adminWritePolicy,
+	constants.TableFlow:                    adminWritePolicy,
+}
+
+// systemTableWritePolicyIndex finds policies by lowercase table name, since the data APIs
+// lowercase object names
+var systemTableWritePolicyIndex = func() map[string]SystemTableWritePolicy {
+	index := make(map[string]SystemTableWritePolicy, len(systemTableWritePolicies))
+	for table, policy := range systemTableWritePolicies {
+		index[strings.ToLower(table)] = policy
+	}
+	return index
+}()
+
+type systemTableWriteKey struct{}
+
+// WithSystemTableWrite returns ctx in which record saves skip the system table write policy.
+// It is for the services that own the system tables they write (bootstrap, setup, approvals,
+// SCIM, notifications), never for writes whose object a user or configuration chose.
+func WithSystemTableWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemTableWriteKey{}, true)
+}
+
+// systemTableWriteAllowed reports whether record saves in ctx skip the system table write policy
+func systemTableWriteAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(systemTableWriteKey{}).(bool)
+	return allowed
+}
+
+// CheckSystemTableWrite rejects a write to a system table its policy does not open to the user.
+// Objects other than system tables are not restricted here.
+func CheckSystemTableWrite(objectName, operation string, user *models.UserSession) error {
+	if !strings.HasPrefix(strings.ToLower(objectName), strings.ToLower(constants.SystemTablePrefix)) {
+		return nil
+	}
+	policy, ok := systemTableWritePolicyIndex[strings.ToLower(objectName)]
+	if !ok {
+		return errors.NewPermissionError(operation, fmt.Sprintf("%s through the data API; it is managed by its own endpoints", objectName))
+	}
+	if len(policy.Operations) > 0 && !ContainsString(policy.Operations, operation) {
+		return errors.NewPermissionError(operation, fmt.Sprintf("%s records through the data API", objectName))
+	}
+	if len(policy.Profiles) > 0 && (user == nil || !ContainsString(policy.Profiles, user.ProfileID)) {
+		return errors.NewPermissionError(operation, fmt.Sprintf("%s records; only %s may", objectName, strings.Join(policy.Profiles, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistenceEnforcesSystemTableWritePolicy_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	notification := models.SObject{
+		constants.FieldSysNotification_RecipientID:      h.Admin.ID,
+		constants.FieldSysNotification_Title:            "Policy check",
+		constants.FieldSysNotification_Body:             "Written by the service that owns the table",
+		constants.FieldSysNotification_Link:             "/notifications",
+		constants.FieldSysNotification_NotificationType: "system",
+	}
+
+	_, err := h.Services.Persistence.Insert(ctx, constants.TableNotification, notification, h.Admin)
+	require.Error(t, err)
+	assert.True(t, errors.IsPermission(err), "got %v", err)
+
+	created, err := h.Services.Persistence.Insert(services.WithSystemTableWrite(ctx), constants.TableNotification, notification, h.Admin)
+	require.NoError(t, err)
+	id := created.GetString(constants.FieldID)
+
+	// Marking as read is open to the data APIs
+	require.NoError(t, h.Services.Persistence.Update(ctx, constants.TableNotification, id, models.SObject{constants.FieldSysNotification_IsRead: true}, h.Admin))
+
+	err = h.Services.Persistence.Delete(ctx, constants.TableNotification, id, h.Admin)
+	assert.True(t, errors.IsPermission(err), "got %v", err)
+}
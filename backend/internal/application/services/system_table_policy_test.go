@@ -0,0 +1,33 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSystemTableWrite(t *testing.T) {
+	admin := GetTestUser("admin", constants.ProfileSystemAdmin)
+	user := GetTestUser("user", constants.ProfileStandardUser)
+
+	// Business objects are not restricted
+	assert.NoError(t, CheckSystemTableWrite("account", constants.PermDelete, user))
+
+	// Tables without a policy are closed to everyone, whatever the case of the name
+	assert.True(t, errors.IsPermission(CheckSystemTableWrite(constants.TableSession, constants.PermCreate, admin)))
+	assert.True(t, errors.IsPermission(CheckSystemTableWrite(strings.ToLower(constants.TableObjectPerms), constants.PermEdit, admin)))
+
+	// Setup tables are open to admins only
+	assert.NoError(t, CheckSystemTableWrite(strings.ToLower(constants.TablePermissionSetAssignment), constants.PermCreate, admin))
+	assert.True(t, errors.IsPermission(CheckSystemTableWrite(constants.TablePermissionSetAssignment, constants.PermCreate, user)))
+
+	// Operations a policy does not list are rejected
+	assert.NoError(t, CheckSystemTableWrite(constants.TableNotification, constants.PermEdit, user))
+	assert.True(t, errors.IsPermission(CheckSystemTableWrite(constants.TableNotification, constants.PermCreate, admin)))
+	assert.True(t, errors.IsPermission(CheckSystemTableWrite(constants.TableProfile, constants.PermCreate, admin)))
+
+	assert.NoError(t, CheckSystemTableWrite(constants.TableRecordShare, constants.PermCreate, user))
+}
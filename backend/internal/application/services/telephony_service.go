@@ -0,0 +1,405 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/infrastructure/telephony"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultTelephonyMatchFields applies when TELEPHONY_MATCH_FIELDS is unset
+	defaultTelephonyMatchFields = "contact.phone"
+	// phoneMatchDigits is how many trailing digits two numbers must share to match, which
+	// ignores country prefixes and formatting
+	phoneMatchDigits = 10
+	// phoneMinDigits is the fewest digits a matchable number has
+	phoneMinDigits = 7
+	// phoneFilterDigits are matched in the database; candidates are compared in full after
+	phoneFilterDigits = 4
+	// phoneMatchLimit caps the candidate rows read per match field
+	phoneMatchLimit = 500
+)
+
+// phoneMatch is a record a phone number matched
+type phoneMatch struct {
+	recordRef
+	OwnerID string
+}
+
+// TelephonyService places click-to-dial calls, logs calls and turns provider call events into
+// Call activities on the records whose phone fields match the other party
+type TelephonyService struct {
+	repo        *persistence.SyncRepository
+	providers   *telephony.Registry
+	users       *persistence.UserRepository
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	matchFields []SyncMatchField
+	stats       *RecordStatsService // nil leaves last activity dates alone
+}
+
+// NewTelephonyService creates a new TelephonyService
+func NewTelephonyService(repo *persistence.SyncRepository, providers *telephony.Registry, users *persistence.UserRepository, metadata *MetadataService, query *QueryService, permissions *PermissionService, matchFields []SyncMatchField) *TelephonyService {
+	return &TelephonyService{
+		repo:        repo,
+		providers:   providers,
+		users:       users,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		matchFields: matchFields,
+	}
+}
+
+// telephonyMatchFields parses TELEPHONY_MATCH_FIELDS, a comma-separated list of
+// object.field phone fields (default "contact.phone")
+func telephonyMatchFields(raw string) []SyncMatchField {
+	if raw == "" {
+		raw = defaultTelephonyMatchFields
+	}
+	return parseSyncMatchFields(raw)
+}
+
+// SetRecordStats moves the last activity date of the records calls are logged on
+func (s *TelephonyService) SetRecordStats(stats *RecordStatsService) {
+	s.stats = stats
+}
+
+// Providers lists the configured telephony providers
+func (s *TelephonyService) Providers() []constants.TelephonyProvider {
+	return s.providers.Names()
+}
+
+// Dial rings the current user's phone and connects them to a number. The call is logged on
+// the given record, or on the records whose phone fields match the number.
+func (s *TelephonyService) Dial(ctx context.Context, req models.ClickToDialRequest, currentUser *models.UserSession) (*models.CallResult, error) {
+	p, err := s.providers.Dialer()
+	if err != nil {
+		return nil, errors.NewValidationError("provider", err.Error())
+	}
+	to := strings.TrimSpace(req.To)
+	if len(phoneDigits(to)) < phoneMinDigits {
+		return nil, errors.NewValidationError("to", "A valid phone number is required")
+	}
+	user, err := s.users.GetUserByID(ctx, currentUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if user == nil || user.Phone == nil || *user.Phone == "" {
+		return nil, errors.NewValidationError("phone", "Set a phone number on your user to use click-to-dial")
+	}
+
+	var refs []phoneMatch
+	if req.ObjectAPIName != "" || req.RecordID != "" {
+		ref, err := s.readableRecord(ctx, req.ObjectAPIName, req.RecordID, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		refs = []phoneMatch{*ref}
+	} else if refs, err = s.matchPhone(ctx, to); err != nil {
+		return nil, err
+	}
+
+	callID, err := p.Dial(ctx, telephony.DialRequest{AgentNumber: *user.Phone, To: to})
+	if err != nil {
+		return nil, errors.NewValidationError("to", err.Error())
+	}
+
+	call := &models.SystemActivity{
+		ActivityType:  constants.ActivityTypeCall,
+		Subject:       optionalString("Call to " + to),
+		FromAddress:   user.Phone,
+		ToAddresses:   &to,
+		ActivityDate:  time.Now().UTC(),
+		CallDirection: optionalString(constants.CallDirectionOutbound),
+		CallStatus:    optionalString(constants.CallStatusInitiated),
+		ConnectorID:   string(p.Name()),
+		ExternalID:    callID,
+		OwnerID:       currentUser.ID,
+	}
+	activities := callActivities(call, refs, currentUser.ID)
+	if err := s.repo.InsertActivities(ctx, activities); err != nil {
+		return nil, err
+	}
+	s.stats.RecordActivities(ctx, activities)
+	return &models.CallResult{CallID: callID, Activities: activities}, nil
+}
+
+// LogCall records a call made outside the telephony integration on a record the user can read
+func (s *TelephonyService) LogCall(ctx context.Context, req models.CallLogRequest, currentUser *models.UserSession) (*models.SystemActivity, error) {
+	ref, err := s.readableRecord(ctx, req.ObjectAPIName, req.RecordID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	direction := req.Direction
+	if direction == "" {
+		direction = constants.CallDirectionOutbound
+	}
+	if direction != constants.CallDirectionInbound && direction != constants.CallDirectionOutbound {
+		return nil, errors.NewValidationError("direction", "Direction must be inbound or outbound")
+	}
+	status := req.Status
+	if status == "" {
+		status = constants.CallStatusCompleted
+	}
+	if !isCallStatus(status) {
+		return nil, errors.NewValidationError("status", fmt.Sprintf("Unknown call status: %s", status))
+	}
+	if req.Duration < 0 {
+		return nil, errors.NewValidationError("duration", "Duration cannot be negative")
+	}
+	callDate := time.Now().UTC()
+	if req.CallDate != nil {
+		callDate = req.CallDate.UTC()
+	}
+	subject := req.Subject
+	if subject == "" {
+		subject = "Call"
+	}
+
+	call := &models.SystemActivity{
+		ID:            utils.GenerateID(),
+		ActivityType:  constants.ActivityTypeCall,
+		Subject:       &subject,
+		Description:   optionalString(req.Description),
+		ActivityDate:  callDate,
+		ObjectAPIName: ref.Object,
+		RecordID:      ref.ID,
+		CallDirection: &direction,
+		CallStatus:    &status,
+		ConnectorID:   string(constants.TelephonyProviderManual),
+		OwnerID:       currentUser.ID,
+	}
+	call.ExternalID = call.ID
+	if req.Duration > 0 {
+		duration := req.Duration
+		end := callDate.Add(time.Duration(duration) * time.Second)
+		call.CallDuration = &duration
+		call.EndDate = &end
+	}
+	if direction == constants.CallDirectionInbound {
+		call.FromAddress = optionalString(req.PhoneNumber)
+	} else {
+		call.ToAddresses = optionalString(req.PhoneNumber)
+	}
+	if err := s.repo.InsertActivities(ctx, []*models.SystemActivity{call}); err != nil {
+		return nil, err
+	}
+	s.stats.RecordActivities(ctx, []*models.SystemActivity{call})
+	return call, nil
+}
+
+// HandleEvent processes a provider's webhook request. Events of a logged call update its
+// activities; the first event of any other call logs it on the records whose phone fields
+// match the other party, owned by the user whose phone took part or else the record owner.
+func (s *TelephonyService) HandleEvent(ctx context.Context, provider constants.TelephonyProvider, r *http.Request) error {
+	p, err := s.providers.Get(provider)
+	if err != nil {
+		return errors.NewNotFoundError("TelephonyProvider", string(provider))
+	}
+	event, err := p.ParseEvent(r)
+	if err != nil {
+		if stderrors.Is(err, telephony.ErrInvalidSignature) {
+			return errors.NewUnauthorizedError(err.Error())
+		}
+		return errors.NewValidationError("body", err.Error())
+	}
+
+	existing, err := s.repo.ExistingActivities(ctx, string(provider), []string{event.CallID})
+	if err != nil {
+		return err
+	}
+	var endDate *time.Time
+	if isFinalCallStatus(event.Status) {
+		endDate = &event.Timestamp
+	}
+	var recordingURL *string
+	if event.RecordingURL != "" {
+		recordingURL = &event.RecordingURL
+	}
+	if len(existing) > 0 {
+		return s.repo.UpdateCall(ctx, string(provider), event.CallID, event.Status, event.Duration, endDate, recordingURL)
+	}
+
+	party, agent := event.To, event.From
+	if event.Direction == constants.CallDirectionInbound {
+		party, agent = event.From, event.To
+	}
+	refs, err := s.matchPhone(ctx, party)
+	if err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	ownerID := ""
+	if users, err := s.matchPhoneField(ctx, SyncMatchField{Object: constants.TableUser, Field: constants.FieldSysUser_Phone}, agent); err != nil {
+		log.Printf("⚠️ [Telephony] Failed to match agent %s: %v", agent, err)
+	} else if len(users) > 0 {
+		ownerID = users[0].ID
+	}
+
+	subject := "Call with " + party
+	if event.Direction == constants.CallDirectionInbound {
+		subject = "Call from " + party
+	}
+	start := event.Timestamp
+	if event.Duration != nil && endDate != nil {
+		start = endDate.Add(-time.Duration(*event.Duration) * time.Second)
+	}
+	call := &models.SystemActivity{
+		ActivityType:  constants.ActivityTypeCall,
+		Subject:       &subject,
+		FromAddress:   optionalString(event.From),
+		ToAddresses:   optionalString(event.To),
+		ActivityDate:  start,
+		EndDate:       endDate,
+		CallDirection: &event.Direction,
+		CallStatus:    &event.Status,
+		CallDuration:  event.Duration,
+		RecordingURL:  recordingURL,
+		ConnectorID:   string(provider),
+		ExternalID:    event.CallID,
+	}
+	activities := callActivities(call, refs, ownerID)
+	if err := s.repo.InsertActivities(ctx, activities); err != nil {
+		return err
+	}
+	s.stats.RecordActivities(ctx, activities)
+	return nil
+}
+
+// readableRecord resolves a record the user can read; others are reported as not found
+func (s *TelephonyService) readableRecord(ctx context.Context, objectAPIName, recordID string, currentUser *models.UserSession) (*phoneMatch, error) {
+	if objectAPIName == "" || recordID == "" {
+		return nil, errors.NewValidationError("record_id", "Both object_api_name and record_id are required")
+	}
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	return &phoneMatch{recordRef: recordRef{Object: schema.APIName, ID: recordID}, OwnerID: records[0].GetString(constants.FieldOwnerID)}, nil
+}
+
+// matchPhone finds the records whose match fields hold the number, read as the system
+func (s *TelephonyService) matchPhone(ctx context.Context, number string) ([]phoneMatch, error) {
+	matches := make([]phoneMatch, 0)
+	for _, mf := range s.matchFields {
+		found, err := s.matchPhoneField(ctx, mf, number)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, found...)
+	}
+	return matches, nil
+}
+
+// matchPhoneField narrows the rows down by the number's last digits in the database and
+// compares the candidates' digits in full
+func (s *TelephonyService) matchPhoneField(ctx context.Context, mf SyncMatchField, number string) ([]phoneMatch, error) {
+	digits := phoneDigits(number)
+	if len(digits) < phoneMinDigits {
+		return nil, nil
+	}
+	schema := s.metadata.GetSchema(ctx, mf.Object)
+	if schema == nil || FindField(schema, mf.Field) == nil {
+		log.Printf("⚠️ [Telephony] Match field %s.%s does not exist", mf.Object, mf.Field)
+		return nil, nil
+	}
+	rows, err := s.query.Query(ctx, models.QueryRequest{
+		ObjectAPIName:   schema.APIName,
+		FilterExpr:      fmt.Sprintf("ENDS_WITH(%s, %s)", mf.Field, strconv.Quote(digits[len(digits)-phoneFilterDigits:])),
+		Limit:           phoneMatchLimit,
+		SkipLookupNames: true,
+	}, syncSystemContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to match %s.%s: %w", mf.Object, mf.Field, err)
+	}
+	matches := make([]phoneMatch, 0)
+	for _, row := range rows {
+		if phoneNumbersMatch(row.GetString(mf.Field), number) {
+			matches = append(matches, phoneMatch{
+				recordRef: recordRef{Object: schema.APIName, ID: row.GetString(constants.FieldID)},
+				OwnerID:   row.GetString(constants.FieldOwnerID),
+			})
+		}
+	}
+	return matches, nil
+}
+
+// callActivities copies a call onto each matched record. ownerID owns them all when set;
+// otherwise each is owned by its record's owner.
+func callActivities(call *models.SystemActivity, refs []phoneMatch, ownerID string) []*models.SystemActivity {
+	activities := make([]*models.SystemActivity, 0, len(refs))
+	for _, ref := range refs {
+		activity := *call
+		activity.ID = utils.GenerateID()
+		activity.ObjectAPIName = ref.Object
+		activity.RecordID = ref.ID
+		activity.OwnerID = ownerID
+		if activity.OwnerID == "" {
+			activity.OwnerID = ref.OwnerID
+		}
+		activities = append(activities, &activity)
+	}
+	return activities
+}
+
+// phoneDigits strips a phone number down to its digits
+func phoneDigits(number string) string {
+	var b strings.Builder
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// phoneNumbersMatch compares the last phoneMatchDigits digits of two numbers, so
+// "+1 (555) 123-4567" matches "555.123.4567"
+func phoneNumbersMatch(a, b string) bool {
+	da, db := phoneDigits(a), phoneDigits(b)
+	if len(da) < phoneMinDigits || len(db) < phoneMinDigits {
+		return false
+	}
+	n := min(phoneMatchDigits, len(da), len(db))
+	return da[len(da)-n:] == db[len(db)-n:]
+}
+
+func isCallStatus(status string) bool {
+	switch status {
+	case constants.CallStatusInitiated, constants.CallStatusRinging, constants.CallStatusInProgress:
+		return true
+	}
+	return isFinalCallStatus(status)
+}
+
+func isFinalCallStatus(status string) bool {
+	switch status {
+	case constants.CallStatusCompleted, constants.CallStatusBusy, constants.CallStatusNoAnswer,
+		constants.CallStatusFailed, constants.CallStatusCanceled:
+		return true
+	}
+	return false
+}
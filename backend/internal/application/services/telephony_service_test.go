@@ -0,0 +1,36 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneNumbersMatch(t *testing.T) {
+	assert.True(t, phoneNumbersMatch("+1 (555) 123-4567", "555.123.4567"))
+	assert.True(t, phoneNumbersMatch("+44 20 7946 0958", "020 7946 0958"))
+	assert.False(t, phoneNumbersMatch("+1 555 123 4567", "+1 555 123 4568"))
+	assert.False(t, phoneNumbersMatch("4567", "555-4567"), "too short to match")
+}
+
+func TestCallActivities(t *testing.T) {
+	call := &models.SystemActivity{ActivityType: constants.ActivityTypeCall, ConnectorID: "twilio", ExternalID: "CA1"}
+	refs := []phoneMatch{
+		{recordRef: recordRef{Object: "contact", ID: "c1"}, OwnerID: "u1"},
+		{recordRef: recordRef{Object: "lead", ID: "l1"}, OwnerID: "u2"},
+	}
+
+	activities := callActivities(call, refs, "")
+	require.Len(t, activities, 2)
+	assert.Equal(t, "c1", activities[0].RecordID)
+	assert.Equal(t, "u1", activities[0].OwnerID, "without an agent the record owner owns the call")
+	assert.Equal(t, "u2", activities[1].OwnerID)
+	assert.NotEqual(t, activities[0].ID, activities[1].ID)
+	assert.Equal(t, "CA1", activities[1].ExternalID)
+
+	activities = callActivities(call, refs, "agent")
+	assert.Equal(t, "agent", activities[1].OwnerID)
+}
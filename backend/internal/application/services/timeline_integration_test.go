@@ -0,0 +1,131 @@
+package services_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeline_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+	ctx := h.Context(t)
+	db := h.DB.DB()
+
+	deal := h.CreateObject(t, "deal", testharness.Field("name", constants.FieldTypeText), testharness.Field("amount", constants.FieldTypeNumber))
+	task := h.CreateObject(t, "task", testharness.Field("subject", constants.FieldTypeText), testharness.Field("what_id", constants.FieldTypeText),
+		testharness.Field("status", constants.FieldTypeText))
+	record := h.CreateRecord(t, deal.APIName, models.SObject{"name": "Big deal", "amount": 100})
+	recordID := record.GetString(constants.FieldID)
+	svc := services.NewTimelineService(persistence.NewTimelineRepository(db), h.Services.Metadata, h.Services.QuerySvc, h.Services.Permissions, task.APIName, "what_id")
+
+	// One entry of every kind: the field change now, the others at fixed offsets in the past.
+	// Dates are given explicitly so SQLite stores them all in the same text format.
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, h.Services.Persistence.Update(ctx, deal.APIName, recordID, models.SObject{"amount": 250}, h.Admin))
+	_, err := h.Services.Feed.CreateComment(ctx, models.SystemComment{ObjectAPIName: deal.APIName, RecordID: recordID, Body: "Call them back",
+		CreatedDate: now.Add(-10 * time.Minute)}, h.Admin)
+	require.NoError(t, err)
+	h.CreateRecord(t, task.APIName, models.SObject{"subject": "Send proposal", "what_id": recordID, "status": "Open",
+		constants.FieldCreatedDate: now.Add(-20 * time.Minute)})
+
+	subject := "Pricing"
+	require.NoError(t, persistence.NewSyncRepository(db).InsertActivities(ctx, []*models.SystemActivity{{
+		ID: services.GenerateID(), ActivityType: constants.ActivityTypeEmail, Subject: &subject, ActivityDate: now.Add(-time.Hour),
+		ObjectAPIName: deal.APIName, RecordID: recordID, ConnectorID: "timeline-test", ExternalID: "m1", OwnerID: h.Admin.ID,
+	}}))
+	processID, workItemID, flowID, instanceID := services.GenerateID(), services.GenerateID(), services.GenerateID(), services.GenerateID()
+	for _, stmt := range []struct {
+		sql    string
+		params []interface{}
+	}{
+		{fmt.Sprintf("INSERT INTO `%s` (`%s`, `%s`, `%s`) VALUES (?, ?, ?)", constants.TableApprovalProcess,
+			constants.FieldID, constants.FieldSysApprovalProcess_Name, constants.FieldSysApprovalProcess_ObjectAPIName),
+			[]interface{}{processID, "Discount approval", deal.APIName}},
+		{fmt.Sprintf("INSERT INTO `%s` (`%s`, `%s`, `%s`, `%s`, `%s`, `%s`) VALUES (?, ?, ?, ?, ?, ?)", constants.TableApprovalWorkItem,
+			constants.FieldID, constants.FieldSysApprovalWorkItem_ProcessID, constants.FieldSysApprovalWorkItem_ObjectAPIName,
+			constants.FieldSysApprovalWorkItem_RecordID, constants.FieldSysApprovalWorkItem_SubmittedByID, constants.FieldSysApprovalWorkItem_SubmittedDate),
+			[]interface{}{workItemID, processID, deal.APIName, recordID, h.Admin.ID, now.Add(-2 * time.Hour)}},
+		{fmt.Sprintf("INSERT INTO `%s` (`%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`) VALUES (?, ?, ?, ?, ?, ?, ?)", constants.TableFlow,
+			constants.FieldID, constants.FieldSysFlow_Name, constants.FieldSysFlow_TriggerObject, constants.FieldSysFlow_TriggerType,
+			constants.FieldSysFlow_TriggerCondition, constants.FieldSysFlow_ActionType, constants.FieldSysFlow_ActionConfig),
+			[]interface{}{flowID, "Welcome", deal.APIName, "afterCreate", "true", "updateRecord", "{}"}},
+		{fmt.Sprintf("INSERT INTO `%s` (`%s`, `%s`, `%s`, `%s`, `%s`) VALUES (?, ?, ?, ?, ?)", constants.TableFlowInstance,
+			constants.FieldID, constants.FieldSysFlowInstance_FlowID, constants.FieldSysFlowInstance_ObjectAPIName,
+			constants.FieldSysFlowInstance_RecordID, constants.FieldSysFlowInstance_StartedDate),
+			[]interface{}{instanceID, flowID, deal.APIName, recordID, now.Add(-3 * time.Hour)}},
+	} {
+		_, err := db.ExecContext(ctx, stmt.sql, stmt.params...)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", constants.TableFlowInstance, constants.FieldID), instanceID)
+		_, _ = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", constants.TableFlow, constants.FieldID), flowID)
+		_, _ = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", constants.TableApprovalWorkItem, constants.FieldID), workItemID)
+		_, _ = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", constants.TableApprovalProcess, constants.FieldID), processID)
+		_, _ = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", constants.TableActivity, constants.FieldSysActivity_ConnectorID), "timeline-test")
+	})
+
+	page, err := svc.GetTimeline(ctx, deal.APIName, recordID, services.TimelineQuery{}, h.Admin)
+	require.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	byType := make(map[string]*models.TimelineEntry)
+	for _, e := range page.Entries {
+		byType[e.Type] = e
+	}
+	for _, entryType := range []string{constants.TimelineEntryComment, constants.TimelineEntryFieldChange, constants.TimelineEntryTask,
+		constants.TimelineEntryEmail, constants.TimelineEntryApproval, constants.TimelineEntryFlow} {
+		require.Contains(t, byType, entryType)
+	}
+	assert.Equal(t, "Send proposal", byType[constants.TimelineEntryTask].Title)
+	assert.Equal(t, "Open", byType[constants.TimelineEntryTask].Details["status"])
+	assert.Equal(t, "Pricing", byType[constants.TimelineEntryEmail].Title)
+	assert.Equal(t, "Discount approval", byType[constants.TimelineEntryApproval].Title)
+	assert.Equal(t, "Welcome", byType[constants.TimelineEntryFlow].Title)
+	assert.Equal(t, "250", byType[constants.TimelineEntryFieldChange].Details[constants.FieldSysAuditLog_NewValue])
+	assert.Equal(t, constants.TimelineEntryFlow, page.Entries[len(page.Entries)-1].Type, "oldest last")
+	for i := 1; i < len(page.Entries); i++ {
+		assert.False(t, page.Entries[i].Date.After(page.Entries[i-1].Date), "newest first")
+	}
+
+	// Small pages walk the same stream without gaps or repeats
+	seen := make([]string, 0, len(page.Entries))
+	q := services.TimelineQuery{Limit: 2}
+	for pages := 0; pages < 10; pages++ {
+		p, err := svc.GetTimeline(ctx, deal.APIName, recordID, q, h.Admin)
+		require.NoError(t, err)
+		for _, e := range p.Entries {
+			seen = append(seen, e.ID)
+		}
+		if p.NextCursor == "" {
+			break
+		}
+		q.Cursor = p.NextCursor
+	}
+	all := make([]string, len(page.Entries))
+	for i, e := range page.Entries {
+		all[i] = e.ID
+	}
+	assert.Equal(t, all, seen)
+
+	// Type filters
+	emails, err := svc.GetTimeline(ctx, deal.APIName, recordID, services.TimelineQuery{Types: []string{"email", "flow"}}, h.Admin)
+	require.NoError(t, err)
+	require.Len(t, emails.Entries, 2)
+	assert.Equal(t, constants.TimelineEntryEmail, emails.Entries[0].Type)
+	assert.Equal(t, constants.TimelineEntryFlow, emails.Entries[1].Type)
+	_, err = svc.GetTimeline(ctx, deal.APIName, recordID, services.TimelineQuery{Types: []string{"tweet"}}, h.Admin)
+	require.Error(t, err)
+	_, err = svc.GetTimeline(ctx, deal.APIName, "missing", services.TimelineQuery{}, h.Admin)
+	require.Error(t, err)
+}
@@ -0,0 +1,352 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// defaultTimelineTaskLink is the task object and the field of it pointing at a record
+	defaultTimelineTaskLink = "task.what_id"
+	// defaultTimelinePageSize and maxTimelinePageSize bound the entries of a timeline page
+	defaultTimelinePageSize = 25
+	maxTimelinePageSize     = 100
+)
+
+// timelineActivityTypes maps timeline entry types to _System_Activity activity types
+var timelineActivityTypes = map[string]string{
+	constants.TimelineEntryEmail: constants.ActivityTypeEmail,
+	constants.TimelineEntryEvent: constants.ActivityTypeEvent,
+	constants.TimelineEntryCall:  constants.ActivityTypeCall,
+}
+
+// timelineTaskLink parses TIMELINE_TASK_LINK, the task object and its field pointing at the
+// record a task is about, as object.field (default task.what_id)
+func timelineTaskLink(raw string) (string, string) {
+	if raw == "" {
+		raw = defaultTimelineTaskLink
+	}
+	object, field, ok := strings.Cut(strings.TrimSpace(raw), ".")
+	if !ok || object == "" || field == "" {
+		log.Printf("⚠️  Invalid TIMELINE_TASK_LINK %q, using %s", raw, defaultTimelineTaskLink)
+		object, field, _ = strings.Cut(defaultTimelineTaskLink, ".")
+	}
+	return strings.ToLower(object), strings.ToLower(field)
+}
+
+// TimelineService merges what happened to a record — comments, field changes, tasks,
+// events, emails, calls, approvals and flow runs — into one stream, newest first. Pages
+// continue from an opaque cursor, so entries added while paging do not shift later pages.
+type TimelineService struct {
+	repo        *persistence.TimelineRepository
+	metadata    *MetadataService
+	query       *QueryService
+	permissions *PermissionService
+	taskObject  string
+	taskField   string
+}
+
+// NewTimelineService creates a new TimelineService
+func NewTimelineService(
+	repo *persistence.TimelineRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	permissions *PermissionService,
+	taskObject, taskField string,
+) *TimelineService {
+	return &TimelineService{
+		repo:        repo,
+		metadata:    metadata,
+		query:       query,
+		permissions: permissions,
+		taskObject:  taskObject,
+		taskField:   taskField,
+	}
+}
+
+// TimelineQuery selects a page of a record's timeline
+type TimelineQuery struct {
+	Types  []string // Entry types to include; empty includes all
+	Cursor string   // NextCursor of the previous page; empty for the newest entries
+	Limit  int      // Page size, default 25, at most 100
+}
+
+// timelineSource is what one source returned for a page. When it returned more rows than
+// the page holds, boundary is its last row within the page: older rows of it are unseen.
+type timelineSource struct {
+	entries  []*models.TimelineEntry
+	boundary *models.TimelineEntry
+}
+
+// GetTimeline returns a page of the timeline of a record the user can read. Field changes
+// leave out the fields hidden from the user and tasks the tasks they cannot read.
+func (s *TimelineService) GetTimeline(ctx context.Context, objectAPIName, recordID string, q TimelineQuery, currentUser *models.UserSession) (*models.TimelinePage, error) {
+	schema := s.metadata.GetSchema(ctx, objectAPIName)
+	if schema == nil {
+		return nil, errors.NewNotFoundError("Object", objectAPIName)
+	}
+	rows, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, rows[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+
+	types, err := timelineTypes(q.Types)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeTimelineCursor(q.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultTimelinePageSize
+	}
+	limit = min(limit, maxTimelinePageSize)
+	// One row more than the page holds tells whether a source continues past it
+	fetch := limit + 1
+
+	sources := make([]timelineSource, 0, len(types))
+	add := func(entries []*models.TimelineEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		sources = append(sources, newTimelineSource(entries, limit))
+		return nil
+	}
+
+	if types[constants.TimelineEntryComment] {
+		if err := add(s.repo.Comments(ctx, schema.APIName, recordID, cursor, fetch)); err != nil {
+			return nil, err
+		}
+	}
+	if types[constants.TimelineEntryFieldChange] {
+		labels := make(map[string]string, len(schema.Fields))
+		hidden := make([]string, 0)
+		for _, f := range schema.Fields {
+			labels[f.APIName] = f.Label
+			if !s.permissions.CheckFieldVisibilityWithUser(ctx, schema.APIName, f.APIName, currentUser) {
+				hidden = append(hidden, f.APIName)
+			}
+		}
+		entries, err := s.repo.FieldChanges(ctx, schema.APIName, recordID, hidden, cursor, fetch)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if label := labels[e.Title]; label != "" {
+				e.Title = label
+			}
+		}
+		sources = append(sources, newTimelineSource(entries, limit))
+	}
+	if types[constants.TimelineEntryTask] {
+		source, err := s.taskSource(ctx, recordID, cursor, limit, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		if source != nil {
+			sources = append(sources, *source)
+		}
+	}
+	activityTypes := make([]string, 0, len(timelineActivityTypes))
+	for _, entryType := range constants.TimelineEntryTypes {
+		if activityType, ok := timelineActivityTypes[entryType]; ok && types[entryType] {
+			activityTypes = append(activityTypes, activityType)
+		}
+	}
+	if len(activityTypes) > 0 {
+		if err := add(s.repo.Activities(ctx, schema.APIName, recordID, activityTypes, cursor, fetch)); err != nil {
+			return nil, err
+		}
+	}
+	if types[constants.TimelineEntryApproval] {
+		if err := add(s.repo.Approvals(ctx, schema.APIName, recordID, cursor, fetch)); err != nil {
+			return nil, err
+		}
+	}
+	if types[constants.TimelineEntryFlow] {
+		if err := add(s.repo.FlowRuns(ctx, schema.APIName, recordID, cursor, fetch)); err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeTimeline(sources, limit), nil
+}
+
+// taskSource returns the tasks about a record the user can read, or nil when there is no
+// task object or link field the user can read
+func (s *TimelineService) taskSource(ctx context.Context, recordID string, cursor *persistence.TimelineCursor, limit int, currentUser *models.UserSession) (*timelineSource, error) {
+	taskSchema := s.metadata.GetSchema(ctx, s.taskObject)
+	if taskSchema == nil || !s.permissions.CheckObjectPermissionWithUser(ctx, taskSchema.APIName, constants.PermRead, currentUser) {
+		return nil, nil
+	}
+	linkField := ""
+	for _, f := range taskSchema.Fields {
+		if strings.EqualFold(f.APIName, s.taskField) {
+			linkField = f.APIName
+		}
+	}
+	if linkField == "" || !s.permissions.CheckFieldVisibilityWithUser(ctx, taskSchema.APIName, linkField, currentUser) {
+		return nil, nil
+	}
+
+	entries, err := s.repo.Tasks(ctx, taskSchema.APIName, linkField, recordID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	// The boundary comes from the rows read, including those the user cannot see
+	source := newTimelineSource(entries, limit)
+	if len(entries) == 0 {
+		return &source, nil
+	}
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	records, err := s.query.QueryByIDs(ctx, taskSchema.APIName, ids, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]models.SObject, len(records))
+	for _, record := range records {
+		if s.permissions.CheckRecordAccess(ctx, taskSchema, record, constants.PermRead, currentUser) {
+			byID[record.GetString(constants.FieldID)] = record
+		}
+	}
+
+	nameField := GetNameFieldAPIName(taskSchema)
+	visible := make([]*models.TimelineEntry, 0, len(entries))
+	for _, e := range entries {
+		record, ok := byID[e.ID]
+		if !ok {
+			continue
+		}
+		if nameField != "" {
+			e.Title = record.GetString(nameField)
+		}
+		if e.Title == "" {
+			e.Title = record.GetString("subject")
+		}
+		taskDetails := make(map[string]interface{})
+		for _, field := range []string{"status", "priority", "due_date"} {
+			if value, ok := record[field]; ok && value != nil {
+				taskDetails[field] = value
+			}
+		}
+		if len(taskDetails) > 0 {
+			e.Details = taskDetails
+		}
+		visible = append(visible, e)
+	}
+	source.entries = visible
+	return &source, nil
+}
+
+// newTimelineSource wraps the rows a source returned for a page of limit entries
+func newTimelineSource(entries []*models.TimelineEntry, limit int) timelineSource {
+	source := timelineSource{entries: entries}
+	if len(entries) > limit {
+		source.boundary = entries[limit-1]
+	}
+	return source
+}
+
+// mergeTimeline merges the sources of a page newest first. Entries older than the newest
+// boundary are left to the next page, since a source with a boundary may have unseen rows
+// newer than them.
+func mergeTimeline(sources []timelineSource, limit int) *models.TimelinePage {
+	var horizon *models.TimelineEntry
+	for _, source := range sources {
+		if source.boundary != nil && (horizon == nil || timelineAfter(source.boundary, horizon)) {
+			horizon = source.boundary
+		}
+	}
+
+	entries := make([]*models.TimelineEntry, 0, limit)
+	for _, source := range sources {
+		for _, e := range source.entries {
+			if horizon == nil || !timelineAfter(horizon, e) {
+				entries = append(entries, e)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return timelineAfter(entries[i], entries[j]) })
+
+	page := &models.TimelinePage{Entries: entries}
+	switch {
+	case len(entries) > limit:
+		page.Entries = entries[:limit]
+		page.NextCursor = encodeTimelineCursor(entries[limit-1])
+	case horizon != nil:
+		page.NextCursor = encodeTimelineCursor(horizon)
+	}
+	return page
+}
+
+// timelineAfter reports whether entry a comes before entry b in a timeline: it is newer,
+// or as new with a higher ID
+func timelineAfter(a, b *models.TimelineEntry) bool {
+	if !a.Date.Equal(b.Date) {
+		return a.Date.After(b.Date)
+	}
+	return a.ID > b.ID
+}
+
+// timelineTypes validates the requested entry types; none requests all
+func timelineTypes(requested []string) (map[string]bool, error) {
+	types := make(map[string]bool, len(constants.TimelineEntryTypes))
+	for _, t := range requested {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if !ContainsString(constants.TimelineEntryTypes, t) {
+			return nil, errors.NewValidationError("types", "unknown timeline type "+t+"; expected "+strings.Join(constants.TimelineEntryTypes, ", "))
+		}
+		types[t] = true
+	}
+	if len(types) == 0 {
+		for _, t := range constants.TimelineEntryTypes {
+			types[t] = true
+		}
+	}
+	return types, nil
+}
+
+// encodeTimelineCursor returns the cursor of the page after an entry
+func encodeTimelineCursor(e *models.TimelineEntry) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(e.Date.UTC().Format(time.RFC3339Nano) + "|" + e.ID))
+}
+
+func decodeTimelineCursor(cursor string) (*persistence.TimelineCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	invalid := errors.NewValidationError("cursor", "invalid timeline cursor")
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	date, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, invalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, date)
+	if err != nil {
+		return nil, invalid
+	}
+	return &persistence.TimelineCursor{Date: t, ID: id}, nil
+}
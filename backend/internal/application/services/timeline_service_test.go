@@ -0,0 +1,86 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTimeline(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	entry := func(id string, minutes int) *models.TimelineEntry {
+		return &models.TimelineEntry{ID: id, Date: base.Add(time.Duration(minutes) * time.Minute)}
+	}
+	ids := func(page *models.TimelinePage) []string {
+		out := make([]string, len(page.Entries))
+		for i, e := range page.Entries {
+			out[i] = e.ID
+		}
+		return out
+	}
+
+	// Sources that fit the page merge newest first, ties by ID, with no next page
+	page := mergeTimeline([]timelineSource{
+		newTimelineSource([]*models.TimelineEntry{entry("c2", 5), entry("c1", 1)}, 3),
+		newTimelineSource([]*models.TimelineEntry{entry("e1", 5)}, 3),
+	}, 3)
+	assert.Equal(t, []string{"e1", "c2", "c1"}, ids(page))
+	assert.Empty(t, page.NextCursor)
+
+	// More entries than the page holds continue after the last one shown
+	page = mergeTimeline([]timelineSource{
+		newTimelineSource([]*models.TimelineEntry{entry("a3", 30), entry("a2", 20)}, 2),
+		newTimelineSource([]*models.TimelineEntry{entry("b2", 25), entry("b1", 10)}, 2),
+	}, 2)
+	assert.Equal(t, []string{"a3", "b2"}, ids(page))
+	cursor, err := decodeTimelineCursor(page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "b2", cursor.ID)
+	assert.True(t, cursor.Date.Equal(base.Add(25*time.Minute)))
+
+	// A source cut short hides whatever is older than its last row; those entries wait for
+	// the next page even if rows were filtered out of it
+	truncated := newTimelineSource([]*models.TimelineEntry{entry("t3", 30), entry("t2", 20), entry("t1", 15)}, 2)
+	truncated.entries = truncated.entries[:1]
+	page = mergeTimeline([]timelineSource{
+		truncated,
+		newTimelineSource([]*models.TimelineEntry{entry("o1", 5)}, 2),
+	}, 2)
+	assert.Equal(t, []string{"t3"}, ids(page))
+	cursor, err = decodeTimelineCursor(page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "t2", cursor.ID)
+}
+
+func TestTimelineCursor(t *testing.T) {
+	e := &models.TimelineEntry{ID: "abc|def", Date: time.Date(2026, 10, 1, 12, 0, 0, 123456000, time.FixedZone("CET", 3600))}
+	cursor, err := decodeTimelineCursor(encodeTimelineCursor(e))
+	require.NoError(t, err)
+	assert.Equal(t, "abc|def", cursor.ID)
+	assert.True(t, cursor.Date.Equal(e.Date))
+
+	none, err := decodeTimelineCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, none)
+	for _, bad := range []string{"!!", "bm9waXBl", "eHx5"} {
+		_, err := decodeTimelineCursor(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestTimelineTypes(t *testing.T) {
+	all, err := timelineTypes(nil)
+	require.NoError(t, err)
+	assert.Len(t, all, len(constants.TimelineEntryTypes))
+
+	some, err := timelineTypes([]string{" Email", "comment", ""})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{constants.TimelineEntryEmail: true, constants.TimelineEntryComment: true}, some)
+
+	_, err = timelineTypes([]string{"tweet"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,385 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/i18n"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// translationTypes lists the translatable component types in workbench order
+var translationTypes = []string{
+	constants.TranslationTypeObject,
+	constants.TranslationTypeObjectPlural,
+	constants.TranslationTypeField,
+	constants.TranslationTypeFieldHelp,
+	constants.TranslationTypePicklistValue,
+	constants.TranslationTypeValidationRule,
+	constants.TranslationTypeApp,
+	constants.TranslationTypeAppNavigation,
+}
+
+// TranslationFile is the import/export format of a locale's translations
+type TranslationFile struct {
+	Locale       string                `json:"locale"`
+	Translations []*models.Translation `json:"translations"`
+}
+
+// TranslationService stores translated labels and messages of metadata components and
+// localizes metadata for the locale a request resolves to (see pkg/i18n). Untranslated
+// components keep their original text.
+type TranslationService struct {
+	repo     *persistence.TranslationRepository
+	metadata *MetadataService
+
+	mu            sync.RWMutex
+	byLocale      map[string]map[string]string // locale -> translationKey(type, key) -> translation
+	defaultLocale string                       // Org default, for requests that state no locale
+}
+
+// NewTranslationService creates a new TranslationService
+func NewTranslationService(repo *persistence.TranslationRepository, metadata *MetadataService) *TranslationService {
+	return &TranslationService{
+		repo:     repo,
+		metadata: metadata,
+		byLocale: make(map[string]map[string]string),
+	}
+}
+
+func translationKey(componentType, componentKey string) string {
+	return componentType + ":" + componentKey
+}
+
+// RefreshCache reloads every translation
+func (s *TranslationService) RefreshCache(ctx context.Context) error {
+	translations, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	byLocale := make(map[string]map[string]string)
+	for _, t := range translations {
+		if byLocale[t.Locale] == nil {
+			byLocale[t.Locale] = make(map[string]string)
+		}
+		byLocale[t.Locale][translationKey(t.ComponentType, t.ComponentKey)] = t.Translation
+	}
+
+	s.mu.Lock()
+	s.byLocale = byLocale
+	s.mu.Unlock()
+	return nil
+}
+
+// SetDefaultLocale sets the org default locale, used for requests without a preferred locale
+// (API clients send no Accept-Language); empty clears it
+func (s *TranslationService) SetDefaultLocale(locale string) {
+	s.mu.Lock()
+	s.defaultLocale = locale
+	s.mu.Unlock()
+}
+
+// translator returns a lookup of the translations of the locale ctx resolves to, or nil
+// when none of the caller's preferred locales (else the org default) has translations
+func (s *TranslationService) translator(ctx context.Context) func(componentType, componentKey, source string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	preferred := i18n.Locales(ctx)
+	if len(preferred) == 0 && s.defaultLocale != "" {
+		preferred = []string{s.defaultLocale}
+	}
+	if len(preferred) == 0 {
+		return nil
+	}
+	locale := i18n.Match(preferred, func(l string) bool { return len(s.byLocale[l]) > 0 })
+	if locale == "" {
+		return nil
+	}
+	translations := s.byLocale[locale]
+	return func(componentType, componentKey, source string) string {
+		if t, ok := translations[translationKey(componentType, componentKey)]; ok {
+			return t
+		}
+		return source
+	}
+}
+
+// ==================== Localization ====================
+
+// LocalizeSchema returns a copy of an object's metadata with labels, help texts and picklist
+// value labels translated into the caller's locale; the schema itself is returned unchanged
+// when there is nothing to translate
+func (s *TranslationService) LocalizeSchema(ctx context.Context, schema *models.ObjectMetadata) *models.ObjectMetadata {
+	translate := s.translator(ctx)
+	if translate == nil || schema == nil {
+		return schema
+	}
+	return localizeSchema(schema, translate)
+}
+
+// LocalizeSchemas localizes a list of objects' metadata
+func (s *TranslationService) LocalizeSchemas(ctx context.Context, schemas []*models.ObjectMetadata) []*models.ObjectMetadata {
+	translate := s.translator(ctx)
+	if translate == nil {
+		return schemas
+	}
+	localized := make([]*models.ObjectMetadata, len(schemas))
+	for i, schema := range schemas {
+		localized[i] = localizeSchema(schema, translate)
+	}
+	return localized
+}
+
+func localizeSchema(schema *models.ObjectMetadata, translate func(string, string, string) string) *models.ObjectMetadata {
+	localized := *schema
+	localized.Label = translate(constants.TranslationTypeObject, schema.APIName, schema.Label)
+	localized.PluralLabel = translate(constants.TranslationTypeObjectPlural, schema.APIName, schema.PluralLabel)
+	localized.Fields = make([]models.FieldMetadata, len(schema.Fields))
+	for i, field := range schema.Fields {
+		key := schema.APIName + "." + field.APIName
+		field.Label = translate(constants.TranslationTypeField, key, field.Label)
+		if field.HelpText != nil {
+			help := translate(constants.TranslationTypeFieldHelp, key, *field.HelpText)
+			field.HelpText = &help
+		}
+		field.OptionLabels = nil
+		for _, option := range append(append([]string{}, field.Options...), field.InactiveOptions...) {
+			if label := translate(constants.TranslationTypePicklistValue, key+"."+option, option); label != option {
+				if field.OptionLabels == nil {
+					field.OptionLabels = make(map[string]string)
+				}
+				field.OptionLabels[option] = label
+			}
+		}
+		localized.Fields[i] = field
+	}
+	return &localized
+}
+
+// LocalizeApps returns copies of apps with their labels and navigation labels translated
+func (s *TranslationService) LocalizeApps(ctx context.Context, apps []*models.AppConfig) []*models.AppConfig {
+	translate := s.translator(ctx)
+	if translate == nil {
+		return apps
+	}
+	localized := make([]*models.AppConfig, len(apps))
+	for i, app := range apps {
+		copied := *app
+		copied.Label = translate(constants.TranslationTypeApp, app.ID, app.Label)
+		copied.NavigationItems = make([]models.NavigationItem, len(app.NavigationItems))
+		for j, item := range app.NavigationItems {
+			item.Label = translate(constants.TranslationTypeAppNavigation, app.ID+"."+item.ID, item.Label)
+			copied.NavigationItems[j] = item
+		}
+		localized[i] = &copied
+	}
+	return localized
+}
+
+// LocalizePicklistValues fills in the translated labels of a picklist field's values
+func (s *TranslationService) LocalizePicklistValues(ctx context.Context, objectAPIName, fieldAPIName string, values []models.PicklistValue) []models.PicklistValue {
+	translate := s.translator(ctx)
+	if translate == nil {
+		return values
+	}
+	for i := range values {
+		if label := translate(constants.TranslationTypePicklistValue, objectAPIName+"."+fieldAPIName+"."+values[i].Value, ""); label != "" {
+			values[i].Label = label
+		}
+	}
+	return values
+}
+
+// LocalizeValidationRules returns copies of validation rules with their error messages translated
+func (s *TranslationService) LocalizeValidationRules(ctx context.Context, rules []*models.ValidationRule) []*models.ValidationRule {
+	translate := s.translator(ctx)
+	if translate == nil {
+		return rules
+	}
+	localized := make([]*models.ValidationRule, len(rules))
+	for i, rule := range rules {
+		copied := *rule
+		copied.ErrorMessage = translate(constants.TranslationTypeValidationRule, rule.ObjectAPIName+"."+rule.Name, rule.ErrorMessage)
+		localized[i] = &copied
+	}
+	return localized
+}
+
+// ==================== Workbench ====================
+
+// GetLocales returns every locale with translations and how many components each translates
+func (s *TranslationService) GetLocales() []models.TranslationLocale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	locales := make([]models.TranslationLocale, 0, len(s.byLocale))
+	for locale, translations := range s.byLocale {
+		locales = append(locales, models.TranslationLocale{Locale: locale, Translated: len(translations)})
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i].Locale < locales[j].Locale })
+	return locales
+}
+
+// GetWorkbench lists every translatable component with its original text and its translation
+// in a locale (empty when untranslated). An empty componentType lists every type.
+func (s *TranslationService) GetWorkbench(ctx context.Context, locale, componentType string) ([]*models.Translation, error) {
+	normalized := i18n.Normalize(locale)
+	if normalized == "" {
+		return nil, errors.NewValidationError(constants.FieldSysTranslation_Locale, fmt.Sprintf("invalid locale %q", locale))
+	}
+	if componentType != "" && !ContainsString(translationTypes, componentType) {
+		return nil, errors.NewValidationError(constants.FieldSysTranslation_ComponentType, fmt.Sprintf("must be one of %s", strings.Join(translationTypes, ", ")))
+	}
+
+	s.mu.RLock()
+	translations := s.byLocale[normalized]
+	s.mu.RUnlock()
+
+	components := s.components(ctx)
+	entries := make([]*models.Translation, 0, len(components))
+	for _, c := range components {
+		if componentType != "" && c.ComponentType != componentType {
+			continue
+		}
+		c.Locale = normalized
+		c.Translation = translations[translationKey(c.ComponentType, c.ComponentKey)]
+		entries = append(entries, c)
+	}
+	return entries, nil
+}
+
+// components lists the translatable components of the current metadata with their original text
+func (s *TranslationService) components(ctx context.Context) []*models.Translation {
+	var components []*models.Translation
+	add := func(componentType, componentKey, source string) {
+		if source != "" {
+			components = append(components, &models.Translation{ComponentType: componentType, ComponentKey: componentKey, Source: source})
+		}
+	}
+
+	schemas := s.metadata.GetSchemas(ctx)
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].APIName < schemas[j].APIName })
+	for _, schema := range schemas {
+		if constants.IsSystemTable(schema.APIName) {
+			continue
+		}
+		add(constants.TranslationTypeObject, schema.APIName, schema.Label)
+		add(constants.TranslationTypeObjectPlural, schema.APIName, schema.PluralLabel)
+		for _, field := range schema.Fields {
+			if field.IsSystem {
+				continue
+			}
+			key := schema.APIName + "." + field.APIName
+			add(constants.TranslationTypeField, key, field.Label)
+			if field.HelpText != nil {
+				add(constants.TranslationTypeFieldHelp, key, *field.HelpText)
+			}
+			for _, v := range picklistValues(&field) {
+				add(constants.TranslationTypePicklistValue, key+"."+v.Value, v.Value)
+			}
+		}
+		for _, rule := range s.metadata.GetValidationRules(ctx, schema.APIName) {
+			add(constants.TranslationTypeValidationRule, schema.APIName+"."+rule.Name, rule.ErrorMessage)
+		}
+	}
+
+	for _, app := range s.metadata.GetApps(ctx) {
+		add(constants.TranslationTypeApp, app.ID, app.Label)
+		for _, item := range app.NavigationItems {
+			add(constants.TranslationTypeAppNavigation, app.ID+"."+item.ID, item.Label)
+		}
+	}
+
+	order := make(map[string]int, len(translationTypes))
+	for i, t := range translationTypes {
+		order[t] = i
+	}
+	sort.SliceStable(components, func(i, j int) bool {
+		return order[components[i].ComponentType] < order[components[j].ComponentType]
+	})
+	return components
+}
+
+// Save writes translations of a locale. Entries with an empty translation remove the
+// component's translation. It returns the number of translations written.
+func (s *TranslationService) Save(ctx context.Context, locale string, entries []*models.Translation) (int, error) {
+	normalized := i18n.Normalize(locale)
+	if normalized == "" {
+		return 0, errors.NewValidationError(constants.FieldSysTranslation_Locale, fmt.Sprintf("invalid locale %q", locale))
+	}
+
+	var upserts []*models.Translation
+	var removals []*models.Translation
+	for i, e := range entries {
+		if !ContainsString(translationTypes, e.ComponentType) {
+			return 0, errors.NewValidationError(constants.FieldSysTranslation_ComponentType,
+				fmt.Sprintf("translation %d: must be one of %s", i+1, strings.Join(translationTypes, ", ")))
+		}
+		if strings.TrimSpace(e.ComponentKey) == "" {
+			return 0, errors.NewValidationError(constants.FieldSysTranslation_ComponentKey, fmt.Sprintf("translation %d: is required", i+1))
+		}
+		t := &models.Translation{Locale: normalized, ComponentType: e.ComponentType, ComponentKey: e.ComponentKey, Translation: e.Translation}
+		if strings.TrimSpace(e.Translation) == "" {
+			removals = append(removals, t)
+		} else {
+			upserts = append(upserts, t)
+		}
+	}
+
+	if err := s.repo.Upsert(ctx, upserts); err != nil {
+		return 0, err
+	}
+	for _, t := range removals {
+		if err := s.repo.Delete(ctx, t.Locale, t.ComponentType, t.ComponentKey); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.RefreshCache(ctx); err != nil {
+		log.Printf("⚠️ Failed to refresh translations: %v", err)
+	}
+	return len(upserts), nil
+}
+
+// DeleteLocale removes every translation of a locale
+func (s *TranslationService) DeleteLocale(ctx context.Context, locale string) error {
+	normalized := i18n.Normalize(locale)
+	if normalized == "" {
+		return errors.NewValidationError(constants.FieldSysTranslation_Locale, fmt.Sprintf("invalid locale %q", locale))
+	}
+	if err := s.repo.DeleteLocale(ctx, normalized); err != nil {
+		return err
+	}
+	return s.RefreshCache(ctx)
+}
+
+// Export returns the translation file of a locale, listing every translatable component
+// so translators can fill in the untranslated ones
+func (s *TranslationService) Export(ctx context.Context, locale string) (*TranslationFile, error) {
+	entries, err := s.GetWorkbench(ctx, locale, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		e.Locale = ""
+	}
+	return &TranslationFile{Locale: i18n.Normalize(locale), Translations: entries}, nil
+}
+
+// Import saves the translations of a translation file. Untranslated entries are skipped
+// rather than removing existing translations.
+func (s *TranslationService) Import(ctx context.Context, file *TranslationFile) (int, error) {
+	entries := make([]*models.Translation, 0, len(file.Translations))
+	for _, e := range file.Translations {
+		if strings.TrimSpace(e.Translation) != "" {
+			entries = append(entries, e)
+		}
+	}
+	return s.Save(ctx, file.Locale, entries)
+}
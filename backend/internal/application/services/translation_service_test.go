@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/i18n"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func frenchTranslations() *TranslationService {
+	return &TranslationService{byLocale: map[string]map[string]string{
+		"fr": {
+			translationKey(constants.TranslationTypeObject, "account"):                      "Compte",
+			translationKey(constants.TranslationTypeField, "account.industry"):              "Secteur",
+			translationKey(constants.TranslationTypeFieldHelp, "account.industry"):          "Secteur d'activité",
+			translationKey(constants.TranslationTypePicklistValue, "account.industry.Tech"): "Technologie",
+			translationKey(constants.TranslationTypeValidationRule, "account.need_name"):    "Le nom est obligatoire",
+			translationKey(constants.TranslationTypeAppNavigation, "sales.nav1"):            "Comptes",
+		},
+	}}
+}
+
+func TestTranslationService_LocalizeSchema(t *testing.T) {
+	s := frenchTranslations()
+	help := "Line of business"
+	schema := &models.ObjectMetadata{
+		APIName:     "account",
+		Label:       "Account",
+		PluralLabel: "Accounts",
+		Fields: []models.FieldMetadata{
+			{APIName: "industry", Label: "Industry", HelpText: &help, Options: []string{"Tech", "Retail"}},
+			{APIName: "name", Label: "Name"},
+		},
+	}
+
+	// Regional locales fall back to their language
+	ctx := i18n.WithLocales(context.Background(), []string{"de", "fr-CA"})
+	localized := s.LocalizeSchema(ctx, schema)
+	assert.Equal(t, "Compte", localized.Label)
+	assert.Equal(t, "Accounts", localized.PluralLabel, "untranslated labels are kept")
+	assert.Equal(t, "Secteur", localized.Fields[0].Label)
+	assert.Equal(t, "Secteur d'activité", *localized.Fields[0].HelpText)
+	assert.Equal(t, map[string]string{"Tech": "Technologie"}, localized.Fields[0].OptionLabels)
+	assert.Equal(t, "Name", localized.Fields[1].Label)
+
+	// The cached schema is not modified
+	assert.Equal(t, "Account", schema.Label)
+	assert.Equal(t, "Industry", schema.Fields[0].Label)
+	assert.Equal(t, "Line of business", help)
+
+	assert.Same(t, schema, s.LocalizeSchema(context.Background(), schema))
+	assert.Same(t, schema, s.LocalizeSchema(i18n.WithLocales(context.Background(), []string{"es"}), schema))
+}
+
+func TestTranslationService_LocalizeMessagesAndApps(t *testing.T) {
+	s := frenchTranslations()
+	ctx := i18n.WithLocales(context.Background(), []string{"fr"})
+
+	rules := []*models.ValidationRule{{ObjectAPIName: "account", Name: "need_name", ErrorMessage: "Name is required"}}
+	localized := s.LocalizeValidationRules(ctx, rules)
+	assert.Equal(t, "Le nom est obligatoire", localized[0].ErrorMessage)
+	assert.Equal(t, "Name is required", rules[0].ErrorMessage)
+
+	apps := s.LocalizeApps(ctx, []*models.AppConfig{{ID: "sales", Label: "Sales", NavigationItems: []models.NavigationItem{{ID: "nav1", Label: "Accounts"}}}})
+	require.Len(t, apps, 1)
+	assert.Equal(t, "Sales", apps[0].Label)
+	assert.Equal(t, "Comptes", apps[0].NavigationItems[0].Label)
+
+	values := s.LocalizePicklistValues(ctx, "account", "industry", []models.PicklistValue{{Value: "Tech", Active: true}, {Value: "Retail", Active: true}})
+	assert.Equal(t, "Technologie", values[0].Label)
+	assert.Empty(t, values[1].Label)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+)
+
+// Record automation (flows, rollups, scripts and state machine actions) saves records, and those saves fire more automation.
+// The automation that led to a save travels with it as a trigger chain: in the context
+// within a transaction, and in the RecordEventPayload through the outbox, so after-save
+// flows still know what fired them. The chain caps recursion depth and stops automation
+// that keeps re-triggering itself on the same record.
+
+const (
+	// MaxTriggerDepth caps how many automation steps may nest below a single save
+	MaxTriggerDepth = 16
+
+	// maxTriggerReentries is how often automation may run again on the same record within
+	// one chain. One re-run lets a flow that updates its own record see the update settle
+	// (the second save changes nothing and fires nothing); another run is an update loop.
+	maxTriggerReentries = 1
+)
+
+// Trigger kinds
+const (
+	TriggerKindFlow         = "flow"
+	TriggerKindRollup       = "rollup"
+	TriggerKindScript       = "script"
+	TriggerKindStateMachine = "state_machine"
+)
+
+// TriggerFrame is one automation running on one record
+type TriggerFrame struct {
+	Kind          string `json:"kind"`
+	Name          string `json:"name"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID      string `json:"record_id"`
+}
+
+func (f TriggerFrame) String() string {
+	return fmt.Sprintf("%s %s on %s/%s", f.Kind, f.Name, f.ObjectAPIName, f.RecordID)
+}
+
+type triggerChainKey struct{}
+
+// TriggerChain returns the automation running in ctx, outermost first
+func TriggerChain(ctx context.Context) []TriggerFrame {
+	chain, _ := ctx.Value(triggerChainKey{}).([]TriggerFrame)
+	return chain
+}
+
+// WithTriggerChain resumes a chain carried by an event payload. A chain already in ctx is kept.
+func WithTriggerChain(ctx context.Context, chain []TriggerFrame) context.Context {
+	if len(chain) == 0 || len(TriggerChain(ctx)) > 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, triggerChainKey{}, chain)
+}
+
+// EnterTrigger returns ctx with frame appended to its trigger chain. It fails with a
+// TriggerRecursionError when the chain is already MaxTriggerDepth deep or the same
+// automation has already re-run on the record.
+func EnterTrigger(ctx context.Context, frame TriggerFrame) (context.Context, error) {
+	chain := TriggerChain(ctx)
+	next := make([]TriggerFrame, len(chain), len(chain)+1)
+	copy(next, chain)
+	next = append(next, frame)
+
+	if len(chain) >= MaxTriggerDepth {
+		return ctx, errors.NewTriggerRecursionError(
+			fmt.Sprintf("record automation exceeded the maximum depth of %d", MaxTriggerDepth), describeTriggerChain(next))
+	}
+	runs := 0
+	for _, f := range chain {
+		if f == frame {
+			runs++
+		}
+	}
+	if runs > maxTriggerReentries {
+		return ctx, errors.NewTriggerRecursionError(
+			fmt.Sprintf("%s %s keeps re-triggering itself on %s/%s", frame.Kind, frame.Name, frame.ObjectAPIName, frame.RecordID),
+			describeTriggerChain(next))
+	}
+	return context.WithValue(ctx, triggerChainKey{}, next), nil
+}
+
+func describeTriggerChain(chain []TriggerFrame) []string {
+	steps := make([]string, len(chain))
+	for i, f := range chain {
+		steps[i] = f.String()
+	}
+	return steps
+}
+
+type suppressFlowsKey struct{}
+
+// WithFlowsSuppressed returns ctx in which record saves run no record-triggered flows, for
+// bulk admin operations that must not fan out into automation. Rollups still run.
+func WithFlowsSuppressed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, suppressFlowsKey{}, true)
+}
+
+// FlowsSuppressed reports whether record saves in ctx skip record-triggered flows
+func FlowsSuppressed(ctx context.Context) bool {
+	suppressed, _ := ctx.Value(suppressFlowsKey{}).(bool)
+	return suppressed
+}
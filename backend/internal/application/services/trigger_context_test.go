@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnterTrigger_Depth(t *testing.T) {
+	ctx := context.Background()
+	for i := 0; i < MaxTriggerDepth; i++ {
+		var err error
+		ctx, err = EnterTrigger(ctx, TriggerFrame{Kind: TriggerKindFlow, Name: "Cascade", ObjectAPIName: "account", RecordID: fmt.Sprintf("acc-%d", i)})
+		require.NoError(t, err)
+	}
+	assert.Len(t, TriggerChain(ctx), MaxTriggerDepth)
+
+	_, err := EnterTrigger(ctx, TriggerFrame{Kind: TriggerKindFlow, Name: "Cascade", ObjectAPIName: "account", RecordID: "acc-last"})
+	assert.True(t, errors.IsTriggerRecursion(err))
+	assert.Contains(t, err.Error(), "maximum depth of 16")
+}
+
+func TestEnterTrigger_Reentry(t *testing.T) {
+	frame := TriggerFrame{Kind: TriggerKindFlow, Name: "Set Stage", ObjectAPIName: "lead", RecordID: "lead-1"}
+
+	first, err := EnterTrigger(context.Background(), frame)
+	require.NoError(t, err)
+	second, err := EnterTrigger(first, frame)
+	require.NoError(t, err, "one re-run on the same record is allowed")
+
+	// Other automation, and the same flow on another record, may still run
+	_, err = EnterTrigger(second, TriggerFrame{Kind: TriggerKindRollup, Name: "total_amount", ObjectAPIName: "account", RecordID: "acc-1"})
+	assert.NoError(t, err)
+	_, err = EnterTrigger(second, TriggerFrame{Kind: TriggerKindFlow, Name: "Set Stage", ObjectAPIName: "lead", RecordID: "lead-2"})
+	assert.NoError(t, err)
+
+	_, err = EnterTrigger(second, frame)
+	require.Error(t, err)
+	var recursion *errors.TriggerRecursionError
+	require.ErrorAs(t, err, &recursion)
+	assert.Equal(t, []string{
+		"flow Set Stage on lead/lead-1", "flow Set Stage on lead/lead-1", "flow Set Stage on lead/lead-1",
+	}, recursion.Chain)
+
+	// Entering never changes the parent context
+	assert.Len(t, TriggerChain(first), 1)
+}
+
+func TestWithTriggerChain(t *testing.T) {
+	carried := []TriggerFrame{{Kind: TriggerKindFlow, Name: "A", ObjectAPIName: "lead", RecordID: "lead-1"}}
+	ctx := WithTriggerChain(context.Background(), carried)
+	assert.Equal(t, carried, TriggerChain(ctx))
+
+	// A chain already running in the context is kept
+	inner, err := EnterTrigger(ctx, TriggerFrame{Kind: TriggerKindFlow, Name: "B", ObjectAPIName: "lead", RecordID: "lead-1"})
+	require.NoError(t, err)
+	assert.Len(t, TriggerChain(WithTriggerChain(inner, carried)), 2)
+	assert.Nil(t, TriggerChain(WithTriggerChain(context.Background(), nil)))
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Record Page Describe ====================
+
+// RecordPageDescribe bundles the metadata a record page needs, so the UI loads it in one
+// request instead of fetching schema, layout, actions and picklist values separately
+type RecordPageDescribe struct {
+	Schema         *models.ObjectMetadata            `json:"schema"`
+	RecordType     *models.RecordType                `json:"record_type"`
+	RecordTypes    []*models.RecordType              `json:"record_types"`
+	Layout         *models.PageLayout                `json:"layout"`
+	CompactLayout  []string                          `json:"compact_layout"`
+	Actions        []*models.ActionMetadata          `json:"actions"`
+	RelatedLists   []models.RelatedListConfig        `json:"related_lists"`
+	PicklistValues map[string][]models.PicklistValue `json:"picklist_values"`
+}
+
+// DescribeRecordPage returns the permission-filtered schema of an object together with the
+// user's resolved layout, actions and picklist values for a record type. An explicit record
+// type must be available to the user; otherwise the record's own type is used when a record
+// ID is given, and the user's default type for new records. Picklist values are narrowed to
+// the values the record type allows.
+func (s *UIMetadataService) DescribeRecordPage(ctx context.Context, objectName, recordTypeID, recordID string, currentUser *models.UserSession) (*RecordPageDescribe, error) {
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	schema = s.permissions.GetEffectiveSchema(ctx, schema, currentUser)
+
+	record, err := s.layoutRecord(ctx, schema.APIName, recordID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	available, def, err := s.metadata.GetAvailableRecordTypes(ctx, schema.APIName, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	var recordType *models.RecordType
+	if recordTypeID == "" {
+		recordTypeID, _ = record[constants.FieldRecordTypeID].(string)
+		if recordTypeID != "" {
+			// An existing record keeps its type even when the user may no longer create it
+			if recordType, err = s.metadata.GetRecordType(ctx, recordTypeID); err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+		} else {
+			recordType = def
+		}
+	} else if recordType, err = pickRecordType(available, def, recordTypeID, schema.APIName); err != nil {
+		return nil, err
+	}
+	if recordType != nil {
+		recordTypeID = recordType.ID
+	}
+
+	layout, err := s.resolveLayout(ctx, schema.APIName, recordTypeID, record, currentUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if available == nil {
+		available = []*models.RecordType{}
+	}
+	actions := s.metadata.GetActions(ctx, schema.APIName)
+	if actions == nil {
+		actions = []*models.ActionMetadata{}
+	}
+	return &RecordPageDescribe{
+		Schema:         schema,
+		RecordType:     recordType,
+		RecordTypes:    available,
+		Layout:         layout,
+		CompactLayout:  layout.CompactLayout,
+		Actions:        actions,
+		RelatedLists:   layout.RelatedLists,
+		PicklistValues: recordTypePicklistValues(schema, recordType),
+	}, nil
+}
+
+// recordTypePicklistValues lists the values of every picklist field of the schema, keeping
+// only those the record type allows for fields it restricts
+func recordTypePicklistValues(schema *models.ObjectMetadata, recordType *models.RecordType) map[string][]models.PicklistValue {
+	result := make(map[string][]models.PicklistValue)
+	for i := range schema.Fields {
+		field := &schema.Fields[i]
+		if field.Type != constants.FieldTypePicklist && field.Type != constants.FieldTypeMultiPicklist {
+			continue
+		}
+		values := picklistValues(field)
+		if recordType != nil {
+			if allowed, ok := recordType.PicklistValues[field.APIName]; ok {
+				filtered := make([]models.PicklistValue, 0, len(allowed))
+				for _, v := range values {
+					if ContainsString(allowed, v.Value) {
+						filtered = append(filtered, v)
+					}
+				}
+				values = filtered
+			}
+		}
+		result[field.APIName] = values
+	}
+	return result
+}
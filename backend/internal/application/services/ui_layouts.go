@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ==================== Resolved Layouts ====================
+
+// ResolveLayout returns the layout for a record with the visibility conditions of its sections,
+// related lists and actions evaluated server-side against the record and the current user.
+// Hidden components are removed and the conditions are stripped from the response. Without a
+// record ID (e.g. a create form) conditions are evaluated against an empty record.
+func (s *UIMetadataService) ResolveLayout(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) (*models.PageLayout, error) {
+	record, err := s.layoutRecord(ctx, objectName, recordID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	recordTypeID, _ := record[constants.FieldRecordTypeID].(string)
+	return s.resolveLayout(ctx, objectName, recordTypeID, record, currentUser)
+}
+
+// layoutRecord loads the record layout conditions are evaluated against. Records the user
+// cannot read are reported as not found; an empty record ID yields an empty record.
+func (s *UIMetadataService) layoutRecord(ctx context.Context, objectName, recordID string, currentUser *models.UserSession) (models.SObject, error) {
+	if recordID == "" {
+		return models.SObject{}, nil
+	}
+	schema, err := s.metadata.GetSchemaOrError(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.query.QueryByIDs(ctx, schema.APIName, []string{recordID}, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !s.permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, currentUser) {
+		return nil, errors.NewNotFoundError(schema.APIName, recordID)
+	}
+	return records[0], nil
+}
+
+// resolveLayout picks the user's layout for the record type and evaluates its visibility
+// conditions against the record
+func (s *UIMetadataService) resolveLayout(ctx context.Context, objectName, recordTypeID string, record models.SObject, currentUser *models.UserSession) (*models.PageLayout, error) {
+	var profileID *string
+	if currentUser != nil {
+		profileID = &currentUser.ProfileID
+	}
+	layout := s.metadata.GetLayout(ctx, objectName, profileID, recordTypeID)
+	if layout == nil {
+		return nil, errors.NewNotFoundError("Layout", objectName)
+	}
+
+	formulaCtx := &formula.Context{Record: record, User: layoutUserContext(currentUser)}
+	return applyLayoutVisibility(layout, func(condition string) bool {
+		result, err := s.formula.Evaluate(condition, formulaCtx)
+		if err != nil {
+			log.Printf("⚠️ Layout %s: visibility condition %q failed: %v", layout.ID, condition, err)
+			return false
+		}
+		visible, _ := result.(bool)
+		return visible
+	}), nil
+}
+
+// applyLayoutVisibility returns a copy of the layout without the components whose visibility
+// condition is false. Conditions are cleared from the components that remain.
+func applyLayoutVisibility(layout *models.PageLayout, visible func(condition string) bool) *models.PageLayout {
+	shown := func(condition *string) bool {
+		return condition == nil || strings.TrimSpace(*condition) == "" || visible(*condition)
+	}
+
+	resolved := *layout
+	resolved.Sections = make([]models.PageSection, 0, len(layout.Sections))
+	for _, section := range layout.Sections {
+		if shown(section.VisibilityCondition) {
+			section.VisibilityCondition = nil
+			resolved.Sections = append(resolved.Sections, section)
+		}
+	}
+	resolved.RelatedLists = make([]models.RelatedListConfig, 0, len(layout.RelatedLists))
+	for _, rl := range layout.RelatedLists {
+		if shown(rl.VisibilityCondition) {
+			rl.VisibilityCondition = nil
+			resolved.RelatedLists = append(resolved.RelatedLists, rl)
+		}
+	}
+	filterActions := func(actions []models.ActionConfig) []models.ActionConfig {
+		kept := make([]models.ActionConfig, 0, len(actions))
+		for _, action := range actions {
+			if shown(action.VisibilityCondition) {
+				action.VisibilityCondition = nil
+				kept = append(kept, action)
+			}
+		}
+		return kept
+	}
+	resolved.HeaderActions = filterActions(layout.HeaderActions)
+	resolved.QuickActions = filterActions(layout.QuickActions)
+	return &resolved
+}
+
+// layoutUserContext exposes the current user to visibility conditions as `user`
+func layoutUserContext(user *models.UserSession) map[string]interface{} {
+	if user == nil {
+		return map[string]interface{}{}
+	}
+	email, roleID := "", ""
+	if user.Email != nil {
+		email = *user.Email
+	}
+	if user.RoleID != nil {
+		roleID = *user.RoleID
+	}
+	return map[string]interface{}{
+		constants.FieldID:        user.ID,
+		constants.FieldName:      user.Name,
+		constants.FieldEmail:     email,
+		constants.FieldProfileID: user.ProfileID,
+		constants.FieldRoleID:    roleID,
+		"is_system_admin":        user.IsSystemAdmin,
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyLayoutVisibility(t *testing.T) {
+	str := func(s string) *string { return &s }
+	layout := &models.PageLayout{
+		ID: "opportunity_layout",
+		Sections: []models.PageSection{
+			{ID: "info", Label: "Information"},
+			{ID: "loss", Label: "Loss Reason", VisibilityCondition: str("record.stage == 'Closed Lost'")},
+			{ID: "admin", Label: "Admin", VisibilityCondition: str("user.profile_id == 'system_admin'")},
+			{ID: "blank", Label: "Blank Condition", VisibilityCondition: str("  ")},
+		},
+		RelatedLists: []models.RelatedListConfig{
+			{ID: "quotes", VisibilityCondition: str("amount > 1000")},
+			{ID: "notes"},
+		},
+		HeaderActions: []models.ActionConfig{
+			{Name: "close", VisibilityCondition: str("record.stage != 'Closed Won' && record.stage != 'Closed Lost'")},
+			{Name: "broken", VisibilityCondition: str("record.stage ==")},
+		},
+		QuickActions: []models.ActionConfig{{Name: "log_call"}},
+	}
+
+	engine := formula.NewEngine()
+	ctx := &formula.Context{
+		Record: map[string]interface{}{"stage": "Closed Lost", "amount": 500.0},
+		User:   layoutUserContext(&models.UserSession{ID: "u1", ProfileID: "standard_user"}),
+	}
+	resolved := applyLayoutVisibility(layout, func(condition string) bool {
+		result, err := engine.Evaluate(condition, ctx)
+		visible, _ := result.(bool)
+		return err == nil && visible
+	})
+
+	var sections []string
+	for _, s := range resolved.Sections {
+		sections = append(sections, s.ID)
+		assert.Nil(t, s.VisibilityCondition, "conditions are stripped")
+	}
+	assert.Equal(t, []string{"info", "loss", "blank"}, sections)
+	assert.Len(t, resolved.RelatedLists, 1)
+	assert.Equal(t, "notes", resolved.RelatedLists[0].ID)
+	assert.Empty(t, resolved.HeaderActions, "false and invalid conditions hide the action")
+	assert.Len(t, resolved.QuickActions, 1)
+
+	assert.Len(t, layout.Sections, 4, "source layout is not modified")
+	assert.NotNil(t, layout.Sections[1].VisibilityCondition)
+}
+
+func TestRecordTypePicklistValues(t *testing.T) {
+	schema := &models.ObjectMetadata{
+		APIName: "opportunity",
+		Fields: []models.FieldMetadata{
+			{APIName: "name", Type: constants.FieldTypeText},
+			{APIName: "stage", Type: constants.FieldTypePicklist, Options: []string{"Prospecting", "Negotiation", "Closed Won"}, InactiveOptions: []string{"Legacy"}},
+			{APIName: "source", Type: constants.FieldTypePicklist, Options: []string{"Web", "Partner"}},
+		},
+	}
+	values := func(list []models.PicklistValue) []string {
+		var out []string
+		for _, v := range list {
+			out = append(out, v.Value)
+		}
+		return out
+	}
+
+	all := recordTypePicklistValues(schema, nil)
+	assert.Len(t, all, 2, "only picklist fields are described")
+	assert.Equal(t, []string{"Prospecting", "Negotiation", "Closed Won", "Legacy"}, values(all["stage"]))
+
+	renewal := &models.RecordType{PicklistValues: map[string][]string{"stage": {"Closed Won", "Negotiation"}}}
+	filtered := recordTypePicklistValues(schema, renewal)
+	assert.Equal(t, []string{"Negotiation", "Closed Won"}, values(filtered["stage"]), "option order is kept")
+	assert.Equal(t, []string{"Web", "Partner"}, values(filtered["source"]), "unrestricted fields keep every value")
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 
+	"github.com/nexuscrm/backend/pkg/formula"
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
@@ -10,13 +11,17 @@ import (
 type UIMetadataService struct {
 	metadata    *MetadataService
 	permissions *PermissionService
+	query       *QueryService
+	formula     *formula.Engine
 }
 
 // NewUIMetadataService creates a new UIMetadataService
-func NewUIMetadataService(metadata *MetadataService, permissions *PermissionService) *UIMetadataService {
+func NewUIMetadataService(metadata *MetadataService, permissions *PermissionService, query *QueryService) *UIMetadataService {
 	return &UIMetadataService{
 		metadata:    metadata,
 		permissions: permissions,
+		query:       query,
+		formula:     formula.NewEngine(),
 	}
 }
 
@@ -39,13 +44,13 @@ func (s *UIMetadataService) GetDashboard(ctx context.Context, id string) *models
 }
 
 // CreateDashboard delegates to MetadataService
-func (s *UIMetadataService) CreateDashboard(ctx context.Context, dashboard *models.DashboardConfig) error {
-	return s.metadata.CreateDashboard(ctx, dashboard)
+func (s *UIMetadataService) CreateDashboard(ctx context.Context, dashboard *models.DashboardConfig, user *models.UserSession) error {
+	return s.metadata.CreateDashboard(ctx, dashboard, user)
 }
 
 // UpdateDashboard delegates to MetadataService
-func (s *UIMetadataService) UpdateDashboard(ctx context.Context, id string, updates *models.DashboardConfig) error {
-	return s.metadata.UpdateDashboard(ctx, id, updates)
+func (s *UIMetadataService) UpdateDashboard(ctx context.Context, id string, updates *models.DashboardConfig, user *models.UserSession) error {
+	return s.metadata.UpdateDashboard(ctx, id, updates, user)
 }
 
 // DeleteDashboard delegates to MetadataService
@@ -55,10 +60,10 @@ func (s *UIMetadataService) DeleteDashboard(ctx context.Context, id string) erro
 
 // ==================== Layout Methods ====================
 
-func (s *UIMetadataService) GetLayout(ctx context.Context, apiName string, profileID *string) *models.PageLayout {
-	// MetadataService.GetLayout takes (apiName, profileID *string) and returns *models.PageLayout
+func (s *UIMetadataService) GetLayout(ctx context.Context, apiName string, profileID *string, recordTypeID string) *models.PageLayout {
+	// MetadataService.GetLayout resolves by profile and record type and returns *models.PageLayout
 	// It internally handles augmenting with related lists.
-	return s.metadata.GetLayout(ctx, apiName, profileID)
+	return s.metadata.GetLayout(ctx, apiName, profileID, recordTypeID)
 }
 
 func (s *UIMetadataService) SaveLayout(ctx context.Context, layout *models.PageLayout) error {
@@ -75,8 +80,8 @@ func (s *UIMetadataService) AssignLayoutToProfile(ctx context.Context, profileID
 
 // ==================== List View Methods ====================
 
-func (s *UIMetadataService) GetListViews(ctx context.Context, objectAPIName string) []*models.ListView {
-	return s.metadata.GetListViews(ctx, objectAPIName)
+func (s *UIMetadataService) GetListViews(ctx context.Context, objectAPIName string, user *models.UserSession) []*models.ListView {
+	return s.metadata.GetListViews(ctx, objectAPIName, user)
 }
 
 // App methods are in ui_apps.go (kept separate due to larger logic)
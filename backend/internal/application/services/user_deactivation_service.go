@@ -0,0 +1,411 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ownershipBatchSize is the page size used to collect the records a user owns
+const ownershipBatchSize = 1000
+
+// UserDeactivationService reports what a user owns or is responsible for and hands it over
+// when the user leaves: records, flows, pending approvals, approval processes naming them as
+// approver, dashboards running as them and reports. Sessions are the only credentials
+// NexusCRM issues; deactivation revokes them.
+type UserDeactivationService struct {
+	auth        *AuthService
+	users       *persistence.UserRepository
+	metadata    *MetadataService
+	query       *QueryService
+	persistence *PersistenceService
+	permissions *PermissionService
+}
+
+// NewUserDeactivationService creates a new UserDeactivationService
+func NewUserDeactivationService(
+	authSvc *AuthService,
+	users *persistence.UserRepository,
+	metadata *MetadataService,
+	query *QueryService,
+	persistenceSvc *PersistenceService,
+	permissions *PermissionService,
+) *UserDeactivationService {
+	return &UserDeactivationService{
+		auth:        authSvc,
+		users:       users,
+		metadata:    metadata,
+		query:       query,
+		persistence: persistenceSvc,
+		permissions: permissions,
+	}
+}
+
+// OwnershipReport lists everything the user owns or is responsible for
+func (s *UserDeactivationService) OwnershipReport(ctx context.Context, userID string, currentUser *models.UserSession) (*models.UserOwnershipReport, error) {
+	if _, err := s.findUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	report := &models.UserOwnershipReport{
+		UserID:            userID,
+		Records:           make([]models.OwnedRecordCount, 0),
+		Flows:             make([]models.OwnedComponent, 0),
+		PendingApprovals:  make([]models.OwnedComponent, 0),
+		ApprovalProcesses: make([]models.OwnedComponent, 0),
+		Dashboards:        make([]models.OwnedComponent, 0),
+		Reports:           make([]models.OwnedComponent, 0),
+	}
+
+	for _, schema := range s.ownableObjects(ctx) {
+		val, err := s.query.RunAnalytics(ctx, models.AnalyticsQuery{
+			ObjectAPIName: schema.APIName,
+			Operation:     persistence.OpCount,
+			FilterExpr:    fieldEquals(constants.FieldOwnerID, userID),
+		}, currentUser)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s records: %w", schema.APIName, err)
+		}
+		if count := toInt64(val); count > 0 {
+			report.Records = append(report.Records, models.OwnedRecordCount{ObjectAPIName: schema.APIName, Count: count})
+		}
+	}
+
+	flows, err := s.findAll(ctx, constants.TableFlow, fieldEquals(constants.FieldSysFlow_OwnerID, userID), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, flow := range flows {
+		detail := flow.GetString(constants.FieldSysFlow_Status)
+		if schedule := flow.GetString(constants.FieldSysFlow_Schedule); schedule != "" {
+			detail += ", scheduled " + schedule
+		}
+		report.Flows = append(report.Flows, ownedComponent(flow, constants.FieldSysFlow_Name, detail))
+	}
+
+	approvals, err := s.findAll(ctx, constants.TableApprovalWorkItem, combineFilters(
+		fieldEquals(constants.FieldSysApprovalWorkItem_ApproverID, userID),
+		fieldEquals(constants.FieldSysApprovalWorkItem_Status, constants.ApprovalStatusPending),
+	), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range approvals {
+		report.PendingApprovals = append(report.PendingApprovals, ownedComponent(item, constants.FieldSysApprovalWorkItem_RecordID, item.GetString(constants.FieldSysApprovalWorkItem_ObjectAPIName)))
+	}
+
+	processes, err := s.findAll(ctx, constants.TableApprovalProcess, fieldEquals(constants.FieldSysApprovalProcess_ApproverID, userID), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, process := range processes {
+		report.ApprovalProcesses = append(report.ApprovalProcesses, ownedComponent(process, constants.FieldSysApprovalProcess_Name, process.GetString(constants.FieldSysApprovalProcess_ObjectAPIName)))
+	}
+
+	dashboards, err := s.findAll(ctx, constants.TableDashboard, fieldEquals(constants.FieldSysDashboard_RunAsUserID, userID), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, dashboard := range dashboards {
+		report.Dashboards = append(report.Dashboards, ownedComponent(dashboard, constants.FieldSysDashboard_Name, "runs as user"))
+	}
+
+	reports, err := s.findAll(ctx, constants.TableReport, fieldEquals(constants.FieldSysReport_OwnerID, userID), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reports {
+		report.Reports = append(report.Reports, ownedComponent(r, constants.FieldSysReport_Name, r.GetString(constants.FieldSysReport_ObjectAPIName)))
+	}
+
+	return report, nil
+}
+
+// Deactivate deactivates a user and, in one transaction, hands over what they own. With a
+// transfer user, records, flows, reports, pending approvals, approval processes and
+// dashboards running as the user all move to them. Without one they are frozen: records
+// keep their owner (admins can mass-transfer them later), owned flows and approval processes
+// naming the user are deactivated and dashboards run as each viewer. Pending approvals cannot
+// be frozen, so they need a transfer user. The user's sessions are revoked.
+func (s *UserDeactivationService) Deactivate(ctx context.Context, userID string, req models.UserDeactivationRequest, currentUser *models.UserSession) (*models.UserOwnershipReport, error) {
+	if userID == currentUser.ID {
+		return nil, errors.NewValidationError(constants.FieldID, "you cannot deactivate yourself")
+	}
+	transferTo := req.TransferToUserID
+	if transferTo != "" {
+		if err := s.checkTransferUser(ctx, userID, transferTo); err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := s.OwnershipReport(ctx, userID, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if transferTo == "" && len(report.PendingApprovals) > 0 {
+		return nil, errors.NewValidationError("transfer_to_user_id", fmt.Sprintf("is required: the user has %d pending approvals", len(report.PendingApprovals)))
+	}
+
+	// Records and active flows are collected before the transaction, since queries do not
+	// read through it
+	var ownedRecords map[string][]string
+	var activeFlows []models.SObject
+	if transferTo == "" {
+		activeFlows, err = s.findAll(ctx, constants.TableFlow, combineFilters(
+			fieldEquals(constants.FieldSysFlow_OwnerID, userID),
+			fieldEquals(constants.FieldSysFlow_Status, constants.FlowStatusActive),
+		), currentUser)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ownedRecords = make(map[string][]string, len(report.Records))
+		for _, owned := range report.Records {
+			ids, err := s.ownedRecordIDs(ctx, owned.ObjectAPIName, userID, currentUser)
+			if err != nil {
+				return nil, err
+			}
+			ownedRecords[owned.ObjectAPIName] = ids
+		}
+	}
+
+	err = s.persistence.RunInTransaction(ctx, func(tx *sql.Tx, txCtx context.Context) error {
+		for objectAPIName, ids := range ownedRecords {
+			if err := s.transferRecords(txCtx, objectAPIName, ids, transferTo, req.KeepManualShares, currentUser); err != nil {
+				return err
+			}
+		}
+
+		if transferTo != "" {
+			for _, flow := range report.Flows {
+				updates := models.SObject{constants.FieldSysFlow_OwnerID: transferTo}
+				if err := s.persistence.Update(WithSystemTableWrite(WithOwnerTransfer(txCtx)), constants.TableFlow, flow.ID, updates, currentUser); err != nil {
+					return fmt.Errorf("failed to transfer flow %s: %w", flow.Name, err)
+				}
+			}
+		}
+		for _, flow := range activeFlows {
+			updates := models.SObject{constants.FieldSysFlow_Status: constants.FlowStatusInactive}
+			if err := s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableFlow, flow.GetString(constants.FieldID), updates, currentUser); err != nil {
+				return fmt.Errorf("failed to deactivate flow %s: %w", flow.GetString(constants.FieldSysFlow_Name), err)
+			}
+		}
+
+		for _, item := range report.PendingApprovals {
+			updates := models.SObject{constants.FieldSysApprovalWorkItem_ApproverID: transferTo}
+			if err := s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableApprovalWorkItem, item.ID, updates, currentUser); err != nil {
+				return fmt.Errorf("failed to reassign approval %s: %w", item.ID, err)
+			}
+		}
+
+		for _, process := range report.ApprovalProcesses {
+			updates := models.SObject{constants.FieldSysApprovalProcess_IsActive: false}
+			if transferTo != "" {
+				updates = models.SObject{constants.FieldSysApprovalProcess_ApproverID: transferTo}
+			}
+			if err := s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableApprovalProcess, process.ID, updates, currentUser); err != nil {
+				return fmt.Errorf("failed to hand over approval process %s: %w", process.Name, err)
+			}
+		}
+
+		for _, dashboard := range report.Dashboards {
+			updates := models.SObject{
+				constants.FieldSysDashboard_RunAs:       string(constants.DashboardRunAsViewer),
+				constants.FieldSysDashboard_RunAsUserID: nil,
+			}
+			if transferTo != "" {
+				updates = models.SObject{constants.FieldSysDashboard_RunAsUserID: transferTo}
+			}
+			if err := s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableDashboard, dashboard.ID, updates, currentUser); err != nil {
+				return fmt.Errorf("failed to hand over dashboard %s: %w", dashboard.Name, err)
+			}
+		}
+
+		if transferTo != "" {
+			for _, r := range report.Reports {
+				updates := models.SObject{constants.FieldSysReport_OwnerID: transferTo}
+				if err := s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableReport, r.ID, updates, currentUser); err != nil {
+					return fmt.Errorf("failed to transfer report %s: %w", r.Name, err)
+				}
+			}
+		}
+
+		return s.persistence.Update(WithSystemTableWrite(txCtx), constants.TableUser, userID, models.SObject{constants.FieldIsActive: false}, currentUser)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(report.Flows) > 0 {
+		s.metadata.InvalidateCache()
+	}
+	if err := s.auth.sessionRepo.RevokeUserSessions(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	action := "frozen"
+	if transferTo != "" {
+		action = "transferred to " + transferTo
+	}
+	log.Printf("🚪 User %s deactivated by %s; ownership %s", userID, currentUser.ID, action)
+	return report, nil
+}
+
+// DeleteUser deletes a user who no longer owns or is responsible for anything, so deleting
+// never leaves records without an owner or approvals without an approver
+func (s *UserDeactivationService) DeleteUser(ctx context.Context, userID string, currentUser *models.UserSession) error {
+	report, err := s.OwnershipReport(ctx, userID, currentUser)
+	if err != nil {
+		return err
+	}
+	if dependents := ownershipDependents(report); len(dependents) > 0 {
+		return errors.NewDependencyError("User "+userID, dependents)
+	}
+	return s.auth.DeleteUser(ctx, userID)
+}
+
+// ownershipDependents summarizes a report as the items that block deleting the user
+func ownershipDependents(report *models.UserOwnershipReport) []string {
+	dependents := make([]string, 0)
+	for _, owned := range report.Records {
+		dependents = append(dependents, fmt.Sprintf("%d %s records", owned.Count, owned.ObjectAPIName))
+	}
+	for _, flow := range report.Flows {
+		dependents = append(dependents, "flow "+flow.Name)
+	}
+	if n := len(report.PendingApprovals); n > 0 {
+		dependents = append(dependents, fmt.Sprintf("%d pending approvals", n))
+	}
+	for _, process := range report.ApprovalProcesses {
+		dependents = append(dependents, "approval process "+process.Name)
+	}
+	for _, dashboard := range report.Dashboards {
+		dependents = append(dependents, "dashboard "+dashboard.Name)
+	}
+	for _, r := range report.Reports {
+		dependents = append(dependents, "report "+r.Name)
+	}
+	return dependents
+}
+
+func (s *UserDeactivationService) findUser(ctx context.Context, userID string) (*models.SystemUser, error) {
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if user == nil {
+		return nil, errors.NewNotFoundError("User", userID)
+	}
+	return user, nil
+}
+
+// checkTransferUser verifies that the receiving user is another active internal user
+func (s *UserDeactivationService) checkTransferUser(ctx context.Context, userID, transferTo string) error {
+	if transferTo == userID {
+		return errors.NewValidationError("transfer_to_user_id", "must be a different user")
+	}
+	target, err := s.findUser(ctx, transferTo)
+	if err != nil {
+		return err
+	}
+	if !target.IsActive {
+		return errors.NewValidationError("transfer_to_user_id", "must be an active user")
+	}
+	if target.UserType == constants.UserTypePortal {
+		return errors.NewValidationError("transfer_to_user_id", "cannot be a portal user")
+	}
+	return nil
+}
+
+// ownableObjects returns the objects whose records have an owner. System tables are covered
+// by the components of the report; external objects are read-only.
+func (s *UserDeactivationService) ownableObjects(ctx context.Context) []*models.ObjectMetadata {
+	objects := make([]*models.ObjectMetadata, 0)
+	for _, schema := range s.metadata.GetSchemas(ctx) {
+		if schema.IsExternal || strings.HasPrefix(schema.APIName, constants.SystemTablePrefix) {
+			continue
+		}
+		if FindField(schema, constants.FieldOwnerID) != nil {
+			objects = append(objects, schema)
+		}
+	}
+	return objects
+}
+
+// ownedRecordIDs collects the IDs of the records of an object the user owns
+func (s *UserDeactivationService) ownedRecordIDs(ctx context.Context, objectAPIName, userID string, currentUser *models.UserSession) ([]string, error) {
+	rows, err := s.findAll(ctx, objectAPIName, fieldEquals(constants.FieldOwnerID, userID), currentUser)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.GetString(constants.FieldID))
+	}
+	return ids, nil
+}
+
+// transferRecords changes the owner of records, revoking the manual shares on them unless
+// they are kept
+func (s *UserDeactivationService) transferRecords(ctx context.Context, objectAPIName string, ids []string, transferTo string, keepManualShares bool, currentUser *models.UserSession) error {
+	ctx = WithOwnerTransfer(ctx)
+	for _, id := range ids {
+		if err := s.persistence.Update(ctx, objectAPIName, id, models.SObject{constants.FieldOwnerID: transferTo}, currentUser); err != nil {
+			return fmt.Errorf("failed to transfer %s/%s: %w", objectAPIName, id, err)
+		}
+	}
+	if keepManualShares || len(ids) == 0 {
+		return nil
+	}
+	_, err := s.permissions.RevokeManualShares(ctx, objectAPIName, ids)
+	return err
+}
+
+// findAll reads every row of an object matching filter, in ID order and in batches so query
+// row limits do not cut the result short
+func (s *UserDeactivationService) findAll(ctx context.Context, objectAPIName, filter string, currentUser *models.UserSession) ([]models.SObject, error) {
+	all := make([]models.SObject, 0)
+	lastID := ""
+	for {
+		batchFilter := filter
+		if lastID != "" {
+			batchFilter = combineFilters(fmt.Sprintf("%s > %s", constants.FieldID, strconv.Quote(lastID)), filter)
+		}
+		rows, err := s.query.Query(ctx, models.QueryRequest{
+			ObjectAPIName:   objectAPIName,
+			FilterExpr:      batchFilter,
+			SortField:       constants.FieldID,
+			SortDirection:   constants.SortASC,
+			Limit:           ownershipBatchSize,
+			SkipLookupNames: true,
+		}, currentUser)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", objectAPIName, err)
+		}
+		all = append(all, rows...)
+		if len(rows) < ownershipBatchSize {
+			return all, nil
+		}
+		lastID = rows[len(rows)-1].GetString(constants.FieldID)
+	}
+}
+
+// fieldEquals is a filter expression matching rows whose field equals value
+func fieldEquals(field, value string) string {
+	return fmt.Sprintf("%s == %s", field, strconv.Quote(value))
+}
+
+func ownedComponent(row models.SObject, nameField, detail string) models.OwnedComponent {
+	return models.OwnedComponent{
+		ID:     row.GetString(constants.FieldID),
+		Name:   row.GetString(nameField),
+		Detail: detail,
+	}
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnershipDependents(t *testing.T) {
+	assert.Empty(t, ownershipDependents(&models.UserOwnershipReport{UserID: "u1"}))
+
+	report := &models.UserOwnershipReport{
+		UserID:           "u1",
+		Records:          []models.OwnedRecordCount{{ObjectAPIName: "account", Count: 12}},
+		Flows:            []models.OwnedComponent{{ID: "f1", Name: "Nightly Sync"}},
+		PendingApprovals: []models.OwnedComponent{{ID: "w1"}, {ID: "w2"}},
+		Dashboards:       []models.OwnedComponent{{ID: "d1", Name: "Pipeline"}},
+	}
+	assert.Equal(t, []string{
+		"12 account records",
+		"flow Nightly Sync",
+		"2 pending approvals",
+		"dashboard Pipeline",
+	}, ownershipDependents(report))
+}
+
+func TestFieldEquals(t *testing.T) {
+	assert.Equal(t, `__sys_gen_owner_id == "u\"1"`, fieldEquals("__sys_gen_owner_id", `u"1`))
+}
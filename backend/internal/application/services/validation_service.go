@@ -7,6 +7,7 @@ import (
 
 	"fmt"
 
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
 	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/backend/pkg/fieldtypes"
 	"github.com/nexuscrm/backend/pkg/formula"
@@ -49,7 +50,7 @@ func (vs *ValidationService) ValidateRecord(
 		// Required Check
 		if field.Required {
 			if !exists || val == nil || val == "" {
-				return errors.NewValidationError(field.APIName, "is required")
+				return errors.NewFieldValidationError(constants.ErrorCodeRequiredFieldMissing, field.APIName, "is required")
 			}
 		}
 
@@ -69,10 +70,10 @@ func (vs *ValidationService) ValidateRecord(
 					if message == "" {
 						message = "invalid format"
 					}
-					return errors.NewValidationError(field.APIName, message)
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, message)
 				}
 			} else {
-				return errors.NewValidationError(field.APIName, "expected string for "+fieldType)
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected string for "+fieldType)
 			}
 		}
 
@@ -83,20 +84,20 @@ func (vs *ValidationService) ValidateRecord(
 				// Also allow string "true"/"false" or "0"/"1"
 				if strVal, ok := val.(string); ok {
 					if _, err := strconv.ParseBool(strVal); err != nil {
-						return errors.NewValidationError(field.APIName, "expected boolean")
+						return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected boolean")
 					}
 				} else if intVal, ok := val.(int); ok {
 					// Allow 0 or 1
 					if intVal != 0 && intVal != 1 {
-						return errors.NewValidationError(field.APIName, "expected boolean (0/1)")
+						return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected boolean (0/1)")
 					}
 				} else if int64Val, ok := val.(int64); ok {
 					// Allow 0 or 1
 					if int64Val != 0 && int64Val != 1 {
-						return errors.NewValidationError(field.APIName, "expected boolean (0/1)")
+						return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected boolean (0/1)")
 					}
 				} else {
-					return errors.NewValidationError(field.APIName, "expected boolean")
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected boolean")
 				}
 			}
 		case string(constants.FieldTypeNumber), string(constants.FieldTypeCurrency), string(constants.FieldTypePercent):
@@ -106,20 +107,24 @@ func (vs *ValidationService) ValidateRecord(
 				// OK
 			case string:
 				if _, err := strconv.ParseFloat(v, 64); err != nil {
-					return errors.NewValidationError(field.APIName, "expected numeric value")
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected numeric value")
 				}
 			default:
-				return errors.NewValidationError(field.APIName, "expected numeric value")
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "expected numeric value")
+			}
+		case string(constants.FieldTypeGeolocation):
+			if _, err := persistence.ParseGeolocation(val); err != nil {
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, err.Error())
 			}
 		}
 
 		// Length Checks for String types
 		if strVal, ok := val.(string); ok {
 			if field.MinLength != nil && len(strVal) < *field.MinLength {
-				return errors.NewValidationError(field.APIName, "is too short")
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "is too short")
 			}
 			if field.MaxLength != nil && len(strVal) > *field.MaxLength {
-				return errors.NewValidationError(field.APIName, "is too long")
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "is too long")
 			}
 
 			// Regex Check
@@ -130,7 +135,7 @@ func (vs *ValidationService) ValidateRecord(
 					if field.RegexMessage != nil {
 						msg = *field.RegexMessage
 					}
-					return errors.NewValidationError(field.APIName, msg)
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, msg)
 				}
 			}
 		}
@@ -160,10 +165,10 @@ func (vs *ValidationService) ValidateRecord(
 
 			if isNum {
 				if field.MinValue != nil && numVal < *field.MinValue {
-					return errors.NewValidationError(field.APIName, "value is too small")
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "value is too small")
 				}
 				if field.MaxValue != nil && numVal > *field.MaxValue {
-					return errors.NewValidationError(field.APIName, "value is too large")
+					return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, "value is too large")
 				}
 			}
 		}
@@ -183,7 +188,7 @@ func (vs *ValidationService) ValidateRecord(
 			}
 
 			if err := vs.validator.Validate(validatorName, val, config); err != nil {
-				return errors.NewValidationError(field.APIName, err.Error())
+				return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, field.APIName, err.Error())
 			}
 		}
 	}
@@ -239,7 +244,7 @@ func (vs *ValidationService) ValidateRecord(
 			}
 
 			if isTrue, ok := result.(bool); ok && isTrue {
-				return errors.NewValidationError("", rule.ErrorMessage)
+				return errors.NewFieldValidationError(constants.ErrorCodeValidationRuleFailed, "", rule.ErrorMessage)
 			}
 		}
 	}
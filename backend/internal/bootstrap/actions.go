@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -23,7 +24,7 @@ type StandardActionDef struct {
 }
 
 // InitializeStandardActions ensures standard actions exist for core objects
-func InitializeStandardActions(metadataService *services.MetadataService) error {
+func InitializeStandardActions(ctx context.Context, metadataService *services.MetadataService) error {
 	log.Println("🔧 Initializing standard actions...")
 
 	var actions []StandardActionDef
@@ -32,9 +33,10 @@ func InitializeStandardActions(metadataService *services.MetadataService) error
 	}
 
 	// Step 2: Ensure 'Edit' and 'Delete' exist for ALL objects in metadata
-	schemas := metadataService.GetSchemas(context.Background())
+	schemas := metadataService.GetSchemas(ctx)
 	log.Printf("   ...Ensuring %d standard actions for %d objects...", len(actions), len(schemas))
 
+	var failed []error
 	for _, schema := range schemas {
 		for _, action := range actions {
 			newAction := &models.ActionMetadata{
@@ -45,15 +47,16 @@ func InitializeStandardActions(metadataService *services.MetadataService) error
 				Icon:          action.Icon,
 			}
 
-			if err := metadataService.CreateAction(context.Background(), newAction); err != nil {
+			if err := metadataService.CreateAction(ctx, newAction); err != nil {
 				// Only log if it's not a duplicate/exists error
 				errStr := err.Error()
 				if !strings.Contains(errStr, "already exists") {
 					log.Printf("   ⚠️  Failed to create action %s.%s: %v", schema.APIName, action.Name, err)
+					failed = append(failed, fmt.Errorf("action %s.%s: %w", schema.APIName, action.Name, err))
 				}
 			}
 		}
 	}
 
-	return nil
+	return errors.Join(failed...)
 }
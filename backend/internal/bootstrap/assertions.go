@@ -304,10 +304,21 @@ func assertSystemAdminProfileExists(db *sql.DB, result *AssertionResult) {
 	}
 }
 
-// assertSystemAdminUserExists ensures there is at least one active admin user
+// assertSystemAdminUserExists ensures there is at least one active admin user once first-run
+// setup has created the first one
 func assertSystemAdminUserExists(db *sql.DB, result *AssertionResult) {
 	log.Println("   📋 Checking for active System Admin user...")
 
+	var users int
+	if err := db.QueryRow("SELECT COUNT(*) FROM " + constants.TableUser).Scan(&users); err != nil {
+		log.Printf("   ⚠️  Could not query users: %v", err)
+		return
+	}
+	if users == 0 {
+		log.Println("   ⏳ No users yet: first-run setup is pending")
+		return
+	}
+
 	var count int
 	err := db.QueryRow("SELECT COUNT(*) FROM "+constants.TableUser+" WHERE profile_id = ? AND is_active = true", constants.ProfileSystemAdmin).Scan(&count)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
 )
 
@@ -81,7 +82,7 @@ func assertFieldColumnMapping(db *sql.DB, result *AssertionResult) {
 		_ = fieldRows.Close()
 
 		// Get actual columns from DB
-		cols, err := db.Query("SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ?", obj.Name)
+		cols, err := db.Query(fmt.Sprintf("SELECT column_name FROM information_schema.columns WHERE table_schema = %s AND table_name = ?", query.ActiveDialect().CurrentSchema()), obj.Name)
 		if err != nil {
 			log.Printf("   ⚠️  Could not query schema for table %s: %v", obj.Name, err)
 			continue
@@ -205,13 +206,13 @@ func assertConstraintConsistency(db *sql.DB, result *AssertionResult) {
 		}
 
 		// Check information_schema.statistics for non_unique = 0
-		idxRows, err := db.Query(`
+		idxRows, err := db.Query(fmt.Sprintf(`
 			SELECT index_name FROM information_schema.statistics 
-			WHERE table_schema = DATABASE() 
+			WHERE table_schema = %s 
 			  AND table_name = ? 
 			  AND column_name = ? 
 			  AND non_unique = 0
-		`, tableName, colName)
+		`, query.ActiveDialect().CurrentSchema()), tableName, colName)
 
 		if err != nil {
 			continue
@@ -0,0 +1,75 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/secretstore"
+	"github.com/nexuscrm/backend/pkg/auth"
+	"github.com/nexuscrm/backend/pkg/secrets"
+)
+
+// SecretEnvKeys are the environment variables that may hold a secret reference instead of
+// the secret itself
+var SecretEnvKeys = []string{
+	"JWT_SECRET",
+	"JWT_PREVIOUS_SECRETS",
+	"JWT_PRIVATE_KEY",
+	"CREDENTIAL_ENCRYPTION_KEY",
+	"TIDB_PASSWORD",
+	"TIDB_REPLICA_DSN",
+	"POSTGRES_DSN",
+	"POSTGRES_REPLICA_DSN",
+	"LLM_API_KEY",
+	"EMBEDDING_API_KEY",
+	"MEILISEARCH_API_KEY",
+	"SCIM_TOKEN",
+	"GOOGLE_OAUTH_CLIENT_SECRET",
+	"MICROSOFT_OAUTH_CLIENT_SECRET",
+	"TWILIO_AUTH_TOKEN",
+}
+
+// LoadConfig prepares the process configuration the server and the admin CLI share. It
+// parses the server flags in args and loads the .env files (see config.LoadEnv), resolves
+// the secret references (vault:..., aws:..., env:...) of SecretEnvKeys, then reads and
+// validates the typed configuration, loads the JWT signing keys and sets the credential
+// encryption key. It runs before the database is opened, since the database credentials
+// may be secret references. The resolver is returned for secret references read later,
+// such as those in _System_Config.
+func LoadConfig(ctx context.Context, args []string) (*config.Config, *secretstore.Resolver, error) {
+	if err := config.LoadEnv(args); err != nil {
+		return nil, nil, err
+	}
+	stores, err := config.SecretStoresFromEnv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure secret stores: %w", err)
+	}
+	resolver := secretstore.New(stores)
+	if err := resolver.ResolveEnv(ctx, SecretEnvKeys...); err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve secrets: %w", err)
+	}
+	cfg, err := config.FromEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := auth.Configure(keyConfig(cfg.Auth)); err != nil {
+		return nil, nil, fmt.Errorf("failed to load JWT signing keys: %w", err)
+	}
+	secrets.SetEncryptionKey(cfg.Auth.CredentialEncryptionKey)
+	return cfg, resolver, nil
+}
+
+// keyConfig returns the settings pkg/auth builds the JWT key ring from
+func keyConfig(a config.Auth) auth.KeyConfig {
+	return auth.KeyConfig{
+		Algorithm:          a.Algorithm,
+		Secret:             a.Secret,
+		PreviousSecrets:    a.PreviousSecrets,
+		PrivateKey:         a.PrivateKey,
+		PreviousPublicKeys: a.PreviousPublicKeys,
+		KeyID:              a.KeyID,
+		Issuer:             a.Issuer,
+		Audiences:          a.Audiences,
+	}
+}
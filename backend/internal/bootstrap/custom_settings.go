@@ -0,0 +1,30 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+)
+
+// MigrateLegacyConfig copies the flat _System_Config entries into custom settings as org
+// defaults. It is idempotent: settings that already exist are never overwritten, so values
+// changed through the custom settings API survive restarts.
+func MigrateLegacyConfig(ctx context.Context, svcMgr *services.ServiceManager) error {
+	configs, err := svcMgr.System.GetLegacyConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read legacy config: %w", err)
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+
+	log.Println("🔧 Migrating legacy config to custom settings...")
+	imported, err := svcMgr.Settings.ImportLegacyConfig(ctx, configs)
+	if err != nil {
+		return err
+	}
+	log.Printf("   ✅ Migrated %d of %d legacy config entries", imported, len(configs))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 
@@ -15,7 +16,7 @@ import (
 var flowsJSON []byte
 
 // InitializeFlows ensures standard flows exist
-func InitializeFlows(metadata *services.MetadataService) error {
+func InitializeFlows(ctx context.Context, metadata *services.MetadataService) error {
 	log.Println("🔧 Initializing flows...")
 
 	var flows []models.Flow
@@ -23,28 +24,31 @@ func InitializeFlows(metadata *services.MetadataService) error {
 		return fmt.Errorf("failed to parse flows.json: %w", err)
 	}
 
+	var failed []error
 	for _, flow := range flows {
 		// Check if flow exists
-		existing := metadata.GetFlow(context.Background(), flow.ID)
+		existing := metadata.GetFlow(ctx, flow.ID)
 		if existing != nil {
 			// Update existing flow
 			log.Printf("   🔄 Flow %s already exists, updating...", flow.Name)
 			// Apply updates (status, logic, etc.)
 			// Enforce system flow definition.
-			if err := metadata.UpdateFlow(context.Background(), flow.ID, &flow); err != nil {
+			if err := metadata.UpdateFlow(ctx, flow.ID, &flow); err != nil {
 				log.Printf("   ⚠️  Failed to update flow %s: %v", flow.Name, err)
+				failed = append(failed, fmt.Errorf("flow %s: %w", flow.Name, err))
 			}
 		} else {
 			// Create new flow
 			// Ensure ID consistency from JSON definition.
 			// CreateFlow should respect the provided ID to allow idempotent updates.
-			if err := metadata.CreateFlow(context.Background(), &flow); err != nil {
+			if err := metadata.CreateFlow(ctx, &flow); err != nil {
 				log.Printf("   ⚠️  Failed to create flow %s: %v", flow.Name, err)
+				failed = append(failed, fmt.Errorf("flow %s: %w", flow.Name, err))
 			} else {
 				log.Printf("   ✅ Flow %s created", flow.Name)
 			}
 		}
 	}
 
-	return nil
+	return errors.Join(failed...)
 }
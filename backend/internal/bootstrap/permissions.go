@@ -3,6 +3,8 @@ package bootstrap
 import (
 	"context"
 	_ "embed"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/nexuscrm/backend/internal/application/services"
@@ -31,7 +33,7 @@ type PermissionsData struct {
 
 // InitializePermissions seeds default object permissions for all profiles
 // It first seeds from permissions.json, then ensures ALL objects in metadata have permissions
-func InitializePermissions(permSvc *services.PermissionService, metadata *services.MetadataService) error {
+func InitializePermissions(ctx context.Context, permSvc *services.PermissionService, metadata *services.MetadataService) error {
 	log.Println("🔧 Initializing permissions...")
 
 	// Step 1: Seed from JSON file (explicit overrides) removed - files missing
@@ -39,10 +41,11 @@ func InitializePermissions(permSvc *services.PermissionService, metadata *servic
 
 	// Step 2: Ensure ALL objects in metadata have permissions for system_admin and standard_user
 	profiles := []string{constants.ProfileSystemAdmin, constants.ProfileStandardUser}
-	schemas := metadata.GetSchemas(context.Background())
+	schemas := metadata.GetSchemas(ctx)
 
 	log.Printf("   ...Batching permission updates for %d objects across %d profiles...", len(schemas), len(profiles))
 
+	var failed []error
 	for _, profileID := range profiles {
 		if seededObjects[profileID] == nil {
 			seededObjects[profileID] = make(map[string]bool)
@@ -86,6 +89,7 @@ func InitializePermissions(permSvc *services.PermissionService, metadata *servic
 			// Performance impact is negligible for bootstrap
 			if err := permSvc.UpdateObjectPermission(perm); err != nil {
 				log.Printf("   ⚠️  Failed to auto-seed permissions for %s/%s: %v", profileID, schema.APIName, err)
+				failed = append(failed, fmt.Errorf("permissions %s/%s: %w", profileID, schema.APIName, err))
 			}
 
 			seededObjects[profileID][schema.APIName] = true
@@ -93,5 +97,5 @@ func InitializePermissions(permSvc *services.PermissionService, metadata *servic
 	}
 
 	log.Printf("   ✅ Permissions initialized for %d objects across %d profiles", len(schemas), len(profiles))
-	return nil
+	return errors.Join(failed...)
 }
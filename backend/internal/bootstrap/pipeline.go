@@ -0,0 +1,308 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Step is one unit of startup work in a Pipeline
+type Step struct {
+	Name string
+	// After lists the steps that must complete before this one starts
+	After []string
+	// Required steps abort startup when they fail; other failures are reported and only
+	// block the steps that depend on them
+	Required bool
+	// Checksum fingerprints the input of the step. The step is skipped while the checksum
+	// matches the one stored by its last successful run; nil means the step always runs.
+	Checksum func(ctx context.Context) (string, error)
+	// Present confirms that what an unchanged step set up is still in place, such as tables
+	// dropped by hand since; the step runs anyway when it reports false or fails
+	Present func(ctx context.Context) (bool, error)
+	Run     func(ctx context.Context) error
+}
+
+// StepStatus is the outcome of a step
+type StepStatus string
+
+const (
+	StepDone      StepStatus = "done"
+	StepUnchanged StepStatus = "unchanged"
+	StepFailed    StepStatus = "failed"
+	// StepBlocked steps never started because a dependency failed or startup was canceled
+	StepBlocked StepStatus = "blocked"
+)
+
+// StepResult reports how a step ended and how long it took
+type StepResult struct {
+	Name     string
+	Status   StepStatus
+	Duration time.Duration
+	Err      error
+}
+
+// ChecksumStore keeps the checksum of each step's last successful run between restarts
+type ChecksumStore interface {
+	GetChecksum(ctx context.Context, stepName string) (string, error)
+	SaveStep(ctx context.Context, stepName, checksum string, duration time.Duration) error
+}
+
+// Pipeline runs steps in dependency order, starting independent steps in parallel
+type Pipeline struct {
+	steps []Step
+	index map[string]int
+
+	// Store enables skipping unchanged steps; nil runs every step
+	Store ChecksumStore
+	// Force runs every step even when its checksum is unchanged
+	Force bool
+	// Parallelism caps the steps running at once (0 means no cap)
+	Parallelism int
+	// Progress is called as each step ends, after the result is logged
+	Progress func(done, total int, result StepResult)
+}
+
+// NewPipeline validates the step graph: names are unique, dependencies exist and there are
+// no cycles
+func NewPipeline(steps ...Step) (*Pipeline, error) {
+	p := &Pipeline{steps: steps, index: make(map[string]int, len(steps))}
+	for i, step := range steps {
+		if step.Name == "" || step.Run == nil {
+			return nil, fmt.Errorf("bootstrap step %d needs a name and a Run function", i)
+		}
+		if _, dup := p.index[step.Name]; dup {
+			return nil, fmt.Errorf("duplicate bootstrap step %q", step.Name)
+		}
+		p.index[step.Name] = i
+	}
+	for _, step := range steps {
+		for _, dep := range step.After {
+			if _, ok := p.index[dep]; !ok {
+				return nil, fmt.Errorf("bootstrap step %q depends on unknown step %q", step.Name, dep)
+			}
+		}
+	}
+
+	// Depth-first search for a cycle: 1 = on the current path, 2 = finished
+	state := make([]int, len(steps))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case 1:
+			return fmt.Errorf("bootstrap step %q is part of a dependency cycle", steps[i].Name)
+		case 2:
+			return nil
+		}
+		state[i] = 1
+		for _, dep := range steps[i].After {
+			if err := visit(p.index[dep]); err != nil {
+				return err
+			}
+		}
+		state[i] = 2
+		return nil
+	}
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Run executes the pipeline and returns one result per step, in declaration order. The
+// error is the first required step that failed or was blocked, or the cancellation of ctx;
+// either one cancels the steps still running and keeps new ones from starting.
+func (p *Pipeline) Run(parent context.Context) ([]StepResult, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	n := len(p.steps)
+	results := make([]StepResult, n)
+	pending := make([]int, n)
+	dependents := make([][]int, n)
+	for i, step := range p.steps {
+		results[i].Name = step.Name
+		pending[i] = len(step.After)
+		for _, dep := range step.After {
+			d := p.index[dep]
+			dependents[d] = append(dependents[d], i)
+		}
+	}
+
+	var (
+		ready    []int
+		running  int
+		finished int
+		firstErr error
+	)
+	for i := range p.steps {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	type outcome struct {
+		index  int
+		result StepResult
+	}
+	outcomes := make(chan outcome)
+
+	// finish records a result and releases or blocks the dependents of the step
+	var finish func(i int, result StepResult)
+	finish = func(i int, result StepResult) {
+		if results[i].Status != "" {
+			return // already blocked through another failed dependency
+		}
+		results[i] = result
+		finished++
+		p.report(finished, n, result)
+
+		if result.Status == StepFailed || result.Status == StepBlocked {
+			if p.steps[i].Required && firstErr == nil {
+				firstErr = fmt.Errorf("bootstrap step %s: %w", result.Name, result.Err)
+				cancel()
+			}
+			for _, d := range dependents[i] {
+				finish(d, StepResult{
+					Name:   p.steps[d].Name,
+					Status: StepBlocked,
+					Err:    fmt.Errorf("dependency %s did not complete", result.Name),
+				})
+			}
+			return
+		}
+		for _, d := range dependents[i] {
+			if pending[d]--; pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	started := make([]bool, n)
+	for finished < n {
+		if ctx.Err() != nil {
+			// Nothing new starts once startup is canceled
+			for _, i := range ready {
+				if results[i].Status == "" {
+					finish(i, StepResult{Name: p.steps[i].Name, Status: StepBlocked, Err: ctx.Err()})
+				}
+			}
+			ready = nil
+		}
+		for len(ready) > 0 && (p.Parallelism <= 0 || running < p.Parallelism) {
+			i := ready[0]
+			ready = ready[1:]
+			if started[i] || results[i].Status != "" {
+				continue
+			}
+			started[i] = true
+			running++
+			go func(i int) {
+				outcomes <- outcome{index: i, result: p.runStep(ctx, p.steps[i])}
+			}(i)
+		}
+		if running == 0 {
+			// Everything left was blocked by a failed dependency or the cancellation
+			if len(ready) == 0 {
+				break
+			}
+			continue
+		}
+		o := <-outcomes
+		running--
+		finish(o.index, o.result)
+	}
+
+	if firstErr == nil {
+		firstErr = parent.Err()
+	}
+	return results, firstErr
+}
+
+// runStep runs one step unless its checksum is unchanged, and stores the new checksum
+// when it completes
+func (p *Pipeline) runStep(ctx context.Context, step Step) StepResult {
+	start := time.Now()
+	result := StepResult{Name: step.Name}
+
+	checksum := ""
+	if step.Checksum != nil && p.Store != nil {
+		sum, err := step.Checksum(ctx)
+		if err != nil {
+			log.Printf("   ⚠️  Failed to compute the checksum of bootstrap step %s, running it: %v", step.Name, err)
+		} else {
+			checksum = sum
+			// A missing table (first start) reads as an unknown checksum
+			if stored, err := p.Store.GetChecksum(ctx, step.Name); err == nil && stored == checksum && !p.Force && present(ctx, step) {
+				result.Status = StepUnchanged
+				result.Duration = time.Since(start)
+				return result
+			}
+		}
+	}
+
+	if err := step.Run(ctx); err != nil {
+		result.Status = StepFailed
+		result.Err = err
+		result.Duration = time.Since(start)
+		return result
+	}
+	result.Status = StepDone
+	result.Duration = time.Since(start)
+
+	if checksum != "" {
+		if err := p.Store.SaveStep(ctx, step.Name, checksum, result.Duration); err != nil {
+			log.Printf("   ⚠️  Failed to store the checksum of bootstrap step %s: %v", step.Name, err)
+		}
+	}
+	return result
+}
+
+// report logs the end of a step and passes it on to the Progress callback
+func (p *Pipeline) report(done, total int, result StepResult) {
+	switch result.Status {
+	case StepDone:
+		log.Printf("✅ [%d/%d] %s (%s)", done, total, result.Name, result.Duration.Round(time.Millisecond))
+	case StepUnchanged:
+		log.Printf("⏭️  [%d/%d] %s unchanged, skipped", done, total, result.Name)
+	case StepFailed:
+		log.Printf("⚠️  [%d/%d] %s failed after %s: %v", done, total, result.Name, result.Duration.Round(time.Millisecond), result.Err)
+	case StepBlocked:
+		log.Printf("⚠️  [%d/%d] %s not run: %v", done, total, result.Name, result.Err)
+	}
+	if p.Progress != nil {
+		p.Progress(done, total, result)
+	}
+}
+
+// Checksum hashes the parts of a step's input into a hex SHA-256 digest
+func Checksum(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// Length-prefix each part so ("ab", "c") and ("a", "bc") differ
+		fmt.Fprintf(h, "%d:", len(part))
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// present reports whether an unchanged step may be skipped as far as its Present check goes
+func present(ctx context.Context, step Step) bool {
+	if step.Present == nil {
+		return true
+	}
+	ok, err := step.Present(ctx)
+	if err != nil {
+		log.Printf("   ⚠️  Failed to check bootstrap step %s, running it: %v", step.Name, err)
+		return false
+	}
+	if !ok {
+		log.Printf("   🔁 Bootstrap step %s is unchanged but its output is missing, running it", step.Name)
+	}
+	return ok
+}
@@ -0,0 +1,235 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryChecksumStore struct {
+	mu        sync.Mutex
+	checksums map[string]string
+}
+
+func (s *memoryChecksumStore) GetChecksum(ctx context.Context, stepName string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checksums[stepName], nil
+}
+
+func (s *memoryChecksumStore) SaveStep(ctx context.Context, stepName, checksum string, duration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checksums[stepName] = checksum
+	return nil
+}
+
+func statuses(results []StepResult) map[string]StepStatus {
+	out := make(map[string]StepStatus, len(results))
+	for _, r := range results {
+		out[r.Name] = r.Status
+	}
+	return out
+}
+
+func TestNewPipeline_ValidatesGraph(t *testing.T) {
+	noop := func(context.Context) error { return nil }
+
+	_, err := NewPipeline(Step{Name: "a", Run: noop}, Step{Name: "a", Run: noop})
+	assert.ErrorContains(t, err, "duplicate")
+
+	_, err = NewPipeline(Step{Name: "a", After: []string{"missing"}, Run: noop})
+	assert.ErrorContains(t, err, "unknown step")
+
+	_, err = NewPipeline(
+		Step{Name: "a", After: []string{"c"}, Run: noop},
+		Step{Name: "b", After: []string{"a"}, Run: noop},
+		Step{Name: "c", After: []string{"b"}, Run: noop},
+	)
+	assert.ErrorContains(t, err, "cycle")
+}
+
+func TestPipeline_RunsDependenciesFirstAndIndependentStepsInParallel(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+
+	// b and c wait for each other, so they only finish if they run at the same time
+	bStarted, cStarted := make(chan struct{}), make(chan struct{})
+	p, err := NewPipeline(
+		Step{Name: "a", Run: func(context.Context) error { record("a"); return nil }},
+		Step{Name: "b", After: []string{"a"}, Run: func(context.Context) error {
+			close(bStarted)
+			<-cStarted
+			record("b")
+			return nil
+		}},
+		Step{Name: "c", After: []string{"a"}, Run: func(context.Context) error {
+			close(cStarted)
+			<-bStarted
+			record("c")
+			return nil
+		}},
+		Step{Name: "d", After: []string{"b", "c"}, Run: func(context.Context) error { record("d"); return nil }},
+	)
+	require.NoError(t, err)
+
+	var progress []int
+	p.Progress = func(done, total int, result StepResult) {
+		assert.Equal(t, 4, total)
+		progress = append(progress, done)
+	}
+
+	results, err := p.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]StepStatus{"a": StepDone, "b": StepDone, "c": StepDone, "d": StepDone}, statuses(results))
+	assert.Equal(t, []int{1, 2, 3, 4}, progress)
+	require.Len(t, order, 4)
+	assert.Equal(t, "a", order[0])
+	assert.Equal(t, "d", order[3])
+}
+
+func TestPipeline_SkipsUnchangedSteps(t *testing.T) {
+	store := &memoryChecksumStore{checksums: map[string]string{}}
+	input := "v1"
+	runs := 0
+	steps := func() *Pipeline {
+		p, err := NewPipeline(
+			Step{
+				Name:     "seed",
+				Checksum: func(context.Context) (string, error) { return Checksum([]byte(input)), nil },
+				Run:      func(context.Context) error { runs++; return nil },
+			},
+		)
+		require.NoError(t, err)
+		p.Store = store
+		return p
+	}
+
+	results, err := steps().Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StepDone, results[0].Status)
+
+	results, err = steps().Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StepUnchanged, results[0].Status)
+	assert.Equal(t, 1, runs)
+
+	forced := steps()
+	forced.Force = true
+	results, err = forced.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StepDone, results[0].Status)
+	assert.Equal(t, 2, runs)
+
+	input = "v2"
+	results, err = steps().Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StepDone, results[0].Status)
+	assert.Equal(t, 3, runs)
+}
+
+func TestPipeline_RunsUnchangedStepsWhoseOutputIsMissing(t *testing.T) {
+	store := &memoryChecksumStore{checksums: map[string]string{"seed": "sum"}}
+	present := true
+	runs := 0
+	run := func() StepStatus {
+		p, err := NewPipeline(Step{
+			Name:     "seed",
+			Checksum: func(context.Context) (string, error) { return "sum", nil },
+			Present:  func(context.Context) (bool, error) { return present, nil },
+			Run:      func(context.Context) error { runs++; present = true; return nil },
+		})
+		require.NoError(t, err)
+		p.Store = store
+		results, err := p.Run(context.Background())
+		require.NoError(t, err)
+		return results[0].Status
+	}
+
+	assert.Equal(t, StepUnchanged, run())
+	present = false
+	assert.Equal(t, StepDone, run())
+	assert.Equal(t, StepUnchanged, run())
+	assert.Equal(t, 1, runs)
+}
+
+func TestPipeline_FailedStepDoesNotStoreItsChecksum(t *testing.T) {
+	store := &memoryChecksumStore{checksums: map[string]string{}}
+	p, err := NewPipeline(Step{
+		Name:     "seed",
+		Checksum: func(context.Context) (string, error) { return "sum", nil },
+		Run:      func(context.Context) error { return errors.New("boom") },
+	})
+	require.NoError(t, err)
+	p.Store = store
+
+	results, err := p.Run(context.Background())
+	require.NoError(t, err, "optional steps only report their failure")
+	assert.Equal(t, StepFailed, results[0].Status)
+	assert.Empty(t, store.checksums)
+}
+
+func TestPipeline_FailuresBlockDependents(t *testing.T) {
+	noop := func(context.Context) error { return nil }
+	p, err := NewPipeline(
+		Step{Name: "optional", Run: func(context.Context) error { return errors.New("boom") }},
+		Step{Name: "after_optional", After: []string{"optional"}, Run: noop},
+		Step{Name: "independent", Run: noop},
+	)
+	require.NoError(t, err)
+
+	results, err := p.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]StepStatus{
+		"optional":       StepFailed,
+		"after_optional": StepBlocked,
+		"independent":    StepDone,
+	}, statuses(results))
+
+	// A required failure cancels the steps still running and fails the pipeline
+	canceled := make(chan struct{})
+	p, err = NewPipeline(
+		Step{Name: "schema", Required: true, Run: func(context.Context) error { return errors.New("no database") }},
+		Step{Name: "services", After: []string{"schema"}, Run: noop},
+		Step{Name: "slow", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			close(canceled)
+			return ctx.Err()
+		}},
+	)
+	require.NoError(t, err)
+
+	results, err = p.Run(context.Background())
+	assert.ErrorContains(t, err, "bootstrap step schema: no database")
+	assert.Equal(t, map[string]StepStatus{
+		"schema":   StepFailed,
+		"services": StepBlocked,
+		"slow":     StepFailed,
+	}, statuses(results))
+	<-canceled
+}
+
+func TestPipeline_StopsOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ran := false
+	p, err := NewPipeline(
+		Step{Name: "first", Run: func(context.Context) error { cancel(); return nil }},
+		Step{Name: "second", After: []string{"first"}, Run: func(context.Context) error { ran = true; return nil }},
+	)
+	require.NoError(t, err)
+
+	results, err := p.Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, ran)
+	assert.Equal(t, map[string]StepStatus{"first": StepDone, "second": StepBlocked}, statuses(results))
+}
@@ -14,7 +14,7 @@ import (
 
 // InitializeSchema creates core system tables using SchemaManager
 // This replaces raw SQL execution with declarative table definitions
-func InitializeSchema(db *database.TiDBConnection) error {
+func InitializeSchema(ctx context.Context, db *database.TiDBConnection) error {
 	log.Println("🔧 Initializing core system schema...")
 
 	// Create SchemaManager for centralized DDL execution
@@ -31,7 +31,7 @@ func InitializeSchema(db *database.TiDBConnection) error {
 		for _, def := range tableDefs {
 			if def.TableName == coreName {
 				log.Printf("🧱 Pre-creating physical table: %s", coreName)
-				if err := schemaMgr.CreatePhysicalTable(context.Background(), def); err != nil {
+				if err := schemaMgr.CreatePhysicalTable(ctx, def); err != nil {
 					log.Printf("⚠️  Warning pre-creating %s: %v", coreName, err)
 					return err
 				}
@@ -58,7 +58,7 @@ func InitializeSchema(db *database.TiDBConnection) error {
 
 	// BatchCreatePhysicalTables handles parallel DDL and batch registration internally
 	log.Println("⚡️ Creating tables and registering (Super Batch)...")
-	if err := schemaMgr.BatchCreatePhysicalTables(context.Background(), remainingDefs); err != nil {
+	if err := schemaMgr.BatchCreatePhysicalTables(ctx, remainingDefs); err != nil {
 		log.Printf("   ⚠️  Batch physical table creation failed: %v", err)
 		return err
 	}
@@ -98,7 +98,7 @@ func InitializeSchema(db *database.TiDBConnection) error {
 	}
 
 	// Batch insert objects (single statement) within a Transaction
-	tx, err := db.DB().Begin()
+	tx, err := db.DB().BeginTx(ctx, nil)
 	if err != nil {
 		log.Printf("   ⚠️  Failed to begin transaction: %v", err)
 		return err
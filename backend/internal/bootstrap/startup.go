@@ -0,0 +1,257 @@
+package bootstrap
+
+import (
+	"context"
+	"log"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	"github.com/nexuscrm/backend/pkg/query"
+)
+
+// schemaCodeVersion is part of the schema step's checksum. Bump it when InitializeSchema or
+// the DDL it generates changes in a way builds without VCS information would not notice.
+const schemaCodeVersion = "1"
+
+// StartupOptions tunes the startup pipeline
+type StartupOptions struct {
+	// Configure runs on the service manager as soon as it exists, before any step uses it
+	Configure func(*services.ServiceManager)
+	// Force reruns the steps whose input is unchanged since their last successful run
+	Force bool
+	// Parallelism caps the steps running at once
+	Parallelism int
+	// Progress is called as each step ends
+	Progress func(done, total int, result StepResult)
+	// Services configures the service manager
+	Services config.Services
+}
+
+// StartupOptionsFrom returns the pipeline and service settings of cfg
+func StartupOptionsFrom(cfg *config.Config) StartupOptions {
+	return StartupOptions{
+		Force:       cfg.Bootstrap.Force,
+		Parallelism: cfg.Bootstrap.Parallelism,
+		Services:    cfg.Services,
+	}
+}
+
+// Startup brings the database and the seeded system data up to date and returns the
+// service manager. The schema, the service manager and the system data are required;
+// the other steps only log their failures. Steps whose input is unchanged since their
+// last successful run are skipped, and canceling ctx stops the steps not yet started.
+func Startup(ctx context.Context, db *database.TiDBConnection, opts StartupOptions) (*services.ServiceManager, []StepResult, error) {
+	var svcMgr *services.ServiceManager
+
+	pipeline, err := NewPipeline(
+		Step{
+			Name:     "schema",
+			Required: true,
+			// The DDL generated for the tables depends on the code and the dialect too
+			Checksum: staticChecksum(systemTablesJSON, []byte(schemaCodeVersion), []byte(codeRevision()), []byte(query.ActiveDialect().Name())),
+			Present: func(ctx context.Context) (bool, error) {
+				health, err := persistence.NewSchemaRepository(db.DB()).ValidateSchemaRegistry()
+				if err != nil {
+					return false, err
+				}
+				return len(health.MissingTables) == 0, nil
+			},
+			Run: func(ctx context.Context) error {
+				return InitializeSchema(ctx, db)
+			},
+		},
+		Step{
+			Name:     "services",
+			After:    []string{"schema"},
+			Required: true,
+			Run: func(ctx context.Context) error {
+				svcMgr = services.NewServiceManager(db, opts.Services)
+				if opts.Configure != nil {
+					opts.Configure(svcMgr)
+				}
+				return nil
+			},
+		},
+		Step{
+			Name:  "metadata_cache",
+			After: []string{"services"},
+			Run: func(ctx context.Context) error {
+				return svcMgr.RefreshMetadataCache()
+			},
+		},
+		Step{
+			// An idempotent upsert of the standard profiles, which sign-in depends on, so it
+			// runs on every start rather than only when system_data.json changes
+			Name:     "system_data",
+			After:    []string{"services"},
+			Required: true,
+			Run: func(ctx context.Context) error {
+				return InitializeSystemData(ctx, svcMgr.System)
+			},
+		},
+		Step{
+			// Closes setup for databases that have users, else completes an unattended setup
+			Name:  "setup",
+			After: []string{"system_data"},
+			Run: func(ctx context.Context) error {
+				return svcMgr.Setup.Initialize(ctx)
+			},
+		},
+		Step{
+			Name:  "standard_actions",
+			After: []string{"metadata_cache"},
+			Checksum: func(ctx context.Context) (string, error) {
+				return Checksum(standardActionsJSON, objectNames(ctx, svcMgr)), nil
+			},
+			Run: func(ctx context.Context) error {
+				return InitializeStandardActions(ctx, svcMgr.Metadata)
+			},
+		},
+		Step{
+			Name:     "value_sets",
+			After:    []string{"metadata_cache"},
+			Checksum: staticChecksum(standardValueSetsJSON),
+			Run: func(ctx context.Context) error {
+				return InitializeStandardValueSets(ctx, svcMgr.Metadata)
+			},
+		},
+		Step{
+			Name:  "legacy_config",
+			After: []string{"services"},
+			Run: func(ctx context.Context) error {
+				return MigrateLegacyConfig(ctx, svcMgr)
+			},
+		},
+		Step{
+			Name:  "permissions",
+			After: []string{"metadata_cache", "system_data"},
+			Checksum: func(ctx context.Context) (string, error) {
+				return Checksum(objectNames(ctx, svcMgr)), nil
+			},
+			Run: func(ctx context.Context) error {
+				return InitializePermissions(ctx, svcMgr.Permissions, svcMgr.Metadata)
+			},
+		},
+		Step{
+			Name:     "themes",
+			After:    []string{"services"},
+			Checksum: staticChecksum(themesJSON),
+			Run: func(ctx context.Context) error {
+				return InitializeThemes(ctx, svcMgr)
+			},
+		},
+		Step{
+			Name:     "ui_components",
+			After:    []string{"services"},
+			Checksum: staticChecksum(uiComponentsJSON),
+			Run: func(ctx context.Context) error {
+				return InitializeUIComponents(ctx, svcMgr)
+			},
+		},
+		Step{
+			Name:  "setup_pages",
+			After: []string{"services"},
+			Checksum: func(ctx context.Context) (string, error) {
+				content, err := os.ReadFile(setupPagesPath)
+				if err != nil {
+					return "", err
+				}
+				return Checksum(content), nil
+			},
+			Run: func(ctx context.Context) error {
+				return InitializeSetupPages(ctx, svcMgr)
+			},
+		},
+		Step{
+			Name:     "flows",
+			After:    []string{"metadata_cache"},
+			Checksum: staticChecksum(flowsJSON),
+			Run: func(ctx context.Context) error {
+				return InitializeFlows(ctx, svcMgr.Metadata)
+			},
+		},
+		Step{
+			// Adds the statistics fields of the objects configured in RECORD_STATS_OBJECTS
+			Name:  "record_stats",
+			After: []string{"metadata_cache"},
+			Checksum: func(ctx context.Context) (string, error) {
+				return Checksum([]byte(opts.Services.RecordStatsObjects), objectNames(ctx, svcMgr)), nil
+			},
+			Run: func(ctx context.Context) error {
+				return svcMgr.RecordStats.EnsureFields(ctx)
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	pipeline.Store = persistence.NewBootstrapStepRepository(db.DB())
+	pipeline.Force = opts.Force
+	pipeline.Parallelism = opts.Parallelism
+	pipeline.Progress = opts.Progress
+
+	log.Println("🔧 Running bootstrap steps...")
+	start := time.Now()
+	results, err := pipeline.Run(ctx)
+
+	counts := make(map[StepStatus]int)
+	for _, result := range results {
+		counts[result.Status]++
+	}
+	log.Printf("🏁 Bootstrap finished in %s: %d run, %d unchanged, %d failed, %d not run",
+		time.Since(start).Round(time.Millisecond), counts[StepDone], counts[StepUnchanged], counts[StepFailed], counts[StepBlocked])
+	if err != nil {
+		return nil, results, err
+	}
+	return svcMgr, results, nil
+}
+
+// staticChecksum fingerprints a step whose input is embedded seed files and other values
+// fixed for the life of the process
+func staticChecksum(parts ...[]byte) func(ctx context.Context) (string, error) {
+	sum := Checksum(parts...)
+	return func(ctx context.Context) (string, error) {
+		return sum, nil
+	}
+}
+
+// objectNames lists the API names of all objects, sorted, so steps that seed something per
+// object rerun when an object is added or removed
+func objectNames(ctx context.Context, svcMgr *services.ServiceManager) []byte {
+	schemas := svcMgr.Metadata.GetSchemas(ctx)
+	names := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		names = append(names, schema.APIName)
+	}
+	sort.Strings(names)
+	return []byte(strings.Join(names, ","))
+}
+
+// codeRevision returns the VCS revision the binary was built from, marked when the tree had
+// local changes, or "" when the build recorded none (go run, go test)
+func codeRevision() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	var revision, modified string
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value
+		}
+	}
+	if revision != "" && modified == "true" {
+		revision += "+dirty"
+	}
+	return revision
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -17,23 +18,12 @@ var systemDataJSON []byte
 
 type SystemData struct {
 	Profiles []models.SystemProfile `json:"profiles"`
-	Users    []SystemUserJSON       `json:"users"`
-}
-
-// SystemUserJSON is a DTO for seeding users from JSON
-// It handles Password (which is ignored in the main model)
-type SystemUserJSON struct {
-	ID        string `json:"id,omitempty"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Email     string `json:"email"`
-	Password  string `json:"password"`
-	ProfileID string `json:"profile_id"`
 }
 
 // InitializeSystemData ensures required system data exists
-// This should be called during server startup BEFORE accepting requests
-func InitializeSystemData(sys *services.SystemManager) error {
+// This should be called during server startup BEFORE accepting requests.
+// No user is seeded: the first administrator is created by first-run setup (SetupService).
+func InitializeSystemData(ctx context.Context, sys *services.SystemManager) error {
 	log.Println("🔧 Initializing system data...")
 
 	var data SystemData
@@ -46,36 +36,11 @@ func InitializeSystemData(sys *services.SystemManager) error {
 		data.Profiles[i].IsActive = true
 		data.Profiles[i].IsSystem = true
 	}
-	if err := sys.BatchUpsertProfiles(context.Background(), data.Profiles); err != nil {
+	if err := sys.BatchUpsertProfiles(ctx, data.Profiles); err != nil {
 		return fmt.Errorf("failed to batch upsert profiles: %w", err)
 	}
 	log.Printf("   ✅ Ensure %d system profiles (batch)", len(data.Profiles))
 
-	// 2. Process Users (Batch)
-	users := make([]models.SystemUser, len(data.Users))
-	for i, u := range data.Users {
-		id := u.ID
-		if id == "" {
-			id = services.GenerateID()
-		}
-
-		users[i] = models.SystemUser{
-			ID:        id,
-			Username:  u.Email,
-			Email:     u.Email,
-			Password:  u.Password,
-			ProfileID: u.ProfileID,
-			FirstName: u.FirstName,
-			LastName:  u.LastName,
-			IsActive:  true,
-		}
-	}
-
-	if err := sys.BatchUpsertUsers(context.Background(), users); err != nil {
-		return fmt.Errorf("failed to batch upsert users: %w", err)
-	}
-	log.Printf("   ✅ Ensure %d system users (batch)", len(users))
-
 	return nil
 }
 
@@ -87,7 +52,7 @@ type ThemesData struct {
 }
 
 // InitializeThemes ensures standard themes exist
-func InitializeThemes(sm *services.ServiceManager) error {
+func InitializeThemes(ctx context.Context, sm *services.ServiceManager) error {
 	log.Println("🔧 Initializing themes...")
 
 	var data ThemesData
@@ -95,15 +60,17 @@ func InitializeThemes(sm *services.ServiceManager) error {
 		return fmt.Errorf("failed to parse themes.json: %w", err)
 	}
 
+	var failed []error
 	for _, theme := range data.Themes {
-		if err := sm.Metadata.UpsertTheme(context.Background(), &theme); err != nil {
+		if err := sm.Metadata.UpsertTheme(ctx, &theme); err != nil {
 			log.Printf("   ⚠️  Failed to ensure theme %s: %v", theme.Name, err)
+			failed = append(failed, fmt.Errorf("theme %s: %w", theme.Name, err))
 		} else {
 			log.Printf("   ✅ %s theme ensured", theme.Name)
 		}
 	}
 
-	return nil
+	return errors.Join(failed...)
 }
 
 //go:embed ui_components.json
@@ -114,7 +81,7 @@ type UIComponentsData struct {
 }
 
 // InitializeUIComponents ensures standard UI components exist (from ui_components.json)
-func InitializeUIComponents(sm *services.ServiceManager) error {
+func InitializeUIComponents(ctx context.Context, sm *services.ServiceManager) error {
 	log.Println("🔧 Initializing UI components...")
 
 	var data UIComponentsData
@@ -122,31 +89,33 @@ func InitializeUIComponents(sm *services.ServiceManager) error {
 		return fmt.Errorf("failed to parse ui_components.json: %w", err)
 	}
 
+	var failed []error
 	for _, comp := range data.Components {
 		// Clean description if nil
 		if comp.Description != nil && *comp.Description == "" {
 			comp.Description = nil
 		}
 
-		if err := sm.Metadata.UpsertUIComponent(context.Background(), &comp); err != nil {
+		if err := sm.Metadata.UpsertUIComponent(ctx, &comp); err != nil {
 			log.Printf("   ⚠️  Failed to ensure component %s: %v", comp.Name, err)
+			failed = append(failed, fmt.Errorf("UI component %s: %w", comp.Name, err))
 		}
 	}
 	log.Printf("   ✅ Ensure %d UI components", len(data.Components))
 
-	return nil
+	return errors.Join(failed...)
 }
 
+// setupPagesPath is the setup page seed file, read from disk relative to the working directory
+const setupPagesPath = "internal/bootstrap/setup_pages.json"
+
 // InitializeSetupPages loads setup page metadata from JSON
-func InitializeSetupPages(sm *services.ServiceManager) error {
+func InitializeSetupPages(ctx context.Context, sm *services.ServiceManager) error {
 	log.Println("🔧 Initializing setup pages...")
 
-	// Read setup_pages.json
-	filePath := "internal/bootstrap/setup_pages.json"
-
-	content, err := os.ReadFile(filePath)
+	content, err := os.ReadFile(setupPagesPath)
 	if err != nil {
-		log.Printf("   ⚠️  Setup pages seed file not found at %s (skipping)", filePath)
+		log.Printf("   ⚠️  Setup pages seed file not found at %s (skipping)", setupPagesPath)
 		return nil
 	}
 
@@ -155,12 +124,14 @@ func InitializeSetupPages(sm *services.ServiceManager) error {
 		return fmt.Errorf("failed to parse setup_pages.json: %w", err)
 	}
 
+	var failed []error
 	for _, page := range pages {
-		if err := sm.Metadata.UpsertSetupPage(context.Background(), &page); err != nil {
+		if err := sm.Metadata.UpsertSetupPage(ctx, &page); err != nil {
 			log.Printf("   ⚠️  Failed to ensure setup page %s: %v", page.Label, err)
+			failed = append(failed, fmt.Errorf("setup page %s: %w", page.Label, err))
 		}
 	}
 	log.Printf("   ✅ Ensure %d setup pages", len(pages))
 
-	return nil
+	return errors.Join(failed...)
 }
@@ -0,0 +1,49 @@
+package bootstrap
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+//go:embed standard_value_sets.json
+var standardValueSetsJSON []byte
+
+// InitializeStandardValueSets creates the standard global value sets that do not exist yet.
+// Existing sets are left alone so admin changes to their values survive restarts.
+func InitializeStandardValueSets(ctx context.Context, metadataService *services.MetadataService) error {
+	log.Println("🔧 Initializing standard value sets...")
+
+	var sets []models.GlobalValueSet
+	if err := json.Unmarshal(standardValueSetsJSON, &sets); err != nil {
+		return fmt.Errorf("failed to parse standard_value_sets.json: %w", err)
+	}
+
+	created := 0
+	var failed []error
+	for i := range sets {
+		vs := &sets[i]
+		if _, err := metadataService.GetGlobalValueSet(ctx, vs.Name); err == nil {
+			continue
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		vs.IsStandard = true
+		if err := metadataService.CreateGlobalValueSet(ctx, vs); err != nil {
+			log.Printf("   ⚠️  Failed to create value set %s: %v", vs.Name, err)
+			failed = append(failed, fmt.Errorf("value set %s: %w", vs.Name, err))
+			continue
+		}
+		created++
+	}
+	log.Printf("   ✅ Ensure %d standard value sets (%d created)", len(sets), created)
+	return stderrors.Join(failed...)
+}
@@ -0,0 +1,324 @@
+// Package config loads the typed configuration of the server and the admin CLI. Settings
+// come from command-line flags, the environment and .env files, in that order of
+// precedence, and are validated once at startup so a missing database DSN or JWT secret
+// stops the process before it serves anything. Runtime settings (see Runtime) may also be
+// set in _System_Config and are reloaded while the server runs.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/nexuscrm/backend/pkg/query"
+)
+
+// Deployment environments (GO_ENV)
+const (
+	EnvDevelopment = "development"
+	EnvTest        = "test"
+	EnvProduction  = "production"
+)
+
+const (
+	defaultPort           = "3001"
+	defaultTiDBPort       = "4000"
+	defaultDatabaseName   = "nexuscrm"
+	defaultReloadInterval = 30 * time.Second
+
+	// DefaultSQLitePath is the embedded database file used when SQLITE_PATH is unset
+	DefaultSQLitePath = "nexuscrm-dev.db"
+
+	// minJWTSecretLength is the shortest HS256 secret accepted outside development
+	minJWTSecretLength = 32
+)
+
+// defaultEnvFiles are read, when they exist, from the working directory: the backend
+// directory and the repository root
+var defaultEnvFiles = []string{".env", "../.env"}
+
+// Config is the process configuration
+type Config struct {
+	Env       string // GO_ENV
+	Server    Server
+	Database  Database
+	Auth      Auth
+	Runtime   Runtime
+	HTTP      HTTP
+	Bootstrap Bootstrap
+	Services  Services
+
+	// ReloadInterval is how often Runtime settings are reloaded from _System_Config; 0 disables it
+	ReloadInterval time.Duration
+}
+
+// Server holds the listener settings
+type Server struct {
+	Port           string // PORT
+	GRPCPort       string // GRPC_PORT; empty disables the gRPC API
+	APIBaseURL     string // API_BASE_URL; empty keeps MCP tools in-process
+	SkipAssertions bool   // SKIP_ASSERTIONS
+	ExtensionsDir  string // EXTENSIONS_DIR; Go plugins (*.so) installed as extensions at startup
+}
+
+// Database holds the connection settings of the selected dialect
+type Database struct {
+	Dialect     string // DB_DIALECT
+	Host        string // TIDB_HOST
+	Port        string // TIDB_PORT
+	User        string // TIDB_USER
+	Password    string // TIDB_PASSWORD
+	Name        string // TIDB_DATABASE
+	PostgresDSN string // POSTGRES_DSN
+	SQLitePath  string // SQLITE_PATH
+	ReplicaDSN  string // TIDB_REPLICA_DSN, or POSTGRES_REPLICA_DSN on PostgreSQL
+	Configured  bool   // DB_DIALECT, TIDB_HOST or POSTGRES_DSN is set, rather than all defaulted
+}
+
+// Auth holds the JWT settings pkg/auth builds the signing keys from, and the passphrase
+// credentials stored in the database are encrypted with
+type Auth struct {
+	Algorithm          string   // JWT_ALGORITHM
+	Secret             string   // JWT_SECRET
+	PreviousSecrets    []string // JWT_PREVIOUS_SECRETS
+	PrivateKey         string   // JWT_PRIVATE_KEY, or the file named by JWT_PRIVATE_KEY_FILE
+	PreviousPublicKeys string   // JWT_PREVIOUS_PUBLIC_KEYS, or the file named by JWT_PREVIOUS_PUBLIC_KEYS_FILE
+	KeyID              string   // JWT_KEY_ID
+	Issuer             string   // JWT_ISSUER
+	Audiences          []string // JWT_AUDIENCE
+
+	CredentialEncryptionKey string // CREDENTIAL_ENCRYPTION_KEY
+}
+
+// Bootstrap tunes the startup pipeline
+type Bootstrap struct {
+	Force       bool // BOOTSTRAP_FORCE reruns the steps whose input is unchanged
+	Parallelism int  // BOOTSTRAP_PARALLELISM caps the steps running at once
+}
+
+// defaultBootstrapParallelism is how many bootstrap steps run at once by default
+const defaultBootstrapParallelism = 4
+
+// IsDevelopment reports whether the process runs in a development or test environment,
+// where missing secrets fall back to insecure defaults
+func (c *Config) IsDevelopment() bool {
+	return c.Env == EnvDevelopment || c.Env == EnvTest
+}
+
+// LoadEnv prepares the environment the configuration is read from. It parses the server
+// flags in args (none for commands that do not take them):
+//
+//	-dev              use the embedded SQLite database (DB_DIALECT=sqlite)
+//	-port, -grpc-port override PORT and GRPC_PORT
+//	-env-file         read this .env file instead of .env and ../.env (also ENV_FILE)
+//
+// and loads the .env files, whose values never replace variables already set. Flags are
+// written to the environment, so the subsystems configured from it see them as well.
+func LoadEnv(args []string) error {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	dev := fs.Bool("dev", false, "run against an embedded SQLite database (SQLITE_PATH, default "+DefaultSQLitePath+") instead of TiDB")
+	port := fs.String("port", "", "HTTP port (default $PORT or "+defaultPort+")")
+	grpcPort := fs.String("grpc-port", "", "gRPC port (default $GRPC_PORT; unset disables the gRPC API)")
+	envFile := fs.String("env-file", os.Getenv("ENV_FILE"), "read settings from this .env file instead of .env and ../.env")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	files := defaultEnvFiles
+	if *envFile != "" {
+		if _, err := os.Stat(*envFile); err != nil {
+			return fmt.Errorf("env file: %w", err)
+		}
+		files = []string{*envFile}
+	}
+	for _, file := range files {
+		if _, err := os.Stat(file); err != nil {
+			continue
+		}
+		if err := godotenv.Load(file); err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		log.Printf("📄 Loaded settings from %s", file)
+	}
+
+	overrides := map[string]string{"PORT": *port, "GRPC_PORT": *grpcPort}
+	if *dev {
+		overrides["DB_DIALECT"] = query.DialectSQLite
+		log.Println("🧪 Dev mode: using the embedded SQLite database")
+	}
+	for key, value := range overrides {
+		if value == "" {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// FromEnv reads and validates the configuration. Every invalid setting is reported at once.
+func FromEnv() (*Config, error) {
+	r := &envReader{strict: true}
+	cfg := &Config{
+		Env: strings.ToLower(envOrDefault("GO_ENV", EnvDevelopment)),
+		Server: Server{
+			Port:           envOrDefault("PORT", defaultPort),
+			GRPCPort:       strings.TrimSpace(os.Getenv("GRPC_PORT")),
+			APIBaseURL:     strings.TrimSpace(os.Getenv("API_BASE_URL")),
+			SkipAssertions: os.Getenv("SKIP_ASSERTIONS") == "true",
+			ExtensionsDir:  strings.TrimSpace(os.Getenv("EXTENSIONS_DIR")),
+		},
+		Database: DatabaseFromEnv(),
+		Auth: Auth{
+			Algorithm:               envOrDefault("JWT_ALGORITHM", "HS256"),
+			Secret:                  os.Getenv("JWT_SECRET"),
+			PreviousSecrets:         r.list("JWT_PREVIOUS_SECRETS"),
+			PrivateKey:              r.fileOrEnv("JWT_PRIVATE_KEY"),
+			PreviousPublicKeys:      r.fileOrEnv("JWT_PREVIOUS_PUBLIC_KEYS"),
+			KeyID:                   r.value("JWT_KEY_ID"),
+			Issuer:                  r.value("JWT_ISSUER"),
+			Audiences:               r.list("JWT_AUDIENCE"),
+			CredentialEncryptionKey: os.Getenv("CREDENTIAL_ENCRYPTION_KEY"),
+		},
+		Bootstrap: Bootstrap{
+			Force:       r.boolean("BOOTSTRAP_FORCE", false),
+			Parallelism: r.positive("BOOTSTRAP_PARALLELISM", defaultBootstrapParallelism),
+		},
+		Services:       ServicesFromEnv(),
+		ReloadInterval: r.duration("CONFIG_RELOAD_INTERVAL", defaultReloadInterval),
+	}
+
+	errs := r.errs
+	httpCfg, err := HTTPFromEnv()
+	cfg.HTTP = httpCfg
+	if err != nil {
+		errs = append(errs, err)
+	}
+	runtime, runtimeErrs := parseRuntime(DefaultRuntime(), os.LookupEnv)
+	cfg.Runtime = runtime
+	errs = append(errs, runtimeErrs...)
+	if err := cfg.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// DatabaseFromEnv reads the database settings, applying their defaults. The database
+// package opens its connections with them; FromEnv validates them.
+func DatabaseFromEnv() Database {
+	db := Database{
+		Dialect:     strings.ToLower(envOrDefault("DB_DIALECT", query.DialectTiDB)),
+		Host:        strings.TrimSpace(os.Getenv("TIDB_HOST")),
+		Port:        envOrDefault("TIDB_PORT", defaultTiDBPort),
+		User:        os.Getenv("TIDB_USER"),
+		Password:    os.Getenv("TIDB_PASSWORD"),
+		Name:        envOrDefault("TIDB_DATABASE", defaultDatabaseName),
+		PostgresDSN: os.Getenv("POSTGRES_DSN"),
+		SQLitePath:  envOrDefault("SQLITE_PATH", DefaultSQLitePath),
+		ReplicaDSN:  os.Getenv("TIDB_REPLICA_DSN"),
+	}
+	_, explicitDialect := os.LookupEnv("DB_DIALECT")
+	db.Configured = explicitDialect || db.Host != "" || db.PostgresDSN != ""
+	if db.DialectName() == query.DialectPostgres {
+		db.ReplicaDSN = os.Getenv("POSTGRES_REPLICA_DSN")
+	}
+	return db
+}
+
+// DialectName returns the canonical name of the SQL dialect (see query.DialectFor), or ""
+// when DB_DIALECT names none
+func (d Database) DialectName() string {
+	dialect, err := query.DialectFor(d.Dialect)
+	if err != nil {
+		return ""
+	}
+	return dialect.Name()
+}
+
+// Validate checks the settings the server cannot start without
+func (c *Config) Validate() error {
+	var errs []error
+	if err := validatePort(c.Server.Port); err != nil {
+		errs = append(errs, fmt.Errorf("PORT: %w", err))
+	}
+	if c.Server.GRPCPort != "" {
+		if err := validatePort(c.Server.GRPCPort); err != nil {
+			errs = append(errs, fmt.Errorf("GRPC_PORT: %w", err))
+		}
+		if c.Server.GRPCPort == c.Server.Port {
+			errs = append(errs, fmt.Errorf("GRPC_PORT: must differ from PORT (%s)", c.Server.Port))
+		}
+	}
+
+	if c.Server.ExtensionsDir != "" {
+		if info, err := os.Stat(c.Server.ExtensionsDir); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("EXTENSIONS_DIR: %q is not a directory", c.Server.ExtensionsDir))
+		}
+	}
+
+	db := c.Database
+	switch _, err := query.DialectFor(db.Dialect); {
+	case err != nil:
+		errs = append(errs, fmt.Errorf("DB_DIALECT: %w", err))
+	case db.DialectName() == query.DialectPostgres:
+		if db.PostgresDSN == "" {
+			errs = append(errs, errors.New("POSTGRES_DSN is required when DB_DIALECT is postgres"))
+		}
+	case db.DialectName() == query.DialectSQLite:
+		// SQLITE_PATH has a default
+	default:
+		if db.Host == "" {
+			errs = append(errs, fmt.Errorf("TIDB_HOST is required when DB_DIALECT is %s (use -dev for the embedded SQLite database)", db.Dialect))
+		}
+		if db.User == "" {
+			errs = append(errs, fmt.Errorf("TIDB_USER is required when DB_DIALECT is %s", db.Dialect))
+		}
+		if err := validatePort(db.Port); err != nil {
+			errs = append(errs, fmt.Errorf("TIDB_PORT: %w", err))
+		}
+	}
+
+	switch c.Auth.Algorithm {
+	case "HS256":
+		switch {
+		case c.Auth.Secret == "" && c.IsDevelopment():
+			log.Printf("⚠️  JWT_SECRET is unset; signing tokens with the built-in development secret (GO_ENV=%s)", c.Env)
+		case c.Auth.Secret == "":
+			errs = append(errs, fmt.Errorf("JWT_SECRET is required when GO_ENV is %s", c.Env))
+		case len(c.Auth.Secret) < minJWTSecretLength && !c.IsDevelopment():
+			errs = append(errs, fmt.Errorf("JWT_SECRET must be at least %d characters when GO_ENV is %s", minJWTSecretLength, c.Env))
+		}
+	case "RS256", "EdDSA":
+		if c.Auth.PrivateKey == "" {
+			errs = append(errs, fmt.Errorf("JWT_ALGORITHM=%s requires JWT_PRIVATE_KEY or JWT_PRIVATE_KEY_FILE", c.Auth.Algorithm))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("JWT_ALGORITHM: unsupported algorithm %q; use HS256, RS256 or EdDSA", c.Auth.Algorithm))
+	}
+	return errors.Join(errs...)
+}
+
+func validatePort(raw string) error {
+	port, err := strconv.Atoi(raw)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%q is not a port number", raw)
+	}
+	return nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearEnv unsets every documented setting for the duration of a test
+func clearEnv(t *testing.T) {
+	t.Helper()
+	keys := []string{"JWT_PRIVATE_KEY", "JWT_PRIVATE_KEY_FILE", "ENV_FILE"}
+	for _, s := range Settings {
+		keys = append(keys, s.Key)
+	}
+	for _, key := range keys {
+		t.Setenv(key, "") // Restores the variable after the test
+		require.NoError(t, os.Unsetenv(key))
+	}
+}
+
+func TestFromEnvDefaults(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("DB_DIALECT", "sqlite3")
+
+	cfg, err := FromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, EnvDevelopment, cfg.Env)
+	assert.Equal(t, "3001", cfg.Server.Port)
+	assert.Equal(t, "sqlite", cfg.Database.DialectName())
+	assert.Equal(t, DefaultSQLitePath, cfg.Database.SQLitePath)
+	assert.Equal(t, DefaultRuntime(), cfg.Runtime)
+	assert.Equal(t, 30*time.Second, cfg.ReloadInterval)
+}
+
+func TestFromEnvFailsFast(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("GO_ENV", "production")
+	t.Setenv("DB_DIALECT", "postgres")
+	t.Setenv("PORT", "http")
+	t.Setenv("QUERY_TIMEOUT", "soon")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	for _, want := range []string{"POSTGRES_DSN is required", "JWT_SECRET is required", "PORT:", "QUERY_TIMEOUT:"} {
+		assert.Contains(t, err.Error(), want)
+	}
+
+	t.Setenv("DB_DIALECT", "")
+	t.Setenv("PORT", "")
+	t.Setenv("QUERY_TIMEOUT", "")
+	t.Setenv("JWT_SECRET", "too-short")
+	_, err = FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TIDB_HOST is required")
+	assert.Contains(t, err.Error(), "at least 32 characters")
+
+	t.Setenv("GO_ENV", "development")
+	t.Setenv("DB_DIALECT", "sqlite")
+	t.Setenv("JWT_ALGORITHM", "RS256")
+	_, err = FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires JWT_PRIVATE_KEY")
+
+	t.Setenv("JWT_ALGORITHM", "")
+	t.Setenv("EXTENSIONS_DIR", filepath.Join(t.TempDir(), "missing"))
+	_, err = FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EXTENSIONS_DIR:")
+}
+
+func TestLoadEnv(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "test.env")
+	require.NoError(t, os.WriteFile(envFile, []byte("PORT=4100\nGRPC_PORT=4200\nTIDB_HOST=from-file\n"), 0o600))
+	t.Setenv("TIDB_HOST", "from-env")
+	t.Setenv("DB_DIALECT", "tidb")
+
+	require.NoError(t, LoadEnv([]string{"-env-file", envFile, "-dev", "-grpc-port", "4300"}))
+	assert.Equal(t, "4100", os.Getenv("PORT"), "the .env file fills unset variables")
+	assert.Equal(t, "from-env", os.Getenv("TIDB_HOST"), "the environment wins over the .env file")
+	assert.Equal(t, "4300", os.Getenv("GRPC_PORT"), "flags win over both")
+	assert.Equal(t, "sqlite", os.Getenv("DB_DIALECT"))
+
+	assert.Error(t, LoadEnv([]string{"-env-file", filepath.Join(dir, "missing.env")}))
+}
+
+type fakeSource struct {
+	configs []*models.SystemConfig
+}
+
+func (f *fakeSource) ListConfigs(context.Context) ([]*models.SystemConfig, error) {
+	return f.configs, nil
+}
+
+func TestReloader(t *testing.T) {
+	base := DefaultRuntime()
+	base.QueryMaxRows = 2000
+	source := &fakeSource{}
+	var applied []Runtime
+	r := NewReloader(source, base, func(rt Runtime) { applied = append(applied, rt) })
+	ctx := context.Background()
+
+	changed, err := r.Reload(ctx)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	source.configs = []*models.SystemConfig{
+		{KeyName: "query_max_rows", Value: "500"},
+		{KeyName: "DASHBOARD_CACHE_TTL", Value: "0"},
+		{KeyName: "PORT", Value: "9999"},
+		{KeyName: "support_email", Value: "help@example.com"},
+	}
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, applied, 1)
+	assert.Equal(t, 500, applied[0].QueryMaxRows)
+	assert.Equal(t, time.Duration(0), applied[0].DashboardCacheTTL)
+	assert.Equal(t, base.QueryTimeout, applied[0].QueryTimeout)
+
+	source.configs = append(source.configs, &models.SystemConfig{KeyName: "QUERY_TIMEOUT", Value: "-1s"})
+	_, err = r.Reload(ctx)
+	assert.ErrorContains(t, err, "QUERY_TIMEOUT")
+	assert.Equal(t, 500, r.Current().QueryMaxRows, "an invalid entry leaves the settings as they were")
+
+	source.configs = nil
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, base, r.Current(), "removing the entries restores the startup settings")
+}
+
+// unsetEnv unsets keys for the duration of a test
+func unsetEnv(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		t.Setenv(key, "")
+		require.NoError(t, os.Unsetenv(key))
+	}
+}
+
+func TestServicesFromEnv(t *testing.T) {
+	unsetEnv(t, "FRONTEND_URL", "SURVEY_LINK_BASE_URL", "SCRIPT_LOG_RETENTION_DAYS", "SYNC_INTERVAL",
+		"KAFKA_BROKERS", "EVENT_BUS", "SETUP_ADMIN_PASSWORD", "SETUP_SAMPLE_DATA", "REPLICA_MAX_STALENESS")
+
+	s := ServicesFromEnv()
+	assert.Equal(t, 7*24*time.Hour, s.ScriptLogRetention)
+	assert.Equal(t, 30*time.Second, s.ReplicaMaxStaleness)
+	assert.Equal(t, "/survey", s.Survey.LinkBaseURL)
+	assert.Empty(t, s.EventBus.KafkaBrokers)
+
+	t.Setenv("FRONTEND_URL", "https://crm.example.com/")
+	t.Setenv("SCRIPT_LOG_RETENTION_DAYS", "30")
+	t.Setenv("SYNC_INTERVAL", "soon") // Invalid settings keep their default
+	t.Setenv("KAFKA_BROKERS", " k1:9092, ,k2:9092")
+	t.Setenv("EVENT_BUS", "Kafka")
+	t.Setenv("SETUP_ADMIN_PASSWORD", " spaced ")
+	t.Setenv("SETUP_SAMPLE_DATA", "yes")
+	s = ServicesFromEnv()
+	assert.Equal(t, "https://crm.example.com/survey", s.Survey.LinkBaseURL)
+	assert.Equal(t, 30*24*time.Hour, s.ScriptLogRetention)
+	assert.Equal(t, 15*time.Minute, s.SyncInterval)
+	assert.Equal(t, []string{"k1:9092", "k2:9092"}, s.EventBus.KafkaBrokers)
+	assert.Equal(t, "kafka", s.EventBus.Bus)
+	assert.Equal(t, " spaced ", s.Setup.AdminPassword)
+	assert.True(t, s.Setup.SampleData)
+
+	t.Setenv("SURVEY_LINK_BASE_URL", "https://surveys.example.com/")
+	assert.Equal(t, "https://surveys.example.com", ServicesFromEnv().Survey.LinkBaseURL)
+}
+
+func TestHTTPFromEnv(t *testing.T) {
+	unsetEnv(t, "FRONTEND_URL", "CORS_ALLOWED_ORIGINS", "SECURITY_CSP", "MAX_BULK_BODY_SIZE", "MAX_UPLOAD_BODY_SIZE",
+		"AUTH_COOKIE_SAMESITE", "AUTH_COOKIE_SECURE", "HTTP_IDLE_TIMEOUT", "HTTP_MAX_HEADER_BYTES")
+
+	cfg, err := HTTPFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, cfg.CORSAllowedOrigins)
+	assert.Equal(t, DefaultCSP, cfg.ContentSecurityPolicy)
+	assert.Equal(t, int64(4<<20), cfg.MaxRequestBodySize)
+	assert.Equal(t, http.SameSiteLaxMode, cfg.CookieSameSite)
+	assert.True(t, cfg.CSRF)
+
+	t.Setenv("FRONTEND_URL", "https://crm.example.com/")
+	t.Setenv("SECURITY_CSP", "OFF")
+	t.Setenv("MAX_BULK_BODY_SIZE", "64mb")
+	t.Setenv("MAX_UPLOAD_BODY_SIZE", "512KB")
+	t.Setenv("AUTH_COOKIE_SAMESITE", "None")
+	cfg, err = HTTPFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://crm.example.com"}, cfg.CORSAllowedOrigins)
+	assert.Empty(t, cfg.ContentSecurityPolicy)
+	assert.Equal(t, int64(64<<20), cfg.MaxBulkBodySize)
+	assert.Equal(t, int64(512<<10), cfg.MaxUploadBodySize)
+	assert.Equal(t, http.SameSiteNoneMode, cfg.CookieSameSite)
+	assert.True(t, cfg.CookieSecure, "SameSite=none defaults to secure cookies")
+
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://a.example.com/, https://b.example.com")
+	cfg, err = HTTPFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, cfg.CORSAllowedOrigins)
+
+	t.Setenv("AUTH_COOKIE_SECURE", "false")
+	t.Setenv("HTTP_IDLE_TIMEOUT", "forever")
+	t.Setenv("HTTP_MAX_HEADER_BYTES", "0")
+	t.Setenv("MAX_BULK_BODY_SIZE", "lots")
+	_, err = HTTPFromEnv()
+	require.Error(t, err)
+	for _, want := range []string{"AUTH_COOKIE_SAMESITE=none requires", "HTTP_IDLE_TIMEOUT:", "HTTP_MAX_HEADER_BYTES:", "MAX_BULK_BODY_SIZE:"} {
+		assert.Contains(t, err.Error(), want)
+	}
+
+	t.Setenv("AUTH_COOKIE_SAMESITE", "sometimes")
+	_, err = HTTPFromEnv()
+	assert.ErrorContains(t, err, "AUTH_COOKIE_SAMESITE: unsupported value")
+}
+
+func TestSecretStoresFromEnv(t *testing.T) {
+	unsetEnv(t, "SECRETS_CACHE_TTL", "VAULT_ADDR", "VAULT_TOKEN", "AWS_REGION", "AWS_DEFAULT_REGION",
+		"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY")
+
+	cfg, err := SecretStoresFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, cfg.CacheTTL)
+
+	t.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+	cfg, err = SecretStoresFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", cfg.AWSRegion)
+
+	t.Setenv("VAULT_ADDR", "https://vault.example.com")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIA")
+	t.Setenv("SECRETS_CACHE_TTL", "a while")
+	_, err = SecretStoresFromEnv()
+	require.Error(t, err)
+	for _, want := range []string{"VAULT_ADDR requires VAULT_TOKEN", "AWS_ACCESS_KEY_ID requires AWS_SECRET_ACCESS_KEY", "SECRETS_CACHE_TTL:"} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// envReader parses typed settings from the environment. A strict reader collects the
+// invalid settings for the caller to report; a lenient one logs them and keeps the default,
+// for settings that never stopped the server from starting.
+type envReader struct {
+	strict bool
+	errs   []error
+}
+
+// invalid records a setting that could not be parsed
+func (r *envReader) invalid(key, raw, expected string, fallback interface{}) {
+	if r.strict {
+		r.errs = append(r.errs, fmt.Errorf("%s: %q is not %s", key, raw, expected))
+		return
+	}
+	log.Printf("⚠️  Invalid %s %q, using %v", key, raw, fallback)
+}
+
+// value returns the trimmed value of key, or "" when it is unset
+func (r *envReader) value(key string) string {
+	return strings.TrimSpace(os.Getenv(key))
+}
+
+// list splits a comma-separated setting, dropping blank items
+func (r *envReader) list(key string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// duration parses a non-negative Go duration such as "90s" or "6h"
+func (r *envReader) duration(key string, fallback time.Duration) time.Duration {
+	raw := r.value(key)
+	if raw == "" {
+		return fallback
+	}
+	d, err := parseDuration(raw)
+	if err != nil {
+		r.invalid(key, raw, "a duration such as 500ms, 30s or 5m", fallback)
+		return fallback
+	}
+	return d
+}
+
+// days parses a non-negative number of days
+func (r *envReader) days(key string, fallback time.Duration) time.Duration {
+	raw := r.value(key)
+	if raw == "" {
+		return fallback
+	}
+	n, err := parseCount(raw)
+	if err != nil {
+		r.invalid(key, raw, "a number of days", fmt.Sprintf("%d days", int(fallback/(24*time.Hour))))
+		return fallback
+	}
+	return time.Duration(n) * 24 * time.Hour
+}
+
+// positive parses an integer greater than zero
+func (r *envReader) positive(key string, fallback int) int {
+	raw := r.value(key)
+	if raw == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		r.invalid(key, raw, "a number greater than 0", fallback)
+		return fallback
+	}
+	return n
+}
+
+// boolean parses true/false, 1/0 or yes/no
+func (r *envReader) boolean(key string, fallback bool) bool {
+	raw := strings.ToLower(r.value(key))
+	switch raw {
+	case "":
+		return fallback
+	case "true", "1", "yes":
+		return true
+	case "false", "0", "no":
+		return false
+	}
+	r.invalid(key, raw, "true or false", fallback)
+	return fallback
+}
+
+// byteSize parses a size such as 512KB, 4MB, 1GB or a plain number of bytes
+func (r *envReader) byteSize(key string, fallback int64) int64 {
+	raw := strings.ToUpper(r.value(key))
+	if raw == "" {
+		return fallback
+	}
+	number, multiplier := raw, int64(1)
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(number, unit.suffix) {
+			number, multiplier = strings.TrimSpace(strings.TrimSuffix(number, unit.suffix)), unit.size
+			break
+		}
+	}
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || n < 0 {
+		r.invalid(key, os.Getenv(key), "a size such as 512KB, 4MB or 1GB", fallback)
+		return fallback
+	}
+	return n * multiplier
+}
+
+// fileOrEnv reads a setting from key, or from the file named by key_FILE
+func (r *envReader) fileOrEnv(key string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return ""
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s_FILE: %w", key, err))
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTP defaults
+const (
+	defaultReadHeaderTimeout  = 10 * time.Second
+	defaultIdleTimeout        = 120 * time.Second
+	defaultMaxBodySize        = 4 << 20
+	defaultMaxBulkBodySize    = 32 << 20
+	defaultMaxUploadBodySize  = 50 << 20
+	defaultRequestBodyTimeout = 60 * time.Second
+	defaultHSTSMaxAge         = 365 * 24 * time.Hour
+
+	// DefaultCSP suits an API that serves JSON and user uploads, never pages or scripts
+	DefaultCSP = "default-src 'none'; frame-ancestors 'none'; sandbox"
+)
+
+// HTTP holds the settings of the REST server and the browser-facing middleware
+type HTTP struct {
+	FrontendURL string // FRONTEND_URL, where the browser app is served
+	// CORSAllowedOrigins is CORS_ALLOWED_ORIGINS, else FRONTEND_URL; "*" allows every origin
+	// without credentials, and none allows only same-origin requests
+	CORSAllowedOrigins []string
+
+	ReadHeaderTimeout time.Duration // HTTP_READ_HEADER_TIMEOUT (slow-loris protection)
+	IdleTimeout       time.Duration // HTTP_IDLE_TIMEOUT for keep-alive connections
+	ReadTimeout       time.Duration // HTTP_READ_TIMEOUT; 0 because the body limits bound bodies
+	WriteTimeout      time.Duration // HTTP_WRITE_TIMEOUT; 0 so streaming responses are not cut off
+	MaxHeaderBytes    int           // HTTP_MAX_HEADER_BYTES
+
+	MaxRequestBodySize int64         // MAX_REQUEST_BODY_SIZE, routes without their own limit
+	MaxBulkBodySize    int64         // MAX_BULK_BODY_SIZE, bulk record routes
+	MaxUploadBodySize  int64         // MAX_UPLOAD_BODY_SIZE, file uploads and translation imports
+	RequestBodyTimeout time.Duration // REQUEST_BODY_TIMEOUT; 0 disables it
+
+	HSTSMaxAge            time.Duration // SECURITY_HSTS_MAX_AGE; 0 disables HSTS
+	ContentSecurityPolicy string        // SECURITY_CSP; "off" disables it (empty)
+
+	SessionCookie  bool          // AUTH_SESSION_COOKIE
+	CookieSameSite http.SameSite // AUTH_COOKIE_SAMESITE: lax (default), strict or none
+	CookieSecure   bool          // AUTH_COOKIE_SECURE (default true for SameSite=none)
+	CookieDomain   string        // AUTH_COOKIE_DOMAIN
+	CSRF           bool          // AUTH_CSRF
+
+	SCIMToken      string // SCIM_TOKEN; empty disables SCIM provisioning
+	MCPToolbusMode string // MCP_TOOLBUS_MODE; "http" keeps MCP tool calls on the REST API
+}
+
+// HTTPFromEnv reads the HTTP settings, reporting every invalid one
+func HTTPFromEnv() (HTTP, error) {
+	r := &envReader{strict: true}
+	cfg := HTTP{
+		FrontendURL:        r.value("FRONTEND_URL"),
+		ReadHeaderTimeout:  r.duration("HTTP_READ_HEADER_TIMEOUT", defaultReadHeaderTimeout),
+		IdleTimeout:        r.duration("HTTP_IDLE_TIMEOUT", defaultIdleTimeout),
+		ReadTimeout:        r.duration("HTTP_READ_TIMEOUT", 0),
+		WriteTimeout:       r.duration("HTTP_WRITE_TIMEOUT", 0),
+		MaxHeaderBytes:     r.positive("HTTP_MAX_HEADER_BYTES", http.DefaultMaxHeaderBytes),
+		MaxRequestBodySize: r.byteSize("MAX_REQUEST_BODY_SIZE", defaultMaxBodySize),
+		MaxBulkBodySize:    r.byteSize("MAX_BULK_BODY_SIZE", defaultMaxBulkBodySize),
+		MaxUploadBodySize:  r.byteSize("MAX_UPLOAD_BODY_SIZE", defaultMaxUploadBodySize),
+		RequestBodyTimeout: r.duration("REQUEST_BODY_TIMEOUT", defaultRequestBodyTimeout),
+		HSTSMaxAge:         r.duration("SECURITY_HSTS_MAX_AGE", defaultHSTSMaxAge),
+		SessionCookie:      r.boolean("AUTH_SESSION_COOKIE", false),
+		CookieSameSite:     http.SameSiteLaxMode,
+		CookieDomain:       r.value("AUTH_COOKIE_DOMAIN"),
+		CSRF:               r.boolean("AUTH_CSRF", true),
+		SCIMToken:          r.value("SCIM_TOKEN"),
+		MCPToolbusMode:     strings.ToLower(r.value("MCP_TOOLBUS_MODE")),
+	}
+
+	cfg.CORSAllowedOrigins = make([]string, 0)
+	origins := r.list("CORS_ALLOWED_ORIGINS")
+	if len(origins) == 0 && cfg.FrontendURL != "" {
+		origins = []string{cfg.FrontendURL}
+	}
+	for _, origin := range origins {
+		if origin = strings.TrimRight(origin, "/"); origin != "" {
+			cfg.CORSAllowedOrigins = append(cfg.CORSAllowedOrigins, origin)
+		}
+	}
+
+	cfg.ContentSecurityPolicy = DefaultCSP
+	if raw := r.value("SECURITY_CSP"); raw != "" {
+		cfg.ContentSecurityPolicy = raw
+		if strings.EqualFold(raw, "off") {
+			cfg.ContentSecurityPolicy = ""
+		}
+	}
+
+	switch sameSite := strings.ToLower(r.value("AUTH_COOKIE_SAMESITE")); sameSite {
+	case "", "lax":
+	case "strict":
+		cfg.CookieSameSite = http.SameSiteStrictMode
+	case "none":
+		cfg.CookieSameSite = http.SameSiteNoneMode
+	default:
+		r.errs = append(r.errs, fmt.Errorf("AUTH_COOKIE_SAMESITE: unsupported value %q; use lax, strict or none", sameSite))
+	}
+	cfg.CookieSecure = r.boolean("AUTH_COOKIE_SECURE", cfg.CookieSameSite == http.SameSiteNoneMode)
+	if cfg.CookieSameSite == http.SameSiteNoneMode && !cfg.CookieSecure {
+		r.errs = append(r.errs, errors.New("AUTH_COOKIE_SAMESITE=none requires AUTH_COOKIE_SECURE=true"))
+	}
+	return cfg, errors.Join(r.errs...)
+}
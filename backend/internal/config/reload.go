@@ -0,0 +1,146 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// Source lists the _System_Config entries
+type Source interface {
+	ListConfigs(ctx context.Context) ([]*models.SystemConfig, error)
+}
+
+// Reloader keeps the runtime settings in line with _System_Config. Entries named after a
+// reloadable setting override the value the process started with; removing the entry
+// restores it. A reload with an invalid value changes nothing, so a typo in one entry never
+// leaves the server half reconfigured.
+type Reloader struct {
+	source Source
+	base   Runtime
+	apply  func(Runtime)
+
+	mu       sync.Mutex
+	current  Runtime
+	reported map[string]bool // Entries already logged as ignored
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewReloader creates a Reloader calling apply whenever the effective runtime settings
+// change. base holds the settings read from the environment at startup.
+func NewReloader(source Source, base Runtime, apply func(Runtime)) *Reloader {
+	return &Reloader{
+		source:   source,
+		base:     base,
+		apply:    apply,
+		current:  base,
+		reported: make(map[string]bool),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Current returns the runtime settings in effect
+func (r *Reloader) Current() Runtime {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// Reload reads _System_Config and applies the runtime settings if they changed, reporting
+// whether they did
+func (r *Reloader) Reload(ctx context.Context) (bool, error) {
+	configs, err := r.source.ListConfigs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	values := make(map[string]string)
+	for _, c := range configs {
+		key := strings.ToUpper(strings.TrimSpace(c.KeyName))
+		setting, ok := settingByKey(key)
+		switch {
+		case !ok:
+			continue // Not a setting, e.g. a legacy entry read as a custom setting
+		case !setting.Reloadable() || c.IsSecret:
+			if !r.reported[key] {
+				log.Printf("⚠️  _System_Config entry %s is ignored: it is read from the environment at startup only (reloadable: %s)",
+					key, strings.Join(ReloadableKeys(), ", "))
+				r.reported[key] = true
+			}
+			continue
+		}
+		values[key] = c.Value
+	}
+
+	next, errs := parseRuntime(r.base, func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	})
+	if err := errors.Join(errs...); err != nil {
+		return false, err
+	}
+	if next == r.current {
+		return false, nil
+	}
+	r.current = next
+	r.apply(next)
+	return true, nil
+}
+
+// Start reloads the settings now and then every interval. It does nothing when interval is 0.
+func (r *Reloader) Start(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.Printf("🔄 Runtime settings reloaded from _System_Config every %s", interval)
+		for {
+			if changed, err := r.Reload(context.Background()); err != nil {
+				log.Printf("⚠️  Runtime settings not reloaded: %v", err)
+			} else if changed {
+				log.Printf("🔄 Runtime settings changed: %+v", r.Current())
+			}
+
+			select {
+			case <-r.stopCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the reload worker gracefully
+func (r *Reloader) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	r.wg.Wait()
+}
+
+func settingByKey(key string) (Setting, bool) {
+	for _, s := range Settings {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return Setting{}, false
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const defaultSecretsCacheTTL = 5 * time.Minute
+
+// SecretStores holds the secret stores that secret references (vault:..., aws:...) in the
+// other settings are resolved through. It is read on its own, before Config, because the
+// settings FromEnv reads may be such references.
+type SecretStores struct {
+	CacheTTL time.Duration // SECRETS_CACHE_TTL, how long resolved secrets are reused; 0 disables
+
+	VaultAddr      string // VAULT_ADDR; enables vault:<path>#<key> with VAULT_TOKEN
+	VaultToken     string // VAULT_TOKEN
+	VaultNamespace string // VAULT_NAMESPACE
+
+	AWSRegion          string // AWS_REGION, else AWS_DEFAULT_REGION
+	AWSAccessKeyID     string // AWS_ACCESS_KEY_ID; enables aws:<secret id>#<key> with a region
+	AWSSecretAccessKey string // AWS_SECRET_ACCESS_KEY
+	AWSSessionToken    string // AWS_SESSION_TOKEN
+	AWSEndpoint        string // AWS_SECRETS_MANAGER_ENDPOINT
+}
+
+// SecretStoresFromEnv reads and validates the secret store settings
+func SecretStoresFromEnv() (SecretStores, error) {
+	r := &envReader{strict: true}
+	cfg := SecretStores{
+		CacheTTL:           r.duration("SECRETS_CACHE_TTL", defaultSecretsCacheTTL),
+		VaultAddr:          r.value("VAULT_ADDR"),
+		VaultToken:         os.Getenv("VAULT_TOKEN"),
+		VaultNamespace:     r.value("VAULT_NAMESPACE"),
+		AWSRegion:          r.value("AWS_REGION"),
+		AWSAccessKeyID:     r.value("AWS_ACCESS_KEY_ID"),
+		AWSSecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		AWSSessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		AWSEndpoint:        r.value("AWS_SECRETS_MANAGER_ENDPOINT"),
+	}
+	if cfg.AWSRegion == "" {
+		cfg.AWSRegion = r.value("AWS_DEFAULT_REGION")
+	}
+	if cfg.VaultAddr != "" && cfg.VaultToken == "" {
+		r.errs = append(r.errs, errors.New("VAULT_ADDR requires VAULT_TOKEN"))
+	}
+	if cfg.AWSAccessKeyID != "" && cfg.AWSRegion != "" && cfg.AWSSecretAccessKey == "" {
+		r.errs = append(r.errs, errors.New("AWS_ACCESS_KEY_ID requires AWS_SECRET_ACCESS_KEY"))
+	}
+	return cfg, errors.Join(r.errs...)
+}
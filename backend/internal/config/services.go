@@ -0,0 +1,259 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// Service defaults
+const (
+	defaultReplicaMaxStaleness    = 30 * time.Second
+	defaultSchemaDriftInterval    = time.Hour
+	defaultScriptLogRetention     = 7 * 24 * time.Hour
+	defaultArchiveInterval        = time.Hour
+	defaultSyncInterval           = 15 * time.Minute
+	defaultCampaignRollupInterval = time.Hour
+	defaultSurveyInvitationTTL    = 30 * 24 * time.Hour
+)
+
+// Services holds the settings of the application services and of the adapters they are
+// built with. Lists with a format of their own, such as GEOCODE_FIELDS, are kept as written
+// and parsed by the service that uses them; empty strings select the service's default.
+type Services struct {
+	ReplicaMaxStaleness   time.Duration // REPLICA_MAX_STALENESS; replica lag analytics tolerate, 0 keeps them on the primary
+	SchemaDriftInterval   time.Duration // SCHEMA_DRIFT_INTERVAL; 0 disables the periodic check
+	SchemaDriftAutoRepair bool          // SCHEMA_DRIFT_AUTO_REPAIR; add the missing columns and indexes found
+	ScriptLogRetention    time.Duration // SCRIPT_LOG_RETENTION_DAYS; 0 keeps script runs forever
+	AIHistoryRetention    time.Duration // AI_HISTORY_RETENTION_DAYS; 0 keeps AI history forever
+	ArchiveInterval       time.Duration // ARCHIVE_INTERVAL; 0 disables scheduled archiving
+	ChangeDataCapture     bool          // CHANGE_DATA_CAPTURE
+
+	SyncInterval         time.Duration // SYNC_INTERVAL; 0 disables scheduled syncing
+	SyncMatchFields      string        // SYNC_MATCH_FIELDS, object.field email fields
+	TelephonyMatchFields string        // TELEPHONY_MATCH_FIELDS, object.field phone fields
+
+	CampaignInfluenceObject string        // CAMPAIGN_INFLUENCE_OBJECT
+	CampaignRollupInterval  time.Duration // CAMPAIGN_ROLLUP_INTERVAL; 0 disables scheduled recalculation
+
+	RecordStatsObjects string // RECORD_STATS_OBJECTS, object or object:stage_field entries
+	RecordStatsTasks   string // RECORD_STATS_TASKS, object.status_field:closed_value+closed_value
+	GeocodeFields      string // GEOCODE_FIELDS, object.location_field:address_field+address_field entries
+	TimelineTaskLink   string // TIMELINE_TASK_LINK, object.field
+
+	Inventory    Inventory
+	Entitlements Entitlements
+	Survey       Survey
+	Setup        Setup
+
+	Search               Search
+	Embeddings           Embeddings
+	EventBus             EventBus
+	Geocoding            Geocoding
+	LLM                  LLM
+	DocumentConverterURL string // DOCUMENT_CONVERTER_URL; empty renders HTML templates in-process
+	Telephony            Telephony
+	MailSync             MailSync
+}
+
+// Inventory names the product object and fields orders draw stock from
+type Inventory struct {
+	ProductObject string // INVENTORY_PRODUCT_OBJECT
+	QuantityField string // INVENTORY_QUANTITY_FIELD
+	PriceField    string // INVENTORY_PRICE_FIELD
+	NegativeStock string // INVENTORY_NEGATIVE_STOCK: reject, allow or backorder
+}
+
+// Entitlements names the account and case objects entitlements apply to
+type Entitlements struct {
+	AccountObject    string // ENTITLEMENT_ACCOUNT_OBJECT
+	CaseObject       string // ENTITLEMENT_CASE_OBJECT
+	CaseAccountField string // ENTITLEMENT_CASE_ACCOUNT_FIELD
+	CaseField        string // ENTITLEMENT_CASE_FIELD
+	Required         bool   // ENTITLEMENT_REQUIRED
+}
+
+// Survey controls the links survey invitations are sent as
+type Survey struct {
+	LinkBaseURL   string        // SURVEY_LINK_BASE_URL, default FRONTEND_URL + "/survey"
+	InvitationTTL time.Duration // SURVEY_INVITATION_TTL_DAYS; 0 keeps links valid until answered
+	ContactObject string        // SURVEY_CONTACT_OBJECT
+}
+
+// Setup holds the first-run setup token and the unattended setup, which needs at least the
+// admin email and password
+type Setup struct {
+	Token         string // SETUP_TOKEN
+	AdminEmail    string // SETUP_ADMIN_EMAIL
+	AdminPassword string // SETUP_ADMIN_PASSWORD
+	AdminName     string // SETUP_ADMIN_NAME
+	OrgName       string // SETUP_ORG_NAME
+	Locale        string // SETUP_LOCALE
+	SampleData    bool   // SETUP_SAMPLE_DATA
+}
+
+// Search selects the full-text index
+type Search struct {
+	Engine           string // SEARCH_ENGINE: none (default), embedded or meilisearch
+	MeilisearchURL   string // MEILISEARCH_URL
+	MeilisearchIndex string // MEILISEARCH_INDEX
+	MeilisearchKey   string // MEILISEARCH_API_KEY
+}
+
+// Embeddings selects the semantic search embedder and vector store
+type Embeddings struct {
+	Provider    string // EMBEDDING_PROVIDER: none (default) or openai
+	BaseURL     string // EMBEDDING_BASE_URL
+	Model       string // EMBEDDING_MODEL
+	APIKey      string // EMBEDDING_API_KEY
+	VectorStore string // VECTOR_STORE: tidb (default) or memory
+}
+
+// EventBus selects the transport that shares after-commit events between nodes
+type EventBus struct {
+	Bus          string   // EVENT_BUS: inprocess (default), kafka or nats
+	KafkaBrokers []string // KAFKA_BROKERS
+	NATSURL      string   // NATS_URL
+	Topic        string   // EVENT_BUS_TOPIC
+	Stream       string   // EVENT_BUS_STREAM
+	Group        string   // EVENT_BUS_GROUP
+}
+
+// Geocoding selects the geocoder that places addresses on Geolocation fields
+type Geocoding struct {
+	Provider string // GEOCODING_PROVIDER: none (default), nominatim or google
+	BaseURL  string // GEOCODING_BASE_URL
+	APIKey   string // GEOCODING_API_KEY
+}
+
+// LLM configures the model natural-language queries are translated with
+type LLM struct {
+	BaseURL string // LLM_BASE_URL; empty disables natural-language queries
+	APIKey  string // LLM_API_KEY
+	Model   string // LLM_MODEL
+}
+
+// Telephony holds the provider accounts of click-to-dial
+type Telephony struct {
+	WebhookURL       string // TELEPHONY_WEBHOOK_URL, the public URL of /api/telephony/webhooks
+	TwilioAccountSID string // TWILIO_ACCOUNT_SID
+	TwilioAuthToken  string // TWILIO_AUTH_TOKEN
+	TwilioCallerID   string // TWILIO_CALLER_ID
+}
+
+// MailSync holds the OAuth clients of email and calendar sync
+type MailSync struct {
+	RedirectURL           string // SYNC_OAUTH_REDIRECT_URL
+	GoogleClientID        string // GOOGLE_OAUTH_CLIENT_ID
+	GoogleClientSecret    string // GOOGLE_OAUTH_CLIENT_SECRET
+	MicrosoftClientID     string // MICROSOFT_OAUTH_CLIENT_ID
+	MicrosoftClientSecret string // MICROSOFT_OAUTH_CLIENT_SECRET
+	MicrosoftTenant       string // MICROSOFT_OAUTH_TENANT
+}
+
+// ServicesFromEnv reads the service settings. Invalid values are logged and keep their
+// default, as they never stopped the server from starting.
+func ServicesFromEnv() Services {
+	r := &envReader{}
+	frontendURL := strings.TrimSuffix(r.value("FRONTEND_URL"), "/")
+	s := Services{
+		ReplicaMaxStaleness:   r.duration("REPLICA_MAX_STALENESS", defaultReplicaMaxStaleness),
+		SchemaDriftInterval:   r.duration("SCHEMA_DRIFT_INTERVAL", defaultSchemaDriftInterval),
+		SchemaDriftAutoRepair: r.boolean("SCHEMA_DRIFT_AUTO_REPAIR", false),
+		ScriptLogRetention:    r.days("SCRIPT_LOG_RETENTION_DAYS", defaultScriptLogRetention),
+		AIHistoryRetention:    r.days("AI_HISTORY_RETENTION_DAYS", 0),
+		ArchiveInterval:       r.duration("ARCHIVE_INTERVAL", defaultArchiveInterval),
+		ChangeDataCapture:     r.boolean("CHANGE_DATA_CAPTURE", false),
+
+		SyncInterval:         r.duration("SYNC_INTERVAL", defaultSyncInterval),
+		SyncMatchFields:      r.value("SYNC_MATCH_FIELDS"),
+		TelephonyMatchFields: r.value("TELEPHONY_MATCH_FIELDS"),
+
+		CampaignInfluenceObject: r.value("CAMPAIGN_INFLUENCE_OBJECT"),
+		CampaignRollupInterval:  r.duration("CAMPAIGN_ROLLUP_INTERVAL", defaultCampaignRollupInterval),
+
+		RecordStatsObjects: r.value("RECORD_STATS_OBJECTS"),
+		RecordStatsTasks:   r.value("RECORD_STATS_TASKS"),
+		GeocodeFields:      r.value("GEOCODE_FIELDS"),
+		TimelineTaskLink:   r.value("TIMELINE_TASK_LINK"),
+
+		Inventory: Inventory{
+			ProductObject: r.value("INVENTORY_PRODUCT_OBJECT"),
+			QuantityField: r.value("INVENTORY_QUANTITY_FIELD"),
+			PriceField:    r.value("INVENTORY_PRICE_FIELD"),
+			NegativeStock: r.value("INVENTORY_NEGATIVE_STOCK"),
+		},
+		Entitlements: Entitlements{
+			AccountObject:    r.value("ENTITLEMENT_ACCOUNT_OBJECT"),
+			CaseObject:       r.value("ENTITLEMENT_CASE_OBJECT"),
+			CaseAccountField: r.value("ENTITLEMENT_CASE_ACCOUNT_FIELD"),
+			CaseField:        r.value("ENTITLEMENT_CASE_FIELD"),
+			Required:         r.boolean("ENTITLEMENT_REQUIRED", false),
+		},
+		Survey: Survey{
+			LinkBaseURL:   frontendURL + "/survey",
+			InvitationTTL: r.days("SURVEY_INVITATION_TTL_DAYS", defaultSurveyInvitationTTL),
+			ContactObject: r.value("SURVEY_CONTACT_OBJECT"),
+		},
+		Setup: Setup{
+			Token:         r.value("SETUP_TOKEN"),
+			AdminEmail:    r.value("SETUP_ADMIN_EMAIL"),
+			AdminPassword: os.Getenv("SETUP_ADMIN_PASSWORD"),
+			AdminName:     r.value("SETUP_ADMIN_NAME"),
+			OrgName:       r.value("SETUP_ORG_NAME"),
+			Locale:        r.value("SETUP_LOCALE"),
+			SampleData:    r.boolean("SETUP_SAMPLE_DATA", false),
+		},
+
+		Search: Search{
+			Engine:           strings.ToLower(r.value("SEARCH_ENGINE")),
+			MeilisearchURL:   r.value("MEILISEARCH_URL"),
+			MeilisearchIndex: r.value("MEILISEARCH_INDEX"),
+			MeilisearchKey:   r.value("MEILISEARCH_API_KEY"),
+		},
+		Embeddings: Embeddings{
+			Provider:    strings.ToLower(r.value("EMBEDDING_PROVIDER")),
+			BaseURL:     r.value("EMBEDDING_BASE_URL"),
+			Model:       r.value("EMBEDDING_MODEL"),
+			APIKey:      r.value("EMBEDDING_API_KEY"),
+			VectorStore: strings.ToLower(r.value("VECTOR_STORE")),
+		},
+		EventBus: EventBus{
+			Bus:          strings.ToLower(r.value("EVENT_BUS")),
+			KafkaBrokers: r.list("KAFKA_BROKERS"),
+			NATSURL:      r.value("NATS_URL"),
+			Topic:        r.value("EVENT_BUS_TOPIC"),
+			Stream:       r.value("EVENT_BUS_STREAM"),
+			Group:        r.value("EVENT_BUS_GROUP"),
+		},
+		Geocoding: Geocoding{
+			Provider: strings.ToLower(r.value("GEOCODING_PROVIDER")),
+			BaseURL:  r.value("GEOCODING_BASE_URL"),
+			APIKey:   r.value("GEOCODING_API_KEY"),
+		},
+		LLM: LLM{
+			BaseURL: r.value("LLM_BASE_URL"),
+			APIKey:  r.value("LLM_API_KEY"),
+			Model:   r.value("LLM_MODEL"),
+		},
+		DocumentConverterURL: r.value("DOCUMENT_CONVERTER_URL"),
+		Telephony: Telephony{
+			WebhookURL:       strings.TrimSuffix(r.value("TELEPHONY_WEBHOOK_URL"), "/"),
+			TwilioAccountSID: r.value("TWILIO_ACCOUNT_SID"),
+			TwilioAuthToken:  r.value("TWILIO_AUTH_TOKEN"),
+			TwilioCallerID:   r.value("TWILIO_CALLER_ID"),
+		},
+		MailSync: MailSync{
+			RedirectURL:           r.value("SYNC_OAUTH_REDIRECT_URL"),
+			GoogleClientID:        r.value("GOOGLE_OAUTH_CLIENT_ID"),
+			GoogleClientSecret:    r.value("GOOGLE_OAUTH_CLIENT_SECRET"),
+			MicrosoftClientID:     r.value("MICROSOFT_OAUTH_CLIENT_ID"),
+			MicrosoftClientSecret: r.value("MICROSOFT_OAUTH_CLIENT_SECRET"),
+			MicrosoftTenant:       r.value("MICROSOFT_OAUTH_TENANT"),
+		},
+	}
+	if base := r.value("SURVEY_LINK_BASE_URL"); base != "" {
+		s.Survey.LinkBaseURL = strings.TrimSuffix(base, "/")
+	}
+	return s
+}
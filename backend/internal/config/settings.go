@@ -0,0 +1,180 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Setting documents one configuration key
+type Setting struct {
+	Key         string
+	Default     string
+	Description string
+
+	// apply parses a value into Runtime; set only for settings reloaded from _System_Config
+	apply func(rt *Runtime, raw string) error
+}
+
+// Reloadable reports whether the setting may be changed in _System_Config while the server runs
+func (s Setting) Reloadable() bool {
+	return s.apply != nil
+}
+
+// Settings lists the core keys Config reads, with their defaults. The settings of the HTTP
+// server, the services and their adapters, and the secret stores are documented on HTTP,
+// Services and SecretStores, and in .env.example.
+var Settings = []Setting{
+	{Key: "GO_ENV", Default: EnvDevelopment, Description: "deployment environment; outside development and test, secrets have no fallback"},
+	{Key: "PORT", Default: defaultPort, Description: "HTTP port"},
+	{Key: "GRPC_PORT", Description: "gRPC port; unset disables the gRPC API"},
+	{Key: "API_BASE_URL", Description: "URL MCP tools call the REST API at when MCP_TOOLBUS_MODE=http"},
+	{Key: "SKIP_ASSERTIONS", Default: "false", Description: "skip the schema assertions run at startup"},
+	{Key: "EXTENSIONS_DIR", Description: "directory of Go plugins (*.so) installed as extensions at startup"},
+	{Key: "DB_DIALECT", Default: "tidb", Description: "tidb, mysql, postgres or sqlite"},
+	{Key: "TIDB_HOST", Description: "TiDB/MySQL host (required for those dialects)"},
+	{Key: "TIDB_PORT", Default: defaultTiDBPort, Description: "TiDB/MySQL port"},
+	{Key: "TIDB_USER", Description: "TiDB/MySQL user (required for those dialects)"},
+	{Key: "TIDB_PASSWORD", Description: "TiDB/MySQL password"},
+	{Key: "TIDB_DATABASE", Default: defaultDatabaseName, Description: "TiDB/MySQL database"},
+	{Key: "POSTGRES_DSN", Description: "PostgreSQL connection URL (required for postgres)"},
+	{Key: "SQLITE_PATH", Default: DefaultSQLitePath, Description: "embedded database file; :memory: keeps it in memory"},
+	{Key: "TIDB_REPLICA_DSN", Description: "read replica DSN on TiDB/MySQL"},
+	{Key: "POSTGRES_REPLICA_DSN", Description: "read replica URL on PostgreSQL"},
+	{Key: "JWT_ALGORITHM", Default: "HS256", Description: "HS256, RS256 or EdDSA"},
+	{Key: "JWT_SECRET", Description: "HS256 signing secret, 32+ characters (required outside development)"},
+	{Key: "CONFIG_RELOAD_INTERVAL", Default: defaultReloadInterval.String(), Description: "how often runtime settings are reloaded from _System_Config; 0 disables it"},
+
+	{Key: "QUERY_MAX_ROWS", Default: strconv.Itoa(defaultQueryMaxRows), Description: "rows one record query may request; 0 lifts the limit",
+		apply: func(rt *Runtime, raw string) (err error) { rt.QueryMaxRows, err = parseCount(raw); return }},
+	{Key: "QUERY_TIMEOUT", Default: defaultQueryTimeout.String(), Description: "execution time of a record query; 0 lifts the limit",
+		apply: func(rt *Runtime, raw string) (err error) { rt.QueryTimeout, err = parseDuration(raw); return }},
+	{Key: "QUERY_MAX_CONCURRENT", Default: strconv.Itoa(defaultQueryMaxConcurrent), Description: "record queries a user may run at once; 0 lifts the limit",
+		apply: func(rt *Runtime, raw string) (err error) { rt.QueryMaxConcurrent, err = parseCount(raw); return }},
+	{Key: "DASHBOARD_CACHE_TTL", Default: defaultDashboardCacheTTL.String(), Description: "how long dashboard results are cached; 0 disables the cache",
+		apply: func(rt *Runtime, raw string) (err error) { rt.DashboardCacheTTL, err = parseDuration(raw); return }},
+	{Key: "SLOW_QUERY_THRESHOLD", Default: defaultSlowQueryThreshold.String(), Description: "duration from which the index advisor captures a query; 0 disables the capture",
+		apply: func(rt *Runtime, raw string) (err error) { rt.SlowQueryThreshold, err = parseDuration(raw); return }},
+}
+
+// Runtime defaults
+const (
+	defaultQueryMaxRows       = 10000
+	defaultQueryTimeout       = 30 * time.Second
+	defaultQueryMaxConcurrent = 10
+	defaultDashboardCacheTTL  = 60 * time.Second
+	defaultSlowQueryThreshold = 500 * time.Millisecond
+)
+
+// Runtime holds the settings that can change while the server runs. They are read from the
+// environment at startup; _System_Config entries with the same key take precedence and are
+// reloaded every Config.ReloadInterval.
+type Runtime struct {
+	QueryMaxRows       int
+	QueryTimeout       time.Duration
+	QueryMaxConcurrent int
+	DashboardCacheTTL  time.Duration
+	SlowQueryThreshold time.Duration
+}
+
+// DefaultRuntime returns the runtime settings used when nothing overrides them
+func DefaultRuntime() Runtime {
+	return Runtime{
+		QueryMaxRows:       defaultQueryMaxRows,
+		QueryTimeout:       defaultQueryTimeout,
+		QueryMaxConcurrent: defaultQueryMaxConcurrent,
+		DashboardCacheTTL:  defaultDashboardCacheTTL,
+		SlowQueryThreshold: defaultSlowQueryThreshold,
+	}
+}
+
+// RuntimeFromEnv reads the runtime settings from the environment. Invalid values keep their
+// default, so it suits code paths that run without startup validation, such as tests.
+func RuntimeFromEnv() Runtime {
+	rt, errs := parseRuntime(DefaultRuntime(), os.LookupEnv)
+	for _, err := range errs {
+		log.Printf("⚠️  Invalid %v, using the default", err)
+	}
+	return rt
+}
+
+// parseRuntime applies the reloadable settings that lookup finds to base
+func parseRuntime(base Runtime, lookup func(key string) (string, bool)) (Runtime, []error) {
+	rt := base
+	var errs []error
+	for _, s := range Settings {
+		if !s.Reloadable() {
+			continue
+		}
+		raw, ok := lookup(s.Key)
+		if !ok || strings.TrimSpace(raw) == "" {
+			continue
+		}
+		candidate := rt
+		if err := s.apply(&candidate, strings.TrimSpace(raw)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.Key, err))
+			continue
+		}
+		rt = candidate
+	}
+	return rt, errs
+}
+
+// ReloadableKeys returns the keys of the settings that may be set in _System_Config
+func ReloadableKeys() []string {
+	keys := make([]string, 0)
+	for _, s := range Settings {
+		if s.Reloadable() {
+			keys = append(keys, s.Key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CheckEntry validates a _System_Config entry named after a setting: only reloadable settings
+// may be set there, with a valid value. Other entries are not checked.
+func CheckEntry(key, value string) error {
+	s, ok := settingByKey(strings.ToUpper(strings.TrimSpace(key)))
+	if !ok {
+		return nil
+	}
+	if !s.Reloadable() {
+		return fmt.Errorf("%s is read from the environment at startup only; the settings that may be changed here are %s",
+			s.Key, strings.Join(ReloadableKeys(), ", "))
+	}
+	var rt Runtime
+	return s.apply(&rt, strings.TrimSpace(value))
+}
+
+// IsSetting reports whether key is a configuration key, in any case
+func IsSetting(key string) bool {
+	for _, s := range Settings {
+		if strings.EqualFold(s.Key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCount parses a non-negative integer
+func parseCount(raw string) (int, error) {
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%q is not a count (0 or greater)", raw)
+	}
+	return n, nil
+}
+
+// parseDuration parses a non-negative Go duration such as "250ms" or "5m"; a bare "0" is allowed
+func parseDuration(raw string) (time.Duration, error) {
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("%q is not a duration such as 500ms, 30s or 5m", raw)
+	}
+	return d, nil
+}
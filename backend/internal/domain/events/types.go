@@ -19,6 +19,14 @@ const (
 	ObjectCreated EventType = "schema.object_created"
 	FieldCreated  EventType = "schema.field_created"
 
+	// Order Events (published for fulfillment integrations)
+	OrderActivated EventType = "order.activated"
+	OrderFulfilled EventType = "order.fulfilled"
+	OrderCancelled EventType = "order.cancelled"
+
+	// Survey Events
+	SurveyResponded EventType = "survey.responded"
+
 	// System Events
 	SystemStartup EventType = "system.startup"
 )
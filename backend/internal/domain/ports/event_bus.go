@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+)
+
+// EventMessage is an event as carried by an external event bus
+type EventMessage struct {
+	ID      string           // Outbox event ID; stays the same on redelivery so consumers can spot duplicates
+	Type    events.EventType // Event type
+	Key     string           // Partitioning key; events with the same key are delivered in order
+	Payload []byte           // JSON-encoded payload
+}
+
+// EventMessageHandler processes one delivered message. Returning an error leaves the
+// message unacknowledged so that it is delivered again.
+type EventMessageHandler func(ctx context.Context, msg EventMessage) error
+
+// EventBusPort carries after-commit events through an external broker so that all nodes
+// of a deployment share one event stream. Delivery is at-least-once.
+//
+// In-transaction events (beforeCreate, beforeUpdate, ...) never go through the port:
+// their handlers may change the record being saved and must run in-process.
+type EventBusPort interface {
+	// Publish returns once the broker has durably accepted the message.
+	Publish(ctx context.Context, msg EventMessage) error
+
+	// Consume delivers messages to handle as a member of a consumer group until ctx is
+	// done. Each message goes to one member of the group and is acknowledged only after
+	// handle succeeds.
+	Consume(ctx context.Context, group string, handle EventMessageHandler) error
+
+	// Close releases the broker connections.
+	Close() error
+}
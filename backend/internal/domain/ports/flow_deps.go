@@ -29,3 +29,10 @@ type ApprovalPersistence interface {
 	// Insert creates a new record in the specified table
 	Insert(ctx context.Context, tableName string, data models.SObject, user *models.UserSession) (models.SObject, error)
 }
+
+// SavepointRunner isolates work inside a savepoint of the transaction carried by ctx.
+// This interface enables FlowExecutor to roll back a failing flow without aborting the save.
+type SavepointRunner interface {
+	// WithSavepoint runs fn, rolling back only its work when it fails
+	WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
+}
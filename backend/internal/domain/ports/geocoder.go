@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// Geocoder resolves postal addresses to coordinates for Geolocation fields.
+type Geocoder interface {
+	// Name returns the provider identifier (e.g. "nominatim", "google").
+	Name() string
+
+	// Geocode returns the best match for address, or nil when the provider cannot place it.
+	Geocode(ctx context.Context, address string) (*models.GeoPoint, error)
+}
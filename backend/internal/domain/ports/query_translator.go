@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// QueryTranslation is a translator's proposal for the query answering a question
+type QueryTranslation struct {
+	ObjectAPIName string `json:"object_api_name"`
+	FilterExpr    string `json:"filter_expr,omitempty"` // Formula filter syntax
+	SortField     string `json:"sort_field,omitempty"`
+	SortDirection string `json:"sort_direction,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+	Explanation   string `json:"explanation,omitempty"`
+}
+
+// QueryTranslator turns natural-language questions into structured queries.
+// Proposals are untrusted: callers validate them against metadata before running them.
+type QueryTranslator interface {
+	// Translate proposes a query over one of objects. feedback, when set, explains
+	// why the previous proposal for the same question was rejected.
+	Translate(ctx context.Context, question string, objects []*models.ObjectMetadata, feedback string) (*QueryTranslation, error)
+}
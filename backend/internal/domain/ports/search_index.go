@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+)
+
+// SearchDocument is the indexable projection of a single record.
+// Only searchable text fields are included; values are flattened to strings.
+type SearchDocument struct {
+	ObjectAPIName string            `json:"object_api_name"`
+	RecordID      string            `json:"record_id"`
+	Fields        map[string]string `json:"fields"`
+}
+
+// SearchQuery describes a full-text query against the index.
+type SearchQuery struct {
+	Term           string   `json:"term"`
+	ObjectAPINames []string `json:"object_api_names,omitempty"` // Empty means all indexed objects
+	Limit          int      `json:"limit,omitempty"`
+}
+
+// SearchHit is a single ranked match returned by the index.
+type SearchHit struct {
+	ObjectAPIName string            `json:"object_api_name"`
+	RecordID      string            `json:"record_id"`
+	Score         float64           `json:"score"`
+	Highlights    map[string]string `json:"highlights,omitempty"` // field -> snippet with <mark> tags
+}
+
+// SearchIndex provides full-text indexing and relevance-ranked search.
+// Implementations may be embedded (in-process) or backed by an external engine.
+type SearchIndex interface {
+	// Name returns the engine identifier (e.g. "embedded", "meilisearch").
+	Name() string
+
+	// Index adds or replaces a document.
+	Index(ctx context.Context, doc SearchDocument) error
+
+	// Remove deletes a document. Removing an unknown document is not an error.
+	Remove(ctx context.Context, objectAPIName, recordID string) error
+
+	// Search returns hits ordered by descending relevance.
+	Search(ctx context.Context, query SearchQuery) ([]SearchHit, error)
+}
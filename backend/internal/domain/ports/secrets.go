@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSecretNotFound is returned when a secret store has no secret at the path
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretsPort reads secrets from a secret store, so that configuration only has to hold a
+// reference such as "vault:secret/data/nexuscrm#jwt_secret" instead of the secret itself.
+type SecretsPort interface {
+	// Name returns the store identifier used as the reference scheme (e.g. "env", "vault").
+	Name() string
+
+	// GetSecret returns the secret at path, the part of the reference after "<name>:".
+	// A path may end in "#key" to pick one key of a secret holding several.
+	GetSecret(ctx context.Context, path string) (string, error)
+}
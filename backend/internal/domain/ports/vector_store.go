@@ -0,0 +1,59 @@
+package ports
+
+import (
+	"context"
+)
+
+// Embedder turns text into embedding vectors for semantic search.
+type Embedder interface {
+	// Name returns the embedding model identifier (e.g. "text-embedding-3-small").
+	Name() string
+
+	// Embed returns one vector per input text, in input order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// VectorDocument is the stored embedding of a single record.
+type VectorDocument struct {
+	ObjectAPIName string    `json:"object_api_name"`
+	RecordID      string    `json:"record_id"`
+	Vector        []float32 `json:"vector"`
+	ContentHash   string    `json:"content_hash"` // Hash of the embedded text; unchanged text is not re-embedded
+	Model         string    `json:"model"`
+}
+
+// VectorQuery describes a nearest-neighbour query against the store.
+type VectorQuery struct {
+	Vector         []float32 `json:"vector"`
+	ObjectAPINames []string  `json:"object_api_names,omitempty"` // Empty means all embedded objects
+	Limit          int       `json:"limit,omitempty"`
+}
+
+// VectorHit is a single match returned by the store.
+type VectorHit struct {
+	ObjectAPIName string  `json:"object_api_name"`
+	RecordID      string  `json:"record_id"`
+	Score         float64 `json:"score"` // Cosine similarity; higher is closer
+}
+
+// VectorStore keeps record embeddings and answers similarity queries.
+// Implementations may be embedded (in-process) or backed by a vector column in the database.
+type VectorStore interface {
+	// Name returns the store identifier (e.g. "memory", "tidb").
+	Name() string
+
+	// Upsert adds or replaces a record's embedding.
+	Upsert(ctx context.Context, doc VectorDocument) error
+
+	// Remove deletes a record's embedding. Removing an unknown record is not an error.
+	Remove(ctx context.Context, objectAPIName, recordID string) error
+
+	// ContentHash returns the hash stored with a record's embedding, or "" when it has none.
+	ContentHash(ctx context.Context, objectAPIName, recordID string) (string, error)
+
+	// Vector returns a record's stored embedding, or nil when it has none.
+	Vector(ctx context.Context, objectAPIName, recordID string) ([]float32, error)
+
+	// Search returns hits ordered by descending similarity.
+	Search(ctx context.Context, query VectorQuery) ([]VectorHit, error)
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/nexuscrm/backend/pkg/query"
+)
+
+// The PostgreSQL driver rebinds every statement before pgx sees it, so the repositories'
+// MySQL-flavoured SQL (backtick identifiers, ? placeholders) runs unchanged.
+func init() {
+	sql.Register(query.Postgres{}.DriverName(), rebindDriver{inner: stdlib.GetDefaultDriver(), dialect: query.Postgres{}})
+}
+
+type rebindDriver struct {
+	inner   driver.Driver
+	dialect query.Dialect
+}
+
+func (d rebindDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.inner.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &rebindConn{Conn: conn, dialect: d.dialect}, nil
+}
+
+// rebindConn rebinds statements and forwards everything else to the pgx connection
+type rebindConn struct {
+	driver.Conn
+	dialect query.Dialect
+}
+
+func (c *rebindConn) Prepare(sql string) (driver.Stmt, error) {
+	return c.Conn.Prepare(c.dialect.Rebind(sql))
+}
+
+func (c *rebindConn) PrepareContext(ctx context.Context, sql string) (driver.Stmt, error) {
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return p.PrepareContext(ctx, c.dialect.Rebind(sql))
+	}
+	return c.Prepare(sql)
+}
+
+func (c *rebindConn) ExecContext(ctx context.Context, sql string, args []driver.NamedValue) (driver.Result, error) {
+	if e, ok := c.Conn.(driver.ExecerContext); ok {
+		return e.ExecContext(ctx, c.dialect.Rebind(sql), args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *rebindConn) QueryContext(ctx context.Context, sql string, args []driver.NamedValue) (driver.Rows, error) {
+	if q, ok := c.Conn.(driver.QueryerContext); ok {
+		return q.QueryContext(ctx, c.dialect.Rebind(sql), args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *rebindConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *rebindConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *rebindConn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *rebindConn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (c *rebindConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if n, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
@@ -0,0 +1,247 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"modernc.org/sqlite"
+)
+
+// mysqlTimeLayout is how MySQL renders DATETIME values
+const mysqlTimeLayout = "2006-01-02 15:04:05"
+
+// The SQLite driver adds the MySQL date and comparison functions the repositories and
+// generated filters call, and rebinds INFORMATION_SCHEMA lookups onto SQLite's catalog.
+func init() {
+	for name, fn := range map[string]func() string{
+		"now":           func() string { return time.Now().Format(mysqlTimeLayout) },
+		"utc_timestamp": func() string { return time.Now().UTC().Format(mysqlTimeLayout) },
+		"curdate":       func() string { return time.Now().Format(time.DateOnly) },
+		"database":      func() string { return "main" },
+	} {
+		if err := sqlite.RegisterScalarFunction(name, 0, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+			return fn(), nil
+		}); err != nil {
+			panic(fmt.Sprintf("sqlite: register %s(): %v", name, err))
+		}
+	}
+	for name, fn := range map[string]func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error){
+		"date_add_days":         dateAddDays,
+		"generation_expression": generationExpression,
+	} {
+		if err := sqlite.RegisterDeterministicScalarFunction(name, 2, fn); err != nil {
+			panic(fmt.Sprintf("sqlite: register %s(): %v", name, err))
+		}
+	}
+	for name, keep := range map[string]func(cmp int) bool{
+		"least":    func(cmp int) bool { return cmp < 0 },
+		"greatest": func(cmp int) bool { return cmp > 0 },
+	} {
+		if err := sqlite.RegisterDeterministicScalarFunction(name, -1, extremum(keep)); err != nil {
+			panic(fmt.Sprintf("sqlite: register %s(): %v", name, err))
+		}
+	}
+	sql.Register(query.SQLite{}.DriverName(), rebindDriver{inner: registeredDriver("sqlite"), dialect: query.SQLite{}})
+}
+
+// sqliteTimeLayouts are the forms dates and datetimes are stored in
+var sqliteTimeLayouts = []string{time.DateOnly, mysqlTimeLayout, "2006-01-02 15:04:05.999999999-07:00", time.RFC3339Nano}
+
+// dateAddDays implements date_add_days(date, days), the SQLite side of Dialect.AddDays.
+// A date comes back as a date and anything else as a MySQL-style datetime; NULL or an
+// unreadable date gives NULL, as DATE_ADD does.
+func dateAddDays(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	var days int
+	switch v := args[1].(type) {
+	case int64:
+		days = int(v)
+	case float64:
+		days = int(v)
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, nil
+		}
+		days = n
+	default:
+		return nil, nil
+	}
+
+	var value string
+	switch v := args[0].(type) {
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	case time.Time:
+		return v.AddDate(0, 0, days).Format(mysqlTimeLayout), nil
+	default:
+		return nil, nil
+	}
+	for _, layout := range sqliteTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == time.DateOnly {
+				return t.AddDate(0, 0, days).Format(time.DateOnly), nil
+			}
+			return t.AddDate(0, 0, days).Format(mysqlTimeLayout), nil
+		}
+	}
+	return nil, nil
+}
+
+// extremum implements LEAST and GREATEST: the argument keep prefers over every other,
+// compared as numbers when all are numbers and as text otherwise. Any NULL gives NULL, as
+// in MySQL; SQLite's own multi-argument min() and max() would skip it.
+func extremum(keep func(cmp int) bool) func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+	return func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		if len(args) < 2 {
+			return nil, fmt.Errorf("expected at least 2 arguments, got %d", len(args))
+		}
+		numeric := true
+		for _, arg := range args {
+			switch arg.(type) {
+			case nil:
+				return nil, nil
+			case int64, float64:
+			default:
+				numeric = false
+			}
+		}
+
+		best := args[0]
+		for _, arg := range args[1:] {
+			var cmp int
+			if numeric {
+				cmp = compareNumbers(arg, best)
+			} else {
+				cmp = strings.Compare(asText(arg), asText(best))
+			}
+			if keep(cmp) {
+				best = arg
+			}
+		}
+		return best, nil
+	}
+}
+
+func compareNumbers(a, b driver.Value) int {
+	x, y := asFloat(a), asFloat(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func asFloat(v driver.Value) float64 {
+	if n, ok := v.(int64); ok {
+		return float64(n)
+	}
+	return v.(float64)
+}
+
+func asText(v driver.Value) string {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(mysqlTimeLayout)
+	}
+	return fmt.Sprint(v)
+}
+
+// registeredDriver returns the driver registered with database/sql under a name
+func registeredDriver(name string) driver.Driver {
+	db, err := sql.Open(name, "")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	return db.Driver()
+}
+
+// OpenSQLite opens a standalone embedded database file outside the GetInstance singleton.
+// The test harness gives each test its own file this way; callers own the connection and
+// must make the SQLite dialect active before running repository SQL against it.
+func OpenSQLite(path string) (*TiDBConnection, error) {
+	dsn := "file:" + path
+	if path == ":memory:" {
+		dsn = "file:nexuscrm?mode=memory&cache=shared"
+	}
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	// WAL lets readers run alongside the single writer; busy_timeout queues writers
+	// instead of failing them
+	dsn += sep + "_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_pragma=foreign_keys(0)"
+
+	conn, err := openConnection(query.SQLite{}.DriverName(), dsn)
+	if err != nil {
+		return nil, err
+	}
+	if path == ":memory:" {
+		// The shared in-memory database disappears once its last connection closes
+		conn.db.SetConnMaxLifetime(0)
+		conn.db.SetConnMaxIdleTime(0)
+	}
+	return conn, nil
+}
+
+// generationExpression implements generation_expression(create_sql, column), which the
+// INFORMATION_SCHEMA.COLUMNS emulation uses: SQLite keeps a generated column's expression
+// only in the table's CREATE statement. It returns "" when the column is not generated.
+func generationExpression(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	createSQL, _ := args[0].(string)
+	column, _ := args[1].(string)
+	start := strings.Index(createSQL, "`"+column+"`")
+	if start < 0 {
+		start = strings.Index(createSQL, `"`+column+`"`)
+	}
+	if start < 0 || column == "" {
+		return "", nil
+	}
+
+	// The definition runs to the next comma outside parentheses
+	def := createSQL[start+len(column)+2:]
+	depth := 0
+	for i, c := range def {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			if depth == 0 {
+				def = def[:i]
+				break
+			}
+			depth--
+		} else if c == ',' && depth == 0 {
+			def = def[:i]
+			break
+		}
+	}
+
+	as := strings.Index(strings.ToUpper(def), " AS (")
+	if as < 0 {
+		return "", nil
+	}
+	expr := def[as+len(" AS ("):]
+	depth = 0
+	for i, c := range expr {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			if depth == 0 {
+				return strings.TrimSpace(expr[:i]), nil
+			}
+			depth--
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteDateExpressions(t *testing.T) {
+	conn, err := OpenSQLite(filepath.Join(t.TempDir(), "dates.db"))
+	require.NoError(t, err)
+	defer conn.Close()
+	d := query.SQLite{}
+
+	for period, want := range map[constants.ReportDateBucket]string{
+		constants.ReportDateBucketDay:     "2024-01-01",
+		constants.ReportDateBucketWeek:    "2024-W01",
+		constants.ReportDateBucketMonth:   "2024-01",
+		constants.ReportDateBucketQuarter: "2024-Q1",
+		constants.ReportDateBucketYear:    "2024",
+	} {
+		var got string
+		require.NoError(t, conn.QueryRow("SELECT "+d.DatePeriod("v", period)+" FROM (SELECT ? AS v)", "2024-01-01 10:30:00").Scan(&got), period)
+		assert.Equal(t, want, got, period)
+	}
+
+	var week string
+	require.NoError(t, conn.QueryRow("SELECT "+d.DatePeriod("v", constants.ReportDateBucketWeek)+" FROM (SELECT ? AS v)", "2021-01-03").Scan(&week))
+	assert.Equal(t, "2020-W53", week, "ISO weeks belong to the year of their Thursday")
+
+	var date, datetime string
+	require.NoError(t, conn.QueryRow("SELECT "+d.AddDays("?", "?")+", "+d.AddDays("?", "?"),
+		"2024-02-27", 3, "2024-12-31 23:00:00", 1).Scan(&date, &datetime))
+	assert.Equal(t, "2024-03-01", date, "dates stay dates")
+	assert.Equal(t, "2025-01-01 23:00:00", datetime)
+
+	var today string
+	require.NoError(t, conn.QueryRow("SELECT "+d.CurrentDate()).Scan(&today))
+	assert.Len(t, today, len("2006-01-02"))
+}
+
+func TestSQLiteLeastGreatest(t *testing.T) {
+	conn, err := OpenSQLite(filepath.Join(t.TempDir(), "compare.db"))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var least, greatest float64
+	require.NoError(t, conn.QueryRow("SELECT LEAST(1, ?, 3), GREATEST(1, ?, 3)", 0.5, 7.25).Scan(&least, &greatest))
+	assert.Equal(t, 0.5, least)
+	assert.Equal(t, 7.25, greatest)
+
+	var text string
+	require.NoError(t, conn.QueryRow("SELECT LEAST('pear', 'apple')").Scan(&text))
+	assert.Equal(t, "apple", text)
+
+	var null *float64
+	require.NoError(t, conn.QueryRow("SELECT LEAST(1, NULL)").Scan(&null))
+	assert.Nil(t, null, "NULL wins, as in MySQL")
+}
@@ -0,0 +1,58 @@
+package documents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+)
+
+// Pixel sizes codes are drawn at; renderers scale them to the page
+const (
+	barcodeWidth  = 600
+	barcodeHeight = 150
+	qrSize        = 300
+)
+
+// CodePNG draws a value as a Code 128 barcode or a QR code
+func CodePNG(code, value string) ([]byte, error) {
+	var bc barcode.Barcode
+	var err error
+	switch code {
+	case CodeBarcode:
+		if bc, err = code128.Encode(value); err == nil {
+			bc, err = barcode.Scale(bc, barcodeWidth, barcodeHeight)
+		}
+	case CodeQR:
+		if bc, err = qr.Encode(value, qr.M, qr.Auto); err == nil {
+			bc, err = barcode.Scale(bc, qrSize, qrSize)
+		}
+	default:
+		return nil, fmt.Errorf("unknown code '%s'; expected %s or %s", code, CodeBarcode, CodeQR)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", code, err)
+	}
+
+	// Codes are 16-bit grayscale, which PDF renderers may not embed; 8 bits are plenty
+	img := image.NewGray(bc.Bounds())
+	draw.Draw(img, img.Bounds(), bc, bc.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CodeImageTag is the HTML image of a code, embedded as a data URI. The class tells the
+// built-in renderer how large to draw it.
+func CodeImageTag(code string, pngData []byte) string {
+	return fmt.Sprintf(`<img class="nexus-%s" src="data:image/png;base64,%s">`, code, base64.StdEncoding.EncodeToString(pngData))
+}
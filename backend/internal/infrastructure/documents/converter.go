@@ -0,0 +1,96 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxConvertedSize caps the PDF read back from the converter
+const maxConvertedSize = 50 << 20
+
+// Converter turns HTML and DOCX into PDF with a Gotenberg server
+// (https://gotenberg.dev): Chromium renders HTML with full CSS, LibreOffice renders DOCX.
+type Converter struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewConverter creates a client for the converter at baseURL
+func NewConverter(baseURL string) *Converter {
+	return &Converter{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// NewConverterAt creates the converter at DOCUMENT_CONVERTER_URL, or nil when it is empty
+// (HTML templates then use the built-in renderer and DOCX templates cannot render)
+func NewConverterAt(url string) *Converter {
+	if url == "" {
+		return nil
+	}
+	return NewConverter(url)
+}
+
+// HTMLToPDF renders an HTML document
+func (c *Converter) HTMLToPDF(ctx context.Context, htmlDoc []byte, pageSize string) ([]byte, error) {
+	fields := map[string]string{}
+	switch pageSize {
+	case PageSizeLetter:
+		fields["paperWidth"], fields["paperHeight"] = "8.5", "11"
+	default:
+		fields["paperWidth"], fields["paperHeight"] = "8.27", "11.7"
+	}
+	return c.convert(ctx, "/forms/chromium/convert/html", "index.html", htmlDoc, fields)
+}
+
+// DOCXToPDF renders a Word document
+func (c *Converter) DOCXToPDF(ctx context.Context, docx []byte) ([]byte, error) {
+	return c.convert(ctx, "/forms/libreoffice/convert", "document.docx", docx, nil)
+}
+
+func (c *Converter) convert(ctx context.Context, path, fileName string, content []byte, fields map[string]string) ([]byte, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("files", fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("document converter request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxConvertedSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read converted document: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("document converter returned %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
@@ -0,0 +1,90 @@
+package documents
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeFields(t *testing.T) {
+	template := `<h1>Quote {{ quote_number }}</h1><p>{{account__name}}</p>{{barcode:quote_number}}{{QRCODE:url}}{{quote_number}}{{not a field}}`
+
+	assert.Equal(t, []MergeField{
+		{Field: "quote_number"},
+		{Field: "account__name"},
+		{Field: "quote_number", Code: CodeBarcode},
+		{Field: "url", Code: CodeQR},
+	}, MergeFields(template))
+
+	merged := Merge(template, func(f MergeField) string {
+		if f.Code != "" {
+			return "[" + f.Code + "]"
+		}
+		return f.Field
+	})
+	assert.Equal(t, `<h1>Quote quote_number</h1><p>account__name</p>[barcode][qrcode]quote_number{{not a field}}`, merged)
+}
+
+func TestCodePNG(t *testing.T) {
+	for _, code := range []string{CodeBarcode, CodeQR} {
+		png, err := CodePNG(code, "Q-00042")
+		require.NoError(t, err, code)
+		assert.Equal(t, "\x89PNG", string(png[:4]), code)
+	}
+	_, err := CodePNG("ean", "Q-00042")
+	assert.Error(t, err)
+}
+
+func TestRenderHTML(t *testing.T) {
+	png, err := CodePNG(CodeQR, "https://example.com/q/42")
+	require.NoError(t, err)
+
+	pdf, err := RenderHTML(`<html><head><style>p { color: red }</style></head><body>
+		<h1>Quote Q-00042</h1>
+		<p>For <b>Acme &amp; Co</b> – café</p>
+		<table><tr><th>Item</th><th>Amount</th></tr><tr><td>Widget</td><td>10.00</td></tr></table>
+		<ul><li>Net 30</li></ul><hr>`+CodeImageTag(CodeQR, png)+`</body></html>`, PageSizeLetter)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF", string(pdf[:4]))
+}
+
+func TestMergeDOCX(t *testing.T) {
+	var template bytes.Buffer
+	w := zip.NewWriter(&template)
+	files := map[string]string{
+		"[Content_Types].xml": `<Types/>`,
+		"word/document.xml":   `<w:body><w:p><w:r><w:t>Dear {{</w:t></w:r><w:r><w:t>name}}, total {{amount}}</w:t></w:r></w:p></w:body>`,
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	merged, err := MergeDOCX(template.Bytes(), func(f MergeField) string {
+		return map[string]string{"name": "Smith & Sons", "amount": "12.50"}[f.Field]
+	})
+	require.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(merged), int64(len(merged)))
+	require.NoError(t, err)
+	for _, f := range r.File {
+		if f.Name != "word/document.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, `<w:body><w:p><w:r><w:t>Dear Smith &amp; Sons, total 12.50</w:t></w:r></w:p></w:body>`, string(content))
+	}
+
+	_, err = MergeDOCX([]byte("not a zip"), func(MergeField) string { return "" })
+	assert.Error(t, err)
+}
@@ -0,0 +1,104 @@
+package documents
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// wordPart matches the parts of a DOCX package holding text: the body, headers and footers
+var wordPart = regexp.MustCompile(`^word/(document|header[0-9]*|footer[0-9]*)\.xml$`)
+
+// xmlTag matches a single XML tag; Word often splits a merge field across several runs
+var xmlTag = regexp.MustCompile(`<[^>]+>`)
+
+// MergeDOCX fills the merge fields of a DOCX template. Values are inserted as text, so
+// barcodes and QR codes print their value; convert the result with an external converter.
+func MergeDOCX(template []byte, resolve func(MergeField) string) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(template), int64(len(template)))
+	if err != nil {
+		return nil, fmt.Errorf("template is not a DOCX file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if wordPart.MatchString(file.Name) {
+			content = []byte(mergeWordXML(string(content), resolve))
+		}
+		header := file.FileHeader
+		part, err := writer.CreateHeader(&header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// mergeWordXML replaces merge fields in WordprocessingML. A field Word split across runs
+// ("{{" in one run, the name in the next) is joined first by dropping the tags inside it.
+func mergeWordXML(content string, resolve func(MergeField) string) string {
+	var out strings.Builder
+	for {
+		start := strings.Index(content, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(content[start:], "}}")
+		if end < 0 {
+			break
+		}
+		end += start + 2
+		token := xmlTag.ReplaceAllString(content[start:end], "")
+		out.WriteString(content[:start])
+		if mergeFieldPattern.MatchString(token) {
+			out.WriteString(Merge(token, func(f MergeField) string { return xmlEscape(resolve(f)) }))
+		} else {
+			out.WriteString(content[start:end])
+		}
+		content = content[end:]
+	}
+	out.WriteString(content)
+	return out.String()
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		case '"':
+			buf.WriteString("&quot;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
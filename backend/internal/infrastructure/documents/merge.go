@@ -0,0 +1,52 @@
+// Package documents renders document templates into PDFs: merge fields are filled from a
+// record, barcodes and QR codes are drawn from field values, and the result is converted
+// by the built-in renderer or an external converter.
+package documents
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Merge field prefixes drawing a field's value as an image instead of text
+const (
+	CodeBarcode = "barcode" // {{barcode:field}}: Code 128
+	CodeQR      = "qrcode"  // {{qrcode:field}}: QR code
+)
+
+// mergeFieldPattern matches {{field}}, {{lookup__name}} and {{barcode:field}}
+var mergeFieldPattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+(?::[A-Za-z0-9_]+)?)\s*\}\}`)
+
+// MergeField is a merge field of a template: the field it reads and, for images, the code drawn
+type MergeField struct {
+	Field string
+	Code  string // CodeBarcode, CodeQR or empty for text
+}
+
+// ParseMergeField splits a merge field token's name into its code and field
+func ParseMergeField(name string) MergeField {
+	if code, field, ok := strings.Cut(name, ":"); ok {
+		return MergeField{Field: field, Code: strings.ToLower(code)}
+	}
+	return MergeField{Field: name}
+}
+
+// MergeFields lists the distinct merge fields of a template in order of appearance
+func MergeFields(template string) []MergeField {
+	seen := make(map[string]bool)
+	fields := make([]MergeField, 0)
+	for _, m := range mergeFieldPattern.FindAllStringSubmatch(template, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			fields = append(fields, ParseMergeField(m[1]))
+		}
+	}
+	return fields
+}
+
+// Merge replaces every merge field of a template with what resolve returns for it
+func Merge(template string, resolve func(MergeField) string) string {
+	return mergeFieldPattern.ReplaceAllStringFunc(template, func(token string) string {
+		return resolve(ParseMergeField(mergeFieldPattern.FindStringSubmatch(token)[1]))
+	})
+}
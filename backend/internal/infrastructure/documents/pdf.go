@@ -0,0 +1,271 @@
+package documents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Page sizes templates may use
+const (
+	PageSizeA4     = "A4"
+	PageSizeLetter = "Letter"
+)
+
+const (
+	pageMargin   = 20.0 // mm
+	baseFontSize = 11.0 // pt
+	// pxToMM converts CSS pixels (96 per inch) to millimetres
+	pxToMM = 25.4 / 96
+)
+
+var (
+	headingSizes = map[string]float64{"h1": 20, "h2": 16, "h3": 13}
+	// codeSizes are the printed sizes (mm) of barcode and QR images
+	codeSizes = map[string][2]float64{
+		"nexus-" + CodeBarcode: {60, 15},
+		"nexus-" + CodeQR:      {30, 30},
+	}
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// blockTags start and end on a line of their own
+var blockTags = map[string]bool{
+	"p": true, "div": true, "h1": true, "h2": true, "h3": true, "li": true,
+	"ul": true, "ol": true, "table": true, "tr": true, "section": true, "header": true, "footer": true,
+}
+
+// skippedTags have content that is not printed
+var skippedTags = map[string]bool{"head": true, "title": true, "style": true, "script": true}
+
+// RenderHTML renders HTML into a PDF with the built-in renderer. It prints text with bold,
+// italic and underline, headings (h1-h3), paragraphs, list items, table rows (cells
+// separated by spaces), horizontal rules and embedded PNG or JPEG images, each on a line
+// of its own; CSS is ignored. Use an external converter for full-fidelity layouts.
+func RenderHTML(src, pageSize string) ([]byte, error) {
+	if pageSize == "" {
+		pageSize = PageSizeA4
+	}
+	pdf := gofpdf.New("P", "mm", pageSize, "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.AddPage()
+
+	r := &htmlRenderer{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor(""), size: baseFontSize}
+	r.setFont()
+	for _, seg := range gofpdf.HTMLBasicTokenize(src) {
+		switch seg.Cat {
+		case 'T':
+			r.text(seg.Str)
+		case 'O':
+			r.open(seg.Str, seg.Attr)
+		case 'C':
+			r.close(seg.Str)
+		}
+		if pdf.Err() {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+type htmlRenderer struct {
+	pdf                     *gofpdf.Fpdf
+	tr                      func(string) string
+	bold, italic, underline int
+	size                    float64
+	skip                    int // Depth inside skipped tags
+	cell                    int // Cell index within the current table row
+	images                  int
+}
+
+func (r *htmlRenderer) lineHeight() float64 {
+	return r.size * 0.5
+}
+
+func (r *htmlRenderer) setFont() {
+	style := ""
+	if r.bold > 0 {
+		style += "B"
+	}
+	if r.italic > 0 {
+		style += "I"
+	}
+	if r.underline > 0 {
+		style += "U"
+	}
+	r.pdf.SetFont("Helvetica", style, r.size)
+}
+
+// newLine ends the current line unless nothing was written on it
+func (r *htmlRenderer) newLine() {
+	left, _, _, _ := r.pdf.GetMargins()
+	if r.pdf.GetX() > left+0.01 {
+		r.pdf.Ln(r.lineHeight())
+	}
+}
+
+func (r *htmlRenderer) text(s string) {
+	if r.skip > 0 {
+		return
+	}
+	s = whitespace.ReplaceAllString(html.UnescapeString(s), " ")
+	left, _, _, _ := r.pdf.GetMargins()
+	if r.pdf.GetX() <= left+0.01 {
+		s = strings.TrimLeft(s, " ")
+	}
+	if s != "" {
+		r.pdf.Write(r.lineHeight(), r.tr(s))
+	}
+}
+
+func (r *htmlRenderer) open(tag string, attr map[string]string) {
+	tag = strings.TrimSuffix(tag, "/")
+	if skippedTags[tag] {
+		r.skip++
+		return
+	}
+	if r.skip > 0 {
+		return
+	}
+	if blockTags[tag] {
+		r.newLine()
+	}
+	switch tag {
+	case "b", "strong":
+		r.bold++
+	case "i", "em":
+		r.italic++
+	case "u":
+		r.underline++
+	case "br":
+		r.pdf.Ln(r.lineHeight())
+	case "h1", "h2", "h3":
+		r.pdf.Ln(r.lineHeight() / 2)
+		r.size = headingSizes[tag]
+		r.bold++
+	case "p":
+		r.pdf.Ln(r.lineHeight() / 2)
+	case "li":
+		r.pdf.Write(r.lineHeight(), r.tr("• "))
+	case "tr":
+		r.cell = 0
+	case "td", "th":
+		if r.cell > 0 {
+			r.pdf.Write(r.lineHeight(), "    ")
+		}
+		r.cell++
+		if tag == "th" {
+			r.bold++
+		}
+	case "hr":
+		r.newLine()
+		left, _, right, _ := r.pdf.GetMargins()
+		width, _ := r.pdf.GetPageSize()
+		y := r.pdf.GetY() + 1
+		r.pdf.Line(left, y, width-right, y)
+		r.pdf.SetY(y + 2)
+	case "img":
+		r.image(attr)
+	}
+	r.setFont()
+}
+
+func (r *htmlRenderer) close(tag string) {
+	if skippedTags[tag] {
+		if r.skip > 0 {
+			r.skip--
+		}
+		return
+	}
+	if r.skip > 0 {
+		return
+	}
+	switch tag {
+	case "b", "strong":
+		r.bold = max(r.bold-1, 0)
+	case "i", "em":
+		r.italic = max(r.italic-1, 0)
+	case "u":
+		r.underline = max(r.underline-1, 0)
+	case "th":
+		r.bold = max(r.bold-1, 0)
+	case "h1", "h2", "h3":
+		r.newLine()
+		r.size = baseFontSize
+		r.bold = max(r.bold-1, 0)
+	}
+	if blockTags[tag] {
+		r.newLine()
+	}
+	r.setFont()
+}
+
+// image draws an embedded (data URI) image on a line of its own; other sources are skipped
+func (r *htmlRenderer) image(attr map[string]string) {
+	data, imageType, ok := decodeDataURI(attr["src"])
+	if !ok {
+		return
+	}
+	r.images++
+	name := "img" + strconv.Itoa(r.images)
+	info := r.pdf.RegisterImageOptionsReader(name, gofpdf.ImageOptions{ImageType: imageType}, bytes.NewReader(data))
+	if info == nil || r.pdf.Err() {
+		return
+	}
+
+	left, _, right, bottom := r.pdf.GetMargins()
+	pageWidth, pageHeight := r.pdf.GetPageSize()
+	maxWidth := pageWidth - left - right
+	w, h := info.Width()*pxToMM, info.Height()*pxToMM
+	if size, ok := codeSizes[attr["class"]]; ok {
+		w, h = size[0], size[1]
+	} else if px, err := strconv.ParseFloat(strings.TrimSuffix(attr["width"], "px"), 64); err == nil && px > 0 {
+		w, h = px*pxToMM, h*px*pxToMM/w
+	}
+	if w > maxWidth {
+		w, h = maxWidth, h*maxWidth/w
+	}
+
+	r.newLine()
+	y := r.pdf.GetY()
+	if y+h > pageHeight-bottom {
+		r.pdf.AddPage()
+		y = r.pdf.GetY()
+	}
+	r.pdf.ImageOptions(name, left, y, w, h, false, gofpdf.ImageOptions{ImageType: imageType}, 0, "")
+	r.pdf.SetXY(left, y+h+1)
+}
+
+// decodeDataURI decodes a base64 PNG or JPEG data URI
+func decodeDataURI(src string) ([]byte, string, bool) {
+	header, payload, ok := strings.Cut(src, ",")
+	if !ok || !strings.HasSuffix(header, ";base64") {
+		return nil, "", false
+	}
+	var imageType string
+	switch strings.TrimSuffix(strings.TrimPrefix(header, "data:"), ";base64") {
+	case "image/png":
+		imageType = "PNG"
+	case "image/jpeg", "image/jpg":
+		imageType = "JPG"
+	default:
+		return nil, "", false
+	}
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, "", false
+	}
+	return data, imageType, true
+}
@@ -0,0 +1,83 @@
+// Package eventbus provides EventBusPort adapters for Kafka and NATS JetStream, used to
+// share after-commit events between the nodes of a multi-node deployment.
+package eventbus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Supported EVENT_BUS values
+const (
+	BusInProcess = "inprocess"
+	BusKafka     = "kafka"
+	BusNATS      = "nats"
+
+	defaultTopic  = "nexuscrm.events"
+	defaultStream = "NEXUSCRM_EVENTS"
+	defaultGroup  = "nexuscrm"
+)
+
+// MaxDeliveries is how many times a message is handed to a consumer before it is given up
+const MaxDeliveries = 5
+
+// Message header names
+const (
+	headerEventID   = "nexus-event-id"
+	headerEventType = "nexus-event-type"
+	headerEventKey  = "nexus-event-key" // NATS only; Kafka uses the message key
+)
+
+// New builds the EventBusPort selected by EVENT_BUS.
+// Returns (nil, nil) for the in-process bus, which suits single-node deployments.
+//
+//	EVENT_BUS=inprocess   events stay in the node that committed them (default)
+//	EVENT_BUS=kafka       requires KAFKA_BROKERS (comma-separated); optional EVENT_BUS_TOPIC
+//	EVENT_BUS=nats        requires NATS_URL; optional EVENT_BUS_TOPIC (subject prefix), EVENT_BUS_STREAM
+func New(cfg config.EventBus) (ports.EventBusPort, error) {
+	topic := orDefault(cfg.Topic, defaultTopic)
+
+	switch cfg.Bus {
+	case "", BusInProcess:
+		return nil, nil
+	case BusKafka:
+		if len(cfg.KafkaBrokers) == 0 {
+			return nil, fmt.Errorf("EVENT_BUS=kafka requires KAFKA_BROKERS")
+		}
+		return NewKafkaBus(cfg.KafkaBrokers, topic), nil
+	case BusNATS:
+		if cfg.NATSURL == "" {
+			return nil, fmt.Errorf("EVENT_BUS=nats requires NATS_URL")
+		}
+		return NewNATSBus(cfg.NATSURL, orDefault(cfg.Stream, defaultStream), topic)
+	default:
+		return nil, fmt.Errorf("unsupported EVENT_BUS %q", cfg.Bus)
+	}
+}
+
+// Group returns EVENT_BUS_GROUP, the consumer group shared by all nodes of a deployment
+func Group(cfg config.EventBus) string {
+	return orDefault(cfg.Group, defaultGroup)
+}
+
+// redeliveryDelay is the backoff before the given delivery attempt (1-based) of a failed message
+func redeliveryDelay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := time.Second << (attempt - 1)
+	if delay > 30*time.Second {
+		delay = 30 * time.Second
+	}
+	return delay
+}
+
+func orDefault(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,53 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	bus, err := New(config.EventBus{})
+	require.NoError(t, err)
+	assert.Nil(t, bus, "the in-process bus needs no adapter")
+
+	bus, err = New(config.EventBus{Bus: BusInProcess})
+	require.NoError(t, err)
+	assert.Nil(t, bus)
+
+	_, err = New(config.EventBus{Bus: BusKafka})
+	assert.ErrorContains(t, err, "KAFKA_BROKERS")
+
+	bus, err = New(config.EventBus{Bus: BusKafka, KafkaBrokers: []string{"k1:9092", "k2:9092"}, Topic: "crm.events"})
+	require.NoError(t, err)
+	kafkaBus, ok := bus.(*KafkaBus)
+	require.True(t, ok)
+	assert.Equal(t, []string{"k1:9092", "k2:9092"}, kafkaBus.brokers)
+	assert.Equal(t, "crm.events", kafkaBus.topic)
+
+	_, err = New(config.EventBus{Bus: BusNATS})
+	assert.ErrorContains(t, err, "NATS_URL")
+
+	_, err = New(config.EventBus{Bus: "rabbitmq"})
+	assert.ErrorContains(t, err, "unsupported")
+}
+
+func TestGroup(t *testing.T) {
+	assert.Equal(t, "nexuscrm", Group(config.EventBus{}))
+	assert.Equal(t, "crm-prod", Group(config.EventBus{Group: "crm-prod"}))
+}
+
+func TestRedeliveryDelay(t *testing.T) {
+	assert.Equal(t, time.Second, redeliveryDelay(0))
+	assert.Equal(t, time.Second, redeliveryDelay(1))
+	assert.Equal(t, 4*time.Second, redeliveryDelay(3))
+	assert.Equal(t, 30*time.Second, redeliveryDelay(10))
+}
+
+func TestDurableName(t *testing.T) {
+	assert.Equal(t, "crm_prod_workers", durableName("crm.prod workers"))
+	assert.Equal(t, "a_b_", durableName("a*b>"))
+}
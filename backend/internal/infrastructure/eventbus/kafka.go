@@ -0,0 +1,114 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaBus publishes events to a Kafka topic and consumes them through consumer groups.
+// Messages are keyed so that the events of one record land on one partition and keep
+// their order. Offsets are committed only after a message is handled.
+type KafkaBus struct {
+	brokers []string
+	topic   string
+	writer  *kafka.Writer
+}
+
+var _ ports.EventBusPort = (*KafkaBus)(nil)
+
+// NewKafkaBus creates a new KafkaBus
+func NewKafkaBus(brokers []string, topic string) *KafkaBus {
+	return &KafkaBus{
+		brokers: brokers,
+		topic:   topic,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Publish writes the message and waits for all in-sync replicas to acknowledge it
+func (b *KafkaBus) Publish(ctx context.Context, msg ports.EventMessage) error {
+	err := b.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: headerEventID, Value: []byte(msg.ID)},
+			{Key: headerEventType, Value: []byte(msg.Type)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("kafka publish to %s failed: %w", b.topic, err)
+	}
+	return nil
+}
+
+// Consume reads the topic as a member of the consumer group. A failing message is retried
+// in place, holding back its partition, and skipped after MaxDeliveries attempts.
+func (b *KafkaBus) Consume(ctx context.Context, group string, handle ports.EventMessageHandler) error {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: b.brokers,
+		GroupID: group,
+		Topic:   b.topic,
+	})
+	defer reader.Close()
+
+	for {
+		m, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return fmt.Errorf("kafka fetch from %s failed: %w", b.topic, err)
+		}
+
+		msg := ports.EventMessage{Key: string(m.Key), Payload: m.Value}
+		for _, h := range m.Headers {
+			switch h.Key {
+			case headerEventID:
+				msg.ID = string(h.Value)
+			case headerEventType:
+				msg.Type = events.EventType(h.Value)
+			}
+		}
+
+		for attempt := 1; ; attempt++ {
+			err := handle(ctx, msg)
+			if err == nil {
+				break
+			}
+			if attempt >= MaxDeliveries {
+				log.Printf("❌ [EventBus] Giving up on event %s (%s) after %d attempts: %v", msg.ID, msg.Type, attempt, err)
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return nil // Not committed, so the group redelivers it
+			case <-time.After(redeliveryDelay(attempt)):
+			}
+		}
+
+		if err := reader.CommitMessages(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("kafka commit on %s failed: %w", b.topic, err)
+		}
+	}
+}
+
+// Close flushes and closes the producer
+func (b *KafkaBus) Close() error {
+	return b.writer.Close()
+}
@@ -0,0 +1,132 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nexuscrm/backend/internal/domain/events"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// natsStreamMaxAge bounds how long events stay in the stream once published
+const natsStreamMaxAge = 7 * 24 * time.Hour
+
+// NATSBus publishes events to a JetStream stream, one subject per event type, and consumes
+// them through durable consumers. Publishing uses the event ID as the message ID, so an
+// event re-published by the outbox within the duplicate window is stored once.
+type NATSBus struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	stream  string
+	subject string // Subject prefix
+}
+
+var _ ports.EventBusPort = (*NATSBus)(nil)
+
+// NewNATSBus connects to NATS and creates or updates the event stream
+func NewNATSBus(url, stream, subject string) (*NATSBus, error) {
+	conn, err := nats.Connect(url, nats.Name("nexuscrm"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       stream,
+		Subjects:   []string{subject + ".>"},
+		Storage:    jetstream.FileStorage,
+		MaxAge:     natsStreamMaxAge,
+		Duplicates: 2 * time.Minute,
+	}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set up stream %s: %w", stream, err)
+	}
+
+	return &NATSBus{conn: conn, js: js, stream: stream, subject: subject}, nil
+}
+
+// Publish stores the message in the stream and waits for the server's acknowledgement
+func (b *NATSBus) Publish(ctx context.Context, msg ports.EventMessage) error {
+	m := nats.NewMsg(b.subject + "." + string(msg.Type))
+	m.Data = msg.Payload
+	m.Header.Set(headerEventID, msg.ID)
+	m.Header.Set(headerEventType, string(msg.Type))
+	if msg.Key != "" {
+		m.Header.Set(headerEventKey, msg.Key)
+	}
+
+	opts := []jetstream.PublishOpt{}
+	if msg.ID != "" {
+		opts = append(opts, jetstream.WithMsgID(msg.ID))
+	}
+	if _, err := b.js.PublishMsg(ctx, m, opts...); err != nil {
+		return fmt.Errorf("NATS publish to %s failed: %w", m.Subject, err)
+	}
+	return nil
+}
+
+// Consume pulls the stream through the group's durable consumer. A failing message is
+// negatively acknowledged with a growing delay and dropped after MaxDeliveries attempts.
+func (b *NATSBus) Consume(ctx context.Context, group string, handle ports.EventMessageHandler) error {
+	consumer, err := b.js.CreateOrUpdateConsumer(ctx, b.stream, jetstream.ConsumerConfig{
+		Durable:       durableName(group),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       time.Minute,
+		MaxDeliver:    MaxDeliveries,
+		FilterSubject: b.subject + ".>",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up consumer %s: %w", group, err)
+	}
+
+	consumeCtx, err := consumer.Consume(func(m jetstream.Msg) {
+		msg := ports.EventMessage{
+			ID:      m.Headers().Get(headerEventID),
+			Type:    events.EventType(m.Headers().Get(headerEventType)),
+			Key:     m.Headers().Get(headerEventKey),
+			Payload: m.Data(),
+		}
+		if err := handle(ctx, msg); err != nil {
+			attempt := 1
+			if meta, metaErr := m.Metadata(); metaErr == nil {
+				attempt = int(meta.NumDelivered)
+			}
+			if attempt >= MaxDeliveries {
+				log.Printf("❌ [EventBus] Giving up on event %s (%s) after %d attempts: %v", msg.ID, msg.Type, attempt, err)
+				_ = m.Term()
+				return
+			}
+			_ = m.NakWithDelay(redeliveryDelay(attempt))
+			return
+		}
+		_ = m.Ack()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to consume %s: %w", b.stream, err)
+	}
+
+	<-ctx.Done()
+	consumeCtx.Stop()
+	return nil
+}
+
+// Close drains the connection
+func (b *NATSBus) Close() error {
+	return b.conn.Drain()
+}
+
+// durableName turns a group name into a valid durable consumer name
+func durableName(group string) string {
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_").Replace(group)
+}
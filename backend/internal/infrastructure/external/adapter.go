@@ -0,0 +1,105 @@
+// Package external fetches the records of external objects from the systems that own them.
+// Adapters speak in remote field names; mapping to local fields, security and any filtering
+// an adapter could not push down are handled by the caller.
+package external
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// Criterion is a comparison on a remote field. Op is one of =, !=, <, >, <=, >=, LIKE and IN.
+type Criterion struct {
+	Field string
+	Op    string
+	Val   interface{}
+}
+
+// Query describes the rows to fetch from an external source
+type Query struct {
+	Fields    []string // Remote fields to return
+	Criteria  []Criterion
+	SortField string // Remote field; empty leaves the source's order
+	SortDesc  bool
+	Limit     int // 0 fetches up to the source's max rows
+}
+
+// Adapter reads rows from one kind of external source. Fetch must return every row matching
+// the query, up to Limit; an adapter that cannot apply a criterion returns a superset and
+// ignores Limit, and the caller filters again.
+type Adapter interface {
+	Fetch(ctx context.Context, src *models.ExternalDataSource, q Query) ([]map[string]interface{}, error)
+}
+
+// Caller sends GET requests through a named credential and returns the decoded JSON body
+type Caller interface {
+	GetJSON(ctx context.Context, namedCredential, path string) (interface{}, error)
+}
+
+// Registry holds one adapter per adapter type
+type Registry struct {
+	adapters map[constants.ExternalAdapterType]Adapter
+}
+
+// NewRegistry creates a registry with the REST, OData and SQL adapters
+func NewRegistry(caller Caller) *Registry {
+	return &Registry{adapters: map[constants.ExternalAdapterType]Adapter{
+		constants.ExternalAdapterREST:  NewRESTAdapter(caller),
+		constants.ExternalAdapterOData: NewODataAdapter(caller),
+		constants.ExternalAdapterSQL:   NewSQLAdapter(),
+	}}
+}
+
+// Register adds or replaces the adapter of a type
+func (r *Registry) Register(adapterType constants.ExternalAdapterType, adapter Adapter) {
+	r.adapters[adapterType] = adapter
+}
+
+// Get returns the adapter of a type
+func (r *Registry) Get(adapterType constants.ExternalAdapterType) (Adapter, error) {
+	adapter, ok := r.adapters[adapterType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported external adapter: %s", adapterType)
+	}
+	return adapter, nil
+}
+
+// effectiveLimit caps a query limit at the source's max rows
+func effectiveLimit(src *models.ExternalDataSource, limit int) int {
+	if limit <= 0 || (src.MaxRows > 0 && limit > src.MaxRows) {
+		return src.MaxRows
+	}
+	return limit
+}
+
+// rowsFromJSON extracts the record array at a dotted path of a decoded JSON body.
+// A body that is itself an array is used as is.
+func rowsFromJSON(body interface{}, path string) ([]map[string]interface{}, error) {
+	current := body
+	if path != "" {
+		for _, key := range strings.Split(path, ".") {
+			node, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("records path %q not found in response", path)
+			}
+			if current, ok = node[key]; !ok {
+				return nil, fmt.Errorf("records path %q not found in response", path)
+			}
+		}
+	}
+	items, ok := current.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("records path %q is not an array", path)
+	}
+	rows := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if row, ok := item.(map[string]interface{}); ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,104 @@
+package external
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCaller struct {
+	path string
+	body interface{}
+}
+
+func (f *fakeCaller) GetJSON(_ context.Context, _ string, path string) (interface{}, error) {
+	f.path = path
+	return f.body, nil
+}
+
+func TestBuildODataPath(t *testing.T) {
+	src := &models.ExternalDataSource{Resource: "Invoices", MaxRows: 500}
+
+	path := BuildODataPath(src, Query{
+		Fields: []string{"InvoiceID", "Customer"},
+		Criteria: []Criterion{
+			{Field: "Customer", Op: "=", Val: "O'Neil"},
+			{Field: "Amount", Op: ">=", Val: 100.5},
+			{Field: "Status", Op: "IN", Val: []interface{}{"Open", "Overdue"}},
+			{Field: "Customer", Op: "LIKE", Val: "Acme%"},
+		},
+		SortField: "Amount",
+		SortDesc:  true,
+		Limit:     20,
+	})
+
+	u, err := url.Parse(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Invoices", u.Path)
+	q := u.Query()
+	assert.Equal(t, "Customer eq 'O''Neil' and Amount ge 100.5 and (Status eq 'Open' or Status eq 'Overdue') and startswith(Customer,'Acme')", q.Get("$filter"))
+	assert.Equal(t, "InvoiceID,Customer", q.Get("$select"))
+	assert.Equal(t, "Amount desc", q.Get("$orderby"))
+	assert.Equal(t, "20", q.Get("$top"))
+	assert.NotContains(t, path, "+", "spaces must be encoded as %20")
+}
+
+func TestBuildODataPath_UntranslatableCriterionDropsLimit(t *testing.T) {
+	src := &models.ExternalDataSource{Resource: "Invoices", MaxRows: 500}
+
+	path := BuildODataPath(src, Query{
+		Criteria: []Criterion{{Field: "Customer", Op: "LIKE", Val: "A%c_e"}},
+		Limit:    20,
+	})
+
+	u, err := url.Parse(path)
+	require.NoError(t, err)
+	assert.Empty(t, u.Query().Get("$filter"))
+	assert.Equal(t, "500", u.Query().Get("$top"), "the caller filters, so fetch up to max rows")
+}
+
+func TestBuildSQLQuery(t *testing.T) {
+	src := &models.ExternalDataSource{Resource: "erp_invoices", MaxRows: 100}
+
+	q, err := BuildSQLQuery(src, Query{
+		Fields:    []string{"inv_no", "total"},
+		Criteria:  []Criterion{{Field: "status", Op: "in", Val: []interface{}{"open", "late"}}, {Field: "total", Op: ">", Val: 10}},
+		SortField: "total",
+		Limit:     500,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT `erp_invoices`.`inv_no`, `erp_invoices`.`total` FROM `erp_invoices` "+
+		"WHERE `erp_invoices`.`status` IN (?, ?) AND `erp_invoices`.`total` > ? ORDER BY `erp_invoices`.`total` ASC LIMIT 100", q.SQL)
+	assert.Equal(t, []interface{}{"open", "late", 10}, q.Params)
+
+	_, err = BuildSQLQuery(src, Query{Fields: []string{"total; DROP TABLE x"}})
+	assert.Error(t, err)
+	_, err = BuildSQLQuery(&models.ExternalDataSource{Resource: "db.invoices"}, Query{})
+	assert.Error(t, err)
+}
+
+func TestRESTAdapter_RecordsPath(t *testing.T) {
+	caller := &fakeCaller{body: map[string]interface{}{
+		"data": map[string]interface{}{"items": []interface{}{
+			map[string]interface{}{"id": "1"}, map[string]interface{}{"id": "2"}, map[string]interface{}{"id": "3"},
+		}},
+	}}
+	credential := "Erp"
+	recordsPath := "data.items"
+	src := &models.ExternalDataSource{Adapter: constants.ExternalAdapterREST, NamedCredential: &credential, Resource: "/invoices", RecordsPath: &recordsPath, MaxRows: 2}
+
+	rows, err := NewRESTAdapter(caller).Fetch(context.Background(), src, Query{})
+	require.NoError(t, err)
+	assert.Equal(t, "/invoices", caller.path)
+	assert.Len(t, rows, 2, "truncated to max rows")
+
+	badPath := "data.missing"
+	src.RecordsPath = &badPath
+	_, err = NewRESTAdapter(caller).Fetch(context.Background(), src, Query{})
+	assert.Error(t, err)
+}
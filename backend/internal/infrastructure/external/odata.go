@@ -0,0 +1,159 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// odataOperators maps criterion operators to OData comparison operators
+var odataOperators = map[string]string{
+	"=": "eq", "!=": "ne", "<": "lt", ">": "gt", "<=": "le", ">=": "ge",
+}
+
+// ODataAdapter reads an OData v4 entity set, pushing criteria, sort, field selection and
+// limit down as $filter, $orderby, $select and $top
+type ODataAdapter struct {
+	caller Caller
+}
+
+// NewODataAdapter creates a new ODataAdapter
+func NewODataAdapter(caller Caller) *ODataAdapter {
+	return &ODataAdapter{caller: caller}
+}
+
+// Fetch returns the entities matching the query
+func (a *ODataAdapter) Fetch(ctx context.Context, src *models.ExternalDataSource, q Query) ([]map[string]interface{}, error) {
+	if src.NamedCredential == nil {
+		return nil, fmt.Errorf("external object %s has no named credential", src.ObjectAPIName)
+	}
+	body, err := a.caller.GetJSON(ctx, *src.NamedCredential, BuildODataPath(src, q))
+	if err != nil {
+		return nil, err
+	}
+	return rowsFromJSON(body, "value")
+}
+
+// BuildODataPath builds the entity set path with its query options. Criteria that OData cannot
+// express are left out, and then $top is capped at max rows instead of the query limit so that
+// the caller's own filtering still sees every candidate.
+func BuildODataPath(src *models.ExternalDataSource, q Query) string {
+	params := url.Values{}
+
+	filters := make([]string, 0, len(q.Criteria))
+	complete := true
+	for _, c := range q.Criteria {
+		if f, ok := odataFilter(c); ok {
+			filters = append(filters, f)
+		} else {
+			complete = false
+		}
+	}
+	if len(filters) > 0 {
+		params.Set("$filter", strings.Join(filters, " and "))
+	}
+	if len(q.Fields) > 0 {
+		params.Set("$select", strings.Join(q.Fields, ","))
+	}
+	if q.SortField != "" {
+		order := q.SortField
+		if q.SortDesc {
+			order += " desc"
+		}
+		params.Set("$orderby", order)
+	}
+
+	limit := q.Limit
+	if !complete {
+		limit = 0
+	}
+	if top := effectiveLimit(src, limit); top > 0 {
+		params.Set("$top", strconv.Itoa(top))
+	}
+
+	if len(params) == 0 {
+		return src.Resource
+	}
+	sep := "?"
+	if strings.Contains(src.Resource, "?") {
+		sep = "&"
+	}
+	// OData expects spaces as %20 rather than +
+	return src.Resource + sep + strings.ReplaceAll(params.Encode(), "+", "%20")
+}
+
+// odataFilter translates a criterion into an OData filter expression
+func odataFilter(c Criterion) (string, bool) {
+	op := strings.ToUpper(c.Op)
+	switch op {
+	case "IN":
+		values, ok := c.Val.([]interface{})
+		if !ok || len(values) == 0 {
+			return "", false
+		}
+		parts := make([]string, 0, len(values))
+		for _, v := range values {
+			literal, ok := odataLiteral(v)
+			if !ok {
+				return "", false
+			}
+			parts = append(parts, fmt.Sprintf("%s eq %s", c.Field, literal))
+		}
+		return "(" + strings.Join(parts, " or ") + ")", true
+	case "LIKE":
+		pattern, ok := c.Val.(string)
+		if !ok {
+			return "", false
+		}
+		inner := strings.Trim(pattern, "%")
+		if inner == "" || strings.ContainsAny(inner, "%_") {
+			return "", false
+		}
+		literal, _ := odataLiteral(inner)
+		switch {
+		case strings.HasPrefix(pattern, "%") && strings.HasSuffix(pattern, "%"):
+			return fmt.Sprintf("contains(%s,%s)", c.Field, literal), true
+		case strings.HasSuffix(pattern, "%"):
+			return fmt.Sprintf("startswith(%s,%s)", c.Field, literal), true
+		case strings.HasPrefix(pattern, "%"):
+			return fmt.Sprintf("endswith(%s,%s)", c.Field, literal), true
+		default:
+			return fmt.Sprintf("%s eq %s", c.Field, literal), true
+		}
+	}
+	odataOp, ok := odataOperators[op]
+	if !ok {
+		return "", false
+	}
+	literal, ok := odataLiteral(c.Val)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%s %s %s", c.Field, odataOp, literal), true
+}
+
+// odataLiteral formats a value as an OData literal
+func odataLiteral(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "null", true
+	case string:
+		return "'" + strings.ReplaceAll(val, "'", "''") + "'", true
+	case bool:
+		return strconv.FormatBool(val), true
+	case int:
+		return strconv.Itoa(val), true
+	case int64:
+		return strconv.FormatInt(val, 10), true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
+	case time.Time:
+		return val.UTC().Format(time.RFC3339), true
+	}
+	return "", false
+}
@@ -0,0 +1,42 @@
+package external
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// RESTAdapter reads a JSON list endpoint. REST APIs have no common filter syntax, so nothing is
+// pushed down: the resource is fetched as is and truncated to the source's max rows.
+type RESTAdapter struct {
+	caller Caller
+}
+
+// NewRESTAdapter creates a new RESTAdapter
+func NewRESTAdapter(caller Caller) *RESTAdapter {
+	return &RESTAdapter{caller: caller}
+}
+
+// Fetch returns the records of the REST resource
+func (a *RESTAdapter) Fetch(ctx context.Context, src *models.ExternalDataSource, q Query) ([]map[string]interface{}, error) {
+	if src.NamedCredential == nil {
+		return nil, fmt.Errorf("external object %s has no named credential", src.ObjectAPIName)
+	}
+	body, err := a.caller.GetJSON(ctx, *src.NamedCredential, src.Resource)
+	if err != nil {
+		return nil, err
+	}
+	recordsPath := ""
+	if src.RecordsPath != nil {
+		recordsPath = *src.RecordsPath
+	}
+	rows, err := rowsFromJSON(body, recordsPath)
+	if err != nil {
+		return nil, err
+	}
+	if src.MaxRows > 0 && len(rows) > src.MaxRows {
+		rows = rows[:src.MaxRows]
+	}
+	return rows, nil
+}
@@ -0,0 +1,138 @@
+package external
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+var sqlOperators = map[string]bool{
+	"=": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true, "LIKE": true, "IN": true,
+}
+
+// SQLAdapter reads a table of a MySQL-compatible database, pushing the whole query down.
+// Connection pools are kept per DSN and shared by every external object using it.
+type SQLAdapter struct {
+	mu    sync.Mutex
+	pools map[string]*sql.DB
+}
+
+// NewSQLAdapter creates a new SQLAdapter
+func NewSQLAdapter() *SQLAdapter {
+	return &SQLAdapter{pools: make(map[string]*sql.DB)}
+}
+
+// Fetch returns the rows matching the query. The source's DSN must already be decrypted.
+func (a *SQLAdapter) Fetch(ctx context.Context, src *models.ExternalDataSource, q Query) ([]map[string]interface{}, error) {
+	built, err := BuildSQLQuery(src, q)
+	if err != nil {
+		return nil, err
+	}
+	db, err := a.pool(src.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, built.SQL, built.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("external query on %s failed: %w", src.Resource, err)
+	}
+	defer rows.Close()
+
+	records, err := query.ScanRowsToSObjects(rows)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]map[string]interface{}, len(records))
+	for i, r := range records {
+		result[i] = r
+	}
+	return result, rows.Err()
+}
+
+// BuildSQLQuery builds the SELECT statement for a query on the source's table
+func BuildSQLQuery(src *models.ExternalDataSource, q Query) (query.QueryResult, error) {
+	if !query.IsValidIdentifier(src.Resource) {
+		return query.QueryResult{}, fmt.Errorf("invalid external table name: %s", src.Resource)
+	}
+	table := "`" + src.Resource + "`"
+
+	b := query.From(src.Resource)
+	for _, f := range q.Fields {
+		if !query.IsValidIdentifier(f) {
+			return query.QueryResult{}, fmt.Errorf("invalid external field name: %s", f)
+		}
+		b.AddSelectRaw(fmt.Sprintf("%s.`%s`", table, f))
+	}
+
+	for _, c := range q.Criteria {
+		op := strings.ToUpper(c.Op)
+		if !sqlOperators[op] {
+			return query.QueryResult{}, fmt.Errorf("invalid operator in criteria: %s", c.Op)
+		}
+		if !query.IsValidIdentifier(c.Field) {
+			return query.QueryResult{}, fmt.Errorf("invalid external field name: %s", c.Field)
+		}
+		if op == "IN" {
+			values, ok := c.Val.([]interface{})
+			if !ok || len(values) == 0 {
+				return query.QueryResult{}, fmt.Errorf("IN criteria on %s needs a non-empty list", c.Field)
+			}
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+			b.Where(fmt.Sprintf("%s.`%s` IN (%s)", table, c.Field, placeholders), values...)
+			continue
+		}
+		b.Where(fmt.Sprintf("%s.`%s` %s ?", table, c.Field, op), c.Val)
+	}
+
+	if q.SortField != "" {
+		if !query.IsValidIdentifier(q.SortField) {
+			return query.QueryResult{}, fmt.Errorf("invalid external field name: %s", q.SortField)
+		}
+		direction := constants.SortASC
+		if q.SortDesc {
+			direction = constants.SortDESC
+		}
+		b.OrderBy(fmt.Sprintf("%s.`%s`", table, q.SortField), direction)
+	}
+
+	if limit := effectiveLimit(src, q.Limit); limit > 0 {
+		b.Limit(limit)
+	}
+	return b.Build(), nil
+}
+
+// pool returns the connection pool of a DSN, opening it on first use
+func (a *SQLAdapter) pool(dsn string) (*sql.DB, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("external SQL source has no DSN")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if db, ok := a.pools[dsn]; ok {
+		return db, nil
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open external database: %w", err)
+	}
+	db.SetMaxOpenConns(5)
+	db.SetConnMaxIdleTime(5 * time.Minute)
+	a.pools[dsn] = db
+	return db, nil
+}
+
+// IsValidSQLIdentifier reports whether a name can be used as an external table or column;
+// the database is chosen by the DSN
+func IsValidSQLIdentifier(name string) bool {
+	return query.IsValidIdentifier(name)
+}
@@ -0,0 +1,49 @@
+// Package geocoding provides the Geocoder implementations that place postal addresses on
+// Geolocation fields.
+package geocoding
+
+import (
+	"fmt"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Supported GEOCODING_PROVIDER values
+const (
+	ProviderNone      = "none"
+	ProviderNominatim = "nominatim"
+	ProviderGoogle    = "google"
+
+	defaultNominatimURL = "https://nominatim.openstreetmap.org"
+	defaultGoogleURL    = "https://maps.googleapis.com"
+)
+
+// New builds the Geocoder selected by GEOCODING_PROVIDER.
+// Returns (nil, nil) when geocoding is disabled.
+//
+//	GEOCODING_PROVIDER=nominatim   OpenStreetMap Nominatim, or a self-hosted instance at GEOCODING_BASE_URL
+//	GEOCODING_PROVIDER=google      Google Geocoding API; requires GEOCODING_API_KEY
+func New(cfg config.Geocoding) (ports.Geocoder, error) {
+	baseURL := cfg.BaseURL
+
+	switch cfg.Provider {
+	case "", ProviderNone:
+		return nil, nil
+	case ProviderNominatim:
+		if baseURL == "" {
+			baseURL = defaultNominatimURL
+		}
+		return NewNominatimGeocoder(baseURL), nil
+	case ProviderGoogle:
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("GEOCODING_API_KEY is required for the google provider")
+		}
+		if baseURL == "" {
+			baseURL = defaultGoogleURL
+		}
+		return NewGoogleGeocoder(baseURL, cfg.APIKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported GEOCODING_PROVIDER %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,75 @@
+package geocoding
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	g, err := New(config.Geocoding{})
+	require.NoError(t, err)
+	assert.Nil(t, g)
+
+	g, err = New(config.Geocoding{Provider: ProviderNominatim})
+	require.NoError(t, err)
+	assert.Equal(t, ProviderNominatim, g.Name())
+
+	_, err = New(config.Geocoding{Provider: ProviderGoogle})
+	assert.Error(t, err, "google needs an API key")
+
+	_, err = New(config.Geocoding{Provider: "bing"})
+	assert.Error(t, err)
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, nominatimUserAgent, r.Header.Get("User-Agent"))
+		if r.URL.Query().Get("q") == "nowhere" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		assert.Equal(t, "10 Downing St, London", r.URL.Query().Get("q"))
+		_, _ = w.Write([]byte(`[{"lat":"51.5033635","lon":"-0.1276248"}]`))
+	}))
+	defer server.Close()
+
+	g := NewNominatimGeocoder(server.URL + "/")
+	point, err := g.Geocode(context.Background(), "10 Downing St, London")
+	require.NoError(t, err)
+	require.NotNil(t, point)
+	assert.InDelta(t, 51.5033635, point.Latitude, 1e-9)
+	assert.InDelta(t, -0.1276248, point.Longitude, 1e-9)
+
+	point, err = g.Geocode(context.Background(), "nowhere")
+	require.NoError(t, err)
+	assert.Nil(t, point)
+}
+
+func TestGoogleGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/maps/api/geocode/json", r.URL.Path)
+		switch r.URL.Query().Get("key") {
+		case "bad":
+			_, _ = w.Write([]byte(`{"status":"REQUEST_DENIED","error_message":"The provided API key is invalid."}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"OK","results":[{"geometry":{"location":{"lat":37.4224,"lng":-122.0842}}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	point, err := NewGoogleGeocoder(server.URL, "key").Geocode(context.Background(), "1600 Amphitheatre Pkwy")
+	require.NoError(t, err)
+	require.NotNil(t, point)
+	assert.Equal(t, 37.4224, point.Latitude)
+	assert.Equal(t, -122.0842, point.Longitude)
+
+	_, err = NewGoogleGeocoder(server.URL, "bad").Geocode(context.Background(), "1600 Amphitheatre Pkwy")
+	assert.ErrorContains(t, err, "REQUEST_DENIED")
+}
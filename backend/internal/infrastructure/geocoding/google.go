@@ -0,0 +1,88 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GoogleGeocoder calls the Google Maps Geocoding API
+type GoogleGeocoder struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// Ensure GoogleGeocoder implements ports.Geocoder at compile time
+var _ ports.Geocoder = (*GoogleGeocoder)(nil)
+
+// NewGoogleGeocoder creates a geocoder calling the Geocoding API at baseURL with apiKey
+func NewGoogleGeocoder(baseURL, apiKey string) *GoogleGeocoder {
+	return &GoogleGeocoder{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the provider identifier
+func (g *GoogleGeocoder) Name() string {
+	return ProviderGoogle
+}
+
+// Geocode returns the first result for address
+func (g *GoogleGeocoder) Geocode(ctx context.Context, address string) (*models.GeoPoint, error) {
+	query := url.Values{"address": {address}, "key": {g.apiKey}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/maps/api/geocode/json?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("google geocoding: failed to build request: %w", err)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("google geocoding: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("google geocoding: returned %d: %s", resp.StatusCode, string(msg))
+	}
+
+	var out struct {
+		Status       string `json:"status"`
+		ErrorMessage string `json:"error_message"`
+		Results      []struct {
+			Geometry struct {
+				Location struct {
+					Lat float64 `json:"lat"`
+					Lng float64 `json:"lng"`
+				} `json:"location"`
+			} `json:"geometry"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("google geocoding: failed to decode response: %w", err)
+	}
+
+	switch out.Status {
+	case "OK":
+	case "ZERO_RESULTS":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("google geocoding: %s %s", out.Status, out.ErrorMessage)
+	}
+	if len(out.Results) == 0 {
+		return nil, nil
+	}
+	location := out.Results[0].Geometry.Location
+	return &models.GeoPoint{Latitude: location.Lat, Longitude: location.Lng}, nil
+}
@@ -0,0 +1,84 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// nominatimUserAgent identifies the application, as the Nominatim usage policy requires
+const nominatimUserAgent = "NexusCRM"
+
+// NominatimGeocoder calls the search endpoint of an OpenStreetMap Nominatim server
+type NominatimGeocoder struct {
+	baseURL string
+	client  *http.Client
+}
+
+// Ensure NominatimGeocoder implements ports.Geocoder at compile time
+var _ ports.Geocoder = (*NominatimGeocoder)(nil)
+
+// NewNominatimGeocoder creates a geocoder querying the Nominatim server at baseURL
+func NewNominatimGeocoder(baseURL string) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the provider identifier
+func (g *NominatimGeocoder) Name() string {
+	return ProviderNominatim
+}
+
+// Geocode returns the first search result for address
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (*models.GeoPoint, error) {
+	query := url.Values{"q": {address}, "format": {"jsonv2"}, "limit": {"1"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", nominatimUserAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("nominatim: %s returned %d: %s", g.baseURL, resp.StatusCode, string(msg))
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("nominatim: failed to decode response: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: invalid latitude %q", results[0].Lat)
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: invalid longitude %q", results[0].Lon)
+	}
+	return &models.GeoPoint{Latitude: lat, Longitude: lng}, nil
+}
@@ -0,0 +1,245 @@
+package mailsync
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// googleMaxMessageIDs caps how many message IDs one listing pages through
+const googleMaxMessageIDs = 5000
+
+// GoogleProvider reads Gmail and Google Calendar through the Google REST APIs
+type GoogleProvider struct {
+	client      OAuthClient
+	authURL     string
+	tokenURL    string
+	userInfoURL string
+	gmailURL    string
+	calendarURL string
+}
+
+// NewGoogleProvider creates a Google provider for an OAuth client
+func NewGoogleProvider(client OAuthClient) *GoogleProvider {
+	return &GoogleProvider{
+		client:      client,
+		authURL:     "https://accounts.google.com/o/oauth2/v2/auth",
+		tokenURL:    "https://oauth2.googleapis.com/token",
+		userInfoURL: "https://openidconnect.googleapis.com/v1/userinfo",
+		gmailURL:    "https://gmail.googleapis.com/gmail/v1/users/me",
+		calendarURL: "https://www.googleapis.com/calendar/v3/calendars/primary",
+	}
+}
+
+func (p *GoogleProvider) Name() constants.SyncProvider { return constants.SyncProviderGoogle }
+
+// AuthCodeURL requests offline access so Google issues a refresh token
+func (p *GoogleProvider) AuthCodeURL(state string) string {
+	return p.client.authCodeURL(p.authURL,
+		"openid email https://www.googleapis.com/auth/gmail.readonly https://www.googleapis.com/auth/calendar.readonly",
+		state, url.Values{"access_type": {"offline"}, "prompt": {"consent"}})
+}
+
+func (p *GoogleProvider) Exchange(ctx context.Context, code string) (*Token, error) {
+	return p.client.exchange(ctx, p.tokenURL, code)
+}
+
+func (p *GoogleProvider) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return p.client.refresh(ctx, p.tokenURL, refreshToken)
+}
+
+func (p *GoogleProvider) AccountEmail(ctx context.Context, accessToken string) (string, error) {
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := p.client.getJSON(ctx, p.userInfoURL, accessToken, nil, &info); err != nil {
+		return "", err
+	}
+	return info.Email, nil
+}
+
+// ListMessages pages through the IDs of messages received after since (Gmail lists newest
+// first) and fetches the headers of the oldest limit of them
+func (p *GoogleProvider) ListMessages(ctx context.Context, accessToken string, since time.Time, limit int) ([]Message, error) {
+	var ids []string
+	pageToken := ""
+	for len(ids) < googleMaxMessageIDs {
+		v := url.Values{"q": {fmt.Sprintf("after:%d", since.Unix())}, "maxResults": {"500"}}
+		if pageToken != "" {
+			v.Set("pageToken", pageToken)
+		}
+		var page struct {
+			Messages []struct {
+				ID string `json:"id"`
+			} `json:"messages"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		if err := p.client.getJSON(ctx, p.gmailURL+"/messages?"+v.Encode(), accessToken, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, m := range page.Messages {
+			ids = append(ids, m.ID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	// Oldest first, so a truncated listing resumes where it stopped
+	for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
+		ids[i], ids[j] = ids[j], ids[i]
+	}
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	messages := make([]Message, 0, len(ids))
+	for _, id := range ids {
+		v := url.Values{"format": {"metadata"}, "metadataHeaders": {"From", "To", "Cc", "Subject"}}
+		var raw struct {
+			ID           string `json:"id"`
+			Snippet      string `json:"snippet"`
+			InternalDate string `json:"internalDate"`
+			Payload      struct {
+				Headers []struct {
+					Name  string `json:"name"`
+					Value string `json:"value"`
+				} `json:"headers"`
+			} `json:"payload"`
+		}
+		if err := p.client.getJSON(ctx, p.gmailURL+"/messages/"+url.PathEscape(id)+"?"+v.Encode(), accessToken, nil, &raw); err != nil {
+			return nil, err
+		}
+		msg := Message{ID: raw.ID, Snippet: raw.Snippet}
+		if ms, err := strconv.ParseInt(raw.InternalDate, 10, 64); err == nil {
+			msg.Date = time.UnixMilli(ms).UTC()
+		}
+		for _, h := range raw.Payload.Headers {
+			switch strings.ToLower(h.Name) {
+			case "subject":
+				msg.Subject = h.Value
+			case "from":
+				if from := parseAddresses(h.Value); len(from) > 0 {
+					msg.From = from[0]
+				}
+			case "to", "cc":
+				msg.To = append(msg.To, parseAddresses(h.Value)...)
+			}
+		}
+		messages = append(messages, msg)
+	}
+	sort.SliceStable(messages, func(i, j int) bool { return messages[i].Date.Before(messages[j].Date) })
+	return messages, nil
+}
+
+type googleEventTime struct {
+	DateTime string `json:"dateTime"`
+	Date     string `json:"date"`
+}
+
+func (t googleEventTime) time() time.Time {
+	if t.DateTime != "" {
+		parsed, _ := time.Parse(time.RFC3339, t.DateTime)
+		return parsed.UTC()
+	}
+	parsed, _ := time.Parse(time.DateOnly, t.Date)
+	return parsed
+}
+
+// ListEvents lists events of the primary calendar updated after since, expanding recurring
+// events into instances
+func (p *GoogleProvider) ListEvents(ctx context.Context, accessToken string, since time.Time, limit int) ([]Event, error) {
+	var events []Event
+	pageToken := ""
+	for limit <= 0 || len(events) < limit {
+		v := url.Values{
+			"updatedMin":   {since.UTC().Format(time.RFC3339)},
+			"singleEvents": {"true"},
+			"showDeleted":  {"true"},
+			"maxResults":   {"250"},
+		}
+		if pageToken != "" {
+			v.Set("pageToken", pageToken)
+		}
+		var page struct {
+			Items []struct {
+				ID          string `json:"id"`
+				Status      string `json:"status"`
+				Summary     string `json:"summary"`
+				Description string `json:"description"`
+				Updated     string `json:"updated"`
+				Organizer   struct {
+					Email string `json:"email"`
+				} `json:"organizer"`
+				Attendees []struct {
+					Email string `json:"email"`
+				} `json:"attendees"`
+				Start googleEventTime `json:"start"`
+				End   googleEventTime `json:"end"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		if err := p.client.getJSON(ctx, p.calendarURL+"/events?"+v.Encode(), accessToken, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			e := Event{
+				ID:          item.ID,
+				Subject:     item.Summary,
+				Description: item.Description,
+				Organizer:   strings.ToLower(item.Organizer.Email),
+				Start:       item.Start.time(),
+				End:         item.End.time(),
+				Cancelled:   item.Status == "cancelled",
+			}
+			e.Modified, _ = time.Parse(time.RFC3339, item.Updated)
+			for _, a := range item.Attendees {
+				if a.Email != "" {
+					e.Attendees = append(e.Attendees, strings.ToLower(a.Email))
+				}
+			}
+			events = append(events, e)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Modified.Before(events[j].Modified) })
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// parseAddresses extracts the lower-cased addresses of an address-list header
+func parseAddresses(header string) []string {
+	list, err := mail.ParseAddressList(header)
+	if err != nil {
+		// Fall back to a plain comma split for headers net/mail rejects
+		var out []string
+		for _, part := range strings.Split(header, ",") {
+			part = strings.TrimSpace(part)
+			if start, end := strings.LastIndex(part, "<"), strings.LastIndex(part, ">"); start >= 0 && end > start {
+				part = part[start+1 : end]
+			}
+			if strings.Contains(part, "@") {
+				out = append(out, strings.ToLower(part))
+			}
+		}
+		return out
+	}
+	out := make([]string, 0, len(list))
+	for _, a := range list {
+		out = append(out, strings.ToLower(a.Address))
+	}
+	return out
+}
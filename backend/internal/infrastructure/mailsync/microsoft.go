@@ -0,0 +1,180 @@
+package mailsync
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// graphDateTimeLayout is the layout of Graph dateTimeTimeZone values
+const graphDateTimeLayout = "2006-01-02T15:04:05.9999999"
+
+// MicrosoftProvider reads Outlook mail and calendars of Microsoft 365 accounts through
+// Microsoft Graph
+type MicrosoftProvider struct {
+	client   OAuthClient
+	authURL  string
+	tokenURL string
+	graphURL string
+}
+
+// NewMicrosoftProvider creates a Microsoft 365 provider for an OAuth client registered in a
+// tenant ("common" accepts any work or school account)
+func NewMicrosoftProvider(client OAuthClient, tenant string) *MicrosoftProvider {
+	base := "https://login.microsoftonline.com/" + url.PathEscape(tenant) + "/oauth2/v2.0"
+	return &MicrosoftProvider{
+		client:   client,
+		authURL:  base + "/authorize",
+		tokenURL: base + "/token",
+		graphURL: "https://graph.microsoft.com/v1.0",
+	}
+}
+
+func (p *MicrosoftProvider) Name() constants.SyncProvider { return constants.SyncProviderMicrosoft }
+
+// AuthCodeURL requests offline_access so Microsoft issues a refresh token
+func (p *MicrosoftProvider) AuthCodeURL(state string) string {
+	return p.client.authCodeURL(p.authURL, "offline_access User.Read Mail.Read Calendars.Read", state, nil)
+}
+
+func (p *MicrosoftProvider) Exchange(ctx context.Context, code string) (*Token, error) {
+	return p.client.exchange(ctx, p.tokenURL, code)
+}
+
+func (p *MicrosoftProvider) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return p.client.refresh(ctx, p.tokenURL, refreshToken)
+}
+
+func (p *MicrosoftProvider) AccountEmail(ctx context.Context, accessToken string) (string, error) {
+	var me struct {
+		Mail              string `json:"mail"`
+		UserPrincipalName string `json:"userPrincipalName"`
+	}
+	if err := p.client.getJSON(ctx, p.graphURL+"/me", accessToken, nil, &me); err != nil {
+		return "", err
+	}
+	if me.Mail != "" {
+		return me.Mail, nil
+	}
+	return me.UserPrincipalName, nil
+}
+
+type graphRecipient struct {
+	EmailAddress struct {
+		Address string `json:"address"`
+	} `json:"emailAddress"`
+}
+
+func (r graphRecipient) address() string {
+	return strings.ToLower(r.EmailAddress.Address)
+}
+
+type graphDateTime struct {
+	DateTime string `json:"dateTime"`
+}
+
+// time parses a dateTimeTimeZone; listings ask Graph for UTC
+func (t graphDateTime) time() time.Time {
+	parsed, _ := time.Parse(graphDateTimeLayout, t.DateTime)
+	return parsed
+}
+
+func (p *MicrosoftProvider) ListMessages(ctx context.Context, accessToken string, since time.Time, limit int) ([]Message, error) {
+	v := url.Values{
+		"$filter":  {"receivedDateTime ge " + since.UTC().Format(time.RFC3339)},
+		"$orderby": {"receivedDateTime asc"},
+		"$select":  {"id,subject,bodyPreview,from,toRecipients,ccRecipients,receivedDateTime"},
+		"$top":     {"100"},
+	}
+	var messages []Message
+	next := p.graphURL + "/me/messages?" + v.Encode()
+	for next != "" && (limit <= 0 || len(messages) < limit) {
+		var page struct {
+			Value []struct {
+				ID               string           `json:"id"`
+				Subject          string           `json:"subject"`
+				BodyPreview      string           `json:"bodyPreview"`
+				ReceivedDateTime time.Time        `json:"receivedDateTime"`
+				From             graphRecipient   `json:"from"`
+				ToRecipients     []graphRecipient `json:"toRecipients"`
+				CcRecipients     []graphRecipient `json:"ccRecipients"`
+			} `json:"value"`
+			NextLink string `json:"@odata.nextLink"`
+		}
+		if err := p.client.getJSON(ctx, next, accessToken, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, m := range page.Value {
+			msg := Message{ID: m.ID, Subject: m.Subject, Snippet: m.BodyPreview, From: m.From.address(), Date: m.ReceivedDateTime.UTC()}
+			for _, r := range append(m.ToRecipients, m.CcRecipients...) {
+				if addr := r.address(); addr != "" {
+					msg.To = append(msg.To, addr)
+				}
+			}
+			messages = append(messages, msg)
+		}
+		next = page.NextLink
+	}
+	if limit > 0 && len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
+
+func (p *MicrosoftProvider) ListEvents(ctx context.Context, accessToken string, since time.Time, limit int) ([]Event, error) {
+	v := url.Values{
+		"$filter":  {"lastModifiedDateTime ge " + since.UTC().Format(time.RFC3339)},
+		"$orderby": {"lastModifiedDateTime asc"},
+		"$select":  {"id,subject,bodyPreview,organizer,attendees,start,end,isCancelled,lastModifiedDateTime"},
+		"$top":     {"100"},
+	}
+	header := http.Header{"Prefer": {`outlook.timezone="UTC"`}}
+	var events []Event
+	next := p.graphURL + "/me/events?" + v.Encode()
+	for next != "" && (limit <= 0 || len(events) < limit) {
+		var page struct {
+			Value []struct {
+				ID                   string           `json:"id"`
+				Subject              string           `json:"subject"`
+				BodyPreview          string           `json:"bodyPreview"`
+				IsCancelled          bool             `json:"isCancelled"`
+				LastModifiedDateTime time.Time        `json:"lastModifiedDateTime"`
+				Organizer            graphRecipient   `json:"organizer"`
+				Attendees            []graphRecipient `json:"attendees"`
+				Start                graphDateTime    `json:"start"`
+				End                  graphDateTime    `json:"end"`
+			} `json:"value"`
+			NextLink string `json:"@odata.nextLink"`
+		}
+		if err := p.client.getJSON(ctx, next, accessToken, header, &page); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Value {
+			e := Event{
+				ID:          item.ID,
+				Subject:     item.Subject,
+				Description: item.BodyPreview,
+				Organizer:   item.Organizer.address(),
+				Start:       item.Start.time(),
+				End:         item.End.time(),
+				Modified:    item.LastModifiedDateTime.UTC(),
+				Cancelled:   item.IsCancelled,
+			}
+			for _, a := range item.Attendees {
+				if addr := a.address(); addr != "" {
+					e.Attendees = append(e.Attendees, addr)
+				}
+			}
+			events = append(events, e)
+		}
+		next = page.NextLink
+	}
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
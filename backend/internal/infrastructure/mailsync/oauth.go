@@ -0,0 +1,129 @@
+package mailsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OAuthClient is a registered OAuth client of a provider
+type OAuthClient struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	HTTP         *http.Client
+}
+
+// authCodeURL builds the authorization endpoint URL the user is sent to
+func (c OAuthClient) authCodeURL(endpoint, scope, state string, extra url.Values) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURL},
+		"scope":         {scope},
+		"state":         {state},
+	}
+	for k, vals := range extra {
+		v[k] = vals
+	}
+	return endpoint + "?" + v.Encode()
+}
+
+// exchange redeems an authorization code at the token endpoint
+func (c OAuthClient) exchange(ctx context.Context, endpoint, code string) (*Token, error) {
+	return c.token(ctx, endpoint, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.RedirectURL},
+	})
+}
+
+// refresh obtains a new access token with a refresh token
+func (c OAuthClient) refresh(ctx context.Context, endpoint, refreshToken string) (*Token, error) {
+	return c.token(ctx, endpoint, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+func (c OAuthClient) token(ctx context.Context, endpoint string, form url.Values) (*Token, error) {
+	form.Set("client_id", c.ClientID)
+	form.Set("client_secret", c.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		RefreshToken     string `json:"refresh_token"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := c.do(req, &body)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || body.AccessToken == "" {
+		if body.Error != "" {
+			return nil, fmt.Errorf("token request failed: %s %s", body.Error, body.ErrorDescription)
+		}
+		return nil, fmt.Errorf("token request failed with status %d", status)
+	}
+	tok := &Token{AccessToken: body.AccessToken, RefreshToken: body.RefreshToken}
+	if body.ExpiresIn > 0 {
+		tok.ExpiresAt = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	return tok, nil
+}
+
+// getJSON sends an authenticated GET and decodes the JSON response
+func (c OAuthClient) getJSON(ctx context.Context, rawURL, accessToken string, header http.Header, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	for k, vals := range header {
+		req.Header[k] = vals
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	status, err := c.do(req, out)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("GET %s failed with status %d", req.URL.Path, status)
+	}
+	return nil
+}
+
+func (c OAuthClient) do(req *http.Request, out interface{}) (int, error) {
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil && resp.StatusCode == http.StatusOK {
+			return resp.StatusCode, fmt.Errorf("invalid response from %s: %w", req.URL.Host, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
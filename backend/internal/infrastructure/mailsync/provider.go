@@ -0,0 +1,116 @@
+// Package mailsync reads mail and calendar items from the services users connect their
+// mailboxes from. Providers handle the OAuth authorization-code flow and return items in
+// a provider-neutral shape; matching them to records and storing activities is up to the
+// caller.
+package mailsync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// Token is an OAuth token set. RefreshToken is empty when the provider did not issue a new one.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // Zero when the provider did not say
+}
+
+// Message is an email in the connected mailbox
+type Message struct {
+	ID      string
+	Subject string
+	Snippet string
+	From    string   // Sender address
+	To      []string // To and Cc addresses
+	Date    time.Time
+}
+
+// Event is a calendar event in the connected calendar
+type Event struct {
+	ID          string
+	Subject     string
+	Description string
+	Organizer   string   // Organizer address
+	Attendees   []string // Attendee addresses
+	Start       time.Time
+	End         time.Time
+	Modified    time.Time // When the event last changed; listing is by this time
+	Cancelled   bool
+}
+
+// Provider is a mail and calendar service. List calls return items received or changed at
+// or after since, oldest first, up to limit.
+type Provider interface {
+	Name() constants.SyncProvider
+	AuthCodeURL(state string) string
+	Exchange(ctx context.Context, code string) (*Token, error)
+	Refresh(ctx context.Context, refreshToken string) (*Token, error)
+	AccountEmail(ctx context.Context, accessToken string) (string, error)
+	ListMessages(ctx context.Context, accessToken string, since time.Time, limit int) ([]Message, error)
+	ListEvents(ctx context.Context, accessToken string, since time.Time, limit int) ([]Event, error)
+}
+
+// Registry holds the configured providers
+type Registry struct {
+	providers map[constants.SyncProvider]Provider
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[constants.SyncProvider]Provider)}
+}
+
+// NewConfiguredRegistry registers each provider whose OAuth client is configured:
+// GOOGLE_OAUTH_CLIENT_ID/GOOGLE_OAUTH_CLIENT_SECRET and
+// MICROSOFT_OAUTH_CLIENT_ID/MICROSOFT_OAUTH_CLIENT_SECRET (MICROSOFT_OAUTH_TENANT defaults
+// to "common"). SYNC_OAUTH_REDIRECT_URL is the callback registered with both.
+func NewConfiguredRegistry(cfg config.MailSync) *Registry {
+	r := NewRegistry()
+	redirectURL := cfg.RedirectURL
+	if redirectURL == "" {
+		return r
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	if id, secret := cfg.GoogleClientID, cfg.GoogleClientSecret; id != "" && secret != "" {
+		r.Register(NewGoogleProvider(OAuthClient{ClientID: id, ClientSecret: secret, RedirectURL: redirectURL, HTTP: client}))
+	}
+	if id, secret := cfg.MicrosoftClientID, cfg.MicrosoftClientSecret; id != "" && secret != "" {
+		tenant := cfg.MicrosoftTenant
+		if tenant == "" {
+			tenant = "common"
+		}
+		r.Register(NewMicrosoftProvider(OAuthClient{ClientID: id, ClientSecret: secret, RedirectURL: redirectURL, HTTP: client}, tenant))
+	}
+	return r
+}
+
+// Register adds or replaces a provider
+func (r *Registry) Register(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+// Get returns a configured provider
+func (r *Registry) Get(name constants.SyncProvider) (Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("sync provider not configured: %s", name)
+	}
+	return p, nil
+}
+
+// Names lists the configured providers
+func (r *Registry) Names() []constants.SyncProvider {
+	names := make([]constants.SyncProvider, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
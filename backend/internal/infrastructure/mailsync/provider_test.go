@@ -0,0 +1,149 @@
+package mailsync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestGoogleProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		writeJSON(w, map[string]interface{}{"access_token": "at", "refresh_token": "rt", "expires_in": 3600})
+	})
+	mux.HandleFunc("/gmail/messages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer at", r.Header.Get("Authorization"))
+		assert.Equal(t, "after:1700000000", r.URL.Query().Get("q"))
+		// Gmail lists newest first
+		writeJSON(w, map[string]interface{}{"messages": []map[string]string{{"id": "m3"}, {"id": "m2"}, {"id": "m1"}}})
+	})
+	mux.HandleFunc("/gmail/messages/", func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/gmail/messages/"):]
+		dates := map[string]string{"m1": "1700000100000", "m2": "1700000200000"}
+		writeJSON(w, map[string]interface{}{
+			"id": id, "snippet": "Hi", "internalDate": dates[id],
+			"payload": map[string]interface{}{"headers": []map[string]string{
+				{"name": "From", "value": `"Jane Doe" <Jane@Example.com>`},
+				{"name": "To", "value": "bob@example.com, Ann <ann@example.com>"},
+				{"name": "Subject", "value": "Proposal " + id},
+			}},
+		})
+	})
+	mux.HandleFunc("/calendar/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("singleEvents"))
+		writeJSON(w, map[string]interface{}{"items": []map[string]interface{}{{
+			"id": "e1", "status": "confirmed", "summary": "Demo", "updated": "2023-11-15T10:00:00Z",
+			"organizer": map[string]string{"email": "jane@example.com"},
+			"attendees": []map[string]string{{"email": "Bob@example.com"}},
+			"start":     map[string]string{"dateTime": "2023-11-20T15:00:00+01:00"},
+			"end":       map[string]string{"date": "2023-11-21"},
+		}}})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	p := NewGoogleProvider(OAuthClient{ClientID: "id", ClientSecret: "secret", RedirectURL: "https://crm.example.com/cb"})
+	p.tokenURL = srv.URL + "/token"
+	p.gmailURL = srv.URL + "/gmail"
+	p.calendarURL = srv.URL + "/calendar"
+
+	authURL, err := url.Parse(p.AuthCodeURL("st"))
+	require.NoError(t, err)
+	assert.Equal(t, "offline", authURL.Query().Get("access_type"))
+	assert.Equal(t, "st", authURL.Query().Get("state"))
+
+	tok, err := p.Exchange(context.Background(), "code")
+	require.NoError(t, err)
+	assert.Equal(t, "rt", tok.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), tok.ExpiresAt, time.Minute)
+
+	messages, err := p.ListMessages(context.Background(), "at", time.Unix(1700000000, 0), 2)
+	require.NoError(t, err)
+	require.Len(t, messages, 2, "the oldest messages are kept when over the limit")
+	assert.Equal(t, "m1", messages[0].ID)
+	assert.Equal(t, "jane@example.com", messages[0].From)
+	assert.Equal(t, []string{"bob@example.com", "ann@example.com"}, messages[0].To)
+	assert.Equal(t, "Proposal m1", messages[0].Subject)
+
+	events, err := p.ListEvents(context.Background(), "at", time.Unix(1700000000, 0), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, []string{"bob@example.com"}, events[0].Attendees)
+	assert.Equal(t, time.Date(2023, 11, 20, 14, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2023, 11, 21, 0, 0, 0, 0, time.UTC), events[0].End)
+}
+
+func TestMicrosoftProvider(t *testing.T) {
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"error": "invalid_grant", "error_description": "expired"})
+	})
+	mux.HandleFunc("/me/messages", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(w, map[string]interface{}{"value": []map[string]interface{}{{
+				"id": "m2", "receivedDateTime": "2023-11-15T11:00:00Z",
+				"from": map[string]interface{}{"emailAddress": map[string]string{"address": "b@example.com"}},
+			}}})
+			return
+		}
+		assert.Equal(t, "receivedDateTime ge 2023-11-15T00:00:00Z", r.URL.Query().Get("$filter"))
+		writeJSON(w, map[string]interface{}{
+			"value": []map[string]interface{}{{
+				"id": "m1", "subject": "Hello", "receivedDateTime": "2023-11-15T10:00:00Z",
+				"from":         map[string]interface{}{"emailAddress": map[string]string{"address": "A@example.com"}},
+				"toRecipients": []map[string]interface{}{{"emailAddress": map[string]string{"address": "c@example.com"}}},
+			}},
+			"@odata.nextLink": srv.URL + "/me/messages?page=2",
+		})
+	})
+	mux.HandleFunc("/me/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `outlook.timezone="UTC"`, r.Header.Get("Prefer"))
+		writeJSON(w, map[string]interface{}{"value": []map[string]interface{}{{
+			"id": "e1", "subject": "Call", "isCancelled": true, "lastModifiedDateTime": "2023-11-15T10:00:00Z",
+			"organizer": map[string]interface{}{"emailAddress": map[string]string{"address": "a@example.com"}},
+			"attendees": []map[string]interface{}{{"emailAddress": map[string]string{"address": "D@example.com"}}},
+			"start":     map[string]string{"dateTime": "2023-11-20T09:30:00.0000000", "timeZone": "UTC"},
+			"end":       map[string]string{"dateTime": "2023-11-20T10:00:00.0000000", "timeZone": "UTC"},
+		}}})
+	})
+	srv = httptest.NewServer(mux)
+	defer srv.Close()
+
+	p := NewMicrosoftProvider(OAuthClient{ClientID: "id", ClientSecret: "secret"}, "contoso.onmicrosoft.com")
+	assert.Contains(t, p.AuthCodeURL("st"), "login.microsoftonline.com/contoso.onmicrosoft.com/oauth2/v2.0/authorize")
+	p.tokenURL = srv.URL + "/token"
+	p.graphURL = srv.URL
+
+	_, err := p.Refresh(context.Background(), "rt")
+	assert.ErrorContains(t, err, "invalid_grant")
+
+	since := time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)
+	messages, err := p.ListMessages(context.Background(), "at", since, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 2, "next links are followed")
+	assert.Equal(t, "a@example.com", messages[0].From)
+	assert.Equal(t, []string{"c@example.com"}, messages[0].To)
+
+	events, err := p.ListEvents(context.Background(), "at", since, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Cancelled)
+	assert.Equal(t, []string{"d@example.com"}, events[0].Attendees)
+	assert.Equal(t, time.Date(2023, 11, 20, 9, 30, 0, 0, time.UTC), events[0].Start)
+}
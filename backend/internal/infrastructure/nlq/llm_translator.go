@@ -0,0 +1,120 @@
+// Package nlq provides QueryTranslator adapters that turn natural-language questions
+// into structured queries.
+package nlq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/mcp/pkg/llm"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// defaultModel matches the AI agent's default model
+const defaultModel = "nvidia-nemotron-3-nano-30b-a3b-mlx"
+
+// maxPromptOptions caps how many picklist values are listed per field
+const maxPromptOptions = 20
+
+const systemPrompt = `You translate questions about CRM data into a single query.
+Reply with only a JSON object, no prose:
+{"object_api_name": "...", "filter_expr": "...", "sort_field": "...", "sort_direction": "ASC|DESC", "limit": 20, "explanation": "..."}
+
+Rules:
+- Use only the objects and fields listed below, by api_name.
+- filter_expr uses formula syntax. Operators: ==, !=, >, <, >=, <=, &&, ||, !.
+  Strings are single-quoted. Text matching: CONTAINS(field, 'text'), STARTS_WITH(field, 'text').
+  Null checks: field == null, field != null. Dates: TODAY(), NOW().
+  Example: "stage == 'Closed Won' && amount > 10000"
+- Leave filter_expr empty to match every record.
+- For "top", "largest" or "latest" questions, sort and limit instead of filtering.
+- explanation is one short sentence describing the query in plain words.`
+
+// LLMTranslator asks an OpenAI-compatible chat model to translate questions
+type LLMTranslator struct {
+	client llm.Client
+	model  string
+}
+
+var _ ports.QueryTranslator = (*LLMTranslator)(nil)
+
+// NewLLMTranslator creates a translator using model on client
+func NewLLMTranslator(client llm.Client, model string) *LLMTranslator {
+	if model == "" {
+		model = defaultModel
+	}
+	return &LLMTranslator{client: client, model: model}
+}
+
+// New builds the translator configured by LLM_BASE_URL, LLM_API_KEY and LLM_MODEL.
+// Returns nil when LLM_BASE_URL is unset, in which case natural-language queries are disabled.
+func New(cfg config.LLM) ports.QueryTranslator {
+	if cfg.BaseURL == "" {
+		return nil
+	}
+	return NewLLMTranslator(llm.NewOpenAIClient(cfg.BaseURL, cfg.APIKey), cfg.Model)
+}
+
+func (t *LLMTranslator) Translate(ctx context.Context, question string, objects []*models.ObjectMetadata, feedback string) (*ports.QueryTranslation, error) {
+	messages := []llm.Message{
+		{Role: "system", Content: systemPrompt + "\n\nOBJECTS:\n" + describeObjects(objects)},
+		{Role: "user", Content: question},
+	}
+	if feedback != "" {
+		messages = append(messages, llm.Message{Role: "user", Content: "That query was rejected: " + feedback + "\nReply with a corrected JSON object."})
+	}
+
+	resp, err := t.client.Chat(ctx, llm.Request{Model: t.model, Messages: messages})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("LLM returned no answer")
+	}
+	return parseTranslation(resp.Choices[0].Message.Content)
+}
+
+// describeObjects lists objects and their queryable fields compactly for the prompt
+func describeObjects(objects []*models.ObjectMetadata) string {
+	var sb strings.Builder
+	for _, obj := range objects {
+		fmt.Fprintf(&sb, "- %s (%s):", obj.APIName, obj.Label)
+		for _, f := range obj.Fields {
+			if f.IsSystem && f.APIName != constants.FieldCreatedDate && f.APIName != constants.FieldLastModifiedDate {
+				continue
+			}
+			fmt.Fprintf(&sb, " %s %s", f.APIName, strings.ToLower(string(f.Type)))
+			if len(f.ReferenceTo) > 0 {
+				fmt.Fprintf(&sb, "->%s", strings.Join(f.ReferenceTo, "|"))
+			}
+			if len(f.Options) > 0 {
+				options := f.Options
+				if len(options) > maxPromptOptions {
+					options = options[:maxPromptOptions]
+				}
+				fmt.Fprintf(&sb, "[%s]", strings.Join(options, "|"))
+			}
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// parseTranslation extracts the JSON object from a model reply, tolerating code fences and prose
+func parseTranslation(content string) (*ports.QueryTranslation, error) {
+	start, end := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("LLM reply contains no query: %q", content)
+	}
+	var translation ports.QueryTranslation
+	if err := json.Unmarshal([]byte(content[start:end+1]), &translation); err != nil {
+		return nil, fmt.Errorf("LLM reply is not a valid query: %w", err)
+	}
+	return &translation, nil
+}
@@ -0,0 +1,52 @@
+package nlq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuscrm/mcp/pkg/llm"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLLM struct {
+	reply string
+	req   llm.Request
+}
+
+func (f *fakeLLM) Chat(_ context.Context, req llm.Request) (*llm.Response, error) {
+	f.req = req
+	return &llm.Response{Choices: []llm.Choice{{Message: llm.Message{Role: "assistant", Content: f.reply}}}}, nil
+}
+
+func TestLLMTranslator(t *testing.T) {
+	client := &fakeLLM{reply: "Here you go:\n```json\n{\"object_api_name\": \"lead\", \"filter_expr\": \"status == 'Open'\", \"limit\": 10}\n```"}
+	translator := NewLLMTranslator(client, "")
+	objects := []*models.ObjectMetadata{{
+		APIName: "lead",
+		Label:   "Lead",
+		Fields: []models.FieldMetadata{
+			{APIName: "status", Type: constants.FieldTypePicklist, Options: []string{"Open", "Closed"}},
+			{APIName: "owner_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"_system_user"}},
+			{APIName: constants.FieldIsDeleted, Type: constants.FieldTypeBoolean, IsSystem: true},
+		},
+	}}
+
+	translation, err := translator.Translate(context.Background(), "open leads", objects, "field foo does not exist")
+	require.NoError(t, err)
+	assert.Equal(t, "lead", translation.ObjectAPIName)
+	assert.Equal(t, "status == 'Open'", translation.FilterExpr)
+	assert.Equal(t, 10, translation.Limit)
+
+	assert.Equal(t, defaultModel, client.req.Model)
+	require.Len(t, client.req.Messages, 3, "feedback is sent as a follow-up")
+	prompt := client.req.Messages[0].Content
+	assert.Contains(t, prompt, "- lead (Lead): status picklist[Open|Closed], owner_id lookup->_system_user,")
+	assert.NotContains(t, prompt, constants.FieldIsDeleted)
+
+	client.reply = "I don't know"
+	_, err = translator.Translate(context.Background(), "open leads", objects, "")
+	assert.Error(t, err)
+}
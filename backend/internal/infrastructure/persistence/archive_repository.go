@@ -0,0 +1,382 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ArchiveSuffix is appended to an object's table name to name its archive table
+const ArchiveSuffix = "__archive"
+
+// ArchiveTableName returns the table that holds the archived rows of an object
+func ArchiveTableName(tableName string) string {
+	return tableName + ArchiveSuffix
+}
+
+// ArchiveRepository handles archival policies and moves old rows between an object's
+// table and its archive table
+type ArchiveRepository struct {
+	db     *sql.DB
+	schema *SchemaRepository // Builds the archive tables' DDL for the active dialect
+}
+
+// NewArchiveRepository creates a new ArchiveRepository
+func NewArchiveRepository(db *sql.DB) *ArchiveRepository {
+	return &ArchiveRepository{db: db, schema: NewSchemaRepository(db)}
+}
+
+var archivePolicyColumns = []string{
+	constants.FieldSysArchivePolicy_ID,
+	constants.FieldSysArchivePolicy_ObjectAPIName,
+	constants.FieldSysArchivePolicy_DateField,
+	constants.FieldSysArchivePolicy_RetainDays,
+	constants.FieldSysArchivePolicy_IsActive,
+	constants.FieldSysArchivePolicy_LastRunDate,
+	constants.FieldSysArchivePolicy_LastArchivedCount,
+	constants.FieldSysArchivePolicy_LastError,
+	constants.FieldSysArchivePolicy_CreatedDate,
+	constants.FieldSysArchivePolicy_LastModifiedDate,
+}
+
+// ListPolicies returns every archival policy ordered by object
+func (r *ArchiveRepository) ListPolicies(ctx context.Context) ([]*models.SystemArchivePolicy, error) {
+	q := query.From(constants.TableArchivePolicy).
+		Select(archivePolicyColumns).
+		OrderBy(constants.FieldSysArchivePolicy_ObjectAPIName, constants.SortASC).
+		Build()
+	return r.findPolicies(ctx, q)
+}
+
+// GetPolicy returns the archival policy of an object, or nil if it has none
+func (r *ArchiveRepository) GetPolicy(ctx context.Context, objectAPIName string) (*models.SystemArchivePolicy, error) {
+	q := query.From(constants.TableArchivePolicy).
+		Select(archivePolicyColumns).
+		Where(constants.FieldSysArchivePolicy_ObjectAPIName+" = ?", objectAPIName).
+		Build()
+	policies, err := r.findPolicies(ctx, q)
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+	return policies[0], nil
+}
+
+// InsertPolicy creates an archival policy
+func (r *ArchiveRepository) InsertPolicy(ctx context.Context, p *models.SystemArchivePolicy) error {
+	now := time.Now().UTC()
+	q := query.Insert(constants.TableArchivePolicy, map[string]interface{}{
+		constants.FieldSysArchivePolicy_ID:                p.ID,
+		constants.FieldSysArchivePolicy_ObjectAPIName:     p.ObjectAPIName,
+		constants.FieldSysArchivePolicy_DateField:         p.DateField,
+		constants.FieldSysArchivePolicy_RetainDays:        p.RetainDays,
+		constants.FieldSysArchivePolicy_IsActive:          p.IsActive,
+		constants.FieldSysArchivePolicy_LastArchivedCount: 0,
+		constants.FieldSysArchivePolicy_CreatedDate:       now,
+		constants.FieldSysArchivePolicy_LastModifiedDate:  now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to create archive policy: %w", err)
+	}
+	return nil
+}
+
+// UpdatePolicy saves the editable settings of an archival policy
+func (r *ArchiveRepository) UpdatePolicy(ctx context.Context, p *models.SystemArchivePolicy) error {
+	q := query.Update(constants.TableArchivePolicy).
+		Set(map[string]interface{}{
+			constants.FieldSysArchivePolicy_DateField:        p.DateField,
+			constants.FieldSysArchivePolicy_RetainDays:       p.RetainDays,
+			constants.FieldSysArchivePolicy_IsActive:         p.IsActive,
+			constants.FieldSysArchivePolicy_LastModifiedDate: time.Now().UTC(),
+		}).
+		Where(constants.FieldSysArchivePolicy_ID+" = ?", p.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update archive policy: %w", err)
+	}
+	return nil
+}
+
+// RecordRun stores the outcome of an archival run
+func (r *ArchiveRepository) RecordRun(ctx context.Context, id string, ranAt time.Time, archived int, runErr *string) error {
+	q := query.Update(constants.TableArchivePolicy).
+		Set(map[string]interface{}{
+			constants.FieldSysArchivePolicy_LastRunDate:       ranAt,
+			constants.FieldSysArchivePolicy_LastArchivedCount: archived,
+			constants.FieldSysArchivePolicy_LastError:         runErr,
+		}).
+		Where(constants.FieldSysArchivePolicy_ID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// DeletePolicy removes the archival policy of an object. Archived rows are kept.
+func (r *ArchiveRepository) DeletePolicy(ctx context.Context, objectAPIName string) error {
+	q := query.Delete(constants.TableArchivePolicy).
+		Where(constants.FieldSysArchivePolicy_ObjectAPIName+" = ?", objectAPIName).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete archive policy of %s: %w", objectAPIName, err)
+	}
+	return nil
+}
+
+func (r *ArchiveRepository) findPolicies(ctx context.Context, q query.QueryResult) ([]*models.SystemArchivePolicy, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]*models.SystemArchivePolicy, 0)
+	for rows.Next() {
+		var p models.SystemArchivePolicy
+		var lastRun sql.NullTime
+		var lastError sql.NullString
+		if err := rows.Scan(&p.ID, &p.ObjectAPIName, &p.DateField, &p.RetainDays, &p.IsActive,
+			&lastRun, &p.LastArchivedCount, &lastError, &p.CreatedDate, &p.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan archive policy: %w", err)
+		}
+		if lastRun.Valid {
+			p.LastRunDate = &lastRun.Time
+		}
+		if lastError.Valid {
+			p.LastError = &lastError.String
+		}
+		policies = append(policies, &p)
+	}
+	return policies, rows.Err()
+}
+
+// tableColumns returns the column names of a table in ordinal order; none when it does
+// not exist
+func tableColumns(ctx context.Context, exec Executor, tableName string) ([]string, error) {
+	rows, err := exec.QueryContext(ctx, fmt.Sprintf(`
+		SELECT COLUMN_NAME
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = %s AND TABLE_NAME = ?
+		ORDER BY ORDINAL_POSITION
+	`, query.ActiveDialect().CurrentSchema()), tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns of %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan column of %s: %w", tableName, err)
+		}
+		columns = append(columns, name)
+	}
+	return columns, rows.Err()
+}
+
+// columnSet indexes column names case-insensitively
+func columnSet(columns []string) map[string]bool {
+	set := make(map[string]bool, len(columns))
+	for _, name := range columns {
+		set[strings.ToLower(name)] = true
+	}
+	return set
+}
+
+// archiveUnionSQL selects the live and archived rows of a table together. Columns added
+// after the last archival run read as NULL for archived rows.
+func archiveUnionSQL(tableName string, live []string, archived map[string]bool) string {
+	liveCols := make([]string, 0, len(live))
+	archiveCols := make([]string, 0, len(live))
+	for _, name := range live {
+		col := "`" + name + "`"
+		liveCols = append(liveCols, col)
+		if archived[strings.ToLower(name)] {
+			archiveCols = append(archiveCols, col)
+		} else {
+			archiveCols = append(archiveCols, "NULL AS "+col)
+		}
+	}
+	return fmt.Sprintf("SELECT %s FROM `%s` UNION ALL SELECT %s FROM `%s`",
+		strings.Join(liveCols, ", "), tableName, strings.Join(archiveCols, ", "), ArchiveTableName(tableName))
+}
+
+// ArchiveUnion returns a SELECT over the live and archived rows of a table, or "" when the
+// table has no archive yet
+func ArchiveUnion(ctx context.Context, exec Executor, tableName string) (string, error) {
+	archived, err := tableColumns(ctx, exec, ArchiveTableName(tableName))
+	if err != nil || len(archived) == 0 {
+		return "", err
+	}
+	live, err := tableColumns(ctx, exec, tableName)
+	if err != nil {
+		return "", err
+	}
+	return archiveUnionSQL(tableName, live, columnSet(archived)), nil
+}
+
+// archiveColumn is the column an archive table keeps for a live column: a plain column of
+// the same type. Only the ID stays a primary key; no other column is unique, as a value may
+// repeat once its first holder is archived, and all are nullable, so the columns of fields
+// deleted later keep accepting rows. Formula values are kept as they were when archived.
+func archiveColumn(col schema.ColumnDefinition) schema.ColumnDefinition {
+	return schema.ColumnDefinition{
+		Name:       col.Name,
+		Type:       col.Type,
+		Length:     col.Length,
+		Nullable:   !col.PrimaryKey,
+		PrimaryKey: col.PrimaryKey,
+	}
+}
+
+// EnsureArchiveTable creates the archive table of an object from the columns of its live
+// table, and adds the columns the object gained since the archive was created
+func (r *ArchiveRepository) EnsureArchiveTable(ctx context.Context, def schema.TableDefinition) error {
+	archive := ArchiveTableName(def.TableName)
+	if len(archive) > query.MaxIdentifierLength {
+		return fmt.Errorf("archive table name for %s exceeds %d characters", def.TableName, query.MaxIdentifierLength)
+	}
+
+	existing, err := tableColumns(ctx, r.db, archive)
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		log.Printf("🗄️  Creating archive table %s", archive)
+		table := schema.TableDefinition{TableName: archive, TableType: def.TableType, Description: def.Description}
+		for _, col := range def.Columns {
+			table.Columns = append(table.Columns, archiveColumn(col))
+		}
+		return r.schema.CreatePhysicalTable(ctx, table)
+	}
+
+	archived := columnSet(existing)
+	for _, col := range def.Columns {
+		if archived[strings.ToLower(col.Name)] {
+			continue
+		}
+		log.Printf("🗄️  Adding column %s to archive table %s", col.Name, archive)
+		if err := r.schema.AddMissingColumn(archive, archiveColumn(col)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencingColumns returns the foreign keys (table, column) that point at a table
+func (r *ArchiveRepository) referencingColumns(ctx context.Context, tableName string) ([][2]string, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT TABLE_NAME, COLUMN_NAME
+		FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = %s AND REFERENCED_TABLE_NAME = ?
+	`, query.ActiveDialect().CurrentSchema()), tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read references to %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var refs [][2]string
+	for rows.Next() {
+		var ref [2]string
+		if err := rows.Scan(&ref[0], &ref[1]); err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, rows.Err()
+}
+
+// ArchiveBatch moves up to limit live rows whose dateField is older than cutoff into the
+// archive table, in one transaction. Rows still referenced through a foreign key, and rows
+// in the recycle bin, stay in place. It returns the number of rows moved.
+func (r *ArchiveRepository) ArchiveBatch(ctx context.Context, tableName, dateField string, cutoff time.Time, limit int) (int, error) {
+	live, err := tableColumns(ctx, r.db, tableName)
+	if err != nil {
+		return 0, err
+	}
+	archived, err := tableColumns(ctx, r.db, ArchiveTableName(tableName))
+	if err != nil {
+		return 0, err
+	}
+	inArchive := columnSet(archived)
+
+	var columns []string
+	hasDateField, hasIsDeleted := false, false
+	for _, name := range live {
+		if strings.EqualFold(name, dateField) {
+			hasDateField = true
+		}
+		if strings.EqualFold(name, constants.FieldIsDeleted) {
+			hasIsDeleted = true
+		}
+		if inArchive[strings.ToLower(name)] {
+			columns = append(columns, "`"+name+"`")
+		}
+	}
+	if !hasDateField {
+		return 0, fmt.Errorf("table %s has no column %s", tableName, dateField)
+	}
+
+	refs, err := r.referencingColumns(ctx, tableName)
+	if err != nil {
+		return 0, err
+	}
+
+	conditions := []string{fmt.Sprintf("t.`%s` < ?", dateField)}
+	if hasIsDeleted {
+		conditions = append(conditions, fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse))
+	}
+	for _, ref := range refs {
+		conditions = append(conditions, fmt.Sprintf("NOT EXISTS (SELECT 1 FROM `%s` ref WHERE ref.`%s` = t.`%s`)", ref[0], ref[1], constants.FieldID))
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	selectIDs := fmt.Sprintf("SELECT t.`%s` FROM `%s` t WHERE %s ORDER BY t.`%s` LIMIT %d FOR UPDATE",
+		constants.FieldID, tableName, strings.Join(conditions, " AND "), dateField, limit)
+	rows, err := tx.QueryContext(ctx, selectIDs, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select rows to archive from %s: %w", tableName, err)
+	}
+	var ids []interface{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	cols := strings.Join(columns, ", ")
+	copyRows := fmt.Sprintf("INSERT INTO `%s` (%s) SELECT %s FROM `%s` WHERE `%s` IN (%s)",
+		ArchiveTableName(tableName), cols, cols, tableName, constants.FieldID, placeholders)
+	if _, err := tx.ExecContext(ctx, copyRows, ids...); err != nil {
+		return 0, fmt.Errorf("failed to copy rows to %s: %w", ArchiveTableName(tableName), err)
+	}
+	deleteRows := fmt.Sprintf("DELETE FROM `%s` WHERE `%s` IN (%s)", tableName, constants.FieldID, placeholders)
+	if _, err := tx.ExecContext(ctx, deleteRows, ids...); err != nil {
+		return 0, fmt.Errorf("failed to remove archived rows from %s: %w", tableName, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
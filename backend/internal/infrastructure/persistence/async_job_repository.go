@@ -0,0 +1,174 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// AsyncJobRepository handles database operations for background jobs and their progress
+type AsyncJobRepository struct {
+	db *sql.DB
+}
+
+// NewAsyncJobRepository creates a new AsyncJobRepository
+func NewAsyncJobRepository(db *sql.DB) *AsyncJobRepository {
+	return &AsyncJobRepository{db: db}
+}
+
+var asyncJobColumns = []string{
+	constants.FieldSysAsyncJob_ID,
+	constants.FieldSysAsyncJob_JobType,
+	constants.FieldSysAsyncJob_ObjectAPIName,
+	constants.FieldSysAsyncJob_Status,
+	constants.FieldSysAsyncJob_Parameters,
+	constants.FieldSysAsyncJob_TotalCount,
+	constants.FieldSysAsyncJob_ProcessedCount,
+	constants.FieldSysAsyncJob_FailedCount,
+	constants.FieldSysAsyncJob_ErrorMessage,
+	constants.FieldSysAsyncJob_StartedDate,
+	constants.FieldSysAsyncJob_CompletedDate,
+	constants.FieldSysAsyncJob_CreatedByID,
+	constants.FieldSysAsyncJob_CreatedDate,
+	constants.FieldSysAsyncJob_LastModifiedDate,
+}
+
+// Insert stores a new job
+func (r *AsyncJobRepository) Insert(ctx context.Context, job *models.SystemAsyncJob) error {
+	now := time.Now()
+	q := query.Insert(constants.TableAsyncJob, map[string]interface{}{
+		constants.FieldSysAsyncJob_ID:               job.ID,
+		constants.FieldSysAsyncJob_JobType:          job.JobType,
+		constants.FieldSysAsyncJob_ObjectAPIName:    job.ObjectAPIName,
+		constants.FieldSysAsyncJob_Status:           job.Status,
+		constants.FieldSysAsyncJob_Parameters:       nullableJSON(job.Parameters),
+		constants.FieldSysAsyncJob_TotalCount:       job.TotalCount,
+		constants.FieldSysAsyncJob_CreatedByID:      job.CreatedByID,
+		constants.FieldSysAsyncJob_CreatedDate:      now,
+		constants.FieldSysAsyncJob_LastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert async job: %w", err)
+	}
+	job.CreatedDate = now
+	job.LastModifiedDate = now
+	return nil
+}
+
+// FindByID returns a job, or nil if not found
+func (r *AsyncJobRepository) FindByID(ctx context.Context, id string) (*models.SystemAsyncJob, error) {
+	q := query.From(constants.TableAsyncJob).
+		Select(asyncJobColumns).
+		Where(constants.FieldSysAsyncJob_ID+" = ?", id).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanAsyncJob(rows)
+}
+
+// UpdateProgress stores the status and counters of a job. Finished jobs get their completion
+// date. A job that has been aborted keeps that status unless it is being saved as aborted; it
+// reports false then, telling the runner to stop.
+func (r *AsyncJobRepository) UpdateProgress(ctx context.Context, job *models.SystemAsyncJob) (bool, error) {
+	now := time.Now()
+	values := map[string]interface{}{
+		constants.FieldSysAsyncJob_Status:           job.Status,
+		constants.FieldSysAsyncJob_TotalCount:       job.TotalCount,
+		constants.FieldSysAsyncJob_ProcessedCount:   job.ProcessedCount,
+		constants.FieldSysAsyncJob_FailedCount:      job.FailedCount,
+		constants.FieldSysAsyncJob_ErrorMessage:     job.ErrorMessage,
+		constants.FieldSysAsyncJob_StartedDate:      job.StartedDate,
+		constants.FieldSysAsyncJob_CompletedDate:    job.CompletedDate,
+		constants.FieldSysAsyncJob_LastModifiedDate: now,
+	}
+	aborted := string(constants.AsyncJobStatusAborted)
+	q := query.Update(constants.TableAsyncJob).
+		Set(values).
+		Where(constants.FieldSysAsyncJob_ID+" = ?", job.ID).
+		Where(fmt.Sprintf("(%s <> ? OR ? = ?)", constants.FieldSysAsyncJob_Status), aborted, job.Status, aborted).
+		Build()
+
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update async job: %w", err)
+	}
+	job.LastModifiedDate = now
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update async job: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// Abort marks a queued or running job as aborted, reporting false if it had already finished
+func (r *AsyncJobRepository) Abort(ctx context.Context, id string) (bool, error) {
+	now := time.Now()
+	q := query.Update(constants.TableAsyncJob).
+		Set(map[string]interface{}{
+			constants.FieldSysAsyncJob_Status:           string(constants.AsyncJobStatusAborted),
+			constants.FieldSysAsyncJob_CompletedDate:    now,
+			constants.FieldSysAsyncJob_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysAsyncJob_ID+" = ?", id).
+		Where(constants.FieldSysAsyncJob_Status+" IN (?, ?)", string(constants.AsyncJobStatusQueued), string(constants.AsyncJobStatusRunning)).
+		Build()
+
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to abort async job: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to abort async job: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func scanAsyncJob(rows *sql.Rows) (*models.SystemAsyncJob, error) {
+	var job models.SystemAsyncJob
+	var objectAPIName, errorMessage, createdBy sql.NullString
+	var parameters []byte
+	var started, completed sql.NullTime
+
+	if err := rows.Scan(
+		&job.ID, &job.JobType, &objectAPIName, &job.Status, &parameters,
+		&job.TotalCount, &job.ProcessedCount, &job.FailedCount, &errorMessage,
+		&started, &completed, &createdBy, &job.CreatedDate, &job.LastModifiedDate,
+	); err != nil {
+		return nil, err
+	}
+	if objectAPIName.Valid {
+		job.ObjectAPIName = &objectAPIName.String
+	}
+	if len(parameters) > 0 {
+		job.Parameters = parameters
+	}
+	if errorMessage.Valid {
+		job.ErrorMessage = &errorMessage.String
+	}
+	if started.Valid {
+		job.StartedDate = &started.Time
+	}
+	if completed.Valid {
+		job.CompletedDate = &completed.Time
+	}
+	if createdBy.Valid {
+		job.CreatedByID = &createdBy.String
+	}
+	return &job, nil
+}
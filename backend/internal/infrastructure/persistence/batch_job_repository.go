@@ -0,0 +1,230 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// BatchJobRepository handles database operations for batch job definitions
+type BatchJobRepository struct {
+	db *sql.DB
+}
+
+// NewBatchJobRepository creates a new BatchJobRepository
+func NewBatchJobRepository(db *sql.DB) *BatchJobRepository {
+	return &BatchJobRepository{db: db}
+}
+
+var batchJobColumns = []string{
+	constants.FieldSysBatchJob_ID,
+	constants.FieldSysBatchJob_Name,
+	constants.FieldSysBatchJob_Description,
+	constants.FieldSysBatchJob_ObjectAPIName,
+	constants.FieldSysBatchJob_ScopeFilter,
+	constants.FieldSysBatchJob_Processor,
+	constants.FieldSysBatchJob_ScriptName,
+	constants.FieldSysBatchJob_FieldValues,
+	constants.FieldSysBatchJob_ChunkSize,
+	constants.FieldSysBatchJob_Concurrency,
+	constants.FieldSysBatchJob_Schedule,
+	constants.FieldSysBatchJob_ScheduleTimezone,
+	constants.FieldSysBatchJob_NextRunAt,
+	constants.FieldSysBatchJob_LastRunAt,
+	constants.FieldSysBatchJob_LastJobID,
+	constants.FieldSysBatchJob_IsActive,
+	constants.FieldSysBatchJob_OwnerID,
+	constants.FieldSysBatchJob_CreatedDate,
+	constants.FieldSysBatchJob_LastModifiedDate,
+}
+
+// GetAll queries all batch jobs ordered by name
+func (r *BatchJobRepository) GetAll(ctx context.Context) ([]*models.BatchJob, error) {
+	q := query.From(constants.TableBatchJob).
+		Select(batchJobColumns).
+		OrderBy(constants.FieldSysBatchJob_Name, constants.SortASC).
+		Build()
+	return r.queryBatchJobs(ctx, q)
+}
+
+// FindByName queries a batch job by name, or nil if not found
+func (r *BatchJobRepository) FindByName(ctx context.Context, name string) (*models.BatchJob, error) {
+	q := query.From(constants.TableBatchJob).
+		Select(batchJobColumns).
+		Where(constants.FieldSysBatchJob_Name+" = ?", name).
+		Limit(1).
+		Build()
+	jobs, err := r.queryBatchJobs(ctx, q)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// FindDue queries the active scheduled batch jobs whose next run is at or before now
+func (r *BatchJobRepository) FindDue(ctx context.Context, now time.Time) ([]*models.BatchJob, error) {
+	q := query.From(constants.TableBatchJob).
+		Select(batchJobColumns).
+		Where(constants.FieldSysBatchJob_IsActive+" = ?", true).
+		Where(constants.FieldSysBatchJob_NextRunAt+" <= ?", now).
+		OrderBy(constants.FieldSysBatchJob_NextRunAt, constants.SortASC).
+		Build()
+	return r.queryBatchJobs(ctx, q)
+}
+
+func (r *BatchJobRepository) queryBatchJobs(ctx context.Context, q query.QueryResult) ([]*models.BatchJob, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query batch jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs := make([]*models.BatchJob, 0)
+	for rows.Next() {
+		var b models.BatchJob
+		var processor string
+		var description, scopeFilter, scriptName, fieldValues, schedule, timezone, lastJobID, ownerID sql.NullString
+		var chunkSize, concurrency sql.NullInt64
+		var nextRun, lastRun sql.NullTime
+		if err := rows.Scan(&b.ID, &b.Name, &description, &b.ObjectAPIName, &scopeFilter, &processor, &scriptName,
+			&fieldValues, &chunkSize, &concurrency, &schedule, &timezone, &nextRun, &lastRun, &lastJobID,
+			&b.IsActive, &ownerID, &b.CreatedDate, &b.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan batch job: %w", err)
+		}
+		b.Processor = constants.BatchProcessor(processor)
+		b.Description = description.String
+		b.ScopeFilter = scopeFilter.String
+		b.ScriptName = scriptName.String
+		b.ChunkSize = int(chunkSize.Int64)
+		b.Concurrency = int(concurrency.Int64)
+		b.Schedule = schedule.String
+		b.ScheduleTimezone = timezone.String
+		b.NextRunAt = nullTimePtr(nextRun)
+		b.LastRunAt = nullTimePtr(lastRun)
+		b.LastJobID = lastJobID.String
+		b.OwnerID = ownerID.String
+		if err := unmarshalNullJSON(fieldValues, &b.FieldValues); err != nil {
+			return nil, fmt.Errorf("failed to decode field values of batch job %s: %w", b.Name, err)
+		}
+		jobs = append(jobs, &b)
+	}
+	return jobs, rows.Err()
+}
+
+func batchJobValues(b *models.BatchJob) (map[string]interface{}, error) {
+	var fieldValues interface{}
+	if len(b.FieldValues) > 0 {
+		data, err := json.Marshal(b.FieldValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field values: %w", err)
+		}
+		fieldValues = string(data)
+	}
+	return map[string]interface{}{
+		constants.FieldSysBatchJob_Description:      nullableString(b.Description),
+		constants.FieldSysBatchJob_ObjectAPIName:    b.ObjectAPIName,
+		constants.FieldSysBatchJob_ScopeFilter:      nullableString(b.ScopeFilter),
+		constants.FieldSysBatchJob_Processor:        string(b.Processor),
+		constants.FieldSysBatchJob_ScriptName:       nullableString(b.ScriptName),
+		constants.FieldSysBatchJob_FieldValues:      fieldValues,
+		constants.FieldSysBatchJob_ChunkSize:        nullableQuota(int64(b.ChunkSize)),
+		constants.FieldSysBatchJob_Concurrency:      nullableQuota(int64(b.Concurrency)),
+		constants.FieldSysBatchJob_Schedule:         nullableString(b.Schedule),
+		constants.FieldSysBatchJob_ScheduleTimezone: nullableString(b.ScheduleTimezone),
+		constants.FieldSysBatchJob_NextRunAt:        b.NextRunAt,
+		constants.FieldSysBatchJob_IsActive:         b.IsActive,
+	}, nil
+}
+
+// Insert inserts a batch job
+func (r *BatchJobRepository) Insert(ctx context.Context, b *models.BatchJob) error {
+	values, err := batchJobValues(b)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysBatchJob_ID] = b.ID
+	values[constants.FieldSysBatchJob_Name] = b.Name
+	values[constants.FieldSysBatchJob_OwnerID] = nullableString(b.OwnerID)
+	values[constants.FieldSysBatchJob_CreatedDate] = now
+	values[constants.FieldSysBatchJob_LastModifiedDate] = now
+	q := query.Insert(constants.TableBatchJob, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert batch job: %w", err)
+	}
+	b.CreatedDate = now
+	b.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites a batch job's settings; the name and run history are left as they are
+func (r *BatchJobRepository) Update(ctx context.Context, b *models.BatchJob) error {
+	values, err := batchJobValues(b)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysBatchJob_LastModifiedDate] = now
+	q := query.Update(constants.TableBatchJob).
+		Set(values).
+		Where(constants.FieldSysBatchJob_ID+" = ?", b.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update batch job: %w", err)
+	}
+	b.LastModifiedDate = now
+	return nil
+}
+
+// ClaimScheduledRun moves a batch job's next run from due to next, reporting false when another
+// process has claimed the run first
+func (r *BatchJobRepository) ClaimScheduledRun(ctx context.Context, id string, due time.Time, next *time.Time) (bool, error) {
+	q := query.Update(constants.TableBatchJob).
+		Set(map[string]interface{}{
+			constants.FieldSysBatchJob_NextRunAt: next,
+		}).
+		Where(constants.FieldSysBatchJob_ID+" = ?", id).
+		Where(constants.FieldSysBatchJob_NextRunAt+" = ?", due).
+		Build()
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim batch job run: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim batch job run: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// SaveRun records the async job of a batch job's latest run
+func (r *BatchJobRepository) SaveRun(ctx context.Context, id, jobID string, ranAt time.Time) error {
+	q := query.Update(constants.TableBatchJob).
+		Set(map[string]interface{}{
+			constants.FieldSysBatchJob_LastJobID: jobID,
+			constants.FieldSysBatchJob_LastRunAt: ranAt,
+		}).
+		Where(constants.FieldSysBatchJob_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to save batch job run: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a batch job
+func (r *BatchJobRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableBatchJob).
+		Where(constants.FieldSysBatchJob_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete batch job: %w", err)
+	}
+	return nil
+}
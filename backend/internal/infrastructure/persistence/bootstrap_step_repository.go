@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// BootstrapStepRepository records the startup steps that completed and the checksum of the
+// input they ran with
+type BootstrapStepRepository struct {
+	db *sql.DB
+}
+
+// NewBootstrapStepRepository creates a new BootstrapStepRepository
+func NewBootstrapStepRepository(db *sql.DB) *BootstrapStepRepository {
+	return &BootstrapStepRepository{db: db}
+}
+
+// GetChecksum returns the checksum stored for a step, or "" if the step never completed
+func (r *BootstrapStepRepository) GetChecksum(ctx context.Context, stepName string) (string, error) {
+	q := query.From(constants.TableBootstrapStep).
+		Select([]string{constants.FieldSysBootstrapStep_Checksum}).
+		Where(constants.FieldSysBootstrapStep_StepName+" = ?", stepName).
+		Limit(1).
+		Build()
+
+	var id, checksum string
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&id, &checksum)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get bootstrap step %s: %w", stepName, err)
+	}
+	return checksum, nil
+}
+
+// SaveStep stores the checksum and duration of a completed step
+func (r *BootstrapStepRepository) SaveStep(ctx context.Context, stepName, checksum string, duration time.Duration) error {
+	now := time.Now()
+
+	q := query.Update(constants.TableBootstrapStep).
+		Set(map[string]interface{}{
+			constants.FieldSysBootstrapStep_Checksum:         checksum,
+			constants.FieldSysBootstrapStep_DurationMs:       duration.Milliseconds(),
+			constants.FieldSysBootstrapStep_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysBootstrapStep_StepName+" = ?", stepName).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return fmt.Errorf("failed to update bootstrap step %s: %w", stepName, err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected > 0 {
+		return nil
+	}
+
+	q = query.Insert(constants.TableBootstrapStep, map[string]interface{}{
+		constants.FieldSysBootstrapStep_ID:               uuid.New().String(),
+		constants.FieldSysBootstrapStep_StepName:         stepName,
+		constants.FieldSysBootstrapStep_Checksum:         checksum,
+		constants.FieldSysBootstrapStep_DurationMs:       duration.Milliseconds(),
+		constants.FieldSysBootstrapStep_CreatedDate:      now,
+		constants.FieldSysBootstrapStep_LastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert bootstrap step %s: %w", stepName, err)
+	}
+	return nil
+}
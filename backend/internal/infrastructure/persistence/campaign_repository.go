@@ -0,0 +1,477 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// CampaignRepository handles database operations for campaigns and their members, and
+// finds the records a campaign influenced
+type CampaignRepository struct {
+	db *sql.DB
+}
+
+// NewCampaignRepository creates a new CampaignRepository
+func NewCampaignRepository(db *sql.DB) *CampaignRepository {
+	return &CampaignRepository{db: db}
+}
+
+// CampaignInfluence is a record of the influence object linked to a campaign member
+type CampaignInfluence struct {
+	RecordID string
+	Amount   float64
+	Stage    string
+}
+
+// CampaignRollups are the influence figures stored on a campaign
+type CampaignRollups struct {
+	Opportunities    int
+	WonOpportunities int
+	AmountAll        float64
+	AmountWon        float64
+}
+
+// campaignTimestamp formats dates like record system dates so members and records compare
+func campaignTimestamp(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+var campaignColumns = []string{
+	constants.FieldSysCampaign_Name,
+	constants.FieldSysCampaign_Description,
+	constants.FieldSysCampaign_Type,
+	constants.FieldSysCampaign_Status,
+	constants.FieldSysCampaign_StartDate,
+	constants.FieldSysCampaign_EndDate,
+	constants.FieldSysCampaign_BudgetedCost,
+	constants.FieldSysCampaign_ActualCost,
+	constants.FieldSysCampaign_ExpectedRevenue,
+	constants.FieldSysCampaign_MemberStatuses,
+	constants.FieldSysCampaign_IsActive,
+	constants.FieldSysCampaign_NumberOfMembers,
+	constants.FieldSysCampaign_NumberOfResponses,
+	constants.FieldSysCampaign_NumberOfOpportunities,
+	constants.FieldSysCampaign_NumberOfWonOpportunities,
+	constants.FieldSysCampaign_AmountAllOpportunities,
+	constants.FieldSysCampaign_AmountWonOpportunities,
+	constants.FieldSysCampaign_RollupsDate,
+	constants.FieldSysCampaign_OwnerID,
+	constants.FieldSysCampaign_CreatedDate,
+	constants.FieldSysCampaign_LastModifiedDate,
+}
+
+var campaignMemberColumns = []string{
+	constants.FieldSysCampaignMember_CampaignID,
+	constants.FieldSysCampaignMember_ObjectAPIName,
+	constants.FieldSysCampaignMember_RecordID,
+	constants.FieldSysCampaignMember_Status,
+	constants.FieldSysCampaignMember_HasResponded,
+	constants.FieldSysCampaignMember_FirstRespondedDate,
+	constants.FieldSysCampaignMember_AddedByID,
+	constants.FieldSysCampaignMember_CreatedDate,
+	constants.FieldSysCampaignMember_LastModifiedDate,
+}
+
+// List returns every campaign, most recent first
+func (r *CampaignRepository) List(ctx context.Context) ([]*models.SystemCampaign, error) {
+	q := query.From(constants.TableCampaign).
+		Select(campaignColumns).
+		OrderBy(constants.FieldSysCampaign_CreatedDate, constants.SortDESC).
+		Build()
+	return r.queryCampaigns(ctx, q)
+}
+
+// ListActive returns the active campaigns
+func (r *CampaignRepository) ListActive(ctx context.Context) ([]*models.SystemCampaign, error) {
+	q := query.From(constants.TableCampaign).
+		Select(campaignColumns).
+		Where(constants.FieldSysCampaign_IsActive+" = ?", true).
+		Build()
+	return r.queryCampaigns(ctx, q)
+}
+
+// Get returns a campaign by ID, or nil if not found
+func (r *CampaignRepository) Get(ctx context.Context, id string) (*models.SystemCampaign, error) {
+	q := query.From(constants.TableCampaign).
+		Select(campaignColumns).
+		Where(constants.FieldSysCampaign_ID+" = ?", id).
+		Limit(1).
+		Build()
+	campaigns, err := r.queryCampaigns(ctx, q)
+	if err != nil || len(campaigns) == 0 {
+		return nil, err
+	}
+	return campaigns[0], nil
+}
+
+func (r *CampaignRepository) queryCampaigns(ctx context.Context, q query.QueryResult) ([]*models.SystemCampaign, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns := make([]*models.SystemCampaign, 0)
+	for rows.Next() {
+		var c models.SystemCampaign
+		var statuses []byte
+		if err := rows.Scan(&c.ID, &c.Name, &c.Description, &c.Type, &c.Status, &c.StartDate, &c.EndDate,
+			&c.BudgetedCost, &c.ActualCost, &c.ExpectedRevenue, &statuses, &c.IsActive, &c.NumberOfMembers, &c.NumberOfResponses,
+			&c.NumberOfOpportunities, &c.NumberOfWonOpportunities, &c.AmountAllOpportunities, &c.AmountWonOpportunities,
+			&c.RollupsDate, &c.OwnerID, &c.CreatedDate, &c.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan campaign: %w", err)
+		}
+		if len(statuses) > 0 {
+			c.MemberStatuses = statuses
+		}
+		campaigns = append(campaigns, &c)
+	}
+	return campaigns, rows.Err()
+}
+
+// campaignValues returns the editable columns of a campaign
+func campaignValues(c *models.SystemCampaign) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysCampaign_Name:            c.Name,
+		constants.FieldSysCampaign_Description:     ToNullString(c.Description),
+		constants.FieldSysCampaign_Type:            ToNullString(c.Type),
+		constants.FieldSysCampaign_Status:          c.Status,
+		constants.FieldSysCampaign_StartDate:       campaignDate(c.StartDate),
+		constants.FieldSysCampaign_EndDate:         campaignDate(c.EndDate),
+		constants.FieldSysCampaign_BudgetedCost:    c.BudgetedCost,
+		constants.FieldSysCampaign_ActualCost:      c.ActualCost,
+		constants.FieldSysCampaign_ExpectedRevenue: c.ExpectedRevenue,
+		constants.FieldSysCampaign_MemberStatuses:  nullableJSON(c.MemberStatuses),
+		constants.FieldSysCampaign_IsActive:        c.IsActive,
+		constants.FieldSysCampaign_OwnerID:         c.OwnerID,
+	}
+}
+
+// campaignDate stores a date as YYYY-MM-DD, or NULL
+func campaignDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.DateOnly)
+}
+
+// Insert stores a new campaign
+func (r *CampaignRepository) Insert(ctx context.Context, c *models.SystemCampaign) error {
+	now := time.Now()
+	values := campaignValues(c)
+	values[constants.FieldSysCampaign_ID] = c.ID
+	values[constants.FieldSysCampaign_CreatedDate] = campaignTimestamp(now)
+	values[constants.FieldSysCampaign_LastModifiedDate] = campaignTimestamp(now)
+	q := query.Insert(constants.TableCampaign, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert campaign: %w", err)
+	}
+	c.CreatedDate = now
+	c.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites the editable fields of a campaign
+func (r *CampaignRepository) Update(ctx context.Context, c *models.SystemCampaign) error {
+	now := time.Now()
+	values := campaignValues(c)
+	values[constants.FieldSysCampaign_LastModifiedDate] = campaignTimestamp(now)
+	q := query.Update(constants.TableCampaign).
+		Set(values).
+		Where(constants.FieldSysCampaign_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update campaign: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// Delete removes a campaign and its members in one transaction
+func (r *CampaignRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	mq := query.Delete(constants.TableCampaignMember).
+		Where(constants.FieldSysCampaignMember_CampaignID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, mq.SQL, mq.Params...); err != nil {
+		return fmt.Errorf("failed to delete campaign members: %w", err)
+	}
+	cq := query.Delete(constants.TableCampaign).
+		Where(constants.FieldSysCampaign_ID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, cq.SQL, cq.Params...); err != nil {
+		return fmt.Errorf("failed to delete campaign: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ListMembers returns a page of a campaign's members in the order they were added
+func (r *CampaignRepository) ListMembers(ctx context.Context, campaignID string, limit, offset int) ([]*models.SystemCampaignMember, error) {
+	q := query.From(constants.TableCampaignMember).
+		Select(campaignMemberColumns).
+		Where(constants.FieldSysCampaignMember_CampaignID+" = ?", campaignID).
+		OrderBy(constants.FieldSysCampaignMember_CreatedDate, constants.SortASC).
+		Limit(limit).
+		Offset(offset).
+		Build()
+	return r.queryMembers(ctx, q)
+}
+
+// GetMember returns a campaign member by ID, or nil if not found
+func (r *CampaignRepository) GetMember(ctx context.Context, id string) (*models.SystemCampaignMember, error) {
+	q := query.From(constants.TableCampaignMember).
+		Select(campaignMemberColumns).
+		Where(constants.FieldSysCampaignMember_ID+" = ?", id).
+		Limit(1).
+		Build()
+	members, err := r.queryMembers(ctx, q)
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+	return members[0], nil
+}
+
+func (r *CampaignRepository) queryMembers(ctx context.Context, q query.QueryResult) ([]*models.SystemCampaignMember, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]*models.SystemCampaignMember, 0)
+	for rows.Next() {
+		var m models.SystemCampaignMember
+		if err := rows.Scan(&m.ID, &m.CampaignID, &m.ObjectAPIName, &m.RecordID, &m.Status, &m.HasResponded,
+			&m.FirstRespondedDate, &m.AddedByID, &m.CreatedDate, &m.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan campaign member: %w", err)
+		}
+		members = append(members, &m)
+	}
+	return members, rows.Err()
+}
+
+// ExistingMembers returns which of the given records of an object are already members of a campaign
+func (r *CampaignRepository) ExistingMembers(ctx context.Context, campaignID, objectAPIName string, recordIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(recordIDs) == 0 {
+		return existing, nil
+	}
+	params := []interface{}{campaignID, objectAPIName}
+	for _, id := range recordIDs {
+		params = append(params, id)
+	}
+	sqlStr := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ? AND %s = ? AND %s IN (%s)",
+		constants.FieldSysCampaignMember_RecordID, constants.TableCampaignMember,
+		constants.FieldSysCampaignMember_CampaignID, constants.FieldSysCampaignMember_ObjectAPIName,
+		constants.FieldSysCampaignMember_RecordID, strings.TrimSuffix(strings.Repeat("?, ", len(recordIDs)), ", "))
+	rows, err := r.db.QueryContext(ctx, sqlStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign members: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		existing[id] = true
+	}
+	return existing, rows.Err()
+}
+
+// InsertMembers stores new campaign members in one transaction
+func (r *CampaignRepository) InsertMembers(ctx context.Context, members []*models.SystemCampaignMember) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, m := range members {
+		q := query.Insert(constants.TableCampaignMember, map[string]interface{}{
+			constants.FieldSysCampaignMember_ID:                 m.ID,
+			constants.FieldSysCampaignMember_CampaignID:         m.CampaignID,
+			constants.FieldSysCampaignMember_ObjectAPIName:      m.ObjectAPIName,
+			constants.FieldSysCampaignMember_RecordID:           m.RecordID,
+			constants.FieldSysCampaignMember_Status:             m.Status,
+			constants.FieldSysCampaignMember_HasResponded:       m.HasResponded,
+			constants.FieldSysCampaignMember_FirstRespondedDate: campaignNullTimestamp(m.FirstRespondedDate),
+			constants.FieldSysCampaignMember_AddedByID:          m.AddedByID,
+			constants.FieldSysCampaignMember_CreatedDate:        campaignTimestamp(m.CreatedDate),
+			constants.FieldSysCampaignMember_LastModifiedDate:   campaignTimestamp(m.LastModifiedDate),
+		}).Build()
+		if _, err := tx.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+			return fmt.Errorf("failed to insert campaign member: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func campaignNullTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return campaignTimestamp(*t)
+}
+
+// UpdateMemberStatus stores the status and response of a campaign member
+func (r *CampaignRepository) UpdateMemberStatus(ctx context.Context, m *models.SystemCampaignMember) error {
+	m.LastModifiedDate = time.Now()
+	q := query.Update(constants.TableCampaignMember).
+		Set(map[string]interface{}{
+			constants.FieldSysCampaignMember_Status:             m.Status,
+			constants.FieldSysCampaignMember_HasResponded:       m.HasResponded,
+			constants.FieldSysCampaignMember_FirstRespondedDate: campaignNullTimestamp(m.FirstRespondedDate),
+			constants.FieldSysCampaignMember_LastModifiedDate:   campaignTimestamp(m.LastModifiedDate),
+		}).
+		Where(constants.FieldSysCampaignMember_ID+" = ?", m.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update campaign member: %w", err)
+	}
+	return nil
+}
+
+// DeleteMember removes a campaign member
+func (r *CampaignRepository) DeleteMember(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableCampaignMember).
+		Where(constants.FieldSysCampaignMember_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete campaign member: %w", err)
+	}
+	return nil
+}
+
+// StatusCounts counts the members of a campaign per status
+func (r *CampaignRepository) StatusCounts(ctx context.Context, campaignID string) (map[string]int, error) {
+	sqlStr := fmt.Sprintf("SELECT %s, COUNT(*) FROM %s WHERE %s = ? GROUP BY %s",
+		constants.FieldSysCampaignMember_Status, constants.TableCampaignMember,
+		constants.FieldSysCampaignMember_CampaignID, constants.FieldSysCampaignMember_Status)
+	rows, err := r.db.QueryContext(ctx, sqlStr, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count campaign members: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+// RefreshMemberCounts recounts the members and responses stored on a campaign
+func (r *CampaignRepository) RefreshMemberCounts(ctx context.Context, campaignID string) error {
+	count := fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s = ?%%s)",
+		constants.TableCampaignMember, constants.FieldSysCampaignMember_CampaignID)
+	sqlStr := fmt.Sprintf("UPDATE %s SET %s = %s, %s = %s WHERE %s = ?",
+		constants.TableCampaign,
+		constants.FieldSysCampaign_NumberOfMembers, fmt.Sprintf(count, ""),
+		constants.FieldSysCampaign_NumberOfResponses, fmt.Sprintf(count, " AND "+constants.FieldSysCampaignMember_HasResponded+" = true"),
+		constants.FieldSysCampaign_ID)
+	if _, err := r.db.ExecContext(ctx, sqlStr, campaignID, campaignID, campaignID); err != nil {
+		return fmt.Errorf("failed to count campaign members: %w", err)
+	}
+	return nil
+}
+
+// MemberObjects returns the objects a campaign has members of
+func (r *CampaignRepository) MemberObjects(ctx context.Context, campaignID string) ([]string, error) {
+	sqlStr := fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s = ?",
+		constants.FieldSysCampaignMember_ObjectAPIName, constants.TableCampaignMember, constants.FieldSysCampaignMember_CampaignID)
+	rows, err := r.db.QueryContext(ctx, sqlStr, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign member objects: %w", err)
+	}
+	defer rows.Close()
+
+	objects := make([]string, 0)
+	for rows.Next() {
+		var object string
+		if err := rows.Scan(&object); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, rows.Err()
+}
+
+// InfluencedRecords returns the live records of table whose lookupField references a member
+// of memberObject in the campaign, created once the member was added. An empty amountField
+// or stageField reads as 0 and ”.
+func (r *CampaignRepository) InfluencedRecords(ctx context.Context, campaignID, memberObject, table, lookupField, amountField, stageField string) ([]CampaignInfluence, error) {
+	for _, name := range []string{table, lookupField} {
+		if err := query.ValidateIdentifier(name); err != nil {
+			return nil, err
+		}
+	}
+	amount, stage := "0", "''"
+	if amountField != "" {
+		if err := query.ValidateIdentifier(amountField); err != nil {
+			return nil, err
+		}
+		amount = fmt.Sprintf("COALESCE(o.`%s`, 0)", amountField)
+	}
+	if stageField != "" {
+		if err := query.ValidateIdentifier(stageField); err != nil {
+			return nil, err
+		}
+		stage = fmt.Sprintf("COALESCE(o.`%s`, '')", stageField)
+	}
+	sqlStr := fmt.Sprintf("SELECT DISTINCT o.`%s`, %s, %s FROM `%s` o JOIN %s m ON m.%s = o.`%s` "+
+		"WHERE m.%s = ? AND m.%s = ? AND o.`%s` = false AND o.`%s` >= m.%s",
+		constants.FieldID, amount, stage, table, constants.TableCampaignMember, constants.FieldSysCampaignMember_RecordID, lookupField,
+		constants.FieldSysCampaignMember_CampaignID, constants.FieldSysCampaignMember_ObjectAPIName,
+		constants.FieldIsDeleted, constants.FieldCreatedDate, constants.FieldSysCampaignMember_CreatedDate)
+	rows, err := r.db.QueryContext(ctx, sqlStr, campaignID, memberObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query records influenced by campaign: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]CampaignInfluence, 0)
+	for rows.Next() {
+		var rec CampaignInfluence
+		if err := rows.Scan(&rec.RecordID, &rec.Amount, &rec.Stage); err != nil {
+			return nil, fmt.Errorf("failed to scan influenced record: %w", err)
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+// SetRollups stores the influence figures of a campaign
+func (r *CampaignRepository) SetRollups(ctx context.Context, campaignID string, rollups CampaignRollups, at time.Time) error {
+	q := query.Update(constants.TableCampaign).
+		Set(map[string]interface{}{
+			constants.FieldSysCampaign_NumberOfOpportunities:    rollups.Opportunities,
+			constants.FieldSysCampaign_NumberOfWonOpportunities: rollups.WonOpportunities,
+			constants.FieldSysCampaign_AmountAllOpportunities:   rollups.AmountAll,
+			constants.FieldSysCampaign_AmountWonOpportunities:   rollups.AmountWon,
+			constants.FieldSysCampaign_RollupsDate:              campaignTimestamp(at),
+		}).
+		Where(constants.FieldSysCampaign_ID+" = ?", campaignID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update campaign rollups: %w", err)
+	}
+	return nil
+}
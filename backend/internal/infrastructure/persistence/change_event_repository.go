@@ -0,0 +1,219 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ChangeEventRepository handles database operations for the change data capture stream
+// and its consumer offsets
+type ChangeEventRepository struct {
+	db *sql.DB
+}
+
+// NewChangeEventRepository creates a new ChangeEventRepository
+func NewChangeEventRepository(db *sql.DB) *ChangeEventRepository {
+	return &ChangeEventRepository{db: db}
+}
+
+var changeEventColumns = []string{
+	constants.FieldSysChangeEvent_ID,
+	constants.FieldSysChangeEvent_Position,
+	constants.FieldSysChangeEvent_ObjectAPIName,
+	constants.FieldSysChangeEvent_RecordID,
+	constants.FieldSysChangeEvent_Operation,
+	constants.FieldSysChangeEvent_TransactionID,
+	constants.FieldSysChangeEvent_CommitTimestamp,
+	constants.FieldSysChangeEvent_ChangedFields,
+	constants.FieldSysChangeEvent_BeforeData,
+	constants.FieldSysChangeEvent_AfterData,
+	constants.FieldSysChangeEvent_UserID,
+}
+
+// Append writes change events to the stream. Positions are assigned by the database, so
+// events must be appended inside the transaction that made the changes.
+func (r *ChangeEventRepository) Append(ctx context.Context, exec Executor, events []models.ChangeEvent) error {
+	if exec == nil {
+		exec = r.db
+	}
+	for _, e := range events {
+		changedFields, err := jsonValue(e.Header.ChangedFields)
+		if err != nil {
+			return err
+		}
+		before, err := changeEventImage(e.Before)
+		if err != nil {
+			return err
+		}
+		after, err := changeEventImage(e.After)
+		if err != nil {
+			return err
+		}
+		q := query.Insert(constants.TableChangeEvent, map[string]interface{}{
+			constants.FieldSysChangeEvent_ID:              utils.GenerateID(),
+			constants.FieldSysChangeEvent_ObjectAPIName:   e.Header.ObjectAPIName,
+			constants.FieldSysChangeEvent_RecordID:        e.Header.RecordID,
+			constants.FieldSysChangeEvent_Operation:       string(e.Header.Operation),
+			constants.FieldSysChangeEvent_TransactionID:   e.Header.TransactionID,
+			constants.FieldSysChangeEvent_CommitTimestamp: e.Header.CommitTimestamp,
+			constants.FieldSysChangeEvent_ChangedFields:   changedFields,
+			constants.FieldSysChangeEvent_BeforeData:      before,
+			constants.FieldSysChangeEvent_AfterData:       after,
+			constants.FieldSysChangeEvent_UserID:          e.Header.UserID,
+		}).Build()
+		if _, err := exec.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+			return fmt.Errorf("failed to append change event: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadFrom returns up to limit events after the given position in stream order, optionally
+// restricted to some objects. Events committed after settledBefore are left out.
+func (r *ChangeEventRepository) ReadFrom(ctx context.Context, from int64, objects []string, settledBefore time.Time, limit int) ([]models.ChangeEvent, error) {
+	b := query.From(constants.TableChangeEvent).
+		Select(changeEventColumns).
+		Where(constants.FieldSysChangeEvent_Position+" > ?", from).
+		Where(constants.FieldSysChangeEvent_CommitTimestamp+" <= ?", settledBefore)
+	if len(objects) > 0 {
+		args := make([]interface{}, len(objects))
+		for i, o := range objects {
+			args[i] = o
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(objects)), ", ")
+		b.Where(fmt.Sprintf("%s IN (%s)", constants.FieldSysChangeEvent_ObjectAPIName, placeholders), args...)
+	}
+	q := b.OrderBy(constants.FieldSysChangeEvent_Position, constants.SortASC).
+		Limit(limit).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read change events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]models.ChangeEvent, 0)
+	for rows.Next() {
+		var e models.ChangeEvent
+		var id, operation string
+		var changedFields, before, after, userID sql.NullString
+		if err := rows.Scan(&id, &e.Position, &e.Header.ObjectAPIName, &e.Header.RecordID, &operation, &e.Header.TransactionID,
+			&e.Header.CommitTimestamp, &changedFields, &before, &after, &userID); err != nil {
+			return nil, fmt.Errorf("failed to scan change event: %w", err)
+		}
+		e.Header.Operation = constants.ChangeOperation(operation)
+		e.Header.ChangedFields = []string{}
+		if changedFields.Valid {
+			_ = json.Unmarshal([]byte(changedFields.String), &e.Header.ChangedFields)
+		}
+		if before.Valid {
+			_ = json.Unmarshal([]byte(before.String), &e.Before)
+		}
+		if after.Valid {
+			_ = json.Unmarshal([]byte(after.String), &e.After)
+		}
+		if userID.Valid {
+			e.Header.UserID = &userID.String
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+var changeEventOffsetColumns = []string{
+	constants.FieldSysChangeEventOffset_ID,
+	constants.FieldSysChangeEventOffset_Consumer,
+	constants.FieldSysChangeEventOffset_Position,
+	constants.FieldSysChangeEventOffset_LastModifiedDate,
+}
+
+// GetOffsets queries the committed positions of all consumers
+func (r *ChangeEventRepository) GetOffsets(ctx context.Context) ([]models.ChangeEventOffset, error) {
+	q := query.From(constants.TableChangeEventOffset).
+		Select(changeEventOffsetColumns).
+		OrderBy(constants.FieldSysChangeEventOffset_Consumer, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query change event offsets: %w", err)
+	}
+	defer rows.Close()
+
+	offsets := make([]models.ChangeEventOffset, 0)
+	for rows.Next() {
+		var o models.ChangeEventOffset
+		var id string
+		if err := rows.Scan(&id, &o.Consumer, &o.Position, &o.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan change event offset: %w", err)
+		}
+		offsets = append(offsets, o)
+	}
+	return offsets, rows.Err()
+}
+
+// FindOffset queries the committed position of a consumer, or nil if it never committed one
+func (r *ChangeEventRepository) FindOffset(ctx context.Context, consumer string) (*models.ChangeEventOffset, error) {
+	q := query.From(constants.TableChangeEventOffset).
+		Select(changeEventOffsetColumns).
+		Where(constants.FieldSysChangeEventOffset_Consumer+" = ?", consumer).
+		Limit(1).
+		Build()
+
+	var o models.ChangeEventOffset
+	var id string
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&id, &o.Consumer, &o.Position, &o.LastModifiedDate)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query change event offset: %w", err)
+	}
+	return &o, nil
+}
+
+// SaveOffset inserts or moves the committed position of a consumer
+func (r *ChangeEventRepository) SaveOffset(ctx context.Context, consumer string, position int64) error {
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableChangeEventOffset, constants.FieldSysChangeEventOffset_ID, constants.FieldSysChangeEventOffset_Consumer,
+		constants.FieldSysChangeEventOffset_Position, constants.FieldSysChangeEventOffset_CreatedDate, constants.FieldSysChangeEventOffset_LastModifiedDate,
+		query.ActiveDialect().Upsert([]string{constants.FieldSysChangeEventOffset_Consumer}, []string{constants.FieldSysChangeEventOffset_Position},
+			fmt.Sprintf("%s = NOW()", constants.FieldSysChangeEventOffset_LastModifiedDate)))
+	if _, err := r.db.ExecContext(ctx, stmt, utils.GenerateID(), consumer, position); err != nil {
+		return fmt.Errorf("failed to save change event offset: %w", err)
+	}
+	return nil
+}
+
+// DeleteOffset removes a consumer's committed position, reporting whether it existed
+func (r *ChangeEventRepository) DeleteOffset(ctx context.Context, consumer string) (bool, error) {
+	q := query.Delete(constants.TableChangeEventOffset).
+		Where(constants.FieldSysChangeEventOffset_Consumer+" = ?", consumer).
+		Build()
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete change event offset: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// changeEventImage encodes a before or after image for its JSON column, keeping a missing image as NULL
+func changeEventImage(image models.SObject) (interface{}, error) {
+	if image == nil {
+		return nil, nil
+	}
+	return jsonValue(image)
+}
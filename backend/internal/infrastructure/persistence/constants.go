@@ -20,6 +20,7 @@ const (
 	SQLTypeTimestamp = "TIMESTAMP"
 	SQLTypeDate      = "DATE"
 	SQLTypeJSON      = "JSON"
+	SQLTypeVector    = "VECTOR"
 
 	// Standard SQL Type Definitions with Precision
 	SQLTypeVarchar255  = "VARCHAR(255)"
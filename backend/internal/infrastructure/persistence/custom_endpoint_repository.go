@@ -0,0 +1,204 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// CustomEndpointRepository handles database operations for custom REST endpoints
+type CustomEndpointRepository struct {
+	db *sql.DB
+}
+
+// NewCustomEndpointRepository creates a new CustomEndpointRepository
+func NewCustomEndpointRepository(db *sql.DB) *CustomEndpointRepository {
+	return &CustomEndpointRepository{db: db}
+}
+
+var customEndpointColumns = []string{
+	constants.FieldSysCustomEndpoint_ID,
+	constants.FieldSysCustomEndpoint_Name,
+	constants.FieldSysCustomEndpoint_Description,
+	constants.FieldSysCustomEndpoint_HttpMethod,
+	constants.FieldSysCustomEndpoint_Action,
+	constants.FieldSysCustomEndpoint_ScriptName,
+	constants.FieldSysCustomEndpoint_ObjectAPIName,
+	constants.FieldSysCustomEndpoint_FilterExpr,
+	constants.FieldSysCustomEndpoint_SortField,
+	constants.FieldSysCustomEndpoint_SortDirection,
+	constants.FieldSysCustomEndpoint_RowLimit,
+	constants.FieldSysCustomEndpoint_RecordID,
+	constants.FieldSysCustomEndpoint_FieldValues,
+	constants.FieldSysCustomEndpoint_InputSchema,
+	constants.FieldSysCustomEndpoint_ProfileIDs,
+	constants.FieldSysCustomEndpoint_IsActive,
+	constants.FieldSysCustomEndpoint_OwnerID,
+	constants.FieldSysCustomEndpoint_CreatedDate,
+	constants.FieldSysCustomEndpoint_LastModifiedDate,
+}
+
+// GetAll queries all custom endpoints ordered by name
+func (r *CustomEndpointRepository) GetAll(ctx context.Context) ([]*models.CustomEndpoint, error) {
+	q := query.From(constants.TableCustomEndpoint).
+		Select(customEndpointColumns).
+		OrderBy(constants.FieldSysCustomEndpoint_Name, constants.SortASC).
+		Build()
+	return r.queryEndpoints(ctx, q)
+}
+
+// FindByName queries a custom endpoint by name, or nil if not found
+func (r *CustomEndpointRepository) FindByName(ctx context.Context, name string) (*models.CustomEndpoint, error) {
+	q := query.From(constants.TableCustomEndpoint).
+		Select(customEndpointColumns).
+		Where(constants.FieldSysCustomEndpoint_Name+" = ?", name).
+		Limit(1).
+		Build()
+	endpoints, err := r.queryEndpoints(ctx, q)
+	if err != nil || len(endpoints) == 0 {
+		return nil, err
+	}
+	return endpoints[0], nil
+}
+
+func (r *CustomEndpointRepository) queryEndpoints(ctx context.Context, q query.QueryResult) ([]*models.CustomEndpoint, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := make([]*models.CustomEndpoint, 0)
+	for rows.Next() {
+		var e models.CustomEndpoint
+		var action string
+		var description, scriptName, objectName, filterExpr, sortField, sortDirection, recordID, ownerID sql.NullString
+		var fieldValues, inputSchema, profileIDs sql.NullString
+		var rowLimit sql.NullInt64
+		if err := rows.Scan(&e.ID, &e.Name, &description, &e.HTTPMethod, &action, &scriptName, &objectName,
+			&filterExpr, &sortField, &sortDirection, &rowLimit, &recordID, &fieldValues, &inputSchema, &profileIDs,
+			&e.IsActive, &ownerID, &e.CreatedDate, &e.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan custom endpoint: %w", err)
+		}
+		e.Action = constants.CustomEndpointAction(action)
+		e.Description = description.String
+		e.ScriptName = scriptName.String
+		e.ObjectAPIName = objectName.String
+		e.FilterExpr = filterExpr.String
+		e.SortField = sortField.String
+		e.SortDirection = sortDirection.String
+		e.RowLimit = int(rowLimit.Int64)
+		e.RecordID = recordID.String
+		e.OwnerID = ownerID.String
+		if err := unmarshalNullJSON(fieldValues, &e.FieldValues); err != nil {
+			return nil, fmt.Errorf("failed to decode field values of custom endpoint %s: %w", e.Name, err)
+		}
+		if err := unmarshalNullJSON(inputSchema, &e.InputSchema); err != nil {
+			return nil, fmt.Errorf("failed to decode input schema of custom endpoint %s: %w", e.Name, err)
+		}
+		if err := unmarshalNullJSON(profileIDs, &e.ProfileIDs); err != nil {
+			return nil, fmt.Errorf("failed to decode profiles of custom endpoint %s: %w", e.Name, err)
+		}
+		endpoints = append(endpoints, &e)
+	}
+	return endpoints, rows.Err()
+}
+
+// unmarshalNullJSON decodes a nullable JSON column, leaving v unchanged when it is NULL
+func unmarshalNullJSON(s sql.NullString, v interface{}) error {
+	if !s.Valid || s.String == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(s.String), v)
+}
+
+func customEndpointValues(e *models.CustomEndpoint) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		constants.FieldSysCustomEndpoint_Description:   nullableString(e.Description),
+		constants.FieldSysCustomEndpoint_HttpMethod:    e.HTTPMethod,
+		constants.FieldSysCustomEndpoint_Action:        string(e.Action),
+		constants.FieldSysCustomEndpoint_ScriptName:    nullableString(e.ScriptName),
+		constants.FieldSysCustomEndpoint_ObjectAPIName: nullableString(e.ObjectAPIName),
+		constants.FieldSysCustomEndpoint_FilterExpr:    nullableString(e.FilterExpr),
+		constants.FieldSysCustomEndpoint_SortField:     nullableString(e.SortField),
+		constants.FieldSysCustomEndpoint_SortDirection: nullableString(e.SortDirection),
+		constants.FieldSysCustomEndpoint_RowLimit:      nullableQuota(int64(e.RowLimit)),
+		constants.FieldSysCustomEndpoint_RecordID:      nullableString(e.RecordID),
+		constants.FieldSysCustomEndpoint_ProfileIDs:    SliceToNullJSON(e.ProfileIDs),
+		constants.FieldSysCustomEndpoint_IsActive:      e.IsActive,
+	}
+	for field, v := range map[string]interface{}{
+		constants.FieldSysCustomEndpoint_FieldValues: e.FieldValues,
+		constants.FieldSysCustomEndpoint_InputSchema: e.InputSchema,
+	} {
+		values[field] = nil
+		if v == nil {
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", field, err)
+		}
+		if string(data) != "null" && string(data) != "{}" {
+			values[field] = string(data)
+		}
+	}
+	return values, nil
+}
+
+// Insert inserts a custom endpoint
+func (r *CustomEndpointRepository) Insert(ctx context.Context, e *models.CustomEndpoint) error {
+	values, err := customEndpointValues(e)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysCustomEndpoint_ID] = e.ID
+	values[constants.FieldSysCustomEndpoint_Name] = e.Name
+	values[constants.FieldSysCustomEndpoint_OwnerID] = nullableString(e.OwnerID)
+	values[constants.FieldSysCustomEndpoint_CreatedDate] = now
+	values[constants.FieldSysCustomEndpoint_LastModifiedDate] = now
+	q := query.Insert(constants.TableCustomEndpoint, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert custom endpoint: %w", err)
+	}
+	e.CreatedDate = now
+	e.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites a custom endpoint's settings; the name cannot change
+func (r *CustomEndpointRepository) Update(ctx context.Context, e *models.CustomEndpoint) error {
+	values, err := customEndpointValues(e)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysCustomEndpoint_LastModifiedDate] = now
+	q := query.Update(constants.TableCustomEndpoint).
+		Set(values).
+		Where(constants.FieldSysCustomEndpoint_ID+" = ?", e.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update custom endpoint: %w", err)
+	}
+	e.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a custom endpoint
+func (r *CustomEndpointRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableCustomEndpoint).
+		Where(constants.FieldSysCustomEndpoint_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete custom endpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// CustomSettingRepository handles database operations for hierarchical custom settings
+type CustomSettingRepository struct {
+	db *sql.DB
+}
+
+// NewCustomSettingRepository creates a new CustomSettingRepository
+func NewCustomSettingRepository(db *sql.DB) *CustomSettingRepository {
+	return &CustomSettingRepository{db: db}
+}
+
+var customSettingColumns = []string{
+	constants.FieldSysCustomSetting_ID,
+	constants.FieldSysCustomSetting_Name,
+	constants.FieldSysCustomSetting_Label,
+	constants.FieldSysCustomSetting_Description,
+	constants.FieldSysCustomSetting_Type,
+	constants.FieldSysCustomSetting_DefaultValue,
+	constants.FieldSysCustomSetting_CreatedDate,
+	constants.FieldSysCustomSetting_LastModifiedDate,
+}
+
+var customSettingValueColumns = []string{
+	constants.FieldSysCustomSettingValue_ID,
+	constants.FieldSysCustomSettingValue_SettingName,
+	constants.FieldSysCustomSettingValue_ScopeType,
+	constants.FieldSysCustomSettingValue_ScopeID,
+	constants.FieldSysCustomSettingValue_Value,
+	constants.FieldSysCustomSettingValue_CreatedDate,
+	constants.FieldSysCustomSettingValue_LastModifiedDate,
+}
+
+// GetAll queries all custom settings ordered by name
+func (r *CustomSettingRepository) GetAll(ctx context.Context) ([]*models.CustomSetting, error) {
+	q := query.From(constants.TableCustomSetting).
+		Select(customSettingColumns).
+		OrderBy(constants.FieldSysCustomSetting_Name, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom settings: %w", err)
+	}
+	defer rows.Close()
+
+	settings := make([]*models.CustomSetting, 0)
+	for rows.Next() {
+		s, err := scanCustomSetting(rows)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, s)
+	}
+	return settings, rows.Err()
+}
+
+// FindByName queries a custom setting by name, or nil if not found
+func (r *CustomSettingRepository) FindByName(ctx context.Context, name string) (*models.CustomSetting, error) {
+	q := query.From(constants.TableCustomSetting).
+		Select(customSettingColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomSetting, constants.FieldSysCustomSetting_Name), name).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom setting: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanCustomSetting(rows)
+}
+
+func scanCustomSetting(rows *sql.Rows) (*models.CustomSetting, error) {
+	var s models.CustomSetting
+	var description, defaultValue sql.NullString
+	var settingType string
+	if err := rows.Scan(&s.ID, &s.Name, &s.Label, &description, &settingType, &defaultValue, &s.CreatedDate, &s.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan custom setting: %w", err)
+	}
+	s.Type = models.FieldType(settingType)
+	if description.Valid {
+		s.Description = &description.String
+	}
+	if defaultValue.Valid {
+		_ = json.Unmarshal([]byte(defaultValue.String), &s.DefaultValue)
+	}
+	return &s, nil
+}
+
+// Insert inserts a custom setting
+func (r *CustomSettingRepository) Insert(ctx context.Context, s *models.CustomSetting) error {
+	defaultValue, err := jsonValue(s.DefaultValue)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Insert(constants.TableCustomSetting, map[string]interface{}{
+		constants.FieldSysCustomSetting_ID:               s.ID,
+		constants.FieldSysCustomSetting_Name:             s.Name,
+		constants.FieldSysCustomSetting_Label:            s.Label,
+		constants.FieldSysCustomSetting_Description:      s.Description,
+		constants.FieldSysCustomSetting_Type:             string(s.Type),
+		constants.FieldSysCustomSetting_DefaultValue:     defaultValue,
+		constants.FieldSysCustomSetting_CreatedDate:      now,
+		constants.FieldSysCustomSetting_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert custom setting: %w", err)
+	}
+	s.CreatedDate = now
+	s.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites the label, description and org default of a custom setting
+func (r *CustomSettingRepository) Update(ctx context.Context, s *models.CustomSetting) error {
+	defaultValue, err := jsonValue(s.DefaultValue)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Update(constants.TableCustomSetting).
+		Set(map[string]interface{}{
+			constants.FieldSysCustomSetting_Label:            s.Label,
+			constants.FieldSysCustomSetting_Description:      s.Description,
+			constants.FieldSysCustomSetting_DefaultValue:     defaultValue,
+			constants.FieldSysCustomSetting_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysCustomSetting_ID+" = ?", s.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update custom setting: %w", err)
+	}
+	s.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a custom setting and its overrides
+func (r *CustomSettingRepository) Delete(ctx context.Context, s *models.CustomSetting) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	values := query.Delete(constants.TableCustomSettingValue).
+		Where(constants.FieldSysCustomSettingValue_SettingName+" = ?", s.Name).
+		Build()
+	if _, err := tx.ExecContext(ctx, values.SQL, values.Params...); err != nil {
+		return fmt.Errorf("failed to delete custom setting overrides: %w", err)
+	}
+	setting := query.Delete(constants.TableCustomSetting).
+		Where(constants.FieldSysCustomSetting_ID+" = ?", s.ID).
+		Build()
+	if _, err := tx.ExecContext(ctx, setting.SQL, setting.Params...); err != nil {
+		return fmt.Errorf("failed to delete custom setting: %w", err)
+	}
+	return tx.Commit()
+}
+
+// GetValues queries the overrides of a custom setting, or of all settings when settingName is empty
+func (r *CustomSettingRepository) GetValues(ctx context.Context, settingName string) ([]*models.CustomSettingValue, error) {
+	b := query.From(constants.TableCustomSettingValue).
+		Select(customSettingValueColumns).
+		OrderBy(constants.FieldSysCustomSettingValue_ScopeType, constants.SortASC)
+	if settingName != "" {
+		b = b.Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomSettingValue, constants.FieldSysCustomSettingValue_SettingName), settingName)
+	}
+	q := b.Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom setting overrides: %w", err)
+	}
+	defer rows.Close()
+
+	values := make([]*models.CustomSettingValue, 0)
+	for rows.Next() {
+		var v models.CustomSettingValue
+		var scopeType string
+		var value sql.NullString
+		if err := rows.Scan(&v.ID, &v.SettingName, &scopeType, &v.ScopeID, &value, &v.CreatedDate, &v.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan custom setting override: %w", err)
+		}
+		v.ScopeType = constants.CustomSettingScope(scopeType)
+		if value.Valid {
+			_ = json.Unmarshal([]byte(value.String), &v.Value)
+		}
+		values = append(values, &v)
+	}
+	return values, rows.Err()
+}
+
+// UpsertValue inserts or replaces the override of a custom setting for a profile or user
+func (r *CustomSettingRepository) UpsertValue(ctx context.Context, v *models.CustomSettingValue) error {
+	value, err := jsonValue(v.Value)
+	if err != nil {
+		return err
+	}
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableCustomSettingValue, constants.FieldSysCustomSettingValue_ID, constants.FieldSysCustomSettingValue_SettingName,
+		constants.FieldSysCustomSettingValue_ScopeType, constants.FieldSysCustomSettingValue_ScopeID, constants.FieldSysCustomSettingValue_Value,
+		constants.FieldSysCustomSettingValue_CreatedDate, constants.FieldSysCustomSettingValue_LastModifiedDate,
+		query.ActiveDialect().Upsert(
+			[]string{constants.FieldSysCustomSettingValue_SettingName, constants.FieldSysCustomSettingValue_ScopeType, constants.FieldSysCustomSettingValue_ScopeID},
+			[]string{constants.FieldSysCustomSettingValue_Value},
+			fmt.Sprintf("%s = NOW()", constants.FieldSysCustomSettingValue_LastModifiedDate)))
+	if _, err := r.db.ExecContext(ctx, stmt, v.ID, v.SettingName, string(v.ScopeType), v.ScopeID, value); err != nil {
+		return fmt.Errorf("failed to save custom setting override: %w", err)
+	}
+	return nil
+}
+
+// DeleteValue removes the override of a custom setting for a profile or user
+func (r *CustomSettingRepository) DeleteValue(ctx context.Context, settingName string, scopeType constants.CustomSettingScope, scopeID string) (bool, error) {
+	q := query.Delete(constants.TableCustomSettingValue).
+		Where(constants.FieldSysCustomSettingValue_SettingName+" = ?", settingName).
+		Where(constants.FieldSysCustomSettingValue_ScopeType+" = ?", string(scopeType)).
+		Where(constants.FieldSysCustomSettingValue_ScopeID+" = ?", scopeID).
+		Build()
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// jsonValue encodes a value for a JSON column, keeping nil as NULL
+func jsonValue(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,256 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// DataQualityRepository handles database operations for data quality rules and record scores
+type DataQualityRepository struct {
+	db *sql.DB
+}
+
+// NewDataQualityRepository creates a new DataQualityRepository
+func NewDataQualityRepository(db *sql.DB) *DataQualityRepository {
+	return &DataQualityRepository{db: db}
+}
+
+// DataQualityStats aggregates the stored scores of one object
+type DataQualityStats struct {
+	ObjectAPIName       string
+	ScoredRecords       int
+	AverageCompleteness float64
+	AverageQuality      float64
+	ProbableDuplicates  int
+	Poor, Fair, Good    int
+	LastScoredDate      *time.Time
+}
+
+// Quality score bands used by the dashboard
+const (
+	qualityFairMin = 50
+	qualityGoodMin = 80
+)
+
+var dataQualityScoreColumns = []string{
+	constants.FieldSysDataQualityScore_ID,
+	constants.FieldSysDataQualityScore_ObjectAPIName,
+	constants.FieldSysDataQualityScore_RecordID,
+	constants.FieldSysDataQualityScore_CompletenessScore,
+	constants.FieldSysDataQualityScore_DuplicateScore,
+	constants.FieldSysDataQualityScore_DuplicateOf,
+	constants.FieldSysDataQualityScore_QualityScore,
+	constants.FieldSysDataQualityScore_MissingFields,
+	constants.FieldSysDataQualityScore_ScoredDate,
+}
+
+// GetRule returns the data quality rule of an object, or nil if none is configured
+func (r *DataQualityRepository) GetRule(ctx context.Context, objectAPIName string) (*models.SystemDataQualityRule, error) {
+	q := query.From(constants.TableDataQualityRule).
+		Select([]string{
+			constants.FieldSysDataQualityRule_ObjectAPIName,
+			constants.FieldSysDataQualityRule_CompletenessFields,
+			constants.FieldSysDataQualityRule_MatchFields,
+			constants.FieldSysDataQualityRule_DuplicateThreshold,
+			constants.FieldSysDataQualityRule_UseEmbeddings,
+		}).
+		Where(constants.FieldSysDataQualityRule_ObjectAPIName+" = ?", objectAPIName).
+		Limit(1).
+		Build()
+
+	var rule models.SystemDataQualityRule
+	var completeness, match []byte
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&rule.ID, &rule.ObjectAPIName, &completeness, &match, &rule.DuplicateThreshold, &rule.UseEmbeddings)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rule.CompletenessFields = completeness
+	rule.MatchFields = match
+	return &rule, nil
+}
+
+// UpsertRule creates or replaces the data quality rule of an object
+func (r *DataQualityRepository) UpsertRule(ctx context.Context, rule *models.SystemDataQualityRule) error {
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableDataQualityRule, constants.FieldSysDataQualityRule_ID, constants.FieldSysDataQualityRule_ObjectAPIName,
+		constants.FieldSysDataQualityRule_CompletenessFields, constants.FieldSysDataQualityRule_MatchFields,
+		constants.FieldSysDataQualityRule_DuplicateThreshold, constants.FieldSysDataQualityRule_UseEmbeddings,
+		constants.FieldSysDataQualityRule_CreatedDate, constants.FieldSysDataQualityRule_LastModifiedDate,
+		query.ActiveDialect().Upsert([]string{constants.FieldSysDataQualityRule_ObjectAPIName}, []string{
+			constants.FieldSysDataQualityRule_CompletenessFields, constants.FieldSysDataQualityRule_MatchFields,
+			constants.FieldSysDataQualityRule_DuplicateThreshold, constants.FieldSysDataQualityRule_UseEmbeddings,
+		}, fmt.Sprintf("%s = NOW()", constants.FieldSysDataQualityRule_LastModifiedDate)))
+	_, err := r.db.ExecContext(ctx, stmt, rule.ID, rule.ObjectAPIName, nullableJSON(rule.CompletenessFields),
+		nullableJSON(rule.MatchFields), rule.DuplicateThreshold, rule.UseEmbeddings)
+	if err != nil {
+		return fmt.Errorf("failed to save data quality rule: %w", err)
+	}
+	return nil
+}
+
+// UpsertScores writes record scores, replacing earlier scores of the same records
+func (r *DataQualityRepository) UpsertScores(ctx context.Context, scores []*models.SystemDataQualityScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	updated := []string{
+		constants.FieldSysDataQualityScore_CompletenessScore,
+		constants.FieldSysDataQualityScore_DuplicateScore,
+		constants.FieldSysDataQualityScore_DuplicateOf,
+		constants.FieldSysDataQualityScore_QualityScore,
+		constants.FieldSysDataQualityScore_MissingFields,
+		constants.FieldSysDataQualityScore_ScoredDate,
+	}
+	upsert := query.ActiveDialect().Upsert(
+		[]string{constants.FieldSysDataQualityScore_ObjectAPIName, constants.FieldSysDataQualityScore_RecordID},
+		updated, constants.FieldSysDataQualityScore_LastModifiedDate+" = NOW()")
+
+	placeholders := make([]string, len(scores))
+	params := make([]interface{}, 0, len(scores)*len(dataQualityScoreColumns))
+	for i, s := range scores {
+		placeholders[i] = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(dataQualityScoreColumns)), ", ") + ", NOW(), NOW())"
+		params = append(params, s.ID, s.ObjectAPIName, s.RecordID, s.CompletenessScore, s.DuplicateScore,
+			s.DuplicateOf, s.QualityScore, nullableJSON(s.MissingFields), s.ScoredDate)
+	}
+
+	stmt := fmt.Sprintf("%s %s (%s, %s, %s) %s %s %s",
+		KeywordInsertInto, constants.TableDataQualityScore, strings.Join(dataQualityScoreColumns, ", "),
+		constants.FieldSysDataQualityScore_CreatedDate, constants.FieldSysDataQualityScore_LastModifiedDate,
+		KeywordValues, strings.Join(placeholders, ", "), upsert)
+	if _, err := r.db.ExecContext(ctx, stmt, params...); err != nil {
+		return fmt.Errorf("failed to save data quality scores: %w", err)
+	}
+	return nil
+}
+
+// DeleteScoresBefore removes an object's scores written before a run started; they belong
+// to records deleted since the previous run
+func (r *DataQualityRepository) DeleteScoresBefore(ctx context.Context, objectAPIName string, before time.Time) error {
+	q := query.Delete(constants.TableDataQualityScore).
+		Where(constants.FieldSysDataQualityScore_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysDataQualityScore_ScoredDate+" < ?", before).
+		Build()
+
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// Stats aggregates the stored scores of every scored object
+func (r *DataQualityRepository) Stats(ctx context.Context) ([]DataQualityStats, error) {
+	stmt := fmt.Sprintf(`SELECT %s, COUNT(*), AVG(%s), AVG(%s),
+		SUM(CASE WHEN %s IS NOT NULL THEN 1 ELSE 0 END),
+		SUM(CASE WHEN %s < %d THEN 1 ELSE 0 END),
+		SUM(CASE WHEN %s >= %d AND %s < %d THEN 1 ELSE 0 END),
+		SUM(CASE WHEN %s >= %d THEN 1 ELSE 0 END),
+		MAX(%s)
+		FROM %s GROUP BY %s ORDER BY %s`,
+		constants.FieldSysDataQualityScore_ObjectAPIName,
+		constants.FieldSysDataQualityScore_CompletenessScore, constants.FieldSysDataQualityScore_QualityScore,
+		constants.FieldSysDataQualityScore_DuplicateOf,
+		constants.FieldSysDataQualityScore_QualityScore, qualityFairMin,
+		constants.FieldSysDataQualityScore_QualityScore, qualityFairMin, constants.FieldSysDataQualityScore_QualityScore, qualityGoodMin,
+		constants.FieldSysDataQualityScore_QualityScore, qualityGoodMin,
+		constants.FieldSysDataQualityScore_ScoredDate,
+		constants.TableDataQualityScore, constants.FieldSysDataQualityScore_ObjectAPIName, constants.FieldSysDataQualityScore_ObjectAPIName)
+
+	rows, err := r.db.QueryContext(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]DataQualityStats, 0)
+	for rows.Next() {
+		var s DataQualityStats
+		var lastScored sql.NullTime
+		if err := rows.Scan(&s.ObjectAPIName, &s.ScoredRecords, &s.AverageCompleteness, &s.AverageQuality,
+			&s.ProbableDuplicates, &s.Poor, &s.Fair, &s.Good, &lastScored); err != nil {
+			return nil, err
+		}
+		if lastScored.Valid {
+			s.LastScoredDate = &lastScored.Time
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+// MissingFieldCounts counts, per field, the scored records of an object missing it
+func (r *DataQualityRepository) MissingFieldCounts(ctx context.Context, objectAPIName string) (map[string]int, error) {
+	q := query.From(constants.TableDataQualityScore).
+		Select([]string{constants.FieldSysDataQualityScore_MissingFields}).
+		Where(constants.FieldSysDataQualityScore_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysDataQualityScore_MissingFields + " IS NOT NULL").
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var id string
+		var raw []byte
+		if err := rows.Scan(&id, &raw); err != nil {
+			return nil, err
+		}
+		var fields []string
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			continue
+		}
+		for _, f := range fields {
+			counts[f]++
+		}
+	}
+	return counts, rows.Err()
+}
+
+// ListScores returns an object's scores, lowest quality first. maxQuality < 100 keeps only
+// records scoring at or below it; duplicatesOnly keeps only probable duplicates.
+func (r *DataQualityRepository) ListScores(ctx context.Context, objectAPIName string, maxQuality int, duplicatesOnly bool, limit int) ([]*models.SystemDataQualityScore, error) {
+	b := query.From(constants.TableDataQualityScore).
+		Select(dataQualityScoreColumns).
+		Where(constants.FieldSysDataQualityScore_ObjectAPIName+" = ?", objectAPIName)
+	if maxQuality < 100 {
+		b = b.Where(constants.FieldSysDataQualityScore_QualityScore+" <= ?", maxQuality)
+	}
+	if duplicatesOnly {
+		b = b.Where(constants.FieldSysDataQualityScore_DuplicateOf + " IS NOT NULL")
+	}
+	q := b.OrderBy(constants.FieldSysDataQualityScore_QualityScore, constants.SortASC).Limit(limit).Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make([]*models.SystemDataQualityScore, 0)
+	for rows.Next() {
+		var s models.SystemDataQualityScore
+		var missing []byte
+		if err := rows.Scan(&s.ID, &s.ObjectAPIName, &s.RecordID, &s.CompletenessScore, &s.DuplicateScore,
+			&s.DuplicateOf, &s.QualityScore, &missing, &s.ScoredDate); err != nil {
+			return nil, err
+		}
+		s.MissingFields = missing
+		scores = append(scores, &s)
+	}
+	return scores, rows.Err()
+}
@@ -0,0 +1,131 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// DeletedMetadataRepository handles database operations for erased objects and fields
+// awaiting restore or purge
+type DeletedMetadataRepository struct {
+	db *sql.DB
+}
+
+// NewDeletedMetadataRepository creates a new DeletedMetadataRepository
+func NewDeletedMetadataRepository(db *sql.DB) *DeletedMetadataRepository {
+	return &DeletedMetadataRepository{db: db}
+}
+
+var deletedMetadataColumns = []string{
+	constants.FieldSysDeletedMetadata_ID,
+	constants.FieldSysDeletedMetadata_ComponentType,
+	constants.FieldSysDeletedMetadata_ObjectAPIName,
+	constants.FieldSysDeletedMetadata_FieldAPIName,
+	constants.FieldSysDeletedMetadata_StorageName,
+	constants.FieldSysDeletedMetadata_Metadata,
+	constants.FieldSysDeletedMetadata_DeletedByID,
+	constants.FieldSysDeletedMetadata_DeletedDate,
+	constants.FieldSysDeletedMetadata_PurgeAfter,
+}
+
+// Insert records an erased component
+func (r *DeletedMetadataRepository) Insert(ctx context.Context, d *models.SystemDeletedMetadata) error {
+	q := query.Insert(constants.TableDeletedMetadata, map[string]interface{}{
+		constants.FieldSysDeletedMetadata_ID:               d.ID,
+		constants.FieldSysDeletedMetadata_ComponentType:    d.ComponentType,
+		constants.FieldSysDeletedMetadata_ObjectAPIName:    d.ObjectAPIName,
+		constants.FieldSysDeletedMetadata_FieldAPIName:     d.FieldAPIName,
+		constants.FieldSysDeletedMetadata_StorageName:      d.StorageName,
+		constants.FieldSysDeletedMetadata_Metadata:         nullableJSON(d.Metadata),
+		constants.FieldSysDeletedMetadata_DeletedByID:      d.DeletedByID,
+		constants.FieldSysDeletedMetadata_DeletedDate:      d.DeletedDate,
+		constants.FieldSysDeletedMetadata_PurgeAfter:       d.PurgeAfter,
+		constants.FieldSysDeletedMetadata_CreatedDate:      d.DeletedDate,
+		constants.FieldSysDeletedMetadata_LastModifiedDate: d.DeletedDate,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to record deleted metadata: %w", err)
+	}
+	return nil
+}
+
+// Get returns an erased component by ID, or nil if it does not exist
+func (r *DeletedMetadataRepository) Get(ctx context.Context, id string) (*models.SystemDeletedMetadata, error) {
+	q := query.From(constants.TableDeletedMetadata).
+		Select(deletedMetadataColumns).
+		Where(constants.FieldSysDeletedMetadata_ID+" = ?", id).
+		Build()
+	entries, err := r.find(ctx, q)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return entries[0], nil
+}
+
+// List returns every erased component, most recently deleted first
+func (r *DeletedMetadataRepository) List(ctx context.Context) ([]*models.SystemDeletedMetadata, error) {
+	q := query.From(constants.TableDeletedMetadata).
+		Select(deletedMetadataColumns).
+		OrderBy(constants.FieldSysDeletedMetadata_DeletedDate, constants.SortDESC).
+		Build()
+	return r.find(ctx, q)
+}
+
+// ListExpired returns the erased components whose grace period ended before now, oldest
+// first so fields are purged before a later-erased object
+func (r *DeletedMetadataRepository) ListExpired(ctx context.Context, now time.Time) ([]*models.SystemDeletedMetadata, error) {
+	q := query.From(constants.TableDeletedMetadata).
+		Select(deletedMetadataColumns).
+		Where(constants.FieldSysDeletedMetadata_PurgeAfter+" <= ?", now).
+		OrderBy(constants.FieldSysDeletedMetadata_DeletedDate, constants.SortASC).
+		Build()
+	return r.find(ctx, q)
+}
+
+// Delete removes the record of an erased component
+func (r *DeletedMetadataRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableDeletedMetadata).
+		Where(constants.FieldSysDeletedMetadata_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete deleted metadata %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *DeletedMetadataRepository) find(ctx context.Context, q query.QueryResult) ([]*models.SystemDeletedMetadata, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted metadata: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*models.SystemDeletedMetadata, 0)
+	for rows.Next() {
+		var d models.SystemDeletedMetadata
+		var fieldAPIName, storageName, deletedByID sql.NullString
+		var metadata []byte
+		if err := rows.Scan(&d.ID, &d.ComponentType, &d.ObjectAPIName, &fieldAPIName, &storageName,
+			&metadata, &deletedByID, &d.DeletedDate, &d.PurgeAfter); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted metadata: %w", err)
+		}
+		if fieldAPIName.Valid {
+			d.FieldAPIName = &fieldAPIName.String
+		}
+		if storageName.Valid {
+			d.StorageName = &storageName.String
+		}
+		if deletedByID.Valid {
+			d.DeletedByID = &deletedByID.String
+		}
+		d.Metadata = metadata
+		entries = append(entries, &d)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// DocumentTemplateRepository handles database operations for document templates
+type DocumentTemplateRepository struct {
+	db *sql.DB
+}
+
+// NewDocumentTemplateRepository creates a new DocumentTemplateRepository
+func NewDocumentTemplateRepository(db *sql.DB) *DocumentTemplateRepository {
+	return &DocumentTemplateRepository{db: db}
+}
+
+var documentTemplateColumns = []string{
+	constants.FieldSysDocumentTemplate_ID,
+	constants.FieldSysDocumentTemplate_Name,
+	constants.FieldSysDocumentTemplate_Label,
+	constants.FieldSysDocumentTemplate_ObjectAPIName,
+	constants.FieldSysDocumentTemplate_TemplateType,
+	constants.FieldSysDocumentTemplate_Content,
+	constants.FieldSysDocumentTemplate_FileName,
+	constants.FieldSysDocumentTemplate_PageSize,
+	constants.FieldSysDocumentTemplate_IsActive,
+	constants.FieldSysDocumentTemplate_CreatedDate,
+	constants.FieldSysDocumentTemplate_LastModifiedDate,
+}
+
+// List queries document templates ordered by label, optionally only those of one object
+func (r *DocumentTemplateRepository) List(ctx context.Context, objectAPIName string) ([]*models.SystemDocumentTemplate, error) {
+	builder := query.From(constants.TableDocumentTemplate).Select(documentTemplateColumns)
+	if objectAPIName != "" {
+		builder = builder.Where(constants.FieldSysDocumentTemplate_ObjectAPIName+" = ?", objectAPIName)
+	}
+	q := builder.OrderBy(constants.FieldSysDocumentTemplate_Label, constants.SortASC).Build()
+	return r.find(ctx, q)
+}
+
+// Find queries a document template by ID, or nil if not found
+func (r *DocumentTemplateRepository) Find(ctx context.Context, id string) (*models.SystemDocumentTemplate, error) {
+	q := query.From(constants.TableDocumentTemplate).
+		Select(documentTemplateColumns).
+		Where(constants.FieldSysDocumentTemplate_ID+" = ?", id).
+		Limit(1).
+		Build()
+	templates, err := r.find(ctx, q)
+	if err != nil || len(templates) == 0 {
+		return nil, err
+	}
+	return templates[0], nil
+}
+
+// FindByName queries a document template by name, or nil if not found
+func (r *DocumentTemplateRepository) FindByName(ctx context.Context, name string) (*models.SystemDocumentTemplate, error) {
+	q := query.From(constants.TableDocumentTemplate).
+		Select(documentTemplateColumns).
+		Where(constants.FieldSysDocumentTemplate_Name+" = ?", name).
+		Limit(1).
+		Build()
+	templates, err := r.find(ctx, q)
+	if err != nil || len(templates) == 0 {
+		return nil, err
+	}
+	return templates[0], nil
+}
+
+func (r *DocumentTemplateRepository) find(ctx context.Context, q query.QueryResult) ([]*models.SystemDocumentTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query document templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := make([]*models.SystemDocumentTemplate, 0)
+	for rows.Next() {
+		var t models.SystemDocumentTemplate
+		var fileName sql.NullString
+		if err := rows.Scan(&t.ID, &t.Name, &t.Label, &t.ObjectAPIName, &t.TemplateType, &t.Content, &fileName,
+			&t.PageSize, &t.IsActive, &t.CreatedDate, &t.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan document template: %w", err)
+		}
+		t.FileName = nullStringPtr(fileName)
+		templates = append(templates, &t)
+	}
+	return templates, rows.Err()
+}
+
+// Insert inserts a document template
+func (r *DocumentTemplateRepository) Insert(ctx context.Context, t *models.SystemDocumentTemplate) error {
+	now := time.Now()
+	q := query.Insert(constants.TableDocumentTemplate, map[string]interface{}{
+		constants.FieldSysDocumentTemplate_ID:               t.ID,
+		constants.FieldSysDocumentTemplate_Name:             t.Name,
+		constants.FieldSysDocumentTemplate_Label:            t.Label,
+		constants.FieldSysDocumentTemplate_ObjectAPIName:    t.ObjectAPIName,
+		constants.FieldSysDocumentTemplate_TemplateType:     t.TemplateType,
+		constants.FieldSysDocumentTemplate_Content:          t.Content,
+		constants.FieldSysDocumentTemplate_FileName:         t.FileName,
+		constants.FieldSysDocumentTemplate_PageSize:         t.PageSize,
+		constants.FieldSysDocumentTemplate_IsActive:         t.IsActive,
+		constants.FieldSysDocumentTemplate_CreatedDate:      now,
+		constants.FieldSysDocumentTemplate_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert document template: %w", err)
+	}
+	t.CreatedDate = now
+	t.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites a document template's settings
+func (r *DocumentTemplateRepository) Update(ctx context.Context, t *models.SystemDocumentTemplate) error {
+	now := time.Now()
+	q := query.Update(constants.TableDocumentTemplate).
+		Set(map[string]interface{}{
+			constants.FieldSysDocumentTemplate_Label:            t.Label,
+			constants.FieldSysDocumentTemplate_ObjectAPIName:    t.ObjectAPIName,
+			constants.FieldSysDocumentTemplate_TemplateType:     t.TemplateType,
+			constants.FieldSysDocumentTemplate_Content:          t.Content,
+			constants.FieldSysDocumentTemplate_FileName:         t.FileName,
+			constants.FieldSysDocumentTemplate_PageSize:         t.PageSize,
+			constants.FieldSysDocumentTemplate_IsActive:         t.IsActive,
+			constants.FieldSysDocumentTemplate_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysDocumentTemplate_ID+" = ?", t.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update document template: %w", err)
+	}
+	t.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a document template
+func (r *DocumentTemplateRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableDocumentTemplate).
+		Where(constants.FieldSysDocumentTemplate_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete document template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// EntitlementRepository handles database operations for support contracts, entitlements and
+// the units cases consume from them
+type EntitlementRepository struct {
+	db *sql.DB
+}
+
+// NewEntitlementRepository creates a new EntitlementRepository
+func NewEntitlementRepository(db *sql.DB) *EntitlementRepository {
+	return &EntitlementRepository{db: db}
+}
+
+func (r *EntitlementRepository) executor(tx *sql.Tx) Executor {
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// entitlementTimestamp formats dates like record system dates
+func entitlementTimestamp(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// entitlementDate stores a date as YYYY-MM-DD, or NULL
+func entitlementDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.DateOnly)
+}
+
+var contractColumns = []string{
+	constants.FieldSysContract_Name,
+	constants.FieldSysContract_AccountID,
+	constants.FieldSysContract_Status,
+	constants.FieldSysContract_StartDate,
+	constants.FieldSysContract_EndDate,
+	constants.FieldSysContract_Description,
+	constants.FieldSysContract_OwnerID,
+	constants.FieldSysContract_CreatedDate,
+	constants.FieldSysContract_LastModifiedDate,
+}
+
+var entitlementColumns = []string{
+	constants.FieldSysEntitlement_Name,
+	constants.FieldSysEntitlement_AccountID,
+	constants.FieldSysEntitlement_ContractID,
+	constants.FieldSysEntitlement_Type,
+	constants.FieldSysEntitlement_StartDate,
+	constants.FieldSysEntitlement_EndDate,
+	constants.FieldSysEntitlement_TotalUnits,
+	constants.FieldSysEntitlement_RemainingUnits,
+	constants.FieldSysEntitlement_IsActive,
+	constants.FieldSysEntitlement_OwnerID,
+	constants.FieldSysEntitlement_CreatedDate,
+	constants.FieldSysEntitlement_LastModifiedDate,
+}
+
+var entitlementUsageColumns = []string{
+	constants.FieldSysEntitlementUsage_EntitlementID,
+	constants.FieldSysEntitlementUsage_ObjectAPIName,
+	constants.FieldSysEntitlementUsage_RecordID,
+	constants.FieldSysEntitlementUsage_Units,
+	constants.FieldSysEntitlementUsage_ConsumedByID,
+	constants.FieldSysEntitlementUsage_CreatedDate,
+	constants.FieldSysEntitlementUsage_LastModifiedDate,
+}
+
+// ListContracts returns the contracts of an account, or every contract when accountID is
+// empty, most recent first
+func (r *EntitlementRepository) ListContracts(ctx context.Context, accountID string) ([]*models.SystemContract, error) {
+	b := query.From(constants.TableContract).Select(contractColumns)
+	if accountID != "" {
+		b = b.Where(constants.FieldSysContract_AccountID+" = ?", accountID)
+	}
+	q := b.OrderBy(constants.FieldSysContract_StartDate, constants.SortDESC).Build()
+	return r.queryContracts(ctx, nil, q)
+}
+
+// GetContract returns a contract by ID, or nil if not found. tx may be nil.
+func (r *EntitlementRepository) GetContract(ctx context.Context, tx *sql.Tx, id string) (*models.SystemContract, error) {
+	q := query.From(constants.TableContract).
+		Select(contractColumns).
+		Where(constants.FieldSysContract_ID+" = ?", id).
+		Limit(1).
+		Build()
+	contracts, err := r.queryContracts(ctx, tx, q)
+	if err != nil || len(contracts) == 0 {
+		return nil, err
+	}
+	return contracts[0], nil
+}
+
+func (r *EntitlementRepository) queryContracts(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemContract, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contracts: %w", err)
+	}
+	defer rows.Close()
+
+	contracts := make([]*models.SystemContract, 0)
+	for rows.Next() {
+		var c models.SystemContract
+		if err := rows.Scan(&c.ID, &c.Name, &c.AccountID, &c.Status, &c.StartDate, &c.EndDate, &c.Description,
+			&c.OwnerID, &c.CreatedDate, &c.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan contract: %w", err)
+		}
+		contracts = append(contracts, &c)
+	}
+	return contracts, rows.Err()
+}
+
+// contractValues returns the editable columns of a contract
+func contractValues(c *models.SystemContract) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysContract_Name:        c.Name,
+		constants.FieldSysContract_AccountID:   c.AccountID,
+		constants.FieldSysContract_Status:      c.Status,
+		constants.FieldSysContract_StartDate:   entitlementDate(&c.StartDate),
+		constants.FieldSysContract_EndDate:     entitlementDate(c.EndDate),
+		constants.FieldSysContract_Description: ToNullString(c.Description),
+		constants.FieldSysContract_OwnerID:     c.OwnerID,
+	}
+}
+
+// InsertContract stores a new contract
+func (r *EntitlementRepository) InsertContract(ctx context.Context, c *models.SystemContract) error {
+	now := time.Now()
+	values := contractValues(c)
+	values[constants.FieldSysContract_ID] = c.ID
+	values[constants.FieldSysContract_CreatedDate] = entitlementTimestamp(now)
+	values[constants.FieldSysContract_LastModifiedDate] = entitlementTimestamp(now)
+	q := query.Insert(constants.TableContract, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert contract: %w", err)
+	}
+	c.CreatedDate = now
+	c.LastModifiedDate = now
+	return nil
+}
+
+// UpdateContract overwrites the editable fields of a contract
+func (r *EntitlementRepository) UpdateContract(ctx context.Context, c *models.SystemContract) error {
+	now := time.Now()
+	values := contractValues(c)
+	values[constants.FieldSysContract_LastModifiedDate] = entitlementTimestamp(now)
+	q := query.Update(constants.TableContract).
+		Set(values).
+		Where(constants.FieldSysContract_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update contract: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// DeleteContract removes a contract
+func (r *EntitlementRepository) DeleteContract(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableContract).
+		Where(constants.FieldSysContract_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete contract: %w", err)
+	}
+	return nil
+}
+
+// ListEntitlements returns the entitlements of an account, or every entitlement when
+// accountID is empty, most recent first
+func (r *EntitlementRepository) ListEntitlements(ctx context.Context, accountID string) ([]*models.SystemEntitlement, error) {
+	b := query.From(constants.TableEntitlement).Select(entitlementColumns)
+	if accountID != "" {
+		b = b.Where(constants.FieldSysEntitlement_AccountID+" = ?", accountID)
+	}
+	q := b.OrderBy(constants.FieldSysEntitlement_StartDate, constants.SortDESC).Build()
+	return r.queryEntitlements(ctx, nil, q)
+}
+
+// CountContractEntitlements returns the number of entitlements of a contract
+func (r *EntitlementRepository) CountContractEntitlements(ctx context.Context, contractID string) (int, error) {
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?", constants.TableEntitlement, constants.FieldSysEntitlement_ContractID)
+	var count int
+	if err := r.db.QueryRowContext(ctx, sqlStr, contractID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count entitlements: %w", err)
+	}
+	return count, nil
+}
+
+// GetEntitlement returns an entitlement by ID, or nil if not found
+func (r *EntitlementRepository) GetEntitlement(ctx context.Context, id string) (*models.SystemEntitlement, error) {
+	return r.getEntitlement(ctx, nil, id, false)
+}
+
+// GetEntitlementLock returns an entitlement by ID locked for update within tx, or nil if not found
+func (r *EntitlementRepository) GetEntitlementLock(ctx context.Context, tx *sql.Tx, id string) (*models.SystemEntitlement, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction required for locking entitlement %s", id)
+	}
+	return r.getEntitlement(ctx, tx, id, true)
+}
+
+func (r *EntitlementRepository) getEntitlement(ctx context.Context, tx *sql.Tx, id string, lock bool) (*models.SystemEntitlement, error) {
+	q := query.From(constants.TableEntitlement).
+		Select(entitlementColumns).
+		Where(constants.FieldSysEntitlement_ID+" = ?", id).
+		Limit(1).
+		Build()
+	if lock {
+		q.SQL += " FOR UPDATE"
+	}
+	entitlements, err := r.queryEntitlements(ctx, tx, q)
+	if err != nil || len(entitlements) == 0 {
+		return nil, err
+	}
+	return entitlements[0], nil
+}
+
+// ActiveEntitlementIDs returns the IDs of an account's active entitlements in effect on a
+// date (YYYY-MM-DD), oldest first
+func (r *EntitlementRepository) ActiveEntitlementIDs(ctx context.Context, tx *sql.Tx, accountID, date string) ([]string, error) {
+	q := query.From(constants.TableEntitlement).
+		Select([]string{constants.FieldSysEntitlement_ID}).
+		Where(constants.FieldSysEntitlement_AccountID+" = ?", accountID).
+		Where(constants.FieldSysEntitlement_IsActive+" = ?", true).
+		Where(constants.FieldSysEntitlement_StartDate+" <= ?", date).
+		Where("("+constants.FieldSysEntitlement_EndDate+" IS NULL OR "+constants.FieldSysEntitlement_EndDate+" >= ?)", date).
+		OrderBy(constants.FieldSysEntitlement_StartDate, constants.SortASC).
+		Build()
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entitlements: %w", err)
+	}
+	defer rows.Close()
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *EntitlementRepository) queryEntitlements(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemEntitlement, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entitlements: %w", err)
+	}
+	defer rows.Close()
+
+	entitlements := make([]*models.SystemEntitlement, 0)
+	for rows.Next() {
+		var e models.SystemEntitlement
+		if err := rows.Scan(&e.ID, &e.Name, &e.AccountID, &e.ContractID, &e.Type, &e.StartDate, &e.EndDate,
+			&e.TotalUnits, &e.RemainingUnits, &e.IsActive, &e.OwnerID, &e.CreatedDate, &e.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan entitlement: %w", err)
+		}
+		entitlements = append(entitlements, &e)
+	}
+	return entitlements, rows.Err()
+}
+
+// entitlementValues returns the editable columns of an entitlement
+func entitlementValues(e *models.SystemEntitlement) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysEntitlement_Name:           e.Name,
+		constants.FieldSysEntitlement_AccountID:      e.AccountID,
+		constants.FieldSysEntitlement_ContractID:     ToNullString(e.ContractID),
+		constants.FieldSysEntitlement_Type:           ToNullString(e.Type),
+		constants.FieldSysEntitlement_StartDate:      entitlementDate(&e.StartDate),
+		constants.FieldSysEntitlement_EndDate:        entitlementDate(e.EndDate),
+		constants.FieldSysEntitlement_TotalUnits:     e.TotalUnits,
+		constants.FieldSysEntitlement_RemainingUnits: e.RemainingUnits,
+		constants.FieldSysEntitlement_IsActive:       e.IsActive,
+		constants.FieldSysEntitlement_OwnerID:        e.OwnerID,
+	}
+}
+
+// InsertEntitlement stores a new entitlement
+func (r *EntitlementRepository) InsertEntitlement(ctx context.Context, e *models.SystemEntitlement) error {
+	now := time.Now()
+	values := entitlementValues(e)
+	values[constants.FieldSysEntitlement_ID] = e.ID
+	values[constants.FieldSysEntitlement_CreatedDate] = entitlementTimestamp(now)
+	values[constants.FieldSysEntitlement_LastModifiedDate] = entitlementTimestamp(now)
+	q := query.Insert(constants.TableEntitlement, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert entitlement: %w", err)
+	}
+	e.CreatedDate = now
+	e.LastModifiedDate = now
+	return nil
+}
+
+// UpdateEntitlement overwrites the editable fields of an entitlement within tx
+func (r *EntitlementRepository) UpdateEntitlement(ctx context.Context, tx *sql.Tx, e *models.SystemEntitlement) error {
+	now := time.Now()
+	values := entitlementValues(e)
+	values[constants.FieldSysEntitlement_LastModifiedDate] = entitlementTimestamp(now)
+	q := query.Update(constants.TableEntitlement).
+		Set(values).
+		Where(constants.FieldSysEntitlement_ID+" = ?", e.ID).
+		Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update entitlement: %w", err)
+	}
+	e.LastModifiedDate = now
+	return nil
+}
+
+// DeleteEntitlement removes an entitlement and its usage in one transaction
+func (r *EntitlementRepository) DeleteEntitlement(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	uq := query.Delete(constants.TableEntitlementUsage).
+		Where(constants.FieldSysEntitlementUsage_EntitlementID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, uq.SQL, uq.Params...); err != nil {
+		return fmt.Errorf("failed to delete entitlement usage: %w", err)
+	}
+	eq := query.Delete(constants.TableEntitlement).
+		Where(constants.FieldSysEntitlement_ID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, eq.SQL, eq.Params...); err != nil {
+		return fmt.Errorf("failed to delete entitlement: %w", err)
+	}
+	return tx.Commit()
+}
+
+// Consume takes units from an entitlement's remaining units, when it has any, and records
+// the usage within tx
+func (r *EntitlementRepository) Consume(ctx context.Context, tx *sql.Tx, e *models.SystemEntitlement, usage *models.SystemEntitlementUsage) error {
+	now := time.Now()
+	if e.RemainingUnits != nil {
+		remaining := *e.RemainingUnits - usage.Units
+		q := query.Update(constants.TableEntitlement).
+			Set(map[string]interface{}{
+				constants.FieldSysEntitlement_RemainingUnits:   remaining,
+				constants.FieldSysEntitlement_LastModifiedDate: entitlementTimestamp(now),
+			}).
+			Where(constants.FieldSysEntitlement_ID+" = ?", e.ID).
+			Build()
+		if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+			return fmt.Errorf("failed to consume entitlement: %w", err)
+		}
+		e.RemainingUnits = &remaining
+		e.LastModifiedDate = now
+	}
+	q := query.Insert(constants.TableEntitlementUsage, map[string]interface{}{
+		constants.FieldSysEntitlementUsage_ID:               usage.ID,
+		constants.FieldSysEntitlementUsage_EntitlementID:    usage.EntitlementID,
+		constants.FieldSysEntitlementUsage_ObjectAPIName:    usage.ObjectAPIName,
+		constants.FieldSysEntitlementUsage_RecordID:         usage.RecordID,
+		constants.FieldSysEntitlementUsage_Units:            usage.Units,
+		constants.FieldSysEntitlementUsage_ConsumedByID:     usage.ConsumedByID,
+		constants.FieldSysEntitlementUsage_CreatedDate:      entitlementTimestamp(now),
+		constants.FieldSysEntitlementUsage_LastModifiedDate: entitlementTimestamp(now),
+	}).Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to record entitlement usage: %w", err)
+	}
+	usage.CreatedDate = now
+	usage.LastModifiedDate = now
+	return nil
+}
+
+// ListUsage returns the usage of an entitlement, most recent first
+func (r *EntitlementRepository) ListUsage(ctx context.Context, entitlementID string) ([]*models.SystemEntitlementUsage, error) {
+	q := query.From(constants.TableEntitlementUsage).
+		Select(entitlementUsageColumns).
+		Where(constants.FieldSysEntitlementUsage_EntitlementID+" = ?", entitlementID).
+		OrderBy(constants.FieldSysEntitlementUsage_CreatedDate, constants.SortDESC).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entitlement usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make([]*models.SystemEntitlementUsage, 0)
+	for rows.Next() {
+		var u models.SystemEntitlementUsage
+		if err := rows.Scan(&u.ID, &u.EntitlementID, &u.ObjectAPIName, &u.RecordID, &u.Units, &u.ConsumedByID,
+			&u.CreatedDate, &u.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan entitlement usage: %w", err)
+		}
+		usage = append(usage, &u)
+	}
+	return usage, rows.Err()
+}
@@ -0,0 +1,240 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// EscalationRepository handles database operations for escalation rules and the escalation log
+type EscalationRepository struct {
+	db *sql.DB
+}
+
+// NewEscalationRepository creates a new EscalationRepository
+func NewEscalationRepository(db *sql.DB) *EscalationRepository {
+	return &EscalationRepository{db: db}
+}
+
+var escalationRuleColumns = []string{
+	constants.FieldSysEscalationRule_ID,
+	constants.FieldSysEscalationRule_Name,
+	constants.FieldSysEscalationRule_Label,
+	constants.FieldSysEscalationRule_ObjectAPIName,
+	constants.FieldSysEscalationRule_Criteria,
+	constants.FieldSysEscalationRule_StartField,
+	constants.FieldSysEscalationRule_BusinessHours,
+	constants.FieldSysEscalationRule_Actions,
+	constants.FieldSysEscalationRule_IsActive,
+	constants.FieldSysEscalationRule_CreatedDate,
+	constants.FieldSysEscalationRule_LastModifiedDate,
+}
+
+// GetRules queries all escalation rules ordered by name
+func (r *EscalationRepository) GetRules(ctx context.Context) ([]*models.EscalationRule, error) {
+	q := query.From(constants.TableEscalationRule).
+		Select(escalationRuleColumns).
+		OrderBy(constants.FieldSysEscalationRule_Name, constants.SortASC).
+		Build()
+	return r.queryRules(ctx, q)
+}
+
+// FindRule queries an escalation rule by name, or nil if not found
+func (r *EscalationRepository) FindRule(ctx context.Context, name string) (*models.EscalationRule, error) {
+	q := query.From(constants.TableEscalationRule).
+		Select(escalationRuleColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableEscalationRule, constants.FieldSysEscalationRule_Name), name).
+		Limit(1).
+		Build()
+	rules, err := r.queryRules(ctx, q)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	return rules[0], nil
+}
+
+// FindActiveRules queries all active escalation rules
+func (r *EscalationRepository) FindActiveRules(ctx context.Context) ([]*models.EscalationRule, error) {
+	q := query.From(constants.TableEscalationRule).
+		Select(escalationRuleColumns).
+		Where(constants.FieldSysEscalationRule_IsActive+" = ?", true).
+		Build()
+	return r.queryRules(ctx, q)
+}
+
+func (r *EscalationRepository) queryRules(ctx context.Context, q query.QueryResult) ([]*models.EscalationRule, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query escalation rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]*models.EscalationRule, 0)
+	for rows.Next() {
+		var rule models.EscalationRule
+		var criteria, businessHours sql.NullString
+		var actions []byte
+		if err := rows.Scan(&rule.ID, &rule.Name, &rule.Label, &rule.ObjectAPIName, &criteria, &rule.StartField,
+			&businessHours, &actions, &rule.IsActive, &rule.CreatedDate, &rule.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan escalation rule: %w", err)
+		}
+		rule.Criteria = criteria.String
+		rule.BusinessHours = businessHours.String
+		if len(actions) > 0 {
+			if err := json.Unmarshal(actions, &rule.Actions); err != nil {
+				return nil, fmt.Errorf("invalid actions of escalation rule %s: %w", rule.Name, err)
+			}
+		}
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+func escalationRuleValues(rule *models.EscalationRule) (map[string]interface{}, error) {
+	actions, err := json.Marshal(rule.Actions)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		constants.FieldSysEscalationRule_Label:         rule.Label,
+		constants.FieldSysEscalationRule_ObjectAPIName: rule.ObjectAPIName,
+		constants.FieldSysEscalationRule_Criteria:      nullableString(rule.Criteria),
+		constants.FieldSysEscalationRule_StartField:    rule.StartField,
+		constants.FieldSysEscalationRule_BusinessHours: nullableString(rule.BusinessHours),
+		constants.FieldSysEscalationRule_Actions:       string(actions),
+		constants.FieldSysEscalationRule_IsActive:      rule.IsActive,
+	}, nil
+}
+
+// InsertRule inserts an escalation rule
+func (r *EscalationRepository) InsertRule(ctx context.Context, rule *models.EscalationRule) error {
+	values, err := escalationRuleValues(rule)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	values[constants.FieldSysEscalationRule_ID] = rule.ID
+	values[constants.FieldSysEscalationRule_Name] = rule.Name
+	values[constants.FieldSysEscalationRule_CreatedDate] = now
+	values[constants.FieldSysEscalationRule_LastModifiedDate] = now
+	q := query.Insert(constants.TableEscalationRule, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert escalation rule: %w", err)
+	}
+	rule.CreatedDate = now
+	rule.LastModifiedDate = now
+	return nil
+}
+
+// UpdateRule overwrites an escalation rule
+func (r *EscalationRepository) UpdateRule(ctx context.Context, rule *models.EscalationRule) error {
+	values, err := escalationRuleValues(rule)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	values[constants.FieldSysEscalationRule_LastModifiedDate] = now
+	q := query.Update(constants.TableEscalationRule).
+		Set(values).
+		Where(constants.FieldSysEscalationRule_ID+" = ?", rule.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update escalation rule: %w", err)
+	}
+	rule.LastModifiedDate = now
+	return nil
+}
+
+// DeleteRule deletes an escalation rule and its log
+func (r *EscalationRepository) DeleteRule(ctx context.Context, rule *models.EscalationRule) error {
+	lq := query.Delete(constants.TableEscalationLog).
+		Where(constants.FieldSysEscalationLog_RuleName+" = ?", rule.Name).
+		Build()
+	if _, err := r.db.ExecContext(ctx, lq.SQL, lq.Params...); err != nil {
+		return fmt.Errorf("failed to delete escalation log: %w", err)
+	}
+	q := query.Delete(constants.TableEscalationRule).
+		Where(constants.FieldSysEscalationRule_ID+" = ?", rule.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete escalation rule: %w", err)
+	}
+	return nil
+}
+
+// FindCandidates queries records of a rule's object that match its criteria, whose start
+// field is at or before cutoff, and that have not been escalated to the given level yet.
+// Records are returned oldest first with their ID, start field and the given extra fields.
+func (r *EscalationRepository) FindCandidates(ctx context.Context, schema *models.ObjectMetadata, rule *models.EscalationRule, level int, fields []string, cutoff time.Time, limit int) ([]models.SObject, error) {
+	builder := query.From(schema.APIName).WithMetadata(schema).
+		Select(append([]string{rule.StartField}, fields...)).
+		Where(fmt.Sprintf("`%s`.`%s` <= ?", schema.APIName, rule.StartField), cutoff).
+		Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM `%s` l WHERE l.`%s` = ? AND l.`%s` = `%s`.`%s` AND l.`%s` >= ?)",
+			constants.TableEscalationLog, constants.FieldSysEscalationLog_RuleName, constants.FieldSysEscalationLog_RecordID,
+			schema.APIName, constants.FieldID, constants.FieldSysEscalationLog_Level), rule.Name, level)
+	if FindField(schema, constants.FieldIsDeleted) != nil {
+		builder.ExcludeDeleted()
+	}
+	if rule.Criteria != "" {
+		sqlWhere, args, err := formula.ToSQL(rule.Criteria)
+		if err != nil {
+			return nil, fmt.Errorf("invalid criteria: %w", err)
+		}
+		builder.WhereRaw(sqlWhere, args)
+	}
+	q := builder.OrderBy(rule.StartField, constants.SortASC).Limit(limit).Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query escalation candidates: %w", err)
+	}
+	defer rows.Close()
+	return query.ScanRowsToSObjects(rows)
+}
+
+// ClaimLevel logs that a record reached a level of a rule and reports whether this call
+// did so; a false result means the level was already logged (e.g. by another server)
+func (r *EscalationRepository) ClaimLevel(ctx context.Context, ruleName, objectAPIName, recordID string, level int, now time.Time) (string, bool, error) {
+	id := utils.GenerateID()
+	q := query.Insert(constants.TableEscalationLog, map[string]interface{}{
+		constants.FieldSysEscalationLog_ID:               id,
+		constants.FieldSysEscalationLog_RuleName:         ruleName,
+		constants.FieldSysEscalationLog_ObjectAPIName:    objectAPIName,
+		constants.FieldSysEscalationLog_RecordID:         recordID,
+		constants.FieldSysEscalationLog_Level:            level,
+		constants.FieldSysEscalationLog_EscalatedDate:    now,
+		constants.FieldSysEscalationLog_CreatedDate:      now,
+		constants.FieldSysEscalationLog_LastModifiedDate: now,
+	}).Build()
+	// Another server that claimed the level first leaves its row in place and no row affected
+	claim := q.SQL + " " + query.ActiveDialect().Upsert([]string{
+		constants.FieldSysEscalationLog_RuleName, constants.FieldSysEscalationLog_RecordID, constants.FieldSysEscalationLog_Level,
+	}, nil)
+	result, err := r.db.ExecContext(ctx, claim, q.Params...)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to log escalation: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return id, n > 0, err
+}
+
+// SetLogError records why the actions of a logged level failed
+func (r *EscalationRepository) SetLogError(ctx context.Context, id, message string) error {
+	q := query.Update(constants.TableEscalationLog).
+		Set(map[string]interface{}{
+			constants.FieldSysEscalationLog_ErrorMessage:     message,
+			constants.FieldSysEscalationLog_LastModifiedDate: time.Now().UTC(),
+		}).
+		Where(constants.FieldSysEscalationLog_ID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ExternalObjectRepository handles database operations for external object connection settings
+type ExternalObjectRepository struct {
+	db *sql.DB
+}
+
+// NewExternalObjectRepository creates a new ExternalObjectRepository
+func NewExternalObjectRepository(db *sql.DB) *ExternalObjectRepository {
+	return &ExternalObjectRepository{db: db}
+}
+
+var externalObjectColumns = []string{
+	constants.FieldSysExternalObject_ID,
+	constants.FieldSysExternalObject_ObjectAPIName,
+	constants.FieldSysExternalObject_Adapter,
+	constants.FieldSysExternalObject_NamedCredential,
+	constants.FieldSysExternalObject_Resource,
+	constants.FieldSysExternalObject_RecordsPath,
+	constants.FieldSysExternalObject_IDField,
+	constants.FieldSysExternalObject_FieldMap,
+	constants.FieldSysExternalObject_Dsn,
+	constants.FieldSysExternalObject_MaxRows,
+	constants.FieldSysExternalObject_CreatedDate,
+	constants.FieldSysExternalObject_LastModifiedDate,
+}
+
+// GetAll queries the settings of all external objects. DSNs are returned encrypted.
+func (r *ExternalObjectRepository) GetAll(ctx context.Context) ([]*models.ExternalDataSource, error) {
+	q := query.From(constants.TableExternalObject).
+		Select(externalObjectColumns).
+		OrderBy(constants.FieldSysExternalObject_ObjectAPIName, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query external objects: %w", err)
+	}
+	defer rows.Close()
+
+	sources := make([]*models.ExternalDataSource, 0)
+	for rows.Next() {
+		src, err := scanExternalDataSource(rows)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+	return sources, rows.Err()
+}
+
+// FindByObject queries the settings of an external object, or nil if not found. The DSN is returned encrypted.
+func (r *ExternalObjectRepository) FindByObject(ctx context.Context, objectAPIName string) (*models.ExternalDataSource, error) {
+	q := query.From(constants.TableExternalObject).
+		Select(externalObjectColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableExternalObject, constants.FieldSysExternalObject_ObjectAPIName), objectAPIName).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query external object: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanExternalDataSource(rows)
+}
+
+func scanExternalDataSource(rows *sql.Rows) (*models.ExternalDataSource, error) {
+	var src models.ExternalDataSource
+	var adapter string
+	var namedCredential, recordsPath, fieldMap, dsn sql.NullString
+	if err := rows.Scan(&src.ID, &src.ObjectAPIName, &adapter, &namedCredential, &src.Resource, &recordsPath,
+		&src.IDField, &fieldMap, &dsn, &src.MaxRows, &src.CreatedDate, &src.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan external object: %w", err)
+	}
+	src.Adapter = constants.ExternalAdapterType(adapter)
+	if namedCredential.Valid {
+		src.NamedCredential = &namedCredential.String
+	}
+	if recordsPath.Valid {
+		src.RecordsPath = &recordsPath.String
+	}
+	if fieldMap.Valid {
+		_ = json.Unmarshal([]byte(fieldMap.String), &src.FieldMap)
+	}
+	src.DSN = dsn.String
+	src.HasDSN = dsn.String != ""
+	return &src, nil
+}
+
+// Insert inserts the settings of an external object; the DSN must already be encrypted
+func (r *ExternalObjectRepository) Insert(ctx context.Context, src *models.ExternalDataSource) error {
+	fieldMap, err := externalFieldMapValue(src.FieldMap)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Insert(constants.TableExternalObject, map[string]interface{}{
+		constants.FieldSysExternalObject_ID:               src.ID,
+		constants.FieldSysExternalObject_ObjectAPIName:    src.ObjectAPIName,
+		constants.FieldSysExternalObject_Adapter:          string(src.Adapter),
+		constants.FieldSysExternalObject_NamedCredential:  src.NamedCredential,
+		constants.FieldSysExternalObject_Resource:         src.Resource,
+		constants.FieldSysExternalObject_RecordsPath:      src.RecordsPath,
+		constants.FieldSysExternalObject_IDField:          src.IDField,
+		constants.FieldSysExternalObject_FieldMap:         fieldMap,
+		constants.FieldSysExternalObject_Dsn:              src.DSN,
+		constants.FieldSysExternalObject_MaxRows:          src.MaxRows,
+		constants.FieldSysExternalObject_CreatedDate:      now,
+		constants.FieldSysExternalObject_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert external object: %w", err)
+	}
+	src.CreatedDate = now
+	src.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites the settings of an external object; the DSN must already be encrypted
+func (r *ExternalObjectRepository) Update(ctx context.Context, src *models.ExternalDataSource) error {
+	fieldMap, err := externalFieldMapValue(src.FieldMap)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Update(constants.TableExternalObject).
+		Set(map[string]interface{}{
+			constants.FieldSysExternalObject_Adapter:          string(src.Adapter),
+			constants.FieldSysExternalObject_NamedCredential:  src.NamedCredential,
+			constants.FieldSysExternalObject_Resource:         src.Resource,
+			constants.FieldSysExternalObject_RecordsPath:      src.RecordsPath,
+			constants.FieldSysExternalObject_IDField:          src.IDField,
+			constants.FieldSysExternalObject_FieldMap:         fieldMap,
+			constants.FieldSysExternalObject_Dsn:              src.DSN,
+			constants.FieldSysExternalObject_MaxRows:          src.MaxRows,
+			constants.FieldSysExternalObject_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysExternalObject_ID+" = ?", src.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update external object: %w", err)
+	}
+	src.LastModifiedDate = now
+	return nil
+}
+
+// externalFieldMapValue encodes a field map for its JSON column, keeping an empty map as NULL
+func externalFieldMapValue(fieldMap map[string]string) (interface{}, error) {
+	if len(fieldMap) == 0 {
+		return nil, nil
+	}
+	return jsonValue(fieldMap)
+}
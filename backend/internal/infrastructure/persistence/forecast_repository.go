@@ -0,0 +1,275 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ForecastRepository handles database operations for forecast settings, quotas and
+// adjustments, and sums the forecast amounts of an object
+type ForecastRepository struct {
+	db *sql.DB
+}
+
+// NewForecastRepository creates a new ForecastRepository
+func NewForecastRepository(db *sql.DB) *ForecastRepository {
+	return &ForecastRepository{db: db}
+}
+
+// ForecastSum is the amount a user owns in one stage of a period
+type ForecastSum struct {
+	OwnerID string
+	Stage   string
+	Amount  float64
+	Records int
+}
+
+var forecastAdjustmentColumns = []string{
+	constants.FieldSysForecastAdjustment_ObjectAPIName,
+	constants.FieldSysForecastAdjustment_UserID,
+	constants.FieldSysForecastAdjustment_Period,
+	constants.FieldSysForecastAdjustment_Category,
+	constants.FieldSysForecastAdjustment_Amount,
+	constants.FieldSysForecastAdjustment_Note,
+	constants.FieldSysForecastAdjustment_AdjustedByID,
+	constants.FieldSysForecastAdjustment_CreatedDate,
+	constants.FieldSysForecastAdjustment_LastModifiedDate,
+}
+
+// GetSetting returns the forecast setting of an object, or nil if forecasting is not configured
+func (r *ForecastRepository) GetSetting(ctx context.Context, objectAPIName string) (*models.SystemForecastSetting, error) {
+	q := query.From(constants.TableForecastSetting).
+		Select([]string{
+			constants.FieldSysForecastSetting_ObjectAPIName,
+			constants.FieldSysForecastSetting_AmountField,
+			constants.FieldSysForecastSetting_CloseDateField,
+			constants.FieldSysForecastSetting_StageField,
+			constants.FieldSysForecastSetting_CategoryMapping,
+		}).
+		Where(constants.FieldSysForecastSetting_ObjectAPIName+" = ?", objectAPIName).
+		Limit(1).
+		Build()
+
+	var s models.SystemForecastSetting
+	var mapping []byte
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&s.ID, &s.ObjectAPIName, &s.AmountField, &s.CloseDateField, &s.StageField, &mapping)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast setting: %w", err)
+	}
+	s.CategoryMapping = mapping
+	return &s, nil
+}
+
+// UpsertSetting creates or replaces the forecast setting of an object
+func (r *ForecastRepository) UpsertSetting(ctx context.Context, s *models.SystemForecastSetting) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableForecastSetting, constants.FieldSysForecastSetting_ID, constants.FieldSysForecastSetting_ObjectAPIName,
+		constants.FieldSysForecastSetting_AmountField, constants.FieldSysForecastSetting_CloseDateField,
+		constants.FieldSysForecastSetting_StageField, constants.FieldSysForecastSetting_CategoryMapping,
+		constants.FieldSysForecastSetting_CreatedDate, constants.FieldSysForecastSetting_LastModifiedDate,
+		query.ActiveDialect().Upsert([]string{constants.FieldSysForecastSetting_ObjectAPIName}, []string{
+			constants.FieldSysForecastSetting_AmountField, constants.FieldSysForecastSetting_CloseDateField,
+			constants.FieldSysForecastSetting_StageField, constants.FieldSysForecastSetting_CategoryMapping,
+		}, fmt.Sprintf("%s = NOW()", constants.FieldSysForecastSetting_LastModifiedDate)))
+	_, err := r.db.ExecContext(ctx, stmt, s.ID, s.ObjectAPIName, s.AmountField, s.CloseDateField, s.StageField, string(s.CategoryMapping))
+	if err != nil {
+		return fmt.Errorf("failed to save forecast setting: %w", err)
+	}
+	return nil
+}
+
+// FindQuotas queries the quotas of a period
+func (r *ForecastRepository) FindQuotas(ctx context.Context, objectAPIName, period string) ([]*models.SystemForecastQuota, error) {
+	q := query.From(constants.TableForecastQuota).
+		Select([]string{
+			constants.FieldSysForecastQuota_ObjectAPIName,
+			constants.FieldSysForecastQuota_UserID,
+			constants.FieldSysForecastQuota_Period,
+			constants.FieldSysForecastQuota_Amount,
+			constants.FieldSysForecastQuota_CreatedDate,
+			constants.FieldSysForecastQuota_LastModifiedDate,
+		}).
+		Where(constants.FieldSysForecastQuota_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysForecastQuota_Period+" = ?", period).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast quotas: %w", err)
+	}
+	defer rows.Close()
+
+	quotas := make([]*models.SystemForecastQuota, 0)
+	for rows.Next() {
+		var qt models.SystemForecastQuota
+		if err := rows.Scan(&qt.ID, &qt.ObjectAPIName, &qt.UserID, &qt.Period, &qt.Amount, &qt.CreatedDate, &qt.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan forecast quota: %w", err)
+		}
+		quotas = append(quotas, &qt)
+	}
+	return quotas, rows.Err()
+}
+
+// SaveQuotas sets the quotas of users for a period in one transaction; a nil amount
+// removes the user's quota
+func (r *ForecastRepository) SaveQuotas(ctx context.Context, objectAPIName, period string, amounts map[string]*float64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	upsert := query.ActiveDialect().Upsert(
+		[]string{constants.FieldSysForecastQuota_ObjectAPIName, constants.FieldSysForecastQuota_Period, constants.FieldSysForecastQuota_UserID},
+		[]string{constants.FieldSysForecastQuota_Amount},
+		fmt.Sprintf("%s = NOW()", constants.FieldSysForecastQuota_LastModifiedDate))
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableForecastQuota, constants.FieldSysForecastQuota_ID, constants.FieldSysForecastQuota_ObjectAPIName,
+		constants.FieldSysForecastQuota_UserID, constants.FieldSysForecastQuota_Period, constants.FieldSysForecastQuota_Amount,
+		constants.FieldSysForecastQuota_CreatedDate, constants.FieldSysForecastQuota_LastModifiedDate, upsert)
+
+	for userID, amount := range amounts {
+		if amount == nil {
+			dq := query.Delete(constants.TableForecastQuota).
+				Where(constants.FieldSysForecastQuota_ObjectAPIName+" = ?", objectAPIName).
+				Where(constants.FieldSysForecastQuota_Period+" = ?", period).
+				Where(constants.FieldSysForecastQuota_UserID+" = ?", userID).
+				Build()
+			if _, err := tx.ExecContext(ctx, dq.SQL, dq.Params...); err != nil {
+				return fmt.Errorf("failed to delete forecast quota: %w", err)
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, stmt, uuid.New().String(), objectAPIName, userID, period, *amount); err != nil {
+			return fmt.Errorf("failed to save forecast quota: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// FindAdjustments queries the adjustments of a period
+func (r *ForecastRepository) FindAdjustments(ctx context.Context, objectAPIName, period string) ([]*models.SystemForecastAdjustment, error) {
+	q := query.From(constants.TableForecastAdjustment).
+		Select(forecastAdjustmentColumns).
+		Where(constants.FieldSysForecastAdjustment_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysForecastAdjustment_Period+" = ?", period).
+		Build()
+	return r.queryAdjustments(ctx, q)
+}
+
+// GetAdjustment queries an adjustment by ID, or nil if not found
+func (r *ForecastRepository) GetAdjustment(ctx context.Context, id string) (*models.SystemForecastAdjustment, error) {
+	q := query.From(constants.TableForecastAdjustment).
+		Select(forecastAdjustmentColumns).
+		Where(constants.FieldSysForecastAdjustment_ID+" = ?", id).
+		Limit(1).
+		Build()
+	adjustments, err := r.queryAdjustments(ctx, q)
+	if err != nil || len(adjustments) == 0 {
+		return nil, err
+	}
+	return adjustments[0], nil
+}
+
+func (r *ForecastRepository) queryAdjustments(ctx context.Context, q query.QueryResult) ([]*models.SystemForecastAdjustment, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast adjustments: %w", err)
+	}
+	defer rows.Close()
+
+	adjustments := make([]*models.SystemForecastAdjustment, 0)
+	for rows.Next() {
+		var a models.SystemForecastAdjustment
+		if err := rows.Scan(&a.ID, &a.ObjectAPIName, &a.UserID, &a.Period, &a.Category, &a.Amount, &a.Note,
+			&a.AdjustedByID, &a.CreatedDate, &a.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan forecast adjustment: %w", err)
+		}
+		adjustments = append(adjustments, &a)
+	}
+	return adjustments, rows.Err()
+}
+
+// UpsertAdjustment creates or replaces the adjustment of a user's category in a period.
+// The ID of a new adjustment is generated when empty.
+func (r *ForecastRepository) UpsertAdjustment(ctx context.Context, a *models.SystemForecastAdjustment) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableForecastAdjustment, constants.FieldSysForecastAdjustment_ID, constants.FieldSysForecastAdjustment_ObjectAPIName,
+		constants.FieldSysForecastAdjustment_UserID, constants.FieldSysForecastAdjustment_Period, constants.FieldSysForecastAdjustment_Category,
+		constants.FieldSysForecastAdjustment_Amount, constants.FieldSysForecastAdjustment_Note, constants.FieldSysForecastAdjustment_AdjustedByID,
+		constants.FieldSysForecastAdjustment_CreatedDate, constants.FieldSysForecastAdjustment_LastModifiedDate,
+		query.ActiveDialect().Upsert([]string{
+			constants.FieldSysForecastAdjustment_ObjectAPIName, constants.FieldSysForecastAdjustment_Period,
+			constants.FieldSysForecastAdjustment_UserID, constants.FieldSysForecastAdjustment_Category,
+		}, []string{
+			constants.FieldSysForecastAdjustment_Amount, constants.FieldSysForecastAdjustment_Note,
+			constants.FieldSysForecastAdjustment_AdjustedByID,
+		}, fmt.Sprintf("%s = NOW()", constants.FieldSysForecastAdjustment_LastModifiedDate)))
+	_, err := r.db.ExecContext(ctx, stmt, a.ID, a.ObjectAPIName, a.UserID, a.Period, a.Category, a.Amount, ToNullString(a.Note), a.AdjustedByID)
+	if err != nil {
+		return fmt.Errorf("failed to save forecast adjustment: %w", err)
+	}
+	return nil
+}
+
+// DeleteAdjustment deletes an adjustment, restoring the computed amount
+func (r *ForecastRepository) DeleteAdjustment(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableForecastAdjustment).
+		Where(constants.FieldSysForecastAdjustment_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete forecast adjustment: %w", err)
+	}
+	return nil
+}
+
+// SumByOwner sums amountField of the live records of table closing within [start, end),
+// grouped by owner and stage. Dates are compared as YYYY-MM-DD strings, which holds for
+// both date and datetime columns.
+func (r *ForecastRepository) SumByOwner(ctx context.Context, table, stageField, amountField, closeDateField string, start, end time.Time) ([]ForecastSum, error) {
+	for _, name := range []string{table, stageField, amountField, closeDateField} {
+		if err := query.ValidateIdentifier(name); err != nil {
+			return nil, err
+		}
+	}
+	sqlStr := fmt.Sprintf("SELECT `%s`, COALESCE(`%s`, ''), COALESCE(SUM(`%s`), 0), COUNT(*) FROM `%s` "+
+		"WHERE `%s` = false AND `%s` >= ? AND `%s` < ? AND `%s` IS NOT NULL GROUP BY `%s`, `%s`",
+		constants.FieldOwnerID, stageField, amountField, table,
+		constants.FieldIsDeleted, closeDateField, closeDateField, constants.FieldOwnerID, constants.FieldOwnerID, stageField)
+	rows, err := r.db.QueryContext(ctx, sqlStr, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum forecast of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	sums := make([]ForecastSum, 0)
+	for rows.Next() {
+		var s ForecastSum
+		if err := rows.Scan(&s.OwnerID, &s.Stage, &s.Amount, &s.Records); err != nil {
+			return nil, fmt.Errorf("failed to scan forecast sum: %w", err)
+		}
+		sums = append(sums, s)
+	}
+	return sums, rows.Err()
+}
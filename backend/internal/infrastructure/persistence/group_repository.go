@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GroupRepository reads public groups and maintains their memberships
+type GroupRepository struct {
+	db *sql.DB
+}
+
+// NewGroupRepository creates a new GroupRepository
+func NewGroupRepository(db *sql.DB) *GroupRepository {
+	return &GroupRepository{db: db}
+}
+
+var groupColumns = []string{
+	constants.FieldSysGroup_ID, constants.FieldSysGroup_Name, constants.FieldSysGroup_Label, constants.FieldSysGroup_Type,
+	constants.FieldSysGroup_CreatedDate, constants.FieldSysGroup_LastModifiedDate,
+}
+
+// FindAll retrieves all groups ordered by label
+func (r *GroupRepository) FindAll(ctx context.Context) ([]*models.SystemGroup, error) {
+	q := query.From(constants.TableGroup).
+		Select(groupColumns).
+		ExcludeDeleted().
+		OrderBy(constants.FieldSysGroup_Label, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := make([]*models.SystemGroup, 0)
+	for rows.Next() {
+		group, err := scanGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+// FindByID retrieves a group, or nil when it does not exist
+func (r *GroupRepository) FindByID(ctx context.Context, groupID string) (*models.SystemGroup, error) {
+	q := query.From(constants.TableGroup).
+		Select(groupColumns).
+		Where(constants.FieldSysGroup_ID+" = ?", groupID).
+		ExcludeDeleted().
+		Build()
+
+	group, err := scanGroup(r.db.QueryRowContext(ctx, q.SQL, q.Params...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return group, err
+}
+
+// GetMemberIDs maps each group to the IDs of its member users
+func (r *GroupRepository) GetMemberIDs(ctx context.Context) (map[string][]string, error) {
+	q := query.From(constants.TableGroupMember).
+		Select([]string{constants.FieldSysGroupMember_GroupID, constants.FieldSysGroupMember_UserID}).
+		ExcludeDeleted().
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make(map[string][]string)
+	for rows.Next() {
+		var groupID, userID string
+		if err := rows.Scan(&groupID, &userID); err != nil {
+			return nil, err
+		}
+		members[groupID] = append(members[groupID], userID)
+	}
+	return members, rows.Err()
+}
+
+// AddMember adds a user to a group unless it is already a member
+func (r *GroupRepository) AddMember(ctx context.Context, groupID, userID string) error {
+	q := query.From(constants.TableGroupMember).
+		Select([]string{constants.FieldSysGroupMember_ID}).
+		Where(constants.FieldSysGroupMember_GroupID+" = ?", groupID).
+		Where(constants.FieldSysGroupMember_UserID+" = ?", userID).
+		ExcludeDeleted().
+		Build()
+	var existing string
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&existing)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("failed to check group membership: %w", err)
+	}
+
+	now := time.Now()
+	q = query.Insert(constants.TableGroupMember, map[string]interface{}{
+		constants.FieldSysGroupMember_ID:               utils.GenerateID(),
+		constants.FieldSysGroupMember_GroupID:          groupID,
+		constants.FieldSysGroupMember_UserID:           userID,
+		constants.FieldSysGroupMember_CreatedDate:      now,
+		constants.FieldSysGroupMember_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to add group member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember removes a user from a group. Memberships are deleted outright, since sharing
+// checks do not look at the deleted flag of _System_GroupMember.
+func (r *GroupRepository) RemoveMember(ctx context.Context, groupID, userID string) error {
+	q := query.Delete(constants.TableGroupMember).
+		Where(constants.FieldSysGroupMember_GroupID+" = ?", groupID).
+		Where(constants.FieldSysGroupMember_UserID+" = ?", userID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+	return nil
+}
+
+// RemoveAllMembers empties a group
+func (r *GroupRepository) RemoveAllMembers(ctx context.Context, groupID string) error {
+	q := query.Delete(constants.TableGroupMember).
+		Where(constants.FieldSysGroupMember_GroupID+" = ?", groupID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to remove group members: %w", err)
+	}
+	return nil
+}
+
+func scanGroup(row interface{ Scan(...interface{}) error }) (*models.SystemGroup, error) {
+	var g models.SystemGroup
+	var groupType sql.NullString
+	var createdRaw, modifiedRaw []byte
+	if err := row.Scan(&g.ID, &g.Name, &g.Label, &groupType, &createdRaw, &modifiedRaw); err != nil {
+		return nil, err
+	}
+	g.Type = groupType.String
+	if t, err := ParseDBTime(createdRaw); err == nil {
+		g.CreatedDate = t
+	}
+	if t, err := ParseDBTime(modifiedRaw); err == nil {
+		g.LastModifiedDate = t
+	}
+	return &g, nil
+}
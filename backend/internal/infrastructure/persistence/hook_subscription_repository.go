@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// HookSubscriptionRepository handles database operations for REST hook subscriptions
+type HookSubscriptionRepository struct {
+	db *sql.DB
+}
+
+// NewHookSubscriptionRepository creates a new HookSubscriptionRepository
+func NewHookSubscriptionRepository(db *sql.DB) *HookSubscriptionRepository {
+	return &HookSubscriptionRepository{db: db}
+}
+
+var hookSubscriptionColumns = []string{
+	constants.FieldSysHookSubscription_ID,
+	constants.FieldSysHookSubscription_UserID,
+	constants.FieldSysHookSubscription_ObjectAPIName,
+	constants.FieldSysHookSubscription_Event,
+	constants.FieldSysHookSubscription_TargetURL,
+	constants.FieldSysHookSubscription_IsActive,
+	constants.FieldSysHookSubscription_FailureCount,
+	constants.FieldSysHookSubscription_LastDeliveryDate,
+	constants.FieldSysHookSubscription_LastError,
+	constants.FieldSysHookSubscription_CreatedDate,
+	constants.FieldSysHookSubscription_LastModifiedDate,
+}
+
+// ListByUser queries a user's subscriptions
+func (r *HookSubscriptionRepository) ListByUser(ctx context.Context, userID string) ([]*models.SystemHookSubscription, error) {
+	return r.list(ctx, constants.FieldSysHookSubscription_UserID+" = ?", userID)
+}
+
+// ListActive queries the active subscriptions to an event of an object
+func (r *HookSubscriptionRepository) ListActive(ctx context.Context, objectAPIName string, event constants.HookEvent) ([]*models.SystemHookSubscription, error) {
+	return r.list(ctx,
+		fmt.Sprintf("%s = ? AND %s = ? AND %s = 1", constants.FieldSysHookSubscription_ObjectAPIName,
+			constants.FieldSysHookSubscription_Event, constants.FieldSysHookSubscription_IsActive),
+		objectAPIName, string(event))
+}
+
+func (r *HookSubscriptionRepository) list(ctx context.Context, condition string, params ...interface{}) ([]*models.SystemHookSubscription, error) {
+	q := query.From(constants.TableHookSubscription).
+		Select(hookSubscriptionColumns).
+		Where(condition, params...).
+		OrderBy(constants.FieldSysHookSubscription_CreatedDate, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subs := make([]*models.SystemHookSubscription, 0)
+	for rows.Next() {
+		sub, err := scanHookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+// Find queries a subscription by ID, or nil if not found
+func (r *HookSubscriptionRepository) Find(ctx context.Context, id string) (*models.SystemHookSubscription, error) {
+	q := query.From(constants.TableHookSubscription).
+		Select(hookSubscriptionColumns).
+		Where(constants.FieldSysHookSubscription_ID+" = ?", id).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hook subscription: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanHookSubscription(rows)
+}
+
+func scanHookSubscription(rows *sql.Rows) (*models.SystemHookSubscription, error) {
+	var sub models.SystemHookSubscription
+	var lastDelivery sql.NullTime
+	var lastError sql.NullString
+	if err := rows.Scan(&sub.ID, &sub.UserID, &sub.ObjectAPIName, &sub.Event, &sub.TargetURL, &sub.IsActive,
+		&sub.FailureCount, &lastDelivery, &lastError, &sub.CreatedDate, &sub.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan hook subscription: %w", err)
+	}
+	sub.LastDeliveryDate = nullTimePtr(lastDelivery)
+	sub.LastError = nullStringPtr(lastError)
+	return &sub, nil
+}
+
+// Insert inserts a subscription
+func (r *HookSubscriptionRepository) Insert(ctx context.Context, sub *models.SystemHookSubscription) error {
+	now := time.Now()
+	q := query.Insert(constants.TableHookSubscription, map[string]interface{}{
+		constants.FieldSysHookSubscription_ID:               sub.ID,
+		constants.FieldSysHookSubscription_UserID:           sub.UserID,
+		constants.FieldSysHookSubscription_ObjectAPIName:    sub.ObjectAPIName,
+		constants.FieldSysHookSubscription_Event:            sub.Event,
+		constants.FieldSysHookSubscription_TargetURL:        sub.TargetURL,
+		constants.FieldSysHookSubscription_IsActive:         sub.IsActive,
+		constants.FieldSysHookSubscription_FailureCount:     0,
+		constants.FieldSysHookSubscription_CreatedDate:      now,
+		constants.FieldSysHookSubscription_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert hook subscription: %w", err)
+	}
+	sub.CreatedDate = now
+	sub.LastModifiedDate = now
+	return nil
+}
+
+// SaveDeliveryState records the outcome of a delivery: the failure count, the error if any,
+// and whether the subscription is still active
+func (r *HookSubscriptionRepository) SaveDeliveryState(ctx context.Context, sub *models.SystemHookSubscription) error {
+	now := time.Now()
+	q := query.Update(constants.TableHookSubscription).
+		Set(map[string]interface{}{
+			constants.FieldSysHookSubscription_IsActive:         sub.IsActive,
+			constants.FieldSysHookSubscription_FailureCount:     sub.FailureCount,
+			constants.FieldSysHookSubscription_LastDeliveryDate: sub.LastDeliveryDate,
+			constants.FieldSysHookSubscription_LastError:        sub.LastError,
+			constants.FieldSysHookSubscription_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysHookSubscription_ID+" = ?", sub.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to save hook delivery state: %w", err)
+	}
+	sub.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a subscription
+func (r *HookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableHookSubscription).
+		Where(constants.FieldSysHookSubscription_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete hook subscription: %w", err)
+	}
+	return nil
+}
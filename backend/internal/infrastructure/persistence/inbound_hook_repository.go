@@ -0,0 +1,192 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// InboundHookRepository handles database operations for inbound webhooks
+type InboundHookRepository struct {
+	db *sql.DB
+}
+
+// NewInboundHookRepository creates a new InboundHookRepository
+func NewInboundHookRepository(db *sql.DB) *InboundHookRepository {
+	return &InboundHookRepository{db: db}
+}
+
+var inboundHookColumns = []string{
+	constants.FieldSysInboundHook_ID,
+	constants.FieldSysInboundHook_Slug,
+	constants.FieldSysInboundHook_Label,
+	constants.FieldSysInboundHook_ObjectAPIName,
+	constants.FieldSysInboundHook_Operation,
+	constants.FieldSysInboundHook_MatchField,
+	constants.FieldSysInboundHook_RecordsPath,
+	constants.FieldSysInboundHook_FieldMapping,
+	constants.FieldSysInboundHook_Secret,
+	constants.FieldSysInboundHook_RunAsUserID,
+	constants.FieldSysInboundHook_IsActive,
+	constants.FieldSysInboundHook_LastReceivedDate,
+	constants.FieldSysInboundHook_LastError,
+	constants.FieldSysInboundHook_CreatedDate,
+	constants.FieldSysInboundHook_LastModifiedDate,
+}
+
+// GetAll queries all inbound webhooks ordered by slug. Secrets are returned encrypted.
+func (r *InboundHookRepository) GetAll(ctx context.Context) ([]*models.InboundHook, error) {
+	q := query.From(constants.TableInboundHook).
+		Select(inboundHookColumns).
+		OrderBy(constants.FieldSysInboundHook_Slug, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inbound hooks: %w", err)
+	}
+	defer rows.Close()
+
+	hooks := make([]*models.InboundHook, 0)
+	for rows.Next() {
+		h, err := scanInboundHook(rows)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, h)
+	}
+	return hooks, rows.Err()
+}
+
+// FindBySlug queries an inbound webhook by slug, or nil if not found. The secret is returned encrypted.
+func (r *InboundHookRepository) FindBySlug(ctx context.Context, slug string) (*models.InboundHook, error) {
+	q := query.From(constants.TableInboundHook).
+		Select(inboundHookColumns).
+		Where(constants.FieldSysInboundHook_Slug+" = ?", slug).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inbound hook: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanInboundHook(rows)
+}
+
+func scanInboundHook(rows *sql.Rows) (*models.InboundHook, error) {
+	var h models.InboundHook
+	var operation string
+	var matchField, recordsPath, lastError sql.NullString
+	var mapping []byte
+	var lastReceived sql.NullTime
+	if err := rows.Scan(&h.ID, &h.Slug, &h.Label, &h.ObjectAPIName, &operation, &matchField, &recordsPath, &mapping,
+		&h.Secret, &h.RunAsUserID, &h.IsActive, &lastReceived, &lastError, &h.CreatedDate, &h.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan inbound hook: %w", err)
+	}
+	h.Operation = constants.InboundHookOperation(operation)
+	h.MatchField = nullStringPtr(matchField)
+	h.RecordsPath = nullStringPtr(recordsPath)
+	h.LastError = nullStringPtr(lastError)
+	h.LastReceivedDate = nullTimePtr(lastReceived)
+	h.HasSecret = h.Secret != ""
+	if err := json.Unmarshal(mapping, &h.FieldMapping); err != nil {
+		return nil, fmt.Errorf("failed to decode field mapping of inbound hook %s: %w", h.Slug, err)
+	}
+	return &h, nil
+}
+
+// Insert inserts an inbound webhook; the secret must already be encrypted
+func (r *InboundHookRepository) Insert(ctx context.Context, h *models.InboundHook) error {
+	mapping, err := json.Marshal(h.FieldMapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode field mapping: %w", err)
+	}
+	now := time.Now()
+	q := query.Insert(constants.TableInboundHook, map[string]interface{}{
+		constants.FieldSysInboundHook_ID:               h.ID,
+		constants.FieldSysInboundHook_Slug:             h.Slug,
+		constants.FieldSysInboundHook_Label:            h.Label,
+		constants.FieldSysInboundHook_ObjectAPIName:    h.ObjectAPIName,
+		constants.FieldSysInboundHook_Operation:        string(h.Operation),
+		constants.FieldSysInboundHook_MatchField:       h.MatchField,
+		constants.FieldSysInboundHook_RecordsPath:      h.RecordsPath,
+		constants.FieldSysInboundHook_FieldMapping:     string(mapping),
+		constants.FieldSysInboundHook_Secret:           h.Secret,
+		constants.FieldSysInboundHook_RunAsUserID:      h.RunAsUserID,
+		constants.FieldSysInboundHook_IsActive:         h.IsActive,
+		constants.FieldSysInboundHook_CreatedDate:      now,
+		constants.FieldSysInboundHook_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert inbound hook: %w", err)
+	}
+	h.CreatedDate = now
+	h.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites an inbound webhook's settings; the secret must already be encrypted
+func (r *InboundHookRepository) Update(ctx context.Context, h *models.InboundHook) error {
+	mapping, err := json.Marshal(h.FieldMapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode field mapping: %w", err)
+	}
+	now := time.Now()
+	q := query.Update(constants.TableInboundHook).
+		Set(map[string]interface{}{
+			constants.FieldSysInboundHook_Label:            h.Label,
+			constants.FieldSysInboundHook_ObjectAPIName:    h.ObjectAPIName,
+			constants.FieldSysInboundHook_Operation:        string(h.Operation),
+			constants.FieldSysInboundHook_MatchField:       h.MatchField,
+			constants.FieldSysInboundHook_RecordsPath:      h.RecordsPath,
+			constants.FieldSysInboundHook_FieldMapping:     string(mapping),
+			constants.FieldSysInboundHook_Secret:           h.Secret,
+			constants.FieldSysInboundHook_RunAsUserID:      h.RunAsUserID,
+			constants.FieldSysInboundHook_IsActive:         h.IsActive,
+			constants.FieldSysInboundHook_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysInboundHook_ID+" = ?", h.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update inbound hook: %w", err)
+	}
+	h.LastModifiedDate = now
+	return nil
+}
+
+// SaveReceiveState records when an inbound webhook last received a delivery and its error, if any
+func (r *InboundHookRepository) SaveReceiveState(ctx context.Context, id string, received time.Time, lastError *string) error {
+	q := query.Update(constants.TableInboundHook).
+		Set(map[string]interface{}{
+			constants.FieldSysInboundHook_LastReceivedDate: received,
+			constants.FieldSysInboundHook_LastError:        lastError,
+		}).
+		Where(constants.FieldSysInboundHook_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to save inbound hook state: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes an inbound webhook
+func (r *InboundHookRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableInboundHook).
+		Where(constants.FieldSysInboundHook_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete inbound hook: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,583 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// KnowledgeRepository handles database operations for knowledge categories, articles, their
+// published versions and the records articles are attached to
+type KnowledgeRepository struct {
+	db *sql.DB
+}
+
+// NewKnowledgeRepository creates a new KnowledgeRepository
+func NewKnowledgeRepository(db *sql.DB) *KnowledgeRepository {
+	return &KnowledgeRepository{db: db}
+}
+
+func (r *KnowledgeRepository) executor(tx *sql.Tx) Executor {
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// knowledgeTimestamp formats dates like record system dates
+func knowledgeTimestamp(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// knowledgeNullTimestamp formats an optional date, or NULL
+func knowledgeNullTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return knowledgeTimestamp(*t)
+}
+
+// inPlaceholders returns "?, ?, ..." and the params for an IN list
+func inPlaceholders(values []string) (string, []interface{}) {
+	params := make([]interface{}, len(values))
+	for i, v := range values {
+		params[i] = v
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "), params
+}
+
+var knowledgeCategoryColumns = []string{
+	constants.FieldSysKnowledgeCategory_Name,
+	constants.FieldSysKnowledgeCategory_ParentID,
+	constants.FieldSysKnowledgeCategory_Description,
+	constants.FieldSysKnowledgeCategory_SortOrder,
+	constants.FieldSysKnowledgeCategory_CreatedDate,
+	constants.FieldSysKnowledgeCategory_LastModifiedDate,
+}
+
+var knowledgeArticleColumns = []string{
+	constants.FieldSysKnowledgeArticle_URLName,
+	constants.FieldSysKnowledgeArticle_Title,
+	constants.FieldSysKnowledgeArticle_Summary,
+	constants.FieldSysKnowledgeArticle_Body,
+	constants.FieldSysKnowledgeArticle_CategoryID,
+	constants.FieldSysKnowledgeArticle_Status,
+	constants.FieldSysKnowledgeArticle_VersionNumber,
+	constants.FieldSysKnowledgeArticle_PublishedVersionNumber,
+	constants.FieldSysKnowledgeArticle_PublishedDate,
+	constants.FieldSysKnowledgeArticle_ArchivedDate,
+	constants.FieldSysKnowledgeArticle_OwnerID,
+	constants.FieldSysKnowledgeArticle_CreatedDate,
+	constants.FieldSysKnowledgeArticle_LastModifiedDate,
+}
+
+var knowledgeVersionColumns = []string{
+	constants.FieldSysKnowledgeArticleVersion_ArticleID,
+	constants.FieldSysKnowledgeArticleVersion_VersionNumber,
+	constants.FieldSysKnowledgeArticleVersion_Title,
+	constants.FieldSysKnowledgeArticleVersion_Summary,
+	constants.FieldSysKnowledgeArticleVersion_Body,
+	constants.FieldSysKnowledgeArticleVersion_CategoryID,
+	constants.FieldSysKnowledgeArticleVersion_PublishedByID,
+	constants.FieldSysKnowledgeArticleVersion_PublishedDate,
+	constants.FieldSysKnowledgeArticleVersion_CreatedDate,
+	constants.FieldSysKnowledgeArticleVersion_LastModifiedDate,
+}
+
+var knowledgeLinkColumns = []string{
+	constants.FieldSysKnowledgeArticleLink_ArticleID,
+	constants.FieldSysKnowledgeArticleLink_ObjectAPIName,
+	constants.FieldSysKnowledgeArticleLink_RecordID,
+	constants.FieldSysKnowledgeArticleLink_VersionNumber,
+	constants.FieldSysKnowledgeArticleLink_LinkedByID,
+	constants.FieldSysKnowledgeArticleLink_CreatedDate,
+	constants.FieldSysKnowledgeArticleLink_LastModifiedDate,
+}
+
+// ==================== Categories ====================
+
+// ListCategories returns every knowledge category in sort order
+func (r *KnowledgeRepository) ListCategories(ctx context.Context) ([]*models.SystemKnowledgeCategory, error) {
+	q := query.From(constants.TableKnowledgeCategory).
+		Select(knowledgeCategoryColumns).
+		OrderBy(constants.FieldSysKnowledgeCategory_SortOrder, constants.SortASC).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query knowledge categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]*models.SystemKnowledgeCategory, 0)
+	for rows.Next() {
+		var c models.SystemKnowledgeCategory
+		if err := rows.Scan(&c.ID, &c.Name, &c.ParentID, &c.Description, &c.SortOrder,
+			&c.CreatedDate, &c.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan knowledge category: %w", err)
+		}
+		categories = append(categories, &c)
+	}
+	return categories, rows.Err()
+}
+
+// knowledgeCategoryValues returns the editable columns of a category
+func knowledgeCategoryValues(c *models.SystemKnowledgeCategory) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysKnowledgeCategory_Name:        c.Name,
+		constants.FieldSysKnowledgeCategory_ParentID:    ToNullString(c.ParentID),
+		constants.FieldSysKnowledgeCategory_Description: ToNullString(c.Description),
+		constants.FieldSysKnowledgeCategory_SortOrder:   c.SortOrder,
+	}
+}
+
+// InsertCategory stores a new category
+func (r *KnowledgeRepository) InsertCategory(ctx context.Context, c *models.SystemKnowledgeCategory) error {
+	now := time.Now()
+	values := knowledgeCategoryValues(c)
+	values[constants.FieldSysKnowledgeCategory_ID] = c.ID
+	values[constants.FieldSysKnowledgeCategory_CreatedDate] = knowledgeTimestamp(now)
+	values[constants.FieldSysKnowledgeCategory_LastModifiedDate] = knowledgeTimestamp(now)
+	q := query.Insert(constants.TableKnowledgeCategory, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert knowledge category: %w", err)
+	}
+	c.CreatedDate = now
+	c.LastModifiedDate = now
+	return nil
+}
+
+// UpdateCategory overwrites the editable fields of a category
+func (r *KnowledgeRepository) UpdateCategory(ctx context.Context, c *models.SystemKnowledgeCategory) error {
+	now := time.Now()
+	values := knowledgeCategoryValues(c)
+	values[constants.FieldSysKnowledgeCategory_LastModifiedDate] = knowledgeTimestamp(now)
+	q := query.Update(constants.TableKnowledgeCategory).
+		Set(values).
+		Where(constants.FieldSysKnowledgeCategory_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update knowledge category: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// DeleteCategory removes a category
+func (r *KnowledgeRepository) DeleteCategory(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableKnowledgeCategory).
+		Where(constants.FieldSysKnowledgeCategory_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete knowledge category: %w", err)
+	}
+	return nil
+}
+
+// CountCategoryArticles returns the number of articles filed under a category
+func (r *KnowledgeRepository) CountCategoryArticles(ctx context.Context, categoryID string) (int, error) {
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?", constants.TableKnowledgeArticle, constants.FieldSysKnowledgeArticle_CategoryID)
+	var count int
+	if err := r.db.QueryRowContext(ctx, sqlStr, categoryID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count knowledge articles: %w", err)
+	}
+	return count, nil
+}
+
+// ==================== Articles ====================
+
+// ArticleFilter narrows ListArticles; empty fields do not filter
+type ArticleFilter struct {
+	Status      string
+	OwnerID     string
+	CategoryIDs []string
+}
+
+// ListArticles returns the working copies of articles matching a filter, most recently
+// modified first
+func (r *KnowledgeRepository) ListArticles(ctx context.Context, filter ArticleFilter) ([]*models.SystemKnowledgeArticle, error) {
+	b := query.From(constants.TableKnowledgeArticle).Select(knowledgeArticleColumns)
+	if filter.Status != "" {
+		b = b.Where(constants.FieldSysKnowledgeArticle_Status+" = ?", filter.Status)
+	}
+	if filter.OwnerID != "" {
+		b = b.Where(constants.FieldSysKnowledgeArticle_OwnerID+" = ?", filter.OwnerID)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		placeholders, params := inPlaceholders(filter.CategoryIDs)
+		b = b.Where(fmt.Sprintf("%s IN (%s)", constants.FieldSysKnowledgeArticle_CategoryID, placeholders), params...)
+	}
+	q := b.OrderBy(constants.FieldSysKnowledgeArticle_LastModifiedDate, constants.SortDESC).Build()
+	return r.queryArticles(ctx, nil, q)
+}
+
+// GetArticle returns an article by ID, or nil if not found
+func (r *KnowledgeRepository) GetArticle(ctx context.Context, id string) (*models.SystemKnowledgeArticle, error) {
+	return r.getArticle(ctx, nil, constants.FieldSysKnowledgeArticle_ID, id, false)
+}
+
+// GetArticleLock returns an article by ID locked for update within tx, or nil if not found
+func (r *KnowledgeRepository) GetArticleLock(ctx context.Context, tx *sql.Tx, id string) (*models.SystemKnowledgeArticle, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction required for locking knowledge article %s", id)
+	}
+	return r.getArticle(ctx, tx, constants.FieldSysKnowledgeArticle_ID, id, true)
+}
+
+// FindArticleByURLName returns the article with a URL name, or nil if there is none
+func (r *KnowledgeRepository) FindArticleByURLName(ctx context.Context, urlName string) (*models.SystemKnowledgeArticle, error) {
+	return r.getArticle(ctx, nil, constants.FieldSysKnowledgeArticle_URLName, urlName, false)
+}
+
+func (r *KnowledgeRepository) getArticle(ctx context.Context, tx *sql.Tx, field, value string, lock bool) (*models.SystemKnowledgeArticle, error) {
+	q := query.From(constants.TableKnowledgeArticle).
+		Select(knowledgeArticleColumns).
+		Where(field+" = ?", value).
+		Limit(1).
+		Build()
+	if lock {
+		q.SQL += " FOR UPDATE"
+	}
+	articles, err := r.queryArticles(ctx, tx, q)
+	if err != nil || len(articles) == 0 {
+		return nil, err
+	}
+	return articles[0], nil
+}
+
+func (r *KnowledgeRepository) queryArticles(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemKnowledgeArticle, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query knowledge articles: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]*models.SystemKnowledgeArticle, 0)
+	for rows.Next() {
+		var a models.SystemKnowledgeArticle
+		if err := rows.Scan(&a.ID, &a.URLName, &a.Title, &a.Summary, &a.Body, &a.CategoryID, &a.Status,
+			&a.VersionNumber, &a.PublishedVersionNumber, &a.PublishedDate, &a.ArchivedDate,
+			&a.OwnerID, &a.CreatedDate, &a.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan knowledge article: %w", err)
+		}
+		articles = append(articles, &a)
+	}
+	return articles, rows.Err()
+}
+
+// knowledgeArticleValues returns the editable columns of an article
+func knowledgeArticleValues(a *models.SystemKnowledgeArticle) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysKnowledgeArticle_URLName:                a.URLName,
+		constants.FieldSysKnowledgeArticle_Title:                  a.Title,
+		constants.FieldSysKnowledgeArticle_Summary:                ToNullString(a.Summary),
+		constants.FieldSysKnowledgeArticle_Body:                   ToNullString(a.Body),
+		constants.FieldSysKnowledgeArticle_CategoryID:             ToNullString(a.CategoryID),
+		constants.FieldSysKnowledgeArticle_Status:                 a.Status,
+		constants.FieldSysKnowledgeArticle_VersionNumber:          a.VersionNumber,
+		constants.FieldSysKnowledgeArticle_PublishedVersionNumber: a.PublishedVersionNumber,
+		constants.FieldSysKnowledgeArticle_PublishedDate:          knowledgeNullTimestamp(a.PublishedDate),
+		constants.FieldSysKnowledgeArticle_ArchivedDate:           knowledgeNullTimestamp(a.ArchivedDate),
+		constants.FieldSysKnowledgeArticle_OwnerID:                a.OwnerID,
+	}
+}
+
+// InsertArticle stores a new article
+func (r *KnowledgeRepository) InsertArticle(ctx context.Context, a *models.SystemKnowledgeArticle) error {
+	now := time.Now()
+	values := knowledgeArticleValues(a)
+	values[constants.FieldSysKnowledgeArticle_ID] = a.ID
+	values[constants.FieldSysKnowledgeArticle_CreatedDate] = knowledgeTimestamp(now)
+	values[constants.FieldSysKnowledgeArticle_LastModifiedDate] = knowledgeTimestamp(now)
+	q := query.Insert(constants.TableKnowledgeArticle, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert knowledge article: %w", err)
+	}
+	a.CreatedDate = now
+	a.LastModifiedDate = now
+	return nil
+}
+
+// UpdateArticle overwrites the editable fields of an article within tx
+func (r *KnowledgeRepository) UpdateArticle(ctx context.Context, tx *sql.Tx, a *models.SystemKnowledgeArticle) error {
+	now := time.Now()
+	values := knowledgeArticleValues(a)
+	values[constants.FieldSysKnowledgeArticle_LastModifiedDate] = knowledgeTimestamp(now)
+	q := query.Update(constants.TableKnowledgeArticle).
+		Set(values).
+		Where(constants.FieldSysKnowledgeArticle_ID+" = ?", a.ID).
+		Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update knowledge article: %w", err)
+	}
+	a.LastModifiedDate = now
+	return nil
+}
+
+// DeleteArticle removes an article with its versions and links in one transaction
+func (r *KnowledgeRepository) DeleteArticle(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	lq := query.Delete(constants.TableKnowledgeArticleLink).
+		Where(constants.FieldSysKnowledgeArticleLink_ArticleID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, lq.SQL, lq.Params...); err != nil {
+		return fmt.Errorf("failed to delete knowledge article links: %w", err)
+	}
+	vq := query.Delete(constants.TableKnowledgeArticleVersion).
+		Where(constants.FieldSysKnowledgeArticleVersion_ArticleID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, vq.SQL, vq.Params...); err != nil {
+		return fmt.Errorf("failed to delete knowledge article versions: %w", err)
+	}
+	aq := query.Delete(constants.TableKnowledgeArticle).
+		Where(constants.FieldSysKnowledgeArticle_ID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, aq.SQL, aq.Params...); err != nil {
+		return fmt.Errorf("failed to delete knowledge article: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ==================== Versions ====================
+
+// ListVersions returns the published versions of an article, newest first
+func (r *KnowledgeRepository) ListVersions(ctx context.Context, articleID string) ([]*models.SystemKnowledgeArticleVersion, error) {
+	q := query.From(constants.TableKnowledgeArticleVersion).
+		Select(knowledgeVersionColumns).
+		Where(constants.FieldSysKnowledgeArticleVersion_ArticleID+" = ?", articleID).
+		OrderBy(constants.FieldSysKnowledgeArticleVersion_VersionNumber, constants.SortDESC).
+		Build()
+	return r.queryVersions(ctx, nil, q)
+}
+
+// GetVersion returns one version of an article, or nil if it was never published. tx may be nil.
+func (r *KnowledgeRepository) GetVersion(ctx context.Context, tx *sql.Tx, articleID string, versionNumber int) (*models.SystemKnowledgeArticleVersion, error) {
+	q := query.From(constants.TableKnowledgeArticleVersion).
+		Select(knowledgeVersionColumns).
+		Where(constants.FieldSysKnowledgeArticleVersion_ArticleID+" = ?", articleID).
+		Where(constants.FieldSysKnowledgeArticleVersion_VersionNumber+" = ?", versionNumber).
+		Limit(1).
+		Build()
+	versions, err := r.queryVersions(ctx, tx, q)
+	if err != nil || len(versions) == 0 {
+		return nil, err
+	}
+	return versions[0], nil
+}
+
+// LatestVersionNumber returns the highest published version number of an article, or 0
+func (r *KnowledgeRepository) LatestVersionNumber(ctx context.Context, tx *sql.Tx, articleID string) (int, error) {
+	sqlStr := fmt.Sprintf("SELECT COALESCE(MAX(%s), 0) FROM %s WHERE %s = ?",
+		constants.FieldSysKnowledgeArticleVersion_VersionNumber, constants.TableKnowledgeArticleVersion,
+		constants.FieldSysKnowledgeArticleVersion_ArticleID)
+	var latest int
+	if err := r.executor(tx).QueryRowContext(ctx, sqlStr, articleID).Scan(&latest); err != nil {
+		return 0, fmt.Errorf("failed to read latest knowledge article version: %w", err)
+	}
+	return latest, nil
+}
+
+// InsertVersion stores a published version within tx
+func (r *KnowledgeRepository) InsertVersion(ctx context.Context, tx *sql.Tx, v *models.SystemKnowledgeArticleVersion) error {
+	now := time.Now()
+	q := query.Insert(constants.TableKnowledgeArticleVersion, map[string]interface{}{
+		constants.FieldSysKnowledgeArticleVersion_ID:               v.ID,
+		constants.FieldSysKnowledgeArticleVersion_ArticleID:        v.ArticleID,
+		constants.FieldSysKnowledgeArticleVersion_VersionNumber:    v.VersionNumber,
+		constants.FieldSysKnowledgeArticleVersion_Title:            v.Title,
+		constants.FieldSysKnowledgeArticleVersion_Summary:          ToNullString(v.Summary),
+		constants.FieldSysKnowledgeArticleVersion_Body:             ToNullString(v.Body),
+		constants.FieldSysKnowledgeArticleVersion_CategoryID:       ToNullString(v.CategoryID),
+		constants.FieldSysKnowledgeArticleVersion_PublishedByID:    v.PublishedByID,
+		constants.FieldSysKnowledgeArticleVersion_PublishedDate:    knowledgeTimestamp(v.PublishedDate),
+		constants.FieldSysKnowledgeArticleVersion_CreatedDate:      knowledgeTimestamp(now),
+		constants.FieldSysKnowledgeArticleVersion_LastModifiedDate: knowledgeTimestamp(now),
+	}).Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert knowledge article version: %w", err)
+	}
+	v.CreatedDate = now
+	v.LastModifiedDate = now
+	return nil
+}
+
+func (r *KnowledgeRepository) queryVersions(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemKnowledgeArticleVersion, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query knowledge article versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]*models.SystemKnowledgeArticleVersion, 0)
+	for rows.Next() {
+		var v models.SystemKnowledgeArticleVersion
+		if err := rows.Scan(&v.ID, &v.ArticleID, &v.VersionNumber, &v.Title, &v.Summary, &v.Body, &v.CategoryID,
+			&v.PublishedByID, &v.PublishedDate, &v.CreatedDate, &v.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan knowledge article version: %w", err)
+		}
+		versions = append(versions, &v)
+	}
+	return versions, rows.Err()
+}
+
+// PublishedFilter narrows ListPublished; empty fields do not filter. Terms match any of
+// title, summary or body, and an article matches when it contains any term.
+type PublishedFilter struct {
+	ArticleIDs  []string
+	CategoryIDs []string
+	URLName     string
+	Terms       []string
+	Limit       int
+}
+
+// ListPublished returns the live version of each published article matching a filter,
+// most recently published first
+func (r *KnowledgeRepository) ListPublished(ctx context.Context, filter PublishedFilter) ([]*models.PublishedArticle, error) {
+	version := func(field string) string {
+		return fmt.Sprintf("`%s`.`%s`", constants.TableKnowledgeArticleVersion, field)
+	}
+	b := query.From(constants.TableKnowledgeArticleVersion).
+		Select(knowledgeVersionColumns).
+		AddSelectRaw("`a`.`"+constants.FieldSysKnowledgeArticle_URLName+"`").
+		Join("INNER", constants.TableKnowledgeArticle, "a", fmt.Sprintf("`a`.`%s` = %s AND `a`.`%s` = %s",
+			constants.FieldSysKnowledgeArticle_ID, version(constants.FieldSysKnowledgeArticleVersion_ArticleID),
+			constants.FieldSysKnowledgeArticle_PublishedVersionNumber, version(constants.FieldSysKnowledgeArticleVersion_VersionNumber)))
+	if len(filter.ArticleIDs) > 0 {
+		placeholders, params := inPlaceholders(filter.ArticleIDs)
+		b = b.Where(fmt.Sprintf("%s IN (%s)", version(constants.FieldSysKnowledgeArticleVersion_ArticleID), placeholders), params...)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		placeholders, params := inPlaceholders(filter.CategoryIDs)
+		b = b.Where(fmt.Sprintf("%s IN (%s)", version(constants.FieldSysKnowledgeArticleVersion_CategoryID), placeholders), params...)
+	}
+	if filter.URLName != "" {
+		b = b.Where("`a`.`"+constants.FieldSysKnowledgeArticle_URLName+"` = ?", filter.URLName)
+	}
+	if len(filter.Terms) > 0 {
+		conditions := make([]string, 0, len(filter.Terms)*3)
+		params := make([]interface{}, 0, len(filter.Terms)*3)
+		for _, term := range filter.Terms {
+			pattern := "%" + term + "%"
+			for _, field := range []string{
+				constants.FieldSysKnowledgeArticleVersion_Title,
+				constants.FieldSysKnowledgeArticleVersion_Summary,
+				constants.FieldSysKnowledgeArticleVersion_Body,
+			} {
+				conditions = append(conditions, version(field)+" LIKE ?")
+				params = append(params, pattern)
+			}
+		}
+		b = b.WhereRaw("("+strings.Join(conditions, " OR ")+")", params)
+	}
+	b = b.OrderBy(constants.FieldSysKnowledgeArticleVersion_PublishedDate, constants.SortDESC)
+	if filter.Limit > 0 {
+		b = b.Limit(filter.Limit)
+	}
+	q := b.Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query published knowledge articles: %w", err)
+	}
+	defer rows.Close()
+
+	articles := make([]*models.PublishedArticle, 0)
+	for rows.Next() {
+		var v models.SystemKnowledgeArticleVersion
+		var urlName string
+		if err := rows.Scan(&v.ID, &v.ArticleID, &v.VersionNumber, &v.Title, &v.Summary, &v.Body, &v.CategoryID,
+			&v.PublishedByID, &v.PublishedDate, &v.CreatedDate, &v.LastModifiedDate, &urlName); err != nil {
+			return nil, fmt.Errorf("failed to scan published knowledge article: %w", err)
+		}
+		articles = append(articles, &models.PublishedArticle{Version: &v, URLName: urlName})
+	}
+	return articles, rows.Err()
+}
+
+// ==================== Links ====================
+
+// ListLinks returns the articles attached to a record, most recent first
+func (r *KnowledgeRepository) ListLinks(ctx context.Context, objectAPIName, recordID string) ([]*models.SystemKnowledgeArticleLink, error) {
+	q := query.From(constants.TableKnowledgeArticleLink).
+		Select(knowledgeLinkColumns).
+		Where(constants.FieldSysKnowledgeArticleLink_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysKnowledgeArticleLink_RecordID+" = ?", recordID).
+		OrderBy(constants.FieldSysKnowledgeArticleLink_CreatedDate, constants.SortDESC).
+		Build()
+	return r.queryLinks(ctx, q)
+}
+
+// GetLink returns a link by ID, or nil if not found
+func (r *KnowledgeRepository) GetLink(ctx context.Context, id string) (*models.SystemKnowledgeArticleLink, error) {
+	q := query.From(constants.TableKnowledgeArticleLink).
+		Select(knowledgeLinkColumns).
+		Where(constants.FieldSysKnowledgeArticleLink_ID+" = ?", id).
+		Limit(1).
+		Build()
+	links, err := r.queryLinks(ctx, q)
+	if err != nil || len(links) == 0 {
+		return nil, err
+	}
+	return links[0], nil
+}
+
+func (r *KnowledgeRepository) queryLinks(ctx context.Context, q query.QueryResult) ([]*models.SystemKnowledgeArticleLink, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query knowledge article links: %w", err)
+	}
+	defer rows.Close()
+
+	links := make([]*models.SystemKnowledgeArticleLink, 0)
+	for rows.Next() {
+		var l models.SystemKnowledgeArticleLink
+		if err := rows.Scan(&l.ID, &l.ArticleID, &l.ObjectAPIName, &l.RecordID, &l.VersionNumber, &l.LinkedByID,
+			&l.CreatedDate, &l.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan knowledge article link: %w", err)
+		}
+		links = append(links, &l)
+	}
+	return links, rows.Err()
+}
+
+// InsertLink attaches an article to a record
+func (r *KnowledgeRepository) InsertLink(ctx context.Context, l *models.SystemKnowledgeArticleLink) error {
+	now := time.Now()
+	q := query.Insert(constants.TableKnowledgeArticleLink, map[string]interface{}{
+		constants.FieldSysKnowledgeArticleLink_ID:               l.ID,
+		constants.FieldSysKnowledgeArticleLink_ArticleID:        l.ArticleID,
+		constants.FieldSysKnowledgeArticleLink_ObjectAPIName:    l.ObjectAPIName,
+		constants.FieldSysKnowledgeArticleLink_RecordID:         l.RecordID,
+		constants.FieldSysKnowledgeArticleLink_VersionNumber:    l.VersionNumber,
+		constants.FieldSysKnowledgeArticleLink_LinkedByID:       l.LinkedByID,
+		constants.FieldSysKnowledgeArticleLink_CreatedDate:      knowledgeTimestamp(now),
+		constants.FieldSysKnowledgeArticleLink_LastModifiedDate: knowledgeTimestamp(now),
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert knowledge article link: %w", err)
+	}
+	l.CreatedDate = now
+	l.LastModifiedDate = now
+	return nil
+}
+
+// DeleteLink detaches an article from a record
+func (r *KnowledgeRepository) DeleteLink(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableKnowledgeArticleLink).
+		Where(constants.FieldSysKnowledgeArticleLink_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete knowledge article link: %w", err)
+	}
+	return nil
+}
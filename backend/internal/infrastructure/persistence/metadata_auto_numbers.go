@@ -0,0 +1,143 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GetAutoNumber queries the auto number of a field, or nil if the field has none
+func (r *MetadataRepository) GetAutoNumber(ctx context.Context, objectAPIName, fieldAPIName string) (*models.AutoNumber, error) {
+	ans, err := r.GetAutoNumbers(ctx, objectAPIName)
+	if err != nil {
+		return nil, err
+	}
+	for _, an := range ans {
+		if strings.EqualFold(an.FieldAPIName, fieldAPIName) {
+			return an, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateAutoNumberSettings changes the display format and gap-free mode of an auto number
+// without touching its sequence
+func (r *MetadataRepository) UpdateAutoNumberSettings(ctx context.Context, id, displayFormat string, gapFree bool) error {
+	q := query.Update(constants.TableAutoNumber).
+		Set(map[string]interface{}{
+			constants.FieldSysAutoNumber_DisplayFormat: displayFormat,
+			constants.FieldSysAutoNumber_GapFree:       gapFree,
+			constants.FieldLastModifiedDate:            time.Now(),
+		}).
+		Where(constants.FieldID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// DeleteAutoNumber removes the auto number of a field
+func (r *MetadataRepository) DeleteAutoNumber(ctx context.Context, objectAPIName, fieldAPIName string) error {
+	q := query.Delete(constants.TableAutoNumber).
+		Where(constants.FieldObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysAutoNumber_FieldAPIName+" = ?", fieldAPIName).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// BackfillAutoNumber numbers every existing record of the object (including deleted ones) in
+// creation order, continuing the field's sequence. The sequence row stays locked for the
+// whole run so concurrent inserts wait instead of taking the same numbers. Returns the number
+// of records updated.
+func (r *MetadataRepository) BackfillAutoNumber(ctx context.Context, an *models.AutoNumber, format func(int) string) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	lock := query.From(constants.TableAutoNumber).
+		Select([]string{constants.FieldSysAutoNumber_CurrentNumber, constants.FieldSysAutoNumber_StartingNumber}).
+		Where(constants.FieldID+" = ?", an.ID).
+		Build()
+	var current, starting int
+	if err := tx.QueryRowContext(ctx, lock.SQL+" FOR UPDATE", lock.Params...).Scan(&current, &starting); err != nil {
+		return 0, fmt.Errorf("failed to lock auto-number %s: %w", an.ID, err)
+	}
+	if current < starting-1 {
+		current = starting - 1
+	}
+
+	sel := query.From(an.ObjectAPIName).
+		Select([]string{constants.FieldID}).
+		OrderBy(constants.FieldCreatedDate, constants.SortASC).
+		Build()
+	rows, err := tx.QueryContext(ctx, sel.SQL, sel.Params...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query %s records: %w", an.ObjectAPIName, err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	upd := query.Update(an.ObjectAPIName).
+		Set(map[string]interface{}{an.FieldAPIName: nil}).
+		Where(constants.FieldID+" = ?", nil).
+		Build()
+	stmt, err := tx.PrepareContext(ctx, upd.SQL)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	for _, id := range ids {
+		current++
+		if _, err := stmt.ExecContext(ctx, format(current), id); err != nil {
+			return 0, fmt.Errorf("failed to number record %s: %w", id, err)
+		}
+	}
+
+	counter := query.Update(constants.TableAutoNumber).
+		Set(map[string]interface{}{
+			constants.FieldSysAutoNumber_CurrentNumber: current,
+			constants.FieldLastModifiedDate:            time.Now(),
+		}).
+		Where(constants.FieldID+" = ?", an.ID).
+		Build()
+	if _, err := tx.ExecContext(ctx, counter.SQL, counter.Params...); err != nil {
+		return 0, fmt.Errorf("failed to update auto-number %s: %w", an.ID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	an.CurrentValue = current
+	return len(ids), nil
+}
+
+// SetFieldDefaultValue overwrites the default value of a field, which holds the display format
+// of auto-number fields
+func (r *MetadataRepository) SetFieldDefaultValue(ctx context.Context, fieldID string, value *string) error {
+	q := query.Update(constants.TableField).
+		Set(map[string]interface{}{
+			constants.FieldSysField_DefaultValue: value,
+			constants.FieldLastModifiedDate:      time.Now(),
+		}).
+		Where(constants.FieldID+" = ?", fieldID).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
@@ -0,0 +1,285 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+var customMetadataTypeColumns = []string{
+	constants.FieldSysCustomMetadataType_ID,
+	constants.FieldSysCustomMetadataType_APIName,
+	constants.FieldSysCustomMetadataType_Label,
+	constants.FieldSysCustomMetadataType_Description,
+	constants.FieldSysCustomMetadataType_Fields,
+	constants.FieldSysCustomMetadataType_CreatedDate,
+	constants.FieldSysCustomMetadataType_LastModifiedDate,
+}
+
+var customMetadataRecordColumns = []string{
+	constants.FieldSysCustomMetadataRecord_ID,
+	constants.FieldSysCustomMetadataRecord_TypeAPIName,
+	constants.FieldSysCustomMetadataRecord_DeveloperName,
+	constants.FieldSysCustomMetadataRecord_Label,
+	constants.FieldSysCustomMetadataRecord_FieldValues,
+	constants.FieldSysCustomMetadataRecord_CreatedDate,
+	constants.FieldSysCustomMetadataRecord_LastModifiedDate,
+}
+
+// ==================== Custom Metadata Types ====================
+
+// GetCustomMetadataTypes queries all custom metadata types ordered by API name
+func (r *MetadataRepository) GetCustomMetadataTypes(ctx context.Context) ([]*models.CustomMetadataType, error) {
+	q := query.From(constants.TableCustomMetadataType).
+		Select(customMetadataTypeColumns).
+		OrderBy(constants.FieldSysCustomMetadataType_APIName, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom metadata types: %w", err)
+	}
+	defer rows.Close()
+
+	types := make([]*models.CustomMetadataType, 0)
+	for rows.Next() {
+		t, err := r.scanCustomMetadataType(rows)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+	}
+	return types, rows.Err()
+}
+
+// GetCustomMetadataType queries a custom metadata type by API name, or nil if not found
+func (r *MetadataRepository) GetCustomMetadataType(ctx context.Context, apiName string) (*models.CustomMetadataType, error) {
+	q := query.From(constants.TableCustomMetadataType).
+		Select(customMetadataTypeColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomMetadataType, constants.FieldSysCustomMetadataType_APIName), apiName).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom metadata type: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return r.scanCustomMetadataType(rows)
+}
+
+func (r *MetadataRepository) scanCustomMetadataType(rows *sql.Rows) (*models.CustomMetadataType, error) {
+	var t models.CustomMetadataType
+	var description, fields sql.NullString
+	if err := rows.Scan(&t.ID, &t.APIName, &t.Label, &description, &fields, &t.CreatedDate, &t.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan custom metadata type: %w", err)
+	}
+	if description.Valid {
+		t.Description = &description.String
+	}
+	t.Fields = make([]models.CustomMetadataField, 0)
+	if fields.Valid {
+		r.unmarshalJSON(fields.String, &t.Fields)
+	}
+	return &t, nil
+}
+
+// CreateCustomMetadataType inserts a custom metadata type
+func (r *MetadataRepository) CreateCustomMetadataType(ctx context.Context, t *models.CustomMetadataType) error {
+	fields, err := json.Marshal(t.Fields)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Insert(constants.TableCustomMetadataType, map[string]interface{}{
+		constants.FieldSysCustomMetadataType_ID:               t.ID,
+		constants.FieldSysCustomMetadataType_APIName:          t.APIName,
+		constants.FieldSysCustomMetadataType_Label:            t.Label,
+		constants.FieldSysCustomMetadataType_Description:      t.Description,
+		constants.FieldSysCustomMetadataType_Fields:           string(fields),
+		constants.FieldSysCustomMetadataType_CreatedDate:      now,
+		constants.FieldSysCustomMetadataType_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert custom metadata type: %w", err)
+	}
+	t.CreatedDate = now
+	t.LastModifiedDate = now
+	return nil
+}
+
+// UpdateCustomMetadataType overwrites the label, description and fields of a custom metadata type
+func (r *MetadataRepository) UpdateCustomMetadataType(ctx context.Context, t *models.CustomMetadataType) error {
+	fields, err := json.Marshal(t.Fields)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Update(constants.TableCustomMetadataType).
+		Set(map[string]interface{}{
+			constants.FieldSysCustomMetadataType_Label:            t.Label,
+			constants.FieldSysCustomMetadataType_Description:      t.Description,
+			constants.FieldSysCustomMetadataType_Fields:           string(fields),
+			constants.FieldSysCustomMetadataType_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysCustomMetadataType_ID+" = ?", t.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update custom metadata type: %w", err)
+	}
+	t.LastModifiedDate = now
+	return nil
+}
+
+// DeleteCustomMetadataType deletes a custom metadata type and all of its records
+func (r *MetadataRepository) DeleteCustomMetadataType(ctx context.Context, t *models.CustomMetadataType) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	records := query.Delete(constants.TableCustomMetadataRecord).
+		Where(constants.FieldSysCustomMetadataRecord_TypeAPIName+" = ?", t.APIName).
+		Build()
+	if _, err := tx.ExecContext(ctx, records.SQL, records.Params...); err != nil {
+		return fmt.Errorf("failed to delete custom metadata records: %w", err)
+	}
+	typ := query.Delete(constants.TableCustomMetadataType).
+		Where(constants.FieldSysCustomMetadataType_ID+" = ?", t.ID).
+		Build()
+	if _, err := tx.ExecContext(ctx, typ.SQL, typ.Params...); err != nil {
+		return fmt.Errorf("failed to delete custom metadata type: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ==================== Custom Metadata Records ====================
+
+// GetCustomMetadataRecords queries the records of a custom metadata type, or of all types when
+// typeAPIName is empty
+func (r *MetadataRepository) GetCustomMetadataRecords(ctx context.Context, typeAPIName string) ([]*models.CustomMetadataRecord, error) {
+	b := query.From(constants.TableCustomMetadataRecord).
+		Select(customMetadataRecordColumns).
+		OrderBy(constants.FieldSysCustomMetadataRecord_DeveloperName, constants.SortASC)
+	if typeAPIName != "" {
+		b = b.Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomMetadataRecord, constants.FieldSysCustomMetadataRecord_TypeAPIName), typeAPIName)
+	}
+	q := b.Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom metadata records: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]*models.CustomMetadataRecord, 0)
+	for rows.Next() {
+		rec, err := r.scanCustomMetadataRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+// GetCustomMetadataRecord queries a custom metadata record by type and developer name, or nil
+// if not found
+func (r *MetadataRepository) GetCustomMetadataRecord(ctx context.Context, typeAPIName, developerName string) (*models.CustomMetadataRecord, error) {
+	q := query.From(constants.TableCustomMetadataRecord).
+		Select(customMetadataRecordColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomMetadataRecord, constants.FieldSysCustomMetadataRecord_TypeAPIName), typeAPIName).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableCustomMetadataRecord, constants.FieldSysCustomMetadataRecord_DeveloperName), developerName).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom metadata record: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return r.scanCustomMetadataRecord(rows)
+}
+
+func (r *MetadataRepository) scanCustomMetadataRecord(rows *sql.Rows) (*models.CustomMetadataRecord, error) {
+	var rec models.CustomMetadataRecord
+	var values sql.NullString
+	if err := rows.Scan(&rec.ID, &rec.TypeAPIName, &rec.DeveloperName, &rec.Label, &values, &rec.CreatedDate, &rec.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan custom metadata record: %w", err)
+	}
+	rec.Values = make(map[string]interface{})
+	if values.Valid {
+		r.unmarshalJSON(values.String, &rec.Values)
+	}
+	return &rec, nil
+}
+
+// CreateCustomMetadataRecord inserts a custom metadata record
+func (r *MetadataRepository) CreateCustomMetadataRecord(ctx context.Context, rec *models.CustomMetadataRecord) error {
+	values, err := json.Marshal(rec.Values)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Insert(constants.TableCustomMetadataRecord, map[string]interface{}{
+		constants.FieldSysCustomMetadataRecord_ID:               rec.ID,
+		constants.FieldSysCustomMetadataRecord_TypeAPIName:      rec.TypeAPIName,
+		constants.FieldSysCustomMetadataRecord_DeveloperName:    rec.DeveloperName,
+		constants.FieldSysCustomMetadataRecord_Label:            rec.Label,
+		constants.FieldSysCustomMetadataRecord_FieldValues:      string(values),
+		constants.FieldSysCustomMetadataRecord_CreatedDate:      now,
+		constants.FieldSysCustomMetadataRecord_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert custom metadata record: %w", err)
+	}
+	rec.CreatedDate = now
+	rec.LastModifiedDate = now
+	return nil
+}
+
+// UpdateCustomMetadataRecord overwrites the label and values of a custom metadata record
+func (r *MetadataRepository) UpdateCustomMetadataRecord(ctx context.Context, rec *models.CustomMetadataRecord) error {
+	values, err := json.Marshal(rec.Values)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q := query.Update(constants.TableCustomMetadataRecord).
+		Set(map[string]interface{}{
+			constants.FieldSysCustomMetadataRecord_Label:            rec.Label,
+			constants.FieldSysCustomMetadataRecord_FieldValues:      string(values),
+			constants.FieldSysCustomMetadataRecord_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysCustomMetadataRecord_ID+" = ?", rec.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update custom metadata record: %w", err)
+	}
+	rec.LastModifiedDate = now
+	return nil
+}
+
+// DeleteCustomMetadataRecord deletes a custom metadata record
+func (r *MetadataRepository) DeleteCustomMetadataRecord(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableCustomMetadataRecord).
+		Where(constants.FieldSysCustomMetadataRecord_ID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
@@ -0,0 +1,304 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// recordTypeSelect selects record types joined to their object for the API name
+func recordTypeSelect() *query.Builder {
+	rt := constants.TableRecordType
+	return query.From(rt).
+		Select([]string{
+			constants.FieldSysRecordType_ID,
+			constants.FieldSysRecordType_Name,
+			constants.FieldSysRecordType_Description,
+			constants.FieldSysRecordType_IsActive,
+			constants.FieldSysRecordType_IsDefault,
+			constants.FieldSysRecordType_PicklistValues,
+			constants.FieldSysRecordType_CreatedDate,
+			constants.FieldSysRecordType_LastModifiedDate,
+		}).
+		AddSelectRaw(fmt.Sprintf("`o`.`%s`", constants.FieldSysObject_APIName), constants.FieldObjectAPIName).
+		Join("INNER", constants.TableObject, "o", fmt.Sprintf("`o`.`%s` = `%s`.`%s`", constants.FieldID, rt, constants.FieldSysRecordType_ObjectID)).
+		ExcludeDeleted()
+}
+
+// GetRecordTypes queries the record types of an object ordered by name
+func (r *MetadataRepository) GetRecordTypes(ctx context.Context, objectAPIName string) ([]*models.RecordType, error) {
+	q := recordTypeSelect().
+		Where(fmt.Sprintf("LOWER(`o`.`%s`) = LOWER(?)", constants.FieldSysObject_APIName), objectAPIName).
+		OrderBy(constants.FieldSysRecordType_Name, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query record types: %w", err)
+	}
+	defer rows.Close()
+
+	types := make([]*models.RecordType, 0)
+	for rows.Next() {
+		rt, err := r.scanRecordType(rows)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, rt)
+	}
+	return types, rows.Err()
+}
+
+// GetRecordType queries a single record type by ID, or nil if not found
+func (r *MetadataRepository) GetRecordType(ctx context.Context, id string) (*models.RecordType, error) {
+	q := recordTypeSelect().
+		Where(fmt.Sprintf("`%s`.`%s` = ?", constants.TableRecordType, constants.FieldSysRecordType_ID), id).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query record type: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return r.scanRecordType(rows)
+}
+
+func (r *MetadataRepository) scanRecordType(rows *sql.Rows) (*models.RecordType, error) {
+	var rt models.RecordType
+	var description, picklistValues sql.NullString
+	var isActive, isDefault sql.NullBool
+	if err := rows.Scan(
+		&rt.ID, &rt.Name, &description, &isActive, &isDefault, &picklistValues,
+		&rt.CreatedDate, &rt.LastModifiedDate, &rt.ObjectAPIName,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan record type: %w", err)
+	}
+
+	rt.Label = rt.Name // The table has no separate label
+	if description.Valid {
+		rt.Description = &description.String
+	}
+	rt.IsActive = isActive.Bool
+	rt.IsDefault = isDefault.Bool
+	if picklistValues.Valid {
+		r.unmarshalJSON(picklistValues.String, &rt.PicklistValues)
+	}
+	return &rt, nil
+}
+
+// CreateRecordType inserts a record type for the object named by rt.ObjectAPIName
+func (r *MetadataRepository) CreateRecordType(ctx context.Context, rt *models.RecordType) error {
+	var objectID string
+	err := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE LOWER(%s) = LOWER(?)",
+		constants.FieldID, constants.TableObject, constants.FieldSysObject_APIName), rt.ObjectAPIName).Scan(&objectID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve object id: %w", err)
+	}
+
+	values, err := r.recordTypeValues(rt)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysRecordType_ID] = rt.ID
+	values[constants.FieldSysRecordType_ObjectID] = objectID
+	values[constants.FieldSysRecordType_CreatedDate] = now
+	values[constants.FieldSysRecordType_LastModifiedDate] = now
+
+	q := query.Insert(constants.TableRecordType, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert record type: %w", err)
+	}
+	rt.CreatedDate = now
+	rt.LastModifiedDate = now
+	return nil
+}
+
+// UpdateRecordType overwrites the editable settings of a record type
+func (r *MetadataRepository) UpdateRecordType(ctx context.Context, rt *models.RecordType) error {
+	values, err := r.recordTypeValues(rt)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysRecordType_LastModifiedDate] = now
+
+	q := query.Update(constants.TableRecordType).
+		Set(values).
+		Where(constants.FieldSysRecordType_ID+" = ?", rt.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update record type: %w", err)
+	}
+	rt.LastModifiedDate = now
+	return nil
+}
+
+// DeleteRecordType deletes a record type; its profile assignments are removed by cascade
+func (r *MetadataRepository) DeleteRecordType(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableRecordType).
+		Where(constants.FieldSysRecordType_ID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// ClearDefaultRecordType unsets the object-wide default on all record types of an object except keepID
+func (r *MetadataRepository) ClearDefaultRecordType(ctx context.Context, objectAPIName, keepID string) error {
+	_, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		"UPDATE %s SET %s = 0 WHERE %s <> ? AND %s = (SELECT %s FROM %s WHERE LOWER(%s) = LOWER(?))",
+		constants.TableRecordType, constants.FieldSysRecordType_IsDefault, constants.FieldSysRecordType_ID,
+		constants.FieldSysRecordType_ObjectID, constants.FieldID, constants.TableObject, constants.FieldSysObject_APIName,
+	), keepID, objectAPIName)
+	return err
+}
+
+// RecordTypeInUse reports whether any record of an object references the record type
+func (r *MetadataRepository) RecordTypeInUse(ctx context.Context, objectAPIName, id string) (bool, error) {
+	column, err := query.QuoteColumn(objectAPIName, constants.FieldRecordTypeID)
+	if err != nil {
+		return false, err
+	}
+	q := query.From(objectAPIName).
+		Select([]string{constants.FieldID}).
+		Where(column+" = ?", id).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	return rows.Next(), rows.Err()
+}
+
+func (r *MetadataRepository) recordTypeValues(rt *models.RecordType) (map[string]interface{}, error) {
+	var picklistValues interface{}
+	if len(rt.PicklistValues) > 0 {
+		b, err := r.marshalJSON(rt.PicklistValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal picklist values: %w", err)
+		}
+		picklistValues = b
+	}
+	return map[string]interface{}{
+		constants.FieldSysRecordType_Name:           rt.Name,
+		constants.FieldSysRecordType_Description:    rt.Description,
+		constants.FieldSysRecordType_IsActive:       rt.IsActive,
+		constants.FieldSysRecordType_IsDefault:      rt.IsDefault,
+		constants.FieldSysRecordType_PicklistValues: picklistValues,
+	}, nil
+}
+
+// ==================== Profile Record Type Assignments ====================
+
+var profileRecordTypeColumns = []string{
+	constants.FieldSysProfileRecordType_ID,
+	constants.FieldSysProfileRecordType_ProfileID,
+	constants.FieldSysProfileRecordType_ObjectAPIName,
+	constants.FieldSysProfileRecordType_RecordTypeID,
+	constants.FieldSysProfileRecordType_IsDefault,
+	constants.FieldSysProfileRecordType_LayoutID,
+	constants.FieldSysProfileRecordType_CreatedDate,
+	constants.FieldSysProfileRecordType_LastModifiedDate,
+}
+
+// GetProfileRecordTypes returns the record type assignments of a profile for an object
+func (r *MetadataRepository) GetProfileRecordTypes(ctx context.Context, profileID, objectAPIName string) ([]*models.ProfileRecordType, error) {
+	q := query.From(constants.TableProfileRecordType).
+		Select(profileRecordTypeColumns).
+		Where(constants.FieldSysProfileRecordType_ProfileID+" = ?", profileID).
+		Where(fmt.Sprintf("LOWER(%s) = LOWER(?)", constants.FieldSysProfileRecordType_ObjectAPIName), objectAPIName).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profile record types: %w", err)
+	}
+	defer rows.Close()
+
+	assignments := make([]*models.ProfileRecordType, 0)
+	for rows.Next() {
+		var a models.ProfileRecordType
+		var isDefault sql.NullBool
+		var layoutID sql.NullString
+		if err := rows.Scan(&a.ID, &a.ProfileID, &a.ObjectAPIName, &a.RecordTypeID, &isDefault, &layoutID,
+			&a.CreatedDate, &a.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan profile record type: %w", err)
+		}
+		a.IsDefault = isDefault.Bool
+		if layoutID.Valid {
+			a.LayoutID = &layoutID.String
+		}
+		assignments = append(assignments, &a)
+	}
+	return assignments, rows.Err()
+}
+
+// AssignRecordTypeToProfile makes a record type available to a profile, replacing any
+// existing assignment. A default assignment clears the profile's other defaults for the object.
+func (r *MetadataRepository) AssignRecordTypeToProfile(ctx context.Context, a *models.ProfileRecordType) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if a.IsDefault {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET %s = 0 WHERE %s = ? AND LOWER(%s) = LOWER(?)",
+			constants.TableProfileRecordType, constants.FieldSysProfileRecordType_IsDefault,
+			constants.FieldSysProfileRecordType_ProfileID, constants.FieldSysProfileRecordType_ObjectAPIName,
+		), a.ProfileID, a.ObjectAPIName); err != nil {
+			return fmt.Errorf("failed to clear profile default record type: %w", err)
+		}
+	}
+
+	now := time.Now()
+	if a.ID == "" {
+		a.ID = utils.GenerateID()
+	}
+	stmt := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		%s
+	`, constants.TableProfileRecordType,
+		constants.FieldSysProfileRecordType_ID, constants.FieldSysProfileRecordType_ProfileID,
+		constants.FieldSysProfileRecordType_ObjectAPIName, constants.FieldSysProfileRecordType_RecordTypeID,
+		constants.FieldSysProfileRecordType_IsDefault, constants.FieldSysProfileRecordType_LayoutID,
+		constants.FieldSysProfileRecordType_CreatedDate, constants.FieldSysProfileRecordType_LastModifiedDate,
+		query.ActiveDialect().Upsert(
+			[]string{constants.FieldSysProfileRecordType_ProfileID, constants.FieldSysProfileRecordType_RecordTypeID},
+			[]string{constants.FieldSysProfileRecordType_IsDefault, constants.FieldSysProfileRecordType_LayoutID,
+				constants.FieldSysProfileRecordType_LastModifiedDate}))
+	if _, err := tx.ExecContext(ctx, stmt, a.ID, a.ProfileID, a.ObjectAPIName, a.RecordTypeID, a.IsDefault, a.LayoutID, now, now); err != nil {
+		return fmt.Errorf("failed to assign record type to profile: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	a.CreatedDate = now
+	a.LastModifiedDate = now
+	return nil
+}
+
+// RemoveRecordTypeFromProfile removes a record type assignment from a profile
+func (r *MetadataRepository) RemoveRecordTypeFromProfile(ctx context.Context, profileID, recordTypeID string) error {
+	q := query.Delete(constants.TableProfileRecordType).
+		Where(constants.FieldSysProfileRecordType_ProfileID+" = ?", profileID).
+		Where(constants.FieldSysProfileRecordType_RecordTypeID+" = ?", recordTypeID).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"github.com/nexuscrm/backend/pkg/query"
 	"log"
 	"strings"
 	"time"
@@ -14,12 +15,6 @@ import (
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
-const (
-	// Local constants for fields not yet in shared constants
-	fieldRecordTypeIsDefault         = "is_default"
-	fieldRecordTypeBusinessProcessID = "business_process_id"
-)
-
 type MetadataRepository struct {
 	db *sql.DB
 }
@@ -44,6 +39,11 @@ var objectColumns = []string{
 	constants.FieldSysObject_ListFields,
 	constants.FieldSysObject_AppID,
 	constants.FieldSysObject_ThemeColor,
+	constants.FieldSysObject_TableType,
+	constants.FieldLastModifiedDate,
+	// Field changes count as changes to their object
+	fmt.Sprintf("(SELECT MAX(f.%s) FROM %s f WHERE f.%s = %s.%s)",
+		constants.FieldLastModifiedDate, constants.TableField, constants.FieldObjectID, constants.TableObject, constants.FieldID),
 }
 
 var fieldColumns = []string{
@@ -78,6 +78,10 @@ var fieldColumns = []string{
 	constants.FieldSysField_ControllingField,
 	constants.FieldSysField_PicklistDependency,
 	constants.FieldSysField_RollupConfig,
+	constants.FieldSysField_InactiveOptions,
+	constants.FieldSysField_ValueSet,
+	constants.FieldSysField_Indexed,
+	constants.FieldSysField_LookupFilter,
 }
 
 var actionColumns = []string{
@@ -89,6 +93,7 @@ var actionColumns = []string{
 	constants.FieldSysAction_Icon,
 	constants.FieldSysAction_TargetObject,
 	constants.FieldSysAction_Config,
+	constants.FieldSysAction_LastModifiedDate,
 }
 
 var validationRuleColumns = []string{
@@ -217,77 +222,13 @@ func (r *MetadataRepository) GetFieldsForObject(ctx context.Context, objectID st
 	return fields, nil
 }
 
-// GetRecordTypes queries record types for an object
-func (r *MetadataRepository) GetRecordTypes(ctx context.Context, objectAPIName string) ([]*models.RecordType, error) {
-	// 1. Resolve Object ID
-	var objectID string
-	err := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", constants.FieldID, constants.TableObject, constants.FieldSysObject_APIName), objectAPIName).Scan(&objectID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return []*models.RecordType{}, nil
-		}
-		return nil, fmt.Errorf("failed to resolve object id: %w", err)
-	}
-
-	// 2. Query Record Types by Object ID
-	// Note: _System_RecordType has columns: id, object_id, name, description, is_active, is_master
-	cols := strings.Join([]string{
-		constants.FieldID, constants.FieldObjectID, constants.FieldSysRecordType_Name,
-		constants.FieldSysRecordType_Description, constants.FieldSysRecordType_IsActive,
-		"is_master", // Missing constant for is_master
-		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
-	}, ", ")
-
-	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", cols, constants.TableRecordType, constants.FieldObjectID)
-
-	rows, err := r.db.QueryContext(ctx, query, objectID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	types := make([]*models.RecordType, 0)
-	for rows.Next() {
-		var rt models.RecordType
-		var description sql.NullString
-		var isActive, isMaster sql.NullBool
-		var objID string
-
-		// Scan matching the selected columns
-		if err := rows.Scan(
-			&rt.ID, &objID, &rt.Name,
-			&description, &isActive, &isMaster,
-			&rt.CreatedDate, &rt.LastModifiedDate,
-		); err != nil {
-			log.Printf("Warning: Failed to scan record type: %v", err)
-			continue
-		}
-
-		rt.ObjectAPIName = objectAPIName // Set from argument
-		rt.Label = rt.Name               // Set Label to Name as table has no separate Label
-
-		desc := ""
-		if description.Valid {
-			desc = description.String
-		}
-		rt.Description = &desc
-
-		// BusinessProcessID does not exist in schema, leaving nil
-
-		rt.IsActive = isActive.Bool
-		// rt.IsMaster = isMaster.Bool // If struct has IsMaster
-
-		types = append(types, &rt)
-	}
-	return types, nil
-}
-
 // GetAutoNumbers queries auto numbers for an object
 func (r *MetadataRepository) GetAutoNumbers(ctx context.Context, objectAPIName string) ([]*models.AutoNumber, error) {
 	cols := strings.Join([]string{
 		constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysAutoNumber_FieldAPIName,
 		constants.FieldSysAutoNumber_DisplayFormat, constants.FieldSysAutoNumber_StartingNumber,
-		constants.FieldSysAutoNumber_CurrentNumber, constants.FieldCreatedDate, constants.FieldLastModifiedDate,
+		constants.FieldSysAutoNumber_CurrentNumber, constants.FieldSysAutoNumber_GapFree,
+		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 	query := fmt.Sprintf(`
 		SELECT %s
@@ -305,7 +246,7 @@ func (r *MetadataRepository) GetAutoNumbers(ctx context.Context, objectAPIName s
 		var an models.AutoNumber
 		if err := rows.Scan(
 			&an.ID, &an.ObjectAPIName, &an.FieldAPIName, &an.DisplayFormat,
-			&an.StartingNumber, &an.CurrentValue, &an.CreatedDate, &an.LastModifiedDate,
+			&an.StartingNumber, &an.CurrentValue, &an.GapFree, &an.CreatedDate, &an.LastModifiedDate,
 		); err != nil {
 			log.Printf("Warning: Failed to scan auto number: %v", err)
 			continue
@@ -368,41 +309,40 @@ func (r *MetadataRepository) GetRelationships(ctx context.Context, childObjectAP
 	return rels, nil
 }
 
-// GetFieldDependencies queries field dependencies for an object
+// GetFieldDependencies queries field dependencies for an object, or for every object when
+// objectAPIName is empty. Controlling and dependent fields are returned as API names.
 func (r *MetadataRepository) GetFieldDependencies(ctx context.Context, objectAPIName string) ([]*models.FieldDependency, error) {
-	// 1. Resolve Object ID
-	var objectID string
-	err := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", constants.FieldID, constants.TableObject, constants.FieldSysObject_APIName), objectAPIName).Scan(&objectID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return []*models.FieldDependency{}, nil
-		}
-		return nil, fmt.Errorf("failed to resolve object id: %w", err)
-	}
-
-	// 2. Query Field Dependencies via JOIN with _System_Field to filter by Object
 	// Schema: id, controlling_field_id, dependent_field_id, controlling_value, dependent_values
 	cols := strings.Join([]string{
 		"d." + constants.FieldID,
-		"d." + constants.FieldSysFieldDependency_ControllingFieldID,
-		"d." + constants.FieldSysFieldDependency_DependentFieldID,
+		"o." + constants.FieldSysObject_APIName,
+		"cf." + constants.FieldSysField_APIName,
+		"df." + constants.FieldSysField_APIName,
 		"d." + constants.FieldSysFieldDependency_ControllingValue,
 		"d." + constants.FieldSysFieldDependency_DependentValues,
 		"d." + constants.FieldCreatedDate,
 		"d." + constants.FieldLastModifiedDate,
 	}, ", ")
 
-	// We join on dependent_field_id as dependencies are usually part of the dependent field's definition
+	// Dependencies belong to the object of the dependent field
 	query := fmt.Sprintf(`
 		SELECT %s
 		FROM %s d
-		JOIN %s f ON d.%s = f.%s
-		WHERE f.%s = ?
+		JOIN %s df ON d.%s = df.%s
+		JOIN %s cf ON d.%s = cf.%s
+		JOIN %s o ON df.%s = o.%s
 	`, cols, constants.TableFieldDependency,
-		constants.TableField, constants.FieldSysFieldDependency_DependentFieldID, constants.FieldID, // d.dependent_field_id = f.id
-		constants.FieldObjectID)
+		constants.TableField, constants.FieldSysFieldDependency_DependentFieldID, constants.FieldID, // d.dependent_field_id = df.id
+		constants.TableField, constants.FieldSysFieldDependency_ControllingFieldID, constants.FieldID, // d.controlling_field_id = cf.id
+		constants.TableObject, constants.FieldSysField_ObjectID, constants.FieldID)
+
+	var args []interface{}
+	if objectAPIName != "" {
+		query += fmt.Sprintf(" WHERE LOWER(o.%s) = LOWER(?)", constants.FieldSysObject_APIName)
+		args = append(args, objectAPIName)
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, objectID)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +354,7 @@ func (r *MetadataRepository) GetFieldDependencies(ctx context.Context, objectAPI
 		var controllingValue, dependentValues sql.NullString
 
 		if err := rows.Scan(
-			&dep.ID, &dep.ControllingField, &dep.DependentField,
+			&dep.ID, &dep.ObjectAPIName, &dep.ControllingField, &dep.DependentField,
 			&controllingValue, &dependentValues,
 			&dep.CreatedDate, &dep.LastModifiedDate,
 		); err != nil {
@@ -422,8 +362,7 @@ func (r *MetadataRepository) GetFieldDependencies(ctx context.Context, objectAPI
 			continue
 		}
 
-		dep.ObjectAPIName = objectAPIName // Manual set
-
+		dep.IsActive = true
 		if controllingValue.Valid {
 			dep.ControllingValue = controllingValue.String
 		}
@@ -436,7 +375,55 @@ func (r *MetadataRepository) GetFieldDependencies(ctx context.Context, objectAPI
 
 		deps = append(deps, &dep)
 	}
-	return deps, nil
+	return deps, rows.Err()
+}
+
+// UpdatePicklistValues stores the active and inactive options of a picklist field
+func (r *MetadataRepository) UpdatePicklistValues(ctx context.Context, fieldID string, options, inactive []string) error {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	var inactiveJSON interface{}
+	if len(inactive) > 0 {
+		b, err := json.Marshal(inactive)
+		if err != nil {
+			return err
+		}
+		inactiveJSON = string(b)
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s = ?, %s = ?, %s = NOW() WHERE %s = ?",
+		constants.TableField, constants.FieldSysField_Options, constants.FieldSysField_InactiveOptions,
+		constants.FieldLastModifiedDate, constants.FieldID)
+	_, err = r.db.ExecContext(ctx, query, string(optionsJSON), inactiveJSON, fieldID)
+	return err
+}
+
+// SetFieldLookupFilter stores the filter of a lookup field, clearing it when filter is nil
+func (r *MetadataRepository) SetFieldLookupFilter(ctx context.Context, fieldID string, filter *string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = ?, %s = NOW() WHERE %s = ?",
+		constants.TableField, constants.FieldSysField_LookupFilter, constants.FieldLastModifiedDate, constants.FieldID)
+	_, err := r.db.ExecContext(ctx, query, ToNullString(filter), fieldID)
+	return err
+}
+
+// SetFieldTrackHistory records whether the value changes of a field are kept in its history.
+// Field IDs carry a random suffix, so the row is matched by object and API name.
+func (r *MetadataRepository) SetFieldTrackHistory(ctx context.Context, objectID, fieldAPIName string, track bool) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = ?, %s = NOW() WHERE %s = ? AND LOWER(%s) = LOWER(?)",
+		constants.TableField, constants.FieldSysField_TrackHistory, constants.FieldLastModifiedDate,
+		constants.FieldSysField_ObjectID, constants.FieldSysField_APIName)
+	_, err := r.db.ExecContext(ctx, query, track, objectID, fieldAPIName)
+	return err
+}
+
+// TouchObject marks an object as modified, for changes that leave no field row behind
+// such as deleting a field
+func (r *MetadataRepository) TouchObject(ctx context.Context, objectID string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = NOW() WHERE %s = ?", constants.TableObject, constants.FieldLastModifiedDate, constants.FieldID)
+	_, err := r.db.ExecContext(ctx, query, objectID)
+	return err
 }
 
 // =================================================================================
@@ -689,10 +676,11 @@ func (r *MetadataRepository) UpdateAction(ctx context.Context, actionID string,
 		fmt.Sprintf("%s=?", constants.FieldSysAction_Icon),
 		fmt.Sprintf("%s=?", constants.FieldSysAction_TargetObject),
 		fmt.Sprintf("%s=?", constants.FieldSysAction_Config),
+		fmt.Sprintf("%s=?", constants.FieldSysAction_LastModifiedDate),
 	}, ", ")
 	query := fmt.Sprintf(`UPDATE %s SET %s WHERE %s=?`, constants.TableAction, updatesCols, constants.FieldSysAction_ID)
 	_, err = r.db.ExecContext(ctx, query, updates.ObjectAPIName, updates.Name, updates.Label,
-		updates.Type, updates.Icon, targetObject, configJSON, actionID)
+		updates.Type, updates.Icon, targetObject, configJSON, time.Now(), actionID)
 	return err
 }
 
@@ -995,16 +983,16 @@ func (r *MetadataRepository) SaveLayout(ctx context.Context, layout *models.Page
 		return fmt.Errorf("failed to marshal layout: %w", err)
 	}
 
-	query := fmt.Sprintf(`
+	upsert := query.ActiveDialect().Upsert([]string{constants.FieldID},
+		[]string{constants.FieldObjectAPIName, constants.FieldSysLayout_Config},
+		fmt.Sprintf("%s = CURRENT_TIMESTAMP", constants.FieldLastModifiedDate))
+	stmt := fmt.Sprintf(`
 		INSERT INTO %s (%s, %s, %s, %s, %s) 
 		VALUES (?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		ON DUPLICATE KEY UPDATE 
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = CURRENT_TIMESTAMP
-	`, constants.TableLayout, constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysLayout_Config, constants.FieldCreatedDate, constants.FieldLastModifiedDate, constants.FieldObjectAPIName, constants.FieldObjectAPIName, constants.FieldSysLayout_Config, constants.FieldSysLayout_Config, constants.FieldLastModifiedDate)
+		%s
+	`, constants.TableLayout, constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysLayout_Config, constants.FieldCreatedDate, constants.FieldLastModifiedDate, upsert)
 
-	_, err = r.db.ExecContext(ctx, query, layout.ID, layout.ObjectAPIName, configJSON)
+	_, err = r.db.ExecContext(ctx, stmt, layout.ID, layout.ObjectAPIName, configJSON)
 	return err
 }
 
@@ -1020,17 +1008,15 @@ func (r *MetadataRepository) AssignLayoutToProfile(ctx context.Context, profileI
 		constants.FieldProfileID, constants.FieldObjectAPIName, constants.FieldSysProfileLayout_LayoutID,
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysProfileLayout_LayoutID, constants.FieldSysProfileLayout_LayoutID),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
-	query := fmt.Sprintf(`
+	upsert := query.ActiveDialect().Upsert([]string{constants.FieldProfileID, constants.FieldObjectAPIName},
+		[]string{constants.FieldSysProfileLayout_LayoutID}, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate))
+	stmt := fmt.Sprintf(`
 		INSERT INTO %s (%s) 
 		VALUES (?, ?, ?, NOW(), NOW())
-		ON DUPLICATE KEY UPDATE %s
-	`, constants.TableProfileLayout, cols, updates)
+		%s
+	`, constants.TableProfileLayout, cols, upsert)
 
-	_, err := r.db.ExecContext(ctx, query, profileID, objectAPIName, layoutID)
+	_, err := r.db.ExecContext(ctx, stmt, profileID, objectAPIName, layoutID)
 	return err
 }
 
@@ -1081,39 +1067,74 @@ func (r *MetadataRepository) DeleteApp(ctx context.Context, appID string) error
 	return err
 }
 
+// dashboardColumns is the column order expected by scanDashboard
+var dashboardColumns = []string{
+	constants.FieldID, constants.FieldSysDashboard_Name, constants.FieldSysDashboard_Description,
+	constants.FieldSysDashboard_Layout, constants.FieldSysDashboard_Widgets, constants.FieldSysDashboard_Filters,
+	constants.FieldSysDashboard_RunAs, constants.FieldSysDashboard_RunAsUserID,
+}
+
 // CreateDashboard creates a new dashboard
 func (r *MetadataRepository) CreateDashboard(ctx context.Context, dashboard *models.DashboardConfig) error {
-	widgetsJSON, err := r.marshalJSON(dashboard.Widgets)
+	values, err := r.dashboardValues(dashboard)
 	if err != nil {
-		return fmt.Errorf("failed to marshal widgets: %w", err)
-	}
-
-	// Handle description pointer or value
-	desc := ""
-	if dashboard.Description != nil {
-		desc = *dashboard.Description
+		return err
 	}
 
-	query := fmt.Sprintf("INSERT INTO %s (%s, %s, %s, %s, %s) VALUES (?, ?, ?, ?, ?)", constants.TableDashboard, constants.FieldID, constants.FieldSysDashboard_Name, constants.FieldSysDashboard_Description, constants.FieldSysDashboard_Layout, constants.FieldSysDashboard_Widgets)
-	_, err = r.db.ExecContext(ctx, query, dashboard.ID, dashboard.Label, desc, dashboard.Layout, widgetsJSON)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(dashboardColumns)), ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", constants.TableDashboard, strings.Join(dashboardColumns, ", "), placeholders)
+	_, err = r.db.ExecContext(ctx, query, append([]interface{}{dashboard.ID}, values...)...)
 	return err
 }
 
 // UpdateDashboard updates a dashboard
 func (r *MetadataRepository) UpdateDashboard(ctx context.Context, id string, dashboard *models.DashboardConfig) error {
+	values, err := r.dashboardValues(dashboard)
+	if err != nil {
+		return err
+	}
+
+	setClauses := make([]string, 0, len(dashboardColumns)-1)
+	for _, col := range dashboardColumns[1:] {
+		setClauses = append(setClauses, fmt.Sprintf("%s = ?", col))
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = ?", constants.TableDashboard, strings.Join(setClauses, ", "), constants.FieldID)
+	_, err = r.db.ExecContext(ctx, query, append(values, id)...)
+	return err
+}
+
+// dashboardValues returns the column values of a dashboard in dashboardColumns order (after ID)
+func (r *MetadataRepository) dashboardValues(dashboard *models.DashboardConfig) ([]interface{}, error) {
 	widgetsJSON, err := r.marshalJSON(dashboard.Widgets)
 	if err != nil {
-		return fmt.Errorf("failed to marshal widgets: %w", err)
+		return nil, fmt.Errorf("failed to marshal widgets: %w", err)
+	}
+
+	var filtersJSON sql.NullString
+	if len(dashboard.Filters) > 0 {
+		b, err := r.marshalJSON(dashboard.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filters: %w", err)
+		}
+		filtersJSON = sql.NullString{String: b, Valid: true}
 	}
 
+	// Handle description pointer or value
 	desc := ""
 	if dashboard.Description != nil {
 		desc = *dashboard.Description
 	}
 
-	query := fmt.Sprintf("UPDATE %s SET %s = ?, %s = ?, %s = ?, %s = ? WHERE %s = ?", constants.TableDashboard, constants.FieldSysDashboard_Name, constants.FieldSysDashboard_Description, constants.FieldSysDashboard_Layout, constants.FieldSysDashboard_Widgets, constants.FieldID)
-	_, err = r.db.ExecContext(ctx, query, dashboard.Label, desc, dashboard.Layout, widgetsJSON, id)
-	return err
+	runAs := dashboard.RunAs
+	if runAs == "" {
+		runAs = constants.DashboardRunAsViewer
+	}
+
+	return []interface{}{
+		dashboard.Label, desc, dashboard.Layout, widgetsJSON, filtersJSON,
+		string(runAs), ToNullString(dashboard.RunAsUserID),
+	}, nil
 }
 
 // DeleteDashboard deletes a dashboard
@@ -1124,7 +1145,7 @@ func (r *MetadataRepository) DeleteDashboard(ctx context.Context, id string) err
 
 // GetAllDashboards queries all dashboards
 func (r *MetadataRepository) GetAllDashboards(ctx context.Context) ([]*models.DashboardConfig, error) {
-	rows, err := r.db.QueryContext(ctx, fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s", constants.FieldID, constants.FieldSysDashboard_Name, constants.FieldSysDashboard_Description, constants.FieldSysDashboard_Layout, constants.FieldSysDashboard_Widgets, constants.TableDashboard))
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(dashboardColumns, ", "), constants.TableDashboard))
 	if err != nil {
 		return nil, err
 	}
@@ -1145,7 +1166,7 @@ func (r *MetadataRepository) GetAllDashboards(ctx context.Context) ([]*models.Da
 // GetDashboard queries a single dashboard
 func (r *MetadataRepository) GetDashboard(ctx context.Context, id string) (*models.DashboardConfig, error) {
 	db, err := r.scanDashboard(r.db.QueryRowContext(ctx,
-		fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s WHERE %s = ?", constants.FieldID, constants.FieldSysDashboard_Name, constants.FieldSysDashboard_Description, constants.FieldSysDashboard_Layout, constants.FieldSysDashboard_Widgets, constants.TableDashboard, constants.FieldID),
+		fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", strings.Join(dashboardColumns, ", "), constants.TableDashboard, constants.FieldID),
 		id,
 	))
 	if err != nil {
@@ -1157,9 +1178,18 @@ func (r *MetadataRepository) GetDashboard(ctx context.Context, id string) (*mode
 	return db, nil
 }
 
+// listViewColumns is the column order expected by scanListView
+var listViewColumns = []string{
+	constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysListView_Label,
+	constants.FieldSysListView_FilterExpr, constants.FieldSysListView_Fields,
+	constants.FieldSysListView_Visibility, constants.FieldSysListView_OwnerID, constants.FieldSysListView_ProfileIDs,
+	constants.FieldSysListView_ColumnSettings, constants.FieldSysListView_SortField, constants.FieldSysListView_SortDirection,
+	constants.FieldSysListView_Aggregates,
+}
+
 // GetListViews queries list views for an object
 func (r *MetadataRepository) GetListViews(ctx context.Context, objectAPIName string) ([]*models.ListView, error) {
-	query := fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s WHERE LOWER(%s) = LOWER(?)", constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysListView_Label, constants.FieldSysListView_FilterExpr, constants.FieldSysListView_Fields, constants.TableListView, constants.FieldObjectAPIName)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE LOWER(%s) = LOWER(?)", strings.Join(listViewColumns, ", "), constants.TableListView, constants.FieldObjectAPIName)
 	rows, err := r.db.QueryContext(ctx, query, objectAPIName)
 	if err != nil {
 		return nil, err
@@ -1178,6 +1208,16 @@ func (r *MetadataRepository) GetListViews(ctx context.Context, objectAPIName str
 	return views, nil
 }
 
+// GetListView retrieves a single list view by ID. Returns nil if not found.
+func (r *MetadataRepository) GetListView(ctx context.Context, id string) (*models.ListView, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", strings.Join(listViewColumns, ", "), constants.TableListView, constants.FieldID)
+	view, err := r.scanListView(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return view, err
+}
+
 // GetScheduledFlows returns all scheduled flows
 func (r *MetadataRepository) GetScheduledFlows(ctx context.Context) ([]*models.Flow, error) {
 	query := fmt.Sprintf(`
@@ -1224,38 +1264,36 @@ func (r *MetadataRepository) GetScheduledFlows(ctx context.Context) ([]*models.F
 
 // CreateListView creates a new list view
 func (r *MetadataRepository) CreateListView(ctx context.Context, view *models.ListView) error {
-	fieldsJSON, err := r.marshalJSON(view.Fields)
+	values, err := r.listViewValues(view)
 	if err != nil {
-		return fmt.Errorf("failed to marshal fields: %w", err)
+		return err
 	}
 
-	cols := strings.Join([]string{
-		constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysListView_Label,
-		constants.FieldSysListView_FilterExpr, constants.FieldSysListView_Fields,
-	}, ", ")
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(listViewColumns)), ", ")
+	args := append([]interface{}{view.ID, view.ObjectAPIName}, values...)
 	_, err = r.db.ExecContext(ctx,
-		fmt.Sprintf("INSERT INTO %s (%s) VALUES (?, ?, ?, ?, ?)", constants.TableListView, cols),
-		view.ID, view.ObjectAPIName, view.Label, view.FilterExpr, fieldsJSON,
+		fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", constants.TableListView, strings.Join(listViewColumns, ", "), placeholders),
+		args...,
 	)
 	return err
 }
 
 // UpdateListView updates a list view
 func (r *MetadataRepository) UpdateListView(ctx context.Context, id string, updates *models.ListView) error {
-	fieldsJSON, err := r.marshalJSON(updates.Fields)
+	values, err := r.listViewValues(updates)
 	if err != nil {
-		return fmt.Errorf("failed to marshal fields: %w", err)
+		return err
 	}
 
-	updateFields := strings.Join([]string{
-		fmt.Sprintf("%s = ?", constants.FieldSysListView_Label),
-		fmt.Sprintf("%s = ?", constants.FieldSysListView_FilterExpr),
-		fmt.Sprintf("%s = ?", constants.FieldSysListView_Fields),
-	}, ", ")
+	// Every column except ID and object API name is editable
+	setClauses := make([]string, 0, len(listViewColumns)-2)
+	for _, col := range listViewColumns[2:] {
+		setClauses = append(setClauses, fmt.Sprintf("%s = ?", col))
+	}
 
 	result, err := r.db.ExecContext(ctx,
-		fmt.Sprintf("UPDATE %s SET %s WHERE %s = ?", constants.TableListView, updateFields, constants.FieldID),
-		updates.Label, updates.FilterExpr, fieldsJSON, id,
+		fmt.Sprintf("UPDATE %s SET %s WHERE %s = ?", constants.TableListView, strings.Join(setClauses, ", "), constants.FieldID),
+		append(values, id)...,
 	)
 	if err != nil {
 		return err
@@ -1267,6 +1305,45 @@ func (r *MetadataRepository) UpdateListView(ctx context.Context, id string, upda
 	return nil
 }
 
+// listViewValues returns the editable column values of a list view in listViewColumns order (after ID and object)
+func (r *MetadataRepository) listViewValues(view *models.ListView) ([]interface{}, error) {
+	fieldsJSON, err := r.marshalJSON(view.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fields: %w", err)
+	}
+
+	optionalJSON := func(name string, v interface{}, empty bool) (sql.NullString, error) {
+		if empty {
+			return sql.NullString{}, nil
+		}
+		b, err := r.marshalJSON(v)
+		if err != nil {
+			return sql.NullString{}, fmt.Errorf("failed to marshal %s: %w", name, err)
+		}
+		return sql.NullString{String: b, Valid: true}, nil
+	}
+	columns, err := optionalJSON("column settings", view.Columns, len(view.Columns) == 0)
+	if err != nil {
+		return nil, err
+	}
+	aggregates, err := optionalJSON("aggregates", view.Aggregates, len(view.Aggregates) == 0)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := view.Visibility
+	if visibility == "" {
+		visibility = constants.ListViewVisibilityShared
+	}
+
+	return []interface{}{
+		view.Label, view.FilterExpr, fieldsJSON,
+		string(visibility), EmptyToNullString(view.OwnerID), SliceToNullJSON(view.ProfileIDs),
+		columns, EmptyToNullString(view.SortField), EmptyToNullString(view.SortDirection),
+		aggregates,
+	}, nil
+}
+
 // DeleteListView deletes a list view
 func (r *MetadataRepository) DeleteListView(ctx context.Context, id string) error {
 	result, err := r.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableListView, constants.FieldID), id)
@@ -1298,14 +1375,12 @@ func (r *MetadataRepository) UpsertLayout(ctx context.Context, layout *models.Pa
 		constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysLayout_Config,
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldObjectAPIName, constants.FieldObjectAPIName),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysLayout_Config, constants.FieldSysLayout_Config),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
+	upsert := query.ActiveDialect().Upsert([]string{constants.FieldID},
+		[]string{constants.FieldObjectAPIName, constants.FieldSysLayout_Config},
+		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate))
 	// Used for EnsureDefaultLayout and CreateSchema
 	_, err = r.db.ExecContext(ctx,
-		fmt.Sprintf("INSERT INTO %s (%s) VALUES (?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP) ON DUPLICATE KEY UPDATE %s", constants.TableLayout, cols, updates),
+		fmt.Sprintf("INSERT INTO %s (%s) VALUES (?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP) %s", constants.TableLayout, cols, upsert),
 		layout.ID, layout.ObjectAPIName, configJSON,
 	)
 	return err
@@ -1321,45 +1396,6 @@ func (r *MetadataRepository) BatchUpsertLayouts(ctx context.Context, layouts []*
 	return nil
 }
 
-// GetChildRelationships returns fields on OTHER objects that lookup to this object
-func (r *MetadataRepository) GetChildRelationships(ctx context.Context, parentObjectAPIName string) ([]*models.ObjectMetadata, error) {
-	// Query fields that reference this object
-	query := fmt.Sprintf(`
-		SELECT o.%s 
-		FROM %s f
-		JOIN %s o ON f.%s = o.%s
-		WHERE (f.%s = ? OR f.%s LIKE ?) AND f.%s = 'Lookup'
-	`,
-		constants.FieldAPIName,
-		constants.TableField,
-		constants.TableObject, constants.FieldObjectID, constants.FieldID,
-		constants.FieldReferenceTo, constants.FieldReferenceTo, constants.FieldType)
-
-	likePattern := fmt.Sprintf("%%%s%%", parentObjectAPIName)
-	rows, err := r.db.QueryContext(ctx, query, parentObjectAPIName, likePattern)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var children []*models.ObjectMetadata
-	for rows.Next() {
-		var apiName string
-		if err := rows.Scan(&apiName); err != nil {
-			log.Printf("Warning: Failed to scan child relationship: %v", err)
-			continue
-		}
-
-		// Load full schema for child
-		// Use internal method or recursive call?
-		// GetSchemaByAPIName is available on r *MetadataRepository
-		if schema, err := r.GetSchemaByAPIName(ctx, apiName); err == nil && schema != nil {
-			children = append(children, schema)
-		}
-	}
-	return children, nil
-}
-
 // UpsertUIComponent inserts or updates a UI component definition
 func (r *MetadataRepository) UpsertUIComponent(ctx context.Context, component *models.UIComponent) error {
 	// Check if exists by Name
@@ -1624,18 +1660,21 @@ func (r *MetadataRepository) ActivateTheme(ctx context.Context, themeID string)
 
 // UpsertAutoNumber inserts or updates an auto number configuration
 func (r *MetadataRepository) UpsertAutoNumber(ctx context.Context, id, objectAPIName, fieldAPIName, displayFormat string, startingNumber, currentNumber int) error {
-	query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
 		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW()) 
-		ON DUPLICATE KEY UPDATE %s = VALUES(%s), %s = NOW()`,
+		%s`,
 		constants.TableAutoNumber, constants.FieldID, constants.FieldObjectAPIName, constants.FieldSysAutoNumber_FieldAPIName,
 		constants.FieldSysAutoNumber_DisplayFormat, constants.FieldSysAutoNumber_StartingNumber,
 		constants.FieldSysAutoNumber_CurrentNumber, constants.FieldCreatedDate, constants.FieldLastModifiedDate,
-		constants.FieldSysAutoNumber_DisplayFormat, constants.FieldSysAutoNumber_DisplayFormat, constants.FieldLastModifiedDate)
-	_, err := r.db.ExecContext(ctx, query, id, objectAPIName, fieldAPIName, displayFormat, startingNumber, currentNumber)
+		query.ActiveDialect().Upsert([]string{constants.FieldObjectAPIName, constants.FieldSysAutoNumber_FieldAPIName},
+			[]string{constants.FieldSysAutoNumber_DisplayFormat}, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
+	_, err := r.db.ExecContext(ctx, stmt, id, objectAPIName, fieldAPIName, displayFormat, startingNumber, currentNumber)
 	return err
 }
 
-// GetRelatedListConfigs queries related list configs for relationship
+// GetRelatedListConfigs returns the lookup fields to an object together with their child
+// object and related list columns, in one query. reference_to holds a JSON array of object
+// names, so the parent is matched as a quoted element of it.
 func (r *MetadataRepository) GetRelatedListConfigs(ctx context.Context, layoutObjectAPIName string) ([]struct {
 	LookupFieldAPI    string
 	ChildObjectAPI    string
@@ -1648,15 +1687,15 @@ func (r *MetadataRepository) GetRelatedListConfigs(ctx context.Context, layoutOb
 		FROM %s f
 		JOIN %s o ON f.%s = o.%s
 		LEFT JOIN %s r ON r.%s = o.%s AND r.%s = f.%s
-		WHERE f.%s = ? AND f.%s = 'Lookup'
+		WHERE (f.%s = ? OR f.%s LIKE ? ESCAPE '!') AND f.%s = 'Lookup'
 	`,
 		constants.FieldAPIName, constants.FieldAPIName, constants.FieldPluralLabel, constants.FieldSysRelationship_RelatedListFields,
 		constants.TableField,
 		constants.TableObject, constants.FieldObjectID, constants.FieldID,
 		constants.TableRelationship, constants.FieldSysRelationship_ChildObjectAPIName, constants.FieldAPIName, constants.FieldSysRelationship_FieldAPIName, constants.FieldAPIName,
-		constants.FieldReferenceTo, constants.FieldType)
+		constants.FieldReferenceTo, constants.FieldReferenceTo, constants.FieldType)
 
-	rows, err := r.db.QueryContext(ctx, query, layoutObjectAPIName)
+	rows, err := r.db.QueryContext(ctx, query, layoutObjectAPIName, `%"`+escapeLike(layoutObjectAPIName)+`"%`)
 	if err != nil {
 		return nil, err
 	}
@@ -1685,6 +1724,16 @@ func (r *MetadataRepository) GetRelatedListConfigs(ctx context.Context, layoutOb
 	return results, nil
 }
 
+// likeEscaper escapes the LIKE wildcards of a value matched with ESCAPE '!'. '!' rather than
+// a backslash keeps the clause the same in every dialect, as backslashes in string literals
+// are escapes to MySQL but not to PostgreSQL.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// escapeLike makes value match itself only in a LIKE pattern with ESCAPE '!'
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
 // =================================================================================
 // Scan Helpers (Local to Package)
 // =================================================================================
@@ -1695,17 +1744,22 @@ type Scannable interface {
 
 func (r *MetadataRepository) scanObject(row Scannable) (*models.ObjectMetadata, error) {
 	var obj models.ObjectMetadata
-	var description, icon, pathField, listFieldsJSON, appID sql.NullString
+	var description, icon, pathField, listFieldsJSON, appID, tableType sql.NullString
 	var isCustom bool
+	var lastModifiedDateVal, fieldsLastModifiedVal interface{}
 
 	err := row.Scan(
 		&obj.ID, &obj.APIName, &obj.Label, &obj.PluralLabel,
 		&icon, &description, &isCustom, &pathField, &listFieldsJSON,
-		&appID, &obj.ThemeColor,
+		&appID, &obj.ThemeColor, &tableType, &lastModifiedDateVal, &fieldsLastModifiedVal,
 	)
 	if err != nil {
 		return nil, err
 	}
+	obj.LastModifiedDate = parseTime(lastModifiedDateVal)
+	if fieldsLastModified := parseTime(fieldsLastModifiedVal); fieldsLastModified.After(obj.LastModifiedDate) {
+		obj.LastModifiedDate = fieldsLastModified
+	}
 
 	if description.Valid {
 		val := description.String
@@ -1724,12 +1778,13 @@ func (r *MetadataRepository) scanObject(row Scannable) (*models.ObjectMetadata,
 	}
 	obj.IsCustom = isCustom
 	obj.IsSystem = !isCustom
+	obj.IsExternal = tableType.String == string(constants.TableTypeExternalObject)
 	// Unmarshal ListFields
 	if listFieldsJSON.Valid {
 		r.unmarshalJSON(listFieldsJSON.String, &obj.ListFields)
 	}
 	obj.SharingModel = constants.SharingModelPrivate
-	obj.Searchable = true
+	obj.Searchable = !obj.IsExternal // External records are not indexed or LIKE-searchable
 	obj.EnableHierarchySharing = false
 	obj.Fields = make([]models.FieldMetadata, 0)
 
@@ -1739,8 +1794,8 @@ func (r *MetadataRepository) scanObject(row Scannable) (*models.ObjectMetadata,
 func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, string, error) {
 	var field models.FieldMetadata
 	var id, objectAPIName string
-	var required, unique, isSystem, trackHistory, isNameField, isMasterDetail, isPolymorphic sql.NullBool
-	var options, referenceTo, formula, returnType, defaultValue, helpText, controllingField, picklistDependency, rollupConfig, deleteRule, relationshipName, regex, regexMessage, validator, description sql.NullString
+	var required, unique, indexed, isSystem, trackHistory, isNameField, isMasterDetail, isPolymorphic sql.NullBool
+	var options, referenceTo, formula, returnType, defaultValue, helpText, controllingField, picklistDependency, rollupConfig, inactiveOptions, valueSet, lookupFilter, deleteRule, relationshipName, regex, regexMessage, validator, description sql.NullString
 	var minValue, maxValue sql.NullFloat64
 	var minLength, maxLength sql.NullInt64
 
@@ -1751,7 +1806,7 @@ func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, st
 		&formula, &returnType, &defaultValue, &isPolymorphic, &helpText, &description,
 		&trackHistory, &minValue, &maxValue, &minLength, &maxLength,
 		&regex, &regexMessage, &validator, &controllingField,
-		&picklistDependency, &rollupConfig,
+		&picklistDependency, &rollupConfig, &inactiveOptions, &valueSet, &indexed, &lookupFilter,
 	)
 	if err != nil {
 		return nil, "", err
@@ -1759,6 +1814,7 @@ func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, st
 
 	field.Required = required.Bool
 	field.IsUnique = unique.Bool
+	field.IsIndexed = indexed.Bool
 	field.IsSystem = isSystem.Bool
 	field.TrackHistory = trackHistory.Bool
 	field.IsNameField = isNameField.Bool
@@ -1777,6 +1833,12 @@ func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, st
 	if controllingField.Valid {
 		field.ControllingField = &controllingField.String
 	}
+	if valueSet.Valid {
+		field.ValueSet = &valueSet.String
+	}
+	if lookupFilter.Valid {
+		field.LookupFilter = &lookupFilter.String
+	}
 	if relationshipName.Valid {
 		field.RelationshipName = &relationshipName.String
 	}
@@ -1823,6 +1885,9 @@ func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, st
 	if picklistDependency.Valid {
 		r.unmarshalJSON(picklistDependency.String, &field.PicklistDependency)
 	}
+	if inactiveOptions.Valid {
+		r.unmarshalJSON(inactiveOptions.String, &field.InactiveOptions)
+	}
 	if rollupConfig.Valid {
 		var rc models.RollupConfig
 		r.unmarshalJSON(rollupConfig.String, &rc)
@@ -1835,9 +1900,11 @@ func (r *MetadataRepository) scanField(row Scannable) (*models.FieldMetadata, st
 func (r *MetadataRepository) scanAction(row Scannable) (*models.ActionMetadata, error) {
 	var action models.ActionMetadata
 	var targetObject, configJSON sql.NullString
-	if err := row.Scan(&action.ID, &action.ObjectAPIName, &action.Name, &action.Label, &action.Type, &action.Icon, &targetObject, &configJSON); err != nil {
+	var lastModifiedDateVal interface{}
+	if err := row.Scan(&action.ID, &action.ObjectAPIName, &action.Name, &action.Label, &action.Type, &action.Icon, &targetObject, &configJSON, &lastModifiedDateVal); err != nil {
 		return nil, err
 	}
+	action.LastModifiedDate = parseTime(lastModifiedDateVal)
 	if targetObject.Valid {
 		action.TargetObject = &targetObject.String
 	}
@@ -1961,9 +2028,9 @@ func (r *MetadataRepository) scanLayout(row Scannable) (*models.PageLayout, erro
 
 func (r *MetadataRepository) scanDashboard(row Scannable) (*models.DashboardConfig, error) {
 	var db models.DashboardConfig
-	var description, widgetsJSON sql.NullString
+	var description, widgetsJSON, filtersJSON, runAs, runAsUserID sql.NullString
 
-	if err := row.Scan(&db.ID, &db.Label, &description, &db.Layout, &widgetsJSON); err != nil {
+	if err := row.Scan(&db.ID, &db.Label, &description, &db.Layout, &widgetsJSON, &filtersJSON, &runAs, &runAsUserID); err != nil {
 		return nil, err
 	}
 
@@ -1973,20 +2040,37 @@ func (r *MetadataRepository) scanDashboard(row Scannable) (*models.DashboardConf
 	if widgetsJSON.Valid {
 		r.unmarshalJSON(widgetsJSON.String, &db.Widgets)
 	}
+	r.unmarshalJSON(filtersJSON.String, &db.Filters)
+	db.RunAs = models.DashboardRunAs(runAs.String)
+	if db.RunAs == "" {
+		db.RunAs = constants.DashboardRunAsViewer
+	}
+	if runAsUserID.Valid {
+		db.RunAsUserID = &runAsUserID.String
+	}
 	return &db, nil
 }
 
 func (r *MetadataRepository) scanListView(row Scannable) (*models.ListView, error) {
 	var view models.ListView
-	var filterExpr, fieldsJSON sql.NullString
-	if err := row.Scan(&view.ID, &view.ObjectAPIName, &view.Label, &filterExpr, &fieldsJSON); err != nil {
+	var filterExpr, fieldsJSON, visibility, ownerID, profileIDs, columns, sortField, sortDirection, aggregates sql.NullString
+	if err := row.Scan(&view.ID, &view.ObjectAPIName, &view.Label, &filterExpr, &fieldsJSON,
+		&visibility, &ownerID, &profileIDs, &columns, &sortField, &sortDirection, &aggregates); err != nil {
 		return nil, err
 	}
-	if filterExpr.Valid {
-		view.FilterExpr = filterExpr.String
-	}
+	view.FilterExpr = filterExpr.String
 	if fieldsJSON.Valid {
 		r.unmarshalJSON(fieldsJSON.String, &view.Fields)
 	}
+	view.Visibility = models.ListViewVisibility(visibility.String)
+	if view.Visibility == "" {
+		view.Visibility = constants.ListViewVisibilityShared
+	}
+	view.OwnerID = ownerID.String
+	r.unmarshalJSON(profileIDs.String, &view.ProfileIDs)
+	r.unmarshalJSON(columns.String, &view.Columns)
+	view.SortField = sortField.String
+	view.SortDirection = sortDirection.String
+	r.unmarshalJSON(aggregates.String, &view.Aggregates)
 	return &view, nil
 }
@@ -0,0 +1,204 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+var globalValueSetColumns = []string{
+	constants.FieldSysGlobalValueSet_ID,
+	constants.FieldSysGlobalValueSet_Name,
+	constants.FieldSysGlobalValueSet_Label,
+	constants.FieldSysGlobalValueSet_Description,
+	constants.FieldSysGlobalValueSet_Options,
+	constants.FieldSysGlobalValueSet_InactiveOptions,
+	constants.FieldSysGlobalValueSet_IsStandard,
+	constants.FieldSysGlobalValueSet_CreatedDate,
+	constants.FieldSysGlobalValueSet_LastModifiedDate,
+}
+
+// GetGlobalValueSets queries all global value sets ordered by name
+func (r *MetadataRepository) GetGlobalValueSets(ctx context.Context) ([]*models.GlobalValueSet, error) {
+	q := query.From(constants.TableGlobalValueSet).
+		Select(globalValueSetColumns).
+		OrderBy(constants.FieldSysGlobalValueSet_Name, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query global value sets: %w", err)
+	}
+	defer rows.Close()
+
+	sets := make([]*models.GlobalValueSet, 0)
+	for rows.Next() {
+		vs, err := r.scanGlobalValueSet(rows)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, vs)
+	}
+	return sets, rows.Err()
+}
+
+// GetGlobalValueSet queries a global value set by name, or nil if not found
+func (r *MetadataRepository) GetGlobalValueSet(ctx context.Context, name string) (*models.GlobalValueSet, error) {
+	q := query.From(constants.TableGlobalValueSet).
+		Select(globalValueSetColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableGlobalValueSet, constants.FieldSysGlobalValueSet_Name), name).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query global value set: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return r.scanGlobalValueSet(rows)
+}
+
+func (r *MetadataRepository) scanGlobalValueSet(rows *sql.Rows) (*models.GlobalValueSet, error) {
+	var vs models.GlobalValueSet
+	var description, options, inactive sql.NullString
+	var isStandard sql.NullBool
+	if err := rows.Scan(
+		&vs.ID, &vs.Name, &vs.Label, &description, &options, &inactive, &isStandard,
+		&vs.CreatedDate, &vs.LastModifiedDate,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan global value set: %w", err)
+	}
+
+	if description.Valid {
+		vs.Description = &description.String
+	}
+	if options.Valid {
+		r.unmarshalJSON(options.String, &vs.Options)
+	}
+	if inactive.Valid {
+		r.unmarshalJSON(inactive.String, &vs.InactiveOptions)
+	}
+	vs.IsStandard = isStandard.Bool
+	return &vs, nil
+}
+
+// CreateGlobalValueSet inserts a global value set
+func (r *MetadataRepository) CreateGlobalValueSet(ctx context.Context, vs *models.GlobalValueSet) error {
+	values, err := globalValueSetValues(vs)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysGlobalValueSet_ID] = vs.ID
+	values[constants.FieldSysGlobalValueSet_Name] = vs.Name
+	values[constants.FieldSysGlobalValueSet_IsStandard] = vs.IsStandard
+	values[constants.FieldSysGlobalValueSet_CreatedDate] = now
+	values[constants.FieldSysGlobalValueSet_LastModifiedDate] = now
+
+	q := query.Insert(constants.TableGlobalValueSet, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert global value set: %w", err)
+	}
+	vs.CreatedDate = now
+	vs.LastModifiedDate = now
+	return nil
+}
+
+// UpdateGlobalValueSet overwrites the label, description and values of a global value set
+func (r *MetadataRepository) UpdateGlobalValueSet(ctx context.Context, vs *models.GlobalValueSet) error {
+	values, err := globalValueSetValues(vs)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysGlobalValueSet_LastModifiedDate] = now
+
+	q := query.Update(constants.TableGlobalValueSet).
+		Set(values).
+		Where(constants.FieldSysGlobalValueSet_ID+" = ?", vs.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update global value set: %w", err)
+	}
+	vs.LastModifiedDate = now
+	return nil
+}
+
+// DeleteGlobalValueSet deletes a global value set
+func (r *MetadataRepository) DeleteGlobalValueSet(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableGlobalValueSet).
+		Where(constants.FieldSysGlobalValueSet_ID+" = ?", id).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// GetValueSetFields returns the fields ("object.field") that use a global value set
+func (r *MetadataRepository) GetValueSetFields(ctx context.Context, name string) ([]string, error) {
+	q := query.From(constants.TableField).
+		AddSelectRaw(fmt.Sprintf("CONCAT(`o`.`%s`, '.', `%s`.`%s`)", constants.FieldSysObject_APIName, constants.TableField, constants.FieldSysField_APIName), "field").
+		Join("INNER", constants.TableObject, "o", fmt.Sprintf("`o`.`%s` = `%s`.`%s`", constants.FieldID, constants.TableField, constants.FieldSysField_ObjectID)).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableField, constants.FieldSysField_ValueSet), name).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query value set fields: %w", err)
+	}
+	defer rows.Close()
+
+	fields := make([]string, 0)
+	for rows.Next() {
+		var field string
+		if err := rows.Scan(&field); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, rows.Err()
+}
+
+// SetFieldValueSet binds a field to a global value set, or unbinds it when name is nil
+func (r *MetadataRepository) SetFieldValueSet(ctx context.Context, fieldID string, name *string) error {
+	q := query.Update(constants.TableField).
+		Set(map[string]interface{}{
+			constants.FieldSysField_ValueSet: name,
+			constants.FieldLastModifiedDate:  time.Now(),
+		}).
+		Where(constants.FieldID+" = ?", fieldID).
+		Build()
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// globalValueSetValues maps the editable settings of a global value set to column values
+func globalValueSetValues(vs *models.GlobalValueSet) (map[string]interface{}, error) {
+	options, err := json.Marshal(vs.Options)
+	if err != nil {
+		return nil, err
+	}
+	var inactive interface{}
+	if len(vs.InactiveOptions) > 0 {
+		b, err := json.Marshal(vs.InactiveOptions)
+		if err != nil {
+			return nil, err
+		}
+		inactive = string(b)
+	}
+	return map[string]interface{}{
+		constants.FieldSysGlobalValueSet_Label:           vs.Label,
+		constants.FieldSysGlobalValueSet_Description:     vs.Description,
+		constants.FieldSysGlobalValueSet_Options:         string(options),
+		constants.FieldSysGlobalValueSet_InactiveOptions: inactive,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// NamedCredentialRepository handles database operations for named credentials
+type NamedCredentialRepository struct {
+	db *sql.DB
+}
+
+// NewNamedCredentialRepository creates a new NamedCredentialRepository
+func NewNamedCredentialRepository(db *sql.DB) *NamedCredentialRepository {
+	return &NamedCredentialRepository{db: db}
+}
+
+var namedCredentialColumns = []string{
+	constants.FieldSysNamedCredential_ID,
+	constants.FieldSysNamedCredential_Name,
+	constants.FieldSysNamedCredential_Label,
+	constants.FieldSysNamedCredential_BaseURL,
+	constants.FieldSysNamedCredential_AuthType,
+	constants.FieldSysNamedCredential_Username,
+	constants.FieldSysNamedCredential_AuthHeader,
+	constants.FieldSysNamedCredential_Secret,
+	constants.FieldSysNamedCredential_TimeoutSeconds,
+	constants.FieldSysNamedCredential_MaxRetries,
+	constants.FieldSysNamedCredential_CreatedDate,
+	constants.FieldSysNamedCredential_LastModifiedDate,
+}
+
+// GetAll queries all named credentials ordered by name. Secrets are returned encrypted.
+func (r *NamedCredentialRepository) GetAll(ctx context.Context) ([]*models.NamedCredential, error) {
+	q := query.From(constants.TableNamedCredential).
+		Select(namedCredentialColumns).
+		OrderBy(constants.FieldSysNamedCredential_Name, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query named credentials: %w", err)
+	}
+	defer rows.Close()
+
+	creds := make([]*models.NamedCredential, 0)
+	for rows.Next() {
+		c, err := scanNamedCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, c)
+	}
+	return creds, rows.Err()
+}
+
+// FindByName queries a named credential by name, or nil if not found. The secret is returned encrypted.
+func (r *NamedCredentialRepository) FindByName(ctx context.Context, name string) (*models.NamedCredential, error) {
+	q := query.From(constants.TableNamedCredential).
+		Select(namedCredentialColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableNamedCredential, constants.FieldSysNamedCredential_Name), name).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query named credential: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanNamedCredential(rows)
+}
+
+func scanNamedCredential(rows *sql.Rows) (*models.NamedCredential, error) {
+	var c models.NamedCredential
+	var authType string
+	var username, authHeader, secret sql.NullString
+	if err := rows.Scan(&c.ID, &c.Name, &c.Label, &c.BaseURL, &authType, &username, &authHeader, &secret,
+		&c.TimeoutSeconds, &c.MaxRetries, &c.CreatedDate, &c.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan named credential: %w", err)
+	}
+	c.AuthType = constants.NamedCredentialAuthType(authType)
+	if username.Valid {
+		c.Username = &username.String
+	}
+	if authHeader.Valid {
+		c.AuthHeader = &authHeader.String
+	}
+	c.Secret = secret.String
+	c.HasSecret = secret.String != ""
+	return &c, nil
+}
+
+// Insert inserts a named credential; the secret must already be encrypted
+func (r *NamedCredentialRepository) Insert(ctx context.Context, c *models.NamedCredential) error {
+	now := time.Now()
+	q := query.Insert(constants.TableNamedCredential, map[string]interface{}{
+		constants.FieldSysNamedCredential_ID:               c.ID,
+		constants.FieldSysNamedCredential_Name:             c.Name,
+		constants.FieldSysNamedCredential_Label:            c.Label,
+		constants.FieldSysNamedCredential_BaseURL:          c.BaseURL,
+		constants.FieldSysNamedCredential_AuthType:         string(c.AuthType),
+		constants.FieldSysNamedCredential_Username:         c.Username,
+		constants.FieldSysNamedCredential_AuthHeader:       c.AuthHeader,
+		constants.FieldSysNamedCredential_Secret:           c.Secret,
+		constants.FieldSysNamedCredential_TimeoutSeconds:   c.TimeoutSeconds,
+		constants.FieldSysNamedCredential_MaxRetries:       c.MaxRetries,
+		constants.FieldSysNamedCredential_CreatedDate:      now,
+		constants.FieldSysNamedCredential_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert named credential: %w", err)
+	}
+	c.CreatedDate = now
+	c.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites a named credential; the secret must already be encrypted
+func (r *NamedCredentialRepository) Update(ctx context.Context, c *models.NamedCredential) error {
+	now := time.Now()
+	q := query.Update(constants.TableNamedCredential).
+		Set(map[string]interface{}{
+			constants.FieldSysNamedCredential_Label:            c.Label,
+			constants.FieldSysNamedCredential_BaseURL:          c.BaseURL,
+			constants.FieldSysNamedCredential_AuthType:         string(c.AuthType),
+			constants.FieldSysNamedCredential_Username:         c.Username,
+			constants.FieldSysNamedCredential_AuthHeader:       c.AuthHeader,
+			constants.FieldSysNamedCredential_Secret:           c.Secret,
+			constants.FieldSysNamedCredential_TimeoutSeconds:   c.TimeoutSeconds,
+			constants.FieldSysNamedCredential_MaxRetries:       c.MaxRetries,
+			constants.FieldSysNamedCredential_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysNamedCredential_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update named credential: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a named credential
+func (r *NamedCredentialRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableNamedCredential).
+		Where(constants.FieldSysNamedCredential_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete named credential: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// OrderRepository handles database operations for orders and their items. Methods taking a
+// transaction run on it when it is not nil, so stock changes and order updates commit together.
+type OrderRepository struct {
+	db *sql.DB
+}
+
+// NewOrderRepository creates a new OrderRepository
+func NewOrderRepository(db *sql.DB) *OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+func (r *OrderRepository) executor(tx *sql.Tx) Executor {
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// orderTimestamp formats dates like record system dates
+func orderTimestamp(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func orderNullTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return orderTimestamp(*t)
+}
+
+var orderColumns = []string{
+	constants.FieldSysOrder_Name,
+	constants.FieldSysOrder_Status,
+	constants.FieldSysOrder_CustomerObjectAPIName,
+	constants.FieldSysOrder_CustomerID,
+	constants.FieldSysOrder_Description,
+	constants.FieldSysOrder_TotalAmount,
+	constants.FieldSysOrder_ActivatedDate,
+	constants.FieldSysOrder_FulfilledDate,
+	constants.FieldSysOrder_CancelledDate,
+	constants.FieldSysOrder_OwnerID,
+	constants.FieldSysOrder_CreatedDate,
+	constants.FieldSysOrder_LastModifiedDate,
+}
+
+var orderItemColumns = []string{
+	constants.FieldSysOrderItem_OrderID,
+	constants.FieldSysOrderItem_ProductID,
+	constants.FieldSysOrderItem_ProductName,
+	constants.FieldSysOrderItem_Quantity,
+	constants.FieldSysOrderItem_BackorderedQuantity,
+	constants.FieldSysOrderItem_UnitPrice,
+	constants.FieldSysOrderItem_TotalPrice,
+	constants.FieldSysOrderItem_SortOrder,
+	constants.FieldSysOrderItem_CreatedDate,
+	constants.FieldSysOrderItem_LastModifiedDate,
+}
+
+// List returns the orders of an owner, or every order when ownerID is empty, most recent first
+func (r *OrderRepository) List(ctx context.Context, ownerID string) ([]*models.SystemOrder, error) {
+	b := query.From(constants.TableOrder).Select(orderColumns)
+	if ownerID != "" {
+		b = b.Where(constants.FieldSysOrder_OwnerID+" = ?", ownerID)
+	}
+	q := b.OrderBy(constants.FieldSysOrder_CreatedDate, constants.SortDESC).Build()
+	return r.queryOrders(ctx, nil, q)
+}
+
+// Get returns an order by ID, or nil if not found
+func (r *OrderRepository) Get(ctx context.Context, id string) (*models.SystemOrder, error) {
+	return r.get(ctx, nil, id, false)
+}
+
+// GetLock returns an order by ID locked for update within tx, or nil if not found
+func (r *OrderRepository) GetLock(ctx context.Context, tx *sql.Tx, id string) (*models.SystemOrder, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction required for locking order %s", id)
+	}
+	return r.get(ctx, tx, id, true)
+}
+
+func (r *OrderRepository) get(ctx context.Context, tx *sql.Tx, id string, lock bool) (*models.SystemOrder, error) {
+	q := query.From(constants.TableOrder).
+		Select(orderColumns).
+		Where(constants.FieldSysOrder_ID+" = ?", id).
+		Limit(1).
+		Build()
+	if lock {
+		q.SQL += " FOR UPDATE"
+	}
+	orders, err := r.queryOrders(ctx, tx, q)
+	if err != nil || len(orders) == 0 {
+		return nil, err
+	}
+	return orders[0], nil
+}
+
+func (r *OrderRepository) queryOrders(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemOrder, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]*models.SystemOrder, 0)
+	for rows.Next() {
+		var o models.SystemOrder
+		if err := rows.Scan(&o.ID, &o.Name, &o.Status, &o.CustomerObjectAPIName, &o.CustomerID, &o.Description,
+			&o.TotalAmount, &o.ActivatedDate, &o.FulfilledDate, &o.CancelledDate, &o.OwnerID, &o.CreatedDate,
+			&o.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, &o)
+	}
+	return orders, rows.Err()
+}
+
+// ListItems returns the items of an order in their sort order
+func (r *OrderRepository) ListItems(ctx context.Context, tx *sql.Tx, orderID string) ([]*models.SystemOrderItem, error) {
+	q := query.From(constants.TableOrderItem).
+		Select(orderItemColumns).
+		Where(constants.FieldSysOrderItem_OrderID+" = ?", orderID).
+		OrderBy(constants.FieldSysOrderItem_SortOrder, constants.SortASC).
+		Build()
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order items: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]*models.SystemOrderItem, 0)
+	for rows.Next() {
+		var i models.SystemOrderItem
+		if err := rows.Scan(&i.ID, &i.OrderID, &i.ProductID, &i.ProductName, &i.Quantity, &i.BackorderedQuantity,
+			&i.UnitPrice, &i.TotalPrice, &i.SortOrder, &i.CreatedDate, &i.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan order item: %w", err)
+		}
+		items = append(items, &i)
+	}
+	return items, rows.Err()
+}
+
+// orderValues returns the editable columns of an order
+func orderValues(o *models.SystemOrder) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysOrder_Name:                  o.Name,
+		constants.FieldSysOrder_Status:                o.Status,
+		constants.FieldSysOrder_CustomerObjectAPIName: ToNullString(o.CustomerObjectAPIName),
+		constants.FieldSysOrder_CustomerID:            ToNullString(o.CustomerID),
+		constants.FieldSysOrder_Description:           ToNullString(o.Description),
+		constants.FieldSysOrder_TotalAmount:           o.TotalAmount,
+		constants.FieldSysOrder_OwnerID:               o.OwnerID,
+	}
+}
+
+// Insert stores a new order with its items in one transaction
+func (r *OrderRepository) Insert(ctx context.Context, o *models.SystemOrder, items []*models.SystemOrderItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	values := orderValues(o)
+	values[constants.FieldSysOrder_ID] = o.ID
+	values[constants.FieldSysOrder_CreatedDate] = orderTimestamp(now)
+	values[constants.FieldSysOrder_LastModifiedDate] = orderTimestamp(now)
+	q := query.Insert(constants.TableOrder, values).Build()
+	if _, err := tx.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert order: %w", err)
+	}
+	if err := r.insertItems(ctx, tx, items, now); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	o.CreatedDate = now
+	o.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites the editable fields of an order and replaces its items in one transaction
+func (r *OrderRepository) Update(ctx context.Context, o *models.SystemOrder, items []*models.SystemOrderItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	values := orderValues(o)
+	values[constants.FieldSysOrder_LastModifiedDate] = orderTimestamp(now)
+	q := query.Update(constants.TableOrder).
+		Set(values).
+		Where(constants.FieldSysOrder_ID+" = ?", o.ID).
+		Build()
+	if _, err := tx.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+	dq := query.Delete(constants.TableOrderItem).
+		Where(constants.FieldSysOrderItem_OrderID+" = ?", o.ID).
+		Build()
+	if _, err := tx.ExecContext(ctx, dq.SQL, dq.Params...); err != nil {
+		return fmt.Errorf("failed to delete order items: %w", err)
+	}
+	if err := r.insertItems(ctx, tx, items, now); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	o.LastModifiedDate = now
+	return nil
+}
+
+func (r *OrderRepository) insertItems(ctx context.Context, tx *sql.Tx, items []*models.SystemOrderItem, now time.Time) error {
+	for _, i := range items {
+		q := query.Insert(constants.TableOrderItem, map[string]interface{}{
+			constants.FieldSysOrderItem_ID:                  i.ID,
+			constants.FieldSysOrderItem_OrderID:             i.OrderID,
+			constants.FieldSysOrderItem_ProductID:           i.ProductID,
+			constants.FieldSysOrderItem_ProductName:         ToNullString(i.ProductName),
+			constants.FieldSysOrderItem_Quantity:            i.Quantity,
+			constants.FieldSysOrderItem_BackorderedQuantity: i.BackorderedQuantity,
+			constants.FieldSysOrderItem_UnitPrice:           i.UnitPrice,
+			constants.FieldSysOrderItem_TotalPrice:          i.TotalPrice,
+			constants.FieldSysOrderItem_SortOrder:           i.SortOrder,
+			constants.FieldSysOrderItem_CreatedDate:         orderTimestamp(now),
+			constants.FieldSysOrderItem_LastModifiedDate:    orderTimestamp(now),
+		}).Build()
+		if _, err := tx.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+			return fmt.Errorf("failed to insert order item: %w", err)
+		}
+		i.CreatedDate = now
+		i.LastModifiedDate = now
+	}
+	return nil
+}
+
+// SetStatus stores the status and lifecycle dates of an order within tx
+func (r *OrderRepository) SetStatus(ctx context.Context, tx *sql.Tx, o *models.SystemOrder) error {
+	now := time.Now()
+	q := query.Update(constants.TableOrder).
+		Set(map[string]interface{}{
+			constants.FieldSysOrder_Status:           o.Status,
+			constants.FieldSysOrder_ActivatedDate:    orderNullTimestamp(o.ActivatedDate),
+			constants.FieldSysOrder_FulfilledDate:    orderNullTimestamp(o.FulfilledDate),
+			constants.FieldSysOrder_CancelledDate:    orderNullTimestamp(o.CancelledDate),
+			constants.FieldSysOrder_LastModifiedDate: orderTimestamp(now),
+		}).
+		Where(constants.FieldSysOrder_ID+" = ?", o.ID).
+		Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	o.LastModifiedDate = now
+	return nil
+}
+
+// SetBackordered stores the backordered quantity of an order item within tx
+func (r *OrderRepository) SetBackordered(ctx context.Context, tx *sql.Tx, i *models.SystemOrderItem) error {
+	q := query.Update(constants.TableOrderItem).
+		Set(map[string]interface{}{
+			constants.FieldSysOrderItem_BackorderedQuantity: i.BackorderedQuantity,
+			constants.FieldSysOrderItem_LastModifiedDate:    orderTimestamp(time.Now()),
+		}).
+		Where(constants.FieldSysOrderItem_ID+" = ?", i.ID).
+		Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update order item: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an order and its items in one transaction
+func (r *OrderRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	iq := query.Delete(constants.TableOrderItem).
+		Where(constants.FieldSysOrderItem_OrderID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, iq.SQL, iq.Params...); err != nil {
+		return fmt.Errorf("failed to delete order items: %w", err)
+	}
+	oq := query.Delete(constants.TableOrder).
+		Where(constants.FieldSysOrder_ID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, oq.SQL, oq.Params...); err != nil {
+		return fmt.Errorf("failed to delete order: %w", err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// organizationRowID is the ID of the single _System_Organization row
+const organizationRowID = "org"
+
+// OrganizationRepository handles the organization row and its first-run setup state
+type OrganizationRepository struct {
+	db *sql.DB
+}
+
+// NewOrganizationRepository creates a new OrganizationRepository
+func NewOrganizationRepository(db *sql.DB) *OrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+var organizationColumns = []string{
+	constants.FieldSysOrganization_ID,
+	constants.FieldSysOrganization_Name,
+	constants.FieldSysOrganization_DefaultLocale,
+	constants.FieldSysOrganization_SetupStatus,
+	constants.FieldSysOrganization_SampleData,
+	constants.FieldSysOrganization_SetupCompletedByID,
+	constants.FieldSysOrganization_SetupCompletedDate,
+	constants.FieldSysOrganization_CreatedDate,
+	constants.FieldSysOrganization_LastModifiedDate,
+}
+
+// Get returns the organization, or nil if its row does not exist yet
+func (r *OrganizationRepository) Get(ctx context.Context) (*models.SystemOrganization, error) {
+	q := query.From(constants.TableOrganization).
+		Select(organizationColumns).
+		Where(constants.FieldSysOrganization_ID+" = ?", organizationRowID).
+		Limit(1).
+		Build()
+
+	var org models.SystemOrganization
+	err := r.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&org.ID, &org.Name, &org.DefaultLocale, &org.SetupStatus,
+		&org.SampleData, &org.SetupCompletedByID, &org.SetupCompletedDate, &org.CreatedDate, &org.LastModifiedDate)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return &org, nil
+}
+
+func (r *OrganizationRepository) executor(tx *sql.Tx) Executor {
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// Ensure creates the organization row in the given setup status unless it exists. The
+// insert leaves an existing row alone, so concurrent starts cannot both create it.
+func (r *OrganizationRepository) Ensure(ctx context.Context, status string) error {
+	now := time.Now()
+	q := query.Insert(constants.TableOrganization, map[string]interface{}{
+		constants.FieldSysOrganization_ID:               organizationRowID,
+		constants.FieldSysOrganization_SetupStatus:      status,
+		constants.FieldSysOrganization_SampleData:       false,
+		constants.FieldSysOrganization_CreatedDate:      now,
+		constants.FieldSysOrganization_LastModifiedDate: now,
+	}).Build()
+	stmt := q.SQL + " " + query.ActiveDialect().Upsert([]string{constants.FieldSysOrganization_ID}, nil)
+	if _, err := r.db.ExecContext(ctx, stmt, q.Params...); err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	return nil
+}
+
+// ClaimSetup moves setup from pending to in_progress and reports whether this caller won
+// the claim. A claim last touched before staleBefore is taken over, so a setup request that
+// died halfway does not lock setup forever.
+func (r *OrganizationRepository) ClaimSetup(ctx context.Context, staleBefore time.Time) (bool, error) {
+	q := query.Update(constants.TableOrganization).
+		Set(map[string]interface{}{
+			constants.FieldSysOrganization_SetupStatus:      constants.SetupStatusInProgress,
+			constants.FieldSysOrganization_LastModifiedDate: time.Now(),
+		}).
+		Where(constants.FieldSysOrganization_ID+" = ?", organizationRowID).
+		Where("("+constants.FieldSysOrganization_SetupStatus+" = ? OR ("+
+			constants.FieldSysOrganization_SetupStatus+" = ? AND "+constants.FieldSysOrganization_LastModifiedDate+" < ?))",
+			constants.SetupStatusPending, constants.SetupStatusInProgress, staleBefore).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim setup: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim setup: %w", err)
+	}
+	return affected == 1, nil
+}
+
+// ReleaseSetup returns an in_progress setup to pending after the setup request failed
+func (r *OrganizationRepository) ReleaseSetup(ctx context.Context) error {
+	q := query.Update(constants.TableOrganization).
+		Set(map[string]interface{}{
+			constants.FieldSysOrganization_SetupStatus:      constants.SetupStatusPending,
+			constants.FieldSysOrganization_LastModifiedDate: time.Now(),
+		}).
+		Where(constants.FieldSysOrganization_ID+" = ?", organizationRowID).
+		Where(constants.FieldSysOrganization_SetupStatus+" = ?", constants.SetupStatusInProgress).
+		Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to release setup: %w", err)
+	}
+	return nil
+}
+
+// CompleteSetup stores the organization settings chosen in setup and closes setup, within tx
+// when it is not nil
+func (r *OrganizationRepository) CompleteSetup(ctx context.Context, tx *sql.Tx, org *models.SystemOrganization) error {
+	now := time.Now()
+	q := query.Update(constants.TableOrganization).
+		Set(map[string]interface{}{
+			constants.FieldSysOrganization_Name:               org.Name,
+			constants.FieldSysOrganization_DefaultLocale:      org.DefaultLocale,
+			constants.FieldSysOrganization_SampleData:         org.SampleData,
+			constants.FieldSysOrganization_SetupCompletedByID: org.SetupCompletedByID,
+			constants.FieldSysOrganization_SetupCompletedDate: now,
+			constants.FieldSysOrganization_SetupStatus:        constants.SetupStatusCompleted,
+			constants.FieldSysOrganization_LastModifiedDate:   now,
+		}).
+		Where(constants.FieldSysOrganization_ID+" = ?", organizationRowID).
+		Build()
+
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to complete setup: %w", err)
+	}
+	org.ID = organizationRowID
+	org.SetupStatus = constants.SetupStatusCompleted
+	org.SetupCompletedDate = &now
+	org.LastModifiedDate = now
+	return nil
+}
+
+// MarkSetupCompleted closes setup without changing the organization settings; used for
+// databases that had users before setup existed
+func (r *OrganizationRepository) MarkSetupCompleted(ctx context.Context) error {
+	q := query.Update(constants.TableOrganization).
+		Set(map[string]interface{}{
+			constants.FieldSysOrganization_SetupStatus:      constants.SetupStatusCompleted,
+			constants.FieldSysOrganization_LastModifiedDate: time.Now(),
+		}).
+		Where(constants.FieldSysOrganization_ID+" = ?", organizationRowID).
+		Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to complete setup: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/nexuscrm/backend/pkg/query"
 	"log"
 	"strings"
 
@@ -175,6 +176,33 @@ func (r *PermissionRepository) LoadEffectiveFieldPermission(ctx context.Context,
 	}, nil
 }
 
+// HasSystemPermission reports whether the user's profile or one of their active permission sets
+// grants a system permission. The permission is the name of a boolean column both tables share.
+func (r *PermissionRepository) HasSystemPermission(ctx context.Context, user *models.UserSession, permission string) (bool, error) {
+	switch permission {
+	case constants.PermBypassFlows, constants.PermBypassValidation:
+	default:
+		return false, fmt.Errorf("unknown system permission: %s", permission)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			(SELECT COUNT(*) FROM %s WHERE %s = ? AND %s = 1 AND %s = 0)
+			+
+			(SELECT COUNT(*) FROM %s WHERE %s = 1 AND %s = 1 AND %s = 0
+				AND %s IN (SELECT %s FROM %s WHERE %s = ?))
+	`, constants.TableProfile, constants.FieldID, permission, constants.FieldIsDeleted,
+		constants.TablePermissionSet, permission, constants.FieldIsActive, constants.FieldIsDeleted,
+		constants.FieldID, constants.FieldPermissionSetID, constants.TablePermissionSetAssignment,
+		constants.FieldSysPermissionSetAssignment_AssigneeID)
+
+	var grants int
+	if err := r.db.QueryRowContext(ctx, query, user.ProfileID, user.ID).Scan(&grants); err != nil {
+		return false, fmt.Errorf("failed to check system permission: %w", err)
+	}
+	return grants > 0, nil
+}
+
 // ListObjectPermissions retrieves all object permissions for a profile
 func (r *PermissionRepository) ListObjectPermissions(ctx context.Context, profileID string) ([]models.SystemObjectPerms, error) {
 	cols := strings.Join([]string{
@@ -239,6 +267,22 @@ func (r *PermissionRepository) ListFieldPermissions(ctx context.Context, profile
 	return perms, nil
 }
 
+// objectPermsUpdateColumns are the columns an object permission upsert replaces
+var objectPermsUpdateColumns = []string{
+	constants.FieldSysObjectPerms_AllowRead, constants.FieldSysObjectPerms_AllowCreate,
+	constants.FieldSysObjectPerms_AllowEdit, constants.FieldSysObjectPerms_AllowDelete,
+	constants.FieldSysObjectPerms_ViewAll, constants.FieldSysObjectPerms_ModifyAll,
+}
+
+// permissionOwnerColumn is the column whose unique key a permission upsert conflicts on:
+// permissions belong to either a profile or a permission set
+func permissionOwnerColumn(profileID *string) string {
+	if profileID != nil {
+		return constants.FieldProfileID
+	}
+	return constants.FieldPermissionSetID
+}
+
 // UpsertObjectPermission creates or updates an object permission
 func (r *PermissionRepository) UpsertObjectPermission(ctx context.Context, perm models.SystemObjectPerms) error {
 	return r.upsertObjectPermission(ctx, r.db, perm)
@@ -260,23 +304,17 @@ func (r *PermissionRepository) upsertObjectPermission(ctx context.Context, exec
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowRead, constants.FieldSysObjectPerms_AllowRead),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowCreate, constants.FieldSysObjectPerms_AllowCreate),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowEdit, constants.FieldSysObjectPerms_AllowEdit),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowDelete, constants.FieldSysObjectPerms_AllowDelete),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_ViewAll, constants.FieldSysObjectPerms_ViewAll),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_ModifyAll, constants.FieldSysObjectPerms_ModifyAll),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
+	updates := query.ActiveDialect().Upsert(
+		[]string{permissionOwnerColumn(perm.ProfileID), constants.FieldObjectAPIName}, objectPermsUpdateColumns,
+		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate))
 
-	query := fmt.Sprintf(`
+	stmt := fmt.Sprintf(`
 		INSERT INTO %s (%s)
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
-		ON DUPLICATE KEY UPDATE %s
+		%s
 		`, constants.TableObjectPerms, cols, updates)
 
-	_, err := exec.ExecContext(ctx, query, id, perm.ProfileID, perm.PermissionSetID, perm.ObjectAPIName, perm.AllowRead, perm.AllowCreate, perm.AllowEdit, perm.AllowDelete, perm.ViewAll, perm.ModifyAll)
+	_, err := exec.ExecContext(ctx, stmt, id, perm.ProfileID, perm.PermissionSetID, perm.ObjectAPIName, perm.AllowRead, perm.AllowCreate, perm.AllowEdit, perm.AllowDelete, perm.ViewAll, perm.ModifyAll)
 	return err
 }
 
@@ -299,27 +337,26 @@ func (r *PermissionRepository) upsertFieldPermission(ctx context.Context, exec E
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysFieldPerms_Readable, constants.FieldSysFieldPerms_Readable),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysFieldPerms_Editable, constants.FieldSysFieldPerms_Editable),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
+	updates := query.ActiveDialect().Upsert(
+		[]string{permissionOwnerColumn(perm.ProfileID), constants.FieldObjectAPIName, constants.FieldSysFieldPerms_FieldAPIName},
+		[]string{constants.FieldSysFieldPerms_Readable, constants.FieldSysFieldPerms_Editable},
+		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate))
 
-	query := fmt.Sprintf(`
+	stmt := fmt.Sprintf(`
 		INSERT INTO %s (%s)
 		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
-		ON DUPLICATE KEY UPDATE %s
+		%s
 	`, constants.TableFieldPerms, cols, updates)
 
-	_, err := exec.ExecContext(ctx, query, id, perm.ProfileID, perm.PermissionSetID, perm.ObjectAPIName, perm.FieldAPIName, perm.Readable, perm.Editable)
+	_, err := exec.ExecContext(ctx, stmt, id, perm.ProfileID, perm.PermissionSetID, perm.ObjectAPIName, perm.FieldAPIName, perm.Readable, perm.Editable)
 	return err
 }
 
 // GrantInitialPermissions grants default permissions for a new object to all profiles
 func (r *PermissionRepository) GrantInitialPermissions(ctx context.Context, objectAPIName string) error {
 	// Get all Profiles
-	query := fmt.Sprintf("SELECT id FROM %s", constants.TableProfile)
-	rows, err := r.db.QueryContext(ctx, query)
+	profilesQuery := fmt.Sprintf("SELECT id FROM %s", constants.TableProfile)
+	rows, err := r.db.QueryContext(ctx, profilesQuery)
 	if err != nil {
 		return fmt.Errorf("failed to fetch profiles: %w", err)
 	}
@@ -356,20 +393,14 @@ func (r *PermissionRepository) GrantInitialPermissions(ctx context.Context, obje
 			constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 		}, ", ")
 
-		updates := strings.Join([]string{
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowRead, constants.FieldSysObjectPerms_AllowRead),
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowCreate, constants.FieldSysObjectPerms_AllowCreate),
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowEdit, constants.FieldSysObjectPerms_AllowEdit),
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_AllowDelete, constants.FieldSysObjectPerms_AllowDelete),
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_ViewAll, constants.FieldSysObjectPerms_ViewAll),
-			fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObjectPerms_ModifyAll, constants.FieldSysObjectPerms_ModifyAll),
-			fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-		}, ", ")
+		updates := query.ActiveDialect().Upsert(
+			[]string{constants.FieldProfileID, constants.FieldObjectAPIName}, objectPermsUpdateColumns,
+			fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate))
 
 		insertQuery := fmt.Sprintf(`
 			INSERT INTO %s (%s)
 			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
-			ON DUPLICATE KEY UPDATE %s
+			%s
 		`, constants.TableObjectPerms, cols, updates)
 
 		if _, err := r.db.ExecContext(ctx, insertQuery, id, profileID, objectAPIName, allowRead, allowCreate, allowEdit, allowDelete, viewAll, modifyAll); err != nil {
@@ -722,6 +753,29 @@ func (r *PermissionRepository) GetManualShareAccessLevels(ctx context.Context, o
 	return levels, nil
 }
 
+// RevokeManualShares removes the manual shares of the given records
+func (r *PermissionRepository) RevokeManualShares(ctx context.Context, objectAPIName string, recordIDs []string) (int, error) {
+	if len(recordIDs) == 0 {
+		return 0, nil
+	}
+	placeholders := make([]string, len(recordIDs))
+	args := []interface{}{objectAPIName}
+	for i, id := range recordIDs {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s = 1 WHERE %s = ? AND %s IN (%s) AND %s = 0",
+		constants.TableRecordShare, constants.FieldIsDeleted,
+		constants.FieldObjectAPIName, constants.FieldRecordID, strings.Join(placeholders, ","), constants.FieldIsDeleted)
+
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke manual shares: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
 // GetTeamMemberAccessLevel retrieves the access level for a user in a record team
 func (r *PermissionRepository) GetTeamMemberAccessLevel(ctx context.Context, objectAPIName, recordID, userID string) (*string, error) {
 	query := fmt.Sprintf(`
@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// PortalRepository handles database operations for the objects exposed to portal users
+type PortalRepository struct {
+	db *sql.DB
+}
+
+// NewPortalRepository creates a new PortalRepository
+func NewPortalRepository(db *sql.DB) *PortalRepository {
+	return &PortalRepository{db: db}
+}
+
+var portalObjectColumns = []string{
+	constants.FieldSysPortalObject_ID,
+	constants.FieldSysPortalObject_ObjectAPIName,
+	constants.FieldSysPortalObject_ContactField,
+	constants.FieldSysPortalObject_AccountField,
+	constants.FieldSysPortalObject_AllowCreate,
+	constants.FieldSysPortalObject_CreatedDate,
+	constants.FieldSysPortalObject_LastModifiedDate,
+}
+
+// GetObjects queries all portal objects ordered by object name
+func (r *PortalRepository) GetObjects(ctx context.Context) ([]*models.PortalObject, error) {
+	q := query.From(constants.TablePortalObject).
+		Select(portalObjectColumns).
+		OrderBy(constants.FieldSysPortalObject_ObjectAPIName, constants.SortASC).
+		Build()
+	return r.queryObjects(ctx, q)
+}
+
+// FindObject queries the portal settings of an object, or nil if it is not exposed
+func (r *PortalRepository) FindObject(ctx context.Context, objectAPIName string) (*models.PortalObject, error) {
+	q := query.From(constants.TablePortalObject).
+		Select(portalObjectColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TablePortalObject, constants.FieldSysPortalObject_ObjectAPIName), objectAPIName).
+		Limit(1).
+		Build()
+	objects, err := r.queryObjects(ctx, q)
+	if err != nil || len(objects) == 0 {
+		return nil, err
+	}
+	return objects[0], nil
+}
+
+func (r *PortalRepository) queryObjects(ctx context.Context, q query.QueryResult) ([]*models.PortalObject, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query portal objects: %w", err)
+	}
+	defer rows.Close()
+
+	objects := make([]*models.PortalObject, 0)
+	for rows.Next() {
+		var o models.PortalObject
+		var contactField, accountField sql.NullString
+		if err := rows.Scan(&o.ID, &o.ObjectAPIName, &contactField, &accountField, &o.AllowCreate, &o.CreatedDate, &o.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan portal object: %w", err)
+		}
+		o.ContactField = contactField.String
+		o.AccountField = accountField.String
+		objects = append(objects, &o)
+	}
+	return objects, rows.Err()
+}
+
+// InsertObject inserts the portal settings of an object
+func (r *PortalRepository) InsertObject(ctx context.Context, o *models.PortalObject) error {
+	now := time.Now().UTC()
+	q := query.Insert(constants.TablePortalObject, map[string]interface{}{
+		constants.FieldSysPortalObject_ID:               o.ID,
+		constants.FieldSysPortalObject_ObjectAPIName:    o.ObjectAPIName,
+		constants.FieldSysPortalObject_ContactField:     nullableString(o.ContactField),
+		constants.FieldSysPortalObject_AccountField:     nullableString(o.AccountField),
+		constants.FieldSysPortalObject_AllowCreate:      o.AllowCreate,
+		constants.FieldSysPortalObject_CreatedDate:      now,
+		constants.FieldSysPortalObject_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert portal object: %w", err)
+	}
+	o.CreatedDate = now
+	o.LastModifiedDate = now
+	return nil
+}
+
+// UpdateObject overwrites the portal settings of an object
+func (r *PortalRepository) UpdateObject(ctx context.Context, o *models.PortalObject) error {
+	now := time.Now().UTC()
+	q := query.Update(constants.TablePortalObject).
+		Set(map[string]interface{}{
+			constants.FieldSysPortalObject_ContactField:     nullableString(o.ContactField),
+			constants.FieldSysPortalObject_AccountField:     nullableString(o.AccountField),
+			constants.FieldSysPortalObject_AllowCreate:      o.AllowCreate,
+			constants.FieldSysPortalObject_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysPortalObject_ID+" = ?", o.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update portal object: %w", err)
+	}
+	o.LastModifiedDate = now
+	return nil
+}
+
+// DeleteObject removes an object from the portal
+func (r *PortalRepository) DeleteObject(ctx context.Context, id string) error {
+	q := query.Delete(constants.TablePortalObject).
+		Where(constants.FieldSysPortalObject_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete portal object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// QueryGovernorRepository handles the per-profile overrides of the query governor limits
+type QueryGovernorRepository struct {
+	db *sql.DB
+}
+
+// NewQueryGovernorRepository creates a new QueryGovernorRepository
+func NewQueryGovernorRepository(db *sql.DB) *QueryGovernorRepository {
+	return &QueryGovernorRepository{db: db}
+}
+
+var queryGovernorColumns = []string{
+	constants.FieldSysQueryGovernor_ID,
+	constants.FieldSysQueryGovernor_ProfileID,
+	constants.FieldSysQueryGovernor_MaxRows,
+	constants.FieldSysQueryGovernor_MaxExecutionMs,
+	constants.FieldSysQueryGovernor_MaxConcurrentQueries,
+	constants.FieldSysQueryGovernor_CreatedDate,
+	constants.FieldSysQueryGovernor_LastModifiedDate,
+}
+
+// List returns every profile override
+func (r *QueryGovernorRepository) List(ctx context.Context) ([]*models.SystemQueryGovernor, error) {
+	q := query.From(constants.TableQueryGovernor).
+		Select(queryGovernorColumns).
+		OrderBy(constants.FieldSysQueryGovernor_ProfileID, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query governor limits: %w", err)
+	}
+	defer rows.Close()
+
+	overrides := make([]*models.SystemQueryGovernor, 0)
+	for rows.Next() {
+		var g models.SystemQueryGovernor
+		var maxRows, maxExecutionMs, maxConcurrent sql.NullInt64
+		if err := rows.Scan(&g.ID, &g.ProfileID, &maxRows, &maxExecutionMs, &maxConcurrent, &g.CreatedDate, &g.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan governor limits: %w", err)
+		}
+		g.MaxRows = nullIntPtr(maxRows)
+		g.MaxExecutionMs = nullIntPtr(maxExecutionMs)
+		g.MaxConcurrentQueries = nullIntPtr(maxConcurrent)
+		overrides = append(overrides, &g)
+	}
+	return overrides, rows.Err()
+}
+
+// Save creates or replaces the override of a profile
+func (r *QueryGovernorRepository) Save(ctx context.Context, g *models.SystemQueryGovernor) error {
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+		%s`,
+		constants.TableQueryGovernor, constants.FieldSysQueryGovernor_ID, constants.FieldSysQueryGovernor_ProfileID,
+		constants.FieldSysQueryGovernor_MaxRows, constants.FieldSysQueryGovernor_MaxExecutionMs, constants.FieldSysQueryGovernor_MaxConcurrentQueries,
+		constants.FieldSysQueryGovernor_CreatedDate, constants.FieldSysQueryGovernor_LastModifiedDate,
+		query.ActiveDialect().Upsert([]string{constants.FieldSysQueryGovernor_ProfileID}, []string{
+			constants.FieldSysQueryGovernor_MaxRows, constants.FieldSysQueryGovernor_MaxExecutionMs,
+			constants.FieldSysQueryGovernor_MaxConcurrentQueries,
+		}, constants.FieldSysQueryGovernor_LastModifiedDate+" = NOW()"))
+	if _, err := r.db.ExecContext(ctx, stmt, g.ID, g.ProfileID, g.MaxRows, g.MaxExecutionMs, g.MaxConcurrentQueries); err != nil {
+		return fmt.Errorf("failed to save governor limits of profile %s: %w", g.ProfileID, err)
+	}
+	return nil
+}
+
+// Delete removes the override of a profile, returning it to the defaults
+func (r *QueryGovernorRepository) Delete(ctx context.Context, profileID string) error {
+	q := query.Delete(constants.TableQueryGovernor).
+		Where(constants.FieldSysQueryGovernor_ProfileID+" = ?", profileID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete governor limits of profile %s: %w", profileID, err)
+	}
+	return nil
+}
+
+// ProfileExists reports whether a profile exists
+func (r *QueryGovernorRepository) ProfileExists(ctx context.Context, profileID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?", constants.TableProfile, constants.FieldID), profileID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check profile %s: %w", profileID, err)
+	}
+	return count > 0, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
@@ -14,7 +14,8 @@ import (
 
 // QueryRepository handles complex read operations (filtering, searching, analytics)
 type QueryRepository struct {
-	db *sql.DB
+	db    *sql.DB
+	reads *ReadRouter // Routes stale-tolerant analytics to a read replica; nil reads the primary
 }
 
 // NewQueryRepository creates a new QueryRepository
@@ -22,17 +23,42 @@ func NewQueryRepository(db *sql.DB) *QueryRepository {
 	return &QueryRepository{db: db}
 }
 
+// SetReadRouter sets the router that sends stale-tolerant analytics reads to a read replica
+func (r *QueryRepository) SetReadRouter(reads *ReadRouter) {
+	r.reads = reads
+}
+
 // GetExecutor returns the DB connection (Queries are usually not transactional, but could be)
 func (r *QueryRepository) GetExecutor() Executor {
 	return r.db
 }
 
+// analyticsExecutor returns the replica when ctx tolerates stale reads and the replica is
+// fit to serve them, and the primary otherwise
+func (r *QueryRepository) analyticsExecutor(ctx context.Context) Executor {
+	if r.reads == nil {
+		return r.db
+	}
+	return r.reads.Reader(ctx)
+}
+
 // Find executes a structured query request
 func (r *QueryRepository) Find(ctx context.Context, tableSchema *models.ObjectMetadata, req models.QueryRequest, visibleFields []string) ([]models.SObject, error) {
 	// Build query
 	builder := query.From(tableSchema.APIName).WithMetadata(tableSchema)
 	builder.Select(visibleFields)
 
+	// Read archived rows too; objects that were never archived only have live rows
+	if req.IncludeArchived {
+		union, err := ArchiveUnion(ctx, r.db, tableSchema.APIName)
+		if err != nil {
+			return nil, err
+		}
+		if union != "" {
+			builder.FromDerived(union)
+		}
+	}
+
 	// Exclude deleted (only if field exists)
 	hasIsDeleted := false
 	for _, f := range tableSchema.Fields {
@@ -48,9 +74,9 @@ func (r *QueryRepository) Find(ctx context.Context, tableSchema *models.ObjectMe
 	// Apply criteria
 	if len(req.Criteria) > 0 {
 		for _, c := range req.Criteria {
-			// Validate Field Name (simple alphanumeric check)
-			if !isValidFieldName(c.Field) {
-				return nil, fmt.Errorf("invalid field name in criteria: %s", c.Field)
+			column, err := query.QuoteColumn(tableSchema.APIName, c.Field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field name in criteria: %w", err)
 			}
 			// Validate Operator
 			validOps := map[string]bool{
@@ -60,7 +86,7 @@ func (r *QueryRepository) Find(ctx context.Context, tableSchema *models.ObjectMe
 				return nil, fmt.Errorf("invalid operator in criteria: %s", c.Op)
 			}
 
-			condition := fmt.Sprintf("`%s`.`%s` %s ?", tableSchema.APIName, c.Field, c.Op)
+			condition := fmt.Sprintf("%s %s ?", column, c.Op)
 			builder.Where(condition, c.Val)
 		}
 	}
@@ -76,6 +102,10 @@ func (r *QueryRepository) Find(ctx context.Context, tableSchema *models.ObjectMe
 
 	// Apply sorting
 	if req.SortField != "" {
+		// Validated here: OrderBy passes qualified names through unchecked
+		if err := query.ValidateIdentifier(req.SortField); err != nil {
+			return nil, fmt.Errorf("invalid sort field: %w", err)
+		}
 		builder.OrderBy(req.SortField, req.SortDirection)
 	}
 
@@ -85,8 +115,14 @@ func (r *QueryRepository) Find(ctx context.Context, tableSchema *models.ObjectMe
 		limit = 20
 	}
 	builder.Limit(limit)
+	if req.Offset > 0 {
+		builder.Offset(req.Offset)
+	}
 
 	// Build and execute
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
 	q := builder.Build()
 
 	exec := r.GetExecutor()
@@ -113,7 +149,11 @@ func (r *QueryRepository) Search(ctx context.Context, tableName string, term str
 			searchConditions := make([]string, 0)
 			searchParams := make([]interface{}, 0)
 			for _, field := range searchFields {
-				searchConditions = append(searchConditions, fmt.Sprintf("`%s`.`%s` LIKE ?", tableName, field))
+				column, err := query.QuoteColumn(tableName, field)
+				if err != nil {
+					return nil, fmt.Errorf("invalid search field: %w", err)
+				}
+				searchConditions = append(searchConditions, fmt.Sprintf("%s LIKE ?", column))
 				searchParams = append(searchParams, fmt.Sprintf("%%%s%%", term))
 			}
 			builder.WhereRaw(fmt.Sprintf("(%s)", strings.Join(searchConditions, " OR ")), searchParams)
@@ -125,6 +165,9 @@ func (r *QueryRepository) Search(ctx context.Context, tableName string, term str
 	}
 	builder.Limit(limit)
 
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
 	q := builder.Build()
 
 	exec := r.GetExecutor()
@@ -156,28 +199,60 @@ func (r *QueryRepository) RunAnalytics(ctx context.Context, tableName string, q
 		builder.WhereRaw(sqlWhere, args)
 	}
 
+	// Field and GroupBy come straight from the request; quote them before they reach SQL
+	var field, groupBy string
+	if q.Field != nil {
+		quoted, err := query.QuoteIdentifier(*q.Field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid analytics field: %w", err)
+		}
+		field = quoted
+	}
+	if q.GroupBy != nil {
+		quoted, err := query.QuoteIdentifier(*q.GroupBy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group by field: %w", err)
+		}
+		groupBy = quoted
+	}
+
 	switch q.Operation {
 	case OpCount:
 		builder.AddSelectRaw("COUNT(*) as val")
 
 	case OpGroupBy:
+		if groupBy == "" {
+			return nil, fmt.Errorf("%s requires a group by field", OpGroupBy)
+		}
 		agg := "COUNT(*)"
-		if q.Field != nil {
-			agg = fmt.Sprintf("SUM(`%s`)", *q.Field)
+		if field != "" {
+			agg = fmt.Sprintf("SUM(%s)", field)
 		}
 
-		builder.AddSelectRaw(fmt.Sprintf("`%s` as name", *q.GroupBy))
+		builder.AddSelectRaw(fmt.Sprintf("%s as name", groupBy))
 		builder.AddSelectRaw(fmt.Sprintf("%s as value", agg))
-		builder.GroupByRaw(fmt.Sprintf("`%s`", *q.GroupBy))
+		builder.GroupByRaw(groupBy)
 		builder.Limit(20)
 
 	default: // sum, avg
-		builder.AddSelectRaw(fmt.Sprintf("%s(`%s`) as val", strings.ToUpper(q.Operation), *q.Field))
+		fn := strings.ToUpper(q.Operation)
+		switch fn {
+		case RollupTypeSum, RollupTypeAvg, RollupTypeMin, RollupTypeMax:
+		default:
+			return nil, fmt.Errorf("unsupported analytics operation: %s", q.Operation)
+		}
+		if field == "" {
+			return nil, fmt.Errorf("%s requires a field", q.Operation)
+		}
+		builder.AddSelectRaw(fmt.Sprintf("%s(%s) as val", fn, field))
 	}
 
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
 	queryP := builder.Build()
 
-	exec := r.GetExecutor()
+	exec := r.analyticsExecutor(ctx)
 	rows, err := exec.QueryContext(ctx, queryP.SQL, queryP.Params...)
 	if err != nil {
 		return nil, err
@@ -200,10 +275,142 @@ func (r *QueryRepository) RunAnalytics(ctx context.Context, tableName string, q
 	return 0, nil
 }
 
-// ExecuteRawSQL executes a raw SQL string (Validated by Service Layer)
-func (r *QueryRepository) ExecuteRawSQL(ctx context.Context, sql string, params []interface{}) ([]models.SObject, error) {
-	exec := r.GetExecutor()
-	rows, err := exec.QueryContext(ctx, sql, params...)
+// RunAggregates computes several aggregates over the rows matching filterExpr in a single query.
+// Results are keyed by AggregateAlias(function, field).
+func (r *QueryRepository) RunAggregates(ctx context.Context, tableSchema *models.ObjectMetadata, filterExpr string, aggregates []models.ListViewAggregate) (map[string]interface{}, error) {
+	results := make(map[string]interface{})
+	if len(aggregates) == 0 {
+		return results, nil
+	}
+
+	builder, err := aggregateBuilder(tableSchema, filterExpr, aggregates)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := r.runBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 {
+		for k, v := range records[0] {
+			results[k] = v
+		}
+	}
+	return results, nil
+}
+
+// RunGroupedAggregates computes a row count plus the given aggregates per distinct value of groupBy.
+// Results are keyed by group value ("" for NULL), then by AggregateAlias; the row count is under "count".
+func (r *QueryRepository) RunGroupedAggregates(ctx context.Context, tableSchema *models.ObjectMetadata, filterExpr string, groupBy string, aggregates []models.ListViewAggregate) (map[string]map[string]interface{}, error) {
+	if !query.IsValidIdentifier(groupBy) {
+		return nil, fmt.Errorf("invalid group by field: %s", groupBy)
+	}
+
+	withCount := append([]models.ListViewAggregate{{Function: RollupTypeCount}}, aggregates...)
+	builder, err := aggregateBuilder(tableSchema, filterExpr, withCount)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddSelectRaw(fmt.Sprintf("`%s`", groupBy), groupKeyAlias)
+	builder.GroupByRaw(fmt.Sprintf("`%s`", groupBy))
+
+	records, err := r.runBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]map[string]interface{}, len(records))
+	for _, rec := range records {
+		key := ""
+		if v := rec[groupKeyAlias]; v != nil {
+			key = fmt.Sprintf("%v", v)
+		}
+		delete(rec, groupKeyAlias)
+		results[key] = rec
+	}
+	return results, nil
+}
+
+// groupKeyAlias is the column alias used for the group value in grouped aggregate queries
+const groupKeyAlias = "__group_key"
+
+// aggregateBuilder builds a SELECT of aggregate expressions over non-deleted rows matching filterExpr
+func aggregateBuilder(tableSchema *models.ObjectMetadata, filterExpr string, aggregates []models.ListViewAggregate) (*query.Builder, error) {
+	builder := query.From(tableSchema.APIName).WithMetadata(tableSchema)
+	for _, f := range tableSchema.Fields {
+		if strings.EqualFold(f.APIName, constants.FieldIsDeleted) {
+			builder.ExcludeDeleted()
+			break
+		}
+	}
+
+	if filterExpr != "" {
+		sqlWhere, args, err := formula.ToSQL(filterExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+		builder.WhereRaw(sqlWhere, args)
+	}
+
+	for _, agg := range aggregates {
+		fn := strings.ToUpper(agg.Function)
+		if agg.Field != "" && !query.IsValidIdentifier(agg.Field) {
+			return nil, fmt.Errorf("invalid aggregate field: %s", agg.Field)
+		}
+		var expr string
+		switch fn {
+		case RollupTypeCount:
+			expr = FuncCount
+			if agg.Field != "" {
+				expr = fmt.Sprintf("COUNT(`%s`)", agg.Field)
+			}
+		case RollupTypeSum, RollupTypeAvg, RollupTypeMin, RollupTypeMax:
+			if agg.Field == "" {
+				return nil, fmt.Errorf("%s requires a field", fn)
+			}
+			expr = fmt.Sprintf("%s(`%s`)", fn, agg.Field)
+		default:
+			return nil, fmt.Errorf("unsupported aggregate function: %s", agg.Function)
+		}
+		builder.AddSelectRaw(expr, AggregateAlias(fn, agg.Field))
+	}
+	return builder, nil
+}
+
+func (r *QueryRepository) runBuilder(ctx context.Context, builder *query.Builder) ([]models.SObject, error) {
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
+	q := builder.Build()
+	rows, err := r.analyticsExecutor(ctx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate query error: %w", err)
+	}
+	defer rows.Close()
+
+	return query.ScanRowsToSObjects(rows)
+}
+
+// AggregateAlias returns the result key for an aggregate, e.g. "sum_amount" or "count"
+func AggregateAlias(function, field string) string {
+	if field == "" {
+		return strings.ToLower(function)
+	}
+	return strings.ToLower(function) + "_" + field
+}
+
+// ExecuteReadOnlySQL executes a raw SQL string (Validated by Service Layer) in a read-only
+// transaction, so the database rejects any write the validation missed. The transaction is
+// always rolled back.
+func (r *QueryRepository) ExecuteReadOnlySQL(ctx context.Context, sqlStr string, params []interface{}) ([]models.SObject, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start read-only transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, sqlStr, params...)
 	if err != nil {
 		return nil, fmt.Errorf("raw query error: %w", err)
 	}
@@ -225,8 +432,20 @@ func (r *QueryRepository) GetLookupNames(ctx context.Context, tableName string,
 		params[i] = id
 	}
 
-	sql := fmt.Sprintf("SELECT `id`, `%s` FROM `%s` WHERE `id` IN (%s)",
-		nameField, tableName, strings.Join(placeholders, ","))
+	name, err := query.QuoteIdentifier(nameField)
+	if err != nil {
+		return nil, err
+	}
+	table, err := query.QuoteIdentifier(tableName)
+	if err != nil {
+		return nil, err
+	}
+	id, err := query.QuoteIdentifier(constants.FieldID)
+	if err != nil {
+		return nil, err
+	}
+	sql := fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s IN (%s)",
+		id, name, table, id, strings.Join(placeholders, ","))
 
 	exec := r.GetExecutor()
 	rows, err := exec.QueryContext(ctx, sql, params...)
@@ -238,12 +457,54 @@ func (r *QueryRepository) GetLookupNames(ctx context.Context, tableName string,
 	return query.ScanRowsToSObjects(rows)
 }
 
-// isValidFieldName checks if a field name is safe (alphanumeric + underscore)
-func isValidFieldName(name string) bool {
-	for _, c := range name {
-		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_') {
-			return false
-		}
+// ScanAfter returns up to limit non-deleted rows ordered by ID, starting after afterID.
+// Used for keyset-paginated full-table scans (e.g. search index rebuilds).
+func (r *QueryRepository) ScanAfter(ctx context.Context, tableName string, fields []string, afterID string, limit int) ([]models.SObject, error) {
+	builder := query.From(tableName).Select(fields).ExcludeDeleted()
+	if afterID != "" {
+		builder.Where(fmt.Sprintf("`%s`.`%s` > ?", tableName, constants.FieldID), afterID)
+	}
+	builder.OrderBy(constants.FieldID, constants.SortASC)
+	builder.Limit(limit)
+
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
+	q := builder.Build()
+	rows, err := r.GetExecutor().QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("scan error: %w", err)
+	}
+	defer rows.Close()
+
+	return query.ScanRowsToSObjects(rows)
+}
+
+// FindByIDs returns the non-deleted rows whose ID is in ids
+func (r *QueryRepository) FindByIDs(ctx context.Context, tableName string, fields []string, ids []string) ([]models.SObject, error) {
+	if len(ids) == 0 {
+		return []models.SObject{}, nil
 	}
-	return true
+
+	placeholders := make([]string, len(ids))
+	params := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		params[i] = id
+	}
+
+	builder := query.From(tableName).Select(fields).ExcludeDeleted()
+	builder.WhereRaw(fmt.Sprintf("`%s`.`%s` IN (%s)", tableName, constants.FieldID, strings.Join(placeholders, ",")), params)
+
+	if err := builder.Err(); err != nil {
+		return nil, err
+	}
+	q := builder.Build()
+	rows, err := r.GetExecutor().QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("find by ids error: %w", err)
+	}
+	defer rows.Close()
+
+	return query.ScanRowsToSObjects(rows)
 }
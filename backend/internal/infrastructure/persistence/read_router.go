@@ -0,0 +1,187 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// staleReadKey carries the replica lag a read tolerates
+type staleReadKey struct{}
+
+// defaultStaleness marks a read that tolerates the router's default replica lag
+const defaultStaleness time.Duration = -1
+
+// AllowStaleReads marks the reads made with ctx as servable by the read replica, within the
+// router's default staleness tolerance. A tolerance already set on ctx is kept, so callers
+// can demand fresher data.
+func AllowStaleReads(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(staleReadKey{}).(time.Duration); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, staleReadKey{}, defaultStaleness)
+}
+
+// WithStaleness sets the replica lag the reads made with ctx tolerate; 0 keeps them on the primary
+func WithStaleness(ctx context.Context, tolerance time.Duration) context.Context {
+	if tolerance < 0 {
+		tolerance = 0
+	}
+	return context.WithValue(ctx, staleReadKey{}, tolerance)
+}
+
+// ReadRouter sends reads that tolerate staleness to a read replica while it is reachable
+// and close enough to the primary. Writes, permission checks and every other read stay on
+// the primary.
+type ReadRouter struct {
+	primary      *sql.DB
+	replica      *sql.DB // nil when no replica is configured
+	maxStaleness time.Duration
+
+	mu        sync.RWMutex
+	healthy   bool
+	lag       time.Duration // -1 when the replica does not report its lag
+	lastError string
+	checkedAt time.Time
+}
+
+// NewReadRouter creates a new ReadRouter. replica may be nil, routing every read to the primary.
+func NewReadRouter(primary, replica *sql.DB, maxStaleness time.Duration) *ReadRouter {
+	return &ReadRouter{
+		primary:      primary,
+		replica:      replica,
+		maxStaleness: maxStaleness,
+		healthy:      replica != nil,
+		lag:          -1,
+	}
+}
+
+// Reader returns the connection a read made with ctx should use
+func (r *ReadRouter) Reader(ctx context.Context) Executor {
+	if r.replica == nil {
+		return r.primary
+	}
+	tolerance, ok := ctx.Value(staleReadKey{}).(time.Duration)
+	if tolerance == defaultStaleness {
+		tolerance = r.maxStaleness
+	}
+	if !ok || tolerance == 0 {
+		return r.primary
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.healthy || r.lag > tolerance {
+		return r.primary
+	}
+	return r.replica
+}
+
+// Check pings the replica and measures its lag. It runs on the scheduler tick.
+func (r *ReadRouter) Check(ctx context.Context, now time.Time) {
+	if r.replica == nil {
+		return
+	}
+	lag := time.Duration(-1)
+	err := r.replica.PingContext(ctx)
+	if err == nil {
+		lag, err = replicaLag(ctx, r.replica)
+	}
+	healthy := err == nil
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if healthy != r.healthy {
+		if healthy {
+			log.Printf("✅ [ReadReplica] Replica is available again; routing stale-tolerant reads to it")
+		} else {
+			log.Printf("⚠️ [ReadReplica] Replica is unavailable, reading from the primary: %v", err)
+		}
+	}
+	r.healthy = healthy
+	r.lag = lag
+	r.checkedAt = now
+	r.lastError = ""
+	if err != nil {
+		r.lastError = err.Error()
+	}
+}
+
+// Status reports the replica's configuration and last health check
+func (r *ReadRouter) Status() *models.ReadReplicaStatus {
+	status := &models.ReadReplicaStatus{
+		Configured:     r.replica != nil,
+		MaxStalenessMs: r.maxStaleness.Milliseconds(),
+	}
+	if r.replica == nil {
+		return status
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	status.Healthy = r.healthy
+	if r.lag >= 0 {
+		lagMs := r.lag.Milliseconds()
+		status.LagMs = &lagMs
+	}
+	if !r.checkedAt.IsZero() {
+		checkedAt := r.checkedAt
+		status.CheckedAt = &checkedAt
+	}
+	if r.lastError != "" {
+		lastError := r.lastError
+		status.LastError = &lastError
+	}
+	return status
+}
+
+// replicaLag reads Seconds_Behind_Source (or Seconds_Behind_Master on older servers) from
+// the replica's status. It returns -1 when the server does not report a lag, as with TiDB,
+// and an error when replication is stopped.
+func replicaLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	for _, stmt := range []string{"SHOW REPLICA STATUS", "SHOW SLAVE STATUS"} {
+		rows, err := db.QueryContext(ctx, stmt)
+		if err != nil {
+			continue
+		}
+		lag, err := scanReplicaLag(rows)
+		rows.Close()
+		return lag, err
+	}
+	return -1, nil
+}
+
+func scanReplicaLag(rows *sql.Rows) (time.Duration, error) {
+	columns, err := rows.Columns()
+	if err != nil || !rows.Next() {
+		return -1, nil
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return -1, nil
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if values[i] == nil {
+			return -1, fmt.Errorf("replication is not running")
+		}
+		seconds, err := strconv.Atoi(string(values[i]))
+		if err != nil {
+			return -1, fmt.Errorf("unexpected %s %q", column, values[i])
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return -1, nil
+}
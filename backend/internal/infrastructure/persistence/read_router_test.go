@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRouterReader(t *testing.T) {
+	primary, replica := &sql.DB{}, &sql.DB{}
+	router := NewReadRouter(primary, replica, 30*time.Second)
+	ctx := context.Background()
+
+	assert.Same(t, primary, router.Reader(ctx), "reads without a tolerance stay on the primary")
+	assert.Same(t, replica, router.Reader(AllowStaleReads(ctx)))
+	assert.Same(t, primary, router.Reader(AllowStaleReads(WithStaleness(ctx, 0))), "an explicit tolerance is kept")
+
+	router.lag = 10 * time.Second
+	assert.Same(t, replica, router.Reader(AllowStaleReads(ctx)))
+	assert.Same(t, primary, router.Reader(WithStaleness(ctx, 5*time.Second)))
+
+	router.healthy = false
+	assert.Same(t, primary, router.Reader(AllowStaleReads(ctx)))
+
+	unconfigured := NewReadRouter(primary, nil, 30*time.Second)
+	assert.Same(t, primary, unconfigured.Reader(AllowStaleReads(ctx)))
+	assert.False(t, unconfigured.Status().Configured)
+
+	strict := NewReadRouter(primary, replica, 0)
+	assert.Same(t, primary, strict.Reader(AllowStaleReads(ctx)), "a zero default keeps stale-tolerant reads on the primary")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
@@ -156,11 +158,14 @@ func (r *RecordRepository) Delete(ctx context.Context, tx *sql.Tx, tableName str
 
 // GetChildren retrieves active child records for cascade delete
 func (r *RecordRepository) GetChildren(ctx context.Context, tx *sql.Tx, childTable string, foreignKey string, parentID string) ([]models.SObject, error) {
-	q := query.From(childTable).
+	b := query.From(childTable).
 		Select([]string{constants.FieldID}).
-		Where(fmt.Sprintf("%s = ?", foreignKey), parentID).
-		ExcludeDeleted(). // Generic "Active" check
-		Build()
+		WhereColumn(foreignKey, "=", parentID).
+		ExcludeDeleted() // Generic "Active" check
+	if err := b.Err(); err != nil {
+		return nil, err
+	}
+	q := b.Build()
 
 	exec := r.GetExecutor(tx)
 	rows, err := exec.QueryContext(ctx, q.SQL, q.Params...)
@@ -174,12 +179,15 @@ func (r *RecordRepository) GetChildren(ctx context.Context, tx *sql.Tx, childTab
 
 // ExistsByField checks if any record exists matching a specific field value (useful for Restrict delete rules)
 func (r *RecordRepository) ExistsByField(ctx context.Context, tx *sql.Tx, tableName string, fieldName string, value interface{}) (bool, error) {
-	q := query.From(tableName).
+	b := query.From(tableName).
 		Select([]string{constants.FieldID}).
-		Where(fmt.Sprintf("%s = ?", fieldName), value).
+		WhereColumn(fieldName, "=", value).
 		ExcludeDeleted().
-		Limit(1).
-		Build()
+		Limit(1)
+	if err := b.Err(); err != nil {
+		return false, err
+	}
+	q := b.Build()
 
 	exec := r.GetExecutor(tx)
 	rows, err := exec.QueryContext(ctx, q.SQL, q.Params...)
@@ -255,26 +263,93 @@ func (r *RecordRepository) PhysicalDelete(ctx context.Context, tx *sql.Tx, table
 
 // DeleteByField deletes records matching a specific field value
 func (r *RecordRepository) DeleteByField(ctx context.Context, tx *sql.Tx, tableName string, fieldName string, value interface{}) error {
-	q := query.Delete(tableName).
-		Where(fmt.Sprintf("%s = ?", fieldName), value).
-		Build()
+	b := query.Delete(tableName).
+		WhereColumn(fieldName, "=", value)
+	if err := b.Err(); err != nil {
+		return err
+	}
+	q := b.Build()
 
 	exec := r.GetExecutor(tx)
 	_, err := exec.ExecContext(ctx, q.SQL, q.Params...)
 	return err
 }
 
+// CountByField counts records (including deleted ones) holding a specific field value
+func (r *RecordRepository) CountByField(ctx context.Context, tableName string, fieldName string, value interface{}) (int, error) {
+	b := query.From(tableName).
+		AddSelectRaw("COUNT(*)", "total").
+		WhereColumn(fieldName, "=", value)
+	if err := b.Err(); err != nil {
+		return 0, err
+	}
+	q := b.Build()
+
+	var total int
+	err := r.GetExecutor(nil).QueryRowContext(ctx, q.SQL, q.Params...).Scan(&total)
+	return total, err
+}
+
+// ReplaceFieldValue rewrites a field value on up to batchSize records (including deleted ones)
+// and returns the number of records updated. Zero means no records hold the value anymore.
+func (r *RecordRepository) ReplaceFieldValue(ctx context.Context, tableName string, fieldName string, from, to interface{}, batchSize int) (int, error) {
+	b := query.From(tableName).
+		Select([]string{constants.FieldID}).
+		WhereColumn(fieldName, "=", from).
+		Limit(batchSize)
+	if err := b.Err(); err != nil {
+		return 0, err
+	}
+	q := b.Build()
+
+	exec := r.GetExecutor(nil)
+	rows, err := exec.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return 0, err
+	}
+	records, err := query.ScanRowsToSObjects(rows)
+	rows.Close()
+	if err != nil || len(records) == 0 {
+		return 0, err
+	}
+
+	ids := make([]interface{}, len(records))
+	for i, record := range records {
+		ids[i] = record[constants.FieldID]
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+
+	u := query.Update(tableName).
+		Set(map[string]interface{}{
+			fieldName:                       to,
+			constants.FieldLastModifiedDate: time.Now(),
+		}).
+		WhereColumn(fieldName, "=", from).
+		WhereRaw(fmt.Sprintf("%s IN (%s)", constants.FieldID, placeholders), ids).
+		Build()
+
+	res, err := exec.ExecContext(ctx, u.SQL, u.Params...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	return int(affected), err
+}
+
 // CheckUniqueness checks if a value exists for a field, excluding a specific ID
 func (r *RecordRepository) CheckUniqueness(ctx context.Context, tableName string, fieldName string, value interface{}, excludeID string) (bool, error) {
 	builder := query.From(tableName).
 		Select([]string{constants.FieldID}).
-		Where(fmt.Sprintf("%s = ?", fieldName), value).
+		WhereColumn(fieldName, "=", value).
 		Limit(1)
 
 	if excludeID != "" {
 		builder.Where(fmt.Sprintf("%s != ?", constants.FieldID), excludeID)
 	}
 
+	if err := builder.Err(); err != nil {
+		return false, err
+	}
 	q := builder.Build()
 
 	exec := r.GetExecutor(nil)
@@ -19,7 +19,7 @@ func TestRecordRepository_Integration(t *testing.T) {
 	}
 
 	// Setup DB
-	conn, err := database.GetInstance()
+	conn, err := database.GetTestInstance()
 	require.NoError(t, err)
 	db := conn.DB()
 
@@ -0,0 +1,137 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// StageAge is when a record entered its current stage and the day count stored for it
+type StageAge struct {
+	RecordID     string
+	EnteredDate  time.Time
+	DaysInStage  int
+	HasDaysValue bool
+}
+
+// RecordStatsRepository writes the server-maintained statistics fields of records
+// (last activity, open tasks, time in stage). Like rollups, the values are written
+// directly so they neither fire record events nor touch the audit fields.
+type RecordStatsRepository struct {
+	db *sql.DB
+}
+
+// NewRecordStatsRepository creates a new RecordStatsRepository
+func NewRecordStatsRepository(db *sql.DB) *RecordStatsRepository {
+	return &RecordStatsRepository{db: db}
+}
+
+// SetStats writes statistics fields of a record
+func (r *RecordStatsRepository) SetStats(ctx context.Context, table, recordID string, values map[string]interface{}) error {
+	q := query.Update(table).
+		Set(values).
+		Where(constants.FieldID+" = ?", recordID)
+	if err := q.Err(); err != nil {
+		return err
+	}
+	built := q.Build()
+	if _, err := r.db.ExecContext(ctx, built.SQL, built.Params...); err != nil {
+		return fmt.Errorf("failed to update statistics of %s/%s: %w", table, recordID, err)
+	}
+	return nil
+}
+
+// TouchLastActivity moves a record's last activity date forward to at; older dates are ignored
+func (r *RecordStatsRepository) TouchLastActivity(ctx context.Context, table, recordID string, at time.Time) error {
+	if err := query.ValidateIdentifier(table); err != nil {
+		return err
+	}
+	stamp := at.Format("2006-01-02 15:04:05")
+	sqlStr := fmt.Sprintf("UPDATE `%s` SET `%s` = ? WHERE `%s` = ? AND (`%s` IS NULL OR `%s` < ?)",
+		table, constants.FieldLastActivityDate, constants.FieldID, constants.FieldLastActivityDate, constants.FieldLastActivityDate)
+	if _, err := r.db.ExecContext(ctx, sqlStr, stamp, recordID, stamp); err != nil {
+		return fmt.Errorf("failed to update last activity of %s/%s: %w", table, recordID, err)
+	}
+	return nil
+}
+
+// CountOpenTasks counts the live records of taskTable that reference parentID through
+// lookupField and whose statusField is not one of closedStatuses
+func (r *RecordStatsRepository) CountOpenTasks(ctx context.Context, taskTable, lookupField, parentID, statusField string, closedStatuses []string) (int, error) {
+	for _, name := range []string{taskTable, lookupField, statusField} {
+		if err := query.ValidateIdentifier(name); err != nil {
+			return 0, err
+		}
+	}
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM `%s` WHERE `%s` = ? AND `%s` = false", taskTable, lookupField, constants.FieldIsDeleted)
+	args := []interface{}{parentID}
+	if len(closedStatuses) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(closedStatuses)), ", ")
+		sqlStr += fmt.Sprintf(" AND (`%s` IS NULL OR `%s` NOT IN (%s))", statusField, statusField, placeholders)
+		for _, status := range closedStatuses {
+			args = append(args, status)
+		}
+	}
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, sqlStr, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count open tasks of %s: %w", parentID, err)
+	}
+	return count, nil
+}
+
+// StageAges returns the live records of table that have a stage entry date
+func (r *RecordStatsRepository) StageAges(ctx context.Context, table string) ([]StageAge, error) {
+	if err := query.ValidateIdentifier(table); err != nil {
+		return nil, err
+	}
+	sqlStr := fmt.Sprintf("SELECT `%s`, `%s`, `%s` FROM `%s` WHERE `%s` IS NOT NULL AND `%s` = false",
+		constants.FieldID, constants.FieldStageEnteredDate, constants.FieldDaysInCurrentStage, table,
+		constants.FieldStageEnteredDate, constants.FieldIsDeleted)
+	rows, err := r.db.QueryContext(ctx, sqlStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stage ages of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	ages := make([]StageAge, 0)
+	for rows.Next() {
+		var age StageAge
+		var entered sql.NullTime
+		var days sql.NullInt64
+		if err := rows.Scan(&age.RecordID, &entered, &days); err != nil {
+			return nil, err
+		}
+		age.EnteredDate = entered.Time
+		age.DaysInStage = int(days.Int64)
+		age.HasDaysValue = days.Valid
+		ages = append(ages, age)
+	}
+	return ages, rows.Err()
+}
+
+// SetDaysInStage stores the same day count on several records of table
+func (r *RecordStatsRepository) SetDaysInStage(ctx context.Context, table string, days int, recordIDs []string) error {
+	if len(recordIDs) == 0 {
+		return nil
+	}
+	if err := query.ValidateIdentifier(table); err != nil {
+		return err
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(recordIDs)), ", ")
+	sqlStr := fmt.Sprintf("UPDATE `%s` SET `%s` = ? WHERE `%s` IN (%s)", table, constants.FieldDaysInCurrentStage, constants.FieldID, placeholders)
+	args := make([]interface{}, 0, len(recordIDs)+1)
+	args = append(args, days)
+	for _, id := range recordIDs {
+		args = append(args, id)
+	}
+	if _, err := r.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("failed to update days in stage of %s: %w", table, err)
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ReportRepository handles storage of report definitions and execution of resolved report queries
+type ReportRepository struct {
+	db    *sql.DB
+	reads *ReadRouter // Routes stale-tolerant report runs to a read replica; nil reads the primary
+}
+
+// NewReportRepository creates a new ReportRepository
+func NewReportRepository(db *sql.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// SetReadRouter sets the router that sends stale-tolerant report runs to a read replica
+func (r *ReportRepository) SetReadRouter(reads *ReadRouter) {
+	r.reads = reads
+}
+
+var reportColumns = []string{
+	constants.FieldSysReport_ID,
+	constants.FieldSysReport_Name,
+	constants.FieldSysReport_Description,
+	constants.FieldSysReport_ObjectAPIName,
+	constants.FieldSysReport_Format,
+	constants.FieldSysReport_Columns,
+	constants.FieldSysReport_FilterExpr,
+	constants.FieldSysReport_Groupings,
+	constants.FieldSysReport_ColumnGroupings,
+	constants.FieldSysReport_Aggregates,
+	constants.FieldSysReport_SortField,
+	constants.FieldSysReport_SortDirection,
+	constants.FieldSysReport_RowLimit,
+	constants.FieldSysReport_OwnerID,
+	constants.FieldSysReport_CreatedDate,
+	constants.FieldSysReport_LastModifiedDate,
+}
+
+// List returns report definitions ordered by name, optionally restricted to one object
+func (r *ReportRepository) List(ctx context.Context, objectAPIName string) ([]*models.Report, error) {
+	builder := query.From(constants.TableReport).Select(reportColumns)
+	if objectAPIName != "" {
+		builder.Where(constants.FieldSysReport_ObjectAPIName+" = ?", objectAPIName)
+	}
+	q := builder.OrderBy(constants.FieldSysReport_Name, constants.SortASC).Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := make([]*models.Report, 0)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
+
+// FindByID returns a report definition, or nil if not found
+func (r *ReportRepository) FindByID(ctx context.Context, id string) (*models.Report, error) {
+	q := query.From(constants.TableReport).
+		Select(reportColumns).
+		Where(constants.FieldSysReport_ID+" = ?", id).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanReport(rows)
+}
+
+// Insert stores a new report definition
+func (r *ReportRepository) Insert(ctx context.Context, report *models.Report) error {
+	values, err := reportValues(report)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysReport_ID] = report.ID
+	values[constants.FieldSysReport_OwnerID] = report.OwnerID
+	values[constants.FieldSysReport_CreatedDate] = now
+	values[constants.FieldSysReport_LastModifiedDate] = now
+
+	q := query.Insert(constants.TableReport, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert report: %w", err)
+	}
+	report.CreatedDate = &now
+	report.LastModifiedDate = &now
+	return nil
+}
+
+// Update overwrites the definition of a report. Returns false if no such report exists.
+func (r *ReportRepository) Update(ctx context.Context, report *models.Report) (bool, error) {
+	values, err := reportValues(report)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	values[constants.FieldSysReport_LastModifiedDate] = now
+
+	q := query.Update(constants.TableReport).
+		Set(values).
+		Where(constants.FieldSysReport_ID+" = ?", report.ID).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update report: %w", err)
+	}
+	report.LastModifiedDate = &now
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Delete removes a report definition. Returns false if no such report exists.
+func (r *ReportRepository) Delete(ctx context.Context, id string) (bool, error) {
+	q := query.Delete(constants.TableReport).
+		Where(constants.FieldSysReport_ID+" = ?", id).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete report: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// reportValues maps the editable fields of a report to column values
+func reportValues(report *models.Report) (map[string]interface{}, error) {
+	columnsJSON, err := json.Marshal(report.Columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal report columns: %w", err)
+	}
+	groupings, err := marshalOptionalJSON(report.Groupings)
+	if err != nil {
+		return nil, err
+	}
+	columnGroupings, err := marshalOptionalJSON(report.ColumnGroupings)
+	if err != nil {
+		return nil, err
+	}
+	aggregates, err := marshalOptionalJSON(report.Aggregates)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		constants.FieldSysReport_Name:            report.Name,
+		constants.FieldSysReport_Description:     report.Description,
+		constants.FieldSysReport_ObjectAPIName:   report.ObjectAPIName,
+		constants.FieldSysReport_Format:          string(report.Format),
+		constants.FieldSysReport_Columns:         string(columnsJSON),
+		constants.FieldSysReport_FilterExpr:      nullableString(report.FilterExpr),
+		constants.FieldSysReport_Groupings:       groupings,
+		constants.FieldSysReport_ColumnGroupings: columnGroupings,
+		constants.FieldSysReport_Aggregates:      aggregates,
+		constants.FieldSysReport_SortField:       nullableString(report.SortField),
+		constants.FieldSysReport_SortDirection:   nullableString(report.SortDirection),
+		constants.FieldSysReport_RowLimit:        report.RowLimit,
+	}, nil
+}
+
+// marshalOptionalJSON encodes a slice as JSON, storing empty slices as SQL NULL
+func marshalOptionalJSON[T any](items []T) (interface{}, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal report settings: %w", err)
+	}
+	return string(b), nil
+}
+
+// nullableString stores empty strings as SQL NULL
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func scanReport(rows *sql.Rows) (*models.Report, error) {
+	var report models.Report
+	var description, filterExpr, sortField, sortDirection, ownerID sql.NullString
+	var format string
+	var columnsJSON, groupingsJSON, columnGroupingsJSON, aggregatesJSON []byte
+	var rowLimit sql.NullInt64
+	var created, modified time.Time
+
+	if err := rows.Scan(&report.ID, &report.Name, &description, &report.ObjectAPIName, &format,
+		&columnsJSON, &filterExpr, &groupingsJSON, &columnGroupingsJSON, &aggregatesJSON,
+		&sortField, &sortDirection, &rowLimit, &ownerID, &created, &modified); err != nil {
+		return nil, err
+	}
+
+	if description.Valid {
+		report.Description = &description.String
+	}
+	report.Format = models.ReportFormat(format)
+	report.FilterExpr = filterExpr.String
+	report.SortField = sortField.String
+	report.SortDirection = sortDirection.String
+	report.RowLimit = int(rowLimit.Int64)
+	report.OwnerID = ownerID.String
+	report.CreatedDate = &created
+	report.LastModifiedDate = &modified
+
+	for _, field := range []struct {
+		raw    []byte
+		target interface{}
+	}{
+		{columnsJSON, &report.Columns},
+		{groupingsJSON, &report.Groupings},
+		{columnGroupingsJSON, &report.ColumnGroupings},
+		{aggregatesJSON, &report.Aggregates},
+	} {
+		if len(field.raw) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(field.raw, field.target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal report %s: %w", report.ID, err)
+		}
+	}
+	if report.Columns == nil {
+		report.Columns = []models.ReportColumn{}
+	}
+	return &report, nil
+}
+
+// ReportJoin is a LEFT JOIN from a report's base object to a parent object through a lookup field
+type ReportJoin struct {
+	Alias          string // Table alias referenced by ReportField.Table
+	Table          string // Parent object table
+	LookupField    string // Lookup column on the base table
+	ExcludeDeleted bool   // Treat soft-deleted parents as missing
+}
+
+// ReportField is a column of a report query
+type ReportField struct {
+	Key    string // Result key, e.g. "amount", "account_id.industry" or "close_date:month"
+	Table  string // The base table or a join alias
+	Column string
+	Bucket constants.ReportDateBucket // Truncates a date column to a period label; empty keeps the value
+}
+
+// ReportPlan is a resolved report query. The service layer builds it from metadata after
+// checking permissions; Where must have been produced by the formula SQL walker.
+type ReportPlan struct {
+	Table          string
+	ExcludeDeleted bool
+	Joins          []ReportJoin
+	Where          string
+	Params         []interface{}
+}
+
+// RunReportRows returns up to limit detail rows, each keyed by ReportField.Key
+func (r *ReportRepository) RunReportRows(ctx context.Context, plan ReportPlan, fields []ReportField, sort *ReportField, sortDirection string, limit int) ([]models.SObject, error) {
+	builder, err := reportBuilder(plan)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		expr, err := reportFieldExpr(f)
+		if err != nil {
+			return nil, err
+		}
+		builder.AddSelectRaw(expr, f.Key)
+	}
+	if sort != nil {
+		expr, err := reportFieldExpr(*sort)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(sortDirection, constants.SortDESC) {
+			sortDirection = constants.SortASC
+		}
+		builder.OrderBy(expr, strings.ToUpper(sortDirection))
+	}
+	builder.Limit(limit)
+	return r.runReport(ctx, builder)
+}
+
+// RunReportAggregates returns the row count and aggregates of the report's records per distinct
+// combination of groupBy values (a single row when groupBy is empty), capped at limit groups.
+// Group values are keyed by ReportField.Key, aggregates by AggregateAlias.
+func (r *ReportRepository) RunReportAggregates(ctx context.Context, plan ReportPlan, groupBy []ReportField, aggregates []models.ListViewAggregate, limit int) ([]models.SObject, error) {
+	builder, err := reportBuilder(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	groupExprs := make([]string, 0, len(groupBy))
+	for _, f := range groupBy {
+		expr, err := reportFieldExpr(f)
+		if err != nil {
+			return nil, err
+		}
+		builder.AddSelectRaw(expr, f.Key)
+		groupExprs = append(groupExprs, expr)
+	}
+
+	builder.AddSelectRaw(FuncCount, AggregateAlias(RollupTypeCount, ""))
+	for _, agg := range aggregates {
+		fn := strings.ToUpper(agg.Function)
+		if !query.IsValidIdentifier(agg.Field) {
+			return nil, fmt.Errorf("invalid aggregate field: %s", agg.Field)
+		}
+		switch fn {
+		case RollupTypeCount, RollupTypeSum, RollupTypeAvg, RollupTypeMin, RollupTypeMax:
+			builder.AddSelectRaw(fmt.Sprintf("%s(`%s`.`%s`)", fn, plan.Table, agg.Field), AggregateAlias(fn, agg.Field))
+		default:
+			return nil, fmt.Errorf("unsupported aggregate function: %s", agg.Function)
+		}
+	}
+
+	if len(groupExprs) > 0 {
+		builder.GroupByRaw(strings.Join(groupExprs, ", "))
+		builder.Limit(limit)
+	}
+	return r.runReport(ctx, builder)
+}
+
+// reportBuilder starts a SELECT over the plan's base table and joins
+func reportBuilder(plan ReportPlan) (*query.Builder, error) {
+	if !query.IsValidIdentifier(plan.Table) {
+		return nil, fmt.Errorf("invalid report table: %s", plan.Table)
+	}
+	builder := query.From(plan.Table)
+	for _, join := range plan.Joins {
+		if !query.IsValidIdentifier(join.Alias) || !query.IsValidIdentifier(join.Table) || !query.IsValidIdentifier(join.LookupField) {
+			return nil, fmt.Errorf("invalid report join: %s", join.LookupField)
+		}
+		on := fmt.Sprintf("`%s`.`%s` = `%s`.`%s`", join.Alias, constants.FieldID, plan.Table, join.LookupField)
+		if join.ExcludeDeleted {
+			on += fmt.Sprintf(" AND `%s`.`%s` = %d", join.Alias, constants.FieldIsDeleted, constants.IsDeletedFalse)
+		}
+		builder.Join("LEFT", join.Table, join.Alias, on)
+	}
+	if plan.ExcludeDeleted {
+		builder.ExcludeDeleted()
+	}
+	builder.WhereRaw(plan.Where, plan.Params)
+	return builder, nil
+}
+
+// reportFieldExpr renders a validated, fully qualified column reference. Bucketed dates are
+// rendered as sortable period labels.
+func reportFieldExpr(f ReportField) (string, error) {
+	if !query.IsValidIdentifier(f.Table) || !query.IsValidIdentifier(f.Column) {
+		return "", fmt.Errorf("invalid report field: %s", f.Key)
+	}
+	col := fmt.Sprintf("`%s`.`%s`", f.Table, f.Column)
+	if f.Bucket == "" {
+		return col, nil
+	}
+	expr := query.ActiveDialect().DatePeriod(col, f.Bucket)
+	if expr == "" {
+		return "", fmt.Errorf("invalid date bucket for report field %s: %s", f.Key, f.Bucket)
+	}
+	return expr, nil
+}
+
+func (r *ReportRepository) runReport(ctx context.Context, builder *query.Builder) ([]models.SObject, error) {
+	var exec Executor = r.db
+	if r.reads != nil {
+		exec = r.reads.Reader(ctx)
+	}
+	q := builder.Build()
+	rows, err := exec.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("report query error: %w", err)
+	}
+	defer rows.Close()
+
+	return query.ScanRowsToSObjects(rows)
+}
@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SavedSearchRepository handles database operations for per-user saved searches.
+// All lookups are scoped by owner so one user can never read or modify another's searches.
+type SavedSearchRepository struct {
+	db *sql.DB
+}
+
+// NewSavedSearchRepository creates a new SavedSearchRepository
+func NewSavedSearchRepository(db *sql.DB) *SavedSearchRepository {
+	return &SavedSearchRepository{db: db}
+}
+
+var savedSearchColumns = []string{
+	constants.FieldSysSavedSearch_ID,
+	constants.FieldSysSavedSearch_UserID,
+	constants.FieldSysSavedSearch_Name,
+	constants.FieldSysSavedSearch_Term,
+	constants.FieldSysSavedSearch_ObjectScope,
+	constants.FieldSysSavedSearch_LastRunDate,
+	constants.FieldSysSavedSearch_CreatedDate,
+	constants.FieldSysSavedSearch_LastModifiedDate,
+}
+
+// ListByUser returns a user's saved searches ordered by name
+func (r *SavedSearchRepository) ListByUser(ctx context.Context, userID string) ([]*models.SystemSavedSearch, error) {
+	q := query.From(constants.TableSavedSearch).
+		Select(savedSearchColumns).
+		Where(constants.FieldSysSavedSearch_UserID+" = ?", userID).
+		OrderBy(constants.FieldSysSavedSearch_Name, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	searches := make([]*models.SystemSavedSearch, 0)
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, search)
+	}
+	return searches, rows.Err()
+}
+
+// FindByID returns a saved search owned by userID, or nil if not found
+func (r *SavedSearchRepository) FindByID(ctx context.Context, id, userID string) (*models.SystemSavedSearch, error) {
+	q := query.From(constants.TableSavedSearch).
+		Select(savedSearchColumns).
+		Where(constants.FieldSysSavedSearch_ID+" = ?", id).
+		Where(constants.FieldSysSavedSearch_UserID+" = ?", userID).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanSavedSearch(rows)
+}
+
+// Insert stores a new saved search
+func (r *SavedSearchRepository) Insert(ctx context.Context, search *models.SystemSavedSearch) error {
+	now := time.Now()
+	q := query.Insert(constants.TableSavedSearch, map[string]interface{}{
+		constants.FieldSysSavedSearch_ID:               search.ID,
+		constants.FieldSysSavedSearch_UserID:           search.UserID,
+		constants.FieldSysSavedSearch_Name:             search.Name,
+		constants.FieldSysSavedSearch_Term:             search.Term,
+		constants.FieldSysSavedSearch_ObjectScope:      nullableJSON(search.ObjectScope),
+		constants.FieldSysSavedSearch_CreatedDate:      now,
+		constants.FieldSysSavedSearch_LastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert saved search: %w", err)
+	}
+	search.CreatedDate = now
+	search.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites the editable fields of a saved search owned by userID.
+// Returns false if no such search exists.
+func (r *SavedSearchRepository) Update(ctx context.Context, search *models.SystemSavedSearch) (bool, error) {
+	now := time.Now()
+	q := query.Update(constants.TableSavedSearch).
+		Set(map[string]interface{}{
+			constants.FieldSysSavedSearch_Name:             search.Name,
+			constants.FieldSysSavedSearch_Term:             search.Term,
+			constants.FieldSysSavedSearch_ObjectScope:      nullableJSON(search.ObjectScope),
+			constants.FieldSysSavedSearch_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysSavedSearch_ID+" = ?", search.ID).
+		Where(constants.FieldSysSavedSearch_UserID+" = ?", search.UserID).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update saved search: %w", err)
+	}
+	search.LastModifiedDate = now
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Delete removes a saved search owned by userID. Returns false if no such search exists.
+func (r *SavedSearchRepository) Delete(ctx context.Context, id, userID string) (bool, error) {
+	q := query.Delete(constants.TableSavedSearch).
+		Where(constants.FieldSysSavedSearch_ID+" = ?", id).
+		Where(constants.FieldSysSavedSearch_UserID+" = ?", userID).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// MarkRun stamps the last run date of a saved search
+func (r *SavedSearchRepository) MarkRun(ctx context.Context, id string, runAt time.Time) error {
+	q := query.Update(constants.TableSavedSearch).
+		Set(map[string]interface{}{
+			constants.FieldSysSavedSearch_LastRunDate: runAt,
+		}).
+		Where(constants.FieldSysSavedSearch_ID+" = ?", id).
+		Build()
+
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+func scanSavedSearch(rows *sql.Rows) (*models.SystemSavedSearch, error) {
+	var search models.SystemSavedSearch
+	var scope []byte
+	var lastRun sql.NullTime
+
+	if err := rows.Scan(&search.ID, &search.UserID, &search.Name, &search.Term, &scope, &lastRun, &search.CreatedDate, &search.LastModifiedDate); err != nil {
+		return nil, err
+	}
+	if len(scope) > 0 {
+		search.ObjectScope = json.RawMessage(scope)
+	}
+	if lastRun.Valid {
+		search.LastRunDate = &lastRun.Time
+	}
+	return &search, nil
+}
+
+// nullableJSON stores empty JSON payloads as SQL NULL
+func nullableJSON(raw json.RawMessage) interface{} {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return string(raw)
+}
@@ -3,7 +3,6 @@ package persistence
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 
 	"github.com/nexuscrm/backend/internal/domain/schema"
@@ -19,11 +18,8 @@ func (r *SchemaRepository) AddColumn(tableName string, col schema.ColumnDefiniti
 
 	// VALIDATION: Table Name
 	// System tables (starting with _System_) are exempt from strict snake_case but we generally don't add columns to them dynamically
-	if !constants.IsSystemTable(tableName) {
-		validName := regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
-		if !validName.MatchString(tableName) {
-			return fmt.Errorf("invalid table name '%s': must be snake_case", tableName)
-		}
+	if err := validateColumnIdentifiers(tableName); err != nil {
+		return err
 	}
 
 	// VALIDATION: Field Definition
@@ -113,6 +109,27 @@ func (r *SchemaRepository) AddColumn(tableName string, col schema.ColumnDefiniti
 	return nil
 }
 
+// RegisterColumn registers a field without DDL, for objects that have no physical table
+func (r *SchemaRepository) RegisterColumn(tableName string, col schema.ColumnDefinition) error {
+	if err := r.ValidateFieldDefinition(col); err != nil {
+		return err
+	}
+	if err := r.registerField(tableName, col, r.db); err != nil {
+		return fmt.Errorf("failed to register field metadata: %w", err)
+	}
+	log.Printf("   ✅ Field registered: %s.%s", tableName, col.Name)
+	return nil
+}
+
+// UnregisterColumn removes a field's metadata without DDL, for objects that have no physical table
+func (r *SchemaRepository) UnregisterColumn(tableName, columnName string) error {
+	q := fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableField, constants.FieldID)
+	if _, err := r.db.Exec(q, GenerateFieldID(tableName, columnName)); err != nil {
+		return fmt.Errorf("failed to unregister field %s.%s: %w", tableName, columnName, err)
+	}
+	return nil
+}
+
 // EnsureColumn checks if a column exists and adds it if missing
 func (r *SchemaRepository) EnsureColumn(tableName string, col schema.ColumnDefinition) error {
 	exists, err := r.checkColumnExists(tableName, col.Name)
@@ -132,13 +149,13 @@ func (r *SchemaRepository) EnsureColumn(tableName string, col schema.ColumnDefin
 
 // checkColumnExists queries INFORMATION_SCHEMA to see if a column exists
 func (r *SchemaRepository) checkColumnExists(tableName, columnName string) (bool, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT COUNT(*) 
 		FROM INFORMATION_SCHEMA.COLUMNS 
-		WHERE TABLE_SCHEMA = DATABASE() 
+		WHERE TABLE_SCHEMA = %s 
 		  AND TABLE_NAME = ? 
 		  AND COLUMN_NAME = ?
-	`
+	`, r.dialect.CurrentSchema())
 	var count int
 	if err := r.db.QueryRow(query, tableName, columnName).Scan(&count); err != nil {
 		return false, err
@@ -151,14 +168,8 @@ func (r *SchemaRepository) DropColumn(tableName string, columnName string) error
 	log.Printf("➖ Dropping column %s from table %s", columnName, tableName)
 
 	// VALIDATION: Table/Column Name
-	if !constants.IsSystemTable(tableName) {
-		validName := regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
-		if !validName.MatchString(tableName) {
-			return fmt.Errorf("invalid table name '%s': must be snake_case", tableName)
-		}
-		if !validName.MatchString(columnName) {
-			return fmt.Errorf("invalid column name '%s': must be snake_case", columnName)
-		}
+	if err := validateColumnIdentifiers(tableName, columnName); err != nil {
+		return err
 	}
 
 	// 0. IDEMPOTENCY CHECK: Check if column exists
@@ -193,14 +204,8 @@ func (r *SchemaRepository) ModifyColumn(tableName, columnName string, newCol sch
 	log.Printf("🔧 Modifying column %s.%s to type %s", tableName, columnName, newCol.Type)
 
 	// VALIDATION: Table/Column Name
-	if !constants.IsSystemTable(tableName) {
-		validName := regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
-		if !validName.MatchString(tableName) {
-			return fmt.Errorf("invalid table name '%s': must be snake_case", tableName)
-		}
-		if !validName.MatchString(columnName) {
-			return fmt.Errorf("invalid column name '%s': must be snake_case", columnName)
-		}
+	if err := validateColumnIdentifiers(tableName, columnName); err != nil {
+		return err
 	}
 
 	// Check column exists
@@ -213,13 +218,15 @@ func (r *SchemaRepository) ModifyColumn(tableName, columnName string, newCol sch
 	}
 
 	// Execute ALTER TABLE MODIFY COLUMN
-	ddl := fmt.Sprintf("ALTER TABLE `%s` MODIFY COLUMN %s", tableName, r.buildColumnDDL(newCol))
-	log.Printf("   🏁 Executing DDL: %s", ddl)
-	if _, err := r.db.Exec(ddl); err != nil {
-		log.Printf("   ❌ DDL execution failed: %v", err)
-		return fmt.Errorf("failed to modify column %s.%s: %w", tableName, columnName, err)
+	ddl := r.dialect.ModifyColumn(tableName, columnName, r.MapFieldTypeToSQL(newCol.Type), r.buildColumnDDL(newCol))
+	if ddl != "" {
+		log.Printf("   🏁 Executing DDL: %s", ddl)
+		if _, err := r.db.Exec(ddl); err != nil {
+			log.Printf("   ❌ DDL execution failed: %v", err)
+			return fmt.Errorf("failed to modify column %s.%s: %w", tableName, columnName, err)
+		}
+		log.Printf("   ✅ DDL execution complete")
 	}
-	log.Printf("   ✅ DDL execution complete")
 
 	// Update metadata in _System_Field
 	if err := r.registerField(tableName, newCol, r.db); err != nil {
@@ -28,8 +28,8 @@ func (r *SchemaRepository) buildColumnDDL(col schema.ColumnDefinition) string {
 		// Convert formula expression to SQL (inline, no placeholders)
 		formulaSQL := r.convertFormulaToSQL(col.Formula)
 
-		sb.WriteString(fmt.Sprintf("`%s` %s GENERATED ALWAYS AS (%s) VIRTUAL",
-			col.Name, sqlType, formulaSQL))
+		sb.WriteString(fmt.Sprintf("`%s` %s GENERATED ALWAYS AS (%s) %s",
+			col.Name, r.dialect.ColumnType(sqlType), formulaSQL, r.dialect.GeneratedColumn()))
 		return sb.String()
 	}
 
@@ -45,16 +45,18 @@ func (r *SchemaRepository) buildColumnDDL(col schema.ColumnDefinition) string {
 		}
 	}
 
+	if col.AutoIncrement {
+		sqlType = r.dialect.AutoIncrement(sqlType)
+	} else {
+		sqlType = r.dialect.ColumnType(sqlType)
+	}
 	sb.WriteString(fmt.Sprintf("`%s` %s", col.Name, sqlType))
 
 	if !col.Nullable {
 		sb.WriteString(" NOT NULL")
 	}
 	if col.Default != "" {
-		sb.WriteString(fmt.Sprintf(" DEFAULT %s", col.Default))
-	}
-	if col.AutoIncrement {
-		sb.WriteString(" AUTO_INCREMENT")
+		sb.WriteString(fmt.Sprintf(" DEFAULT %s", columnDefault(col.Default)))
 	}
 	if col.PrimaryKey {
 		sb.WriteString(" PRIMARY KEY")
@@ -66,6 +68,18 @@ func (r *SchemaRepository) buildColumnDDL(col schema.ColumnDefinition) string {
 	return sb.String()
 }
 
+// columnDefault writes boolean defaults as 1/0, which every dialect accepts for the
+// integer columns booleans are stored in
+func columnDefault(value string) string {
+	switch strings.ToLower(value) {
+	case "true":
+		return "1"
+	case "false":
+		return "0"
+	}
+	return value
+}
+
 // convertFormulaToSQL converts a formula expression to inline SQL for generated columns
 // This is different from ToSQL which uses placeholders - here we need inline values
 func (r *SchemaRepository) convertFormulaToSQL(formula string) string {
@@ -77,9 +91,9 @@ func (r *SchemaRepository) convertFormulaToSQL(formula string) string {
 	// Replace common functions with SQL equivalents
 	result := formula
 
-	// TODAY() -> CURDATE()
-	result = strings.ReplaceAll(result, "TODAY()", "CURDATE()")
-	result = strings.ReplaceAll(result, "today()", "CURDATE()")
+	// TODAY() -> the dialect's current date, e.g. CURDATE()
+	result = strings.ReplaceAll(result, "TODAY()", r.dialect.CurrentDate())
+	result = strings.ReplaceAll(result, "today()", r.dialect.CurrentDate())
 
 	// NOW() stays as NOW()
 
@@ -109,10 +123,7 @@ func (r *SchemaRepository) buildForeignKeyDDL(fk schema.ForeignKeyDefinition) st
 // buildIndexDDL generates inline index DDL for CREATE TABLE statement
 func (r *SchemaRepository) buildIndexDDL(tableName string, idx schema.IndexDefinition) string {
 	// Generate index name if not provided
-	indexName := idx.Name
-	if indexName == "" {
-		indexName = fmt.Sprintf("idx_%s_%s", tableName, strings.Join(idx.Columns, "_"))
-	}
+	indexName := IndexName(tableName, idx)
 
 	columnList := strings.Join(idx.Columns, "`, `")
 
@@ -2,13 +2,14 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"sync"
 
 	"github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 )
@@ -19,11 +20,12 @@ func (r *SchemaRepository) CreatePhysicalTable(ctx context.Context, def schema.T
 
 	// VALIDATION: Table Name
 	// System tables (starting with _System_) are exempt from strict snake_case
-	if !constants.IsSystemTable(def.TableName) {
-		validName := regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
-		if !validName.MatchString(def.TableName) {
-			return fmt.Errorf("table name '%s' must be snake_case (lowercase, alphanumeric, underscores)", def.TableName)
+	if constants.IsSystemTable(def.TableName) {
+		if err := query.ValidateIdentifier(def.TableName); err != nil {
+			return err
 		}
+	} else if !isSnakeCaseIdentifier(def.TableName) || strings.HasPrefix(def.TableName, "_") {
+		return fmt.Errorf("table name '%s' must be snake_case (lowercase, alphanumeric, underscores)", def.TableName)
 	}
 
 	// Build CREATE TABLE statement with indexes inline
@@ -40,17 +42,22 @@ func (r *SchemaRepository) CreatePhysicalTable(ctx context.Context, def schema.T
 		ddl.WriteString("  ")
 		ddl.WriteString(r.buildColumnDDL(col))
 		// Always add comma if there are more columns, indexes, or foreign keys
-		if i < len(def.Columns)-1 || len(def.Indices) > 0 || len(def.ForeignKeys) > 0 {
+		if i < len(def.Columns)-1 || (len(def.Indices) > 0 && r.dialect.InlineIndexes()) || len(def.ForeignKeys) > 0 {
 			ddl.WriteString(",")
 		}
 		ddl.WriteString("\n")
 	}
 
-	// Add indexes inline (KEY or UNIQUE KEY)
-	for i, idx := range def.Indices {
+	// Add indexes inline (KEY or UNIQUE KEY) where the dialect allows it; otherwise they
+	// are created once the table exists
+	indices := def.Indices
+	if !r.dialect.InlineIndexes() {
+		indices = nil
+	}
+	for i, idx := range indices {
 		ddl.WriteString("  ")
 		ddl.WriteString(r.buildIndexDDL(def.TableName, idx))
-		if i < len(def.Indices)-1 || len(def.ForeignKeys) > 0 {
+		if i < len(indices)-1 || len(def.ForeignKeys) > 0 {
 			ddl.WriteString(",")
 		}
 		ddl.WriteString("\n")
@@ -65,7 +72,7 @@ func (r *SchemaRepository) CreatePhysicalTable(ctx context.Context, def schema.T
 		}
 		ddl.WriteString("\n")
 	}
-	ddl.WriteString(") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci")
+	ddl.WriteString(")" + r.dialect.TableOptions())
 
 	// Execute DDL using a dedicated connection to ensure SET FOREIGN_KEY_CHECKS works
 
@@ -76,8 +83,10 @@ func (r *SchemaRepository) CreatePhysicalTable(ctx context.Context, def schema.T
 	defer func() { _ = conn.Close() }()
 
 	// Disable foreign key checks for this DDL session
-	if _, err := conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS=0"); err != nil {
-		log.Printf("⚠️ Failed to disable FK checks: %v", err)
+	if stmt := r.dialect.DisableForeignKeyChecks(); stmt != "" {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			log.Printf("⚠️ Failed to disable FK checks: %v", err)
+		}
 	}
 
 	log.Printf("📝 Executing DDL for %s: \n%s", def.TableName, ddl.String())
@@ -85,6 +94,14 @@ func (r *SchemaRepository) CreatePhysicalTable(ctx context.Context, def schema.T
 		log.Printf("❌ Failed to create table %s: %v", def.TableName, err)
 		return fmt.Errorf("failed to create table %s: %w", def.TableName, err)
 	}
+	if !r.dialect.InlineIndexes() {
+		for _, idx := range def.Indices {
+			stmt := r.dialect.CreateIndex(def.TableName, IndexName(def.TableName, idx), idx.Columns, idx.Unique)
+			if _, err := conn.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("failed to create index on %s: %w", def.TableName, err)
+			}
+		}
+	}
 	log.Printf("✅ DDL executed successfully for %s", def.TableName)
 
 	return nil
@@ -123,13 +140,50 @@ func (r *SchemaRepository) CreateTableWithStrictMetadata(ctx context.Context, de
 		return fmt.Errorf("failed to register table %s: %w", def.TableName, err)
 	}
 
-	// 2. Register Object Metadata (Strict)
+	// 2-3. Register Object and Field Metadata (Strict)
+	if err = r.registerObjectWithFields(def, objectMeta, tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit metadata transaction: %w", err)
+	}
+
+	log.Printf("   ✅ Table created and registered (strict): %s", def.TableName)
+	return nil
+}
+
+// RegisterExternalObject registers the object and field metadata of an external object
+// (Strict). No physical table is created and nothing is added to _System_Table, since the
+// records live in the external system.
+func (r *SchemaRepository) RegisterExternalObject(ctx context.Context, def schema.TableDefinition, objectMeta *models.ObjectMetadata) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := r.registerObjectWithFields(def, objectMeta, tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit metadata transaction: %w", err)
+	}
+
+	log.Printf("   ✅ External object registered: %s", def.TableName)
+	return nil
+}
+
+// registerObjectWithFields inserts object metadata (failing on uniqueness) and its fields
+func (r *SchemaRepository) registerObjectWithFields(def schema.TableDefinition, objectMeta *models.ObjectMetadata, tx *sql.Tx) error {
 	// We use InsertObjectMetadata instead of BatchSaveObjectMetadata to fail on uniqueness
-	if err = r.InsertObjectMetadata(objectMeta, tx); err != nil {
+	if err := r.InsertObjectMetadata(objectMeta, tx); err != nil {
 		return fmt.Errorf("failed to register object (strict) %s: %w", def.TableName, err)
 	}
 
-	// 3. Register Fields (Batch is OK here as fields belong to new object)
+	// Register Fields (Batch is OK here as fields belong to new object)
 	batchFields := make([]FieldWithContext, 0, len(def.Columns))
 	for _, col := range def.Columns {
 		fc := r.PrepareFieldForBatch(def.TableName, col)
@@ -141,15 +195,9 @@ func (r *SchemaRepository) CreateTableWithStrictMetadata(ctx context.Context, de
 		batchFields = append(batchFields, fc)
 	}
 
-	if err = r.BatchSaveFieldMetadata(batchFields, tx); err != nil {
+	if err := r.BatchSaveFieldMetadata(batchFields, tx); err != nil {
 		return fmt.Errorf("failed to register fields for %s: %w", def.TableName, err)
 	}
-
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit metadata transaction: %w", err)
-	}
-
-	log.Printf("   ✅ Table created and registered (strict): %s", def.TableName)
 	return nil
 }
 
@@ -264,8 +312,9 @@ func (r *SchemaRepository) BatchRegisterTables(defs []schema.TableDefinition, ex
 	query := fmt.Sprintf(`
 		INSERT INTO %s (%s)
 		VALUES %s
-		ON DUPLICATE KEY UPDATE %s = NOW()
-	`, constants.TableTable, cols, strings.Join(valuePlaceholders, ", "), constants.FieldLastModifiedDate)
+		%s
+	`, constants.TableTable, cols, strings.Join(valuePlaceholders, ", "),
+		r.dialect.Upsert([]string{constants.FieldSysTable_TableName}, nil, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
 
 	_, err := exec.Exec(query, args...)
 	return err
@@ -275,6 +324,10 @@ func (r *SchemaRepository) BatchRegisterTables(defs []schema.TableDefinition, ex
 func (r *SchemaRepository) DropTable(tableName string) error {
 	log.Printf("🔥 Dropping table: %s", tableName)
 
+	if err := query.ValidateIdentifier(tableName); err != nil {
+		return err
+	}
+
 	// Drop the table
 	if _, err := r.db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", tableName)); err != nil {
 		return fmt.Errorf("failed to drop table %s: %w", tableName, err)
@@ -301,23 +354,109 @@ func (r *SchemaRepository) DropTable(tableName string) error {
 		log.Printf("⚠️  Warning: Failed to delete object metadata %s: %v", tableName, err)
 	}
 
+	r.DeleteObjectSettings(tableName)
+
+	log.Printf("   ✅ Table dropped and metadata cleaned: %s", tableName)
+	return nil
+}
+
+// DropAllTables drops every table of the connected database, system tables included, and
+// returns their names. It backs the admin CLI's database wipe; the next startup recreates
+// the system tables.
+func (r *SchemaRepository) DropAllTables(ctx context.Context) ([]string, error) {
+	// Foreign key checks are a session setting, so every statement runs on one connection
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = %s", r.dialect.CurrentSchema()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		// SQLite's own bookkeeping tables cannot be dropped
+		if !strings.HasPrefix(table, "sqlite_") {
+			tables = append(tables, table)
+		}
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if stmt := r.dialect.DisableForeignKeyChecks(); stmt != "" {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to disable foreign key checks: %w", err)
+		}
+	}
+	cascade := ""
+	if r.dialect.Name() == query.DialectPostgres {
+		cascade = " CASCADE"
+	}
+	dropped := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s%s", r.dialect.Quote(table), cascade)); err != nil {
+			return dropped, fmt.Errorf("failed to drop table %s: %w", table, err)
+		}
+		dropped = append(dropped, table)
+	}
+	return dropped, nil
+}
+
+// DeleteObjectSettings removes the auto-number sequences, external object settings,
+// permissions, archival policy and archived rows of an object. Failures are logged, as they
+// leave only orphaned rows behind.
+func (r *SchemaRepository) DeleteObjectSettings(apiName string) {
 	// Delete AutoNumber metadata
-	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableAutoNumber, constants.FieldSysAutoNumber_ObjectAPIName), tableName); err != nil {
-		log.Printf("⚠️  Warning: Failed to delete auto-number metadata for %s: %v", tableName, err)
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableAutoNumber, constants.FieldSysAutoNumber_ObjectAPIName), apiName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete auto-number metadata for %s: %v", apiName, err)
+	}
+
+	// Delete external object connection settings
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableExternalObject, constants.FieldSysExternalObject_ObjectAPIName), apiName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete external object settings for %s: %v", apiName, err)
 	}
 
 	// Delete Object Permissions
-	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableObjectPerms, constants.FieldSysObjectPerms_ObjectAPIName), tableName); err != nil {
-		log.Printf("⚠️  Warning: Failed to delete object permissions for %s: %v", tableName, err)
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableObjectPerms, constants.FieldSysObjectPerms_ObjectAPIName), apiName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete object permissions for %s: %v", apiName, err)
 	}
 
 	// Delete Field Permissions
-	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableFieldPerms, constants.FieldSysFieldPerms_ObjectAPIName), tableName); err != nil {
-		log.Printf("⚠️  Warning: Failed to delete field permissions for %s: %v", tableName, err)
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableFieldPerms, constants.FieldSysFieldPerms_ObjectAPIName), apiName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete field permissions for %s: %v", apiName, err)
 	}
 
-	log.Printf("   ✅ Table dropped and metadata cleaned: %s", tableName)
-	return nil
+	// Delete the archival policy and archive table
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableArchivePolicy, constants.FieldSysArchivePolicy_ObjectAPIName), apiName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete archive policy for %s: %v", apiName, err)
+	}
+	if !constants.IsSystemTable(apiName) && len(ArchiveTableName(apiName)) <= 64 {
+		if _, err := r.db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", ArchiveTableName(apiName))); err != nil {
+			log.Printf("⚠️  Warning: Failed to drop archive table for %s: %v", apiName, err)
+		}
+	}
+}
+
+// DeleteFieldSettings removes the auto-number sequence and permissions of a field
+func (r *SchemaRepository) DeleteFieldSettings(objectAPIName, fieldAPIName string) {
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?", constants.TableAutoNumber,
+		constants.FieldSysAutoNumber_ObjectAPIName, constants.FieldSysAutoNumber_FieldAPIName), objectAPIName, fieldAPIName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete auto-number metadata for %s.%s: %v", objectAPIName, fieldAPIName, err)
+	}
+
+	if _, err := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?", constants.TableFieldPerms,
+		constants.FieldSysFieldPerms_ObjectAPIName, constants.FieldSysFieldPerms_FieldAPIName), objectAPIName, fieldAPIName); err != nil {
+		log.Printf("⚠️  Warning: Failed to delete field permissions for %s.%s: %v", objectAPIName, fieldAPIName, err)
+	}
 }
 
 // ValidateSchema checks if a table matches its expected definition by comparing
@@ -356,11 +495,11 @@ func (r *SchemaRepository) ValidateSchema(tableName string) error {
 	}
 
 	// Get actual columns from INFORMATION_SCHEMA
-	actualQuery := `
+	actualQuery := fmt.Sprintf(`
 		SELECT COLUMN_NAME, DATA_TYPE 
 		FROM INFORMATION_SCHEMA.COLUMNS 
-		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?
-	`
+		WHERE TABLE_SCHEMA = %s AND TABLE_NAME = ?
+	`, r.dialect.CurrentSchema())
 	actualRows, err := r.db.Query(actualQuery, tableName)
 	if err != nil {
 		return fmt.Errorf("failed to query actual schema: %w", err)
@@ -394,8 +533,7 @@ func (r *SchemaRepository) ValidateSchema(tableName string) error {
 // ValidateFieldDefinition validates the field schema against core assertions
 func (r *SchemaRepository) ValidateFieldDefinition(field schema.ColumnDefinition) error {
 	// 1. Naming Convention: snake_case (allow leading underscores for system fields)
-	validName := regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
-	if !validName.MatchString(field.Name) {
+	if !isSnakeCaseIdentifier(field.Name) {
 		return fmt.Errorf("field name '%s' must be snake_case (lowercase, alphanumeric, underscores)", field.Name)
 	}
 
@@ -459,11 +597,11 @@ func (r *SchemaRepository) ValidateSchemaRegistry() (*SchemaHealth, error) {
 	}
 
 	// Get actual tables
-	actualRows, err := r.db.Query(`
+	actualRows, err := r.db.Query(fmt.Sprintf(`
 		SELECT TABLE_NAME 
 		FROM INFORMATION_SCHEMA.TABLES 
-		WHERE TABLE_SCHEMA = DATABASE()
-	`)
+		WHERE TABLE_SCHEMA = %s
+	`, r.dialect.CurrentSchema()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,135 @@
+package persistence
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/pkg/query"
+)
+
+// PhysicalSchema is the table, column and index layout of the database as reported by
+// INFORMATION_SCHEMA. All names are lowercased.
+type PhysicalSchema struct {
+	Columns       map[string]map[string]bool // table -> columns
+	Indexes       map[string]map[string]bool // table -> index names
+	UniqueColumns map[string]map[string]bool // table -> columns covered by a unique index
+}
+
+// HasTable reports whether the table exists
+func (p *PhysicalSchema) HasTable(table string) bool {
+	_, ok := p.Columns[strings.ToLower(table)]
+	return ok
+}
+
+// HasColumn reports whether the table has the column
+func (p *PhysicalSchema) HasColumn(table, column string) bool {
+	return p.Columns[strings.ToLower(table)][strings.ToLower(column)]
+}
+
+// HasIndex reports whether the table has an index of that name
+func (p *PhysicalSchema) HasIndex(table, index string) bool {
+	return p.Indexes[strings.ToLower(table)][strings.ToLower(index)]
+}
+
+// IsUnique reports whether a unique index covers the column
+func (p *PhysicalSchema) IsUnique(table, column string) bool {
+	return p.UniqueColumns[strings.ToLower(table)][strings.ToLower(column)]
+}
+
+// GetPhysicalSchema reads the columns and indexes of every table in the current database
+func (r *SchemaRepository) GetPhysicalSchema() (*PhysicalSchema, error) {
+	physical := &PhysicalSchema{
+		Columns:       make(map[string]map[string]bool),
+		Indexes:       make(map[string]map[string]bool),
+		UniqueColumns: make(map[string]map[string]bool),
+	}
+	add := func(m map[string]map[string]bool, table, name string) {
+		table = strings.ToLower(table)
+		if m[table] == nil {
+			m[table] = make(map[string]bool)
+		}
+		m[table][strings.ToLower(name)] = true
+	}
+
+	colRows, err := r.db.Query(fmt.Sprintf(`
+		SELECT TABLE_NAME, COLUMN_NAME
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = %s
+	`, r.dialect.CurrentSchema()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+	defer func() { _ = colRows.Close() }()
+	for colRows.Next() {
+		var table, column string
+		if err := colRows.Scan(&table, &column); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		add(physical.Columns, table, column)
+	}
+	if err := colRows.Err(); err != nil {
+		return nil, err
+	}
+
+	idxRows, err := r.db.Query(fmt.Sprintf(`
+		SELECT TABLE_NAME, INDEX_NAME, COLUMN_NAME, NON_UNIQUE
+		FROM INFORMATION_SCHEMA.STATISTICS
+		WHERE TABLE_SCHEMA = %s
+	`, r.dialect.CurrentSchema()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexes: %w", err)
+	}
+	defer func() { _ = idxRows.Close() }()
+	for idxRows.Next() {
+		var table, index, column string
+		var nonUnique int
+		if err := idxRows.Scan(&table, &index, &column, &nonUnique); err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		add(physical.Indexes, table, index)
+		if nonUnique == 0 {
+			add(physical.UniqueColumns, table, column)
+		}
+	}
+	return physical, idxRows.Err()
+}
+
+// AddMissingColumn adds a column that metadata already describes. Unlike AddColumn nothing
+// is registered, and the column is added as nullable so existing rows are accepted.
+func (r *SchemaRepository) AddMissingColumn(tableName string, col schema.ColumnDefinition) error {
+	col.Nullable = true
+	col.PrimaryKey = false
+	col.AutoIncrement = false
+	if err := validateColumnIdentifiers(tableName, col.Name); err != nil {
+		return err
+	}
+	ddl := fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN %s", tableName, r.buildColumnDDL(col))
+	log.Printf("   🔧 Repairing schema drift: %s", ddl)
+	if _, err := r.db.Exec(ddl); err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", tableName, col.Name, err)
+	}
+	return nil
+}
+
+// AddIndex creates an index on an existing table
+func (r *SchemaRepository) AddIndex(tableName string, idx schema.IndexDefinition) error {
+	if err := query.ValidateIdentifier(tableName); err != nil {
+		return err
+	}
+	ddl := r.dialect.CreateIndex(tableName, IndexName(tableName, idx), idx.Columns, idx.Unique)
+	log.Printf("   🔧 Repairing schema drift: %s", ddl)
+	if _, err := r.db.Exec(ddl); err != nil {
+		return fmt.Errorf("failed to add index to %s: %w", tableName, err)
+	}
+	return nil
+}
+
+// IndexName returns the name of an index, generating the default one when it has none
+func IndexName(tableName string, idx schema.IndexDefinition) string {
+	if idx.Name != "" {
+		return idx.Name
+	}
+	return fmt.Sprintf("idx_%s_%s", tableName, strings.Join(idx.Columns, "_"))
+}
@@ -37,7 +37,9 @@ func (r *SchemaRepository) prepareObjectDBValues(obj *models.ObjectMetadata) ([]
 	themeColor := ToNullString(obj.ThemeColor)
 
 	tableType := constants.TableTypeSystemMetadata
-	if obj.IsCustom {
+	if obj.IsExternal {
+		tableType = constants.TableTypeExternalObject
+	} else if obj.IsCustom {
 		tableType = constants.TableTypeCustomObject
 	}
 	// For core objects bootstrapped via this path, we default to metadata/custom.
@@ -135,23 +137,16 @@ func (r *SchemaRepository) getObjectInsertQuery() string {
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_Label, constants.FieldSysObject_Label),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_PluralLabel, constants.FieldSysObject_PluralLabel),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_Icon, constants.FieldSysObject_Icon),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_Description, constants.FieldSysObject_Description),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_SharingModel, constants.FieldSysObject_SharingModel),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_AppID, constants.FieldSysObject_AppID),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_ListFields, constants.FieldSysObject_ListFields),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_PathField, constants.FieldSysObject_PathField),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_ThemeColor, constants.FieldSysObject_ThemeColor),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysObject_TableType, constants.FieldSysObject_TableType),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
+	updates := []string{
+		constants.FieldSysObject_Label, constants.FieldSysObject_PluralLabel, constants.FieldSysObject_Icon,
+		constants.FieldSysObject_Description, constants.FieldSysObject_SharingModel,
+		constants.FieldSysObject_AppID, constants.FieldSysObject_ListFields, constants.FieldSysObject_PathField,
+		constants.FieldSysObject_ThemeColor, constants.FieldSysObject_TableType,
+	}
 
 	return fmt.Sprintf(`%s %s (%s) %s (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, %s, %s)
-	%s %s`, KeywordInsertInto, constants.TableObject, cols, KeywordValues, FuncNow, FuncNow,
-		KeywordOnDuplicate, updates)
+	%s`, KeywordInsertInto, constants.TableObject, cols, KeywordValues, FuncNow, FuncNow,
+		r.dialect.Upsert([]string{constants.FieldSysObject_APIName}, updates, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
 }
 
 func (r *SchemaRepository) getObjectStrictInsertQuery() string {
@@ -177,30 +172,17 @@ func (r *SchemaRepository) getFieldInsertQuery() string {
 		constants.FieldSysField_RelationshipName, constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 
-	updates := strings.Join([]string{
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_Label, constants.FieldSysField_Label),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldType, constants.FieldType),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_Required, constants.FieldSysField_Required),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_IsUnique, constants.FieldSysField_IsUnique),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_DefaultValue, constants.FieldSysField_DefaultValue),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_HelpText, constants.FieldSysField_HelpText),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_IsSystem, constants.FieldSysField_IsSystem),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_IsNameField, constants.FieldSysField_IsNameField),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_Options, constants.FieldSysField_Options),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_MinLength, constants.FieldSysField_MinLength),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_MaxLength, constants.FieldSysField_MaxLength),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldReferenceTo, constants.FieldReferenceTo),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_Formula, constants.FieldSysField_Formula),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_ReturnType, constants.FieldSysField_ReturnType),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_RollupConfig, constants.FieldSysField_RollupConfig),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_IsMasterDetail, constants.FieldSysField_IsMasterDetail),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_IsPolymorphic, constants.FieldSysField_IsPolymorphic),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_DeleteRule, constants.FieldSysField_DeleteRule),
-		fmt.Sprintf("%s = VALUES(%s)", constants.FieldSysField_RelationshipName, constants.FieldSysField_RelationshipName),
-		fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate),
-	}, ", ")
+	updates := []string{
+		constants.FieldSysField_Label, constants.FieldType, constants.FieldSysField_Required,
+		constants.FieldSysField_IsUnique, constants.FieldSysField_DefaultValue, constants.FieldSysField_HelpText,
+		constants.FieldSysField_IsSystem, constants.FieldSysField_IsNameField, constants.FieldSysField_Options,
+		constants.FieldSysField_MinLength, constants.FieldSysField_MaxLength, constants.FieldReferenceTo,
+		constants.FieldSysField_Formula, constants.FieldSysField_ReturnType, constants.FieldSysField_RollupConfig,
+		constants.FieldSysField_IsMasterDetail, constants.FieldSysField_IsPolymorphic,
+		constants.FieldSysField_DeleteRule, constants.FieldSysField_RelationshipName,
+	}
 
 	return fmt.Sprintf(`%s %s (%s) %s (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, %s, %s)
-	%s %s`, KeywordInsertInto, constants.TableField, cols, KeywordValues, FuncNow, FuncNow,
-		KeywordOnDuplicate, updates)
+	%s`, KeywordInsertInto, constants.TableField, cols, KeywordValues, FuncNow, FuncNow,
+		r.dialect.Upsert([]string{constants.FieldObjectID, constants.FieldAPIName}, updates, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
 }
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// onlineDDL asks for an index build that keeps the table readable and writable.
+// TiDB always builds indexes online; MySQL needs the clause to refuse a locking build.
+const onlineDDL = ", ALGORITHM=INPLACE, LOCK=NONE"
+
+// FieldIndexName returns the name of the secondary index behind an indexed field,
+// shortened with a hash when the default name exceeds the identifier limit
+func FieldIndexName(tableName, fieldAPIName string) string {
+	name := IndexName(tableName, schema.IndexDefinition{Columns: []string{fieldAPIName}})
+	if len(name) <= 64 {
+		return name
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("%s_%08x", name[:55], h.Sum32())
+}
+
+// AddFieldIndex creates the secondary index of an indexed field. The build runs online
+// where the database supports it and falls back to a regular build otherwise.
+func (r *SchemaRepository) AddFieldIndex(tableName, fieldAPIName string) error {
+	for _, name := range []string{tableName, fieldAPIName} {
+		if !isSnakeCaseIdentifier(name) {
+			return fmt.Errorf("invalid identifier '%s': must be snake_case", name)
+		}
+	}
+
+	name := FieldIndexName(tableName, fieldAPIName)
+	ddl := r.dialect.CreateIndex(tableName, name, []string{fieldAPIName}, false)
+	log.Printf("🗂️  Adding index %s on %s.%s", name, tableName, fieldAPIName)
+	err := r.execOnline(ddl)
+	if err != nil && strings.Contains(err.Error(), "Duplicate key name") {
+		log.Printf("⚠️  Index %s already exists, skipping...", name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add index to %s.%s: %w", tableName, fieldAPIName, err)
+	}
+	return nil
+}
+
+// DropFieldIndex removes the secondary index of a field that is no longer indexed
+func (r *SchemaRepository) DropFieldIndex(tableName, fieldAPIName string) error {
+	for _, name := range []string{tableName, fieldAPIName} {
+		if !isSnakeCaseIdentifier(name) {
+			return fmt.Errorf("invalid identifier '%s': must be snake_case", name)
+		}
+	}
+
+	name := FieldIndexName(tableName, fieldAPIName)
+	ddl := r.dialect.DropIndex(tableName, name)
+	log.Printf("🗂️  Dropping index %s from %s", name, tableName)
+	err := r.execOnline(ddl)
+	if err != nil && (strings.Contains(err.Error(), "1091") || strings.Contains(err.Error(), "doesn't exist")) {
+		log.Printf("⚠️  Index %s does not exist, skipping...", name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to drop index from %s.%s: %w", tableName, fieldAPIName, err)
+	}
+	return nil
+}
+
+// execOnline runs an ALTER TABLE without blocking writes, retrying as a regular ALTER
+// when the database cannot honour the online clause
+func (r *SchemaRepository) execOnline(ddl string) error {
+	_, err := r.db.Exec(ddl + onlineDDL)
+	if err == nil || strings.Contains(err.Error(), "Duplicate key name") || strings.Contains(err.Error(), "1091") {
+		return err
+	}
+	log.Printf("   ⚠️  Online DDL not available (%v), retrying without it", err)
+	_, err = r.db.Exec(ddl)
+	return err
+}
+
+// SetFieldIndexed records whether a field is backed by a secondary index
+func (r *SchemaRepository) SetFieldIndexed(objectID, fieldAPIName string, indexed bool) error {
+	_, err := r.db.Exec(fmt.Sprintf("UPDATE %s SET %s = ?, %s = NOW() WHERE %s = ? AND %s = ?",
+		constants.TableField, constants.FieldSysField_Indexed, constants.FieldLastModifiedDate,
+		constants.FieldObjectID, constants.FieldAPIName),
+		indexed, objectID, fieldAPIName)
+	if err != nil {
+		return fmt.Errorf("failed to update index flag of field %s: %w", fieldAPIName, err)
+	}
+	return nil
+}
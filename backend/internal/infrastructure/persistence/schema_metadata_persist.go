@@ -118,31 +118,14 @@ func (r *SchemaRepository) BatchSaveObjectMetadata(objs []*models.ObjectMetadata
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 	}, ", ")
 
-	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s
-	ON DUPLICATE KEY UPDATE
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = VALUES(%s),
-        %s = VALUES(%s),
-		%s = VALUES(%s),
-		%s = NOW()
-	`, constants.TableObject, cols, strings.Join(valuePlaceholders, ", "),
-		constants.FieldSysObject_Label, constants.FieldSysObject_Label,
-		constants.FieldSysObject_PluralLabel, constants.FieldSysObject_PluralLabel,
-		constants.FieldSysObject_Icon, constants.FieldSysObject_Icon,
-		constants.FieldSysObject_Description, constants.FieldSysObject_Description,
-		constants.FieldSysObject_SharingModel, constants.FieldSysObject_SharingModel,
-		constants.FieldSysObject_AppID, constants.FieldSysObject_AppID,
-		constants.FieldSysObject_ListFields, constants.FieldSysObject_ListFields,
-		constants.FieldSysObject_PathField, constants.FieldSysObject_PathField,
-		constants.FieldSysObject_ThemeColor, constants.FieldSysObject_ThemeColor,
-		constants.FieldSysObject_TableType, constants.FieldSysObject_TableType,
-		constants.FieldLastModifiedDate)
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s %s`,
+		constants.TableObject, cols, strings.Join(valuePlaceholders, ", "),
+		r.dialect.Upsert([]string{constants.FieldSysObject_APIName}, []string{
+			constants.FieldSysObject_Label, constants.FieldSysObject_PluralLabel, constants.FieldSysObject_Icon,
+			constants.FieldSysObject_Description, constants.FieldSysObject_SharingModel, constants.FieldSysObject_AppID,
+			constants.FieldSysObject_ListFields, constants.FieldSysObject_PathField, constants.FieldSysObject_ThemeColor,
+			constants.FieldSysObject_TableType,
+		}, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
 
 	_, err := exec.Exec(query, args...)
 	return err
@@ -191,49 +174,17 @@ func (r *SchemaRepository) BatchSaveFieldMetadata(fields []FieldWithContext, exe
 			constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 		}, ", ")
 
-		query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s
-		ON DUPLICATE KEY UPDATE
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = VALUES(%s),
-			%s = NOW()
-		`, constants.TableField, cols, strings.Join(valuePlaceholders, ", "),
-			constants.FieldSysField_Label, constants.FieldSysField_Label,
-			constants.FieldSysField_Type, constants.FieldSysField_Type,
-			constants.FieldSysField_Required, constants.FieldSysField_Required,
-			constants.FieldSysField_IsUnique, constants.FieldSysField_IsUnique,
-			constants.FieldSysField_DefaultValue, constants.FieldSysField_DefaultValue,
-			constants.FieldSysField_HelpText, constants.FieldSysField_HelpText,
-			constants.FieldSysField_IsSystem, constants.FieldSysField_IsSystem,
-			constants.FieldSysField_IsNameField, constants.FieldSysField_IsNameField,
-			constants.FieldSysField_Options, constants.FieldSysField_Options,
-			constants.FieldSysField_MinLength, constants.FieldSysField_MinLength,
-			constants.FieldSysField_MaxLength, constants.FieldSysField_MaxLength,
-			constants.FieldReferenceTo, constants.FieldReferenceTo,
-			constants.FieldSysField_Formula, constants.FieldSysField_Formula,
-			constants.FieldSysField_ReturnType, constants.FieldSysField_ReturnType,
-			constants.FieldSysField_RollupConfig, constants.FieldSysField_RollupConfig,
-			constants.FieldSysField_IsMasterDetail, constants.FieldSysField_IsMasterDetail,
-			constants.FieldSysField_IsPolymorphic, constants.FieldSysField_IsPolymorphic,
-			constants.FieldSysField_DeleteRule, constants.FieldSysField_DeleteRule,
-			constants.FieldSysField_RelationshipName, constants.FieldSysField_RelationshipName,
-			constants.FieldLastModifiedDate)
+		query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s %s`,
+			constants.TableField, cols, strings.Join(valuePlaceholders, ", "),
+			r.dialect.Upsert([]string{constants.FieldObjectID, constants.FieldSysField_APIName}, []string{
+				constants.FieldSysField_Label, constants.FieldSysField_Type, constants.FieldSysField_Required,
+				constants.FieldSysField_IsUnique, constants.FieldSysField_DefaultValue, constants.FieldSysField_HelpText,
+				constants.FieldSysField_IsSystem, constants.FieldSysField_IsNameField, constants.FieldSysField_Options,
+				constants.FieldSysField_MinLength, constants.FieldSysField_MaxLength, constants.FieldReferenceTo,
+				constants.FieldSysField_Formula, constants.FieldSysField_ReturnType, constants.FieldSysField_RollupConfig,
+				constants.FieldSysField_IsMasterDetail, constants.FieldSysField_IsPolymorphic, constants.FieldSysField_DeleteRule,
+				constants.FieldSysField_RelationshipName,
+			}, fmt.Sprintf("%s = NOW()", constants.FieldLastModifiedDate)))
 
 		if _, err := exec.Exec(query, args...); err != nil {
 			return fmt.Errorf("batch field insert failed: %w", err)
@@ -285,6 +236,9 @@ func (r *SchemaRepository) PrepareFieldForBatch(tableName string, col schema.Col
 	if col.Default != "" {
 		f.Field.DefaultValue = &col.Default
 	}
+	if len(col.Options) > 0 {
+		f.Field.Options = col.Options
+	}
 
 	return f
 }
@@ -0,0 +1,154 @@
+package persistence
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/schema"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+var snakeCaseIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// isSnakeCaseIdentifier reports whether name is a lowercase identifier DDL can interpolate
+func isSnakeCaseIdentifier(name string) bool {
+	return snakeCaseIdentifier.MatchString(name) && query.IsValidIdentifier(name)
+}
+
+// validateColumnIdentifiers rejects a table or column name that DDL cannot interpolate.
+// Custom tables and their columns must be snake_case; system tables keep their _System_
+// casing but must still be plain identifiers.
+func validateColumnIdentifiers(tableName string, columnNames ...string) error {
+	if constants.IsSystemTable(tableName) {
+		for _, name := range append([]string{tableName}, columnNames...) {
+			if err := query.ValidateIdentifier(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if !isSnakeCaseIdentifier(tableName) {
+		return fmt.Errorf("invalid table name '%s': must be snake_case", tableName)
+	}
+	for _, name := range columnNames {
+		if !isSnakeCaseIdentifier(name) {
+			return fmt.Errorf("invalid column name '%s': must be snake_case", name)
+		}
+	}
+	return nil
+}
+
+// RenameColumn renames a column, keeping its data. The column is left nullable, so a column
+// hidden by a soft delete does not reject inserts that no longer set it; a restored
+// required field is enforced by record validation instead.
+func (r *SchemaRepository) RenameColumn(tableName, from, to string) error {
+	log.Printf("✏️  Renaming column %s.%s to %s", tableName, from, to)
+
+	for _, name := range []string{tableName, from, to} {
+		if !isSnakeCaseIdentifier(name) {
+			return fmt.Errorf("invalid identifier '%s': must be snake_case", name)
+		}
+	}
+
+	var columnType, isNullable, extra string
+	err := r.db.QueryRow(fmt.Sprintf(`
+		SELECT COLUMN_TYPE, IS_NULLABLE, EXTRA
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = %s
+		  AND TABLE_NAME = ?
+		  AND COLUMN_NAME = ?
+	`, r.dialect.CurrentSchema()), tableName, from).Scan(&columnType, &isNullable, &extra)
+	if err != nil {
+		return fmt.Errorf("failed to read column %s.%s: %w", tableName, from, err)
+	}
+
+	ddl := fmt.Sprintf("ALTER TABLE `%s` RENAME COLUMN `%s` TO `%s`", tableName, from, to)
+	if _, err := r.db.Exec(ddl); err != nil {
+		return fmt.Errorf("failed to rename column %s.%s: %w", tableName, from, err)
+	}
+
+	// Generated (formula) columns compute their value and never block inserts
+	if isNullable == "NO" && !strings.Contains(strings.ToUpper(extra), "GENERATED") {
+		ddl = r.dialect.ModifyColumn(tableName, to, columnType, fmt.Sprintf("`%s` %s NULL", to, columnType))
+		if ddl == "" {
+			return nil
+		}
+		if _, err := r.db.Exec(ddl); err != nil {
+			return fmt.Errorf("failed to make column %s.%s nullable: %w", tableName, to, err)
+		}
+	}
+	return nil
+}
+
+// RenameTable renames a physical table, keeping its data. The registry is not touched.
+func (r *SchemaRepository) RenameTable(from, to string) error {
+	log.Printf("✏️  Renaming table %s to %s", from, to)
+
+	for _, name := range []string{from, to} {
+		if !isSnakeCaseIdentifier(name) {
+			return fmt.Errorf("invalid table name '%s': must be snake_case", name)
+		}
+	}
+	if _, err := r.db.Exec(r.dialect.RenameTable(from, to)); err != nil {
+		return fmt.Errorf("failed to rename table %s: %w", from, err)
+	}
+	return nil
+}
+
+// TableExists reports whether a physical table exists in the current database
+func (r *SchemaRepository) TableExists(tableName string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE TABLE_SCHEMA = %s
+		  AND TABLE_NAME = ?
+	`, r.dialect.CurrentSchema()), tableName).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check table %s: %w", tableName, err)
+	}
+	return count > 0, nil
+}
+
+// UnregisterField removes a field's metadata by ID without DDL
+func (r *SchemaRepository) UnregisterField(fieldID string) error {
+	q := fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableField, constants.FieldID)
+	if _, err := r.db.Exec(q, fieldID); err != nil {
+		return fmt.Errorf("failed to unregister field %s: %w", fieldID, err)
+	}
+	return nil
+}
+
+// UnregisterObject removes an object from _System_Table, _System_Object and _System_Field
+// without DDL. Permissions, auto-number sequences and external object settings are kept so
+// the object can be registered again as it was.
+func (r *SchemaRepository) UnregisterObject(apiName string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	statements := []string{
+		fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableTable, constants.FieldSysTable_TableName),
+		fmt.Sprintf("DELETE FROM %s WHERE %s IN (SELECT %s FROM %s WHERE %s = ?)",
+			constants.TableField, constants.FieldObjectID, constants.FieldID, constants.TableObject, constants.FieldSysObject_APIName),
+		fmt.Sprintf("DELETE FROM %s WHERE %s = ?", constants.TableObject, constants.FieldSysObject_APIName),
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt, apiName); err != nil {
+			return fmt.Errorf("failed to unregister object %s: %w", apiName, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// RegisterTable adds a table to the _System_Table registry
+func (r *SchemaRepository) RegisterTable(def schema.TableDefinition) error {
+	return r.registerTable(def, nil)
+}
@@ -5,18 +5,21 @@ import (
 	"database/sql"
 	"strings"
 
+	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
 )
 
 // SchemaRepository handles direct database schema operations (DDL) and system registry updates
 type SchemaRepository struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect query.Dialect // Generates the DDL the connected database understands
 }
 
-// NewSchemaRepository creates a new SchemaRepository
+// NewSchemaRepository creates a new SchemaRepository for the active SQL dialect
 func NewSchemaRepository(db *sql.DB) *SchemaRepository {
 	return &SchemaRepository{
-		db: db,
+		db:      db,
+		dialect: query.ActiveDialect(),
 	}
 }
 
@@ -114,6 +117,8 @@ func (r *SchemaRepository) MapFieldTypeToSQL(fieldType string) string {
 		return SQLTypeText
 	case constants.FieldTypeJSON:
 		return SQLTypeJSON
+	case constants.FieldTypeGeolocation:
+		return SQLTypeJSON // Coordinates are also kept in numeric companion columns
 	}
 
 	// 2. Check Raw SQL Types (Passthrough for System Tables)
@@ -131,6 +136,9 @@ func (r *SchemaRepository) MapFieldTypeToSQL(fieldType string) string {
 	case "VARCHAR(255)", "VARCHAR(36)", "VARCHAR(50)", "CHAR(36)":
 		return fieldType
 	}
+	if strings.HasPrefix(upper, SQLTypeVector) { // TiDB vector column, optionally with a dimension
+		return upper
+	}
 
 	// Default fallback
 	return SQLTypeVarchar255
@@ -125,7 +125,7 @@ func ToStorageRecord(schema *models.ObjectMetadata, data models.SObject) models.
 		}
 
 		// Convert JSON to string (for database driver support)
-		if fieldMeta.Type == constants.FieldTypeJSON {
+		if fieldMeta.Type == constants.FieldTypeJSON || fieldMeta.Type == constants.FieldTypeGeolocation {
 			if val == nil {
 				result[columnName] = nil
 				continue
@@ -224,7 +224,7 @@ func FromStorageRecord(schema *models.ObjectMetadata, record models.SObject, vis
 		}
 
 		// Handle JSON types: Unmarshal string/bytes back to interface{}
-		if field.Type == constants.FieldTypeJSON {
+		if field.Type == constants.FieldTypeJSON || field.Type == constants.FieldTypeGeolocation {
 			var jsonVal interface{}
 			var err error
 
@@ -261,6 +261,81 @@ func GetPolymorphicTypeColumnName(fieldAPIName string) string {
 	return fieldAPIName + constants.PolymorphicTypeSuffix
 }
 
+// GetGeolocationColumnNames returns the latitude and longitude companion columns of a Geolocation field
+func GetGeolocationColumnNames(fieldAPIName string) (string, string) {
+	return fieldAPIName + constants.GeolocationLatitudeSuffix, fieldAPIName + constants.GeolocationLongitudeSuffix
+}
+
+// ParseGeolocation reads a Geolocation value: a GeoPoint, or an object (or its JSON text) with
+// "latitude" and "longitude" in decimal degrees. Returns nil for an empty value.
+func ParseGeolocation(val interface{}) (*models.GeoPoint, error) {
+	var point models.GeoPoint
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case models.GeoPoint:
+		point = v
+	case *models.GeoPoint:
+		if v == nil {
+			return nil, nil
+		}
+		point = *v
+	case []byte:
+		return ParseGeolocation(string(v))
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		var raw map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &raw); err != nil {
+			return nil, fmt.Errorf("expected an object with latitude and longitude")
+		}
+		return ParseGeolocation(raw)
+	case map[string]interface{}:
+		lat, latOK := geoCoordinate(v["latitude"])
+		lng, lngOK := geoCoordinate(v["longitude"])
+		if v["latitude"] == nil && v["longitude"] == nil {
+			return nil, nil
+		}
+		if !latOK || !lngOK {
+			return nil, fmt.Errorf("latitude and longitude must both be numbers")
+		}
+		point = models.GeoPoint{Latitude: lat, Longitude: lng}
+	case models.SObject:
+		return ParseGeolocation(map[string]interface{}(v))
+	default:
+		return nil, fmt.Errorf("expected an object with latitude and longitude")
+	}
+
+	if point.Latitude < -90 || point.Latitude > 90 {
+		return nil, fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if point.Longitude < -180 || point.Longitude > 180 {
+		return nil, fmt.Errorf("longitude must be between -180 and 180")
+	}
+	return &point, nil
+}
+
+func geoCoordinate(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
 // GenerateObjectID generates a standardized ID for an object based on its API Name
 func GenerateObjectID(apiName string) string {
 	// Hybrid ID: Readable prefix + Name + Random UUID
@@ -298,6 +373,11 @@ func ToNullString(s *string) sql.NullString {
 	return sql.NullString{Valid: false}
 }
 
+// EmptyToNullString converts a string to sql.NullString, treating "" as NULL
+func EmptyToNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
 // ToNullInt64 converts a *int or *int64 to sql.NullInt64
 func ToNullInt64(i interface{}) sql.NullInt64 {
 	if i == nil {
@@ -0,0 +1,277 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// ScriptRepository handles database operations for scripts and their run log
+type ScriptRepository struct {
+	db *sql.DB
+}
+
+// NewScriptRepository creates a new ScriptRepository
+func NewScriptRepository(db *sql.DB) *ScriptRepository {
+	return &ScriptRepository{db: db}
+}
+
+var scriptColumns = []string{
+	constants.FieldSysScript_ID,
+	constants.FieldSysScript_Name,
+	constants.FieldSysScript_Description,
+	constants.FieldSysScript_Source,
+	constants.FieldSysScript_TriggerObject,
+	constants.FieldSysScript_TriggerEvents,
+	constants.FieldSysScript_IsActive,
+	constants.FieldSysScript_MaxSteps,
+	constants.FieldSysScript_TimeoutMs,
+	constants.FieldSysScript_MaxMemoryMb,
+	constants.FieldSysScript_MaxDmlRows,
+	constants.FieldSysScript_MaxQueryRows,
+	constants.FieldSysScript_MaxCallouts,
+	constants.FieldSysScript_OwnerID,
+	constants.FieldSysScript_CreatedDate,
+	constants.FieldSysScript_LastModifiedDate,
+}
+
+var scriptLogColumns = []string{
+	constants.FieldSysScriptLog_ID,
+	constants.FieldSysScriptLog_ScriptID,
+	constants.FieldSysScriptLog_ScriptName,
+	constants.FieldSysScriptLog_Invocation,
+	constants.FieldSysScriptLog_ObjectAPIName,
+	constants.FieldSysScriptLog_RecordID,
+	constants.FieldSysScriptLog_Status,
+	constants.FieldSysScriptLog_DurationMs,
+	constants.FieldSysScriptLog_Steps,
+	constants.FieldSysScriptLog_DmlRows,
+	constants.FieldSysScriptLog_QueryRows,
+	constants.FieldSysScriptLog_Callouts,
+	constants.FieldSysScriptLog_Output,
+	constants.FieldSysScriptLog_ErrorMessage,
+	constants.FieldSysScriptLog_UserID,
+	constants.FieldSysScriptLog_CreatedDate,
+}
+
+// GetScripts queries all scripts ordered by name
+func (r *ScriptRepository) GetScripts(ctx context.Context) ([]*models.Script, error) {
+	q := query.From(constants.TableScript).
+		Select(scriptColumns).
+		OrderBy(constants.FieldSysScript_Name, constants.SortASC).
+		Build()
+	return r.queryScripts(ctx, q)
+}
+
+// FindScript queries a script by name, or nil if not found
+func (r *ScriptRepository) FindScript(ctx context.Context, name string) (*models.Script, error) {
+	q := query.From(constants.TableScript).
+		Select(scriptColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableScript, constants.FieldSysScript_Name), name).
+		Limit(1).
+		Build()
+	scripts, err := r.queryScripts(ctx, q)
+	if err != nil || len(scripts) == 0 {
+		return nil, err
+	}
+	return scripts[0], nil
+}
+
+// FindTriggerScripts queries the active scripts that run on record saves
+func (r *ScriptRepository) FindTriggerScripts(ctx context.Context) ([]*models.Script, error) {
+	q := query.From(constants.TableScript).
+		Select(scriptColumns).
+		Where(constants.FieldSysScript_IsActive+" = ?", true).
+		Where(constants.FieldSysScript_TriggerObject+" IS NOT NULL").
+		OrderBy(constants.FieldSysScript_Name, constants.SortASC).
+		Build()
+	return r.queryScripts(ctx, q)
+}
+
+func (r *ScriptRepository) queryScripts(ctx context.Context, q query.QueryResult) ([]*models.Script, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scripts: %w", err)
+	}
+	defer rows.Close()
+
+	scripts := make([]*models.Script, 0)
+	for rows.Next() {
+		var s models.Script
+		var description, triggerObject, triggerEvents, ownerID sql.NullString
+		var maxSteps, timeoutMs, maxMemory, maxDML, maxQueryRows, maxCallouts sql.NullInt64
+		if err := rows.Scan(&s.ID, &s.Name, &description, &s.Source, &triggerObject, &triggerEvents, &s.IsActive,
+			&maxSteps, &timeoutMs, &maxMemory, &maxDML, &maxQueryRows, &maxCallouts, &ownerID,
+			&s.CreatedDate, &s.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan script: %w", err)
+		}
+		s.Description = description.String
+		s.TriggerObject = triggerObject.String
+		if triggerEvents.String != "" {
+			s.TriggerEvents = strings.Split(triggerEvents.String, ",")
+		}
+		s.MaxSteps = maxSteps.Int64
+		s.TimeoutMs = int(timeoutMs.Int64)
+		s.MaxMemoryMB = int(maxMemory.Int64)
+		s.MaxDMLRows = int(maxDML.Int64)
+		s.MaxQueryRows = int(maxQueryRows.Int64)
+		s.MaxCallouts = int(maxCallouts.Int64)
+		s.OwnerID = ownerID.String
+		scripts = append(scripts, &s)
+	}
+	return scripts, rows.Err()
+}
+
+// nullableQuota stores an unset (zero) quota as NULL
+func nullableQuota(n int64) interface{} {
+	if n <= 0 {
+		return nil
+	}
+	return n
+}
+
+func scriptValues(s *models.Script) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysScript_Description:   nullableString(s.Description),
+		constants.FieldSysScript_Source:        s.Source,
+		constants.FieldSysScript_TriggerObject: nullableString(s.TriggerObject),
+		constants.FieldSysScript_TriggerEvents: nullableString(strings.Join(s.TriggerEvents, ",")),
+		constants.FieldSysScript_IsActive:      s.IsActive,
+		constants.FieldSysScript_MaxSteps:      nullableQuota(s.MaxSteps),
+		constants.FieldSysScript_TimeoutMs:     nullableQuota(int64(s.TimeoutMs)),
+		constants.FieldSysScript_MaxMemoryMb:   nullableQuota(int64(s.MaxMemoryMB)),
+		constants.FieldSysScript_MaxDmlRows:    nullableQuota(int64(s.MaxDMLRows)),
+		constants.FieldSysScript_MaxQueryRows:  nullableQuota(int64(s.MaxQueryRows)),
+		constants.FieldSysScript_MaxCallouts:   nullableQuota(int64(s.MaxCallouts)),
+	}
+}
+
+// InsertScript inserts a script
+func (r *ScriptRepository) InsertScript(ctx context.Context, s *models.Script) error {
+	values := scriptValues(s)
+	now := time.Now().UTC()
+	values[constants.FieldSysScript_ID] = s.ID
+	values[constants.FieldSysScript_Name] = s.Name
+	values[constants.FieldSysScript_OwnerID] = nullableString(s.OwnerID)
+	values[constants.FieldSysScript_CreatedDate] = now
+	values[constants.FieldSysScript_LastModifiedDate] = now
+	q := query.Insert(constants.TableScript, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert script: %w", err)
+	}
+	s.CreatedDate = now
+	s.LastModifiedDate = now
+	return nil
+}
+
+// UpdateScript overwrites a script
+func (r *ScriptRepository) UpdateScript(ctx context.Context, s *models.Script) error {
+	values := scriptValues(s)
+	now := time.Now().UTC()
+	values[constants.FieldSysScript_LastModifiedDate] = now
+	q := query.Update(constants.TableScript).
+		Set(values).
+		Where(constants.FieldSysScript_ID+" = ?", s.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update script: %w", err)
+	}
+	s.LastModifiedDate = now
+	return nil
+}
+
+// DeleteScript deletes a script and its log
+func (r *ScriptRepository) DeleteScript(ctx context.Context, s *models.Script) error {
+	lq := query.Delete(constants.TableScriptLog).
+		Where(constants.FieldSysScriptLog_ScriptID+" = ?", s.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, lq.SQL, lq.Params...); err != nil {
+		return fmt.Errorf("failed to delete script log: %w", err)
+	}
+	q := query.Delete(constants.TableScript).
+		Where(constants.FieldSysScript_ID+" = ?", s.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete script: %w", err)
+	}
+	return nil
+}
+
+// InsertLog records a run of a script. It does not join the transaction of the save that
+// ran the script, so runs that fail the save are logged as well.
+func (r *ScriptRepository) InsertLog(ctx context.Context, l *models.ScriptLog) error {
+	q := query.Insert(constants.TableScriptLog, map[string]interface{}{
+		constants.FieldSysScriptLog_ID:               l.ID,
+		constants.FieldSysScriptLog_ScriptID:         l.ScriptID,
+		constants.FieldSysScriptLog_ScriptName:       l.ScriptName,
+		constants.FieldSysScriptLog_Invocation:       l.Invocation,
+		constants.FieldSysScriptLog_ObjectAPIName:    nullableString(l.ObjectAPIName),
+		constants.FieldSysScriptLog_RecordID:         nullableString(l.RecordID),
+		constants.FieldSysScriptLog_Status:           l.Status,
+		constants.FieldSysScriptLog_DurationMs:       l.DurationMs,
+		constants.FieldSysScriptLog_Steps:            l.Steps,
+		constants.FieldSysScriptLog_DmlRows:          l.DMLRows,
+		constants.FieldSysScriptLog_QueryRows:        l.QueryRows,
+		constants.FieldSysScriptLog_Callouts:         l.Callouts,
+		constants.FieldSysScriptLog_Output:           nullableString(l.Output),
+		constants.FieldSysScriptLog_ErrorMessage:     nullableString(l.ErrorMessage),
+		constants.FieldSysScriptLog_UserID:           nullableString(l.UserID),
+		constants.FieldSysScriptLog_CreatedDate:      l.CreatedDate,
+		constants.FieldSysScriptLog_LastModifiedDate: l.CreatedDate,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to log script run: %w", err)
+	}
+	return nil
+}
+
+// GetLogs queries the latest runs of a script, newest first
+func (r *ScriptRepository) GetLogs(ctx context.Context, scriptID string, limit int) ([]*models.ScriptLog, error) {
+	q := query.From(constants.TableScriptLog).
+		Select(scriptLogColumns).
+		Where(constants.FieldSysScriptLog_ScriptID+" = ?", scriptID).
+		OrderBy(constants.FieldSysScriptLog_CreatedDate, constants.SortDESC).
+		Limit(limit).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query script log: %w", err)
+	}
+	defer rows.Close()
+
+	logs := make([]*models.ScriptLog, 0)
+	for rows.Next() {
+		var l models.ScriptLog
+		var objectName, recordID, output, errorMessage, userID sql.NullString
+		if err := rows.Scan(&l.ID, &l.ScriptID, &l.ScriptName, &l.Invocation, &objectName, &recordID, &l.Status,
+			&l.DurationMs, &l.Steps, &l.DMLRows, &l.QueryRows, &l.Callouts, &output, &errorMessage, &userID,
+			&l.CreatedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan script log: %w", err)
+		}
+		l.ObjectAPIName = objectName.String
+		l.RecordID = recordID.String
+		l.Output = output.String
+		l.ErrorMessage = errorMessage.String
+		l.UserID = userID.String
+		logs = append(logs, &l)
+	}
+	return logs, rows.Err()
+}
+
+// PurgeLogs deletes script runs logged before cutoff
+func (r *ScriptRepository) PurgeLogs(ctx context.Context, cutoff time.Time) (int64, error) {
+	q := query.Delete(constants.TableScriptLog).
+		Where(constants.FieldSysScriptLog_CreatedDate+" < ?", cutoff).
+		Build()
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge script log: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -96,6 +96,14 @@ func (r *SessionRepository) RevokeSession(ctx context.Context, sessionID string)
 	return err
 }
 
+// RevokeUserSessions marks all active sessions of a user as revoked
+func (r *SessionRepository) RevokeUserSessions(ctx context.Context, userID string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = 1, %s = NOW() WHERE %s = ? AND %s = 0",
+		constants.TableSession, constants.FieldSysSession_IsRevoked, constants.FieldLastModifiedDate, constants.FieldSysSession_UserID, constants.FieldSysSession_IsRevoked)
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
+
 // UpdateLastActivity updates the last activity timestamp
 func (r *SessionRepository) UpdateLastActivity(ctx context.Context, sessionID string) error {
 	query := fmt.Sprintf("UPDATE %s SET %s = NOW() WHERE %s = ?",
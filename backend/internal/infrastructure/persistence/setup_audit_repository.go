@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SetupAuditRepository handles database operations for the setup audit trail
+type SetupAuditRepository struct {
+	db *sql.DB
+}
+
+// NewSetupAuditRepository creates a new SetupAuditRepository
+func NewSetupAuditRepository(db *sql.DB) *SetupAuditRepository {
+	return &SetupAuditRepository{db: db}
+}
+
+// SetupAuditFilter narrows a setup audit query; zero values match everything
+type SetupAuditFilter struct {
+	ComponentType string
+	ComponentName string
+	ObjectAPIName string
+	ActorID       string
+	Action        string
+	Since         *time.Time
+	Until         *time.Time
+	Limit         int
+	Offset        int
+}
+
+var setupAuditColumns = []string{
+	constants.FieldSysSetupAudit_ID,
+	constants.FieldSysSetupAudit_Action,
+	constants.FieldSysSetupAudit_ComponentType,
+	constants.FieldSysSetupAudit_ComponentName,
+	constants.FieldSysSetupAudit_ObjectAPIName,
+	constants.FieldSysSetupAudit_BeforeData,
+	constants.FieldSysSetupAudit_AfterData,
+	constants.FieldSysSetupAudit_ActorID,
+	constants.FieldSysSetupAudit_ActorName,
+	constants.FieldSysSetupAudit_ChangedAt,
+}
+
+// Insert writes a setup audit entry
+func (r *SetupAuditRepository) Insert(ctx context.Context, e *models.SystemSetupAudit) error {
+	q := query.Insert(constants.TableSetupAudit, map[string]interface{}{
+		constants.FieldSysSetupAudit_ID:               e.ID,
+		constants.FieldSysSetupAudit_Action:           e.Action,
+		constants.FieldSysSetupAudit_ComponentType:    e.ComponentType,
+		constants.FieldSysSetupAudit_ComponentName:    e.ComponentName,
+		constants.FieldSysSetupAudit_ObjectAPIName:    e.ObjectAPIName,
+		constants.FieldSysSetupAudit_BeforeData:       nullableJSON(e.BeforeData),
+		constants.FieldSysSetupAudit_AfterData:        nullableJSON(e.AfterData),
+		constants.FieldSysSetupAudit_ActorID:          e.ActorID,
+		constants.FieldSysSetupAudit_ActorName:        e.ActorName,
+		constants.FieldSysSetupAudit_ChangedAt:        e.ChangedAt,
+		constants.FieldSysSetupAudit_CreatedDate:      e.ChangedAt,
+		constants.FieldSysSetupAudit_LastModifiedDate: e.ChangedAt,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to write setup audit entry: %w", err)
+	}
+	return nil
+}
+
+// Find queries setup audit entries matching a filter, newest first
+func (r *SetupAuditRepository) Find(ctx context.Context, f SetupAuditFilter) ([]*models.SystemSetupAudit, error) {
+	builder := query.From(constants.TableSetupAudit).Select(setupAuditColumns)
+	for column, value := range map[string]string{
+		constants.FieldSysSetupAudit_ComponentType: f.ComponentType,
+		constants.FieldSysSetupAudit_ComponentName: f.ComponentName,
+		constants.FieldSysSetupAudit_ObjectAPIName: f.ObjectAPIName,
+		constants.FieldSysSetupAudit_ActorID:       f.ActorID,
+		constants.FieldSysSetupAudit_Action:        f.Action,
+	} {
+		if value != "" {
+			builder.Where(column+" = ?", value)
+		}
+	}
+	if f.Since != nil {
+		builder.Where(constants.FieldSysSetupAudit_ChangedAt+" >= ?", *f.Since)
+	}
+	if f.Until != nil {
+		builder.Where(constants.FieldSysSetupAudit_ChangedAt+" < ?", *f.Until)
+	}
+	q := builder.OrderBy(constants.FieldSysSetupAudit_ChangedAt, constants.SortDESC).
+		Limit(f.Limit).
+		Offset(f.Offset).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query setup audit trail: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*models.SystemSetupAudit, 0)
+	for rows.Next() {
+		var e models.SystemSetupAudit
+		var objectAPIName, actorID, actorName sql.NullString
+		var before, after []byte
+		if err := rows.Scan(&e.ID, &e.Action, &e.ComponentType, &e.ComponentName, &objectAPIName,
+			&before, &after, &actorID, &actorName, &e.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan setup audit entry: %w", err)
+		}
+		if objectAPIName.Valid {
+			e.ObjectAPIName = &objectAPIName.String
+		}
+		if actorID.Valid {
+			e.ActorID = &actorID.String
+		}
+		if actorName.Valid {
+			e.ActorName = &actorName.String
+		}
+		e.BeforeData = before
+		e.AfterData = after
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
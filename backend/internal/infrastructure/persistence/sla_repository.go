@@ -0,0 +1,583 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SLARepository handles database operations for business hours, holidays, SLA policies and SLA timers
+type SLARepository struct {
+	db *sql.DB
+}
+
+// NewSLARepository creates a new SLARepository
+func NewSLARepository(db *sql.DB) *SLARepository {
+	return &SLARepository{db: db}
+}
+
+var businessHoursColumns = []string{
+	constants.FieldSysBusinessHours_ID,
+	constants.FieldSysBusinessHours_Name,
+	constants.FieldSysBusinessHours_Label,
+	constants.FieldSysBusinessHours_Timezone,
+	constants.FieldSysBusinessHours_IsDefault,
+	constants.FieldSysBusinessHours_Schedule,
+	constants.FieldSysBusinessHours_CreatedDate,
+	constants.FieldSysBusinessHours_LastModifiedDate,
+}
+
+var slaPolicyColumns = []string{
+	constants.FieldSysSLAPolicy_ID,
+	constants.FieldSysSLAPolicy_Name,
+	constants.FieldSysSLAPolicy_Label,
+	constants.FieldSysSLAPolicy_ObjectAPIName,
+	constants.FieldSysSLAPolicy_PriorityField,
+	constants.FieldSysSLAPolicy_StatusField,
+	constants.FieldSysSLAPolicy_PausedStatuses,
+	constants.FieldSysSLAPolicy_ClosedStatuses,
+	constants.FieldSysSLAPolicy_BusinessHours,
+	constants.FieldSysSLAPolicy_Milestones,
+	constants.FieldSysSLAPolicy_IsActive,
+	constants.FieldSysSLAPolicy_CreatedDate,
+	constants.FieldSysSLAPolicy_LastModifiedDate,
+}
+
+var slaTimerColumns = []string{
+	constants.FieldSysSLATimer_ID,
+	constants.FieldSysSLATimer_PolicyName,
+	constants.FieldSysSLATimer_ObjectAPIName,
+	constants.FieldSysSLATimer_RecordID,
+	constants.FieldSysSLATimer_Milestone,
+	constants.FieldSysSLATimer_TargetMinutes,
+	constants.FieldSysSLATimer_Status,
+	constants.FieldSysSLATimer_ElapsedSeconds,
+	constants.FieldSysSLATimer_RunningSince,
+	constants.FieldSysSLATimer_DueDate,
+	constants.FieldSysSLATimer_StartedDate,
+	constants.FieldSysSLATimer_CompletedDate,
+	constants.FieldSysSLATimer_Violated,
+	constants.FieldSysSLATimer_EscalatedDate,
+}
+
+// ==================== Business Hours ====================
+
+// GetAllBusinessHours queries all business hours with their holidays, ordered by name
+func (r *SLARepository) GetAllBusinessHours(ctx context.Context) ([]*models.BusinessHours, error) {
+	q := query.From(constants.TableBusinessHours).
+		Select(businessHoursColumns).
+		OrderBy(constants.FieldSysBusinessHours_Name, constants.SortASC).
+		Build()
+	return r.queryBusinessHours(ctx, q)
+}
+
+// FindBusinessHours queries business hours by name with their holidays, or nil if not found
+func (r *SLARepository) FindBusinessHours(ctx context.Context, name string) (*models.BusinessHours, error) {
+	q := query.From(constants.TableBusinessHours).
+		Select(businessHoursColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableBusinessHours, constants.FieldSysBusinessHours_Name), name).
+		Limit(1).
+		Build()
+	all, err := r.queryBusinessHours(ctx, q)
+	if err != nil || len(all) == 0 {
+		return nil, err
+	}
+	return all[0], nil
+}
+
+// FindDefaultBusinessHours queries the default business hours with their holidays, or nil if none is default
+func (r *SLARepository) FindDefaultBusinessHours(ctx context.Context) (*models.BusinessHours, error) {
+	q := query.From(constants.TableBusinessHours).
+		Select(businessHoursColumns).
+		Where(constants.FieldSysBusinessHours_IsDefault+" = ?", true).
+		Limit(1).
+		Build()
+	all, err := r.queryBusinessHours(ctx, q)
+	if err != nil || len(all) == 0 {
+		return nil, err
+	}
+	return all[0], nil
+}
+
+func (r *SLARepository) queryBusinessHours(ctx context.Context, q query.QueryResult) ([]*models.BusinessHours, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query business hours: %w", err)
+	}
+	defer rows.Close()
+
+	all := make([]*models.BusinessHours, 0)
+	byID := make(map[string]*models.BusinessHours)
+	for rows.Next() {
+		var bh models.BusinessHours
+		var schedule []byte
+		if err := rows.Scan(&bh.ID, &bh.Name, &bh.Label, &bh.Timezone, &bh.IsDefault, &schedule, &bh.CreatedDate, &bh.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan business hours: %w", err)
+		}
+		if len(schedule) > 0 {
+			if err := json.Unmarshal(schedule, &bh.Schedule); err != nil {
+				return nil, fmt.Errorf("invalid schedule of business hours %s: %w", bh.Name, err)
+			}
+		}
+		bh.Holidays = make([]models.Holiday, 0)
+		all = append(all, &bh)
+		byID[bh.ID] = &bh
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return all, nil
+	}
+
+	ids := make([]interface{}, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	hq := query.From(constants.TableHoliday).
+		Select([]string{
+			constants.FieldSysHoliday_ID,
+			constants.FieldSysHoliday_BusinessHoursID,
+			constants.FieldSysHoliday_Name,
+			constants.FieldSysHoliday_HolidayDate,
+			constants.FieldSysHoliday_IsRecurring,
+		}).
+		Where(fmt.Sprintf("%s IN (%s)", constants.FieldSysHoliday_BusinessHoursID, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")), ids...).
+		OrderBy(constants.FieldSysHoliday_HolidayDate, constants.SortASC).
+		Build()
+	hrows, err := r.db.QueryContext(ctx, hq.SQL, hq.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query holidays: %w", err)
+	}
+	defer hrows.Close()
+	for hrows.Next() {
+		var h models.Holiday
+		if err := hrows.Scan(&h.ID, &h.BusinessHoursID, &h.Name, &h.Date, &h.IsRecurring); err != nil {
+			return nil, fmt.Errorf("failed to scan holiday: %w", err)
+		}
+		if bh, ok := byID[h.BusinessHoursID]; ok {
+			bh.Holidays = append(bh.Holidays, h)
+		}
+	}
+	return all, hrows.Err()
+}
+
+// InsertBusinessHours inserts business hours without their holidays
+func (r *SLARepository) InsertBusinessHours(ctx context.Context, bh *models.BusinessHours) error {
+	schedule, err := json.Marshal(bh.Schedule)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	q := query.Insert(constants.TableBusinessHours, map[string]interface{}{
+		constants.FieldSysBusinessHours_ID:               bh.ID,
+		constants.FieldSysBusinessHours_Name:             bh.Name,
+		constants.FieldSysBusinessHours_Label:            bh.Label,
+		constants.FieldSysBusinessHours_Timezone:         bh.Timezone,
+		constants.FieldSysBusinessHours_IsDefault:        bh.IsDefault,
+		constants.FieldSysBusinessHours_Schedule:         string(schedule),
+		constants.FieldSysBusinessHours_CreatedDate:      now,
+		constants.FieldSysBusinessHours_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert business hours: %w", err)
+	}
+	bh.CreatedDate = now
+	bh.LastModifiedDate = now
+	return nil
+}
+
+// UpdateBusinessHours overwrites business hours without touching their holidays
+func (r *SLARepository) UpdateBusinessHours(ctx context.Context, bh *models.BusinessHours) error {
+	schedule, err := json.Marshal(bh.Schedule)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	q := query.Update(constants.TableBusinessHours).
+		Set(map[string]interface{}{
+			constants.FieldSysBusinessHours_Label:            bh.Label,
+			constants.FieldSysBusinessHours_Timezone:         bh.Timezone,
+			constants.FieldSysBusinessHours_IsDefault:        bh.IsDefault,
+			constants.FieldSysBusinessHours_Schedule:         string(schedule),
+			constants.FieldSysBusinessHours_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysBusinessHours_ID+" = ?", bh.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update business hours: %w", err)
+	}
+	bh.LastModifiedDate = now
+	return nil
+}
+
+// ClearDefaultBusinessHours unsets the default flag on all business hours except one
+func (r *SLARepository) ClearDefaultBusinessHours(ctx context.Context, exceptID string) error {
+	q := query.Update(constants.TableBusinessHours).
+		Set(map[string]interface{}{constants.FieldSysBusinessHours_IsDefault: false}).
+		Where(constants.FieldSysBusinessHours_ID+" <> ?", exceptID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to clear default business hours: %w", err)
+	}
+	return nil
+}
+
+// DeleteBusinessHours deletes business hours and their holidays
+func (r *SLARepository) DeleteBusinessHours(ctx context.Context, id string) error {
+	hq := query.Delete(constants.TableHoliday).
+		Where(constants.FieldSysHoliday_BusinessHoursID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, hq.SQL, hq.Params...); err != nil {
+		return fmt.Errorf("failed to delete holidays: %w", err)
+	}
+	q := query.Delete(constants.TableBusinessHours).
+		Where(constants.FieldSysBusinessHours_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete business hours: %w", err)
+	}
+	return nil
+}
+
+// InsertHoliday inserts a holiday
+func (r *SLARepository) InsertHoliday(ctx context.Context, h *models.Holiday) error {
+	now := time.Now().UTC()
+	q := query.Insert(constants.TableHoliday, map[string]interface{}{
+		constants.FieldSysHoliday_ID:               h.ID,
+		constants.FieldSysHoliday_BusinessHoursID:  h.BusinessHoursID,
+		constants.FieldSysHoliday_Name:             h.Name,
+		constants.FieldSysHoliday_HolidayDate:      h.Date,
+		constants.FieldSysHoliday_IsRecurring:      h.IsRecurring,
+		constants.FieldSysHoliday_CreatedDate:      now,
+		constants.FieldSysHoliday_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert holiday: %w", err)
+	}
+	return nil
+}
+
+// DeleteHoliday deletes a holiday of business hours and reports whether it existed
+func (r *SLARepository) DeleteHoliday(ctx context.Context, businessHoursID, id string) (bool, error) {
+	q := query.Delete(constants.TableHoliday).
+		Where(constants.FieldSysHoliday_ID+" = ?", id).
+		Where(constants.FieldSysHoliday_BusinessHoursID+" = ?", businessHoursID).
+		Build()
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete holiday: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// ==================== SLA Policies ====================
+
+// GetPolicies queries all SLA policies ordered by name
+func (r *SLARepository) GetPolicies(ctx context.Context) ([]*models.SLAPolicy, error) {
+	q := query.From(constants.TableSLAPolicy).
+		Select(slaPolicyColumns).
+		OrderBy(constants.FieldSysSLAPolicy_Name, constants.SortASC).
+		Build()
+	return r.queryPolicies(ctx, q)
+}
+
+// FindPolicy queries an SLA policy by name, or nil if not found
+func (r *SLARepository) FindPolicy(ctx context.Context, name string) (*models.SLAPolicy, error) {
+	q := query.From(constants.TableSLAPolicy).
+		Select(slaPolicyColumns).
+		Where(fmt.Sprintf("LOWER(`%s`.`%s`) = LOWER(?)", constants.TableSLAPolicy, constants.FieldSysSLAPolicy_Name), name).
+		Limit(1).
+		Build()
+	policies, err := r.queryPolicies(ctx, q)
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+	return policies[0], nil
+}
+
+// FindActivePolicies queries the active SLA policies of an object
+func (r *SLARepository) FindActivePolicies(ctx context.Context, objectAPIName string) ([]*models.SLAPolicy, error) {
+	q := query.From(constants.TableSLAPolicy).
+		Select(slaPolicyColumns).
+		Where(constants.FieldSysSLAPolicy_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysSLAPolicy_IsActive+" = ?", true).
+		Build()
+	return r.queryPolicies(ctx, q)
+}
+
+func (r *SLARepository) queryPolicies(ctx context.Context, q query.QueryResult) ([]*models.SLAPolicy, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SLA policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]*models.SLAPolicy, 0)
+	for rows.Next() {
+		var p models.SLAPolicy
+		var priorityField, businessHours sql.NullString
+		var paused, closed, milestones []byte
+		if err := rows.Scan(&p.ID, &p.Name, &p.Label, &p.ObjectAPIName, &priorityField, &p.StatusField,
+			&paused, &closed, &businessHours, &milestones, &p.IsActive, &p.CreatedDate, &p.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan SLA policy: %w", err)
+		}
+		p.PriorityField = priorityField.String
+		p.BusinessHours = businessHours.String
+		for _, col := range []struct {
+			raw  []byte
+			dest interface{}
+		}{{paused, &p.PausedStatuses}, {closed, &p.ClosedStatuses}, {milestones, &p.Milestones}} {
+			if len(col.raw) > 0 {
+				if err := json.Unmarshal(col.raw, col.dest); err != nil {
+					return nil, fmt.Errorf("invalid SLA policy %s: %w", p.Name, err)
+				}
+			}
+		}
+		policies = append(policies, &p)
+	}
+	return policies, rows.Err()
+}
+
+func slaPolicyValues(p *models.SLAPolicy) (map[string]interface{}, error) {
+	paused, err := json.Marshal(p.PausedStatuses)
+	if err != nil {
+		return nil, err
+	}
+	closed, err := json.Marshal(p.ClosedStatuses)
+	if err != nil {
+		return nil, err
+	}
+	milestones, err := json.Marshal(p.Milestones)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		constants.FieldSysSLAPolicy_Label:          p.Label,
+		constants.FieldSysSLAPolicy_ObjectAPIName:  p.ObjectAPIName,
+		constants.FieldSysSLAPolicy_PriorityField:  nullableString(p.PriorityField),
+		constants.FieldSysSLAPolicy_StatusField:    p.StatusField,
+		constants.FieldSysSLAPolicy_PausedStatuses: string(paused),
+		constants.FieldSysSLAPolicy_ClosedStatuses: string(closed),
+		constants.FieldSysSLAPolicy_BusinessHours:  nullableString(p.BusinessHours),
+		constants.FieldSysSLAPolicy_Milestones:     string(milestones),
+		constants.FieldSysSLAPolicy_IsActive:       p.IsActive,
+	}, nil
+}
+
+// InsertPolicy inserts an SLA policy
+func (r *SLARepository) InsertPolicy(ctx context.Context, p *models.SLAPolicy) error {
+	values, err := slaPolicyValues(p)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	values[constants.FieldSysSLAPolicy_ID] = p.ID
+	values[constants.FieldSysSLAPolicy_Name] = p.Name
+	values[constants.FieldSysSLAPolicy_CreatedDate] = now
+	values[constants.FieldSysSLAPolicy_LastModifiedDate] = now
+	q := query.Insert(constants.TableSLAPolicy, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert SLA policy: %w", err)
+	}
+	p.CreatedDate = now
+	p.LastModifiedDate = now
+	return nil
+}
+
+// UpdatePolicy overwrites an SLA policy
+func (r *SLARepository) UpdatePolicy(ctx context.Context, p *models.SLAPolicy) error {
+	values, err := slaPolicyValues(p)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	values[constants.FieldSysSLAPolicy_LastModifiedDate] = now
+	q := query.Update(constants.TableSLAPolicy).
+		Set(values).
+		Where(constants.FieldSysSLAPolicy_ID+" = ?", p.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update SLA policy: %w", err)
+	}
+	p.LastModifiedDate = now
+	return nil
+}
+
+// DeletePolicy deletes an SLA policy and its timers
+func (r *SLARepository) DeletePolicy(ctx context.Context, p *models.SLAPolicy) error {
+	tq := query.Delete(constants.TableSLATimer).
+		Where(constants.FieldSysSLATimer_PolicyName+" = ?", p.Name).
+		Build()
+	if _, err := r.db.ExecContext(ctx, tq.SQL, tq.Params...); err != nil {
+		return fmt.Errorf("failed to delete SLA timers: %w", err)
+	}
+	q := query.Delete(constants.TableSLAPolicy).
+		Where(constants.FieldSysSLAPolicy_ID+" = ?", p.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete SLA policy: %w", err)
+	}
+	return nil
+}
+
+// ==================== SLA Timers ====================
+
+// FindTimers queries the SLA timers of a record, oldest first
+func (r *SLARepository) FindTimers(ctx context.Context, objectAPIName, recordID string) ([]*models.SLATimer, error) {
+	q := query.From(constants.TableSLATimer).
+		Select(slaTimerColumns).
+		Where(constants.FieldSysSLATimer_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysSLATimer_RecordID+" = ?", recordID).
+		OrderBy(constants.FieldSysSLATimer_StartedDate, constants.SortASC).
+		Build()
+	return r.queryTimers(ctx, q)
+}
+
+// FindDueTimers queries running timers that passed their due date and were not yet violated, earliest due first
+func (r *SLARepository) FindDueTimers(ctx context.Context, now time.Time, limit int) ([]*models.SLATimer, error) {
+	q := query.From(constants.TableSLATimer).
+		Select(slaTimerColumns).
+		Where(constants.FieldSysSLATimer_Status+" = ?", string(constants.SLATimerStatusRunning)).
+		Where(constants.FieldSysSLATimer_DueDate+" <= ?", now).
+		Where(constants.FieldSysSLATimer_Violated+" = ?", false).
+		OrderBy(constants.FieldSysSLATimer_DueDate, constants.SortASC).
+		Limit(limit).
+		Build()
+	return r.queryTimers(ctx, q)
+}
+
+func (r *SLARepository) queryTimers(ctx context.Context, q query.QueryResult) ([]*models.SLATimer, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SLA timers: %w", err)
+	}
+	defer rows.Close()
+
+	timers := make([]*models.SLATimer, 0)
+	for rows.Next() {
+		var t models.SLATimer
+		var status string
+		var runningSince, dueDate, completedDate, escalatedDate sql.NullTime
+		if err := rows.Scan(&t.ID, &t.PolicyName, &t.ObjectAPIName, &t.RecordID, &t.Milestone, &t.TargetMinutes, &status,
+			&t.ElapsedSeconds, &runningSince, &dueDate, &t.StartedDate, &completedDate, &t.Violated, &escalatedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan SLA timer: %w", err)
+		}
+		t.Status = constants.SLATimerStatus(status)
+		t.RunningSince = nullTimePtr(runningSince)
+		t.DueDate = nullTimePtr(dueDate)
+		t.CompletedDate = nullTimePtr(completedDate)
+		t.EscalatedDate = nullTimePtr(escalatedDate)
+		timers = append(timers, &t)
+	}
+	return timers, rows.Err()
+}
+
+// SaveTimer inserts a timer, or overwrites its state when it already exists
+func (r *SLARepository) SaveTimer(ctx context.Context, t *models.SLATimer) error {
+	now := time.Now().UTC()
+	q := query.Insert(constants.TableSLATimer, map[string]interface{}{
+		constants.FieldSysSLATimer_ID:               t.ID,
+		constants.FieldSysSLATimer_PolicyName:       t.PolicyName,
+		constants.FieldSysSLATimer_ObjectAPIName:    t.ObjectAPIName,
+		constants.FieldSysSLATimer_RecordID:         t.RecordID,
+		constants.FieldSysSLATimer_Milestone:        t.Milestone,
+		constants.FieldSysSLATimer_TargetMinutes:    t.TargetMinutes,
+		constants.FieldSysSLATimer_Status:           string(t.Status),
+		constants.FieldSysSLATimer_ElapsedSeconds:   t.ElapsedSeconds,
+		constants.FieldSysSLATimer_RunningSince:     t.RunningSince,
+		constants.FieldSysSLATimer_DueDate:          t.DueDate,
+		constants.FieldSysSLATimer_StartedDate:      t.StartedDate,
+		constants.FieldSysSLATimer_CompletedDate:    t.CompletedDate,
+		constants.FieldSysSLATimer_Violated:         t.Violated,
+		constants.FieldSysSLATimer_EscalatedDate:    t.EscalatedDate,
+		constants.FieldSysSLATimer_CreatedDate:      now,
+		constants.FieldSysSLATimer_LastModifiedDate: now,
+	}).Build()
+
+	upsert := query.ActiveDialect().Upsert([]string{
+		constants.FieldSysSLATimer_ObjectAPIName, constants.FieldSysSLATimer_RecordID,
+		constants.FieldSysSLATimer_PolicyName, constants.FieldSysSLATimer_Milestone,
+	}, []string{
+		constants.FieldSysSLATimer_TargetMinutes,
+		constants.FieldSysSLATimer_Status,
+		constants.FieldSysSLATimer_ElapsedSeconds,
+		constants.FieldSysSLATimer_RunningSince,
+		constants.FieldSysSLATimer_DueDate,
+		constants.FieldSysSLATimer_CompletedDate,
+		constants.FieldSysSLATimer_Violated,
+		constants.FieldSysSLATimer_EscalatedDate,
+		constants.FieldSysSLATimer_LastModifiedDate,
+	})
+	sqlStr := q.SQL + " " + upsert
+	if _, err := r.db.ExecContext(ctx, sqlStr, q.Params...); err != nil {
+		return fmt.Errorf("failed to save SLA timer: %w", err)
+	}
+	return nil
+}
+
+// DeleteTimers deletes timers by ID
+func (r *SLARepository) DeleteTimers(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	params := make([]interface{}, len(ids))
+	for i, id := range ids {
+		params[i] = id
+	}
+	q := query.Delete(constants.TableSLATimer).
+		Where(fmt.Sprintf("%s IN (%s)", constants.FieldSysSLATimer_ID, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")), params...).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete SLA timers: %w", err)
+	}
+	return nil
+}
+
+// DeleteRecordTimers deletes all timers of a record
+func (r *SLARepository) DeleteRecordTimers(ctx context.Context, objectAPIName, recordID string) error {
+	q := query.Delete(constants.TableSLATimer).
+		Where(constants.FieldSysSLATimer_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysSLATimer_RecordID+" = ?", recordID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete SLA timers: %w", err)
+	}
+	return nil
+}
+
+// MarkViolated atomically flags a running timer as violated and escalated, and reports
+// whether this call did so; a false result means another server already escalated it
+func (r *SLARepository) MarkViolated(ctx context.Context, id string, now time.Time) (bool, error) {
+	q := query.Update(constants.TableSLATimer).
+		Set(map[string]interface{}{
+			constants.FieldSysSLATimer_Violated:         true,
+			constants.FieldSysSLATimer_EscalatedDate:    now,
+			constants.FieldSysSLATimer_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysSLATimer_ID+" = ?", id).
+		Where(constants.FieldSysSLATimer_Violated+" = ?", false).
+		Build()
+	result, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark SLA timer violated: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// nullTimePtr converts a nullable time column to a pointer
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
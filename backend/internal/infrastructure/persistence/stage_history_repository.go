@@ -0,0 +1,291 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// StageHistoryRepository handles database operations for the stays of records in the values
+// of history-tracked picklist fields
+type StageHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewStageHistoryRepository creates a new StageHistoryRepository
+func NewStageHistoryRepository(db *sql.DB) *StageHistoryRepository {
+	return &StageHistoryRepository{db: db}
+}
+
+var stageHistoryColumns = []string{
+	constants.FieldSysStageHistory_ID,
+	constants.FieldSysStageHistory_ObjectAPIName,
+	constants.FieldSysStageHistory_RecordID,
+	constants.FieldSysStageHistory_FieldAPIName,
+	constants.FieldSysStageHistory_Value,
+	constants.FieldSysStageHistory_PreviousValue,
+	constants.FieldSysStageHistory_EnteredDate,
+	constants.FieldSysStageHistory_ExitedDate,
+	constants.FieldSysStageHistory_DurationSeconds,
+	constants.FieldSysStageHistory_ChangedByID,
+}
+
+// StageAggregate holds the figures of the stays in one value
+type StageAggregate struct {
+	Value              string
+	Entries            int
+	Records            int
+	Current            int
+	Exited             int
+	AvgDurationSeconds float64
+	MaxDurationSeconds int64
+}
+
+// StageVisit is a record that stayed in a value at least once
+type StageVisit struct {
+	RecordID string
+	Value    string
+}
+
+// RecordTransition closes the open stay of a record's field and opens one in entry.Value;
+// an empty Value (the field was cleared) only closes it. The ID of entry is generated when empty.
+func (r *StageHistoryRepository) RecordTransition(ctx context.Context, entry *models.StageHistoryEntry) error {
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := query.From(constants.TableStageHistory).
+		Select([]string{constants.FieldSysStageHistory_EnteredDate}).
+		Where(constants.FieldSysStageHistory_ObjectAPIName+" = ?", entry.ObjectAPIName).
+		Where(constants.FieldSysStageHistory_RecordID+" = ?", entry.RecordID).
+		Where(constants.FieldSysStageHistory_FieldAPIName+" = ?", entry.FieldAPIName).
+		Where(constants.FieldSysStageHistory_ExitedDate + " IS NULL").
+		Build()
+	rows, err := tx.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return fmt.Errorf("failed to query open stage history: %w", err)
+	}
+	open := make(map[string]time.Time)
+	for rows.Next() {
+		var id string
+		var entered time.Time
+		if err := rows.Scan(&id, &entered); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan stage history: %w", err)
+		}
+		open[id] = entered
+	}
+	_ = rows.Close()
+
+	for id, entered := range open {
+		duration := int64(entry.EnteredDate.Sub(entered).Seconds())
+		if duration < 0 {
+			duration = 0
+		}
+		uq := query.Update(constants.TableStageHistory).
+			Set(map[string]interface{}{
+				constants.FieldSysStageHistory_ExitedDate:      entry.EnteredDate,
+				constants.FieldSysStageHistory_DurationSeconds: duration,
+			}).
+			Where(constants.FieldSysStageHistory_ID+" = ?", id).
+			Build()
+		if _, err := tx.ExecContext(ctx, uq.SQL, uq.Params...); err != nil {
+			return fmt.Errorf("failed to close stage history: %w", err)
+		}
+	}
+
+	if entry.Value == "" {
+		return tx.Commit()
+	}
+	iq := query.Insert(constants.TableStageHistory, map[string]interface{}{
+		constants.FieldSysStageHistory_ID:            entry.ID,
+		constants.FieldSysStageHistory_ObjectAPIName: entry.ObjectAPIName,
+		constants.FieldSysStageHistory_RecordID:      entry.RecordID,
+		constants.FieldSysStageHistory_FieldAPIName:  entry.FieldAPIName,
+		constants.FieldSysStageHistory_Value:         entry.Value,
+		constants.FieldSysStageHistory_PreviousValue: entry.PreviousValue,
+		constants.FieldSysStageHistory_EnteredDate:   entry.EnteredDate,
+		constants.FieldSysStageHistory_ChangedByID:   entry.ChangedByID,
+		constants.FieldSysStageHistory_CreatedDate:   time.Now().UTC(),
+	}).Build()
+	if _, err := tx.ExecContext(ctx, iq.SQL, iq.Params...); err != nil {
+		return fmt.Errorf("failed to insert stage history: %w", err)
+	}
+	return tx.Commit()
+}
+
+// CloseOpen ends the open stays of all tracked fields of a record, e.g. when it is deleted
+func (r *StageHistoryRepository) CloseOpen(ctx context.Context, objectAPIName, recordID string, exited time.Time) error {
+	entries, err := r.FindByRecord(ctx, objectAPIName, recordID)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.ExitedDate != nil {
+			continue
+		}
+		duration := int64(exited.Sub(e.EnteredDate).Seconds())
+		if duration < 0 {
+			duration = 0
+		}
+		q := query.Update(constants.TableStageHistory).
+			Set(map[string]interface{}{
+				constants.FieldSysStageHistory_ExitedDate:      exited,
+				constants.FieldSysStageHistory_DurationSeconds: duration,
+			}).
+			Where(constants.FieldSysStageHistory_ID+" = ?", e.ID).
+			Build()
+		if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+			return fmt.Errorf("failed to close stage history: %w", err)
+		}
+	}
+	return nil
+}
+
+// FindByRecord queries the stays of a record, oldest first
+func (r *StageHistoryRepository) FindByRecord(ctx context.Context, objectAPIName, recordID string) ([]*models.StageHistoryEntry, error) {
+	q := query.From(constants.TableStageHistory).
+		Select(stageHistoryColumns).
+		Where(constants.FieldSysStageHistory_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysStageHistory_RecordID+" = ?", recordID).
+		OrderBy(constants.FieldSysStageHistory_EnteredDate, constants.SortASC).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stage history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*models.StageHistoryEntry, 0)
+	for rows.Next() {
+		var e models.StageHistoryEntry
+		var previous, changedBy sql.NullString
+		var exited sql.NullTime
+		var duration sql.NullInt64
+		if err := rows.Scan(&e.ID, &e.ObjectAPIName, &e.RecordID, &e.FieldAPIName, &e.Value, &previous,
+			&e.EnteredDate, &exited, &duration, &changedBy); err != nil {
+			return nil, fmt.Errorf("failed to scan stage history: %w", err)
+		}
+		e.PreviousValue = nullStringPtr(previous)
+		e.ChangedByID = nullStringPtr(changedBy)
+		e.ExitedDate = nullTimePtr(exited)
+		if duration.Valid {
+			e.DurationSeconds = &duration.Int64
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// stageHistoryScope selects the stays of a field entered within [from, to); nil bounds are open
+func stageHistoryScope(b *query.Builder, objectAPIName, fieldAPIName string, from, to *time.Time) *query.Builder {
+	b.Where(constants.FieldSysStageHistory_ObjectAPIName+" = ?", objectAPIName).
+		Where(constants.FieldSysStageHistory_FieldAPIName+" = ?", fieldAPIName)
+	if from != nil {
+		b.Where(constants.FieldSysStageHistory_EnteredDate+" >= ?", *from)
+	}
+	if to != nil {
+		b.Where(constants.FieldSysStageHistory_EnteredDate+" < ?", *to)
+	}
+	return b
+}
+
+// Aggregates sums up the stays of a field per value
+func (r *StageHistoryRepository) Aggregates(ctx context.Context, objectAPIName, fieldAPIName string, from, to *time.Time) ([]StageAggregate, error) {
+	b := query.From(constants.TableStageHistory).
+		AddSelectRaw(fmt.Sprintf("`%s`", constants.FieldSysStageHistory_Value)).
+		AddSelectRaw("COUNT(*)").
+		AddSelectRaw(fmt.Sprintf("COUNT(DISTINCT `%s`)", constants.FieldSysStageHistory_RecordID)).
+		AddSelectRaw(fmt.Sprintf("SUM(CASE WHEN `%s` IS NULL THEN 1 ELSE 0 END)", constants.FieldSysStageHistory_ExitedDate)).
+		AddSelectRaw(fmt.Sprintf("COUNT(`%s`)", constants.FieldSysStageHistory_ExitedDate)).
+		AddSelectRaw(fmt.Sprintf("AVG(`%s`)", constants.FieldSysStageHistory_DurationSeconds)).
+		AddSelectRaw(fmt.Sprintf("MAX(`%s`)", constants.FieldSysStageHistory_DurationSeconds))
+	q := stageHistoryScope(b, objectAPIName, fieldAPIName, from, to).
+		GroupBy(constants.FieldSysStageHistory_Value).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate stage history: %w", err)
+	}
+	defer rows.Close()
+
+	aggregates := make([]StageAggregate, 0)
+	for rows.Next() {
+		var a StageAggregate
+		var current sql.NullInt64
+		var avg sql.NullFloat64
+		var max sql.NullInt64
+		if err := rows.Scan(&a.Value, &a.Entries, &a.Records, &current, &a.Exited, &avg, &max); err != nil {
+			return nil, fmt.Errorf("failed to scan stage history aggregate: %w", err)
+		}
+		a.Current = int(current.Int64)
+		a.AvgDurationSeconds = avg.Float64
+		a.MaxDurationSeconds = max.Int64
+		aggregates = append(aggregates, a)
+	}
+	return aggregates, rows.Err()
+}
+
+// Visits lists the distinct records that stayed in each value of a field
+func (r *StageHistoryRepository) Visits(ctx context.Context, objectAPIName, fieldAPIName string, from, to *time.Time) ([]StageVisit, error) {
+	b := query.From(constants.TableStageHistory).
+		AddSelectRaw(fmt.Sprintf("DISTINCT `%s`", constants.FieldSysStageHistory_RecordID)).
+		AddSelectRaw(fmt.Sprintf("`%s`", constants.FieldSysStageHistory_Value))
+	q := stageHistoryScope(b, objectAPIName, fieldAPIName, from, to).Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stage visits: %w", err)
+	}
+	defer rows.Close()
+
+	visits := make([]StageVisit, 0)
+	for rows.Next() {
+		var v StageVisit
+		if err := rows.Scan(&v.RecordID, &v.Value); err != nil {
+			return nil, fmt.Errorf("failed to scan stage visit: %w", err)
+		}
+		visits = append(visits, v)
+	}
+	return visits, rows.Err()
+}
+
+// Transitions counts the moves between values of a field, busiest first
+func (r *StageHistoryRepository) Transitions(ctx context.Context, objectAPIName, fieldAPIName string, from, to *time.Time) ([]models.StageTransition, error) {
+	b := query.From(constants.TableStageHistory).
+		AddSelectRaw(fmt.Sprintf("`%s`", constants.FieldSysStageHistory_PreviousValue)).
+		AddSelectRaw(fmt.Sprintf("`%s`", constants.FieldSysStageHistory_Value)).
+		AddSelectRaw("COUNT(*)", "transition_count").
+		Where(constants.FieldSysStageHistory_PreviousValue + " IS NOT NULL")
+	q := stageHistoryScope(b, objectAPIName, fieldAPIName, from, to).
+		GroupBy(constants.FieldSysStageHistory_PreviousValue).
+		GroupBy(constants.FieldSysStageHistory_Value).
+		OrderBy("`transition_count`", constants.SortDESC).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stage transitions: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := make([]models.StageTransition, 0)
+	for rows.Next() {
+		var t models.StageTransition
+		if err := rows.Scan(&t.From, &t.To, &t.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan stage transition: %w", err)
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions, rows.Err()
+}
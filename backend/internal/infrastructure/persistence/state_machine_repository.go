@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// StateMachineRepository handles database operations for picklist state machines
+type StateMachineRepository struct {
+	db *sql.DB
+}
+
+// NewStateMachineRepository creates a new StateMachineRepository
+func NewStateMachineRepository(db *sql.DB) *StateMachineRepository {
+	return &StateMachineRepository{db: db}
+}
+
+var stateMachineColumns = []string{
+	constants.FieldSysStateMachine_ID,
+	constants.FieldSysStateMachine_Name,
+	constants.FieldSysStateMachine_Description,
+	constants.FieldSysStateMachine_ObjectAPIName,
+	constants.FieldSysStateMachine_FieldAPIName,
+	constants.FieldSysStateMachine_InitialStates,
+	constants.FieldSysStateMachine_States,
+	constants.FieldSysStateMachine_Transitions,
+	constants.FieldSysStateMachine_IsActive,
+	constants.FieldSysStateMachine_CreatedDate,
+	constants.FieldSysStateMachine_LastModifiedDate,
+}
+
+// GetAll queries all state machines ordered by name
+func (r *StateMachineRepository) GetAll(ctx context.Context) ([]*models.StateMachine, error) {
+	q := query.From(constants.TableStateMachine).
+		Select(stateMachineColumns).
+		OrderBy(constants.FieldSysStateMachine_Name, constants.SortASC).
+		Build()
+	return r.queryStateMachines(ctx, q)
+}
+
+// FindActive queries the active state machines
+func (r *StateMachineRepository) FindActive(ctx context.Context) ([]*models.StateMachine, error) {
+	q := query.From(constants.TableStateMachine).
+		Select(stateMachineColumns).
+		Where(constants.FieldSysStateMachine_IsActive+" = ?", true).
+		Build()
+	return r.queryStateMachines(ctx, q)
+}
+
+// FindByName queries a state machine by name, or nil if not found
+func (r *StateMachineRepository) FindByName(ctx context.Context, name string) (*models.StateMachine, error) {
+	q := query.From(constants.TableStateMachine).
+		Select(stateMachineColumns).
+		Where(constants.FieldSysStateMachine_Name+" = ?", name).
+		Limit(1).
+		Build()
+	machines, err := r.queryStateMachines(ctx, q)
+	if err != nil || len(machines) == 0 {
+		return nil, err
+	}
+	return machines[0], nil
+}
+
+// FindByField queries the state machine of a field, or nil if it has none
+func (r *StateMachineRepository) FindByField(ctx context.Context, objectAPIName, fieldAPIName string) (*models.StateMachine, error) {
+	q := query.From(constants.TableStateMachine).
+		Select(stateMachineColumns).
+		Where(fmt.Sprintf("LOWER(%s) = LOWER(?)", constants.FieldSysStateMachine_ObjectAPIName), objectAPIName).
+		Where(fmt.Sprintf("LOWER(%s) = LOWER(?)", constants.FieldSysStateMachine_FieldAPIName), fieldAPIName).
+		Limit(1).
+		Build()
+	machines, err := r.queryStateMachines(ctx, q)
+	if err != nil || len(machines) == 0 {
+		return nil, err
+	}
+	return machines[0], nil
+}
+
+func (r *StateMachineRepository) queryStateMachines(ctx context.Context, q query.QueryResult) ([]*models.StateMachine, error) {
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query state machines: %w", err)
+	}
+	defer rows.Close()
+
+	machines := make([]*models.StateMachine, 0)
+	for rows.Next() {
+		var m models.StateMachine
+		var description, initialStates, states, transitions sql.NullString
+		if err := rows.Scan(&m.ID, &m.Name, &description, &m.ObjectAPIName, &m.FieldAPIName,
+			&initialStates, &states, &transitions, &m.IsActive, &m.CreatedDate, &m.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan state machine: %w", err)
+		}
+		m.Description = description.String
+		if err := unmarshalNullJSON(initialStates, &m.InitialStates); err != nil {
+			return nil, fmt.Errorf("failed to decode initial states of state machine %s: %w", m.Name, err)
+		}
+		if err := unmarshalNullJSON(states, &m.States); err != nil {
+			return nil, fmt.Errorf("failed to decode states of state machine %s: %w", m.Name, err)
+		}
+		if err := unmarshalNullJSON(transitions, &m.Transitions); err != nil {
+			return nil, fmt.Errorf("failed to decode transitions of state machine %s: %w", m.Name, err)
+		}
+		machines = append(machines, &m)
+	}
+	return machines, rows.Err()
+}
+
+func stateMachineValues(m *models.StateMachine) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		constants.FieldSysStateMachine_Description:   nullableString(m.Description),
+		constants.FieldSysStateMachine_ObjectAPIName: m.ObjectAPIName,
+		constants.FieldSysStateMachine_FieldAPIName:  m.FieldAPIName,
+		constants.FieldSysStateMachine_InitialStates: SliceToNullJSON(m.InitialStates),
+		constants.FieldSysStateMachine_IsActive:      m.IsActive,
+	}
+	for field, v := range map[string]interface{}{
+		constants.FieldSysStateMachine_States:      m.States,
+		constants.FieldSysStateMachine_Transitions: m.Transitions,
+	} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", field, err)
+		}
+		values[field] = string(data)
+	}
+	return values, nil
+}
+
+// Insert inserts a state machine
+func (r *StateMachineRepository) Insert(ctx context.Context, m *models.StateMachine) error {
+	values, err := stateMachineValues(m)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysStateMachine_ID] = m.ID
+	values[constants.FieldSysStateMachine_Name] = m.Name
+	values[constants.FieldSysStateMachine_CreatedDate] = now
+	values[constants.FieldSysStateMachine_LastModifiedDate] = now
+	q := query.Insert(constants.TableStateMachine, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert state machine: %w", err)
+	}
+	m.CreatedDate = now
+	m.LastModifiedDate = now
+	return nil
+}
+
+// Update overwrites a state machine; its name is left as it is
+func (r *StateMachineRepository) Update(ctx context.Context, m *models.StateMachine) error {
+	values, err := stateMachineValues(m)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	values[constants.FieldSysStateMachine_LastModifiedDate] = now
+	q := query.Update(constants.TableStateMachine).
+		Set(values).
+		Where(constants.FieldSysStateMachine_ID+" = ?", m.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update state machine: %w", err)
+	}
+	m.LastModifiedDate = now
+	return nil
+}
+
+// Delete deletes a state machine
+func (r *StateMachineRepository) Delete(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableStateMachine).
+		Where(constants.FieldSysStateMachine_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete state machine: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SurveyRepository handles database operations for surveys, the invitations sent for them
+// and the responses they collect
+type SurveyRepository struct {
+	db *sql.DB
+}
+
+// NewSurveyRepository creates a new SurveyRepository
+func NewSurveyRepository(db *sql.DB) *SurveyRepository {
+	return &SurveyRepository{db: db}
+}
+
+func (r *SurveyRepository) executor(tx *sql.Tx) Executor {
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// surveyTimestamp formats dates like record system dates
+func surveyTimestamp(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// surveyNullTimestamp formats an optional date, or NULL
+func surveyNullTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return surveyTimestamp(*t)
+}
+
+var surveyColumns = []string{
+	constants.FieldSysSurvey_Name,
+	constants.FieldSysSurvey_Description,
+	constants.FieldSysSurvey_ScoreType,
+	constants.FieldSysSurvey_Question,
+	constants.FieldSysSurvey_Questions,
+	constants.FieldSysSurvey_IsActive,
+	constants.FieldSysSurvey_OwnerID,
+	constants.FieldSysSurvey_CreatedDate,
+	constants.FieldSysSurvey_LastModifiedDate,
+}
+
+var surveyInvitationColumns = []string{
+	constants.FieldSysSurveyInvitation_SurveyID,
+	constants.FieldSysSurveyInvitation_TokenHash,
+	constants.FieldSysSurveyInvitation_ObjectAPIName,
+	constants.FieldSysSurveyInvitation_RecordID,
+	constants.FieldSysSurveyInvitation_ContactID,
+	constants.FieldSysSurveyInvitation_ExpiresDate,
+	constants.FieldSysSurveyInvitation_RespondedDate,
+	constants.FieldSysSurveyInvitation_CreatedByID,
+	constants.FieldSysSurveyInvitation_CreatedDate,
+	constants.FieldSysSurveyInvitation_LastModifiedDate,
+}
+
+var surveyResponseColumns = []string{
+	constants.FieldSysSurveyResponse_SurveyID,
+	constants.FieldSysSurveyResponse_InvitationID,
+	constants.FieldSysSurveyResponse_ObjectAPIName,
+	constants.FieldSysSurveyResponse_RecordID,
+	constants.FieldSysSurveyResponse_ContactID,
+	constants.FieldSysSurveyResponse_Score,
+	constants.FieldSysSurveyResponse_Answers,
+	constants.FieldSysSurveyResponse_Comment,
+	constants.FieldSysSurveyResponse_SubmittedDate,
+	constants.FieldSysSurveyResponse_CreatedDate,
+	constants.FieldSysSurveyResponse_LastModifiedDate,
+}
+
+// ==================== Surveys ====================
+
+// ListSurveys returns surveys by name, only active ones when activeOnly is set
+func (r *SurveyRepository) ListSurveys(ctx context.Context, activeOnly bool) ([]*models.SystemSurvey, error) {
+	b := query.From(constants.TableSurvey).Select(surveyColumns)
+	if activeOnly {
+		b = b.Where(constants.FieldSysSurvey_IsActive+" = ?", true)
+	}
+	q := b.OrderBy(constants.FieldSysSurvey_Name, constants.SortASC).Build()
+	return r.querySurveys(ctx, nil, q)
+}
+
+// GetSurvey returns a survey by ID, or nil if not found. tx may be nil.
+func (r *SurveyRepository) GetSurvey(ctx context.Context, tx *sql.Tx, id string) (*models.SystemSurvey, error) {
+	q := query.From(constants.TableSurvey).
+		Select(surveyColumns).
+		Where(constants.FieldSysSurvey_ID+" = ?", id).
+		Limit(1).
+		Build()
+	surveys, err := r.querySurveys(ctx, tx, q)
+	if err != nil || len(surveys) == 0 {
+		return nil, err
+	}
+	return surveys[0], nil
+}
+
+func (r *SurveyRepository) querySurveys(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemSurvey, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query surveys: %w", err)
+	}
+	defer rows.Close()
+
+	surveys := make([]*models.SystemSurvey, 0)
+	for rows.Next() {
+		var s models.SystemSurvey
+		var questions []byte
+		if err := rows.Scan(&s.ID, &s.Name, &s.Description, &s.ScoreType, &s.Question, &questions, &s.IsActive,
+			&s.OwnerID, &s.CreatedDate, &s.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan survey: %w", err)
+		}
+		if len(questions) > 0 {
+			s.Questions = json.RawMessage(questions)
+		}
+		surveys = append(surveys, &s)
+	}
+	return surveys, rows.Err()
+}
+
+// surveyValues returns the editable columns of a survey
+func surveyValues(s *models.SystemSurvey) map[string]interface{} {
+	return map[string]interface{}{
+		constants.FieldSysSurvey_Name:        s.Name,
+		constants.FieldSysSurvey_Description: ToNullString(s.Description),
+		constants.FieldSysSurvey_ScoreType:   s.ScoreType,
+		constants.FieldSysSurvey_Question:    s.Question,
+		constants.FieldSysSurvey_Questions:   nullableJSON(s.Questions),
+		constants.FieldSysSurvey_IsActive:    s.IsActive,
+		constants.FieldSysSurvey_OwnerID:     s.OwnerID,
+	}
+}
+
+// InsertSurvey stores a new survey
+func (r *SurveyRepository) InsertSurvey(ctx context.Context, s *models.SystemSurvey) error {
+	now := time.Now()
+	values := surveyValues(s)
+	values[constants.FieldSysSurvey_ID] = s.ID
+	values[constants.FieldSysSurvey_CreatedDate] = surveyTimestamp(now)
+	values[constants.FieldSysSurvey_LastModifiedDate] = surveyTimestamp(now)
+	q := query.Insert(constants.TableSurvey, values).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert survey: %w", err)
+	}
+	s.CreatedDate = now
+	s.LastModifiedDate = now
+	return nil
+}
+
+// UpdateSurvey overwrites the editable fields of a survey
+func (r *SurveyRepository) UpdateSurvey(ctx context.Context, s *models.SystemSurvey) error {
+	now := time.Now()
+	values := surveyValues(s)
+	values[constants.FieldSysSurvey_LastModifiedDate] = surveyTimestamp(now)
+	q := query.Update(constants.TableSurvey).
+		Set(values).
+		Where(constants.FieldSysSurvey_ID+" = ?", s.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update survey: %w", err)
+	}
+	s.LastModifiedDate = now
+	return nil
+}
+
+// DeleteSurvey removes a survey and its invitations in one transaction
+func (r *SurveyRepository) DeleteSurvey(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	iq := query.Delete(constants.TableSurveyInvitation).
+		Where(constants.FieldSysSurveyInvitation_SurveyID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, iq.SQL, iq.Params...); err != nil {
+		return fmt.Errorf("failed to delete survey invitations: %w", err)
+	}
+	sq := query.Delete(constants.TableSurvey).
+		Where(constants.FieldSysSurvey_ID+" = ?", id).
+		Build()
+	if _, err := tx.ExecContext(ctx, sq.SQL, sq.Params...); err != nil {
+		return fmt.Errorf("failed to delete survey: %w", err)
+	}
+	return tx.Commit()
+}
+
+// CountResponses returns the number of responses to a survey
+func (r *SurveyRepository) CountResponses(ctx context.Context, surveyID string) (int, error) {
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?", constants.TableSurveyResponse, constants.FieldSysSurveyResponse_SurveyID)
+	var count int
+	if err := r.db.QueryRowContext(ctx, sqlStr, surveyID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count survey responses: %w", err)
+	}
+	return count, nil
+}
+
+// ==================== Invitations ====================
+
+// ListInvitations returns the invitations of a survey, most recent first
+func (r *SurveyRepository) ListInvitations(ctx context.Context, surveyID string, limit int) ([]*models.SystemSurveyInvitation, error) {
+	q := query.From(constants.TableSurveyInvitation).
+		Select(surveyInvitationColumns).
+		Where(constants.FieldSysSurveyInvitation_SurveyID+" = ?", surveyID).
+		OrderBy(constants.FieldSysSurveyInvitation_CreatedDate, constants.SortDESC).
+		Limit(limit).
+		Build()
+	return r.queryInvitations(ctx, nil, q)
+}
+
+// GetInvitationByTokenLock returns the invitation with a token hash locked for update within
+// tx, or nil if there is none
+func (r *SurveyRepository) GetInvitationByTokenLock(ctx context.Context, tx *sql.Tx, tokenHash string) (*models.SystemSurveyInvitation, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction required for locking a survey invitation")
+	}
+	return r.getInvitationByToken(ctx, tx, tokenHash, true)
+}
+
+// GetInvitationByToken returns the invitation with a token hash, or nil if there is none
+func (r *SurveyRepository) GetInvitationByToken(ctx context.Context, tokenHash string) (*models.SystemSurveyInvitation, error) {
+	return r.getInvitationByToken(ctx, nil, tokenHash, false)
+}
+
+func (r *SurveyRepository) getInvitationByToken(ctx context.Context, tx *sql.Tx, tokenHash string, lock bool) (*models.SystemSurveyInvitation, error) {
+	q := query.From(constants.TableSurveyInvitation).
+		Select(surveyInvitationColumns).
+		Where(constants.FieldSysSurveyInvitation_TokenHash+" = ?", tokenHash).
+		Limit(1).
+		Build()
+	if lock {
+		q.SQL += " FOR UPDATE"
+	}
+	invitations, err := r.queryInvitations(ctx, tx, q)
+	if err != nil || len(invitations) == 0 {
+		return nil, err
+	}
+	return invitations[0], nil
+}
+
+func (r *SurveyRepository) queryInvitations(ctx context.Context, tx *sql.Tx, q query.QueryResult) ([]*models.SystemSurveyInvitation, error) {
+	rows, err := r.executor(tx).QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query survey invitations: %w", err)
+	}
+	defer rows.Close()
+
+	invitations := make([]*models.SystemSurveyInvitation, 0)
+	for rows.Next() {
+		var i models.SystemSurveyInvitation
+		if err := rows.Scan(&i.ID, &i.SurveyID, &i.TokenHash, &i.ObjectAPIName, &i.RecordID, &i.ContactID,
+			&i.ExpiresDate, &i.RespondedDate, &i.CreatedByID, &i.CreatedDate, &i.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan survey invitation: %w", err)
+		}
+		invitations = append(invitations, &i)
+	}
+	return invitations, rows.Err()
+}
+
+// InsertInvitation stores a new invitation
+func (r *SurveyRepository) InsertInvitation(ctx context.Context, i *models.SystemSurveyInvitation) error {
+	now := time.Now()
+	q := query.Insert(constants.TableSurveyInvitation, map[string]interface{}{
+		constants.FieldSysSurveyInvitation_ID:               i.ID,
+		constants.FieldSysSurveyInvitation_SurveyID:         i.SurveyID,
+		constants.FieldSysSurveyInvitation_TokenHash:        i.TokenHash,
+		constants.FieldSysSurveyInvitation_ObjectAPIName:    ToNullString(i.ObjectAPIName),
+		constants.FieldSysSurveyInvitation_RecordID:         ToNullString(i.RecordID),
+		constants.FieldSysSurveyInvitation_ContactID:        ToNullString(i.ContactID),
+		constants.FieldSysSurveyInvitation_ExpiresDate:      surveyNullTimestamp(i.ExpiresDate),
+		constants.FieldSysSurveyInvitation_CreatedByID:      i.CreatedByID,
+		constants.FieldSysSurveyInvitation_CreatedDate:      surveyTimestamp(now),
+		constants.FieldSysSurveyInvitation_LastModifiedDate: surveyTimestamp(now),
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert survey invitation: %w", err)
+	}
+	i.CreatedDate = now
+	i.LastModifiedDate = now
+	return nil
+}
+
+// ==================== Responses ====================
+
+// Respond stores a response and marks its invitation answered within tx
+func (r *SurveyRepository) Respond(ctx context.Context, tx *sql.Tx, invitation *models.SystemSurveyInvitation, response *models.SystemSurveyResponse) error {
+	now := time.Now()
+	iq := query.Update(constants.TableSurveyInvitation).
+		Set(map[string]interface{}{
+			constants.FieldSysSurveyInvitation_RespondedDate:    surveyTimestamp(response.SubmittedDate),
+			constants.FieldSysSurveyInvitation_LastModifiedDate: surveyTimestamp(now),
+		}).
+		Where(constants.FieldSysSurveyInvitation_ID+" = ?", invitation.ID).
+		Build()
+	if _, err := r.executor(tx).ExecContext(ctx, iq.SQL, iq.Params...); err != nil {
+		return fmt.Errorf("failed to update survey invitation: %w", err)
+	}
+	invitation.RespondedDate = &response.SubmittedDate
+	invitation.LastModifiedDate = now
+
+	q := query.Insert(constants.TableSurveyResponse, map[string]interface{}{
+		constants.FieldSysSurveyResponse_ID:               response.ID,
+		constants.FieldSysSurveyResponse_SurveyID:         response.SurveyID,
+		constants.FieldSysSurveyResponse_InvitationID:     response.InvitationID,
+		constants.FieldSysSurveyResponse_ObjectAPIName:    ToNullString(response.ObjectAPIName),
+		constants.FieldSysSurveyResponse_RecordID:         ToNullString(response.RecordID),
+		constants.FieldSysSurveyResponse_ContactID:        ToNullString(response.ContactID),
+		constants.FieldSysSurveyResponse_Score:            response.Score,
+		constants.FieldSysSurveyResponse_Answers:          nullableJSON(response.Answers),
+		constants.FieldSysSurveyResponse_Comment:          ToNullString(response.Comment),
+		constants.FieldSysSurveyResponse_SubmittedDate:    surveyTimestamp(response.SubmittedDate),
+		constants.FieldSysSurveyResponse_CreatedDate:      surveyTimestamp(now),
+		constants.FieldSysSurveyResponse_LastModifiedDate: surveyTimestamp(now),
+	}).Build()
+	if _, err := r.executor(tx).ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert survey response: %w", err)
+	}
+	response.CreatedDate = now
+	response.LastModifiedDate = now
+	return nil
+}
+
+// ResponseFilter narrows ListResponses and ScoreCounts; empty fields do not filter
+type ResponseFilter struct {
+	SurveyID      string
+	ObjectAPIName string
+	RecordID      string
+	ContactID     string
+	From          *time.Time // Submitted at or after
+	To            *time.Time // Submitted before
+}
+
+// where returns the filter's conditions and their params
+func (f ResponseFilter) where() (string, []interface{}) {
+	conditions := make([]string, 0)
+	params := make([]interface{}, 0)
+	for _, c := range []struct{ field, value string }{
+		{constants.FieldSysSurveyResponse_SurveyID, f.SurveyID},
+		{constants.FieldSysSurveyResponse_ObjectAPIName, f.ObjectAPIName},
+		{constants.FieldSysSurveyResponse_RecordID, f.RecordID},
+		{constants.FieldSysSurveyResponse_ContactID, f.ContactID},
+	} {
+		if c.value != "" {
+			conditions = append(conditions, c.field+" = ?")
+			params = append(params, c.value)
+		}
+	}
+	if f.From != nil {
+		conditions = append(conditions, constants.FieldSysSurveyResponse_SubmittedDate+" >= ?")
+		params = append(params, surveyTimestamp(*f.From))
+	}
+	if f.To != nil {
+		conditions = append(conditions, constants.FieldSysSurveyResponse_SubmittedDate+" < ?")
+		params = append(params, surveyTimestamp(*f.To))
+	}
+	return strings.Join(conditions, " AND "), params
+}
+
+// ListResponses returns the responses matching a filter, most recent first
+func (r *SurveyRepository) ListResponses(ctx context.Context, filter ResponseFilter, limit, offset int) ([]*models.SystemSurveyResponse, error) {
+	conditions, params := filter.where()
+	q := query.From(constants.TableSurveyResponse).
+		Select(surveyResponseColumns).
+		WhereRaw(conditions, params).
+		OrderBy(constants.FieldSysSurveyResponse_SubmittedDate, constants.SortDESC).
+		Limit(limit).
+		Offset(offset).
+		Build()
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query survey responses: %w", err)
+	}
+	defer rows.Close()
+
+	responses := make([]*models.SystemSurveyResponse, 0)
+	for rows.Next() {
+		var resp models.SystemSurveyResponse
+		var answers []byte
+		if err := rows.Scan(&resp.ID, &resp.SurveyID, &resp.InvitationID, &resp.ObjectAPIName, &resp.RecordID, &resp.ContactID,
+			&resp.Score, &answers, &resp.Comment, &resp.SubmittedDate, &resp.CreatedDate, &resp.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan survey response: %w", err)
+		}
+		if len(answers) > 0 {
+			resp.Answers = json.RawMessage(answers)
+		}
+		responses = append(responses, &resp)
+	}
+	return responses, rows.Err()
+}
+
+// DailyScoreCount is the number of responses with a score submitted on a day
+type DailyScoreCount struct {
+	Day   string // YYYY-MM-DD
+	Score int
+	Count int
+}
+
+// ScoreCounts counts the responses matching a filter by day and score, oldest day first
+func (r *SurveyRepository) ScoreCounts(ctx context.Context, filter ResponseFilter) ([]DailyScoreCount, error) {
+	conditions, params := filter.where()
+	where := ""
+	if conditions != "" {
+		where = " WHERE " + conditions
+	}
+	day := fmt.Sprintf("DATE(%s)", constants.FieldSysSurveyResponse_SubmittedDate)
+	sqlStr := fmt.Sprintf("SELECT %s, %s, COUNT(*) FROM %s%s GROUP BY %s, %s ORDER BY %s",
+		day, constants.FieldSysSurveyResponse_Score, constants.TableSurveyResponse, where,
+		day, constants.FieldSysSurveyResponse_Score, day)
+	rows, err := r.db.QueryContext(ctx, sqlStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count survey responses: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]DailyScoreCount, 0)
+	for rows.Next() {
+		var c DailyScoreCount
+		if err := rows.Scan(&c.Day, &c.Score, &c.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan survey response counts: %w", err)
+		}
+		// Drivers return DATE as "YYYY-MM-DD" or as a timestamp at midnight
+		if len(c.Day) > len(time.DateOnly) {
+			c.Day = c.Day[:len(time.DateOnly)]
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
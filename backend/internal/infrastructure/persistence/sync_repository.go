@@ -0,0 +1,387 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// SyncRepository handles database operations for mail/calendar sync connectors and the
+// activities they capture
+type SyncRepository struct {
+	db *sql.DB
+}
+
+// NewSyncRepository creates a new SyncRepository
+func NewSyncRepository(db *sql.DB) *SyncRepository {
+	return &SyncRepository{db: db}
+}
+
+var syncConnectorColumns = []string{
+	constants.FieldSysSyncConnector_ID,
+	constants.FieldSysSyncConnector_UserID,
+	constants.FieldSysSyncConnector_Provider,
+	constants.FieldSysSyncConnector_AccountEmail,
+	constants.FieldSysSyncConnector_AccessToken,
+	constants.FieldSysSyncConnector_RefreshToken,
+	constants.FieldSysSyncConnector_TokenExpiresAt,
+	constants.FieldSysSyncConnector_SyncEmail,
+	constants.FieldSysSyncConnector_SyncCalendar,
+	constants.FieldSysSyncConnector_IsActive,
+	constants.FieldSysSyncConnector_EmailSyncedUntil,
+	constants.FieldSysSyncConnector_CalendarSyncedUntil,
+	constants.FieldSysSyncConnector_LastSyncDate,
+	constants.FieldSysSyncConnector_LastError,
+	constants.FieldSysSyncConnector_CreatedDate,
+	constants.FieldSysSyncConnector_LastModifiedDate,
+}
+
+// ListConnectors queries a user's connectors, or every active connector when userID is
+// empty. Tokens are returned encrypted.
+func (r *SyncRepository) ListConnectors(ctx context.Context, userID string) ([]*models.SystemSyncConnector, error) {
+	b := query.From(constants.TableSyncConnector).Select(syncConnectorColumns)
+	if userID != "" {
+		b = b.Where(constants.FieldSysSyncConnector_UserID+" = ?", userID)
+	} else {
+		b = b.Where(constants.FieldSysSyncConnector_IsActive + " = 1")
+	}
+	q := b.OrderBy(constants.FieldSysSyncConnector_CreatedDate, constants.SortASC).Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sync connectors: %w", err)
+	}
+	defer rows.Close()
+
+	connectors := make([]*models.SystemSyncConnector, 0)
+	for rows.Next() {
+		c, err := scanSyncConnector(rows)
+		if err != nil {
+			return nil, err
+		}
+		connectors = append(connectors, c)
+	}
+	return connectors, rows.Err()
+}
+
+// FindConnector queries a connector by ID, or nil if not found. Tokens are returned encrypted.
+func (r *SyncRepository) FindConnector(ctx context.Context, id string) (*models.SystemSyncConnector, error) {
+	return r.findConnector(ctx, constants.FieldSysSyncConnector_ID+" = ?", id)
+}
+
+// FindConnectorByProvider queries a user's connector for a provider, or nil if not found
+func (r *SyncRepository) FindConnectorByProvider(ctx context.Context, userID string, provider constants.SyncProvider) (*models.SystemSyncConnector, error) {
+	return r.findConnector(ctx,
+		fmt.Sprintf("%s = ? AND %s = ?", constants.FieldSysSyncConnector_UserID, constants.FieldSysSyncConnector_Provider),
+		userID, string(provider))
+}
+
+func (r *SyncRepository) findConnector(ctx context.Context, condition string, params ...interface{}) (*models.SystemSyncConnector, error) {
+	q := query.From(constants.TableSyncConnector).
+		Select(syncConnectorColumns).
+		Where(condition, params...).
+		Limit(1).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sync connector: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanSyncConnector(rows)
+}
+
+func scanSyncConnector(rows *sql.Rows) (*models.SystemSyncConnector, error) {
+	var c models.SystemSyncConnector
+	var refreshToken, lastError sql.NullString
+	var expiresAt, emailUntil, calendarUntil, lastSync sql.NullTime
+	if err := rows.Scan(&c.ID, &c.UserID, &c.Provider, &c.AccountEmail, &c.AccessToken, &refreshToken, &expiresAt,
+		&c.SyncEmail, &c.SyncCalendar, &c.IsActive, &emailUntil, &calendarUntil, &lastSync, &lastError,
+		&c.CreatedDate, &c.LastModifiedDate); err != nil {
+		return nil, fmt.Errorf("failed to scan sync connector: %w", err)
+	}
+	if refreshToken.Valid {
+		c.RefreshToken = &refreshToken.String
+	}
+	if lastError.Valid {
+		c.LastError = &lastError.String
+	}
+	c.TokenExpiresAt = nullTimePtr(expiresAt)
+	c.EmailSyncedUntil = nullTimePtr(emailUntil)
+	c.CalendarSyncedUntil = nullTimePtr(calendarUntil)
+	c.LastSyncDate = nullTimePtr(lastSync)
+	return &c, nil
+}
+
+// InsertConnector inserts a connector; tokens must already be encrypted
+func (r *SyncRepository) InsertConnector(ctx context.Context, c *models.SystemSyncConnector) error {
+	now := time.Now()
+	q := query.Insert(constants.TableSyncConnector, map[string]interface{}{
+		constants.FieldSysSyncConnector_ID:               c.ID,
+		constants.FieldSysSyncConnector_UserID:           c.UserID,
+		constants.FieldSysSyncConnector_Provider:         c.Provider,
+		constants.FieldSysSyncConnector_AccountEmail:     c.AccountEmail,
+		constants.FieldSysSyncConnector_AccessToken:      c.AccessToken,
+		constants.FieldSysSyncConnector_RefreshToken:     c.RefreshToken,
+		constants.FieldSysSyncConnector_TokenExpiresAt:   c.TokenExpiresAt,
+		constants.FieldSysSyncConnector_SyncEmail:        c.SyncEmail,
+		constants.FieldSysSyncConnector_SyncCalendar:     c.SyncCalendar,
+		constants.FieldSysSyncConnector_IsActive:         c.IsActive,
+		constants.FieldSysSyncConnector_CreatedDate:      now,
+		constants.FieldSysSyncConnector_LastModifiedDate: now,
+	}).Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert sync connector: %w", err)
+	}
+	c.CreatedDate = now
+	c.LastModifiedDate = now
+	return nil
+}
+
+// UpdateConnector overwrites a connector's account, tokens and settings; tokens must
+// already be encrypted. The sync cursors are left as they are.
+func (r *SyncRepository) UpdateConnector(ctx context.Context, c *models.SystemSyncConnector) error {
+	now := time.Now()
+	q := query.Update(constants.TableSyncConnector).
+		Set(map[string]interface{}{
+			constants.FieldSysSyncConnector_AccountEmail:     c.AccountEmail,
+			constants.FieldSysSyncConnector_AccessToken:      c.AccessToken,
+			constants.FieldSysSyncConnector_RefreshToken:     c.RefreshToken,
+			constants.FieldSysSyncConnector_TokenExpiresAt:   c.TokenExpiresAt,
+			constants.FieldSysSyncConnector_SyncEmail:        c.SyncEmail,
+			constants.FieldSysSyncConnector_SyncCalendar:     c.SyncCalendar,
+			constants.FieldSysSyncConnector_IsActive:         c.IsActive,
+			constants.FieldSysSyncConnector_LastError:        c.LastError,
+			constants.FieldSysSyncConnector_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysSyncConnector_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update sync connector: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// SaveSyncState records the outcome of a sync run: the cursors reached and the error, if any
+func (r *SyncRepository) SaveSyncState(ctx context.Context, c *models.SystemSyncConnector) error {
+	now := time.Now()
+	q := query.Update(constants.TableSyncConnector).
+		Set(map[string]interface{}{
+			constants.FieldSysSyncConnector_EmailSyncedUntil:    c.EmailSyncedUntil,
+			constants.FieldSysSyncConnector_CalendarSyncedUntil: c.CalendarSyncedUntil,
+			constants.FieldSysSyncConnector_LastSyncDate:        c.LastSyncDate,
+			constants.FieldSysSyncConnector_LastError:           c.LastError,
+			constants.FieldSysSyncConnector_LastModifiedDate:    now,
+		}).
+		Where(constants.FieldSysSyncConnector_ID+" = ?", c.ID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to save sync state: %w", err)
+	}
+	c.LastModifiedDate = now
+	return nil
+}
+
+// DeleteConnector deletes a connector. Activities it captured are kept.
+func (r *SyncRepository) DeleteConnector(ctx context.Context, id string) error {
+	q := query.Delete(constants.TableSyncConnector).
+		Where(constants.FieldSysSyncConnector_ID+" = ?", id).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete sync connector: %w", err)
+	}
+	return nil
+}
+
+var activityColumns = []string{
+	constants.FieldSysActivity_ID,
+	constants.FieldSysActivity_ActivityType,
+	constants.FieldSysActivity_Subject,
+	constants.FieldSysActivity_Description,
+	constants.FieldSysActivity_FromAddress,
+	constants.FieldSysActivity_ToAddresses,
+	constants.FieldSysActivity_ActivityDate,
+	constants.FieldSysActivity_EndDate,
+	constants.FieldSysActivity_ObjectAPIName,
+	constants.FieldSysActivity_RecordID,
+	constants.FieldSysActivity_CallDirection,
+	constants.FieldSysActivity_CallStatus,
+	constants.FieldSysActivity_CallDuration,
+	constants.FieldSysActivity_RecordingURL,
+	constants.FieldSysActivity_ConnectorID,
+	constants.FieldSysActivity_ExternalID,
+	constants.FieldSysActivity_OwnerID,
+	constants.FieldSysActivity_IsDeleted,
+	constants.FieldSysActivity_CreatedDate,
+	constants.FieldSysActivity_LastModifiedDate,
+}
+
+// ActivityKey identifies a captured activity: one external item linked to one record
+func ActivityKey(externalID, recordID string) string {
+	return externalID + "\x00" + recordID
+}
+
+// ExistingActivities returns the ActivityKeys a connector already captured for the given
+// external IDs
+func (r *SyncRepository) ExistingActivities(ctx context.Context, connectorID string, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+	params := make([]interface{}, 0, len(externalIDs)+1)
+	params = append(params, connectorID)
+	for _, id := range externalIDs {
+		params = append(params, id)
+	}
+	q := query.From(constants.TableActivity).
+		Select([]string{constants.FieldSysActivity_ExternalID, constants.FieldSysActivity_RecordID}).
+		WhereRaw(fmt.Sprintf("%s = ? AND %s IN (%s)", constants.FieldSysActivity_ConnectorID, constants.FieldSysActivity_ExternalID,
+			strings.TrimSuffix(strings.Repeat("?, ", len(externalIDs)), ", ")), params).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query activities: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var externalID, recordID string
+		if err := rows.Scan(&externalID, &recordID); err != nil {
+			return nil, fmt.Errorf("failed to scan activity: %w", err)
+		}
+		existing[ActivityKey(externalID, recordID)] = true
+	}
+	return existing, rows.Err()
+}
+
+// InsertActivities inserts activities, skipping any a concurrent run already captured
+func (r *SyncRepository) InsertActivities(ctx context.Context, activities []*models.SystemActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+	now := time.Now()
+	records := make([]map[string]interface{}, len(activities))
+	for i, a := range activities {
+		records[i] = map[string]interface{}{
+			constants.FieldSysActivity_ID:               a.ID,
+			constants.FieldSysActivity_ActivityType:     a.ActivityType,
+			constants.FieldSysActivity_Subject:          a.Subject,
+			constants.FieldSysActivity_Description:      a.Description,
+			constants.FieldSysActivity_FromAddress:      a.FromAddress,
+			constants.FieldSysActivity_ToAddresses:      a.ToAddresses,
+			constants.FieldSysActivity_ActivityDate:     a.ActivityDate,
+			constants.FieldSysActivity_EndDate:          a.EndDate,
+			constants.FieldSysActivity_ObjectAPIName:    a.ObjectAPIName,
+			constants.FieldSysActivity_RecordID:         a.RecordID,
+			constants.FieldSysActivity_CallDirection:    a.CallDirection,
+			constants.FieldSysActivity_CallStatus:       a.CallStatus,
+			constants.FieldSysActivity_CallDuration:     a.CallDuration,
+			constants.FieldSysActivity_RecordingURL:     a.RecordingURL,
+			constants.FieldSysActivity_ConnectorID:      a.ConnectorID,
+			constants.FieldSysActivity_ExternalID:       a.ExternalID,
+			constants.FieldSysActivity_OwnerID:          a.OwnerID,
+			constants.FieldSysActivity_IsDeleted:        false,
+			constants.FieldSysActivity_CreatedDate:      now,
+			constants.FieldSysActivity_LastModifiedDate: now,
+		}
+		a.CreatedDate = now
+		a.LastModifiedDate = now
+	}
+	stmt, params := query.BulkInsertOrdered(constants.TableActivity, activityColumns, records)
+	stmt += " " + query.ActiveDialect().Upsert(
+		[]string{constants.FieldSysActivity_ConnectorID, constants.FieldSysActivity_ExternalID, constants.FieldSysActivity_RecordID}, nil,
+		fmt.Sprintf("%s = %s", constants.FieldSysActivity_ConnectorID, constants.FieldSysActivity_ConnectorID))
+	if _, err := r.db.ExecContext(ctx, stmt, params...); err != nil {
+		return fmt.Errorf("failed to insert activities: %w", err)
+	}
+	return nil
+}
+
+// UpdateCall records the progress of a call on every activity captured for it. Unset fields
+// are left as they are.
+func (r *SyncRepository) UpdateCall(ctx context.Context, connectorID, externalID string, status string, duration *int, endDate *time.Time, recordingURL *string) error {
+	set := map[string]interface{}{
+		constants.FieldSysActivity_CallStatus:       status,
+		constants.FieldSysActivity_LastModifiedDate: time.Now(),
+	}
+	if duration != nil {
+		set[constants.FieldSysActivity_CallDuration] = *duration
+	}
+	if endDate != nil {
+		set[constants.FieldSysActivity_EndDate] = *endDate
+	}
+	if recordingURL != nil {
+		set[constants.FieldSysActivity_RecordingURL] = *recordingURL
+	}
+	q := query.Update(constants.TableActivity).
+		Set(set).
+		Where(fmt.Sprintf("%s = ? AND %s = ?", constants.FieldSysActivity_ConnectorID, constants.FieldSysActivity_ExternalID), connectorID, externalID).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to update call: %w", err)
+	}
+	return nil
+}
+
+// ListActivities queries the activities linked to a record, newest first
+func (r *SyncRepository) ListActivities(ctx context.Context, objectAPIName, recordID string, limit int) ([]*models.SystemActivity, error) {
+	q := query.From(constants.TableActivity).
+		Select(activityColumns).
+		Where(fmt.Sprintf("%s = ? AND %s = ?", constants.FieldSysActivity_ObjectAPIName, constants.FieldSysActivity_RecordID), objectAPIName, recordID).
+		ExcludeDeleted().
+		OrderBy(constants.FieldSysActivity_ActivityDate, constants.SortDESC).
+		Limit(limit).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query activities: %w", err)
+	}
+	defer rows.Close()
+
+	activities := make([]*models.SystemActivity, 0)
+	for rows.Next() {
+		var a models.SystemActivity
+		var subject, description, from, to, direction, status, recordingURL sql.NullString
+		var duration sql.NullInt64
+		var endDate sql.NullTime
+		if err := rows.Scan(&a.ID, &a.ActivityType, &subject, &description, &from, &to, &a.ActivityDate, &endDate,
+			&a.ObjectAPIName, &a.RecordID, &direction, &status, &duration, &recordingURL, &a.ConnectorID, &a.ExternalID, &a.OwnerID, &a.IsDeleted,
+			&a.CreatedDate, &a.LastModifiedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan activity: %w", err)
+		}
+		a.Subject = nullStringPtr(subject)
+		a.Description = nullStringPtr(description)
+		a.FromAddress = nullStringPtr(from)
+		a.ToAddresses = nullStringPtr(to)
+		a.EndDate = nullTimePtr(endDate)
+		a.CallDirection = nullStringPtr(direction)
+		a.CallStatus = nullStringPtr(status)
+		a.RecordingURL = nullStringPtr(recordingURL)
+		if duration.Valid {
+			seconds := int(duration.Int64)
+			a.CallDuration = &seconds
+		}
+		activities = append(activities, &a)
+	}
+	return activities, rows.Err()
+}
+
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
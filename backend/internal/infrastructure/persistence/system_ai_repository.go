@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// GetAIContextItems returns the files pinned to a user's agent context
+func (r *SystemRepository) GetAIContextItems(ctx context.Context, userID string) ([]*models.SystemAIContextItem, error) {
+	q := query.From(constants.TableAIContextItem).
+		Select([]string{
+			constants.FieldSysAIContextItem_ID,
+			constants.FieldSysAIContextItem_UserID,
+			constants.FieldSysAIContextItem_Path,
+			constants.FieldSysAIContextItem_Content,
+			constants.FieldSysAIContextItem_TokenSize,
+			constants.FieldSysAIContextItem_CreatedDate,
+			constants.FieldSysAIContextItem_LastModifiedDate,
+		}).
+		Where(constants.FieldSysAIContextItem_UserID+" = ?", userID).
+		OrderBy(constants.FieldSysAIContextItem_Path, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*models.SystemAIContextItem, 0)
+	for rows.Next() {
+		var item models.SystemAIContextItem
+		var content *string
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Path, &content, &item.TokenSize, &item.CreatedDate, &item.LastModifiedDate); err != nil {
+			return nil, err
+		}
+		if content != nil {
+			item.Content = *content
+		}
+		items = append(items, &item)
+	}
+	return items, rows.Err()
+}
+
+// UpsertAIContextItem pins a file to a user's agent context, replacing the content of a
+// file already pinned under the same path
+func (r *SystemRepository) UpsertAIContextItem(ctx context.Context, item *models.SystemAIContextItem) error {
+	now := time.Now()
+
+	q := query.Update(constants.TableAIContextItem).
+		Set(map[string]interface{}{
+			constants.FieldSysAIContextItem_Content:          item.Content,
+			constants.FieldSysAIContextItem_TokenSize:        item.TokenSize,
+			constants.FieldSysAIContextItem_LastModifiedDate: now,
+		}).
+		Where(constants.FieldSysAIContextItem_UserID+" = ?", item.UserID).
+		Where(constants.FieldSysAIContextItem_Path+" = ?", item.Path).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return fmt.Errorf("failed to update context item: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected > 0 {
+		return nil
+	}
+
+	q = query.Insert(constants.TableAIContextItem, map[string]interface{}{
+		constants.FieldSysAIContextItem_ID:               item.ID,
+		constants.FieldSysAIContextItem_UserID:           item.UserID,
+		constants.FieldSysAIContextItem_Path:             item.Path,
+		constants.FieldSysAIContextItem_Content:          item.Content,
+		constants.FieldSysAIContextItem_TokenSize:        item.TokenSize,
+		constants.FieldSysAIContextItem_CreatedDate:      now,
+		constants.FieldSysAIContextItem_LastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert context item: %w", err)
+	}
+	return nil
+}
+
+// DeleteAIContextItem unpins one file from a user's agent context
+func (r *SystemRepository) DeleteAIContextItem(ctx context.Context, userID, path string) error {
+	q := query.Delete(constants.TableAIContextItem).
+		Where(constants.FieldSysAIContextItem_UserID+" = ?", userID).
+		Where(constants.FieldSysAIContextItem_Path+" = ?", path).
+		Build()
+
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// ClearAIContextItems unpins every file from a user's agent context
+func (r *SystemRepository) ClearAIContextItems(ctx context.Context, userID string) error {
+	q := query.Delete(constants.TableAIContextItem).
+		Where(constants.FieldSysAIContextItem_UserID+" = ?", userID).
+		Build()
+
+	_, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// PurgeAIHistory permanently deletes agent conversations and context items not modified
+// since before, returning how many rows were removed
+func (r *SystemRepository) PurgeAIHistory(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for _, table := range []string{constants.TableAIConversation, constants.TableAIContextItem} {
+		q := query.Delete(table).
+			Where(constants.FieldLastModifiedDate+" < ?", before).
+			Build()
+
+		res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+		if err != nil {
+			return purged, fmt.Errorf("failed to purge %s: %w", table, err)
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			purged += n
+		}
+	}
+	return purged, nil
+}
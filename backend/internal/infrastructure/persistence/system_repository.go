@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/nexuscrm/shared/pkg/constants"
@@ -55,6 +56,11 @@ func (r *SystemRepository) GetLogs(ctx context.Context, limit int) ([]*models.Sy
 
 // GetRecentItems retrieves recently viewed items for a user
 func (r *SystemRepository) GetRecentItems(ctx context.Context, userID string, limit int) ([]*models.SystemRecent, error) {
+	return r.GetRecentItemsByObject(ctx, userID, "", limit)
+}
+
+// GetRecentItemsByObject retrieves recently viewed items for a user, optionally restricted to one object
+func (r *SystemRepository) GetRecentItemsByObject(ctx context.Context, userID, objectName string, limit int) ([]*models.SystemRecent, error) {
 	if userID == "" {
 		return []*models.SystemRecent{}, nil
 	}
@@ -63,9 +69,13 @@ func (r *SystemRepository) GetRecentItems(ctx context.Context, userID string, li
 		limit = 10
 	}
 
-	q := query.From(constants.TableRecent).
+	builder := query.From(constants.TableRecent).
 		Select([]string{constants.FieldID, constants.FieldUserID, constants.FieldObjectAPIName, constants.FieldRecordID, constants.FieldRecordName, constants.FieldTimestamp}).
-		Where(constants.FieldUserID+" = ?", userID).
+		Where(constants.FieldUserID+" = ?", userID)
+	if objectName != "" {
+		builder = builder.Where(constants.FieldObjectAPIName+" = ?", objectName)
+	}
+	q := builder.
 		OrderBy(constants.FieldTimestamp, constants.SortDESC).
 		Limit(limit).
 		Build()
@@ -90,36 +100,79 @@ func (r *SystemRepository) GetRecentItems(ctx context.Context, userID string, li
 	return items, nil
 }
 
-// GetConfig retrieves a system configuration value
-func (r *SystemRepository) GetConfig(ctx context.Context, key string) (*string, error) {
-	q := query.From(constants.TableConfig).
-		Select([]string{constants.FieldValue}).
-		Where(constants.FieldKeyName+" = ?", key).
-		Limit(1).
+// UpsertRecentItem records a view of a record, refreshing the existing entry for the same
+// user and record instead of appending a duplicate row
+func (r *SystemRepository) UpsertRecentItem(ctx context.Context, item *models.SystemRecent) error {
+	now := time.Now()
+
+	q := query.Update(constants.TableRecent).
+		Set(map[string]interface{}{
+			constants.FieldRecordName:       item.RecordName,
+			constants.FieldTimestamp:        now,
+			constants.FieldLastModifiedDate: now,
+		}).
+		Where(constants.FieldUserID+" = ?", item.UserID).
+		Where(constants.FieldRecordID+" = ?", item.RecordID).
+		Where(constants.FieldObjectAPIName+" = ?", item.ObjectAPIName).
 		Build()
 
-	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to update recent item: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected > 0 {
+		return nil
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		var value string
-		if err := rows.Scan(&value); err != nil {
-			return nil, err
-		}
-		return &value, nil
+	q = query.Insert(constants.TableRecent, map[string]interface{}{
+		constants.FieldID:               item.ID,
+		constants.FieldUserID:           item.UserID,
+		constants.FieldObjectAPIName:    item.ObjectAPIName,
+		constants.FieldRecordID:         item.RecordID,
+		constants.FieldRecordName:       item.RecordName,
+		constants.FieldTimestamp:        now,
+		constants.FieldCreatedDate:      now,
+		constants.FieldLastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert recent item: %w", err)
+	}
+	return nil
+}
+
+// PruneRecentItems keeps only the newest keep entries for a user
+func (r *SystemRepository) PruneRecentItems(ctx context.Context, userID string, keep int) error {
+	if userID == "" || keep <= 0 {
+		return nil
+	}
+
+	// Find the timestamp of the oldest entry that survives
+	cutoffSQL := fmt.Sprintf("%s %s %s %s WHERE %s = ? %s %s %s %s 1 OFFSET ?",
+		KeywordSelect, constants.FieldTimestamp, KeywordFrom, constants.TableRecent,
+		constants.FieldUserID, KeywordOrderBy, constants.FieldTimestamp, KeywordDesc, KeywordLimit)
+
+	var cutoff time.Time
+	err := r.db.QueryRowContext(ctx, cutoffSQL, userID, keep-1).Scan(&cutoff)
+	if err == sql.ErrNoRows {
+		return nil // Fewer than keep entries
 	}
+	if err != nil {
+		return err
+	}
+
+	q := query.Delete(constants.TableRecent).
+		Where(constants.FieldUserID+" = ?", userID).
+		Where(constants.FieldTimestamp+" < ?", cutoff).
+		Build()
 
-	return nil, nil
+	_, err = r.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
 }
 
-// GetAllConfigs retrieves all system configurations
+// GetAllConfigs retrieves every _System_Config entry
 func (r *SystemRepository) GetAllConfigs(ctx context.Context) ([]*models.SystemConfig, error) {
-	q := query.From(constants.TableConfig).
-		Select([]string{constants.FieldKeyName, constants.FieldValue, constants.FieldIsSecret, constants.FieldDescription}).
-		Build()
+	q := selectConfig().Build()
 
 	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
 	if err != nil {
@@ -129,34 +182,112 @@ func (r *SystemRepository) GetAllConfigs(ctx context.Context) ([]*models.SystemC
 
 	configs := make([]*models.SystemConfig, 0)
 	for rows.Next() {
-		var config models.SystemConfig
-		var isSecret int // TiDB/MySQL boolean is tinyint
-		var description *string
-
-		if err := rows.Scan(&config.KeyName, &config.Value, &isSecret, &description); err != nil {
+		config, err := scanConfig(rows)
+		if err != nil {
 			continue
 		}
+		configs = append(configs, config)
+	}
 
-		config.IsSecret = isSecret != 0
-		if description != nil {
-			config.Description = *description
-		}
+	return configs, nil
+}
+
+// GetConfig retrieves one _System_Config entry, or nil when the key does not exist
+func (r *SystemRepository) GetConfig(ctx context.Context, keyName string) (*models.SystemConfig, error) {
+	q := selectConfig().
+		Where(constants.FieldKeyName+" = ?", keyName).
+		Build()
 
-		configs = append(configs, &config)
+	config, err := scanConfig(r.db.QueryRowContext(ctx, q.SQL, q.Params...))
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config %s: %w", keyName, err)
+	}
+	return config, nil
+}
 
-	return configs, nil
+// UpsertConfig creates or replaces a _System_Config entry
+func (r *SystemRepository) UpsertConfig(ctx context.Context, config *models.SystemConfig) error {
+	now := time.Now()
+
+	q := query.Update(constants.TableConfig).
+		Set(map[string]interface{}{
+			constants.FieldValue:            config.Value,
+			constants.FieldIsSecret:         config.IsSecret,
+			constants.FieldDescription:      config.Description,
+			constants.FieldLastModifiedDate: now,
+		}).
+		Where(constants.FieldKeyName+" = ?", config.KeyName).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return fmt.Errorf("failed to update config %s: %w", config.KeyName, err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected > 0 {
+		return nil
+	}
+
+	q = query.Insert(constants.TableConfig, map[string]interface{}{
+		constants.FieldKeyName:          config.KeyName,
+		constants.FieldValue:            config.Value,
+		constants.FieldIsSecret:         config.IsSecret,
+		constants.FieldDescription:      config.Description,
+		constants.FieldCreatedDate:      now,
+		constants.FieldLastModifiedDate: now,
+	}).Build()
+
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to insert config %s: %w", config.KeyName, err)
+	}
+	return nil
 }
 
-// CheckConfigExists checks if a config key exists and returns the key if found
-func (r *SystemRepository) CheckConfigExists(ctx context.Context, key string) (bool, error) {
-	var exists int
-	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE %s = ?)", constants.TableConfig, constants.FieldKeyName)
-	err := r.db.QueryRowContext(ctx, query, key).Scan(&exists)
+// DeleteConfig removes a _System_Config entry, reporting whether it existed
+func (r *SystemRepository) DeleteConfig(ctx context.Context, keyName string) (bool, error) {
+	q := query.Delete(constants.TableConfig).
+		Where(constants.FieldKeyName+" = ?", keyName).
+		Build()
+
+	res, err := r.db.ExecContext(ctx, q.SQL, q.Params...)
 	if err != nil {
-		return false, err
+		return false, fmt.Errorf("failed to delete config %s: %w", keyName, err)
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+var configColumns = []string{constants.FieldKeyName, constants.FieldValue, constants.FieldIsSecret, constants.FieldDescription}
+
+// selectConfig starts a query of configColumns. _System_Config is keyed by key_name and has
+// no __sys_gen_id column, which Select always adds.
+func selectConfig() *query.Builder {
+	q := query.From(constants.TableConfig)
+	for _, column := range configColumns {
+		q.AddSelectRaw(fmt.Sprintf("`%s`", column))
+	}
+	return q
+}
+
+func scanConfig(row interface{ Scan(...interface{}) error }) (*models.SystemConfig, error) {
+	var config models.SystemConfig
+	var value, description *string
+	var isSecret int // TiDB/MySQL boolean is tinyint
+
+	if err := row.Scan(&config.KeyName, &value, &isSecret, &description); err != nil {
+		return nil, err
 	}
-	return exists == 1, nil
+
+	config.IsSecret = isSecret != 0
+	if value != nil {
+		config.Value = *value
+	}
+	if description != nil {
+		config.Description = *description
+	}
+	return &config, nil
 }
 
 // CheckProfileExistsByName checks existence by name and returns ID if found
@@ -202,69 +333,21 @@ func (r *SystemRepository) BatchUpsertProfiles(ctx context.Context, profiles []m
 		args = append(args, p.ID, p.Name, p.Description, p.IsActive, p.IsSystem)
 	}
 
-	query := fmt.Sprintf(
-		"%s %s (%s, %s, %s, %s, %s, %s, %s) %s %s %s %s=VALUES(%s), %s=VALUES(%s), %s=VALUES(%s), %s=VALUES(%s), %s=%s",
+	stmt := fmt.Sprintf(
+		"%s %s (%s, %s, %s, %s, %s, %s, %s) %s %s %s",
 		KeywordInsertInto, constants.TableProfile,
 		constants.FieldID, constants.FieldSysProfile_Name, constants.FieldSysProfile_Description,
 		constants.FieldSysProfile_IsActive, constants.FieldSysProfile_IsSystem,
 		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
 		KeywordValues, strings.Join(values, ","),
-		KeywordOnDuplicate,
-		constants.FieldID, constants.FieldID, // Updates ID
-		constants.FieldSysProfile_Description, constants.FieldSysProfile_Description,
-		constants.FieldSysProfile_IsActive, constants.FieldSysProfile_IsActive,
-		constants.FieldSysProfile_IsSystem, constants.FieldSysProfile_IsSystem,
-		constants.FieldLastModifiedDate, FuncCurrentTimestamp,
+		query.ActiveDialect().Upsert([]string{constants.FieldSysProfile_Name}, []string{
+			constants.FieldID, // Updates ID
+			constants.FieldSysProfile_Description, constants.FieldSysProfile_IsActive, constants.FieldSysProfile_IsSystem,
+		}, fmt.Sprintf("%s=%s", constants.FieldLastModifiedDate, FuncCurrentTimestamp)),
 	)
 
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := r.db.ExecContext(ctx, stmt, args...); err != nil {
 		return fmt.Errorf("batch upsert profiles failed: %w", err)
 	}
 	return nil
 }
-
-// BatchUpsertUsers inserts multiple users using direct SQL
-func (r *SystemRepository) BatchUpsertUsers(ctx context.Context, users []models.SystemUser) error {
-	if len(users) == 0 {
-		return nil
-	}
-
-	values := []string{}
-	args := []interface{}{}
-
-	for _, u := range users {
-		values = append(values, fmt.Sprintf("(?, ?, ?, ?, ?, ?, ?, ?, %s, %s)", FuncCurrentTimestamp, FuncCurrentTimestamp))
-		// ID, Username, Email, Password, FirstName, LastName, ProfileID, IsActive
-		args = append(args, u.ID, u.Email, u.Email, u.Password, u.FirstName, u.LastName, u.ProfileID, true)
-	}
-
-	cols := strings.Join([]string{
-		constants.FieldID, constants.FieldSysUser_Username, constants.FieldSysUser_Email,
-		constants.FieldSysUser_Password, constants.FieldSysUser_FirstName, constants.FieldSysUser_LastName,
-		constants.FieldSysUser_ProfileID, constants.FieldSysUser_IsActive,
-		constants.FieldCreatedDate, constants.FieldLastModifiedDate,
-	}, ", ")
-
-	query := fmt.Sprintf(
-		`%s %s (%s) 
-		%s %s 
-		%s 
-		%s=VALUES(%s), %s=VALUES(%s), %s=VALUES(%s), 
-		%s=VALUES(%s), %s=VALUES(%s), %s=VALUES(%s), %s=%s`,
-		KeywordInsertInto, constants.TableUser, cols,
-		KeywordValues, strings.Join(values, ","),
-		KeywordOnDuplicate,
-		constants.FieldSysUser_Username, constants.FieldSysUser_Username,
-		constants.FieldSysUser_Password, constants.FieldSysUser_Password,
-		constants.FieldSysUser_FirstName, constants.FieldSysUser_FirstName,
-		constants.FieldSysUser_LastName, constants.FieldSysUser_LastName,
-		constants.FieldSysUser_ProfileID, constants.FieldSysUser_ProfileID,
-		constants.FieldSysUser_IsActive, constants.FieldSysUser_IsActive,
-		constants.FieldLastModifiedDate, FuncCurrentTimestamp,
-	)
-
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("batch upsert users failed: %w", err)
-	}
-	return nil
-}
@@ -0,0 +1,343 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// TimelineRepository reads the rows a record's timeline merges: comments, field history,
+// activities, approval work items, flow runs and linked tasks. Every source is read newest
+// first from an optional cursor, so the service can merge them page by page.
+type TimelineRepository struct {
+	db *sql.DB
+}
+
+// NewTimelineRepository creates a new TimelineRepository
+func NewTimelineRepository(db *sql.DB) *TimelineRepository {
+	return &TimelineRepository{db: db}
+}
+
+// TimelineCursor is the position of the last entry of a timeline page; the next page starts
+// with the entries strictly before it (older, or as old with a lower ID)
+type TimelineCursor struct {
+	Date time.Time
+	ID   string
+}
+
+// timelineQuery selects one source of a timeline; columns and conditions refer to the
+// source table as t
+type timelineQuery struct {
+	table      string
+	dateColumn string
+	columns    []string
+	joins      string
+	conditions []string
+	params     []interface{}
+}
+
+func (r *TimelineRepository) query(ctx context.Context, q timelineQuery, cursor *TimelineCursor, limit int, scan func(*sql.Rows) (*models.TimelineEntry, error)) ([]*models.TimelineEntry, error) {
+	conditions := q.conditions
+	params := q.params
+	if cursor != nil {
+		conditions = append(conditions, fmt.Sprintf("(t.`%s` < ? OR (t.`%s` = ? AND t.`%s` < ?))", q.dateColumn, q.dateColumn, constants.FieldID))
+		params = append(params, cursor.Date, cursor.Date, cursor.ID)
+	}
+	sqlStr := fmt.Sprintf("SELECT t.`%s`, t.`%s`, %s FROM `%s` t%s WHERE %s ORDER BY t.`%s` DESC, t.`%s` DESC LIMIT %d",
+		constants.FieldID, q.dateColumn, strings.Join(q.columns, ", "), q.table, q.joins,
+		strings.Join(conditions, " AND "), q.dateColumn, constants.FieldID, limit)
+
+	rows, err := r.db.QueryContext(ctx, sqlStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query timeline from %s: %w", q.table, err)
+	}
+	defer rows.Close()
+
+	entries := make([]*models.TimelineEntry, 0)
+	for rows.Next() {
+		entry, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan timeline entry from %s: %w", q.table, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// timelineRecordConditions matches the rows of a system table about a record
+func timelineRecordConditions(objectField, recordField, objectAPIName, recordID string) ([]string, []interface{}) {
+	return []string{fmt.Sprintf("t.`%s` = ?", objectField), fmt.Sprintf("t.`%s` = ?", recordField)},
+		[]interface{}{objectAPIName, recordID}
+}
+
+// timelineDetails drops the unset values of a timeline entry's details and dereferences the rest
+func timelineDetails(values map[string]interface{}) map[string]interface{} {
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+			delete(values, key)
+		case *string:
+			if v == nil {
+				delete(values, key)
+			} else {
+				values[key] = *v
+			}
+		case *time.Time:
+			if v == nil {
+				delete(values, key)
+			} else {
+				values[key] = *v
+			}
+		case *int:
+			if v == nil {
+				delete(values, key)
+			} else {
+				values[key] = *v
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// Comments returns the comments on a record
+func (r *TimelineRepository) Comments(ctx context.Context, objectAPIName, recordID string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	conditions, params := timelineRecordConditions(constants.FieldSysComment_ObjectAPIName, constants.FieldSysComment_RecordID, objectAPIName, recordID)
+	q := timelineQuery{
+		table:      constants.TableComment,
+		dateColumn: constants.FieldCreatedDate,
+		columns: []string{
+			"t.`" + constants.FieldSysComment_Body + "`",
+			"t.`" + constants.FieldCreatedByID + "`",
+			"t.`" + constants.FieldSysComment_ParentCommentID + "`",
+			"t.`" + constants.FieldSysComment_IsResolved + "`",
+		},
+		conditions: append(conditions, fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse)),
+		params:     params,
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{Type: constants.TimelineEntryComment}
+		var body, author, parent sql.NullString
+		var resolved bool
+		if err := rows.Scan(&e.ID, &e.Date, &body, &author, &parent, &resolved); err != nil {
+			return nil, err
+		}
+		e.Body = nullStringPtr(body)
+		e.ActorID = nullStringPtr(author)
+		e.Details = timelineDetails(map[string]interface{}{
+			constants.FieldSysComment_ParentCommentID: nullStringPtr(parent),
+			constants.FieldSysComment_IsResolved:      resolved,
+		})
+		return e, nil
+	})
+}
+
+// FieldChanges returns the field history of a record, leaving out hiddenFields
+func (r *TimelineRepository) FieldChanges(ctx context.Context, objectAPIName, recordID string, hiddenFields []string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	conditions, params := timelineRecordConditions(constants.FieldSysAuditLog_ObjectAPIName, constants.FieldSysAuditLog_RecordID, objectAPIName, recordID)
+	if len(hiddenFields) > 0 {
+		placeholders, hidden := inPlaceholders(hiddenFields)
+		conditions = append(conditions, fmt.Sprintf("t.`%s` NOT IN (%s)", constants.FieldSysAuditLog_FieldName, placeholders))
+		params = append(params, hidden...)
+	}
+	q := timelineQuery{
+		table:      constants.TableAuditLog,
+		dateColumn: constants.FieldSysAuditLog_ChangedAt,
+		columns: []string{
+			"t.`" + constants.FieldSysAuditLog_FieldName + "`",
+			"t.`" + constants.FieldSysAuditLog_OldValue + "`",
+			"t.`" + constants.FieldSysAuditLog_NewValue + "`",
+			"t.`" + constants.FieldSysAuditLog_ChangedByID + "`",
+		},
+		conditions: conditions,
+		params:     params,
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{Type: constants.TimelineEntryFieldChange}
+		var field string
+		var oldValue, newValue, changedBy sql.NullString
+		if err := rows.Scan(&e.ID, &e.Date, &field, &oldValue, &newValue, &changedBy); err != nil {
+			return nil, err
+		}
+		e.Title = field
+		e.ActorID = nullStringPtr(changedBy)
+		e.Details = timelineDetails(map[string]interface{}{
+			constants.FieldSysAuditLog_FieldName: field,
+			constants.FieldSysAuditLog_OldValue:  nullStringPtr(oldValue),
+			constants.FieldSysAuditLog_NewValue:  nullStringPtr(newValue),
+		})
+		return e, nil
+	})
+}
+
+// Activities returns the emails, events and calls linked to a record, of the given
+// activity types (Email, Event, Call)
+func (r *TimelineRepository) Activities(ctx context.Context, objectAPIName, recordID string, activityTypes []string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	conditions, params := timelineRecordConditions(constants.FieldSysActivity_ObjectAPIName, constants.FieldSysActivity_RecordID, objectAPIName, recordID)
+	placeholders, types := inPlaceholders(activityTypes)
+	conditions = append(conditions,
+		fmt.Sprintf("t.`%s` IN (%s)", constants.FieldSysActivity_ActivityType, placeholders),
+		fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse))
+	q := timelineQuery{
+		table:      constants.TableActivity,
+		dateColumn: constants.FieldSysActivity_ActivityDate,
+		columns: []string{
+			"t.`" + constants.FieldSysActivity_ActivityType + "`",
+			"t.`" + constants.FieldSysActivity_Subject + "`",
+			"t.`" + constants.FieldSysActivity_Description + "`",
+			"t.`" + constants.FieldSysActivity_FromAddress + "`",
+			"t.`" + constants.FieldSysActivity_ToAddresses + "`",
+			"t.`" + constants.FieldSysActivity_EndDate + "`",
+			"t.`" + constants.FieldSysActivity_CallDirection + "`",
+			"t.`" + constants.FieldSysActivity_CallStatus + "`",
+			"t.`" + constants.FieldSysActivity_CallDuration + "`",
+			"t.`" + constants.FieldSysActivity_RecordingURL + "`",
+			"t.`" + constants.FieldOwnerID + "`",
+		},
+		conditions: conditions,
+		params:     append(params, types...),
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{}
+		var activityType string
+		var subject, description, from, to, direction, status, recordingURL, owner sql.NullString
+		var endDate sql.NullTime
+		var duration sql.NullInt64
+		if err := rows.Scan(&e.ID, &e.Date, &activityType, &subject, &description, &from, &to, &endDate,
+			&direction, &status, &duration, &recordingURL, &owner); err != nil {
+			return nil, err
+		}
+		e.Type = strings.ToLower(activityType)
+		e.Title = subject.String
+		e.Body = nullStringPtr(description)
+		e.ActorID = nullStringPtr(owner)
+		var seconds *int
+		if duration.Valid {
+			d := int(duration.Int64)
+			seconds = &d
+		}
+		e.Details = timelineDetails(map[string]interface{}{
+			constants.FieldSysActivity_FromAddress:   nullStringPtr(from),
+			constants.FieldSysActivity_ToAddresses:   nullStringPtr(to),
+			constants.FieldSysActivity_EndDate:       nullTimePtr(endDate),
+			constants.FieldSysActivity_CallDirection: nullStringPtr(direction),
+			constants.FieldSysActivity_CallStatus:    nullStringPtr(status),
+			constants.FieldSysActivity_CallDuration:  seconds,
+			constants.FieldSysActivity_RecordingURL:  nullStringPtr(recordingURL),
+		})
+		return e, nil
+	})
+}
+
+// Approvals returns the approval work items of a record with the name of their process
+func (r *TimelineRepository) Approvals(ctx context.Context, objectAPIName, recordID string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	conditions, params := timelineRecordConditions(constants.FieldSysApprovalWorkItem_ObjectAPIName, constants.FieldSysApprovalWorkItem_RecordID, objectAPIName, recordID)
+	q := timelineQuery{
+		table:      constants.TableApprovalWorkItem,
+		dateColumn: constants.FieldSysApprovalWorkItem_SubmittedDate,
+		columns: []string{
+			"p.`" + constants.FieldSysApprovalProcess_Name + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_ProcessID + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_Status + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_SubmittedByID + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_ApproverID + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_ApprovedByID + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_ApprovedDate + "`",
+			"t.`" + constants.FieldSysApprovalWorkItem_Comments + "`",
+		},
+		joins: fmt.Sprintf(" LEFT JOIN `%s` p ON p.`%s` = t.`%s`",
+			constants.TableApprovalProcess, constants.FieldID, constants.FieldSysApprovalWorkItem_ProcessID),
+		conditions: append(conditions, fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse)),
+		params:     params,
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{Type: constants.TimelineEntryApproval}
+		var process, submittedBy, approver, approvedBy, comments sql.NullString
+		var processID, status string
+		var approvedDate sql.NullTime
+		if err := rows.Scan(&e.ID, &e.Date, &process, &processID, &status, &submittedBy, &approver, &approvedBy, &approvedDate, &comments); err != nil {
+			return nil, err
+		}
+		e.Title = process.String
+		e.Body = nullStringPtr(comments)
+		e.ActorID = nullStringPtr(submittedBy)
+		e.Details = timelineDetails(map[string]interface{}{
+			constants.FieldSysApprovalWorkItem_ProcessID:    processID,
+			constants.FieldSysApprovalWorkItem_Status:       status,
+			constants.FieldSysApprovalWorkItem_ApproverID:   nullStringPtr(approver),
+			constants.FieldSysApprovalWorkItem_ApprovedByID: nullStringPtr(approvedBy),
+			constants.FieldSysApprovalWorkItem_ApprovedDate: nullTimePtr(approvedDate),
+		})
+		return e, nil
+	})
+}
+
+// FlowRuns returns the flow instances started for a record with the name of their flow
+func (r *TimelineRepository) FlowRuns(ctx context.Context, objectAPIName, recordID string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	conditions, params := timelineRecordConditions(constants.FieldSysFlowInstance_ObjectAPIName, constants.FieldSysFlowInstance_RecordID, objectAPIName, recordID)
+	q := timelineQuery{
+		table:      constants.TableFlowInstance,
+		dateColumn: constants.FieldSysFlowInstance_StartedDate,
+		columns: []string{
+			"f.`" + constants.FieldSysFlow_Name + "`",
+			"t.`" + constants.FieldSysFlowInstance_FlowID + "`",
+			"t.`" + constants.FieldSysFlowInstance_Status + "`",
+			"t.`" + constants.FieldSysFlowInstance_PausedDate + "`",
+			"t.`" + constants.FieldSysFlowInstance_CompletedDate + "`",
+			"t.`" + constants.FieldCreatedByID + "`",
+		},
+		joins: fmt.Sprintf(" LEFT JOIN `%s` f ON f.`%s` = t.`%s`",
+			constants.TableFlow, constants.FieldID, constants.FieldSysFlowInstance_FlowID),
+		conditions: append(conditions, fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse)),
+		params:     params,
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{Type: constants.TimelineEntryFlow}
+		var flow, createdBy sql.NullString
+		var flowID, status string
+		var paused, completed sql.NullTime
+		if err := rows.Scan(&e.ID, &e.Date, &flow, &flowID, &status, &paused, &completed, &createdBy); err != nil {
+			return nil, err
+		}
+		e.Title = flow.String
+		e.ActorID = nullStringPtr(createdBy)
+		e.Details = timelineDetails(map[string]interface{}{
+			constants.FieldSysFlowInstance_FlowID:        flowID,
+			constants.FieldSysFlowInstance_Status:        status,
+			constants.FieldSysFlowInstance_PausedDate:    nullTimePtr(paused),
+			constants.FieldSysFlowInstance_CompletedDate: nullTimePtr(completed),
+		})
+		return e, nil
+	})
+}
+
+// Tasks returns the IDs and creation dates of the records of a task object whose
+// linkField points at a record; the caller loads them with the user's access
+func (r *TimelineRepository) Tasks(ctx context.Context, taskObject, linkField, recordID string, cursor *TimelineCursor, limit int) ([]*models.TimelineEntry, error) {
+	q := timelineQuery{
+		table:      taskObject,
+		dateColumn: constants.FieldCreatedDate,
+		columns:    []string{"t.`" + constants.FieldOwnerID + "`"},
+		conditions: []string{
+			fmt.Sprintf("t.`%s` = ?", linkField),
+			fmt.Sprintf("t.`%s` = %d", constants.FieldIsDeleted, constants.IsDeletedFalse),
+		},
+		params: []interface{}{recordID},
+	}
+	return r.query(ctx, q, cursor, limit, func(rows *sql.Rows) (*models.TimelineEntry, error) {
+		e := &models.TimelineEntry{Type: constants.TimelineEntryTask}
+		var owner sql.NullString
+		if err := rows.Scan(&e.ID, &e.Date, &owner); err != nil {
+			return nil, err
+		}
+		e.ActorID = nullStringPtr(owner)
+		return e, nil
+	})
+}
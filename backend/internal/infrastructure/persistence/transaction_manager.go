@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/nexuscrm/backend/internal/infrastructure/database"
@@ -101,6 +102,46 @@ func (tm *TransactionManager) ExtractTx(ctx context.Context) *sql.Tx {
 	return nil
 }
 
+// savepointSeq numbers savepoints, so nested savepoints of one transaction never share a name
+var savepointSeq atomic.Uint64
+
+// WithSavepoint runs fn inside a savepoint of the transaction in ctx. When fn fails or
+// panics only its own work is rolled back and the transaction stays usable, so a caller can
+// record the failure and carry on; fn's error is returned. Savepoints nest: fn may call
+// WithSavepoint again. Without a transaction in ctx fn simply runs, as there is no enclosing
+// work to protect.
+func (tm *TransactionManager) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := tm.ExtractTx(ctx)
+	if tx == nil {
+		return fn(ctx)
+	}
+
+	name := fmt.Sprintf("nexus_sp_%d", savepointSeq.Add(1))
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	// Ensure rollback to the savepoint on panic
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint error: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
 // WithIsolationLevel executes a function within a transaction with a specific isolation level.
 // Supported levels: READ UNCOMMITTED, READ COMMITTED, REPEATABLE READ, SERIALIZABLE
 func (tm *TransactionManager) WithIsolationLevel(
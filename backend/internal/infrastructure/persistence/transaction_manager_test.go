@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/infrastructure/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionManager_WithSavepoint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	conn, err := database.GetTestInstance()
+	if err != nil {
+		t.Skipf("Skipping integration test: failed to connect to DB: %v", err)
+	}
+	tm := NewTransactionManager(conn)
+
+	tableName := fmt.Sprintf("test_savepoint_%d", time.Now().UnixNano())
+	_, err = conn.Exec(fmt.Sprintf("CREATE TABLE %s (name VARCHAR(50) NOT NULL PRIMARY KEY)", tableName))
+	require.NoError(t, err)
+	defer func() { _, _ = conn.Exec("DROP TABLE " + tableName) }()
+
+	insert := func(ctx context.Context, name string) error {
+		_, err := tm.ExtractTx(ctx).ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (name) VALUES (?)", tableName), name)
+		return err
+	}
+	failed := errors.New("sub-operation failed")
+
+	err = tm.WithTransaction(func(tx *sql.Tx) error {
+		ctx := tm.InjectTx(context.Background(), tx)
+		require.NoError(t, insert(ctx, "kept"))
+
+		// A failing savepoint rolls back only its own work
+		err := tm.WithSavepoint(ctx, func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, "rolled_back"))
+			return failed
+		})
+		assert.ErrorIs(t, err, failed)
+
+		// Nested savepoints: the inner failure leaves the outer savepoint's work
+		return tm.WithSavepoint(ctx, func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, "outer"))
+			err := tm.WithSavepoint(ctx, func(ctx context.Context) error {
+				require.NoError(t, insert(ctx, "inner"))
+				return failed
+			})
+			assert.ErrorIs(t, err, failed)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	rows, err := conn.Query(fmt.Sprintf("SELECT name FROM %s ORDER BY name", tableName))
+	require.NoError(t, err)
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	assert.Equal(t, []string{"kept", "outer"}, names)
+
+	// Without a transaction there is nothing to roll back to; fn simply runs
+	assert.ErrorIs(t, tm.WithSavepoint(context.Background(), func(context.Context) error { return failed }), failed)
+}
@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// TranslationRepository handles database operations for metadata translations
+type TranslationRepository struct {
+	db *sql.DB
+}
+
+// NewTranslationRepository creates a new TranslationRepository
+func NewTranslationRepository(db *sql.DB) *TranslationRepository {
+	return &TranslationRepository{db: db}
+}
+
+var translationColumns = []string{
+	constants.FieldSysTranslation_ID,
+	constants.FieldSysTranslation_Locale,
+	constants.FieldSysTranslation_ComponentType,
+	constants.FieldSysTranslation_ComponentKey,
+	constants.FieldSysTranslation_Translation,
+	constants.FieldSysTranslation_LastModifiedDate,
+}
+
+// GetAll queries the translations of every locale
+func (r *TranslationRepository) GetAll(ctx context.Context) ([]*models.Translation, error) {
+	q := query.From(constants.TableTranslation).
+		Select(translationColumns).
+		OrderBy(constants.FieldSysTranslation_Locale, constants.SortASC).
+		Build()
+
+	rows, err := r.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make([]*models.Translation, 0)
+	for rows.Next() {
+		var t models.Translation
+		var modified sql.NullTime
+		if err := rows.Scan(&t.ID, &t.Locale, &t.ComponentType, &t.ComponentKey, &t.Translation, &modified); err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+		if modified.Valid {
+			t.LastModifiedDate = &modified.Time
+		}
+		translations = append(translations, &t)
+	}
+	return translations, rows.Err()
+}
+
+// Upsert writes translations, replacing earlier translations of the same components
+func (r *TranslationRepository) Upsert(ctx context.Context, translations []*models.Translation) error {
+	if len(translations) == 0 {
+		return nil
+	}
+
+	columns := translationColumns[:len(translationColumns)-1]
+	placeholders := make([]string, len(translations))
+	params := make([]interface{}, 0, len(translations)*len(columns))
+	for i, t := range translations {
+		placeholders[i] = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ", NOW(), NOW())"
+		params = append(params, utils.GenerateID(), t.Locale, t.ComponentType, t.ComponentKey, t.Translation)
+	}
+
+	upsert := query.ActiveDialect().Upsert(
+		[]string{constants.FieldSysTranslation_Locale, constants.FieldSysTranslation_ComponentType, constants.FieldSysTranslation_ComponentKey},
+		[]string{constants.FieldSysTranslation_Translation}, constants.FieldSysTranslation_LastModifiedDate+" = NOW()")
+	stmt := fmt.Sprintf("%s %s (%s, %s, %s) %s %s %s",
+		KeywordInsertInto, constants.TableTranslation, strings.Join(columns, ", "),
+		constants.FieldSysTranslation_CreatedDate, constants.FieldSysTranslation_LastModifiedDate,
+		KeywordValues, strings.Join(placeholders, ", "), upsert)
+	if _, err := r.db.ExecContext(ctx, stmt, params...); err != nil {
+		return fmt.Errorf("failed to save translations: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the translation of a component in a locale
+func (r *TranslationRepository) Delete(ctx context.Context, locale, componentType, componentKey string) error {
+	q := query.Delete(constants.TableTranslation).
+		Where(constants.FieldSysTranslation_Locale+" = ?", locale).
+		Where(constants.FieldSysTranslation_ComponentType+" = ?", componentType).
+		Where(constants.FieldSysTranslation_ComponentKey+" = ?", componentKey).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+	return nil
+}
+
+// DeleteLocale removes every translation of a locale
+func (r *TranslationRepository) DeleteLocale(ctx context.Context, locale string) error {
+	q := query.Delete(constants.TableTranslation).
+		Where(constants.FieldSysTranslation_Locale+" = ?", locale).
+		Build()
+	if _, err := r.db.ExecContext(ctx, q.SQL, q.Params...); err != nil {
+		return fmt.Errorf("failed to delete translations: %w", err)
+	}
+	return nil
+}
@@ -41,6 +41,18 @@ func (r *UserRepository) CheckUserExistsByID(ctx context.Context, id string) (bo
 	return exists, nil
 }
 
+// HasUsers reports whether any user exists; first-run setup stays open until one does
+func (r *UserRepository) HasUsers(ctx context.Context) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf("%s %s(%s 1 %s %s)",
+		KeywordSelect, "EXISTS", KeywordSelect, KeywordFrom, constants.TableUser)
+	err := r.db.QueryRowContext(ctx, query).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 func (r *UserRepository) CheckEmailConflict(ctx context.Context, email, excludeID string) (bool, error) {
 	var exists bool
 	query := fmt.Sprintf("%s %s(%s 1 %s %s %s %s = ? %s %s != ?)",
@@ -79,7 +91,7 @@ func (r *UserRepository) UpdateUser(ctx context.Context, userID string, updates
 
 // DeleteUser deletes a user record
 func (r *UserRepository) DeleteUser(ctx context.Context, userID string) error {
-	query := fmt.Sprintf("%s %s %s = ?", KeywordDeleteFrom, constants.TableUser, constants.FieldID)
+	query := fmt.Sprintf("%s %s %s %s = ?", KeywordDeleteFrom, constants.TableUser, KeywordWhere, constants.FieldID)
 	_, err := r.db.ExecContext(ctx, query, userID)
 	return err
 }
@@ -87,10 +99,10 @@ func (r *UserRepository) DeleteUser(ctx context.Context, userID string) error {
 // FindAll retrieves all users
 func (r *UserRepository) FindAll(ctx context.Context) ([]*models.SystemUser, error) {
 	query := fmt.Sprintf(`
-		%s %s, %s, %s, %s, %s, %s, %s, %s, %s, %s 
+		%s %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s 
 		%s %s 
 		%s %s %s`,
-		KeywordSelect, constants.FieldID, constants.FieldUsername, constants.FieldEmail, constants.FieldProfileID, constants.FieldRoleID, constants.FieldIsActive, constants.FieldCreatedDate, constants.FieldLastLoginDate, constants.FieldFirstName, constants.FieldLastName,
+		KeywordSelect, constants.FieldID, constants.FieldUsername, constants.FieldEmail, constants.FieldProfileID, constants.FieldRoleID, constants.FieldIsActive, constants.FieldCreatedDate, constants.FieldLastLoginDate, constants.FieldFirstName, constants.FieldLastName, constants.FieldSysUser_UserType,
 		KeywordFrom, constants.TableUser,
 		KeywordOrderBy, constants.FieldCreatedDate, KeywordDesc)
 
@@ -106,7 +118,7 @@ func (r *UserRepository) FindAll(ctx context.Context) ([]*models.SystemUser, err
 		var createdDateRaw, lastLoginRaw []byte
 		var firstName, lastName, roleID sql.NullString
 
-		if err := rows.Scan(&u.ID, &u.Username, &u.Email, &u.ProfileID, &roleID, &u.IsActive, &createdDateRaw, &lastLoginRaw, &firstName, &lastName); err != nil {
+		if err := rows.Scan(&u.ID, &u.Username, &u.Email, &u.ProfileID, &roleID, &u.IsActive, &createdDateRaw, &lastLoginRaw, &firstName, &lastName, &u.UserType); err != nil {
 			continue
 		}
 
@@ -165,7 +177,8 @@ func (r *UserRepository) FindUserByEmailWithPassword(ctx context.Context, email
 	cols := strings.Join([]string{
 		constants.FieldID, constants.FieldSysUser_Username, constants.FieldSysUser_Email,
 		constants.FieldSysUser_Password, constants.FieldSysUser_ProfileID, constants.FieldSysUser_RoleID,
-		constants.FieldSysUser_FirstName, constants.FieldSysUser_LastName,
+		constants.FieldSysUser_FirstName, constants.FieldSysUser_LastName, constants.FieldSysUser_UserType,
+		constants.FieldSysUser_Locale, constants.FieldSysUser_IsActive,
 	}, ", ")
 
 	query := fmt.Sprintf(`
@@ -178,7 +191,7 @@ func (r *UserRepository) FindUserByEmailWithPassword(ctx context.Context, email
 	var sysUser models.SystemUser
 	u.SystemUser = &sysUser
 
-	var password, roleID, firstName, lastName sql.NullString
+	var password, roleID, firstName, lastName, locale sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&sysUser.ID,
@@ -189,6 +202,9 @@ func (r *UserRepository) FindUserByEmailWithPassword(ctx context.Context, email
 		&roleID,
 		&firstName,
 		&lastName,
+		&sysUser.UserType,
+		&locale,
+		&sysUser.IsActive,
 	)
 
 	if err != nil {
@@ -215,6 +231,9 @@ func (r *UserRepository) FindUserByEmailWithPassword(ctx context.Context, email
 	if roleID.Valid {
 		sysUser.RoleID = &roleID.String
 	}
+	if locale.Valid {
+		sysUser.Locale = &locale.String
+	}
 
 	return &u, nil
 }
@@ -259,6 +278,8 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*model
 	cols := strings.Join([]string{
 		constants.FieldID, constants.FieldSysUser_Username, constants.FieldSysUser_Email,
 		constants.FieldSysUser_ProfileID, constants.FieldSysUser_FirstName, constants.FieldSysUser_LastName,
+		constants.FieldSysUser_UserType, constants.FieldSysUser_ContactID, constants.FieldSysUser_AccountID,
+		constants.FieldSysUser_Locale, constants.FieldSysUser_IsActive, constants.FieldSysUser_Phone,
 	}, ", ")
 
 	query := fmt.Sprintf(`
@@ -268,7 +289,7 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*model
 		KeywordSelect, cols, KeywordFrom, constants.TableUser, KeywordWhere, constants.FieldID, KeywordLimit)
 
 	var u models.SystemUser
-	var firstName, lastName sql.NullString
+	var firstName, lastName, contactID, accountID, locale, phone sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, userID).Scan(
 		&u.ID,
@@ -277,6 +298,12 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*model
 		&u.ProfileID,
 		&firstName,
 		&lastName,
+		&u.UserType,
+		&contactID,
+		&accountID,
+		&locale,
+		&u.IsActive,
+		&phone,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -291,6 +318,18 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*model
 	if lastName.Valid {
 		u.LastName = lastName.String
 	}
+	if contactID.Valid {
+		u.ContactID = &contactID.String
+	}
+	if accountID.Valid {
+		u.AccountID = &accountID.String
+	}
+	if locale.Valid {
+		u.Locale = &locale.String
+	}
+	if phone.Valid {
+		u.Phone = &phone.String
+	}
 
 	return &u, nil
 }
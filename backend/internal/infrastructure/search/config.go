@@ -0,0 +1,44 @@
+// Package search provides full-text SearchIndex adapters: an embedded
+// in-process index and an external Meilisearch engine.
+package search
+
+import (
+	"fmt"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Supported SEARCH_ENGINE values
+const (
+	EngineNone        = "none"
+	EngineEmbedded    = "embedded"
+	EngineMeilisearch = "meilisearch"
+
+	defaultMeiliIndex = "nexuscrm_records"
+)
+
+// New builds the SearchIndex selected by SEARCH_ENGINE.
+// Returns (nil, nil) when indexing is disabled, in which case callers fall back to LIKE search.
+//
+//	SEARCH_ENGINE=embedded       in-process index rebuilt at startup
+//	SEARCH_ENGINE=meilisearch    requires MEILISEARCH_URL; optional MEILISEARCH_API_KEY, MEILISEARCH_INDEX
+func New(cfg config.Search) (ports.SearchIndex, error) {
+	switch cfg.Engine {
+	case "", EngineNone:
+		return nil, nil
+	case EngineEmbedded:
+		return NewEmbeddedIndex(), nil
+	case EngineMeilisearch:
+		if cfg.MeilisearchURL == "" {
+			return nil, fmt.Errorf("SEARCH_ENGINE=meilisearch requires MEILISEARCH_URL")
+		}
+		indexUID := cfg.MeilisearchIndex
+		if indexUID == "" {
+			indexUID = defaultMeiliIndex
+		}
+		return NewMeilisearchIndex(cfg.MeilisearchURL, cfg.MeilisearchKey, indexUID), nil
+	default:
+		return nil, fmt.Errorf("unsupported SEARCH_ENGINE %q", cfg.Engine)
+	}
+}
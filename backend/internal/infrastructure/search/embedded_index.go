@@ -0,0 +1,382 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Match weights applied to a document term depending on how it matched the query token.
+const (
+	weightExact  = 1.0
+	weightPrefix = 0.8
+	weightFuzzy  = 0.6
+
+	defaultSearchLimit = 50
+	snippetRadius      = 60
+	highlightPreTag    = "<mark>"
+	highlightPostTag   = "</mark>"
+)
+
+// indexedDoc is the in-memory representation of a SearchDocument
+type indexedDoc struct {
+	objectAPIName string
+	recordID      string
+	fields        map[string]string
+	termFreq      map[string]int
+	length        int
+}
+
+// EmbeddedIndex is an in-process inverted index with prefix matching,
+// typo tolerance (bounded edit distance) and TF-IDF relevance ranking.
+// It is rebuilt from the database at startup and kept current from record events.
+type EmbeddedIndex struct {
+	mu       sync.RWMutex
+	docs     map[string]*indexedDoc
+	postings map[string]map[string]int // term -> docKey -> term frequency
+}
+
+// Ensure EmbeddedIndex implements ports.SearchIndex at compile time
+var _ ports.SearchIndex = (*EmbeddedIndex)(nil)
+
+// NewEmbeddedIndex creates an empty embedded index
+func NewEmbeddedIndex() *EmbeddedIndex {
+	return &EmbeddedIndex{
+		docs:     make(map[string]*indexedDoc),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// Name returns the engine identifier
+func (idx *EmbeddedIndex) Name() string {
+	return EngineEmbedded
+}
+
+// Index adds or replaces a document
+func (idx *EmbeddedIndex) Index(ctx context.Context, doc ports.SearchDocument) error {
+	key := docKey(doc.ObjectAPIName, doc.RecordID)
+
+	termFreq := make(map[string]int)
+	length := 0
+	for _, value := range doc.Fields {
+		for _, tok := range Tokenize(value) {
+			termFreq[tok]++
+			length++
+		}
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(key)
+	if length == 0 {
+		return nil
+	}
+
+	fields := make(map[string]string, len(doc.Fields))
+	for k, v := range doc.Fields {
+		fields[k] = v
+	}
+
+	idx.docs[key] = &indexedDoc{
+		objectAPIName: strings.ToLower(doc.ObjectAPIName),
+		recordID:      doc.RecordID,
+		fields:        fields,
+		termFreq:      termFreq,
+		length:        length,
+	}
+	for term, freq := range termFreq {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[string]int)
+		}
+		idx.postings[term][key] = freq
+	}
+	return nil
+}
+
+// Remove deletes a document from the index
+func (idx *EmbeddedIndex) Remove(ctx context.Context, objectAPIName, recordID string) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(docKey(objectAPIName, recordID))
+	return nil
+}
+
+func (idx *EmbeddedIndex) removeLocked(key string) {
+	existing, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+	for term := range existing.termFreq {
+		if posting := idx.postings[term]; posting != nil {
+			delete(posting, key)
+			if len(posting) == 0 {
+				delete(idx.postings, term)
+			}
+		}
+	}
+	delete(idx.docs, key)
+}
+
+// Size returns the number of indexed documents
+func (idx *EmbeddedIndex) Size() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Search returns hits ordered by descending relevance
+func (idx *EmbeddedIndex) Search(ctx context.Context, q ports.SearchQuery) ([]ports.SearchHit, error) {
+	queryTokens := Tokenize(q.Term)
+	if len(queryTokens) == 0 {
+		return []ports.SearchHit{}, nil
+	}
+
+	allowed := make(map[string]bool, len(q.ObjectAPINames))
+	for _, name := range q.ObjectAPINames {
+		allowed[strings.ToLower(name)] = true
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	totalDocs := float64(len(idx.docs))
+	scores := make(map[string]float64)
+	matchedTokens := make(map[string]int)
+	matchedTerms := make(map[string]map[string]bool) // docKey -> index terms that matched
+
+	for _, token := range queryTokens {
+		// Best contribution of this token per document
+		best := make(map[string]float64)
+
+		for term, weight := range idx.expandToken(token) {
+			posting := idx.postings[term]
+			idf := math.Log(1 + totalDocs/float64(len(posting)))
+			for key, freq := range posting {
+				doc := idx.docs[key]
+				if len(allowed) > 0 && !allowed[doc.objectAPIName] {
+					continue
+				}
+				score := weight * (1 + math.Log(float64(freq))) * idf / math.Sqrt(float64(doc.length))
+				if score > best[key] {
+					best[key] = score
+				}
+				if matchedTerms[key] == nil {
+					matchedTerms[key] = make(map[string]bool)
+				}
+				matchedTerms[key][term] = true
+			}
+		}
+
+		for key, score := range best {
+			scores[key] += score
+			matchedTokens[key]++
+		}
+	}
+
+	hits := make([]ports.SearchHit, 0, len(scores))
+	for key, score := range scores {
+		doc := idx.docs[key]
+		// Reward documents that match more of the query
+		coverage := float64(matchedTokens[key]) / float64(len(queryTokens))
+		hits = append(hits, ports.SearchHit{
+			ObjectAPIName: doc.objectAPIName,
+			RecordID:      doc.recordID,
+			Score:         score * coverage,
+			Highlights:    highlightFields(doc.fields, matchedTerms[key]),
+		})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score == hits[j].Score {
+			return hits[i].RecordID < hits[j].RecordID
+		}
+		return hits[i].Score > hits[j].Score
+	})
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// expandToken returns the index terms matching a query token with their match weight.
+// Exact matches win over prefix matches, which win over fuzzy matches.
+func (idx *EmbeddedIndex) expandToken(token string) map[string]float64 {
+	matches := make(map[string]float64)
+	if _, ok := idx.postings[token]; ok {
+		matches[token] = weightExact
+	}
+
+	maxEdits := maxEditsFor(token)
+	tokenRunes := []rune(token)
+	for term := range idx.postings {
+		if term == token {
+			continue
+		}
+		if len(tokenRunes) >= 2 && strings.HasPrefix(term, token) {
+			matches[term] = weightPrefix
+			continue
+		}
+		if maxEdits == 0 {
+			continue
+		}
+		termRunes := []rune(term)
+		if abs(len(termRunes)-len(tokenRunes)) > maxEdits {
+			continue
+		}
+		if levenshtein(tokenRunes, termRunes, maxEdits) <= maxEdits {
+			matches[term] = weightFuzzy
+		}
+	}
+	return matches
+}
+
+// Tokenize lowercases text and splits it on any non letter/digit rune
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// maxEditsFor returns the typo budget for a token, scaled by its length
+func maxEditsFor(token string) int {
+	n := len([]rune(token))
+	switch {
+	case n <= 3:
+		return 0
+	case n <= 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// levenshtein computes the edit distance between a and b, stopping early once
+// every candidate in a row exceeds limit.
+func levenshtein(a, b []rune, limit int) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// highlightFields wraps matched words in each field value with highlight tags,
+// trimming long values to a snippet around the first match.
+func highlightFields(fields map[string]string, terms map[string]bool) map[string]string {
+	if len(terms) == 0 {
+		return nil
+	}
+	highlights := make(map[string]string)
+	for field, value := range fields {
+		if snippet, ok := highlightValue(value, terms); ok {
+			highlights[field] = snippet
+		}
+	}
+	return highlights
+}
+
+func highlightValue(value string, terms map[string]bool) (string, bool) {
+	runes := []rune(value)
+	firstMatch := -1
+	for _, w := range wordSpans(runes) {
+		if terms[strings.ToLower(string(runes[w[0]:w[1]]))] {
+			firstMatch = w[0]
+			break
+		}
+	}
+	if firstMatch < 0 {
+		return "", false
+	}
+
+	// Trim long values to a window around the first match
+	from, to := 0, len(runes)
+	if len(runes) > snippetRadius*2 {
+		from = max(firstMatch-snippetRadius, 0)
+		to = min(firstMatch+snippetRadius*2, len(runes))
+	}
+	window := runes[from:to]
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := 0
+	for _, w := range wordSpans(window) {
+		b.WriteString(string(window[pos:w[0]]))
+		word := string(window[w[0]:w[1]])
+		if terms[strings.ToLower(word)] {
+			b.WriteString(highlightPreTag + word + highlightPostTag)
+		} else {
+			b.WriteString(word)
+		}
+		pos = w[1]
+	}
+	b.WriteString(string(window[pos:]))
+	if to < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// wordSpans returns [start, end) rune offsets of each word in runes
+func wordSpans(runes []rune) [][2]int {
+	spans := make([][2]int, 0)
+	i := 0
+	for i < len(runes) {
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(runes) && isWordRune(runes[i]) {
+			i++
+		}
+		spans = append(spans, [2]int{start, i})
+	}
+	return spans
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func docKey(objectAPIName, recordID string) string {
+	return strings.ToLower(objectAPIName) + "/" + recordID
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
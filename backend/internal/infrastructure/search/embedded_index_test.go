@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedIndex(t *testing.T) *EmbeddedIndex {
+	idx := NewEmbeddedIndex()
+	ctx := context.Background()
+	docs := []ports.SearchDocument{
+		{ObjectAPIName: "account", RecordID: "a1", Fields: map[string]string{"name": "Acme Corporation", "description": "Global widget manufacturer"}},
+		{ObjectAPIName: "account", RecordID: "a2", Fields: map[string]string{"name": "Globex", "description": "Acme competitor"}},
+		{ObjectAPIName: "contact", RecordID: "c1", Fields: map[string]string{"name": "Jane Smith", "email": "jane@acme.com"}},
+	}
+	for _, d := range docs {
+		require.NoError(t, idx.Index(ctx, d))
+	}
+	return idx
+}
+
+func TestEmbeddedIndex_RanksAndHighlights(t *testing.T) {
+	idx := seedIndex(t)
+
+	hits, err := idx.Search(context.Background(), ports.SearchQuery{Term: "acme corporation"})
+	require.NoError(t, err)
+	require.NotEmpty(t, hits)
+
+	// The record matching both tokens ranks first
+	assert.Equal(t, "a1", hits[0].RecordID)
+	assert.Equal(t, "<mark>Acme</mark> <mark>Corporation</mark>", hits[0].Highlights["name"])
+}
+
+func TestEmbeddedIndex_TypoTolerance(t *testing.T) {
+	idx := seedIndex(t)
+
+	hits, err := idx.Search(context.Background(), ports.SearchQuery{Term: "corporaton"})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "a1", hits[0].RecordID)
+}
+
+func TestEmbeddedIndex_PrefixAndScope(t *testing.T) {
+	idx := seedIndex(t)
+
+	hits, err := idx.Search(context.Background(), ports.SearchQuery{Term: "ac", ObjectAPINames: []string{"Contact"}})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "c1", hits[0].RecordID)
+	assert.Equal(t, "contact", hits[0].ObjectAPIName)
+}
+
+func TestEmbeddedIndex_ReplaceAndRemove(t *testing.T) {
+	idx := seedIndex(t)
+	ctx := context.Background()
+
+	require.NoError(t, idx.Index(ctx, ports.SearchDocument{ObjectAPIName: "account", RecordID: "a2", Fields: map[string]string{"name": "Initech"}}))
+	hits, err := idx.Search(ctx, ports.SearchQuery{Term: "globex"})
+	require.NoError(t, err)
+	assert.Empty(t, hits, "replaced document must not match old terms")
+
+	require.NoError(t, idx.Remove(ctx, "ACCOUNT", "a1"))
+	assert.Equal(t, 2, idx.Size())
+	hits, err = idx.Search(ctx, ports.SearchQuery{Term: "corporation"})
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+}
+
+func TestHighlightValue_TrimsLongValues(t *testing.T) {
+	long := strings.Repeat("lorem ipsum ", 30) + "needle " + strings.Repeat("dolor sit ", 30)
+	snippet, ok := highlightValue(long, map[string]bool{"needle": true})
+	require.True(t, ok)
+	assert.Contains(t, snippet, "<mark>needle</mark>")
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+}
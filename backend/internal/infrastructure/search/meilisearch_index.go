@@ -0,0 +1,194 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Reserved document attributes; record fields are stored under fieldPrefix to avoid collisions.
+const (
+	meiliAttrDocID    = "doc_id"
+	meiliAttrObject   = "object_api_name"
+	meiliAttrRecordID = "record_id"
+	fieldPrefix       = "f_"
+)
+
+// MeilisearchIndex stores documents in an external Meilisearch index.
+// Typo tolerance, ranking and highlighting are delegated to the engine.
+type MeilisearchIndex struct {
+	baseURL   string
+	apiKey    string
+	indexUID  string
+	client    *http.Client
+	setupOnce sync.Once
+	setupErr  error
+}
+
+// Ensure MeilisearchIndex implements ports.SearchIndex at compile time
+var _ ports.SearchIndex = (*MeilisearchIndex)(nil)
+
+// NewMeilisearchIndex creates an adapter for the given Meilisearch server and index
+func NewMeilisearchIndex(baseURL, apiKey, indexUID string) *MeilisearchIndex {
+	return &MeilisearchIndex{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		apiKey:   apiKey,
+		indexUID: indexUID,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the engine identifier
+func (m *MeilisearchIndex) Name() string {
+	return EngineMeilisearch
+}
+
+// Index adds or replaces a document
+func (m *MeilisearchIndex) Index(ctx context.Context, doc ports.SearchDocument) error {
+	if err := m.ensureSetup(ctx); err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		meiliAttrDocID:    meiliDocID(doc.ObjectAPIName, doc.RecordID),
+		meiliAttrObject:   strings.ToLower(doc.ObjectAPIName),
+		meiliAttrRecordID: doc.RecordID,
+	}
+	for k, v := range doc.Fields {
+		body[fieldPrefix+k] = v
+	}
+
+	path := fmt.Sprintf("/indexes/%s/documents?primaryKey=%s", m.indexUID, meiliAttrDocID)
+	return m.do(ctx, http.MethodPut, path, []interface{}{body}, nil)
+}
+
+// Remove deletes a document from the index
+func (m *MeilisearchIndex) Remove(ctx context.Context, objectAPIName, recordID string) error {
+	path := fmt.Sprintf("/indexes/%s/documents/%s", m.indexUID, meiliDocID(objectAPIName, recordID))
+	return m.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// Search returns hits ordered by descending relevance
+func (m *MeilisearchIndex) Search(ctx context.Context, q ports.SearchQuery) ([]ports.SearchHit, error) {
+	if err := m.ensureSetup(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	req := map[string]interface{}{
+		"q":                     q.Term,
+		"limit":                 limit,
+		"attributesToHighlight": []string{fieldPrefix + "*"},
+		"highlightPreTag":       highlightPreTag,
+		"highlightPostTag":      highlightPostTag,
+		"showRankingScore":      true,
+	}
+	if len(q.ObjectAPINames) > 0 {
+		quoted := make([]string, len(q.ObjectAPINames))
+		for i, name := range q.ObjectAPINames {
+			quoted[i] = fmt.Sprintf("%q", strings.ToLower(name))
+		}
+		req["filter"] = fmt.Sprintf("%s IN [%s]", meiliAttrObject, strings.Join(quoted, ", "))
+	}
+
+	var resp struct {
+		Hits []map[string]interface{} `json:"hits"`
+	}
+	if err := m.do(ctx, http.MethodPost, fmt.Sprintf("/indexes/%s/search", m.indexUID), req, &resp); err != nil {
+		return nil, err
+	}
+
+	hits := make([]ports.SearchHit, 0, len(resp.Hits))
+	for _, h := range resp.Hits {
+		hit := ports.SearchHit{
+			ObjectAPIName: fmt.Sprint(h[meiliAttrObject]),
+			RecordID:      fmt.Sprint(h[meiliAttrRecordID]),
+		}
+		if score, ok := h["_rankingScore"].(float64); ok {
+			hit.Score = score
+		}
+		if formatted, ok := h["_formatted"].(map[string]interface{}); ok {
+			hit.Highlights = make(map[string]string)
+			for k, v := range formatted {
+				s, ok := v.(string)
+				if !ok || !strings.HasPrefix(k, fieldPrefix) || !strings.Contains(s, highlightPreTag) {
+					continue
+				}
+				hit.Highlights[strings.TrimPrefix(k, fieldPrefix)] = s
+			}
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// ensureSetup declares the object attribute filterable once per process
+func (m *MeilisearchIndex) ensureSetup(ctx context.Context) error {
+	m.setupOnce.Do(func() {
+		path := fmt.Sprintf("/indexes/%s/settings/filterable-attributes", m.indexUID)
+		m.setupErr = m.do(ctx, http.MethodPut, path, []string{meiliAttrObject}, nil)
+	})
+	return m.setupErr
+}
+
+func (m *MeilisearchIndex) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("meilisearch: failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, m.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("meilisearch: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("meilisearch: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && method == http.MethodDelete {
+		return nil
+	}
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("meilisearch: %s %s returned %d: %s", method, path, resp.StatusCode, string(msg))
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("meilisearch: failed to decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+// meiliDocID builds a primary key that satisfies Meilisearch's [a-zA-Z0-9_-] constraint
+func meiliDocID(objectAPIName, recordID string) string {
+	raw := strings.ToLower(objectAPIName) + "__" + recordID
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			return r
+		}
+		return '-'
+	}, raw)
+}
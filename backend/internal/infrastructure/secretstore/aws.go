@@ -0,0 +1,183 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+const awsSecretsManagerService = "secretsmanager"
+
+// AWSCredentials are the static credentials requests to AWS are signed with
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Set for temporary credentials
+}
+
+// AWSSecretsManagerStore reads secrets from AWS Secrets Manager: aws:prod/nexuscrm reads a
+// plain secret, aws:prod/nexuscrm#jwt_secret one key of a JSON secret. The secret ID may be
+// a name or an ARN.
+type AWSSecretsManagerStore struct {
+	region   string
+	creds    AWSCredentials
+	endpoint string
+	client   *http.Client
+}
+
+var _ ports.SecretsPort = (*AWSSecretsManagerStore)(nil)
+
+// NewAWSSecretsManagerStore creates an adapter for Secrets Manager in region. endpoint
+// overrides the regional endpoint, e.g. for a VPC endpoint or a local emulator.
+func NewAWSSecretsManagerStore(region string, creds AWSCredentials, endpoint string) *AWSSecretsManagerStore {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", region)
+	}
+	return &AWSSecretsManagerStore{
+		region:   region,
+		creds:    creds,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the store identifier
+func (s *AWSSecretsManagerStore) Name() string {
+	return StoreAWS
+}
+
+// GetSecret reads the current version of a secret
+func (s *AWSSecretsManagerStore) GetSecret(ctx context.Context, path string) (string, error) {
+	secretID, key := splitKey(path)
+	body, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	signAWSRequest(req, body, awsSecretsManagerService, s.region, s.creds, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("secrets manager request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secrets manager response: %w", err)
+	}
+
+	var result struct {
+		SecretString string `json:"SecretString"`
+		Type         string `json:"__type"`
+		Message      string `json:"message"`
+	}
+	_ = json.Unmarshal(respBody, &result)
+	if resp.StatusCode >= 300 {
+		if strings.HasSuffix(result.Type, "ResourceNotFoundException") {
+			return "", fmt.Errorf("%w: %s", ports.ErrSecretNotFound, secretID)
+		}
+		return "", fmt.Errorf("secrets manager returned %d: %s %s", resp.StatusCode, result.Type, result.Message)
+	}
+	return pickKey(result.SecretString, key)
+}
+
+// signAWSRequest adds AWS Signature Version 4 headers to req
+func signAWSRequest(req *http.Request, body []byte, service, region string, creds AWSCredentials, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	// Canonical headers: host plus every header set on the request, lowercased and sorted
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hexSHA256([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	for _, part := range []string{region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, awsEscape(k)+"="+awsEscape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsEscape percent-encodes everything but the unreserved characters, as SigV4 requires
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,177 @@
+// Package secretstore provides SecretsPort adapters for environment variables, HashiCorp
+// Vault and AWS Secrets Manager, and resolves the secret references held by configuration.
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Secret store names, used as the scheme of secret references ("vault:secret/data/crm#key")
+const (
+	StoreEnv   = "env"
+	StoreVault = "vault"
+	StoreAWS   = "aws"
+)
+
+var knownStores = []string{StoreEnv, StoreVault, StoreAWS}
+
+// New builds a Resolver over the env store and every store configured in cfg
+func New(cfg config.SecretStores) *Resolver {
+	stores := []ports.SecretsPort{NewEnvStore()}
+	if cfg.VaultAddr != "" {
+		stores = append(stores, NewVaultStore(cfg.VaultAddr, cfg.VaultToken, cfg.VaultNamespace))
+	}
+	if cfg.AWSAccessKeyID != "" && cfg.AWSRegion != "" {
+		creds := AWSCredentials{
+			AccessKeyID:     cfg.AWSAccessKeyID,
+			SecretAccessKey: cfg.AWSSecretAccessKey,
+			SessionToken:    cfg.AWSSessionToken,
+		}
+		stores = append(stores, NewAWSSecretsManagerStore(cfg.AWSRegion, creds, cfg.AWSEndpoint))
+	}
+	return NewResolver(cfg.CacheTTL, stores...)
+}
+
+// Resolver resolves secret references "<store>:<path>" through the stores it was given,
+// caching each secret for the TTL
+type Resolver struct {
+	stores map[string]ports.SecretsPort
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+type cachedSecret struct {
+	value   string
+	expires time.Time
+}
+
+// NewResolver creates a Resolver over the given stores
+func NewResolver(ttl time.Duration, stores ...ports.SecretsPort) *Resolver {
+	r := &Resolver{stores: make(map[string]ports.SecretsPort, len(stores)), ttl: ttl, cache: make(map[string]cachedSecret)}
+	for _, store := range stores {
+		r.stores[store.Name()] = store
+	}
+	return r
+}
+
+// Stores lists the names of the configured stores
+func (r *Resolver) Stores() []string {
+	names := make([]string, 0, len(r.stores))
+	for name := range r.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsReference reports whether value is a secret reference rather than a plain value
+func IsReference(value string) bool {
+	_, _, ok := splitReference(value)
+	return ok
+}
+
+// Resolve returns the secret a reference points to
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	storeName, path, ok := splitReference(ref)
+	if !ok {
+		return "", fmt.Errorf("not a secret reference; expected <store>:<path> with store one of %s", strings.Join(knownStores, ", "))
+	}
+	store, ok := r.stores[storeName]
+	if !ok {
+		return "", fmt.Errorf("secret store %q is not configured", storeName)
+	}
+
+	if r.ttl > 0 {
+		r.mu.Lock()
+		cached, ok := r.cache[ref]
+		r.mu.Unlock()
+		if ok && time.Now().Before(cached.expires) {
+			return cached.value, nil
+		}
+	}
+
+	value, err := store.GetSecret(ctx, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", ref, err)
+	}
+	if r.ttl > 0 {
+		r.mu.Lock()
+		r.cache[ref] = cachedSecret{value: value, expires: time.Now().Add(r.ttl)}
+		r.mu.Unlock()
+	}
+	return value, nil
+}
+
+// ResolveEnv replaces each of the environment variables that holds a secret reference with
+// the secret, so that settings such as JWT_SECRET or TIDB_PASSWORD can live in a store
+func (r *Resolver) ResolveEnv(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		value := os.Getenv(key)
+		if !IsReference(value) {
+			continue
+		}
+		secret, err := r.Resolve(ctx, value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if err := os.Setenv(key, secret); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// splitReference splits "<store>:<path>" for a known store
+func splitReference(ref string) (store, path string, ok bool) {
+	store, path, found := strings.Cut(strings.TrimSpace(ref), ":")
+	if !found || path == "" || strings.HasPrefix(path, "//") {
+		return "", "", false
+	}
+	for _, known := range knownStores {
+		if store == known {
+			return store, path, true
+		}
+	}
+	return "", "", false
+}
+
+// splitKey splits "<path>#<key>"
+func splitKey(path string) (string, string) {
+	path, key, _ := strings.Cut(path, "#")
+	return path, key
+}
+
+// pickKey returns one key of a secret holding a JSON object, or the whole secret without a key
+func pickKey(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, so it has no key %q", key)
+	}
+	return fieldString(fields, key)
+}
+
+func fieldString(fields map[string]interface{}, key string) (string, error) {
+	value, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("%w: no key %q", ports.ErrSecretNotFound, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(value), nil
+}
@@ -0,0 +1,35 @@
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// EnvStore reads secrets from environment variables: env:SMTP_PASSWORD, or
+// env:SMTP#password for a variable holding a JSON object
+type EnvStore struct{}
+
+var _ ports.SecretsPort = (*EnvStore)(nil)
+
+// NewEnvStore creates an EnvStore
+func NewEnvStore() *EnvStore {
+	return &EnvStore{}
+}
+
+// Name returns the store identifier
+func (s *EnvStore) Name() string {
+	return StoreEnv
+}
+
+// GetSecret returns the value of the environment variable named by path
+func (s *EnvStore) GetSecret(ctx context.Context, path string) (string, error) {
+	name, key := splitKey(path)
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: environment variable %s is not set", ports.ErrSecretNotFound, name)
+	}
+	return pickKey(value, key)
+}
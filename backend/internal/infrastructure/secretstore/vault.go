@@ -0,0 +1,93 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// VaultStore reads secrets from HashiCorp Vault over its HTTP API. The path is the API path
+// after /v1/, so KV v2 secrets read as vault:secret/data/nexuscrm#jwt_secret and KV v1
+// secrets as vault:kv/nexuscrm#jwt_secret.
+type VaultStore struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+var _ ports.SecretsPort = (*VaultStore)(nil)
+
+// NewVaultStore creates an adapter for the Vault server at addr
+func NewVaultStore(addr, token, namespace string) *VaultStore {
+	return &VaultStore{
+		addr:      strings.TrimRight(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the store identifier
+func (s *VaultStore) Name() string {
+	return StoreVault
+}
+
+// GetSecret reads one key of the secret at path. The key may be left out of secrets that
+// hold a single key.
+func (s *VaultStore) GetSecret(ctx context.Context, path string) (string, error) {
+	path, key := splitKey(path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.addr+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	if s.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.namespace)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault response: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: vault path %s", ports.ErrSecretNotFound, path)
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("vault returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("invalid vault response: %w", err)
+	}
+	fields := secret.Data
+	// KV v2 nests the secret under data.data, next to data.metadata
+	if inner, ok := fields["data"].(map[string]interface{}); ok {
+		if _, versioned := fields["metadata"]; versioned {
+			fields = inner
+		}
+	}
+	if key == "" {
+		if len(fields) != 1 {
+			return "", fmt.Errorf("vault secret %s holds %d keys; pick one with #<key>", path, len(fields))
+		}
+		for only := range fields {
+			key = only
+		}
+	}
+	return fieldString(fields, key)
+}
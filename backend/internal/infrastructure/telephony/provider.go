@@ -0,0 +1,111 @@
+// Package telephony places calls through and receives call events from telephony services.
+// Providers speak their own wire formats and report calls in a provider-neutral shape;
+// matching calls to records and storing them is up to the caller.
+package telephony
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// ErrInvalidSignature is returned for webhook requests the provider did not sign
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// DialRequest asks the provider to ring the agent and connect them to a number
+type DialRequest struct {
+	AgentNumber string // Rung first
+	To          string // Dialed once the agent answers
+}
+
+// CallEvent reports the progress of a call. Direction and Status use the constants.CallDirection*
+// and constants.CallStatus* values.
+type CallEvent struct {
+	CallID       string
+	From         string
+	To           string
+	Direction    string
+	Status       string
+	Duration     *int // Seconds, once the call has ended
+	RecordingURL string
+	Timestamp    time.Time
+}
+
+// Provider is a telephony service
+type Provider interface {
+	Name() constants.TelephonyProvider
+	// Dial places a click-to-dial call, returning the provider's call ID
+	Dial(ctx context.Context, req DialRequest) (string, error)
+	// ParseEvent verifies and decodes a webhook request
+	ParseEvent(r *http.Request) (*CallEvent, error)
+}
+
+// Registry holds the configured providers
+type Registry struct {
+	providers map[constants.TelephonyProvider]Provider
+	dialer    constants.TelephonyProvider
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[constants.TelephonyProvider]Provider)}
+}
+
+// NewConfiguredRegistry registers each provider whose account is configured. Twilio needs
+// TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN and TWILIO_CALLER_ID. TELEPHONY_WEBHOOK_URL is the
+// public URL of /api/telephony/webhooks; each provider posts to its own path below it.
+func NewConfiguredRegistry(cfg config.Telephony) *Registry {
+	r := NewRegistry()
+	webhookURL := strings.TrimSuffix(cfg.WebhookURL, "/")
+	if webhookURL == "" {
+		return r
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	if cfg.TwilioAccountSID != "" && cfg.TwilioAuthToken != "" && cfg.TwilioCallerID != "" {
+		r.Register(NewTwilioProvider(cfg.TwilioAccountSID, cfg.TwilioAuthToken, cfg.TwilioCallerID,
+			webhookURL+"/"+string(constants.TelephonyProviderTwilio), client))
+	}
+	return r
+}
+
+// Register adds or replaces a provider. The first provider registered places click-to-dial calls.
+func (r *Registry) Register(p Provider) {
+	if r.dialer == "" {
+		r.dialer = p.Name()
+	}
+	r.providers[p.Name()] = p
+}
+
+// Get returns a configured provider
+func (r *Registry) Get(name constants.TelephonyProvider) (Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("telephony provider not configured: %s", name)
+	}
+	return p, nil
+}
+
+// Dialer returns the provider that places click-to-dial calls
+func (r *Registry) Dialer() (Provider, error) {
+	if r.dialer == "" {
+		return nil, fmt.Errorf("no telephony provider is configured")
+	}
+	return r.providers[r.dialer], nil
+}
+
+// Names lists the configured providers
+func (r *Registry) Names() []constants.TelephonyProvider {
+	names := make([]constants.TelephonyProvider, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
@@ -0,0 +1,167 @@
+package telephony
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// twilioStatuses maps Twilio call statuses onto constants.CallStatus* values
+var twilioStatuses = map[string]string{
+	"queued":      constants.CallStatusInitiated,
+	"initiated":   constants.CallStatusInitiated,
+	"ringing":     constants.CallStatusRinging,
+	"in-progress": constants.CallStatusInProgress,
+	"completed":   constants.CallStatusCompleted,
+	"busy":        constants.CallStatusBusy,
+	"no-answer":   constants.CallStatusNoAnswer,
+	"failed":      constants.CallStatusFailed,
+	"canceled":    constants.CallStatusCanceled,
+}
+
+// TwilioProvider places calls through the Twilio Voice API and receives its status callbacks
+type TwilioProvider struct {
+	accountSID string
+	authToken  string
+	callerID   string
+	webhookURL string
+	apiURL     string
+	client     *http.Client
+}
+
+// NewTwilioProvider creates a Twilio provider. Calls show callerID, a number of the account;
+// webhookURL is the public URL Twilio posts call events to, and must match exactly for the
+// signatures to verify.
+func NewTwilioProvider(accountSID, authToken, callerID, webhookURL string, client *http.Client) *TwilioProvider {
+	return &TwilioProvider{
+		accountSID: accountSID,
+		authToken:  authToken,
+		callerID:   callerID,
+		webhookURL: webhookURL,
+		apiURL:     "https://api.twilio.com/2010-04-01",
+		client:     client,
+	}
+}
+
+func (p *TwilioProvider) Name() constants.TelephonyProvider { return constants.TelephonyProviderTwilio }
+
+// Dial rings the agent and, once they answer, dials the number with the account's caller ID
+func (p *TwilioProvider) Dial(ctx context.Context, req DialRequest) (string, error) {
+	var to strings.Builder
+	if err := xml.EscapeText(&to, []byte(req.To)); err != nil {
+		return "", err
+	}
+	var callerID strings.Builder
+	if err := xml.EscapeText(&callerID, []byte(p.callerID)); err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"To":                   {req.AgentNumber},
+		"From":                 {p.callerID},
+		"Twiml":                {fmt.Sprintf(`<Response><Dial callerId="%s"><Number>%s</Number></Dial></Response>`, callerID.String(), to.String())},
+		"StatusCallback":       {p.webhookURL},
+		"StatusCallbackMethod": {http.MethodPost},
+		"StatusCallbackEvent":  {"initiated", "ringing", "answered", "completed"},
+	}
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Calls.json", p.apiURL, url.PathEscape(p.accountSID))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	httpReq.SetBasicAuth(p.accountSID, p.authToken)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("twilio call failed: %w", err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		SID     string `json:"sid"`
+		Message string `json:"message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	_ = json.Unmarshal(data, &body)
+	if resp.StatusCode >= 300 || body.SID == "" {
+		if body.Message != "" {
+			return "", fmt.Errorf("twilio call failed: %s", body.Message)
+		}
+		return "", fmt.Errorf("twilio call failed with status %d", resp.StatusCode)
+	}
+	return body.SID, nil
+}
+
+// ParseEvent verifies the X-Twilio-Signature of a status callback and decodes it
+func (p *TwilioProvider) ParseEvent(r *http.Request) (*CallEvent, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	if !p.validSignature(r.Header.Get("X-Twilio-Signature"), r.PostForm) {
+		return nil, ErrInvalidSignature
+	}
+
+	form := r.PostForm
+	event := &CallEvent{
+		CallID:       form.Get("CallSid"),
+		From:         form.Get("From"),
+		To:           form.Get("To"),
+		Direction:    constants.CallDirectionOutbound,
+		Status:       twilioStatuses[form.Get("CallStatus")],
+		RecordingURL: form.Get("RecordingUrl"),
+		Timestamp:    time.Now().UTC(),
+	}
+	if event.CallID == "" {
+		return nil, fmt.Errorf("missing CallSid")
+	}
+	if form.Get("Direction") == "inbound" {
+		event.Direction = constants.CallDirectionInbound
+	}
+	if event.Status == "" {
+		event.Status = constants.CallStatusInitiated
+	}
+	if raw := form.Get("CallDuration"); raw != "" {
+		if seconds, err := strconv.Atoi(raw); err == nil {
+			event.Duration = &seconds
+		}
+	}
+	if ts, err := time.Parse(time.RFC1123Z, form.Get("Timestamp")); err == nil {
+		event.Timestamp = ts.UTC()
+	}
+	return event, nil
+}
+
+// validSignature checks Twilio's request signature: the base64 HMAC-SHA1, keyed with the
+// auth token, of the webhook URL followed by the sorted POST parameters
+func (p *TwilioProvider) validSignature(signature string, form url.Values) bool {
+	if signature == "" {
+		return false
+	}
+	keys := make([]string, 0, len(form))
+	for k := range form {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mac := hmac.New(sha1.New, []byte(p.authToken))
+	mac.Write([]byte(p.webhookURL))
+	for _, k := range keys {
+		for _, v := range form[k] {
+			mac.Write([]byte(k + v))
+		}
+	}
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,77 @@
+package telephony
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookURL = "https://crm.example.com/api/telephony/webhooks/twilio"
+
+func TestTwilioDial(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Accounts/AC1/Calls.json", r.URL.Path)
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "AC1", user)
+		assert.Equal(t, "token", pass)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "+15550001111", r.PostForm.Get("To"))
+		assert.Equal(t, `<Response><Dial callerId="+15559990000"><Number>+1 555 &lt;123&gt;</Number></Dial></Response>`, r.PostForm.Get("Twiml"))
+		assert.Equal(t, []string{"initiated", "ringing", "answered", "completed"}, r.PostForm["StatusCallbackEvent"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid": "CA123", "status": "queued"}`))
+	}))
+	defer srv.Close()
+
+	p := NewTwilioProvider("AC1", "token", "+15559990000", testWebhookURL, srv.Client())
+	p.apiURL = srv.URL
+	sid, err := p.Dial(context.Background(), DialRequest{AgentNumber: "+15550001111", To: "+1 555 <123>"})
+	require.NoError(t, err)
+	assert.Equal(t, "CA123", sid)
+}
+
+func TestTwilioParseEvent(t *testing.T) {
+	p := NewTwilioProvider("AC1", "token", "+15559990000", testWebhookURL, http.DefaultClient)
+	form := url.Values{
+		"CallSid":      {"CA123"},
+		"From":         {"+15551234567"},
+		"To":           {"+15559990000"},
+		"Direction":    {"inbound"},
+		"CallStatus":   {"completed"},
+		"CallDuration": {"42"},
+		"Timestamp":    {"Mon, 16 Aug 2010 03:45:01 +0000"},
+	}
+	sign := func() string {
+		mac := hmac.New(sha1.New, []byte("token"))
+		mac.Write([]byte(testWebhookURL + "CallDuration42CallSidCA123CallStatuscompletedDirectioninboundFrom+15551234567TimestampMon, 16 Aug 2010 03:45:01 +0000To+15559990000"))
+		return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	request := func(signature string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/telephony/webhooks/twilio", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("X-Twilio-Signature", signature)
+		return r
+	}
+
+	event, err := p.ParseEvent(request(sign()))
+	require.NoError(t, err)
+	assert.Equal(t, "CA123", event.CallID)
+	assert.Equal(t, constants.CallDirectionInbound, event.Direction)
+	assert.Equal(t, constants.CallStatusCompleted, event.Status)
+	require.NotNil(t, event.Duration)
+	assert.Equal(t, 42, *event.Duration)
+	assert.Equal(t, 2010, event.Timestamp.Year())
+
+	_, err = p.ParseEvent(request("bogus"))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
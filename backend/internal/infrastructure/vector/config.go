@@ -0,0 +1,59 @@
+// Package vector provides the semantic search adapters: Embedder implementations that
+// turn record text into vectors, and VectorStore implementations that keep them.
+package vector
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// Supported EMBEDDING_PROVIDER and VECTOR_STORE values
+const (
+	ProviderNone   = "none"
+	ProviderOpenAI = "openai"
+
+	StoreTiDB   = "tidb"
+	StoreMemory = "memory"
+
+	defaultEmbeddingURL   = "https://api.openai.com/v1/embeddings"
+	defaultEmbeddingModel = "text-embedding-3-small"
+)
+
+// New builds the Embedder selected by EMBEDDING_PROVIDER and the VectorStore selected by VECTOR_STORE.
+// Returns (nil, nil, nil) when semantic search is disabled.
+//
+//	EMBEDDING_PROVIDER=openai    any OpenAI-compatible /embeddings endpoint; optional EMBEDDING_BASE_URL,
+//	                             EMBEDDING_API_KEY, EMBEDDING_MODEL
+//	VECTOR_STORE=tidb            vectors in the _System_Record_Embedding VECTOR column (default)
+//	VECTOR_STORE=memory          in-process store rebuilt at startup
+func New(cfg config.Embeddings, db *sql.DB) (ports.Embedder, ports.VectorStore, error) {
+	var embedder ports.Embedder
+	switch cfg.Provider {
+	case "", ProviderNone:
+		return nil, nil, nil
+	case ProviderOpenAI:
+		url := cfg.BaseURL
+		if url == "" {
+			url = defaultEmbeddingURL
+		}
+		model := cfg.Model
+		if model == "" {
+			model = defaultEmbeddingModel
+		}
+		embedder = NewOpenAIEmbedder(url, cfg.APIKey, model)
+	default:
+		return nil, nil, fmt.Errorf("unsupported EMBEDDING_PROVIDER %q", cfg.Provider)
+	}
+
+	switch cfg.VectorStore {
+	case "", StoreTiDB:
+		return embedder, NewTiDBStore(db), nil
+	case StoreMemory:
+		return embedder, NewMemoryStore(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported VECTOR_STORE %q", cfg.VectorStore)
+	}
+}
@@ -0,0 +1,122 @@
+package vector
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+const defaultSearchLimit = 50
+
+// MemoryStore keeps embeddings in process and ranks them by brute-force cosine similarity.
+// It is rebuilt from the database at startup and kept current from record events.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	docs map[string]ports.VectorDocument
+}
+
+// Ensure MemoryStore implements ports.VectorStore at compile time
+var _ ports.VectorStore = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty in-process store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{docs: make(map[string]ports.VectorDocument)}
+}
+
+// Name returns the store identifier
+func (m *MemoryStore) Name() string {
+	return StoreMemory
+}
+
+// Upsert adds or replaces a record's embedding
+func (m *MemoryStore) Upsert(_ context.Context, doc ports.VectorDocument) error {
+	doc.ObjectAPIName = strings.ToLower(doc.ObjectAPIName)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.docs[docKey(doc.ObjectAPIName, doc.RecordID)] = doc
+	return nil
+}
+
+// Remove deletes a record's embedding
+func (m *MemoryStore) Remove(_ context.Context, objectAPIName, recordID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.docs, docKey(objectAPIName, recordID))
+	return nil
+}
+
+// ContentHash returns the hash stored with a record's embedding
+func (m *MemoryStore) ContentHash(_ context.Context, objectAPIName, recordID string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.docs[docKey(objectAPIName, recordID)].ContentHash, nil
+}
+
+// Vector returns a record's stored embedding
+func (m *MemoryStore) Vector(_ context.Context, objectAPIName, recordID string) ([]float32, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.docs[docKey(objectAPIName, recordID)].Vector, nil
+}
+
+// Search returns hits ordered by descending cosine similarity
+func (m *MemoryStore) Search(_ context.Context, q ports.VectorQuery) ([]ports.VectorHit, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	scope := make(map[string]bool, len(q.ObjectAPINames))
+	for _, name := range q.ObjectAPINames {
+		scope[strings.ToLower(name)] = true
+	}
+
+	m.mu.RLock()
+	hits := make([]ports.VectorHit, 0)
+	for _, doc := range m.docs {
+		if len(scope) > 0 && !scope[doc.ObjectAPIName] {
+			continue
+		}
+		if len(doc.Vector) != len(q.Vector) {
+			continue // Embedded by a different model
+		}
+		hits = append(hits, ports.VectorHit{
+			ObjectAPIName: doc.ObjectAPIName,
+			RecordID:      doc.RecordID,
+			Score:         cosineSimilarity(q.Vector, doc.Vector),
+		})
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].RecordID < hits[j].RecordID
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+func docKey(objectAPIName, recordID string) string {
+	return strings.ToLower(objectAPIName) + "/" + recordID
+}
+
+// cosineSimilarity returns the cosine of the angle between a and b, or 0 for a zero vector
+func cosineSimilarity(a, b []float32) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
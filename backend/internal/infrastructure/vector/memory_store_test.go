@@ -0,0 +1,72 @@
+package vector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_RanksByCosineSimilarity(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	for _, d := range []ports.VectorDocument{
+		{ObjectAPIName: "Account", RecordID: "a1", Vector: []float32{1, 0, 0}, ContentHash: "h1"},
+		{ObjectAPIName: "account", RecordID: "a2", Vector: []float32{0.7, 0.7, 0}},
+		{ObjectAPIName: "contact", RecordID: "c1", Vector: []float32{0.9, 0.1, 0}},
+		{ObjectAPIName: "contact", RecordID: "c2", Vector: []float32{1, 0}}, // Different model
+	} {
+		require.NoError(t, store.Upsert(ctx, d))
+	}
+
+	hits, err := store.Search(ctx, ports.VectorQuery{Vector: []float32{1, 0, 0}})
+	require.NoError(t, err)
+	require.Len(t, hits, 3)
+	assert.Equal(t, []string{"a1", "c1", "a2"}, []string{hits[0].RecordID, hits[1].RecordID, hits[2].RecordID})
+	assert.InDelta(t, 1.0, hits[0].Score, 1e-9)
+
+	hits, err = store.Search(ctx, ports.VectorQuery{Vector: []float32{1, 0, 0}, ObjectAPINames: []string{"ACCOUNT"}, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "a1", hits[0].RecordID)
+
+	hash, err := store.ContentHash(ctx, "account", "a1")
+	require.NoError(t, err)
+	assert.Equal(t, "h1", hash)
+	v, err := store.Vector(ctx, "ACCOUNT", "a1")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 0, 0}, v)
+
+	require.NoError(t, store.Remove(ctx, "account", "a1"))
+	hash, err = store.ContentHash(ctx, "account", "a1")
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
+func TestOpenAIEmbedder_OrdersVectorsByIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		var req struct {
+			Model string   `json:"model"`
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "test-model", req.Model)
+		assert.Equal(t, []string{"first", "second"}, req.Input)
+		_, _ = w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer server.Close()
+
+	vectors, err := NewOpenAIEmbedder(server.URL, "key", "test-model").Embed(context.Background(), []string{"first", "second"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{1, 0}, {0, 1}}, vectors)
+}
+
+func TestVectorLiteral(t *testing.T) {
+	assert.Equal(t, "[0.5,-1,0.25]", vectorLiteral([]float32{0.5, -1, 0.25}))
+}
@@ -0,0 +1,93 @@
+package vector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+)
+
+// OpenAIEmbedder calls an OpenAI-compatible embeddings endpoint
+type OpenAIEmbedder struct {
+	url    string
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+// Ensure OpenAIEmbedder implements ports.Embedder at compile time
+var _ ports.Embedder = (*OpenAIEmbedder)(nil)
+
+// NewOpenAIEmbedder creates an embedder posting to url (the full /embeddings URL) with model
+func NewOpenAIEmbedder(url, apiKey, model string) *OpenAIEmbedder {
+	return &OpenAIEmbedder{
+		url:    strings.TrimRight(url, "/"),
+		apiKey: apiKey,
+		model:  model,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name returns the embedding model
+func (e *OpenAIEmbedder) Name() string {
+	return e.model
+}
+
+// Embed returns one vector per text, in input order
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{"model": e.model, "input": texts})
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: failed to encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("embeddings: %s returned %d: %s", e.url, resp.StatusCode, string(msg))
+	}
+
+	var out struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("embeddings: failed to decode response: %w", err)
+	}
+	if len(out.Data) != len(texts) {
+		return nil, fmt.Errorf("embeddings: expected %d vectors, got %d", len(texts), len(out.Data))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range out.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("embeddings: vector index %d out of range", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
@@ -0,0 +1,158 @@
+package vector
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/domain/ports"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/backend/pkg/utils"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// TiDBStore keeps embeddings in the VECTOR column of _System_Record_Embedding and ranks
+// them with VEC_COSINE_DISTANCE, so vectors survive restarts and need no rebuild.
+type TiDBStore struct {
+	db *sql.DB
+}
+
+// Ensure TiDBStore implements ports.VectorStore at compile time
+var _ ports.VectorStore = (*TiDBStore)(nil)
+
+// NewTiDBStore creates a store on db
+func NewTiDBStore(db *sql.DB) *TiDBStore {
+	return &TiDBStore{db: db}
+}
+
+// Name returns the store identifier
+func (t *TiDBStore) Name() string {
+	return StoreTiDB
+}
+
+// Upsert adds or replaces a record's embedding
+func (t *TiDBStore) Upsert(ctx context.Context, doc ports.VectorDocument) error {
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE %s = VALUES(%s), %s = VALUES(%s), %s = VALUES(%s), %s = NOW()`,
+		constants.TableRecordEmbedding, constants.FieldSysRecordEmbedding_ID, constants.FieldSysRecordEmbedding_ObjectAPIName,
+		constants.FieldSysRecordEmbedding_RecordID, constants.FieldSysRecordEmbedding_Embedding, constants.FieldSysRecordEmbedding_ContentHash,
+		constants.FieldSysRecordEmbedding_Model, constants.FieldSysRecordEmbedding_CreatedDate, constants.FieldSysRecordEmbedding_LastModifiedDate,
+		constants.FieldSysRecordEmbedding_Embedding, constants.FieldSysRecordEmbedding_Embedding,
+		constants.FieldSysRecordEmbedding_ContentHash, constants.FieldSysRecordEmbedding_ContentHash,
+		constants.FieldSysRecordEmbedding_Model, constants.FieldSysRecordEmbedding_Model,
+		constants.FieldSysRecordEmbedding_LastModifiedDate)
+	_, err := t.db.ExecContext(ctx, stmt, utils.GenerateID(), strings.ToLower(doc.ObjectAPIName), doc.RecordID,
+		vectorLiteral(doc.Vector), doc.ContentHash, doc.Model)
+	if err != nil {
+		return fmt.Errorf("failed to save embedding: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes a record's embedding
+func (t *TiDBStore) Remove(ctx context.Context, objectAPIName, recordID string) error {
+	q := query.Delete(constants.TableRecordEmbedding).
+		Where(constants.FieldSysRecordEmbedding_ObjectAPIName+" = ?", strings.ToLower(objectAPIName)).
+		Where(constants.FieldSysRecordEmbedding_RecordID+" = ?", recordID).
+		Build()
+
+	_, err := t.db.ExecContext(ctx, q.SQL, q.Params...)
+	return err
+}
+
+// ContentHash returns the hash stored with a record's embedding
+func (t *TiDBStore) ContentHash(ctx context.Context, objectAPIName, recordID string) (string, error) {
+	q := query.From(constants.TableRecordEmbedding).
+		Select([]string{constants.FieldSysRecordEmbedding_ContentHash}).
+		Where(constants.FieldSysRecordEmbedding_ObjectAPIName+" = ?", strings.ToLower(objectAPIName)).
+		Where(constants.FieldSysRecordEmbedding_RecordID+" = ?", recordID).
+		Build()
+
+	var id, hash string
+	err := t.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&id, &hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return hash, err
+}
+
+// Vector returns a record's stored embedding
+func (t *TiDBStore) Vector(ctx context.Context, objectAPIName, recordID string) ([]float32, error) {
+	q := query.From(constants.TableRecordEmbedding).
+		Select([]string{constants.FieldSysRecordEmbedding_Embedding}).
+		Where(constants.FieldSysRecordEmbedding_ObjectAPIName+" = ?", strings.ToLower(objectAPIName)).
+		Where(constants.FieldSysRecordEmbedding_RecordID+" = ?", recordID).
+		Build()
+
+	var id, literal string
+	err := t.db.QueryRowContext(ctx, q.SQL, q.Params...).Scan(&id, &literal)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var v []float32
+	if err := json.Unmarshal([]byte(literal), &v); err != nil {
+		return nil, fmt.Errorf("invalid stored vector: %w", err)
+	}
+	return v, nil
+}
+
+// Search returns hits ordered by descending cosine similarity
+func (t *TiDBStore) Search(ctx context.Context, vq ports.VectorQuery) ([]ports.VectorHit, error) {
+	limit := vq.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	// The query vector is built from floats only, so it is inlined rather than bound:
+	// the builder binds WHERE parameters, not select expressions
+	distance := fmt.Sprintf("VEC_COSINE_DISTANCE(`%s`, '%s')", constants.FieldSysRecordEmbedding_Embedding, vectorLiteral(vq.Vector))
+	b := query.From(constants.TableRecordEmbedding).
+		Select([]string{constants.FieldSysRecordEmbedding_ObjectAPIName, constants.FieldSysRecordEmbedding_RecordID}).
+		AddSelectRaw(distance, "distance").
+		Where(fmt.Sprintf("VEC_DIMS(`%s`) = ?", constants.FieldSysRecordEmbedding_Embedding), len(vq.Vector)) // Skip vectors from another model
+	if len(vq.ObjectAPINames) > 0 {
+		params := make([]interface{}, len(vq.ObjectAPINames))
+		for i, name := range vq.ObjectAPINames {
+			params[i] = strings.ToLower(name)
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(params)), ", ")
+		b = b.WhereRaw(fmt.Sprintf("`%s` IN (%s)", constants.FieldSysRecordEmbedding_ObjectAPIName, placeholders), params)
+	}
+	q := b.OrderBy("`distance`", constants.SortASC).Limit(limit).Build()
+
+	rows, err := t.db.QueryContext(ctx, q.SQL, q.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("vector search failed: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]ports.VectorHit, 0)
+	for rows.Next() {
+		var id string
+		var hit ports.VectorHit
+		var dist float64
+		if err := rows.Scan(&id, &hit.ObjectAPIName, &hit.RecordID, &dist); err != nil {
+			return nil, err
+		}
+		hit.Score = 1 - dist
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+// vectorLiteral formats v in TiDB's vector text form, e.g. [0.1,-0.2]
+func vectorLiteral(v []float32) string {
+	parts := make([]string, len(v))
+	for i, f := range v {
+		parts[i] = strconv.FormatFloat(float64(f), 'g', -1, 32)
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
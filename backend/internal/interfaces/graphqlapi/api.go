@@ -0,0 +1,222 @@
+// Package graphqlapi serves a GraphQL API whose schema is generated at runtime from
+// object metadata. Each request gets a schema built for the caller: objects they
+// cannot read and fields hidden by field-level security are simply not part of it.
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// accessChecker decides what the caller may see and change; implemented by services.PermissionService
+type accessChecker interface {
+	CheckObjectPermissionWithUser(ctx context.Context, objectAPIName string, operation string, user *models.UserSession) bool
+	CheckFieldVisibilityWithUser(ctx context.Context, objectAPIName, fieldAPIName string, user *models.UserSession) bool
+	CheckFieldEditabilityWithUser(ctx context.Context, objectAPIName, fieldAPIName string, user *models.UserSession) bool
+}
+
+// dataSource reads and writes records on behalf of the caller
+type dataSource interface {
+	Query(ctx context.Context, req models.QueryRequest, user *models.UserSession) ([]models.SObject, error)
+	// FindByIDs returns the records among ids that the caller can read
+	FindByIDs(ctx context.Context, objectAPIName string, ids []string, user *models.UserSession) ([]models.SObject, error)
+	Insert(ctx context.Context, objectAPIName string, data models.SObject, user *models.UserSession) (models.SObject, error)
+	Update(ctx context.Context, objectAPIName, id string, data models.SObject, user *models.UserSession) error
+	Delete(ctx context.Context, objectAPIName, id string, user *models.UserSession) error
+}
+
+// API executes GraphQL requests
+type API struct {
+	schemas func(ctx context.Context) []*models.ObjectMetadata
+	perms   accessChecker
+	data    dataSource
+}
+
+// New creates an API backed by the service layer
+func New(svc *services.ServiceManager) *API {
+	return &API{
+		schemas: svc.GetSchemas,
+		perms:   svc.Permissions,
+		data:    &serviceDataSource{svc: svc},
+	}
+}
+
+// Execute runs a query or mutation as user. Errors are reported in the result, as
+// GraphQL clients expect.
+func (a *API) Execute(ctx context.Context, user *models.UserSession, query string, variables map[string]interface{}, operationName string) *graphql.Result {
+	schema, err := newSchemaBuilder(ctx, user, a.perms, newLoader(a.data, user)).build(a.schemas(ctx))
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{{Message: err.Error(), Extensions: codedError{err}.Extensions()}}}
+	}
+	return graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  query,
+		VariableValues: variables,
+		OperationName:  operationName,
+		Context:        ctx,
+	})
+}
+
+// codedError reports the API error code, field path and i18n key of a resolver error as
+// GraphQL error extensions
+type codedError struct {
+	error
+}
+
+func (e codedError) Extensions() map[string]interface{} {
+	resp := appErrors.ToResponse(e.error)
+	extensions := map[string]interface{}{"code": resp.Code, "i18n_key": resp.I18nKey}
+	if resp.Field != "" {
+		extensions["field"] = resp.Field
+	}
+	return extensions
+}
+
+func (e codedError) Unwrap() error {
+	return e.error
+}
+
+// withErrorCodes wraps the resolvers of fields so the errors they return are codedErrors
+func withErrorCodes(fields graphql.Fields) graphql.Fields {
+	for _, field := range fields {
+		resolve := field.Resolve
+		if resolve == nil {
+			continue
+		}
+		field.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+			result, err := resolve(p)
+			if err != nil {
+				return result, codedError{err}
+			}
+			return result, nil
+		}
+	}
+	return fields
+}
+
+// serviceDataSource adapts the ServiceManager to dataSource
+type serviceDataSource struct {
+	svc *services.ServiceManager
+}
+
+func (d *serviceDataSource) Query(ctx context.Context, req models.QueryRequest, user *models.UserSession) ([]models.SObject, error) {
+	return d.svc.QuerySvc.Query(ctx, req, user)
+}
+
+func (d *serviceDataSource) FindByIDs(ctx context.Context, objectAPIName string, ids []string, user *models.UserSession) ([]models.SObject, error) {
+	records, err := d.svc.QuerySvc.QueryByIDs(ctx, objectAPIName, ids, user)
+	if err != nil {
+		return nil, err
+	}
+	schema := d.svc.Metadata.GetSchema(ctx, objectAPIName)
+	readable := make([]models.SObject, 0, len(records))
+	for _, r := range records {
+		if schema == nil || d.svc.Permissions.CheckRecordAccess(ctx, schema, r, constants.PermRead, user) {
+			readable = append(readable, r)
+		}
+	}
+	return readable, nil
+}
+
+func (d *serviceDataSource) Insert(ctx context.Context, objectAPIName string, data models.SObject, user *models.UserSession) (models.SObject, error) {
+	return d.svc.Persistence.Insert(ctx, objectAPIName, data, user)
+}
+
+func (d *serviceDataSource) Update(ctx context.Context, objectAPIName, id string, data models.SObject, user *models.UserSession) error {
+	return d.svc.Persistence.Update(ctx, objectAPIName, id, data, user)
+}
+
+func (d *serviceDataSource) Delete(ctx context.Context, objectAPIName, id string, user *models.UserSession) error {
+	return d.svc.Persistence.Delete(ctx, objectAPIName, id, user)
+}
+
+// record is a record as served over GraphQL: JSON-shaped, so dates are strings and
+// numbers are float64, matching the REST API
+type record = map[string]interface{}
+
+func toRecords(objects []models.SObject) ([]record, error) {
+	raw, err := json.Marshal(objects)
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// loader caches records by ID for the duration of one request, so that a lookup
+// shared by many rows is fetched once
+type loader struct {
+	data dataSource
+	user *models.UserSession
+
+	mu    sync.Mutex
+	cache map[string]map[string]record // object -> id -> record; nil when not readable
+}
+
+func newLoader(data dataSource, user *models.UserSession) *loader {
+	return &loader{data: data, user: user, cache: make(map[string]map[string]record)}
+}
+
+// prefetch loads the uncached ids of an object in one round trip
+func (l *loader) prefetch(ctx context.Context, objectAPIName string, ids []string) error {
+	l.mu.Lock()
+	cached := l.cache[objectAPIName]
+	if cached == nil {
+		cached = make(map[string]record)
+		l.cache[objectAPIName] = cached
+	}
+	missing := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := cached[id]; !ok && id != "" && !seen[id] {
+			seen[id] = true
+			missing = append(missing, id)
+		}
+	}
+	l.mu.Unlock()
+	if len(missing) == 0 {
+		return nil
+	}
+
+	objects, err := l.data.FindByIDs(ctx, objectAPIName, missing, l.user)
+	if err != nil {
+		return err
+	}
+	records, err := toRecords(objects)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, id := range missing {
+		cached[id] = nil
+	}
+	for _, r := range records {
+		if id, ok := r[constants.FieldID].(string); ok {
+			cached[id] = r
+		}
+	}
+	return nil
+}
+
+// load returns one record, or nil when it does not exist or the caller cannot read it
+func (l *loader) load(ctx context.Context, objectAPIName, id string) (record, error) {
+	if err := l.prefetch(ctx, objectAPIName, []string{id}); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cache[objectAPIName][id], nil
+}
@@ -0,0 +1,584 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 200
+)
+
+var namePattern = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// jsonScalar carries JSON field values as they are
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "JSON",
+	Description:  "Any JSON value",
+	Serialize:    func(v interface{}) interface{} { return v },
+	ParseValue:   func(v interface{}) interface{} { return v },
+	ParseLiteral: parseJSONLiteral,
+})
+
+var sortDirectionEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "SortDirection",
+	Values: graphql.EnumValueConfigMap{
+		constants.SortASC:  &graphql.EnumValueConfig{Value: constants.SortASC},
+		constants.SortDESC: &graphql.EnumValueConfig{Value: constants.SortDESC},
+	},
+})
+
+// reservedTypeNames are taken by the schema itself
+var reservedTypeNames = map[string]bool{"Query": true, "Mutation": true, "JSON": true, "SortDirection": true}
+
+// objectType is the GraphQL view of one object
+type objectType struct {
+	schema    *models.ObjectMetadata
+	name      string
+	fields    []*scalarField
+	byName    map[string]bool // GraphQL field names in use on the type
+	relations []*relation
+	children  []*childRelation
+	output    *graphql.Object
+	whereType *graphql.InputObject
+}
+
+// scalarField maps a GraphQL field onto a column
+type scalarField struct {
+	name     string
+	apiName  string
+	output   graphql.Output
+	stored   bool // Can be filtered on
+	editable bool
+}
+
+// relation resolves a lookup field to the referenced record
+type relation struct {
+	name    string
+	apiName string
+	target  *objectType
+}
+
+// childRelation lists the records of another object that look up to this one
+type childRelation struct {
+	name    string
+	apiName string // Lookup field on the child
+	child   *objectType
+}
+
+type schemaBuilder struct {
+	ctx     context.Context
+	user    *models.UserSession
+	perms   accessChecker
+	loader  *loader
+	objects map[string]*objectType
+	order   []*objectType
+}
+
+func newSchemaBuilder(ctx context.Context, user *models.UserSession, perms accessChecker, l *loader) *schemaBuilder {
+	return &schemaBuilder{ctx: ctx, user: user, perms: perms, loader: l, objects: make(map[string]*objectType)}
+}
+
+// build generates the schema for the objects the caller can read
+func (b *schemaBuilder) build(schemas []*models.ObjectMetadata) (graphql.Schema, error) {
+	sorted := make([]*models.ObjectMetadata, len(schemas))
+	copy(sorted, schemas)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].APIName < sorted[j].APIName })
+
+	typeNames := make(map[string]bool)
+	for _, s := range sorted {
+		// System tables have their own admin APIs
+		if strings.HasPrefix(s.APIName, "_") || !namePattern.MatchString(s.APIName) {
+			continue
+		}
+		if !b.perms.CheckObjectPermissionWithUser(b.ctx, s.APIName, constants.PermRead, b.user) {
+			continue
+		}
+		name := typeName(s.APIName)
+		if reservedTypeNames[name] || typeNames[name] {
+			continue
+		}
+		typeNames[name] = true
+		obj := &objectType{schema: s, name: name, byName: make(map[string]bool)}
+		b.objects[s.APIName] = obj
+		b.order = append(b.order, obj)
+	}
+
+	for _, obj := range b.order {
+		b.addFields(obj)
+	}
+	for _, obj := range b.order {
+		b.addRelations(obj)
+	}
+	for _, obj := range b.order {
+		obj.output = b.outputType(obj)
+	}
+
+	query := graphql.Fields{
+		"objects": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			Description: "API names of the objects in this schema",
+			Resolve: func(graphql.ResolveParams) (interface{}, error) {
+				names := make([]string, len(b.order))
+				for i, obj := range b.order {
+					names[i] = obj.schema.APIName
+				}
+				return names, nil
+			},
+		},
+	}
+	mutation := graphql.Fields{}
+	for _, obj := range b.order {
+		b.addQueryFields(query, obj)
+		b.addMutationFields(mutation, obj)
+	}
+
+	config := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: withErrorCodes(query)}),
+	}
+	if len(mutation) > 0 {
+		config.Mutation = graphql.NewObject(graphql.ObjectConfig{Name: "Mutation", Fields: withErrorCodes(mutation)})
+	}
+	return graphql.NewSchema(config)
+}
+
+// addFields collects the fields the caller can see; system and name fields are always visible
+func (b *schemaBuilder) addFields(obj *objectType) {
+	s := obj.schema
+	readOnly := s.IsExternal
+	for _, f := range s.Fields {
+		if f.Type == constants.FieldTypePassword {
+			continue
+		}
+		if !f.IsSystem && !f.IsNameField && !b.perms.CheckFieldVisibilityWithUser(b.ctx, s.APIName, f.APIName, b.user) {
+			continue
+		}
+		name := fieldName(f.APIName)
+		if !namePattern.MatchString(name) || strings.HasPrefix(name, "__") || obj.byName[name] {
+			continue
+		}
+
+		output := outputFor(f)
+		computed := f.Type == constants.FieldTypeFormula || f.Type == constants.FieldTypeRollupSummary || f.Type == constants.FieldTypeAutoNumber
+		if f.APIName == constants.FieldID {
+			output = graphql.NewNonNull(graphql.ID)
+		}
+		obj.byName[name] = true
+		obj.fields = append(obj.fields, &scalarField{
+			name:     name,
+			apiName:  f.APIName,
+			output:   output,
+			stored:   f.Type != constants.FieldTypeFormula && output != jsonScalar,
+			editable: !readOnly && !f.IsSystem && !computed && b.perms.CheckFieldEditabilityWithUser(b.ctx, s.APIName, f.APIName, b.user),
+		})
+	}
+}
+
+// addRelations links single-target lookups to their parent type, and the parent back to its children
+func (b *schemaBuilder) addRelations(obj *objectType) {
+	for _, f := range obj.fields {
+		meta := fieldMeta(obj.schema, f.apiName)
+		if meta == nil || (meta.Type != constants.FieldTypeLookup && meta.Type != constants.FieldTypeMasterDetail) || len(meta.ReferenceTo) != 1 {
+			continue
+		}
+		target := b.objects[strings.ToLower(meta.ReferenceTo[0])]
+		if target == nil {
+			continue
+		}
+
+		name := strings.TrimSuffix(f.name, "_id")
+		if name == f.name || obj.byName[name] {
+			name = f.name + "_record"
+		}
+		if !obj.byName[name] {
+			obj.byName[name] = true
+			obj.relations = append(obj.relations, &relation{name: name, apiName: f.apiName, target: target})
+		}
+
+		childName := obj.schema.APIName + "_list"
+		if meta.RelationshipName != nil && *meta.RelationshipName != "" {
+			childName = strings.ToLower(*meta.RelationshipName)
+		}
+		if target.byName[childName] || !namePattern.MatchString(childName) {
+			childName = obj.schema.APIName + "_by_" + f.name
+		}
+		if !target.byName[childName] {
+			target.byName[childName] = true
+			target.children = append(target.children, &childRelation{name: childName, apiName: f.apiName, child: obj})
+		}
+	}
+}
+
+func (b *schemaBuilder) outputType(obj *objectType) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        obj.name,
+		Description: obj.schema.Label,
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			fields := graphql.Fields{}
+			for _, f := range obj.fields {
+				apiName := f.apiName
+				fields[f.name] = &graphql.Field{
+					Type: f.output,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(record)[apiName], nil
+					},
+				}
+			}
+			for _, rel := range obj.relations {
+				fields[rel.name] = &graphql.Field{
+					Type:        rel.target.output,
+					Description: "Record referenced by " + rel.apiName,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						id, _ := p.Source.(record)[rel.apiName].(string)
+						if id == "" {
+							return nil, nil
+						}
+						found, err := b.loader.load(p.Context, rel.target.schema.APIName, id)
+						if err != nil || found == nil {
+							return nil, err
+						}
+						return found, nil
+					},
+				}
+			}
+			for _, child := range obj.children {
+				fields[child.name] = &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(child.child.output))),
+					Description: fmt.Sprintf("%s records whose %s is this record", child.child.schema.APIName, child.apiName),
+					Args:        b.listArgs(child.child),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						id, _ := p.Source.(record)[constants.FieldID].(string)
+						criteria := []models.QueryCriterion{{Field: child.apiName, Op: "=", Val: id}}
+						return b.list(p, child.child, criteria)
+					},
+				}
+			}
+			return withErrorCodes(fields)
+		}),
+	})
+}
+
+func (b *schemaBuilder) addQueryFields(query graphql.Fields, obj *objectType) {
+	apiName := obj.schema.APIName
+	if _, taken := query[apiName]; !taken {
+		query[apiName] = &graphql.Field{
+			Type:        obj.output,
+			Description: "One " + obj.schema.Label + " record by ID",
+			Args:        graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				found, err := b.loader.load(p.Context, apiName, p.Args["id"].(string))
+				if err != nil || found == nil {
+					return nil, err
+				}
+				return found, nil
+			},
+		}
+	}
+	if _, taken := query[apiName+"_list"]; !taken {
+		query[apiName+"_list"] = &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(obj.output))),
+			Description: obj.schema.PluralLabel + " matching the filters",
+			Args:        b.listArgs(obj),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return b.list(p, obj, nil)
+			},
+		}
+	}
+}
+
+func (b *schemaBuilder) addMutationFields(mutation graphql.Fields, obj *objectType) {
+	if obj.schema.IsExternal {
+		return
+	}
+	apiName := obj.schema.APIName
+
+	inputFields := graphql.InputObjectConfigFieldMap{}
+	for _, f := range obj.fields {
+		if f.editable {
+			inputFields[f.name] = &graphql.InputObjectFieldConfig{Type: inputFor(f.output)}
+		}
+	}
+	var input *graphql.InputObject
+	if len(inputFields) > 0 {
+		input = graphql.NewInputObject(graphql.InputObjectConfig{Name: obj.name + "Input", Fields: inputFields})
+	}
+
+	if input != nil && b.perms.CheckObjectPermissionWithUser(b.ctx, apiName, constants.PermCreate, b.user) {
+		mutation["create_"+apiName] = &graphql.Field{
+			Type: obj.output,
+			Args: graphql.FieldConfigArgument{"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(input)}},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				created, err := b.loader.data.Insert(p.Context, apiName, obj.toSObject(p.Args["input"]), b.user)
+				if err != nil {
+					return nil, err
+				}
+				records, err := toRecords([]models.SObject{created})
+				if err != nil {
+					return nil, err
+				}
+				return records[0], nil
+			},
+		}
+	}
+	if input != nil && b.perms.CheckObjectPermissionWithUser(b.ctx, apiName, constants.PermEdit, b.user) {
+		mutation["update_"+apiName] = &graphql.Field{
+			Type: obj.output,
+			Args: graphql.FieldConfigArgument{
+				"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(input)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				id := p.Args["id"].(string)
+				if err := b.loader.data.Update(p.Context, apiName, id, obj.toSObject(p.Args["input"]), b.user); err != nil {
+					return nil, err
+				}
+				updated, err := b.loader.data.FindByIDs(p.Context, apiName, []string{id}, b.user)
+				if err != nil || len(updated) == 0 {
+					return nil, err
+				}
+				records, err := toRecords(updated)
+				if err != nil {
+					return nil, err
+				}
+				return records[0], nil
+			},
+		}
+	}
+	if b.perms.CheckObjectPermissionWithUser(b.ctx, apiName, constants.PermDelete, b.user) {
+		mutation["delete_"+apiName] = &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+			Args: graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := b.loader.data.Delete(p.Context, apiName, p.Args["id"].(string), b.user); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		}
+	}
+}
+
+// listArgs are the filter, sort and paging arguments of a list field
+func (b *schemaBuilder) listArgs(obj *objectType) graphql.FieldConfigArgument {
+	whereFields := graphql.InputObjectConfigFieldMap{}
+	for _, f := range obj.fields {
+		if f.stored {
+			whereFields[f.name] = &graphql.InputObjectFieldConfig{Type: inputFor(f.output)}
+		}
+	}
+	args := graphql.FieldConfigArgument{
+		"filter":          &graphql.ArgumentConfig{Type: graphql.String, Description: "Formula expression, e.g. \"amount > 1000 && stage != 'Closed'\""},
+		"order_by":        &graphql.ArgumentConfig{Type: graphql.String},
+		"order_direction": &graphql.ArgumentConfig{Type: sortDirectionEnum},
+		"limit":           &graphql.ArgumentConfig{Type: graphql.Int, Description: fmt.Sprintf("Defaults to %d, at most %d", defaultListLimit, maxListLimit)},
+		"offset":          &graphql.ArgumentConfig{Type: graphql.Int},
+	}
+	if len(whereFields) > 0 {
+		if obj.whereType == nil {
+			obj.whereType = graphql.NewInputObject(graphql.InputObjectConfig{
+				Name:        obj.name + "Where",
+				Description: "Fields that must equal the given values",
+				Fields:      whereFields,
+			})
+		}
+		args["where"] = &graphql.ArgumentConfig{Type: obj.whereType}
+	}
+	return args
+}
+
+// list runs a list field's query and prefetches the lookups selected below it
+func (b *schemaBuilder) list(p graphql.ResolveParams, obj *objectType, criteria []models.QueryCriterion) (interface{}, error) {
+	req := models.QueryRequest{ObjectAPIName: obj.schema.APIName, Criteria: criteria, Limit: defaultListLimit}
+	if filter, ok := p.Args["filter"].(string); ok {
+		req.FilterExpr = filter
+	}
+	if orderBy, ok := p.Args["order_by"].(string); ok && orderBy != "" {
+		f := obj.field(orderBy)
+		if f == nil || !f.stored {
+			return nil, fmt.Errorf("cannot order %s by %s", obj.name, orderBy)
+		}
+		req.SortField = f.apiName
+	}
+	if dir, ok := p.Args["order_direction"].(string); ok {
+		req.SortDirection = dir
+	}
+	if limit, ok := p.Args["limit"].(int); ok {
+		if limit < 1 || limit > maxListLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxListLimit)
+		}
+		req.Limit = limit
+	}
+	if offset, ok := p.Args["offset"].(int); ok {
+		if offset < 0 {
+			return nil, fmt.Errorf("offset must not be negative")
+		}
+		req.Offset = offset
+	}
+	if where, ok := p.Args["where"].(map[string]interface{}); ok {
+		for name, val := range where {
+			if f := obj.field(name); f != nil && val != nil {
+				req.Criteria = append(req.Criteria, models.QueryCriterion{Field: f.apiName, Op: "=", Val: val})
+			}
+		}
+		sort.Slice(req.Criteria, func(i, j int) bool { return req.Criteria[i].Field < req.Criteria[j].Field })
+	}
+
+	objects, err := b.loader.data.Query(p.Context, req, b.user)
+	if err != nil {
+		return nil, err
+	}
+	records, err := toRecords(objects)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := selectedFields(p.Info)
+	for _, rel := range obj.relations {
+		if !selected[rel.name] {
+			continue
+		}
+		ids := make([]string, 0, len(records))
+		for _, r := range records {
+			if id, ok := r[rel.apiName].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+		if err := b.loader.prefetch(p.Context, rel.target.schema.APIName, ids); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+func (obj *objectType) field(name string) *scalarField {
+	for _, f := range obj.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// toSObject maps mutation input onto field API names
+func (obj *objectType) toSObject(input interface{}) models.SObject {
+	values, _ := input.(map[string]interface{})
+	data := make(models.SObject, len(values))
+	for name, val := range values {
+		if f := obj.field(name); f != nil {
+			data[f.apiName] = val
+		}
+	}
+	return data
+}
+
+// selectedFields returns the names selected directly below the resolved field
+func selectedFields(info graphql.ResolveInfo) map[string]bool {
+	selected := make(map[string]bool)
+	var walk func(set *ast.SelectionSet)
+	walk = func(set *ast.SelectionSet) {
+		if set == nil {
+			return
+		}
+		for _, s := range set.Selections {
+			switch sel := s.(type) {
+			case *ast.Field:
+				selected[sel.Name.Value] = true
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if def, ok := info.Fragments[sel.Name.Value].(*ast.FragmentDefinition); ok {
+					walk(def.SelectionSet)
+				}
+			}
+		}
+	}
+	for _, f := range info.FieldASTs {
+		walk(f.SelectionSet)
+	}
+	return selected
+}
+
+// outputFor maps a field type onto a GraphQL scalar
+func outputFor(f models.FieldMetadata) graphql.Output {
+	t := f.Type
+	if t == constants.FieldTypeFormula && f.ReturnType != nil {
+		t = *f.ReturnType
+	}
+	switch t {
+	case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent, constants.FieldTypeRollupSummary:
+		return graphql.Float
+	case constants.FieldTypeBoolean:
+		return graphql.Boolean
+	case constants.FieldTypeJSON, constants.FieldTypeGeolocation:
+		return jsonScalar
+	case constants.FieldTypeLookup, constants.FieldTypeMasterDetail:
+		return graphql.ID
+	default:
+		return graphql.String
+	}
+}
+
+// inputFor returns the nullable input type of a field's output type
+func inputFor(output graphql.Output) graphql.Input {
+	if nonNull, ok := output.(*graphql.NonNull); ok {
+		output = nonNull.OfType
+	}
+	return output.(graphql.Input)
+}
+
+func fieldMeta(schema *models.ObjectMetadata, apiName string) *models.FieldMetadata {
+	for i := range schema.Fields {
+		if schema.Fields[i].APIName == apiName {
+			return &schema.Fields[i]
+		}
+	}
+	return nil
+}
+
+// typeName converts "opportunity_line_item" to "OpportunityLineItem"
+func typeName(apiName string) string {
+	parts := strings.Split(apiName, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// fieldName drops the system prefix, which GraphQL reserves: "__sys_gen_id" becomes "id"
+func fieldName(apiName string) string {
+	return strings.TrimPrefix(apiName, "__sys_gen_")
+}
+
+func parseJSONLiteral(value ast.Value) interface{} {
+	switch v := value.(type) {
+	case *ast.ObjectValue:
+		obj := make(map[string]interface{}, len(v.Fields))
+		for _, f := range v.Fields {
+			obj[f.Name.Value] = parseJSONLiteral(f.Value)
+		}
+		return obj
+	case *ast.ListValue:
+		list := make([]interface{}, len(v.Values))
+		for i, item := range v.Values {
+			list[i] = parseJSONLiteral(item)
+		}
+		return list
+	case *ast.IntValue, *ast.FloatValue:
+		var n float64
+		_, _ = fmt.Sscan(v.GetValue().(string), &n)
+		return n
+	default:
+		return value.GetValue()
+	}
+}
@@ -0,0 +1,189 @@
+package graphqlapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePerms struct{}
+
+func (fakePerms) CheckObjectPermissionWithUser(_ context.Context, object, op string, _ *models.UserSession) bool {
+	return !(object == "account" && op == constants.PermDelete)
+}
+
+func (fakePerms) CheckFieldVisibilityWithUser(_ context.Context, object, field string, _ *models.UserSession) bool {
+	return !(object == "contact" && field == "salary")
+}
+
+func (fakePerms) CheckFieldEditabilityWithUser(_ context.Context, _, field string, _ *models.UserSession) bool {
+	return field != "rating"
+}
+
+type fakeData struct {
+	records  map[string][]models.SObject
+	queries  []models.QueryRequest
+	lookups  int
+	inserted models.SObject
+}
+
+func (d *fakeData) Query(_ context.Context, req models.QueryRequest, _ *models.UserSession) ([]models.SObject, error) {
+	d.queries = append(d.queries, req)
+	var out []models.SObject
+	for _, r := range d.records[req.ObjectAPIName] {
+		match := true
+		for _, c := range req.Criteria {
+			match = match && r[c.Field] == c.Val
+		}
+		if match {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (d *fakeData) FindByIDs(_ context.Context, object string, ids []string, _ *models.UserSession) ([]models.SObject, error) {
+	d.lookups++
+	var out []models.SObject
+	for _, r := range d.records[object] {
+		for _, id := range ids {
+			if r[constants.FieldID] == id {
+				out = append(out, r)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (d *fakeData) Insert(_ context.Context, _ string, data models.SObject, _ *models.UserSession) (models.SObject, error) {
+	d.inserted = data
+	created := models.SObject{constants.FieldID: "new"}
+	for k, v := range data {
+		created[k] = v
+	}
+	return created, nil
+}
+
+func (d *fakeData) Update(context.Context, string, string, models.SObject, *models.UserSession) error {
+	return nil
+}
+
+func (d *fakeData) Delete(context.Context, string, string, *models.UserSession) error {
+	return nil
+}
+
+func newTestAPI() (*API, *fakeData) {
+	accountRef := "Contacts"
+	schemas := []*models.ObjectMetadata{
+		{
+			APIName: "account", Label: "Account", PluralLabel: "Accounts",
+			Fields: []models.FieldMetadata{
+				{APIName: constants.FieldID, Type: constants.FieldTypeText, IsSystem: true},
+				{APIName: "name", Type: constants.FieldTypeText, IsNameField: true},
+				{APIName: "rating", Type: constants.FieldTypeNumber},
+			},
+		},
+		{
+			APIName: "contact", Label: "Contact", PluralLabel: "Contacts",
+			Fields: []models.FieldMetadata{
+				{APIName: constants.FieldID, Type: constants.FieldTypeText, IsSystem: true},
+				{APIName: "name", Type: constants.FieldTypeText, IsNameField: true},
+				{APIName: "salary", Type: constants.FieldTypeCurrency},
+				{APIName: "account_id", Type: constants.FieldTypeLookup, ReferenceTo: []string{"account"}, RelationshipName: &accountRef},
+				{APIName: "portal_password", Type: constants.FieldTypePassword},
+			},
+		},
+		{APIName: "_System_User", Fields: []models.FieldMetadata{{APIName: constants.FieldID, IsSystem: true}}},
+	}
+	data := &fakeData{records: map[string][]models.SObject{
+		"account": {
+			{constants.FieldID: "a1", "name": "Acme", "rating": 4.5},
+		},
+		"contact": {
+			{constants.FieldID: "c1", "name": "Ada", "salary": 100, "account_id": "a1"},
+			{constants.FieldID: "c2", "name": "Bob", "salary": 200, "account_id": "a1"},
+		},
+	}}
+	return &API{
+		schemas: func(context.Context) []*models.ObjectMetadata { return schemas },
+		perms:   fakePerms{},
+		data:    data,
+	}, data
+}
+
+func TestExecute_NestedQuery(t *testing.T) {
+	api, data := newTestAPI()
+
+	result := api.Execute(context.Background(), &models.UserSession{ID: "u1"}, `{
+		contact_list(order_by: "name", order_direction: DESC, limit: 5) { id name account { name rating } }
+		account(id: "a1") { name contacts(where: {name: "Bob"}) { name } }
+		objects
+	}`, nil, "")
+	require.Empty(t, result.Errors)
+
+	out := result.Data.(map[string]interface{})
+	contacts := out["contact_list"].([]interface{})
+	require.Len(t, contacts, 2)
+	first := contacts[0].(map[string]interface{})
+	assert.Equal(t, "c1", first["id"])
+	assert.Equal(t, "Acme", first["account"].(map[string]interface{})["name"])
+	assert.Equal(t, 4.5, first["account"].(map[string]interface{})["rating"])
+
+	// Both contacts share one account, fetched in a single prefetch
+	assert.Equal(t, 1, data.lookups)
+	// Top-level fields resolve in no particular order
+	require.Len(t, data.queries, 2)
+	list, related := data.queries[0], data.queries[1]
+	if len(list.Criteria) > 0 {
+		list, related = related, list
+	}
+	assert.Equal(t, "name", list.SortField)
+	assert.Equal(t, constants.SortDESC, list.SortDirection)
+	assert.Equal(t, 5, list.Limit)
+
+	account := out["account"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "Bob"}}, account["contacts"])
+	assert.ElementsMatch(t, []models.QueryCriterion{
+		{Field: "account_id", Op: "=", Val: "a1"},
+		{Field: "name", Op: "=", Val: "Bob"},
+	}, related.Criteria)
+
+	assert.Equal(t, []interface{}{"account", "contact"}, out["objects"], "system tables are not exposed")
+}
+
+func TestExecute_FieldLevelSecurity(t *testing.T) {
+	api, _ := newTestAPI()
+	user := &models.UserSession{ID: "u1"}
+
+	for _, query := range []string{
+		`{ contact_list { salary } }`,
+		`{ contact_list { portal_password } }`,
+		`mutation { delete_account(id: "a1") }`,
+		`mutation { create_account(input: {name: "X", rating: 1}) { id } }`,
+	} {
+		result := api.Execute(context.Background(), user, query, nil, "")
+		assert.NotEmpty(t, result.Errors, query)
+	}
+}
+
+func TestExecute_Mutation(t *testing.T) {
+	api, data := newTestAPI()
+
+	result := api.Execute(context.Background(), &models.UserSession{ID: "u1"},
+		`mutation Create($name: String) { create_contact(input: {name: $name, account_id: "a1"}) { id name account { name } } }`,
+		map[string]interface{}{"name": "Cy"}, "Create")
+	require.Empty(t, result.Errors)
+
+	created := result.Data.(map[string]interface{})["create_contact"].(map[string]interface{})
+	assert.Equal(t, "new", created["id"])
+	assert.Equal(t, "Acme", created["account"].(map[string]interface{})["name"])
+	assert.Equal(t, models.SObject{"name": "Cy", "account_id": "a1"}, data.inserted)
+
+	result = api.Execute(context.Background(), &models.UserSession{ID: "u1"}, `mutation { delete_contact(id: "c1") }`, nil, "")
+	require.Empty(t, result.Errors)
+	assert.Equal(t, true, result.Data.(map[string]interface{})["delete_contact"])
+}
@@ -0,0 +1,91 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nexuscrm/backend/pkg/auth"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// SessionValidator checks session tokens; implemented by services.AuthService
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, tokenString string) (*auth.Claims, error)
+	TouchSession(sessionID string)
+}
+
+type userContextKey struct{}
+
+// UnaryAuthInterceptor authenticates unary calls with the bearer token in the
+// "authorization" metadata, the same session token the REST API accepts
+func UnaryAuthInterceptor(validator SessionValidator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, validator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor authenticates streaming calls like UnaryAuthInterceptor
+func StreamAuthInterceptor(validator SessionValidator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), validator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate validates the token and returns a context carrying the caller's session
+func authenticate(ctx context.Context, validator SessionValidator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(strings.ToLower(constants.HeaderAuthorization))
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "No authorization token provided")
+	}
+
+	parts := strings.SplitN(values[0], " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, status.Error(codes.Unauthenticated, "Invalid authorization header format")
+	}
+
+	claims, err := validator.ValidateSession(ctx, parts[1])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	validator.TouchSession(claims.RegisteredClaims.ID)
+
+	user := claims.User
+	return context.WithValue(ctx, userContextKey{}, &models.UserSession{
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         &user.Email,
+		ProfileID:     user.ProfileId,
+		RoleID:        user.RoleId,
+		IsSystemAdmin: user.IsSuperUser(),
+	}), nil
+}
+
+// userFromContext returns the session set by the auth interceptors
+func userFromContext(ctx context.Context) *models.UserSession {
+	user, _ := ctx.Value(userContextKey{}).(*models.UserSession)
+	return user
+}
+
+// authenticatedStream overrides the stream context with the authenticated one
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
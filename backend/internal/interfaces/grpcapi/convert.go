@@ -0,0 +1,121 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/nexuscrm/backend/pkg/api/nexuscrmv1"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// errorDomain identifies NexusCRM in the ErrorInfo detail of error statuses
+const errorDomain = "nexuscrm"
+
+// toRecord converts a record to its protobuf form. Values go through JSON so that
+// times, decimals and raw JSON columns come out exactly as the REST API returns them.
+func toRecord(record models.SObject) (*nexuscrmv1.Record, error) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &nexuscrmv1.Record{Fields: s}, nil
+}
+
+// toSObject converts request fields to a record
+func toSObject(fields *structpb.Struct) models.SObject {
+	record := make(models.SObject, len(fields.GetFields()))
+	for k, v := range fields.GetFields() {
+		record[k] = v.AsInterface()
+	}
+	return record
+}
+
+// toMessage fills a generated system table message from a metadata model whose JSON
+// keys are the table's column names. Keys without a matching column are dropped.
+func toMessage(v interface{}, m proto.Message) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(raw, m)
+}
+
+// describeObject converts an object's metadata to its _System_Object and _System_Field rows
+func describeObject(schema *models.ObjectMetadata) (*nexuscrmv1.DescribeObjectResponse, error) {
+	resp := &nexuscrmv1.DescribeObjectResponse{
+		Object: &nexuscrmv1.SystemObject{},
+		Fields: make([]*nexuscrmv1.SystemField, len(schema.Fields)),
+	}
+	if err := toMessage(schema, resp.Object); err != nil {
+		return nil, err
+	}
+	for i, f := range schema.Fields {
+		resp.Fields[i] = &nexuscrmv1.SystemField{}
+		if err := toMessage(f, resp.Fields[i]); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// toStatusError maps service errors onto gRPC status codes, carrying the REST API's
+// error code as the reason of an ErrorInfo detail
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	httpStatus := appErrors.GetHTTPStatus(err)
+	code := codes.Internal
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	}
+	if code == codes.Internal {
+		log.Printf("❌ [gRPC] %v", err)
+	}
+
+	resp := appErrors.ToResponse(err)
+	info := &errdetails.ErrorInfo{
+		Reason:   resp.Code,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"i18n_key": resp.I18nKey},
+	}
+	if resp.Field != "" {
+		info.Metadata["field"] = resp.Field
+	}
+	st := status.New(code, err.Error())
+	if detailed, detailErr := st.WithDetails(info); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,235 @@
+// Package grpcapi serves the NexusCRM gRPC API. Handlers are thin adapters over the
+// ServiceManager, mirroring the REST data and metadata handlers.
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/api/nexuscrmv1"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// maxBulkSize matches the REST bulk endpoint
+	maxBulkSize = 1000
+	// streamPageSize is how many records StreamQuery reads per round trip to the database
+	streamPageSize = 500
+)
+
+// Server implements the NexusCRM gRPC service
+type Server struct {
+	nexuscrmv1.UnimplementedNexusCRMServer
+	svc *services.ServiceManager
+}
+
+var _ nexuscrmv1.NexusCRMServer = (*Server)(nil)
+
+// NewServer creates a gRPC server with the NexusCRM service registered behind token auth
+func NewServer(svc *services.ServiceManager) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UnaryAuthInterceptor(svc.Auth)),
+		grpc.ChainStreamInterceptor(StreamAuthInterceptor(svc.Auth)),
+	)
+	nexuscrmv1.RegisterNexusCRMServer(srv, &Server{svc: svc})
+	return srv
+}
+
+// GetRecord returns one record the caller can read
+func (s *Server) GetRecord(ctx context.Context, req *nexuscrmv1.GetRecordRequest) (*nexuscrmv1.Record, error) {
+	user := userFromContext(ctx)
+	objectAPIName := strings.ToLower(req.GetObjectApiName())
+	id := req.GetId()
+	if id == "" || strings.ContainsAny(id, `'\`) {
+		return nil, toStatusError(appErrors.NewValidationError("id", "Invalid record ID"))
+	}
+
+	records, err := s.svc.QuerySvc.QueryWithFilter(
+		ctx,
+		objectAPIName,
+		fmt.Sprintf("%s == '%s'", constants.FieldID, id),
+		user,
+		constants.FieldCreatedDate,
+		constants.SortDESC,
+		1,
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if len(records) == 0 {
+		return nil, toStatusError(appErrors.NewNotFoundError(objectAPIName, id))
+	}
+
+	schema := s.svc.Metadata.GetSchema(ctx, objectAPIName)
+	if schema != nil && !s.svc.Permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, user) {
+		return nil, toStatusError(appErrors.NewPermissionError(constants.PermRead, objectAPIName+"/"+id))
+	}
+	return s.record(records[0])
+}
+
+// CreateRecord inserts a record
+func (s *Server) CreateRecord(ctx context.Context, req *nexuscrmv1.CreateRecordRequest) (*nexuscrmv1.Record, error) {
+	record, err := s.svc.Persistence.Insert(ctx, strings.ToLower(req.GetObjectApiName()), toSObject(req.GetFields()), userFromContext(ctx))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return s.record(record)
+}
+
+// UpdateRecord applies a partial update
+func (s *Server) UpdateRecord(ctx context.Context, req *nexuscrmv1.UpdateRecordRequest) (*emptypb.Empty, error) {
+	if err := s.svc.Persistence.Update(ctx, strings.ToLower(req.GetObjectApiName()), req.GetId(), toSObject(req.GetFields()), userFromContext(ctx)); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteRecord moves a record to the recycle bin
+func (s *Server) DeleteRecord(ctx context.Context, req *nexuscrmv1.DeleteRecordRequest) (*emptypb.Empty, error) {
+	if err := s.svc.Persistence.Delete(ctx, strings.ToLower(req.GetObjectApiName()), req.GetId(), userFromContext(ctx)); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// BulkCreateRecords inserts records in batches
+func (s *Server) BulkCreateRecords(ctx context.Context, req *nexuscrmv1.BulkCreateRecordsRequest) (*nexuscrmv1.BulkCreateRecordsResponse, error) {
+	if len(req.GetRecords()) == 0 {
+		return nil, toStatusError(appErrors.NewValidationError("records", "At least one record is required"))
+	}
+	if len(req.GetRecords()) > maxBulkSize {
+		return nil, toStatusError(appErrors.NewValidationError("records", fmt.Sprintf("Maximum %d records per request", maxBulkSize)))
+	}
+
+	records := make([]models.SObject, len(req.GetRecords()))
+	for i, r := range req.GetRecords() {
+		records[i] = toSObject(r)
+	}
+	result, err := s.svc.Persistence.BulkInsert(ctx, strings.ToLower(req.GetObjectApiName()), records, userFromContext(ctx), services.BulkInsertOptions{
+		BatchSize:       int(req.GetBatchSize()),
+		SkipFlows:       req.GetSkipFlows(),
+		SkipAutoNumbers: req.GetSkipAutoNumbers(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &nexuscrmv1.BulkCreateRecordsResponse{
+		SuccessCount: int32(result.SuccessCount),
+		FailedCount:  int32(result.FailedCount),
+		Errors:       result.Errors,
+	}, nil
+}
+
+// Query returns the matching records in one response
+func (s *Server) Query(ctx context.Context, req *nexuscrmv1.QueryRequest) (*nexuscrmv1.QueryResponse, error) {
+	records, err := s.svc.QuerySvc.Query(ctx, toQueryRequest(req), userFromContext(ctx))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &nexuscrmv1.QueryResponse{Records: make([]*nexuscrmv1.Record, len(records))}
+	for i, r := range records {
+		if resp.Records[i], err = s.record(r); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// StreamQuery pages through the matching records and sends them one at a time, so a
+// large result set never has to fit in a single message
+func (s *Server) StreamQuery(req *nexuscrmv1.QueryRequest, stream nexuscrmv1.NexusCRM_StreamQueryServer) error {
+	ctx := stream.Context()
+	user := userFromContext(ctx)
+	query := toQueryRequest(req)
+	if query.SortField == "" {
+		query.SortField = constants.FieldID // Stable order across pages
+	}
+	remaining := query.Limit
+
+	for {
+		query.Limit = streamPageSize
+		if remaining > 0 && remaining < streamPageSize {
+			query.Limit = remaining
+		}
+		records, err := s.svc.QuerySvc.Query(ctx, query, user)
+		if err != nil {
+			return toStatusError(err)
+		}
+		for _, r := range records {
+			record, err := s.record(r)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(record); err != nil {
+				return err
+			}
+		}
+
+		if remaining > 0 {
+			remaining -= len(records)
+			if remaining <= 0 {
+				return nil
+			}
+		}
+		if len(records) < query.Limit {
+			return nil
+		}
+		query.Offset += len(records)
+	}
+}
+
+// ListObjects returns the objects the caller can read
+func (s *Server) ListObjects(ctx context.Context, _ *nexuscrmv1.ListObjectsRequest) (*nexuscrmv1.ListObjectsResponse, error) {
+	user := userFromContext(ctx)
+	resp := &nexuscrmv1.ListObjectsResponse{}
+	for _, schema := range s.svc.GetSchemas(ctx) {
+		if !s.svc.Permissions.CheckObjectPermissionWithUser(ctx, schema.APIName, constants.PermRead, user) {
+			continue
+		}
+		object := &nexuscrmv1.SystemObject{}
+		if err := toMessage(schema, object); err != nil {
+			return nil, toStatusError(appErrors.NewInternalError("failed to convert object "+schema.APIName, err))
+		}
+		resp.Objects = append(resp.Objects, object)
+	}
+	return resp, nil
+}
+
+// DescribeObject returns an object's metadata with the fields visible to the caller
+func (s *Server) DescribeObject(ctx context.Context, req *nexuscrmv1.DescribeObjectRequest) (*nexuscrmv1.DescribeObjectResponse, error) {
+	apiName := strings.ToLower(req.GetObjectApiName())
+	schema := s.svc.GetEffectiveSchema(ctx, apiName, userFromContext(ctx))
+	if schema == nil {
+		return nil, toStatusError(appErrors.NewNotFoundError("Schema", apiName))
+	}
+	resp, err := describeObject(schema)
+	if err != nil {
+		return nil, toStatusError(appErrors.NewInternalError("failed to convert object "+apiName, err))
+	}
+	return resp, nil
+}
+
+func (s *Server) record(r models.SObject) (*nexuscrmv1.Record, error) {
+	record, err := toRecord(r)
+	if err != nil {
+		return nil, toStatusError(appErrors.NewInternalError("failed to encode record", err))
+	}
+	return record, nil
+}
+
+func toQueryRequest(req *nexuscrmv1.QueryRequest) models.QueryRequest {
+	return models.QueryRequest{
+		ObjectAPIName: strings.ToLower(req.GetObjectApiName()),
+		FilterExpr:    req.GetFilterExpr(),
+		SortField:     req.GetSortField(),
+		SortDirection: req.GetSortDirection(),
+		Limit:         int(req.GetLimit()),
+		Offset:        int(req.GetOffset()),
+	}
+}
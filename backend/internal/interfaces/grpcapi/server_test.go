@@ -0,0 +1,140 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nexuscrm/backend/pkg/auth"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeValidator struct {
+	touched []string
+}
+
+func (v *fakeValidator) ValidateSession(_ context.Context, token string) (*auth.Claims, error) {
+	if token != "good" {
+		return nil, appErrors.NewUnauthorizedError("Session not found")
+	}
+	return &auth.Claims{
+		User:             auth.UserSession{ID: "u1", Name: "Ada", ProfileId: constants.ProfileSystemAdmin},
+		RegisteredClaims: jwt.RegisteredClaims{ID: "s1"},
+	}, nil
+}
+
+func (v *fakeValidator) TouchSession(sessionID string) {
+	v.touched = append(v.touched, sessionID)
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	validator := &fakeValidator{}
+	interceptor := UnaryAuthInterceptor(validator)
+
+	var seen *models.UserSession
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		seen = userFromContext(ctx)
+		return "ok", nil
+	}
+	call := func(md metadata.MD) error {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		return err
+	}
+
+	for name, md := range map[string]metadata.MD{
+		"missing":   {},
+		"no bearer": metadata.Pairs("authorization", "good"),
+		"revoked":   metadata.Pairs("authorization", "Bearer bad"),
+	} {
+		err := call(md)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), name)
+	}
+	assert.Nil(t, seen)
+
+	require.NoError(t, call(metadata.Pairs("authorization", "Bearer good")))
+	require.NotNil(t, seen)
+	assert.Equal(t, "u1", seen.ID)
+	assert.True(t, seen.IsSystemAdmin)
+	assert.Equal(t, []string{"s1"}, validator.touched)
+}
+
+func TestToStatusError(t *testing.T) {
+	cases := map[error]codes.Code{
+		appErrors.NewValidationError("name", "Name is required"): codes.InvalidArgument,
+		appErrors.NewNotFoundError("account", "a1"):              codes.NotFound,
+		appErrors.NewPermissionError("read", "account"):          codes.PermissionDenied,
+		appErrors.NewConflictError("account", "name", "Acme"):    codes.AlreadyExists,
+		assert.AnError: codes.Internal,
+	}
+	for err, code := range cases {
+		st := status.Convert(toStatusError(err))
+		assert.Equal(t, code, st.Code(), err.Error())
+		require.Len(t, st.Details(), 1)
+		info := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.Equal(t, appErrors.GetErrorCode(err), info.GetReason())
+	}
+	assert.NoError(t, toStatusError(nil))
+}
+
+func TestRecordConversion(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	record, err := toRecord(models.SObject{
+		constants.FieldID:          "a1",
+		"name":                     "Acme",
+		"employees":                42,
+		constants.FieldCreatedDate: created,
+		"owner":                    nil,
+	})
+	require.NoError(t, err)
+	fields := record.GetFields().GetFields()
+	assert.Equal(t, "Acme", fields["name"].GetStringValue())
+	assert.Equal(t, float64(42), fields["employees"].GetNumberValue())
+	assert.Equal(t, "2026-03-01T09:30:00Z", fields[constants.FieldCreatedDate].GetStringValue())
+	assert.Contains(t, fields, "owner")
+
+	in, err := structpb.NewStruct(map[string]interface{}{"name": "Acme", "active": true})
+	require.NoError(t, err)
+	assert.Equal(t, models.SObject{"name": "Acme", "active": true}, toSObject(in))
+}
+
+func TestDescribeObject(t *testing.T) {
+	description := "Companies we do business with"
+	maxLength := 80
+	resp, err := describeObject(&models.ObjectMetadata{
+		ID:           "o1",
+		APIName:      "account",
+		Label:        "Account",
+		PluralLabel:  "Accounts",
+		Description:  &description,
+		SharingModel: "Private",
+		ListFields:   []string{"name"},
+		Searchable:   true,
+		Fields: []models.FieldMetadata{
+			{APIName: "name", Label: "Name", Type: constants.FieldTypeText, Required: true, MaxLength: &maxLength},
+			{APIName: "industry", Label: "Industry", Type: constants.FieldTypePicklist, Options: []string{"Tech", "Retail"}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "o1", resp.GetObject().GetId())
+	assert.Equal(t, "account", resp.GetObject().GetApiName())
+	assert.Equal(t, description, resp.GetObject().GetDescription())
+	assert.Equal(t, "name", resp.GetObject().GetListFields().GetListValue().GetValues()[0].GetStringValue())
+
+	require.Len(t, resp.GetFields(), 2)
+	assert.True(t, resp.GetFields()[0].GetRequired())
+	assert.Equal(t, int32(80), resp.GetFields()[0].GetMaxLength())
+	assert.Len(t, resp.GetFields()[1].GetOptions().GetListValue().GetValues(), 2)
+}
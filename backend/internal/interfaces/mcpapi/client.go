@@ -0,0 +1,252 @@
+// Package mcpapi serves the MCP ToolBus in-process. When the ToolBus runs inside the
+// backend, record and schema calls go straight to the ServiceManager instead of looping
+// back through the REST API, which saves a second token check, a JSON round trip and a
+// network hop per tool call.
+package mcpapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/mcp/pkg/client"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// maxBulkSize matches the REST bulk endpoint
+const maxBulkSize = 1000
+
+// DirectClient implements client.API on the ServiceManager, acting as the user the MCP
+// layer put in the context. Calls it does not serve directly, such as metadata writes
+// that rely on route-level admin checks, and calls without a user in the context fall
+// through to the embedded HTTP client.
+type DirectClient struct {
+	*client.NexusClient
+	svc *services.ServiceManager
+}
+
+var _ client.API = (*DirectClient)(nil)
+
+// NewDirectClient creates a client that serves the ToolBus from svc, falling back to fallback
+func NewDirectClient(svc *services.ServiceManager, fallback *client.NexusClient) *DirectClient {
+	return &DirectClient{NexusClient: fallback, svc: svc}
+}
+
+// userFromContext returns the caller's session as set by the MCP endpoint or the agent handler
+func userFromContext(ctx context.Context) *models.UserSession {
+	user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession)
+	return user
+}
+
+func (c *DirectClient) ListObjects(ctx context.Context, authToken string) ([]models.ObjectMetadata, error) {
+	if userFromContext(ctx) == nil {
+		return c.NexusClient.ListObjects(ctx, authToken)
+	}
+	schemas := c.svc.GetSchemas(ctx)
+	objects := make([]models.ObjectMetadata, 0, len(schemas))
+	for _, schema := range schemas {
+		objects = append(objects, *schema)
+	}
+	return objects, nil
+}
+
+func (c *DirectClient) DescribeObject(ctx context.Context, objectName string, authToken string) (*models.ObjectMetadata, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.DescribeObject(ctx, objectName, authToken)
+	}
+	objectName = strings.ToLower(objectName)
+	schema := c.svc.GetEffectiveSchema(ctx, objectName, user)
+	if schema == nil {
+		return nil, appErrors.NewNotFoundError("Schema", objectName)
+	}
+	return schema, nil
+}
+
+func (c *DirectClient) Query(ctx context.Context, req models.QueryRequest, authToken string) ([]models.SObject, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.Query(ctx, req, authToken)
+	}
+	req.ObjectAPIName = strings.ToLower(req.ObjectAPIName)
+	if len(req.OrderBy) > 0 && req.SortField == "" {
+		req.SortField = req.OrderBy[0].Field
+		req.SortDirection = req.OrderBy[0].Direction
+	}
+	records, err := c.svc.QuerySvc.Query(ctx, req, user)
+	if err == nil && req.ForView {
+		c.svc.Recent.TrackViewsAsync(req.ObjectAPIName, records, user)
+	}
+	return records, err
+}
+
+// GetRecord returns one record the caller can read, tracking it as recently viewed
+func (c *DirectClient) GetRecord(ctx context.Context, objectName, id string, authToken string) (models.SObject, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.GetRecord(ctx, objectName, id, authToken)
+	}
+	objectName = strings.ToLower(objectName)
+	if id == "" || strings.ContainsAny(id, `'\`) {
+		return nil, appErrors.NewValidationError("id", "Invalid record ID")
+	}
+
+	records, err := c.svc.QuerySvc.QueryWithFilter(
+		ctx,
+		objectName,
+		fmt.Sprintf("%s == '%s'", constants.FieldID, id),
+		user,
+		constants.FieldCreatedDate,
+		constants.SortDESC,
+		1,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, appErrors.NewNotFoundError(objectName, id)
+	}
+
+	schema := c.svc.Metadata.GetSchema(ctx, objectName)
+	if schema != nil && !c.svc.Permissions.CheckRecordAccess(ctx, schema, records[0], constants.PermRead, user) {
+		return nil, appErrors.NewPermissionError(constants.PermRead, objectName+"/"+id)
+	}
+	c.svc.Recent.TrackViewsAsync(objectName, records[:1], user)
+	return records[0], nil
+}
+
+func (c *DirectClient) CreateRecord(ctx context.Context, objectName string, data map[string]interface{}, authToken string) (string, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.CreateRecord(ctx, objectName, data, authToken)
+	}
+	record, err := c.svc.Persistence.Insert(ctx, strings.ToLower(objectName), data, user)
+	if err != nil {
+		return "", err
+	}
+	id := record.GetString(constants.FieldID)
+	if id == "" {
+		return "", fmt.Errorf("created record missing ID")
+	}
+	return id, nil
+}
+
+func (c *DirectClient) UpdateRecord(ctx context.Context, objectName, id string, data map[string]interface{}, authToken string) error {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.UpdateRecord(ctx, objectName, id, data, authToken)
+	}
+	return c.svc.Persistence.Update(ctx, strings.ToLower(objectName), id, data, user)
+}
+
+func (c *DirectClient) DeleteRecord(ctx context.Context, objectName, id string, authToken string) error {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.DeleteRecord(ctx, objectName, id, authToken)
+	}
+	return c.svc.Persistence.Delete(ctx, strings.ToLower(objectName), id, user)
+}
+
+func (c *DirectClient) BulkCreateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.BulkCreateRecords(ctx, objectName, records, authToken)
+	}
+	if err := checkBulkSize("records", len(records)); err != nil {
+		return nil, err
+	}
+	result, err := c.svc.Persistence.BulkInsert(ctx, strings.ToLower(objectName), records, user, services.BulkInsertOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DirectClient) BulkUpdateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.BulkUpdateRecords(ctx, objectName, records, authToken)
+	}
+	if err := checkBulkSize("records", len(records)); err != nil {
+		return nil, err
+	}
+	result, err := c.svc.Persistence.BulkUpdate(ctx, strings.ToLower(objectName), records, user, services.BulkWriteOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DirectClient) BulkDeleteRecords(ctx context.Context, objectName string, ids []string, authToken string) (*models.BulkResult, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.BulkDeleteRecords(ctx, objectName, ids, authToken)
+	}
+	if err := checkBulkSize("ids", len(ids)); err != nil {
+		return nil, err
+	}
+	result, err := c.svc.Persistence.BulkDelete(ctx, strings.ToLower(objectName), ids, user, services.BulkWriteOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DirectClient) SearchObject(ctx context.Context, objectName, term string, authToken string) ([]models.SObject, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.SearchObject(ctx, objectName, term, authToken)
+	}
+	if term == "" {
+		return nil, appErrors.NewValidationError("term", "Search term is required")
+	}
+	return c.svc.QuerySvc.SearchSingleObject(ctx, strings.ToLower(objectName), term, user)
+}
+
+func (c *DirectClient) Calculate(ctx context.Context, objectName string, data map[string]interface{}, authToken string) (map[string]interface{}, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.Calculate(ctx, objectName, data, authToken)
+	}
+	return c.svc.QuerySvc.Calculate(ctx, strings.ToLower(objectName), data, user)
+}
+
+func (c *DirectClient) RunAnalytics(ctx context.Context, query models.AnalyticsQuery, authToken string) (interface{}, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.RunAnalytics(ctx, query, authToken)
+	}
+	query.ObjectAPIName = strings.ToLower(query.ObjectAPIName)
+	return c.svc.QuerySvc.RunAnalytics(ctx, query, user)
+}
+
+func (c *DirectClient) AskData(ctx context.Context, req models.NLQRequest, authToken string) (*models.NLQResult, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.AskData(ctx, req, authToken)
+	}
+	return c.svc.NLQ.Ask(ctx, req, user)
+}
+
+func (c *DirectClient) SemanticSearch(ctx context.Context, req models.SemanticSearchRequest, authToken string) ([]models.SemanticSearchHit, error) {
+	user := userFromContext(ctx)
+	if user == nil {
+		return c.NexusClient.SemanticSearch(ctx, req, authToken)
+	}
+	return c.svc.Semantic.Search(ctx, req, user)
+}
+
+// checkBulkSize rejects empty and oversized bulk requests
+func checkBulkSize(field string, n int) error {
+	if n == 0 {
+		return appErrors.NewValidationError(field, "At least one record is required")
+	}
+	if n > maxBulkSize {
+		return appErrors.NewValidationError(field, fmt.Sprintf("Maximum %d records per request", maxBulkSize))
+	}
+	return nil
+}
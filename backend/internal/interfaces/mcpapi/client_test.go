@@ -0,0 +1,41 @@
+package mcpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuscrm/mcp/pkg/client"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectClientFallsBackWithoutUser(t *testing.T) {
+	var paths []string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"data":{"__sys_gen_id":"l1","name":"Ada"}}`))
+	}))
+	defer backend.Close()
+
+	// No services: any call that does not fall back would panic
+	direct := NewDirectClient(nil, client.NewNexusClient(backend.URL))
+	record, err := direct.GetRecord(context.Background(), "lead", "l1", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", record["name"])
+	assert.Equal(t, []string{"/api/data/lead/l1"}, paths)
+}
+
+func TestDirectClientChecksBulkSize(t *testing.T) {
+	ctx := context.WithValue(context.Background(), mcp.ContextKeyUser, &models.UserSession{ID: "u1"})
+	direct := NewDirectClient(nil, client.NewNexusClient("http://unused"))
+
+	_, err := direct.BulkDeleteRecords(ctx, "lead", nil, "")
+	assert.ErrorContains(t, err, "At least one record is required")
+	_, err = direct.BulkCreateRecords(ctx, "lead", make([]models.SObject, maxBulkSize+1), "")
+	assert.ErrorContains(t, err, "Maximum 1000 records per request")
+}
@@ -0,0 +1,45 @@
+package mcpapi
+
+import (
+	"context"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/mcp/pkg/contextstore"
+)
+
+// ContextPersister keeps the agent's context files in _System_AI_Context_Item. Sessions
+// are keyed by user ID.
+type ContextPersister struct {
+	history *services.AIHistoryService
+}
+
+var _ contextstore.Persister = (*ContextPersister)(nil)
+
+// NewContextPersister creates a persister backed by the AI history service
+func NewContextPersister(history *services.AIHistoryService) *ContextPersister {
+	return &ContextPersister{history: history}
+}
+
+func (p *ContextPersister) LoadItems(userID string) (map[string]contextstore.ContextItem, error) {
+	rows, err := p.history.ContextItems(context.Background(), userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[string]contextstore.ContextItem, len(rows))
+	for _, row := range rows {
+		items[row.Path] = contextstore.ContextItem{Path: row.Path, Content: row.Content, TokenSize: row.TokenSize}
+	}
+	return items, nil
+}
+
+func (p *ContextPersister) SaveItem(userID string, item contextstore.ContextItem) error {
+	return p.history.PinContextItem(context.Background(), userID, item.Path, item.Content, item.TokenSize)
+}
+
+func (p *ContextPersister) DeleteItem(userID string, path string) error {
+	return p.history.UnpinContextItem(context.Background(), userID, path)
+}
+
+func (p *ContextPersister) ClearItems(userID string) error {
+	return p.history.ClearContext(context.Background(), userID)
+}
@@ -1,58 +1,60 @@
 package middleware
 
 import (
-	"net/http"
+	"context"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
 	"github.com/nexuscrm/backend/pkg/auth"
+	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
 )
 
-// RequireAuth is a middleware that validates JWT tokens
-func RequireAuth(authSvc *services.AuthService) gin.HandlerFunc {
+// RequireAuth is a middleware that validates JWT tokens of internal users, sent as a
+// bearer token or, when enabled, in the session cookie
+func RequireAuth(authSvc *services.AuthService, cookies SessionCookies) gin.HandlerFunc {
+	return requireSession(authSvc, authSvc.ValidateSession, cookies, SessionCookieName)
+}
+
+// RequirePortalAuth is a middleware that validates JWT tokens of portal users
+func RequirePortalAuth(authSvc *services.AuthService, cookies SessionCookies) gin.HandlerFunc {
+	return requireSession(authSvc, authSvc.ValidatePortalSession, cookies, PortalSessionCookieName)
+}
+
+func requireSession(authSvc *services.AuthService, validate func(ctx context.Context, tokenString string) (*auth.Claims, error), cookies SessionCookies, cookieName string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get token from Authorization header
 		authHeader := c.GetHeader(constants.HeaderAuthorization)
+		var tokenString string
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				constants.ResponseError: "Unauthorized",
-				constants.FieldMessage:  "No authorization token provided",
-				"code":                  "UNAUTHORIZED",
-				"data":                  nil,
-			})
-			c.Abort()
-			return
+			// Fall back to the session cookie; writes must carry its CSRF token
+			token, err := cookies.sessionToken(c, cookieName)
+			if err != nil {
+				abortWithError(c, err)
+				return
+			}
+			if token == "" {
+				abortWithError(c, errors.NewUnauthorizedError("No authorization token provided"))
+				return
+			}
+			tokenString = token
+		} else {
+			// Extract token (format: "Bearer <token>")
+			parts := strings.SplitN(authHeader, " ", 2)
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				abortWithError(c, errors.NewUnauthorizedError("Invalid authorization header format"))
+				return
+			}
+			tokenString = parts[1]
 		}
 
-		// Extract token (format: "Bearer <token>")
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				constants.ResponseError: "Unauthorized",
-				constants.FieldMessage:  "Invalid authorization header format",
-				"code":                  "UNAUTHORIZED",
-				"data":                  nil,
-			})
-			c.Abort()
-			return
-		}
-
-		tokenString := parts[1]
-
 		// Validate token and session via AuthService
-		claims, err := authSvc.ValidateSession(c.Request.Context(), tokenString)
+		claims, err := validate(c.Request.Context(), tokenString)
 		if err != nil {
 			// Determine status code based on error type?
 			// For now, 401 is safe for all session failures
-			c.JSON(http.StatusUnauthorized, gin.H{
-				constants.ResponseError: "Unauthorized",
-				constants.FieldMessage:  err.Error(),
-				"code":                  "UNAUTHORIZED",
-				"data":                  nil,
-			})
-			c.Abort()
+			abortWithError(c, errors.NewUnauthorizedError(err.Error()))
 			return
 		}
 
@@ -61,7 +63,9 @@ func RequireAuth(authSvc *services.AuthService) gin.HandlerFunc {
 
 		// Set user session in context
 		c.Set(constants.ContextKeyUser, claims.User)
-		c.Set("token", tokenString)
+		c.Set(constants.ContextKeyToken, tokenString)
+		preferUserLocale(c, claims.User.Locale)
+		c.Request = c.Request.WithContext(services.WithSetupActor(c.Request.Context(), claims.User.ID, claims.User.Name))
 
 		c.Next()
 	}
@@ -72,28 +76,35 @@ func RequireSystemAdmin() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userInterface, exists := c.Get(constants.ContextKeyUser)
 		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				constants.ResponseError: "Unauthorized",
-				constants.FieldMessage:  "User not authenticated",
-				"code":                  "UNAUTHORIZED",
-				"data":                  nil,
-			})
-			c.Abort()
+			abortWithError(c, errors.NewUnauthorizedError("User not authenticated"))
 			return
 		}
 
 		user := userInterface.(auth.UserSession)
 		if !user.IsSuperUser() {
-			c.JSON(http.StatusForbidden, gin.H{
-				constants.ResponseError: "Forbidden",
-				constants.FieldMessage:  "Only System Administrators can access this resource",
-				"code":                  "FORBIDDEN",
-				"data":                  nil,
-			})
-			c.Abort()
+			abortWithError(c, errors.NewForbiddenError("Only System Administrators can access this resource"))
 			return
 		}
 
 		c.Next()
 	}
 }
+
+// AllowBasicToken lets clients that only speak HTTP Basic auth, like Excel and Power BI,
+// sign in with a session token as the password. It must run before RequireAuth.
+func AllowBasicToken(realm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(constants.HeaderAuthorization) == "" {
+			// Prompt the client for credentials; RequireAuth rejects the request
+			c.Header("WWW-Authenticate", `Basic realm="`+realm+`"`)
+		} else if _, token, ok := c.Request.BasicAuth(); ok && token != "" {
+			c.Request.Header.Set(constants.HeaderAuthorization, "Bearer "+token)
+		}
+		c.Next()
+	}
+}
+
+// abortWithError stops the request with the standard API error body
+func abortWithError(c *gin.Context, err error) {
+	c.AbortWithStatusJSON(errors.GetHTTPStatus(err), errors.ToResponse(err))
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/pkg/errors"
+)
+
+// BodyLimits caps the size of request bodies, with larger caps for the routes that take files
+// or many records, and the time a client may take to send one.
+//
+//	MAX_REQUEST_BODY_SIZE=4MB     any other route
+//	MAX_BULK_BODY_SIZE=32MB       bulk record create/update/delete
+//	MAX_UPLOAD_BODY_SIZE=50MB     file uploads and translation imports
+//	REQUEST_BODY_TIMEOUT=60s      time to receive a request body (0 disables)
+type BodyLimits struct {
+	Default     int64
+	Routes      map[string]int64 // Route pattern as registered, e.g. "/api/files/upload"
+	ReadTimeout time.Duration
+}
+
+// Route patterns with their own body limits
+var (
+	bulkRoutes   = []string{"/api/data/:objectApiName/bulk"}
+	uploadRoutes = []string{"/api/files/upload", "/api/metadata/translations/:locale/import"}
+)
+
+// BodyLimitsFrom applies the body limits of the HTTP settings to their routes
+func BodyLimitsFrom(cfg config.HTTP) BodyLimits {
+	limits := BodyLimits{Default: cfg.MaxRequestBodySize, Routes: make(map[string]int64), ReadTimeout: cfg.RequestBodyTimeout}
+	for _, route := range bulkRoutes {
+		limits.Routes[route] = cfg.MaxBulkBodySize
+	}
+	for _, route := range uploadRoutes {
+		limits.Routes[route] = cfg.MaxUploadBodySize
+	}
+	return limits
+}
+
+// limitFor returns the body limit of a route pattern
+func (l BodyLimits) limitFor(route string) int64 {
+	if limit, ok := l.Routes[route]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// LimitBody enforces BodyLimits. Bodies declared too large are refused up front; others are
+// cut off at the limit, which handlers report as PAYLOAD_TOO_LARGE.
+func LimitBody(limits BodyLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+		limit := limits.limitFor(c.FullPath())
+		if limit > 0 {
+			if c.Request.ContentLength > limit {
+				// Don't keep reading a body we refuse
+				c.Header("Connection", "close")
+				abortWithError(c, errors.NewPayloadTooLargeError(limit))
+				return
+			}
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		}
+
+		if limits.ReadTimeout > 0 {
+			// Stop clients that trickle the body in; the deadline is lifted once it has been read
+			// so long-running responses, like streams, are unaffected
+			rc := http.NewResponseController(c.Writer)
+			if rc.SetReadDeadline(time.Now().Add(limits.ReadTimeout)) == nil {
+				c.Request.Body = &deadlineBody{ReadCloser: c.Request.Body, rc: rc}
+				defer func() { _ = rc.SetReadDeadline(time.Time{}) }()
+			}
+		}
+		c.Next()
+	}
+}
+
+// deadlineBody clears the connection read deadline when the body has been fully read
+type deadlineBody struct {
+	io.ReadCloser
+	rc *http.ResponseController
+}
+
+func (b *deadlineBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		_ = b.rc.SetReadDeadline(time.Time{})
+	}
+	return n, err
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LimitBody(BodyLimits{Default: 10, Routes: map[string]int64{"/api/files/upload": 100}, ReadTimeout: time.Minute}))
+	echo := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	}
+	router.POST("/api/data/:objectApiName", echo)
+	router.POST("/api/files/upload", echo)
+
+	send := func(path, body string, chunked bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if chunked {
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send("/api/data/account", "small", false).Code)
+
+	w := send("/api/data/account", strings.Repeat("x", 11), false)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), constants.ErrorCodePayloadTooLarge)
+
+	// Without a declared length the body is cut off while it is read
+	w = send("/api/data/account", strings.Repeat("x", 11), true)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "request body too large")
+
+	assert.Equal(t, http.StatusOK, send("/api/files/upload", strings.Repeat("x", 50), false).Code)
+}
+
+func TestBodyLimitsFrom(t *testing.T) {
+	limits := BodyLimitsFrom(config.HTTP{MaxRequestBodySize: 512 << 10, MaxUploadBodySize: 2 << 20, MaxBulkBodySize: 1 << 20})
+	assert.Equal(t, int64(512<<10), limits.limitFor("/api/data/:objectApiName"))
+	assert.Equal(t, int64(2<<20), limits.limitFor("/api/files/upload"))
+	assert.Equal(t, int64(1<<20), limits.limitFor("/api/data/:objectApiName/bulk"))
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(SecurityHeaders(SecurityHeadersConfig{HSTSMaxAge: time.Hour, ContentSecurityPolicy: config.DefaultCSP}))
+	router.GET("/health", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, config.DefaultCSP, w.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, w.Header().Get("Strict-Transport-Security"), "HSTS is only sent over HTTPS")
+
+	req = httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.TLS = &tls.ConnectionState{}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, "max-age=3600; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+}
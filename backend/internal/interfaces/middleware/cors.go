@@ -1,25 +1,81 @@
 package middleware
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/shared/pkg/constants"
 )
 
-// Cors returns a middleware that handles Cross-Origin Resource Sharing (CORS)
-func Cors() gin.HandlerFunc {
+// anyOrigin in CORS_ALLOWED_ORIGINS allows every origin, without credentials
+const anyOrigin = "*"
+
+// CorsConfig lists the browser origins allowed to call the API cross-origin
+type CorsConfig struct {
+	AllowedOrigins []string
+}
+
+// CorsConfigFrom returns the allowed origins of the HTTP settings: CORS_ALLOWED_ORIGINS, a
+// comma-separated list of origins such as https://crm.example.com, else FRONTEND_URL. With
+// neither set only same-origin requests (e.g. through the dev server proxy) are allowed.
+func CorsConfigFrom(cfg config.HTTP) CorsConfig {
+	return CorsConfig{AllowedOrigins: cfg.CORSAllowedOrigins}
+}
+
+// allows reports whether origin may call the API and whether it may send credentials
+func (cfg CorsConfig) allows(origin string) (allowed, credentials bool) {
+	wildcard := false
+	for _, allowedOrigin := range cfg.AllowedOrigins {
+		if strings.EqualFold(allowedOrigin, origin) {
+			return true, true
+		}
+		wildcard = wildcard || allowedOrigin == anyOrigin
+	}
+	return wildcard, false
+}
+
+// Cors returns a middleware that handles Cross-Origin Resource Sharing (CORS).
+// Listed origins may send credentials; with "*" other origins are allowed without them.
+func Cors(cfg CorsConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Origin")
+
 		origin := c.Request.Header.Get("Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
 		if origin == "" {
-			origin = "*"
+			c.Next()
+			return
 		}
 
-		c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+		allowed, credentials := cfg.allows(origin)
+		if !allowed {
+			// Same-origin requests also carry Origin, so only preflights are refused here;
+			// the browser keeps other responses from a disallowed origin
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if credentials {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		} else {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", anyOrigin)
+		}
 
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
+		if preflight {
+			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			c.Writer.Header().Set("Access-Control-Allow-Headers", strings.Join([]string{
+				constants.HeaderContentType, constants.HeaderAuthorization, constants.HeaderCSRFToken,
+				constants.HeaderXRequestID,
+			}, ", "))
+			c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
 
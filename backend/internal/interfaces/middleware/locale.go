@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/pkg/i18n"
+)
+
+// Locale carries the locales preferred by the Accept-Language header in the request
+// context. RequireAuth puts the signed-in user's own locale ahead of them.
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if locales := i18n.ParseAcceptLanguage(c.GetHeader("Accept-Language")); len(locales) > 0 {
+			c.Request = c.Request.WithContext(i18n.WithLocales(c.Request.Context(), locales))
+		}
+		c.Next()
+	}
+}
+
+// preferUserLocale puts a user's preferred locale ahead of the request's
+func preferUserLocale(c *gin.Context, locale string) {
+	if locale == "" {
+		return
+	}
+	ctx := c.Request.Context()
+	locales := append([]string{locale}, i18n.Locales(ctx)...)
+	c.Request = c.Request.WithContext(i18n.WithLocales(ctx, locales))
+}
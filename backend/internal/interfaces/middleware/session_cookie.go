@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/config"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// Cookie names; portal sessions get their own so both can be open in one browser
+const (
+	SessionCookieName       = "nexus_session"
+	PortalSessionCookieName = "nexus_portal_session"
+	CSRFCookieName          = "nexus_csrf"
+)
+
+// SessionCookies configures cookie-based sessions, which let browser flows that cannot set an
+// Authorization header (downloads, embedded pages) authenticate. Bearer tokens keep working.
+//
+//	AUTH_SESSION_COOKIE=true      issue an HttpOnly session cookie at login (off by default)
+//	AUTH_COOKIE_SAMESITE=lax      lax (default), strict or none; none requires HTTPS
+//	AUTH_COOKIE_SECURE=true       send cookies over HTTPS only (default true for SameSite=none)
+//	AUTH_COOKIE_DOMAIN=           cookie domain, e.g. .example.com (default: the API host)
+//	AUTH_CSRF=true                require X-CSRF-Token on cookie-authenticated writes (default true)
+type SessionCookies struct {
+	Enabled  bool
+	SameSite http.SameSite
+	Secure   bool
+	Domain   string
+	CSRF     bool
+}
+
+// SessionCookiesFrom returns the session cookie settings of the HTTP settings
+func SessionCookiesFrom(cfg config.HTTP) SessionCookies {
+	return SessionCookies{
+		Enabled:  cfg.SessionCookie,
+		SameSite: cfg.CookieSameSite,
+		Secure:   cfg.CookieSecure,
+		Domain:   cfg.CookieDomain,
+		CSRF:     cfg.CSRF,
+	}
+}
+
+// Issue sets the session cookie for token, and the CSRF cookie scripts echo in X-CSRF-Token
+func (s SessionCookies) Issue(c *gin.Context, cookieName, token string, expiresAt time.Time) {
+	if !s.Enabled {
+		return
+	}
+	s.set(c, cookieName, token, expiresAt, true)
+	if s.CSRF {
+		s.set(c, CSRFCookieName, CSRFToken(token), expiresAt, false)
+	}
+}
+
+// Clear removes the session and CSRF cookies
+func (s SessionCookies) Clear(c *gin.Context, cookieName string) {
+	if !s.Enabled {
+		return
+	}
+	s.set(c, cookieName, "", time.Unix(0, 0), true)
+	s.set(c, CSRFCookieName, "", time.Unix(0, 0), false)
+}
+
+func (s SessionCookies) set(c *gin.Context, name, value string, expiresAt time.Time, httpOnly bool) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Domain:   s.Domain,
+		Expires:  expiresAt,
+		Secure:   s.Secure,
+		HttpOnly: httpOnly,
+		SameSite: s.SameSite,
+	})
+}
+
+// sessionToken returns the session token of a cookie-authenticated request, checking the
+// CSRF token of state-changing requests
+func (s SessionCookies) sessionToken(c *gin.Context, cookieName string) (string, error) {
+	if !s.Enabled {
+		return "", nil
+	}
+	token, err := c.Cookie(cookieName)
+	if err != nil || token == "" {
+		return "", nil
+	}
+	if s.CSRF && !isSafeMethod(c.Request.Method) {
+		sent := c.GetHeader(constants.HeaderCSRFToken)
+		if sent == "" || subtle.ConstantTimeCompare([]byte(sent), []byte(CSRFToken(token))) != 1 {
+			return "", errors.NewForbiddenError("Missing or invalid CSRF token")
+		}
+	}
+	return token, nil
+}
+
+// CSRFToken derives the CSRF token of a session. It is bound to the session token, which
+// scripts cannot read from the HttpOnly cookie, so another site cannot forge or plant it.
+func CSRFToken(sessionToken string) string {
+	sum := sha256.Sum256([]byte("nexus-csrf:" + sessionToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCookies_IssueAndCSRF(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cookies := SessionCookies{Enabled: true, SameSite: http.SameSiteLaxMode, CSRF: true}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	cookies.Issue(c, SessionCookieName, "session-token", time.Now().Add(time.Hour))
+
+	issued := map[string]*http.Cookie{}
+	for _, cookie := range w.Result().Cookies() {
+		issued[cookie.Name] = cookie
+	}
+	require.Contains(t, issued, SessionCookieName)
+	require.Contains(t, issued, CSRFCookieName)
+	assert.True(t, issued[SessionCookieName].HttpOnly)
+	assert.False(t, issued[CSRFCookieName].HttpOnly)
+	assert.Equal(t, CSRFToken("session-token"), issued[CSRFCookieName].Value)
+
+	request := func(method, csrf string) (string, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(method, "/api/data/account", nil)
+		c.Request.AddCookie(issued[SessionCookieName])
+		if csrf != "" {
+			c.Request.Header.Set(constants.HeaderCSRFToken, csrf)
+		}
+		return cookies.sessionToken(c, SessionCookieName)
+	}
+
+	token, err := request(http.MethodGet, "")
+	require.NoError(t, err)
+	assert.Equal(t, "session-token", token)
+
+	token, err = request(http.MethodPost, issued[CSRFCookieName].Value)
+	require.NoError(t, err)
+	assert.Equal(t, "session-token", token)
+
+	_, err = request(http.MethodPost, "")
+	assert.Error(t, err)
+	_, err = request(http.MethodDelete, CSRFToken("another-session"))
+	assert.Error(t, err)
+
+	// Cookies are ignored unless enabled
+	cookies.Enabled = false
+	token, err = request(http.MethodPost, "")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+}
+
+func TestCors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Cors(CorsConfig{AllowedOrigins: []string{"https://crm.example.com"}}))
+	router.GET("/api/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	send := func(method, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/ping", nil)
+		req.Header.Set("Origin", origin)
+		if method == http.MethodOptions {
+			req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send(http.MethodOptions, "https://crm.example.com")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "https://crm.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), constants.HeaderCSRFToken)
+
+	w = send(http.MethodOptions, "https://evil.example.net")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = send(http.MethodGet, "https://evil.example.net")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	router = gin.New()
+	router.Use(Cors(CorsConfig{AllowedOrigins: []string{"*"}}))
+	router.GET("/api/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w = send(http.MethodGet, "https://anywhere.example.org")
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+}
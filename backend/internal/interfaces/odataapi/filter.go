@@ -0,0 +1,288 @@
+package odataapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// filterOperators maps OData comparison operators onto formula operators
+var filterOperators = map[string]string{
+	"eq": "==",
+	"ne": "!=",
+	"gt": ">",
+	"ge": ">=",
+	"lt": "<",
+	"le": "<=",
+}
+
+// filterFunctions maps OData functions onto formula functions, with their arity
+var filterFunctions = map[string]struct {
+	name  string
+	arity int
+}{
+	"contains":   {"CONTAINS", 2},
+	"startswith": {"STARTS_WITH", 2},
+	"endswith":   {"ENDS_WITH", 2},
+	"tolower":    {"LOWER", 1},
+	"toupper":    {"UPPER", 1},
+	"length":     {"LEN", 1},
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenDate
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// translateFilter turns an OData $filter expression into a formula filter expression.
+// Only properties the caller can filter on are accepted, so a filter cannot probe
+// values hidden by field-level security.
+func translateFilter(filter string, filterable func(property string) bool) (string, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return "", err
+	}
+	p := &filterParser{tokens: tokens, filterable: filterable}
+	expr, err := p.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if p.peek().kind != tokenEOF {
+		return "", fmt.Errorf("unexpected %q in $filter", p.peek().value)
+	}
+	return expr, nil
+}
+
+func tokenizeFilter(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenOpen, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenClose, ")"})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{tokenComma, ","})
+			i++
+		case c == '\'':
+			// Strings are single-quoted; a doubled quote is a literal quote
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(s) {
+					return nil, fmt.Errorf("unterminated string in $filter")
+				}
+				if s[i] == '\'' {
+					if i+1 < len(s) && s[i+1] == '\'' {
+						sb.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+			tokens = append(tokens, token{tokenString, sb.String()})
+		case c == '-' || unicode.IsDigit(rune(c)):
+			start := i
+			i++
+			for i < len(s) && strings.IndexByte("0123456789.:-+TZeE", s[i]) >= 0 {
+				i++
+			}
+			lit := s[start:i]
+			if strings.ContainsAny(lit[1:], "-:T") {
+				tokens = append(tokens, token{tokenDate, lit})
+			} else {
+				tokens = append(tokens, token{tokenNumber, lit})
+			}
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, s[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q in $filter", c)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+type filterParser struct {
+	tokens     []token
+	pos        int
+	filterable func(string) bool
+}
+
+func (p *filterParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) keyword(word string) bool {
+	if t := p.peek(); t.kind == tokenIdent && t.value == word {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (string, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return "", err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return "", err
+		}
+		left = "(" + left + " || " + right + ")"
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (string, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return "", err
+	}
+	for p.keyword("and") {
+		right, err := p.parseComparison()
+		if err != nil {
+			return "", err
+		}
+		left = "(" + left + " && " + right + ")"
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseComparison() (string, error) {
+	if p.peek().kind == tokenIdent && p.peek().value == "not" {
+		return "", fmt.Errorf("the not operator is not supported in $filter")
+	}
+	left, err := p.parseOperand()
+	if err != nil {
+		return "", err
+	}
+	if t := p.peek(); t.kind == tokenIdent {
+		if op, ok := filterOperators[t.value]; ok {
+			p.pos++
+			right, err := p.parseOperand()
+			if err != nil {
+				return "", err
+			}
+			return "(" + left + " " + op + " " + right + ")", nil
+		}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseOperand() (string, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenOpen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return "", err
+		}
+		if p.next().kind != tokenClose {
+			return "", fmt.Errorf("missing ) in $filter")
+		}
+		return inner, nil
+	case tokenString:
+		return strconv.Quote(t.value), nil
+	case tokenNumber:
+		if _, err := strconv.ParseFloat(t.value, 64); err != nil {
+			return "", fmt.Errorf("invalid number %q in $filter", t.value)
+		}
+		if strings.HasPrefix(t.value, "-") {
+			return "(0 - " + t.value[1:] + ")", nil // Filters have no unary minus
+		}
+		return t.value, nil
+	case tokenDate:
+		return dateLiteral(t.value)
+	case tokenIdent:
+		switch t.value {
+		case "true", "false":
+			return t.value, nil
+		case "null":
+			return "null", nil
+		}
+		if fn, ok := filterFunctions[t.value]; ok && p.peek().kind == tokenOpen {
+			return p.parseCall(t.value, fn.name, fn.arity)
+		}
+		if !p.filterable(t.value) {
+			return "", fmt.Errorf("cannot filter on property %q", t.value)
+		}
+		return t.value, nil
+	case tokenEOF:
+		return "", fmt.Errorf("incomplete $filter expression")
+	default:
+		return "", fmt.Errorf("unexpected %q in $filter", t.value)
+	}
+}
+
+func (p *filterParser) parseCall(odataName, name string, arity int) (string, error) {
+	p.next() // (
+	args := make([]string, 0, arity)
+	for {
+		arg, err := p.parseOperand()
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+		if p.peek().kind != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if p.next().kind != tokenClose {
+		return "", fmt.Errorf("missing ) after %s arguments", odataName)
+	}
+	if len(args) != arity {
+		return "", fmt.Errorf("%s takes %d argument(s)", odataName, arity)
+	}
+	return name + "(" + strings.Join(args, ", ") + ")", nil
+}
+
+// dateLiteral converts an Edm.Date or Edm.DateTimeOffset literal to a quoted UTC
+// timestamp in the database's format
+func dateLiteral(lit string) (string, error) {
+	if t, err := time.Parse(time.RFC3339Nano, lit); err == nil {
+		return strconv.Quote(t.UTC().Format("2006-01-02 15:04:05")), nil
+	}
+	if t, err := time.Parse("2006-01-02", lit); err == nil {
+		return strconv.Quote(t.Format("2006-01-02")), nil
+	}
+	return "", fmt.Errorf("invalid date %q in $filter", lit)
+}
@@ -0,0 +1,132 @@
+package odataapi
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/backend/pkg/expression"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateFilter(t *testing.T) {
+	filterable := func(name string) bool { return name != "salary" }
+
+	cases := map[string]string{
+		"name eq 'Acme'":                            `(name == "Acme")`,
+		"amount gt 1000 and stage ne 'Closed'":      `((amount > 1000) && (stage != "Closed"))`,
+		"(a eq 1 or b le -2.5) and c eq true":       `(((a == 1) || (b <= (0 - 2.5))) && (c == true))`,
+		"contains(name,'O''Brien')":                 `CONTAINS(name, "O'Brien")`,
+		"startswith(tolower(name), 'ac') eq true":   `(STARTS_WITH(LOWER(name), "ac") == true)`,
+		"close_date ge 2024-01-31":                  `(close_date >= "2024-01-31")`,
+		"created_date lt 2024-01-31T10:00:00+02:00": `(created_date < "2024-01-31 08:00:00")`,
+		"owner_id eq null":                          `(owner_id == null)`,
+		"name eq '\" || true || \"'":                `(name == "\" || true || \"")`,
+	}
+	for filter, want := range cases {
+		got, err := translateFilter(filter, filterable)
+		require.NoError(t, err, filter)
+		assert.Equal(t, want, got, filter)
+
+		_, _, err = expression.ToSQL(got)
+		assert.NoError(t, err, "translated %q must be a valid filter expression", filter)
+	}
+
+	for _, filter := range []string{
+		"salary gt 10",
+		"not (a eq 1)",
+		"name eq 'open",
+		"contains(name)",
+		"name eq",
+		"a eq 1 b",
+		"name eq 1; DROP TABLE account",
+	} {
+		_, err := translateFilter(filter, filterable)
+		assert.Error(t, err, filter)
+	}
+}
+
+func newTestEntityType() *entityType {
+	formula := constants.FieldTypeNumber
+	return newEntityType(&models.ObjectMetadata{
+		APIName: "account",
+		Fields: []models.FieldMetadata{
+			{APIName: constants.FieldID, Type: constants.FieldTypeText, IsSystem: true},
+			{APIName: "name", Type: constants.FieldTypeText, IsNameField: true},
+			{APIName: "revenue", Type: constants.FieldTypeCurrency},
+			{APIName: "score", Type: constants.FieldTypeFormula, ReturnType: &formula},
+			{APIName: "founded", Type: constants.FieldTypeDate},
+			{APIName: "active", Type: constants.FieldTypeBoolean},
+			{APIName: "secret", Type: constants.FieldTypeText},
+			{APIName: "portal_password", Type: constants.FieldTypePassword},
+		},
+	}, func(field string) bool { return field != "secret" })
+}
+
+func TestParseQueryOptions(t *testing.T) {
+	et := newTestEntityType()
+
+	opts, err := parseQueryOptions(et, url.Values{
+		"$filter":  {"revenue gt 5"},
+		"$orderby": {"name desc"},
+		"$top":     {"10"},
+		"$skip":    {"20"},
+		"$select":  {"name, revenue"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "(revenue > 5)", opts.filter)
+	assert.Equal(t, "name", opts.orderBy)
+	assert.Equal(t, constants.SortDESC, opts.orderDirection)
+	assert.Equal(t, 10, opts.top)
+	assert.Equal(t, 20, opts.skip)
+	assert.Equal(t, []string{"name", "revenue"}, opts.selected)
+
+	for name, query := range map[string]url.Values{
+		"hidden field":        {"$select": {"secret"}},
+		"password":            {"$filter": {"portal_password eq 'x'"}},
+		"formula filter":      {"$filter": {"score gt 1"}},
+		"formula order":       {"$orderby": {"score"}},
+		"two sort keys":       {"$orderby": {"name,revenue"}},
+		"bad direction":       {"$orderby": {"name sideways"}},
+		"negative top":        {"$top": {"-1"}},
+		"unsupported $expand": {"$expand": {"contacts"}},
+	} {
+		_, err := parseQueryOptions(et, query)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestEntitiesAndMetadata(t *testing.T) {
+	et := newTestEntityType()
+	founded := time.Date(1999, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	entities := et.entities([]models.SObject{{
+		constants.FieldID: "a1",
+		"name":            "Acme",
+		"founded":         founded,
+		"active":          int64(1),
+		"secret":          "s3cr3t",
+	}}, nil)
+	require.Len(t, entities, 1)
+	assert.Equal(t, "1999-04-01", entities[0]["founded"])
+	assert.Equal(t, true, entities[0]["active"])
+	assert.NotContains(t, entities[0], "secret")
+	assert.NotContains(t, entities[0], "portal_password")
+
+	selected := et.entities([]models.SObject{{constants.FieldID: "a1", "name": "Acme"}}, []string{"name"})
+	assert.Equal(t, map[string]interface{}{"name": "Acme"}, selected[0])
+
+	body, err := buildMetadata([]*entityType{et})
+	require.NoError(t, err)
+	doc := string(body)
+	assert.Contains(t, doc, `<EntityType Name="account">`)
+	assert.Contains(t, doc, `<PropertyRef Name="__sys_gen_id">`)
+	assert.Contains(t, doc, `<Property Name="revenue" Type="Edm.Double" Nullable="true">`)
+	assert.Contains(t, doc, `<Property Name="founded" Type="Edm.Date" Nullable="true">`)
+	assert.Contains(t, doc, `<EntitySet Name="account" EntityType="NexusCRM.account">`)
+	assert.False(t, strings.Contains(doc, "secret") || strings.Contains(doc, "portal_password"))
+}
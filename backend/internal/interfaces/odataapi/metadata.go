@@ -0,0 +1,89 @@
+package odataapi
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+type edmx struct {
+	XMLName      xml.Name        `xml:"edmx:Edmx"`
+	Xmlns        string          `xml:"xmlns:edmx,attr"`
+	Version      string          `xml:"Version,attr"`
+	DataServices edmDataServices `xml:"edmx:DataServices"`
+}
+
+type edmDataServices struct {
+	Schema edmSchema `xml:"Schema"`
+}
+
+type edmSchema struct {
+	Xmlns       string          `xml:"xmlns,attr"`
+	Namespace   string          `xml:"Namespace,attr"`
+	EntityTypes []edmEntityType `xml:"EntityType"`
+	Container   edmContainer    `xml:"EntityContainer"`
+}
+
+type edmEntityType struct {
+	Name       string        `xml:"Name,attr"`
+	Key        edmKey        `xml:"Key"`
+	Properties []edmProperty `xml:"Property"`
+}
+
+type edmKey struct {
+	PropertyRef edmPropertyRef `xml:"PropertyRef"`
+}
+
+type edmPropertyRef struct {
+	Name string `xml:"Name,attr"`
+}
+
+type edmProperty struct {
+	Name     string `xml:"Name,attr"`
+	Type     string `xml:"Type,attr"`
+	Nullable string `xml:"Nullable,attr"`
+}
+
+type edmContainer struct {
+	Name       string         `xml:"Name,attr"`
+	EntitySets []edmEntitySet `xml:"EntitySet"`
+}
+
+type edmEntitySet struct {
+	Name       string `xml:"Name,attr"`
+	EntityType string `xml:"EntityType,attr"`
+}
+
+// buildMetadata renders the CSDL XML document for the given entity types
+func buildMetadata(types []*entityType) ([]byte, error) {
+	schema := edmSchema{
+		Xmlns:     "http://docs.oasis-open.org/odata/ns/edm",
+		Namespace: Namespace,
+		Container: edmContainer{Name: "Container"},
+	}
+	for _, et := range types {
+		t := edmEntityType{
+			Name: et.schema.APIName,
+			Key:  edmKey{PropertyRef: edmPropertyRef{Name: constants.FieldID}},
+		}
+		for _, p := range et.properties {
+			t.Properties = append(t.Properties, edmProperty{Name: p.name, Type: p.edmType, Nullable: strconv.FormatBool(p.nullable)})
+		}
+		schema.EntityTypes = append(schema.EntityTypes, t)
+		schema.Container.EntitySets = append(schema.Container.EntitySets, edmEntitySet{
+			Name:       et.schema.APIName,
+			EntityType: Namespace + "." + et.schema.APIName,
+		})
+	}
+
+	body, err := xml.MarshalIndent(edmx{
+		Xmlns:        "http://docs.oasis-open.org/odata/ns/edmx",
+		Version:      "4.0",
+		DataServices: edmDataServices{Schema: schema},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,366 @@
+// Package odataapi serves objects as read-only OData v4 entity sets, so that Excel,
+// Power BI and other OData clients can connect without exports. Query options are
+// translated into QueryService requests, so permissions and field-level security
+// apply as in the REST API.
+package odataapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+const (
+	// Namespace of the entity types in $metadata
+	Namespace = "NexusCRM"
+	// MaxPageSize caps the entities per response; clients follow @odata.nextLink for the rest
+	MaxPageSize = 1000
+)
+
+// unsupportedOptions are system query options this read-only endpoint does not implement
+var unsupportedOptions = []string{"$expand", "$apply", "$search", "$count", "$compute"}
+
+// API answers OData requests
+type API struct {
+	svc *services.ServiceManager
+}
+
+// New creates an API backed by the service layer
+func New(svc *services.ServiceManager) *API {
+	return &API{svc: svc}
+}
+
+// entityType is an object as the caller sees it over OData
+type entityType struct {
+	schema     *models.ObjectMetadata
+	properties []*property
+	byName     map[string]*property
+}
+
+type property struct {
+	name     string
+	edmType  string
+	nullable bool
+	stored   bool // Backed by a column, so it can be filtered and sorted on
+}
+
+// entityTypes returns the objects the caller can read, with their visible properties
+func (a *API) entityTypes(ctx context.Context, user *models.UserSession) []*entityType {
+	var types []*entityType
+	for _, s := range a.svc.GetSchemas(ctx) {
+		if strings.HasPrefix(s.APIName, "_") {
+			continue // System tables have their own admin APIs
+		}
+		if !a.svc.Permissions.CheckObjectPermissionWithUser(ctx, s.APIName, constants.PermRead, user) {
+			continue
+		}
+		types = append(types, newEntityType(s, func(field string) bool {
+			return a.svc.Permissions.CheckFieldVisibilityWithUser(ctx, s.APIName, field, user)
+		}))
+	}
+	return types
+}
+
+func newEntityType(s *models.ObjectMetadata, visible func(field string) bool) *entityType {
+	et := &entityType{schema: s, byName: make(map[string]*property)}
+	for _, f := range s.Fields {
+		if f.Type == constants.FieldTypePassword {
+			continue
+		}
+		if !f.IsSystem && !f.IsNameField && !visible(f.APIName) {
+			continue
+		}
+		p := &property{
+			name:     f.APIName,
+			edmType:  edmType(f),
+			nullable: f.APIName != constants.FieldID,
+			stored:   f.Type != constants.FieldTypeFormula,
+		}
+		et.properties = append(et.properties, p)
+		et.byName[p.name] = p
+	}
+	return et
+}
+
+func (a *API) entityType(ctx context.Context, user *models.UserSession, entitySet string) (*entityType, error) {
+	for _, et := range a.entityTypes(ctx, user) {
+		if et.schema.APIName == entitySet {
+			return et, nil
+		}
+	}
+	return nil, appErrors.NewNotFoundError("EntitySet", entitySet)
+}
+
+// ServiceDocument lists the entity sets
+func (a *API) ServiceDocument(ctx context.Context, user *models.UserSession, baseURL string) map[string]interface{} {
+	sets := make([]map[string]string, 0)
+	for _, et := range a.entityTypes(ctx, user) {
+		sets = append(sets, map[string]string{"name": et.schema.APIName, "kind": "EntitySet", "url": et.schema.APIName})
+	}
+	return map[string]interface{}{
+		"@odata.context": baseURL + "/$metadata",
+		"value":          sets,
+	}
+}
+
+// Metadata returns the CSDL document describing the caller's entity sets
+func (a *API) Metadata(ctx context.Context, user *models.UserSession) ([]byte, error) {
+	return buildMetadata(a.entityTypes(ctx, user))
+}
+
+// Query returns one page of an entity set. baseURL is the service root, used for the
+// context URL and the next link.
+func (a *API) Query(ctx context.Context, user *models.UserSession, entitySet string, query url.Values, baseURL string) (map[string]interface{}, error) {
+	et, err := a.entityType(ctx, user, entitySet)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := parseQueryOptions(et, query)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := MaxPageSize
+	if opts.top >= 0 && opts.top < pageSize {
+		pageSize = opts.top
+	}
+	req := models.QueryRequest{
+		ObjectAPIName: et.schema.APIName,
+		FilterExpr:    opts.filter,
+		SortField:     opts.orderBy,
+		SortDirection: opts.orderDirection,
+		Limit:         pageSize + 1,
+		Offset:        opts.skip,
+	}
+	if req.SortField == "" {
+		req.SortField = constants.FieldID // Stable order for paging
+	}
+
+	var records []models.SObject
+	if pageSize > 0 {
+		if records, err = a.svc.QuerySvc.Query(ctx, req, user); err != nil {
+			return nil, err
+		}
+	}
+	hasMore := len(records) > pageSize
+	if hasMore {
+		records = records[:pageSize]
+	}
+
+	contextURL := baseURL + "/$metadata#" + entitySet
+	if len(opts.selected) > 0 {
+		contextURL += "(" + strings.Join(opts.selected, ",") + ")"
+	}
+	resp := map[string]interface{}{
+		"@odata.context": contextURL,
+		"value":          et.entities(records, opts.selected),
+	}
+
+	// A $top larger than a page continues on the next page with what is left of it
+	if hasMore && (opts.top < 0 || opts.top > pageSize) {
+		next := url.Values{}
+		for k, v := range query {
+			next[k] = v
+		}
+		next.Set("$skip", strconv.Itoa(opts.skip+pageSize))
+		if opts.top > 0 {
+			next.Set("$top", strconv.Itoa(opts.top-pageSize))
+		}
+		resp["@odata.nextLink"] = baseURL + "/" + entitySet + "?" + next.Encode()
+	}
+	return resp, nil
+}
+
+// Get returns one entity by key
+func (a *API) Get(ctx context.Context, user *models.UserSession, entitySet, id string, query url.Values, baseURL string) (map[string]interface{}, error) {
+	et, err := a.entityType(ctx, user, entitySet)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := parseQueryOptions(et, url.Values{"$select": query["$select"]})
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := a.svc.QuerySvc.QueryByIDs(ctx, entitySet, []string{id}, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !a.svc.Permissions.CheckRecordAccess(ctx, et.schema, records[0], constants.PermRead, user) {
+		return nil, appErrors.NewNotFoundError(entitySet, id)
+	}
+
+	entity := et.entities(records, opts.selected)[0]
+	entity["@odata.context"] = baseURL + "/$metadata#" + entitySet + "/$entity"
+	return entity, nil
+}
+
+// queryOptions are the parsed system query options of a request
+type queryOptions struct {
+	filter         string
+	orderBy        string
+	orderDirection string
+	top            int // -1 when absent
+	skip           int
+	selected       []string
+}
+
+func parseQueryOptions(et *entityType, query url.Values) (*queryOptions, error) {
+	for _, opt := range unsupportedOptions {
+		if query.Has(opt) {
+			return nil, appErrors.NewValidationError(opt, opt+" is not supported")
+		}
+	}
+
+	opts := &queryOptions{top: -1}
+	if raw := query.Get("$filter"); raw != "" {
+		filter, err := translateFilter(raw, func(name string) bool {
+			p := et.byName[name]
+			return p != nil && p.stored
+		})
+		if err != nil {
+			return nil, appErrors.NewValidationError("$filter", err.Error())
+		}
+		opts.filter = filter
+	}
+
+	if raw := strings.TrimSpace(query.Get("$orderby")); raw != "" {
+		if strings.Contains(raw, ",") {
+			return nil, appErrors.NewValidationError("$orderby", "only one property can be ordered by")
+		}
+		parts := strings.Fields(raw)
+		p := et.byName[parts[0]]
+		if p == nil || !p.stored || len(parts) > 2 {
+			return nil, appErrors.NewValidationError("$orderby", fmt.Sprintf("cannot order by %q", raw))
+		}
+		opts.orderBy = p.name
+		opts.orderDirection = constants.SortASC
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				opts.orderDirection = constants.SortDESC
+			default:
+				return nil, appErrors.NewValidationError("$orderby", "direction must be asc or desc")
+			}
+		}
+	}
+
+	for name, dest := range map[string]*int{"$top": &opts.top, "$skip": &opts.skip} {
+		if raw := query.Get(name); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 0 {
+				return nil, appErrors.NewValidationError(name, "must be a non-negative integer")
+			}
+			*dest = n
+		}
+	}
+
+	if raw := strings.TrimSpace(query.Get("$select")); raw != "" && raw != "*" {
+		for _, name := range strings.Split(raw, ",") {
+			name = strings.TrimSpace(name)
+			if et.byName[name] == nil {
+				return nil, appErrors.NewValidationError("$select", fmt.Sprintf("unknown property %q", name))
+			}
+			opts.selected = append(opts.selected, name)
+		}
+	}
+	return opts, nil
+}
+
+// entities shapes records as OData entities: only declared properties, each
+// formatted for its Edm type
+func (et *entityType) entities(records []models.SObject, selected []string) []map[string]interface{} {
+	props := et.properties
+	if len(selected) > 0 {
+		props = make([]*property, len(selected))
+		for i, name := range selected {
+			props[i] = et.byName[name]
+		}
+	}
+
+	out := make([]map[string]interface{}, len(records))
+	for i, r := range records {
+		entity := make(map[string]interface{}, len(props))
+		for _, p := range props {
+			entity[p.name] = p.format(r[p.name])
+		}
+		out[i] = entity
+	}
+	return out
+}
+
+// format converts a record value to the JSON representation of the property's Edm type
+func (p *property) format(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch p.edmType {
+	case "Edm.Date":
+		switch t := v.(type) {
+		case time.Time:
+			return t.Format("2006-01-02")
+		case string:
+			if len(t) >= 10 {
+				return t[:10]
+			}
+		}
+	case "Edm.DateTimeOffset":
+		switch t := v.(type) {
+		case time.Time:
+			return t.UTC().Format(time.RFC3339)
+		case string:
+			if parsed, err := time.Parse("2006-01-02 15:04:05", t); err == nil {
+				return parsed.UTC().Format(time.RFC3339)
+			}
+		}
+	case "Edm.Boolean":
+		switch b := v.(type) {
+		case int64:
+			return b != 0
+		case int:
+			return b != 0
+		}
+	case "Edm.String":
+		switch s := v.(type) {
+		case string:
+			return s
+		case []byte:
+			return string(s)
+		default:
+			if raw, err := json.Marshal(s); err == nil {
+				return string(raw)
+			}
+		}
+	}
+	return v
+}
+
+// edmType maps a field type onto an OData primitive type
+func edmType(f models.FieldMetadata) string {
+	t := f.Type
+	if t == constants.FieldTypeFormula && f.ReturnType != nil {
+		t = *f.ReturnType
+	}
+	switch t {
+	case constants.FieldTypeNumber, constants.FieldTypeCurrency, constants.FieldTypePercent, constants.FieldTypeRollupSummary:
+		return "Edm.Double"
+	case constants.FieldTypeBoolean:
+		return "Edm.Boolean"
+	case constants.FieldTypeDate:
+		return "Edm.Date"
+	case constants.FieldTypeDateTime:
+		return "Edm.DateTimeOffset"
+	default:
+		return "Edm.String"
+	}
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
@@ -66,16 +67,17 @@ func (h *ActionHandler) GetActions(c *gin.Context) {
 	})
 }
 
-// GetAllActions handles GET /api/metadata/actions
+// GetAllActions handles GET /api/metadata/actions?modifiedSince=&limit=&offset=
 func (h *ActionHandler) GetAllActions(c *gin.Context) {
-	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		actions := h.svc.Metadata.GetAllActions(c.Request.Context())
-		sanitized := make([]*models.ActionMetadata, len(actions))
-		for i, a := range actions {
-			sanitized[i] = sanitizeAction(a)
-		}
-		return sanitized, nil
-	})
+	HandleMetadataList(c, func(a *models.ActionMetadata) (string, time.Time) { return a.ID, a.LastModifiedDate },
+		func() ([]*models.ActionMetadata, error) {
+			actions := h.svc.Metadata.GetAllActions(c.Request.Context())
+			sanitized := make([]*models.ActionMetadata, len(actions))
+			for i, a := range actions {
+				sanitized[i] = sanitizeAction(a)
+			}
+			return sanitized, nil
+		})
 }
 
 // GetAction handles GET /api/metadata/actions/id/:actionId
@@ -1,8 +1,13 @@
 package rest
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
 )
 
 // AdminHandler handles administrative endpoints
@@ -35,3 +40,156 @@ func (h *AdminHandler) ValidateSchema(c *gin.Context) {
 		return h.svc.Schema.ValidateSchemaRegistry()
 	})
 }
+
+// GetSchemaDrift returns the latest schema drift report, checking now when none exists yet
+// or ?refresh=true is given
+func (h *AdminHandler) GetSchemaDrift(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		if report := h.svc.SchemaDrift.Latest(); report != nil && c.Query("refresh") != "true" {
+			return report, nil
+		}
+		return h.svc.SchemaDrift.Detect(c.Request.Context())
+	})
+}
+
+// RepairSchemaDrift adds missing columns and indexes and returns the report after the repair
+func (h *AdminHandler) RepairSchemaDrift(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SchemaDrift.Repair(c.Request.Context())
+	})
+}
+
+// GetIndexAdvisor suggests indexes for custom fields that captured slow queries filter or sort on
+func (h *AdminHandler) GetIndexAdvisor(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.IndexAdvisor.Report(c.Request.Context()), nil
+	})
+}
+
+// GetReadReplica handles GET /api/admin/read-replica, reporting the replica's health and lag
+func (h *AdminHandler) GetReadReplica(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.ReadRouter.Status(), nil
+	})
+}
+
+// GetSearchStatus returns the configured full-text search engine
+func (h *AdminHandler) GetSearchStatus(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return map[string]interface{}{
+			"engine":  h.svc.Search.EngineName(),
+			"enabled": h.svc.Search.Enabled(),
+		}, nil
+	})
+}
+
+// ReindexSearch rebuilds the full-text search index from the database
+func (h *AdminHandler) ReindexSearch(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		count, err := h.svc.Search.Rebuild(c.Request.Context())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"engine":  h.svc.Search.EngineName(),
+			"indexed": count,
+		}, nil
+	})
+}
+
+// MassTransfer handles POST /api/admin/mass-transfer, queueing a job that changes the owner
+// of the matching records. Its progress is polled at /api/metadata/async-jobs/:id.
+func (h *AdminHandler) MassTransfer(c *gin.Context) {
+	var req models.MassTransferRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	req.ObjectAPIName = strings.ToLower(req.ObjectAPIName)
+
+	job, err := h.svc.MassOperations.Transfer(c.Request.Context(), req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Mass transfer queued",
+		"data":                 job,
+	})
+}
+
+// MassUpdate handles POST /api/admin/mass-update, queueing a job that sets field values on
+// the matching records. Its progress is polled at /api/metadata/async-jobs/:id.
+func (h *AdminHandler) MassUpdate(c *gin.Context) {
+	var req models.MassUpdateRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	req.ObjectAPIName = strings.ToLower(req.ObjectAPIName)
+
+	job, err := h.svc.MassOperations.Update(c.Request.Context(), req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Mass update queued",
+		"data":                 job,
+	})
+}
+
+// GenerateSampleData handles POST /api/admin/sample-data, queueing a job that creates linked
+// demo accounts, contacts, opportunities and tasks. Its progress is polled at
+// /api/metadata/async-jobs/:id.
+func (h *AdminHandler) GenerateSampleData(c *gin.Context) {
+	var req models.SampleDataRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	job, err := h.svc.SampleData.Generate(c.Request.Context(), req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Sample data generation queued",
+		"data":                 job,
+	})
+}
+
+// GetConfigs handles GET /api/admin/config, listing _System_Config entries with secret
+// values redacted
+func (h *AdminHandler) GetConfigs(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.System.ListConfigs(c.Request.Context())
+	})
+}
+
+// SaveConfig handles PUT /api/admin/config/:key. Secret entries take a secret reference
+// (vault:..., aws:..., env:...) as their value, never the secret itself.
+func (h *AdminHandler) SaveConfig(c *gin.Context) {
+	var config models.SystemConfig
+	if !BindJSON(c, &config) {
+		return
+	}
+	config.KeyName = c.Param("key")
+
+	if err := h.svc.System.SaveConfig(c.Request.Context(), &config); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	if config.IsSecret {
+		config.Value = services.RedactSecretConfig(config.Value)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Config saved",
+		"data":                 config,
+	})
+}
+
+// DeleteConfig handles DELETE /api/admin/config/:key
+func (h *AdminHandler) DeleteConfig(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Config deleted", func() error {
+		return h.svc.System.DeleteConfig(c.Request.Context(), c.Param("key"))
+	})
+}
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
 	"github.com/nexuscrm/backend/pkg/auth"
@@ -22,6 +24,8 @@ type AdminQueryRequest struct {
 	Params []interface{} `json:"params"`
 }
 
+// ExecuteAdminQuery handles POST /api/analytics/query: administrator SQL run in the
+// analytics sandbox. Response: {"data": rows, "truncated": bool}
 func (h *AnalyticsHandler) ExecuteAdminQuery(c *gin.Context) {
 	// Parse request
 	var req AdminQueryRequest
@@ -61,12 +65,10 @@ func (h *AnalyticsHandler) ExecuteAdminQuery(c *gin.Context) {
 		RoleID:    authSession.RoleId,
 	}
 
-	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.QuerySvc.ExecuteRawSQL(
-			c.Request.Context(),
-			req.SQL,
-			req.Params,
-			userSession,
-		)
-	})
+	result, err := h.svc.QuerySvc.ExecuteAdminQuery(c.Request.Context(), req.SQL, req.Params, userSession)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
 }
@@ -17,6 +17,8 @@ type ApprovalService interface {
 	Submit(ctx context.Context, req services.SubmitRequest, user *models.UserSession) (models.SObject, error)
 	Approve(ctx context.Context, workItemID, comments string, user *models.UserSession) error
 	Reject(ctx context.Context, workItemID, comments string, user *models.UserSession) error
+	Recall(ctx context.Context, workItemID, comments string, user *models.UserSession) error
+	Reassign(ctx context.Context, workItemID, approverID, comments string, user *models.UserSession) (models.SObject, error)
 	GetPending(ctx context.Context, user *models.UserSession) ([]models.SObject, error)
 	GetHistory(ctx context.Context, objectAPIName, recordID string, user *models.UserSession) ([]models.SObject, error)
 	CheckProcess(ctx context.Context, objectAPIName string, user *models.UserSession) (models.SObject, error)
@@ -49,6 +51,12 @@ type ApprovalActionRequest struct {
 	Comments string `json:"comments"`
 }
 
+// ReassignRequest represents a request to route a work item to another approver
+type ReassignRequest struct {
+	ApproverID string `json:"approver_id" binding:"required"`
+	Comments   string `json:"comments"`
+}
+
 // ============================================================================
 // Public Endpoints
 // ============================================================================
@@ -70,16 +78,6 @@ func (h *ApprovalHandler) Submit(c *gin.Context) {
 
 	workItem, err := h.svc.Submit(c.Request.Context(), serviceReq, user)
 	if err != nil {
-		// Map common errors to status codes
-		if err.Error() == "no active approval process found for this object" ||
-			err.Error() == "record already has a pending approval" {
-			RespondAppError(c, appErrors.NewValidationError("approval", err.Error()))
-			return
-		}
-		if err.Error() == "you don't have permission to submit this record for approval" {
-			RespondAppError(c, appErrors.NewPermissionError("submit_approval", req.RecordID))
-			return
-		}
 		RespondAppError(c, err)
 		return
 	}
@@ -137,6 +135,53 @@ func (h *ApprovalHandler) Reject(c *gin.Context) {
 	})
 }
 
+// Recall handles POST /api/approvals/:workItemId/recall
+func (h *ApprovalHandler) Recall(c *gin.Context) {
+	workItemID := c.Param("workItemId")
+	user := GetUserFromContext(c)
+
+	var req ApprovalActionRequest
+	_ = c.ShouldBindJSON(&req) // Optional comments
+
+	err := h.svc.Recall(c.Request.Context(), workItemID, req.Comments, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": gin.H{
+			"success":              true,
+			constants.FieldMessage: "Approval request recalled",
+		},
+	})
+}
+
+// Reassign handles POST /api/approvals/:workItemId/reassign
+func (h *ApprovalHandler) Reassign(c *gin.Context) {
+	workItemID := c.Param("workItemId")
+	user := GetUserFromContext(c)
+
+	var req ReassignRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	workItem, err := h.svc.Reassign(c.Request.Context(), workItemID, req.ApproverID, req.Comments, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": gin.H{
+			"success":              true,
+			constants.FieldMessage: "Approval request reassigned",
+			"work_item_id":         workItem[constants.FieldID],
+		},
+	})
+}
+
 // GetPending handles GET /api/approvals/pending
 func (h *ApprovalHandler) GetPending(c *gin.Context) {
 	user := GetUserFromContext(c)
@@ -197,23 +242,3 @@ func (h *ApprovalHandler) GetFlowProgress(c *gin.Context) {
 		return progress, nil
 	})
 }
-
-// Private helpers
-
-func handleApprovalError(c *gin.Context, err error) {
-	msg := err.Error()
-	if msg == "approval work item not found" {
-		RespondAppError(c, appErrors.NewNotFoundError("Approval Work Item", "unknown"))
-		return
-	}
-	if msg == "work item is not pending" {
-		RespondAppError(c, appErrors.NewValidationError("status", msg))
-		return
-	}
-	if msg == "you are not authorized to approve this item" ||
-		msg == "you are not authorized to reject this item" {
-		RespondAppError(c, appErrors.NewPermissionError("approve/reject", "Work Item"))
-		return
-	}
-	RespondAppError(c, appErrors.NewInternalError(msg, err))
-}
@@ -13,6 +13,7 @@ import (
 	"github.com/nexuscrm/backend/internal/application/services"
 	"github.com/nexuscrm/backend/internal/interfaces/rest"
 	"github.com/nexuscrm/backend/pkg/auth"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
 	"github.com/nexuscrm/shared/pkg/models"
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,19 @@ func (m *MockApprovalService) Reject(ctx context.Context, workItemID, comments s
 	return args.Error(0)
 }
 
+func (m *MockApprovalService) Recall(ctx context.Context, workItemID, comments string, user *models.UserSession) error {
+	args := m.Called(ctx, workItemID, comments, user)
+	return args.Error(0)
+}
+
+func (m *MockApprovalService) Reassign(ctx context.Context, workItemID, approverID, comments string, user *models.UserSession) (models.SObject, error) {
+	args := m.Called(ctx, workItemID, approverID, comments, user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(models.SObject), args.Error(1)
+}
+
 func (m *MockApprovalService) GetPending(ctx context.Context, user *models.UserSession) ([]models.SObject, error) {
 	args := m.Called(ctx, user)
 	if args.Get(0) == nil {
@@ -140,7 +154,7 @@ func TestApprovalHandler_Submit(t *testing.T) {
 		serviceReq := services.SubmitRequest{ObjectAPIName: "Account", RecordID: "rec1"}
 
 		// Simulate permission error
-		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, errors.New("you don't have permission to submit this record for approval")).Once()
+		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, appErrors.NewPermissionError("submit for approval", "Account")).Once()
 
 		handler.Submit(c)
 
@@ -161,7 +175,7 @@ func TestApprovalHandler_Submit(t *testing.T) {
 
 		serviceReq := services.SubmitRequest{ObjectAPIName: "Custom", RecordID: "rec1"}
 
-		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, errors.New("no active approval process found for this object")).Once()
+		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, appErrors.NewValidationError(constants.FieldObjectAPIName, "no active approval process found for this object")).Once()
 
 		handler.Submit(c)
 
@@ -182,7 +196,7 @@ func TestApprovalHandler_Submit(t *testing.T) {
 
 		serviceReq := services.SubmitRequest{ObjectAPIName: "Ticket", RecordID: "rec1"}
 
-		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, errors.New("record already has a pending approval")).Once()
+		mockService.On("Submit", mock.Anything, serviceReq, mock.Anything).Return(nil, appErrors.NewValidationError(constants.FieldRecordID, "record already has a pending approval")).Once()
 
 		handler.Submit(c)
 
@@ -211,3 +225,88 @@ func TestApprovalHandler_Submit(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestApprovalHandler_Recall(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := new(MockApprovalService)
+	handler := rest.NewApprovalHandler(mockService)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set(constants.ContextKeyUser, auth.UserSession{ID: "user1"})
+		c.Params = gin.Params{{Key: "workItemId", Value: "wi1"}}
+
+		jsonBytes, _ := json.Marshal(rest.ApprovalActionRequest{Comments: "Submitted too early"})
+		c.Request = httptest.NewRequest("POST", "/approvals/wi1/recall", bytes.NewBuffer(jsonBytes))
+
+		mockService.On("Recall", mock.Anything, "wi1", "Submitted too early", mock.Anything).Return(nil).Once()
+
+		handler.Recall(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Not The Submitter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set(constants.ContextKeyUser, auth.UserSession{ID: "user2"})
+		c.Params = gin.Params{{Key: "workItemId", Value: "wi1"}}
+		c.Request = httptest.NewRequest("POST", "/approvals/wi1/recall", nil)
+
+		mockService.On("Recall", mock.Anything, "wi1", "", mock.Anything).Return(appErrors.NewPermissionError("recall", "Approval Work Item")).Once()
+
+		handler.Recall(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestApprovalHandler_Reassign(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := new(MockApprovalService)
+	handler := rest.NewApprovalHandler(mockService)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set(constants.ContextKeyUser, auth.UserSession{ID: "approver1"})
+		c.Params = gin.Params{{Key: "workItemId", Value: "wi1"}}
+
+		jsonBytes, _ := json.Marshal(rest.ReassignRequest{ApproverID: "approver2", Comments: "On leave this week"})
+		c.Request = httptest.NewRequest("POST", "/approvals/wi1/reassign", bytes.NewBuffer(jsonBytes))
+
+		mockService.On("Reassign", mock.Anything, "wi1", "approver2", "On leave this week", mock.Anything).
+			Return(models.SObject{constants.FieldID: "wi2"}, nil).Once()
+
+		handler.Reassign(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "wi2", resp["data"]["work_item_id"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Missing Approver", func(t *testing.T) {
+		mockService := new(MockApprovalService)
+		handler := rest.NewApprovalHandler(mockService)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set(constants.ContextKeyUser, auth.UserSession{ID: "approver1"})
+		c.Params = gin.Params{{Key: "workItemId", Value: "wi1"}}
+
+		jsonBytes, _ := json.Marshal(map[string]string{"comments": "no approver"})
+		c.Request = httptest.NewRequest("POST", "/approvals/wi1/reassign", bytes.NewBuffer(jsonBytes))
+
+		handler.Reassign(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type ArchiveHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewArchiveHandler(svc *services.ServiceManager) *ArchiveHandler {
+	return &ArchiveHandler{svc: svc}
+}
+
+// GetPolicies handles GET /api/metadata/archive-policies
+func (h *ArchiveHandler) GetPolicies(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Archive.GetPolicies(c.Request.Context())
+	})
+}
+
+// GetPolicy handles GET /api/metadata/archive-policies/:objectApiName
+func (h *ArchiveHandler) GetPolicy(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Archive.GetPolicy(c.Request.Context(), c.Param("objectApiName"))
+	})
+}
+
+// SavePolicy handles PUT /api/metadata/archive-policies/:objectApiName
+func (h *ArchiveHandler) SavePolicy(c *gin.Context) {
+	var policy models.SystemArchivePolicy
+	if !BindJSON(c, &policy) {
+		return
+	}
+	saved, err := h.svc.Archive.SavePolicy(c.Request.Context(), c.Param("objectApiName"), &policy)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Archive policy saved successfully",
+		"data":                 saved,
+	})
+}
+
+// DeletePolicy handles DELETE /api/metadata/archive-policies/:objectApiName
+func (h *ArchiveHandler) DeletePolicy(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Archive policy deleted successfully", func() error {
+		return h.svc.Archive.DeletePolicy(c.Request.Context(), c.Param("objectApiName"))
+	})
+}
+
+// RunPolicy handles POST /api/metadata/archive-policies/:objectApiName/run
+func (h *ArchiveHandler) RunPolicy(c *gin.Context) {
+	policy, err := h.svc.Archive.RunPolicy(c.Request.Context(), c.Param("objectApiName"))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Archive run completed",
+		"data":                 policy,
+	})
+}
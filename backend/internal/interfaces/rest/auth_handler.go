@@ -1,19 +1,22 @@
 package rest
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/interfaces/middleware"
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
 )
 
 type AuthHandler struct {
-	svcMgr *services.ServiceManager
+	svcMgr  *services.ServiceManager
+	cookies middleware.SessionCookies
 }
 
 func NewAuthHandler(svcMgr *services.ServiceManager) *AuthHandler {
@@ -22,6 +25,11 @@ func NewAuthHandler(svcMgr *services.ServiceManager) *AuthHandler {
 	}
 }
 
+// SetSessionCookies makes login and logout issue and clear session cookies as configured
+func (h *AuthHandler) SetSessionCookies(cookies middleware.SessionCookies) {
+	h.cookies = cookies
+}
+
 // LoginRequest represents login request body
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required"`
@@ -40,6 +48,15 @@ type LoginResponse struct {
 
 // Login handles POST /api/auth/login
 func (h *AuthHandler) Login(c *gin.Context) {
+	h.login(c, h.svcMgr.Auth.Login, middleware.SessionCookieName)
+}
+
+// PortalLogin handles POST /api/portal/auth/login
+func (h *AuthHandler) PortalLogin(c *gin.Context) {
+	h.login(c, h.svcMgr.Auth.PortalLogin, middleware.PortalSessionCookieName)
+}
+
+func (h *AuthHandler) login(c *gin.Context, login func(ctx context.Context, email, password, ip, userAgent string) (*services.LoginResult, error), cookieName string) {
 	var req LoginRequest
 	if !BindJSON(c, &req) {
 		return
@@ -53,7 +70,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Delegate to AuthService
-	result, err := h.svcMgr.Auth.Login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
+	result, err := login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		RespondAppError(c, err)
 		return
@@ -66,6 +83,12 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		constants.FieldEmail:     result.User.Email,
 		constants.FieldProfileID: result.User.ProfileId,
 	}
+	if result.User.UserType != "" {
+		userData[constants.FieldSysUser_UserType] = result.User.UserType
+	}
+	if result.User.Locale != "" {
+		userData[constants.FieldSysUser_Locale] = result.User.Locale
+	}
 
 	// Always include roleId for consistent API contract (value or null)
 	if result.User.RoleId != nil {
@@ -74,6 +97,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		userData[constants.FieldRoleID] = nil
 	}
 
+	h.cookies.Issue(c, cookieName, result.Token, result.ExpiresAt)
 	c.JSON(http.StatusOK, LoginResponse{
 		Success:   true,
 		Token:     result.Token,
@@ -84,6 +108,15 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 // Logout handles POST /api/auth/logout
 func (h *AuthHandler) Logout(c *gin.Context) {
+	h.logout(c, middleware.SessionCookieName)
+}
+
+// PortalLogout handles POST /api/portal/auth/logout
+func (h *AuthHandler) PortalLogout(c *gin.Context) {
+	h.logout(c, middleware.PortalSessionCookieName)
+}
+
+func (h *AuthHandler) logout(c *gin.Context, cookieName string) {
 	// Get token from context (set by auth middleware)
 	tokenString, exists := c.Get(constants.ContextKeyToken)
 	if !exists {
@@ -92,10 +125,18 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	}
 
 	HandleDeleteEnvelope(c, "Logged out successfully", func() error {
+		h.cookies.Clear(c, cookieName)
 		return h.svcMgr.Auth.Logout(c.Request.Context(), tokenString.(string))
 	})
 }
 
+// GetJWKS handles GET /.well-known/jwks.json, publishing the public keys tokens are signed
+// with. It is empty while tokens are signed with an HS256 secret.
+func (h *AuthHandler) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, auth.JWKS())
+}
+
 // GetMe handles GET /api/auth/me
 func (h *AuthHandler) GetMe(c *gin.Context) {
 	// Get user from context (set by auth middleware)
@@ -109,11 +150,12 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
 		return gin.H{
-			constants.FieldID:        user.ID,
-			constants.FieldName:      user.Name,
-			constants.FieldEmail:     user.Email,
-			constants.FieldProfileID: user.ProfileId,
-			constants.FieldRoleID:    user.RoleId,
+			constants.FieldID:             user.ID,
+			constants.FieldName:           user.Name,
+			constants.FieldEmail:          user.Email,
+			constants.FieldProfileID:      user.ProfileId,
+			constants.FieldRoleID:         user.RoleId,
+			constants.FieldSysUser_Locale: user.Locale,
 		}, nil
 	})
 }
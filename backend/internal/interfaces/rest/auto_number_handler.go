@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+type AutoNumberHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewAutoNumberHandler(svc *services.ServiceManager) *AutoNumberHandler {
+	return &AutoNumberHandler{svc: svc}
+}
+
+// GetAutoNumber handles GET /api/metadata/objects/:apiName/fields/:fieldApiName/auto-number
+func (h *AutoNumberHandler) GetAutoNumber(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetAutoNumber(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"))
+	})
+}
+
+// UpdateAutoNumber handles PATCH /api/metadata/objects/:apiName/fields/:fieldApiName/auto-number
+func (h *AutoNumberHandler) UpdateAutoNumber(c *gin.Context) {
+	var req services.AutoNumberSettings
+	if !BindJSON(c, &req) {
+		return
+	}
+	an, err := h.svc.Metadata.UpdateAutoNumberSettings(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Auto number updated successfully",
+		"data":                 an,
+	})
+}
+
+// BackfillAutoNumber handles POST /api/metadata/objects/:apiName/fields/:fieldApiName/auto-number/backfill
+func (h *AutoNumberHandler) BackfillAutoNumber(c *gin.Context) {
+	n, err := h.svc.Metadata.BackfillAutoNumber(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Records numbered successfully",
+		"data":                 gin.H{"updated_count": n},
+	})
+}
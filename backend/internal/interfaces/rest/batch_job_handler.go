@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type BatchJobHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewBatchJobHandler(svc *services.ServiceManager) *BatchJobHandler {
+	return &BatchJobHandler{svc: svc}
+}
+
+// GetBatchJobs handles GET /api/metadata/batch-jobs
+func (h *BatchJobHandler) GetBatchJobs(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.BatchJobs.GetBatchJobs(c.Request.Context())
+	})
+}
+
+// GetBatchJob handles GET /api/metadata/batch-jobs/:name
+func (h *BatchJobHandler) GetBatchJob(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.BatchJobs.GetBatchJob(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateBatchJob handles POST /api/metadata/batch-jobs
+func (h *BatchJobHandler) CreateBatchJob(c *gin.Context) {
+	var batchJob models.BatchJob
+	HandleCreateEnvelope(c, "data", "Batch job created successfully", &batchJob, func() error {
+		return h.svc.BatchJobs.CreateBatchJob(c.Request.Context(), &batchJob, GetUserFromContext(c))
+	})
+}
+
+// UpdateBatchJob handles PUT /api/metadata/batch-jobs/:name
+func (h *BatchJobHandler) UpdateBatchJob(c *gin.Context) {
+	var batchJob models.BatchJob
+	if !BindJSON(c, &batchJob) {
+		return
+	}
+	updated, err := h.svc.BatchJobs.UpdateBatchJob(c.Request.Context(), c.Param("name"), &batchJob)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Batch job updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeleteBatchJob handles DELETE /api/metadata/batch-jobs/:name
+func (h *BatchJobHandler) DeleteBatchJob(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Batch job deleted successfully", func() error {
+		return h.svc.BatchJobs.DeleteBatchJob(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// RunBatchJob handles POST /api/metadata/batch-jobs/:name/run and queues a run of the batch job
+func (h *BatchJobHandler) RunBatchJob(c *gin.Context) {
+	job, err := h.svc.BatchJobs.Run(c.Request.Context(), c.Param("name"), GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Batch job queued",
+		"data":                 job,
+	})
+}
+
+// AbortBatchJob handles POST /api/metadata/batch-jobs/:name/abort
+func (h *BatchJobHandler) AbortBatchJob(c *gin.Context) {
+	job, err := h.svc.BatchJobs.Abort(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Batch job aborted",
+		"data":                 job,
+	})
+}
+
+// AbortAsyncJob handles POST /api/metadata/async-jobs/:id/abort
+func (h *BatchJobHandler) AbortAsyncJob(c *gin.Context) {
+	job, err := h.svc.AsyncJobs.Abort(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Job aborted",
+		"data":                 job,
+	})
+}
@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type CampaignHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewCampaignHandler(svc *services.ServiceManager) *CampaignHandler {
+	return &CampaignHandler{svc: svc}
+}
+
+// ListCampaigns handles GET /api/campaigns
+func (h *CampaignHandler) ListCampaigns(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Campaigns.List(c.Request.Context())
+	})
+}
+
+// GetCampaign handles GET /api/campaigns/:id
+func (h *CampaignHandler) GetCampaign(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Campaigns.Get(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// CreateCampaign handles POST /api/campaigns
+func (h *CampaignHandler) CreateCampaign(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var campaign models.SystemCampaign
+	HandleCreateEnvelope(c, "data", "Campaign created successfully", &campaign, func() error {
+		return h.svc.Campaigns.Create(c.Request.Context(), &campaign, user)
+	})
+}
+
+// UpdateCampaign handles PUT /api/campaigns/:id
+func (h *CampaignHandler) UpdateCampaign(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemCampaign
+	HandleUpdateEnvelope(c, "data", "Campaign updated successfully", &updates, func() error {
+		return h.svc.Campaigns.Update(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteCampaign handles DELETE /api/campaigns/:id
+func (h *CampaignHandler) DeleteCampaign(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Campaign deleted successfully", func() error {
+		return h.svc.Campaigns.Delete(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// RecalculateCampaign handles POST /api/campaigns/:id/recalculate
+func (h *CampaignHandler) RecalculateCampaign(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Campaigns.Recalculate(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ListMembers handles GET /api/campaigns/:id/members?limit=200&offset=0
+func (h *CampaignHandler) ListMembers(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Campaigns.ListMembers(c.Request.Context(), c.Param("id"), limit, offset, user)
+	})
+}
+
+// AddMembers handles POST /api/campaigns/:id/members
+func (h *CampaignHandler) AddMembers(c *gin.Context) {
+	var input models.AddCampaignMembersInput
+	if !BindJSONStrict(c, &input) {
+		return
+	}
+	result, err := h.svc.Campaigns.AddMembers(c.Request.Context(), c.Param("id"), input, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Campaign members added successfully",
+		"data":                 result,
+	})
+}
+
+// UpdateMember handles PATCH /api/campaigns/members/:memberId
+func (h *CampaignHandler) UpdateMember(c *gin.Context) {
+	var input struct {
+		Status string `json:"status"`
+	}
+	if !BindJSONStrict(c, &input) {
+		return
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Campaigns.UpdateMemberStatus(c.Request.Context(), c.Param("memberId"), input.Status, GetUserFromContext(c))
+	})
+}
+
+// RemoveMember handles DELETE /api/campaigns/members/:memberId
+func (h *CampaignHandler) RemoveMember(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Campaign member removed successfully", func() error {
+		return h.svc.Campaigns.RemoveMember(c.Request.Context(), c.Param("memberId"), GetUserFromContext(c))
+	})
+}
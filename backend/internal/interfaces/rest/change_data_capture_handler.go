@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// debeziumServerName is the logical server name of the stream, prefixing Debezium topic names
+const debeziumServerName = "nexuscrm"
+
+type ChangeDataCaptureHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewChangeDataCaptureHandler(svc *services.ServiceManager) *ChangeDataCaptureHandler {
+	return &ChangeDataCaptureHandler{svc: svc}
+}
+
+// GetChangeEvents handles GET /api/admin/cdc/events?from=&objects=&limit=&format=
+func (h *ChangeDataCaptureHandler) GetChangeEvents(c *gin.Context) {
+	var from int64
+	if raw := c.Query("from"); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			RespondAppError(c, appErrors.NewValidationError("from", "must be a stream position"))
+			return
+		}
+		from = n
+	}
+	limit, ok := parseChangeEventLimit(c)
+	if !ok {
+		return
+	}
+	page, err := h.svc.ChangeCapture.Read(c.Request.Context(), from, parseChangeEventObjects(c), limit)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	respondChangeEvents(c, page)
+}
+
+// GetConsumerChangeEvents handles GET /api/admin/cdc/consumers/:consumer/events?objects=&limit=&format=
+func (h *ChangeDataCaptureHandler) GetConsumerChangeEvents(c *gin.Context) {
+	limit, ok := parseChangeEventLimit(c)
+	if !ok {
+		return
+	}
+	page, err := h.svc.ChangeCapture.ReadForConsumer(c.Request.Context(), c.Param("consumer"), parseChangeEventObjects(c), limit)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	respondChangeEvents(c, page)
+}
+
+// GetConsumers handles GET /api/admin/cdc/consumers
+func (h *ChangeDataCaptureHandler) GetConsumers(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.ChangeCapture.GetOffsets(c.Request.Context())
+	})
+}
+
+// CommitOffset handles PUT /api/admin/cdc/consumers/:consumer/offset
+func (h *ChangeDataCaptureHandler) CommitOffset(c *gin.Context) {
+	var req struct {
+		Position *int64 `json:"position"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	if req.Position == nil {
+		RespondAppError(c, appErrors.NewValidationError("position", "Position is required"))
+		return
+	}
+	offset, err := h.svc.ChangeCapture.CommitOffset(c.Request.Context(), c.Param("consumer"), *req.Position)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Offset committed successfully",
+		"data":                 offset,
+	})
+}
+
+// DeleteConsumer handles DELETE /api/admin/cdc/consumers/:consumer
+func (h *ChangeDataCaptureHandler) DeleteConsumer(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Consumer deleted successfully", func() error {
+		return h.svc.ChangeCapture.DeleteOffset(c.Request.Context(), c.Param("consumer"))
+	})
+}
+
+// parseChangeEventObjects reads the comma-separated objects filter
+func parseChangeEventObjects(c *gin.Context) []string {
+	objects := make([]string, 0)
+	for _, o := range strings.Split(c.Query("objects"), ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			objects = append(objects, o)
+		}
+	}
+	return objects
+}
+
+func parseChangeEventLimit(c *gin.Context) (int, bool) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return 0, true
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 {
+		RespondAppError(c, appErrors.NewValidationError("limit", "must be a positive number"))
+		return 0, false
+	}
+	return limit, true
+}
+
+// respondChangeEvents writes a page of events, as Debezium change records when format=debezium
+func respondChangeEvents(c *gin.Context, page *models.ChangeEventPage) {
+	if c.Query("format") != "debezium" {
+		c.JSON(http.StatusOK, gin.H{"data": page})
+		return
+	}
+	records := make([]models.DebeziumRecord, len(page.Events))
+	for i, e := range page.Events {
+		records[i] = e.Debezium(debeziumServerName)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": gin.H{
+		"records":       records,
+		"next_position": page.NextPosition,
+		"has_more":      page.HasMore,
+	}})
+}
@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/charts"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+)
+
+type ChartHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewChartHandler(svc *services.ServiceManager) *ChartHandler {
+	return &ChartHandler{svc: svc}
+}
+
+// GetWidgetImage handles GET /api/metadata/dashboards/:id/widgets/:wid/image?format=png|svg&width=&height=
+func (h *ChartHandler) GetWidgetImage(c *gin.Context) {
+	user := GetUserFromContext(c)
+	opts, ok := parseChartImageOptions(c)
+	if !ok {
+		return
+	}
+	image, err := h.svc.Charts.WidgetImage(c.Request.Context(), c.Param("id"), c.Param("wid"), opts, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	writeChartImage(c, opts.Format, image)
+}
+
+// GetReportChart handles GET /api/metadata/reports/:id/chart?type=bar|line|pie&format=png|svg&width=&height=
+func (h *ChartHandler) GetReportChart(c *gin.Context) {
+	user := GetUserFromContext(c)
+	opts, ok := parseChartImageOptions(c)
+	if !ok {
+		return
+	}
+	image, err := h.svc.Charts.ReportImage(c.Request.Context(), c.Param("id"), opts, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	writeChartImage(c, opts.Format, image)
+}
+
+// parseChartImageOptions reads the image format, chart type and size query parameters
+func parseChartImageOptions(c *gin.Context) (services.ChartImageOptions, bool) {
+	var opts services.ChartImageOptions
+	var err error
+	if opts.Format, err = charts.ParseFormat(c.Query("format")); err != nil {
+		RespondAppError(c, appErrors.NewValidationError("format", err.Error()))
+		return opts, false
+	}
+	if opts.Kind, err = charts.ParseKind(c.Query("type")); err != nil {
+		RespondAppError(c, appErrors.NewValidationError("type", err.Error()))
+		return opts, false
+	}
+	for param, target := range map[string]*int{"width": &opts.Width, "height": &opts.Height} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < charts.MinSize || n > charts.MaxSize {
+			RespondAppError(c, appErrors.NewValidationError(param, fmt.Sprintf("must be between %d and %d pixels", charts.MinSize, charts.MaxSize)))
+			return opts, false
+		}
+		*target = n
+	}
+	return opts, true
+}
+
+func writeChartImage(c *gin.Context, format charts.Format, image []byte) {
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, format.ContentType(), image)
+}
@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type CustomEndpointHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewCustomEndpointHandler(svc *services.ServiceManager) *CustomEndpointHandler {
+	return &CustomEndpointHandler{svc: svc}
+}
+
+// GetCustomEndpoints handles GET /api/metadata/custom-endpoints
+func (h *CustomEndpointHandler) GetCustomEndpoints(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.CustomEndpoints.GetCustomEndpoints(c.Request.Context())
+	})
+}
+
+// GetCustomEndpoint handles GET /api/metadata/custom-endpoints/:name
+func (h *CustomEndpointHandler) GetCustomEndpoint(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.CustomEndpoints.GetCustomEndpoint(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateCustomEndpoint handles POST /api/metadata/custom-endpoints
+func (h *CustomEndpointHandler) CreateCustomEndpoint(c *gin.Context) {
+	var endpoint models.CustomEndpoint
+	HandleCreateEnvelope(c, "data", "Custom endpoint created successfully", &endpoint, func() error {
+		return h.svc.CustomEndpoints.CreateCustomEndpoint(c.Request.Context(), &endpoint, GetUserFromContext(c))
+	})
+}
+
+// UpdateCustomEndpoint handles PUT /api/metadata/custom-endpoints/:name
+func (h *CustomEndpointHandler) UpdateCustomEndpoint(c *gin.Context) {
+	var endpoint models.CustomEndpoint
+	if !BindJSON(c, &endpoint) {
+		return
+	}
+	updated, err := h.svc.CustomEndpoints.UpdateCustomEndpoint(c.Request.Context(), c.Param("name"), &endpoint)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Custom endpoint updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeleteCustomEndpoint handles DELETE /api/metadata/custom-endpoints/:name
+func (h *CustomEndpointHandler) DeleteCustomEndpoint(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Custom endpoint deleted successfully", func() error {
+		return h.svc.CustomEndpoints.DeleteCustomEndpoint(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// Call handles requests to /api/custom/:name with any of the methods an endpoint may answer
+func (h *CustomEndpointHandler) Call(c *gin.Context) {
+	call := services.CustomEndpointCall{Method: c.Request.Method, Query: c.Request.URL.Query()}
+	if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodDelete {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			if tooLarge := bodyTooLarge(err); tooLarge != nil {
+				RespondAppError(c, tooLarge)
+				return
+			}
+			RespondAppError(c, err)
+			return
+		}
+		call.Body = body
+	}
+
+	result, err := h.svc.CustomEndpoints.Call(c.Request.Context(), c.Param("name"), call, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
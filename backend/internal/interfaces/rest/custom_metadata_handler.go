@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type CustomMetadataHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewCustomMetadataHandler(svc *services.ServiceManager) *CustomMetadataHandler {
+	return &CustomMetadataHandler{svc: svc}
+}
+
+// GetTypes handles GET /api/metadata/custom-metadata-types
+func (h *CustomMetadataHandler) GetTypes(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetCustomMetadataTypes(c.Request.Context())
+	})
+}
+
+// GetType handles GET /api/metadata/custom-metadata-types/:typeName
+func (h *CustomMetadataHandler) GetType(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetCustomMetadataType(c.Request.Context(), c.Param("typeName"))
+	})
+}
+
+// CreateType handles POST /api/metadata/custom-metadata-types
+func (h *CustomMetadataHandler) CreateType(c *gin.Context) {
+	var t models.CustomMetadataType
+	HandleCreateEnvelope(c, "data", "Custom metadata type created successfully", &t, func() error {
+		return h.svc.Metadata.CreateCustomMetadataType(c.Request.Context(), &t)
+	})
+}
+
+// UpdateType handles PATCH /api/metadata/custom-metadata-types/:typeName
+func (h *CustomMetadataHandler) UpdateType(c *gin.Context) {
+	var updates models.CustomMetadataType
+	if !BindJSON(c, &updates) {
+		return
+	}
+	t, err := h.svc.Metadata.UpdateCustomMetadataType(c.Request.Context(), c.Param("typeName"), &updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Custom metadata type updated successfully",
+		"data":                 t,
+	})
+}
+
+// DeleteType handles DELETE /api/metadata/custom-metadata-types/:typeName
+func (h *CustomMetadataHandler) DeleteType(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Custom metadata type deleted successfully", func() error {
+		return h.svc.Metadata.DeleteCustomMetadataType(c.Request.Context(), c.Param("typeName"))
+	})
+}
+
+// GetRecords handles GET /api/metadata/custom-metadata-types/:typeName/records
+func (h *CustomMetadataHandler) GetRecords(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetCustomMetadataRecords(c.Request.Context(), c.Param("typeName"))
+	})
+}
+
+// GetRecord handles GET /api/metadata/custom-metadata-types/:typeName/records/:developerName
+func (h *CustomMetadataHandler) GetRecord(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetCustomMetadataRecord(c.Request.Context(), c.Param("typeName"), c.Param("developerName"))
+	})
+}
+
+// CreateRecord handles POST /api/metadata/custom-metadata-types/:typeName/records
+func (h *CustomMetadataHandler) CreateRecord(c *gin.Context) {
+	var rec models.CustomMetadataRecord
+	HandleCreateEnvelope(c, "data", "Custom metadata record created successfully", &rec, func() error {
+		return h.svc.Metadata.CreateCustomMetadataRecord(c.Request.Context(), c.Param("typeName"), &rec)
+	})
+}
+
+// UpdateRecord handles PATCH /api/metadata/custom-metadata-types/:typeName/records/:developerName
+func (h *CustomMetadataHandler) UpdateRecord(c *gin.Context) {
+	var updates models.CustomMetadataRecord
+	if !BindJSON(c, &updates) {
+		return
+	}
+	rec, err := h.svc.Metadata.UpdateCustomMetadataRecord(c.Request.Context(), c.Param("typeName"), c.Param("developerName"), &updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Custom metadata record updated successfully",
+		"data":                 rec,
+	})
+}
+
+// DeleteRecord handles DELETE /api/metadata/custom-metadata-types/:typeName/records/:developerName
+func (h *CustomMetadataHandler) DeleteRecord(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Custom metadata record deleted successfully", func() error {
+		return h.svc.Metadata.DeleteCustomMetadataRecord(c.Request.Context(), c.Param("typeName"), c.Param("developerName"))
+	})
+}
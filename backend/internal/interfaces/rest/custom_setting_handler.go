@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type CustomSettingHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewCustomSettingHandler(svc *services.ServiceManager) *CustomSettingHandler {
+	return &CustomSettingHandler{svc: svc}
+}
+
+// GetSettings handles GET /api/metadata/custom-settings
+func (h *CustomSettingHandler) GetSettings(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Settings.GetSettings(c.Request.Context())
+	})
+}
+
+// GetResolved handles GET /api/metadata/custom-settings/resolved
+// Returns every setting resolved for the current user. System admins may pass user_id and
+// profile_id to preview the values another user would see.
+func (h *CustomSettingHandler) GetResolved(c *gin.Context) {
+	user := GetUserFromContext(c)
+	resolved := h.svc.Settings.ResolveForUser(user)
+	if user != nil && user.IsSystemAdmin && (c.Query("user_id") != "" || c.Query("profile_id") != "") {
+		resolved = h.svc.Settings.Resolve(c.Query("user_id"), c.Query("profile_id"))
+	}
+	c.JSON(http.StatusOK, gin.H{"data": resolved})
+}
+
+// GetSetting handles GET /api/metadata/custom-settings/:name
+func (h *CustomSettingHandler) GetSetting(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Settings.GetSetting(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateSetting handles POST /api/metadata/custom-settings
+func (h *CustomSettingHandler) CreateSetting(c *gin.Context) {
+	var setting models.CustomSetting
+	HandleCreateEnvelope(c, "data", "Custom setting created successfully", &setting, func() error {
+		return h.svc.Settings.CreateSetting(c.Request.Context(), &setting)
+	})
+}
+
+// UpdateSetting handles PATCH /api/metadata/custom-settings/:name
+func (h *CustomSettingHandler) UpdateSetting(c *gin.Context) {
+	var updates models.CustomSetting
+	if !BindJSON(c, &updates) {
+		return
+	}
+	setting, err := h.svc.Settings.UpdateSetting(c.Request.Context(), c.Param("name"), &updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Custom setting updated successfully",
+		"data":                 setting,
+	})
+}
+
+// DeleteSetting handles DELETE /api/metadata/custom-settings/:name
+func (h *CustomSettingHandler) DeleteSetting(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Custom setting deleted successfully", func() error {
+		return h.svc.Settings.DeleteSetting(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// GetOverrides handles GET /api/metadata/custom-settings/:name/values
+func (h *CustomSettingHandler) GetOverrides(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Settings.GetOverrides(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// SetOverride handles PUT /api/metadata/custom-settings/:name/values
+func (h *CustomSettingHandler) SetOverride(c *gin.Context) {
+	var req models.CustomSettingValue
+	if !BindJSON(c, &req) {
+		return
+	}
+	override, err := h.svc.Settings.SetOverride(c.Request.Context(), c.Param("name"), req.ScopeType, req.ScopeID, req.Value)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Custom setting value saved successfully",
+		"data":                 override,
+	})
+}
+
+// DeleteOverride handles DELETE /api/metadata/custom-settings/:name/values/:scope/:scopeId
+func (h *CustomSettingHandler) DeleteOverride(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Custom setting value deleted successfully", func() error {
+		return h.svc.Settings.DeleteOverride(c.Request.Context(), c.Param("name"),
+			constants.CustomSettingScope(c.Param("scope")), c.Param("scopeId"))
+	})
+}
@@ -1,8 +1,11 @@
 package rest
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -12,6 +15,9 @@ import (
 	"github.com/nexuscrm/shared/pkg/models"
 )
 
+// maxBulkSize caps the records accepted by one bulk request
+const maxBulkSize = 1000
+
 type DataHandler struct {
 	svc *services.ServiceManager
 }
@@ -37,13 +43,35 @@ func (h *DataHandler) Query(c *gin.Context) {
 		req.SortField = req.OrderBy[0].Field
 		req.SortDirection = req.OrderBy[0].Direction
 	}
+	// Archived rows can also be requested as ?includeArchived=true
+	if c.Query("includeArchived") == "true" {
+		req.IncludeArchived = true
+	}
 
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.QuerySvc.Query(
+		records, err := h.svc.QuerySvc.Query(
 			c.Request.Context(),
 			req,
 			user,
 		)
+		if err == nil && req.ForView {
+			h.svc.Recent.TrackViewsAsync(req.ObjectAPIName, records, user)
+		}
+		return records, err
+	})
+}
+
+// NaturalLanguageQuery handles POST /api/data/nlq, answering a question with the
+// validated query it was translated into and that query's results
+func (h *DataHandler) NaturalLanguageQuery(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var req models.NLQRequest
+	if !BindJSONStrict(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.NLQ.Ask(c.Request.Context(), req, user)
 	})
 }
 
@@ -51,7 +79,8 @@ func (h *DataHandler) Query(c *gin.Context) {
 func (h *DataHandler) Search(c *gin.Context) {
 	user := GetUserFromContext(c)
 	var req struct {
-		Term string `json:"term" binding:"required"`
+		Term    string   `json:"term" binding:"required"`
+		Objects []string `json:"objects,omitempty"` // Optional scope (full-text engine only)
 	}
 
 	if !BindJSON(c, &req) {
@@ -59,7 +88,21 @@ func (h *DataHandler) Search(c *gin.Context) {
 	}
 
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.QuerySvc.GlobalSearch(c.Request.Context(), req.Term, user)
+		return h.svc.Search.GlobalSearch(c.Request.Context(), req.Term, req.Objects, user)
+	})
+}
+
+// SemanticSearch handles POST /api/data/semantic-search, ranking readable records by
+// similarity in meaning to the search term
+func (h *DataHandler) SemanticSearch(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var req models.SemanticSearchRequest
+	if !BindJSONStrict(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Semantic.Search(c.Request.Context(), req, user)
 	})
 }
 
@@ -77,6 +120,18 @@ func (h *DataHandler) SearchSingleObject(c *gin.Context) {
 	})
 }
 
+// GetRecentItems handles GET /api/data/recent
+// Optional query params: object (restrict to one object), limit (items per object)
+func (h *DataHandler) GetRecentItems(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectName := strings.ToLower(c.Query("object"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Recent.GetRecent(c.Request.Context(), objectName, limit, user)
+	})
+}
+
 // GetRecycleBinItems handles GET /api/data/recyclebin/items
 func (h *DataHandler) GetRecycleBinItems(c *gin.Context) {
 	user := GetUserFromContext(c)
@@ -150,6 +205,7 @@ func (h *DataHandler) GetRecord(c *gin.Context) {
 			}
 		}
 
+		h.svc.Recent.TrackViewsAsync(objectApiName, []models.SObject{record}, user)
 		return record, nil
 	})
 }
@@ -167,7 +223,11 @@ func (h *DataHandler) CreateRecord(c *gin.Context) {
 	// We need to capture the created record to return it
 	HandleCreateEnvelope(c, "data", "Record created successfully", &data, func() error {
 		// Data is already bound by HandleCreateEnvelope
-		record, err := h.svc.Persistence.Insert(c.Request.Context(), objectApiName, data, user)
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return err
+		}
+		record, err := h.svc.Persistence.Insert(ctx, objectApiName, data, user)
 		if err != nil {
 			return err
 		}
@@ -185,8 +245,61 @@ func (h *DataHandler) UpdateRecord(c *gin.Context) {
 
 	updates := make(models.SObject)
 
+	// Dry runs return what the update would change without saving it
+	if c.Query("dryRun") == "true" {
+		if !BindJSON(c, &updates) {
+			return
+		}
+		HandleGetEnvelope(c, "data", func() (interface{}, error) {
+			ctx, err := h.writeContext(c, user)
+			if err != nil {
+				return nil, err
+			}
+			return h.svc.Persistence.PreviewUpdate(ctx, objectApiName, id, updates, user)
+		})
+		return
+	}
+
 	HandleUpdateEnvelope(c, "", "Record updated successfully", &updates, func() error {
-		return h.svc.Persistence.Update(c.Request.Context(), objectApiName, id, updates, user)
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return err
+		}
+		return h.svc.Persistence.Update(ctx, objectApiName, id, updates, user)
+	})
+}
+
+// UpdateField handles PATCH /api/data/:objectApiName/:id/fields/:fieldApiName, writing a
+// single field for inline editing. With ?dryRun=true it returns the update preview instead.
+func (h *DataHandler) UpdateField(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+	id := c.Param("id")
+	fieldApiName := strings.ToLower(c.Param("fieldApiName"))
+
+	var req struct {
+		Value interface{} `json:"value"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return nil, err
+		}
+		updates, err := h.svc.Persistence.FieldUpdate(ctx, objectApiName, fieldApiName, req.Value, user)
+		if err != nil {
+			return nil, err
+		}
+		if c.Query("dryRun") == "true" {
+			return h.svc.Persistence.PreviewUpdate(ctx, objectApiName, id, updates, user)
+		}
+		if err := h.svc.Persistence.Update(ctx, objectApiName, id, updates, user); err != nil {
+			return nil, err
+		}
+		return updates, nil
 	})
 }
 
@@ -197,10 +310,23 @@ func (h *DataHandler) DeleteRecord(c *gin.Context) {
 	id := c.Param("id")
 
 	HandleDeleteEnvelope(c, "Record deleted successfully", func() error {
-		return h.svc.Persistence.Delete(c.Request.Context(), objectApiName, id, user)
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return err
+		}
+		return h.svc.Persistence.Delete(ctx, objectApiName, id, user)
 	})
 }
 
+// writeContext applies the ?skipFlows=true and ?skipValidation=true options of the request;
+// each needs its bypass permission
+func (h *DataHandler) writeContext(c *gin.Context, user *models.UserSession) (context.Context, error) {
+	return h.svc.Persistence.WithWriteOptions(c.Request.Context(), services.WriteOptions{
+		SkipFlows:      c.Query("skipFlows") == "true",
+		SkipValidation: c.Query("skipValidation") == "true",
+	}, user)
+}
+
 // RunAnalytics handles POST /api/data/analytics
 func (h *DataHandler) RunAnalytics(c *gin.Context) {
 	user := GetUserFromContext(c)
@@ -214,7 +340,7 @@ func (h *DataHandler) RunAnalytics(c *gin.Context) {
 	query.ObjectAPIName = strings.ToLower(query.ObjectAPIName)
 
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.QuerySvc.RunAnalytics(c.Request.Context(), query, user)
+		return h.svc.QuerySvc.RunAnalytics(ReadContext(c), query, user)
 	})
 }
 
@@ -233,6 +359,79 @@ func (h *DataHandler) Calculate(c *gin.Context) {
 	})
 }
 
+// Kanban handles POST /api/data/:objectApiName/kanban
+func (h *DataHandler) Kanban(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	var req models.KanbanRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.QuerySvc.Kanban(c.Request.Context(), objectApiName, req, user)
+	})
+}
+
+// MoveKanbanCard handles POST /api/data/:objectApiName/kanban/move
+func (h *DataHandler) MoveKanbanCard(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	var req models.KanbanMoveRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Persistence.MoveKanbanCard(c.Request.Context(), objectApiName, req, user)
+	})
+}
+
+// Calendar handles POST /api/data/:objectApiName/calendar
+func (h *DataHandler) Calendar(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	var req models.CalendarRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.QuerySvc.Calendar(c.Request.Context(), objectApiName, req, user)
+	})
+}
+
+// Lookup handles GET /api/data/:objectApiName/lookup?q=&field=&dependentValues=&limit=
+// where field names the lookup ("object.field") whose filter applies and dependentValues is a
+// JSON object of the edited record's values the filter reads
+func (h *DataHandler) Lookup(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		req := models.LookupRequest{
+			Term:  c.Query("q"),
+			Field: strings.ToLower(c.Query("field")),
+		}
+		if raw := c.Query("dependentValues"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.DependentValues); err != nil {
+				return nil, errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, "dependentValues", "must be a JSON object")
+			}
+		}
+		if raw := c.Query("limit"); raw != "" {
+			limit, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, "limit", "must be a number")
+			}
+			req.Limit = limit
+		}
+		return h.svc.QuerySvc.Lookup(c.Request.Context(), objectApiName, req, user)
+	})
+}
+
 // BulkCreateRecords handles POST /api/data/:objectApiName/bulk
 func (h *DataHandler) BulkCreateRecords(c *gin.Context) {
 	user := GetUserFromContext(c)
@@ -249,15 +448,8 @@ func (h *DataHandler) BulkCreateRecords(c *gin.Context) {
 		return
 	}
 
-	if len(req.Records) == 0 {
-		RespondAppError(c, errors.NewValidationError("records", "At least one record is required"))
-		return
-	}
-
 	// Limit bulk size to prevent overload
-	const maxBulkSize = 1000
-	if len(req.Records) > maxBulkSize {
-		RespondAppError(c, errors.NewValidationError("records", fmt.Sprintf("Maximum %d records per request", maxBulkSize)))
+	if !checkBulkSize(c, "records", len(req.Records)) {
 		return
 	}
 
@@ -267,7 +459,12 @@ func (h *DataHandler) BulkCreateRecords(c *gin.Context) {
 		SkipAutoNumbers: req.SkipAutoNumbers,
 	}
 
-	result, err := h.svc.Persistence.BulkInsert(c.Request.Context(), objectApiName, req.Records, user, options)
+	ctx, err := h.writeContext(c, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	result, err := h.svc.Persistence.BulkInsert(ctx, objectApiName, req.Records, user, options)
 	if err != nil {
 		RespondAppError(c, err)
 		return
@@ -277,3 +474,68 @@ func (h *DataHandler) BulkCreateRecords(c *gin.Context) {
 		"data": result,
 	})
 }
+
+// BulkUpdateRecords handles PATCH /api/data/:objectApiName/bulk
+func (h *DataHandler) BulkUpdateRecords(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	var req struct {
+		Records   []models.SObject `json:"records" binding:"required"`
+		AllOrNone bool             `json:"all_or_none,omitempty"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	if !checkBulkSize(c, "records", len(req.Records)) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return nil, err
+		}
+		return h.svc.Persistence.BulkUpdate(ctx, objectApiName, req.Records, user,
+			services.BulkWriteOptions{AllOrNone: req.AllOrNone})
+	})
+}
+
+// BulkDeleteRecords handles DELETE /api/data/:objectApiName/bulk
+func (h *DataHandler) BulkDeleteRecords(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+
+	var req struct {
+		IDs       []string `json:"ids" binding:"required"`
+		AllOrNone bool     `json:"all_or_none,omitempty"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	if !checkBulkSize(c, "ids", len(req.IDs)) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		ctx, err := h.writeContext(c, user)
+		if err != nil {
+			return nil, err
+		}
+		return h.svc.Persistence.BulkDelete(ctx, objectApiName, req.IDs, user,
+			services.BulkWriteOptions{AllOrNone: req.AllOrNone})
+	})
+}
+
+// checkBulkSize rejects empty and oversized bulk requests
+func checkBulkSize(c *gin.Context, field string, n int) bool {
+	if n == 0 {
+		RespondAppError(c, errors.NewValidationError(field, "At least one record is required"))
+		return false
+	}
+	if n > maxBulkSize {
+		RespondAppError(c, errors.NewValidationError(field, fmt.Sprintf("Maximum %d records per request", maxBulkSize)))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type DataQualityHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewDataQualityHandler(svc *services.ServiceManager) *DataQualityHandler {
+	return &DataQualityHandler{svc: svc}
+}
+
+// GetDashboard handles GET /api/data-quality/dashboard
+func (h *DataQualityHandler) GetDashboard(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.DataQuality.Dashboard(c.Request.Context(), user)
+	})
+}
+
+// GetRecords handles GET /api/data-quality/:objectApiName/records
+// Optional query params: max_quality (0-100), duplicates_only (true/false), limit
+func (h *DataQualityHandler) GetRecords(c *gin.Context) {
+	user := GetUserFromContext(c)
+	maxQuality := 100
+	if v, err := strconv.Atoi(c.Query("max_quality")); err == nil {
+		maxQuality = v
+	}
+	duplicatesOnly := c.Query("duplicates_only") == "true"
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.DataQuality.Records(c.Request.Context(), c.Param("objectApiName"), maxQuality, duplicatesOnly, limit, user)
+	})
+}
+
+// GetRule handles GET /api/data-quality/:objectApiName/rule
+func (h *DataQualityHandler) GetRule(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.DataQuality.GetRule(c.Request.Context(), c.Param("objectApiName"))
+	})
+}
+
+// SaveRule handles PUT /api/data-quality/:objectApiName/rule
+func (h *DataQualityHandler) SaveRule(c *gin.Context) {
+	var rule models.DataQualityRule
+	if !BindJSONStrict(c, &rule) {
+		return
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.DataQuality.SaveRule(c.Request.Context(), c.Param("objectApiName"), rule)
+	})
+}
+
+// Score handles POST /api/data-quality/:objectApiName/score, queueing a scoring job whose
+// progress is polled at /api/metadata/async-jobs/:id
+func (h *DataQualityHandler) Score(c *gin.Context) {
+	job, err := h.svc.DataQuality.Score(c.Request.Context(), c.Param("objectApiName"), GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Data quality scoring queued",
+		"data":                 job,
+	})
+}
@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type DocumentHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewDocumentHandler(svc *services.ServiceManager) *DocumentHandler {
+	return &DocumentHandler{svc: svc}
+}
+
+// GetTemplates handles GET /api/metadata/document-templates?object=quote
+func (h *DocumentHandler) GetTemplates(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Documents.GetTemplates(c.Request.Context(), c.Query("object"))
+	})
+}
+
+// GetTemplate handles GET /api/metadata/document-templates/:id
+func (h *DocumentHandler) GetTemplate(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Documents.GetTemplate(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// CreateTemplate handles POST /api/metadata/document-templates
+func (h *DocumentHandler) CreateTemplate(c *gin.Context) {
+	var template models.SystemDocumentTemplate
+	HandleCreateEnvelope(c, "data", "Document template created successfully", &template, func() error {
+		return h.svc.Documents.CreateTemplate(c.Request.Context(), &template)
+	})
+}
+
+// UpdateTemplate handles PATCH /api/metadata/document-templates/:id
+func (h *DocumentHandler) UpdateTemplate(c *gin.Context) {
+	var updates services.DocumentTemplateUpdate
+	if !BindJSON(c, &updates) {
+		return
+	}
+	template, err := h.svc.Documents.UpdateTemplate(c.Request.Context(), c.Param("id"), updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Document template updated successfully",
+		"data":                 template,
+	})
+}
+
+// DeleteTemplate handles DELETE /api/metadata/document-templates/:id
+func (h *DocumentHandler) DeleteTemplate(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Document template deleted successfully", func() error {
+		return h.svc.Documents.DeleteTemplate(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// Generate handles POST /api/data/:objectApiName/:id/documents/:templateId. The PDF is
+// attached to the record and the created file is returned.
+func (h *DocumentHandler) Generate(c *gin.Context) {
+	user := GetUserFromContext(c)
+	file, err := h.svc.Documents.Generate(c.Request.Context(), c.Param("objectApiName"), c.Param("id"), c.Param("templateId"), user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		constants.FieldMessage: "Document generated successfully",
+		"data":                 file,
+	})
+}
@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type EntitlementHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewEntitlementHandler(svc *services.ServiceManager) *EntitlementHandler {
+	return &EntitlementHandler{svc: svc}
+}
+
+// ListContracts handles GET /api/contracts?account_id=...
+func (h *EntitlementHandler) ListContracts(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Entitlements.ListContracts(c.Request.Context(), c.Query("account_id"))
+	})
+}
+
+// GetContract handles GET /api/contracts/:id
+func (h *EntitlementHandler) GetContract(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Entitlements.GetContract(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// CreateContract handles POST /api/contracts
+func (h *EntitlementHandler) CreateContract(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var contract models.SystemContract
+	HandleCreateEnvelope(c, "data", "Contract created successfully", &contract, func() error {
+		return h.svc.Entitlements.CreateContract(c.Request.Context(), &contract, user)
+	})
+}
+
+// UpdateContract handles PUT /api/contracts/:id
+func (h *EntitlementHandler) UpdateContract(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemContract
+	HandleUpdateEnvelope(c, "data", "Contract updated successfully", &updates, func() error {
+		return h.svc.Entitlements.UpdateContract(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteContract handles DELETE /api/contracts/:id
+func (h *EntitlementHandler) DeleteContract(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Contract deleted successfully", func() error {
+		return h.svc.Entitlements.DeleteContract(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ListEntitlements handles GET /api/entitlements?account_id=...
+func (h *EntitlementHandler) ListEntitlements(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Entitlements.ListEntitlements(c.Request.Context(), c.Query("account_id"))
+	})
+}
+
+// GetEntitlement handles GET /api/entitlements/:id
+func (h *EntitlementHandler) GetEntitlement(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Entitlements.GetEntitlement(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// ListUsage handles GET /api/entitlements/:id/usage
+func (h *EntitlementHandler) ListUsage(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Entitlements.ListUsage(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// CreateEntitlement handles POST /api/entitlements
+func (h *EntitlementHandler) CreateEntitlement(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var entitlement models.SystemEntitlement
+	HandleCreateEnvelope(c, "data", "Entitlement created successfully", &entitlement, func() error {
+		return h.svc.Entitlements.CreateEntitlement(c.Request.Context(), &entitlement, user)
+	})
+}
+
+// UpdateEntitlement handles PUT /api/entitlements/:id
+func (h *EntitlementHandler) UpdateEntitlement(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemEntitlement
+	HandleUpdateEnvelope(c, "data", "Entitlement updated successfully", &updates, func() error {
+		return h.svc.Entitlements.UpdateEntitlement(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteEntitlement handles DELETE /api/entitlements/:id
+func (h *EntitlementHandler) DeleteEntitlement(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Entitlement deleted successfully", func() error {
+		return h.svc.Entitlements.DeleteEntitlement(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
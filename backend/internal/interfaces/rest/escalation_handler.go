@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type EscalationHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewEscalationHandler(svc *services.ServiceManager) *EscalationHandler {
+	return &EscalationHandler{svc: svc}
+}
+
+// GetRules handles GET /api/metadata/escalation-rules
+func (h *EscalationHandler) GetRules(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Escalations.GetRules(c.Request.Context())
+	})
+}
+
+// GetRule handles GET /api/metadata/escalation-rules/:name
+func (h *EscalationHandler) GetRule(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Escalations.GetRule(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateRule handles POST /api/metadata/escalation-rules
+func (h *EscalationHandler) CreateRule(c *gin.Context) {
+	var rule models.EscalationRule
+	HandleCreateEnvelope(c, "data", "Escalation rule created successfully", &rule, func() error {
+		return h.svc.Escalations.CreateRule(c.Request.Context(), &rule)
+	})
+}
+
+// UpdateRule handles PUT /api/metadata/escalation-rules/:name
+func (h *EscalationHandler) UpdateRule(c *gin.Context) {
+	var rule models.EscalationRule
+	if !BindJSON(c, &rule) {
+		return
+	}
+	updated, err := h.svc.Escalations.UpdateRule(c.Request.Context(), c.Param("name"), &rule)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Escalation rule updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeleteRule handles DELETE /api/metadata/escalation-rules/:name
+func (h *EscalationHandler) DeleteRule(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Escalation rule deleted successfully", func() error {
+		return h.svc.Escalations.DeleteRule(c.Request.Context(), c.Param("name"))
+	})
+}
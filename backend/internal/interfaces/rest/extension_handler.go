@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/extension"
+)
+
+// ExtensionsBasePath is where extension routes are served, under /api/ext/<extension>
+const ExtensionsBasePath = "/ext"
+
+type ExtensionHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewExtensionHandler(svc *services.ServiceManager) *ExtensionHandler {
+	return &ExtensionHandler{svc: svc}
+}
+
+// ListExtensions handles GET /api/admin/extensions
+func (h *ExtensionHandler) ListExtensions(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Extensions.List(), nil
+	})
+}
+
+// MountRoutes serves the routes of the installed extensions under api/ext/<extension>.
+// requireAuth guards every route and requireSystemAdmin those for administrators; the
+// signed-in user reaches the handlers through extension.User.
+func (h *ExtensionHandler) MountRoutes(api *gin.RouterGroup, requireAuth, requireSystemAdmin gin.HandlerFunc) int {
+	routes := h.svc.Extensions.Registry().Routes()
+	for _, route := range routes {
+		handlers := []gin.HandlerFunc{requireAuth}
+		if route.AdminOnly {
+			handlers = append(handlers, requireSystemAdmin)
+		}
+		handlers = append(handlers, withExtensionUser, route.Handler)
+		api.Handle(route.Method, ExtensionsBasePath+"/"+route.Extension+route.Path, handlers...)
+	}
+	return len(routes)
+}
+
+// withExtensionUser passes the signed-in user on to an extension route
+func withExtensionUser(c *gin.Context) {
+	c.Request = c.Request.WithContext(extension.WithUser(c.Request.Context(), GetUserFromContext(c)))
+	c.Next()
+}
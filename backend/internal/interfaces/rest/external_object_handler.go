@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type ExternalObjectHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewExternalObjectHandler(svc *services.ServiceManager) *ExternalObjectHandler {
+	return &ExternalObjectHandler{svc: svc}
+}
+
+// GetExternalObjects handles GET /api/metadata/external-objects
+func (h *ExternalObjectHandler) GetExternalObjects(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.External.GetExternalObjects(c.Request.Context())
+	})
+}
+
+// GetExternalObject handles GET /api/metadata/external-objects/:apiName
+func (h *ExternalObjectHandler) GetExternalObject(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.External.GetExternalObject(c.Request.Context(), c.Param("apiName"))
+	})
+}
+
+// CreateExternalObject handles POST /api/metadata/external-objects
+func (h *ExternalObjectHandler) CreateExternalObject(c *gin.Context) {
+	var def models.ExternalObject
+	HandleCreateEnvelope(c, "data", "External object created successfully", &def, func() error {
+		if err := h.svc.External.CreateExternalObject(c.Request.Context(), &def); err != nil {
+			return err
+		}
+
+		// Grant admins read access; external objects are read-only so nothing else applies
+		return h.svc.TxManager.WithTransaction(func(tx *sql.Tx) error {
+			adminProfileID := constants.ProfileSystemAdmin
+			perm := models.SystemObjectPerms{
+				ProfileID:     &adminProfileID,
+				ObjectAPIName: def.Object.APIName,
+				AllowRead:     true,
+				ViewAll:       true,
+			}
+			if err := h.svc.Permissions.UpdateObjectPermissionTx(tx, perm); err != nil {
+				return fmt.Errorf("failed to grant admin permissions: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// UpdateExternalDataSource handles PATCH /api/metadata/external-objects/:apiName/source
+func (h *ExternalObjectHandler) UpdateExternalDataSource(c *gin.Context) {
+	var updates services.ExternalDataSourceUpdate
+	if !BindJSON(c, &updates) {
+		return
+	}
+	src, err := h.svc.External.UpdateExternalDataSource(c.Request.Context(), c.Param("apiName"), updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "External object updated successfully",
+		"data":                 src,
+	})
+}
+
+// DeleteExternalObject handles DELETE /api/metadata/external-objects/:apiName
+func (h *ExternalObjectHandler) DeleteExternalObject(c *gin.Context) {
+	HandleDeleteEnvelope(c, "External object deleted successfully", func() error {
+		return h.svc.External.DeleteExternalObject(c.Request.Context(), c.Param("apiName"))
+	})
+}
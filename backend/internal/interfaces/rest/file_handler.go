@@ -3,9 +3,6 @@ package rest
 import (
 	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
@@ -24,27 +21,24 @@ func NewFileHandler(svcMgr *services.ServiceManager) *FileHandler {
 // Upload handles file uploads
 func (h *FileHandler) Upload(c *gin.Context) {
 	file, err := c.FormFile("file")
+	if tooLarge := bodyTooLarge(err); tooLarge != nil {
+		RespondAppError(c, tooLarge)
+		return
+	}
 	if err != nil {
 		RespondAppError(c, errors.NewValidationError("file", "No file uploaded"))
 		return
 	}
 
-	// Create uploads directory if not exists
-	uploadDir := "uploads"
-	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
-		if err := os.Mkdir(uploadDir, 0755); err != nil {
-			RespondAppError(c, errors.NewInternalError(fmt.Sprintf("Failed to create upload directory: %v", err), err))
-			return
-		}
+	src, err := file.Open()
+	if err != nil {
+		RespondAppError(c, errors.NewInternalError(fmt.Sprintf("Failed to read upload: %v", err), err))
+		return
 	}
+	defer src.Close()
 
-	// Generate safe filename
-	ext := filepath.Ext(file.Filename)
-	filename := fmt.Sprintf("%d-%s%s", time.Now().UnixNano(), "file", ext)
-	path := filepath.Join(uploadDir, filename)
-
-	// Save
-	if err := c.SaveUploadedFile(file, path); err != nil {
+	path, _, err := h.svcMgr.Files.Save(file.Filename, src)
+	if err != nil {
 		RespondAppError(c, errors.NewInternalError(fmt.Sprintf("Failed to save file: %v", err), err))
 		return
 	}
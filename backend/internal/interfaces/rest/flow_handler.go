@@ -3,6 +3,7 @@ package rest
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
@@ -21,9 +22,12 @@ func NewFlowHandler(svc *services.ServiceManager) *FlowHandler {
 	return &FlowHandler{svc: svc}
 }
 
-// GetAllFlows handles GET /api/metadata/flows
+// GetAllFlows handles GET /api/metadata/flows?modifiedSince=&limit=&offset=
 func (h *FlowHandler) GetAllFlows(c *gin.Context) {
-	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+	HandleMetadataList(c, func(f *models.Flow) (string, time.Time) {
+		modified, _ := time.Parse(time.RFC3339, f.LastModified)
+		return f.ID, modified
+	}, func() ([]*models.Flow, error) {
 		return h.svc.Metadata.GetFlows(c.Request.Context()), nil
 	})
 }
@@ -110,7 +114,7 @@ func (h *FlowHandler) ExecuteFlow(c *gin.Context) {
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
 		var req ExecuteFlowRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
-			return nil, errors.NewValidationError("", "Invalid JSON body: "+err.Error())
+			return nil, bindingError(err)
 		}
 
 		// Validate and get flow
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// defaultForecastObject is forecast when the object query param is omitted
+const defaultForecastObject = "opportunity"
+
+type ForecastHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewForecastHandler(svc *services.ServiceManager) *ForecastHandler {
+	return &ForecastHandler{svc: svc}
+}
+
+// forecastObject returns the object query param, defaulting to opportunity
+func forecastObject(c *gin.Context) string {
+	if object := c.Query("object"); object != "" {
+		return object
+	}
+	return defaultForecastObject
+}
+
+// GetForecast handles GET /api/analytics/forecast?period=2026-Q4&object=opportunity
+func (h *ForecastHandler) GetForecast(c *gin.Context) {
+	period := c.Query("period")
+	if period == "" {
+		RespondAppError(c, errors.NewValidationError("period", "period is required (YYYY, YYYY-Qn or YYYY-MM)"))
+		return
+	}
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Forecasts.GetForecast(c.Request.Context(), forecastObject(c), period, user)
+	})
+}
+
+// GetSetting handles GET /api/analytics/forecast/settings/:objectApiName
+func (h *ForecastHandler) GetSetting(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Forecasts.GetSetting(c.Request.Context(), c.Param("objectApiName"))
+	})
+}
+
+// SaveSetting handles PUT /api/analytics/forecast/settings/:objectApiName
+func (h *ForecastHandler) SaveSetting(c *gin.Context) {
+	var setting models.ForecastSetting
+	if !BindJSONStrict(c, &setting) {
+		return
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Forecasts.SaveSetting(c.Request.Context(), c.Param("objectApiName"), setting)
+	})
+}
+
+// GetQuotas handles GET /api/analytics/forecast/quotas?period=2026-Q4&object=opportunity
+func (h *ForecastHandler) GetQuotas(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Forecasts.GetQuotas(c.Request.Context(), forecastObject(c), c.Query("period"))
+	})
+}
+
+// SaveQuotas handles PUT /api/analytics/forecast/quotas
+func (h *ForecastHandler) SaveQuotas(c *gin.Context) {
+	var input models.ForecastQuotaInput
+	if !BindJSONStrict(c, &input) {
+		return
+	}
+	if input.ObjectAPIName == "" {
+		input.ObjectAPIName = defaultForecastObject
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Forecasts.SaveQuotas(c.Request.Context(), input)
+	})
+}
+
+// SaveAdjustment handles POST /api/analytics/forecast/adjustments
+func (h *ForecastHandler) SaveAdjustment(c *gin.Context) {
+	var input models.ForecastAdjustmentInput
+	if !BindJSONStrict(c, &input) {
+		return
+	}
+	if input.ObjectAPIName == "" {
+		input.ObjectAPIName = defaultForecastObject
+	}
+	adjustment, err := h.svc.Forecasts.SaveAdjustment(c.Request.Context(), input, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Forecast adjusted successfully",
+		"data":                 adjustment,
+	})
+}
+
+// DeleteAdjustment handles DELETE /api/analytics/forecast/adjustments/:id
+func (h *ForecastHandler) DeleteAdjustment(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Forecast adjustment deleted successfully", func() error {
+		return h.svc.Forecasts.DeleteAdjustment(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
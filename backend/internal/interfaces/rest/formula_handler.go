@@ -48,8 +48,7 @@ type SubstituteRequest struct {
 // Evaluate handles POST /api/formula/evaluate
 func (h *FormulaHandler) Evaluate(c *gin.Context) {
 	var req EvaluateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		RespondAppError(c, appErrors.NewValidationError("json", err.Error()))
+	if !BindJSON(c, &req) {
 		return
 	}
 
@@ -77,8 +76,7 @@ func (h *FormulaHandler) Evaluate(c *gin.Context) {
 // This is used by the frontend for visibility conditions and validation rules
 func (h *FormulaHandler) EvaluateCondition(c *gin.Context) {
 	var req EvaluateConditionRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		RespondAppError(c, appErrors.NewValidationError("json", err.Error()))
+	if !BindJSON(c, &req) {
 		return
 	}
 
@@ -113,11 +111,7 @@ func (h *FormulaHandler) EvaluateCondition(c *gin.Context) {
 // Used by the frontend for template strings like "{Name} - {Email}"
 func (h *FormulaHandler) Substitute(c *gin.Context) {
 	var req SubstituteRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error":   err.Error(),
-		})
+	if !BindJSON(c, &req) {
 		return
 	}
 
@@ -131,11 +125,7 @@ func (h *FormulaHandler) Substitute(c *gin.Context) {
 // Validate handles POST /api/formula/validate
 func (h *FormulaHandler) Validate(c *gin.Context) {
 	var req ValidateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error":   err.Error(),
-		})
+	if !BindJSON(c, &req) {
 		return
 	}
 
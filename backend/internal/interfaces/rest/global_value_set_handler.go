@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type GlobalValueSetHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewGlobalValueSetHandler(svc *services.ServiceManager) *GlobalValueSetHandler {
+	return &GlobalValueSetHandler{svc: svc}
+}
+
+// GetGlobalValueSets handles GET /api/metadata/global-value-sets
+func (h *GlobalValueSetHandler) GetGlobalValueSets(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetGlobalValueSets(c.Request.Context())
+	})
+}
+
+// GetGlobalValueSet handles GET /api/metadata/global-value-sets/:name and includes the
+// fields that use the set
+func (h *GlobalValueSetHandler) GetGlobalValueSet(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		vs, err := h.svc.Metadata.GetGlobalValueSet(c.Request.Context(), c.Param("name"))
+		if err != nil {
+			return nil, err
+		}
+		fields, err := h.svc.Metadata.GetValueSetFields(c.Request.Context(), vs.Name)
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			*models.GlobalValueSet
+			UsedBy []string `json:"used_by"`
+		}{vs, fields}, nil
+	})
+}
+
+// CreateGlobalValueSet handles POST /api/metadata/global-value-sets
+func (h *GlobalValueSetHandler) CreateGlobalValueSet(c *gin.Context) {
+	var vs models.GlobalValueSet
+	HandleCreateEnvelope(c, "data", "Global value set created successfully", &vs, func() error {
+		vs.IsStandard = false
+		return h.svc.Metadata.CreateGlobalValueSet(c.Request.Context(), &vs)
+	})
+}
+
+// UpdateGlobalValueSet handles PATCH /api/metadata/global-value-sets/:name
+func (h *GlobalValueSetHandler) UpdateGlobalValueSet(c *gin.Context) {
+	var updates models.GlobalValueSet
+	if !BindJSON(c, &updates) {
+		return
+	}
+	vs, err := h.svc.Metadata.UpdateGlobalValueSet(c.Request.Context(), c.Param("name"), &updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Global value set updated successfully",
+		"data":                 vs,
+	})
+}
+
+// DeleteGlobalValueSet handles DELETE /api/metadata/global-value-sets/:name
+func (h *GlobalValueSetHandler) DeleteGlobalValueSet(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Global value set deleted successfully", func() error {
+		return h.svc.Metadata.DeleteGlobalValueSet(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// SetFieldValueSet handles PUT /api/metadata/objects/:apiName/fields/:fieldApiName/value-set;
+// a null value_set unbinds the field
+func (h *GlobalValueSetHandler) SetFieldValueSet(c *gin.Context) {
+	var req struct {
+		ValueSet *string `json:"value_set"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	field, err := h.svc.Metadata.SetFieldValueSet(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req.ValueSet)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Field value set updated successfully",
+		"data":                 field,
+	})
+}
@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/interfaces/graphqlapi"
+)
+
+type GraphQLHandler struct {
+	api *graphqlapi.API
+}
+
+func NewGraphQLHandler(svc *services.ServiceManager) *GraphQLHandler {
+	return &GraphQLHandler{api: graphqlapi.New(svc)}
+}
+
+// Execute handles POST /api/graphql
+func (h *GraphQLHandler) Execute(c *gin.Context) {
+	var req struct {
+		Query         string                 `json:"query" binding:"required"`
+		Variables     map[string]interface{} `json:"variables,omitempty"`
+		OperationName string                 `json:"operationName,omitempty"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	// GraphQL reports errors in the response body, so the status is 200 either way
+	result := h.api.Execute(c.Request.Context(), GetUserFromContext(c), req.Query, req.Variables, req.OperationName)
+	c.JSON(http.StatusOK, result)
+}
@@ -1,12 +1,22 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"log"
 	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/backend/pkg/errors"
 	"github.com/nexuscrm/shared/pkg/constants"
@@ -29,30 +39,47 @@ func GetUserFromContext(c *gin.Context) *models.UserSession {
 		ProfileID:     authUser.ProfileId,
 		RoleID:        authUser.RoleId,
 		IsSystemAdmin: authUser.IsSuperUser(),
+		UserType:      authUser.UserType,
+		Locale:        authUser.Locale,
 	}
 }
 
+// ReadContext returns the request context with the read-consistency flags of analytics,
+// report and dashboard requests applied: ?consistent=true reads from the primary and
+// ?maxStaleness=<duration> (e.g. "5s") bounds the replica lag the request tolerates.
+func ReadContext(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	if c.Query("consistent") == "true" {
+		return persistence.WithStaleness(ctx, 0)
+	}
+	if raw := c.Query("maxStaleness"); raw != "" {
+		if tolerance, err := time.ParseDuration(raw); err == nil {
+			return persistence.WithStaleness(ctx, tolerance)
+		}
+	}
+	return ctx
+}
+
 // RespondAppError sends a standardised JSON error response using pkg/errors
 func RespondAppError(c *gin.Context, err error) {
 	code := errors.GetHTTPStatus(err)
-	errorCode := errors.GetErrorCode(err)
-	message := err.Error()
-
 	if code >= 500 {
-		log.Printf("❌ ERROR [%d] %s %s: %s", code, c.Request.Method, c.Request.URL.Path, message)
+		log.Printf("❌ ERROR [%d] %s %s: %s", code, c.Request.Method, c.Request.URL.Path, err.Error())
 	}
 
-	c.JSON(code, gin.H{
-		"message": message,
-		"code":    errorCode,
-		"data":    nil,
-	})
+	c.JSON(code, errorBody{ErrorResponse: errors.ToResponse(err)})
+}
+
+// errorBody is an ErrorResponse with the null "data" every API response carries
+type errorBody struct {
+	errors.ErrorResponse
+	Data any `json:"data"`
 }
 
 // BindJSON binds JSON and returns true if successful. If failed, it sends bad request error.
 func BindJSON(c *gin.Context, obj interface{}) bool {
 	if err := c.ShouldBindJSON(obj); err != nil {
-		RespondAppError(c, errors.NewValidationError("body", err.Error()))
+		RespondAppError(c, bindingError(err))
 		return false
 	}
 	return true
@@ -63,16 +90,75 @@ func BindJSONStrict(c *gin.Context, obj interface{}) bool {
 	dec := json.NewDecoder(c.Request.Body)
 	dec.DisallowUnknownFields()
 	if err := dec.Decode(obj); err != nil {
-		RespondAppError(c, errors.NewValidationError("body", err.Error()))
+		RespondAppError(c, bindingError(err))
 		return false
 	}
 	if err := binding.Validator.ValidateStruct(obj); err != nil {
-		RespondAppError(c, errors.NewValidationError("body", err.Error()))
+		RespondAppError(c, bindingError(err))
 		return false
 	}
 	return true
 }
 
+func init() {
+	// Report binding failures by JSON field name ("records[0].id"), not Go field name
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return f.Name
+			}
+			return name
+		})
+	}
+}
+
+// bindingError converts a request binding failure into a ValidationError carrying the
+// registered code and the path of the offending field
+func bindingError(err error) error {
+	if tooLarge := bodyTooLarge(err); tooLarge != nil {
+		return tooLarge
+	}
+	if invalid, ok := err.(validator.ValidationErrors); ok {
+		fieldErrs := make(errors.ValidationErrors, len(invalid))
+		for i, fe := range invalid {
+			// Namespace is "<Struct>.<path>"; the path is what the client sent
+			path := fe.Namespace()
+			if dot := strings.Index(path, "."); dot >= 0 {
+				path = path[dot+1:]
+			}
+			if fe.Tag() == "required" {
+				fieldErrs[i] = errors.NewFieldValidationError(constants.ErrorCodeRequiredFieldMissing, path, "is required")
+			} else {
+				fieldErrs[i] = errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, path,
+					fmt.Sprintf("failed the '%s' check", fe.Tag()))
+			}
+		}
+		if len(fieldErrs) == 1 {
+			return fieldErrs[0]
+		}
+		return fieldErrs
+	}
+
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok && typeErr.Field != "" {
+		return errors.NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, typeErr.Field,
+			fmt.Sprintf("expected %s, got %s", typeErr.Type, typeErr.Value))
+	}
+	return errors.NewFieldValidationError(constants.ErrorCodeMalformedRequest, "body", err.Error())
+}
+
+// bodyTooLarge reports a request body cut off by the body size limit
+func bodyTooLarge(err error) error {
+	var maxBytes *http.MaxBytesError
+	if stderrors.As(err, &maxBytes) {
+		return errors.NewPayloadTooLargeError(maxBytes.Limit)
+	}
+	return nil
+}
+
 // HandleGetEnvelope executes a read action and returns the result wrapped in a JSON key
 // Response: { [key]: result }
 func HandleGetEnvelope(c *gin.Context, key string, action func() (interface{}, error)) {
@@ -84,6 +170,70 @@ func HandleGetEnvelope(c *gin.Context, key string, action func() (interface{}, e
 	c.JSON(http.StatusOK, gin.H{key: result})
 }
 
+// HandleMetadataList returns a metadata list with optional incremental reads:
+// ?modifiedSince=<RFC 3339> keeps the items changed at or after that time and
+// ?limit=&offset= page them, oldest change first. Without these parameters it answers like
+// HandleGetEnvelope. With them the response also carries the number of matching items and
+// as_of, the server time to pass as the next modifiedSince. Deletions are not reported; a
+// full read (or a changed total) catches them.
+// Response: { "data": items, "total": n, "as_of": time }
+func HandleMetadataList[T any](c *gin.Context, key func(T) (string, time.Time), list func() ([]T, error)) {
+	var since *time.Time
+	if raw := c.Query("modifiedSince"); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			RespondAppError(c, errors.NewValidationError("modifiedSince", "must be an RFC 3339 timestamp"))
+			return
+		}
+		since = &t
+	}
+	var limit, offset int
+	for param, target := range map[string]*int{"limit": &limit, "offset": &offset} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			RespondAppError(c, errors.NewValidationError(param, "must be a non-negative integer"))
+			return
+		}
+		*target = n
+	}
+	if since == nil && c.Query("limit") == "" && c.Query("offset") == "" {
+		HandleGetEnvelope(c, "data", func() (interface{}, error) { return list() })
+		return
+	}
+
+	// Metadata dates have second precision, so the next read starts at the whole second
+	asOf := time.Now().UTC().Truncate(time.Second)
+	items, err := list()
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	page := make([]T, 0, len(items))
+	for _, item := range items {
+		if _, modified := key(item); since == nil || !modified.Before(*since) {
+			page = append(page, item)
+		}
+	}
+	sort.Slice(page, func(i, j int) bool {
+		iID, iModified := key(page[i])
+		jID, jModified := key(page[j])
+		if !iModified.Equal(jModified) {
+			return iModified.Before(jModified)
+		}
+		return iID < jID
+	})
+	total := len(page)
+	page = page[min(offset, total):]
+	if limit > 0 && limit < len(page) {
+		page = page[:limit]
+	}
+	c.JSON(http.StatusOK, gin.H{"data": page, "total": total, "as_of": asOf})
+}
+
 // HandleCreateEnvelope executes a create action and returns the object wrapped + message
 // Response: { constants.FieldMessage: successMsg, "data": obj }
 // If key is empty, defaults to "data" for consistent API response format.
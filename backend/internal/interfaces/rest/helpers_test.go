@@ -0,0 +1,148 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/interfaces/rest"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindJSON_ErrorCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type item struct {
+		ID string `json:"id" binding:"required"`
+	}
+	type request struct {
+		Name    string `json:"name" binding:"required"`
+		Email   string `json:"email" binding:"omitempty,email"`
+		Count   int    `json:"count"`
+		Records []item `json:"records" binding:"dive"`
+	}
+
+	bind := func(body string) (int, errors.ErrorResponse) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		var req request
+		assert.False(t, rest.BindJSON(c, &req))
+
+		var resp errors.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w.Code, resp
+	}
+
+	t.Run("missing field", func(t *testing.T) {
+		status, resp := bind(`{"email": "a@example.com"}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, constants.ErrorCodeRequiredFieldMissing, resp.Code)
+		assert.Equal(t, "name", resp.Field)
+		assert.Equal(t, "errors.requiredFieldMissing", resp.I18nKey)
+	})
+
+	t.Run("several invalid fields", func(t *testing.T) {
+		_, resp := bind(`{"email": "nope", "records": [{"id": "1"}, {}]}`)
+		assert.Equal(t, constants.ErrorCodeValidationError, resp.Code)
+		var fields []string
+		for _, e := range resp.Errors {
+			fields = append(fields, e.Code+" "+e.Field)
+		}
+		assert.ElementsMatch(t, []string{
+			constants.ErrorCodeRequiredFieldMissing + " name",
+			constants.ErrorCodeInvalidFieldValue + " email",
+			constants.ErrorCodeRequiredFieldMissing + " records[1].id",
+		}, fields)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		_, resp := bind(`{"name": "x", "count": "many"}`)
+		assert.Equal(t, constants.ErrorCodeInvalidFieldValue, resp.Code)
+		assert.Equal(t, "count", resp.Field)
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		_, resp := bind(`{"name": `)
+		assert.Equal(t, constants.ErrorCodeMalformedRequest, resp.Code)
+	})
+}
+
+func TestHandleMetadataList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type item struct {
+		ID       string    `json:"id"`
+		Modified time.Time `json:"modified"`
+	}
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	items := []item{
+		{ID: "c", Modified: base.Add(2 * time.Hour)},
+		{ID: "a", Modified: base},
+		{ID: "b", Modified: base.Add(time.Hour)},
+		{ID: "d", Modified: base.Add(time.Hour)},
+	}
+
+	type response struct {
+		Data  []item     `json:"data"`
+		Total *int       `json:"total"`
+		AsOf  *time.Time `json:"as_of"`
+	}
+	get := func(query string) (int, response) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+		rest.HandleMetadataList(c, func(i item) (string, time.Time) { return i.ID, i.Modified }, func() ([]item, error) {
+			return items, nil
+		})
+		var resp response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w.Code, resp
+	}
+	ids := func(resp response) []string {
+		out := make([]string, len(resp.Data))
+		for i, it := range resp.Data {
+			out[i] = it.ID
+		}
+		return out
+	}
+
+	t.Run("plain list is unchanged", func(t *testing.T) {
+		_, resp := get("")
+		assert.Equal(t, []string{"c", "a", "b", "d"}, ids(resp))
+		assert.Nil(t, resp.Total)
+		assert.Nil(t, resp.AsOf)
+	})
+
+	t.Run("modified since", func(t *testing.T) {
+		_, resp := get("modifiedSince=" + base.Add(time.Hour).Format(time.RFC3339))
+		assert.Equal(t, []string{"b", "d", "c"}, ids(resp))
+		require.NotNil(t, resp.Total)
+		assert.Equal(t, 3, *resp.Total)
+		require.NotNil(t, resp.AsOf)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		_, first := get("limit=3")
+		assert.Equal(t, []string{"a", "b", "d"}, ids(first))
+		assert.Equal(t, 4, *first.Total)
+		_, second := get("limit=3&offset=3")
+		assert.Equal(t, []string{"c"}, ids(second))
+		_, past := get("offset=10")
+		assert.Empty(t, past.Data)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, query := range []string{"modifiedSince=yesterday", "limit=-1", "offset=x"} {
+			status, _ := get(query)
+			assert.Equal(t, http.StatusBadRequest, status, query)
+		}
+	})
+}
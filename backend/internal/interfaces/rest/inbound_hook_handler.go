@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type InboundHookHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewInboundHookHandler(svc *services.ServiceManager) *InboundHookHandler {
+	return &InboundHookHandler{svc: svc}
+}
+
+// GetInboundHooks handles GET /api/metadata/inbound-hooks
+func (h *InboundHookHandler) GetInboundHooks(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.InboundHooks.GetInboundHooks(c.Request.Context())
+	})
+}
+
+// GetInboundHook handles GET /api/metadata/inbound-hooks/:slug
+func (h *InboundHookHandler) GetInboundHook(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.InboundHooks.GetInboundHook(c.Request.Context(), c.Param("slug"))
+	})
+}
+
+// CreateInboundHook handles POST /api/metadata/inbound-hooks
+func (h *InboundHookHandler) CreateInboundHook(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var hook models.InboundHook
+	HandleCreateEnvelope(c, "data", "Inbound hook created successfully", &hook, func() error {
+		return h.svc.InboundHooks.CreateInboundHook(c.Request.Context(), &hook, user)
+	})
+}
+
+// UpdateInboundHook handles PATCH /api/metadata/inbound-hooks/:slug
+func (h *InboundHookHandler) UpdateInboundHook(c *gin.Context) {
+	var updates services.InboundHookUpdate
+	if !BindJSON(c, &updates) {
+		return
+	}
+	hook, err := h.svc.InboundHooks.UpdateInboundHook(c.Request.Context(), c.Param("slug"), updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Inbound hook updated successfully",
+		"data":                 hook,
+	})
+}
+
+// DeleteInboundHook handles DELETE /api/metadata/inbound-hooks/:slug
+func (h *InboundHookHandler) DeleteInboundHook(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Inbound hook deleted successfully", func() error {
+		return h.svc.InboundHooks.DeleteInboundHook(c.Request.Context(), c.Param("slug"))
+	})
+}
+
+// Receive handles POST /hooks/:slug. External systems authenticate with the hook's secret,
+// so no session is required.
+func (h *InboundHookHandler) Receive(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if tooLarge := bodyTooLarge(err); tooLarge != nil {
+			RespondAppError(c, tooLarge)
+			return
+		}
+		RespondAppError(c, err)
+		return
+	}
+
+	result, err := h.svc.InboundHooks.Receive(c.Request.Context(), c.Param("slug"), services.InboundDelivery{
+		Body:      body,
+		Signature: c.GetHeader("X-Hook-Signature"),
+		Secret:    c.GetHeader("X-Hook-Secret"),
+	})
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// IntegrationHookHandler serves the integration platform contract under /api/integrations/hooks.
+// Responses are bare JSON arrays and objects rather than the usual envelope, as Zapier and
+// Make expect.
+type IntegrationHookHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewIntegrationHookHandler(svc *services.ServiceManager) *IntegrationHookHandler {
+	return &IntegrationHookHandler{svc: svc}
+}
+
+// Me handles GET /api/integrations/hooks/me, the connection test of platforms
+func (h *IntegrationHookHandler) Me(c *gin.Context) {
+	user := GetUserFromContext(c)
+	me := gin.H{"id": user.ID, "name": user.Name}
+	if user.Email != nil {
+		me["email"] = *user.Email
+	}
+	c.JSON(http.StatusOK, me)
+}
+
+// Poll handles GET /api/integrations/hooks/triggers/:objectApiName/:event
+func (h *IntegrationHookHandler) Poll(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectApiName := strings.ToLower(c.Param("objectApiName"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	items, err := h.svc.Hooks.Poll(ReadContext(c), objectApiName, constants.HookEvent(c.Param("event")), limit, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, items)
+}
+
+// GetSubscriptions handles GET /api/integrations/hooks/subscriptions
+func (h *IntegrationHookHandler) GetSubscriptions(c *gin.Context) {
+	user := GetUserFromContext(c)
+	subs, err := h.svc.Hooks.ListSubscriptions(c.Request.Context(), user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, subs)
+}
+
+// Subscribe handles POST /api/integrations/hooks/subscriptions
+func (h *IntegrationHookHandler) Subscribe(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var req models.HookSubscribeRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	req.ObjectAPIName = strings.ToLower(req.ObjectAPIName)
+
+	sub, err := h.svc.Hooks.Subscribe(c.Request.Context(), req, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, sub)
+}
+
+// Unsubscribe handles DELETE /api/integrations/hooks/subscriptions/:id
+func (h *IntegrationHookHandler) Unsubscribe(c *gin.Context) {
+	user := GetUserFromContext(c)
+	if err := h.svc.Hooks.Unsubscribe(c.Request.Context(), c.Param("id"), user); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// CreateRecord handles POST /api/integrations/hooks/actions/:objectApiName
+func (h *IntegrationHookHandler) CreateRecord(c *gin.Context) {
+	user := GetUserFromContext(c)
+	data := make(models.SObject)
+	if !BindJSON(c, &data) {
+		return
+	}
+	record, err := h.svc.Hooks.CreateRecord(c.Request.Context(), strings.ToLower(c.Param("objectApiName")), data, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, record)
+}
+
+// UpdateRecord handles PATCH /api/integrations/hooks/actions/:objectApiName/:id
+func (h *IntegrationHookHandler) UpdateRecord(c *gin.Context) {
+	user := GetUserFromContext(c)
+	data := make(models.SObject)
+	if !BindJSON(c, &data) {
+		return
+	}
+	record, err := h.svc.Hooks.UpdateRecord(c.Request.Context(), strings.ToLower(c.Param("objectApiName")), c.Param("id"), data, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, record)
+}
+
+// FindRecords handles GET /api/integrations/hooks/actions/:objectApiName?field=&value=
+func (h *IntegrationHookHandler) FindRecords(c *gin.Context) {
+	user := GetUserFromContext(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	items, err := h.svc.Hooks.FindRecords(ReadContext(c), strings.ToLower(c.Param("objectApiName")),
+		c.Query("field"), c.Query("value"), limit, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, items)
+}
@@ -0,0 +1,183 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type KnowledgeHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewKnowledgeHandler(svc *services.ServiceManager) *KnowledgeHandler {
+	return &KnowledgeHandler{svc: svc}
+}
+
+// ListCategories handles GET /api/knowledge/categories
+func (h *KnowledgeHandler) ListCategories(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ListCategories(c.Request.Context())
+	})
+}
+
+// CreateCategory handles POST /api/knowledge/categories
+func (h *KnowledgeHandler) CreateCategory(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var category models.SystemKnowledgeCategory
+	HandleCreateEnvelope(c, "data", "Category created successfully", &category, func() error {
+		return h.svc.Knowledge.CreateCategory(c.Request.Context(), &category, user)
+	})
+}
+
+// UpdateCategory handles PUT /api/knowledge/categories/:id
+func (h *KnowledgeHandler) UpdateCategory(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemKnowledgeCategory
+	HandleUpdateEnvelope(c, "data", "Category updated successfully", &updates, func() error {
+		return h.svc.Knowledge.UpdateCategory(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteCategory handles DELETE /api/knowledge/categories/:id
+func (h *KnowledgeHandler) DeleteCategory(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Category deleted successfully", func() error {
+		return h.svc.Knowledge.DeleteCategory(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ListArticles handles GET /api/knowledge/articles?status=...&category_id=...
+func (h *KnowledgeHandler) ListArticles(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ListArticles(c.Request.Context(), c.Query("status"), c.Query("category_id"), GetUserFromContext(c))
+	})
+}
+
+// GetArticle handles GET /api/knowledge/articles/:id
+func (h *KnowledgeHandler) GetArticle(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.GetArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// CreateArticle handles POST /api/knowledge/articles
+func (h *KnowledgeHandler) CreateArticle(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var article models.SystemKnowledgeArticle
+	HandleCreateEnvelope(c, "data", "Article created successfully", &article, func() error {
+		return h.svc.Knowledge.CreateArticle(c.Request.Context(), &article, user)
+	})
+}
+
+// UpdateArticle handles PUT /api/knowledge/articles/:id
+func (h *KnowledgeHandler) UpdateArticle(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemKnowledgeArticle
+	HandleUpdateEnvelope(c, "data", "Article updated successfully", &updates, func() error {
+		return h.svc.Knowledge.UpdateArticle(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteArticle handles DELETE /api/knowledge/articles/:id
+func (h *KnowledgeHandler) DeleteArticle(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Article deleted successfully", func() error {
+		return h.svc.Knowledge.DeleteArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// PublishArticle handles POST /api/knowledge/articles/:id/publish
+func (h *KnowledgeHandler) PublishArticle(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.PublishArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ArchiveArticle handles POST /api/knowledge/articles/:id/archive
+func (h *KnowledgeHandler) ArchiveArticle(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ArchiveArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// RestoreArticle handles POST /api/knowledge/articles/:id/restore
+func (h *KnowledgeHandler) RestoreArticle(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.RestoreArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ListVersions handles GET /api/knowledge/articles/:id/versions
+func (h *KnowledgeHandler) ListVersions(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ListVersions(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// RevertArticle handles POST /api/knowledge/articles/:id/versions/:version/revert
+func (h *KnowledgeHandler) RevertArticle(c *gin.Context) {
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		RespondAppError(c, errors.NewValidationError("version", "Version must be a positive number"))
+		return
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.RevertArticle(c.Request.Context(), c.Param("id"), version, GetUserFromContext(c))
+	})
+}
+
+// ListPublished handles GET /api/knowledge/published?category_id=...
+func (h *KnowledgeHandler) ListPublished(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ListPublished(c.Request.Context(), c.Query("category_id"))
+	})
+}
+
+// GetPublished handles GET /api/knowledge/published/:urlName (URL name or article ID)
+func (h *KnowledgeHandler) GetPublished(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.GetPublished(c.Request.Context(), c.Param("urlName"))
+	})
+}
+
+// Search handles GET /api/knowledge/search?term=...&category_id=...&limit=20
+func (h *KnowledgeHandler) Search(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.SearchPublished(c.Request.Context(), c.Query("term"), c.Query("category_id"), limit)
+	})
+}
+
+// Suggestions handles GET /api/knowledge/suggestions?object_api_name=case&record_id=...
+func (h *KnowledgeHandler) Suggestions(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.Suggestions(c.Request.Context(), c.Query("object_api_name"), c.Query("record_id"), GetUserFromContext(c))
+	})
+}
+
+// ListLinks handles GET /api/knowledge/links?object_api_name=case&record_id=...
+func (h *KnowledgeHandler) ListLinks(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Knowledge.ListLinks(c.Request.Context(), c.Query("object_api_name"), c.Query("record_id"), GetUserFromContext(c))
+	})
+}
+
+// CreateLink handles POST /api/knowledge/links
+func (h *KnowledgeHandler) CreateLink(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var link models.SystemKnowledgeArticleLink
+	HandleCreateEnvelope(c, "data", "Article attached successfully", &link, func() error {
+		return h.svc.Knowledge.LinkArticle(c.Request.Context(), &link, user)
+	})
+}
+
+// DeleteLink handles DELETE /api/knowledge/links/:id
+func (h *KnowledgeHandler) DeleteLink(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Article detached successfully", func() error {
+		return h.svc.Knowledge.UnlinkArticle(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type NamedCredentialHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewNamedCredentialHandler(svc *services.ServiceManager) *NamedCredentialHandler {
+	return &NamedCredentialHandler{svc: svc}
+}
+
+// GetNamedCredentials handles GET /api/metadata/named-credentials
+func (h *NamedCredentialHandler) GetNamedCredentials(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Callouts.GetNamedCredentials(c.Request.Context())
+	})
+}
+
+// GetNamedCredential handles GET /api/metadata/named-credentials/:name
+func (h *NamedCredentialHandler) GetNamedCredential(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Callouts.GetNamedCredential(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateNamedCredential handles POST /api/metadata/named-credentials
+func (h *NamedCredentialHandler) CreateNamedCredential(c *gin.Context) {
+	var cred models.NamedCredential
+	HandleCreateEnvelope(c, "data", "Named credential created successfully", &cred, func() error {
+		return h.svc.Callouts.CreateNamedCredential(c.Request.Context(), &cred)
+	})
+}
+
+// UpdateNamedCredential handles PATCH /api/metadata/named-credentials/:name
+func (h *NamedCredentialHandler) UpdateNamedCredential(c *gin.Context) {
+	var updates services.NamedCredentialUpdate
+	if !BindJSON(c, &updates) {
+		return
+	}
+	cred, err := h.svc.Callouts.UpdateNamedCredential(c.Request.Context(), c.Param("name"), updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Named credential updated successfully",
+		"data":                 cred,
+	})
+}
+
+// DeleteNamedCredential handles DELETE /api/metadata/named-credentials/:name
+func (h *NamedCredentialHandler) DeleteNamedCredential(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Named credential deleted successfully", func() error {
+		return h.svc.Callouts.DeleteNamedCredential(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// TestCallout handles POST /api/metadata/named-credentials/:name/callout
+// Sends a request through the credential so admins can check connectivity and response shape.
+func (h *NamedCredentialHandler) TestCallout(c *gin.Context) {
+	var req services.CalloutRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	req.NamedCredential = c.Param("name")
+	resp, err := h.svc.Callouts.Callout(c.Request.Context(), req)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": resp})
+}
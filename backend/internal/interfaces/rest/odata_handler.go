@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/interfaces/odataapi"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+)
+
+// odataRoot is where the OData service is mounted
+const odataRoot = "/api/odata"
+
+type ODataHandler struct {
+	api *odataapi.API
+}
+
+func NewODataHandler(svc *services.ServiceManager) *ODataHandler {
+	return &ODataHandler{api: odataapi.New(svc)}
+}
+
+// ServiceDocument handles GET /api/odata
+func (h *ODataHandler) ServiceDocument(c *gin.Context) {
+	respondOData(c, h.api.ServiceDocument(c.Request.Context(), GetUserFromContext(c), odataBaseURL(c)), nil)
+}
+
+// Resource handles GET /api/odata/:resource, which is $metadata, an entity set
+// ("account") or a single entity ("account('a1')")
+func (h *ODataHandler) Resource(c *gin.Context) {
+	user := GetUserFromContext(c)
+	resource := c.Param("resource")
+
+	if resource == "$metadata" {
+		body, err := h.api.Metadata(c.Request.Context(), user)
+		if err != nil {
+			respondOData(c, nil, err)
+			return
+		}
+		c.Header("OData-Version", "4.0")
+		c.Data(http.StatusOK, "application/xml", body)
+		return
+	}
+
+	if open := strings.IndexByte(resource, '('); open > 0 && strings.HasSuffix(resource, ")") {
+		key := strings.Trim(resource[open+1:len(resource)-1], "'")
+		entity, err := h.api.Get(c.Request.Context(), user, resource[:open], key, c.Request.URL.Query(), odataBaseURL(c))
+		respondOData(c, entity, err)
+		return
+	}
+
+	page, err := h.api.Query(c.Request.Context(), user, resource, c.Request.URL.Query(), odataBaseURL(c))
+	respondOData(c, page, err)
+}
+
+// respondOData writes a JSON payload, or an error in the OData error format
+func respondOData(c *gin.Context, body interface{}, err error) {
+	c.Header("OData-Version", "4.0")
+	if err != nil {
+		resp := appErrors.ToResponse(err)
+		body := gin.H{"code": resp.Code, "message": resp.Message}
+		if resp.Field != "" {
+			body["target"] = resp.Field
+		}
+		c.JSON(appErrors.GetHTTPStatus(err), gin.H{"error": body})
+		return
+	}
+	c.Header("Content-Type", "application/json;odata.metadata=minimal")
+	c.JSON(http.StatusOK, body)
+}
+
+// odataBaseURL is the absolute service root as seen by the client
+func odataBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host + odataRoot
+}
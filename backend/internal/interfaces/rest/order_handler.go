@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type OrderHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewOrderHandler(svc *services.ServiceManager) *OrderHandler {
+	return &OrderHandler{svc: svc}
+}
+
+// ListOrders handles GET /api/orders
+func (h *OrderHandler) ListOrders(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Orders.List(c.Request.Context(), GetUserFromContext(c))
+	})
+}
+
+// GetOrder handles GET /api/orders/:id
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Orders.Get(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// CreateOrder handles POST /api/orders
+func (h *OrderHandler) CreateOrder(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var detail models.OrderDetail
+	HandleCreateEnvelope(c, "data", "Order created successfully", &detail, func() error {
+		return h.svc.Orders.Create(c.Request.Context(), &detail, user)
+	})
+}
+
+// UpdateOrder handles PUT /api/orders/:id
+func (h *OrderHandler) UpdateOrder(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var detail models.OrderDetail
+	HandleUpdateEnvelope(c, "data", "Order updated successfully", &detail, func() error {
+		return h.svc.Orders.Update(c.Request.Context(), id, &detail, user)
+	})
+}
+
+// DeleteOrder handles DELETE /api/orders/:id
+func (h *OrderHandler) DeleteOrder(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Order deleted successfully", func() error {
+		return h.svc.Orders.Delete(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ActivateOrder handles POST /api/orders/:id/activate
+func (h *OrderHandler) ActivateOrder(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Orders.Activate(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// FulfillOrder handles POST /api/orders/:id/fulfill
+func (h *OrderHandler) FulfillOrder(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Orders.Fulfill(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// CancelOrder handles POST /api/orders/:id/cancel
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Orders.Cancel(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
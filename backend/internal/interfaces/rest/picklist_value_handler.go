@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+type PicklistValueHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewPicklistValueHandler(svc *services.ServiceManager) *PicklistValueHandler {
+	return &PicklistValueHandler{svc: svc}
+}
+
+// GetPicklistValues handles GET /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values
+func (h *PicklistValueHandler) GetPicklistValues(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		ctx := c.Request.Context()
+		values, err := h.svc.Metadata.GetPicklistValues(ctx, c.Param("apiName"), c.Param("fieldApiName"))
+		if err != nil {
+			return nil, err
+		}
+		return h.svc.Translations.LocalizePicklistValues(ctx, c.Param("apiName"), c.Param("fieldApiName"), values), nil
+	})
+}
+
+// AddPicklistValue handles POST /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values
+func (h *PicklistValueHandler) AddPicklistValue(c *gin.Context) {
+	var req struct {
+		Value    string `json:"value" binding:"required"`
+		Position *int   `json:"position"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	h.respondValues(c, "Picklist value added successfully", func() (interface{}, error) {
+		return h.svc.Metadata.AddPicklistValue(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req.Value, req.Position)
+	})
+}
+
+// ActivatePicklistValue handles POST /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values/activate
+func (h *PicklistValueHandler) ActivatePicklistValue(c *gin.Context) {
+	h.setActive(c, true, "Picklist value activated successfully")
+}
+
+// DeactivatePicklistValue handles POST /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values/deactivate
+func (h *PicklistValueHandler) DeactivatePicklistValue(c *gin.Context) {
+	h.setActive(c, false, "Picklist value deactivated successfully")
+}
+
+func (h *PicklistValueHandler) setActive(c *gin.Context, active bool, successMsg string) {
+	var req struct {
+		Value string `json:"value" binding:"required"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	h.respondValues(c, successMsg, func() (interface{}, error) {
+		return h.svc.Metadata.SetPicklistValueActive(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req.Value, active)
+	})
+}
+
+// ReorderPicklistValues handles PUT /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values/order
+func (h *PicklistValueHandler) ReorderPicklistValues(c *gin.Context) {
+	var req struct {
+		Values []string `json:"values" binding:"required"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	h.respondValues(c, "Picklist values reordered successfully", func() (interface{}, error) {
+		return h.svc.Metadata.ReorderPicklistValues(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req.Values)
+	})
+}
+
+// ReplacePicklistValue handles POST /api/metadata/objects/:apiName/fields/:fieldApiName/picklist-values/replace
+// and queues a job that rewrites the value on existing records
+func (h *PicklistValueHandler) ReplacePicklistValue(c *gin.Context) {
+	var req services.PicklistReplaceRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	job, err := h.svc.Picklists.ReplaceValue(c.Request.Context(), c.Param("apiName"), c.Param("fieldApiName"), req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		constants.FieldMessage: "Picklist value replacement queued",
+		"data":                 job,
+	})
+}
+
+// GetAsyncJob handles GET /api/metadata/async-jobs/:id
+func (h *PicklistValueHandler) GetAsyncJob(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.AsyncJobs.GetJob(c.Request.Context(), c.Param("id"))
+	})
+}
+
+func (h *PicklistValueHandler) respondValues(c *gin.Context, successMsg string, action func() (interface{}, error)) {
+	values, err := action()
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: successMsg,
+		"data":                 values,
+	})
+}
@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type PortalHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewPortalHandler(svc *services.ServiceManager) *PortalHandler {
+	return &PortalHandler{svc: svc}
+}
+
+// ==================== Configuration ====================
+
+// GetPortalObjects handles GET /api/metadata/portal-objects
+func (h *PortalHandler) GetPortalObjects(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Portal.GetObjects(c.Request.Context())
+	})
+}
+
+// SavePortalObject handles PUT /api/metadata/portal-objects/:objectApiName
+func (h *PortalHandler) SavePortalObject(c *gin.Context) {
+	var o models.PortalObject
+	if !BindJSON(c, &o) {
+		return
+	}
+	saved, err := h.svc.Portal.SaveObject(c.Request.Context(), c.Param("objectApiName"), &o)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Portal object saved successfully",
+		"data":                 saved,
+	})
+}
+
+// DeletePortalObject handles DELETE /api/metadata/portal-objects/:objectApiName
+func (h *PortalHandler) DeletePortalObject(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Portal object removed successfully", func() error {
+		return h.svc.Portal.DeleteObject(c.Request.Context(), c.Param("objectApiName"))
+	})
+}
+
+// ==================== Portal API ====================
+
+// GetMe handles GET /api/portal/me
+func (h *PortalHandler) GetMe(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Portal.GetIdentity(c.Request.Context(), user)
+	})
+}
+
+// GetObjects handles GET /api/portal/objects
+func (h *PortalHandler) GetObjects(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Portal.GetAccessibleObjects(c.Request.Context(), user)
+	})
+}
+
+// GetRecords handles GET /api/portal/data/:objectApiName
+func (h *PortalHandler) GetRecords(c *gin.Context) {
+	user := GetUserFromContext(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Portal.ListRecords(c.Request.Context(), c.Param("objectApiName"), limit, offset, user)
+	})
+}
+
+// GetRecord handles GET /api/portal/data/:objectApiName/:id
+func (h *PortalHandler) GetRecord(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Portal.GetRecord(c.Request.Context(), c.Param("objectApiName"), c.Param("id"), user)
+	})
+}
+
+// CreateRecord handles POST /api/portal/data/:objectApiName
+func (h *PortalHandler) CreateRecord(c *gin.Context) {
+	user := GetUserFromContext(c)
+	data := make(models.SObject)
+	HandleCreateEnvelope(c, "data", "Record created successfully", &data, func() error {
+		record, err := h.svc.Portal.CreateRecord(c.Request.Context(), c.Param("objectApiName"), data, user)
+		if err != nil {
+			return err
+		}
+		data = record
+		return nil
+	})
+}
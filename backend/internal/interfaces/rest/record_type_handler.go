@@ -0,0 +1,125 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type RecordTypeHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewRecordTypeHandler(svc *services.ServiceManager) *RecordTypeHandler {
+	return &RecordTypeHandler{svc: svc}
+}
+
+// GetRecordTypes handles GET /api/metadata/objects/:apiName/record-types
+func (h *RecordTypeHandler) GetRecordTypes(c *gin.Context) {
+	apiName := c.Param("apiName")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetRecordTypes(c.Request.Context(), apiName), nil
+	})
+}
+
+// GetAvailableRecordTypes handles GET /api/metadata/objects/:apiName/record-types/available
+// and returns the record types the current user may create records with, and their default
+func (h *RecordTypeHandler) GetAvailableRecordTypes(c *gin.Context) {
+	user := GetUserFromContext(c)
+	apiName := c.Param("apiName")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		available, def, err := h.svc.Metadata.GetAvailableRecordTypes(c.Request.Context(), apiName, user)
+		if err != nil {
+			return nil, err
+		}
+		if available == nil {
+			available = []*models.RecordType{}
+		}
+		var defaultID *string
+		if def != nil {
+			defaultID = &def.ID
+		}
+		return gin.H{"record_types": available, "default_record_type_id": defaultID}, nil
+	})
+}
+
+// CreateRecordType handles POST /api/metadata/objects/:apiName/record-types
+func (h *RecordTypeHandler) CreateRecordType(c *gin.Context) {
+	var rt models.RecordType
+	HandleCreateEnvelope(c, "data", "Record type created successfully", &rt, func() error {
+		rt.ObjectAPIName = c.Param("apiName")
+		return h.svc.Metadata.CreateRecordType(c.Request.Context(), &rt)
+	})
+}
+
+// UpdateRecordType handles PATCH /api/metadata/record-types/:id
+func (h *RecordTypeHandler) UpdateRecordType(c *gin.Context) {
+	id := c.Param("id")
+	var updates models.RecordType
+	HandleUpdateEnvelope(c, "data", "Record type updated successfully", &updates, func() error {
+		_, err := h.svc.Metadata.UpdateRecordType(c.Request.Context(), id, &updates)
+		return err
+	})
+}
+
+// DeleteRecordType handles DELETE /api/metadata/record-types/:id
+func (h *RecordTypeHandler) DeleteRecordType(c *gin.Context) {
+	id := c.Param("id")
+	HandleDeleteEnvelope(c, "Record type deleted successfully", func() error {
+		return h.svc.Metadata.DeleteRecordType(c.Request.Context(), id)
+	})
+}
+
+// GetProfileRecordTypes handles GET /api/metadata/record-types/assignments?profile_id=&object=
+func (h *RecordTypeHandler) GetProfileRecordTypes(c *gin.Context) {
+	profileID := c.Query("profile_id")
+	objectAPIName := c.Query("object")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		if profileID == "" || objectAPIName == "" {
+			return nil, appErrors.NewValidationError(constants.FieldProfileID, "profile_id and object are required")
+		}
+		return h.svc.Metadata.GetProfileRecordTypes(c.Request.Context(), profileID, objectAPIName)
+	})
+}
+
+// AssignRecordTypeToProfile handles POST /api/metadata/record-types/assign
+func (h *RecordTypeHandler) AssignRecordTypeToProfile(c *gin.Context) {
+	var req struct {
+		ProfileID    string  `json:"profile_id" binding:"required"`
+		RecordTypeID string  `json:"record_type_id" binding:"required"`
+		IsDefault    bool    `json:"is_default"`
+		LayoutID     *string `json:"layout_id"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	assignment := &models.ProfileRecordType{
+		ProfileID:    req.ProfileID,
+		RecordTypeID: req.RecordTypeID,
+		IsDefault:    req.IsDefault,
+		LayoutID:     req.LayoutID,
+	}
+	if err := h.svc.Metadata.AssignRecordTypeToProfile(c.Request.Context(), assignment); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Record type assigned to profile successfully",
+		"data":                 assignment,
+	})
+}
+
+// RemoveRecordTypeFromProfile handles DELETE /api/metadata/record-types/:id/assignments/:profileId
+func (h *RecordTypeHandler) RemoveRecordTypeFromProfile(c *gin.Context) {
+	id := c.Param("id")
+	profileID := c.Param("profileId")
+	HandleDeleteEnvelope(c, "Record type removed from profile successfully", func() error {
+		return h.svc.Metadata.RemoveRecordTypeFromProfile(c.Request.Context(), profileID, id)
+	})
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type ReportHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewReportHandler(svc *services.ServiceManager) *ReportHandler {
+	return &ReportHandler{svc: svc}
+}
+
+// GetReports handles GET /api/metadata/reports?object=<api_name>
+func (h *ReportHandler) GetReports(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Reports.List(c.Request.Context(), c.Query("object"), user)
+	})
+}
+
+// GetReport handles GET /api/metadata/reports/:id
+func (h *ReportHandler) GetReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Reports.Get(c.Request.Context(), id, user)
+	})
+}
+
+// CreateReport handles POST /api/metadata/reports
+func (h *ReportHandler) CreateReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var report models.Report
+	HandleCreateEnvelope(c, "data", "Report created successfully", &report, func() error {
+		return h.svc.Reports.Create(c.Request.Context(), &report, user)
+	})
+}
+
+// UpdateReport handles PATCH /api/metadata/reports/:id
+func (h *ReportHandler) UpdateReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.Report
+	HandleUpdateEnvelope(c, "data", "Report updated successfully", &updates, func() error {
+		return h.svc.Reports.Update(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteReport handles DELETE /api/metadata/reports/:id
+func (h *ReportHandler) DeleteReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleDeleteEnvelope(c, "Report deleted successfully", func() error {
+		return h.svc.Reports.Delete(c.Request.Context(), id, user)
+	})
+}
+
+// RunReport handles POST /api/metadata/reports/:id/run
+func (h *ReportHandler) RunReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Reports.Run(ReadContext(c), id, user)
+	})
+}
+
+// PreviewReport handles POST /api/metadata/reports/run with an unsaved report definition
+func (h *ReportHandler) PreviewReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var report models.Report
+	if !BindJSON(c, &report) {
+		return
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Reports.Preview(ReadContext(c), &report, user)
+	})
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// ExportReport handles GET /api/metadata/reports/:id/export (CSV download)
+func (h *ReportHandler) ExportReport(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+
+	// Buffer the export so a failing query still produces a JSON error response
+	var buf bytes.Buffer
+	report, err := h.svc.Reports.Export(ReadContext(c), id, &buf, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+
+	name := unsafeFilenameChars.ReplaceAllString(report.Name, "_")
+	if name == "" || name == "_" {
+		name = "report"
+	}
+	filename := fmt.Sprintf("%s_%s.csv", name, time.Now().Format("20060102"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
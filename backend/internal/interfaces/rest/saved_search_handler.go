@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type SavedSearchHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewSavedSearchHandler(svc *services.ServiceManager) *SavedSearchHandler {
+	return &SavedSearchHandler{svc: svc}
+}
+
+// GetSavedSearches handles GET /api/data/saved-searches
+func (h *SavedSearchHandler) GetSavedSearches(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SavedSearch.List(c.Request.Context(), user)
+	})
+}
+
+// CreateSavedSearch handles POST /api/data/saved-searches
+func (h *SavedSearchHandler) CreateSavedSearch(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var search models.SystemSavedSearch
+	HandleCreateEnvelope(c, "data", "Saved search created successfully", &search, func() error {
+		return h.svc.SavedSearch.Create(c.Request.Context(), &search, user)
+	})
+}
+
+// UpdateSavedSearch handles PATCH /api/data/saved-searches/:id
+func (h *SavedSearchHandler) UpdateSavedSearch(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemSavedSearch
+	HandleUpdateEnvelope(c, "data", "Saved search updated successfully", &updates, func() error {
+		return h.svc.SavedSearch.Update(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteSavedSearch handles DELETE /api/data/saved-searches/:id
+func (h *SavedSearchHandler) DeleteSavedSearch(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleDeleteEnvelope(c, "Saved search deleted successfully", func() error {
+		return h.svc.SavedSearch.Delete(c.Request.Context(), id, user)
+	})
+}
+
+// RunSavedSearch handles POST /api/data/saved-searches/:id/run
+func (h *SavedSearchHandler) RunSavedSearch(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SavedSearch.Run(c.Request.Context(), id, user)
+	})
+}
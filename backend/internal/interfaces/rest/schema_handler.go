@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	appErrors "github.com/nexuscrm/backend/pkg/errors"
@@ -14,11 +16,13 @@ import (
 
 // ==================== Schema Handlers ====================
 
-// GetSchemas handles GET /api/metadata/objects
+// GetSchemas handles GET /api/metadata/objects?modifiedSince=&limit=&offset=
 func (h *MetadataHandler) GetSchemas(c *gin.Context) {
-	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.GetSchemas(c.Request.Context()), nil
-	})
+	HandleMetadataList(c, func(s *models.ObjectMetadata) (string, time.Time) { return s.APIName, s.LastModifiedDate },
+		func() ([]*models.ObjectMetadata, error) {
+			ctx := c.Request.Context()
+			return h.svc.Translations.LocalizeSchemas(ctx, h.svc.GetSchemas(ctx)), nil
+		})
 }
 
 // GetSchema handles GET /api/metadata/objects/:apiName
@@ -32,7 +36,33 @@ func (h *MetadataHandler) GetSchema(c *gin.Context) {
 		if schema == nil {
 			return nil, appErrors.NewNotFoundError("Schema", apiName)
 		}
-		return schema, nil
+		return h.svc.Translations.LocalizeSchema(c.Request.Context(), schema), nil
+	})
+}
+
+// DescribeFull handles GET /api/metadata/objects/:apiName/describe-full?recordTypeId=&recordId=
+// and returns the schema, resolved layout, actions and record-type filtered picklist values
+// a record page needs in a single response
+func (h *MetadataHandler) DescribeFull(c *gin.Context) {
+	user := GetUserFromContext(c)
+	apiName := strings.ToLower(c.Param("apiName"))
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		ctx := c.Request.Context()
+		describe, err := h.svc.UIMetadata.DescribeRecordPage(ctx, apiName, c.Query("recordTypeId"), c.Query("recordId"), user)
+		if err != nil {
+			return nil, err
+		}
+		describe.Schema = h.svc.Translations.LocalizeSchema(ctx, describe.Schema)
+		sanitized := make([]*models.ActionMetadata, len(describe.Actions))
+		for i, a := range describe.Actions {
+			sanitized[i] = sanitizeAction(a)
+		}
+		describe.Actions = sanitized
+		for field, values := range describe.PicklistValues {
+			describe.PicklistValues[field] = h.svc.Translations.LocalizePicklistValues(ctx, describe.Schema.APIName, field, values)
+		}
+		return describe, nil
 	})
 }
 
@@ -220,6 +250,56 @@ func (h *MetadataHandler) DeleteField(c *gin.Context) {
 	})
 }
 
+// GetDependencies handles GET /api/metadata/:type/:apiName/dependencies, where type is
+// "objects" or "fields" and a field is named <object>.<field>
+func (h *MetadataHandler) GetDependencies(c *gin.Context) {
+	apiName := c.Param("apiName")
+	var objectAPIName, fieldAPIName string
+	switch c.Param("type") {
+	case "objects", constants.DependencyTargetObject:
+		objectAPIName = apiName
+	case "fields", constants.DependencyTargetField:
+		var ok bool
+		objectAPIName, fieldAPIName, ok = strings.Cut(apiName, ".")
+		if !ok || objectAPIName == "" || fieldAPIName == "" {
+			RespondAppError(c, appErrors.NewValidationError("apiName", "Fields are named <object>.<field>"))
+			return
+		}
+	default:
+		RespondAppError(c, appErrors.NewValidationError("type", "Dependencies are reported for objects and fields"))
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetDependencies(c.Request.Context(), objectAPIName, fieldAPIName)
+	})
+}
+
+// ==================== Deleted Metadata Handlers ====================
+
+// GetDeletedMetadata handles GET /api/metadata/deleted
+func (h *MetadataHandler) GetDeletedMetadata(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Metadata.GetDeletedMetadata(c.Request.Context())
+	})
+}
+
+// RestoreDeletedMetadata handles POST /api/metadata/deleted/:id/restore
+func (h *MetadataHandler) RestoreDeletedMetadata(c *gin.Context) {
+	if err := h.svc.Metadata.RestoreDeletedMetadata(c.Request.Context(), c.Param("id")); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{constants.FieldMessage: "Metadata restored successfully"})
+}
+
+// PurgeDeletedMetadata handles DELETE /api/metadata/deleted/:id
+func (h *MetadataHandler) PurgeDeletedMetadata(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Metadata purged successfully", func() error {
+		return h.svc.Metadata.PurgeDeletedMetadata(c.Request.Context(), c.Param("id"))
+	})
+}
+
 // ==================== Validation Rule Handlers ====================
 
 // CreateValidationRule handles POST /api/metadata/validation-rules
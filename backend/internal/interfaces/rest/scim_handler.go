@@ -0,0 +1,240 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	stderrors "errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// scimContentType is the media type of SCIM requests and responses (RFC 7644 §3.1)
+const scimContentType = "application/scim+json"
+
+// SCIMHandler serves the SCIM 2.0 provisioning API under /scim/v2. Identity providers
+// authenticate with a static bearer token (SCIM_TOKEN) rather than a user session.
+type SCIMHandler struct {
+	svc       *services.ServiceManager
+	tokenHash [32]byte
+}
+
+// NewSCIMHandler creates a SCIM handler accepting the given bearer token
+func NewSCIMHandler(svc *services.ServiceManager, token string) *SCIMHandler {
+	return &SCIMHandler{svc: svc, tokenHash: sha256.Sum256([]byte(token))}
+}
+
+// Authenticate rejects requests without the SCIM bearer token
+func (h *SCIMHandler) Authenticate(c *gin.Context) {
+	header := c.GetHeader("Authorization")
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	hash := sha256.Sum256([]byte(token))
+	if !ok || subtle.ConstantTimeCompare(hash[:], h.tokenHash[:]) != 1 {
+		h.respondError(c, errors.NewUnauthorizedError("Invalid SCIM bearer token"))
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// GetServiceProviderConfig handles GET /scim/v2/ServiceProviderConfig
+func (h *SCIMHandler) GetServiceProviderConfig(c *gin.Context) {
+	h.respond(c, http.StatusOK, gin.H{
+		"schemas":        []string{models.SCIMSchemaServiceProviderConfig},
+		"patch":          gin.H{"supported": true},
+		"bulk":           gin.H{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         gin.H{"supported": true, "maxResults": 200},
+		"changePassword": gin.H{"supported": true},
+		"sort":           gin.H{"supported": false},
+		"etag":           gin.H{"supported": false},
+		"authenticationSchemes": []gin.H{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "Static bearer token configured with SCIM_TOKEN",
+		}},
+	})
+}
+
+// ListUsers handles GET /scim/v2/Users
+func (h *SCIMHandler) ListUsers(c *gin.Context) {
+	result, err := h.svc.SCIM.ListUsers(c.Request.Context(), scimListQuery(c))
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// GetUser handles GET /scim/v2/Users/:id
+func (h *SCIMHandler) GetUser(c *gin.Context) {
+	result, err := h.svc.SCIM.GetUser(c.Request.Context(), c.Param("id"))
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// CreateUser handles POST /scim/v2/Users
+func (h *SCIMHandler) CreateUser(c *gin.Context) {
+	var user models.SCIMUser
+	if !h.bind(c, &user) {
+		return
+	}
+	result, err := h.svc.SCIM.CreateUser(c.Request.Context(), user)
+	h.respondResult(c, http.StatusCreated, result, err)
+}
+
+// ReplaceUser handles PUT /scim/v2/Users/:id
+func (h *SCIMHandler) ReplaceUser(c *gin.Context) {
+	var user models.SCIMUser
+	if !h.bind(c, &user) {
+		return
+	}
+	result, err := h.svc.SCIM.ReplaceUser(c.Request.Context(), c.Param("id"), user)
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// PatchUser handles PATCH /scim/v2/Users/:id
+func (h *SCIMHandler) PatchUser(c *gin.Context) {
+	var patch models.SCIMPatchRequest
+	if !h.bind(c, &patch) {
+		return
+	}
+	result, err := h.svc.SCIM.PatchUser(c.Request.Context(), c.Param("id"), patch)
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// DeleteUser handles DELETE /scim/v2/Users/:id
+func (h *SCIMHandler) DeleteUser(c *gin.Context) {
+	if err := h.svc.SCIM.DeleteUser(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListGroups handles GET /scim/v2/Groups
+func (h *SCIMHandler) ListGroups(c *gin.Context) {
+	result, err := h.svc.SCIM.ListGroups(c.Request.Context(), scimListQuery(c))
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// GetGroup handles GET /scim/v2/Groups/:id
+func (h *SCIMHandler) GetGroup(c *gin.Context) {
+	result, err := h.svc.SCIM.GetGroup(c.Request.Context(), c.Param("id"))
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// CreateGroup handles POST /scim/v2/Groups
+func (h *SCIMHandler) CreateGroup(c *gin.Context) {
+	var group models.SCIMGroup
+	if !h.bind(c, &group) {
+		return
+	}
+	result, err := h.svc.SCIM.CreateGroup(c.Request.Context(), group)
+	h.respondResult(c, http.StatusCreated, result, err)
+}
+
+// ReplaceGroup handles PUT /scim/v2/Groups/:id
+func (h *SCIMHandler) ReplaceGroup(c *gin.Context) {
+	var group models.SCIMGroup
+	if !h.bind(c, &group) {
+		return
+	}
+	result, err := h.svc.SCIM.ReplaceGroup(c.Request.Context(), c.Param("id"), group)
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// PatchGroup handles PATCH /scim/v2/Groups/:id
+func (h *SCIMHandler) PatchGroup(c *gin.Context) {
+	var patch models.SCIMPatchRequest
+	if !h.bind(c, &patch) {
+		return
+	}
+	result, err := h.svc.SCIM.PatchGroup(c.Request.Context(), c.Param("id"), patch)
+	h.respondResult(c, http.StatusOK, result, err)
+}
+
+// DeleteGroup handles DELETE /scim/v2/Groups/:id
+func (h *SCIMHandler) DeleteGroup(c *gin.Context) {
+	if err := h.svc.SCIM.DeleteGroup(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// scimListQuery reads the filter, startIndex and count query parameters
+func scimListQuery(c *gin.Context) models.SCIMListQuery {
+	q := models.SCIMListQuery{Filter: c.Query("filter"), StartIndex: 1}
+	if start, err := strconv.Atoi(c.Query("startIndex")); err == nil {
+		q.StartIndex = start
+	}
+	if count, err := strconv.Atoi(c.Query("count")); err == nil {
+		q.Count = count
+	}
+	return q
+}
+
+func (h *SCIMHandler) bind(c *gin.Context, obj interface{}) bool {
+	if err := c.ShouldBindJSON(obj); err != nil {
+		if tooLarge := bodyTooLarge(err); tooLarge != nil {
+			h.respondError(c, tooLarge)
+			return false
+		}
+		h.respond(c, http.StatusBadRequest, models.SCIMError{
+			Schemas:  []string{models.SCIMSchemaError},
+			Status:   strconv.Itoa(http.StatusBadRequest),
+			ScimType: "invalidSyntax",
+			Detail:   err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+func (h *SCIMHandler) respondResult(c *gin.Context, status int, result interface{}, err error) {
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	h.respond(c, status, result)
+}
+
+func (h *SCIMHandler) respond(c *gin.Context, status int, body interface{}) {
+	c.Header("Content-Type", scimContentType)
+	c.JSON(status, body)
+}
+
+// respondError writes an error in the SCIM error format (RFC 7644 §3.12)
+func (h *SCIMHandler) respondError(c *gin.Context, err error) {
+	status := errors.GetHTTPStatus(err)
+	if status >= 500 {
+		log.Printf("❌ SCIM ERROR [%d] %s %s: %s", status, c.Request.Method, c.Request.URL.Path, err.Error())
+	}
+	body := models.SCIMError{
+		Schemas: []string{models.SCIMSchemaError},
+		Status:  strconv.Itoa(status),
+		Detail:  err.Error(),
+	}
+
+	var validation *errors.ValidationError
+	var conflict *errors.ConflictError
+	switch {
+	case stderrors.As(err, &validation):
+		body.ScimType = services.SCIMInvalidValue
+		switch validation.Field {
+		case services.SCIMInvalidFilter, services.SCIMInvalidPath, services.SCIMInvalidValue, services.SCIMUniqueness:
+			body.ScimType = validation.Field
+			body.Detail = validation.Message
+		}
+		if body.ScimType == services.SCIMUniqueness {
+			status = http.StatusConflict
+			body.Status = strconv.Itoa(status)
+		}
+	case stderrors.As(err, &conflict):
+		body.ScimType = services.SCIMUniqueness
+	case status >= 500:
+		body.Detail = "Internal server error"
+	}
+	h.respond(c, status, body)
+}
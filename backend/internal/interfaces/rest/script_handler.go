@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type ScriptHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewScriptHandler(svc *services.ServiceManager) *ScriptHandler {
+	return &ScriptHandler{svc: svc}
+}
+
+// GetScripts handles GET /api/metadata/scripts
+func (h *ScriptHandler) GetScripts(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Scripts.GetScripts(c.Request.Context())
+	})
+}
+
+// GetScript handles GET /api/metadata/scripts/:name
+func (h *ScriptHandler) GetScript(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Scripts.GetScript(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateScript handles POST /api/metadata/scripts
+func (h *ScriptHandler) CreateScript(c *gin.Context) {
+	var script models.Script
+	HandleCreateEnvelope(c, "data", "Script created successfully", &script, func() error {
+		return h.svc.Scripts.CreateScript(c.Request.Context(), &script, GetUserFromContext(c))
+	})
+}
+
+// UpdateScript handles PUT /api/metadata/scripts/:name
+func (h *ScriptHandler) UpdateScript(c *gin.Context) {
+	var script models.Script
+	if !BindJSON(c, &script) {
+		return
+	}
+	updated, err := h.svc.Scripts.UpdateScript(c.Request.Context(), c.Param("name"), &script)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Script updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeleteScript handles DELETE /api/metadata/scripts/:name
+func (h *ScriptHandler) DeleteScript(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Script deleted successfully", func() error {
+		return h.svc.Scripts.DeleteScript(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// RunScript handles POST /api/metadata/scripts/:name/run
+func (h *ScriptHandler) RunScript(c *gin.Context) {
+	var req models.ScriptRunRequest
+	if c.Request.ContentLength > 0 && !BindJSON(c, &req) {
+		return
+	}
+	result, err := h.svc.Scripts.Run(c.Request.Context(), c.Param("name"), &req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
+
+// GetScriptLogs handles GET /api/metadata/scripts/:name/logs
+func (h *ScriptHandler) GetScriptLogs(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Scripts.GetLogs(c.Request.Context(), c.Param("name"))
+	})
+}
@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/infrastructure/persistence"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+)
+
+type SetupAuditHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewSetupAuditHandler(svc *services.ServiceManager) *SetupAuditHandler {
+	return &SetupAuditHandler{svc: svc}
+}
+
+// GetSetupAudit handles GET /api/admin/setup-audit?component_type=&component_name=&object=&actor_id=&action=&since=&until=&limit=&offset=
+func (h *SetupAuditHandler) GetSetupAudit(c *gin.Context) {
+	filter := persistence.SetupAuditFilter{
+		ComponentType: c.Query("component_type"),
+		ComponentName: c.Query("component_name"),
+		ObjectAPIName: c.Query("object"),
+		ActorID:       c.Query("actor_id"),
+		Action:        c.Query("action"),
+	}
+	for param, target := range map[string]**time.Time{"since": &filter.Since, "until": &filter.Until} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			RespondAppError(c, appErrors.NewValidationError(param, "must be an RFC 3339 timestamp"))
+			return
+		}
+		*target = &t
+	}
+	for param, target := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			RespondAppError(c, appErrors.NewValidationError(param, "must be a non-negative integer"))
+			return
+		}
+		*target = n
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SetupAudit.Query(c.Request.Context(), filter)
+	})
+}
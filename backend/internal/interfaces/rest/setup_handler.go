@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// setupTokenHeader may carry SETUP_TOKEN instead of the setup_token body field
+const setupTokenHeader = "X-Setup-Token"
+
+type SetupHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewSetupHandler(svc *services.ServiceManager) *SetupHandler {
+	return &SetupHandler{svc: svc}
+}
+
+// GetState handles GET /api/setup (no session): whether first-run setup is still open
+func (h *SetupHandler) GetState(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Setup.State(c.Request.Context())
+	})
+}
+
+// Complete handles POST /api/setup (no session, only while setup is open)
+// Body: { org_name, admin: { name, email, password }, locale, sample_data, setup_token };
+// the administrator then signs in through /api/auth/login
+func (h *SetupHandler) Complete(c *gin.Context) {
+	var req models.SetupRequest
+	if !BindJSONStrict(c, &req) {
+		return
+	}
+	if req.SetupToken == "" {
+		req.SetupToken = c.GetHeader(setupTokenHeader)
+	}
+	result, err := h.svc.Setup.Complete(c.Request.Context(), req)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		constants.FieldMessage: "Setup completed successfully",
+		"data":                 result,
+	})
+}
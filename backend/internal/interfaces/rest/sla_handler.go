@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type SLAHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewSLAHandler(svc *services.ServiceManager) *SLAHandler {
+	return &SLAHandler{svc: svc}
+}
+
+// ==================== Business Hours ====================
+
+// GetBusinessHours handles GET /api/metadata/business-hours
+func (h *SLAHandler) GetBusinessHours(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SLA.GetBusinessHours(c.Request.Context())
+	})
+}
+
+// GetBusinessHoursByName handles GET /api/metadata/business-hours/:name
+func (h *SLAHandler) GetBusinessHoursByName(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SLA.GetBusinessHoursByName(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateBusinessHours handles POST /api/metadata/business-hours
+func (h *SLAHandler) CreateBusinessHours(c *gin.Context) {
+	var bh models.BusinessHours
+	HandleCreateEnvelope(c, "data", "Business hours created successfully", &bh, func() error {
+		return h.svc.SLA.CreateBusinessHours(c.Request.Context(), &bh)
+	})
+}
+
+// UpdateBusinessHours handles PATCH /api/metadata/business-hours/:name
+func (h *SLAHandler) UpdateBusinessHours(c *gin.Context) {
+	var updates services.BusinessHoursUpdate
+	if !BindJSON(c, &updates) {
+		return
+	}
+	bh, err := h.svc.SLA.UpdateBusinessHours(c.Request.Context(), c.Param("name"), updates)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Business hours updated successfully",
+		"data":                 bh,
+	})
+}
+
+// DeleteBusinessHours handles DELETE /api/metadata/business-hours/:name
+func (h *SLAHandler) DeleteBusinessHours(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Business hours deleted successfully", func() error {
+		return h.svc.SLA.DeleteBusinessHours(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// AddHoliday handles POST /api/metadata/business-hours/:name/holidays
+func (h *SLAHandler) AddHoliday(c *gin.Context) {
+	var holiday models.Holiday
+	HandleCreateEnvelope(c, "data", "Holiday added successfully", &holiday, func() error {
+		return h.svc.SLA.AddHoliday(c.Request.Context(), c.Param("name"), &holiday)
+	})
+}
+
+// DeleteHoliday handles DELETE /api/metadata/business-hours/:name/holidays/:holidayId
+func (h *SLAHandler) DeleteHoliday(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Holiday deleted successfully", func() error {
+		return h.svc.SLA.DeleteHoliday(c.Request.Context(), c.Param("name"), c.Param("holidayId"))
+	})
+}
+
+// ==================== SLA Policies ====================
+
+// GetPolicies handles GET /api/metadata/sla-policies
+func (h *SLAHandler) GetPolicies(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SLA.GetPolicies(c.Request.Context())
+	})
+}
+
+// GetPolicy handles GET /api/metadata/sla-policies/:name
+func (h *SLAHandler) GetPolicy(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SLA.GetPolicy(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreatePolicy handles POST /api/metadata/sla-policies
+func (h *SLAHandler) CreatePolicy(c *gin.Context) {
+	var policy models.SLAPolicy
+	HandleCreateEnvelope(c, "data", "SLA policy created successfully", &policy, func() error {
+		return h.svc.SLA.CreatePolicy(c.Request.Context(), &policy)
+	})
+}
+
+// UpdatePolicy handles PUT /api/metadata/sla-policies/:name
+func (h *SLAHandler) UpdatePolicy(c *gin.Context) {
+	var policy models.SLAPolicy
+	if !BindJSON(c, &policy) {
+		return
+	}
+	updated, err := h.svc.SLA.UpdatePolicy(c.Request.Context(), c.Param("name"), &policy)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "SLA policy updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeletePolicy handles DELETE /api/metadata/sla-policies/:name
+func (h *SLAHandler) DeletePolicy(c *gin.Context) {
+	HandleDeleteEnvelope(c, "SLA policy deleted successfully", func() error {
+		return h.svc.SLA.DeletePolicy(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// GetRecordTimers handles GET /api/data/:objectApiName/:id/sla
+func (h *SLAHandler) GetRecordTimers(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.SLA.GetRecordTimers(c.Request.Context(), c.Param("objectApiName"), c.Param("id"), user)
+	})
+}
@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	appErrors "github.com/nexuscrm/backend/pkg/errors"
+)
+
+type StageHistoryHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewStageHistoryHandler(svc *services.ServiceManager) *StageHistoryHandler {
+	return &StageHistoryHandler{svc: svc}
+}
+
+// GetRecordHistory handles GET /api/data/:objectApiName/:id/stage-history
+func (h *StageHistoryHandler) GetRecordHistory(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.StageHistory.GetRecordHistory(c.Request.Context(), strings.ToLower(c.Param("objectApiName")), c.Param("id"), user)
+	})
+}
+
+// GetAnalytics handles GET /api/data/:objectApiName/stage-analytics?field=&from=&to=
+// (from and to are dates or RFC 3339 timestamps; to is exclusive)
+func (h *StageHistoryHandler) GetAnalytics(c *gin.Context) {
+	user := GetUserFromContext(c)
+	field := c.Query("field")
+	if field == "" {
+		RespondAppError(c, appErrors.NewValidationError("field", "field is required"))
+		return
+	}
+	var from, to *time.Time
+	for param, target := range map[string]**time.Time{"from": &from, "to": &to} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, raw); err != nil {
+				RespondAppError(c, appErrors.NewValidationError(param, "must be a date or an RFC 3339 timestamp"))
+				return
+			}
+		}
+		*target = &t
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.StageHistory.GetAnalytics(ReadContext(c), strings.ToLower(c.Param("objectApiName")), field, from, to, user)
+	})
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type StateMachineHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewStateMachineHandler(svc *services.ServiceManager) *StateMachineHandler {
+	return &StateMachineHandler{svc: svc}
+}
+
+// GetStateMachines handles GET /api/metadata/state-machines
+func (h *StateMachineHandler) GetStateMachines(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.StateMachines.GetStateMachines(c.Request.Context())
+	})
+}
+
+// GetObjectStateMachines handles GET /api/metadata/objects/:apiName/state-machines
+func (h *StateMachineHandler) GetObjectStateMachines(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.StateMachines.GetObjectStateMachines(c.Request.Context(), c.Param("apiName"))
+	})
+}
+
+// GetStateMachine handles GET /api/metadata/state-machines/:name
+func (h *StateMachineHandler) GetStateMachine(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.StateMachines.GetStateMachine(c.Request.Context(), c.Param("name"))
+	})
+}
+
+// CreateStateMachine handles POST /api/metadata/state-machines
+func (h *StateMachineHandler) CreateStateMachine(c *gin.Context) {
+	var machine models.StateMachine
+	HandleCreateEnvelope(c, "data", "State machine created successfully", &machine, func() error {
+		return h.svc.StateMachines.CreateStateMachine(c.Request.Context(), &machine)
+	})
+}
+
+// UpdateStateMachine handles PUT /api/metadata/state-machines/:name
+func (h *StateMachineHandler) UpdateStateMachine(c *gin.Context) {
+	var machine models.StateMachine
+	if !BindJSON(c, &machine) {
+		return
+	}
+	updated, err := h.svc.StateMachines.UpdateStateMachine(c.Request.Context(), c.Param("name"), &machine)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "State machine updated successfully",
+		"data":                 updated,
+	})
+}
+
+// DeleteStateMachine handles DELETE /api/metadata/state-machines/:name
+func (h *StateMachineHandler) DeleteStateMachine(c *gin.Context) {
+	HandleDeleteEnvelope(c, "State machine deleted successfully", func() error {
+		return h.svc.StateMachines.DeleteStateMachine(c.Request.Context(), c.Param("name"))
+	})
+}
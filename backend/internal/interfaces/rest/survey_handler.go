@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type SurveyHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewSurveyHandler(svc *services.ServiceManager) *SurveyHandler {
+	return &SurveyHandler{svc: svc}
+}
+
+// ListSurveys handles GET /api/surveys?active=true
+func (h *SurveyHandler) ListSurveys(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.ListSurveys(c.Request.Context(), c.Query("active") == "true")
+	})
+}
+
+// GetSurvey handles GET /api/surveys/:id
+func (h *SurveyHandler) GetSurvey(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.GetSurvey(c.Request.Context(), c.Param("id"))
+	})
+}
+
+// CreateSurvey handles POST /api/surveys
+func (h *SurveyHandler) CreateSurvey(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var survey models.SystemSurvey
+	HandleCreateEnvelope(c, "data", "Survey created successfully", &survey, func() error {
+		return h.svc.Surveys.CreateSurvey(c.Request.Context(), &survey, user)
+	})
+}
+
+// UpdateSurvey handles PUT /api/surveys/:id
+func (h *SurveyHandler) UpdateSurvey(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	var updates models.SystemSurvey
+	HandleUpdateEnvelope(c, "data", "Survey updated successfully", &updates, func() error {
+		return h.svc.Surveys.UpdateSurvey(c.Request.Context(), id, &updates, user)
+	})
+}
+
+// DeleteSurvey handles DELETE /api/surveys/:id
+func (h *SurveyHandler) DeleteSurvey(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Survey deleted successfully", func() error {
+		return h.svc.Surveys.DeleteSurvey(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// ListInvitations handles GET /api/surveys/:id/invitations
+func (h *SurveyHandler) ListInvitations(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.ListInvitations(c.Request.Context(), c.Param("id"), GetUserFromContext(c))
+	})
+}
+
+// CreateInvitation handles POST /api/surveys/:id/invitations
+// Body: { object_api_name, record_id, contact_id }; the response carries the link, shown only once
+func (h *SurveyHandler) CreateInvitation(c *gin.Context) {
+	var input models.CreateSurveyInvitationInput
+	if !BindJSONStrict(c, &input) {
+		return
+	}
+	link, err := h.svc.Surveys.CreateInvitation(c.Request.Context(), c.Param("id"), input, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		constants.FieldMessage: "Survey invitation created successfully",
+		"data":                 link,
+	})
+}
+
+// ListResponses handles GET /api/surveys/:id/responses?limit=&offset=
+func (h *SurveyHandler) ListResponses(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.ListResponses(c.Request.Context(), c.Param("id"), limit, offset, GetUserFromContext(c))
+	})
+}
+
+// ListRecordResponses handles GET /api/surveys/responses?object_api_name=case&record_id=...
+func (h *SurveyHandler) ListRecordResponses(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.ListRecordResponses(c.Request.Context(), strings.ToLower(c.Query("object_api_name")), c.Query("record_id"), GetUserFromContext(c))
+	})
+}
+
+// Analytics handles GET /api/surveys/:id/analytics?from=&to=&interval=day|week|month&object_api_name=
+// (from and to are dates or RFC 3339 timestamps; to is exclusive)
+func (h *SurveyHandler) Analytics(c *gin.Context) {
+	query := services.SurveyAnalyticsQuery{
+		Interval:      c.Query("interval"),
+		ObjectAPIName: strings.ToLower(c.Query("object_api_name")),
+	}
+	for param, target := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, raw); err != nil {
+				RespondAppError(c, errors.NewValidationError(param, "must be a date or an RFC 3339 timestamp"))
+				return
+			}
+		}
+		*target = &t
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.Analytics(c.Request.Context(), c.Param("id"), query, GetUserFromContext(c))
+	})
+}
+
+// GetPublicSurvey handles GET /api/public/surveys/:token (no session)
+func (h *SurveyHandler) GetPublicSurvey(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Surveys.GetPublicSurvey(c.Request.Context(), c.Param("token"))
+	})
+}
+
+// SubmitResponse handles POST /api/public/surveys/:token (no session)
+// Body: { score, answers: { key: value }, comment }
+func (h *SurveyHandler) SubmitResponse(c *gin.Context) {
+	var submission models.SurveySubmission
+	if !BindJSONStrict(c, &submission) {
+		return
+	}
+	if _, err := h.svc.Surveys.SubmitResponse(c.Request.Context(), c.Param("token"), submission); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{constants.FieldMessage: "Thank you for your feedback"})
+}
@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type SyncHandler struct {
+	svc         *services.ServiceManager
+	frontendURL string
+}
+
+// NewSyncHandler creates the handler; frontendURL is where Callback sends the browser back
+func NewSyncHandler(svc *services.ServiceManager, frontendURL string) *SyncHandler {
+	return &SyncHandler{svc: svc, frontendURL: frontendURL}
+}
+
+// GetProviders handles GET /api/sync/providers
+func (h *SyncHandler) GetProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"data": h.svc.ActivitySync.Providers()})
+}
+
+// Authorize handles POST /api/sync/providers/:provider/authorize
+func (h *SyncHandler) Authorize(c *gin.Context) {
+	user := GetUserFromContext(c)
+	authURL, err := h.svc.ActivitySync.AuthorizeURL(c.Request.Context(), constants.SyncProvider(c.Param("provider")), user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": gin.H{"url": authURL}})
+}
+
+// Callback handles GET /api/sync/oauth/callback, the redirect URL registered with the
+// providers. The encrypted state identifies the user, so no session is required. With
+// FRONTEND_URL set the browser is sent back to the app.
+func (h *SyncHandler) Callback(c *gin.Context) {
+	var connector *models.SyncConnector
+	var err error
+	if providerErr := c.Query("error"); providerErr != "" {
+		err = errors.NewValidationError("code", "Authorization was not granted: "+providerErr)
+	} else {
+		connector, err = h.svc.ActivitySync.CompleteAuthorization(c.Request.Context(), c.Query("state"), c.Query("code"))
+	}
+
+	frontendURL := h.frontendURL
+	if frontendURL == "" {
+		if err != nil {
+			RespondAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			constants.FieldMessage: "Account connected successfully",
+			"data":                 connector,
+		})
+		return
+	}
+
+	params := url.Values{}
+	if err != nil {
+		params.Set("sync_error", err.Error())
+	} else {
+		params.Set("sync_connected", string(connector.Provider))
+	}
+	c.Redirect(http.StatusFound, frontendURL+"/?"+params.Encode())
+}
+
+// GetConnectors handles GET /api/sync/connectors
+func (h *SyncHandler) GetConnectors(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.ActivitySync.ListConnectors(c.Request.Context(), user)
+	})
+}
+
+// UpdateConnector handles PATCH /api/sync/connectors/:id
+func (h *SyncHandler) UpdateConnector(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var update models.SyncConnectorUpdate
+	if !BindJSON(c, &update) {
+		return
+	}
+	connector, err := h.svc.ActivitySync.UpdateConnector(c.Request.Context(), c.Param("id"), update, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Connector updated successfully",
+		"data":                 connector,
+	})
+}
+
+// DeleteConnector handles DELETE /api/sync/connectors/:id
+func (h *SyncHandler) DeleteConnector(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleDeleteEnvelope(c, "Connector deleted successfully", func() error {
+		return h.svc.ActivitySync.DeleteConnector(c.Request.Context(), c.Param("id"), user)
+	})
+}
+
+// RunConnector handles POST /api/sync/connectors/:id/run
+func (h *SyncHandler) RunConnector(c *gin.Context) {
+	user := GetUserFromContext(c)
+	result, err := h.svc.ActivitySync.SyncConnector(c.Request.Context(), c.Param("id"), user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Sync completed",
+		"data":                 result,
+	})
+}
+
+// GetActivities handles GET /api/sync/activities/:objectApiName/:recordId
+func (h *SyncHandler) GetActivities(c *gin.Context) {
+	user := GetUserFromContext(c)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.ActivitySync.GetActivities(c.Request.Context(), c.Param("objectApiName"), c.Param("recordId"), user)
+	})
+}
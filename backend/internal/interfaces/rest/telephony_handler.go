@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+type TelephonyHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewTelephonyHandler(svc *services.ServiceManager) *TelephonyHandler {
+	return &TelephonyHandler{svc: svc}
+}
+
+// GetProviders handles GET /api/telephony/providers
+func (h *TelephonyHandler) GetProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"data": h.svc.Telephony.Providers()})
+}
+
+// Dial handles POST /api/telephony/dial
+func (h *TelephonyHandler) Dial(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var req models.ClickToDialRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	result, err := h.svc.Telephony.Dial(c.Request.Context(), req, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Call placed",
+		"data":                 result,
+	})
+}
+
+// LogCall handles POST /api/telephony/calls
+func (h *TelephonyHandler) LogCall(c *gin.Context) {
+	user := GetUserFromContext(c)
+	var req models.CallLogRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	call, err := h.svc.Telephony.LogCall(c.Request.Context(), req, user)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		constants.FieldMessage: "Call logged successfully",
+		"data":                 call,
+	})
+}
+
+// Webhook handles POST /api/telephony/webhooks/:provider. Providers sign their requests, so
+// no session is required.
+func (h *TelephonyHandler) Webhook(c *gin.Context) {
+	provider := constants.TelephonyProvider(c.Param("provider"))
+	if err := h.svc.Telephony.HandleEvent(c.Request.Context(), provider, c.Request); err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+)
+
+type TimelineHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewTimelineHandler(svc *services.ServiceManager) *TimelineHandler {
+	return &TimelineHandler{svc: svc}
+}
+
+// GetTimeline handles GET /api/data/:objectApiName/:id/timeline?types=comment,email&cursor=&limit=25
+// (types is a comma-separated list; cursor is the next_cursor of the previous page)
+func (h *TimelineHandler) GetTimeline(c *gin.Context) {
+	user := GetUserFromContext(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	q := services.TimelineQuery{Cursor: c.Query("cursor"), Limit: limit}
+	if types := c.Query("types"); types != "" {
+		q.Types = strings.Split(types, ",")
+	}
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Timeline.GetTimeline(ReadContext(c), strings.ToLower(c.Param("objectApiName")), c.Param("id"), q, user)
+	})
+}
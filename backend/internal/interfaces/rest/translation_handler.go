@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/pkg/errors"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// maxTranslationFileSize caps uploaded translation files
+const maxTranslationFileSize = 10 << 20
+
+type TranslationHandler struct {
+	svc *services.ServiceManager
+}
+
+func NewTranslationHandler(svc *services.ServiceManager) *TranslationHandler {
+	return &TranslationHandler{svc: svc}
+}
+
+// GetLocales handles GET /api/metadata/translations
+func (h *TranslationHandler) GetLocales(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Translations.GetLocales(), nil
+	})
+}
+
+// GetWorkbench handles GET /api/metadata/translations/:locale?type=Field
+func (h *TranslationHandler) GetWorkbench(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Translations.GetWorkbench(c.Request.Context(), c.Param("locale"), c.Query("type"))
+	})
+}
+
+// SaveTranslations handles PUT /api/metadata/translations/:locale
+func (h *TranslationHandler) SaveTranslations(c *gin.Context) {
+	var req struct {
+		Translations []*models.Translation `json:"translations" binding:"required"`
+	}
+	if !BindJSON(c, &req) {
+		return
+	}
+	saved, err := h.svc.Translations.Save(c.Request.Context(), c.Param("locale"), req.Translations)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Translations saved successfully",
+		"data":                 gin.H{"saved": saved},
+	})
+}
+
+// DeleteLocale handles DELETE /api/metadata/translations/:locale
+func (h *TranslationHandler) DeleteLocale(c *gin.Context) {
+	HandleDeleteEnvelope(c, "Translations deleted successfully", func() error {
+		return h.svc.Translations.DeleteLocale(c.Request.Context(), c.Param("locale"))
+	})
+}
+
+// ExportTranslations handles GET /api/metadata/translations/:locale/export (JSON download)
+func (h *TranslationHandler) ExportTranslations(c *gin.Context) {
+	file, err := h.svc.Translations.Export(c.Request.Context(), c.Param("locale"))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	body, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		RespondAppError(c, errors.NewInternalError("Failed to encode translations", err))
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="translations_%s.json"`, file.Locale))
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// ImportTranslations handles POST /api/metadata/translations/:locale/import. It accepts an
+// exported translation file either as a multipart "file" upload or as the request body.
+func (h *TranslationHandler) ImportTranslations(c *gin.Context) {
+	var file services.TranslationFile
+	if upload, err := c.FormFile("file"); err == nil {
+		if upload.Size > maxTranslationFileSize {
+			RespondAppError(c, errors.NewValidationError("file", "Translation file is too large"))
+			return
+		}
+		f, err := upload.Open()
+		if err != nil {
+			RespondAppError(c, errors.NewValidationError("file", "Failed to read translation file"))
+			return
+		}
+		defer f.Close()
+		if err := json.NewDecoder(f).Decode(&file); err != nil {
+			RespondAppError(c, errors.NewValidationError("file", fmt.Sprintf("Invalid translation file: %v", err)))
+			return
+		}
+	} else if !BindJSON(c, &file) {
+		return
+	}
+	file.Locale = c.Param("locale")
+
+	imported, err := h.svc.Translations.Import(c.Request.Context(), &file)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: fmt.Sprintf("Imported %d translations", imported),
+		"data":                 gin.H{"imported": imported},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/backend/internal/application/services"
@@ -22,11 +23,13 @@ func NewUIHandler(svc *services.ServiceManager) *UIHandler {
 
 // ==================== App Handlers ====================
 
-// GetApps handles GET /api/metadata/apps
+// GetApps handles GET /api/metadata/apps?modifiedSince=&limit=&offset=
 func (h *UIHandler) GetApps(c *gin.Context) {
-	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.UIMetadata.GetApps(c.Request.Context()), nil
-	})
+	HandleMetadataList(c, func(a *models.AppConfig) (string, time.Time) { return a.ID, a.LastModifiedDate },
+		func() ([]*models.AppConfig, error) {
+			ctx := c.Request.Context()
+			return h.svc.Translations.LocalizeApps(ctx, h.svc.UIMetadata.GetApps(ctx)), nil
+		})
 }
 
 // CreateApp handles POST /api/metadata/apps
@@ -102,7 +105,7 @@ func (h *UIHandler) ActivateTheme(c *gin.Context) {
 
 // ==================== Layout Handlers ====================
 
-// GetLayout handles GET /api/metadata/layouts/:objectName
+// GetLayout handles GET /api/metadata/layouts/:objectName?recordTypeId=
 func (h *UIHandler) GetLayout(c *gin.Context) {
 	user := GetUserFromContext(c)
 	objectName := c.Param("objectName")
@@ -113,7 +116,7 @@ func (h *UIHandler) GetLayout(c *gin.Context) {
 		if user != nil {
 			profileID = &user.ProfileID
 		}
-		layout := h.svc.UIMetadata.GetLayout(c.Request.Context(), objectName, profileID)
+		layout := h.svc.UIMetadata.GetLayout(c.Request.Context(), objectName, profileID, c.Query("recordTypeId"))
 		if layout == nil {
 			return nil, appErrors.NewNotFoundError("Layout", objectName)
 		}
@@ -121,6 +124,18 @@ func (h *UIHandler) GetLayout(c *gin.Context) {
 	})
 }
 
+// GetResolvedLayout handles GET /api/metadata/layouts/:objectName/resolved?recordId=
+// and returns the layout with visibility conditions evaluated for the record and user
+func (h *UIHandler) GetResolvedLayout(c *gin.Context) {
+	user := GetUserFromContext(c)
+	objectName := c.Param("objectName")
+	recordID := c.Query("recordId")
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.UIMetadata.ResolveLayout(c.Request.Context(), objectName, recordID, user)
+	})
+}
+
 // SaveLayout handles POST /api/metadata/layouts
 func (h *UIHandler) SaveLayout(c *gin.Context) {
 	var layout models.PageLayout
@@ -190,6 +205,7 @@ func (h *UIHandler) GetDashboard(c *gin.Context) {
 
 // CreateDashboard handles POST /api/metadata/dashboards
 func (h *UIHandler) CreateDashboard(c *gin.Context) {
+	user := GetUserFromContext(c)
 	var dashboard models.DashboardConfig
 	HandleCreateEnvelope(c, "data", "Dashboard created successfully", &dashboard, func() error {
 		// Strict Simplification: Do not allow widgets during creation.
@@ -197,16 +213,17 @@ func (h *UIHandler) CreateDashboard(c *gin.Context) {
 		if len(dashboard.Widgets) > 0 {
 			return appErrors.NewValidationError("widgets", "Dashboard creation with widgets is not supported. Please create the dashboard first, then add widgets.")
 		}
-		return h.svc.UIMetadata.CreateDashboard(c.Request.Context(), &dashboard)
+		return h.svc.UIMetadata.CreateDashboard(c.Request.Context(), &dashboard, user)
 	})
 }
 
 // UpdateDashboard handles PATCH /api/metadata/dashboards/:id
 func (h *UIHandler) UpdateDashboard(c *gin.Context) {
+	user := GetUserFromContext(c)
 	id := c.Param("id")
 	var updates models.DashboardConfig
 	HandleUpdateEnvelope(c, "data", "Dashboard updated successfully", &updates, func() error {
-		return h.svc.UIMetadata.UpdateDashboard(c.Request.Context(), id, &updates)
+		return h.svc.UIMetadata.UpdateDashboard(c.Request.Context(), id, &updates, user)
 	})
 }
 
@@ -218,22 +235,39 @@ func (h *UIHandler) DeleteDashboard(c *gin.Context) {
 	})
 }
 
+// RunDashboard handles POST /api/metadata/dashboards/:id/run
+func (h *UIHandler) RunDashboard(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+
+	var req models.DashboardRunRequest
+	if c.Request.ContentLength > 0 && !BindJSON(c, &req) {
+		return
+	}
+
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svc.Dashboards.Run(ReadContext(c), id, req, user)
+	})
+}
+
 // ==================== List View Handlers ====================
 
 // GetListViews handles GET /api/metadata/listviews?objectApiName=X
 func (h *UIHandler) GetListViews(c *gin.Context) {
+	user := GetUserFromContext(c)
 	objectAPIName := c.Query("objectApiName")
 	if objectAPIName == "" {
 		RespondAppError(c, appErrors.NewValidationError("objectApiName", "query parameter is required"))
 		return
 	}
 	HandleGetEnvelope(c, "data", func() (interface{}, error) {
-		return h.svc.UIMetadata.GetListViews(c.Request.Context(), objectAPIName), nil
+		return h.svc.UIMetadata.GetListViews(c.Request.Context(), objectAPIName, user), nil
 	})
 }
 
 // CreateListView handles POST /api/metadata/listviews
 func (h *UIHandler) CreateListView(c *gin.Context) {
+	user := GetUserFromContext(c)
 	var view models.ListView
 	HandleCreateEnvelope(c, "data", "List view created successfully", &view, func() error {
 		if view.ObjectAPIName == "" {
@@ -242,24 +276,39 @@ func (h *UIHandler) CreateListView(c *gin.Context) {
 		if view.Label == "" {
 			return appErrors.NewValidationError("label", "is required")
 		}
-		return h.svc.Metadata.CreateListView(c.Request.Context(), &view)
+		return h.svc.Metadata.CreateListView(c.Request.Context(), &view, user)
 	})
 }
 
 // UpdateListView handles PATCH /api/metadata/listviews/:id
 func (h *UIHandler) UpdateListView(c *gin.Context) {
+	user := GetUserFromContext(c)
 	id := c.Param("id")
 	var updates models.ListView
 	HandleUpdateEnvelope(c, "data", "List view updated successfully", &updates, func() error {
-		return h.svc.Metadata.UpdateListView(c.Request.Context(), id, &updates)
+		return h.svc.Metadata.UpdateListView(c.Request.Context(), id, &updates, user)
 	})
 }
 
 // DeleteListView handles DELETE /api/metadata/listviews/:id
 func (h *UIHandler) DeleteListView(c *gin.Context) {
+	user := GetUserFromContext(c)
 	id := c.Param("id")
 	HandleDeleteEnvelope(c, "List view deleted successfully", func() error {
-		return h.svc.Metadata.DeleteListView(c.Request.Context(), id)
+		return h.svc.Metadata.DeleteListView(c.Request.Context(), id, user)
+	})
+}
+
+// GetListViewFooter handles GET /api/metadata/listviews/:id/footer
+func (h *UIHandler) GetListViewFooter(c *gin.Context) {
+	user := GetUserFromContext(c)
+	id := c.Param("id")
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		view, err := h.svc.Metadata.GetListView(c.Request.Context(), id, user)
+		if err != nil {
+			return nil, err
+		}
+		return h.svc.QuerySvc.ComputeListViewFooter(c.Request.Context(), view, user)
 	})
 }
 
@@ -27,6 +27,10 @@ type RegisterRequest struct {
 	Password  string `json:"password" binding:"required"`
 	ProfileId string `json:"profile_id"`
 	RoleId    string `json:"role_id"`
+	UserType  string `json:"user_type"`
+	ContactID string `json:"contact_id"`
+	AccountID string `json:"account_id"`
+	Locale    string `json:"locale"`
 }
 
 // Register handles POST /api/auth/register
@@ -47,6 +51,10 @@ func (h *UserHandler) Register(c *gin.Context) {
 		Password:  req.Password,
 		ProfileID: req.ProfileId,
 		RoleID:    req.RoleId,
+		UserType:  req.UserType,
+		ContactID: req.ContactID,
+		AccountID: req.AccountID,
+		Locale:    req.Locale,
 	})
 
 	if err != nil {
@@ -58,22 +66,27 @@ func (h *UserHandler) Register(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{
 		constants.FieldMessage: "User created successfully",
 		"data": gin.H{
-			constants.FieldID:        user.ID,
-			constants.FieldName:      user.Name,
-			constants.FieldEmail:     user.Email,
-			constants.FieldProfileID: user.ProfileID,
+			constants.FieldID:               user.ID,
+			constants.FieldName:             user.Name,
+			constants.FieldEmail:            user.Email,
+			constants.FieldProfileID:        user.ProfileID,
+			constants.FieldSysUser_UserType: user.UserType,
 		},
 	})
 }
 
 // UpdateUserRequest represents update user request
 type UpdateUserRequest struct {
-	Name      string `json:"name"`
-	Email     string `json:"email"`
-	Password  string `json:"password"`
-	ProfileId string `json:"profile_id"`
-	RoleId    string `json:"role_id"`
-	IsActive  *bool  `json:"is_active"`
+	Name      string  `json:"name"`
+	Email     string  `json:"email"`
+	Password  string  `json:"password"`
+	ProfileId string  `json:"profile_id"`
+	RoleId    string  `json:"role_id"`
+	IsActive  *bool   `json:"is_active"`
+	UserType  string  `json:"user_type"`
+	ContactID *string `json:"contact_id"`
+	AccountID *string `json:"account_id"`
+	Locale    *string `json:"locale"`
 }
 
 // UpdateUser handles PUT /api/auth/users/:id
@@ -92,6 +105,10 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 			ProfileID: req.ProfileId,
 			RoleID:    req.RoleId,
 			IsActive:  req.IsActive,
+			UserType:  req.UserType,
+			ContactID: req.ContactID,
+			AccountID: req.AccountID,
+			Locale:    req.Locale,
 		})
 	})
 }
@@ -103,7 +120,31 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 		if userID == "" {
 			return errors.NewValidationError(constants.FieldID, "is required")
 		}
-		return h.svcMgr.Auth.DeleteUser(c.Request.Context(), userID)
+		return h.svcMgr.Deactivation.DeleteUser(c.Request.Context(), userID, GetUserFromContext(c))
+	})
+}
+
+// GetUserOwnership handles GET /api/auth/users/:id/ownership
+func (h *UserHandler) GetUserOwnership(c *gin.Context) {
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svcMgr.Deactivation.OwnershipReport(c.Request.Context(), c.Param(constants.FieldID), GetUserFromContext(c))
+	})
+}
+
+// DeactivateUser handles POST /api/auth/users/:id/deactivate
+func (h *UserHandler) DeactivateUser(c *gin.Context) {
+	var req models.UserDeactivationRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+	report, err := h.svcMgr.Deactivation.Deactivate(c.Request.Context(), c.Param(constants.FieldID), req, GetUserFromContext(c))
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "User deactivated successfully",
+		"data":                 report,
 	})
 }
 
@@ -144,7 +185,9 @@ func (h *UserHandler) UpdateProfilePermissions(c *gin.Context) {
 	}
 
 	// Helper handles binding
-	HandleUpdateEnvelope(c, "", "Permissions updated successfully", &perms, func() error {
+	HandleUpdateEnvelope(c, "", "Permissions updated successfully", &perms, h.auditPermissions(c, constants.SetupComponentProfileObjectPerms, profileID, func() (interface{}, error) {
+		return h.svcMgr.Permissions.GetObjectPermissions(profileID)
+	}, func() error {
 		for _, p := range perms {
 			perm := models.SystemObjectPerms{
 				ProfileID:     &profileID,
@@ -161,7 +204,7 @@ func (h *UserHandler) UpdateProfilePermissions(c *gin.Context) {
 			}
 		}
 		return nil
-	})
+	}))
 }
 
 // GetProfileFieldPermissions handles GET /api/auth/profiles/:id/permissions/fields
@@ -183,7 +226,9 @@ func (h *UserHandler) UpdateProfileFieldPermissions(c *gin.Context) {
 		AllowEdit     bool   `json:"allow_edit"`
 	}
 
-	HandleUpdateEnvelope(c, "", "Field permissions updated successfully", &perms, func() error {
+	HandleUpdateEnvelope(c, "", "Field permissions updated successfully", &perms, h.auditPermissions(c, constants.SetupComponentProfileFieldPerms, profileID, func() (interface{}, error) {
+		return h.svcMgr.Permissions.GetFieldPermissions(profileID)
+	}, func() error {
 		for _, p := range perms {
 			perm := models.SystemFieldPerms{
 				ProfileID:     &profileID,
@@ -197,7 +242,7 @@ func (h *UserHandler) UpdateProfileFieldPermissions(c *gin.Context) {
 			}
 		}
 		return nil
-	})
+	}))
 }
 
 // ==================== Permission Set Permissions ====================
@@ -224,7 +269,9 @@ func (h *UserHandler) UpdatePermissionSetPermissions(c *gin.Context) {
 		ModifyAll     bool   `json:"modify_all"`
 	}
 
-	HandleUpdateEnvelope(c, "", "Permissions updated successfully", &perms, func() error {
+	HandleUpdateEnvelope(c, "", "Permissions updated successfully", &perms, h.auditPermissions(c, constants.SetupComponentPermissionSetObjectPerms, permSetID, func() (interface{}, error) {
+		return h.svcMgr.Permissions.GetPermissionSetObjectPermissions(permSetID)
+	}, func() error {
 		for _, p := range perms {
 			perm := models.SystemObjectPerms{
 				PermissionSetID: &permSetID,
@@ -241,7 +288,7 @@ func (h *UserHandler) UpdatePermissionSetPermissions(c *gin.Context) {
 			}
 		}
 		return nil
-	})
+	}))
 }
 
 // GetPermissionSetFieldPermissions handles GET /api/auth/permission-sets/:id/permissions/fields
@@ -263,7 +310,9 @@ func (h *UserHandler) UpdatePermissionSetFieldPermissions(c *gin.Context) {
 		AllowEdit     bool   `json:"allow_edit"`
 	}
 
-	HandleUpdateEnvelope(c, "", "Field permissions updated successfully", &perms, func() error {
+	HandleUpdateEnvelope(c, "", "Field permissions updated successfully", &perms, h.auditPermissions(c, constants.SetupComponentPermissionSetFieldPerms, permSetID, func() (interface{}, error) {
+		return h.svcMgr.Permissions.GetPermissionSetFieldPermissions(permSetID)
+	}, func() error {
 		for _, p := range perms {
 			perm := models.SystemFieldPerms{
 				PermissionSetID: &permSetID,
@@ -277,9 +326,80 @@ func (h *UserHandler) UpdatePermissionSetFieldPermissions(c *gin.Context) {
 			}
 		}
 		return nil
+	}))
+}
+
+// GetProfileQueryLimits handles GET /api/auth/profiles/:id/query-limits
+func (h *UserHandler) GetProfileQueryLimits(c *gin.Context) {
+	profileID := c.Param(constants.FieldID)
+	HandleGetEnvelope(c, "data", func() (interface{}, error) {
+		return h.svcMgr.QueryGovernor.GetProfileLimits(c.Request.Context(), profileID)
 	})
 }
 
+// UpdateProfileQueryLimits handles PUT /api/auth/profiles/:id/query-limits
+func (h *UserHandler) UpdateProfileQueryLimits(c *gin.Context) {
+	profileID := c.Param(constants.FieldID)
+	var input models.SystemQueryGovernor
+	if !BindJSON(c, &input) {
+		return
+	}
+	ctx := c.Request.Context()
+	before, err := h.svcMgr.QueryGovernor.GetProfileLimits(ctx, profileID)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	after, err := h.svcMgr.QueryGovernor.SaveProfileLimits(ctx, profileID, &input)
+	if err != nil {
+		RespondAppError(c, err)
+		return
+	}
+	h.svcMgr.SetupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentProfileQueryLimits, profileID, "", before.Effective, after.Effective)
+	c.JSON(http.StatusOK, gin.H{
+		constants.FieldMessage: "Query limits updated successfully",
+		"data":                 after,
+	})
+}
+
+// DeleteProfileQueryLimits handles DELETE /api/auth/profiles/:id/query-limits, returning the
+// profile to the default limits
+func (h *UserHandler) DeleteProfileQueryLimits(c *gin.Context) {
+	profileID := c.Param(constants.FieldID)
+	HandleDeleteEnvelope(c, "Query limits reset to defaults", func() error {
+		ctx := c.Request.Context()
+		before, err := h.svcMgr.QueryGovernor.GetProfileLimits(ctx, profileID)
+		if err != nil {
+			return err
+		}
+		if err := h.svcMgr.QueryGovernor.DeleteProfileLimits(ctx, profileID); err != nil {
+			return err
+		}
+		h.svcMgr.SetupAudit.Record(ctx, constants.SetupActionUpdate, constants.SetupComponentProfileQueryLimits, profileID, "", before.Effective, before.Defaults)
+		return nil
+	})
+}
+
+// auditPermissions wraps a permission update so the permissions loaded before and after it
+// are recorded in the setup audit trail
+func (h *UserHandler) auditPermissions(c *gin.Context, componentType, name string, load func() (interface{}, error), update func() error) func() error {
+	return func() error {
+		before, err := load()
+		if err != nil {
+			return err
+		}
+		if err := update(); err != nil {
+			return err
+		}
+		after, err := load()
+		if err != nil {
+			return err
+		}
+		h.svcMgr.SetupAudit.Record(c.Request.Context(), constants.SetupActionUpdate, componentType, name, "", before, after)
+		return nil
+	}
+}
+
 // ==================== Effective Permissions (Admin View) ====================
 
 // GetUserEffectivePermissions handles GET /api/auth/users/:id/permissions/effective
@@ -325,6 +445,7 @@ func (h *UserHandler) CreatePermissionSet(c *gin.Context) {
 		RespondAppError(c, err)
 		return
 	}
+	h.svcMgr.SetupAudit.Record(c.Request.Context(), constants.SetupActionCreate, constants.SetupComponentPermissionSet, id, "", nil, req)
 
 	c.JSON(http.StatusCreated, gin.H{
 		constants.FieldMessage: "Permission Set created successfully",
@@ -343,7 +464,11 @@ func (h *UserHandler) UpdatePermissionSet(c *gin.Context) {
 	id := c.Param("id")
 	var req UpdatePermissionSetRequest
 	HandleUpdateEnvelope(c, "", "Permission Set updated successfully", &req, func() error {
-		return h.svcMgr.Permissions.UpdatePermissionSet(id, req.Name, req.Label, req.Description, req.IsActive)
+		if err := h.svcMgr.Permissions.UpdatePermissionSet(id, req.Name, req.Label, req.Description, req.IsActive); err != nil {
+			return err
+		}
+		h.svcMgr.SetupAudit.Record(c.Request.Context(), constants.SetupActionUpdate, constants.SetupComponentPermissionSet, id, "", nil, req)
+		return nil
 	})
 }
 
@@ -351,6 +476,10 @@ func (h *UserHandler) UpdatePermissionSet(c *gin.Context) {
 func (h *UserHandler) DeletePermissionSet(c *gin.Context) {
 	id := c.Param("id")
 	HandleDeleteEnvelope(c, "Permission Set deleted successfully", func() error {
-		return h.svcMgr.Permissions.DeletePermissionSet(id)
+		if err := h.svcMgr.Permissions.DeletePermissionSet(id); err != nil {
+			return err
+		}
+		h.svcMgr.SetupAudit.Record(c.Request.Context(), constants.SetupActionDelete, constants.SetupComponentPermissionSet, id, "", nil, nil)
+		return nil
 	})
 }
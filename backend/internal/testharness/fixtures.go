@@ -0,0 +1,105 @@
+package testharness
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// UserOption adjusts the request a fixture user is created from
+type UserOption func(*services.CreateUserRequest)
+
+// WithProfile creates the user with a profile other than the standard user profile
+func WithProfile(profileID string) UserOption {
+	return func(req *services.CreateUserRequest) { req.ProfileID = profileID }
+}
+
+// WithRole places the user in the role hierarchy
+func WithRole(roleID string) UserOption {
+	return func(req *services.CreateUserRequest) { req.RoleID = roleID }
+}
+
+// CreateUser creates an active standard user and deletes it when the test finishes.
+// The returned session can be passed to services to act as that user.
+func (h *Harness) CreateUser(t testing.TB, opts ...UserOption) *models.UserSession {
+	t.Helper()
+
+	name := UniqueName("user")
+	req := services.CreateUserRequest{
+		Name:     "Test " + name,
+		Email:    name + "@testharness.example.com",
+		Password: fixturePassword,
+	}
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	ctx := h.Context(t)
+	user, err := h.Services.Auth.CreateUser(ctx, req)
+	if err != nil {
+		t.Fatalf("testharness: create user %s: %v", req.Email, err)
+	}
+	t.Cleanup(func() {
+		if err := h.Services.Auth.DeleteUser(ctx, user.ID); err != nil {
+			t.Errorf("testharness: delete user %s: %v", user.ID, err)
+		}
+	})
+	return user
+}
+
+// Field describes a custom field for CreateObject
+func Field(apiName string, fieldType models.FieldType) models.FieldMetadata {
+	return models.FieldMetadata{APIName: apiName, Label: apiName, Type: fieldType}
+}
+
+// CreateObject creates a custom object with a unique API name derived from prefix and the
+// given fields, and drops it when the test finishes
+func (h *Harness) CreateObject(t testing.TB, prefix string, fields ...models.FieldMetadata) *models.ObjectMetadata {
+	t.Helper()
+
+	apiName := UniqueName(prefix)
+	obj := &models.ObjectMetadata{
+		APIName:      apiName,
+		Label:        apiName,
+		PluralLabel:  apiName + "s",
+		SharingModel: constants.SharingModelPublicReadWrite,
+		Fields:       fields,
+	}
+
+	ctx := h.Context(t)
+	if err := h.Services.Metadata.CreateSchema(ctx, obj); err != nil {
+		t.Fatalf("testharness: create object %s: %v", apiName, err)
+	}
+	t.Cleanup(func() {
+		if err := h.Services.Metadata.DeleteSchema(ctx, apiName); err != nil {
+			t.Errorf("testharness: delete object %s: %v", apiName, err)
+		}
+	})
+
+	created := h.Services.Metadata.GetSchema(ctx, apiName)
+	if created == nil {
+		t.Fatalf("testharness: object %s missing after create", apiName)
+	}
+	return created
+}
+
+// CreateRecord inserts a record as the harness admin and purges it when the test finishes
+func (h *Harness) CreateRecord(t testing.TB, objectName string, data models.SObject) models.SObject {
+	t.Helper()
+
+	ctx := h.Context(t)
+	record, err := h.Services.Persistence.Insert(ctx, objectName, data, h.Admin)
+	if err != nil {
+		t.Fatalf("testharness: insert %s record: %v", objectName, err)
+	}
+	id, _ := record[constants.FieldID].(string)
+	t.Cleanup(func() {
+		// The record may already be gone when the test deleted it itself
+		if err := h.Services.Persistence.Delete(ctx, objectName, id, h.Admin); err == nil {
+			_ = h.Services.Persistence.Purge(ctx, id, h.Admin)
+		}
+	})
+	return record
+}
@@ -0,0 +1,36 @@
+package testharness_test
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/backend/internal/application/services"
+	"github.com/nexuscrm/backend/internal/testharness"
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHarnessFixtures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database bootstrap in short mode")
+	}
+	h := testharness.New(t)
+
+	var objectName, recordID string
+	t.Run("create", func(t *testing.T) {
+		obj := h.CreateObject(t, "invoice", testharness.Field("amount", constants.FieldTypeNumber))
+		objectName = obj.APIName
+		assert.NotNil(t, services.FindField(obj, "amount"))
+
+		user := h.CreateUser(t)
+		assert.Equal(t, constants.ProfileStandardUser, user.ProfileID)
+
+		rec := h.CreateRecord(t, obj.APIName, models.SObject{"name": "INV-1", "amount": 100})
+		recordID, _ = rec[constants.FieldID].(string)
+		require.NotEmpty(t, recordID)
+	})
+
+	// Subtest fixtures are torn down once the subtest finishes
+	assert.Nil(t, h.Services.Metadata.GetSchema(h.Context(t), objectName))
+}
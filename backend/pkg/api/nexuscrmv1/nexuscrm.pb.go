@@ -0,0 +1,880 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: nexuscrm/v1/nexuscrm.proto
+
+package nexuscrmv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Record is one record of any object, keyed by field API name
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        *structpb.Struct       `protobuf:"bytes,1,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecordRequest) Reset() {
+	*x = GetRecordRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordRequest) ProtoMessage() {}
+
+func (x *GetRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRecordRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *GetRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	Fields        *structpb.Struct       `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecordRequest) Reset() {
+	*x = CreateRecordRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecordRequest) ProtoMessage() {}
+
+func (x *CreateRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecordRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecordRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRecordRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *CreateRecordRequest) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type UpdateRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        *structpb.Struct       `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecordRequest) Reset() {
+	*x = UpdateRecordRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecordRequest) ProtoMessage() {}
+
+func (x *UpdateRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecordRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateRecordRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *UpdateRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRecordRequest) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordRequest) Reset() {
+	*x = DeleteRecordRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordRequest) ProtoMessage() {}
+
+func (x *DeleteRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRecordRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BulkCreateRecordsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName   string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	Records         []*structpb.Struct     `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	BatchSize       int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	SkipFlows       bool                   `protobuf:"varint,4,opt,name=skip_flows,json=skipFlows,proto3" json:"skip_flows,omitempty"`
+	SkipAutoNumbers bool                   `protobuf:"varint,5,opt,name=skip_auto_numbers,json=skipAutoNumbers,proto3" json:"skip_auto_numbers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkCreateRecordsRequest) Reset() {
+	*x = BulkCreateRecordsRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateRecordsRequest) ProtoMessage() {}
+
+func (x *BulkCreateRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateRecordsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BulkCreateRecordsRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *BulkCreateRecordsRequest) GetRecords() []*structpb.Struct {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *BulkCreateRecordsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *BulkCreateRecordsRequest) GetSkipFlows() bool {
+	if x != nil {
+		return x.SkipFlows
+	}
+	return false
+}
+
+func (x *BulkCreateRecordsRequest) GetSkipAutoNumbers() bool {
+	if x != nil {
+		return x.SkipAutoNumbers
+	}
+	return false
+}
+
+type BulkCreateRecordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SuccessCount  int32                  `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,2,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateRecordsResponse) Reset() {
+	*x = BulkCreateRecordsResponse{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateRecordsResponse) ProtoMessage() {}
+
+func (x *BulkCreateRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateRecordsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BulkCreateRecordsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BulkCreateRecordsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateRecordsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	// Formula expression, e.g. "status == 'Open' && amount > 1000"
+	FilterExpr string `protobuf:"bytes,2,opt,name=filter_expr,json=filterExpr,proto3" json:"filter_expr,omitempty"`
+	SortField  string `protobuf:"bytes,3,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`
+	// ASC or DESC
+	SortDirection string `protobuf:"bytes,4,opt,name=sort_direction,json=sortDirection,proto3" json:"sort_direction,omitempty"`
+	Limit         int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetFilterExpr() string {
+	if x != nil {
+		return x.FilterExpr
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetSortDirection() string {
+	if x != nil {
+		return x.SortDirection
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Record              `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryResponse) GetRecords() []*Record {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ListObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{9}
+}
+
+type ListObjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objects       []*SystemObject        `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectsResponse) Reset() {
+	*x = ListObjectsResponse{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsResponse) ProtoMessage() {}
+
+func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListObjectsResponse) GetObjects() []*SystemObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type DescribeObjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectApiName string                 `protobuf:"bytes,1,opt,name=object_api_name,json=objectApiName,proto3" json:"object_api_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeObjectRequest) Reset() {
+	*x = DescribeObjectRequest{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeObjectRequest) ProtoMessage() {}
+
+func (x *DescribeObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeObjectRequest.ProtoReflect.Descriptor instead.
+func (*DescribeObjectRequest) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DescribeObjectRequest) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+type DescribeObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *SystemObject          `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Fields        []*SystemField         `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeObjectResponse) Reset() {
+	*x = DescribeObjectResponse{}
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeObjectResponse) ProtoMessage() {}
+
+func (x *DescribeObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_nexuscrm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeObjectResponse.ProtoReflect.Descriptor instead.
+func (*DescribeObjectResponse) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DescribeObjectResponse) GetObject() *SystemObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *DescribeObjectResponse) GetFields() []*SystemField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_nexuscrm_v1_nexuscrm_proto protoreflect.FileDescriptor
+
+const file_nexuscrm_v1_nexuscrm_proto_rawDesc = "" +
+	"\n" +
+	"\x1anexuscrm/v1/nexuscrm.proto\x12\vnexuscrm.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fnexuscrm/v1/system_tables.proto\"9\n" +
+	"\x06Record\x12/\n" +
+	"\x06fields\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06fields\"J\n" +
+	"\x10GetRecordRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"n\n" +
+	"\x13CreateRecordRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06fields\"~\n" +
+	"\x13UpdateRecordRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12/\n" +
+	"\x06fields\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06fields\"M\n" +
+	"\x13DeleteRecordRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xdf\x01\n" +
+	"\x18BulkCreateRecordsRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x121\n" +
+	"\arecords\x18\x02 \x03(\v2\x17.google.protobuf.StructR\arecords\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12\x1d\n" +
+	"\n" +
+	"skip_flows\x18\x04 \x01(\bR\tskipFlows\x12*\n" +
+	"\x11skip_auto_numbers\x18\x05 \x01(\bR\x0fskipAutoNumbers\"{\n" +
+	"\x19BulkCreateRecordsResponse\x12#\n" +
+	"\rsuccess_count\x18\x01 \x01(\x05R\fsuccessCount\x12!\n" +
+	"\ffailed_count\x18\x02 \x01(\x05R\vfailedCount\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\xcb\x01\n" +
+	"\fQueryRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\x12\x1f\n" +
+	"\vfilter_expr\x18\x02 \x01(\tR\n" +
+	"filterExpr\x12\x1d\n" +
+	"\n" +
+	"sort_field\x18\x03 \x01(\tR\tsortField\x12%\n" +
+	"\x0esort_direction\x18\x04 \x01(\tR\rsortDirection\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\">\n" +
+	"\rQueryResponse\x12-\n" +
+	"\arecords\x18\x01 \x03(\v2\x13.nexuscrm.v1.RecordR\arecords\"\x14\n" +
+	"\x12ListObjectsRequest\"J\n" +
+	"\x13ListObjectsResponse\x123\n" +
+	"\aobjects\x18\x01 \x03(\v2\x19.nexuscrm.v1.SystemObjectR\aobjects\"?\n" +
+	"\x15DescribeObjectRequest\x12&\n" +
+	"\x0fobject_api_name\x18\x01 \x01(\tR\robjectApiName\"}\n" +
+	"\x16DescribeObjectResponse\x121\n" +
+	"\x06object\x18\x01 \x01(\v2\x19.nexuscrm.v1.SystemObjectR\x06object\x120\n" +
+	"\x06fields\x18\x02 \x03(\v2\x18.nexuscrm.v1.SystemFieldR\x06fields2\xb8\x05\n" +
+	"\bNexusCRM\x12?\n" +
+	"\tGetRecord\x12\x1d.nexuscrm.v1.GetRecordRequest\x1a\x13.nexuscrm.v1.Record\x12E\n" +
+	"\fCreateRecord\x12 .nexuscrm.v1.CreateRecordRequest\x1a\x13.nexuscrm.v1.Record\x12H\n" +
+	"\fUpdateRecord\x12 .nexuscrm.v1.UpdateRecordRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\fDeleteRecord\x12 .nexuscrm.v1.DeleteRecordRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x11BulkCreateRecords\x12%.nexuscrm.v1.BulkCreateRecordsRequest\x1a&.nexuscrm.v1.BulkCreateRecordsResponse\x12>\n" +
+	"\x05Query\x12\x19.nexuscrm.v1.QueryRequest\x1a\x1a.nexuscrm.v1.QueryResponse\x12?\n" +
+	"\vStreamQuery\x12\x19.nexuscrm.v1.QueryRequest\x1a\x13.nexuscrm.v1.Record0\x01\x12P\n" +
+	"\vListObjects\x12\x1f.nexuscrm.v1.ListObjectsRequest\x1a .nexuscrm.v1.ListObjectsResponse\x12Y\n" +
+	"\x0eDescribeObject\x12\".nexuscrm.v1.DescribeObjectRequest\x1a#.nexuscrm.v1.DescribeObjectResponseB;Z9github.com/nexuscrm/backend/pkg/api/nexuscrmv1;nexuscrmv1b\x06proto3"
+
+var (
+	file_nexuscrm_v1_nexuscrm_proto_rawDescOnce sync.Once
+	file_nexuscrm_v1_nexuscrm_proto_rawDescData []byte
+)
+
+func file_nexuscrm_v1_nexuscrm_proto_rawDescGZIP() []byte {
+	file_nexuscrm_v1_nexuscrm_proto_rawDescOnce.Do(func() {
+		file_nexuscrm_v1_nexuscrm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nexuscrm_v1_nexuscrm_proto_rawDesc), len(file_nexuscrm_v1_nexuscrm_proto_rawDesc)))
+	})
+	return file_nexuscrm_v1_nexuscrm_proto_rawDescData
+}
+
+var file_nexuscrm_v1_nexuscrm_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_nexuscrm_v1_nexuscrm_proto_goTypes = []any{
+	(*Record)(nil),                    // 0: nexuscrm.v1.Record
+	(*GetRecordRequest)(nil),          // 1: nexuscrm.v1.GetRecordRequest
+	(*CreateRecordRequest)(nil),       // 2: nexuscrm.v1.CreateRecordRequest
+	(*UpdateRecordRequest)(nil),       // 3: nexuscrm.v1.UpdateRecordRequest
+	(*DeleteRecordRequest)(nil),       // 4: nexuscrm.v1.DeleteRecordRequest
+	(*BulkCreateRecordsRequest)(nil),  // 5: nexuscrm.v1.BulkCreateRecordsRequest
+	(*BulkCreateRecordsResponse)(nil), // 6: nexuscrm.v1.BulkCreateRecordsResponse
+	(*QueryRequest)(nil),              // 7: nexuscrm.v1.QueryRequest
+	(*QueryResponse)(nil),             // 8: nexuscrm.v1.QueryResponse
+	(*ListObjectsRequest)(nil),        // 9: nexuscrm.v1.ListObjectsRequest
+	(*ListObjectsResponse)(nil),       // 10: nexuscrm.v1.ListObjectsResponse
+	(*DescribeObjectRequest)(nil),     // 11: nexuscrm.v1.DescribeObjectRequest
+	(*DescribeObjectResponse)(nil),    // 12: nexuscrm.v1.DescribeObjectResponse
+	(*structpb.Struct)(nil),           // 13: google.protobuf.Struct
+	(*SystemObject)(nil),              // 14: nexuscrm.v1.SystemObject
+	(*SystemField)(nil),               // 15: nexuscrm.v1.SystemField
+	(*emptypb.Empty)(nil),             // 16: google.protobuf.Empty
+}
+var file_nexuscrm_v1_nexuscrm_proto_depIdxs = []int32{
+	13, // 0: nexuscrm.v1.Record.fields:type_name -> google.protobuf.Struct
+	13, // 1: nexuscrm.v1.CreateRecordRequest.fields:type_name -> google.protobuf.Struct
+	13, // 2: nexuscrm.v1.UpdateRecordRequest.fields:type_name -> google.protobuf.Struct
+	13, // 3: nexuscrm.v1.BulkCreateRecordsRequest.records:type_name -> google.protobuf.Struct
+	0,  // 4: nexuscrm.v1.QueryResponse.records:type_name -> nexuscrm.v1.Record
+	14, // 5: nexuscrm.v1.ListObjectsResponse.objects:type_name -> nexuscrm.v1.SystemObject
+	14, // 6: nexuscrm.v1.DescribeObjectResponse.object:type_name -> nexuscrm.v1.SystemObject
+	15, // 7: nexuscrm.v1.DescribeObjectResponse.fields:type_name -> nexuscrm.v1.SystemField
+	1,  // 8: nexuscrm.v1.NexusCRM.GetRecord:input_type -> nexuscrm.v1.GetRecordRequest
+	2,  // 9: nexuscrm.v1.NexusCRM.CreateRecord:input_type -> nexuscrm.v1.CreateRecordRequest
+	3,  // 10: nexuscrm.v1.NexusCRM.UpdateRecord:input_type -> nexuscrm.v1.UpdateRecordRequest
+	4,  // 11: nexuscrm.v1.NexusCRM.DeleteRecord:input_type -> nexuscrm.v1.DeleteRecordRequest
+	5,  // 12: nexuscrm.v1.NexusCRM.BulkCreateRecords:input_type -> nexuscrm.v1.BulkCreateRecordsRequest
+	7,  // 13: nexuscrm.v1.NexusCRM.Query:input_type -> nexuscrm.v1.QueryRequest
+	7,  // 14: nexuscrm.v1.NexusCRM.StreamQuery:input_type -> nexuscrm.v1.QueryRequest
+	9,  // 15: nexuscrm.v1.NexusCRM.ListObjects:input_type -> nexuscrm.v1.ListObjectsRequest
+	11, // 16: nexuscrm.v1.NexusCRM.DescribeObject:input_type -> nexuscrm.v1.DescribeObjectRequest
+	0,  // 17: nexuscrm.v1.NexusCRM.GetRecord:output_type -> nexuscrm.v1.Record
+	0,  // 18: nexuscrm.v1.NexusCRM.CreateRecord:output_type -> nexuscrm.v1.Record
+	16, // 19: nexuscrm.v1.NexusCRM.UpdateRecord:output_type -> google.protobuf.Empty
+	16, // 20: nexuscrm.v1.NexusCRM.DeleteRecord:output_type -> google.protobuf.Empty
+	6,  // 21: nexuscrm.v1.NexusCRM.BulkCreateRecords:output_type -> nexuscrm.v1.BulkCreateRecordsResponse
+	8,  // 22: nexuscrm.v1.NexusCRM.Query:output_type -> nexuscrm.v1.QueryResponse
+	0,  // 23: nexuscrm.v1.NexusCRM.StreamQuery:output_type -> nexuscrm.v1.Record
+	10, // 24: nexuscrm.v1.NexusCRM.ListObjects:output_type -> nexuscrm.v1.ListObjectsResponse
+	12, // 25: nexuscrm.v1.NexusCRM.DescribeObject:output_type -> nexuscrm.v1.DescribeObjectResponse
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_nexuscrm_v1_nexuscrm_proto_init() }
+func file_nexuscrm_v1_nexuscrm_proto_init() {
+	if File_nexuscrm_v1_nexuscrm_proto != nil {
+		return
+	}
+	file_nexuscrm_v1_system_tables_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nexuscrm_v1_nexuscrm_proto_rawDesc), len(file_nexuscrm_v1_nexuscrm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nexuscrm_v1_nexuscrm_proto_goTypes,
+		DependencyIndexes: file_nexuscrm_v1_nexuscrm_proto_depIdxs,
+		MessageInfos:      file_nexuscrm_v1_nexuscrm_proto_msgTypes,
+	}.Build()
+	File_nexuscrm_v1_nexuscrm_proto = out.File
+	file_nexuscrm_v1_nexuscrm_proto_goTypes = nil
+	file_nexuscrm_v1_nexuscrm_proto_depIdxs = nil
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nexuscrm/v1/nexuscrm.proto
+
+package nexuscrmv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NexusCRM_GetRecord_FullMethodName         = "/nexuscrm.v1.NexusCRM/GetRecord"
+	NexusCRM_CreateRecord_FullMethodName      = "/nexuscrm.v1.NexusCRM/CreateRecord"
+	NexusCRM_UpdateRecord_FullMethodName      = "/nexuscrm.v1.NexusCRM/UpdateRecord"
+	NexusCRM_DeleteRecord_FullMethodName      = "/nexuscrm.v1.NexusCRM/DeleteRecord"
+	NexusCRM_BulkCreateRecords_FullMethodName = "/nexuscrm.v1.NexusCRM/BulkCreateRecords"
+	NexusCRM_Query_FullMethodName             = "/nexuscrm.v1.NexusCRM/Query"
+	NexusCRM_StreamQuery_FullMethodName       = "/nexuscrm.v1.NexusCRM/StreamQuery"
+	NexusCRM_ListObjects_FullMethodName       = "/nexuscrm.v1.NexusCRM/ListObjects"
+	NexusCRM_DescribeObject_FullMethodName    = "/nexuscrm.v1.NexusCRM/DescribeObject"
+)
+
+// NexusCRMClient is the client API for NexusCRM service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NexusCRM exposes the data and metadata APIs for high-throughput integrations.
+// Calls go through the same services as the REST API, so permissions, validation
+// rules, flows and field-level security apply in the same way.
+//
+// Authenticate with the session token returned by POST /api/auth/login, sent as
+// "authorization: Bearer <token>" request metadata.
+type NexusCRMClient interface {
+	// GetRecord returns one record by ID
+	GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*Record, error)
+	// CreateRecord inserts a record and returns it with its system fields
+	CreateRecord(ctx context.Context, in *CreateRecordRequest, opts ...grpc.CallOption) (*Record, error)
+	// UpdateRecord applies a partial update
+	UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteRecord moves a record to the recycle bin
+	DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BulkCreateRecords inserts up to 1000 records in batches
+	BulkCreateRecords(ctx context.Context, in *BulkCreateRecordsRequest, opts ...grpc.CallOption) (*BulkCreateRecordsResponse, error)
+	// Query returns the records matching a filter expression
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// StreamQuery sends the records matching a filter expression one message at a time,
+	// paging through the result set on the server
+	StreamQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Record], error)
+	// ListObjects returns the objects the caller can see
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// DescribeObject returns an object's metadata and fields
+	DescribeObject(ctx context.Context, in *DescribeObjectRequest, opts ...grpc.CallOption) (*DescribeObjectResponse, error)
+}
+
+type nexusCRMClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNexusCRMClient(cc grpc.ClientConnInterface) NexusCRMClient {
+	return &nexusCRMClient{cc}
+}
+
+func (c *nexusCRMClient) GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*Record, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Record)
+	err := c.cc.Invoke(ctx, NexusCRM_GetRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) CreateRecord(ctx context.Context, in *CreateRecordRequest, opts ...grpc.CallOption) (*Record, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Record)
+	err := c.cc.Invoke(ctx, NexusCRM_CreateRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NexusCRM_UpdateRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NexusCRM_DeleteRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) BulkCreateRecords(ctx context.Context, in *BulkCreateRecordsRequest, opts ...grpc.CallOption) (*BulkCreateRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateRecordsResponse)
+	err := c.cc.Invoke(ctx, NexusCRM_BulkCreateRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, NexusCRM_Query_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) StreamQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Record], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexusCRM_ServiceDesc.Streams[0], NexusCRM_StreamQuery_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QueryRequest, Record]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusCRM_StreamQueryClient = grpc.ServerStreamingClient[Record]
+
+func (c *nexusCRMClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, NexusCRM_ListObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusCRMClient) DescribeObject(ctx context.Context, in *DescribeObjectRequest, opts ...grpc.CallOption) (*DescribeObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeObjectResponse)
+	err := c.cc.Invoke(ctx, NexusCRM_DescribeObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NexusCRMServer is the server API for NexusCRM service.
+// All implementations must embed UnimplementedNexusCRMServer
+// for forward compatibility.
+//
+// NexusCRM exposes the data and metadata APIs for high-throughput integrations.
+// Calls go through the same services as the REST API, so permissions, validation
+// rules, flows and field-level security apply in the same way.
+//
+// Authenticate with the session token returned by POST /api/auth/login, sent as
+// "authorization: Bearer <token>" request metadata.
+type NexusCRMServer interface {
+	// GetRecord returns one record by ID
+	GetRecord(context.Context, *GetRecordRequest) (*Record, error)
+	// CreateRecord inserts a record and returns it with its system fields
+	CreateRecord(context.Context, *CreateRecordRequest) (*Record, error)
+	// UpdateRecord applies a partial update
+	UpdateRecord(context.Context, *UpdateRecordRequest) (*emptypb.Empty, error)
+	// DeleteRecord moves a record to the recycle bin
+	DeleteRecord(context.Context, *DeleteRecordRequest) (*emptypb.Empty, error)
+	// BulkCreateRecords inserts up to 1000 records in batches
+	BulkCreateRecords(context.Context, *BulkCreateRecordsRequest) (*BulkCreateRecordsResponse, error)
+	// Query returns the records matching a filter expression
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// StreamQuery sends the records matching a filter expression one message at a time,
+	// paging through the result set on the server
+	StreamQuery(*QueryRequest, grpc.ServerStreamingServer[Record]) error
+	// ListObjects returns the objects the caller can see
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// DescribeObject returns an object's metadata and fields
+	DescribeObject(context.Context, *DescribeObjectRequest) (*DescribeObjectResponse, error)
+	mustEmbedUnimplementedNexusCRMServer()
+}
+
+// UnimplementedNexusCRMServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNexusCRMServer struct{}
+
+func (UnimplementedNexusCRMServer) GetRecord(context.Context, *GetRecordRequest) (*Record, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
+}
+func (UnimplementedNexusCRMServer) CreateRecord(context.Context, *CreateRecordRequest) (*Record, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecord not implemented")
+}
+func (UnimplementedNexusCRMServer) UpdateRecord(context.Context, *UpdateRecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
+}
+func (UnimplementedNexusCRMServer) DeleteRecord(context.Context, *DeleteRecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (UnimplementedNexusCRMServer) BulkCreateRecords(context.Context, *BulkCreateRecordsRequest) (*BulkCreateRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateRecords not implemented")
+}
+func (UnimplementedNexusCRMServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedNexusCRMServer) StreamQuery(*QueryRequest, grpc.ServerStreamingServer[Record]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuery not implemented")
+}
+func (UnimplementedNexusCRMServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedNexusCRMServer) DescribeObject(context.Context, *DescribeObjectRequest) (*DescribeObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeObject not implemented")
+}
+func (UnimplementedNexusCRMServer) mustEmbedUnimplementedNexusCRMServer() {}
+func (UnimplementedNexusCRMServer) testEmbeddedByValue()                  {}
+
+// UnsafeNexusCRMServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NexusCRMServer will
+// result in compilation errors.
+type UnsafeNexusCRMServer interface {
+	mustEmbedUnimplementedNexusCRMServer()
+}
+
+func RegisterNexusCRMServer(s grpc.ServiceRegistrar, srv NexusCRMServer) {
+	// If the following call pancis, it indicates UnimplementedNexusCRMServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NexusCRM_ServiceDesc, srv)
+}
+
+func _NexusCRM_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).GetRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_GetRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).GetRecord(ctx, req.(*GetRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_CreateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).CreateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_CreateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).CreateRecord(ctx, req.(*CreateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).UpdateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_UpdateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).UpdateRecord(ctx, req.(*UpdateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_DeleteRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).DeleteRecord(ctx, req.(*DeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_BulkCreateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).BulkCreateRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_BulkCreateRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).BulkCreateRecords(ctx, req.(*BulkCreateRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_Query_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_StreamQuery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NexusCRMServer).StreamQuery(m, &grpc.GenericServerStream[QueryRequest, Record]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusCRM_StreamQueryServer = grpc.ServerStreamingServer[Record]
+
+func _NexusCRM_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_ListObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).ListObjects(ctx, req.(*ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusCRM_DescribeObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusCRMServer).DescribeObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusCRM_DescribeObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusCRMServer).DescribeObject(ctx, req.(*DescribeObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NexusCRM_ServiceDesc is the grpc.ServiceDesc for NexusCRM service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NexusCRM_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nexuscrm.v1.NexusCRM",
+	HandlerType: (*NexusCRMServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRecord",
+			Handler:    _NexusCRM_GetRecord_Handler,
+		},
+		{
+			MethodName: "CreateRecord",
+			Handler:    _NexusCRM_CreateRecord_Handler,
+		},
+		{
+			MethodName: "UpdateRecord",
+			Handler:    _NexusCRM_UpdateRecord_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _NexusCRM_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "BulkCreateRecords",
+			Handler:    _NexusCRM_BulkCreateRecords_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _NexusCRM_Query_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _NexusCRM_ListObjects_Handler,
+		},
+		{
+			MethodName: "DescribeObject",
+			Handler:    _NexusCRM_DescribeObject_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQuery",
+			Handler:       _NexusCRM_StreamQuery_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nexuscrm/v1/nexuscrm.proto",
+}
@@ -0,0 +1,16069 @@
+// Code generated by cmd/codegen. DO NOT EDIT.
+// Source: backend/internal/bootstrap/system_tables.json
+// Generated at: 2026-10-18T13:48:25Z
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: nexuscrm/v1/system_tables.proto
+
+package nexuscrmv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SystemAIContextItem represents the _System_AI_Context_Item table (generated).
+// Files pinned to a user's AI agent context
+type SystemAIContextItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Path             string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Content          string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	TokenSize        int32                  `protobuf:"varint,5,opt,name=token_size,proto3" json:"token_size,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAIContextItem) Reset() {
+	*x = SystemAIContextItem{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAIContextItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAIContextItem) ProtoMessage() {}
+
+func (x *SystemAIContextItem) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAIContextItem.ProtoReflect.Descriptor instead.
+func (*SystemAIContextItem) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SystemAIContextItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAIContextItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemAIContextItem) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SystemAIContextItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SystemAIContextItem) GetTokenSize() int32 {
+	if x != nil {
+		return x.TokenSize
+	}
+	return 0
+}
+
+func (x *SystemAIContextItem) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAIContextItem) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemAIConversation represents the _System_AI_Conversation table (generated).
+// Persisted AI conversation history per user
+type SystemAIConversation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Messages         *structpb.Value        `protobuf:"bytes,4,opt,name=messages,proto3" json:"messages,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	Settings         *structpb.Value        `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,9,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAIConversation) Reset() {
+	*x = SystemAIConversation{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAIConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAIConversation) ProtoMessage() {}
+
+func (x *SystemAIConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAIConversation.ProtoReflect.Descriptor instead.
+func (*SystemAIConversation) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SystemAIConversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAIConversation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemAIConversation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SystemAIConversation) GetMessages() *structpb.Value {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SystemAIConversation) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemAIConversation) GetSettings() *structpb.Value {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *SystemAIConversation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAIConversation) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemAIConversation) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemAction represents the _System_Action table (generated).
+// UI action definitions
+type SystemAction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type             string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Icon             *string                `protobuf:"bytes,6,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	TargetObject     *string                `protobuf:"bytes,7,opt,name=target_object,proto3,oneof" json:"target_object,omitempty"`
+	Config           *structpb.Value        `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAction) Reset() {
+	*x = SystemAction{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAction) ProtoMessage() {}
+
+func (x *SystemAction) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAction.ProtoReflect.Descriptor instead.
+func (*SystemAction) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SystemAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAction) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemAction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemAction) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *SystemAction) GetTargetObject() string {
+	if x != nil && x.TargetObject != nil {
+		return *x.TargetObject
+	}
+	return ""
+}
+
+func (x *SystemAction) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SystemAction) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAction) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemActivity represents the _System_Activity table (generated).
+// Emails, calendar events and calls captured from sync connectors and telephony providers, linked to the records whose email addresses or phone numbers they involve
+type SystemActivity struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ActivityType     string                 `protobuf:"bytes,2,opt,name=activity_type,proto3" json:"activity_type,omitempty"`
+	Subject          *string                `protobuf:"bytes,3,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FromAddress      *string                `protobuf:"bytes,5,opt,name=from_address,proto3,oneof" json:"from_address,omitempty"`
+	ToAddresses      *string                `protobuf:"bytes,6,opt,name=to_addresses,proto3,oneof" json:"to_addresses,omitempty"`
+	ActivityDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=activity_date,proto3" json:"activity_date,omitempty"`
+	EndDate          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,proto3" json:"end_date,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,9,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,10,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	CallDirection    *string                `protobuf:"bytes,11,opt,name=call_direction,proto3,oneof" json:"call_direction,omitempty"`
+	CallStatus       *string                `protobuf:"bytes,12,opt,name=call_status,proto3,oneof" json:"call_status,omitempty"`
+	CallDuration     *int32                 `protobuf:"varint,13,opt,name=call_duration,proto3,oneof" json:"call_duration,omitempty"`
+	RecordingUrl     *string                `protobuf:"bytes,14,opt,name=recording_url,proto3,oneof" json:"recording_url,omitempty"`
+	ConnectorId      string                 `protobuf:"bytes,15,opt,name=connector_id,proto3" json:"connector_id,omitempty"`
+	ExternalId       string                 `protobuf:"bytes,16,opt,name=external_id,proto3" json:"external_id,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,17,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,18,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemActivity) Reset() {
+	*x = SystemActivity{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemActivity) ProtoMessage() {}
+
+func (x *SystemActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemActivity.ProtoReflect.Descriptor instead.
+func (*SystemActivity) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SystemActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetFromAddress() string {
+	if x != nil && x.FromAddress != nil {
+		return *x.FromAddress
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetToAddresses() string {
+	if x != nil && x.ToAddresses != nil {
+		return *x.ToAddresses
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetActivityDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivityDate
+	}
+	return nil
+}
+
+func (x *SystemActivity) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *SystemActivity) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetCallDirection() string {
+	if x != nil && x.CallDirection != nil {
+		return *x.CallDirection
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetCallStatus() string {
+	if x != nil && x.CallStatus != nil {
+		return *x.CallStatus
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetCallDuration() int32 {
+	if x != nil && x.CallDuration != nil {
+		return *x.CallDuration
+	}
+	return 0
+}
+
+func (x *SystemActivity) GetRecordingUrl() string {
+	if x != nil && x.RecordingUrl != nil {
+		return *x.RecordingUrl
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemActivity) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemActivity) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemActivity) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemApp represents the _System_App table (generated).
+// Application configurations
+type SystemApp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Icon             string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color            string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	NavigationItems  *structpb.Value        `protobuf:"bytes,7,opt,name=navigation_items,proto3" json:"navigation_items,omitempty"`
+	IsDefault        bool                   `protobuf:"varint,8,opt,name=is_default,proto3" json:"is_default,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemApp) Reset() {
+	*x = SystemApp{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemApp) ProtoMessage() {}
+
+func (x *SystemApp) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemApp.ProtoReflect.Descriptor instead.
+func (*SystemApp) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SystemApp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemApp) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemApp) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemApp) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemApp) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *SystemApp) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *SystemApp) GetNavigationItems() *structpb.Value {
+	if x != nil {
+		return x.NavigationItems
+	}
+	return nil
+}
+
+func (x *SystemApp) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SystemApp) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemApp) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemApprovalProcess represents the _System_ApprovalProcess table (generated).
+// Approval process definitions
+type SystemApprovalProcess struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	EntryCriteria    *string                `protobuf:"bytes,5,opt,name=entry_criteria,proto3,oneof" json:"entry_criteria,omitempty"`
+	ApproverType     string                 `protobuf:"bytes,6,opt,name=approver_type,proto3" json:"approver_type,omitempty"`
+	ApproverId       *string                `protobuf:"bytes,7,opt,name=approver_id,proto3,oneof" json:"approver_id,omitempty"`
+	IsActive         bool                   `protobuf:"varint,8,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,9,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,10,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,12,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,14,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemApprovalProcess) Reset() {
+	*x = SystemApprovalProcess{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemApprovalProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemApprovalProcess) ProtoMessage() {}
+
+func (x *SystemApprovalProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemApprovalProcess.ProtoReflect.Descriptor instead.
+func (*SystemApprovalProcess) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SystemApprovalProcess) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetEntryCriteria() string {
+	if x != nil && x.EntryCriteria != nil {
+		return *x.EntryCriteria
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetApproverType() string {
+	if x != nil {
+		return x.ApproverType
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetApproverId() string {
+	if x != nil && x.ApproverId != nil {
+		return *x.ApproverId
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemApprovalProcess) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalProcess) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalProcess) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalProcess) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemApprovalWorkItem represents the _System_ApprovalWorkItem table (generated).
+// Pending approval work items
+type SystemApprovalWorkItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProcessId        string                 `protobuf:"bytes,2,opt,name=process_id,proto3" json:"process_id,omitempty"`
+	FlowInstanceId   *string                `protobuf:"bytes,3,opt,name=flow_instance_id,proto3,oneof" json:"flow_instance_id,omitempty"`
+	FlowStepId       *string                `protobuf:"bytes,4,opt,name=flow_step_id,proto3,oneof" json:"flow_step_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,5,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,6,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	SubmittedById    *string                `protobuf:"bytes,8,opt,name=submitted_by_id,proto3,oneof" json:"submitted_by_id,omitempty"`
+	SubmittedDate    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=submitted_date,proto3" json:"submitted_date,omitempty"`
+	ApproverId       *string                `protobuf:"bytes,10,opt,name=approver_id,proto3,oneof" json:"approver_id,omitempty"`
+	ApprovedById     *string                `protobuf:"bytes,11,opt,name=approved_by_id,proto3,oneof" json:"approved_by_id,omitempty"`
+	ApprovedDate     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=approved_date,proto3" json:"approved_date,omitempty"`
+	Comments         *string                `protobuf:"bytes,13,opt,name=comments,proto3,oneof" json:"comments,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,14,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,15,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,17,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,19,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemApprovalWorkItem) Reset() {
+	*x = SystemApprovalWorkItem{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemApprovalWorkItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemApprovalWorkItem) ProtoMessage() {}
+
+func (x *SystemApprovalWorkItem) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemApprovalWorkItem.ProtoReflect.Descriptor instead.
+func (*SystemApprovalWorkItem) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SystemApprovalWorkItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetFlowInstanceId() string {
+	if x != nil && x.FlowInstanceId != nil {
+		return *x.FlowInstanceId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetFlowStepId() string {
+	if x != nil && x.FlowStepId != nil {
+		return *x.FlowStepId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetSubmittedById() string {
+	if x != nil && x.SubmittedById != nil {
+		return *x.SubmittedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetSubmittedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalWorkItem) GetApproverId() string {
+	if x != nil && x.ApproverId != nil {
+		return *x.ApproverId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetApprovedById() string {
+	if x != nil && x.ApprovedById != nil {
+		return *x.ApprovedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetApprovedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalWorkItem) GetComments() string {
+	if x != nil && x.Comments != nil {
+		return *x.Comments
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalWorkItem) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemApprovalWorkItem) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemApprovalWorkItem) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemArchivePolicy represents the _System_ArchivePolicy table (generated).
+// Per-object archival policies moving old rows to an archive table
+type SystemArchivePolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName     string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	DateField         string                 `protobuf:"bytes,3,opt,name=date_field,proto3" json:"date_field,omitempty"`
+	RetainDays        int32                  `protobuf:"varint,4,opt,name=retain_days,proto3" json:"retain_days,omitempty"`
+	IsActive          bool                   `protobuf:"varint,5,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	LastRunDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_date,proto3" json:"last_run_date,omitempty"`
+	LastArchivedCount int32                  `protobuf:"varint,7,opt,name=last_archived_count,proto3" json:"last_archived_count,omitempty"`
+	LastError         *string                `protobuf:"bytes,8,opt,name=last_error,proto3,oneof" json:"last_error,omitempty"`
+	CreatedDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SystemArchivePolicy) Reset() {
+	*x = SystemArchivePolicy{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemArchivePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemArchivePolicy) ProtoMessage() {}
+
+func (x *SystemArchivePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemArchivePolicy.ProtoReflect.Descriptor instead.
+func (*SystemArchivePolicy) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SystemArchivePolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemArchivePolicy) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemArchivePolicy) GetDateField() string {
+	if x != nil {
+		return x.DateField
+	}
+	return ""
+}
+
+func (x *SystemArchivePolicy) GetRetainDays() int32 {
+	if x != nil {
+		return x.RetainDays
+	}
+	return 0
+}
+
+func (x *SystemArchivePolicy) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemArchivePolicy) GetLastRunDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunDate
+	}
+	return nil
+}
+
+func (x *SystemArchivePolicy) GetLastArchivedCount() int32 {
+	if x != nil {
+		return x.LastArchivedCount
+	}
+	return 0
+}
+
+func (x *SystemArchivePolicy) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *SystemArchivePolicy) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemArchivePolicy) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemAsyncJob represents the _System_AsyncJob table (generated).
+// Background jobs (e.g. picklist value replacement) with their progress and outcome
+type SystemAsyncJob struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	JobType          string                 `protobuf:"bytes,2,opt,name=job_type,proto3" json:"job_type,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,3,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Parameters       *structpb.Value        `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	TotalCount       int32                  `protobuf:"varint,6,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	ProcessedCount   int32                  `protobuf:"varint,7,opt,name=processed_count,proto3" json:"processed_count,omitempty"`
+	FailedCount      int32                  `protobuf:"varint,8,opt,name=failed_count,proto3" json:"failed_count,omitempty"`
+	ErrorMessage     *string                `protobuf:"bytes,9,opt,name=error_message,proto3,oneof" json:"error_message,omitempty"`
+	StartedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_date,proto3" json:"started_date,omitempty"`
+	CompletedDate    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_date,proto3" json:"completed_date,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,12,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAsyncJob) Reset() {
+	*x = SystemAsyncJob{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAsyncJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAsyncJob) ProtoMessage() {}
+
+func (x *SystemAsyncJob) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAsyncJob.ProtoReflect.Descriptor instead.
+func (*SystemAsyncJob) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SystemAsyncJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetParameters() *structpb.Value {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SystemAsyncJob) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SystemAsyncJob) GetProcessedCount() int32 {
+	if x != nil {
+		return x.ProcessedCount
+	}
+	return 0
+}
+
+func (x *SystemAsyncJob) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *SystemAsyncJob) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetStartedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedDate
+	}
+	return nil
+}
+
+func (x *SystemAsyncJob) GetCompletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedDate
+	}
+	return nil
+}
+
+func (x *SystemAsyncJob) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemAsyncJob) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAsyncJob) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemAuditLog represents the _System_AuditLog table (generated).
+// Field history tracking
+type SystemAuditLog struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	FieldName        string                 `protobuf:"bytes,4,opt,name=field_name,proto3" json:"field_name,omitempty"`
+	OldValue         string                 `protobuf:"bytes,5,opt,name=old_value,proto3" json:"old_value,omitempty"`
+	NewValue         string                 `protobuf:"bytes,6,opt,name=new_value,proto3" json:"new_value,omitempty"`
+	ChangedById      string                 `protobuf:"bytes,7,opt,name=changed_by_id,proto3" json:"changed_by_id,omitempty"`
+	ChangedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAuditLog) Reset() {
+	*x = SystemAuditLog{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAuditLog) ProtoMessage() {}
+
+func (x *SystemAuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAuditLog.ProtoReflect.Descriptor instead.
+func (*SystemAuditLog) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SystemAuditLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetChangedById() string {
+	if x != nil {
+		return x.ChangedById
+	}
+	return ""
+}
+
+func (x *SystemAuditLog) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *SystemAuditLog) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAuditLog) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemAutoNumber represents the _System_AutoNumber table (generated).
+// Auto-number sequence tracking
+type SystemAutoNumber struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	FieldApiName     string                 `protobuf:"bytes,3,opt,name=field_api_name,proto3" json:"field_api_name,omitempty"`
+	DisplayFormat    string                 `protobuf:"bytes,4,opt,name=display_format,proto3" json:"display_format,omitempty"`
+	StartingNumber   int32                  `protobuf:"varint,5,opt,name=starting_number,proto3" json:"starting_number,omitempty"`
+	CurrentNumber    int32                  `protobuf:"varint,6,opt,name=current_number,proto3" json:"current_number,omitempty"`
+	GapFree          bool                   `protobuf:"varint,7,opt,name=gap_free,proto3" json:"gap_free,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemAutoNumber) Reset() {
+	*x = SystemAutoNumber{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAutoNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAutoNumber) ProtoMessage() {}
+
+func (x *SystemAutoNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAutoNumber.ProtoReflect.Descriptor instead.
+func (*SystemAutoNumber) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SystemAutoNumber) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemAutoNumber) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemAutoNumber) GetFieldApiName() string {
+	if x != nil {
+		return x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemAutoNumber) GetDisplayFormat() string {
+	if x != nil {
+		return x.DisplayFormat
+	}
+	return ""
+}
+
+func (x *SystemAutoNumber) GetStartingNumber() int32 {
+	if x != nil {
+		return x.StartingNumber
+	}
+	return 0
+}
+
+func (x *SystemAutoNumber) GetCurrentNumber() int32 {
+	if x != nil {
+		return x.CurrentNumber
+	}
+	return 0
+}
+
+func (x *SystemAutoNumber) GetGapFree() bool {
+	if x != nil {
+		return x.GapFree
+	}
+	return false
+}
+
+func (x *SystemAutoNumber) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemAutoNumber) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemBatchJob represents the _System_BatchJob table (generated).
+// Batch jobs processing the records of a scope query in chunks with a script or a built-in action, run on demand or on a schedule
+type SystemBatchJob struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	ScopeFilter      *string                `protobuf:"bytes,5,opt,name=scope_filter,proto3,oneof" json:"scope_filter,omitempty"`
+	Processor        string                 `protobuf:"bytes,6,opt,name=processor,proto3" json:"processor,omitempty"`
+	ScriptName       *string                `protobuf:"bytes,7,opt,name=script_name,proto3,oneof" json:"script_name,omitempty"`
+	FieldValues      *structpb.Value        `protobuf:"bytes,8,opt,name=field_values,proto3" json:"field_values,omitempty"`
+	ChunkSize        *int32                 `protobuf:"varint,9,opt,name=chunk_size,proto3,oneof" json:"chunk_size,omitempty"`
+	Concurrency      *int32                 `protobuf:"varint,10,opt,name=concurrency,proto3,oneof" json:"concurrency,omitempty"`
+	Schedule         *string                `protobuf:"bytes,11,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
+	ScheduleTimezone *string                `protobuf:"bytes,12,opt,name=schedule_timezone,proto3,oneof" json:"schedule_timezone,omitempty"`
+	NextRunAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run_at,proto3" json:"next_run_at,omitempty"`
+	LastRunAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_run_at,proto3" json:"last_run_at,omitempty"`
+	LastJobId        *string                `protobuf:"bytes,15,opt,name=last_job_id,proto3,oneof" json:"last_job_id,omitempty"`
+	IsActive         bool                   `protobuf:"varint,16,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,17,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemBatchJob) Reset() {
+	*x = SystemBatchJob{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemBatchJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBatchJob) ProtoMessage() {}
+
+func (x *SystemBatchJob) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBatchJob.ProtoReflect.Descriptor instead.
+func (*SystemBatchJob) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SystemBatchJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetScopeFilter() string {
+	if x != nil && x.ScopeFilter != nil {
+		return *x.ScopeFilter
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetProcessor() string {
+	if x != nil {
+		return x.Processor
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetScriptName() string {
+	if x != nil && x.ScriptName != nil {
+		return *x.ScriptName
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetFieldValues() *structpb.Value {
+	if x != nil {
+		return x.FieldValues
+	}
+	return nil
+}
+
+func (x *SystemBatchJob) GetChunkSize() int32 {
+	if x != nil && x.ChunkSize != nil {
+		return *x.ChunkSize
+	}
+	return 0
+}
+
+func (x *SystemBatchJob) GetConcurrency() int32 {
+	if x != nil && x.Concurrency != nil {
+		return *x.Concurrency
+	}
+	return 0
+}
+
+func (x *SystemBatchJob) GetSchedule() string {
+	if x != nil && x.Schedule != nil {
+		return *x.Schedule
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetScheduleTimezone() string {
+	if x != nil && x.ScheduleTimezone != nil {
+		return *x.ScheduleTimezone
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *SystemBatchJob) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *SystemBatchJob) GetLastJobId() string {
+	if x != nil && x.LastJobId != nil {
+		return *x.LastJobId
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemBatchJob) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemBatchJob) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemBatchJob) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemBootstrapStep represents the _System_BootstrapStep table (generated).
+// Startup steps that completed and the checksum of their input; a step is skipped while its checksum is unchanged
+type SystemBootstrapStep struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	StepName         string                 `protobuf:"bytes,2,opt,name=step_name,proto3" json:"step_name,omitempty"`
+	Checksum         string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	DurationMs       int32                  `protobuf:"varint,4,opt,name=duration_ms,proto3" json:"duration_ms,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemBootstrapStep) Reset() {
+	*x = SystemBootstrapStep{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemBootstrapStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBootstrapStep) ProtoMessage() {}
+
+func (x *SystemBootstrapStep) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBootstrapStep.ProtoReflect.Descriptor instead.
+func (*SystemBootstrapStep) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SystemBootstrapStep) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemBootstrapStep) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *SystemBootstrapStep) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *SystemBootstrapStep) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SystemBootstrapStep) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemBootstrapStep) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemBusinessHours represents the _System_BusinessHours table (generated).
+// Weekly working schedules used to measure elapsed business time
+type SystemBusinessHours struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Timezone         string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	IsDefault        bool                   `protobuf:"varint,5,opt,name=is_default,proto3" json:"is_default,omitempty"`
+	Schedule         *structpb.Value        `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemBusinessHours) Reset() {
+	*x = SystemBusinessHours{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemBusinessHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBusinessHours) ProtoMessage() {}
+
+func (x *SystemBusinessHours) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBusinessHours.ProtoReflect.Descriptor instead.
+func (*SystemBusinessHours) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SystemBusinessHours) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemBusinessHours) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemBusinessHours) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemBusinessHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SystemBusinessHours) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SystemBusinessHours) GetSchedule() *structpb.Value {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *SystemBusinessHours) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemBusinessHours) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCampaign represents the _System_Campaign table (generated).
+// Marketing campaign with its member statuses and influenced revenue rollups
+type SystemCampaign struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description              *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Type                     *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Status                   string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate                *string                `protobuf:"bytes,6,opt,name=start_date,proto3,oneof" json:"start_date,omitempty"`
+	EndDate                  *string                `protobuf:"bytes,7,opt,name=end_date,proto3,oneof" json:"end_date,omitempty"`
+	BudgetedCost             *float64               `protobuf:"fixed64,8,opt,name=budgeted_cost,proto3,oneof" json:"budgeted_cost,omitempty"`
+	ActualCost               *float64               `protobuf:"fixed64,9,opt,name=actual_cost,proto3,oneof" json:"actual_cost,omitempty"`
+	ExpectedRevenue          *float64               `protobuf:"fixed64,10,opt,name=expected_revenue,proto3,oneof" json:"expected_revenue,omitempty"`
+	MemberStatuses           *structpb.Value        `protobuf:"bytes,11,opt,name=member_statuses,proto3" json:"member_statuses,omitempty"`
+	IsActive                 bool                   `protobuf:"varint,12,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	NumberOfMembers          int32                  `protobuf:"varint,13,opt,name=number_of_members,proto3" json:"number_of_members,omitempty"`
+	NumberOfResponses        int32                  `protobuf:"varint,14,opt,name=number_of_responses,proto3" json:"number_of_responses,omitempty"`
+	NumberOfOpportunities    int32                  `protobuf:"varint,15,opt,name=number_of_opportunities,proto3" json:"number_of_opportunities,omitempty"`
+	NumberOfWonOpportunities int32                  `protobuf:"varint,16,opt,name=number_of_won_opportunities,proto3" json:"number_of_won_opportunities,omitempty"`
+	AmountAllOpportunities   float64                `protobuf:"fixed64,17,opt,name=amount_all_opportunities,proto3" json:"amount_all_opportunities,omitempty"`
+	AmountWonOpportunities   float64                `protobuf:"fixed64,18,opt,name=amount_won_opportunities,proto3" json:"amount_won_opportunities,omitempty"`
+	RollupsDate              *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=rollups_date,proto3" json:"rollups_date,omitempty"`
+	OwnerId                  string                 `protobuf:"bytes,20,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate              *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate         *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SystemCampaign) Reset() {
+	*x = SystemCampaign{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCampaign) ProtoMessage() {}
+
+func (x *SystemCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCampaign.ProtoReflect.Descriptor instead.
+func (*SystemCampaign) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SystemCampaign) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetBudgetedCost() float64 {
+	if x != nil && x.BudgetedCost != nil {
+		return *x.BudgetedCost
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetActualCost() float64 {
+	if x != nil && x.ActualCost != nil {
+		return *x.ActualCost
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetExpectedRevenue() float64 {
+	if x != nil && x.ExpectedRevenue != nil {
+		return *x.ExpectedRevenue
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetMemberStatuses() *structpb.Value {
+	if x != nil {
+		return x.MemberStatuses
+	}
+	return nil
+}
+
+func (x *SystemCampaign) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemCampaign) GetNumberOfMembers() int32 {
+	if x != nil {
+		return x.NumberOfMembers
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetNumberOfResponses() int32 {
+	if x != nil {
+		return x.NumberOfResponses
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetNumberOfOpportunities() int32 {
+	if x != nil {
+		return x.NumberOfOpportunities
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetNumberOfWonOpportunities() int32 {
+	if x != nil {
+		return x.NumberOfWonOpportunities
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetAmountAllOpportunities() float64 {
+	if x != nil {
+		return x.AmountAllOpportunities
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetAmountWonOpportunities() float64 {
+	if x != nil {
+		return x.AmountWonOpportunities
+	}
+	return 0
+}
+
+func (x *SystemCampaign) GetRollupsDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RollupsDate
+	}
+	return nil
+}
+
+func (x *SystemCampaign) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemCampaign) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCampaign) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCampaignMember represents the _System_CampaignMember table (generated).
+// Record (lead, contact...) targeted by a campaign and its response status
+type SystemCampaignMember struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	CampaignId         string                 `protobuf:"bytes,2,opt,name=campaign_id,proto3" json:"campaign_id,omitempty"`
+	ObjectApiName      string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId           string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Status             string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	HasResponded       bool                   `protobuf:"varint,6,opt,name=has_responded,proto3" json:"has_responded,omitempty"`
+	FirstRespondedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_responded_date,proto3" json:"first_responded_date,omitempty"`
+	AddedById          string                 `protobuf:"bytes,8,opt,name=added_by_id,proto3" json:"added_by_id,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemCampaignMember) Reset() {
+	*x = SystemCampaignMember{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCampaignMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCampaignMember) ProtoMessage() {}
+
+func (x *SystemCampaignMember) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCampaignMember.ProtoReflect.Descriptor instead.
+func (*SystemCampaignMember) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SystemCampaignMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetHasResponded() bool {
+	if x != nil {
+		return x.HasResponded
+	}
+	return false
+}
+
+func (x *SystemCampaignMember) GetFirstRespondedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstRespondedDate
+	}
+	return nil
+}
+
+func (x *SystemCampaignMember) GetAddedById() string {
+	if x != nil {
+		return x.AddedById
+	}
+	return ""
+}
+
+func (x *SystemCampaignMember) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCampaignMember) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemChangeEvent represents the _System_ChangeEvent table (generated).
+// Change data capture stream: one row per captured record change, ordered by position
+type SystemChangeEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Position         int64                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Operation        string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	TransactionId    string                 `protobuf:"bytes,6,opt,name=transaction_id,proto3" json:"transaction_id,omitempty"`
+	CommitTimestamp  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=commit_timestamp,proto3" json:"commit_timestamp,omitempty"`
+	ChangedFields    *structpb.Value        `protobuf:"bytes,8,opt,name=changed_fields,proto3" json:"changed_fields,omitempty"`
+	BeforeData       *structpb.Value        `protobuf:"bytes,9,opt,name=before_data,proto3" json:"before_data,omitempty"`
+	AfterData        *structpb.Value        `protobuf:"bytes,10,opt,name=after_data,proto3" json:"after_data,omitempty"`
+	UserId           *string                `protobuf:"bytes,11,opt,name=user_id,proto3,oneof" json:"user_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemChangeEvent) Reset() {
+	*x = SystemChangeEvent{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChangeEvent) ProtoMessage() {}
+
+func (x *SystemChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChangeEvent.ProtoReflect.Descriptor instead.
+func (*SystemChangeEvent) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SystemChangeEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SystemChangeEvent) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetCommitTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitTimestamp
+	}
+	return nil
+}
+
+func (x *SystemChangeEvent) GetChangedFields() *structpb.Value {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *SystemChangeEvent) GetBeforeData() *structpb.Value {
+	if x != nil {
+		return x.BeforeData
+	}
+	return nil
+}
+
+func (x *SystemChangeEvent) GetAfterData() *structpb.Value {
+	if x != nil {
+		return x.AfterData
+	}
+	return nil
+}
+
+func (x *SystemChangeEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *SystemChangeEvent) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemChangeEvent) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemChangeEventOffset represents the _System_ChangeEventOffset table (generated).
+// Last change event position committed by each change data capture consumer
+type SystemChangeEventOffset struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Consumer         string                 `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Position         int64                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemChangeEventOffset) Reset() {
+	*x = SystemChangeEventOffset{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemChangeEventOffset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChangeEventOffset) ProtoMessage() {}
+
+func (x *SystemChangeEventOffset) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChangeEventOffset.ProtoReflect.Descriptor instead.
+func (*SystemChangeEventOffset) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SystemChangeEventOffset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemChangeEventOffset) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *SystemChangeEventOffset) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SystemChangeEventOffset) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemChangeEventOffset) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemComment represents the _System_Comment table (generated).
+// User comments on records
+type SystemComment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Body             string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedById      string                 `protobuf:"bytes,5,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,7,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	ParentCommentId  *string                `protobuf:"bytes,8,opt,name=parent_comment_id,proto3,oneof" json:"parent_comment_id,omitempty"`
+	IsResolved       bool                   `protobuf:"varint,9,opt,name=is_resolved,proto3" json:"is_resolved,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemComment) Reset() {
+	*x = SystemComment{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemComment) ProtoMessage() {}
+
+func (x *SystemComment) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemComment.ProtoReflect.Descriptor instead.
+func (*SystemComment) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SystemComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemComment) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemComment) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SystemComment) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemComment) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemComment) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemComment) GetParentCommentId() string {
+	if x != nil && x.ParentCommentId != nil {
+		return *x.ParentCommentId
+	}
+	return ""
+}
+
+func (x *SystemComment) GetIsResolved() bool {
+	if x != nil {
+		return x.IsResolved
+	}
+	return false
+}
+
+func (x *SystemComment) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemConfig represents the _System_Config table (generated).
+// Legacy flat configuration; superseded by _System_CustomSetting and migrated into it at startup
+type SystemConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	KeyName          string                 `protobuf:"bytes,1,opt,name=key_name,proto3" json:"key_name,omitempty"`
+	Value            string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	IsSecret         bool                   `protobuf:"varint,3,opt,name=is_secret,proto3" json:"is_secret,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemConfig) Reset() {
+	*x = SystemConfig{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemConfig) ProtoMessage() {}
+
+func (x *SystemConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemConfig.ProtoReflect.Descriptor instead.
+func (*SystemConfig) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SystemConfig) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *SystemConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SystemConfig) GetIsSecret() bool {
+	if x != nil {
+		return x.IsSecret
+	}
+	return false
+}
+
+func (x *SystemConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemConfig) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemConfig) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemContract represents the _System_Contract table (generated).
+// Support contract of an account; its entitlements apply while it is activated and in term
+type SystemContract struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId        string                 `protobuf:"bytes,3,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate        string                 `protobuf:"bytes,5,opt,name=start_date,proto3" json:"start_date,omitempty"`
+	EndDate          *string                `protobuf:"bytes,6,opt,name=end_date,proto3,oneof" json:"end_date,omitempty"`
+	Description      *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,8,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemContract) Reset() {
+	*x = SystemContract{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemContract) ProtoMessage() {}
+
+func (x *SystemContract) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemContract.ProtoReflect.Descriptor instead.
+func (*SystemContract) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SystemContract) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemContract) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemContract) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SystemContract) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemContract) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SystemContract) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *SystemContract) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemContract) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemContract) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemContract) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCustomEndpoint represents the _System_CustomEndpoint table (generated).
+// Custom REST endpoints served at /api/custom/:name that run a script or a declarative query or DML template on validated input
+type SystemCustomEndpoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	HttpMethod       string                 `protobuf:"bytes,4,opt,name=http_method,proto3" json:"http_method,omitempty"`
+	Action           string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	ScriptName       *string                `protobuf:"bytes,6,opt,name=script_name,proto3,oneof" json:"script_name,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,7,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	FilterExpr       *string                `protobuf:"bytes,8,opt,name=filter_expr,proto3,oneof" json:"filter_expr,omitempty"`
+	SortField        *string                `protobuf:"bytes,9,opt,name=sort_field,proto3,oneof" json:"sort_field,omitempty"`
+	SortDirection    *string                `protobuf:"bytes,10,opt,name=sort_direction,proto3,oneof" json:"sort_direction,omitempty"`
+	RowLimit         *int32                 `protobuf:"varint,11,opt,name=row_limit,proto3,oneof" json:"row_limit,omitempty"`
+	RecordId         *string                `protobuf:"bytes,12,opt,name=record_id,proto3,oneof" json:"record_id,omitempty"`
+	FieldValues      *structpb.Value        `protobuf:"bytes,13,opt,name=field_values,proto3" json:"field_values,omitempty"`
+	InputSchema      *structpb.Value        `protobuf:"bytes,14,opt,name=input_schema,proto3" json:"input_schema,omitempty"`
+	ProfileIds       *structpb.Value        `protobuf:"bytes,15,opt,name=profile_ids,proto3" json:"profile_ids,omitempty"`
+	IsActive         bool                   `protobuf:"varint,16,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,17,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemCustomEndpoint) Reset() {
+	*x = SystemCustomEndpoint{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCustomEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCustomEndpoint) ProtoMessage() {}
+
+func (x *SystemCustomEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCustomEndpoint.ProtoReflect.Descriptor instead.
+func (*SystemCustomEndpoint) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SystemCustomEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetHttpMethod() string {
+	if x != nil {
+		return x.HttpMethod
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetScriptName() string {
+	if x != nil && x.ScriptName != nil {
+		return *x.ScriptName
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetFilterExpr() string {
+	if x != nil && x.FilterExpr != nil {
+		return *x.FilterExpr
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetSortField() string {
+	if x != nil && x.SortField != nil {
+		return *x.SortField
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetSortDirection() string {
+	if x != nil && x.SortDirection != nil {
+		return *x.SortDirection
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetRowLimit() int32 {
+	if x != nil && x.RowLimit != nil {
+		return *x.RowLimit
+	}
+	return 0
+}
+
+func (x *SystemCustomEndpoint) GetRecordId() string {
+	if x != nil && x.RecordId != nil {
+		return *x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetFieldValues() *structpb.Value {
+	if x != nil {
+		return x.FieldValues
+	}
+	return nil
+}
+
+func (x *SystemCustomEndpoint) GetInputSchema() *structpb.Value {
+	if x != nil {
+		return x.InputSchema
+	}
+	return nil
+}
+
+func (x *SystemCustomEndpoint) GetProfileIds() *structpb.Value {
+	if x != nil {
+		return x.ProfileIds
+	}
+	return nil
+}
+
+func (x *SystemCustomEndpoint) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemCustomEndpoint) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemCustomEndpoint) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCustomEndpoint) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCustomMetadataRecord represents the _System_CustomMetadataRecord table (generated).
+// Records of custom metadata types, cached in memory with the rest of the metadata
+type SystemCustomMetadataRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	TypeApiName      string                 `protobuf:"bytes,2,opt,name=type_api_name,proto3" json:"type_api_name,omitempty"`
+	DeveloperName    string                 `protobuf:"bytes,3,opt,name=developer_name,proto3" json:"developer_name,omitempty"`
+	Label            string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	FieldValues      *structpb.Value        `protobuf:"bytes,5,opt,name=field_values,proto3" json:"field_values,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemCustomMetadataRecord) Reset() {
+	*x = SystemCustomMetadataRecord{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCustomMetadataRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCustomMetadataRecord) ProtoMessage() {}
+
+func (x *SystemCustomMetadataRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCustomMetadataRecord.ProtoReflect.Descriptor instead.
+func (*SystemCustomMetadataRecord) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SystemCustomMetadataRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataRecord) GetTypeApiName() string {
+	if x != nil {
+		return x.TypeApiName
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataRecord) GetDeveloperName() string {
+	if x != nil {
+		return x.DeveloperName
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataRecord) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataRecord) GetFieldValues() *structpb.Value {
+	if x != nil {
+		return x.FieldValues
+	}
+	return nil
+}
+
+func (x *SystemCustomMetadataRecord) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCustomMetadataRecord) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCustomMetadataType represents the _System_CustomMetadataType table (generated).
+// Admin-defined configuration types whose records are deployed as metadata
+type SystemCustomMetadataType struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ApiName          string                 `protobuf:"bytes,2,opt,name=api_name,proto3" json:"api_name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Fields           *structpb.Value        `protobuf:"bytes,5,opt,name=fields,proto3" json:"fields,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemCustomMetadataType) Reset() {
+	*x = SystemCustomMetadataType{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCustomMetadataType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCustomMetadataType) ProtoMessage() {}
+
+func (x *SystemCustomMetadataType) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCustomMetadataType.ProtoReflect.Descriptor instead.
+func (*SystemCustomMetadataType) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SystemCustomMetadataType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataType) GetApiName() string {
+	if x != nil {
+		return x.ApiName
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataType) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataType) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemCustomMetadataType) GetFields() *structpb.Value {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SystemCustomMetadataType) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCustomMetadataType) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCustomSetting represents the _System_CustomSetting table (generated).
+// Hierarchical custom settings with an org-wide default value
+type SystemCustomSetting struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Type             string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue     *structpb.Value        `protobuf:"bytes,6,opt,name=default_value,proto3" json:"default_value,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemCustomSetting) Reset() {
+	*x = SystemCustomSetting{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCustomSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCustomSetting) ProtoMessage() {}
+
+func (x *SystemCustomSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCustomSetting.ProtoReflect.Descriptor instead.
+func (*SystemCustomSetting) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SystemCustomSetting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCustomSetting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemCustomSetting) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemCustomSetting) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemCustomSetting) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemCustomSetting) GetDefaultValue() *structpb.Value {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *SystemCustomSetting) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCustomSetting) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemCustomSettingValue represents the _System_CustomSettingValue table (generated).
+// Profile and user overrides of custom settings
+type SystemCustomSettingValue struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	SettingName      string                 `protobuf:"bytes,2,opt,name=setting_name,proto3" json:"setting_name,omitempty"`
+	ScopeType        string                 `protobuf:"bytes,3,opt,name=scope_type,proto3" json:"scope_type,omitempty"`
+	ScopeId          string                 `protobuf:"bytes,4,opt,name=scope_id,proto3" json:"scope_id,omitempty"`
+	Value            *structpb.Value        `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemCustomSettingValue) Reset() {
+	*x = SystemCustomSettingValue{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCustomSettingValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCustomSettingValue) ProtoMessage() {}
+
+func (x *SystemCustomSettingValue) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCustomSettingValue.ProtoReflect.Descriptor instead.
+func (*SystemCustomSettingValue) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SystemCustomSettingValue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemCustomSettingValue) GetSettingName() string {
+	if x != nil {
+		return x.SettingName
+	}
+	return ""
+}
+
+func (x *SystemCustomSettingValue) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *SystemCustomSettingValue) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *SystemCustomSettingValue) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SystemCustomSettingValue) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemCustomSettingValue) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemDashboard represents the _System_Dashboard table (generated).
+// Dashboard configurations with widget-based layouts
+type SystemDashboard struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Layout           string                 `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
+	Widgets          *structpb.Value        `protobuf:"bytes,5,opt,name=widgets,proto3" json:"widgets,omitempty"`
+	Filters          *structpb.Value        `protobuf:"bytes,6,opt,name=filters,proto3" json:"filters,omitempty"`
+	RunAs            string                 `protobuf:"bytes,7,opt,name=run_as,proto3" json:"run_as,omitempty"`
+	RunAsUserId      string                 `protobuf:"bytes,8,opt,name=run_as_user_id,proto3" json:"run_as_user_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,10,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,11,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,12,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,13,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemDashboard) Reset() {
+	*x = SystemDashboard{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemDashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDashboard) ProtoMessage() {}
+
+func (x *SystemDashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDashboard.ProtoReflect.Descriptor instead.
+func (*SystemDashboard) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SystemDashboard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetWidgets() *structpb.Value {
+	if x != nil {
+		return x.Widgets
+	}
+	return nil
+}
+
+func (x *SystemDashboard) GetFilters() *structpb.Value {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SystemDashboard) GetRunAs() string {
+	if x != nil {
+		return x.RunAs
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetRunAsUserId() string {
+	if x != nil {
+		return x.RunAsUserId
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemDashboard) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemDashboard) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemDashboard) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemDataQualityRule represents the _System_Data_Quality_Rule table (generated).
+// Per-object rules for data quality scoring
+type SystemDataQualityRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName      string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	CompletenessFields *structpb.Value        `protobuf:"bytes,3,opt,name=completeness_fields,proto3" json:"completeness_fields,omitempty"`
+	MatchFields        *structpb.Value        `protobuf:"bytes,4,opt,name=match_fields,proto3" json:"match_fields,omitempty"`
+	DuplicateThreshold int32                  `protobuf:"varint,5,opt,name=duplicate_threshold,proto3" json:"duplicate_threshold,omitempty"`
+	UseEmbeddings      bool                   `protobuf:"varint,6,opt,name=use_embeddings,proto3" json:"use_embeddings,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemDataQualityRule) Reset() {
+	*x = SystemDataQualityRule{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemDataQualityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDataQualityRule) ProtoMessage() {}
+
+func (x *SystemDataQualityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDataQualityRule.ProtoReflect.Descriptor instead.
+func (*SystemDataQualityRule) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SystemDataQualityRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemDataQualityRule) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemDataQualityRule) GetCompletenessFields() *structpb.Value {
+	if x != nil {
+		return x.CompletenessFields
+	}
+	return nil
+}
+
+func (x *SystemDataQualityRule) GetMatchFields() *structpb.Value {
+	if x != nil {
+		return x.MatchFields
+	}
+	return nil
+}
+
+func (x *SystemDataQualityRule) GetDuplicateThreshold() int32 {
+	if x != nil {
+		return x.DuplicateThreshold
+	}
+	return 0
+}
+
+func (x *SystemDataQualityRule) GetUseEmbeddings() bool {
+	if x != nil {
+		return x.UseEmbeddings
+	}
+	return false
+}
+
+func (x *SystemDataQualityRule) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemDataQualityRule) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemDataQualityScore represents the _System_Data_Quality_Score table (generated).
+// Completeness and duplicate scores of CRM records
+type SystemDataQualityScore struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName     string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId          string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	CompletenessScore int32                  `protobuf:"varint,4,opt,name=completeness_score,proto3" json:"completeness_score,omitempty"`
+	DuplicateScore    int32                  `protobuf:"varint,5,opt,name=duplicate_score,proto3" json:"duplicate_score,omitempty"`
+	DuplicateOf       *string                `protobuf:"bytes,6,opt,name=duplicate_of,proto3,oneof" json:"duplicate_of,omitempty"`
+	QualityScore      int32                  `protobuf:"varint,7,opt,name=quality_score,proto3" json:"quality_score,omitempty"`
+	MissingFields     *structpb.Value        `protobuf:"bytes,8,opt,name=missing_fields,proto3" json:"missing_fields,omitempty"`
+	ScoredDate        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=scored_date,proto3" json:"scored_date,omitempty"`
+	CreatedDate       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SystemDataQualityScore) Reset() {
+	*x = SystemDataQualityScore{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemDataQualityScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDataQualityScore) ProtoMessage() {}
+
+func (x *SystemDataQualityScore) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDataQualityScore.ProtoReflect.Descriptor instead.
+func (*SystemDataQualityScore) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SystemDataQualityScore) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemDataQualityScore) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemDataQualityScore) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemDataQualityScore) GetCompletenessScore() int32 {
+	if x != nil {
+		return x.CompletenessScore
+	}
+	return 0
+}
+
+func (x *SystemDataQualityScore) GetDuplicateScore() int32 {
+	if x != nil {
+		return x.DuplicateScore
+	}
+	return 0
+}
+
+func (x *SystemDataQualityScore) GetDuplicateOf() string {
+	if x != nil && x.DuplicateOf != nil {
+		return *x.DuplicateOf
+	}
+	return ""
+}
+
+func (x *SystemDataQualityScore) GetQualityScore() int32 {
+	if x != nil {
+		return x.QualityScore
+	}
+	return 0
+}
+
+func (x *SystemDataQualityScore) GetMissingFields() *structpb.Value {
+	if x != nil {
+		return x.MissingFields
+	}
+	return nil
+}
+
+func (x *SystemDataQualityScore) GetScoredDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScoredDate
+	}
+	return nil
+}
+
+func (x *SystemDataQualityScore) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemDataQualityScore) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemDeletedMetadata represents the _System_DeletedMetadata table (generated).
+// Erased objects and fields kept restorable, with their data, until the grace period ends
+type SystemDeletedMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ComponentType    string                 `protobuf:"bytes,2,opt,name=component_type,proto3" json:"component_type,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	FieldApiName     *string                `protobuf:"bytes,4,opt,name=field_api_name,proto3,oneof" json:"field_api_name,omitempty"`
+	StorageName      *string                `protobuf:"bytes,5,opt,name=storage_name,proto3,oneof" json:"storage_name,omitempty"`
+	Metadata         *structpb.Value        `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DeletedById      *string                `protobuf:"bytes,7,opt,name=deleted_by_id,proto3,oneof" json:"deleted_by_id,omitempty"`
+	DeletedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_date,proto3" json:"deleted_date,omitempty"`
+	PurgeAfter       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=purge_after,proto3" json:"purge_after,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemDeletedMetadata) Reset() {
+	*x = SystemDeletedMetadata{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemDeletedMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDeletedMetadata) ProtoMessage() {}
+
+func (x *SystemDeletedMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDeletedMetadata.ProtoReflect.Descriptor instead.
+func (*SystemDeletedMetadata) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SystemDeletedMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetComponentType() string {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetFieldApiName() string {
+	if x != nil && x.FieldApiName != nil {
+		return *x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetStorageName() string {
+	if x != nil && x.StorageName != nil {
+		return *x.StorageName
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetMetadata() *structpb.Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SystemDeletedMetadata) GetDeletedById() string {
+	if x != nil && x.DeletedById != nil {
+		return *x.DeletedById
+	}
+	return ""
+}
+
+func (x *SystemDeletedMetadata) GetDeletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedDate
+	}
+	return nil
+}
+
+func (x *SystemDeletedMetadata) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+func (x *SystemDeletedMetadata) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemDeletedMetadata) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemDocumentTemplate represents the _System_DocumentTemplate table (generated).
+// Printable document templates (HTML or DOCX with {{field}} merge fields, barcodes and QR codes) rendered to PDF from a record and stored as a file
+type SystemDocumentTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	TemplateType     string                 `protobuf:"bytes,5,opt,name=template_type,proto3" json:"template_type,omitempty"`
+	Content          string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	FileName         *string                `protobuf:"bytes,7,opt,name=file_name,proto3,oneof" json:"file_name,omitempty"`
+	PageSize         string                 `protobuf:"bytes,8,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	IsActive         bool                   `protobuf:"varint,9,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemDocumentTemplate) Reset() {
+	*x = SystemDocumentTemplate{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemDocumentTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDocumentTemplate) ProtoMessage() {}
+
+func (x *SystemDocumentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDocumentTemplate.ProtoReflect.Descriptor instead.
+func (*SystemDocumentTemplate) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SystemDocumentTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetTemplateType() string {
+	if x != nil {
+		return x.TemplateType
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetPageSize() string {
+	if x != nil {
+		return x.PageSize
+	}
+	return ""
+}
+
+func (x *SystemDocumentTemplate) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemDocumentTemplate) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemDocumentTemplate) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemEmailTemplate represents the _System_EmailTemplate table (generated).
+// Email templates for notifications
+type SystemEmailTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Subject          string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody         string                 `protobuf:"bytes,4,opt,name=html_body,proto3" json:"html_body,omitempty"`
+	TextBody         string                 `protobuf:"bytes,5,opt,name=text_body,proto3" json:"text_body,omitempty"`
+	FromName         string                 `protobuf:"bytes,6,opt,name=from_name,proto3" json:"from_name,omitempty"`
+	FromEmail        string                 `protobuf:"bytes,7,opt,name=from_email,proto3" json:"from_email,omitempty"`
+	ReplyTo          string                 `protobuf:"bytes,8,opt,name=reply_to,proto3" json:"reply_to,omitempty"`
+	IsActive         bool                   `protobuf:"varint,9,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEmailTemplate) Reset() {
+	*x = SystemEmailTemplate{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEmailTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEmailTemplate) ProtoMessage() {}
+
+func (x *SystemEmailTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEmailTemplate.ProtoReflect.Descriptor instead.
+func (*SystemEmailTemplate) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SystemEmailTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *SystemEmailTemplate) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemEmailTemplate) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemEmailTemplate) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemEntitlement represents the _System_Entitlement table (generated).
+// Support an account is entitled to; each case created against it consumes one unit
+type SystemEntitlement struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId        string                 `protobuf:"bytes,3,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	ContractId       *string                `protobuf:"bytes,4,opt,name=contract_id,proto3,oneof" json:"contract_id,omitempty"`
+	Type             *string                `protobuf:"bytes,5,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	StartDate        string                 `protobuf:"bytes,6,opt,name=start_date,proto3" json:"start_date,omitempty"`
+	EndDate          *string                `protobuf:"bytes,7,opt,name=end_date,proto3,oneof" json:"end_date,omitempty"`
+	TotalUnits       *float64               `protobuf:"fixed64,8,opt,name=total_units,proto3,oneof" json:"total_units,omitempty"`
+	RemainingUnits   *float64               `protobuf:"fixed64,9,opt,name=remaining_units,proto3,oneof" json:"remaining_units,omitempty"`
+	IsActive         bool                   `protobuf:"varint,10,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,11,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEntitlement) Reset() {
+	*x = SystemEntitlement{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEntitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEntitlement) ProtoMessage() {}
+
+func (x *SystemEntitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEntitlement.ProtoReflect.Descriptor instead.
+func (*SystemEntitlement) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SystemEntitlement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetContractId() string {
+	if x != nil && x.ContractId != nil {
+		return *x.ContractId
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetTotalUnits() float64 {
+	if x != nil && x.TotalUnits != nil {
+		return *x.TotalUnits
+	}
+	return 0
+}
+
+func (x *SystemEntitlement) GetRemainingUnits() float64 {
+	if x != nil && x.RemainingUnits != nil {
+		return *x.RemainingUnits
+	}
+	return 0
+}
+
+func (x *SystemEntitlement) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemEntitlement) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemEntitlement) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemEntitlement) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemEntitlementUsage represents the _System_EntitlementUsage table (generated).
+// Units of an entitlement consumed by a case
+type SystemEntitlementUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	EntitlementId    string                 `protobuf:"bytes,2,opt,name=entitlement_id,proto3" json:"entitlement_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Units            float64                `protobuf:"fixed64,5,opt,name=units,proto3" json:"units,omitempty"`
+	ConsumedById     string                 `protobuf:"bytes,6,opt,name=consumed_by_id,proto3" json:"consumed_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEntitlementUsage) Reset() {
+	*x = SystemEntitlementUsage{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEntitlementUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEntitlementUsage) ProtoMessage() {}
+
+func (x *SystemEntitlementUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEntitlementUsage.ProtoReflect.Descriptor instead.
+func (*SystemEntitlementUsage) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SystemEntitlementUsage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemEntitlementUsage) GetEntitlementId() string {
+	if x != nil {
+		return x.EntitlementId
+	}
+	return ""
+}
+
+func (x *SystemEntitlementUsage) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemEntitlementUsage) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemEntitlementUsage) GetUnits() float64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *SystemEntitlementUsage) GetConsumedById() string {
+	if x != nil {
+		return x.ConsumedById
+	}
+	return ""
+}
+
+func (x *SystemEntitlementUsage) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemEntitlementUsage) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemEscalationLog represents the _System_EscalationLog table (generated).
+// Escalation actions taken on records, one row per rule level
+type SystemEscalationLog struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	RuleName         string                 `protobuf:"bytes,2,opt,name=rule_name,proto3" json:"rule_name,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Level            int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	EscalatedDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=escalated_date,proto3" json:"escalated_date,omitempty"`
+	ErrorMessage     *string                `protobuf:"bytes,7,opt,name=error_message,proto3,oneof" json:"error_message,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEscalationLog) Reset() {
+	*x = SystemEscalationLog{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEscalationLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEscalationLog) ProtoMessage() {}
+
+func (x *SystemEscalationLog) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEscalationLog.ProtoReflect.Descriptor instead.
+func (*SystemEscalationLog) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SystemEscalationLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemEscalationLog) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *SystemEscalationLog) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemEscalationLog) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemEscalationLog) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SystemEscalationLog) GetEscalatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EscalatedDate
+	}
+	return nil
+}
+
+func (x *SystemEscalationLog) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SystemEscalationLog) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemEscalationLog) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemEscalationRule represents the _System_EscalationRule table (generated).
+// Time-based escalations (reassign, notify, run flow) for records that stay open too long
+type SystemEscalationRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Criteria         *string                `protobuf:"bytes,5,opt,name=criteria,proto3,oneof" json:"criteria,omitempty"`
+	StartField       string                 `protobuf:"bytes,6,opt,name=start_field,proto3" json:"start_field,omitempty"`
+	BusinessHours    *string                `protobuf:"bytes,7,opt,name=business_hours,proto3,oneof" json:"business_hours,omitempty"`
+	Actions          *structpb.Value        `protobuf:"bytes,8,opt,name=actions,proto3" json:"actions,omitempty"`
+	IsActive         bool                   `protobuf:"varint,9,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEscalationRule) Reset() {
+	*x = SystemEscalationRule{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEscalationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEscalationRule) ProtoMessage() {}
+
+func (x *SystemEscalationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEscalationRule.ProtoReflect.Descriptor instead.
+func (*SystemEscalationRule) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SystemEscalationRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetCriteria() string {
+	if x != nil && x.Criteria != nil {
+		return *x.Criteria
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetStartField() string {
+	if x != nil {
+		return x.StartField
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetBusinessHours() string {
+	if x != nil && x.BusinessHours != nil {
+		return *x.BusinessHours
+	}
+	return ""
+}
+
+func (x *SystemEscalationRule) GetActions() *structpb.Value {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *SystemEscalationRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemEscalationRule) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemEscalationRule) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemExternalObject represents the _System_ExternalObject table (generated).
+// Connection settings of external objects, read-only objects whose records are fetched from a REST, OData or SQL source at query time
+type SystemExternalObject struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Adapter          string                 `protobuf:"bytes,3,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	NamedCredential  *string                `protobuf:"bytes,4,opt,name=named_credential,proto3,oneof" json:"named_credential,omitempty"`
+	Resource         string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	RecordsPath      *string                `protobuf:"bytes,6,opt,name=records_path,proto3,oneof" json:"records_path,omitempty"`
+	IdField          string                 `protobuf:"bytes,7,opt,name=id_field,proto3" json:"id_field,omitempty"`
+	FieldMap         *structpb.Value        `protobuf:"bytes,8,opt,name=field_map,proto3" json:"field_map,omitempty"`
+	Dsn              *string                `protobuf:"bytes,9,opt,name=dsn,proto3,oneof" json:"dsn,omitempty"`
+	MaxRows          int32                  `protobuf:"varint,10,opt,name=max_rows,proto3" json:"max_rows,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemExternalObject) Reset() {
+	*x = SystemExternalObject{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemExternalObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemExternalObject) ProtoMessage() {}
+
+func (x *SystemExternalObject) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemExternalObject.ProtoReflect.Descriptor instead.
+func (*SystemExternalObject) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SystemExternalObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetNamedCredential() string {
+	if x != nil && x.NamedCredential != nil {
+		return *x.NamedCredential
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetRecordsPath() string {
+	if x != nil && x.RecordsPath != nil {
+		return *x.RecordsPath
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetIdField() string {
+	if x != nil {
+		return x.IdField
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetFieldMap() *structpb.Value {
+	if x != nil {
+		return x.FieldMap
+	}
+	return nil
+}
+
+func (x *SystemExternalObject) GetDsn() string {
+	if x != nil && x.Dsn != nil {
+		return *x.Dsn
+	}
+	return ""
+}
+
+func (x *SystemExternalObject) GetMaxRows() int32 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *SystemExternalObject) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemExternalObject) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFeedItem represents the _System_FeedItem table (generated).
+// Feed items for chatter and notifications
+type SystemFeedItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ParentId         string                 `protobuf:"bytes,2,opt,name=parent_id,proto3" json:"parent_id,omitempty"`
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Body             string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedById      string                 `protobuf:"bytes,5,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFeedItem) Reset() {
+	*x = SystemFeedItem{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFeedItem) ProtoMessage() {}
+
+func (x *SystemFeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFeedItem.ProtoReflect.Descriptor instead.
+func (*SystemFeedItem) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SystemFeedItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFeedItem) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SystemFeedItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemFeedItem) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SystemFeedItem) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemFeedItem) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFeedItem) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemField represents the _System_Field table (generated).
+// Field metadata definitions
+type SystemField struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectId           string                 `protobuf:"bytes,2,opt,name=object_id,proto3" json:"object_id,omitempty"`
+	ApiName            string                 `protobuf:"bytes,3,opt,name=api_name,proto3" json:"api_name,omitempty"`
+	Label              string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type               string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Required           bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	IsUnique           bool                   `protobuf:"varint,7,opt,name=is_unique,proto3" json:"is_unique,omitempty"`
+	IsSystem           bool                   `protobuf:"varint,8,opt,name=is_system,proto3" json:"is_system,omitempty"`
+	IsNameField        bool                   `protobuf:"varint,9,opt,name=is_name_field,proto3" json:"is_name_field,omitempty"`
+	Indexed            bool                   `protobuf:"varint,10,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	Options            *structpb.Value        `protobuf:"bytes,11,opt,name=options,proto3" json:"options,omitempty"`
+	ReferenceTo        *structpb.Value        `protobuf:"bytes,12,opt,name=reference_to,proto3" json:"reference_to,omitempty"`
+	DeleteRule         *string                `protobuf:"bytes,13,opt,name=delete_rule,proto3,oneof" json:"delete_rule,omitempty"`
+	Formula            *string                `protobuf:"bytes,14,opt,name=formula,proto3,oneof" json:"formula,omitempty"`
+	ReturnType         *string                `protobuf:"bytes,15,opt,name=return_type,proto3,oneof" json:"return_type,omitempty"`
+	DefaultValue       *string                `protobuf:"bytes,16,opt,name=default_value,proto3,oneof" json:"default_value,omitempty"`
+	HelpText           *string                `protobuf:"bytes,17,opt,name=help_text,proto3,oneof" json:"help_text,omitempty"`
+	Description        *string                `protobuf:"bytes,18,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TrackHistory       bool                   `protobuf:"varint,19,opt,name=track_history,proto3" json:"track_history,omitempty"`
+	MinValue           *float64               `protobuf:"fixed64,20,opt,name=min_value,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue           *float64               `protobuf:"fixed64,21,opt,name=max_value,proto3,oneof" json:"max_value,omitempty"`
+	MinLength          *int32                 `protobuf:"varint,22,opt,name=min_length,proto3,oneof" json:"min_length,omitempty"`
+	MaxLength          *int32                 `protobuf:"varint,23,opt,name=max_length,proto3,oneof" json:"max_length,omitempty"`
+	Regex              *string                `protobuf:"bytes,24,opt,name=regex,proto3,oneof" json:"regex,omitempty"`
+	RegexMessage       *string                `protobuf:"bytes,25,opt,name=regex_message,proto3,oneof" json:"regex_message,omitempty"`
+	Validator          *string                `protobuf:"bytes,26,opt,name=validator,proto3,oneof" json:"validator,omitempty"`
+	ControllingField   *string                `protobuf:"bytes,27,opt,name=controlling_field,proto3,oneof" json:"controlling_field,omitempty"`
+	PicklistDependency *structpb.Value        `protobuf:"bytes,28,opt,name=picklist_dependency,proto3" json:"picklist_dependency,omitempty"`
+	InactiveOptions    *structpb.Value        `protobuf:"bytes,29,opt,name=inactive_options,proto3" json:"inactive_options,omitempty"`
+	ValueSet           *string                `protobuf:"bytes,30,opt,name=value_set,proto3,oneof" json:"value_set,omitempty"`
+	LookupFilter       *string                `protobuf:"bytes,31,opt,name=lookup_filter,proto3,oneof" json:"lookup_filter,omitempty"`
+	RollupConfig       *structpb.Value        `protobuf:"bytes,32,opt,name=rollup_config,proto3" json:"rollup_config,omitempty"`
+	IsMasterDetail     bool                   `protobuf:"varint,33,opt,name=is_master_detail,proto3" json:"is_master_detail,omitempty"`
+	IsPolymorphic      bool                   `protobuf:"varint,34,opt,name=is_polymorphic,proto3" json:"is_polymorphic,omitempty"`
+	RelationshipName   *string                `protobuf:"bytes,35,opt,name=relationship_name,proto3,oneof" json:"relationship_name,omitempty"`
+	IsDeleted          bool                   `protobuf:"varint,36,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId            *string                `protobuf:"bytes,37,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById        *string                `protobuf:"bytes,38,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById   *string                `protobuf:"bytes,39,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,40,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,41,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemField) Reset() {
+	*x = SystemField{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemField) ProtoMessage() {}
+
+func (x *SystemField) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemField.ProtoReflect.Descriptor instead.
+func (*SystemField) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SystemField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemField) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *SystemField) GetApiName() string {
+	if x != nil {
+		return x.ApiName
+	}
+	return ""
+}
+
+func (x *SystemField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *SystemField) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *SystemField) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *SystemField) GetIsNameField() bool {
+	if x != nil {
+		return x.IsNameField
+	}
+	return false
+}
+
+func (x *SystemField) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+func (x *SystemField) GetOptions() *structpb.Value {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SystemField) GetReferenceTo() *structpb.Value {
+	if x != nil {
+		return x.ReferenceTo
+	}
+	return nil
+}
+
+func (x *SystemField) GetDeleteRule() string {
+	if x != nil && x.DeleteRule != nil {
+		return *x.DeleteRule
+	}
+	return ""
+}
+
+func (x *SystemField) GetFormula() string {
+	if x != nil && x.Formula != nil {
+		return *x.Formula
+	}
+	return ""
+}
+
+func (x *SystemField) GetReturnType() string {
+	if x != nil && x.ReturnType != nil {
+		return *x.ReturnType
+	}
+	return ""
+}
+
+func (x *SystemField) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *SystemField) GetHelpText() string {
+	if x != nil && x.HelpText != nil {
+		return *x.HelpText
+	}
+	return ""
+}
+
+func (x *SystemField) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemField) GetTrackHistory() bool {
+	if x != nil {
+		return x.TrackHistory
+	}
+	return false
+}
+
+func (x *SystemField) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *SystemField) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *SystemField) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *SystemField) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *SystemField) GetRegex() string {
+	if x != nil && x.Regex != nil {
+		return *x.Regex
+	}
+	return ""
+}
+
+func (x *SystemField) GetRegexMessage() string {
+	if x != nil && x.RegexMessage != nil {
+		return *x.RegexMessage
+	}
+	return ""
+}
+
+func (x *SystemField) GetValidator() string {
+	if x != nil && x.Validator != nil {
+		return *x.Validator
+	}
+	return ""
+}
+
+func (x *SystemField) GetControllingField() string {
+	if x != nil && x.ControllingField != nil {
+		return *x.ControllingField
+	}
+	return ""
+}
+
+func (x *SystemField) GetPicklistDependency() *structpb.Value {
+	if x != nil {
+		return x.PicklistDependency
+	}
+	return nil
+}
+
+func (x *SystemField) GetInactiveOptions() *structpb.Value {
+	if x != nil {
+		return x.InactiveOptions
+	}
+	return nil
+}
+
+func (x *SystemField) GetValueSet() string {
+	if x != nil && x.ValueSet != nil {
+		return *x.ValueSet
+	}
+	return ""
+}
+
+func (x *SystemField) GetLookupFilter() string {
+	if x != nil && x.LookupFilter != nil {
+		return *x.LookupFilter
+	}
+	return ""
+}
+
+func (x *SystemField) GetRollupConfig() *structpb.Value {
+	if x != nil {
+		return x.RollupConfig
+	}
+	return nil
+}
+
+func (x *SystemField) GetIsMasterDetail() bool {
+	if x != nil {
+		return x.IsMasterDetail
+	}
+	return false
+}
+
+func (x *SystemField) GetIsPolymorphic() bool {
+	if x != nil {
+		return x.IsPolymorphic
+	}
+	return false
+}
+
+func (x *SystemField) GetRelationshipName() string {
+	if x != nil && x.RelationshipName != nil {
+		return *x.RelationshipName
+	}
+	return ""
+}
+
+func (x *SystemField) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemField) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemField) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemField) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemField) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemField) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFieldDependency represents the _System_FieldDependency table (generated).
+// Field dependency rules
+type SystemFieldDependency struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ControllingFieldId string                 `protobuf:"bytes,2,opt,name=controlling_field_id,proto3" json:"controlling_field_id,omitempty"`
+	DependentFieldId   string                 `protobuf:"bytes,3,opt,name=dependent_field_id,proto3" json:"dependent_field_id,omitempty"`
+	ControllingValue   string                 `protobuf:"bytes,4,opt,name=controlling_value,proto3" json:"controlling_value,omitempty"`
+	DependentValues    *structpb.Value        `protobuf:"bytes,5,opt,name=dependent_values,proto3" json:"dependent_values,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemFieldDependency) Reset() {
+	*x = SystemFieldDependency{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFieldDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFieldDependency) ProtoMessage() {}
+
+func (x *SystemFieldDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFieldDependency.ProtoReflect.Descriptor instead.
+func (*SystemFieldDependency) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SystemFieldDependency) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFieldDependency) GetControllingFieldId() string {
+	if x != nil {
+		return x.ControllingFieldId
+	}
+	return ""
+}
+
+func (x *SystemFieldDependency) GetDependentFieldId() string {
+	if x != nil {
+		return x.DependentFieldId
+	}
+	return ""
+}
+
+func (x *SystemFieldDependency) GetControllingValue() string {
+	if x != nil {
+		return x.ControllingValue
+	}
+	return ""
+}
+
+func (x *SystemFieldDependency) GetDependentValues() *structpb.Value {
+	if x != nil {
+		return x.DependentValues
+	}
+	return nil
+}
+
+func (x *SystemFieldDependency) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFieldDependency) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFieldPerms represents the _System_FieldPerms table (generated).
+// Field permissions for profiles
+type SystemFieldPerms struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProfileId        *string                `protobuf:"bytes,2,opt,name=profile_id,proto3,oneof" json:"profile_id,omitempty"`
+	PermissionSetId  *string                `protobuf:"bytes,3,opt,name=permission_set_id,proto3,oneof" json:"permission_set_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	FieldApiName     string                 `protobuf:"bytes,5,opt,name=field_api_name,proto3" json:"field_api_name,omitempty"`
+	Readable         bool                   `protobuf:"varint,6,opt,name=readable,proto3" json:"readable,omitempty"`
+	Editable         bool                   `protobuf:"varint,7,opt,name=editable,proto3" json:"editable,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFieldPerms) Reset() {
+	*x = SystemFieldPerms{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFieldPerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFieldPerms) ProtoMessage() {}
+
+func (x *SystemFieldPerms) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFieldPerms.ProtoReflect.Descriptor instead.
+func (*SystemFieldPerms) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SystemFieldPerms) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFieldPerms) GetProfileId() string {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemFieldPerms) GetPermissionSetId() string {
+	if x != nil && x.PermissionSetId != nil {
+		return *x.PermissionSetId
+	}
+	return ""
+}
+
+func (x *SystemFieldPerms) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemFieldPerms) GetFieldApiName() string {
+	if x != nil {
+		return x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemFieldPerms) GetReadable() bool {
+	if x != nil {
+		return x.Readable
+	}
+	return false
+}
+
+func (x *SystemFieldPerms) GetEditable() bool {
+	if x != nil {
+		return x.Editable
+	}
+	return false
+}
+
+func (x *SystemFieldPerms) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFieldPerms) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFile represents the _System_File table (generated).
+// File attachments
+type SystemFile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ParentId         string                 `protobuf:"bytes,2,opt,name=parent_id,proto3" json:"parent_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType         string                 `protobuf:"bytes,4,opt,name=mime_type,proto3" json:"mime_type,omitempty"`
+	SizeBytes        int64                  `protobuf:"varint,5,opt,name=size_bytes,proto3" json:"size_bytes,omitempty"`
+	StoragePath      string                 `protobuf:"bytes,6,opt,name=storage_path,proto3" json:"storage_path,omitempty"`
+	CreatedById      string                 `protobuf:"bytes,7,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFile) Reset() {
+	*x = SystemFile{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFile) ProtoMessage() {}
+
+func (x *SystemFile) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFile.ProtoReflect.Descriptor instead.
+func (*SystemFile) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SystemFile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFile) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SystemFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemFile) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SystemFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SystemFile) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
+}
+
+func (x *SystemFile) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemFile) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFile) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFlow represents the _System_Flow table (generated).
+// Workflow automation definitions
+type SystemFlow struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TriggerObject    string                 `protobuf:"bytes,3,opt,name=trigger_object,proto3" json:"trigger_object,omitempty"`
+	TriggerType      string                 `protobuf:"bytes,4,opt,name=trigger_type,proto3" json:"trigger_type,omitempty"`
+	TriggerCondition string                 `protobuf:"bytes,5,opt,name=trigger_condition,proto3" json:"trigger_condition,omitempty"`
+	ActionType       string                 `protobuf:"bytes,6,opt,name=action_type,proto3" json:"action_type,omitempty"`
+	ActionConfig     *structpb.Value        `protobuf:"bytes,7,opt,name=action_config,proto3" json:"action_config,omitempty"`
+	FlowType         string                 `protobuf:"bytes,8,opt,name=flow_type,proto3" json:"flow_type,omitempty"`
+	Description      *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status           string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,12,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,13,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,14,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,15,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	Schedule         *string                `protobuf:"bytes,16,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
+	ScheduleTimezone *string                `protobuf:"bytes,17,opt,name=schedule_timezone,proto3,oneof" json:"schedule_timezone,omitempty"`
+	LastRunAt        *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_run_at,proto3" json:"last_run_at,omitempty"`
+	NextRunAt        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=next_run_at,proto3" json:"next_run_at,omitempty"`
+	IsRunning        bool                   `protobuf:"varint,20,opt,name=is_running,proto3" json:"is_running,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFlow) Reset() {
+	*x = SystemFlow{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFlow) ProtoMessage() {}
+
+func (x *SystemFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFlow.ProtoReflect.Descriptor instead.
+func (*SystemFlow) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SystemFlow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetTriggerObject() string {
+	if x != nil {
+		return x.TriggerObject
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetTriggerCondition() string {
+	if x != nil {
+		return x.TriggerCondition
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetActionConfig() *structpb.Value {
+	if x != nil {
+		return x.ActionConfig
+	}
+	return nil
+}
+
+func (x *SystemFlow) GetFlowType() string {
+	if x != nil {
+		return x.FlowType
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFlow) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemFlow) GetSchedule() string {
+	if x != nil && x.Schedule != nil {
+		return *x.Schedule
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetScheduleTimezone() string {
+	if x != nil && x.ScheduleTimezone != nil {
+		return *x.ScheduleTimezone
+	}
+	return ""
+}
+
+func (x *SystemFlow) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *SystemFlow) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *SystemFlow) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *SystemFlow) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemFlowInstance represents the _System_FlowInstance table (generated).
+// Tracks running and paused flow executions
+type SystemFlowInstance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	FlowId           string                 `protobuf:"bytes,2,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentStepId    *string                `protobuf:"bytes,6,opt,name=current_step_id,proto3,oneof" json:"current_step_id,omitempty"`
+	ContextData      *structpb.Value        `protobuf:"bytes,7,opt,name=context_data,proto3" json:"context_data,omitempty"`
+	StartedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_date,proto3" json:"started_date,omitempty"`
+	PausedDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=paused_date,proto3" json:"paused_date,omitempty"`
+	CompletedDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_date,proto3" json:"completed_date,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,11,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,12,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,14,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,16,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFlowInstance) Reset() {
+	*x = SystemFlowInstance{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFlowInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFlowInstance) ProtoMessage() {}
+
+func (x *SystemFlowInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFlowInstance.ProtoReflect.Descriptor instead.
+func (*SystemFlowInstance) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SystemFlowInstance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetFlowId() string {
+	if x != nil {
+		return x.FlowId
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetCurrentStepId() string {
+	if x != nil && x.CurrentStepId != nil {
+		return *x.CurrentStepId
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetContextData() *structpb.Value {
+	if x != nil {
+		return x.ContextData
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetStartedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetPausedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetCompletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemFlowInstance) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowInstance) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemFlowStep represents the _System_FlowStep table (generated).
+// Step definitions for multi-step flows
+type SystemFlowStep struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	FlowId           string                 `protobuf:"bytes,2,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	StepOrder        int32                  `protobuf:"varint,3,opt,name=step_order,proto3" json:"step_order,omitempty"`
+	StepName         string                 `protobuf:"bytes,4,opt,name=step_name,proto3" json:"step_name,omitempty"`
+	StepType         string                 `protobuf:"bytes,5,opt,name=step_type,proto3" json:"step_type,omitempty"`
+	ActionType       *string                `protobuf:"bytes,6,opt,name=action_type,proto3,oneof" json:"action_type,omitempty"`
+	ActionConfig     *structpb.Value        `protobuf:"bytes,7,opt,name=action_config,proto3" json:"action_config,omitempty"`
+	EntryCondition   *string                `protobuf:"bytes,8,opt,name=entry_condition,proto3,oneof" json:"entry_condition,omitempty"`
+	OnSuccessStep    *string                `protobuf:"bytes,9,opt,name=on_success_step,proto3,oneof" json:"on_success_step,omitempty"`
+	OnFailureStep    *string                `protobuf:"bytes,10,opt,name=on_failure_step,proto3,oneof" json:"on_failure_step,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,12,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,13,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,14,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,15,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemFlowStep) Reset() {
+	*x = SystemFlowStep{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemFlowStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemFlowStep) ProtoMessage() {}
+
+func (x *SystemFlowStep) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemFlowStep.ProtoReflect.Descriptor instead.
+func (*SystemFlowStep) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SystemFlowStep) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetFlowId() string {
+	if x != nil {
+		return x.FlowId
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetStepOrder() int32 {
+	if x != nil {
+		return x.StepOrder
+	}
+	return 0
+}
+
+func (x *SystemFlowStep) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetStepType() string {
+	if x != nil {
+		return x.StepType
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetActionType() string {
+	if x != nil && x.ActionType != nil {
+		return *x.ActionType
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetActionConfig() *structpb.Value {
+	if x != nil {
+		return x.ActionConfig
+	}
+	return nil
+}
+
+func (x *SystemFlowStep) GetEntryCondition() string {
+	if x != nil && x.EntryCondition != nil {
+		return *x.EntryCondition
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetOnSuccessStep() string {
+	if x != nil && x.OnSuccessStep != nil {
+		return *x.OnSuccessStep
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetOnFailureStep() string {
+	if x != nil && x.OnFailureStep != nil {
+		return *x.OnFailureStep
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemFlowStep) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemFlowStep) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemFlowStep) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemForecastAdjustment represents the _System_ForecastAdjustment table (generated).
+// Manager override of the amount a user forecasts in a category for a period
+type SystemForecastAdjustment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Period           string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Category         string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Amount           float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note             *string                `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	AdjustedById     string                 `protobuf:"bytes,8,opt,name=adjusted_by_id,proto3" json:"adjusted_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemForecastAdjustment) Reset() {
+	*x = SystemForecastAdjustment{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemForecastAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemForecastAdjustment) ProtoMessage() {}
+
+func (x *SystemForecastAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemForecastAdjustment.ProtoReflect.Descriptor instead.
+func (*SystemForecastAdjustment) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SystemForecastAdjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SystemForecastAdjustment) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetAdjustedById() string {
+	if x != nil {
+		return x.AdjustedById
+	}
+	return ""
+}
+
+func (x *SystemForecastAdjustment) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemForecastAdjustment) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemForecastQuota represents the _System_ForecastQuota table (generated).
+// Forecast quota of a user for a period (2026, 2026-Q4 or 2026-10)
+type SystemForecastQuota struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Period           string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemForecastQuota) Reset() {
+	*x = SystemForecastQuota{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemForecastQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemForecastQuota) ProtoMessage() {}
+
+func (x *SystemForecastQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemForecastQuota.ProtoReflect.Descriptor instead.
+func (*SystemForecastQuota) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SystemForecastQuota) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemForecastQuota) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemForecastQuota) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemForecastQuota) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SystemForecastQuota) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SystemForecastQuota) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemForecastQuota) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemForecastSetting represents the _System_ForecastSetting table (generated).
+// Per-object forecasting: amount, close date and stage fields with the forecast category of each stage
+type SystemForecastSetting struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	AmountField      string                 `protobuf:"bytes,3,opt,name=amount_field,proto3" json:"amount_field,omitempty"`
+	CloseDateField   string                 `protobuf:"bytes,4,opt,name=close_date_field,proto3" json:"close_date_field,omitempty"`
+	StageField       string                 `protobuf:"bytes,5,opt,name=stage_field,proto3" json:"stage_field,omitempty"`
+	CategoryMapping  *structpb.Value        `protobuf:"bytes,6,opt,name=category_mapping,proto3" json:"category_mapping,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemForecastSetting) Reset() {
+	*x = SystemForecastSetting{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemForecastSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemForecastSetting) ProtoMessage() {}
+
+func (x *SystemForecastSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemForecastSetting.ProtoReflect.Descriptor instead.
+func (*SystemForecastSetting) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SystemForecastSetting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemForecastSetting) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemForecastSetting) GetAmountField() string {
+	if x != nil {
+		return x.AmountField
+	}
+	return ""
+}
+
+func (x *SystemForecastSetting) GetCloseDateField() string {
+	if x != nil {
+		return x.CloseDateField
+	}
+	return ""
+}
+
+func (x *SystemForecastSetting) GetStageField() string {
+	if x != nil {
+		return x.StageField
+	}
+	return ""
+}
+
+func (x *SystemForecastSetting) GetCategoryMapping() *structpb.Value {
+	if x != nil {
+		return x.CategoryMapping
+	}
+	return nil
+}
+
+func (x *SystemForecastSetting) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemForecastSetting) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemGlobalValueSet represents the _System_GlobalValueSet table (generated).
+// Picklist value lists shared by multiple picklist fields
+type SystemGlobalValueSet struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Options          *structpb.Value        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	InactiveOptions  *structpb.Value        `protobuf:"bytes,6,opt,name=inactive_options,proto3" json:"inactive_options,omitempty"`
+	IsStandard       bool                   `protobuf:"varint,7,opt,name=is_standard,proto3" json:"is_standard,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemGlobalValueSet) Reset() {
+	*x = SystemGlobalValueSet{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemGlobalValueSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGlobalValueSet) ProtoMessage() {}
+
+func (x *SystemGlobalValueSet) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGlobalValueSet.ProtoReflect.Descriptor instead.
+func (*SystemGlobalValueSet) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SystemGlobalValueSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemGlobalValueSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemGlobalValueSet) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemGlobalValueSet) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemGlobalValueSet) GetOptions() *structpb.Value {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SystemGlobalValueSet) GetInactiveOptions() *structpb.Value {
+	if x != nil {
+		return x.InactiveOptions
+	}
+	return nil
+}
+
+func (x *SystemGlobalValueSet) GetIsStandard() bool {
+	if x != nil {
+		return x.IsStandard
+	}
+	return false
+}
+
+func (x *SystemGlobalValueSet) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemGlobalValueSet) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemGroup represents the _System_Group table (generated).
+// Groups and Queues
+type SystemGroup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type             string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Email            *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,7,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemGroup) Reset() {
+	*x = SystemGroup{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGroup) ProtoMessage() {}
+
+func (x *SystemGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGroup.ProtoReflect.Descriptor instead.
+func (*SystemGroup) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SystemGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemGroup) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemGroup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemGroup) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *SystemGroup) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemGroup) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemGroup) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemGroupMember represents the _System_GroupMember table (generated).
+// Group membership
+type SystemGroupMember struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	GroupId          string                 `protobuf:"bytes,2,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,5,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemGroupMember) Reset() {
+	*x = SystemGroupMember{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGroupMember) ProtoMessage() {}
+
+func (x *SystemGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGroupMember.ProtoReflect.Descriptor instead.
+func (*SystemGroupMember) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SystemGroupMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemGroupMember) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SystemGroupMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemGroupMember) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemGroupMember) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemGroupMember) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemHoliday represents the _System_Holiday table (generated).
+// Non-working days of business hours
+type SystemHoliday struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	BusinessHoursId  string                 `protobuf:"bytes,2,opt,name=business_hours_id,proto3" json:"business_hours_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	HolidayDate      string                 `protobuf:"bytes,4,opt,name=holiday_date,proto3" json:"holiday_date,omitempty"`
+	IsRecurring      bool                   `protobuf:"varint,5,opt,name=is_recurring,proto3" json:"is_recurring,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemHoliday) Reset() {
+	*x = SystemHoliday{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemHoliday) ProtoMessage() {}
+
+func (x *SystemHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemHoliday.ProtoReflect.Descriptor instead.
+func (*SystemHoliday) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SystemHoliday) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemHoliday) GetBusinessHoursId() string {
+	if x != nil {
+		return x.BusinessHoursId
+	}
+	return ""
+}
+
+func (x *SystemHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemHoliday) GetHolidayDate() string {
+	if x != nil {
+		return x.HolidayDate
+	}
+	return ""
+}
+
+func (x *SystemHoliday) GetIsRecurring() bool {
+	if x != nil {
+		return x.IsRecurring
+	}
+	return false
+}
+
+func (x *SystemHoliday) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemHoliday) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemHookSubscription represents the _System_HookSubscription table (generated).
+// REST hook subscriptions of integration platforms (Zapier, Make): record events of an object are posted to the target URL with the subscribing user's access
+type SystemHookSubscription struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Event            string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	TargetUrl        string                 `protobuf:"bytes,5,opt,name=target_url,proto3" json:"target_url,omitempty"`
+	IsActive         bool                   `protobuf:"varint,6,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	FailureCount     int32                  `protobuf:"varint,7,opt,name=failure_count,proto3" json:"failure_count,omitempty"`
+	LastDeliveryDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_delivery_date,proto3" json:"last_delivery_date,omitempty"`
+	LastError        *string                `protobuf:"bytes,9,opt,name=last_error,proto3,oneof" json:"last_error,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemHookSubscription) Reset() {
+	*x = SystemHookSubscription{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemHookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemHookSubscription) ProtoMessage() {}
+
+func (x *SystemHookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemHookSubscription.ProtoReflect.Descriptor instead.
+func (*SystemHookSubscription) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SystemHookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemHookSubscription) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *SystemHookSubscription) GetLastDeliveryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDeliveryDate
+	}
+	return nil
+}
+
+func (x *SystemHookSubscription) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *SystemHookSubscription) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemHookSubscription) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemInboundHook represents the _System_InboundHook table (generated).
+// Inbound webhook endpoints (/hooks/:slug): JSON payloads pushed by external systems are mapped onto fields and create or upsert records; secrets are stored encrypted
+type SystemInboundHook struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Slug             string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Operation        string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	MatchField       *string                `protobuf:"bytes,6,opt,name=match_field,proto3,oneof" json:"match_field,omitempty"`
+	RecordsPath      *string                `protobuf:"bytes,7,opt,name=records_path,proto3,oneof" json:"records_path,omitempty"`
+	FieldMapping     *structpb.Value        `protobuf:"bytes,8,opt,name=field_mapping,proto3" json:"field_mapping,omitempty"`
+	Secret           string                 `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	RunAsUserId      string                 `protobuf:"bytes,10,opt,name=run_as_user_id,proto3" json:"run_as_user_id,omitempty"`
+	IsActive         bool                   `protobuf:"varint,11,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	LastReceivedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_received_date,proto3" json:"last_received_date,omitempty"`
+	LastError        *string                `protobuf:"bytes,13,opt,name=last_error,proto3,oneof" json:"last_error,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemInboundHook) Reset() {
+	*x = SystemInboundHook{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInboundHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInboundHook) ProtoMessage() {}
+
+func (x *SystemInboundHook) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInboundHook.ProtoReflect.Descriptor instead.
+func (*SystemInboundHook) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SystemInboundHook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetMatchField() string {
+	if x != nil && x.MatchField != nil {
+		return *x.MatchField
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetRecordsPath() string {
+	if x != nil && x.RecordsPath != nil {
+		return *x.RecordsPath
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetFieldMapping() *structpb.Value {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *SystemInboundHook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetRunAsUserId() string {
+	if x != nil {
+		return x.RunAsUserId
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemInboundHook) GetLastReceivedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReceivedDate
+	}
+	return nil
+}
+
+func (x *SystemInboundHook) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *SystemInboundHook) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemInboundHook) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemKnowledgeArticle represents the _System_KnowledgeArticle table (generated).
+// Knowledge base article; holds the working copy while its published versions are served to readers
+type SystemKnowledgeArticle struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UrlName                string                 `protobuf:"bytes,2,opt,name=url_name,proto3" json:"url_name,omitempty"`
+	Title                  string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary                *string                `protobuf:"bytes,4,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Body                   *string                `protobuf:"bytes,5,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	CategoryId             *string                `protobuf:"bytes,6,opt,name=category_id,proto3,oneof" json:"category_id,omitempty"`
+	Status                 string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	VersionNumber          int32                  `protobuf:"varint,8,opt,name=version_number,proto3" json:"version_number,omitempty"`
+	PublishedVersionNumber *int32                 `protobuf:"varint,9,opt,name=published_version_number,proto3,oneof" json:"published_version_number,omitempty"`
+	PublishedDate          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=published_date,proto3" json:"published_date,omitempty"`
+	ArchivedDate           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=archived_date,proto3" json:"archived_date,omitempty"`
+	OwnerId                string                 `protobuf:"bytes,12,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SystemKnowledgeArticle) Reset() {
+	*x = SystemKnowledgeArticle{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKnowledgeArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKnowledgeArticle) ProtoMessage() {}
+
+func (x *SystemKnowledgeArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKnowledgeArticle.ProtoReflect.Descriptor instead.
+func (*SystemKnowledgeArticle) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SystemKnowledgeArticle) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetUrlName() string {
+	if x != nil {
+		return x.UrlName
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SystemKnowledgeArticle) GetPublishedVersionNumber() int32 {
+	if x != nil && x.PublishedVersionNumber != nil {
+		return *x.PublishedVersionNumber
+	}
+	return 0
+}
+
+func (x *SystemKnowledgeArticle) GetPublishedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticle) GetArchivedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticle) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticle) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticle) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemKnowledgeArticleLink represents the _System_KnowledgeArticleLink table (generated).
+// Knowledge article attached to a record such as a case, with the version attached
+type SystemKnowledgeArticleLink struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ArticleId        string                 `protobuf:"bytes,2,opt,name=article_id,proto3" json:"article_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	VersionNumber    int32                  `protobuf:"varint,5,opt,name=version_number,proto3" json:"version_number,omitempty"`
+	LinkedById       string                 `protobuf:"bytes,6,opt,name=linked_by_id,proto3" json:"linked_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemKnowledgeArticleLink) Reset() {
+	*x = SystemKnowledgeArticleLink{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKnowledgeArticleLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKnowledgeArticleLink) ProtoMessage() {}
+
+func (x *SystemKnowledgeArticleLink) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKnowledgeArticleLink.ProtoReflect.Descriptor instead.
+func (*SystemKnowledgeArticleLink) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SystemKnowledgeArticleLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleLink) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleLink) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleLink) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleLink) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SystemKnowledgeArticleLink) GetLinkedById() string {
+	if x != nil {
+		return x.LinkedById
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleLink) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticleLink) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemKnowledgeArticleVersion represents the _System_KnowledgeArticleVersion table (generated).
+// Snapshot of a knowledge article taken each time it is published
+type SystemKnowledgeArticleVersion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ArticleId        string                 `protobuf:"bytes,2,opt,name=article_id,proto3" json:"article_id,omitempty"`
+	VersionNumber    int32                  `protobuf:"varint,3,opt,name=version_number,proto3" json:"version_number,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Summary          *string                `protobuf:"bytes,5,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Body             *string                `protobuf:"bytes,6,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	CategoryId       *string                `protobuf:"bytes,7,opt,name=category_id,proto3,oneof" json:"category_id,omitempty"`
+	PublishedById    string                 `protobuf:"bytes,8,opt,name=published_by_id,proto3" json:"published_by_id,omitempty"`
+	PublishedDate    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=published_date,proto3" json:"published_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemKnowledgeArticleVersion) Reset() {
+	*x = SystemKnowledgeArticleVersion{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKnowledgeArticleVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKnowledgeArticleVersion) ProtoMessage() {}
+
+func (x *SystemKnowledgeArticleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKnowledgeArticleVersion.ProtoReflect.Descriptor instead.
+func (*SystemKnowledgeArticleVersion) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SystemKnowledgeArticleVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SystemKnowledgeArticleVersion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetPublishedById() string {
+	if x != nil {
+		return x.PublishedById
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeArticleVersion) GetPublishedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticleVersion) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeArticleVersion) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemKnowledgeCategory represents the _System_KnowledgeCategory table (generated).
+// Category of the knowledge base taxonomy; categories nest under a parent
+type SystemKnowledgeCategory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId         *string                `protobuf:"bytes,3,opt,name=parent_id,proto3,oneof" json:"parent_id,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SortOrder        int32                  `protobuf:"varint,5,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemKnowledgeCategory) Reset() {
+	*x = SystemKnowledgeCategory{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemKnowledgeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemKnowledgeCategory) ProtoMessage() {}
+
+func (x *SystemKnowledgeCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemKnowledgeCategory.ProtoReflect.Descriptor instead.
+func (*SystemKnowledgeCategory) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SystemKnowledgeCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeCategory) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeCategory) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemKnowledgeCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *SystemKnowledgeCategory) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemKnowledgeCategory) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemLayout represents the _System_Layout table (generated).
+// Page layout configurations
+type SystemLayout struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Config           *structpb.Value        `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemLayout) Reset() {
+	*x = SystemLayout{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemLayout) ProtoMessage() {}
+
+func (x *SystemLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemLayout.ProtoReflect.Descriptor instead.
+func (*SystemLayout) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SystemLayout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemLayout) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemLayout) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SystemLayout) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemLayout) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemListView represents the _System_ListView table (generated).
+// List view configurations
+type SystemListView struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	FilterExpr       *string                `protobuf:"bytes,4,opt,name=filter_expr,proto3,oneof" json:"filter_expr,omitempty"`
+	Fields           *structpb.Value        `protobuf:"bytes,5,opt,name=fields,proto3" json:"fields,omitempty"`
+	Visibility       string                 `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,7,opt,name=owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	ProfileIds       *structpb.Value        `protobuf:"bytes,8,opt,name=profile_ids,proto3" json:"profile_ids,omitempty"`
+	ColumnSettings   *structpb.Value        `protobuf:"bytes,9,opt,name=column_settings,proto3" json:"column_settings,omitempty"`
+	SortField        *string                `protobuf:"bytes,10,opt,name=sort_field,proto3,oneof" json:"sort_field,omitempty"`
+	SortDirection    *string                `protobuf:"bytes,11,opt,name=sort_direction,proto3,oneof" json:"sort_direction,omitempty"`
+	Aggregates       *structpb.Value        `protobuf:"bytes,12,opt,name=aggregates,proto3" json:"aggregates,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemListView) Reset() {
+	*x = SystemListView{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemListView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListView) ProtoMessage() {}
+
+func (x *SystemListView) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListView.ProtoReflect.Descriptor instead.
+func (*SystemListView) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SystemListView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemListView) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemListView) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemListView) GetFilterExpr() string {
+	if x != nil && x.FilterExpr != nil {
+		return *x.FilterExpr
+	}
+	return ""
+}
+
+func (x *SystemListView) GetFields() *structpb.Value {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SystemListView) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SystemListView) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemListView) GetProfileIds() *structpb.Value {
+	if x != nil {
+		return x.ProfileIds
+	}
+	return nil
+}
+
+func (x *SystemListView) GetColumnSettings() *structpb.Value {
+	if x != nil {
+		return x.ColumnSettings
+	}
+	return nil
+}
+
+func (x *SystemListView) GetSortField() string {
+	if x != nil && x.SortField != nil {
+		return *x.SortField
+	}
+	return ""
+}
+
+func (x *SystemListView) GetSortDirection() string {
+	if x != nil && x.SortDirection != nil {
+		return *x.SortDirection
+	}
+	return ""
+}
+
+func (x *SystemListView) GetAggregates() *structpb.Value {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+func (x *SystemListView) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemListView) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemLog represents the _System_Log table (generated).
+// System event logs
+type SystemLog struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level            string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Source           string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Message          string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Details          *string                `protobuf:"bytes,6,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemLog) Reset() {
+	*x = SystemLog{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemLog) ProtoMessage() {}
+
+func (x *SystemLog) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemLog.ProtoReflect.Descriptor instead.
+func (*SystemLog) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SystemLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemLog) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SystemLog) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SystemLog) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SystemLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SystemLog) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+func (x *SystemLog) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemLog) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemNamedCredential represents the _System_NamedCredential table (generated).
+// External service endpoints and their credentials for callouts; secrets are stored encrypted
+type SystemNamedCredential struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	BaseUrl          string                 `protobuf:"bytes,4,opt,name=base_url,proto3" json:"base_url,omitempty"`
+	AuthType         string                 `protobuf:"bytes,5,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
+	Username         *string                `protobuf:"bytes,6,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	AuthHeader       *string                `protobuf:"bytes,7,opt,name=auth_header,proto3,oneof" json:"auth_header,omitempty"`
+	Secret           *string                `protobuf:"bytes,8,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	TimeoutSeconds   int32                  `protobuf:"varint,9,opt,name=timeout_seconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxRetries       int32                  `protobuf:"varint,10,opt,name=max_retries,proto3" json:"max_retries,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemNamedCredential) Reset() {
+	*x = SystemNamedCredential{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemNamedCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemNamedCredential) ProtoMessage() {}
+
+func (x *SystemNamedCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemNamedCredential.ProtoReflect.Descriptor instead.
+func (*SystemNamedCredential) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SystemNamedCredential) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetAuthHeader() string {
+	if x != nil && x.AuthHeader != nil {
+		return *x.AuthHeader
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *SystemNamedCredential) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *SystemNamedCredential) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *SystemNamedCredential) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemNamedCredential) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemNotification represents the _System_Notification table (generated).
+// User notifications
+type SystemNotification struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	RecipientId      string                 `protobuf:"bytes,2,opt,name=recipient_id,proto3" json:"recipient_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body             string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Link             string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	IsRead           bool                   `protobuf:"varint,6,opt,name=is_read,proto3" json:"is_read,omitempty"`
+	NotificationType string                 `protobuf:"bytes,7,opt,name=notification_type,proto3" json:"notification_type,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemNotification) Reset() {
+	*x = SystemNotification{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemNotification) ProtoMessage() {}
+
+func (x *SystemNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemNotification.ProtoReflect.Descriptor instead.
+func (*SystemNotification) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SystemNotification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *SystemNotification) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemNotification) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemObject represents the _System_Object table (generated).
+// Object metadata definitions
+type SystemObject struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ApiName          string                 `protobuf:"bytes,2,opt,name=api_name,proto3" json:"api_name,omitempty"`
+	TableType        string                 `protobuf:"bytes,3,opt,name=table_type,proto3" json:"table_type,omitempty"`
+	Label            string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	PluralLabel      *string                `protobuf:"bytes,5,opt,name=plural_label,proto3,oneof" json:"plural_label,omitempty"`
+	AppId            *string                `protobuf:"bytes,6,opt,name=app_id,proto3,oneof" json:"app_id,omitempty"`
+	Icon             *string                `protobuf:"bytes,7,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Description      string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ThemeColor       *string                `protobuf:"bytes,9,opt,name=theme_color,proto3,oneof" json:"theme_color,omitempty"`
+	IsCustom         bool                   `protobuf:"varint,10,opt,name=is_custom,proto3" json:"is_custom,omitempty"`
+	SharingModel     string                 `protobuf:"bytes,11,opt,name=sharing_model,proto3" json:"sharing_model,omitempty"`
+	PathField        *string                `protobuf:"bytes,12,opt,name=path_field,proto3,oneof" json:"path_field,omitempty"`
+	ListFields       *structpb.Value        `protobuf:"bytes,13,opt,name=list_fields,proto3" json:"list_fields,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,14,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,15,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,16,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,17,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemObject) Reset() {
+	*x = SystemObject{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemObject) ProtoMessage() {}
+
+func (x *SystemObject) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemObject.ProtoReflect.Descriptor instead.
+func (*SystemObject) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SystemObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemObject) GetApiName() string {
+	if x != nil {
+		return x.ApiName
+	}
+	return ""
+}
+
+func (x *SystemObject) GetTableType() string {
+	if x != nil {
+		return x.TableType
+	}
+	return ""
+}
+
+func (x *SystemObject) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemObject) GetPluralLabel() string {
+	if x != nil && x.PluralLabel != nil {
+		return *x.PluralLabel
+	}
+	return ""
+}
+
+func (x *SystemObject) GetAppId() string {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return ""
+}
+
+func (x *SystemObject) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *SystemObject) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemObject) GetThemeColor() string {
+	if x != nil && x.ThemeColor != nil {
+		return *x.ThemeColor
+	}
+	return ""
+}
+
+func (x *SystemObject) GetIsCustom() bool {
+	if x != nil {
+		return x.IsCustom
+	}
+	return false
+}
+
+func (x *SystemObject) GetSharingModel() string {
+	if x != nil {
+		return x.SharingModel
+	}
+	return ""
+}
+
+func (x *SystemObject) GetPathField() string {
+	if x != nil && x.PathField != nil {
+		return *x.PathField
+	}
+	return ""
+}
+
+func (x *SystemObject) GetListFields() *structpb.Value {
+	if x != nil {
+		return x.ListFields
+	}
+	return nil
+}
+
+func (x *SystemObject) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemObject) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemObject) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemObject) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemObject) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemObject) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemObjectPerms represents the _System_ObjectPerms table (generated).
+// Object permissions for profiles
+type SystemObjectPerms struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProfileId        *string                `protobuf:"bytes,2,opt,name=profile_id,proto3,oneof" json:"profile_id,omitempty"`
+	PermissionSetId  *string                `protobuf:"bytes,3,opt,name=permission_set_id,proto3,oneof" json:"permission_set_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	AllowRead        bool                   `protobuf:"varint,5,opt,name=allow_read,proto3" json:"allow_read,omitempty"`
+	AllowCreate      bool                   `protobuf:"varint,6,opt,name=allow_create,proto3" json:"allow_create,omitempty"`
+	AllowEdit        bool                   `protobuf:"varint,7,opt,name=allow_edit,proto3" json:"allow_edit,omitempty"`
+	AllowDelete      bool                   `protobuf:"varint,8,opt,name=allow_delete,proto3" json:"allow_delete,omitempty"`
+	ViewAll          bool                   `protobuf:"varint,9,opt,name=view_all,proto3" json:"view_all,omitempty"`
+	ModifyAll        bool                   `protobuf:"varint,10,opt,name=modify_all,proto3" json:"modify_all,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemObjectPerms) Reset() {
+	*x = SystemObjectPerms{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemObjectPerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemObjectPerms) ProtoMessage() {}
+
+func (x *SystemObjectPerms) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemObjectPerms.ProtoReflect.Descriptor instead.
+func (*SystemObjectPerms) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SystemObjectPerms) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemObjectPerms) GetProfileId() string {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemObjectPerms) GetPermissionSetId() string {
+	if x != nil && x.PermissionSetId != nil {
+		return *x.PermissionSetId
+	}
+	return ""
+}
+
+func (x *SystemObjectPerms) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemObjectPerms) GetAllowRead() bool {
+	if x != nil {
+		return x.AllowRead
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetAllowCreate() bool {
+	if x != nil {
+		return x.AllowCreate
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetAllowEdit() bool {
+	if x != nil {
+		return x.AllowEdit
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetAllowDelete() bool {
+	if x != nil {
+		return x.AllowDelete
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetViewAll() bool {
+	if x != nil {
+		return x.ViewAll
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetModifyAll() bool {
+	if x != nil {
+		return x.ModifyAll
+	}
+	return false
+}
+
+func (x *SystemObjectPerms) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemObjectPerms) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemOrder represents the _System_Order table (generated).
+// Customer order of products; activation decrements the stock of its items
+type SystemOrder struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status                string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CustomerObjectApiName *string                `protobuf:"bytes,4,opt,name=customer_object_api_name,proto3,oneof" json:"customer_object_api_name,omitempty"`
+	CustomerId            *string                `protobuf:"bytes,5,opt,name=customer_id,proto3,oneof" json:"customer_id,omitempty"`
+	Description           *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TotalAmount           float64                `protobuf:"fixed64,7,opt,name=total_amount,proto3" json:"total_amount,omitempty"`
+	ActivatedDate         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=activated_date,proto3" json:"activated_date,omitempty"`
+	FulfilledDate         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=fulfilled_date,proto3" json:"fulfilled_date,omitempty"`
+	CancelledDate         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cancelled_date,proto3" json:"cancelled_date,omitempty"`
+	OwnerId               string                 `protobuf:"bytes,11,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SystemOrder) Reset() {
+	*x = SystemOrder{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOrder) ProtoMessage() {}
+
+func (x *SystemOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOrder.ProtoReflect.Descriptor instead.
+func (*SystemOrder) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SystemOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetCustomerObjectApiName() string {
+	if x != nil && x.CustomerObjectApiName != nil {
+		return *x.CustomerObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetCustomerId() string {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *SystemOrder) GetActivatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedDate
+	}
+	return nil
+}
+
+func (x *SystemOrder) GetFulfilledDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FulfilledDate
+	}
+	return nil
+}
+
+func (x *SystemOrder) GetCancelledDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledDate
+	}
+	return nil
+}
+
+func (x *SystemOrder) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemOrder) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemOrder) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemOrderItem represents the _System_OrderItem table (generated).
+// Product line of an order; backordered_quantity is the part not covered by stock at activation
+type SystemOrderItem struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	OrderId             string                 `protobuf:"bytes,2,opt,name=order_id,proto3" json:"order_id,omitempty"`
+	ProductId           string                 `protobuf:"bytes,3,opt,name=product_id,proto3" json:"product_id,omitempty"`
+	ProductName         *string                `protobuf:"bytes,4,opt,name=product_name,proto3,oneof" json:"product_name,omitempty"`
+	Quantity            float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BackorderedQuantity float64                `protobuf:"fixed64,6,opt,name=backordered_quantity,proto3" json:"backordered_quantity,omitempty"`
+	UnitPrice           float64                `protobuf:"fixed64,7,opt,name=unit_price,proto3" json:"unit_price,omitempty"`
+	TotalPrice          float64                `protobuf:"fixed64,8,opt,name=total_price,proto3" json:"total_price,omitempty"`
+	SortOrder           int32                  `protobuf:"varint,9,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	CreatedDate         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SystemOrderItem) Reset() {
+	*x = SystemOrderItem{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOrderItem) ProtoMessage() {}
+
+func (x *SystemOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOrderItem.ProtoReflect.Descriptor instead.
+func (*SystemOrderItem) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SystemOrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemOrderItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SystemOrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SystemOrderItem) GetProductName() string {
+	if x != nil && x.ProductName != nil {
+		return *x.ProductName
+	}
+	return ""
+}
+
+func (x *SystemOrderItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SystemOrderItem) GetBackorderedQuantity() float64 {
+	if x != nil {
+		return x.BackorderedQuantity
+	}
+	return 0
+}
+
+func (x *SystemOrderItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *SystemOrderItem) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *SystemOrderItem) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *SystemOrderItem) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemOrderItem) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemOrganization represents the _System_Organization table (generated).
+// The organization and its first-run setup state (a single row)
+type SystemOrganization struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name               *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	DefaultLocale      *string                `protobuf:"bytes,3,opt,name=default_locale,proto3,oneof" json:"default_locale,omitempty"`
+	SetupStatus        string                 `protobuf:"bytes,4,opt,name=setup_status,proto3" json:"setup_status,omitempty"`
+	SampleData         bool                   `protobuf:"varint,5,opt,name=sample_data,proto3" json:"sample_data,omitempty"`
+	SetupCompletedById *string                `protobuf:"bytes,6,opt,name=setup_completed_by_id,proto3,oneof" json:"setup_completed_by_id,omitempty"`
+	SetupCompletedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=setup_completed_date,proto3" json:"setup_completed_date,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemOrganization) Reset() {
+	*x = SystemOrganization{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemOrganization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOrganization) ProtoMessage() {}
+
+func (x *SystemOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOrganization.ProtoReflect.Descriptor instead.
+func (*SystemOrganization) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SystemOrganization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemOrganization) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SystemOrganization) GetDefaultLocale() string {
+	if x != nil && x.DefaultLocale != nil {
+		return *x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *SystemOrganization) GetSetupStatus() string {
+	if x != nil {
+		return x.SetupStatus
+	}
+	return ""
+}
+
+func (x *SystemOrganization) GetSampleData() bool {
+	if x != nil {
+		return x.SampleData
+	}
+	return false
+}
+
+func (x *SystemOrganization) GetSetupCompletedById() string {
+	if x != nil && x.SetupCompletedById != nil {
+		return *x.SetupCompletedById
+	}
+	return ""
+}
+
+func (x *SystemOrganization) GetSetupCompletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SetupCompletedDate
+	}
+	return nil
+}
+
+func (x *SystemOrganization) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemOrganization) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemOutboxEvent represents the _System_OutboxEvent table (generated).
+// Transactional event outbox for guaranteed delivery
+type SystemOutboxEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	EventType        string                 `protobuf:"bytes,2,opt,name=event_type,proto3" json:"event_type,omitempty"`
+	Payload          *structpb.Value        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RetryCount       int32                  `protobuf:"varint,5,opt,name=retry_count,proto3" json:"retry_count,omitempty"`
+	ErrorMessage     *string                `protobuf:"bytes,6,opt,name=error_message,proto3,oneof" json:"error_message,omitempty"`
+	ProcessedDate    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=processed_date,proto3" json:"processed_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemOutboxEvent) Reset() {
+	*x = SystemOutboxEvent{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemOutboxEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOutboxEvent) ProtoMessage() {}
+
+func (x *SystemOutboxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOutboxEvent.ProtoReflect.Descriptor instead.
+func (*SystemOutboxEvent) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SystemOutboxEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemOutboxEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *SystemOutboxEvent) GetPayload() *structpb.Value {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SystemOutboxEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemOutboxEvent) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *SystemOutboxEvent) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SystemOutboxEvent) GetProcessedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedDate
+	}
+	return nil
+}
+
+func (x *SystemOutboxEvent) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemOutboxEvent) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemPermissionSet represents the _System_PermissionSet table (generated).
+// Additive permission sets
+type SystemPermissionSet struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	BypassFlows      bool                   `protobuf:"varint,6,opt,name=bypass_flows,proto3" json:"bypass_flows,omitempty"`
+	BypassValidation bool                   `protobuf:"varint,7,opt,name=bypass_validation,proto3" json:"bypass_validation,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,9,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemPermissionSet) Reset() {
+	*x = SystemPermissionSet{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemPermissionSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPermissionSet) ProtoMessage() {}
+
+func (x *SystemPermissionSet) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPermissionSet.ProtoReflect.Descriptor instead.
+func (*SystemPermissionSet) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SystemPermissionSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemPermissionSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemPermissionSet) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemPermissionSet) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemPermissionSet) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemPermissionSet) GetBypassFlows() bool {
+	if x != nil {
+		return x.BypassFlows
+	}
+	return false
+}
+
+func (x *SystemPermissionSet) GetBypassValidation() bool {
+	if x != nil {
+		return x.BypassValidation
+	}
+	return false
+}
+
+func (x *SystemPermissionSet) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemPermissionSet) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemPermissionSet) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemPermissionSetAssignment represents the _System_PermissionSetAssignment table (generated).
+// Assignment of permission sets to users
+type SystemPermissionSetAssignment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	AssigneeId       string                 `protobuf:"bytes,2,opt,name=assignee_id,proto3" json:"assignee_id,omitempty"`
+	PermissionSetId  string                 `protobuf:"bytes,3,opt,name=permission_set_id,proto3" json:"permission_set_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,5,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemPermissionSetAssignment) Reset() {
+	*x = SystemPermissionSetAssignment{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemPermissionSetAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPermissionSetAssignment) ProtoMessage() {}
+
+func (x *SystemPermissionSetAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPermissionSetAssignment.ProtoReflect.Descriptor instead.
+func (*SystemPermissionSetAssignment) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SystemPermissionSetAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemPermissionSetAssignment) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *SystemPermissionSetAssignment) GetPermissionSetId() string {
+	if x != nil {
+		return x.PermissionSetId
+	}
+	return ""
+}
+
+func (x *SystemPermissionSetAssignment) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemPermissionSetAssignment) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemPermissionSetAssignment) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemPortalObject represents the _System_PortalObject table (generated).
+// Objects exposed to portal users and the fields relating their records to a portal user's contact or account
+type SystemPortalObject struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	ContactField     *string                `protobuf:"bytes,3,opt,name=contact_field,proto3,oneof" json:"contact_field,omitempty"`
+	AccountField     *string                `protobuf:"bytes,4,opt,name=account_field,proto3,oneof" json:"account_field,omitempty"`
+	AllowCreate      bool                   `protobuf:"varint,5,opt,name=allow_create,proto3" json:"allow_create,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemPortalObject) Reset() {
+	*x = SystemPortalObject{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemPortalObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPortalObject) ProtoMessage() {}
+
+func (x *SystemPortalObject) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPortalObject.ProtoReflect.Descriptor instead.
+func (*SystemPortalObject) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SystemPortalObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemPortalObject) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemPortalObject) GetContactField() string {
+	if x != nil && x.ContactField != nil {
+		return *x.ContactField
+	}
+	return ""
+}
+
+func (x *SystemPortalObject) GetAccountField() string {
+	if x != nil && x.AccountField != nil {
+		return *x.AccountField
+	}
+	return ""
+}
+
+func (x *SystemPortalObject) GetAllowCreate() bool {
+	if x != nil {
+		return x.AllowCreate
+	}
+	return false
+}
+
+func (x *SystemPortalObject) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemPortalObject) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemProfile represents the _System_Profile table (generated).
+// User profiles and permission sets
+type SystemProfile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive         bool                   `protobuf:"varint,4,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	IsSystem         bool                   `protobuf:"varint,5,opt,name=is_system,proto3" json:"is_system,omitempty"`
+	BypassFlows      bool                   `protobuf:"varint,6,opt,name=bypass_flows,proto3" json:"bypass_flows,omitempty"`
+	BypassValidation bool                   `protobuf:"varint,7,opt,name=bypass_validation,proto3" json:"bypass_validation,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,8,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,9,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,10,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,11,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemProfile) Reset() {
+	*x = SystemProfile{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemProfile) ProtoMessage() {}
+
+func (x *SystemProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemProfile.ProtoReflect.Descriptor instead.
+func (*SystemProfile) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SystemProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemProfile) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *SystemProfile) GetBypassFlows() bool {
+	if x != nil {
+		return x.BypassFlows
+	}
+	return false
+}
+
+func (x *SystemProfile) GetBypassValidation() bool {
+	if x != nil {
+		return x.BypassValidation
+	}
+	return false
+}
+
+func (x *SystemProfile) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemProfile) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemProfile) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemProfile) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemProfileLayout represents the _System_ProfileLayout table (generated).
+// Profile to layout assignments
+type SystemProfileLayout struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProfileId        string                 `protobuf:"bytes,2,opt,name=profile_id,proto3" json:"profile_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	LayoutId         string                 `protobuf:"bytes,4,opt,name=layout_id,proto3" json:"layout_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemProfileLayout) Reset() {
+	*x = SystemProfileLayout{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemProfileLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemProfileLayout) ProtoMessage() {}
+
+func (x *SystemProfileLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemProfileLayout.ProtoReflect.Descriptor instead.
+func (*SystemProfileLayout) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SystemProfileLayout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemProfileLayout) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemProfileLayout) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemProfileLayout) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *SystemProfileLayout) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemProfileLayout) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemProfileRecordType represents the _System_ProfileRecordType table (generated).
+// Record types available to a profile, with the profile default and page layout per record type
+type SystemProfileRecordType struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProfileId        string                 `protobuf:"bytes,2,opt,name=profile_id,proto3" json:"profile_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordTypeId     string                 `protobuf:"bytes,4,opt,name=record_type_id,proto3" json:"record_type_id,omitempty"`
+	IsDefault        bool                   `protobuf:"varint,5,opt,name=is_default,proto3" json:"is_default,omitempty"`
+	LayoutId         *string                `protobuf:"bytes,6,opt,name=layout_id,proto3,oneof" json:"layout_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemProfileRecordType) Reset() {
+	*x = SystemProfileRecordType{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemProfileRecordType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemProfileRecordType) ProtoMessage() {}
+
+func (x *SystemProfileRecordType) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemProfileRecordType.ProtoReflect.Descriptor instead.
+func (*SystemProfileRecordType) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SystemProfileRecordType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemProfileRecordType) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemProfileRecordType) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemProfileRecordType) GetRecordTypeId() string {
+	if x != nil {
+		return x.RecordTypeId
+	}
+	return ""
+}
+
+func (x *SystemProfileRecordType) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SystemProfileRecordType) GetLayoutId() string {
+	if x != nil && x.LayoutId != nil {
+		return *x.LayoutId
+	}
+	return ""
+}
+
+func (x *SystemProfileRecordType) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemProfileRecordType) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemQueryGovernor represents the _System_QueryGovernor table (generated).
+// Per-profile overrides of the query governor limits
+type SystemQueryGovernor struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ProfileId            string                 `protobuf:"bytes,2,opt,name=profile_id,proto3" json:"profile_id,omitempty"`
+	MaxRows              *int32                 `protobuf:"varint,3,opt,name=max_rows,proto3,oneof" json:"max_rows,omitempty"`
+	MaxExecutionMs       *int32                 `protobuf:"varint,4,opt,name=max_execution_ms,proto3,oneof" json:"max_execution_ms,omitempty"`
+	MaxConcurrentQueries *int32                 `protobuf:"varint,5,opt,name=max_concurrent_queries,proto3,oneof" json:"max_concurrent_queries,omitempty"`
+	CreatedDate          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SystemQueryGovernor) Reset() {
+	*x = SystemQueryGovernor{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemQueryGovernor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemQueryGovernor) ProtoMessage() {}
+
+func (x *SystemQueryGovernor) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemQueryGovernor.ProtoReflect.Descriptor instead.
+func (*SystemQueryGovernor) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SystemQueryGovernor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemQueryGovernor) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemQueryGovernor) GetMaxRows() int32 {
+	if x != nil && x.MaxRows != nil {
+		return *x.MaxRows
+	}
+	return 0
+}
+
+func (x *SystemQueryGovernor) GetMaxExecutionMs() int32 {
+	if x != nil && x.MaxExecutionMs != nil {
+		return *x.MaxExecutionMs
+	}
+	return 0
+}
+
+func (x *SystemQueryGovernor) GetMaxConcurrentQueries() int32 {
+	if x != nil && x.MaxConcurrentQueries != nil {
+		return *x.MaxConcurrentQueries
+	}
+	return 0
+}
+
+func (x *SystemQueryGovernor) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemQueryGovernor) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRecent represents the _System_Recent table (generated).
+// Recently viewed records
+type SystemRecent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	RecordName       string                 `protobuf:"bytes,5,opt,name=record_name,proto3" json:"record_name,omitempty"`
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRecent) Reset() {
+	*x = SystemRecent{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRecent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRecent) ProtoMessage() {}
+
+func (x *SystemRecent) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRecent.ProtoReflect.Descriptor instead.
+func (*SystemRecent) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SystemRecent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRecent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemRecent) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRecent) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemRecent) GetRecordName() string {
+	if x != nil {
+		return x.RecordName
+	}
+	return ""
+}
+
+func (x *SystemRecent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SystemRecent) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRecent) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRecordShare represents the _System_RecordShare table (generated).
+// Manual record sharing with users or groups
+type SystemRecordShare struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	ShareWithUserId  *string                `protobuf:"bytes,4,opt,name=share_with_user_id,proto3,oneof" json:"share_with_user_id,omitempty"`
+	ShareWithGroupId *string                `protobuf:"bytes,5,opt,name=share_with_group_id,proto3,oneof" json:"share_with_group_id,omitempty"`
+	AccessLevel      string                 `protobuf:"bytes,6,opt,name=access_level,proto3" json:"access_level,omitempty"`
+	Reason           string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,8,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,10,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,12,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRecordShare) Reset() {
+	*x = SystemRecordShare{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRecordShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRecordShare) ProtoMessage() {}
+
+func (x *SystemRecordShare) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRecordShare.ProtoReflect.Descriptor instead.
+func (*SystemRecordShare) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SystemRecordShare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetShareWithUserId() string {
+	if x != nil && x.ShareWithUserId != nil {
+		return *x.ShareWithUserId
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetShareWithGroupId() string {
+	if x != nil && x.ShareWithGroupId != nil {
+		return *x.ShareWithGroupId
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetAccessLevel() string {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRecordShare) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemRecordShare) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemRecordShare) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemRecordType represents the _System_RecordType table (generated).
+// Record type configurations
+type SystemRecordType struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectId         string                 `protobuf:"bytes,2,opt,name=object_id,proto3" json:"object_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	IsMaster         bool                   `protobuf:"varint,6,opt,name=is_master,proto3" json:"is_master,omitempty"`
+	IsDefault        bool                   `protobuf:"varint,7,opt,name=is_default,proto3" json:"is_default,omitempty"`
+	PicklistValues   *structpb.Value        `protobuf:"bytes,8,opt,name=picklist_values,proto3" json:"picklist_values,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,9,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRecordType) Reset() {
+	*x = SystemRecordType{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRecordType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRecordType) ProtoMessage() {}
+
+func (x *SystemRecordType) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRecordType.ProtoReflect.Descriptor instead.
+func (*SystemRecordType) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SystemRecordType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRecordType) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *SystemRecordType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemRecordType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemRecordType) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemRecordType) GetIsMaster() bool {
+	if x != nil {
+		return x.IsMaster
+	}
+	return false
+}
+
+func (x *SystemRecordType) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SystemRecordType) GetPicklistValues() *structpb.Value {
+	if x != nil {
+		return x.PicklistValues
+	}
+	return nil
+}
+
+func (x *SystemRecordType) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemRecordType) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRecordType) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRecordEmbedding represents the _System_Record_Embedding table (generated).
+// Embedding vectors of CRM records for semantic search
+type SystemRecordEmbedding struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Embedding        string                 `protobuf:"bytes,4,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	ContentHash      string                 `protobuf:"bytes,5,opt,name=content_hash,proto3" json:"content_hash,omitempty"`
+	Model            string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRecordEmbedding) Reset() {
+	*x = SystemRecordEmbedding{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRecordEmbedding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRecordEmbedding) ProtoMessage() {}
+
+func (x *SystemRecordEmbedding) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRecordEmbedding.ProtoReflect.Descriptor instead.
+func (*SystemRecordEmbedding) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SystemRecordEmbedding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetEmbedding() string {
+	if x != nil {
+		return x.Embedding
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *SystemRecordEmbedding) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRecordEmbedding) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRecycleBin represents the _System_RecycleBin table (generated).
+// Recycle bin for soft-deleted records
+type SystemRecycleBin struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	RecordId         string                 `protobuf:"bytes,2,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordName       string                 `protobuf:"bytes,4,opt,name=record_name,proto3" json:"record_name,omitempty"`
+	DeletedBy        string                 `protobuf:"bytes,5,opt,name=deleted_by,proto3" json:"deleted_by,omitempty"`
+	DeletedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_date,proto3" json:"deleted_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRecycleBin) Reset() {
+	*x = SystemRecycleBin{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRecycleBin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRecycleBin) ProtoMessage() {}
+
+func (x *SystemRecycleBin) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRecycleBin.ProtoReflect.Descriptor instead.
+func (*SystemRecycleBin) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SystemRecycleBin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRecycleBin) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemRecycleBin) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRecycleBin) GetRecordName() string {
+	if x != nil {
+		return x.RecordName
+	}
+	return ""
+}
+
+func (x *SystemRecycleBin) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *SystemRecycleBin) GetDeletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedDate
+	}
+	return nil
+}
+
+func (x *SystemRecycleBin) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRecycleBin) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRelationship represents the _System_Relationship table (generated).
+// Object relationship definitions
+type SystemRelationship struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ChildObjectApiName  string                 `protobuf:"bytes,2,opt,name=child_object_api_name,proto3" json:"child_object_api_name,omitempty"`
+	ParentObjectApiName string                 `protobuf:"bytes,3,opt,name=parent_object_api_name,proto3" json:"parent_object_api_name,omitempty"`
+	FieldApiName        string                 `protobuf:"bytes,4,opt,name=field_api_name,proto3" json:"field_api_name,omitempty"`
+	RelationshipName    string                 `protobuf:"bytes,5,opt,name=relationship_name,proto3" json:"relationship_name,omitempty"`
+	RelationshipType    string                 `protobuf:"bytes,6,opt,name=relationship_type,proto3" json:"relationship_type,omitempty"`
+	CascadeDelete       bool                   `protobuf:"varint,7,opt,name=cascade_delete,proto3" json:"cascade_delete,omitempty"`
+	RestrictedDelete    bool                   `protobuf:"varint,8,opt,name=restricted_delete,proto3" json:"restricted_delete,omitempty"`
+	RelatedListLabel    string                 `protobuf:"bytes,9,opt,name=related_list_label,proto3" json:"related_list_label,omitempty"`
+	RelatedListFields   string                 `protobuf:"bytes,10,opt,name=related_list_fields,proto3" json:"related_list_fields,omitempty"`
+	CreatedDate         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SystemRelationship) Reset() {
+	*x = SystemRelationship{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRelationship) ProtoMessage() {}
+
+func (x *SystemRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRelationship.ProtoReflect.Descriptor instead.
+func (*SystemRelationship) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SystemRelationship) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetChildObjectApiName() string {
+	if x != nil {
+		return x.ChildObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetParentObjectApiName() string {
+	if x != nil {
+		return x.ParentObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetFieldApiName() string {
+	if x != nil {
+		return x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetRelationshipName() string {
+	if x != nil {
+		return x.RelationshipName
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetRelationshipType() string {
+	if x != nil {
+		return x.RelationshipType
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetCascadeDelete() bool {
+	if x != nil {
+		return x.CascadeDelete
+	}
+	return false
+}
+
+func (x *SystemRelationship) GetRestrictedDelete() bool {
+	if x != nil {
+		return x.RestrictedDelete
+	}
+	return false
+}
+
+func (x *SystemRelationship) GetRelatedListLabel() string {
+	if x != nil {
+		return x.RelatedListLabel
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetRelatedListFields() string {
+	if x != nil {
+		return x.RelatedListFields
+	}
+	return ""
+}
+
+func (x *SystemRelationship) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRelationship) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemReport represents the _System_Report table (generated).
+// Report definitions (tabular, summary and matrix)
+type SystemReport struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Format           string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	Columns          *structpb.Value        `protobuf:"bytes,6,opt,name=columns,proto3" json:"columns,omitempty"`
+	FilterExpr       *string                `protobuf:"bytes,7,opt,name=filter_expr,proto3,oneof" json:"filter_expr,omitempty"`
+	Groupings        *structpb.Value        `protobuf:"bytes,8,opt,name=groupings,proto3" json:"groupings,omitempty"`
+	ColumnGroupings  *structpb.Value        `protobuf:"bytes,9,opt,name=column_groupings,proto3" json:"column_groupings,omitempty"`
+	Aggregates       *structpb.Value        `protobuf:"bytes,10,opt,name=aggregates,proto3" json:"aggregates,omitempty"`
+	SortField        *string                `protobuf:"bytes,11,opt,name=sort_field,proto3,oneof" json:"sort_field,omitempty"`
+	SortDirection    *string                `protobuf:"bytes,12,opt,name=sort_direction,proto3,oneof" json:"sort_direction,omitempty"`
+	RowLimit         *int32                 `protobuf:"varint,13,opt,name=row_limit,proto3,oneof" json:"row_limit,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,14,opt,name=owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemReport) Reset() {
+	*x = SystemReport{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReport) ProtoMessage() {}
+
+func (x *SystemReport) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReport.ProtoReflect.Descriptor instead.
+func (*SystemReport) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SystemReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemReport) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemReport) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemReport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *SystemReport) GetColumns() *structpb.Value {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *SystemReport) GetFilterExpr() string {
+	if x != nil && x.FilterExpr != nil {
+		return *x.FilterExpr
+	}
+	return ""
+}
+
+func (x *SystemReport) GetGroupings() *structpb.Value {
+	if x != nil {
+		return x.Groupings
+	}
+	return nil
+}
+
+func (x *SystemReport) GetColumnGroupings() *structpb.Value {
+	if x != nil {
+		return x.ColumnGroupings
+	}
+	return nil
+}
+
+func (x *SystemReport) GetAggregates() *structpb.Value {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+func (x *SystemReport) GetSortField() string {
+	if x != nil && x.SortField != nil {
+		return *x.SortField
+	}
+	return ""
+}
+
+func (x *SystemReport) GetSortDirection() string {
+	if x != nil && x.SortDirection != nil {
+		return *x.SortDirection
+	}
+	return ""
+}
+
+func (x *SystemReport) GetRowLimit() int32 {
+	if x != nil && x.RowLimit != nil {
+		return *x.RowLimit
+	}
+	return 0
+}
+
+func (x *SystemReport) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemReport) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemReport) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemRole represents the _System_Role table (generated).
+// Role hierarchy for access control
+type SystemRole struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentRoleId     *string                `protobuf:"bytes,4,opt,name=parent_role_id,proto3,oneof" json:"parent_role_id,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,6,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,7,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,8,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,9,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemRole) Reset() {
+	*x = SystemRole{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRole) ProtoMessage() {}
+
+func (x *SystemRole) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRole.ProtoReflect.Descriptor instead.
+func (*SystemRole) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SystemRole) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemRole) GetParentRoleId() string {
+	if x != nil && x.ParentRoleId != nil {
+		return *x.ParentRoleId
+	}
+	return ""
+}
+
+func (x *SystemRole) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemRole) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemRole) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemRole) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemRole) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemRole) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemRole) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSLAPolicy represents the _System_SLAPolicy table (generated).
+// Service level milestones per record priority, with pause statuses and escalation flows
+type SystemSLAPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	PriorityField    *string                `protobuf:"bytes,5,opt,name=priority_field,proto3,oneof" json:"priority_field,omitempty"`
+	StatusField      string                 `protobuf:"bytes,6,opt,name=status_field,proto3" json:"status_field,omitempty"`
+	PausedStatuses   *structpb.Value        `protobuf:"bytes,7,opt,name=paused_statuses,proto3" json:"paused_statuses,omitempty"`
+	ClosedStatuses   *structpb.Value        `protobuf:"bytes,8,opt,name=closed_statuses,proto3" json:"closed_statuses,omitempty"`
+	BusinessHours    *string                `protobuf:"bytes,9,opt,name=business_hours,proto3,oneof" json:"business_hours,omitempty"`
+	Milestones       *structpb.Value        `protobuf:"bytes,10,opt,name=milestones,proto3" json:"milestones,omitempty"`
+	IsActive         bool                   `protobuf:"varint,11,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSLAPolicy) Reset() {
+	*x = SystemSLAPolicy{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSLAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSLAPolicy) ProtoMessage() {}
+
+func (x *SystemSLAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSLAPolicy.ProtoReflect.Descriptor instead.
+func (*SystemSLAPolicy) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SystemSLAPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetPriorityField() string {
+	if x != nil && x.PriorityField != nil {
+		return *x.PriorityField
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetStatusField() string {
+	if x != nil {
+		return x.StatusField
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetPausedStatuses() *structpb.Value {
+	if x != nil {
+		return x.PausedStatuses
+	}
+	return nil
+}
+
+func (x *SystemSLAPolicy) GetClosedStatuses() *structpb.Value {
+	if x != nil {
+		return x.ClosedStatuses
+	}
+	return nil
+}
+
+func (x *SystemSLAPolicy) GetBusinessHours() string {
+	if x != nil && x.BusinessHours != nil {
+		return *x.BusinessHours
+	}
+	return ""
+}
+
+func (x *SystemSLAPolicy) GetMilestones() *structpb.Value {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *SystemSLAPolicy) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemSLAPolicy) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSLAPolicy) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSLATimer represents the _System_SLATimer table (generated).
+// Milestone timers of records under an SLA policy
+type SystemSLATimer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	PolicyName       string                 `protobuf:"bytes,2,opt,name=policy_name,proto3" json:"policy_name,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,3,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,4,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	Milestone        string                 `protobuf:"bytes,5,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	TargetMinutes    int32                  `protobuf:"varint,6,opt,name=target_minutes,proto3" json:"target_minutes,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ElapsedSeconds   int64                  `protobuf:"varint,8,opt,name=elapsed_seconds,proto3" json:"elapsed_seconds,omitempty"`
+	RunningSince     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=running_since,proto3" json:"running_since,omitempty"`
+	DueDate          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_date,proto3" json:"due_date,omitempty"`
+	StartedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_date,proto3" json:"started_date,omitempty"`
+	CompletedDate    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_date,proto3" json:"completed_date,omitempty"`
+	Violated         bool                   `protobuf:"varint,13,opt,name=violated,proto3" json:"violated,omitempty"`
+	EscalatedDate    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=escalated_date,proto3" json:"escalated_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSLATimer) Reset() {
+	*x = SystemSLATimer{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSLATimer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSLATimer) ProtoMessage() {}
+
+func (x *SystemSLATimer) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSLATimer.ProtoReflect.Descriptor instead.
+func (*SystemSLATimer) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SystemSLATimer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetMilestone() string {
+	if x != nil {
+		return x.Milestone
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetTargetMinutes() int32 {
+	if x != nil {
+		return x.TargetMinutes
+	}
+	return 0
+}
+
+func (x *SystemSLATimer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemSLATimer) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *SystemSLATimer) GetRunningSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunningSince
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetStartedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedDate
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetCompletedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedDate
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetViolated() bool {
+	if x != nil {
+		return x.Violated
+	}
+	return false
+}
+
+func (x *SystemSLATimer) GetEscalatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EscalatedDate
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSLATimer) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSavedSearch represents the _System_SavedSearch table (generated).
+// Per-user saved global searches
+type SystemSavedSearch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Term             string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	ObjectScope      *structpb.Value        `protobuf:"bytes,5,opt,name=object_scope,proto3" json:"object_scope,omitempty"`
+	LastRunDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_date,proto3" json:"last_run_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSavedSearch) Reset() {
+	*x = SystemSavedSearch{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSavedSearch) ProtoMessage() {}
+
+func (x *SystemSavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSavedSearch.ProtoReflect.Descriptor instead.
+func (*SystemSavedSearch) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SystemSavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSavedSearch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemSavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemSavedSearch) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SystemSavedSearch) GetObjectScope() *structpb.Value {
+	if x != nil {
+		return x.ObjectScope
+	}
+	return nil
+}
+
+func (x *SystemSavedSearch) GetLastRunDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunDate
+	}
+	return nil
+}
+
+func (x *SystemSavedSearch) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSavedSearch) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemScript represents the _System_Script table (generated).
+// Sandboxed Starlark scripts run as record triggers and flow actions under step, time, memory and DML quotas
+type SystemScript struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Source           string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	TriggerObject    *string                `protobuf:"bytes,5,opt,name=trigger_object,proto3,oneof" json:"trigger_object,omitempty"`
+	TriggerEvents    *string                `protobuf:"bytes,6,opt,name=trigger_events,proto3,oneof" json:"trigger_events,omitempty"`
+	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	MaxSteps         *int64                 `protobuf:"varint,8,opt,name=max_steps,proto3,oneof" json:"max_steps,omitempty"`
+	TimeoutMs        *int32                 `protobuf:"varint,9,opt,name=timeout_ms,proto3,oneof" json:"timeout_ms,omitempty"`
+	MaxMemoryMb      *int32                 `protobuf:"varint,10,opt,name=max_memory_mb,proto3,oneof" json:"max_memory_mb,omitempty"`
+	MaxDmlRows       *int32                 `protobuf:"varint,11,opt,name=max_dml_rows,proto3,oneof" json:"max_dml_rows,omitempty"`
+	MaxQueryRows     *int32                 `protobuf:"varint,12,opt,name=max_query_rows,proto3,oneof" json:"max_query_rows,omitempty"`
+	MaxCallouts      *int32                 `protobuf:"varint,13,opt,name=max_callouts,proto3,oneof" json:"max_callouts,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,14,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemScript) Reset() {
+	*x = SystemScript{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemScript) ProtoMessage() {}
+
+func (x *SystemScript) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemScript.ProtoReflect.Descriptor instead.
+func (*SystemScript) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SystemScript) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemScript) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemScript) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SystemScript) GetTriggerObject() string {
+	if x != nil && x.TriggerObject != nil {
+		return *x.TriggerObject
+	}
+	return ""
+}
+
+func (x *SystemScript) GetTriggerEvents() string {
+	if x != nil && x.TriggerEvents != nil {
+		return *x.TriggerEvents
+	}
+	return ""
+}
+
+func (x *SystemScript) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemScript) GetMaxSteps() int64 {
+	if x != nil && x.MaxSteps != nil {
+		return *x.MaxSteps
+	}
+	return 0
+}
+
+func (x *SystemScript) GetTimeoutMs() int32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *SystemScript) GetMaxMemoryMb() int32 {
+	if x != nil && x.MaxMemoryMb != nil {
+		return *x.MaxMemoryMb
+	}
+	return 0
+}
+
+func (x *SystemScript) GetMaxDmlRows() int32 {
+	if x != nil && x.MaxDmlRows != nil {
+		return *x.MaxDmlRows
+	}
+	return 0
+}
+
+func (x *SystemScript) GetMaxQueryRows() int32 {
+	if x != nil && x.MaxQueryRows != nil {
+		return *x.MaxQueryRows
+	}
+	return 0
+}
+
+func (x *SystemScript) GetMaxCallouts() int32 {
+	if x != nil && x.MaxCallouts != nil {
+		return *x.MaxCallouts
+	}
+	return 0
+}
+
+func (x *SystemScript) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemScript) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemScript) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemScriptLog represents the _System_ScriptLog table (generated).
+// Script runs with their outcome, resource usage and log output
+type SystemScriptLog struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ScriptId         string                 `protobuf:"bytes,2,opt,name=script_id,proto3" json:"script_id,omitempty"`
+	ScriptName       string                 `protobuf:"bytes,3,opt,name=script_name,proto3" json:"script_name,omitempty"`
+	Invocation       string                 `protobuf:"bytes,4,opt,name=invocation,proto3" json:"invocation,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,5,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	RecordId         *string                `protobuf:"bytes,6,opt,name=record_id,proto3,oneof" json:"record_id,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	DurationMs       int32                  `protobuf:"varint,8,opt,name=duration_ms,proto3" json:"duration_ms,omitempty"`
+	Steps            int64                  `protobuf:"varint,9,opt,name=steps,proto3" json:"steps,omitempty"`
+	DmlRows          int32                  `protobuf:"varint,10,opt,name=dml_rows,proto3" json:"dml_rows,omitempty"`
+	QueryRows        int32                  `protobuf:"varint,11,opt,name=query_rows,proto3" json:"query_rows,omitempty"`
+	Callouts         int32                  `protobuf:"varint,12,opt,name=callouts,proto3" json:"callouts,omitempty"`
+	Output           *string                `protobuf:"bytes,13,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	ErrorMessage     *string                `protobuf:"bytes,14,opt,name=error_message,proto3,oneof" json:"error_message,omitempty"`
+	UserId           *string                `protobuf:"bytes,15,opt,name=user_id,proto3,oneof" json:"user_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemScriptLog) Reset() {
+	*x = SystemScriptLog{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemScriptLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemScriptLog) ProtoMessage() {}
+
+func (x *SystemScriptLog) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemScriptLog.ProtoReflect.Descriptor instead.
+func (*SystemScriptLog) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SystemScriptLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetScriptName() string {
+	if x != nil {
+		return x.ScriptName
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetInvocation() string {
+	if x != nil {
+		return x.Invocation
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetRecordId() string {
+	if x != nil && x.RecordId != nil {
+		return *x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SystemScriptLog) GetSteps() int64 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *SystemScriptLog) GetDmlRows() int32 {
+	if x != nil {
+		return x.DmlRows
+	}
+	return 0
+}
+
+func (x *SystemScriptLog) GetQueryRows() int32 {
+	if x != nil {
+		return x.QueryRows
+	}
+	return 0
+}
+
+func (x *SystemScriptLog) GetCallouts() int32 {
+	if x != nil {
+		return x.Callouts
+	}
+	return 0
+}
+
+func (x *SystemScriptLog) GetOutput() string {
+	if x != nil && x.Output != nil {
+		return *x.Output
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *SystemScriptLog) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemScriptLog) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSession represents the _System_Session table (generated).
+// User authentication sessions
+type SystemSession struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Token            string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	LastActivity     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_activity,proto3" json:"last_activity,omitempty"`
+	IpAddress        string                 `protobuf:"bytes,6,opt,name=ip_address,proto3" json:"ip_address,omitempty"`
+	UserAgent        string                 `protobuf:"bytes,7,opt,name=user_agent,proto3" json:"user_agent,omitempty"`
+	IsRevoked        bool                   `protobuf:"varint,8,opt,name=is_revoked,proto3" json:"is_revoked,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSession) Reset() {
+	*x = SystemSession{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSession) ProtoMessage() {}
+
+func (x *SystemSession) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSession.ProtoReflect.Descriptor instead.
+func (*SystemSession) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SystemSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSession) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemSession) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SystemSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SystemSession) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *SystemSession) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SystemSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SystemSession) GetIsRevoked() bool {
+	if x != nil {
+		return x.IsRevoked
+	}
+	return false
+}
+
+func (x *SystemSession) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSession) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSetupAudit represents the _System_SetupAudit table (generated).
+// Setup audit trail: one row per metadata change with its before/after state and actor
+type SystemSetupAudit struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Action           string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ComponentType    string                 `protobuf:"bytes,3,opt,name=component_type,proto3" json:"component_type,omitempty"`
+	ComponentName    string                 `protobuf:"bytes,4,opt,name=component_name,proto3" json:"component_name,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,5,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	BeforeData       *structpb.Value        `protobuf:"bytes,6,opt,name=before_data,proto3" json:"before_data,omitempty"`
+	AfterData        *structpb.Value        `protobuf:"bytes,7,opt,name=after_data,proto3" json:"after_data,omitempty"`
+	ActorId          *string                `protobuf:"bytes,8,opt,name=actor_id,proto3,oneof" json:"actor_id,omitempty"`
+	ActorName        *string                `protobuf:"bytes,9,opt,name=actor_name,proto3,oneof" json:"actor_name,omitempty"`
+	ChangedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSetupAudit) Reset() {
+	*x = SystemSetupAudit{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSetupAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetupAudit) ProtoMessage() {}
+
+func (x *SystemSetupAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetupAudit.ProtoReflect.Descriptor instead.
+func (*SystemSetupAudit) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SystemSetupAudit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetComponentType() string {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetComponentName() string {
+	if x != nil {
+		return x.ComponentName
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetBeforeData() *structpb.Value {
+	if x != nil {
+		return x.BeforeData
+	}
+	return nil
+}
+
+func (x *SystemSetupAudit) GetAfterData() *structpb.Value {
+	if x != nil {
+		return x.AfterData
+	}
+	return nil
+}
+
+func (x *SystemSetupAudit) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetActorName() string {
+	if x != nil && x.ActorName != nil {
+		return *x.ActorName
+	}
+	return ""
+}
+
+func (x *SystemSetupAudit) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *SystemSetupAudit) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSetupAudit) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSetupPage represents the _System_SetupPage table (generated).
+// Setup page definitions
+type SystemSetupPage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Label              string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Icon               *string                `protobuf:"bytes,3,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	ComponentName      string                 `protobuf:"bytes,4,opt,name=component_name,proto3" json:"component_name,omitempty"`
+	Category           string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	PageOrder          int32                  `protobuf:"varint,6,opt,name=page_order,proto3" json:"page_order,omitempty"`
+	PermissionRequired *string                `protobuf:"bytes,7,opt,name=permission_required,proto3,oneof" json:"permission_required,omitempty"`
+	IsEnabled          bool                   `protobuf:"varint,8,opt,name=is_enabled,proto3" json:"is_enabled,omitempty"`
+	Description        *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Path               *string                `protobuf:"bytes,10,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	CreatedDate        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemSetupPage) Reset() {
+	*x = SystemSetupPage{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSetupPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetupPage) ProtoMessage() {}
+
+func (x *SystemSetupPage) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetupPage.ProtoReflect.Descriptor instead.
+func (*SystemSetupPage) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SystemSetupPage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetComponentName() string {
+	if x != nil {
+		return x.ComponentName
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetPageOrder() int32 {
+	if x != nil {
+		return x.PageOrder
+	}
+	return 0
+}
+
+func (x *SystemSetupPage) GetPermissionRequired() string {
+	if x != nil && x.PermissionRequired != nil {
+		return *x.PermissionRequired
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SystemSetupPage) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *SystemSetupPage) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSetupPage) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSharingRule represents the _System_SharingRule table (generated).
+// Record sharing rules
+type SystemSharingRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Criteria         string                 `protobuf:"bytes,4,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	AccessLevel      string                 `protobuf:"bytes,5,opt,name=access_level,proto3" json:"access_level,omitempty"`
+	ShareWithRoleId  *string                `protobuf:"bytes,6,opt,name=share_with_role_id,proto3,oneof" json:"share_with_role_id,omitempty"`
+	ShareWithGroupId *string                `protobuf:"bytes,7,opt,name=share_with_group_id,proto3,oneof" json:"share_with_group_id,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,8,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,9,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,10,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,11,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSharingRule) Reset() {
+	*x = SystemSharingRule{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSharingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSharingRule) ProtoMessage() {}
+
+func (x *SystemSharingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSharingRule.ProtoReflect.Descriptor instead.
+func (*SystemSharingRule) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SystemSharingRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetCriteria() string {
+	if x != nil {
+		return x.Criteria
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetAccessLevel() string {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetShareWithRoleId() string {
+	if x != nil && x.ShareWithRoleId != nil {
+		return *x.ShareWithRoleId
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetShareWithGroupId() string {
+	if x != nil && x.ShareWithGroupId != nil {
+		return *x.ShareWithGroupId
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemSharingRule) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemSharingRule) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSharingRule) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemStageHistory represents the _System_StageHistory table (generated).
+// Stays of records in the values of history-tracked picklist fields (stage, status) with entry and exit times
+type SystemStageHistory struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName   string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId        string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	FieldApiName    string                 `protobuf:"bytes,4,opt,name=field_api_name,proto3" json:"field_api_name,omitempty"`
+	Value           string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	PreviousValue   *string                `protobuf:"bytes,6,opt,name=previous_value,proto3,oneof" json:"previous_value,omitempty"`
+	EnteredDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=entered_date,proto3" json:"entered_date,omitempty"`
+	ExitedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=exited_date,proto3" json:"exited_date,omitempty"`
+	DurationSeconds *int64                 `protobuf:"varint,9,opt,name=duration_seconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	ChangedById     *string                `protobuf:"bytes,10,opt,name=changed_by_id,proto3,oneof" json:"changed_by_id,omitempty"`
+	CreatedDate     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SystemStageHistory) Reset() {
+	*x = SystemStageHistory{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemStageHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStageHistory) ProtoMessage() {}
+
+func (x *SystemStageHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStageHistory.ProtoReflect.Descriptor instead.
+func (*SystemStageHistory) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SystemStageHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetFieldApiName() string {
+	if x != nil {
+		return x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetPreviousValue() string {
+	if x != nil && x.PreviousValue != nil {
+		return *x.PreviousValue
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetEnteredDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnteredDate
+	}
+	return nil
+}
+
+func (x *SystemStageHistory) GetExitedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExitedDate
+	}
+	return nil
+}
+
+func (x *SystemStageHistory) GetDurationSeconds() int64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *SystemStageHistory) GetChangedById() string {
+	if x != nil && x.ChangedById != nil {
+		return *x.ChangedById
+	}
+	return ""
+}
+
+func (x *SystemStageHistory) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+// SystemStateMachine represents the _System_StateMachine table (generated).
+// State machines on picklist fields: the allowed transitions between values, their required fields and profiles, and the actions run on entering and leaving each state
+type SystemStateMachine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,4,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	FieldApiName     string                 `protobuf:"bytes,5,opt,name=field_api_name,proto3" json:"field_api_name,omitempty"`
+	InitialStates    *structpb.Value        `protobuf:"bytes,6,opt,name=initial_states,proto3" json:"initial_states,omitempty"`
+	States           *structpb.Value        `protobuf:"bytes,7,opt,name=states,proto3" json:"states,omitempty"`
+	Transitions      *structpb.Value        `protobuf:"bytes,8,opt,name=transitions,proto3" json:"transitions,omitempty"`
+	IsActive         bool                   `protobuf:"varint,9,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemStateMachine) Reset() {
+	*x = SystemStateMachine{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemStateMachine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStateMachine) ProtoMessage() {}
+
+func (x *SystemStateMachine) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStateMachine.ProtoReflect.Descriptor instead.
+func (*SystemStateMachine) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SystemStateMachine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemStateMachine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemStateMachine) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemStateMachine) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemStateMachine) GetFieldApiName() string {
+	if x != nil {
+		return x.FieldApiName
+	}
+	return ""
+}
+
+func (x *SystemStateMachine) GetInitialStates() *structpb.Value {
+	if x != nil {
+		return x.InitialStates
+	}
+	return nil
+}
+
+func (x *SystemStateMachine) GetStates() *structpb.Value {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *SystemStateMachine) GetTransitions() *structpb.Value {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *SystemStateMachine) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemStateMachine) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemStateMachine) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSurvey represents the _System_Survey table (generated).
+// Customer survey: a CSAT or NPS score question with optional follow-up questions
+type SystemSurvey struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ScoreType        string                 `protobuf:"bytes,4,opt,name=score_type,proto3" json:"score_type,omitempty"`
+	Question         string                 `protobuf:"bytes,5,opt,name=question,proto3" json:"question,omitempty"`
+	Questions        *structpb.Value        `protobuf:"bytes,6,opt,name=questions,proto3" json:"questions,omitempty"`
+	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,8,opt,name=owner_id,json=__sys_gen_owner_id,proto3" json:"owner_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSurvey) Reset() {
+	*x = SystemSurvey{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSurvey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSurvey) ProtoMessage() {}
+
+func (x *SystemSurvey) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSurvey.ProtoReflect.Descriptor instead.
+func (*SystemSurvey) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SystemSurvey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetScoreType() string {
+	if x != nil {
+		return x.ScoreType
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetQuestions() *structpb.Value {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *SystemSurvey) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemSurvey) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemSurvey) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSurvey) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSurveyInvitation represents the _System_SurveyInvitation table (generated).
+// Single-use survey link sent about a record such as a case; only a hash of its token is stored
+type SystemSurveyInvitation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	SurveyId         string                 `protobuf:"bytes,2,opt,name=survey_id,proto3" json:"survey_id,omitempty"`
+	TokenHash        string                 `protobuf:"bytes,3,opt,name=token_hash,proto3" json:"token_hash,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,4,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	RecordId         *string                `protobuf:"bytes,5,opt,name=record_id,proto3,oneof" json:"record_id,omitempty"`
+	ContactId        *string                `protobuf:"bytes,6,opt,name=contact_id,proto3,oneof" json:"contact_id,omitempty"`
+	ExpiresDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_date,proto3" json:"expires_date,omitempty"`
+	RespondedDate    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=responded_date,proto3" json:"responded_date,omitempty"`
+	CreatedById      string                 `protobuf:"bytes,9,opt,name=created_by_id,proto3" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSurveyInvitation) Reset() {
+	*x = SystemSurveyInvitation{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSurveyInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSurveyInvitation) ProtoMessage() {}
+
+func (x *SystemSurveyInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSurveyInvitation.ProtoReflect.Descriptor instead.
+func (*SystemSurveyInvitation) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SystemSurveyInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetSurveyId() string {
+	if x != nil {
+		return x.SurveyId
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetRecordId() string {
+	if x != nil && x.RecordId != nil {
+		return *x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetContactId() string {
+	if x != nil && x.ContactId != nil {
+		return *x.ContactId
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetExpiresDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresDate
+	}
+	return nil
+}
+
+func (x *SystemSurveyInvitation) GetRespondedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedDate
+	}
+	return nil
+}
+
+func (x *SystemSurveyInvitation) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemSurveyInvitation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSurveyInvitation) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSurveyResponse represents the _System_SurveyResponse table (generated).
+// Scored answer to a survey invitation, linked to the invitation's record and contact
+type SystemSurveyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	SurveyId         string                 `protobuf:"bytes,2,opt,name=survey_id,proto3" json:"survey_id,omitempty"`
+	InvitationId     string                 `protobuf:"bytes,3,opt,name=invitation_id,proto3" json:"invitation_id,omitempty"`
+	ObjectApiName    *string                `protobuf:"bytes,4,opt,name=object_api_name,proto3,oneof" json:"object_api_name,omitempty"`
+	RecordId         *string                `protobuf:"bytes,5,opt,name=record_id,proto3,oneof" json:"record_id,omitempty"`
+	ContactId        *string                `protobuf:"bytes,6,opt,name=contact_id,proto3,oneof" json:"contact_id,omitempty"`
+	Score            int32                  `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	Answers          *structpb.Value        `protobuf:"bytes,8,opt,name=answers,proto3" json:"answers,omitempty"`
+	Comment          *string                `protobuf:"bytes,9,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	SubmittedDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=submitted_date,proto3" json:"submitted_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemSurveyResponse) Reset() {
+	*x = SystemSurveyResponse{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSurveyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSurveyResponse) ProtoMessage() {}
+
+func (x *SystemSurveyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSurveyResponse.ProtoReflect.Descriptor instead.
+func (*SystemSurveyResponse) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SystemSurveyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetSurveyId() string {
+	if x != nil {
+		return x.SurveyId
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetObjectApiName() string {
+	if x != nil && x.ObjectApiName != nil {
+		return *x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetRecordId() string {
+	if x != nil && x.RecordId != nil {
+		return *x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetContactId() string {
+	if x != nil && x.ContactId != nil {
+		return *x.ContactId
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SystemSurveyResponse) GetAnswers() *structpb.Value {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *SystemSurveyResponse) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *SystemSurveyResponse) GetSubmittedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedDate
+	}
+	return nil
+}
+
+func (x *SystemSurveyResponse) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSurveyResponse) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSyncConnector represents the _System_SyncConnector table (generated).
+// Mailbox and calendar connections of users (Google, Microsoft 365); OAuth tokens are stored encrypted
+type SystemSyncConnector struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Provider            string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	AccountEmail        string                 `protobuf:"bytes,4,opt,name=account_email,proto3" json:"account_email,omitempty"`
+	AccessToken         string                 `protobuf:"bytes,5,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	RefreshToken        *string                `protobuf:"bytes,6,opt,name=refresh_token,proto3,oneof" json:"refresh_token,omitempty"`
+	TokenExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=token_expires_at,proto3" json:"token_expires_at,omitempty"`
+	SyncEmail           bool                   `protobuf:"varint,8,opt,name=sync_email,proto3" json:"sync_email,omitempty"`
+	SyncCalendar        bool                   `protobuf:"varint,9,opt,name=sync_calendar,proto3" json:"sync_calendar,omitempty"`
+	IsActive            bool                   `protobuf:"varint,10,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	EmailSyncedUntil    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=email_synced_until,proto3" json:"email_synced_until,omitempty"`
+	CalendarSyncedUntil *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=calendar_synced_until,proto3" json:"calendar_synced_until,omitempty"`
+	LastSyncDate        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_sync_date,proto3" json:"last_sync_date,omitempty"`
+	LastError           *string                `protobuf:"bytes,14,opt,name=last_error,proto3,oneof" json:"last_error,omitempty"`
+	CreatedDate         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SystemSyncConnector) Reset() {
+	*x = SystemSyncConnector{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSyncConnector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSyncConnector) ProtoMessage() {}
+
+func (x *SystemSyncConnector) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSyncConnector.ProtoReflect.Descriptor instead.
+func (*SystemSyncConnector) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SystemSyncConnector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetAccountEmail() string {
+	if x != nil {
+		return x.AccountEmail
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return nil
+}
+
+func (x *SystemSyncConnector) GetSyncEmail() bool {
+	if x != nil {
+		return x.SyncEmail
+	}
+	return false
+}
+
+func (x *SystemSyncConnector) GetSyncCalendar() bool {
+	if x != nil {
+		return x.SyncCalendar
+	}
+	return false
+}
+
+func (x *SystemSyncConnector) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemSyncConnector) GetEmailSyncedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EmailSyncedUntil
+	}
+	return nil
+}
+
+func (x *SystemSyncConnector) GetCalendarSyncedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CalendarSyncedUntil
+	}
+	return nil
+}
+
+func (x *SystemSyncConnector) GetLastSyncDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncDate
+	}
+	return nil
+}
+
+func (x *SystemSyncConnector) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *SystemSyncConnector) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemSyncConnector) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemSystemLog represents the _System_SystemLog table (generated).
+// System operation logs
+type SystemSystemLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Details       *string                `protobuf:"bytes,6,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemSystemLog) Reset() {
+	*x = SystemSystemLog{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSystemLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSystemLog) ProtoMessage() {}
+
+func (x *SystemSystemLog) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSystemLog.ProtoReflect.Descriptor instead.
+func (*SystemSystemLog) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SystemSystemLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemSystemLog) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SystemSystemLog) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SystemSystemLog) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SystemSystemLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SystemSystemLog) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+// SystemTable represents the _System_Table table (generated).
+// Meta-metadata registry cataloging all tables
+type SystemTable struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	TableName        string                 `protobuf:"bytes,2,opt,name=table_name,proto3" json:"table_name,omitempty"`
+	TableType        string                 `protobuf:"bytes,3,opt,name=table_type,proto3" json:"table_type,omitempty"`
+	Category         string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	IsManaged        bool                   `protobuf:"varint,6,opt,name=is_managed,proto3" json:"is_managed,omitempty"`
+	SchemaVersion    string                 `protobuf:"bytes,7,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemTable) Reset() {
+	*x = SystemTable{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemTable) ProtoMessage() {}
+
+func (x *SystemTable) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemTable.ProtoReflect.Descriptor instead.
+func (*SystemTable) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SystemTable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemTable) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SystemTable) GetTableType() string {
+	if x != nil {
+		return x.TableType
+	}
+	return ""
+}
+
+func (x *SystemTable) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SystemTable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemTable) GetIsManaged() bool {
+	if x != nil {
+		return x.IsManaged
+	}
+	return false
+}
+
+func (x *SystemTable) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SystemTable) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SystemTable) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemTable) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemTeamMember represents the _System_TeamMember table (generated).
+// Generic record team members
+type SystemTeamMember struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	RecordId         string                 `protobuf:"bytes,3,opt,name=record_id,proto3" json:"record_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,4,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	TeamRole         string                 `protobuf:"bytes,5,opt,name=team_role,proto3" json:"team_role,omitempty"`
+	AccessLevel      string                 `protobuf:"bytes,6,opt,name=access_level,proto3" json:"access_level,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,7,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,9,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,11,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemTeamMember) Reset() {
+	*x = SystemTeamMember{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemTeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemTeamMember) ProtoMessage() {}
+
+func (x *SystemTeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemTeamMember.ProtoReflect.Descriptor instead.
+func (*SystemTeamMember) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SystemTeamMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetTeamRole() string {
+	if x != nil {
+		return x.TeamRole
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetAccessLevel() string {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemTeamMember) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemTeamMember) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+func (x *SystemTeamMember) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+// SystemTheme represents the _System_Theme table (generated).
+// Visual theme configurations
+type SystemTheme struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive         bool                   `protobuf:"varint,3,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	Colors           *structpb.Value        `protobuf:"bytes,4,opt,name=colors,proto3" json:"colors,omitempty"`
+	Density          string                 `protobuf:"bytes,5,opt,name=density,proto3" json:"density,omitempty"`
+	LogoUrl          *string                `protobuf:"bytes,6,opt,name=logo_url,proto3,oneof" json:"logo_url,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemTheme) Reset() {
+	*x = SystemTheme{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemTheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemTheme) ProtoMessage() {}
+
+func (x *SystemTheme) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemTheme.ProtoReflect.Descriptor instead.
+func (*SystemTheme) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SystemTheme) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemTheme) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemTheme) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemTheme) GetColors() *structpb.Value {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *SystemTheme) GetDensity() string {
+	if x != nil {
+		return x.Density
+	}
+	return ""
+}
+
+func (x *SystemTheme) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *SystemTheme) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemTheme) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemTranslation represents the _System_Translation table (generated).
+// Translated labels and messages of metadata components, one row per locale and component
+type SystemTranslation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Locale           string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	ComponentType    string                 `protobuf:"bytes,3,opt,name=component_type,proto3" json:"component_type,omitempty"`
+	ComponentKey     string                 `protobuf:"bytes,4,opt,name=component_key,proto3" json:"component_key,omitempty"`
+	Translation      string                 `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemTranslation) Reset() {
+	*x = SystemTranslation{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemTranslation) ProtoMessage() {}
+
+func (x *SystemTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemTranslation.ProtoReflect.Descriptor instead.
+func (*SystemTranslation) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SystemTranslation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemTranslation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SystemTranslation) GetComponentType() string {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ""
+}
+
+func (x *SystemTranslation) GetComponentKey() string {
+	if x != nil {
+		return x.ComponentKey
+	}
+	return ""
+}
+
+func (x *SystemTranslation) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *SystemTranslation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemTranslation) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemUIComponent represents the _System_UIComponent table (generated).
+// Registered UI components
+type SystemUIComponent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	IsEmbeddable     bool                   `protobuf:"varint,4,opt,name=is_embeddable,proto3" json:"is_embeddable,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ComponentPath    *string                `protobuf:"bytes,6,opt,name=component_path,proto3,oneof" json:"component_path,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemUIComponent) Reset() {
+	*x = SystemUIComponent{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemUIComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUIComponent) ProtoMessage() {}
+
+func (x *SystemUIComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUIComponent.ProtoReflect.Descriptor instead.
+func (*SystemUIComponent) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SystemUIComponent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemUIComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemUIComponent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemUIComponent) GetIsEmbeddable() bool {
+	if x != nil {
+		return x.IsEmbeddable
+	}
+	return false
+}
+
+func (x *SystemUIComponent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemUIComponent) GetComponentPath() string {
+	if x != nil && x.ComponentPath != nil {
+		return *x.ComponentPath
+	}
+	return ""
+}
+
+func (x *SystemUIComponent) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemUIComponent) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemUser represents the _System_User table (generated).
+// System users
+type SystemUser struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email            string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName        string                 `protobuf:"bytes,5,opt,name=first_name,proto3" json:"first_name,omitempty"`
+	LastName         string                 `protobuf:"bytes,6,opt,name=last_name,proto3" json:"last_name,omitempty"`
+	Phone            *string                `protobuf:"bytes,7,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	ProfileId        string                 `protobuf:"bytes,8,opt,name=profile_id,proto3" json:"profile_id,omitempty"`
+	RoleId           *string                `protobuf:"bytes,9,opt,name=role_id,proto3,oneof" json:"role_id,omitempty"`
+	UserType         string                 `protobuf:"bytes,10,opt,name=user_type,proto3" json:"user_type,omitempty"`
+	ContactId        *string                `protobuf:"bytes,11,opt,name=contact_id,proto3,oneof" json:"contact_id,omitempty"`
+	AccountId        *string                `protobuf:"bytes,12,opt,name=account_id,proto3,oneof" json:"account_id,omitempty"`
+	Locale           *string                `protobuf:"bytes,13,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	IsActive         bool                   `protobuf:"varint,14,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,15,opt,name=is_deleted,json=__sys_gen_is_deleted,proto3" json:"is_deleted,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,16,opt,name=owner_id,json=__sys_gen_owner_id,proto3,oneof" json:"owner_id,omitempty"`
+	CreatedById      *string                `protobuf:"bytes,17,opt,name=created_by_id,json=__sys_gen_created_by_id,proto3,oneof" json:"created_by_id,omitempty"`
+	LastModifiedById *string                `protobuf:"bytes,18,opt,name=last_modified_by_id,json=__sys_gen_last_modified_by_id,proto3,oneof" json:"last_modified_by_id,omitempty"`
+	LastLoginDate    *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_login_date,proto3" json:"last_login_date,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemUser) Reset() {
+	*x = SystemUser{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUser) ProtoMessage() {}
+
+func (x *SystemUser) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUser.ProtoReflect.Descriptor instead.
+func (*SystemUser) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SystemUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SystemUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SystemUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *SystemUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *SystemUser) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *SystemUser) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *SystemUser) GetRoleId() string {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return ""
+}
+
+func (x *SystemUser) GetUserType() string {
+	if x != nil {
+		return x.UserType
+	}
+	return ""
+}
+
+func (x *SystemUser) GetContactId() string {
+	if x != nil && x.ContactId != nil {
+		return *x.ContactId
+	}
+	return ""
+}
+
+func (x *SystemUser) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *SystemUser) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *SystemUser) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemUser) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SystemUser) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SystemUser) GetCreatedById() string {
+	if x != nil && x.CreatedById != nil {
+		return *x.CreatedById
+	}
+	return ""
+}
+
+func (x *SystemUser) GetLastModifiedById() string {
+	if x != nil && x.LastModifiedById != nil {
+		return *x.LastModifiedById
+	}
+	return ""
+}
+
+func (x *SystemUser) GetLastLoginDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginDate
+	}
+	return nil
+}
+
+func (x *SystemUser) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemUser) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemValidation represents the _System_Validation table (generated).
+// Validation rule definitions
+type SystemValidation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	ObjectApiName    string                 `protobuf:"bytes,2,opt,name=object_api_name,proto3" json:"object_api_name,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Active           bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Condition        string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	ErrorMessage     string                 `protobuf:"bytes,6,opt,name=error_message,proto3" json:"error_message,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemValidation) Reset() {
+	*x = SystemValidation{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemValidation) ProtoMessage() {}
+
+func (x *SystemValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemValidation.ProtoReflect.Descriptor instead.
+func (*SystemValidation) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SystemValidation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemValidation) GetObjectApiName() string {
+	if x != nil {
+		return x.ObjectApiName
+	}
+	return ""
+}
+
+func (x *SystemValidation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemValidation) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SystemValidation) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *SystemValidation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SystemValidation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemValidation) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+// SystemWebhook represents the _System_Webhook table (generated).
+// External webhook configurations
+type SystemWebhook struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,json=__sys_gen_id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url              string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Method           string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Headers          string                 `protobuf:"bytes,5,opt,name=headers,proto3" json:"headers,omitempty"`
+	AuthType         string                 `protobuf:"bytes,6,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
+	AuthConfig       string                 `protobuf:"bytes,7,opt,name=auth_config,proto3" json:"auth_config,omitempty"`
+	IsActive         bool                   `protobuf:"varint,8,opt,name=is_active,proto3" json:"is_active,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_date,json=__sys_gen_created_date,proto3" json:"created_date,omitempty"`
+	LastModifiedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_modified_date,json=__sys_gen_last_modified_date,proto3" json:"last_modified_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemWebhook) Reset() {
+	*x = SystemWebhook{}
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemWebhook) ProtoMessage() {}
+
+func (x *SystemWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_nexuscrm_v1_system_tables_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemWebhook.ProtoReflect.Descriptor instead.
+func (*SystemWebhook) Descriptor() ([]byte, []int) {
+	return file_nexuscrm_v1_system_tables_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SystemWebhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetHeaders() string {
+	if x != nil {
+		return x.Headers
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetAuthConfig() string {
+	if x != nil {
+		return x.AuthConfig
+	}
+	return ""
+}
+
+func (x *SystemWebhook) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SystemWebhook) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *SystemWebhook) GetLastModifiedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedDate
+	}
+	return nil
+}
+
+var File_nexuscrm_v1_system_tables_proto protoreflect.FileDescriptor
+
+const file_nexuscrm_v1_system_tables_proto_rawDesc = "" +
+	"\n" +
+	"\x1fnexuscrm/v1/system_tables.proto\x12\vnexuscrm.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x02\n" +
+	"\x13SystemAIContextItem\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"token_size\x18\x05 \x01(\x05R\n" +
+	"token_size\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xb0\x03\n" +
+	"\x14SystemAIConversation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x122\n" +
+	"\bmessages\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\bmessages\x12\x1c\n" +
+	"\tis_active\x18\x05 \x01(\bR\tis_active\x122\n" +
+	"\bsettings\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\bsettings\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\x14__sys_gen_is_deleted\"\xbf\x03\n" +
+	"\fSystemAction\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x17\n" +
+	"\x04icon\x18\x06 \x01(\tH\x00R\x04icon\x88\x01\x01\x12)\n" +
+	"\rtarget_object\x18\a \x01(\tH\x01R\rtarget_object\x88\x01\x01\x12.\n" +
+	"\x06config\x18\b \x01(\v2\x16.google.protobuf.ValueR\x06config\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\a\n" +
+	"\x05_iconB\x10\n" +
+	"\x0e_target_object\"\x8f\b\n" +
+	"\x0eSystemActivity\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12$\n" +
+	"\ractivity_type\x18\x02 \x01(\tR\ractivity_type\x12\x1d\n" +
+	"\asubject\x18\x03 \x01(\tH\x00R\asubject\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12'\n" +
+	"\ffrom_address\x18\x05 \x01(\tH\x02R\ffrom_address\x88\x01\x01\x12'\n" +
+	"\fto_addresses\x18\x06 \x01(\tH\x03R\fto_addresses\x88\x01\x01\x12@\n" +
+	"\ractivity_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ractivity_date\x126\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bend_date\x12(\n" +
+	"\x0fobject_api_name\x18\t \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\n" +
+	" \x01(\tR\trecord_id\x12+\n" +
+	"\x0ecall_direction\x18\v \x01(\tH\x04R\x0ecall_direction\x88\x01\x01\x12%\n" +
+	"\vcall_status\x18\f \x01(\tH\x05R\vcall_status\x88\x01\x01\x12)\n" +
+	"\rcall_duration\x18\r \x01(\x05H\x06R\rcall_duration\x88\x01\x01\x12)\n" +
+	"\rrecording_url\x18\x0e \x01(\tH\aR\rrecording_url\x88\x01\x01\x12\"\n" +
+	"\fconnector_id\x18\x0f \x01(\tR\fconnector_id\x12 \n" +
+	"\vexternal_id\x18\x10 \x01(\tR\vexternal_id\x12$\n" +
+	"\bowner_id\x18\x11 \x01(\tR\x12__sys_gen_owner_id\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x12 \x01(\bR\x14__sys_gen_is_deleted\x12H\n" +
+	"\fcreated_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\n" +
+	"\n" +
+	"\b_subjectB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_from_addressB\x0f\n" +
+	"\r_to_addressesB\x11\n" +
+	"\x0f_call_directionB\x0e\n" +
+	"\f_call_statusB\x10\n" +
+	"\x0e_call_durationB\x10\n" +
+	"\x0e_recording_url\"\x9f\x03\n" +
+	"\tSystemApp\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12B\n" +
+	"\x10navigation_items\x18\a \x01(\v2\x16.google.protobuf.ValueR\x10navigation_items\x12\x1e\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\n" +
+	"is_default\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x83\x06\n" +
+	"\x15SystemApprovalProcess\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12+\n" +
+	"\x0eentry_criteria\x18\x05 \x01(\tH\x01R\x0eentry_criteria\x88\x01\x01\x12$\n" +
+	"\rapprover_type\x18\x06 \x01(\tR\rapprover_type\x12%\n" +
+	"\vapprover_id\x18\a \x01(\tH\x02R\vapprover_id\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\b \x01(\bR\tis_active\x12)\n" +
+	"\bowner_id\x18\t \x01(\tH\x03R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\n" +
+	" \x01(\tH\x04R\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12?\n" +
+	"\x13last_modified_by_id\x18\f \x01(\tH\x05R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x0e \x01(\bR\x14__sys_gen_is_deletedB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_entry_criteriaB\x0e\n" +
+	"\f_approver_idB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xc2\b\n" +
+	"\x16SystemApprovalWorkItem\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"process_id\x18\x02 \x01(\tR\n" +
+	"process_id\x12/\n" +
+	"\x10flow_instance_id\x18\x03 \x01(\tH\x00R\x10flow_instance_id\x88\x01\x01\x12'\n" +
+	"\fflow_step_id\x18\x04 \x01(\tH\x01R\fflow_step_id\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x05 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x06 \x01(\tR\trecord_id\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12-\n" +
+	"\x0fsubmitted_by_id\x18\b \x01(\tH\x02R\x0fsubmitted_by_id\x88\x01\x01\x12B\n" +
+	"\x0esubmitted_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0esubmitted_date\x12%\n" +
+	"\vapprover_id\x18\n" +
+	" \x01(\tH\x03R\vapprover_id\x88\x01\x01\x12+\n" +
+	"\x0eapproved_by_id\x18\v \x01(\tH\x04R\x0eapproved_by_id\x88\x01\x01\x12@\n" +
+	"\rapproved_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rapproved_date\x12\x1f\n" +
+	"\bcomments\x18\r \x01(\tH\x05R\bcomments\x88\x01\x01\x12)\n" +
+	"\bowner_id\x18\x0e \x01(\tH\x06R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\x0f \x01(\tH\aR\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12?\n" +
+	"\x13last_modified_by_id\x18\x11 \x01(\tH\bR\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12T\n" +
+	"\x12last_modified_date\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x13 \x01(\bR\x14__sys_gen_is_deletedB\x13\n" +
+	"\x11_flow_instance_idB\x0f\n" +
+	"\r_flow_step_idB\x12\n" +
+	"\x10_submitted_by_idB\x0e\n" +
+	"\f_approver_idB\x11\n" +
+	"\x0f_approved_by_idB\v\n" +
+	"\t_commentsB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\x81\x04\n" +
+	"\x13SystemArchivePolicy\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1e\n" +
+	"\n" +
+	"date_field\x18\x03 \x01(\tR\n" +
+	"date_field\x12 \n" +
+	"\vretain_days\x18\x04 \x01(\x05R\vretain_days\x12\x1c\n" +
+	"\tis_active\x18\x05 \x01(\bR\tis_active\x12@\n" +
+	"\rlast_run_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlast_run_date\x120\n" +
+	"\x13last_archived_count\x18\a \x01(\x05R\x13last_archived_count\x12#\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tH\x00R\n" +
+	"last_error\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\r\n" +
+	"\v_last_error\"\xf1\x05\n" +
+	"\x0eSystemAsyncJob\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bjob_type\x18\x02 \x01(\tR\bjob_type\x12-\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tH\x00R\x0fobject_api_name\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x126\n" +
+	"\n" +
+	"parameters\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"parameters\x12 \n" +
+	"\vtotal_count\x18\x06 \x01(\x05R\vtotal_count\x12(\n" +
+	"\x0fprocessed_count\x18\a \x01(\x05R\x0fprocessed_count\x12\"\n" +
+	"\ffailed_count\x18\b \x01(\x05R\ffailed_count\x12)\n" +
+	"\rerror_message\x18\t \x01(\tH\x01R\rerror_message\x88\x01\x01\x12>\n" +
+	"\fstarted_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fstarted_date\x12B\n" +
+	"\x0ecompleted_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompleted_date\x123\n" +
+	"\rcreated_by_id\x18\f \x01(\tH\x02R\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x12\n" +
+	"\x10_object_api_nameB\x10\n" +
+	"\x0e_error_messageB\x10\n" +
+	"\x0e_created_by_id\"\xd0\x03\n" +
+	"\x0eSystemAuditLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12\x1e\n" +
+	"\n" +
+	"field_name\x18\x04 \x01(\tR\n" +
+	"field_name\x12\x1c\n" +
+	"\told_value\x18\x05 \x01(\tR\told_value\x12\x1c\n" +
+	"\tnew_value\x18\x06 \x01(\tR\tnew_value\x12$\n" +
+	"\rchanged_by_id\x18\a \x01(\tR\rchanged_by_id\x12:\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changed_at\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xb4\x03\n" +
+	"\x10SystemAutoNumber\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12&\n" +
+	"\x0efield_api_name\x18\x03 \x01(\tR\x0efield_api_name\x12&\n" +
+	"\x0edisplay_format\x18\x04 \x01(\tR\x0edisplay_format\x12(\n" +
+	"\x0fstarting_number\x18\x05 \x01(\x05R\x0fstarting_number\x12&\n" +
+	"\x0ecurrent_number\x18\x06 \x01(\x05R\x0ecurrent_number\x12\x1a\n" +
+	"\bgap_free\x18\a \x01(\bR\bgap_free\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xf5\a\n" +
+	"\x0eSystemBatchJob\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12'\n" +
+	"\fscope_filter\x18\x05 \x01(\tH\x01R\fscope_filter\x88\x01\x01\x12\x1c\n" +
+	"\tprocessor\x18\x06 \x01(\tR\tprocessor\x12%\n" +
+	"\vscript_name\x18\a \x01(\tH\x02R\vscript_name\x88\x01\x01\x12:\n" +
+	"\ffield_values\x18\b \x01(\v2\x16.google.protobuf.ValueR\ffield_values\x12#\n" +
+	"\n" +
+	"chunk_size\x18\t \x01(\x05H\x03R\n" +
+	"chunk_size\x88\x01\x01\x12%\n" +
+	"\vconcurrency\x18\n" +
+	" \x01(\x05H\x04R\vconcurrency\x88\x01\x01\x12\x1f\n" +
+	"\bschedule\x18\v \x01(\tH\x05R\bschedule\x88\x01\x01\x121\n" +
+	"\x11schedule_timezone\x18\f \x01(\tH\x06R\x11schedule_timezone\x88\x01\x01\x12<\n" +
+	"\vnext_run_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vnext_run_at\x12<\n" +
+	"\vlast_run_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vlast_run_at\x12%\n" +
+	"\vlast_job_id\x18\x0f \x01(\tH\aR\vlast_job_id\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\x10 \x01(\bR\tis_active\x12)\n" +
+	"\bowner_id\x18\x11 \x01(\tH\bR\x12__sys_gen_owner_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_scope_filterB\x0e\n" +
+	"\f_script_nameB\r\n" +
+	"\v_chunk_sizeB\x0e\n" +
+	"\f_concurrencyB\v\n" +
+	"\t_scheduleB\x14\n" +
+	"\x12_schedule_timezoneB\x0e\n" +
+	"\f_last_job_idB\v\n" +
+	"\t_owner_id\"\xab\x02\n" +
+	"\x13SystemBootstrapStep\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tstep_name\x18\x02 \x01(\tR\tstep_name\x12\x1a\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x12 \n" +
+	"\vduration_ms\x18\x04 \x01(\x05R\vduration_ms\x12H\n" +
+	"\fcreated_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xe9\x02\n" +
+	"\x13SystemBusinessHours\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x1e\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\n" +
+	"is_default\x122\n" +
+	"\bschedule\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\bschedule\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x85\t\n" +
+	"\x0eSystemCampaign\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tH\x02R\n" +
+	"start_date\x88\x01\x01\x12\x1f\n" +
+	"\bend_date\x18\a \x01(\tH\x03R\bend_date\x88\x01\x01\x12)\n" +
+	"\rbudgeted_cost\x18\b \x01(\x01H\x04R\rbudgeted_cost\x88\x01\x01\x12%\n" +
+	"\vactual_cost\x18\t \x01(\x01H\x05R\vactual_cost\x88\x01\x01\x12/\n" +
+	"\x10expected_revenue\x18\n" +
+	" \x01(\x01H\x06R\x10expected_revenue\x88\x01\x01\x12@\n" +
+	"\x0fmember_statuses\x18\v \x01(\v2\x16.google.protobuf.ValueR\x0fmember_statuses\x12\x1c\n" +
+	"\tis_active\x18\f \x01(\bR\tis_active\x12,\n" +
+	"\x11number_of_members\x18\r \x01(\x05R\x11number_of_members\x120\n" +
+	"\x13number_of_responses\x18\x0e \x01(\x05R\x13number_of_responses\x128\n" +
+	"\x17number_of_opportunities\x18\x0f \x01(\x05R\x17number_of_opportunities\x12@\n" +
+	"\x1bnumber_of_won_opportunities\x18\x10 \x01(\x05R\x1bnumber_of_won_opportunities\x12:\n" +
+	"\x18amount_all_opportunities\x18\x11 \x01(\x01R\x18amount_all_opportunities\x12:\n" +
+	"\x18amount_won_opportunities\x18\x12 \x01(\x01R\x18amount_won_opportunities\x12>\n" +
+	"\frollups_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\frollups_date\x12$\n" +
+	"\bowner_id\x18\x14 \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\a\n" +
+	"\x05_typeB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\x10\n" +
+	"\x0e_budgeted_costB\x0e\n" +
+	"\f_actual_costB\x13\n" +
+	"\x11_expected_revenue\"\xea\x03\n" +
+	"\x14SystemCampaignMember\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12 \n" +
+	"\vcampaign_id\x18\x02 \x01(\tR\vcampaign_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12$\n" +
+	"\rhas_responded\x18\x06 \x01(\bR\rhas_responded\x12N\n" +
+	"\x14first_responded_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x14first_responded_date\x12 \n" +
+	"\vadded_by_id\x18\b \x01(\tR\vadded_by_id\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x9c\x05\n" +
+	"\x11SystemChangeEvent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x03R\bposition\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12&\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\x0etransaction_id\x12F\n" +
+	"\x10commit_timestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10commit_timestamp\x12>\n" +
+	"\x0echanged_fields\x18\b \x01(\v2\x16.google.protobuf.ValueR\x0echanged_fields\x128\n" +
+	"\vbefore_data\x18\t \x01(\v2\x16.google.protobuf.ValueR\vbefore_data\x126\n" +
+	"\n" +
+	"after_data\x18\n" +
+	" \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"after_data\x12\x1d\n" +
+	"\auser_id\x18\v \x01(\tH\x00R\auser_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\n" +
+	"\n" +
+	"\b_user_id\"\x8b\x02\n" +
+	"\x17SystemChangeEventOffset\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bconsumer\x18\x02 \x01(\tR\bconsumer\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x03R\bposition\x12H\n" +
+	"\fcreated_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xea\x03\n" +
+	"\rSystemComment\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12.\n" +
+	"\rcreated_by_id\x18\x05 \x01(\tR\x17__sys_gen_created_by_id\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\a \x01(\bR\x14__sys_gen_is_deleted\x121\n" +
+	"\x11parent_comment_id\x18\b \x01(\tH\x00R\x11parent_comment_id\x88\x01\x01\x12 \n" +
+	"\vis_resolved\x18\t \x01(\bR\vis_resolved\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x14\n" +
+	"\x12_parent_comment_id\"\xa0\x02\n" +
+	"\fSystemConfig\x12\x1a\n" +
+	"\bkey_name\x18\x01 \x01(\tR\bkey_name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1c\n" +
+	"\tis_secret\x18\x03 \x01(\bR\tis_secret\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12H\n" +
+	"\fcreated_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xc1\x03\n" +
+	"\x0eSystemContract\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\n" +
+	"account_id\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\n" +
+	"start_date\x12\x1f\n" +
+	"\bend_date\x18\x06 \x01(\tH\x00R\bend_date\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\bowner_id\x18\b \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_end_dateB\x0e\n" +
+	"\f_description\"\xe4\a\n" +
+	"\x14SystemCustomEndpoint\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12 \n" +
+	"\vhttp_method\x18\x04 \x01(\tR\vhttp_method\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12%\n" +
+	"\vscript_name\x18\x06 \x01(\tH\x01R\vscript_name\x88\x01\x01\x12-\n" +
+	"\x0fobject_api_name\x18\a \x01(\tH\x02R\x0fobject_api_name\x88\x01\x01\x12%\n" +
+	"\vfilter_expr\x18\b \x01(\tH\x03R\vfilter_expr\x88\x01\x01\x12#\n" +
+	"\n" +
+	"sort_field\x18\t \x01(\tH\x04R\n" +
+	"sort_field\x88\x01\x01\x12+\n" +
+	"\x0esort_direction\x18\n" +
+	" \x01(\tH\x05R\x0esort_direction\x88\x01\x01\x12!\n" +
+	"\trow_limit\x18\v \x01(\x05H\x06R\trow_limit\x88\x01\x01\x12!\n" +
+	"\trecord_id\x18\f \x01(\tH\aR\trecord_id\x88\x01\x01\x12:\n" +
+	"\ffield_values\x18\r \x01(\v2\x16.google.protobuf.ValueR\ffield_values\x12:\n" +
+	"\finput_schema\x18\x0e \x01(\v2\x16.google.protobuf.ValueR\finput_schema\x128\n" +
+	"\vprofile_ids\x18\x0f \x01(\v2\x16.google.protobuf.ValueR\vprofile_ids\x12\x1c\n" +
+	"\tis_active\x18\x10 \x01(\bR\tis_active\x12)\n" +
+	"\bowner_id\x18\x11 \x01(\tH\bR\x12__sys_gen_owner_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_script_nameB\x12\n" +
+	"\x10_object_api_nameB\x0e\n" +
+	"\f_filter_exprB\r\n" +
+	"\v_sort_fieldB\x11\n" +
+	"\x0f_sort_directionB\f\n" +
+	"\n" +
+	"_row_limitB\f\n" +
+	"\n" +
+	"_record_idB\v\n" +
+	"\t_owner_id\"\xf6\x02\n" +
+	"\x1aSystemCustomMetadataRecord\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12$\n" +
+	"\rtype_api_name\x18\x02 \x01(\tR\rtype_api_name\x12&\n" +
+	"\x0edeveloper_name\x18\x03 \x01(\tR\x0edeveloper_name\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12:\n" +
+	"\ffield_values\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\ffield_values\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xed\x02\n" +
+	"\x18SystemCustomMetadataType\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bapi_name\x18\x02 \x01(\tR\bapi_name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12.\n" +
+	"\x06fields\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x06fields\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_description\"\x82\x03\n" +
+	"\x13SystemCustomSetting\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12<\n" +
+	"\rdefault_value\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\rdefault_value\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_description\"\xe2\x02\n" +
+	"\x18SystemCustomSettingValue\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\"\n" +
+	"\fsetting_name\x18\x02 \x01(\tR\fsetting_name\x12\x1e\n" +
+	"\n" +
+	"scope_type\x18\x03 \x01(\tR\n" +
+	"scope_type\x12\x1a\n" +
+	"\bscope_id\x18\x04 \x01(\tR\bscope_id\x12,\n" +
+	"\x05value\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xbf\x05\n" +
+	"\x0fSystemDashboard\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06layout\x18\x04 \x01(\tR\x06layout\x120\n" +
+	"\awidgets\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\awidgets\x120\n" +
+	"\afilters\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\afilters\x12\x16\n" +
+	"\x06run_as\x18\a \x01(\tR\x06run_as\x12&\n" +
+	"\x0erun_as_user_id\x18\b \x01(\tR\x0erun_as_user_id\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12)\n" +
+	"\bowner_id\x18\n" +
+	" \x01(\tH\x00R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\v \x01(\tH\x01R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\f \x01(\tH\x02R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12(\n" +
+	"\n" +
+	"is_deleted\x18\r \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xdb\x03\n" +
+	"\x15SystemDataQualityRule\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12H\n" +
+	"\x13completeness_fields\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x13completeness_fields\x12:\n" +
+	"\fmatch_fields\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\fmatch_fields\x120\n" +
+	"\x13duplicate_threshold\x18\x05 \x01(\x05R\x13duplicate_threshold\x12&\n" +
+	"\x0euse_embeddings\x18\x06 \x01(\bR\x0euse_embeddings\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xd2\x04\n" +
+	"\x16SystemDataQualityScore\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12.\n" +
+	"\x12completeness_score\x18\x04 \x01(\x05R\x12completeness_score\x12(\n" +
+	"\x0fduplicate_score\x18\x05 \x01(\x05R\x0fduplicate_score\x12'\n" +
+	"\fduplicate_of\x18\x06 \x01(\tH\x00R\fduplicate_of\x88\x01\x01\x12$\n" +
+	"\rquality_score\x18\a \x01(\x05R\rquality_score\x12>\n" +
+	"\x0emissing_fields\x18\b \x01(\v2\x16.google.protobuf.ValueR\x0emissing_fields\x12<\n" +
+	"\vscored_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vscored_date\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0f\n" +
+	"\r_duplicate_of\"\x8c\x05\n" +
+	"\x15SystemDeletedMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12&\n" +
+	"\x0ecomponent_type\x18\x02 \x01(\tR\x0ecomponent_type\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12+\n" +
+	"\x0efield_api_name\x18\x04 \x01(\tH\x00R\x0efield_api_name\x88\x01\x01\x12'\n" +
+	"\fstorage_name\x18\x05 \x01(\tH\x01R\fstorage_name\x88\x01\x01\x122\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\bmetadata\x12)\n" +
+	"\rdeleted_by_id\x18\a \x01(\tH\x02R\rdeleted_by_id\x88\x01\x01\x12>\n" +
+	"\fdeleted_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fdeleted_date\x12<\n" +
+	"\vpurge_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vpurge_after\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x11\n" +
+	"\x0f_field_api_nameB\x0f\n" +
+	"\r_storage_nameB\x10\n" +
+	"\x0e_deleted_by_id\"\xd3\x03\n" +
+	"\x16SystemDocumentTemplate\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12$\n" +
+	"\rtemplate_type\x18\x05 \x01(\tR\rtemplate_type\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12!\n" +
+	"\tfile_name\x18\a \x01(\tH\x00R\tfile_name\x88\x01\x01\x12\x1c\n" +
+	"\tpage_size\x18\b \x01(\tR\tpage_size\x12\x1c\n" +
+	"\tis_active\x18\t \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\f\n" +
+	"\n" +
+	"_file_name\"\xb1\x03\n" +
+	"\x13SystemEmailTemplate\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1c\n" +
+	"\thtml_body\x18\x04 \x01(\tR\thtml_body\x12\x1c\n" +
+	"\ttext_body\x18\x05 \x01(\tR\ttext_body\x12\x1c\n" +
+	"\tfrom_name\x18\x06 \x01(\tR\tfrom_name\x12\x1e\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\n" +
+	"from_email\x12\x1a\n" +
+	"\breply_to\x18\b \x01(\tR\breply_to\x12\x1c\n" +
+	"\tis_active\x18\t \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xe6\x04\n" +
+	"\x11SystemEntitlement\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\n" +
+	"account_id\x12%\n" +
+	"\vcontract_id\x18\x04 \x01(\tH\x00R\vcontract_id\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x05 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\n" +
+	"start_date\x12\x1f\n" +
+	"\bend_date\x18\a \x01(\tH\x02R\bend_date\x88\x01\x01\x12%\n" +
+	"\vtotal_units\x18\b \x01(\x01H\x03R\vtotal_units\x88\x01\x01\x12-\n" +
+	"\x0fremaining_units\x18\t \x01(\x01H\x04R\x0fremaining_units\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\n" +
+	" \x01(\bR\tis_active\x12$\n" +
+	"\bowner_id\x18\v \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_contract_idB\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_end_dateB\x0e\n" +
+	"\f_total_unitsB\x12\n" +
+	"\x10_remaining_units\"\x80\x03\n" +
+	"\x16SystemEntitlementUsage\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12&\n" +
+	"\x0eentitlement_id\x18\x02 \x01(\tR\x0eentitlement_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x01R\x05units\x12&\n" +
+	"\x0econsumed_by_id\x18\x06 \x01(\tR\x0econsumed_by_id\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xcc\x03\n" +
+	"\x13SystemEscalationLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\trule_name\x18\x02 \x01(\tR\trule_name\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x05R\x05level\x12B\n" +
+	"\x0eescalated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eescalated_date\x12)\n" +
+	"\rerror_message\x18\a \x01(\tH\x00R\rerror_message\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x10\n" +
+	"\x0e_error_message\"\x84\x04\n" +
+	"\x14SystemEscalationRule\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12\x1f\n" +
+	"\bcriteria\x18\x05 \x01(\tH\x00R\bcriteria\x88\x01\x01\x12 \n" +
+	"\vstart_field\x18\x06 \x01(\tR\vstart_field\x12+\n" +
+	"\x0ebusiness_hours\x18\a \x01(\tH\x01R\x0ebusiness_hours\x88\x01\x01\x120\n" +
+	"\aactions\x18\b \x01(\v2\x16.google.protobuf.ValueR\aactions\x12\x1c\n" +
+	"\tis_active\x18\t \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_criteriaB\x11\n" +
+	"\x0f_business_hours\"\xbd\x04\n" +
+	"\x14SystemExternalObject\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x18\n" +
+	"\aadapter\x18\x03 \x01(\tR\aadapter\x12/\n" +
+	"\x10named_credential\x18\x04 \x01(\tH\x00R\x10named_credential\x88\x01\x01\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12'\n" +
+	"\frecords_path\x18\x06 \x01(\tH\x01R\frecords_path\x88\x01\x01\x12\x1a\n" +
+	"\bid_field\x18\a \x01(\tR\bid_field\x124\n" +
+	"\tfield_map\x18\b \x01(\v2\x16.google.protobuf.ValueR\tfield_map\x12\x15\n" +
+	"\x03dsn\x18\t \x01(\tH\x02R\x03dsn\x88\x01\x01\x12\x1a\n" +
+	"\bmax_rows\x18\n" +
+	" \x01(\x05R\bmax_rows\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x13\n" +
+	"\x11_named_credentialB\x0f\n" +
+	"\r_records_pathB\x06\n" +
+	"\x04_dsn\"\xc0\x02\n" +
+	"\x0eSystemFeedItem\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tparent_id\x18\x02 \x01(\tR\tparent_id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12.\n" +
+	"\rcreated_by_id\x18\x05 \x01(\tR\x17__sys_gen_created_by_id\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x94\x10\n" +
+	"\vSystemField\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tobject_id\x18\x02 \x01(\tR\tobject_id\x12\x1a\n" +
+	"\bapi_name\x18\x03 \x01(\tR\bapi_name\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1c\n" +
+	"\tis_unique\x18\a \x01(\bR\tis_unique\x12\x1c\n" +
+	"\tis_system\x18\b \x01(\bR\tis_system\x12$\n" +
+	"\ris_name_field\x18\t \x01(\bR\ris_name_field\x12\x18\n" +
+	"\aindexed\x18\n" +
+	" \x01(\bR\aindexed\x120\n" +
+	"\aoptions\x18\v \x01(\v2\x16.google.protobuf.ValueR\aoptions\x12:\n" +
+	"\freference_to\x18\f \x01(\v2\x16.google.protobuf.ValueR\freference_to\x12%\n" +
+	"\vdelete_rule\x18\r \x01(\tH\x00R\vdelete_rule\x88\x01\x01\x12\x1d\n" +
+	"\aformula\x18\x0e \x01(\tH\x01R\aformula\x88\x01\x01\x12%\n" +
+	"\vreturn_type\x18\x0f \x01(\tH\x02R\vreturn_type\x88\x01\x01\x12)\n" +
+	"\rdefault_value\x18\x10 \x01(\tH\x03R\rdefault_value\x88\x01\x01\x12!\n" +
+	"\thelp_text\x18\x11 \x01(\tH\x04R\thelp_text\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x12 \x01(\tH\x05R\vdescription\x88\x01\x01\x12$\n" +
+	"\rtrack_history\x18\x13 \x01(\bR\rtrack_history\x12!\n" +
+	"\tmin_value\x18\x14 \x01(\x01H\x06R\tmin_value\x88\x01\x01\x12!\n" +
+	"\tmax_value\x18\x15 \x01(\x01H\aR\tmax_value\x88\x01\x01\x12#\n" +
+	"\n" +
+	"min_length\x18\x16 \x01(\x05H\bR\n" +
+	"min_length\x88\x01\x01\x12#\n" +
+	"\n" +
+	"max_length\x18\x17 \x01(\x05H\tR\n" +
+	"max_length\x88\x01\x01\x12\x19\n" +
+	"\x05regex\x18\x18 \x01(\tH\n" +
+	"R\x05regex\x88\x01\x01\x12)\n" +
+	"\rregex_message\x18\x19 \x01(\tH\vR\rregex_message\x88\x01\x01\x12!\n" +
+	"\tvalidator\x18\x1a \x01(\tH\fR\tvalidator\x88\x01\x01\x121\n" +
+	"\x11controlling_field\x18\x1b \x01(\tH\rR\x11controlling_field\x88\x01\x01\x12H\n" +
+	"\x13picklist_dependency\x18\x1c \x01(\v2\x16.google.protobuf.ValueR\x13picklist_dependency\x12B\n" +
+	"\x10inactive_options\x18\x1d \x01(\v2\x16.google.protobuf.ValueR\x10inactive_options\x12!\n" +
+	"\tvalue_set\x18\x1e \x01(\tH\x0eR\tvalue_set\x88\x01\x01\x12)\n" +
+	"\rlookup_filter\x18\x1f \x01(\tH\x0fR\rlookup_filter\x88\x01\x01\x12<\n" +
+	"\rrollup_config\x18  \x01(\v2\x16.google.protobuf.ValueR\rrollup_config\x12*\n" +
+	"\x10is_master_detail\x18! \x01(\bR\x10is_master_detail\x12&\n" +
+	"\x0eis_polymorphic\x18\" \x01(\bR\x0eis_polymorphic\x121\n" +
+	"\x11relationship_name\x18# \x01(\tH\x10R\x11relationship_name\x88\x01\x01\x12(\n" +
+	"\n" +
+	"is_deleted\x18$ \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18% \x01(\tH\x11R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18& \x01(\tH\x12R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18' \x01(\tH\x13R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18( \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18) \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_delete_ruleB\n" +
+	"\n" +
+	"\b_formulaB\x0e\n" +
+	"\f_return_typeB\x10\n" +
+	"\x0e_default_valueB\f\n" +
+	"\n" +
+	"_help_textB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_min_valueB\f\n" +
+	"\n" +
+	"_max_valueB\r\n" +
+	"\v_min_lengthB\r\n" +
+	"\v_max_lengthB\b\n" +
+	"\x06_regexB\x10\n" +
+	"\x0e_regex_messageB\f\n" +
+	"\n" +
+	"_validatorB\x14\n" +
+	"\x12_controlling_fieldB\f\n" +
+	"\n" +
+	"_value_setB\x10\n" +
+	"\x0e_lookup_filterB\x14\n" +
+	"\x12_relationship_nameB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xa7\x03\n" +
+	"\x15SystemFieldDependency\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x122\n" +
+	"\x14controlling_field_id\x18\x02 \x01(\tR\x14controlling_field_id\x12.\n" +
+	"\x12dependent_field_id\x18\x03 \x01(\tR\x12dependent_field_id\x12,\n" +
+	"\x11controlling_value\x18\x04 \x01(\tR\x11controlling_value\x12B\n" +
+	"\x10dependent_values\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x10dependent_values\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xd3\x03\n" +
+	"\x10SystemFieldPerms\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12#\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tH\x00R\n" +
+	"profile_id\x88\x01\x01\x121\n" +
+	"\x11permission_set_id\x18\x03 \x01(\tH\x01R\x11permission_set_id\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12&\n" +
+	"\x0efield_api_name\x18\x05 \x01(\tR\x0efield_api_name\x12\x1a\n" +
+	"\breadable\x18\x06 \x01(\bR\breadable\x12\x1a\n" +
+	"\beditable\x18\a \x01(\bR\beditable\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\r\n" +
+	"\v_profile_idB\x14\n" +
+	"\x12_permission_set_id\"\x8a\x03\n" +
+	"\n" +
+	"SystemFile\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tparent_id\x18\x02 \x01(\tR\tparent_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tmime_type\x18\x04 \x01(\tR\tmime_type\x12\x1e\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\n" +
+	"size_bytes\x12\"\n" +
+	"\fstorage_path\x18\x06 \x01(\tR\fstorage_path\x12.\n" +
+	"\rcreated_by_id\x18\a \x01(\tR\x17__sys_gen_created_by_id\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xb6\b\n" +
+	"\n" +
+	"SystemFlow\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0etrigger_object\x18\x03 \x01(\tR\x0etrigger_object\x12\"\n" +
+	"\ftrigger_type\x18\x04 \x01(\tR\ftrigger_type\x12,\n" +
+	"\x11trigger_condition\x18\x05 \x01(\tR\x11trigger_condition\x12 \n" +
+	"\vaction_type\x18\x06 \x01(\tR\vaction_type\x12<\n" +
+	"\raction_config\x18\a \x01(\v2\x16.google.protobuf.ValueR\raction_config\x12\x1c\n" +
+	"\tflow_type\x18\b \x01(\tR\tflow_type\x12%\n" +
+	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x123\n" +
+	"\rcreated_by_id\x18\f \x01(\tH\x01R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\r \x01(\tH\x02R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12)\n" +
+	"\bowner_id\x18\x0e \x01(\tH\x03R\x12__sys_gen_owner_id\x88\x01\x01\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x0f \x01(\bR\x14__sys_gen_is_deleted\x12\x1f\n" +
+	"\bschedule\x18\x10 \x01(\tH\x04R\bschedule\x88\x01\x01\x121\n" +
+	"\x11schedule_timezone\x18\x11 \x01(\tH\x05R\x11schedule_timezone\x88\x01\x01\x12<\n" +
+	"\vlast_run_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vlast_run_at\x12<\n" +
+	"\vnext_run_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\vnext_run_at\x12\x1e\n" +
+	"\n" +
+	"is_running\x18\x14 \x01(\bR\n" +
+	"is_running\x12T\n" +
+	"\x12last_modified_date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_idB\v\n" +
+	"\t_owner_idB\v\n" +
+	"\t_scheduleB\x14\n" +
+	"\x12_schedule_timezone\"\x8b\a\n" +
+	"\x12SystemFlowInstance\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\aflow_id\x18\x02 \x01(\tR\aflow_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12-\n" +
+	"\x0fcurrent_step_id\x18\x06 \x01(\tH\x00R\x0fcurrent_step_id\x88\x01\x01\x12:\n" +
+	"\fcontext_data\x18\a \x01(\v2\x16.google.protobuf.ValueR\fcontext_data\x12>\n" +
+	"\fstarted_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fstarted_date\x12<\n" +
+	"\vpaused_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vpaused_date\x12B\n" +
+	"\x0ecompleted_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompleted_date\x12)\n" +
+	"\bowner_id\x18\v \x01(\tH\x01R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\f \x01(\tH\x02R\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12?\n" +
+	"\x13last_modified_by_id\x18\x0e \x01(\tH\x03R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12T\n" +
+	"\x12last_modified_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x10 \x01(\bR\x14__sys_gen_is_deletedB\x12\n" +
+	"\x10_current_step_idB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\x80\a\n" +
+	"\x0eSystemFlowStep\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\aflow_id\x18\x02 \x01(\tR\aflow_id\x12\x1e\n" +
+	"\n" +
+	"step_order\x18\x03 \x01(\x05R\n" +
+	"step_order\x12\x1c\n" +
+	"\tstep_name\x18\x04 \x01(\tR\tstep_name\x12\x1c\n" +
+	"\tstep_type\x18\x05 \x01(\tR\tstep_type\x12%\n" +
+	"\vaction_type\x18\x06 \x01(\tH\x00R\vaction_type\x88\x01\x01\x12<\n" +
+	"\raction_config\x18\a \x01(\v2\x16.google.protobuf.ValueR\raction_config\x12-\n" +
+	"\x0fentry_condition\x18\b \x01(\tH\x01R\x0fentry_condition\x88\x01\x01\x12-\n" +
+	"\x0fon_success_step\x18\t \x01(\tH\x02R\x0fon_success_step\x88\x01\x01\x12-\n" +
+	"\x0fon_failure_step\x18\n" +
+	" \x01(\tH\x03R\x0fon_failure_step\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x123\n" +
+	"\rcreated_by_id\x18\f \x01(\tH\x04R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\r \x01(\tH\x05R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12)\n" +
+	"\bowner_id\x18\x0e \x01(\tH\x06R\x12__sys_gen_owner_id\x88\x01\x01\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x0f \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_action_typeB\x12\n" +
+	"\x10_entry_conditionB\x12\n" +
+	"\x10_on_success_stepB\x12\n" +
+	"\x10_on_failure_stepB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_idB\v\n" +
+	"\t_owner_id\"\xae\x03\n" +
+	"\x18SystemForecastAdjustment\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x18\n" +
+	"\auser_id\x18\x03 \x01(\tR\auser_id\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x17\n" +
+	"\x04note\x18\a \x01(\tH\x00R\x04note\x88\x01\x01\x12&\n" +
+	"\x0eadjusted_by_id\x18\b \x01(\tR\x0eadjusted_by_id\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\a\n" +
+	"\x05_note\"\xc3\x02\n" +
+	"\x13SystemForecastQuota\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x18\n" +
+	"\auser_id\x18\x03 \x01(\tR\auser_id\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xb1\x03\n" +
+	"\x15SystemForecastSetting\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\"\n" +
+	"\famount_field\x18\x03 \x01(\tR\famount_field\x12*\n" +
+	"\x10close_date_field\x18\x04 \x01(\tR\x10close_date_field\x12 \n" +
+	"\vstage_field\x18\x05 \x01(\tR\vstage_field\x12B\n" +
+	"\x10category_mapping\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\x10category_mapping\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xc9\x03\n" +
+	"\x14SystemGlobalValueSet\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x120\n" +
+	"\aoptions\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\aoptions\x12B\n" +
+	"\x10inactive_options\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\x10inactive_options\x12 \n" +
+	"\vis_standard\x18\a \x01(\bR\vis_standard\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_description\"\xd4\x02\n" +
+	"\vSystemGroup\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x19\n" +
+	"\x05email\x18\x05 \x01(\tH\x00R\x05email\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\a \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\b\n" +
+	"\x06_email\"\xad\x02\n" +
+	"\x11SystemGroupMember\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\bgroup_id\x12\x18\n" +
+	"\auser_id\x18\x03 \x01(\tR\auser_id\x12H\n" +
+	"\fcreated_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x05 \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xd3\x02\n" +
+	"\rSystemHoliday\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12,\n" +
+	"\x11business_hours_id\x18\x02 \x01(\tR\x11business_hours_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\fholiday_date\x18\x04 \x01(\tR\fholiday_date\x12\"\n" +
+	"\fis_recurring\x18\x05 \x01(\bR\fis_recurring\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x90\x04\n" +
+	"\x16SystemHookSubscription\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x1e\n" +
+	"\n" +
+	"target_url\x18\x05 \x01(\tR\n" +
+	"target_url\x12\x1c\n" +
+	"\tis_active\x18\x06 \x01(\bR\tis_active\x12$\n" +
+	"\rfailure_count\x18\a \x01(\x05R\rfailure_count\x12J\n" +
+	"\x12last_delivery_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12last_delivery_date\x12#\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tH\x00R\n" +
+	"last_error\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\r\n" +
+	"\v_last_error\"\xcc\x05\n" +
+	"\x11SystemInboundHook\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12%\n" +
+	"\vmatch_field\x18\x06 \x01(\tH\x00R\vmatch_field\x88\x01\x01\x12'\n" +
+	"\frecords_path\x18\a \x01(\tH\x01R\frecords_path\x88\x01\x01\x12<\n" +
+	"\rfield_mapping\x18\b \x01(\v2\x16.google.protobuf.ValueR\rfield_mapping\x12\x16\n" +
+	"\x06secret\x18\t \x01(\tR\x06secret\x12&\n" +
+	"\x0erun_as_user_id\x18\n" +
+	" \x01(\tR\x0erun_as_user_id\x12\x1c\n" +
+	"\tis_active\x18\v \x01(\bR\tis_active\x12J\n" +
+	"\x12last_received_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x12last_received_date\x12#\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tH\x02R\n" +
+	"last_error\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_match_fieldB\x0f\n" +
+	"\r_records_pathB\r\n" +
+	"\v_last_error\"\xd2\x05\n" +
+	"\x16SystemKnowledgeArticle\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\burl_name\x18\x02 \x01(\tR\burl_name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\asummary\x18\x04 \x01(\tH\x00R\asummary\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\x05 \x01(\tH\x01R\x04body\x88\x01\x01\x12%\n" +
+	"\vcategory_id\x18\x06 \x01(\tH\x02R\vcategory_id\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12&\n" +
+	"\x0eversion_number\x18\b \x01(\x05R\x0eversion_number\x12?\n" +
+	"\x18published_version_number\x18\t \x01(\x05H\x03R\x18published_version_number\x88\x01\x01\x12B\n" +
+	"\x0epublished_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0epublished_date\x12@\n" +
+	"\rarchived_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rarchived_date\x12$\n" +
+	"\bowner_id\x18\f \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\n" +
+	"\n" +
+	"\b_summaryB\a\n" +
+	"\x05_bodyB\x0e\n" +
+	"\f_category_idB\x1b\n" +
+	"\x19_published_version_number\"\x8a\x03\n" +
+	"\x1aSystemKnowledgeArticleLink\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\tR\n" +
+	"article_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12&\n" +
+	"\x0eversion_number\x18\x05 \x01(\x05R\x0eversion_number\x12\"\n" +
+	"\flinked_by_id\x18\x06 \x01(\tR\flinked_by_id\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xa9\x04\n" +
+	"\x1dSystemKnowledgeArticleVersion\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\tR\n" +
+	"article_id\x12&\n" +
+	"\x0eversion_number\x18\x03 \x01(\x05R\x0eversion_number\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1d\n" +
+	"\asummary\x18\x05 \x01(\tH\x00R\asummary\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\x06 \x01(\tH\x01R\x04body\x88\x01\x01\x12%\n" +
+	"\vcategory_id\x18\a \x01(\tH\x02R\vcategory_id\x88\x01\x01\x12(\n" +
+	"\x0fpublished_by_id\x18\b \x01(\tR\x0fpublished_by_id\x12B\n" +
+	"\x0epublished_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0epublished_date\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\n" +
+	"\n" +
+	"\b_summaryB\a\n" +
+	"\x05_bodyB\x0e\n" +
+	"\f_category_id\"\xef\x02\n" +
+	"\x17SystemKnowledgeCategory\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\tparent_id\x18\x03 \x01(\tH\x00R\tparent_id\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\n" +
+	"sort_order\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\f\n" +
+	"\n" +
+	"_parent_idB\x0e\n" +
+	"\f_description\"\xa2\x02\n" +
+	"\fSystemLayout\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12.\n" +
+	"\x06config\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x06config\x12H\n" +
+	"\fcreated_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xe7\x05\n" +
+	"\x0eSystemListView\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12%\n" +
+	"\vfilter_expr\x18\x04 \x01(\tH\x00R\vfilter_expr\x88\x01\x01\x12.\n" +
+	"\x06fields\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x06fields\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\x12\x1f\n" +
+	"\bowner_id\x18\a \x01(\tH\x01R\bowner_id\x88\x01\x01\x128\n" +
+	"\vprofile_ids\x18\b \x01(\v2\x16.google.protobuf.ValueR\vprofile_ids\x12@\n" +
+	"\x0fcolumn_settings\x18\t \x01(\v2\x16.google.protobuf.ValueR\x0fcolumn_settings\x12#\n" +
+	"\n" +
+	"sort_field\x18\n" +
+	" \x01(\tH\x02R\n" +
+	"sort_field\x88\x01\x01\x12+\n" +
+	"\x0esort_direction\x18\v \x01(\tH\x03R\x0esort_direction\x88\x01\x01\x126\n" +
+	"\n" +
+	"aggregates\x18\f \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"aggregates\x12H\n" +
+	"\fcreated_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_filter_exprB\v\n" +
+	"\t_owner_idB\r\n" +
+	"\v_sort_fieldB\x11\n" +
+	"\x0f_sort_direction\"\xf2\x02\n" +
+	"\tSystemLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1d\n" +
+	"\adetails\x18\x06 \x01(\tH\x00R\adetails\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\n" +
+	"\n" +
+	"\b_details\"\x8e\x04\n" +
+	"\x15SystemNamedCredential\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1a\n" +
+	"\bbase_url\x18\x04 \x01(\tR\bbase_url\x12\x1c\n" +
+	"\tauth_type\x18\x05 \x01(\tR\tauth_type\x12\x1f\n" +
+	"\busername\x18\x06 \x01(\tH\x00R\busername\x88\x01\x01\x12%\n" +
+	"\vauth_header\x18\a \x01(\tH\x01R\vauth_header\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\b \x01(\tH\x02R\x06secret\x88\x01\x01\x12(\n" +
+	"\x0ftimeout_seconds\x18\t \x01(\x05R\x0ftimeout_seconds\x12 \n" +
+	"\vmax_retries\x18\n" +
+	" \x01(\x05R\vmax_retries\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_usernameB\x0e\n" +
+	"\f_auth_headerB\t\n" +
+	"\a_secret\"\xf8\x02\n" +
+	"\x12SystemNotification\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\"\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\frecipient_id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x12\n" +
+	"\x04link\x18\x05 \x01(\tR\x04link\x12\x18\n" +
+	"\ais_read\x18\x06 \x01(\bR\ais_read\x12,\n" +
+	"\x11notification_type\x18\a \x01(\tR\x11notification_type\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xab\a\n" +
+	"\fSystemObject\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\bapi_name\x18\x02 \x01(\tR\bapi_name\x12\x1e\n" +
+	"\n" +
+	"table_type\x18\x03 \x01(\tR\n" +
+	"table_type\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12'\n" +
+	"\fplural_label\x18\x05 \x01(\tH\x00R\fplural_label\x88\x01\x01\x12\x1b\n" +
+	"\x06app_id\x18\x06 \x01(\tH\x01R\x06app_id\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\a \x01(\tH\x02R\x04icon\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12%\n" +
+	"\vtheme_color\x18\t \x01(\tH\x03R\vtheme_color\x88\x01\x01\x12\x1c\n" +
+	"\tis_custom\x18\n" +
+	" \x01(\bR\tis_custom\x12$\n" +
+	"\rsharing_model\x18\v \x01(\tR\rsharing_model\x12#\n" +
+	"\n" +
+	"path_field\x18\f \x01(\tH\x04R\n" +
+	"path_field\x88\x01\x01\x128\n" +
+	"\vlist_fields\x18\r \x01(\v2\x16.google.protobuf.ValueR\vlist_fields\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x0e \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18\x0f \x01(\tH\x05R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\x10 \x01(\tH\x06R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\x11 \x01(\tH\aR\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0f\n" +
+	"\r_plural_labelB\t\n" +
+	"\a_app_idB\a\n" +
+	"\x05_iconB\x0e\n" +
+	"\f_theme_colorB\r\n" +
+	"\v_path_fieldB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xb8\x04\n" +
+	"\x11SystemObjectPerms\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12#\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tH\x00R\n" +
+	"profile_id\x88\x01\x01\x121\n" +
+	"\x11permission_set_id\x18\x03 \x01(\tH\x01R\x11permission_set_id\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12\x1e\n" +
+	"\n" +
+	"allow_read\x18\x05 \x01(\bR\n" +
+	"allow_read\x12\"\n" +
+	"\fallow_create\x18\x06 \x01(\bR\fallow_create\x12\x1e\n" +
+	"\n" +
+	"allow_edit\x18\a \x01(\bR\n" +
+	"allow_edit\x12\"\n" +
+	"\fallow_delete\x18\b \x01(\bR\fallow_delete\x12\x1a\n" +
+	"\bview_all\x18\t \x01(\bR\bview_all\x12\x1e\n" +
+	"\n" +
+	"modify_all\x18\n" +
+	" \x01(\bR\n" +
+	"modify_all\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\r\n" +
+	"\v_profile_idB\x14\n" +
+	"\x12_permission_set_id\"\xd5\x05\n" +
+	"\vSystemOrder\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12?\n" +
+	"\x18customer_object_api_name\x18\x04 \x01(\tH\x00R\x18customer_object_api_name\x88\x01\x01\x12%\n" +
+	"\vcustomer_id\x18\x05 \x01(\tH\x01R\vcustomer_id\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\"\n" +
+	"\ftotal_amount\x18\a \x01(\x01R\ftotal_amount\x12B\n" +
+	"\x0eactivated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0eactivated_date\x12B\n" +
+	"\x0efulfilled_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0efulfilled_date\x12B\n" +
+	"\x0ecancelled_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0ecancelled_date\x12$\n" +
+	"\bowner_id\x18\v \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x1b\n" +
+	"\x19_customer_object_api_nameB\x0e\n" +
+	"\f_customer_idB\x0e\n" +
+	"\f_description\"\xf3\x03\n" +
+	"\x0fSystemOrderItem\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\border_id\x18\x02 \x01(\tR\border_id\x12\x1e\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\n" +
+	"product_id\x12'\n" +
+	"\fproduct_name\x18\x04 \x01(\tH\x00R\fproduct_name\x88\x01\x01\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x122\n" +
+	"\x14backordered_quantity\x18\x06 \x01(\x01R\x14backordered_quantity\x12\x1e\n" +
+	"\n" +
+	"unit_price\x18\a \x01(\x01R\n" +
+	"unit_price\x12 \n" +
+	"\vtotal_price\x18\b \x01(\x01R\vtotal_price\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\x05R\n" +
+	"sort_order\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0f\n" +
+	"\r_product_name\"\x9b\x04\n" +
+	"\x12SystemOrganization\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12+\n" +
+	"\x0edefault_locale\x18\x03 \x01(\tH\x01R\x0edefault_locale\x88\x01\x01\x12\"\n" +
+	"\fsetup_status\x18\x04 \x01(\tR\fsetup_status\x12 \n" +
+	"\vsample_data\x18\x05 \x01(\bR\vsample_data\x129\n" +
+	"\x15setup_completed_by_id\x18\x06 \x01(\tH\x02R\x15setup_completed_by_id\x88\x01\x01\x12N\n" +
+	"\x14setup_completed_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x14setup_completed_date\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\a\n" +
+	"\x05_nameB\x11\n" +
+	"\x0f_default_localeB\x18\n" +
+	"\x16_setup_completed_by_id\"\xda\x03\n" +
+	"\x11SystemOutboxEvent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\n" +
+	"event_type\x120\n" +
+	"\apayload\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\apayload\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12 \n" +
+	"\vretry_count\x18\x05 \x01(\x05R\vretry_count\x12)\n" +
+	"\rerror_message\x18\x06 \x01(\tH\x00R\rerror_message\x88\x01\x01\x12B\n" +
+	"\x0eprocessed_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0eprocessed_date\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x10\n" +
+	"\x0e_error_message\"\xb5\x03\n" +
+	"\x13SystemPermissionSet\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tis_active\x18\x05 \x01(\bR\tis_active\x12\"\n" +
+	"\fbypass_flows\x18\x06 \x01(\bR\fbypass_flows\x12,\n" +
+	"\x11bypass_validation\x18\a \x01(\bR\x11bypass_validation\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xd3\x02\n" +
+	"\x1dSystemPermissionSetAssignment\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12 \n" +
+	"\vassignee_id\x18\x02 \x01(\tR\vassignee_id\x12,\n" +
+	"\x11permission_set_id\x18\x03 \x01(\tR\x11permission_set_id\x12H\n" +
+	"\fcreated_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x05 \x01(\bR\x14__sys_gen_is_deleted\x12T\n" +
+	"\x12last_modified_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x96\x03\n" +
+	"\x12SystemPortalObject\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12)\n" +
+	"\rcontact_field\x18\x03 \x01(\tH\x00R\rcontact_field\x88\x01\x01\x12)\n" +
+	"\raccount_field\x18\x04 \x01(\tH\x01R\raccount_field\x88\x01\x01\x12\"\n" +
+	"\fallow_create\x18\x05 \x01(\bR\fallow_create\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x10\n" +
+	"\x0e_contact_fieldB\x10\n" +
+	"\x0e_account_field\"\x8f\x05\n" +
+	"\rSystemProfile\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tis_active\x18\x04 \x01(\bR\tis_active\x12\x1c\n" +
+	"\tis_system\x18\x05 \x01(\bR\tis_system\x12\"\n" +
+	"\fbypass_flows\x18\x06 \x01(\bR\fbypass_flows\x12,\n" +
+	"\x11bypass_validation\x18\a \x01(\bR\x11bypass_validation\x12(\n" +
+	"\n" +
+	"is_deleted\x18\b \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18\t \x01(\tH\x00R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\n" +
+	" \x01(\tH\x01R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\v \x01(\tH\x02R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xb7\x02\n" +
+	"\x13SystemProfileLayout\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tR\n" +
+	"profile_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\tlayout_id\x18\x04 \x01(\tR\tlayout_id\x12H\n" +
+	"\fcreated_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x96\x03\n" +
+	"\x17SystemProfileRecordType\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tR\n" +
+	"profile_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12&\n" +
+	"\x0erecord_type_id\x18\x04 \x01(\tR\x0erecord_type_id\x12\x1e\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\n" +
+	"is_default\x12!\n" +
+	"\tlayout_id\x18\x06 \x01(\tH\x00R\tlayout_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\f\n" +
+	"\n" +
+	"_layout_id\"\xbb\x03\n" +
+	"\x13SystemQueryGovernor\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tR\n" +
+	"profile_id\x12\x1f\n" +
+	"\bmax_rows\x18\x03 \x01(\x05H\x00R\bmax_rows\x88\x01\x01\x12/\n" +
+	"\x10max_execution_ms\x18\x04 \x01(\x05H\x01R\x10max_execution_ms\x88\x01\x01\x12;\n" +
+	"\x16max_concurrent_queries\x18\x05 \x01(\x05H\x02R\x16max_concurrent_queries\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_max_rowsB\x13\n" +
+	"\x11_max_execution_msB\x19\n" +
+	"\x17_max_concurrent_queries\"\x86\x03\n" +
+	"\fSystemRecent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12 \n" +
+	"\vrecord_name\x18\x05 \x01(\tR\vrecord_name\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xb6\x05\n" +
+	"\x11SystemRecordShare\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x123\n" +
+	"\x12share_with_user_id\x18\x04 \x01(\tH\x00R\x12share_with_user_id\x88\x01\x01\x125\n" +
+	"\x13share_with_group_id\x18\x05 \x01(\tH\x01R\x13share_with_group_id\x88\x01\x01\x12\"\n" +
+	"\faccess_level\x18\x06 \x01(\tR\faccess_level\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x123\n" +
+	"\rcreated_by_id\x18\b \x01(\tH\x02R\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12?\n" +
+	"\x13last_modified_by_id\x18\n" +
+	" \x01(\tH\x03R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\f \x01(\bR\x14__sys_gen_is_deletedB\x15\n" +
+	"\x13_share_with_user_idB\x16\n" +
+	"\x14_share_with_group_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xe8\x03\n" +
+	"\x10SystemRecordType\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tobject_id\x18\x02 \x01(\tR\tobject_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tis_active\x18\x05 \x01(\bR\tis_active\x12\x1c\n" +
+	"\tis_master\x18\x06 \x01(\bR\tis_master\x12\x1e\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bR\n" +
+	"is_default\x12@\n" +
+	"\x0fpicklist_values\x18\b \x01(\v2\x16.google.protobuf.ValueR\x0fpicklist_values\x12(\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\x14__sys_gen_is_deleted\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xf1\x02\n" +
+	"\x15SystemRecordEmbedding\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12\x1c\n" +
+	"\tembedding\x18\x04 \x01(\tR\tembedding\x12\"\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\fcontent_hash\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x96\x03\n" +
+	"\x10SystemRecycleBin\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\trecord_id\x18\x02 \x01(\tR\trecord_id\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12 \n" +
+	"\vrecord_name\x18\x04 \x01(\tR\vrecord_name\x12\x1e\n" +
+	"\n" +
+	"deleted_by\x18\x05 \x01(\tR\n" +
+	"deleted_by\x12>\n" +
+	"\fdeleted_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fdeleted_date\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xf8\x04\n" +
+	"\x12SystemRelationship\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x124\n" +
+	"\x15child_object_api_name\x18\x02 \x01(\tR\x15child_object_api_name\x126\n" +
+	"\x16parent_object_api_name\x18\x03 \x01(\tR\x16parent_object_api_name\x12&\n" +
+	"\x0efield_api_name\x18\x04 \x01(\tR\x0efield_api_name\x12,\n" +
+	"\x11relationship_name\x18\x05 \x01(\tR\x11relationship_name\x12,\n" +
+	"\x11relationship_type\x18\x06 \x01(\tR\x11relationship_type\x12&\n" +
+	"\x0ecascade_delete\x18\a \x01(\bR\x0ecascade_delete\x12,\n" +
+	"\x11restricted_delete\x18\b \x01(\bR\x11restricted_delete\x12.\n" +
+	"\x12related_list_label\x18\t \x01(\tR\x12related_list_label\x120\n" +
+	"\x13related_list_fields\x18\n" +
+	" \x01(\tR\x13related_list_fields\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xc3\x06\n" +
+	"\fSystemReport\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\x120\n" +
+	"\acolumns\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\acolumns\x12%\n" +
+	"\vfilter_expr\x18\a \x01(\tH\x01R\vfilter_expr\x88\x01\x01\x124\n" +
+	"\tgroupings\x18\b \x01(\v2\x16.google.protobuf.ValueR\tgroupings\x12B\n" +
+	"\x10column_groupings\x18\t \x01(\v2\x16.google.protobuf.ValueR\x10column_groupings\x126\n" +
+	"\n" +
+	"aggregates\x18\n" +
+	" \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"aggregates\x12#\n" +
+	"\n" +
+	"sort_field\x18\v \x01(\tH\x02R\n" +
+	"sort_field\x88\x01\x01\x12+\n" +
+	"\x0esort_direction\x18\f \x01(\tH\x03R\x0esort_direction\x88\x01\x01\x12!\n" +
+	"\trow_limit\x18\r \x01(\x05H\x04R\trow_limit\x88\x01\x01\x12\x1f\n" +
+	"\bowner_id\x18\x0e \x01(\tH\x05R\bowner_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_filter_exprB\r\n" +
+	"\v_sort_fieldB\x11\n" +
+	"\x0f_sort_directionB\f\n" +
+	"\n" +
+	"_row_limitB\v\n" +
+	"\t_owner_id\"\xdc\x04\n" +
+	"\n" +
+	"SystemRole\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x0eparent_role_id\x18\x04 \x01(\tH\x00R\x0eparent_role_id\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\x05 \x01(\bR\tis_active\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x06 \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18\a \x01(\tH\x01R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\b \x01(\tH\x02R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\t \x01(\tH\x03R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x11\n" +
+	"\x0f_parent_role_idB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\x9d\x05\n" +
+	"\x0fSystemSLAPolicy\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12+\n" +
+	"\x0epriority_field\x18\x05 \x01(\tH\x00R\x0epriority_field\x88\x01\x01\x12\"\n" +
+	"\fstatus_field\x18\x06 \x01(\tR\fstatus_field\x12@\n" +
+	"\x0fpaused_statuses\x18\a \x01(\v2\x16.google.protobuf.ValueR\x0fpaused_statuses\x12@\n" +
+	"\x0fclosed_statuses\x18\b \x01(\v2\x16.google.protobuf.ValueR\x0fclosed_statuses\x12+\n" +
+	"\x0ebusiness_hours\x18\t \x01(\tH\x01R\x0ebusiness_hours\x88\x01\x01\x126\n" +
+	"\n" +
+	"milestones\x18\n" +
+	" \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"milestones\x12\x1c\n" +
+	"\tis_active\x18\v \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x11\n" +
+	"\x0f_priority_fieldB\x11\n" +
+	"\x0f_business_hours\"\x9a\x06\n" +
+	"\x0eSystemSLATimer\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12 \n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\vpolicy_name\x12(\n" +
+	"\x0fobject_api_name\x18\x03 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x04 \x01(\tR\trecord_id\x12\x1c\n" +
+	"\tmilestone\x18\x05 \x01(\tR\tmilestone\x12&\n" +
+	"\x0etarget_minutes\x18\x06 \x01(\x05R\x0etarget_minutes\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12(\n" +
+	"\x0felapsed_seconds\x18\b \x01(\x03R\x0felapsed_seconds\x12@\n" +
+	"\rrunning_since\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rrunning_since\x126\n" +
+	"\bdue_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bdue_date\x12>\n" +
+	"\fstarted_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fstarted_date\x12B\n" +
+	"\x0ecompleted_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompleted_date\x12\x1a\n" +
+	"\bviolated\x18\r \x01(\bR\bviolated\x12B\n" +
+	"\x0eescalated_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0eescalated_date\x12H\n" +
+	"\fcreated_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x8d\x03\n" +
+	"\x11SystemSavedSearch\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\x12:\n" +
+	"\fobject_scope\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\fobject_scope\x12@\n" +
+	"\rlast_run_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlast_run_date\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xd7\x06\n" +
+	"\fSystemScript\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12+\n" +
+	"\x0etrigger_object\x18\x05 \x01(\tH\x01R\x0etrigger_object\x88\x01\x01\x12+\n" +
+	"\x0etrigger_events\x18\x06 \x01(\tH\x02R\x0etrigger_events\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\a \x01(\bR\tis_active\x12!\n" +
+	"\tmax_steps\x18\b \x01(\x03H\x03R\tmax_steps\x88\x01\x01\x12#\n" +
+	"\n" +
+	"timeout_ms\x18\t \x01(\x05H\x04R\n" +
+	"timeout_ms\x88\x01\x01\x12)\n" +
+	"\rmax_memory_mb\x18\n" +
+	" \x01(\x05H\x05R\rmax_memory_mb\x88\x01\x01\x12'\n" +
+	"\fmax_dml_rows\x18\v \x01(\x05H\x06R\fmax_dml_rows\x88\x01\x01\x12+\n" +
+	"\x0emax_query_rows\x18\f \x01(\x05H\aR\x0emax_query_rows\x88\x01\x01\x12'\n" +
+	"\fmax_callouts\x18\r \x01(\x05H\bR\fmax_callouts\x88\x01\x01\x12)\n" +
+	"\bowner_id\x18\x0e \x01(\tH\tR\x12__sys_gen_owner_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_trigger_objectB\x11\n" +
+	"\x0f_trigger_eventsB\f\n" +
+	"\n" +
+	"_max_stepsB\r\n" +
+	"\v_timeout_msB\x10\n" +
+	"\x0e_max_memory_mbB\x0f\n" +
+	"\r_max_dml_rowsB\x11\n" +
+	"\x0f_max_query_rowsB\x0f\n" +
+	"\r_max_calloutsB\v\n" +
+	"\t_owner_id\"\xd7\x05\n" +
+	"\x0fSystemScriptLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tscript_id\x18\x02 \x01(\tR\tscript_id\x12 \n" +
+	"\vscript_name\x18\x03 \x01(\tR\vscript_name\x12\x1e\n" +
+	"\n" +
+	"invocation\x18\x04 \x01(\tR\n" +
+	"invocation\x12-\n" +
+	"\x0fobject_api_name\x18\x05 \x01(\tH\x00R\x0fobject_api_name\x88\x01\x01\x12!\n" +
+	"\trecord_id\x18\x06 \x01(\tH\x01R\trecord_id\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12 \n" +
+	"\vduration_ms\x18\b \x01(\x05R\vduration_ms\x12\x14\n" +
+	"\x05steps\x18\t \x01(\x03R\x05steps\x12\x1a\n" +
+	"\bdml_rows\x18\n" +
+	" \x01(\x05R\bdml_rows\x12\x1e\n" +
+	"\n" +
+	"query_rows\x18\v \x01(\x05R\n" +
+	"query_rows\x12\x1a\n" +
+	"\bcallouts\x18\f \x01(\x05R\bcallouts\x12\x1b\n" +
+	"\x06output\x18\r \x01(\tH\x02R\x06output\x88\x01\x01\x12)\n" +
+	"\rerror_message\x18\x0e \x01(\tH\x03R\rerror_message\x88\x01\x01\x12\x1d\n" +
+	"\auser_id\x18\x0f \x01(\tH\x04R\auser_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x12\n" +
+	"\x10_object_api_nameB\f\n" +
+	"\n" +
+	"_record_idB\t\n" +
+	"\a_outputB\x10\n" +
+	"\x0e_error_messageB\n" +
+	"\n" +
+	"\b_user_id\"\xd7\x03\n" +
+	"\rSystemSession\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12:\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12@\n" +
+	"\rlast_activity\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlast_activity\x12\x1e\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tR\n" +
+	"ip_address\x12\x1e\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\n" +
+	"user_agent\x12\x1e\n" +
+	"\n" +
+	"is_revoked\x18\b \x01(\bR\n" +
+	"is_revoked\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\x87\x05\n" +
+	"\x10SystemSetupAudit\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12&\n" +
+	"\x0ecomponent_type\x18\x03 \x01(\tR\x0ecomponent_type\x12&\n" +
+	"\x0ecomponent_name\x18\x04 \x01(\tR\x0ecomponent_name\x12-\n" +
+	"\x0fobject_api_name\x18\x05 \x01(\tH\x00R\x0fobject_api_name\x88\x01\x01\x128\n" +
+	"\vbefore_data\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\vbefore_data\x126\n" +
+	"\n" +
+	"after_data\x18\a \x01(\v2\x16.google.protobuf.ValueR\n" +
+	"after_data\x12\x1f\n" +
+	"\bactor_id\x18\b \x01(\tH\x01R\bactor_id\x88\x01\x01\x12#\n" +
+	"\n" +
+	"actor_name\x18\t \x01(\tH\x02R\n" +
+	"actor_name\x88\x01\x01\x12:\n" +
+	"\n" +
+	"changed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changed_at\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x12\n" +
+	"\x10_object_api_nameB\v\n" +
+	"\t_actor_idB\r\n" +
+	"\v_actor_name\"\xaf\x04\n" +
+	"\x0fSystemSetupPage\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x17\n" +
+	"\x04icon\x18\x03 \x01(\tH\x00R\x04icon\x88\x01\x01\x12&\n" +
+	"\x0ecomponent_name\x18\x04 \x01(\tR\x0ecomponent_name\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"page_order\x18\x06 \x01(\x05R\n" +
+	"page_order\x125\n" +
+	"\x13permission_required\x18\a \x01(\tH\x01R\x13permission_required\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"is_enabled\x18\b \x01(\bR\n" +
+	"is_enabled\x12%\n" +
+	"\vdescription\x18\t \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\x04path\x18\n" +
+	" \x01(\tH\x03R\x04path\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\a\n" +
+	"\x05_iconB\x16\n" +
+	"\x14_permission_requiredB\x0e\n" +
+	"\f_descriptionB\a\n" +
+	"\x05_path\"\xe8\x05\n" +
+	"\x11SystemSharingRule\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcriteria\x18\x04 \x01(\tR\bcriteria\x12\"\n" +
+	"\faccess_level\x18\x05 \x01(\tR\faccess_level\x123\n" +
+	"\x12share_with_role_id\x18\x06 \x01(\tH\x00R\x12share_with_role_id\x88\x01\x01\x125\n" +
+	"\x13share_with_group_id\x18\a \x01(\tH\x01R\x13share_with_group_id\x88\x01\x01\x12(\n" +
+	"\n" +
+	"is_deleted\x18\b \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18\t \x01(\tH\x02R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\n" +
+	" \x01(\tH\x03R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\v \x01(\tH\x04R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x15\n" +
+	"\x13_share_with_role_idB\x16\n" +
+	"\x14_share_with_group_idB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xbf\x04\n" +
+	"\x12SystemStageHistory\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12&\n" +
+	"\x0efield_api_name\x18\x04 \x01(\tR\x0efield_api_name\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12+\n" +
+	"\x0eprevious_value\x18\x06 \x01(\tH\x00R\x0eprevious_value\x88\x01\x01\x12>\n" +
+	"\fentered_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fentered_date\x12<\n" +
+	"\vexited_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vexited_date\x12/\n" +
+	"\x10duration_seconds\x18\t \x01(\x03H\x01R\x10duration_seconds\x88\x01\x01\x12)\n" +
+	"\rchanged_by_id\x18\n" +
+	" \x01(\tH\x02R\rchanged_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_dateB\x11\n" +
+	"\x0f_previous_valueB\x13\n" +
+	"\x11_duration_secondsB\x10\n" +
+	"\x0e_changed_by_id\"\xb3\x04\n" +
+	"\x12SystemStateMachine\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tR\x0fobject_api_name\x12&\n" +
+	"\x0efield_api_name\x18\x05 \x01(\tR\x0efield_api_name\x12>\n" +
+	"\x0einitial_states\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\x0einitial_states\x12.\n" +
+	"\x06states\x18\a \x01(\v2\x16.google.protobuf.ValueR\x06states\x128\n" +
+	"\vtransitions\x18\b \x01(\v2\x16.google.protobuf.ValueR\vtransitions\x12\x1c\n" +
+	"\tis_active\x18\t \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_description\"\xc9\x03\n" +
+	"\fSystemSurvey\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"score_type\x18\x04 \x01(\tR\n" +
+	"score_type\x12\x1a\n" +
+	"\bquestion\x18\x05 \x01(\tR\bquestion\x124\n" +
+	"\tquestions\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\tquestions\x12\x1c\n" +
+	"\tis_active\x18\a \x01(\bR\tis_active\x12$\n" +
+	"\bowner_id\x18\b \x01(\tR\x12__sys_gen_owner_id\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x0e\n" +
+	"\f_description\"\xe2\x04\n" +
+	"\x16SystemSurveyInvitation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tsurvey_id\x18\x02 \x01(\tR\tsurvey_id\x12\x1e\n" +
+	"\n" +
+	"token_hash\x18\x03 \x01(\tR\n" +
+	"token_hash\x12-\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tH\x00R\x0fobject_api_name\x88\x01\x01\x12!\n" +
+	"\trecord_id\x18\x05 \x01(\tH\x01R\trecord_id\x88\x01\x01\x12#\n" +
+	"\n" +
+	"contact_id\x18\x06 \x01(\tH\x02R\n" +
+	"contact_id\x88\x01\x01\x12>\n" +
+	"\fexpires_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fexpires_date\x12B\n" +
+	"\x0eresponded_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0eresponded_date\x12$\n" +
+	"\rcreated_by_id\x18\t \x01(\tR\rcreated_by_id\x12H\n" +
+	"\fcreated_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x12\n" +
+	"\x10_object_api_nameB\f\n" +
+	"\n" +
+	"_record_idB\r\n" +
+	"\v_contact_id\"\xf3\x04\n" +
+	"\x14SystemSurveyResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1c\n" +
+	"\tsurvey_id\x18\x02 \x01(\tR\tsurvey_id\x12$\n" +
+	"\rinvitation_id\x18\x03 \x01(\tR\rinvitation_id\x12-\n" +
+	"\x0fobject_api_name\x18\x04 \x01(\tH\x00R\x0fobject_api_name\x88\x01\x01\x12!\n" +
+	"\trecord_id\x18\x05 \x01(\tH\x01R\trecord_id\x88\x01\x01\x12#\n" +
+	"\n" +
+	"contact_id\x18\x06 \x01(\tH\x02R\n" +
+	"contact_id\x88\x01\x01\x12\x14\n" +
+	"\x05score\x18\a \x01(\x05R\x05score\x120\n" +
+	"\aanswers\x18\b \x01(\v2\x16.google.protobuf.ValueR\aanswers\x12\x1d\n" +
+	"\acomment\x18\t \x01(\tH\x03R\acomment\x88\x01\x01\x12B\n" +
+	"\x0esubmitted_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0esubmitted_date\x12H\n" +
+	"\fcreated_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x12\n" +
+	"\x10_object_api_nameB\f\n" +
+	"\n" +
+	"_record_idB\r\n" +
+	"\v_contact_idB\n" +
+	"\n" +
+	"\b_comment\"\xce\x06\n" +
+	"\x13SystemSyncConnector\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x18\n" +
+	"\auser_id\x18\x02 \x01(\tR\auser_id\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12$\n" +
+	"\raccount_email\x18\x04 \x01(\tR\raccount_email\x12\"\n" +
+	"\faccess_token\x18\x05 \x01(\tR\faccess_token\x12)\n" +
+	"\rrefresh_token\x18\x06 \x01(\tH\x00R\rrefresh_token\x88\x01\x01\x12F\n" +
+	"\x10token_expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10token_expires_at\x12\x1e\n" +
+	"\n" +
+	"sync_email\x18\b \x01(\bR\n" +
+	"sync_email\x12$\n" +
+	"\rsync_calendar\x18\t \x01(\bR\rsync_calendar\x12\x1c\n" +
+	"\tis_active\x18\n" +
+	" \x01(\bR\tis_active\x12J\n" +
+	"\x12email_synced_until\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x12email_synced_until\x12P\n" +
+	"\x15calendar_synced_until\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x15calendar_synced_until\x12B\n" +
+	"\x0elast_sync_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0elast_sync_date\x12#\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tH\x01R\n" +
+	"last_error\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x10\n" +
+	"\x0e_refresh_tokenB\r\n" +
+	"\v_last_error\"\xd8\x01\n" +
+	"\x0fSystemSystemLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1d\n" +
+	"\adetails\x18\x06 \x01(\tH\x00R\adetails\x88\x01\x01B\n" +
+	"\n" +
+	"\b_details\"\xad\x03\n" +
+	"\vSystemTable\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1e\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\n" +
+	"table_name\x12\x1e\n" +
+	"\n" +
+	"table_type\x18\x03 \x01(\tR\n" +
+	"table_type\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"is_managed\x18\x06 \x01(\bR\n" +
+	"is_managed\x12&\n" +
+	"\x0eschema_version\x18\a \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\n" +
+	"created_by\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xba\x04\n" +
+	"\x10SystemTeamMember\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x1c\n" +
+	"\trecord_id\x18\x03 \x01(\tR\trecord_id\x12\x18\n" +
+	"\auser_id\x18\x04 \x01(\tR\auser_id\x12\x1c\n" +
+	"\tteam_role\x18\x05 \x01(\tR\tteam_role\x12\"\n" +
+	"\faccess_level\x18\x06 \x01(\tR\faccess_level\x123\n" +
+	"\rcreated_by_id\x18\a \x01(\tH\x00R\x17__sys_gen_created_by_id\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12?\n" +
+	"\x13last_modified_by_id\x18\t \x01(\tH\x01R\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\x12(\n" +
+	"\n" +
+	"is_deleted\x18\v \x01(\bR\x14__sys_gen_is_deletedB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_id\"\xf1\x02\n" +
+	"\vSystemTheme\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tis_active\x18\x03 \x01(\bR\tis_active\x12.\n" +
+	"\x06colors\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\x06colors\x12\x18\n" +
+	"\adensity\x18\x05 \x01(\tR\adensity\x12\x1f\n" +
+	"\blogo_url\x18\x06 \x01(\tH\x00R\blogo_url\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\v\n" +
+	"\t_logo_url\"\xd5\x02\n" +
+	"\x11SystemTranslation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12&\n" +
+	"\x0ecomponent_type\x18\x03 \x01(\tR\x0ecomponent_type\x12$\n" +
+	"\rcomponent_key\x18\x04 \x01(\tR\rcomponent_key\x12 \n" +
+	"\vtranslation\x18\x05 \x01(\tR\vtranslation\x12H\n" +
+	"\fcreated_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xfd\x02\n" +
+	"\x11SystemUIComponent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12$\n" +
+	"\ris_embeddable\x18\x04 \x01(\bR\ris_embeddable\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12+\n" +
+	"\x0ecomponent_path\x18\x06 \x01(\tH\x00R\x0ecomponent_path\x88\x01\x01\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\x11\n" +
+	"\x0f_component_path\"\xc0\a\n" +
+	"\n" +
+	"SystemUser\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1e\n" +
+	"\n" +
+	"first_name\x18\x05 \x01(\tR\n" +
+	"first_name\x12\x1c\n" +
+	"\tlast_name\x18\x06 \x01(\tR\tlast_name\x12\x19\n" +
+	"\x05phone\x18\a \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"profile_id\x18\b \x01(\tR\n" +
+	"profile_id\x12\x1d\n" +
+	"\arole_id\x18\t \x01(\tH\x01R\arole_id\x88\x01\x01\x12\x1c\n" +
+	"\tuser_type\x18\n" +
+	" \x01(\tR\tuser_type\x12#\n" +
+	"\n" +
+	"contact_id\x18\v \x01(\tH\x02R\n" +
+	"contact_id\x88\x01\x01\x12#\n" +
+	"\n" +
+	"account_id\x18\f \x01(\tH\x03R\n" +
+	"account_id\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\r \x01(\tH\x04R\x06locale\x88\x01\x01\x12\x1c\n" +
+	"\tis_active\x18\x0e \x01(\bR\tis_active\x12(\n" +
+	"\n" +
+	"is_deleted\x18\x0f \x01(\bR\x14__sys_gen_is_deleted\x12)\n" +
+	"\bowner_id\x18\x10 \x01(\tH\x05R\x12__sys_gen_owner_id\x88\x01\x01\x123\n" +
+	"\rcreated_by_id\x18\x11 \x01(\tH\x06R\x17__sys_gen_created_by_id\x88\x01\x01\x12?\n" +
+	"\x13last_modified_by_id\x18\x12 \x01(\tH\aR\x1d__sys_gen_last_modified_by_id\x88\x01\x01\x12D\n" +
+	"\x0flast_login_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x0flast_login_date\x12H\n" +
+	"\fcreated_date\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_role_idB\r\n" +
+	"\v_contact_idB\r\n" +
+	"\v_account_idB\t\n" +
+	"\a_localeB\v\n" +
+	"\t_owner_idB\x10\n" +
+	"\x0e_created_by_idB\x16\n" +
+	"\x14_last_modified_by_idJ\x04\b\x04\x10\x05\"\xe6\x02\n" +
+	"\x10SystemValidation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12(\n" +
+	"\x0fobject_api_name\x18\x02 \x01(\tR\x0fobject_api_name\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\x12$\n" +
+	"\rerror_message\x18\x06 \x01(\tR\rerror_message\x12H\n" +
+	"\fcreated_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_date\"\xff\x02\n" +
+	"\rSystemWebhook\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tR\f__sys_gen_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x18\n" +
+	"\aheaders\x18\x05 \x01(\tR\aheaders\x12\x1c\n" +
+	"\tauth_type\x18\x06 \x01(\tR\tauth_type\x12 \n" +
+	"\vauth_config\x18\a \x01(\tR\vauth_config\x12\x1c\n" +
+	"\tis_active\x18\b \x01(\bR\tis_active\x12H\n" +
+	"\fcreated_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16__sys_gen_created_date\x12T\n" +
+	"\x12last_modified_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x1c__sys_gen_last_modified_dateB;Z9github.com/nexuscrm/backend/pkg/api/nexuscrmv1;nexuscrmv1b\x06proto3"
+
+var (
+	file_nexuscrm_v1_system_tables_proto_rawDescOnce sync.Once
+	file_nexuscrm_v1_system_tables_proto_rawDescData []byte
+)
+
+func file_nexuscrm_v1_system_tables_proto_rawDescGZIP() []byte {
+	file_nexuscrm_v1_system_tables_proto_rawDescOnce.Do(func() {
+		file_nexuscrm_v1_system_tables_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nexuscrm_v1_system_tables_proto_rawDesc), len(file_nexuscrm_v1_system_tables_proto_rawDesc)))
+	})
+	return file_nexuscrm_v1_system_tables_proto_rawDescData
+}
+
+var file_nexuscrm_v1_system_tables_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
+var file_nexuscrm_v1_system_tables_proto_goTypes = []any{
+	(*SystemAIContextItem)(nil),           // 0: nexuscrm.v1.SystemAIContextItem
+	(*SystemAIConversation)(nil),          // 1: nexuscrm.v1.SystemAIConversation
+	(*SystemAction)(nil),                  // 2: nexuscrm.v1.SystemAction
+	(*SystemActivity)(nil),                // 3: nexuscrm.v1.SystemActivity
+	(*SystemApp)(nil),                     // 4: nexuscrm.v1.SystemApp
+	(*SystemApprovalProcess)(nil),         // 5: nexuscrm.v1.SystemApprovalProcess
+	(*SystemApprovalWorkItem)(nil),        // 6: nexuscrm.v1.SystemApprovalWorkItem
+	(*SystemArchivePolicy)(nil),           // 7: nexuscrm.v1.SystemArchivePolicy
+	(*SystemAsyncJob)(nil),                // 8: nexuscrm.v1.SystemAsyncJob
+	(*SystemAuditLog)(nil),                // 9: nexuscrm.v1.SystemAuditLog
+	(*SystemAutoNumber)(nil),              // 10: nexuscrm.v1.SystemAutoNumber
+	(*SystemBatchJob)(nil),                // 11: nexuscrm.v1.SystemBatchJob
+	(*SystemBootstrapStep)(nil),           // 12: nexuscrm.v1.SystemBootstrapStep
+	(*SystemBusinessHours)(nil),           // 13: nexuscrm.v1.SystemBusinessHours
+	(*SystemCampaign)(nil),                // 14: nexuscrm.v1.SystemCampaign
+	(*SystemCampaignMember)(nil),          // 15: nexuscrm.v1.SystemCampaignMember
+	(*SystemChangeEvent)(nil),             // 16: nexuscrm.v1.SystemChangeEvent
+	(*SystemChangeEventOffset)(nil),       // 17: nexuscrm.v1.SystemChangeEventOffset
+	(*SystemComment)(nil),                 // 18: nexuscrm.v1.SystemComment
+	(*SystemConfig)(nil),                  // 19: nexuscrm.v1.SystemConfig
+	(*SystemContract)(nil),                // 20: nexuscrm.v1.SystemContract
+	(*SystemCustomEndpoint)(nil),          // 21: nexuscrm.v1.SystemCustomEndpoint
+	(*SystemCustomMetadataRecord)(nil),    // 22: nexuscrm.v1.SystemCustomMetadataRecord
+	(*SystemCustomMetadataType)(nil),      // 23: nexuscrm.v1.SystemCustomMetadataType
+	(*SystemCustomSetting)(nil),           // 24: nexuscrm.v1.SystemCustomSetting
+	(*SystemCustomSettingValue)(nil),      // 25: nexuscrm.v1.SystemCustomSettingValue
+	(*SystemDashboard)(nil),               // 26: nexuscrm.v1.SystemDashboard
+	(*SystemDataQualityRule)(nil),         // 27: nexuscrm.v1.SystemDataQualityRule
+	(*SystemDataQualityScore)(nil),        // 28: nexuscrm.v1.SystemDataQualityScore
+	(*SystemDeletedMetadata)(nil),         // 29: nexuscrm.v1.SystemDeletedMetadata
+	(*SystemDocumentTemplate)(nil),        // 30: nexuscrm.v1.SystemDocumentTemplate
+	(*SystemEmailTemplate)(nil),           // 31: nexuscrm.v1.SystemEmailTemplate
+	(*SystemEntitlement)(nil),             // 32: nexuscrm.v1.SystemEntitlement
+	(*SystemEntitlementUsage)(nil),        // 33: nexuscrm.v1.SystemEntitlementUsage
+	(*SystemEscalationLog)(nil),           // 34: nexuscrm.v1.SystemEscalationLog
+	(*SystemEscalationRule)(nil),          // 35: nexuscrm.v1.SystemEscalationRule
+	(*SystemExternalObject)(nil),          // 36: nexuscrm.v1.SystemExternalObject
+	(*SystemFeedItem)(nil),                // 37: nexuscrm.v1.SystemFeedItem
+	(*SystemField)(nil),                   // 38: nexuscrm.v1.SystemField
+	(*SystemFieldDependency)(nil),         // 39: nexuscrm.v1.SystemFieldDependency
+	(*SystemFieldPerms)(nil),              // 40: nexuscrm.v1.SystemFieldPerms
+	(*SystemFile)(nil),                    // 41: nexuscrm.v1.SystemFile
+	(*SystemFlow)(nil),                    // 42: nexuscrm.v1.SystemFlow
+	(*SystemFlowInstance)(nil),            // 43: nexuscrm.v1.SystemFlowInstance
+	(*SystemFlowStep)(nil),                // 44: nexuscrm.v1.SystemFlowStep
+	(*SystemForecastAdjustment)(nil),      // 45: nexuscrm.v1.SystemForecastAdjustment
+	(*SystemForecastQuota)(nil),           // 46: nexuscrm.v1.SystemForecastQuota
+	(*SystemForecastSetting)(nil),         // 47: nexuscrm.v1.SystemForecastSetting
+	(*SystemGlobalValueSet)(nil),          // 48: nexuscrm.v1.SystemGlobalValueSet
+	(*SystemGroup)(nil),                   // 49: nexuscrm.v1.SystemGroup
+	(*SystemGroupMember)(nil),             // 50: nexuscrm.v1.SystemGroupMember
+	(*SystemHoliday)(nil),                 // 51: nexuscrm.v1.SystemHoliday
+	(*SystemHookSubscription)(nil),        // 52: nexuscrm.v1.SystemHookSubscription
+	(*SystemInboundHook)(nil),             // 53: nexuscrm.v1.SystemInboundHook
+	(*SystemKnowledgeArticle)(nil),        // 54: nexuscrm.v1.SystemKnowledgeArticle
+	(*SystemKnowledgeArticleLink)(nil),    // 55: nexuscrm.v1.SystemKnowledgeArticleLink
+	(*SystemKnowledgeArticleVersion)(nil), // 56: nexuscrm.v1.SystemKnowledgeArticleVersion
+	(*SystemKnowledgeCategory)(nil),       // 57: nexuscrm.v1.SystemKnowledgeCategory
+	(*SystemLayout)(nil),                  // 58: nexuscrm.v1.SystemLayout
+	(*SystemListView)(nil),                // 59: nexuscrm.v1.SystemListView
+	(*SystemLog)(nil),                     // 60: nexuscrm.v1.SystemLog
+	(*SystemNamedCredential)(nil),         // 61: nexuscrm.v1.SystemNamedCredential
+	(*SystemNotification)(nil),            // 62: nexuscrm.v1.SystemNotification
+	(*SystemObject)(nil),                  // 63: nexuscrm.v1.SystemObject
+	(*SystemObjectPerms)(nil),             // 64: nexuscrm.v1.SystemObjectPerms
+	(*SystemOrder)(nil),                   // 65: nexuscrm.v1.SystemOrder
+	(*SystemOrderItem)(nil),               // 66: nexuscrm.v1.SystemOrderItem
+	(*SystemOrganization)(nil),            // 67: nexuscrm.v1.SystemOrganization
+	(*SystemOutboxEvent)(nil),             // 68: nexuscrm.v1.SystemOutboxEvent
+	(*SystemPermissionSet)(nil),           // 69: nexuscrm.v1.SystemPermissionSet
+	(*SystemPermissionSetAssignment)(nil), // 70: nexuscrm.v1.SystemPermissionSetAssignment
+	(*SystemPortalObject)(nil),            // 71: nexuscrm.v1.SystemPortalObject
+	(*SystemProfile)(nil),                 // 72: nexuscrm.v1.SystemProfile
+	(*SystemProfileLayout)(nil),           // 73: nexuscrm.v1.SystemProfileLayout
+	(*SystemProfileRecordType)(nil),       // 74: nexuscrm.v1.SystemProfileRecordType
+	(*SystemQueryGovernor)(nil),           // 75: nexuscrm.v1.SystemQueryGovernor
+	(*SystemRecent)(nil),                  // 76: nexuscrm.v1.SystemRecent
+	(*SystemRecordShare)(nil),             // 77: nexuscrm.v1.SystemRecordShare
+	(*SystemRecordType)(nil),              // 78: nexuscrm.v1.SystemRecordType
+	(*SystemRecordEmbedding)(nil),         // 79: nexuscrm.v1.SystemRecordEmbedding
+	(*SystemRecycleBin)(nil),              // 80: nexuscrm.v1.SystemRecycleBin
+	(*SystemRelationship)(nil),            // 81: nexuscrm.v1.SystemRelationship
+	(*SystemReport)(nil),                  // 82: nexuscrm.v1.SystemReport
+	(*SystemRole)(nil),                    // 83: nexuscrm.v1.SystemRole
+	(*SystemSLAPolicy)(nil),               // 84: nexuscrm.v1.SystemSLAPolicy
+	(*SystemSLATimer)(nil),                // 85: nexuscrm.v1.SystemSLATimer
+	(*SystemSavedSearch)(nil),             // 86: nexuscrm.v1.SystemSavedSearch
+	(*SystemScript)(nil),                  // 87: nexuscrm.v1.SystemScript
+	(*SystemScriptLog)(nil),               // 88: nexuscrm.v1.SystemScriptLog
+	(*SystemSession)(nil),                 // 89: nexuscrm.v1.SystemSession
+	(*SystemSetupAudit)(nil),              // 90: nexuscrm.v1.SystemSetupAudit
+	(*SystemSetupPage)(nil),               // 91: nexuscrm.v1.SystemSetupPage
+	(*SystemSharingRule)(nil),             // 92: nexuscrm.v1.SystemSharingRule
+	(*SystemStageHistory)(nil),            // 93: nexuscrm.v1.SystemStageHistory
+	(*SystemStateMachine)(nil),            // 94: nexuscrm.v1.SystemStateMachine
+	(*SystemSurvey)(nil),                  // 95: nexuscrm.v1.SystemSurvey
+	(*SystemSurveyInvitation)(nil),        // 96: nexuscrm.v1.SystemSurveyInvitation
+	(*SystemSurveyResponse)(nil),          // 97: nexuscrm.v1.SystemSurveyResponse
+	(*SystemSyncConnector)(nil),           // 98: nexuscrm.v1.SystemSyncConnector
+	(*SystemSystemLog)(nil),               // 99: nexuscrm.v1.SystemSystemLog
+	(*SystemTable)(nil),                   // 100: nexuscrm.v1.SystemTable
+	(*SystemTeamMember)(nil),              // 101: nexuscrm.v1.SystemTeamMember
+	(*SystemTheme)(nil),                   // 102: nexuscrm.v1.SystemTheme
+	(*SystemTranslation)(nil),             // 103: nexuscrm.v1.SystemTranslation
+	(*SystemUIComponent)(nil),             // 104: nexuscrm.v1.SystemUIComponent
+	(*SystemUser)(nil),                    // 105: nexuscrm.v1.SystemUser
+	(*SystemValidation)(nil),              // 106: nexuscrm.v1.SystemValidation
+	(*SystemWebhook)(nil),                 // 107: nexuscrm.v1.SystemWebhook
+	(*timestamppb.Timestamp)(nil),         // 108: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                // 109: google.protobuf.Value
+}
+var file_nexuscrm_v1_system_tables_proto_depIdxs = []int32{
+	108, // 0: nexuscrm.v1.SystemAIContextItem.created_date:type_name -> google.protobuf.Timestamp
+	108, // 1: nexuscrm.v1.SystemAIContextItem.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 2: nexuscrm.v1.SystemAIConversation.messages:type_name -> google.protobuf.Value
+	109, // 3: nexuscrm.v1.SystemAIConversation.settings:type_name -> google.protobuf.Value
+	108, // 4: nexuscrm.v1.SystemAIConversation.created_date:type_name -> google.protobuf.Timestamp
+	108, // 5: nexuscrm.v1.SystemAIConversation.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 6: nexuscrm.v1.SystemAction.config:type_name -> google.protobuf.Value
+	108, // 7: nexuscrm.v1.SystemAction.created_date:type_name -> google.protobuf.Timestamp
+	108, // 8: nexuscrm.v1.SystemAction.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 9: nexuscrm.v1.SystemActivity.activity_date:type_name -> google.protobuf.Timestamp
+	108, // 10: nexuscrm.v1.SystemActivity.end_date:type_name -> google.protobuf.Timestamp
+	108, // 11: nexuscrm.v1.SystemActivity.created_date:type_name -> google.protobuf.Timestamp
+	108, // 12: nexuscrm.v1.SystemActivity.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 13: nexuscrm.v1.SystemApp.navigation_items:type_name -> google.protobuf.Value
+	108, // 14: nexuscrm.v1.SystemApp.created_date:type_name -> google.protobuf.Timestamp
+	108, // 15: nexuscrm.v1.SystemApp.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 16: nexuscrm.v1.SystemApprovalProcess.created_date:type_name -> google.protobuf.Timestamp
+	108, // 17: nexuscrm.v1.SystemApprovalProcess.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 18: nexuscrm.v1.SystemApprovalWorkItem.submitted_date:type_name -> google.protobuf.Timestamp
+	108, // 19: nexuscrm.v1.SystemApprovalWorkItem.approved_date:type_name -> google.protobuf.Timestamp
+	108, // 20: nexuscrm.v1.SystemApprovalWorkItem.created_date:type_name -> google.protobuf.Timestamp
+	108, // 21: nexuscrm.v1.SystemApprovalWorkItem.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 22: nexuscrm.v1.SystemArchivePolicy.last_run_date:type_name -> google.protobuf.Timestamp
+	108, // 23: nexuscrm.v1.SystemArchivePolicy.created_date:type_name -> google.protobuf.Timestamp
+	108, // 24: nexuscrm.v1.SystemArchivePolicy.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 25: nexuscrm.v1.SystemAsyncJob.parameters:type_name -> google.protobuf.Value
+	108, // 26: nexuscrm.v1.SystemAsyncJob.started_date:type_name -> google.protobuf.Timestamp
+	108, // 27: nexuscrm.v1.SystemAsyncJob.completed_date:type_name -> google.protobuf.Timestamp
+	108, // 28: nexuscrm.v1.SystemAsyncJob.created_date:type_name -> google.protobuf.Timestamp
+	108, // 29: nexuscrm.v1.SystemAsyncJob.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 30: nexuscrm.v1.SystemAuditLog.changed_at:type_name -> google.protobuf.Timestamp
+	108, // 31: nexuscrm.v1.SystemAuditLog.created_date:type_name -> google.protobuf.Timestamp
+	108, // 32: nexuscrm.v1.SystemAuditLog.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 33: nexuscrm.v1.SystemAutoNumber.created_date:type_name -> google.protobuf.Timestamp
+	108, // 34: nexuscrm.v1.SystemAutoNumber.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 35: nexuscrm.v1.SystemBatchJob.field_values:type_name -> google.protobuf.Value
+	108, // 36: nexuscrm.v1.SystemBatchJob.next_run_at:type_name -> google.protobuf.Timestamp
+	108, // 37: nexuscrm.v1.SystemBatchJob.last_run_at:type_name -> google.protobuf.Timestamp
+	108, // 38: nexuscrm.v1.SystemBatchJob.created_date:type_name -> google.protobuf.Timestamp
+	108, // 39: nexuscrm.v1.SystemBatchJob.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 40: nexuscrm.v1.SystemBootstrapStep.created_date:type_name -> google.protobuf.Timestamp
+	108, // 41: nexuscrm.v1.SystemBootstrapStep.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 42: nexuscrm.v1.SystemBusinessHours.schedule:type_name -> google.protobuf.Value
+	108, // 43: nexuscrm.v1.SystemBusinessHours.created_date:type_name -> google.protobuf.Timestamp
+	108, // 44: nexuscrm.v1.SystemBusinessHours.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 45: nexuscrm.v1.SystemCampaign.member_statuses:type_name -> google.protobuf.Value
+	108, // 46: nexuscrm.v1.SystemCampaign.rollups_date:type_name -> google.protobuf.Timestamp
+	108, // 47: nexuscrm.v1.SystemCampaign.created_date:type_name -> google.protobuf.Timestamp
+	108, // 48: nexuscrm.v1.SystemCampaign.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 49: nexuscrm.v1.SystemCampaignMember.first_responded_date:type_name -> google.protobuf.Timestamp
+	108, // 50: nexuscrm.v1.SystemCampaignMember.created_date:type_name -> google.protobuf.Timestamp
+	108, // 51: nexuscrm.v1.SystemCampaignMember.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 52: nexuscrm.v1.SystemChangeEvent.commit_timestamp:type_name -> google.protobuf.Timestamp
+	109, // 53: nexuscrm.v1.SystemChangeEvent.changed_fields:type_name -> google.protobuf.Value
+	109, // 54: nexuscrm.v1.SystemChangeEvent.before_data:type_name -> google.protobuf.Value
+	109, // 55: nexuscrm.v1.SystemChangeEvent.after_data:type_name -> google.protobuf.Value
+	108, // 56: nexuscrm.v1.SystemChangeEvent.created_date:type_name -> google.protobuf.Timestamp
+	108, // 57: nexuscrm.v1.SystemChangeEvent.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 58: nexuscrm.v1.SystemChangeEventOffset.created_date:type_name -> google.protobuf.Timestamp
+	108, // 59: nexuscrm.v1.SystemChangeEventOffset.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 60: nexuscrm.v1.SystemComment.created_date:type_name -> google.protobuf.Timestamp
+	108, // 61: nexuscrm.v1.SystemComment.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 62: nexuscrm.v1.SystemConfig.created_date:type_name -> google.protobuf.Timestamp
+	108, // 63: nexuscrm.v1.SystemConfig.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 64: nexuscrm.v1.SystemContract.created_date:type_name -> google.protobuf.Timestamp
+	108, // 65: nexuscrm.v1.SystemContract.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 66: nexuscrm.v1.SystemCustomEndpoint.field_values:type_name -> google.protobuf.Value
+	109, // 67: nexuscrm.v1.SystemCustomEndpoint.input_schema:type_name -> google.protobuf.Value
+	109, // 68: nexuscrm.v1.SystemCustomEndpoint.profile_ids:type_name -> google.protobuf.Value
+	108, // 69: nexuscrm.v1.SystemCustomEndpoint.created_date:type_name -> google.protobuf.Timestamp
+	108, // 70: nexuscrm.v1.SystemCustomEndpoint.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 71: nexuscrm.v1.SystemCustomMetadataRecord.field_values:type_name -> google.protobuf.Value
+	108, // 72: nexuscrm.v1.SystemCustomMetadataRecord.created_date:type_name -> google.protobuf.Timestamp
+	108, // 73: nexuscrm.v1.SystemCustomMetadataRecord.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 74: nexuscrm.v1.SystemCustomMetadataType.fields:type_name -> google.protobuf.Value
+	108, // 75: nexuscrm.v1.SystemCustomMetadataType.created_date:type_name -> google.protobuf.Timestamp
+	108, // 76: nexuscrm.v1.SystemCustomMetadataType.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 77: nexuscrm.v1.SystemCustomSetting.default_value:type_name -> google.protobuf.Value
+	108, // 78: nexuscrm.v1.SystemCustomSetting.created_date:type_name -> google.protobuf.Timestamp
+	108, // 79: nexuscrm.v1.SystemCustomSetting.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 80: nexuscrm.v1.SystemCustomSettingValue.value:type_name -> google.protobuf.Value
+	108, // 81: nexuscrm.v1.SystemCustomSettingValue.created_date:type_name -> google.protobuf.Timestamp
+	108, // 82: nexuscrm.v1.SystemCustomSettingValue.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 83: nexuscrm.v1.SystemDashboard.widgets:type_name -> google.protobuf.Value
+	109, // 84: nexuscrm.v1.SystemDashboard.filters:type_name -> google.protobuf.Value
+	108, // 85: nexuscrm.v1.SystemDashboard.created_date:type_name -> google.protobuf.Timestamp
+	108, // 86: nexuscrm.v1.SystemDashboard.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 87: nexuscrm.v1.SystemDataQualityRule.completeness_fields:type_name -> google.protobuf.Value
+	109, // 88: nexuscrm.v1.SystemDataQualityRule.match_fields:type_name -> google.protobuf.Value
+	108, // 89: nexuscrm.v1.SystemDataQualityRule.created_date:type_name -> google.protobuf.Timestamp
+	108, // 90: nexuscrm.v1.SystemDataQualityRule.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 91: nexuscrm.v1.SystemDataQualityScore.missing_fields:type_name -> google.protobuf.Value
+	108, // 92: nexuscrm.v1.SystemDataQualityScore.scored_date:type_name -> google.protobuf.Timestamp
+	108, // 93: nexuscrm.v1.SystemDataQualityScore.created_date:type_name -> google.protobuf.Timestamp
+	108, // 94: nexuscrm.v1.SystemDataQualityScore.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 95: nexuscrm.v1.SystemDeletedMetadata.metadata:type_name -> google.protobuf.Value
+	108, // 96: nexuscrm.v1.SystemDeletedMetadata.deleted_date:type_name -> google.protobuf.Timestamp
+	108, // 97: nexuscrm.v1.SystemDeletedMetadata.purge_after:type_name -> google.protobuf.Timestamp
+	108, // 98: nexuscrm.v1.SystemDeletedMetadata.created_date:type_name -> google.protobuf.Timestamp
+	108, // 99: nexuscrm.v1.SystemDeletedMetadata.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 100: nexuscrm.v1.SystemDocumentTemplate.created_date:type_name -> google.protobuf.Timestamp
+	108, // 101: nexuscrm.v1.SystemDocumentTemplate.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 102: nexuscrm.v1.SystemEmailTemplate.created_date:type_name -> google.protobuf.Timestamp
+	108, // 103: nexuscrm.v1.SystemEmailTemplate.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 104: nexuscrm.v1.SystemEntitlement.created_date:type_name -> google.protobuf.Timestamp
+	108, // 105: nexuscrm.v1.SystemEntitlement.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 106: nexuscrm.v1.SystemEntitlementUsage.created_date:type_name -> google.protobuf.Timestamp
+	108, // 107: nexuscrm.v1.SystemEntitlementUsage.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 108: nexuscrm.v1.SystemEscalationLog.escalated_date:type_name -> google.protobuf.Timestamp
+	108, // 109: nexuscrm.v1.SystemEscalationLog.created_date:type_name -> google.protobuf.Timestamp
+	108, // 110: nexuscrm.v1.SystemEscalationLog.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 111: nexuscrm.v1.SystemEscalationRule.actions:type_name -> google.protobuf.Value
+	108, // 112: nexuscrm.v1.SystemEscalationRule.created_date:type_name -> google.protobuf.Timestamp
+	108, // 113: nexuscrm.v1.SystemEscalationRule.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 114: nexuscrm.v1.SystemExternalObject.field_map:type_name -> google.protobuf.Value
+	108, // 115: nexuscrm.v1.SystemExternalObject.created_date:type_name -> google.protobuf.Timestamp
+	108, // 116: nexuscrm.v1.SystemExternalObject.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 117: nexuscrm.v1.SystemFeedItem.created_date:type_name -> google.protobuf.Timestamp
+	108, // 118: nexuscrm.v1.SystemFeedItem.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 119: nexuscrm.v1.SystemField.options:type_name -> google.protobuf.Value
+	109, // 120: nexuscrm.v1.SystemField.reference_to:type_name -> google.protobuf.Value
+	109, // 121: nexuscrm.v1.SystemField.picklist_dependency:type_name -> google.protobuf.Value
+	109, // 122: nexuscrm.v1.SystemField.inactive_options:type_name -> google.protobuf.Value
+	109, // 123: nexuscrm.v1.SystemField.rollup_config:type_name -> google.protobuf.Value
+	108, // 124: nexuscrm.v1.SystemField.created_date:type_name -> google.protobuf.Timestamp
+	108, // 125: nexuscrm.v1.SystemField.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 126: nexuscrm.v1.SystemFieldDependency.dependent_values:type_name -> google.protobuf.Value
+	108, // 127: nexuscrm.v1.SystemFieldDependency.created_date:type_name -> google.protobuf.Timestamp
+	108, // 128: nexuscrm.v1.SystemFieldDependency.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 129: nexuscrm.v1.SystemFieldPerms.created_date:type_name -> google.protobuf.Timestamp
+	108, // 130: nexuscrm.v1.SystemFieldPerms.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 131: nexuscrm.v1.SystemFile.created_date:type_name -> google.protobuf.Timestamp
+	108, // 132: nexuscrm.v1.SystemFile.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 133: nexuscrm.v1.SystemFlow.action_config:type_name -> google.protobuf.Value
+	108, // 134: nexuscrm.v1.SystemFlow.created_date:type_name -> google.protobuf.Timestamp
+	108, // 135: nexuscrm.v1.SystemFlow.last_run_at:type_name -> google.protobuf.Timestamp
+	108, // 136: nexuscrm.v1.SystemFlow.next_run_at:type_name -> google.protobuf.Timestamp
+	108, // 137: nexuscrm.v1.SystemFlow.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 138: nexuscrm.v1.SystemFlowInstance.context_data:type_name -> google.protobuf.Value
+	108, // 139: nexuscrm.v1.SystemFlowInstance.started_date:type_name -> google.protobuf.Timestamp
+	108, // 140: nexuscrm.v1.SystemFlowInstance.paused_date:type_name -> google.protobuf.Timestamp
+	108, // 141: nexuscrm.v1.SystemFlowInstance.completed_date:type_name -> google.protobuf.Timestamp
+	108, // 142: nexuscrm.v1.SystemFlowInstance.created_date:type_name -> google.protobuf.Timestamp
+	108, // 143: nexuscrm.v1.SystemFlowInstance.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 144: nexuscrm.v1.SystemFlowStep.action_config:type_name -> google.protobuf.Value
+	108, // 145: nexuscrm.v1.SystemFlowStep.created_date:type_name -> google.protobuf.Timestamp
+	108, // 146: nexuscrm.v1.SystemFlowStep.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 147: nexuscrm.v1.SystemForecastAdjustment.created_date:type_name -> google.protobuf.Timestamp
+	108, // 148: nexuscrm.v1.SystemForecastAdjustment.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 149: nexuscrm.v1.SystemForecastQuota.created_date:type_name -> google.protobuf.Timestamp
+	108, // 150: nexuscrm.v1.SystemForecastQuota.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 151: nexuscrm.v1.SystemForecastSetting.category_mapping:type_name -> google.protobuf.Value
+	108, // 152: nexuscrm.v1.SystemForecastSetting.created_date:type_name -> google.protobuf.Timestamp
+	108, // 153: nexuscrm.v1.SystemForecastSetting.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 154: nexuscrm.v1.SystemGlobalValueSet.options:type_name -> google.protobuf.Value
+	109, // 155: nexuscrm.v1.SystemGlobalValueSet.inactive_options:type_name -> google.protobuf.Value
+	108, // 156: nexuscrm.v1.SystemGlobalValueSet.created_date:type_name -> google.protobuf.Timestamp
+	108, // 157: nexuscrm.v1.SystemGlobalValueSet.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 158: nexuscrm.v1.SystemGroup.created_date:type_name -> google.protobuf.Timestamp
+	108, // 159: nexuscrm.v1.SystemGroup.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 160: nexuscrm.v1.SystemGroupMember.created_date:type_name -> google.protobuf.Timestamp
+	108, // 161: nexuscrm.v1.SystemGroupMember.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 162: nexuscrm.v1.SystemHoliday.created_date:type_name -> google.protobuf.Timestamp
+	108, // 163: nexuscrm.v1.SystemHoliday.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 164: nexuscrm.v1.SystemHookSubscription.last_delivery_date:type_name -> google.protobuf.Timestamp
+	108, // 165: nexuscrm.v1.SystemHookSubscription.created_date:type_name -> google.protobuf.Timestamp
+	108, // 166: nexuscrm.v1.SystemHookSubscription.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 167: nexuscrm.v1.SystemInboundHook.field_mapping:type_name -> google.protobuf.Value
+	108, // 168: nexuscrm.v1.SystemInboundHook.last_received_date:type_name -> google.protobuf.Timestamp
+	108, // 169: nexuscrm.v1.SystemInboundHook.created_date:type_name -> google.protobuf.Timestamp
+	108, // 170: nexuscrm.v1.SystemInboundHook.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 171: nexuscrm.v1.SystemKnowledgeArticle.published_date:type_name -> google.protobuf.Timestamp
+	108, // 172: nexuscrm.v1.SystemKnowledgeArticle.archived_date:type_name -> google.protobuf.Timestamp
+	108, // 173: nexuscrm.v1.SystemKnowledgeArticle.created_date:type_name -> google.protobuf.Timestamp
+	108, // 174: nexuscrm.v1.SystemKnowledgeArticle.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 175: nexuscrm.v1.SystemKnowledgeArticleLink.created_date:type_name -> google.protobuf.Timestamp
+	108, // 176: nexuscrm.v1.SystemKnowledgeArticleLink.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 177: nexuscrm.v1.SystemKnowledgeArticleVersion.published_date:type_name -> google.protobuf.Timestamp
+	108, // 178: nexuscrm.v1.SystemKnowledgeArticleVersion.created_date:type_name -> google.protobuf.Timestamp
+	108, // 179: nexuscrm.v1.SystemKnowledgeArticleVersion.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 180: nexuscrm.v1.SystemKnowledgeCategory.created_date:type_name -> google.protobuf.Timestamp
+	108, // 181: nexuscrm.v1.SystemKnowledgeCategory.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 182: nexuscrm.v1.SystemLayout.config:type_name -> google.protobuf.Value
+	108, // 183: nexuscrm.v1.SystemLayout.created_date:type_name -> google.protobuf.Timestamp
+	108, // 184: nexuscrm.v1.SystemLayout.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 185: nexuscrm.v1.SystemListView.fields:type_name -> google.protobuf.Value
+	109, // 186: nexuscrm.v1.SystemListView.profile_ids:type_name -> google.protobuf.Value
+	109, // 187: nexuscrm.v1.SystemListView.column_settings:type_name -> google.protobuf.Value
+	109, // 188: nexuscrm.v1.SystemListView.aggregates:type_name -> google.protobuf.Value
+	108, // 189: nexuscrm.v1.SystemListView.created_date:type_name -> google.protobuf.Timestamp
+	108, // 190: nexuscrm.v1.SystemListView.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 191: nexuscrm.v1.SystemLog.timestamp:type_name -> google.protobuf.Timestamp
+	108, // 192: nexuscrm.v1.SystemLog.created_date:type_name -> google.protobuf.Timestamp
+	108, // 193: nexuscrm.v1.SystemLog.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 194: nexuscrm.v1.SystemNamedCredential.created_date:type_name -> google.protobuf.Timestamp
+	108, // 195: nexuscrm.v1.SystemNamedCredential.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 196: nexuscrm.v1.SystemNotification.created_date:type_name -> google.protobuf.Timestamp
+	108, // 197: nexuscrm.v1.SystemNotification.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 198: nexuscrm.v1.SystemObject.list_fields:type_name -> google.protobuf.Value
+	108, // 199: nexuscrm.v1.SystemObject.created_date:type_name -> google.protobuf.Timestamp
+	108, // 200: nexuscrm.v1.SystemObject.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 201: nexuscrm.v1.SystemObjectPerms.created_date:type_name -> google.protobuf.Timestamp
+	108, // 202: nexuscrm.v1.SystemObjectPerms.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 203: nexuscrm.v1.SystemOrder.activated_date:type_name -> google.protobuf.Timestamp
+	108, // 204: nexuscrm.v1.SystemOrder.fulfilled_date:type_name -> google.protobuf.Timestamp
+	108, // 205: nexuscrm.v1.SystemOrder.cancelled_date:type_name -> google.protobuf.Timestamp
+	108, // 206: nexuscrm.v1.SystemOrder.created_date:type_name -> google.protobuf.Timestamp
+	108, // 207: nexuscrm.v1.SystemOrder.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 208: nexuscrm.v1.SystemOrderItem.created_date:type_name -> google.protobuf.Timestamp
+	108, // 209: nexuscrm.v1.SystemOrderItem.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 210: nexuscrm.v1.SystemOrganization.setup_completed_date:type_name -> google.protobuf.Timestamp
+	108, // 211: nexuscrm.v1.SystemOrganization.created_date:type_name -> google.protobuf.Timestamp
+	108, // 212: nexuscrm.v1.SystemOrganization.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 213: nexuscrm.v1.SystemOutboxEvent.payload:type_name -> google.protobuf.Value
+	108, // 214: nexuscrm.v1.SystemOutboxEvent.processed_date:type_name -> google.protobuf.Timestamp
+	108, // 215: nexuscrm.v1.SystemOutboxEvent.created_date:type_name -> google.protobuf.Timestamp
+	108, // 216: nexuscrm.v1.SystemOutboxEvent.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 217: nexuscrm.v1.SystemPermissionSet.created_date:type_name -> google.protobuf.Timestamp
+	108, // 218: nexuscrm.v1.SystemPermissionSet.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 219: nexuscrm.v1.SystemPermissionSetAssignment.created_date:type_name -> google.protobuf.Timestamp
+	108, // 220: nexuscrm.v1.SystemPermissionSetAssignment.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 221: nexuscrm.v1.SystemPortalObject.created_date:type_name -> google.protobuf.Timestamp
+	108, // 222: nexuscrm.v1.SystemPortalObject.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 223: nexuscrm.v1.SystemProfile.created_date:type_name -> google.protobuf.Timestamp
+	108, // 224: nexuscrm.v1.SystemProfile.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 225: nexuscrm.v1.SystemProfileLayout.created_date:type_name -> google.protobuf.Timestamp
+	108, // 226: nexuscrm.v1.SystemProfileLayout.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 227: nexuscrm.v1.SystemProfileRecordType.created_date:type_name -> google.protobuf.Timestamp
+	108, // 228: nexuscrm.v1.SystemProfileRecordType.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 229: nexuscrm.v1.SystemQueryGovernor.created_date:type_name -> google.protobuf.Timestamp
+	108, // 230: nexuscrm.v1.SystemQueryGovernor.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 231: nexuscrm.v1.SystemRecent.timestamp:type_name -> google.protobuf.Timestamp
+	108, // 232: nexuscrm.v1.SystemRecent.created_date:type_name -> google.protobuf.Timestamp
+	108, // 233: nexuscrm.v1.SystemRecent.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 234: nexuscrm.v1.SystemRecordShare.created_date:type_name -> google.protobuf.Timestamp
+	108, // 235: nexuscrm.v1.SystemRecordShare.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 236: nexuscrm.v1.SystemRecordType.picklist_values:type_name -> google.protobuf.Value
+	108, // 237: nexuscrm.v1.SystemRecordType.created_date:type_name -> google.protobuf.Timestamp
+	108, // 238: nexuscrm.v1.SystemRecordType.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 239: nexuscrm.v1.SystemRecordEmbedding.created_date:type_name -> google.protobuf.Timestamp
+	108, // 240: nexuscrm.v1.SystemRecordEmbedding.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 241: nexuscrm.v1.SystemRecycleBin.deleted_date:type_name -> google.protobuf.Timestamp
+	108, // 242: nexuscrm.v1.SystemRecycleBin.created_date:type_name -> google.protobuf.Timestamp
+	108, // 243: nexuscrm.v1.SystemRecycleBin.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 244: nexuscrm.v1.SystemRelationship.created_date:type_name -> google.protobuf.Timestamp
+	108, // 245: nexuscrm.v1.SystemRelationship.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 246: nexuscrm.v1.SystemReport.columns:type_name -> google.protobuf.Value
+	109, // 247: nexuscrm.v1.SystemReport.groupings:type_name -> google.protobuf.Value
+	109, // 248: nexuscrm.v1.SystemReport.column_groupings:type_name -> google.protobuf.Value
+	109, // 249: nexuscrm.v1.SystemReport.aggregates:type_name -> google.protobuf.Value
+	108, // 250: nexuscrm.v1.SystemReport.created_date:type_name -> google.protobuf.Timestamp
+	108, // 251: nexuscrm.v1.SystemReport.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 252: nexuscrm.v1.SystemRole.created_date:type_name -> google.protobuf.Timestamp
+	108, // 253: nexuscrm.v1.SystemRole.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 254: nexuscrm.v1.SystemSLAPolicy.paused_statuses:type_name -> google.protobuf.Value
+	109, // 255: nexuscrm.v1.SystemSLAPolicy.closed_statuses:type_name -> google.protobuf.Value
+	109, // 256: nexuscrm.v1.SystemSLAPolicy.milestones:type_name -> google.protobuf.Value
+	108, // 257: nexuscrm.v1.SystemSLAPolicy.created_date:type_name -> google.protobuf.Timestamp
+	108, // 258: nexuscrm.v1.SystemSLAPolicy.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 259: nexuscrm.v1.SystemSLATimer.running_since:type_name -> google.protobuf.Timestamp
+	108, // 260: nexuscrm.v1.SystemSLATimer.due_date:type_name -> google.protobuf.Timestamp
+	108, // 261: nexuscrm.v1.SystemSLATimer.started_date:type_name -> google.protobuf.Timestamp
+	108, // 262: nexuscrm.v1.SystemSLATimer.completed_date:type_name -> google.protobuf.Timestamp
+	108, // 263: nexuscrm.v1.SystemSLATimer.escalated_date:type_name -> google.protobuf.Timestamp
+	108, // 264: nexuscrm.v1.SystemSLATimer.created_date:type_name -> google.protobuf.Timestamp
+	108, // 265: nexuscrm.v1.SystemSLATimer.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 266: nexuscrm.v1.SystemSavedSearch.object_scope:type_name -> google.protobuf.Value
+	108, // 267: nexuscrm.v1.SystemSavedSearch.last_run_date:type_name -> google.protobuf.Timestamp
+	108, // 268: nexuscrm.v1.SystemSavedSearch.created_date:type_name -> google.protobuf.Timestamp
+	108, // 269: nexuscrm.v1.SystemSavedSearch.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 270: nexuscrm.v1.SystemScript.created_date:type_name -> google.protobuf.Timestamp
+	108, // 271: nexuscrm.v1.SystemScript.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 272: nexuscrm.v1.SystemScriptLog.created_date:type_name -> google.protobuf.Timestamp
+	108, // 273: nexuscrm.v1.SystemScriptLog.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 274: nexuscrm.v1.SystemSession.expires_at:type_name -> google.protobuf.Timestamp
+	108, // 275: nexuscrm.v1.SystemSession.last_activity:type_name -> google.protobuf.Timestamp
+	108, // 276: nexuscrm.v1.SystemSession.created_date:type_name -> google.protobuf.Timestamp
+	108, // 277: nexuscrm.v1.SystemSession.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 278: nexuscrm.v1.SystemSetupAudit.before_data:type_name -> google.protobuf.Value
+	109, // 279: nexuscrm.v1.SystemSetupAudit.after_data:type_name -> google.protobuf.Value
+	108, // 280: nexuscrm.v1.SystemSetupAudit.changed_at:type_name -> google.protobuf.Timestamp
+	108, // 281: nexuscrm.v1.SystemSetupAudit.created_date:type_name -> google.protobuf.Timestamp
+	108, // 282: nexuscrm.v1.SystemSetupAudit.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 283: nexuscrm.v1.SystemSetupPage.created_date:type_name -> google.protobuf.Timestamp
+	108, // 284: nexuscrm.v1.SystemSetupPage.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 285: nexuscrm.v1.SystemSharingRule.created_date:type_name -> google.protobuf.Timestamp
+	108, // 286: nexuscrm.v1.SystemSharingRule.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 287: nexuscrm.v1.SystemStageHistory.entered_date:type_name -> google.protobuf.Timestamp
+	108, // 288: nexuscrm.v1.SystemStageHistory.exited_date:type_name -> google.protobuf.Timestamp
+	108, // 289: nexuscrm.v1.SystemStageHistory.created_date:type_name -> google.protobuf.Timestamp
+	109, // 290: nexuscrm.v1.SystemStateMachine.initial_states:type_name -> google.protobuf.Value
+	109, // 291: nexuscrm.v1.SystemStateMachine.states:type_name -> google.protobuf.Value
+	109, // 292: nexuscrm.v1.SystemStateMachine.transitions:type_name -> google.protobuf.Value
+	108, // 293: nexuscrm.v1.SystemStateMachine.created_date:type_name -> google.protobuf.Timestamp
+	108, // 294: nexuscrm.v1.SystemStateMachine.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 295: nexuscrm.v1.SystemSurvey.questions:type_name -> google.protobuf.Value
+	108, // 296: nexuscrm.v1.SystemSurvey.created_date:type_name -> google.protobuf.Timestamp
+	108, // 297: nexuscrm.v1.SystemSurvey.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 298: nexuscrm.v1.SystemSurveyInvitation.expires_date:type_name -> google.protobuf.Timestamp
+	108, // 299: nexuscrm.v1.SystemSurveyInvitation.responded_date:type_name -> google.protobuf.Timestamp
+	108, // 300: nexuscrm.v1.SystemSurveyInvitation.created_date:type_name -> google.protobuf.Timestamp
+	108, // 301: nexuscrm.v1.SystemSurveyInvitation.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 302: nexuscrm.v1.SystemSurveyResponse.answers:type_name -> google.protobuf.Value
+	108, // 303: nexuscrm.v1.SystemSurveyResponse.submitted_date:type_name -> google.protobuf.Timestamp
+	108, // 304: nexuscrm.v1.SystemSurveyResponse.created_date:type_name -> google.protobuf.Timestamp
+	108, // 305: nexuscrm.v1.SystemSurveyResponse.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 306: nexuscrm.v1.SystemSyncConnector.token_expires_at:type_name -> google.protobuf.Timestamp
+	108, // 307: nexuscrm.v1.SystemSyncConnector.email_synced_until:type_name -> google.protobuf.Timestamp
+	108, // 308: nexuscrm.v1.SystemSyncConnector.calendar_synced_until:type_name -> google.protobuf.Timestamp
+	108, // 309: nexuscrm.v1.SystemSyncConnector.last_sync_date:type_name -> google.protobuf.Timestamp
+	108, // 310: nexuscrm.v1.SystemSyncConnector.created_date:type_name -> google.protobuf.Timestamp
+	108, // 311: nexuscrm.v1.SystemSyncConnector.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 312: nexuscrm.v1.SystemSystemLog.timestamp:type_name -> google.protobuf.Timestamp
+	108, // 313: nexuscrm.v1.SystemTable.created_date:type_name -> google.protobuf.Timestamp
+	108, // 314: nexuscrm.v1.SystemTable.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 315: nexuscrm.v1.SystemTeamMember.created_date:type_name -> google.protobuf.Timestamp
+	108, // 316: nexuscrm.v1.SystemTeamMember.last_modified_date:type_name -> google.protobuf.Timestamp
+	109, // 317: nexuscrm.v1.SystemTheme.colors:type_name -> google.protobuf.Value
+	108, // 318: nexuscrm.v1.SystemTheme.created_date:type_name -> google.protobuf.Timestamp
+	108, // 319: nexuscrm.v1.SystemTheme.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 320: nexuscrm.v1.SystemTranslation.created_date:type_name -> google.protobuf.Timestamp
+	108, // 321: nexuscrm.v1.SystemTranslation.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 322: nexuscrm.v1.SystemUIComponent.created_date:type_name -> google.protobuf.Timestamp
+	108, // 323: nexuscrm.v1.SystemUIComponent.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 324: nexuscrm.v1.SystemUser.last_login_date:type_name -> google.protobuf.Timestamp
+	108, // 325: nexuscrm.v1.SystemUser.created_date:type_name -> google.protobuf.Timestamp
+	108, // 326: nexuscrm.v1.SystemUser.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 327: nexuscrm.v1.SystemValidation.created_date:type_name -> google.protobuf.Timestamp
+	108, // 328: nexuscrm.v1.SystemValidation.last_modified_date:type_name -> google.protobuf.Timestamp
+	108, // 329: nexuscrm.v1.SystemWebhook.created_date:type_name -> google.protobuf.Timestamp
+	108, // 330: nexuscrm.v1.SystemWebhook.last_modified_date:type_name -> google.protobuf.Timestamp
+	331, // [331:331] is the sub-list for method output_type
+	331, // [331:331] is the sub-list for method input_type
+	331, // [331:331] is the sub-list for extension type_name
+	331, // [331:331] is the sub-list for extension extendee
+	0,   // [0:331] is the sub-list for field type_name
+}
+
+func init() { file_nexuscrm_v1_system_tables_proto_init() }
+func file_nexuscrm_v1_system_tables_proto_init() {
+	if File_nexuscrm_v1_system_tables_proto != nil {
+		return
+	}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[2].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[3].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[5].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[6].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[7].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[8].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[11].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[14].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[16].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[18].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[20].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[21].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[23].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[24].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[26].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[28].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[29].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[30].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[32].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[34].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[35].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[36].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[38].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[40].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[42].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[43].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[44].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[45].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[48].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[49].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[52].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[53].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[54].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[56].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[57].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[59].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[60].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[61].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[63].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[64].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[65].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[66].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[67].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[68].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[71].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[72].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[74].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[75].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[77].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[82].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[83].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[84].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[87].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[88].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[90].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[91].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[92].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[93].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[94].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[95].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[96].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[97].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[98].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[99].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[101].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[102].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[104].OneofWrappers = []any{}
+	file_nexuscrm_v1_system_tables_proto_msgTypes[105].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nexuscrm_v1_system_tables_proto_rawDesc), len(file_nexuscrm_v1_system_tables_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   108,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nexuscrm_v1_system_tables_proto_goTypes,
+		DependencyIndexes: file_nexuscrm_v1_system_tables_proto_depIdxs,
+		MessageInfos:      file_nexuscrm_v1_system_tables_proto_msgTypes,
+	}.Build()
+	File_nexuscrm_v1_system_tables_proto = out.File
+	file_nexuscrm_v1_system_tables_proto_goTypes = nil
+	file_nexuscrm_v1_system_tables_proto_depIdxs = nil
+}
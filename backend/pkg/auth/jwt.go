@@ -2,7 +2,6 @@ package auth
 
 import (
 	"errors"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -17,11 +16,18 @@ type UserSession struct {
 	Email     string  `json:"email"`
 	ProfileId string  `json:"profile_id"`        // Required: User's permissions profile
 	RoleId    *string `json:"role_id,omitempty"` // Optional: Role for hierarchy-based data sharing (Salesforce pattern)
+	UserType  string  `json:"user_type,omitempty"`
+	Locale    string  `json:"locale,omitempty"` // Preferred locale for translated metadata
 }
 
 // IsSuperUser checks if the user has super user privileges
 func (u UserSession) IsSuperUser() bool {
-	return constants.IsSuperUser(u.ProfileId)
+	return !u.IsPortal() && constants.IsSuperUser(u.ProfileId)
+}
+
+// IsPortal checks if the session belongs to an external portal user
+func (u UserSession) IsPortal() bool {
+	return u.UserType == constants.UserTypePortal
 }
 
 // Claims represents JWT claims
@@ -30,18 +36,35 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-var jwtSecret = []byte(getJWTSecret())
+// defaultJWTSecret signs tokens when JWT_SECRET is unset; never use it in production
+const defaultJWTSecret = "default-secret-change-in-production"
+
+// keyRing signs with the development secret until Configure runs
+var keyRing = NewHMACKeyRing(defaultJWTSecret)
 
-func getJWTSecret() string {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		secret = "default-secret-change-in-production"
+// Configure loads the signing keys and the issuer and audience to enforce (see LoadKeyRing)
+func Configure(cfg KeyConfig) error {
+	ring, err := LoadKeyRing(cfg)
+	if err != nil {
+		return err
 	}
-	return secret
+	SetKeyRing(ring)
+	return nil
+}
+
+// SetKeyRing replaces the keys tokens are signed and validated with
+func SetKeyRing(ring *KeyRing) {
+	keyRing = ring
+}
+
+// JWKS returns the public signing keys, served at /.well-known/jwks.json
+func JWKS() JSONWebKeySet {
+	return keyRing.JWKS()
 }
 
 // GenerateToken creates a JWT token for a user session
 func GenerateToken(session UserSession) (string, error) {
+	ring := keyRing
 	expirationTime := time.Now().Add(24 * time.Hour)
 	jti := utils.GenerateID()
 
@@ -51,32 +74,49 @@ func GenerateToken(session UserSession) (string, error) {
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ID:        jti,
+			Issuer:    ring.issuer,
 		},
 	}
+	if len(ring.audiences) > 0 {
+		claims.Audience = jwt.ClaimStrings{ring.audiences[0]}
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	token := jwt.NewWithClaims(ring.signing.method, claims)
+	token.Header["kid"] = ring.signing.kid
+	return token.SignedString(ring.signing.sign)
 }
 
-// ValidateToken validates and parses a JWT token
+// ValidateToken validates and parses a JWT token signed with any key of the ring
 func ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verify signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return jwtSecret, nil
-	})
-
+	ring := keyRing
+	options := []jwt.ParserOption{jwt.WithValidMethods(ring.validMethods())}
+	if ring.issuer != "" {
+		options = append(options, jwt.WithIssuer(ring.issuer))
+	}
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, ring.verificationKey, options...)
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if len(ring.audiences) > 0 && !hasAudience(claims.Audience, ring.audiences) {
+		return nil, errors.New("token has an invalid audience")
 	}
+	return claims, nil
+}
 
-	return nil, errors.New("invalid token")
+func hasAudience(tokenAudiences jwt.ClaimStrings, accepted []string) bool {
+	for _, aud := range tokenAudiences {
+		for _, a := range accepted {
+			if aud == a {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // DecodeToken decodes a token without validation (for extracting JTI)
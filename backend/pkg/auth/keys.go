@@ -0,0 +1,328 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported JWT signing algorithms
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// signingKey is one key of the key ring, identified by its kid
+type signingKey struct {
+	kid    string
+	method jwt.SigningMethod
+	sign   interface{}      // Private key or HMAC secret; nil for verification-only keys
+	verify interface{}      // Public key or HMAC secret
+	public crypto.PublicKey // Published in the JWKS; nil for HMAC keys
+}
+
+// KeyRing holds the key new tokens are signed with and every key tokens are still accepted
+// from, so keys can be rotated without ending the sessions signed with the previous one
+type KeyRing struct {
+	signing   *signingKey
+	keys      map[string]*signingKey
+	ordered   []*signingKey
+	issuer    string
+	audiences []string
+}
+
+// NewHMACKeyRing creates a key ring signing with HS256. Previous secrets stay valid for
+// verification.
+func NewHMACKeyRing(secret string, previous ...string) *KeyRing {
+	ring := &KeyRing{keys: make(map[string]*signingKey)}
+	ring.signing = ring.add(hmacKey(secret), true)
+	for _, p := range previous {
+		ring.add(hmacKey(p), false)
+	}
+	return ring
+}
+
+// WithClaims sets the issuer and audiences of the tokens the ring signs. Tokens are accepted
+// only with the issuer, and with any of the audiences, when those are set.
+func (r *KeyRing) WithClaims(issuer string, audiences ...string) *KeyRing {
+	r.issuer = issuer
+	r.audiences = audiences
+	return r
+}
+
+func (r *KeyRing) add(key *signingKey, signing bool) *signingKey {
+	if existing, ok := r.keys[key.kid]; ok {
+		if signing {
+			existing.sign = key.sign
+		}
+		return existing
+	}
+	if !signing {
+		key.sign = nil
+	}
+	r.keys[key.kid] = key
+	r.ordered = append(r.ordered, key)
+	return key
+}
+
+// KeyConfig holds the JWT key settings, read by internal/config:
+//
+//	Algorithm           HS256 (default), RS256 or EdDSA
+//	Secret              HS256 secret; with RS256/EdDSA it stays valid for verification
+//	PreviousSecrets     HS256 secrets still accepted
+//	PrivateKey          PEM private key for RS256/EdDSA (PKCS#1, PKCS#8)
+//	PreviousPublicKeys  PEM public keys still accepted and published in the JWKS
+//	KeyID               kid of the signing key (default: derived from the key)
+//	Issuer, Audiences   iss and aud claims to issue and require
+type KeyConfig struct {
+	Algorithm          string
+	Secret             string
+	PreviousSecrets    []string
+	PrivateKey         string
+	PreviousPublicKeys string
+	KeyID              string
+	Issuer             string
+	Audiences          []string
+}
+
+// LoadKeyRing builds the key ring from its settings
+func LoadKeyRing(cfg KeyConfig) (*KeyRing, error) {
+	algorithm := strings.TrimSpace(cfg.Algorithm)
+	if algorithm == "" {
+		algorithm = AlgorithmHS256
+	}
+	secret := cfg.Secret
+	previous := append([]string(nil), cfg.PreviousSecrets...)
+
+	var ring *KeyRing
+	switch algorithm {
+	case AlgorithmHS256:
+		if secret == "" {
+			secret = defaultJWTSecret
+		}
+		ring = NewHMACKeyRing(secret, previous...)
+	case AlgorithmRS256, AlgorithmEdDSA:
+		if cfg.PrivateKey == "" {
+			return nil, fmt.Errorf("JWT_ALGORITHM=%s requires JWT_PRIVATE_KEY or JWT_PRIVATE_KEY_FILE", algorithm)
+		}
+		key, err := parsePrivateKey([]byte(cfg.PrivateKey), algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_PRIVATE_KEY: %w", err)
+		}
+		ring = &KeyRing{keys: make(map[string]*signingKey)}
+		ring.signing = ring.add(key, true)
+		// Tokens signed before the switch from HS256 stay valid
+		if secret != "" {
+			previous = append(previous, secret)
+		}
+		for _, p := range previous {
+			ring.add(hmacKey(p), false)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JWT_ALGORITHM %q; use %s, %s or %s", algorithm, AlgorithmHS256, AlgorithmRS256, AlgorithmEdDSA)
+	}
+
+	if kid := strings.TrimSpace(cfg.KeyID); kid != "" {
+		delete(ring.keys, ring.signing.kid)
+		ring.signing.kid = kid
+		ring.keys[kid] = ring.signing
+	}
+
+	publicKeys, err := parsePublicKeys([]byte(cfg.PreviousPublicKeys))
+	if err != nil {
+		return nil, fmt.Errorf("JWT_PREVIOUS_PUBLIC_KEYS: %w", err)
+	}
+	for _, key := range publicKeys {
+		ring.add(key, false)
+	}
+
+	return ring.WithClaims(strings.TrimSpace(cfg.Issuer), cfg.Audiences...), nil
+}
+
+// Algorithm returns the algorithm new tokens are signed with
+func (r *KeyRing) Algorithm() string {
+	return r.signing.method.Alg()
+}
+
+// JSONWebKey is a public key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // OKP curve
+	X   string `json:"x,omitempty"`   // OKP public key
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys of the ring. HMAC secrets are never published.
+func (r *KeyRing) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, key := range r.ordered {
+		jwk := JSONWebKey{Kid: key.kid, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// verificationKey finds the key for a token: by kid, or for tokens issued before kids
+// were added, every key of the token's algorithm
+func (r *KeyRing) verificationKey(token *jwt.Token) (interface{}, error) {
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		key, ok := r.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		if key.method.Alg() != token.Method.Alg() {
+			return nil, fmt.Errorf("signing key %q does not use %s", kid, token.Method.Alg())
+		}
+		return key.verify, nil
+	}
+	set := jwt.VerificationKeySet{}
+	for _, key := range r.ordered {
+		if key.method.Alg() == token.Method.Alg() {
+			set.Keys = append(set.Keys, key.verify)
+		}
+	}
+	if len(set.Keys) == 0 {
+		return nil, fmt.Errorf("no key for %s tokens", token.Method.Alg())
+	}
+	return set, nil
+}
+
+// validMethods lists the algorithms of the keys in the ring
+func (r *KeyRing) validMethods() []string {
+	seen := make(map[string]bool)
+	methods := make([]string, 0, 2)
+	for _, key := range r.ordered {
+		if alg := key.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+func hmacKey(secret string) *signingKey {
+	sum := sha256.Sum256([]byte(secret))
+	return &signingKey{
+		kid:    "hs-" + hex.EncodeToString(sum[:6]),
+		method: jwt.SigningMethodHS256,
+		sign:   []byte(secret),
+		verify: []byte(secret),
+	}
+}
+
+func asymmetricKey(method jwt.SigningMethod, private, public interface{}) (*signingKey, error) {
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(der)
+	return &signingKey{
+		kid:    base64.RawURLEncoding.EncodeToString(sum[:12]),
+		method: method,
+		sign:   private,
+		verify: public,
+		public: public,
+	}, nil
+}
+
+func parsePrivateKey(data []byte, algorithm string) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	var parsed interface{}
+	var err error
+	if block.Type == "RSA PRIVATE KEY" {
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	} else {
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != AlgorithmRS256 {
+			return nil, fmt.Errorf("an RSA key needs JWT_ALGORITHM=%s", AlgorithmRS256)
+		}
+		if key.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("RSA keys must have at least 2048 bits")
+		}
+		return asymmetricKey(jwt.SigningMethodRS256, key, &key.PublicKey)
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("an Ed25519 key needs JWT_ALGORITHM=%s", AlgorithmEdDSA)
+		}
+		return asymmetricKey(jwt.SigningMethodEdDSA, key, key.Public())
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", parsed)
+	}
+}
+
+func parsePublicKeys(data []byte) ([]*signingKey, error) {
+	var keys []*signingKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return keys, nil
+		}
+		var parsed interface{}
+		var err error
+		if block.Type == "RSA PUBLIC KEY" {
+			parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		} else {
+			parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var key *signingKey
+		switch pub := parsed.(type) {
+		case *rsa.PublicKey:
+			key, err = asymmetricKey(jwt.SigningMethodRS256, nil, pub)
+		case ed25519.PublicKey:
+			key, err = asymmetricKey(jwt.SigningMethodEdDSA, nil, pub)
+		default:
+			err = fmt.Errorf("unsupported public key type %T", parsed)
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useKeyRing(t *testing.T, ring *KeyRing) {
+	previous := keyRing
+	SetKeyRing(ring)
+	t.Cleanup(func() { SetKeyRing(previous) })
+}
+
+func pemBlock(blockType string, der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+func TestKeyRing_HMACRotation(t *testing.T) {
+	useKeyRing(t, NewHMACKeyRing("old-secret"))
+	oldToken, err := GenerateToken(UserSession{ID: "u1"})
+	require.NoError(t, err)
+
+	useKeyRing(t, NewHMACKeyRing("new-secret", "old-secret"))
+	claims, err := ValidateToken(oldToken)
+	require.NoError(t, err, "tokens signed with a previous secret stay valid")
+	assert.Equal(t, "u1", claims.User.ID)
+
+	useKeyRing(t, NewHMACKeyRing("new-secret"))
+	_, err = ValidateToken(oldToken)
+	assert.Error(t, err, "tokens of a retired secret are rejected")
+}
+
+func TestKeyRing_TokensWithoutKid(t *testing.T) {
+	useKeyRing(t, NewHMACKeyRing("secret"))
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{User: UserSession{ID: "u1"}}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = ValidateToken(legacy)
+	assert.NoError(t, err)
+}
+
+func TestKeyRing_RS256(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	// Sessions signed with the HS256 secret survive the switch to RS256
+	useKeyRing(t, NewHMACKeyRing("hs-secret"))
+	hsToken, err := GenerateToken(UserSession{ID: "u1"})
+	require.NoError(t, err)
+
+	ring, err := LoadKeyRing(KeyConfig{Algorithm: AlgorithmRS256, PrivateKey: pemBlock("PRIVATE KEY", der), Secret: "hs-secret"})
+	require.NoError(t, err)
+	useKeyRing(t, ring)
+
+	rsToken, err := GenerateToken(UserSession{ID: "u2"})
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(rsToken, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, AlgorithmRS256, parsed.Method.Alg())
+
+	_, err = ValidateToken(rsToken)
+	assert.NoError(t, err)
+	_, err = ValidateToken(hsToken)
+	assert.NoError(t, err)
+
+	jwks := JWKS()
+	require.Len(t, jwks.Keys, 1, "only public keys are published")
+	assert.Equal(t, "RSA", jwks.Keys[0].Kty)
+	assert.Equal(t, parsed.Header["kid"], jwks.Keys[0].Kid)
+}
+
+func TestKeyRing_EdDSARotation(t *testing.T) {
+	oldPublic, oldPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, newPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	oldDER, err := x509.MarshalPKCS8PrivateKey(oldPrivate)
+	require.NoError(t, err)
+	newDER, err := x509.MarshalPKCS8PrivateKey(newPrivate)
+	require.NoError(t, err)
+	oldPublicDER, err := x509.MarshalPKIXPublicKey(oldPublic)
+	require.NoError(t, err)
+
+	ring, err := LoadKeyRing(KeyConfig{Algorithm: AlgorithmEdDSA, PrivateKey: pemBlock("PRIVATE KEY", oldDER)})
+	require.NoError(t, err)
+	useKeyRing(t, ring)
+	oldToken, err := GenerateToken(UserSession{ID: "u1"})
+	require.NoError(t, err)
+
+	ring, err = LoadKeyRing(KeyConfig{
+		Algorithm:          AlgorithmEdDSA,
+		PrivateKey:         pemBlock("PRIVATE KEY", newDER),
+		PreviousPublicKeys: pemBlock("PUBLIC KEY", oldPublicDER),
+	})
+	require.NoError(t, err)
+	useKeyRing(t, ring)
+
+	_, err = ValidateToken(oldToken)
+	assert.NoError(t, err, "the previous key still verifies")
+	assert.Len(t, JWKS().Keys, 2)
+}
+
+func TestKeyRing_IssuerAndAudience(t *testing.T) {
+	useKeyRing(t, NewHMACKeyRing("secret").WithClaims("https://crm.example.com", "nexuscrm", "reports"))
+	token, err := GenerateToken(UserSession{ID: "u1"})
+	require.NoError(t, err)
+	claims, err := ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "https://crm.example.com", claims.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{"nexuscrm"}, claims.Audience)
+
+	useKeyRing(t, NewHMACKeyRing("secret").WithClaims("https://other.example.com"))
+	_, err = ValidateToken(token)
+	assert.Error(t, err, "issuer mismatch")
+
+	useKeyRing(t, NewHMACKeyRing("secret").WithClaims("https://crm.example.com", "billing"))
+	_, err = ValidateToken(token)
+	assert.ErrorContains(t, err, "audience")
+}
+
+func TestLoadKeyRing_Errors(t *testing.T) {
+	_, err := LoadKeyRing(KeyConfig{Algorithm: "none"})
+	assert.ErrorContains(t, err, "unsupported JWT_ALGORITHM")
+
+	_, err = LoadKeyRing(KeyConfig{Algorithm: AlgorithmRS256})
+	assert.ErrorContains(t, err, "requires JWT_PRIVATE_KEY")
+}
@@ -0,0 +1,192 @@
+// Package charts renders simple category charts (bar, line, pie) to PNG or SVG for use
+// outside the browser, e.g. in emails and exports.
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	chart "github.com/wcharczuk/go-chart/v2"
+)
+
+// Kind is the chart type
+type Kind string
+
+const (
+	KindBar  Kind = "bar"
+	KindLine Kind = "line"
+	KindPie  Kind = "pie"
+)
+
+// Format is the output image format
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+const (
+	DefaultWidth  = 800
+	DefaultHeight = 400
+	MinSize       = 100
+	MaxSize       = 2000
+)
+
+// ErrNoData is returned when a chart has nothing to plot
+var ErrNoData = errors.New("no data to chart")
+
+// Point is one category of a chart
+type Point struct {
+	Label string
+	Value float64
+}
+
+// Chart describes a chart to render
+type Chart struct {
+	Kind   Kind
+	Title  string
+	Points []Point
+	Width  int // Pixels; 0 uses DefaultWidth
+	Height int // Pixels; 0 uses DefaultHeight
+}
+
+// ParseFormat parses an image format name, defaulting to PNG
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case "", FormatPNG:
+		return FormatPNG, nil
+	case FormatSVG:
+		return FormatSVG, nil
+	}
+	return "", fmt.Errorf("unsupported image format '%s'", s)
+}
+
+// ParseKind parses a chart type name, defaulting to bar
+func ParseKind(s string) (Kind, error) {
+	switch Kind(strings.ToLower(s)) {
+	case "", KindBar:
+		return KindBar, nil
+	case KindLine:
+		return KindLine, nil
+	case KindPie:
+		return KindPie, nil
+	}
+	return "", fmt.Errorf("unsupported chart type '%s'", s)
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == FormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+// Render draws the chart in the given format
+func Render(w io.Writer, c Chart, format Format) error {
+	if len(c.Points) == 0 {
+		return ErrNoData
+	}
+	if c.Width == 0 {
+		c.Width = DefaultWidth
+	}
+	if c.Height == 0 {
+		c.Height = DefaultHeight
+	}
+	if c.Width < MinSize || c.Width > MaxSize || c.Height < MinSize || c.Height > MaxSize {
+		return fmt.Errorf("chart size must be between %d and %d pixels", MinSize, MaxSize)
+	}
+
+	provider := chart.PNG
+	if format == FormatSVG {
+		provider = chart.SVG
+	}
+
+	switch c.Kind {
+	case KindPie:
+		return renderPie(w, c, provider)
+	case KindLine:
+		// A line needs two points; a single category is drawn as a bar
+		if len(c.Points) > 1 {
+			return renderLine(w, c, provider)
+		}
+	}
+	return renderBar(w, c, provider)
+}
+
+func renderBar(w io.Writer, c Chart, provider chart.RendererProvider) error {
+	bars := make([]chart.Value, 0, len(c.Points))
+	for _, p := range c.Points {
+		bars = append(bars, chart.Value{Label: p.Label, Value: p.Value})
+	}
+	bc := chart.BarChart{
+		Title:  c.Title,
+		Width:  c.Width,
+		Height: c.Height,
+		Bars:   bars,
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		YAxis: chart.YAxis{Range: valueRange(c.Points)},
+	}
+	return bc.Render(provider, w)
+}
+
+func renderLine(w io.Writer, c Chart, provider chart.RendererProvider) error {
+	xs := make([]float64, len(c.Points))
+	ys := make([]float64, len(c.Points))
+	ticks := make([]chart.Tick, len(c.Points))
+	for i, p := range c.Points {
+		xs[i], ys[i] = float64(i), p.Value
+		ticks[i] = chart.Tick{Value: float64(i), Label: p.Label}
+	}
+	lc := chart.Chart{
+		Title:  c.Title,
+		Width:  c.Width,
+		Height: c.Height,
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis:  chart.XAxis{Ticks: ticks},
+		YAxis:  chart.YAxis{Range: valueRange(c.Points)},
+		Series: []chart.Series{chart.ContinuousSeries{XValues: xs, YValues: ys}},
+	}
+	return lc.Render(provider, w)
+}
+
+func renderPie(w io.Writer, c Chart, provider chart.RendererProvider) error {
+	// Slices must be positive; empty and negative categories are left out
+	values := make([]chart.Value, 0, len(c.Points))
+	for _, p := range c.Points {
+		if p.Value > 0 {
+			values = append(values, chart.Value{Label: p.Label, Value: p.Value})
+		}
+	}
+	if len(values) == 0 {
+		return ErrNoData
+	}
+	pc := chart.PieChart{
+		Title:  c.Title,
+		Width:  c.Width,
+		Height: c.Height,
+		Values: values,
+	}
+	return pc.Render(provider, w)
+}
+
+// valueRange spans zero and all values, so equal values still produce a drawable axis
+func valueRange(points []Point) *chart.ContinuousRange {
+	lo, hi := 0.0, 0.0
+	for _, p := range points {
+		lo = math.Min(lo, p.Value)
+		hi = math.Max(hi, p.Value)
+	}
+	if lo == hi {
+		hi = lo + 1
+	}
+	return &chart.ContinuousRange{Min: lo, Max: hi}
+}
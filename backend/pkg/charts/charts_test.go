@@ -0,0 +1,48 @@
+package charts
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	points := []Point{{Label: "Open", Value: 3}, {Label: "Won", Value: 5}, {Label: "Lost", Value: 0}}
+
+	for _, kind := range []Kind{KindBar, KindLine, KindPie} {
+		var png bytes.Buffer
+		assert.NoError(t, Render(&png, Chart{Kind: kind, Title: "Stages", Points: points}, FormatPNG), kind)
+		assert.True(t, bytes.HasPrefix(png.Bytes(), []byte("\x89PNG")), kind)
+
+		var svg bytes.Buffer
+		assert.NoError(t, Render(&svg, Chart{Kind: kind, Points: points}, FormatSVG), kind)
+		assert.True(t, strings.Contains(svg.String(), "<svg"), kind)
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, Render(&buf, Chart{Kind: KindLine, Points: points[:1]}, FormatPNG), "single point falls back to a bar")
+	assert.NoError(t, Render(&buf, Chart{Kind: KindBar, Points: []Point{{Label: "a", Value: 0}, {Label: "b", Value: 0}}}, FormatPNG))
+
+	assert.ErrorIs(t, Render(&buf, Chart{Kind: KindBar}, FormatPNG), ErrNoData)
+	assert.ErrorIs(t, Render(&buf, Chart{Kind: KindPie, Points: points[2:]}, FormatPNG), ErrNoData)
+	assert.Error(t, Render(&buf, Chart{Kind: KindBar, Points: points, Width: 5000}, FormatPNG))
+}
+
+func TestParse(t *testing.T) {
+	f, err := ParseFormat("SVG")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/svg+xml", f.ContentType())
+	f, err = ParseFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatPNG, f)
+	_, err = ParseFormat("gif")
+	assert.Error(t, err)
+
+	k, err := ParseKind("")
+	assert.NoError(t, err)
+	assert.Equal(t, KindBar, k)
+	_, err = ParseKind("radar")
+	assert.Error(t, err)
+}
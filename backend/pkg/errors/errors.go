@@ -4,15 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/nexuscrm/shared/pkg/constants"
 )
 
-// AppError is the base interface for all application errors
+// AppError is the base interface for all application errors. Code returns one of the
+// codes registered in shared/constants/errorCodes.json (constants.ErrorCode*).
 type AppError interface {
 	error
 	HTTPStatus() int
 	Code() string
 }
 
+// FieldError is implemented by errors about a single input field
+type FieldError interface {
+	FieldPath() string
+}
+
 // NotFoundError represents a resource that was not found
 type NotFoundError struct {
 	Resource string
@@ -31,7 +40,7 @@ func (e *NotFoundError) HTTPStatus() int {
 }
 
 func (e *NotFoundError) Code() string {
-	return "NOT_FOUND"
+	return constants.ErrorCodeNotFound
 }
 
 // NewNotFoundError creates a new NotFoundError
@@ -41,9 +50,10 @@ func NewNotFoundError(resource, id string) *NotFoundError {
 
 // ValidationError represents invalid input
 type ValidationError struct {
-	Field   string
+	Field   string // Path of the invalid input, e.g. "email" or "records[2].name"
 	Message string
 	Value   interface{}
+	Reason  string // Registered code refining VALIDATION_ERROR, e.g. REQUIRED_FIELD_MISSING
 }
 
 func (e *ValidationError) Error() string {
@@ -58,7 +68,14 @@ func (e *ValidationError) HTTPStatus() int {
 }
 
 func (e *ValidationError) Code() string {
-	return "VALIDATION_ERROR"
+	if e.Reason != "" {
+		return e.Reason
+	}
+	return constants.ErrorCodeValidationError
+}
+
+func (e *ValidationError) FieldPath() string {
+	return e.Field
 }
 
 // NewValidationError creates a new ValidationError
@@ -66,6 +83,33 @@ func NewValidationError(field, message string) *ValidationError {
 	return &ValidationError{Field: field, Message: message}
 }
 
+// NewFieldValidationError creates a ValidationError with a more specific registered code
+func NewFieldValidationError(code, field, message string) *ValidationError {
+	return &ValidationError{Field: field, Message: message, Reason: code}
+}
+
+// ValidationErrors collects the validation errors of several fields
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+func (e ValidationErrors) Code() string {
+	if len(e) == 1 {
+		return e[0].Code()
+	}
+	return constants.ErrorCodeValidationError
+}
+
 // PermissionError represents insufficient permissions
 type PermissionError struct {
 	Action   string
@@ -82,7 +126,7 @@ func (e *PermissionError) HTTPStatus() int {
 }
 
 func (e *PermissionError) Code() string {
-	return "PERMISSION_DENIED"
+	return constants.ErrorCodePermissionDenied
 }
 
 // NewPermissionError creates a new PermissionError
@@ -107,7 +151,7 @@ func (e *UnauthorizedError) HTTPStatus() int {
 }
 
 func (e *UnauthorizedError) Code() string {
-	return "UNAUTHORIZED"
+	return constants.ErrorCodeUnauthorized
 }
 
 // NewUnauthorizedError creates a new UnauthorizedError
@@ -115,6 +159,31 @@ func NewUnauthorizedError(reason string) *UnauthorizedError {
 	return &UnauthorizedError{Reason: reason}
 }
 
+// ForbiddenError represents an authenticated user denied a restricted resource
+type ForbiddenError struct {
+	Reason string
+}
+
+func (e *ForbiddenError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("forbidden: %s", e.Reason)
+	}
+	return "forbidden"
+}
+
+func (e *ForbiddenError) HTTPStatus() int {
+	return http.StatusForbidden
+}
+
+func (e *ForbiddenError) Code() string {
+	return constants.ErrorCodeForbidden
+}
+
+// NewForbiddenError creates a new ForbiddenError
+func NewForbiddenError(reason string) *ForbiddenError {
+	return &ForbiddenError{Reason: reason}
+}
+
 // ConflictError represents a conflict with existing data
 type ConflictError struct {
 	Resource string
@@ -134,7 +203,7 @@ func (e *ConflictError) HTTPStatus() int {
 }
 
 func (e *ConflictError) Code() string {
-	return "CONFLICT"
+	return constants.ErrorCodeConflict
 }
 
 // NewConflictError creates a new ConflictError
@@ -142,6 +211,108 @@ func NewConflictError(resource, field, value string) *ConflictError {
 	return &ConflictError{Resource: resource, Field: field, Value: value}
 }
 
+// DependencyError represents a deletion prevented by components that still reference the resource
+type DependencyError struct {
+	Resource   string
+	Dependents []string
+}
+
+// dependencyErrorListLimit caps the dependents named in a DependencyError message
+const dependencyErrorListLimit = 5
+
+func (e *DependencyError) Error() string {
+	names := e.Dependents
+	more := ""
+	if len(names) > dependencyErrorListLimit {
+		more = fmt.Sprintf(" and %d more", len(names)-dependencyErrorListLimit)
+		names = names[:dependencyErrorListLimit]
+	}
+	return fmt.Sprintf("%s cannot be deleted: it is referenced by %s%s", e.Resource, strings.Join(names, ", "), more)
+}
+
+func (e *DependencyError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+func (e *DependencyError) Code() string {
+	return constants.ErrorCodeDependencyConflict
+}
+
+// NewDependencyError creates a new DependencyError
+func NewDependencyError(resource string, dependents []string) *DependencyError {
+	return &DependencyError{Resource: resource, Dependents: dependents}
+}
+
+// LimitExceededError represents a request stopped by a governor limit
+type LimitExceededError struct {
+	Limit   string // Name of the limit, e.g. "max_rows"
+	Max     int64  // Configured value of the limit
+	Message string
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.Message
+}
+
+func (e *LimitExceededError) HTTPStatus() int {
+	return http.StatusTooManyRequests
+}
+
+func (e *LimitExceededError) Code() string {
+	return constants.ErrorCodeLimitExceeded
+}
+
+// NewLimitExceededError creates a new LimitExceededError
+func NewLimitExceededError(limit string, max int64, message string) *LimitExceededError {
+	return &LimitExceededError{Limit: limit, Max: max, Message: message}
+}
+
+// PayloadTooLargeError represents a request body over the size limit of its route
+type PayloadTooLargeError struct {
+	Max int64 // Limit in bytes
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("request body exceeds the limit of %d bytes", e.Max)
+}
+
+func (e *PayloadTooLargeError) HTTPStatus() int {
+	return http.StatusRequestEntityTooLarge
+}
+
+func (e *PayloadTooLargeError) Code() string {
+	return constants.ErrorCodePayloadTooLarge
+}
+
+// NewPayloadTooLargeError creates a new PayloadTooLargeError
+func NewPayloadTooLargeError(max int64) *PayloadTooLargeError {
+	return &PayloadTooLargeError{Max: max}
+}
+
+// TriggerRecursionError represents record automation stopped because it recursed too
+// deeply or re-triggered itself on the same record
+type TriggerRecursionError struct {
+	Chain  []string // Automation that led here, outermost first, e.g. "flow Set Stage on lead/123"
+	Reason string
+}
+
+func (e *TriggerRecursionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, strings.Join(e.Chain, " → "))
+}
+
+func (e *TriggerRecursionError) HTTPStatus() int {
+	return http.StatusUnprocessableEntity
+}
+
+func (e *TriggerRecursionError) Code() string {
+	return constants.ErrorCodeTriggerRecursion
+}
+
+// NewTriggerRecursionError creates a new TriggerRecursionError
+func NewTriggerRecursionError(reason string, chain []string) *TriggerRecursionError {
+	return &TriggerRecursionError{Reason: reason, Chain: chain}
+}
+
 // InternalError represents unexpected server errors
 type InternalError struct {
 	Message string
@@ -160,7 +331,7 @@ func (e *InternalError) HTTPStatus() int {
 }
 
 func (e *InternalError) Code() string {
-	return "INTERNAL_ERROR"
+	return constants.ErrorCodeInternalError
 }
 
 func (e *InternalError) Unwrap() error {
@@ -180,10 +351,11 @@ func IsNotFound(err error) bool {
 	return errors.As(err, &notFound)
 }
 
-// IsValidation checks if an error is a ValidationError
+// IsValidation checks if an error is a ValidationError or ValidationErrors
 func IsValidation(err error) bool {
 	var validation *ValidationError
-	return errors.As(err, &validation)
+	var validations ValidationErrors
+	return errors.As(err, &validation) || errors.As(err, &validations)
 }
 
 // IsPermission checks if an error is a PermissionError
@@ -204,6 +376,18 @@ func IsConflict(err error) bool {
 	return errors.As(err, &conflict)
 }
 
+// IsLimitExceeded checks if an error is a LimitExceededError
+func IsLimitExceeded(err error) bool {
+	var limit *LimitExceededError
+	return errors.As(err, &limit)
+}
+
+// IsTriggerRecursion checks if an error is a TriggerRecursionError
+func IsTriggerRecursion(err error) bool {
+	var recursion *TriggerRecursionError
+	return errors.As(err, &recursion)
+}
+
 // GetHTTPStatus returns the HTTP status code for an error
 // Returns 500 if the error doesn't implement AppError
 func GetHTTPStatus(err error) int {
@@ -221,20 +405,40 @@ func GetErrorCode(err error) string {
 	if errors.As(err, &appErr) {
 		return appErr.Code()
 	}
-	return "UNKNOWN_ERROR"
+	return constants.ErrorCodeUnknownError
 }
 
-// ErrorResponse represents a standardized error response
+// ErrorResponse is the error body every API handler returns. Clients branch on Code and
+// Field, and localize with I18nKey; Message is for people and may change.
 type ErrorResponse struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details any    `json:"details,omitempty"`
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Field   string          `json:"field,omitempty"`
+	I18nKey string          `json:"i18n_key,omitempty"`
+	Details any             `json:"details,omitempty"`
+	Errors  []ErrorResponse `json:"errors,omitempty"` // One entry per field when several are invalid
 }
 
 // ToResponse converts an error to an ErrorResponse
 func ToResponse(err error) ErrorResponse {
-	return ErrorResponse{
-		Code:    GetErrorCode(err),
+	code := GetErrorCode(err)
+	resp := ErrorResponse{
+		Code:    code,
 		Message: err.Error(),
+		I18nKey: constants.ErrorCodeI18nKeys[code],
+	}
+	var fieldErr FieldError
+	if errors.As(err, &fieldErr) {
+		resp.Field = fieldErr.FieldPath()
+	}
+	var validations ValidationErrors
+	if errors.As(err, &validations) {
+		for _, v := range validations {
+			resp.Errors = append(resp.Errors, ToResponse(v))
+		}
+		if len(validations) > 0 {
+			resp.Field = validations[0].Field
+		}
 	}
+	return resp
 }
@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToResponse(t *testing.T) {
+	t.Run("field error", func(t *testing.T) {
+		err := fmt.Errorf("saving lead: %w", NewFieldValidationError(constants.ErrorCodeRequiredFieldMissing, "email", "is required"))
+
+		resp := ToResponse(err)
+		assert.Equal(t, constants.ErrorCodeRequiredFieldMissing, resp.Code)
+		assert.Equal(t, "email", resp.Field)
+		assert.Equal(t, "errors.requiredFieldMissing", resp.I18nKey)
+		assert.Equal(t, http.StatusBadRequest, GetHTTPStatus(err))
+	})
+
+	t.Run("several fields", func(t *testing.T) {
+		err := ValidationErrors{
+			NewFieldValidationError(constants.ErrorCodeRequiredFieldMissing, "name", "is required"),
+			NewFieldValidationError(constants.ErrorCodeInvalidFieldValue, "records[1].amount", "expected number"),
+		}
+
+		resp := ToResponse(err)
+		assert.Equal(t, constants.ErrorCodeValidationError, resp.Code)
+		assert.Equal(t, "name", resp.Field)
+		if assert.Len(t, resp.Errors, 2) {
+			assert.Equal(t, constants.ErrorCodeInvalidFieldValue, resp.Errors[1].Code)
+			assert.Equal(t, "records[1].amount", resp.Errors[1].Field)
+		}
+		assert.True(t, IsValidation(err))
+	})
+
+	t.Run("unclassified error", func(t *testing.T) {
+		resp := ToResponse(fmt.Errorf("boom"))
+		assert.Equal(t, constants.ErrorCodeUnknownError, resp.Code)
+		assert.Empty(t, resp.Field)
+		assert.Equal(t, "errors.unknownError", resp.I18nKey)
+	})
+}
+
+func TestErrorCodesAreRegistered(t *testing.T) {
+	errs := []AppError{
+		NewNotFoundError("Lead", "1"),
+		NewValidationError("name", "is required"),
+		NewPermissionError("edit", "Lead"),
+		NewUnauthorizedError(""),
+		NewForbiddenError(""),
+		NewConflictError("Lead", "name", "Acme"),
+		NewDependencyError("Lead", nil),
+		NewLimitExceededError("max_rows", 10, "too many rows"),
+		NewPayloadTooLargeError(1024),
+		NewTriggerRecursionError("loop", nil),
+		NewInternalError("boom", nil),
+	}
+	for _, err := range errs {
+		status, ok := constants.ErrorCodeStatus[err.Code()]
+		if assert.True(t, ok, "%T returns unregistered code %s", err, err.Code()) {
+			assert.Equal(t, status, err.HTTPStatus(), "%T", err)
+		}
+	}
+}
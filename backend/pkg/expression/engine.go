@@ -51,6 +51,40 @@ func (e *Engine) RegisterFunction(name string, fn func(params ...interface{}) (i
 	e.programCache = make(map[string]*vm.Program)
 }
 
+// builtinFunctions are the functions every engine defines
+var builtinFunctions = map[string]bool{
+	"TODAY": true, "NOW": true, "LEN": true, "UPPER": true, "LOWER": true,
+	"ROUND": true, "IF": true, "DATE_ADD": true, "DISTANCE": true,
+}
+
+var (
+	globalMu        sync.RWMutex
+	globalFunctions = make(map[string]func(params ...interface{}) (interface{}, error))
+)
+
+// RegisterGlobalFunction makes fn available to every engine, including engines already
+// created. Cached programs stay valid: none of them can call a function that did not exist
+// when it compiled. A name taken by a built-in or another global function is rejected.
+func RegisterGlobalFunction(name string, fn func(params ...interface{}) (interface{}, error)) error {
+	if name == "" || fn == nil {
+		return fmt.Errorf("a function needs a name and an implementation")
+	}
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	if builtinFunctions[name] || globalFunctions[name] != nil {
+		return fmt.Errorf("function %s is already defined", name)
+	}
+	globalFunctions[name] = fn
+	return nil
+}
+
+// IsFunctionDefined reports whether name is a built-in or global function
+func IsFunctionDefined(name string) bool {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return builtinFunctions[name] || globalFunctions[name] != nil
+}
+
 func (e *Engine) getProgram(expression string, env map[string]interface{}) (*vm.Program, error) {
 	e.mu.RLock()
 	if prog, ok := e.programCache[expression]; ok {
@@ -70,12 +104,22 @@ func (e *Engine) getProgram(expression string, env map[string]interface{}) (*vm.
 	// Define standard functions
 	options := []expr.Option{
 		expr.Env(env),
+		// TODAY and NOW are typed so the date arithmetic operators below apply to them
 		expr.Function("TODAY", func(params ...interface{}) (interface{}, error) {
 			return time.Now().Format("2006-01-02"), nil
-		}),
+		}, new(func() string)),
 		expr.Function("NOW", func(params ...interface{}) (interface{}, error) {
 			return time.Now().Format("2006-01-02 15:04:05"), nil
-		}),
+		}, new(func() string)),
+		// date + days and date - days, e.g. TODAY() + 7
+		expr.Function(addDaysOperator, func(params ...interface{}) (interface{}, error) {
+			return addDays(params[0].(string), params[1].(int))
+		}, new(func(string, int) string)),
+		expr.Function(subtractDaysOperator, func(params ...interface{}) (interface{}, error) {
+			return addDays(params[0].(string), -params[1].(int))
+		}, new(func(string, int) string)),
+		expr.Operator("+", addDaysOperator),
+		expr.Operator("-", subtractDaysOperator),
 		expr.Function("LEN", func(params ...interface{}) (interface{}, error) {
 			if len(params) != 1 {
 				return nil, fmt.Errorf("LEN requires 1 argument")
@@ -165,9 +209,17 @@ func (e *Engine) getProgram(expression string, env map[string]interface{}) (*vm.
 			result := t.AddDate(0, 0, days)
 			return result.Format("2006-01-02"), nil
 		}),
+		expr.Function("DISTANCE", distance),
 	}
 
-	// Add custom functions
+	// Add global functions, then the engine's own, which take precedence
+	globalMu.RLock()
+	for name, fn := range globalFunctions {
+		if e.functions[name] == nil {
+			options = append(options, expr.Function(name, fn))
+		}
+	}
+	globalMu.RUnlock()
 	for name, fn := range e.functions {
 		options = append(options, expr.Function(name, fn))
 	}
@@ -223,3 +275,19 @@ func toInt(v interface{}) (int, error) {
 	}
 	return 0, fmt.Errorf("cannot convert %T to int", v)
 }
+
+// Date arithmetic overloads of + and - for date strings and day counts
+const (
+	addDaysOperator      = "_addDays"
+	subtractDaysOperator = "_subtractDays"
+)
+
+// addDays shifts a date or datetime string by a number of days, keeping its format
+func addDays(value string, days int) (string, error) {
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.AddDate(0, 0, days).Format(layout), nil
+		}
+	}
+	return "", fmt.Errorf("cannot add days to %q: not a date", value)
+}
@@ -41,6 +41,18 @@ func TestEngine_Evaluate(t *testing.T) {
 			env:      nil,
 			expected: time.Now().Format("2006-01-02"),
 		},
+		{
+			name:     "Date Arithmetic",
+			expr:     "TODAY() + 7",
+			env:      nil,
+			expected: time.Now().AddDate(0, 0, 7).Format("2006-01-02"),
+		},
+		{
+			name:     "Date Field Arithmetic",
+			expr:     "close_date - 1",
+			env:      map[string]interface{}{"close_date": "2024-03-01"},
+			expected: "2024-02-29",
+		},
 		{
 			name:     "String Function",
 			expr:     "LEN(name)",
@@ -59,6 +71,12 @@ func TestEngine_Evaluate(t *testing.T) {
 			env:      map[string]interface{}{"amount": 2000},
 			expected: true, // 200 > 100
 		},
+		{
+			name:     "Distance Function",
+			expr:     "DISTANCE(location, 48.8566, 2.3522) < 400",
+			env:      map[string]interface{}{"location": map[string]interface{}{"latitude": 51.5074, "longitude": -0.1278}},
+			expected: true, // London to Paris is ~344 km
+		},
 	}
 
 	for _, tt := range tests {
@@ -73,3 +91,12 @@ func TestEngine_Evaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestHaversineKm(t *testing.T) {
+	assert.InDelta(t, 343.5, HaversineKm(51.5074, -0.1278, 48.8566, 2.3522), 1)
+	assert.Equal(t, 0.0, HaversineKm(10, 20, 10, 20))
+
+	d, err := distance(nil, 1, 2)
+	assert.NoError(t, err)
+	assert.Nil(t, d, "a blank location has no distance")
+}
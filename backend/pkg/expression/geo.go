@@ -0,0 +1,74 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// EarthRadiusKm is the mean Earth radius DISTANCE uses
+const EarthRadiusKm = 6371.0
+
+// HaversineKm returns the great-circle distance in kilometres between two points in decimal degrees
+func HaversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Pow(math.Sin(dLng/2), 2)
+	return EarthRadiusKm * 2 * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// distance implements DISTANCE(location, lat, lng) for in-memory evaluation. A blank
+// location has no distance (nil).
+func distance(params ...interface{}) (interface{}, error) {
+	if len(params) != 3 {
+		return nil, fmt.Errorf("DISTANCE requires 3 arguments (field, latitude, longitude)")
+	}
+	point, err := geoPoint(params[0])
+	if err != nil || point == nil {
+		return nil, err
+	}
+	lat, err := toFloat(params[1])
+	if err != nil {
+		return nil, fmt.Errorf("DISTANCE latitude must be number")
+	}
+	lng, err := toFloat(params[2])
+	if err != nil {
+		return nil, fmt.Errorf("DISTANCE longitude must be number")
+	}
+	return HaversineKm(point.Latitude, point.Longitude, lat, lng), nil
+}
+
+// geoPoint reads a Geolocation value as stored on a record
+func geoPoint(val interface{}) (*models.GeoPoint, error) {
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case models.GeoPoint:
+		return &v, nil
+	case *models.GeoPoint:
+		return v, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		var point models.GeoPoint
+		if err := json.Unmarshal([]byte(v), &point); err != nil {
+			return nil, fmt.Errorf("DISTANCE field must be a location")
+		}
+		return &point, nil
+	case map[string]interface{}:
+		if v["latitude"] == nil || v["longitude"] == nil {
+			return nil, nil
+		}
+		lat, latErr := toFloat(v["latitude"])
+		lng, lngErr := toFloat(v["longitude"])
+		if latErr != nil || lngErr != nil {
+			return nil, fmt.Errorf("DISTANCE field must be a location")
+		}
+		return &models.GeoPoint{Latitude: lat, Longitude: lng}, nil
+	}
+	return nil, fmt.Errorf("DISTANCE field must be a location")
+}
@@ -0,0 +1,71 @@
+package expression
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+)
+
+// References returns the field references of an expression, such as "amount" or
+// "account_id.name", sorted and without duplicates. Function names and null literals
+// are not references.
+func References(expression string) ([]string, error) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse expression: %w", err)
+	}
+	c := &referenceCollector{refs: make(map[ast.Node]string)}
+	ast.Walk(&tree.Node, c)
+
+	seen := make(map[string]bool, len(c.refs))
+	refs := make([]string, 0, len(c.refs))
+	for _, ref := range c.refs {
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	sort.Strings(refs)
+	return refs, nil
+}
+
+// referenceCollector gathers identifiers and member paths. Walk visits children before
+// their parent, so a parent replaces the references its children contributed.
+type referenceCollector struct {
+	refs map[ast.Node]string
+}
+
+func (c *referenceCollector) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IdentifierNode:
+		if !isNilNode(n) {
+			c.refs[n] = n.Value
+		}
+	case *ast.MemberNode:
+		path, ok := memberPath(n)
+		if !ok {
+			return
+		}
+		c.forget(n.Node)
+		c.refs[n] = path
+	case *ast.CallNode:
+		c.forget(n.Callee)
+	}
+}
+
+// forget drops the reference recorded for a node and, for member chains, its inner nodes
+func (c *referenceCollector) forget(node ast.Node) {
+	delete(c.refs, node)
+	if m, ok := node.(*ast.MemberNode); ok {
+		c.forget(m.Node)
+	}
+}
+
+// ReferencesField reports whether a reference path starts with the given field
+func ReferencesField(ref, field string) bool {
+	first, _, _ := strings.Cut(ref, ".")
+	return strings.EqualFold(first, field)
+}
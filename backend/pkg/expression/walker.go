@@ -6,13 +6,20 @@ import (
 
 	"github.com/expr-lang/expr/ast"
 	"github.com/expr-lang/expr/parser"
+	"github.com/nexuscrm/backend/pkg/query"
+	"github.com/nexuscrm/shared/pkg/constants"
 )
 
+// IdentifierResolver maps a field reference such as "amount" or "account_id.name"
+// to the SQL column expression it should be rendered as
+type IdentifierResolver func(ref string) (string, error)
+
 // SQLWalker converts an expr AST to SQL
 type SQLWalker struct {
 	builder strings.Builder
 	args    []interface{}
 	err     error
+	resolve IdentifierResolver
 }
 
 // isNilNode checks if a node represents a null/nil value
@@ -30,6 +37,13 @@ func isNilNode(node ast.Node) bool {
 
 // ToSQL converts an expression string to a SQL WHERE clause and arguments
 func ToSQL(expression string) (string, []interface{}, error) {
+	return ToSQLWithResolver(expression, nil)
+}
+
+// ToSQLWithResolver converts an expression string to a SQL WHERE clause, rendering every
+// field reference (including dotted paths across relationships) through resolve.
+// A nil resolver emits identifiers as written and rejects dotted paths.
+func ToSQLWithResolver(expression string, resolve IdentifierResolver) (string, []interface{}, error) {
 	// Parse the expression using expr parser directly to get AST
 	// We use standard parser.Parse because we just need the tree structure
 	tree, err := parser.Parse(expression)
@@ -38,7 +52,8 @@ func ToSQL(expression string) (string, []interface{}, error) {
 	}
 
 	walker := &SQLWalker{
-		args: make([]interface{}, 0),
+		args:    make([]interface{}, 0),
+		resolve: resolve,
 	}
 
 	// Start manual traversal
@@ -51,6 +66,18 @@ func ToSQL(expression string) (string, []interface{}, error) {
 	return walker.builder.String(), walker.args, nil
 }
 
+// render walks a node on its own and returns its SQL, so dialect templates can place it.
+// Its arguments are appended as usual, in the order the node is rendered.
+func (w *SQLWalker) render(node ast.Node) string {
+	outer := w.builder.String()
+	w.builder.Reset()
+	w.walk(&node)
+	sql := w.builder.String()
+	w.builder.Reset()
+	w.builder.WriteString(outer)
+	return sql
+}
+
 // Visit implements ast.Visitor
 // Note: Based on probe test, signature must be Visit(node *ast.Node)
 func (w *SQLWalker) walk(node *ast.Node) {
@@ -67,7 +94,14 @@ func (w *SQLWalker) walk(node *ast.Node) {
 	case *ast.BinaryNode:
 		w.visitBinary(v)
 	case *ast.IdentifierNode:
-		w.builder.WriteString(v.Value)
+		w.writeIdentifier(v.Value)
+	case *ast.MemberNode:
+		path, ok := memberPath(v)
+		if !ok || w.resolve == nil {
+			w.err = fmt.Errorf("unsupported node type: %T", n)
+			return
+		}
+		w.writeIdentifier(path)
 	case *ast.IntegerNode:
 		w.builder.WriteString("?")
 		w.args = append(w.args, v.Value)
@@ -89,6 +123,39 @@ func (w *SQLWalker) walk(node *ast.Node) {
 	}
 }
 
+// writeIdentifier writes a field reference, resolving it when a resolver is set
+func (w *SQLWalker) writeIdentifier(ref string) {
+	if w.resolve == nil {
+		w.builder.WriteString(ref)
+		return
+	}
+	col, err := w.resolve(ref)
+	if err != nil {
+		w.err = err
+		return
+	}
+	w.builder.WriteString(col)
+}
+
+// memberPath flattens a member access chain such as account_id.owner_id.name into a dotted path
+func memberPath(node *ast.MemberNode) (string, bool) {
+	prop, ok := node.Property.(*ast.StringNode)
+	if !ok || node.Method || node.Optional {
+		return "", false
+	}
+	switch parent := node.Node.(type) {
+	case *ast.IdentifierNode:
+		return parent.Value + "." + prop.Value, true
+	case *ast.MemberNode:
+		base, ok := memberPath(parent)
+		if !ok {
+			return "", false
+		}
+		return base + "." + prop.Value, true
+	}
+	return "", false
+}
+
 func (w *SQLWalker) visitBinary(node *ast.BinaryNode) {
 	// Check for null comparisons which need special SQL syntax
 	// Note: In expr-lang, null can be either NilNode or IdentifierNode with value "null"/"nil"
@@ -189,26 +256,21 @@ func (w *SQLWalker) visitCall(node *ast.CallNode) {
 		w.builder.WriteString(")")
 
 	case "TODAY":
-		// TODAY() -> CURDATE()
-		w.builder.WriteString("CURDATE()")
+		w.builder.WriteString(query.ActiveDialect().CurrentDate())
 
 	case "NOW":
 		// NOW() -> NOW()
 		w.builder.WriteString("NOW()")
 
 	case "DATE_ADD":
-		// DATE_ADD(date, days) -> DATE_ADD(date_sql, INTERVAL ? DAY)
+		// DATE_ADD(date, days) -> the dialect's date arithmetic, e.g. DATE_ADD(date_sql, INTERVAL ? DAY)
 		if len(node.Arguments) != 2 {
 			w.err = fmt.Errorf("DATE_ADD requires 2 arguments")
 			return
 		}
-		w.builder.WriteString("DATE_ADD(")
-		arg0 := node.Arguments[0]
-		w.walk(&arg0)
-		w.builder.WriteString(", INTERVAL ")
-		arg1 := node.Arguments[1]
-		w.walk(&arg1)
-		w.builder.WriteString(" DAY)")
+		date := w.render(node.Arguments[0])
+		days := w.render(node.Arguments[1])
+		w.builder.WriteString(query.ActiveDialect().AddDays(date, days))
 
 	case "CONTAINS":
 		// CONTAINS(field, 'text') -> field LIKE '%text%'
@@ -262,11 +324,74 @@ func (w *SQLWalker) visitCall(node *ast.CallNode) {
 		w.builder.WriteString("?")
 		w.args = append(w.args, "%"+strArg.Value)
 
+	case "DISTANCE":
+		// DISTANCE(location, lat, lng) -> great-circle (haversine) distance in km between a
+		// Geolocation field's coordinate columns and a point
+		w.visitDistance(node)
+
 	default:
 		w.err = fmt.Errorf("unsupported function: %s", callee.Value)
 	}
 }
 
+// visitDistance renders DISTANCE(field, lat, lng) against the field's latitude/longitude columns
+func (w *SQLWalker) visitDistance(node *ast.CallNode) {
+	if len(node.Arguments) != 3 {
+		w.err = fmt.Errorf("DISTANCE requires 3 arguments (field, latitude, longitude)")
+		return
+	}
+	var ref string
+	switch field := node.Arguments[0].(type) {
+	case *ast.IdentifierNode:
+		ref = field.Value
+	case *ast.MemberNode:
+		path, ok := memberPath(field)
+		if !ok || w.resolve == nil {
+			w.err = fmt.Errorf("unsupported DISTANCE field")
+			return
+		}
+		ref = path
+	default:
+		w.err = fmt.Errorf("DISTANCE first argument must be a Geolocation field")
+		return
+	}
+	lat, latOK := numberLiteral(node.Arguments[1])
+	lng, lngOK := numberLiteral(node.Arguments[2])
+	if !latOK || !lngOK {
+		w.err = fmt.Errorf("DISTANCE latitude and longitude must be numbers")
+		return
+	}
+
+	w.builder.WriteString(fmt.Sprintf("(%g * 2 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(", EarthRadiusKm))
+	w.writeIdentifier(ref + constants.GeolocationLatitudeSuffix)
+	w.builder.WriteString(" - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(")
+	w.writeIdentifier(ref + constants.GeolocationLatitudeSuffix)
+	w.builder.WriteString(")) * POWER(SIN(RADIANS(")
+	w.writeIdentifier(ref + constants.GeolocationLongitudeSuffix)
+	w.builder.WriteString(" - ?) / 2), 2)))))")
+	w.args = append(w.args, lat, lat, lng)
+}
+
+// numberLiteral reads a numeric literal, including a negated one such as -122.4
+func numberLiteral(node ast.Node) (float64, bool) {
+	switch v := node.(type) {
+	case *ast.IntegerNode:
+		return float64(v.Value), true
+	case *ast.FloatNode:
+		return v.Value, true
+	case *ast.UnaryNode:
+		if v.Operator != "-" && v.Operator != "+" {
+			return 0, false
+		}
+		n, ok := numberLiteral(v.Node)
+		if v.Operator == "-" {
+			n = -n
+		}
+		return n, ok
+	}
+	return 0, false
+}
+
 // Helper to walk multiple args with comma separation
 func (w *SQLWalker) walkArgs(args []ast.Node) {
 	for i, arg := range args {
@@ -1,8 +1,10 @@
 package expression
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/nexuscrm/backend/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -92,6 +94,17 @@ func TestToSQL(t *testing.T) {
 			expectedSQL:  "(CloseDate < DATE_ADD(CURDATE(), INTERVAL ? DAY))",
 			expectedArgs: []interface{}{30},
 		},
+		{
+			name:         "function DISTANCE",
+			expression:   "DISTANCE(location, 37.77, -122.42) < 10",
+			expectedSQL:  "((6371 * 2 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(location__latitude - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(location__latitude)) * POWER(SIN(RADIANS(location__longitude - ?) / 2), 2))))) < ?)",
+			expectedArgs: []interface{}{37.77, 37.77, -122.42, 10},
+		},
+		{
+			name:        "function DISTANCE with a field as the point",
+			expression:  "DISTANCE(location, lat, lng) < 10",
+			expectError: true,
+		},
 		{
 			name:         "null comparison IS NOT NULL",
 			expression:   "id != null",
@@ -140,3 +153,45 @@ func TestToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestToSQLWithResolver(t *testing.T) {
+	resolve := func(ref string) (string, error) {
+		switch ref {
+		case "amount":
+			return "`t0`.`amount`", nil
+		case "account_id.name":
+			return "`t1`.`name`", nil
+		}
+		return "", fmt.Errorf("unknown field %s", ref)
+	}
+
+	sql, args, err := ToSQLWithResolver("amount > 100 && account_id.name == 'Acme'", resolve)
+	assert.NoError(t, err)
+	assert.Equal(t, "((`t0`.`amount` > ?) AND (`t1`.`name` = ?))", sql)
+	assert.Equal(t, []interface{}{100, "Acme"}, args)
+
+	_, _, err = ToSQLWithResolver("secret == 1", resolve)
+	assert.Error(t, err)
+
+	_, _, err = ToSQL("account_id.name == 'Acme'")
+	assert.Error(t, err, "dotted paths require a resolver")
+}
+
+func TestToSQLDateFunctionsFollowDialect(t *testing.T) {
+	query.SetDialect(query.Postgres{})
+	defer query.SetDialect(query.MySQL{})
+
+	sql, args, err := ToSQL("CloseDate < DATE_ADD(TODAY(), 30) && Amount > 5")
+	assert.NoError(t, err)
+	assert.Equal(t, "((CloseDate < (CURRENT_DATE + CAST(? AS INTEGER) * INTERVAL '1 day')) AND (Amount > ?))", sql)
+	assert.Equal(t, []interface{}{30, 5}, args)
+}
+
+func TestReferences(t *testing.T) {
+	refs, err := References(`ISBLANK(close_date) && amount > 100 && account_id.owner_id.name == "x" || stage == nil`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"account_id.owner_id.name", "amount", "close_date", "stage"}, refs)
+
+	_, err = References("amount >")
+	assert.Error(t, err)
+}
@@ -0,0 +1,325 @@
+// Package extension lets customers add server-side logic without forking the services:
+// before and after save hooks, REST routes, formula functions and MCP tools. An extension
+// registers them when it is installed, either compiled into the server (call Install from
+// an init function of a package imported for its side effects) or built as a Go plugin
+// and loaded from EXTENSIONS_DIR (see LoadPlugins).
+package extension
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/backend/pkg/formula"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/models"
+)
+
+// Extension is a unit of server-side logic
+type Extension interface {
+	// Name identifies the extension; its routes are served under /api/ext/<name>
+	Name() string
+
+	// Register adds the extension's hooks, routes, functions and tools
+	Register(r *Registrar) error
+}
+
+// Save operations
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// SaveEvent is a record being saved
+type SaveEvent struct {
+	Operation     string
+	ObjectAPIName string
+	Record        models.SObject // The record as saved; before hooks may change its fields
+	OldRecord     models.SObject // The record before an update, nil otherwise
+	User          *models.UserSession
+}
+
+// SaveHook runs as a record is saved. Before a save, an error rejects it; after the save
+// has committed, an error is only logged.
+type SaveHook func(ctx context.Context, event *SaveEvent) error
+
+// Route is a REST endpoint of an extension. Callers must be signed in.
+type Route struct {
+	Extension string
+	Method    string
+	Path      string // Relative to /api/ext/<extension>; "" is the extension's root
+	AdminOnly bool
+	Handler   gin.HandlerFunc
+}
+
+// ToolHandler executes an MCP tool call
+type ToolHandler func(ctx context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error)
+
+// Tool is an MCP tool of an extension
+type Tool struct {
+	Extension string
+	Tool      mcp.Tool
+	Handler   ToolHandler
+}
+
+// Info describes an installed extension
+type Info struct {
+	Name            string   `json:"name"`
+	Source          string   `json:"source"` // "builtin", or the path of the plugin
+	BeforeSaveHooks int      `json:"before_save_hooks"`
+	AfterSaveHooks  int      `json:"after_save_hooks"`
+	Routes          []string `json:"routes"`
+	Functions       []string `json:"functions"`
+	Tools           []string `json:"tools"`
+}
+
+type saveHook struct {
+	extension string
+	object    string // "" hooks every object except system tables
+	hook      SaveHook
+}
+
+// Registry holds the installed extensions and what they registered
+type Registry struct {
+	mu         sync.RWMutex
+	extensions map[string]*Info
+	order      []string
+	beforeSave []saveHook
+	afterSave  []saveHook
+	routes     []Route
+	tools      []Tool
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{extensions: make(map[string]*Info)}
+}
+
+// extensionName is the form of extension names, which appear in URLs
+var extensionName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Install registers ext, recording source as where it came from. When its Register fails,
+// the hooks, routes and tools it added are discarded; formula functions are global and stay.
+func (r *Registry) Install(ext Extension, source string) error {
+	name := ext.Name()
+	if !extensionName.MatchString(name) {
+		return fmt.Errorf("invalid extension name %q: use lower case letters, digits, '-' and '_'", name)
+	}
+
+	r.mu.Lock()
+	if _, exists := r.extensions[name]; exists {
+		r.mu.Unlock()
+		return fmt.Errorf("extension '%s' is already installed", name)
+	}
+	r.mu.Unlock()
+
+	registrar := &Registrar{name: name, info: &Info{Name: name, Source: source}}
+	if err := ext.Register(registrar); err != nil {
+		return fmt.Errorf("extension '%s': %w", name, err)
+	}
+	registrar.info.BeforeSaveHooks = len(registrar.beforeSave)
+	registrar.info.AfterSaveHooks = len(registrar.afterSave)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.extensions[name]; exists {
+		return fmt.Errorf("extension '%s' is already installed", name)
+	}
+	r.extensions[name] = registrar.info
+	r.order = append(r.order, name)
+	r.beforeSave = append(r.beforeSave, registrar.beforeSave...)
+	r.afterSave = append(r.afterSave, registrar.afterSave...)
+	r.routes = append(r.routes, registrar.routes...)
+	r.tools = append(r.tools, registrar.tools...)
+	return nil
+}
+
+// Extensions describes the installed extensions in installation order
+func (r *Registry) Extensions() []Info {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	infos := make([]Info, 0, len(r.order))
+	for _, name := range r.order {
+		infos = append(infos, *r.extensions[name])
+	}
+	return infos
+}
+
+// Routes returns the routes of every extension
+func (r *Registry) Routes() []Route {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Route(nil), r.routes...)
+}
+
+// Tools returns the MCP tools of every extension
+func (r *Registry) Tools() []Tool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Tool(nil), r.tools...)
+}
+
+// RunBeforeSave runs the before save hooks matching event in installation order and stops
+// at the first error. systemTable reports whether the object is a system table, which only
+// hooks naming it see.
+func (r *Registry) RunBeforeSave(ctx context.Context, event *SaveEvent, systemTable bool) error {
+	for _, h := range r.matchingHooks(r.beforeSave, event.ObjectAPIName, systemTable) {
+		if err := runHook(ctx, h, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunAfterSave runs every after save hook matching event and returns their errors
+func (r *Registry) RunAfterSave(ctx context.Context, event *SaveEvent, systemTable bool) []error {
+	var errs []error
+	for _, h := range r.matchingHooks(r.afterSave, event.ObjectAPIName, systemTable) {
+		if err := runHook(ctx, h, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (r *Registry) matchingHooks(hooks []saveHook, objectName string, systemTable bool) []saveHook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var matching []saveHook
+	for _, h := range hooks {
+		if strings.EqualFold(h.object, objectName) || (h.object == "" && !systemTable) {
+			matching = append(matching, h)
+		}
+	}
+	return matching
+}
+
+// runHook runs a hook, turning a panic into an error so a faulty extension cannot take the
+// server down
+func runHook(ctx context.Context, h saveHook, event *SaveEvent) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			log.Printf("❌ Extension '%s' panicked in a save hook: %v\n%s", h.extension, p, debug.Stack())
+			err = fmt.Errorf("extension '%s' failed: %v", h.extension, p)
+		}
+	}()
+	if err := h.hook(ctx, event); err != nil {
+		return fmt.Errorf("extension '%s': %w", h.extension, err)
+	}
+	return nil
+}
+
+// Registrar is what an extension registers its additions with
+type Registrar struct {
+	name       string
+	info       *Info
+	beforeSave []saveHook
+	afterSave  []saveHook
+	routes     []Route
+	tools      []Tool
+}
+
+// Name returns the name of the extension registering
+func (r *Registrar) Name() string {
+	return r.name
+}
+
+// BeforeSave runs hook inside the save transaction of records of objectName, before they
+// are written. An empty objectName hooks every object except system tables.
+func (r *Registrar) BeforeSave(objectName string, hook SaveHook) {
+	r.beforeSave = append(r.beforeSave, saveHook{extension: r.name, object: objectName, hook: hook})
+}
+
+// AfterSave runs hook once a save of a record of objectName has committed. An empty
+// objectName hooks every object except system tables.
+func (r *Registrar) AfterSave(objectName string, hook SaveHook) {
+	r.afterSave = append(r.afterSave, saveHook{extension: r.name, object: objectName, hook: hook})
+}
+
+// Handle serves handler at /api/ext/<extension>/<path> for signed-in users
+func (r *Registrar) Handle(method, path string, handler gin.HandlerFunc) error {
+	return r.addRoute(Route{Method: method, Path: path, Handler: handler})
+}
+
+// HandleAdmin serves handler at /api/ext/<extension>/<path> for system administrators
+func (r *Registrar) HandleAdmin(method, path string, handler gin.HandlerFunc) error {
+	return r.addRoute(Route{Method: method, Path: path, AdminOnly: true, Handler: handler})
+}
+
+func (r *Registrar) addRoute(route Route) error {
+	route.Method = strings.ToUpper(route.Method)
+	switch route.Method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return fmt.Errorf("unsupported route method %q", route.Method)
+	}
+	if route.Handler == nil {
+		return fmt.Errorf("route %s %s needs a handler", route.Method, route.Path)
+	}
+	route.Extension = r.name
+	if path := strings.Trim(route.Path, "/"); path != "" {
+		route.Path = "/" + path
+	} else {
+		route.Path = ""
+	}
+	r.routes = append(r.routes, route)
+	r.info.Routes = append(r.info.Routes, route.Method+" "+route.Path)
+	return nil
+}
+
+// FormulaFunction makes fn callable as def.Name from formulas, validation rules and flow
+// conditions (see formula.RegisterCustomFunction). Functions are available as soon as they
+// are registered and stay registered.
+func (r *Registrar) FormulaFunction(def formula.FunctionDefinition, fn func(params ...interface{}) (interface{}, error)) error {
+	if err := formula.RegisterCustomFunction(def, fn); err != nil {
+		return err
+	}
+	r.info.Functions = append(r.info.Functions, def.Name)
+	return nil
+}
+
+// Tool adds an MCP tool for the agent and MCP clients. Tool names must not collide with
+// built-in tools or those of other extensions.
+func (r *Registrar) Tool(tool mcp.Tool, handler ToolHandler) error {
+	if tool.Name == "" || handler == nil {
+		return fmt.Errorf("a tool needs a name and a handler")
+	}
+	r.tools = append(r.tools, Tool{Extension: r.name, Tool: tool, Handler: handler})
+	r.info.Tools = append(r.info.Tools, tool.Name)
+	return nil
+}
+
+// SourceBuiltin is the source of extensions compiled into the server
+const SourceBuiltin = "builtin"
+
+var defaultRegistry = NewRegistry()
+
+// Default returns the registry of the server
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Install installs an extension compiled into the server into the default registry
+func Install(ext Extension) error {
+	return defaultRegistry.Install(ext, SourceBuiltin)
+}
+
+type userKey struct{}
+
+// WithUser returns ctx carrying the signed-in user of an extension route
+func WithUser(ctx context.Context, user *models.UserSession) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// User returns the signed-in user calling an extension route, or nil
+func User(c *gin.Context) *models.UserSession {
+	user, _ := c.Request.Context().Value(userKey{}).(*models.UserSession)
+	return user
+}
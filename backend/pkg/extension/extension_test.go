@@ -0,0 +1,108 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testExtension struct {
+	name     string
+	register func(r *Registrar) error
+}
+
+func (e testExtension) Name() string                { return e.name }
+func (e testExtension) Register(r *Registrar) error { return e.register(r) }
+
+func TestRegistryInstall(t *testing.T) {
+	r := NewRegistry()
+	ok := testExtension{name: "pricing", register: func(reg *Registrar) error {
+		reg.BeforeSave("Opportunity", func(ctx context.Context, e *SaveEvent) error { return nil })
+		reg.AfterSave("", func(ctx context.Context, e *SaveEvent) error { return nil })
+		require.NoError(t, reg.Handle("get", "/quotes/", func(c *gin.Context) {}))
+		require.NoError(t, reg.HandleAdmin("POST", "", func(c *gin.Context) {}))
+		assert.Error(t, reg.Handle("TRACE", "/x", func(c *gin.Context) {}))
+		return reg.Tool(mcp.Tool{Name: "quote"}, func(ctx context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error) {
+			return mcp.CallToolResult{}, nil
+		})
+	}}
+	require.NoError(t, r.Install(ok, SourceBuiltin))
+	assert.ErrorContains(t, r.Install(ok, SourceBuiltin), "already installed")
+	assert.ErrorContains(t, r.Install(testExtension{name: "Bad Name"}, SourceBuiltin), "invalid extension name")
+
+	failing := testExtension{name: "broken", register: func(reg *Registrar) error {
+		reg.BeforeSave("", func(ctx context.Context, e *SaveEvent) error { return nil })
+		return errors.New("missing license")
+	}}
+	assert.ErrorContains(t, r.Install(failing, "/plugins/broken.so"), "missing license")
+
+	infos := r.Extensions()
+	require.Len(t, infos, 1, "a failed extension is not installed")
+	assert.Equal(t, Info{
+		Name: "pricing", Source: SourceBuiltin, BeforeSaveHooks: 1, AfterSaveHooks: 1,
+		Routes: []string{"GET /quotes", "POST "}, Tools: []string{"quote"},
+	}, infos[0])
+
+	routes := r.Routes()
+	require.Len(t, routes, 2)
+	assert.Equal(t, "pricing", routes[0].Extension)
+	assert.Equal(t, "/quotes", routes[0].Path)
+	assert.True(t, routes[1].AdminOnly)
+	assert.Len(t, r.Tools(), 1)
+}
+
+func TestRegistrySaveHooks(t *testing.T) {
+	r := NewRegistry()
+	var calls []string
+	require.NoError(t, r.Install(testExtension{name: "audit", register: func(reg *Registrar) error {
+		reg.BeforeSave("", func(ctx context.Context, e *SaveEvent) error {
+			calls = append(calls, "any:"+e.ObjectAPIName)
+			e.Record["checked"] = true
+			return nil
+		})
+		reg.BeforeSave("account", func(ctx context.Context, e *SaveEvent) error {
+			calls = append(calls, "account")
+			if e.Record["name"] == "" {
+				return errors.New("name is required")
+			}
+			return nil
+		})
+		reg.AfterSave("Account", func(ctx context.Context, e *SaveEvent) error {
+			panic("boom")
+		})
+		return nil
+	}}, SourceBuiltin))
+
+	event := &SaveEvent{Operation: OperationCreate, ObjectAPIName: "Account", Record: models.SObject{"name": "Acme"}}
+	require.NoError(t, r.RunBeforeSave(context.Background(), event, false))
+	assert.Equal(t, []string{"any:Account", "account"}, calls)
+	assert.Equal(t, true, event.Record["checked"], "before hooks change the saved record")
+
+	event.Record["name"] = ""
+	assert.ErrorContains(t, r.RunBeforeSave(context.Background(), event, false), "extension 'audit': name is required")
+
+	calls = nil
+	require.NoError(t, r.RunBeforeSave(context.Background(), &SaveEvent{ObjectAPIName: "_System_Log", Record: models.SObject{}}, true))
+	assert.Empty(t, calls, "hooks on every object skip system tables")
+
+	errs := r.RunAfterSave(context.Background(), event, false)
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "extension 'audit' failed: boom")
+}
+
+func TestLoadPlugins(t *testing.T) {
+	r := NewRegistry()
+	names, err := r.LoadPlugins(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	_, err = r.LoadPlugins(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, err, "failed to read extensions directory")
+}
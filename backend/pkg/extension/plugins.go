@@ -0,0 +1,67 @@
+package extension
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+	"sort"
+)
+
+// PluginSymbol is the variable a Go plugin exports its extension as:
+//
+//	var Extension extension.Extension = myExtension{}
+//
+// Plugins are built with `go build -buildmode=plugin` against the same module versions as
+// the server.
+const PluginSymbol = "Extension"
+
+// LoadPlugins installs the extension of every Go plugin (*.so) in dir into r, in file name
+// order, and returns the names installed. Loading stops at the first plugin that fails.
+func (r *Registry) LoadPlugins(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read extensions directory: %w", err)
+	}
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".so" {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+
+	var names []string
+	for _, path := range paths {
+		ext, err := openPlugin(path)
+		if err != nil {
+			return names, err
+		}
+		if err := r.Install(ext, path); err != nil {
+			return names, fmt.Errorf("%s: %w", path, err)
+		}
+		names = append(names, ext.Name())
+	}
+	return names, nil
+}
+
+// openPlugin opens a Go plugin and returns the extension it exports
+func openPlugin(path string) (Extension, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin %s: %w", path, err)
+	}
+	symbol, err := p.Lookup(PluginSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", path, err)
+	}
+	switch ext := symbol.(type) {
+	case *Extension:
+		if *ext != nil {
+			return *ext, nil
+		}
+	case Extension:
+		return ext, nil
+	}
+	return nil, fmt.Errorf("plugin %s: %s is a %T, not an extension.Extension", path, PluginSymbol, symbol)
+}
@@ -3,6 +3,9 @@ package formula
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sync"
+	"sync/atomic"
 
 	"github.com/nexuscrm/backend/pkg/auth"
 	"github.com/nexuscrm/backend/pkg/expression"
@@ -56,6 +59,54 @@ func (c *Context) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CustomMetadataVar is the variable through which formulas read custom metadata records,
+// e.g. cmdt.TaxRate.CA.rate
+const CustomMetadataVar = "cmdt"
+
+var customMetadataSource atomic.Value // func() map[string]interface{}
+
+// SetCustomMetadataSource sets the function supplying custom metadata records to formulas as
+// {type: {developer_name: {field: value}}}. It is called on every evaluation, so it must read
+// from memory and must not block.
+func SetCustomMetadataSource(fn func() map[string]interface{}) {
+	customMetadataSource.Store(fn)
+}
+
+// addCustomMetadata exposes custom metadata records to an expression environment
+func addCustomMetadata(env map[string]interface{}) {
+	fn, _ := customMetadataSource.Load().(func() map[string]interface{})
+	if fn == nil {
+		return
+	}
+	if _, exists := env[CustomMetadataVar]; !exists {
+		env[CustomMetadataVar] = fn()
+	}
+}
+
+// SettingVar is the variable through which formulas read custom settings resolved for the
+// running user, e.g. $Setting.DiscountLimit
+const SettingVar = "$Setting"
+
+var customSettingsSource atomic.Value // func(user map[string]interface{}) map[string]interface{}
+
+// SetCustomSettingsSource sets the function resolving custom settings for the user of an
+// evaluation (nil when there is none). Like the custom metadata source, it must read from
+// memory and must not block.
+func SetCustomSettingsSource(fn func(user map[string]interface{}) map[string]interface{}) {
+	customSettingsSource.Store(fn)
+}
+
+// addCustomSettings exposes custom settings resolved for user to an expression environment
+func addCustomSettings(env map[string]interface{}, user map[string]interface{}) {
+	fn, _ := customSettingsSource.Load().(func(map[string]interface{}) map[string]interface{})
+	if fn == nil {
+		return
+	}
+	if _, exists := env[SettingVar]; !exists {
+		env[SettingVar] = fn(user)
+	}
+}
+
 // CompiledFormula represents a compiled formula ready for evaluation
 // In this new engine, it's just a placeholder as caching is handled by expression engine
 type CompiledFormula struct {
@@ -96,8 +147,23 @@ func ToSQL(expr string) (string, []interface{}, error) {
 	return expression.ToSQL(expr)
 }
 
+// ToSQLWithResolver converts an expression string to a SQL WHERE clause, mapping field references through resolve
+func ToSQLWithResolver(expr string, resolve expression.IdentifierResolver) (string, []interface{}, error) {
+	return expression.ToSQLWithResolver(expr, resolve)
+}
+
+// References returns the field references of an expression, such as "amount" or "account_id.name"
+func References(expr string) ([]string, error) {
+	return expression.References(expr)
+}
+
 // Validate validates a formula expression syntax
 func (e *Engine) Validate(expression string, env map[string]interface{}) error {
+	if env != nil {
+		addCustomMetadata(env)
+		user, _ := env["user"].(map[string]interface{})
+		addCustomSettings(env, user)
+	}
 	return e.exprEngine.Validate(expression, env)
 }
 
@@ -138,6 +204,9 @@ func (e *Engine) Evaluate(expression string, ctx *Context) (interface{}, error)
 		env["env"] = ctx.Env
 	}
 
+	addCustomMetadata(env)
+	addCustomSettings(env, ctx.User)
+
 	// 2. Add extra fields (overrides)
 	if ctx.Fields != nil {
 		for k, v := range ctx.Fields {
@@ -160,7 +229,7 @@ func (e *Engine) Evaluate(expression string, ctx *Context) (interface{}, error)
 func (e *Engine) GetFunctionDefinitions() []FunctionDefinition {
 	// Note: these are manually synced with expression/engine.go standard functions.
 	// The frontend uses this for auto-complete.
-	return []FunctionDefinition{
+	definitions := []FunctionDefinition{
 		{Name: "TODAY", Category: "Date", Description: "Returns today's date (YYYY-MM-DD)", Usage: "TODAY()"},
 		{Name: "NOW", Category: "Date", Description: "Returns current date/time", Usage: "NOW()"},
 		{Name: "DATE_ADD", Category: "Date", Description: "Adds days to a date", Usage: "DATE_ADD(date, days)"},
@@ -170,6 +239,46 @@ func (e *Engine) GetFunctionDefinitions() []FunctionDefinition {
 		{Name: "ROUND", Category: "Math", Description: "Rounds a number to specified precision", Usage: "ROUND(number, precision)"},
 		{Name: "IF", Category: "Logic", Description: "Conditional logic", Usage: "IF(condition, true_val, false_val)"},
 	}
+	customFunctionsMu.RLock()
+	defer customFunctionsMu.RUnlock()
+	return append(definitions, customFunctions...)
+}
+
+// CustomFunctionCategory is the category of custom functions that do not name one
+const CustomFunctionCategory = "Custom"
+
+// customFunctionName is the form of custom function names, e.g. TAX_RATE
+var customFunctionName = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+var (
+	customFunctionsMu sync.RWMutex
+	customFunctions   []FunctionDefinition // Added with RegisterCustomFunction
+)
+
+// RegisterCustomFunction makes fn callable as def.Name from every formula, validation rule
+// and flow condition, and lists def for auto-complete. Names are upper case, like the
+// built-in functions, and cannot replace one.
+func RegisterCustomFunction(def FunctionDefinition, fn func(params ...interface{}) (interface{}, error)) error {
+	if !customFunctionName.MatchString(def.Name) {
+		return fmt.Errorf("invalid function name %q: use upper case letters, digits and underscores", def.Name)
+	}
+	if def.Name == "BCRYPT" {
+		return fmt.Errorf("function %s is already defined", def.Name)
+	}
+	if def.Category == "" {
+		def.Category = CustomFunctionCategory
+	}
+	if def.Usage == "" {
+		def.Usage = def.Name + "()"
+	}
+
+	customFunctionsMu.Lock()
+	defer customFunctionsMu.Unlock()
+	if err := expression.RegisterGlobalFunction(def.Name, fn); err != nil {
+		return err
+	}
+	customFunctions = append(customFunctions, def)
+	return nil
 }
 
 // RegisterFunction delegates to expression engine
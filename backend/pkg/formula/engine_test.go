@@ -157,3 +157,64 @@ func BenchmarkFormulaEvaluate(b *testing.B) {
 		_, _ = engine.Evaluate("record.Amount*1.1", ctx)
 	}
 }
+
+func TestFormulaEngine_CustomMetadata(t *testing.T) {
+	SetCustomMetadataSource(func() map[string]interface{} {
+		return map[string]interface{}{
+			"TaxRate": map[string]interface{}{
+				"CA": map[string]interface{}{"rate": 0.0725},
+			},
+		}
+	})
+	defer SetCustomMetadataSource(nil)
+
+	engine := NewEngine()
+	result, err := engine.Evaluate("Amount * cmdt.TaxRate.CA.rate", &Context{Record: map[string]interface{}{"Amount": 200.0}})
+	assert.NoError(t, err)
+	assert.InDelta(t, 14.5, result, 0.0001)
+
+	assert.NoError(t, engine.Validate("cmdt.TaxRate.CA.rate > 0", map[string]interface{}{}))
+}
+
+func TestFormulaEngine_CustomSettings(t *testing.T) {
+	SetCustomSettingsSource(func(user map[string]interface{}) map[string]interface{} {
+		if user != nil && user["id"] == "u1" {
+			return map[string]interface{}{"DiscountLimit": 35.0}
+		}
+		return map[string]interface{}{"DiscountLimit": 10.0}
+	})
+	defer SetCustomSettingsSource(nil)
+
+	engine := NewEngine()
+	record := map[string]interface{}{"Discount": 20.0}
+	result, err := engine.Evaluate("Discount <= $Setting.DiscountLimit", &Context{Record: record, User: map[string]interface{}{"id": "u1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+
+	result, err = engine.Evaluate("Discount <= $Setting.DiscountLimit", &Context{Record: record})
+	assert.NoError(t, err)
+	assert.Equal(t, false, result)
+
+	assert.NoError(t, engine.Validate("$Setting.DiscountLimit > 0", map[string]interface{}{}))
+}
+
+func TestFormulaEngine_CustomFunctions(t *testing.T) {
+	engine := NewEngine() // Created before the function exists
+	double := func(args ...interface{}) (interface{}, error) {
+		return args[0].(float64) * 2, nil
+	}
+	assert.NoError(t, RegisterCustomFunction(FunctionDefinition{Name: "TEST_DOUBLE", Description: "Doubles a number"}, double))
+	assert.ErrorContains(t, RegisterCustomFunction(FunctionDefinition{Name: "TEST_DOUBLE"}, double), "already defined")
+	assert.ErrorContains(t, RegisterCustomFunction(FunctionDefinition{Name: "UPPER"}, double), "already defined")
+	assert.ErrorContains(t, RegisterCustomFunction(FunctionDefinition{Name: "double"}, double), "invalid function name")
+
+	result, err := engine.Evaluate("TEST_DOUBLE(Amount)", &Context{Record: map[string]interface{}{"Amount": 21.0}})
+	assert.NoError(t, err)
+	assert.Equal(t, 42.0, result)
+
+	definitions := engine.GetFunctionDefinitions()
+	last := definitions[len(definitions)-1]
+	assert.Equal(t, "TEST_DOUBLE", last.Name)
+	assert.Equal(t, CustomFunctionCategory, last.Category)
+	assert.Equal(t, "TEST_DOUBLE()", last.Usage)
+}
@@ -0,0 +1,105 @@
+// Package i18n resolves the locale a request is served in and carries it through contexts.
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type contextKey struct{}
+
+// Normalize canonicalizes a locale tag ("fr_fr" -> "fr-FR", "EN" -> "en").
+// It returns "" for tags that are not a language with an optional region.
+func Normalize(tag string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	if len(parts) > 2 || !isAlpha(parts[0], 2, 3) {
+		return ""
+	}
+	locale := strings.ToLower(parts[0])
+	if len(parts) == 2 {
+		region := parts[1]
+		switch {
+		case isAlpha(region, 2, 2):
+			locale += "-" + strings.ToUpper(region)
+		case isAlpha(region, 4, 4): // Script, e.g. zh-Hant
+			locale += "-" + strings.ToUpper(region[:1]) + strings.ToLower(region[1:])
+		default:
+			return ""
+		}
+	}
+	return locale
+}
+
+func isAlpha(s string, min, max int) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header, most preferred first.
+// Wildcards, malformed tags and tags with q=0 are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		locale := Normalize(fields[0])
+		if locale == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{locale, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	locales := make([]string, len(tags))
+	for i, t := range tags {
+		locales[i] = t.locale
+	}
+	return locales
+}
+
+// WithLocales returns a context carrying the caller's preferred locales, most preferred first
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locales)
+}
+
+// Locales returns the preferred locales carried by ctx
+func Locales(ctx context.Context) []string {
+	locales, _ := ctx.Value(contextKey{}).([]string)
+	return locales
+}
+
+// Match returns the first preferred locale that is available, falling back from a regional
+// locale to its language ("fr-CA" -> "fr"). It returns "" when none is available.
+func Match(preferred []string, available func(locale string) bool) string {
+	for _, locale := range preferred {
+		if available(locale) {
+			return locale
+		}
+		if base, _, ok := strings.Cut(locale, "-"); ok && available(base) {
+			return base
+		}
+	}
+	return ""
+}
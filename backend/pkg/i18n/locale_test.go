@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "fr-FR", Normalize("fr_fr"))
+	assert.Equal(t, "en", Normalize(" EN "))
+	assert.Equal(t, "zh-Hant", Normalize("zh-HANT"))
+	assert.Empty(t, Normalize("*"))
+	assert.Empty(t, Normalize("en-US-x-private"))
+	assert.Empty(t, Normalize("e1"))
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, []string{"fr-CA", "fr", "en"}, ParseAcceptLanguage("en;q=0.5, fr-CA, fr;q=0.8, *;q=0.1, de;q=0"))
+	assert.Empty(t, ParseAcceptLanguage(""))
+}
+
+func TestMatch(t *testing.T) {
+	available := func(l string) bool { return l == "fr" || l == "de-DE" }
+	assert.Equal(t, "fr", Match([]string{"es", "fr-CA", "de-DE"}, available))
+	assert.Equal(t, "de-DE", Match([]string{"de-DE"}, available))
+	assert.Empty(t, Match([]string{"de"}, available))
+
+	ctx := WithLocales(context.Background(), []string{"fr"})
+	assert.Equal(t, []string{"fr"}, Locales(ctx))
+	assert.Nil(t, Locales(context.Background()))
+}
@@ -28,6 +28,7 @@ type QueryResult struct {
 type Builder struct {
 	queryType    QueryType
 	table        string
+	source       string // Derived table read in place of table, aliased as table
 	fields       []string
 	joins        []string
 	whereClauses []string
@@ -35,7 +36,9 @@ type Builder struct {
 	orderBy      string
 	groupBy      string
 	limit        *int
+	offset       int
 	values       map[string]interface{}
+	err          error // First invalid identifier or clause; Build returns no SQL while set
 
 	// Metadata context for smart features
 	schema *models.ObjectMetadata
@@ -43,7 +46,7 @@ type Builder struct {
 
 // From creates a new SELECT query builder
 func From(table string) *Builder {
-	return &Builder{
+	b := &Builder{
 		queryType:    QueryTypeSelect,
 		table:        table,
 		fields:       make([]string, 0),
@@ -51,37 +54,70 @@ func From(table string) *Builder {
 		whereClauses: make([]string, 0),
 		params:       make([]interface{}, 0),
 	}
+	return b.checkIdentifier(table)
 }
 
 // Insert creates a new INSERT query builder
 func Insert(table string, data map[string]interface{}) *Builder {
-	return &Builder{
+	b := &Builder{
 		queryType: QueryTypeInsert,
 		table:     table,
 		values:    data,
 		params:    make([]interface{}, 0),
 	}
+	b.checkIdentifier(table)
+	for key := range data {
+		b.checkIdentifier(key)
+	}
+	return b
 }
 
 // Update creates a new UPDATE query builder
 func Update(table string) *Builder {
-	return &Builder{
+	b := &Builder{
 		queryType:    QueryTypeUpdate,
 		table:        table,
 		values:       make(map[string]interface{}),
 		whereClauses: make([]string, 0),
 		params:       make([]interface{}, 0),
 	}
+	return b.checkIdentifier(table)
 }
 
 // Delete creates a new DELETE query builder
 func Delete(table string) *Builder {
-	return &Builder{
+	b := &Builder{
 		queryType:    QueryTypeDelete,
 		table:        table,
 		whereClauses: make([]string, 0),
 		params:       make([]interface{}, 0),
 	}
+	return b.checkIdentifier(table)
+}
+
+// checkIdentifier records an error when name is not a valid table or column name
+func (b *Builder) checkIdentifier(name string) *Builder {
+	if b.err == nil {
+		b.err = ValidateIdentifier(name)
+	}
+	return b
+}
+
+// Err returns the first invalid identifier or clause passed to the builder. Build returns an
+// empty query while it is set, so a name taken from a request can never reach the database.
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// FromDerived reads the rows of a subquery instead of the table. The subquery is aliased
+// as the table, so table-qualified fields and conditions apply to it unchanged.
+func (b *Builder) FromDerived(subquery string) *Builder {
+	if b.queryType != QueryTypeSelect {
+		return b
+	}
+
+	b.source = subquery
+	return b
 }
 
 // WithMetadata attaches object metadata for smart features
@@ -104,6 +140,7 @@ func (b *Builder) Select(fields []string) *Builder {
 	for _, field := range fields {
 		// Add field with table prefix if not already prefixed
 		if !strings.Contains(field, ".") && field != "*" {
+			b.checkIdentifier(field)
 			b.fields = append(b.fields, fmt.Sprintf("`%s`.`%s`", b.table, field))
 		} else {
 			b.fields = append(b.fields, field)
@@ -145,6 +182,8 @@ func (b *Builder) Join(joinType string, table string, alias string, on string) *
 		return b
 	}
 
+	b.checkIdentifier(table)
+	b.checkIdentifier(alias)
 	b.joins = append(b.joins, fmt.Sprintf("%s JOIN `%s` as `%s` ON %s", joinType, table, alias, on))
 	return b
 }
@@ -158,6 +197,12 @@ func (b *Builder) Where(condition string, value ...interface{}) *Builder {
 	return b
 }
 
+// WhereColumn adds a "column op ?" condition, validating the column name
+func (b *Builder) WhereColumn(column string, op string, value interface{}) *Builder {
+	b.checkIdentifier(column)
+	return b.Where(fmt.Sprintf("`%s` %s ?", column, op), value)
+}
+
 // WhereRaw adds a raw WHERE condition with parameters
 func (b *Builder) WhereRaw(sql string, params []interface{}) *Builder {
 	if sql != "" {
@@ -179,6 +224,9 @@ func (b *Builder) Set(data map[string]interface{}) *Builder {
 	}
 
 	b.values = data
+	for key := range data {
+		b.checkIdentifier(key)
+	}
 	return b
 }
 
@@ -188,9 +236,22 @@ func (b *Builder) OrderBy(field string, direction string) *Builder {
 		return b
 	}
 
+	switch strings.ToUpper(direction) {
+	case "":
+		direction = constants.SortASC
+	case constants.SortASC, constants.SortDESC:
+		direction = strings.ToUpper(direction)
+	default:
+		if b.err == nil {
+			b.err = fmt.Errorf("invalid sort direction: %q", direction)
+		}
+		return b
+	}
+
 	// Add table prefix if not present
 	col := field
 	if !strings.Contains(field, ".") && !strings.Contains(field, "`") {
+		b.checkIdentifier(field)
 		col = fmt.Sprintf("`%s`.`%s`", b.table, field)
 	}
 
@@ -206,6 +267,7 @@ func (b *Builder) GroupBy(field string) *Builder {
 
 	col := field
 	if !strings.Contains(field, ".") && !strings.Contains(field, "`") {
+		b.checkIdentifier(field)
 		col = fmt.Sprintf("`%s`.`%s`", b.table, field)
 	}
 
@@ -237,8 +299,22 @@ func (b *Builder) Limit(n int) *Builder {
 	return b
 }
 
+// Offset skips the first n rows; only applied together with Limit
+func (b *Builder) Offset(n int) *Builder {
+	if b.queryType != QueryTypeSelect {
+		return b
+	}
+
+	b.offset = n
+	return b
+}
+
 // Build constructs the final SQL query
 func (b *Builder) Build() QueryResult {
+	if b.err != nil {
+		return QueryResult{}
+	}
+
 	var sql string
 	var params []interface{}
 
@@ -272,7 +348,11 @@ func (b *Builder) buildSelect() string {
 	if len(b.fields) > 0 {
 		fields = strings.Join(b.fields, ", ")
 	}
-	parts = append(parts, fmt.Sprintf("SELECT %s FROM `%s`", fields, b.table))
+	if b.source != "" {
+		parts = append(parts, fmt.Sprintf("SELECT %s FROM (%s) AS `%s`", fields, b.source, b.table))
+	} else {
+		parts = append(parts, fmt.Sprintf("SELECT %s FROM `%s`", fields, b.table))
+	}
 
 	// JOINs
 	if len(b.joins) > 0 {
@@ -297,6 +377,9 @@ func (b *Builder) buildSelect() string {
 	// LIMIT
 	if b.limit != nil {
 		parts = append(parts, fmt.Sprintf("LIMIT %d", *b.limit))
+		if b.offset > 0 {
+			parts = append(parts, fmt.Sprintf("OFFSET %d", b.offset))
+		}
 	}
 
 	return strings.Join(parts, " ")
@@ -363,13 +446,16 @@ func (b *Builder) ApplySecurity(securitySQL string, securityParams []interface{}
 // BulkInsertOrdered generates a multi-row INSERT with explicit column order
 // This version ensures consistent column ordering across all records
 func BulkInsertOrdered(table string, columns []string, records []map[string]interface{}) (string, []interface{}) {
-	if len(records) == 0 || len(columns) == 0 {
+	if len(records) == 0 || len(columns) == 0 || !IsValidIdentifier(table) {
 		return "", nil
 	}
 
 	// Format columns
 	var cols []string
 	for _, col := range columns {
+		if !IsValidIdentifier(col) {
+			return "", nil
+		}
 		cols = append(cols, fmt.Sprintf("`%s`", col))
 	}
 
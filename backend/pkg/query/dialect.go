@@ -0,0 +1,447 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// Dialect names accepted by DialectFor
+const (
+	DialectMySQL    = "mysql"
+	DialectTiDB     = "tidb"
+	DialectPostgres = "postgres"
+	DialectSQLite   = "sqlite"
+)
+
+// Dialect captures the SQL differences between the databases the backend runs on. SQL is
+// written once in the MySQL flavour (backtick identifiers, ? placeholders); Rebind turns
+// it into the dialect's flavour, and the methods below generate the statements that
+// cannot be translated mechanically.
+type Dialect interface {
+	// Name returns the dialect name, as accepted by DialectFor
+	Name() string
+	// DriverName returns the database/sql driver that opens connections
+	DriverName() string
+	// Quote quotes an identifier
+	Quote(identifier string) string
+	// Rebind translates MySQL-flavoured SQL: identifier quotes and ? placeholders
+	Rebind(sql string) string
+	// ColumnType translates a MySQL column type into the dialect's equivalent
+	ColumnType(sqlType string) string
+	// AutoIncrement returns the column type of an auto-incrementing column of sqlType
+	AutoIncrement(sqlType string) string
+	// GeneratedColumn returns the storage clause of a generated column
+	GeneratedColumn() string
+	// TableOptions returns the options that follow a CREATE TABLE body
+	TableOptions() string
+	// InlineIndexes reports whether secondary indexes are declared inside CREATE TABLE
+	InlineIndexes() bool
+	// CreateIndex returns a standalone CREATE INDEX statement
+	CreateIndex(table, name string, columns []string, unique bool) string
+	// DropIndex returns a DROP INDEX statement
+	DropIndex(table, name string) string
+	// ModifyColumn returns the statement that changes a column to definition, its full
+	// MySQL column definition. Dialects that alter columns piecemeal only apply sqlType
+	// and the nullability of definition; "" means columns need no change, as in SQLite.
+	ModifyColumn(table, column, sqlType, definition string) string
+	// RenameTable returns the statement that renames a table
+	RenameTable(from, to string) string
+	// DisableForeignKeyChecks returns the session statement that lets DDL reference
+	// tables that do not exist yet, or "" when the dialect has none
+	DisableForeignKeyChecks() string
+	// Upsert returns the clause that turns an INSERT into an upsert. A conflict on key
+	// copies the inserted columns over the existing row and applies the raw assignments
+	// (e.g. "last_modified_date = NOW()"). With neither, a conflict leaves the existing row
+	// as it is, like INSERT IGNORE.
+	Upsert(key []string, columns []string, assignments ...string) string
+	// CurrentSchema returns the expression naming the connection's schema, for
+	// INFORMATION_SCHEMA lookups
+	CurrentSchema() string
+	// CurrentDate returns the expression of today's date
+	CurrentDate() string
+	// AddDays returns the expression of date moved by days. Both are SQL expressions and
+	// are rendered once each, in order, so placeholders inside them keep their positions.
+	AddDays(date, days string) string
+	// DatePeriod returns a sortable label of the period containing date: 2024-03-15,
+	// 2024-W11 (ISO week), 2024-03, 2024-Q1 or 2024. An unknown period returns "".
+	DatePeriod(date string, period constants.ReportDateBucket) string
+}
+
+var (
+	activeMu sync.RWMutex
+	active   Dialect = MySQL{}
+)
+
+// SetDialect selects the dialect of the database the backend is connected to
+func SetDialect(d Dialect) {
+	activeMu.Lock()
+	active = d
+	activeMu.Unlock()
+}
+
+// ActiveDialect returns the dialect selected by SetDialect; MySQL until one is set
+func ActiveDialect() Dialect {
+	activeMu.RLock()
+	defer activeMu.RUnlock()
+	return active
+}
+
+// DialectFor returns the dialect with a name. An empty name selects MySQL, which TiDB speaks.
+func DialectFor(name string) (Dialect, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", DialectMySQL, DialectTiDB:
+		return MySQL{}, nil
+	case DialectPostgres, "postgresql":
+		return Postgres{}, nil
+	case DialectSQLite, "sqlite3":
+		return SQLite{}, nil
+	}
+	return nil, fmt.Errorf("unsupported SQL dialect %q (use %s, %s, %s or %s)", name, DialectTiDB, DialectMySQL, DialectPostgres, DialectSQLite)
+}
+
+// MySQL is the dialect of TiDB and MySQL
+type MySQL struct{}
+
+func (MySQL) Name() string       { return DialectMySQL }
+func (MySQL) DriverName() string { return "mysql" }
+
+func (MySQL) Quote(identifier string) string {
+	return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+}
+
+func (MySQL) Rebind(sql string) string            { return sql }
+func (MySQL) ColumnType(sqlType string) string    { return sqlType }
+func (MySQL) AutoIncrement(sqlType string) string { return sqlType + " AUTO_INCREMENT" }
+func (MySQL) GeneratedColumn() string             { return "VIRTUAL" }
+
+func (MySQL) TableOptions() string {
+	return " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"
+}
+
+func (MySQL) InlineIndexes() bool { return true }
+
+func (d MySQL) CreateIndex(table, name string, columns []string, unique bool) string {
+	kind := "INDEX"
+	if unique {
+		kind = "UNIQUE INDEX"
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD %s %s (%s)", d.Quote(table), kind, d.Quote(name), quoteAll(d, columns))
+}
+
+func (d MySQL) DropIndex(table, name string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s", d.Quote(table), d.Quote(name))
+}
+
+func (d MySQL) ModifyColumn(table, column, sqlType, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", d.Quote(table), definition)
+}
+
+func (d MySQL) RenameTable(from, to string) string {
+	return fmt.Sprintf("RENAME TABLE %s TO %s", d.Quote(from), d.Quote(to))
+}
+
+func (MySQL) DisableForeignKeyChecks() string { return "SET FOREIGN_KEY_CHECKS=0" }
+
+func (d MySQL) Upsert(key []string, columns []string, assignments ...string) string {
+	updates := make([]string, 0, len(columns)+len(assignments))
+	for _, col := range columns {
+		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", d.Quote(col), d.Quote(col)))
+	}
+	updates = append(updates, assignments...)
+	if len(updates) == 0 {
+		// Unlike INSERT IGNORE, a no-op update still fails on errors other than the conflict
+		updates = append(updates, fmt.Sprintf("%s = %s", d.Quote(key[0]), d.Quote(key[0])))
+	}
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(updates, ", ")
+}
+
+func (MySQL) CurrentSchema() string { return "DATABASE()" }
+func (MySQL) CurrentDate() string   { return "CURDATE()" }
+
+func (MySQL) AddDays(date, days string) string {
+	return fmt.Sprintf("DATE_ADD(%s, INTERVAL %s DAY)", date, days)
+}
+
+func (MySQL) DatePeriod(date string, period constants.ReportDateBucket) string {
+	switch period {
+	case constants.ReportDateBucketDay:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d')", date)
+	case constants.ReportDateBucketWeek:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%x-W%%v')", date)
+	case constants.ReportDateBucketMonth:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m')", date)
+	case constants.ReportDateBucketQuarter:
+		return fmt.Sprintf("CONCAT(YEAR(%s), '-Q', QUARTER(%s))", date, date)
+	case constants.ReportDateBucketYear:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y')", date)
+	}
+	return ""
+}
+
+// Postgres is the dialect of PostgreSQL. Booleans stay SMALLINT so the 0/1 comparisons
+// used throughout the queries keep working.
+type Postgres struct{}
+
+func (Postgres) Name() string       { return DialectPostgres }
+func (Postgres) DriverName() string { return "nexus-pgx" }
+
+func (Postgres) Quote(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+// Rebind turns backtick identifiers into double-quoted ones and ? placeholders into $1,
+// $2, ..., leaving string literals untouched
+func (Postgres) Rebind(sql string) string {
+	if !strings.ContainsAny(sql, "`?") {
+		return sql
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(sql) + 8)
+	n := 0
+	inString := false
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case inString:
+			if c == '\\' && i+1 < len(sql) {
+				sb.WriteByte(c)
+				i++
+				c = sql[i]
+			} else if c == '\'' {
+				inString = false
+			}
+		case c == '\'':
+			inString = true
+		case c == '`':
+			c = '"'
+		case c == '?':
+			n++
+			sb.WriteString(fmt.Sprintf("$%d", n))
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+func (Postgres) ColumnType(sqlType string) string {
+	upper := strings.ToUpper(strings.TrimSpace(sqlType))
+	switch {
+	case upper == "DATETIME":
+		return "TIMESTAMP"
+	case upper == "BOOLEAN", upper == "BOOL", strings.HasPrefix(upper, "TINYINT"):
+		return "SMALLINT"
+	case upper == "LONGTEXT", upper == "MEDIUMTEXT":
+		return "TEXT"
+	case upper == "DOUBLE":
+		return "DOUBLE PRECISION"
+	case upper == "JSON":
+		return "JSONB"
+	case strings.HasPrefix(upper, "VECTOR"): // pgvector
+		return strings.ToLower(upper)
+	}
+	return sqlType
+}
+
+func (Postgres) AutoIncrement(sqlType string) string {
+	if strings.EqualFold(sqlType, "BIGINT") {
+		return "BIGSERIAL"
+	}
+	return "SERIAL"
+}
+
+func (Postgres) GeneratedColumn() string { return "STORED" }
+func (Postgres) TableOptions() string    { return "" }
+func (Postgres) InlineIndexes() bool     { return false }
+
+func (d Postgres) CreateIndex(table, name string, columns []string, unique bool) string {
+	kind := "INDEX"
+	if unique {
+		kind = "UNIQUE INDEX"
+	}
+	return fmt.Sprintf("CREATE %s IF NOT EXISTS %s ON %s (%s)", kind, d.Quote(name), d.Quote(table), quoteAll(d, columns))
+}
+
+// DropIndex drops an index; index names are schema-wide in PostgreSQL, so table is unused
+func (d Postgres) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s", d.Quote(name))
+}
+
+func (d Postgres) ModifyColumn(table, column, sqlType, definition string) string {
+	col := d.Quote(column)
+	sqlType = d.ColumnType(sqlType)
+	nullability := "DROP NOT NULL"
+	if strings.Contains(strings.ToUpper(definition), "NOT NULL") {
+		nullability = "SET NOT NULL"
+	}
+	return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s, ALTER COLUMN %s %s",
+		d.Quote(table), col, sqlType, col, sqlType, col, nullability)
+}
+
+func (d Postgres) RenameTable(from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s", d.Quote(from), d.Quote(to))
+}
+
+func (Postgres) DisableForeignKeyChecks() string { return "" }
+
+func (d Postgres) Upsert(key []string, columns []string, assignments ...string) string {
+	return onConflict(d, key, columns, assignments)
+}
+
+func (Postgres) CurrentSchema() string { return "current_schema()" }
+func (Postgres) CurrentDate() string   { return "CURRENT_DATE" }
+
+func (Postgres) AddDays(date, days string) string {
+	return fmt.Sprintf("(%s + CAST(%s AS INTEGER) * INTERVAL '1 day')", date, days)
+}
+
+// postgresPeriodFormats are the to_char patterns of the date periods
+var postgresPeriodFormats = map[constants.ReportDateBucket]string{
+	constants.ReportDateBucketDay:     "YYYY-MM-DD",
+	constants.ReportDateBucketWeek:    `IYYY-"W"IW`,
+	constants.ReportDateBucketMonth:   "YYYY-MM",
+	constants.ReportDateBucketQuarter: `YYYY-"Q"Q`,
+	constants.ReportDateBucketYear:    "YYYY",
+}
+
+func (Postgres) DatePeriod(date string, period constants.ReportDateBucket) string {
+	format, ok := postgresPeriodFormats[period]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("to_char(%s, '%s')", date, format)
+}
+
+// SQLite is the embedded dialect for local development and hermetic tests. SQLite reads
+// backtick identifiers and ? placeholders itself; Rebind maps the INFORMATION_SCHEMA views
+// used for introspection onto SQLite's catalog and drops row locks, which SQLite does not
+// have (it runs one writer at a time). The catalog and AddDays call functions that the
+// SQLite driver in internal/infrastructure/database registers.
+type SQLite struct{}
+
+func (SQLite) Name() string       { return DialectSQLite }
+func (SQLite) DriverName() string { return "nexus-sqlite" }
+
+func (SQLite) Quote(identifier string) string {
+	return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+}
+
+// sqliteCatalog emulates the INFORMATION_SCHEMA views over sqlite_master
+var sqliteCatalog = map[string]string{
+	"TABLES": "(SELECT 'main' AS TABLE_SCHEMA, name AS TABLE_NAME FROM sqlite_master WHERE type = 'table')",
+	"COLUMNS": "(SELECT 'main' AS TABLE_SCHEMA, m.name AS TABLE_NAME, p.name AS COLUMN_NAME, " +
+		"p.cid + 1 AS ORDINAL_POSITION, lower(p.type) AS DATA_TYPE, p.type AS COLUMN_TYPE, " +
+		"CASE WHEN p.\"notnull\" THEN 'NO' ELSE 'YES' END AS IS_NULLABLE, " +
+		"CASE WHEN p.hidden IN (2, 3) THEN 'VIRTUAL GENERATED' ELSE '' END AS EXTRA, " +
+		"CASE WHEN p.hidden IN (2, 3) THEN generation_expression(m.sql, p.name) ELSE '' END AS GENERATION_EXPRESSION " +
+		"FROM sqlite_master m, pragma_table_xinfo(m.name) p WHERE m.type = 'table')",
+	"STATISTICS": "(SELECT 'main' AS TABLE_SCHEMA, m.name AS TABLE_NAME, il.name AS INDEX_NAME, " +
+		"ii.name AS COLUMN_NAME, 1 - il.\"unique\" AS NON_UNIQUE " +
+		"FROM sqlite_master m, pragma_index_list(m.name) il, pragma_index_info(il.name) ii WHERE m.type = 'table')",
+	"KEY_COLUMN_USAGE": "(SELECT 'main' AS TABLE_SCHEMA, m.name AS TABLE_NAME, fk.\"from\" AS COLUMN_NAME, " +
+		"fk.\"table\" AS REFERENCED_TABLE_NAME, fk.\"to\" AS REFERENCED_COLUMN_NAME " +
+		"FROM sqlite_master m, pragma_foreign_key_list(m.name) fk WHERE m.type = 'table')",
+}
+
+var (
+	sqliteCatalogView = regexp.MustCompile(`(?i)\bINFORMATION_SCHEMA\.(TABLES|COLUMNS|STATISTICS|KEY_COLUMN_USAGE)\b`)
+	sqliteRowLock     = regexp.MustCompile(`(?i)\s+FOR\s+UPDATE(\s+SKIP\s+LOCKED)?\s*$`)
+)
+
+func (SQLite) Rebind(sql string) string {
+	sql = sqliteRowLock.ReplaceAllString(sql, "")
+	return sqliteCatalogView.ReplaceAllStringFunc(sql, func(view string) string {
+		return sqliteCatalog[strings.ToUpper(view[len("INFORMATION_SCHEMA."):])]
+	})
+}
+
+func (SQLite) ColumnType(sqlType string) string {
+	if strings.HasPrefix(strings.ToUpper(sqlType), "VECTOR") {
+		return "BLOB"
+	}
+	return sqlType
+}
+
+// AutoIncrement returns INTEGER, which SQLite fills in for a PRIMARY KEY column
+func (SQLite) AutoIncrement(sqlType string) string { return "INTEGER" }
+
+func (SQLite) GeneratedColumn() string { return "VIRTUAL" }
+func (SQLite) TableOptions() string    { return "" }
+func (SQLite) InlineIndexes() bool     { return false }
+
+func (d SQLite) CreateIndex(table, name string, columns []string, unique bool) string {
+	kind := "INDEX"
+	if unique {
+		kind = "UNIQUE INDEX"
+	}
+	return fmt.Sprintf("CREATE %s IF NOT EXISTS %s ON %s (%s)", kind, d.Quote(name), d.Quote(table), quoteAll(d, columns))
+}
+
+func (d SQLite) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s", d.Quote(name))
+}
+
+// ModifyColumn returns "": SQLite columns are dynamically typed
+func (SQLite) ModifyColumn(table, column, sqlType, definition string) string { return "" }
+
+func (d SQLite) RenameTable(from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s", d.Quote(from), d.Quote(to))
+}
+
+// DisableForeignKeyChecks returns "": the connection is opened with foreign keys off
+func (SQLite) DisableForeignKeyChecks() string { return "" }
+
+func (d SQLite) Upsert(key []string, columns []string, assignments ...string) string {
+	return onConflict(d, key, columns, assignments)
+}
+
+func (SQLite) CurrentSchema() string { return "'main'" }
+func (SQLite) CurrentDate() string   { return "date('now', 'localtime')" }
+
+// AddDays calls date_add_days, which the SQLite driver registers: unlike date() and
+// datetime(), it keeps dates as dates and datetimes as datetimes, as DATE_ADD does
+func (SQLite) AddDays(date, days string) string {
+	return fmt.Sprintf("date_add_days(%s, %s)", date, days)
+}
+
+func (SQLite) DatePeriod(date string, period constants.ReportDateBucket) string {
+	switch period {
+	case constants.ReportDateBucketDay:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s)", date)
+	case constants.ReportDateBucketWeek:
+		return fmt.Sprintf("strftime('%%G-W%%V', %s)", date)
+	case constants.ReportDateBucketMonth:
+		return fmt.Sprintf("strftime('%%Y-%%m', %s)", date)
+	case constants.ReportDateBucketQuarter:
+		return fmt.Sprintf("(strftime('%%Y', %s) || '-Q' || ((CAST(strftime('%%m', %s) AS INTEGER) + 2) / 3))", date, date)
+	case constants.ReportDateBucketYear:
+		return fmt.Sprintf("strftime('%%Y', %s)", date)
+	}
+	return ""
+}
+
+// onConflict builds the ON CONFLICT upsert clause shared by PostgreSQL and SQLite
+func onConflict(d Dialect, key, columns, assignments []string) string {
+	updates := make([]string, 0, len(columns)+len(assignments))
+	for _, col := range columns {
+		updates = append(updates, fmt.Sprintf("%s = excluded.%s", d.Quote(col), d.Quote(col)))
+	}
+	updates = append(updates, assignments...)
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", quoteAll(d, key))
+	}
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", quoteAll(d, key), strings.Join(updates, ", "))
+}
+
+func quoteAll(d Dialect, identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, id := range identifiers {
+		quoted[i] = d.Quote(id)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialectFor(t *testing.T) {
+	for name, want := range map[string]string{
+		"":           DialectMySQL,
+		"tidb":       DialectMySQL,
+		"MySQL":      DialectMySQL,
+		"postgres":   DialectPostgres,
+		"postgresql": DialectPostgres,
+		"sqlite":     DialectSQLite,
+	} {
+		d, err := DialectFor(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, d.Name(), name)
+	}
+
+	_, err := DialectFor("oracle")
+	assert.Error(t, err)
+}
+
+func TestPostgresRebind(t *testing.T) {
+	d := Postgres{}
+
+	assert.Equal(t,
+		`SELECT "account"."id" FROM "account" WHERE "account"."name" = $1 AND "account"."stage" IN ($2, $3)`,
+		d.Rebind("SELECT `account`.`id` FROM `account` WHERE `account`.`name` = ? AND `account`.`stage` IN (?, ?)"))
+
+	// String literals keep their question marks and backticks
+	assert.Equal(t,
+		`SELECT 'why? `+"`"+`x`+"`"+`' FROM "t" WHERE "a" = $1 AND "b" = 'it''s ?'`,
+		d.Rebind("SELECT 'why? `x`' FROM `t` WHERE `a` = ? AND `b` = 'it''s ?'"))
+	assert.Equal(t, `SELECT 'a\'?' FROM "t" WHERE "a" = $1`, d.Rebind("SELECT 'a\\'?' FROM `t` WHERE `a` = ?"))
+
+	assert.Equal(t, "SELECT 1", d.Rebind("SELECT 1"))
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `a` = ?", MySQL{}.Rebind("SELECT `a` FROM `t` WHERE `a` = ?"))
+}
+
+func TestSQLiteRebind(t *testing.T) {
+	d := SQLite{}
+
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `a` = ?", d.Rebind("SELECT `a` FROM `t` WHERE `a` = ?"))
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `id` = ?", d.Rebind("SELECT `a` FROM `t` WHERE `id` = ? FOR UPDATE"))
+	assert.Equal(t, "SELECT id FROM outbox LIMIT 10", d.Rebind("SELECT id FROM outbox LIMIT 10\n\t\tFOR UPDATE SKIP LOCKED\n"))
+
+	rebound := d.Rebind("SELECT COUNT(*) FROM information_schema.tables WHERE TABLE_SCHEMA = 'main' AND TABLE_NAME = ?")
+	assert.NotContains(t, rebound, "information_schema")
+	assert.Contains(t, rebound, "FROM sqlite_master WHERE type = 'table'")
+	assert.Contains(t, d.Rebind("SELECT INDEX_NAME FROM INFORMATION_SCHEMA.STATISTICS"), "pragma_index_list")
+}
+
+func TestDialectUpsert(t *testing.T) {
+	key := []string{"object_id", "api_name"}
+	cols := []string{"label", "type"}
+
+	assert.Equal(t,
+		"ON DUPLICATE KEY UPDATE `label` = VALUES(`label`), `type` = VALUES(`type`), last_modified_date = NOW()",
+		MySQL{}.Upsert(key, cols, "last_modified_date = NOW()"))
+	assert.Equal(t,
+		`ON CONFLICT ("object_id", "api_name") DO UPDATE SET "label" = excluded."label", "type" = excluded."type", last_modified_date = NOW()`,
+		Postgres{}.Upsert(key, cols, "last_modified_date = NOW()"))
+	assert.Equal(t, `ON CONFLICT ("id") DO NOTHING`, Postgres{}.Upsert([]string{"id"}, nil))
+	assert.Equal(t, "ON DUPLICATE KEY UPDATE `id` = `id`", MySQL{}.Upsert([]string{"id"}, nil))
+	assert.Equal(t,
+		"ON CONFLICT (`object_id`, `api_name`) DO UPDATE SET `label` = excluded.`label`, `type` = excluded.`type`",
+		SQLite{}.Upsert(key, cols))
+}
+
+func TestDialectDDL(t *testing.T) {
+	assert.Equal(t, "BIGINT AUTO_INCREMENT", MySQL{}.AutoIncrement("BIGINT"))
+	assert.Equal(t, "BIGSERIAL", Postgres{}.AutoIncrement("BIGINT"))
+	assert.Equal(t, "SERIAL", Postgres{}.AutoIncrement("INT"))
+	assert.Equal(t, "INTEGER", SQLite{}.AutoIncrement("BIGINT"))
+	assert.Equal(t, "BLOB", SQLite{}.ColumnType("VECTOR(1536)"))
+
+	assert.Equal(t, "DATETIME", MySQL{}.ColumnType("DATETIME"))
+	assert.Equal(t, "TIMESTAMP", Postgres{}.ColumnType("DATETIME"))
+	assert.Equal(t, "SMALLINT", Postgres{}.ColumnType("BOOLEAN"))
+	assert.Equal(t, "TEXT", Postgres{}.ColumnType("LONGTEXT"))
+	assert.Equal(t, "JSONB", Postgres{}.ColumnType("JSON"))
+	assert.Equal(t, "VARCHAR(255)", Postgres{}.ColumnType("VARCHAR(255)"))
+
+	assert.Equal(t, "ALTER TABLE `lead` ADD INDEX `idx_lead_email` (`email`)",
+		MySQL{}.CreateIndex("lead", "idx_lead_email", []string{"email"}, false))
+	assert.Equal(t, `CREATE UNIQUE INDEX IF NOT EXISTS "idx_lead_email" ON "lead" ("email")`,
+		Postgres{}.CreateIndex("lead", "idx_lead_email", []string{"email"}, true))
+
+	assert.Equal(t, "ALTER TABLE `lead` MODIFY COLUMN `score` DECIMAL(18,6) NULL",
+		MySQL{}.ModifyColumn("lead", "score", "DECIMAL(18,6)", "`score` DECIMAL(18,6) NULL"))
+	assert.Equal(t, `ALTER TABLE "lead" ALTER COLUMN "score" TYPE DECIMAL(18,6) USING "score"::DECIMAL(18,6), ALTER COLUMN "score" DROP NOT NULL`,
+		Postgres{}.ModifyColumn("lead", "score", "DECIMAL(18,6)", "`score` DECIMAL(18,6) NULL"))
+}
+
+func TestDialectDates(t *testing.T) {
+	assert.Equal(t, "CURDATE()", MySQL{}.CurrentDate())
+	assert.Equal(t, "CURRENT_DATE", Postgres{}.CurrentDate())
+
+	assert.Equal(t, "DATE_ADD(`close_date`, INTERVAL ? DAY)", MySQL{}.AddDays("`close_date`", "?"))
+	assert.Equal(t, "(`close_date` + CAST(? AS INTEGER) * INTERVAL '1 day')", Postgres{}.AddDays("`close_date`", "?"))
+	assert.Equal(t, "date_add_days(`close_date`, ?)", SQLite{}.AddDays("`close_date`", "?"))
+
+	assert.Equal(t, "DATE_FORMAT(`d`, '%x-W%v')", MySQL{}.DatePeriod("`d`", constants.ReportDateBucketWeek))
+	assert.Equal(t, "CONCAT(YEAR(`d`), '-Q', QUARTER(`d`))", MySQL{}.DatePeriod("`d`", constants.ReportDateBucketQuarter))
+	assert.Equal(t, `to_char(`+"`d`"+`, 'IYYY-"W"IW')`, Postgres{}.DatePeriod("`d`", constants.ReportDateBucketWeek))
+	assert.Equal(t, "strftime('%Y-%m', `d`)", SQLite{}.DatePeriod("`d`", constants.ReportDateBucketMonth))
+	for _, d := range []Dialect{MySQL{}, Postgres{}, SQLite{}} {
+		assert.Empty(t, d.DatePeriod("`d`", "fortnight"), d.Name())
+	}
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// MaxIdentifierLength is the longest table or column name accepted; MySQL's limit
+const MaxIdentifierLength = 64
+
+// identifierPattern matches the table and column names SQL may be assembled from: API
+// names, which never need escaping
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ErrInvalidIdentifier is wrapped by the errors of names that are not valid identifiers
+var ErrInvalidIdentifier = errors.New("invalid SQL identifier")
+
+// IsValidIdentifier reports whether name can be interpolated into SQL as a table or column
+func IsValidIdentifier(name string) bool {
+	return len(name) <= MaxIdentifierLength && identifierPattern.MatchString(name)
+}
+
+// ValidateIdentifier returns an error wrapping ErrInvalidIdentifier when name is not a valid
+// table or column name
+func ValidateIdentifier(name string) error {
+	if !IsValidIdentifier(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidIdentifier, name)
+	}
+	return nil
+}
+
+// QuoteIdentifier validates name and quotes it in the MySQL flavour, which Dialect.Rebind
+// translates. Every table or column name that reaches SQL through Sprintf goes through here
+// or through the Builder, which calls it.
+func QuoteIdentifier(name string) (string, error) {
+	if err := ValidateIdentifier(name); err != nil {
+		return "", err
+	}
+	return "`" + name + "`", nil
+}
+
+// QuoteColumn validates and quotes a table-qualified column, as in `table`.`column`
+func QuoteColumn(table, column string) (string, error) {
+	quotedTable, err := QuoteIdentifier(table)
+	if err != nil {
+		return "", err
+	}
+	quotedColumn, err := QuoteIdentifier(column)
+	if err != nil {
+		return "", err
+	}
+	return quotedTable + "." + quotedColumn, nil
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/shared/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidIdentifier(t *testing.T) {
+	for _, name := range []string{"account", "_System_User", "owner_id__name", "a1", strings.Repeat("a", MaxIdentifierLength)} {
+		assert.True(t, IsValidIdentifier(name), name)
+	}
+	for _, name := range []string{
+		"", "1account", "account name", "account`", "account.name", "name; DROP TABLE account",
+		"name--", "na\x00me", "naïve", strings.Repeat("a", MaxIdentifierLength+1),
+	} {
+		assert.False(t, IsValidIdentifier(name), name)
+	}
+
+	err := ValidateIdentifier("a`b")
+	assert.True(t, errors.Is(err, ErrInvalidIdentifier))
+}
+
+func TestQuoteColumn(t *testing.T) {
+	col, err := QuoteColumn("account", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "`account`.`name`", col)
+
+	_, err = QuoteColumn("account", "name` = 1 OR `id")
+	assert.ErrorIs(t, err, ErrInvalidIdentifier)
+	_, err = QuoteColumn("account`", "name")
+	assert.ErrorIs(t, err, ErrInvalidIdentifier)
+}
+
+func TestBuilderRejectsInvalidIdentifiers(t *testing.T) {
+	b := From("account").Select([]string{"name"}).OrderBy("name", "desc")
+	require.NoError(t, b.Err())
+	assert.Equal(t, "SELECT `account`.`"+constants.FieldID+"`, `account`.`name` FROM `account` ORDER BY `account`.`name` DESC", b.Build().SQL)
+
+	for name, b := range map[string]*Builder{
+		"table":     From("account; DROP TABLE x"),
+		"select":    From("account").Select([]string{"name`, (SELECT 1) AS `x"}),
+		"order by":  From("account").OrderBy("name; --", "ASC"),
+		"direction": From("account").OrderBy("name", "ASC; DROP TABLE account"),
+		"group by":  From("account").GroupBy("a b"),
+		"insert":    Insert("account", map[string]interface{}{"name`) VALUES (1); --": 1}),
+		"update":    Update("account").Set(map[string]interface{}{"a = 1, b": 1}),
+		"where":     Delete("account").WhereColumn("1 = 1 OR id", "=", 1),
+	} {
+		assert.Error(t, b.Err(), name)
+		assert.Empty(t, b.Build().SQL, name)
+	}
+
+	sql, _ := BulkInsertOrdered("account", []string{"name`"}, []map[string]interface{}{{"name`": 1}})
+	assert.Empty(t, sql)
+}
+
+// FuzzQuoteIdentifier checks that no input can break out of its quotes: a name is either
+// rejected or quoted as a single backtick-delimited identifier
+func FuzzQuoteIdentifier(f *testing.F) {
+	for _, seed := range []string{"account", "_System_User", "", "a`b", "a.b", "x` OR 1=1 --", "名前", strings.Repeat("z", 65)} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		quoted, err := QuoteIdentifier(name)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidIdentifier) {
+				t.Fatalf("unexpected error for %q: %v", name, err)
+			}
+			if quoted != "" {
+				t.Fatalf("rejected %q but returned %q", name, quoted)
+			}
+			return
+		}
+		if quoted != "`"+name+"`" || strings.Count(quoted, "`") != 2 {
+			t.Fatalf("%q quoted as %q", name, quoted)
+		}
+		if strings.ContainsAny(name, " \t\r\n;'\"-/*.()`\x00") {
+			t.Fatalf("accepted unsafe identifier %q", name)
+		}
+		if (Postgres{}).Rebind(quoted) != `"`+name+`"` {
+			t.Fatalf("%q does not rebind to a single Postgres identifier", quoted)
+		}
+	})
+}
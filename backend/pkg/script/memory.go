@@ -0,0 +1,894 @@
+package script
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	starlarkjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+// Starlark builds some values in a single step, such as "x" * (1 << 29) or sep.join(rows), so
+// the step limit does not bound what a script allocates. Scripts are compiled with the
+// operators, methods and built-ins that build strings, lists and dicts routed through guards
+// that reserve the memory of a value before it is built. A reservation past Limits.MaxMemory
+// measures the values the script can still reach and fails with a memory LimitError when they
+// and the new value do not fit. Values that grow an element at a time (append, item
+// assignment, comprehensions) are bounded by the step limit between reservations.
+
+// Guards the compiled script calls
+const (
+	guardBinary    = "__binary__"    // __binary__(op, x, y) is x op y
+	guardAugmented = "__augmented__" // x op= __augmented__(op, y[, x]) reserves for x op= y and returns y
+	guardAttr      = "__attr__"      // __attr__(x, name) is x.name
+	guardPin       = "__pin__"       // __unpin__(__pin__(), [__keep__(e) for ...]) keeps the elements
+	guardKeep      = "__keep__"      // of a comprehension reachable while it is built
+	guardUnpin     = "__unpin__"
+)
+
+var guardNames = []string{guardBinary, guardAugmented, guardAttr, guardPin, guardKeep, guardUnpin}
+
+// Operators whose result can outgrow their operands, by the name guards receive
+var guardedOperators = map[string]syntax.Token{
+	"+": syntax.PLUS,
+	"*": syntax.STAR,
+	"%": syntax.PERCENT,
+	"|": syntax.PIPE,
+}
+
+var augmentedOperators = map[syntax.Token]string{
+	syntax.PLUS_EQ:    "+",
+	syntax.STAR_EQ:    "*",
+	syntax.PERCENT_EQ: "%",
+	syntax.PIPE_EQ:    "|",
+}
+
+// Methods whose result can outgrow their receiver and arguments
+var guardedMethods = map[string]bool{
+	"join": true, "replace": true, "format": true, "split": true, "rsplit": true, "splitlines": true,
+	"upper": true, "lower": true, "title": true, "capitalize": true,
+	"items": true, "keys": true, "values": true, "extend": true, "update": true, "union": true,
+}
+
+// Built-ins shadowed by guarded versions
+var guardedBuiltins = []string{
+	"list", "tuple", "sorted", "reversed", "set", "dict", "enumerate", "zip",
+	"str", "repr", "bytes", "print", "fail", "getattr",
+}
+
+// Approximate sizes, in bytes
+const (
+	valueSize  = 16 // An element of a list or tuple, or a boxed string
+	entrySize  = 48 // An entry of a dict or set
+	headerSize = 32 // A list, tuple, dict or set
+
+	sharedStringSize = 256 // Strings at least this long are counted once however often they are referenced
+)
+
+// compile parses source and routes its allocations through the guards
+func compile(name, source string) (*starlark.Program, error) {
+	f, err := fileOptions.Parse(name+".star", source, 0)
+	if err != nil {
+		return nil, err
+	}
+	var g guarder
+	g.stmts(f.Stmts)
+	if g.err != nil {
+		return nil, g.err
+	}
+	return starlark.FileProgram(f, isPredeclared)
+}
+
+// guarder rewrites a syntax tree in place
+type guarder struct {
+	err error
+}
+
+func (g *guarder) fail(pos syntax.Position, format string, args ...interface{}) {
+	if g.err == nil {
+		g.err = syntax.Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+	}
+}
+
+func (g *guarder) stmts(stmts []syntax.Stmt) {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *syntax.AssignStmt:
+			rhs := g.expr(s.RHS)
+			if op, ok := augmentedOperators[s.Op]; ok {
+				args := []syntax.Expr{literal(s.OpPos, op), rhs}
+				// Repetition and formatting grow with the current value, not only the operand
+				if op == "*" || op == "%" {
+					current, ok := clone(s.LHS)
+					if !ok {
+						g.fail(s.OpPos, "the target of %s= must not call functions", op)
+						return
+					}
+					args = append(args, g.expr(current))
+				}
+				rhs = call(s.OpPos, guardAugmented, args...)
+			}
+			s.LHS = g.target(s.LHS)
+			s.RHS = rhs
+		case *syntax.DefStmt:
+			g.binding(s.Name)
+			g.params(s.Params)
+			g.stmts(s.Body)
+		case *syntax.ExprStmt:
+			s.X = g.expr(s.X)
+		case *syntax.IfStmt:
+			s.Cond = g.expr(s.Cond)
+			g.stmts(s.True)
+			g.stmts(s.False)
+		case *syntax.ForStmt:
+			s.Vars = g.target(s.Vars)
+			s.X = g.expr(s.X)
+			g.stmts(s.Body)
+		case *syntax.WhileStmt:
+			s.Cond = g.expr(s.Cond)
+			g.stmts(s.Body)
+		case *syntax.ReturnStmt:
+			if s.Result != nil {
+				s.Result = g.expr(s.Result)
+			}
+		}
+	}
+}
+
+// binding rejects assignments to the names of guards
+func (g *guarder) binding(id *syntax.Ident) {
+	for _, name := range guardNames {
+		if id.Name == name {
+			g.fail(id.NamePos, "%s is reserved", name)
+		}
+	}
+}
+
+func (g *guarder) params(params []syntax.Expr) {
+	for _, param := range params {
+		switch p := param.(type) {
+		case *syntax.Ident:
+			g.binding(p)
+		case *syntax.BinaryExpr: // name=default
+			g.binding(p.X.(*syntax.Ident))
+			p.Y = g.expr(p.Y)
+		case *syntax.UnaryExpr: // *args, **kwargs
+			if id, ok := p.X.(*syntax.Ident); ok {
+				g.binding(id)
+			}
+		}
+	}
+}
+
+// target rewrites the left-hand side of an assignment. Its outermost attribute is assigned,
+// not read, so it stays as written.
+func (g *guarder) target(e syntax.Expr) syntax.Expr {
+	switch t := e.(type) {
+	case *syntax.Ident:
+		g.binding(t)
+	case *syntax.ParenExpr:
+		t.X = g.target(t.X)
+	case *syntax.TupleExpr:
+		for i := range t.List {
+			t.List[i] = g.target(t.List[i])
+		}
+	case *syntax.ListExpr:
+		for i := range t.List {
+			t.List[i] = g.target(t.List[i])
+		}
+	case *syntax.IndexExpr:
+		t.X = g.expr(t.X)
+		t.Y = g.expr(t.Y)
+	case *syntax.DotExpr:
+		t.X = g.expr(t.X)
+	}
+	return e
+}
+
+func (g *guarder) exprs(list []syntax.Expr) {
+	for i := range list {
+		list[i] = g.expr(list[i])
+	}
+}
+
+func (g *guarder) expr(e syntax.Expr) syntax.Expr {
+	switch x := e.(type) {
+	case *syntax.BinaryExpr:
+		x.X = g.expr(x.X)
+		x.Y = g.expr(x.Y)
+		for name, op := range guardedOperators {
+			if x.Op == op {
+				return call(x.OpPos, guardBinary, literal(x.OpPos, name), x.X, x.Y)
+			}
+		}
+	case *syntax.UnaryExpr:
+		if x.X != nil {
+			x.X = g.expr(x.X)
+		}
+	case *syntax.ParenExpr:
+		x.X = g.expr(x.X)
+	case *syntax.CallExpr:
+		x.Fn = g.expr(x.Fn)
+		for i, arg := range x.Args {
+			if kw, ok := arg.(*syntax.BinaryExpr); ok && kw.Op == syntax.EQ {
+				kw.Y = g.expr(kw.Y)
+			} else {
+				x.Args[i] = g.expr(arg)
+			}
+		}
+	case *syntax.DotExpr:
+		x.X = g.expr(x.X)
+		if guardedMethods[x.Name.Name] {
+			return call(x.Dot, guardAttr, x.X, literal(x.NamePos, x.Name.Name))
+		}
+	case *syntax.IndexExpr:
+		x.X = g.expr(x.X)
+		x.Y = g.expr(x.Y)
+	case *syntax.SliceExpr:
+		x.X = g.expr(x.X)
+		for _, part := range []*syntax.Expr{&x.Lo, &x.Hi, &x.Step} {
+			if *part != nil {
+				*part = g.expr(*part)
+			}
+		}
+	case *syntax.ListExpr:
+		g.exprs(x.List)
+	case *syntax.TupleExpr:
+		g.exprs(x.List)
+	case *syntax.DictExpr:
+		for _, item := range x.List {
+			entry := item.(*syntax.DictEntry)
+			entry.Key = g.expr(entry.Key)
+			entry.Value = g.expr(entry.Value)
+		}
+	case *syntax.CondExpr:
+		x.Cond = g.expr(x.Cond)
+		x.True = g.expr(x.True)
+		x.False = g.expr(x.False)
+	case *syntax.LambdaExpr:
+		g.params(x.Params)
+		x.Body = g.expr(x.Body)
+	case *syntax.Comprehension:
+		for _, clause := range x.Clauses {
+			switch c := clause.(type) {
+			case *syntax.ForClause:
+				c.Vars = g.target(c.Vars)
+				c.X = g.expr(c.X)
+			case *syntax.IfClause:
+				c.Cond = g.expr(c.Cond)
+			}
+		}
+		if entry, ok := x.Body.(*syntax.DictEntry); ok {
+			entry.Key = call(x.Lbrack, guardKeep, g.expr(entry.Key))
+			entry.Value = call(x.Lbrack, guardKeep, g.expr(entry.Value))
+		} else {
+			x.Body = call(x.Lbrack, guardKeep, g.expr(x.Body))
+		}
+		return call(x.Lbrack, guardUnpin, call(x.Lbrack, guardPin), x)
+	}
+	return e
+}
+
+func call(pos syntax.Position, guard string, args ...syntax.Expr) *syntax.CallExpr {
+	return &syntax.CallExpr{Fn: &syntax.Ident{NamePos: pos, Name: guard}, Lparen: pos, Args: args, Rparen: pos}
+}
+
+func literal(pos syntax.Position, s string) *syntax.Literal {
+	return &syntax.Literal{Token: syntax.STRING, TokenPos: pos, Raw: strconv.Quote(s), Value: s}
+}
+
+// clone copies the target of an augmented assignment so its value can be read, if reading it
+// has no effects
+func clone(e syntax.Expr) (syntax.Expr, bool) {
+	switch x := e.(type) {
+	case *syntax.Ident:
+		return &syntax.Ident{NamePos: x.NamePos, Name: x.Name}, true
+	case *syntax.Literal:
+		return &syntax.Literal{Token: x.Token, TokenPos: x.TokenPos, Raw: x.Raw, Value: x.Value}, true
+	case *syntax.ParenExpr:
+		inner, ok := clone(x.X)
+		return &syntax.ParenExpr{Lparen: x.Lparen, X: inner, Rparen: x.Rparen}, ok
+	case *syntax.DotExpr:
+		inner, ok := clone(x.X)
+		name := &syntax.Ident{NamePos: x.Name.NamePos, Name: x.Name.Name}
+		return &syntax.DotExpr{X: inner, Dot: x.Dot, NamePos: x.NamePos, Name: name}, ok
+	case *syntax.IndexExpr:
+		inner, ok := clone(x.X)
+		index, ok2 := clone(x.Y)
+		return &syntax.IndexExpr{X: inner, Lbrack: x.Lbrack, Y: index, Rbrack: x.Rbrack}, ok && ok2
+	case *syntax.UnaryExpr:
+		if x.X == nil {
+			return nil, false
+		}
+		inner, ok := clone(x.X)
+		return &syntax.UnaryExpr{OpPos: x.OpPos, Op: x.Op, X: inner}, ok
+	case *syntax.BinaryExpr:
+		left, ok := clone(x.X)
+		right, ok2 := clone(x.Y)
+		return &syntax.BinaryExpr{X: left, OpPos: x.OpPos, Op: x.Op, Y: right}, ok && ok2
+	}
+	return nil, false
+}
+
+// ==================== Budget ====================
+
+// reserve accounts for n bytes about to be allocated. Reservations add up until they pass the
+// limit; then the values the script can reach are measured, which forgives its garbage.
+func (r *run) reserve(thread *starlark.Thread, n uint64) error {
+	limit := r.limits.MaxMemory
+	if n <= limit && r.live+r.charged <= limit-n {
+		r.charged += n
+		return nil
+	}
+	if n <= limit {
+		r.live = r.measure(thread, limit-n)
+		r.charged = 0
+		if r.live <= limit-n {
+			r.charged = n
+			return nil
+		}
+	}
+	return &LimitError{Limit: LimitMemory, Max: fmt.Sprintf("%d MB", limit>>20)}
+}
+
+// measure estimates the memory of the values the script can reach, stopping once it passes max
+func (r *run) measure(thread *starlark.Thread, max uint64) uint64 {
+	m := measurer{max: max, seen: make(map[interface{}]bool)}
+	for _, v := range r.inputs {
+		m.value(v)
+	}
+	for _, pinned := range r.pinned {
+		for _, v := range pinned {
+			m.value(v)
+		}
+	}
+	globals := false
+	for i := 0; i < thread.CallStackDepth(); i++ {
+		frame := thread.DebugFrame(i)
+		for j := 0; j < frame.NumLocals(); j++ {
+			_, v := frame.Local(j)
+			m.value(v)
+		}
+		if fn, ok := frame.Callable().(*starlark.Function); ok {
+			m.value(fn)
+			if !globals {
+				for _, v := range fn.Globals() {
+					m.value(v)
+				}
+				globals = true
+			}
+		}
+	}
+	return m.size
+}
+
+type measurer struct {
+	size, max uint64
+	seen      map[interface{}]bool // Containers and long strings already counted
+}
+
+// first reports whether key is counted for the first time
+func (m *measurer) first(key interface{}) bool {
+	if m.seen[key] {
+		return false
+	}
+	m.seen[key] = true
+	return true
+}
+
+func (m *measurer) value(v starlark.Value) {
+	if v == nil || m.size > m.max {
+		return
+	}
+	switch v := v.(type) {
+	case starlark.String:
+		m.str(unsafe.StringData(string(v)), len(v))
+	case starlark.Bytes:
+		m.str(unsafe.StringData(string(v)), len(v))
+	case starlark.Int:
+		m.size += intSize(v)
+	case *starlark.List:
+		if m.first(v) {
+			m.size += headerSize + uint64(v.Len())*valueSize
+			for i := 0; i < v.Len(); i++ {
+				m.value(v.Index(i))
+			}
+		}
+	case starlark.Tuple:
+		if len(v) > 0 && m.first(&v[0]) {
+			m.size += headerSize + uint64(len(v))*valueSize
+			for _, x := range v {
+				m.value(x)
+			}
+		}
+	case *starlark.Dict:
+		if m.first(v) {
+			m.size += headerSize + uint64(v.Len())*entrySize
+			for key, x := range v.Entries() {
+				m.value(key)
+				m.value(x)
+			}
+		}
+	case *starlark.Set:
+		if m.first(v) {
+			m.size += headerSize + uint64(v.Len())*entrySize
+			iter := v.Iterate()
+			defer iter.Done()
+			var x starlark.Value
+			for iter.Next(&x) {
+				m.value(x)
+			}
+		}
+	case *starlark.Function:
+		if m.first(v) {
+			for i := 0; i < v.NumParams(); i++ {
+				m.value(v.ParamDefault(i))
+			}
+			for i := 0; i < v.NumFreeVars(); i++ {
+				_, x := v.FreeVar(i)
+				m.value(x)
+			}
+		}
+	case *starlark.Builtin:
+		m.value(v.Receiver()) // A bound method such as s.join
+	}
+}
+
+func (m *measurer) str(data *byte, n int) {
+	if n >= sharedStringSize && !m.first(data) {
+		return
+	}
+	m.size += valueSize + uint64(n)
+}
+
+// ==================== Estimates ====================
+
+func add(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+func mul(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+func intSize(x starlark.Int) uint64 {
+	if _, ok := x.Int64(); ok {
+		return 0
+	}
+	return uint64(x.BigInt().BitLen()/8) + valueSize
+}
+
+// length is the number of elements of v, or 0 if it has no known length
+func length(v starlark.Value) uint64 {
+	if n := starlark.Len(v); n > 0 {
+		return uint64(n)
+	}
+	return 0
+}
+
+// contentSize is the memory of the elements of v, not counting what they refer to
+func contentSize(v starlark.Value) uint64 {
+	switch v := v.(type) {
+	case starlark.String:
+		return uint64(len(v))
+	case starlark.Bytes:
+		return uint64(len(v))
+	case starlark.Int:
+		return intSize(v)
+	case *starlark.Dict, *starlark.Set:
+		return length(v) * entrySize
+	case *starlark.List, starlark.Tuple:
+		return length(v) * valueSize
+	}
+	return 0
+}
+
+func binarySize(op syntax.Token, x, y starlark.Value) uint64 {
+	switch op {
+	case syntax.PLUS, syntax.PIPE:
+		return add(add(contentSize(x), contentSize(y)), headerSize)
+	case syntax.STAR:
+		if n, ok := y.(starlark.Int); ok {
+			return repeatSize(x, n)
+		}
+		if n, ok := x.(starlark.Int); ok {
+			return repeatSize(y, n)
+		}
+	case syntax.PERCENT:
+		if format, ok := x.(starlark.String); ok {
+			args := starlark.Tuple{y}
+			if tuple, ok := y.(starlark.Tuple); ok {
+				args = tuple
+			}
+			return formatSize(string(format), "%", args)
+		}
+	}
+	return 0
+}
+
+func repeatSize(x starlark.Value, n starlark.Int) uint64 {
+	if _, ok := x.(starlark.Int); ok {
+		return add(intSize(x.(starlark.Int)), intSize(n))
+	}
+	times, ok := n.Int64()
+	if !ok {
+		return math.MaxUint64
+	}
+	if times <= 0 {
+		return 0
+	}
+	return add(mul(contentSize(x), uint64(times)), headerSize)
+}
+
+// formatSize bounds the length of a format string with every placeholder, marked by
+// placeholder, printing the longest argument
+func formatSize(format, placeholder string, args starlark.Tuple) uint64 {
+	var longest uint64
+	for _, arg := range args {
+		if n := printedSize(arg, math.MaxUint32); n > longest {
+			longest = n
+		}
+	}
+	return add(uint64(len(format)), mul(uint64(strings.Count(format, placeholder)), longest))
+}
+
+// printedSize estimates the length of v printed by str(), stopping once it passes max
+func printedSize(v starlark.Value, max uint64) uint64 {
+	p := printer{max: max}
+	p.value(v)
+	return p.size
+}
+
+type printer struct {
+	size, max uint64
+	path      []starlark.Value // Containers being printed, which print as [...] inside themselves
+}
+
+func (p *printer) value(v starlark.Value) {
+	if p.size > p.max {
+		return
+	}
+	switch v := v.(type) {
+	case starlark.String:
+		p.size += uint64(len(v)) + 2
+	case starlark.Bytes:
+		p.size += uint64(len(v))*4 + 3
+	case starlark.Int:
+		p.size += intSize(v)*3 + 20
+	case starlark.Tuple:
+		p.size += 2
+		for _, x := range v {
+			p.size += 2
+			p.value(x)
+		}
+	case *starlark.List, *starlark.Dict, *starlark.Set:
+		for _, outer := range p.path {
+			if outer == v {
+				p.size += 5
+				return
+			}
+		}
+		p.path = append(p.path, v)
+		defer func() { p.path = p.path[:len(p.path)-1] }()
+		p.size += 7
+		if dict, ok := v.(*starlark.Dict); ok {
+			for key, x := range dict.Entries() {
+				p.size += 4
+				p.value(key)
+				p.value(x)
+			}
+			return
+		}
+		iter := v.(starlark.Iterable).Iterate()
+		defer iter.Done()
+		var x starlark.Value
+		for iter.Next(&x) {
+			p.size += 2
+			p.value(x)
+		}
+	default:
+		p.size += 24
+	}
+}
+
+// ==================== Guards ====================
+
+func (r *run) guards() starlark.StringDict {
+	guards := starlark.StringDict{
+		guardBinary:    starlark.NewBuiltin(guardBinary, r.binary),
+		guardAugmented: starlark.NewBuiltin(guardAugmented, r.augmented),
+		guardAttr:      starlark.NewBuiltin(guardAttr, r.attr),
+		guardPin:       starlark.NewBuiltin(guardPin, r.pin),
+		guardKeep:      starlark.NewBuiltin(guardKeep, r.keep),
+		guardUnpin:     starlark.NewBuiltin(guardUnpin, r.unpin),
+	}
+	for _, name := range guardedBuiltins {
+		if name == "getattr" {
+			guards[name] = starlark.NewBuiltin(name, r.getattr)
+			continue
+		}
+		guards[name] = r.guarded(starlark.Universe[name].(*starlark.Builtin), builtinSize(name))
+	}
+	guards["json"] = r.guardedJSON()
+	return guards
+}
+
+// guarded wraps fn to reserve size(args, kwargs) bytes before each call
+func (r *run) guarded(fn *starlark.Builtin, size func(args starlark.Tuple, kwargs []starlark.Tuple) uint64) *starlark.Builtin {
+	return starlark.NewBuiltin(fn.Name(), func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := r.reserve(thread, size(args, kwargs)); err != nil {
+			return nil, err
+		}
+		return starlark.Call(thread, fn, args, kwargs)
+	})
+}
+
+func (r *run) binary(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, _ []starlark.Tuple) (starlark.Value, error) {
+	op := guardedOperators[string(args[0].(starlark.String))]
+	if err := r.reserve(thread, binarySize(op, args[1], args[2])); err != nil {
+		return nil, err
+	}
+	return starlark.Binary(op, args[1], args[2])
+}
+
+func (r *run) augmented(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, _ []starlark.Tuple) (starlark.Value, error) {
+	op := guardedOperators[string(args[0].(starlark.String))]
+	operand := args[1]
+	// x += y and x |= y grow x by y; x is already accounted for
+	size := contentSize(operand)
+	if len(args) == 3 {
+		size = binarySize(op, args[2], operand)
+	} else if size == 0 {
+		size = length(operand) * valueSize
+	}
+	if err := r.reserve(thread, size); err != nil {
+		return nil, err
+	}
+	return operand, nil
+}
+
+func (r *run) attr(_ *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, _ []starlark.Tuple) (starlark.Value, error) {
+	return r.method(args[0], string(args[1].(starlark.String)))
+}
+
+// getattr(x, name[, default]) guards the methods it returns like x.name
+func (r *run) getattr(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(args) >= 2 && len(kwargs) == 0 {
+		if name, ok := args[1].(starlark.String); ok && guardedMethods[string(name)] {
+			if value, err := r.method(args[0], string(name)); err == nil || len(args) == 2 {
+				return value, err
+			}
+		}
+	}
+	return starlark.Call(thread, starlark.Universe["getattr"], args, kwargs)
+}
+
+// method is x.name, which reserves the memory of its result before each call
+func (r *run) method(x starlark.Value, name string) (starlark.Value, error) {
+	var value starlark.Value
+	if attrs, ok := x.(starlark.HasAttrs); ok {
+		var err error
+		if value, err = attrs.Attr(name); err != nil {
+			return nil, err
+		}
+	}
+	if value == nil {
+		return nil, fmt.Errorf("%s has no .%s field or method", x.Type(), name)
+	}
+	fn, ok := value.(*starlark.Builtin)
+	if !ok || !guardedMethods[name] {
+		return value, nil
+	}
+	return r.guarded(fn, func(args starlark.Tuple, kwargs []starlark.Tuple) uint64 {
+		return methodSize(x, name, args, kwargs)
+	}), nil
+}
+
+func (r *run) pin(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	r.pinned = append(r.pinned, nil)
+	return starlark.None, nil
+}
+
+func (r *run) keep(_ *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, _ []starlark.Tuple) (starlark.Value, error) {
+	top := len(r.pinned) - 1
+	r.pinned[top] = append(r.pinned[top], args[0])
+	return args[0], nil
+}
+
+func (r *run) unpin(_ *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, _ []starlark.Tuple) (starlark.Value, error) {
+	r.pinned = r.pinned[:len(r.pinned)-1]
+	return args[1], nil
+}
+
+// methodSize bounds the memory of the result of x.name(args, kwargs)
+func methodSize(x starlark.Value, name string, args starlark.Tuple, kwargs []starlark.Tuple) uint64 {
+	s, _ := starlark.AsString(x)
+	switch name {
+	case "join":
+		if len(args) != 1 {
+			return 0
+		}
+		var size, n uint64
+		iter := starlark.Iterate(args[0])
+		if iter == nil {
+			return 0
+		}
+		defer iter.Done()
+		var elem starlark.Value
+		for iter.Next(&elem) {
+			size = add(size, contentSize(elem))
+			n++
+		}
+		return add(size, mul(n, uint64(len(s))))
+	case "replace":
+		if len(args) < 2 {
+			return 0
+		}
+		old, _ := starlark.AsString(args[0])
+		replacement, _ := starlark.AsString(args[1])
+		if len(replacement) <= len(old) {
+			return uint64(len(s))
+		}
+		return add(uint64(len(s)), mul(uint64(strings.Count(s, old)), uint64(len(replacement)-len(old))))
+	case "format":
+		for _, kw := range kwargs {
+			args = append(args[:len(args):len(args)], kw[1])
+		}
+		return formatSize(s, "{", args)
+	case "split", "rsplit":
+		pieces := uint64(len(s))/2 + 1
+		if len(args) > 0 {
+			if sep, ok := starlark.AsString(args[0]); ok && sep != "" {
+				pieces = uint64(strings.Count(s, sep)) + 1
+			}
+		}
+		return add(mul(pieces, 2*valueSize), headerSize)
+	case "splitlines":
+		return add(mul(uint64(strings.Count(s, "\n"))+1, 2*valueSize), headerSize)
+	case "upper", "lower", "title", "capitalize":
+		return uint64(len(s)) + valueSize
+	case "items":
+		return add(mul(length(x), headerSize+3*valueSize), headerSize)
+	case "keys", "values":
+		return add(mul(length(x), valueSize), headerSize)
+	case "extend", "update", "union":
+		var size uint64
+		for _, arg := range args {
+			size = add(size, mul(length(arg), entrySize))
+		}
+		return add(size, mul(uint64(len(kwargs)), entrySize))
+	}
+	return 0
+}
+
+// builtinSize bounds the memory of the result of the built-in name
+func builtinSize(name string) func(args starlark.Tuple, kwargs []starlark.Tuple) uint64 {
+	return func(args starlark.Tuple, kwargs []starlark.Tuple) uint64 {
+		var first starlark.Value = starlark.None
+		if len(args) > 0 {
+			first = args[0]
+		}
+		switch name {
+		case "list", "tuple", "sorted", "reversed":
+			return add(mul(length(first), valueSize), headerSize)
+		case "set", "dict":
+			return add(mul(add(length(first), uint64(len(kwargs))), entrySize), headerSize)
+		case "enumerate":
+			return add(mul(length(first), headerSize+3*valueSize), headerSize)
+		case "zip":
+			var shortest uint64 = math.MaxUint64
+			for _, arg := range args {
+				if n := length(arg); n < shortest {
+					shortest = n
+				}
+			}
+			if len(args) == 0 {
+				return 0
+			}
+			return add(mul(shortest, headerSize+uint64(len(args)+1)*valueSize), headerSize)
+		case "str", "bytes":
+			if _, ok := first.(starlark.String); ok {
+				return uint64(len(first.(starlark.String)))
+			}
+			return printedSize(first, math.MaxUint32)
+		}
+		// repr, print and fail print all their arguments
+		var size uint64
+		for _, arg := range args {
+			size = add(size, printedSize(arg, math.MaxUint32))
+		}
+		return size
+	}
+}
+
+// guardedJSON is the json module with encode, decode and indent guarded
+func (r *run) guardedJSON() *starlarkstruct.Module {
+	members := make(starlark.StringDict, len(starlarkjson.Module.Members))
+	for name, member := range starlarkjson.Module.Members {
+		members[name] = member
+	}
+	first := func(args starlark.Tuple) (starlark.Value, string) {
+		if len(args) == 0 {
+			return starlark.None, ""
+		}
+		s, _ := starlark.AsString(args[0])
+		return args[0], s
+	}
+	members["encode"] = r.guarded(members["encode"].(*starlark.Builtin), func(args starlark.Tuple, _ []starlark.Tuple) uint64 {
+		value, _ := first(args)
+		return printedSize(value, math.MaxUint32)
+	})
+	members["decode"] = r.guarded(members["decode"].(*starlark.Builtin), func(args starlark.Tuple, _ []starlark.Tuple) uint64 {
+		_, s := first(args)
+		containers := strings.Count(s, "[") + strings.Count(s, "{") + strings.Count(s, ",")
+		return add(mul(uint64(len(s)), 2), mul(uint64(containers), headerSize+entrySize))
+	})
+	members["indent"] = r.guarded(members["indent"].(*starlark.Builtin), func(args starlark.Tuple, kwargs []starlark.Tuple) uint64 {
+		_, s := first(args)
+		// Every bracket and comma may start a line indented to the deepest nesting
+		var depth, deepest int
+		for _, c := range s {
+			switch c {
+			case '[', '{':
+				depth++
+				if depth > deepest {
+					deepest = depth
+				}
+			case ']', '}':
+				depth--
+			}
+		}
+		var indent uint64 = 1
+		for _, kw := range kwargs {
+			if text, ok := starlark.AsString(kw[1]); ok {
+				indent = add(indent, uint64(len(text)))
+			}
+		}
+		lines := uint64(strings.Count(s, "[") + strings.Count(s, "{") + strings.Count(s, ",") + strings.Count(s, "]") + strings.Count(s, "}"))
+		return add(uint64(len(s)), mul(lines, mul(indent, uint64(deepest)+1)))
+	})
+	return &starlarkstruct.Module{Name: starlarkjson.Module.Name, Members: members}
+}
+
+// hostSize estimates the memory of a value returned by the Host once converted for the script
+func hostSize(v interface{}) uint64 {
+	switch v := v.(type) {
+	case string:
+		return valueSize + uint64(len(v))
+	case []byte:
+		return valueSize + uint64(len(v))
+	case map[string]interface{}:
+		size := headerSize + uint64(len(v))*entrySize
+		for key, x := range v {
+			size = add(size, add(hostSize(key), hostSize(x)))
+		}
+		return size
+	case models.SObject:
+		return hostSize(map[string]interface{}(v))
+	case []interface{}:
+		size := headerSize + uint64(len(v))*valueSize
+		for _, x := range v {
+			size = add(size, hostSize(x))
+		}
+		return size
+	case []string:
+		size := headerSize + uint64(len(v))*valueSize
+		for _, x := range v {
+			size = add(size, hostSize(x))
+		}
+		return size
+	}
+	return valueSize
+}
@@ -0,0 +1,632 @@
+// Package script runs Starlark scripts (https://github.com/google/starlark-go) in a sandbox.
+// Scripts run as record triggers and flow actions: they see the record they run on and reach
+// beyond their own values only through a Host, which queries, saves records and calls out
+// through named credentials as the running user. Every run is bounded by Limits on execution
+// steps, wall time, memory, rows and callouts.
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	starlarkmath "go.starlark.net/lib/math"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// Host carries out what scripts ask of the platform. Implementations enforce the permissions
+// of the user the script runs as.
+type Host interface {
+	// Query returns records of objectName matching the formula filter where, sorted by orderBy
+	// ("field" or "field desc")
+	Query(ctx context.Context, objectName, where, orderBy string, limit int) ([]models.SObject, error)
+
+	// Get returns a record by ID, or nil if there is none
+	Get(ctx context.Context, objectName, id string) (models.SObject, error)
+
+	// Insert creates a record and returns its ID
+	Insert(ctx context.Context, objectName string, fields models.SObject) (string, error)
+
+	Update(ctx context.Context, objectName, id string, fields models.SObject) error
+	Delete(ctx context.Context, objectName, id string) error
+
+	// Callout sends a request through a named credential
+	Callout(ctx context.Context, credential, method, path string, headers map[string]string, body interface{}) (*Response, error)
+}
+
+// Response is the answer to a callout. Body is the decoded JSON, or the text of other responses.
+type Response struct {
+	StatusCode int
+	Body       interface{}
+}
+
+// Limits are the quotas of a run. Zero values take the defaults.
+type Limits struct {
+	MaxSteps     uint64        // Starlark execution steps, the measure of CPU
+	Timeout      time.Duration // Wall time, queries and callouts included
+	MaxMemory    uint64        // Bytes of the values the script holds, estimated (see reserve)
+	MaxDMLRows   int           // Records inserted, updated or deleted
+	MaxQueryRows int           // Records read by query() and get()
+	MaxCallouts  int           // Calls of http()
+	MaxLogBytes  int           // Log output kept; the rest is cut off
+}
+
+// Default limits
+const (
+	DefaultMaxSteps     = 1_000_000
+	DefaultTimeout      = 5 * time.Second
+	DefaultMaxMemory    = 64 << 20
+	DefaultMaxDMLRows   = 150
+	DefaultMaxQueryRows = 5000
+	DefaultMaxCallouts  = 10
+	DefaultMaxLogBytes  = 32 << 10
+)
+
+// Limit names, as reported by LimitError
+const (
+	LimitSteps     = "steps"
+	LimitTimeout   = "timeout"
+	LimitMemory    = "memory"
+	LimitDMLRows   = "dml_rows"
+	LimitQueryRows = "query_rows"
+	LimitCallouts  = "callouts"
+)
+
+func (l Limits) withDefaults() Limits {
+	if l.MaxSteps == 0 {
+		l.MaxSteps = DefaultMaxSteps
+	}
+	if l.Timeout <= 0 {
+		l.Timeout = DefaultTimeout
+	}
+	if l.MaxMemory == 0 {
+		l.MaxMemory = DefaultMaxMemory
+	}
+	if l.MaxDMLRows <= 0 {
+		l.MaxDMLRows = DefaultMaxDMLRows
+	}
+	if l.MaxQueryRows <= 0 {
+		l.MaxQueryRows = DefaultMaxQueryRows
+	}
+	if l.MaxCallouts <= 0 {
+		l.MaxCallouts = DefaultMaxCallouts
+	}
+	if l.MaxLogBytes <= 0 {
+		l.MaxLogBytes = DefaultMaxLogBytes
+	}
+	return l
+}
+
+// LimitError is a run stopped by one of its quotas
+type LimitError struct {
+	Limit string // One of the Limit* names
+	Max   string // The quota, e.g. "150" or "5s"
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("script exceeded its %s limit of %s", e.Limit, e.Max)
+}
+
+// Error is a script that failed: a runtime error, a call to fail() or a host call that was refused
+type Error struct {
+	Message   string // For fail("..."), the message the script gave
+	Backtrace string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Input is what a script runs on
+type Input struct {
+	Event     string                 // Bound to `event`: what ran the script, e.g. beforeUpdate, flow or manual
+	Record    models.SObject         // Bound to `record`; the script may change its fields (see Result.Changes)
+	OldRecord models.SObject         // Bound to `old_record`: the record before an update, None otherwise
+	User      models.SObject         // Bound to `user`
+	Params    map[string]interface{} // Bound to `params`
+}
+
+// Result is the outcome of a run. It is returned for failed runs as well, with the usage
+// up to the failure.
+type Result struct {
+	Changes   models.SObject // Fields of `record` the script set or changed
+	Value     interface{}    // The global `result`, if the script assigned it
+	Output    string         // What log() and print() wrote
+	Steps     uint64
+	Duration  time.Duration
+	DMLRows   int
+	QueryRows int
+	Callouts  int
+}
+
+// fileOptions allows top-level statements and while loops, which step limits keep in check
+var fileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// Names bound in every script besides the Starlark built-ins
+var predeclaredNames = []string{
+	"record", "old_record", "user", "event", "params",
+	"query", "get", "insert", "update", "delete", "http", "log",
+	"json", "math",
+}
+
+// ResultGlobal is the global a script assigns to return a value
+const ResultGlobal = "result"
+
+// Check parses and resolves source, reporting syntax errors and undefined names
+func Check(name, source string) error {
+	_, err := compile(name, source)
+	return err
+}
+
+func isPredeclared(name string) bool {
+	for _, names := range [][]string{predeclaredNames, guardNames, guardedBuiltins} {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Run executes source against input. Host calls run with ctx, bounded by the timeout.
+func Run(ctx context.Context, name, source string, input Input, host Host, limits Limits) (*Result, error) {
+	limits = limits.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, limits.Timeout)
+	defer cancel()
+
+	r := &run{ctx: ctx, host: host, limits: limits, result: &Result{}}
+	r.thread = &starlark.Thread{
+		Name:  name,
+		Print: func(_ *starlark.Thread, msg string) { r.log(msg) },
+	}
+	r.thread.SetMaxExecutionSteps(limits.MaxSteps)
+	r.thread.OnMaxSteps = func(*starlark.Thread) {
+		r.stop(&LimitError{Limit: LimitSteps, Max: fmt.Sprint(limits.MaxSteps)})
+	}
+
+	prog, err := compile(name, source)
+	if err != nil {
+		return r.result, err
+	}
+	predeclared, err := r.predeclared(input)
+	if err != nil {
+		return r.result, err
+	}
+
+	started := time.Now()
+	done := make(chan struct{})
+	go r.watch(done)
+	globals, err := prog.Init(r.thread, predeclared)
+	close(done)
+	r.result.Output = r.output.String()
+	r.result.Steps = r.thread.ExecutionSteps()
+	r.result.Duration = time.Since(started)
+
+	if stopErr := r.stopped(); stopErr != nil {
+		return r.result, stopErr
+	}
+	if err != nil {
+		return r.result, scriptError(err)
+	}
+
+	if err := r.collectChanges(predeclared["record"]); err != nil {
+		return r.result, err
+	}
+	if value, ok := globals[ResultGlobal]; ok {
+		if r.result.Value, err = FromStarlark(value); err != nil {
+			return r.result, fmt.Errorf("invalid %s: %w", ResultGlobal, err)
+		}
+	}
+	return r.result, nil
+}
+
+// scriptError turns an error of the interpreter into an Error, keeping quota errors raised
+// by host functions
+func scriptError(err error) error {
+	var limitErr *LimitError
+	if errors.As(err, &limitErr) {
+		return limitErr
+	}
+	var evalErr *starlark.EvalError
+	if !errors.As(err, &evalErr) {
+		return err // Syntax and resolve errors
+	}
+	message := evalErr.Msg
+	if i := strings.Index(message, "fail: "); i >= 0 {
+		message = message[i+len("fail: "):]
+	}
+	return &Error{Message: message, Backtrace: evalErr.Backtrace()}
+}
+
+// run is the state of one execution
+type run struct {
+	ctx    context.Context
+	host   Host
+	limits Limits
+	thread *starlark.Thread
+	result *Result
+
+	original map[string]starlark.Value // Fields of the input record, to detect changes
+	output   strings.Builder
+	cut      bool
+
+	inputs  []starlark.Value   // Values bound to the predeclared names, for measuring memory
+	pinned  [][]starlark.Value // Elements of the comprehensions being built
+	live    uint64             // Memory of the reachable values when last measured
+	charged uint64             // Memory reserved since
+
+	mu      sync.Mutex
+	stopErr error
+}
+
+// stop cancels the script for err; the first reason wins
+func (r *run) stop(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopErr == nil {
+		r.stopErr = err
+		r.thread.Cancel(err.Error())
+	}
+}
+
+func (r *run) stopped() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stopErr
+}
+
+// watch stops the script at its deadline or when ctx is canceled. Memory is bounded by the
+// guards the script is compiled with (see memory.go).
+func (r *run) watch(done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-r.ctx.Done():
+		if errors.Is(r.ctx.Err(), context.DeadlineExceeded) {
+			r.stop(&LimitError{Limit: LimitTimeout, Max: r.limits.Timeout.String()})
+		} else {
+			r.stop(r.ctx.Err())
+		}
+	}
+}
+
+// log appends a line to the output, up to the log limit
+func (r *run) log(line string) {
+	if r.cut {
+		return
+	}
+	if r.output.Len()+len(line)+1 > r.limits.MaxLogBytes {
+		r.output.WriteString("... (log truncated)\n")
+		r.cut = true
+		return
+	}
+	r.output.WriteString(line)
+	r.output.WriteByte('\n')
+}
+
+func (r *run) predeclared(input Input) (starlark.StringDict, error) {
+	record := starlark.Value(starlark.None)
+	if input.Record != nil {
+		dict, err := toDict(input.Record)
+		if err != nil {
+			return nil, fmt.Errorf("record: %w", err)
+		}
+		record = dict
+		// A second copy keeps the original values, even of lists changed in place
+		copied, err := toDict(input.Record)
+		if err != nil {
+			return nil, err
+		}
+		r.original = make(map[string]starlark.Value, copied.Len())
+		for _, item := range copied.Items() {
+			r.original[string(item[0].(starlark.String))] = item[1]
+		}
+	}
+
+	frozen := func(name string, fields map[string]interface{}) (starlark.Value, error) {
+		if fields == nil {
+			return starlark.None, nil
+		}
+		value, err := toDict(fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		value.Freeze()
+		return value, nil
+	}
+	oldRecord, err := frozen("old_record", input.OldRecord)
+	if err != nil {
+		return nil, err
+	}
+	user, err := frozen("user", input.User)
+	if err != nil {
+		return nil, err
+	}
+	params := input.Params
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	paramsValue, err := frozen("params", params)
+	if err != nil {
+		return nil, err
+	}
+
+	r.inputs = []starlark.Value{record, oldRecord, user, paramsValue}
+
+	predeclared := starlark.StringDict{
+		"record":     record,
+		"old_record": oldRecord,
+		"user":       user,
+		"event":      starlark.String(input.Event),
+		"params":     paramsValue,
+		"query":      starlark.NewBuiltin("query", r.query),
+		"get":        starlark.NewBuiltin("get", r.get),
+		"insert":     starlark.NewBuiltin("insert", r.insert),
+		"update":     starlark.NewBuiltin("update", r.update),
+		"delete":     starlark.NewBuiltin("delete", r.delete),
+		"http":       starlark.NewBuiltin("http", r.http),
+		"log":        starlark.NewBuiltin("log", r.logBuiltin),
+		"math":       starlarkmath.Module,
+	}
+	for name, guard := range r.guards() {
+		predeclared[name] = guard
+	}
+	return predeclared, nil
+}
+
+// collectChanges compares the record as the script left it with the input record
+func (r *run) collectChanges(record starlark.Value) error {
+	dict, ok := record.(*starlark.Dict)
+	if !ok {
+		return nil
+	}
+	changes := make(models.SObject)
+	for _, item := range dict.Items() {
+		key, ok := item[0].(starlark.String)
+		if !ok {
+			return fmt.Errorf("record keys must be strings, got %s", item[0].Type())
+		}
+		if before, ok := r.original[string(key)]; ok {
+			if same, err := starlark.Equal(before, item[1]); err == nil && same {
+				continue
+			}
+		}
+		value, err := FromStarlark(item[1])
+		if err != nil {
+			return fmt.Errorf("record field %s: %w", key, err)
+		}
+		changes[string(key)] = value
+	}
+	if len(changes) > 0 {
+		r.result.Changes = changes
+	}
+	return nil
+}
+
+// ==================== Built-ins ====================
+
+// query(object, where="", order_by="", limit=0) returns a list of records
+func (r *run) query(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var object, where, orderBy string
+	var limit int
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "object", &object, "where?", &where, "order_by?", &orderBy, "limit?", &limit); err != nil {
+		return nil, err
+	}
+	// Ask for one row more than the quota leaves, so going over it is noticed
+	remaining := r.limits.MaxQueryRows - r.result.QueryRows
+	if limit <= 0 || limit > remaining+1 {
+		limit = remaining + 1
+	}
+	records, err := r.host.Query(r.ctx, object, where, orderBy, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.countQueryRows(len(records)); err != nil {
+		return nil, err
+	}
+	size := uint64(headerSize + len(records)*valueSize)
+	for _, record := range records {
+		size = add(size, hostSize(record))
+	}
+	if err := r.reserve(thread, size); err != nil {
+		return nil, err
+	}
+	list := make([]starlark.Value, 0, len(records))
+	for _, record := range records {
+		dict, err := toDict(record)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, dict)
+	}
+	return starlark.NewList(list), nil
+}
+
+// get(object, id) returns a record, or None
+func (r *run) get(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var object, id string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "object", &object, "id", &id); err != nil {
+		return nil, err
+	}
+	record, err := r.host.Get(r.ctx, object, id)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return starlark.None, nil
+	}
+	if err := r.countQueryRows(1); err != nil {
+		return nil, err
+	}
+	if err := r.reserve(thread, hostSize(record)); err != nil {
+		return nil, err
+	}
+	return toDict(record)
+}
+
+// insert(object, fields) creates a record and returns its ID
+func (r *run) insert(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var object string
+	var fields *starlark.Dict
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "object", &object, "fields", &fields); err != nil {
+		return nil, err
+	}
+	record, err := fromDict(fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.countDML(); err != nil {
+		return nil, err
+	}
+	id, err := r.host.Insert(r.ctx, object, record)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.String(id), nil
+}
+
+// update(object, id, fields) changes fields of a record
+func (r *run) update(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var object, id string
+	var fields *starlark.Dict
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "object", &object, "id", &id, "fields", &fields); err != nil {
+		return nil, err
+	}
+	updates, err := fromDict(fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.countDML(); err != nil {
+		return nil, err
+	}
+	return starlark.None, r.host.Update(r.ctx, object, id, updates)
+}
+
+// delete(object, id) deletes a record
+func (r *run) delete(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var object, id string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "object", &object, "id", &id); err != nil {
+		return nil, err
+	}
+	if err := r.countDML(); err != nil {
+		return nil, err
+	}
+	return starlark.None, r.host.Delete(r.ctx, object, id)
+}
+
+// http(credential, path="", method="GET", body=None, headers={}) calls out through a named
+// credential and returns {"status": ..., "body": ...}
+func (r *run) http(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var credential, path string
+	method := "GET"
+	var body starlark.Value = starlark.None
+	var headers *starlark.Dict
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "credential", &credential, "path?", &path, "method?", &method,
+		"body?", &body, "headers?", &headers); err != nil {
+		return nil, err
+	}
+	requestBody, err := FromStarlark(body)
+	if err != nil {
+		return nil, fmt.Errorf("body: %w", err)
+	}
+	var requestHeaders map[string]string
+	if headers != nil {
+		requestHeaders = make(map[string]string, headers.Len())
+		for _, item := range headers.Items() {
+			name, ok := starlark.AsString(item[0])
+			value, ok2 := starlark.AsString(item[1])
+			if !ok || !ok2 {
+				return nil, fmt.Errorf("headers must map strings to strings")
+			}
+			requestHeaders[name] = value
+		}
+	}
+
+	if r.result.Callouts >= r.limits.MaxCallouts {
+		return nil, &LimitError{Limit: LimitCallouts, Max: fmt.Sprint(r.limits.MaxCallouts)}
+	}
+	r.result.Callouts++
+	resp, err := r.host.Callout(r.ctx, credential, strings.ToUpper(method), path, requestHeaders, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.reserve(thread, hostSize(resp.Body)); err != nil {
+		return nil, err
+	}
+	return toDict(map[string]interface{}{"status": resp.StatusCode, "body": resp.Body})
+}
+
+// log(*args) writes a line to the script's log
+func (r *run) logBuiltin(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(kwargs) > 0 {
+		return nil, fmt.Errorf("%s: unexpected keyword arguments", b.Name())
+	}
+	if err := r.reserve(thread, builtinSize("log")(args, nil)); err != nil {
+		return nil, err
+	}
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		if s, ok := starlark.AsString(arg); ok {
+			parts[i] = s
+		} else {
+			parts[i] = arg.String()
+		}
+	}
+	r.log(strings.Join(parts, " "))
+	return starlark.None, nil
+}
+
+func (r *run) countQueryRows(n int) error {
+	r.result.QueryRows += n
+	if r.result.QueryRows > r.limits.MaxQueryRows {
+		return &LimitError{Limit: LimitQueryRows, Max: fmt.Sprint(r.limits.MaxQueryRows)}
+	}
+	return nil
+}
+
+func (r *run) countDML() error {
+	if r.result.DMLRows >= r.limits.MaxDMLRows {
+		return &LimitError{Limit: LimitDMLRows, Max: fmt.Sprint(r.limits.MaxDMLRows)}
+	}
+	r.result.DMLRows++
+	return nil
+}
+
+// toDict converts fields to a dict with sorted keys
+func toDict(fields map[string]interface{}) (*starlark.Dict, error) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	dict := starlark.NewDict(len(keys))
+	for _, k := range keys {
+		value, err := ToStarlark(fields[k])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", k, err)
+		}
+		if err := dict.SetKey(starlark.String(k), value); err != nil {
+			return nil, err
+		}
+	}
+	return dict, nil
+}
+
+func fromDict(dict *starlark.Dict) (models.SObject, error) {
+	value, err := FromStarlark(dict)
+	if err != nil {
+		return nil, err
+	}
+	return models.SObject(value.(map[string]interface{})), nil
+}
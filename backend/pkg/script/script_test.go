@@ -0,0 +1,169 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHost struct {
+	records  []models.SObject
+	inserted []models.SObject
+	updated  map[string]models.SObject
+	deleted  []string
+	requests []string
+}
+
+func (h *fakeHost) Query(ctx context.Context, objectName, where, orderBy string, limit int) ([]models.SObject, error) {
+	if objectName != "account" {
+		return nil, errors.New("insufficient permissions to read " + objectName)
+	}
+	if limit < len(h.records) {
+		return h.records[:limit], nil
+	}
+	return h.records, nil
+}
+
+func (h *fakeHost) Get(ctx context.Context, objectName, id string) (models.SObject, error) {
+	for _, r := range h.records {
+		if r["id"] == id {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *fakeHost) Insert(ctx context.Context, objectName string, fields models.SObject) (string, error) {
+	h.inserted = append(h.inserted, fields)
+	return fmt.Sprintf("new-%d", len(h.inserted)), nil
+}
+
+func (h *fakeHost) Update(ctx context.Context, objectName, id string, fields models.SObject) error {
+	if h.updated == nil {
+		h.updated = make(map[string]models.SObject)
+	}
+	h.updated[id] = fields
+	return nil
+}
+
+func (h *fakeHost) Delete(ctx context.Context, objectName, id string) error {
+	h.deleted = append(h.deleted, id)
+	return nil
+}
+
+func (h *fakeHost) Callout(ctx context.Context, credential, method, path string, headers map[string]string, body interface{}) (*Response, error) {
+	h.requests = append(h.requests, method+" "+credential+path)
+	return &Response{StatusCode: 200, Body: map[string]interface{}{"score": 42.0}}, nil
+}
+
+func TestRun(t *testing.T) {
+	host := &fakeHost{records: []models.SObject{{"id": "a1", "name": "Acme"}, {"id": "a2", "name": "Globex"}}}
+	source := `
+accounts = query("account", where="name != ''", limit=10)
+log("found", len(accounts))
+record["description"] = "Accounts: " + ", ".join([a["name"] for a in accounts])
+record["score"] = http("scoring", path="/score", method="post", body={"id": record["id"]})["body"]["score"]
+new_id = insert("task", {"subject": "Follow up", "what_id": record["id"]})
+update("account", "a1", {"rating": "Hot"})
+result = {"task": new_id, "old": old_record["amount"]}
+`
+	res, err := Run(context.Background(), "enrich", source, Input{
+		Event:     "beforeUpdate",
+		Record:    models.SObject{"id": "o1", "amount": 100.0, "tags": []interface{}{"a"}},
+		OldRecord: models.SObject{"amount": 50.0},
+	}, host, Limits{})
+	require.NoError(t, err)
+
+	assert.Equal(t, models.SObject{"description": "Accounts: Acme, Globex", "score": 42.0}, res.Changes, "unchanged fields are not reported")
+	assert.Equal(t, map[string]interface{}{"task": "new-1", "old": 50.0}, res.Value)
+	assert.Equal(t, "found 2\n", res.Output)
+	assert.Equal(t, 2, res.DMLRows)
+	assert.Equal(t, 2, res.QueryRows)
+	assert.Equal(t, 1, res.Callouts)
+	assert.Positive(t, res.Steps)
+	assert.Equal(t, []string{"POST scoring/score"}, host.requests)
+	assert.Equal(t, models.SObject{"rating": "Hot"}, host.updated["a1"])
+}
+
+func TestRunErrors(t *testing.T) {
+	host := &fakeHost{records: []models.SObject{{"id": "a1"}, {"id": "a2"}, {"id": "a3"}}}
+
+	_, err := Run(context.Background(), "guard", `fail("Discount above", 30, "percent")`, Input{}, host, Limits{})
+	var scriptErr *Error
+	require.ErrorAs(t, err, &scriptErr)
+	assert.Equal(t, "Discount above 30 percent", scriptErr.Message)
+	assert.Contains(t, scriptErr.Backtrace, "guard.star")
+
+	_, err = Run(context.Background(), "denied", `query("secret")`, Input{}, host, Limits{})
+	require.ErrorAs(t, err, &scriptErr)
+	assert.Contains(t, scriptErr.Message, "insufficient permissions")
+
+	_, err = Run(context.Background(), "frozen", `old_record["amount"] = 1`, Input{OldRecord: models.SObject{"amount": 1}}, host, Limits{})
+	assert.ErrorContains(t, err, "frozen")
+
+	assert.Error(t, Check("typo", `x = undefined_name + 1`))
+	assert.Error(t, Check("syntax", `if true`))
+	assert.NoError(t, Check("ok", "while True:\n    break\nresult = len(query('account'))"))
+}
+
+func TestRunLimits(t *testing.T) {
+	host := &fakeHost{records: []models.SObject{{"id": "a1"}, {"id": "a2"}, {"id": "a3"}}}
+	cases := []struct {
+		name   string
+		source string
+		limits Limits
+		limit  string
+	}{
+		{"steps", "while True:\n    pass", Limits{MaxSteps: 10000}, LimitSteps},
+		{"timeout", "while True:\n    pass", Limits{MaxSteps: 1 << 62, Timeout: 50 * time.Millisecond}, LimitTimeout},
+		{"dml", "for i in range(3):\n    delete('account', 'a1')", Limits{MaxDMLRows: 2}, LimitDMLRows},
+		{"query rows", "query('account')", Limits{MaxQueryRows: 2}, LimitQueryRows},
+		{"callouts", "http('a')\nhttp('b')", Limits{MaxCallouts: 1}, LimitCallouts},
+		{"memory", "x = 'x' * (1 << 29)", Limits{}, LimitMemory},
+		{"memory doubling", "x = 'x'\nwhile True:\n    x += x", Limits{MaxSteps: 1 << 62}, LimitMemory},
+		{"memory join", "x = ','.join(['x' * 100000] * 1000)", Limits{}, LimitMemory},
+		{"memory retained", "x = []\nwhile True:\n    x.append('x' * 100000)", Limits{MaxSteps: 1 << 62, MaxMemory: 8 << 20}, LimitMemory},
+		{"memory comprehension", "x = ['x' * 100000 for i in range(1000)]", Limits{MaxMemory: 8 << 20}, LimitMemory},
+		{"memory range", "x = list(range(1 << 30))", Limits{}, LimitMemory},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Run(context.Background(), tc.name, tc.source, Input{}, host, tc.limits)
+			var limitErr *LimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, tc.limit, limitErr.Limit)
+			assert.NotNil(t, res)
+		})
+	}
+
+	res, err := Run(context.Background(), "chatty", "for i in range(1000):\n    print('line', i)", Input{}, host, Limits{MaxLogBytes: 100})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(res.Output), 130)
+	assert.Contains(t, res.Output, "truncated")
+}
+
+func TestRunMemory(t *testing.T) {
+	// Garbage is forgiven: only what the script still holds counts against the limit
+	source := `
+total = 0
+for i in range(200):
+    chunk = "x" * 100000
+    total += len(chunk)
+counts = {"a": [1]}
+counts["a"] *= 3
+words = ", ".join(["%s=%d" % (k, len(v)) for k, v in counts.items()])
+result = {"total": total, "words": words, "upper": "{}!".format(words.upper())}
+`
+	res, err := Run(context.Background(), "churn", source, Input{}, &fakeHost{}, Limits{MaxMemory: 8 << 20})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"total": int64(20_000_000), "words": "a=3", "upper": "A=3!"}, res.Value)
+
+	assert.ErrorContains(t, Check("reserved", "__binary__ = 1"), "reserved")
+	assert.ErrorContains(t, Check("target", "x = {}\nx[str(1)] *= 2"), "must not call functions")
+}
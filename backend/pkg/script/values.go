@@ -0,0 +1,151 @@
+package script
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/nexuscrm/shared/pkg/models"
+	"go.starlark.net/starlark"
+)
+
+// ToStarlark converts a record value to a Starlark value. Times become RFC 3339 strings and
+// values of other Go types are converted through their JSON form.
+func ToStarlark(v interface{}) (starlark.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return starlark.None, nil
+	case starlark.Value:
+		return v, nil
+	case bool:
+		return starlark.Bool(v), nil
+	case string:
+		return starlark.String(v), nil
+	case []byte:
+		return starlark.String(v), nil
+	case int:
+		return starlark.MakeInt(v), nil
+	case int8:
+		return starlark.MakeInt64(int64(v)), nil
+	case int16:
+		return starlark.MakeInt64(int64(v)), nil
+	case int32:
+		return starlark.MakeInt64(int64(v)), nil
+	case int64:
+		return starlark.MakeInt64(v), nil
+	case uint:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint8:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint16:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint32:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint64:
+		return starlark.MakeUint64(v), nil
+	case float32:
+		return starlark.Float(v), nil
+	case float64:
+		return starlark.Float(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return starlark.MakeInt64(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return starlark.Float(f), nil
+	case time.Time:
+		return starlark.String(v.UTC().Format(time.RFC3339)), nil
+	case models.SObject:
+		return toDict(v)
+	case map[string]interface{}:
+		return toDict(v)
+	case []interface{}:
+		list := make([]starlark.Value, len(v))
+		for i, item := range v {
+			value, err := ToStarlark(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		return starlark.NewList(list), nil
+	case []string:
+		list := make([]starlark.Value, len(v))
+		for i, item := range v {
+			list[i] = starlark.String(item)
+		}
+		return starlark.NewList(list), nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported value of type %T", v)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return ToStarlark(decoded)
+}
+
+// FromStarlark converts a Starlark value to a record value: None, bools, ints, floats,
+// strings, lists, tuples and dicts with string keys
+func FromStarlark(v starlark.Value) (interface{}, error) {
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(v), nil
+	case starlark.Int:
+		if i, ok := v.Int64(); ok {
+			return i, nil
+		}
+		f := float64(v.Float())
+		if math.IsInf(f, 0) {
+			return nil, fmt.Errorf("integer %s is too large", v)
+		}
+		return f, nil
+	case starlark.Float:
+		return float64(v), nil
+	case starlark.String:
+		return string(v), nil
+	case *starlark.List:
+		return fromIterable(v, v.Len())
+	case starlark.Tuple:
+		return fromIterable(v, v.Len())
+	case *starlark.Dict:
+		m := make(map[string]interface{}, v.Len())
+		for _, item := range v.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dict keys must be strings, got %s", item[0].Type())
+			}
+			value, err := FromStarlark(item[1])
+			if err != nil {
+				return nil, err
+			}
+			m[string(key)] = value
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("cannot convert a %s", v.Type())
+}
+
+func fromIterable(v starlark.Iterable, n int) ([]interface{}, error) {
+	items := make([]interface{}, 0, n)
+	iter := v.Iterate()
+	defer iter.Done()
+	var item starlark.Value
+	for iter.Next(&item) {
+		value, err := FromStarlark(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+	}
+	return items, nil
+}
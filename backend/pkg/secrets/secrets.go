@@ -0,0 +1,87 @@
+// Package secrets encrypts credentials stored in the database with AES-256-GCM.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// prefix marks an encrypted value and its format version
+const prefix = "enc:v1:"
+
+// defaultPassphrase derives the key until SetEncryptionKey runs; never use it in production
+const defaultPassphrase = "default-credential-key-change-in-production"
+
+var key = deriveKey(defaultPassphrase)
+
+// SetEncryptionKey replaces the passphrase the encryption key is derived from
+// (CREDENTIAL_ENCRYPTION_KEY, set at startup). An empty passphrase is ignored.
+func SetEncryptionKey(passphrase string) {
+	if passphrase != "" {
+		key = deriveKey(passphrase)
+	}
+}
+
+// deriveKey turns a passphrase of any length into a 32-byte AES-256 key
+func deriveKey(passphrase string) []byte {
+	sum := sha256.Sum256([]byte(passphrase))
+	return sum[:]
+}
+
+// Encrypt encrypts a plaintext secret. The empty string stays empty.
+func Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a secret produced by Encrypt
+func Decrypt(ciphertext string) (string, error) {
+	if ciphertext == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(ciphertext, prefix) {
+		return "", errors.New("value is not an encrypted secret")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, prefix))
+	if err != nil {
+		return "", fmt.Errorf("malformed secret: %w", err)
+	}
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("malformed secret: too short")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("failed to decrypt secret; was CREDENTIAL_ENCRYPTION_KEY changed?")
+	}
+	return string(plaintext), nil
+}
+
+func newGCM() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,43 @@
+package secrets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	encrypted, err := Encrypt("s3cr3t-token")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encrypted, prefix))
+	assert.NotContains(t, encrypted, "s3cr3t-token")
+
+	again, _ := Encrypt("s3cr3t-token")
+	assert.NotEqual(t, encrypted, again, "each encryption uses a fresh nonce")
+
+	decrypted, err := Decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t-token", decrypted)
+}
+
+func TestEncrypt_Empty(t *testing.T) {
+	encrypted, err := Encrypt("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", encrypted)
+	decrypted, err := Decrypt("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", decrypted)
+}
+
+func TestDecrypt_Invalid(t *testing.T) {
+	_, err := Decrypt("plaintext")
+	assert.Error(t, err)
+	_, err = Decrypt(prefix + "not-base64!")
+	assert.Error(t, err)
+
+	encrypted, _ := Encrypt("value")
+	tampered := encrypted[:len(encrypted)-4] + "AAAA"
+	_, err = Decrypt(tampered)
+	assert.Error(t, err)
+}
@@ -1,5 +1,5 @@
 import React, { useState, useEffect } from 'react';
-import { Clock, CheckCircle, XCircle, Send, User, MessageSquare } from 'lucide-react';
+import { Clock, CheckCircle, XCircle, Send, User, MessageSquare, RotateCcw, ArrowRight } from 'lucide-react';
 import { approvalsAPI, ApprovalWorkItem } from '../infrastructure/api/approvals';
 import { APPROVAL_STATUS } from '../core/constants';
 import { formatDateTime } from '../core/utils/formatting';
@@ -37,6 +37,10 @@ export function ApprovalHistory({ objectApiName, recordId }: ApprovalHistoryProp
                 return { icon: CheckCircle, color: 'text-green-500', bg: 'bg-green-50' };
             case APPROVAL_STATUS.REJECTED:
                 return { icon: XCircle, color: 'text-red-500', bg: 'bg-red-50' };
+            case APPROVAL_STATUS.RECALLED:
+                return { icon: RotateCcw, color: 'text-gray-500', bg: 'bg-gray-50' };
+            case APPROVAL_STATUS.REASSIGNED:
+                return { icon: ArrowRight, color: 'text-blue-500', bg: 'bg-blue-50' };
             case APPROVAL_STATUS.PENDING:
             default:
                 return { icon: Clock, color: 'text-amber-500', bg: 'bg-amber-50' };
@@ -100,7 +104,7 @@ export function ApprovalHistory({ objectApiName, recordId }: ApprovalHistoryProp
 
                         {item.approved_date && (
                             <div className="text-xs text-gray-400 mt-1">
-                                {item.status === APPROVAL_STATUS.APPROVED ? 'Approved' : 'Rejected'} on {formatDateTime(item.approved_date)}
+                                {item.status} on {formatDateTime(item.approved_date)}
                             </div>
                         )}
                     </div>
@@ -115,7 +119,7 @@ export function ApprovalHistory({ objectApiName, recordId }: ApprovalHistoryProp
  * Also returns the pending work item if one exists (for inline actions).
  */
 export function useApprovalStatus(objectApiName: string, recordId: string) {
-    const [status, setStatus] = useState<'Pending' | 'Approved' | 'Rejected' | null>(null);
+    const [status, setStatus] = useState<ApprovalWorkItem['status'] | null>(null);
     const [pendingItem, setPendingItem] = useState<ApprovalWorkItem | null>(null);
     const [loading, setLoading] = useState(true);
     const [refreshKey, setRefreshKey] = useState(0);
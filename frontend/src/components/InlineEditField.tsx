@@ -74,9 +74,7 @@ export const InlineEditField: React.FC<InlineEditFieldProps> = ({
 
         setSaving(true);
         try {
-            await dataAPI.updateRecord(objectApiName, recordId, {
-                [field.api_name]: editValue
-            });
+            await dataAPI.updateField(objectApiName, recordId, field.api_name, editValue);
             success('Field Updated', `${field.label} has been updated.`);
             onUpdate?.(field.api_name, editValue);
             setIsEditing(false);
@@ -1,13 +1,14 @@
 import React, { useState, useEffect } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { ArrowLeft, Lock, Share2, Send } from 'lucide-react';
-import { ObjectMetadata, SObject, PageLayout, FieldMetadata } from '../types';
+import { ObjectMetadata, SObject, PageLayout, FieldMetadata, ActionMetadata } from '../types';
 import { dataAPI } from '../infrastructure/api/data';
 import { Button } from './ui/Button';
 import { EmptyState, ErrorEmptyState, AccessDeniedEmptyState } from './ui/EmptyState';
 import { MetadataAwareSkeleton } from './ui/LoadingSkeleton';
 import { useToast, useSuccessToast, useErrorToast } from './ui/Toast';
 import { formatApiError, getOperationErrorMessage, AppError } from '../core/utils/errorHandling';
+import { ERROR_CODES } from '../generated-error-codes';
 import { usePermissions } from '../contexts/PermissionContext';
 import { getHighlightFields, getPathField } from '../core/utils/recordUtils';
 import { SYSTEM_FIELDS } from '../core/constants/CommonFields';
@@ -33,6 +34,7 @@ interface MetadataRecordDetailProps {
     objectMetadata: ObjectMetadata;
     recordId: string;
     layout?: PageLayout | null;
+    actions?: ActionMetadata[]; // Preloaded actions; fetched by the component when omitted
     onBack?: () => void;
     extraActions?: React.ReactNode;
 }
@@ -41,6 +43,7 @@ export function MetadataRecordDetail({
     objectMetadata,
     recordId,
     layout,
+    actions: preloadedActions,
     onBack,
     extraActions,
 }: MetadataRecordDetailProps) {
@@ -48,7 +51,8 @@ export function MetadataRecordDetail({
     const showSuccess = useSuccessToast();
     const showError = useErrorToast();
     const { hasObjectPermission, hasFieldPermission } = usePermissions();
-    const { actions, refresh: refreshActions } = useActions(objectMetadata.api_name);
+    const { actions: fetchedActions, refresh: refreshActions } = useActions(preloadedActions ? '' : objectMetadata.api_name);
+    const actions = preloadedActions ?? fetchedActions;
     const { status: approvalStatus, pendingItem, loading: approvalStatusLoading, refresh: refreshApprovalStatus } = useApprovalStatus(objectMetadata.api_name, recordId);
 
     const [record, setRecord] = useState<SObject | null>(null);
@@ -114,7 +118,7 @@ export function MetadataRecordDetail({
 
     if (error || !record) {
         // Show access denied if 403 error
-        if (error?.code === 'FORBIDDEN') {
+        if (error?.code === ERROR_CODES.FORBIDDEN || error?.code === ERROR_CODES.PERMISSION_DENIED) {
             return <AccessDeniedEmptyState onGoBack={() => navigate(-1)} />;
         }
         return <ErrorEmptyState onRetry={loadRecord} />;
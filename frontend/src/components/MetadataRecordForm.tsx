@@ -15,6 +15,16 @@ import { FIELD_TYPES } from '../core/constants/SchemaDefinitions';
 // Additional hidden fields beyond SYSTEM_FIELDS
 const ADDITIONAL_HIDDEN = ['system_modstamp'] as const;
 
+// Picks the edited record values a lookup filter reads through $source.<field>
+function lookupDependentValues(field: FieldMetadata, values: Record<string, unknown>): Record<string, unknown> | undefined {
+    if (!field.lookup_filter) return undefined;
+    const dependent: Record<string, unknown> = {};
+    for (const [, name] of field.lookup_filter.matchAll(/\$source\.([A-Za-z_][A-Za-z0-9_]*)/g)) {
+        dependent[name] = values[name] ?? null;
+    }
+    return dependent;
+}
+
 interface MetadataRecordFormProps {
     objectMetadata: ObjectMetadata;
     recordId?: string; // If present, edit mode
@@ -34,7 +44,7 @@ export function MetadataRecordForm({
     const showSuccess = useSuccessToast();
     const showError = useErrorToast();
 
-    const { control, handleSubmit, setValue, formState: { errors, isSubmitting }, reset } = useForm({
+    const { control, handleSubmit, setValue, setError, formState: { errors, isSubmitting }, reset } = useForm({
         defaultValues: initialData || {}
     });
 
@@ -144,6 +154,10 @@ export function MetadataRecordForm({
             onSuccess?.(savedRecord);
         } catch (err: unknown) {
             const apiError = formatApiError(err);
+            // Show field errors next to the field the backend named
+            if (apiError.field && objectMetadata.fields.some(f => f.api_name === apiError.field)) {
+                setError(apiError.field, { type: 'server', message: apiError.message });
+            }
             showError(getOperationErrorMessage(isEdit ? 'update' : 'create', objectMetadata.label, apiError));
         }
     };
@@ -276,6 +290,8 @@ export function MetadataRecordForm({
                             <SearchableLookup
                                 objectApiName={field.reference_to || ''}
                                 objectType={(values as Record<string, unknown>)[field.api_name + '_type'] as string | undefined}
+                                lookupField={`${objectMetadata.api_name}.${field.api_name}`}
+                                dependentValues={lookupDependentValues(field, values as Record<string, unknown>)}
                                 value={value as string}
                                 onChange={(newValue, selectedRecord) => {
                                     onChange(newValue);
@@ -379,7 +395,7 @@ export function MetadataRecordForm({
                             </label>
                             {renderFieldInput(field)}
                             {errors[field.api_name] && (
-                                <p className="mt-1 text-sm text-red-600">This field is required</p>
+                                <p className="mt-1 text-sm text-red-600">{String(errors[field.api_name]?.message || 'This field is required')}</p>
                             )}
                         </div>
                     );
@@ -2,7 +2,7 @@
 import React, { useState, useEffect, useRef } from 'react';
 import { Search, X, ChevronDown, Check, Loader2, Plus, ArrowRight } from 'lucide-react';
 import { dataAPI } from '../infrastructure/api/data';
-import type { SObject } from '../types';
+import type { SObject, LookupRecord, LookupResult } from '../types';
 import { KEYS, DOM_EVENTS } from '../core/constants';
 import { useDebounce } from '../core/hooks/useDebounce';
 import { getRecordDisplayName } from '../core/utils/recordUtils';
@@ -16,8 +16,18 @@ interface SearchableLookupProps {
     placeholder?: string;
     error?: boolean;
     objectType?: string; // For Polymorphic Lookups: The specific type of the current value
+    lookupField?: string; // "object.field" whose lookup filter limits the candidates
+    dependentValues?: Record<string, unknown>; // Edited record values the lookup filter reads
 }
 
+// Flattens a lookup candidate into a record the picker can display
+const toLookupRecord = (result: LookupResult, record: LookupRecord): SObject => ({
+    ...record.fields,
+    [COMMON_FIELDS.ID]: record.id,
+    [COMMON_FIELDS.NAME]: record.name,
+    [result.name_field]: record.name,
+});
+
 export const SearchableLookup: React.FC<SearchableLookupProps> = ({
     objectApiName,
     value,
@@ -25,7 +35,9 @@ export const SearchableLookup: React.FC<SearchableLookupProps> = ({
     disabled = false,
     placeholder = 'Search...',
     error = false,
-    objectType
+    objectType,
+    lookupField,
+    dependentValues
 }) => {
     const [searchTerm, setSearchTerm] = useState('');
     const [results, setResults] = useState<SObject[]>([]);
@@ -38,6 +50,7 @@ export const SearchableLookup: React.FC<SearchableLookupProps> = ({
     const containerRef = useRef<HTMLDivElement>(null);
     const inputRef = useRef<HTMLInputElement>(null);
     const debouncedSearchTerm = useDebounce(searchTerm, 300);
+    const dependentValuesKey = JSON.stringify(dependentValues ?? {});
 
     // Initial load handling
     useEffect(() => {
@@ -116,17 +129,19 @@ export const SearchableLookup: React.FC<SearchableLookupProps> = ({
                 setIsLoading(true);
                 let records: SObject[] = [];
 
+                const options = { field: lookupField, dependentValues: JSON.parse(dependentValuesKey) };
                 if (Array.isArray(objectApiName)) {
                     // Polymorphic Search: Search all allowed objects
                     const promises = objectApiName.map(obj =>
-                        dataAPI.searchSingleObject(obj, debouncedSearchTerm)
-                            .then(recs => recs.map(r => ({ ...r, _object_type: obj }))) // Tag with type
+                        dataAPI.lookup(obj, debouncedSearchTerm, options)
+                            .then(res => res.records.map(r => ({ ...toLookupRecord(res, r), _object_type: obj }))) // Tag with type
                             .catch(() => [])
                     );
                     const results = await Promise.all(promises);
                     records = results.flat();
                 } else {
-                    records = await dataAPI.searchSingleObject(objectApiName, debouncedSearchTerm);
+                    const res = await dataAPI.lookup(objectApiName, debouncedSearchTerm, options);
+                    records = res.records.map(r => toLookupRecord(res, r));
                 }
 
                 setResults(records);
@@ -142,7 +157,7 @@ export const SearchableLookup: React.FC<SearchableLookupProps> = ({
         if (debouncedSearchTerm && isOpen) {
             search();
         }
-    }, [debouncedSearchTerm, objectApiName, isOpen, selectedRecord]);
+    }, [debouncedSearchTerm, objectApiName, isOpen, selectedRecord, lookupField, dependentValuesKey]);
 
     // Click outside to close
     useEffect(() => {
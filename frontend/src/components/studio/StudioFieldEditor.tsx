@@ -30,6 +30,8 @@ export const StudioFieldEditor: React.FC<StudioFieldEditorProps> = ({
         type: 'Text' as FieldType,
         required: false,
         unique: false,
+        is_indexed: false,
+        track_history: false,
         options: '', // For picklist, newline-separated
         reference_to: [] as string[],
         description: '',
@@ -53,6 +55,8 @@ export const StudioFieldEditor: React.FC<StudioFieldEditorProps> = ({
                 type: field.type,
                 required: field.required || false,
                 unique: field.unique || false,
+                is_indexed: field.is_indexed || false,
+                track_history: field.track_history || false,
                 options: field.options?.join('\n') || '',
                 reference_to: field.reference_to || [],
                 description: field.description || '',
@@ -152,6 +156,7 @@ export const StudioFieldEditor: React.FC<StudioFieldEditorProps> = ({
                 type: formData.type,
                 required: formData.required,
                 unique: formData.unique,
+                is_indexed: formData.is_indexed && !formData.unique,
                 description: formData.description || undefined,
                 help_text: formData.help_text || undefined,
                 default_value: formData.default_value || undefined,
@@ -159,6 +164,7 @@ export const StudioFieldEditor: React.FC<StudioFieldEditorProps> = ({
 
             if (formData.type === 'Picklist') {
                 fieldData.options = formData.options.split('\n').map(s => s.trim()).filter(Boolean);
+                fieldData.track_history = formData.track_history;
             }
 
             if (formData.type === 'Lookup') {
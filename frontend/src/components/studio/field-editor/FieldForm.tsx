@@ -3,12 +3,19 @@ import React from 'react';
 import type { FieldType, ObjectMetadata } from '../../../types';
 import { FIELD_TYPE_OPTIONS } from './FieldTypeSelector';
 
+// Field types stored in columns a secondary index can cover (see isIndexableFieldType)
+const INDEXABLE_TYPES: FieldType[] = [
+    'Text', 'Email', 'Phone', 'Picklist', 'AutoNumber', 'Number', 'Currency', 'Percent', 'Boolean', 'Date', 'DateTime',
+];
+
 interface FieldFormData {
     label: string;
     api_name: string;
     type: FieldType;
     required: boolean;
     unique: boolean;
+    is_indexed: boolean;
+    track_history: boolean;
     options: string;
     reference_to: string[];
     description: string;
@@ -245,6 +252,28 @@ export const FieldForm: React.FC<FieldFormProps> = ({
                         <span className="text-sm text-slate-700">Unique</span>
                     </label>
                 )}
+                {INDEXABLE_TYPES.includes(formData.type) && !formData.unique && (
+                    <label className="flex items-center gap-2 cursor-pointer" title="Speeds up filtering and sorting on this field">
+                        <input
+                            type="checkbox"
+                            checked={formData.is_indexed}
+                            onChange={(e) => onChange({ is_indexed: e.target.checked })}
+                            className="w-4 h-4 text-blue-600 rounded focus:ring-blue-500"
+                        />
+                        <span className="text-sm text-slate-700">Indexed</span>
+                    </label>
+                )}
+                {formData.type === 'Picklist' && (
+                    <label className="flex items-center gap-2 cursor-pointer" title="Records how long records stay in each value for funnel and velocity reports">
+                        <input
+                            type="checkbox"
+                            checked={formData.track_history}
+                            onChange={(e) => onChange({ track_history: e.target.checked })}
+                            className="w-4 h-4 text-blue-600 rounded focus:ring-blue-500"
+                        />
+                        <span className="text-sm text-slate-700">Track Stage History</span>
+                    </label>
+                )}
             </div>
 
             {/* Help Text */}
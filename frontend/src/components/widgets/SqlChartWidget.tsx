@@ -25,6 +25,7 @@ export const SqlChartWidget: React.FC<WidgetRendererProps> = ({
     const [data, setData] = useState<Record<string, unknown>[]>([]);
     const [loading, setLoading] = useState(false);
     const [error, setError] = useState<string | null>(null);
+    const [truncated, setTruncated] = useState(false);
     const [sqlInput, setSqlInput] = useState(configuredSql);
 
     // Execute Query Function
@@ -43,7 +44,7 @@ export const SqlChartWidget: React.FC<WidgetRendererProps> = ({
             // User research said bind vars.
             // Let's assume the user writes "WHERE created_date > @startTime" and we regex replace it?
             // Or better, we pass named params if backend supports it.
-            // Our backend `ExecuteRawSQL` takes `[]interface{}`. So it supports positional `?`.
+            // Our backend `ExecuteAdminQuery` takes `[]interface{}`. So it supports positional `?`.
             // We need to parse named params -> positional params here? Or just let user use `?`?
             // "Best practice" research said named args.
             // But `database/sql` uses `?` for standard replacement usually (or `$1` for postgres).
@@ -81,6 +82,7 @@ export const SqlChartWidget: React.FC<WidgetRendererProps> = ({
 
             const result = await analyticsAPI.executeAdminQuery(finalSql, finalParams);
             setData(result.data || []);
+            setTruncated(!!result.truncated);
         } catch (err: unknown) {
             const message = err instanceof Error ? err.message : "Failed to execute query";
             setError(message);
@@ -234,6 +236,11 @@ export const SqlChartWidget: React.FC<WidgetRendererProps> = ({
                     )}
                 </div>
             )}
+            {!isEditing && !error && truncated && (
+                <p className="mt-2 text-xs text-amber-700">
+                    Showing the first {data.length} rows only; the query row limit was reached.
+                </p>
+            )}
         </div>
     );
 };
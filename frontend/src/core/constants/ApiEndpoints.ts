@@ -16,14 +16,28 @@ export const API_ENDPOINTS = {
         // Permission related routes
         PROFILE_PERMISSIONS: (profileId: string) => `/api/auth/profiles/${profileId}/permissions`,
         PROFILE_FIELD_PERMISSIONS: (profileId: string) => `/api/auth/profiles/${profileId}/permissions/fields`,
+        PROFILE_QUERY_LIMITS: (profileId: string) => `/api/auth/profiles/${profileId}/query-limits`,
         PERM_SET_PERMISSIONS: (permSetId: string) => `/api/auth/permission-sets/${permSetId}/permissions`,
         PERM_SET_FIELD_PERMISSIONS: (permSetId: string) => `/api/auth/permission-sets/${permSetId}/permissions/fields`,
         USER_EFFECTIVE_PERMISSIONS: (userId: string) => `/api/auth/users/${userId}/permissions/effective`,
         USER_EFFECTIVE_FIELD_PERMISSIONS: (userId: string) => `/api/auth/users/${userId}/permissions/fields/effective`,
+        USER_OWNERSHIP: (userId: string) => `/api/auth/users/${userId}/ownership`,
+        USER_DEACTIVATE: (userId: string) => `/api/auth/users/${userId}/deactivate`,
+    },
+    SETUP: {
+        STATE: '/api/setup',
+        COMPLETE: '/api/setup',
     },
     METADATA: {
         OBJECTS: '/api/metadata/objects',
         FIELDS: (objectApiName: string) => `/api/metadata/objects/${objectApiName}/fields`,
+        OBJECT_DEPENDENCIES: (objectApiName: string) => `/api/metadata/objects/${encodeURIComponent(objectApiName)}/dependencies`,
+        OBJECT_DESCRIBE_FULL: (objectApiName: string) => `/api/metadata/objects/${encodeURIComponent(objectApiName)}/describe-full`,
+        FIELD_DEPENDENCIES: (objectApiName: string, fieldApiName: string) =>
+            `/api/metadata/fields/${encodeURIComponent(`${objectApiName}.${fieldApiName}`)}/dependencies`,
+        DELETED: '/api/metadata/deleted',
+        DELETED_ITEM: (id: string) => `/api/metadata/deleted/${encodeURIComponent(id)}`,
+        DELETED_RESTORE: (id: string) => `/api/metadata/deleted/${encodeURIComponent(id)}/restore`,
         LAYOUTS: '/api/metadata/layouts',
         APPS: '/api/metadata/apps',
         ACTIONS: (objectApiName: string) => `/api/metadata/actions/${objectApiName}`,
@@ -35,9 +49,77 @@ export const API_ENDPOINTS = {
         FLOWS: '/api/metadata/flows',
         FLOW: (flowId: string) => `/api/metadata/flows/${flowId}`,
         LAYOUT: (objectApiName: string) => `/api/metadata/layouts/${objectApiName}`,
+        LAYOUT_RESOLVED: (objectApiName: string) => `/api/metadata/layouts/${objectApiName}/resolved`,
         LAYOUT_ID: (layoutId: string) => `/api/metadata/layouts/${layoutId}`,
         LAYOUT_ASSIGN: '/api/metadata/layouts/assign',
+        RECORD_TYPES: (objectApiName: string) => `/api/metadata/objects/${objectApiName}/record-types`,
+        RECORD_TYPES_AVAILABLE: (objectApiName: string) => `/api/metadata/objects/${objectApiName}/record-types/available`,
+        RECORD_TYPE: (id: string) => `/api/metadata/record-types/${id}`,
+        RECORD_TYPE_ASSIGNMENTS: '/api/metadata/record-types/assignments',
+        RECORD_TYPE_ASSIGN: '/api/metadata/record-types/assign',
+        RECORD_TYPE_ASSIGNMENT: (id: string, profileId: string) => `/api/metadata/record-types/${id}/assignments/${profileId}`,
+        OBJECT_STATE_MACHINES: (objectApiName: string) => `/api/metadata/objects/${objectApiName}/state-machines`,
+        STATE_MACHINES: '/api/metadata/state-machines',
+        STATE_MACHINE: (name: string) => `/api/metadata/state-machines/${name}`,
+        PICKLIST_VALUES: (objectApiName: string, fieldApiName: string) => `/api/metadata/objects/${objectApiName}/fields/${fieldApiName}/picklist-values`,
+        ASYNC_JOB: (id: string) => `/api/metadata/async-jobs/${id}`,
+        ASYNC_JOB_ABORT: (id: string) => `/api/metadata/async-jobs/${id}/abort`,
+        AUTO_NUMBER: (objectApiName: string, fieldApiName: string) => `/api/metadata/objects/${objectApiName}/fields/${fieldApiName}/auto-number`,
+        FIELD_VALUE_SET: (objectApiName: string, fieldApiName: string) => `/api/metadata/objects/${objectApiName}/fields/${fieldApiName}/value-set`,
+        CUSTOM_METADATA_TYPES: '/api/metadata/custom-metadata-types',
+        CUSTOM_METADATA_TYPE: (typeName: string) => `/api/metadata/custom-metadata-types/${typeName}`,
+        CUSTOM_METADATA_RECORDS: (typeName: string) => `/api/metadata/custom-metadata-types/${typeName}/records`,
+        CUSTOM_METADATA_RECORD: (typeName: string, developerName: string) => `/api/metadata/custom-metadata-types/${typeName}/records/${developerName}`,
+        CUSTOM_SETTINGS: '/api/metadata/custom-settings',
+        CUSTOM_SETTINGS_RESOLVED: '/api/metadata/custom-settings/resolved',
+        CUSTOM_SETTING: (name: string) => `/api/metadata/custom-settings/${name}`,
+        CUSTOM_SETTING_VALUES: (name: string) => `/api/metadata/custom-settings/${name}/values`,
+        CUSTOM_SETTING_VALUE: (name: string, scope: string, scopeId: string) => `/api/metadata/custom-settings/${name}/values/${scope}/${scopeId}`,
+        NAMED_CREDENTIALS: '/api/metadata/named-credentials',
+        NAMED_CREDENTIAL: (name: string) => `/api/metadata/named-credentials/${name}`,
+        NAMED_CREDENTIAL_CALLOUT: (name: string) => `/api/metadata/named-credentials/${name}/callout`,
+        INBOUND_HOOKS: '/api/metadata/inbound-hooks',
+        INBOUND_HOOK: (slug: string) => `/api/metadata/inbound-hooks/${slug}`,
+        DOCUMENT_TEMPLATES: '/api/metadata/document-templates',
+        DOCUMENT_TEMPLATE: (id: string) => `/api/metadata/document-templates/${id}`,
+        EXTERNAL_OBJECTS: '/api/metadata/external-objects',
+        EXTERNAL_OBJECT: (apiName: string) => `/api/metadata/external-objects/${apiName}`,
+        EXTERNAL_OBJECT_SOURCE: (apiName: string) => `/api/metadata/external-objects/${apiName}/source`,
+        BUSINESS_HOURS: '/api/metadata/business-hours',
+        BUSINESS_HOURS_BY_NAME: (name: string) => `/api/metadata/business-hours/${name}`,
+        HOLIDAYS: (name: string) => `/api/metadata/business-hours/${name}/holidays`,
+        HOLIDAY: (name: string, id: string) => `/api/metadata/business-hours/${name}/holidays/${id}`,
+        SLA_POLICIES: '/api/metadata/sla-policies',
+        SLA_POLICY: (name: string) => `/api/metadata/sla-policies/${name}`,
+        ESCALATION_RULES: '/api/metadata/escalation-rules',
+        ESCALATION_RULE: (name: string) => `/api/metadata/escalation-rules/${name}`,
+        SCRIPTS: '/api/metadata/scripts',
+        SCRIPT: (name: string) => `/api/metadata/scripts/${name}`,
+        SCRIPT_RUN: (name: string) => `/api/metadata/scripts/${name}/run`,
+        SCRIPT_LOGS: (name: string) => `/api/metadata/scripts/${name}/logs`,
+        CUSTOM_ENDPOINTS: '/api/metadata/custom-endpoints',
+        CUSTOM_ENDPOINT: (name: string) => `/api/metadata/custom-endpoints/${name}`,
+        BATCH_JOBS: '/api/metadata/batch-jobs',
+        BATCH_JOB: (name: string) => `/api/metadata/batch-jobs/${name}`,
+        BATCH_JOB_RUN: (name: string) => `/api/metadata/batch-jobs/${name}/run`,
+        BATCH_JOB_ABORT: (name: string) => `/api/metadata/batch-jobs/${name}/abort`,
+        ARCHIVE_POLICIES: '/api/metadata/archive-policies',
+        ARCHIVE_POLICY: (objectApiName: string) => `/api/metadata/archive-policies/${objectApiName}`,
+        ARCHIVE_POLICY_RUN: (objectApiName: string) => `/api/metadata/archive-policies/${objectApiName}/run`,
+        PORTAL_OBJECTS: '/api/metadata/portal-objects',
+        TRANSLATIONS: '/api/metadata/translations',
+        TRANSLATION_LOCALE: (locale: string) => `/api/metadata/translations/${locale}`,
+        TRANSLATION_EXPORT: (locale: string) => `/api/metadata/translations/${locale}/export`,
+        TRANSLATION_IMPORT: (locale: string) => `/api/metadata/translations/${locale}/import`,
+        PORTAL_OBJECT: (objectApiName: string) => `/api/metadata/portal-objects/${objectApiName}`,
+        GLOBAL_VALUE_SETS: '/api/metadata/global-value-sets',
+        GLOBAL_VALUE_SET: (name: string) => `/api/metadata/global-value-sets/${name}`,
         DASHBOARD: (id: string) => `/api/metadata/dashboards/${id}`,
+        DASHBOARD_WIDGET_IMAGE: (id: string, widgetId: string) => `/api/metadata/dashboards/${id}/widgets/${widgetId}/image`,
+        REPORTS: '/api/metadata/reports',
+        REPORT: (id: string) => `/api/metadata/reports/${id}`,
+        REPORT_PREVIEW: '/api/metadata/reports/run',
+        REPORT_CHART: (id: string) => `/api/metadata/reports/${id}/chart`,
         VALIDATION_RULE: (id: string) => `/api/metadata/validation-rules/${id}`,
         LIST_VIEW: (id: string) => `/api/metadata/listviews/${id}`,
         FIELD: (objectApiName: string, fieldApiName: string) => `/api/metadata/objects/${objectApiName}/fields/${fieldApiName}`,
@@ -45,19 +127,33 @@ export const API_ENDPOINTS = {
     DATA: {
         RECORDS: (objectName: string) => `/api/data/${objectName}`,
         RECORD: (objectName: string, id: string) => `/api/data/${objectName}/${id}`,
+        RECORD_FIELD: (objectName: string, id: string, fieldName: string) => `/api/data/${objectName}/${id}/fields/${fieldName}`,
+        RECORD_SLA: (objectName: string, id: string) => `/api/data/${objectName}/${id}/sla`,
+        RECORD_STAGE_HISTORY: (objectName: string, id: string) => `/api/data/${objectName}/${id}/stage-history`,
+        RECORD_TIMELINE: (objectName: string, id: string) => `/api/data/${objectName}/${id}/timeline`,
+        STAGE_ANALYTICS: (objectName: string) => `/api/data/${encodeURIComponent(objectName)}/stage-analytics`,
+        GENERATE_DOCUMENT: (objectName: string, id: string, templateId: string) => `/api/data/${objectName}/${id}/documents/${templateId}`,
         QUERY: '/api/data/query',
+        NLQ: '/api/data/nlq',
         SEARCH: '/api/data/search',
+        SEMANTIC_SEARCH: '/api/data/semantic-search',
         SEARCH_OBJECT: (objectApiName: string) => `/api/data/search/${encodeURIComponent(objectApiName)}`,
         RECYCLE_BIN: '/api/data/recyclebin/items',
         RESTORE: (id: string) => `/api/data/recyclebin/restore/${encodeURIComponent(id)}`,
         PURGE: (id: string) => `/api/data/recyclebin/${encodeURIComponent(id)}`,
         ANALYTICS: '/api/data/analytics',
         CALCULATE: (objectApiName: string) => `/api/data/${encodeURIComponent(objectApiName)}/calculate`,
+        KANBAN: (objectApiName: string) => `/api/data/${encodeURIComponent(objectApiName)}/kanban`,
+        KANBAN_MOVE: (objectApiName: string) => `/api/data/${encodeURIComponent(objectApiName)}/kanban/move`,
+        CALENDAR: (objectApiName: string) => `/api/data/${encodeURIComponent(objectApiName)}/calendar`,
+        LOOKUP: (objectApiName: string) => `/api/data/${encodeURIComponent(objectApiName)}/lookup`,
     },
     APPROVALS: {
         SUBMIT: '/api/approvals/submit',
         APPROVE: (workItemId: string) => `/api/approvals/${encodeURIComponent(workItemId)}/approve`,
         REJECT: (workItemId: string) => `/api/approvals/${encodeURIComponent(workItemId)}/reject`,
+        RECALL: (workItemId: string) => `/api/approvals/${encodeURIComponent(workItemId)}/recall`,
+        REASSIGN: (workItemId: string) => `/api/approvals/${encodeURIComponent(workItemId)}/reassign`,
         PENDING: '/api/approvals/pending',
         HISTORY: (objectApiName: string, recordId: string) => `/api/approvals/history/${encodeURIComponent(objectApiName)}/${encodeURIComponent(recordId)}`,
         FLOW_PROGRESS: (flowInstanceId: string) => `/api/approvals/flow-progress/${encodeURIComponent(flowInstanceId)}`,
@@ -74,6 +170,87 @@ export const API_ENDPOINTS = {
     },
     ANALYTICS: {
         QUERY: '/api/analytics/query',
+        FORECAST: '/api/analytics/forecast',
+        FORECAST_SETTINGS: (objectApiName: string) => `/api/analytics/forecast/settings/${encodeURIComponent(objectApiName)}`,
+        FORECAST_QUOTAS: '/api/analytics/forecast/quotas',
+        FORECAST_ADJUSTMENTS: '/api/analytics/forecast/adjustments',
+        FORECAST_ADJUSTMENT: (id: string) => `/api/analytics/forecast/adjustments/${encodeURIComponent(id)}`,
+    },
+    CAMPAIGNS: {
+        LIST: '/api/campaigns',
+        DETAIL: (id: string) => `/api/campaigns/${encodeURIComponent(id)}`,
+        RECALCULATE: (id: string) => `/api/campaigns/${encodeURIComponent(id)}/recalculate`,
+        MEMBERS: (id: string) => `/api/campaigns/${encodeURIComponent(id)}/members`,
+        MEMBER: (memberId: string) => `/api/campaigns/members/${encodeURIComponent(memberId)}`,
+    },
+    ORDERS: {
+        LIST: '/api/orders',
+        DETAIL: (id: string) => `/api/orders/${encodeURIComponent(id)}`,
+        ACTIVATE: (id: string) => `/api/orders/${encodeURIComponent(id)}/activate`,
+        FULFILL: (id: string) => `/api/orders/${encodeURIComponent(id)}/fulfill`,
+        CANCEL: (id: string) => `/api/orders/${encodeURIComponent(id)}/cancel`,
+    },
+    CONTRACTS: {
+        LIST: '/api/contracts',
+        DETAIL: (id: string) => `/api/contracts/${encodeURIComponent(id)}`,
+    },
+    ENTITLEMENTS: {
+        LIST: '/api/entitlements',
+        DETAIL: (id: string) => `/api/entitlements/${encodeURIComponent(id)}`,
+        USAGE: (id: string) => `/api/entitlements/${encodeURIComponent(id)}/usage`,
+    },
+    KNOWLEDGE: {
+        CATEGORIES: '/api/knowledge/categories',
+        CATEGORY: (id: string) => `/api/knowledge/categories/${encodeURIComponent(id)}`,
+        ARTICLES: '/api/knowledge/articles',
+        ARTICLE: (id: string) => `/api/knowledge/articles/${encodeURIComponent(id)}`,
+        PUBLISH: (id: string) => `/api/knowledge/articles/${encodeURIComponent(id)}/publish`,
+        ARCHIVE: (id: string) => `/api/knowledge/articles/${encodeURIComponent(id)}/archive`,
+        RESTORE: (id: string) => `/api/knowledge/articles/${encodeURIComponent(id)}/restore`,
+        VERSIONS: (id: string) => `/api/knowledge/articles/${encodeURIComponent(id)}/versions`,
+        REVERT: (id: string, version: number) => `/api/knowledge/articles/${encodeURIComponent(id)}/versions/${version}/revert`,
+        PUBLISHED: '/api/knowledge/published',
+        PUBLISHED_DETAIL: (urlName: string) => `/api/knowledge/published/${encodeURIComponent(urlName)}`,
+        SEARCH: '/api/knowledge/search',
+        SUGGESTIONS: '/api/knowledge/suggestions',
+        LINKS: '/api/knowledge/links',
+        LINK: (id: string) => `/api/knowledge/links/${encodeURIComponent(id)}`,
+    },
+    SURVEYS: {
+        LIST: '/api/surveys',
+        DETAIL: (id: string) => `/api/surveys/${encodeURIComponent(id)}`,
+        INVITATIONS: (id: string) => `/api/surveys/${encodeURIComponent(id)}/invitations`,
+        RESPONSES: (id: string) => `/api/surveys/${encodeURIComponent(id)}/responses`,
+        RECORD_RESPONSES: '/api/surveys/responses',
+        ANALYTICS: (id: string) => `/api/surveys/${encodeURIComponent(id)}/analytics`,
+        PUBLIC: (token: string) => `/api/public/surveys/${encodeURIComponent(token)}`,
+    },
+    DATA_QUALITY: {
+        DASHBOARD: '/api/data-quality/dashboard',
+        RECORDS: (objectApiName: string) => `/api/data-quality/${encodeURIComponent(objectApiName)}/records`,
+        RULE: (objectApiName: string) => `/api/data-quality/${encodeURIComponent(objectApiName)}/rule`,
+        SCORE: (objectApiName: string) => `/api/data-quality/${encodeURIComponent(objectApiName)}/score`,
+    },
+    PORTAL: {
+        LOGIN: '/api/portal/auth/login',
+        LOGOUT: '/api/portal/auth/logout',
+        CHANGE_PASSWORD: '/api/portal/auth/change-password',
+        ME: '/api/portal/me',
+        OBJECTS: '/api/portal/objects',
+        RECORDS: (objectApiName: string) => `/api/portal/data/${encodeURIComponent(objectApiName)}`,
+        RECORD: (objectApiName: string, id: string) => `/api/portal/data/${encodeURIComponent(objectApiName)}/${encodeURIComponent(id)}`,
+    },
+    ADMIN: {
+        SETUP_AUDIT: '/api/admin/setup-audit',
+        SCHEMA_DRIFT: '/api/admin/schema-drift',
+        SCHEMA_DRIFT_REPAIR: '/api/admin/schema-drift/repair',
+        INDEX_ADVISOR: '/api/admin/index-advisor',
+        READ_REPLICA: '/api/admin/read-replica',
+        MASS_TRANSFER: '/api/admin/mass-transfer',
+        MASS_UPDATE: '/api/admin/mass-update',
+        SAMPLE_DATA: '/api/admin/sample-data',
+        CONFIG: '/api/admin/config',
+        CONFIG_KEY: (key: string) => `/api/admin/config/${encodeURIComponent(key)}`,
     },
     AGENT: {
         CHAT: '/api/agent/chat',
@@ -87,4 +264,26 @@ export const API_ENDPOINTS = {
         RECORD: (recordId: string) => `/api/feed/${encodeURIComponent(recordId)}`,
         COMMENTS: '/api/feed/comments',
     },
+    SYNC: {
+        PROVIDERS: '/api/sync/providers',
+        AUTHORIZE: (provider: string) => `/api/sync/providers/${provider}/authorize`,
+        CONNECTORS: '/api/sync/connectors',
+        CONNECTOR: (id: string) => `/api/sync/connectors/${id}`,
+        CONNECTOR_RUN: (id: string) => `/api/sync/connectors/${id}/run`,
+        ACTIVITIES: (objectApiName: string, recordId: string) =>
+            `/api/sync/activities/${objectApiName}/${encodeURIComponent(recordId)}`,
+    },
+    TELEPHONY: {
+        PROVIDERS: '/api/telephony/providers',
+        DIAL: '/api/telephony/dial',
+        CALLS: '/api/telephony/calls',
+    },
+    INTEGRATION_HOOKS: {
+        ME: '/api/integrations/hooks/me',
+        TRIGGER: (objectApiName: string, event: string) => `/api/integrations/hooks/triggers/${objectApiName}/${event}`,
+        SUBSCRIPTIONS: '/api/integrations/hooks/subscriptions',
+        SUBSCRIPTION: (id: string) => `/api/integrations/hooks/subscriptions/${id}`,
+        ACTIONS: (objectApiName: string) => `/api/integrations/hooks/actions/${objectApiName}`,
+        ACTION_RECORD: (objectApiName: string, id: string) => `/api/integrations/hooks/actions/${objectApiName}/${id}`,
+    },
 };
@@ -7,6 +7,7 @@ export const APPROVAL_STATUS = {
     APPROVED: 'Approved',
     REJECTED: 'Rejected',
     RECALLED: 'Recalled',
+    REASSIGNED: 'Reassigned',
 } as const;
 
 export type ApprovalStatus = typeof APPROVAL_STATUS[keyof typeof APPROVAL_STATUS];
@@ -25,6 +25,11 @@ export const SYSTEM_FIELDS = [
     'is_deleted'
 ];
 
+/**
+ * Suffix of the key holding a lookup's referenced record name in query results
+ */
+export const LOOKUP_NAME_SUFFIX = '__name';
+
 /**
  * Check if a field name is a standard system field
  */
@@ -38,6 +38,8 @@ export const ACTION_TYPE = {
     DELETE_RECORD: 'DeleteRecord',
     SEND_EMAIL: 'SendEmail',
     CALL_WEBHOOK: 'CallWebhook',
+    CALLOUT: 'Callout',
+    RUN_SCRIPT: 'RunScript',
     COMPOSITE: 'Composite',
     APPROVAL: 'Approval',
 } as const;
@@ -1,10 +1,11 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: shared/constants/*.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T08:16:21Z
 
 // ==================== Profiles ====================
 
 export const PROFILE_IDS = {
+    PORTAL_USER: 'portal_user',
     STANDARD_USER: 'standard_user',
     SYSTEM_ADMIN: 'system_admin',
 } as const;
@@ -20,6 +21,13 @@ export interface ProfileMetadata {
 }
 
 export const SYSTEM_PROFILES: Record<string, ProfileMetadata> = {
+    "PORTAL_USER": {
+        "id": "portal_user",
+        "label": "Portal User",
+        "description": "External customer profile for portal users; grants only the object permissions administrators add.",
+        "is_system": true,
+        "is_super_user": false
+    },
     "STANDARD_USER": {
         "id": "standard_user",
         "label": "Standard User",
@@ -116,7 +124,7 @@ export const DEFAULTS = {
 
 // ==================== Field Types ====================
 
-export type FieldType = 'AutoNumber' | 'Boolean' | 'Currency' | 'Date' | 'DateTime' | 'Email' | 'Formula' | 'JSON' | 'LongTextArea' | 'Lookup' | 'Number' | 'Password' | 'Percent' | 'Phone' | 'Picklist' | 'RichText' | 'RollupSummary' | 'Text' | 'TextArea' | 'Url';
+export type FieldType = 'AutoNumber' | 'Boolean' | 'Currency' | 'Date' | 'DateTime' | 'Email' | 'Formula' | 'Geolocation' | 'JSON' | 'LongTextArea' | 'Lookup' | 'Number' | 'Password' | 'Percent' | 'Phone' | 'Picklist' | 'RichText' | 'RollupSummary' | 'Text' | 'TextArea' | 'Url';
 
 export interface FieldTypeDefinition {
     sqlType: string | null;
@@ -257,6 +265,19 @@ export const FIELD_TYPES: Record<FieldType, FieldTypeDefinition> = {
         "operators": [
         ]
     },
+    "Geolocation": {
+        "sqlType": "JSON",
+        "icon": "MapPin",
+        "label": "Geolocation",
+        "description": "Latitude and longitude, filterable by distance",
+        "isSearchable": false,
+        "isGroupable": false,
+        "isSummable": false,
+        "operators": [
+            "is_null",
+            "is_not_null",
+        ]
+    },
     "JSON": {
         "sqlType": "JSON",
         "icon": "Code",
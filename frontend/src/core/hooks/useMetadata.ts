@@ -1,6 +1,6 @@
 import { useState, useEffect } from 'react';
 import { metadataAPI } from '../../infrastructure/api/metadata';
-import type { ObjectMetadata, PageLayout, ActionMetadata } from '../../types';
+import type { ObjectMetadata, PageLayout, ActionMetadata, RecordPageDescribe } from '../../types';
 
 export function useObjectMetadata(objectApiName: string) {
     const [metadata, setMetadata] = useState<ObjectMetadata | null>(null);
@@ -101,3 +101,31 @@ export function useActions(objectApiName: string) {
 
     return { actions, loading, error, refresh: () => setRefreshKey(k => k + 1) };
 }
+
+// Loads schema, resolved layout, actions and picklist values of a record page in one request
+export function useRecordPageDescribe(objectApiName: string, recordId?: string) {
+    const [describe, setDescribe] = useState<RecordPageDescribe | null>(null);
+    const [loading, setLoading] = useState(false);
+    const [error, setError] = useState<Error | null>(null);
+
+    useEffect(() => {
+        if (!objectApiName) {
+            setDescribe(null);
+            return;
+        }
+        const load = async () => {
+            setLoading(true);
+            setError(null);
+            try {
+                setDescribe(await metadataAPI.describeFull(objectApiName, { recordId }));
+            } catch (err) {
+                setError(err instanceof Error ? err : new Error('Unknown error'));
+            } finally {
+                setLoading(false);
+            }
+        };
+        load();
+    }, [objectApiName, recordId]);
+
+    return { describe, loading, error };
+}
@@ -4,25 +4,29 @@
  */
 
 import { IS_DEVELOPMENT } from '../constants/EnvironmentConfig';
+import { ERROR_CODE_REGISTRY, isErrorCode, type ApiErrorBody } from '../../generated-error-codes';
 
 export interface ApiError {
     message: string;
     code?: string;
     field?: string;
+    i18nKey?: string;
     details?: unknown;
 }
 
 export class AppError extends Error {
     code?: string;
     field?: string;
+    i18nKey?: string;
     details?: unknown;
 
-    constructor(message: string, code?: string, field?: string, details?: unknown) {
+    constructor(message: string, code?: string, field?: string, details?: unknown, i18nKey?: string) {
         super(message);
         this.name = 'AppError';
         this.code = code;
         this.field = field;
         this.details = details;
+        this.i18nKey = i18nKey;
     }
 }
 
@@ -38,22 +42,16 @@ function isErrorWithMessage(error: unknown): error is { message: string } {
     );
 }
 
+type BackendErrorData = Partial<ApiErrorBody> & {
+    error?: string; // Some backend errors use this key
+};
+
 interface BackendError {
     status?: number;
-    data?: {
-        message?: string;
-        error?: string; // Some backend errors use this key
-        field?: string;
-        details?: unknown;
-    };
+    data?: BackendErrorData;
     response?: {
         status: number;
-        data?: {
-            message?: string;
-            error?: string;
-            field?: string;
-            details?: unknown;
-        };
+        data?: BackendErrorData;
     };
 }
 
@@ -89,6 +87,17 @@ export function formatApiError(error: unknown): AppError {
     const status = error.status ?? error.response?.status;
     const data = error.data ?? error.response?.data;
 
+    // Errors carrying a registered code are classified by the backend; trust its code and field
+    if (data && isErrorCode(data.code)) {
+        return new AppError(
+            data.message || ERROR_CODE_REGISTRY[data.code].message,
+            data.code,
+            data.field,
+            data.errors ?? data.details,
+            data.i18n_key ?? ERROR_CODE_REGISTRY[data.code].i18nKey
+        );
+    }
+
     if (status) {
         switch (status) {
             case 400:
@@ -206,9 +215,15 @@ export function getErrorRecoveryAction(error: AppError): {
             return { label: 'Go Back', action: 'dismiss' };
 
         case 'VALIDATION_ERROR':
+        case 'REQUIRED_FIELD_MISSING':
+        case 'INVALID_FIELD_VALUE':
+        case 'VALIDATION_RULE_FAILED':
+        case 'MALFORMED_REQUEST':
+        case 'PAYLOAD_TOO_LARGE':
             return { label: 'Fix Errors', action: 'dismiss' };
 
         case 'SERVER_ERROR':
+        case 'INTERNAL_ERROR':
             return { label: 'Contact Support', action: 'contact_support' };
 
         case 'SERVICE_UNAVAILABLE':
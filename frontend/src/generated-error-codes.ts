@@ -0,0 +1,65 @@
+// Code generated by cmd/codegen. DO NOT EDIT.
+// Source: shared/constants/errorCodes.json
+// Generated at: 2026-10-18T07:01:54Z
+
+// ==================== API Error Codes ====================
+
+export const ERROR_CODES = {
+    CONFLICT: 'CONFLICT',
+    DEPENDENCY_CONFLICT: 'DEPENDENCY_CONFLICT',
+    FORBIDDEN: 'FORBIDDEN',
+    INTERNAL_ERROR: 'INTERNAL_ERROR',
+    INVALID_FIELD_VALUE: 'INVALID_FIELD_VALUE',
+    LIMIT_EXCEEDED: 'LIMIT_EXCEEDED',
+    MALFORMED_REQUEST: 'MALFORMED_REQUEST',
+    NOT_FOUND: 'NOT_FOUND',
+    PAYLOAD_TOO_LARGE: 'PAYLOAD_TOO_LARGE',
+    PERMISSION_DENIED: 'PERMISSION_DENIED',
+    REQUIRED_FIELD_MISSING: 'REQUIRED_FIELD_MISSING',
+    TRIGGER_RECURSION: 'TRIGGER_RECURSION',
+    UNAUTHORIZED: 'UNAUTHORIZED',
+    UNKNOWN_ERROR: 'UNKNOWN_ERROR',
+    VALIDATION_ERROR: 'VALIDATION_ERROR',
+    VALIDATION_RULE_FAILED: 'VALIDATION_RULE_FAILED',
+} as const;
+
+export type ErrorCode = keyof typeof ERROR_CODES;
+
+export interface ErrorCodeDefinition {
+    status: number;
+    i18nKey: string;
+    message: string;
+}
+
+export const ERROR_CODE_REGISTRY: Record<ErrorCode, ErrorCodeDefinition> = {
+    CONFLICT: { status: 409, i18nKey: 'errors.conflict', message: 'The resource already exists.' },
+    DEPENDENCY_CONFLICT: { status: 409, i18nKey: 'errors.dependencyConflict', message: 'The resource is still referenced by other components.' },
+    FORBIDDEN: { status: 403, i18nKey: 'errors.forbidden', message: 'The resource is restricted to other users.' },
+    INTERNAL_ERROR: { status: 500, i18nKey: 'errors.internalError', message: 'An unexpected server error occurred.' },
+    INVALID_FIELD_VALUE: { status: 400, i18nKey: 'errors.invalidFieldValue', message: 'A field has a value of the wrong type, length or range.' },
+    LIMIT_EXCEEDED: { status: 429, i18nKey: 'errors.limitExceeded', message: 'A governor limit was exceeded.' },
+    MALFORMED_REQUEST: { status: 400, i18nKey: 'errors.malformedRequest', message: 'The request body could not be read.' },
+    NOT_FOUND: { status: 404, i18nKey: 'errors.notFound', message: 'The resource was not found.' },
+    PAYLOAD_TOO_LARGE: { status: 413, i18nKey: 'errors.payloadTooLarge', message: 'The request body exceeds the size limit.' },
+    PERMISSION_DENIED: { status: 403, i18nKey: 'errors.permissionDenied', message: 'The user lacks the permission for this action.' },
+    REQUIRED_FIELD_MISSING: { status: 400, i18nKey: 'errors.requiredFieldMissing', message: 'A required field is missing.' },
+    TRIGGER_RECURSION: { status: 422, i18nKey: 'errors.triggerRecursion', message: 'Record automation recursed too deeply or kept re-triggering itself.' },
+    UNAUTHORIZED: { status: 401, i18nKey: 'errors.unauthorized', message: 'Authentication is required.' },
+    UNKNOWN_ERROR: { status: 500, i18nKey: 'errors.unknownError', message: 'An unexpected error occurred.' },
+    VALIDATION_ERROR: { status: 400, i18nKey: 'errors.validationError', message: 'The request is invalid.' },
+    VALIDATION_RULE_FAILED: { status: 400, i18nKey: 'errors.validationRuleFailed', message: 'The record failed a validation rule.' },
+};
+
+/** Error body returned by every API handler */
+export interface ApiErrorBody {
+    code: ErrorCode;
+    message: string;
+    field?: string;
+    i18n_key?: string;
+    details?: unknown;
+    errors?: ApiErrorBody[];
+}
+
+export function isErrorCode(code: unknown): code is ErrorCode {
+    return typeof code === 'string' && code in ERROR_CODES;
+}
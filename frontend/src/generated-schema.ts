@@ -1,21 +1,47 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: backend/internal/bootstrap/system_tables.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T13:48:25Z
 
 // ==================== System Table Names ====================
 
 export const SYSTEM_TABLE_NAMES = {
+    SYSTEM_AI_CONTEXT_ITEM: '_System_AI_Context_Item',
     SYSTEM_AI_CONVERSATION: '_System_AI_Conversation',
     SYSTEM_ACTION: '_System_Action',
+    SYSTEM_ACTIVITY: '_System_Activity',
     SYSTEM_APP: '_System_App',
     SYSTEM_APPROVALPROCESS: '_System_ApprovalProcess',
     SYSTEM_APPROVALWORKITEM: '_System_ApprovalWorkItem',
+    SYSTEM_ARCHIVEPOLICY: '_System_ArchivePolicy',
+    SYSTEM_ASYNCJOB: '_System_AsyncJob',
     SYSTEM_AUDITLOG: '_System_AuditLog',
     SYSTEM_AUTONUMBER: '_System_AutoNumber',
+    SYSTEM_BATCHJOB: '_System_BatchJob',
+    SYSTEM_BOOTSTRAPSTEP: '_System_BootstrapStep',
+    SYSTEM_BUSINESSHOURS: '_System_BusinessHours',
+    SYSTEM_CAMPAIGN: '_System_Campaign',
+    SYSTEM_CAMPAIGNMEMBER: '_System_CampaignMember',
+    SYSTEM_CHANGEEVENT: '_System_ChangeEvent',
+    SYSTEM_CHANGEEVENTOFFSET: '_System_ChangeEventOffset',
     SYSTEM_COMMENT: '_System_Comment',
     SYSTEM_CONFIG: '_System_Config',
+    SYSTEM_CONTRACT: '_System_Contract',
+    SYSTEM_CUSTOMENDPOINT: '_System_CustomEndpoint',
+    SYSTEM_CUSTOMMETADATARECORD: '_System_CustomMetadataRecord',
+    SYSTEM_CUSTOMMETADATATYPE: '_System_CustomMetadataType',
+    SYSTEM_CUSTOMSETTING: '_System_CustomSetting',
+    SYSTEM_CUSTOMSETTINGVALUE: '_System_CustomSettingValue',
     SYSTEM_DASHBOARD: '_System_Dashboard',
+    SYSTEM_DATA_QUALITY_RULE: '_System_Data_Quality_Rule',
+    SYSTEM_DATA_QUALITY_SCORE: '_System_Data_Quality_Score',
+    SYSTEM_DELETEDMETADATA: '_System_DeletedMetadata',
+    SYSTEM_DOCUMENTTEMPLATE: '_System_DocumentTemplate',
     SYSTEM_EMAILTEMPLATE: '_System_EmailTemplate',
+    SYSTEM_ENTITLEMENT: '_System_Entitlement',
+    SYSTEM_ENTITLEMENTUSAGE: '_System_EntitlementUsage',
+    SYSTEM_ESCALATIONLOG: '_System_EscalationLog',
+    SYSTEM_ESCALATIONRULE: '_System_EscalationRule',
+    SYSTEM_EXTERNALOBJECT: '_System_ExternalObject',
     SYSTEM_FEEDITEM: '_System_FeedItem',
     SYSTEM_FIELD: '_System_Field',
     SYSTEM_FIELDDEPENDENCY: '_System_FieldDependency',
@@ -24,32 +50,65 @@ export const SYSTEM_TABLE_NAMES = {
     SYSTEM_FLOW: '_System_Flow',
     SYSTEM_FLOWINSTANCE: '_System_FlowInstance',
     SYSTEM_FLOWSTEP: '_System_FlowStep',
+    SYSTEM_FORECASTADJUSTMENT: '_System_ForecastAdjustment',
+    SYSTEM_FORECASTQUOTA: '_System_ForecastQuota',
+    SYSTEM_FORECASTSETTING: '_System_ForecastSetting',
+    SYSTEM_GLOBALVALUESET: '_System_GlobalValueSet',
     SYSTEM_GROUP: '_System_Group',
     SYSTEM_GROUPMEMBER: '_System_GroupMember',
+    SYSTEM_HOLIDAY: '_System_Holiday',
+    SYSTEM_HOOKSUBSCRIPTION: '_System_HookSubscription',
+    SYSTEM_INBOUNDHOOK: '_System_InboundHook',
+    SYSTEM_KNOWLEDGEARTICLE: '_System_KnowledgeArticle',
+    SYSTEM_KNOWLEDGEARTICLELINK: '_System_KnowledgeArticleLink',
+    SYSTEM_KNOWLEDGEARTICLEVERSION: '_System_KnowledgeArticleVersion',
+    SYSTEM_KNOWLEDGECATEGORY: '_System_KnowledgeCategory',
     SYSTEM_LAYOUT: '_System_Layout',
     SYSTEM_LISTVIEW: '_System_ListView',
     SYSTEM_LOG: '_System_Log',
+    SYSTEM_NAMEDCREDENTIAL: '_System_NamedCredential',
     SYSTEM_NOTIFICATION: '_System_Notification',
     SYSTEM_OBJECT: '_System_Object',
     SYSTEM_OBJECTPERMS: '_System_ObjectPerms',
+    SYSTEM_ORDER: '_System_Order',
+    SYSTEM_ORDERITEM: '_System_OrderItem',
+    SYSTEM_ORGANIZATION: '_System_Organization',
     SYSTEM_OUTBOXEVENT: '_System_OutboxEvent',
     SYSTEM_PERMISSIONSET: '_System_PermissionSet',
     SYSTEM_PERMISSIONSETASSIGNMENT: '_System_PermissionSetAssignment',
+    SYSTEM_PORTALOBJECT: '_System_PortalObject',
     SYSTEM_PROFILE: '_System_Profile',
     SYSTEM_PROFILELAYOUT: '_System_ProfileLayout',
+    SYSTEM_PROFILERECORDTYPE: '_System_ProfileRecordType',
+    SYSTEM_QUERYGOVERNOR: '_System_QueryGovernor',
     SYSTEM_RECENT: '_System_Recent',
     SYSTEM_RECORDSHARE: '_System_RecordShare',
     SYSTEM_RECORDTYPE: '_System_RecordType',
+    SYSTEM_RECORD_EMBEDDING: '_System_Record_Embedding',
     SYSTEM_RECYCLEBIN: '_System_RecycleBin',
     SYSTEM_RELATIONSHIP: '_System_Relationship',
+    SYSTEM_REPORT: '_System_Report',
     SYSTEM_ROLE: '_System_Role',
+    SYSTEM_SLAPOLICY: '_System_SLAPolicy',
+    SYSTEM_SLATIMER: '_System_SLATimer',
+    SYSTEM_SAVEDSEARCH: '_System_SavedSearch',
+    SYSTEM_SCRIPT: '_System_Script',
+    SYSTEM_SCRIPTLOG: '_System_ScriptLog',
     SYSTEM_SESSION: '_System_Session',
+    SYSTEM_SETUPAUDIT: '_System_SetupAudit',
     SYSTEM_SETUPPAGE: '_System_SetupPage',
     SYSTEM_SHARINGRULE: '_System_SharingRule',
+    SYSTEM_STAGEHISTORY: '_System_StageHistory',
+    SYSTEM_STATEMACHINE: '_System_StateMachine',
+    SYSTEM_SURVEY: '_System_Survey',
+    SYSTEM_SURVEYINVITATION: '_System_SurveyInvitation',
+    SYSTEM_SURVEYRESPONSE: '_System_SurveyResponse',
+    SYSTEM_SYNCCONNECTOR: '_System_SyncConnector',
     SYSTEM_SYSTEMLOG: '_System_SystemLog',
     SYSTEM_TABLE: '_System_Table',
     SYSTEM_TEAMMEMBER: '_System_TeamMember',
     SYSTEM_THEME: '_System_Theme',
+    SYSTEM_TRANSLATION: '_System_Translation',
     SYSTEM_UICOMPONENT: '_System_UIComponent',
     SYSTEM_USER: '_System_User',
     SYSTEM_VALIDATION: '_System_Validation',
@@ -147,6 +206,16 @@ export const COMMON_FIELDS = {
 
 // ==================== Field Constants ====================
 
+export const FIELDS_SYSTEM_AI_CONTEXT_ITEM = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONTENT: 'content',
+    PATH: 'path',
+    TOKEN_SIZE: 'token_size',
+    USER_ID: 'user_id',
+} as const;
+
 export const FIELDS_SYSTEM_AI_CONVERSATION = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -154,6 +223,7 @@ export const FIELDS_SYSTEM_AI_CONVERSATION = {
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
     IS_ACTIVE: 'is_active',
     MESSAGES: 'messages',
+    SETTINGS: 'settings',
     TITLE: 'title',
     USER_ID: 'user_id',
 } as const;
@@ -171,6 +241,29 @@ export const FIELDS_SYSTEM_ACTION = {
     TYPE: 'type',
 } as const;
 
+export const FIELDS_SYSTEM_ACTIVITY = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    IS_DELETED: '__sys_gen_is_deleted',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACTIVITY_DATE: 'activity_date',
+    ACTIVITY_TYPE: 'activity_type',
+    CALL_DIRECTION: 'call_direction',
+    CALL_DURATION: 'call_duration',
+    CALL_STATUS: 'call_status',
+    CONNECTOR_ID: 'connector_id',
+    DESCRIPTION: 'description',
+    END_DATE: 'end_date',
+    EXTERNAL_ID: 'external_id',
+    FROM_ADDRESS: 'from_address',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    RECORDING_URL: 'recording_url',
+    SUBJECT: 'subject',
+    TO_ADDRESSES: 'to_addresses',
+} as const;
+
 export const FIELDS_SYSTEM_APP = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -223,6 +316,36 @@ export const FIELDS_SYSTEM_APPROVALWORKITEM = {
     SUBMITTED_DATE: 'submitted_date',
 } as const;
 
+export const FIELDS_SYSTEM_ARCHIVEPOLICY = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DATE_FIELD: 'date_field',
+    IS_ACTIVE: 'is_active',
+    LAST_ARCHIVED_COUNT: 'last_archived_count',
+    LAST_ERROR: 'last_error',
+    LAST_RUN_DATE: 'last_run_date',
+    OBJECT_API_NAME: 'object_api_name',
+    RETAIN_DAYS: 'retain_days',
+} as const;
+
+export const FIELDS_SYSTEM_ASYNCJOB = {
+    CREATED_BY_ID: '__sys_gen_created_by_id',
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPLETED_DATE: 'completed_date',
+    ERROR_MESSAGE: 'error_message',
+    FAILED_COUNT: 'failed_count',
+    JOB_TYPE: 'job_type',
+    OBJECT_API_NAME: 'object_api_name',
+    PARAMETERS: 'parameters',
+    PROCESSED_COUNT: 'processed_count',
+    STARTED_DATE: 'started_date',
+    STATUS: 'status',
+    TOTAL_COUNT: 'total_count',
+} as const;
+
 export const FIELDS_SYSTEM_AUDITLOG = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -243,10 +366,115 @@ export const FIELDS_SYSTEM_AUTONUMBER = {
     CURRENT_NUMBER: 'current_number',
     DISPLAY_FORMAT: 'display_format',
     FIELD_API_NAME: 'field_api_name',
+    GAP_FREE: 'gap_free',
     OBJECT_API_NAME: 'object_api_name',
     STARTING_NUMBER: 'starting_number',
 } as const;
 
+export const FIELDS_SYSTEM_BATCHJOB = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    CHUNK_SIZE: 'chunk_size',
+    CONCURRENCY: 'concurrency',
+    DESCRIPTION: 'description',
+    FIELD_VALUES: 'field_values',
+    IS_ACTIVE: 'is_active',
+    LAST_JOB_ID: 'last_job_id',
+    LAST_RUN_AT: 'last_run_at',
+    NAME: 'name',
+    NEXT_RUN_AT: 'next_run_at',
+    OBJECT_API_NAME: 'object_api_name',
+    PROCESSOR: 'processor',
+    SCHEDULE: 'schedule',
+    SCHEDULE_TIMEZONE: 'schedule_timezone',
+    SCOPE_FILTER: 'scope_filter',
+    SCRIPT_NAME: 'script_name',
+} as const;
+
+export const FIELDS_SYSTEM_BOOTSTRAPSTEP = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CHECKSUM: 'checksum',
+    DURATION_MS: 'duration_ms',
+    STEP_NAME: 'step_name',
+} as const;
+
+export const FIELDS_SYSTEM_BUSINESSHOURS = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    IS_DEFAULT: 'is_default',
+    LABEL: 'label',
+    NAME: 'name',
+    SCHEDULE: 'schedule',
+    TIMEZONE: 'timezone',
+} as const;
+
+export const FIELDS_SYSTEM_CAMPAIGN = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACTUAL_COST: 'actual_cost',
+    AMOUNT_ALL_OPPORTUNITIES: 'amount_all_opportunities',
+    AMOUNT_WON_OPPORTUNITIES: 'amount_won_opportunities',
+    BUDGETED_COST: 'budgeted_cost',
+    DESCRIPTION: 'description',
+    END_DATE: 'end_date',
+    EXPECTED_REVENUE: 'expected_revenue',
+    IS_ACTIVE: 'is_active',
+    MEMBER_STATUSES: 'member_statuses',
+    NAME: 'name',
+    NUMBER_OF_MEMBERS: 'number_of_members',
+    NUMBER_OF_OPPORTUNITIES: 'number_of_opportunities',
+    NUMBER_OF_RESPONSES: 'number_of_responses',
+    NUMBER_OF_WON_OPPORTUNITIES: 'number_of_won_opportunities',
+    ROLLUPS_DATE: 'rollups_date',
+    START_DATE: 'start_date',
+    STATUS: 'status',
+    TYPE: 'type',
+} as const;
+
+export const FIELDS_SYSTEM_CAMPAIGNMEMBER = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ADDED_BY_ID: 'added_by_id',
+    CAMPAIGN_ID: 'campaign_id',
+    FIRST_RESPONDED_DATE: 'first_responded_date',
+    HAS_RESPONDED: 'has_responded',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    STATUS: 'status',
+} as const;
+
+export const FIELDS_SYSTEM_CHANGEEVENT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    AFTER_DATA: 'after_data',
+    BEFORE_DATA: 'before_data',
+    CHANGED_FIELDS: 'changed_fields',
+    COMMIT_TIMESTAMP: 'commit_timestamp',
+    OBJECT_API_NAME: 'object_api_name',
+    OPERATION: 'operation',
+    POSITION: 'position',
+    RECORD_ID: 'record_id',
+    TRANSACTION_ID: 'transaction_id',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_CHANGEEVENTOFFSET = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONSUMER: 'consumer',
+    POSITION: 'position',
+} as const;
+
 export const FIELDS_SYSTEM_COMMENT = {
     CREATED_BY_ID: '__sys_gen_created_by_id',
     CREATED_DATE: '__sys_gen_created_date',
@@ -269,6 +497,82 @@ export const FIELDS_SYSTEM_CONFIG = {
     VALUE: 'value',
 } as const;
 
+export const FIELDS_SYSTEM_CONTRACT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACCOUNT_ID: 'account_id',
+    DESCRIPTION: 'description',
+    END_DATE: 'end_date',
+    NAME: 'name',
+    START_DATE: 'start_date',
+    STATUS: 'status',
+} as const;
+
+export const FIELDS_SYSTEM_CUSTOMENDPOINT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACTION: 'action',
+    DESCRIPTION: 'description',
+    FIELD_VALUES: 'field_values',
+    FILTER_EXPR: 'filter_expr',
+    HTTP_METHOD: 'http_method',
+    INPUT_SCHEMA: 'input_schema',
+    IS_ACTIVE: 'is_active',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    PROFILE_IDS: 'profile_ids',
+    RECORD_ID: 'record_id',
+    ROW_LIMIT: 'row_limit',
+    SCRIPT_NAME: 'script_name',
+    SORT_DIRECTION: 'sort_direction',
+    SORT_FIELD: 'sort_field',
+} as const;
+
+export const FIELDS_SYSTEM_CUSTOMMETADATARECORD = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DEVELOPER_NAME: 'developer_name',
+    FIELD_VALUES: 'field_values',
+    LABEL: 'label',
+    TYPE_API_NAME: 'type_api_name',
+} as const;
+
+export const FIELDS_SYSTEM_CUSTOMMETADATATYPE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    API_NAME: 'api_name',
+    DESCRIPTION: 'description',
+    FIELDS: 'fields',
+    LABEL: 'label',
+} as const;
+
+export const FIELDS_SYSTEM_CUSTOMSETTING = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DEFAULT_VALUE: 'default_value',
+    DESCRIPTION: 'description',
+    LABEL: 'label',
+    NAME: 'name',
+    TYPE: 'type',
+} as const;
+
+export const FIELDS_SYSTEM_CUSTOMSETTINGVALUE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    SCOPE_ID: 'scope_id',
+    SCOPE_TYPE: 'scope_type',
+    SETTING_NAME: 'setting_name',
+    VALUE: 'value',
+} as const;
+
 export const FIELDS_SYSTEM_DASHBOARD = {
     CREATED_BY_ID: '__sys_gen_created_by_id',
     CREATED_DATE: '__sys_gen_created_date',
@@ -278,11 +582,67 @@ export const FIELDS_SYSTEM_DASHBOARD = {
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
     OWNER_ID: '__sys_gen_owner_id',
     DESCRIPTION: 'description',
+    FILTERS: 'filters',
     LAYOUT: 'layout',
     NAME: 'name',
+    RUN_AS: 'run_as',
+    RUN_AS_USER_ID: 'run_as_user_id',
     WIDGETS: 'widgets',
 } as const;
 
+export const FIELDS_SYSTEM_DATA_QUALITY_RULE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPLETENESS_FIELDS: 'completeness_fields',
+    DUPLICATE_THRESHOLD: 'duplicate_threshold',
+    MATCH_FIELDS: 'match_fields',
+    OBJECT_API_NAME: 'object_api_name',
+    USE_EMBEDDINGS: 'use_embeddings',
+} as const;
+
+export const FIELDS_SYSTEM_DATA_QUALITY_SCORE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPLETENESS_SCORE: 'completeness_score',
+    DUPLICATE_OF: 'duplicate_of',
+    DUPLICATE_SCORE: 'duplicate_score',
+    MISSING_FIELDS: 'missing_fields',
+    OBJECT_API_NAME: 'object_api_name',
+    QUALITY_SCORE: 'quality_score',
+    RECORD_ID: 'record_id',
+    SCORED_DATE: 'scored_date',
+} as const;
+
+export const FIELDS_SYSTEM_DELETEDMETADATA = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPONENT_TYPE: 'component_type',
+    DELETED_BY_ID: 'deleted_by_id',
+    DELETED_DATE: 'deleted_date',
+    FIELD_API_NAME: 'field_api_name',
+    METADATA: 'metadata',
+    OBJECT_API_NAME: 'object_api_name',
+    PURGE_AFTER: 'purge_after',
+    STORAGE_NAME: 'storage_name',
+} as const;
+
+export const FIELDS_SYSTEM_DOCUMENTTEMPLATE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONTENT: 'content',
+    FILE_NAME: 'file_name',
+    IS_ACTIVE: 'is_active',
+    LABEL: 'label',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    PAGE_SIZE: 'page_size',
+    TEMPLATE_TYPE: 'template_type',
+} as const;
+
 export const FIELDS_SYSTEM_EMAILTEMPLATE = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -297,6 +657,74 @@ export const FIELDS_SYSTEM_EMAILTEMPLATE = {
     TEXT_BODY: 'text_body',
 } as const;
 
+export const FIELDS_SYSTEM_ENTITLEMENT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACCOUNT_ID: 'account_id',
+    CONTRACT_ID: 'contract_id',
+    END_DATE: 'end_date',
+    IS_ACTIVE: 'is_active',
+    NAME: 'name',
+    REMAINING_UNITS: 'remaining_units',
+    START_DATE: 'start_date',
+    TOTAL_UNITS: 'total_units',
+    TYPE: 'type',
+} as const;
+
+export const FIELDS_SYSTEM_ENTITLEMENTUSAGE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONSUMED_BY_ID: 'consumed_by_id',
+    ENTITLEMENT_ID: 'entitlement_id',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    UNITS: 'units',
+} as const;
+
+export const FIELDS_SYSTEM_ESCALATIONLOG = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ERROR_MESSAGE: 'error_message',
+    ESCALATED_DATE: 'escalated_date',
+    LEVEL: 'level',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    RULE_NAME: 'rule_name',
+} as const;
+
+export const FIELDS_SYSTEM_ESCALATIONRULE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ACTIONS: 'actions',
+    BUSINESS_HOURS: 'business_hours',
+    CRITERIA: 'criteria',
+    IS_ACTIVE: 'is_active',
+    LABEL: 'label',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    START_FIELD: 'start_field',
+} as const;
+
+export const FIELDS_SYSTEM_EXTERNALOBJECT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ADAPTER: 'adapter',
+    DSN: 'dsn',
+    FIELD_MAP: 'field_map',
+    ID_FIELD: 'id_field',
+    MAX_ROWS: 'max_rows',
+    NAMED_CREDENTIAL: 'named_credential',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORDS_PATH: 'records_path',
+    RESOURCE: 'resource',
+} as const;
+
 export const FIELDS_SYSTEM_FEEDITEM = {
     CREATED_BY_ID: '__sys_gen_created_by_id',
     CREATED_DATE: '__sys_gen_created_date',
@@ -322,6 +750,7 @@ export const FIELDS_SYSTEM_FIELD = {
     DESCRIPTION: 'description',
     FORMULA: 'formula',
     HELP_TEXT: 'help_text',
+    INACTIVE_OPTIONS: 'inactive_options',
     INDEXED: 'indexed',
     IS_MASTER_DETAIL: 'is_master_detail',
     IS_NAME_FIELD: 'is_name_field',
@@ -329,6 +758,7 @@ export const FIELDS_SYSTEM_FIELD = {
     IS_SYSTEM: 'is_system',
     IS_UNIQUE: 'is_unique',
     LABEL: 'label',
+    LOOKUP_FILTER: 'lookup_filter',
     MAX_LENGTH: 'max_length',
     MAX_VALUE: 'max_value',
     MIN_LENGTH: 'min_length',
@@ -346,6 +776,7 @@ export const FIELDS_SYSTEM_FIELD = {
     TRACK_HISTORY: 'track_history',
     TYPE: 'type',
     VALIDATOR: 'validator',
+    VALUE_SET: 'value_set',
 } as const;
 
 export const FIELDS_SYSTEM_FIELDDEPENDENCY = {
@@ -444,56 +875,218 @@ export const FIELDS_SYSTEM_FLOWSTEP = {
     STEP_TYPE: 'step_type',
 } as const;
 
-export const FIELDS_SYSTEM_GROUP = {
+export const FIELDS_SYSTEM_FORECASTADJUSTMENT = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
-    IS_DELETED: '__sys_gen_is_deleted',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    EMAIL: 'email',
-    LABEL: 'label',
-    NAME: 'name',
-    TYPE: 'type',
+    ADJUSTED_BY_ID: 'adjusted_by_id',
+    AMOUNT: 'amount',
+    CATEGORY: 'category',
+    NOTE: 'note',
+    OBJECT_API_NAME: 'object_api_name',
+    PERIOD: 'period',
+    USER_ID: 'user_id',
 } as const;
 
-export const FIELDS_SYSTEM_GROUPMEMBER = {
+export const FIELDS_SYSTEM_FORECASTQUOTA = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
-    IS_DELETED: '__sys_gen_is_deleted',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    GROUP_ID: 'group_id',
+    AMOUNT: 'amount',
+    OBJECT_API_NAME: 'object_api_name',
+    PERIOD: 'period',
     USER_ID: 'user_id',
 } as const;
 
-export const FIELDS_SYSTEM_LAYOUT = {
+export const FIELDS_SYSTEM_FORECASTSETTING = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    CONFIG: 'config',
+    AMOUNT_FIELD: 'amount_field',
+    CATEGORY_MAPPING: 'category_mapping',
+    CLOSE_DATE_FIELD: 'close_date_field',
     OBJECT_API_NAME: 'object_api_name',
+    STAGE_FIELD: 'stage_field',
 } as const;
 
-export const FIELDS_SYSTEM_LISTVIEW = {
+export const FIELDS_SYSTEM_GLOBALVALUESET = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    FIELDS: 'fields',
-    FILTER_EXPR: 'filter_expr',
+    DESCRIPTION: 'description',
+    INACTIVE_OPTIONS: 'inactive_options',
+    IS_STANDARD: 'is_standard',
     LABEL: 'label',
-    OBJECT_API_NAME: 'object_api_name',
+    NAME: 'name',
+    OPTIONS: 'options',
 } as const;
 
-export const FIELDS_SYSTEM_LOG = {
+export const FIELDS_SYSTEM_GROUP = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
+    IS_DELETED: '__sys_gen_is_deleted',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    DETAILS: 'details',
-    LEVEL: 'level',
-    MESSAGE: 'message',
-    SOURCE: 'source',
-    TIMESTAMP: 'timestamp',
+    EMAIL: 'email',
+    LABEL: 'label',
+    NAME: 'name',
+    TYPE: 'type',
 } as const;
 
-export const FIELDS_SYSTEM_NOTIFICATION = {
+export const FIELDS_SYSTEM_GROUPMEMBER = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    IS_DELETED: '__sys_gen_is_deleted',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    GROUP_ID: 'group_id',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_HOLIDAY = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    BUSINESS_HOURS_ID: 'business_hours_id',
+    HOLIDAY_DATE: 'holiday_date',
+    IS_RECURRING: 'is_recurring',
+    NAME: 'name',
+} as const;
+
+export const FIELDS_SYSTEM_HOOKSUBSCRIPTION = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    EVENT: 'event',
+    FAILURE_COUNT: 'failure_count',
+    IS_ACTIVE: 'is_active',
+    LAST_DELIVERY_DATE: 'last_delivery_date',
+    LAST_ERROR: 'last_error',
+    OBJECT_API_NAME: 'object_api_name',
+    TARGET_URL: 'target_url',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_INBOUNDHOOK = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    FIELD_MAPPING: 'field_mapping',
+    IS_ACTIVE: 'is_active',
+    LABEL: 'label',
+    LAST_ERROR: 'last_error',
+    LAST_RECEIVED_DATE: 'last_received_date',
+    MATCH_FIELD: 'match_field',
+    OBJECT_API_NAME: 'object_api_name',
+    OPERATION: 'operation',
+    RECORDS_PATH: 'records_path',
+    RUN_AS_USER_ID: 'run_as_user_id',
+    SECRET: 'secret',
+    SLUG: 'slug',
+} as const;
+
+export const FIELDS_SYSTEM_KNOWLEDGEARTICLE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ARCHIVED_DATE: 'archived_date',
+    BODY: 'body',
+    CATEGORY_ID: 'category_id',
+    PUBLISHED_DATE: 'published_date',
+    PUBLISHED_VERSION_NUMBER: 'published_version_number',
+    STATUS: 'status',
+    SUMMARY: 'summary',
+    TITLE: 'title',
+    URL_NAME: 'url_name',
+    VERSION_NUMBER: 'version_number',
+} as const;
+
+export const FIELDS_SYSTEM_KNOWLEDGEARTICLELINK = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ARTICLE_ID: 'article_id',
+    LINKED_BY_ID: 'linked_by_id',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    VERSION_NUMBER: 'version_number',
+} as const;
+
+export const FIELDS_SYSTEM_KNOWLEDGEARTICLEVERSION = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ARTICLE_ID: 'article_id',
+    BODY: 'body',
+    CATEGORY_ID: 'category_id',
+    PUBLISHED_BY_ID: 'published_by_id',
+    PUBLISHED_DATE: 'published_date',
+    SUMMARY: 'summary',
+    TITLE: 'title',
+    VERSION_NUMBER: 'version_number',
+} as const;
+
+export const FIELDS_SYSTEM_KNOWLEDGECATEGORY = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DESCRIPTION: 'description',
+    NAME: 'name',
+    PARENT_ID: 'parent_id',
+    SORT_ORDER: 'sort_order',
+} as const;
+
+export const FIELDS_SYSTEM_LAYOUT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONFIG: 'config',
+    OBJECT_API_NAME: 'object_api_name',
+} as const;
+
+export const FIELDS_SYSTEM_LISTVIEW = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    AGGREGATES: 'aggregates',
+    COLUMN_SETTINGS: 'column_settings',
+    FIELDS: 'fields',
+    FILTER_EXPR: 'filter_expr',
+    LABEL: 'label',
+    OBJECT_API_NAME: 'object_api_name',
+    OWNER_ID: 'owner_id',
+    PROFILE_IDS: 'profile_ids',
+    SORT_DIRECTION: 'sort_direction',
+    SORT_FIELD: 'sort_field',
+    VISIBILITY: 'visibility',
+} as const;
+
+export const FIELDS_SYSTEM_LOG = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DETAILS: 'details',
+    LEVEL: 'level',
+    MESSAGE: 'message',
+    SOURCE: 'source',
+    TIMESTAMP: 'timestamp',
+} as const;
+
+export const FIELDS_SYSTEM_NAMEDCREDENTIAL = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    AUTH_HEADER: 'auth_header',
+    AUTH_TYPE: 'auth_type',
+    BASE_URL: 'base_url',
+    LABEL: 'label',
+    MAX_RETRIES: 'max_retries',
+    NAME: 'name',
+    SECRET: 'secret',
+    TIMEOUT_SECONDS: 'timeout_seconds',
+    USERNAME: 'username',
+} as const;
+
+export const FIELDS_SYSTEM_NOTIFICATION = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
@@ -542,6 +1135,48 @@ export const FIELDS_SYSTEM_OBJECTPERMS = {
     VIEW_ALL: 'view_all',
 } as const;
 
+export const FIELDS_SYSTEM_ORDER = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    ACTIVATED_DATE: 'activated_date',
+    CANCELLED_DATE: 'cancelled_date',
+    CUSTOMER_ID: 'customer_id',
+    CUSTOMER_OBJECT_API_NAME: 'customer_object_api_name',
+    DESCRIPTION: 'description',
+    FULFILLED_DATE: 'fulfilled_date',
+    NAME: 'name',
+    STATUS: 'status',
+    TOTAL_AMOUNT: 'total_amount',
+} as const;
+
+export const FIELDS_SYSTEM_ORDERITEM = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    BACKORDERED_QUANTITY: 'backordered_quantity',
+    ORDER_ID: 'order_id',
+    PRODUCT_ID: 'product_id',
+    PRODUCT_NAME: 'product_name',
+    QUANTITY: 'quantity',
+    SORT_ORDER: 'sort_order',
+    TOTAL_PRICE: 'total_price',
+    UNIT_PRICE: 'unit_price',
+} as const;
+
+export const FIELDS_SYSTEM_ORGANIZATION = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    DEFAULT_LOCALE: 'default_locale',
+    NAME: 'name',
+    SAMPLE_DATA: 'sample_data',
+    SETUP_COMPLETED_BY_ID: 'setup_completed_by_id',
+    SETUP_COMPLETED_DATE: 'setup_completed_date',
+    SETUP_STATUS: 'setup_status',
+} as const;
+
 export const FIELDS_SYSTEM_OUTBOXEVENT = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -559,6 +1194,8 @@ export const FIELDS_SYSTEM_PERMISSIONSET = {
     ID: '__sys_gen_id',
     IS_DELETED: '__sys_gen_is_deleted',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    BYPASS_FLOWS: 'bypass_flows',
+    BYPASS_VALIDATION: 'bypass_validation',
     DESCRIPTION: 'description',
     IS_ACTIVE: 'is_active',
     LABEL: 'label',
@@ -574,6 +1211,16 @@ export const FIELDS_SYSTEM_PERMISSIONSETASSIGNMENT = {
     PERMISSION_SET_ID: 'permission_set_id',
 } as const;
 
+export const FIELDS_SYSTEM_PORTALOBJECT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ACCOUNT_FIELD: 'account_field',
+    ALLOW_CREATE: 'allow_create',
+    CONTACT_FIELD: 'contact_field',
+    OBJECT_API_NAME: 'object_api_name',
+} as const;
+
 export const FIELDS_SYSTEM_PROFILE = {
     CREATED_BY_ID: '__sys_gen_created_by_id',
     CREATED_DATE: '__sys_gen_created_date',
@@ -582,6 +1229,8 @@ export const FIELDS_SYSTEM_PROFILE = {
     LAST_MODIFIED_BY_ID: '__sys_gen_last_modified_by_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
     OWNER_ID: '__sys_gen_owner_id',
+    BYPASS_FLOWS: 'bypass_flows',
+    BYPASS_VALIDATION: 'bypass_validation',
     DESCRIPTION: 'description',
     IS_ACTIVE: 'is_active',
     IS_SYSTEM: 'is_system',
@@ -597,6 +1246,27 @@ export const FIELDS_SYSTEM_PROFILELAYOUT = {
     PROFILE_ID: 'profile_id',
 } as const;
 
+export const FIELDS_SYSTEM_PROFILERECORDTYPE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    IS_DEFAULT: 'is_default',
+    LAYOUT_ID: 'layout_id',
+    OBJECT_API_NAME: 'object_api_name',
+    PROFILE_ID: 'profile_id',
+    RECORD_TYPE_ID: 'record_type_id',
+} as const;
+
+export const FIELDS_SYSTEM_QUERYGOVERNOR = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    MAX_CONCURRENT_QUERIES: 'max_concurrent_queries',
+    MAX_EXECUTION_MS: 'max_execution_ms',
+    MAX_ROWS: 'max_rows',
+    PROFILE_ID: 'profile_id',
+} as const;
+
 export const FIELDS_SYSTEM_RECENT = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -630,9 +1300,22 @@ export const FIELDS_SYSTEM_RECORDTYPE = {
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
     DESCRIPTION: 'description',
     IS_ACTIVE: 'is_active',
+    IS_DEFAULT: 'is_default',
     IS_MASTER: 'is_master',
     NAME: 'name',
     OBJECT_ID: 'object_id',
+    PICKLIST_VALUES: 'picklist_values',
+} as const;
+
+export const FIELDS_SYSTEM_RECORD_EMBEDDING = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CONTENT_HASH: 'content_hash',
+    EMBEDDING: 'embedding',
+    MODEL: 'model',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
 } as const;
 
 export const FIELDS_SYSTEM_RECYCLEBIN = {
@@ -661,6 +1344,25 @@ export const FIELDS_SYSTEM_RELATIONSHIP = {
     RESTRICTED_DELETE: 'restricted_delete',
 } as const;
 
+export const FIELDS_SYSTEM_REPORT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    AGGREGATES: 'aggregates',
+    COLUMN_GROUPINGS: 'column_groupings',
+    COLUMNS: 'columns',
+    DESCRIPTION: 'description',
+    FILTER_EXPR: 'filter_expr',
+    FORMAT: 'format',
+    GROUPINGS: 'groupings',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    OWNER_ID: 'owner_id',
+    ROW_LIMIT: 'row_limit',
+    SORT_DIRECTION: 'sort_direction',
+    SORT_FIELD: 'sort_field',
+} as const;
+
 export const FIELDS_SYSTEM_ROLE = {
     CREATED_BY_ID: '__sys_gen_created_by_id',
     CREATED_DATE: '__sys_gen_created_date',
@@ -675,6 +1377,91 @@ export const FIELDS_SYSTEM_ROLE = {
     PARENT_ROLE_ID: 'parent_role_id',
 } as const;
 
+export const FIELDS_SYSTEM_SLAPOLICY = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    BUSINESS_HOURS: 'business_hours',
+    CLOSED_STATUSES: 'closed_statuses',
+    IS_ACTIVE: 'is_active',
+    LABEL: 'label',
+    MILESTONES: 'milestones',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    PAUSED_STATUSES: 'paused_statuses',
+    PRIORITY_FIELD: 'priority_field',
+    STATUS_FIELD: 'status_field',
+} as const;
+
+export const FIELDS_SYSTEM_SLATIMER = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPLETED_DATE: 'completed_date',
+    DUE_DATE: 'due_date',
+    ELAPSED_SECONDS: 'elapsed_seconds',
+    ESCALATED_DATE: 'escalated_date',
+    MILESTONE: 'milestone',
+    OBJECT_API_NAME: 'object_api_name',
+    POLICY_NAME: 'policy_name',
+    RECORD_ID: 'record_id',
+    RUNNING_SINCE: 'running_since',
+    STARTED_DATE: 'started_date',
+    STATUS: 'status',
+    TARGET_MINUTES: 'target_minutes',
+    VIOLATED: 'violated',
+} as const;
+
+export const FIELDS_SYSTEM_SAVEDSEARCH = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    LAST_RUN_DATE: 'last_run_date',
+    NAME: 'name',
+    OBJECT_SCOPE: 'object_scope',
+    TERM: 'term',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_SCRIPT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    OWNER_ID: '__sys_gen_owner_id',
+    DESCRIPTION: 'description',
+    IS_ACTIVE: 'is_active',
+    MAX_CALLOUTS: 'max_callouts',
+    MAX_DML_ROWS: 'max_dml_rows',
+    MAX_MEMORY_MB: 'max_memory_mb',
+    MAX_QUERY_ROWS: 'max_query_rows',
+    MAX_STEPS: 'max_steps',
+    NAME: 'name',
+    SOURCE: 'source',
+    TIMEOUT_MS: 'timeout_ms',
+    TRIGGER_EVENTS: 'trigger_events',
+    TRIGGER_OBJECT: 'trigger_object',
+} as const;
+
+export const FIELDS_SYSTEM_SCRIPTLOG = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CALLOUTS: 'callouts',
+    DML_ROWS: 'dml_rows',
+    DURATION_MS: 'duration_ms',
+    ERROR_MESSAGE: 'error_message',
+    INVOCATION: 'invocation',
+    OBJECT_API_NAME: 'object_api_name',
+    OUTPUT: 'output',
+    QUERY_ROWS: 'query_rows',
+    RECORD_ID: 'record_id',
+    SCRIPT_ID: 'script_id',
+    SCRIPT_NAME: 'script_name',
+    STATUS: 'status',
+    STEPS: 'steps',
+    USER_ID: 'user_id',
+} as const;
+
 export const FIELDS_SYSTEM_SESSION = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -688,6 +1475,21 @@ export const FIELDS_SYSTEM_SESSION = {
     USER_ID: 'user_id',
 } as const;
 
+export const FIELDS_SYSTEM_SETUPAUDIT = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ACTION: 'action',
+    ACTOR_ID: 'actor_id',
+    ACTOR_NAME: 'actor_name',
+    AFTER_DATA: 'after_data',
+    BEFORE_DATA: 'before_data',
+    CHANGED_AT: 'changed_at',
+    COMPONENT_NAME: 'component_name',
+    COMPONENT_TYPE: 'component_type',
+    OBJECT_API_NAME: 'object_api_name',
+} as const;
+
 export const FIELDS_SYSTEM_SETUPPAGE = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -719,53 +1521,152 @@ export const FIELDS_SYSTEM_SHARINGRULE = {
     SHARE_WITH_ROLE_ID: 'share_with_role_id',
 } as const;
 
-export const FIELDS_SYSTEM_SYSTEMLOG = {
+export const FIELDS_SYSTEM_STAGEHISTORY = {
+    CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
-    DETAILS: 'details',
-    LEVEL: 'level',
-    MESSAGE: 'message',
-    SOURCE: 'source',
-    TIMESTAMP: 'timestamp',
+    CHANGED_BY_ID: 'changed_by_id',
+    DURATION_SECONDS: 'duration_seconds',
+    ENTERED_DATE: 'entered_date',
+    EXITED_DATE: 'exited_date',
+    FIELD_API_NAME: 'field_api_name',
+    OBJECT_API_NAME: 'object_api_name',
+    PREVIOUS_VALUE: 'previous_value',
+    RECORD_ID: 'record_id',
+    VALUE: 'value',
 } as const;
 
-export const FIELDS_SYSTEM_TABLE = {
+export const FIELDS_SYSTEM_STATEMACHINE = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    CATEGORY: 'category',
-    CREATED_BY: 'created_by',
     DESCRIPTION: 'description',
-    IS_MANAGED: 'is_managed',
-    SCHEMA_VERSION: 'schema_version',
-    TABLE_NAME: 'table_name',
-    TABLE_TYPE: 'table_type',
+    FIELD_API_NAME: 'field_api_name',
+    INITIAL_STATES: 'initial_states',
+    IS_ACTIVE: 'is_active',
+    NAME: 'name',
+    OBJECT_API_NAME: 'object_api_name',
+    STATES: 'states',
+    TRANSITIONS: 'transitions',
 } as const;
 
-export const FIELDS_SYSTEM_TEAMMEMBER = {
-    CREATED_BY_ID: '__sys_gen_created_by_id',
+export const FIELDS_SYSTEM_SURVEY = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
-    IS_DELETED: '__sys_gen_is_deleted',
-    LAST_MODIFIED_BY_ID: '__sys_gen_last_modified_by_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    ACCESS_LEVEL: 'access_level',
-    OBJECT_API_NAME: 'object_api_name',
-    RECORD_ID: 'record_id',
-    TEAM_ROLE: 'team_role',
-    USER_ID: 'user_id',
+    OWNER_ID: '__sys_gen_owner_id',
+    DESCRIPTION: 'description',
+    IS_ACTIVE: 'is_active',
+    NAME: 'name',
+    QUESTION: 'question',
+    QUESTIONS: 'questions',
+    SCORE_TYPE: 'score_type',
 } as const;
 
-export const FIELDS_SYSTEM_THEME = {
+export const FIELDS_SYSTEM_SURVEYINVITATION = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
-    COLORS: 'colors',
-    DENSITY: 'density',
+    CONTACT_ID: 'contact_id',
+    CREATED_BY_ID: 'created_by_id',
+    EXPIRES_DATE: 'expires_date',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    RESPONDED_DATE: 'responded_date',
+    SURVEY_ID: 'survey_id',
+    TOKEN_HASH: 'token_hash',
+} as const;
+
+export const FIELDS_SYSTEM_SURVEYRESPONSE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ANSWERS: 'answers',
+    COMMENT: 'comment',
+    CONTACT_ID: 'contact_id',
+    INVITATION_ID: 'invitation_id',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    SCORE: 'score',
+    SUBMITTED_DATE: 'submitted_date',
+    SURVEY_ID: 'survey_id',
+} as const;
+
+export const FIELDS_SYSTEM_SYNCCONNECTOR = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ACCESS_TOKEN: 'access_token',
+    ACCOUNT_EMAIL: 'account_email',
+    CALENDAR_SYNCED_UNTIL: 'calendar_synced_until',
+    EMAIL_SYNCED_UNTIL: 'email_synced_until',
+    IS_ACTIVE: 'is_active',
+    LAST_ERROR: 'last_error',
+    LAST_SYNC_DATE: 'last_sync_date',
+    PROVIDER: 'provider',
+    REFRESH_TOKEN: 'refresh_token',
+    SYNC_CALENDAR: 'sync_calendar',
+    SYNC_EMAIL: 'sync_email',
+    TOKEN_EXPIRES_AT: 'token_expires_at',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_SYSTEMLOG = {
+    ID: '__sys_gen_id',
+    DETAILS: 'details',
+    LEVEL: 'level',
+    MESSAGE: 'message',
+    SOURCE: 'source',
+    TIMESTAMP: 'timestamp',
+} as const;
+
+export const FIELDS_SYSTEM_TABLE = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    CATEGORY: 'category',
+    CREATED_BY: 'created_by',
+    DESCRIPTION: 'description',
+    IS_MANAGED: 'is_managed',
+    SCHEMA_VERSION: 'schema_version',
+    TABLE_NAME: 'table_name',
+    TABLE_TYPE: 'table_type',
+} as const;
+
+export const FIELDS_SYSTEM_TEAMMEMBER = {
+    CREATED_BY_ID: '__sys_gen_created_by_id',
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    IS_DELETED: '__sys_gen_is_deleted',
+    LAST_MODIFIED_BY_ID: '__sys_gen_last_modified_by_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    ACCESS_LEVEL: 'access_level',
+    OBJECT_API_NAME: 'object_api_name',
+    RECORD_ID: 'record_id',
+    TEAM_ROLE: 'team_role',
+    USER_ID: 'user_id',
+} as const;
+
+export const FIELDS_SYSTEM_THEME = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COLORS: 'colors',
+    DENSITY: 'density',
     IS_ACTIVE: 'is_active',
     LOGO_URL: 'logo_url',
     NAME: 'name',
 } as const;
 
+export const FIELDS_SYSTEM_TRANSLATION = {
+    CREATED_DATE: '__sys_gen_created_date',
+    ID: '__sys_gen_id',
+    LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
+    COMPONENT_KEY: 'component_key',
+    COMPONENT_TYPE: 'component_type',
+    LOCALE: 'locale',
+    TRANSLATION: 'translation',
+} as const;
+
 export const FIELDS_SYSTEM_UICOMPONENT = {
     CREATED_DATE: '__sys_gen_created_date',
     ID: '__sys_gen_id',
@@ -785,15 +1686,19 @@ export const FIELDS_SYSTEM_USER = {
     LAST_MODIFIED_BY_ID: '__sys_gen_last_modified_by_id',
     LAST_MODIFIED_DATE: '__sys_gen_last_modified_date',
     OWNER_ID: '__sys_gen_owner_id',
+    ACCOUNT_ID: 'account_id',
+    CONTACT_ID: 'contact_id',
     EMAIL: 'email',
     FIRST_NAME: 'first_name',
     IS_ACTIVE: 'is_active',
     LAST_LOGIN_DATE: 'last_login_date',
     LAST_NAME: 'last_name',
+    LOCALE: 'locale',
     PASSWORD: 'password',
     PHONE: 'phone',
     PROFILE_ID: 'profile_id',
     ROLE_ID: 'role_id',
+    USER_TYPE: 'user_type',
     USERNAME: 'username',
 } as const;
 
@@ -823,6 +1728,20 @@ export const FIELDS_SYSTEM_WEBHOOK = {
 
 // ==================== TypeScript Interfaces ====================
 
+/** _System_AI_Context_Item - Files pinned to a user's AI agent context */
+export interface SystemAIContextItem {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    user_id: string;
+    path: string;
+    content: string;
+    token_size: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_AI_Conversation - Persisted AI conversation history per user */
 export interface SystemAIConversation {
     __sys_gen_id: string;
@@ -831,6 +1750,7 @@ export interface SystemAIConversation {
     title: string;
     messages: Record<string, unknown>;
     is_active: boolean;
+    settings: Record<string, unknown>;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
@@ -856,6 +1776,35 @@ export interface SystemAction {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_Activity - Emails, calendar events and calls captured from sync connectors and telephony providers, linked to the records whose email addresses or phone numbers they involve */
+export interface SystemActivity {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    activity_type: string;
+    subject?: string;
+    description?: string;
+    from_address?: string;
+    to_addresses?: string;
+    activity_date: string;
+    end_date?: string;
+    object_api_name: string;
+    record_id: string;
+    call_direction?: string;
+    call_status?: string;
+    call_duration?: number;
+    recording_url?: string;
+    connector_id: string;
+    external_id: string;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_App - Application configurations */
 export interface SystemApp {
     __sys_gen_id: string;
@@ -873,158 +1822,595 @@ export interface SystemApp {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_ApprovalProcess - Approval process definitions */
-export interface SystemApprovalProcess {
+/** _System_ApprovalProcess - Approval process definitions */
+export interface SystemApprovalProcess {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    object_api_name: string;
+    description?: string;
+    entry_criteria?: string;
+    approver_type: string;
+    approver_id?: string;
+    is_active: boolean;
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_by_id?: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_by_id?: string;
+    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
+    __sys_gen_last_modified_date?: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+}
+
+/** _System_ApprovalWorkItem - Pending approval work items */
+export interface SystemApprovalWorkItem {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    process_id: string;
+    flow_instance_id?: string;
+    flow_step_id?: string;
+    object_api_name: string;
+    record_id: string;
+    status: string;
+    submitted_by_id?: string;
+    submitted_date: string;
+    approver_id?: string;
+    approved_by_id?: string;
+    approved_date?: string;
+    comments?: string;
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_by_id?: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_by_id?: string;
+    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
+    __sys_gen_last_modified_date?: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+}
+
+/** _System_ArchivePolicy - Per-object archival policies moving old rows to an archive table */
+export interface SystemArchivePolicy {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    date_field: string;
+    retain_days: number;
+    is_active: boolean;
+    last_run_date?: string;
+    last_archived_count: number;
+    last_error?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_AsyncJob - Background jobs (e.g. picklist value replacement) with their progress and outcome */
+export interface SystemAsyncJob {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    job_type: string;
+    object_api_name?: string;
+    status: string;
+    parameters?: Record<string, unknown>;
+    total_count: number;
+    processed_count: number;
+    failed_count: number;
+    error_message?: string;
+    started_date?: string;
+    completed_date?: string;
+    __sys_gen_created_by_id?: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_AuditLog - Field history tracking */
+export interface SystemAuditLog {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    record_id: string;
+    field_name: string;
+    old_value: string;
+    new_value: string;
+    changed_by_id: string;
+    changed_at: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_AutoNumber - Auto-number sequence tracking */
+export interface SystemAutoNumber {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    field_api_name: string;
+    display_format: string;
+    starting_number: number;
+    current_number: number;
+    gap_free: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_BatchJob - Batch jobs processing the records of a scope query in chunks with a script or a built-in action, run on demand or on a schedule */
+export interface SystemBatchJob {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    object_api_name: string;
+    scope_filter?: string;
+    processor: string;
+    script_name?: string;
+    field_values?: Record<string, unknown>;
+    chunk_size?: number;
+    concurrency?: number;
+    schedule?: string;
+    schedule_timezone?: string;
+    next_run_at?: string;
+    last_run_at?: string;
+    last_job_id?: string;
+    is_active: boolean;
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_BootstrapStep - Startup steps that completed and the checksum of their input; a step is skipped while its checksum is unchanged */
+export interface SystemBootstrapStep {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    step_name: string;
+    checksum: string;
+    duration_ms: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_BusinessHours - Weekly working schedules used to measure elapsed business time */
+export interface SystemBusinessHours {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    timezone: string;
+    is_default: boolean;
+    schedule: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Campaign - Marketing campaign with its member statuses and influenced revenue rollups */
+export interface SystemCampaign {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    type?: string;
+    status: string;
+    start_date?: string;
+    end_date?: string;
+    budgeted_cost?: number;
+    actual_cost?: number;
+    expected_revenue?: number;
+    member_statuses?: Record<string, unknown>;
+    is_active: boolean;
+    number_of_members: number;
+    number_of_responses: number;
+    number_of_opportunities: number;
+    number_of_won_opportunities: number;
+    amount_all_opportunities: number;
+    amount_won_opportunities: number;
+    rollups_date?: string;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CampaignMember - Record (lead, contact...) targeted by a campaign and its response status */
+export interface SystemCampaignMember {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    campaign_id: string;
+    object_api_name: string;
+    record_id: string;
+    status: string;
+    has_responded: boolean;
+    first_responded_date?: string;
+    added_by_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ChangeEvent - Change data capture stream: one row per captured record change, ordered by position */
+export interface SystemChangeEvent {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    position: number;
+    object_api_name: string;
+    record_id: string;
+    operation: string;
+    transaction_id: string;
+    commit_timestamp: string;
+    changed_fields?: Record<string, unknown>;
+    before_data?: Record<string, unknown>;
+    after_data?: Record<string, unknown>;
+    user_id?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ChangeEventOffset - Last change event position committed by each change data capture consumer */
+export interface SystemChangeEventOffset {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    consumer: string;
+    position: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Comment - User comments on records */
+export interface SystemComment {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    record_id: string;
+    body: string;
+    __sys_gen_created_by_id: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    parent_comment_id?: string;
+    is_resolved: boolean;
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Config - Legacy flat configuration; superseded by _System_CustomSetting and migrated into it at startup */
+export interface SystemConfig {
+    key_name: string;
+    value: string;
+    is_secret: boolean;
+    description: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Contract - Support contract of an account; its entitlements apply while it is activated and in term */
+export interface SystemContract {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    account_id: string;
+    status: string;
+    start_date: string;
+    end_date?: string;
+    description?: string;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CustomEndpoint - Custom REST endpoints served at /api/custom/:name that run a script or a declarative query or DML template on validated input */
+export interface SystemCustomEndpoint {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    http_method: string;
+    action: string;
+    script_name?: string;
+    object_api_name?: string;
+    filter_expr?: string;
+    sort_field?: string;
+    sort_direction?: string;
+    row_limit?: number;
+    record_id?: string;
+    field_values?: Record<string, unknown>;
+    input_schema?: Record<string, unknown>;
+    profile_ids?: Record<string, unknown>;
+    is_active: boolean;
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CustomMetadataRecord - Records of custom metadata types, cached in memory with the rest of the metadata */
+export interface SystemCustomMetadataRecord {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    type_api_name: string;
+    developer_name: string;
+    label: string;
+    field_values?: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CustomMetadataType - Admin-defined configuration types whose records are deployed as metadata */
+export interface SystemCustomMetadataType {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    api_name: string;
+    label: string;
+    description?: string;
+    fields?: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CustomSetting - Hierarchical custom settings with an org-wide default value */
+export interface SystemCustomSetting {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    description?: string;
+    type: string;
+    default_value?: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_CustomSettingValue - Profile and user overrides of custom settings */
+export interface SystemCustomSettingValue {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    setting_name: string;
+    scope_type: string;
+    scope_id: string;
+    value?: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Dashboard - Dashboard configurations with widget-based layouts */
+export interface SystemDashboard {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description: string;
+    layout: string;
+    widgets: Record<string, unknown>;
+    filters: Record<string, unknown>;
+    run_as: string;
+    run_as_user_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_by_id?: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_last_modified_by_id?: string;
+    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Data_Quality_Rule - Per-object rules for data quality scoring */
+export interface SystemDataQualityRule {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    completeness_fields: Record<string, unknown>;
+    match_fields: Record<string, unknown>;
+    duplicate_threshold: number;
+    use_embeddings: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Data_Quality_Score - Completeness and duplicate scores of CRM records */
+export interface SystemDataQualityScore {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    record_id: string;
+    completeness_score: number;
+    duplicate_score: number;
+    duplicate_of?: string;
+    quality_score: number;
+    missing_fields: Record<string, unknown>;
+    scored_date: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_DeletedMetadata - Erased objects and fields kept restorable, with their data, until the grace period ends */
+export interface SystemDeletedMetadata {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    name: string;
+    component_type: string;
     object_api_name: string;
-    description?: string;
-    entry_criteria?: string;
-    approver_type: string;
-    approver_id?: string;
-    is_active: boolean;
-    __sys_gen_owner_id?: string;
-    owner_id?: string; // Alias for __sys_gen_owner_id
-    __sys_gen_created_by_id?: string;
-    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    field_api_name?: string;
+    storage_name?: string;
+    metadata: Record<string, unknown>;
+    deleted_by_id?: string;
+    deleted_date: string;
+    purge_after: string;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_last_modified_by_id?: string;
-    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
-    __sys_gen_last_modified_date?: string;
+    __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
 }
 
-/** _System_ApprovalWorkItem - Pending approval work items */
-export interface SystemApprovalWorkItem {
+/** _System_DocumentTemplate - Printable document templates (HTML or DOCX with {{field}} merge fields, barcodes and QR codes) rendered to PDF from a record and stored as a file */
+export interface SystemDocumentTemplate {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    process_id: string;
-    flow_instance_id?: string;
-    flow_step_id?: string;
+    name: string;
+    label: string;
     object_api_name: string;
-    record_id: string;
-    status: string;
-    submitted_by_id?: string;
-    submitted_date: string;
-    approver_id?: string;
-    approved_by_id?: string;
-    approved_date?: string;
-    comments?: string;
-    __sys_gen_owner_id?: string;
-    owner_id?: string; // Alias for __sys_gen_owner_id
-    __sys_gen_created_by_id?: string;
-    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    template_type: string;
+    content: string;
+    file_name?: string;
+    page_size: string;
+    is_active: boolean;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_last_modified_by_id?: string;
-    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
-    __sys_gen_last_modified_date?: string;
+    __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
 }
 
-/** _System_AuditLog - Field history tracking */
-export interface SystemAuditLog {
+/** _System_EmailTemplate - Email templates for notifications */
+export interface SystemEmailTemplate {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    object_api_name: string;
-    record_id: string;
-    field_name: string;
-    old_value: string;
-    new_value: string;
-    changed_by_id: string;
-    changed_at: string;
+    name: string;
+    subject: string;
+    html_body: string;
+    text_body: string;
+    from_name: string;
+    from_email: string;
+    reply_to: string;
+    is_active: boolean;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_AutoNumber - Auto-number sequence tracking */
-export interface SystemAutoNumber {
+/** _System_Entitlement - Support an account is entitled to; each case created against it consumes one unit */
+export interface SystemEntitlement {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    object_api_name: string;
-    field_api_name: string;
-    display_format: string;
-    starting_number: number;
-    current_number: number;
+    name: string;
+    account_id: string;
+    contract_id?: string;
+    type?: string;
+    start_date: string;
+    end_date?: string;
+    total_units?: number;
+    remaining_units?: number;
+    is_active: boolean;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_Comment - User comments on records */
-export interface SystemComment {
+/** _System_EntitlementUsage - Units of an entitlement consumed by a case */
+export interface SystemEntitlementUsage {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
+    entitlement_id: string;
     object_api_name: string;
     record_id: string;
-    body: string;
-    __sys_gen_created_by_id: string;
-    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    units: number;
+    consumed_by_id: string;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
-    parent_comment_id?: string;
-    is_resolved: boolean;
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_Config - System configuration settings */
-export interface SystemConfig {
-    key_name: string;
-    value: string;
-    is_secret: boolean;
-    description: string;
+/** _System_EscalationLog - Escalation actions taken on records, one row per rule level */
+export interface SystemEscalationLog {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    rule_name: string;
+    object_api_name: string;
+    record_id: string;
+    level: number;
+    escalated_date: string;
+    error_message?: string;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_Dashboard - Dashboard configurations with widget-based layouts */
-export interface SystemDashboard {
+/** _System_EscalationRule - Time-based escalations (reassign, notify, run flow) for records that stay open too long */
+export interface SystemEscalationRule {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
     name: string;
-    description: string;
-    layout: string;
-    widgets: Record<string, unknown>;
+    label: string;
+    object_api_name: string;
+    criteria?: string;
+    start_field: string;
+    business_hours?: string;
+    actions: Record<string, unknown>;
+    is_active: boolean;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_owner_id?: string;
-    owner_id?: string; // Alias for __sys_gen_owner_id
-    __sys_gen_created_by_id?: string;
-    created_by_id?: string; // Alias for __sys_gen_created_by_id
-    __sys_gen_last_modified_by_id?: string;
-    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_EmailTemplate - Email templates for notifications */
-export interface SystemEmailTemplate {
+/** _System_ExternalObject - Connection settings of external objects, read-only objects whose records are fetched from a REST, OData or SQL source at query time */
+export interface SystemExternalObject {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    name: string;
-    subject: string;
-    html_body: string;
-    text_body: string;
-    from_name: string;
-    from_email: string;
-    reply_to: string;
-    is_active: boolean;
+    object_api_name: string;
+    adapter: string;
+    named_credential?: string;
+    resource: string;
+    records_path?: string;
+    id_field: string;
+    field_map?: Record<string, unknown>;
+    dsn?: string;
+    max_rows: number;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
@@ -1077,6 +2463,9 @@ export interface SystemField {
     validator?: string;
     controlling_field?: string;
     picklist_dependency?: Record<string, unknown>;
+    inactive_options?: Record<string, unknown>;
+    value_set?: string;
+    lookup_filter?: string;
     rollup_config?: Record<string, unknown>;
     is_master_detail: boolean;
     is_polymorphic: boolean;
@@ -1216,44 +2605,229 @@ export interface SystemFlowStep {
     on_failure_step?: string;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_created_by_id?: string;
-    created_by_id?: string; // Alias for __sys_gen_created_by_id
-    __sys_gen_last_modified_by_id?: string;
-    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
-    __sys_gen_owner_id?: string;
-    owner_id?: string; // Alias for __sys_gen_owner_id
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_created_by_id?: string;
+    created_by_id?: string; // Alias for __sys_gen_created_by_id
+    __sys_gen_last_modified_by_id?: string;
+    last_modified_by_id?: string; // Alias for __sys_gen_last_modified_by_id
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ForecastAdjustment - Manager override of the amount a user forecasts in a category for a period */
+export interface SystemForecastAdjustment {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    user_id: string;
+    period: string;
+    category: string;
+    amount: number;
+    note?: string;
+    adjusted_by_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ForecastQuota - Forecast quota of a user for a period (2026, 2026-Q4 or 2026-10) */
+export interface SystemForecastQuota {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    user_id: string;
+    period: string;
+    amount: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ForecastSetting - Per-object forecasting: amount, close date and stage fields with the forecast category of each stage */
+export interface SystemForecastSetting {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    amount_field: string;
+    close_date_field: string;
+    stage_field: string;
+    category_mapping: Record<string, unknown>;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_GlobalValueSet - Picklist value lists shared by multiple picklist fields */
+export interface SystemGlobalValueSet {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    description?: string;
+    options: Record<string, unknown>;
+    inactive_options?: Record<string, unknown>;
+    is_standard: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Group - Groups and Queues */
+export interface SystemGroup {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    type: string;
+    email?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_GroupMember - Group membership */
+export interface SystemGroupMember {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    group_id: string;
+    user_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_is_deleted: boolean;
+    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Holiday - Non-working days of business hours */
+export interface SystemHoliday {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    business_hours_id: string;
+    name: string;
+    holiday_date: string;
+    is_recurring: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_HookSubscription - REST hook subscriptions of integration platforms (Zapier, Make): record events of an object are posted to the target URL with the subscribing user's access */
+export interface SystemHookSubscription {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    user_id: string;
+    object_api_name: string;
+    event: string;
+    target_url: string;
+    is_active: boolean;
+    failure_count: number;
+    last_delivery_date?: string;
+    last_error?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_InboundHook - Inbound webhook endpoints (/hooks/:slug): JSON payloads pushed by external systems are mapped onto fields and create or upsert records; secrets are stored encrypted */
+export interface SystemInboundHook {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    slug: string;
+    label: string;
+    object_api_name: string;
+    operation: string;
+    match_field?: string;
+    records_path?: string;
+    field_mapping: Record<string, unknown>;
+    secret: string;
+    run_as_user_id: string;
+    is_active: boolean;
+    last_received_date?: string;
+    last_error?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_KnowledgeArticle - Knowledge base article; holds the working copy while its published versions are served to readers */
+export interface SystemKnowledgeArticle {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    url_name: string;
+    title: string;
+    summary?: string;
+    body?: string;
+    category_id?: string;
+    status: string;
+    version_number: number;
+    published_version_number?: number;
+    published_date?: string;
+    archived_date?: string;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_KnowledgeArticleLink - Knowledge article attached to a record such as a case, with the version attached */
+export interface SystemKnowledgeArticleLink {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    article_id: string;
+    object_api_name: string;
+    record_id: string;
+    version_number: number;
+    linked_by_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_Group - Groups and Queues */
-export interface SystemGroup {
+/** _System_KnowledgeArticleVersion - Snapshot of a knowledge article taken each time it is published */
+export interface SystemKnowledgeArticleVersion {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    name: string;
-    label: string;
-    type: string;
-    email?: string;
+    article_id: string;
+    version_number: number;
+    title: string;
+    summary?: string;
+    body?: string;
+    category_id?: string;
+    published_by_id: string;
+    published_date: string;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
-/** _System_GroupMember - Group membership */
-export interface SystemGroupMember {
+/** _System_KnowledgeCategory - Category of the knowledge base taxonomy; categories nest under a parent */
+export interface SystemKnowledgeCategory {
     __sys_gen_id: string;
     id?: string; // Alias for __sys_gen_id
-    group_id: string;
-    user_id: string;
+    name: string;
+    parent_id?: string;
+    description?: string;
+    sort_order: number;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
-    __sys_gen_is_deleted: boolean;
-    is_deleted?: boolean; // Alias for __sys_gen_is_deleted
     __sys_gen_last_modified_date: string;
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
@@ -1278,6 +2852,13 @@ export interface SystemListView {
     label: string;
     filter_expr?: string;
     fields: Record<string, unknown>;
+    visibility: string;
+    owner_id?: string;
+    profile_ids?: Record<string, unknown>;
+    column_settings?: Record<string, unknown>;
+    sort_field?: string;
+    sort_direction?: string;
+    aggregates?: Record<string, unknown>;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_last_modified_date: string;
@@ -1299,6 +2880,25 @@ export interface SystemLog {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_NamedCredential - External service endpoints and their credentials for callouts; secrets are stored encrypted */
+export interface SystemNamedCredential {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    base_url: string;
+    auth_type: string;
+    username?: string;
+    auth_header?: string;
+    secret?: string;
+    timeout_seconds: number;
+    max_retries: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_Notification - User notifications */
 export interface SystemNotification {
     __sys_gen_id: string;
@@ -1364,6 +2964,61 @@ export interface SystemObjectPerms {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_Order - Customer order of products; activation decrements the stock of its items */
+export interface SystemOrder {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    status: string;
+    customer_object_api_name?: string;
+    customer_id?: string;
+    description?: string;
+    total_amount: number;
+    activated_date?: string;
+    fulfilled_date?: string;
+    cancelled_date?: string;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_OrderItem - Product line of an order; backordered_quantity is the part not covered by stock at activation */
+export interface SystemOrderItem {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    order_id: string;
+    product_id: string;
+    product_name?: string;
+    quantity: number;
+    backordered_quantity: number;
+    unit_price: number;
+    total_price: number;
+    sort_order: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Organization - The organization and its first-run setup state (a single row) */
+export interface SystemOrganization {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name?: string;
+    default_locale?: string;
+    setup_status: string;
+    sample_data: boolean;
+    setup_completed_by_id?: string;
+    setup_completed_date?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_OutboxEvent - Transactional event outbox for guaranteed delivery */
 export interface SystemOutboxEvent {
     __sys_gen_id: string;
@@ -1388,6 +3043,8 @@ export interface SystemPermissionSet {
     label: string;
     description: string;
     is_active: boolean;
+    bypass_flows: boolean;
+    bypass_validation: boolean;
     __sys_gen_created_date: string;
     created_date?: string; // Alias for __sys_gen_created_date
     __sys_gen_is_deleted: boolean;
@@ -1410,6 +3067,20 @@ export interface SystemPermissionSetAssignment {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_PortalObject - Objects exposed to portal users and the fields relating their records to a portal user's contact or account */
+export interface SystemPortalObject {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    contact_field?: string;
+    account_field?: string;
+    allow_create: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_Profile - User profiles and permission sets */
 export interface SystemProfile {
     __sys_gen_id: string;
@@ -1418,6 +3089,8 @@ export interface SystemProfile {
     description: string;
     is_active: boolean;
     is_system: boolean;
+    bypass_flows: boolean;
+    bypass_validation: boolean;
     __sys_gen_is_deleted: boolean;
     is_deleted?: boolean; // Alias for __sys_gen_is_deleted
     __sys_gen_owner_id?: string;
@@ -1445,6 +3118,35 @@ export interface SystemProfileLayout {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_ProfileRecordType - Record types available to a profile, with the profile default and page layout per record type */
+export interface SystemProfileRecordType {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    profile_id: string;
+    object_api_name: string;
+    record_type_id: string;
+    is_default: boolean;
+    layout_id?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_QueryGovernor - Per-profile overrides of the query governor limits */
+export interface SystemQueryGovernor {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    profile_id: string;
+    max_rows?: number;
+    max_execution_ms?: number;
+    max_concurrent_queries?: number;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_Recent - Recently viewed records */
 export interface SystemRecent {
     __sys_gen_id: string;
@@ -1491,6 +3193,8 @@ export interface SystemRecordType {
     description: string;
     is_active: boolean;
     is_master: boolean;
+    is_default: boolean;
+    picklist_values?: Record<string, unknown>;
     __sys_gen_is_deleted: boolean;
     is_deleted?: boolean; // Alias for __sys_gen_is_deleted
     __sys_gen_created_date: string;
@@ -1499,6 +3203,21 @@ export interface SystemRecordType {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_Record_Embedding - Embedding vectors of CRM records for semantic search */
+export interface SystemRecordEmbedding {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    record_id: string;
+    embedding: unknown;
+    content_hash: string;
+    model: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_RecycleBin - Recycle bin for soft-deleted records */
 export interface SystemRecycleBin {
     __sys_gen_id: string;
@@ -1533,6 +3252,29 @@ export interface SystemRelationship {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_Report - Report definitions (tabular, summary and matrix) */
+export interface SystemReport {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    object_api_name: string;
+    format: string;
+    columns: Record<string, unknown>;
+    filter_expr?: string;
+    groupings?: Record<string, unknown>;
+    column_groupings?: Record<string, unknown>;
+    aggregates?: Record<string, unknown>;
+    sort_field?: string;
+    sort_direction?: string;
+    row_limit?: number;
+    owner_id?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_Role - Role hierarchy for access control */
 export interface SystemRole {
     __sys_gen_id: string;
@@ -1555,6 +3297,112 @@ export interface SystemRole {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_SLAPolicy - Service level milestones per record priority, with pause statuses and escalation flows */
+export interface SystemSLAPolicy {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    label: string;
+    object_api_name: string;
+    priority_field?: string;
+    status_field: string;
+    paused_statuses: Record<string, unknown>;
+    closed_statuses: Record<string, unknown>;
+    business_hours?: string;
+    milestones: Record<string, unknown>;
+    is_active: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_SLATimer - Milestone timers of records under an SLA policy */
+export interface SystemSLATimer {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    policy_name: string;
+    object_api_name: string;
+    record_id: string;
+    milestone: string;
+    target_minutes: number;
+    status: string;
+    elapsed_seconds: number;
+    running_since?: string;
+    due_date?: string;
+    started_date: string;
+    completed_date?: string;
+    violated: boolean;
+    escalated_date?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_SavedSearch - Per-user saved global searches */
+export interface SystemSavedSearch {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    user_id: string;
+    name: string;
+    term: string;
+    object_scope?: Record<string, unknown>;
+    last_run_date?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Script - Sandboxed Starlark scripts run as record triggers and flow actions under step, time, memory and DML quotas */
+export interface SystemScript {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    source: string;
+    trigger_object?: string;
+    trigger_events?: string;
+    is_active: boolean;
+    max_steps?: number;
+    timeout_ms?: number;
+    max_memory_mb?: number;
+    max_dml_rows?: number;
+    max_query_rows?: number;
+    max_callouts?: number;
+    __sys_gen_owner_id?: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_ScriptLog - Script runs with their outcome, resource usage and log output */
+export interface SystemScriptLog {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    script_id: string;
+    script_name: string;
+    invocation: string;
+    object_api_name?: string;
+    record_id?: string;
+    status: string;
+    duration_ms: number;
+    steps: number;
+    dml_rows: number;
+    query_rows: number;
+    callouts: number;
+    output?: string;
+    error_message?: string;
+    user_id?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_Session - User authentication sessions */
 export interface SystemSession {
     __sys_gen_id: string;
@@ -1572,6 +3420,25 @@ export interface SystemSession {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_SetupAudit - Setup audit trail: one row per metadata change with its before/after state and actor */
+export interface SystemSetupAudit {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    action: string;
+    component_type: string;
+    component_name: string;
+    object_api_name?: string;
+    before_data?: Record<string, unknown>;
+    after_data?: Record<string, unknown>;
+    actor_id?: string;
+    actor_name?: string;
+    changed_at: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_SetupPage - Setup page definitions */
 export interface SystemSetupPage {
     __sys_gen_id: string;
@@ -1615,6 +3482,119 @@ export interface SystemSharingRule {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_StageHistory - Stays of records in the values of history-tracked picklist fields (stage, status) with entry and exit times */
+export interface SystemStageHistory {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    object_api_name: string;
+    record_id: string;
+    field_api_name: string;
+    value: string;
+    previous_value?: string;
+    entered_date: string;
+    exited_date?: string;
+    duration_seconds?: number;
+    changed_by_id?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+}
+
+/** _System_StateMachine - State machines on picklist fields: the allowed transitions between values, their required fields and profiles, and the actions run on entering and leaving each state */
+export interface SystemStateMachine {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    object_api_name: string;
+    field_api_name: string;
+    initial_states?: Record<string, unknown>;
+    states?: Record<string, unknown>;
+    transitions?: Record<string, unknown>;
+    is_active: boolean;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_Survey - Customer survey: a CSAT or NPS score question with optional follow-up questions */
+export interface SystemSurvey {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    name: string;
+    description?: string;
+    score_type: string;
+    question: string;
+    questions?: Record<string, unknown>;
+    is_active: boolean;
+    __sys_gen_owner_id: string;
+    owner_id?: string; // Alias for __sys_gen_owner_id
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_SurveyInvitation - Single-use survey link sent about a record such as a case; only a hash of its token is stored */
+export interface SystemSurveyInvitation {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    survey_id: string;
+    token_hash: string;
+    object_api_name?: string;
+    record_id?: string;
+    contact_id?: string;
+    expires_date?: string;
+    responded_date?: string;
+    created_by_id: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_SurveyResponse - Scored answer to a survey invitation, linked to the invitation's record and contact */
+export interface SystemSurveyResponse {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    survey_id: string;
+    invitation_id: string;
+    object_api_name?: string;
+    record_id?: string;
+    contact_id?: string;
+    score: number;
+    answers?: Record<string, unknown>;
+    comment?: string;
+    submitted_date: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
+/** _System_SyncConnector - Mailbox and calendar connections of users (Google, Microsoft 365); OAuth tokens are stored encrypted */
+export interface SystemSyncConnector {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    user_id: string;
+    provider: string;
+    account_email: string;
+    access_token: string;
+    refresh_token?: string;
+    token_expires_at?: string;
+    sync_email: boolean;
+    sync_calendar: boolean;
+    is_active: boolean;
+    email_synced_until?: string;
+    calendar_synced_until?: string;
+    last_sync_date?: string;
+    last_error?: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_SystemLog - System operation logs */
 export interface SystemSystemLog {
     __sys_gen_id: string;
@@ -1679,6 +3659,20 @@ export interface SystemTheme {
     last_modified_date?: string; // Alias for __sys_gen_last_modified_date
 }
 
+/** _System_Translation - Translated labels and messages of metadata components, one row per locale and component */
+export interface SystemTranslation {
+    __sys_gen_id: string;
+    id?: string; // Alias for __sys_gen_id
+    locale: string;
+    component_type: string;
+    component_key: string;
+    translation: string;
+    __sys_gen_created_date: string;
+    created_date?: string; // Alias for __sys_gen_created_date
+    __sys_gen_last_modified_date: string;
+    last_modified_date?: string; // Alias for __sys_gen_last_modified_date
+}
+
 /** _System_UIComponent - Registered UI components */
 export interface SystemUIComponent {
     __sys_gen_id: string;
@@ -1705,6 +3699,10 @@ export interface SystemUser {
     phone?: string;
     profile_id: string;
     role_id?: string;
+    user_type: string;
+    contact_id?: string;
+    account_id?: string;
+    locale?: string;
     is_active: boolean;
     __sys_gen_is_deleted: boolean;
     is_deleted?: boolean; // Alias for __sys_gen_is_deleted
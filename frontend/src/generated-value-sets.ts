@@ -0,0 +1,19 @@
+// Code generated by cmd/codegen. DO NOT EDIT.
+// Source: backend/internal/bootstrap/standard_value_sets.json
+// Generated at: 2026-10-18T05:25:48Z
+
+// ==================== Standard Value Sets ====================
+
+export const STANDARD_VALUE_SETS = {
+    Industry: ['Agriculture', 'Apparel', 'Banking', 'Biotechnology', 'Chemicals', 'Communications', 'Construction', 'Consulting', 'Education', 'Electronics', 'Energy', 'Engineering', 'Entertainment', 'Environmental', 'Finance', 'Food & Beverage', 'Government', 'Healthcare', 'Hospitality', 'Insurance', 'Machinery', 'Manufacturing', 'Media', 'Not For Profit', 'Recreation', 'Retail', 'Shipping', 'Technology', 'Telecommunications', 'Transportation', 'Utilities', 'Other'],
+    LeadSource: ['Web', 'Phone Inquiry', 'Partner Referral', 'Purchased List', 'Trade Show', 'Word of Mouth', 'Other'],
+    Rating: ['Hot', 'Warm', 'Cold'],
+    Salutation: ['Mr.', 'Ms.', 'Mrs.', 'Dr.', 'Prof.'],
+} as const;
+
+export type StandardValueSetName = keyof typeof STANDARD_VALUE_SETS;
+
+export type IndustryValue = typeof STANDARD_VALUE_SETS['Industry'][number];
+export type LeadSourceValue = typeof STANDARD_VALUE_SETS['LeadSource'][number];
+export type RatingValue = typeof STANDARD_VALUE_SETS['Rating'][number];
+export type SalutationValue = typeof STANDARD_VALUE_SETS['Salutation'][number];
@@ -24,6 +24,15 @@ export interface ChatMessage {
 export interface ChatRequest {
     messages: ChatMessage[];
     model?: string;
+    conversation_id?: string; // The saved conversation's settings apply
+    settings?: ConversationSettings; // Used when the conversation is not saved yet
+}
+
+// Guardrails enforced on every tool call the agent makes in a conversation
+export interface ConversationSettings {
+    read_only: boolean;
+    allowed_objects?: string[]; // Empty allows every object
+    max_records_per_call?: number; // 0 or unset means no limit
 }
 
 export interface ChatResponse {
@@ -166,11 +175,12 @@ export const agentApi = {
         return await apiClient.get<{ data: ConversationResponse }>(`${API_ENDPOINTS.AGENT.CONVERSATION}${query}`).then(res => res.data);
     },
 
-    saveConversation: async (messages: ChatMessage[], conversationId?: string, title?: string): Promise<{ id: string; status: string }> => {
+    saveConversation: async (messages: ChatMessage[], conversationId?: string, title?: string, settings?: ConversationSettings): Promise<{ id: string; status: string }> => {
         return await apiClient.post<{ data: { id: string; status: string } }>(API_ENDPOINTS.AGENT.CONVERSATION, {
             messages,
             conversation_id: conversationId,
             title,
+            settings, // Omitted keeps the saved settings
         }).then(res => res.data);
     },
 
@@ -179,8 +189,13 @@ export const agentApi = {
     },
 
     // Multiple conversations
-    listConversations: async (): Promise<{ conversations: ConversationSummary[] }> => {
-        return await apiClient.get<{ data: { conversations: ConversationSummary[] } }>(API_ENDPOINTS.AGENT.CONVERSATIONS).then(res => res.data);
+    listConversations: async (options: ConversationListOptions = {}): Promise<ConversationPage> => {
+        const params = new URLSearchParams();
+        if (options.limit) params.set('limit', String(options.limit));
+        if (options.offset) params.set('offset', String(options.offset));
+        if (options.search) params.set('search', options.search);
+        const query = params.toString() ? `?${params.toString()}` : '';
+        return await apiClient.get<{ data: ConversationPage }>(`${API_ENDPOINTS.AGENT.CONVERSATIONS}${query}`).then(res => res.data);
     },
 
     deleteConversation: async (id: string): Promise<void> => {
@@ -188,6 +203,17 @@ export const agentApi = {
     },
 };
 
+export interface ConversationListOptions {
+    limit?: number;
+    offset?: number;
+    search?: string; // Matches the conversation title
+}
+
+export interface ConversationPage {
+    conversations: ConversationSummary[];
+    has_more: boolean;
+}
+
 export interface ConversationSummary {
     id: string;
     title: string;
@@ -197,7 +223,7 @@ export interface ConversationSummary {
 }
 
 export interface ConversationResponse {
-    conversation: { id: string; title: string } | null;
+    conversation: { id: string; title: string; settings: ConversationSettings } | null;
     messages: ChatMessage[];
 }
 
@@ -3,11 +3,116 @@ import { API_ENDPOINTS } from './endpoints';
 
 export interface AnalyticsResult {
     data: Record<string, unknown>[];
+    /** True when the sandbox row limit cut the result short */
+    truncated?: boolean;
+}
+
+export type ForecastCategory = 'Pipeline' | 'BestCase' | 'Commit' | 'Closed' | 'Omitted';
+
+export interface ForecastSetting {
+    object_api_name: string;
+    amount_field: string;
+    close_date_field: string;
+    stage_field: string;
+    category_mapping: Record<string, ForecastCategory>; // Unmapped stages count as Pipeline
+}
+
+export interface ForecastQuota {
+    __sys_gen_id: string;
+    object_api_name: string;
+    user_id: string;
+    period: string;
+    amount: number;
+}
+
+export interface ForecastAdjustment {
+    __sys_gen_id: string;
+    object_api_name: string;
+    user_id: string;
+    period: string;
+    category: ForecastCategory;
+    amount: number;
+    note?: string;
+    adjusted_by_id: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface ForecastUser {
+    user_id: string;
+    name: string;
+    quota?: number;
+    amounts: Record<ForecastCategory, number>;
+    adjusted: Record<ForecastCategory, number>;
+    adjustments?: ForecastAdjustment[];
+    attainment?: number; // Adjusted closed amount over quota
+}
+
+export interface ForecastNode {
+    role_id: string | null; // Null for users without a role
+    role_name: string;
+    users: ForecastUser[];
+    children: ForecastNode[];
+    quota: number;
+    amounts: Record<ForecastCategory, number>;
+    adjusted: Record<ForecastCategory, number>;
+    attainment?: number;
+}
+
+export interface ForecastRollup {
+    object_api_name: string;
+    period: string;
+    period_start: string;
+    period_end: string; // Exclusive
+    categories: ForecastCategory[];
+    roles: ForecastNode[];
 }
 
 export const analyticsAPI = {
     executeAdminQuery: async (sql: string, params: unknown[] = []): Promise<AnalyticsResult> => {
         const response = await apiClient.post<AnalyticsResult>(API_ENDPOINTS.ANALYTICS.QUERY, { sql, params });
         return response;
-    }
+    },
+
+    /** Forecast of a period (2026, 2026-Q4 or 2026-10) rolled up the role hierarchy */
+    getForecast: async (period: string, objectApiName = 'opportunity'): Promise<ForecastRollup> => {
+        const params = new URLSearchParams({ period, object: objectApiName });
+        const response = await apiClient.get<{ data: ForecastRollup }>(`${API_ENDPOINTS.ANALYTICS.FORECAST}?${params.toString()}`);
+        return response.data;
+    },
+
+    getForecastSetting: async (objectApiName: string): Promise<ForecastSetting> => {
+        const response = await apiClient.get<{ data: ForecastSetting }>(API_ENDPOINTS.ANALYTICS.FORECAST_SETTINGS(objectApiName));
+        return response.data;
+    },
+
+    saveForecastSetting: async (objectApiName: string, setting: ForecastSetting): Promise<ForecastSetting> => {
+        const response = await apiClient.put<{ data: ForecastSetting }>(API_ENDPOINTS.ANALYTICS.FORECAST_SETTINGS(objectApiName), setting);
+        return response.data;
+    },
+
+    getForecastQuotas: async (period: string, objectApiName = 'opportunity'): Promise<ForecastQuota[]> => {
+        const params = new URLSearchParams({ period, object: objectApiName });
+        const response = await apiClient.get<{ data: ForecastQuota[] }>(`${API_ENDPOINTS.ANALYTICS.FORECAST_QUOTAS}?${params.toString()}`);
+        return response.data || [];
+    },
+
+    /** Set quotas of users for a period; a null amount removes the user's quota */
+    saveForecastQuotas: async (period: string, quotas: { user_id: string; amount: number | null }[], objectApiName = 'opportunity'): Promise<ForecastQuota[]> => {
+        const response = await apiClient.put<{ data: ForecastQuota[] }>(API_ENDPOINTS.ANALYTICS.FORECAST_QUOTAS, {
+            object_api_name: objectApiName,
+            period,
+            quotas,
+        });
+        return response.data || [];
+    },
+
+    /** Override the amount a user forecasts in a category (self or users below in the role hierarchy) */
+    adjustForecast: async (adjustment: Omit<ForecastAdjustment, '__sys_gen_id' | 'adjusted_by_id' | '__sys_gen_last_modified_date'>): Promise<ForecastAdjustment> => {
+        const response = await apiClient.post<{ data: ForecastAdjustment }>(API_ENDPOINTS.ANALYTICS.FORECAST_ADJUSTMENTS, adjustment);
+        return response.data;
+    },
+
+    deleteForecastAdjustment: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.ANALYTICS.FORECAST_ADJUSTMENT(id));
+    },
 };
@@ -13,7 +13,7 @@ export interface ApprovalWorkItem {
     [COMMON_FIELDS.PROCESS_ID]: string;
     [COMMON_FIELDS.OBJECT_API_NAME]: string;
     [COMMON_FIELDS.RECORD_ID]: string;
-    status: 'Pending' | 'Approved' | 'Rejected';
+    status: 'Pending' | 'Approved' | 'Rejected' | 'Recalled' | 'Reassigned';
     submitted_by_id: string;
     [COMMON_FIELDS.SUBMITTED_DATE]: string;
     approver_id?: string;
@@ -36,6 +36,11 @@ export interface ApprovalActionRequest {
     comments?: string;
 }
 
+export interface ReassignApprovalRequest {
+    approver_id: string;
+    comments?: string;
+}
+
 export interface SubmitApprovalResponse {
     success: boolean;
     message: string;
@@ -82,6 +87,29 @@ export const approvalsAPI = {
         return response.data;
     },
 
+    /**
+     * Recall a pending work item (submitter only)
+     */
+    async recall(workItemId: string, comments?: string): Promise<ApprovalActionResponse> {
+        const response = await apiClient.post<{ data: ApprovalActionResponse }>(
+            API_ENDPOINTS.APPROVALS.RECALL(workItemId),
+            { comments }
+        );
+        return response.data;
+    },
+
+    /**
+     * Reassign a pending work item to another approver
+     */
+    async reassign(workItemId: string, approverId: string, comments?: string): Promise<SubmitApprovalResponse> {
+        const request: ReassignApprovalRequest = { approver_id: approverId, comments };
+        const response = await apiClient.post<{ data: SubmitApprovalResponse }>(
+            API_ENDPOINTS.APPROVALS.REASSIGN(workItemId),
+            request
+        );
+        return response.data;
+    },
+
     /**
      * Get pending approvals for current user
      */
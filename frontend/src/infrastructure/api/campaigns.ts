@@ -0,0 +1,129 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+
+export type CampaignStatus = 'Planned' | 'In Progress' | 'Completed' | 'Aborted';
+
+export interface CampaignMemberStatus {
+    status: string;
+    responded: boolean;
+    is_default?: boolean; // Given to added members without a status
+}
+
+export interface Campaign {
+    __sys_gen_id: string;
+    name: string;
+    description?: string;
+    type?: string;
+    status: CampaignStatus;
+    start_date?: string; // RFC 3339 (e.g. 2026-10-01T00:00:00Z); only the date is kept
+    end_date?: string;
+    budgeted_cost?: number;
+    actual_cost?: number;
+    expected_revenue?: number;
+    member_statuses?: CampaignMemberStatus[];
+    is_active: boolean;
+    // Rollups maintained by the server
+    number_of_members: number;
+    number_of_responses: number;
+    number_of_opportunities: number;
+    number_of_won_opportunities: number;
+    amount_all_opportunities: number;
+    amount_won_opportunities: number;
+    rollups_date?: string;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type CampaignInput = Pick<Campaign, 'name' | 'status' | 'is_active'> &
+    Partial<Pick<Campaign, 'description' | 'type' | 'start_date' | 'end_date' | 'budgeted_cost' | 'actual_cost' | 'expected_revenue' | 'member_statuses' | '__sys_gen_owner_id'>>;
+
+export interface CampaignDetail {
+    campaign: Campaign;
+    statuses: CampaignMemberStatus[];
+    status_counts: Record<string, number>;
+    response_rate?: number; // Responses over members
+    roi?: number; // (Won amount - actual cost) / actual cost
+}
+
+export interface CampaignMember {
+    __sys_gen_id: string;
+    campaign_id: string;
+    object_api_name: string;
+    record_id: string;
+    status: string;
+    has_responded: boolean;
+    first_responded_date?: string;
+    added_by_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+/** Exactly one of record_ids (with object_api_name), list_view_id or report_id */
+export interface AddCampaignMembersInput {
+    object_api_name?: string;
+    record_ids?: string[];
+    list_view_id?: string;
+    report_id?: string;
+    status?: string; // Defaults to the campaign's default status
+}
+
+export interface AddCampaignMembersResult {
+    added: number;
+    skipped: number; // Already members
+}
+
+export const campaignsAPI = {
+    list: async (): Promise<Campaign[]> => {
+        const response = await apiClient.get<{ data: Campaign[] }>(API_ENDPOINTS.CAMPAIGNS.LIST);
+        return response.data;
+    },
+
+    get: async (id: string): Promise<CampaignDetail> => {
+        const response = await apiClient.get<{ data: CampaignDetail }>(API_ENDPOINTS.CAMPAIGNS.DETAIL(id));
+        return response.data;
+    },
+
+    create: async (campaign: CampaignInput): Promise<Campaign> => {
+        const response = await apiClient.post<{ data: Campaign }>(API_ENDPOINTS.CAMPAIGNS.LIST, campaign);
+        return response.data;
+    },
+
+    update: async (id: string, campaign: CampaignInput): Promise<Campaign> => {
+        const response = await apiClient.put<{ data: Campaign }>(API_ENDPOINTS.CAMPAIGNS.DETAIL(id), campaign);
+        return response.data;
+    },
+
+    delete: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.CAMPAIGNS.DETAIL(id));
+    },
+
+    /** Refresh member counts and influenced revenue now instead of on the next scheduled run */
+    recalculate: async (id: string): Promise<CampaignDetail> => {
+        const response = await apiClient.post<{ data: CampaignDetail }>(API_ENDPOINTS.CAMPAIGNS.RECALCULATE(id), {});
+        return response.data;
+    },
+
+    getMembers: async (id: string, limit?: number, offset?: number): Promise<CampaignMember[]> => {
+        const params = new URLSearchParams();
+        if (limit) params.set('limit', String(limit));
+        if (offset) params.set('offset', String(offset));
+        const query = params.toString() ? `?${params.toString()}` : '';
+        const response = await apiClient.get<{ data: CampaignMember[] }>(`${API_ENDPOINTS.CAMPAIGNS.MEMBERS(id)}${query}`);
+        return response.data;
+    },
+
+    addMembers: async (id: string, input: AddCampaignMembersInput): Promise<AddCampaignMembersResult> => {
+        const response = await apiClient.post<{ data: AddCampaignMembersResult }>(API_ENDPOINTS.CAMPAIGNS.MEMBERS(id), input);
+        return response.data;
+    },
+
+    updateMemberStatus: async (memberId: string, status: string): Promise<CampaignMember> => {
+        const response = await apiClient.patch<{ data: CampaignMember }>(API_ENDPOINTS.CAMPAIGNS.MEMBER(memberId), { status });
+        return response.data;
+    },
+
+    removeMember: async (memberId: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.CAMPAIGNS.MEMBER(memberId));
+    },
+};
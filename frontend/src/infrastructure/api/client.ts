@@ -1,5 +1,6 @@
 import { API_CONFIG } from '../../core/constants/EnvironmentConfig';
 import { STORAGE_KEYS } from '../../core/constants/ApplicationDefaults';
+import { isErrorCode, type ApiErrorBody, type ErrorCode } from '../../generated-error-codes';
 
 export class APIError extends Error {
   constructor(
@@ -10,6 +11,22 @@ export class APIError extends Error {
     super(message);
     this.name = 'APIError';
   }
+
+  /** Error body of the response, when the backend sent one */
+  get body(): ApiErrorBody | undefined {
+    const data = this.data as Partial<ApiErrorBody> | undefined;
+    return data && isErrorCode(data.code) ? data as ApiErrorBody : undefined;
+  }
+
+  /** Registered error code (see shared/constants/errorCodes.json) */
+  get code(): ErrorCode | undefined {
+    return this.body?.code;
+  }
+
+  /** Path of the input field the error is about */
+  get field(): string | undefined {
+    return this.body?.field;
+  }
 }
 
 export interface RequestOptions {
@@ -19,6 +36,15 @@ export interface RequestOptions {
   requiresAuth?: boolean;
 }
 
+// CSRF cookie set by the backend when cookie sessions are enabled (AUTH_SESSION_COOKIE)
+const CSRF_COOKIE = 'nexus_csrf';
+const CSRF_HEADER = 'X-CSRF-Token';
+
+function readCsrfToken(): string | null {
+  const match = document.cookie.match(new RegExp(`(?:^|; )${CSRF_COOKIE}=([^;]*)`));
+  return match ? decodeURIComponent(match[1]) : null;
+}
+
 // Event bus for auth errors to avoid circular dependencies
 export const authEvents = new EventTarget();
 export const AUTH_EVENT_UNAUTHORIZED = 'auth:unauthorized';
@@ -66,6 +92,14 @@ class APIClient {
       requestHeaders['Authorization'] = `Bearer ${this.token}`;
     }
 
+    // Echo the CSRF token on writes so cookie-authenticated requests are accepted
+    if (method !== 'GET') {
+      const csrfToken = readCsrfToken();
+      if (csrfToken) {
+        requestHeaders[CSRF_HEADER] = csrfToken;
+      }
+    }
+
     const requestOptions: RequestInit = {
       method,
       headers: requestHeaders,
@@ -1,7 +1,24 @@
 import { apiClient } from './client';
 import { API_ENDPOINTS } from './endpoints';
 import { COMMON_FIELDS } from '../../core/constants';
-import type { SObject, SearchResult, AnalyticsQuery, RecycleBinItem } from '../../types';
+import type {
+  SObject,
+  SearchResult,
+  AnalyticsQuery,
+  RecycleBinItem,
+  KanbanRequest,
+  KanbanResult,
+  KanbanMoveRequest,
+  CalendarRequest,
+  CalendarResult,
+  LookupResult,
+  UpdatePreview,
+  SLATimer,
+  StageHistoryEntry,
+  StageAnalytics,
+  TimelineEntryType,
+  TimelinePage,
+} from '../../types';
 
 export interface QueryRequest {
   objectApiName: string;
@@ -9,6 +26,34 @@ export interface QueryRequest {
   sortField?: string;
   sortDirection?: string;
   limit?: number;
+  includeArchived?: boolean; // Also read rows moved to the object's archive table
+  skipLookupNames?: boolean; // Leave out the {lookup}__name display values
+}
+
+// A natural-language question and the validated query it was answered with
+export interface AskDataRequest {
+  question: string;
+  objectApiName?: string; // Restricts the question to one object
+  limit?: number;
+}
+
+export interface AskDataResult<T = SObject> {
+  query: {
+    object_api_name: string;
+    filter_expr?: string;
+    sort_field?: string;
+    sort_direction?: string;
+    limit?: number;
+  };
+  explanation?: string;
+  records: T[];
+}
+
+export interface SemanticSearchHit<T = SObject> {
+  object_api_name: string;
+  object_label: string;
+  record: T;
+  score: number; // Cosine similarity; higher is closer
 }
 
 export const dataAPI = {
@@ -22,6 +67,8 @@ export const dataAPI = {
       sort_field: request.sortField,
       sort_direction: request.sortDirection,
       limit: request.limit,
+      include_archived: request.includeArchived || undefined,
+      skip_lookup_names: request.skipLookupNames || undefined,
     };
     const response = await apiClient.post<{ data: T[] }>(
       API_ENDPOINTS.DATA.QUERY,
@@ -61,6 +108,55 @@ export const dataAPI = {
     return response.data;
   },
 
+  /**
+   * Get the SLA milestone timers of a record
+   */
+  async getRecordSLA(objectApiName: string, id: string): Promise<SLATimer[]> {
+    const response = await apiClient.get<{ data: SLATimer[] }>(
+      API_ENDPOINTS.DATA.RECORD_SLA(objectApiName, id)
+    );
+    return response.data || [];
+  },
+
+  /**
+   * Get the stays of a record in the values of its history-tracked picklist fields
+   */
+  async getRecordStageHistory(objectApiName: string, id: string): Promise<StageHistoryEntry[]> {
+    const response = await apiClient.get<{ data: StageHistoryEntry[] }>(
+      API_ENDPOINTS.DATA.RECORD_STAGE_HISTORY(objectApiName, id)
+    );
+    return response.data || [];
+  },
+
+  /**
+   * Get one page of a record's timeline, newest first. cursor is the next_cursor of the previous page.
+   */
+  async getRecordTimeline(objectApiName: string, id: string, types?: TimelineEntryType[], cursor?: string, limit?: number): Promise<TimelinePage> {
+    const params = new URLSearchParams();
+    if (types?.length) params.set('types', types.join(','));
+    if (cursor) params.set('cursor', cursor);
+    if (limit) params.set('limit', String(limit));
+    const query = params.toString();
+    const response = await apiClient.get<{ data: TimelinePage }>(
+      `${API_ENDPOINTS.DATA.RECORD_TIMELINE(objectApiName, id)}${query ? `?${query}` : ''}`
+    );
+    return response.data || { entries: [] };
+  },
+
+  /**
+   * Get funnel and velocity figures of a history-tracked picklist field.
+   * from and to are dates or ISO timestamps; to is exclusive.
+   */
+  async getStageAnalytics(objectApiName: string, field: string, from?: string, to?: string): Promise<StageAnalytics> {
+    const params = new URLSearchParams({ field });
+    if (from) params.set('from', from);
+    if (to) params.set('to', to);
+    const response = await apiClient.get<{ data: StageAnalytics }>(
+      `${API_ENDPOINTS.DATA.STAGE_ANALYTICS(objectApiName)}?${params.toString()}`
+    );
+    return response.data;
+  },
+
   /**
    * Create a new record
    */
@@ -82,6 +178,35 @@ export const dataAPI = {
     );
   },
 
+  /**
+   * Run an update's validation and formula recalculation without saving it
+   */
+  async previewUpdate(objectApiName: string, id: string, updates: Partial<SObject>): Promise<UpdatePreview> {
+    const response = await apiClient.patch<{ data: UpdatePreview }>(
+      `${API_ENDPOINTS.DATA.RECORD(objectApiName, id)}?dryRun=true`,
+      updates
+    );
+    return response.data;
+  },
+
+  /**
+   * Update a single field, as inline editing does
+   */
+  async updateField(objectApiName: string, id: string, fieldApiName: string, value: unknown): Promise<void> {
+    await apiClient.patch(API_ENDPOINTS.DATA.RECORD_FIELD(objectApiName, id, fieldApiName), { value });
+  },
+
+  /**
+   * Preview the update of a single field without saving it
+   */
+  async previewField(objectApiName: string, id: string, fieldApiName: string, value: unknown): Promise<UpdatePreview> {
+    const response = await apiClient.patch<{ data: UpdatePreview }>(
+      `${API_ENDPOINTS.DATA.RECORD_FIELD(objectApiName, id, fieldApiName)}?dryRun=true`,
+      { value }
+    );
+    return response.data;
+  },
+
   /**
    * Delete a record (soft delete to recycle bin)
    */
@@ -113,6 +238,30 @@ export const dataAPI = {
     await apiClient.delete(API_ENDPOINTS.DATA.PURGE(id));
   },
 
+  /**
+   * Answer a natural-language question about records ("ask your data")
+   */
+  async askData<T = SObject>(request: AskDataRequest): Promise<AskDataResult<T>> {
+    const response = await apiClient.post<{ data: AskDataResult<T> }>(API_ENDPOINTS.DATA.NLQ, {
+      question: request.question,
+      [COMMON_FIELDS.OBJECT_API_NAME]: request.objectApiName,
+      limit: request.limit,
+    });
+    return response.data;
+  },
+
+  /**
+   * Rank readable records by similarity in meaning to a search term
+   */
+  async semanticSearch<T = SObject>(term: string, objectApiNames?: string[], limit?: number): Promise<SemanticSearchHit<T>[]> {
+    const response = await apiClient.post<{ data: SemanticSearchHit<T>[] }>(API_ENDPOINTS.DATA.SEMANTIC_SEARCH, {
+      term,
+      object_api_names: objectApiNames,
+      limit,
+    });
+    return response.data;
+  },
+
   /**
    * Run analytics query
    */
@@ -131,6 +280,47 @@ export const dataAPI = {
     return response.data;
   },
 
+  /**
+   * Get records grouped into kanban columns by a picklist field
+   */
+  async kanban(objectApiName: string, request: KanbanRequest): Promise<KanbanResult> {
+    const response = await apiClient.post<{ data: KanbanResult }>(API_ENDPOINTS.DATA.KANBAN(objectApiName), request);
+    return response.data;
+  },
+
+  /**
+   * Move a kanban card to another column
+   */
+  async moveKanbanCard(objectApiName: string, request: KanbanMoveRequest): Promise<SObject> {
+    const response = await apiClient.post<{ data: SObject }>(API_ENDPOINTS.DATA.KANBAN_MOVE(objectApiName), request);
+    return response.data;
+  },
+
+  /**
+   * Get records grouped by day within a date range
+   */
+  async calendar(objectApiName: string, request: CalendarRequest): Promise<CalendarResult> {
+    const response = await apiClient.post<{ data: CalendarResult }>(API_ENDPOINTS.DATA.CALENDAR(objectApiName), request);
+    return response.data;
+  },
+
+  /**
+   * Search lookup candidates by name prefix. When field ("object.field") is given, its lookup
+   * filter applies with the edited record's values from dependentValues.
+   */
+  async lookup(
+    objectApiName: string,
+    term: string,
+    options: { field?: string; dependentValues?: Record<string, unknown>; limit?: number } = {}
+  ): Promise<LookupResult> {
+    const params = new URLSearchParams({ q: term });
+    if (options.field) params.set('field', options.field);
+    if (options.dependentValues) params.set('dependentValues', JSON.stringify(options.dependentValues));
+    if (options.limit) params.set('limit', String(options.limit));
+    const response = await apiClient.get<{ data: LookupResult }>(`${API_ENDPOINTS.DATA.LOOKUP(objectApiName)}?${params}`);
+    return response.data;
+  },
+
   /**
    * Execute a server-side action
    */
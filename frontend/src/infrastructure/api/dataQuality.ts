@@ -0,0 +1,73 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+import type { AsyncJob } from '../../types';
+
+export interface DataQualityRule {
+    object_api_name: string;
+    completeness_fields?: string[]; // Empty scores the required fields and the name field
+    match_fields?: string[]; // Empty compares the name, email and phone fields
+    duplicate_threshold?: number; // Similarity (1-100) at which records are probable duplicates
+    use_embeddings: boolean;
+}
+
+export interface DataQualitySummary {
+    object_api_name: string;
+    object_label: string;
+    scored_records: number;
+    average_completeness: number;
+    average_quality: number;
+    probable_duplicates: number;
+    quality_bands: Record<'poor' | 'fair' | 'good', number>;
+    missing_fields: Record<string, number>;
+    last_scored_date?: string;
+}
+
+export interface DataQualityRecord {
+    record_id: string;
+    name: string;
+    completeness_score: number;
+    duplicate_score: number;
+    duplicate_of?: string;
+    quality_score: number;
+    missing_fields: string[];
+    scored_date: string;
+}
+
+export interface DataQualityRecordFilter {
+    maxQuality?: number;
+    duplicatesOnly?: boolean;
+    limit?: number;
+}
+
+export const dataQualityAPI = {
+    getDashboard: async (): Promise<DataQualitySummary[]> => {
+        const response = await apiClient.get<{ data: DataQualitySummary[] }>(API_ENDPOINTS.DATA_QUALITY.DASHBOARD);
+        return response.data;
+    },
+
+    getRecords: async (objectApiName: string, filter: DataQualityRecordFilter = {}): Promise<DataQualityRecord[]> => {
+        const params = new URLSearchParams();
+        if (filter.maxQuality !== undefined) params.set('max_quality', String(filter.maxQuality));
+        if (filter.duplicatesOnly) params.set('duplicates_only', 'true');
+        if (filter.limit) params.set('limit', String(filter.limit));
+        const query = params.toString() ? `?${params.toString()}` : '';
+        const response = await apiClient.get<{ data: DataQualityRecord[] }>(`${API_ENDPOINTS.DATA_QUALITY.RECORDS(objectApiName)}${query}`);
+        return response.data;
+    },
+
+    getRule: async (objectApiName: string): Promise<DataQualityRule> => {
+        const response = await apiClient.get<{ data: DataQualityRule }>(API_ENDPOINTS.DATA_QUALITY.RULE(objectApiName));
+        return response.data;
+    },
+
+    saveRule: async (objectApiName: string, rule: DataQualityRule): Promise<DataQualityRule> => {
+        const response = await apiClient.put<{ data: DataQualityRule }>(API_ENDPOINTS.DATA_QUALITY.RULE(objectApiName), rule);
+        return response.data;
+    },
+
+    /** Queue a scoring job; poll its progress with metadataAPI.getAsyncJob */
+    score: async (objectApiName: string): Promise<AsyncJob> => {
+        const response = await apiClient.post<{ data: AsyncJob }>(API_ENDPOINTS.DATA_QUALITY.SCORE(objectApiName), {});
+        return response.data;
+    },
+};
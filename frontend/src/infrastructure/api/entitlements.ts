@@ -0,0 +1,109 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+
+export type ContractStatus = 'Draft' | 'Activated' | 'Terminated';
+
+export interface Contract {
+    __sys_gen_id: string;
+    name: string;
+    account_id: string;
+    status: ContractStatus;
+    start_date: string; // RFC 3339 (e.g. 2026-10-01T00:00:00Z); only the date is kept
+    end_date?: string;
+    description?: string;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type ContractInput = Pick<Contract, 'name' | 'account_id' | 'start_date'> &
+    Partial<Pick<Contract, 'status' | 'end_date' | 'description' | '__sys_gen_owner_id'>>;
+
+export interface Entitlement {
+    __sys_gen_id: string;
+    name: string;
+    account_id: string;
+    contract_id?: string;
+    type?: string;
+    start_date: string;
+    end_date?: string;
+    total_units?: number; // Unlimited when unset
+    remaining_units?: number; // Maintained by the server as cases consume units
+    is_active: boolean;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type EntitlementInput = Pick<Entitlement, 'name' | 'account_id' | 'start_date' | 'is_active'> &
+    Partial<Pick<Entitlement, 'contract_id' | 'type' | 'end_date' | 'total_units' | '__sys_gen_owner_id'>>;
+
+export interface EntitlementUsage {
+    __sys_gen_id: string;
+    entitlement_id: string;
+    object_api_name: string;
+    record_id: string; // The case that consumed the units
+    units: number;
+    consumed_by_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+const accountQuery = (accountId?: string) => (accountId ? `?account_id=${encodeURIComponent(accountId)}` : '');
+
+export const entitlementsAPI = {
+    listContracts: async (accountId?: string): Promise<Contract[]> => {
+        const response = await apiClient.get<{ data: Contract[] }>(`${API_ENDPOINTS.CONTRACTS.LIST}${accountQuery(accountId)}`);
+        return response.data;
+    },
+
+    getContract: async (id: string): Promise<Contract> => {
+        const response = await apiClient.get<{ data: Contract }>(API_ENDPOINTS.CONTRACTS.DETAIL(id));
+        return response.data;
+    },
+
+    createContract: async (contract: ContractInput): Promise<Contract> => {
+        const response = await apiClient.post<{ data: Contract }>(API_ENDPOINTS.CONTRACTS.LIST, contract);
+        return response.data;
+    },
+
+    updateContract: async (id: string, contract: ContractInput): Promise<Contract> => {
+        const response = await apiClient.put<{ data: Contract }>(API_ENDPOINTS.CONTRACTS.DETAIL(id), contract);
+        return response.data;
+    },
+
+    /** Fails while the contract has entitlements */
+    deleteContract: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.CONTRACTS.DETAIL(id));
+    },
+
+    listEntitlements: async (accountId?: string): Promise<Entitlement[]> => {
+        const response = await apiClient.get<{ data: Entitlement[] }>(`${API_ENDPOINTS.ENTITLEMENTS.LIST}${accountQuery(accountId)}`);
+        return response.data;
+    },
+
+    getEntitlement: async (id: string): Promise<Entitlement> => {
+        const response = await apiClient.get<{ data: Entitlement }>(API_ENDPOINTS.ENTITLEMENTS.DETAIL(id));
+        return response.data;
+    },
+
+    createEntitlement: async (entitlement: EntitlementInput): Promise<Entitlement> => {
+        const response = await apiClient.post<{ data: Entitlement }>(API_ENDPOINTS.ENTITLEMENTS.LIST, entitlement);
+        return response.data;
+    },
+
+    /** Changing total_units moves remaining_units by the same amount */
+    updateEntitlement: async (id: string, entitlement: EntitlementInput): Promise<Entitlement> => {
+        const response = await apiClient.put<{ data: Entitlement }>(API_ENDPOINTS.ENTITLEMENTS.DETAIL(id), entitlement);
+        return response.data;
+    },
+
+    deleteEntitlement: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.ENTITLEMENTS.DETAIL(id));
+    },
+
+    getUsage: async (id: string): Promise<EntitlementUsage[]> => {
+        const response = await apiClient.get<{ data: EntitlementUsage[] }>(API_ENDPOINTS.ENTITLEMENTS.USAGE(id));
+        return response.data;
+    },
+};
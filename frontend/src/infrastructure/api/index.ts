@@ -19,6 +19,14 @@ export { apiClient, APIError } from './client';
 export * from './flows';
 export * from './feed';
 export * from './analytics';
+export * from './dataQuality';
+export * from './campaigns';
+export * from './orders';
+export * from './entitlements';
+export * from './knowledge';
+export * from './surveys';
+export * from './portal';
+export * from './setup';
 export type { RequestOptions } from './client';
 
 export { authAPI } from './auth';
@@ -29,6 +37,8 @@ export { metadataAPI } from './metadata';
 export { dataAPI } from './data';
 export type { QueryRequest } from './data';
 
+export { reportsAPI } from './reports';
+
 export { filesAPI } from './files';
 export type { UploadedFile } from './files';
 
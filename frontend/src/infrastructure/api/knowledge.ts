@@ -0,0 +1,202 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+
+export type KnowledgeArticleStatus = 'Draft' | 'Published' | 'Archived';
+
+export interface KnowledgeCategory {
+    __sys_gen_id: string;
+    name: string;
+    parent_id?: string;
+    description?: string;
+    sort_order: number;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type KnowledgeCategoryInput = Pick<KnowledgeCategory, 'name'> &
+    Partial<Pick<KnowledgeCategory, 'parent_id' | 'description' | 'sort_order'>>;
+
+/** The working copy of an article, as its author edits it */
+export interface KnowledgeArticle {
+    __sys_gen_id: string;
+    url_name: string;
+    title: string;
+    summary?: string;
+    body?: string;
+    category_id?: string;
+    status: KnowledgeArticleStatus; // Of the working copy; a published version stays live while a newer draft is edited
+    version_number: number; // Version the working copy will be published as
+    published_version_number?: number; // Live version; unset when never published or archived
+    published_date?: string;
+    archived_date?: string;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type KnowledgeArticleInput = Pick<KnowledgeArticle, 'title'> &
+    Partial<Pick<KnowledgeArticle, 'url_name' | 'summary' | 'body' | 'category_id' | '__sys_gen_owner_id'>>;
+
+export interface KnowledgeArticleVersion {
+    __sys_gen_id: string;
+    article_id: string;
+    version_number: number;
+    title: string;
+    summary?: string;
+    body?: string;
+    category_id?: string;
+    published_by_id: string;
+    published_date: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface PublishedArticle {
+    version: KnowledgeArticleVersion;
+    url_name: string;
+    score?: number; // Set on search results
+    highlights?: Record<string, string>;
+}
+
+export interface KnowledgeArticleLink {
+    __sys_gen_id: string;
+    article_id: string;
+    object_api_name: string;
+    record_id: string;
+    version_number: number; // Published version when the article was attached
+    linked_by_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+const recordQuery = (objectApiName: string, recordId: string) =>
+    `?object_api_name=${encodeURIComponent(objectApiName)}&record_id=${encodeURIComponent(recordId)}`;
+
+export const knowledgeAPI = {
+    listCategories: async (): Promise<KnowledgeCategory[]> => {
+        const response = await apiClient.get<{ data: KnowledgeCategory[] }>(API_ENDPOINTS.KNOWLEDGE.CATEGORIES);
+        return response.data;
+    },
+
+    createCategory: async (category: KnowledgeCategoryInput): Promise<KnowledgeCategory> => {
+        const response = await apiClient.post<{ data: KnowledgeCategory }>(API_ENDPOINTS.KNOWLEDGE.CATEGORIES, category);
+        return response.data;
+    },
+
+    updateCategory: async (id: string, category: KnowledgeCategoryInput): Promise<KnowledgeCategory> => {
+        const response = await apiClient.put<{ data: KnowledgeCategory }>(API_ENDPOINTS.KNOWLEDGE.CATEGORY(id), category);
+        return response.data;
+    },
+
+    /** Fails while the category has subcategories or articles */
+    deleteCategory: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.KNOWLEDGE.CATEGORY(id));
+    },
+
+    /** Working copies; non-admins only see their own articles */
+    listArticles: async (filter: { status?: KnowledgeArticleStatus; categoryId?: string } = {}): Promise<KnowledgeArticle[]> => {
+        const params = new URLSearchParams();
+        if (filter.status) params.set('status', filter.status);
+        if (filter.categoryId) params.set('category_id', filter.categoryId);
+        const query = params.toString();
+        const response = await apiClient.get<{ data: KnowledgeArticle[] }>(
+            `${API_ENDPOINTS.KNOWLEDGE.ARTICLES}${query ? `?${query}` : ''}`
+        );
+        return response.data;
+    },
+
+    getArticle: async (id: string): Promise<KnowledgeArticle> => {
+        const response = await apiClient.get<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.ARTICLE(id));
+        return response.data;
+    },
+
+    createArticle: async (article: KnowledgeArticleInput): Promise<KnowledgeArticle> => {
+        const response = await apiClient.post<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.ARTICLES, article);
+        return response.data;
+    },
+
+    /** Editing a published article starts a new draft version */
+    updateArticle: async (id: string, article: KnowledgeArticleInput): Promise<KnowledgeArticle> => {
+        const response = await apiClient.put<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.ARTICLE(id), article);
+        return response.data;
+    },
+
+    /** Only articles that are not live can be deleted; archive published ones first */
+    deleteArticle: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.KNOWLEDGE.ARTICLE(id));
+    },
+
+    publishArticle: async (id: string): Promise<KnowledgeArticle> => {
+        const response = await apiClient.post<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.PUBLISH(id), {});
+        return response.data;
+    },
+
+    archiveArticle: async (id: string): Promise<KnowledgeArticle> => {
+        const response = await apiClient.post<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.ARCHIVE(id), {});
+        return response.data;
+    },
+
+    restoreArticle: async (id: string): Promise<KnowledgeArticle> => {
+        const response = await apiClient.post<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.RESTORE(id), {});
+        return response.data;
+    },
+
+    listVersions: async (id: string): Promise<KnowledgeArticleVersion[]> => {
+        const response = await apiClient.get<{ data: KnowledgeArticleVersion[] }>(API_ENDPOINTS.KNOWLEDGE.VERSIONS(id));
+        return response.data;
+    },
+
+    /** Copies a published version into the working draft */
+    revertArticle: async (id: string, version: number): Promise<KnowledgeArticle> => {
+        const response = await apiClient.post<{ data: KnowledgeArticle }>(API_ENDPOINTS.KNOWLEDGE.REVERT(id, version), {});
+        return response.data;
+    },
+
+    listPublished: async (categoryId?: string): Promise<PublishedArticle[]> => {
+        const query = categoryId ? `?category_id=${encodeURIComponent(categoryId)}` : '';
+        const response = await apiClient.get<{ data: PublishedArticle[] }>(`${API_ENDPOINTS.KNOWLEDGE.PUBLISHED}${query}`);
+        return response.data;
+    },
+
+    /** Accepts a URL name or an article ID */
+    getPublished: async (urlName: string): Promise<PublishedArticle> => {
+        const response = await apiClient.get<{ data: PublishedArticle }>(API_ENDPOINTS.KNOWLEDGE.PUBLISHED_DETAIL(urlName));
+        return response.data;
+    },
+
+    search: async (term: string, options: { categoryId?: string; limit?: number } = {}): Promise<PublishedArticle[]> => {
+        const params = new URLSearchParams({ term });
+        if (options.categoryId) params.set('category_id', options.categoryId);
+        if (options.limit) params.set('limit', String(options.limit));
+        const response = await apiClient.get<{ data: PublishedArticle[] }>(`${API_ENDPOINTS.KNOWLEDGE.SEARCH}?${params}`);
+        return response.data;
+    },
+
+    /** Published articles relevant to a record such as a case, excluding those already attached */
+    getSuggestions: async (objectApiName: string, recordId: string): Promise<PublishedArticle[]> => {
+        const response = await apiClient.get<{ data: PublishedArticle[] }>(
+            `${API_ENDPOINTS.KNOWLEDGE.SUGGESTIONS}${recordQuery(objectApiName, recordId)}`
+        );
+        return response.data;
+    },
+
+    listLinks: async (objectApiName: string, recordId: string): Promise<KnowledgeArticleLink[]> => {
+        const response = await apiClient.get<{ data: KnowledgeArticleLink[] }>(
+            `${API_ENDPOINTS.KNOWLEDGE.LINKS}${recordQuery(objectApiName, recordId)}`
+        );
+        return response.data;
+    },
+
+    linkArticle: async (articleId: string, objectApiName: string, recordId: string): Promise<KnowledgeArticleLink> => {
+        const response = await apiClient.post<{ data: KnowledgeArticleLink }>(API_ENDPOINTS.KNOWLEDGE.LINKS, {
+            article_id: articleId,
+            object_api_name: objectApiName,
+            record_id: recordId,
+        });
+        return response.data;
+    },
+
+    unlinkArticle: async (linkId: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.KNOWLEDGE.LINK(linkId));
+    },
+};
@@ -1,7 +1,8 @@
 import { api } from './client';
 import { API_ENDPOINTS } from './endpoints';
 import { COMMON_FIELDS } from '../../core/constants';
-import type { ObjectMetadata, FieldMetadata, PageLayout, AppConfig, DashboardConfig } from '../../types';
+import type { SystemArchivePolicy, SystemDeletedMetadata, SystemSetupAudit } from '../../generated-schema';
+import type { ObjectMetadata, FieldMetadata, PageLayout, AppConfig, DashboardConfig, RecordType, ProfileRecordType, AvailableRecordTypes, StateMachine, PicklistValue, RecordPageDescribe, AsyncJob, GlobalValueSet, AutoNumber, CustomMetadataType, CustomMetadataRecord, CustomSetting, CustomSettingOverride, CustomSettingScope, CustomSettingValueType, NamedCredential, CalloutRequest, CalloutResponse, ExternalObject, ExternalDataSource, BusinessHours, Holiday, SLAPolicy, EscalationRule, Script, ScriptLog, ScriptRunRequest, ScriptRunResult, CustomEndpoint, BatchJob, Translation, TranslationLocale, TranslationFile, TranslationComponentType, DependencyReport, SchemaDriftReport, IndexAdvisorReport, ReadReplicaStatus, MassTransferRequest, MassUpdateRequest, SampleDataRequest } from '../../types';
 
 export const metadataAPI = {
   // Schema operations
@@ -10,6 +11,14 @@ export const metadataAPI = {
   createSchema: (schema: Partial<ObjectMetadata>) => api.post<{ data: ObjectMetadata; message: string }>(API_ENDPOINTS.METADATA.OBJECTS, schema).then(r => ({ schema: r.data, message: r.message })),
   updateSchema: (api_name: string, updates: Partial<ObjectMetadata>) => api.patch<{ message: string; data: ObjectMetadata }>(`${API_ENDPOINTS.METADATA.OBJECTS}/${api_name}`, updates).then(r => ({ schema: r.data, message: r.message })),
   deleteSchema: (api_name: string) => api.delete<{ message: string }>(`${API_ENDPOINTS.METADATA.OBJECTS}/${api_name}`),
+  // Schema, resolved layout, actions and record-type filtered picklist values for a record page in one call
+  describeFull: (objectApiName: string, options: { recordTypeId?: string; recordId?: string } = {}) => {
+    const params = new URLSearchParams();
+    if (options.recordTypeId) params.set('recordTypeId', options.recordTypeId);
+    if (options.recordId) params.set('recordId', options.recordId);
+    const query = params.toString();
+    return api.get<{ data: RecordPageDescribe }>(API_ENDPOINTS.METADATA.OBJECT_DESCRIBE_FULL(objectApiName) + (query ? `?${query}` : '')).then(r => r.data);
+  },
 
   // Field operations
   createField: (objectApiName: string, field: Partial<FieldMetadata>) =>
@@ -19,13 +28,244 @@ export const metadataAPI = {
   deleteField: (objectApiName: string, fieldApiName: string) =>
     api.delete(API_ENDPOINTS.METADATA.FIELD(objectApiName, fieldApiName)),
 
+  // Where-used analysis; deletion is refused while blocking dependencies remain
+  getObjectDependencies: (objectApiName: string) =>
+    api.get<{ data: DependencyReport }>(API_ENDPOINTS.METADATA.OBJECT_DEPENDENCIES(objectApiName)).then(r => r.data),
+  getFieldDependencies: (objectApiName: string, fieldApiName: string) =>
+    api.get<{ data: DependencyReport }>(API_ENDPOINTS.METADATA.FIELD_DEPENDENCIES(objectApiName, fieldApiName)).then(r => r.data),
+
+  // Deleted objects and fields stay restorable, with their data, for 15 days before they are purged
+  getDeletedMetadata: () =>
+    api.get<{ data: SystemDeletedMetadata[] }>(API_ENDPOINTS.METADATA.DELETED).then(r => r.data || []),
+  restoreDeletedMetadata: (id: string) => api.post<{ message: string }>(API_ENDPOINTS.METADATA.DELETED_RESTORE(id)),
+  purgeDeletedMetadata: (id: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.DELETED_ITEM(id)),
+
   // Layout operations
-  getLayout: (objectApiName: string) => api.get<{ data: PageLayout }>(API_ENDPOINTS.METADATA.LAYOUT(objectApiName)).then(r => ({ layout: r.data })),
+  getLayout: (objectApiName: string, recordTypeId?: string) =>
+    api.get<{ data: PageLayout }>(API_ENDPOINTS.METADATA.LAYOUT(objectApiName) + (recordTypeId ? `?recordTypeId=${encodeURIComponent(recordTypeId)}` : '')).then(r => ({ layout: r.data })),
+  // Layout with visibility conditions evaluated server-side for the record and current user
+  getResolvedLayout: (objectApiName: string, recordId?: string) =>
+    api.get<{ data: PageLayout }>(API_ENDPOINTS.METADATA.LAYOUT_RESOLVED(objectApiName) + (recordId ? `?recordId=${encodeURIComponent(recordId)}` : '')).then(r => ({ layout: r.data })),
   saveLayout: (layout: PageLayout) => api.post(API_ENDPOINTS.METADATA.LAYOUTS, layout),
   deleteLayout: (layoutId: string) => api.delete(API_ENDPOINTS.METADATA.LAYOUT_ID(layoutId)),
   assignLayoutToProfile: (profileId: string, objectApiName: string, layoutId: string) =>
     api.post(API_ENDPOINTS.METADATA.LAYOUT_ASSIGN, { [COMMON_FIELDS.PROFILE_ID]: profileId, [COMMON_FIELDS.OBJECT_API_NAME]: objectApiName, layout_id: layoutId }),
 
+  // Record type operations
+  getRecordTypes: (objectApiName: string) =>
+    api.get<{ data: RecordType[] }>(API_ENDPOINTS.METADATA.RECORD_TYPES(objectApiName)).then(r => r.data || []),
+  getAvailableRecordTypes: (objectApiName: string) =>
+    api.get<{ data: AvailableRecordTypes }>(API_ENDPOINTS.METADATA.RECORD_TYPES_AVAILABLE(objectApiName)).then(r => r.data),
+  createRecordType: (objectApiName: string, recordType: Partial<RecordType>) =>
+    api.post<{ data: RecordType }>(API_ENDPOINTS.METADATA.RECORD_TYPES(objectApiName), recordType).then(r => r.data),
+  updateRecordType: (id: string, updates: Partial<RecordType>) =>
+    api.patch<{ data: RecordType }>(API_ENDPOINTS.METADATA.RECORD_TYPE(id), updates).then(r => r.data),
+  deleteRecordType: (id: string) => api.delete(API_ENDPOINTS.METADATA.RECORD_TYPE(id)),
+  getProfileRecordTypes: (profileId: string, objectApiName: string) =>
+    api.get<{ data: ProfileRecordType[] }>(`${API_ENDPOINTS.METADATA.RECORD_TYPE_ASSIGNMENTS}?profile_id=${encodeURIComponent(profileId)}&object=${encodeURIComponent(objectApiName)}`).then(r => r.data || []),
+  assignRecordTypeToProfile: (profileId: string, recordTypeId: string, isDefault: boolean, layoutId?: string) =>
+    api.post(API_ENDPOINTS.METADATA.RECORD_TYPE_ASSIGN, { [COMMON_FIELDS.PROFILE_ID]: profileId, record_type_id: recordTypeId, is_default: isDefault, layout_id: layoutId }),
+  removeRecordTypeFromProfile: (recordTypeId: string, profileId: string) =>
+    api.delete(API_ENDPOINTS.METADATA.RECORD_TYPE_ASSIGNMENT(recordTypeId, profileId)),
+
+  // State machines
+  getObjectStateMachines: (objectApiName: string) =>
+    api.get<{ data: StateMachine[] }>(API_ENDPOINTS.METADATA.OBJECT_STATE_MACHINES(objectApiName)).then(r => r.data || []),
+  getStateMachines: () => api.get<{ data: StateMachine[] }>(API_ENDPOINTS.METADATA.STATE_MACHINES).then(r => r.data || []),
+  getStateMachine: (name: string) => api.get<{ data: StateMachine }>(API_ENDPOINTS.METADATA.STATE_MACHINE(name)).then(r => r.data),
+  createStateMachine: (machine: Partial<StateMachine>) =>
+    api.post<{ data: StateMachine }>(API_ENDPOINTS.METADATA.STATE_MACHINES, machine).then(r => r.data),
+  updateStateMachine: (name: string, machine: Partial<StateMachine>) =>
+    api.put<{ data: StateMachine }>(API_ENDPOINTS.METADATA.STATE_MACHINE(name), machine).then(r => r.data),
+  deleteStateMachine: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.STATE_MACHINE(name)),
+
+  // Picklist value operations
+  getPicklistValues: (objectApiName: string, fieldApiName: string) =>
+    api.get<{ data: PicklistValue[] }>(API_ENDPOINTS.METADATA.PICKLIST_VALUES(objectApiName, fieldApiName)).then(r => r.data || []),
+  addPicklistValue: (objectApiName: string, fieldApiName: string, value: string, position?: number) =>
+    api.post<{ data: PicklistValue[] }>(API_ENDPOINTS.METADATA.PICKLIST_VALUES(objectApiName, fieldApiName), { value, position }).then(r => r.data),
+  setPicklistValueActive: (objectApiName: string, fieldApiName: string, value: string, active: boolean) =>
+    api.post<{ data: PicklistValue[] }>(`${API_ENDPOINTS.METADATA.PICKLIST_VALUES(objectApiName, fieldApiName)}/${active ? 'activate' : 'deactivate'}`, { value }).then(r => r.data),
+  reorderPicklistValues: (objectApiName: string, fieldApiName: string, values: string[]) =>
+    api.put<{ data: PicklistValue[] }>(`${API_ENDPOINTS.METADATA.PICKLIST_VALUES(objectApiName, fieldApiName)}/order`, { values }).then(r => r.data),
+  replacePicklistValue: (objectApiName: string, fieldApiName: string, from: string, to: string, deactivate = false) =>
+    api.post<{ data: AsyncJob }>(`${API_ENDPOINTS.METADATA.PICKLIST_VALUES(objectApiName, fieldApiName)}/replace`, { from, to, deactivate }).then(r => r.data),
+  getAsyncJob: (id: string) => api.get<{ data: AsyncJob }>(API_ENDPOINTS.METADATA.ASYNC_JOB(id)).then(r => r.data),
+  abortAsyncJob: (id: string) => api.post<{ data: AsyncJob }>(API_ENDPOINTS.METADATA.ASYNC_JOB_ABORT(id)).then(r => r.data),
+  setFieldValueSet: (objectApiName: string, fieldApiName: string, valueSet: string | null) =>
+    api.put<{ data: FieldMetadata }>(API_ENDPOINTS.METADATA.FIELD_VALUE_SET(objectApiName, fieldApiName), { value_set: valueSet }).then(r => r.data),
+
+  // Auto number operations
+  getAutoNumber: (objectApiName: string, fieldApiName: string) =>
+    api.get<{ data: AutoNumber }>(API_ENDPOINTS.METADATA.AUTO_NUMBER(objectApiName, fieldApiName)).then(r => r.data),
+  updateAutoNumber: (objectApiName: string, fieldApiName: string, settings: { display_format?: string; gap_free?: boolean }) =>
+    api.patch<{ data: AutoNumber }>(API_ENDPOINTS.METADATA.AUTO_NUMBER(objectApiName, fieldApiName), settings).then(r => r.data),
+  backfillAutoNumber: (objectApiName: string, fieldApiName: string) =>
+    api.post<{ data: { updated_count: number } }>(`${API_ENDPOINTS.METADATA.AUTO_NUMBER(objectApiName, fieldApiName)}/backfill`, {}).then(r => r.data),
+
+  // Custom metadata operations
+  getCustomMetadataTypes: () => api.get<{ data: CustomMetadataType[] }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_TYPES).then(r => r.data || []),
+  getCustomMetadataType: (typeName: string) => api.get<{ data: CustomMetadataType }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_TYPE(typeName)).then(r => r.data),
+  createCustomMetadataType: (type: Partial<CustomMetadataType>) =>
+    api.post<{ data: CustomMetadataType }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_TYPES, type).then(r => r.data),
+  updateCustomMetadataType: (typeName: string, updates: Partial<CustomMetadataType>) =>
+    api.patch<{ data: CustomMetadataType }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_TYPE(typeName), updates).then(r => r.data),
+  deleteCustomMetadataType: (typeName: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_TYPE(typeName)),
+  getCustomMetadataRecords: (typeName: string) =>
+    api.get<{ data: CustomMetadataRecord[] }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_RECORDS(typeName)).then(r => r.data || []),
+  createCustomMetadataRecord: (typeName: string, record: Partial<CustomMetadataRecord>) =>
+    api.post<{ data: CustomMetadataRecord }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_RECORDS(typeName), record).then(r => r.data),
+  updateCustomMetadataRecord: (typeName: string, developerName: string, updates: Partial<CustomMetadataRecord>) =>
+    api.patch<{ data: CustomMetadataRecord }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_RECORD(typeName, developerName), updates).then(r => r.data),
+  deleteCustomMetadataRecord: (typeName: string, developerName: string) =>
+    api.delete<{ message: string }>(API_ENDPOINTS.METADATA.CUSTOM_METADATA_RECORD(typeName, developerName)),
+
+  // Custom setting operations
+  getCustomSettings: () => api.get<{ data: CustomSetting[] }>(API_ENDPOINTS.METADATA.CUSTOM_SETTINGS).then(r => r.data || []),
+  getResolvedCustomSettings: () =>
+    api.get<{ data: Record<string, CustomSettingValueType> }>(API_ENDPOINTS.METADATA.CUSTOM_SETTINGS_RESOLVED).then(r => r.data || {}),
+  getCustomSetting: (name: string) => api.get<{ data: CustomSetting }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING(name)).then(r => r.data),
+  createCustomSetting: (setting: Partial<CustomSetting>) =>
+    api.post<{ data: CustomSetting }>(API_ENDPOINTS.METADATA.CUSTOM_SETTINGS, setting).then(r => r.data),
+  updateCustomSetting: (name: string, updates: Partial<CustomSetting>) =>
+    api.patch<{ data: CustomSetting }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING(name), updates).then(r => r.data),
+  deleteCustomSetting: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING(name)),
+  getCustomSettingOverrides: (name: string) =>
+    api.get<{ data: CustomSettingOverride[] }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING_VALUES(name)).then(r => r.data || []),
+  setCustomSettingOverride: (name: string, scope: CustomSettingScope, scopeId: string, value: CustomSettingValueType) =>
+    api.put<{ data: CustomSettingOverride }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING_VALUES(name), { scope_type: scope, scope_id: scopeId, value }).then(r => r.data),
+  deleteCustomSettingOverride: (name: string, scope: CustomSettingScope, scopeId: string) =>
+    api.delete<{ message: string }>(API_ENDPOINTS.METADATA.CUSTOM_SETTING_VALUE(name, scope, scopeId)),
+
+  // Named credential operations
+  getNamedCredentials: () => api.get<{ data: NamedCredential[] }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIALS).then(r => r.data || []),
+  getNamedCredential: (name: string) => api.get<{ data: NamedCredential }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIAL(name)).then(r => r.data),
+  createNamedCredential: (credential: Partial<NamedCredential>) =>
+    api.post<{ data: NamedCredential }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIALS, credential).then(r => r.data),
+  updateNamedCredential: (name: string, updates: Partial<NamedCredential>) =>
+    api.patch<{ data: NamedCredential }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIAL(name), updates).then(r => r.data),
+  deleteNamedCredential: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIAL(name)),
+  testCallout: (name: string, request: CalloutRequest) =>
+    api.post<{ data: CalloutResponse }>(API_ENDPOINTS.METADATA.NAMED_CREDENTIAL_CALLOUT(name), request).then(r => r.data),
+
+  // External object operations (records are queried through the regular data API)
+  getExternalObjects: () => api.get<{ data: ExternalObject[] }>(API_ENDPOINTS.METADATA.EXTERNAL_OBJECTS).then(r => r.data || []),
+  getExternalObject: (apiName: string) => api.get<{ data: ExternalObject }>(API_ENDPOINTS.METADATA.EXTERNAL_OBJECT(apiName)).then(r => r.data),
+  createExternalObject: (definition: ExternalObject) =>
+    api.post<{ data: ExternalObject }>(API_ENDPOINTS.METADATA.EXTERNAL_OBJECTS, definition).then(r => r.data),
+  updateExternalDataSource: (apiName: string, updates: Partial<ExternalDataSource>) =>
+    api.patch<{ data: ExternalDataSource }>(API_ENDPOINTS.METADATA.EXTERNAL_OBJECT_SOURCE(apiName), updates).then(r => r.data),
+  deleteExternalObject: (apiName: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.EXTERNAL_OBJECT(apiName)),
+
+  // Business hours, holidays and SLA policies (record timers are read through dataAPI.getRecordSLA)
+  getBusinessHours: () => api.get<{ data: BusinessHours[] }>(API_ENDPOINTS.METADATA.BUSINESS_HOURS).then(r => r.data || []),
+  createBusinessHours: (businessHours: Partial<BusinessHours>) =>
+    api.post<{ data: BusinessHours }>(API_ENDPOINTS.METADATA.BUSINESS_HOURS, businessHours).then(r => r.data),
+  updateBusinessHours: (name: string, updates: Partial<Pick<BusinessHours, 'label' | 'timezone' | 'is_default' | 'schedule'>>) =>
+    api.patch<{ data: BusinessHours }>(API_ENDPOINTS.METADATA.BUSINESS_HOURS_BY_NAME(name), updates).then(r => r.data),
+  deleteBusinessHours: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.BUSINESS_HOURS_BY_NAME(name)),
+  addHoliday: (name: string, holiday: Pick<Holiday, 'name' | 'holiday_date' | 'is_recurring'>) =>
+    api.post<{ data: Holiday }>(API_ENDPOINTS.METADATA.HOLIDAYS(name), holiday).then(r => r.data),
+  deleteHoliday: (name: string, id: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.HOLIDAY(name, id)),
+  getSLAPolicies: () => api.get<{ data: SLAPolicy[] }>(API_ENDPOINTS.METADATA.SLA_POLICIES).then(r => r.data || []),
+  getSLAPolicy: (name: string) => api.get<{ data: SLAPolicy }>(API_ENDPOINTS.METADATA.SLA_POLICY(name)).then(r => r.data),
+  createSLAPolicy: (policy: Partial<SLAPolicy>) => api.post<{ data: SLAPolicy }>(API_ENDPOINTS.METADATA.SLA_POLICIES, policy).then(r => r.data),
+  updateSLAPolicy: (name: string, policy: Partial<SLAPolicy>) =>
+    api.put<{ data: SLAPolicy }>(API_ENDPOINTS.METADATA.SLA_POLICY(name), policy).then(r => r.data),
+  deleteSLAPolicy: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.SLA_POLICY(name)),
+
+  // Escalation rules
+  getEscalationRules: () => api.get<{ data: EscalationRule[] }>(API_ENDPOINTS.METADATA.ESCALATION_RULES).then(r => r.data || []),
+  getEscalationRule: (name: string) => api.get<{ data: EscalationRule }>(API_ENDPOINTS.METADATA.ESCALATION_RULE(name)).then(r => r.data),
+  createEscalationRule: (rule: Partial<EscalationRule>) =>
+    api.post<{ data: EscalationRule }>(API_ENDPOINTS.METADATA.ESCALATION_RULES, rule).then(r => r.data),
+  updateEscalationRule: (name: string, rule: Partial<EscalationRule>) =>
+    api.put<{ data: EscalationRule }>(API_ENDPOINTS.METADATA.ESCALATION_RULE(name), rule).then(r => r.data),
+  deleteEscalationRule: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.ESCALATION_RULE(name)),
+
+  // Scripts
+  getScripts: () => api.get<{ data: Script[] }>(API_ENDPOINTS.METADATA.SCRIPTS).then(r => r.data || []),
+  getScript: (name: string) => api.get<{ data: Script }>(API_ENDPOINTS.METADATA.SCRIPT(name)).then(r => r.data),
+  createScript: (script: Partial<Script>) => api.post<{ data: Script }>(API_ENDPOINTS.METADATA.SCRIPTS, script).then(r => r.data),
+  updateScript: (name: string, script: Partial<Script>) =>
+    api.put<{ data: Script }>(API_ENDPOINTS.METADATA.SCRIPT(name), script).then(r => r.data),
+  deleteScript: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.SCRIPT(name)),
+  runScript: (name: string, request: ScriptRunRequest = {}) =>
+    api.post<{ data: ScriptRunResult }>(API_ENDPOINTS.METADATA.SCRIPT_RUN(name), request).then(r => r.data),
+  getScriptLogs: (name: string) => api.get<{ data: ScriptLog[] }>(API_ENDPOINTS.METADATA.SCRIPT_LOGS(name)).then(r => r.data || []),
+
+  // Custom REST Endpoints
+  getCustomEndpoints: () => api.get<{ data: CustomEndpoint[] }>(API_ENDPOINTS.METADATA.CUSTOM_ENDPOINTS).then(r => r.data || []),
+  getCustomEndpoint: (name: string) => api.get<{ data: CustomEndpoint }>(API_ENDPOINTS.METADATA.CUSTOM_ENDPOINT(name)).then(r => r.data),
+  createCustomEndpoint: (endpoint: Partial<CustomEndpoint>) =>
+    api.post<{ data: CustomEndpoint }>(API_ENDPOINTS.METADATA.CUSTOM_ENDPOINTS, endpoint).then(r => r.data),
+  updateCustomEndpoint: (name: string, endpoint: Partial<CustomEndpoint>) =>
+    api.put<{ data: CustomEndpoint }>(API_ENDPOINTS.METADATA.CUSTOM_ENDPOINT(name), endpoint).then(r => r.data),
+  deleteCustomEndpoint: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.CUSTOM_ENDPOINT(name)),
+
+  // Batch Jobs
+  getBatchJobs: () => api.get<{ data: BatchJob[] }>(API_ENDPOINTS.METADATA.BATCH_JOBS).then(r => r.data || []),
+  getBatchJob: (name: string) => api.get<{ data: BatchJob }>(API_ENDPOINTS.METADATA.BATCH_JOB(name)).then(r => r.data),
+  createBatchJob: (batchJob: Partial<BatchJob>) =>
+    api.post<{ data: BatchJob }>(API_ENDPOINTS.METADATA.BATCH_JOBS, batchJob).then(r => r.data),
+  updateBatchJob: (name: string, batchJob: Partial<BatchJob>) =>
+    api.put<{ data: BatchJob }>(API_ENDPOINTS.METADATA.BATCH_JOB(name), batchJob).then(r => r.data),
+  deleteBatchJob: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.BATCH_JOB(name)),
+  runBatchJob: (name: string) => api.post<{ data: AsyncJob }>(API_ENDPOINTS.METADATA.BATCH_JOB_RUN(name)).then(r => r.data),
+  abortBatchJob: (name: string) => api.post<{ data: AsyncJob }>(API_ENDPOINTS.METADATA.BATCH_JOB_ABORT(name)).then(r => r.data),
+
+  // Archival policies: rows older than retain_days move to the object's archive table
+  getArchivePolicies: () => api.get<{ data: SystemArchivePolicy[] }>(API_ENDPOINTS.METADATA.ARCHIVE_POLICIES).then(r => r.data || []),
+  getArchivePolicy: (objectApiName: string) =>
+    api.get<{ data: SystemArchivePolicy }>(API_ENDPOINTS.METADATA.ARCHIVE_POLICY(objectApiName)).then(r => r.data),
+  saveArchivePolicy: (objectApiName: string, policy: Pick<SystemArchivePolicy, 'date_field' | 'retain_days' | 'is_active'>) =>
+    api.put<{ data: SystemArchivePolicy }>(API_ENDPOINTS.METADATA.ARCHIVE_POLICY(objectApiName), policy).then(r => r.data),
+  deleteArchivePolicy: (objectApiName: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.ARCHIVE_POLICY(objectApiName)),
+  runArchivePolicy: (objectApiName: string) =>
+    api.post<{ data: SystemArchivePolicy }>(API_ENDPOINTS.METADATA.ARCHIVE_POLICY_RUN(objectApiName)).then(r => r.data),
+
+  // Translation workbench
+  getTranslationLocales: () => api.get<{ data: TranslationLocale[] }>(API_ENDPOINTS.METADATA.TRANSLATIONS).then(r => r.data || []),
+  getTranslations: (locale: string, type?: TranslationComponentType) =>
+    api.get<{ data: Translation[] }>(`${API_ENDPOINTS.METADATA.TRANSLATION_LOCALE(locale)}${type ? `?type=${type}` : ''}`).then(r => r.data || []),
+  saveTranslations: (locale: string, translations: Translation[]) =>
+    api.put<{ data: { saved: number } }>(API_ENDPOINTS.METADATA.TRANSLATION_LOCALE(locale), { translations }).then(r => r.data),
+  deleteTranslationLocale: (locale: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.TRANSLATION_LOCALE(locale)),
+  exportTranslations: (locale: string) => api.get<TranslationFile>(API_ENDPOINTS.METADATA.TRANSLATION_EXPORT(locale)),
+  importTranslations: (locale: string, file: TranslationFile) =>
+    api.post<{ data: { imported: number } }>(API_ENDPOINTS.METADATA.TRANSLATION_IMPORT(locale), file).then(r => r.data),
+
+  // Setup audit trail of metadata changes (filters: component_type, component_name, object, actor_id, action, since, until, limit, offset)
+  getSetupAudit: (filters: Record<string, string | number> = {}) => {
+    const params = new URLSearchParams(Object.entries(filters).map(([k, v]) => [k, String(v)])).toString();
+    return api.get<{ data: SystemSetupAudit[] }>(`${API_ENDPOINTS.ADMIN.SETUP_AUDIT}${params ? `?${params}` : ''}`).then(r => r.data || []);
+  },
+
+  // Drift between the database schema and metadata; repair adds missing columns and indexes
+  getSchemaDrift: (refresh = false) =>
+    api.get<{ data: SchemaDriftReport }>(`${API_ENDPOINTS.ADMIN.SCHEMA_DRIFT}${refresh ? '?refresh=true' : ''}`).then(r => r.data),
+  repairSchemaDrift: () => api.post<{ data: SchemaDriftReport }>(API_ENDPOINTS.ADMIN.SCHEMA_DRIFT_REPAIR).then(r => r.data),
+  getIndexAdvisor: () => api.get<{ data: IndexAdvisorReport }>(API_ENDPOINTS.ADMIN.INDEX_ADVISOR).then(r => r.data),
+  getReadReplicaStatus: () => api.get<{ data: ReadReplicaStatus }>(API_ENDPOINTS.ADMIN.READ_REPLICA).then(r => r.data),
+
+  // Mass ownership transfer and field updates run as async jobs
+  massTransfer: (request: MassTransferRequest) =>
+    api.post<{ data: AsyncJob }>(API_ENDPOINTS.ADMIN.MASS_TRANSFER, request).then(r => r.data),
+  massUpdate: (request: MassUpdateRequest) =>
+    api.post<{ data: AsyncJob }>(API_ENDPOINTS.ADMIN.MASS_UPDATE, request).then(r => r.data),
+  // Linked demo accounts, contacts, opportunities and tasks, generated as an async job
+  generateSampleData: (request: SampleDataRequest = {}) =>
+    api.post<{ data: AsyncJob }>(API_ENDPOINTS.ADMIN.SAMPLE_DATA, request).then(r => r.data),
+
+  // Global value set operations
+  getGlobalValueSets: () => api.get<{ data: GlobalValueSet[] }>(API_ENDPOINTS.METADATA.GLOBAL_VALUE_SETS).then(r => r.data || []),
+  getGlobalValueSet: (name: string) => api.get<{ data: GlobalValueSet }>(API_ENDPOINTS.METADATA.GLOBAL_VALUE_SET(name)).then(r => r.data),
+  createGlobalValueSet: (valueSet: Partial<GlobalValueSet>) =>
+    api.post<{ data: GlobalValueSet }>(API_ENDPOINTS.METADATA.GLOBAL_VALUE_SETS, valueSet).then(r => r.data),
+  updateGlobalValueSet: (name: string, updates: Partial<GlobalValueSet>) =>
+    api.patch<{ data: GlobalValueSet }>(API_ENDPOINTS.METADATA.GLOBAL_VALUE_SET(name), updates).then(r => r.data),
+  deleteGlobalValueSet: (name: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.GLOBAL_VALUE_SET(name)),
+
   // Action operations
   getActions: (objectApiName: string) => api.get<{ data: import('../../types').ActionMetadata[] }>(API_ENDPOINTS.METADATA.ACTIONS(objectApiName)).then(r => ({ actions: r.data || [] })),
 
@@ -44,6 +284,7 @@ export const metadataAPI = {
   deleteDashboard: async (id: string) => {
     return api.delete<{ message: string }>(API_ENDPOINTS.METADATA.DASHBOARD(id));
   },
+  runDashboard: (id: string, filters?: Record<string, import('../../types').DashboardFilterValue>, refresh = false) => api.post<{ data: import('../../types').DashboardRunResult }>(`${API_ENDPOINTS.METADATA.DASHBOARD(id)}/run`, { filters, refresh }).then(r => r.data),
 
   // Validation Rules
   getValidationRules: (objectApiName: string) => api.get<{ data: import('../../types').ValidationRule[] }>(`${API_ENDPOINTS.METADATA.VALIDATION_RULES}?objectApiName=${objectApiName}`).then(r => ({ rules: r.data })),
@@ -59,6 +300,7 @@ export const metadataAPI = {
   createListView: (view: Partial<import('../../types').ListView>) => api.post<{ data: import('../../types').ListView; message: string }>(API_ENDPOINTS.METADATA.LIST_VIEWS, view).then(r => ({ view: r.data, message: r.message })),
   updateListView: (id: string, updates: Partial<import('../../types').ListView>) => api.patch<{ data: import('../../types').ListView; message: string }>(API_ENDPOINTS.METADATA.LIST_VIEW(id), updates).then(r => ({ view: r.data, message: r.message })),
   deleteListView: (id: string) => api.delete<{ message: string }>(API_ENDPOINTS.METADATA.LIST_VIEW(id)),
+  getListViewFooter: (id: string) => api.get<{ data: import('../../types').ListViewFooter }>(`${API_ENDPOINTS.METADATA.LIST_VIEW(id)}/footer`).then(r => r.data),
 
   // Theme
   getActiveTheme: () => api.get<{ data: import('../../types').Theme }>(API_ENDPOINTS.METADATA.THEMES).then(res => res.data),
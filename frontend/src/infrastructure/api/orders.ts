@@ -0,0 +1,89 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+
+export type OrderStatus = 'Draft' | 'Activated' | 'Fulfilled' | 'Cancelled';
+
+export interface Order {
+    __sys_gen_id: string;
+    name: string;
+    status: OrderStatus;
+    customer_object_api_name?: string;
+    customer_id?: string;
+    description?: string;
+    total_amount: number;
+    activated_date?: string;
+    fulfilled_date?: string;
+    cancelled_date?: string;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface OrderItem {
+    __sys_gen_id: string;
+    order_id: string;
+    product_id: string;
+    product_name?: string; // Product name when the item was saved
+    quantity: number;
+    backordered_quantity: number; // Part not covered by stock at activation
+    unit_price: number;
+    total_price: number;
+    sort_order: number;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface OrderDetail {
+    order: Order;
+    items: OrderItem[];
+}
+
+export interface OrderInput {
+    order: Pick<Order, 'name'> & Partial<Pick<Order, 'customer_object_api_name' | 'customer_id' | 'description'>>;
+    /** unit_price defaults to the product's price */
+    items: (Pick<OrderItem, 'product_id' | 'quantity'> & Partial<Pick<OrderItem, 'unit_price'>>)[];
+}
+
+export const ordersAPI = {
+    list: async (): Promise<Order[]> => {
+        const response = await apiClient.get<{ data: Order[] }>(API_ENDPOINTS.ORDERS.LIST);
+        return response.data;
+    },
+
+    get: async (id: string): Promise<OrderDetail> => {
+        const response = await apiClient.get<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.DETAIL(id));
+        return response.data;
+    },
+
+    create: async (input: OrderInput): Promise<OrderDetail> => {
+        const response = await apiClient.post<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.LIST, input);
+        return response.data;
+    },
+
+    /** Only draft orders can be edited; the items are replaced */
+    update: async (id: string, input: OrderInput): Promise<OrderDetail> => {
+        const response = await apiClient.put<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.DETAIL(id), input);
+        return response.data;
+    },
+
+    delete: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.ORDERS.DETAIL(id));
+    },
+
+    /** Decrements product stock; fails on a shortfall unless the server allows or backorders it */
+    activate: async (id: string): Promise<OrderDetail> => {
+        const response = await apiClient.post<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.ACTIVATE(id), {});
+        return response.data;
+    },
+
+    fulfill: async (id: string): Promise<OrderDetail> => {
+        const response = await apiClient.post<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.FULFILL(id), {});
+        return response.data;
+    },
+
+    /** Restores the stock an activated order took */
+    cancel: async (id: string): Promise<OrderDetail> => {
+        const response = await apiClient.post<{ data: OrderDetail }>(API_ENDPOINTS.ORDERS.CANCEL(id), {});
+        return response.data;
+    },
+};
@@ -0,0 +1,85 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+import { COMMON_FIELDS } from '../../core/constants';
+import type { SObject, UserSession } from '../../types';
+
+export type UserType = 'Standard' | 'Portal';
+
+/** An object exposed to portal users, scoped by its contact and/or account field */
+export interface PortalObject {
+    [COMMON_FIELDS.ID]: string;
+    object_api_name: string;
+    contact_field?: string;
+    account_field?: string;
+    allow_create: boolean;
+}
+
+export interface PortalIdentity {
+    user_id: string;
+    name: string;
+    email: string;
+    contact_id?: string;
+    account_id?: string;
+}
+
+export const portalAPI = {
+    login: async (credentials: { email: string; password: string }): Promise<{ token: string; user: UserSession }> => {
+        const response = await apiClient.post<{ token: string; user: UserSession }>(API_ENDPOINTS.PORTAL.LOGIN, credentials, false);
+        if (response.token) {
+            apiClient.setToken(response.token);
+        }
+        return response;
+    },
+
+    logout: async (): Promise<void> => {
+        try {
+            await apiClient.post(API_ENDPOINTS.PORTAL.LOGOUT, {});
+        } finally {
+            apiClient.setToken(null);
+        }
+    },
+
+    getMe: async (): Promise<PortalIdentity> => {
+        const response = await apiClient.get<{ data: PortalIdentity }>(API_ENDPOINTS.PORTAL.ME);
+        return response.data;
+    },
+
+    getObjects: async (): Promise<PortalObject[]> => {
+        const response = await apiClient.get<{ data: PortalObject[] }>(API_ENDPOINTS.PORTAL.OBJECTS);
+        return response.data || [];
+    },
+
+    getRecords: async (objectApiName: string, limit?: number, offset?: number): Promise<SObject[]> => {
+        const params = new URLSearchParams();
+        if (limit) params.set('limit', String(limit));
+        if (offset) params.set('offset', String(offset));
+        const query = params.toString() ? `?${params.toString()}` : '';
+        const response = await apiClient.get<{ data: SObject[] }>(`${API_ENDPOINTS.PORTAL.RECORDS(objectApiName)}${query}`);
+        return response.data || [];
+    },
+
+    getRecord: async (objectApiName: string, id: string): Promise<SObject> => {
+        const response = await apiClient.get<{ data: SObject }>(API_ENDPOINTS.PORTAL.RECORD(objectApiName, id));
+        return response.data;
+    },
+
+    createRecord: async (objectApiName: string, data: Partial<SObject>): Promise<SObject> => {
+        const response = await apiClient.post<{ data: SObject }>(API_ENDPOINTS.PORTAL.RECORDS(objectApiName), data);
+        return response.data;
+    },
+
+    // Administration (system admins)
+    getPortalObjects: async (): Promise<PortalObject[]> => {
+        const response = await apiClient.get<{ data: PortalObject[] }>(API_ENDPOINTS.METADATA.PORTAL_OBJECTS);
+        return response.data || [];
+    },
+
+    savePortalObject: async (objectApiName: string, o: Partial<PortalObject>): Promise<PortalObject> => {
+        const response = await apiClient.put<{ data: PortalObject }>(API_ENDPOINTS.METADATA.PORTAL_OBJECT(objectApiName), o);
+        return response.data;
+    },
+
+    deletePortalObject: async (objectApiName: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.METADATA.PORTAL_OBJECT(objectApiName));
+    },
+};
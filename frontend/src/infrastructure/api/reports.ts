@@ -0,0 +1,71 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+import { API_CONFIG } from '../../core/constants/EnvironmentConfig';
+import type { Report, ReportResult } from '../../types';
+
+export const reportsAPI = {
+    /**
+     * List reports, optionally for one object
+     */
+    async getReports(objectApiName?: string): Promise<Report[]> {
+        const query = objectApiName ? `?object=${encodeURIComponent(objectApiName)}` : '';
+        const response = await apiClient.get<{ data: Report[] }>(`${API_ENDPOINTS.METADATA.REPORTS}${query}`);
+        return response.data;
+    },
+
+    async getReport(id: string): Promise<Report> {
+        const response = await apiClient.get<{ data: Report }>(API_ENDPOINTS.METADATA.REPORT(id));
+        return response.data;
+    },
+
+    async createReport(report: Partial<Report>): Promise<Report> {
+        const response = await apiClient.post<{ data: Report }>(API_ENDPOINTS.METADATA.REPORTS, report);
+        return response.data;
+    },
+
+    async updateReport(id: string, updates: Partial<Report>): Promise<Report> {
+        const response = await apiClient.patch<{ data: Report }>(API_ENDPOINTS.METADATA.REPORT(id), updates);
+        return response.data;
+    },
+
+    async deleteReport(id: string): Promise<void> {
+        await apiClient.delete(API_ENDPOINTS.METADATA.REPORT(id));
+    },
+
+    /**
+     * Run a saved report
+     */
+    async runReport(id: string): Promise<ReportResult> {
+        const response = await apiClient.post<{ data: ReportResult }>(`${API_ENDPOINTS.METADATA.REPORT(id)}/run`, {});
+        return response.data;
+    },
+
+    /**
+     * Run an unsaved report definition (report builder preview)
+     */
+    async previewReport(report: Partial<Report>): Promise<ReportResult> {
+        const response = await apiClient.post<{ data: ReportResult }>(API_ENDPOINTS.METADATA.REPORT_PREVIEW, report);
+        return response.data;
+    },
+
+    /**
+     * Download a saved report as CSV
+     */
+    async exportReport(id: string): Promise<Blob> {
+        const token = apiClient.getToken();
+        const headers: Record<string, string> = {};
+        if (token) {
+            headers['Authorization'] = `Bearer ${token}`;
+        }
+
+        const response = await fetch(`${API_CONFIG.BACKEND_URL}${API_ENDPOINTS.METADATA.REPORT(id)}/export`, {
+            headers,
+            credentials: 'include'
+        });
+        if (!response.ok) {
+            const errorData = await response.json().catch(() => ({ message: 'Export failed' }));
+            throw new Error(errorData.message || `Export failed with status ${response.status}`);
+        }
+        return response.blob();
+    }
+};
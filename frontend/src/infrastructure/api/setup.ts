@@ -0,0 +1,46 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+import type { SystemOrganization } from '../../generated-schema';
+import type { UserSession } from '../../types';
+
+export type SetupStatus = 'pending' | 'in_progress' | 'completed';
+
+export interface SetupState {
+    status: SetupStatus;
+    required: boolean; // The setup form may be submitted
+    token_required: boolean; // The server expects SETUP_TOKEN with the form
+    org_name?: string;
+    default_locale?: string;
+}
+
+export interface SetupRequest {
+    org_name: string;
+    admin: {
+        name: string;
+        email: string;
+        password: string;
+    };
+    locale?: string; // Org default locale, also the admin's own
+    sample_data?: boolean;
+    setup_token?: string;
+}
+
+export interface SetupResult {
+    organization: SystemOrganization;
+    admin: UserSession;
+    sample_data_loaded: boolean;
+}
+
+/** First-run setup; both calls work without a session and only while no user exists */
+export const setupAPI = {
+    getState: async (): Promise<SetupState> => {
+        const response = await apiClient.get<{ data: SetupState }>(API_ENDPOINTS.SETUP.STATE, false);
+        return response.data;
+    },
+
+    /** Creates the organization and the first administrator, who then signs in normally */
+    complete: async (request: SetupRequest): Promise<SetupResult> => {
+        const response = await apiClient.post<{ data: SetupResult }>(API_ENDPOINTS.SETUP.COMPLETE, request, false);
+        return response.data;
+    },
+};
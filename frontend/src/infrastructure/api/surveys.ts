@@ -0,0 +1,195 @@
+import { apiClient } from './client';
+import { API_ENDPOINTS } from './endpoints';
+
+export type SurveyScoreType = 'CSAT' | 'NPS'; // CSAT scores 1-5, NPS scores 0-10
+
+export type SurveyQuestionType = 'text' | 'choice' | 'rating'; // Ratings are 1-5
+
+export interface SurveyQuestion {
+    key: string;
+    label: string;
+    type: SurveyQuestionType;
+    required?: boolean;
+    options?: string[]; // Choices of a choice question
+}
+
+export interface Survey {
+    __sys_gen_id: string;
+    name: string;
+    description?: string;
+    score_type: SurveyScoreType;
+    question: string; // The scored question
+    questions?: SurveyQuestion[]; // Follow-up questions
+    is_active: boolean;
+    __sys_gen_owner_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export type SurveyInput = Pick<Survey, 'name' | 'question' | 'is_active'> &
+    Partial<Pick<Survey, 'description' | 'score_type' | 'questions' | '__sys_gen_owner_id'>>;
+
+export interface SurveyInvitation {
+    __sys_gen_id: string;
+    survey_id: string;
+    object_api_name?: string;
+    record_id?: string;
+    contact_id?: string;
+    expires_date?: string;
+    responded_date?: string;
+    created_by_id: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface SurveyInvitationInput {
+    object_api_name?: string;
+    record_id?: string;
+    contact_id?: string;
+}
+
+export interface SurveyInvitationLink {
+    invitation: SurveyInvitation;
+    token: string; // Only returned when the invitation is created
+    url: string;
+}
+
+export interface SurveyResponse {
+    __sys_gen_id: string;
+    survey_id: string;
+    invitation_id: string;
+    object_api_name?: string;
+    record_id?: string;
+    contact_id?: string;
+    score: number;
+    answers?: Record<string, string | number>;
+    comment?: string;
+    submitted_date: string;
+    __sys_gen_created_date: string;
+    __sys_gen_last_modified_date: string;
+}
+
+export interface PublicSurvey {
+    name: string;
+    description?: string;
+    score_type: SurveyScoreType;
+    question: string;
+    score_min: number;
+    score_max: number;
+    questions: SurveyQuestion[];
+    expires_date?: string;
+}
+
+export interface SurveySubmission {
+    score: number;
+    answers?: Record<string, string | number>; // By question key
+    comment?: string;
+}
+
+export interface SurveyScoreSummary {
+    responses: number;
+    average_score: number;
+    csat?: number; // % of CSAT responses scoring 4 or 5
+    nps?: number; // % promoters (9-10) minus % detractors (0-6)
+    promoters?: number;
+    passives?: number;
+    detractors?: number;
+}
+
+export type SurveyInterval = 'day' | 'week' | 'month';
+
+export interface SurveyAnalytics extends SurveyScoreSummary {
+    survey_id: string;
+    score_type: SurveyScoreType;
+    interval: SurveyInterval;
+    distribution: { score: number; count: number }[];
+    series: (SurveyScoreSummary & { period: string })[]; // period is the first day, YYYY-MM-DD
+}
+
+export interface SurveyAnalyticsQuery {
+    from?: string; // Date or RFC 3339 timestamp
+    to?: string; // Exclusive
+    interval?: SurveyInterval;
+    objectApiName?: string;
+}
+
+export const surveysAPI = {
+    listSurveys: async (activeOnly = false): Promise<Survey[]> => {
+        const response = await apiClient.get<{ data: Survey[] }>(`${API_ENDPOINTS.SURVEYS.LIST}${activeOnly ? '?active=true' : ''}`);
+        return response.data;
+    },
+
+    getSurvey: async (id: string): Promise<Survey> => {
+        const response = await apiClient.get<{ data: Survey }>(API_ENDPOINTS.SURVEYS.DETAIL(id));
+        return response.data;
+    },
+
+    createSurvey: async (survey: SurveyInput): Promise<Survey> => {
+        const response = await apiClient.post<{ data: Survey }>(API_ENDPOINTS.SURVEYS.LIST, survey);
+        return response.data;
+    },
+
+    updateSurvey: async (id: string, survey: SurveyInput): Promise<Survey> => {
+        const response = await apiClient.put<{ data: Survey }>(API_ENDPOINTS.SURVEYS.DETAIL(id), survey);
+        return response.data;
+    },
+
+    /** Fails once the survey has responses; deactivate it instead */
+    deleteSurvey: async (id: string): Promise<void> => {
+        await apiClient.delete(API_ENDPOINTS.SURVEYS.DETAIL(id));
+    },
+
+    listInvitations: async (surveyId: string): Promise<SurveyInvitation[]> => {
+        const response = await apiClient.get<{ data: SurveyInvitation[] }>(API_ENDPOINTS.SURVEYS.INVITATIONS(surveyId));
+        return response.data;
+    },
+
+    /** Creates a single-use link about a record such as a case and/or a contact */
+    createInvitation: async (surveyId: string, input: SurveyInvitationInput): Promise<SurveyInvitationLink> => {
+        const response = await apiClient.post<{ data: SurveyInvitationLink }>(API_ENDPOINTS.SURVEYS.INVITATIONS(surveyId), input);
+        return response.data;
+    },
+
+    listResponses: async (surveyId: string, limit?: number, offset?: number): Promise<SurveyResponse[]> => {
+        const params = new URLSearchParams();
+        if (limit) params.set('limit', String(limit));
+        if (offset) params.set('offset', String(offset));
+        const query = params.toString();
+        const response = await apiClient.get<{ data: SurveyResponse[] }>(
+            `${API_ENDPOINTS.SURVEYS.RESPONSES(surveyId)}${query ? `?${query}` : ''}`
+        );
+        return response.data;
+    },
+
+    /** Responses about a record; for contacts, all responses of the contact */
+    listRecordResponses: async (objectApiName: string, recordId: string): Promise<SurveyResponse[]> => {
+        const response = await apiClient.get<{ data: SurveyResponse[] }>(
+            `${API_ENDPOINTS.SURVEYS.RECORD_RESPONSES}?object_api_name=${encodeURIComponent(objectApiName)}&record_id=${encodeURIComponent(recordId)}`
+        );
+        return response.data;
+    },
+
+    getAnalytics: async (surveyId: string, query: SurveyAnalyticsQuery = {}): Promise<SurveyAnalytics> => {
+        const params = new URLSearchParams();
+        if (query.from) params.set('from', query.from);
+        if (query.to) params.set('to', query.to);
+        if (query.interval) params.set('interval', query.interval);
+        if (query.objectApiName) params.set('object_api_name', query.objectApiName);
+        const qs = params.toString();
+        const response = await apiClient.get<{ data: SurveyAnalytics }>(
+            `${API_ENDPOINTS.SURVEYS.ANALYTICS(surveyId)}${qs ? `?${qs}` : ''}`
+        );
+        return response.data;
+    },
+
+    /** Public: the survey behind a link, for the respondent */
+    getPublicSurvey: async (token: string): Promise<PublicSurvey> => {
+        const response = await apiClient.get<{ data: PublicSurvey }>(API_ENDPOINTS.SURVEYS.PUBLIC(token));
+        return response.data;
+    },
+
+    /** Public: a link takes one response */
+    submitResponse: async (token: string, submission: SurveySubmission): Promise<void> => {
+        await apiClient.post(API_ENDPOINTS.SURVEYS.PUBLIC(token), submission);
+    },
+};
@@ -1,7 +1,7 @@
 import { api } from './client';
 import { API_ENDPOINTS } from './endpoints';
 import { COMMON_FIELDS } from '../../core/constants';
-import type { User, Profile, ObjectPermission, FieldPermission } from '../../types';
+import type { User, Profile, ObjectPermission, FieldPermission, ProfileQueryLimits, QueryLimitsOverride } from '../../types';
 
 export interface CreateUserPayload {
     [COMMON_FIELDS.NAME]: string;
@@ -36,6 +36,13 @@ export const usersAPI = {
     getProfileFieldPermissions: (profileId: string) => api.get<{ data: FieldPermission[] }>(API_ENDPOINTS.AUTH.PROFILE_FIELD_PERMISSIONS(profileId)),
     updateProfileFieldPermissions: (profileId: string, permissions: FieldPermission[]) => api.put<{ message: string }>(API_ENDPOINTS.AUTH.PROFILE_FIELD_PERMISSIONS(profileId), permissions),
 
+    // Query governor limits (omitted limits keep the platform default; 0 lifts a limit)
+    getProfileQueryLimits: (profileId: string) =>
+        api.get<{ data: ProfileQueryLimits }>(API_ENDPOINTS.AUTH.PROFILE_QUERY_LIMITS(profileId)).then(r => r.data),
+    updateProfileQueryLimits: (profileId: string, limits: QueryLimitsOverride) =>
+        api.put<{ data: ProfileQueryLimits }>(API_ENDPOINTS.AUTH.PROFILE_QUERY_LIMITS(profileId), limits).then(r => r.data),
+    resetProfileQueryLimits: (profileId: string) => api.delete<{ message: string }>(API_ENDPOINTS.AUTH.PROFILE_QUERY_LIMITS(profileId)),
+
     // Permission Set permission operations
     getPermissionSetPermissions: (permSetId: string) => api.get<{ data: ObjectPermission[] }>(API_ENDPOINTS.AUTH.PERM_SET_PERMISSIONS(permSetId)),
     updatePermissionSetPermissions: (permSetId: string, permissions: ObjectPermission[]) => api.put<{ message: string }>(API_ENDPOINTS.AUTH.PERM_SET_PERMISSIONS(permSetId), permissions),
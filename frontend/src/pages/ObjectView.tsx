@@ -1,6 +1,6 @@
 import React, { useState, useEffect } from 'react';
 import { useParams, useSearchParams, useNavigate } from 'react-router-dom';
-import { useObjectMetadata, useLayout } from '../core/hooks/useMetadata';
+import { useObjectMetadata, useLayout, useRecordPageDescribe } from '../core/hooks/useMetadata';
 import { MetadataRecordList } from '../components/MetadataRecordList';
 import { MetadataRecordDetail } from '../components/MetadataRecordDetail';
 import { MetadataRecordForm } from '../components/MetadataRecordForm';
@@ -33,8 +33,17 @@ export const ObjectView: React.FC = () => {
         }
     }, [searchParams]);
 
+    // The detail view loads schema, layout and actions with one describe-full request;
+    // list and form views fetch the schema and layout separately
+    const isCreate = recordId === 'new';
+    const describeDetail = !!recordId && !isCreate && !isEditing;
+    const { describe, loading: describeLoading, error: describeError } = useRecordPageDescribe(describeDetail ? objectApiName || '' : '', recordId);
+
     // Fetch Metadata
-    const { metadata, loading: metaLoading, error: metaError } = useObjectMetadata(objectApiName || '');
+    const objectMetadata = useObjectMetadata(describeDetail ? '' : objectApiName || '');
+    const metadata = describeDetail ? describe?.schema ?? null : objectMetadata.metadata;
+    const metaLoading = describeDetail ? describeLoading || (!describe && !describeError) : objectMetadata.loading;
+    const metaError = describeDetail ? describeError : objectMetadata.error;
 
     // Fetch Record Data for Edit / Detail
     const [recordData, setRecordData] = useState<Record<string, unknown> | null>(null);
@@ -58,9 +67,9 @@ export const ObjectView: React.FC = () => {
     }, [objectApiName, recordId, isEditing]);
 
     // Fetch Layout
-    const isCreate = recordId === 'new';
     const mode = isCreate ? 'Create' : (isEditing ? 'Edit' : 'Detail');
-    const { layout } = useLayout(objectApiName || '', mode);
+    const formLayout = useLayout(describeDetail ? '' : objectApiName || '', mode);
+    const layout = describeDetail ? describe?.layout ?? null : formLayout.layout;
 
     if (metaLoading) return (
         <div className="max-w-7xl mx-auto p-6">
@@ -157,6 +166,7 @@ export const ObjectView: React.FC = () => {
                     objectMetadata={metadata}
                     recordId={recordId || ''}
                     layout={layout}
+                    actions={describe?.actions}
                     onBack={() => navigate(ROUTES.OBJECT.LIST(objectApiName || ''))}
                 />
             )}
@@ -10,7 +10,7 @@ import { formatCurrency, formatDate, formatDateTime } from '../core/utils/format
 import { SearchableLookup } from '../components/SearchableLookup';
 import { UI_DEFAULTS } from '../core/constants';
 import { dataAPI } from '../infrastructure/api/data';
-import { COMMON_FIELDS } from '../core/constants/CommonFields';
+import { COMMON_FIELDS, LOOKUP_NAME_SUFFIX } from '../core/constants/CommonFields';
 
 // --- Prop Type Definitions ---
 
@@ -101,8 +101,8 @@ const UrlRenderer: React.FC<FieldRendererProps> = ({ value, variant, field }) =>
 
 const LookupRenderer: React.FC<FieldRendererProps> = ({ field, value, onNavigate, record }) => {
     // Always try to use the resolved name if available
-    const displayLabel = (record && record[`${field.api_name}_Name`])
-        ? String(record[`${field.api_name}_Name`])
+    const displayLabel = (record && record[`${field.api_name}${LOOKUP_NAME_SUFFIX}`])
+        ? String(record[`${field.api_name}${LOOKUP_NAME_SUFFIX}`])
         : String(value);
 
     // If we have navigation capability and reference_to, make it clickable
@@ -42,9 +42,14 @@ export interface FieldMetadata {
   description?: string;
   required?: boolean;
   unique?: boolean; // Data Integrity: Enforce Uniqueness
+  is_indexed?: boolean; // Performance: Backed by a secondary index for filtering and sorting
   is_name_field?: boolean; // Display Identity: Used as the primary record label (replaces hardcoded 'Name')
   options?: string[]; // For Picklists
+  inactive_options?: string[]; // Retired picklist values kept on existing records
+  option_labels?: Record<string, string>; // Translated picklist value labels in the user's locale
+  value_set?: string; // Global value set supplying the picklist values
   reference_to?: string[]; // For Lookups. Array of object names.
+  lookup_filter?: string; // For Lookups. Formula limiting candidates; $source.<field> reads the edited record
   is_polymorphic?: boolean; // If true, can reference multiple object types.
   delete_rule?: 'Restrict' | 'Cascade' | 'SetNull'; // Referential Integrity
   is_system?: boolean;
@@ -90,6 +95,7 @@ export interface ObjectMetadata {
   description?: string;
   is_system?: boolean; // Added is_system property
   is_custom?: boolean; // Added is_custom property
+  is_external?: boolean; // Read-only object backed by an external data source
   theme_color?: string; // Visual Identity: e.g. 'blue', 'orange', '#FF5733'
   sharing_model: SharingModel; // Security: OWD
   enable_hierarchy_sharing?: boolean; // Security: Grant Access Using Hierarchies
@@ -100,6 +106,7 @@ export interface ObjectMetadata {
   list_fields?: string[]; // Fields to display in List View (Default fallback)
   searchable?: boolean; // Can be searched via Global Search
   path_field?: string; // Field to use for Path component (must be Picklist)
+  last_modified_date?: string; // Latest change to the object or one of its fields
 }
 
 // --- Permissions & Security ---
@@ -164,6 +171,8 @@ export interface UserSession {
   email: string;
   profile_id: string;
   role_id?: string;
+  user_type?: 'Standard' | 'Portal';
+  locale?: string;
 }
 
 export interface User extends SystemUser {
@@ -202,6 +211,7 @@ export interface ActionMetadata {
   icon: string;
   target_object?: string;
   config?: Record<string, unknown>;
+  last_modified_date?: string;
 }
 
 export interface PageSection {
@@ -221,6 +231,7 @@ export interface RelatedListConfig {
   object_api_name: string;
   lookup_field: string;
   fields: string[];
+  visibility_condition?: string; // Formula string
 }
 
 export type LayoutType = 'Detail' | 'Edit' | 'Create' | 'List';
@@ -239,6 +250,612 @@ export interface PageLayout {
   quick_actions: ActionConfig[];
 }
 
+export interface RecordType {
+  [COMMON_FIELDS.ID]: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]: string;
+  name: string;
+  label?: string;
+  description?: string;
+  is_active: boolean;
+  is_default: boolean; // Object-wide default when the profile has none
+  picklist_values?: Record<string, string[]>; // Picklist field -> allowed values for this record type
+}
+
+export interface ProfileRecordType {
+  [COMMON_FIELDS.ID]: string;
+  [COMMON_FIELDS.PROFILE_ID]: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]: string;
+  record_type_id: string;
+  is_default: boolean;
+  layout_id?: string; // Page layout for records of this type
+}
+
+export interface AvailableRecordTypes {
+  record_types: RecordType[];
+  default_record_type_id: string | null;
+}
+
+// Picklist field whose values are states: records move between them only along transitions
+export interface StateMachine {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  description?: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]: string;
+  field_api_name: string;
+  initial_states?: string[]; // States new records may start in; empty allows any
+  states: StateMachineState[];
+  transitions: StateMachineTransition[];
+  is_active: boolean;
+}
+
+export interface StateMachineState {
+  value: string;
+  entry_actions?: string[]; // Action IDs run after a record enters the state
+  exit_actions?: string[]; // Action IDs run after a record leaves the state
+}
+
+export interface StateMachineTransition {
+  from: string; // '*' allows the move from any state
+  to: string;
+  required_fields?: string[];
+  profile_ids?: string[]; // Empty allows every user
+}
+
+export interface PicklistValue {
+  value: string;
+  label?: string; // Translated label in the user's locale
+  active: boolean;
+}
+
+// Everything a record page needs, returned by one describe-full request
+export interface RecordPageDescribe {
+  schema: ObjectMetadata;
+  record_type: RecordType | null; // Record type the layout and picklist values were resolved for
+  record_types: RecordType[]; // Record types available to the user
+  layout: PageLayout; // Visibility conditions already evaluated
+  compact_layout: string[];
+  actions: ActionMetadata[];
+  related_lists: RelatedListConfig[];
+  picklist_values: Record<string, PicklistValue[]>; // Narrowed to the record type's allowed values
+}
+
+export interface GlobalValueSet {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  description?: string;
+  options: string[];
+  inactive_options?: string[];
+  is_standard: boolean;
+  used_by?: string[]; // "object.field" entries, only returned when fetching a single set
+}
+
+export interface AutoNumber {
+  [COMMON_FIELDS.ID]: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]: string;
+  field_api_name: string;
+  display_format: string; // e.g. "INV-{0000}"
+  starting_number: number;
+  current_value: number;
+  gap_free: boolean; // Numbers are reserved in the record transaction, so rollbacks leave no gaps
+}
+
+export type CustomMetadataFieldType = 'Text' | 'Number' | 'Currency' | 'Percent' | 'Boolean' | 'Date';
+
+export interface CustomMetadataField {
+  api_name: string;
+  label: string;
+  type: CustomMetadataFieldType;
+  required?: boolean;
+}
+
+// Admin-defined config type; formulas read its records as cmdt.<Type>.<Record>.<field>
+export interface CustomMetadataType {
+  [COMMON_FIELDS.ID]: string;
+  api_name: string;
+  label: string;
+  description?: string;
+  fields: CustomMetadataField[];
+}
+
+export interface CustomMetadataRecord {
+  [COMMON_FIELDS.ID]: string;
+  type_api_name: string;
+  developer_name: string;
+  label: string;
+  values: Record<string, string | number | boolean | null>;
+}
+
+export type CustomSettingValueType = string | number | boolean | null;
+
+// Setting resolved user override → profile override → org default; formulas read $Setting.<name>
+export interface CustomSetting {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  description?: string;
+  type: CustomMetadataFieldType;
+  default_value: CustomSettingValueType;
+}
+
+export type CustomSettingScope = 'profile' | 'user';
+
+export interface CustomSettingOverride {
+  [COMMON_FIELDS.ID]: string;
+  setting_name: string;
+  scope_type: CustomSettingScope;
+  scope_id: string;
+  value: CustomSettingValueType;
+}
+
+export type NamedCredentialAuthType = 'None' | 'Basic' | 'Bearer' | 'ApiKey';
+
+// External endpoint for callouts; the secret is write-only and never returned
+export interface NamedCredential {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  base_url: string;
+  auth_type: NamedCredentialAuthType;
+  username?: string;
+  auth_header?: string; // Header carrying the key for ApiKey auth
+  secret?: string;
+  has_secret: boolean;
+  timeout_seconds: number;
+  max_retries: number;
+}
+
+export interface CalloutRequest {
+  method?: string;
+  path?: string;
+  headers?: Record<string, string>;
+  body?: unknown;
+}
+
+export interface CalloutResponse {
+  status_code: number;
+  body: unknown;
+  attempts: number;
+}
+
+export type ExternalAdapterType = 'REST' | 'OData' | 'SQL';
+
+// Where an external object's records live; the DSN of SQL sources is write-only
+export interface ExternalDataSource {
+  [COMMON_FIELDS.ID]: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]: string;
+  adapter: ExternalAdapterType;
+  named_credential?: string; // REST and OData
+  resource: string; // REST path, OData entity set or SQL table
+  records_path?: string; // Dotted path to the record array in REST responses
+  id_field: string;
+  field_map?: Record<string, string>; // Local field → remote field
+  dsn?: string;
+  has_dsn: boolean;
+  max_rows: number;
+}
+
+export interface ExternalObject {
+  object: ObjectMetadata;
+  source: ExternalDataSource;
+}
+
+export interface BusinessHoursWindow {
+  start: string; // HH:MM in the business hours' time zone
+  end: string; // Up to 24:00
+}
+
+export interface Holiday {
+  [COMMON_FIELDS.ID]: string;
+  business_hours_id: string;
+  name: string;
+  holiday_date: string; // YYYY-MM-DD
+  is_recurring: boolean; // Repeats on the same month and day every year
+}
+
+// Weekly working schedule; weekdays without a window are non-working days
+export interface BusinessHours {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  timezone: string;
+  is_default: boolean;
+  schedule: Record<string, BusinessHoursWindow>; // Lowercase weekday name → window
+  holidays: Holiday[];
+}
+
+export interface SLAMilestone {
+  name: string;
+  priority?: string; // Empty applies to every priority
+  target_minutes: number; // Business minutes
+  completion_field?: string; // Met once set; empty is met on a closed status
+  escalation_flow_id?: string; // Run on the record when the milestone is violated
+}
+
+export interface SLAPolicy {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  object_api_name: string;
+  priority_field?: string;
+  status_field: string;
+  paused_statuses: string[];
+  closed_statuses: string[];
+  business_hours?: string; // Empty uses the default business hours, or 24x7
+  milestones: SLAMilestone[];
+  is_active: boolean;
+}
+
+export type SLATimerStatus = 'Running' | 'Paused' | 'Completed';
+
+export interface SLATimer {
+  [COMMON_FIELDS.ID]: string;
+  policy_name: string;
+  object_api_name: string;
+  record_id: string;
+  milestone: string;
+  target_minutes: number;
+  status: SLATimerStatus;
+  elapsed_seconds: number; // Business time, including the running interval up to the request
+  running_since?: string;
+  due_date?: string;
+  started_date: string;
+  completed_date?: string;
+  violated: boolean;
+  escalated_date?: string;
+}
+
+export interface StageHistoryEntry {
+  [COMMON_FIELDS.ID]: string;
+  object_api_name: string;
+  record_id: string;
+  field_api_name: string;
+  value: string;
+  previous_value?: string;
+  entered_date: string;
+  exited_date?: string; // Unset while the record is still in the value
+  duration_seconds?: number;
+  changed_by_id?: string;
+}
+
+export interface StageMetrics {
+  value: string;
+  entries: number;
+  records: number;
+  reached: number; // Records that entered this value or a later one
+  current: number;
+  exited: number;
+  avg_duration_seconds: number;
+  max_duration_seconds: number;
+  conversion_rate?: number; // Share of reached records that reached the next value
+}
+
+export interface StageTransition {
+  from: string;
+  to: string;
+  count: number;
+}
+
+export interface StageAnalytics {
+  object_api_name: string;
+  field_api_name: string;
+  from?: string;
+  to?: string;
+  stages: StageMetrics[];
+  transitions: StageTransition[];
+}
+
+export type TimelineEntryType = 'comment' | 'field_change' | 'task' | 'event' | 'email' | 'call' | 'approval' | 'flow';
+
+export interface TimelineEntry {
+  id: string; // Unique within the entry type
+  type: TimelineEntryType;
+  date: string;
+  title: string;
+  body?: string;
+  actor_id?: string;
+  details?: Record<string, unknown>;
+}
+
+export interface TimelinePage {
+  entries: TimelineEntry[];
+  next_cursor?: string; // Unset on the last page
+}
+
+export interface EscalationAction {
+  after_minutes: number; // Business time the record has matched the rule
+  reassign_to?: string; // User or queue made the owner
+  notify_owner: boolean;
+  notify_user_ids?: string[];
+  flow_id?: string;
+}
+
+export type TranslationComponentType =
+  | 'Object'
+  | 'ObjectPlural'
+  | 'Field'
+  | 'FieldHelp'
+  | 'PicklistValue'
+  | 'App'
+  | 'AppNavigation'
+  | 'ValidationRule';
+
+export interface Translation {
+  locale?: string;
+  component_type: TranslationComponentType;
+  component_key: string; // e.g. "account.industry" for a field label
+  source?: string; // Untranslated text
+  translation: string; // Empty when untranslated; saving an empty translation removes it
+}
+
+export interface TranslationLocale {
+  locale: string;
+  translated: number;
+}
+
+export interface TranslationFile {
+  locale: string;
+  translations: Translation[];
+}
+
+export type DependencyComponentType =
+  | 'FormulaField'
+  | 'RollupField'
+  | 'LookupField'
+  | 'DependentPicklist'
+  | 'ValidationRule'
+  | 'Flow'
+  | 'Layout'
+  | 'ListView'
+  | 'Dashboard';
+
+export interface MetadataDependency {
+  component_type: DependencyComponentType;
+  component_id: string;
+  component_name: string;
+  object_api_name?: string;
+  usage: string; // e.g. "formula" or "section Details"
+  blocking: boolean; // Blocking dependencies prevent deletion; the others only lose the reference
+}
+
+export interface DependencyReport {
+  type: 'object' | 'field';
+  object_api_name: string;
+  field_api_name?: string;
+  dependencies: MetadataDependency[];
+  can_delete: boolean;
+}
+
+export type SchemaDriftKind = 'MissingTable' | 'MissingColumn' | 'MissingIndex' | 'UnexpectedColumn';
+
+export interface SchemaDriftIssue {
+  kind: SchemaDriftKind;
+  severity: 'error' | 'warning';
+  table: string;
+  column?: string;
+  index?: string;
+  description: string;
+  repairable: boolean;
+}
+
+export interface SchemaDriftReport {
+  checked_at: string;
+  healthy: boolean;
+  issues: SchemaDriftIssue[];
+  repaired?: SchemaDriftIssue[];
+  repair_errors?: string[];
+}
+
+export interface IndexSuggestion {
+  object_api_name: string;
+  field_api_name: string;
+  field_label: string;
+  slow_queries: number;
+  avg_duration_ms: number;
+  max_duration_ms: number;
+  last_seen: string;
+}
+
+export interface IndexAdvisorReport {
+  threshold_ms: number;
+  captured_queries: number;
+  suggestions: IndexSuggestion[];
+}
+
+// Read replica serving analytics, reports and dashboards
+export interface ReadReplicaStatus {
+  configured: boolean;
+  healthy: boolean;
+  lag_ms?: number;
+  max_staleness_ms: number;
+  checked_at?: string;
+  last_error?: string;
+}
+
+// Query governor limits; 0 means unlimited
+export interface QueryLimits {
+  max_rows: number;
+  max_execution_ms: number;
+  max_concurrent_queries: number;
+}
+
+export type QueryLimitsOverride = Partial<QueryLimits>;
+
+export interface ProfileQueryLimits {
+  profile_id: string;
+  defaults: QueryLimits;
+  override?: QueryLimitsOverride & { profile_id: string };
+  effective: QueryLimits;
+}
+
+export interface EscalationRule {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  label: string;
+  object_api_name: string;
+  criteria?: string; // Formula filter; empty monitors every record
+  start_field: string; // Defaults to the created date
+  business_hours?: string; // Empty uses the default business hours, or 24x7
+  actions: EscalationAction[]; // One per level, ordered by after_minutes
+  is_active: boolean;
+}
+
+// Starlark script run as a record trigger or RunScript flow action; unset quotas use the defaults
+export interface Script {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  description?: string;
+  source: string;
+  trigger_object?: string; // Empty for scripts only run by flows
+  trigger_events?: string[]; // e.g. beforeCreate, afterUpdate
+  is_active: boolean;
+  max_steps?: number;
+  timeout_ms?: number;
+  max_memory_mb?: number;
+  max_dml_rows?: number;
+  max_query_rows?: number;
+  max_callouts?: number;
+}
+
+export type ScriptRunStatus = 'Success' | 'Failed' | 'LimitExceeded';
+
+export interface ScriptLog {
+  [COMMON_FIELDS.ID]: string;
+  script_id: string;
+  script_name: string;
+  invocation: string; // Trigger event, 'flow' or 'manual'
+  object_api_name?: string;
+  record_id?: string;
+  status: ScriptRunStatus;
+  duration_ms: number;
+  steps: number;
+  dml_rows: number;
+  query_rows: number;
+  callouts: number;
+  output?: string;
+  error_message?: string;
+  user_id?: string;
+  [COMMON_FIELDS.CREATED_DATE]: string;
+}
+
+export interface ScriptRunRequest {
+  object_api_name?: string;
+  record_id?: string;
+  params?: Record<string, unknown>;
+  rollback?: boolean; // Undo the script's writes (callouts are still made)
+}
+
+export interface ScriptRunResult {
+  log: ScriptLog;
+  changes?: Record<string, unknown>; // Fields of the record the script changed (not saved)
+  result?: unknown;
+}
+
+export type CustomEndpointAction = 'script' | 'query' | 'insert' | 'update' | 'delete';
+
+export interface CustomEndpointParam {
+  type: 'string' | 'number' | 'integer' | 'boolean' | 'object' | 'array';
+  required?: boolean;
+  description?: string;
+  default?: unknown;
+  enum?: unknown[];
+  min?: number;
+  max?: number;
+  max_length?: number;
+  pattern?: string; // Regular expression the whole value must match
+}
+
+// Admin-defined REST endpoint served at /api/custom/:name with the caller's access.
+// Templates reference the validated input as $input.<param>.
+export interface CustomEndpoint {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  description?: string;
+  http_method: 'GET' | 'POST' | 'PUT' | 'PATCH' | 'DELETE'; // GET and DELETE take their input from the query string
+  action: CustomEndpointAction;
+  script_name?: string;
+  object_api_name?: string;
+  filter_expr?: string;
+  sort_field?: string;
+  sort_direction?: 'ASC' | 'DESC';
+  row_limit?: number;
+  record_id?: string; // e.g. $input.id
+  field_values?: Record<string, unknown>;
+  input_schema?: Record<string, CustomEndpointParam>;
+  profile_ids?: string[]; // Empty allows every user
+  is_active: boolean;
+}
+
+export type AsyncJobStatus = 'queued' | 'running' | 'completed' | 'failed' | 'aborted';
+
+export interface AsyncJob {
+  [COMMON_FIELDS.ID]: string;
+  job_type: string;
+  [COMMON_FIELDS.OBJECT_API_NAME]?: string;
+  status: AsyncJobStatus;
+  parameters?: Record<string, unknown>;
+  total_count: number;
+  processed_count: number;
+  failed_count: number;
+  error_message?: string;
+  started_date?: string;
+  completed_date?: string;
+}
+
+export type BatchProcessor = 'script' | 'mass_update' | 'delete';
+
+// Bulk processing of the records matching a scope filter, read in chunks and handed to a
+// script or a built-in action. Each run is an async job.
+export interface BatchJob {
+  [COMMON_FIELDS.ID]: string;
+  name: string;
+  description?: string;
+  object_api_name: string;
+  scope_filter?: string; // Empty processes every record
+  processor: BatchProcessor;
+  script_name?: string; // Script processor; receives each chunk as records
+  field_values?: Record<string, unknown>; // Mass update processor
+  chunk_size?: number; // Defaults to 200, at most 2000
+  concurrency?: number; // Chunks processed at a time; defaults to 1, at most 10
+  schedule?: string; // Cron expression
+  schedule_timezone?: string; // IANA time zone; defaults to UTC
+  next_run_at?: string;
+  last_run_at?: string;
+  last_job_id?: string;
+  last_job?: AsyncJob;
+  is_active: boolean;
+}
+
+// Options shared by mass transfer and mass update jobs
+export interface MassOperationOptions {
+  batch_size?: number; // Records saved per batch; defaults to 200, at most 1000
+  suppress_flows?: boolean; // Skip record-triggered flows for the updated records
+}
+
+export interface MassTransferRequest extends MassOperationOptions {
+  object_api_name: string;
+  filter_expr?: string;
+  from_owner_id?: string;
+  to_owner_id: string; // User or queue
+  keep_manual_shares?: boolean;
+}
+
+export interface MassUpdateRequest extends MassOperationOptions {
+  object_api_name: string;
+  filter_expr?: string;
+  values: Record<string, unknown>;
+}
+
+// Sizes a generated demo dataset; counts left out take their defaults
+export interface SampleDataRequest {
+  accounts?: number;
+  contacts_per_account?: number;
+  opportunities_per_account?: number;
+  tasks_per_record?: number; // Tasks on each account and opportunity
+  seed?: number; // The same seed generates the same values
+  batch_size?: number;
+}
+
 export interface ListView {
   [COMMON_FIELDS.ID]: string;
   id?: string; // Alias for [COMMON_FIELDS.ID]
@@ -246,6 +863,105 @@ export interface ListView {
   [COMMON_FIELDS.LABEL]: string;
   filter_expr?: string;
   fields?: string[]; // Columns to display
+  visibility?: 'private' | 'shared' | 'profile';
+  owner_id?: string;
+  profile_ids?: string[];
+  columns?: ListViewColumn[];
+  sort_field?: string;
+  sort_direction?: 'ASC' | 'DESC';
+  aggregates?: ListViewAggregate[];
+}
+
+export interface ListViewColumn {
+  field: string;
+  width?: number;
+  pinned?: 'left' | 'right';
+}
+
+export interface ListViewAggregate {
+  field: string;
+  function: 'COUNT' | 'SUM' | 'AVG' | 'MIN' | 'MAX';
+}
+
+export interface ListViewFooter {
+  list_view_id: string;
+  values: Record<string, unknown>; // Keyed by "<function>_<field>", e.g. "sum_amount"
+}
+
+export interface KanbanRequest {
+  group_by: string;
+  filter_expr?: string;
+  sort_field?: string;
+  sort_direction?: 'ASC' | 'DESC';
+  per_column_limit?: number;
+  aggregates?: ListViewAggregate[];
+}
+
+export interface KanbanColumn {
+  value: string; // "" holds records with no value
+  records: SObject[];
+  total_count: number;
+  aggregates?: Record<string, unknown>;
+}
+
+export interface KanbanResult {
+  object_api_name: string;
+  group_by: string;
+  columns: KanbanColumn[];
+}
+
+export interface KanbanMoveRequest {
+  record_id: string;
+  group_by: string;
+  from_value?: string;
+  to_value: string;
+}
+
+export interface CalendarRequest {
+  date_field: string;
+  end_date_field?: string;
+  start: string; // YYYY-MM-DD
+  end: string; // YYYY-MM-DD, inclusive
+  filter_expr?: string;
+  limit?: number;
+}
+
+export interface CalendarDay {
+  date: string;
+  records: SObject[];
+}
+
+export interface CalendarResult {
+  object_api_name: string;
+  date_field: string;
+  start: string;
+  end: string;
+  days: CalendarDay[];
+  truncated: boolean;
+}
+
+export interface FieldChange {
+  field: string;
+  old_value: unknown;
+  new_value: unknown;
+}
+
+// Outcome of a dry-run update: the record as it would be saved and what would change
+export interface UpdatePreview {
+  record: SObject;
+  changes: FieldChange[];
+}
+
+export interface LookupRecord {
+  id: string;
+  name: string;
+  fields: Record<string, unknown>;
+}
+
+export interface LookupResult {
+  name_field: string;
+  fields: string[]; // Compact layout fields the user may read
+  records: LookupRecord[];
 }
 
 // --- Business Logic Metadata ---
@@ -339,6 +1055,119 @@ export interface DashboardConfig {
   label: string;
   description?: string;
   widgets: WidgetConfig[];
+  filters?: DashboardFilter[];
+  run_as?: 'viewer' | 'user';
+  run_as_user_id?: string; // Required when run_as is 'user'
+}
+
+export interface DashboardFilterValue {
+  start?: string; // date_range: YYYY-MM-DD, inclusive
+  end?: string; // date_range: YYYY-MM-DD, inclusive
+  values?: string[]; // picklist: any of
+}
+
+export interface DashboardFilter {
+  id: string;
+  label: string;
+  type: 'date_range' | 'picklist';
+  field: string; // Applied to every widget whose object has this field
+  options?: string[];
+  default?: DashboardFilterValue;
+}
+
+export interface WidgetResult {
+  widget_id: string;
+  data: unknown;
+  error?: string; // Set when this widget failed; other widgets are unaffected
+  duration_ms: number;
+}
+
+export interface DashboardRunResult {
+  dashboard_id: string;
+  widgets: WidgetResult[];
+  run_at: string;
+  cached: boolean;
+  running_user_id: string;
+}
+
+// --- Reports ---
+
+export type ReportFormat = 'tabular' | 'summary' | 'matrix';
+
+export interface ReportColumn {
+  field: string; // "amount" or, across a lookup, "account_id.industry"
+  label?: string;
+}
+
+export interface Report {
+  id: string;
+  name: string;
+  description?: string;
+  object_api_name: string;
+  format: ReportFormat;
+  columns: ReportColumn[];
+  filter_expr?: string;
+  groupings?: string[]; // summary: 1-3; matrix: exactly one
+  column_groupings?: string[]; // matrix only: exactly one
+  aggregates?: ListViewAggregate[];
+  sort_field?: string;
+  sort_direction?: 'ASC' | 'DESC';
+  row_limit?: number;
+  owner_id?: string;
+  created_date?: string;
+  last_modified_date?: string;
+}
+
+export interface ReportResultColumn {
+  key: string;
+  label: string;
+  type: string;
+}
+
+export interface ReportGroup {
+  field: string;
+  value: unknown;
+  count: number;
+  aggregates?: Record<string, unknown>; // Subtotals keyed like "sum_amount"
+  groups?: ReportGroup[];
+  rows?: SObject[]; // Leaf groups only
+}
+
+export interface ReportMatrixCell {
+  row: unknown;
+  column: unknown;
+  count: number;
+  values?: Record<string, unknown>;
+}
+
+export interface ReportMatrixTotal {
+  value: unknown;
+  count: number;
+  values?: Record<string, unknown>;
+}
+
+export interface ReportMatrix {
+  row_grouping: string;
+  column_grouping: string;
+  cells: ReportMatrixCell[];
+  row_totals: ReportMatrixTotal[];
+  column_totals: ReportMatrixTotal[];
+}
+
+export interface ReportResult {
+  report_id?: string;
+  name: string;
+  format: ReportFormat;
+  columns: ReportResultColumn[];
+  groupings?: ReportResultColumn[];
+  rows?: SObject[];
+  groups?: ReportGroup[];
+  matrix?: ReportMatrix;
+  total_count: number;
+  grand_totals?: Record<string, unknown>;
+  aggregates?: string[]; // Aggregate keys in report order
+  truncated: boolean;
+  run_at: string;
 }
 
 // --- App & Navigation Configuration ---
@@ -21,6 +21,12 @@ type ToolBus interface {
 	HandleCallTool(ctx context.Context, params json.RawMessage) (interface{}, error)
 }
 
+// ContextRefresher is implemented by tool buses that can re-fetch the CRM data pinned to
+// the caller's context before it is injected
+type ContextRefresher interface {
+	RefreshContext(ctx context.Context)
+}
+
 // Auto-compact configuration defaults
 const (
 	DefaultMaxContextTokens     = 100000 // Default max tokens
@@ -81,7 +87,10 @@ func (s *AgentService) GetBaseSystemPrompt() string {
 type ChatRequest struct {
 	Model    string        `json:"model"` // Optional, override default
 	Messages []llm.Message `json:"messages"`
-	User     *models.UserSession
+	// ConversationID selects the saved conversation whose settings apply; without it Settings is used
+	ConversationID string                `json:"conversation_id,omitempty"`
+	Settings       *ConversationSettings `json:"settings,omitempty"`
+	User           *models.UserSession
 }
 
 // ChatResponse final response to the UI
@@ -164,17 +173,31 @@ func (s *AgentService) ChatStream(ctx context.Context, req ChatRequest, eventCha
 	}
 
 	// 3. Inject Context from ContextStore
-	authToken, _ := ctx.Value(mcp.ContextKeyAuthToken).(string)
+	// Context is kept per user; callers without a user session are keyed by their token
+	sessionID, _ := ctx.Value(mcp.ContextKeyAuthToken).(string)
+	if user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession); user != nil {
+		sessionID = user.ID
+	}
 	contextInjection := ""
-	if authToken != "" {
-		session := s.contextStore.GetSession(authToken)
-		items := session.ListItems()
+	if sessionID != "" && s.contextStore != nil {
+		if refresher, ok := s.toolBus.(ContextRefresher); ok {
+			refresher.RefreshContext(ctx)
+		}
+		session := s.contextStore.GetSession(sessionID)
+		items, omitted := session.WithinBudget(contextstore.DefaultTokenBudget)
 		if len(items) > 0 {
 			contextInjection = "\n\nACTIVE CONTEXT FILES (Priority over general knowledge):\n"
 			for _, item := range items {
-				contextInjection += fmt.Sprintf("\n--- FILE: %s ---\n%s\n--- END FILE ---\n", item.Path, item.Content)
+				kind := "FILE"
+				if item.IsSnapshot() {
+					kind = "CRM DATA"
+				}
+				contextInjection += fmt.Sprintf("\n--- %s: %s ---\n%s\n--- END %s ---\n", kind, item.Path, item.Content, kind)
 			}
 		}
+		if len(omitted) > 0 {
+			contextInjection += fmt.Sprintf("\n(%d pinned context items omitted to stay within the token budget)\n", len(omitted))
+		}
 	}
 
 	// 4. Prepare Messages
@@ -192,6 +215,8 @@ func (s *AgentService) ChatStream(ctx context.Context, req ChatRequest, eventCha
 			messages[0].Content += contextInjection
 		}
 	}
+	// Guardrails can change between turns, so the section is always rewritten
+	messages[0].Content = withGuardrails(messages[0].Content, ConversationSettingsFromContext(ctx).PromptSection())
 
 	// 4. Auto-Compact Check - Compact if token count exceeds threshold
 	tokenCount := compactor.EstimateTokens(messages)
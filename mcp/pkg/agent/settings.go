@@ -0,0 +1,89 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+)
+
+// guardrailsMarker heads the system prompt section describing the conversation's settings
+const guardrailsMarker = "CONVERSATION GUARDRAILS"
+
+// ConversationSettings are the guardrails a user sets on one agent conversation. They are
+// stored with the conversation and enforced by the ToolBus on every tool call the agent makes.
+type ConversationSettings struct {
+	ReadOnly          bool     `json:"read_only"`
+	AllowedObjects    []string `json:"allowed_objects,omitempty"`      // empty allows every object
+	MaxRecordsPerCall int      `json:"max_records_per_call,omitempty"` // 0 means no limit
+}
+
+// Normalize lowercases and de-duplicates the allowed objects and drops a negative record limit
+func (s *ConversationSettings) Normalize() {
+	objects := make([]string, 0, len(s.AllowedObjects))
+	for _, o := range s.AllowedObjects {
+		o = strings.ToLower(strings.TrimSpace(o))
+		if o != "" && !slices.Contains(objects, o) {
+			objects = append(objects, o)
+		}
+	}
+	s.AllowedObjects = objects
+	if s.MaxRecordsPerCall < 0 {
+		s.MaxRecordsPerCall = 0
+	}
+}
+
+// Restricted reports whether any guardrail is set
+func (s *ConversationSettings) Restricted() bool {
+	return s != nil && (s.ReadOnly || len(s.AllowedObjects) > 0 || s.MaxRecordsPerCall > 0)
+}
+
+// AllowsObject reports whether the agent may touch objectName
+func (s *ConversationSettings) AllowsObject(objectName string) bool {
+	return len(s.AllowedObjects) == 0 || slices.Contains(s.AllowedObjects, strings.ToLower(objectName))
+}
+
+// PromptSection tells the model which guardrails apply, so it does not plan calls that will be refused
+func (s *ConversationSettings) PromptSection() string {
+	if !s.Restricted() {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\n\n" + guardrailsMarker + " (set by the user, enforced on every tool call):")
+	if s.ReadOnly {
+		sb.WriteString("\n- READ-ONLY: do not create, update or delete anything; only read and analyze.")
+	}
+	if len(s.AllowedObjects) > 0 {
+		fmt.Fprintf(&sb, "\n- Only these objects may be used: %s.", strings.Join(s.AllowedObjects, ", "))
+	}
+	if s.MaxRecordsPerCall > 0 {
+		fmt.Fprintf(&sb, "\n- At most %d records per tool call.", s.MaxRecordsPerCall)
+	}
+	return sb.String()
+}
+
+// WithConversationSettings returns a context carrying the conversation's guardrails
+func WithConversationSettings(ctx context.Context, settings *ConversationSettings) context.Context {
+	return context.WithValue(ctx, mcp.ContextKeyConversationSettings, settings)
+}
+
+// ConversationSettingsFromContext returns the guardrails of the conversation being served, or nil
+func ConversationSettingsFromContext(ctx context.Context) *ConversationSettings {
+	settings, _ := ctx.Value(mcp.ContextKeyConversationSettings).(*ConversationSettings)
+	return settings
+}
+
+// withGuardrails replaces the guardrail section of a system prompt with section
+func withGuardrails(prompt, section string) string {
+	if start := strings.Index(prompt, guardrailsMarker); start >= 0 {
+		start = max(start-2, 0) // the section opens with a blank line
+		end := len(prompt)
+		if next := strings.Index(prompt[start+2:], "\n\n"); next >= 0 {
+			end = start + 2 + next
+		}
+		prompt = prompt[:start] + prompt[end:]
+	}
+	return prompt + section
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+
+	"github.com/nexuscrm/mcp/pkg/models"
+)
+
+// API is the NexusCRM backend as seen by the ToolBus. NexusClient implements it over
+// HTTP; a backend that embeds the ToolBus can implement it in-process instead.
+// Implementations act as the user the authToken belongs to.
+type API interface {
+	ListObjects(ctx context.Context, authToken string) ([]models.ObjectMetadata, error)
+	DescribeObject(ctx context.Context, objectName string, authToken string) (*models.ObjectMetadata, error)
+	Query(ctx context.Context, req models.QueryRequest, authToken string) ([]models.SObject, error)
+	CreateRecord(ctx context.Context, objectName string, data map[string]interface{}, authToken string) (string, error)
+	GetRecord(ctx context.Context, objectName, id string, authToken string) (models.SObject, error)
+	UpdateRecord(ctx context.Context, objectName, id string, data map[string]interface{}, authToken string) error
+	DeleteRecord(ctx context.Context, objectName, id string, authToken string) error
+	BulkCreateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error)
+	BulkUpdateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error)
+	BulkDeleteRecords(ctx context.Context, objectName string, ids []string, authToken string) (*models.BulkResult, error)
+	RunReport(ctx context.Context, report models.Report, authToken string) (*models.ReportResult, error)
+	GetLayout(ctx context.Context, objectName string, authToken string) (*models.PageLayout, error)
+	GetRecentItems(ctx context.Context, objectName string, authToken string) ([]models.RecentItemGroup, error)
+	GetRecordTimeline(ctx context.Context, objectName, id string, types []string, cursor string, limit int, authToken string) (*models.TimelinePage, error)
+	GetDashboards(ctx context.Context, authToken string) ([]models.DashboardConfig, error)
+	GetDashboard(ctx context.Context, id string, authToken string) (*models.DashboardConfig, error)
+	CreateDashboard(ctx context.Context, dashboard models.DashboardCreate, authToken string) (string, error)
+	CreateApp(ctx context.Context, app models.AppConfig, authToken string) (string, error)
+	UpdateApp(ctx context.Context, id string, app models.AppConfig, authToken string) error
+	DeleteApp(ctx context.Context, id string, authToken string) error
+	CreateObject(ctx context.Context, schema models.ObjectMetadata, authToken string) error
+	DeleteObject(ctx context.Context, apiName string, authToken string) error
+	UpdateObject(ctx context.Context, apiName string, schema models.ObjectMetadata, authToken string) error
+	CreateField(ctx context.Context, objectName string, field models.FieldMetadata, authToken string) error
+	DeleteField(ctx context.Context, objectName, fieldName string, authToken string) error
+	UpdateField(ctx context.Context, objectName, fieldName string, field models.FieldMetadata, authToken string) error
+	Search(ctx context.Context, term string, authToken string) ([]interface{}, error)
+	Calculate(ctx context.Context, objectName string, data map[string]interface{}, authToken string) (map[string]interface{}, error)
+	GetValidationRules(ctx context.Context, objectName string, authToken string) ([]interface{}, error)
+	ListThemes(ctx context.Context, authToken string) (interface{}, error)
+	ActivateTheme(ctx context.Context, id string, authToken string) error
+	SearchObject(ctx context.Context, objectName, term string, authToken string) ([]models.SObject, error)
+	RunAnalytics(ctx context.Context, query models.AnalyticsQuery, authToken string) (interface{}, error)
+	AskData(ctx context.Context, req models.NLQRequest, authToken string) (*models.NLQResult, error)
+	SemanticSearch(ctx context.Context, req models.SemanticSearchRequest, authToken string) ([]models.SemanticSearchHit, error)
+	ListApps(ctx context.Context, authToken string) ([]models.AppConfig, error)
+	UpdateDashboard(ctx context.Context, id string, dashboard models.DashboardConfig, authToken string) error
+	DeleteDashboard(ctx context.Context, id string, authToken string) error
+	GetRecycleBinItems(ctx context.Context, scope string, authToken string) ([]models.RecycleBinItem, error)
+	RestoreRecord(ctx context.Context, id string, authToken string) error
+	PurgeRecord(ctx context.Context, id string, authToken string) error
+	ListFlows(ctx context.Context, authToken string) ([]models.Flow, error)
+	CreateFlow(ctx context.Context, flow map[string]interface{}, authToken string) (*models.Flow, error)
+	UpdateFlow(ctx context.Context, id string, updates map[string]interface{}, authToken string) (*models.Flow, error)
+	SubmitForApproval(ctx context.Context, objectName, recordID, comments string, authToken string) (string, error)
+	DecideWorkItem(ctx context.Context, workItemID string, approve bool, comments string, authToken string) error
+	GetPendingApprovals(ctx context.Context, authToken string) ([]models.SObject, error)
+	GetListViews(ctx context.Context, objectName string, authToken string) ([]models.ListView, error)
+	RunSavedSearch(ctx context.Context, id string, authToken string) ([]interface{}, error)
+	GetUsers(ctx context.Context, authToken string) ([]models.SObject, error)
+	CreateUser(ctx context.Context, user map[string]interface{}, authToken string) (string, error)
+	GetEffectivePermissions(ctx context.Context, userID string, authToken string) ([]models.ObjectPermission, error)
+	GetProfilePermissions(ctx context.Context, profileID string, authToken string) ([]models.ObjectPermission, error)
+	GetPermissionSetPermissions(ctx context.Context, permSetID string, authToken string) ([]models.ObjectPermission, error)
+	UpdateProfilePermissions(ctx context.Context, profileID string, perms []models.ObjectPermission, authToken string) error
+	UpdatePermissionSetPermissions(ctx context.Context, permSetID string, perms []models.ObjectPermission, authToken string) error
+	ExecuteFlow(ctx context.Context, flowID string, authToken string) error
+	CreateValidationRule(ctx context.Context, rule models.ValidationRule, authToken string) (string, error)
+	UpdateValidationRule(ctx context.Context, id string, rule models.ValidationRule, authToken string) error
+	DeleteValidationRule(ctx context.Context, id string, authToken string) error
+}
+
+var _ API = (*NexusClient)(nil)
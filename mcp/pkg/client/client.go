@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/nexuscrm/mcp/pkg/models"
@@ -177,6 +180,146 @@ func (c *NexusClient) DeleteRecord(ctx context.Context, objectName, id string, a
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/data/%s/%s", objectName, id), nil, nil, authToken)
 }
 
+// BulkCreateRecords creates many records in one request
+func (c *NexusClient) BulkCreateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error) {
+	// POST /api/data/:objectApiName/bulk
+	var respMap map[string]*models.BulkResult
+	body := map[string]interface{}{"records": records}
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/data/%s/bulk", objectName), body, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if result, ok := respMap["data"]; ok && result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("invalid response format for bulk create")
+}
+
+// BulkUpdateRecords updates many records in one request; each record carries its ID
+func (c *NexusClient) BulkUpdateRecords(ctx context.Context, objectName string, records []models.SObject, authToken string) (*models.BulkResult, error) {
+	// PATCH /api/data/:objectApiName/bulk
+	var respMap map[string]*models.BulkResult
+	body := map[string]interface{}{"records": records}
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/api/data/%s/bulk", objectName), body, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if result, ok := respMap["data"]; ok && result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("invalid response format for bulk update")
+}
+
+// BulkDeleteRecords deletes many records in one request
+func (c *NexusClient) BulkDeleteRecords(ctx context.Context, objectName string, ids []string, authToken string) (*models.BulkResult, error) {
+	// DELETE /api/data/:objectApiName/bulk
+	var respMap map[string]*models.BulkResult
+	body := map[string]interface{}{"ids": ids}
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/data/%s/bulk", objectName), body, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if result, ok := respMap["data"]; ok && result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("invalid response format for bulk delete")
+}
+
+// RunReport runs an unsaved report definition as the calling user
+func (c *NexusClient) RunReport(ctx context.Context, report models.Report, authToken string) (*models.ReportResult, error) {
+	// POST /api/metadata/reports/run
+	var respMap map[string]*models.ReportResult
+	if err := c.doRequest(ctx, "POST", "/api/metadata/reports/run", report, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if result, ok := respMap["data"]; ok && result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("invalid response format for report")
+}
+
+// AskData translates a natural-language question into a query and returns it with its results
+func (c *NexusClient) AskData(ctx context.Context, req models.NLQRequest, authToken string) (*models.NLQResult, error) {
+	// POST /api/data/nlq
+	var respMap map[string]*models.NLQResult
+	if err := c.doRequest(ctx, "POST", "/api/data/nlq", req, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if result, ok := respMap["data"]; ok && result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("invalid response format for natural language query")
+}
+
+// SemanticSearch returns the records most similar in meaning to req.Term
+func (c *NexusClient) SemanticSearch(ctx context.Context, req models.SemanticSearchRequest, authToken string) ([]models.SemanticSearchHit, error) {
+	// POST /api/data/semantic-search
+	var respMap map[string][]models.SemanticSearchHit
+	if err := c.doRequest(ctx, "POST", "/api/data/semantic-search", req, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if hits, ok := respMap["data"]; ok {
+		return hits, nil
+	}
+	return nil, fmt.Errorf("invalid response format for semantic search")
+}
+
+// GetLayout returns the page layout of an object as seen by the caller's profile
+func (c *NexusClient) GetLayout(ctx context.Context, objectName string, authToken string) (*models.PageLayout, error) {
+	// GET /api/metadata/layouts/:objectName
+	var respMap map[string]*models.PageLayout
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/metadata/layouts/%s", objectName), nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if layout, ok := respMap["data"]; ok && layout != nil {
+		return layout, nil
+	}
+	return nil, fmt.Errorf("layout not found")
+}
+
+// GetRecentItems returns the caller's recently viewed records grouped by object,
+// limited to one object when objectName is set
+func (c *NexusClient) GetRecentItems(ctx context.Context, objectName string, authToken string) ([]models.RecentItemGroup, error) {
+	// GET /api/data/recent?object=
+	path := "/api/data/recent"
+	if objectName != "" {
+		path += "?object=" + url.QueryEscape(objectName)
+	}
+	var respMap map[string][]models.RecentItemGroup
+	if err := c.doRequest(ctx, "GET", path, nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if groups, ok := respMap["data"]; ok {
+		return groups, nil
+	}
+	return nil, fmt.Errorf("invalid response format for recent items")
+}
+
+// GetRecordTimeline returns one page of a record's timeline, limited to some entry types
+// when types is set; cursor is the next_cursor of the previous page
+func (c *NexusClient) GetRecordTimeline(ctx context.Context, objectName, id string, types []string, cursor string, limit int, authToken string) (*models.TimelinePage, error) {
+	// GET /api/data/:objectApiName/:id/timeline?types=&cursor=&limit=
+	params := url.Values{}
+	if len(types) > 0 {
+		params.Set("types", strings.Join(types, ","))
+	}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	path := fmt.Sprintf("/api/data/%s/%s/timeline", objectName, id)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	var respMap map[string]*models.TimelinePage
+	if err := c.doRequest(ctx, "GET", path, nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if page, ok := respMap["data"]; ok && page != nil {
+		return page, nil
+	}
+	return nil, fmt.Errorf("invalid response format for record timeline")
+}
+
 // GetDashboards returns all dashboards visible to the user
 func (c *NexusClient) GetDashboards(ctx context.Context, authToken string) ([]models.DashboardConfig, error) {
 	// GET /api/metadata/dashboards
@@ -434,6 +577,185 @@ func (c *NexusClient) PurgeRecord(ctx context.Context, id string, authToken stri
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/data/recyclebin/%s", id), nil, nil, authToken)
 }
 
+// ListFlows returns all flow definitions
+func (c *NexusClient) ListFlows(ctx context.Context, authToken string) ([]models.Flow, error) {
+	// GET /api/metadata/flows
+	var respMap map[string][]models.Flow
+	if err := c.doRequest(ctx, "GET", "/api/metadata/flows", nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if flows, ok := respMap["data"]; ok {
+		return flows, nil
+	}
+	return nil, fmt.Errorf("invalid response format for flows")
+}
+
+// CreateFlow creates a flow and returns it as stored
+func (c *NexusClient) CreateFlow(ctx context.Context, flow map[string]interface{}, authToken string) (*models.Flow, error) {
+	// POST /api/metadata/flows
+	// Response envelope: { "message": ..., "data": flow }
+	var resp struct {
+		Data *models.Flow `json:"data"`
+	}
+	if err := c.doRequest(ctx, "POST", "/api/metadata/flows", flow, &resp, authToken); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("invalid response format for create flow")
+	}
+	return resp.Data, nil
+}
+
+// UpdateFlow applies a partial update to a flow and returns it as stored
+func (c *NexusClient) UpdateFlow(ctx context.Context, id string, updates map[string]interface{}, authToken string) (*models.Flow, error) {
+	// PATCH /api/metadata/flows/:flowId
+	// Response envelope: { "message": ..., "data": flow }
+	var resp struct {
+		Data *models.Flow `json:"data"`
+	}
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/api/metadata/flows/%s", id), updates, &resp, authToken); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("invalid response format for update flow")
+	}
+	return resp.Data, nil
+}
+
+// SubmitForApproval submits a record to its object's approval process and returns the work item ID
+func (c *NexusClient) SubmitForApproval(ctx context.Context, objectName, recordID, comments string, authToken string) (string, error) {
+	// POST /api/approvals/submit
+	var respMap map[string]map[string]interface{}
+	body := map[string]string{"object_api_name": objectName, "record_id": recordID, "comments": comments}
+	if err := c.doRequest(ctx, "POST", "/api/approvals/submit", body, &respMap, authToken); err != nil {
+		return "", err
+	}
+	if id, ok := respMap["data"]["work_item_id"].(string); ok {
+		return id, nil
+	}
+	return "", fmt.Errorf("submitted approval missing work item ID")
+}
+
+// DecideWorkItem approves or rejects a pending approval work item
+func (c *NexusClient) DecideWorkItem(ctx context.Context, workItemID string, approve bool, comments string, authToken string) error {
+	// POST /api/approvals/:workItemId/approve|reject
+	action := "reject"
+	if approve {
+		action = "approve"
+	}
+	body := map[string]string{"comments": comments}
+	return c.doRequest(ctx, "POST", fmt.Sprintf("/api/approvals/%s/%s", workItemID, action), body, nil, authToken)
+}
+
+// GetPendingApprovals returns the work items waiting for the user's decision
+func (c *NexusClient) GetPendingApprovals(ctx context.Context, authToken string) ([]models.SObject, error) {
+	// GET /api/approvals/pending
+	var respMap map[string][]models.SObject
+	if err := c.doRequest(ctx, "GET", "/api/approvals/pending", nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if items, ok := respMap["data"]; ok {
+		return items, nil
+	}
+	return nil, fmt.Errorf("invalid response format for pending approvals")
+}
+
+// GetListViews returns the list views of an object visible to the caller
+func (c *NexusClient) GetListViews(ctx context.Context, objectName string, authToken string) ([]models.ListView, error) {
+	// GET /api/metadata/listviews?objectApiName=
+	var respMap map[string][]models.ListView
+	path := "/api/metadata/listviews?objectApiName=" + url.QueryEscape(objectName)
+	if err := c.doRequest(ctx, "GET", path, nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if views, ok := respMap["data"]; ok {
+		return views, nil
+	}
+	return nil, fmt.Errorf("invalid response format for list views")
+}
+
+// RunSavedSearch runs one of the caller's saved searches
+func (c *NexusClient) RunSavedSearch(ctx context.Context, id string, authToken string) ([]interface{}, error) {
+	// POST /api/data/saved-searches/:id/run
+	var respMap map[string][]interface{}
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/data/saved-searches/%s/run", id), nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if results, ok := respMap["data"]; ok {
+		return results, nil
+	}
+	return nil, fmt.Errorf("invalid response format for saved search")
+}
+
+// GetUsers returns all users with their profile and role
+func (c *NexusClient) GetUsers(ctx context.Context, authToken string) ([]models.SObject, error) {
+	// GET /api/auth/users
+	var respMap map[string][]models.SObject
+	if err := c.doRequest(ctx, "GET", "/api/auth/users", nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if users, ok := respMap["data"]; ok {
+		return users, nil
+	}
+	return nil, fmt.Errorf("invalid response format for users")
+}
+
+// CreateUser registers a new user and returns its ID
+func (c *NexusClient) CreateUser(ctx context.Context, user map[string]interface{}, authToken string) (string, error) {
+	// POST /api/auth/register
+	var respMap map[string]interface{}
+	if err := c.doRequest(ctx, "POST", "/api/auth/register", user, &respMap, authToken); err != nil {
+		return "", err
+	}
+	if data, ok := respMap["data"].(map[string]interface{}); ok {
+		if id, ok := data["__sys_gen_id"].(string); ok {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("created user missing ID")
+}
+
+// GetEffectivePermissions returns a user's object permissions merged from their profile and permission sets
+func (c *NexusClient) GetEffectivePermissions(ctx context.Context, userID string, authToken string) ([]models.ObjectPermission, error) {
+	// GET /api/auth/users/:id/permissions/effective
+	return c.getObjectPermissions(ctx, fmt.Sprintf("/api/auth/users/%s/permissions/effective", userID), authToken)
+}
+
+// GetProfilePermissions returns the object permissions granted by a profile
+func (c *NexusClient) GetProfilePermissions(ctx context.Context, profileID string, authToken string) ([]models.ObjectPermission, error) {
+	// GET /api/auth/profiles/:id/permissions
+	return c.getObjectPermissions(ctx, fmt.Sprintf("/api/auth/profiles/%s/permissions", profileID), authToken)
+}
+
+// GetPermissionSetPermissions returns the object permissions granted by a permission set
+func (c *NexusClient) GetPermissionSetPermissions(ctx context.Context, permSetID string, authToken string) ([]models.ObjectPermission, error) {
+	// GET /api/auth/permission-sets/:id/permissions
+	return c.getObjectPermissions(ctx, fmt.Sprintf("/api/auth/permission-sets/%s/permissions", permSetID), authToken)
+}
+
+func (c *NexusClient) getObjectPermissions(ctx context.Context, path string, authToken string) ([]models.ObjectPermission, error) {
+	var respMap map[string][]models.ObjectPermission
+	if err := c.doRequest(ctx, "GET", path, nil, &respMap, authToken); err != nil {
+		return nil, err
+	}
+	if perms, ok := respMap["data"]; ok {
+		return perms, nil
+	}
+	return nil, fmt.Errorf("invalid response format for permissions")
+}
+
+// UpdateProfilePermissions saves object permissions on a profile
+func (c *NexusClient) UpdateProfilePermissions(ctx context.Context, profileID string, perms []models.ObjectPermission, authToken string) error {
+	// PUT /api/auth/profiles/:id/permissions
+	return c.doRequest(ctx, "PUT", fmt.Sprintf("/api/auth/profiles/%s/permissions", profileID), perms, nil, authToken)
+}
+
+// UpdatePermissionSetPermissions saves object permissions on a permission set
+func (c *NexusClient) UpdatePermissionSetPermissions(ctx context.Context, permSetID string, perms []models.ObjectPermission, authToken string) error {
+	// PUT /api/auth/permission-sets/:id/permissions
+	return c.doRequest(ctx, "PUT", fmt.Sprintf("/api/auth/permission-sets/%s/permissions", permSetID), perms, nil, authToken)
+}
+
 // ExecuteFlow triggers a flow to run immediately
 func (c *NexusClient) ExecuteFlow(ctx context.Context, flowID string, authToken string) error {
 	// POST /api/flows/:flowId/execute
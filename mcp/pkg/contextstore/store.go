@@ -3,11 +3,21 @@ package contextstore
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 )
 
+// SnapshotPrefix marks items that pin CRM data (records, list views, queries) rather than
+// files; their Path is the nexus:// URI of the data and their Content a snapshot of it
+const SnapshotPrefix = "nexus://"
+
+// DefaultTokenBudget is how many tokens of pinned context are sent to the agent
+const DefaultTokenBudget = 16000
+
 // ContextItem represents a file or content added to context
 type ContextItem struct {
 	Path      string `json:"path"`
@@ -15,11 +25,22 @@ type ContextItem struct {
 	TokenSize int    `json:"token_size"` // Estimated
 }
 
+// IsSnapshot reports whether the item pins CRM data rather than a file
+func (i ContextItem) IsSnapshot() bool {
+	return strings.HasPrefix(i.Path, SnapshotPrefix)
+}
+
+// EstimateTokens approximates the token count of content (4 chars ~= 1 token)
+func EstimateTokens(content string) int {
+	return len(content) / 4
+}
+
 // SessionContext holds the context for a specific session/user
 type SessionContext struct {
 	Items map[string]ContextItem `json:"items"`
 	mu    sync.RWMutex
 	store *ContextStore // Back reference for saving
+	id    string
 }
 
 // MarshalJSON implements custom marshaling to ensure thread safety
@@ -36,11 +57,20 @@ func (sc *SessionContext) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Persister keeps session items outside the process, one entry per session and path
+type Persister interface {
+	LoadItems(sessionID string) (map[string]ContextItem, error)
+	SaveItem(sessionID string, item ContextItem) error
+	DeleteItem(sessionID string, path string) error
+	ClearItems(sessionID string) error
+}
+
 // ContextStore manages context across multiple sessions
 type ContextStore struct {
-	Sessions map[string]*SessionContext `json:"sessions"`
-	mu       sync.RWMutex
-	filePath string
+	Sessions  map[string]*SessionContext `json:"sessions"`
+	mu        sync.RWMutex
+	filePath  string
+	persister Persister
 }
 
 func NewContextStore(filePath string) *ContextStore {
@@ -53,6 +83,16 @@ func NewContextStore(filePath string) *ContextStore {
 	return store
 }
 
+// NewPersistentContextStore creates a store backed by p. It caches nothing: every GetSession
+// reads the latest items and every change is written through, so several processes can
+// share the same context.
+func NewPersistentContextStore(p Persister) *ContextStore {
+	return &ContextStore{
+		Sessions:  make(map[string]*SessionContext),
+		persister: p,
+	}
+}
+
 // Load reads the context from disk
 func (s *ContextStore) Load() error {
 	s.mu.Lock()
@@ -81,8 +121,9 @@ func (s *ContextStore) Load() error {
 
 	// Rehydrate sessions
 	s.Sessions = tempSessions
-	for _, sess := range s.Sessions {
+	for id, sess := range s.Sessions {
 		sess.store = s
+		sess.id = id
 		if sess.Items == nil {
 			sess.Items = make(map[string]ContextItem)
 		}
@@ -109,6 +150,17 @@ func (s *ContextStore) Save() error {
 }
 
 func (s *ContextStore) GetSession(sessionID string) *SessionContext {
+	if s.persister != nil {
+		items, err := s.persister.LoadItems(sessionID)
+		if err != nil {
+			log.Printf("⚠️ [ContextStore] Failed to load session context: %v", err)
+		}
+		if items == nil {
+			items = make(map[string]ContextItem)
+		}
+		return &SessionContext{Items: items, store: s, id: sessionID}
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -116,6 +168,7 @@ func (s *ContextStore) GetSession(sessionID string) *SessionContext {
 		s.Sessions[sessionID] = &SessionContext{
 			Items: make(map[string]ContextItem),
 			store: s,
+			id:    sessionID,
 		}
 	}
 	return s.Sessions[sessionID]
@@ -137,14 +190,17 @@ func (sc *SessionContext) AddFile(path string) error {
 		return fmt.Errorf("failed to read file: %v", err)
 	}
 
-	// Simple token estimation (4 chars ~= 1 token)
-	tokenSize := len(content) / 4
-
-	sc.Items[absPath] = ContextItem{
+	item := ContextItem{
 		Path:      absPath,
 		Content:   string(content),
-		TokenSize: tokenSize,
+		TokenSize: EstimateTokens(string(content)),
 	}
+	if sc.store != nil && sc.store.persister != nil {
+		if err := sc.store.persister.SaveItem(sc.id, item); err != nil {
+			return fmt.Errorf("failed to save context: %v", err)
+		}
+	}
+	sc.Items[absPath] = item
 
 	// Trigger Save
 	if sc.store != nil {
@@ -154,6 +210,24 @@ func (sc *SessionContext) AddFile(path string) error {
 	return nil
 }
 
+// Put adds an item to the session context or replaces the item with the same path
+func (sc *SessionContext) Put(item ContextItem) error {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if sc.store != nil && sc.store.persister != nil {
+		if err := sc.store.persister.SaveItem(sc.id, item); err != nil {
+			return fmt.Errorf("failed to save context: %v", err)
+		}
+	}
+	sc.Items[item.Path] = item
+
+	if sc.store != nil {
+		go sc.store.Save()
+	}
+	return nil
+}
+
 // RemoveFile removes a file from context
 func (sc *SessionContext) RemoveFile(path string) {
 	sc.mu.Lock()
@@ -161,10 +235,10 @@ func (sc *SessionContext) RemoveFile(path string) {
 
 	absPath, err := filepath.Abs(path)
 	if err == nil {
-		delete(sc.Items, absPath)
+		sc.forget(absPath)
 	}
 	// Also try deleting as-is in case it was stored differently
-	delete(sc.Items, path)
+	sc.forget(path)
 
 	// Trigger Save
 	if sc.store != nil {
@@ -172,6 +246,19 @@ func (sc *SessionContext) RemoveFile(path string) {
 	}
 }
 
+// forget drops an item from the session and its persister; the caller holds sc.mu
+func (sc *SessionContext) forget(path string) {
+	if _, ok := sc.Items[path]; !ok {
+		return
+	}
+	delete(sc.Items, path)
+	if sc.store != nil && sc.store.persister != nil {
+		if err := sc.store.persister.DeleteItem(sc.id, path); err != nil {
+			log.Printf("⚠️ [ContextStore] Failed to remove %s from session context: %v", path, err)
+		}
+	}
+}
+
 // ListItems returns all items in context
 func (sc *SessionContext) ListItems() []ContextItem {
 	sc.mu.RLock()
@@ -189,6 +276,11 @@ func (sc *SessionContext) Clear() {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.Items = make(map[string]ContextItem)
+	if sc.store != nil && sc.store.persister != nil {
+		if err := sc.store.persister.ClearItems(sc.id); err != nil {
+			log.Printf("⚠️ [ContextStore] Failed to clear session context: %v", err)
+		}
+	}
 
 	// Trigger Save
 	if sc.store != nil {
@@ -196,6 +288,24 @@ func (sc *SessionContext) Clear() {
 	}
 }
 
+// WithinBudget splits the items, ordered by path, into those that fit in a budget of tokens
+// and those left out. Items that do not fit are skipped, so smaller later items may still fit.
+func (sc *SessionContext) WithinBudget(budget int) (included, omitted []ContextItem) {
+	items := sc.ListItems()
+	sort.Slice(items, func(i, j int) bool { return items[i].Path < items[j].Path })
+
+	used := 0
+	for _, item := range items {
+		if used+item.TokenSize > budget {
+			omitted = append(omitted, item)
+			continue
+		}
+		used += item.TokenSize
+		included = append(included, item)
+	}
+	return included, omitted
+}
+
 // GetTotalTokens returns estimated total tokens
 func (sc *SessionContext) GetTotalTokens() int {
 	sc.mu.RLock()
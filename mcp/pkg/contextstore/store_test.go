@@ -10,7 +10,8 @@ import (
 
 func TestContextStore_SaveLoad(t *testing.T) {
 	// Create a temporary file for the store
-	tmpStoreFile := filepath.Join(os.TempDir(), "test_context_store.json")
+	// A unique file per run: the store saves asynchronously and may recreate it after removal
+	tmpStoreFile := tempStorePath(t, "test_context_store_*.json")
 	defer os.Remove(tmpStoreFile)
 
 	// Create a temporary file to be added to context
@@ -66,7 +67,8 @@ func TestContextStore_SaveLoad(t *testing.T) {
 }
 
 func TestContextStore_Concurrency(t *testing.T) {
-	tmpStoreFile := filepath.Join(os.TempDir(), "test_context_store_concurrent.json")
+	// A unique file per run: the store saves asynchronously and may recreate it after removal
+	tmpStoreFile := tempStorePath(t, "test_context_store_concurrent_*.json")
 	defer os.Remove(tmpStoreFile)
 
 	// Create a dummy file to read
@@ -143,3 +145,90 @@ func TestContextStore_JSONMarshaling(t *testing.T) {
 		t.Errorf("Expected 1 item after unmarshal, got %d", len(unmarshaled.Items))
 	}
 }
+
+func tempStorePath(t *testing.T, pattern string) string {
+	t.Helper()
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	return f.Name()
+}
+
+// memoryPersister stands in for a shared database
+type memoryPersister struct {
+	mu       sync.Mutex
+	sessions map[string]map[string]ContextItem
+}
+
+func (p *memoryPersister) LoadItems(sessionID string) (map[string]ContextItem, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	items := make(map[string]ContextItem)
+	for k, v := range p.sessions[sessionID] {
+		items[k] = v
+	}
+	return items, nil
+}
+
+func (p *memoryPersister) SaveItem(sessionID string, item ContextItem) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.sessions[sessionID] == nil {
+		p.sessions[sessionID] = make(map[string]ContextItem)
+	}
+	p.sessions[sessionID][item.Path] = item
+	return nil
+}
+
+func (p *memoryPersister) DeleteItem(sessionID string, path string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.sessions[sessionID], path)
+	return nil
+}
+
+func (p *memoryPersister) ClearItems(sessionID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.sessions, sessionID)
+	return nil
+}
+
+func TestContextStore_Persister(t *testing.T) {
+	tmpContentFile, err := os.CreateTemp("", "persisted_*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpContentFile.WriteString("shared content")
+	tmpContentFile.Close()
+	defer os.Remove(tmpContentFile.Name())
+	absPath, _ := filepath.Abs(tmpContentFile.Name())
+
+	// Two stores on one persister behave like two backend instances
+	persister := &memoryPersister{sessions: make(map[string]map[string]ContextItem)}
+	first, second := NewPersistentContextStore(persister), NewPersistentContextStore(persister)
+
+	if err := first.GetSession("u1").AddFile(absPath); err != nil {
+		t.Fatalf("Failed to add file: %v", err)
+	}
+	items := second.GetSession("u1").ListItems()
+	if len(items) != 1 || items[0].Content != "shared content" {
+		t.Fatalf("Expected the other store to see the item, got %+v", items)
+	}
+	if len(second.GetSession("u2").ListItems()) != 0 {
+		t.Errorf("Expected sessions to be isolated")
+	}
+
+	second.GetSession("u1").RemoveFile(absPath)
+	if n := len(first.GetSession("u1").ListItems()); n != 0 {
+		t.Errorf("Expected the removal to be shared, got %d items", n)
+	}
+
+	_ = first.GetSession("u1").AddFile(absPath)
+	second.GetSession("u1").Clear()
+	if n := len(first.GetSession("u1").ListItems()); n != 0 {
+		t.Errorf("Expected the clear to be shared, got %d items", n)
+	}
+}
@@ -5,4 +5,15 @@ const (
 	ContextKeyAuthToken = "auth_token"
 	// ContextKeyUser is the key used to store/retrieve the user object from context
 	ContextKeyUser = "user"
+	// ContextKeyConversationSettings is the key used to store/retrieve the agent conversation's guardrails
+	ContextKeyConversationSettings = "conversation_settings"
+)
+
+const (
+	// ContextKeySessionID is the key used to store/retrieve the MCP session ID from context
+	ContextKeySessionID = "mcp_session_id"
+	// HeaderSessionID carries the session ID assigned at initialize (Streamable HTTP transport)
+	HeaderSessionID = "Mcp-Session-Id"
+	// ProtocolVersion is the MCP revision this server implements
+	ProtocolVersion = "2025-03-26"
 )
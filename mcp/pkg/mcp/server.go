@@ -2,14 +2,20 @@ package mcp
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 )
 
+// notificationBuffer is how many notifications may queue for a slow stream before new ones are dropped
+const notificationBuffer = 32
+
 // HandlerFunc matches the signature of an MCP method handler
 type HandlerFunc func(ctx context.Context, params json.RawMessage) (interface{}, error)
 
@@ -17,12 +23,18 @@ type HandlerFunc func(ctx context.Context, params json.RawMessage) (interface{},
 type Server struct {
 	handlers map[string]HandlerFunc
 	mu       sync.RWMutex
+
+	// Open notification streams by session ID, and hooks run when one closes
+	streams       map[string]chan Notification
+	onStreamClose []func(sessionID string)
+	streamsMu     sync.Mutex
 }
 
 // NewServer creates a new MCP Server
 func NewServer() *Server {
 	return &Server{
 		handlers: make(map[string]HandlerFunc),
+		streams:  make(map[string]chan Notification),
 	}
 }
 
@@ -33,11 +45,45 @@ func (s *Server) Register(method string, handler HandlerFunc) {
 	s.handlers[method] = handler
 }
 
-// HandleHTTP manages MCP over HTTP (POST for RPC, GET for SSE handshake)
-// Note: For simplicity in Phase 1, we implement standard POST JSON-RPC.
-// Full SSE support requires a more complex connection manager.
+// OnStreamClose registers a hook run after a session's notification stream closes
+func (s *Server) OnStreamClose(fn func(sessionID string)) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	s.onStreamClose = append(s.onStreamClose, fn)
+}
+
+// Notify sends a notification to a session's open stream. It reports false when the session
+// has no stream or the stream is too far behind.
+func (s *Server) Notify(sessionID, method string, params interface{}) bool {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		log.Printf("MCP notification %s: %v", method, err)
+		return false
+	}
+
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	stream, ok := s.streams[sessionID]
+	if !ok {
+		return false
+	}
+	select {
+	case stream <- Notification{JSONRPC: JSONRPCVersion, Method: method, Params: raw}:
+		return true
+	default:
+		return false
+	}
+}
+
+// ServeHTTP manages MCP over HTTP: POST carries JSON-RPC requests and GET opens the
+// session's server-sent event stream for notifications.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "POST" {
+	switch r.Method {
+	case http.MethodPost:
+	case http.MethodGet:
+		s.serveStream(w, r)
+		return
+	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
@@ -55,13 +101,92 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Notifications from the client (e.g. notifications/initialized) get no response
+	if req.ID == nil && strings.HasPrefix(req.Method, "notifications/") {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// The session starts at initialize; later requests echo its ID in the header
+	sessionID := r.Header.Get(HeaderSessionID)
+	if sessionID == "" && req.Method == "initialize" {
+		sessionID = newSessionID()
+	}
+	ctx := r.Context()
+	if sessionID != "" {
+		w.Header().Set(HeaderSessionID, sessionID)
+		ctx = context.WithValue(ctx, ContextKeySessionID, sessionID)
+	}
+
 	// Route the request
-	resp := s.handleRequest(r.Context(), req)
+	resp := s.handleRequest(ctx, req)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
 
+// serveStream relays the session's notifications as server-sent events until the client disconnects
+func (s *Server) serveStream(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.Header.Get(HeaderSessionID)
+	if sessionID == "" {
+		http.Error(w, "Missing "+HeaderSessionID+" header", http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	stream := make(chan Notification, notificationBuffer)
+	s.streamsMu.Lock()
+	if _, exists := s.streams[sessionID]; exists {
+		s.streamsMu.Unlock()
+		http.Error(w, "Session already has an open stream", http.StatusConflict)
+		return
+	}
+	s.streams[sessionID] = stream
+	s.streamsMu.Unlock()
+
+	defer func() {
+		s.streamsMu.Lock()
+		delete(s.streams, sessionID)
+		hooks := append([]func(string){}, s.onStreamClose...)
+		s.streamsMu.Unlock()
+		for _, fn := range hooks {
+			fn(sessionID)
+		}
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set(HeaderSessionID, sessionID)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case n := <-stream:
+			data, err := json.Marshal(n)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: message\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func newSessionID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 func (s *Server) handleRequest(ctx context.Context, req Request) Response {
 	s.mu.RLock()
 	handler, ok := s.handlers[req.Method]
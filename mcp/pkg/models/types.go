@@ -48,3 +48,51 @@ type RecycleBinItem = shared.RecycleBinItem
 
 // ValidationRule represents a validation rule
 type ValidationRule = shared.ValidationRule
+
+// BulkResult reports which records of a bulk operation succeeded or failed
+type BulkResult = shared.BulkResult
+
+// Report represents a report definition; run_report sends unsaved summary reports
+type Report = shared.Report
+
+// ReportResult is the output of running a report
+type ReportResult = shared.ReportResult
+
+// ReportGroup is one grouping level of a summary report result
+type ReportGroup = shared.ReportGroup
+
+// ListViewAggregate is an aggregate function over a field
+type ListViewAggregate = shared.ListViewAggregate
+
+// Flow represents an automation flow definition
+type Flow = shared.Flow
+
+// FlowStep represents a step of a multi-step flow
+type FlowStep = shared.FlowStep
+
+// ObjectPermission is one object's CRUD access granted by a profile or permission set
+type ObjectPermission = shared.SystemObjectPerms
+
+// PageLayout describes how an object's record page is laid out
+type PageLayout = shared.PageLayout
+
+// RecentItemGroup holds a user's recently viewed records of one object
+type RecentItemGroup = shared.RecentItemGroup
+
+// TimelinePage is one page of a record's activity timeline, newest first
+type TimelinePage = shared.TimelinePage
+
+// ListView is a saved filter, sort and column set over an object's records
+type ListView = shared.ListView
+
+// NLQRequest asks a natural-language question about CRM data
+type NLQRequest = shared.NLQRequest
+
+// NLQResult is the validated query a question was translated into and its results
+type NLQResult = shared.NLQResult
+
+// SemanticSearchRequest searches records by meaning rather than by keyword
+type SemanticSearchRequest = shared.SemanticSearchRequest
+
+// SemanticSearchHit is one record ranked by similarity to a semantic search term
+type SemanticSearchHit = shared.SemanticSearchHit
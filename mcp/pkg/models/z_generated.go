@@ -1,6 +1,6 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: backend/internal/bootstrap/system_tables.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T06:41:52Z
 
 package models
 
@@ -38,6 +38,9 @@ type SystemDashboard struct {
 	Description string `json:"description"`
 	Layout string `json:"layout"`
 	Widgets json.RawMessage `json:"widgets"`
+	Filters json.RawMessage `json:"filters"`
+	RunAs string `json:"run_as"`
+	RunAsUserID string `json:"run_as_user_id"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
 	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
@@ -77,6 +80,9 @@ type SystemField struct {
 	Validator *string `json:"validator,omitempty"`
 	ControllingField *string `json:"controlling_field,omitempty"`
 	PicklistDependency json.RawMessage `json:"picklist_dependency,omitempty"`
+	InactiveOptions json.RawMessage `json:"inactive_options,omitempty"`
+	ValueSet *string `json:"value_set,omitempty"`
+	LookupFilter *string `json:"lookup_filter,omitempty"`
 	RollupConfig json.RawMessage `json:"rollup_config,omitempty"`
 	IsMasterDetail bool `json:"is_master_detail"`
 	IsPolymorphic bool `json:"is_polymorphic"`
@@ -133,6 +139,13 @@ type SystemListView struct {
 	Label string `json:"label"`
 	FilterExpr *string `json:"filter_expr,omitempty"`
 	Fields json.RawMessage `json:"fields"`
+	Visibility string `json:"visibility"`
+	OwnerID *string `json:"owner_id,omitempty"`
+	ProfileIDs json.RawMessage `json:"profile_ids,omitempty"`
+	ColumnSettings json.RawMessage `json:"column_settings,omitempty"`
+	SortField *string `json:"sort_field,omitempty"`
+	SortDirection *string `json:"sort_direction,omitempty"`
+	Aggregates json.RawMessage `json:"aggregates,omitempty"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
@@ -169,6 +182,8 @@ type SystemProfile struct {
 	Description string `json:"description"`
 	IsActive bool `json:"is_active"`
 	IsSystem bool `json:"is_system"`
+	BypassFlows bool `json:"bypass_flows"`
+	BypassValidation bool `json:"bypass_validation"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
 	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
@@ -204,6 +219,10 @@ type SystemUser struct {
 	Phone *string `json:"phone,omitempty"`
 	ProfileID string `json:"profile_id"`
 	RoleID *string `json:"role_id,omitempty"`
+	UserType string `json:"user_type"`
+	ContactID *string `json:"contact_id,omitempty"`
+	AccountID *string `json:"account_id,omitempty"`
+	Locale *string `json:"locale,omitempty"`
 	IsActive bool `json:"is_active"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
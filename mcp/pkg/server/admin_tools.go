@@ -0,0 +1,318 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// adminTools are only listed for, and callable by, system administrators
+var adminTools = map[string]bool{
+	ToolListUsers:               true,
+	ToolCreateUser:              true,
+	ToolAssignPermissionSet:     true,
+	ToolGetEffectivePermissions: true,
+	ToolUpdateObjectPermissions: true,
+}
+
+// requireSystemAdmin rejects callers whose session is not a system administrator
+func requireSystemAdmin(ctx context.Context) error {
+	user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession)
+	if user == nil || !user.IsSystemAdmin {
+		return fmt.Errorf("this tool is restricted to system administrators")
+	}
+	return nil
+}
+
+// handleListUsers lists users, optionally those whose name, username or email contains search
+func (s *ToolBusService) handleListUsers(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	users, err := s.client.GetUsers(ctx, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to list users: %v", err)}}}, nil
+	}
+
+	search := strings.ToLower(getStringFromMap(args, "search"))
+	matched := []models.SObject{}
+	for _, u := range users {
+		if search != "" &&
+			!strings.Contains(strings.ToLower(getStringFromSObject(u, constants.FieldName)), search) &&
+			!strings.Contains(strings.ToLower(getStringFromSObject(u, constants.FieldUsername)), search) &&
+			!strings.Contains(strings.ToLower(getStringFromSObject(u, constants.FieldEmail)), search) {
+			continue
+		}
+		matched = append(matched, models.SObject{
+			constants.FieldID:        u[constants.FieldID],
+			constants.FieldName:      u[constants.FieldName],
+			constants.FieldEmail:     u[constants.FieldEmail],
+			constants.FieldProfileID: u[constants.FieldProfileID],
+			constants.FieldRoleID:    u[constants.FieldRoleID],
+			constants.FieldIsActive:  u[constants.FieldIsActive],
+		})
+	}
+
+	jsonBytes, _ := json.MarshalIndent(matched, "", "  ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Found %d user(s):\n%s", len(matched), string(jsonBytes))}},
+	}, nil
+}
+
+// handleCreateUser registers a new user
+func (s *ToolBusService) handleCreateUser(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	name := getStringFromMap(args, "name")
+	email := getStringFromMap(args, "email")
+	password := getStringFromMap(args, "password")
+	if name == "" || email == "" || password == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "name, email, and password are required"}}}, nil
+	}
+
+	user := map[string]interface{}{
+		constants.FieldName:  name,
+		constants.FieldEmail: email,
+		"password":           password,
+	}
+	if profileID := getStringFromMap(args, "profile_id"); profileID != "" {
+		user[constants.FieldProfileID] = profileID
+	}
+	if roleID := getStringFromMap(args, "role_id"); roleID != "" {
+		user[constants.FieldRoleID] = roleID
+	}
+
+	id, err := s.client.CreateUser(ctx, user, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to create user: %v", err)}}}, nil
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Successfully created user '%s' with ID: %s", name, id)}},
+	}, nil
+}
+
+// handleAssignPermissionSet assigns a permission set to a user unless it is already assigned
+func (s *ToolBusService) handleAssignPermissionSet(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	userID := getStringFromMap(args, "user_id")
+	permSetID := getStringFromMap(args, "permission_set_id")
+	if userID == "" || permSetID == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "user_id and permission_set_id are required"}}}, nil
+	}
+
+	assigned, err := s.permissionSetIDs(ctx, userID, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to read permission set assignments: %v", err)}}}, nil
+	}
+	for _, id := range assigned {
+		if id == permSetID {
+			return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Permission set %s is already assigned to user %s", permSetID, userID)}}}, nil
+		}
+	}
+
+	if _, err := s.client.CreateRecord(ctx, constants.TablePermissionSetAssignment, map[string]interface{}{
+		constants.FieldSysPermissionSetAssignment_AssigneeID:      userID,
+		constants.FieldSysPermissionSetAssignment_PermissionSetID: permSetID,
+	}, token); err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to assign permission set: %v", err)}}}, nil
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Assigned permission set %s to user %s", permSetID, userID)}},
+	}, nil
+}
+
+// handleGetEffectivePermissions shows what a user may do on each object and which profile or
+// permission set grants it, so a missing right can be traced to its source
+func (s *ToolBusService) handleGetEffectivePermissions(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	userID := getStringFromMap(args, "user_id")
+	if userID == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "user_id is required"}}}, nil
+	}
+	objectName := getStringFromMap(args, "object_name")
+
+	users, err := s.client.GetUsers(ctx, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to look up user: %v", err)}}}, nil
+	}
+	var user models.SObject
+	for _, u := range users {
+		if getStringFromSObject(u, constants.FieldID) == userID {
+			user = u
+			break
+		}
+	}
+	if user == nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("User %s not found", userID)}}}, nil
+	}
+	profileID := getStringFromSObject(user, constants.FieldProfileID)
+
+	effective, err := s.client.GetEffectivePermissions(ctx, userID, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to get effective permissions: %v", err)}}}, nil
+	}
+
+	type permissionSource struct {
+		Type        string              `json:"type"`
+		ID          string              `json:"id"`
+		Permissions map[string][]string `json:"permissions"`
+	}
+	var sources []permissionSource
+	if profileID != "" {
+		perms, err := s.client.GetProfilePermissions(ctx, profileID, token)
+		if err != nil {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to get profile permissions: %v", err)}}}, nil
+		}
+		sources = append(sources, permissionSource{Type: "profile", ID: profileID, Permissions: summarizeObjectPermissions(perms, objectName)})
+	}
+	permSetIDs, err := s.permissionSetIDs(ctx, userID, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to read permission set assignments: %v", err)}}}, nil
+	}
+	for _, id := range permSetIDs {
+		perms, err := s.client.GetPermissionSetPermissions(ctx, id, token)
+		if err != nil {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to get permission set permissions: %v", err)}}}, nil
+		}
+		sources = append(sources, permissionSource{Type: "permission_set", ID: id, Permissions: summarizeObjectPermissions(perms, objectName)})
+	}
+
+	out := map[string]interface{}{
+		"user_id":    userID,
+		"name":       user[constants.FieldName],
+		"profile_id": profileID,
+		"effective":  summarizeObjectPermissions(effective, objectName),
+		"sources":    sources,
+	}
+	jsonBytes, _ := json.MarshalIndent(out, "", "  ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: string(jsonBytes)}},
+	}, nil
+}
+
+// handleUpdateObjectPermissions changes one object's permissions on a profile or permission set.
+// Flags left out keep their current value.
+func (s *ToolBusService) handleUpdateObjectPermissions(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName := getStringFromMap(args, "object_name")
+	profileID := getStringFromMap(args, "profile_id")
+	permSetID := getStringFromMap(args, "permission_set_id")
+	if objectName == "" || (profileID == "") == (permSetID == "") {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and exactly one of profile_id or permission_set_id are required"}}}, nil
+	}
+
+	var current []models.ObjectPermission
+	if profileID != "" {
+		current, err = s.client.GetProfilePermissions(ctx, profileID, token)
+	} else {
+		current, err = s.client.GetPermissionSetPermissions(ctx, permSetID, token)
+	}
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to get current permissions: %v", err)}}}, nil
+	}
+
+	perm := models.ObjectPermission{ObjectAPIName: objectName}
+	for _, p := range current {
+		if p.ObjectAPIName == objectName {
+			perm = p
+			break
+		}
+	}
+	for key, flag := range map[string]*bool{
+		constants.FieldSysObjectPerms_AllowRead:   &perm.AllowRead,
+		constants.FieldSysObjectPerms_AllowCreate: &perm.AllowCreate,
+		constants.FieldSysObjectPerms_AllowEdit:   &perm.AllowEdit,
+		constants.FieldSysObjectPerms_AllowDelete: &perm.AllowDelete,
+		constants.FieldSysObjectPerms_ViewAll:     &perm.ViewAll,
+		constants.FieldSysObjectPerms_ModifyAll:   &perm.ModifyAll,
+	} {
+		if v, ok := args[key].(bool); ok {
+			*flag = v
+		}
+	}
+
+	perms := []models.ObjectPermission{perm}
+	if profileID != "" {
+		err = s.client.UpdateProfilePermissions(ctx, profileID, perms, token)
+	} else {
+		err = s.client.UpdatePermissionSetPermissions(ctx, permSetID, perms, token)
+	}
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to update permissions: %v", err)}}}, nil
+	}
+
+	granted := strings.Join(summarizeObjectPermissions(perms, "")[objectName], ", ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Updated %s permissions: %s", objectName, granted)}},
+	}, nil
+}
+
+// permissionSetIDs returns the IDs of the permission sets assigned to a user
+func (s *ToolBusService) permissionSetIDs(ctx context.Context, userID, token string) ([]string, error) {
+	records, err := s.client.Query(ctx, models.QueryRequest{
+		ObjectAPIName: constants.TablePermissionSetAssignment,
+		FilterExpr:    fmt.Sprintf("%s == '%s'", constants.FieldSysPermissionSetAssignment_AssigneeID, userID),
+	}, token)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		if id := getStringFromSObject(rec, constants.FieldSysPermissionSetAssignment_PermissionSetID); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// summarizeObjectPermissions maps each object to the rights granted on it, limited to
+// objectName when set
+func summarizeObjectPermissions(perms []models.ObjectPermission, objectName string) map[string][]string {
+	out := make(map[string][]string)
+	for _, p := range perms {
+		if objectName != "" && !strings.EqualFold(p.ObjectAPIName, objectName) {
+			continue
+		}
+		granted := []string{}
+		for _, right := range []struct {
+			name string
+			on   bool
+		}{
+			{"read", p.AllowRead}, {"create", p.AllowCreate}, {"edit", p.AllowEdit},
+			{"delete", p.AllowDelete}, {"view_all", p.ViewAll}, {"modify_all", p.ModifyAll},
+		} {
+			if right.on {
+				granted = append(granted, right.name)
+			}
+		}
+		if len(granted) == 0 {
+			granted = append(granted, "none")
+		}
+		out[p.ObjectAPIName] = granted
+	}
+	return out
+}
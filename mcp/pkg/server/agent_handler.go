@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nexuscrm/mcp/pkg/agent"
@@ -25,15 +27,23 @@ const ObjectAIConversation = constants.TableAIConversation
 // maxTitleLength is the maximum length for auto-generated conversation titles
 const maxTitleLength = 50
 
+// Conversation list page sizes
+const (
+	defaultConversationPageSize = 50
+	maxConversationPageSize     = 100
+)
+
 type AgentHandler struct {
 	agentSvc      *agent.AgentService
 	compactor     *compactor.Compactor
 	contextStore  *contextstore.ContextStore
 	userExtractor func(c *gin.Context) *models.UserSession
-	nexusClient   *client.NexusClient
+	nexusClient   client.API
 }
 
-func NewAgentHandler(userExtractor func(c *gin.Context) *models.UserSession, contextStore *contextstore.ContextStore) *AgentHandler {
+// NewAgentHandler creates the agent chat handler. The agent calls tools through toolBus, so it
+// shares the bus's auditing and rate limits with MCP clients.
+func NewAgentHandler(userExtractor func(c *gin.Context) *models.UserSession, toolBus *ToolBusService, contextStore *contextstore.ContextStore) *AgentHandler {
 	baseURL := os.Getenv("LLM_BASE_URL")
 	if baseURL == "" {
 		baseURL = "http://localhost:1234/v1/chat/completions" // Default to LM Studio usually
@@ -41,13 +51,7 @@ func NewAgentHandler(userExtractor func(c *gin.Context) *models.UserSession, con
 	llmClient := llm.NewOpenAIClient(baseURL, os.Getenv("LLM_API_KEY"))
 
 	// Create Agent Service
-	// ToolBus is now decoupled and uses NexusClient
-	apiBaseURL := "http://localhost:3001" // Default to local
-	if url := os.Getenv("API_BASE_URL"); url != "" {
-		apiBaseURL = url
-	}
-	nexusClient := client.NewNexusClient(apiBaseURL)
-	toolBus := NewToolBusService(nexusClient, contextStore)
+	nexusClient := toolBus.client
 
 	agentSvc := agent.NewAgentService(llmClient, toolBus, contextStore)
 
@@ -60,16 +64,38 @@ func NewAgentHandler(userExtractor func(c *gin.Context) *models.UserSession, con
 	}
 }
 
-// RespondError sends a standardized JSON error response
-func RespondError(c *gin.Context, code int, message string) {
-	c.JSON(code, gin.H{
-		"error":   message,
-		"message": message,
-		"code":    http.StatusText(code), // Simple fallback since we don't have pkg/errors here easily
-		"data":    nil,
+// RespondError sends a standardized JSON error response with the registered error code for the status
+func RespondError(c *gin.Context, status int, message string) {
+	code := errorCodeForStatus(status)
+	c.JSON(status, gin.H{
+		"message":  message,
+		"code":     code,
+		"i18n_key": constants.ErrorCodeI18nKeys[code],
+		"data":     nil,
 	})
 }
 
+// errorCodeForStatus picks the registered error code of the statuses the MCP handlers return
+func errorCodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return constants.ErrorCodeValidationError
+	case http.StatusUnauthorized:
+		return constants.ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return constants.ErrorCodePermissionDenied
+	case http.StatusNotFound:
+		return constants.ErrorCodeNotFound
+	case http.StatusConflict:
+		return constants.ErrorCodeConflict
+	case http.StatusTooManyRequests:
+		return constants.ErrorCodeLimitExceeded
+	case http.StatusInternalServerError:
+		return constants.ErrorCodeInternalError
+	}
+	return constants.ErrorCodeUnknownError
+}
+
 // extractUserAndToken extracts and validates user session and auth token from request
 func (h *AgentHandler) extractUserAndToken(c *gin.Context) (*models.UserSession, string, error) {
 	user := h.userExtractor(c)
@@ -141,9 +167,28 @@ func (h *AgentHandler) ChatStream(c *gin.Context) {
 	// Extract Token using helper
 	token, _ := h.getAuthToken(c)
 
+	// A saved conversation's own settings take precedence over any sent with the request
+	settings := req.Settings
+	if req.ConversationID != "" {
+		record, err := h.nexusClient.GetRecord(c.Request.Context(), ObjectAIConversation, req.ConversationID, token)
+		if err != nil {
+			RespondError(c, http.StatusNotFound, "conversation not found")
+			return
+		}
+		if !verifyOwnership(record, user.ID) {
+			RespondError(c, http.StatusForbidden, "not your conversation")
+			return
+		}
+		settings = conversationSettings(record)
+	}
+	if settings != nil {
+		settings.Normalize()
+	}
+
 	// Create Context with User AND Token for ToolBus (with cancellation)
 	ctxValue := context.WithValue(c.Request.Context(), mcp.ContextKeyUser, user)
 	ctxValue = context.WithValue(ctxValue, mcp.ContextKeyAuthToken, token)
+	ctxValue = agent.WithConversationSettings(ctxValue, settings)
 	ctx, cancel := context.WithCancel(ctxValue)
 	defer cancel()
 
@@ -177,13 +222,13 @@ func (h *AgentHandler) ChatStream(c *gin.Context) {
 
 // GetContext returns the current context for the authenticated user
 func (h *AgentHandler) GetContext(c *gin.Context) {
-	token, err := h.getAuthToken(c)
+	user, _, err := h.extractUserAndToken(c)
 	if err != nil {
 		RespondError(c, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
 
-	session := h.contextStore.GetSession(token)
+	session := h.contextStore.GetSession(user.ID)
 	items := session.ListItems()
 	totalTokens := session.GetTotalTokens()
 
@@ -320,17 +365,42 @@ func (h *AgentHandler) GetConversation(c *gin.Context) {
 		}
 	}
 
+	settings := conversationSettings(record)
+	if settings == nil {
+		settings = &agent.ConversationSettings{}
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"data": gin.H{
 			"conversation": gin.H{
-				constants.FieldID:                      record[constants.FieldID],
-				constants.FieldSysAIConversation_Title: record[constants.FieldSysAIConversation_Title],
+				constants.FieldID:                         record[constants.FieldID],
+				constants.FieldSysAIConversation_Title:    record[constants.FieldSysAIConversation_Title],
+				constants.FieldSysAIConversation_Settings: settings,
 			},
 			"messages": messages,
 		},
 	})
 }
 
+// conversationSettings parses the guardrails stored on a conversation record, or returns nil
+func conversationSettings(record models.SObject) *agent.ConversationSettings {
+	var raw []byte
+	switch v := record[constants.FieldSysAIConversation_Settings].(type) {
+	case string:
+		raw = []byte(v)
+	case map[string]interface{}:
+		raw, _ = json.Marshal(v)
+	default:
+		return nil
+	}
+	var settings agent.ConversationSettings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return nil
+	}
+	settings.Normalize()
+	return &settings
+}
+
 // SaveConversation saves/updates a conversation for the current user
 // If conversation_id provided, updates that conversation
 // If no conversation_id, creates new conversation (sets as active)
@@ -342,9 +412,10 @@ func (h *AgentHandler) SaveConversation(c *gin.Context) {
 	}
 
 	var req struct {
-		ConversationID string        `json:"conversation_id"`
-		Messages       []interface{} `json:"messages"`
-		Title          string        `json:"title"`
+		ConversationID string                      `json:"conversation_id"`
+		Messages       []interface{}               `json:"messages"`
+		Title          string                      `json:"title"`
+		Settings       *agent.ConversationSettings `json:"settings"` // Omitted keeps the saved settings
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		RespondError(c, http.StatusBadRequest, err.Error())
@@ -352,6 +423,11 @@ func (h *AgentHandler) SaveConversation(c *gin.Context) {
 	}
 
 	messagesJSON, _ := json.Marshal(req.Messages)
+	var settingsJSON []byte
+	if req.Settings != nil {
+		req.Settings.Normalize()
+		settingsJSON, _ = json.Marshal(req.Settings)
+	}
 
 	if req.ConversationID != "" {
 		// Update existing conversation
@@ -372,6 +448,9 @@ func (h *AgentHandler) SaveConversation(c *gin.Context) {
 		if req.Title != "" {
 			updateData[constants.FieldSysAIConversation_Title] = req.Title
 		}
+		if settingsJSON != nil {
+			updateData[constants.FieldSysAIConversation_Settings] = string(settingsJSON)
+		}
 		err = h.nexusClient.UpdateRecord(c.Request.Context(), ObjectAIConversation, req.ConversationID, updateData, token)
 		if err != nil {
 			RespondError(c, http.StatusInternalServerError, err.Error())
@@ -407,6 +486,9 @@ func (h *AgentHandler) SaveConversation(c *gin.Context) {
 			constants.FieldSysAIConversation_Messages: string(messagesJSON),
 			constants.FieldIsActive:                   true,
 		}
+		if settingsJSON != nil {
+			createData[constants.FieldSysAIConversation_Settings] = string(settingsJSON)
+		}
 		id, err := h.nexusClient.CreateRecord(c.Request.Context(), ObjectAIConversation, createData, token)
 		if err != nil {
 			RespondError(c, http.StatusInternalServerError, err.Error())
@@ -448,7 +530,8 @@ func (h *AgentHandler) ClearConversation(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": gin.H{"status": "cleared"}})
 }
 
-// ListConversations returns all conversations for the current user
+// ListConversations returns a page of the current user's conversations, most recent first.
+// Query params: limit (default 50, at most 100), offset, and search (matches the title).
 func (h *AgentHandler) ListConversations(c *gin.Context) {
 	user, token, err := h.extractUserAndToken(c)
 	if err != nil {
@@ -456,19 +539,38 @@ func (h *AgentHandler) ListConversations(c *gin.Context) {
 		return
 	}
 
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	if limit <= 0 || limit > maxConversationPageSize {
+		limit = defaultConversationPageSize
+	}
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+
+	filter := fmt.Sprintf("%s == '%s'", constants.FieldUserID, user.ID)
+	if search := strings.TrimSpace(c.Query("search")); search != "" {
+		filter += fmt.Sprintf(" && CONTAINS(LOWER(%s), %s)", constants.FieldSysAIConversation_Title, strconv.Quote(strings.ToLower(search)))
+	}
+
 	queryReq := models.QueryRequest{
 		ObjectAPIName: ObjectAIConversation,
-		FilterExpr:    fmt.Sprintf("%s == '%s'", constants.FieldUserID, user.ID),
+		FilterExpr:    filter,
 		SortField:     constants.FieldLastModifiedDate,
 		SortDirection: constants.SortDESC, // Use constant
-		Limit:         100,
+		Limit:         limit + 1,          // One extra row tells whether another page follows
+		Offset:        offset,
 	}
 
 	records, err := h.nexusClient.Query(c.Request.Context(), queryReq, token)
 	if err != nil {
-		c.JSON(http.StatusOK, gin.H{"data": gin.H{"conversations": []interface{}{}}})
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"conversations": []interface{}{}, "has_more": false}})
 		return
 	}
+	hasMore := len(records) > limit
+	if hasMore {
+		records = records[:limit]
+	}
 
 	// Map to simplified response
 	conversations := make([]gin.H, 0, len(records))
@@ -483,7 +585,7 @@ func (h *AgentHandler) ListConversations(c *gin.Context) {
 		conversations = append(conversations, conv)
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": gin.H{"conversations": conversations}})
+	c.JSON(http.StatusOK, gin.H{"data": gin.H{"conversations": conversations, "has_more": hasMore}})
 }
 
 // DeleteConversation deletes a specific conversation
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/contextstore"
+	"github.com/nexuscrm/mcp/pkg/models"
+)
+
+const (
+	// maxSnapshotTokens caps one pinned snapshot; longer snapshots are truncated
+	maxSnapshotTokens = 2000
+	// maxSnapshotRows caps how many records a pinned list view or query captures
+	maxSnapshotRows = 50
+)
+
+// Pinned CRM data is keyed by nexus:// URIs. Records share the URI of their MCP resource.
+func recordSnapshotURI(objectName, id string) string {
+	return fmt.Sprintf("%srecords/%s/%s", contextstore.SnapshotPrefix, objectName, id)
+}
+
+func listViewSnapshotURI(objectName, id string) string {
+	return fmt.Sprintf("%slistviews/%s/%s", contextstore.SnapshotPrefix, objectName, id)
+}
+
+func savedSearchSnapshotURI(id string) string {
+	return fmt.Sprintf("%ssearches/%s", contextstore.SnapshotPrefix, id)
+}
+
+func querySnapshotURI(objectName, filter string, limit int) string {
+	q := url.Values{}
+	if filter != "" {
+		q.Set("filter", filter)
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	return fmt.Sprintf("%squery/%s?%s", contextstore.SnapshotPrefix, objectName, q.Encode())
+}
+
+// snapshot fetches the current data behind a pinned URI as prompt-ready text
+func (s *ToolBusService) snapshot(ctx context.Context, uri string, token string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid context URI %s", uri)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	var sb strings.Builder
+	switch {
+	case u.Host == "records" && len(parts) == 2:
+		record, err := s.client.GetRecord(ctx, parts[0], parts[1], token)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "%s record %s:\n", parts[0], parts[1])
+		writePromptRecords(&sb, []models.SObject{record})
+
+	case u.Host == "listviews" && len(parts) == 2:
+		views, err := s.client.GetListViews(ctx, parts[0], token)
+		if err != nil {
+			return "", err
+		}
+		var view *models.ListView
+		for i := range views {
+			if views[i].ID == parts[1] {
+				view = &views[i]
+			}
+		}
+		if view == nil {
+			return "", fmt.Errorf("list view %s not found on %s", parts[1], parts[0])
+		}
+		records, err := s.client.Query(ctx, models.QueryRequest{
+			ObjectAPIName: parts[0],
+			FilterExpr:    view.FilterExpr,
+			SortField:     view.SortField,
+			SortDirection: view.SortDirection,
+			Limit:         maxSnapshotRows,
+		}, token)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "%s list view %q (%d records):\n", parts[0], view.Label, len(records))
+		writePromptRecords(&sb, records)
+
+	case u.Host == "searches" && len(parts) == 1:
+		results, err := s.client.RunSavedSearch(ctx, parts[0], token)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "Saved search %s (%d result groups):\n", parts[0], len(results))
+		for _, r := range results {
+			line, _ := json.Marshal(r)
+			sb.Write(line)
+			sb.WriteByte('\n')
+		}
+
+	case u.Host == "query" && len(parts) == 1:
+		limit, _ := strconv.Atoi(u.Query().Get("limit"))
+		if limit <= 0 || limit > maxSnapshotRows {
+			limit = maxSnapshotRows
+		}
+		filter := u.Query().Get("filter")
+		records, err := s.client.Query(ctx, models.QueryRequest{
+			ObjectAPIName: parts[0],
+			FilterExpr:    filter,
+			Limit:         limit,
+		}, token)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "%s records matching %q (%d):\n", parts[0], filter, len(records))
+		writePromptRecords(&sb, records)
+
+	default:
+		return "", fmt.Errorf("unsupported context URI %s", uri)
+	}
+	return truncateToTokens(sb.String(), maxSnapshotTokens), nil
+}
+
+// pinSnapshot captures the data behind uri and pins it to the session
+func (s *ToolBusService) pinSnapshot(ctx context.Context, session *contextstore.SessionContext, uri string, token string) error {
+	content, err := s.snapshot(ctx, uri, token)
+	if err != nil {
+		return err
+	}
+	return session.Put(contextstore.ContextItem{Path: uri, Content: content, TokenSize: contextstore.EstimateTokens(content)})
+}
+
+// RefreshContext re-fetches every CRM snapshot pinned to the caller's context, so the agent
+// sees current data. A snapshot that can no longer be fetched keeps its last content.
+func (s *ToolBusService) RefreshContext(ctx context.Context) {
+	if s.contextStore == nil {
+		return
+	}
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return
+	}
+	session := s.contextStore.GetSession(contextSessionID(ctx, token))
+	for _, item := range session.ListItems() {
+		if !item.IsSnapshot() {
+			continue
+		}
+		content, err := s.snapshot(ctx, item.Path, token)
+		if err != nil {
+			log.Printf("⚠️ [Context] Failed to refresh %s: %v", item.Path, err)
+			continue
+		}
+		if content == item.Content {
+			continue
+		}
+		item.Content, item.TokenSize = content, contextstore.EstimateTokens(content)
+		if err := session.Put(item); err != nil {
+			log.Printf("⚠️ [Context] Failed to save %s: %v", item.Path, err)
+		}
+	}
+}
+
+// snapshotURIs turns the CRM arguments of context_add into the URIs to pin
+func snapshotURIs(args map[string]interface{}) ([]string, []string) {
+	var uris, problems []string
+	for _, raw := range objectArgs(args, "records") {
+		object, id := getStringFromMap(raw, "object"), getStringFromMap(raw, "id")
+		if object == "" || id == "" {
+			problems = append(problems, "records need object and id")
+			continue
+		}
+		uris = append(uris, recordSnapshotURI(strings.ToLower(object), id))
+	}
+	for _, raw := range objectArgs(args, "list_views") {
+		object, id := getStringFromMap(raw, "object"), getStringFromMap(raw, "id")
+		if object == "" || id == "" {
+			problems = append(problems, "list_views need object and id")
+			continue
+		}
+		uris = append(uris, listViewSnapshotURI(strings.ToLower(object), id))
+	}
+	if ids, ok := args["saved_searches"].([]interface{}); ok {
+		for _, raw := range ids {
+			if id, ok := raw.(string); ok && id != "" {
+				uris = append(uris, savedSearchSnapshotURI(id))
+			}
+		}
+	}
+	for _, raw := range objectArgs(args, "queries") {
+		object := getStringFromMap(raw, "object")
+		if object == "" {
+			problems = append(problems, "queries need object")
+			continue
+		}
+		limit := maxSnapshotRows
+		if l, ok := raw["limit"].(float64); ok && l > 0 && int(l) < limit {
+			limit = int(l)
+		}
+		uris = append(uris, querySnapshotURI(strings.ToLower(object), getStringFromMap(raw, "filter"), limit))
+	}
+	return uris, problems
+}
+
+// objectArgs returns the objects of an array argument, skipping other elements
+func objectArgs(args map[string]interface{}, key string) []map[string]interface{} {
+	raw, _ := args[key].([]interface{})
+	objects := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		if m, ok := r.(map[string]interface{}); ok {
+			objects = append(objects, m)
+		}
+	}
+	return objects
+}
+
+// truncateToTokens shortens content to roughly budget tokens
+func truncateToTokens(content string, budget int) string {
+	if contextstore.EstimateTokens(content) <= budget {
+		return content
+	}
+	return strings.ToValidUTF8(content[:budget*4], "") + "\n… (truncated)"
+}
+
+// contextPinSchema describes the CRM data context_add can pin
+var contextPinSchema = map[string]interface{}{
+	"records": map[string]interface{}{
+		"type":        "array",
+		"description": "Records to pin, e.g. [{\"object\": \"account\", \"id\": \"...\"}]",
+		"items":       objectRefSchema,
+	},
+	"list_views": map[string]interface{}{
+		"type":        "array",
+		"description": "List views to pin; their current records are captured",
+		"items":       objectRefSchema,
+	},
+	"saved_searches": map[string]interface{}{
+		"type":        "array",
+		"items":       map[string]interface{}{"type": "string"},
+		"description": "IDs of saved searches to pin; their current results are captured",
+	},
+	"queries": map[string]interface{}{
+		"type":        "array",
+		"description": "Queries to pin; their current results are captured",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object": map[string]interface{}{"type": "string"},
+				"filter": map[string]interface{}{"type": "string", "description": "Formula filter expression"},
+				"limit":  map[string]interface{}{"type": "integer", "description": fmt.Sprintf("Max records (at most %d)", maxSnapshotRows)},
+			},
+			"required": []string{"object"},
+		},
+	},
+}
+
+var objectRefSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"object": map[string]interface{}{"type": "string"},
+		"id":     map[string]interface{}{"type": "string"},
+	},
+	"required": []string{"object", "id"},
+}
@@ -3,26 +3,36 @@ package server
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 
+	"github.com/nexuscrm/mcp/pkg/contextstore"
 	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
 )
 
+// contextSessionID keys the agent context by user, so it survives signing in again;
+// callers without a user session are keyed by their token
+func contextSessionID(ctx context.Context, token string) string {
+	if user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession); user != nil {
+		return user.ID
+	}
+	return token
+}
+
 func (s *ToolBusService) handleContextAdd(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
 	token, err := s.getAuthToken(ctx)
 	if err != nil {
 		return mcp.CallToolResult{}, err
 	}
 
-	filesRaw, ok := req.Arguments["files"].([]interface{})
-	if !ok {
-		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "files array is required"}}}, nil
+	filesRaw, _ := req.Arguments["files"].([]interface{})
+	uris, errors := snapshotURIs(req.Arguments)
+	if len(filesRaw) == 0 && len(uris) == 0 && len(errors) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "Provide files, records, list_views, saved_searches or queries to add"}}}, nil
 	}
 
-	session := s.contextStore.GetSession(token)
+	session := s.contextStore.GetSession(contextSessionID(ctx, token))
 	var added []string
-	var errors []string
 
 	for _, f := range filesRaw {
 		path, ok := f.(string)
@@ -35,8 +45,18 @@ func (s *ToolBusService) handleContextAdd(ctx context.Context, req mcp.CallToolP
 			added = append(added, path)
 		}
 	}
+	for _, uri := range uris {
+		if err := s.pinSnapshot(ctx, session, uri, token); err != nil {
+			errors = append(errors, fmt.Sprintf("Failed to pin %s: %v", uri, err))
+		} else {
+			added = append(added, uri)
+		}
+	}
 
-	msg := fmt.Sprintf("Added %d files to context.", len(added))
+	msg := fmt.Sprintf("Added %d items to context.", len(added))
+	if len(added) > 0 {
+		msg += "\n" + strings.Join(added, "\n")
+	}
 	if len(errors) > 0 {
 		msg += fmt.Sprintf("\nErrors:\n%s", strings.Join(errors, "\n"))
 	}
@@ -57,7 +77,7 @@ func (s *ToolBusService) handleContextRemove(ctx context.Context, req mcp.CallTo
 		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "files array is required"}}}, nil
 	}
 
-	session := s.contextStore.GetSession(token)
+	session := s.contextStore.GetSession(contextSessionID(ctx, token))
 	count := 0
 	for _, f := range filesRaw {
 		path, ok := f.(string)
@@ -79,20 +99,22 @@ func (s *ToolBusService) handleContextList(ctx context.Context, req mcp.CallTool
 		return mcp.CallToolResult{}, err
 	}
 
-	session := s.contextStore.GetSession(token)
-	items := session.ListItems()
+	s.RefreshContext(ctx)
+	session := s.contextStore.GetSession(contextSessionID(ctx, token))
+	included, omitted := session.WithinBudget(contextstore.DefaultTokenBudget)
 	totalTokens := session.GetTotalTokens()
 
-	// Sort by path
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].Path < items[j].Path
-	})
-
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("Active Context (%d files, ~%d tokens):\n", len(items), totalTokens))
-	for _, item := range items {
+	sb.WriteString(fmt.Sprintf("Active Context (%d items, ~%d tokens):\n", len(included)+len(omitted), totalTokens))
+	for _, item := range included {
 		sb.WriteString(fmt.Sprintf("- %s (~%d tokens)\n", item.Path, item.TokenSize))
 	}
+	if len(omitted) > 0 {
+		sb.WriteString(fmt.Sprintf("Over the %d token budget, not sent to the agent:\n", contextstore.DefaultTokenBudget))
+		for _, item := range omitted {
+			sb.WriteString(fmt.Sprintf("- %s (~%d tokens)\n", item.Path, item.TokenSize))
+		}
+	}
 
 	return mcp.CallToolResult{
 		Content: []mcp.Content{{Type: "text", Text: sb.String()}},
@@ -105,7 +127,7 @@ func (s *ToolBusService) handleContextClear(ctx context.Context, req mcp.CallToo
 		return mcp.CallToolResult{}, err
 	}
 
-	session := s.contextStore.GetSession(token)
+	session := s.contextStore.GetSession(contextSessionID(ctx, token))
 	session.Clear()
 
 	return mcp.CallToolResult{
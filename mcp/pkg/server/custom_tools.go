@@ -0,0 +1,66 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+)
+
+// customTool is a tool added with RegisterTool
+type customTool struct {
+	tool    mcp.Tool
+	handler ToolHandler
+}
+
+// RegisterTool adds a tool served by handler, such as one contributed by a server extension.
+// Custom tools are listed after the built-in ones and pass through the same middleware
+// (audit, rate limit, conversation settings); a name already taken is rejected.
+func (s *ToolBusService) RegisterTool(tool mcp.Tool, handler ToolHandler) error {
+	tool.Name = strings.TrimSpace(tool.Name)
+	if tool.Name == "" || handler == nil {
+		return fmt.Errorf("a tool needs a name and a handler")
+	}
+	if tool.InputSchema == nil {
+		tool.InputSchema = map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}
+	}
+
+	s.customMu.Lock()
+	defer s.customMu.Unlock()
+	if isBuiltinTool(tool.Name) || s.customTools[tool.Name] != nil {
+		return fmt.Errorf("tool '%s' is already registered", tool.Name)
+	}
+	if s.customTools == nil {
+		s.customTools = make(map[string]*customTool)
+	}
+	s.customTools[tool.Name] = &customTool{tool: tool, handler: handler}
+	s.customOrder = append(s.customOrder, tool.Name)
+	return nil
+}
+
+// isBuiltinTool reports whether name is the name of a built-in tool
+func isBuiltinTool(name string) bool {
+	return slices.ContainsFunc(builtinTools(), func(t mcp.Tool) bool { return t.Name == name })
+}
+
+// customToolList returns the custom tools in registration order
+func (s *ToolBusService) customToolList() []mcp.Tool {
+	s.customMu.RLock()
+	defer s.customMu.RUnlock()
+	tools := make([]mcp.Tool, 0, len(s.customOrder))
+	for _, name := range s.customOrder {
+		tools = append(tools, s.customTools[name].tool)
+	}
+	return tools
+}
+
+// customToolHandler returns the handler of a custom tool, or nil
+func (s *ToolBusService) customToolHandler(name string) ToolHandler {
+	s.customMu.RLock()
+	defer s.customMu.RUnlock()
+	if tool := s.customTools[name]; tool != nil {
+		return tool.handler
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+// flowToolFields are the flow arguments passed through to the flows API as given
+var flowToolFields = []string{
+	"name", "description", "status", "trigger_object", "trigger_type",
+	"trigger_condition", "action_type", "action_config", "steps",
+}
+
+// flowSummary is the compact view list_flows returns for each flow
+type flowSummary struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	FlowType         string `json:"flow_type"`
+	TriggerObject    string `json:"trigger_object"`
+	TriggerType      string `json:"trigger_type"`
+	TriggerCondition string `json:"trigger_condition,omitempty"`
+	ActionType       string `json:"action_type,omitempty"`
+	StepCount        int    `json:"step_count,omitempty"`
+}
+
+// handleListFlows lists flows, optionally narrowed to one trigger object and status
+func (s *ToolBusService) handleListFlows(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	flows, err := s.client.ListFlows(ctx, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to list flows: %v", err)}}}, nil
+	}
+
+	objectName := getStringFromMap(args, "object_name")
+	status := getStringFromMap(args, "status")
+	summaries := []flowSummary{}
+	for _, f := range flows {
+		if objectName != "" && !strings.EqualFold(f.TriggerObject, objectName) {
+			continue
+		}
+		if status != "" && !strings.EqualFold(f.Status, status) {
+			continue
+		}
+		summaries = append(summaries, flowSummary{
+			ID:               f.ID,
+			Name:             f.Name,
+			Status:           f.Status,
+			FlowType:         f.FlowType,
+			TriggerObject:    f.TriggerObject,
+			TriggerType:      f.TriggerType,
+			TriggerCondition: f.TriggerCondition,
+			ActionType:       f.ActionType,
+			StepCount:        len(f.Steps),
+		})
+	}
+
+	jsonBytes, _ := json.MarshalIndent(summaries, "", "  ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Found %d flow(s):\n%s", len(summaries), string(jsonBytes))}},
+	}, nil
+}
+
+// handleCreateFlow creates a flow; it is multistep when steps are given and Active by default
+func (s *ToolBusService) handleCreateFlow(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	flow := flowArguments(args)
+	if flow["name"] == nil || flow["trigger_object"] == nil || flow["trigger_type"] == nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "name, trigger_object, and trigger_type are required"}}}, nil
+	}
+	if _, ok := flow["status"]; !ok {
+		flow["status"] = constants.FlowStatusActive
+	}
+	if _, ok := flow["flow_type"]; !ok {
+		flow["flow_type"] = constants.FlowTypeSimple
+	}
+	if flow["flow_type"] == constants.FlowTypeSimple && flow["action_type"] == nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "action_type is required unless steps are given"}}}, nil
+	}
+
+	created, err := s.client.CreateFlow(ctx, flow, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to create flow: %v", err)}}}, nil
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Successfully created %s flow '%s' (%s) with ID: %s", created.FlowType, created.Name, created.Status, created.ID)}},
+	}, nil
+}
+
+// handleUpdateFlow sends only the fields provided, so omitted settings and steps are kept
+func (s *ToolBusService) handleUpdateFlow(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	id := getStringFromMap(args, "id")
+	if id == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "id is required"}}}, nil
+	}
+	updates := flowArguments(args)
+	if len(updates) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "No fields to update"}}}, nil
+	}
+
+	updated, err := s.client.UpdateFlow(ctx, id, updates, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to update flow: %v", err)}}}, nil
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Successfully updated flow '%s' (%s)", updated.Name, updated.Status)}},
+	}, nil
+}
+
+// flowArguments picks the flow fields out of the tool arguments. Giving steps makes the flow
+// multistep, since the backend only runs steps for multistep flows.
+func flowArguments(args map[string]interface{}) map[string]interface{} {
+	flow := make(map[string]interface{})
+	for _, key := range flowToolFields {
+		if v, ok := args[key]; ok && v != nil && v != "" {
+			flow[key] = v
+		}
+	}
+	if steps, ok := flow["steps"].([]interface{}); ok && len(steps) > 0 {
+		flow["flow_type"] = constants.FlowTypeMultistep
+	}
+	return flow
+}
+
+// handleSubmitForApproval submits a record to the approval process of its object
+func (s *ToolBusService) handleSubmitForApproval(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName := getStringFromMap(args, "object_name")
+	recordID := getStringFromMap(args, "record_id")
+	if objectName == "" || recordID == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and record_id are required"}}}, nil
+	}
+
+	workItemID, err := s.client.SubmitForApproval(ctx, objectName, recordID, getStringFromMap(args, "comments"), token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to submit for approval: %v", err)}}}, nil
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Submitted %s %s for approval. Work item ID: %s", objectName, recordID, workItemID)}},
+	}, nil
+}
+
+// handleApproveWorkItem approves or rejects a pending work item
+func (s *ToolBusService) handleApproveWorkItem(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	workItemID := getStringFromMap(args, "work_item_id")
+	if workItemID == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "work_item_id is required"}}}, nil
+	}
+	decision := strings.ToLower(getStringFromMap(args, "decision"))
+	if decision == "" {
+		decision = "approve"
+	}
+	if decision != "approve" && decision != "reject" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "decision must be 'approve' or 'reject'"}}}, nil
+	}
+
+	if err := s.client.DecideWorkItem(ctx, workItemID, decision == "approve", getStringFromMap(args, "comments"), token); err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to %s work item: %v", decision, err)}}}, nil
+	}
+
+	verb := "Approved"
+	if decision == "reject" {
+		verb = "Rejected"
+	}
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("%s work item %s", verb, workItemID)}},
+	}, nil
+}
+
+// handleListPendingApprovals lists the work items waiting for the caller's decision
+func (s *ToolBusService) handleListPendingApprovals(ctx context.Context, _ map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	items, err := s.client.GetPendingApprovals(ctx, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Failed to list pending approvals: %v", err)}}}, nil
+	}
+	if len(items) == 0 {
+		return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: "No approvals are waiting for you."}}}, nil
+	}
+
+	jsonBytes, _ := json.MarshalIndent(items, "", "  ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("%d approval(s) waiting:\n%s", len(items), string(jsonBytes))}},
+	}, nil
+}
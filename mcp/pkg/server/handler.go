@@ -9,16 +9,39 @@ import (
 	"github.com/nexuscrm/mcp/pkg/mcp"
 )
 
-// NewHandler creates a new HTTP handler for the MCP server
-func NewHandler(bus *ToolBusService) http.Handler {
+// NewHandler creates a new HTTP handler for the MCP server. resources may be nil,
+// in which case only tools are served.
+func NewHandler(bus *ToolBusService, resources *ResourceService) http.Handler {
 	// 2. Create MCP Server
 	server := mcp.NewServer()
 
+	capabilities := mcp.ServerCapabilities{Tools: &mcp.ToolsCapability{}, Prompts: &mcp.PromptsCapability{}}
+
 	// 3. Register Routes
 	server.Register("tools/list", bus.HandleListTools)
 	server.Register("tools/call", bus.HandleCallTool)
+	server.Register("prompts/list", bus.HandleListPrompts)
+	server.Register("prompts/get", bus.HandleGetPrompt)
+
+	if resources != nil {
+		resources.notifier = server
+		server.OnStreamClose(resources.dropSession)
+		server.Register("resources/list", resources.HandleListResources)
+		server.Register("resources/templates/list", resources.HandleListResourceTemplates)
+		server.Register("resources/read", resources.HandleReadResource)
+		server.Register("resources/subscribe", resources.HandleSubscribe)
+		server.Register("resources/unsubscribe", resources.HandleUnsubscribe)
+		capabilities.Resources = &mcp.ResourcesCapability{Subscribe: true}
+	}
 
 	// Add other standard routes
+	server.Register("initialize", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		return mcp.InitializeResult{
+			ProtocolVersion: mcp.ProtocolVersion,
+			Capabilities:    capabilities,
+			ServerInfo:      mcp.Implementation{Name: "nexuscrm", Version: "1.0.0"},
+		}, nil
+	})
 	server.Register("ping", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
 		return "pong", nil
 	})
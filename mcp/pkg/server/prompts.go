@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+const (
+	PromptSummarizePipeline = "summarize_pipeline"
+	PromptDraftFollowUp     = "draft_follow_up_email"
+
+	// maxPromptRecords caps how many records a prompt embeds
+	maxPromptRecords = 100
+	// systemFieldPrefix marks platform-managed fields, which prompts leave out
+	systemFieldPrefix = "__sys_gen_"
+)
+
+// prompts are the templates served by prompts/list; prompts/get fills them with live data
+var prompts = []mcp.Prompt{
+	{
+		Name:        PromptSummarizePipeline,
+		Description: "Summarize the sales pipeline owned by a user: totals by stage, deals at risk and next steps.",
+		Arguments: []mcp.PromptArgument{
+			{Name: "owner", Description: "User ID, email or name of the owner; 'me' for yourself", Required: true},
+			{Name: "object", Description: "Object holding the deals (default 'opportunity')"},
+		},
+	},
+	{
+		Name:        PromptDraftFollowUp,
+		Description: "Draft a follow-up email about a record, using its current data.",
+		Arguments: []mcp.PromptArgument{
+			{Name: "object", Description: "API name of the record's object (e.g. 'lead')", Required: true},
+			{Name: "record_id", Description: "ID of the record", Required: true},
+			{Name: "goal", Description: "What the email should achieve (e.g. 'book a demo')"},
+		},
+	},
+}
+
+// HandleListPrompts returns the prompt templates
+func (s *ToolBusService) HandleListPrompts(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return mcp.ListPromptsResult{Prompts: prompts}, nil
+}
+
+// HandleGetPrompt renders a prompt template with data fetched for the caller
+func (s *ToolBusService) HandleGetPrompt(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var req mcp.GetPromptParams
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: "Invalid params"}
+	}
+
+	var prompt *mcp.Prompt
+	for i := range prompts {
+		if prompts[i].Name == req.Name {
+			prompt = &prompts[i]
+		}
+	}
+	if prompt == nil {
+		return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: fmt.Sprintf("Prompt '%s' not found", req.Name)}
+	}
+	for _, arg := range prompt.Arguments {
+		if arg.Required && strings.TrimSpace(req.Arguments[arg.Name]) == "" {
+			return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: fmt.Sprintf("Missing required argument '%s'", arg.Name)}
+		}
+	}
+
+	var text string
+	switch req.Name {
+	case PromptSummarizePipeline:
+		text, err = s.pipelinePrompt(ctx, req.Arguments, token)
+	case PromptDraftFollowUp:
+		text, err = s.followUpPrompt(ctx, req.Arguments, token)
+	}
+	if err != nil {
+		return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: err.Error()}
+	}
+
+	return mcp.GetPromptResult{
+		Description: prompt.Description,
+		Messages:    []mcp.PromptMessage{{Role: "user", Content: mcp.Content{Type: "text", Text: text}}},
+	}, nil
+}
+
+func (s *ToolBusService) pipelinePrompt(ctx context.Context, args map[string]string, token string) (string, error) {
+	objectName := args["object"]
+	if objectName == "" {
+		objectName = "opportunity"
+	}
+	ownerID, ownerName, err := s.resolvePromptUser(ctx, args["owner"], token)
+	if err != nil {
+		return "", err
+	}
+
+	records, err := s.client.Query(ctx, models.QueryRequest{
+		ObjectAPIName: objectName,
+		FilterExpr:    fmt.Sprintf("%s == '%s'", constants.FieldOwnerID, ownerID),
+		SortField:     constants.FieldLastModifiedDate,
+		SortDirection: constants.SortDESC,
+		Limit:         maxPromptRecords,
+	}, token)
+	if err != nil {
+		return "", fmt.Errorf("failed to load %s records: %v", objectName, err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Summarize the sales pipeline owned by %s.\n\n", ownerName)
+	sb.WriteString("Cover the total value and count by stage, deals closing soon, deals that look stalled or at risk, and recommended next steps. Be concise and use the data below only.\n\n")
+	fmt.Fprintf(&sb, "%s records owned by %s (%d, most recently changed first", objectName, ownerName, len(records))
+	if len(records) == maxPromptRecords {
+		sb.WriteString(", truncated")
+	}
+	sb.WriteString("):\n")
+	writePromptRecords(&sb, records)
+	return sb.String(), nil
+}
+
+func (s *ToolBusService) followUpPrompt(ctx context.Context, args map[string]string, token string) (string, error) {
+	objectName, recordID := args["object"], args["record_id"]
+	record, err := s.client.GetRecord(ctx, objectName, recordID, token)
+	if err != nil {
+		return "", fmt.Errorf("failed to load %s %s: %v", objectName, recordID, err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Draft a short, friendly follow-up email about this %s record.\n", objectName)
+	if goal := args["goal"]; goal != "" {
+		fmt.Fprintf(&sb, "The goal of the email: %s.\n", goal)
+	}
+	if user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession); user != nil && user.Name != "" {
+		fmt.Fprintf(&sb, "Sign it as %s.\n", user.Name)
+	}
+	sb.WriteString("Address the recipient by name if the record has one, refer to specifics from the record, and give the email a subject line.\n\nRecord:\n")
+	writePromptRecords(&sb, []models.SObject{record})
+	return sb.String(), nil
+}
+
+// resolvePromptUser finds a user by ID, email or name ("me" is the caller) and returns
+// their ID and display name
+func (s *ToolBusService) resolvePromptUser(ctx context.Context, who string, token string) (string, string, error) {
+	if strings.EqualFold(who, "me") {
+		user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession)
+		if user == nil {
+			return "", "", fmt.Errorf("no signed-in user for 'me'")
+		}
+		return user.ID, user.Name, nil
+	}
+
+	users, err := s.client.GetUsers(ctx, token)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to look up users: %v", err)
+	}
+	for _, u := range users {
+		if getStringFromSObject(u, constants.FieldID) == who ||
+			strings.EqualFold(getStringFromSObject(u, constants.FieldEmail), who) ||
+			strings.EqualFold(getStringFromSObject(u, constants.FieldName), who) {
+			return getStringFromSObject(u, constants.FieldID), getStringFromSObject(u, constants.FieldName), nil
+		}
+	}
+	return "", "", fmt.Errorf("no user matches '%s'", who)
+}
+
+// writePromptRecords writes one JSON line per record, leaving out system fields other than the ID
+func writePromptRecords(sb *strings.Builder, records []models.SObject) {
+	for _, rec := range records {
+		clean := make(models.SObject, len(rec))
+		for k, v := range rec {
+			if v == nil || (strings.HasPrefix(k, systemFieldPrefix) && k != constants.FieldID) {
+				continue
+			}
+			clean[k] = v
+		}
+		line, _ := json.Marshal(clean)
+		sb.Write(line)
+		sb.WriteByte('\n')
+	}
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
+	"github.com/nexuscrm/shared/pkg/constants"
+)
+
+const (
+	defaultReportToolLimit = 50
+	maxReportToolLimit     = 500
+	reportCountColumn      = "count"
+)
+
+// handleRunReport runs an unsaved summary report without detail rows and returns its leaf
+// groups as one table, so a multi-dimension summary takes a single call
+func (s *ToolBusService) handleRunReport(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName, _ := args["object_name"].(string)
+	var groupBy []string
+	if items, ok := args["group_by"].([]interface{}); ok {
+		for _, item := range items {
+			if g, ok := item.(string); ok && g != "" {
+				groupBy = append(groupBy, g)
+			}
+		}
+	}
+	if objectName == "" || len(groupBy) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and group_by are required"}}}, nil
+	}
+
+	report := models.Report{
+		Name:          "run_report",
+		ObjectAPIName: objectName,
+		Format:        constants.ReportFormatSummary,
+		Groupings:     groupBy,
+		FilterExpr:    getStringFromMap(args, "filter"),
+	}
+	if items, ok := args["aggregates"].([]interface{}); ok {
+		for i, item := range items {
+			agg, _ := item.(map[string]interface{})
+			fn := getStringFromMap(agg, "function")
+			field := getStringFromMap(agg, "field")
+			if fn == "" || field == "" {
+				return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("aggregates[%d] requires function and field", i)}}}, nil
+			}
+			report.Aggregates = append(report.Aggregates, models.ListViewAggregate{Function: strings.ToUpper(fn), Field: field})
+		}
+	}
+
+	limit := defaultReportToolLimit
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = min(int(l), maxReportToolLimit)
+	}
+
+	result, err := s.client.RunReport(ctx, report, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Report failed: %v", err)}}}, nil
+	}
+
+	dimensions := make([]string, 0, len(result.Groupings))
+	for _, g := range result.Groupings {
+		dimensions = append(dimensions, g.Key)
+	}
+	columns := append(append(append([]string{}, dimensions...), reportCountColumn), result.Aggregates...)
+
+	var rows []models.SObject
+	flattenReportGroups(result.Groups, models.SObject{}, &rows)
+
+	if sortBy := getStringFromMap(args, "sort_by"); sortBy != "" {
+		if !slices.Contains(columns, sortBy) {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("sort_by must be one of: %s", strings.Join(columns, ", "))}}}, nil
+		}
+		desc := !slices.Contains(dimensions, sortBy)
+		if order := getStringFromMap(args, "sort_order"); order != "" {
+			desc = strings.EqualFold(order, constants.SortDESC)
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			if desc {
+				return lessReportCell(rows[j][sortBy], rows[i][sortBy])
+			}
+			return lessReportCell(rows[i][sortBy], rows[j][sortBy])
+		})
+	}
+
+	return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: renderReportTable(objectName, result, columns, rows, limit)}}}, nil
+}
+
+// flattenReportGroups turns the group tree into one row per leaf group holding its group
+// values, count and aggregates
+func flattenReportGroups(groups []models.ReportGroup, prefix models.SObject, out *[]models.SObject) {
+	for _, g := range groups {
+		row := make(models.SObject, len(prefix)+2+len(g.Aggregates))
+		for k, v := range prefix {
+			row[k] = v
+		}
+		row[g.Field] = g.Value
+		if len(g.Groups) > 0 {
+			flattenReportGroups(g.Groups, row, out)
+			continue
+		}
+		row[reportCountColumn] = g.Count
+		for k, v := range g.Aggregates {
+			row[k] = v
+		}
+		*out = append(*out, row)
+	}
+}
+
+// renderReportTable writes up to limit rows as a markdown table followed by the grand totals
+func renderReportTable(objectName string, result *models.ReportResult, columns []string, rows []models.SObject, limit int) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %d record(s) in %d group(s)\n\n", objectName, result.TotalCount, len(rows))
+	if len(rows) == 0 {
+		sb.WriteString("No records matched.")
+		return sb.String()
+	}
+
+	sb.WriteString("| " + strings.Join(columns, " | ") + " |\n")
+	sb.WriteString(strings.Repeat("|---", len(columns)) + "|\n")
+	for i, row := range rows {
+		if i == limit {
+			break
+		}
+		cells := make([]string, len(columns))
+		for j, col := range columns {
+			cells[j] = formatReportCell(row[col])
+		}
+		sb.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	totals := []string{fmt.Sprintf("%s=%d", reportCountColumn, result.TotalCount)}
+	for _, key := range result.Aggregates {
+		totals = append(totals, fmt.Sprintf("%s=%s", key, formatReportCell(result.GrandTotals[key])))
+	}
+	sb.WriteString("\nTotals: " + strings.Join(totals, ", "))
+
+	if len(rows) > limit {
+		fmt.Fprintf(&sb, "\nShowing %d of %d groups; raise limit or add a filter to see the rest.", limit, len(rows))
+	}
+	if result.Truncated {
+		sb.WriteString("\nThe server capped the number of groups; add a filter or group by fewer fields for complete results.")
+	}
+	return sb.String()
+}
+
+// formatReportCell renders a value compactly: integral numbers without decimals, others with two
+func formatReportCell(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "(none)"
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < 1e15 {
+			return strconv.FormatInt(int64(val), 10)
+		}
+		return strconv.FormatFloat(val, 'f', 2, 64)
+	case string:
+		return strings.ReplaceAll(val, "|", "\\|")
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// lessReportCell orders cell values: missing values first, then numerically when both sides
+// are numbers, otherwise as text
+func lessReportCell(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	if x, ok := reportCellNumber(a); ok {
+		if y, ok := reportCellNumber(b); ok {
+			return x < y
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func reportCellNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,259 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/nexuscrm/mcp/pkg/client"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+)
+
+const (
+	resourceURIPrefix = "nexus://"
+	resourceMimeType  = "application/json"
+
+	// notificationResourceUpdated tells a subscribed client to re-read a resource
+	notificationResourceUpdated = "notifications/resources/updated"
+)
+
+// ResourceService exposes CRM metadata and records as MCP resources:
+//
+//	nexus://objects/{object}          object schema
+//	nexus://objects/{object}/layout   page layout for the caller's profile
+//	nexus://dashboards/{id}           dashboard definition
+//	nexus://recent[/{object}]         the caller's recently viewed records
+//	nexus://records/{object}/{id}     a single record
+//
+// Clients may subscribe to any of them; record resources are pushed a
+// notifications/resources/updated message when the record changes.
+type ResourceService struct {
+	client client.API
+
+	// notifier delivers notifications to sessions; set by NewHandler
+	notifier *mcp.Server
+
+	mu            sync.Mutex
+	subscriptions map[string]map[string]bool // uri -> subscribed session IDs
+}
+
+func NewResourceService(client client.API) *ResourceService {
+	return &ResourceService{
+		client:        client,
+		subscriptions: make(map[string]map[string]bool),
+	}
+}
+
+// HandleListResources lists every object schema and layout, every dashboard and the recent records
+func (s *ResourceService) HandleListResources(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	token, err := authTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := s.client.ListObjects(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+	dashboards, err := s.client.GetDashboards(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dashboards: %w", err)
+	}
+
+	resources := []mcp.Resource{{
+		URI:         resourceURIPrefix + "recent",
+		Name:        "Recent records",
+		Description: "Records you viewed recently, grouped by object",
+		MimeType:    resourceMimeType,
+	}}
+	for _, d := range dashboards {
+		resources = append(resources, mcp.Resource{
+			URI:      resourceURIPrefix + "dashboards/" + d.ID,
+			Name:     d.Label + " dashboard",
+			MimeType: resourceMimeType,
+		})
+	}
+	for _, obj := range objects {
+		if obj.IsSystem {
+			continue
+		}
+		resources = append(resources,
+			mcp.Resource{
+				URI:         resourceURIPrefix + "objects/" + obj.APIName,
+				Name:        obj.Label + " schema",
+				Description: fmt.Sprintf("Fields and settings of the %s object", obj.APIName),
+				MimeType:    resourceMimeType,
+			},
+			mcp.Resource{
+				URI:      resourceURIPrefix + "objects/" + obj.APIName + "/layout",
+				Name:     obj.Label + " layout",
+				MimeType: resourceMimeType,
+			},
+		)
+	}
+	return mcp.ListResourcesResult{Resources: resources}, nil
+}
+
+// HandleListResourceTemplates describes the parameterized resources
+func (s *ResourceService) HandleListResourceTemplates(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return mcp.ListResourceTemplatesResult{ResourceTemplates: []mcp.ResourceTemplate{
+		{URITemplate: resourceURIPrefix + "objects/{object}", Name: "Object schema", MimeType: resourceMimeType},
+		{URITemplate: resourceURIPrefix + "objects/{object}/layout", Name: "Object page layout", MimeType: resourceMimeType},
+		{URITemplate: resourceURIPrefix + "dashboards/{id}", Name: "Dashboard", MimeType: resourceMimeType},
+		{URITemplate: resourceURIPrefix + "recent/{object}", Name: "Recent records of an object", MimeType: resourceMimeType},
+		{URITemplate: resourceURIPrefix + "records/{object}/{id}", Name: "Record", Description: "A single record; subscribe to be notified when it changes", MimeType: resourceMimeType},
+	}}, nil
+}
+
+// HandleReadResource returns a resource as JSON text
+func (s *ResourceService) HandleReadResource(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	token, err := authTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var req mcp.ResourceParams
+	if err := json.Unmarshal(params, &req); err != nil || req.URI == "" {
+		return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: "Invalid params"}
+	}
+	parts, ok := parseResourceURI(req.URI)
+	if !ok {
+		return nil, resourceNotFound(req.URI)
+	}
+
+	var content interface{}
+	switch {
+	case parts[0] == "objects" && len(parts) == 2:
+		content, err = s.client.DescribeObject(ctx, parts[1], token)
+	case parts[0] == "objects":
+		content, err = s.client.GetLayout(ctx, parts[1], token)
+	case parts[0] == "dashboards":
+		content, err = s.client.GetDashboard(ctx, parts[1], token)
+	case parts[0] == "recent" && len(parts) == 1:
+		content, err = s.client.GetRecentItems(ctx, "", token)
+	case parts[0] == "recent":
+		content, err = s.client.GetRecentItems(ctx, parts[1], token)
+	case parts[0] == "records":
+		content, err = s.client.GetRecord(ctx, parts[1], parts[2], token)
+	}
+	if err != nil {
+		return nil, &mcp.Error{Code: mcp.ErrResourceNotFound, Message: fmt.Sprintf("Failed to read %s: %v", req.URI, err)}
+	}
+
+	text, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return mcp.ReadResourceResult{Contents: []mcp.ResourceContents{{URI: req.URI, MimeType: resourceMimeType, Text: string(text)}}}, nil
+}
+
+// HandleSubscribe subscribes the calling session to updates of a resource
+func (s *ResourceService) HandleSubscribe(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	uri, sessionID, err := subscriptionParams(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscriptions[uri] == nil {
+		s.subscriptions[uri] = make(map[string]bool)
+	}
+	s.subscriptions[uri][sessionID] = true
+	return struct{}{}, nil
+}
+
+// HandleUnsubscribe cancels a subscription made with HandleSubscribe
+func (s *ResourceService) HandleUnsubscribe(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	uri, sessionID, err := subscriptionParams(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions[uri], sessionID)
+	if len(s.subscriptions[uri]) == 0 {
+		delete(s.subscriptions, uri)
+	}
+	return struct{}{}, nil
+}
+
+// RecordChanged notifies sessions subscribed to the record's resource
+func (s *ResourceService) RecordChanged(objectName, recordID string) {
+	s.NotifyUpdated(fmt.Sprintf("%srecords/%s/%s", resourceURIPrefix, objectName, recordID))
+}
+
+// NotifyUpdated sends notifications/resources/updated to every session subscribed to uri
+func (s *ResourceService) NotifyUpdated(uri string) {
+	s.mu.Lock()
+	sessions := make([]string, 0, len(s.subscriptions[uri]))
+	for id := range s.subscriptions[uri] {
+		sessions = append(sessions, id)
+	}
+	notifier := s.notifier
+	s.mu.Unlock()
+
+	if notifier == nil {
+		return
+	}
+	for _, id := range sessions {
+		notifier.Notify(id, notificationResourceUpdated, mcp.ResourceParams{URI: uri})
+	}
+}
+
+// dropSession removes all subscriptions of a session whose stream has closed
+func (s *ResourceService) dropSession(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for uri, sessions := range s.subscriptions {
+		delete(sessions, sessionID)
+		if len(sessions) == 0 {
+			delete(s.subscriptions, uri)
+		}
+	}
+}
+
+// parseResourceURI splits a nexus:// URI into its path segments, accepting only the shapes
+// ResourceService serves
+func parseResourceURI(uri string) ([]string, bool) {
+	path, ok := strings.CutPrefix(uri, resourceURIPrefix)
+	if !ok {
+		return nil, false
+	}
+	parts := strings.Split(path, "/")
+	for _, p := range parts {
+		if p == "" {
+			return nil, false
+		}
+	}
+	switch parts[0] {
+	case "objects":
+		return parts, len(parts) == 2 || (len(parts) == 3 && parts[2] == "layout")
+	case "dashboards":
+		return parts, len(parts) == 2
+	case "recent":
+		return parts, len(parts) <= 2
+	case "records":
+		return parts, len(parts) == 3
+	}
+	return nil, false
+}
+
+func subscriptionParams(ctx context.Context, params json.RawMessage) (string, string, error) {
+	var req mcp.ResourceParams
+	if err := json.Unmarshal(params, &req); err != nil || req.URI == "" {
+		return "", "", &mcp.Error{Code: mcp.ErrInvalidParams, Message: "Invalid params"}
+	}
+	if _, ok := parseResourceURI(req.URI); !ok {
+		return "", "", resourceNotFound(req.URI)
+	}
+	sessionID, _ := ctx.Value(mcp.ContextKeySessionID).(string)
+	if sessionID == "" {
+		return "", "", &mcp.Error{Code: mcp.ErrInvalidRequest, Message: "Subscriptions require the " + mcp.HeaderSessionID + " header from initialize"}
+	}
+	return req.URI, sessionID, nil
+}
+
+func resourceNotFound(uri string) error {
+	return &mcp.Error{Code: mcp.ErrResourceNotFound, Message: "Resource not found", Data: map[string]string{"uri": uri}}
+}
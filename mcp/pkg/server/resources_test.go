@@ -0,0 +1,140 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nexuscrm/mcp/pkg/client"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceURI(t *testing.T) {
+	for uri, ok := range map[string]bool{
+		"nexus://objects/account":        true,
+		"nexus://objects/account/layout": true,
+		"nexus://objects/account/fields": false,
+		"nexus://dashboards/d1":          true,
+		"nexus://recent":                 true,
+		"nexus://recent/lead":            true,
+		"nexus://records/lead/l1":        true,
+		"nexus://records/lead":           false,
+		"nexus://records//l1":            false,
+		"nexus://users/u1":               false,
+		"https://objects/account":        false,
+	} {
+		_, got := parseResourceURI(uri)
+		assert.Equal(t, ok, got, uri)
+	}
+}
+
+func TestResources(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/metadata/objects":
+			_, _ = w.Write([]byte(`{"data":[{"api_name":"lead","label":"Lead"},{"api_name":"_system_user","label":"User","is_system":true}]}`))
+		case "/api/metadata/dashboards":
+			_, _ = w.Write([]byte(`{"data":[{"id":"d1","label":"Sales"}]}`))
+		case "/api/data/lead/l1":
+			_, _ = w.Write([]byte(`{"data":{"__sys_gen_id":"l1","name":"Ada"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer backend.Close()
+
+	nexus := client.NewNexusClient(backend.URL)
+	resources := NewResourceService(nexus)
+	server := httptest.NewServer(withToken(NewHandler(NewToolBusService(nexus, nil), resources)))
+	defer server.Close()
+
+	// initialize assigns the session
+	resp, sessionID := rpc(t, server.URL, "", "initialize", nil)
+	require.NotEmpty(t, sessionID)
+	assert.Contains(t, string(resp), `"resources":{"subscribe":true}`)
+
+	resp, _ = rpc(t, server.URL, sessionID, "resources/list", nil)
+	var list struct {
+		Result mcp.ListResourcesResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(resp, &list))
+	var uris []string
+	for _, r := range list.Result.Resources {
+		uris = append(uris, r.URI)
+	}
+	assert.Equal(t, []string{"nexus://recent", "nexus://dashboards/d1", "nexus://objects/lead", "nexus://objects/lead/layout"}, uris)
+
+	resp, _ = rpc(t, server.URL, sessionID, "resources/read", mcp.ResourceParams{URI: "nexus://records/lead/l1"})
+	assert.Contains(t, string(resp), `\"name\": \"Ada\"`)
+	resp, _ = rpc(t, server.URL, sessionID, "resources/read", mcp.ResourceParams{URI: "nexus://nowhere"})
+	assert.Contains(t, string(resp), `"code":-32002`)
+
+	// Open the notification stream, subscribe, and change the record
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(mcp.HeaderSessionID, sessionID)
+	stream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer stream.Body.Close()
+	require.Equal(t, http.StatusOK, stream.StatusCode)
+
+	resp, _ = rpc(t, server.URL, sessionID, "resources/subscribe", mcp.ResourceParams{URI: "nexus://records/lead/l1"})
+	assert.NotContains(t, string(resp), `"error"`)
+	resources.RecordChanged("lead", "l2")
+	resources.RecordChanged("lead", "l1")
+
+	line, err := readEvent(bufio.NewReader(stream.Body))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"notifications/resources/updated","params":{"uri":"nexus://records/lead/l1"}}`, line)
+
+	// Subscribing without a session is rejected
+	resp, _ = rpc(t, server.URL, "", "resources/subscribe", mcp.ResourceParams{URI: "nexus://records/lead/l1"})
+	assert.Contains(t, string(resp), `"code":-32600`)
+}
+
+// withToken stands in for the backend's auth middleware
+func withToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), mcp.ContextKeyAuthToken, "token")))
+	})
+}
+
+func rpc(t *testing.T, url, sessionID, method string, params interface{}) ([]byte, string) {
+	t.Helper()
+	raw, err := json.Marshal(params)
+	require.NoError(t, err)
+	body, err := json.Marshal(mcp.Request{JSONRPC: mcp.JSONRPCVersion, ID: 1, Method: method, Params: raw})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	if sessionID != "" {
+		req.Header.Set(mcp.HeaderSessionID, sessionID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(resp.Body)
+	require.NoError(t, err)
+	return buf.Bytes(), resp.Header.Get(mcp.HeaderSessionID)
+}
+
+// readEvent returns the data of the next server-sent event
+func readEvent(r *bufio.Reader) (string, error) {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if data, ok := strings.CutPrefix(strings.TrimSpace(line), "data: "); ok {
+			return data, nil
+		}
+	}
+}
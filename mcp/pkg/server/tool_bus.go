@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/nexuscrm/mcp/pkg/client"
 	"github.com/nexuscrm/mcp/pkg/contextstore"
@@ -21,6 +24,10 @@ const (
 	ToolUpdateRecord    = "update_record"
 	ToolDeleteRecord    = "delete_record"
 	ToolCreateDashboard = "create_dashboard"
+	// Bulk Tools
+	ToolBulkCreate = "bulk_create"
+	ToolBulkUpdate = "bulk_update"
+	ToolBulkDelete = "bulk_delete"
 	// Schema Tools
 	ToolCreateObject = "create_object"
 	ToolCreateField  = "create_field"
@@ -31,15 +38,19 @@ const (
 	ToolContextList   = "context_list"
 	ToolContextClear  = "context_clear"
 	// Search & Analytics
-	ToolSearchRecords = "search_records"
-	ToolSearchObject  = "search_object_records"
-	ToolRunAnalytics  = "run_analytics"
-	ToolListApps      = "list_apps"
+	ToolSearchRecords  = "search_records"
+	ToolSearchObject   = "search_object_records"
+	ToolRunAnalytics   = "run_analytics"
+	ToolRunReport      = "run_report"
+	ToolAskData        = "ask_data"
+	ToolSemanticSearch = "semantic_search"
+	ToolListApps       = "list_apps"
 	// Deletion Tools
 	ToolDeleteObject = "delete_object"
 	ToolDeleteField  = "delete_field"
 	// Record Retrieval
-	ToolGetRecord = "get_record"
+	ToolGetRecord         = "get_record"
+	ToolGetRecordTimeline = "get_record_timeline"
 	// Update Tools
 	ToolUpdateObject    = "update_object"
 	ToolUpdateField     = "update_field"
@@ -69,21 +80,46 @@ const (
 	ToolUpdateValidationRule = "update_validation_rule"
 	ToolDeleteValidationRule = "delete_validation_rule"
 	ToolGetValidationRules   = "get_validation_rules"
+	// Flow & Approval Tools
+	ToolListFlows            = "list_flows"
+	ToolCreateFlow           = "create_flow"
+	ToolUpdateFlow           = "update_flow"
+	ToolSubmitForApproval    = "submit_for_approval"
+	ToolApproveWorkItem      = "approve_work_item"
+	ToolListPendingApprovals = "list_pending_approvals"
+	// User & Permission Admin Tools (system administrators only)
+	ToolListUsers               = "list_users"
+	ToolCreateUser              = "create_user"
+	ToolAssignPermissionSet     = "assign_permission_set"
+	ToolGetEffectivePermissions = "get_effective_permissions"
+	ToolUpdateObjectPermissions = "update_object_permissions"
 )
 
 type ToolBusService struct {
-	client       *client.NexusClient
+	client       client.API
 	contextStore *contextstore.ContextStore
+	auditSink    ToolAuditSink
+	rateLimiter  *toolRateLimiter
+
+	customMu    sync.RWMutex
+	customTools map[string]*customTool // Tools added with RegisterTool
+	customOrder []string
 }
 
-func NewToolBusService(client *client.NexusClient, contextStore *contextstore.ContextStore) *ToolBusService {
+func NewToolBusService(client client.API, contextStore *contextstore.ContextStore) *ToolBusService {
 	return &ToolBusService{
 		client:       client,
 		contextStore: contextStore,
+		auditSink:    logAuditSink,
+		rateLimiter:  newToolRateLimiter(defaultToolRateLimit, defaultToolRateWindow),
 	}
 }
 
 func (s *ToolBusService) getAuthToken(ctx context.Context) (string, error) {
+	return authTokenFromContext(ctx)
+}
+
+func authTokenFromContext(ctx context.Context) (string, error) {
 	token, ok := ctx.Value(mcp.ContextKeyAuthToken).(string)
 	if !ok || token == "" {
 		return "", &mcp.Error{Code: mcp.ErrInternal, Message: "Unauthorized: No auth token"}
@@ -91,8 +127,26 @@ func (s *ToolBusService) getAuthToken(ctx context.Context) (string, error) {
 	return token, nil
 }
 
-// HandleListTools returns discovery tools + generic CRUD tools
+// HandleListTools returns discovery tools + generic CRUD tools, then the custom tools
 func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	allTools := append(builtinTools(), s.customToolList()...)
+
+	for i := range allTools {
+		if destructiveTools[allTools[i].Name] {
+			requireConfirmation(&allTools[i])
+		}
+	}
+
+	if requireSystemAdmin(ctx) != nil {
+		allTools = slices.DeleteFunc(allTools, func(t mcp.Tool) bool { return adminTools[t.Name] })
+	}
+	allTools = allowedTools(ctx, allTools)
+
+	return mcp.ListToolsResult{Tools: allTools}, nil
+}
+
+// builtinTools returns the definitions of the built-in tools
+func builtinTools() []mcp.Tool {
 	var allTools []mcp.Tool
 
 	// 1. Discovery Tools
@@ -131,7 +185,7 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolQueryObject,
-		Description: "Query business data records from a specific object. For dashboards use list_dashboards, for apps use list_apps instead.",
+		Description: "Query business data records from a specific object. Each lookup field comes with the display name of the referenced record as <field>__name, so there is no need to fetch referenced records for their names. For dashboards use list_dashboards, for apps use list_apps instead.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
 			"properties": map[string]interface{}{
@@ -222,6 +276,73 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 		},
 	})
 
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolBulkCreate,
+		Description: "Create many business data records of one object in a single call (up to 1000). Records that fail validation are reported individually while the rest are created. Prefer this over repeated create_record calls.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "The API name of the object",
+				},
+				"records": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "object"},
+					"description": "Field values for each new record",
+				},
+			},
+			"required": []string{"object_name", "records"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolBulkUpdate,
+		Description: "Update many business data records of one object in a single call (up to 1000). Each record is updated on its own: failures are reported per record ID while the rest are saved.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "The API name of the object",
+				},
+				"updates": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"id":   map[string]interface{}{"type": "string", "description": "The record ID to update"},
+							"data": map[string]interface{}{"type": "object", "description": "Fields to update"},
+						},
+						"required": []string{"id", "data"},
+					},
+					"description": "The records to update, each with its ID and the fields to change",
+				},
+			},
+			"required": []string{"object_name", "updates"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolBulkDelete,
+		Description: "Delete many business data records of one object in a single call (up to 1000). Deleted records move to the recycle bin; records that cannot be deleted are reported individually.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "The API name of the object",
+				},
+				"ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "The record IDs to delete",
+				},
+			},
+			"required": []string{"object_name", "ids"},
+		},
+	})
+
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolCreateDashboard,
 		Description: "Create a dashboard with widgets. Use this specialized tool instead of create_record for _System_Dashboard. Widgets are passed as a structured array, NOT as a JSON string.",
@@ -391,30 +512,35 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolContextAdd,
-		Description: "Add files to the conversation context. The content of these files will be available to the AI.",
+		Description: "Pin files or CRM data (records, list views, saved searches, queries) to the conversation context. Pinned CRM data is refreshed before each turn and is available to the AI.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
-			"properties": map[string]interface{}{
-				"files": map[string]interface{}{
-					"type":        "array",
-					"items":       map[string]interface{}{"type": "string"},
-					"description": "List of file paths to add",
-				},
-			},
-			"required": []string{"files"},
+			"properties": func() map[string]interface{} {
+				props := map[string]interface{}{
+					"files": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "List of file paths to add",
+					},
+				}
+				for k, v := range contextPinSchema {
+					props[k] = v
+				}
+				return props
+			}(),
 		},
 	})
 
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolContextRemove,
-		Description: "Remove files from the conversation context.",
+		Description: "Remove files or pinned CRM data from the conversation context.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
 			"properties": map[string]interface{}{
 				"files": map[string]interface{}{
 					"type":        "array",
 					"items":       map[string]interface{}{"type": "string"},
-					"description": "List of file paths to remove",
+					"description": "File paths or pinned nexus:// URIs, as shown by context_list",
 				},
 			},
 			"required": []string{"files"},
@@ -423,7 +549,7 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolContextList,
-		Description: "List all files currently in the conversation context.",
+		Description: "List the files and CRM data pinned to the conversation context, and which fit the token budget.",
 		InputSchema: map[string]interface{}{
 			"type":       "object",
 			"properties": map[string]interface{}{},
@@ -454,6 +580,30 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 		},
 	})
 
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolSemanticSearch,
+		Description: "Find records by meaning rather than exact words (e.g. 'customers unhappy with onboarding', 'deals at risk of slipping'). Results are ranked by similarity. Use search_records for names and exact terms.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"term": map[string]interface{}{
+					"type":        "string",
+					"description": "What the records should be about",
+				},
+				"object_names": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Optional API names of the objects to search",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Max records (default 10, max 50)",
+				},
+			},
+			"required": []string{"term"},
+		},
+	})
+
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolSearchObject,
 		Description: "Perform a text search within a specific object. Use this when you know which object to search but want to find records matching a text string.",
@@ -475,7 +625,7 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolRunAnalytics,
-		Description: "Run an analytics query to get counts, sums, or group-by results. Use this for reports and metrics.",
+		Description: "Run a single analytics operation (one count, sum, or group-by). For grouping by several fields, several aggregates at once, or by date period, use run_report instead.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
 			"properties": map[string]interface{}{
@@ -505,6 +655,78 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 		},
 	})
 
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolAskData,
+		Description: "Answer a plain-language question about CRM records (e.g. 'open opportunities over 50k closing this quarter'). The question is translated into a validated query, which is returned with its results. Prefer query_object when you already know the exact filter.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"question": map[string]interface{}{
+					"type":        "string",
+					"description": "The question to answer",
+				},
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional API name of the object the question is about",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Max records (default 20, max 200)",
+				},
+			},
+			"required": []string{"question"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolRunReport,
+		Description: "Summarize records of an object grouped by up to 3 fields, with the record count and any number of aggregates per group, returned as a compact table. Date fields can be grouped by period with 'field:bucket' (bucket: day, week, month, quarter, year), e.g. 'close_date:month'. Fields of a looked-up parent can be used as 'lookup_field.parent_field'.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "The API name of the object to report on",
+				},
+				"group_by": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "1 to 3 fields to group by, outermost first (e.g. ['stage', 'close_date:quarter'])",
+				},
+				"aggregates": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"function": map[string]interface{}{"type": "string", "enum": []string{"SUM", "AVG", "MIN", "MAX", "COUNT"}},
+							"field":    map[string]interface{}{"type": "string", "description": "Field of the object to aggregate"},
+						},
+						"required": []string{"function", "field"},
+					},
+					"description": "Aggregates computed per group, in addition to the record count",
+				},
+				"filter": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional filter using formula syntax, e.g. \"status == 'Closed' && amount > 1000\"",
+				},
+				"sort_by": map[string]interface{}{
+					"type":        "string",
+					"description": "Column to order the groups by: a group_by entry, 'count', or an aggregate key such as 'sum_amount'. Defaults to the group_by order.",
+				},
+				"sort_order": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"ASC", "DESC"},
+					"description": "Sort direction (default DESC when sorting by count or an aggregate, otherwise ASC)",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Max groups to return (default 50, max 500)",
+				},
+			},
+			"required": []string{"object_name", "group_by"},
+		},
+	})
+
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolListApps,
 		Description: "List all application configurations, including their navigation items. Call this FIRST when you need to add items to an app's navigation/sidebar - you need the app ID for update_app.",
@@ -567,6 +789,38 @@ func (s *ToolBusService) HandleListTools(ctx context.Context, params json.RawMes
 		},
 	})
 
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolGetRecordTimeline,
+		Description: "Retrieve a record's activity history, newest first: comments, field changes, tasks, emails, calls, events, approvals and flow runs. Pass next_cursor back as cursor to read older entries.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "API name of the object (e.g., 'Account')",
+				},
+				"id": map[string]interface{}{
+					"type":        "string",
+					"description": "The unique UUID of the record",
+				},
+				"types": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string", "enum": constants.TimelineEntryTypes},
+					"description": "Optional entry types to include (default all)",
+				},
+				"cursor": map[string]interface{}{
+					"type":        "string",
+					"description": "next_cursor of the previous page",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Max entries (default 25, max 100)",
+				},
+			},
+			"required": []string{"object_name", "id"},
+		},
+	})
+
 	allTools = append(allTools, mcp.Tool{
 		Name:        ToolUpdateObject,
 		Description: "Update properties of an existing object schema (e.g., label, description).",
@@ -1139,7 +1393,278 @@ EXAMPLES:
 		},
 	})
 
-	return mcp.ListToolsResult{Tools: allTools}, nil
+	// Flow & Approval Tools
+	flowStepSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"step_order":      map[string]interface{}{"type": "integer", "description": "Position of the step, starting at 1"},
+			"step_name":       map[string]interface{}{"type": "string"},
+			"step_type":       map[string]interface{}{"type": "string", "enum": []string{constants.FlowStepTypeAction, constants.FlowStepTypeApproval, constants.FlowStepTypeDecision}},
+			"action_type":     map[string]interface{}{"type": "string", "description": "Action to run for 'action' steps"},
+			"action_config":   map[string]interface{}{"type": "object"},
+			"entry_condition": map[string]interface{}{"type": "string", "description": "Formula; the step is skipped when it evaluates to false"},
+			"on_success_step": map[string]interface{}{"type": "integer", "description": "step_order to jump to on success"},
+			"on_failure_step": map[string]interface{}{"type": "integer", "description": "step_order to jump to on failure"},
+		},
+		"required": []string{"step_order", "step_name", "step_type"},
+	}
+	flowProperties := map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":        "string",
+			"description": "Flow name",
+		},
+		"description": map[string]interface{}{
+			"type":        "string",
+			"description": "What the flow does",
+		},
+		"status": map[string]interface{}{
+			"type": "string",
+			"enum": []string{constants.FlowStatusActive, constants.FlowStatusInactive, constants.FlowStatusDraft},
+		},
+		"trigger_object": map[string]interface{}{
+			"type":        "string",
+			"description": "API name of the object whose records trigger the flow",
+		},
+		"trigger_type": map[string]interface{}{
+			"type": "string",
+			"enum": []string{constants.TriggerTypeRecordCreated, constants.TriggerTypeRecordUpdated, constants.TriggerTypeRecordDeleted, constants.TriggerTypeSchedule},
+		},
+		"trigger_condition": map[string]interface{}{
+			"type":        "string",
+			"description": "Formula the triggering record must satisfy (e.g. \"stage == 'Closed Won'\")",
+		},
+		"action_type": map[string]interface{}{
+			"type":        "string",
+			"description": "Action of a simple flow: CreateRecord, UpdateRecord, DeleteRecord, SendEmail, CallWebhook, Callout or SubmitForApproval",
+		},
+		"action_config": map[string]interface{}{
+			"type":        "object",
+			"description": "Configuration for action_type (e.g. {\"target_object\": \"task\", \"field_mappings\": {...}})",
+		},
+		"steps": map[string]interface{}{
+			"type":        "array",
+			"description": "Steps of a multistep flow. Replaces all existing steps when given.",
+			"items":       flowStepSchema,
+		},
+	}
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolListFlows,
+		Description: "List automation flows with their trigger, action and status.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Only flows triggered by this object (optional)",
+				},
+				"status": map[string]interface{}{
+					"type":        "string",
+					"description": "Only flows with this status (optional)",
+				},
+			},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolCreateFlow,
+		Description: "Create an automation flow. A simple flow runs one action; pass steps to create a multistep flow with approvals and decisions. New flows are Active unless a status is given.",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": flowProperties,
+			"required":   []string{"name", "trigger_object", "trigger_type"},
+		},
+	})
+
+	updateFlowProperties := map[string]interface{}{
+		"id": map[string]interface{}{
+			"type":        "string",
+			"description": "ID of the flow to update",
+		},
+	}
+	for k, v := range flowProperties {
+		updateFlowProperties[k] = v
+	}
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolUpdateFlow,
+		Description: "Update a flow. Only the fields provided are changed; use status to activate or deactivate it.",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": updateFlowProperties,
+			"required":   []string{"id"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolSubmitForApproval,
+		Description: "Submit a record to its object's active approval process.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "API name of the object",
+				},
+				"record_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the record to submit",
+				},
+				"comments": map[string]interface{}{
+					"type":        "string",
+					"description": "Comments for the approver (optional)",
+				},
+			},
+			"required": []string{"object_name", "record_id"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolApproveWorkItem,
+		Description: "Approve or reject a pending approval work item assigned to you.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"work_item_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the work item (see list_pending_approvals)",
+				},
+				"decision": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"approve", "reject"},
+					"description": "Defaults to approve",
+				},
+				"comments": map[string]interface{}{
+					"type":        "string",
+					"description": "Reason for the decision (optional)",
+				},
+			},
+			"required": []string{"work_item_id"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolListPendingApprovals,
+		Description: "List approval work items waiting for your decision.",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{},
+		},
+	})
+
+	// User & Permission Admin Tools
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolListUsers,
+		Description: "List users with their profile, role and active status. Use it to find a user's ID by name or email.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"search": map[string]interface{}{
+					"type":        "string",
+					"description": "Only users whose name, username or email contains this text (optional)",
+				},
+			},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolCreateUser,
+		Description: "Create a user.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Full name",
+				},
+				"email": map[string]interface{}{
+					"type":        "string",
+					"description": "Email address, also used to log in",
+				},
+				"password": map[string]interface{}{
+					"type":        "string",
+					"description": "Initial password",
+				},
+				"profile_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Profile controlling the user's base permissions (optional)",
+				},
+				"role_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Role in the hierarchy, controlling record visibility (optional)",
+				},
+			},
+			"required": []string{"name", "email", "password"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolAssignPermissionSet,
+		Description: "Assign a permission set to a user, granting its permissions on top of the user's profile.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"user_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the user",
+				},
+				"permission_set_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the permission set",
+				},
+			},
+			"required": []string{"user_id", "permission_set_id"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolGetEffectivePermissions,
+		Description: "Show what a user can do on each object, and which profile or permission set grants each right. Use it to explain why a user cannot read or edit something.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"user_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the user",
+				},
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Only show permissions on this object (optional)",
+				},
+			},
+			"required": []string{"user_id"},
+		},
+	})
+
+	allTools = append(allTools, mcp.Tool{
+		Name:        ToolUpdateObjectPermissions,
+		Description: "Change one object's permissions on a profile or a permission set. Flags left out keep their current value. Changing a profile affects every user with that profile.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"object_name": map[string]interface{}{
+					"type":        "string",
+					"description": "API name of the object",
+				},
+				"profile_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Profile to change (give this or permission_set_id)",
+				},
+				"permission_set_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Permission set to change (give this or profile_id)",
+				},
+				"allow_read":   map[string]interface{}{"type": "boolean"},
+				"allow_create": map[string]interface{}{"type": "boolean"},
+				"allow_edit":   map[string]interface{}{"type": "boolean"},
+				"allow_delete": map[string]interface{}{"type": "boolean"},
+				"view_all":     map[string]interface{}{"type": "boolean", "description": "Read all records regardless of sharing"},
+				"modify_all":   map[string]interface{}{"type": "boolean", "description": "Edit and delete all records regardless of sharing"},
+			},
+			"required": []string{"object_name"},
+		},
+	})
+
+	return allTools
 }
 
 // HandleCallTool executes a tool
@@ -1149,6 +1674,21 @@ func (s *ToolBusService) HandleCallTool(ctx context.Context, params json.RawMess
 		return nil, &mcp.Error{Code: mcp.ErrInvalidParams, Message: "Invalid params"}
 	}
 
+	result, err := s.runTool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// dispatchTool routes a call to its tool handler
+func (s *ToolBusService) dispatchTool(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
+	if adminTools[req.Name] {
+		if err := requireSystemAdmin(ctx); err != nil {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: err.Error()}}}, nil
+		}
+	}
+
 	// Tool routing based on tool name
 	switch req.Name {
 	case ToolListObjects:
@@ -1163,6 +1703,12 @@ func (s *ToolBusService) HandleCallTool(ctx context.Context, params json.RawMess
 		return s.handleUpdateRecord(ctx, req)
 	case ToolDeleteRecord:
 		return s.handleDeleteRecord(ctx, req)
+	case ToolBulkCreate:
+		return s.handleBulkCreate(ctx, req.Arguments)
+	case ToolBulkUpdate:
+		return s.handleBulkUpdate(ctx, req.Arguments)
+	case ToolBulkDelete:
+		return s.handleBulkDelete(ctx, req.Arguments)
 	case ToolCreateDashboard:
 		return s.handleCreateDashboard(ctx, req)
 	case ToolAddDashboardWidget:
@@ -1173,6 +1719,12 @@ func (s *ToolBusService) HandleCallTool(ctx context.Context, params json.RawMess
 		return s.handleSearchObject(ctx, req)
 	case ToolRunAnalytics:
 		return s.handleRunAnalytics(ctx, req)
+	case ToolRunReport:
+		return s.handleRunReport(ctx, req.Arguments)
+	case ToolAskData:
+		return s.handleAskData(ctx, req)
+	case ToolSemanticSearch:
+		return s.handleSemanticSearch(ctx, req)
 	case ToolListApps:
 		return s.handleListApps(ctx, req)
 	case ToolCreateObject:
@@ -1195,6 +1747,8 @@ func (s *ToolBusService) HandleCallTool(ctx context.Context, params json.RawMess
 		return s.handleContextClear(ctx, req)
 	case ToolGetRecord:
 		return s.handleGetRecord(ctx, req.Arguments)
+	case ToolGetRecordTimeline:
+		return s.handleGetRecordTimeline(ctx, req.Arguments)
 	case ToolUpdateObject:
 		return s.handleUpdateObject(ctx, req.Arguments)
 	case ToolUpdateField:
@@ -1239,8 +1793,33 @@ func (s *ToolBusService) HandleCallTool(ctx context.Context, params json.RawMess
 		return s.handleDeleteValidationRule(ctx, req.Arguments)
 	case ToolGetValidationRules:
 		return s.handleGetValidationRules(ctx, req.Arguments)
+	case ToolListFlows:
+		return s.handleListFlows(ctx, req.Arguments)
+	case ToolCreateFlow:
+		return s.handleCreateFlow(ctx, req.Arguments)
+	case ToolUpdateFlow:
+		return s.handleUpdateFlow(ctx, req.Arguments)
+	case ToolSubmitForApproval:
+		return s.handleSubmitForApproval(ctx, req.Arguments)
+	case ToolApproveWorkItem:
+		return s.handleApproveWorkItem(ctx, req.Arguments)
+	case ToolListPendingApprovals:
+		return s.handleListPendingApprovals(ctx, req.Arguments)
+	case ToolListUsers:
+		return s.handleListUsers(ctx, req.Arguments)
+	case ToolCreateUser:
+		return s.handleCreateUser(ctx, req.Arguments)
+	case ToolAssignPermissionSet:
+		return s.handleAssignPermissionSet(ctx, req.Arguments)
+	case ToolGetEffectivePermissions:
+		return s.handleGetEffectivePermissions(ctx, req.Arguments)
+	case ToolUpdateObjectPermissions:
+		return s.handleUpdateObjectPermissions(ctx, req.Arguments)
 	default:
-		return nil, &mcp.Error{Code: mcp.ErrMethodNotFound, Message: fmt.Sprintf("Tool '%s' not found", req.Name)}
+		if handler := s.customToolHandler(req.Name); handler != nil {
+			return handler(ctx, req)
+		}
+		return mcp.CallToolResult{}, &mcp.Error{Code: mcp.ErrMethodNotFound, Message: fmt.Sprintf("Tool '%s' not found", req.Name)}
 	}
 }
 
@@ -1454,6 +2033,114 @@ func (s *ToolBusService) handleDeleteRecord(ctx context.Context, req mcp.CallToo
 	}, nil
 }
 
+func (s *ToolBusService) handleBulkCreate(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName, _ := args["object_name"].(string)
+	items, _ := args["records"].([]interface{})
+	if objectName == "" || len(items) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and a non-empty records array are required"}}}, nil
+	}
+
+	records := make([]models.SObject, 0, len(items))
+	for i, item := range items {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("records[%d] must be an object", i)}}}, nil
+		}
+		records = append(records, data)
+	}
+
+	result, err := s.client.BulkCreateRecords(ctx, objectName, records, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Bulk create failed: %v", err)}}}, nil
+	}
+	return bulkToolResult("created", objectName, result), nil
+}
+
+func (s *ToolBusService) handleBulkUpdate(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName, _ := args["object_name"].(string)
+	items, _ := args["updates"].([]interface{})
+	if objectName == "" || len(items) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and a non-empty updates array are required"}}}, nil
+	}
+
+	records := make([]models.SObject, 0, len(items))
+	for i, item := range items {
+		update, _ := item.(map[string]interface{})
+		id, _ := update["id"].(string)
+		data, ok := update["data"].(map[string]interface{})
+		if id == "" || !ok {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("updates[%d] requires id and data", i)}}}, nil
+		}
+		record := models.SObject{constants.FieldID: id}
+		for k, v := range data {
+			if k != constants.FieldID {
+				record[k] = v
+			}
+		}
+		records = append(records, record)
+	}
+
+	result, err := s.client.BulkUpdateRecords(ctx, objectName, records, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Bulk update failed: %v", err)}}}, nil
+	}
+	return bulkToolResult("updated", objectName, result), nil
+}
+
+func (s *ToolBusService) handleBulkDelete(ctx context.Context, args map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName, _ := args["object_name"].(string)
+	items, _ := args["ids"].([]interface{})
+	if objectName == "" || len(items) == 0 {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "object_name and a non-empty ids array are required"}}}, nil
+	}
+
+	ids := make([]string, 0, len(items))
+	for i, item := range items {
+		id, _ := item.(string)
+		if id == "" {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("ids[%d] must be a non-empty string", i)}}}, nil
+		}
+		ids = append(ids, id)
+	}
+
+	result, err := s.client.BulkDeleteRecords(ctx, objectName, ids, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Bulk delete failed: %v", err)}}}, nil
+	}
+	return bulkToolResult("deleted", objectName, result), nil
+}
+
+// bulkToolResult summarizes a bulk operation. It is an error only when nothing succeeded,
+// so a partial success still reads as done with the failures listed.
+func bulkToolResult(verb, objectName string, result *models.BulkResult) mcp.CallToolResult {
+	text := fmt.Sprintf("Successfully %s %d %s record(s)", verb, result.SuccessCount, objectName)
+	if result.FailedCount > 0 {
+		text += fmt.Sprintf("; %d failed:", result.FailedCount)
+		for _, e := range result.Errors {
+			text += "\n- " + e
+		}
+	}
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: text}},
+		IsError: result.SuccessCount == 0 && result.FailedCount > 0,
+	}
+}
+
 func (s *ToolBusService) handleCreateDashboard(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
 	token, err := s.getAuthToken(ctx)
 	if err != nil {
@@ -1613,6 +2300,18 @@ func getStringFromMap(m map[string]interface{}, key string) string {
 	return ""
 }
 
+// getStringSliceFromMap returns the non-empty strings of an array argument
+func getStringSliceFromMap(m map[string]interface{}, key string) []string {
+	raw, _ := m[key].([]interface{})
+	values := make([]string, 0, len(raw))
+	for _, r := range raw {
+		if v, ok := r.(string); ok && v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // getStringFromSObject extracts a string field from models.SObject
 func getStringFromSObject(m models.SObject, key string) string {
 	if v, ok := m[key].(string); ok {
@@ -1733,6 +2432,72 @@ func (s *ToolBusService) handleSearchObject(ctx context.Context, req mcp.CallToo
 	return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: string(jsonBytes)}}}, nil
 }
 
+// handleAskData answers a natural-language question, showing the query it ran so the
+// answer can be checked and refined
+func (s *ToolBusService) handleAskData(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	question := getStringFromMap(req.Arguments, "question")
+	if question == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "question required"}}}, nil
+	}
+	nlq := models.NLQRequest{Question: question, ObjectAPIName: getStringFromMap(req.Arguments, "object_name")}
+	if l, ok := req.Arguments["limit"].(float64); ok {
+		nlq.Limit = int(l)
+	}
+
+	result, err := s.client.AskData(ctx, nlq, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Question failed: %v", err)}}}, nil
+	}
+
+	var sb strings.Builder
+	if result.Explanation != "" {
+		sb.WriteString(result.Explanation + "\n")
+	}
+	q := result.Query
+	fmt.Fprintf(&sb, "Query: object=%s filter=%q", q.ObjectAPIName, q.FilterExpr)
+	if q.SortField != "" {
+		fmt.Fprintf(&sb, " sort=%s %s", q.SortField, q.SortDirection)
+	}
+	fmt.Fprintf(&sb, " limit=%d\nFound %d records:\n", q.Limit, len(result.Records))
+	writePromptRecords(&sb, result.Records)
+	return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: sb.String()}}}, nil
+}
+
+// handleSemanticSearch ranks records by similarity in meaning to the search term
+func (s *ToolBusService) handleSemanticSearch(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	term := getStringFromMap(req.Arguments, "term")
+	if term == "" {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: "term required"}}}, nil
+	}
+	search := models.SemanticSearchRequest{Term: term, ObjectAPINames: getStringSliceFromMap(req.Arguments, "object_names")}
+	if l, ok := req.Arguments["limit"].(float64); ok {
+		search.Limit = int(l)
+	}
+
+	hits, err := s.client.SemanticSearch(ctx, search, token)
+	if err != nil {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Semantic search failed: %v", err)}}}, nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Found %d records, most similar first:\n", len(hits))
+	for _, hit := range hits {
+		fmt.Fprintf(&sb, "[%s, score %.2f] ", hit.ObjectAPIName, hit.Score)
+		writePromptRecords(&sb, []models.SObject{hit.Record})
+	}
+	return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: sb.String()}}}, nil
+}
+
 func (s *ToolBusService) handleRunAnalytics(ctx context.Context, req mcp.CallToolParams) (mcp.CallToolResult, error) {
 	token, err := s.getAuthToken(ctx)
 	if err != nil {
@@ -1830,6 +2595,33 @@ func (s *ToolBusService) handleGetRecord(ctx context.Context, arguments map[stri
 	}, nil
 }
 
+func (s *ToolBusService) handleGetRecordTimeline(ctx context.Context, arguments map[string]interface{}) (mcp.CallToolResult, error) {
+	token, err := s.getAuthToken(ctx)
+	if err != nil {
+		return mcp.CallToolResult{}, err
+	}
+
+	objectName := getStringFromMap(arguments, "object_name")
+	id := getStringFromMap(arguments, "id")
+	limit := 0
+	if l, ok := arguments["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	page, err := s.client.GetRecordTimeline(ctx, objectName, id, getStringSliceFromMap(arguments, "types"), getStringFromMap(arguments, "cursor"), limit, token)
+	if err != nil {
+		return mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf("Error retrieving timeline: %v", err)}},
+		}, nil
+	}
+
+	jsonData, _ := json.MarshalIndent(page, "", "  ")
+	return mcp.CallToolResult{
+		Content: []mcp.Content{{Type: "text", Text: string(jsonData)}},
+	}, nil
+}
+
 func (s *ToolBusService) handleUpdateObject(ctx context.Context, arguments map[string]interface{}) (mcp.CallToolResult, error) {
 	token, err := s.getAuthToken(ctx)
 	if err != nil {
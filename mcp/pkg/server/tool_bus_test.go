@@ -0,0 +1,533 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuscrm/mcp/pkg/agent"
+	"github.com/nexuscrm/mcp/pkg/client"
+	"github.com/nexuscrm/mcp/pkg/contextstore"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callTool(t *testing.T, s *ToolBusService, name string, args map[string]interface{}) mcp.CallToolResult {
+	t.Helper()
+	return callToolAs(t, s, &models.UserSession{ID: "u0", IsSystemAdmin: true}, name, args)
+}
+
+func callToolAs(t *testing.T, s *ToolBusService, user *models.UserSession, name string, args map[string]interface{}) mcp.CallToolResult {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), mcp.ContextKeyAuthToken, "token")
+	ctx = context.WithValue(ctx, mcp.ContextKeyUser, user)
+	return callToolIn(t, ctx, s, name, args)
+}
+
+func callToolIn(t *testing.T, ctx context.Context, s *ToolBusService, name string, args map[string]interface{}) mcp.CallToolResult {
+	t.Helper()
+	params, err := json.Marshal(mcp.CallToolParams{Name: name, Arguments: args})
+	require.NoError(t, err)
+	result, err := s.HandleCallTool(ctx, params)
+	require.NoError(t, err)
+	return result.(mcp.CallToolResult)
+}
+
+func TestBulkTools(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"data":{"success_count":1,"failed_count":1,"errors":["record c2: not found"]}}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	result := callTool(t, s, ToolBulkUpdate, map[string]interface{}{
+		"object_name": "contact",
+		"updates": []interface{}{
+			map[string]interface{}{"id": "c1", "data": map[string]interface{}{"name": "Ada"}},
+			map[string]interface{}{"id": "c2", "data": map[string]interface{}{"name": "Bob"}},
+		},
+	})
+	assert.Equal(t, "PATCH", method)
+	assert.Equal(t, "/api/data/contact/bulk", path)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"__sys_gen_id": "c1", "name": "Ada"},
+		map[string]interface{}{"__sys_gen_id": "c2", "name": "Bob"},
+	}, body["records"])
+	assert.False(t, result.IsError, "a partial success is not an error")
+	assert.Contains(t, result.Content[0].Text, "Successfully updated 1 contact record(s); 1 failed:\n- record c2: not found")
+
+	result = callTool(t, s, ToolBulkDelete, map[string]interface{}{
+		"object_name": "contact",
+		"ids":         []interface{}{"c1", "c2"},
+	})
+	assert.Equal(t, "DELETE", method)
+	assert.Equal(t, []interface{}{"c1", "c2"}, body["ids"])
+	assert.False(t, result.IsError)
+
+	result = callTool(t, s, ToolBulkCreate, map[string]interface{}{
+		"object_name": "contact",
+		"records":     []interface{}{"not an object"},
+	})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "records[0] must be an object", result.Content[0].Text)
+}
+
+func TestRunReport(t *testing.T) {
+	var sent map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/metadata/reports/run", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		_, _ = w.Write([]byte(`{"data":{
+			"format":"summary","total_count":6,"grand_totals":{"sum_amount":900},"aggregates":["sum_amount"],
+			"groupings":[{"key":"stage"},{"key":"close_date:quarter"}],
+			"groups":[
+				{"field":"stage","value":"Lost","count":2,"groups":[
+					{"field":"close_date:quarter","value":"2024-Q1","count":2,"aggregates":{"sum_amount":100}}]},
+				{"field":"stage","value":"Won","count":4,"groups":[
+					{"field":"close_date:quarter","value":"2024-Q1","count":1,"aggregates":{"sum_amount":300}},
+					{"field":"close_date:quarter","value":null,"count":3,"aggregates":{"sum_amount":500.5}}]}
+			]}}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	result := callTool(t, s, ToolRunReport, map[string]interface{}{
+		"object_name": "opportunity",
+		"group_by":    []interface{}{"stage", "close_date:quarter"},
+		"aggregates":  []interface{}{map[string]interface{}{"function": "sum", "field": "amount"}},
+		"sort_by":     "sum_amount",
+		"limit":       2,
+	})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, "summary", sent["format"])
+	assert.Nil(t, sent["columns"], "no detail rows are requested")
+	assert.Equal(t, []interface{}{map[string]interface{}{"function": "SUM", "field": "amount"}}, sent["aggregates"])
+
+	assert.Equal(t, "opportunity: 6 record(s) in 3 group(s)\n\n"+
+		"| stage | close_date:quarter | count | sum_amount |\n"+
+		"|---|---|---|---|\n"+
+		"| Won | (none) | 3 | 500.50 |\n"+
+		"| Won | 2024-Q1 | 1 | 300 |\n"+
+		"\nTotals: count=6, sum_amount=900"+
+		"\nShowing 2 of 3 groups; raise limit or add a filter to see the rest.", result.Content[0].Text)
+
+	result = callTool(t, s, ToolRunReport, map[string]interface{}{
+		"object_name": "opportunity",
+		"group_by":    []interface{}{"stage"},
+		"sort_by":     "amount",
+	})
+	assert.True(t, result.IsError)
+}
+
+func TestFlowTools(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, body = r.Method, r.URL.Path, nil
+		if r.Body != nil && r.ContentLength > 0 {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		}
+		switch {
+		case path == "/api/metadata/flows" && method == "GET":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":"f1","name":"Welcome","status":"Active","flow_type":"simple","trigger_object":"contact","trigger_type":"record_created","action_type":"SendEmail"},
+				{"id":"f2","name":"Discount","status":"Draft","flow_type":"multistep","trigger_object":"opportunity","trigger_type":"record_updated","steps":[{"step_order":1},{"step_order":2}]}]}`))
+		case path == "/api/approvals/submit":
+			_, _ = w.Write([]byte(`{"data":{"success":true,"work_item_id":"w1"}}`))
+		case strings.HasPrefix(path, "/api/approvals/"):
+			_, _ = w.Write([]byte(`{"message":"ok"}`))
+		default:
+			_, _ = w.Write([]byte(`{"message":"ok","data":{"id":"f3","name":"Escalate","status":"Active","flow_type":"multistep"}}`))
+		}
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	result := callTool(t, s, ToolListFlows, map[string]interface{}{"object_name": "Opportunity"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Contains(t, result.Content[0].Text, "Found 1 flow(s)")
+	assert.Contains(t, result.Content[0].Text, `"step_count": 2`)
+
+	result = callTool(t, s, ToolCreateFlow, map[string]interface{}{
+		"name":           "Escalate",
+		"trigger_object": "case",
+		"trigger_type":   "record_created",
+		"steps":          []interface{}{map[string]interface{}{"step_order": 1, "step_name": "Manager", "step_type": "approval"}},
+	})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, "POST", method)
+	assert.Equal(t, "multistep", body["flow_type"])
+	assert.Equal(t, "Active", body["status"])
+
+	result = callTool(t, s, ToolCreateFlow, map[string]interface{}{"name": "x", "trigger_object": "case", "trigger_type": "record_created"})
+	assert.True(t, result.IsError, "a simple flow needs an action")
+
+	result = callTool(t, s, ToolUpdateFlow, map[string]interface{}{"id": "f3", "status": "Inactive"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, "PATCH", method)
+	assert.Equal(t, "/api/metadata/flows/f3", path)
+	assert.Equal(t, map[string]interface{}{"status": "Inactive"}, body, "omitted fields and steps are left alone")
+
+	result = callTool(t, s, ToolSubmitForApproval, map[string]interface{}{"object_name": "case", "record_id": "c1"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Contains(t, result.Content[0].Text, "Work item ID: w1")
+
+	result = callTool(t, s, ToolApproveWorkItem, map[string]interface{}{"work_item_id": "w1", "decision": "reject", "comments": "too high"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, "/api/approvals/w1/reject", path)
+	assert.Equal(t, "too high", body["comments"])
+}
+
+func TestAdminTools(t *testing.T) {
+	var updated []map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/auth/users":
+			_, _ = w.Write([]byte(`{"data":[{"__sys_gen_id":"u1","name":"Jane Doe","email":"jane@example.com","profile_id":"standard_user"}]}`))
+		case "GET /api/auth/users/u1/permissions/effective":
+			_, _ = w.Write([]byte(`{"data":[{"object_api_name":"account","allow_read":true},{"object_api_name":"lead","allow_read":true,"allow_edit":true}]}`))
+		case "GET /api/auth/profiles/standard_user/permissions":
+			_, _ = w.Write([]byte(`{"data":[{"object_api_name":"account","allow_read":true,"allow_create":true}]}`))
+		case "POST /api/data/query":
+			_, _ = w.Write([]byte(`{"data":[{"assignee_id":"u1","permission_set_id":"ps1"}]}`))
+		case "GET /api/auth/permission-sets/ps1/permissions":
+			_, _ = w.Write([]byte(`{"data":[{"object_api_name":"lead","allow_edit":true}]}`))
+		case "PUT /api/auth/profiles/standard_user/permissions":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_, _ = w.Write([]byte(`{"message":"ok"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	result := callToolAs(t, s, &models.UserSession{ID: "u2"}, ToolGetEffectivePermissions, map[string]interface{}{"user_id": "u1"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "system administrators")
+
+	listed, err := s.HandleListTools(context.WithValue(context.Background(), mcp.ContextKeyUser, &models.UserSession{ID: "u2"}), nil)
+	require.NoError(t, err)
+	for _, tool := range listed.(mcp.ListToolsResult).Tools {
+		assert.False(t, adminTools[tool.Name], "%s is hidden from non-admins", tool.Name)
+	}
+
+	result = callTool(t, s, ToolGetEffectivePermissions, map[string]interface{}{"user_id": "u1", "object_name": "account"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	var out struct {
+		Effective map[string][]string `json:"effective"`
+		Sources   []struct {
+			Type        string              `json:"type"`
+			ID          string              `json:"id"`
+			Permissions map[string][]string `json:"permissions"`
+		} `json:"sources"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &out))
+	assert.Equal(t, map[string][]string{"account": {"read"}}, out.Effective)
+	require.Len(t, out.Sources, 2)
+	assert.Equal(t, map[string][]string{"account": {"read", "create"}}, out.Sources[0].Permissions)
+	assert.Equal(t, "ps1", out.Sources[1].ID)
+	assert.Empty(t, out.Sources[1].Permissions, "the permission set grants nothing on account")
+
+	result = callTool(t, s, ToolUpdateObjectPermissions, map[string]interface{}{
+		"profile_id":  "standard_user",
+		"object_name": "account",
+		"allow_edit":  true,
+	})
+	require.False(t, result.IsError, result.Content[0].Text)
+	require.Len(t, updated, 1)
+	assert.Equal(t, true, updated[0]["allow_create"], "flags left out keep their value")
+	assert.Equal(t, true, updated[0]["allow_edit"])
+	assert.Equal(t, "Updated account permissions: read, create, edit", result.Content[0].Text)
+
+	result = callTool(t, s, ToolAssignPermissionSet, map[string]interface{}{"user_id": "u1", "permission_set_id": "ps1"})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Contains(t, result.Content[0].Text, "already assigned")
+}
+
+func TestPrompts(t *testing.T) {
+	var query map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/auth/users":
+			_, _ = w.Write([]byte(`{"data":[{"__sys_gen_id":"u1","name":"Jane Doe","email":"jane@example.com"}]}`))
+		case "/api/data/query":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+			_, _ = w.Write([]byte(`{"data":[{"__sys_gen_id":"o1","__sys_gen_owner_id":"u1","name":"Big Deal","stage":"Negotiation","amount":5000}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+	ctx := context.WithValue(context.Background(), mcp.ContextKeyAuthToken, "token")
+
+	params, _ := json.Marshal(mcp.GetPromptParams{Name: PromptSummarizePipeline, Arguments: map[string]string{"owner": "JANE@example.com"}})
+	result, err := s.HandleGetPrompt(ctx, params)
+	require.NoError(t, err)
+	text := result.(mcp.GetPromptResult).Messages[0].Content.Text
+	assert.Equal(t, "opportunity", query["object_api_name"])
+	assert.Equal(t, "__sys_gen_owner_id == 'u1'", query["filter_expr"])
+	assert.Contains(t, text, "owned by Jane Doe")
+	assert.Contains(t, text, `{"__sys_gen_id":"o1","amount":5000,"name":"Big Deal","stage":"Negotiation"}`)
+
+	params, _ = json.Marshal(mcp.GetPromptParams{Name: PromptDraftFollowUp, Arguments: map[string]string{"object": "lead"}})
+	_, err = s.HandleGetPrompt(ctx, params)
+	assert.ErrorContains(t, err, "Missing required argument 'record_id'")
+
+	params, _ = json.Marshal(mcp.GetPromptParams{Name: PromptSummarizePipeline, Arguments: map[string]string{"owner": "nobody"}})
+	_, err = s.HandleGetPrompt(ctx, params)
+	assert.ErrorContains(t, err, "no user matches 'nobody'")
+}
+
+func TestToolMiddleware(t *testing.T) {
+	var deletes int
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deletes++
+		}
+		_, _ = w.Write([]byte(`{"message":"ok"}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+	var audit []ToolCallAudit
+	s.SetAuditSink(ToolAuditFunc(func(_ context.Context, call ToolCallAudit) { audit = append(audit, call) }))
+	s.SetRateLimit(2, time.Minute)
+
+	result := callTool(t, s, ToolDeleteObject, map[string]interface{}{"object_name": "lead"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "confirm: true")
+	assert.Zero(t, deletes, "nothing is deleted without confirmation")
+
+	result = callTool(t, s, ToolDeleteObject, map[string]interface{}{"object_name": "lead", "confirm": true})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, 1, deletes)
+
+	result = callTool(t, s, ToolDeleteObject, map[string]interface{}{"object_name": "lead", "confirm": true})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "Rate limit exceeded: at most 2 tool calls per 1m0s")
+	assert.Equal(t, 1, deletes)
+
+	require.Len(t, audit, 3)
+	assert.Equal(t, "u0", audit[0].UserID)
+	assert.Equal(t, ToolDeleteObject, audit[0].Tool)
+	assert.Equal(t, ToolCallFailed, audit[0].Status)
+	assert.Equal(t, ToolCallSucceeded, audit[1].Status)
+	assert.Equal(t, audit[1].ArgsHash, audit[2].ArgsHash, "equal arguments hash equally")
+	assert.NotEqual(t, audit[0].ArgsHash, audit[1].ArgsHash)
+
+	listed, err := s.HandleListTools(context.Background(), nil)
+	require.NoError(t, err)
+	for _, tool := range listed.(mcp.ListToolsResult).Tools {
+		required := tool.InputSchema.(map[string]interface{})["required"]
+		if destructiveTools[tool.Name] {
+			assert.Contains(t, required, confirmArgument, tool.Name)
+		}
+	}
+}
+
+func TestManagementDeletesRequireConfirmation(t *testing.T) {
+	var deletes int
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deletes++
+		}
+		_, _ = w.Write([]byte(`{"message":"ok"}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	for _, tool := range []string{ToolDeleteApp, ToolDeleteDashboard, ToolDeleteValidationRule} {
+		result := callTool(t, s, tool, map[string]interface{}{"id": "x1"})
+		assert.True(t, result.IsError, tool)
+		assert.Contains(t, result.Content[0].Text, "confirm: true", tool)
+	}
+	assert.Zero(t, deletes, "nothing is deleted without confirmation")
+
+	result := callTool(t, s, ToolDeleteDashboard, map[string]interface{}{"id": "x1", "confirm": true})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, 1, deletes)
+}
+
+func TestToolRateLimiterWindow(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newToolRateLimiter(1, time.Minute)
+	l.now = func() time.Time { return now }
+
+	_, ok := l.allow("u1")
+	assert.True(t, ok)
+	retryIn, ok := l.allow("u1")
+	assert.False(t, ok)
+	assert.Equal(t, time.Minute, retryIn)
+	_, ok = l.allow("u2")
+	assert.True(t, ok, "limits are per user")
+
+	now = now.Add(time.Minute)
+	_, ok = l.allow("u1")
+	assert.True(t, ok, "a new window starts after the old one ends")
+}
+
+func TestCustomTools(t *testing.T) {
+	s := NewToolBusService(client.NewNexusClient("http://127.0.0.1:0"), nil)
+	var audit []ToolCallAudit
+	s.SetAuditSink(ToolAuditFunc(func(_ context.Context, call ToolCallAudit) { audit = append(audit, call) }))
+
+	echo := func(_ context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error) {
+		return mcp.CallToolResult{Content: []mcp.Content{{Type: "text", Text: "hello " + call.Arguments["name"].(string)}}}, nil
+	}
+	require.NoError(t, s.RegisterTool(mcp.Tool{Name: "greet", Description: "Greets someone"}, echo))
+	assert.ErrorContains(t, s.RegisterTool(mcp.Tool{Name: "greet"}, echo), "already registered")
+	assert.ErrorContains(t, s.RegisterTool(mcp.Tool{Name: ToolQueryObject}, echo), "already registered")
+
+	listed, err := s.HandleListTools(context.Background(), nil)
+	require.NoError(t, err)
+	tools := listed.(mcp.ListToolsResult).Tools
+	assert.Equal(t, "greet", tools[len(tools)-1].Name)
+	assert.NotNil(t, tools[len(tools)-1].InputSchema)
+
+	result := callTool(t, s, "greet", map[string]interface{}{"name": "Ada"})
+	require.False(t, result.IsError)
+	assert.Equal(t, "hello Ada", result.Content[0].Text)
+	require.Len(t, audit, 1, "custom tools pass through the middleware")
+	assert.Equal(t, "greet", audit[0].Tool)
+}
+
+func TestContextPinning(t *testing.T) {
+	status := "Open"
+	var queried map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/data/lead/l1":
+			_, _ = w.Write([]byte(`{"data":{"__sys_gen_id":"l1","name":"Ada","status":"` + status + `"}}`))
+		case "/api/data/query":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&queried))
+			_, _ = w.Write([]byte(`{"data":[{"__sys_gen_id":"o1","amount":500}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer backend.Close()
+	store := contextstore.NewContextStore("")
+	s := NewToolBusService(client.NewNexusClient(backend.URL), store)
+
+	result := callTool(t, s, ToolContextAdd, map[string]interface{}{
+		"records": []interface{}{map[string]interface{}{"object": "Lead", "id": "l1"}},
+		"queries": []interface{}{map[string]interface{}{"object": "opportunity", "filter": "amount > 100", "limit": 5}},
+	})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Contains(t, result.Content[0].Text, "Added 2 items to context.")
+	assert.Equal(t, "amount > 100", queried["filter_expr"])
+	assert.EqualValues(t, 5, queried["limit"])
+
+	session := store.GetSession("u0")
+	record, ok := session.Items["nexus://records/lead/l1"]
+	require.True(t, ok)
+	assert.True(t, record.IsSnapshot())
+	assert.Contains(t, record.Content, `"status":"Open"`)
+
+	status = "Qualified"
+	result = callTool(t, s, ToolContextList, nil)
+	assert.Contains(t, result.Content[0].Text, "Active Context (2 items")
+	assert.Contains(t, session.Items["nexus://records/lead/l1"].Content, `"status":"Qualified"`, "listing refreshes snapshots")
+
+	result = callTool(t, s, ToolContextRemove, map[string]interface{}{"files": []interface{}{"nexus://records/lead/l1"}})
+	require.False(t, result.IsError)
+	assert.Len(t, session.ListItems(), 1)
+
+	result = callTool(t, s, ToolContextAdd, map[string]interface{}{
+		"records": []interface{}{map[string]interface{}{"object": "lead", "id": "missing"}},
+	})
+	assert.Contains(t, result.Content[0].Text, "Failed to pin nexus://records/lead/missing")
+	result = callTool(t, s, ToolContextAdd, map[string]interface{}{})
+	assert.True(t, result.IsError)
+}
+
+func TestConversationSettings(t *testing.T) {
+	var calls []string
+	var queried map[string]interface{}
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/api/data/query" {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&queried))
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	settings := &agent.ConversationSettings{ReadOnly: true, AllowedObjects: []string{" Lead", "lead", "Account"}, MaxRecordsPerCall: 10}
+	settings.Normalize()
+	assert.Equal(t, []string{"lead", "account"}, settings.AllowedObjects)
+	ctx := context.WithValue(context.Background(), mcp.ContextKeyAuthToken, "token")
+	ctx = context.WithValue(ctx, mcp.ContextKeyUser, &models.UserSession{ID: "u1"})
+	ctx = agent.WithConversationSettings(ctx, settings)
+
+	result := callToolIn(t, ctx, s, ToolCreateRecord, map[string]interface{}{"object_name": "lead", "data": map[string]interface{}{}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "read-only")
+
+	result = callToolIn(t, ctx, s, ToolQueryObject, map[string]interface{}{"object_name": "Opportunity"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "Object Opportunity is not allowed")
+
+	result = callToolIn(t, ctx, s, ToolSearchRecords, map[string]interface{}{"term": "acme"})
+	assert.True(t, result.IsError, "global search cannot be scoped to objects")
+	assert.Empty(t, calls)
+
+	result = callToolIn(t, ctx, s, ToolQueryObject, map[string]interface{}{"object_name": "Lead", "limit": 500})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.EqualValues(t, 10, queried["limit"], "the page size is capped")
+
+	settings.ReadOnly = false
+	ids := make([]interface{}, 11)
+	for i := range ids {
+		ids[i] = "l" + strconv.Itoa(i)
+	}
+	result = callToolIn(t, ctx, s, ToolBulkDelete, map[string]interface{}{"object_name": "lead", "ids": ids})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "at most 10 per call")
+
+	listed, err := s.HandleListTools(ctx, nil)
+	require.NoError(t, err)
+	for _, tool := range listed.(mcp.ListToolsResult).Tools {
+		assert.NotEqual(t, ToolSearchRecords, tool.Name)
+	}
+	assert.Contains(t, settings.PromptSection(), "Only these objects may be used: lead, account.")
+}
+
+func TestSemanticSearch(t *testing.T) {
+	var body models.SemanticSearchRequest
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/data/semantic-search", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"data":[{"object_api_name":"lead","object_label":"Lead","record":{"__sys_gen_id":"l1","name":"Acme"},"score":0.91}]}`))
+	}))
+	defer backend.Close()
+	s := NewToolBusService(client.NewNexusClient(backend.URL), nil)
+
+	result := callTool(t, s, ToolSemanticSearch, map[string]interface{}{"term": "unhappy customers", "object_names": []interface{}{"lead"}, "limit": 5})
+	require.False(t, result.IsError, result.Content[0].Text)
+	assert.Equal(t, models.SemanticSearchRequest{Term: "unhappy customers", ObjectAPINames: []string{"lead"}, Limit: 5}, body)
+	assert.Contains(t, result.Content[0].Text, "[lead, score 0.91]")
+	assert.Contains(t, result.Content[0].Text, `"name":"Acme"`)
+
+	// Conversations limited to some objects must name them, and only those
+	ctx := context.WithValue(context.Background(), mcp.ContextKeyAuthToken, "token")
+	ctx = agent.WithConversationSettings(ctx, &agent.ConversationSettings{AllowedObjects: []string{"lead"}})
+	result = callToolIn(t, ctx, s, ToolSemanticSearch, map[string]interface{}{"term": "unhappy customers"})
+	assert.True(t, result.IsError)
+	result = callToolIn(t, ctx, s, ToolSemanticSearch, map[string]interface{}{"term": "unhappy customers", "object_names": []interface{}{"lead", "account"}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "Object account is not allowed")
+}
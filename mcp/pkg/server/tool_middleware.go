@@ -0,0 +1,365 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nexuscrm/mcp/pkg/agent"
+	"github.com/nexuscrm/mcp/pkg/mcp"
+	"github.com/nexuscrm/mcp/pkg/models"
+)
+
+const (
+	// Default per-user tool call budget
+	defaultToolRateLimit  = 120
+	defaultToolRateWindow = time.Minute
+
+	// confirmArgument must be true for destructive tools to run
+	confirmArgument = "confirm"
+
+	ToolCallSucceeded = "success"
+	ToolCallFailed    = "error"
+)
+
+// destructiveTools irreversibly remove data and only run with confirm: true
+var destructiveTools = map[string]bool{
+	ToolDeleteObject:         true,
+	ToolDeleteField:          true,
+	ToolPurgeRecord:          true,
+	ToolDeleteApp:            true,
+	ToolDeleteDashboard:      true,
+	ToolDeleteValidationRule: true,
+}
+
+// readOnlyTools do not change CRM data, so they stay available in read-only conversations.
+// Context tools only change what the agent has pinned.
+var readOnlyTools = map[string]bool{
+	ToolListObjects:             true,
+	ToolDescribeObject:          true,
+	ToolQueryObject:             true,
+	ToolGetRecord:               true,
+	ToolGetRecordTimeline:       true,
+	ToolSearchRecords:           true,
+	ToolSearchObject:            true,
+	ToolRunAnalytics:            true,
+	ToolRunReport:               true,
+	ToolAskData:                 true,
+	ToolSemanticSearch:          true,
+	ToolCalculateFormula:        true,
+	ToolListApps:                true,
+	ToolListDashboards:          true,
+	ToolGetDashboard:            true,
+	ToolListThemes:              true,
+	ToolGetRecycleBin:           true,
+	ToolListScheduledJobs:       true,
+	ToolGetValidationRules:      true,
+	ToolListFlows:               true,
+	ToolListPendingApprovals:    true,
+	ToolListUsers:               true,
+	ToolGetEffectivePermissions: true,
+	ToolContextAdd:              true,
+	ToolContextRemove:           true,
+	ToolContextList:             true,
+	ToolContextClear:            true,
+}
+
+// unscopedTools reach records without naming their object, so they are unavailable in
+// conversations limited to some objects
+var unscopedTools = map[string]bool{
+	ToolSearchRecords:        true,
+	ToolGetRecycleBin:        true,
+	ToolRestoreRecord:        true,
+	ToolPurgeRecord:          true,
+	ToolApproveWorkItem:      true,
+	ToolListPendingApprovals: true,
+}
+
+// optionallyScopedTools search every object unless the call names some, so conversations
+// limited to some objects must name them
+var optionallyScopedTools = map[string]bool{
+	ToolAskData:        true,
+	ToolSemanticSearch: true,
+}
+
+// objectArguments name the object a tool works on
+var objectArguments = []string{"object_name", "object_api_name"}
+
+// batchArguments hold the records of a bulk tool
+var batchArguments = []string{"records", "updates", "ids"}
+
+// ToolHandler executes a tool call
+type ToolHandler func(ctx context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error)
+
+// ToolMiddleware wraps tool execution; it may short-circuit the call or observe its result
+type ToolMiddleware func(ctx context.Context, call mcp.CallToolParams, next ToolHandler) (mcp.CallToolResult, error)
+
+// ToolCallAudit describes one tool invocation. Arguments are hashed rather than stored,
+// since they may hold record data.
+type ToolCallAudit struct {
+	UserID     string `json:"user_id"`
+	Tool       string `json:"tool"`
+	ArgsHash   string `json:"args_hash"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// ToolAuditSink receives an audit entry for every tool call
+type ToolAuditSink interface {
+	RecordToolCall(ctx context.Context, call ToolCallAudit)
+}
+
+// ToolAuditFunc adapts a function to ToolAuditSink
+type ToolAuditFunc func(ctx context.Context, call ToolCallAudit)
+
+func (f ToolAuditFunc) RecordToolCall(ctx context.Context, call ToolCallAudit) { f(ctx, call) }
+
+// logAuditSink is the default sink, writing audit entries to the process log
+var logAuditSink = ToolAuditFunc(func(_ context.Context, call ToolCallAudit) {
+	log.Printf("🔧 [MCP] %s called %s (%s): %s %dms", call.UserID, call.Tool, call.ArgsHash, call.Status, call.DurationMs)
+})
+
+// SetAuditSink replaces where tool call audit entries are written
+func (s *ToolBusService) SetAuditSink(sink ToolAuditSink) {
+	s.auditSink = sink
+}
+
+// SetRateLimit allows each user at most limit tool calls per window
+func (s *ToolBusService) SetRateLimit(limit int, window time.Duration) {
+	s.rateLimiter = newToolRateLimiter(limit, window)
+}
+
+// runTool passes the call through the middleware chain, outermost first, to the tool itself
+func (s *ToolBusService) runTool(ctx context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error) {
+	middlewares := []ToolMiddleware{s.auditToolCall, s.limitToolCalls, enforceConversationSettings, confirmDestructiveTool}
+	handler := ToolHandler(s.dispatchTool)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		mw, next := middlewares[i], handler
+		handler = func(ctx context.Context, call mcp.CallToolParams) (mcp.CallToolResult, error) {
+			return mw(ctx, call, next)
+		}
+	}
+	return handler(ctx, call)
+}
+
+// auditToolCall records every call, including ones rejected further down the chain
+func (s *ToolBusService) auditToolCall(ctx context.Context, call mcp.CallToolParams, next ToolHandler) (mcp.CallToolResult, error) {
+	start := time.Now()
+	result, err := next(ctx, call)
+
+	entry := ToolCallAudit{
+		UserID:     toolCallerID(ctx),
+		Tool:       call.Name,
+		ArgsHash:   hashToolArgs(call.Arguments),
+		Status:     ToolCallSucceeded,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	switch {
+	case err != nil:
+		entry.Status, entry.Error = ToolCallFailed, err.Error()
+	case result.IsError:
+		entry.Status = ToolCallFailed
+		if len(result.Content) > 0 {
+			entry.Error = result.Content[0].Text
+		}
+	}
+	if s.auditSink != nil {
+		s.auditSink.RecordToolCall(ctx, entry)
+	}
+	return result, err
+}
+
+// limitToolCalls rejects calls beyond the caller's budget for the current window
+func (s *ToolBusService) limitToolCalls(ctx context.Context, call mcp.CallToolParams, next ToolHandler) (mcp.CallToolResult, error) {
+	if s.rateLimiter != nil {
+		if retryIn, ok := s.rateLimiter.allow(toolCallerID(ctx)); !ok {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf(
+				"Rate limit exceeded: at most %d tool calls per %s. Try again in %ds.",
+				s.rateLimiter.limit, s.rateLimiter.window, int(retryIn.Seconds())+1)}}}, nil
+		}
+	}
+	return next(ctx, call)
+}
+
+// confirmDestructiveTool refuses destructive tools unless the caller passed confirm: true
+func confirmDestructiveTool(ctx context.Context, call mcp.CallToolParams, next ToolHandler) (mcp.CallToolResult, error) {
+	if destructiveTools[call.Name] {
+		if confirmed, _ := call.Arguments[confirmArgument].(bool); !confirmed {
+			return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf(
+				"%s permanently deletes data. Describe exactly what will be deleted, get the user's approval, then call it again with %s: true.",
+				call.Name, confirmArgument)}}}, nil
+		}
+	}
+	return next(ctx, call)
+}
+
+// enforceConversationSettings applies the guardrails of the agent conversation making the call:
+// read-only mode, the allowed objects and the per-call record limit
+func enforceConversationSettings(ctx context.Context, call mcp.CallToolParams, next ToolHandler) (mcp.CallToolResult, error) {
+	settings := agent.ConversationSettingsFromContext(ctx)
+	if !settings.Restricted() {
+		return next(ctx, call)
+	}
+	refuse := func(format string, args ...interface{}) (mcp.CallToolResult, error) {
+		return mcp.CallToolResult{IsError: true, Content: []mcp.Content{{Type: "text", Text: fmt.Sprintf(format, args...)}}}, nil
+	}
+
+	if settings.ReadOnly && !readOnlyTools[call.Name] {
+		return refuse("%s is not allowed: this conversation is read-only.", call.Name)
+	}
+
+	if len(settings.AllowedObjects) > 0 {
+		if unscopedTools[call.Name] {
+			return refuse("%s is not allowed: this conversation is limited to %s.", call.Name, strings.Join(settings.AllowedObjects, ", "))
+		}
+		for _, object := range calledObjects(call) {
+			if !settings.AllowsObject(object) {
+				return refuse("Object %s is not allowed: this conversation is limited to %s.", object, strings.Join(settings.AllowedObjects, ", "))
+			}
+		}
+		if optionallyScopedTools[call.Name] && len(calledObjects(call)) == 0 {
+			return refuse("Name the objects to use: this conversation is limited to %s.", strings.Join(settings.AllowedObjects, ", "))
+		}
+		if call.Name == ToolContextAdd && call.Arguments["saved_searches"] != nil {
+			return refuse("Saved searches cannot be pinned: this conversation is limited to %s.", strings.Join(settings.AllowedObjects, ", "))
+		}
+	}
+
+	if maxRecords := settings.MaxRecordsPerCall; maxRecords > 0 {
+		for _, key := range batchArguments {
+			if batch, ok := call.Arguments[key].([]interface{}); ok && len(batch) > maxRecords {
+				return refuse("%s has %d records; this conversation allows at most %d per call. Split it into smaller batches.", key, len(batch), maxRecords)
+			}
+		}
+		if call.Name == ToolQueryObject {
+			// Cap the page size rather than failing, so the agent still gets an answer
+			args := make(map[string]interface{}, len(call.Arguments)+1)
+			for k, v := range call.Arguments {
+				args[k] = v
+			}
+			if limit, ok := args["limit"].(float64); !ok || int(limit) > maxRecords {
+				args["limit"] = float64(maxRecords)
+			}
+			call.Arguments = args
+		}
+	}
+	return next(ctx, call)
+}
+
+// calledObjects returns the objects a call names, including the CRM data context_add pins
+func calledObjects(call mcp.CallToolParams) []string {
+	var objects []string
+	for _, key := range objectArguments {
+		if object, ok := call.Arguments[key].(string); ok && object != "" {
+			objects = append(objects, object)
+		}
+	}
+	objects = append(objects, getStringSliceFromMap(call.Arguments, "object_names")...)
+	if call.Name == ToolContextAdd {
+		for _, key := range []string{"records", "list_views", "queries"} {
+			for _, ref := range objectArgs(call.Arguments, key) {
+				objects = append(objects, getStringFromMap(ref, "object"))
+			}
+		}
+	}
+	return objects
+}
+
+// allowedTools drops the tools the conversation's guardrails would refuse
+func allowedTools(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+	settings := agent.ConversationSettingsFromContext(ctx)
+	if !settings.Restricted() {
+		return tools
+	}
+	return slices.DeleteFunc(tools, func(t mcp.Tool) bool {
+		return (settings.ReadOnly && !readOnlyTools[t.Name]) || (len(settings.AllowedObjects) > 0 && unscopedTools[t.Name])
+	})
+}
+
+// requireConfirmation adds the confirm argument to a destructive tool's schema
+func requireConfirmation(tool *mcp.Tool) {
+	schema, ok := tool.InputSchema.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		props[confirmArgument] = map[string]interface{}{
+			"type":        "boolean",
+			"description": "Must be true. Only set it after the user has explicitly approved this deletion.",
+		}
+	}
+	required, _ := schema["required"].([]string)
+	schema["required"] = append(required, confirmArgument)
+	tool.Description += " DESTRUCTIVE: requires confirm: true after the user approves."
+}
+
+// toolCallerID identifies the caller for auditing and rate limiting
+func toolCallerID(ctx context.Context) string {
+	if user, _ := ctx.Value(mcp.ContextKeyUser).(*models.UserSession); user != nil {
+		return user.ID
+	}
+	return "anonymous"
+}
+
+// hashToolArgs fingerprints arguments so repeated calls can be correlated without storing them
+func hashToolArgs(args map[string]interface{}) string {
+	raw, _ := json.Marshal(args) // map keys are sorted, so equal arguments hash equally
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8])
+}
+
+// toolRateLimiter counts calls per user in fixed windows
+type toolRateLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newToolRateLimiter(limit int, window time.Duration) *toolRateLimiter {
+	return &toolRateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		windows: make(map[string]*rateWindow),
+	}
+}
+
+// allow counts a call for the user, reporting how long until the next window when over the limit
+func (l *toolRateLimiter) allow(userID string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.windows[userID]
+	if !ok || now.Sub(w.start) >= l.window {
+		// Drop expired windows so idle users do not accumulate
+		for id, other := range l.windows {
+			if now.Sub(other.start) >= l.window {
+				delete(l.windows, id)
+			}
+		}
+		w = &rateWindow{start: now}
+		l.windows[userID] = w
+	}
+	if w.count >= l.limit {
+		return w.start.Add(l.window).Sub(now), false
+	}
+	w.count++
+	return 0, true
+}
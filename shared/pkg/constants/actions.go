@@ -7,9 +7,11 @@ const (
 	ActionTypeDeleteRecord      = "DeleteRecord"
 	ActionTypeSendEmail         = "SendEmail"
 	ActionTypeCallWebhook       = "CallWebhook"
+	ActionTypeCallout           = "Callout"
 	ActionTypeComposite         = "Composite"
 	ActionTypeExecuteAction     = "Action"
 	ActionTypeSubmitForApproval = "SubmitForApproval"
+	ActionTypeRunScript         = "RunScript"
 )
 
 // Flow trigger type constants
@@ -10,6 +10,7 @@ const (
 	HeaderContentType   = "Content-Type"
 	HeaderAuthorization = "Authorization"
 	HeaderXRequestID    = "X-Request-ID"
+	HeaderCSRFToken     = "X-CSRF-Token"
 
 	// Auth
 	BearerPrefix = "Bearer "
@@ -27,6 +27,7 @@ const (
 	FieldTypeMultiPicklist   SchemaFieldType = "MultiPicklist"
 	FieldTypeMasterDetail    SchemaFieldType = "MasterDetail"
 	FieldTypeEncryptedString SchemaFieldType = "EncryptedString"
+	FieldTypeGeolocation     SchemaFieldType = "Geolocation"
 )
 
 // GetAllFieldTypes returns all valid field types as a slice of strings
@@ -55,6 +56,7 @@ func GetAllFieldTypes() []string {
 		string(FieldTypeMultiPicklist),
 		string(FieldTypeMasterDetail),
 		string(FieldTypeEncryptedString),
+		string(FieldTypeGeolocation),
 	}
 }
 
@@ -67,6 +69,60 @@ const (
 	SharingModelPublicReadWrite SharingModel = "PublicReadWrite"
 )
 
+// ListViewVisibility controls who can see a list view
+type ListViewVisibility string
+
+const (
+	ListViewVisibilityPrivate ListViewVisibility = "private" // Owner only
+	ListViewVisibilityShared  ListViewVisibility = "shared"  // Everyone who can read the object
+	ListViewVisibilityProfile ListViewVisibility = "profile" // Owner and users in the listed profiles
+)
+
+// ColumnPin represents where a list view column is pinned
+type ColumnPin string
+
+const (
+	ColumnPinNone  ColumnPin = ""
+	ColumnPinLeft  ColumnPin = "left"
+	ColumnPinRight ColumnPin = "right"
+)
+
+// DashboardRunAs controls whose permissions dashboard widgets run with
+type DashboardRunAs string
+
+const (
+	DashboardRunAsViewer DashboardRunAs = "viewer" // Each viewer sees their own data
+	DashboardRunAsUser   DashboardRunAs = "user"   // Everyone sees the data of the running user
+)
+
+// DashboardFilterType represents the control type of a dashboard filter
+type DashboardFilterType string
+
+const (
+	DashboardFilterDateRange DashboardFilterType = "date_range"
+	DashboardFilterPicklist  DashboardFilterType = "picklist"
+)
+
+// ReportFormat represents the layout of a report
+type ReportFormat string
+
+const (
+	ReportFormatTabular ReportFormat = "tabular" // Flat list of rows
+	ReportFormatSummary ReportFormat = "summary" // Rows grouped by up to three fields with subtotals
+	ReportFormatMatrix  ReportFormat = "matrix"  // Aggregates cross-tabulated by a row and a column grouping
+)
+
+// ReportDateBucket truncates a Date or DateTime report grouping, written as "<field>:<bucket>"
+type ReportDateBucket string
+
+const (
+	ReportDateBucketDay     ReportDateBucket = "day"     // 2024-03-15
+	ReportDateBucketWeek    ReportDateBucket = "week"    // 2024-W11 (ISO week)
+	ReportDateBucketMonth   ReportDateBucket = "month"   // 2024-03
+	ReportDateBucketQuarter ReportDateBucket = "quarter" // 2024-Q1
+	ReportDateBucketYear    ReportDateBucket = "year"    // 2024
+)
+
 // DeleteRule represents referential integrity rules
 type DeleteRule string
 
@@ -92,3 +148,378 @@ const (
 	OutboxStatusProcessed OutboxEventStatus = "processed"
 	OutboxStatusFailed    OutboxEventStatus = "failed"
 )
+
+// AsyncJobStatus represents the lifecycle of a background job
+type AsyncJobStatus string
+
+const (
+	AsyncJobStatusQueued    AsyncJobStatus = "queued"
+	AsyncJobStatusRunning   AsyncJobStatus = "running"
+	AsyncJobStatusCompleted AsyncJobStatus = "completed"
+	AsyncJobStatusFailed    AsyncJobStatus = "failed"
+	AsyncJobStatusAborted   AsyncJobStatus = "aborted"
+)
+
+// CustomSettingScope is the level of the custom setting hierarchy an override applies to
+type CustomSettingScope string
+
+const (
+	CustomSettingScopeProfile CustomSettingScope = "profile"
+	CustomSettingScopeUser    CustomSettingScope = "user"
+)
+
+// NamedCredentialAuthType is how a callout authenticates against an external service
+type NamedCredentialAuthType string
+
+const (
+	NamedCredentialAuthNone   NamedCredentialAuthType = "None"
+	NamedCredentialAuthBasic  NamedCredentialAuthType = "Basic"  // Username + password
+	NamedCredentialAuthBearer NamedCredentialAuthType = "Bearer" // Authorization: Bearer <token>
+	NamedCredentialAuthAPIKey NamedCredentialAuthType = "ApiKey" // <auth_header>: <key>
+)
+
+// SyncProvider is a mail and calendar service users connect to capture activities
+type SyncProvider string
+
+const (
+	SyncProviderGoogle    SyncProvider = "google"       // Gmail and Google Calendar
+	SyncProviderMicrosoft SyncProvider = "microsoft365" // Outlook mail and calendar through Microsoft Graph
+)
+
+// Activity types (_System_Activity.activity_type)
+const (
+	ActivityTypeEmail = "Email"
+	ActivityTypeEvent = "Event"
+	ActivityTypeCall  = "Call"
+)
+
+// TelephonyProvider is a telephony service calls are placed through and reported by
+type TelephonyProvider string
+
+const (
+	TelephonyProviderTwilio TelephonyProvider = "twilio"
+	// TelephonyProviderManual marks calls logged by hand (_System_Activity.connector_id)
+	TelephonyProviderManual TelephonyProvider = "manual"
+)
+
+// Call directions (_System_Activity.call_direction)
+const (
+	CallDirectionInbound  = "inbound"
+	CallDirectionOutbound = "outbound"
+)
+
+// Call statuses (_System_Activity.call_status); providers' statuses are mapped onto these
+const (
+	CallStatusInitiated  = "initiated"
+	CallStatusRinging    = "ringing"
+	CallStatusInProgress = "in-progress"
+	CallStatusCompleted  = "completed"
+	CallStatusBusy       = "busy"
+	CallStatusNoAnswer   = "no-answer"
+	CallStatusFailed     = "failed"
+	CallStatusCanceled   = "canceled"
+)
+
+// HookEvent is a record event integration platforms subscribe to or poll for
+type HookEvent string
+
+const (
+	HookEventCreated HookEvent = "created"
+	HookEventUpdated HookEvent = "updated"
+	HookEventDeleted HookEvent = "deleted" // REST hooks only; deleted records cannot be polled
+)
+
+// InboundHookOperation is what an inbound webhook does with each mapped item
+type InboundHookOperation string
+
+const (
+	InboundHookCreate InboundHookOperation = "create" // Always create a record
+	InboundHookUpsert InboundHookOperation = "upsert" // Update the record whose match field equals the item's, else create
+)
+
+// BatchProcessor is what a batch job does with each chunk of the records in its scope
+type BatchProcessor string
+
+const (
+	BatchProcessorScript     BatchProcessor = "script"      // Run a script once per chunk with the chunk's records as params["records"]
+	BatchProcessorMassUpdate BatchProcessor = "mass_update" // Set the field values on every record
+	BatchProcessorDelete     BatchProcessor = "delete"      // Delete every record
+)
+
+// CustomEndpointAction is what a custom REST endpoint (/api/custom/:name) does with its input
+type CustomEndpointAction string
+
+const (
+	CustomEndpointScript CustomEndpointAction = "script" // Run a script with the input as params and respond with its result
+	CustomEndpointQuery  CustomEndpointAction = "query"  // Respond with the records matching the filter
+	CustomEndpointInsert CustomEndpointAction = "insert" // Create a record from the field values
+	CustomEndpointUpdate CustomEndpointAction = "update" // Update the record with the record ID to the field values
+	CustomEndpointDelete CustomEndpointAction = "delete" // Delete the record with the record ID
+)
+
+// CustomEndpointParamType is the JSON type of a custom endpoint's input parameter
+type CustomEndpointParamType string
+
+const (
+	CustomEndpointParamString  CustomEndpointParamType = "string"
+	CustomEndpointParamNumber  CustomEndpointParamType = "number"
+	CustomEndpointParamInteger CustomEndpointParamType = "integer"
+	CustomEndpointParamBoolean CustomEndpointParamType = "boolean"
+	CustomEndpointParamObject  CustomEndpointParamType = "object"
+	CustomEndpointParamArray   CustomEndpointParamType = "array"
+)
+
+// DocumentTemplateType is the format of a document template
+type DocumentTemplateType string
+
+const (
+	DocumentTemplateHTML DocumentTemplateType = "HTML" // HTML rendered by the built-in renderer or the document converter
+	DocumentTemplateDOCX DocumentTemplateType = "DOCX" // Word document (stored base64-encoded) rendered by the document converter
+)
+
+// ExternalAdapterType is the kind of source an external object reads its records from
+type ExternalAdapterType string
+
+const (
+	ExternalAdapterREST  ExternalAdapterType = "REST"  // JSON endpoint reached through a named credential
+	ExternalAdapterOData ExternalAdapterType = "OData" // OData v4 entity set reached through a named credential
+	ExternalAdapterSQL   ExternalAdapterType = "SQL"   // Table of a MySQL-compatible database
+)
+
+// ChangeOperation is the Debezium operation code of a captured record change
+type ChangeOperation string
+
+const (
+	ChangeOperationCreate ChangeOperation = "c"
+	ChangeOperationUpdate ChangeOperation = "u"
+	ChangeOperationDelete ChangeOperation = "d"
+)
+
+// SLATimerStatus is the state of a record's SLA milestone timer
+type SLATimerStatus string
+
+const (
+	SLATimerStatusRunning   SLATimerStatus = "Running"
+	SLATimerStatusPaused    SLATimerStatus = "Paused" // Record is in a paused status; business time stops accruing
+	SLATimerStatusCompleted SLATimerStatus = "Completed"
+)
+
+// User types (_System_User.user_type)
+const (
+	UserTypeStandard = "Standard" // Internal CRM user
+	UserTypePortal   = "Portal"   // External customer; signs in to the portal and sees only records related to their contact or account
+)
+
+// Setup audit actions (_System_SetupAudit.action)
+const (
+	SetupActionCreate  = "Create"
+	SetupActionUpdate  = "Update"
+	SetupActionDelete  = "Delete"
+	SetupActionAssign  = "Assign"
+	SetupActionRestore = "Restore" // Erased object or field brought back within the grace period
+	SetupActionPurge   = "Purge"   // Erased object or field dropped with its data
+	SetupActionQuery   = "Query"   // Administrator SQL run through the analytics sandbox
+)
+
+// Audited setup components (_System_SetupAudit.component_type)
+const (
+	SetupComponentObject                   = "Object"                         // <object>
+	SetupComponentField                    = "Field"                          // <object>.<field>
+	SetupComponentLayout                   = "Layout"                         // <layout id>
+	SetupComponentLayoutAssignment         = "LayoutAssignment"               // <profile>.<object>
+	SetupComponentFlow                     = "Flow"                           // <flow id>
+	SetupComponentValidationRule           = "ValidationRule"                 // <rule id>
+	SetupComponentProfileObjectPerms       = "ProfileObjectPermissions"       // <profile>
+	SetupComponentProfileFieldPerms        = "ProfileFieldPermissions"        // <profile>
+	SetupComponentProfileQueryLimits       = "ProfileQueryLimits"             // <profile>
+	SetupComponentPermissionSet            = "PermissionSet"                  // <permission set id>
+	SetupComponentPermissionSetObjectPerms = "PermissionSetObjectPermissions" // <permission set id>
+	SetupComponentPermissionSetFieldPerms  = "PermissionSetFieldPermissions"  // <permission set id>
+	SetupComponentAdminQuery               = "AdminQuery"                     // <SQL as submitted>
+)
+
+// Components that can reference a field or object (MetadataDependency.component_type)
+const (
+	DependencyFormulaField   = "FormulaField"
+	DependencyRollupField    = "RollupField"
+	DependencyLookupField    = "LookupField"
+	DependencyDependentField = "DependentPicklist"
+	DependencyValidationRule = "ValidationRule"
+	DependencyFlow           = "Flow"
+	DependencyLayout         = "Layout"
+	DependencyListView       = "ListView"
+	DependencyDashboard      = "Dashboard"
+)
+
+// Targets of a dependency report (DependencyReport.type)
+const (
+	DependencyTargetObject = "object"
+	DependencyTargetField  = "field"
+)
+
+// Kinds of schema drift between the database and its metadata (SchemaDriftIssue.kind)
+const (
+	DriftMissingTable     = "MissingTable"     // Described by metadata but not in the database
+	DriftMissingColumn    = "MissingColumn"    // Field or system column without a physical column
+	DriftMissingIndex     = "MissingIndex"     // Declared index, or unique field, without a database index
+	DriftUnexpectedColumn = "UnexpectedColumn" // Physical column no metadata describes
+)
+
+// Translatable metadata components (_System_Translation.component_type) and the keys identifying them
+const (
+	TranslationTypeObject         = "Object"         // <object>: object label
+	TranslationTypeObjectPlural   = "ObjectPlural"   // <object>: plural label
+	TranslationTypeField          = "Field"          // <object>.<field>: field label
+	TranslationTypeFieldHelp      = "FieldHelp"      // <object>.<field>: help text
+	TranslationTypePicklistValue  = "PicklistValue"  // <object>.<field>.<value>: label shown for a picklist value
+	TranslationTypeApp            = "App"            // <app>: app label
+	TranslationTypeAppNavigation  = "AppNavigation"  // <app>.<item>: navigation item label
+	TranslationTypeValidationRule = "ValidationRule" // <object>.<rule>: validation error message
+)
+
+// Async job types
+const (
+	AsyncJobTypePicklistReplace = "picklist_value_replace"
+	AsyncJobTypeDataQuality     = "data_quality_score"
+	AsyncJobTypeMassTransfer    = "mass_transfer"
+	AsyncJobTypeMassUpdate      = "mass_update"
+	AsyncJobTypeSampleData      = "sample_data"
+	AsyncJobTypeBatch           = "batch_job"
+)
+
+// Forecast categories stages map to (_System_ForecastSetting.category_mapping), in funnel order
+const (
+	ForecastCategoryPipeline = "Pipeline"
+	ForecastCategoryBestCase = "BestCase"
+	ForecastCategoryCommit   = "Commit"
+	ForecastCategoryClosed   = "Closed"
+	ForecastCategoryOmitted  = "Omitted" // Lost or otherwise excluded from the forecast
+)
+
+// ForecastCategories lists the forecast categories in funnel order
+var ForecastCategories = []string{
+	ForecastCategoryPipeline,
+	ForecastCategoryBestCase,
+	ForecastCategoryCommit,
+	ForecastCategoryClosed,
+	ForecastCategoryOmitted,
+}
+
+// Campaign statuses (_System_Campaign.status)
+const (
+	CampaignStatusPlanned    = "Planned"
+	CampaignStatusInProgress = "In Progress"
+	CampaignStatusCompleted  = "Completed"
+	CampaignStatusAborted    = "Aborted"
+)
+
+// CampaignStatuses lists the campaign statuses in lifecycle order
+var CampaignStatuses = []string{
+	CampaignStatusPlanned,
+	CampaignStatusInProgress,
+	CampaignStatusCompleted,
+	CampaignStatusAborted,
+}
+
+// Member statuses of campaigns created without their own (_System_Campaign.member_statuses)
+const (
+	CampaignMemberStatusSent      = "Sent"
+	CampaignMemberStatusResponded = "Responded"
+)
+
+// Order statuses (_System_Order.status)
+const (
+	OrderStatusDraft     = "Draft"
+	OrderStatusActivated = "Activated"
+	OrderStatusFulfilled = "Fulfilled"
+	OrderStatusCancelled = "Cancelled"
+)
+
+// Negative stock policies applied when an activated order needs more than a product has
+const (
+	NegativeStockReject    = "reject"    // Activation fails
+	NegativeStockAllow     = "allow"     // Stock goes below zero
+	NegativeStockBackorder = "backorder" // Stock stops at zero; the shortfall is backordered on the item
+)
+
+// Contract statuses (_System_Contract.status)
+const (
+	ContractStatusDraft      = "Draft"
+	ContractStatusActivated  = "Activated"
+	ContractStatusTerminated = "Terminated"
+)
+
+// ContractStatuses lists the contract statuses in lifecycle order
+var ContractStatuses = []string{
+	ContractStatusDraft,
+	ContractStatusActivated,
+	ContractStatusTerminated,
+}
+
+// Knowledge article statuses (_System_KnowledgeArticle.status). The status describes the
+// working copy; readers see the published version until the article is archived.
+const (
+	KnowledgeArticleStatusDraft     = "Draft"
+	KnowledgeArticleStatusPublished = "Published"
+	KnowledgeArticleStatusArchived  = "Archived"
+)
+
+// Survey score types (_System_Survey.score_type): CSAT asks for 1-5, NPS for 0-10
+const (
+	SurveyScoreTypeCSAT = "CSAT"
+	SurveyScoreTypeNPS  = "NPS"
+)
+
+// Survey follow-up question types (_System_Survey.questions)
+const (
+	SurveyQuestionTypeText   = "text"
+	SurveyQuestionTypeChoice = "choice"
+	SurveyQuestionTypeRating = "rating"
+)
+
+// Record timeline entry types: what a record's timeline merges
+const (
+	TimelineEntryComment     = "comment"      // _System_Comment
+	TimelineEntryFieldChange = "field_change" // _System_AuditLog
+	TimelineEntryTask        = "task"         // Records of the task object linked to the record
+	TimelineEntryEvent       = "event"        // _System_Activity
+	TimelineEntryEmail       = "email"        // _System_Activity
+	TimelineEntryCall        = "call"         // _System_Activity
+	TimelineEntryApproval    = "approval"     // _System_ApprovalWorkItem
+	TimelineEntryFlow        = "flow"         // _System_FlowInstance
+)
+
+// TimelineEntryTypes lists the record timeline entry types in display order
+var TimelineEntryTypes = []string{
+	TimelineEntryComment,
+	TimelineEntryFieldChange,
+	TimelineEntryTask,
+	TimelineEntryEvent,
+	TimelineEntryEmail,
+	TimelineEntryCall,
+	TimelineEntryApproval,
+	TimelineEntryFlow,
+}
+
+// First-run setup states (_System_Organization.setup_status). Setup is pending until the
+// first administrator exists; in_progress marks the one setup request allowed to run.
+const (
+	SetupStatusPending    = "pending"
+	SetupStatusInProgress = "in_progress"
+	SetupStatusCompleted  = "completed"
+)
+
+// Script run statuses (_System_ScriptLog.status)
+const (
+	ScriptStatusSuccess       = "Success"
+	ScriptStatusFailed        = "Failed"
+	ScriptStatusLimitExceeded = "LimitExceeded"
+)
+
+// Script invocations other than trigger events (_System_ScriptLog.invocation)
+const (
+	ScriptInvocationFlow     = "flow"
+	ScriptInvocationManual   = "manual"
+	ScriptInvocationEndpoint = "endpoint" // A custom REST endpoint
+	ScriptInvocationBatch    = "batch"    // A chunk of a batch job
+)
@@ -30,9 +30,11 @@ const (
 
 // Approval work item status constants
 const (
-	ApprovalStatusPending  = "Pending"
-	ApprovalStatusApproved = "Approved"
-	ApprovalStatusRejected = "Rejected"
+	ApprovalStatusPending    = "Pending"
+	ApprovalStatusApproved   = "Approved"
+	ApprovalStatusRejected   = "Rejected"
+	ApprovalStatusRecalled   = "Recalled"   // Withdrawn by the submitter
+	ApprovalStatusReassigned = "Reassigned" // Handed to another approver; a new pending item takes over
 )
 
 // Flow trigger types
@@ -14,3 +14,15 @@ const (
 const (
 	PolymorphicTypeSuffix = "_type"
 )
+
+// Geolocation Suffixes: a Geolocation field keeps its coordinates in two numeric
+// companion columns so they can be filtered and indexed
+const (
+	GeolocationLatitudeSuffix  = "__latitude"
+	GeolocationLongitudeSuffix = "__longitude"
+)
+
+// Lookup Suffixes
+const (
+	LookupNameSuffix = "__name" // Display name of the referenced record in query results
+)
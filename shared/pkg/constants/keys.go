@@ -18,6 +18,16 @@ const (
 	ConfigApproverID      = "approver_id"
 	ConfigApproverFormula = "approver_formula"
 	ConfigComments        = "comments"
+
+	// Callout action keys
+	ConfigNamedCredential = "named_credential"
+	ConfigPath            = "path"
+	ConfigResponseMapping = "response_mapping" // Record field → dotted path into the response body
+	ConfigWriteBack       = "write_back"       // Save mapped fields to the record (default true)
+
+	// RunScript action keys
+	ConfigScript = "script" // Script name
+	ConfigParams = "params" // Templates evaluated against the record, bound to the script's params
 )
 
 // Context Keys
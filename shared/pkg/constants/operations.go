@@ -10,8 +10,9 @@ const (
 
 // Action configuration keys
 const (
-	ConfigKeySteps   = "steps"
-	ConfigKeyResults = "results"
+	ConfigKeySteps     = "steps"
+	ConfigKeyResults   = "results"
+	ConfigKeyAllOrNone = "all_or_none"
 )
 
 // Assertion severity constants
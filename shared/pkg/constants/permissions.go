@@ -9,3 +9,9 @@ const (
 	PermViewAll   = "view_all"   // If needed
 	PermModifyAll = "modify_all" // If needed
 )
+
+// System permissions, granted by the profile or permission set column of the same name
+const (
+	PermBypassFlows      = "bypass_flows"      // Save records without running record-triggered flows
+	PermBypassValidation = "bypass_validation" // Save records without evaluating validation rules
+)
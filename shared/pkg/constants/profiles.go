@@ -5,6 +5,7 @@ package constants
 const (
 	ProfileSystemAdmin  = "system_admin"
 	ProfileStandardUser = "standard_user"
+	ProfilePortalUser   = "portal_user"
 )
 
 // IsSystemAdmin checks if a profile ID is the system admin profile
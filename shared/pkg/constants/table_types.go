@@ -10,4 +10,5 @@ const (
 	TableTypeSystemCore     TableType = "system_core"
 	TableTypeSystemData     TableType = "system_data"
 	TableTypeSystemJunction TableType = "system_junction"
+	TableTypeExternalObject TableType = "external_object"
 )
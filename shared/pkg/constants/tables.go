@@ -66,3 +66,17 @@ func AuditFields() []string {
 		FieldLastModifiedByID,
 	}
 }
+
+// FieldRecordTypeID is the lookup to _System_RecordType that is added to an object
+// when its first record type is created
+const FieldRecordTypeID = "record_type_id"
+
+// Record statistics fields, maintained by the server on the objects configured with
+// RECORD_STATS_OBJECTS. They are read-only system fields that queries and list views
+// filter like any other column.
+const (
+	FieldLastActivityDate   = "last_activity_date"
+	FieldOpenTaskCount      = "open_task_count"
+	FieldStageEnteredDate   = "stage_entered_date"
+	FieldDaysInCurrentStage = "days_in_current_stage"
+)
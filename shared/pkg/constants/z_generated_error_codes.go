@@ -0,0 +1,65 @@
+// Code generated by cmd/codegen. DO NOT EDIT.
+// Source: shared/constants/errorCodes.json
+// Generated at: 2026-10-18T07:01:54Z
+
+package constants
+
+// API Error Codes
+const (
+	ErrorCodeConflict = "CONFLICT" // The resource already exists.
+	ErrorCodeDependencyConflict = "DEPENDENCY_CONFLICT" // The resource is still referenced by other components.
+	ErrorCodeForbidden = "FORBIDDEN" // The resource is restricted to other users.
+	ErrorCodeInternalError = "INTERNAL_ERROR" // An unexpected server error occurred.
+	ErrorCodeInvalidFieldValue = "INVALID_FIELD_VALUE" // A field has a value of the wrong type, length or range.
+	ErrorCodeLimitExceeded = "LIMIT_EXCEEDED" // A governor limit was exceeded.
+	ErrorCodeMalformedRequest = "MALFORMED_REQUEST" // The request body could not be read.
+	ErrorCodeNotFound = "NOT_FOUND" // The resource was not found.
+	ErrorCodePayloadTooLarge = "PAYLOAD_TOO_LARGE" // The request body exceeds the size limit.
+	ErrorCodePermissionDenied = "PERMISSION_DENIED" // The user lacks the permission for this action.
+	ErrorCodeRequiredFieldMissing = "REQUIRED_FIELD_MISSING" // A required field is missing.
+	ErrorCodeTriggerRecursion = "TRIGGER_RECURSION" // Record automation recursed too deeply or kept re-triggering itself.
+	ErrorCodeUnauthorized = "UNAUTHORIZED" // Authentication is required.
+	ErrorCodeUnknownError = "UNKNOWN_ERROR" // An unexpected error occurred.
+	ErrorCodeValidationError = "VALIDATION_ERROR" // The request is invalid.
+	ErrorCodeValidationRuleFailed = "VALIDATION_RULE_FAILED" // The record failed a validation rule.
+)
+
+// ErrorCodeStatus maps each error code to its HTTP status
+var ErrorCodeStatus = map[string]int{
+	ErrorCodeConflict: 409,
+	ErrorCodeDependencyConflict: 409,
+	ErrorCodeForbidden: 403,
+	ErrorCodeInternalError: 500,
+	ErrorCodeInvalidFieldValue: 400,
+	ErrorCodeLimitExceeded: 429,
+	ErrorCodeMalformedRequest: 400,
+	ErrorCodeNotFound: 404,
+	ErrorCodePayloadTooLarge: 413,
+	ErrorCodePermissionDenied: 403,
+	ErrorCodeRequiredFieldMissing: 400,
+	ErrorCodeTriggerRecursion: 422,
+	ErrorCodeUnauthorized: 401,
+	ErrorCodeUnknownError: 500,
+	ErrorCodeValidationError: 400,
+	ErrorCodeValidationRuleFailed: 400,
+}
+
+// ErrorCodeI18nKeys maps each error code to the translation key of its message
+var ErrorCodeI18nKeys = map[string]string{
+	ErrorCodeConflict: "errors.conflict",
+	ErrorCodeDependencyConflict: "errors.dependencyConflict",
+	ErrorCodeForbidden: "errors.forbidden",
+	ErrorCodeInternalError: "errors.internalError",
+	ErrorCodeInvalidFieldValue: "errors.invalidFieldValue",
+	ErrorCodeLimitExceeded: "errors.limitExceeded",
+	ErrorCodeMalformedRequest: "errors.malformedRequest",
+	ErrorCodeNotFound: "errors.notFound",
+	ErrorCodePayloadTooLarge: "errors.payloadTooLarge",
+	ErrorCodePermissionDenied: "errors.permissionDenied",
+	ErrorCodeRequiredFieldMissing: "errors.requiredFieldMissing",
+	ErrorCodeTriggerRecursion: "errors.triggerRecursion",
+	ErrorCodeUnauthorized: "errors.unauthorized",
+	ErrorCodeUnknownError: "errors.unknownError",
+	ErrorCodeValidationError: "errors.validationError",
+	ErrorCodeValidationRuleFailed: "errors.validationRuleFailed",
+}
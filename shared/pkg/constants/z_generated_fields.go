@@ -1,6 +1,6 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: internal/bootstrap/system_tables.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T13:48:25Z
 
 package constants
 
@@ -90,6 +90,17 @@ const (
 	FieldWorkItemID = "work_item_id"
 )
 
+// _System_AI_Context_Item fields
+const (
+	FieldSysAIContextItem_CreatedDate = "__sys_gen_created_date"
+	FieldSysAIContextItem_ID = "__sys_gen_id"
+	FieldSysAIContextItem_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysAIContextItem_Content = "content"
+	FieldSysAIContextItem_Path = "path"
+	FieldSysAIContextItem_TokenSize = "token_size"
+	FieldSysAIContextItem_UserID = "user_id"
+)
+
 // _System_AI_Conversation fields
 const (
 	FieldSysAIConversation_CreatedDate = "__sys_gen_created_date"
@@ -98,6 +109,7 @@ const (
 	FieldSysAIConversation_LastModifiedDate = "__sys_gen_last_modified_date"
 	FieldSysAIConversation_IsActive = "is_active"
 	FieldSysAIConversation_Messages = "messages"
+	FieldSysAIConversation_Settings = "settings"
 	FieldSysAIConversation_Title = "title"
 	FieldSysAIConversation_UserID = "user_id"
 )
@@ -116,6 +128,30 @@ const (
 	FieldSysAction_Type = "type"
 )
 
+// _System_Activity fields
+const (
+	FieldSysActivity_CreatedDate = "__sys_gen_created_date"
+	FieldSysActivity_ID = "__sys_gen_id"
+	FieldSysActivity_IsDeleted = "__sys_gen_is_deleted"
+	FieldSysActivity_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysActivity_OwnerID = "__sys_gen_owner_id"
+	FieldSysActivity_ActivityDate = "activity_date"
+	FieldSysActivity_ActivityType = "activity_type"
+	FieldSysActivity_CallDirection = "call_direction"
+	FieldSysActivity_CallDuration = "call_duration"
+	FieldSysActivity_CallStatus = "call_status"
+	FieldSysActivity_ConnectorID = "connector_id"
+	FieldSysActivity_Description = "description"
+	FieldSysActivity_EndDate = "end_date"
+	FieldSysActivity_ExternalID = "external_id"
+	FieldSysActivity_FromAddress = "from_address"
+	FieldSysActivity_ObjectAPIName = "object_api_name"
+	FieldSysActivity_RecordID = "record_id"
+	FieldSysActivity_RecordingURL = "recording_url"
+	FieldSysActivity_Subject = "subject"
+	FieldSysActivity_ToAddresses = "to_addresses"
+)
+
 // _System_App fields
 const (
 	FieldSysApp_CreatedDate = "__sys_gen_created_date"
@@ -171,6 +207,38 @@ const (
 	FieldSysApprovalWorkItem_SubmittedDate = "submitted_date"
 )
 
+// _System_ArchivePolicy fields
+const (
+	FieldSysArchivePolicy_CreatedDate = "__sys_gen_created_date"
+	FieldSysArchivePolicy_ID = "__sys_gen_id"
+	FieldSysArchivePolicy_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysArchivePolicy_DateField = "date_field"
+	FieldSysArchivePolicy_IsActive = "is_active"
+	FieldSysArchivePolicy_LastArchivedCount = "last_archived_count"
+	FieldSysArchivePolicy_LastError = "last_error"
+	FieldSysArchivePolicy_LastRunDate = "last_run_date"
+	FieldSysArchivePolicy_ObjectAPIName = "object_api_name"
+	FieldSysArchivePolicy_RetainDays = "retain_days"
+)
+
+// _System_AsyncJob fields
+const (
+	FieldSysAsyncJob_CreatedByID = "__sys_gen_created_by_id"
+	FieldSysAsyncJob_CreatedDate = "__sys_gen_created_date"
+	FieldSysAsyncJob_ID = "__sys_gen_id"
+	FieldSysAsyncJob_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysAsyncJob_CompletedDate = "completed_date"
+	FieldSysAsyncJob_ErrorMessage = "error_message"
+	FieldSysAsyncJob_FailedCount = "failed_count"
+	FieldSysAsyncJob_JobType = "job_type"
+	FieldSysAsyncJob_ObjectAPIName = "object_api_name"
+	FieldSysAsyncJob_Parameters = "parameters"
+	FieldSysAsyncJob_ProcessedCount = "processed_count"
+	FieldSysAsyncJob_StartedDate = "started_date"
+	FieldSysAsyncJob_Status = "status"
+	FieldSysAsyncJob_TotalCount = "total_count"
+)
+
 // _System_AuditLog fields
 const (
 	FieldSysAuditLog_CreatedDate = "__sys_gen_created_date"
@@ -193,10 +261,122 @@ const (
 	FieldSysAutoNumber_CurrentNumber = "current_number"
 	FieldSysAutoNumber_DisplayFormat = "display_format"
 	FieldSysAutoNumber_FieldAPIName = "field_api_name"
+	FieldSysAutoNumber_GapFree = "gap_free"
 	FieldSysAutoNumber_ObjectAPIName = "object_api_name"
 	FieldSysAutoNumber_StartingNumber = "starting_number"
 )
 
+// _System_BatchJob fields
+const (
+	FieldSysBatchJob_CreatedDate = "__sys_gen_created_date"
+	FieldSysBatchJob_ID = "__sys_gen_id"
+	FieldSysBatchJob_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysBatchJob_OwnerID = "__sys_gen_owner_id"
+	FieldSysBatchJob_ChunkSize = "chunk_size"
+	FieldSysBatchJob_Concurrency = "concurrency"
+	FieldSysBatchJob_Description = "description"
+	FieldSysBatchJob_FieldValues = "field_values"
+	FieldSysBatchJob_IsActive = "is_active"
+	FieldSysBatchJob_LastJobID = "last_job_id"
+	FieldSysBatchJob_LastRunAt = "last_run_at"
+	FieldSysBatchJob_Name = "name"
+	FieldSysBatchJob_NextRunAt = "next_run_at"
+	FieldSysBatchJob_ObjectAPIName = "object_api_name"
+	FieldSysBatchJob_Processor = "processor"
+	FieldSysBatchJob_Schedule = "schedule"
+	FieldSysBatchJob_ScheduleTimezone = "schedule_timezone"
+	FieldSysBatchJob_ScopeFilter = "scope_filter"
+	FieldSysBatchJob_ScriptName = "script_name"
+)
+
+// _System_BootstrapStep fields
+const (
+	FieldSysBootstrapStep_CreatedDate = "__sys_gen_created_date"
+	FieldSysBootstrapStep_ID = "__sys_gen_id"
+	FieldSysBootstrapStep_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysBootstrapStep_Checksum = "checksum"
+	FieldSysBootstrapStep_DurationMs = "duration_ms"
+	FieldSysBootstrapStep_StepName = "step_name"
+)
+
+// _System_BusinessHours fields
+const (
+	FieldSysBusinessHours_CreatedDate = "__sys_gen_created_date"
+	FieldSysBusinessHours_ID = "__sys_gen_id"
+	FieldSysBusinessHours_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysBusinessHours_IsDefault = "is_default"
+	FieldSysBusinessHours_Label = "label"
+	FieldSysBusinessHours_Name = "name"
+	FieldSysBusinessHours_Schedule = "schedule"
+	FieldSysBusinessHours_Timezone = "timezone"
+)
+
+// _System_Campaign fields
+const (
+	FieldSysCampaign_CreatedDate = "__sys_gen_created_date"
+	FieldSysCampaign_ID = "__sys_gen_id"
+	FieldSysCampaign_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCampaign_OwnerID = "__sys_gen_owner_id"
+	FieldSysCampaign_ActualCost = "actual_cost"
+	FieldSysCampaign_AmountAllOpportunities = "amount_all_opportunities"
+	FieldSysCampaign_AmountWonOpportunities = "amount_won_opportunities"
+	FieldSysCampaign_BudgetedCost = "budgeted_cost"
+	FieldSysCampaign_Description = "description"
+	FieldSysCampaign_EndDate = "end_date"
+	FieldSysCampaign_ExpectedRevenue = "expected_revenue"
+	FieldSysCampaign_IsActive = "is_active"
+	FieldSysCampaign_MemberStatuses = "member_statuses"
+	FieldSysCampaign_Name = "name"
+	FieldSysCampaign_NumberOfMembers = "number_of_members"
+	FieldSysCampaign_NumberOfOpportunities = "number_of_opportunities"
+	FieldSysCampaign_NumberOfResponses = "number_of_responses"
+	FieldSysCampaign_NumberOfWonOpportunities = "number_of_won_opportunities"
+	FieldSysCampaign_RollupsDate = "rollups_date"
+	FieldSysCampaign_StartDate = "start_date"
+	FieldSysCampaign_Status = "status"
+	FieldSysCampaign_Type = "type"
+)
+
+// _System_CampaignMember fields
+const (
+	FieldSysCampaignMember_CreatedDate = "__sys_gen_created_date"
+	FieldSysCampaignMember_ID = "__sys_gen_id"
+	FieldSysCampaignMember_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCampaignMember_AddedByID = "added_by_id"
+	FieldSysCampaignMember_CampaignID = "campaign_id"
+	FieldSysCampaignMember_FirstRespondedDate = "first_responded_date"
+	FieldSysCampaignMember_HasResponded = "has_responded"
+	FieldSysCampaignMember_ObjectAPIName = "object_api_name"
+	FieldSysCampaignMember_RecordID = "record_id"
+	FieldSysCampaignMember_Status = "status"
+)
+
+// _System_ChangeEvent fields
+const (
+	FieldSysChangeEvent_CreatedDate = "__sys_gen_created_date"
+	FieldSysChangeEvent_ID = "__sys_gen_id"
+	FieldSysChangeEvent_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysChangeEvent_AfterData = "after_data"
+	FieldSysChangeEvent_BeforeData = "before_data"
+	FieldSysChangeEvent_ChangedFields = "changed_fields"
+	FieldSysChangeEvent_CommitTimestamp = "commit_timestamp"
+	FieldSysChangeEvent_ObjectAPIName = "object_api_name"
+	FieldSysChangeEvent_Operation = "operation"
+	FieldSysChangeEvent_Position = "position"
+	FieldSysChangeEvent_RecordID = "record_id"
+	FieldSysChangeEvent_TransactionID = "transaction_id"
+	FieldSysChangeEvent_UserID = "user_id"
+)
+
+// _System_ChangeEventOffset fields
+const (
+	FieldSysChangeEventOffset_CreatedDate = "__sys_gen_created_date"
+	FieldSysChangeEventOffset_ID = "__sys_gen_id"
+	FieldSysChangeEventOffset_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysChangeEventOffset_Consumer = "consumer"
+	FieldSysChangeEventOffset_Position = "position"
+)
+
 // _System_Comment fields
 const (
 	FieldSysComment_CreatedByID = "__sys_gen_created_by_id"
@@ -221,6 +401,88 @@ const (
 	FieldSysConfig_Value = "value"
 )
 
+// _System_Contract fields
+const (
+	FieldSysContract_CreatedDate = "__sys_gen_created_date"
+	FieldSysContract_ID = "__sys_gen_id"
+	FieldSysContract_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysContract_OwnerID = "__sys_gen_owner_id"
+	FieldSysContract_AccountID = "account_id"
+	FieldSysContract_Description = "description"
+	FieldSysContract_EndDate = "end_date"
+	FieldSysContract_Name = "name"
+	FieldSysContract_StartDate = "start_date"
+	FieldSysContract_Status = "status"
+)
+
+// _System_CustomEndpoint fields
+const (
+	FieldSysCustomEndpoint_CreatedDate = "__sys_gen_created_date"
+	FieldSysCustomEndpoint_ID = "__sys_gen_id"
+	FieldSysCustomEndpoint_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCustomEndpoint_OwnerID = "__sys_gen_owner_id"
+	FieldSysCustomEndpoint_Action = "action"
+	FieldSysCustomEndpoint_Description = "description"
+	FieldSysCustomEndpoint_FieldValues = "field_values"
+	FieldSysCustomEndpoint_FilterExpr = "filter_expr"
+	FieldSysCustomEndpoint_HttpMethod = "http_method"
+	FieldSysCustomEndpoint_InputSchema = "input_schema"
+	FieldSysCustomEndpoint_IsActive = "is_active"
+	FieldSysCustomEndpoint_Name = "name"
+	FieldSysCustomEndpoint_ObjectAPIName = "object_api_name"
+	FieldSysCustomEndpoint_ProfileIDs = "profile_ids"
+	FieldSysCustomEndpoint_RecordID = "record_id"
+	FieldSysCustomEndpoint_RowLimit = "row_limit"
+	FieldSysCustomEndpoint_ScriptName = "script_name"
+	FieldSysCustomEndpoint_SortDirection = "sort_direction"
+	FieldSysCustomEndpoint_SortField = "sort_field"
+)
+
+// _System_CustomMetadataRecord fields
+const (
+	FieldSysCustomMetadataRecord_CreatedDate = "__sys_gen_created_date"
+	FieldSysCustomMetadataRecord_ID = "__sys_gen_id"
+	FieldSysCustomMetadataRecord_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCustomMetadataRecord_DeveloperName = "developer_name"
+	FieldSysCustomMetadataRecord_FieldValues = "field_values"
+	FieldSysCustomMetadataRecord_Label = "label"
+	FieldSysCustomMetadataRecord_TypeAPIName = "type_api_name"
+)
+
+// _System_CustomMetadataType fields
+const (
+	FieldSysCustomMetadataType_CreatedDate = "__sys_gen_created_date"
+	FieldSysCustomMetadataType_ID = "__sys_gen_id"
+	FieldSysCustomMetadataType_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCustomMetadataType_APIName = "api_name"
+	FieldSysCustomMetadataType_Description = "description"
+	FieldSysCustomMetadataType_Fields = "fields"
+	FieldSysCustomMetadataType_Label = "label"
+)
+
+// _System_CustomSetting fields
+const (
+	FieldSysCustomSetting_CreatedDate = "__sys_gen_created_date"
+	FieldSysCustomSetting_ID = "__sys_gen_id"
+	FieldSysCustomSetting_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCustomSetting_DefaultValue = "default_value"
+	FieldSysCustomSetting_Description = "description"
+	FieldSysCustomSetting_Label = "label"
+	FieldSysCustomSetting_Name = "name"
+	FieldSysCustomSetting_Type = "type"
+)
+
+// _System_CustomSettingValue fields
+const (
+	FieldSysCustomSettingValue_CreatedDate = "__sys_gen_created_date"
+	FieldSysCustomSettingValue_ID = "__sys_gen_id"
+	FieldSysCustomSettingValue_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysCustomSettingValue_ScopeID = "scope_id"
+	FieldSysCustomSettingValue_ScopeType = "scope_type"
+	FieldSysCustomSettingValue_SettingName = "setting_name"
+	FieldSysCustomSettingValue_Value = "value"
+)
+
 // _System_Dashboard fields
 const (
 	FieldSysDashboard_CreatedByID = "__sys_gen_created_by_id"
@@ -231,11 +493,71 @@ const (
 	FieldSysDashboard_LastModifiedDate = "__sys_gen_last_modified_date"
 	FieldSysDashboard_OwnerID = "__sys_gen_owner_id"
 	FieldSysDashboard_Description = "description"
+	FieldSysDashboard_Filters = "filters"
 	FieldSysDashboard_Layout = "layout"
 	FieldSysDashboard_Name = "name"
+	FieldSysDashboard_RunAs = "run_as"
+	FieldSysDashboard_RunAsUserID = "run_as_user_id"
 	FieldSysDashboard_Widgets = "widgets"
 )
 
+// _System_Data_Quality_Rule fields
+const (
+	FieldSysDataQualityRule_CreatedDate = "__sys_gen_created_date"
+	FieldSysDataQualityRule_ID = "__sys_gen_id"
+	FieldSysDataQualityRule_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysDataQualityRule_CompletenessFields = "completeness_fields"
+	FieldSysDataQualityRule_DuplicateThreshold = "duplicate_threshold"
+	FieldSysDataQualityRule_MatchFields = "match_fields"
+	FieldSysDataQualityRule_ObjectAPIName = "object_api_name"
+	FieldSysDataQualityRule_UseEmbeddings = "use_embeddings"
+)
+
+// _System_Data_Quality_Score fields
+const (
+	FieldSysDataQualityScore_CreatedDate = "__sys_gen_created_date"
+	FieldSysDataQualityScore_ID = "__sys_gen_id"
+	FieldSysDataQualityScore_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysDataQualityScore_CompletenessScore = "completeness_score"
+	FieldSysDataQualityScore_DuplicateOf = "duplicate_of"
+	FieldSysDataQualityScore_DuplicateScore = "duplicate_score"
+	FieldSysDataQualityScore_MissingFields = "missing_fields"
+	FieldSysDataQualityScore_ObjectAPIName = "object_api_name"
+	FieldSysDataQualityScore_QualityScore = "quality_score"
+	FieldSysDataQualityScore_RecordID = "record_id"
+	FieldSysDataQualityScore_ScoredDate = "scored_date"
+)
+
+// _System_DeletedMetadata fields
+const (
+	FieldSysDeletedMetadata_CreatedDate = "__sys_gen_created_date"
+	FieldSysDeletedMetadata_ID = "__sys_gen_id"
+	FieldSysDeletedMetadata_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysDeletedMetadata_ComponentType = "component_type"
+	FieldSysDeletedMetadata_DeletedByID = "deleted_by_id"
+	FieldSysDeletedMetadata_DeletedDate = "deleted_date"
+	FieldSysDeletedMetadata_FieldAPIName = "field_api_name"
+	FieldSysDeletedMetadata_Metadata = "metadata"
+	FieldSysDeletedMetadata_ObjectAPIName = "object_api_name"
+	FieldSysDeletedMetadata_PurgeAfter = "purge_after"
+	FieldSysDeletedMetadata_StorageName = "storage_name"
+)
+
+// _System_DocumentTemplate fields
+const (
+	FieldSysDocumentTemplate_CreatedDate = "__sys_gen_created_date"
+	FieldSysDocumentTemplate_ID = "__sys_gen_id"
+	FieldSysDocumentTemplate_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysDocumentTemplate_Content = "content"
+	FieldSysDocumentTemplate_FileName = "file_name"
+	FieldSysDocumentTemplate_IsActive = "is_active"
+	FieldSysDocumentTemplate_Label = "label"
+	FieldSysDocumentTemplate_Name = "name"
+	FieldSysDocumentTemplate_ObjectAPIName = "object_api_name"
+	FieldSysDocumentTemplate_PageSize = "page_size"
+	FieldSysDocumentTemplate_TemplateType = "template_type"
+)
+
 // _System_EmailTemplate fields
 const (
 	FieldSysEmailTemplate_CreatedDate = "__sys_gen_created_date"
@@ -251,6 +573,79 @@ const (
 	FieldSysEmailTemplate_TextBody = "text_body"
 )
 
+// _System_Entitlement fields
+const (
+	FieldSysEntitlement_CreatedDate = "__sys_gen_created_date"
+	FieldSysEntitlement_ID = "__sys_gen_id"
+	FieldSysEntitlement_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysEntitlement_OwnerID = "__sys_gen_owner_id"
+	FieldSysEntitlement_AccountID = "account_id"
+	FieldSysEntitlement_ContractID = "contract_id"
+	FieldSysEntitlement_EndDate = "end_date"
+	FieldSysEntitlement_IsActive = "is_active"
+	FieldSysEntitlement_Name = "name"
+	FieldSysEntitlement_RemainingUnits = "remaining_units"
+	FieldSysEntitlement_StartDate = "start_date"
+	FieldSysEntitlement_TotalUnits = "total_units"
+	FieldSysEntitlement_Type = "type"
+)
+
+// _System_EntitlementUsage fields
+const (
+	FieldSysEntitlementUsage_CreatedDate = "__sys_gen_created_date"
+	FieldSysEntitlementUsage_ID = "__sys_gen_id"
+	FieldSysEntitlementUsage_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysEntitlementUsage_ConsumedByID = "consumed_by_id"
+	FieldSysEntitlementUsage_EntitlementID = "entitlement_id"
+	FieldSysEntitlementUsage_ObjectAPIName = "object_api_name"
+	FieldSysEntitlementUsage_RecordID = "record_id"
+	FieldSysEntitlementUsage_Units = "units"
+)
+
+// _System_EscalationLog fields
+const (
+	FieldSysEscalationLog_CreatedDate = "__sys_gen_created_date"
+	FieldSysEscalationLog_ID = "__sys_gen_id"
+	FieldSysEscalationLog_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysEscalationLog_ErrorMessage = "error_message"
+	FieldSysEscalationLog_EscalatedDate = "escalated_date"
+	FieldSysEscalationLog_Level = "level"
+	FieldSysEscalationLog_ObjectAPIName = "object_api_name"
+	FieldSysEscalationLog_RecordID = "record_id"
+	FieldSysEscalationLog_RuleName = "rule_name"
+)
+
+// _System_EscalationRule fields
+const (
+	FieldSysEscalationRule_CreatedDate = "__sys_gen_created_date"
+	FieldSysEscalationRule_ID = "__sys_gen_id"
+	FieldSysEscalationRule_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysEscalationRule_Actions = "actions"
+	FieldSysEscalationRule_BusinessHours = "business_hours"
+	FieldSysEscalationRule_Criteria = "criteria"
+	FieldSysEscalationRule_IsActive = "is_active"
+	FieldSysEscalationRule_Label = "label"
+	FieldSysEscalationRule_Name = "name"
+	FieldSysEscalationRule_ObjectAPIName = "object_api_name"
+	FieldSysEscalationRule_StartField = "start_field"
+)
+
+// _System_ExternalObject fields
+const (
+	FieldSysExternalObject_CreatedDate = "__sys_gen_created_date"
+	FieldSysExternalObject_ID = "__sys_gen_id"
+	FieldSysExternalObject_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysExternalObject_Adapter = "adapter"
+	FieldSysExternalObject_Dsn = "dsn"
+	FieldSysExternalObject_FieldMap = "field_map"
+	FieldSysExternalObject_IDField = "id_field"
+	FieldSysExternalObject_MaxRows = "max_rows"
+	FieldSysExternalObject_NamedCredential = "named_credential"
+	FieldSysExternalObject_ObjectAPIName = "object_api_name"
+	FieldSysExternalObject_RecordsPath = "records_path"
+	FieldSysExternalObject_Resource = "resource"
+)
+
 // _System_FeedItem fields
 const (
 	FieldSysFeedItem_CreatedByID = "__sys_gen_created_by_id"
@@ -278,6 +673,7 @@ const (
 	FieldSysField_Description = "description"
 	FieldSysField_Formula = "formula"
 	FieldSysField_HelpText = "help_text"
+	FieldSysField_InactiveOptions = "inactive_options"
 	FieldSysField_Indexed = "indexed"
 	FieldSysField_IsMasterDetail = "is_master_detail"
 	FieldSysField_IsNameField = "is_name_field"
@@ -285,6 +681,7 @@ const (
 	FieldSysField_IsSystem = "is_system"
 	FieldSysField_IsUnique = "is_unique"
 	FieldSysField_Label = "label"
+	FieldSysField_LookupFilter = "lookup_filter"
 	FieldSysField_MaxLength = "max_length"
 	FieldSysField_MaxValue = "max_value"
 	FieldSysField_MinLength = "min_length"
@@ -302,6 +699,7 @@ const (
 	FieldSysField_TrackHistory = "track_history"
 	FieldSysField_Type = "type"
 	FieldSysField_Validator = "validator"
+	FieldSysField_ValueSet = "value_set"
 )
 
 // _System_FieldDependency fields
@@ -406,6 +804,56 @@ const (
 	FieldSysFlowStep_StepType = "step_type"
 )
 
+// _System_ForecastAdjustment fields
+const (
+	FieldSysForecastAdjustment_CreatedDate = "__sys_gen_created_date"
+	FieldSysForecastAdjustment_ID = "__sys_gen_id"
+	FieldSysForecastAdjustment_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysForecastAdjustment_AdjustedByID = "adjusted_by_id"
+	FieldSysForecastAdjustment_Amount = "amount"
+	FieldSysForecastAdjustment_Category = "category"
+	FieldSysForecastAdjustment_Note = "note"
+	FieldSysForecastAdjustment_ObjectAPIName = "object_api_name"
+	FieldSysForecastAdjustment_Period = "period"
+	FieldSysForecastAdjustment_UserID = "user_id"
+)
+
+// _System_ForecastQuota fields
+const (
+	FieldSysForecastQuota_CreatedDate = "__sys_gen_created_date"
+	FieldSysForecastQuota_ID = "__sys_gen_id"
+	FieldSysForecastQuota_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysForecastQuota_Amount = "amount"
+	FieldSysForecastQuota_ObjectAPIName = "object_api_name"
+	FieldSysForecastQuota_Period = "period"
+	FieldSysForecastQuota_UserID = "user_id"
+)
+
+// _System_ForecastSetting fields
+const (
+	FieldSysForecastSetting_CreatedDate = "__sys_gen_created_date"
+	FieldSysForecastSetting_ID = "__sys_gen_id"
+	FieldSysForecastSetting_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysForecastSetting_AmountField = "amount_field"
+	FieldSysForecastSetting_CategoryMapping = "category_mapping"
+	FieldSysForecastSetting_CloseDateField = "close_date_field"
+	FieldSysForecastSetting_ObjectAPIName = "object_api_name"
+	FieldSysForecastSetting_StageField = "stage_field"
+)
+
+// _System_GlobalValueSet fields
+const (
+	FieldSysGlobalValueSet_CreatedDate = "__sys_gen_created_date"
+	FieldSysGlobalValueSet_ID = "__sys_gen_id"
+	FieldSysGlobalValueSet_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysGlobalValueSet_Description = "description"
+	FieldSysGlobalValueSet_InactiveOptions = "inactive_options"
+	FieldSysGlobalValueSet_IsStandard = "is_standard"
+	FieldSysGlobalValueSet_Label = "label"
+	FieldSysGlobalValueSet_Name = "name"
+	FieldSysGlobalValueSet_Options = "options"
+)
+
 // _System_Group fields
 const (
 	FieldSysGroup_CreatedDate = "__sys_gen_created_date"
@@ -428,6 +876,107 @@ const (
 	FieldSysGroupMember_UserID = "user_id"
 )
 
+// _System_Holiday fields
+const (
+	FieldSysHoliday_CreatedDate = "__sys_gen_created_date"
+	FieldSysHoliday_ID = "__sys_gen_id"
+	FieldSysHoliday_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysHoliday_BusinessHoursID = "business_hours_id"
+	FieldSysHoliday_HolidayDate = "holiday_date"
+	FieldSysHoliday_IsRecurring = "is_recurring"
+	FieldSysHoliday_Name = "name"
+)
+
+// _System_HookSubscription fields
+const (
+	FieldSysHookSubscription_CreatedDate = "__sys_gen_created_date"
+	FieldSysHookSubscription_ID = "__sys_gen_id"
+	FieldSysHookSubscription_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysHookSubscription_Event = "event"
+	FieldSysHookSubscription_FailureCount = "failure_count"
+	FieldSysHookSubscription_IsActive = "is_active"
+	FieldSysHookSubscription_LastDeliveryDate = "last_delivery_date"
+	FieldSysHookSubscription_LastError = "last_error"
+	FieldSysHookSubscription_ObjectAPIName = "object_api_name"
+	FieldSysHookSubscription_TargetURL = "target_url"
+	FieldSysHookSubscription_UserID = "user_id"
+)
+
+// _System_InboundHook fields
+const (
+	FieldSysInboundHook_CreatedDate = "__sys_gen_created_date"
+	FieldSysInboundHook_ID = "__sys_gen_id"
+	FieldSysInboundHook_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysInboundHook_FieldMapping = "field_mapping"
+	FieldSysInboundHook_IsActive = "is_active"
+	FieldSysInboundHook_Label = "label"
+	FieldSysInboundHook_LastError = "last_error"
+	FieldSysInboundHook_LastReceivedDate = "last_received_date"
+	FieldSysInboundHook_MatchField = "match_field"
+	FieldSysInboundHook_ObjectAPIName = "object_api_name"
+	FieldSysInboundHook_Operation = "operation"
+	FieldSysInboundHook_RecordsPath = "records_path"
+	FieldSysInboundHook_RunAsUserID = "run_as_user_id"
+	FieldSysInboundHook_Secret = "secret"
+	FieldSysInboundHook_Slug = "slug"
+)
+
+// _System_KnowledgeArticle fields
+const (
+	FieldSysKnowledgeArticle_CreatedDate = "__sys_gen_created_date"
+	FieldSysKnowledgeArticle_ID = "__sys_gen_id"
+	FieldSysKnowledgeArticle_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysKnowledgeArticle_OwnerID = "__sys_gen_owner_id"
+	FieldSysKnowledgeArticle_ArchivedDate = "archived_date"
+	FieldSysKnowledgeArticle_Body = "body"
+	FieldSysKnowledgeArticle_CategoryID = "category_id"
+	FieldSysKnowledgeArticle_PublishedDate = "published_date"
+	FieldSysKnowledgeArticle_PublishedVersionNumber = "published_version_number"
+	FieldSysKnowledgeArticle_Status = "status"
+	FieldSysKnowledgeArticle_Summary = "summary"
+	FieldSysKnowledgeArticle_Title = "title"
+	FieldSysKnowledgeArticle_URLName = "url_name"
+	FieldSysKnowledgeArticle_VersionNumber = "version_number"
+)
+
+// _System_KnowledgeArticleLink fields
+const (
+	FieldSysKnowledgeArticleLink_CreatedDate = "__sys_gen_created_date"
+	FieldSysKnowledgeArticleLink_ID = "__sys_gen_id"
+	FieldSysKnowledgeArticleLink_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysKnowledgeArticleLink_ArticleID = "article_id"
+	FieldSysKnowledgeArticleLink_LinkedByID = "linked_by_id"
+	FieldSysKnowledgeArticleLink_ObjectAPIName = "object_api_name"
+	FieldSysKnowledgeArticleLink_RecordID = "record_id"
+	FieldSysKnowledgeArticleLink_VersionNumber = "version_number"
+)
+
+// _System_KnowledgeArticleVersion fields
+const (
+	FieldSysKnowledgeArticleVersion_CreatedDate = "__sys_gen_created_date"
+	FieldSysKnowledgeArticleVersion_ID = "__sys_gen_id"
+	FieldSysKnowledgeArticleVersion_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysKnowledgeArticleVersion_ArticleID = "article_id"
+	FieldSysKnowledgeArticleVersion_Body = "body"
+	FieldSysKnowledgeArticleVersion_CategoryID = "category_id"
+	FieldSysKnowledgeArticleVersion_PublishedByID = "published_by_id"
+	FieldSysKnowledgeArticleVersion_PublishedDate = "published_date"
+	FieldSysKnowledgeArticleVersion_Summary = "summary"
+	FieldSysKnowledgeArticleVersion_Title = "title"
+	FieldSysKnowledgeArticleVersion_VersionNumber = "version_number"
+)
+
+// _System_KnowledgeCategory fields
+const (
+	FieldSysKnowledgeCategory_CreatedDate = "__sys_gen_created_date"
+	FieldSysKnowledgeCategory_ID = "__sys_gen_id"
+	FieldSysKnowledgeCategory_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysKnowledgeCategory_Description = "description"
+	FieldSysKnowledgeCategory_Name = "name"
+	FieldSysKnowledgeCategory_ParentID = "parent_id"
+	FieldSysKnowledgeCategory_SortOrder = "sort_order"
+)
+
 // _System_Layout fields
 const (
 	FieldSysLayout_CreatedDate = "__sys_gen_created_date"
@@ -442,10 +991,17 @@ const (
 	FieldSysListView_CreatedDate = "__sys_gen_created_date"
 	FieldSysListView_ID = "__sys_gen_id"
 	FieldSysListView_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysListView_Aggregates = "aggregates"
+	FieldSysListView_ColumnSettings = "column_settings"
 	FieldSysListView_Fields = "fields"
 	FieldSysListView_FilterExpr = "filter_expr"
 	FieldSysListView_Label = "label"
 	FieldSysListView_ObjectAPIName = "object_api_name"
+	FieldSysListView_OwnerID = "owner_id"
+	FieldSysListView_ProfileIDs = "profile_ids"
+	FieldSysListView_SortDirection = "sort_direction"
+	FieldSysListView_SortField = "sort_field"
+	FieldSysListView_Visibility = "visibility"
 )
 
 // _System_Log fields
@@ -460,6 +1016,22 @@ const (
 	FieldSysLog_Timestamp = "timestamp"
 )
 
+// _System_NamedCredential fields
+const (
+	FieldSysNamedCredential_CreatedDate = "__sys_gen_created_date"
+	FieldSysNamedCredential_ID = "__sys_gen_id"
+	FieldSysNamedCredential_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysNamedCredential_AuthHeader = "auth_header"
+	FieldSysNamedCredential_AuthType = "auth_type"
+	FieldSysNamedCredential_BaseURL = "base_url"
+	FieldSysNamedCredential_Label = "label"
+	FieldSysNamedCredential_MaxRetries = "max_retries"
+	FieldSysNamedCredential_Name = "name"
+	FieldSysNamedCredential_Secret = "secret"
+	FieldSysNamedCredential_TimeoutSeconds = "timeout_seconds"
+	FieldSysNamedCredential_Username = "username"
+)
+
 // _System_Notification fields
 const (
 	FieldSysNotification_CreatedDate = "__sys_gen_created_date"
@@ -512,6 +1084,51 @@ const (
 	FieldSysObjectPerms_ViewAll = "view_all"
 )
 
+// _System_Order fields
+const (
+	FieldSysOrder_CreatedDate = "__sys_gen_created_date"
+	FieldSysOrder_ID = "__sys_gen_id"
+	FieldSysOrder_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysOrder_OwnerID = "__sys_gen_owner_id"
+	FieldSysOrder_ActivatedDate = "activated_date"
+	FieldSysOrder_CancelledDate = "cancelled_date"
+	FieldSysOrder_CustomerID = "customer_id"
+	FieldSysOrder_CustomerObjectAPIName = "customer_object_api_name"
+	FieldSysOrder_Description = "description"
+	FieldSysOrder_FulfilledDate = "fulfilled_date"
+	FieldSysOrder_Name = "name"
+	FieldSysOrder_Status = "status"
+	FieldSysOrder_TotalAmount = "total_amount"
+)
+
+// _System_OrderItem fields
+const (
+	FieldSysOrderItem_CreatedDate = "__sys_gen_created_date"
+	FieldSysOrderItem_ID = "__sys_gen_id"
+	FieldSysOrderItem_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysOrderItem_BackorderedQuantity = "backordered_quantity"
+	FieldSysOrderItem_OrderID = "order_id"
+	FieldSysOrderItem_ProductID = "product_id"
+	FieldSysOrderItem_ProductName = "product_name"
+	FieldSysOrderItem_Quantity = "quantity"
+	FieldSysOrderItem_SortOrder = "sort_order"
+	FieldSysOrderItem_TotalPrice = "total_price"
+	FieldSysOrderItem_UnitPrice = "unit_price"
+)
+
+// _System_Organization fields
+const (
+	FieldSysOrganization_CreatedDate = "__sys_gen_created_date"
+	FieldSysOrganization_ID = "__sys_gen_id"
+	FieldSysOrganization_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysOrganization_DefaultLocale = "default_locale"
+	FieldSysOrganization_Name = "name"
+	FieldSysOrganization_SampleData = "sample_data"
+	FieldSysOrganization_SetupCompletedByID = "setup_completed_by_id"
+	FieldSysOrganization_SetupCompletedDate = "setup_completed_date"
+	FieldSysOrganization_SetupStatus = "setup_status"
+)
+
 // _System_OutboxEvent fields
 const (
 	FieldSysOutboxEvent_CreatedDate = "__sys_gen_created_date"
@@ -531,6 +1148,8 @@ const (
 	FieldSysPermissionSet_ID = "__sys_gen_id"
 	FieldSysPermissionSet_IsDeleted = "__sys_gen_is_deleted"
 	FieldSysPermissionSet_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysPermissionSet_BypassFlows = "bypass_flows"
+	FieldSysPermissionSet_BypassValidation = "bypass_validation"
 	FieldSysPermissionSet_Description = "description"
 	FieldSysPermissionSet_IsActive = "is_active"
 	FieldSysPermissionSet_Label = "label"
@@ -547,6 +1166,17 @@ const (
 	FieldSysPermissionSetAssignment_PermissionSetID = "permission_set_id"
 )
 
+// _System_PortalObject fields
+const (
+	FieldSysPortalObject_CreatedDate = "__sys_gen_created_date"
+	FieldSysPortalObject_ID = "__sys_gen_id"
+	FieldSysPortalObject_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysPortalObject_AccountField = "account_field"
+	FieldSysPortalObject_AllowCreate = "allow_create"
+	FieldSysPortalObject_ContactField = "contact_field"
+	FieldSysPortalObject_ObjectAPIName = "object_api_name"
+)
+
 // _System_Profile fields
 const (
 	FieldSysProfile_CreatedByID = "__sys_gen_created_by_id"
@@ -556,6 +1186,8 @@ const (
 	FieldSysProfile_LastModifiedByID = "__sys_gen_last_modified_by_id"
 	FieldSysProfile_LastModifiedDate = "__sys_gen_last_modified_date"
 	FieldSysProfile_OwnerID = "__sys_gen_owner_id"
+	FieldSysProfile_BypassFlows = "bypass_flows"
+	FieldSysProfile_BypassValidation = "bypass_validation"
 	FieldSysProfile_Description = "description"
 	FieldSysProfile_IsActive = "is_active"
 	FieldSysProfile_IsSystem = "is_system"
@@ -572,6 +1204,29 @@ const (
 	FieldSysProfileLayout_ProfileID = "profile_id"
 )
 
+// _System_ProfileRecordType fields
+const (
+	FieldSysProfileRecordType_CreatedDate = "__sys_gen_created_date"
+	FieldSysProfileRecordType_ID = "__sys_gen_id"
+	FieldSysProfileRecordType_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysProfileRecordType_IsDefault = "is_default"
+	FieldSysProfileRecordType_LayoutID = "layout_id"
+	FieldSysProfileRecordType_ObjectAPIName = "object_api_name"
+	FieldSysProfileRecordType_ProfileID = "profile_id"
+	FieldSysProfileRecordType_RecordTypeID = "record_type_id"
+)
+
+// _System_QueryGovernor fields
+const (
+	FieldSysQueryGovernor_CreatedDate = "__sys_gen_created_date"
+	FieldSysQueryGovernor_ID = "__sys_gen_id"
+	FieldSysQueryGovernor_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysQueryGovernor_MaxConcurrentQueries = "max_concurrent_queries"
+	FieldSysQueryGovernor_MaxExecutionMs = "max_execution_ms"
+	FieldSysQueryGovernor_MaxRows = "max_rows"
+	FieldSysQueryGovernor_ProfileID = "profile_id"
+)
+
 // _System_Recent fields
 const (
 	FieldSysRecent_CreatedDate = "__sys_gen_created_date"
@@ -608,9 +1263,23 @@ const (
 	FieldSysRecordType_LastModifiedDate = "__sys_gen_last_modified_date"
 	FieldSysRecordType_Description = "description"
 	FieldSysRecordType_IsActive = "is_active"
+	FieldSysRecordType_IsDefault = "is_default"
 	FieldSysRecordType_IsMaster = "is_master"
 	FieldSysRecordType_Name = "name"
 	FieldSysRecordType_ObjectID = "object_id"
+	FieldSysRecordType_PicklistValues = "picklist_values"
+)
+
+// _System_Record_Embedding fields
+const (
+	FieldSysRecordEmbedding_CreatedDate = "__sys_gen_created_date"
+	FieldSysRecordEmbedding_ID = "__sys_gen_id"
+	FieldSysRecordEmbedding_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysRecordEmbedding_ContentHash = "content_hash"
+	FieldSysRecordEmbedding_Embedding = "embedding"
+	FieldSysRecordEmbedding_Model = "model"
+	FieldSysRecordEmbedding_ObjectAPIName = "object_api_name"
+	FieldSysRecordEmbedding_RecordID = "record_id"
 )
 
 // _System_RecycleBin fields
@@ -641,6 +1310,26 @@ const (
 	FieldSysRelationship_RestrictedDelete = "restricted_delete"
 )
 
+// _System_Report fields
+const (
+	FieldSysReport_CreatedDate = "__sys_gen_created_date"
+	FieldSysReport_ID = "__sys_gen_id"
+	FieldSysReport_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysReport_Aggregates = "aggregates"
+	FieldSysReport_ColumnGroupings = "column_groupings"
+	FieldSysReport_Columns = "columns"
+	FieldSysReport_Description = "description"
+	FieldSysReport_FilterExpr = "filter_expr"
+	FieldSysReport_Format = "format"
+	FieldSysReport_Groupings = "groupings"
+	FieldSysReport_Name = "name"
+	FieldSysReport_ObjectAPIName = "object_api_name"
+	FieldSysReport_OwnerID = "owner_id"
+	FieldSysReport_RowLimit = "row_limit"
+	FieldSysReport_SortDirection = "sort_direction"
+	FieldSysReport_SortField = "sort_field"
+)
+
 // _System_Role fields
 const (
 	FieldSysRole_CreatedByID = "__sys_gen_created_by_id"
@@ -656,6 +1345,96 @@ const (
 	FieldSysRole_ParentRoleID = "parent_role_id"
 )
 
+// _System_SLAPolicy fields
+const (
+	FieldSysSLAPolicy_CreatedDate = "__sys_gen_created_date"
+	FieldSysSLAPolicy_ID = "__sys_gen_id"
+	FieldSysSLAPolicy_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSLAPolicy_BusinessHours = "business_hours"
+	FieldSysSLAPolicy_ClosedStatuses = "closed_statuses"
+	FieldSysSLAPolicy_IsActive = "is_active"
+	FieldSysSLAPolicy_Label = "label"
+	FieldSysSLAPolicy_Milestones = "milestones"
+	FieldSysSLAPolicy_Name = "name"
+	FieldSysSLAPolicy_ObjectAPIName = "object_api_name"
+	FieldSysSLAPolicy_PausedStatuses = "paused_statuses"
+	FieldSysSLAPolicy_PriorityField = "priority_field"
+	FieldSysSLAPolicy_StatusField = "status_field"
+)
+
+// _System_SLATimer fields
+const (
+	FieldSysSLATimer_CreatedDate = "__sys_gen_created_date"
+	FieldSysSLATimer_ID = "__sys_gen_id"
+	FieldSysSLATimer_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSLATimer_CompletedDate = "completed_date"
+	FieldSysSLATimer_DueDate = "due_date"
+	FieldSysSLATimer_ElapsedSeconds = "elapsed_seconds"
+	FieldSysSLATimer_EscalatedDate = "escalated_date"
+	FieldSysSLATimer_Milestone = "milestone"
+	FieldSysSLATimer_ObjectAPIName = "object_api_name"
+	FieldSysSLATimer_PolicyName = "policy_name"
+	FieldSysSLATimer_RecordID = "record_id"
+	FieldSysSLATimer_RunningSince = "running_since"
+	FieldSysSLATimer_StartedDate = "started_date"
+	FieldSysSLATimer_Status = "status"
+	FieldSysSLATimer_TargetMinutes = "target_minutes"
+	FieldSysSLATimer_Violated = "violated"
+)
+
+// _System_SavedSearch fields
+const (
+	FieldSysSavedSearch_CreatedDate = "__sys_gen_created_date"
+	FieldSysSavedSearch_ID = "__sys_gen_id"
+	FieldSysSavedSearch_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSavedSearch_LastRunDate = "last_run_date"
+	FieldSysSavedSearch_Name = "name"
+	FieldSysSavedSearch_ObjectScope = "object_scope"
+	FieldSysSavedSearch_Term = "term"
+	FieldSysSavedSearch_UserID = "user_id"
+)
+
+// _System_Script fields
+const (
+	FieldSysScript_CreatedDate = "__sys_gen_created_date"
+	FieldSysScript_ID = "__sys_gen_id"
+	FieldSysScript_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysScript_OwnerID = "__sys_gen_owner_id"
+	FieldSysScript_Description = "description"
+	FieldSysScript_IsActive = "is_active"
+	FieldSysScript_MaxCallouts = "max_callouts"
+	FieldSysScript_MaxDmlRows = "max_dml_rows"
+	FieldSysScript_MaxMemoryMb = "max_memory_mb"
+	FieldSysScript_MaxQueryRows = "max_query_rows"
+	FieldSysScript_MaxSteps = "max_steps"
+	FieldSysScript_Name = "name"
+	FieldSysScript_Source = "source"
+	FieldSysScript_TimeoutMs = "timeout_ms"
+	FieldSysScript_TriggerEvents = "trigger_events"
+	FieldSysScript_TriggerObject = "trigger_object"
+)
+
+// _System_ScriptLog fields
+const (
+	FieldSysScriptLog_CreatedDate = "__sys_gen_created_date"
+	FieldSysScriptLog_ID = "__sys_gen_id"
+	FieldSysScriptLog_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysScriptLog_Callouts = "callouts"
+	FieldSysScriptLog_DmlRows = "dml_rows"
+	FieldSysScriptLog_DurationMs = "duration_ms"
+	FieldSysScriptLog_ErrorMessage = "error_message"
+	FieldSysScriptLog_Invocation = "invocation"
+	FieldSysScriptLog_ObjectAPIName = "object_api_name"
+	FieldSysScriptLog_Output = "output"
+	FieldSysScriptLog_QueryRows = "query_rows"
+	FieldSysScriptLog_RecordID = "record_id"
+	FieldSysScriptLog_ScriptID = "script_id"
+	FieldSysScriptLog_ScriptName = "script_name"
+	FieldSysScriptLog_Status = "status"
+	FieldSysScriptLog_Steps = "steps"
+	FieldSysScriptLog_UserID = "user_id"
+)
+
 // _System_Session fields
 const (
 	FieldSysSession_CreatedDate = "__sys_gen_created_date"
@@ -670,6 +1449,22 @@ const (
 	FieldSysSession_UserID = "user_id"
 )
 
+// _System_SetupAudit fields
+const (
+	FieldSysSetupAudit_CreatedDate = "__sys_gen_created_date"
+	FieldSysSetupAudit_ID = "__sys_gen_id"
+	FieldSysSetupAudit_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSetupAudit_Action = "action"
+	FieldSysSetupAudit_ActorID = "actor_id"
+	FieldSysSetupAudit_ActorName = "actor_name"
+	FieldSysSetupAudit_AfterData = "after_data"
+	FieldSysSetupAudit_BeforeData = "before_data"
+	FieldSysSetupAudit_ChangedAt = "changed_at"
+	FieldSysSetupAudit_ComponentName = "component_name"
+	FieldSysSetupAudit_ComponentType = "component_type"
+	FieldSysSetupAudit_ObjectAPIName = "object_api_name"
+)
+
 // _System_SetupPage fields
 const (
 	FieldSysSetupPage_CreatedDate = "__sys_gen_created_date"
@@ -703,6 +1498,101 @@ const (
 	FieldSysSharingRule_ShareWithRoleID = "share_with_role_id"
 )
 
+// _System_StageHistory fields
+const (
+	FieldSysStageHistory_CreatedDate = "__sys_gen_created_date"
+	FieldSysStageHistory_ID = "__sys_gen_id"
+	FieldSysStageHistory_ChangedByID = "changed_by_id"
+	FieldSysStageHistory_DurationSeconds = "duration_seconds"
+	FieldSysStageHistory_EnteredDate = "entered_date"
+	FieldSysStageHistory_ExitedDate = "exited_date"
+	FieldSysStageHistory_FieldAPIName = "field_api_name"
+	FieldSysStageHistory_ObjectAPIName = "object_api_name"
+	FieldSysStageHistory_PreviousValue = "previous_value"
+	FieldSysStageHistory_RecordID = "record_id"
+	FieldSysStageHistory_Value = "value"
+)
+
+// _System_StateMachine fields
+const (
+	FieldSysStateMachine_CreatedDate = "__sys_gen_created_date"
+	FieldSysStateMachine_ID = "__sys_gen_id"
+	FieldSysStateMachine_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysStateMachine_Description = "description"
+	FieldSysStateMachine_FieldAPIName = "field_api_name"
+	FieldSysStateMachine_InitialStates = "initial_states"
+	FieldSysStateMachine_IsActive = "is_active"
+	FieldSysStateMachine_Name = "name"
+	FieldSysStateMachine_ObjectAPIName = "object_api_name"
+	FieldSysStateMachine_States = "states"
+	FieldSysStateMachine_Transitions = "transitions"
+)
+
+// _System_Survey fields
+const (
+	FieldSysSurvey_CreatedDate = "__sys_gen_created_date"
+	FieldSysSurvey_ID = "__sys_gen_id"
+	FieldSysSurvey_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSurvey_OwnerID = "__sys_gen_owner_id"
+	FieldSysSurvey_Description = "description"
+	FieldSysSurvey_IsActive = "is_active"
+	FieldSysSurvey_Name = "name"
+	FieldSysSurvey_Question = "question"
+	FieldSysSurvey_Questions = "questions"
+	FieldSysSurvey_ScoreType = "score_type"
+)
+
+// _System_SurveyInvitation fields
+const (
+	FieldSysSurveyInvitation_CreatedDate = "__sys_gen_created_date"
+	FieldSysSurveyInvitation_ID = "__sys_gen_id"
+	FieldSysSurveyInvitation_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSurveyInvitation_ContactID = "contact_id"
+	FieldSysSurveyInvitation_CreatedByID = "created_by_id"
+	FieldSysSurveyInvitation_ExpiresDate = "expires_date"
+	FieldSysSurveyInvitation_ObjectAPIName = "object_api_name"
+	FieldSysSurveyInvitation_RecordID = "record_id"
+	FieldSysSurveyInvitation_RespondedDate = "responded_date"
+	FieldSysSurveyInvitation_SurveyID = "survey_id"
+	FieldSysSurveyInvitation_TokenHash = "token_hash"
+)
+
+// _System_SurveyResponse fields
+const (
+	FieldSysSurveyResponse_CreatedDate = "__sys_gen_created_date"
+	FieldSysSurveyResponse_ID = "__sys_gen_id"
+	FieldSysSurveyResponse_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSurveyResponse_Answers = "answers"
+	FieldSysSurveyResponse_Comment = "comment"
+	FieldSysSurveyResponse_ContactID = "contact_id"
+	FieldSysSurveyResponse_InvitationID = "invitation_id"
+	FieldSysSurveyResponse_ObjectAPIName = "object_api_name"
+	FieldSysSurveyResponse_RecordID = "record_id"
+	FieldSysSurveyResponse_Score = "score"
+	FieldSysSurveyResponse_SubmittedDate = "submitted_date"
+	FieldSysSurveyResponse_SurveyID = "survey_id"
+)
+
+// _System_SyncConnector fields
+const (
+	FieldSysSyncConnector_CreatedDate = "__sys_gen_created_date"
+	FieldSysSyncConnector_ID = "__sys_gen_id"
+	FieldSysSyncConnector_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysSyncConnector_AccessToken = "access_token"
+	FieldSysSyncConnector_AccountEmail = "account_email"
+	FieldSysSyncConnector_CalendarSyncedUntil = "calendar_synced_until"
+	FieldSysSyncConnector_EmailSyncedUntil = "email_synced_until"
+	FieldSysSyncConnector_IsActive = "is_active"
+	FieldSysSyncConnector_LastError = "last_error"
+	FieldSysSyncConnector_LastSyncDate = "last_sync_date"
+	FieldSysSyncConnector_Provider = "provider"
+	FieldSysSyncConnector_RefreshToken = "refresh_token"
+	FieldSysSyncConnector_SyncCalendar = "sync_calendar"
+	FieldSysSyncConnector_SyncEmail = "sync_email"
+	FieldSysSyncConnector_TokenExpiresAt = "token_expires_at"
+	FieldSysSyncConnector_UserID = "user_id"
+)
+
 // _System_SystemLog fields
 const (
 	FieldSysSystemLog_ID = "__sys_gen_id"
@@ -754,6 +1644,17 @@ const (
 	FieldSysTheme_Name = "name"
 )
 
+// _System_Translation fields
+const (
+	FieldSysTranslation_CreatedDate = "__sys_gen_created_date"
+	FieldSysTranslation_ID = "__sys_gen_id"
+	FieldSysTranslation_LastModifiedDate = "__sys_gen_last_modified_date"
+	FieldSysTranslation_ComponentKey = "component_key"
+	FieldSysTranslation_ComponentType = "component_type"
+	FieldSysTranslation_Locale = "locale"
+	FieldSysTranslation_Translation = "translation"
+)
+
 // _System_UIComponent fields
 const (
 	FieldSysUIComponent_CreatedDate = "__sys_gen_created_date"
@@ -775,15 +1676,19 @@ const (
 	FieldSysUser_LastModifiedByID = "__sys_gen_last_modified_by_id"
 	FieldSysUser_LastModifiedDate = "__sys_gen_last_modified_date"
 	FieldSysUser_OwnerID = "__sys_gen_owner_id"
+	FieldSysUser_AccountID = "account_id"
+	FieldSysUser_ContactID = "contact_id"
 	FieldSysUser_Email = "email"
 	FieldSysUser_FirstName = "first_name"
 	FieldSysUser_IsActive = "is_active"
 	FieldSysUser_LastLoginDate = "last_login_date"
 	FieldSysUser_LastName = "last_name"
+	FieldSysUser_Locale = "locale"
 	FieldSysUser_Password = "password"
 	FieldSysUser_Phone = "phone"
 	FieldSysUser_ProfileID = "profile_id"
 	FieldSysUser_RoleID = "role_id"
+	FieldSysUser_UserType = "user_type"
 	FieldSysUser_Username = "username"
 )
 
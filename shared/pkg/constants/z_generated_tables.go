@@ -1,22 +1,48 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: internal/bootstrap/system_tables.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T13:48:25Z
 
 package constants
 
 // System Table Names
 const (
+	TableAIContextItem = "_System_AI_Context_Item"
 	TableAIConversation = "_System_AI_Conversation"
 	TableAction = "_System_Action"
+	TableActivity = "_System_Activity"
 	TableApp = "_System_App"
 	TableApprovalProcess = "_System_ApprovalProcess"
 	TableApprovalWorkItem = "_System_ApprovalWorkItem"
+	TableArchivePolicy = "_System_ArchivePolicy"
+	TableAsyncJob = "_System_AsyncJob"
 	TableAuditLog = "_System_AuditLog"
 	TableAutoNumber = "_System_AutoNumber"
+	TableBatchJob = "_System_BatchJob"
+	TableBootstrapStep = "_System_BootstrapStep"
+	TableBusinessHours = "_System_BusinessHours"
+	TableCampaign = "_System_Campaign"
+	TableCampaignMember = "_System_CampaignMember"
+	TableChangeEvent = "_System_ChangeEvent"
+	TableChangeEventOffset = "_System_ChangeEventOffset"
 	TableComment = "_System_Comment"
 	TableConfig = "_System_Config"
+	TableContract = "_System_Contract"
+	TableCustomEndpoint = "_System_CustomEndpoint"
+	TableCustomMetadataRecord = "_System_CustomMetadataRecord"
+	TableCustomMetadataType = "_System_CustomMetadataType"
+	TableCustomSetting = "_System_CustomSetting"
+	TableCustomSettingValue = "_System_CustomSettingValue"
 	TableDashboard = "_System_Dashboard"
+	TableDataQualityRule = "_System_Data_Quality_Rule"
+	TableDataQualityScore = "_System_Data_Quality_Score"
+	TableDeletedMetadata = "_System_DeletedMetadata"
+	TableDocumentTemplate = "_System_DocumentTemplate"
 	TableEmailTemplate = "_System_EmailTemplate"
+	TableEntitlement = "_System_Entitlement"
+	TableEntitlementUsage = "_System_EntitlementUsage"
+	TableEscalationLog = "_System_EscalationLog"
+	TableEscalationRule = "_System_EscalationRule"
+	TableExternalObject = "_System_ExternalObject"
 	TableFeedItem = "_System_FeedItem"
 	TableField = "_System_Field"
 	TableFieldDependency = "_System_FieldDependency"
@@ -25,32 +51,65 @@ const (
 	TableFlow = "_System_Flow"
 	TableFlowInstance = "_System_FlowInstance"
 	TableFlowStep = "_System_FlowStep"
+	TableForecastAdjustment = "_System_ForecastAdjustment"
+	TableForecastQuota = "_System_ForecastQuota"
+	TableForecastSetting = "_System_ForecastSetting"
+	TableGlobalValueSet = "_System_GlobalValueSet"
 	TableGroup = "_System_Group"
 	TableGroupMember = "_System_GroupMember"
+	TableHoliday = "_System_Holiday"
+	TableHookSubscription = "_System_HookSubscription"
+	TableInboundHook = "_System_InboundHook"
+	TableKnowledgeArticle = "_System_KnowledgeArticle"
+	TableKnowledgeArticleLink = "_System_KnowledgeArticleLink"
+	TableKnowledgeArticleVersion = "_System_KnowledgeArticleVersion"
+	TableKnowledgeCategory = "_System_KnowledgeCategory"
 	TableLayout = "_System_Layout"
 	TableListView = "_System_ListView"
 	TableLog = "_System_Log"
+	TableNamedCredential = "_System_NamedCredential"
 	TableNotification = "_System_Notification"
 	TableObject = "_System_Object"
 	TableObjectPerms = "_System_ObjectPerms"
+	TableOrder = "_System_Order"
+	TableOrderItem = "_System_OrderItem"
+	TableOrganization = "_System_Organization"
 	TableOutboxEvent = "_System_OutboxEvent"
 	TablePermissionSet = "_System_PermissionSet"
 	TablePermissionSetAssignment = "_System_PermissionSetAssignment"
+	TablePortalObject = "_System_PortalObject"
 	TableProfile = "_System_Profile"
 	TableProfileLayout = "_System_ProfileLayout"
+	TableProfileRecordType = "_System_ProfileRecordType"
+	TableQueryGovernor = "_System_QueryGovernor"
 	TableRecent = "_System_Recent"
 	TableRecordShare = "_System_RecordShare"
 	TableRecordType = "_System_RecordType"
+	TableRecordEmbedding = "_System_Record_Embedding"
 	TableRecycleBin = "_System_RecycleBin"
 	TableRelationship = "_System_Relationship"
+	TableReport = "_System_Report"
 	TableRole = "_System_Role"
+	TableSLAPolicy = "_System_SLAPolicy"
+	TableSLATimer = "_System_SLATimer"
+	TableSavedSearch = "_System_SavedSearch"
+	TableScript = "_System_Script"
+	TableScriptLog = "_System_ScriptLog"
 	TableSession = "_System_Session"
+	TableSetupAudit = "_System_SetupAudit"
 	TableSetupPage = "_System_SetupPage"
 	TableSharingRule = "_System_SharingRule"
+	TableStageHistory = "_System_StageHistory"
+	TableStateMachine = "_System_StateMachine"
+	TableSurvey = "_System_Survey"
+	TableSurveyInvitation = "_System_SurveyInvitation"
+	TableSurveyResponse = "_System_SurveyResponse"
+	TableSyncConnector = "_System_SyncConnector"
 	TableSystemLog = "_System_SystemLog"
 	TableTable = "_System_Table"
 	TableTeamMember = "_System_TeamMember"
 	TableTheme = "_System_Theme"
+	TableTranslation = "_System_Translation"
 	TableUIComponent = "_System_UIComponent"
 	TableUser = "_System_User"
 	TableValidation = "_System_Validation"
@@ -59,17 +118,43 @@ const (
 
 // AllSystemTableNames returns all system table names for validation
 var AllSystemTableNames = []string{
+	TableAIContextItem,
 	TableAIConversation,
 	TableAction,
+	TableActivity,
 	TableApp,
 	TableApprovalProcess,
 	TableApprovalWorkItem,
+	TableArchivePolicy,
+	TableAsyncJob,
 	TableAuditLog,
 	TableAutoNumber,
+	TableBatchJob,
+	TableBootstrapStep,
+	TableBusinessHours,
+	TableCampaign,
+	TableCampaignMember,
+	TableChangeEvent,
+	TableChangeEventOffset,
 	TableComment,
 	TableConfig,
+	TableContract,
+	TableCustomEndpoint,
+	TableCustomMetadataRecord,
+	TableCustomMetadataType,
+	TableCustomSetting,
+	TableCustomSettingValue,
 	TableDashboard,
+	TableDataQualityRule,
+	TableDataQualityScore,
+	TableDeletedMetadata,
+	TableDocumentTemplate,
 	TableEmailTemplate,
+	TableEntitlement,
+	TableEntitlementUsage,
+	TableEscalationLog,
+	TableEscalationRule,
+	TableExternalObject,
 	TableFeedItem,
 	TableField,
 	TableFieldDependency,
@@ -78,32 +163,65 @@ var AllSystemTableNames = []string{
 	TableFlow,
 	TableFlowInstance,
 	TableFlowStep,
+	TableForecastAdjustment,
+	TableForecastQuota,
+	TableForecastSetting,
+	TableGlobalValueSet,
 	TableGroup,
 	TableGroupMember,
+	TableHoliday,
+	TableHookSubscription,
+	TableInboundHook,
+	TableKnowledgeArticle,
+	TableKnowledgeArticleLink,
+	TableKnowledgeArticleVersion,
+	TableKnowledgeCategory,
 	TableLayout,
 	TableListView,
 	TableLog,
+	TableNamedCredential,
 	TableNotification,
 	TableObject,
 	TableObjectPerms,
+	TableOrder,
+	TableOrderItem,
+	TableOrganization,
 	TableOutboxEvent,
 	TablePermissionSet,
 	TablePermissionSetAssignment,
+	TablePortalObject,
 	TableProfile,
 	TableProfileLayout,
+	TableProfileRecordType,
+	TableQueryGovernor,
 	TableRecent,
 	TableRecordShare,
 	TableRecordType,
+	TableRecordEmbedding,
 	TableRecycleBin,
 	TableRelationship,
+	TableReport,
 	TableRole,
+	TableSLAPolicy,
+	TableSLATimer,
+	TableSavedSearch,
+	TableScript,
+	TableScriptLog,
 	TableSession,
+	TableSetupAudit,
 	TableSetupPage,
 	TableSharingRule,
+	TableStageHistory,
+	TableStateMachine,
+	TableSurvey,
+	TableSurveyInvitation,
+	TableSurveyResponse,
+	TableSyncConnector,
 	TableSystemLog,
 	TableTable,
 	TableTeamMember,
 	TableTheme,
+	TableTranslation,
 	TableUIComponent,
 	TableUser,
 	TableValidation,
@@ -0,0 +1,21 @@
+// Code generated by cmd/codegen. DO NOT EDIT.
+// Source: internal/bootstrap/standard_value_sets.json
+// Generated at: 2026-10-18T05:25:48Z
+
+package constants
+
+// Standard Global Value Set Names
+const (
+	ValueSetIndustry = "Industry"
+	ValueSetLeadSource = "LeadSource"
+	ValueSetRating = "Rating"
+	ValueSetSalutation = "Salutation"
+)
+
+// StandardValueSetNames lists the standard global value sets
+var StandardValueSetNames = []string{
+	ValueSetIndustry,
+	ValueSetLeadSource,
+	ValueSetRating,
+	ValueSetSalutation,
+}
@@ -8,6 +8,8 @@ type UserSession struct {
 	ProfileID     string  `json:"profile_id"`
 	RoleID        *string `json:"role_id,omitempty"`
 	IsSystemAdmin bool    `json:"is_system_admin"`
+	UserType      string  `json:"user_type,omitempty"` // constants.UserTypePortal for external portal users
+	Locale        string  `json:"locale,omitempty"`    // Preferred locale for translated metadata
 }
 
 // SystemPermissionSetAssignment - use generated
@@ -15,3 +17,69 @@ type UserSession struct {
 
 // SystemGroupMember - use generated
 // type GroupMember struct { ... }
+
+// UserOwnershipReport lists what a user owns or is responsible for, so it can be handed over
+// before the user is deactivated
+type UserOwnershipReport struct {
+	UserID            string             `json:"user_id"`
+	Records           []OwnedRecordCount `json:"records"`            // Records owned, per object
+	Flows             []OwnedComponent   `json:"flows"`              // Flows owned, scheduled ones included
+	PendingApprovals  []OwnedComponent   `json:"pending_approvals"`  // Work items awaiting the user's decision
+	ApprovalProcesses []OwnedComponent   `json:"approval_processes"` // Processes naming the user as approver
+	Dashboards        []OwnedComponent   `json:"dashboards"`         // Dashboards running as the user
+	Reports           []OwnedComponent   `json:"reports"`            // Reports owned
+}
+
+// OwnedRecordCount is the number of records of an object a user owns
+type OwnedRecordCount struct {
+	ObjectAPIName string `json:"object_api_name"`
+	Count         int64  `json:"count"`
+}
+
+// OwnedComponent is a flow, approval, dashboard or report tied to a user
+type OwnedComponent struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// UserDeactivationRequest deactivates a user, handing what they own to another user or,
+// without one, freezing it
+type UserDeactivationRequest struct {
+	TransferToUserID string `json:"transfer_to_user_id,omitempty"`
+	KeepManualShares bool   `json:"keep_manual_shares,omitempty"` // Keep the manual shares granted on transferred records
+}
+
+// SetupRequest completes first-run setup: it names the organization and creates the first
+// system administrator
+type SetupRequest struct {
+	OrgName    string     `json:"org_name"`
+	Admin      SetupAdmin `json:"admin"`
+	Locale     string     `json:"locale,omitempty"` // Org default locale, also the admin's own
+	SampleData bool       `json:"sample_data,omitempty"`
+	SetupToken string     `json:"setup_token,omitempty"` // Required when the server sets SETUP_TOKEN
+}
+
+// SetupAdmin is the first system administrator created by setup
+type SetupAdmin struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// SetupState tells the setup wizard whether first-run setup is still open
+type SetupState struct {
+	Status        string `json:"status"`
+	Required      bool   `json:"required"`       // The setup form may be submitted
+	TokenRequired bool   `json:"token_required"` // The server expects SETUP_TOKEN with the form
+	OrgName       string `json:"org_name,omitempty"`
+	DefaultLocale string `json:"default_locale,omitempty"`
+}
+
+// SetupResult is the organization and administrator created by setup; the administrator
+// signs in through the regular login endpoint
+type SetupResult struct {
+	Organization     *SystemOrganization `json:"organization"`
+	Admin            *UserSession        `json:"admin"`
+	SampleDataLoaded bool                `json:"sample_data_loaded"`
+}
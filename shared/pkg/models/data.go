@@ -3,6 +3,8 @@ package models
 import (
 	"database/sql"
 	"time"
+
+	"github.com/nexuscrm/shared/pkg/constants"
 )
 
 // SObject represents a generic record
@@ -44,12 +46,356 @@ func (s SObject) Get(key string) interface{} {
 	return s[key]
 }
 
+// GeoPoint is the value of a Geolocation field, in decimal degrees
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
 // SearchResult represents global search results
 type SearchResult struct {
 	ObjectLabel   string    `json:"object_label"`
 	ObjectAPIName string    `json:"object_api_name"`
 	Icon          string    `json:"icon"`
 	Matches       []SObject `json:"matches"`
+
+	// Populated only when a full-text search engine is configured (keyed by record ID)
+	Scores     map[string]float64           `json:"scores,omitempty"`
+	Highlights map[string]map[string]string `json:"highlights,omitempty"`
+}
+
+// SemanticSearchRequest searches records by meaning rather than by keyword
+type SemanticSearchRequest struct {
+	Term           string   `json:"term" binding:"required"`
+	ObjectAPINames []string `json:"object_api_names,omitempty"` // Empty searches every readable object
+	Limit          int      `json:"limit,omitempty"`
+}
+
+// SemanticSearchHit is one record ranked by similarity to a semantic search term
+type SemanticSearchHit struct {
+	ObjectAPIName string  `json:"object_api_name"`
+	ObjectLabel   string  `json:"object_label"`
+	Record        SObject `json:"record"`
+	Score         float64 `json:"score"` // Cosine similarity; higher is closer
+}
+
+// DataQualityRule configures how one object's records are scored for data quality
+type DataQualityRule struct {
+	ObjectAPIName      string   `json:"object_api_name"`
+	CompletenessFields []string `json:"completeness_fields,omitempty"` // Empty scores the required fields and the name field
+	MatchFields        []string `json:"match_fields,omitempty"`        // Empty compares the name, email and phone fields
+	DuplicateThreshold int      `json:"duplicate_threshold,omitempty"` // Similarity (1-100) at which records are probable duplicates
+	UseEmbeddings      bool     `json:"use_embeddings"`                // Also compare record embeddings (requires semantic search)
+}
+
+// DataQualitySummary is one object's row of the data quality dashboard
+type DataQualitySummary struct {
+	ObjectAPIName       string         `json:"object_api_name"`
+	ObjectLabel         string         `json:"object_label"`
+	ScoredRecords       int            `json:"scored_records"`
+	AverageCompleteness float64        `json:"average_completeness"`
+	AverageQuality      float64        `json:"average_quality"`
+	ProbableDuplicates  int            `json:"probable_duplicates"`
+	QualityBands        map[string]int `json:"quality_bands"`  // Record counts for "poor" (<50), "fair" (50-79) and "good" (80+)
+	MissingFields       map[string]int `json:"missing_fields"` // Field -> number of records missing it
+	LastScoredDate      *time.Time     `json:"last_scored_date,omitempty"`
+}
+
+// DataQualityRecord is a scored record listed for cleanup
+type DataQualityRecord struct {
+	RecordID          string    `json:"record_id"`
+	Name              string    `json:"name"`
+	CompletenessScore int       `json:"completeness_score"`
+	DuplicateScore    int       `json:"duplicate_score"`
+	DuplicateOf       string    `json:"duplicate_of,omitempty"`
+	QualityScore      int       `json:"quality_score"`
+	MissingFields     []string  `json:"missing_fields"`
+	ScoredDate        time.Time `json:"scored_date"`
+}
+
+// SLATimer tracks one SLA milestone of a record. ElapsedSeconds is the business time
+// accrued up to RunningSince; reads add the time accrued since.
+type SLATimer struct {
+	ID             string                   `json:"__sys_gen_id"`
+	PolicyName     string                   `json:"policy_name"`
+	ObjectAPIName  string                   `json:"object_api_name"`
+	RecordID       string                   `json:"record_id"`
+	Milestone      string                   `json:"milestone"`
+	TargetMinutes  int                      `json:"target_minutes"`
+	Status         constants.SLATimerStatus `json:"status"`
+	ElapsedSeconds int64                    `json:"elapsed_seconds"`
+	RunningSince   *time.Time               `json:"running_since,omitempty"`
+	DueDate        *time.Time               `json:"due_date,omitempty"` // When a running timer is violated
+	StartedDate    time.Time                `json:"started_date"`
+	CompletedDate  *time.Time               `json:"completed_date,omitempty"`
+	Violated       bool                     `json:"violated"`
+	EscalatedDate  *time.Time               `json:"escalated_date,omitempty"`
+}
+
+// StageHistoryEntry is a stay of a record in one value of a history-tracked picklist field.
+// ExitedDate and DurationSeconds are set once the field moves on to another value.
+type StageHistoryEntry struct {
+	ID              string     `json:"__sys_gen_id"`
+	ObjectAPIName   string     `json:"object_api_name"`
+	RecordID        string     `json:"record_id"`
+	FieldAPIName    string     `json:"field_api_name"`
+	Value           string     `json:"value"`
+	PreviousValue   *string    `json:"previous_value,omitempty"`
+	EnteredDate     time.Time  `json:"entered_date"`
+	ExitedDate      *time.Time `json:"exited_date,omitempty"`
+	DurationSeconds *int64     `json:"duration_seconds,omitempty"`
+	ChangedByID     *string    `json:"changed_by_id,omitempty"`
+}
+
+// StageAnalytics summarizes the stays entered within a period for a funnel and velocity
+// report of one picklist field. Stages follow the picklist order.
+type StageAnalytics struct {
+	ObjectAPIName string            `json:"object_api_name"`
+	FieldAPIName  string            `json:"field_api_name"`
+	From          *time.Time        `json:"from,omitempty"`
+	To            *time.Time        `json:"to,omitempty"`
+	Stages        []StageMetrics    `json:"stages"`
+	Transitions   []StageTransition `json:"transitions"`
+}
+
+// StageMetrics are the figures of one picklist value. Reached counts the records that
+// entered the value or a later one (the funnel); ConversionRate is the share of them that
+// reached the next value.
+type StageMetrics struct {
+	Value              string   `json:"value"`
+	Entries            int      `json:"entries"`
+	Records            int      `json:"records"`
+	Reached            int      `json:"reached"`
+	Current            int      `json:"current"`
+	Exited             int      `json:"exited"`
+	AvgDurationSeconds float64  `json:"avg_duration_seconds"`
+	MaxDurationSeconds int64    `json:"max_duration_seconds"`
+	ConversionRate     *float64 `json:"conversion_rate,omitempty"`
+}
+
+// StageTransition counts the moves from one picklist value to another
+type StageTransition struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+// ForecastSetting configures forecasting on one object (typically opportunity)
+type ForecastSetting struct {
+	ObjectAPIName   string            `json:"object_api_name"`
+	AmountField     string            `json:"amount_field"`
+	CloseDateField  string            `json:"close_date_field"`
+	StageField      string            `json:"stage_field"`
+	CategoryMapping map[string]string `json:"category_mapping"` // Stage value -> forecast category; unmapped stages count as Pipeline
+}
+
+// ForecastQuotaInput sets the quotas of users for one period
+type ForecastQuotaInput struct {
+	ObjectAPIName string              `json:"object_api_name"`
+	Period        string              `json:"period"`
+	Quotas        []ForecastQuotaItem `json:"quotas"`
+}
+
+// ForecastQuotaItem is the quota of one user; a nil amount removes it
+type ForecastQuotaItem struct {
+	UserID string   `json:"user_id"`
+	Amount *float64 `json:"amount"`
+}
+
+// ForecastAdjustmentInput overrides the amount a user forecasts in a category
+type ForecastAdjustmentInput struct {
+	ObjectAPIName string  `json:"object_api_name"`
+	UserID        string  `json:"user_id"`
+	Period        string  `json:"period"`
+	Category      string  `json:"category"`
+	Amount        float64 `json:"amount"`
+	Note          *string `json:"note,omitempty"`
+}
+
+// ForecastRollup is the forecast of a period rolled up along the role hierarchy
+type ForecastRollup struct {
+	ObjectAPIName string          `json:"object_api_name"`
+	Period        string          `json:"period"`
+	PeriodStart   string          `json:"period_start"` // Inclusive date
+	PeriodEnd     string          `json:"period_end"`   // Exclusive date
+	Categories    []string        `json:"categories"`
+	Roles         []*ForecastNode `json:"roles"`
+}
+
+// ForecastNode is one role of the hierarchy: its users and subordinate roles, with totals
+// covering the whole branch
+type ForecastNode struct {
+	RoleID     *string            `json:"role_id"` // Nil for users without a role
+	RoleName   string             `json:"role_name"`
+	Users      []ForecastUser     `json:"users"`
+	Children   []*ForecastNode    `json:"children"`
+	Quota      float64            `json:"quota"`
+	Amounts    map[string]float64 `json:"amounts"`
+	Adjusted   map[string]float64 `json:"adjusted"`
+	Attainment *float64           `json:"attainment,omitempty"` // Adjusted closed amount over quota
+}
+
+// ForecastUser is the forecast of one user: amounts computed from the records they own,
+// and the same amounts after manager adjustments
+type ForecastUser struct {
+	UserID      string                      `json:"user_id"`
+	Name        string                      `json:"name"`
+	Quota       *float64                    `json:"quota,omitempty"`
+	Amounts     map[string]float64          `json:"amounts"`
+	Adjusted    map[string]float64          `json:"adjusted"`
+	Adjustments []*SystemForecastAdjustment `json:"adjustments,omitempty"`
+	Attainment  *float64                    `json:"attainment,omitempty"`
+}
+
+// CampaignMemberStatus is a status campaign members can have; responded statuses count as
+// responses in the campaign rollups
+type CampaignMemberStatus struct {
+	Status    string `json:"status"`
+	Responded bool   `json:"responded"`
+	IsDefault bool   `json:"is_default,omitempty"` // Given to added members without a status
+}
+
+// CampaignDetail is a campaign with its member statuses, member counts per status and the
+// ratios derived from its rollups
+type CampaignDetail struct {
+	Campaign     *SystemCampaign        `json:"campaign"`
+	Statuses     []CampaignMemberStatus `json:"statuses"`
+	StatusCounts map[string]int         `json:"status_counts"`
+	ResponseRate *float64               `json:"response_rate,omitempty"` // Responses over members
+	ROI          *float64               `json:"roi,omitempty"`           // (Won amount - actual cost) / actual cost
+}
+
+// AddCampaignMembersInput adds the records of one object to a campaign, given either by ID,
+// or as the records matching a list view or a report
+type AddCampaignMembersInput struct {
+	ObjectAPIName string   `json:"object_api_name,omitempty"` // Required with record_ids
+	RecordIDs     []string `json:"record_ids,omitempty"`
+	ListViewID    string   `json:"list_view_id,omitempty"`
+	ReportID      string   `json:"report_id,omitempty"`
+	Status        string   `json:"status,omitempty"` // Defaults to the campaign's default status
+}
+
+// AddCampaignMembersResult counts the records added to a campaign and those already members
+type AddCampaignMembersResult struct {
+	Added   int `json:"added"`
+	Skipped int `json:"skipped"`
+}
+
+// OrderDetail is an order with its items
+type OrderDetail struct {
+	Order *SystemOrder       `json:"order"`
+	Items []*SystemOrderItem `json:"items"`
+}
+
+// PublishedArticle is the published version of a knowledge article as readers see it.
+// Score and Highlights are set on search results.
+type PublishedArticle struct {
+	Version    *SystemKnowledgeArticleVersion `json:"version"`
+	URLName    string                         `json:"url_name"`
+	Score      float64                        `json:"score,omitempty"`
+	Highlights map[string]string              `json:"highlights,omitempty"`
+}
+
+// SurveyQuestion is a follow-up question of a survey, after its score question
+type SurveyQuestion struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"` // text, choice or rating (1-5)
+	Required bool     `json:"required,omitempty"`
+	Options  []string `json:"options,omitempty"` // Choices of a choice question
+}
+
+// PublicSurvey is what a survey link shows the person answering it
+type PublicSurvey struct {
+	Name        string           `json:"name"`
+	Description *string          `json:"description,omitempty"`
+	ScoreType   string           `json:"score_type"`
+	Question    string           `json:"question"`
+	ScoreMin    int              `json:"score_min"`
+	ScoreMax    int              `json:"score_max"`
+	Questions   []SurveyQuestion `json:"questions"`
+	ExpiresDate *time.Time       `json:"expires_date,omitempty"`
+}
+
+// SurveySubmission is an answer posted to a survey link
+type SurveySubmission struct {
+	Score   *int                   `json:"score"`
+	Answers map[string]interface{} `json:"answers,omitempty"` // By question key
+	Comment *string                `json:"comment,omitempty"`
+}
+
+// CreateSurveyInvitationInput asks for a survey link about a record and/or contact
+type CreateSurveyInvitationInput struct {
+	ObjectAPIName string `json:"object_api_name,omitempty"`
+	RecordID      string `json:"record_id,omitempty"`
+	ContactID     string `json:"contact_id,omitempty"`
+}
+
+// SurveyInvitationLink is a new invitation with its token, which is only shown once
+type SurveyInvitationLink struct {
+	Invitation *SystemSurveyInvitation `json:"invitation"`
+	Token      string                  `json:"token"`
+	URL        string                  `json:"url"`
+}
+
+// SurveyScoreCount is the number of responses with a score
+type SurveyScoreCount struct {
+	Score int `json:"score"`
+	Count int `json:"count"`
+}
+
+// SurveyScoreSummary aggregates a set of responses. CSAT is the percentage of CSAT responses
+// scoring 4 or 5; NPS is the percentage of promoters (9-10) minus that of detractors (0-6).
+type SurveyScoreSummary struct {
+	Responses    int      `json:"responses"`
+	AverageScore float64  `json:"average_score"`
+	CSAT         *float64 `json:"csat,omitempty"`
+	NPS          *float64 `json:"nps,omitempty"`
+	Promoters    int      `json:"promoters,omitempty"`
+	Passives     int      `json:"passives,omitempty"`
+	Detractors   int      `json:"detractors,omitempty"`
+}
+
+// SurveyAnalyticsPeriod summarizes the responses of one day, week or month
+type SurveyAnalyticsPeriod struct {
+	Period string `json:"period"` // First day of the period, YYYY-MM-DD
+	SurveyScoreSummary
+}
+
+// SurveyAnalytics summarizes the responses to a survey for dashboards
+type SurveyAnalytics struct {
+	SurveyID     string                  `json:"survey_id"`
+	ScoreType    string                  `json:"score_type"`
+	Interval     string                  `json:"interval"`
+	Distribution []SurveyScoreCount      `json:"distribution"`
+	Series       []SurveyAnalyticsPeriod `json:"series"`
+	SurveyScoreSummary
+}
+
+// TimelineEntry is one item of a record's timeline: a comment, field change, task, event,
+// email, call, approval or flow run
+type TimelineEntry struct {
+	ID      string                 `json:"id"` // ID of the comment, audit entry, task, ... it shows
+	Type    string                 `json:"type"`
+	Date    time.Time              `json:"date"`
+	Title   string                 `json:"title"`
+	Body    *string                `json:"body,omitempty"`
+	ActorID *string                `json:"actor_id,omitempty"` // User who commented, changed, owns or submitted it
+	Details map[string]interface{} `json:"details,omitempty"`  // Type-specific fields
+}
+
+// TimelinePage is a page of a record's timeline, newest first
+type TimelinePage struct {
+	Entries    []*TimelineEntry `json:"entries"`
+	NextCursor string           `json:"next_cursor,omitempty"` // Empty on the last page
+}
+
+// RecentItemGroup groups a user's recently viewed records by object
+type RecentItemGroup struct {
+	ObjectLabel   string          `json:"object_label"`
+	ObjectAPIName string          `json:"object_api_name"`
+	Icon          string          `json:"icon"`
+	Items         []*SystemRecent `json:"items"`
 }
 
 // AnalyticsQuery represents an analytics query
@@ -84,6 +430,129 @@ type QueryRequest struct {
 	Limit         int              `json:"limit,omitempty"`
 	Offset        int              `json:"offset,omitempty"`
 	OrderBy       []SortCriterion  `json:"order_by,omitempty"`
+	ForView       bool             `json:"for_view,omitempty"` // Track returned records as recently viewed
+
+	IncludeArchived bool `json:"include_archived,omitempty"`  // Also read rows moved to the object's archive table
+	SkipLookupNames bool `json:"skip_lookup_names,omitempty"` // Leave out the {lookup}__name display values of lookup fields
+}
+
+// UpdatePreview is the outcome of a dry-run update: the record as it would be saved and
+// the fields that would change, recalculated formulas included
+type UpdatePreview struct {
+	Record  SObject       `json:"record"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange is the old and new value of one field
+type FieldChange struct {
+	Field    string      `json:"field"`
+	OldValue interface{} `json:"old_value"`
+	NewValue interface{} `json:"new_value"`
+}
+
+// LookupRequest is a type-ahead search for the records a lookup field may reference
+type LookupRequest struct {
+	Term            string                 `json:"q"`                          // Prefix of the target's name field
+	Field           string                 `json:"field,omitempty"`            // Lookup field ("object.field") whose filter applies
+	DependentValues map[string]interface{} `json:"dependent_values,omitempty"` // Values of the edited record read by the filter as $source.<field>
+	Limit           int                    `json:"limit,omitempty"`
+}
+
+// LookupResult lists lookup candidates with the name and compact layout fields of each
+type LookupResult struct {
+	NameField string         `json:"name_field"`
+	Fields    []string       `json:"fields"` // Compact layout fields the user may read
+	Records   []LookupRecord `json:"records"`
+}
+
+// LookupRecord is a single lookup candidate
+type LookupRecord struct {
+	ID     string                 `json:"id"`
+	Name   string                 `json:"name"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// QueryLimits are the governor limits applied to record queries. 0 means unlimited.
+type QueryLimits struct {
+	MaxRows              int `json:"max_rows"`               // Largest limit a query may request
+	MaxExecutionMs       int `json:"max_execution_ms"`       // Time a query may run before it is cancelled
+	MaxConcurrentQueries int `json:"max_concurrent_queries"` // Queries one user may run at once
+}
+
+// ProfileQueryLimits are the governor limits of a profile: the platform defaults, the
+// profile's override of them, if any, and the limits in effect
+type ProfileQueryLimits struct {
+	ProfileID string               `json:"profile_id"`
+	Defaults  QueryLimits          `json:"defaults"`
+	Override  *SystemQueryGovernor `json:"override,omitempty"`
+	Effective QueryLimits          `json:"effective"`
+}
+
+// ReadReplicaStatus reports the read replica that serves analytics, reports and dashboards
+type ReadReplicaStatus struct {
+	Configured     bool       `json:"configured"`
+	Healthy        bool       `json:"healthy"`
+	LagMs          *int64     `json:"lag_ms,omitempty"` // Omitted when the replica does not report its lag
+	MaxStalenessMs int64      `json:"max_staleness_ms"` // Default lag tolerated by stale-tolerant reads
+	CheckedAt      *time.Time `json:"checked_at,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+}
+
+// NLQRequest asks a natural-language question about CRM data
+type NLQRequest struct {
+	Question      string `json:"question" binding:"required"`
+	ObjectAPIName string `json:"object_api_name,omitempty"` // Restricts the question to one object
+	Limit         int    `json:"limit,omitempty"`
+}
+
+// NLQResult is the validated query a question was translated into and its results
+type NLQResult struct {
+	Query       QueryRequest `json:"query"`
+	Explanation string       `json:"explanation,omitempty"`
+	Records     []SObject    `json:"records"`
+}
+
+// BulkResult reports a bulk create, update or delete. Records that fail are listed
+// in Errors by index or ID while the rest are applied.
+type BulkResult struct {
+	SuccessCount int      `json:"success_count"`
+	FailedCount  int      `json:"failed_count"`
+	Errors       []string `json:"errors,omitempty"`
+}
+
+// MassOperationOptions configure how a mass transfer or mass update job saves records
+type MassOperationOptions struct {
+	BatchSize     int  `json:"batch_size,omitempty"`     // Records saved per batch
+	SuppressFlows bool `json:"suppress_flows,omitempty"` // Save without running record-triggered flows
+}
+
+// MassTransferRequest changes the owner of the records of an object matching a filter
+type MassTransferRequest struct {
+	ObjectAPIName    string `json:"object_api_name" binding:"required"`
+	FilterExpr       string `json:"filter_expr,omitempty"`
+	FromOwnerID      string `json:"from_owner_id,omitempty"` // Only transfer records of this owner
+	ToOwnerID        string `json:"to_owner_id" binding:"required"`
+	KeepManualShares bool   `json:"keep_manual_shares,omitempty"` // Keep the manual shares granted by the previous owner
+	MassOperationOptions
+}
+
+// MassUpdateRequest sets field values on the records of an object matching a filter
+type MassUpdateRequest struct {
+	ObjectAPIName string  `json:"object_api_name" binding:"required"`
+	FilterExpr    string  `json:"filter_expr,omitempty"`
+	Values        SObject `json:"values" binding:"required"`
+	MassOperationOptions
+}
+
+// SampleDataRequest sizes a generated demo dataset of linked accounts, contacts,
+// opportunities and tasks. Counts left at zero take their defaults.
+type SampleDataRequest struct {
+	Accounts                int   `json:"accounts,omitempty"`
+	ContactsPerAccount      int   `json:"contacts_per_account,omitempty"`
+	OpportunitiesPerAccount int   `json:"opportunities_per_account,omitempty"`
+	TasksPerRecord          int   `json:"tasks_per_record,omitempty"` // Tasks on each account and opportunity
+	Seed                    int64 `json:"seed,omitempty"`             // The same seed generates the same values; 0 picks one
+	BatchSize               int   `json:"batch_size,omitempty"`       // Records inserted per batch
 }
 
 // SearchRequest represents a search request
@@ -91,6 +560,66 @@ type SearchRequest struct {
 	Term string `json:"term" binding:"required"`
 }
 
+// KanbanRequest asks for an object's records grouped into columns by a picklist field
+type KanbanRequest struct {
+	GroupBy        string              `json:"group_by" binding:"required"`
+	FilterExpr     string              `json:"filter_expr,omitempty"`
+	SortField      string              `json:"sort_field,omitempty"`
+	SortDirection  string              `json:"sort_direction,omitempty"`
+	PerColumnLimit int                 `json:"per_column_limit,omitempty"`
+	Aggregates     []ListViewAggregate `json:"aggregates,omitempty"`
+}
+
+// KanbanColumn is one picklist value with its records and summary values
+type KanbanColumn struct {
+	Value      string                 `json:"value"` // "" holds records with no value
+	Records    []SObject              `json:"records"`
+	TotalCount int64                  `json:"total_count"`
+	Aggregates map[string]interface{} `json:"aggregates,omitempty"` // Keyed by "<function>_<field>"
+}
+
+// KanbanResult is the full board payload
+type KanbanResult struct {
+	ObjectAPIName string         `json:"object_api_name"`
+	GroupBy       string         `json:"group_by"`
+	Columns       []KanbanColumn `json:"columns"`
+}
+
+// KanbanMoveRequest moves a card to another column.
+// FromValue, when set, must match the stored value so concurrent moves are detected.
+type KanbanMoveRequest struct {
+	RecordID  string  `json:"record_id" binding:"required"`
+	GroupBy   string  `json:"group_by" binding:"required"`
+	FromValue *string `json:"from_value,omitempty"`
+	ToValue   string  `json:"to_value"`
+}
+
+// CalendarRequest asks for records whose date field falls within [Start, End]
+type CalendarRequest struct {
+	DateField    string `json:"date_field" binding:"required"`
+	EndDateField string `json:"end_date_field,omitempty"` // Optional; multi-day records appear on every day they span
+	Start        string `json:"start" binding:"required"` // YYYY-MM-DD
+	End          string `json:"end" binding:"required"`   // YYYY-MM-DD, inclusive
+	FilterExpr   string `json:"filter_expr,omitempty"`
+	Limit        int    `json:"limit,omitempty"`
+}
+
+// CalendarDay holds the records falling on one day
+type CalendarDay struct {
+	Date    string    `json:"date"` // YYYY-MM-DD
+	Records []SObject `json:"records"`
+}
+
+// CalendarResult is the calendar payload; days without records are omitted
+type CalendarResult struct {
+	ObjectAPIName string        `json:"object_api_name"`
+	DateField     string        `json:"date_field"`
+	Start         string        `json:"start"`
+	End           string        `json:"end"`
+	Days          []CalendarDay `json:"days"`
+	Truncated     bool          `json:"truncated"` // True when Limit cut off matching records
+}
+
 // RecycleBinItem represents an item in the recycle bin
 type RecycleBinItem struct {
 	ID            string `json:"id"`
@@ -101,6 +630,91 @@ type RecycleBinItem struct {
 	DeletedDate   string `json:"deleted_date"`
 }
 
+// ChangeEventHeader describes a captured record change
+type ChangeEventHeader struct {
+	Operation       constants.ChangeOperation `json:"op"`
+	ObjectAPIName   string                    `json:"object_api_name"`
+	RecordID        string                    `json:"record_id"`
+	TransactionID   string                    `json:"transaction_id"` // Shared by every change made in one transaction
+	CommitTimestamp time.Time                 `json:"commit_timestamp"`
+	ChangedFields   []string                  `json:"changed_fields"`
+	UserID          *string                   `json:"user_id,omitempty"`
+}
+
+// ChangeEvent is one entry of the change data capture stream. Before is nil for creates
+// and After is nil for deletes.
+type ChangeEvent struct {
+	Position int64             `json:"position"`
+	Header   ChangeEventHeader `json:"header"`
+	Before   SObject           `json:"before"`
+	After    SObject           `json:"after"`
+}
+
+// ChangeEventPage is a batch of change events read from a stream position
+type ChangeEventPage struct {
+	Events       []ChangeEvent `json:"events"`
+	NextPosition int64         `json:"next_position"` // Read from here to continue after this page
+	HasMore      bool          `json:"has_more"`
+}
+
+// ChangeEventOffset is the last position a CDC consumer has committed
+type ChangeEventOffset struct {
+	Consumer         string    `json:"consumer"`
+	Position         int64     `json:"position"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// DebeziumRecord is a change event as a Debezium connector would produce it to Kafka,
+// with the JSON converter and schemas disabled
+type DebeziumRecord struct {
+	Topic string            `json:"topic"`
+	Key   map[string]string `json:"key"`
+	Value DebeziumEnvelope  `json:"value"`
+}
+
+// DebeziumEnvelope is the value of a Debezium change record
+type DebeziumEnvelope struct {
+	Before SObject        `json:"before"`
+	After  SObject        `json:"after"`
+	Source DebeziumSource `json:"source"`
+	Op     string         `json:"op"`
+	TsMs   int64          `json:"ts_ms"`
+}
+
+// DebeziumSource is the source block of a Debezium change record
+type DebeziumSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Table     string `json:"table"`
+	TxID      string `json:"txId"`
+	Pos       int64  `json:"pos"` // Stream position, usable as the replay offset
+}
+
+// Debezium converts the event to a Debezium change record. Topics are named
+// <serverName>.<object API name> like Debezium's <server>.<table>.
+func (e ChangeEvent) Debezium(serverName string) DebeziumRecord {
+	tsMs := e.Header.CommitTimestamp.UnixMilli()
+	return DebeziumRecord{
+		Topic: serverName + "." + e.Header.ObjectAPIName,
+		Key:   map[string]string{constants.FieldID: e.Header.RecordID},
+		Value: DebeziumEnvelope{
+			Before: e.Before,
+			After:  e.After,
+			Source: DebeziumSource{
+				Connector: "nexuscrm",
+				Name:      serverName,
+				TsMs:      tsMs,
+				Table:     e.Header.ObjectAPIName,
+				TxID:      e.Header.TransactionID,
+				Pos:       e.Position,
+			},
+			Op:   string(e.Header.Operation),
+			TsMs: tsMs,
+		},
+	}
+}
+
 // Transaction represents a database transaction
 type Transaction interface {
 	Exec(query string, args ...interface{}) (sql.Result, error)
@@ -15,6 +15,21 @@ type SharingModel = constants.SharingModel
 // DeleteRule is defined in pkg/constants
 type DeleteRule = constants.DeleteRule
 
+// ListViewVisibility is defined in pkg/constants
+type ListViewVisibility = constants.ListViewVisibility
+
+// ColumnPin is defined in pkg/constants
+type ColumnPin = constants.ColumnPin
+
+// DashboardRunAs is defined in pkg/constants
+type DashboardRunAs = constants.DashboardRunAs
+
+// DashboardFilterType is defined in pkg/constants
+type DashboardFilterType = constants.DashboardFilterType
+
+// ReportFormat is defined in pkg/constants
+type ReportFormat = constants.ReportFormat
+
 // RollupConfig represents rollup summary field configuration
 type RollupConfig struct {
 	SummaryObject     string  `json:"summary_object"`
@@ -32,10 +47,15 @@ type FieldMetadata struct {
 	Type               FieldType           `json:"type"`
 	Required           bool                `json:"required,omitempty"`
 	IsUnique           bool                `json:"is_unique,omitempty"`
+	IsIndexed          bool                `json:"is_indexed,omitempty"` // Backed by a secondary index for filtering and sorting
 	IsNameField        bool                `json:"is_name_field,omitempty"`
 	Options            []string            `json:"options,omitempty"`
-	ReferenceTo        []string            `json:"reference_to,omitempty"`   // Supports polymorphic (multiple objects)
-	IsPolymorphic      bool                `json:"is_polymorphic,omitempty"` // True if len(ReferenceTo) > 1
+	InactiveOptions    []string            `json:"inactive_options,omitempty"` // Retired picklist values still present in data
+	OptionLabels       map[string]string   `json:"option_labels,omitempty"`    // Translated labels of picklist values in the caller's locale
+	ValueSet           *string             `json:"value_set,omitempty"`        // Global value set supplying the options
+	ReferenceTo        []string            `json:"reference_to,omitempty"`     // Supports polymorphic (multiple objects)
+	IsPolymorphic      bool                `json:"is_polymorphic,omitempty"`   // True if len(ReferenceTo) > 1
+	LookupFilter       *string             `json:"lookup_filter,omitempty"`    // Formula over the target object limiting lookup candidates; $source.<field> reads the edited record
 	DeleteRule         *DeleteRule         `json:"delete_rule,omitempty"`
 	IsSystem           bool                `json:"is_system,omitempty"`
 	Formula            *string             `json:"formula,omitempty"`
@@ -69,6 +89,7 @@ type ObjectMetadata struct {
 	Description            *string         `json:"description,omitempty"`
 	IsSystem               bool            `json:"is_system,omitempty"`
 	IsCustom               bool            `json:"is_custom,omitempty"`
+	IsExternal             bool            `json:"is_external,omitempty"` // Read-only object backed by an external data source
 	ThemeColor             *string         `json:"theme_color,omitempty"`
 	SharingModel           SharingModel    `json:"sharing_model"`
 	EnableHierarchySharing bool            `json:"enable_hierarchy_sharing"`
@@ -78,7 +99,8 @@ type ObjectMetadata struct {
 	KanbanSummaryField     *string         `json:"kanban_summary_field,omitempty"`
 	ListFields             []string        `json:"list_fields,omitempty"`
 	Searchable             bool            `json:"searchable"`
-	PathField              *string         `json:"path_field,omitempty"` // Field to use for Path component (must be Picklist)
+	PathField              *string         `json:"path_field,omitempty"`         // Field to use for Path component (must be Picklist)
+	LastModifiedDate       time.Time       `json:"last_modified_date,omitempty"` // Latest change to the object or one of its fields
 }
 
 // ListView represents a list view configuration
@@ -89,6 +111,36 @@ type ListView struct {
 
 	FilterExpr string   `json:"filter_expr,omitempty"`
 	Fields     []string `json:"fields,omitempty"`
+
+	// Sharing: private views are visible to their owner only, profile views to the listed profiles
+	Visibility ListViewVisibility `json:"visibility,omitempty"`
+	OwnerID    string             `json:"owner_id,omitempty"`
+	ProfileIDs []string           `json:"profile_ids,omitempty"`
+
+	// Presentation
+	Columns       []ListViewColumn    `json:"columns,omitempty"`
+	SortField     string              `json:"sort_field,omitempty"`
+	SortDirection string              `json:"sort_direction,omitempty"`
+	Aggregates    []ListViewAggregate `json:"aggregates,omitempty"`
+}
+
+// ListViewColumn holds per-column display settings for a list view
+type ListViewColumn struct {
+	Field  string    `json:"field"`
+	Width  int       `json:"width,omitempty"` // Pixels; 0 means auto
+	Pinned ColumnPin `json:"pinned,omitempty"`
+}
+
+// ListViewAggregate is a footer summary computed server-side over the view's records
+type ListViewAggregate struct {
+	Field    string `json:"field"`
+	Function string `json:"function"` // COUNT, SUM, AVG, MIN, MAX
+}
+
+// ListViewFooter holds computed footer values keyed by "<function>_<field>" (e.g. "sum_amount")
+type ListViewFooter struct {
+	ListViewID string                 `json:"list_view_id"`
+	Values     map[string]interface{} `json:"values"`
 }
 
 // PageLayout represents page layout configuration
@@ -122,11 +174,12 @@ type PageSection struct {
 
 // RelatedListConfig represents a related list configuration
 type RelatedListConfig struct {
-	ID            string   `json:"__sys_gen_id"`
-	Label         string   `json:"label"`
-	ObjectAPIName string   `json:"object_api_name"`
-	LookupField   string   `json:"lookup_field"`
-	Fields        []string `json:"fields"`
+	ID                  string   `json:"__sys_gen_id"`
+	Label               string   `json:"label"`
+	ObjectAPIName       string   `json:"object_api_name"`
+	LookupField         string   `json:"lookup_field"`
+	Fields              []string `json:"fields"`
+	VisibilityCondition *string  `json:"visibility_condition,omitempty"`
 }
 
 // ActionConfig represents an action configuration
@@ -143,27 +196,44 @@ type ActionConfig struct {
 
 // ActionMetadata represents action metadata
 type ActionMetadata struct {
-	ID            string                 `json:"__sys_gen_id"`
-	ObjectAPIName string                 `json:"object_api_name"`
-	Name          string                 `json:"name"`
-	Label         string                 `json:"label"`
-	Type          string                 `json:"type"`
-	Icon          string                 `json:"icon"`
-	TargetObject  *string                `json:"target_object,omitempty"`
-	Config        map[string]interface{} `json:"config,omitempty"`
+	ID               string                 `json:"__sys_gen_id"`
+	ObjectAPIName    string                 `json:"object_api_name"`
+	Name             string                 `json:"name"`
+	Label            string                 `json:"label"`
+	Type             string                 `json:"type"`
+	Icon             string                 `json:"icon"`
+	TargetObject     *string                `json:"target_object,omitempty"`
+	Config           map[string]interface{} `json:"config,omitempty"`
+	LastModifiedDate time.Time              `json:"last_modified_date,omitempty"`
 }
 
+// RecordType partitions an object's records. PicklistValues restricts picklist fields
+// (by API name) to a subset of their options for records of the type.
 type RecordType struct {
-	ID                string    `json:"__sys_gen_id"`
-	ObjectAPIName     string    `json:"object_api_name"`
-	Name              string    `json:"name"`
-	Label             string    `json:"label"`
-	Description       *string   `json:"description,omitempty"`
-	IsActive          bool      `json:"is_active"`
-	IsDefault         bool      `json:"is_default"`
-	BusinessProcessID *string   `json:"business_process_id,omitempty"`
-	CreatedDate       time.Time `json:"__sys_gen_created_date"`
-	LastModifiedDate  time.Time `json:"__sys_gen_last_modified_date"`
+	ID                string              `json:"__sys_gen_id"`
+	ObjectAPIName     string              `json:"object_api_name"`
+	Name              string              `json:"name"`
+	Label             string              `json:"label"`
+	Description       *string             `json:"description,omitempty"`
+	IsActive          bool                `json:"is_active"`
+	IsDefault         bool                `json:"is_default"`
+	BusinessProcessID *string             `json:"business_process_id,omitempty"`
+	PicklistValues    map[string][]string `json:"picklist_values,omitempty"`
+	CreatedDate       time.Time           `json:"__sys_gen_created_date"`
+	LastModifiedDate  time.Time           `json:"__sys_gen_last_modified_date"`
+}
+
+// ProfileRecordType makes a record type available to a profile, optionally as the
+// profile's default for the object and with its own page layout
+type ProfileRecordType struct {
+	ID               string    `json:"__sys_gen_id"`
+	ProfileID        string    `json:"profile_id"`
+	ObjectAPIName    string    `json:"object_api_name"`
+	RecordTypeID     string    `json:"record_type_id"`
+	IsDefault        bool      `json:"is_default"`
+	LayoutID         *string   `json:"layout_id,omitempty"`
+	CreatedDate      time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
 
 type AutoNumber struct {
@@ -173,6 +243,7 @@ type AutoNumber struct {
 	DisplayFormat    string    `json:"display_format"`
 	StartingNumber   int       `json:"starting_number"`
 	CurrentValue     int       `json:"current_value"`
+	GapFree          bool      `json:"gap_free"` // Reserve numbers inside the record transaction so rollbacks leave no gaps
 	CreatedDate      time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
@@ -192,6 +263,513 @@ type Relationship struct {
 	LastModifiedDate    time.Time `json:"__sys_gen_last_modified_date"`
 }
 
+// PicklistValue is a picklist option and whether it can still be selected
+type PicklistValue struct {
+	Value  string `json:"value"`
+	Label  string `json:"label,omitempty"` // Translated label in the caller's locale
+	Active bool   `json:"active"`
+}
+
+// GlobalValueSet is a list of picklist values shared by every field that references it
+type GlobalValueSet struct {
+	ID               string    `json:"__sys_gen_id"`
+	Name             string    `json:"name"`
+	Label            string    `json:"label"`
+	Description      *string   `json:"description,omitempty"`
+	Options          []string  `json:"options"`
+	InactiveOptions  []string  `json:"inactive_options,omitempty"`
+	IsStandard       bool      `json:"is_standard"`
+	CreatedDate      time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// CustomSetting is a named setting resolved through the hierarchy org default → profile → user
+type CustomSetting struct {
+	ID               string      `json:"__sys_gen_id"`
+	Name             string      `json:"name"`
+	Label            string      `json:"label"`
+	Description      *string     `json:"description,omitempty"`
+	Type             FieldType   `json:"type"` // Text, Number, Currency, Percent, Boolean or Date
+	DefaultValue     interface{} `json:"default_value"`
+	CreatedDate      time.Time   `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time   `json:"__sys_gen_last_modified_date"`
+}
+
+// CustomSettingValue overrides a custom setting for one profile or user
+type CustomSettingValue struct {
+	ID               string                       `json:"__sys_gen_id"`
+	SettingName      string                       `json:"setting_name"`
+	ScopeType        constants.CustomSettingScope `json:"scope_type"`
+	ScopeID          string                       `json:"scope_id"`
+	Value            interface{}                  `json:"value"`
+	CreatedDate      time.Time                    `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                    `json:"__sys_gen_last_modified_date"`
+}
+
+// NamedCredential is an external service endpoint with its authentication. The secret
+// (password, token or API key) is write-only: it is stored encrypted and never returned.
+type NamedCredential struct {
+	ID               string                            `json:"__sys_gen_id"`
+	Name             string                            `json:"name"`
+	Label            string                            `json:"label"`
+	BaseURL          string                            `json:"base_url"`
+	AuthType         constants.NamedCredentialAuthType `json:"auth_type"`
+	Username         *string                           `json:"username,omitempty"`
+	AuthHeader       *string                           `json:"auth_header,omitempty"` // Header carrying the key for ApiKey auth
+	Secret           string                            `json:"secret,omitempty"`
+	HasSecret        bool                              `json:"has_secret"`
+	TimeoutSeconds   int                               `json:"timeout_seconds"`
+	MaxRetries       int                               `json:"max_retries"`
+	CreatedDate      time.Time                         `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                         `json:"__sys_gen_last_modified_date"`
+}
+
+// InboundHook is an endpoint (/hooks/:slug) external systems push JSON to. FieldMapping maps
+// field API names to dotted paths into each item (e.g. "customer.email"). The secret is
+// write-only; it is returned once when generated on create.
+type InboundHook struct {
+	ID               string                         `json:"__sys_gen_id"`
+	Slug             string                         `json:"slug"`
+	Label            string                         `json:"label"`
+	ObjectAPIName    string                         `json:"object_api_name"`
+	Operation        constants.InboundHookOperation `json:"operation"`
+	MatchField       *string                        `json:"match_field,omitempty"`  // Upsert key; must be mapped
+	RecordsPath      *string                        `json:"records_path,omitempty"` // Path to an array of items; default the whole payload
+	FieldMapping     map[string]string              `json:"field_mapping"`
+	Secret           string                         `json:"secret,omitempty"`
+	HasSecret        bool                           `json:"has_secret"`
+	RunAsUserID      string                         `json:"run_as_user_id"` // Records are saved with this user's access
+	IsActive         bool                           `json:"is_active"`
+	LastReceivedDate *time.Time                     `json:"last_received_date,omitempty"`
+	LastError        *string                        `json:"last_error,omitempty"`
+	CreatedDate      time.Time                      `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                      `json:"__sys_gen_last_modified_date"`
+}
+
+// InboundHookResult is what one delivery to an inbound webhook saved
+type InboundHookResult struct {
+	Created   int      `json:"created"`
+	Updated   int      `json:"updated"`
+	RecordIDs []string `json:"record_ids"`
+}
+
+// SyncConnector is a user's connected mailbox and calendar. The OAuth tokens are stored
+// encrypted and never returned.
+type SyncConnector struct {
+	ID                  string                 `json:"__sys_gen_id"`
+	UserID              string                 `json:"user_id"`
+	Provider            constants.SyncProvider `json:"provider"`
+	AccountEmail        string                 `json:"account_email"`
+	SyncEmail           bool                   `json:"sync_email"`
+	SyncCalendar        bool                   `json:"sync_calendar"`
+	IsActive            bool                   `json:"is_active"`
+	EmailSyncedUntil    *time.Time             `json:"email_synced_until,omitempty"`
+	CalendarSyncedUntil *time.Time             `json:"calendar_synced_until,omitempty"`
+	LastSyncDate        *time.Time             `json:"last_sync_date,omitempty"`
+	LastError           *string                `json:"last_error,omitempty"`
+	CreatedDate         time.Time              `json:"__sys_gen_created_date"`
+	LastModifiedDate    time.Time              `json:"__sys_gen_last_modified_date"`
+}
+
+// SyncConnectorUpdate changes what a connector syncs; unset fields are left as they are
+type SyncConnectorUpdate struct {
+	SyncEmail    *bool `json:"sync_email,omitempty"`
+	SyncCalendar *bool `json:"sync_calendar,omitempty"`
+	IsActive     *bool `json:"is_active,omitempty"`
+}
+
+// SyncResult counts what one sync of a connector imported
+type SyncResult struct {
+	Emails     int `json:"emails"`     // Emails fetched
+	Events     int `json:"events"`     // Calendar events fetched
+	Activities int `json:"activities"` // Activities created, one per matched record
+}
+
+// HookSubscribeRequest subscribes a REST hook: the platform's target URL receives a POST
+// for every matching record event
+type HookSubscribeRequest struct {
+	ObjectAPIName string              `json:"object_api_name" binding:"required"`
+	Event         constants.HookEvent `json:"event" binding:"required"`
+	TargetURL     string              `json:"target_url" binding:"required"`
+}
+
+// ClickToDialRequest places a call from the current user's phone to a number, optionally
+// logged on a record
+type ClickToDialRequest struct {
+	To            string `json:"to" binding:"required"`
+	ObjectAPIName string `json:"object_api_name,omitempty"`
+	RecordID      string `json:"record_id,omitempty"`
+}
+
+// CallResult is a placed call and the activities logging it
+type CallResult struct {
+	CallID     string            `json:"call_id"`
+	Activities []*SystemActivity `json:"activities"`
+}
+
+// CallLogRequest logs a call made outside the telephony integration on a record
+type CallLogRequest struct {
+	ObjectAPIName string     `json:"object_api_name" binding:"required"`
+	RecordID      string     `json:"record_id" binding:"required"`
+	PhoneNumber   string     `json:"phone_number"`
+	Direction     string     `json:"direction"` // inbound or outbound (default)
+	Status        string     `json:"status"`    // Default completed
+	Subject       string     `json:"subject"`
+	Description   string     `json:"description"`
+	Duration      int        `json:"duration"`  // Seconds
+	CallDate      *time.Time `json:"call_date"` // Default now
+}
+
+// ExternalDataSource is the connection config of an external object. FieldMap maps local
+// field API names to remote field names; unmapped fields use their own name remotely. The
+// DSN of SQL sources is write-only like a named credential secret.
+type ExternalDataSource struct {
+	ID               string                        `json:"__sys_gen_id"`
+	ObjectAPIName    string                        `json:"object_api_name"`
+	Adapter          constants.ExternalAdapterType `json:"adapter"`
+	NamedCredential  *string                       `json:"named_credential,omitempty"` // REST and OData
+	Resource         string                        `json:"resource"`                   // REST path, OData entity set or SQL table
+	RecordsPath      *string                       `json:"records_path,omitempty"`     // Dotted path to the record array in REST responses
+	IDField          string                        `json:"id_field"`                   // Remote field holding the record ID
+	FieldMap         map[string]string             `json:"field_map,omitempty"`
+	DSN              string                        `json:"dsn,omitempty"`
+	HasDSN           bool                          `json:"has_dsn"`
+	MaxRows          int                           `json:"max_rows"` // Cap on rows fetched per query
+	CreatedDate      time.Time                     `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                     `json:"__sys_gen_last_modified_date"`
+}
+
+// ExternalObject is an external object definition: its metadata and where its records live
+type ExternalObject struct {
+	Object ObjectMetadata     `json:"object"`
+	Source ExternalDataSource `json:"source"`
+}
+
+// BusinessHoursWindow is the working time of one weekday as "HH:MM" times in the business
+// hours' time zone. End may be "24:00" for a day that works until midnight.
+type BusinessHoursWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// BusinessHours is a weekly working schedule with its holidays, used to measure elapsed
+// business time. Weekdays without a window are non-working days.
+type BusinessHours struct {
+	ID               string                         `json:"__sys_gen_id"`
+	Name             string                         `json:"name"`
+	Label            string                         `json:"label"`
+	Timezone         string                         `json:"timezone"`
+	IsDefault        bool                           `json:"is_default"` // Used by SLA policies that name no business hours
+	Schedule         map[string]BusinessHoursWindow `json:"schedule"`   // Lowercase weekday name (e.g. "monday") -> working window
+	Holidays         []Holiday                      `json:"holidays"`
+	CreatedDate      time.Time                      `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                      `json:"__sys_gen_last_modified_date"`
+}
+
+// Holiday is a non-working day of business hours
+type Holiday struct {
+	ID              string `json:"__sys_gen_id"`
+	BusinessHoursID string `json:"business_hours_id"`
+	Name            string `json:"name"`
+	Date            string `json:"holiday_date"` // YYYY-MM-DD
+	IsRecurring     bool   `json:"is_recurring"` // Repeats on the same month and day every year
+}
+
+// SLAMilestone is a target an SLA policy measures for records of one priority
+type SLAMilestone struct {
+	Name             string `json:"name"`
+	Priority         string `json:"priority,omitempty"`           // Priority value the milestone applies to; empty applies to every priority
+	TargetMinutes    int    `json:"target_minutes"`               // Business minutes allowed before the milestone is violated
+	CompletionField  string `json:"completion_field,omitempty"`   // Met once this field has a value; empty is met on a closed status
+	EscalationFlowID string `json:"escalation_flow_id,omitempty"` // Flow run on the record when the milestone is violated
+}
+
+// SLAPolicy tracks milestone timers for the records of an object. Timers accrue business
+// time only while the record's status is neither paused nor closed.
+type SLAPolicy struct {
+	ID               string         `json:"__sys_gen_id"`
+	Name             string         `json:"name"`
+	Label            string         `json:"label"`
+	ObjectAPIName    string         `json:"object_api_name"`
+	PriorityField    string         `json:"priority_field,omitempty"` // Empty applies only milestones without a priority
+	StatusField      string         `json:"status_field"`
+	PausedStatuses   []string       `json:"paused_statuses"` // e.g. "Waiting on Customer"
+	ClosedStatuses   []string       `json:"closed_statuses"`
+	BusinessHours    string         `json:"business_hours,omitempty"` // Business hours name; empty uses the default business hours, or 24x7 without one
+	Milestones       []SLAMilestone `json:"milestones"`
+	IsActive         bool           `json:"is_active"`
+	CreatedDate      time.Time      `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time      `json:"__sys_gen_last_modified_date"`
+}
+
+// EscalationAction is one level of an escalation rule, taken once a record has matched the
+// rule for AfterMinutes of business time
+type EscalationAction struct {
+	AfterMinutes  int      `json:"after_minutes"`
+	ReassignTo    string   `json:"reassign_to,omitempty"`     // User or queue ID made the record owner
+	NotifyOwner   bool     `json:"notify_owner"`              // Notify the record owner (after any reassignment)
+	NotifyUserIDs []string `json:"notify_user_ids,omitempty"` // Additional users to notify
+	FlowID        string   `json:"flow_id,omitempty"`         // Flow run on the record
+}
+
+// EscalationRule escalates records of an object that keep matching its criteria, e.g.
+// cases still open hours after they were created
+type EscalationRule struct {
+	ID               string             `json:"__sys_gen_id"`
+	Name             string             `json:"name"`
+	Label            string             `json:"label"`
+	ObjectAPIName    string             `json:"object_api_name"`
+	Criteria         string             `json:"criteria,omitempty"`       // Formula filter of records to monitor; empty monitors every record
+	StartField       string             `json:"start_field"`              // Date/time field the age is measured from
+	BusinessHours    string             `json:"business_hours,omitempty"` // Business hours name; empty uses the default business hours, or 24x7 without one
+	Actions          []EscalationAction `json:"actions"`                  // Ordered by AfterMinutes
+	IsActive         bool               `json:"is_active"`
+	CreatedDate      time.Time          `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time          `json:"__sys_gen_last_modified_date"`
+}
+
+// Script is a Starlark script run as a record trigger on TriggerObject and by flow actions.
+// Zero quotas take the defaults of package script.
+type Script struct {
+	ID               string    `json:"__sys_gen_id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description,omitempty"`
+	Source           string    `json:"source"`
+	TriggerObject    string    `json:"trigger_object,omitempty"` // Object whose saves run the script; empty for scripts only run by flows
+	TriggerEvents    []string  `json:"trigger_events,omitempty"` // e.g. beforeCreate, afterUpdate
+	IsActive         bool      `json:"is_active"`
+	MaxSteps         int64     `json:"max_steps,omitempty"`
+	TimeoutMs        int       `json:"timeout_ms,omitempty"`
+	MaxMemoryMB      int       `json:"max_memory_mb,omitempty"`
+	MaxDMLRows       int       `json:"max_dml_rows,omitempty"`
+	MaxQueryRows     int       `json:"max_query_rows,omitempty"`
+	MaxCallouts      int       `json:"max_callouts,omitempty"`
+	OwnerID          string    `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate      time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// ScriptLog is one run of a script
+type ScriptLog struct {
+	ID            string    `json:"__sys_gen_id"`
+	ScriptID      string    `json:"script_id"`
+	ScriptName    string    `json:"script_name"`
+	Invocation    string    `json:"invocation"` // Trigger event, "flow" or "manual"
+	ObjectAPIName string    `json:"object_api_name,omitempty"`
+	RecordID      string    `json:"record_id,omitempty"`
+	Status        string    `json:"status"`
+	DurationMs    int       `json:"duration_ms"`
+	Steps         int64     `json:"steps"`
+	DMLRows       int       `json:"dml_rows"`
+	QueryRows     int       `json:"query_rows"`
+	Callouts      int       `json:"callouts"`
+	Output        string    `json:"output,omitempty"`
+	ErrorMessage  string    `json:"error_message,omitempty"`
+	UserID        string    `json:"user_id,omitempty"`
+	CreatedDate   time.Time `json:"__sys_gen_created_date"`
+}
+
+// ScriptRunRequest runs a script by hand, optionally on a record. With Rollback its writes
+// are undone, to try a script without side effects (callouts are still made).
+type ScriptRunRequest struct {
+	ObjectAPIName string                 `json:"object_api_name,omitempty"`
+	RecordID      string                 `json:"record_id,omitempty"`
+	Params        map[string]interface{} `json:"params,omitempty"`
+	Rollback      bool                   `json:"rollback,omitempty"`
+}
+
+// ScriptRunResult is the outcome of a script run by hand
+type ScriptRunResult struct {
+	Log     *ScriptLog             `json:"log"`
+	Changes map[string]interface{} `json:"changes,omitempty"` // Fields of the record the script changed (not saved)
+	Result  interface{}            `json:"result,omitempty"`  // The global `result` of the script
+}
+
+// CustomEndpoint is an admin-defined REST endpoint served at /api/custom/:name with the
+// caller's access. It runs a script with the input as params, or a query or DML template whose
+// filter, record ID and field values reference the validated input as $input.<param>.
+type CustomEndpoint struct {
+	ID               string                         `json:"__sys_gen_id"`
+	Name             string                         `json:"name"`
+	Description      string                         `json:"description,omitempty"`
+	HTTPMethod       string                         `json:"http_method"` // GET and DELETE read the input from the query string, others from the JSON body
+	Action           constants.CustomEndpointAction `json:"action"`
+	ScriptName       string                         `json:"script_name,omitempty"`     // Script action
+	ObjectAPIName    string                         `json:"object_api_name,omitempty"` // Query and DML actions
+	FilterExpr       string                         `json:"filter_expr,omitempty"`     // Query action; formula filter
+	SortField        string                         `json:"sort_field,omitempty"`
+	SortDirection    string                         `json:"sort_direction,omitempty"`
+	RowLimit         int                            `json:"row_limit,omitempty"`    // Query action; 0 means the default
+	RecordID         string                         `json:"record_id,omitempty"`    // Update and delete actions, e.g. "$input.id"
+	FieldValues      map[string]interface{}         `json:"field_values,omitempty"` // Insert and update actions; values or $input.<param> references
+	InputSchema      map[string]CustomEndpointParam `json:"input_schema,omitempty"`
+	ProfileIDs       []string                       `json:"profile_ids,omitempty"` // Profiles that may call the endpoint; empty allows every user
+	IsActive         bool                           `json:"is_active"`
+	OwnerID          string                         `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate      time.Time                      `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                      `json:"__sys_gen_last_modified_date"`
+}
+
+// CustomEndpointParam describes an input parameter of a custom endpoint. Input that does not
+// match its schema is rejected before the endpoint runs.
+type CustomEndpointParam struct {
+	Type        constants.CustomEndpointParamType `json:"type"`
+	Required    bool                              `json:"required,omitempty"`
+	Description string                            `json:"description,omitempty"`
+	Default     interface{}                       `json:"default,omitempty"` // Used when the parameter is not given
+	Enum        []interface{}                     `json:"enum,omitempty"`    // Allowed values
+	Min         *float64                          `json:"min,omitempty"`     // Numbers
+	Max         *float64                          `json:"max,omitempty"`
+	MaxLength   int                               `json:"max_length,omitempty"` // Strings, in characters
+	Pattern     string                            `json:"pattern,omitempty"`    // Strings; regular expression the whole value must match
+}
+
+// BatchJob processes the records of ObjectAPIName matching ScopeFilter in chunks of ChunkSize,
+// up to Concurrency chunks at a time, as an async job. It runs on demand, and on Schedule (a
+// cron expression) as its owner while active. Zero sizes take the defaults.
+type BatchJob struct {
+	ID               string                   `json:"__sys_gen_id"`
+	Name             string                   `json:"name"`
+	Description      string                   `json:"description,omitempty"`
+	ObjectAPIName    string                   `json:"object_api_name"`
+	ScopeFilter      string                   `json:"scope_filter,omitempty"` // Formula filter; empty processes every record
+	Processor        constants.BatchProcessor `json:"processor"`
+	ScriptName       string                   `json:"script_name,omitempty"`  // Script processor; its quotas apply to each chunk
+	FieldValues      map[string]interface{}   `json:"field_values,omitempty"` // Mass update processor
+	ChunkSize        int                      `json:"chunk_size,omitempty"`
+	Concurrency      int                      `json:"concurrency,omitempty"`
+	Schedule         string                   `json:"schedule,omitempty"`
+	ScheduleTimezone string                   `json:"schedule_timezone,omitempty"` // IANA time zone of the schedule; default UTC
+	NextRunAt        *time.Time               `json:"next_run_at,omitempty"`
+	LastRunAt        *time.Time               `json:"last_run_at,omitempty"`
+	LastJobID        string                   `json:"last_job_id,omitempty"`
+	LastJob          *SystemAsyncJob          `json:"last_job,omitempty"` // Progress of the latest run
+	IsActive         bool                     `json:"is_active"`
+	OwnerID          string                   `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate      time.Time                `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                `json:"__sys_gen_last_modified_date"`
+}
+
+// PortalObject exposes an object to portal users. A portal user sees the records whose
+// contact field holds their contact or whose account field holds their account.
+type PortalObject struct {
+	ID               string    `json:"__sys_gen_id"`
+	ObjectAPIName    string    `json:"object_api_name"`
+	ContactField     string    `json:"contact_field,omitempty"` // Field holding the related contact's ID
+	AccountField     string    `json:"account_field,omitempty"` // Field holding the related account's ID
+	AllowCreate      bool      `json:"allow_create"`            // Portal users may create records, linked to their contact and account
+	CreatedDate      time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// MetadataDependency is a component that references a field or object. Blocking
+// dependencies stop working when the target is deleted, so they prevent the deletion;
+// the others (layouts, list views, dashboards) only lose the reference.
+type MetadataDependency struct {
+	ComponentType string `json:"component_type"`
+	ComponentID   string `json:"component_id"`
+	ComponentName string `json:"component_name"`
+	ObjectAPIName string `json:"object_api_name,omitempty"` // Object the component belongs to
+	Usage         string `json:"usage"`                     // Where the component uses the target, e.g. "formula" or "section Details"
+	Blocking      bool   `json:"blocking"`
+}
+
+// DependencyReport lists what references a field or object
+type DependencyReport struct {
+	Type          string               `json:"type"` // object or field
+	ObjectAPIName string               `json:"object_api_name"`
+	FieldAPIName  string               `json:"field_api_name,omitempty"`
+	Dependencies  []MetadataDependency `json:"dependencies"`
+	CanDelete     bool                 `json:"can_delete"` // False when a blocking dependency exists
+}
+
+// SchemaDriftIssue is one difference between the physical schema and the metadata describing it
+type SchemaDriftIssue struct {
+	Kind        string `json:"kind"`
+	Severity    string `json:"severity"` // error or warning
+	Table       string `json:"table"`
+	Column      string `json:"column,omitempty"`
+	Index       string `json:"index,omitempty"`
+	Description string `json:"description"`
+	Repairable  bool   `json:"repairable"` // Auto-repair can add the missing column or index
+}
+
+// SchemaDriftReport is the result of comparing information_schema with _System_Object,
+// _System_Field and the system table definitions
+type SchemaDriftReport struct {
+	CheckedAt    time.Time          `json:"checked_at"`
+	Healthy      bool               `json:"healthy"`
+	Issues       []SchemaDriftIssue `json:"issues"`
+	Repaired     []SchemaDriftIssue `json:"repaired,omitempty"`
+	RepairErrors []string           `json:"repair_errors,omitempty"`
+}
+
+// IndexSuggestion recommends indexing a field that captured slow queries filter or sort on
+type IndexSuggestion struct {
+	ObjectAPIName string    `json:"object_api_name"`
+	FieldAPIName  string    `json:"field_api_name"`
+	FieldLabel    string    `json:"field_label"`
+	SlowQueries   int       `json:"slow_queries"`    // Captured slow queries using the field
+	AvgDurationMs int64     `json:"avg_duration_ms"` // Average duration of those queries
+	MaxDurationMs int64     `json:"max_duration_ms"`
+	LastSeen      time.Time `json:"last_seen"`
+}
+
+// IndexAdvisorReport lists index suggestions derived from the slow query capture
+type IndexAdvisorReport struct {
+	ThresholdMs     int64             `json:"threshold_ms"`     // Queries at least this slow are captured
+	CapturedQueries int               `json:"captured_queries"` // Slow queries currently held in the capture
+	Suggestions     []IndexSuggestion `json:"suggestions"`
+}
+
+// Translation is the label or message of a metadata component in one locale
+type Translation struct {
+	ID               string     `json:"__sys_gen_id,omitempty"`
+	Locale           string     `json:"locale,omitempty"`
+	ComponentType    string     `json:"component_type"`   // constants.TranslationType*
+	ComponentKey     string     `json:"component_key"`    // e.g. "account.industry" for a field label
+	Source           string     `json:"source,omitempty"` // Untranslated text, filled in by the workbench and exports
+	Translation      string     `json:"translation"`
+	LastModifiedDate *time.Time `json:"__sys_gen_last_modified_date,omitempty"`
+}
+
+// TranslationLocale summarizes the translations of one locale
+type TranslationLocale struct {
+	Locale     string `json:"locale"`
+	Translated int    `json:"translated"`
+}
+
+// CustomMetadataType is an admin-defined configuration type (e.g. tax rates or thresholds)
+// whose records are deployed as metadata and cached in memory
+type CustomMetadataType struct {
+	ID               string                `json:"__sys_gen_id"`
+	APIName          string                `json:"api_name"`
+	Label            string                `json:"label"`
+	Description      *string               `json:"description,omitempty"`
+	Fields           []CustomMetadataField `json:"fields"`
+	CreatedDate      time.Time             `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time             `json:"__sys_gen_last_modified_date"`
+}
+
+// CustomMetadataField is a field of a custom metadata type
+type CustomMetadataField struct {
+	APIName  string    `json:"api_name"`
+	Label    string    `json:"label"`
+	Type     FieldType `json:"type"` // Text, Number, Currency, Percent, Boolean or Date
+	Required bool      `json:"required,omitempty"`
+}
+
+// CustomMetadataRecord is a record of a custom metadata type, identified by its developer name
+type CustomMetadataRecord struct {
+	ID               string                 `json:"__sys_gen_id"`
+	TypeAPIName      string                 `json:"type_api_name"`
+	DeveloperName    string                 `json:"developer_name"`
+	Label            string                 `json:"label"`
+	Values           map[string]interface{} `json:"values"`
+	CreatedDate      time.Time              `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time              `json:"__sys_gen_last_modified_date"`
+}
+
 type FieldDependency struct {
 	ID               string    `json:"__sys_gen_id"`
 	ObjectAPIName    string    `json:"object_api_name"`
@@ -204,6 +782,39 @@ type FieldDependency struct {
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
 
+// StateMachine governs the values of a picklist field as states: a record may only move
+// between them along a transition, and states run actions when records enter or leave them.
+type StateMachine struct {
+	ID               string                   `json:"__sys_gen_id"`
+	Name             string                   `json:"name"`
+	Description      string                   `json:"description,omitempty"`
+	ObjectAPIName    string                   `json:"object_api_name"`
+	FieldAPIName     string                   `json:"field_api_name"`
+	InitialStates    []string                 `json:"initial_states,omitempty"` // States new records may start in; empty allows any
+	States           []StateMachineState      `json:"states"`
+	Transitions      []StateMachineTransition `json:"transitions"`
+	IsActive         bool                     `json:"is_active"`
+	CreatedDate      time.Time                `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time                `json:"__sys_gen_last_modified_date"`
+}
+
+// StateMachineState is a picklist value of a state machine with the actions (by ID) run
+// after a record enters or leaves it
+type StateMachineState struct {
+	Value        string   `json:"value"`
+	EntryActions []string `json:"entry_actions,omitempty"`
+	ExitActions  []string `json:"exit_actions,omitempty"`
+}
+
+// StateMachineTransition allows records to move from one state to another. From "*" allows
+// the move from any state.
+type StateMachineTransition struct {
+	From           string   `json:"from"`
+	To             string   `json:"to"`
+	RequiredFields []string `json:"required_fields,omitempty"` // Fields that must have a value once the record moves
+	ProfileIDs     []string `json:"profile_ids,omitempty"`     // Profiles that may make the move; empty allows every user
+}
+
 // ValidationRule represents a validation rule
 type ValidationRule struct {
 	ID            string `json:"__sys_gen_id"`
@@ -252,11 +863,32 @@ type Theme struct {
 
 // DashboardConfig represents dashboard configuration
 type DashboardConfig struct {
-	ID          string         `json:"id"`
-	Label       string         `json:"label"`
-	Description *string        `json:"description,omitempty"`
-	Layout      string         `json:"layout,omitempty"`
-	Widgets     []WidgetConfig `json:"widgets"`
+	ID          string            `json:"id"`
+	Label       string            `json:"label"`
+	Description *string           `json:"description,omitempty"`
+	Layout      string            `json:"layout,omitempty"`
+	Widgets     []WidgetConfig    `json:"widgets"`
+	Filters     []DashboardFilter `json:"filters,omitempty"`
+	RunAs       DashboardRunAs    `json:"run_as,omitempty"`         // viewer (default) or user
+	RunAsUserID *string           `json:"run_as_user_id,omitempty"` // Required when RunAs is user
+}
+
+// DashboardFilter is a dashboard-level filter control applied to every widget whose
+// object has the filter field
+type DashboardFilter struct {
+	ID      string                `json:"id"`
+	Label   string                `json:"label"`
+	Type    DashboardFilterType   `json:"type"`
+	Field   string                `json:"field"`
+	Options []string              `json:"options,omitempty"` // Picklist filters: values offered (defaults to the field's options)
+	Default *DashboardFilterValue `json:"default,omitempty"`
+}
+
+// DashboardFilterValue is the selected value of a dashboard filter
+type DashboardFilterValue struct {
+	Start  string   `json:"start,omitempty"`  // date_range: YYYY-MM-DD, inclusive
+	End    string   `json:"end,omitempty"`    // date_range: YYYY-MM-DD, inclusive
+	Values []string `json:"values,omitempty"` // picklist: any of
 }
 
 // WidgetConfig represents a dashboard widget
@@ -274,6 +906,30 @@ type WidgetConfig struct {
 	Color  *string                `json:"color,omitempty"`
 }
 
+// DashboardRunRequest controls a server-side dashboard run
+type DashboardRunRequest struct {
+	Refresh bool                            `json:"refresh,omitempty"` // Bypass the result cache
+	Filters map[string]DashboardFilterValue `json:"filters,omitempty"` // Keyed by filter ID; unset filters use their default
+}
+
+// WidgetResult is the outcome of one widget query. A failing widget sets Error
+// without affecting the other widgets of the dashboard.
+type WidgetResult struct {
+	WidgetID   string      `json:"widget_id"`
+	Data       interface{} `json:"data"`
+	Error      string      `json:"error,omitempty"`
+	DurationMs int64       `json:"duration_ms"`
+}
+
+// DashboardRunResult holds the results of all query-backed widgets of a dashboard
+type DashboardRunResult struct {
+	DashboardID   string         `json:"dashboard_id"`
+	Widgets       []WidgetResult `json:"widgets"`
+	RunAt         time.Time      `json:"run_at"`
+	Cached        bool           `json:"cached"`
+	RunningUserID string         `json:"running_user_id"`
+}
+
 type ProfileLayoutAssignment struct {
 	LayoutID string `json:"layout_id"`
 }
@@ -304,3 +960,121 @@ type UIComponent struct {
 	CreatedDate   time.Time `json:"created_date,omitempty"`
 	LastModified  time.Time `json:"last_modified_date,omitempty"`
 }
+
+// Report is a saved report definition over one object and, through lookup fields, its parents.
+// Field references in Columns, Groupings, FilterExpr and Aggregates may be a field of the object
+// ("amount") or a field of a looked-up parent ("account_id.industry").
+type Report struct {
+	ID               string              `json:"id"`
+	Name             string              `json:"name"`
+	Description      *string             `json:"description,omitempty"`
+	ObjectAPIName    string              `json:"object_api_name"`
+	Format           ReportFormat        `json:"format"`
+	Columns          []ReportColumn      `json:"columns"`
+	FilterExpr       string              `json:"filter_expr,omitempty"`
+	Groupings        []string            `json:"groupings,omitempty"`        // summary: 1-3 row groupings; matrix: exactly one
+	ColumnGroupings  []string            `json:"column_groupings,omitempty"` // matrix only: exactly one
+	Aggregates       []ListViewAggregate `json:"aggregates,omitempty"`       // Computed per group and as grand totals
+	SortField        string              `json:"sort_field,omitempty"`
+	SortDirection    string              `json:"sort_direction,omitempty"`
+	RowLimit         int                 `json:"row_limit,omitempty"` // Max detail rows; 0 uses the server default
+	OwnerID          string              `json:"owner_id,omitempty"`
+	CreatedDate      *time.Time          `json:"created_date,omitempty"`
+	LastModifiedDate *time.Time          `json:"last_modified_date,omitempty"`
+}
+
+// ReportColumn is a column of a report's detail rows
+type ReportColumn struct {
+	Field string `json:"field"`
+	Label string `json:"label,omitempty"` // Defaults to the field label
+}
+
+// ReportResultColumn describes a column of a report result
+type ReportResultColumn struct {
+	Key   string `json:"key"` // Field path; also the key of the value in each row
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+// ReportGroup is one grouping level of a summary report. Aggregates are the group's subtotals,
+// keyed by "<function>_<field>" like list view footers. Leaf groups carry the detail rows.
+type ReportGroup struct {
+	Field      string                 `json:"field"`
+	Value      interface{}            `json:"value"`
+	Count      int64                  `json:"count"`
+	Aggregates map[string]interface{} `json:"aggregates,omitempty"`
+	Groups     []ReportGroup          `json:"groups,omitempty"`
+	Rows       []SObject              `json:"rows,omitempty"`
+}
+
+// ReportMatrixCell holds the aggregates of one row/column intersection of a matrix report
+type ReportMatrixCell struct {
+	Row    interface{}            `json:"row"`
+	Column interface{}            `json:"column"`
+	Count  int64                  `json:"count"`
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// ReportMatrixTotal holds the aggregates of a whole matrix row or column
+type ReportMatrixTotal struct {
+	Value  interface{}            `json:"value"`
+	Count  int64                  `json:"count"`
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// ReportMatrix is the cross-tabulation of a matrix report
+type ReportMatrix struct {
+	RowGrouping    string              `json:"row_grouping"`
+	ColumnGrouping string              `json:"column_grouping"`
+	Cells          []ReportMatrixCell  `json:"cells"`
+	RowTotals      []ReportMatrixTotal `json:"row_totals"`
+	ColumnTotals   []ReportMatrixTotal `json:"column_totals"`
+}
+
+// ReportResult is the output of running a report
+type ReportResult struct {
+	ReportID    string                 `json:"report_id,omitempty"` // Empty for unsaved (preview) reports
+	Name        string                 `json:"name"`
+	Format      ReportFormat           `json:"format"`
+	Columns     []ReportResultColumn   `json:"columns"`
+	Groupings   []ReportResultColumn   `json:"groupings,omitempty"` // summary and matrix grouping fields
+	Rows        []SObject              `json:"rows,omitempty"`      // tabular
+	Groups      []ReportGroup          `json:"groups,omitempty"`    // summary
+	Matrix      *ReportMatrix          `json:"matrix,omitempty"`    // matrix
+	TotalCount  int64                  `json:"total_count"`
+	GrandTotals map[string]interface{} `json:"grand_totals,omitempty"`
+	Aggregates  []string               `json:"aggregates,omitempty"` // Aggregate keys in report order
+	Truncated   bool                   `json:"truncated"`            // More rows matched than RowLimit
+	RunAt       time.Time              `json:"run_at"`
+}
+
+// MetadataBundleVersion is the format version of metadata bundles written by this release
+const MetadataBundleVersion = 1
+
+// MetadataBundle is a portable copy of an org's customizations: its custom objects and
+// their fields, their validation rules, flows and dashboards
+type MetadataBundle struct {
+	Version         int               `json:"version"`
+	ExportedAt      time.Time         `json:"exported_at"`
+	Objects         []ObjectMetadata  `json:"objects"`
+	ValidationRules []ValidationRule  `json:"validation_rules,omitempty"`
+	Flows           []Flow            `json:"flows,omitempty"`
+	Dashboards      []DashboardConfig `json:"dashboards,omitempty"`
+}
+
+// MetadataImportCount counts the components of one kind an import created and updated
+type MetadataImportCount struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+}
+
+// MetadataImportResult describes what importing a metadata bundle changed. Errors lists the
+// components that failed; the rest of the bundle is still imported.
+type MetadataImportResult struct {
+	Objects         MetadataImportCount `json:"objects"`
+	Fields          MetadataImportCount `json:"fields"`
+	ValidationRules MetadataImportCount `json:"validation_rules"`
+	Flows           MetadataImportCount `json:"flows"`
+	Dashboards      MetadataImportCount `json:"dashboards"`
+	Errors          []string            `json:"errors,omitempty"`
+}
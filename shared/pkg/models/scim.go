@@ -0,0 +1,111 @@
+package models
+
+// SCIM 2.0 schema URNs (RFC 7643, RFC 7644)
+const (
+	SCIMSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaUserExtension         = "urn:nexuscrm:params:scim:schemas:extension:2.0:User"
+	SCIMSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SCIMSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// SCIMUser is a _System_User as a SCIM User resource. userName is the user's email.
+type SCIMUser struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id,omitempty"`
+	ExternalID  string             `json:"externalId,omitempty"`
+	UserName    string             `json:"userName"`
+	Name        *SCIMName          `json:"name,omitempty"`
+	DisplayName string             `json:"displayName,omitempty"`
+	Emails      []SCIMEmail        `json:"emails,omitempty"`
+	Active      *bool              `json:"active,omitempty"`
+	Locale      string             `json:"locale,omitempty"`
+	Groups      []SCIMMember       `json:"groups,omitempty"` // Read-only; managed through Groups
+	Password    string             `json:"password,omitempty"`
+	NexusCRM    *SCIMUserExtension `json:"urn:nexuscrm:params:scim:schemas:extension:2.0:User,omitempty"`
+	Meta        *SCIMMeta          `json:"meta,omitempty"`
+}
+
+// SCIMName is the name of a SCIM user
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// SCIMEmail is an email address of a SCIM user
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMUserExtension carries the NexusCRM profile and role of a user
+type SCIMUserExtension struct {
+	ProfileID string `json:"profileId,omitempty"`
+	RoleID    string `json:"roleId,omitempty"`
+}
+
+// SCIMGroup is a _System_Group as a SCIM Group resource
+type SCIMGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []SCIMMember `json:"members,omitempty"`
+	Meta        *SCIMMeta    `json:"meta,omitempty"`
+}
+
+// SCIMMember references a user in a group, or a group of a user
+type SCIMMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// SCIMMeta is the resource metadata of a SCIM resource
+type SCIMMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// SCIMListResponse is a page of SCIM resources
+type SCIMListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// SCIMPatchRequest is a SCIM PATCH request body
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+// SCIMPatchOperation is one operation of a SCIM PATCH request
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// SCIMListQuery holds the filter and paging parameters of a SCIM list request
+type SCIMListQuery struct {
+	Filter     string
+	StartIndex int // 1-based
+	Count      int
+}
+
+// SCIMError is the body of a SCIM error response
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
@@ -146,6 +146,22 @@ func (p *SystemFieldPerms) ToSObject() SObject {
 	return m
 }
 
+// ToSObject converts OrderDetail to SObject: the order's fields with its items under "items"
+func (d *OrderDetail) ToSObject() SObject {
+	b, _ := json.Marshal(d.Order)
+	var m map[string]interface{}
+	_ = json.Unmarshal(b, &m)
+	items := make([]interface{}, 0, len(d.Items))
+	for _, item := range d.Items {
+		ib, _ := json.Marshal(item)
+		var im map[string]interface{}
+		_ = json.Unmarshal(ib, &im)
+		items = append(items, im)
+	}
+	m["items"] = items
+	return m
+}
+
 // UserSession Helper Methods
 
 // ToMap converts UserSession to a map for formula context
@@ -163,3 +179,11 @@ func (u *UserSession) ToMap() map[string]interface{} {
 func (u *UserSession) IsSuperUser() bool {
 	return constants.IsSuperUser(u.ProfileID)
 }
+
+// ToSObject converts SystemSurveyResponse to SObject
+func (r *SystemSurveyResponse) ToSObject() SObject {
+	b, _ := json.Marshal(r)
+	var m map[string]interface{}
+	_ = json.Unmarshal(b, &m)
+	return m
+}
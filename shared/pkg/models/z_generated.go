@@ -1,6 +1,6 @@
 // Code generated by cmd/codegen. DO NOT EDIT.
 // Source: internal/bootstrap/system_tables.json
-// Generated at: 2026-01-12T06:46:13+08:00
+// Generated at: 2026-10-18T13:48:25Z
 
 //go:generate go run ../../../cmd/codegen
 
@@ -17,6 +17,23 @@ var (
 	_ time.Time
 )
 
+// SystemAIContextItem represents the _System_AI_Context_Item table (generated).
+// Files pinned to a user's AI agent context
+type SystemAIContextItem struct {
+	ID string `json:"__sys_gen_id"`
+	UserID string `json:"user_id"`
+	Path string `json:"path"`
+	Content string `json:"content"`
+	TokenSize int `json:"token_size"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemAIContextItem.
+func (SystemAIContextItem) GetTableName() string {
+	return "_System_AI_Context_Item"
+}
+
 // SystemAIConversation represents the _System_AI_Conversation table (generated).
 // Persisted AI conversation history per user
 type SystemAIConversation struct {
@@ -25,6 +42,7 @@ type SystemAIConversation struct {
 	Title string `json:"title"`
 	Messages json.RawMessage `json:"messages"`
 	IsActive bool `json:"is_active"`
+	Settings json.RawMessage `json:"settings"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
@@ -55,6 +73,36 @@ func (SystemAction) GetTableName() string {
 	return "_System_Action"
 }
 
+// SystemActivity represents the _System_Activity table (generated).
+// Emails, calendar events and calls captured from sync connectors and telephony providers, linked to the records whose email addresses or phone numbers they involve
+type SystemActivity struct {
+	ID string `json:"__sys_gen_id"`
+	ActivityType string `json:"activity_type"`
+	Subject *string `json:"subject,omitempty"`
+	Description *string `json:"description,omitempty"`
+	FromAddress *string `json:"from_address,omitempty"`
+	ToAddresses *string `json:"to_addresses,omitempty"`
+	ActivityDate time.Time `json:"activity_date"`
+	EndDate *time.Time `json:"end_date,omitempty"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	CallDirection *string `json:"call_direction,omitempty"`
+	CallStatus *string `json:"call_status,omitempty"`
+	CallDuration *int `json:"call_duration,omitempty"`
+	RecordingURL *string `json:"recording_url,omitempty"`
+	ConnectorID string `json:"connector_id"`
+	ExternalID string `json:"external_id"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	IsDeleted bool `json:"__sys_gen_is_deleted"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemActivity.
+func (SystemActivity) GetTableName() string {
+	return "_System_Activity"
+}
+
 // SystemApp represents the _System_App table (generated).
 // Application configurations
 type SystemApp struct {
@@ -128,6 +176,50 @@ func (SystemApprovalWorkItem) GetTableName() string {
 	return "_System_ApprovalWorkItem"
 }
 
+// SystemArchivePolicy represents the _System_ArchivePolicy table (generated).
+// Per-object archival policies moving old rows to an archive table
+type SystemArchivePolicy struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	DateField string `json:"date_field"`
+	RetainDays int `json:"retain_days"`
+	IsActive bool `json:"is_active"`
+	LastRunDate *time.Time `json:"last_run_date,omitempty"`
+	LastArchivedCount int `json:"last_archived_count"`
+	LastError *string `json:"last_error,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemArchivePolicy.
+func (SystemArchivePolicy) GetTableName() string {
+	return "_System_ArchivePolicy"
+}
+
+// SystemAsyncJob represents the _System_AsyncJob table (generated).
+// Background jobs (e.g. picklist value replacement) with their progress and outcome
+type SystemAsyncJob struct {
+	ID string `json:"__sys_gen_id"`
+	JobType string `json:"job_type"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	Status string `json:"status"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+	TotalCount int `json:"total_count"`
+	ProcessedCount int `json:"processed_count"`
+	FailedCount int `json:"failed_count"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	StartedDate *time.Time `json:"started_date,omitempty"`
+	CompletedDate *time.Time `json:"completed_date,omitempty"`
+	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemAsyncJob.
+func (SystemAsyncJob) GetTableName() string {
+	return "_System_AsyncJob"
+}
+
 // SystemAuditLog represents the _System_AuditLog table (generated).
 // Field history tracking
 type SystemAuditLog struct {
@@ -157,6 +249,7 @@ type SystemAutoNumber struct {
 	DisplayFormat string `json:"display_format"`
 	StartingNumber int `json:"starting_number"`
 	CurrentNumber int `json:"current_number"`
+	GapFree bool `json:"gap_free"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
@@ -166,6 +259,159 @@ func (SystemAutoNumber) GetTableName() string {
 	return "_System_AutoNumber"
 }
 
+// SystemBatchJob represents the _System_BatchJob table (generated).
+// Batch jobs processing the records of a scope query in chunks with a script or a built-in action, run on demand or on a schedule
+type SystemBatchJob struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ObjectAPIName string `json:"object_api_name"`
+	ScopeFilter *string `json:"scope_filter,omitempty"`
+	Processor string `json:"processor"`
+	ScriptName *string `json:"script_name,omitempty"`
+	FieldValues json.RawMessage `json:"field_values,omitempty"`
+	ChunkSize *int `json:"chunk_size,omitempty"`
+	Concurrency *int `json:"concurrency,omitempty"`
+	Schedule *string `json:"schedule,omitempty"`
+	ScheduleTimezone *string `json:"schedule_timezone,omitempty"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	LastJobID *string `json:"last_job_id,omitempty"`
+	IsActive bool `json:"is_active"`
+	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemBatchJob.
+func (SystemBatchJob) GetTableName() string {
+	return "_System_BatchJob"
+}
+
+// SystemBootstrapStep represents the _System_BootstrapStep table (generated).
+// Startup steps that completed and the checksum of their input; a step is skipped while its checksum is unchanged
+type SystemBootstrapStep struct {
+	ID string `json:"__sys_gen_id"`
+	StepName string `json:"step_name"`
+	Checksum string `json:"checksum"`
+	DurationMs int `json:"duration_ms"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemBootstrapStep.
+func (SystemBootstrapStep) GetTableName() string {
+	return "_System_BootstrapStep"
+}
+
+// SystemBusinessHours represents the _System_BusinessHours table (generated).
+// Weekly working schedules used to measure elapsed business time
+type SystemBusinessHours struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	Timezone string `json:"timezone"`
+	IsDefault bool `json:"is_default"`
+	Schedule json.RawMessage `json:"schedule"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemBusinessHours.
+func (SystemBusinessHours) GetTableName() string {
+	return "_System_BusinessHours"
+}
+
+// SystemCampaign represents the _System_Campaign table (generated).
+// Marketing campaign with its member statuses and influenced revenue rollups
+type SystemCampaign struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Type *string `json:"type,omitempty"`
+	Status string `json:"status"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate *time.Time `json:"end_date,omitempty"`
+	BudgetedCost *float64 `json:"budgeted_cost,omitempty"`
+	ActualCost *float64 `json:"actual_cost,omitempty"`
+	ExpectedRevenue *float64 `json:"expected_revenue,omitempty"`
+	MemberStatuses json.RawMessage `json:"member_statuses,omitempty"`
+	IsActive bool `json:"is_active"`
+	NumberOfMembers int `json:"number_of_members"`
+	NumberOfResponses int `json:"number_of_responses"`
+	NumberOfOpportunities int `json:"number_of_opportunities"`
+	NumberOfWonOpportunities int `json:"number_of_won_opportunities"`
+	AmountAllOpportunities float64 `json:"amount_all_opportunities"`
+	AmountWonOpportunities float64 `json:"amount_won_opportunities"`
+	RollupsDate *time.Time `json:"rollups_date,omitempty"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCampaign.
+func (SystemCampaign) GetTableName() string {
+	return "_System_Campaign"
+}
+
+// SystemCampaignMember represents the _System_CampaignMember table (generated).
+// Record (lead, contact...) targeted by a campaign and its response status
+type SystemCampaignMember struct {
+	ID string `json:"__sys_gen_id"`
+	CampaignID string `json:"campaign_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Status string `json:"status"`
+	HasResponded bool `json:"has_responded"`
+	FirstRespondedDate *time.Time `json:"first_responded_date,omitempty"`
+	AddedByID string `json:"added_by_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCampaignMember.
+func (SystemCampaignMember) GetTableName() string {
+	return "_System_CampaignMember"
+}
+
+// SystemChangeEvent represents the _System_ChangeEvent table (generated).
+// Change data capture stream: one row per captured record change, ordered by position
+type SystemChangeEvent struct {
+	ID string `json:"__sys_gen_id"`
+	Position int64 `json:"position"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Operation string `json:"operation"`
+	TransactionID string `json:"transaction_id"`
+	CommitTimestamp time.Time `json:"commit_timestamp"`
+	ChangedFields json.RawMessage `json:"changed_fields,omitempty"`
+	BeforeData json.RawMessage `json:"before_data,omitempty"`
+	AfterData json.RawMessage `json:"after_data,omitempty"`
+	UserID *string `json:"user_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemChangeEvent.
+func (SystemChangeEvent) GetTableName() string {
+	return "_System_ChangeEvent"
+}
+
+// SystemChangeEventOffset represents the _System_ChangeEventOffset table (generated).
+// Last change event position committed by each change data capture consumer
+type SystemChangeEventOffset struct {
+	ID string `json:"__sys_gen_id"`
+	Consumer string `json:"consumer"`
+	Position int64 `json:"position"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemChangeEventOffset.
+func (SystemChangeEventOffset) GetTableName() string {
+	return "_System_ChangeEventOffset"
+}
+
 // SystemComment represents the _System_Comment table (generated).
 // User comments on records
 type SystemComment struct {
@@ -187,7 +433,7 @@ func (SystemComment) GetTableName() string {
 }
 
 // SystemConfig represents the _System_Config table (generated).
-// System configuration settings
+// Legacy flat configuration; superseded by _System_CustomSetting and migrated into it at startup
 type SystemConfig struct {
 	KeyName string `json:"key_name"`
 	Value string `json:"value"`
@@ -202,6 +448,124 @@ func (SystemConfig) GetTableName() string {
 	return "_System_Config"
 }
 
+// SystemContract represents the _System_Contract table (generated).
+// Support contract of an account; its entitlements apply while it is activated and in term
+type SystemContract struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	AccountID string `json:"account_id"`
+	Status string `json:"status"`
+	StartDate time.Time `json:"start_date"`
+	EndDate *time.Time `json:"end_date,omitempty"`
+	Description *string `json:"description,omitempty"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemContract.
+func (SystemContract) GetTableName() string {
+	return "_System_Contract"
+}
+
+// SystemCustomEndpoint represents the _System_CustomEndpoint table (generated).
+// Custom REST endpoints served at /api/custom/:name that run a script or a declarative query or DML template on validated input
+type SystemCustomEndpoint struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	HttpMethod string `json:"http_method"`
+	Action string `json:"action"`
+	ScriptName *string `json:"script_name,omitempty"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	FilterExpr *string `json:"filter_expr,omitempty"`
+	SortField *string `json:"sort_field,omitempty"`
+	SortDirection *string `json:"sort_direction,omitempty"`
+	RowLimit *int `json:"row_limit,omitempty"`
+	RecordID *string `json:"record_id,omitempty"`
+	FieldValues json.RawMessage `json:"field_values,omitempty"`
+	InputSchema json.RawMessage `json:"input_schema,omitempty"`
+	ProfileIDs json.RawMessage `json:"profile_ids,omitempty"`
+	IsActive bool `json:"is_active"`
+	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCustomEndpoint.
+func (SystemCustomEndpoint) GetTableName() string {
+	return "_System_CustomEndpoint"
+}
+
+// SystemCustomMetadataRecord represents the _System_CustomMetadataRecord table (generated).
+// Records of custom metadata types, cached in memory with the rest of the metadata
+type SystemCustomMetadataRecord struct {
+	ID string `json:"__sys_gen_id"`
+	TypeAPIName string `json:"type_api_name"`
+	DeveloperName string `json:"developer_name"`
+	Label string `json:"label"`
+	FieldValues json.RawMessage `json:"field_values,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCustomMetadataRecord.
+func (SystemCustomMetadataRecord) GetTableName() string {
+	return "_System_CustomMetadataRecord"
+}
+
+// SystemCustomMetadataType represents the _System_CustomMetadataType table (generated).
+// Admin-defined configuration types whose records are deployed as metadata
+type SystemCustomMetadataType struct {
+	ID string `json:"__sys_gen_id"`
+	APIName string `json:"api_name"`
+	Label string `json:"label"`
+	Description *string `json:"description,omitempty"`
+	Fields json.RawMessage `json:"fields,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCustomMetadataType.
+func (SystemCustomMetadataType) GetTableName() string {
+	return "_System_CustomMetadataType"
+}
+
+// SystemCustomSetting represents the _System_CustomSetting table (generated).
+// Hierarchical custom settings with an org-wide default value
+type SystemCustomSetting struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	Description *string `json:"description,omitempty"`
+	Type string `json:"type"`
+	DefaultValue json.RawMessage `json:"default_value,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCustomSetting.
+func (SystemCustomSetting) GetTableName() string {
+	return "_System_CustomSetting"
+}
+
+// SystemCustomSettingValue represents the _System_CustomSettingValue table (generated).
+// Profile and user overrides of custom settings
+type SystemCustomSettingValue struct {
+	ID string `json:"__sys_gen_id"`
+	SettingName string `json:"setting_name"`
+	ScopeType string `json:"scope_type"`
+	ScopeID string `json:"scope_id"`
+	Value json.RawMessage `json:"value,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemCustomSettingValue.
+func (SystemCustomSettingValue) GetTableName() string {
+	return "_System_CustomSettingValue"
+}
+
 // SystemDashboard represents the _System_Dashboard table (generated).
 // Dashboard configurations with widget-based layouts
 type SystemDashboard struct {
@@ -210,6 +574,9 @@ type SystemDashboard struct {
 	Description string `json:"description"`
 	Layout string `json:"layout"`
 	Widgets json.RawMessage `json:"widgets"`
+	Filters json.RawMessage `json:"filters"`
+	RunAs string `json:"run_as"`
+	RunAsUserID string `json:"run_as_user_id"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
 	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
@@ -223,6 +590,87 @@ func (SystemDashboard) GetTableName() string {
 	return "_System_Dashboard"
 }
 
+// SystemDataQualityRule represents the _System_Data_Quality_Rule table (generated).
+// Per-object rules for data quality scoring
+type SystemDataQualityRule struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	CompletenessFields json.RawMessage `json:"completeness_fields"`
+	MatchFields json.RawMessage `json:"match_fields"`
+	DuplicateThreshold int `json:"duplicate_threshold"`
+	UseEmbeddings bool `json:"use_embeddings"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemDataQualityRule.
+func (SystemDataQualityRule) GetTableName() string {
+	return "_System_Data_Quality_Rule"
+}
+
+// SystemDataQualityScore represents the _System_Data_Quality_Score table (generated).
+// Completeness and duplicate scores of CRM records
+type SystemDataQualityScore struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	CompletenessScore int `json:"completeness_score"`
+	DuplicateScore int `json:"duplicate_score"`
+	DuplicateOf *string `json:"duplicate_of,omitempty"`
+	QualityScore int `json:"quality_score"`
+	MissingFields json.RawMessage `json:"missing_fields"`
+	ScoredDate time.Time `json:"scored_date"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemDataQualityScore.
+func (SystemDataQualityScore) GetTableName() string {
+	return "_System_Data_Quality_Score"
+}
+
+// SystemDeletedMetadata represents the _System_DeletedMetadata table (generated).
+// Erased objects and fields kept restorable, with their data, until the grace period ends
+type SystemDeletedMetadata struct {
+	ID string `json:"__sys_gen_id"`
+	ComponentType string `json:"component_type"`
+	ObjectAPIName string `json:"object_api_name"`
+	FieldAPIName *string `json:"field_api_name,omitempty"`
+	StorageName *string `json:"storage_name,omitempty"`
+	Metadata json.RawMessage `json:"metadata"`
+	DeletedByID *string `json:"deleted_by_id,omitempty"`
+	DeletedDate time.Time `json:"deleted_date"`
+	PurgeAfter time.Time `json:"purge_after"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemDeletedMetadata.
+func (SystemDeletedMetadata) GetTableName() string {
+	return "_System_DeletedMetadata"
+}
+
+// SystemDocumentTemplate represents the _System_DocumentTemplate table (generated).
+// Printable document templates (HTML or DOCX with {{field}} merge fields, barcodes and QR codes) rendered to PDF from a record and stored as a file
+type SystemDocumentTemplate struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	ObjectAPIName string `json:"object_api_name"`
+	TemplateType string `json:"template_type"`
+	Content string `json:"content"`
+	FileName *string `json:"file_name,omitempty"`
+	PageSize string `json:"page_size"`
+	IsActive bool `json:"is_active"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemDocumentTemplate.
+func (SystemDocumentTemplate) GetTableName() string {
+	return "_System_DocumentTemplate"
+}
+
 // SystemEmailTemplate represents the _System_EmailTemplate table (generated).
 // Email templates for notifications
 type SystemEmailTemplate struct {
@@ -244,6 +692,109 @@ func (SystemEmailTemplate) GetTableName() string {
 	return "_System_EmailTemplate"
 }
 
+// SystemEntitlement represents the _System_Entitlement table (generated).
+// Support an account is entitled to; each case created against it consumes one unit
+type SystemEntitlement struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	AccountID string `json:"account_id"`
+	ContractID *string `json:"contract_id,omitempty"`
+	Type *string `json:"type,omitempty"`
+	StartDate time.Time `json:"start_date"`
+	EndDate *time.Time `json:"end_date,omitempty"`
+	TotalUnits *float64 `json:"total_units,omitempty"`
+	RemainingUnits *float64 `json:"remaining_units,omitempty"`
+	IsActive bool `json:"is_active"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemEntitlement.
+func (SystemEntitlement) GetTableName() string {
+	return "_System_Entitlement"
+}
+
+// SystemEntitlementUsage represents the _System_EntitlementUsage table (generated).
+// Units of an entitlement consumed by a case
+type SystemEntitlementUsage struct {
+	ID string `json:"__sys_gen_id"`
+	EntitlementID string `json:"entitlement_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Units float64 `json:"units"`
+	ConsumedByID string `json:"consumed_by_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemEntitlementUsage.
+func (SystemEntitlementUsage) GetTableName() string {
+	return "_System_EntitlementUsage"
+}
+
+// SystemEscalationLog represents the _System_EscalationLog table (generated).
+// Escalation actions taken on records, one row per rule level
+type SystemEscalationLog struct {
+	ID string `json:"__sys_gen_id"`
+	RuleName string `json:"rule_name"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Level int `json:"level"`
+	EscalatedDate time.Time `json:"escalated_date"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemEscalationLog.
+func (SystemEscalationLog) GetTableName() string {
+	return "_System_EscalationLog"
+}
+
+// SystemEscalationRule represents the _System_EscalationRule table (generated).
+// Time-based escalations (reassign, notify, run flow) for records that stay open too long
+type SystemEscalationRule struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	ObjectAPIName string `json:"object_api_name"`
+	Criteria *string `json:"criteria,omitempty"`
+	StartField string `json:"start_field"`
+	BusinessHours *string `json:"business_hours,omitempty"`
+	Actions json.RawMessage `json:"actions"`
+	IsActive bool `json:"is_active"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemEscalationRule.
+func (SystemEscalationRule) GetTableName() string {
+	return "_System_EscalationRule"
+}
+
+// SystemExternalObject represents the _System_ExternalObject table (generated).
+// Connection settings of external objects, read-only objects whose records are fetched from a REST, OData or SQL source at query time
+type SystemExternalObject struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	Adapter string `json:"adapter"`
+	NamedCredential *string `json:"named_credential,omitempty"`
+	Resource string `json:"resource"`
+	RecordsPath *string `json:"records_path,omitempty"`
+	IDField string `json:"id_field"`
+	FieldMap json.RawMessage `json:"field_map,omitempty"`
+	Dsn *string `json:"dsn,omitempty"`
+	MaxRows int `json:"max_rows"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemExternalObject.
+func (SystemExternalObject) GetTableName() string {
+	return "_System_ExternalObject"
+}
+
 // SystemFeedItem represents the _System_FeedItem table (generated).
 // Feed items for chatter and notifications
 type SystemFeedItem struct {
@@ -292,6 +843,9 @@ type SystemField struct {
 	Validator *string `json:"validator,omitempty"`
 	ControllingField *string `json:"controlling_field,omitempty"`
 	PicklistDependency json.RawMessage `json:"picklist_dependency,omitempty"`
+	InactiveOptions json.RawMessage `json:"inactive_options,omitempty"`
+	ValueSet *string `json:"value_set,omitempty"`
+	LookupFilter *string `json:"lookup_filter,omitempty"`
 	RollupConfig json.RawMessage `json:"rollup_config,omitempty"`
 	IsMasterDetail bool `json:"is_master_detail"`
 	IsPolymorphic bool `json:"is_polymorphic"`
@@ -416,69 +970,286 @@ type SystemFlowInstance struct {
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 }
 
-// GetTableName returns the database table name for SystemFlowInstance.
-func (SystemFlowInstance) GetTableName() string {
-	return "_System_FlowInstance"
+// GetTableName returns the database table name for SystemFlowInstance.
+func (SystemFlowInstance) GetTableName() string {
+	return "_System_FlowInstance"
+}
+
+// SystemFlowStep represents the _System_FlowStep table (generated).
+// Step definitions for multi-step flows
+type SystemFlowStep struct {
+	ID string `json:"__sys_gen_id"`
+	FlowID string `json:"flow_id"`
+	StepOrder int `json:"step_order"`
+	StepName string `json:"step_name"`
+	StepType string `json:"step_type"`
+	ActionType *string `json:"action_type,omitempty"`
+	ActionConfig json.RawMessage `json:"action_config,omitempty"`
+	EntryCondition *string `json:"entry_condition,omitempty"`
+	OnSuccessStep *string `json:"on_success_step,omitempty"`
+	OnFailureStep *string `json:"on_failure_step,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
+	LastModifiedByID *string `json:"__sys_gen_last_modified_by_id,omitempty"`
+	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
+	IsDeleted bool `json:"__sys_gen_is_deleted"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemFlowStep.
+func (SystemFlowStep) GetTableName() string {
+	return "_System_FlowStep"
+}
+
+// SystemForecastAdjustment represents the _System_ForecastAdjustment table (generated).
+// Manager override of the amount a user forecasts in a category for a period
+type SystemForecastAdjustment struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	UserID string `json:"user_id"`
+	Period string `json:"period"`
+	Category string `json:"category"`
+	Amount float64 `json:"amount"`
+	Note *string `json:"note,omitempty"`
+	AdjustedByID string `json:"adjusted_by_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemForecastAdjustment.
+func (SystemForecastAdjustment) GetTableName() string {
+	return "_System_ForecastAdjustment"
+}
+
+// SystemForecastQuota represents the _System_ForecastQuota table (generated).
+// Forecast quota of a user for a period (2026, 2026-Q4 or 2026-10)
+type SystemForecastQuota struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	UserID string `json:"user_id"`
+	Period string `json:"period"`
+	Amount float64 `json:"amount"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemForecastQuota.
+func (SystemForecastQuota) GetTableName() string {
+	return "_System_ForecastQuota"
+}
+
+// SystemForecastSetting represents the _System_ForecastSetting table (generated).
+// Per-object forecasting: amount, close date and stage fields with the forecast category of each stage
+type SystemForecastSetting struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	AmountField string `json:"amount_field"`
+	CloseDateField string `json:"close_date_field"`
+	StageField string `json:"stage_field"`
+	CategoryMapping json.RawMessage `json:"category_mapping"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemForecastSetting.
+func (SystemForecastSetting) GetTableName() string {
+	return "_System_ForecastSetting"
+}
+
+// SystemGlobalValueSet represents the _System_GlobalValueSet table (generated).
+// Picklist value lists shared by multiple picklist fields
+type SystemGlobalValueSet struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	Description *string `json:"description,omitempty"`
+	Options json.RawMessage `json:"options"`
+	InactiveOptions json.RawMessage `json:"inactive_options,omitempty"`
+	IsStandard bool `json:"is_standard"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemGlobalValueSet.
+func (SystemGlobalValueSet) GetTableName() string {
+	return "_System_GlobalValueSet"
+}
+
+// SystemGroup represents the _System_Group table (generated).
+// Groups and Queues
+type SystemGroup struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	Type string `json:"type"`
+	Email *string `json:"email,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	IsDeleted bool `json:"__sys_gen_is_deleted"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemGroup.
+func (SystemGroup) GetTableName() string {
+	return "_System_Group"
+}
+
+// SystemGroupMember represents the _System_GroupMember table (generated).
+// Group membership
+type SystemGroupMember struct {
+	ID string `json:"__sys_gen_id"`
+	GroupID string `json:"group_id"`
+	UserID string `json:"user_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	IsDeleted bool `json:"__sys_gen_is_deleted"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemGroupMember.
+func (SystemGroupMember) GetTableName() string {
+	return "_System_GroupMember"
+}
+
+// SystemHoliday represents the _System_Holiday table (generated).
+// Non-working days of business hours
+type SystemHoliday struct {
+	ID string `json:"__sys_gen_id"`
+	BusinessHoursID string `json:"business_hours_id"`
+	Name string `json:"name"`
+	HolidayDate string `json:"holiday_date"`
+	IsRecurring bool `json:"is_recurring"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemHoliday.
+func (SystemHoliday) GetTableName() string {
+	return "_System_Holiday"
+}
+
+// SystemHookSubscription represents the _System_HookSubscription table (generated).
+// REST hook subscriptions of integration platforms (Zapier, Make): record events of an object are posted to the target URL with the subscribing user's access
+type SystemHookSubscription struct {
+	ID string `json:"__sys_gen_id"`
+	UserID string `json:"user_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	Event string `json:"event"`
+	TargetURL string `json:"target_url"`
+	IsActive bool `json:"is_active"`
+	FailureCount int `json:"failure_count"`
+	LastDeliveryDate *time.Time `json:"last_delivery_date,omitempty"`
+	LastError *string `json:"last_error,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemHookSubscription.
+func (SystemHookSubscription) GetTableName() string {
+	return "_System_HookSubscription"
+}
+
+// SystemInboundHook represents the _System_InboundHook table (generated).
+// Inbound webhook endpoints (/hooks/:slug): JSON payloads pushed by external systems are mapped onto fields and create or upsert records; secrets are stored encrypted
+type SystemInboundHook struct {
+	ID string `json:"__sys_gen_id"`
+	Slug string `json:"slug"`
+	Label string `json:"label"`
+	ObjectAPIName string `json:"object_api_name"`
+	Operation string `json:"operation"`
+	MatchField *string `json:"match_field,omitempty"`
+	RecordsPath *string `json:"records_path,omitempty"`
+	FieldMapping json.RawMessage `json:"field_mapping"`
+	Secret string `json:"secret"`
+	RunAsUserID string `json:"run_as_user_id"`
+	IsActive bool `json:"is_active"`
+	LastReceivedDate *time.Time `json:"last_received_date,omitempty"`
+	LastError *string `json:"last_error,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemInboundHook.
+func (SystemInboundHook) GetTableName() string {
+	return "_System_InboundHook"
+}
+
+// SystemKnowledgeArticle represents the _System_KnowledgeArticle table (generated).
+// Knowledge base article; holds the working copy while its published versions are served to readers
+type SystemKnowledgeArticle struct {
+	ID string `json:"__sys_gen_id"`
+	URLName string `json:"url_name"`
+	Title string `json:"title"`
+	Summary *string `json:"summary,omitempty"`
+	Body *string `json:"body,omitempty"`
+	CategoryID *string `json:"category_id,omitempty"`
+	Status string `json:"status"`
+	VersionNumber int `json:"version_number"`
+	PublishedVersionNumber *int `json:"published_version_number,omitempty"`
+	PublishedDate *time.Time `json:"published_date,omitempty"`
+	ArchivedDate *time.Time `json:"archived_date,omitempty"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemKnowledgeArticle.
+func (SystemKnowledgeArticle) GetTableName() string {
+	return "_System_KnowledgeArticle"
 }
 
-// SystemFlowStep represents the _System_FlowStep table (generated).
-// Step definitions for multi-step flows
-type SystemFlowStep struct {
+// SystemKnowledgeArticleLink represents the _System_KnowledgeArticleLink table (generated).
+// Knowledge article attached to a record such as a case, with the version attached
+type SystemKnowledgeArticleLink struct {
 	ID string `json:"__sys_gen_id"`
-	FlowID string `json:"flow_id"`
-	StepOrder int `json:"step_order"`
-	StepName string `json:"step_name"`
-	StepType string `json:"step_type"`
-	ActionType *string `json:"action_type,omitempty"`
-	ActionConfig json.RawMessage `json:"action_config,omitempty"`
-	EntryCondition *string `json:"entry_condition,omitempty"`
-	OnSuccessStep *string `json:"on_success_step,omitempty"`
-	OnFailureStep *string `json:"on_failure_step,omitempty"`
+	ArticleID string `json:"article_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	VersionNumber int `json:"version_number"`
+	LinkedByID string `json:"linked_by_id"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
-	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
-	LastModifiedByID *string `json:"__sys_gen_last_modified_by_id,omitempty"`
-	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
-	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
 
-// GetTableName returns the database table name for SystemFlowStep.
-func (SystemFlowStep) GetTableName() string {
-	return "_System_FlowStep"
+// GetTableName returns the database table name for SystemKnowledgeArticleLink.
+func (SystemKnowledgeArticleLink) GetTableName() string {
+	return "_System_KnowledgeArticleLink"
 }
 
-// SystemGroup represents the _System_Group table (generated).
-// Groups and Queues
-type SystemGroup struct {
+// SystemKnowledgeArticleVersion represents the _System_KnowledgeArticleVersion table (generated).
+// Snapshot of a knowledge article taken each time it is published
+type SystemKnowledgeArticleVersion struct {
 	ID string `json:"__sys_gen_id"`
-	Name string `json:"name"`
-	Label string `json:"label"`
-	Type string `json:"type"`
-	Email *string `json:"email,omitempty"`
+	ArticleID string `json:"article_id"`
+	VersionNumber int `json:"version_number"`
+	Title string `json:"title"`
+	Summary *string `json:"summary,omitempty"`
+	Body *string `json:"body,omitempty"`
+	CategoryID *string `json:"category_id,omitempty"`
+	PublishedByID string `json:"published_by_id"`
+	PublishedDate time.Time `json:"published_date"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
-	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
 
-// GetTableName returns the database table name for SystemGroup.
-func (SystemGroup) GetTableName() string {
-	return "_System_Group"
+// GetTableName returns the database table name for SystemKnowledgeArticleVersion.
+func (SystemKnowledgeArticleVersion) GetTableName() string {
+	return "_System_KnowledgeArticleVersion"
 }
 
-// SystemGroupMember represents the _System_GroupMember table (generated).
-// Group membership
-type SystemGroupMember struct {
+// SystemKnowledgeCategory represents the _System_KnowledgeCategory table (generated).
+// Category of the knowledge base taxonomy; categories nest under a parent
+type SystemKnowledgeCategory struct {
 	ID string `json:"__sys_gen_id"`
-	GroupID string `json:"group_id"`
-	UserID string `json:"user_id"`
+	Name string `json:"name"`
+	ParentID *string `json:"parent_id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	SortOrder int `json:"sort_order"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
-	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
 
-// GetTableName returns the database table name for SystemGroupMember.
-func (SystemGroupMember) GetTableName() string {
-	return "_System_GroupMember"
+// GetTableName returns the database table name for SystemKnowledgeCategory.
+func (SystemKnowledgeCategory) GetTableName() string {
+	return "_System_KnowledgeCategory"
 }
 
 // SystemLayout represents the _System_Layout table (generated).
@@ -504,6 +1275,13 @@ type SystemListView struct {
 	Label string `json:"label"`
 	FilterExpr *string `json:"filter_expr,omitempty"`
 	Fields json.RawMessage `json:"fields"`
+	Visibility string `json:"visibility"`
+	OwnerID *string `json:"owner_id,omitempty"`
+	ProfileIDs json.RawMessage `json:"profile_ids,omitempty"`
+	ColumnSettings json.RawMessage `json:"column_settings,omitempty"`
+	SortField *string `json:"sort_field,omitempty"`
+	SortDirection *string `json:"sort_direction,omitempty"`
+	Aggregates json.RawMessage `json:"aggregates,omitempty"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
 }
@@ -531,6 +1309,28 @@ func (SystemLog) GetTableName() string {
 	return "_System_Log"
 }
 
+// SystemNamedCredential represents the _System_NamedCredential table (generated).
+// External service endpoints and their credentials for callouts; secrets are stored encrypted
+type SystemNamedCredential struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	BaseURL string `json:"base_url"`
+	AuthType string `json:"auth_type"`
+	Username *string `json:"username,omitempty"`
+	AuthHeader *string `json:"auth_header,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+	TimeoutSeconds int `json:"timeout_seconds"`
+	MaxRetries int `json:"max_retries"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemNamedCredential.
+func (SystemNamedCredential) GetTableName() string {
+	return "_System_NamedCredential"
+}
+
 // SystemNotification represents the _System_Notification table (generated).
 // User notifications
 type SystemNotification struct {
@@ -601,6 +1401,69 @@ func (SystemObjectPerms) GetTableName() string {
 	return "_System_ObjectPerms"
 }
 
+// SystemOrder represents the _System_Order table (generated).
+// Customer order of products; activation decrements the stock of its items
+type SystemOrder struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Status string `json:"status"`
+	CustomerObjectAPIName *string `json:"customer_object_api_name,omitempty"`
+	CustomerID *string `json:"customer_id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	TotalAmount float64 `json:"total_amount"`
+	ActivatedDate *time.Time `json:"activated_date,omitempty"`
+	FulfilledDate *time.Time `json:"fulfilled_date,omitempty"`
+	CancelledDate *time.Time `json:"cancelled_date,omitempty"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemOrder.
+func (SystemOrder) GetTableName() string {
+	return "_System_Order"
+}
+
+// SystemOrderItem represents the _System_OrderItem table (generated).
+// Product line of an order; backordered_quantity is the part not covered by stock at activation
+type SystemOrderItem struct {
+	ID string `json:"__sys_gen_id"`
+	OrderID string `json:"order_id"`
+	ProductID string `json:"product_id"`
+	ProductName *string `json:"product_name,omitempty"`
+	Quantity float64 `json:"quantity"`
+	BackorderedQuantity float64 `json:"backordered_quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	TotalPrice float64 `json:"total_price"`
+	SortOrder int `json:"sort_order"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemOrderItem.
+func (SystemOrderItem) GetTableName() string {
+	return "_System_OrderItem"
+}
+
+// SystemOrganization represents the _System_Organization table (generated).
+// The organization and its first-run setup state (a single row)
+type SystemOrganization struct {
+	ID string `json:"__sys_gen_id"`
+	Name *string `json:"name,omitempty"`
+	DefaultLocale *string `json:"default_locale,omitempty"`
+	SetupStatus string `json:"setup_status"`
+	SampleData bool `json:"sample_data"`
+	SetupCompletedByID *string `json:"setup_completed_by_id,omitempty"`
+	SetupCompletedDate *time.Time `json:"setup_completed_date,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemOrganization.
+func (SystemOrganization) GetTableName() string {
+	return "_System_Organization"
+}
+
 // SystemOutboxEvent represents the _System_OutboxEvent table (generated).
 // Transactional event outbox for guaranteed delivery
 type SystemOutboxEvent struct {
@@ -628,6 +1491,8 @@ type SystemPermissionSet struct {
 	Label string `json:"label"`
 	Description string `json:"description"`
 	IsActive bool `json:"is_active"`
+	BypassFlows bool `json:"bypass_flows"`
+	BypassValidation bool `json:"bypass_validation"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
@@ -654,6 +1519,23 @@ func (SystemPermissionSetAssignment) GetTableName() string {
 	return "_System_PermissionSetAssignment"
 }
 
+// SystemPortalObject represents the _System_PortalObject table (generated).
+// Objects exposed to portal users and the fields relating their records to a portal user's contact or account
+type SystemPortalObject struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	ContactField *string `json:"contact_field,omitempty"`
+	AccountField *string `json:"account_field,omitempty"`
+	AllowCreate bool `json:"allow_create"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemPortalObject.
+func (SystemPortalObject) GetTableName() string {
+	return "_System_PortalObject"
+}
+
 // SystemProfile represents the _System_Profile table (generated).
 // User profiles and permission sets
 type SystemProfile struct {
@@ -662,6 +1544,8 @@ type SystemProfile struct {
 	Description string `json:"description"`
 	IsActive bool `json:"is_active"`
 	IsSystem bool `json:"is_system"`
+	BypassFlows bool `json:"bypass_flows"`
+	BypassValidation bool `json:"bypass_validation"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
 	CreatedByID *string `json:"__sys_gen_created_by_id,omitempty"`
@@ -691,6 +1575,41 @@ func (SystemProfileLayout) GetTableName() string {
 	return "_System_ProfileLayout"
 }
 
+// SystemProfileRecordType represents the _System_ProfileRecordType table (generated).
+// Record types available to a profile, with the profile default and page layout per record type
+type SystemProfileRecordType struct {
+	ID string `json:"__sys_gen_id"`
+	ProfileID string `json:"profile_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordTypeID string `json:"record_type_id"`
+	IsDefault bool `json:"is_default"`
+	LayoutID *string `json:"layout_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemProfileRecordType.
+func (SystemProfileRecordType) GetTableName() string {
+	return "_System_ProfileRecordType"
+}
+
+// SystemQueryGovernor represents the _System_QueryGovernor table (generated).
+// Per-profile overrides of the query governor limits
+type SystemQueryGovernor struct {
+	ID string `json:"__sys_gen_id"`
+	ProfileID string `json:"profile_id"`
+	MaxRows *int `json:"max_rows,omitempty"`
+	MaxExecutionMs *int `json:"max_execution_ms,omitempty"`
+	MaxConcurrentQueries *int `json:"max_concurrent_queries,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemQueryGovernor.
+func (SystemQueryGovernor) GetTableName() string {
+	return "_System_QueryGovernor"
+}
+
 // SystemRecent represents the _System_Recent table (generated).
 // Recently viewed records
 type SystemRecent struct {
@@ -740,6 +1659,8 @@ type SystemRecordType struct {
 	Description string `json:"description"`
 	IsActive bool `json:"is_active"`
 	IsMaster bool `json:"is_master"`
+	IsDefault bool `json:"is_default"`
+	PicklistValues json.RawMessage `json:"picklist_values,omitempty"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	CreatedDate time.Time `json:"__sys_gen_created_date"`
 	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
@@ -750,6 +1671,24 @@ func (SystemRecordType) GetTableName() string {
 	return "_System_RecordType"
 }
 
+// SystemRecordEmbedding represents the _System_Record_Embedding table (generated).
+// Embedding vectors of CRM records for semantic search
+type SystemRecordEmbedding struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Embedding string `json:"embedding"`
+	ContentHash string `json:"content_hash"`
+	Model string `json:"model"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemRecordEmbedding.
+func (SystemRecordEmbedding) GetTableName() string {
+	return "_System_Record_Embedding"
+}
+
 // SystemRecycleBin represents the _System_RecycleBin table (generated).
 // Recycle bin for soft-deleted records
 type SystemRecycleBin struct {
@@ -790,6 +1729,32 @@ func (SystemRelationship) GetTableName() string {
 	return "_System_Relationship"
 }
 
+// SystemReport represents the _System_Report table (generated).
+// Report definitions (tabular, summary and matrix)
+type SystemReport struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ObjectAPIName string `json:"object_api_name"`
+	Format string `json:"format"`
+	Columns json.RawMessage `json:"columns"`
+	FilterExpr *string `json:"filter_expr,omitempty"`
+	Groupings json.RawMessage `json:"groupings,omitempty"`
+	ColumnGroupings json.RawMessage `json:"column_groupings,omitempty"`
+	Aggregates json.RawMessage `json:"aggregates,omitempty"`
+	SortField *string `json:"sort_field,omitempty"`
+	SortDirection *string `json:"sort_direction,omitempty"`
+	RowLimit *int `json:"row_limit,omitempty"`
+	OwnerID *string `json:"owner_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemReport.
+func (SystemReport) GetTableName() string {
+	return "_System_Report"
+}
+
 // SystemRole represents the _System_Role table (generated).
 // Role hierarchy for access control
 type SystemRole struct {
@@ -811,6 +1776,126 @@ func (SystemRole) GetTableName() string {
 	return "_System_Role"
 }
 
+// SystemSLAPolicy represents the _System_SLAPolicy table (generated).
+// Service level milestones per record priority, with pause statuses and escalation flows
+type SystemSLAPolicy struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Label string `json:"label"`
+	ObjectAPIName string `json:"object_api_name"`
+	PriorityField *string `json:"priority_field,omitempty"`
+	StatusField string `json:"status_field"`
+	PausedStatuses json.RawMessage `json:"paused_statuses"`
+	ClosedStatuses json.RawMessage `json:"closed_statuses"`
+	BusinessHours *string `json:"business_hours,omitempty"`
+	Milestones json.RawMessage `json:"milestones"`
+	IsActive bool `json:"is_active"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSLAPolicy.
+func (SystemSLAPolicy) GetTableName() string {
+	return "_System_SLAPolicy"
+}
+
+// SystemSLATimer represents the _System_SLATimer table (generated).
+// Milestone timers of records under an SLA policy
+type SystemSLATimer struct {
+	ID string `json:"__sys_gen_id"`
+	PolicyName string `json:"policy_name"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	Milestone string `json:"milestone"`
+	TargetMinutes int `json:"target_minutes"`
+	Status string `json:"status"`
+	ElapsedSeconds int64 `json:"elapsed_seconds"`
+	RunningSince *time.Time `json:"running_since,omitempty"`
+	DueDate *time.Time `json:"due_date,omitempty"`
+	StartedDate time.Time `json:"started_date"`
+	CompletedDate *time.Time `json:"completed_date,omitempty"`
+	Violated bool `json:"violated"`
+	EscalatedDate *time.Time `json:"escalated_date,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSLATimer.
+func (SystemSLATimer) GetTableName() string {
+	return "_System_SLATimer"
+}
+
+// SystemSavedSearch represents the _System_SavedSearch table (generated).
+// Per-user saved global searches
+type SystemSavedSearch struct {
+	ID string `json:"__sys_gen_id"`
+	UserID string `json:"user_id"`
+	Name string `json:"name"`
+	Term string `json:"term"`
+	ObjectScope json.RawMessage `json:"object_scope,omitempty"`
+	LastRunDate *time.Time `json:"last_run_date,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSavedSearch.
+func (SystemSavedSearch) GetTableName() string {
+	return "_System_SavedSearch"
+}
+
+// SystemScript represents the _System_Script table (generated).
+// Sandboxed Starlark scripts run as record triggers and flow actions under step, time, memory and DML quotas
+type SystemScript struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Source string `json:"source"`
+	TriggerObject *string `json:"trigger_object,omitempty"`
+	TriggerEvents *string `json:"trigger_events,omitempty"`
+	IsActive bool `json:"is_active"`
+	MaxSteps *int64 `json:"max_steps,omitempty"`
+	TimeoutMs *int `json:"timeout_ms,omitempty"`
+	MaxMemoryMb *int `json:"max_memory_mb,omitempty"`
+	MaxDmlRows *int `json:"max_dml_rows,omitempty"`
+	MaxQueryRows *int `json:"max_query_rows,omitempty"`
+	MaxCallouts *int `json:"max_callouts,omitempty"`
+	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemScript.
+func (SystemScript) GetTableName() string {
+	return "_System_Script"
+}
+
+// SystemScriptLog represents the _System_ScriptLog table (generated).
+// Script runs with their outcome, resource usage and log output
+type SystemScriptLog struct {
+	ID string `json:"__sys_gen_id"`
+	ScriptID string `json:"script_id"`
+	ScriptName string `json:"script_name"`
+	Invocation string `json:"invocation"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	RecordID *string `json:"record_id,omitempty"`
+	Status string `json:"status"`
+	DurationMs int `json:"duration_ms"`
+	Steps int64 `json:"steps"`
+	DmlRows int `json:"dml_rows"`
+	QueryRows int `json:"query_rows"`
+	Callouts int `json:"callouts"`
+	Output *string `json:"output,omitempty"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	UserID *string `json:"user_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemScriptLog.
+func (SystemScriptLog) GetTableName() string {
+	return "_System_ScriptLog"
+}
+
 // SystemSession represents the _System_Session table (generated).
 // User authentication sessions
 type SystemSession struct {
@@ -831,6 +1916,28 @@ func (SystemSession) GetTableName() string {
 	return "_System_Session"
 }
 
+// SystemSetupAudit represents the _System_SetupAudit table (generated).
+// Setup audit trail: one row per metadata change with its before/after state and actor
+type SystemSetupAudit struct {
+	ID string `json:"__sys_gen_id"`
+	Action string `json:"action"`
+	ComponentType string `json:"component_type"`
+	ComponentName string `json:"component_name"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	BeforeData json.RawMessage `json:"before_data,omitempty"`
+	AfterData json.RawMessage `json:"after_data,omitempty"`
+	ActorID *string `json:"actor_id,omitempty"`
+	ActorName *string `json:"actor_name,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSetupAudit.
+func (SystemSetupAudit) GetTableName() string {
+	return "_System_SetupAudit"
+}
+
 // SystemSetupPage represents the _System_SetupPage table (generated).
 // Setup page definitions
 type SystemSetupPage struct {
@@ -876,6 +1983,137 @@ func (SystemSharingRule) GetTableName() string {
 	return "_System_SharingRule"
 }
 
+// SystemStageHistory represents the _System_StageHistory table (generated).
+// Stays of records in the values of history-tracked picklist fields (stage, status) with entry and exit times
+type SystemStageHistory struct {
+	ID string `json:"__sys_gen_id"`
+	ObjectAPIName string `json:"object_api_name"`
+	RecordID string `json:"record_id"`
+	FieldAPIName string `json:"field_api_name"`
+	Value string `json:"value"`
+	PreviousValue *string `json:"previous_value,omitempty"`
+	EnteredDate time.Time `json:"entered_date"`
+	ExitedDate *time.Time `json:"exited_date,omitempty"`
+	DurationSeconds *int64 `json:"duration_seconds,omitempty"`
+	ChangedByID *string `json:"changed_by_id,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+}
+
+// GetTableName returns the database table name for SystemStageHistory.
+func (SystemStageHistory) GetTableName() string {
+	return "_System_StageHistory"
+}
+
+// SystemStateMachine represents the _System_StateMachine table (generated).
+// State machines on picklist fields: the allowed transitions between values, their required fields and profiles, and the actions run on entering and leaving each state
+type SystemStateMachine struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ObjectAPIName string `json:"object_api_name"`
+	FieldAPIName string `json:"field_api_name"`
+	InitialStates json.RawMessage `json:"initial_states,omitempty"`
+	States json.RawMessage `json:"states,omitempty"`
+	Transitions json.RawMessage `json:"transitions,omitempty"`
+	IsActive bool `json:"is_active"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemStateMachine.
+func (SystemStateMachine) GetTableName() string {
+	return "_System_StateMachine"
+}
+
+// SystemSurvey represents the _System_Survey table (generated).
+// Customer survey: a CSAT or NPS score question with optional follow-up questions
+type SystemSurvey struct {
+	ID string `json:"__sys_gen_id"`
+	Name string `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ScoreType string `json:"score_type"`
+	Question string `json:"question"`
+	Questions json.RawMessage `json:"questions,omitempty"`
+	IsActive bool `json:"is_active"`
+	OwnerID string `json:"__sys_gen_owner_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSurvey.
+func (SystemSurvey) GetTableName() string {
+	return "_System_Survey"
+}
+
+// SystemSurveyInvitation represents the _System_SurveyInvitation table (generated).
+// Single-use survey link sent about a record such as a case; only a hash of its token is stored
+type SystemSurveyInvitation struct {
+	ID string `json:"__sys_gen_id"`
+	SurveyID string `json:"survey_id"`
+	TokenHash string `json:"token_hash"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	RecordID *string `json:"record_id,omitempty"`
+	ContactID *string `json:"contact_id,omitempty"`
+	ExpiresDate *time.Time `json:"expires_date,omitempty"`
+	RespondedDate *time.Time `json:"responded_date,omitempty"`
+	CreatedByID string `json:"created_by_id"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSurveyInvitation.
+func (SystemSurveyInvitation) GetTableName() string {
+	return "_System_SurveyInvitation"
+}
+
+// SystemSurveyResponse represents the _System_SurveyResponse table (generated).
+// Scored answer to a survey invitation, linked to the invitation's record and contact
+type SystemSurveyResponse struct {
+	ID string `json:"__sys_gen_id"`
+	SurveyID string `json:"survey_id"`
+	InvitationID string `json:"invitation_id"`
+	ObjectAPIName *string `json:"object_api_name,omitempty"`
+	RecordID *string `json:"record_id,omitempty"`
+	ContactID *string `json:"contact_id,omitempty"`
+	Score int `json:"score"`
+	Answers json.RawMessage `json:"answers,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+	SubmittedDate time.Time `json:"submitted_date"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSurveyResponse.
+func (SystemSurveyResponse) GetTableName() string {
+	return "_System_SurveyResponse"
+}
+
+// SystemSyncConnector represents the _System_SyncConnector table (generated).
+// Mailbox and calendar connections of users (Google, Microsoft 365); OAuth tokens are stored encrypted
+type SystemSyncConnector struct {
+	ID string `json:"__sys_gen_id"`
+	UserID string `json:"user_id"`
+	Provider string `json:"provider"`
+	AccountEmail string `json:"account_email"`
+	AccessToken string `json:"access_token"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	TokenExpiresAt *time.Time `json:"token_expires_at,omitempty"`
+	SyncEmail bool `json:"sync_email"`
+	SyncCalendar bool `json:"sync_calendar"`
+	IsActive bool `json:"is_active"`
+	EmailSyncedUntil *time.Time `json:"email_synced_until,omitempty"`
+	CalendarSyncedUntil *time.Time `json:"calendar_synced_until,omitempty"`
+	LastSyncDate *time.Time `json:"last_sync_date,omitempty"`
+	LastError *string `json:"last_error,omitempty"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemSyncConnector.
+func (SystemSyncConnector) GetTableName() string {
+	return "_System_SyncConnector"
+}
+
 // SystemSystemLog represents the _System_SystemLog table (generated).
 // System operation logs
 type SystemSystemLog struct {
@@ -951,6 +2189,23 @@ func (SystemTheme) GetTableName() string {
 	return "_System_Theme"
 }
 
+// SystemTranslation represents the _System_Translation table (generated).
+// Translated labels and messages of metadata components, one row per locale and component
+type SystemTranslation struct {
+	ID string `json:"__sys_gen_id"`
+	Locale string `json:"locale"`
+	ComponentType string `json:"component_type"`
+	ComponentKey string `json:"component_key"`
+	Translation string `json:"translation"`
+	CreatedDate time.Time `json:"__sys_gen_created_date"`
+	LastModifiedDate time.Time `json:"__sys_gen_last_modified_date"`
+}
+
+// GetTableName returns the database table name for SystemTranslation.
+func (SystemTranslation) GetTableName() string {
+	return "_System_Translation"
+}
+
 // SystemUIComponent represents the _System_UIComponent table (generated).
 // Registered UI components
 type SystemUIComponent struct {
@@ -981,6 +2236,10 @@ type SystemUser struct {
 	Phone *string `json:"phone,omitempty"`
 	ProfileID string `json:"profile_id"`
 	RoleID *string `json:"role_id,omitempty"`
+	UserType string `json:"user_type"`
+	ContactID *string `json:"contact_id,omitempty"`
+	AccountID *string `json:"account_id,omitempty"`
+	Locale *string `json:"locale,omitempty"`
 	IsActive bool `json:"is_active"`
 	IsDeleted bool `json:"__sys_gen_is_deleted"`
 	OwnerID *string `json:"__sys_gen_owner_id,omitempty"`
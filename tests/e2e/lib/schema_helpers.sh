@@ -104,7 +104,7 @@ add_field() {
         local err=$(echo "$res" | jq -r '.error // empty')
         if [ -z "$err" ]; then err="$res"; fi
         # Don't warn about already exists
-        if [[ "$err" == *"already exists"* ]]; then
+        if [[ "$(echo "$res" | jq -r '.code // empty')" == "CONFLICT" ]]; then
              echo "  ✓ Field $api_name already exists"
              return 0
         fi
@@ -23,15 +23,15 @@ test_protected_endpoints() {
     echo "Test 2.1: Protected Endpoints Require Auth"
     
     local response=$(api_get_unauth "/api/metadata/apps")
-    assert_contains "$response" "Unauthorized" "Metadata endpoints properly protected"
+    assert_contains "$response" "UNAUTHORIZED" "Metadata endpoints properly protected"
     
     echo ""
     response=$(api_post_unauth "/api/formula/evaluate" '{"expression":"2+2","context":{}}')
-    assert_contains "$response" "Unauthorized" "Formula endpoints properly protected"
+    assert_contains "$response" "UNAUTHORIZED" "Formula endpoints properly protected"
     
     echo ""
     response=$(api_post_unauth "/api/data/query" '{"objectApiName":"Account"}')
-    assert_contains "$response" "Unauthorized" "Data endpoints properly protected"
+    assert_contains "$response" "UNAUTHORIZED" "Data endpoints properly protected"
 }
 
 test_login_validation() {
@@ -47,6 +47,9 @@ run_suite() {
     test_manual_sharing
     test_team_members
     
+    # System Table Write Policy
+    test_system_table_write_policy
+    
     # Cleanup
     test_cleanup
 }
@@ -339,13 +342,33 @@ test_team_members() {
     fi
 }
 
+# =========================================
+# SYSTEM TABLE WRITE POLICY TESTS
+# =========================================
+
+test_system_table_write_policy() {
+    echo ""
+    echo "Test 19.10: System Table Write Policy"
+    
+    # Sessions and object permissions are not writable through the data API, even by admins
+    local session=$(api_post "/api/data/_system_session" '{"user_id": "'$USER_ID'", "token": "forged_'$TIMESTAMP'"}')
+    local perm=$(api_post "/api/data/_system_objectperms" '{"profile_id": "standard_user", "object_api_name": "account", "allow_delete": true}')
+    
+    if echo "$session" | grep -q "PERMISSION_DENIED" && echo "$perm" | grep -q "PERMISSION_DENIED"; then
+        echo "  ✓ Session and permission writes rejected"
+        test_passed "System table write policy enforced"
+    else
+        test_failed "System table write policy not enforced" "$session $perm"
+    fi
+}
+
 # =========================================
 # CLEANUP
 # =========================================
 
 test_cleanup() {
     echo ""
-    echo "Test 19.10: Cleanup"
+    echo "Test 19.11: Cleanup"
     
     [ -n "$TEST_TEAM_ID" ] && api_delete "/api/data/_system_teammember/$TEST_TEAM_ID" > /dev/null 2>&1
     [ -n "$TEST_SHARE_ID" ] && api_delete "/api/data/_system_recordshare/$TEST_SHARE_ID" > /dev/null 2>&1